@@ -0,0 +1,300 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package httpreplay
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+)
+
+// MatchMode selects how replayed requests are matched to the recorded interactions
+type MatchMode int
+
+const (
+	// MatchModeScored matches a request to the recorded interaction with the best matching query string or body,
+	// preferring the least used interactions. It depends on the order in which the requests are sent.
+	MatchModeScored MatchMode = iota
+	// MatchModeDeterministic matches a request to the recorded interactions with the same method, path, query and
+	// body which were not used yet, so that concurrent requests replay the same way regardless of scheduling.
+	// The values which differ from the recording, such as generated names, are learned from the recorded body with
+	// the same method, path and query.
+	MatchModeDeterministic
+)
+
+// MatchModeEnvVar is the environment variable selecting the match mode in replay, set it to "deterministic" to use
+// MatchModeDeterministic
+const MatchModeEnvVar = "TF_VAR_REPLAY_MATCH_MODE"
+
+// MatchModeFromEnv returns the match mode selected by the MatchModeEnvVar environment variable
+func MatchModeFromEnv() MatchMode {
+	if strings.EqualFold(os.Getenv(MatchModeEnvVar), "deterministic") {
+		return MatchModeDeterministic
+	}
+	return MatchModeScored
+}
+
+// UnmatchedRequestError reports a request without a recorded interaction in MatchModeDeterministic, along with the
+// recorded interaction closest to it to help finding out why the request differs from the recording
+type UnmatchedRequestError struct {
+	Key     string
+	Closest *Interaction
+	// ClosestKey is the key of the closest interaction
+	ClosestKey string
+}
+
+func (e *UnmatchedRequestError) Error() string {
+	if e.Closest == nil {
+		return fmt.Sprintf("%s: %s, the scenario has no interactions", ErrInteractionNotFound, e.Key)
+	}
+	return fmt.Sprintf("%s: %s, the closest candidate is interaction %d: %s", ErrInteractionNotFound, e.Key, e.Closest.Index, e.ClosestKey)
+}
+
+// Is makes errors.Is(err, ErrInteractionNotFound) hold for an UnmatchedRequestError
+func (e *UnmatchedRequestError) Is(target error) bool {
+	return target == ErrInteractionNotFound
+}
+
+// requestKey identifies the requests which are interchangeable in MatchModeDeterministic
+type requestKey struct {
+	Method string
+	Path   string
+	Query  string
+	Body   string
+}
+
+func (k requestKey) String() string {
+	key := k.Method + " " + k.Path
+	if k.Query != "" {
+		key += "?" + k.Query
+	}
+	if k.Body != "" {
+		key += " body:" + k.Body
+	}
+	return key
+}
+
+// getRequestKey builds the key of a request from its method, normalized path, sorted query and body fingerprint.
+// The values which were replaced in the replay, as tracked by fields, are changed back to the recorded values in the
+// path, the query and the body so that the request matches its recording.
+func getRequestKey(r *Request, fields map[string]string) requestKey {
+	key := requestKey{Method: strings.ToUpper(r.Method)}
+	restore := recordedValuesRestorer(fields)
+
+	requestURL, err := url.Parse(r.URL)
+	if err != nil {
+		key.Path = stripQuery(r.URL)
+	} else {
+		key.Path = requestURL.EscapedPath()
+		key.Query = normalizeQuery(requestURL.RawQuery, restore)
+	}
+	key.Path = restore(strings.TrimSuffix(key.Path, "/"))
+
+	key.Body = bodyFingerprint(r.Body, restore)
+	return key
+}
+
+// recordedValuesRestorer returns a function which changes the values replaced in the replay, as tracked by fields,
+// back to their recorded values
+func recordedValuesRestorer(fields map[string]string) func(string) string {
+	recordedValues := make([]string, 0, len(fields))
+	for recordedValue, replayedValue := range fields {
+		if len(replayedValue) > 1 && recordedValue != "" {
+			recordedValues = append(recordedValues, recordedValue)
+		}
+	}
+	sort.Strings(recordedValues)
+	return func(value string) string {
+		for _, recordedValue := range recordedValues {
+			value = strings.Replace(value, fields[recordedValue], recordedValue, -1)
+		}
+		return value
+	}
+}
+
+func normalizeQuery(rawQuery string, restore func(string) string) string {
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return restore(rawQuery)
+	}
+	for name, values := range query {
+		for i, value := range values {
+			items := strings.Split(restore(value), ",")
+			sort.Strings(items)
+			values[i] = strings.Join(items, ",")
+		}
+		sort.Strings(values)
+		query[name] = values
+	}
+	return query.Encode()
+}
+
+// bodyFingerprint hashes the body of a request, JSON bodies are hashed in their canonical form so that the order of
+// their fields does not matter
+func bodyFingerprint(body string, restore func(string) string) string {
+	if body == "" {
+		return ""
+	}
+	canonical := []byte(restore(body))
+	if bodyParsed, err := unmarshal([]byte(body)); err == nil && bodyParsed != nil {
+		if data, err := json.Marshal(restoreBodyValues(bodyParsed, restore)); err == nil {
+			canonical = data
+		}
+	}
+	sum := sha256.Sum256(canonical)
+	return hex.EncodeToString(sum[:])[:16]
+}
+
+// restoreBodyValues changes the replayed values of the strings of a parsed body back to their recorded values
+func restoreBodyValues(value interface{}, restore func(string) string) interface{} {
+	switch v := value.(type) {
+	case string:
+		return restore(v)
+	case jsonStr:
+		return jsonStr(restore(string(v)))
+	case jsonObj:
+		for key, item := range v {
+			v[key] = restoreBodyValues(item, restore)
+		}
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = restoreBodyValues(item, restore)
+		}
+	case jsonArr:
+		for _, item := range v {
+			restoreBodyValues(item, restore)
+		}
+	case []interface{}:
+		for index, item := range v {
+			v[index] = restoreBodyValues(item, restore)
+		}
+	}
+	return value
+}
+
+// getInteractionByKey returns the first recorded interaction with the key of the request which was not matched by
+// another request yet, so that the requests with the same key are matched to the remaining interactions whatever the
+// order in which they are sent. Once all the interactions with that key have been used, the last one keeps being
+// returned, as when polling for a state.
+func (s *Scenario) getInteractionByKey(r Request) (*Interaction, error) {
+	if s.keyedInteractions == nil {
+		s.keyedInteractions = make(map[requestKey][]int)
+		s.usedInteractions = make(map[int]bool)
+		for index := range s.Interactions {
+			key := getRequestKey(&s.Interactions[index].Request, nil)
+			s.keyedInteractions[key] = append(s.keyedInteractions[key], index)
+		}
+	}
+
+	key := getRequestKey(&r, s.Fields)
+	indexes := s.keyedInteractions[key]
+	if len(indexes) == 0 {
+		key, indexes = s.learnFields(r, key)
+	}
+	if len(indexes) == 0 {
+		return nil, s.unmatchedRequestError(key)
+	}
+
+	index := s.firstUnusedInteraction(indexes)
+	if index < 0 {
+		index = indexes[len(indexes)-1]
+	}
+	s.usedInteractions[index] = true
+	i := &s.Interactions[index]
+	s.updateUsageCount(i.Index)
+	debugLogf("\t-> Request %s matched interaction %d", key, i.Index)
+	return i, nil
+}
+
+// firstUnusedInteraction returns the first of the interactions which was not matched by a request yet, or -1 if they
+// were all used
+func (s *Scenario) firstUnusedInteraction(indexes []int) int {
+	for _, index := range indexes {
+		if !s.usedInteractions[index] {
+			return index
+		}
+	}
+	return -1
+}
+
+// learnFields matches a request whose body was not recorded to a recorded interaction with the same method, path and
+// query, preferring the interactions which were not used yet in recording order. The values which differ between
+// the bodies are learned as fields, as the transformer does once a request is matched, when the body of the request
+// matches the recorded body with them. It returns the key of the request with the learned fields, and the
+// interactions with that key.
+func (s *Scenario) learnFields(r Request, key requestKey) (requestKey, []int) {
+	requestBody, err := unmarshal([]byte(r.Body))
+	if err != nil || requestBody == nil {
+		return key, nil
+	}
+	r.BodyParsed = requestBody
+
+	for _, skipUsed := range []bool{true, false} {
+		for index := range s.Interactions {
+			candidate := getRequestKey(&s.Interactions[index].Request, nil)
+			if candidate.Method != key.Method || candidate.Path != key.Path || candidate.Query != key.Query || candidate.Body == "" {
+				continue
+			}
+			if skipUsed && s.usedInteractions[index] {
+				continue
+			}
+			recordedBody, err := unmarshal([]byte(s.Interactions[index].Request.Body))
+			if err != nil {
+				continue
+			}
+
+			learner := &Scenario{Fields: make(map[string]string, len(s.Fields))}
+			for recordedValue, replayedValue := range s.Fields {
+				learner.Fields[recordedValue] = replayedValue
+			}
+			learner.updateFieldMap(&r, &Interaction{Request: Request{BodyParsed: recordedBody}})
+			if learnedKey := getRequestKey(&r, learner.Fields); learnedKey == candidate {
+				debugLogf("\t-> Request %s learned fields from interaction %d", key, index)
+				s.Fields = learner.Fields
+				return learnedKey, s.keyedInteractions[learnedKey]
+			}
+		}
+	}
+	return key, nil
+}
+
+// unmatchedRequestError finds the recorded interaction closest to an unmatched request: the one with the same method
+// and the most path segments in common, then with the same query and body
+func (s *Scenario) unmatchedRequestError(key requestKey) error {
+	err := &UnmatchedRequestError{Key: key.String()}
+	maxScore := -1
+	for index := range s.Interactions {
+		candidate := getRequestKey(&s.Interactions[index].Request, nil)
+		score := 0
+		if candidate.Method == key.Method {
+			score += 100
+		}
+		keySegments, candidateSegments := strings.Split(key.Path, "/"), strings.Split(candidate.Path, "/")
+		if len(keySegments) == len(candidateSegments) {
+			score += 10
+		}
+		for i := 0; i < len(keySegments) && i < len(candidateSegments); i++ {
+			if keySegments[i] == candidateSegments[i] {
+				score += 10
+			}
+		}
+		if candidate.Query == key.Query {
+			score += 2
+		}
+		if candidate.Body == key.Body {
+			score++
+		}
+		if score > maxScore {
+			maxScore = score
+			err.Closest = &s.Interactions[index]
+			err.ClosestKey = candidate.String()
+		}
+	}
+	return err
+}
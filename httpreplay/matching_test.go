@@ -0,0 +1,222 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Run with a command something like:
+//   go test -run TestDeterministic
+
+package httpreplay
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func newDeterministicScenario(requests ...Request) *Scenario {
+	s := NewScenario("TestDeterministic")
+	for _, request := range requests {
+		s.AddInteraction(&Interaction{Request: request, Response: Response{Body: request.URL + " " + request.Body}})
+	}
+	s.SetMatchMode(MatchModeDeterministic)
+	return s
+}
+
+func TestDeterministicRequestKey(t *testing.T) {
+	a := getRequestKey(&Request{Method: "get", URL: "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns/?limit=10&compartmentId=c1"}, nil)
+	b := getRequestKey(&Request{Method: "GET", URL: "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns?compartmentId=c1&limit=10"}, nil)
+	if a != b {
+		t.Errorf("Expected the same key regardless of the query order and trailing slash, got %v and %v", a, b)
+	}
+
+	a = getRequestKey(&Request{Method: "POST", URL: "/20160918/vcns", Body: `{"displayName": "vcn", "cidrBlock": "10.0.0.0/16"}`}, nil)
+	b = getRequestKey(&Request{Method: "POST", URL: "/20160918/vcns", Body: `{"cidrBlock":"10.0.0.0/16","displayName":"vcn"}`}, nil)
+	if a != b {
+		t.Errorf("Expected the same key regardless of the JSON field order, got %v and %v", a, b)
+	}
+
+	c := getRequestKey(&Request{Method: "POST", URL: "/20160918/vcns", Body: `{"cidrBlock":"10.1.0.0/16","displayName":"vcn"}`}, nil)
+	if a == c {
+		t.Errorf("Expected different keys for different bodies, got %v", c)
+	}
+
+	replayed := getRequestKey(&Request{Method: "GET", URL: "/20160918/vcns/ocid1.vcn.new"}, map[string]string{"ocid1.vcn.old": "ocid1.vcn.new"})
+	if replayed.Path != "/20160918/vcns/ocid1.vcn.old" {
+		t.Errorf("Expected the replayed value to be changed back to the recorded one, got %v", replayed.Path)
+	}
+
+	fields := map[string]string{"ocid1.vcn.old": "ocid1.vcn.new"}
+	recorded := getRequestKey(&Request{Method: "POST", URL: "/20160918/subnets?vcnId=ocid1.vcn.old", Body: `{"vcnId":"ocid1.vcn.old","routeTableIds":["ocid1.vcn.old.rt"]}`}, nil)
+	replayed = getRequestKey(&Request{Method: "POST", URL: "/20160918/subnets?vcnId=ocid1.vcn.new", Body: `{"vcnId":"ocid1.vcn.new","routeTableIds":["ocid1.vcn.new.rt"]}`}, fields)
+	if replayed != recorded {
+		t.Errorf("Expected the replayed values of the query and body to be changed back to the recorded ones, got %v and %v", replayed, recorded)
+	}
+}
+
+func TestDeterministicGetInteraction(t *testing.T) {
+	s := newDeterministicScenario(
+		Request{Method: "GET", URL: "/20160918/vcns/1"},
+		Request{Method: "GET", URL: "/20160918/subnets/1"},
+		Request{Method: "GET", URL: "/20160918/vcns/1"},
+		Request{Method: "POST", URL: "/20160918/vcns", Body: `{"displayName":"a"}`},
+		Request{Method: "POST", URL: "/20160918/vcns", Body: `{"displayName":"b"}`},
+	)
+
+	// Requests sent in another order than recorded still get the interactions of their own key, in recording order
+	expected := []struct {
+		request Request
+		index   int
+	}{
+		{Request{Method: "POST", URL: "/20160918/vcns", Body: `{"displayName":"b"}`}, 4},
+		{Request{Method: "GET", URL: "/20160918/subnets/1"}, 1},
+		{Request{Method: "GET", URL: "/20160918/vcns/1"}, 0},
+		{Request{Method: "POST", URL: "/20160918/vcns", Body: `{"displayName":"a"}`}, 3},
+		{Request{Method: "GET", URL: "/20160918/vcns/1"}, 2},
+		{Request{Method: "GET", URL: "/20160918/vcns/1"}, 2},
+	}
+	for _, e := range expected {
+		i, err := s.GetInteraction(e.request)
+		if err != nil {
+			t.Fatalf("Unexpected error for %s %s: %v", e.request.Method, e.request.URL, err)
+		}
+		if i.Index != e.index {
+			t.Errorf("Expected %s %s %s to match interaction %d, got %d", e.request.Method, e.request.URL, e.request.Body, e.index, i.Index)
+		}
+	}
+}
+
+func TestDeterministicGetInteractionConcurrently(t *testing.T) {
+	var requests []Request
+	for _, path := range []string{"/vcns/1", "/vcns/2", "/vcns/3", "/vcns/4"} {
+		requests = append(requests, Request{Method: "GET", URL: path})
+	}
+	s := newDeterministicScenario(requests...)
+
+	var wg sync.WaitGroup
+	for index := range requests {
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			i, err := s.GetInteraction(requests[len(requests)-1-index])
+			if err != nil || i.Index != len(requests)-1-index {
+				t.Errorf("Expected request %d to match its own interaction, got %v, %v", len(requests)-1-index, i, err)
+			}
+		}(index)
+	}
+	wg.Wait()
+}
+
+func TestDeterministicGetInteractionRemainingUnused(t *testing.T) {
+	var requests []Request
+	for sequence := 0; sequence < 3; sequence++ {
+		requests = append(requests,
+			Request{Method: "GET", URL: "/20160918/vcns/1"},
+			Request{Method: "POST", URL: "/20160918/vcns", Body: `{"displayName":"a"}`},
+			Request{Method: "POST", URL: "/20160918/vcns", Body: `{"displayName":"b"}`},
+		)
+	}
+	s := newDeterministicScenario(requests...)
+
+	// The requests with the same method, URL and body are sent concurrently in another order than recorded, each
+	// one matches one of the interactions of its key which was not used yet
+	var mu sync.Mutex
+	matched := make(map[int]bool)
+	var wg sync.WaitGroup
+	for index := range requests {
+		wg.Add(1)
+		go func(request Request) {
+			defer wg.Done()
+			i, err := s.GetInteraction(request)
+			if err != nil {
+				t.Errorf("Unexpected error for %s %s: %v", request.Method, request.URL, err)
+				return
+			}
+			if getRequestKey(&i.Request, nil) != getRequestKey(&request, nil) {
+				t.Errorf("Expected %s %s %s to match an interaction of its key, got %d", request.Method, request.URL, request.Body, i.Index)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if matched[i.Index] {
+				t.Errorf("Expected interaction %d to be matched once", i.Index)
+			}
+			matched[i.Index] = true
+		}(requests[len(requests)-1-index])
+	}
+	wg.Wait()
+	if len(matched) != len(requests) {
+		t.Errorf("Expected all the interactions to be matched, got %v", matched)
+	}
+}
+
+func TestDeterministicUnmatchedRequest(t *testing.T) {
+	s := newDeterministicScenario(
+		Request{Method: "GET", URL: "/20160918/subnets/1"},
+		Request{Method: "PUT", URL: "/20160918/vcns/1", Body: `{"displayName":"a"}`},
+		Request{Method: "GET", URL: "/20160918/vcns/1"},
+	)
+
+	_, err := s.GetInteraction(Request{Method: "PUT", URL: "/20160918/vcns/1", Body: `{"displayName":"b","dnsLabel":"b"}`})
+	if !errors.Is(err, ErrInteractionNotFound) {
+		t.Fatalf("Expected ErrInteractionNotFound, got %v", err)
+	}
+	var unmatched *UnmatchedRequestError
+	if !errors.As(err, &unmatched) || unmatched.Closest == nil || unmatched.Closest.Index != 1 {
+		t.Fatalf("Expected interaction 1 to be the closest candidate, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "PUT /20160918/vcns/1") {
+		t.Errorf("Expected the diagnostic to describe the closest candidate, got %v", err)
+	}
+}
+
+func TestDeterministicLearnFields(t *testing.T) {
+	s := newDeterministicScenario(
+		Request{Method: "POST", URL: "/20160918/vcns", Body: `{"displayName":"vcn-recorded-1","cidrBlock":"10.0.0.0/16"}`},
+		Request{Method: "POST", URL: "/20160918/vcns", Body: `{"displayName":"vcn-recorded-2","cidrBlock":"10.0.0.0/16"}`},
+		Request{Method: "GET", URL: "/20160918/vcns?displayName=vcn-recorded-2"},
+	)
+
+	// The bodies differ by their generated names, which are learned from the interactions not used yet
+	expected := []struct {
+		request Request
+		index   int
+	}{
+		{Request{Method: "POST", URL: "/20160918/vcns", Body: `{"displayName":"vcn-replayed-1","cidrBlock":"10.0.0.0/16"}`}, 0},
+		{Request{Method: "POST", URL: "/20160918/vcns", Body: `{"displayName":"vcn-replayed-2","cidrBlock":"10.0.0.0/16"}`}, 1},
+		{Request{Method: "GET", URL: "/20160918/vcns?displayName=vcn-replayed-2"}, 2},
+	}
+	for _, e := range expected {
+		i, err := s.GetInteraction(e.request)
+		if err != nil {
+			t.Fatalf("Unexpected error for %s %s %s: %v", e.request.Method, e.request.URL, e.request.Body, err)
+		}
+		if i.Index != e.index {
+			t.Errorf("Expected %s %s %s to match interaction %d, got %d", e.request.Method, e.request.URL, e.request.Body, e.index, i.Index)
+		}
+	}
+	if s.Fields["vcn-recorded-2"] != "vcn-replayed-2" {
+		t.Errorf("Expected the replayed name to be learned, got %v", s.Fields)
+	}
+
+	// A body with another shape is not matched, and nothing is learned from it
+	if _, err := s.GetInteraction(Request{Method: "POST", URL: "/20160918/vcns", Body: `{"displayName":"vcn-replayed-3"}`}); !errors.Is(err, ErrInteractionNotFound) {
+		t.Errorf("Expected ErrInteractionNotFound, got %v", err)
+	}
+	if _, ok := s.Fields["10.0.0.0/16"]; ok || len(s.Fields) != 2 {
+		t.Errorf("Expected no fields to be learned from an unmatched request, got %v", s.Fields)
+	}
+}
+
+func TestDeterministicUsesSorted(t *testing.T) {
+	s := newDeterministicScenario(
+		Request{Method: "GET", URL: "/20160918/vcns/1"},
+		Request{Method: "GET", URL: "/20160918/vcns/2"},
+	)
+	if _, err := s.GetInteraction(Request{Method: "GET", URL: "/20160918/vcns/2"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, i := range s.sortedInteractions {
+		if i.Uses != s.Interactions[i.Index].Uses {
+			t.Errorf("Expected the uses of interaction %d to be %d in the sorted interactions, got %d", i.Index, s.Interactions[i.Index].Uses, i.Uses)
+		}
+	}
+}
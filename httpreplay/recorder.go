@@ -44,7 +44,7 @@ type roundTripperProxy struct {
 
 func (rtp *roundTripperProxy) RoundTrip(r *http.Request) (*http.Response, error) {
 	res, err := rtp.recorder.RoundTrip(r, rtp.chained)
-	if err != nil && err.Error() == "Requested interaction not found" {
+	if errors.Is(err, ErrInteractionNotFound) {
 		debugLogf("stop RoundTrip for err: %v", err)
 		panic(err)
 	}
@@ -65,9 +65,13 @@ type Recorder struct {
 	// Scenario used by the recorder
 	scenario *Scenario
 
-	// transformer is used to adjust responses to match changes in requests
+	// transformer is used to adjust responses to match changes in requests. When recording, it is applied to the
+	// saved copy of the interactions
 	transformer Transformer
 
+	// redactor redacts the replayed requests like the recorded ones, and restores the redacted values in the responses
+	redactor *Redactor
+
 	// count is for debug logging -- how many requests have been matched
 	count int
 }
@@ -92,6 +96,20 @@ func (r *Recorder) SetTransformer(t Transformer) {
 	r.transformer = t
 }
 
+// SetRedactor sets the redactor of the recordings. In record mode, the saved interactions are redacted and the scenario
+// is marked as redacted. In replay mode, the requests are redacted to be matched only if the scenario is marked as
+// redacted, as the recordings made before redaction hold the raw values
+func (r *Recorder) SetRedactor(redactor *Redactor) {
+	if r.mode == ModeRecording {
+		r.scenario.Redacted = true
+		r.SetTransformer(redactor.Transform)
+		return
+	}
+	if r.scenario.Redacted {
+		r.redactor = redactor
+	}
+}
+
 var mut sync.RWMutex
 
 func (r *Recorder) invokeTransformer(req *http.Request) (*Interaction, *Response, error) {
@@ -121,16 +139,28 @@ func (r *Recorder) invokeTransformer(req *http.Request) (*Interaction, *Response
 		URL:        req.URL.String(),
 		Method:     req.Method,
 	}
+	if r.redactor != nil {
+		request.Headers = request.Headers.Clone()
+		request.Form = cloneValues(request.Form)
+		r.redactor.RedactRequest(&request)
+	}
 
 	i, err := r.scenario.GetInteraction(request)
 	if err != nil {
-		if err.Error() == "Requested interaction not found" {
-			debugLogf("\t-> Convert full path of request to find Interaction:")
-			i, err = r.scenario.GetInteractionWithFullPath(request)
-			if err != nil {
-				debugLogf("\t-> Returning error from invokeTransformer: %v", err)
-				return nil, nil, err
+		if !errors.Is(err, ErrInteractionNotFound) {
+			debugLogf("\t-> Returning error from invokeTransformer: %v", err)
+			return nil, nil, err
+		}
+		debugLogf("\t-> Convert full path of request to find Interaction:")
+		var fullPathErr error
+		i, fullPathErr = r.scenario.GetInteractionWithFullPath(request)
+		if fullPathErr != nil {
+			// Keep the diagnostic of the first attempt if the full path did not match either
+			if !errors.Is(fullPathErr, ErrInteractionNotFound) {
+				err = fullPathErr
 			}
+			debugLogf("\t-> Returning error from invokeTransformer: %v", err)
+			return nil, nil, err
 		}
 	}
 	i.Request.BodyParsed, _ = unmarshal([]byte(i.Request.Body))
@@ -165,6 +195,10 @@ func (r *Recorder) invokeTransformer(req *http.Request) (*Interaction, *Response
 		}
 		response.Body = string(resBody)
 	}
+	if r.redactor != nil {
+		response.Body = r.redactor.Restore(response.Body)
+		response.Headers = r.redactor.RestoreHeaders(response.Headers)
+	}
 
 	return i, &response, nil
 }
@@ -227,7 +261,14 @@ func (r *Recorder) recordInteraction(req *http.Request, realTransport http.Round
 			Code:    resp.StatusCode,
 		},
 	}
-	r.scenario.AddInteraction(interaction)
+
+	// Save a copy of the interaction, so that the transformer does not change the response returned to the client
+	recorded := *interaction
+	recorded.Request.Headers = interaction.Request.Headers.Clone()
+	recorded.Request.Form = cloneValues(interaction.Request.Form)
+	recorded.Response.Headers = interaction.Response.Headers.Clone()
+	r.transformer(&recorded.Request, recorded, &recorded.Response)
+	r.scenario.AddInteraction(&recorded)
 
 	return interaction, &interaction.Response, nil
 }
@@ -13,7 +13,13 @@ var recorder *Recorder
 // SetScenario creates a new recorder for this scenario
 func SetScenario(name string) error {
 	var err error
+	var redactor *Redactor
+	if redactor, err = NewRedactorFromEnv(); err != nil {
+		debugLogf("Making a new recorder '%s' failed, %v", name, err)
+		return err
+	}
 	if recorder, err = NewRecorderAsMode(name, ModeRecording); err == nil {
+		recorder.SetRedactor(redactor)
 		debugLogf("Making a new recorder '%s' success", name)
 	} else {
 		debugLogf("Making a new recorder '%s' failed, %v", name, err)
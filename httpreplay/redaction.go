@@ -0,0 +1,412 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package httpreplay
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// RedactionRulesEnvVar is the environment variable with the path of a YAML file of RedactionRule, applied in addition
+// to the DefaultRedactionRules
+const RedactionRulesEnvVar = "TF_VAR_REPLAY_REDACTION_RULES"
+
+// RedactionRule selects values to replace with placeholders in the recorded interactions. A rule sets one of Regex,
+// JSONPath or Header.
+type RedactionRule struct {
+	// Name of the rule, used in the default placeholder
+	Name string `yaml:"name"`
+
+	// Regex redacts its matches in the URLs, form values, header values and bodies. If it has a group, only the
+	// first group of the matches is redacted.
+	Regex string `yaml:"regex"`
+
+	// JSONPath redacts the string values of a JSON body at this path, e.g. $.adminPassword or $..items[*].token.
+	// `..` descends through any number of levels and the field names can use the * wildcard, they are matched
+	// case-insensitively.
+	JSONPath string `yaml:"jsonPath"`
+
+	// Header redacts the values of the request and response headers with this name
+	Header string `yaml:"header"`
+
+	// Placeholder is the format of the placeholders, with a %d verb for the number of the redacted value.
+	// It defaults to REDACTED-<name>-%d
+	Placeholder string `yaml:"placeholder"`
+
+	regex            *regexp.Regexp
+	jsonPath         []jsonPathSegment
+	placeholderRegex *regexp.Regexp
+}
+
+type jsonPathSegment struct {
+	name      string
+	recursive bool
+}
+
+// DefaultRedactionRules redacts the passwords, private keys, auth tokens, vault secret contents, authentication headers
+// and the OCIDs of the tenancy and of the users
+func DefaultRedactionRules() []RedactionRule {
+	return []RedactionRule{
+		{Name: "authorization", Header: "Authorization"},
+		{Name: "obo-token", Header: "opc-obo-token"},
+		{Name: "password", JSONPath: "$..*password"},
+		{Name: "passphrase", JSONPath: "$..*passphrase"},
+		{Name: "token", JSONPath: "$..*token"},
+		{Name: "secret-content", JSONPath: "$..secretContent.content"},
+		{Name: "private-key", Regex: `-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`},
+		{Name: "tenancy", Regex: `ocid1\.tenancy\.[a-z0-9-]+\.[a-z0-9-]*\.[a-z0-9]+`, Placeholder: "ocid1.tenancy.oc1..redacted%d"},
+		{Name: "user", Regex: `ocid1\.user\.[a-z0-9-]+\.[a-z0-9-]*\.[a-z0-9]+`, Placeholder: "ocid1.user.oc1..redacted%d"},
+	}
+}
+
+// Redactor replaces the values selected by its rules with placeholders. The same value always gets the same
+// placeholder. The values first redacted in a request are numbered in the order they are redacted, so that the
+// requests of a replay redacted by a new Redactor match the requests of the recording. The responses are not redacted
+// in replay, the values first redacted in a response are numbered after a hash of the value so that they do not shift
+// the numbers of the requests. The values are kept to restore them in the replayed responses.
+type Redactor struct {
+	rules []*RedactionRule
+
+	mu sync.Mutex
+	// placeholders of the redacted values by rule name
+	placeholders map[string]map[string]string
+	// values of the placeholders, as they appear in the bodies
+	values map[string]string
+	// number of the last placeholder of the values first redacted in a request, by rule name
+	requestNumbers map[string]int
+	// whether a response is being redacted
+	response bool
+}
+
+// NewRedactor compiles the rules of a Redactor
+func NewRedactor(rules ...RedactionRule) (*Redactor, error) {
+	redactor := &Redactor{
+		placeholders:   make(map[string]map[string]string),
+		values:         make(map[string]string),
+		requestNumbers: make(map[string]int),
+	}
+	for index := range rules {
+		rule := rules[index]
+		if err := rule.compile(); err != nil {
+			return nil, err
+		}
+		redactor.rules = append(redactor.rules, &rule)
+	}
+	return redactor, nil
+}
+
+// NewRedactorFromEnv returns a Redactor with the DefaultRedactionRules and the rules of the file in RedactionRulesEnvVar
+func NewRedactorFromEnv() (*Redactor, error) {
+	rules := DefaultRedactionRules()
+	if fileName := os.Getenv(RedactionRulesEnvVar); fileName != "" {
+		data, err := ioutil.ReadFile(fileName)
+		if err != nil {
+			return nil, err
+		}
+		var fileRules []RedactionRule
+		if err := yaml.Unmarshal(data, &fileRules); err != nil {
+			return nil, fmt.Errorf("invalid redaction rules in %s: %v", fileName, err)
+		}
+		rules = append(rules, fileRules...)
+	}
+	return NewRedactor(rules...)
+}
+
+func (rule *RedactionRule) compile() error {
+	set := 0
+	for _, value := range []string{rule.Regex, rule.JSONPath, rule.Header} {
+		if value != "" {
+			set++
+		}
+	}
+	if rule.Name == "" || set != 1 {
+		return fmt.Errorf("redaction rule '%s' must have a name and one of regex, jsonPath or header", rule.Name)
+	}
+	if rule.Placeholder == "" {
+		rule.Placeholder = "REDACTED-" + rule.Name + "-%d"
+	}
+	if strings.Count(rule.Placeholder, "%d") != 1 {
+		return fmt.Errorf("the placeholder of redaction rule '%s' must have a single %%d verb", rule.Name)
+	}
+	rule.placeholderRegex = regexp.MustCompile("^" + strings.Replace(regexp.QuoteMeta(rule.Placeholder), "%d", `\d+`, 1) + "$")
+
+	var err error
+	if rule.Regex != "" {
+		if rule.regex, err = regexp.Compile(rule.Regex); err != nil {
+			return fmt.Errorf("invalid regex of redaction rule '%s': %v", rule.Name, err)
+		}
+	}
+	if rule.JSONPath != "" {
+		if rule.jsonPath, err = parseJSONPath(rule.JSONPath); err != nil {
+			return fmt.Errorf("invalid jsonPath of redaction rule '%s': %v", rule.Name, err)
+		}
+	}
+	return nil
+}
+
+// parseJSONPath parses the subset of JSON paths made of fields, `..` and [*]
+func parseJSONPath(jsonPath string) ([]jsonPathSegment, error) {
+	if !strings.HasPrefix(jsonPath, "$") {
+		return nil, fmt.Errorf("%s does not start with $", jsonPath)
+	}
+	segments := make([]jsonPathSegment, 0)
+	rest := jsonPath[1:]
+	for rest != "" {
+		segment := jsonPathSegment{}
+		switch {
+		case strings.HasPrefix(rest, ".."):
+			segment.recursive = true
+			rest = rest[2:]
+		case strings.HasPrefix(rest, "."):
+			rest = rest[1:]
+		}
+		if strings.HasPrefix(rest, "[*]") {
+			segment.name = "*"
+			rest = rest[3:]
+		} else {
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			segment.name = strings.ToLower(rest[:end])
+			rest = rest[end:]
+		}
+		if segment.name == "" {
+			return nil, fmt.Errorf("%s has an empty field", jsonPath)
+		}
+		if _, err := path.Match(segment.name, ""); err != nil {
+			return nil, fmt.Errorf("%s has an invalid field %s", jsonPath, segment.name)
+		}
+		segments = append(segments, segment)
+	}
+	if len(segments) == 0 {
+		return nil, fmt.Errorf("%s has no field", jsonPath)
+	}
+	return segments, nil
+}
+
+// Transform redacts the request and the response of an interaction, it is set as the Transformer of the Recorder
+// when recording
+func (r *Redactor) Transform(req *Request, i Interaction, res *Response) {
+	r.RedactRequest(req)
+	r.RedactResponse(res)
+}
+
+// RedactRequest redacts the URL, form values, headers and body of a request
+func (r *Redactor) RedactRequest(req *Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	req.URL = r.redactText(req.URL)
+	for _, values := range req.Form {
+		for index := range values {
+			values[index] = r.redactText(values[index])
+		}
+	}
+	r.redactHeaders(req.Headers)
+	r.redactBody(&req.Body, &req.BodyParsed)
+}
+
+// RedactResponse redacts the headers and the body of a response
+func (r *Redactor) RedactResponse(res *Response) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.response = true
+	defer func() { r.response = false }()
+	r.redactHeaders(res.Headers)
+	r.redactBody(&res.Body, &res.BodyParsed)
+}
+
+// Restore replaces the placeholders of the values redacted by this Redactor with the values
+func (r *Redactor) Restore(text string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.values) == 0 {
+		return text
+	}
+	placeholders := make([]string, 0, len(r.values))
+	for placeholder := range r.values {
+		placeholders = append(placeholders, placeholder)
+	}
+	// Replace the longest placeholders first, so that REDACTED-name-1 does not replace the start of REDACTED-name-10
+	sort.Slice(placeholders, func(i, j int) bool {
+		if len(placeholders[i]) != len(placeholders[j]) {
+			return len(placeholders[i]) > len(placeholders[j])
+		}
+		return placeholders[i] < placeholders[j]
+	})
+	oldNew := make([]string, 0, 2*len(placeholders))
+	for _, placeholder := range placeholders {
+		oldNew = append(oldNew, placeholder, r.values[placeholder])
+	}
+	return strings.NewReplacer(oldNew...).Replace(text)
+}
+
+// RestoreHeaders returns a copy of headers with the placeholders replaced by the values
+func (r *Redactor) RestoreHeaders(headers http.Header) http.Header {
+	restored := headers.Clone()
+	for _, values := range restored {
+		for index := range values {
+			values[index] = r.Restore(values[index])
+		}
+	}
+	return restored
+}
+
+// placeholder returns the placeholder of a value redacted by a rule
+func (r *Redactor) placeholder(rule *RedactionRule, value string) string {
+	if value == "" || rule.placeholderRegex.MatchString(value) {
+		return value
+	}
+	placeholders, ok := r.placeholders[rule.Name]
+	if !ok {
+		placeholders = make(map[string]string)
+		r.placeholders[rule.Name] = placeholders
+	}
+	placeholder, ok := placeholders[value]
+	if ok {
+		return placeholder
+	}
+	number := r.requestNumbers[rule.Name] + 1
+	if r.response {
+		hash := fnv.New32a()
+		hash.Write([]byte(value))
+		number = int(hash.Sum32())
+	}
+	placeholder = fmt.Sprintf(rule.Placeholder, number)
+	for _, taken := r.values[placeholder]; taken; _, taken = r.values[placeholder] {
+		number++
+		placeholder = fmt.Sprintf(rule.Placeholder, number)
+	}
+	if !r.response {
+		r.requestNumbers[rule.Name] = number
+	}
+	placeholders[value] = placeholder
+	r.values[placeholder] = value
+	return placeholder
+}
+
+func (r *Redactor) redactText(text string) string {
+	for _, rule := range r.rules {
+		if rule.regex == nil {
+			continue
+		}
+		text = rule.regex.ReplaceAllStringFunc(text, func(match string) string {
+			submatches := rule.regex.FindStringSubmatchIndex(match)
+			if len(submatches) < 4 || submatches[2] < 0 {
+				return r.placeholder(rule, match)
+			}
+			return match[:submatches[2]] + r.placeholder(rule, match[submatches[2]:submatches[3]]) + match[submatches[3]:]
+		})
+	}
+	return text
+}
+
+func (r *Redactor) redactHeaders(headers http.Header) {
+	for name, values := range headers {
+		for index := range values {
+			for _, rule := range r.rules {
+				if rule.Header != "" && strings.EqualFold(rule.Header, name) {
+					values[index] = r.placeholder(rule, values[index])
+				}
+			}
+			values[index] = r.redactText(values[index])
+		}
+	}
+}
+
+// redactBody redacts the JSON paths in a JSON body then the regexes in the text of the body. bodyParsed is parsed
+// again from the redacted body if it was parsed.
+func (r *Redactor) redactBody(body *string, bodyParsed *interface{}) {
+	if *body == "" {
+		return
+	}
+	if parsed, err := unmarshal([]byte(*body)); err == nil && parsed != nil {
+		changed := false
+		for _, rule := range r.rules {
+			if rule.jsonPath != nil {
+				changed = r.redactJSONPath(rule, parsed, rule.jsonPath) || changed
+			}
+		}
+		if changed {
+			if data, err := json.Marshal(parsed); err == nil {
+				*body = string(data)
+			}
+		}
+	}
+	*body = r.redactText(*body)
+	if *bodyParsed != nil {
+		*bodyParsed, _ = unmarshal([]byte(*body))
+	}
+}
+
+// redactJSONPath redacts the string values of node at the path of segments, returns whether a value was redacted
+func (r *Redactor) redactJSONPath(rule *RedactionRule, node interface{}, segments []jsonPathSegment) bool {
+	segment := segments[0]
+	changed := false
+	visit := func(name string, child interface{}, set func(interface{})) {
+		if matched, _ := path.Match(segment.name, strings.ToLower(name)); matched {
+			if len(segments) > 1 {
+				changed = r.redactJSONPath(rule, child, segments[1:]) || changed
+			} else if value, ok := child.(string); ok && value != "" {
+				// The values are kept as they appear in the JSON text of the bodies, to be restored in it
+				data, _ := json.Marshal(value)
+				if placeholder := r.placeholder(rule, string(data[1:len(data)-1])); placeholder != value {
+					set(placeholder)
+					changed = true
+				}
+			}
+		}
+		if segment.recursive {
+			changed = r.redactJSONPath(rule, child, segments) || changed
+		}
+	}
+
+	switch typed := node.(type) {
+	case jsonObj:
+		return r.redactJSONPath(rule, map[string]interface{}(typed), segments)
+	case map[string]interface{}:
+		for name, child := range typed {
+			name := name
+			visit(name, child, func(value interface{}) { typed[name] = value })
+		}
+	case jsonArr:
+		for _, child := range typed {
+			changed = r.redactJSONPath(rule, child, segments) || changed
+		}
+	case []interface{}:
+		for index, child := range typed {
+			index := index
+			if segment.name == "*" {
+				visit("*", child, func(value interface{}) { typed[index] = value })
+			} else {
+				// The fields of the objects of an array are matched without [*], as in $..items.token
+				changed = r.redactJSONPath(rule, child, segments) || changed
+			}
+		}
+	}
+	return changed
+}
+
+func cloneValues(values url.Values) url.Values {
+	if values == nil {
+		return nil
+	}
+	cloned := make(url.Values, len(values))
+	for key, items := range values {
+		cloned[key] = append([]string(nil), items...)
+	}
+	return cloned
+}
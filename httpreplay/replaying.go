@@ -18,12 +18,18 @@ var recorder *Recorder
 // SetScenario creates a new recorder for this scenario
 func SetScenario(name string) error {
 	var err error
+	var redactor *Redactor
+	if redactor, err = NewRedactorFromEnv(); err != nil {
+		return err
+	}
 	if recorder, err = NewRecorderAsMode(name, ModeReplaying); err == nil {
 		// cleanup existing files in /tmp folder
 		RemoveContents("/tmp")
 		// cleanup existing
 		recorder.SetMatcher(matcher)
+		recorder.scenario.SetMatchMode(MatchModeFromEnv())
 		recorder.SetTransformer(recorder.scenario.transformer)
+		recorder.SetRedactor(redactor)
 	}
 	return err
 }
@@ -133,6 +133,15 @@ type Scenario struct {
 
 	// Fields keeps track between old values(in recorded yaml file) and new values(in replay request)
 	Fields map[string]string
+
+	// Redacted is set when the interactions were redacted when recorded
+	Redacted bool `yaml:"redacted,omitempty"`
+
+	// MatchMode selects how requests are matched to the interactions
+	MatchMode MatchMode `yaml:"-"`
+	// Interactions by request key, and the interactions already matched by a request, in MatchModeDeterministic
+	keyedInteractions map[requestKey][]int `yaml:"-"`
+	usedInteractions  map[int]bool         `yaml:"-"`
 }
 
 // Implementations of sort.Interface to give us different orderings.
@@ -238,6 +247,9 @@ func (s *Scenario) GetInteractionWithFullPath(r Request) (*Interaction, error) {
 func (s *Scenario) GetInteraction(r Request) (*Interaction, error) {
 	s.Mu.Lock()
 	defer s.Mu.Unlock()
+	if s.MatchMode == MatchModeDeterministic {
+		return s.getInteractionByKey(r)
+	}
 	sort.Stable(byUsage(s.sortedInteractions))
 	if r.Body != "" {
 		return s.GetInteractionWithBody(r)
@@ -412,12 +424,21 @@ func (s *Scenario) GetInteractionWithBodyFromList(r Request, list []*Interaction
 	return iMax, nil
 }
 
+// SetMatchMode selects how requests are matched to the interactions of the scenario
+func (s *Scenario) SetMatchMode(mode MatchMode) {
+	s.Mu.Lock()
+	defer s.Mu.Unlock()
+	s.MatchMode = mode
+}
+
 // Reset returns us to the beginning of the scenario
 func (s *Scenario) Reset() {
 	for index := range s.Interactions {
 		s.Interactions[index].Uses = 0
 		s.sortedInteractions[index].Uses = 0
 	}
+	s.usedInteractions = nil
+	s.keyedInteractions = nil
 	sort.Stable(byIndex(s.Interactions))
 	sort.Stable(byIndex(s.sortedInteractions))
 }
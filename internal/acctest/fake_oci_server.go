@@ -0,0 +1,470 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
+	oci_work_requests "github.com/oracle/oci-go-sdk/v65/workrequests"
+
+	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+/*
+FakeOciServer is an in-process fake of the OCI control plane for a core set of services: the Identity compartments, the
+Core VCNs, subnets and security lists, the Object Storage buckets and objects and the KMS vaults and keys. The resources
+are kept in memory keyed by OCID. They go through the transitional lifecycle states of the services, e.g. PROVISIONING,
+for TransitionReads reads before reaching their target state, and the asynchronous operations return work requests.
+
+The provider targets the server through the CLIENT_HOST_OVERRIDES and custom_cert_location settings, see
+UseFakeOciServer. The requests are not authenticated.
+*/
+type FakeOciServer struct {
+	URL                    string
+	Region                 string
+	TenancyId              string
+	CompartmentId          string // compartment for the tests, under the tenancy
+	CompartmentIdForUpdate string // another compartment for the tests, to move resources to
+	Namespace              string // Object Storage namespace of the tenancy
+
+	// TransitionReads is the number of reads for which a resource stays in a transitional lifecycle state
+	TransitionReads int
+
+	server       *httptest.Server
+	vaultServers map[string]*httptest.Server // KMS management endpoints, by vault OCID
+	routes       []fakeOciRoute
+	lock         sync.Mutex
+	resources    map[string]*fakeOciResource          // by OCID
+	objects      map[string]map[string]*fakeOciObject // by bucket OCID and object name
+	count        int
+}
+
+// fakeOciResourceKind describes the OCIDs and the lifecycle of a type of resource
+type fakeOciResourceKind struct {
+	ocidType       string // type of resource in its OCIDs, e.g. vcn
+	stateField     string // attribute holding the lifecycle state, if the resources have one
+	creatingState  string
+	activeState    string
+	deletingState  string
+	deletedState   string
+	dependentField string // attribute of the resources which prevent deleting a resource of this kind, if any
+}
+
+type fakeOciResource struct {
+	kind         *fakeOciResourceKind
+	fields       map[string]interface{}
+	etag         string
+	owner        string // OCID of the resource this one was created and is deleted with, if any
+	targetState  string // lifecycle state reached after pendingReads reads
+	pendingReads int
+}
+
+type fakeOciObject struct {
+	content      []byte
+	header       http.Header // content headers and opc-meta-* metadata
+	etag         string
+	md5          string
+	timeCreated  time.Time
+	timeModified time.Time
+}
+
+type fakeOciError struct {
+	status  int
+	code    string
+	message string
+}
+
+// fakeOciRequest is a request matching a route, with the values of the parameters in the path of the route
+type fakeOciRequest struct {
+	*http.Request
+	params  []string
+	vaultId string // vault of the management endpoint the request was sent to, if any
+}
+
+type fakeOciRoute struct {
+	method  string
+	path    *regexp.Regexp
+	handler func(w http.ResponseWriter, r *fakeOciRequest)
+}
+
+var fakeOciWorkRequestKind = &fakeOciResourceKind{
+	ocidType:      "workrequest",
+	stateField:    "status",
+	creatingState: string(oci_work_requests.WorkRequestStatusInProgress),
+	activeState:   string(oci_work_requests.WorkRequestStatusSucceeded),
+}
+
+// NewFakeOciServer starts a FakeOciServer with a tenancy and the compartments for the tests, Close stops it
+func NewFakeOciServer() *FakeOciServer {
+	s := &FakeOciServer{
+		Region:          "us-phoenix-1",
+		TenancyId:       "ocid1.tenancy.oc1..faketenancy",
+		Namespace:       "fakenamespace",
+		TransitionReads: 1,
+		vaultServers:    map[string]*httptest.Server{},
+		resources:       map[string]*fakeOciResource{},
+		objects:         map[string]map[string]*fakeOciObject{},
+	}
+	s.routes = getFakeOciRoutes(s)
+	s.server = httptest.NewTLSServer(s.handler(""))
+	s.URL = s.server.URL
+
+	s.store(&fakeOciResource{kind: fakeOciCompartmentKind, fields: map[string]interface{}{
+		"id":             s.TenancyId,
+		"compartmentId":  s.TenancyId,
+		"name":           "tenancy",
+		"description":    "tenancy",
+		"lifecycleState": string(oci_identity.CompartmentLifecycleStateActive),
+		"isAccessible":   true,
+		"timeCreated":    getFakeOciTime(time.Now()),
+	}})
+	s.CompartmentId = s.createCompartment(s.TenancyId, "compartment_for_tests")
+	s.CompartmentIdForUpdate = s.createCompartment(s.TenancyId, "compartment_for_update_tests")
+	return s
+}
+
+// Close stops the server and the KMS management endpoints of its vaults
+func (s *FakeOciServer) Close() {
+	s.server.Close()
+	for _, vaultServer := range s.vaultServers {
+		vaultServer.Close()
+	}
+}
+
+// Certificate returns the PEM encoded certificate of the server, for the custom_cert_location setting
+func (s *FakeOciServer) Certificate() []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: s.server.Certificate().Raw})
+}
+
+// ClientHostOverrides returns the CLIENT_HOST_OVERRIDES setting pointing the clients of the services of the server to it
+func (s *FakeOciServer) ClientHostOverrides() string {
+	clients := []string{
+		"oci_identity.IdentityClient",
+		"oci_core.VirtualNetworkClient",
+		"oci_object_storage.ObjectStorageClient",
+		"oci_kms.KmsVaultClient",
+		tf_client.WorkRequestClientName,
+	}
+	overrides := make([]string, len(clients))
+	for i, client := range clients {
+		overrides[i] = client + globalvar.EqualToOperatorDelimiter + s.URL
+	}
+	return strings.Join(overrides, globalvar.ColonDelimiter)
+}
+
+/*
+UseFakeOciServer starts a FakeOciServer for the duration of a test and points the provider to it, so that ResourceTest
+runs the steps of the test without credentials nor network. It sets the environment settings of the clients and the
+settings of the acceptance tests: the tenancy, the region, the compartments and a generated API key.
+*/
+func UseFakeOciServer(t *testing.T) *FakeOciServer {
+	t.Helper()
+	s := NewFakeOciServer()
+	t.Cleanup(s.Close)
+
+	certFile := filepath.Join(t.TempDir(), "fake_oci_server.pem")
+	if err := ioutil.WriteFile(certFile, s.Certificate(), 0600); err != nil {
+		t.Fatalf("unable to write the certificate of the fake OCI server: %v", err)
+	}
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("unable to generate an API key for the fake OCI server: %v", err)
+	}
+
+	settings := map[string]string{
+		globalvar.ClientHostOverridesEnv: s.ClientHostOverrides(),
+		globalvar.CustomCertLocationEnv:  certFile,
+		"auth":                           globalvar.AuthAPIKeySetting,
+		"tenancy_ocid":                   s.TenancyId,
+		"user_ocid":                      testUserOCID,
+		"fingerprint":                    testKeyFingerPrint,
+		"private_key":                    string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})),
+		"region":                         s.Region,
+		"compartment_ocid":               s.CompartmentId,
+		"compartment_id_for_create":      s.CompartmentId,
+		"compartment_id_for_update":      s.CompartmentIdForUpdate,
+		"tags_import_if_exists":          "false",
+	}
+	for name, value := range settings {
+		t.Setenv(globalvar.TfEnvPrefix+name, value)
+	}
+	return s
+}
+
+func (s *FakeOciServer) handler(vaultId string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimSuffix(r.URL.EscapedPath(), "/")
+		for _, route := range s.routes {
+			matches := route.path.FindStringSubmatch(path)
+			if route.method != r.Method || matches == nil {
+				continue
+			}
+			params := make([]string, len(matches)-1)
+			for i, match := range matches[1:] {
+				params[i], _ = url.PathUnescape(match)
+			}
+
+			s.lock.Lock()
+			defer s.lock.Unlock()
+			route.handler(w, &fakeOciRequest{Request: r, params: params, vaultId: vaultId})
+			return
+		}
+		writeFakeOciError(w, &fakeOciError{http.StatusNotFound, "NotAuthorizedOrNotFound", fmt.Sprintf("%s %s is not supported by the fake OCI server", r.Method, path)})
+	})
+}
+
+// newFakeOciRoute returns a route for a path where {} matches a parameter, e.g. /20160918/vcns/{}, and {*} matches the
+// rest of the path, e.g. the name of an object
+func newFakeOciRoute(method string, path string, handler func(w http.ResponseWriter, r *fakeOciRequest)) fakeOciRoute {
+	pattern := strings.Replace(regexp.QuoteMeta(path), `\{\}`, `([^/]+)`, -1)
+	pattern = strings.Replace(pattern, `\{\*\}`, `(.+)`, -1)
+	return fakeOciRoute{method: method, path: regexp.MustCompile("^" + pattern + "$"), handler: handler}
+}
+
+func (s *FakeOciServer) newOcid(kind *fakeOciResourceKind) string {
+	s.count++
+	if kind == fakeOciCompartmentKind {
+		return fmt.Sprintf("ocid1.%s.oc1..fake%06d", kind.ocidType, s.count)
+	}
+	return fmt.Sprintf("ocid1.%s.oc1.%s.fake%06d", kind.ocidType, s.Region, s.count)
+}
+
+func (s *FakeOciServer) newEtag() string {
+	s.count++
+	return fmt.Sprintf("fake-etag-%06d", s.count)
+}
+
+func (s *FakeOciServer) store(resource *fakeOciResource) {
+	resource.etag = s.newEtag()
+	s.resources[resource.fields["id"].(string)] = resource
+}
+
+// newResource returns a resource with a new OCID in the creating state of its kind, which is not stored yet
+func (s *FakeOciServer) newResource(kind *fakeOciResourceKind, fields map[string]interface{}) *fakeOciResource {
+	resource := &fakeOciResource{kind: kind, fields: fields}
+	fields["id"] = s.newOcid(kind)
+	fields["timeCreated"] = getFakeOciTime(time.Now())
+	if kind.stateField != "" {
+		s.setState(resource, kind.creatingState, kind.activeState)
+	}
+	return resource
+}
+
+// setState sets the lifecycle state of a resource to a transitional state, which becomes target after TransitionReads reads
+func (s *FakeOciServer) setState(resource *fakeOciResource, transitional string, target string) {
+	if transitional == "" || s.TransitionReads <= 0 {
+		resource.fields[resource.kind.stateField] = target
+		resource.targetState = ""
+		return
+	}
+	resource.fields[resource.kind.stateField] = transitional
+	resource.targetState = target
+	resource.pendingReads = s.TransitionReads
+}
+
+// read returns a resource of a kind by OCID, after moving it along its lifecycle
+func (s *FakeOciServer) read(id string, kind *fakeOciResourceKind) (*fakeOciResource, *fakeOciError) {
+	resource, exists := s.resources[id]
+	if !exists || resource.kind != kind {
+		return nil, getFakeOciNotFoundError(id)
+	}
+	if resource.targetState != "" {
+		if resource.pendingReads > 0 {
+			resource.pendingReads--
+		} else {
+			resource.fields[kind.stateField] = resource.targetState
+			resource.targetState = ""
+		}
+	}
+	return resource, nil
+}
+
+func (s *FakeOciServer) isDeleted(resource *fakeOciResource) bool {
+	kind := resource.kind
+	if kind.stateField == "" || kind.deletedState == "" {
+		return false
+	}
+	state := resource.fields[kind.stateField]
+	return state == kind.deletingState || state == kind.deletedState
+}
+
+// checkActive returns an error if a resource is not found or is being deleted, e.g. the compartment of a new resource
+func (s *FakeOciServer) checkActive(id interface{}, kind *fakeOciResourceKind) (*fakeOciResource, *fakeOciError) {
+	idStr, _ := id.(string)
+	resource, err := s.read(idStr, kind)
+	if err != nil {
+		return nil, err
+	}
+	if s.isDeleted(resource) {
+		return nil, getFakeOciNotFoundError(idStr)
+	}
+	return resource, nil
+}
+
+// hasDependents returns whether resources other than the resources it owns and work requests reference a resource and are not deleted
+func (s *FakeOciServer) hasDependents(resource *fakeOciResource) bool {
+	id := resource.fields["id"]
+	for _, dependent := range s.resources {
+		if dependent.kind == fakeOciWorkRequestKind || dependent.owner == id || dependent.fields["id"] == id {
+			continue
+		}
+		if dependent.fields[resource.kind.dependentField] == id && !s.isDeleted(dependent) {
+			return true
+		}
+	}
+	return false
+}
+
+// list returns the resources of a kind matching the compartmentId, vcnId, displayName, name and lifecycleState query parameters
+func (s *FakeOciServer) list(r *fakeOciRequest, kind *fakeOciResourceKind) []map[string]interface{} {
+	query := r.URL.Query()
+	filters := map[string]string{}
+	for _, field := range []string{"compartmentId", "vcnId", "displayName", "name", "lifecycleState"} {
+		if value := query.Get(field); value != "" {
+			filters[field] = value
+		}
+	}
+	if state, ok := filters["lifecycleState"]; ok && kind.stateField != "" {
+		delete(filters, "lifecycleState")
+		filters[kind.stateField] = state
+	}
+
+	ids := make([]string, 0)
+	for id, resource := range s.resources {
+		if resource.kind == kind {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	items := make([]map[string]interface{}, 0, len(ids))
+	for _, id := range ids {
+		resource, _ := s.read(id, kind)
+		matches := true
+		for field, value := range filters {
+			if fmt.Sprintf("%v", resource.fields[field]) != value {
+				matches = false
+			}
+		}
+		if matches {
+			items = append(items, resource.fields)
+		}
+	}
+	return items
+}
+
+// newWorkRequest returns the OCID of a new work request tracking an operation on a resource
+func (s *FakeOciServer) newWorkRequest(operationType string, actionType string, resource *fakeOciResource) string {
+	workRequest := s.newResource(fakeOciWorkRequestKind, map[string]interface{}{
+		"operationType":   operationType,
+		"compartmentId":   resource.fields["compartmentId"],
+		"percentComplete": 0,
+		"resources": []interface{}{map[string]interface{}{
+			"entityType": resource.kind.ocidType,
+			"actionType": actionType,
+			"identifier": resource.fields["id"],
+		}},
+	})
+	workRequest.fields["timeAccepted"] = workRequest.fields["timeCreated"]
+	workRequest.fields["timeStarted"] = workRequest.fields["timeCreated"]
+	s.store(workRequest)
+	return workRequest.fields["id"].(string)
+}
+
+func (s *FakeOciServer) getWorkRequest(w http.ResponseWriter, r *fakeOciRequest) {
+	workRequest, err := s.read(r.params[0], fakeOciWorkRequestKind)
+	if err != nil {
+		writeFakeOciError(w, err)
+		return
+	}
+	if workRequest.fields["status"] == fakeOciWorkRequestKind.activeState {
+		workRequest.fields["percentComplete"] = 100
+		if _, ok := workRequest.fields["timeFinished"]; !ok {
+			workRequest.fields["timeFinished"] = getFakeOciTime(time.Now())
+		}
+	}
+	writeFakeOciResource(w, workRequest)
+}
+
+func (s *FakeOciServer) listWorkRequests(w http.ResponseWriter, r *fakeOciRequest) {
+	writeFakeOciJson(w, http.StatusOK, s.list(r, fakeOciWorkRequestKind))
+}
+
+// addVaultServer starts the KMS management endpoint of a vault, to which the requests on its keys are sent
+func (s *FakeOciServer) addVaultServer(vaultId string) string {
+	vaultServer := httptest.NewUnstartedServer(s.handler(vaultId))
+	vaultServer.TLS = &tls.Config{Certificates: s.server.TLS.Certificates}
+	vaultServer.StartTLS()
+	s.vaultServers[vaultId] = vaultServer
+	return vaultServer.URL
+}
+
+func decodeFakeOciBody(r *fakeOciRequest) (map[string]interface{}, *fakeOciError) {
+	body := map[string]interface{}{}
+	if r.Body == nil || r.ContentLength == 0 {
+		return body, nil
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, &fakeOciError{http.StatusBadRequest, "InvalidParameter", fmt.Sprintf("invalid request body: %v", err)}
+	}
+	for field, value := range body {
+		if value == nil {
+			delete(body, field)
+		}
+	}
+	return body, nil
+}
+
+func checkFakeOciRequiredFields(body map[string]interface{}, fields ...string) *fakeOciError {
+	for _, field := range fields {
+		if value, ok := body[field]; !ok || value == "" {
+			return &fakeOciError{http.StatusBadRequest, "MissingParameter", fmt.Sprintf("%s is required", field)}
+		}
+	}
+	return nil
+}
+
+func getFakeOciNotFoundError(id string) *fakeOciError {
+	return &fakeOciError{http.StatusNotFound, "NotAuthorizedOrNotFound", fmt.Sprintf("Authorization failed or requested resource %s not found", id)}
+}
+
+func getFakeOciTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func writeFakeOciJson(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("opc-request-id", fmt.Sprintf("fake-request-%d", time.Now().UnixNano()))
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		panic(err)
+	}
+}
+
+func writeFakeOciResource(w http.ResponseWriter, resource *fakeOciResource) {
+	w.Header().Set("etag", resource.etag)
+	writeFakeOciJson(w, http.StatusOK, resource.fields)
+}
+
+func writeFakeOciError(w http.ResponseWriter, err *fakeOciError) {
+	writeFakeOciJson(w, err.status, map[string]string{"code": err.code, "message": err.message})
+}
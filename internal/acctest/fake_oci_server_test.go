@@ -0,0 +1,260 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oci_core "github.com/oracle/oci-go-sdk/v65/core"
+	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
+	oci_work_requests "github.com/oracle/oci-go-sdk/v65/workrequests"
+	"github.com/stretchr/testify/assert"
+
+	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
+	tf_provider "github.com/oracle/terraform-provider-oci/internal/provider"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+// getFakeOciServerClients starts a fake OCI server for a test and returns the clients of the provider configured for it
+func getFakeOciServerClients(t *testing.T) (*FakeOciServer, *tf_client.OracleClients) {
+	server := UseFakeOciServer(t)
+	getEnvSettingWithBlankDefault, getEnvSettingWithDefault, providerConfig := getEnvSettingWithBlankDefaultVar, getEnvSettingWithDefaultVar, tfProviderConfigVar
+	t.Cleanup(func() {
+		getEnvSettingWithBlankDefaultVar, getEnvSettingWithDefaultVar, tfProviderConfigVar = getEnvSettingWithBlankDefault, getEnvSettingWithDefault, providerConfig
+	})
+	getEnvSettingWithBlankDefaultVar = utils.GetEnvSettingWithBlankDefault
+	getEnvSettingWithDefaultVar = utils.GetEnvSettingWithDefault
+	tfProviderConfigVar = tf_provider.ProviderConfig
+	return server, GetTestClients(nil)
+}
+
+// runFakeOciResourceOperation runs the create, read, update or delete function of a resource of the provider
+func runFakeOciResourceOperation(clients *tf_client.OracleClients, resourceType string, operation string, d *schema.ResourceData) error {
+	r := TestAccProvider.ResourcesMap[resourceType]
+	ctx := context.Background()
+	switch operation {
+	case "create":
+		return diagnosticsError(r.CreateContext(ctx, d, clients))
+	case "read":
+		return diagnosticsError(r.ReadContext(ctx, d, clients))
+	case "update":
+		return diagnosticsError(r.UpdateContext(ctx, d, clients))
+	case "delete":
+		return diagnosticsError(r.DeleteContext(ctx, d, clients))
+	}
+	return nil
+}
+
+func diagnosticsError(diags interface{ HasError() bool }) error {
+	if diags.HasError() {
+		return fmt.Errorf("%v", diags)
+	}
+	return nil
+}
+
+func createFakeOciResource(t *testing.T, clients *tf_client.OracleClients, resourceType string, config map[string]interface{}) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, TestAccProvider.ResourcesMap[resourceType].Schema, config)
+	if err := runFakeOciResourceOperation(clients, resourceType, "create", d); err != nil {
+		t.Fatalf("unable to create %s: %v", resourceType, err)
+	}
+	return d
+}
+
+// importFakeOciResource reads a resource from its id only, as terraform import does
+func importFakeOciResource(t *testing.T, clients *tf_client.OracleClients, resourceType string, id string) *schema.ResourceData {
+	d := TestAccProvider.ResourcesMap[resourceType].TestResourceData()
+	d.SetId(id)
+	if err := runFakeOciResourceOperation(clients, resourceType, "read", d); err != nil {
+		t.Fatalf("unable to import %s %s: %v", resourceType, id, err)
+	}
+	return d
+}
+
+// Test that the Core resources go through their lifecycle and can not be deleted while other resources depend on them
+func TestUnitFakeOciServer_core(t *testing.T) {
+	server, clients := getFakeOciServerClients(t)
+
+	vcn := createFakeOciResource(t, clients, "oci_core_vcn", map[string]interface{}{
+		"compartment_id": server.CompartmentId,
+		"cidr_block":     "10.0.0.0/16",
+		"display_name":   "vcn",
+		"dns_label":      "vcn",
+	})
+	assert.Equal(t, "AVAILABLE", vcn.Get("state"))
+	assert.Equal(t, "vcn.oraclevcn.com", vcn.Get("vcn_domain_name"))
+	assert.NotEmpty(t, vcn.Get("default_security_list_id"))
+
+	subnet := createFakeOciResource(t, clients, "oci_core_subnet", map[string]interface{}{
+		"compartment_id": server.CompartmentId,
+		"vcn_id":         vcn.Id(),
+		"cidr_block":     "10.0.1.0/24",
+		"dns_label":      "subnet",
+	})
+	assert.Equal(t, "AVAILABLE", subnet.Get("state"))
+	assert.Equal(t, "10.0.1.1", subnet.Get("virtual_router_ip"))
+	assert.Equal(t, "subnet.vcn.oraclevcn.com", subnet.Get("subnet_domain_name"))
+	assert.Equal(t, []interface{}{vcn.Get("default_security_list_id")}, subnet.Get("security_list_ids").(*schema.Set).List())
+
+	// Update the display name, and move the VCN to the other compartment with a work request
+	vcnId, compartmentId := vcn.Id(), server.CompartmentIdForUpdate
+	update := TestAccProvider.ResourcesMap["oci_core_vcn"].Data(vcn.State())
+	update.Set("display_name", "vcn2")
+	assert.NoError(t, runFakeOciResourceOperation(clients, "oci_core_vcn", "update", update))
+	response, err := clients.VirtualNetworkClient().ChangeVcnCompartment(context.Background(), oci_core.ChangeVcnCompartmentRequest{
+		VcnId:                       &vcnId,
+		ChangeVcnCompartmentDetails: oci_core.ChangeVcnCompartmentDetails{CompartmentId: &compartmentId},
+	})
+	if assert.NoError(t, err) && assert.NotNil(t, response.OpcWorkRequestId) {
+		workRequest, err := clients.WorkRequestClient.GetWorkRequest(context.Background(), oci_work_requests.GetWorkRequestRequest{WorkRequestId: response.OpcWorkRequestId})
+		assert.NoError(t, err)
+		assert.Equal(t, vcnId, *workRequest.Resources[0].Identifier)
+	}
+	imported := importFakeOciResource(t, clients, "oci_core_vcn", vcnId)
+	assert.Equal(t, "vcn2", imported.Get("display_name"))
+	assert.Equal(t, server.CompartmentIdForUpdate, imported.Get("compartment_id"))
+	assert.Equal(t, "10.0.0.0/16", imported.Get("cidr_block"))
+
+	_, err = clients.VirtualNetworkClient().DeleteVcn(context.Background(), oci_core.DeleteVcnRequest{VcnId: &vcnId})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "Conflict")
+	}
+	assert.NoError(t, runFakeOciResourceOperation(clients, "oci_core_subnet", "delete", subnet))
+	assert.NoError(t, runFakeOciResourceOperation(clients, "oci_core_vcn", "delete", vcn))
+
+	// A terminated resource is removed from the state
+	deleted := importFakeOciResource(t, clients, "oci_core_vcn", vcnId)
+	assert.Empty(t, deleted.Id())
+}
+
+// Test that the deletion of a compartment returns a work request which succeeds, and that the name of a compartment is unique
+func TestUnitFakeOciServer_identity(t *testing.T) {
+	server, clients := getFakeOciServerClients(t)
+
+	config := map[string]interface{}{
+		"compartment_id": server.CompartmentId,
+		"name":           "child",
+		"description":    "child compartment",
+		"enable_delete":  true,
+	}
+	compartment := createFakeOciResource(t, clients, "oci_identity_compartment", config)
+	assert.Equal(t, "ACTIVE", compartment.Get("state"))
+
+	duplicate := schema.TestResourceDataRaw(t, TestAccProvider.ResourcesMap["oci_identity_compartment"].Schema, config)
+	err := runFakeOciResourceOperation(clients, "oci_identity_compartment", "create", duplicate)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "already exists")
+	}
+
+	id := compartment.Id()
+	response, err := clients.IdentityClient().DeleteCompartment(context.Background(), oci_identity.DeleteCompartmentRequest{CompartmentId: &id})
+	if !assert.NoError(t, err) || !assert.NotNil(t, response.OpcWorkRequestId) {
+		return
+	}
+	var statuses []oci_identity.WorkRequestStatusEnum
+	for i := 0; i < 2; i++ {
+		workRequest, err := clients.IdentityClient().GetWorkRequest(context.Background(), oci_identity.GetWorkRequestRequest{WorkRequestId: response.OpcWorkRequestId})
+		if !assert.NoError(t, err) {
+			return
+		}
+		statuses = append(statuses, workRequest.Status)
+		assert.Equal(t, id, *workRequest.Resources[0].Identifier)
+	}
+	assert.Equal(t, []oci_identity.WorkRequestStatusEnum{oci_identity.WorkRequestStatusInProgress, oci_identity.WorkRequestStatusSucceeded}, statuses)
+
+	parentId := server.CompartmentId
+	_, err = clients.IdentityClient().DeleteCompartment(context.Background(), oci_identity.DeleteCompartmentRequest{CompartmentId: &parentId})
+	assert.NoError(t, err, "the deleted compartment should not prevent deleting its parent")
+}
+
+// Test that the objects of a bucket are stored with their content, and that a bucket can not be deleted with objects
+func TestUnitFakeOciServer_objectStorage(t *testing.T) {
+	server, clients := getFakeOciServerClients(t)
+
+	bucket := createFakeOciResource(t, clients, "oci_objectstorage_bucket", map[string]interface{}{
+		"compartment_id": server.CompartmentId,
+		"name":           "bucket",
+		"namespace":      server.Namespace,
+	})
+	assert.Equal(t, fmt.Sprintf("n/%s/b/bucket", server.Namespace), bucket.Id())
+	assert.Equal(t, "NoPublicAccess", bucket.Get("access_type"))
+
+	object := createFakeOciResource(t, clients, "oci_objectstorage_object", map[string]interface{}{
+		"namespace":    server.Namespace,
+		"bucket":       "bucket",
+		"object":       "dir/object.txt",
+		"content":      "content",
+		"content_type": "text/plain",
+	})
+	imported := importFakeOciResource(t, clients, "oci_objectstorage_object", object.Id())
+	assert.Equal(t, "dir/object.txt", imported.Get("object"))
+	assert.Equal(t, "text/plain", imported.Get("content_type"))
+	assert.Equal(t, "7", imported.Get("content_length"))
+
+	err := runFakeOciResourceOperation(clients, "oci_objectstorage_bucket", "delete", bucket)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "BucketNotEmpty")
+	}
+	assert.NoError(t, runFakeOciResourceOperation(clients, "oci_objectstorage_object", "delete", object))
+	assert.NoError(t, runFakeOciResourceOperation(clients, "oci_objectstorage_bucket", "delete", bucket))
+}
+
+// Test that the keys of a vault are managed through its own management endpoint
+func TestUnitFakeOciServer_kms(t *testing.T) {
+	server, clients := getFakeOciServerClients(t)
+
+	vault := createFakeOciResource(t, clients, "oci_kms_vault", map[string]interface{}{
+		"compartment_id": server.CompartmentId,
+		"display_name":   "vault",
+		"vault_type":     "DEFAULT",
+	})
+	assert.Equal(t, "ACTIVE", vault.Get("state"))
+	assert.NotEqual(t, server.URL, vault.Get("management_endpoint"))
+
+	key := createFakeOciResource(t, clients, "oci_kms_key", map[string]interface{}{
+		"compartment_id":      server.CompartmentId,
+		"display_name":        "key",
+		"management_endpoint": vault.Get("management_endpoint"),
+		"key_shape":           []interface{}{map[string]interface{}{"algorithm": "AES", "length": 32}},
+	})
+	assert.Equal(t, "ENABLED", key.Get("state"))
+	assert.Equal(t, vault.Id(), key.Get("vault_id"))
+
+	vaultId := vault.Id()
+	assert.NoError(t, runFakeOciResourceOperation(clients, "oci_kms_key", "delete", key))
+	assert.NoError(t, runFakeOciResourceOperation(clients, "oci_kms_vault", "delete", vault))
+	deleted := importFakeOciResource(t, clients, "oci_kms_vault", vaultId)
+	assert.Equal(t, "PENDING_DELETION", deleted.Get("state"))
+}
+
+// Test that a resource stays in its transitional state for TransitionReads reads
+func TestUnitFakeOciServer_transitionReads(t *testing.T) {
+	server, clients := getFakeOciServerClients(t)
+	server.TransitionReads = 3
+
+	compartmentId, name, description := server.CompartmentId, "child", "child compartment"
+	response, err := clients.IdentityClient().CreateCompartment(context.Background(), oci_identity.CreateCompartmentRequest{
+		CreateCompartmentDetails: oci_identity.CreateCompartmentDetails{CompartmentId: &compartmentId, Name: &name, Description: &description},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, oci_identity.CompartmentLifecycleStateCreating, response.LifecycleState)
+	var states []oci_identity.CompartmentLifecycleStateEnum
+	for i := 0; i < 4; i++ {
+		compartment, err := clients.IdentityClient().GetCompartment(context.Background(), oci_identity.GetCompartmentRequest{CompartmentId: response.Id})
+		if !assert.NoError(t, err) {
+			return
+		}
+		states = append(states, compartment.LifecycleState)
+	}
+	assert.Equal(t, []oci_identity.CompartmentLifecycleStateEnum{
+		oci_identity.CompartmentLifecycleStateCreating,
+		oci_identity.CompartmentLifecycleStateCreating,
+		oci_identity.CompartmentLifecycleStateCreating,
+		oci_identity.CompartmentLifecycleStateActive,
+	}, states)
+}
@@ -0,0 +1,727 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	oci_core "github.com/oracle/oci-go-sdk/v65/core"
+	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
+	oci_kms "github.com/oracle/oci-go-sdk/v65/keymanagement"
+)
+
+var (
+	fakeOciCompartmentKind = &fakeOciResourceKind{
+		ocidType:       "compartment",
+		stateField:     "lifecycleState",
+		creatingState:  string(oci_identity.CompartmentLifecycleStateCreating),
+		activeState:    string(oci_identity.CompartmentLifecycleStateActive),
+		deletingState:  string(oci_identity.CompartmentLifecycleStateDeleting),
+		deletedState:   string(oci_identity.CompartmentLifecycleStateDeleted),
+		dependentField: "compartmentId",
+	}
+	fakeOciVcnKind = &fakeOciResourceKind{
+		ocidType:       "vcn",
+		stateField:     "lifecycleState",
+		creatingState:  string(oci_core.VcnLifecycleStateProvisioning),
+		activeState:    string(oci_core.VcnLifecycleStateAvailable),
+		deletingState:  string(oci_core.VcnLifecycleStateTerminating),
+		deletedState:   string(oci_core.VcnLifecycleStateTerminated),
+		dependentField: "vcnId",
+	}
+	fakeOciSubnetKind = &fakeOciResourceKind{
+		ocidType:      "subnet",
+		stateField:    "lifecycleState",
+		creatingState: string(oci_core.SubnetLifecycleStateProvisioning),
+		activeState:   string(oci_core.SubnetLifecycleStateAvailable),
+		deletingState: string(oci_core.SubnetLifecycleStateTerminating),
+		deletedState:  string(oci_core.SubnetLifecycleStateTerminated),
+	}
+	fakeOciSecurityListKind = &fakeOciResourceKind{
+		ocidType:      "securitylist",
+		stateField:    "lifecycleState",
+		creatingState: string(oci_core.SecurityListLifecycleStateProvisioning),
+		activeState:   string(oci_core.SecurityListLifecycleStateAvailable),
+		deletingState: string(oci_core.SecurityListLifecycleStateTerminating),
+		deletedState:  string(oci_core.SecurityListLifecycleStateTerminated),
+	}
+	fakeOciBucketKind = &fakeOciResourceKind{
+		ocidType: "bucket",
+	}
+	fakeOciVaultKind = &fakeOciResourceKind{
+		ocidType:      "vault",
+		stateField:    "lifecycleState",
+		creatingState: string(oci_kms.VaultLifecycleStateCreating),
+		activeState:   string(oci_kms.VaultLifecycleStateActive),
+		deletingState: string(oci_kms.VaultLifecycleStateSchedulingDeletion),
+		deletedState:  string(oci_kms.VaultLifecycleStatePendingDeletion),
+	}
+	fakeOciKeyKind = &fakeOciResourceKind{
+		ocidType:      "key",
+		stateField:    "lifecycleState",
+		creatingState: string(oci_kms.KeyLifecycleStateCreating),
+		activeState:   string(oci_kms.KeyLifecycleStateEnabled),
+		deletingState: string(oci_kms.KeyLifecycleStateSchedulingDeletion),
+		deletedState:  string(oci_kms.KeyLifecycleStatePendingDeletion),
+	}
+)
+
+// fakeOciDeletionDelay is the default delay of the deletion scheduled for vaults and keys
+const fakeOciDeletionDelay = 30 * 24 * time.Hour
+
+func getFakeOciRoutes(s *FakeOciServer) []fakeOciRoute {
+	return []fakeOciRoute{
+		// Identity
+		newFakeOciRoute(http.MethodPost, "/20160918/compartments", s.createIdentityCompartment),
+		newFakeOciRoute(http.MethodGet, "/20160918/compartments", s.listResources(fakeOciCompartmentKind)),
+		newFakeOciRoute(http.MethodGet, "/20160918/compartments/{}", s.getResource(fakeOciCompartmentKind)),
+		newFakeOciRoute(http.MethodPut, "/20160918/compartments/{}", s.updateResource(fakeOciCompartmentKind)),
+		newFakeOciRoute(http.MethodDelete, "/20160918/compartments/{}", s.deleteResource(fakeOciCompartmentKind, "DELETE_COMPARTMENT")),
+		newFakeOciRoute(http.MethodPost, "/20160918/compartments/{}/actions/moveCompartment", s.changeCompartment(fakeOciCompartmentKind, "targetCompartmentId", "MOVE_COMPARTMENT")),
+
+		// Work requests of Identity and of the other services
+		newFakeOciRoute(http.MethodGet, "/20160918/workRequests", s.listWorkRequests),
+		newFakeOciRoute(http.MethodGet, "/20160918/workRequests/{}", s.getWorkRequest),
+
+		// Core
+		newFakeOciRoute(http.MethodPost, "/20160918/vcns", s.createCoreVcn),
+		newFakeOciRoute(http.MethodGet, "/20160918/vcns", s.listResources(fakeOciVcnKind)),
+		newFakeOciRoute(http.MethodGet, "/20160918/vcns/{}", s.getResource(fakeOciVcnKind)),
+		newFakeOciRoute(http.MethodPut, "/20160918/vcns/{}", s.updateResource(fakeOciVcnKind)),
+		newFakeOciRoute(http.MethodDelete, "/20160918/vcns/{}", s.deleteResource(fakeOciVcnKind, "")),
+		newFakeOciRoute(http.MethodPost, "/20160918/vcns/{}/actions/changeCompartment", s.changeCompartment(fakeOciVcnKind, "compartmentId", "CHANGE_VCN_COMPARTMENT")),
+		newFakeOciRoute(http.MethodPost, "/20160918/subnets", s.createCoreSubnet),
+		newFakeOciRoute(http.MethodGet, "/20160918/subnets", s.listResources(fakeOciSubnetKind)),
+		newFakeOciRoute(http.MethodGet, "/20160918/subnets/{}", s.getResource(fakeOciSubnetKind)),
+		newFakeOciRoute(http.MethodPut, "/20160918/subnets/{}", s.updateResource(fakeOciSubnetKind)),
+		newFakeOciRoute(http.MethodDelete, "/20160918/subnets/{}", s.deleteResource(fakeOciSubnetKind, "")),
+		newFakeOciRoute(http.MethodPost, "/20160918/subnets/{}/actions/changeCompartment", s.changeCompartment(fakeOciSubnetKind, "compartmentId", "CHANGE_SUBNET_COMPARTMENT")),
+		newFakeOciRoute(http.MethodPost, "/20160918/securityLists", s.createCoreSecurityList),
+		newFakeOciRoute(http.MethodGet, "/20160918/securityLists", s.listResources(fakeOciSecurityListKind)),
+		newFakeOciRoute(http.MethodGet, "/20160918/securityLists/{}", s.getResource(fakeOciSecurityListKind)),
+		newFakeOciRoute(http.MethodPut, "/20160918/securityLists/{}", s.updateResource(fakeOciSecurityListKind)),
+		newFakeOciRoute(http.MethodDelete, "/20160918/securityLists/{}", s.deleteResource(fakeOciSecurityListKind, "")),
+		newFakeOciRoute(http.MethodPost, "/20160918/securityLists/{}/actions/changeCompartment", s.changeCompartment(fakeOciSecurityListKind, "compartmentId", "CHANGE_SECURITY_LIST_COMPARTMENT")),
+
+		// Object Storage
+		newFakeOciRoute(http.MethodGet, "/n", s.getObjectStorageNamespace),
+		newFakeOciRoute(http.MethodPost, "/n/{}/b", s.createObjectStorageBucket),
+		newFakeOciRoute(http.MethodGet, "/n/{}/b", s.listObjectStorageBuckets),
+		newFakeOciRoute(http.MethodGet, "/n/{}/b/{}", s.getObjectStorageBucket),
+		newFakeOciRoute(http.MethodHead, "/n/{}/b/{}", s.getObjectStorageBucket),
+		newFakeOciRoute(http.MethodPost, "/n/{}/b/{}", s.updateObjectStorageBucket),
+		newFakeOciRoute(http.MethodDelete, "/n/{}/b/{}", s.deleteObjectStorageBucket),
+		newFakeOciRoute(http.MethodGet, "/n/{}/b/{}/retentionRules", s.listObjectStorageRetentionRules),
+		newFakeOciRoute(http.MethodGet, "/n/{}/b/{}/o", s.listObjectStorageObjects),
+		newFakeOciRoute(http.MethodPut, "/n/{}/b/{}/o/{*}", s.putObjectStorageObject),
+		newFakeOciRoute(http.MethodGet, "/n/{}/b/{}/o/{*}", s.getObjectStorageObject),
+		newFakeOciRoute(http.MethodHead, "/n/{}/b/{}/o/{*}", s.getObjectStorageObject),
+		newFakeOciRoute(http.MethodDelete, "/n/{}/b/{}/o/{*}", s.deleteObjectStorageObject),
+		newFakeOciRoute(http.MethodPost, "/n/{}/b/{}/actions/renameObject", s.renameObjectStorageObject),
+
+		// KMS, the keys are managed through the management endpoint of their vault
+		newFakeOciRoute(http.MethodPost, "/20180608/vaults", s.createKmsVault),
+		newFakeOciRoute(http.MethodGet, "/20180608/vaults", s.listResources(fakeOciVaultKind)),
+		newFakeOciRoute(http.MethodGet, "/20180608/vaults/{}", s.getResource(fakeOciVaultKind)),
+		newFakeOciRoute(http.MethodPut, "/20180608/vaults/{}", s.updateResource(fakeOciVaultKind)),
+		newFakeOciRoute(http.MethodPost, "/20180608/vaults/{}/actions/scheduleDeletion", s.scheduleKmsDeletion(fakeOciVaultKind)),
+		newFakeOciRoute(http.MethodPost, "/20180608/vaults/{}/actions/changeCompartment", s.changeCompartment(fakeOciVaultKind, "compartmentId", "")),
+		newFakeOciRoute(http.MethodPost, "/20180608/keys", s.createKmsKey),
+		newFakeOciRoute(http.MethodGet, "/20180608/keys", s.listKmsKeys),
+		newFakeOciRoute(http.MethodGet, "/20180608/keys/{}", s.getResource(fakeOciKeyKind)),
+		newFakeOciRoute(http.MethodPut, "/20180608/keys/{}", s.updateResource(fakeOciKeyKind)),
+		newFakeOciRoute(http.MethodPost, "/20180608/keys/{}/actions/enable", s.changeKmsKeyState(oci_kms.KeyLifecycleStateEnabling, oci_kms.KeyLifecycleStateEnabled)),
+		newFakeOciRoute(http.MethodPost, "/20180608/keys/{}/actions/disable", s.changeKmsKeyState(oci_kms.KeyLifecycleStateDisabling, oci_kms.KeyLifecycleStateDisabled)),
+		newFakeOciRoute(http.MethodPost, "/20180608/keys/{}/actions/scheduleDeletion", s.scheduleKmsDeletion(fakeOciKeyKind)),
+		newFakeOciRoute(http.MethodPost, "/20180608/keys/{}/actions/changeCompartment", s.changeCompartment(fakeOciKeyKind, "compartmentId", "")),
+	}
+}
+
+// createResource creates a resource of a kind in an existing compartment from the body of a request, initialize sets
+// the attributes computed by the service
+func (s *FakeOciServer) createResource(w http.ResponseWriter, r *fakeOciRequest, kind *fakeOciResourceKind, required []string, initialize func(resource *fakeOciResource) *fakeOciError) {
+	body, err := decodeFakeOciBody(r)
+	if err == nil {
+		err = checkFakeOciRequiredFields(body, append([]string{"compartmentId"}, required...)...)
+	}
+	if err == nil {
+		_, err = s.checkActive(body["compartmentId"], fakeOciCompartmentKind)
+	}
+	if err != nil {
+		writeFakeOciError(w, err)
+		return
+	}
+	resource := s.newResource(kind, body)
+	if initialize != nil {
+		if err := initialize(resource); err != nil {
+			writeFakeOciError(w, err)
+			return
+		}
+	}
+	s.store(resource)
+	writeFakeOciResource(w, resource)
+}
+
+func (s *FakeOciServer) listResources(kind *fakeOciResourceKind) func(w http.ResponseWriter, r *fakeOciRequest) {
+	return func(w http.ResponseWriter, r *fakeOciRequest) {
+		writeFakeOciJson(w, http.StatusOK, s.list(r, kind))
+	}
+}
+
+func (s *FakeOciServer) getResource(kind *fakeOciResourceKind) func(w http.ResponseWriter, r *fakeOciRequest) {
+	return func(w http.ResponseWriter, r *fakeOciRequest) {
+		resource, err := s.read(r.params[0], kind)
+		if err != nil {
+			writeFakeOciError(w, err)
+			return
+		}
+		writeFakeOciResource(w, resource)
+	}
+}
+
+func (s *FakeOciServer) updateResource(kind *fakeOciResourceKind) func(w http.ResponseWriter, r *fakeOciRequest) {
+	return func(w http.ResponseWriter, r *fakeOciRequest) {
+		resource, err := s.checkActive(r.params[0], kind)
+		var body map[string]interface{}
+		if err == nil {
+			body, err = decodeFakeOciBody(r)
+		}
+		if err != nil {
+			writeFakeOciError(w, err)
+			return
+		}
+		for field, value := range body {
+			resource.fields[field] = value
+		}
+		resource.etag = s.newEtag()
+		writeFakeOciResource(w, resource)
+	}
+}
+
+// deleteResource moves a resource to the deleting state of its kind, and returns a work request for a non-empty operationType
+func (s *FakeOciServer) deleteResource(kind *fakeOciResourceKind, operationType string) func(w http.ResponseWriter, r *fakeOciRequest) {
+	return func(w http.ResponseWriter, r *fakeOciRequest) {
+		resource, err := s.read(r.params[0], kind)
+		if err == nil && resource.fields[kind.stateField] == kind.deletedState {
+			err = getFakeOciNotFoundError(r.params[0])
+		}
+		if err == nil && kind.dependentField != "" && s.hasDependents(resource) {
+			err = &fakeOciError{http.StatusConflict, "Conflict", fmt.Sprintf("%s still has resources which reference it", r.params[0])}
+		}
+		if err == nil && resource.owner != "" {
+			err = &fakeOciError{http.StatusConflict, "IncorrectState", fmt.Sprintf("%s is deleted with %s", r.params[0], resource.owner)}
+		}
+		if err != nil {
+			writeFakeOciError(w, err)
+			return
+		}
+
+		if !s.isDeleted(resource) {
+			s.setState(resource, kind.deletingState, kind.deletedState)
+			for _, owned := range s.resources {
+				if owned.owner == r.params[0] {
+					owned.fields[owned.kind.stateField] = owned.kind.deletedState
+				}
+			}
+		}
+		if operationType != "" {
+			w.Header().Set("opc-work-request-id", s.newWorkRequest(operationType, "DELETED", resource))
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// changeCompartment moves a resource to the compartment in the compartmentField of the body of the request
+func (s *FakeOciServer) changeCompartment(kind *fakeOciResourceKind, compartmentField string, operationType string) func(w http.ResponseWriter, r *fakeOciRequest) {
+	return func(w http.ResponseWriter, r *fakeOciRequest) {
+		resource, err := s.checkActive(r.params[0], kind)
+		var body map[string]interface{}
+		if err == nil {
+			body, err = decodeFakeOciBody(r)
+		}
+		if err == nil {
+			err = checkFakeOciRequiredFields(body, compartmentField)
+		}
+		if err == nil {
+			_, err = s.checkActive(body[compartmentField], fakeOciCompartmentKind)
+		}
+		if err != nil {
+			writeFakeOciError(w, err)
+			return
+		}
+
+		resource.fields["compartmentId"] = body[compartmentField]
+		resource.etag = s.newEtag()
+		if operationType != "" {
+			w.Header().Set("opc-work-request-id", s.newWorkRequest(operationType, "RELATED", resource))
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// createCompartment creates an active compartment, for the tests
+func (s *FakeOciServer) createCompartment(parentId string, name string) string {
+	compartment := s.newResource(fakeOciCompartmentKind, map[string]interface{}{
+		"compartmentId": parentId,
+		"name":          name,
+		"description":   name,
+		"isAccessible":  true,
+	})
+	compartment.fields["lifecycleState"] = fakeOciCompartmentKind.activeState
+	compartment.targetState = ""
+	s.store(compartment)
+	return compartment.fields["id"].(string)
+}
+
+func (s *FakeOciServer) createIdentityCompartment(w http.ResponseWriter, r *fakeOciRequest) {
+	s.createResource(w, r, fakeOciCompartmentKind, []string{"name", "description"}, func(compartment *fakeOciResource) *fakeOciError {
+		for _, existing := range s.resources {
+			if existing.kind == fakeOciCompartmentKind && !s.isDeleted(existing) &&
+				existing.fields["compartmentId"] == compartment.fields["compartmentId"] && existing.fields["name"] == compartment.fields["name"] {
+				return &fakeOciError{http.StatusConflict, "CompartmentAlreadyExists", fmt.Sprintf("Compartment '%v' already exists", compartment.fields["name"])}
+			}
+		}
+		compartment.fields["isAccessible"] = true
+		return nil
+	})
+}
+
+func (s *FakeOciServer) createCoreVcn(w http.ResponseWriter, r *fakeOciRequest) {
+	s.createResource(w, r, fakeOciVcnKind, nil, func(vcn *fakeOciResource) *fakeOciError {
+		cidrBlocks, _ := vcn.fields["cidrBlocks"].([]interface{})
+		if cidrBlock, ok := vcn.fields["cidrBlock"]; ok {
+			if len(cidrBlocks) == 0 {
+				vcn.fields["cidrBlocks"] = []interface{}{cidrBlock}
+			}
+		} else if len(cidrBlocks) > 0 {
+			vcn.fields["cidrBlock"] = cidrBlocks[0]
+		} else {
+			return &fakeOciError{http.StatusBadRequest, "MissingParameter", "cidrBlock or cidrBlocks is required"}
+		}
+		if dnsLabel, ok := vcn.fields["dnsLabel"]; ok {
+			vcn.fields["vcnDomainName"] = fmt.Sprintf("%v.oraclevcn.com", dnsLabel)
+		}
+		vcnId := vcn.fields["id"].(string)
+		vcn.fields["defaultRouteTableId"] = s.newOcid(&fakeOciResourceKind{ocidType: "routetable"})
+		vcn.fields["defaultDhcpOptionsId"] = s.newOcid(&fakeOciResourceKind{ocidType: "dhcpoptions"})
+
+		// The default security list allows SSH and all outbound traffic
+		securityList := s.newResource(fakeOciSecurityListKind, map[string]interface{}{
+			"compartmentId": vcn.fields["compartmentId"],
+			"vcnId":         vcnId,
+			"displayName":   fmt.Sprintf("Default Security List for %v", vcn.fields["displayName"]),
+			"egressSecurityRules": []interface{}{map[string]interface{}{
+				"destination": "0.0.0.0/0", "destinationType": "CIDR_BLOCK", "protocol": "all", "isStateless": false,
+			}},
+			"ingressSecurityRules": []interface{}{map[string]interface{}{
+				"source": "0.0.0.0/0", "sourceType": "CIDR_BLOCK", "protocol": "6", "isStateless": false,
+				"tcpOptions": map[string]interface{}{"destinationPortRange": map[string]interface{}{"min": 22, "max": 22}},
+			}},
+		})
+		securityList.owner = vcnId
+		securityList.fields["lifecycleState"] = fakeOciSecurityListKind.activeState
+		securityList.targetState = ""
+		s.store(securityList)
+		vcn.fields["defaultSecurityListId"] = securityList.fields["id"]
+		return nil
+	})
+}
+
+func (s *FakeOciServer) createCoreSubnet(w http.ResponseWriter, r *fakeOciRequest) {
+	s.createResource(w, r, fakeOciSubnetKind, []string{"vcnId", "cidrBlock"}, func(subnet *fakeOciResource) *fakeOciError {
+		vcn, err := s.checkActive(subnet.fields["vcnId"], fakeOciVcnKind)
+		if err != nil {
+			return err
+		}
+		ip, _, parseErr := net.ParseCIDR(fmt.Sprintf("%v", subnet.fields["cidrBlock"]))
+		if parseErr != nil || ip.To4() == nil {
+			return &fakeOciError{http.StatusBadRequest, "InvalidParameter", fmt.Sprintf("invalid cidrBlock %v", subnet.fields["cidrBlock"])}
+		}
+		routerIp := ip.To4()
+		routerIp[3]++
+
+		defaults := map[string]interface{}{
+			"securityListIds":         []interface{}{vcn.fields["defaultSecurityListId"]},
+			"routeTableId":            vcn.fields["defaultRouteTableId"],
+			"dhcpOptionsId":           vcn.fields["defaultDhcpOptionsId"],
+			"prohibitPublicIpOnVnic":  false,
+			"prohibitInternetIngress": false,
+			"virtualRouterIp":         routerIp.String(),
+			"virtualRouterMac":        "00:00:17:00:00:01",
+		}
+		for field, value := range defaults {
+			if _, ok := subnet.fields[field]; !ok {
+				subnet.fields[field] = value
+			}
+		}
+		if dnsLabel, ok := subnet.fields["dnsLabel"]; ok {
+			if vcnDomainName, ok := vcn.fields["vcnDomainName"]; ok {
+				subnet.fields["subnetDomainName"] = fmt.Sprintf("%v.%v", dnsLabel, vcnDomainName)
+			}
+		}
+		return nil
+	})
+}
+
+func (s *FakeOciServer) createCoreSecurityList(w http.ResponseWriter, r *fakeOciRequest) {
+	s.createResource(w, r, fakeOciSecurityListKind, []string{"vcnId"}, func(securityList *fakeOciResource) *fakeOciError {
+		if _, err := s.checkActive(securityList.fields["vcnId"], fakeOciVcnKind); err != nil {
+			return err
+		}
+		for _, rules := range []string{"egressSecurityRules", "ingressSecurityRules"} {
+			if _, ok := securityList.fields[rules]; !ok {
+				securityList.fields[rules] = []interface{}{}
+			}
+		}
+		return nil
+	})
+}
+
+func (s *FakeOciServer) getObjectStorageNamespace(w http.ResponseWriter, r *fakeOciRequest) {
+	writeFakeOciJson(w, http.StatusOK, s.Namespace)
+}
+
+// getBucket returns the bucket in the namespace and with the name of the first two parameters of a request
+func (s *FakeOciServer) getBucket(r *fakeOciRequest) (*fakeOciResource, *fakeOciError) {
+	if bucket := s.findBucket(r.params[0], r.params[1]); bucket != nil {
+		return bucket, nil
+	}
+	return nil, &fakeOciError{http.StatusNotFound, "BucketNotFound", fmt.Sprintf("Either the bucket named '%s' does not exist in the namespace '%s' or you are not authorized to access it", r.params[1], r.params[0])}
+}
+
+func (s *FakeOciServer) findBucket(namespace string, name interface{}) *fakeOciResource {
+	for _, resource := range s.resources {
+		if resource.kind == fakeOciBucketKind && resource.fields["namespace"] == namespace && resource.fields["name"] == name {
+			return resource
+		}
+	}
+	return nil
+}
+
+func (s *FakeOciServer) createObjectStorageBucket(w http.ResponseWriter, r *fakeOciRequest) {
+	if r.params[0] != s.Namespace {
+		writeFakeOciError(w, &fakeOciError{http.StatusNotFound, "NamespaceNotFound", fmt.Sprintf("You do not have authorization to perform this request, or the requested resource could not be found: %s", r.params[0])})
+		return
+	}
+	s.createResource(w, r, fakeOciBucketKind, []string{"name"}, func(bucket *fakeOciResource) *fakeOciError {
+		if s.findBucket(r.params[0], bucket.fields["name"]) != nil {
+			return &fakeOciError{http.StatusConflict, "BucketAlreadyExists", fmt.Sprintf("Either the bucket '%v' in namespace '%s' already exists or you are not authorized to create it", bucket.fields["name"], r.params[0])}
+		}
+		defaults := map[string]interface{}{
+			"namespace":           r.params[0],
+			"createdBy":           testUserOCID,
+			"publicAccessType":    "NoPublicAccess",
+			"storageTier":         "Standard",
+			"versioning":          "Disabled",
+			"autoTiering":         "Disabled",
+			"objectEventsEnabled": false,
+			"replicationEnabled":  false,
+			"isReadOnly":          false,
+			"metadata":            map[string]interface{}{},
+		}
+		for field, value := range defaults {
+			if _, ok := bucket.fields[field]; !ok {
+				bucket.fields[field] = value
+			}
+		}
+		s.objects[bucket.fields["id"].(string)] = map[string]*fakeOciObject{}
+		return nil
+	})
+}
+
+func (s *FakeOciServer) listObjectStorageBuckets(w http.ResponseWriter, r *fakeOciRequest) {
+	buckets := s.list(r, fakeOciBucketKind)
+	items := make([]map[string]interface{}, 0, len(buckets))
+	for _, bucket := range buckets {
+		if bucket["namespace"] == r.params[0] {
+			items = append(items, bucket)
+		}
+	}
+	writeFakeOciJson(w, http.StatusOK, items)
+}
+
+func (s *FakeOciServer) getObjectStorageBucket(w http.ResponseWriter, r *fakeOciRequest) {
+	bucket, err := s.getBucket(r)
+	if err != nil {
+		writeFakeOciError(w, err)
+		return
+	}
+	objects := s.objects[bucket.fields["id"].(string)]
+	size := 0
+	for _, object := range objects {
+		size += len(object.content)
+	}
+	bucket.fields["approximateCount"] = len(objects)
+	bucket.fields["approximateSize"] = size
+	writeFakeOciResource(w, bucket)
+}
+
+func (s *FakeOciServer) updateObjectStorageBucket(w http.ResponseWriter, r *fakeOciRequest) {
+	bucket, err := s.getBucket(r)
+	var body map[string]interface{}
+	if err == nil {
+		body, err = decodeFakeOciBody(r)
+	}
+	if name, ok := body["name"]; err == nil && ok && name != bucket.fields["name"] && s.findBucket(r.params[0], name) != nil {
+		err = &fakeOciError{http.StatusConflict, "BucketAlreadyExists", fmt.Sprintf("the bucket '%v' in namespace '%s' already exists", name, r.params[0])}
+	}
+	if compartmentId, ok := body["compartmentId"]; err == nil && ok {
+		_, err = s.checkActive(compartmentId, fakeOciCompartmentKind)
+	}
+	if err != nil {
+		writeFakeOciError(w, err)
+		return
+	}
+	delete(body, "namespace")
+	for field, value := range body {
+		bucket.fields[field] = value
+	}
+	bucket.etag = s.newEtag()
+	writeFakeOciResource(w, bucket)
+}
+
+func (s *FakeOciServer) deleteObjectStorageBucket(w http.ResponseWriter, r *fakeOciRequest) {
+	bucket, err := s.getBucket(r)
+	if err == nil && len(s.objects[bucket.fields["id"].(string)]) > 0 {
+		err = &fakeOciError{http.StatusConflict, "BucketNotEmpty", fmt.Sprintf("Bucket named '%s' is not empty. Delete all objects first.", r.params[1])}
+	}
+	if err != nil {
+		writeFakeOciError(w, err)
+		return
+	}
+	delete(s.resources, bucket.fields["id"].(string))
+	delete(s.objects, bucket.fields["id"].(string))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *FakeOciServer) listObjectStorageRetentionRules(w http.ResponseWriter, r *fakeOciRequest) {
+	if _, err := s.getBucket(r); err != nil {
+		writeFakeOciError(w, err)
+		return
+	}
+	writeFakeOciJson(w, http.StatusOK, map[string]interface{}{"items": []interface{}{}})
+}
+
+func (s *FakeOciServer) listObjectStorageObjects(w http.ResponseWriter, r *fakeOciRequest) {
+	bucket, err := s.getBucket(r)
+	if err != nil {
+		writeFakeOciError(w, err)
+		return
+	}
+	objects := s.objects[bucket.fields["id"].(string)]
+	names := make([]string, 0, len(objects))
+	for name := range objects {
+		if strings.HasPrefix(name, r.URL.Query().Get("prefix")) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	items := make([]map[string]interface{}, len(names))
+	for i, name := range names {
+		object := objects[name]
+		items[i] = map[string]interface{}{
+			"name":         name,
+			"size":         len(object.content),
+			"md5":          object.md5,
+			"etag":         object.etag,
+			"timeCreated":  getFakeOciTime(object.timeCreated),
+			"timeModified": getFakeOciTime(object.timeModified),
+		}
+	}
+	writeFakeOciJson(w, http.StatusOK, map[string]interface{}{"objects": items})
+}
+
+func (s *FakeOciServer) putObjectStorageObject(w http.ResponseWriter, r *fakeOciRequest) {
+	bucket, err := s.getBucket(r)
+	if err != nil {
+		writeFakeOciError(w, err)
+		return
+	}
+	content, readErr := ioutil.ReadAll(r.Body)
+	if readErr != nil {
+		writeFakeOciError(w, &fakeOciError{http.StatusBadRequest, "InvalidParameter", fmt.Sprintf("unable to read the object content: %v", readErr)})
+		return
+	}
+	sum := md5.Sum(content)
+	object := &fakeOciObject{
+		content:      content,
+		header:       http.Header{},
+		etag:         s.newEtag(),
+		md5:          base64.StdEncoding.EncodeToString(sum[:]),
+		timeCreated:  time.Now(),
+		timeModified: time.Now(),
+	}
+	if contentMd5 := r.Header.Get("Content-MD5"); contentMd5 != "" && contentMd5 != object.md5 {
+		writeFakeOciError(w, &fakeOciError{http.StatusBadRequest, "InvalidContentMD5", "The computed MD5 hash does not match the Content-MD5 header"})
+		return
+	}
+	object.header.Set("Content-Type", "application/octet-stream")
+	for name, values := range r.Header {
+		switch name = http.CanonicalHeaderKey(name); {
+		case name == "Content-Type", name == "Content-Language", name == "Content-Encoding", name == "Content-Disposition", name == "Cache-Control", strings.HasPrefix(name, "Opc-Meta-"):
+			object.header[name] = values
+		}
+	}
+
+	objects := s.objects[bucket.fields["id"].(string)]
+	if existing, ok := objects[r.params[2]]; ok {
+		object.timeCreated = existing.timeCreated
+	}
+	objects[r.params[2]] = object
+	w.Header().Set("etag", object.etag)
+	w.Header().Set("opc-content-md5", object.md5)
+	w.Header().Set("last-modified", object.timeModified.UTC().Format(http.TimeFormat))
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *FakeOciServer) getObject(r *fakeOciRequest) (*fakeOciObject, *fakeOciError) {
+	bucket, err := s.getBucket(r)
+	if err != nil {
+		return nil, err
+	}
+	if object, ok := s.objects[bucket.fields["id"].(string)][r.params[2]]; ok {
+		return object, nil
+	}
+	return nil, &fakeOciError{http.StatusNotFound, "ObjectNotFound", fmt.Sprintf("The object '%s' was not found in the bucket '%s'", r.params[2], r.params[1])}
+}
+
+func (s *FakeOciServer) getObjectStorageObject(w http.ResponseWriter, r *fakeOciRequest) {
+	object, err := s.getObject(r)
+	if err != nil {
+		writeFakeOciError(w, err)
+		return
+	}
+	for name, values := range object.header {
+		w.Header()[name] = values
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(len(object.content)))
+	w.Header().Set("Content-MD5", object.md5)
+	w.Header().Set("etag", object.etag)
+	w.Header().Set("last-modified", object.timeModified.UTC().Format(http.TimeFormat))
+	w.Header().Set("storage-tier", "Standard")
+	w.WriteHeader(http.StatusOK)
+	if r.Method == http.MethodGet {
+		w.Write(object.content)
+	}
+}
+
+func (s *FakeOciServer) deleteObjectStorageObject(w http.ResponseWriter, r *fakeOciRequest) {
+	if _, err := s.getObject(r); err != nil {
+		writeFakeOciError(w, err)
+		return
+	}
+	bucket, _ := s.getBucket(r)
+	delete(s.objects[bucket.fields["id"].(string)], r.params[2])
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *FakeOciServer) renameObjectStorageObject(w http.ResponseWriter, r *fakeOciRequest) {
+	bucket, err := s.getBucket(r)
+	var body map[string]interface{}
+	if err == nil {
+		body, err = decodeFakeOciBody(r)
+	}
+	if err == nil {
+		err = checkFakeOciRequiredFields(body, "sourceName", "newName")
+	}
+	if err != nil {
+		writeFakeOciError(w, err)
+		return
+	}
+	objects := s.objects[bucket.fields["id"].(string)]
+	sourceName, newName := body["sourceName"].(string), body["newName"].(string)
+	object, ok := objects[sourceName]
+	if !ok {
+		writeFakeOciError(w, &fakeOciError{http.StatusNotFound, "ObjectNotFound", fmt.Sprintf("The object '%s' was not found in the bucket '%s'", sourceName, r.params[1])})
+		return
+	}
+	delete(objects, sourceName)
+	object.etag = s.newEtag()
+	object.timeModified = time.Now()
+	objects[newName] = object
+	w.Header().Set("etag", object.etag)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *FakeOciServer) createKmsVault(w http.ResponseWriter, r *fakeOciRequest) {
+	s.createResource(w, r, fakeOciVaultKind, []string{"displayName", "vaultType"}, func(vault *fakeOciResource) *fakeOciError {
+		endpoint := s.addVaultServer(vault.fields["id"].(string))
+		vault.fields["managementEndpoint"] = endpoint
+		vault.fields["cryptoEndpoint"] = endpoint
+		vault.fields["wrappingkeyId"] = s.newOcid(fakeOciKeyKind)
+		vault.fields["isPrimary"] = true
+		return nil
+	})
+}
+
+func (s *FakeOciServer) createKmsKey(w http.ResponseWriter, r *fakeOciRequest) {
+	s.createResource(w, r, fakeOciKeyKind, []string{"displayName", "keyShape"}, func(key *fakeOciResource) *fakeOciError {
+		if _, err := s.checkActive(r.vaultId, fakeOciVaultKind); err != nil {
+			return err
+		}
+		key.fields["vaultId"] = r.vaultId
+		key.fields["currentKeyVersion"] = s.newOcid(&fakeOciResourceKind{ocidType: "keyversion"})
+		key.fields["isPrimary"] = true
+		if _, ok := key.fields["protectionMode"]; !ok {
+			key.fields["protectionMode"] = string(oci_kms.KeyProtectionModeHsm)
+		}
+		return nil
+	})
+}
+
+func (s *FakeOciServer) listKmsKeys(w http.ResponseWriter, r *fakeOciRequest) {
+	keys := s.list(r, fakeOciKeyKind)
+	items := make([]map[string]interface{}, 0, len(keys))
+	for _, key := range keys {
+		if key["vaultId"] == r.vaultId {
+			items = append(items, key)
+		}
+	}
+	writeFakeOciJson(w, http.StatusOK, items)
+}
+
+func (s *FakeOciServer) changeKmsKeyState(transitional oci_kms.KeyLifecycleStateEnum, target oci_kms.KeyLifecycleStateEnum) func(w http.ResponseWriter, r *fakeOciRequest) {
+	return func(w http.ResponseWriter, r *fakeOciRequest) {
+		key, err := s.checkActive(r.params[0], fakeOciKeyKind)
+		if err != nil {
+			writeFakeOciError(w, err)
+			return
+		}
+		s.setState(key, string(transitional), string(target))
+		key.etag = s.newEtag()
+		writeFakeOciResource(w, key)
+	}
+}
+
+// scheduleKmsDeletion schedules the deletion of a vault or a key at the timeOfDeletion of the body of the request
+func (s *FakeOciServer) scheduleKmsDeletion(kind *fakeOciResourceKind) func(w http.ResponseWriter, r *fakeOciRequest) {
+	return func(w http.ResponseWriter, r *fakeOciRequest) {
+		resource, err := s.checkActive(r.params[0], kind)
+		var body map[string]interface{}
+		if err == nil {
+			body, err = decodeFakeOciBody(r)
+		}
+		if err != nil {
+			writeFakeOciError(w, err)
+			return
+		}
+		if timeOfDeletion, ok := body["timeOfDeletion"]; ok {
+			resource.fields["timeOfDeletion"] = timeOfDeletion
+		} else {
+			resource.fields["timeOfDeletion"] = getFakeOciTime(time.Now().Add(fakeOciDeletionDelay))
+		}
+		s.setState(resource, kind.deletingState, kind.deletedState)
+		resource.etag = s.newEtag()
+		writeFakeOciResource(w, resource)
+	}
+}
@@ -0,0 +1,319 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+
+	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
+	tf_export "github.com/oracle/terraform-provider-oci/internal/commonexport"
+	utils "github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+const (
+	defaultSweeperTimeout      = 20 * time.Minute
+	defaultSweeperPollInterval = 10 * time.Second
+)
+
+// SweeperResource describes how the sweeper orchestrator lists and deletes the resources of a type in a compartment
+type SweeperResource struct {
+	Name           string // The name of the sweeper of the resource type (e.g. CoreVcn)
+	DependencyName string // The key of the resource type in DependencyGraph (e.g. vcn)
+	ResourceClass  string // The terraform resource class of the resource type in the export graphs (e.g. oci_core_vcn)
+
+	ListFn   func(clients *tf_client.OracleClients, compartmentId string) ([]*SweepableResource, error)
+	DeleteFn func(clients *tf_client.OracleClients, resource *SweepableResource) error
+	// IsDeletedFn is used to wait for a resource to be deleted before deleting the resources it depends on. If nil, the deletion is not waited on
+	IsDeletedFn func(clients *tf_client.OracleClients, resource *SweepableResource) (bool, error)
+}
+
+// SweepableResource is a resource listed by a SweeperResource
+type SweepableResource struct {
+	Id           string
+	DisplayName  string
+	TimeCreated  time.Time
+	FreeformTags map[string]string
+	DefinedTags  map[string]map[string]interface{}
+	RawResource  interface{} // The resource returned by the list API, for the DeleteFn and IsDeletedFn which need more than the id
+}
+
+// SweeperOptions filters the resources deleted by SweepCompartment
+type SweeperOptions struct {
+	DryRun       bool              // List the resources which would be deleted without deleting them
+	MinAge       time.Duration     // Only delete the resources created at least MinAge ago
+	FreeformTags map[string]string // Only delete the resources with all these freeform tags
+	DefinedTags  map[string]string // Only delete the resources with all these defined tags, keyed by <namespace>.<key>
+	Names        []string          // Only delete the resources of these sweepers. By default, all the registered sweepers are run
+	Timeout      time.Duration     // How long to retry a deletion on conflicts, or to wait for a deletion to complete
+	PollInterval time.Duration     // How long to wait between the retries and the checks of the deletions
+}
+
+// SweptResource is a resource deleted, or to be deleted in a dry run, by SweepCompartment
+type SweptResource struct {
+	Name        string
+	Id          string
+	DisplayName string
+	Err         error
+}
+
+var sweeperResources = map[string]*SweeperResource{}
+
+func RegisterSweeperResource(sweeperResource *SweeperResource) {
+	sweeperResources[sweeperResource.Name] = sweeperResource
+}
+
+// GetSweeperDeletionOrder returns the names of the registered sweepers in the order their resources can be deleted: the
+// resources which depend on a resource, according to DependencyGraph and the export resource graphs, come before it.
+// If names is empty, all the registered sweepers are returned
+func GetSweeperDeletionOrder(names []string) ([]string, error) {
+	if DependencyGraph == nil {
+		InitDependencyGraph()
+	}
+	if len(names) == 0 {
+		for name := range sweeperResources {
+			names = append(names, name)
+		}
+	}
+	selected := map[string]bool{}
+	for _, name := range names {
+		if _, ok := sweeperResources[name]; !ok {
+			return nil, fmt.Errorf("[ERROR] no sweeper resource registered for %s", name)
+		}
+		selected[name] = true
+	}
+
+	// dependents[name] holds the sweepers of the resources which must be deleted before the resources of name
+	dependents := map[string]map[string]bool{}
+	addDependent := func(parent string, child string) {
+		if parent == child || !selected[parent] || !selected[child] {
+			return
+		}
+		if dependents[parent] == nil {
+			dependents[parent] = map[string]bool{}
+		}
+		dependents[parent][child] = true
+	}
+	namesByResourceClass := map[string]string{}
+	for name := range selected {
+		sweeperResource := sweeperResources[name]
+		for _, child := range DependencyGraph[sweeperResource.DependencyName] {
+			addDependent(name, child)
+		}
+		if sweeperResource.ResourceClass != "" {
+			namesByResourceClass[sweeperResource.ResourceClass] = name
+		}
+	}
+	for _, resourceGraphs := range []map[string]tf_export.TerraformResourceGraph{tf_export.CompartmentResourceGraphs, tf_export.TenancyResourceGraphs} {
+		for _, resourceGraph := range resourceGraphs {
+			for parentClass, associations := range resourceGraph {
+				for _, association := range associations {
+					if association.TerraformResourceHints != nil {
+						addDependent(namesByResourceClass[parentClass], namesByResourceClass[association.ResourceClass])
+					}
+				}
+			}
+		}
+	}
+
+	order := make([]string, 0, len(selected))
+	for len(selected) > 0 {
+		ready := make([]string, 0)
+		for name := range selected {
+			if len(dependents[name]) == 0 {
+				ready = append(ready, name)
+			}
+		}
+		if len(ready) == 0 {
+			remaining := make([]string, 0)
+			for name := range selected {
+				remaining = append(remaining, name)
+			}
+			sort.Strings(remaining)
+			return nil, fmt.Errorf("[ERROR] dependency cycle between the sweepers %s", strings.Join(remaining, ", "))
+		}
+		sort.Strings(ready)
+		for _, name := range ready {
+			delete(selected, name)
+			for _, children := range dependents {
+				delete(children, name)
+			}
+		}
+		order = append(order, ready...)
+	}
+	return order, nil
+}
+
+// SweepCompartment deletes the resources of the registered sweepers in a compartment in dependency order, retrying the
+// deletions which conflict with resources still being deleted. The resources in SweeperDefaultResourceId are skipped
+func SweepCompartment(compartmentId string, options *SweeperOptions) ([]*SweptResource, error) {
+	if options == nil {
+		options = &SweeperOptions{}
+	}
+	order, err := GetSweeperDeletionOrder(options.Names)
+	if err != nil {
+		return nil, err
+	}
+	clients := GetTestClients(&schema.ResourceData{})
+
+	// List all the resources before deleting any, as listing a resource can add the ids of its default resources to
+	// SweeperDefaultResourceId
+	resources := map[string][]*SweepableResource{}
+	for _, name := range order {
+		listed, err := sweeperResources[name].ListFn(clients, compartmentId)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] failed to list the %s resources in compartment %s: %v", name, compartmentId, err)
+		}
+		resources[name] = listed
+	}
+
+	swept := make([]*SweptResource, 0)
+	errs := make([]string, 0)
+	for _, name := range order {
+		sweeperResource := sweeperResources[name]
+		deleted := make([]*SweepableResource, 0)
+		for _, resource := range resources[name] {
+			if !options.matches(resource) {
+				continue
+			}
+			sweptResource := &SweptResource{Name: name, Id: resource.Id, DisplayName: resource.DisplayName}
+			swept = append(swept, sweptResource)
+			if options.DryRun {
+				log.Printf("[INFO] [dry run] would delete %s %s (%s)", name, resource.Id, resource.DisplayName)
+				continue
+			}
+			log.Printf("[INFO] deleting %s %s (%s)", name, resource.Id, resource.DisplayName)
+			if sweptResource.Err = options.retryOnConflict(func() error { return sweeperResource.DeleteFn(clients, resource) }); sweptResource.Err != nil {
+				errs = append(errs, fmt.Sprintf("%s %s: %v", name, resource.Id, sweptResource.Err))
+				continue
+			}
+			deleted = append(deleted, resource)
+		}
+		if sweeperResource.IsDeletedFn == nil {
+			continue
+		}
+		for _, resource := range deleted {
+			if err := options.waitForDeletion(clients, sweeperResource, resource); err != nil {
+				errs = append(errs, fmt.Sprintf("%s %s: %v", name, resource.Id, err))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return swept, fmt.Errorf("[ERROR] failed to sweep compartment %s:\n%s", compartmentId, strings.Join(errs, "\n"))
+	}
+	return swept, nil
+}
+
+// GetSweeperOptionsFromEnv reads the sweeper options from the sweep_dry_run, sweep_min_age, sweep_freeform_tags,
+// sweep_defined_tags, sweep_resources, sweep_timeout and sweep_poll_interval environment variables
+func GetSweeperOptionsFromEnv() (*SweeperOptions, error) {
+	options := &SweeperOptions{
+		FreeformTags: getSweeperTagsFromEnv("sweep_freeform_tags"),
+		DefinedTags:  getSweeperTagsFromEnv("sweep_defined_tags"),
+	}
+	if dryRun := utils.GetEnvSettingWithBlankDefault("sweep_dry_run"); dryRun != "" {
+		value, err := strconv.ParseBool(dryRun)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] invalid sweep_dry_run '%s': %v", dryRun, err)
+		}
+		options.DryRun = value
+	}
+	for envVar, duration := range map[string]*time.Duration{"sweep_min_age": &options.MinAge, "sweep_timeout": &options.Timeout, "sweep_poll_interval": &options.PollInterval} {
+		if value := utils.GetEnvSettingWithBlankDefault(envVar); value != "" {
+			parsed, err := time.ParseDuration(value)
+			if err != nil {
+				return nil, fmt.Errorf("[ERROR] invalid %s '%s': %v", envVar, value, err)
+			}
+			*duration = parsed
+		}
+	}
+	if names := utils.GetEnvSettingWithBlankDefault("sweep_resources"); names != "" {
+		for _, name := range strings.Split(names, ",") {
+			options.Names = append(options.Names, strings.TrimSpace(name))
+		}
+	}
+	return options, nil
+}
+
+// getSweeperTagsFromEnv reads the comma-separated list of <key>=<value> tags from an environment variable
+func getSweeperTagsFromEnv(envVar string) map[string]string {
+	tags := map[string]string{}
+	for _, tag := range strings.Split(utils.GetEnvSettingWithBlankDefault(envVar), ",") {
+		if keyValue := strings.SplitN(tag, "=", 2); len(keyValue) == 2 {
+			tags[strings.TrimSpace(keyValue[0])] = strings.TrimSpace(keyValue[1])
+		}
+	}
+	return tags
+}
+
+func (options *SweeperOptions) matches(resource *SweepableResource) bool {
+	if _, ok := SweeperDefaultResourceId[resource.Id]; ok {
+		return false
+	}
+	if options.MinAge > 0 && (resource.TimeCreated.IsZero() || time.Since(resource.TimeCreated) < options.MinAge) {
+		return false
+	}
+	for key, value := range options.FreeformTags {
+		if resource.FreeformTags[key] != value {
+			return false
+		}
+	}
+	for namespaceKey, value := range options.DefinedTags {
+		namespaceAndKey := strings.SplitN(namespaceKey, ".", 2)
+		if len(namespaceAndKey) != 2 || fmt.Sprint(resource.DefinedTags[namespaceAndKey[0]][namespaceAndKey[1]]) != value {
+			return false
+		}
+	}
+	return true
+}
+
+func (options *SweeperOptions) timeout() time.Duration {
+	if options.Timeout > 0 {
+		return options.Timeout
+	}
+	return defaultSweeperTimeout
+}
+
+func (options *SweeperOptions) pollInterval() time.Duration {
+	if options.PollInterval > 0 {
+		return options.PollInterval
+	}
+	return defaultSweeperPollInterval
+}
+
+// retryOnConflict retries the deletion while it fails with a 409, e.g. while the resources which depend on the
+// resource are still being deleted
+func (options *SweeperOptions) retryOnConflict(deleteFn func() error) error {
+	stopTime := time.Now().Add(options.timeout())
+	for {
+		err := deleteFn()
+		if serviceError, ok := oci_common.IsServiceError(err); !ok || serviceError.GetHTTPStatusCode() != http.StatusConflict || time.Now().After(stopTime) {
+			return err
+		}
+		log.Printf("[DEBUG] retrying the deletion after a conflict: %v", err)
+		time.Sleep(options.pollInterval())
+	}
+}
+
+func (options *SweeperOptions) waitForDeletion(clients *tf_client.OracleClients, sweeperResource *SweeperResource, resource *SweepableResource) error {
+	stopTime := time.Now().Add(options.timeout())
+	for {
+		deleted, err := sweeperResource.IsDeletedFn(clients, resource)
+		if err != nil || deleted {
+			return err
+		}
+		if time.Now().After(stopTime) {
+			return fmt.Errorf("timed out waiting for the deletion")
+		}
+		time.Sleep(options.pollInterval())
+	}
+}
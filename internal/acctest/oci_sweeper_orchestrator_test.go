@@ -0,0 +1,231 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+type sweeperTestServiceError struct {
+	statusCode int
+}
+
+func (e sweeperTestServiceError) GetHTTPStatusCode() int  { return e.statusCode }
+func (e sweeperTestServiceError) GetMessage() string      { return http.StatusText(e.statusCode) }
+func (e sweeperTestServiceError) GetCode() string         { return http.StatusText(e.statusCode) }
+func (e sweeperTestServiceError) GetOpcRequestID() string { return "" }
+func (e sweeperTestServiceError) Error() string           { return e.GetMessage() }
+
+func setUpSweeperResources(t *testing.T) {
+	registered, defaultResourceIds := sweeperResources, SweeperDefaultResourceId
+	t.Cleanup(func() {
+		sweeperResources, SweeperDefaultResourceId = registered, defaultResourceIds
+	})
+	sweeperResources = map[string]*SweeperResource{}
+	for name, sweeperResource := range registered {
+		sweeperResources[name] = sweeperResource
+	}
+	SweeperDefaultResourceId = map[string]bool{}
+}
+
+func TestUnitGetSweeperDeletionOrder(t *testing.T) {
+	setUpSweeperResources(t)
+
+	order, err := GetSweeperDeletionOrder(nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.ElementsMatch(t, []string{"CoreDhcpOptions", "CoreInstance", "CoreInternetGateway", "CoreRouteTable", "CoreSecurityList", "CoreSubnet", "CoreVcn", "KmsKey", "KmsVault"}, order)
+	index := map[string]int{}
+	for i, name := range order {
+		index[name] = i
+	}
+	// From DependencyGraph
+	assert.Less(t, index["CoreSubnet"], index["CoreVcn"])
+	assert.Less(t, index["CoreInstance"], index["CoreSubnet"])
+	assert.Less(t, index["CoreSubnet"], index["CoreDhcpOptions"])
+	assert.Less(t, index["CoreSubnet"], index["CoreRouteTable"])
+	assert.Less(t, index["CoreRouteTable"], index["CoreInternetGateway"])
+	assert.Less(t, index["CoreInternetGateway"], index["CoreVcn"])
+	// From the export resource graphs
+	assert.Less(t, index["CoreSecurityList"], index["CoreVcn"])
+	assert.Less(t, index["KmsKey"], index["KmsVault"])
+
+	order, err = GetSweeperDeletionOrder([]string{"CoreVcn", "KmsVault"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"CoreVcn", "KmsVault"}, order)
+
+	_, err = GetSweeperDeletionOrder([]string{"CoreNatGateway"})
+	assert.Error(t, err)
+
+	dependencyGraph := DependencyGraph
+	defer func() { DependencyGraph = dependencyGraph }()
+	DependencyGraph = map[string][]string{"first": {"TestSecond"}, "second": {"TestFirst"}}
+	RegisterSweeperResource(&SweeperResource{Name: "TestFirst", DependencyName: "first"})
+	RegisterSweeperResource(&SweeperResource{Name: "TestSecond", DependencyName: "second"})
+	_, err = GetSweeperDeletionOrder([]string{"TestFirst", "TestSecond", "CoreVcn"})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "TestFirst, TestSecond")
+		assert.NotContains(t, err.Error(), "CoreVcn")
+	}
+}
+
+func TestUnitSweeperOptions_matches(t *testing.T) {
+	setUpSweeperResources(t)
+	SweeperDefaultResourceId["default"] = true
+
+	old := time.Now().Add(-2 * time.Hour)
+	tests := []struct {
+		name     string
+		options  SweeperOptions
+		resource SweepableResource
+		want     bool
+	}{
+		{"no filter", SweeperOptions{}, SweepableResource{Id: "id"}, true},
+		{"default resource", SweeperOptions{}, SweepableResource{Id: "default"}, false},
+		{"old enough", SweeperOptions{MinAge: time.Hour}, SweepableResource{Id: "id", TimeCreated: old}, true},
+		{"too recent", SweeperOptions{MinAge: time.Hour}, SweepableResource{Id: "id", TimeCreated: time.Now()}, false},
+		{"unknown age", SweeperOptions{MinAge: time.Hour}, SweepableResource{Id: "id"}, false},
+		{"freeform tag", SweeperOptions{FreeformTags: map[string]string{"owner": "test"}}, SweepableResource{Id: "id", FreeformTags: map[string]string{"owner": "test"}}, true},
+		{"other freeform tag", SweeperOptions{FreeformTags: map[string]string{"owner": "test"}}, SweepableResource{Id: "id", FreeformTags: map[string]string{"owner": "prod"}}, false},
+		{"defined tag", SweeperOptions{DefinedTags: map[string]string{"ns.owner": "test"}}, SweepableResource{Id: "id", DefinedTags: map[string]map[string]interface{}{"ns": {"owner": "test"}}}, true},
+		{"missing defined tag", SweeperOptions{DefinedTags: map[string]string{"ns.owner": "test"}}, SweepableResource{Id: "id"}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, test.options.matches(&test.resource))
+		})
+	}
+}
+
+func TestUnitSweeperOptions_retryOnConflict(t *testing.T) {
+	// Only the resource types served by the fake OCI server are swept
+	options := &SweeperOptions{Timeout: time.Minute, PollInterval: time.Millisecond, Names: []string{"CoreSecurityList", "CoreSubnet", "CoreVcn", "KmsKey", "KmsVault"}}
+	calls := 0
+	err := options.retryOnConflict(func() error {
+		if calls++; calls < 3 {
+			return sweeperTestServiceError{http.StatusConflict}
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+
+	calls = 0
+	err = options.retryOnConflict(func() error {
+		calls++
+		return sweeperTestServiceError{http.StatusBadRequest}
+	})
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+
+	options.Timeout = time.Nanosecond
+	err = options.retryOnConflict(func() error { return sweeperTestServiceError{http.StatusConflict} })
+	assert.Error(t, err)
+}
+
+// Test that a compartment is swept in dependency order against the fake OCI server
+func TestUnitSweepCompartment(t *testing.T) {
+	setUpSweeperResources(t)
+	server, clients := getFakeOciServerClients(t)
+
+	vcn := createFakeOciResource(t, clients, "oci_core_vcn", map[string]interface{}{
+		"compartment_id": server.CompartmentId,
+		"cidr_block":     "10.0.0.0/16",
+		"display_name":   "vcn",
+	})
+	subnet := createFakeOciResource(t, clients, "oci_core_subnet", map[string]interface{}{
+		"compartment_id": server.CompartmentId,
+		"vcn_id":         vcn.Id(),
+		"cidr_block":     "10.0.0.0/24",
+		"display_name":   "subnet",
+		"freeform_tags":  map[string]interface{}{"sweep": "true"},
+	})
+	securityList := createFakeOciResource(t, clients, "oci_core_security_list", map[string]interface{}{
+		"compartment_id": server.CompartmentId,
+		"vcn_id":         vcn.Id(),
+		"display_name":   "security list",
+	})
+	vault := createFakeOciResource(t, clients, "oci_kms_vault", map[string]interface{}{
+		"compartment_id": server.CompartmentId,
+		"display_name":   "vault",
+		"vault_type":     "DEFAULT",
+	})
+	key := createFakeOciResource(t, clients, "oci_kms_key", map[string]interface{}{
+		"compartment_id":      server.CompartmentId,
+		"display_name":        "key",
+		"management_endpoint": vault.Get("management_endpoint"),
+		"key_shape":           []interface{}{map[string]interface{}{"algorithm": "AES", "length": 32}},
+	})
+	// Only the resource types served by the fake OCI server are swept
+	options := &SweeperOptions{Timeout: time.Minute, PollInterval: time.Millisecond, Names: []string{"CoreSecurityList", "CoreSubnet", "CoreVcn", "KmsKey", "KmsVault"}}
+
+	options.DryRun = true
+	swept, err := SweepCompartment(server.CompartmentId, options)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"CoreSecurityList " + securityList.Id(),
+		"CoreSubnet " + subnet.Id(),
+		"KmsKey " + key.Id(),
+		"CoreVcn " + vcn.Id(),
+		"KmsVault " + vault.Id(),
+	}, getSweptResourceNames(swept))
+	assert.True(t, SweeperDefaultResourceId[vcn.Get("default_security_list_id").(string)])
+	assert.Equal(t, "AVAILABLE", importFakeOciResource(t, clients, "oci_core_subnet", subnet.Id()).Get("state"))
+
+	options.FreeformTags = map[string]string{"sweep": "true"}
+	swept, err = SweepCompartment(server.CompartmentId, options)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"CoreSubnet " + subnet.Id()}, getSweptResourceNames(swept))
+
+	options.DryRun, options.FreeformTags = false, nil
+	swept, err = SweepCompartment(server.CompartmentId, options)
+	assert.NoError(t, err)
+	assert.Len(t, swept, 5)
+	for _, sweptResource := range swept {
+		assert.NoError(t, sweptResource.Err)
+	}
+	assert.Equal(t, "TERMINATED", importFakeOciResource(t, clients, "oci_core_vcn", vcn.Id()).Get("state"))
+	assert.Equal(t, "TERMINATED", importFakeOciResource(t, clients, "oci_core_subnet", subnet.Id()).Get("state"))
+	assert.Contains(t, []string{"SCHEDULING_DELETION", "PENDING_DELETION"}, importFakeOciResource(t, clients, "oci_kms_vault", vault.Id()).Get("state"))
+
+	swept, err = SweepCompartment(server.CompartmentId, options)
+	assert.NoError(t, err)
+	assert.Empty(t, swept)
+}
+
+func getSweptResourceNames(swept []*SweptResource) []string {
+	names := make([]string, 0, len(swept))
+	for _, sweptResource := range swept {
+		names = append(names, fmt.Sprintf("%s %s", sweptResource.Name, sweptResource.Id))
+	}
+	return names
+}
+
+// TestSweepCompartment deletes the resources of the registered sweepers in the compartment in TF_VAR_sweep_compartment_id.
+// It is run by `make sweep-compartment`, see GetSweeperOptionsFromEnv for the environment variables which filter the resources
+func TestSweepCompartment(t *testing.T) {
+	compartmentId := utils.GetEnvSettingWithBlankDefault("sweep_compartment_id")
+	if compartmentId == "" {
+		t.Skip("TF_VAR_sweep_compartment_id must be set to sweep a compartment")
+	}
+	options, err := GetSweeperOptionsFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	swept, err := SweepCompartment(compartmentId, options)
+	for _, sweptResource := range swept {
+		t.Logf("%s %s (%s)", sweptResource.Name, sweptResource.Id, sweptResource.DisplayName)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+}
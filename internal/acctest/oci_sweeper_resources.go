@@ -0,0 +1,357 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+	oci_core "github.com/oracle/oci-go-sdk/v65/core"
+	oci_kms "github.com/oracle/oci-go-sdk/v65/keymanagement"
+
+	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
+)
+
+// sweepableKmsKey is the RawResource of the keys, which are managed through the management endpoint of their vault
+type sweepableKmsKey struct {
+	oci_kms.KeySummary
+	managementEndpoint string
+}
+
+func init() {
+	RegisterSweeperResource(&SweeperResource{
+		Name:           "CoreVcn",
+		DependencyName: "vcn",
+		ResourceClass:  "oci_core_vcn",
+		ListFn:         listCoreVcnsToSweep,
+		DeleteFn: func(clients *tf_client.OracleClients, resource *SweepableResource) error {
+			_, err := clients.VirtualNetworkClient().DeleteVcn(context.Background(), oci_core.DeleteVcnRequest{VcnId: &resource.Id})
+			return err
+		},
+		IsDeletedFn: func(clients *tf_client.OracleClients, resource *SweepableResource) (bool, error) {
+			response, err := clients.VirtualNetworkClient().GetVcn(context.Background(), oci_core.GetVcnRequest{VcnId: &resource.Id})
+			return isSweptResourceDeleted(err, response.LifecycleState == oci_core.VcnLifecycleStateTerminated)
+		},
+	})
+	RegisterSweeperResource(&SweeperResource{
+		Name:           "CoreSubnet",
+		DependencyName: "subnet",
+		ResourceClass:  "oci_core_subnet",
+		ListFn:         listCoreSubnetsToSweep,
+		DeleteFn: func(clients *tf_client.OracleClients, resource *SweepableResource) error {
+			_, err := clients.VirtualNetworkClient().DeleteSubnet(context.Background(), oci_core.DeleteSubnetRequest{SubnetId: &resource.Id})
+			return err
+		},
+		IsDeletedFn: func(clients *tf_client.OracleClients, resource *SweepableResource) (bool, error) {
+			response, err := clients.VirtualNetworkClient().GetSubnet(context.Background(), oci_core.GetSubnetRequest{SubnetId: &resource.Id})
+			return isSweptResourceDeleted(err, response.LifecycleState == oci_core.SubnetLifecycleStateTerminated)
+		},
+	})
+	RegisterSweeperResource(&SweeperResource{
+		Name:           "CoreSecurityList",
+		DependencyName: "securityList",
+		ResourceClass:  "oci_core_security_list",
+		ListFn:         listCoreSecurityListsToSweep,
+		DeleteFn: func(clients *tf_client.OracleClients, resource *SweepableResource) error {
+			_, err := clients.VirtualNetworkClient().DeleteSecurityList(context.Background(), oci_core.DeleteSecurityListRequest{SecurityListId: &resource.Id})
+			return err
+		},
+		IsDeletedFn: func(clients *tf_client.OracleClients, resource *SweepableResource) (bool, error) {
+			response, err := clients.VirtualNetworkClient().GetSecurityList(context.Background(), oci_core.GetSecurityListRequest{SecurityListId: &resource.Id})
+			return isSweptResourceDeleted(err, response.LifecycleState == oci_core.SecurityListLifecycleStateTerminated)
+		},
+	})
+	RegisterSweeperResource(&SweeperResource{
+		Name:           "CoreRouteTable",
+		DependencyName: "routeTable",
+		ResourceClass:  "oci_core_route_table",
+		ListFn:         listCoreRouteTablesToSweep,
+		DeleteFn: func(clients *tf_client.OracleClients, resource *SweepableResource) error {
+			_, err := clients.VirtualNetworkClient().DeleteRouteTable(context.Background(), oci_core.DeleteRouteTableRequest{RtId: &resource.Id})
+			return err
+		},
+		IsDeletedFn: func(clients *tf_client.OracleClients, resource *SweepableResource) (bool, error) {
+			response, err := clients.VirtualNetworkClient().GetRouteTable(context.Background(), oci_core.GetRouteTableRequest{RtId: &resource.Id})
+			return isSweptResourceDeleted(err, response.LifecycleState == oci_core.RouteTableLifecycleStateTerminated)
+		},
+	})
+	RegisterSweeperResource(&SweeperResource{
+		Name:           "CoreInternetGateway",
+		DependencyName: "internetGateway",
+		ResourceClass:  "oci_core_internet_gateway",
+		ListFn:         listCoreInternetGatewaysToSweep,
+		DeleteFn: func(clients *tf_client.OracleClients, resource *SweepableResource) error {
+			_, err := clients.VirtualNetworkClient().DeleteInternetGateway(context.Background(), oci_core.DeleteInternetGatewayRequest{IgId: &resource.Id})
+			return err
+		},
+		IsDeletedFn: func(clients *tf_client.OracleClients, resource *SweepableResource) (bool, error) {
+			response, err := clients.VirtualNetworkClient().GetInternetGateway(context.Background(), oci_core.GetInternetGatewayRequest{IgId: &resource.Id})
+			return isSweptResourceDeleted(err, response.LifecycleState == oci_core.InternetGatewayLifecycleStateTerminated)
+		},
+	})
+	RegisterSweeperResource(&SweeperResource{
+		Name:           "CoreDhcpOptions",
+		DependencyName: "dhcpOptions",
+		ResourceClass:  "oci_core_dhcp_options",
+		ListFn:         listCoreDhcpOptionsToSweep,
+		DeleteFn: func(clients *tf_client.OracleClients, resource *SweepableResource) error {
+			_, err := clients.VirtualNetworkClient().DeleteDhcpOptions(context.Background(), oci_core.DeleteDhcpOptionsRequest{DhcpId: &resource.Id})
+			return err
+		},
+		IsDeletedFn: func(clients *tf_client.OracleClients, resource *SweepableResource) (bool, error) {
+			response, err := clients.VirtualNetworkClient().GetDhcpOptions(context.Background(), oci_core.GetDhcpOptionsRequest{DhcpId: &resource.Id})
+			return isSweptResourceDeleted(err, response.LifecycleState == oci_core.DhcpOptionsLifecycleStateTerminated)
+		},
+	})
+	RegisterSweeperResource(&SweeperResource{
+		Name:           "CoreInstance",
+		DependencyName: "instance",
+		ResourceClass:  "oci_core_instance",
+		ListFn:         listCoreInstancesToSweep,
+		// The VNICs and the boot volume of an instance are deleted with the instance, which releases its subnets
+		DeleteFn: func(clients *tf_client.OracleClients, resource *SweepableResource) error {
+			_, err := clients.ComputeClient().TerminateInstance(context.Background(), oci_core.TerminateInstanceRequest{InstanceId: &resource.Id})
+			return err
+		},
+		IsDeletedFn: func(clients *tf_client.OracleClients, resource *SweepableResource) (bool, error) {
+			response, err := clients.ComputeClient().GetInstance(context.Background(), oci_core.GetInstanceRequest{InstanceId: &resource.Id})
+			return isSweptResourceDeleted(err, response.LifecycleState == oci_core.InstanceLifecycleStateTerminated)
+		},
+	})
+	RegisterSweeperResource(&SweeperResource{
+		Name:           "KmsVault",
+		DependencyName: "vault",
+		ResourceClass:  "oci_kms_vault",
+		ListFn:         listKmsVaultsToSweep,
+		// The deletion of a vault is scheduled, its pending deletion does not block the deletion of its compartment
+		DeleteFn: func(clients *tf_client.OracleClients, resource *SweepableResource) error {
+			_, err := clients.KmsVaultClient().ScheduleVaultDeletion(context.Background(), oci_kms.ScheduleVaultDeletionRequest{VaultId: &resource.Id})
+			return err
+		},
+	})
+	RegisterSweeperResource(&SweeperResource{
+		Name:           "KmsKey",
+		DependencyName: "key",
+		ResourceClass:  "oci_kms_key",
+		ListFn:         listKmsKeysToSweep,
+		DeleteFn: func(clients *tf_client.OracleClients, resource *SweepableResource) error {
+			managementClient, err := clients.KmsManagementClientWithEndpoint(resource.RawResource.(sweepableKmsKey).managementEndpoint)
+			if err != nil {
+				return err
+			}
+			_, err = managementClient.ScheduleKeyDeletion(context.Background(), oci_kms.ScheduleKeyDeletionRequest{KeyId: &resource.Id})
+			return err
+		},
+	})
+}
+
+// isSweptResourceDeleted returns whether a resource is deleted from the error and the lifecycle state of its get
+// request, a resource not found is deleted
+func isSweptResourceDeleted(err error, deleted bool) (bool, error) {
+	if serviceError, ok := oci_common.IsServiceError(err); ok && serviceError.GetHTTPStatusCode() == http.StatusNotFound {
+		return true, nil
+	}
+	return deleted, err
+}
+
+func getSweepableTimeCreated(timeCreated *oci_common.SDKTime) time.Time {
+	if timeCreated == nil {
+		return time.Time{}
+	}
+	return timeCreated.Time
+}
+
+func listCoreVcnsToSweep(clients *tf_client.OracleClients, compartmentId string) ([]*SweepableResource, error) {
+	resources := make([]*SweepableResource, 0)
+	request := oci_core.ListVcnsRequest{CompartmentId: &compartmentId, LifecycleState: oci_core.VcnLifecycleStateAvailable}
+	for {
+		response, err := clients.VirtualNetworkClient().ListVcns(context.Background(), request)
+		if err != nil {
+			return nil, err
+		}
+		for _, vcn := range response.Items {
+			// The default resources of a vcn are deleted with the vcn
+			for _, defaultResourceId := range []*string{vcn.DefaultDhcpOptionsId, vcn.DefaultRouteTableId, vcn.DefaultSecurityListId} {
+				if defaultResourceId != nil {
+					SweeperDefaultResourceId[*defaultResourceId] = true
+				}
+			}
+			resources = append(resources, &SweepableResource{Id: *vcn.Id, DisplayName: *vcn.DisplayName, TimeCreated: getSweepableTimeCreated(vcn.TimeCreated), FreeformTags: vcn.FreeformTags, DefinedTags: vcn.DefinedTags, RawResource: vcn})
+		}
+		if request.Page = response.OpcNextPage; request.Page == nil {
+			return resources, nil
+		}
+	}
+}
+
+func listCoreSubnetsToSweep(clients *tf_client.OracleClients, compartmentId string) ([]*SweepableResource, error) {
+	resources := make([]*SweepableResource, 0)
+	request := oci_core.ListSubnetsRequest{CompartmentId: &compartmentId, LifecycleState: oci_core.SubnetLifecycleStateAvailable}
+	for {
+		response, err := clients.VirtualNetworkClient().ListSubnets(context.Background(), request)
+		if err != nil {
+			return nil, err
+		}
+		for _, subnet := range response.Items {
+			resources = append(resources, &SweepableResource{Id: *subnet.Id, DisplayName: *subnet.DisplayName, TimeCreated: getSweepableTimeCreated(subnet.TimeCreated), FreeformTags: subnet.FreeformTags, DefinedTags: subnet.DefinedTags, RawResource: subnet})
+		}
+		if request.Page = response.OpcNextPage; request.Page == nil {
+			return resources, nil
+		}
+	}
+}
+
+func listCoreSecurityListsToSweep(clients *tf_client.OracleClients, compartmentId string) ([]*SweepableResource, error) {
+	resources := make([]*SweepableResource, 0)
+	request := oci_core.ListSecurityListsRequest{CompartmentId: &compartmentId, LifecycleState: oci_core.SecurityListLifecycleStateAvailable}
+	for {
+		response, err := clients.VirtualNetworkClient().ListSecurityLists(context.Background(), request)
+		if err != nil {
+			return nil, err
+		}
+		for _, securityList := range response.Items {
+			resources = append(resources, &SweepableResource{Id: *securityList.Id, DisplayName: *securityList.DisplayName, TimeCreated: getSweepableTimeCreated(securityList.TimeCreated), FreeformTags: securityList.FreeformTags, DefinedTags: securityList.DefinedTags, RawResource: securityList})
+		}
+		if request.Page = response.OpcNextPage; request.Page == nil {
+			return resources, nil
+		}
+	}
+}
+
+func listCoreRouteTablesToSweep(clients *tf_client.OracleClients, compartmentId string) ([]*SweepableResource, error) {
+	resources := make([]*SweepableResource, 0)
+	request := oci_core.ListRouteTablesRequest{CompartmentId: &compartmentId, LifecycleState: oci_core.RouteTableLifecycleStateAvailable}
+	for {
+		response, err := clients.VirtualNetworkClient().ListRouteTables(context.Background(), request)
+		if err != nil {
+			return nil, err
+		}
+		for _, routeTable := range response.Items {
+			resources = append(resources, &SweepableResource{Id: *routeTable.Id, DisplayName: *routeTable.DisplayName, TimeCreated: getSweepableTimeCreated(routeTable.TimeCreated), FreeformTags: routeTable.FreeformTags, DefinedTags: routeTable.DefinedTags, RawResource: routeTable})
+		}
+		if request.Page = response.OpcNextPage; request.Page == nil {
+			return resources, nil
+		}
+	}
+}
+
+func listCoreInternetGatewaysToSweep(clients *tf_client.OracleClients, compartmentId string) ([]*SweepableResource, error) {
+	resources := make([]*SweepableResource, 0)
+	request := oci_core.ListInternetGatewaysRequest{CompartmentId: &compartmentId, LifecycleState: oci_core.InternetGatewayLifecycleStateAvailable}
+	for {
+		response, err := clients.VirtualNetworkClient().ListInternetGateways(context.Background(), request)
+		if err != nil {
+			return nil, err
+		}
+		for _, internetGateway := range response.Items {
+			resources = append(resources, &SweepableResource{Id: *internetGateway.Id, DisplayName: *internetGateway.DisplayName, TimeCreated: getSweepableTimeCreated(internetGateway.TimeCreated), FreeformTags: internetGateway.FreeformTags, DefinedTags: internetGateway.DefinedTags, RawResource: internetGateway})
+		}
+		if request.Page = response.OpcNextPage; request.Page == nil {
+			return resources, nil
+		}
+	}
+}
+
+func listCoreDhcpOptionsToSweep(clients *tf_client.OracleClients, compartmentId string) ([]*SweepableResource, error) {
+	resources := make([]*SweepableResource, 0)
+	request := oci_core.ListDhcpOptionsRequest{CompartmentId: &compartmentId, LifecycleState: oci_core.DhcpOptionsLifecycleStateAvailable}
+	for {
+		response, err := clients.VirtualNetworkClient().ListDhcpOptions(context.Background(), request)
+		if err != nil {
+			return nil, err
+		}
+		for _, dhcpOptions := range response.Items {
+			resources = append(resources, &SweepableResource{Id: *dhcpOptions.Id, DisplayName: *dhcpOptions.DisplayName, TimeCreated: getSweepableTimeCreated(dhcpOptions.TimeCreated), FreeformTags: dhcpOptions.FreeformTags, DefinedTags: dhcpOptions.DefinedTags, RawResource: dhcpOptions})
+		}
+		if request.Page = response.OpcNextPage; request.Page == nil {
+			return resources, nil
+		}
+	}
+}
+
+func listCoreInstancesToSweep(clients *tf_client.OracleClients, compartmentId string) ([]*SweepableResource, error) {
+	resources := make([]*SweepableResource, 0)
+	request := oci_core.ListInstancesRequest{CompartmentId: &compartmentId}
+	for {
+		response, err := clients.ComputeClient().ListInstances(context.Background(), request)
+		if err != nil {
+			return nil, err
+		}
+		for _, instance := range response.Items {
+			if instance.LifecycleState == oci_core.InstanceLifecycleStateTerminating || instance.LifecycleState == oci_core.InstanceLifecycleStateTerminated {
+				continue
+			}
+			resources = append(resources, &SweepableResource{Id: *instance.Id, DisplayName: *instance.DisplayName, TimeCreated: getSweepableTimeCreated(instance.TimeCreated), FreeformTags: instance.FreeformTags, DefinedTags: instance.DefinedTags, RawResource: instance})
+		}
+		if request.Page = response.OpcNextPage; request.Page == nil {
+			return resources, nil
+		}
+	}
+}
+
+func listActiveKmsVaults(clients *tf_client.OracleClients, compartmentId string) ([]oci_kms.VaultSummary, error) {
+	vaults := make([]oci_kms.VaultSummary, 0)
+	request := oci_kms.ListVaultsRequest{CompartmentId: &compartmentId}
+	for {
+		response, err := clients.KmsVaultClient().ListVaults(context.Background(), request)
+		if err != nil {
+			return nil, err
+		}
+		for _, vault := range response.Items {
+			if vault.LifecycleState == oci_kms.VaultSummaryLifecycleStateActive {
+				vaults = append(vaults, vault)
+			}
+		}
+		if request.Page = response.OpcNextPage; request.Page == nil {
+			return vaults, nil
+		}
+	}
+}
+
+func listKmsVaultsToSweep(clients *tf_client.OracleClients, compartmentId string) ([]*SweepableResource, error) {
+	vaults, err := listActiveKmsVaults(clients, compartmentId)
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]*SweepableResource, 0, len(vaults))
+	for _, vault := range vaults {
+		resources = append(resources, &SweepableResource{Id: *vault.Id, DisplayName: *vault.DisplayName, TimeCreated: getSweepableTimeCreated(vault.TimeCreated), FreeformTags: vault.FreeformTags, DefinedTags: vault.DefinedTags, RawResource: vault})
+	}
+	return resources, nil
+}
+
+// listKmsKeysToSweep lists the keys of the active vaults of a compartment, the keys of a vault pending deletion are
+// deleted with the vault
+func listKmsKeysToSweep(clients *tf_client.OracleClients, compartmentId string) ([]*SweepableResource, error) {
+	vaults, err := listActiveKmsVaults(clients, compartmentId)
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]*SweepableResource, 0)
+	for _, vault := range vaults {
+		managementClient, err := clients.KmsManagementClientWithEndpoint(*vault.ManagementEndpoint)
+		if err != nil {
+			return nil, err
+		}
+		request := oci_kms.ListKeysRequest{CompartmentId: &compartmentId}
+		for {
+			response, err := managementClient.ListKeys(context.Background(), request)
+			if err != nil {
+				return nil, err
+			}
+			for _, key := range response.Items {
+				if key.LifecycleState != oci_kms.KeySummaryLifecycleStateEnabled && key.LifecycleState != oci_kms.KeySummaryLifecycleStateDisabled {
+					continue
+				}
+				resources = append(resources, &SweepableResource{Id: *key.Id, DisplayName: *key.DisplayName, TimeCreated: getSweepableTimeCreated(key.TimeCreated), FreeformTags: key.FreeformTags, DefinedTags: key.DefinedTags, RawResource: sweepableKmsKey{key, *vault.ManagementEndpoint}})
+			}
+			if request.Page = response.OpcNextPage; request.Page == nil {
+				break
+			}
+		}
+	}
+	return resources, nil
+}
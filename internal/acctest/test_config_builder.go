@@ -0,0 +1,144 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl2/hcl"
+	"github.com/hashicorp/hcl2/hclwrite"
+)
+
+// Expression is a representation value rendered as a raw HCL expression rather than as a quoted string, e.g.
+// Representation{RepType: Required, Create: Expression(`each.value.cidr_block`)} renders cidr_block = each.value.cidr_block
+type Expression string
+
+// RepresentationDynamicGroup is rendered as a dynamic block generating a nested block for each element of ForEach. The
+// Content refers to the current element with the Iterator name, which defaults to the name of the block.
+type RepresentationDynamicGroup struct {
+	RepType  RepresentationType
+	ForEach  Expression
+	Iterator string
+	Content  map[string]interface{}
+}
+
+func generateDynamicBlock(prop string, representationType RepresentationType, representationMode RepresentationMode, representationDynamicGroup RepresentationDynamicGroup) string {
+	var buffer bytes.Buffer
+	buffer.WriteString(fmt.Sprintf(`dynamic "%s" {%s`, prop, lineSeparator))
+	buffer.WriteString(fmt.Sprintf("for_each = %s%s", representationDynamicGroup.ForEach, lineSeparator))
+	if representationDynamicGroup.Iterator != "" {
+		buffer.WriteString(fmt.Sprintf("iterator = %s%s", representationDynamicGroup.Iterator, lineSeparator))
+	}
+	buffer.WriteString(fmt.Sprintf("content %s", GenerateResourceFromMap(representationType, representationMode, representationDynamicGroup.Content)))
+	buffer.WriteString(fmt.Sprintf("}%s", lineSeparator))
+	return buffer.String()
+}
+
+// CountRepresentation is the count meta-argument of a resource, data source or module
+func CountRepresentation(count int) Representation {
+	return Representation{RepType: Required, Create: Expression(strconv.Itoa(count))}
+}
+
+// ForEachRepresentation is the for_each meta-argument of a resource, data source or module, e.g. ForEachRepresentation(`var.subnets`)
+func ForEachRepresentation(forEach Expression) Representation {
+	return Representation{RepType: Required, Create: forEach}
+}
+
+// DependsOnRepresentation is the depends_on meta-argument, the addresses are references like oci_core_vcn.test_vcn
+func DependsOnRepresentation(addresses ...string) Representation {
+	return Representation{RepType: Required, Create: Expression(fmt.Sprintf("[%s]", strings.Join(addresses, ", ")))}
+}
+
+// ProviderRepresentation is the provider meta-argument of a resource or data source using the provider configuration
+// with an alias, e.g. ProviderRepresentation("oci", "home") renders provider = oci.home
+func ProviderRepresentation(providerName string, alias string) Representation {
+	return Representation{RepType: Required, Create: Expression(providerName + "." + alias)}
+}
+
+// ModuleProvidersRepresentation is the providers meta-argument of a module, mapping the provider names of the module to
+// the provider configurations of the caller, e.g. {"oci": "oci.home"}
+func ModuleProvidersRepresentation(providers map[string]string) Representation {
+	names := make([]string, 0, len(providers))
+	for name := range providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buffer bytes.Buffer
+	buffer.WriteString("{" + lineSeparator)
+	for _, name := range names {
+		buffer.WriteString(fmt.Sprintf("%s = %s%s", name, providers[name], lineSeparator))
+	}
+	buffer.WriteString("}")
+	return Representation{RepType: Required, Create: Expression(buffer.String())}
+}
+
+// LifecycleRepresentation is the lifecycle block of a resource, the ignored changes are attribute references like defined_tags
+func LifecycleRepresentation(createBeforeDestroy bool, ignoreChanges ...string) RepresentationGroup {
+	group := map[string]interface{}{}
+	if createBeforeDestroy {
+		group["create_before_destroy"] = Representation{RepType: Required, Create: Expression("true")}
+	}
+	if len(ignoreChanges) > 0 {
+		group["ignore_changes"] = Representation{RepType: Required, Create: Expression(fmt.Sprintf("[%s]", strings.Join(ignoreChanges, ", ")))}
+	}
+	return RepresentationGroup{RepType: Required, Group: group}
+}
+
+// GenerateModuleFromRepresentationMap generates a module block calling the module in source with the representations as
+// its input variables and meta-arguments
+func GenerateModuleFromRepresentationMap(moduleName string, source string, representationType RepresentationType, representationMode RepresentationMode, representations map[string]interface{}) string {
+	return GenerateBlockFromRepresentationMap("module", []string{moduleName}, representationType, representationMode,
+		RepresentationCopyWithNewProperties(representations, map[string]interface{}{
+			"source": Representation{RepType: Required, Create: source},
+		}))
+}
+
+// GenerateProviderFromRepresentationMap generates a provider configuration, with an alias when alias is not empty
+func GenerateProviderFromRepresentationMap(providerName string, alias string, representations map[string]interface{}) string {
+	if alias != "" {
+		representations = RepresentationCopyWithNewProperties(representations, map[string]interface{}{
+			"alias": Representation{RepType: Required, Create: alias},
+		})
+	}
+	return GenerateBlockFromRepresentationMap("provider", []string{providerName}, Required, Create, representations)
+}
+
+// GenerateBlockFromRepresentationMap generates a top level block of any type, e.g. a variable, an output or a terraform block
+func GenerateBlockFromRepresentationMap(blockType string, labels []string, representationType RepresentationType, representationMode RepresentationMode, representations map[string]interface{}) string {
+	var buffer bytes.Buffer
+	buffer.WriteString(lineSeparator + blockType)
+	for _, label := range labels {
+		buffer.WriteString(fmt.Sprintf(` "%s"`, label))
+	}
+	buffer.WriteString(" " + GenerateResourceFromMap(representationType, representationMode, representations))
+	return buffer.String()
+}
+
+// ValidateConfigSyntax parses a generated config with hclwrite and checks that it is valid HCL which round-trips to the
+// formatted config. It only checks the syntax, the references and the arguments are checked by Terraform.
+func ValidateConfigSyntax(config string) error {
+	file, diags := hclwrite.ParseConfig([]byte(config), "config.tf", hcl.Pos{Line: 1, Column: 1})
+	if diags.HasErrors() {
+		return fmt.Errorf("the config is not valid HCL: %s", diags.Error())
+	}
+	if !bytes.Equal(file.Bytes(), hclwrite.Format([]byte(config))) {
+		return fmt.Errorf("the config does not round-trip through hclwrite, got:\n%s", file.Bytes())
+	}
+	return nil
+}
+
+// CheckConfigSyntax fails the test when a generated config is not valid HCL, and returns the config to be used in a test step
+func CheckConfigSyntax(t *testing.T, config string) string {
+	t.Helper()
+	if err := ValidateConfigSyntax(config); err != nil {
+		t.Fatalf("%v\n%s", err, config)
+	}
+	return config
+}
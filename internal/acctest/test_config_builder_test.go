@@ -0,0 +1,158 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitGenerateResourceFromRepresentationMap_metaArguments(t *testing.T) {
+	subnetRepresentation := map[string]interface{}{
+		"for_each":       ForEachRepresentation(`var.subnets`),
+		"provider":       ProviderRepresentation("oci", "home"),
+		"depends_on":     DependsOnRepresentation("oci_core_vcn.test_vcn", "oci_core_route_table.test_route_table"),
+		"lifecycle":      LifecycleRepresentation(true, "defined_tags", "freeform_tags"),
+		"cidr_block":     Representation{RepType: Required, Create: Expression(`each.value`), Update: `10.0.1.0/24`},
+		"compartment_id": Representation{RepType: Required, Create: `${var.compartment_id}`},
+		"vcn_id":         Representation{RepType: Required, Create: Expression(`oci_core_vcn.test_vcn.id`)},
+		"display_name":   Representation{RepType: Optional, Create: Expression(`"subnet-${each.key}"`)},
+	}
+
+	config := GenerateResourceFromRepresentationMap("oci_core_subnet", "test_subnet", Required, Create, subnetRepresentation)
+	assert.Equal(t, `
+resource "oci_core_subnet" "test_subnet" {
+cidr_block = each.value
+compartment_id = "${var.compartment_id}"
+depends_on = [oci_core_vcn.test_vcn, oci_core_route_table.test_route_table]
+for_each = var.subnets
+lifecycle {
+create_before_destroy = true
+ignore_changes = [defined_tags, freeform_tags]
+}
+provider = oci.home
+vcn_id = oci_core_vcn.test_vcn.id
+}
+`, config)
+	assert.NoError(t, ValidateConfigSyntax(config))
+
+	config = GenerateResourceFromRepresentationMap("oci_core_subnet", "test_subnet", Optional, Update, RepresentationCopyWithNewProperties(subnetRepresentation, map[string]interface{}{
+		"count": CountRepresentation(2),
+	}))
+	assert.Contains(t, config, `cidr_block = "10.0.1.0/24"`)
+	assert.Contains(t, config, `count = 2`)
+	assert.Contains(t, config, `display_name = "subnet-${each.key}"`)
+	assert.NoError(t, ValidateConfigSyntax(config))
+}
+
+func TestUnitGenerateResourceFromRepresentationMap_dynamicBlocks(t *testing.T) {
+	securityListRepresentation := map[string]interface{}{
+		"compartment_id": Representation{RepType: Required, Create: `${var.compartment_id}`},
+		"ingress_security_rules": RepresentationDynamicGroup{RepType: Required, ForEach: `var.ingress_rules`, Iterator: "rule", Content: map[string]interface{}{
+			"protocol": Representation{RepType: Required, Create: Expression(`rule.value.protocol`)},
+			"source":   Representation{RepType: Required, Create: Expression(`rule.value.source`)},
+			"tcp_options": RepresentationGroup{RepType: Optional, Group: map[string]interface{}{
+				"max": Representation{RepType: Required, Create: Expression(`rule.value.port`)},
+				"min": Representation{RepType: Required, Create: Expression(`rule.value.port`)},
+			}},
+		}},
+		"egress_security_rules": RepresentationDynamicGroup{RepType: Optional, ForEach: `var.egress_rules`, Content: map[string]interface{}{
+			"destination": Representation{RepType: Required, Create: Expression(`egress_security_rules.value`)},
+		}},
+	}
+
+	config := GenerateResourceFromRepresentationMap("oci_core_security_list", "test_security_list", Required, Create, securityListRepresentation)
+	assert.Equal(t, `
+resource "oci_core_security_list" "test_security_list" {
+compartment_id = "${var.compartment_id}"
+dynamic "ingress_security_rules" {
+for_each = var.ingress_rules
+iterator = rule
+content {
+protocol = rule.value.protocol
+source = rule.value.source
+}
+}
+}
+`, config)
+	assert.NoError(t, ValidateConfigSyntax(config))
+
+	updated := GetUpdatedRepresentationCopy("ingress_security_rules.tcp_options.max", Representation{RepType: Required, Create: Expression(`rule.value.port + 1`)}, securityListRepresentation)
+	config = GenerateResourceFromRepresentationMap("oci_core_security_list", "test_security_list", Optional, Create, updated)
+	assert.Contains(t, config, "max = rule.value.port + 1\n")
+	assert.Contains(t, config, "dynamic \"egress_security_rules\" {\nfor_each = var.egress_rules\ncontent {\n")
+	assert.NoError(t, ValidateConfigSyntax(config))
+
+	// The copies keep the dynamic blocks, without changing the original
+	removed := RepresentationCopyWithRemovedNestedProperties("ingress_security_rules.tcp_options", updated)
+	assert.NotContains(t, GenerateResourceFromRepresentationMap("oci_core_security_list", "test_security_list", Optional, Create, removed), "tcp_options")
+	assert.Contains(t, GenerateResourceFromRepresentationMap("oci_core_security_list", "test_security_list", Optional, Create, updated), "tcp_options")
+}
+
+func TestUnitGenerateModuleFromRepresentationMap(t *testing.T) {
+	config := GenerateBlockFromRepresentationMap("terraform", nil, Required, Create, map[string]interface{}{
+		"required_providers": RepresentationGroup{RepType: Required, Group: map[string]interface{}{
+			"oci": Representation{RepType: Required, Create: Expression("{\nsource = \"oracle/oci\"\n}")},
+		}},
+	}) +
+		GenerateProviderFromRepresentationMap("oci", "", map[string]interface{}{
+			"region": Representation{RepType: Required, Create: `${var.region}`},
+		}) +
+		GenerateProviderFromRepresentationMap("oci", "home", map[string]interface{}{
+			"region": Representation{RepType: Required, Create: `us-ashburn-1`},
+		}) +
+		GenerateModuleFromRepresentationMap("test_network", "./modules/network", Required, Create, map[string]interface{}{
+			"for_each":       ForEachRepresentation(`toset(["a", "b"])`),
+			"providers":      ModuleProvidersRepresentation(map[string]string{"oci": "oci.home", "oci.other": "oci"}),
+			"compartment_id": Representation{RepType: Required, Create: `${var.compartment_id}`},
+			"cidr_blocks":    Representation{RepType: Required, Create: []string{`10.0.0.0/16`}},
+		})
+
+	assert.Equal(t, `
+terraform {
+required_providers {
+oci = {
+source = "oracle/oci"
+}
+}
+}
+
+provider "oci" {
+region = "${var.region}"
+}
+
+provider "oci" {
+alias = "home"
+region = "us-ashburn-1"
+}
+
+module "test_network" {
+cidr_blocks = ["10.0.0.0/16"]
+compartment_id = "${var.compartment_id}"
+for_each = toset(["a", "b"])
+providers = {
+oci = oci.home
+oci.other = oci
+}
+source = "./modules/network"
+}
+`, config)
+	assert.NoError(t, ValidateConfigSyntax(config))
+	assert.Equal(t, config, CheckConfigSyntax(t, config))
+}
+
+func TestUnitValidateConfigSyntax(t *testing.T) {
+	for _, config := range []string{
+		`resource "oci_core_vcn" "test_vcn" {`,
+		`resource "oci_core_vcn" "test_vcn" { cidr_block = }`,
+		"resource \"oci_core_vcn\" \"test_vcn\" {\ncidr_block = [\"a\"\n}\n",
+		GenerateResourceFromRepresentationMap("oci_core_vcn", "test_vcn", Required, Create, map[string]interface{}{
+			"for_each": ForEachRepresentation(`{`),
+		}),
+	} {
+		assert.Error(t, ValidateConfigSyntax(config), config)
+	}
+	assert.NoError(t, ValidateConfigSyntax(""))
+}
@@ -226,6 +226,10 @@ func cloneRepresentation(representations map[string]interface{}) map[string]inte
 		if ok {
 			copyMap[key] = RepresentationGroup{representationGroup.RepType, cloneRepresentation(representationGroup.Group)}
 		}
+		representationDynamicGroup, ok := value.(RepresentationDynamicGroup)
+		if ok {
+			copyMap[key] = RepresentationDynamicGroup{representationDynamicGroup.RepType, representationDynamicGroup.ForEach, representationDynamicGroup.Iterator, cloneRepresentation(representationDynamicGroup.Content)}
+		}
 		representationGroupArr, ok := value.([]RepresentationGroup)
 		if ok {
 			representationGroupArrClone := make([]RepresentationGroup, len(representationGroupArr))
@@ -272,8 +276,11 @@ func updateNestedRepresentation(currIndex int, propertyNames []string, newValue
 	for prop := range representations {
 		if prop == propertyNames[currIndex] {
 			representationGroup, ok := representations[prop].(RepresentationGroup)
+			representationDynamicGroup, dynamicOk := representations[prop].(RepresentationDynamicGroup)
 			if ok && currIndex+1 < len(propertyNames) {
 				updateNestedRepresentation(currIndex+1, propertyNames, newValue, representationGroup.Group)
+			} else if dynamicOk && currIndex+1 < len(propertyNames) {
+				updateNestedRepresentation(currIndex+1, propertyNames, newValue, representationDynamicGroup.Content)
 			} else {
 				representations[prop] = newValue
 			}
@@ -303,8 +310,11 @@ func UpdateNestedRepresentationRemoveProperty(currIndex int, propertyNames []str
 	for prop := range representation {
 		if prop == propertyNames[currIndex] {
 			representationGroup, ok := representation[prop].(RepresentationGroup)
+			representationDynamicGroup, dynamicOk := representation[prop].(RepresentationDynamicGroup)
 			if ok && currIndex+1 < len(propertyNames) {
 				UpdateNestedRepresentationRemoveProperty(currIndex+1, propertyNames, representationGroup.Group)
+			} else if dynamicOk && currIndex+1 < len(propertyNames) {
+				UpdateNestedRepresentationRemoveProperty(currIndex+1, propertyNames, representationDynamicGroup.Content)
 			} else {
 				delete(representation, prop)
 			}
@@ -345,6 +355,11 @@ func GenerateResourceFromMap(representationType RepresentationType, representati
 				representationValue = representation.Update
 			}
 
+			repExpressionValue, expressionRep := representationValue.(Expression)
+			if expressionRep {
+				buffer.WriteString(fmt.Sprintf(`%s = %s%s`, prop, repExpressionValue, lineSeparator))
+			}
+
 			repStrValue, strRep := representationValue.(string)
 			if strRep {
 				buffer.WriteString(fmt.Sprintf(`%s = "%s"%s`, prop, repStrValue, lineSeparator))
@@ -388,6 +403,10 @@ func GenerateResourceFromMap(representationType RepresentationType, representati
 				}
 			}
 		}
+		representationDynamicGroup, ok := representations[prop].(RepresentationDynamicGroup)
+		if ok && representationDynamicGroup.RepType <= representationType {
+			buffer.WriteString(generateDynamicBlock(prop, representationType, representationMode, representationDynamicGroup))
+		}
 	}
 	buffer.WriteString(fmt.Sprintf("}%s", lineSeparator))
 	return buffer.String()
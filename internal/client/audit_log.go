@@ -0,0 +1,271 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+const auditRedactedValue = "[REDACTED]"
+
+// AuditLogVar records every request sent by the SDK clients configured by ConfigureClientVar. No requests are recorded if nil.
+var AuditLogVar *AuditLog
+
+var auditLogs = map[string]*AuditLog{}
+var auditLogsMutex sync.Mutex
+
+// Headers that carry credentials, never written to the audit log
+var auditSensitiveHeaders = map[string]bool{
+	"authorization":               true,
+	"opc-obo-token":               true,
+	"x-subject-token":             true,
+	"opc-security-token":          true,
+	"x-oci-security-token":        true,
+	"proxy-authorization":         true,
+	"cookie":                      true,
+	"set-cookie":                  true,
+	"x-amz-security-token":        true,
+	"opc-sse-customer-key":        true,
+	"opc-source-sse-customer-key": true,
+}
+
+// Headers worth recording to correlate a request with the service logs
+var auditRecordedHeaders = []string{"opc-request-id", "opc-client-request-id", "opc-retry-token", "opc-work-request-id", "opc-client-retries", "if-match"}
+
+var auditSensitiveQueryParameter = regexp.MustCompile(`(?i)(password|passphrase|secret|token|key|signature|credential)`)
+var auditOcidPathSegment = regexp.MustCompile(`^ocid1\.[^/]+$`)
+
+type auditContextKey struct{}
+
+type auditContext struct {
+	resourceType string
+	operation    string
+	calls        *auditCalls
+}
+
+// auditCalls numbers the attempts of the SDK calls sent with a context. The SDK sends every attempt of a call with the
+// context of the call, so a request following a request with the same method, URL and retry token is counted as a
+// retry until the call returns.
+type auditCalls struct {
+	mutex    sync.Mutex
+	attempts map[string]int
+}
+
+// WithAuditResource records the Terraform resource type and CRUD operation on the context, so that the requests sent
+// with it can be attributed to the resource in the audit log and their attempts numbered
+func WithAuditResource(ctx context.Context, resourceType string, operation string) context.Context {
+	return context.WithValue(ctx, auditContextKey{}, auditContext{resourceType: resourceType, operation: operation, calls: &auditCalls{attempts: map[string]int{}}})
+}
+
+// WithAuditCallEnd makes a retry policy drop the attempt count of an SDK call once the call returns, so that a later
+// call with the same method and URL is not counted as a retry of it
+func WithAuditCallEnd(policy *oci_common.RetryPolicy) *oci_common.RetryPolicy {
+	if policy == nil || policy.ShouldRetryOperation == nil {
+		return policy
+	}
+	shouldRetryOperation := policy.ShouldRetryOperation
+	policy.ShouldRetryOperation = func(response oci_common.OCIOperationResponse) bool {
+		if shouldRetryOperation(response) {
+			return true
+		}
+		if response.Response != nil && response.Response.HTTPResponse() != nil && response.Response.HTTPResponse().Request != nil {
+			req := response.Response.HTTPResponse().Request
+			if auditCtx, ok := req.Context().Value(auditContextKey{}).(auditContext); ok {
+				auditCtx.calls.end(auditAttemptKey(req))
+			}
+		}
+		return false
+	}
+	return policy
+}
+
+// attempt numbers the requests of the SDK call with key
+func (c *auditCalls) attempt(key string) int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.attempts[key]++
+	return c.attempts[key]
+}
+
+// end drops the attempt count of the SDK call with key once it returns
+func (c *auditCalls) end(key string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	delete(c.attempts, key)
+}
+
+func auditAttemptKey(req *http.Request) string {
+	key := req.Method + " " + req.Header.Get("opc-retry-token")
+	if req.URL != nil {
+		key += " " + req.URL.String()
+	}
+	return key
+}
+
+// AuditRecord is a single line of the audit log
+type AuditRecord struct {
+	Time              string            `json:"time"`
+	Operation         string            `json:"operation"`
+	ResourceType      string            `json:"resource_type,omitempty"`
+	ResourceOperation string            `json:"resource_operation,omitempty"`
+	Method            string            `json:"method"`
+	Host              string            `json:"host"`
+	Path              string            `json:"path"`
+	Query             string            `json:"query,omitempty"`
+	StatusCode        int               `json:"status_code,omitempty"`
+	LatencyMs         int64             `json:"latency_ms"`
+	Attempt           int               `json:"attempt"`
+	OpcRequestId      string            `json:"opc_request_id,omitempty"`
+	RequestHeaders    map[string]string `json:"request_headers,omitempty"`
+	Error             string            `json:"error,omitempty"`
+}
+
+// AuditLog writes a JSON line for every request to a file, to trace the calls made by the provider
+type AuditLog struct {
+	path string
+
+	mutex sync.Mutex
+	file  *os.File
+}
+
+// OpenAuditLog opens the audit log at path for appending, reusing it if it was already opened by another provider
+// configuration in this process
+func OpenAuditLog(path string) (*AuditLog, error) {
+	auditLogsMutex.Lock()
+	defer auditLogsMutex.Unlock()
+
+	if auditLog, ok := auditLogs[path]; ok {
+		return auditLog, nil
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open the audit log %s: %v", path, err)
+	}
+	auditLog := &AuditLog{path: path, file: file}
+	auditLogs[path] = auditLog
+	return auditLog, nil
+}
+
+// Dispatcher wraps the HTTP dispatcher of a client so that every request it sends is recorded in the audit log
+func (l *AuditLog) Dispatcher(dispatcher oci_common.HTTPRequestDispatcher) oci_common.HTTPRequestDispatcher {
+	return &auditDispatcher{dispatcher: dispatcher, auditLog: l}
+}
+
+func (l *AuditLog) write(record AuditRecord) {
+	line, err := json.Marshal(record)
+	if err != nil {
+		utils.Logf("[WARN] Unable to encode the audit record for %s: %v", record.Operation, err)
+		return
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if _, err := l.file.Write(append(line, '\n')); err != nil {
+		utils.Logf("[WARN] Unable to write to the audit log %s: %v", l.path, err)
+	}
+}
+
+type auditDispatcher struct {
+	dispatcher oci_common.HTTPRequestDispatcher
+	auditLog   *AuditLog
+}
+
+func (d *auditDispatcher) Do(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	response, err := d.dispatcher.Do(req)
+	latency := time.Since(start)
+
+	record := AuditRecord{
+		Time:           start.UTC().Format(time.RFC3339Nano),
+		Operation:      auditOperationName(req),
+		Method:         req.Method,
+		LatencyMs:      latency.Milliseconds(),
+		RequestHeaders: auditRequestHeaders(req.Header),
+	}
+	if req.URL != nil {
+		record.Host = req.URL.Host
+		record.Path = req.URL.Path
+		record.Query = auditRedactQuery(req.URL.Query())
+	}
+	if auditCtx, ok := req.Context().Value(auditContextKey{}).(auditContext); ok {
+		record.ResourceType = auditCtx.resourceType
+		record.ResourceOperation = auditCtx.operation
+		record.Attempt = auditCtx.calls.attempt(auditAttemptKey(req))
+	} else {
+		// The attempts of the requests sent outside of a resource operation are not tracked
+		record.Attempt = 1
+	}
+	if response != nil {
+		record.StatusCode = response.StatusCode
+		record.OpcRequestId = response.Header.Get("opc-request-id")
+	}
+	if record.OpcRequestId == "" {
+		record.OpcRequestId = req.Header.Get("opc-request-id")
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	d.auditLog.write(record)
+	return response, err
+}
+
+// auditOperationName names the operation after the method and the path, with the OCIDs replaced so that calls of
+// the same operation on different resources share a name
+func auditOperationName(req *http.Request) string {
+	if req.URL == nil {
+		return req.Method
+	}
+	segments := strings.Split(req.URL.Path, "/")
+	for i, segment := range segments {
+		if decoded, err := url.PathUnescape(segment); err == nil && auditOcidPathSegment.MatchString(decoded) {
+			segments[i] = "{id}"
+		}
+	}
+	return req.Method + " " + strings.Join(segments, "/")
+}
+
+func auditRequestHeaders(header http.Header) map[string]string {
+	headers := map[string]string{}
+	for _, name := range auditRecordedHeaders {
+		if value := header.Get(name); value != "" {
+			headers[name] = value
+		}
+	}
+	for name := range header {
+		if auditSensitiveHeaders[strings.ToLower(name)] {
+			headers[strings.ToLower(name)] = auditRedactedValue
+		}
+	}
+	if len(headers) == 0 {
+		return nil
+	}
+	return headers
+}
+
+func auditRedactQuery(query url.Values) string {
+	for name, values := range query {
+		if auditSensitiveQueryParameter.MatchString(name) {
+			for i := range values {
+				values[i] = auditRedactedValue
+			}
+		}
+	}
+	return query.Encode()
+}
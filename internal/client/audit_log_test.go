@@ -0,0 +1,144 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+)
+
+func readAuditRecords(t *testing.T, path string) []AuditRecord {
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Failed to open the audit log: %v", err)
+	}
+	defer file.Close()
+
+	var records []AuditRecord
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record AuditRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("Audit log line is not valid JSON: %s", scanner.Text())
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitAuditLog_dispatcher(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	auditLog, err := OpenAuditLog(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if reopened, _ := OpenAuditLog(path); reopened != auditLog {
+		t.Errorf("Expected the audit log to be reused for the same path")
+	}
+
+	base := &testDispatcher{response: &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Opc-Request-Id": {"ABC/DEF"}}}}
+	dispatcher := auditLog.Dispatcher(base)
+
+	ctx := WithAuditResource(context.Background(), "oci_core_vcn", "create")
+	for i := 0; i < 2; i++ {
+		req := newTestRequest(t, ctx, "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns/ocid1.vcn.oc1.phx.aaaa/actions/changeCompartment?secretId=s3cr3t&limit=10")
+		req.Header.Set("Authorization", "Signature keyId=\"tenancy/user/fingerprint\",signature=\"abc\"")
+		req.Header.Set("opc-retry-token", "token")
+		if _, err := dispatcher.Do(req); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	base.response = &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+	if _, err := dispatcher.Do(newTestRequest(t, context.Background(), "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns")); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	records := readAuditRecords(t, path)
+	if len(records) != 3 {
+		t.Fatalf("Expected 3 audit records, got %d", len(records))
+	}
+
+	record := records[1]
+	if record.Operation != "GET /20160918/vcns/{id}/actions/changeCompartment" {
+		t.Errorf("Unexpected operation %s", record.Operation)
+	}
+	if record.ResourceType != "oci_core_vcn" || record.ResourceOperation != "create" {
+		t.Errorf("Unexpected resource %s %s", record.ResourceType, record.ResourceOperation)
+	}
+	if record.StatusCode != http.StatusTooManyRequests || record.OpcRequestId != "ABC/DEF" || record.Attempt != 2 {
+		t.Errorf("Unexpected status %d, opc-request-id %s or attempt %d", record.StatusCode, record.OpcRequestId, record.Attempt)
+	}
+	if record.RequestHeaders["authorization"] != auditRedactedValue || record.RequestHeaders["opc-retry-token"] != "token" {
+		t.Errorf("Unexpected request headers %v", record.RequestHeaders)
+	}
+	if record.Query != "limit=10&secretId=%5BREDACTED%5D" {
+		t.Errorf("Expected the secret query parameter to be redacted, got %s", record.Query)
+	}
+
+	if records[2].Attempt != 1 || records[2].ResourceType != "" {
+		t.Errorf("Unexpected attempt %d or resource type %s for an unrelated request", records[2].Attempt, records[2].ResourceType)
+	}
+}
+
+type auditTestResponse struct {
+	response *http.Response
+}
+
+func (r auditTestResponse) HTTPResponse() *http.Response {
+	return r.response
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitAuditLog_attemptsPerCall(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	auditLog, err := OpenAuditLog(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	base := &testDispatcher{response: &http.Response{StatusCode: http.StatusConflict, Header: http.Header{}}}
+	dispatcher := auditLog.Dispatcher(base)
+	url := "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns/ocid1.vcn.oc1.phx.aaaa"
+
+	// The identical requests of two resource operations are numbered separately
+	vcnCtx := WithAuditResource(context.Background(), "oci_core_vcn", "read")
+	otherCtx := WithAuditResource(context.Background(), "oci_core_vcn", "read")
+	for _, ctx := range []context.Context{vcnCtx, vcnCtx, otherCtx} {
+		if _, err := dispatcher.Do(newTestRequest(t, ctx, url)); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	// The attempts of a call are dropped once the call returns, whatever the status of its last response
+	policy := WithAuditCallEnd(&oci_common.RetryPolicy{
+		ShouldRetryOperation: func(response oci_common.OCIOperationResponse) bool { return false },
+	})
+	response, _ := dispatcher.Do(newTestRequest(t, vcnCtx, url))
+	response.Request = newTestRequest(t, vcnCtx, url)
+	if policy.ShouldRetryOperation(oci_common.OCIOperationResponse{Response: auditTestResponse{response}}) {
+		t.Errorf("Expected the retry policy decision to be kept")
+	}
+	if _, err := dispatcher.Do(newTestRequest(t, vcnCtx, url)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var attempts []int
+	for _, record := range readAuditRecords(t, path) {
+		attempts = append(attempts, record.Attempt)
+	}
+	if fmt.Sprint(attempts) != "[1 2 1 3 1]" {
+		t.Errorf("Unexpected attempts %v", attempts)
+	}
+	if calls := vcnCtx.Value(auditContextKey{}).(auditContext).calls; len(calls.attempts) != 1 {
+		t.Errorf("Expected only the attempts of the pending call to be kept, got %v", calls.attempts)
+	}
+}
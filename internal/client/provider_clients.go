@@ -52,9 +52,18 @@ type OracleClients struct {
 	Configuration     map[string]string
 	SdkClientMap      map[string]interface{}
 	WorkRequestClient *oci_work_requests.WorkRequestClient
+
+	configProvider  oci_common.ConfigurationProvider
+	configureClient ConfigureClient
+	regions         *regionClients
+	// region is only set on the clients returned by ForRegion
+	region string
 }
 
 func (m *OracleClients) GetClient(name string) interface{} {
+	if m.region != "" {
+		return m.regionClient(name)
+	}
 	return m.SdkClientMap[name]
 }
 
@@ -63,7 +72,7 @@ func (m *OracleClients) GetClient(name string) interface{} {
 // here.
 func (m *OracleClients) FunctionsInvokeClientWithEndpoint(endpoint string) (*oci_functions.FunctionsInvokeClient, error) {
 	if client, err := oci_functions.NewFunctionsInvokeClientWithConfigurationProvider(*m.FunctionsInvokeClient().ConfigurationProvider(), endpoint); err == nil {
-		if err = ConfigureClientVar(&client.BaseClient); err != nil {
+		if err = limitedConfigureClient("oci_functions.FunctionsInvokeClient", ConfigureClientVar)(&client.BaseClient); err != nil {
 			return nil, err
 		}
 		return &client, nil
@@ -73,7 +82,7 @@ func (m *OracleClients) FunctionsInvokeClientWithEndpoint(endpoint string) (*oci
 }
 func (m *OracleClients) KmsCryptoClientWithEndpoint(endpoint string) (*oci_kms.KmsCryptoClient, error) {
 	if client, err := oci_kms.NewKmsCryptoClientWithConfigurationProvider(*m.KmsCryptoClient().ConfigurationProvider(), endpoint); err == nil {
-		if err = ConfigureClientVar(&client.BaseClient); err != nil {
+		if err = limitedConfigureClient("oci_kms.KmsCryptoClient", ConfigureClientVar)(&client.BaseClient); err != nil {
 			return nil, err
 		}
 		return &client, nil
@@ -84,7 +93,7 @@ func (m *OracleClients) KmsCryptoClientWithEndpoint(endpoint string) (*oci_kms.K
 
 func (m *OracleClients) KmsManagementClientWithEndpoint(endpoint string) (*oci_kms.KmsManagementClient, error) {
 	if client, err := oci_kms.NewKmsManagementClientWithConfigurationProvider(*m.KmsManagementClient().ConfigurationProvider(), endpoint); err == nil {
-		if err = ConfigureClientVar(&client.BaseClient); err != nil {
+		if err = limitedConfigureClient("oci_kms.KmsManagementClient", ConfigureClientVar)(&client.BaseClient); err != nil {
 			return nil, err
 		}
 		return &client, nil
@@ -126,7 +135,7 @@ func CreateSDKClients(clients *OracleClients, configProvider oci_common.Configur
 				serviceClientOverrides.HostUrlOverride = host
 			}
 
-			clients.SdkClientMap[serviceName], err = clientRegistration.InitClientFn(configProvider, configureClient, serviceClientOverrides)
+			clients.SdkClientMap[serviceName], err = clientRegistration.InitClientFn(configProvider, limitedConfigureClient(serviceName, configureClient), serviceClientOverrides)
 			if err != nil {
 				return err
 			}
@@ -139,11 +148,32 @@ func CreateSDKClients(clients *OracleClients, configProvider oci_common.Configur
 	if err != nil {
 		return
 	}
-	err = configureClient(&workRequestClient.BaseClient)
+	err = limitedConfigureClient(WorkRequestClientName, configureClient)(&workRequestClient.BaseClient)
 	if err != nil {
 		return
 	}
+	if host, ok := clientHostOverrides[WorkRequestClientName]; ok {
+		workRequestClient.Host = host
+	}
 	clients.WorkRequestClient = &workRequestClient
 
+	clients.configProvider = configProvider
+	clients.configureClient = configureClient
+	clients.regions = &regionClients{provider: clients, byRegion: map[string]*OracleClients{}}
+
 	return
 }
+
+// limitedConfigureClient applies the request limits configured for serviceName, if any, after configuring the client
+func limitedConfigureClient(serviceName string, configureClient ConfigureClient) ConfigureClient {
+	if RequestLimiterVar == nil {
+		return configureClient
+	}
+	return func(client *oci_common.BaseClient) error {
+		if err := configureClient(client); err != nil {
+			return err
+		}
+		client.HTTPClient = RequestLimiterVar.Dispatcher(serviceName, client.HTTPClient)
+		return nil
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package client
+
+import (
+	"fmt"
+	"sync"
+
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+	oci_work_requests "github.com/oracle/oci-go-sdk/v65/workrequests"
+)
+
+// regionConfigurationProvider overrides the region of a configuration provider, so that the clients created with it
+// send their requests to that region while authenticating the same way as the provider's clients
+type regionConfigurationProvider struct {
+	oci_common.ConfigurationProvider
+	region string
+}
+
+func (p regionConfigurationProvider) Region() (string, error) {
+	return p.region, nil
+}
+
+// Refreshable forwards the Refreshable method of the wrapped provider, which the embedded interface hides, so that the
+// clients of another region refresh their token on a 401 like the provider's clients
+func (p regionConfigurationProvider) Refreshable() bool {
+	if refreshable, ok := p.ConfigurationProvider.(oci_common.RefreshableConfigurationProvider); ok {
+		return refreshable.Refreshable()
+	}
+	return false
+}
+
+// regionClients caches the clients created for the regions other than the provider's region
+type regionClients struct {
+	provider *OracleClients
+
+	mutex    sync.Mutex
+	byRegion map[string]*OracleClients
+}
+
+// ForRegion returns the clients bound to region, so that a resource can be managed in another region than the one of
+// its provider. The clients of the provider itself are returned if region is empty or the provider's region. The
+// clients of another region are cached for the lifetime of the provider, and each of their service clients is created
+// on its first use.
+func (m *OracleClients) ForRegion(region string) (*OracleClients, error) {
+	if region == "" {
+		return m, nil
+	}
+	region = string(oci_common.StringToRegion(region))
+	if m.region == region {
+		return m, nil
+	}
+	if m.regions == nil || m.configProvider == nil {
+		return nil, fmt.Errorf("clients for region %s can not be created, the provider is not configured", region)
+	}
+	if providerRegion, err := m.regions.provider.configProvider.Region(); err == nil && string(oci_common.StringToRegion(providerRegion)) == region {
+		return m.regions.provider, nil
+	}
+
+	m.regions.mutex.Lock()
+	defer m.regions.mutex.Unlock()
+	if clients, ok := m.regions.byRegion[region]; ok {
+		return clients, nil
+	}
+
+	configProvider := regionConfigurationProvider{ConfigurationProvider: m.regions.provider.configProvider, region: region}
+	for serviceName, clientRegistration := range OracleClientRegistrationsVar.RegisteredClients {
+		if clientRegistration.InitClientFn == nil {
+			return nil, fmt.Errorf("unable to initialize '%s' client for region %s", serviceName, region)
+		}
+	}
+
+	// The work request client is created with the region, which checks that the clients of the region can be created
+	workRequestClient, err := oci_work_requests.NewWorkRequestClientWithConfigurationProvider(configProvider)
+	if err != nil {
+		return nil, err
+	}
+	if err = limitedConfigureClient(WorkRequestClientName, m.configureClient)(&workRequestClient.BaseClient); err != nil {
+		return nil, err
+	}
+
+	configuration := make(map[string]string, len(m.Configuration))
+	for key, value := range m.Configuration {
+		configuration[key] = value
+	}
+	configuration["region"] = region
+
+	clients := &OracleClients{
+		Configuration:     configuration,
+		SdkClientMap:      make(map[string]interface{}, len(OracleClientRegistrationsVar.RegisteredClients)),
+		WorkRequestClient: &workRequestClient,
+		configProvider:    configProvider,
+		configureClient:   m.configureClient,
+		region:            region,
+		regions:           m.regions,
+	}
+	m.regions.byRegion[region] = clients
+	return clients, nil
+}
+
+// regionClient returns the client of serviceName for the region of the clients returned by ForRegion, creating it on
+// its first use. ForRegion created the work request client of the region with the same configuration provider, so the
+// other clients of the region can be created as well.
+func (m *OracleClients) regionClient(serviceName string) interface{} {
+	m.regions.mutex.Lock()
+	defer m.regions.mutex.Unlock()
+
+	if client, ok := m.SdkClientMap[serviceName]; ok {
+		return client
+	}
+	clientRegistration, ok := OracleClientRegistrationsVar.RegisteredClients[serviceName]
+	if !ok {
+		return nil
+	}
+	// Host overrides are specific to the provider's region, they do not apply to the clients of other regions
+	client, err := clientRegistration.InitClientFn(m.configProvider, limitedConfigureClient(serviceName, m.configureClient), ServiceClientOverrides{})
+	if err != nil {
+		panic(fmt.Sprintf("unable to initialize '%s' client for region %s: %v", serviceName, m.region, err))
+	}
+	m.SdkClientMap[serviceName] = client
+	return client
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package client
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"testing"
+
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+)
+
+func newTestOracleClients(t *testing.T) *OracleClients {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	privateKey := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+	configProvider := oci_common.NewRawConfigurationProvider("ocid1.tenancy.oc1..aaaa", "ocid1.user.oc1..aaaa", "us-phoenix-1", "fingerprint", privateKey, nil)
+
+	clients := &OracleClients{
+		SdkClientMap:  make(map[string]interface{}, len(OracleClientRegistrationsVar.RegisteredClients)),
+		Configuration: map[string]string{"region": "us-phoenix-1"},
+	}
+	if err := CreateSDKClients(clients, configProvider, func(client *oci_common.BaseClient) error { return nil }); err != nil {
+		t.Fatalf("Failed to create clients: %v", err)
+	}
+	return clients
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitOracleClients_ForRegion(t *testing.T) {
+	clients := newTestOracleClients(t)
+
+	for _, region := range []string{"", "us-phoenix-1", "phx"} {
+		if res, err := clients.ForRegion(region); err != nil || res != clients {
+			t.Errorf("Expected the provider's clients for region '%s', got %v", region, err)
+		}
+	}
+
+	ashburn, err := clients.ForRegion("iad")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if ashburn == clients || ashburn.Configuration["region"] != "us-ashburn-1" {
+		t.Errorf("Expected separate clients for us-ashburn-1, got region %s", ashburn.Configuration["region"])
+	}
+	if cached, _ := clients.ForRegion("us-ashburn-1"); cached != ashburn {
+		t.Errorf("Expected the clients of a region to be cached")
+	}
+	if provider, _ := ashburn.ForRegion("us-phoenix-1"); provider != clients {
+		t.Errorf("Expected the provider's clients from the clients of another region")
+	}
+	if !strings.Contains(ashburn.WorkRequestClient.Host, "us-ashburn-1") {
+		t.Errorf("Expected the work request client to send requests to us-ashburn-1, got %s", ashburn.WorkRequestClient.Host)
+	}
+
+	if len(ashburn.SdkClientMap) != 0 {
+		t.Errorf("Expected the clients of another region to be created on their first use, got %d clients", len(ashburn.SdkClientMap))
+	}
+	client, ok := ashburn.GetClient("oci_core.VirtualNetworkClient").(interface{ Endpoint() string })
+	if !ok || !strings.Contains(client.Endpoint(), "us-ashburn-1") {
+		t.Errorf("Expected a client sending requests to us-ashburn-1")
+	}
+	if len(ashburn.SdkClientMap) != 1 {
+		t.Errorf("Expected only the used client of another region to be created, got %d clients", len(ashburn.SdkClientMap))
+	}
+	if ashburn.GetClient("oci_core.VirtualNetworkClient") != client {
+		t.Errorf("Expected the client to be cached")
+	}
+	if !strings.Contains(clients.GetClient("oci_core.VirtualNetworkClient").(interface{ Endpoint() string }).Endpoint(), "us-phoenix-1") {
+		t.Errorf("Expected the provider's client to be left in us-phoenix-1")
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitOracleClients_ForRegionNotConfigured(t *testing.T) {
+	clients := &OracleClients{SdkClientMap: map[string]interface{}{}}
+	if _, err := clients.ForRegion("us-ashburn-1"); err == nil {
+		t.Errorf("Expected an error for clients that were not created by CreateSDKClients")
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitOracleClients_ForRegionClientInitError(t *testing.T) {
+	clients := newTestOracleClients(t)
+	registration := OracleClientRegistrationsVar.RegisteredClients["oci_core.VirtualNetworkClient"]
+	defer func() { OracleClientRegistrationsVar.RegisteredClients["oci_core.VirtualNetworkClient"] = registration }()
+	OracleClientRegistrationsVar.RegisteredClients["oci_core.VirtualNetworkClient"] = &OracleClient{
+		InitClientFn: func(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
+			return nil, fmt.Errorf("init failed")
+		},
+	}
+
+	ashburn, err := clients.ForRegion("us-ashburn-1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer func() {
+		if r := recover(); r == nil || !strings.Contains(fmt.Sprint(r), "oci_core.VirtualNetworkClient") {
+			t.Errorf("Expected a panic naming the client which could not be initialized, got %v", r)
+		}
+	}()
+	ashburn.GetClient("oci_core.VirtualNetworkClient")
+}
+
+type refreshableConfigurationProvider struct {
+	oci_common.ConfigurationProvider
+}
+
+func (p refreshableConfigurationProvider) Refreshable() bool {
+	return true
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitRegionConfigurationProvider_Refreshable(t *testing.T) {
+	clients := newTestOracleClients(t)
+	if (regionConfigurationProvider{ConfigurationProvider: clients.configProvider, region: "us-ashburn-1"}).Refreshable() {
+		t.Errorf("Expected a provider which is not refreshable not to be made refreshable")
+	}
+	var configProvider oci_common.ConfigurationProvider = regionConfigurationProvider{ConfigurationProvider: refreshableConfigurationProvider{clients.configProvider}, region: "us-ashburn-1"}
+	if refreshable, ok := configProvider.(oci_common.RefreshableConfigurationProvider); !ok || !refreshable.Refreshable() {
+		t.Errorf("Expected the Refreshable method of the wrapped provider to be forwarded")
+	}
+}
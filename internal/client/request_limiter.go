@@ -0,0 +1,202 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package client
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+// DefaultRequestLimitName is the request limit applied to every client without a limit of its own
+const DefaultRequestLimitName = "default"
+
+// WorkRequestClientName is the request limit name of the work request client, which is not part of OracleClients.SdkClientMap
+const WorkRequestClientName = "oci_work_requests.WorkRequestClient"
+
+// RequestLimiterVar throttles the requests of the SDK clients created by CreateSDKClients. No throttling is done if nil.
+var RequestLimiterVar *RequestLimiter
+
+// RequestLimit configures a token bucket for a client, keyed by the client name used in OracleClients.SdkClientMap
+type RequestLimit struct {
+	RequestsPerSecond float64
+	Burst             int
+	// PerEndpoint keeps a separate bucket for every host the client sends requests to
+	PerEndpoint bool
+}
+
+// RequestLimiter proactively throttles requests per client and caps the number of requests in flight, so that
+// high Terraform parallelism does not run into TooManyRequests errors from the services.
+type RequestLimiter struct {
+	limits   map[string]RequestLimit
+	inFlight chan struct{}
+
+	mutex   sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+func NewRequestLimiter(limits map[string]RequestLimit, maxInFlightRequests int) *RequestLimiter {
+	limiter := &RequestLimiter{
+		limits:  limits,
+		buckets: map[string]*tokenBucket{},
+	}
+	if maxInFlightRequests > 0 {
+		limiter.inFlight = make(chan struct{}, maxInFlightRequests)
+	}
+	return limiter
+}
+
+// Dispatcher wraps the HTTP dispatcher of the client registered as serviceName with the configured limits
+func (l *RequestLimiter) Dispatcher(serviceName string, dispatcher oci_common.HTTPRequestDispatcher) oci_common.HTTPRequestDispatcher {
+	limit, ok := l.limits[serviceName]
+	if !ok {
+		limit, ok = l.limits[DefaultRequestLimitName]
+	}
+	if !ok && l.inFlight == nil {
+		return dispatcher
+	}
+	return &limitedDispatcher{
+		dispatcher:  dispatcher,
+		limiter:     l,
+		serviceName: serviceName,
+		limit:       limit,
+		hasLimit:    ok,
+	}
+}
+
+func (l *RequestLimiter) bucket(key string, limit RequestLimit) *tokenBucket {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if b, ok := l.buckets[key]; ok {
+		return b
+	}
+	b := newTokenBucket(limit.RequestsPerSecond, limit.Burst)
+	l.buckets[key] = b
+	return b
+}
+
+type limitedDispatcher struct {
+	dispatcher  oci_common.HTTPRequestDispatcher
+	limiter     *RequestLimiter
+	serviceName string
+	limit       RequestLimit
+	hasLimit    bool
+}
+
+func (d *limitedDispatcher) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	var bucket *tokenBucket
+	if d.hasLimit {
+		key := d.serviceName
+		if d.limit.PerEndpoint && req.URL != nil {
+			key = key + "/" + req.URL.Host
+		}
+		bucket = d.limiter.bucket(key, d.limit)
+		if err := bucket.wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if d.limiter.inFlight != nil {
+		select {
+		case d.limiter.inFlight <- struct{}{}:
+			defer func() { <-d.limiter.inFlight }()
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	response, err := d.dispatcher.Do(req)
+
+	// Hold back the following requests of this client for as long as the service asked for
+	if bucket != nil && response != nil && response.StatusCode == http.StatusTooManyRequests {
+		if retryAfter, ok := utils.RetryAfterDuration(response); ok {
+			utils.Debugf("[DEBUG] %s was throttled, pausing requests for %v", d.serviceName, retryAfter)
+			bucket.pause(retryAfter)
+		}
+	}
+	return response, err
+}
+
+// tokenBucket is a token bucket that refills at rate tokens per second up to burst tokens
+type tokenBucket struct {
+	mutex       sync.Mutex
+	rate        float64
+	burst       float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = int(math.Max(1, math.Ceil(rate)))
+	}
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token and returns how long the caller has to wait before using it
+func (b *tokenBucket) reserve() time.Duration {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	now := time.Now()
+	if b.rate > 0 {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	b.last = now
+
+	var wait time.Duration
+	if b.pausedUntil.After(now) {
+		wait = b.pausedUntil.Sub(now)
+	}
+	if b.rate <= 0 {
+		return wait
+	}
+
+	b.tokens--
+	if b.tokens < 0 {
+		if tokenWait := time.Duration(-b.tokens / b.rate * float64(time.Second)); tokenWait > wait {
+			wait = tokenWait
+		}
+	}
+	return wait
+}
+
+func (b *tokenBucket) wait(ctx context.Context) error {
+	wait := b.reserve()
+	if wait <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *tokenBucket) pause(d time.Duration) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if until := time.Now().Add(d); until.After(b.pausedUntil) {
+		b.pausedUntil = until
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+)
+
+type testDispatcher struct {
+	inFlight    int32
+	maxInFlight int32
+	delay       time.Duration
+	response    *http.Response
+}
+
+func (d *testDispatcher) Do(req *http.Request) (*http.Response, error) {
+	current := atomic.AddInt32(&d.inFlight, 1)
+	defer atomic.AddInt32(&d.inFlight, -1)
+	for {
+		max := atomic.LoadInt32(&d.maxInFlight)
+		if current <= max || atomic.CompareAndSwapInt32(&d.maxInFlight, max, current) {
+			break
+		}
+	}
+	time.Sleep(d.delay)
+	if d.response != nil {
+		return d.response, nil
+	}
+	return &http.Response{StatusCode: http.StatusOK}, nil
+}
+
+func newTestRequest(t *testing.T, ctx context.Context, url string) *http.Request {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	return req
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitRequestLimiter_rateLimit(t *testing.T) {
+	limiter := NewRequestLimiter(map[string]RequestLimit{"oci_identity.IdentityClient": {RequestsPerSecond: 20, Burst: 2}}, 0)
+	dispatcher := limiter.Dispatcher("oci_identity.IdentityClient", &testDispatcher{})
+
+	start := time.Now()
+	for i := 0; i < 6; i++ {
+		if _, err := dispatcher.Do(newTestRequest(t, context.Background(), "https://identity.us-phoenix-1.oraclecloud.com")); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	// The burst allows 2 requests immediately, the other 4 have to wait 50ms each
+	if elapsed := time.Since(start); elapsed < 180*time.Millisecond {
+		t.Errorf("Expected requests to be throttled, but they completed in %v", elapsed)
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitRequestLimiter_unlimitedService(t *testing.T) {
+	base := &testDispatcher{}
+	limiter := NewRequestLimiter(map[string]RequestLimit{"oci_identity.IdentityClient": {RequestsPerSecond: 1}}, 0)
+	if dispatcher := limiter.Dispatcher("oci_core.VirtualNetworkClient", base); dispatcher != base {
+		t.Errorf("Expected the dispatcher of a client without limits to be left as is")
+	}
+
+	limiter = NewRequestLimiter(map[string]RequestLimit{DefaultRequestLimitName: {RequestsPerSecond: 1}}, 0)
+	if dispatcher := limiter.Dispatcher("oci_core.VirtualNetworkClient", base); dispatcher == base {
+		t.Errorf("Expected the default limit to apply to a client without limits")
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitRequestLimiter_maxInFlightRequests(t *testing.T) {
+	base := &testDispatcher{delay: 20 * time.Millisecond}
+	limiter := NewRequestLimiter(nil, 2)
+	dispatchers := []oci_common.HTTPRequestDispatcher{
+		limiter.Dispatcher("oci_identity.IdentityClient", base),
+		limiter.Dispatcher("oci_core.VirtualNetworkClient", base),
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if _, err := dispatchers[i%2].Do(newTestRequest(t, context.Background(), "https://example.com")); err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if base.maxInFlight > 2 {
+		t.Errorf("Expected at most 2 requests in flight, but got %d", base.maxInFlight)
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitRequestLimiter_retryAfter(t *testing.T) {
+	base := &testDispatcher{response: &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"1"}}}}
+	limiter := NewRequestLimiter(map[string]RequestLimit{"oci_identity.IdentityClient": {RequestsPerSecond: 100}}, 0)
+	dispatcher := limiter.Dispatcher("oci_identity.IdentityClient", base)
+
+	if _, err := dispatcher.Do(newTestRequest(t, context.Background(), "https://example.com")); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// The next request is held back until the Retry-After expires, so it is cancelled by the shorter deadline
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err := dispatcher.Do(newTestRequest(t, ctx, "https://example.com")); err != context.DeadlineExceeded {
+		t.Errorf("Expected the request to wait for the Retry-After, but got %v", err)
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitRequestLimiter_perEndpoint(t *testing.T) {
+	limiter := NewRequestLimiter(map[string]RequestLimit{"oci_kms.KmsCryptoClient": {RequestsPerSecond: 1, Burst: 1, PerEndpoint: true}}, 0)
+	dispatcher := limiter.Dispatcher("oci_kms.KmsCryptoClient", &testDispatcher{})
+
+	start := time.Now()
+	for _, url := range []string{"https://vault1.example.com", "https://vault2.example.com"} {
+		if _, err := dispatcher.Do(newTestRequest(t, context.Background(), url)); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Expected separate endpoints not to share a bucket, but requests took %v", elapsed)
+	}
+}
@@ -4,12 +4,15 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
 	"regexp"
 	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 )
 
@@ -55,10 +58,43 @@ func (ociRes *OCIResource) GetHCLString(builder *strings.Builder, interpolationM
 		}
 	}
 
+	getHclStringFn := GetHclStringFromGenericMap
 	if ociRes.GetHclStringFn != nil {
-		return ociRes.GetHclStringFn(builder, ociRes, resourceInterpolationMap)
+		getHclStringFn = ociRes.GetHclStringFn
 	}
-	return GetHclStringFromGenericMap(builder, ociRes, resourceInterpolationMap)
+	if ociRes.Region == "" {
+		return getHclStringFn(builder, ociRes, resourceInterpolationMap)
+	}
+
+	// Bind the resources of the other regions to the provider alias of their region
+	resourceBuilder := &strings.Builder{}
+	if err := getHclStringFn(resourceBuilder, ociRes, resourceInterpolationMap); err != nil {
+		return err
+	}
+	hcl := resourceBuilder.String()
+	if idx := strings.Index(hcl, "{\n"); idx >= 0 {
+		hcl = fmt.Sprintf("%sprovider = %s\n%s", hcl[:idx+2], GetRegionProviderReference(ociRes.Region), hcl[idx+2:])
+	}
+	builder.WriteString(hcl)
+	return nil
+}
+
+// GetRegionProviderAlias returns the alias of the provider configuration of a region, e.g. us_phoenix_1 for us-phoenix-1
+func GetRegionProviderAlias(region string) string {
+	return strings.Replace(region, "-", "_", -1)
+}
+
+// GetRegionProviderReference returns the reference to the provider configuration of a region, e.g. oci.us_phoenix_1
+func GetRegionProviderReference(region string) string {
+	return fmt.Sprintf("oci.%s", GetRegionProviderAlias(region))
+}
+
+// GetRegionClients returns the clients of a region, or the clients of the provider configuration for an empty region
+func (ctx *ResourceDiscoveryContext) GetRegionClients(region string) *tf_client.OracleClients {
+	if clients, exists := ctx.RegionClients[region]; exists {
+		return clients
+	}
+	return ctx.Clients
 }
 
 func (tr *TerraformResource) GetHclReferenceIdString() string {
@@ -84,6 +120,16 @@ func GetHCLStringFromMap(builder *strings.Builder, sourceAttributes map[string]i
 		}
 
 		if attributeVal, exists := sourceAttributes[tfAttribute]; exists {
+			if tfSchema.Sensitive && !isEmptyAttributeValue(attributeVal) {
+				if elem, isBlock := tfSchema.Elem.(*schema.Resource); isBlock {
+					// The attributes of a sensitive block are replaced by a variable each
+					tfSchema = &schema.Schema{Type: tfSchema.Type, Optional: tfSchema.Optional, Required: tfSchema.Required, Elem: getSensitiveResource(elem)}
+				} else {
+					variableName := addSensitiveVar(ociRes, attributePrefix, tfAttribute)
+					builder.WriteString(fmt.Sprintf("%s = %s\n", tfAttribute, TfHclVersionvar.GetVarHclString(variableName)))
+					continue
+				}
+			}
 			utils.Debugf("Writing attribute %s and value %s", tfAttribute, attributeVal)
 			switch v := attributeVal.(type) {
 			case InterpolationString:
@@ -114,6 +160,9 @@ func GetHCLStringFromMap(builder *strings.Builder, sourceAttributes map[string]i
 				case schema.TypeList, schema.TypeSet:
 					switch elem := tfSchema.Elem.(type) {
 					case *schema.Resource:
+						if IsTfVersion1() && writeDynamicBlock(builder, tfAttribute, v, elem, interpolationMap) {
+							continue
+						}
 						for i, item := range v {
 							if val := item.(map[string]interface{}); val != nil {
 								builder.WriteString(fmt.Sprintf("%s {\n", tfAttribute))
@@ -249,6 +298,9 @@ func GetHCLStringFromMap(builder *strings.Builder, sourceAttributes map[string]i
 				ociRes.TerraformTypeInfo.IgnorableRequiredMissingAttributes[attributePrefix+"."+tfAttribute] = true
 			}
 
+		} else if tfSchema.Optional && tfAttribute == globalvar.RegionOverrideAttrName {
+			// Resources are discovered in the region of the provider unless the region is overridden
+			continue
 		} else if tfSchema.Optional {
 			utils.Logf("[INFO] Optional TF attribute '%s' not found in source\n", tfAttribute)
 			builder.WriteString(fmt.Sprintf("#%s = <<Optional value not found in discovery>>\n", tfAttribute))
@@ -324,6 +376,86 @@ func (args *ExportCommandArgs) Validate() error {
 		return fmt.Errorf("[ERROR] invalid value for arument parallelism, specify a value >= 1")
 	}
 
+	switch args.ImportMode {
+	case "", ImportModeCli, ImportModeNative:
+	case ImportModeBlocks:
+		if args.GenerateState {
+			return fmt.Errorf("[ERROR] generate_state can not be used with import_mode '%s', the import blocks are applied by terraform plan and apply", ImportModeBlocks)
+		}
+		if args.TFVersion != nil && *args.TFVersion != nil && (*args.TFVersion).ToString() == string(TfVersion11) {
+			return fmt.Errorf("[ERROR] import_mode '%s' requires tf_version %s", ImportModeBlocks, TfVersion12)
+		}
+	default:
+		return fmt.Errorf("[ERROR] invalid value for argument import_mode '%s', supported values: %s, %s, %s", args.ImportMode, ImportModeCli, ImportModeBlocks, ImportModeNative)
+	}
+
+	switch args.OutputLayout {
+	case "", OutputLayoutFlat:
+	case OutputLayoutModules:
+		if args.TFVersion != nil && *args.TFVersion != nil && (*args.TFVersion).ToString() == string(TfVersion11) {
+			return fmt.Errorf("[ERROR] output_layout '%s' requires tf_version %s", OutputLayoutModules, TfVersion12)
+		}
+		if args.GenerateState && args.ImportMode != ImportModeNative {
+			return fmt.Errorf("[ERROR] output_layout '%s' requires import_mode '%s' to generate the state file", OutputLayoutModules, ImportModeNative)
+		}
+	default:
+		return fmt.Errorf("[ERROR] invalid value for argument output_layout '%s', supported values: %s, %s", args.OutputLayout, OutputLayoutFlat, OutputLayoutModules)
+	}
+
+	if ResourceFilters, err = extractResourceFilters(args.Filters); err != nil {
+		utils.Logln(err.Error())
+		return err
+	}
+	if len(ResourceFilters) > 0 && len(args.IDs) > 0 {
+		return fmt.Errorf("[ERROR] filter can not be used with ids, the resources to export are already specified")
+	}
+
+	if len(args.Regions) > 0 {
+		if len(args.IDs) > 0 {
+			return fmt.Errorf("[ERROR] regions can not be used with ids, the resources of ids are discovered in the region of the provider configuration")
+		}
+		if args.TFVersion != nil && *args.TFVersion != nil && (*args.TFVersion).ToString() == string(TfVersion11) {
+			return fmt.Errorf("[ERROR] regions requires tf_version %s", TfVersion12)
+		}
+		if args.GenerateState && args.ImportMode != ImportModeNative {
+			return fmt.Errorf("[ERROR] regions requires import_mode '%s' to generate the state file", ImportModeNative)
+		}
+		regions := map[string]bool{}
+		for _, region := range args.Regions {
+			if region == "" || regions[region] {
+				return fmt.Errorf("[ERROR] invalid value for argument regions, the regions should be distinct and not empty")
+			}
+			regions[region] = true
+		}
+	}
+
+	if len(args.CompartmentIds) > 0 {
+		if (args.CompartmentId != nil && *args.CompartmentId != "") || (args.CompartmentName != nil && *args.CompartmentName != "") {
+			return fmt.Errorf("[ERROR] compartment_ids can not be used with compartment_id or compartment_name")
+		}
+		if len(args.IDs) > 0 {
+			return fmt.Errorf("[ERROR] compartment_ids can not be used with ids")
+		}
+	}
+	if args.CompartmentDepth < 0 {
+		return fmt.Errorf("[ERROR] invalid value for argument compartment_depth, specify a value >= 0")
+	}
+	if args.CompartmentDepth > 0 && len(args.IDs) > 0 {
+		return fmt.Errorf("[ERROR] compartment_depth can not be used with ids")
+	}
+
+	if args.BaselineState != nil && *args.BaselineState != "" {
+		if _, err := os.Stat(*args.BaselineState); err != nil {
+			return fmt.Errorf("[ERROR] invalid value for argument baseline_state: %s", err)
+		}
+	}
+
+	if args.ReportFile != nil && *args.ReportFile != "" {
+		if path, err := os.Stat(filepath.Dir(*args.ReportFile)); err != nil || !path.IsDir() {
+			return fmt.Errorf("[ERROR] invalid value for argument report_file, the directory of %s does not exist", *args.ReportFile)
+		}
+	}
+
 	// validate and extract variables_resource_level
 	if args.VarsExportResourceLevel != nil {
 		VarsExportForResourceLevel, err = extractVarsExportResourceLevel(args.VarsExportResourceLevel)
@@ -460,7 +592,7 @@ func FindResourcesGeneric(ctx *ResourceDiscoveryContext, tfMeta *TerraformResour
 		}
 	}
 
-	if err := datasource.Read(d, clients); err != nil {
+	if err := tfresource.ReadSchemaResource(datasource, d, clients); err != nil {
 		return results, err
 	}
 
@@ -499,6 +631,13 @@ func FindResourcesGeneric(ctx *ResourceDiscoveryContext, tfMeta *TerraformResour
 						continue
 					}
 				}
+
+				// Skip the resources which do not match the filters before refreshing them, unless their children are needed
+				if _, isResource := ResourcesMap[tfMeta.ResourceClass]; isResource && !hasChildResources(tfMeta.ResourceClass, resourceGraph) {
+					if matches, known := MatchesResourceFilters(tfMeta.ResourceClass, itemMap); known && !matches {
+						continue
+					}
+				}
 			}
 			var resource *OCIResource
 			var err error
@@ -544,7 +683,7 @@ func FindResourcesGeneric(ctx *ResourceDiscoveryContext, tfMeta *TerraformResour
 					continue
 				}
 
-				if err = resourceSchema.Read(r, clients); err != nil {
+				if err = tfresource.ReadSchemaResource(resourceSchema, r, clients); err != nil {
 					rdError := &ResourceDiscoveryError{
 						ResourceType:   tfMeta.ResourceClass,
 						ParentResource: parent.TerraformName,
@@ -590,6 +729,10 @@ func FindResourcesGeneric(ctx *ResourceDiscoveryContext, tfMeta *TerraformResour
 				resource.TerraformName = fmt.Sprintf("%s_%s_%d", parent.TerraformName, tfMeta.ResourceAbbreviation, idx+1)
 			}
 
+			if !applyResourceFilters(resource, tfMeta, resourceGraph) {
+				continue
+			}
+
 			results = append(results, resource)
 		}
 	} else if d.Id() != "" {
@@ -614,7 +757,7 @@ func FindResourcesGeneric(ctx *ResourceDiscoveryContext, tfMeta *TerraformResour
 			}
 		}
 
-		if discoverable {
+		if discoverable && applyResourceFilters(resource, tfMeta, resourceGraph) {
 			results = append(results, resource)
 		}
 	} else {
@@ -5,6 +5,12 @@ import "fmt"
 const (
 	TfVersion11 TfVersionEnum = "0.11"
 	TfVersion12 TfVersionEnum = "0.12"
+	TfVersion1  TfVersionEnum = "1" // Terraform 1.x and OpenTofu
+)
+
+const (
+	providerSourceLegacy = "hashicorp/oci" // source of the provider when the configuration does not declare it
+	providerSource       = "oracle/oci"
 )
 
 func (tfversion *TfHclVersion11) GetReference(reference string) string {
@@ -58,3 +64,28 @@ func (tfversion *TfHclVersion12) GetSingleExpHclString(expString string) string
 func (tfversion *TfHclVersion12) GetDoubleExpHclString(expString1 string, expString2 string) string {
 	return fmt.Sprintf("%s.%s", expString1, expString2)
 }
+
+/*
+TfHclVersion1 generates the configuration for Terraform 1.x and OpenTofu: the 0.12 syntax, with the source and version
+of the provider declared in required_providers, and the repeated nested blocks collapsed into dynamic blocks
+*/
+type TfHclVersion1 struct {
+	TfHclVersion12
+}
+
+func (tfversion *TfHclVersion1) ToString() string {
+	return "1"
+}
+
+// IsTfVersion1 returns whether the configuration is generated for Terraform 1.x and OpenTofu
+func IsTfVersion1() bool {
+	return TfHclVersionvar != nil && TfHclVersionvar.ToString() == string(TfVersion1)
+}
+
+// GetProviderSource returns the source address of the provider the generated configuration and state refer to
+func GetProviderSource() string {
+	if IsTfVersion1() {
+		return providerSource
+	}
+	return providerSourceLegacy
+}
@@ -2,6 +2,7 @@ package commonexport
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -58,6 +59,7 @@ type OCIResource struct {
 	GetHclStringFn   func(*strings.Builder, *OCIResource, map[string]string) error
 	Parent           *OCIResource
 	IsErrorResource  bool
+	Region           string // region the resource is discovered in, empty for the region of the provider configuration
 }
 type TfHclVersion11 struct {
 	Value TfVersionEnum
@@ -91,7 +93,8 @@ type ResourceDiscoveryContext struct {
 	*ExportCommandArgs
 	ErrorList                    ErrorList
 	MissingAttributesPerResource map[string][]string
-	IsImportError                bool // flag indicates if there was an import failure and if reference map needs to be updated
+	IsImportError                bool                                // flag indicates if there was an import failure and if reference map needs to be updated
+	RegionClients                map[string]*tf_client.OracleClients // clients of the regions to export other than the region of Clients, by region
 	State                        interface{}
 	TimeTakenToDiscover          time.Duration
 	TimeTakenToGenerateState     time.Duration
@@ -128,6 +131,15 @@ type ExportCommandArgs struct {
 	Parallelism                  int
 	VarsExportResourceLevel      []string
 	VarExportGlobalLevel         []string
+	ImportMode                   ImportModeEnum
+	OutputLayout                 OutputLayoutEnum
+	BaselineState                *string
+	Filters                      []string
+	ReportFile                   *string
+	Resume                       bool
+	Regions                      []string
+	CompartmentIds               []string
+	CompartmentDepth             int
 }
 type ErrorList struct {
 	Errors []*ResourceDiscoveryError
@@ -185,6 +197,23 @@ func (tfE ResourceDiscoveryCustomError) Error() error {
 
 type ErrorTypeEnum string
 
+// ImportModeEnum is how the discovered resources are imported
+type ImportModeEnum string
+
+const (
+	ImportModeCli    ImportModeEnum = "cli"    // run terraform import for every resource to generate the state file
+	ImportModeBlocks ImportModeEnum = "blocks" // write import blocks to adopt the resources with a single plan, without the Terraform CLI
+	ImportModeNative ImportModeEnum = "native" // read every resource with the provider and write the state file directly, without the Terraform CLI
+)
+
+// OutputLayoutEnum is how the generated configuration files are structured
+type OutputLayoutEnum string
+
+const (
+	OutputLayoutFlat    OutputLayoutEnum = "flat"    // write a file per service in the root module
+	OutputLayoutModules OutputLayoutEnum = "modules" // write a module per compartment with a file per service, wired together by the root module
+)
+
 var TfHclVersionvar TfHclVersion
 var GetHclStringFromGenericMap = func(builder *strings.Builder, ociRes *OCIResource, interpolationMap map[string]string) error {
 	resourceSchema := ResourcesMap[ociRes.TerraformClass]
@@ -211,6 +240,7 @@ var GetHclStringFromGenericMap = func(builder *strings.Builder, ociRes *OCIResou
 		for attribute := range ociRes.TerraformTypeInfo.IgnorableRequiredMissingAttributes {
 			missingAttributes = append(missingAttributes, TfHclVersionvar.GetReference(attribute))
 		}
+		sort.Strings(missingAttributes)
 		builder.WriteString(strings.Join(missingAttributes, ","))
 
 		builder.WriteString("]\n" +
@@ -236,12 +266,15 @@ var ResourceNameCount map[string]int
 
 var DatasourcesMap map[string]*schema.Resource
 var Vars map[string]string
+var SensitiveVars map[string]bool // variables of the sensitive attribute values, declared without a default
+var VarsLock sync.Mutex
 var ExportRelatedResourcesGraph TerraformResourceGraph = make(map[string][]TerraformResourceAssociation)
 
 var availabilityDomainResourceGraph TerraformResourceGraph = make(map[string][]TerraformResourceAssociation)
 
 var VarsExportForResourceLevel map[string][]string // store resource type and attribute from customer input to be converted in var file for resource level
 var VarsExportForGlobalLevel []string              // store attributes list from customer input to be converted in var file for global level
+var ResourceFilters []*ResourceFilter              // store filters from customer input to narrow the resources exported
 
 // Tags to filter resources
 const OkeTagValue = "oke"
@@ -0,0 +1,195 @@
+package commonexport
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+// minDynamicBlockCount is the number of repeated nested blocks from which they are collapsed into a dynamic block
+const minDynamicBlockCount = 2
+
+// dynamicBlock is the content of the nested blocks collapsed into a dynamic block
+type dynamicBlock struct {
+	schema     *schema.Resource
+	attributes []string                 // attributes set in at least one of the blocks, sorted
+	nested     map[string]*dynamicBlock // content of the nested blocks of the blocks, by attribute
+}
+
+/*
+writeDynamicBlock writes repeated nested blocks, e.g. the rules of a security list, as a dynamic block iterating over
+their values. It returns false and writes nothing when the blocks can not be collapsed: they are fewer than
+minDynamicBlockCount, or they have sensitive attributes, missing required attributes or values of unexpected types,
+which GetHCLStringFromMap handles attribute by attribute.
+*/
+func writeDynamicBlock(builder *strings.Builder, blockName string, blocks []interface{}, blockSchema *schema.Resource, interpolationMap map[string]string) bool {
+	if len(blocks) < minDynamicBlockCount {
+		return false
+	}
+	block, ok := getDynamicBlock(blocks, blockSchema)
+	if !ok {
+		return false
+	}
+	values := make([]string, len(blocks))
+	for i, item := range blocks {
+		value, ok := getDynamicBlockValue(item.(map[string]interface{}), block, interpolationMap)
+		if !ok {
+			return false
+		}
+		values[i] = value
+	}
+
+	utils.Debugf("[DEBUG] collapsing %d '%s' blocks into a dynamic block", len(blocks), blockName)
+	builder.WriteString(fmt.Sprintf("dynamic %q {\nfor_each = [\n%s,\n]\ncontent {\n", blockName, strings.Join(values, ",\n")))
+	writeDynamicBlockContent(builder, blockName, block)
+	builder.WriteString("}\n}\n")
+	return true
+}
+
+// getDynamicBlock returns the attributes set in the blocks and in their nested blocks, or false if the blocks can not be collapsed
+func getDynamicBlock(blocks []interface{}, blockSchema *schema.Resource) (*dynamicBlock, bool) {
+	result := &dynamicBlock{schema: blockSchema, nested: map[string]*dynamicBlock{}}
+	nestedBlocks := map[string][]interface{}{}
+	for _, item := range blocks {
+		attributes, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		for name, attributeSchema := range blockSchema.Schema {
+			if attributeSchema.Deprecated != "" || (!attributeSchema.Required && !attributeSchema.Optional) {
+				continue
+			}
+			if attributeSchema.Sensitive {
+				return nil, false
+			}
+			value, exists := attributes[name]
+			if !exists || isEmptyAttributeValue(value) {
+				if attributeSchema.Required {
+					return nil, false
+				}
+				continue
+			}
+			if elem, isBlock := attributeSchema.Elem.(*schema.Resource); isBlock && attributeSchema.Type != schema.TypeMap {
+				switch v := value.(type) {
+				case []interface{}:
+					nestedBlocks[name] = append(nestedBlocks[name], v...)
+				case map[string]interface{}:
+					nestedBlocks[name] = append(nestedBlocks[name], v)
+				default:
+					return nil, false
+				}
+				if _, exists := result.nested[name]; !exists {
+					result.nested[name] = &dynamicBlock{schema: elem}
+				}
+			} else if _, exists := result.nested[name]; !exists {
+				result.nested[name] = nil
+			}
+		}
+	}
+
+	for name := range result.nested {
+		result.attributes = append(result.attributes, name)
+		if result.nested[name] == nil {
+			continue
+		}
+		nested, ok := getDynamicBlock(nestedBlocks[name], result.nested[name].schema)
+		if !ok {
+			return nil, false
+		}
+		result.nested[name] = nested
+	}
+	sort.Strings(result.attributes)
+	return result, true
+}
+
+// getDynamicBlockValue returns the object iterated over for a block, with a null value for the attributes it does not set
+func getDynamicBlockValue(attributes map[string]interface{}, block *dynamicBlock, interpolationMap map[string]string) (string, bool) {
+	values := make([]string, 0, len(block.attributes))
+	for _, name := range block.attributes {
+		value := attributes[name]
+		var hcl string
+		if nested := block.nested[name]; nested != nil {
+			var items []interface{}
+			switch v := value.(type) {
+			case []interface{}:
+				items = v
+			case map[string]interface{}:
+				items = []interface{}{v}
+			}
+			nestedValues := make([]string, len(items))
+			for i, item := range items {
+				nestedValue, ok := getDynamicBlockValue(item.(map[string]interface{}), nested, interpolationMap)
+				if !ok {
+					return "", false
+				}
+				nestedValues[i] = nestedValue
+			}
+			hcl = fmt.Sprintf("[%s]", strings.Join(nestedValues, ", "))
+		} else if isEmptyAttributeValue(value) {
+			hcl = "null"
+		} else {
+			var ok bool
+			if hcl, ok = getDynamicValue(value, interpolationMap); !ok {
+				return "", false
+			}
+		}
+		values = append(values, fmt.Sprintf("%s = %s", name, hcl))
+	}
+	return fmt.Sprintf("{ %s }", strings.Join(values, ", ")), true
+}
+
+// getDynamicValue returns the HCL expression of a value, as GetHCLStringFromMap writes it
+func getDynamicValue(value interface{}, interpolationMap map[string]string) (string, bool) {
+	switch v := value.(type) {
+	case InterpolationString:
+		if ok := FailedResourceReferenceSet[v.ResourceReference]; ok {
+			return fmt.Sprintf("%q", v.Value), true
+		}
+		return v.Interpolation, true
+	case string:
+		if varOverride, exists := interpolationMap[v]; exists {
+			return varOverride, true
+		}
+		return fmt.Sprintf("%q", escapeTFStrings(v)), true
+	case int, bool, float64:
+		return fmt.Sprintf("\"%v\"", v), true
+	case []interface{}:
+		items := make([]string, len(v))
+		for i, item := range v {
+			hcl, ok := getDynamicValue(item, interpolationMap)
+			if !ok {
+				return "", false
+			}
+			items[i] = hcl
+		}
+		return fmt.Sprintf("[%s]", strings.Join(items, ", ")), true
+	case map[string]interface{}:
+		items := make([]string, 0, len(v))
+		for _, key := range utils.GetSortedKeys(v) {
+			hcl, ok := getDynamicValue(v[key], interpolationMap)
+			if !ok {
+				return "", false
+			}
+			items = append(items, fmt.Sprintf("%q = %s", key, hcl))
+		}
+		return fmt.Sprintf("{ %s }", strings.Join(items, ", ")), true
+	}
+	return "", false
+}
+
+// writeDynamicBlockContent writes the content of a dynamic block from the values of its iterator
+func writeDynamicBlockContent(builder *strings.Builder, iterator string, block *dynamicBlock) {
+	for _, name := range block.attributes {
+		if nested := block.nested[name]; nested != nil {
+			builder.WriteString(fmt.Sprintf("dynamic %q {\nfor_each = %s.value.%s\ncontent {\n", name, iterator, name))
+			writeDynamicBlockContent(builder, name, nested)
+			builder.WriteString("}\n}\n")
+		} else {
+			builder.WriteString(fmt.Sprintf("%s = %s.value.%s\n", name, iterator, name))
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package commonexport
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl2/hcl"
+	"github.com/hashicorp/hcl2/hcl/hclsyntax"
+	"github.com/hashicorp/hcl2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func getSecurityListSchema() *schema.Resource {
+	portRange := &schema.Resource{Schema: map[string]*schema.Schema{
+		"min": {Type: schema.TypeInt, Required: true},
+		"max": {Type: schema.TypeInt, Required: true},
+	}}
+	return &schema.Resource{Schema: map[string]*schema.Schema{
+		"display_name": {Type: schema.TypeString, Optional: true},
+		"ingress_security_rules": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+				"protocol":    {Type: schema.TypeString, Required: true},
+				"source":      {Type: schema.TypeString, Required: true},
+				"description": {Type: schema.TypeString, Optional: true},
+				"stateless":   {Type: schema.TypeBool, Optional: true},
+				"tcp_options": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+						"min": {Type: schema.TypeInt, Optional: true},
+						"max": {Type: schema.TypeInt, Optional: true},
+						"source_port_range": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     portRange,
+						},
+					}},
+				},
+			}},
+		},
+	}}
+}
+
+func TestUnitGetHCLStringFromMap_dynamicBlocks(t *testing.T) {
+	tfHclVersion := TfHclVersionvar
+	defer func() { TfHclVersionvar = tfHclVersion }()
+	TfHclVersionvar = &TfHclVersion1{TfHclVersion12{Value: TfVersion1}}
+	FailedResourceReferenceSet = map[string]bool{}
+
+	ociRes := &OCIResource{TerraformResource: TerraformResource{TerraformClass: "oci_core_security_list", TerraformName: "export_security_list"}}
+	sourceAttributes := map[string]interface{}{
+		"display_name": "security list",
+		"ingress_security_rules": []interface{}{
+			map[string]interface{}{"protocol": "6", "source": "0.0.0.0/0", "stateless": false, "tcp_options": []interface{}{map[string]interface{}{"min": 22, "max": 22}}},
+			map[string]interface{}{"protocol": "6", "source": "10.0.0.0/16", "description": "https", "tcp_options": []interface{}{map[string]interface{}{"min": 443, "max": 443, "source_port_range": []interface{}{map[string]interface{}{"min": 1024, "max": 65535}}}}},
+			map[string]interface{}{"protocol": "1", "source": InterpolationString{ResourceReference: "oci_core_vcn.vcn", Interpolation: "oci_core_vcn.vcn.cidr_block", Value: "10.1.0.0/16"}},
+		},
+	}
+
+	builder := &strings.Builder{}
+	err := GetHCLStringFromMap(builder, sourceAttributes, getSecurityListSchema(), map[string]string{}, ociRes, "")
+	assert.NoError(t, err)
+	hcl := string(hclwrite.Format([]byte("resource oci_core_security_list export_security_list {\n" + builder.String() + "}\n")))
+	assertValidHcl(t, hcl)
+
+	assert.Equal(t, 1, strings.Count(hcl, "dynamic \"ingress_security_rules\""))
+	assert.NotContains(t, hcl, "ingress_security_rules {")
+	assert.Contains(t, hcl, "protocol    = ingress_security_rules.value.protocol")
+	assert.Contains(t, hcl, "for_each = ingress_security_rules.value.tcp_options")
+	assert.Contains(t, hcl, "for_each = tcp_options.value.source_port_range")
+	assert.Contains(t, hcl, `{ description = null, protocol = "6", source = "0.0.0.0/0", stateless = "false", tcp_options = [{ max = "22", min = "22", source_port_range = [] }] }`)
+	assert.Contains(t, hcl, `source = oci_core_vcn.vcn.cidr_block, stateless = null, tcp_options = [] }`)
+}
+
+func TestUnitGetHCLStringFromMap_dynamicBlocksNotCollapsed(t *testing.T) {
+	tfHclVersion := TfHclVersionvar
+	defer func() { TfHclVersionvar = tfHclVersion }()
+	rules := []interface{}{
+		map[string]interface{}{"protocol": "6", "source": "0.0.0.0/0"},
+		map[string]interface{}{"protocol": "6", "source": "10.0.0.0/16"},
+	}
+	sensitiveSchema := getSecurityListSchema()
+	sensitiveSchema.Schema["ingress_security_rules"].Elem.(*schema.Resource).Schema["description"].Sensitive = true
+	tests := []struct {
+		name             string
+		tfHclVersion     TfHclVersion
+		resourceSchema   *schema.Resource
+		rules            []interface{}
+		wantBlocksCount  int
+		wantDynamicBlock bool
+	}{
+		{"tf_version 0.12", &TfHclVersion12{Value: TfVersion12}, getSecurityListSchema(), rules, 2, false},
+		{"tf_version 1", &TfHclVersion1{TfHclVersion12{Value: TfVersion1}}, getSecurityListSchema(), rules, 0, true},
+		{"single block", &TfHclVersion1{TfHclVersion12{Value: TfVersion1}}, getSecurityListSchema(), rules[:1], 1, false},
+		{"missing required attribute", &TfHclVersion1{TfHclVersion12{Value: TfVersion1}}, getSecurityListSchema(), append([]interface{}{map[string]interface{}{"protocol": "all"}}, rules...), 3, false},
+		{"sensitive attribute", &TfHclVersion1{TfHclVersion12{Value: TfVersion1}}, sensitiveSchema, rules, 2, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			TfHclVersionvar = test.tfHclVersion
+			ociRes := &OCIResource{TerraformResource: TerraformResource{TerraformClass: "oci_core_security_list", TerraformName: "export_security_list"}}
+			builder := &strings.Builder{}
+			err := GetHCLStringFromMap(builder, map[string]interface{}{"ingress_security_rules": test.rules}, test.resourceSchema, map[string]string{}, ociRes, "")
+			assert.NoError(t, err)
+			assert.Equal(t, test.wantBlocksCount, strings.Count(builder.String(), "ingress_security_rules {"))
+			assert.Equal(t, test.wantDynamicBlock, strings.Contains(builder.String(), "dynamic \"ingress_security_rules\""))
+		})
+	}
+}
+
+func assertValidHcl(t *testing.T, config string) {
+	if _, diags := hclsyntax.ParseConfig([]byte(config), "test.tf", hcl.Pos{Line: 1, Column: 1}); diags.HasErrors() {
+		t.Errorf("invalid configuration: %s\n%s", diags.Error(), config)
+	}
+}
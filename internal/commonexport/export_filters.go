@@ -0,0 +1,276 @@
+package commonexport
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+type ResourceFilterAttributeEnum string
+
+const (
+	ResourceFilterFreeformTag ResourceFilterAttributeEnum = "freeform_tag"
+	ResourceFilterDefinedTag  ResourceFilterAttributeEnum = "defined_tag"
+	ResourceFilterDisplayName ResourceFilterAttributeEnum = "display_name"
+	ResourceFilterState       ResourceFilterAttributeEnum = "state"
+	ResourceFilterTimeCreated ResourceFilterAttributeEnum = "time_created"
+	ResourceFilterType        ResourceFilterAttributeEnum = "type"
+)
+
+// Operators of the filter expressions, the two characters operators are matched first
+var resourceFilterOperators = []string{"!=", ">=", "<=", "=", "~", ">", "<"}
+
+// The supported operators of each filter attribute, the tag filters without an operator match the tag key only
+var resourceFilterAttributeOperators = map[ResourceFilterAttributeEnum][]string{
+	ResourceFilterFreeformTag: {"", "=", "!="},
+	ResourceFilterDefinedTag:  {"", "=", "!="},
+	ResourceFilterDisplayName: {"=", "!=", "~"},
+	ResourceFilterState:       {"=", "!="},
+	ResourceFilterTimeCreated: {">=", "<=", ">", "<"},
+	ResourceFilterType:        {"=", "!="},
+}
+
+// Formats of the time_created values set by the resources, in addition to RFC3339
+var resourceFilterTimeFormats = []string{time.RFC3339Nano, "2006-01-02 15:04:05.999999999 -0700 MST"}
+
+// ResourceFilter is a filter expression of the export command, see extractResourceFilters for the syntax
+type ResourceFilter struct {
+	Attribute  ResourceFilterAttributeEnum
+	Key        string
+	Operator   string
+	Values     []string
+	Regex      *regexp.Regexp
+	Time       time.Time
+	Expression string
+}
+
+/*
+extractResourceFilters parses the filter expressions of the export command:
+
+	freeform_tag.<key>[=|!=<value>[,<value>...]]
+	defined_tag.<namespace>.<key>[=|!=<value>[,<value>...]]
+	display_name=|!=<name>[,<name>...] or display_name~<regex>
+	state=|!=<state>[,<state>...]
+	time_created>=|<=|>|<<RFC3339 time>
+	type=|!=<resource type>[,<resource type>...]
+*/
+func extractResourceFilters(expressions []string) ([]*ResourceFilter, error) {
+	var result []*ResourceFilter
+	for _, expression := range expressions {
+		expression = strings.TrimSpace(expression)
+		if expression == "" {
+			continue
+		}
+		filter, err := extractResourceFilter(expression)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, filter)
+	}
+	return result, nil
+}
+
+func extractResourceFilter(expression string) (*ResourceFilter, error) {
+	filter := &ResourceFilter{Expression: expression}
+
+	name, value := expression, ""
+	if idx := strings.IndexAny(expression, "!=~<>"); idx >= 0 {
+		name = expression[:idx]
+		for _, operator := range resourceFilterOperators {
+			if strings.HasPrefix(expression[idx:], operator) {
+				filter.Operator = operator
+				value = expression[idx+len(operator):]
+				break
+			}
+		}
+		if filter.Operator == "" {
+			return nil, fmt.Errorf("[ERROR] invalid operator in filter '%s'", expression)
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(name, string(ResourceFilterFreeformTag)+globalvar.DotDelimiter):
+		filter.Attribute = ResourceFilterFreeformTag
+		filter.Key = strings.TrimPrefix(name, string(ResourceFilterFreeformTag)+globalvar.DotDelimiter)
+	case strings.HasPrefix(name, string(ResourceFilterDefinedTag)+globalvar.DotDelimiter):
+		filter.Attribute = ResourceFilterDefinedTag
+		filter.Key = strings.TrimPrefix(name, string(ResourceFilterDefinedTag)+globalvar.DotDelimiter)
+		if !strings.Contains(filter.Key, globalvar.DotDelimiter) {
+			return nil, fmt.Errorf("[ERROR] filter '%s' is in wrong format of defined_tag.namespace.key", expression)
+		}
+	default:
+		filter.Attribute = ResourceFilterAttributeEnum(name)
+	}
+
+	operators, supported := resourceFilterAttributeOperators[filter.Attribute]
+	if !supported {
+		return nil, fmt.Errorf("[ERROR] unsupported attribute '%s' in filter '%s', supported attributes: freeform_tag.<key>, defined_tag.<namespace>.<key>, %s, %s, %s, %s", name, expression, ResourceFilterDisplayName, ResourceFilterState, ResourceFilterTimeCreated, ResourceFilterType)
+	}
+	if (filter.Attribute == ResourceFilterFreeformTag || filter.Attribute == ResourceFilterDefinedTag) && filter.Key == "" {
+		return nil, fmt.Errorf("[ERROR] missing tag key in filter '%s'", expression)
+	}
+	validOperator := false
+	for _, operator := range operators {
+		if operator == filter.Operator {
+			validOperator = true
+			break
+		}
+	}
+	if !validOperator {
+		return nil, fmt.Errorf("[ERROR] operator '%s' is not supported for attribute '%s' in filter '%s'", filter.Operator, filter.Attribute, expression)
+	}
+	if filter.Operator != "" && value == "" {
+		return nil, fmt.Errorf("[ERROR] missing value in filter '%s'", expression)
+	}
+
+	switch {
+	case filter.Operator == "~":
+		regex, err := regexp.Compile(value)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] invalid regular expression in filter '%s': %v", expression, err)
+		}
+		filter.Regex = regex
+	case filter.Attribute == ResourceFilterTimeCreated:
+		timeValue, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] invalid RFC3339 time in filter '%s': %v", expression, err)
+		}
+		filter.Time = timeValue
+	case filter.Operator != "":
+		filter.Values = strings.Split(value, ",")
+	}
+	return filter, nil
+}
+
+/*
+Matches returns whether a resource matches the filter.
+known is false if the attribute of the filter is missing from the resource attributes, as for the summaries of some
+list data sources, the resource may then match once it is refreshed.
+*/
+func (filter *ResourceFilter) Matches(resourceClass string, attributes map[string]interface{}) (matches bool, known bool) {
+	var value interface{}
+	found := false
+	switch filter.Attribute {
+	case ResourceFilterType:
+		value, found = resourceClass, true
+	case ResourceFilterFreeformTag, ResourceFilterDefinedTag:
+		tags, exists := attributes[string(filter.Attribute)+"s"]
+		if !exists {
+			return false, false
+		}
+		tagMap, _ := tags.(map[string]interface{})
+		if value, found = tagMap[filter.Key]; !found {
+			return filter.Operator == "!=", true
+		}
+		if filter.Operator == "" {
+			return true, true
+		}
+	case ResourceFilterDisplayName:
+		// Some resources have a name instead of a display_name
+		if value, found = attributes["display_name"]; !found {
+			value, found = attributes["name"]
+		}
+	default:
+		value, found = attributes[string(filter.Attribute)]
+	}
+	if !found {
+		return false, false
+	}
+
+	stringValue := fmt.Sprintf("%v", value)
+	switch filter.Operator {
+	case "~":
+		return filter.Regex.MatchString(stringValue), true
+	case ">=", "<=", ">", "<":
+		timeValue, err := parseResourceFilterTime(stringValue)
+		if err != nil {
+			utils.Debugf("[DEBUG] unable to compare '%s' with filter '%s': %v", stringValue, filter.Expression, err)
+			return false, true
+		}
+		switch filter.Operator {
+		case ">=":
+			return !timeValue.Before(filter.Time), true
+		case "<=":
+			return !timeValue.After(filter.Time), true
+		case ">":
+			return timeValue.After(filter.Time), true
+		default:
+			return timeValue.Before(filter.Time), true
+		}
+	}
+
+	inValues := false
+	for _, filterValue := range filter.Values {
+		// The lifecycle states are not case sensitive, as for the DiscoverableLifecycleStates
+		if stringValue == filterValue || (filter.Attribute == ResourceFilterState && strings.EqualFold(stringValue, filterValue)) {
+			inValues = true
+			break
+		}
+	}
+	return inValues == (filter.Operator == "="), true
+}
+
+func parseResourceFilterTime(value string) (time.Time, error) {
+	var err error
+	for _, format := range resourceFilterTimeFormats {
+		var result time.Time
+		if result, err = time.Parse(format, value); err == nil {
+			return result, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+/*
+MatchesResourceFilters returns whether a resource matches all the ResourceFilters of the export command.
+known is false if the resource does not fail any filter but some filter attributes are missing from its attributes.
+*/
+func MatchesResourceFilters(resourceClass string, attributes map[string]interface{}) (matches bool, known bool) {
+	known = true
+	for _, filter := range ResourceFilters {
+		filterMatches, filterKnown := filter.Matches(resourceClass, attributes)
+		if filterKnown && !filterMatches {
+			return false, true
+		}
+		known = known && filterKnown
+	}
+	return known, known
+}
+
+// hasChildResources returns whether resources are discovered under the resources of a type in the resource graph
+func hasChildResources(resourceClass string, resourceGraph *TerraformResourceGraph) bool {
+	if resourceGraph == nil {
+		return false
+	}
+	children, exists := (*resourceGraph)[resourceClass]
+	return exists && len(children) > 0
+}
+
+/*
+applyResourceFilters returns false if a discovered resource does not match the ResourceFilters and can be dropped.
+The resources which do not match but have child resources are still returned to discover their children, they are
+omitted from the export instead.
+The data sources are not filtered as they are only exported to be referenced by the resources.
+*/
+func applyResourceFilters(resource *OCIResource, tfMeta *TerraformResourceAssociation, resourceGraph *TerraformResourceGraph) bool {
+	if len(ResourceFilters) == 0 {
+		return true
+	}
+	if _, isResource := ResourcesMap[tfMeta.ResourceClass]; !isResource {
+		return true
+	}
+	if matches, _ := MatchesResourceFilters(tfMeta.ResourceClass, resource.SourceAttributes); matches {
+		return true
+	}
+	if !hasChildResources(tfMeta.ResourceClass, resourceGraph) {
+		utils.Debugf("[DEBUG] resource %s does not match the filters, skipping it", resource.Id)
+		return false
+	}
+	utils.Debugf("[DEBUG] resource %s does not match the filters, omitting it from the export", resource.Id)
+	resource.OmitFromExport = true
+	return true
+}
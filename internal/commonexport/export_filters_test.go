@@ -0,0 +1,152 @@
+package commonexport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitExtractResourceFilters(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		want       *ResourceFilter
+		wantErr    bool
+	}{
+		{
+			name:       "Test freeform tag value",
+			expression: "freeform_tag.app=payments",
+			want:       &ResourceFilter{Attribute: ResourceFilterFreeformTag, Key: "app", Operator: "=", Values: []string{"payments"}},
+		},
+		{
+			name:       "Test freeform tag key",
+			expression: "freeform_tag.app",
+			want:       &ResourceFilter{Attribute: ResourceFilterFreeformTag, Key: "app"},
+		},
+		{
+			name:       "Test defined tag values",
+			expression: "defined_tag.Operations.CostCenter!=42,43",
+			want:       &ResourceFilter{Attribute: ResourceFilterDefinedTag, Key: "Operations.CostCenter", Operator: "!=", Values: []string{"42", "43"}},
+		},
+		{
+			name:       "Test lifecycle states",
+			expression: "state=AVAILABLE,RUNNING",
+			want:       &ResourceFilter{Attribute: ResourceFilterState, Operator: "=", Values: []string{"AVAILABLE", "RUNNING"}},
+		},
+		{
+			name:       "Test defined tag without namespace",
+			expression: "defined_tag.CostCenter=42",
+			wantErr:    true,
+		},
+		{
+			name:       "Test unsupported attribute",
+			expression: "shape=VM.Standard2.1",
+			wantErr:    true,
+		},
+		{
+			name:       "Test unsupported operator",
+			expression: "state~AVAIL",
+			wantErr:    true,
+		},
+		{
+			name:       "Test missing value",
+			expression: "type=",
+			wantErr:    true,
+		},
+		{
+			name:       "Test invalid regex",
+			expression: "display_name~[",
+			wantErr:    true,
+		},
+		{
+			name:       "Test invalid time",
+			expression: "time_created>=yesterday",
+			wantErr:    true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := extractResourceFilters([]string{test.expression})
+			if (err != nil) != test.wantErr {
+				t.Fatalf("extractResourceFilters() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if test.wantErr {
+				return
+			}
+			test.want.Expression = test.expression
+			if assert.Len(t, got, 1) {
+				assert.Equal(t, test.want, got[0])
+			}
+		})
+	}
+}
+
+func TestUnitResourceFilter_Matches(t *testing.T) {
+	attributes := map[string]interface{}{
+		"display_name":  "payments-db",
+		"state":         "AVAILABLE",
+		"time_created":  "2021-06-15 10:00:00 +0000 UTC",
+		"freeform_tags": map[string]interface{}{"app": "payments"},
+		"defined_tags":  map[string]interface{}{"Operations.CostCenter": "42"},
+	}
+	tests := []struct {
+		expression string
+		matches    bool
+		known      bool
+	}{
+		{"freeform_tag.app=payments", true, true},
+		{"freeform_tag.app=orders", false, true},
+		{"freeform_tag.app", true, true},
+		{"freeform_tag.team", false, true},
+		{"freeform_tag.team!=payments", true, true},
+		{"defined_tag.Operations.CostCenter=42", true, true},
+		{"defined_tag.Operations.CostCenter!=42", false, true},
+		{"display_name~^payments-", true, true},
+		{"display_name=orders-db", false, true},
+		{"state=available", true, true},
+		{"state!=AVAILABLE", false, true},
+		{"time_created>=2021-01-01T00:00:00Z", true, true},
+		{"time_created<2021-01-01T00:00:00Z", false, true},
+		{"type=oci_database_autonomous_database", true, true},
+		{"type!=oci_database_autonomous_database", false, true},
+	}
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			filters, err := extractResourceFilters([]string{test.expression})
+			if err != nil {
+				t.Fatalf("extractResourceFilters() error = %v", err)
+			}
+			matches, known := filters[0].Matches("oci_database_autonomous_database", attributes)
+			assert.Equal(t, test.matches, matches)
+			assert.Equal(t, test.known, known)
+		})
+	}
+
+	// The attributes missing from a data source summary are not known until the resource is refreshed
+	filters, _ := extractResourceFilters([]string{"freeform_tag.app=payments"})
+	_, known := filters[0].Matches("oci_database_autonomous_database", map[string]interface{}{"display_name": "payments-db"})
+	assert.False(t, known)
+}
+
+func TestUnitMatchesResourceFilters(t *testing.T) {
+	defer func() { ResourceFilters = nil }()
+
+	var err error
+	ResourceFilters, err = extractResourceFilters([]string{"freeform_tag.app=payments", "state=AVAILABLE"})
+	if err != nil {
+		t.Fatalf("extractResourceFilters() error = %v", err)
+	}
+
+	matches, known := MatchesResourceFilters("oci_core_vcn", map[string]interface{}{"freeform_tags": map[string]interface{}{"app": "payments"}, "state": "AVAILABLE"})
+	assert.True(t, matches)
+	assert.True(t, known)
+
+	// A failed filter is known even if the other attributes are missing
+	matches, known = MatchesResourceFilters("oci_core_vcn", map[string]interface{}{"state": "TERMINATED"})
+	assert.False(t, matches)
+	assert.True(t, known)
+
+	matches, known = MatchesResourceFilters("oci_core_vcn", map[string]interface{}{"state": "AVAILABLE"})
+	assert.False(t, matches)
+	assert.False(t, known)
+}
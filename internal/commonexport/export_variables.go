@@ -5,11 +5,17 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
 	"github.com/oracle/terraform-provider-oci/internal/globalvar"
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 )
 
 func exportAttributeAsVariable(sourceAttributes map[string]interface{}, resourceType string, resourceName string, interpolationMap map[string]string) error {
+	// the sensitive attributes are always exported as variables without a default by GetHCLStringFromMap
+	sourceAttributes = getNonSensitiveAttributes(sourceAttributes, resourceType)
+	VarsLock.Lock()
+	defer VarsLock.Unlock()
 
 	// handle user input both flags
 	if len(VarsExportForResourceLevel) > 0 && len(VarsExportForGlobalLevel) > 0 {
@@ -122,3 +128,63 @@ func exportAttributeForResourceAndGlobalLevel(sourceAttributes map[string]interf
 func exportAttributeFromDefaultList(defaultList []string, sourceAttributes map[string]interface{}, resourceName string, interpolationMap map[string]string) error {
 	return exportAttributeForGlobalLevel(sourceAttributes, resourceName, defaultList, interpolationMap)
 }
+
+/* Functions for handling sensitive attributes */
+// Return the source attributes without the top level attributes which are sensitive in the schema of the resource type
+func getNonSensitiveAttributes(sourceAttributes map[string]interface{}, resourceType string) map[string]interface{} {
+	resourceSchema, exists := ResourcesMap[resourceType]
+	if !exists || resourceSchema == nil {
+		return sourceAttributes
+	}
+	result := make(map[string]interface{}, len(sourceAttributes))
+	for attribute, value := range sourceAttributes {
+		if attributeSchema, exists := resourceSchema.Schema[attribute]; exists && attributeSchema.Sensitive {
+			continue
+		}
+		result[attribute] = value
+	}
+	return result
+}
+
+// Return a copy of a nested block schema with all its attributes sensitive
+func getSensitiveResource(resource *schema.Resource) *schema.Resource {
+	result := &schema.Resource{Schema: make(map[string]*schema.Schema, len(resource.Schema))}
+	for attribute, attributeSchema := range resource.Schema {
+		sensitiveSchema := *attributeSchema
+		sensitiveSchema.Sensitive = true
+		result.Schema[attribute] = &sensitiveSchema
+	}
+	return result
+}
+
+// Add the variable replacing the value of a sensitive attribute of a resource, following format resourceType--attribute-index-attribute--resourceName
+func addSensitiveVar(ociRes *OCIResource, attributePrefix string, attribute string) string {
+	if attributePrefix != "" {
+		attribute = attributePrefix + globalvar.DotDelimiter + attribute
+	}
+	attribute = strings.NewReplacer("[", globalvar.DotDelimiter, "]", "").Replace(attribute)
+	variableName := utils.GetVarNameFromAttributeOfResources(attribute, ociRes.TerraformClass, ociRes.TerraformName)
+
+	VarsLock.Lock()
+	defer VarsLock.Unlock()
+	Vars[variableName] = ""
+	if SensitiveVars == nil {
+		SensitiveVars = map[string]bool{}
+	}
+	SensitiveVars[variableName] = true
+	return variableName
+}
+
+func isEmptyAttributeValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
@@ -2,8 +2,11 @@ package commonexport
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 
 	"github.com/stretchr/testify/assert"
@@ -256,3 +259,86 @@ func TestUnitExportAttributeForGlobalLevel(t *testing.T) {
 	assert.True(t, exist)
 	assert.Contains(t, v, "available_domain--ad1")
 }
+
+func TestUnitGetHCLStringFromMap_sensitive(t *testing.T) {
+	TfHclVersionvar = &TfHclVersion12{Value: TfVersion12}
+	Vars = map[string]string{}
+	SensitiveVars = map[string]bool{}
+	resourceSchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"display_name":   {Type: schema.TypeString, Optional: true},
+			"admin_password": {Type: schema.TypeString, Required: true, Sensitive: true},
+			"private_key":    {Type: schema.TypeString, Optional: true, Sensitive: true},
+			"database": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"db_name":     {Type: schema.TypeString, Required: true},
+						"tde_wallet":  {Type: schema.TypeString, Optional: true, Sensitive: true},
+						"credentials": {Type: schema.TypeList, Optional: true, Sensitive: true, Elem: &schema.Resource{Schema: map[string]*schema.Schema{"token": {Type: schema.TypeString, Optional: true}}}},
+					},
+				},
+			},
+		},
+	}
+	sourceAttributes := map[string]interface{}{
+		"display_name":   "db1",
+		"admin_password": "BEstr0ng_#11",
+		"private_key":    "",
+		"database": []interface{}{map[string]interface{}{
+			"db_name":     "orcl",
+			"tde_wallet":  "wallet-secret",
+			"credentials": []interface{}{map[string]interface{}{"token": "token-secret"}},
+		}},
+	}
+	ociRes := &OCIResource{TerraformResource: TerraformResource{TerraformClass: "oci_database_db_system", TerraformName: "export_db1"}}
+
+	builder := &strings.Builder{}
+	err := GetHCLStringFromMap(builder, sourceAttributes, resourceSchema, map[string]string{}, ociRes, "")
+	assert.NoError(t, err)
+	hcl := builder.String()
+	for _, secret := range []string{"BEstr0ng_#11", "wallet-secret", "token-secret"} {
+		assert.NotContains(t, hcl, secret)
+	}
+	assert.Contains(t, hcl, "display_name = \"db1\"")
+	assert.Contains(t, hcl, "db_name = \"orcl\"")
+	assert.Contains(t, hcl, "private_key = \"\"")
+
+	for attribute, variableName := range map[string]string{
+		"admin_password": "oci_database_db_system--admin_password--export_db1",
+		"tde_wallet":     "oci_database_db_system--database-0-tde_wallet--export_db1",
+		"token":          "oci_database_db_system--database-0-credentials-0-token--export_db1",
+	} {
+		assert.Contains(t, hcl, attribute+" = var."+variableName)
+		v, exist := Vars[variableName]
+		assert.True(t, exist)
+		assert.Empty(t, v)
+		assert.True(t, SensitiveVars[variableName])
+	}
+	assert.Len(t, SensitiveVars, 3)
+}
+
+func TestUnitExportAttributeAsVariable_sensitive(t *testing.T) {
+	TfHclVersionvar = &TfHclVersion12{Value: TfVersion12}
+	Vars = map[string]string{}
+	resourcesMap := ResourcesMap
+	defer func() { ResourcesMap = resourcesMap }()
+	ResourcesMap = map[string]*schema.Resource{
+		"oci_test_resource": {Schema: map[string]*schema.Schema{
+			"display_name":   {Type: schema.TypeString, Optional: true},
+			"admin_password": {Type: schema.TypeString, Optional: true, Sensitive: true},
+		}},
+	}
+	VarsExportForGlobalLevel = []string{"display_name", "admin_password"}
+	defer func() { VarsExportForGlobalLevel = []string{} }()
+	sourceAttributes := map[string]interface{}{"display_name": "db1", "admin_password": "secret"}
+	interpolationMap := map[string]string{}
+
+	err := exportAttributeAsVariable(sourceAttributes, "oci_test_resource", "export_db1", interpolationMap)
+	assert.NoError(t, err)
+	// the sensitive value is neither a default nor part of the name of a variable
+	assert.Contains(t, Vars, "display_name--db1")
+	assert.Len(t, Vars, 1)
+	assert.NotContains(t, interpolationMap, "secret")
+}
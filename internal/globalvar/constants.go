@@ -10,6 +10,7 @@ const (
 	AuthInstancePrincipalWithCertsSetting = "InstancePrincipalWithCerts"
 	AuthSecurityToken                     = "SecurityToken"
 	ResourcePrincipal                     = "ResourcePrincipal"
+	AuthOIDCSetting                       = "OIDC"
 	RequestHeaderOpcOboToken              = "opc-obo-token"
 	RequestHeaderOpcHostSerial            = "opc-host-serial"
 	DefaultRequestTimeout                 = 0
@@ -42,6 +43,35 @@ const (
 	OboTokenPath                 = "obo_token_path"
 	ConfigFileProfileAttrName    = "config_file_profile"
 	DefinedTagsToIgnore          = "ignore_defined_tags"
+	FreeformTagsToIgnore         = "ignore_freeform_tags"
+	RetryPolicyAttrName          = "retry_policy"
+	AuditLogFileAttrName         = "audit_log_file"
+	RequestLimitAttrName         = "request_limit"
+	RegionOverrideAttrName       = "region_override"
+	DefaultTagsAttrName          = "default_tags"
+
+	RetryPolicyServiceAttrName              = "service"
+	RetryPolicyMaxDurationSecondsAttrName   = "max_duration_seconds"
+	RetryPolicyBackoffBaseSecondsAttrName   = "backoff_base_seconds"
+	RetryPolicyBackoffCapSecondsAttrName    = "backoff_cap_seconds"
+	RetryPolicyJitterAttrName               = "jitter"
+	RetryPolicyRetryableStatusCodesAttrName = "retryable_status_codes"
+	RetryPolicyRetryableErrorCodesAttrName  = "retryable_error_codes"
+
+	RequestLimitServiceAttrName           = "service"
+	RequestLimitRequestsPerSecondAttrName = "requests_per_second"
+	RequestLimitBurstAttrName             = "burst"
+	RequestLimitPerEndpointAttrName       = "per_endpoint"
+	MaxConcurrentRequestsAttrName         = "max_concurrent_requests"
+
+	DefaultTagsDefinedTagsAttrName  = "defined_tags"
+	DefaultTagsFreeformTagsAttrName = "freeform_tags"
+
+	OidcTokenExchangeEndpointAttrName = "oidc_token_exchange_endpoint"
+	OidcClientIdAttrName              = "oidc_client_id"
+	OidcClientSecretAttrName          = "oidc_client_secret"
+	OidcTokenAttrName                 = "oidc_token"
+	OidcTokenFileAttrName             = "oidc_token_file"
 
 	DefaultConfigFileName    = "config"
 	DefaultConfigDirName     = ".oci"
@@ -55,6 +85,14 @@ const (
 	DefaultStateFilename            = "terraform.tfstate"
 	VarsFile                        = "vars.tf"
 	ProviderFile                    = "provider.tf"
+	ImportsFile                     = "imports.tf"
+	MovedFile                       = "moved.tf"
+	MainFile                        = "main.tf"
+	ModulesDir                      = "modules"
+	ModuleVariablesFile             = "variables.tf"
+	ModuleOutputsFile               = "outputs.tf"
+	DriftReportFile                 = "drift.json"
+	CheckpointDir                   = ".export_checkpoint"
 	MissingRequiredAttributeWarning = `
 
 Warning: There are one or more 'Required' attributes for which a value could not be discovered.
@@ -57,7 +57,7 @@ func TestResourcemanagerStackResource_basic(t *testing.T) {
 	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
 	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
 
-	resourceManagerStackId, err := resourcemanager.CreateResourceManagerStack(*client, "TestResourcemanagerStackResource_basic", compartmentId)
+	resourceManagerStackId, err := resourcemanager.CreateResourceManagerStack(context.Background(), *client, "TestResourcemanagerStackResource_basic", compartmentId)
 	if err != nil {
 		t.Errorf("cannot Create resource manager stack for the test run: %v", err)
 	}
@@ -70,7 +70,7 @@ func TestResourcemanagerStackResource_basic(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() { acctest.TestAccPreCheck(t) },
 		CheckDestroy: func(s *terraform.State) error {
-			return resourcemanager.DestroyResourceManagerStack(*client, resourceManagerStackId)
+			return resourcemanager.DestroyResourceManagerStack(context.Background(), *client, resourceManagerStackId)
 		},
 		PreventPostDestroyRefresh: true,
 		Providers: map[string]*schema.Provider{
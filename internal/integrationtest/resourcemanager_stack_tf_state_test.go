@@ -4,6 +4,7 @@
 package integrationtest
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -46,7 +47,7 @@ func TestResourcemanagerStackTfStateResource_basic(t *testing.T) {
 	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
 	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
 
-	resourceManagerStackId, err := resourcemanager.CreateResourceManagerStack(*client, "TestResourcemanagerStackTfStateResource_basic", compartmentId)
+	resourceManagerStackId, err := resourcemanager.CreateResourceManagerStack(context.Background(), *client, "TestResourcemanagerStackTfStateResource_basic", compartmentId)
 	if err != nil {
 		t.Errorf("cannot Create resource manager stack for the test run: %v", err)
 	}
@@ -59,7 +60,7 @@ func TestResourcemanagerStackTfStateResource_basic(t *testing.T) {
 		PreCheck: func() { acctest.TestAccPreCheck(t) },
 		CheckDestroy: func(s *terraform.State) error {
 			os.Remove("test.tfstate")
-			return resourcemanager.DestroyResourceManagerStack(*client, resourceManagerStackId)
+			return resourcemanager.DestroyResourceManagerStack(context.Background(), *client, resourceManagerStackId)
 		},
 		PreventPostDestroyRefresh: true,
 		Providers: map[string]*schema.Provider{
@@ -0,0 +1,269 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+const (
+	oidcTokenExchangeGrantType   = "urn:ietf:params:oauth:grant-type:token-exchange"
+	oidcRequestedTokenType       = "urn:oci:token-type:oci-upst"
+	oidcSubjectTokenType         = "jwt"
+	oidcSessionKeyBits           = 2048
+	oidcTokenRefreshBeforeExpiry = 5 * time.Minute
+)
+
+// oidcTokenSource returns the external JWT to exchange, read again for every exchange since CI systems rotate it
+type oidcTokenSource func() (string, error)
+
+// oidcConfigurationProvider authenticates with a user principal session token (UPST) obtained by exchanging an
+// external OIDC JWT at the token exchange endpoint of an identity domain. The session token is bound to a key pair
+// generated by the provider and is exchanged again shortly before it expires.
+type oidcConfigurationProvider struct {
+	tokenExchangeEndpoint string
+	clientId              string
+	clientSecret          string
+	subjectToken          oidcTokenSource
+	httpClient            *http.Client
+
+	sessionKey *rsa.PrivateKey
+
+	mutex        sync.Mutex
+	sessionToken string
+	claims       map[string]interface{}
+	expiresAt    time.Time
+}
+
+func newOidcConfigurationProvider(tokenExchangeEndpoint string, clientId string, clientSecret string, subjectToken oidcTokenSource, httpClient *http.Client) (*oidcConfigurationProvider, error) {
+	sessionKey, err := rsa.GenerateKey(rand.Reader, oidcSessionKeyBits)
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate the session key for OIDC authentication: %v", err)
+	}
+	provider := &oidcConfigurationProvider{
+		tokenExchangeEndpoint: tokenExchangeEndpoint,
+		clientId:              clientId,
+		clientSecret:          clientSecret,
+		subjectToken:          subjectToken,
+		httpClient:            httpClient,
+		sessionKey:            sessionKey,
+	}
+	// Exchange the token right away so that an invalid configuration is reported when the provider is configured
+	if _, err := provider.token(); err != nil {
+		return nil, err
+	}
+	return provider, nil
+}
+
+// oidcTokenFromFile reads the external JWT from a file, such as the token file written by a CI job
+func oidcTokenFromFile(path string) oidcTokenSource {
+	return func() (string, error) {
+		token, err := ioutil.ReadFile(utils.ExpandPath(path))
+		if err != nil {
+			return "", fmt.Errorf("unable to read the OIDC token file %s: %v", path, err)
+		}
+		return strings.TrimSpace(string(token)), nil
+	}
+}
+
+// oidcTokenFromValue uses a fixed external JWT, such as one passed in an environment variable
+func oidcTokenFromValue(token string) oidcTokenSource {
+	return func() (string, error) {
+		return strings.TrimSpace(token), nil
+	}
+}
+
+// token returns the current session token, exchanging the external JWT again if it is about to expire
+func (p *oidcConfigurationProvider) token() (string, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.sessionToken != "" && time.Now().Add(oidcTokenRefreshBeforeExpiry).Before(p.expiresAt) {
+		return p.sessionToken, nil
+	}
+
+	sessionToken, err := p.exchangeToken()
+	if err != nil {
+		return "", err
+	}
+	claims, err := parseJwtClaims(sessionToken)
+	if err != nil {
+		return "", fmt.Errorf("invalid session token returned by %s: %v", p.tokenExchangeEndpoint, err)
+	}
+	p.sessionToken = sessionToken
+	p.claims = claims
+	p.expiresAt = time.Now().Add(time.Hour)
+	if exp, ok := claims["exp"].(float64); ok {
+		p.expiresAt = time.Unix(int64(exp), 0)
+	}
+	utils.Debugf("[DEBUG] Exchanged the OIDC token for a session token valid until %v", p.expiresAt)
+	return sessionToken, nil
+}
+
+func (p *oidcConfigurationProvider) exchangeToken() (string, error) {
+	subjectToken, err := p.subjectToken()
+	if err != nil {
+		return "", err
+	}
+	if subjectToken == "" {
+		return "", fmt.Errorf("the OIDC token to exchange is empty")
+	}
+	publicKey, err := x509.MarshalPKIXPublicKey(&p.sessionKey.PublicKey)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", oidcTokenExchangeGrantType)
+	form.Set("requested_token_type", oidcRequestedTokenType)
+	form.Set("subject_token", subjectToken)
+	form.Set("subject_token_type", oidcSubjectTokenType)
+	form.Set("public_key", base64.StdEncoding.EncodeToString(publicKey))
+
+	request, err := http.NewRequest(http.MethodPost, p.tokenExchangeEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded;charset=utf-8")
+	request.SetBasicAuth(p.clientId, p.clientSecret)
+
+	response, err := p.httpClient.Do(request)
+	if err != nil {
+		return "", fmt.Errorf("unable to exchange the OIDC token at %s: %v", p.tokenExchangeEndpoint, err)
+	}
+	defer response.Body.Close()
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return "", err
+	}
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unable to exchange the OIDC token at %s: %s %s", p.tokenExchangeEndpoint, response.Status, string(body))
+	}
+
+	var tokenResponse struct {
+		Token string `json:"token"`
+	}
+	if err := json.Unmarshal(body, &tokenResponse); err != nil || tokenResponse.Token == "" {
+		return "", fmt.Errorf("no session token in the response of %s", p.tokenExchangeEndpoint)
+	}
+	return tokenResponse.Token, nil
+}
+
+func (p *oidcConfigurationProvider) claim(name string) (string, error) {
+	if _, err := p.token(); err != nil {
+		return "", err
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if value, ok := p.claims[name].(string); ok && value != "" {
+		return value, nil
+	}
+	return "", fmt.Errorf("session token has no '%s' claim", name)
+}
+
+func (p *oidcConfigurationProvider) TenancyOCID() (string, error) {
+	return p.claim("tenant")
+}
+
+func (p *oidcConfigurationProvider) UserOCID() (string, error) {
+	return p.claim("sub")
+}
+
+func (p *oidcConfigurationProvider) KeyFingerprint() (string, error) {
+	publicKey, err := x509.MarshalPKIXPublicKey(&p.sessionKey.PublicKey)
+	if err != nil {
+		return "", err
+	}
+	fingerprint := md5.Sum(publicKey)
+	hexFingerprint := make([]string, len(fingerprint))
+	for i, b := range fingerprint {
+		hexFingerprint[i] = fmt.Sprintf("%02x", b)
+	}
+	return strings.Join(hexFingerprint, ":"), nil
+}
+
+// Region is taken from the provider block
+func (p *oidcConfigurationProvider) Region() (string, error) {
+	return "", fmt.Errorf("region is not provided by OIDC authentication")
+}
+
+func (p *oidcConfigurationProvider) KeyID() (string, error) {
+	token, err := p.token()
+	if err != nil {
+		return "", err
+	}
+	return "ST$" + token, nil
+}
+
+func (p *oidcConfigurationProvider) PrivateRSAKey() (*rsa.PrivateKey, error) {
+	return p.sessionKey, nil
+}
+
+func (p *oidcConfigurationProvider) AuthType() (oci_common.AuthConfig, error) {
+	return oci_common.AuthConfig{
+			AuthType:         oci_common.UnknownAuthenticationType,
+			IsFromConfigFile: false,
+			OboToken:         nil,
+		},
+		fmt.Errorf("unsupported, keep the interface")
+}
+
+// parseJwtClaims returns the claims of a JWT, without verifying its signature
+func parseJwtClaims(token string) (map[string]interface{}, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("the token is not a JWT")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, err
+	}
+	claims := map[string]interface{}{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func getOidcConfigProvider(d *schema.ResourceData) (*oidcConfigurationProvider, error) {
+	attributes := map[string]string{}
+	for _, attrName := range []string{globalvar.OidcTokenExchangeEndpointAttrName, globalvar.OidcClientIdAttrName, globalvar.OidcClientSecretAttrName} {
+		value, ok := d.GetOk(attrName)
+		if !ok {
+			return nil, fmt.Errorf("can not get %s from Terraform configuration (%s)", attrName, globalvar.AuthOIDCSetting)
+		}
+		attributes[attrName] = value.(string)
+	}
+
+	var subjectToken oidcTokenSource
+	if tokenFile, ok := d.GetOk(globalvar.OidcTokenFileAttrName); ok {
+		subjectToken = oidcTokenFromFile(tokenFile.(string))
+	} else if token, ok := d.GetOk(globalvar.OidcTokenAttrName); ok {
+		subjectToken = oidcTokenFromValue(token.(string))
+	} else {
+		return nil, fmt.Errorf("an %s or an %s must be provided if auth is set to '%s'", globalvar.OidcTokenAttrName, globalvar.OidcTokenFileAttrName, globalvar.AuthOIDCSetting)
+	}
+
+	return newOidcConfigurationProvider(attributes[globalvar.OidcTokenExchangeEndpointAttrName], attributes[globalvar.OidcClientIdAttrName], attributes[globalvar.OidcClientSecretAttrName], subjectToken, BuildHttpClient())
+}
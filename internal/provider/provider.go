@@ -90,7 +90,7 @@ func ociVarName(attrName string) string {
 
 func init() {
 	descriptions = map[string]string{
-		globalvar.AuthAttrName:        fmt.Sprintf("(Optional) The type of auth to use. Options are '%s', '%s' and '%s' and '%s'. By default, '%s' will be used.", globalvar.AuthAPIKeySetting, globalvar.AuthSecurityToken, globalvar.AuthInstancePrincipalSetting, globalvar.ResourcePrincipal, globalvar.AuthAPIKeySetting),
+		globalvar.AuthAttrName:        fmt.Sprintf("(Optional) The type of auth to use. Options are '%s', '%s' and '%s' and '%s' and '%s'. By default, '%s' will be used.", globalvar.AuthAPIKeySetting, globalvar.AuthSecurityToken, globalvar.AuthInstancePrincipalSetting, globalvar.ResourcePrincipal, globalvar.AuthOIDCSetting, globalvar.AuthAPIKeySetting),
 		globalvar.TenancyOcidAttrName: fmt.Sprintf("(Optional) The tenancy OCID for a user. The tenancy OCID can be found at the bottom of user settings in the Oracle Cloud Infrastructure console. Required if auth is set to '%s', ignored otherwise.", globalvar.AuthAPIKeySetting),
 		globalvar.UserOcidAttrName:    fmt.Sprintf("(Optional) The user OCID. This can be found in user settings in the Oracle Cloud Infrastructure console. Required if auth is set to '%s', ignored otherwise.", globalvar.AuthAPIKeySetting),
 		globalvar.FingerprintAttrName: fmt.Sprintf("(Optional) The fingerprint for the user's RSA key. This can be found in user settings in the Oracle Cloud Infrastructure console. Required if auth is set to '%s', ignored otherwise.", globalvar.AuthAPIKeySetting),
@@ -105,7 +105,25 @@ func init() {
 		globalvar.RetryDurationSecondsAttrName: "(Optional) The minimum duration (in seconds) to retry a resource operation in response to an error.\n" +
 			"The actual retry duration may be longer due to jittering of retry operations. This value is ignored if the `disable_auto_retries` field is set to true.",
 		globalvar.ConfigFileProfileAttrName: "(Optional) The profile name to be used from config file, if not set it will be DEFAULT.",
-		globalvar.DefinedTagsToIgnore:       "(Optional) List of defined tags keys that Terraform should ignore when planning creates and updates to the associated remote object",
+		globalvar.DefinedTagsToIgnore:       "(Optional) List of defined tags keys that Terraform should ignore when planning creates and updates to the associated remote object. A key may use '*' as a wildcard, e.g. 'Oracle-Tags.*'. Keys are matched case insensitively",
+		globalvar.FreeformTagsToIgnore:      "(Optional) List of freeform tags keys that Terraform should ignore when planning creates and updates to the associated remote object. A key may use '*' as a wildcard. Keys are case sensitive",
+		globalvar.DefaultTagsAttrName: "(Optional) Tags applied to every resource supporting defined_tags and freeform_tags. Tags set on a resource win over the default tags with the same key.\n" +
+			fmt.Sprintf("The '%s' keys use the namespace.key format of the defined_tags of resources.", globalvar.DefaultTagsDefinedTagsAttrName),
+		globalvar.RetryPolicyAttrName: "(Optional) Retry profile for a service, overriding how long and how often failed requests are retried.\n" +
+			fmt.Sprintf("The '%s' is the service name (e.g. 'database', 'identity', 'limits'), or '%s' to apply the profile to every service without its own profile. ", globalvar.RetryPolicyServiceAttrName, tf_resource.DefaultRetryProfileName) +
+			fmt.Sprintf("Profiles are ignored if the `%s` field is set to true.", globalvar.DisableAutoRetriesAttrName),
+		globalvar.RequestLimitAttrName: "(Optional) Client-side rate limit for the requests sent to a service, so that requests are throttled before the service rejects them with TooManyRequests.\n" +
+			fmt.Sprintf("The '%s' is the client name (e.g. 'oci_identity.IdentityClient', 'oci_core.VirtualNetworkClient'), or '%s' to apply the limit to every client without its own limit.", globalvar.RequestLimitServiceAttrName, tf_client.DefaultRequestLimitName),
+		globalvar.MaxConcurrentRequestsAttrName:     "(Optional) The maximum number of requests in flight across all services at any time. There is no limit if not set.",
+		globalvar.OidcTokenExchangeEndpointAttrName: fmt.Sprintf("(Optional) The token exchange endpoint of the identity domain, e.g. https://idcs-<id>.identity.oraclecloud.com/oauth2/v1/token. Required if auth is set to '%s', ignored otherwise.", globalvar.AuthOIDCSetting),
+		globalvar.OidcClientIdAttrName:              fmt.Sprintf("(Optional) The client ID of the identity domain application trusting the OIDC issuer. Required if auth is set to '%s', ignored otherwise.", globalvar.AuthOIDCSetting),
+		globalvar.OidcClientSecretAttrName:          fmt.Sprintf("(Optional) The client secret of the identity domain application trusting the OIDC issuer. Required if auth is set to '%s', ignored otherwise.", globalvar.AuthOIDCSetting),
+		globalvar.OidcTokenAttrName: "(Optional) The OIDC JWT to exchange for a session token, such as the ID token of a CI job.\n" +
+			fmt.Sprintf("An %s or an %s must be provided if auth is set to '%s', ignored otherwise.", globalvar.OidcTokenAttrName, globalvar.OidcTokenFileAttrName, globalvar.AuthOIDCSetting),
+		globalvar.OidcTokenFileAttrName: "(Optional) The path of a file holding the OIDC JWT to exchange for a session token. The file is read again whenever the session token is refreshed.\n" +
+			fmt.Sprintf("An %s or an %s must be provided if auth is set to '%s', ignored otherwise.", globalvar.OidcTokenAttrName, globalvar.OidcTokenFileAttrName, globalvar.AuthOIDCSetting),
+		globalvar.AuditLogFileAttrName: "(Optional) The path of a file to append a JSON line to for every request sent to the services, with the operation, resource type, HTTP status, latency, retry attempt and opc-request-id.\n" +
+			"Credentials such as the Authorization header are redacted. Nothing is recorded if not set.",
 	}
 }
 
@@ -201,6 +219,154 @@ func SchemaMap() map[string]*schema.Schema {
 			Description: descriptions[globalvar.DefinedTagsToIgnore],
 			MaxItems:    100,
 		},
+		globalvar.FreeformTagsToIgnore: {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: descriptions[globalvar.FreeformTagsToIgnore],
+			MaxItems:    100,
+		},
+		globalvar.DefaultTagsAttrName: {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: descriptions[globalvar.DefaultTagsAttrName],
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					globalvar.DefaultTagsDefinedTagsAttrName: {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Elem:     schema.TypeString,
+					},
+					globalvar.DefaultTagsFreeformTagsAttrName: {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Elem:     schema.TypeString,
+					},
+				},
+			},
+		},
+		globalvar.RetryPolicyAttrName: {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: descriptions[globalvar.RetryPolicyAttrName],
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					globalvar.RetryPolicyServiceAttrName: {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(tf_resource.RetryProfileServices(), true),
+					},
+					globalvar.RetryPolicyMaxDurationSecondsAttrName: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+					globalvar.RetryPolicyBackoffBaseSecondsAttrName: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+					globalvar.RetryPolicyBackoffCapSecondsAttrName: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+					globalvar.RetryPolicyJitterAttrName: {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+					globalvar.RetryPolicyRetryableStatusCodesAttrName: {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type:         schema.TypeInt,
+							ValidateFunc: validation.IntBetween(100, 599),
+						},
+					},
+					globalvar.RetryPolicyRetryableErrorCodesAttrName: {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+		globalvar.RequestLimitAttrName: {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: descriptions[globalvar.RequestLimitAttrName],
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					globalvar.RequestLimitServiceAttrName: {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					globalvar.RequestLimitRequestsPerSecondAttrName: {
+						Type:         schema.TypeFloat,
+						Required:     true,
+						ValidateFunc: validation.FloatAtLeast(0),
+					},
+					globalvar.RequestLimitBurstAttrName: {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+					globalvar.RequestLimitPerEndpointAttrName: {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+		globalvar.MaxConcurrentRequestsAttrName: {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  descriptions[globalvar.MaxConcurrentRequestsAttrName],
+			ValidateFunc: validation.IntAtLeast(0),
+			DefaultFunc:  schema.MultiEnvDefaultFunc([]string{tfVarName(globalvar.MaxConcurrentRequestsAttrName), ociVarName(globalvar.MaxConcurrentRequestsAttrName)}, 0),
+		},
+		globalvar.OidcTokenExchangeEndpointAttrName: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: descriptions[globalvar.OidcTokenExchangeEndpointAttrName],
+			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(globalvar.OidcTokenExchangeEndpointAttrName), ociVarName(globalvar.OidcTokenExchangeEndpointAttrName)}, nil),
+		},
+		globalvar.OidcClientIdAttrName: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: descriptions[globalvar.OidcClientIdAttrName],
+			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(globalvar.OidcClientIdAttrName), ociVarName(globalvar.OidcClientIdAttrName)}, nil),
+		},
+		globalvar.OidcClientSecretAttrName: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: descriptions[globalvar.OidcClientSecretAttrName],
+			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(globalvar.OidcClientSecretAttrName), ociVarName(globalvar.OidcClientSecretAttrName)}, nil),
+		},
+		globalvar.OidcTokenAttrName: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: descriptions[globalvar.OidcTokenAttrName],
+			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(globalvar.OidcTokenAttrName), ociVarName(globalvar.OidcTokenAttrName)}, nil),
+		},
+		globalvar.OidcTokenFileAttrName: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: descriptions[globalvar.OidcTokenFileAttrName],
+			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(globalvar.OidcTokenFileAttrName), ociVarName(globalvar.OidcTokenFileAttrName)}, nil),
+		},
+		globalvar.AuditLogFileAttrName: {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: descriptions[globalvar.AuditLogFileAttrName],
+			DefaultFunc: schema.MultiEnvDefaultFunc([]string{tfVarName(globalvar.AuditLogFileAttrName), ociVarName(globalvar.AuditLogFileAttrName)}, nil),
+		},
 	}
 }
 
@@ -229,6 +395,13 @@ func ResourcesMap() map[string]*schema.Resource {
 
 func ProviderConfig(d *schema.ResourceData) (interface{}, error) {
 	tf_resource.DefinedTagsToSuppress = IgnoreDefinedTags(d)
+	tf_resource.FreeformTagsToSuppress = IgnoreFreeformTags(d)
+	defaultDefinedTags, defaultFreeformTags, err := DefaultTags(d)
+	if err != nil {
+		return nil, err
+	}
+	tf_resource.DefaultDefinedTags = defaultDefinedTags
+	tf_resource.DefaultFreeformTags = defaultFreeformTags
 	clients := &tf_client.OracleClients{
 		SdkClientMap:  make(map[string]interface{}, len(tf_client.OracleClientRegistrationsVar.RegisteredClients)),
 		Configuration: make(map[string]string),
@@ -246,11 +419,36 @@ func ProviderConfig(d *schema.ResourceData) (interface{}, error) {
 		tf_resource.ConfiguredRetryDuration = &val
 	}
 
+	if !d.Get(globalvar.DisableAutoRetriesAttrName).(bool) {
+		retryProfiles, err := RetryProfiles(d)
+		if err != nil {
+			return nil, err
+		}
+		tf_resource.RetryProfiles = retryProfiles
+	}
+
+	requestLimits, err := RequestLimits(d)
+	if err != nil {
+		return nil, err
+	}
+	tf_client.RequestLimiterVar = nil
+	if maxConcurrentRequests := d.Get(globalvar.MaxConcurrentRequestsAttrName).(int); len(requestLimits) > 0 || maxConcurrentRequests > 0 {
+		tf_client.RequestLimiterVar = tf_client.NewRequestLimiter(requestLimits, maxConcurrentRequests)
+	}
+
 	sdkConfigProvider, err := GetSdkConfigProvider(d, clients)
 	if err != nil {
 		return nil, err
 	}
 
+	tf_client.AuditLogVar = nil
+	if auditLogFile, ok := d.GetOk(globalvar.AuditLogFileAttrName); ok {
+		tf_client.AuditLogVar, err = tf_client.OpenAuditLog(auditLogFile.(string))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	httpClient := BuildHttpClient()
 
 	// beware: global variable `configureClient` set here--used elsewhere outside this execution path
@@ -263,6 +461,7 @@ func ProviderConfig(d *schema.ResourceData) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
+	tf_resource.ProviderRegion, _ = sdkConfigProvider.Region()
 
 	AvoidWaitingForDeleteTarget, _ = strconv.ParseBool(utils.GetEnvSettingWithDefault("avoid_waiting_for_delete_target", "false"))
 
@@ -304,6 +503,11 @@ func GetSdkConfigProvider(d *schema.ResourceData, clients *tf_client.OracleClien
 		return nil, err
 	}
 
+	// The SDK only retries requests rejected because of an expired token if the provider used for signing is refreshable
+	if auth == strings.ToLower(globalvar.AuthSecurityToken) {
+		sdkConfigProvider = refreshableConfigurationProvider{sdkConfigProvider}
+	}
+
 	return sdkConfigProvider, nil
 }
 
@@ -424,15 +628,37 @@ func getConfigProviders(d *schema.ResourceData, auth string) ([]oci_common.Confi
 		if err != nil || !strings.HasPrefix(keyId, "ST$") {
 			return nil, fmt.Errorf("Security token is invalid ")
 		}
-		configProviders = append(configProviders, securityTokenBasedAuthConfigProvider)
+		tokenFilePath, err := utils.GetProfileValue(profileString, defaultPath, securityTokenFileConfigKey)
+		if err != nil {
+			return nil, err
+		}
+		configProviders = append(configProviders, newSecurityTokenConfigurationProvider(securityTokenBasedAuthConfigProvider, tokenFilePath, region.(string), BuildHttpClient()))
 	case strings.ToLower(globalvar.ResourcePrincipal):
 		resourcePrincipalAuthConfigProvider, err := oci_common_auth.ResourcePrincipalConfigurationProvider()
 		if err != nil {
 			return nil, err
 		}
 		configProviders = append(configProviders, resourcePrincipalAuthConfigProvider)
+	case strings.ToLower(globalvar.AuthOIDCSetting):
+		_, ok := utils.CheckIncompatibleAttrsForApiKeyAuth(d, ApiKeyConfigAttributes)
+		if !ok {
+			log.Printf("[DEBUG] Ignoring all user credentials for %v authentication", auth)
+		}
+
+		region, ok := d.GetOk(globalvar.RegionAttrName)
+		if !ok {
+			return nil, fmt.Errorf("can not get %s from Terraform configuration (OIDC)", globalvar.RegionAttrName)
+		}
+		regionProvider := oci_common.NewRawConfigurationProvider("", "", region.(string), "", "", nil)
+		configProviders = append(configProviders, regionProvider)
+
+		oidcConfigProvider, err := getOidcConfigProvider(d)
+		if err != nil {
+			return nil, err
+		}
+		configProviders = append(configProviders, oidcConfigProvider)
 	default:
-		return nil, fmt.Errorf("auth must be one of '%s' or '%s' or '%s' or '%s' or '%s'", globalvar.AuthAPIKeySetting, globalvar.AuthInstancePrincipalSetting, globalvar.AuthInstancePrincipalWithCertsSetting, globalvar.AuthSecurityToken, globalvar.ResourcePrincipal)
+		return nil, fmt.Errorf("auth must be one of '%s' or '%s' or '%s' or '%s' or '%s' or '%s'", globalvar.AuthAPIKeySetting, globalvar.AuthInstancePrincipalSetting, globalvar.AuthInstancePrincipalWithCertsSetting, globalvar.AuthSecurityToken, globalvar.ResourcePrincipal, globalvar.AuthOIDCSetting)
 	}
 
 	return configProviders, nil
@@ -487,7 +713,15 @@ func (p ResourceDataConfigProvider) Region() (string, error) {
 }
 
 func IgnoreDefinedTags(d schemaResourceData) []string {
-	if ignoreTags, ok := d.GetOkExists(globalvar.DefinedTagsToIgnore); ok {
+	return ignoreTags(d, globalvar.DefinedTagsToIgnore)
+}
+
+func IgnoreFreeformTags(d schemaResourceData) []string {
+	return ignoreTags(d, globalvar.FreeformTagsToIgnore)
+}
+
+func ignoreTags(d schemaResourceData, attrName string) []string {
+	if ignoreTags, ok := d.GetOkExists(attrName); ok {
 		var tags []string
 		for _, item := range ignoreTags.([]interface{}) {
 			tags = append(tags, item.(string))
@@ -497,6 +731,87 @@ func IgnoreDefinedTags(d schemaResourceData) []string {
 	return nil
 }
 
+// DefaultTags returns the defined and freeform tags of the default_tags block of the provider
+func DefaultTags(d schemaResourceData) (map[string]interface{}, map[string]interface{}, error) {
+	defaultTags, ok := d.GetOkExists(globalvar.DefaultTagsAttrName)
+	if !ok {
+		return nil, nil, nil
+	}
+	items, _ := defaultTags.([]interface{})
+	if len(items) == 0 || items[0] == nil {
+		return nil, nil, nil
+	}
+	block := items[0].(map[string]interface{})
+
+	definedTags, _ := block[globalvar.DefaultTagsDefinedTagsAttrName].(map[string]interface{})
+	convertedDefinedTags, err := tf_resource.MapToDefinedTags(definedTags)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %s %s", globalvar.DefaultTagsAttrName, globalvar.DefaultTagsDefinedTagsAttrName, err)
+	}
+	freeformTags, _ := block[globalvar.DefaultTagsFreeformTagsAttrName].(map[string]interface{})
+	return tf_resource.DefinedTagsToMap(convertedDefinedTags), freeformTags, nil
+}
+
+// RetryProfiles builds the per service retry profiles from the retry_policy blocks of the provider
+func RetryProfiles(d *schema.ResourceData) (map[string]*tf_resource.RetryProfile, error) {
+	retryProfiles := map[string]*tf_resource.RetryProfile{}
+	for _, item := range d.Get(globalvar.RetryPolicyAttrName).([]interface{}) {
+		policy, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		service := strings.ToLower(policy[globalvar.RetryPolicyServiceAttrName].(string))
+		if _, exists := retryProfiles[service]; exists {
+			return nil, fmt.Errorf("%s for service '%s' is defined more than once", globalvar.RetryPolicyAttrName, service)
+		}
+
+		profile := &tf_resource.RetryProfile{
+			BackoffBase: time.Duration(policy[globalvar.RetryPolicyBackoffBaseSecondsAttrName].(int)) * time.Second,
+			BackoffCap:  time.Duration(policy[globalvar.RetryPolicyBackoffCapSecondsAttrName].(int)) * time.Second,
+			Jitter:      policy[globalvar.RetryPolicyJitterAttrName].(bool),
+		}
+		if profile.BackoffCap > 0 && profile.BackoffBase > profile.BackoffCap {
+			return nil, fmt.Errorf("%s for service '%s': %s must not be greater than %s", globalvar.RetryPolicyAttrName, service, globalvar.RetryPolicyBackoffBaseSecondsAttrName, globalvar.RetryPolicyBackoffCapSecondsAttrName)
+		}
+		if maxDurationSeconds := policy[globalvar.RetryPolicyMaxDurationSecondsAttrName].(int); maxDurationSeconds > 0 {
+			val := time.Duration(maxDurationSeconds) * time.Second
+			profile.MaxDuration = &val
+		}
+		for _, statusCode := range policy[globalvar.RetryPolicyRetryableStatusCodesAttrName].([]interface{}) {
+			profile.RetryableStatusCodes = append(profile.RetryableStatusCodes, statusCode.(int))
+		}
+		for _, errorCode := range policy[globalvar.RetryPolicyRetryableErrorCodesAttrName].([]interface{}) {
+			profile.RetryableErrorCodes = append(profile.RetryableErrorCodes, errorCode.(string))
+		}
+		retryProfiles[service] = profile
+	}
+	return retryProfiles, nil
+}
+
+// RequestLimits builds the per client request limits from the request_limit blocks of the provider
+func RequestLimits(d *schema.ResourceData) (map[string]tf_client.RequestLimit, error) {
+	requestLimits := map[string]tf_client.RequestLimit{}
+	for _, item := range d.Get(globalvar.RequestLimitAttrName).([]interface{}) {
+		limit, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		service := limit[globalvar.RequestLimitServiceAttrName].(string)
+		if _, exists := requestLimits[service]; exists {
+			return nil, fmt.Errorf("%s for service '%s' is defined more than once", globalvar.RequestLimitAttrName, service)
+		}
+		if _, registered := tf_client.OracleClientRegistrationsVar.RegisteredClients[service]; !registered && service != tf_client.DefaultRequestLimitName && service != tf_client.WorkRequestClientName {
+			return nil, fmt.Errorf("%s for service '%s': unknown service, expected a client name such as 'oci_identity.IdentityClient' or '%s'", globalvar.RequestLimitAttrName, service, tf_client.DefaultRequestLimitName)
+		}
+		requestLimits[service] = tf_client.RequestLimit{
+			RequestsPerSecond: limit[globalvar.RequestLimitRequestsPerSecondAttrName].(float64),
+			Burst:             limit[globalvar.RequestLimitBurstAttrName].(int),
+			PerEndpoint:       limit[globalvar.RequestLimitPerEndpointAttrName].(bool),
+		}
+	}
+	return requestLimits, nil
+}
+
 func (p ResourceDataConfigProvider) KeyID() (string, error) {
 	tenancy, err := p.TenancyOCID()
 	if err != nil {
@@ -660,6 +975,10 @@ func BuildConfigureClientFn(configProvider oci_common.ConfigurationProvider, htt
 			}
 		}
 
+		if tf_client.AuditLogVar != nil {
+			client.HTTPClient = tf_client.AuditLogVar.Dispatcher(client.HTTPClient)
+		}
+
 		return nil
 	}
 
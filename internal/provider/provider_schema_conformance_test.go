@@ -0,0 +1,572 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// Run with a command something like:
+//   go test ./internal/provider -run TestUnitSchemaConformance
+// and regenerate the baseline of the known violations after fixing some of them with:
+//   go test ./internal/provider -run TestUnitSchemaConformance -update_schema_conformance_baseline
+
+const (
+	sdkImportPath                     = "github.com/oracle/oci-go-sdk/v65/"
+	schemaConformanceBaselineFileName = "testdata/schema_conformance_baseline.txt"
+	// unresolvedSdkRequestsViolation is recorded for the resources whose SDK requests are not found, which can not be checked
+	unresolvedSdkRequestsViolation = "the SDK requests of the CRUD are not resolved"
+)
+
+var updateSchemaConformanceBaseline = flag.Bool("update_schema_conformance_baseline", false, "regenerate "+schemaConformanceBaselineFileName+" from the current schema conformance violations")
+
+var sdkStructTypeRegex = regexp.MustCompile(`(?m)^type (\w+) struct`)
+
+// sdkStructField is a field of the body of an SDK request
+type sdkStructField struct {
+	Name      string
+	JsonName  string
+	Mandatory bool
+}
+
+// sdkStruct is the body of an SDK create or update request
+type sdkStruct struct {
+	Name   string
+	Fields []sdkStructField
+}
+
+// sdkPackage lazily parses the structs of a vendored SDK package, only the files declaring the requested structs are parsed
+type sdkPackage struct {
+	dir       string
+	fileNames map[string]string
+	structs   map[string]*ast.StructType
+}
+
+func newSdkPackage(dir string) (*sdkPackage, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	pkg := &sdkPackage{dir: dir, fileNames: map[string]string{}, structs: map[string]*ast.StructType{}}
+	for _, file := range files {
+		if !strings.HasSuffix(file.Name(), ".go") {
+			continue
+		}
+		content, err := ioutil.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+		for _, match := range sdkStructTypeRegex.FindAllSubmatch(content, -1) {
+			pkg.fileNames[string(match[1])] = file.Name()
+		}
+	}
+	return pkg, nil
+}
+
+func (pkg *sdkPackage) getStructType(name string) (*ast.StructType, error) {
+	if structType, ok := pkg.structs[name]; ok {
+		return structType, nil
+	}
+	fileName, ok := pkg.fileNames[name]
+	if !ok {
+		// Polymorphic request bodies are interfaces
+		return nil, nil
+	}
+	file, err := parser.ParseFile(token.NewFileSet(), filepath.Join(pkg.dir, fileName), nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			if typeSpec, ok := spec.(*ast.TypeSpec); ok {
+				if structType, ok := typeSpec.Type.(*ast.StructType); ok {
+					pkg.structs[typeSpec.Name.Name] = structType
+				}
+			}
+		}
+	}
+	return pkg.structs[name], nil
+}
+
+// getRequestBody returns the struct contributing to the body of a request, or nil if the request has no body struct
+func (pkg *sdkPackage) getRequestBody(requestName string) (*sdkStruct, error) {
+	request, err := pkg.getStructType(requestName)
+	if err != nil || request == nil {
+		return nil, err
+	}
+	for _, field := range request.Fields.List {
+		if getStructTag(field, "contributesTo") != "body" {
+			continue
+		}
+		ident, ok := field.Type.(*ast.Ident)
+		if !ok {
+			return nil, nil
+		}
+		body, err := pkg.getStructType(ident.Name)
+		if err != nil || body == nil {
+			return nil, err
+		}
+		result := &sdkStruct{Name: ident.Name}
+		for _, bodyField := range body.Fields.List {
+			jsonName := strings.Split(getStructTag(bodyField, "json"), ",")[0]
+			if jsonName == "" || jsonName == "-" {
+				continue
+			}
+			for _, name := range bodyField.Names {
+				result.Fields = append(result.Fields, sdkStructField{Name: name.Name, JsonName: jsonName, Mandatory: getStructTag(bodyField, "mandatory") == "true"})
+			}
+		}
+		return result, nil
+	}
+	return nil, nil
+}
+
+func getStructTag(field *ast.Field, key string) string {
+	if field.Tag == nil {
+		return ""
+	}
+	tag, err := strconv.Unquote(field.Tag.Value)
+	if err != nil {
+		return ""
+	}
+	return reflect.StructTag(tag).Get(key)
+}
+
+// resourceSdkRequests are the SDK request bodies used by the Create and Update of a resource
+type resourceSdkRequests struct {
+	Create *sdkStruct
+	Update *sdkStruct
+}
+
+// schemaConformanceChecker finds the SDK requests used by the resources from the source of their service package
+type schemaConformanceChecker struct {
+	vendorDir    string
+	constructors map[string]resourceConstructor
+	funcFiles    map[string]map[string]string
+	sdkPackages  map[string]*sdkPackage
+	files        map[string]*ast.File
+}
+
+// resourceConstructor is the function returning the schema of a resource in a service package
+type resourceConstructor struct {
+	dir  string
+	name string
+}
+
+var serviceFuncRegex = regexp.MustCompile(`(?m)^func (?:\(\w+ \*?(\w+)\) )?(\w+)\(`)
+
+// newSchemaConformanceChecker finds the constructors of the resources from the register_resource.go files of the services
+func newSchemaConformanceChecker(vendorDir string, serviceDir string) (*schemaConformanceChecker, error) {
+	c := &schemaConformanceChecker{
+		vendorDir:    vendorDir,
+		constructors: map[string]resourceConstructor{},
+		funcFiles:    map[string]map[string]string{},
+		sdkPackages:  map[string]*sdkPackage{},
+		files:        map[string]*ast.File{},
+	}
+	registerFileNames, err := filepath.Glob(filepath.Join(serviceDir, "*", "register_resource.go"))
+	if err != nil {
+		return nil, err
+	}
+	for _, fileName := range registerFileNames {
+		file, err := c.parseFile(fileName)
+		if err != nil {
+			return nil, err
+		}
+		ast.Inspect(file, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok || len(call.Args) != 2 {
+				return true
+			}
+			if selector, ok := call.Fun.(*ast.SelectorExpr); !ok || selector.Sel.Name != "RegisterResource" {
+				return true
+			}
+			name, ok := call.Args[0].(*ast.BasicLit)
+			constructorCall, isCall := call.Args[1].(*ast.CallExpr)
+			if !ok || !isCall {
+				return true
+			}
+			if constructor, ok := constructorCall.Fun.(*ast.Ident); ok {
+				resourceName, _ := strconv.Unquote(name.Value)
+				c.constructors[resourceName] = resourceConstructor{dir: filepath.Dir(fileName), name: constructor.Name}
+			}
+			return false
+		})
+	}
+	return c, nil
+}
+
+func (c *schemaConformanceChecker) getSdkPackage(importPath string) (*sdkPackage, error) {
+	if pkg, ok := c.sdkPackages[importPath]; ok {
+		return pkg, nil
+	}
+	pkg, err := newSdkPackage(filepath.Join(c.vendorDir, filepath.FromSlash(importPath)))
+	if err != nil {
+		return nil, err
+	}
+	c.sdkPackages[importPath] = pkg
+	return pkg, nil
+}
+
+func (c *schemaConformanceChecker) parseFile(fileName string) (*ast.File, error) {
+	if file, ok := c.files[fileName]; ok {
+		return file, nil
+	}
+	file, err := parser.ParseFile(token.NewFileSet(), fileName, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	c.files[fileName] = file
+	return file, nil
+}
+
+// getFuncDecl returns the declaration of a function, or of a method when receiverName is set, of a service package with
+// the file declaring it
+func (c *schemaConformanceChecker) getFuncDecl(dir string, receiverName string, name string) (*ast.FuncDecl, *ast.File, error) {
+	funcFiles, ok := c.funcFiles[dir]
+	if !ok {
+		funcFiles = map[string]string{}
+		fileNames, err := filepath.Glob(filepath.Join(dir, "*.go"))
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, fileName := range fileNames {
+			if strings.HasSuffix(fileName, "_test.go") {
+				continue
+			}
+			content, err := ioutil.ReadFile(fileName)
+			if err != nil {
+				return nil, nil, err
+			}
+			for _, match := range serviceFuncRegex.FindAllSubmatch(content, -1) {
+				funcFiles[string(match[1])+"."+string(match[2])] = fileName
+			}
+		}
+		c.funcFiles[dir] = funcFiles
+	}
+	fileName, ok := funcFiles[receiverName+"."+name]
+	if !ok {
+		return nil, nil, nil
+	}
+	file, err := c.parseFile(fileName)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, decl := range file.Decls {
+		if funcDecl, ok := decl.(*ast.FuncDecl); ok && funcDecl.Name.Name == name && (funcDecl.Recv == nil) == (receiverName == "") {
+			if receiverName == "" || getReceiverName(funcDecl) == receiverName {
+				return funcDecl, file, nil
+			}
+		}
+	}
+	return nil, nil, nil
+}
+
+// getResourceSdkRequests returns the bodies of the Create*Request and Update*Request of the CRUD of a resource. The CRUD is
+// the struct created by the create function of the resource constructor, the requests are looked up in its Create and
+// Update methods. It returns nil when the CRUD of the resource is not found.
+func (c *schemaConformanceChecker) getResourceSdkRequests(resourceName string) (*resourceSdkRequests, error) {
+	constructor, ok := c.constructors[resourceName]
+	if !ok {
+		return nil, nil
+	}
+	constructorDecl, _, err := c.getFuncDecl(constructor.dir, "", constructor.name)
+	if err != nil || constructorDecl == nil {
+		return nil, err
+	}
+	createFnName := ""
+	ast.Inspect(constructorDecl, func(node ast.Node) bool {
+		if keyValue, ok := node.(*ast.KeyValueExpr); ok {
+			key, isKeyIdent := keyValue.Key.(*ast.Ident)
+			value, isValueIdent := keyValue.Value.(*ast.Ident)
+			if isKeyIdent && isValueIdent && key.Name == "CreateContext" {
+				createFnName = value.Name
+			}
+		}
+		return createFnName == ""
+	})
+	if createFnName == "" {
+		return nil, nil
+	}
+	createFnDecl, _, err := c.getFuncDecl(constructor.dir, "", createFnName)
+	if err != nil || createFnDecl == nil {
+		return nil, err
+	}
+	crudName := findCrudName(createFnDecl)
+	if crudName == "" {
+		return nil, nil
+	}
+
+	requests := &resourceSdkRequests{}
+	for _, method := range []struct {
+		name   string
+		target **sdkStruct
+	}{
+		{"Create", &requests.Create},
+		{"Update", &requests.Update},
+	} {
+		funcDecl, file, err := c.getFuncDecl(constructor.dir, crudName, method.name)
+		if err != nil {
+			return nil, err
+		}
+		if funcDecl == nil || funcDecl.Body == nil {
+			continue
+		}
+		importPath, requestName := findSdkRequest(funcDecl, getSdkImports(file), method.name)
+		if requestName == "" {
+			continue
+		}
+		pkg, err := c.getSdkPackage(importPath)
+		if err != nil {
+			return nil, err
+		}
+		if *method.target, err = pkg.getRequestBody(requestName); err != nil {
+			return nil, err
+		}
+	}
+	return requests, nil
+}
+
+// getSdkImports returns the import paths of the SDK packages imported by a file by their name in the file
+func getSdkImports(file *ast.File) map[string]string {
+	sdkImports := map[string]string{}
+	for _, spec := range file.Imports {
+		importPath, _ := strconv.Unquote(spec.Path.Value)
+		if !strings.HasPrefix(importPath, sdkImportPath) {
+			continue
+		}
+		alias := importPath[strings.LastIndex(importPath, "/")+1:]
+		if spec.Name != nil {
+			alias = spec.Name.Name
+		}
+		sdkImports[alias] = importPath
+	}
+	return sdkImports
+}
+
+func findCrudName(funcDecl *ast.FuncDecl) string {
+	crudName := ""
+	ast.Inspect(funcDecl, func(node ast.Node) bool {
+		if literal, ok := node.(*ast.CompositeLit); ok && crudName == "" {
+			if ident, ok := literal.Type.(*ast.Ident); ok && strings.HasSuffix(ident.Name, "Crud") {
+				crudName = ident.Name
+			}
+		}
+		return crudName == ""
+	})
+	return crudName
+}
+
+func getReceiverName(funcDecl *ast.FuncDecl) string {
+	receiverType := funcDecl.Recv.List[0].Type
+	if star, ok := receiverType.(*ast.StarExpr); ok {
+		receiverType = star.X
+	}
+	if ident, ok := receiverType.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+// findSdkRequest returns the first SDK request type starting with prefix referenced in a method
+func findSdkRequest(funcDecl *ast.FuncDecl, sdkImports map[string]string, prefix string) (string, string) {
+	importPath, requestName := "", ""
+	ast.Inspect(funcDecl.Body, func(node ast.Node) bool {
+		selector, ok := node.(*ast.SelectorExpr)
+		if !ok || requestName != "" {
+			return requestName == ""
+		}
+		ident, ok := selector.X.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		if path, ok := sdkImports[ident.Name]; ok && strings.HasPrefix(selector.Sel.Name, prefix) && strings.HasSuffix(selector.Sel.Name, "Request") {
+			importPath, requestName = path, selector.Sel.Name
+		}
+		return requestName == ""
+	})
+	return importPath, requestName
+}
+
+// normalizeSchemaConformanceName matches the snake case names of the schemas to the camel case json names of the SDK
+func normalizeSchemaConformanceName(name string) string {
+	return strings.ToLower(strings.Replace(name, "_", "", -1))
+}
+
+// checkSchemaConformance returns the violations of a resource schema against the bodies of its SDK requests:
+//   - the fields which can be updated in the SDK must not be ForceNew
+//   - the fields which are mandatory to create in the SDK must be Required
+func checkSchemaConformance(resourceName string, resourceSchema map[string]*schema.Schema, requests *resourceSdkRequests) []string {
+	attributes := map[string]string{}
+	for name := range resourceSchema {
+		attributes[normalizeSchemaConformanceName(name)] = name
+	}
+	violations := []string{}
+	if requests.Update != nil {
+		for _, field := range requests.Update.Fields {
+			if name, ok := attributes[normalizeSchemaConformanceName(field.JsonName)]; ok && resourceSchema[name].ForceNew {
+				violations = append(violations, fmt.Sprintf("%s: %s is ForceNew but can be updated with %s.%s", resourceName, name, requests.Update.Name, field.Name))
+			}
+		}
+	}
+	if requests.Create != nil {
+		for _, field := range requests.Create.Fields {
+			if !field.Mandatory {
+				continue
+			}
+			if name, ok := attributes[normalizeSchemaConformanceName(field.JsonName)]; ok && !resourceSchema[name].Required {
+				violations = append(violations, fmt.Sprintf("%s: %s is not Required but %s.%s is mandatory", resourceName, name, requests.Create.Name, field.Name))
+			}
+		}
+	}
+	sort.Strings(violations)
+	return violations
+}
+
+func readSchemaConformanceBaseline() (map[string]bool, error) {
+	baseline := map[string]bool{}
+	content, err := ioutil.ReadFile(schemaConformanceBaselineFileName)
+	if os.IsNotExist(err) {
+		return baseline, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, line := range strings.Split(string(content), "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+			baseline[line] = true
+		}
+	}
+	return baseline, nil
+}
+
+func writeSchemaConformanceBaseline(violations []string) error {
+	content := "# Known schema conformance violations, regenerate with:\n" +
+		"#   go test ./internal/provider -run TestUnitSchemaConformance -update_schema_conformance_baseline\n" +
+		strings.Join(violations, "\n") + "\n"
+	if err := os.MkdirAll(filepath.Dir(schemaConformanceBaselineFileName), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(schemaConformanceBaselineFileName, []byte(content), 0644)
+}
+
+func TestUnitCheckSchemaConformance(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"compartment_id": {Type: schema.TypeString, Required: true},
+		"cidr_block":     {Type: schema.TypeString, Optional: true, ForceNew: true},
+		"display_name":   {Type: schema.TypeString, Optional: true, Computed: true},
+		"is_ipv6enabled": {Type: schema.TypeBool, Optional: true, ForceNew: true},
+		"dns_label":      {Type: schema.TypeString, Optional: true, ForceNew: true},
+	}
+	requests := &resourceSdkRequests{
+		Create: &sdkStruct{Name: "CreateVcnDetails", Fields: []sdkStructField{
+			{Name: "CompartmentId", JsonName: "compartmentId", Mandatory: true},
+			{Name: "CidrBlock", JsonName: "cidrBlock", Mandatory: true},
+			{Name: "DnsLabel", JsonName: "dnsLabel"},
+			{Name: "Unknown", JsonName: "unknown", Mandatory: true},
+		}},
+		Update: &sdkStruct{Name: "UpdateVcnDetails", Fields: []sdkStructField{
+			{Name: "DisplayName", JsonName: "displayName"},
+			{Name: "IsIpv6Enabled", JsonName: "isIpv6Enabled"},
+		}},
+	}
+	assert.Equal(t, []string{
+		"oci_test_vcn: cidr_block is not Required but CreateVcnDetails.CidrBlock is mandatory",
+		"oci_test_vcn: is_ipv6enabled is ForceNew but can be updated with UpdateVcnDetails.IsIpv6Enabled",
+	}, checkSchemaConformance("oci_test_vcn", resourceSchema, requests))
+	assert.Empty(t, checkSchemaConformance("oci_test_vcn", resourceSchema, &resourceSdkRequests{}))
+}
+
+// TestUnitSchemaConformance cross-checks the schemas of all the resources against the SDK requests of their Create and
+// Update, it fails for the violations which are not in the baseline and for the baseline entries which are fixed. The
+// resources whose SDK requests are not found are listed in the baseline as well.
+func TestUnitSchemaConformance(t *testing.T) {
+	vendorDir, err := filepath.Abs(filepath.Join("..", "..", "vendor"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(vendorDir, filepath.FromSlash(sdkImportPath))); err != nil {
+		t.Skip("The vendored SDK is required to check the schema conformance")
+	}
+	checker, err := newSchemaConformanceChecker(vendorDir, filepath.Join("..", "service"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resourceNames := []string{}
+	for name := range ResourcesMap() {
+		resourceNames = append(resourceNames, name)
+	}
+	sort.Strings(resourceNames)
+
+	violations := []string{}
+	resourceViolations := map[string][]string{}
+	for _, name := range resourceNames {
+		resource := ResourcesMap()[name]
+		requests, err := checker.getResourceSdkRequests(name)
+		if err != nil {
+			t.Errorf("%s: unable to find the SDK requests: %v", name, err)
+			continue
+		}
+		if requests == nil {
+			// Record the resources whose CRUD is not resolved like violations, so that they are known rather than skipped
+			resourceViolations[name] = []string{name + ": " + unresolvedSdkRequestsViolation}
+		} else {
+			resourceViolations[name] = checkSchemaConformance(name, resource.Schema, requests)
+		}
+		violations = append(violations, resourceViolations[name]...)
+	}
+
+	if *updateSchemaConformanceBaseline {
+		if err := writeSchemaConformanceBaseline(violations); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	baseline, err := readSchemaConformanceBaseline()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range resourceNames {
+		newViolations := []string{}
+		for _, violation := range resourceViolations[name] {
+			if !baseline[violation] {
+				newViolations = append(newViolations, violation)
+			}
+			delete(baseline, violation)
+		}
+		if len(newViolations) > 0 {
+			t.Errorf("%s does not conform to the SDK:\n  %s", name, strings.Join(newViolations, "\n  "))
+		}
+	}
+	if len(baseline) > 0 {
+		fixed := []string{}
+		for violation := range baseline {
+			fixed = append(fixed, violation)
+		}
+		sort.Strings(fixed)
+		t.Errorf("The following violations are fixed, remove them from %s:\n  %s", schemaConformanceBaselineFileName, strings.Join(fixed, "\n  "))
+	}
+}
@@ -2,10 +2,19 @@ package provider
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
 	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
@@ -17,6 +26,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/httpreplay"
 	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+	tf_resource "github.com/oracle/terraform-provider-oci/internal/tfresource"
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 	"github.com/stretchr/testify/assert"
 )
@@ -75,6 +85,22 @@ func TestUnitProvider(t *testing.T) {
 	}
 }
 
+// The requests of a resource are only attributed to it in the audit log when its CRUD functions get a context,
+// ensure no resource or data source is registered with the legacy CRUD functions
+// issue-routing-tag: terraform/default
+func TestUnitProvider_contextCrud(t *testing.T) {
+	for kind, resources := range map[string]map[string]*schema.Resource{"resource": ResourcesMap(), "data source": DataSourcesMap()} {
+		for name, resource := range resources {
+			if resource.Create != nil || resource.Read != nil || resource.Update != nil || resource.Delete != nil {
+				t.Errorf("The %s %s is registered with CRUD functions which do not get a context", kind, name)
+			}
+			if resource.ReadContext == nil {
+				t.Errorf("The %s %s is registered without a ReadContext", kind, name)
+			}
+		}
+	}
+}
+
 // ensure the http client is configured with the expected settings
 // issue-routing-tag: terraform/default
 func TestUnitBuildHttpClient(t *testing.T) {
@@ -644,6 +670,65 @@ func TestUnitSecurityToken_basic(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func newTestSessionToken(jti string, expiresIn time.Duration) string {
+	claims, _ := json.Marshal(map[string]interface{}{"exp": time.Now().Add(expiresIn).Unix(), "jti": jti})
+	return "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString(claims) + ".c2lnbmF0dXJl"
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitSecurityTokenConfigProvider_refresh(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	privateKey := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+	profileProvider := oci_common.NewRawConfigurationProvider(testTenancyOCID, "", "us-phoenix-1", testKeyFingerPrint, privateKey, nil)
+
+	expiring := newTestSessionToken("expiring", time.Minute)
+	refreshed := newTestSessionToken("refreshed", time.Hour)
+	refreshes := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["currentToken"] != expiring || !strings.Contains(r.Header.Get("Authorization"), `keyId="ST$`+expiring+`"`) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		refreshes++
+		json.NewEncoder(w).Encode(map[string]string{"token": refreshed})
+	}))
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	assert.NoError(t, ioutil.WriteFile(tokenFile, []byte(expiring), 0600))
+	provider := newSecurityTokenConfigurationProvider(profileProvider, tokenFile, "us-phoenix-1", http.DefaultClient)
+	assert.Equal(t, "https://auth.us-phoenix-1.oraclecloud.com/v1/authentication/refresh", provider.refreshEndpoint)
+	provider.refreshEndpoint = server.URL
+
+	// A token about to expire is refreshed and written back to the token file
+	keyId, err := provider.KeyID()
+	assert.NoError(t, err)
+	assert.Equal(t, "ST$"+refreshed, keyId)
+	assert.Equal(t, 1, refreshes)
+	content, _ := ioutil.ReadFile(tokenFile)
+	assert.Equal(t, refreshed, string(content))
+
+	keyId, _ = provider.KeyID()
+	assert.Equal(t, "ST$"+refreshed, keyId)
+	assert.Equal(t, 1, refreshes)
+
+	// A token written to the file by someone else is picked up
+	reauthenticated := newTestSessionToken("reauthenticated", time.Hour)
+	assert.NoError(t, ioutil.WriteFile(tokenFile, []byte(reauthenticated), 0600))
+	assert.NoError(t, os.Chtimes(tokenFile, time.Now(), time.Now().Add(time.Second)))
+	keyId, _ = provider.KeyID()
+	assert.Equal(t, "ST$"+reauthenticated, keyId)
+
+	// An expired token that can not be refreshed is an error
+	assert.NoError(t, ioutil.WriteFile(tokenFile, []byte(newTestSessionToken("expired", -time.Minute)), 0600))
+	assert.NoError(t, os.Chtimes(tokenFile, time.Now(), time.Now().Add(2*time.Second)))
+	_, err = provider.KeyID()
+	assert.Error(t, err)
+}
+
 // issue-routing-tag: terraform/default
 func TestUnitResourcePrincipal_basic(t *testing.T) {
 	t.Skip("Run manually with a valid Resource Principle Session Token.")
@@ -720,6 +805,237 @@ func TestUnitIgnoreTags(t *testing.T) {
 
 }
 
+func TestUnitRetryProfiles(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{
+		globalvar.RetryPolicyAttrName: []interface{}{
+			map[string]interface{}{
+				globalvar.RetryPolicyServiceAttrName:              "Database",
+				globalvar.RetryPolicyMaxDurationSecondsAttrName:   3600,
+				globalvar.RetryPolicyRetryableStatusCodesAttrName: []interface{}{409},
+			},
+			map[string]interface{}{
+				globalvar.RetryPolicyServiceAttrName:             "limits",
+				globalvar.RetryPolicyBackoffBaseSecondsAttrName:  2,
+				globalvar.RetryPolicyBackoffCapSecondsAttrName:   60,
+				globalvar.RetryPolicyJitterAttrName:              false,
+				globalvar.RetryPolicyRetryableErrorCodesAttrName: []interface{}{"TooManyRequests"},
+			},
+		},
+	})
+
+	profiles, err := RetryProfiles(d)
+	assert.NoError(t, err)
+	assert.Len(t, profiles, 2)
+
+	database := profiles["database"]
+	assert.NotNil(t, database)
+	assert.Equal(t, time.Hour, *database.MaxDuration)
+	assert.Equal(t, []int{409}, database.RetryableStatusCodes)
+	assert.True(t, database.Jitter)
+
+	limits := profiles["limits"]
+	assert.NotNil(t, limits)
+	assert.Nil(t, limits.MaxDuration)
+	assert.Equal(t, 2*time.Second, limits.BackoffBase)
+	assert.Equal(t, time.Minute, limits.BackoffCap)
+	assert.False(t, limits.Jitter)
+	assert.Equal(t, []string{"TooManyRequests"}, limits.RetryableErrorCodes)
+}
+
+func TestUnitRetryProfiles_invalid(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{
+		globalvar.RetryPolicyAttrName: []interface{}{
+			map[string]interface{}{
+				globalvar.RetryPolicyServiceAttrName:            "core",
+				globalvar.RetryPolicyBackoffBaseSecondsAttrName: 20,
+				globalvar.RetryPolicyBackoffCapSecondsAttrName:  10,
+			},
+		},
+	})
+	_, err := RetryProfiles(d)
+	assert.Error(t, err)
+
+	d = schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{
+		globalvar.RetryPolicyAttrName: []interface{}{
+			map[string]interface{}{globalvar.RetryPolicyServiceAttrName: "core"},
+			map[string]interface{}{globalvar.RetryPolicyServiceAttrName: "CORE"},
+		},
+	})
+	_, err = RetryProfiles(d)
+	assert.Error(t, err)
+}
+
+func TestUnitRetryProfiles_unknownService(t *testing.T) {
+	policySchema := SchemaMap()[globalvar.RetryPolicyAttrName].Elem.(*schema.Resource).Schema
+	validateService := policySchema[globalvar.RetryPolicyServiceAttrName].ValidateFunc
+
+	for _, service := range []string{"database", "Database", tf_resource.DefaultRetryProfileName, "limits"} {
+		_, errs := validateService(service, globalvar.RetryPolicyServiceAttrName)
+		assert.Empty(t, errs, service)
+	}
+	_, errs := validateService("databse", globalvar.RetryPolicyServiceAttrName)
+	assert.Len(t, errs, 1)
+}
+
+func TestUnitDefaultTags(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{
+		globalvar.DefaultTagsAttrName: []interface{}{
+			map[string]interface{}{
+				globalvar.DefaultTagsDefinedTagsAttrName:  map[string]interface{}{"Finance.CostCenter": "42"},
+				globalvar.DefaultTagsFreeformTagsAttrName: map[string]interface{}{"Owner": "ops"},
+			},
+		},
+	})
+	definedTags, freeformTags, err := DefaultTags(d)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"Finance.CostCenter": "42"}, definedTags)
+	assert.Equal(t, map[string]interface{}{"Owner": "ops"}, freeformTags)
+
+	d = schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{
+		globalvar.DefaultTagsAttrName: []interface{}{
+			map[string]interface{}{
+				globalvar.DefaultTagsDefinedTagsAttrName: map[string]interface{}{"CostCenter": "42"},
+			},
+		},
+	})
+	_, _, err = DefaultTags(d)
+	assert.Error(t, err)
+
+	definedTags, freeformTags, err = DefaultTags(schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{}))
+	assert.NoError(t, err)
+	assert.Nil(t, definedTags)
+	assert.Nil(t, freeformTags)
+}
+
+func TestUnitRequestLimits(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{
+		globalvar.RequestLimitAttrName: []interface{}{
+			map[string]interface{}{
+				globalvar.RequestLimitServiceAttrName:           "oci_identity.IdentityClient",
+				globalvar.RequestLimitRequestsPerSecondAttrName: 2.5,
+				globalvar.RequestLimitBurstAttrName:             5,
+			},
+			map[string]interface{}{
+				globalvar.RequestLimitServiceAttrName:           "default",
+				globalvar.RequestLimitRequestsPerSecondAttrName: 10.0,
+				globalvar.RequestLimitPerEndpointAttrName:       true,
+			},
+		},
+	})
+
+	limits, err := RequestLimits(d)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]tf_client.RequestLimit{
+		"oci_identity.IdentityClient":     {RequestsPerSecond: 2.5, Burst: 5},
+		tf_client.DefaultRequestLimitName: {RequestsPerSecond: 10, PerEndpoint: true},
+	}, limits)
+}
+
+func TestUnitRequestLimits_invalid(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{
+		globalvar.RequestLimitAttrName: []interface{}{
+			map[string]interface{}{
+				globalvar.RequestLimitServiceAttrName:           "identity",
+				globalvar.RequestLimitRequestsPerSecondAttrName: 1.0,
+			},
+		},
+	})
+	_, err := RequestLimits(d)
+	assert.Error(t, err)
+
+	d = schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{
+		globalvar.RequestLimitAttrName: []interface{}{
+			map[string]interface{}{globalvar.RequestLimitServiceAttrName: "oci_core.ComputeClient", globalvar.RequestLimitRequestsPerSecondAttrName: 1.0},
+			map[string]interface{}{globalvar.RequestLimitServiceAttrName: "oci_core.ComputeClient", globalvar.RequestLimitRequestsPerSecondAttrName: 2.0},
+		},
+	})
+	_, err = RequestLimits(d)
+	assert.Error(t, err)
+}
+
+// newFakeTokenExchangeServer is a fake identity domain token endpoint exchanging the OIDC token "ci-job-token"
+// for a session token expiring after expiresIn
+func newFakeTokenExchangeServer(t *testing.T, expiresIn time.Duration, exchanges *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientId, clientSecret, ok := r.BasicAuth()
+		if err := r.ParseForm(); err != nil || !ok || clientId != "client" || clientSecret != "secret" ||
+			r.PostForm.Get("grant_type") != oidcTokenExchangeGrantType || r.PostForm.Get("requested_token_type") != oidcRequestedTokenType ||
+			r.PostForm.Get("subject_token") != "ci-job-token" || r.PostForm.Get("public_key") == "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		*exchanges++
+		claims, _ := json.Marshal(map[string]interface{}{
+			"tenant": testTenancyOCID,
+			"sub":    testUserOCID,
+			"exp":    time.Now().Add(expiresIn).Unix(),
+			"jti":    fmt.Sprintf("session-%d", *exchanges),
+		})
+		token := "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString(claims) + ".c2lnbmF0dXJl"
+		json.NewEncoder(w).Encode(map[string]string{"token": token})
+	}))
+}
+
+func TestUnitOidcConfigProvider(t *testing.T) {
+	exchanges := 0
+	server := newFakeTokenExchangeServer(t, time.Hour, &exchanges)
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	assert.NoError(t, ioutil.WriteFile(tokenFile, []byte("ci-job-token\n"), 0600))
+
+	d := schema.TestResourceDataRaw(t, SchemaMap(), map[string]interface{}{
+		globalvar.AuthAttrName:                      globalvar.AuthOIDCSetting,
+		globalvar.RegionAttrName:                    "us-phoenix-1",
+		globalvar.OidcTokenExchangeEndpointAttrName: server.URL,
+		globalvar.OidcClientIdAttrName:              "client",
+		globalvar.OidcClientSecretAttrName:          "secret",
+		globalvar.OidcTokenFileAttrName:             tokenFile,
+	})
+	configProviders, err := getConfigProviders(d, strings.ToLower(globalvar.AuthOIDCSetting))
+	assert.NoError(t, err)
+	configProvider, err := oci_common.ComposingConfigurationProvider(configProviders)
+	assert.NoError(t, err)
+
+	valid, err := oci_common.IsConfigurationProviderValid(configProvider)
+	assert.True(t, valid, "%v", err)
+	keyId, _ := configProvider.KeyID()
+	assert.True(t, strings.HasPrefix(keyId, "ST$eyJ"))
+	tenancy, _ := configProvider.TenancyOCID()
+	assert.Equal(t, testTenancyOCID, tenancy)
+	region, _ := configProvider.Region()
+	assert.Equal(t, "us-phoenix-1", region)
+	assert.Equal(t, 1, exchanges)
+
+	// The session token is exchanged again shortly before it expires
+	oidcProvider := configProviders[1].(*oidcConfigurationProvider)
+	oidcProvider.expiresAt = time.Now().Add(time.Minute)
+	refreshedKeyId, _ := configProvider.KeyID()
+	assert.Equal(t, 2, exchanges)
+	assert.NotEqual(t, keyId, refreshedKeyId)
+}
+
+func TestUnitOidcConfigProvider_invalid(t *testing.T) {
+	exchanges := 0
+	server := newFakeTokenExchangeServer(t, time.Hour, &exchanges)
+	defer server.Close()
+
+	attributes := map[string]interface{}{
+		globalvar.AuthAttrName:                      globalvar.AuthOIDCSetting,
+		globalvar.RegionAttrName:                    "us-phoenix-1",
+		globalvar.OidcTokenExchangeEndpointAttrName: server.URL,
+		globalvar.OidcClientIdAttrName:              "client",
+		globalvar.OidcClientSecretAttrName:          "secret",
+	}
+	_, err := getConfigProviders(schema.TestResourceDataRaw(t, SchemaMap(), attributes), strings.ToLower(globalvar.AuthOIDCSetting))
+	assert.Error(t, err, "expected an error without an OIDC token")
+
+	attributes[globalvar.OidcTokenAttrName] = "other-token"
+	_, err = getConfigProviders(schema.TestResourceDataRaw(t, SchemaMap(), attributes), strings.ToLower(globalvar.AuthOIDCSetting))
+	assert.Error(t, err, "expected an error for a token rejected by the token endpoint")
+	assert.Equal(t, 0, exchanges)
+}
+
 func TestUnit_RegisterResourceMap(t *testing.T) {
 	tests := []struct {
 		name string
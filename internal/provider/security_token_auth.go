@@ -0,0 +1,194 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"bytes"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+const (
+	securityTokenFileConfigKey            = "security_token_file"
+	securityTokenRefreshBeforeExpiry      = 5 * time.Minute
+	securityTokenRefreshEndpointFormatter = "https://%s/v1/authentication/refresh"
+)
+
+// securityTokenConfigurationProvider keeps the session token of a SecurityToken profile valid for the whole apply.
+// The token file is read again whenever it changes, e.g. after an `oci session refresh`, and the token is refreshed
+// through the session refresh endpoint shortly before it expires. The refreshed token is written back to the token
+// file so that it is also picked up by the CLI and by other providers using the profile.
+type securityTokenConfigurationProvider struct {
+	oci_common.ConfigurationProvider
+	tokenFilePath   string
+	refreshEndpoint string
+	httpClient      *http.Client
+
+	mutex       sync.Mutex
+	token       string
+	expiresAt   time.Time
+	fileModTime time.Time
+}
+
+func newSecurityTokenConfigurationProvider(profileProvider oci_common.ConfigurationProvider, tokenFilePath string, region string, httpClient *http.Client) *securityTokenConfigurationProvider {
+	return &securityTokenConfigurationProvider{
+		ConfigurationProvider: profileProvider,
+		tokenFilePath:         utils.ExpandPath(tokenFilePath),
+		refreshEndpoint:       fmt.Sprintf(securityTokenRefreshEndpointFormatter, oci_common.StringToRegion(region).Endpoint("auth")),
+		httpClient:            httpClient,
+	}
+}
+
+func (p *securityTokenConfigurationProvider) KeyID() (string, error) {
+	token, err := p.sessionToken()
+	if err != nil {
+		return "", err
+	}
+	return "ST$" + token, nil
+}
+
+// sessionToken returns the current session token, reading the token file again if it changed and refreshing the
+// token if it is about to expire
+func (p *securityTokenConfigurationProvider) sessionToken() (string, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if err := p.readTokenFile(); err != nil {
+		return "", err
+	}
+	if time.Now().Add(securityTokenRefreshBeforeExpiry).Before(p.expiresAt) {
+		return p.token, nil
+	}
+
+	if err := p.refreshToken(); err != nil {
+		if time.Now().Before(p.expiresAt) {
+			utils.Logf("[WARN] Unable to refresh the security token, it expires at %v: %v", p.expiresAt, err)
+			return p.token, nil
+		}
+		return "", fmt.Errorf("the security token in %s expired at %v and could not be refreshed, please authenticate again with `oci session authenticate`: %v", p.tokenFilePath, p.expiresAt, err)
+	}
+	return p.token, nil
+}
+
+func (p *securityTokenConfigurationProvider) readTokenFile() error {
+	info, err := os.Stat(p.tokenFilePath)
+	if err != nil {
+		return fmt.Errorf("can not read the security token file %s: %v", p.tokenFilePath, err)
+	}
+	if p.token != "" && info.ModTime().Equal(p.fileModTime) {
+		return nil
+	}
+
+	content, err := ioutil.ReadFile(p.tokenFilePath)
+	if err != nil {
+		return fmt.Errorf("can not read the security token file %s: %v", p.tokenFilePath, err)
+	}
+	return p.setToken(strings.TrimSpace(string(content)), info.ModTime())
+}
+
+func (p *securityTokenConfigurationProvider) setToken(token string, fileModTime time.Time) error {
+	claims, err := parseJwtClaims(token)
+	if err != nil {
+		return fmt.Errorf("the security token in %s is invalid: %v", p.tokenFilePath, err)
+	}
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return fmt.Errorf("the security token in %s has no expiry", p.tokenFilePath)
+	}
+	p.token = token
+	p.expiresAt = time.Unix(int64(exp), 0)
+	p.fileModTime = fileModTime
+	return nil
+}
+
+// refreshToken exchanges the current token for a new one, signing the request with the current token
+func (p *securityTokenConfigurationProvider) refreshToken() error {
+	privateKey, err := p.PrivateRSAKey()
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(map[string]string{"currentToken": p.token})
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequest(http.MethodPost, p.refreshEndpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.ContentLength = int64(len(body))
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+	signer := oci_common.DefaultRequestSigner(sessionKeyProvider{keyId: "ST$" + p.token, privateKey: privateKey})
+	if err := signer.Sign(request); err != nil {
+		return err
+	}
+
+	response, err := p.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	responseBody, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned %s %s", p.refreshEndpoint, response.Status, string(responseBody))
+	}
+	var refreshResponse struct {
+		Token string `json:"token"`
+	}
+	if err := json.Unmarshal(responseBody, &refreshResponse); err != nil || refreshResponse.Token == "" {
+		return fmt.Errorf("no security token in the response of %s", p.refreshEndpoint)
+	}
+
+	if err := ioutil.WriteFile(p.tokenFilePath, []byte(refreshResponse.Token), 0600); err != nil {
+		return fmt.Errorf("can not write the refreshed security token to %s: %v", p.tokenFilePath, err)
+	}
+	info, err := os.Stat(p.tokenFilePath)
+	if err != nil {
+		return err
+	}
+	if err := p.setToken(refreshResponse.Token, info.ModTime()); err != nil {
+		return err
+	}
+	utils.Debugf("[DEBUG] Refreshed the security token, it is valid until %v", p.expiresAt)
+	return nil
+}
+
+// refreshableConfigurationProvider marks a configuration provider composed of a refreshable provider as refreshable
+type refreshableConfigurationProvider struct {
+	oci_common.ConfigurationProvider
+}
+
+func (p refreshableConfigurationProvider) Refreshable() bool {
+	return true
+}
+
+// sessionKeyProvider signs the refresh request with the token being refreshed
+type sessionKeyProvider struct {
+	keyId      string
+	privateKey *rsa.PrivateKey
+}
+
+func (p sessionKeyProvider) KeyID() (string, error) {
+	return p.keyId, nil
+}
+
+func (p sessionKeyProvider) PrivateRSAKey() (*rsa.PrivateKey, error) {
+	return p.privateKey, nil
+}
@@ -0,0 +1,507 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strconv"
+	"sync"
+
+	tf_export "github.com/oracle/terraform-provider-oci/internal/commonexport"
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+	utils "github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+const (
+	checkpointVersion = 1
+	// Key of the JSON object an InterpolationString source attribute is checkpointed as
+	checkpointInterpolationKey = "__interpolation_string__"
+)
+
+var checkpointNameSuffixRegex = regexp.MustCompile(`^(.+)_(\d+)$`)
+
+/*
+exportCheckpoint is written to the checkpoint directory once a step completed its discovery without errors, so that
+an export run with resume skips the discovery of the step.
+The discovered and omitted resources are indexes into the resources, which also holds their parents.
+*/
+type exportCheckpoint struct {
+	Version    int                   `json:"version"`
+	Arguments  *checkpointArguments  `json:"arguments"`
+	Resources  []*checkpointResource `json:"resources"`
+	Discovered []int                 `json:"discovered"`
+	Omitted    []int                 `json:"omitted"`
+	FoundIds   []string              `json:"found_ids,omitempty"`
+}
+
+// checkpointArguments are the arguments changing the discovered resources, a checkpoint can only be resumed with the same
+type checkpointArguments struct {
+	CompartmentId                string   `json:"compartment_id"`
+	Services                     []string `json:"services"`
+	IDs                          []string `json:"ids"`
+	IsExportWithRelatedResources bool     `json:"include_related_resources"`
+	Filters                      []string `json:"filters"`
+	Regions                      []string `json:"regions"`
+	CompartmentIds               []string `json:"compartment_ids"`
+	CompartmentDepth             int      `json:"compartment_depth"`
+}
+
+type checkpointResource struct {
+	Id                         string          `json:"id"`
+	ImportId                   string          `json:"import_id,omitempty"`
+	TerraformClass             string          `json:"type"`
+	TerraformName              string          `json:"name"`
+	TerraformReferenceIdString string          `json:"reference_id_string,omitempty"`
+	OmitFromExport             bool            `json:"omit_from_export,omitempty"`
+	CompartmentId              string          `json:"compartment_id,omitempty"`
+	Region                     string          `json:"region,omitempty"`
+	SourceAttributes           json.RawMessage `json:"attributes,omitempty"`
+	Parent                     int             `json:"parent"` // index of the parent in the resources, -1 if none
+}
+
+// checkpointStateResource is a line of the native state checkpoint of a step, written once a resource is read
+type checkpointStateResource struct {
+	Id       string               `json:"id"`
+	Resource *nativeStateResource `json:"resource"`
+}
+
+// checkpointStateWriter appends the resources read for the native state of a step to its checkpoint
+type checkpointStateWriter struct {
+	file *os.File
+	lock sync.Mutex
+}
+
+func getCheckpointDir(ctx *tf_export.ResourceDiscoveryContext) string {
+	return filepath.Join(*ctx.OutputDir, globalvar.CheckpointDir)
+}
+
+func getCheckpointFile(ctx *tf_export.ResourceDiscoveryContext, stepName string) string {
+	return filepath.Join(getCheckpointDir(ctx), stepName+".json")
+}
+
+func getCheckpointStateFile(ctx *tf_export.ResourceDiscoveryContext, stepName string) string {
+	return filepath.Join(getCheckpointDir(ctx), stepName+".state.jsonl")
+}
+
+func getCheckpointArguments(ctx *tf_export.ResourceDiscoveryContext) *checkpointArguments {
+	return &checkpointArguments{
+		CompartmentId:                *ctx.CompartmentId,
+		Services:                     ctx.Services,
+		IDs:                          ctx.IDs,
+		IsExportWithRelatedResources: ctx.IsExportWithRelatedResources,
+		Filters:                      ctx.Filters,
+		Regions:                      ctx.Regions,
+		CompartmentIds:               ctx.CompartmentIds,
+		CompartmentDepth:             ctx.CompartmentDepth,
+	}
+}
+
+/*
+initCheckpoints removes the checkpoints of a previous export unless resuming it, and restores the discovered resources
+of the steps with a checkpoint when resuming. It returns the steps which are resumed.
+*/
+func initCheckpoints(ctx *tf_export.ResourceDiscoveryContext, steps []resourceDiscoveryStep) (map[resourceDiscoveryStep]bool, error) {
+	resumed := map[resourceDiscoveryStep]bool{}
+	if !ctx.Resume {
+		if err := os.RemoveAll(getCheckpointDir(ctx)); err != nil {
+			return resumed, err
+		}
+		return resumed, os.MkdirAll(getCheckpointDir(ctx), os.ModePerm)
+	}
+	if err := os.MkdirAll(getCheckpointDir(ctx), os.ModePerm); err != nil {
+		return resumed, err
+	}
+
+	arguments := getCheckpointArguments(ctx)
+	for _, step := range steps {
+		baseStep := step.getBaseStep()
+		data, err := ioutil.ReadFile(getCheckpointFile(ctx, baseStep.name))
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return resumed, err
+		}
+		var checkpoint exportCheckpoint
+		if err := json.Unmarshal(data, &checkpoint); err != nil {
+			return resumed, fmt.Errorf("[ERROR] invalid checkpoint for step %s: %s", baseStep.name, err)
+		}
+		if checkpoint.Version != checkpointVersion {
+			return resumed, fmt.Errorf("[ERROR] unsupported version %d of the checkpoint for step %s", checkpoint.Version, baseStep.name)
+		}
+		if !reflect.DeepEqual(checkpoint.Arguments, arguments) {
+			return resumed, fmt.Errorf("[ERROR] the checkpoint for step %s was written by an export with other arguments, run the export without resume to start over", baseStep.name)
+		}
+		if err := restoreCheckpoint(ctx, step, &checkpoint); err != nil {
+			return resumed, fmt.Errorf("[ERROR] unable to restore the checkpoint for step %s: %s", baseStep.name, err)
+		}
+		utils.Logf("[INFO] resuming step %s with %d discovered resources from its checkpoint", baseStep.name, len(baseStep.discoveredResources))
+		resumed[step] = true
+	}
+	return resumed, nil
+}
+
+// restoreCheckpoint restores the resources of a step as its discover does, with their references and names
+func restoreCheckpoint(ctx *tf_export.ResourceDiscoveryContext, step resourceDiscoveryStep, checkpoint *exportCheckpoint) error {
+	hints := ctx.ResourceHintsLookup
+	if graphStep, ok := step.(*resourceDiscoveryWithGraph); ok {
+		hints = map[string]*tf_export.TerraformResourceHints{}
+		for class, hint := range ctx.ResourceHintsLookup {
+			hints[class] = hint
+		}
+		// The hints of the step graph take precedence, as they are the ones the resources were discovered with
+		for _, associations := range graphStep.resourceGraph {
+			for _, association := range associations {
+				hints[association.ResourceClass] = association.TerraformResourceHints
+			}
+		}
+	}
+
+	resources := make([]*tf_export.OCIResource, len(checkpoint.Resources))
+	for i, checkpointResource := range checkpoint.Resources {
+		resource, err := restoreCheckpointResource(checkpointResource, hints[checkpointResource.TerraformClass])
+		if err != nil {
+			return err
+		}
+		resources[i] = resource
+	}
+	for i, checkpointResource := range checkpoint.Resources {
+		if checkpointResource.Parent >= 0 && checkpointResource.Parent < len(resources) {
+			resources[i].Parent = resources[checkpointResource.Parent]
+		}
+	}
+
+	baseStep := step.getBaseStep()
+	baseStep.discoveredResources = []*tf_export.OCIResource{}
+	baseStep.omittedResources = []*tf_export.OCIResource{}
+	for _, idx := range checkpoint.Discovered {
+		resource := resources[idx]
+		baseStep.discoveredResources = append(baseStep.discoveredResources, resource)
+		tf_export.ReferenceMap[resource.Id] = resource.GetHclReferenceIdString()
+		registerCheckpointResourceName(resource.TerraformName)
+	}
+	for _, idx := range checkpoint.Omitted {
+		baseStep.omittedResources = append(baseStep.omittedResources, resources[idx])
+		registerCheckpointResourceName(resources[idx].TerraformName)
+	}
+	for _, id := range checkpoint.FoundIds {
+		if _, expected := ctx.ExpectedResourceIds[id]; expected {
+			ctx.ExpectedResourceIds[id] = true
+		}
+	}
+	return nil
+}
+
+func restoreCheckpointResource(checkpointResource *checkpointResource, hints *tf_export.TerraformResourceHints) (*tf_export.OCIResource, error) {
+	resource := &tf_export.OCIResource{
+		TerraformResource: tf_export.TerraformResource{
+			Id:                         checkpointResource.Id,
+			ImportId:                   checkpointResource.ImportId,
+			TerraformClass:             checkpointResource.TerraformClass,
+			TerraformName:              checkpointResource.TerraformName,
+			TerraformReferenceIdString: checkpointResource.TerraformReferenceIdString,
+			TerraformTypeInfo:          hints,
+			OmitFromExport:             checkpointResource.OmitFromExport,
+		},
+		CompartmentId:  checkpointResource.CompartmentId,
+		GetHclStringFn: tf_export.GetHclStringFromGenericMap,
+		Region:         checkpointResource.Region,
+	}
+	if hints != nil && hints.GetHCLStringOverrideFn != nil {
+		resource.GetHclStringFn = hints.GetHCLStringOverrideFn
+	}
+	if len(checkpointResource.SourceAttributes) > 0 {
+		decoder := json.NewDecoder(bytes.NewReader(checkpointResource.SourceAttributes))
+		decoder.UseNumber()
+		var attributes map[string]interface{}
+		if err := decoder.Decode(&attributes); err != nil {
+			return nil, err
+		}
+		resource.SourceAttributes = decodeCheckpointValue(attributes).(map[string]interface{})
+	}
+	return resource, nil
+}
+
+/*
+registerCheckpointResourceName reserves the name of a restored resource, so that the resources discovered by the
+steps which are not resumed do not get the same name
+*/
+func registerCheckpointResourceName(name string) {
+	if _, exists := tf_export.ResourceNameCount[name]; !exists {
+		tf_export.ResourceNameCount[name] = 1
+	}
+	if match := checkpointNameSuffixRegex.FindStringSubmatch(name); match != nil {
+		if suffix, err := strconv.Atoi(match[2]); err == nil && tf_export.ResourceNameCount[match[1]] <= suffix {
+			tf_export.ResourceNameCount[match[1]] = suffix + 1
+		}
+	}
+}
+
+/*
+writeCheckpoint writes the checkpoint of a step once its discovery completed. The steps with discovery errors are not
+checkpointed, so that resuming the export retries their discovery.
+*/
+func writeCheckpoint(ctx *tf_export.ResourceDiscoveryContext, step resourceDiscoveryStep) error {
+	baseStep := step.getBaseStep()
+	if hasStepErrors(ctx, step) {
+		utils.Logf("[INFO] not checkpointing step %s as it has discovery errors", baseStep.name)
+		return nil
+	}
+
+	checkpoint := &exportCheckpoint{
+		Version:    checkpointVersion,
+		Arguments:  getCheckpointArguments(ctx),
+		Resources:  []*checkpointResource{},
+		Discovered: []int{},
+		Omitted:    []int{},
+	}
+	indexes := map[*tf_export.OCIResource]int{}
+	var addResource func(resource *tf_export.OCIResource) (int, error)
+	addResource = func(resource *tf_export.OCIResource) (int, error) {
+		if idx, exists := indexes[resource]; exists {
+			return idx, nil
+		}
+		parent := -1
+		if resource.Parent != nil {
+			var err error
+			if parent, err = addResource(resource.Parent); err != nil {
+				return -1, err
+			}
+		}
+		checkpointResource := &checkpointResource{
+			Id:                         resource.Id,
+			ImportId:                   resource.ImportId,
+			TerraformClass:             resource.TerraformClass,
+			TerraformName:              resource.TerraformName,
+			TerraformReferenceIdString: resource.TerraformReferenceIdString,
+			OmitFromExport:             resource.OmitFromExport,
+			CompartmentId:              resource.CompartmentId,
+			Region:                     resource.Region,
+			Parent:                     parent,
+		}
+		if resource.SourceAttributes != nil {
+			attributes, err := encodeCheckpointAttributes(resource.SourceAttributes)
+			if err != nil {
+				return -1, err
+			}
+			checkpointResource.SourceAttributes = attributes
+		}
+		indexes[resource] = len(checkpoint.Resources)
+		checkpoint.Resources = append(checkpoint.Resources, checkpointResource)
+		return indexes[resource], nil
+	}
+
+	ctx.CtxLock.Lock()
+	for _, resource := range baseStep.discoveredResources {
+		idx, err := addResource(resource)
+		if err != nil {
+			ctx.CtxLock.Unlock()
+			return err
+		}
+		checkpoint.Discovered = append(checkpoint.Discovered, idx)
+		// The targeted resources are expected by their type and id, the related resources by their id
+		if found := ctx.ExpectedResourceIds[resource.Id]; found {
+			checkpoint.FoundIds = append(checkpoint.FoundIds, resource.Id)
+		}
+	}
+	if _, isTargetStep := step.(*resourceDiscoveryWithTargetIds); isTargetStep {
+		for id, found := range ctx.ExpectedResourceIds {
+			if found {
+				checkpoint.FoundIds = append(checkpoint.FoundIds, id)
+			}
+		}
+	}
+	ctx.CtxLock.Unlock()
+	for _, resource := range baseStep.omittedResources {
+		idx, err := addResource(resource)
+		if err != nil {
+			return err
+		}
+		checkpoint.Omitted = append(checkpoint.Omitted, idx)
+	}
+
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+	// Write to a temporary file first so that a crash does not leave a partial checkpoint
+	checkpointFile := getCheckpointFile(ctx, baseStep.name)
+	if err := ioutil.WriteFile(checkpointFile+".tmp", data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(checkpointFile+".tmp", checkpointFile)
+}
+
+// hasStepErrors returns whether discovery errors were reported for the resource types of a step
+func hasStepErrors(ctx *tf_export.ResourceDiscoveryContext, step resourceDiscoveryStep) bool {
+	ctx.CtxLock.Lock()
+	defer ctx.CtxLock.Unlock()
+	graphStep, isGraphStep := step.(*resourceDiscoveryWithGraph)
+	if !isGraphStep {
+		return len(ctx.ErrorList.Errors) > 0
+	}
+	classes := map[string]bool{}
+	for _, associations := range graphStep.resourceGraph {
+		for _, association := range associations {
+			classes[association.ResourceClass] = true
+		}
+	}
+	for _, rdError := range ctx.ErrorList.Errors {
+		if rdError.ResourceType == "" || classes[rdError.ResourceType] {
+			return true
+		}
+	}
+	return false
+}
+
+func encodeCheckpointAttributes(attributes map[string]interface{}) (json.RawMessage, error) {
+	return json.Marshal(encodeCheckpointValue(attributes))
+}
+
+// encodeCheckpointValue converts the InterpolationString values of source attributes to JSON objects
+func encodeCheckpointValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case tf_export.InterpolationString:
+		return map[string]interface{}{checkpointInterpolationKey: map[string]interface{}{
+			"resource_reference": v.ResourceReference,
+			"interpolation":      v.Interpolation,
+			"value":              v.Value,
+		}}
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = encodeCheckpointValue(item)
+		}
+		return result
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[key] = encodeCheckpointValue(item)
+		}
+		return result
+	}
+	return value
+}
+
+// decodeCheckpointValue reverts encodeCheckpointValue, and converts the numbers to int or float64 as discovered
+func decodeCheckpointValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		if intValue, err := strconv.Atoi(v.String()); err == nil {
+			return intValue
+		}
+		floatValue, _ := v.Float64()
+		return floatValue
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = decodeCheckpointValue(item)
+		}
+		return result
+	case map[string]interface{}:
+		if interpolation, ok := v[checkpointInterpolationKey].(map[string]interface{}); ok && len(v) == 1 {
+			result := tf_export.InterpolationString{}
+			result.ResourceReference, _ = interpolation["resource_reference"].(string)
+			result.Interpolation, _ = interpolation["interpolation"].(string)
+			result.Value, _ = interpolation["value"].(string)
+			return result
+		}
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[key] = decodeCheckpointValue(item)
+		}
+		return result
+	}
+	return value
+}
+
+/*
+openCheckpointState returns the resources already read for the native state of a step by id when resuming, and a
+writer appending the resources read from now on to the checkpoint of the step
+*/
+func openCheckpointState(ctx *tf_export.ResourceDiscoveryContext, stepName string) (map[string]*nativeStateResource, *checkpointStateWriter, error) {
+	stateFile := getCheckpointStateFile(ctx, stepName)
+	result := map[string]*nativeStateResource{}
+	if ctx.Resume {
+		if file, err := os.Open(stateFile); err == nil {
+			scanner := bufio.NewScanner(file)
+			scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+			for scanner.Scan() {
+				var line checkpointStateResource
+				// A crash may leave a partial last line, the resource is then read again
+				if err := json.Unmarshal(scanner.Bytes(), &line); err == nil && line.Resource != nil {
+					result[line.Id] = line.Resource
+				}
+			}
+			_ = file.Close()
+		} else if !os.IsNotExist(err) {
+			return nil, nil, err
+		}
+	} else if err := os.RemoveAll(stateFile); err != nil {
+		return nil, nil, err
+	}
+
+	file, err := os.OpenFile(stateFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, &checkpointStateWriter{file: file}, nil
+}
+
+func (w *checkpointStateWriter) write(id string, stateResource *nativeStateResource) error {
+	data, err := json.Marshal(&checkpointStateResource{Id: id, Resource: stateResource})
+	if err != nil {
+		return err
+	}
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	_, err = w.file.Write(append(data, '\n'))
+	return err
+}
+
+func (w *checkpointStateWriter) close() error {
+	return w.file.Close()
+}
+
+// readStateAddresses returns the addresses of the resources in the state files written by terraform import
+func readStateAddresses(stateFiles ...string) map[string]bool {
+	result := map[string]bool{}
+	for _, stateFile := range stateFiles {
+		data, err := ioutil.ReadFile(stateFile)
+		if err != nil {
+			continue
+		}
+		var state struct {
+			Resources []struct {
+				Mode string `json:"mode"`
+				Type string `json:"type"`
+				Name string `json:"name"`
+			} `json:"resources"`
+		}
+		if err := json.Unmarshal(data, &state); err != nil {
+			utils.Logf("[WARN] unable to read the state file %s: %s", stateFile, err)
+			continue
+		}
+		for _, resource := range state.Resources {
+			if resource.Mode == "managed" {
+				result[fmt.Sprintf("%s.%s", resource.Type, resource.Name)] = true
+			}
+		}
+	}
+	return result
+}
+
+// removeCheckpoints removes the checkpoints once an export completed without errors, there is nothing left to resume
+func removeCheckpoints(ctx *tf_export.ResourceDiscoveryContext) {
+	if err := os.RemoveAll(getCheckpointDir(ctx)); err != nil {
+		utils.Logf("[WARN] unable to remove the checkpoints under %s: %s", getCheckpointDir(ctx), err)
+	}
+}
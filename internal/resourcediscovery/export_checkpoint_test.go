@@ -0,0 +1,125 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	tf_export "github.com/oracle/terraform-provider-oci/internal/commonexport"
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+type regionFailingConfigurationProvider struct {
+	acctest.MockConfigurationProvider
+}
+
+func (p regionFailingConfigurationProvider) Region() (string, error) {
+	return "", fmt.Errorf("region is not available")
+}
+
+// Test that RunExportCommand with resume restores the discovered resources of a failed export from its checkpoints
+// issue-routing-tag: terraform/default
+func TestUnitRunExportCommand_resume(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	compartmentId := resourceDiscoveryTestCompartmentOcid
+	workingDir, _ := os.Getwd()
+	outputDir := fmt.Sprintf("%s%sdiscoveryTest-%d", workingDir, string(os.PathSeparator), time.Now().Nanosecond())
+	if err := os.Mkdir(outputDir, os.ModePerm); err != nil {
+		t.Fatalf("unable to mkdir %s. err: %v", outputDir, err)
+	}
+	defer os.RemoveAll(outputDir)
+	checkpointFile := filepath.Join(outputDir, globalvar.CheckpointDir, "compartment_testing.json")
+	configFile := filepath.Join(outputDir, "compartment_testing.tf")
+
+	tf_export.TfHclVersionvar = &tf_export.TfHclVersion12{Value: tf_export.TfVersion12}
+	args := &tf_export.ExportCommandArgs{
+		CompartmentId: &compartmentId,
+		Services:      []string{"compartment_testing"},
+		OutputDir:     &outputDir,
+		TFVersion:     &tf_export.TfHclVersionvar,
+		Parallelism:   1,
+	}
+	getEnvSettingWithBlankDefaultVar = func(varName string) string {
+		return resourceDiscoveryTestTenancyOcid
+	}
+	getExportConfigVar = func(d *schema.ResourceData) (interface{}, error) {
+		return getTestClients(), nil
+	}
+
+	// The export fails after the discovery, which is checkpointed
+	exportConfigProvider = regionFailingConfigurationProvider{}
+	if err, status := RunExportCommand(args); err == nil || status != StatusFail {
+		t.Fatalf("export command returned unexpected status %v", status)
+	}
+	if _, err := os.Stat(checkpointFile); err != nil {
+		t.Fatalf("no checkpoint written for the discovery: %v", err)
+	}
+	config, err := ioutil.ReadFile(configFile)
+	if err != nil {
+		t.Fatalf("no configuration generated: %v", err)
+	}
+
+	// The checkpoint can not be resumed with other arguments
+	initResourceDiscoveryTests()
+	exportConfigProvider = acctest.MockConfigurationProvider{}
+	args.Resume = true
+	args.Filters = []string{"state=ACTIVE"}
+	if err, status := RunExportCommand(args); err == nil || status != StatusFail {
+		t.Fatalf("export command returned unexpected status %v", status)
+	}
+
+	// The resumed discovery does not list the resources again, the faulty parent resources would fail it
+	initResourceDiscoveryTests()
+	tf_export.CompartmentResourceGraphs["compartment_testing"] = compartmentTestingResourceGraphWithFaultyParentResource
+	args.Filters = nil
+	if err, status := RunExportCommand(args); err != nil || status != StatusSuccess {
+		t.Fatalf("export command failed with status %v due to err: %v", status, err)
+	}
+	resumedConfig, err := ioutil.ReadFile(configFile)
+	if err != nil {
+		t.Fatalf("no configuration generated: %v", err)
+	}
+	assert.Equal(t, string(config), string(resumedConfig))
+	for id := range parentResources {
+		assert.Contains(t, tf_export.ReferenceMap, id)
+	}
+
+	// The checkpoints are removed once the export succeeded
+	_, err = os.Stat(filepath.Join(outputDir, globalvar.CheckpointDir))
+	assert.True(t, os.IsNotExist(err))
+}
+
+// Test that the source attributes are restored from a checkpoint with the types they were discovered with
+// issue-routing-tag: terraform/default
+func TestUnitCheckpointValue(t *testing.T) {
+	attributes := map[string]interface{}{
+		"display_name": "string1",
+		"count":        3,
+		"ratio":        0.5,
+		"size":         1000000,
+		"enabled":      true,
+		"subnet_id":    tf_export.InterpolationString{ResourceReference: "oci_core_subnet.subnet", Interpolation: "${oci_core_subnet.subnet.id}", Value: "ocid1.subnet"},
+		"nested":       []interface{}{map[string]interface{}{"port": 443}},
+	}
+
+	data, err := encodeCheckpointAttributes(attributes)
+	if err != nil {
+		t.Fatalf("unable to encode the attributes: %v", err)
+	}
+	restored, err := restoreCheckpointResource(&checkpointResource{SourceAttributes: data}, nil)
+	if err != nil {
+		t.Fatalf("unable to decode the attributes: %v", err)
+	}
+	assert.Equal(t, attributes, restored.SourceAttributes)
+}
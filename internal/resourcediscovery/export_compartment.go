@@ -13,6 +13,7 @@ import (
 	"runtime"
 	"runtime/debug"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -22,6 +23,9 @@ import (
 	tf_export "github.com/oracle/terraform-provider-oci/internal/commonexport"
 
 	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/hcl2/hcl"
+	"github.com/hashicorp/hcl2/hcl/hclsyntax"
+	"github.com/hashicorp/hcl2/hclwrite"
 
 	"github.com/oracle/terraform-provider-oci/internal/globalvar"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
@@ -105,6 +109,7 @@ func elapsed(what string, step *resourceDiscoveryBaseStep, stage ResourceDiscove
 func init() {
 	tf_export.ResourceNameCount = map[string]int{}
 	tf_export.Vars = map[string]string{}
+	tf_export.SensitiveVars = map[string]bool{}
 	tf_export.ReferenceMap = map[string]string{}
 	tf_export.VarsExportForResourceLevel = map[string][]string{}
 	tf_export.VarsExportForGlobalLevel = []string{}
@@ -249,12 +254,23 @@ func RunExportCommand(args *tf_export.ExportCommandArgs) (err error, status Stat
 		return err, StatusFail
 	}
 
+	// The clients of the first region are created last, so that the export configuration is the one of that region
+	regionClients, err := getRegionClients(d, args.Regions)
+	if err != nil {
+		utils.Logln(err.Error())
+		return err, StatusFail
+	}
+
 	clients, err := getExportConfigVar(d)
 	if err != nil {
 		utils.Logln(err.Error())
 		return err, StatusFail
 	}
 
+	if len(args.CompartmentIds) > 0 {
+		args.CompartmentId = &args.CompartmentIds[0]
+	}
+
 	if args.CompartmentName != nil && *args.CompartmentName != "" {
 		var err error
 		args.CompartmentId, err = resolveCompartmentId(clients.(*tf_client.OracleClients), args.CompartmentName)
@@ -293,6 +309,7 @@ func RunExportCommand(args *tf_export.ExportCommandArgs) (err error, status Stat
 		utils.Logln(err.Error())
 		return err, StatusFail
 	}
+	ctx.RegionClients = regionClients
 	args.FinalizeServices(ctx)
 
 	/*
@@ -381,13 +398,29 @@ func getExportConfig(d *schema.ResourceData) (interface{}, error) {
 	return clients, nil
 }
 
-func runExportCommand(ctx *tf_export.ResourceDiscoveryContext) error {
+func runExportCommand(ctx *tf_export.ResourceDiscoveryContext) (err error) {
 	utils.Logf("[INFO] Running export command\n")
 	utils.Logf("[INFO] parallelism: %d", ctx.Parallelism)
 	defer ctx.PrintSummary()
 	exportStart := time.Now()
 	defer elapsed("entire export command", nil, 0)()
-	steps, err := getDiscoverResourceSteps(ctx)
+	var steps []resourceDiscoveryStep
+	if ctx.ReportFile != nil && *ctx.ReportFile != "" {
+		// Write the report whether the export succeeded or not
+		defer func() {
+			if reportErr := generateReportFile(ctx, steps, err); reportErr != nil {
+				utils.Logf("[ERROR] error writing the export report: %s", reportErr.Error())
+				if err == nil {
+					err = reportErr
+				}
+			}
+		}()
+	}
+	steps, err = getDiscoverResourceSteps(ctx)
+	if err != nil {
+		return err
+	}
+	resumedSteps, err := initCheckpoints(ctx, steps)
 	if err != nil {
 		return err
 	}
@@ -412,12 +445,18 @@ func runExportCommand(ctx *tf_export.ResourceDiscoveryContext) error {
 				discoverWg.Done()
 			}()
 
-			err := step.discover()
-			if err != nil {
-				// All errors in discover are added to the ctx.errorList
-				utils.Debugf("[ERROR] error occurred while discovering resources for step %d", i)
-				utils.Logf("[ERROR] error occurred while discovering resources: %s", err.Error())
-				return
+			// The resources of the resumed steps are restored from their checkpoints
+			if !resumedSteps[step] {
+				err := step.discover()
+				if err != nil {
+					// All errors in discover are added to the ctx.errorList
+					utils.Debugf("[ERROR] error occurred while discovering resources for step %d", i)
+					utils.Logf("[ERROR] error occurred while discovering resources: %s", err.Error())
+					return
+				}
+				if err := writeCheckpoint(step.getBaseStep().ctx, step); err != nil {
+					utils.Logf("[WARN] unable to write the checkpoint for step %d: %s", i, err.Error())
+				}
 			}
 			// Cull any references from the ref map that contain omitted resources
 			// This is to avoid omitted resources from being referenced in generated configs
@@ -440,15 +479,34 @@ func runExportCommand(ctx *tf_export.ResourceDiscoveryContext) error {
 
 	// Wait for all steps to complete discovery
 	discoverWg.Wait()
+	mergeRegionContexts(ctx, steps)
 	totalDiscoveryTime := time.Since(discoveryStart)
 	utils.Debugf("discovering resources for all services took %v\n", totalDiscoveryTime)
 	ctx.TimeTakenToDiscover = totalDiscoveryTime
 	utils.Debug("[DEBUG] ~~~~~~ discover steps completed ~~~~~~")
 
+	initExportModules(ctx, steps)
+
+	if ctx.BaselineState != nil && *ctx.BaselineState != "" {
+		if err := detectDrift(ctx, steps); err != nil {
+			return err
+		}
+	}
+
 	if ctx.GenerateState {
 		stateStart := time.Now()
-		// Run import commands
-		if ctx.Parallelism > 1 {
+		if ctx.ImportMode != tf_export.ImportModeNative && tf_export.IsTfVersion1() {
+			if err := generateRequiredProvidersFileForImport(ctx.OutputDir); err != nil {
+				return err
+			}
+		}
+		if ctx.ImportMode == tf_export.ImportModeNative {
+			utils.Debug("[DEBUG] Generating state natively")
+			if err := generateNativeState(ctx, steps); err != nil {
+				return err
+			}
+		} else if ctx.Parallelism > 1 {
+			// Run import commands
 			utils.Debug("[DEBUG] Generating state in parallel")
 			if err := generateStateParallel(ctx, steps); err != nil {
 				return err
@@ -536,14 +594,38 @@ func runExportCommand(ctx *tf_export.ResourceDiscoveryContext) error {
 		return err
 	}
 	tf_export.Vars["region"] = fmt.Sprintf("\"%s\"", region)
+	for _, region := range getExportRegions(ctx)[1:] {
+		tf_export.Vars[getRegionVar(region)] = fmt.Sprintf("\"%s\"", region)
+	}
 
-	if err := generateProviderFile(ctx.OutputDir); err != nil {
+	if err := generateProviderFile(ctx.OutputDir, getExportRegions(ctx)[1:]); err != nil {
 		return err
 	}
 
 	if err := generateVarsFile(tf_export.Vars, ctx.OutputDir); err != nil {
 		return err
 	}
+	if len(tf_export.SensitiveVars) > 0 {
+		ctx.SummaryStatements = append(ctx.SummaryStatements, fmt.Sprintf("Replaced %d sensitive attribute values by variables without a default in '%s', set their values to plan the configuration", len(tf_export.SensitiveVars), globalvar.VarsFile))
+	}
+
+	if ctx.OutputLayout == tf_export.OutputLayoutModules {
+		if err := generateModulesFiles(ctx); err != nil {
+			return err
+		}
+		if tf_export.IsTfVersion1() {
+			if err := generateMovedFile(ctx); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Terraform 1.5+ and OpenTofu import the resources of the import blocks, they are written unless the state is generated
+	if ctx.ImportMode == tf_export.ImportModeBlocks || (tf_export.IsTfVersion1() && !ctx.GenerateState) {
+		if err := generateImportsFile(ctx); err != nil {
+			return err
+		}
+	}
 
 	if tf_export.IsMissingRequiredAttributes {
 		ctx.SummaryStatements = append(ctx.SummaryStatements, "")
@@ -555,6 +637,9 @@ func runExportCommand(ctx *tf_export.ResourceDiscoveryContext) error {
 	}
 	ctx.TimeTakenForEntireExport = time.Since(exportStart)
 	ctx.PostValidate()
+	if len(ctx.ErrorList.Errors) == 0 {
+		removeCheckpoints(ctx)
+	}
 	return nil
 }
 
@@ -564,13 +649,17 @@ generateStateParallel is used if value of parallelism arg > 1
 - writes temp state file for each service in parallel by running import for each of the found resources
 - finally it merges all the state files generated into one state file using json merge
 */
-func generateStateParallel(ctx *tf_export.ResourceDiscoveryContext, steps []resourceDiscoveryStep) error {
+func generateStateParallel(ctx *tf_export.ResourceDiscoveryContext, steps []resourceDiscoveryStep) (err error) {
 
 	// isInitDone is to make sure that multiple threads do not call terraform init
 	utils.Debugf("[DEBUG] Reset isInitDone")
 	isInitDone = false
-	// Cleanup the temporary state files created for each input service
-	defer cleanupTempStateFiles(ctx)
+	// Cleanup the temporary state files created for each input service, they are kept on failure to resume the export
+	defer func() {
+		if err == nil {
+			cleanupTempStateFiles(ctx)
+		}
+	}()
 	defer elapsed("generating state in parallel", nil, 0)()
 
 	/*
@@ -733,13 +822,21 @@ func generateState(ctx *tf_export.ResourceDiscoveryContext, steps []resourceDisc
 
 	stateOutputFile := fmt.Sprintf("%s%s%s", *ctx.OutputDir, string(os.PathSeparator), globalvar.DefaultStateFilename)
 	tmpStateOutputFile := fmt.Sprintf("%s%s%s", *ctx.OutputDir, string(os.PathSeparator), globalvar.DefaultTmpStateFile)
-	if err := os.RemoveAll(tmpStateOutputFile); err != nil {
+	// The tmp state file of the failed export holds the resources already imported when resuming it
+	importedAddresses := map[string]bool{}
+	if ctx.Resume {
+		importedAddresses = readStateAddresses(tmpStateOutputFile)
+	} else if err := os.RemoveAll(tmpStateOutputFile); err != nil {
 		utils.Logf("[WARN] unable to delete existing tmp state file %s", tmpStateOutputFile)
 		return err
 	}
 
 	// Run import for all resources
 	for _, resource := range ctx.DiscoveredResources {
+		if importedAddresses[resource.GetTerraformReference()] {
+			utils.Debugf("[DEBUG] resource '%s' was imported before resuming, skipping it", resource.GetTerraformReference())
+			continue
+		}
 		importResource(ctx, resource, tmpStateOutputFile)
 	}
 
@@ -783,33 +880,38 @@ func importResource(ctx *tf_export.ResourceDiscoveryContext, resource *tf_export
 	if importErr := ctxTerraformImportVar(ctx, context.Background(), resource.GetTerraformReference(), importId, importArgs...); importErr != nil {
 		utils.Logf("[ERROR] terraform import command failed for resource '%s' at id '%s': %s", resource.GetTerraformReference(), importId, importErr.Error())
 
-		// mark resource as errored so that it can be skipped while writing configurations
-		resource.IsErrorResource = true
-
-		ctx.CtxLock.Lock()
-		ctx.IsImportError = true
-		ctx.CtxLock.Unlock()
-
 		err := fmt.Errorf("[ERROR] terraform import command failed for resource '%s' at id '%s': %s Any references to this resource have been replaced with hard coded values in generated configurations", resource.GetTerraformReference(), importId, importErr.Error())
+		addImportError(ctx, resource, err)
+	}
+}
 
-		var rdError *tf_export.ResourceDiscoveryError
-		if ctx.TargetSpecificResources {
-			rdError = &tf_export.ResourceDiscoveryError{
-				ResourceType:   resource.TerraformClass,
-				ParentResource: "",
-				Error:          err,
-				ResourceGraph:  nil}
-		} else {
-			rdError = &tf_export.ResourceDiscoveryError{
-				ResourceType:   resource.TerraformClass,
-				ParentResource: resource.Parent.TerraformName,
-				Error:          err,
-				ResourceGraph:  nil,
-			}
+/*
+addImportError marks a resource that could not be imported as errored so that it is skipped while writing configurations,
+and adds the error to the ctx.ErrorList
+*/
+func addImportError(ctx *tf_export.ResourceDiscoveryContext, resource *tf_export.OCIResource, err error) {
+	resource.IsErrorResource = true
+
+	ctx.CtxLock.Lock()
+	ctx.IsImportError = true
+	ctx.CtxLock.Unlock()
+
+	var rdError *tf_export.ResourceDiscoveryError
+	if ctx.TargetSpecificResources || resource.Parent == nil {
+		rdError = &tf_export.ResourceDiscoveryError{
+			ResourceType:   resource.TerraformClass,
+			ParentResource: "",
+			Error:          err,
+			ResourceGraph:  nil}
+	} else {
+		rdError = &tf_export.ResourceDiscoveryError{
+			ResourceType:   resource.TerraformClass,
+			ParentResource: resource.Parent.TerraformName,
+			Error:          err,
+			ResourceGraph:  nil,
 		}
-		ctx.AddErrorToList(rdError)
-
 	}
+	ctx.AddErrorToList(rdError)
 }
 
 func getDiscoverResourceSteps(ctx *tf_export.ResourceDiscoveryContext) ([]resourceDiscoveryStep, error) {
@@ -834,52 +936,68 @@ func getDiscoverResourceWithGraphSteps(ctx *tf_export.ResourceDiscoveryContext)
 	if ctx.CompartmentId == nil || *ctx.CompartmentId == "" {
 		*ctx.CompartmentId = ctx.TenancyOcid
 	}
+	compartments, err := getExportCompartments(ctx)
+	if err != nil {
+		return nil, err
+	}
 	var result []resourceDiscoveryStep
 
-	// Discover tenancy scope resources only if compartmentId is tenancy ocid
-	if *ctx.CompartmentId == ctx.TenancyOcid {
-		tenancyResource := &tf_export.OCIResource{
-			CompartmentId: ctx.TenancyOcid,
-			TerraformResource: tf_export.TerraformResource{
-				Id:             ctx.TenancyOcid,
-				TerraformClass: "oci_identity_tenancy",
-				TerraformName:  "export",
-			},
-		}
+	regionContexts := map[string]*tf_export.ResourceDiscoveryContext{}
+	for _, region := range getExportRegions(ctx) {
+		regionCtx := getRegionContext(ctx, regionContexts, region)
+		for i, compartment := range compartments {
+			// Discover tenancy scope resources only if compartmentId is tenancy ocid, in a single region as they are global
+			if compartment.id == ctx.TenancyOcid && region == "" {
+				tenancyResource := &tf_export.OCIResource{
+					CompartmentId: ctx.TenancyOcid,
+					TerraformResource: tf_export.TerraformResource{
+						Id:             ctx.TenancyOcid,
+						TerraformClass: "oci_identity_tenancy",
+						TerraformName:  "export",
+					},
+				}
 
-		for _, mode := range ctx.Services {
-			if resourceGraph, exists := tf_export.TenancyResourceGraphs[mode]; exists {
-				result = append(result, &resourceDiscoveryWithGraph{
-					root:                      tenancyResource,
-					resourceGraph:             resourceGraph,
-					resourceDiscoveryBaseStep: resourceDiscoveryBaseStep{name: mode, ctx: ctx},
-				})
+				for _, mode := range ctx.Services {
+					if resourceGraph, exists := tf_export.TenancyResourceGraphs[mode]; exists {
+						result = append(result, &resourceDiscoveryWithGraph{
+							root:                      tenancyResource,
+							resourceGraph:             resourceGraph,
+							resourceDiscoveryBaseStep: resourceDiscoveryBaseStep{name: getStepName(ctx, region, compartment, mode), ctx: regionCtx},
+						})
 
-				tf_export.Vars["tenancy_ocid"] = fmt.Sprintf("\"%s\"", ctx.TenancyOcid)
-				tf_export.ReferenceMap[ctx.TenancyOcid] = tf_export.TfHclVersionvar.GetVarHclString("tenancy_ocid")
+						tf_export.Vars["tenancy_ocid"] = fmt.Sprintf("\"%s\"", ctx.TenancyOcid)
+						tf_export.ReferenceMap[ctx.TenancyOcid] = tf_export.TfHclVersionvar.GetVarHclString("tenancy_ocid")
+					}
+				}
 			}
-		}
-	}
-
-	compartmentResource := &tf_export.OCIResource{
-		CompartmentId: *ctx.CompartmentId,
-		TerraformResource: tf_export.TerraformResource{
-			Id:             *ctx.CompartmentId,
-			TerraformClass: "oci_identity_compartment",
-			TerraformName:  "export",
-		},
-	}
 
-	for _, mode := range ctx.Services {
-		if resourceGraph, exists := tf_export.CompartmentResourceGraphs[mode]; exists {
-			result = append(result, &resourceDiscoveryWithGraph{
-				root:                      compartmentResource,
-				resourceGraph:             resourceGraph,
-				resourceDiscoveryBaseStep: resourceDiscoveryBaseStep{name: mode, ctx: ctx},
-			})
+			compartmentResource := &tf_export.OCIResource{
+				CompartmentId: compartment.id,
+				TerraformResource: tf_export.TerraformResource{
+					Id:             compartment.id,
+					TerraformClass: "oci_identity_compartment",
+					TerraformName:  "export",
+				},
+				Region: region,
+			}
 
-			tf_export.Vars["compartment_ocid"] = fmt.Sprintf("\"%s\"", *ctx.CompartmentId)
-			tf_export.ReferenceMap[*ctx.CompartmentId] = tf_export.TfHclVersionvar.GetVarHclString("compartment_ocid")
+			// The compartments after the first one have a variable of their own
+			compartmentVar := "compartment_ocid"
+			if i > 0 {
+				compartmentVar = fmt.Sprintf("compartment_ocid_%s", compartment.name)
+			}
+			for _, mode := range ctx.Services {
+				if resourceGraph, exists := tf_export.CompartmentResourceGraphs[mode]; exists {
+					result = append(result, &resourceDiscoveryWithGraph{
+						root:                      compartmentResource,
+						resourceGraph:             resourceGraph,
+						resourceDiscoveryBaseStep: resourceDiscoveryBaseStep{name: getStepName(ctx, region, compartment, mode), ctx: regionCtx},
+					})
+
+					tf_export.Vars[compartmentVar] = fmt.Sprintf("\"%s\"", compartment.id)
+					tf_export.ReferenceMap[compartment.id] = tf_export.TfHclVersionvar.GetVarHclString(compartmentVar)
+				}
+			}
 		}
 	}
 
@@ -936,6 +1054,7 @@ func findResources(ctx *tf_export.ResourceDiscoveryContext, root *tf_export.OCIR
 			foundResources = append(foundResources, results...)
 
 			for _, resource := range results {
+				resource.Region = root.Region
 				//referenceMap[resource.id] = resource.getHclReferenceIdString()
 				if ctx.ExpectedResourceIds != nil && len(ctx.ExpectedResourceIds) > 0 {
 					if _, shouldExport := ctx.ExpectedResourceIds[resource.Id]; shouldExport {
@@ -971,6 +1090,8 @@ func generateVarsFile(vars map[string]string, outputDir *string) error {
 	for variable, defaultVal := range vars {
 		if defaultVal != "" {
 			_, _ = file.WriteString(fmt.Sprintf("variable %s { default = %s }\n", variable, defaultVal))
+		} else if tf_export.SensitiveVars[variable] && tf_export.TfHclVersionvar.ToString() != string(tf_export.TfVersion11) {
+			_, _ = file.WriteString(fmt.Sprintf("variable %s { sensitive = true }\n", variable))
 		} else {
 			_, _ = file.WriteString(fmt.Sprintf("variable %s {}\n", variable))
 		}
@@ -987,7 +1108,11 @@ func generateVarsFile(vars map[string]string, outputDir *string) error {
 	return nil
 }
 
-func generateProviderFile(outputDir *string) error {
+/*
+generateProviderFile writes the provider configuration of the region of the export, and a provider configuration with an
+alias for each of the other regions to export
+*/
+func generateProviderFile(outputDir *string, regions []string) error {
 	providerTmpFile := fmt.Sprintf("%s%s%s.tmp", *outputDir, string(os.PathSeparator), globalvar.ProviderFile)
 	providerOutputFile := fmt.Sprintf("%s%s%s", *outputDir, string(os.PathSeparator), globalvar.ProviderFile)
 	file, err := os.OpenFile(providerTmpFile, os.O_CREATE|os.O_RDWR, 0666)
@@ -995,7 +1120,15 @@ func generateProviderFile(outputDir *string) error {
 		return err
 	}
 
-	_, err = file.WriteString(fmt.Sprintf("provider oci {\n\tregion = %s\n}\n", tf_export.TfHclVersionvar.GetVarHclString("region")))
+	builder := &strings.Builder{}
+	if tf_export.IsTfVersion1() {
+		builder.WriteString(getRequiredProvidersHclString())
+	}
+	builder.WriteString(fmt.Sprintf("provider oci {\n\tregion = %s\n}\n", tf_export.TfHclVersionvar.GetVarHclString("region")))
+	for _, region := range regions {
+		builder.WriteString(fmt.Sprintf("\nprovider oci {\n\talias  = \"%s\"\n\tregion = %s\n}\n", tf_export.GetRegionProviderAlias(region), tf_export.TfHclVersionvar.GetVarHclString(getRegionVar(region))))
+	}
+	_, err = file.WriteString(builder.String())
 	if err != nil {
 		_ = file.Close()
 		return err
@@ -1012,6 +1145,100 @@ func generateProviderFile(outputDir *string) error {
 	return nil
 }
 
+// getRequiredProvidersHclString returns the terraform block requiring the OCI provider from its registry source
+func getRequiredProvidersHclString() string {
+	return fmt.Sprintf("terraform {\n\trequired_providers {\n\t\toci = {\n\t\t\tsource  = \"%s\"\n\t\t\tversion = \">= %s\"\n\t\t}\n\t}\n}\n\n", tf_export.GetProviderSource(), globalvar.Version)
+}
+
+/*
+generateRequiredProvidersFileForImport writes the required_providers of the tf_version 1 profile to the provider file
+before running terraform import, so that the state records the same provider source as the generated configuration.
+The provider file is overwritten by generateProviderFile once the state is generated
+*/
+func generateRequiredProvidersFileForImport(outputDir *string) error {
+	providerOutputFile := fmt.Sprintf("%s%s%s", *outputDir, string(os.PathSeparator), globalvar.ProviderFile)
+	return ioutil.WriteFile(providerOutputFile, []byte(getRequiredProvidersHclString()), 0666)
+}
+
+/*
+generateImportsFile writes an import block for each exported resource, so that the resources are imported by
+terraform plan and apply rather than by running terraform import for each of them
+*/
+func generateImportsFile(ctx *tf_export.ResourceDiscoveryContext) error {
+	importsTmpFile := fmt.Sprintf("%s%s%s.tmp", *ctx.OutputDir, string(os.PathSeparator), globalvar.ImportsFile)
+	importsOutputFile := fmt.Sprintf("%s%s%s", *ctx.OutputDir, string(os.PathSeparator), globalvar.ImportsFile)
+
+	resources := make([]*tf_export.OCIResource, 0, len(ctx.DiscoveredResources))
+	for _, resource := range ctx.DiscoveredResources {
+		if resource.IsErrorResource || (resource.TerraformTypeInfo != nil && resource.TerraformTypeInfo.IsDataSource) {
+			continue
+		}
+		resourceDefinition, exists := tf_export.ResourcesMap[resource.TerraformClass]
+		if !exists {
+			continue
+		}
+		if resourceDefinition.Importer == nil {
+			utils.Logf("[WARN] unable to import '%s' because import is not supported for '%s'", resource.GetTerraformReference(), resource.TerraformClass)
+			continue
+		}
+		resources = append(resources, resource)
+	}
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].GetTerraformReference() < resources[j].GetTerraformReference()
+	})
+
+	builder := &strings.Builder{}
+	builder.WriteString("## This configuration was generated by terraform-provider-oci\n")
+	builder.WriteString("## Run terraform plan and apply with Terraform v1.5 or later to import the resources\n\n")
+	for _, resource := range resources {
+		importId := resource.ImportId
+		if len(importId) == 0 {
+			importId = resource.Id
+		}
+		builder.WriteString(fmt.Sprintf("import {\nto = %s\nid = %s\n", getModuleAddress(resource.GetTerraformReference()), getHclQuotedString(importId)))
+		if resource.Region != "" {
+			builder.WriteString(fmt.Sprintf("provider = %s\n", tf_export.GetRegionProviderReference(resource.Region)))
+		}
+		builder.WriteString("}\n\n")
+	}
+
+	formatted, err := getFormattedHcl(globalvar.ImportsFile, builder.String())
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(importsTmpFile, formatted, 0666); err != nil {
+		return err
+	}
+	if err := os.Rename(importsTmpFile, importsOutputFile); err != nil {
+		return err
+	}
+
+	ctx.SummaryStatements = append(ctx.SummaryStatements, fmt.Sprintf("Import blocks for %d resources generated under '%s'", len(resources), importsOutputFile))
+	return nil
+}
+
+/*
+getFormattedHcl formats a generated configuration file. The configuration generated for Terraform 1.x and OpenTofu is
+also parsed, so that an invalid configuration fails the export rather than terraform plan.
+*/
+func getFormattedHcl(filename string, config string) ([]byte, error) {
+	formatted := hclwrite.Format([]byte(config))
+	if tf_export.IsTfVersion1() {
+		if _, diags := hclsyntax.ParseConfig(formatted, filename, hcl.Pos{Line: 1, Column: 1}); diags.HasErrors() {
+			return nil, fmt.Errorf("[ERROR] invalid configuration generated in '%s': %s", filename, diags.Error())
+		}
+	}
+	return formatted, nil
+}
+
+// getHclQuotedString quotes a value as an HCL string literal, escaping the template sequences
+func getHclQuotedString(value string) string {
+	quoted := strconv.Quote(value)
+	quoted = strings.ReplaceAll(quoted, "${", "$${")
+	quoted = strings.ReplaceAll(quoted, "%{", "%%{")
+	return quoted
+}
+
 //func getOciResource(d *schema.ResourceData, resourceSchema map[string]*schema.Schema, compartmentId string, resourceHint *tf_export.TerraformResourceHints, resourceId string) (*tf_export.OCIResource, error) {
 //	resourceMap, err := tf_export.ConvertDatasourceItemToMap(d, "", resourceSchema)
 //	if err != nil {
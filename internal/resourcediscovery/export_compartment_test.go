@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"os"
 	"path"
@@ -620,14 +621,14 @@ func readTestChildWith404Error(d *schema.ResourceData, m interface{}) error {
 	sync := &TestChildWith404ErrorResourceCrud{}
 	sync.D = d
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ReadResource(context.Background(), sync)
 }
 
 type TestChildWith404ErrorResourceCrud struct {
 	tfresource.BaseCrud
 }
 
-func (s TestChildWith404ErrorResourceCrud) Get() error {
+func (s TestChildWith404ErrorResourceCrud) Get(ctx context.Context) error {
 	if s.D.Id() == resourceIdFor404ErrorResource {
 		return fmt.Errorf("404 not found")
 	} else {
@@ -835,6 +836,60 @@ func TestUnitRunExportCommand_basic(t *testing.T) {
 	os.RemoveAll(outputDir)
 }
 
+// Test that RunExportCommand writes import blocks for the exported resources instead of running terraform import
+// issue-routing-tag: terraform/default
+func TestUnitRunExportCommand_importBlocks(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	compartmentId := resourceDiscoveryTestCompartmentOcid
+	outputDir, err := os.Getwd()
+	outputDir = fmt.Sprintf("%s%sdiscoveryTest-%d", outputDir, string(os.PathSeparator), time.Now().Nanosecond())
+	if err = os.Mkdir(outputDir, os.ModePerm); err != nil {
+		t.Fatalf("unable to mkdir %s. err: %v", outputDir, err)
+	}
+	defer os.RemoveAll(outputDir)
+
+	tf_export.TfHclVersionvar = &tf_export.TfHclVersion12{Value: tf_export.TfVersion12}
+	args := &tf_export.ExportCommandArgs{
+		CompartmentId: &compartmentId,
+		Services:      []string{"compartment_testing"},
+		OutputDir:     &outputDir,
+		GenerateState: false,
+		TFVersion:     &tf_export.TfHclVersionvar,
+		Parallelism:   1,
+		ImportMode:    tf_export.ImportModeBlocks,
+	}
+	getEnvSettingWithBlankDefaultVar = func(varName string) string {
+		return resourceDiscoveryTestTenancyOcid
+	}
+	getExportConfigVar = func(d *schema.ResourceData) (interface{}, error) {
+		return getTestClients(), nil
+	}
+	exportConfigProvider = acctest.MockConfigurationProvider{}
+	if err, _ = RunExportCommand(args); err != nil {
+		t.Fatalf("export command failed due to err: %v", err)
+	}
+
+	imports, err := ioutil.ReadFile(fmt.Sprintf("%s%s%s", outputDir, string(os.PathSeparator), globalvar.ImportsFile))
+	if err != nil {
+		t.Fatalf("no %s file generated: %v", globalvar.ImportsFile, err)
+	}
+	assert.Contains(t, string(imports), "to = oci_test_parent.")
+	assert.Contains(t, string(imports), "to = oci_test_child.")
+	assert.Contains(t, string(imports), "id = \"ocid1.parent.")
+	assert.Equal(t, strings.Count(string(imports), "import {"), strings.Count(string(imports), "to = oci_test_"))
+
+	if _, err = os.Stat(fmt.Sprintf("%s%sterraform.tfstate", outputDir, string(os.PathSeparator))); !os.IsNotExist(err) {
+		t.Errorf("found terraform.tfstate even though it wasn't expected")
+	}
+
+	// The import blocks are applied by terraform plan, so they can not be combined with generate_state
+	args.GenerateState = true
+	if err, status := RunExportCommand(args); err == nil || status != StatusFail {
+		t.Errorf("expected generate_state to be rejected with import_mode blocks")
+	}
+}
+
 // issue-routing-tag: terraform/default
 func TestUnitRunExportCommand_Parallel(t *testing.T) {
 	initResourceDiscoveryTests()
@@ -1365,6 +1420,55 @@ func TestUnitFindResources_processResourceFn(t *testing.T) {
 	}
 }
 
+// Test that the resources not matching the filters are skipped, or omitted if their children are discovered
+// issue-routing-tag: terraform/default
+func TestUnitFindResources_filters(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	defer func() { tf_export.ResourceFilters = nil }()
+	rootResource := getRootCompartmentResource()
+
+	getExportedIds := func(results []*tf_export.OCIResource) map[string]bool {
+		exported := map[string]bool{}
+		for _, resource := range results {
+			if !resource.OmitFromExport {
+				exported[resource.Id] = true
+			}
+		}
+		return exported
+	}
+
+	args := &tf_export.ExportCommandArgs{Filters: []string{"display_name~^string[23]$"}}
+	outputDir := os.TempDir()
+	args.OutputDir = &outputDir
+	args.Parallelism = 1
+	if err := args.Validate(); err != nil {
+		t.Fatalf("Validate() failed: %v", err)
+	}
+	ctx := &tf_export.ResourceDiscoveryContext{ErrorList: tf_export.ErrorList{}}
+	results, err := findResources(ctx, rootResource, compartmentTestingResourceGraph)
+	if err != nil {
+		t.Fatalf("got error from findResources: %v", err)
+	}
+	// The parents are kept to discover their children, which have no display_name to match
+	assert.Equal(t, len(parentResources), len(results))
+	assert.Equal(t, map[string]bool{getTestResourceId("parent", 2): true, getTestResourceId("parent", 3): true}, getExportedIds(results))
+
+	args.Filters = []string{"type=oci_test_child"}
+	if err := args.Validate(); err != nil {
+		t.Fatalf("Validate() failed: %v", err)
+	}
+	results, err = findResources(ctx, rootResource, compartmentTestingResourceGraph)
+	if err != nil {
+		t.Fatalf("got error from findResources: %v", err)
+	}
+	exported := getExportedIds(results)
+	assert.Equal(t, len(childrenResources), len(exported))
+	for id := range childrenResources {
+		assert.True(t, exported[id], "child resource %s should be exported", id)
+	}
+}
+
 // Test that Terraform names can be generated from discovered resources
 // issue-routing-tag: terraform/default
 func TestUnitGenerateTerraformNameFromResource_basic(t *testing.T) {
@@ -2624,6 +2728,7 @@ func TestUnitGetDiscoverResourceWithGraphSteps(t *testing.T) {
 	})
 
 }
+
 func TestUnitDeleteInvalidReferences(t *testing.T) {
 	t.Run("Zero Error resource", func(t *testing.T) {
 
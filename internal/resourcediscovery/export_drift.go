@@ -0,0 +1,389 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	tf_export "github.com/oracle/terraform-provider-oci/internal/commonexport"
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+	utils "github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+// driftReport is written to the drift.json file when exporting with a baseline_state
+type driftReport struct {
+	BaselineState string                 `json:"baseline_state"`
+	New           []*driftReportResource `json:"new"`
+	Deleted       []*driftReportResource `json:"deleted"`
+	Changed       []*driftReportResource `json:"changed"`
+}
+
+type driftReportResource struct {
+	Address    string                  `json:"address"`
+	Type       string                  `json:"type"`
+	Id         string                  `json:"id"`
+	Attributes []*driftReportAttribute `json:"attributes,omitempty"`
+}
+
+type driftReportAttribute struct {
+	Name       string      `json:"name"`
+	Baseline   interface{} `json:"baseline"`
+	Discovered interface{} `json:"discovered"`
+}
+
+// sensitiveDriftValue replaces the values of the sensitive attributes in the drift report
+const sensitiveDriftValue = "(sensitive)"
+
+// baselineResource is a resource instance read from the baseline state file
+type baselineResource struct {
+	address    string
+	class      string
+	attributes map[string]interface{}
+}
+
+/*
+detectDrift compares the discovered resources with the resources of the baseline state file, and writes the
+resources which are new, deleted or changed since the baseline to the drift report.
+The new resources are kept in the steps so that the configuration is only generated for them, the other resources
+are omitted from the export.
+*/
+func detectDrift(ctx *tf_export.ResourceDiscoveryContext, steps []resourceDiscoveryStep) error {
+	baselineResources, err := readBaselineState(*ctx.BaselineState)
+	if err != nil {
+		return err
+	}
+
+	report := &driftReport{
+		BaselineState: *ctx.BaselineState,
+		New:           []*driftReportResource{},
+		Deleted:       []*driftReportResource{},
+		Changed:       []*driftReportResource{},
+	}
+	discoveredIds := map[string]bool{}
+	inScope := map[string]bool{}
+	for _, step := range steps {
+		if graphStep, ok := step.(*resourceDiscoveryWithGraph); ok {
+			for _, associations := range graphStep.resourceGraph {
+				for _, association := range associations {
+					inScope[association.ResourceClass] = true
+				}
+			}
+		}
+
+		baseStep := step.getBaseStep()
+		newResources := []*tf_export.OCIResource{}
+		for _, resource := range baseStep.discoveredResources {
+			if _, isResource := tf_export.ResourcesMap[resource.TerraformClass]; !isResource || resource.Id == "" {
+				// Keep the data sources for the references of the new resources
+				newResources = append(newResources, resource)
+				continue
+			}
+			discoveredIds[resource.Id] = true
+			baseline, exists := baselineResources[resource.Id]
+			if !exists {
+				report.New = append(report.New, &driftReportResource{
+					Address: getModuleAddress(resource.GetTerraformReference()),
+					Type:    resource.TerraformClass,
+					Id:      resource.Id,
+				})
+				newResources = append(newResources, resource)
+				continue
+			}
+
+			if attributes := getDriftAttributes(resource, baseline); len(attributes) > 0 {
+				report.Changed = append(report.Changed, &driftReportResource{
+					Address:    baseline.address,
+					Type:       resource.TerraformClass,
+					Id:         resource.Id,
+					Attributes: attributes,
+				})
+			}
+			resource.OmitFromExport = true
+			baseStep.omittedResources = append(baseStep.omittedResources, resource)
+		}
+		baseStep.discoveredResources = newResources
+	}
+
+	for id, baseline := range baselineResources {
+		if discoveredIds[id] {
+			continue
+		}
+		// Only the resources which could have been discovered by this export are reported as deleted
+		if ctx.TargetSpecificResources {
+			if _, expected := ctx.ExpectedResourceIds[id]; !expected {
+				continue
+			}
+		} else if !inScope[baseline.class] {
+			continue
+		}
+		report.Deleted = append(report.Deleted, &driftReportResource{
+			Address: baseline.address,
+			Type:    baseline.class,
+			Id:      id,
+		})
+	}
+
+	for _, resources := range [][]*driftReportResource{report.New, report.Deleted, report.Changed} {
+		sort.Slice(resources, func(i, j int) bool {
+			return resources[i].Address < resources[j].Address
+		})
+	}
+
+	// Cull the references to the resources which are not exported, so that the new resources refer to them by ocid
+	for _, step := range steps {
+		for _, omittedResource := range step.getOmittedResources() {
+			for key, reference := range tf_export.ReferenceMap {
+				if strings.Contains(reference, omittedResource.GetTerraformReference()) {
+					delete(tf_export.ReferenceMap, key)
+				}
+			}
+		}
+	}
+
+	reportFile := fmt.Sprintf("%s%s%s", *ctx.OutputDir, string(os.PathSeparator), globalvar.DriftReportFile)
+	data, err := json.MarshalIndent(report, "", "\t")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(reportFile, data, 0644); err != nil {
+		return err
+	}
+	ctx.SummaryStatements = append(ctx.SummaryStatements, fmt.Sprintf("Found %d new, %d deleted and %d changed resources since the baseline state. Drift report generated under '%s'", len(report.New), len(report.Deleted), len(report.Changed), reportFile))
+	return nil
+}
+
+// readBaselineState reads the managed resource instances of a v4 state file by id
+func readBaselineState(path string) (map[string]*baselineResource, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var state struct {
+		Version   int `json:"version"`
+		Resources []struct {
+			Module    string `json:"module"`
+			Mode      string `json:"mode"`
+			Type      string `json:"type"`
+			Name      string `json:"name"`
+			Instances []struct {
+				IndexKey   interface{}            `json:"index_key"`
+				Attributes map[string]interface{} `json:"attributes"`
+			} `json:"instances"`
+		} `json:"resources"`
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("[ERROR] invalid baseline state file %s: %s", path, err)
+	}
+	if state.Version != 4 {
+		return nil, fmt.Errorf("[ERROR] unsupported version %d of the baseline state file %s, version 4 is required", state.Version, path)
+	}
+
+	result := map[string]*baselineResource{}
+	for _, resource := range state.Resources {
+		if resource.Mode != "managed" {
+			continue
+		}
+		address := fmt.Sprintf("%s.%s", resource.Type, resource.Name)
+		if resource.Module != "" {
+			address = fmt.Sprintf("%s.%s", resource.Module, address)
+		}
+		for _, instance := range resource.Instances {
+			id, ok := instance.Attributes["id"].(string)
+			if !ok || id == "" {
+				continue
+			}
+			instanceAddress := address
+			if instance.IndexKey != nil {
+				indexKey, _ := json.Marshal(instance.IndexKey)
+				instanceAddress = fmt.Sprintf("%s[%s]", address, indexKey)
+			}
+			result[id] = &baselineResource{address: instanceAddress, class: resource.Type, attributes: instance.Attributes}
+		}
+	}
+	utils.Debugf("[DEBUG] read %d resources from the baseline state file %s", len(result), path)
+	return result, nil
+}
+
+/*
+getDriftAttributes returns the configurable attributes of a discovered resource which differ from the baseline.
+The attributes which could not be discovered, the attributes of nested blocks which are missing from the discovered
+values, and the order of the list elements are ignored.
+*/
+func getDriftAttributes(resource *tf_export.OCIResource, baseline *baselineResource) []*driftReportAttribute {
+	resourceSchema := tf_export.ResourcesMap[resource.TerraformClass]
+	result := []*driftReportAttribute{}
+	for _, name := range utils.GetSortedKeys(resource.SourceAttributes) {
+		attributeSchema, exists := resourceSchema.Schema[name]
+		if !exists || attributeSchema.Deprecated != "" || (!attributeSchema.Required && !attributeSchema.Optional) {
+			continue
+		}
+		discovered := normalizeDriftValue(resource.SourceAttributes[name])
+		if !driftValuesEqual(baseline.attributes[name], discovered) {
+			result = append(result, &driftReportAttribute{
+				Name:       name,
+				Baseline:   redactSensitiveDriftValue(baseline.attributes[name], attributeSchema),
+				Discovered: redactSensitiveDriftValue(discovered, attributeSchema),
+			})
+		}
+	}
+	return result
+}
+
+// redactSensitiveDriftValue replaces the sensitive values of an attribute, including in its nested blocks, so that the drift report does not disclose them
+func redactSensitiveDriftValue(value interface{}, attributeSchema *schema.Schema) interface{} {
+	if isEmptyDriftValue(value) {
+		return value
+	}
+	if attributeSchema.Sensitive {
+		return sensitiveDriftValue
+	}
+	elem, isBlock := attributeSchema.Elem.(*schema.Resource)
+	if !isBlock {
+		return value
+	}
+	redactBlock := func(block interface{}) interface{} {
+		attributes, ok := block.(map[string]interface{})
+		if !ok {
+			return block
+		}
+		result := make(map[string]interface{}, len(attributes))
+		for key, item := range attributes {
+			if itemSchema, exists := elem.Schema[key]; exists {
+				item = redactSensitiveDriftValue(item, itemSchema)
+			}
+			result[key] = item
+		}
+		return result
+	}
+	if list, isList := value.([]interface{}); isList {
+		result := make([]interface{}, len(list))
+		for i, item := range list {
+			result[i] = redactBlock(item)
+		}
+		return result
+	}
+	return redactBlock(value)
+}
+
+// normalizeDriftValue converts a discovered value to its JSON representation, as in the state file
+func normalizeDriftValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case tf_export.InterpolationString:
+		return v.Value
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = normalizeDriftValue(item)
+		}
+		return result
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[key] = normalizeDriftValue(item)
+		}
+		return result
+	}
+	var result interface{}
+	if data, err := json.Marshal(value); err == nil && json.Unmarshal(data, &result) == nil {
+		return result
+	}
+	return value
+}
+
+func driftValuesEqual(baseline interface{}, discovered interface{}) bool {
+	if isEmptyDriftValue(baseline) && isEmptyDriftValue(discovered) {
+		return true
+	}
+	switch d := discovered.(type) {
+	case map[string]interface{}:
+		b, ok := baseline.(map[string]interface{})
+		if !ok {
+			// Nested blocks with a single element are lists in the state file
+			if list, isList := baseline.([]interface{}); isList && len(list) == 1 {
+				return driftBlocksEqual(list[0], d)
+			}
+			return false
+		}
+		// Maps are not nested blocks in the state file, the keys removed since the baseline are a change too
+		for key, value := range b {
+			if _, exists := d[key]; !exists && !isEmptyDriftValue(value) {
+				return false
+			}
+		}
+		return driftBlocksEqual(b, d)
+	case []interface{}:
+		b, ok := baseline.([]interface{})
+		if !ok || len(b) != len(d) {
+			return false
+		}
+		// Match every discovered element with a distinct baseline element, regardless of the order
+		used := make([]bool, len(b))
+		for _, value := range d {
+			found := false
+			for i, baselineValue := range b {
+				if used[i] {
+					continue
+				}
+				// The elements which are maps are nested blocks
+				var equal bool
+				if block, isBlock := value.(map[string]interface{}); isBlock {
+					equal = driftBlocksEqual(baselineValue, block)
+				} else {
+					equal = driftValuesEqual(baselineValue, value)
+				}
+				if equal {
+					used[i] = true
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}
+	if reflect.DeepEqual(baseline, discovered) {
+		return true
+	}
+	// The discovered values may be strings for the numbers and booleans of the state file and vice versa
+	return baseline != nil && discovered != nil && fmt.Sprintf("%v", baseline) == fmt.Sprintf("%v", discovered)
+}
+
+// driftBlocksEqual compares the discovered attributes of a nested block, the other attributes of the baseline block
+// may be computed
+func driftBlocksEqual(baseline interface{}, discovered map[string]interface{}) bool {
+	b, ok := baseline.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	for key, value := range discovered {
+		if !driftValuesEqual(b[key], value) {
+			return false
+		}
+	}
+	return true
+}
+
+func isEmptyDriftValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
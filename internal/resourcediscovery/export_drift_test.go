@@ -0,0 +1,209 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	tf_export "github.com/oracle/terraform-provider-oci/internal/commonexport"
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+// Test that RunExportCommand with a baseline_state reports the drift and generates the new resources only
+// issue-routing-tag: terraform/default
+func TestUnitRunExportCommand_baselineState(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	compartmentId := resourceDiscoveryTestCompartmentOcid
+	workingDir, _ := os.Getwd()
+	outputDir := fmt.Sprintf("%s%sdiscoveryTest-%d", workingDir, string(os.PathSeparator), time.Now().Nanosecond())
+	if err := os.Mkdir(outputDir, os.ModePerm); err != nil {
+		t.Fatalf("unable to mkdir %s. err: %v", outputDir, err)
+	}
+	defer os.RemoveAll(outputDir)
+
+	tf_export.TfHclVersionvar = &tf_export.TfHclVersion12{Value: tf_export.TfVersion12}
+	args := &tf_export.ExportCommandArgs{
+		CompartmentId: &compartmentId,
+		Services:      []string{"compartment_testing"},
+		OutputDir:     &outputDir,
+		GenerateState: true,
+		TFVersion:     &tf_export.TfHclVersionvar,
+		Parallelism:   1,
+		ImportMode:    tf_export.ImportModeNative,
+	}
+	getEnvSettingWithBlankDefaultVar = func(varName string) string {
+		return resourceDiscoveryTestTenancyOcid
+	}
+	getExportConfigVar = func(d *schema.ResourceData) (interface{}, error) {
+		return getTestClients(), nil
+	}
+	exportConfigProvider = acctest.MockConfigurationProvider{}
+	if err, _ := RunExportCommand(args); err != nil {
+		t.Fatalf("export command failed due to err: %v", err)
+	}
+
+	// Make a baseline out of the generated state, with a resource created, one deleted and one changed since then
+	stateFile := fmt.Sprintf("%s%s%s", outputDir, string(os.PathSeparator), globalvar.DefaultStateFilename)
+	data, err := ioutil.ReadFile(stateFile)
+	if err != nil {
+		t.Fatalf("no %s file generated: %v", globalvar.DefaultStateFilename, err)
+	}
+	var state map[string]interface{}
+	if err := json.Unmarshal(data, &state); err != nil {
+		t.Fatalf("invalid state file: %v", err)
+	}
+	resources := state["resources"].([]interface{})
+	if len(resources) < 2 {
+		t.Fatalf("expected at least 2 resources in the state, got %d", len(resources))
+	}
+	attributesOf := func(resource interface{}) map[string]interface{} {
+		return resource.(map[string]interface{})["instances"].([]interface{})[0].(map[string]interface{})["attributes"].(map[string]interface{})
+	}
+	var newId, changedId string
+	for i := len(resources) - 1; i >= 0; i-- {
+		resource := resources[i].(map[string]interface{})
+		if resource["type"] == "oci_test_parent" {
+			if newId == "" {
+				newId = attributesOf(resource)["id"].(string)
+				resources = append(resources[:i], resources[i+1:]...)
+			} else if changedId == "" {
+				changedId = attributesOf(resource)["id"].(string)
+				attributesOf(resource)["a_string"] = "changed out of band"
+			}
+		}
+	}
+	deleted := map[string]interface{}{
+		"mode":     "managed",
+		"type":     "oci_test_parent",
+		"name":     "deleted_parent",
+		"provider": getNativeStateProvider(),
+		"instances": []interface{}{map[string]interface{}{
+			"schema_version": 0,
+			"attributes":     map[string]interface{}{"id": "ocid1.parent.deleted"},
+		}},
+	}
+	outOfScope := map[string]interface{}{
+		"mode":     "managed",
+		"type":     "oci_core_vcn",
+		"name":     "vcn",
+		"provider": getNativeStateProvider(),
+		"instances": []interface{}{map[string]interface{}{
+			"schema_version": 0,
+			"attributes":     map[string]interface{}{"id": "ocid1.vcn.other"},
+		}},
+	}
+	state["resources"] = append(resources, deleted, outOfScope)
+	baselineState := fmt.Sprintf("%s%sbaseline.tfstate", outputDir, string(os.PathSeparator))
+	data, _ = json.Marshal(state)
+	if err := ioutil.WriteFile(baselineState, data, 0644); err != nil {
+		t.Fatalf("unable to write the baseline state: %v", err)
+	}
+
+	args.GenerateState = false
+	args.BaselineState = &baselineState
+	initResourceDiscoveryTests()
+	if err, _ := RunExportCommand(args); err != nil {
+		t.Fatalf("export command failed due to err: %v", err)
+	}
+
+	data, err = ioutil.ReadFile(fmt.Sprintf("%s%s%s", outputDir, string(os.PathSeparator), globalvar.DriftReportFile))
+	if err != nil {
+		t.Fatalf("no %s file generated: %v", globalvar.DriftReportFile, err)
+	}
+	var report driftReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		t.Fatalf("invalid drift report: %v", err)
+	}
+	if assert.Len(t, report.New, 1) {
+		assert.Equal(t, newId, report.New[0].Id)
+	}
+	if assert.Len(t, report.Deleted, 1) {
+		assert.Equal(t, "oci_test_parent.deleted_parent", report.Deleted[0].Address)
+	}
+	if assert.Len(t, report.Changed, 1) {
+		assert.Equal(t, changedId, report.Changed[0].Id)
+		if assert.Len(t, report.Changed[0].Attributes, 1) {
+			assert.Equal(t, "a_string", report.Changed[0].Attributes[0].Name)
+			assert.Equal(t, "changed out of band", report.Changed[0].Attributes[0].Baseline)
+		}
+	}
+
+	// Only the new resource is generated, its references to the other resources are hard coded
+	config, err := ioutil.ReadFile(fmt.Sprintf("%s%scompartment_testing.tf", outputDir, string(os.PathSeparator)))
+	if err != nil {
+		t.Fatalf("no configuration generated: %v", err)
+	}
+	assert.Equal(t, 1, strings.Count(string(config), "resource oci_test_"))
+	assert.Contains(t, string(config), "resource oci_test_parent ")
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitDriftValuesEqual(t *testing.T) {
+	tests := []struct {
+		name       string
+		baseline   interface{}
+		discovered interface{}
+		want       bool
+	}{
+		{"same string", "a", "a", true},
+		{"different string", "a", "b", false},
+		{"null and empty string", nil, "", true},
+		{"number and int", float64(10), normalizeDriftValue(10), true},
+		{"bool and string", true, "true", true},
+		{"list in another order", []interface{}{"a", "b"}, []interface{}{"b", "a"}, true},
+		{"list with another element", []interface{}{"a", "b"}, []interface{}{"a", "c"}, false},
+		{"nested block with computed attributes", []interface{}{map[string]interface{}{"a": "1", "computed": "2"}}, map[string]interface{}{"a": "1"}, true},
+		{"nested block with a changed attribute", []interface{}{map[string]interface{}{"a": "1"}}, map[string]interface{}{"a": "2"}, false},
+		{"nested blocks with computed attributes", []interface{}{map[string]interface{}{"a": "1", "computed": "2"}, map[string]interface{}{"a": "3", "computed": "4"}}, []interface{}{map[string]interface{}{"a": "3"}, map[string]interface{}{"a": "1"}}, true},
+		{"map with a removed key", map[string]interface{}{"a": "1", "b": "2"}, map[string]interface{}{"a": "1"}, false},
+		{"map with an added key", map[string]interface{}{"a": "1"}, map[string]interface{}{"a": "1", "b": "2"}, false},
+		{"map with a removed empty key", map[string]interface{}{"a": "1", "b": ""}, map[string]interface{}{"a": "1"}, true},
+		{"interpolation", "backend_set", normalizeDriftValue(tf_export.InterpolationString{Value: "backend_set"}), true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, driftValuesEqual(test.baseline, test.discovered))
+		})
+	}
+}
+
+// Test that the sensitive values are not written to the drift report
+// issue-routing-tag: terraform/default
+func TestUnitRedactSensitiveDriftValue(t *testing.T) {
+	databaseSchema := &schema.Schema{
+		Type: schema.TypeList,
+		Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+			"db_name":        {Type: schema.TypeString},
+			"admin_password": {Type: schema.TypeString, Sensitive: true},
+		}},
+	}
+	tests := []struct {
+		name            string
+		value           interface{}
+		attributeSchema *schema.Schema
+		want            interface{}
+	}{
+		{"sensitive attribute", "secret", &schema.Schema{Type: schema.TypeString, Sensitive: true}, sensitiveDriftValue},
+		{"empty sensitive attribute", nil, &schema.Schema{Type: schema.TypeString, Sensitive: true}, nil},
+		{"attribute", "value", &schema.Schema{Type: schema.TypeString}, "value"},
+		{"nested block in the state", []interface{}{map[string]interface{}{"db_name": "orcl", "admin_password": "secret"}}, databaseSchema, []interface{}{map[string]interface{}{"db_name": "orcl", "admin_password": sensitiveDriftValue}}},
+		{"discovered nested block", map[string]interface{}{"db_name": "orcl", "admin_password": "secret"}, databaseSchema, map[string]interface{}{"db_name": "orcl", "admin_password": sensitiveDriftValue}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, redactSensitiveDriftValue(test.value, test.attributeSchema))
+		})
+	}
+}
@@ -0,0 +1,396 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/hcl2/hclwrite"
+
+	tf_export "github.com/oracle/terraform-provider-oci/internal/commonexport"
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+// exportModule is a child module of the output_layout modules, holding the resources of a compartment
+type exportModule struct {
+	name          string
+	compartmentId string
+	files         map[string]bool // the configuration files written in the module
+	variables     map[string]bool // the variables referenced by the configuration of the module
+	regions       map[string]bool // the regions of the resources of the module bound to a provider alias
+	lock          sync.Mutex
+}
+
+// moduleOutput is an output of a module referenced by the resources of the other modules
+type moduleOutput struct {
+	module     *exportModule
+	expression string
+}
+
+var exportModules []*exportModule            // sorted by name
+var resourceModules map[string]*exportModule // resource or data source reference (e.g. oci_core_vcn.export_vcn) to its module
+var moduleOutputs map[string]*moduleOutput   // output name to the output, for the references across modules
+var moduleOutputsLock sync.Mutex
+var moduleVariableRegex = regexp.MustCompile(`\bvar\.([a-zA-Z_][a-zA-Z0-9_\-]*)`)
+var moduleNameRegex = regexp.MustCompile(`[^a-zA-Z0-9_\-]+`)
+
+/*
+initExportModules assigns each of the discovered resources to the module of its compartment, if the output_layout is
+modules. The module of a compartment is named after the compartment when it is known.
+*/
+func initExportModules(ctx *tf_export.ResourceDiscoveryContext, steps []resourceDiscoveryStep) {
+	exportModules = nil
+	resourceModules = nil
+	moduleOutputs = nil
+	if ctx.OutputLayout != tf_export.OutputLayoutModules {
+		return
+	}
+	resourceModules = map[string]*exportModule{}
+	moduleOutputs = map[string]*moduleOutput{}
+
+	compartmentNames := map[string]string{ctx.TenancyOcid: "tenancy"}
+	for compartmentId, name := range exportCompartmentNames {
+		compartmentNames[compartmentId] = name
+	}
+	if ctx.CompartmentId != nil && ctx.CompartmentName != nil && *ctx.CompartmentName != "" {
+		compartmentNames[*ctx.CompartmentId] = *ctx.CompartmentName
+	}
+	var resources []*tf_export.OCIResource
+	for _, step := range steps {
+		for _, resource := range step.getDiscoveredResources() {
+			if resource.TerraformClass == "oci_identity_compartment" {
+				if name, ok := resource.SourceAttributes["name"].(string); ok && name != "" {
+					compartmentNames[resource.Id] = name
+				}
+			}
+			resources = append(resources, resource)
+		}
+	}
+
+	compartmentIds := []string{}
+	compartmentModules := map[string]*exportModule{}
+	for _, resource := range resources {
+		compartmentId := getResourceCompartmentId(ctx, resource)
+		if _, exists := compartmentModules[compartmentId]; !exists {
+			compartmentModules[compartmentId] = nil
+			compartmentIds = append(compartmentIds, compartmentId)
+		}
+	}
+
+	// Name the modules in a deterministic order, so that the duplicate names get the same suffix across exports
+	sort.Strings(compartmentIds)
+	moduleNames := map[string]bool{}
+	for _, compartmentId := range compartmentIds {
+		name := getCompartmentIdentifier(compartmentId, compartmentNames[compartmentId])
+		uniqueName := name
+		for i := 1; moduleNames[uniqueName]; i++ {
+			uniqueName = fmt.Sprintf("%s_%d", name, i)
+		}
+		moduleNames[uniqueName] = true
+
+		module := &exportModule{
+			name:          uniqueName,
+			compartmentId: compartmentId,
+			files:         map[string]bool{},
+			variables:     map[string]bool{},
+			regions:       map[string]bool{},
+		}
+		compartmentModules[compartmentId] = module
+		exportModules = append(exportModules, module)
+	}
+	sort.Slice(exportModules, func(i, j int) bool {
+		return exportModules[i].name < exportModules[j].name
+	})
+
+	for _, resource := range resources {
+		resourceModules[getModuleResourceKey(resource)] = compartmentModules[getResourceCompartmentId(ctx, resource)]
+	}
+}
+
+// getResourceCompartmentId returns the compartment of a resource, or the one of its closest parent for the resources without one
+func getResourceCompartmentId(ctx *tf_export.ResourceDiscoveryContext, resource *tf_export.OCIResource) string {
+	for current := resource; current != nil; current = current.Parent {
+		if current.CompartmentId != "" {
+			return current.CompartmentId
+		}
+	}
+	if ctx.CompartmentId != nil && *ctx.CompartmentId != "" {
+		return *ctx.CompartmentId
+	}
+	return ctx.TenancyOcid
+}
+
+func getModuleResourceKey(resource *tf_export.OCIResource) string {
+	if resource.TerraformTypeInfo != nil && resource.TerraformTypeInfo.IsDataSource {
+		return "data." + resource.GetTerraformReference()
+	}
+	return resource.GetTerraformReference()
+}
+
+// getReferencedResourceKey returns the resource or data source of a reference, e.g. oci_core_vcn.export_vcn for oci_core_vcn.export_vcn.id
+func getReferencedResourceKey(reference string) string {
+	referenceParts := strings.Split(reference, ".")
+	if referenceParts[0] == "data" {
+		if len(referenceParts) < 3 {
+			return ""
+		}
+		return strings.Join(referenceParts[:3], ".")
+	}
+	if len(referenceParts) < 2 {
+		return ""
+	}
+	return strings.Join(referenceParts[:2], ".")
+}
+
+/*
+getModuleAddress returns the address of a resource in the root module, e.g. module.export_dev.oci_core_vcn.export_vcn,
+used by the state file and the import blocks
+*/
+func getModuleAddress(reference string) string {
+	if module, exists := resourceModules[reference]; exists && module != nil {
+		return fmt.Sprintf("module.%s.%s", module.name, reference)
+	}
+	return reference
+}
+
+/*
+getModuleInterpolationMap replaces the references to the resources of the other modules with variables of the module,
+which are set by the root module from the outputs of the other modules
+*/
+func getModuleInterpolationMap(module *exportModule, interpolationMap map[string]string) map[string]string {
+	result := make(map[string]string, len(interpolationMap))
+	tf_export.RefMapLock.Lock()
+	defer tf_export.RefMapLock.Unlock()
+	for value, interpolation := range interpolationMap {
+		referencedModule, exists := resourceModules[getReferencedResourceKey(interpolation)]
+		if !exists || referencedModule == nil || referencedModule == module {
+			result[value] = interpolation
+			continue
+		}
+		outputName := moduleNameRegex.ReplaceAllString(strings.Replace(interpolation, ".", "_", -1), "_")
+		moduleOutputsLock.Lock()
+		moduleOutputs[outputName] = &moduleOutput{module: referencedModule, expression: interpolation}
+		moduleOutputsLock.Unlock()
+		result[value] = tf_export.TfHclVersionvar.GetVarHclString(outputName)
+	}
+	return result
+}
+
+/*
+writeModulesConfiguration writes the configuration of the step to a file per module under the modules directory,
+for the output_layout modules
+*/
+func (r *resourceDiscoveryBaseStep) writeModulesConfiguration() error {
+	moduleResources := map[*exportModule][]*tf_export.OCIResource{}
+	for _, resource := range r.discoveredResources {
+		module := resourceModules[getModuleResourceKey(resource)]
+		moduleResources[module] = append(moduleResources[module], resource)
+	}
+
+	for _, module := range exportModules {
+		resources, exists := moduleResources[module]
+		if !exists {
+			continue
+		}
+		builder := &strings.Builder{}
+		builder.WriteString("## This configuration was generated by terraform-provider-oci\n\n")
+
+		exportedResourceCount, err := r.writeResourcesHCL(builder, resources, getModuleInterpolationMap(module, tf_export.ReferenceMap))
+		if err != nil {
+			return err
+		}
+		if exportedResourceCount == 0 {
+			continue
+		}
+
+		moduleDir := fmt.Sprintf("%s%s%s%s%s", *r.ctx.OutputDir, string(os.PathSeparator), globalvar.ModulesDir, string(os.PathSeparator), module.name)
+		if err := os.MkdirAll(moduleDir, os.ModePerm); err != nil {
+			return err
+		}
+		configOutputFile := fmt.Sprintf("%s%s%s.tf", moduleDir, string(os.PathSeparator), r.name)
+		formatted, err := getFormattedHcl(configOutputFile, builder.String())
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(configOutputFile, formatted, 0666); err != nil {
+			return err
+		}
+
+		module.lock.Lock()
+		module.files[r.name] = true
+		for _, match := range moduleVariableRegex.FindAllStringSubmatch(builder.String(), -1) {
+			module.variables[match[1]] = true
+		}
+		for _, region := range getResourceRegions(resources) {
+			module.regions[region] = true
+		}
+		module.lock.Unlock()
+
+		r.ctx.CtxLock.Lock()
+		r.ctx.SummaryStatements = append(r.ctx.SummaryStatements, fmt.Sprintf("Found %d '%s' resources. Generated under '%s'", exportedResourceCount, r.name, configOutputFile))
+		r.ctx.CtxLock.Unlock()
+	}
+	r.ctx.CtxLock.Lock()
+	r.ctx.SummaryStatements = append(r.ctx.SummaryStatements, fmt.Sprintf("Time taken for discovery: %v, generating state: %v", r.timeTakenForDiscovery, r.timeTakenForGeneratingState))
+	r.ctx.CtxLock.Unlock()
+	return nil
+}
+
+/*
+generateModulesFiles writes the variables.tf and outputs.tf files of each module, and the main.tf file of the root
+module calling the modules with the variables they reference: the variables of the root module, or the outputs of
+the other modules for the references across modules
+*/
+func generateModulesFiles(ctx *tf_export.ResourceDiscoveryContext) error {
+	moduleOutputNames := map[*exportModule]map[string]bool{}
+	for _, module := range exportModules {
+		for variable := range module.variables {
+			if output, exists := moduleOutputs[variable]; exists {
+				if moduleOutputNames[output.module] == nil {
+					moduleOutputNames[output.module] = map[string]bool{}
+				}
+				moduleOutputNames[output.module][variable] = true
+			}
+		}
+	}
+	moduleCount := 0
+
+	mainBuilder := &strings.Builder{}
+	mainBuilder.WriteString("## This configuration was generated by terraform-provider-oci\n\n")
+	for _, module := range exportModules {
+		if len(module.files) == 0 {
+			continue
+		}
+		moduleCount++
+		moduleDir := fmt.Sprintf("%s%s%s%s%s", *ctx.OutputDir, string(os.PathSeparator), globalvar.ModulesDir, string(os.PathSeparator), module.name)
+		// Skip the matches which are not references, e.g. in string values
+		variables := []string{}
+		for variable := range module.variables {
+			if _, isOutput := moduleOutputs[variable]; isOutput {
+				variables = append(variables, variable)
+			} else if _, isVar := tf_export.Vars[variable]; isVar {
+				variables = append(variables, variable)
+			}
+		}
+		sort.Strings(variables)
+
+		variablesBuilder := &strings.Builder{}
+		variablesBuilder.WriteString("## This configuration was generated by terraform-provider-oci\n\n")
+		mainBuilder.WriteString(fmt.Sprintf("module %s {\nsource = \"./%s/%s\"\n", module.name, globalvar.ModulesDir, module.name))
+		// The modules declare the source of the provider of the root module, which is not the default one for Terraform 1.x
+		if len(module.regions) > 0 || tf_export.IsTfVersion1() {
+			if err := writeModuleProviderFile(moduleDir, module, mainBuilder); err != nil {
+				return err
+			}
+		}
+		for _, variable := range variables {
+			if tf_export.SensitiveVars[variable] {
+				variablesBuilder.WriteString(fmt.Sprintf("variable %s {\nsensitive = true\n}\n", variable))
+			} else {
+				variablesBuilder.WriteString(fmt.Sprintf("variable %s {}\n", variable))
+			}
+			if output, exists := moduleOutputs[variable]; exists {
+				mainBuilder.WriteString(fmt.Sprintf("%s = module.%s.%s\n", variable, output.module.name, variable))
+			} else {
+				mainBuilder.WriteString(fmt.Sprintf("%s = %s\n", variable, tf_export.TfHclVersionvar.GetVarHclString(variable)))
+			}
+		}
+		mainBuilder.WriteString("}\n\n")
+
+		outputNames := []string{}
+		for outputName := range moduleOutputNames[module] {
+			outputNames = append(outputNames, outputName)
+		}
+		sort.Strings(outputNames)
+		outputsBuilder := &strings.Builder{}
+		outputsBuilder.WriteString("## This configuration was generated by terraform-provider-oci\n\n")
+		for _, outputName := range outputNames {
+			outputsBuilder.WriteString(fmt.Sprintf("output %s {\nvalue = %s\n}\n\n", outputName, moduleOutputs[outputName].expression))
+		}
+
+		if err := ioutil.WriteFile(fmt.Sprintf("%s%s%s", moduleDir, string(os.PathSeparator), globalvar.ModuleVariablesFile), hclwrite.Format([]byte(variablesBuilder.String())), 0666); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(fmt.Sprintf("%s%s%s", moduleDir, string(os.PathSeparator), globalvar.ModuleOutputsFile), hclwrite.Format([]byte(outputsBuilder.String())), 0666); err != nil {
+			return err
+		}
+	}
+
+	mainOutputFile := fmt.Sprintf("%s%s%s", *ctx.OutputDir, string(os.PathSeparator), globalvar.MainFile)
+	if err := ioutil.WriteFile(mainOutputFile, hclwrite.Format([]byte(mainBuilder.String())), 0666); err != nil {
+		return err
+	}
+	ctx.SummaryStatements = append(ctx.SummaryStatements, fmt.Sprintf("Generated the modules of %d compartments called by '%s'", moduleCount, mainOutputFile))
+	return nil
+}
+
+/*
+generateMovedFile writes a moved block from the address of each resource in the root module to its address in its
+module, so that the resources of a configuration exported with the flat output_layout are moved into the modules by
+terraform plan and apply rather than recreated
+*/
+func generateMovedFile(ctx *tf_export.ResourceDiscoveryContext) error {
+	references := []string{}
+	for _, resource := range ctx.DiscoveredResources {
+		if resource.IsErrorResource || (resource.TerraformTypeInfo != nil && resource.TerraformTypeInfo.IsDataSource) {
+			continue
+		}
+		if reference := resource.GetTerraformReference(); getModuleAddress(reference) != reference {
+			references = append(references, reference)
+		}
+	}
+	sort.Strings(references)
+
+	builder := &strings.Builder{}
+	builder.WriteString("## This configuration was generated by terraform-provider-oci\n")
+	builder.WriteString("## The moved blocks have no effect unless the resources are in the state file of the root module\n\n")
+	for _, reference := range references {
+		builder.WriteString(fmt.Sprintf("moved {\nfrom = %s\nto = %s\n}\n\n", reference, getModuleAddress(reference)))
+	}
+
+	movedOutputFile := fmt.Sprintf("%s%s%s", *ctx.OutputDir, string(os.PathSeparator), globalvar.MovedFile)
+	formatted, err := getFormattedHcl(movedOutputFile, builder.String())
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(movedOutputFile, formatted, 0666); err != nil {
+		return err
+	}
+	ctx.SummaryStatements = append(ctx.SummaryStatements, fmt.Sprintf("Moved blocks for %d resources generated under '%s'", len(references), movedOutputFile))
+	return nil
+}
+
+/*
+writeModuleProviderFile declares the source of the provider and the provider aliases of the regions of the resources of
+a module in its provider.tf file, and passes the aliases to the module from the root module
+*/
+func writeModuleProviderFile(moduleDir string, module *exportModule, mainBuilder *strings.Builder) error {
+	regions := make([]string, 0, len(module.regions))
+	for region := range module.regions {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+
+	providerBuilder := &strings.Builder{}
+	providerBuilder.WriteString("## This configuration was generated by terraform-provider-oci\n\n")
+	providerBuilder.WriteString(fmt.Sprintf("terraform {\nrequired_providers {\noci = {\nsource = \"%s\"\n", tf_export.GetProviderSource()))
+	if len(regions) > 0 {
+		aliases := make([]string, 0, len(regions))
+		mainBuilder.WriteString("providers = {\noci = oci\n")
+		for _, region := range regions {
+			aliases = append(aliases, tf_export.GetRegionProviderReference(region))
+			mainBuilder.WriteString(fmt.Sprintf("%s = %s\n", tf_export.GetRegionProviderReference(region), tf_export.GetRegionProviderReference(region)))
+		}
+		mainBuilder.WriteString("}\n")
+		providerBuilder.WriteString(fmt.Sprintf("configuration_aliases = [%s]\n", strings.Join(aliases, ", ")))
+	}
+	providerBuilder.WriteString("}\n}\n}\n")
+	return ioutil.WriteFile(fmt.Sprintf("%s%s%s", moduleDir, string(os.PathSeparator), globalvar.ProviderFile), hclwrite.Format([]byte(providerBuilder.String())), 0666)
+}
@@ -0,0 +1,248 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	tf_export "github.com/oracle/terraform-provider-oci/internal/commonexport"
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+// issue-routing-tag: terraform/default
+func TestUnitWriteModulesConfiguration(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	outputDir, err := os.Getwd()
+	outputDir = fmt.Sprintf("%s%sdiscoveryTest-%d", outputDir, string(os.PathSeparator), time.Now().Nanosecond())
+	if err = os.Mkdir(outputDir, os.ModePerm); err != nil {
+		t.Fatalf("unable to mkdir %s. err: %v", outputDir, err)
+	}
+	defer os.RemoveAll(outputDir)
+
+	devCompartmentId := "ocid1.compartment.oc1..devcompartment"
+	otherCompartmentId := "ocid1.compartment.oc1..other123"
+	compartmentName := "dev"
+	ctx := &tf_export.ResourceDiscoveryContext{
+		TenancyOcid: resourceDiscoveryTestTenancyOcid,
+		ExportCommandArgs: &tf_export.ExportCommandArgs{
+			CompartmentId:   &devCompartmentId,
+			CompartmentName: &compartmentName,
+			OutputDir:       &outputDir,
+			OutputLayout:    tf_export.OutputLayoutModules,
+		},
+	}
+	parent := &tf_export.OCIResource{
+		TerraformResource: tf_export.TerraformResource{
+			Id:             "ocid1.parent.1",
+			TerraformClass: "oci_test_parent",
+			TerraformName:  "parent1",
+		},
+		CompartmentId:    devCompartmentId,
+		SourceAttributes: map[string]interface{}{"compartment_id": devCompartmentId},
+	}
+	child := &tf_export.OCIResource{
+		TerraformResource: tf_export.TerraformResource{
+			Id:             "ocid1.child.1",
+			TerraformClass: "oci_test_child",
+			TerraformName:  "child1",
+		},
+		CompartmentId:    otherCompartmentId,
+		SourceAttributes: map[string]interface{}{"compartment_id": otherCompartmentId, "parent_id": "ocid1.parent.1"},
+	}
+	referenceMap, vars := tf_export.ReferenceMap, tf_export.Vars
+	tf_export.ReferenceMap = map[string]string{
+		devCompartmentId: "var.compartment_ocid",
+		"ocid1.parent.1": "oci_test_parent.parent1.id",
+		"ocid1.child.1":  "oci_test_child.child1.id",
+	}
+	tf_export.Vars = map[string]string{"compartment_ocid": fmt.Sprintf("%q", devCompartmentId)}
+	defer func() {
+		tf_export.ReferenceMap, tf_export.Vars = referenceMap, vars
+		initExportModules(&tf_export.ResourceDiscoveryContext{ExportCommandArgs: &tf_export.ExportCommandArgs{}}, nil)
+	}()
+
+	step := &resourceDiscoveryWithGraph{
+		resourceDiscoveryBaseStep: resourceDiscoveryBaseStep{
+			ctx:                 ctx,
+			name:                "testing",
+			discoveredResources: []*tf_export.OCIResource{parent, child},
+		},
+	}
+	initExportModules(ctx, []resourceDiscoveryStep{step})
+	assert.Equal(t, "module.dev.oci_test_parent.parent1", getModuleAddress("oci_test_parent.parent1"))
+	assert.Equal(t, "module.compartment_other123.oci_test_child.child1", getModuleAddress("oci_test_child.child1"))
+
+	if err := step.writeConfiguration(); err != nil {
+		t.Fatalf("writeConfiguration failed: %v", err)
+	}
+	if err := generateModulesFiles(ctx); err != nil {
+		t.Fatalf("generateModulesFiles failed: %v", err)
+	}
+
+	readFile := func(path ...string) string {
+		filePath := outputDir
+		for _, part := range path {
+			filePath = filePath + string(os.PathSeparator) + part
+		}
+		data, err := ioutil.ReadFile(filePath)
+		if err != nil {
+			t.Fatalf("unable to read %s: %v", filePath, err)
+		}
+		return string(data)
+	}
+
+	devConfig := readFile(globalvar.ModulesDir, "dev", "testing.tf")
+	assert.Contains(t, devConfig, "resource oci_test_parent parent1 {")
+	assert.Regexp(t, `compartment_id\s+= var\.compartment_ocid\n`, devConfig)
+	assert.NotContains(t, devConfig, "oci_test_child")
+	assert.Contains(t, readFile(globalvar.ModulesDir, "dev", globalvar.ModuleVariablesFile), "variable compartment_ocid {}")
+	assert.Regexp(t, `output oci_test_parent_parent1_id \{\s+value = oci_test_parent\.parent1\.id\s+\}`, readFile(globalvar.ModulesDir, "dev", globalvar.ModuleOutputsFile))
+
+	// The reference to the parent in another compartment is wired through the outputs of its module
+	otherConfig := readFile(globalvar.ModulesDir, "compartment_other123", "testing.tf")
+	assert.Contains(t, otherConfig, "resource oci_test_child child1 {")
+	assert.Regexp(t, `parent_id\s+= var\.oci_test_parent_parent1_id\n`, otherConfig)
+	otherVariables := readFile(globalvar.ModulesDir, "compartment_other123", globalvar.ModuleVariablesFile)
+	assert.Contains(t, otherVariables, "variable oci_test_parent_parent1_id {}")
+	assert.NotContains(t, otherVariables, "compartment_ocid")
+	assert.NotContains(t, readFile(globalvar.ModulesDir, "compartment_other123", globalvar.ModuleOutputsFile), "output")
+
+	mainConfig := readFile(globalvar.MainFile)
+	assert.Regexp(t, `module dev \{\s+source\s+= "\./modules/dev"\s+compartment_ocid = var\.compartment_ocid\s+\}`, mainConfig)
+	assert.Regexp(t, `module compartment_other123 \{\s+source\s+= "\./modules/compartment_other123"\s+oci_test_parent_parent1_id = module\.dev\.oci_test_parent_parent1_id\s+\}`, mainConfig)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitExportCommandArgs_validateOutputLayout(t *testing.T) {
+	tfVersion11 := tf_export.TfHclVersion(&tf_export.TfHclVersion11{Value: tf_export.TfVersion11})
+	tests := []struct {
+		name    string
+		args    tf_export.ExportCommandArgs
+		wantErr bool
+	}{
+		{"modules", tf_export.ExportCommandArgs{OutputLayout: tf_export.OutputLayoutModules}, false},
+		{"modules with native state", tf_export.ExportCommandArgs{OutputLayout: tf_export.OutputLayoutModules, GenerateState: true, ImportMode: tf_export.ImportModeNative}, false},
+		{"modules with cli state", tf_export.ExportCommandArgs{OutputLayout: tf_export.OutputLayoutModules, GenerateState: true, ImportMode: tf_export.ImportModeCli}, true},
+		{"modules with tf_version 0.11", tf_export.ExportCommandArgs{OutputLayout: tf_export.OutputLayoutModules, TFVersion: &tfVersion11}, true},
+		{"invalid layout", tf_export.ExportCommandArgs{OutputLayout: "tree"}, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			outputDir := os.TempDir()
+			test.args.OutputDir = &outputDir
+			test.args.Parallelism = 1
+			err := test.args.Validate()
+			if (err != nil) != test.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, test.wantErr)
+			}
+		})
+	}
+}
+
+// Test that RunExportCommand with tf_version 1 declares the source of the provider, and writes the import and moved blocks
+// issue-routing-tag: terraform/default
+func TestUnitRunExportCommand_tfVersion1(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	compartmentId := resourceDiscoveryTestCompartmentOcid
+	workingDir, _ := os.Getwd()
+	outputDir := fmt.Sprintf("%s%sdiscoveryTest-%d", workingDir, string(os.PathSeparator), time.Now().Nanosecond())
+	if err := os.Mkdir(outputDir, os.ModePerm); err != nil {
+		t.Fatalf("unable to mkdir %s. err: %v", outputDir, err)
+	}
+	defer os.RemoveAll(outputDir)
+	defer initExportModules(&tf_export.ResourceDiscoveryContext{ExportCommandArgs: &tf_export.ExportCommandArgs{}}, nil)
+
+	tf_export.TfHclVersionvar = &tf_export.TfHclVersion1{TfHclVersion12: tf_export.TfHclVersion12{Value: tf_export.TfVersion1}}
+	args := &tf_export.ExportCommandArgs{
+		CompartmentId: &compartmentId,
+		Services:      []string{"compartment_testing"},
+		OutputDir:     &outputDir,
+		TFVersion:     &tf_export.TfHclVersionvar,
+		Parallelism:   1,
+		ImportMode:    tf_export.ImportModeNative,
+		OutputLayout:  tf_export.OutputLayoutModules,
+	}
+	getEnvSettingWithBlankDefaultVar = func(varName string) string {
+		return resourceDiscoveryTestTenancyOcid
+	}
+	getExportConfigVar = func(d *schema.ResourceData) (interface{}, error) {
+		return getTestClients(), nil
+	}
+	exportConfigProvider = acctest.MockConfigurationProvider{}
+	if err, status := RunExportCommand(args); err != nil || status != StatusSuccess {
+		t.Fatalf("export command failed with status %v due to err: %v", status, err)
+	}
+	assert.Equal(t, `provider["registry.terraform.io/oracle/oci"]`, getNativeStateProvider())
+
+	provider, err := ioutil.ReadFile(filepath.Join(outputDir, globalvar.ProviderFile))
+	if err != nil {
+		t.Fatalf("no provider file generated: %v", err)
+	}
+	assert.Contains(t, string(provider), "source  = \"oracle/oci\"")
+	assert.Contains(t, string(provider), fmt.Sprintf("version = \">= %s\"", globalvar.Version))
+
+	modules, err := ioutil.ReadDir(filepath.Join(outputDir, globalvar.ModulesDir))
+	if err != nil || len(modules) == 0 {
+		t.Fatalf("no modules generated: %v", err)
+	}
+	for _, module := range modules {
+		moduleProvider, err := ioutil.ReadFile(filepath.Join(outputDir, globalvar.ModulesDir, module.Name(), globalvar.ProviderFile))
+		if err != nil {
+			t.Fatalf("no provider file generated for module %s: %v", module.Name(), err)
+		}
+		assert.Contains(t, string(moduleProvider), "source = \"oracle/oci\"")
+	}
+
+	// The state is not generated, the resources are imported with import blocks
+	_, err = os.Stat(filepath.Join(outputDir, globalvar.ImportsFile))
+	assert.NoError(t, err)
+	moved, err := ioutil.ReadFile(filepath.Join(outputDir, globalvar.MovedFile))
+	if err != nil {
+		t.Fatalf("no moved file generated: %v", err)
+	}
+	assert.Equal(t, len(parentResources)+len(childrenResources), strings.Count(string(moved), "moved {"))
+	for id := range parentResources {
+		reference := strings.TrimSuffix(tf_export.ReferenceMap[id], ".id")
+		assert.Contains(t, string(moved), fmt.Sprintf("from = %s\n", reference))
+		assert.Contains(t, string(moved), fmt.Sprintf("to   = %s\n", getModuleAddress(reference)))
+	}
+}
+
+// Test that the provider file written for terraform import with tf_version 1 requires the provider source of the
+// generated configuration, and that it is replaced by the final provider file
+// issue-routing-tag: terraform/default
+func TestUnitGenerateRequiredProvidersFileForImport(t *testing.T) {
+	outputDir, err := ioutil.TempDir("", "discoveryTest")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(outputDir)
+	tfHclVersion := tf_export.TfHclVersionvar
+	defer func() { tf_export.TfHclVersionvar = tfHclVersion }()
+	tf_export.TfHclVersionvar = &tf_export.TfHclVersion1{TfHclVersion12: tf_export.TfHclVersion12{Value: tf_export.TfVersion1}}
+
+	assert.NoError(t, generateRequiredProvidersFileForImport(&outputDir))
+	provider, err := ioutil.ReadFile(filepath.Join(outputDir, globalvar.ProviderFile))
+	assert.NoError(t, err)
+	assert.Contains(t, string(provider), "source  = \"oracle/oci\"")
+	assert.NotContains(t, string(provider), "provider oci {")
+
+	assert.NoError(t, generateProviderFile(&outputDir, nil))
+	provider, err = ioutil.ReadFile(filepath.Join(outputDir, globalvar.ProviderFile))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, strings.Count(string(provider), "required_providers"))
+	assert.Contains(t, string(provider), "provider oci {")
+}
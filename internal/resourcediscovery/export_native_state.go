@@ -0,0 +1,301 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	tf_export "github.com/oracle/terraform-provider-oci/internal/commonexport"
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+	utils "github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+const (
+	nativeStateVersion          = 4
+	nativeStateTerraformVersion = "0.13.0" // oldest version reading the provider address format below, newer versions upgrade the state
+)
+
+// nativeState is the v4 state file format written by Terraform
+type nativeState struct {
+	Version          int                    `json:"version"`
+	TerraformVersion string                 `json:"terraform_version"`
+	Serial           int64                  `json:"serial"`
+	Lineage          string                 `json:"lineage"`
+	Outputs          map[string]interface{} `json:"outputs"`
+	Resources        []*nativeStateResource `json:"resources"`
+}
+
+type nativeStateResource struct {
+	Module    string                 `json:"module,omitempty"`
+	Mode      string                 `json:"mode"`
+	Type      string                 `json:"type"`
+	Name      string                 `json:"name"`
+	Provider  string                 `json:"provider"`
+	Instances []*nativeStateInstance `json:"instances"`
+}
+
+type nativeStateInstance struct {
+	SchemaVersion int             `json:"schema_version"`
+	Attributes    json.RawMessage `json:"attributes"`
+	Dependencies  []string        `json:"dependencies,omitempty"`
+}
+
+// getNativeStateProvider returns the address of the provider of the resources in the state file
+func getNativeStateProvider() string {
+	return fmt.Sprintf(`provider["registry.terraform.io/%s"]`, tf_export.GetProviderSource())
+}
+
+/*
+generateNativeState is used if value of import_mode arg is native
+- reads each of the discovered resources the way terraform import does: the resource importer followed by the resource read
+- writes the state file from the schema and the read attributes of the resources, without running the Terraform CLI
+*/
+func generateNativeState(ctx *tf_export.ResourceDiscoveryContext, steps []resourceDiscoveryStep) error {
+	// The resources read are checkpointed per step, so that resuming the export does not read them again
+	var stepCheckpoints []*checkpointStateWriter
+	var stepImported []map[string]*nativeStateResource
+	defer func() {
+		for _, checkpoint := range stepCheckpoints {
+			_ = checkpoint.close()
+		}
+	}()
+	resourceCheckpoints := []int{}
+	for _, step := range steps {
+		imported, checkpoint, err := openCheckpointState(ctx, step.getBaseStep().name)
+		if err != nil {
+			return err
+		}
+		for range step.getDiscoveredResources() {
+			resourceCheckpoints = append(resourceCheckpoints, len(stepCheckpoints))
+		}
+		stepCheckpoints = append(stepCheckpoints, checkpoint)
+		stepImported = append(stepImported, imported)
+		ctx.DiscoveredResources = append(ctx.DiscoveredResources, step.getDiscoveredResources()...)
+	}
+
+	stateResources := make([]*nativeStateResource, len(ctx.DiscoveredResources))
+	var wg sync.WaitGroup
+	for i, resource := range ctx.DiscoveredResources {
+		checkpointKey := fmt.Sprintf("%s:%s", resource.TerraformClass, resource.Id)
+		if imported, exists := stepImported[resourceCheckpoints[i]][checkpointKey]; exists && imported.Type == resource.TerraformClass && imported.Name == resource.TerraformName {
+			utils.Debugf("[DEBUG] resource '%s' was imported before resuming, skipping it", resource.GetTerraformReference())
+			stateResources[i] = imported
+			continue
+		}
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int, resource *tf_export.OCIResource) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			stateResources[i] = getNativeStateResource(ctx, resource)
+			if stateResources[i] != nil {
+				if err := stepCheckpoints[resourceCheckpoints[i]].write(checkpointKey, stateResources[i]); err != nil {
+					utils.Logf("[WARN] unable to checkpoint the state of resource '%s': %s", resource.GetTerraformReference(), err.Error())
+				}
+			}
+		}(i, resource)
+	}
+	wg.Wait()
+
+	state := &nativeState{
+		Version:          nativeStateVersion,
+		TerraformVersion: nativeStateTerraformVersion,
+		Serial:           1,
+		Outputs:          map[string]interface{}{},
+		Resources:        []*nativeStateResource{},
+	}
+	lineage, err := uuid.GenerateUUID()
+	if err != nil {
+		return err
+	}
+	state.Lineage = lineage
+
+	addresses := map[string]bool{}
+	for _, stateResource := range stateResources {
+		if stateResource != nil {
+			state.Resources = append(state.Resources, stateResource)
+			addresses[getModuleAddress(fmt.Sprintf("%s.%s", stateResource.Type, stateResource.Name))] = true
+		}
+	}
+	// Only keep the dependencies on resources in the state, the others failed to import
+	for _, stateResource := range state.Resources {
+		instance := stateResource.Instances[0]
+		dependencies := instance.Dependencies[:0]
+		for _, dependency := range instance.Dependencies {
+			if addresses[dependency] {
+				dependencies = append(dependencies, dependency)
+			}
+		}
+		instance.Dependencies = dependencies
+	}
+	sort.Slice(state.Resources, func(i, j int) bool {
+		if state.Resources[i].Module != state.Resources[j].Module {
+			return state.Resources[i].Module < state.Resources[j].Module
+		}
+		if state.Resources[i].Type != state.Resources[j].Type {
+			return state.Resources[i].Type < state.Resources[j].Type
+		}
+		return state.Resources[i].Name < state.Resources[j].Name
+	})
+	ctx.State = state
+
+	stateOutputFile := fmt.Sprintf("%s%s%s", *ctx.OutputDir, string(os.PathSeparator), globalvar.DefaultStateFilename)
+	data, err := json.MarshalIndent(state, "", "\t")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(stateOutputFile, data, 0644)
+}
+
+/*
+getNativeStateResource returns the state of a discovered resource, or nil if the resource can not be imported.
+The resource is marked as errored if it could not be read.
+*/
+func getNativeStateResource(ctx *tf_export.ResourceDiscoveryContext, resource *tf_export.OCIResource) *nativeStateResource {
+	utils.Debugf("[DEBUG] ===> Generating state for resource '%s'", resource.GetTerraformReference())
+
+	resourceSchema, exists := tf_export.ResourcesMap[resource.TerraformClass]
+	if !exists {
+		utils.Debugf("[DEBUG] skip importing '%s' since it is not a Terraform OCI resource", resource.GetTerraformReference())
+		return nil
+	}
+
+	if resourceSchema.Importer == nil {
+		utils.Logf("[WARN] unable to import '%s' because import is not supported for '%s'", resource.GetTerraformReference(), resource.TerraformClass)
+		return nil
+	}
+
+	importId := resource.ImportId
+	if len(importId) == 0 {
+		importId = resource.Id
+	}
+
+	instance, err := getNativeStateInstance(ctx, resourceSchema, resource, importId)
+	if err != nil {
+		utils.Logf("[ERROR] state generation failed for resource '%s' at id '%s': %s", resource.GetTerraformReference(), importId, err.Error())
+		addImportError(ctx, resource, fmt.Errorf("[ERROR] state generation failed for resource '%s' at id '%s': %s Any references to this resource have been replaced with hard coded values in generated configurations", resource.GetTerraformReference(), importId, err.Error()))
+		return nil
+	}
+
+	module := ""
+	if address := getModuleAddress(resource.GetTerraformReference()); address != resource.GetTerraformReference() {
+		module = strings.TrimSuffix(address, "."+resource.GetTerraformReference())
+	}
+
+	provider := getNativeStateProvider()
+	if resource.Region != "" {
+		provider = fmt.Sprintf("%s.%s", provider, tf_export.GetRegionProviderAlias(resource.Region))
+	}
+
+	return &nativeStateResource{
+		Module:    module,
+		Mode:      "managed",
+		Type:      resource.TerraformClass,
+		Name:      resource.TerraformName,
+		Provider:  provider,
+		Instances: []*nativeStateInstance{instance},
+	}
+}
+
+func getNativeStateInstance(ctx *tf_export.ResourceDiscoveryContext, resourceSchema *schema.Resource, resource *tf_export.OCIResource, importId string) (*nativeStateInstance, error) {
+	d := resourceSchema.Data(nil)
+	d.SetId(importId)
+
+	// The resources of the other regions are read with the clients of their region
+	clients := ctx.GetRegionClients(resource.Region)
+	var imported []*schema.ResourceData
+	var err error
+	if resourceSchema.Importer.StateContext != nil {
+		imported, err = resourceSchema.Importer.StateContext(context.Background(), d, clients)
+	} else {
+		imported, err = resourceSchema.Importer.State(d, clients)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(imported) == 0 {
+		return nil, fmt.Errorf("the importer returned no resource")
+	}
+
+	// The importer may return a different resource data, e.g. with the attributes parsed from a composite import id
+	d = imported[0]
+	if err := tfresource.ReadSchemaResource(resourceSchema, d, clients); err != nil {
+		return nil, err
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("cannot import non-existent remote object")
+	}
+
+	instanceState := d.State()
+	if instanceState == nil {
+		return nil, fmt.Errorf("the resource has no state")
+	}
+
+	ty := resourceSchema.CoreConfigSchema().ImpliedType()
+	value, err := instanceState.AttrsAsObjectValue(ty)
+	if err != nil {
+		return nil, err
+	}
+	attributes, err := ctyjson.Marshal(value, ty)
+	if err != nil {
+		return nil, err
+	}
+
+	return &nativeStateInstance{
+		SchemaVersion: resourceSchema.SchemaVersion,
+		Attributes:    attributes,
+		Dependencies:  getNativeStateDependencies(resource, instanceState.Attributes),
+	}, nil
+}
+
+/*
+getNativeStateDependencies returns the sorted addresses of the resources referenced by the attributes of a resource,
+using the same references as the ones replacing the ocids in the generated configurations
+*/
+func getNativeStateDependencies(resource *tf_export.OCIResource, attributes map[string]string) []string {
+	dependencySet := map[string]bool{}
+
+	tf_export.RefMapLock.Lock()
+	for _, value := range attributes {
+		reference, exists := tf_export.ReferenceMap[value]
+		if !exists {
+			continue
+		}
+		reference = strings.TrimSuffix(strings.TrimPrefix(reference, "${"), "}")
+		referenceParts := strings.Split(reference, ".")
+		if len(referenceParts) < 2 {
+			continue
+		}
+		// skip the references to variables and data sources
+		if _, isResource := tf_export.ResourcesMap[referenceParts[0]]; !isResource {
+			continue
+		}
+		dependency := fmt.Sprintf("%s.%s", referenceParts[0], referenceParts[1])
+		if dependency != resource.GetTerraformReference() {
+			dependencySet[getModuleAddress(dependency)] = true
+		}
+	}
+	tf_export.RefMapLock.Unlock()
+
+	dependencies := make([]string, 0, len(dependencySet))
+	for dependency := range dependencySet {
+		dependencies = append(dependencies, dependency)
+	}
+	sort.Strings(dependencies)
+	return dependencies
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	tf_export "github.com/oracle/terraform-provider-oci/internal/commonexport"
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+// Test that RunExportCommand writes the state file without the Terraform CLI with import_mode native
+// issue-routing-tag: terraform/default
+func TestUnitRunExportCommand_nativeState(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	compartmentId := resourceDiscoveryTestCompartmentOcid
+	outputDir, err := os.Getwd()
+	outputDir = fmt.Sprintf("%s%sdiscoveryTest-%d", outputDir, string(os.PathSeparator), time.Now().Nanosecond())
+	if err = os.Mkdir(outputDir, os.ModePerm); err != nil {
+		t.Fatalf("unable to mkdir %s. err: %v", outputDir, err)
+	}
+	defer os.RemoveAll(outputDir)
+
+	tf_export.TfHclVersionvar = &tf_export.TfHclVersion12{Value: tf_export.TfVersion12}
+	args := &tf_export.ExportCommandArgs{
+		CompartmentId: &compartmentId,
+		Services:      []string{"compartment_testing"},
+		OutputDir:     &outputDir,
+		GenerateState: true,
+		TFVersion:     &tf_export.TfHclVersionvar,
+		Parallelism:   4,
+		ImportMode:    tf_export.ImportModeNative,
+	}
+	getEnvSettingWithBlankDefaultVar = func(varName string) string {
+		return resourceDiscoveryTestTenancyOcid
+	}
+	getExportConfigVar = func(d *schema.ResourceData) (interface{}, error) {
+		return getTestClients(), nil
+	}
+	exportConfigProvider = acctest.MockConfigurationProvider{}
+	if err, _ = RunExportCommand(args); err != nil {
+		t.Fatalf("export command failed due to err: %v", err)
+	}
+
+	data, err := ioutil.ReadFile(fmt.Sprintf("%s%s%s", outputDir, string(os.PathSeparator), globalvar.DefaultStateFilename))
+	if err != nil {
+		t.Fatalf("no %s file generated: %v", globalvar.DefaultStateFilename, err)
+	}
+	var state nativeState
+	if err := json.Unmarshal(data, &state); err != nil {
+		t.Fatalf("invalid state file: %v", err)
+	}
+	assert.Equal(t, 4, state.Version)
+	assert.Equal(t, int64(1), state.Serial)
+	assert.NotEmpty(t, state.Lineage)
+	assert.NotEmpty(t, state.Resources)
+
+	foundChildDependency := false
+	for i, resource := range state.Resources {
+		if i > 0 {
+			previous := state.Resources[i-1]
+			assert.True(t, previous.Type < resource.Type || (previous.Type == resource.Type && previous.Name < resource.Name), "resources are not sorted")
+		}
+		assert.Equal(t, "managed", resource.Mode)
+		assert.Equal(t, getNativeStateProvider(), resource.Provider)
+		if assert.Len(t, resource.Instances, 1) {
+			var attributes map[string]interface{}
+			assert.NoError(t, json.Unmarshal(resource.Instances[0].Attributes, &attributes))
+			assert.NotEmpty(t, attributes["id"])
+			assert.NotEmpty(t, attributes["compartment_id"])
+			for _, dependency := range resource.Instances[0].Dependencies {
+				if resource.Type == "oci_test_child" && strings.HasPrefix(dependency, "oci_test_parent.") {
+					foundChildDependency = true
+				}
+			}
+		}
+	}
+	assert.True(t, foundChildDependency, "expected a child resource depending on its parent")
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitGetNativeStateDependencies(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	tf_export.ReferenceMap = map[string]string{
+		"ocid1.parent.1": "oci_test_parent.parent1.id",
+		"ocid1.parent.2": "${oci_test_parent.parent2.id}",
+		"ocid1.child.1":  "oci_test_child.child1.id",
+		"ocid1.ad.1":     "data.oci_identity_availability_domain.ad1.name",
+		"region":         "var.region",
+	}
+	resource := &tf_export.OCIResource{
+		TerraformResource: tf_export.TerraformResource{
+			TerraformClass: "oci_test_child",
+			TerraformName:  "child1",
+		},
+	}
+	dependencies := getNativeStateDependencies(resource, map[string]string{
+		"id":                  "ocid1.child.1",
+		"parent_id":           "ocid1.parent.2",
+		"other_parent_ids.0":  "ocid1.parent.1",
+		"other_parent_ids.1":  "ocid1.parent.2",
+		"availability_domain": "ocid1.ad.1",
+		"region":              "region",
+	})
+	assert.Equal(t, []string{"oci_test_parent.parent1", "oci_test_parent.parent2"}, dependencies)
+}
@@ -0,0 +1,185 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+
+	tf_export "github.com/oracle/terraform-provider-oci/internal/commonexport"
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+	utils "github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+const (
+	exportReportStatusSuccess        = "success"
+	exportReportStatusPartialSuccess = "partial_success"
+	exportReportStatusFail           = "fail"
+)
+
+// exportReport is written to the report_file at the end of an export
+type exportReport struct {
+	Status                          string                           `json:"status"`
+	Error                           string                           `json:"error,omitempty"`
+	Resources                       []*exportReportResource          `json:"resources"`
+	OmittedResources                []*exportReportResource          `json:"omitted_resources"`
+	Errors                          []*exportReportError             `json:"errors"`
+	MissingAttributes               []*exportReportMissingAttributes `json:"missing_attributes"`
+	NotFoundIds                     []string                         `json:"not_found_ids"`
+	Steps                           []*exportReportStep              `json:"steps"`
+	TimeTakenToDiscoverSeconds      float64                          `json:"time_taken_to_discover_seconds"`
+	TimeTakenToGenerateStateSeconds float64                          `json:"time_taken_to_generate_state_seconds"`
+	TimeTakenForEntireExportSeconds float64                          `json:"time_taken_for_entire_export_seconds"`
+}
+
+type exportReportResource struct {
+	Address       string `json:"address"`
+	Type          string `json:"type"`
+	Name          string `json:"name"`
+	Id            string `json:"id"`
+	CompartmentId string `json:"compartment_id,omitempty"`
+	File          string `json:"file,omitempty"`
+}
+
+type exportReportError struct {
+	ResourceType   string `json:"resource_type,omitempty"`
+	ParentResource string `json:"parent_resource,omitempty"`
+	Error          string `json:"error"`
+}
+
+type exportReportMissingAttributes struct {
+	Resource   string   `json:"resource"`
+	Attributes []string `json:"attributes"`
+}
+
+type exportReportStep struct {
+	Name                            string  `json:"name"`
+	DiscoveredResources             int     `json:"discovered_resources"`
+	OmittedResources                int     `json:"omitted_resources"`
+	TimeTakenToDiscoverSeconds      float64 `json:"time_taken_to_discover_seconds"`
+	TimeTakenToGenerateStateSeconds float64 `json:"time_taken_to_generate_state_seconds"`
+}
+
+// getExportReportStatus returns the report status of an export, as for the exit status of RunExportCommand
+func getExportReportStatus(ctx *tf_export.ResourceDiscoveryContext, exportErr error) string {
+	if exportErr != nil {
+		return exportReportStatusFail
+	}
+	if len(ctx.ErrorList.Errors) > 0 {
+		return exportReportStatusPartialSuccess
+	}
+	return exportReportStatusSuccess
+}
+
+/*
+generateReportFile writes the report_file with the resources exported and omitted by each step, the errors, the
+missing required attributes and the timings of the export, so that automation does not have to parse the logs.
+*/
+func generateReportFile(ctx *tf_export.ResourceDiscoveryContext, steps []resourceDiscoveryStep, exportErr error) error {
+	report := &exportReport{
+		Status:                          getExportReportStatus(ctx, exportErr),
+		Resources:                       []*exportReportResource{},
+		OmittedResources:                []*exportReportResource{},
+		Errors:                          []*exportReportError{},
+		MissingAttributes:               []*exportReportMissingAttributes{},
+		NotFoundIds:                     []string{},
+		Steps:                           []*exportReportStep{},
+		TimeTakenToDiscoverSeconds:      ctx.TimeTakenToDiscover.Seconds(),
+		TimeTakenToGenerateStateSeconds: ctx.TimeTakenToGenerateState.Seconds(),
+		TimeTakenForEntireExportSeconds: ctx.TimeTakenForEntireExport.Seconds(),
+	}
+	if exportErr != nil {
+		report.Error = exportErr.Error()
+	}
+
+	for _, step := range steps {
+		baseStep := step.getBaseStep()
+		exportedResourceCount := 0
+		for _, resource := range baseStep.discoveredResources {
+			// The resources for which import failed are not written, they are reported in the errors
+			if resource.IsErrorResource {
+				continue
+			}
+			reportResource := getExportReportResource(resource)
+			reportResource.File = getExportReportResourceFile(ctx, baseStep.name, resource)
+			report.Resources = append(report.Resources, reportResource)
+			exportedResourceCount++
+		}
+		for _, resource := range baseStep.omittedResources {
+			report.OmittedResources = append(report.OmittedResources, getExportReportResource(resource))
+		}
+		report.Steps = append(report.Steps, &exportReportStep{
+			Name:                            baseStep.name,
+			DiscoveredResources:             exportedResourceCount,
+			OmittedResources:                len(baseStep.omittedResources),
+			TimeTakenToDiscoverSeconds:      baseStep.timeTakenForDiscovery.Seconds(),
+			TimeTakenToGenerateStateSeconds: baseStep.timeTakenForGeneratingState.Seconds(),
+		})
+	}
+	for _, resources := range [][]*exportReportResource{report.Resources, report.OmittedResources} {
+		sort.SliceStable(resources, func(i, j int) bool {
+			return resources[i].Address < resources[j].Address
+		})
+	}
+
+	for _, rdError := range ctx.ErrorList.Errors {
+		reportError := &exportReportError{
+			ResourceType:   rdError.ResourceType,
+			ParentResource: rdError.ParentResource,
+		}
+		if rdError.Error != nil {
+			reportError.Error = rdError.Error.Error()
+		}
+		report.Errors = append(report.Errors, reportError)
+	}
+
+	for resource, missingAttributes := range ctx.MissingAttributesPerResource {
+		attributes := append([]string{}, missingAttributes...)
+		sort.Strings(attributes)
+		report.MissingAttributes = append(report.MissingAttributes, &exportReportMissingAttributes{Resource: resource, Attributes: attributes})
+	}
+	sort.Slice(report.MissingAttributes, func(i, j int) bool {
+		return report.MissingAttributes[i].Resource < report.MissingAttributes[j].Resource
+	})
+
+	for id, found := range ctx.ExpectedResourceIds {
+		if !found {
+			report.NotFoundIds = append(report.NotFoundIds, id)
+		}
+	}
+	sort.Strings(report.NotFoundIds)
+
+	data, err := json.MarshalIndent(report, "", "\t")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(*ctx.ReportFile, data, 0644); err != nil {
+		return err
+	}
+	utils.Logf("[INFO] export report generated under '%s'", *ctx.ReportFile)
+	return nil
+}
+
+func getExportReportResource(resource *tf_export.OCIResource) *exportReportResource {
+	return &exportReportResource{
+		Address:       getModuleAddress(getModuleResourceKey(resource)),
+		Type:          resource.TerraformClass,
+		Name:          resource.TerraformName,
+		Id:            resource.Id,
+		CompartmentId: resource.CompartmentId,
+	}
+}
+
+// getExportReportResourceFile returns the configuration file written by writeConfiguration for a resource of a step
+func getExportReportResourceFile(ctx *tf_export.ResourceDiscoveryContext, stepName string, resource *tf_export.OCIResource) string {
+	if ctx.OutputLayout == tf_export.OutputLayoutModules {
+		if module, exists := resourceModules[getModuleResourceKey(resource)]; exists && module != nil {
+			return fmt.Sprintf("%s%s%s%s%s%s%s.tf", *ctx.OutputDir, string(os.PathSeparator), globalvar.ModulesDir, string(os.PathSeparator), module.name, string(os.PathSeparator), stepName)
+		}
+	}
+	return fmt.Sprintf("%s%s%s.tf", *ctx.OutputDir, string(os.PathSeparator), stepName)
+}
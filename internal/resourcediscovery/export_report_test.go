@@ -0,0 +1,93 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	tf_export "github.com/oracle/terraform-provider-oci/internal/commonexport"
+)
+
+// Test that RunExportCommand writes the report_file, with the errors of a partial success
+// issue-routing-tag: terraform/default
+func TestUnitRunExportCommand_reportFile(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	compartmentId := resourceDiscoveryTestCompartmentOcid
+	workingDir, _ := os.Getwd()
+	outputDir := fmt.Sprintf("%s%sdiscoveryTest-%d", workingDir, string(os.PathSeparator), time.Now().Nanosecond())
+	if err := os.Mkdir(outputDir, os.ModePerm); err != nil {
+		t.Fatalf("unable to mkdir %s. err: %v", outputDir, err)
+	}
+	defer os.RemoveAll(outputDir)
+	reportFile := fmt.Sprintf("%s%sreport.json", outputDir, string(os.PathSeparator))
+
+	tf_export.TfHclVersionvar = &tf_export.TfHclVersion12{Value: tf_export.TfVersion12}
+	args := &tf_export.ExportCommandArgs{
+		CompartmentId: &compartmentId,
+		Services:      []string{"compartment_testing"},
+		OutputDir:     &outputDir,
+		TFVersion:     &tf_export.TfHclVersionvar,
+		Parallelism:   1,
+		ReportFile:    &reportFile,
+	}
+	getEnvSettingWithBlankDefaultVar = func(varName string) string {
+		return resourceDiscoveryTestTenancyOcid
+	}
+	getExportConfigVar = func(d *schema.ResourceData) (interface{}, error) {
+		return getTestClients(), nil
+	}
+	exportConfigProvider = acctest.MockConfigurationProvider{}
+
+	readReport := func() *exportReport {
+		data, err := ioutil.ReadFile(reportFile)
+		if err != nil {
+			t.Fatalf("no report file generated: %v", err)
+		}
+		var report exportReport
+		if err := json.Unmarshal(data, &report); err != nil {
+			t.Fatalf("invalid report file: %v", err)
+		}
+		return &report
+	}
+
+	if err, status := RunExportCommand(args); err != nil || status != StatusSuccess {
+		t.Fatalf("export command failed with status %v due to err: %v", status, err)
+	}
+	report := readReport()
+	assert.Equal(t, exportReportStatusSuccess, report.Status)
+	assert.Empty(t, report.Errors)
+	assert.Len(t, report.Resources, len(parentResources)+len(childrenResources))
+	for _, resource := range report.Resources {
+		assert.Equal(t, fmt.Sprintf("%s.%s", resource.Type, resource.Name), resource.Address)
+		assert.NotEmpty(t, resource.Id)
+		assert.Equal(t, fmt.Sprintf("%s%scompartment_testing.tf", outputDir, string(os.PathSeparator)), resource.File)
+	}
+	if assert.Len(t, report.Steps, 1) {
+		assert.Equal(t, "compartment_testing", report.Steps[0].Name)
+		assert.Equal(t, len(report.Resources), report.Steps[0].DiscoveredResources)
+	}
+
+	initResourceDiscoveryTests()
+	tf_export.CompartmentResourceGraphs["compartment_testing"] = compartmentTestingResourceGraphWithFaultyParentResource
+	if _, status := RunExportCommand(args); status != StatusPartialSuccess {
+		t.Fatalf("export command returned unexpected status %v", status)
+	}
+	report = readReport()
+	assert.Equal(t, exportReportStatusPartialSuccess, report.Status)
+	if assert.NotEmpty(t, report.Errors) {
+		assert.Equal(t, "oci_test_parent", report.Errors[0].ResourceType)
+		assert.Equal(t, "export", report.Errors[0].ParentResource)
+		assert.NotEmpty(t, report.Errors[0].Error)
+	}
+}
@@ -21,11 +21,10 @@ import (
 
 	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 
 	"github.com/hashicorp/terraform-exec/tfexec"
-
-	"github.com/hashicorp/hcl2/hclwrite"
 )
 
 var isInitDone bool
@@ -92,8 +91,8 @@ func createResourceDiscoveryContext(clients *tf_client.OracleClients, args *tf_e
 			break
 		}
 	}
-	// validate terraform version and initialize terraform for import - only required if generating state file
-	if args.GenerateState {
+	// validate terraform version and initialize terraform for import - only required if generating state file with the Terraform CLI
+	if args.GenerateState && args.ImportMode != tf_export.ImportModeNative {
 		if tf, terraformCLIPath, err := createTerraformStruct(args); err != nil {
 			return result, err
 		} else {
@@ -193,13 +192,27 @@ func (r *resourceDiscoveryBaseStep) writeTmpState() error {
 	tmpStateOutputDir := filepath.Join(*r.ctx.OutputDir, "tmp", r.name)
 	tmpStateOutputFilePrefix := filepath.Join(tmpStateOutputDir, globalvar.DefaultTmpStateFile)
 
-	if err := os.RemoveAll(tmpStateOutputDir); err != nil {
+	// The tmp state files of the failed export hold the resources already imported when resuming it
+	importedAddresses := map[string]bool{}
+	if r.ctx.Resume {
+		if files, err := filepath.Glob(tmpStateOutputFilePrefix + "*"); err == nil {
+			importedAddresses = readStateAddresses(files...)
+		}
+	} else if err := os.RemoveAll(tmpStateOutputDir); err != nil {
 		utils.Logf("[WARN] unable to delete existing tmp state directory %s", tmpStateOutputDir)
 		return err
 	}
+	resourcesToImport := make([]*tf_export.OCIResource, 0, len(r.discoveredResources))
+	for _, resource := range r.discoveredResources {
+		if importedAddresses[resource.GetTerraformReference()] {
+			utils.Debugf("[DEBUG] resource '%s' was imported before resuming, skipping it", resource.GetTerraformReference())
+			continue
+		}
+		resourcesToImport = append(resourcesToImport, resource)
+	}
 
-	isAllDataSources := true
-	totalResources := len(r.discoveredResources)
+	isAllDataSources := len(importedAddresses) == 0
+	totalResources := len(resourcesToImport)
 	// divide list of discovered resources which is a slice into chunks
 	// process each chunk in parallel
 	chunkSize := ChunkSize // chunk size defines number of resources in each chunk.
@@ -237,7 +250,7 @@ func (r *resourceDiscoveryBaseStep) writeTmpState() error {
 			<-semImport
 			importWg.Done()
 			// take resources beginning at chunkIdx upto and excluding lastPos
-		}(r.discoveredResources[chunkIdx:lastPos], chunkIdx)
+		}(resourcesToImport[chunkIdx:lastPos], chunkIdx)
 	}
 	// wait for all chunks to finish importing resources
 	importWg.Wait()
@@ -298,6 +311,10 @@ func (r *resourceDiscoveryBaseStep) writeTmpConfigurationForImport() error {
 
 func (r *resourceDiscoveryBaseStep) writeConfiguration() error {
 	defer elapsed(fmt.Sprintf("writing actual configuration for %d %s resources", len(r.getDiscoveredResources()), r.name), nil, 0)()
+	if r.ctx.OutputLayout == tf_export.OutputLayoutModules {
+		return r.writeModulesConfiguration()
+	}
+
 	configOutputFile := fmt.Sprintf("%s%s%s.tf", *r.ctx.OutputDir, string(os.PathSeparator), r.name)
 	tmpConfigOutputFile := fmt.Sprintf("%s%s%s.tf.tmp", *r.ctx.OutputDir, string(os.PathSeparator), r.name)
 
@@ -313,15 +330,55 @@ func (r *resourceDiscoveryBaseStep) writeConfiguration() error {
 	builder := &strings.Builder{}
 	builder.WriteString("## This configuration was generated by terraform-provider-oci\n\n")
 
+	exportedResourceCount, err := r.writeResourcesHCL(builder, r.discoveredResources, tf_export.ReferenceMap)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	// Format the HCL config
+	formattedString, err := getFormattedHcl(configOutputFile, builder.String())
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	_, err = file.WriteString(string(formattedString))
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if fErr := file.Close(); fErr != nil {
+		return fErr
+	}
+
+	if err := os.Rename(tmpConfigOutputFile, configOutputFile); err != nil {
+		return err
+	}
+
+	if r.ctx.TargetSpecificResources {
+		r.ctx.SummaryStatements = append(r.ctx.SummaryStatements, fmt.Sprintf("Found %d resources. Generated under '%s'", exportedResourceCount, configOutputFile))
+	} else {
+		r.ctx.SummaryStatements = append(r.ctx.SummaryStatements, fmt.Sprintf("Found %d '%s' resources. Generated under '%s'", exportedResourceCount, r.name, configOutputFile))
+	}
+	r.ctx.SummaryStatements = append(r.ctx.SummaryStatements, fmt.Sprintf("Time taken for discovery: %v, generating state: %v", r.timeTakenForDiscovery, r.timeTakenForGeneratingState))
+	return nil
+}
+
+/*
+writeResourcesHCL writes the HCL of the given resources to the builder, skipping the resources for which import failed,
+and returns the number of resources written
+*/
+func (r *resourceDiscoveryBaseStep) writeResourcesHCL(builder *strings.Builder, resources []*tf_export.OCIResource, interpolationMap map[string]string) (int, error) {
 	exportedResourceCount := 0
-	for _, resource := range r.discoveredResources {
+	for _, resource := range resources {
 
 		// Skip writing the config for resources for which import command failed
 		if !resource.IsErrorResource {
 			utils.Logf("[INFO] ===> Generating resource '%s'", resource.GetTerraformReference())
-			if err := resource.GetHCLString(builder, tf_export.ReferenceMap); err != nil {
-				_ = file.Close()
-				return err
+			if err := resource.GetHCLString(builder, interpolationMap); err != nil {
+				return exportedResourceCount, err
 			}
 
 			if resource.TerraformTypeInfo != nil && len(resource.TerraformTypeInfo.IgnorableRequiredMissingAttributes) > 0 {
@@ -348,31 +405,7 @@ func (r *resourceDiscoveryBaseStep) writeConfiguration() error {
 			missingAttributesPerResourceLock.Unlock()
 		}
 	}
-
-	// Format the HCL config
-	formattedString := hclwrite.Format([]byte(builder.String()))
-
-	_, err = file.WriteString(string(formattedString))
-	if err != nil {
-		_ = file.Close()
-		return err
-	}
-
-	if fErr := file.Close(); fErr != nil {
-		return fErr
-	}
-
-	if err := os.Rename(tmpConfigOutputFile, configOutputFile); err != nil {
-		return err
-	}
-
-	if r.ctx.TargetSpecificResources {
-		r.ctx.SummaryStatements = append(r.ctx.SummaryStatements, fmt.Sprintf("Found %d resources. Generated under '%s'", exportedResourceCount, configOutputFile))
-	} else {
-		r.ctx.SummaryStatements = append(r.ctx.SummaryStatements, fmt.Sprintf("Found %d '%s' resources. Generated under '%s'", exportedResourceCount, r.name, configOutputFile))
-	}
-	r.ctx.SummaryStatements = append(r.ctx.SummaryStatements, fmt.Sprintf("Time taken for discovery: %v, generating state: %v", r.timeTakenForDiscovery, r.timeTakenForGeneratingState))
-	return nil
+	return exportedResourceCount, nil
 }
 
 func (r *resourceDiscoveryBaseStep) getOmittedResources() []*tf_export.OCIResource {
@@ -471,14 +504,14 @@ func (r *resourceDiscoveryWithTargetIds) discover() error {
 
 		utils.Logf("===> Finding resource with ID '%s' and type '%s'", resourceId, resourceClass)
 		resourceSchema, exists := tf_export.ResourcesMap[resourceClass]
-		if !exists || resourceSchema.Read == nil {
+		if !exists || (resourceSchema.Read == nil && resourceSchema.ReadContext == nil) {
 			utils.Logf("[WARN] No valid resource schema could be found. Skipping.")
 			continue
 		}
 
 		d := resourceSchema.Data(nil)
 		d.SetId(resourceId)
-		if err := resourceSchema.Read(d, r.ctx.Clients); err != nil {
+		if err := tfresource.ReadSchemaResource(resourceSchema, d, r.ctx.Clients); err != nil {
 			utils.Logf("[WARN] Unable to read resource due to error: %v", err)
 			continue
 		}
@@ -92,6 +92,8 @@ func TestUnitdiscover(t *testing.T) {
 		return resources, nil
 	}
 	defer func() { exportChildDefinition.ProcessDiscoveredResourcesFn = nil }()
+	generateTerraformNameFromResource := tf_export.GenerateTerraformNameFromResource
+	defer func() { tf_export.GenerateTerraformNameFromResource = generateTerraformNameFromResource }()
 
 	r := &resourceDiscoveryWithTargetIds{
 		resourceDiscoveryBaseStep: resourceDiscoveryBaseStep{
@@ -184,6 +186,8 @@ func TestUnitwriteConfiguration(t *testing.T) {
 		},
 		omittedResources: []*tf_export.OCIResource{},
 	}
+	getHclStringFromGenericMap := tf_export.GetHclStringFromGenericMap
+	defer func() { tf_export.GetHclStringFromGenericMap = getHclStringFromGenericMap }()
 	tests := []testFormat{
 		{
 			name:     "Test no error is returned",
@@ -0,0 +1,269 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
+
+	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
+	tf_export "github.com/oracle/terraform-provider-oci/internal/commonexport"
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+	utils "github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+// exportCompartment is a compartment whose resources are discovered
+type exportCompartment struct {
+	id   string
+	name string // unique name of the compartment, only set when more than one compartment is exported
+}
+
+var exportCompartmentNames map[string]string // names of the exported compartments, used to name their modules
+
+/*
+getRegionClients returns the clients of the regions to export other than the first one, by region.
+The clients of the first region are created last by the caller, so that the export configuration is the one of the
+first region.
+*/
+func getRegionClients(d *schema.ResourceData, regions []string) (map[string]*tf_client.OracleClients, error) {
+	result := map[string]*tf_client.OracleClients{}
+	if len(regions) == 0 {
+		return result, nil
+	}
+	for _, region := range regions[1:] {
+		if err := d.Set(globalvar.RegionAttrName, region); err != nil {
+			return nil, err
+		}
+		clients, err := getExportConfigVar(d)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] unable to create the clients of region %s: %s", region, err)
+		}
+		result[region] = clients.(*tf_client.OracleClients)
+	}
+	if err := d.Set(globalvar.RegionAttrName, regions[0]); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// getRegionVar returns the variable of a region to export other than the region of the provider configuration
+func getRegionVar(region string) string {
+	return fmt.Sprintf("region_%s", tf_export.GetRegionProviderAlias(region))
+}
+
+// getExportRegions returns the regions to export, the region of the provider configuration first as an empty region
+func getExportRegions(ctx *tf_export.ResourceDiscoveryContext) []string {
+	result := []string{""}
+	if len(ctx.Regions) > 1 {
+		result = append(result, ctx.Regions[1:]...)
+	}
+	return result
+}
+
+/*
+getRegionContext returns the context discovering the resources of a region with the clients of the region. The errors
+of the discovery are added to the context of the region, and are merged by mergeRegionContexts once the discovery of
+all the regions completed.
+*/
+func getRegionContext(ctx *tf_export.ResourceDiscoveryContext, regionContexts map[string]*tf_export.ResourceDiscoveryContext, region string) *tf_export.ResourceDiscoveryContext {
+	if region == "" {
+		return ctx
+	}
+	if regionCtx, exists := regionContexts[region]; exists {
+		return regionCtx
+	}
+	regionCtx := &tf_export.ResourceDiscoveryContext{
+		TerraformProviderBinaryPath: ctx.TerraformProviderBinaryPath,
+		Clients:                     ctx.GetRegionClients(region),
+		ExpectedResourceIds:         ctx.ExpectedResourceIds,
+		TenancyOcid:                 ctx.TenancyOcid,
+		DiscoveredResources:         []*tf_export.OCIResource{},
+		SummaryStatements:           []string{},
+		TargetSpecificResources:     ctx.TargetSpecificResources,
+		ResourceHintsLookup:         ctx.ResourceHintsLookup,
+		ExportCommandArgs:           ctx.ExportCommandArgs,
+		ErrorList: tf_export.ErrorList{
+			Errors: []*tf_export.ResourceDiscoveryError{},
+		},
+	}
+	regionContexts[region] = regionCtx
+	return regionCtx
+}
+
+/*
+mergeRegionContexts adds the discovery errors of the regions to the context of the export, and switches the steps
+to the context of the export for the state generation and the configuration
+*/
+func mergeRegionContexts(ctx *tf_export.ResourceDiscoveryContext, steps []resourceDiscoveryStep) {
+	merged := map[*tf_export.ResourceDiscoveryContext]bool{ctx: true}
+	for _, step := range steps {
+		baseStep := step.getBaseStep()
+		if !merged[baseStep.ctx] {
+			merged[baseStep.ctx] = true
+			for _, rdError := range baseStep.ctx.ErrorList.Errors {
+				ctx.AddErrorToList(rdError)
+			}
+		}
+		baseStep.ctx = ctx
+	}
+}
+
+/*
+getExportCompartments returns the compartments to export: the compartment_ids or the compartment of the export, and
+their sub-compartments up to the compartment_depth. The compartments are named when more than one is exported, to
+name the steps discovering their resources.
+*/
+func getExportCompartments(ctx *tf_export.ResourceDiscoveryContext) ([]*exportCompartment, error) {
+	exportCompartmentNames = map[string]string{}
+	compartmentIds := ctx.CompartmentIds
+	if len(compartmentIds) == 0 {
+		compartmentIds = []string{*ctx.CompartmentId}
+	}
+
+	var result []*exportCompartment
+	exists := map[string]bool{}
+	for _, compartmentId := range compartmentIds {
+		if !exists[compartmentId] {
+			exists[compartmentId] = true
+			result = append(result, &exportCompartment{id: compartmentId})
+		}
+	}
+
+	// Visit the sub-compartments level by level, the identity service only lists the whole subtree of the tenancy
+	parents := result
+	for depth := 0; depth < ctx.CompartmentDepth && len(parents) > 0; depth++ {
+		var children []*exportCompartment
+		for _, parent := range parents {
+			subCompartments, err := listSubCompartments(ctx, parent.id)
+			if err != nil {
+				return nil, err
+			}
+			for _, subCompartment := range subCompartments {
+				if subCompartment.Id == nil || exists[*subCompartment.Id] {
+					continue
+				}
+				exists[*subCompartment.Id] = true
+				child := &exportCompartment{id: *subCompartment.Id}
+				if subCompartment.Name != nil {
+					exportCompartmentNames[child.id] = *subCompartment.Name
+				}
+				children = append(children, child)
+			}
+		}
+		result = append(result, children...)
+		parents = children
+	}
+
+	if len(result) == 1 {
+		return result, nil
+	}
+	utils.Logf("[INFO] exporting the resources of %d compartments", len(result))
+
+	names := map[string]bool{}
+	for _, compartment := range result {
+		if _, known := exportCompartmentNames[compartment.id]; !known {
+			response, err := identityClientGetCompartmentVar(ctx.Clients, oci_identity.GetCompartmentRequest{
+				CompartmentId: &compartment.id,
+				RequestMetadata: oci_common.RequestMetadata{
+					RetryPolicy: tfresource.GetRetryPolicy(true, "identity"),
+				},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("[ERROR] could not get compartment %s: %s", compartment.id, err)
+			}
+			if response.Name != nil {
+				exportCompartmentNames[compartment.id] = *response.Name
+			}
+		}
+		name := getCompartmentIdentifier(compartment.id, exportCompartmentNames[compartment.id])
+		compartment.name = name
+		for i := 1; names[compartment.name]; i++ {
+			compartment.name = fmt.Sprintf("%s_%d", name, i)
+		}
+		names[compartment.name] = true
+	}
+	return result, nil
+}
+
+func listSubCompartments(ctx *tf_export.ResourceDiscoveryContext, compartmentId string) ([]oci_identity.Compartment, error) {
+	var result []oci_identity.Compartment
+	req := oci_identity.ListCompartmentsRequest{
+		CompartmentId:  &compartmentId,
+		LifecycleState: oci_identity.CompartmentLifecycleStateActive,
+		RequestMetadata: oci_common.RequestMetadata{
+			RetryPolicy: tfresource.GetRetryPolicy(true, "identity"),
+		},
+	}
+	for {
+		resp, err := identityClientListCompartmentsVar(ctx.Clients, req)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] could not list the sub-compartments of compartment %s: %s", compartmentId, err)
+		}
+		result = append(result, resp.Items...)
+		if resp.OpcNextPage == nil {
+			break
+		}
+		req.Page = resp.OpcNextPage
+	}
+	return result, nil
+}
+
+/*
+getCompartmentIdentifier returns an identifier for a compartment to name the Terraform objects generated for it, from
+its name when it is known or from its OCID otherwise
+*/
+func getCompartmentIdentifier(compartmentId string, name string) string {
+	if name == "" {
+		name = compartmentId[strings.LastIndex(compartmentId, ".")+1:]
+		if len(name) > 8 {
+			name = name[len(name)-8:]
+		}
+		name = "compartment_" + name
+	}
+	name = moduleNameRegex.ReplaceAllString(name, "_")
+	if name == "" || !(name[0] == '_' || (name[0] >= 'a' && name[0] <= 'z') || (name[0] >= 'A' && name[0] <= 'Z')) {
+		name = "compartment_" + name
+	}
+	return name
+}
+
+/*
+getStepName returns the name of the step discovering the resources of a service, prefixed with the region and the
+compartment when more than one of them is exported, so that each step writes its own configuration file
+*/
+func getStepName(ctx *tf_export.ResourceDiscoveryContext, region string, compartment *exportCompartment, service string) string {
+	var parts []string
+	if len(ctx.Regions) > 1 {
+		if region == "" {
+			region = ctx.Regions[0]
+		}
+		parts = append(parts, tf_export.GetRegionProviderAlias(region))
+	}
+	if compartment.name != "" {
+		parts = append(parts, compartment.name)
+	}
+	return strings.Join(append(parts, service), "_")
+}
+
+// getResourceRegions returns the sorted regions of the resources bound to a provider alias
+func getResourceRegions(resources []*tf_export.OCIResource) []string {
+	regions := map[string]bool{}
+	for _, resource := range resources {
+		if resource.Region != "" {
+			regions[resource.Region] = true
+		}
+	}
+	result := make([]string, 0, len(regions))
+	for region := range regions {
+		result = append(result, region)
+	}
+	sort.Strings(result)
+	return result
+}
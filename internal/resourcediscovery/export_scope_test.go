@@ -0,0 +1,161 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
+	tf_export "github.com/oracle/terraform-provider-oci/internal/commonexport"
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+// Test that RunExportCommand with regions binds the resources of the other regions to a provider alias
+// issue-routing-tag: terraform/default
+func TestUnitRunExportCommand_regions(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	compartmentId := resourceDiscoveryTestCompartmentOcid
+	workingDir, _ := os.Getwd()
+	outputDir := fmt.Sprintf("%s%sdiscoveryTest-%d", workingDir, string(os.PathSeparator), time.Now().Nanosecond())
+	if err := os.Mkdir(outputDir, os.ModePerm); err != nil {
+		t.Fatalf("unable to mkdir %s. err: %v", outputDir, err)
+	}
+	defer os.RemoveAll(outputDir)
+
+	tf_export.TfHclVersionvar = &tf_export.TfHclVersion12{Value: tf_export.TfVersion12}
+	args := &tf_export.ExportCommandArgs{
+		CompartmentId: &compartmentId,
+		Services:      []string{"compartment_testing"},
+		OutputDir:     &outputDir,
+		GenerateState: true,
+		TFVersion:     &tf_export.TfHclVersionvar,
+		Parallelism:   1,
+		ImportMode:    tf_export.ImportModeNative,
+		Regions:       []string{"us-ashburn-1", "us-phoenix-1"},
+	}
+	getEnvSettingWithBlankDefaultVar = func(varName string) string {
+		return resourceDiscoveryTestTenancyOcid
+	}
+	var clientRegions []string
+	getExportConfigVar = func(d *schema.ResourceData) (interface{}, error) {
+		clientRegions = append(clientRegions, d.Get(globalvar.RegionAttrName).(string))
+		return getTestClients(), nil
+	}
+	exportConfigProvider = acctest.MockConfigurationProvider{}
+	if err, status := RunExportCommand(args); err != nil || status != StatusSuccess {
+		t.Fatalf("export command failed with status %v due to err: %v", status, err)
+	}
+	// The clients of the first region are the last ones created
+	assert.Equal(t, []string{"us-phoenix-1", "us-ashburn-1"}, clientRegions)
+
+	provider, err := ioutil.ReadFile(filepath.Join(outputDir, globalvar.ProviderFile))
+	if err != nil {
+		t.Fatalf("no provider file generated: %v", err)
+	}
+	assert.Contains(t, string(provider), "alias  = \"us_phoenix_1\"")
+	assert.Contains(t, string(provider), "region = var.region_us_phoenix_1")
+	assert.Equal(t, "\"us-phoenix-1\"", tf_export.Vars["region_us_phoenix_1"])
+
+	ashburnConfig, err := ioutil.ReadFile(filepath.Join(outputDir, "us_ashburn_1_compartment_testing.tf"))
+	if err != nil {
+		t.Fatalf("no configuration generated for the first region: %v", err)
+	}
+	assert.NotContains(t, string(ashburnConfig), "provider =")
+	phoenixConfig, err := ioutil.ReadFile(filepath.Join(outputDir, "us_phoenix_1_compartment_testing.tf"))
+	if err != nil {
+		t.Fatalf("no configuration generated for the other region: %v", err)
+	}
+	assert.Equal(t, len(parentResources)+len(childrenResources), strings.Count(string(phoenixConfig), "provider = oci.us_phoenix_1"))
+
+	data, err := ioutil.ReadFile(filepath.Join(outputDir, globalvar.DefaultStateFilename))
+	if err != nil {
+		t.Fatalf("no %s file generated: %v", globalvar.DefaultStateFilename, err)
+	}
+	var state nativeState
+	if err := json.Unmarshal(data, &state); err != nil {
+		t.Fatalf("invalid state file: %v", err)
+	}
+	providers := map[string]int{}
+	for _, resource := range state.Resources {
+		providers[resource.Provider]++
+	}
+	assert.Equal(t, map[string]int{
+		getNativeStateProvider():                   len(parentResources) + len(childrenResources),
+		getNativeStateProvider() + ".us_phoenix_1": len(parentResources) + len(childrenResources),
+	}, providers)
+}
+
+// Test that RunExportCommand with compartment_ids and compartment_depth writes the resources of each compartment to its own files
+// issue-routing-tag: terraform/default
+func TestUnitRunExportCommand_compartmentIds(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	workingDir, _ := os.Getwd()
+	outputDir := fmt.Sprintf("%s%sdiscoveryTest-%d", workingDir, string(os.PathSeparator), time.Now().Nanosecond())
+	if err := os.Mkdir(outputDir, os.ModePerm); err != nil {
+		t.Fatalf("unable to mkdir %s. err: %v", outputDir, err)
+	}
+	defer os.RemoveAll(outputDir)
+
+	listCompartments, getCompartment := identityClientListCompartmentsVar, identityClientGetCompartmentVar
+	defer func() {
+		identityClientListCompartmentsVar, identityClientGetCompartmentVar = listCompartments, getCompartment
+	}()
+	compartmentNames := map[string]string{"ocid1.compartment.dev": "dev", "ocid1.compartment.prod": "prod"}
+	identityClientGetCompartmentVar = func(clients *tf_client.OracleClients, req oci_identity.GetCompartmentRequest) (oci_identity.GetCompartmentResponse, error) {
+		name := compartmentNames[*req.CompartmentId]
+		return oci_identity.GetCompartmentResponse{Compartment: oci_identity.Compartment{Id: req.CompartmentId, Name: &name}}, nil
+	}
+	identityClientListCompartmentsVar = func(clients *tf_client.OracleClients, req oci_identity.ListCompartmentsRequest) (oci_identity.ListCompartmentsResponse, error) {
+		response := oci_identity.ListCompartmentsResponse{}
+		if *req.CompartmentId == "ocid1.compartment.prod" {
+			id, name := "ocid1.compartment.prod-db", "prod db"
+			response.Items = []oci_identity.Compartment{{Id: &id, Name: &name}}
+		}
+		return response, nil
+	}
+
+	compartmentId := ""
+	tf_export.TfHclVersionvar = &tf_export.TfHclVersion12{Value: tf_export.TfVersion12}
+	args := &tf_export.ExportCommandArgs{
+		CompartmentId:    &compartmentId,
+		CompartmentIds:   []string{"ocid1.compartment.dev", "ocid1.compartment.prod"},
+		CompartmentDepth: 1,
+		Services:         []string{"compartment_testing"},
+		OutputDir:        &outputDir,
+		TFVersion:        &tf_export.TfHclVersionvar,
+		Parallelism:      1,
+	}
+	getEnvSettingWithBlankDefaultVar = func(varName string) string {
+		return resourceDiscoveryTestTenancyOcid
+	}
+	getExportConfigVar = func(d *schema.ResourceData) (interface{}, error) {
+		return getTestClients(), nil
+	}
+	exportConfigProvider = acctest.MockConfigurationProvider{}
+	if err, status := RunExportCommand(args); err != nil || status != StatusSuccess {
+		t.Fatalf("export command failed with status %v due to err: %v", status, err)
+	}
+
+	for _, name := range []string{"dev", "prod", "prod_db"} {
+		_, err := os.Stat(filepath.Join(outputDir, fmt.Sprintf("%s_compartment_testing.tf", name)))
+		assert.NoError(t, err, "no configuration generated for compartment %s", name)
+	}
+	assert.Equal(t, "\"ocid1.compartment.dev\"", tf_export.Vars["compartment_ocid"])
+	assert.Equal(t, "\"ocid1.compartment.prod\"", tf_export.Vars["compartment_ocid_prod"])
+	assert.Equal(t, "\"ocid1.compartment.prod-db\"", tf_export.Vars["compartment_ocid_prod_db"])
+}
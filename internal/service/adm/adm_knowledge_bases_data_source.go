@@ -6,6 +6,7 @@ package adm
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_adm "github.com/oracle/oci-go-sdk/v65/adm"
 
@@ -15,7 +16,7 @@ import (
 
 func AdmKnowledgeBasesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readAdmKnowledgeBases,
+		ReadContext: readAdmKnowledgeBases,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -52,12 +53,12 @@ func AdmKnowledgeBasesDataSource() *schema.Resource {
 	}
 }
 
-func readAdmKnowledgeBases(d *schema.ResourceData, m interface{}) error {
+func readAdmKnowledgeBases(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AdmKnowledgeBasesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApplicationDependencyManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type AdmKnowledgeBasesDataSourceCrud struct {
@@ -70,7 +71,7 @@ func (s *AdmKnowledgeBasesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *AdmKnowledgeBasesDataSourceCrud) Get() error {
+func (s *AdmKnowledgeBasesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_adm.ListKnowledgeBasesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -94,7 +95,7 @@ func (s *AdmKnowledgeBasesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "adm")
 
-	response, err := s.Client.ListKnowledgeBases(context.Background(), request)
+	response, err := s.Client.ListKnowledgeBases(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *AdmKnowledgeBasesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListKnowledgeBases(context.Background(), request)
+		listResponse, err := s.Client.ListKnowledgeBases(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package adm
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_adm "github.com/oracle/oci-go-sdk/v65/adm"
 
@@ -15,7 +16,7 @@ import (
 
 func AdmVulnerabilityAuditApplicationDependencyVulnerabilitiesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readAdmVulnerabilityAuditApplicationDependencyVulnerabilities,
+		ReadContext: readAdmVulnerabilityAuditApplicationDependencyVulnerabilities,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"cvss_v2greater_than_or_equal": {
@@ -124,12 +125,12 @@ func AdmVulnerabilityAuditApplicationDependencyVulnerabilitiesDataSource() *sche
 	}
 }
 
-func readAdmVulnerabilityAuditApplicationDependencyVulnerabilities(d *schema.ResourceData, m interface{}) error {
+func readAdmVulnerabilityAuditApplicationDependencyVulnerabilities(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AdmVulnerabilityAuditApplicationDependencyVulnerabilitiesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApplicationDependencyManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type AdmVulnerabilityAuditApplicationDependencyVulnerabilitiesDataSourceCrud struct {
@@ -142,7 +143,7 @@ func (s *AdmVulnerabilityAuditApplicationDependencyVulnerabilitiesDataSourceCrud
 	s.D.SetId("")
 }
 
-func (s *AdmVulnerabilityAuditApplicationDependencyVulnerabilitiesDataSourceCrud) Get() error {
+func (s *AdmVulnerabilityAuditApplicationDependencyVulnerabilitiesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_adm.ListApplicationDependencyVulnerabilitiesRequest{}
 
 	if cvssV2GreaterThanOrEqual, ok := s.D.GetOkExists("cvss_v2greater_than_or_equal"); ok {
@@ -172,7 +173,7 @@ func (s *AdmVulnerabilityAuditApplicationDependencyVulnerabilitiesDataSourceCrud
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "adm")
 
-	response, err := s.Client.ListApplicationDependencyVulnerabilities(context.Background(), request)
+	response, err := s.Client.ListApplicationDependencyVulnerabilities(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -181,7 +182,7 @@ func (s *AdmVulnerabilityAuditApplicationDependencyVulnerabilitiesDataSourceCrud
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListApplicationDependencyVulnerabilities(context.Background(), request)
+		listResponse, err := s.Client.ListApplicationDependencyVulnerabilities(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package adm
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_adm "github.com/oracle/oci-go-sdk/v65/adm"
 
@@ -15,7 +16,7 @@ import (
 
 func AdmVulnerabilityAuditApplicationDependencyVulnerabilityDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularAdmVulnerabilityAuditApplicationDependencyVulnerability,
+		ReadContext: readSingularAdmVulnerabilityAuditApplicationDependencyVulnerability,
 		Schema: map[string]*schema.Schema{
 			"cvss_v2greater_than_or_equal": {
 				Type:     schema.TypeFloat,
@@ -103,12 +104,12 @@ func AdmVulnerabilityAuditApplicationDependencyVulnerabilityDataSource() *schema
 	}
 }
 
-func readSingularAdmVulnerabilityAuditApplicationDependencyVulnerability(d *schema.ResourceData, m interface{}) error {
+func readSingularAdmVulnerabilityAuditApplicationDependencyVulnerability(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AdmVulnerabilityAuditApplicationDependencyVulnerabilityDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApplicationDependencyManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type AdmVulnerabilityAuditApplicationDependencyVulnerabilityDataSourceCrud struct {
@@ -121,7 +122,7 @@ func (s *AdmVulnerabilityAuditApplicationDependencyVulnerabilityDataSourceCrud)
 	s.D.SetId("")
 }
 
-func (s *AdmVulnerabilityAuditApplicationDependencyVulnerabilityDataSourceCrud) Get() error {
+func (s *AdmVulnerabilityAuditApplicationDependencyVulnerabilityDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_adm.ListApplicationDependencyVulnerabilitiesRequest{}
 
 	if cvssV2GreaterThanOrEqual, ok := s.D.GetOkExists("cvss_v2greater_than_or_equal"); ok {
@@ -151,7 +152,7 @@ func (s *AdmVulnerabilityAuditApplicationDependencyVulnerabilityDataSourceCrud)
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "adm")
 
-	response, err := s.Client.ListApplicationDependencyVulnerabilities(context.Background(), request)
+	response, err := s.Client.ListApplicationDependencyVulnerabilities(ctx, request)
 	if err != nil {
 		return err
 	}
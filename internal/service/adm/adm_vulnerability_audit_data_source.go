@@ -6,6 +6,7 @@ package adm
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_adm "github.com/oracle/oci-go-sdk/v65/adm"
 
@@ -22,12 +23,12 @@ func AdmVulnerabilityAuditDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(AdmVulnerabilityAuditResource(), fieldMap, readSingularAdmVulnerabilityAudit)
 }
 
-func readSingularAdmVulnerabilityAudit(d *schema.ResourceData, m interface{}) error {
+func readSingularAdmVulnerabilityAudit(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AdmVulnerabilityAuditDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApplicationDependencyManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type AdmVulnerabilityAuditDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *AdmVulnerabilityAuditDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *AdmVulnerabilityAuditDataSourceCrud) Get() error {
+func (s *AdmVulnerabilityAuditDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_adm.GetVulnerabilityAuditRequest{}
 
 	if vulnerabilityAuditId, ok := s.D.GetOkExists("vulnerability_audit_id"); ok {
@@ -50,7 +51,7 @@ func (s *AdmVulnerabilityAuditDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "adm")
 
-	response, err := s.Client.GetVulnerabilityAudit(context.Background(), request)
+	response, err := s.Client.GetVulnerabilityAudit(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_adm "github.com/oracle/oci-go-sdk/v65/adm"
@@ -20,11 +21,11 @@ func AdmVulnerabilityAuditResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createAdmVulnerabilityAudit,
-		Read:     readAdmVulnerabilityAudit,
-		Update:   updateAdmVulnerabilityAudit,
-		Delete:   deleteAdmVulnerabilityAudit,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createAdmVulnerabilityAudit,
+		ReadContext:   readAdmVulnerabilityAudit,
+		UpdateContext: updateAdmVulnerabilityAudit,
+		DeleteContext: deleteAdmVulnerabilityAudit,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"application_dependencies": {
@@ -199,37 +200,37 @@ func AdmVulnerabilityAuditResource() *schema.Resource {
 	}
 }
 
-func createAdmVulnerabilityAudit(d *schema.ResourceData, m interface{}) error {
+func createAdmVulnerabilityAudit(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AdmVulnerabilityAuditResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApplicationDependencyManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readAdmVulnerabilityAudit(d *schema.ResourceData, m interface{}) error {
+func readAdmVulnerabilityAudit(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AdmVulnerabilityAuditResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApplicationDependencyManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateAdmVulnerabilityAudit(d *schema.ResourceData, m interface{}) error {
+func updateAdmVulnerabilityAudit(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AdmVulnerabilityAuditResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApplicationDependencyManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteAdmVulnerabilityAudit(d *schema.ResourceData, m interface{}) error {
+func deleteAdmVulnerabilityAudit(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AdmVulnerabilityAuditResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApplicationDependencyManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type AdmVulnerabilityAuditResourceCrud struct {
@@ -267,7 +268,7 @@ func (s *AdmVulnerabilityAuditResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *AdmVulnerabilityAuditResourceCrud) Create() error {
+func (s *AdmVulnerabilityAuditResourceCrud) Create(ctx context.Context) error {
 	request := oci_adm.CreateVulnerabilityAuditRequest{}
 
 	if applicationDependencies, ok := s.D.GetOkExists("application_dependencies"); ok {
@@ -331,7 +332,7 @@ func (s *AdmVulnerabilityAuditResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "adm")
 
-	response, err := s.Client.CreateVulnerabilityAudit(context.Background(), request)
+	response, err := s.Client.CreateVulnerabilityAudit(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -340,7 +341,7 @@ func (s *AdmVulnerabilityAuditResourceCrud) Create() error {
 	return nil
 }
 
-func (s *AdmVulnerabilityAuditResourceCrud) Get() error {
+func (s *AdmVulnerabilityAuditResourceCrud) Get(ctx context.Context) error {
 	request := oci_adm.GetVulnerabilityAuditRequest{}
 
 	tmp := s.D.Id()
@@ -348,7 +349,7 @@ func (s *AdmVulnerabilityAuditResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "adm")
 
-	response, err := s.Client.GetVulnerabilityAudit(context.Background(), request)
+	response, err := s.Client.GetVulnerabilityAudit(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -357,11 +358,11 @@ func (s *AdmVulnerabilityAuditResourceCrud) Get() error {
 	return nil
 }
 
-func (s *AdmVulnerabilityAuditResourceCrud) Update() error {
+func (s *AdmVulnerabilityAuditResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -391,7 +392,7 @@ func (s *AdmVulnerabilityAuditResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "adm")
 
-	response, err := s.Client.UpdateVulnerabilityAudit(context.Background(), request)
+	response, err := s.Client.UpdateVulnerabilityAudit(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -400,7 +401,7 @@ func (s *AdmVulnerabilityAuditResourceCrud) Update() error {
 	return nil
 }
 
-func (s *AdmVulnerabilityAuditResourceCrud) Delete() error {
+func (s *AdmVulnerabilityAuditResourceCrud) Delete(ctx context.Context) error {
 	request := oci_adm.DeleteVulnerabilityAuditRequest{}
 
 	tmp := s.D.Id()
@@ -408,7 +409,7 @@ func (s *AdmVulnerabilityAuditResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "adm")
 
-	_, err := s.Client.DeleteVulnerabilityAudit(context.Background(), request)
+	_, err := s.Client.DeleteVulnerabilityAudit(ctx, request)
 	return err
 }
 
@@ -650,7 +651,7 @@ func VulnerabilityAuditSummaryToMap(obj oci_adm.VulnerabilityAuditSummary) map[s
 	return result
 }
 
-func (s *AdmVulnerabilityAuditResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *AdmVulnerabilityAuditResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_adm.ChangeVulnerabilityAuditCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -661,12 +662,12 @@ func (s *AdmVulnerabilityAuditResourceCrud) updateCompartment(compartment interf
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "adm")
 
-	_, err := s.Client.ChangeVulnerabilityAuditCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeVulnerabilityAuditCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package adm
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_adm "github.com/oracle/oci-go-sdk/v65/adm"
 
@@ -15,7 +16,7 @@ import (
 
 func AdmVulnerabilityAuditsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readAdmVulnerabilityAudits,
+		ReadContext: readAdmVulnerabilityAudits,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -60,12 +61,12 @@ func AdmVulnerabilityAuditsDataSource() *schema.Resource {
 	}
 }
 
-func readAdmVulnerabilityAudits(d *schema.ResourceData, m interface{}) error {
+func readAdmVulnerabilityAudits(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AdmVulnerabilityAuditsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApplicationDependencyManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type AdmVulnerabilityAuditsDataSourceCrud struct {
@@ -78,7 +79,7 @@ func (s *AdmVulnerabilityAuditsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *AdmVulnerabilityAuditsDataSourceCrud) Get() error {
+func (s *AdmVulnerabilityAuditsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_adm.ListVulnerabilityAuditsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -112,7 +113,7 @@ func (s *AdmVulnerabilityAuditsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "adm")
 
-	response, err := s.Client.ListVulnerabilityAudits(context.Background(), request)
+	response, err := s.Client.ListVulnerabilityAudits(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -121,7 +122,7 @@ func (s *AdmVulnerabilityAuditsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVulnerabilityAudits(context.Background(), request)
+		listResponse, err := s.Client.ListVulnerabilityAudits(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ai_anomaly_detection "github.com/oracle/oci-go-sdk/v65/aianomalydetection"
 )
@@ -22,12 +23,12 @@ func AiAnomalyDetectionAiPrivateEndpointDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(AiAnomalyDetectionAiPrivateEndpointResource(), fieldMap, readSingularAiAnomalyDetectionAiPrivateEndpoint)
 }
 
-func readSingularAiAnomalyDetectionAiPrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func readSingularAiAnomalyDetectionAiPrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionAiPrivateEndpointDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type AiAnomalyDetectionAiPrivateEndpointDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *AiAnomalyDetectionAiPrivateEndpointDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *AiAnomalyDetectionAiPrivateEndpointDataSourceCrud) Get() error {
+func (s *AiAnomalyDetectionAiPrivateEndpointDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_ai_anomaly_detection.GetAiPrivateEndpointRequest{}
 
 	if aiPrivateEndpointId, ok := s.D.GetOkExists("ai_private_endpoint_id"); ok {
@@ -50,7 +51,7 @@ func (s *AiAnomalyDetectionAiPrivateEndpointDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ai_anomaly_detection")
 
-	response, err := s.Client.GetAiPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.GetAiPrivateEndpoint(ctx, request)
 	if err != nil {
 		return err
 	}
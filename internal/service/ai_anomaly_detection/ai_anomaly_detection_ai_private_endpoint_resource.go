@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -25,11 +26,11 @@ func AiAnomalyDetectionAiPrivateEndpointResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createAiAnomalyDetectionAiPrivateEndpoint,
-		Read:     readAiAnomalyDetectionAiPrivateEndpoint,
-		Update:   updateAiAnomalyDetectionAiPrivateEndpoint,
-		Delete:   deleteAiAnomalyDetectionAiPrivateEndpoint,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createAiAnomalyDetectionAiPrivateEndpoint,
+		ReadContext:   readAiAnomalyDetectionAiPrivateEndpoint,
+		UpdateContext: updateAiAnomalyDetectionAiPrivateEndpoint,
+		DeleteContext: deleteAiAnomalyDetectionAiPrivateEndpoint,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -102,37 +103,37 @@ func AiAnomalyDetectionAiPrivateEndpointResource() *schema.Resource {
 	}
 }
 
-func createAiAnomalyDetectionAiPrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func createAiAnomalyDetectionAiPrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionAiPrivateEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readAiAnomalyDetectionAiPrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func readAiAnomalyDetectionAiPrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionAiPrivateEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateAiAnomalyDetectionAiPrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func updateAiAnomalyDetectionAiPrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionAiPrivateEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteAiAnomalyDetectionAiPrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func deleteAiAnomalyDetectionAiPrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionAiPrivateEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type AiAnomalyDetectionAiPrivateEndpointResourceCrud struct {
@@ -170,7 +171,7 @@ func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) DeletedTarget() []stri
 	}
 }
 
-func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) Create() error {
+func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) Create(ctx context.Context) error {
 	request := oci_ai_anomaly_detection.CreateAiPrivateEndpointRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -215,26 +216,26 @@ func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.CreateAiPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.CreateAiPrivateEndpoint(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getAiPrivateEndpointFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection"), oci_ai_anomaly_detection.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getAiPrivateEndpointFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection"), oci_ai_anomaly_detection.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) getAiPrivateEndpointFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) getAiPrivateEndpointFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_ai_anomaly_detection.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	aiPrivateEndpointId, err := aiPrivateEndpointWaitForWorkRequest(workId, "aiprivateendpoint",
+	aiPrivateEndpointId, err := aiPrivateEndpointWaitForWorkRequest(ctx, workId, "aiprivateendpoint",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, aiPrivateEndpointId)
-		_, cancelErr := s.Client.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.Client.CancelWorkRequest(ctx,
 			oci_ai_anomaly_detection.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -248,7 +249,7 @@ func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) getAiPrivateEndpointFr
 	}
 	s.D.SetId(*aiPrivateEndpointId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func aiPrivateEndpointWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -274,7 +275,7 @@ func aiPrivateEndpointWorkRequestShouldRetryFunc(timeout time.Duration) func(res
 	}
 }
 
-func aiPrivateEndpointWaitForWorkRequest(wId *string, entityType string, action oci_ai_anomaly_detection.ActionTypeEnum,
+func aiPrivateEndpointWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_ai_anomaly_detection.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_ai_anomaly_detection.AnomalyDetectionClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "ai_anomaly_detection")
 	retryPolicy.ShouldRetryOperation = aiPrivateEndpointWorkRequestShouldRetryFunc(timeout)
@@ -293,7 +294,7 @@ func aiPrivateEndpointWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_ai_anomaly_detection.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -322,14 +323,14 @@ func aiPrivateEndpointWaitForWorkRequest(wId *string, entityType string, action
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_ai_anomaly_detection.OperationStatusFailed || response.Status == oci_ai_anomaly_detection.OperationStatusCanceled {
-		return nil, getErrorFromAiAnomalyDetectionAiPrivateEndpointWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromAiAnomalyDetectionAiPrivateEndpointWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromAiAnomalyDetectionAiPrivateEndpointWorkRequest(client *oci_ai_anomaly_detection.AnomalyDetectionClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_ai_anomaly_detection.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromAiAnomalyDetectionAiPrivateEndpointWorkRequest(ctx context.Context, client *oci_ai_anomaly_detection.AnomalyDetectionClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_ai_anomaly_detection.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_ai_anomaly_detection.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -351,7 +352,7 @@ func getErrorFromAiAnomalyDetectionAiPrivateEndpointWorkRequest(client *oci_ai_a
 	return workRequestErr
 }
 
-func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) Get() error {
+func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) Get(ctx context.Context) error {
 	request := oci_ai_anomaly_detection.GetAiPrivateEndpointRequest{}
 
 	tmp := s.D.Id()
@@ -359,7 +360,7 @@ func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.GetAiPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.GetAiPrivateEndpoint(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -368,11 +369,11 @@ func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) Get() error {
 	return nil
 }
 
-func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) Update() error {
+func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -415,16 +416,16 @@ func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.UpdateAiPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.UpdateAiPrivateEndpoint(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getAiPrivateEndpointFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection"), oci_ai_anomaly_detection.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getAiPrivateEndpointFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection"), oci_ai_anomaly_detection.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) Delete() error {
+func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) Delete(ctx context.Context) error {
 	request := oci_ai_anomaly_detection.DeleteAiPrivateEndpointRequest{}
 
 	tmp := s.D.Id()
@@ -432,14 +433,14 @@ func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.DeleteAiPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.DeleteAiPrivateEndpoint(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := aiPrivateEndpointWaitForWorkRequest(workId, "aiprivateendpoint",
+	_, delWorkRequestErr := aiPrivateEndpointWaitForWorkRequest(ctx, workId, "aiprivateendpoint",
 		oci_ai_anomaly_detection.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -536,7 +537,7 @@ func AiPrivateEndpointSummaryToMap(obj oci_ai_anomaly_detection.AiPrivateEndpoin
 	return result
 }
 
-func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_ai_anomaly_detection.ChangeAiPrivateEndpointCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -547,11 +548,11 @@ func (s *AiAnomalyDetectionAiPrivateEndpointResourceCrud) updateCompartment(comp
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.ChangeAiPrivateEndpointCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeAiPrivateEndpointCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getAiPrivateEndpointFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection"), oci_ai_anomaly_detection.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getAiPrivateEndpointFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection"), oci_ai_anomaly_detection.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
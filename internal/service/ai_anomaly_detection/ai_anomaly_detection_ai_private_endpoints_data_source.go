@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ai_anomaly_detection "github.com/oracle/oci-go-sdk/v65/aianomalydetection"
 )
 
 func AiAnomalyDetectionAiPrivateEndpointsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readAiAnomalyDetectionAiPrivateEndpoints,
+		ReadContext: readAiAnomalyDetectionAiPrivateEndpoints,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -52,12 +53,12 @@ func AiAnomalyDetectionAiPrivateEndpointsDataSource() *schema.Resource {
 	}
 }
 
-func readAiAnomalyDetectionAiPrivateEndpoints(d *schema.ResourceData, m interface{}) error {
+func readAiAnomalyDetectionAiPrivateEndpoints(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionAiPrivateEndpointsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type AiAnomalyDetectionAiPrivateEndpointsDataSourceCrud struct {
@@ -70,7 +71,7 @@ func (s *AiAnomalyDetectionAiPrivateEndpointsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *AiAnomalyDetectionAiPrivateEndpointsDataSourceCrud) Get() error {
+func (s *AiAnomalyDetectionAiPrivateEndpointsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_ai_anomaly_detection.ListAiPrivateEndpointsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -94,7 +95,7 @@ func (s *AiAnomalyDetectionAiPrivateEndpointsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ai_anomaly_detection")
 
-	response, err := s.Client.ListAiPrivateEndpoints(context.Background(), request)
+	response, err := s.Client.ListAiPrivateEndpoints(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *AiAnomalyDetectionAiPrivateEndpointsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAiPrivateEndpoints(context.Background(), request)
+		listResponse, err := s.Client.ListAiPrivateEndpoints(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ai_anomaly_detection "github.com/oracle/oci-go-sdk/v65/aianomalydetection"
 )
@@ -22,12 +23,12 @@ func AiAnomalyDetectionDataAssetDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(AiAnomalyDetectionDataAssetResource(), fieldMap, readSingularAiAnomalyDetectionDataAsset)
 }
 
-func readSingularAiAnomalyDetectionDataAsset(d *schema.ResourceData, m interface{}) error {
+func readSingularAiAnomalyDetectionDataAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionDataAssetDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type AiAnomalyDetectionDataAssetDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *AiAnomalyDetectionDataAssetDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *AiAnomalyDetectionDataAssetDataSourceCrud) Get() error {
+func (s *AiAnomalyDetectionDataAssetDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_ai_anomaly_detection.GetDataAssetRequest{}
 
 	if dataAssetId, ok := s.D.GetOkExists("data_asset_id"); ok {
@@ -50,7 +51,7 @@ func (s *AiAnomalyDetectionDataAssetDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ai_anomaly_detection")
 
-	response, err := s.Client.GetDataAsset(context.Background(), request)
+	response, err := s.Client.GetDataAsset(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -23,11 +24,11 @@ func AiAnomalyDetectionDataAssetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createAiAnomalyDetectionDataAsset,
-		Read:     readAiAnomalyDetectionDataAsset,
-		Update:   updateAiAnomalyDetectionDataAsset,
-		Delete:   deleteAiAnomalyDetectionDataAsset,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createAiAnomalyDetectionDataAsset,
+		ReadContext:   readAiAnomalyDetectionDataAsset,
+		UpdateContext: updateAiAnomalyDetectionDataAsset,
+		DeleteContext: deleteAiAnomalyDetectionDataAsset,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -282,37 +283,37 @@ func AiAnomalyDetectionDataAssetResource() *schema.Resource {
 	}
 }
 
-func createAiAnomalyDetectionDataAsset(d *schema.ResourceData, m interface{}) error {
+func createAiAnomalyDetectionDataAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionDataAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readAiAnomalyDetectionDataAsset(d *schema.ResourceData, m interface{}) error {
+func readAiAnomalyDetectionDataAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionDataAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateAiAnomalyDetectionDataAsset(d *schema.ResourceData, m interface{}) error {
+func updateAiAnomalyDetectionDataAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionDataAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteAiAnomalyDetectionDataAsset(d *schema.ResourceData, m interface{}) error {
+func deleteAiAnomalyDetectionDataAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionDataAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type AiAnomalyDetectionDataAssetResourceCrud struct {
@@ -346,7 +347,7 @@ func (s *AiAnomalyDetectionDataAssetResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *AiAnomalyDetectionDataAssetResourceCrud) Create() error {
+func (s *AiAnomalyDetectionDataAssetResourceCrud) Create(ctx context.Context) error {
 	request := oci_ai_anomaly_detection.CreateDataAssetRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -399,7 +400,7 @@ func (s *AiAnomalyDetectionDataAssetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.CreateDataAsset(context.Background(), request)
+	response, err := s.Client.CreateDataAsset(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -408,7 +409,7 @@ func (s *AiAnomalyDetectionDataAssetResourceCrud) Create() error {
 	return nil
 }
 
-func (s *AiAnomalyDetectionDataAssetResourceCrud) Get() error {
+func (s *AiAnomalyDetectionDataAssetResourceCrud) Get(ctx context.Context) error {
 	request := oci_ai_anomaly_detection.GetDataAssetRequest{}
 
 	tmp := s.D.Id()
@@ -416,7 +417,7 @@ func (s *AiAnomalyDetectionDataAssetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.GetDataAsset(context.Background(), request)
+	response, err := s.Client.GetDataAsset(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -425,11 +426,11 @@ func (s *AiAnomalyDetectionDataAssetResourceCrud) Get() error {
 	return nil
 }
 
-func (s *AiAnomalyDetectionDataAssetResourceCrud) Update() error {
+func (s *AiAnomalyDetectionDataAssetResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -464,7 +465,7 @@ func (s *AiAnomalyDetectionDataAssetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.UpdateDataAsset(context.Background(), request)
+	response, err := s.Client.UpdateDataAsset(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -473,7 +474,7 @@ func (s *AiAnomalyDetectionDataAssetResourceCrud) Update() error {
 	return nil
 }
 
-func (s *AiAnomalyDetectionDataAssetResourceCrud) Delete() error {
+func (s *AiAnomalyDetectionDataAssetResourceCrud) Delete(ctx context.Context) error {
 	request := oci_ai_anomaly_detection.DeleteDataAssetRequest{}
 
 	tmp := s.D.Id()
@@ -481,7 +482,7 @@ func (s *AiAnomalyDetectionDataAssetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	_, err := s.Client.DeleteDataAsset(context.Background(), request)
+	_, err := s.Client.DeleteDataAsset(ctx, request)
 	return err
 }
 
@@ -872,7 +873,7 @@ func InfluxDetailsToMap(obj *oci_ai_anomaly_detection.InfluxDetails) map[string]
 	return result
 }
 
-func (s *AiAnomalyDetectionDataAssetResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *AiAnomalyDetectionDataAssetResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_ai_anomaly_detection.ChangeDataAssetCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -883,12 +884,12 @@ func (s *AiAnomalyDetectionDataAssetResourceCrud) updateCompartment(compartment
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	_, err := s.Client.ChangeDataAssetCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeDataAssetCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
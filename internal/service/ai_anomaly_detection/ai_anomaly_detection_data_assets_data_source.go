@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ai_anomaly_detection "github.com/oracle/oci-go-sdk/v65/aianomalydetection"
 )
 
 func AiAnomalyDetectionDataAssetsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readAiAnomalyDetectionDataAssets,
+		ReadContext: readAiAnomalyDetectionDataAssets,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -52,12 +53,12 @@ func AiAnomalyDetectionDataAssetsDataSource() *schema.Resource {
 	}
 }
 
-func readAiAnomalyDetectionDataAssets(d *schema.ResourceData, m interface{}) error {
+func readAiAnomalyDetectionDataAssets(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionDataAssetsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type AiAnomalyDetectionDataAssetsDataSourceCrud struct {
@@ -70,7 +71,7 @@ func (s *AiAnomalyDetectionDataAssetsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *AiAnomalyDetectionDataAssetsDataSourceCrud) Get() error {
+func (s *AiAnomalyDetectionDataAssetsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_ai_anomaly_detection.ListDataAssetsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -94,7 +95,7 @@ func (s *AiAnomalyDetectionDataAssetsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ai_anomaly_detection")
 
-	response, err := s.Client.ListDataAssets(context.Background(), request)
+	response, err := s.Client.ListDataAssets(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *AiAnomalyDetectionDataAssetsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDataAssets(context.Background(), request)
+		listResponse, err := s.Client.ListDataAssets(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ai_anomaly_detection "github.com/oracle/oci-go-sdk/v65/aianomalydetection"
 )
@@ -22,12 +23,12 @@ func AiAnomalyDetectionModelDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(AiAnomalyDetectionModelResource(), fieldMap, readSingularAiAnomalyDetectionModel)
 }
 
-func readSingularAiAnomalyDetectionModel(d *schema.ResourceData, m interface{}) error {
+func readSingularAiAnomalyDetectionModel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionModelDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type AiAnomalyDetectionModelDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *AiAnomalyDetectionModelDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *AiAnomalyDetectionModelDataSourceCrud) Get() error {
+func (s *AiAnomalyDetectionModelDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_ai_anomaly_detection.GetModelRequest{}
 
 	if modelId, ok := s.D.GetOkExists("model_id"); ok {
@@ -50,7 +51,7 @@ func (s *AiAnomalyDetectionModelDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ai_anomaly_detection")
 
-	response, err := s.Client.GetModel(context.Background(), request)
+	response, err := s.Client.GetModel(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -26,11 +27,11 @@ func AiAnomalyDetectionModelResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createAiAnomalyDetectionModel,
-		Read:     readAiAnomalyDetectionModel,
-		Update:   updateAiAnomalyDetectionModel,
-		Delete:   deleteAiAnomalyDetectionModel,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createAiAnomalyDetectionModel,
+		ReadContext:   readAiAnomalyDetectionModel,
+		UpdateContext: updateAiAnomalyDetectionModel,
+		DeleteContext: deleteAiAnomalyDetectionModel,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -235,37 +236,37 @@ func AiAnomalyDetectionModelResource() *schema.Resource {
 	}
 }
 
-func createAiAnomalyDetectionModel(d *schema.ResourceData, m interface{}) error {
+func createAiAnomalyDetectionModel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionModelResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readAiAnomalyDetectionModel(d *schema.ResourceData, m interface{}) error {
+func readAiAnomalyDetectionModel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionModelResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateAiAnomalyDetectionModel(d *schema.ResourceData, m interface{}) error {
+func updateAiAnomalyDetectionModel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionModelResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteAiAnomalyDetectionModel(d *schema.ResourceData, m interface{}) error {
+func deleteAiAnomalyDetectionModel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionModelResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type AiAnomalyDetectionModelResourceCrud struct {
@@ -303,7 +304,7 @@ func (s *AiAnomalyDetectionModelResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *AiAnomalyDetectionModelResourceCrud) Create() error {
+func (s *AiAnomalyDetectionModelResourceCrud) Create(ctx context.Context) error {
 	request := oci_ai_anomaly_detection.CreateModelRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -351,26 +352,26 @@ func (s *AiAnomalyDetectionModelResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.CreateModel(context.Background(), request)
+	response, err := s.Client.CreateModel(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getModelFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection"), oci_ai_anomaly_detection.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getModelFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection"), oci_ai_anomaly_detection.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *AiAnomalyDetectionModelResourceCrud) getModelFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *AiAnomalyDetectionModelResourceCrud) getModelFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_ai_anomaly_detection.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	modelId, err := modelWaitForWorkRequest(workId, "model",
+	modelId, err := modelWaitForWorkRequest(ctx, workId, "model",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, modelId)
-		_, cancelErr := s.Client.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.Client.CancelWorkRequest(ctx,
 			oci_ai_anomaly_detection.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -384,7 +385,7 @@ func (s *AiAnomalyDetectionModelResourceCrud) getModelFromWorkRequest(workId *st
 	}
 	s.D.SetId(*modelId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func modelWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -410,7 +411,7 @@ func modelWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_co
 	}
 }
 
-func modelWaitForWorkRequest(wId *string, entityType string, action oci_ai_anomaly_detection.ActionTypeEnum,
+func modelWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_ai_anomaly_detection.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_ai_anomaly_detection.AnomalyDetectionClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "ai_anomaly_detection")
 	retryPolicy.ShouldRetryOperation = modelWorkRequestShouldRetryFunc(timeout)
@@ -430,7 +431,7 @@ func modelWaitForWorkRequest(wId *string, entityType string, action oci_ai_anoma
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_ai_anomaly_detection.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -459,14 +460,14 @@ func modelWaitForWorkRequest(wId *string, entityType string, action oci_ai_anoma
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_ai_anomaly_detection.OperationStatusFailed || response.Status == oci_ai_anomaly_detection.OperationStatusCanceled {
-		return nil, getErrorFromAiAnomalyDetectionModelWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromAiAnomalyDetectionModelWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromAiAnomalyDetectionModelWorkRequest(client *oci_ai_anomaly_detection.AnomalyDetectionClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_ai_anomaly_detection.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromAiAnomalyDetectionModelWorkRequest(ctx context.Context, client *oci_ai_anomaly_detection.AnomalyDetectionClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_ai_anomaly_detection.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_ai_anomaly_detection.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -488,7 +489,7 @@ func getErrorFromAiAnomalyDetectionModelWorkRequest(client *oci_ai_anomaly_detec
 	return workRequestErr
 }
 
-func (s *AiAnomalyDetectionModelResourceCrud) Get() error {
+func (s *AiAnomalyDetectionModelResourceCrud) Get(ctx context.Context) error {
 	request := oci_ai_anomaly_detection.GetModelRequest{}
 
 	tmp := s.D.Id()
@@ -496,7 +497,7 @@ func (s *AiAnomalyDetectionModelResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.GetModel(context.Background(), request)
+	response, err := s.Client.GetModel(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -505,11 +506,11 @@ func (s *AiAnomalyDetectionModelResourceCrud) Get() error {
 	return nil
 }
 
-func (s *AiAnomalyDetectionModelResourceCrud) Update() error {
+func (s *AiAnomalyDetectionModelResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -544,16 +545,16 @@ func (s *AiAnomalyDetectionModelResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.UpdateModel(context.Background(), request)
+	response, err := s.Client.UpdateModel(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getModelFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection"), oci_ai_anomaly_detection.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getModelFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection"), oci_ai_anomaly_detection.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *AiAnomalyDetectionModelResourceCrud) Delete() error {
+func (s *AiAnomalyDetectionModelResourceCrud) Delete(ctx context.Context) error {
 	request := oci_ai_anomaly_detection.DeleteModelRequest{}
 
 	tmp := s.D.Id()
@@ -561,14 +562,14 @@ func (s *AiAnomalyDetectionModelResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.DeleteModel(context.Background(), request)
+	response, err := s.Client.DeleteModel(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := modelWaitForWorkRequest(workId, "model",
+	_, delWorkRequestErr := modelWaitForWorkRequest(ctx, workId, "model",
 		oci_ai_anomaly_detection.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -816,7 +817,7 @@ func RowReductionDetailsToMap(obj *oci_ai_anomaly_detection.RowReductionDetails)
 	return result
 }
 
-func (s *AiAnomalyDetectionModelResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *AiAnomalyDetectionModelResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_ai_anomaly_detection.ChangeModelCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -827,12 +828,12 @@ func (s *AiAnomalyDetectionModelResourceCrud) updateCompartment(compartment inte
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	_, err := s.Client.ChangeModelCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeModelCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -25,11 +26,11 @@ func AiAnomalyDetectionProjectResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createAiAnomalyDetectionProject,
-		Read:     readAiAnomalyDetectionProject,
-		Update:   updateAiAnomalyDetectionProject,
-		Delete:   deleteAiAnomalyDetectionProject,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createAiAnomalyDetectionProject,
+		ReadContext:   readAiAnomalyDetectionProject,
+		UpdateContext: updateAiAnomalyDetectionProject,
+		DeleteContext: deleteAiAnomalyDetectionProject,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -84,37 +85,37 @@ func AiAnomalyDetectionProjectResource() *schema.Resource {
 	}
 }
 
-func createAiAnomalyDetectionProject(d *schema.ResourceData, m interface{}) error {
+func createAiAnomalyDetectionProject(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionProjectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readAiAnomalyDetectionProject(d *schema.ResourceData, m interface{}) error {
+func readAiAnomalyDetectionProject(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionProjectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateAiAnomalyDetectionProject(d *schema.ResourceData, m interface{}) error {
+func updateAiAnomalyDetectionProject(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionProjectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteAiAnomalyDetectionProject(d *schema.ResourceData, m interface{}) error {
+func deleteAiAnomalyDetectionProject(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiAnomalyDetectionProjectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnomalyDetectionClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type AiAnomalyDetectionProjectResourceCrud struct {
@@ -152,7 +153,7 @@ func (s *AiAnomalyDetectionProjectResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *AiAnomalyDetectionProjectResourceCrud) Create() error {
+func (s *AiAnomalyDetectionProjectResourceCrud) Create(ctx context.Context) error {
 	request := oci_ai_anomaly_detection.CreateProjectRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -184,7 +185,7 @@ func (s *AiAnomalyDetectionProjectResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.CreateProject(context.Background(), request)
+	response, err := s.Client.CreateProject(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -193,11 +194,11 @@ func (s *AiAnomalyDetectionProjectResourceCrud) Create() error {
 	return nil
 }
 
-func (s *AiAnomalyDetectionProjectResourceCrud) getProjectFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *AiAnomalyDetectionProjectResourceCrud) getProjectFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_ai_anomaly_detection.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	projectId, err := aiAnomalyDetectionProjectWaitForWorkRequest(workId, "ai_anomaly_detection",
+	projectId, err := aiAnomalyDetectionProjectWaitForWorkRequest(ctx, workId, "ai_anomaly_detection",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -217,7 +218,7 @@ func (s *AiAnomalyDetectionProjectResourceCrud) getProjectFromWorkRequest(workId
 	}
 	s.D.SetId(*projectId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func aiAnomalyDetectionProjectWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -243,7 +244,7 @@ func aiAnomalyDetectionProjectWorkRequestShouldRetryFunc(timeout time.Duration)
 	}
 }
 
-func aiAnomalyDetectionProjectWaitForWorkRequest(wId *string, entityType string, action oci_ai_anomaly_detection.ActionTypeEnum,
+func aiAnomalyDetectionProjectWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_ai_anomaly_detection.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_ai_anomaly_detection.AnomalyDetectionClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "ai_anomaly_detection")
 	retryPolicy.ShouldRetryOperation = aiAnomalyDetectionProjectWorkRequestShouldRetryFunc(timeout)
@@ -262,7 +263,7 @@ func aiAnomalyDetectionProjectWaitForWorkRequest(wId *string, entityType string,
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_ai_anomaly_detection.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -291,14 +292,14 @@ func aiAnomalyDetectionProjectWaitForWorkRequest(wId *string, entityType string,
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_ai_anomaly_detection.OperationStatusFailed || response.Status == oci_ai_anomaly_detection.OperationStatusCanceled {
-		return nil, getErrorFromAiAnomalyDetectionProjectWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromAiAnomalyDetectionProjectWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromAiAnomalyDetectionProjectWorkRequest(client *oci_ai_anomaly_detection.AnomalyDetectionClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_ai_anomaly_detection.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromAiAnomalyDetectionProjectWorkRequest(ctx context.Context, client *oci_ai_anomaly_detection.AnomalyDetectionClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_ai_anomaly_detection.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_ai_anomaly_detection.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -320,7 +321,7 @@ func getErrorFromAiAnomalyDetectionProjectWorkRequest(client *oci_ai_anomaly_det
 	return workRequestErr
 }
 
-func (s *AiAnomalyDetectionProjectResourceCrud) Get() error {
+func (s *AiAnomalyDetectionProjectResourceCrud) Get(ctx context.Context) error {
 	request := oci_ai_anomaly_detection.GetProjectRequest{}
 
 	tmp := s.D.Id()
@@ -328,7 +329,7 @@ func (s *AiAnomalyDetectionProjectResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.GetProject(context.Background(), request)
+	response, err := s.Client.GetProject(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -337,11 +338,11 @@ func (s *AiAnomalyDetectionProjectResourceCrud) Get() error {
 	return nil
 }
 
-func (s *AiAnomalyDetectionProjectResourceCrud) Update() error {
+func (s *AiAnomalyDetectionProjectResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -376,7 +377,7 @@ func (s *AiAnomalyDetectionProjectResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.UpdateProject(context.Background(), request)
+	response, err := s.Client.UpdateProject(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -385,7 +386,7 @@ func (s *AiAnomalyDetectionProjectResourceCrud) Update() error {
 	return nil
 }
 
-func (s *AiAnomalyDetectionProjectResourceCrud) Delete() error {
+func (s *AiAnomalyDetectionProjectResourceCrud) Delete(ctx context.Context) error {
 	request := oci_ai_anomaly_detection.DeleteProjectRequest{}
 
 	tmp := s.D.Id()
@@ -393,14 +394,14 @@ func (s *AiAnomalyDetectionProjectResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	response, err := s.Client.DeleteProject(context.Background(), request)
+	response, err := s.Client.DeleteProject(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := aiAnomalyDetectionProjectWaitForWorkRequest(workId, "projects",
+	_, delWorkRequestErr := aiAnomalyDetectionProjectWaitForWorkRequest(ctx, workId, "projects",
 		oci_ai_anomaly_detection.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -483,7 +484,7 @@ func aiAnomalyDetectionProjectSummaryToMap(obj oci_ai_anomaly_detection.ProjectS
 	return result
 }
 
-func (s *AiAnomalyDetectionProjectResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *AiAnomalyDetectionProjectResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_ai_anomaly_detection.ChangeProjectCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -494,12 +495,12 @@ func (s *AiAnomalyDetectionProjectResourceCrud) updateCompartment(compartment in
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ai_anomaly_detection")
 
-	_, err := s.Client.ChangeProjectCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeProjectCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
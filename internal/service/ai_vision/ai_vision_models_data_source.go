@@ -6,6 +6,7 @@ package ai_vision
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ai_vision "github.com/oracle/oci-go-sdk/v65/aivision"
 
@@ -15,7 +16,7 @@ import (
 
 func AiVisionModelsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readAiVisionModels,
+		ReadContext: readAiVisionModels,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -56,12 +57,12 @@ func AiVisionModelsDataSource() *schema.Resource {
 	}
 }
 
-func readAiVisionModels(d *schema.ResourceData, m interface{}) error {
+func readAiVisionModels(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiVisionModelsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AiServiceVisionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type AiVisionModelsDataSourceCrud struct {
@@ -74,7 +75,7 @@ func (s *AiVisionModelsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *AiVisionModelsDataSourceCrud) Get() error {
+func (s *AiVisionModelsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_ai_vision.ListModelsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -103,7 +104,7 @@ func (s *AiVisionModelsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ai_vision")
 
-	response, err := s.Client.ListModels(context.Background(), request)
+	response, err := s.Client.ListModels(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +113,7 @@ func (s *AiVisionModelsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListModels(context.Background(), request)
+		listResponse, err := s.Client.ListModels(ctx, request)
 		if err != nil {
 			return err
 		}
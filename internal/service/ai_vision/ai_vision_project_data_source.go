@@ -6,6 +6,7 @@ package ai_vision
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ai_vision "github.com/oracle/oci-go-sdk/v65/aivision"
 
@@ -22,12 +23,12 @@ func AiVisionProjectDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(AiVisionProjectResource(), fieldMap, readSingularAiVisionProject)
 }
 
-func readSingularAiVisionProject(d *schema.ResourceData, m interface{}) error {
+func readSingularAiVisionProject(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AiVisionProjectDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AiServiceVisionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type AiVisionProjectDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *AiVisionProjectDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *AiVisionProjectDataSourceCrud) Get() error {
+func (s *AiVisionProjectDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_ai_vision.GetProjectRequest{}
 
 	if projectId, ok := s.D.GetOkExists("project_id"); ok {
@@ -50,7 +51,7 @@ func (s *AiVisionProjectDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ai_vision")
 
-	response, err := s.Client.GetProject(context.Background(), request)
+	response, err := s.Client.GetProject(ctx, request)
 	if err != nil {
 		return err
 	}
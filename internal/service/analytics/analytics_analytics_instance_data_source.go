@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_analytics "github.com/oracle/oci-go-sdk/v65/analytics"
 )
@@ -22,12 +23,12 @@ func AnalyticsAnalyticsInstanceDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(AnalyticsAnalyticsInstanceResource(), fieldMap, readSingularAnalyticsAnalyticsInstance)
 }
 
-func readSingularAnalyticsAnalyticsInstance(d *schema.ResourceData, m interface{}) error {
+func readSingularAnalyticsAnalyticsInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AnalyticsAnalyticsInstanceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type AnalyticsAnalyticsInstanceDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *AnalyticsAnalyticsInstanceDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *AnalyticsAnalyticsInstanceDataSourceCrud) Get() error {
+func (s *AnalyticsAnalyticsInstanceDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_analytics.GetAnalyticsInstanceRequest{}
 
 	if analyticsInstanceId, ok := s.D.GetOkExists("analytics_instance_id"); ok {
@@ -50,7 +51,7 @@ func (s *AnalyticsAnalyticsInstanceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "analytics")
 
-	response, err := s.Client.GetAnalyticsInstance(context.Background(), request)
+	response, err := s.Client.GetAnalyticsInstance(ctx, request)
 	if err != nil {
 		return err
 	}
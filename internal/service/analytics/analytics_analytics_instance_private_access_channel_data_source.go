@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_analytics "github.com/oracle/oci-go-sdk/v65/analytics"
 )
@@ -26,12 +27,12 @@ func AnalyticsAnalyticsInstancePrivateAccessChannelDataSource() *schema.Resource
 	return tfresource.GetSingularDataSourceItemSchema(AnalyticsAnalyticsInstancePrivateAccessChannelResource(), fieldMap, readSingularAnalyticsAnalyticsInstancePrivateAccessChannel)
 }
 
-func readSingularAnalyticsAnalyticsInstancePrivateAccessChannel(d *schema.ResourceData, m interface{}) error {
+func readSingularAnalyticsAnalyticsInstancePrivateAccessChannel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AnalyticsAnalyticsInstancePrivateAccessChannelDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type AnalyticsAnalyticsInstancePrivateAccessChannelDataSourceCrud struct {
@@ -44,7 +45,7 @@ func (s *AnalyticsAnalyticsInstancePrivateAccessChannelDataSourceCrud) VoidState
 	s.D.SetId("")
 }
 
-func (s *AnalyticsAnalyticsInstancePrivateAccessChannelDataSourceCrud) Get() error {
+func (s *AnalyticsAnalyticsInstancePrivateAccessChannelDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_analytics.GetPrivateAccessChannelRequest{}
 
 	if analyticsInstanceId, ok := s.D.GetOkExists("analytics_instance_id"); ok {
@@ -59,7 +60,7 @@ func (s *AnalyticsAnalyticsInstancePrivateAccessChannelDataSourceCrud) Get() err
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "analytics")
 
-	response, err := s.Client.GetPrivateAccessChannel(context.Background(), request)
+	response, err := s.Client.GetPrivateAccessChannel(ctx, request)
 	if err != nil {
 		return err
 	}
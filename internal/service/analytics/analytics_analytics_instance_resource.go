@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -31,10 +32,10 @@ func AnalyticsAnalyticsInstanceResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("1h"),
 			Delete: tfresource.GetTimeoutDuration("1h"),
 		},
-		Create: createAnalyticsAnalyticsInstance,
-		Read:   readAnalyticsAnalyticsInstance,
-		Update: updateAnalyticsAnalyticsInstance,
-		Delete: deleteAnalyticsAnalyticsInstance,
+		CreateContext: createAnalyticsAnalyticsInstance,
+		ReadContext:   readAnalyticsAnalyticsInstance,
+		UpdateContext: updateAnalyticsAnalyticsInstance,
+		DeleteContext: deleteAnalyticsAnalyticsInstance,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"capacity": {
@@ -240,7 +241,7 @@ func AnalyticsAnalyticsInstanceResource() *schema.Resource {
 	}
 }
 
-func createAnalyticsAnalyticsInstance(d *schema.ResourceData, m interface{}) error {
+func createAnalyticsAnalyticsInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AnalyticsAnalyticsInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnalyticsClient()
@@ -252,13 +253,13 @@ func createAnalyticsAnalyticsInstance(d *schema.ResourceData, m interface{}) err
 		}
 	}
 
-	if e := tfresource.CreateResource(d, sync); e != nil {
-		return e
+	if e := tfresource.CreateResource(ctx, d, sync); e != nil {
+		return tfresource.HandleDiagError(e)
 	}
 
 	if powerOff {
-		if err := sync.StopAnalyticsInstance(); err != nil {
-			return err
+		if err := sync.StopAnalyticsInstance(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		sync.D.Set("state", oci_analytics.AnalyticsInstanceLifecycleStateInactive)
 	}
@@ -266,7 +267,7 @@ func createAnalyticsAnalyticsInstance(d *schema.ResourceData, m interface{}) err
 
 }
 
-func (s *AnalyticsAnalyticsInstanceResourceCrud) SetKmsKey(kmsKeyId *string) error {
+func (s *AnalyticsAnalyticsInstanceResourceCrud) SetKmsKey(ctx context.Context, kmsKeyId *string) error {
 	request := oci_analytics.SetKmsKeyRequest{}
 
 	tmp := s.D.Id()
@@ -274,26 +275,26 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) SetKmsKey(kmsKeyId *string) err
 	request.KmsKeyId = kmsKeyId
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
-	response, err := s.Client.SetKmsKey(context.Background(), request)
+	response, err := s.Client.SetKmsKey(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	_, err = analyticsInstanceWaitForWorkRequest(workId, "analytics",
+	_, err = analyticsInstanceWaitForWorkRequest(ctx, workId, "analytics",
 		oci_analytics.WorkRequestActionResultCreated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 	return err
 }
 
-func readAnalyticsAnalyticsInstance(d *schema.ResourceData, m interface{}) error {
+func readAnalyticsAnalyticsInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AnalyticsAnalyticsInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateAnalyticsAnalyticsInstance(d *schema.ResourceData, m interface{}) error {
+func updateAnalyticsAnalyticsInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AnalyticsAnalyticsInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnalyticsClient()
@@ -310,29 +311,29 @@ func updateAnalyticsAnalyticsInstance(d *schema.ResourceData, m interface{}) err
 	}
 
 	if powerOn {
-		if err := sync.StartAnalyticsInstance(); err != nil {
-			return err
+		if err := sync.StartAnalyticsInstance(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		sync.D.Set("state", oci_analytics.AnalyticsInstanceLifecycleStateActive)
 	}
 	if sync.D.HasChange("kms_key_id") {
 		wantedKmsKeyId := sync.D.Get("kms_key_id").(string)
-		if err := sync.SetKmsKey(&wantedKmsKeyId); err != nil {
+		if err := sync.SetKmsKey(ctx, &wantedKmsKeyId); err != nil {
 			// Re-read the instance to update the state file with correct values after failure
-			err = tfresource.ReadResource(sync)
-			return err
+			err = tfresource.ReadResource(ctx, sync)
+			return tfresource.HandleDiagError(err)
 		}
 
 		sync.D.Set("kms_key_id", wantedKmsKeyId)
 	}
 
-	if err := tfresource.UpdateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.UpdateResource(ctx, d, sync); err != nil {
+		return tfresource.HandleDiagError(err)
 	}
 
 	if powerOff {
-		if err := sync.StopAnalyticsInstance(); err != nil {
-			return err
+		if err := sync.StopAnalyticsInstance(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		sync.D.Set("state", oci_analytics.AnalyticsInstanceLifecycleStateInactive)
 	}
@@ -340,13 +341,13 @@ func updateAnalyticsAnalyticsInstance(d *schema.ResourceData, m interface{}) err
 	return nil
 }
 
-func deleteAnalyticsAnalyticsInstance(d *schema.ResourceData, m interface{}) error {
+func deleteAnalyticsAnalyticsInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AnalyticsAnalyticsInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnalyticsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type AnalyticsAnalyticsInstanceResourceCrud struct {
@@ -384,7 +385,7 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *AnalyticsAnalyticsInstanceResourceCrud) Create() error {
+func (s *AnalyticsAnalyticsInstanceResourceCrud) Create(ctx context.Context) error {
 	request := oci_analytics.CreateAnalyticsInstanceRequest{}
 
 	if capacity, ok := s.D.GetOkExists("capacity"); ok {
@@ -461,26 +462,26 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
-	response, err := s.Client.CreateAnalyticsInstance(context.Background(), request)
+	response, err := s.Client.CreateAnalyticsInstance(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getAnalyticsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics"), oci_analytics.WorkRequestActionResultCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getAnalyticsInstanceFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics"), oci_analytics.WorkRequestActionResultCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *AnalyticsAnalyticsInstanceResourceCrud) getAnalyticsInstanceFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *AnalyticsAnalyticsInstanceResourceCrud) getAnalyticsInstanceFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_analytics.WorkRequestActionResultEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	analyticsInstanceId, err := analyticsInstanceWaitForWorkRequest(workId, "analytics",
+	analyticsInstanceId, err := analyticsInstanceWaitForWorkRequest(ctx, workId, "analytics",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, analyticsInstanceId)
-		_, cancelErr := s.Client.DeleteWorkRequest(context.Background(),
+		_, cancelErr := s.Client.DeleteWorkRequest(ctx,
 			oci_analytics.DeleteWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -499,7 +500,7 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) getAnalyticsInstanceFromWorkReq
 
 	s.D.SetId(*analyticsInstanceId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func analyticsInstanceWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -525,7 +526,7 @@ func analyticsInstanceWorkRequestShouldRetryFunc(timeout time.Duration) func(res
 	}
 }
 
-func analyticsInstanceWaitForWorkRequest(wId *string, entityType string, action oci_analytics.WorkRequestActionResultEnum,
+func analyticsInstanceWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_analytics.WorkRequestActionResultEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_analytics.AnalyticsClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "analytics")
 	retryPolicy.ShouldRetryOperation = analyticsInstanceWorkRequestShouldRetryFunc(timeout)
@@ -544,7 +545,7 @@ func analyticsInstanceWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_analytics.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -573,14 +574,14 @@ func analyticsInstanceWaitForWorkRequest(wId *string, entityType string, action
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_analytics.WorkRequestStatusFailed || response.Status == oci_analytics.WorkRequestStatusCanceled {
-		return nil, getErrorFromAnalyticsAnalyticsInstanceWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromAnalyticsAnalyticsInstanceWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromAnalyticsAnalyticsInstanceWorkRequest(client *oci_analytics.AnalyticsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_analytics.WorkRequestActionResultEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromAnalyticsAnalyticsInstanceWorkRequest(ctx context.Context, client *oci_analytics.AnalyticsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_analytics.WorkRequestActionResultEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_analytics.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -602,7 +603,7 @@ func getErrorFromAnalyticsAnalyticsInstanceWorkRequest(client *oci_analytics.Ana
 	return workRequestErr
 }
 
-func (s *AnalyticsAnalyticsInstanceResourceCrud) Get() error {
+func (s *AnalyticsAnalyticsInstanceResourceCrud) Get(ctx context.Context) error {
 	request := oci_analytics.GetAnalyticsInstanceRequest{}
 
 	tmp := s.D.Id()
@@ -610,7 +611,7 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
-	response, err := s.Client.GetAnalyticsInstance(context.Background(), request)
+	response, err := s.Client.GetAnalyticsInstance(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -619,11 +620,11 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) Get() error {
 	return nil
 }
 
-func (s *AnalyticsAnalyticsInstanceResourceCrud) Update() error {
+func (s *AnalyticsAnalyticsInstanceResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -662,7 +663,7 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
-	response, err := s.Client.UpdateAnalyticsInstance(context.Background(), request)
+	response, err := s.Client.UpdateAnalyticsInstance(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -685,21 +686,21 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) Update() error {
 			scaleRequest.Capacity = &tmp
 
 			scaleRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
-			scaleResponse, err := s.Client.ScaleAnalyticsInstance(context.Background(), scaleRequest)
+			scaleResponse, err := s.Client.ScaleAnalyticsInstance(ctx, scaleRequest)
 
 			if err != nil {
 				return err
 			}
 
 			workId := scaleResponse.OpcWorkRequestId
-			return s.getAnalyticsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics"), oci_analytics.WorkRequestActionResultScaled, s.D.Timeout(schema.TimeoutUpdate))
+			return s.getAnalyticsInstanceFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics"), oci_analytics.WorkRequestActionResultScaled, s.D.Timeout(schema.TimeoutUpdate))
 		}
 	}
 
 	return nil
 }
 
-func (s *AnalyticsAnalyticsInstanceResourceCrud) Delete() error {
+func (s *AnalyticsAnalyticsInstanceResourceCrud) Delete(ctx context.Context) error {
 	request := oci_analytics.DeleteAnalyticsInstanceRequest{}
 
 	tmp := s.D.Id()
@@ -707,7 +708,7 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
-	response, err := s.Client.DeleteAnalyticsInstance(context.Background(), request)
+	response, err := s.Client.DeleteAnalyticsInstance(ctx, request)
 	time.Sleep(2 * time.Minute) //We add this to prevent 412-PreconditionFailed, NetworkSecurityGroup cannot be deleted since it still has vnics attached to it
 	if err != nil {
 		return err
@@ -715,7 +716,7 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) Delete() error {
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := analyticsInstanceWaitForWorkRequest(workId, "analytics",
+	_, delWorkRequestErr := analyticsInstanceWaitForWorkRequest(ctx, workId, "analytics",
 		oci_analytics.WorkRequestActionResultDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -784,7 +785,7 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *AnalyticsAnalyticsInstanceResourceCrud) StartAnalyticsInstance() error {
+func (s *AnalyticsAnalyticsInstanceResourceCrud) StartAnalyticsInstance(ctx context.Context) error {
 	request := oci_analytics.StartAnalyticsInstanceRequest{}
 
 	idTmp := s.D.Id()
@@ -792,16 +793,16 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) StartAnalyticsInstance() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
-	_, err := s.Client.StartAnalyticsInstance(context.Background(), request)
+	_, err := s.Client.StartAnalyticsInstance(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_analytics.AnalyticsInstanceLifecycleStateActive }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *AnalyticsAnalyticsInstanceResourceCrud) StopAnalyticsInstance() error {
+func (s *AnalyticsAnalyticsInstanceResourceCrud) StopAnalyticsInstance(ctx context.Context) error {
 	request := oci_analytics.StopAnalyticsInstanceRequest{}
 
 	idTmp := s.D.Id()
@@ -809,13 +810,13 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) StopAnalyticsInstance() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
-	_, err := s.Client.StopAnalyticsInstance(context.Background(), request)
+	_, err := s.Client.StopAnalyticsInstance(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_analytics.AnalyticsInstanceLifecycleStateInactive }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *AnalyticsAnalyticsInstanceResourceCrud) mapToCapacity(fieldKeyFormat string) (oci_analytics.Capacity, error) {
@@ -1008,7 +1009,7 @@ func VirtualCloudNetworkToMap(obj oci_analytics.VirtualCloudNetwork) map[string]
 	return result
 }
 
-func (s *AnalyticsAnalyticsInstanceResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *AnalyticsAnalyticsInstanceResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_analytics.ChangeAnalyticsInstanceCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -1019,11 +1020,11 @@ func (s *AnalyticsAnalyticsInstanceResourceCrud) updateCompartment(compartment i
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics")
 
-	response, err := s.Client.ChangeAnalyticsInstanceCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeAnalyticsInstanceCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getAnalyticsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics"), oci_analytics.WorkRequestActionResultCompartmentChanged, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getAnalyticsInstanceFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "analytics"), oci_analytics.WorkRequestActionResultCompartmentChanged, s.D.Timeout(schema.TimeoutUpdate))
 }
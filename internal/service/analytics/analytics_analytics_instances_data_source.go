@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_analytics "github.com/oracle/oci-go-sdk/v65/analytics"
 )
 
 func AnalyticsAnalyticsInstancesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readAnalyticsAnalyticsInstances,
+		ReadContext: readAnalyticsAnalyticsInstances,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"capacity_type": {
@@ -47,12 +48,12 @@ func AnalyticsAnalyticsInstancesDataSource() *schema.Resource {
 	}
 }
 
-func readAnalyticsAnalyticsInstances(d *schema.ResourceData, m interface{}) error {
+func readAnalyticsAnalyticsInstances(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AnalyticsAnalyticsInstancesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type AnalyticsAnalyticsInstancesDataSourceCrud struct {
@@ -65,7 +66,7 @@ func (s *AnalyticsAnalyticsInstancesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *AnalyticsAnalyticsInstancesDataSourceCrud) Get() error {
+func (s *AnalyticsAnalyticsInstancesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_analytics.ListAnalyticsInstancesRequest{}
 
 	if capacityType, ok := s.D.GetOkExists("capacity_type"); ok {
@@ -92,7 +93,7 @@ func (s *AnalyticsAnalyticsInstancesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "analytics")
 
-	response, err := s.Client.ListAnalyticsInstances(context.Background(), request)
+	response, err := s.Client.ListAnalyticsInstances(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -101,7 +102,7 @@ func (s *AnalyticsAnalyticsInstancesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAnalyticsInstances(context.Background(), request)
+		listResponse, err := s.Client.ListAnalyticsInstances(ctx, request)
 		if err != nil {
 			return err
 		}
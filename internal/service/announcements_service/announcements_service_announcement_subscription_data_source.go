@@ -6,6 +6,7 @@ package announcements_service
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_announcements_service "github.com/oracle/oci-go-sdk/v65/announcementsservice"
 
@@ -22,12 +23,12 @@ func AnnouncementsServiceAnnouncementSubscriptionDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(AnnouncementsServiceAnnouncementSubscriptionResource(), fieldMap, readSingularAnnouncementsServiceAnnouncementSubscription)
 }
 
-func readSingularAnnouncementsServiceAnnouncementSubscription(d *schema.ResourceData, m interface{}) error {
+func readSingularAnnouncementsServiceAnnouncementSubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AnnouncementsServiceAnnouncementSubscriptionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnnouncementSubscriptionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type AnnouncementsServiceAnnouncementSubscriptionDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *AnnouncementsServiceAnnouncementSubscriptionDataSourceCrud) VoidState()
 	s.D.SetId("")
 }
 
-func (s *AnnouncementsServiceAnnouncementSubscriptionDataSourceCrud) Get() error {
+func (s *AnnouncementsServiceAnnouncementSubscriptionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_announcements_service.GetAnnouncementSubscriptionRequest{}
 
 	if announcementSubscriptionId, ok := s.D.GetOkExists("announcement_subscription_id"); ok {
@@ -50,7 +51,7 @@ func (s *AnnouncementsServiceAnnouncementSubscriptionDataSourceCrud) Get() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "announcements_service")
 
-	response, err := s.Client.GetAnnouncementSubscription(context.Background(), request)
+	response, err := s.Client.GetAnnouncementSubscription(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -20,11 +21,11 @@ func AnnouncementsServiceAnnouncementSubscriptionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createAnnouncementsServiceAnnouncementSubscription,
-		Read:     readAnnouncementsServiceAnnouncementSubscription,
-		Update:   updateAnnouncementsServiceAnnouncementSubscription,
-		Delete:   deleteAnnouncementsServiceAnnouncementSubscription,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createAnnouncementsServiceAnnouncementSubscription,
+		ReadContext:   readAnnouncementsServiceAnnouncementSubscription,
+		UpdateContext: updateAnnouncementsServiceAnnouncementSubscription,
+		DeleteContext: deleteAnnouncementsServiceAnnouncementSubscription,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -131,37 +132,37 @@ func AnnouncementsServiceAnnouncementSubscriptionResource() *schema.Resource {
 	}
 }
 
-func createAnnouncementsServiceAnnouncementSubscription(d *schema.ResourceData, m interface{}) error {
+func createAnnouncementsServiceAnnouncementSubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AnnouncementsServiceAnnouncementSubscriptionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnnouncementSubscriptionClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readAnnouncementsServiceAnnouncementSubscription(d *schema.ResourceData, m interface{}) error {
+func readAnnouncementsServiceAnnouncementSubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AnnouncementsServiceAnnouncementSubscriptionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnnouncementSubscriptionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateAnnouncementsServiceAnnouncementSubscription(d *schema.ResourceData, m interface{}) error {
+func updateAnnouncementsServiceAnnouncementSubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AnnouncementsServiceAnnouncementSubscriptionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnnouncementSubscriptionClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteAnnouncementsServiceAnnouncementSubscription(d *schema.ResourceData, m interface{}) error {
+func deleteAnnouncementsServiceAnnouncementSubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AnnouncementsServiceAnnouncementSubscriptionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnnouncementSubscriptionClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type AnnouncementsServiceAnnouncementSubscriptionResourceCrud struct {
@@ -195,7 +196,7 @@ func (s *AnnouncementsServiceAnnouncementSubscriptionResourceCrud) DeletedTarget
 	}
 }
 
-func (s *AnnouncementsServiceAnnouncementSubscriptionResourceCrud) Create() error {
+func (s *AnnouncementsServiceAnnouncementSubscriptionResourceCrud) Create(ctx context.Context) error {
 	request := oci_announcements_service.CreateAnnouncementSubscriptionRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -243,7 +244,7 @@ func (s *AnnouncementsServiceAnnouncementSubscriptionResourceCrud) Create() erro
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "announcements_service")
 
-	response, err := s.Client.CreateAnnouncementSubscription(context.Background(), request)
+	response, err := s.Client.CreateAnnouncementSubscription(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -252,7 +253,7 @@ func (s *AnnouncementsServiceAnnouncementSubscriptionResourceCrud) Create() erro
 	return nil
 }
 
-func (s *AnnouncementsServiceAnnouncementSubscriptionResourceCrud) Get() error {
+func (s *AnnouncementsServiceAnnouncementSubscriptionResourceCrud) Get(ctx context.Context) error {
 	request := oci_announcements_service.GetAnnouncementSubscriptionRequest{}
 
 	tmp := s.D.Id()
@@ -260,7 +261,7 @@ func (s *AnnouncementsServiceAnnouncementSubscriptionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "announcements_service")
 
-	response, err := s.Client.GetAnnouncementSubscription(context.Background(), request)
+	response, err := s.Client.GetAnnouncementSubscription(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -269,11 +270,11 @@ func (s *AnnouncementsServiceAnnouncementSubscriptionResourceCrud) Get() error {
 	return nil
 }
 
-func (s *AnnouncementsServiceAnnouncementSubscriptionResourceCrud) Update() error {
+func (s *AnnouncementsServiceAnnouncementSubscriptionResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -313,7 +314,7 @@ func (s *AnnouncementsServiceAnnouncementSubscriptionResourceCrud) Update() erro
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "announcements_service")
 
-	response, err := s.Client.UpdateAnnouncementSubscription(context.Background(), request)
+	response, err := s.Client.UpdateAnnouncementSubscription(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -322,7 +323,7 @@ func (s *AnnouncementsServiceAnnouncementSubscriptionResourceCrud) Update() erro
 	return nil
 }
 
-func (s *AnnouncementsServiceAnnouncementSubscriptionResourceCrud) Delete() error {
+func (s *AnnouncementsServiceAnnouncementSubscriptionResourceCrud) Delete(ctx context.Context) error {
 	request := oci_announcements_service.DeleteAnnouncementSubscriptionRequest{}
 
 	tmp := s.D.Id()
@@ -330,7 +331,7 @@ func (s *AnnouncementsServiceAnnouncementSubscriptionResourceCrud) Delete() erro
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "announcements_service")
 
-	_, err := s.Client.DeleteAnnouncementSubscription(context.Background(), request)
+	_, err := s.Client.DeleteAnnouncementSubscription(ctx, request)
 	return err
 }
 
@@ -499,7 +500,7 @@ func FilterGroupToMap(obj oci_announcements_service.FilterGroup) map[string]inte
 	return result
 }
 
-func (s *AnnouncementsServiceAnnouncementSubscriptionResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *AnnouncementsServiceAnnouncementSubscriptionResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_announcements_service.ChangeAnnouncementSubscriptionCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -510,12 +511,12 @@ func (s *AnnouncementsServiceAnnouncementSubscriptionResourceCrud) updateCompart
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "announcements_service")
 
-	_, err := s.Client.ChangeAnnouncementSubscriptionCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeAnnouncementSubscriptionCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package announcements_service
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -19,10 +20,10 @@ func AnnouncementsServiceAnnouncementSubscriptionsActionsChangeCompartmentResour
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createAnnouncementsServiceAnnouncementSubscriptionsActionsChangeCompartment,
-		Read:     readAnnouncementsServiceAnnouncementSubscriptionsActionsChangeCompartment,
-		Delete:   deleteAnnouncementsServiceAnnouncementSubscriptionsActionsChangeCompartment,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createAnnouncementsServiceAnnouncementSubscriptionsActionsChangeCompartment,
+		ReadContext:   readAnnouncementsServiceAnnouncementSubscriptionsActionsChangeCompartment,
+		DeleteContext: deleteAnnouncementsServiceAnnouncementSubscriptionsActionsChangeCompartment,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"announcement_subscription_id": {
@@ -43,19 +44,19 @@ func AnnouncementsServiceAnnouncementSubscriptionsActionsChangeCompartmentResour
 	}
 }
 
-func createAnnouncementsServiceAnnouncementSubscriptionsActionsChangeCompartment(d *schema.ResourceData, m interface{}) error {
+func createAnnouncementsServiceAnnouncementSubscriptionsActionsChangeCompartment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AnnouncementsServiceAnnouncementSubscriptionsActionsChangeCompartmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnnouncementSubscriptionClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readAnnouncementsServiceAnnouncementSubscriptionsActionsChangeCompartment(d *schema.ResourceData, m interface{}) error {
+func readAnnouncementsServiceAnnouncementSubscriptionsActionsChangeCompartment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteAnnouncementsServiceAnnouncementSubscriptionsActionsChangeCompartment(d *schema.ResourceData, m interface{}) error {
+func deleteAnnouncementsServiceAnnouncementSubscriptionsActionsChangeCompartment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -70,7 +71,7 @@ func (s *AnnouncementsServiceAnnouncementSubscriptionsActionsChangeCompartmentRe
 	return s.D.Get("announcement_subscription_id").(string)
 }
 
-func (s *AnnouncementsServiceAnnouncementSubscriptionsActionsChangeCompartmentResourceCrud) Create() error {
+func (s *AnnouncementsServiceAnnouncementSubscriptionsActionsChangeCompartmentResourceCrud) Create(ctx context.Context) error {
 	request := oci_announcements_service.ChangeAnnouncementSubscriptionCompartmentRequest{}
 
 	if announcementSubscriptionId, ok := s.D.GetOkExists("announcement_subscription_id"); ok {
@@ -85,7 +86,7 @@ func (s *AnnouncementsServiceAnnouncementSubscriptionsActionsChangeCompartmentRe
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "announcements_service")
 
-	response, err := s.Client.ChangeAnnouncementSubscriptionCompartment(context.Background(), request)
+	response, err := s.Client.ChangeAnnouncementSubscriptionCompartment(ctx, request)
 	if err != nil {
 		return err
 	}
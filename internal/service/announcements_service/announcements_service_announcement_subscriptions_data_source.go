@@ -6,6 +6,7 @@ package announcements_service
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_announcements_service "github.com/oracle/oci-go-sdk/v65/announcementsservice"
 
@@ -15,7 +16,7 @@ import (
 
 func AnnouncementsServiceAnnouncementSubscriptionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readAnnouncementsServiceAnnouncementSubscriptions,
+		ReadContext: readAnnouncementsServiceAnnouncementSubscriptions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -52,12 +53,12 @@ func AnnouncementsServiceAnnouncementSubscriptionsDataSource() *schema.Resource
 	}
 }
 
-func readAnnouncementsServiceAnnouncementSubscriptions(d *schema.ResourceData, m interface{}) error {
+func readAnnouncementsServiceAnnouncementSubscriptions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AnnouncementsServiceAnnouncementSubscriptionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnnouncementSubscriptionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type AnnouncementsServiceAnnouncementSubscriptionsDataSourceCrud struct {
@@ -70,7 +71,7 @@ func (s *AnnouncementsServiceAnnouncementSubscriptionsDataSourceCrud) VoidState(
 	s.D.SetId("")
 }
 
-func (s *AnnouncementsServiceAnnouncementSubscriptionsDataSourceCrud) Get() error {
+func (s *AnnouncementsServiceAnnouncementSubscriptionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_announcements_service.ListAnnouncementSubscriptionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -94,7 +95,7 @@ func (s *AnnouncementsServiceAnnouncementSubscriptionsDataSourceCrud) Get() erro
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "announcements_service")
 
-	response, err := s.Client.ListAnnouncementSubscriptions(context.Background(), request)
+	response, err := s.Client.ListAnnouncementSubscriptions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *AnnouncementsServiceAnnouncementSubscriptionsDataSourceCrud) Get() erro
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAnnouncementSubscriptions(context.Background(), request)
+		listResponse, err := s.Client.ListAnnouncementSubscriptions(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -20,11 +21,11 @@ func AnnouncementsServiceAnnouncementSubscriptionsFilterGroupResource() *schema.
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createAnnouncementsServiceAnnouncementSubscriptionsFilterGroup,
-		Read:     readAnnouncementsServiceAnnouncementSubscriptionsFilterGroup,
-		Update:   updateAnnouncementsServiceAnnouncementSubscriptionsFilterGroup,
-		Delete:   deleteAnnouncementsServiceAnnouncementSubscriptionsFilterGroup,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createAnnouncementsServiceAnnouncementSubscriptionsFilterGroup,
+		ReadContext:   readAnnouncementsServiceAnnouncementSubscriptionsFilterGroup,
+		UpdateContext: updateAnnouncementsServiceAnnouncementSubscriptionsFilterGroup,
+		DeleteContext: deleteAnnouncementsServiceAnnouncementSubscriptionsFilterGroup,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"announcement_subscription_id": {
@@ -66,33 +67,33 @@ func AnnouncementsServiceAnnouncementSubscriptionsFilterGroupResource() *schema.
 	}
 }
 
-func createAnnouncementsServiceAnnouncementSubscriptionsFilterGroup(d *schema.ResourceData, m interface{}) error {
+func createAnnouncementsServiceAnnouncementSubscriptionsFilterGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AnnouncementsServiceAnnouncementSubscriptionsFilterGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnnouncementSubscriptionClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readAnnouncementsServiceAnnouncementSubscriptionsFilterGroup(d *schema.ResourceData, m interface{}) error {
+func readAnnouncementsServiceAnnouncementSubscriptionsFilterGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func updateAnnouncementsServiceAnnouncementSubscriptionsFilterGroup(d *schema.ResourceData, m interface{}) error {
+func updateAnnouncementsServiceAnnouncementSubscriptionsFilterGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AnnouncementsServiceAnnouncementSubscriptionsFilterGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnnouncementSubscriptionClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteAnnouncementsServiceAnnouncementSubscriptionsFilterGroup(d *schema.ResourceData, m interface{}) error {
+func deleteAnnouncementsServiceAnnouncementSubscriptionsFilterGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AnnouncementsServiceAnnouncementSubscriptionsFilterGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AnnouncementSubscriptionClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type AnnouncementsServiceAnnouncementSubscriptionsFilterGroupResourceCrud struct {
@@ -106,7 +107,7 @@ func (s *AnnouncementsServiceAnnouncementSubscriptionsFilterGroupResourceCrud) I
 	return s.D.Get("announcement_subscription_id").(string)
 }
 
-func (s *AnnouncementsServiceAnnouncementSubscriptionsFilterGroupResourceCrud) Create() error {
+func (s *AnnouncementsServiceAnnouncementSubscriptionsFilterGroupResourceCrud) Create(ctx context.Context) error {
 	request := oci_announcements_service.CreateFilterGroupRequest{}
 
 	if announcementSubscriptionId, ok := s.D.GetOkExists("announcement_subscription_id"); ok {
@@ -138,7 +139,7 @@ func (s *AnnouncementsServiceAnnouncementSubscriptionsFilterGroupResourceCrud) C
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "announcements_service")
 
-	response, err := s.Client.CreateFilterGroup(context.Background(), request)
+	response, err := s.Client.CreateFilterGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -147,7 +148,7 @@ func (s *AnnouncementsServiceAnnouncementSubscriptionsFilterGroupResourceCrud) C
 	return nil
 }
 
-func (s *AnnouncementsServiceAnnouncementSubscriptionsFilterGroupResourceCrud) Update() error {
+func (s *AnnouncementsServiceAnnouncementSubscriptionsFilterGroupResourceCrud) Update(ctx context.Context) error {
 	request := oci_announcements_service.UpdateFilterGroupRequest{}
 
 	if announcementSubscriptionId, ok := s.D.GetOkExists("announcement_subscription_id"); ok {
@@ -179,7 +180,7 @@ func (s *AnnouncementsServiceAnnouncementSubscriptionsFilterGroupResourceCrud) U
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "announcements_service")
 
-	response, err := s.Client.UpdateFilterGroup(context.Background(), request)
+	response, err := s.Client.UpdateFilterGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -188,7 +189,7 @@ func (s *AnnouncementsServiceAnnouncementSubscriptionsFilterGroupResourceCrud) U
 	return nil
 }
 
-func (s *AnnouncementsServiceAnnouncementSubscriptionsFilterGroupResourceCrud) Delete() error {
+func (s *AnnouncementsServiceAnnouncementSubscriptionsFilterGroupResourceCrud) Delete(ctx context.Context) error {
 	request := oci_announcements_service.DeleteFilterGroupRequest{}
 
 	if announcementSubscriptionId, ok := s.D.GetOkExists("announcement_subscription_id"); ok {
@@ -203,7 +204,7 @@ func (s *AnnouncementsServiceAnnouncementSubscriptionsFilterGroupResourceCrud) D
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "announcements_service")
 
-	_, err := s.Client.DeleteFilterGroup(context.Background(), request)
+	_, err := s.Client.DeleteFilterGroup(ctx, request)
 	return err
 }
 
@@ -8,6 +8,7 @@ import (
 	"io/ioutil"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apigateway "github.com/oracle/oci-go-sdk/v65/apigateway"
 
@@ -17,7 +18,7 @@ import (
 
 func ApigatewayApiContentDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularApigatewayApiContent,
+		ReadContext: readSingularApigatewayApiContent,
 		Schema: map[string]*schema.Schema{
 			"api_id": {
 				Type:     schema.TypeString,
@@ -32,12 +33,12 @@ func ApigatewayApiContentDataSource() *schema.Resource {
 	}
 }
 
-func readSingularApigatewayApiContent(d *schema.ResourceData, m interface{}) error {
+func readSingularApigatewayApiContent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayApiContentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApiGatewayClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ApigatewayApiContentDataSourceCrud struct {
@@ -50,7 +51,7 @@ func (s *ApigatewayApiContentDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ApigatewayApiContentDataSourceCrud) Get() error {
+func (s *ApigatewayApiContentDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_apigateway.GetApiContentRequest{}
 
 	if apiId, ok := s.D.GetOkExists("api_id"); ok {
@@ -60,7 +61,7 @@ func (s *ApigatewayApiContentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apigateway")
 
-	response, err := s.Client.GetApiContent(context.Background(), request)
+	response, err := s.Client.GetApiContent(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package apigateway
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apigateway "github.com/oracle/oci-go-sdk/v65/apigateway"
 
@@ -22,12 +23,12 @@ func ApigatewayApiDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ApigatewayApiResource(), fieldMap, readSingularApigatewayApi)
 }
 
-func readSingularApigatewayApi(d *schema.ResourceData, m interface{}) error {
+func readSingularApigatewayApi(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayApiDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApiGatewayClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ApigatewayApiDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *ApigatewayApiDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ApigatewayApiDataSourceCrud) Get() error {
+func (s *ApigatewayApiDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_apigateway.GetApiRequest{}
 
 	if apiId, ok := s.D.GetOkExists("api_id"); ok {
@@ -50,7 +51,7 @@ func (s *ApigatewayApiDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apigateway")
 
-	response, err := s.Client.GetApi(context.Background(), request)
+	response, err := s.Client.GetApi(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/oracle/oci-go-sdk/v65/apigateway"
 	oci_apigateway "github.com/oracle/oci-go-sdk/v65/apigateway"
@@ -18,7 +19,7 @@ import (
 
 func ApigatewayApiDeploymentSpecificationDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularApigatewayApiDeploymentSpecification,
+		ReadContext: readSingularApigatewayApiDeploymentSpecification,
 		Schema: map[string]*schema.Schema{
 			"api_id": {
 				Type:     schema.TypeString,
@@ -1674,12 +1675,12 @@ func ApigatewayApiDeploymentSpecificationDataSource() *schema.Resource {
 	}
 }
 
-func readSingularApigatewayApiDeploymentSpecification(d *schema.ResourceData, m interface{}) error {
+func readSingularApigatewayApiDeploymentSpecification(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayApiDeploymentSpecificationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApiGatewayClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ApigatewayApiDeploymentSpecificationDataSourceCrud struct {
@@ -1692,7 +1693,7 @@ func (s *ApigatewayApiDeploymentSpecificationDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ApigatewayApiDeploymentSpecificationDataSourceCrud) Get() error {
+func (s *ApigatewayApiDeploymentSpecificationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_apigateway.GetApiDeploymentSpecificationRequest{}
 
 	if apiId, ok := s.D.GetOkExists("api_id"); ok {
@@ -1702,7 +1703,7 @@ func (s *ApigatewayApiDeploymentSpecificationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apigateway")
 
-	response, err := s.Client.GetApiDeploymentSpecification(context.Background(), request)
+	response, err := s.Client.GetApiDeploymentSpecification(ctx, request)
 	if err != nil {
 		return err
 	}
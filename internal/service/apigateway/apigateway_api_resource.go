@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -25,11 +26,11 @@ func ApigatewayApiResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createApigatewayApi,
-		Read:     readApigatewayApi,
-		Update:   updateApigatewayApi,
-		Delete:   deleteApigatewayApi,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createApigatewayApi,
+		ReadContext:   readApigatewayApi,
+		UpdateContext: updateApigatewayApi,
+		DeleteContext: deleteApigatewayApi,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -108,40 +109,40 @@ func ApigatewayApiResource() *schema.Resource {
 	}
 }
 
-func createApigatewayApi(d *schema.ResourceData, m interface{}) error {
+func createApigatewayApi(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayApiResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApiGatewayClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).ApigatewayWorkRequestsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readApigatewayApi(d *schema.ResourceData, m interface{}) error {
+func readApigatewayApi(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayApiResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApiGatewayClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateApigatewayApi(d *schema.ResourceData, m interface{}) error {
+func updateApigatewayApi(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayApiResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApiGatewayClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).ApigatewayWorkRequestsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteApigatewayApi(d *schema.ResourceData, m interface{}) error {
+func deleteApigatewayApi(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayApiResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApiGatewayClient()
 	sync.DisableNotFoundRetries = true
 	sync.WorkRequestClient = m.(*client.OracleClients).ApigatewayWorkRequestsClient()
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type ApigatewayApiResourceCrud struct {
@@ -180,7 +181,7 @@ func (s *ApigatewayApiResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *ApigatewayApiResourceCrud) Create() error {
+func (s *ApigatewayApiResourceCrud) Create(ctx context.Context) error {
 	request := oci_apigateway.CreateApiRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -212,30 +213,30 @@ func (s *ApigatewayApiResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.CreateApi(context.Background(), request)
+	response, err := s.Client.CreateApi(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	err = s.getApiFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway"), oci_apigateway.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	err = s.getApiFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway"), oci_apigateway.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
-	return apiWaitForValidation(response.Id, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries, s.Client)
+	return apiWaitForValidation(ctx, response.Id, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries, s.Client)
 }
 
-func (s *ApigatewayApiResourceCrud) getApiFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *ApigatewayApiResourceCrud) getApiFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_apigateway.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	apiId, err := apiWaitForWorkRequest(workId, "api",
+	apiId, err := apiWaitForWorkRequest(ctx, workId, "api",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.WorkRequestClient)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, apiId)
-		_, cancelErr := s.WorkRequestClient.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.WorkRequestClient.CancelWorkRequest(ctx,
 			oci_apigateway.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -249,7 +250,7 @@ func (s *ApigatewayApiResourceCrud) getApiFromWorkRequest(workId *string, retryP
 	}
 	s.D.SetId(*apiId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func apiWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -275,7 +276,7 @@ func apiWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_comm
 	}
 }
 
-func apiWaitForWorkRequest(wId *string, entityType string, action oci_apigateway.WorkRequestResourceActionTypeEnum,
+func apiWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_apigateway.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_apigateway.WorkRequestsClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "apigateway")
 	retryPolicy.ShouldRetryOperation = apiWorkRequestShouldRetryFunc(timeout)
@@ -294,7 +295,7 @@ func apiWaitForWorkRequest(wId *string, entityType string, action oci_apigateway
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_apigateway.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -321,14 +322,14 @@ func apiWaitForWorkRequest(wId *string, entityType string, action oci_apigateway
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_apigateway.WorkRequestStatusFailed || response.Status == oci_apigateway.WorkRequestStatusCanceled {
-		return nil, getErrorFromApigatewayApiWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromApigatewayApiWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromApigatewayApiWorkRequest(client *oci_apigateway.WorkRequestsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_apigateway.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromApigatewayApiWorkRequest(ctx context.Context, client *oci_apigateway.WorkRequestsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_apigateway.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_apigateway.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -350,7 +351,7 @@ func getErrorFromApigatewayApiWorkRequest(client *oci_apigateway.WorkRequestsCli
 	return workRequestErr
 }
 
-func (s *ApigatewayApiResourceCrud) Get() error {
+func (s *ApigatewayApiResourceCrud) Get(ctx context.Context) error {
 	request := oci_apigateway.GetApiRequest{}
 
 	tmp := s.D.Id()
@@ -358,7 +359,7 @@ func (s *ApigatewayApiResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.GetApi(context.Background(), request)
+	response, err := s.Client.GetApi(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -367,11 +368,11 @@ func (s *ApigatewayApiResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ApigatewayApiResourceCrud) Update() error {
+func (s *ApigatewayApiResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -406,20 +407,20 @@ func (s *ApigatewayApiResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.UpdateApi(context.Background(), request)
+	response, err := s.Client.UpdateApi(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	err = s.getApiFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway"), oci_apigateway.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	err = s.getApiFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway"), oci_apigateway.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return err
 	}
-	return apiWaitForValidation(&apiId, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
+	return apiWaitForValidation(ctx, &apiId, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 }
 
-func (s *ApigatewayApiResourceCrud) Delete() error {
+func (s *ApigatewayApiResourceCrud) Delete(ctx context.Context) error {
 	request := oci_apigateway.DeleteApiRequest{}
 
 	tmp := s.D.Id()
@@ -427,14 +428,14 @@ func (s *ApigatewayApiResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.DeleteApi(context.Background(), request)
+	response, err := s.Client.DeleteApi(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := apiWaitForWorkRequest(workId, "api",
+	_, delWorkRequestErr := apiWaitForWorkRequest(ctx, workId, "api",
 		oci_apigateway.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.WorkRequestClient)
 	return delWorkRequestErr
 }
@@ -541,7 +542,7 @@ func ApiValidationResultToMap(obj oci_apigateway.ApiValidationResult) map[string
 	return result
 }
 
-func (s *ApigatewayApiResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *ApigatewayApiResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_apigateway.ChangeApiCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -552,13 +553,13 @@ func (s *ApigatewayApiResourceCrud) updateCompartment(compartment interface{}) e
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.ChangeApiCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeApiCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getApiFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway"), oci_apigateway.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getApiFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway"), oci_apigateway.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func apiResourceShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -581,7 +582,7 @@ func apiResourceShouldRetryFunc(timeout time.Duration) func(response oci_common.
 	}
 }
 
-func apiWaitForValidation(apiId *string, timeout time.Duration, disableFoundRetries bool, client *oci_apigateway.ApiGatewayClient) error {
+func apiWaitForValidation(ctx context.Context, apiId *string, timeout time.Duration, disableFoundRetries bool, client *oci_apigateway.ApiGatewayClient) error {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "apigateway")
 	retryPolicy.ShouldRetryOperation = apiResourceShouldRetryFunc(timeout)
 
@@ -600,7 +601,7 @@ func apiWaitForValidation(apiId *string, timeout time.Duration, disableFoundRetr
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetApi(context.Background(),
+			response, err = client.GetApi(ctx,
 				oci_apigateway.GetApiRequest{
 					ApiId: apiId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -6,6 +6,7 @@ package apigateway
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apigateway "github.com/oracle/oci-go-sdk/v65/apigateway"
 
@@ -15,7 +16,7 @@ import (
 
 func ApigatewayApiValidationDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularApigatewayApiValidation,
+		ReadContext: readSingularApigatewayApiValidation,
 		Schema: map[string]*schema.Schema{
 			"api_id": {
 				Type:     schema.TypeString,
@@ -82,12 +83,12 @@ func ApigatewayApiValidationDataSource() *schema.Resource {
 	}
 }
 
-func readSingularApigatewayApiValidation(d *schema.ResourceData, m interface{}) error {
+func readSingularApigatewayApiValidation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayApiValidationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApiGatewayClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ApigatewayApiValidationDataSourceCrud struct {
@@ -100,7 +101,7 @@ func (s *ApigatewayApiValidationDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ApigatewayApiValidationDataSourceCrud) Get() error {
+func (s *ApigatewayApiValidationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_apigateway.GetApiValidationsRequest{}
 
 	if apiId, ok := s.D.GetOkExists("api_id"); ok {
@@ -110,7 +111,7 @@ func (s *ApigatewayApiValidationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apigateway")
 
-	response, err := s.Client.GetApiValidations(context.Background(), request)
+	response, err := s.Client.GetApiValidations(ctx, request)
 	if err != nil {
 		return err
 	}
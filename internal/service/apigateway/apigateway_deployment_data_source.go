@@ -6,6 +6,7 @@ package apigateway
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apigateway "github.com/oracle/oci-go-sdk/v65/apigateway"
 
@@ -22,12 +23,12 @@ func ApigatewayDeploymentDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ApigatewayDeploymentResource(), fieldMap, readSingularApigatewayDeployment)
 }
 
-func readSingularApigatewayDeployment(d *schema.ResourceData, m interface{}) error {
+func readSingularApigatewayDeployment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayDeploymentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DeploymentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ApigatewayDeploymentDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *ApigatewayDeploymentDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ApigatewayDeploymentDataSourceCrud) Get() error {
+func (s *ApigatewayDeploymentDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_apigateway.GetDeploymentRequest{}
 
 	if deploymentId, ok := s.D.GetOkExists("deployment_id"); ok {
@@ -50,7 +51,7 @@ func (s *ApigatewayDeploymentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apigateway")
 
-	response, err := s.Client.GetDeployment(context.Background(), request)
+	response, err := s.Client.GetDeployment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -27,11 +28,11 @@ func ApigatewayGatewayResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createApigatewayGateway,
-		Read:     readApigatewayGateway,
-		Update:   updateApigatewayGateway,
-		Delete:   deleteApigatewayGateway,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createApigatewayGateway,
+		ReadContext:   readApigatewayGateway,
+		UpdateContext: updateApigatewayGateway,
+		DeleteContext: deleteApigatewayGateway,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -244,40 +245,40 @@ func ApigatewayGatewayResource() *schema.Resource {
 	}
 }
 
-func createApigatewayGateway(d *schema.ResourceData, m interface{}) error {
+func createApigatewayGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GatewayClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).ApigatewayWorkRequestsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readApigatewayGateway(d *schema.ResourceData, m interface{}) error {
+func readApigatewayGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GatewayClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateApigatewayGateway(d *schema.ResourceData, m interface{}) error {
+func updateApigatewayGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GatewayClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).ApigatewayWorkRequestsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteApigatewayGateway(d *schema.ResourceData, m interface{}) error {
+func deleteApigatewayGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GatewayClient()
 	sync.DisableNotFoundRetries = true
 	sync.WorkRequestClient = m.(*client.OracleClients).ApigatewayWorkRequestsClient()
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type ApigatewayGatewayResourceCrud struct {
@@ -316,7 +317,7 @@ func (s *ApigatewayGatewayResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *ApigatewayGatewayResourceCrud) Create() error {
+func (s *ApigatewayGatewayResourceCrud) Create(ctx context.Context) error {
 	request := oci_apigateway.CreateGatewayRequest{}
 
 	if caBundles, ok := s.D.GetOkExists("ca_bundles"); ok {
@@ -399,26 +400,26 @@ func (s *ApigatewayGatewayResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.CreateGateway(context.Background(), request)
+	response, err := s.Client.CreateGateway(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getGatewayFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway"), oci_apigateway.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getGatewayFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway"), oci_apigateway.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *ApigatewayGatewayResourceCrud) getGatewayFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *ApigatewayGatewayResourceCrud) getGatewayFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_apigateway.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	gatewayId, err := gatewayWaitForWorkRequest(workId, "gateway",
+	gatewayId, err := gatewayWaitForWorkRequest(ctx, workId, "gateway",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.WorkRequestClient)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, gatewayId)
-		_, cancelErr := s.WorkRequestClient.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.WorkRequestClient.CancelWorkRequest(ctx,
 			oci_apigateway.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -432,7 +433,7 @@ func (s *ApigatewayGatewayResourceCrud) getGatewayFromWorkRequest(workId *string
 	}
 	s.D.SetId(*gatewayId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func gatewayWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -458,7 +459,7 @@ func gatewayWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_
 	}
 }
 
-func gatewayWaitForWorkRequest(wId *string, entityType string, action oci_apigateway.WorkRequestResourceActionTypeEnum,
+func gatewayWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_apigateway.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_apigateway.WorkRequestsClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "apigateway")
 	retryPolicy.ShouldRetryOperation = gatewayWorkRequestShouldRetryFunc(timeout)
@@ -477,7 +478,7 @@ func gatewayWaitForWorkRequest(wId *string, entityType string, action oci_apigat
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_apigateway.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -506,14 +507,14 @@ func gatewayWaitForWorkRequest(wId *string, entityType string, action oci_apigat
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_apigateway.WorkRequestStatusFailed || response.Status == oci_apigateway.WorkRequestStatusCanceled {
-		return nil, getErrorFromApigatewayGatewayWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromApigatewayGatewayWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromApigatewayGatewayWorkRequest(client *oci_apigateway.WorkRequestsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_apigateway.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromApigatewayGatewayWorkRequest(ctx context.Context, client *oci_apigateway.WorkRequestsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_apigateway.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_apigateway.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -535,7 +536,7 @@ func getErrorFromApigatewayGatewayWorkRequest(client *oci_apigateway.WorkRequest
 	return workRequestErr
 }
 
-func (s *ApigatewayGatewayResourceCrud) Get() error {
+func (s *ApigatewayGatewayResourceCrud) Get(ctx context.Context) error {
 	request := oci_apigateway.GetGatewayRequest{}
 
 	tmp := s.D.Id()
@@ -543,7 +544,7 @@ func (s *ApigatewayGatewayResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.GetGateway(context.Background(), request)
+	response, err := s.Client.GetGateway(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -552,11 +553,11 @@ func (s *ApigatewayGatewayResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ApigatewayGatewayResourceCrud) Update() error {
+func (s *ApigatewayGatewayResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -633,16 +634,16 @@ func (s *ApigatewayGatewayResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.UpdateGateway(context.Background(), request)
+	response, err := s.Client.UpdateGateway(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getGatewayFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway"), oci_apigateway.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getGatewayFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway"), oci_apigateway.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *ApigatewayGatewayResourceCrud) Delete() error {
+func (s *ApigatewayGatewayResourceCrud) Delete(ctx context.Context) error {
 	request := oci_apigateway.DeleteGatewayRequest{}
 
 	tmp := s.D.Id()
@@ -650,14 +651,14 @@ func (s *ApigatewayGatewayResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.DeleteGateway(context.Background(), request)
+	response, err := s.Client.DeleteGateway(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := gatewayWaitForWorkRequest(workId, "gateway",
+	_, delWorkRequestErr := gatewayWaitForWorkRequest(ctx, workId, "gateway",
 		oci_apigateway.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.WorkRequestClient)
 	return delWorkRequestErr
 }
@@ -1014,7 +1015,7 @@ func ResponseCacheRespServerToMap(obj oci_apigateway.ResponseCacheRespServer) ma
 	return result
 }
 
-func (s *ApigatewayGatewayResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *ApigatewayGatewayResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_apigateway.ChangeGatewayCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -1025,11 +1026,11 @@ func (s *ApigatewayGatewayResourceCrud) updateCompartment(compartment interface{
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.ChangeGatewayCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeGatewayCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getGatewayFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway"), oci_apigateway.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getGatewayFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway"), oci_apigateway.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
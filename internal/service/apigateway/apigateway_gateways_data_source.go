@@ -6,6 +6,7 @@ package apigateway
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apigateway "github.com/oracle/oci-go-sdk/v65/apigateway"
 
@@ -15,7 +16,7 @@ import (
 
 func ApigatewayGatewaysDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readApigatewayGateways,
+		ReadContext: readApigatewayGateways,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"certificate_id": {
@@ -44,12 +45,12 @@ func ApigatewayGatewaysDataSource() *schema.Resource {
 	}
 }
 
-func readApigatewayGateways(d *schema.ResourceData, m interface{}) error {
+func readApigatewayGateways(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayGatewaysDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GatewayClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ApigatewayGatewaysDataSourceCrud struct {
@@ -62,7 +63,7 @@ func (s *ApigatewayGatewaysDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ApigatewayGatewaysDataSourceCrud) Get() error {
+func (s *ApigatewayGatewaysDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_apigateway.ListGatewaysRequest{}
 
 	if certificateId, ok := s.D.GetOkExists("certificate_id"); ok {
@@ -86,7 +87,7 @@ func (s *ApigatewayGatewaysDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apigateway")
 
-	response, err := s.Client.ListGateways(context.Background(), request)
+	response, err := s.Client.ListGateways(ctx, request)
 	if err != nil {
 		return err
 	}
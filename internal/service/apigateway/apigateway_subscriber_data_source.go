@@ -6,6 +6,7 @@ package apigateway
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apigateway "github.com/oracle/oci-go-sdk/v65/apigateway"
 
@@ -22,12 +23,12 @@ func ApigatewaySubscriberDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ApigatewaySubscriberResource(), fieldMap, readSingularApigatewaySubscriber)
 }
 
-func readSingularApigatewaySubscriber(d *schema.ResourceData, m interface{}) error {
+func readSingularApigatewaySubscriber(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewaySubscriberDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SubscribersClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ApigatewaySubscriberDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *ApigatewaySubscriberDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ApigatewaySubscriberDataSourceCrud) Get() error {
+func (s *ApigatewaySubscriberDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_apigateway.GetSubscriberRequest{}
 
 	if subscriberId, ok := s.D.GetOkExists("subscriber_id"); ok {
@@ -50,7 +51,7 @@ func (s *ApigatewaySubscriberDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apigateway")
 
-	response, err := s.Client.GetSubscriber(context.Background(), request)
+	response, err := s.Client.GetSubscriber(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -25,11 +26,11 @@ func ApigatewayUsagePlanResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createApigatewayUsagePlan,
-		Read:     readApigatewayUsagePlan,
-		Update:   updateApigatewayUsagePlan,
-		Delete:   deleteApigatewayUsagePlan,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createApigatewayUsagePlan,
+		ReadContext:   readApigatewayUsagePlan,
+		UpdateContext: updateApigatewayUsagePlan,
+		DeleteContext: deleteApigatewayUsagePlan,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -174,40 +175,40 @@ func ApigatewayUsagePlanResource() *schema.Resource {
 	}
 }
 
-func createApigatewayUsagePlan(d *schema.ResourceData, m interface{}) error {
+func createApigatewayUsagePlan(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayUsagePlanResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsagePlansClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).ApigatewayWorkRequestsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readApigatewayUsagePlan(d *schema.ResourceData, m interface{}) error {
+func readApigatewayUsagePlan(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayUsagePlanResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsagePlansClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateApigatewayUsagePlan(d *schema.ResourceData, m interface{}) error {
+func updateApigatewayUsagePlan(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayUsagePlanResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsagePlansClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).ApigatewayWorkRequestsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteApigatewayUsagePlan(d *schema.ResourceData, m interface{}) error {
+func deleteApigatewayUsagePlan(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApigatewayUsagePlanResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsagePlansClient()
 	sync.DisableNotFoundRetries = true
 	sync.WorkRequestClient = m.(*client.OracleClients).ApigatewayWorkRequestsClient()
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type ApigatewayUsagePlanResourceCrud struct {
@@ -246,7 +247,7 @@ func (s *ApigatewayUsagePlanResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *ApigatewayUsagePlanResourceCrud) Create() error {
+func (s *ApigatewayUsagePlanResourceCrud) Create(ctx context.Context) error {
 	request := oci_apigateway.CreateUsagePlanRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -290,26 +291,26 @@ func (s *ApigatewayUsagePlanResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.CreateUsagePlan(context.Background(), request)
+	response, err := s.Client.CreateUsagePlan(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getUsagePlanFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway"), oci_apigateway.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getUsagePlanFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway"), oci_apigateway.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *ApigatewayUsagePlanResourceCrud) getUsagePlanFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *ApigatewayUsagePlanResourceCrud) getUsagePlanFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_apigateway.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	usagePlanId, err := usagePlanWaitForWorkRequest(workId, "usageplan",
+	usagePlanId, err := usagePlanWaitForWorkRequest(ctx, workId, "usageplan",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.WorkRequestClient)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, usagePlanId)
-		_, cancelErr := s.WorkRequestClient.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.WorkRequestClient.CancelWorkRequest(ctx,
 			oci_apigateway.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -323,7 +324,7 @@ func (s *ApigatewayUsagePlanResourceCrud) getUsagePlanFromWorkRequest(workId *st
 	}
 	s.D.SetId(*usagePlanId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func usagePlanWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -349,7 +350,7 @@ func usagePlanWorkRequestShouldRetryFunc(timeout time.Duration) func(response oc
 	}
 }
 
-func usagePlanWaitForWorkRequest(wId *string, entityType string, action oci_apigateway.WorkRequestResourceActionTypeEnum,
+func usagePlanWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_apigateway.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_apigateway.WorkRequestsClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "apigateway")
 	retryPolicy.ShouldRetryOperation = usagePlanWorkRequestShouldRetryFunc(timeout)
@@ -368,7 +369,7 @@ func usagePlanWaitForWorkRequest(wId *string, entityType string, action oci_apig
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_apigateway.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -397,14 +398,14 @@ func usagePlanWaitForWorkRequest(wId *string, entityType string, action oci_apig
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_apigateway.WorkRequestStatusFailed || response.Status == oci_apigateway.WorkRequestStatusCanceled {
-		return nil, getErrorFromApigatewayUsagePlanWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromApigatewayUsagePlanWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromApigatewayUsagePlanWorkRequest(client *oci_apigateway.WorkRequestsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_apigateway.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromApigatewayUsagePlanWorkRequest(ctx context.Context, client *oci_apigateway.WorkRequestsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_apigateway.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_apigateway.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -426,7 +427,7 @@ func getErrorFromApigatewayUsagePlanWorkRequest(client *oci_apigateway.WorkReque
 	return workRequestErr
 }
 
-func (s *ApigatewayUsagePlanResourceCrud) Get() error {
+func (s *ApigatewayUsagePlanResourceCrud) Get(ctx context.Context) error {
 	request := oci_apigateway.GetUsagePlanRequest{}
 
 	tmp := s.D.Id()
@@ -434,7 +435,7 @@ func (s *ApigatewayUsagePlanResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.GetUsagePlan(context.Background(), request)
+	response, err := s.Client.GetUsagePlan(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -443,11 +444,11 @@ func (s *ApigatewayUsagePlanResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ApigatewayUsagePlanResourceCrud) Update() error {
+func (s *ApigatewayUsagePlanResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -494,16 +495,16 @@ func (s *ApigatewayUsagePlanResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.UpdateUsagePlan(context.Background(), request)
+	response, err := s.Client.UpdateUsagePlan(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getUsagePlanFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway"), oci_apigateway.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getUsagePlanFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway"), oci_apigateway.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *ApigatewayUsagePlanResourceCrud) Delete() error {
+func (s *ApigatewayUsagePlanResourceCrud) Delete(ctx context.Context) error {
 	request := oci_apigateway.DeleteUsagePlanRequest{}
 
 	tmp := s.D.Id()
@@ -511,14 +512,14 @@ func (s *ApigatewayUsagePlanResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.DeleteUsagePlan(context.Background(), request)
+	response, err := s.Client.DeleteUsagePlan(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := usagePlanWaitForWorkRequest(workId, "usageplan",
+	_, delWorkRequestErr := usagePlanWaitForWorkRequest(ctx, workId, "usageplan",
 		oci_apigateway.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.WorkRequestClient)
 	return delWorkRequestErr
 }
@@ -795,7 +796,7 @@ func UsagePlanSummaryToMap(obj oci_apigateway.UsagePlanSummary) map[string]inter
 	return result
 }
 
-func (s *ApigatewayUsagePlanResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *ApigatewayUsagePlanResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_apigateway.ChangeUsagePlanCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -806,11 +807,11 @@ func (s *ApigatewayUsagePlanResourceCrud) updateCompartment(compartment interfac
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway")
 
-	response, err := s.Client.ChangeUsagePlanCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeUsagePlanCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getUsagePlanFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway"), oci_apigateway.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getUsagePlanFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apigateway"), oci_apigateway.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
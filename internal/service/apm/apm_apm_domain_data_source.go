@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apm "github.com/oracle/oci-go-sdk/v65/apmcontrolplane"
 )
@@ -22,12 +23,12 @@ func ApmApmDomainDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ApmApmDomainResource(), fieldMap, readSingularApmApmDomain)
 }
 
-func readSingularApmApmDomain(d *schema.ResourceData, m interface{}) error {
+func readSingularApmApmDomain(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmApmDomainDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmDomainClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ApmApmDomainDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *ApmApmDomainDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ApmApmDomainDataSourceCrud) Get() error {
+func (s *ApmApmDomainDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_apm.GetApmDomainRequest{}
 
 	if apmDomainId, ok := s.D.GetOkExists("apm_domain_id"); ok {
@@ -50,7 +51,7 @@ func (s *ApmApmDomainDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm")
 
-	response, err := s.Client.GetApmDomain(context.Background(), request)
+	response, err := s.Client.GetApmDomain(ctx, request)
 	if err != nil {
 		return err
 	}
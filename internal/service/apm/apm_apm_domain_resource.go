@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,11 +25,11 @@ func ApmApmDomainResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createApmApmDomain,
-		Read:     readApmApmDomain,
-		Update:   updateApmApmDomain,
-		Delete:   deleteApmApmDomain,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createApmApmDomain,
+		ReadContext:   readApmApmDomain,
+		UpdateContext: updateApmApmDomain,
+		DeleteContext: deleteApmApmDomain,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -87,37 +88,37 @@ func ApmApmDomainResource() *schema.Resource {
 	}
 }
 
-func createApmApmDomain(d *schema.ResourceData, m interface{}) error {
+func createApmApmDomain(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmApmDomainResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmDomainClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readApmApmDomain(d *schema.ResourceData, m interface{}) error {
+func readApmApmDomain(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmApmDomainResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmDomainClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateApmApmDomain(d *schema.ResourceData, m interface{}) error {
+func updateApmApmDomain(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmApmDomainResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmDomainClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteApmApmDomain(d *schema.ResourceData, m interface{}) error {
+func deleteApmApmDomain(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmApmDomainResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmDomainClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type ApmApmDomainResourceCrud struct {
@@ -155,7 +156,7 @@ func (s *ApmApmDomainResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *ApmApmDomainResourceCrud) Create() error {
+func (s *ApmApmDomainResourceCrud) Create(ctx context.Context) error {
 	request := oci_apm.CreateApmDomainRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -192,20 +193,20 @@ func (s *ApmApmDomainResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm")
 
-	response, err := s.Client.CreateApmDomain(context.Background(), request)
+	response, err := s.Client.CreateApmDomain(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getApmDomainFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm"), oci_apm.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getApmDomainFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm"), oci_apm.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *ApmApmDomainResourceCrud) getApmDomainFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *ApmApmDomainResourceCrud) getApmDomainFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_apm.ActionTypesEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	apmDomainId, err := apmDomainWaitForWorkRequest(workId, "apmDomain",
+	apmDomainId, err := apmDomainWaitForWorkRequest(ctx, workId, "apmDomain",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -213,7 +214,7 @@ func (s *ApmApmDomainResourceCrud) getApmDomainFromWorkRequest(workId *string, r
 	}
 	s.D.SetId(*apmDomainId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func apmDomainWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -239,7 +240,7 @@ func apmDomainWorkRequestShouldRetryFunc(timeout time.Duration) func(response oc
 	}
 }
 
-func apmDomainWaitForWorkRequest(wId *string, entityType string, action oci_apm.ActionTypesEnum,
+func apmDomainWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_apm.ActionTypesEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_apm.ApmDomainClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "apm")
 	retryPolicy.ShouldRetryOperation = apmDomainWorkRequestShouldRetryFunc(timeout)
@@ -258,7 +259,7 @@ func apmDomainWaitForWorkRequest(wId *string, entityType string, action oci_apm.
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_apm.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -287,14 +288,14 @@ func apmDomainWaitForWorkRequest(wId *string, entityType string, action oci_apm.
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_apm.OperationStatusFailed || response.Status == oci_apm.OperationStatusCanceled {
-		return nil, getErrorFromApmControlPlaneWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromApmControlPlaneWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromApmControlPlaneWorkRequest(client *oci_apm.ApmDomainClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_apm.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromApmControlPlaneWorkRequest(ctx context.Context, client *oci_apm.ApmDomainClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_apm.ActionTypesEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_apm.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -316,7 +317,7 @@ func getErrorFromApmControlPlaneWorkRequest(client *oci_apm.ApmDomainClient, wor
 	return workRequestErr
 }
 
-func (s *ApmApmDomainResourceCrud) Get() error {
+func (s *ApmApmDomainResourceCrud) Get(ctx context.Context) error {
 	request := oci_apm.GetApmDomainRequest{}
 
 	tmp := s.D.Id()
@@ -324,7 +325,7 @@ func (s *ApmApmDomainResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm")
 
-	response, err := s.Client.GetApmDomain(context.Background(), request)
+	response, err := s.Client.GetApmDomain(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -333,11 +334,11 @@ func (s *ApmApmDomainResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ApmApmDomainResourceCrud) Update() error {
+func (s *ApmApmDomainResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -372,16 +373,16 @@ func (s *ApmApmDomainResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm")
 
-	response, err := s.Client.UpdateApmDomain(context.Background(), request)
+	response, err := s.Client.UpdateApmDomain(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getApmDomainFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm"), oci_apm.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getApmDomainFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm"), oci_apm.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *ApmApmDomainResourceCrud) Delete() error {
+func (s *ApmApmDomainResourceCrud) Delete(ctx context.Context) error {
 	request := oci_apm.DeleteApmDomainRequest{}
 
 	tmp := s.D.Id()
@@ -389,14 +390,14 @@ func (s *ApmApmDomainResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm")
 
-	response, err := s.Client.DeleteApmDomain(context.Background(), request)
+	response, err := s.Client.DeleteApmDomain(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := apmDomainWaitForWorkRequest(workId, "apmDomain",
+	_, delWorkRequestErr := apmDomainWaitForWorkRequest(ctx, workId, "apmDomain",
 		oci_apm.ActionTypesDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -441,7 +442,7 @@ func (s *ApmApmDomainResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *ApmApmDomainResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *ApmApmDomainResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_apm.ChangeApmDomainCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -452,11 +453,11 @@ func (s *ApmApmDomainResourceCrud) updateCompartment(compartment interface{}) er
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm")
 
-	response, err := s.Client.ChangeApmDomainCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeApmDomainCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getApmDomainFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm"), oci_apm.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getApmDomainFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm"), oci_apm.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
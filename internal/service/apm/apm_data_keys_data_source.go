@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apm "github.com/oracle/oci-go-sdk/v65/apmcontrolplane"
 )
 
 func ApmDataKeysDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readApmDataKeys,
+		ReadContext: readApmDataKeys,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"apm_domain_id": {
@@ -55,12 +56,12 @@ func ApmDataKeysDataSource() *schema.Resource {
 	}
 }
 
-func readApmDataKeys(d *schema.ResourceData, m interface{}) error {
+func readApmDataKeys(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmDataKeysDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmDomainClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ApmDataKeysDataSourceCrud struct {
@@ -73,7 +74,7 @@ func (s *ApmDataKeysDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ApmDataKeysDataSourceCrud) Get() error {
+func (s *ApmDataKeysDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_apm.ListDataKeysRequest{}
 
 	if apmDomainId, ok := s.D.GetOkExists("apm_domain_id"); ok {
@@ -87,7 +88,7 @@ func (s *ApmDataKeysDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm")
 
-	response, err := s.Client.ListDataKeys(context.Background(), request)
+	response, err := s.Client.ListDataKeys(ctx, request)
 	if err != nil {
 		return err
 	}
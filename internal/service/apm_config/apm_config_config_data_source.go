@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apm_config "github.com/oracle/oci-go-sdk/v65/apmconfig"
 )
@@ -27,12 +28,12 @@ func ApmConfigConfigDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ApmConfigConfigResource(), fieldMap, readSingularApmConfigConfig)
 }
 
-func readSingularApmConfigConfig(d *schema.ResourceData, m interface{}) error {
+func readSingularApmConfigConfig(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmConfigConfigDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ConfigClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ApmConfigConfigDataSourceCrud struct {
@@ -45,7 +46,7 @@ func (s *ApmConfigConfigDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ApmConfigConfigDataSourceCrud) Get() error {
+func (s *ApmConfigConfigDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_apm_config.GetConfigRequest{}
 
 	if apmDomainId, ok := s.D.GetOkExists("apm_domain_id"); ok {
@@ -67,7 +68,7 @@ func (s *ApmConfigConfigDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm_config")
 
-	response, err := s.Client.GetConfig(context.Background(), request)
+	response, err := s.Client.GetConfig(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -26,11 +27,11 @@ func ApmConfigConfigResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createApmConfigConfig,
-		Read:     readApmConfigConfig,
-		Update:   updateApmConfigConfig,
-		Delete:   deleteApmConfigConfig,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createApmConfigConfig,
+		ReadContext:   readApmConfigConfig,
+		UpdateContext: updateApmConfigConfig,
+		DeleteContext: deleteApmConfigConfig,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"apm_domain_id": {
@@ -224,37 +225,37 @@ func ApmConfigConfigResource() *schema.Resource {
 	}
 }
 
-func createApmConfigConfig(d *schema.ResourceData, m interface{}) error {
+func createApmConfigConfig(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmConfigConfigResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ConfigClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readApmConfigConfig(d *schema.ResourceData, m interface{}) error {
+func readApmConfigConfig(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmConfigConfigResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ConfigClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateApmConfigConfig(d *schema.ResourceData, m interface{}) error {
+func updateApmConfigConfig(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmConfigConfigResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ConfigClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteApmConfigConfig(d *schema.ResourceData, m interface{}) error {
+func deleteApmConfigConfig(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmConfigConfigResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ConfigClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type ApmConfigConfigResourceCrud struct {
@@ -269,7 +270,7 @@ func (s *ApmConfigConfigResourceCrud) ID() string {
 	return GetConfigCompositeId(*config.GetId(), s.D.Get("apm_domain_id").(string))
 }
 
-func (s *ApmConfigConfigResourceCrud) Create() error {
+func (s *ApmConfigConfigResourceCrud) Create(ctx context.Context) error {
 	request := oci_apm_config.CreateConfigRequest{}
 	err := s.populateTopLevelPolymorphicCreateConfigRequest(&request)
 	if err != nil {
@@ -278,7 +279,7 @@ func (s *ApmConfigConfigResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_config")
 
-	response, err := s.Client.CreateConfig(context.Background(), request)
+	response, err := s.Client.CreateConfig(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -287,7 +288,7 @@ func (s *ApmConfigConfigResourceCrud) Create() error {
 	return nil
 }
 
-func (s *ApmConfigConfigResourceCrud) Get() error {
+func (s *ApmConfigConfigResourceCrud) Get(ctx context.Context) error {
 	request := oci_apm_config.GetConfigRequest{}
 
 	configId, apmDomainId, err := parseConfigCompositeId(s.D.Id())
@@ -300,7 +301,7 @@ func (s *ApmConfigConfigResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_config")
 
-	response, err := s.Client.GetConfig(context.Background(), request)
+	response, err := s.Client.GetConfig(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -309,7 +310,7 @@ func (s *ApmConfigConfigResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ApmConfigConfigResourceCrud) Update() error {
+func (s *ApmConfigConfigResourceCrud) Update(ctx context.Context) error {
 	request := oci_apm_config.UpdateConfigRequest{}
 	err := s.populateTopLevelPolymorphicUpdateConfigRequest(&request)
 	if err != nil {
@@ -318,7 +319,7 @@ func (s *ApmConfigConfigResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_config")
 
-	response, err := s.Client.UpdateConfig(context.Background(), request)
+	response, err := s.Client.UpdateConfig(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -327,7 +328,7 @@ func (s *ApmConfigConfigResourceCrud) Update() error {
 	return nil
 }
 
-func (s *ApmConfigConfigResourceCrud) Delete() error {
+func (s *ApmConfigConfigResourceCrud) Delete(ctx context.Context) error {
 	request := oci_apm_config.DeleteConfigRequest{}
 
 	if tmp := s.D.Id(); tmp != "" {
@@ -342,7 +343,7 @@ func (s *ApmConfigConfigResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_config")
 
-	_, err := s.Client.DeleteConfig(context.Background(), request)
+	_, err := s.Client.DeleteConfig(ctx, request)
 	return err
 }
 
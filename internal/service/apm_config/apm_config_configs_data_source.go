@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apm_config "github.com/oracle/oci-go-sdk/v65/apmconfig"
 )
 
 func ApmConfigConfigsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readApmConfigConfigs,
+		ReadContext: readApmConfigConfigs,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"apm_domain_id": {
@@ -80,12 +81,12 @@ func ApmConfigConfigsDataSource() *schema.Resource {
 	}
 }
 
-func readApmConfigConfigs(d *schema.ResourceData, m interface{}) error {
+func readApmConfigConfigs(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmConfigConfigsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ConfigClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ApmConfigConfigsDataSourceCrud struct {
@@ -98,7 +99,7 @@ func (s *ApmConfigConfigsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ApmConfigConfigsDataSourceCrud) Get() error {
+func (s *ApmConfigConfigsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_apm_config.ListConfigsRequest{}
 
 	if apmDomainId, ok := s.D.GetOkExists("apm_domain_id"); ok {
@@ -175,7 +176,7 @@ func (s *ApmConfigConfigsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm_config")
 
-	response, err := s.Client.ListConfigs(context.Background(), request)
+	response, err := s.Client.ListConfigs(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -184,7 +185,7 @@ func (s *ApmConfigConfigsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListConfigs(context.Background(), request)
+		listResponse, err := s.Client.ListConfigs(ctx, request)
 		if err != nil {
 			return err
 		}
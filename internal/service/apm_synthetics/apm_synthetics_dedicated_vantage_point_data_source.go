@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apm_synthetics "github.com/oracle/oci-go-sdk/v65/apmsynthetics"
 
@@ -27,12 +28,12 @@ func ApmSyntheticsDedicatedVantagePointDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ApmSyntheticsDedicatedVantagePointResource(), fieldMap, readSingularApmSyntheticsDedicatedVantagePoint)
 }
 
-func readSingularApmSyntheticsDedicatedVantagePoint(d *schema.ResourceData, m interface{}) error {
+func readSingularApmSyntheticsDedicatedVantagePoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsDedicatedVantagePointDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ApmSyntheticsDedicatedVantagePointDataSourceCrud struct {
@@ -45,7 +46,7 @@ func (s *ApmSyntheticsDedicatedVantagePointDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ApmSyntheticsDedicatedVantagePointDataSourceCrud) Get() error {
+func (s *ApmSyntheticsDedicatedVantagePointDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_apm_synthetics.GetDedicatedVantagePointRequest{}
 
 	if apmDomainId, ok := s.D.GetOkExists("apm_domain_id"); ok {
@@ -66,7 +67,7 @@ func (s *ApmSyntheticsDedicatedVantagePointDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm_synthetics")
 
-	response, err := s.Client.GetDedicatedVantagePoint(context.Background(), request)
+	response, err := s.Client.GetDedicatedVantagePoint(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -25,11 +26,11 @@ func ApmSyntheticsDedicatedVantagePointResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createApmSyntheticsDedicatedVantagePoint,
-		Read:     readApmSyntheticsDedicatedVantagePoint,
-		Update:   updateApmSyntheticsDedicatedVantagePoint,
-		Delete:   deleteApmSyntheticsDedicatedVantagePoint,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createApmSyntheticsDedicatedVantagePoint,
+		ReadContext:   readApmSyntheticsDedicatedVantagePoint,
+		UpdateContext: updateApmSyntheticsDedicatedVantagePoint,
+		DeleteContext: deleteApmSyntheticsDedicatedVantagePoint,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"apm_domain_id": {
@@ -147,37 +148,37 @@ func ApmSyntheticsDedicatedVantagePointResource() *schema.Resource {
 	}
 }
 
-func createApmSyntheticsDedicatedVantagePoint(d *schema.ResourceData, m interface{}) error {
+func createApmSyntheticsDedicatedVantagePoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsDedicatedVantagePointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readApmSyntheticsDedicatedVantagePoint(d *schema.ResourceData, m interface{}) error {
+func readApmSyntheticsDedicatedVantagePoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsDedicatedVantagePointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateApmSyntheticsDedicatedVantagePoint(d *schema.ResourceData, m interface{}) error {
+func updateApmSyntheticsDedicatedVantagePoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsDedicatedVantagePointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteApmSyntheticsDedicatedVantagePoint(d *schema.ResourceData, m interface{}) error {
+func deleteApmSyntheticsDedicatedVantagePoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsDedicatedVantagePointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type ApmSyntheticsDedicatedVantagePointResourceCrud struct {
@@ -191,7 +192,7 @@ func (s *ApmSyntheticsDedicatedVantagePointResourceCrud) ID() string {
 	return GetDedicatedVantagePointCompositeId(*s.Res.Id, s.D.Get("apm_domain_id").(string))
 }
 
-func (s *ApmSyntheticsDedicatedVantagePointResourceCrud) Create() error {
+func (s *ApmSyntheticsDedicatedVantagePointResourceCrud) Create(ctx context.Context) error {
 	request := oci_apm_synthetics.CreateDedicatedVantagePointRequest{}
 
 	if apmDomainId, ok := s.D.GetOkExists("apm_domain_id"); ok {
@@ -238,7 +239,7 @@ func (s *ApmSyntheticsDedicatedVantagePointResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
 
-	response, err := s.Client.CreateDedicatedVantagePoint(context.Background(), request)
+	response, err := s.Client.CreateDedicatedVantagePoint(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -247,7 +248,7 @@ func (s *ApmSyntheticsDedicatedVantagePointResourceCrud) Create() error {
 	return nil
 }
 
-func (s *ApmSyntheticsDedicatedVantagePointResourceCrud) Get() error {
+func (s *ApmSyntheticsDedicatedVantagePointResourceCrud) Get(ctx context.Context) error {
 	request := oci_apm_synthetics.GetDedicatedVantagePointRequest{}
 
 	if apmDomainId, ok := s.D.GetOkExists("apm_domain_id"); ok {
@@ -268,7 +269,7 @@ func (s *ApmSyntheticsDedicatedVantagePointResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
 
-	response, err := s.Client.GetDedicatedVantagePoint(context.Background(), request)
+	response, err := s.Client.GetDedicatedVantagePoint(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -277,7 +278,7 @@ func (s *ApmSyntheticsDedicatedVantagePointResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ApmSyntheticsDedicatedVantagePointResourceCrud) Update() error {
+func (s *ApmSyntheticsDedicatedVantagePointResourceCrud) Update(ctx context.Context) error {
 	request := oci_apm_synthetics.UpdateDedicatedVantagePointRequest{}
 
 	if apmDomainId, ok := s.D.GetOkExists("apm_domain_id"); ok {
@@ -330,7 +331,7 @@ func (s *ApmSyntheticsDedicatedVantagePointResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
 
-	response, err := s.Client.UpdateDedicatedVantagePoint(context.Background(), request)
+	response, err := s.Client.UpdateDedicatedVantagePoint(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -339,7 +340,7 @@ func (s *ApmSyntheticsDedicatedVantagePointResourceCrud) Update() error {
 	return nil
 }
 
-func (s *ApmSyntheticsDedicatedVantagePointResourceCrud) Delete() error {
+func (s *ApmSyntheticsDedicatedVantagePointResourceCrud) Delete(ctx context.Context) error {
 	request := oci_apm_synthetics.DeleteDedicatedVantagePointRequest{}
 
 	if apmDomainId, ok := s.D.GetOkExists("apm_domain_id"); ok {
@@ -359,7 +360,7 @@ func (s *ApmSyntheticsDedicatedVantagePointResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
 
-	_, err := s.Client.DeleteDedicatedVantagePoint(context.Background(), request)
+	_, err := s.Client.DeleteDedicatedVantagePoint(ctx, request)
 	return err
 }
 
@@ -6,6 +6,7 @@ package apm_synthetics
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apm_synthetics "github.com/oracle/oci-go-sdk/v65/apmsynthetics"
 
@@ -15,7 +16,7 @@ import (
 
 func ApmSyntheticsDedicatedVantagePointsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readApmSyntheticsDedicatedVantagePoints,
+		ReadContext: readApmSyntheticsDedicatedVantagePoints,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"apm_domain_id": {
@@ -52,12 +53,12 @@ func ApmSyntheticsDedicatedVantagePointsDataSource() *schema.Resource {
 	}
 }
 
-func readApmSyntheticsDedicatedVantagePoints(d *schema.ResourceData, m interface{}) error {
+func readApmSyntheticsDedicatedVantagePoints(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsDedicatedVantagePointsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ApmSyntheticsDedicatedVantagePointsDataSourceCrud struct {
@@ -70,7 +71,7 @@ func (s *ApmSyntheticsDedicatedVantagePointsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ApmSyntheticsDedicatedVantagePointsDataSourceCrud) Get() error {
+func (s *ApmSyntheticsDedicatedVantagePointsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_apm_synthetics.ListDedicatedVantagePointsRequest{}
 
 	if apmDomainId, ok := s.D.GetOkExists("apm_domain_id"); ok {
@@ -85,7 +86,7 @@ func (s *ApmSyntheticsDedicatedVantagePointsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm_synthetics")
 
-	response, err := s.Client.ListDedicatedVantagePoints(context.Background(), request)
+	response, err := s.Client.ListDedicatedVantagePoints(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *ApmSyntheticsDedicatedVantagePointsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDedicatedVantagePoints(context.Background(), request)
+		listResponse, err := s.Client.ListDedicatedVantagePoints(ctx, request)
 		if err != nil {
 			return err
 		}
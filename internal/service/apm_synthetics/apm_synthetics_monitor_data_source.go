@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apm_synthetics "github.com/oracle/oci-go-sdk/v65/apmsynthetics"
 )
@@ -27,12 +28,12 @@ func ApmSyntheticsMonitorDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ApmSyntheticsMonitorResource(), fieldMap, readSingularApmSyntheticsMonitor)
 }
 
-func readSingularApmSyntheticsMonitor(d *schema.ResourceData, m interface{}) error {
+func readSingularApmSyntheticsMonitor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsMonitorDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ApmSyntheticsMonitorDataSourceCrud struct {
@@ -45,7 +46,7 @@ func (s *ApmSyntheticsMonitorDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ApmSyntheticsMonitorDataSourceCrud) Get() error {
+func (s *ApmSyntheticsMonitorDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_apm_synthetics.GetMonitorRequest{}
 
 	if apmDomainId, ok := s.D.GetOkExists("apm_domain_id"); ok {
@@ -67,7 +68,7 @@ func (s *ApmSyntheticsMonitorDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm_synthetics")
 
-	response, err := s.Client.GetMonitor(context.Background(), request)
+	response, err := s.Client.GetMonitor(ctx, request)
 	if err != nil {
 		return err
 	}
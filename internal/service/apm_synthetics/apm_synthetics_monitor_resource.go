@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -25,11 +26,11 @@ func ApmSyntheticsMonitorResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createApmSyntheticsMonitor,
-		Read:     readApmSyntheticsMonitor,
-		Update:   updateApmSyntheticsMonitor,
-		Delete:   deleteApmSyntheticsMonitor,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createApmSyntheticsMonitor,
+		ReadContext:   readApmSyntheticsMonitor,
+		UpdateContext: updateApmSyntheticsMonitor,
+		DeleteContext: deleteApmSyntheticsMonitor,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"apm_domain_id": {
@@ -469,37 +470,37 @@ func ApmSyntheticsMonitorResource() *schema.Resource {
 	}
 }
 
-func createApmSyntheticsMonitor(d *schema.ResourceData, m interface{}) error {
+func createApmSyntheticsMonitor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsMonitorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readApmSyntheticsMonitor(d *schema.ResourceData, m interface{}) error {
+func readApmSyntheticsMonitor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsMonitorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateApmSyntheticsMonitor(d *schema.ResourceData, m interface{}) error {
+func updateApmSyntheticsMonitor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsMonitorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteApmSyntheticsMonitor(d *schema.ResourceData, m interface{}) error {
+func deleteApmSyntheticsMonitor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsMonitorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type ApmSyntheticsMonitorResourceCrud struct {
@@ -513,7 +514,7 @@ func (s *ApmSyntheticsMonitorResourceCrud) ID() string {
 	return GetMonitorCompositeId(*s.Res.Id, s.D.Get("apm_domain_id").(string))
 }
 
-func (s *ApmSyntheticsMonitorResourceCrud) Create() error {
+func (s *ApmSyntheticsMonitorResourceCrud) Create(ctx context.Context) error {
 	request := oci_apm_synthetics.CreateMonitorRequest{}
 
 	if apmDomainId, ok := s.D.GetOkExists("apm_domain_id"); ok {
@@ -634,7 +635,7 @@ func (s *ApmSyntheticsMonitorResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
 
-	response, err := s.Client.CreateMonitor(context.Background(), request)
+	response, err := s.Client.CreateMonitor(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -643,7 +644,7 @@ func (s *ApmSyntheticsMonitorResourceCrud) Create() error {
 	return nil
 }
 
-func (s *ApmSyntheticsMonitorResourceCrud) Get() error {
+func (s *ApmSyntheticsMonitorResourceCrud) Get(ctx context.Context) error {
 	request := oci_apm_synthetics.GetMonitorRequest{}
 
 	monitorId, apmDomainId, err := parseMonitorCompositeId(s.D.Id())
@@ -656,7 +657,7 @@ func (s *ApmSyntheticsMonitorResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
 
-	response, err := s.Client.GetMonitor(context.Background(), request)
+	response, err := s.Client.GetMonitor(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -665,7 +666,7 @@ func (s *ApmSyntheticsMonitorResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ApmSyntheticsMonitorResourceCrud) Update() error {
+func (s *ApmSyntheticsMonitorResourceCrud) Update(ctx context.Context) error {
 	request := oci_apm_synthetics.UpdateMonitorRequest{}
 
 	if apmDomainId, ok := s.D.GetOkExists("apm_domain_id"); ok {
@@ -790,7 +791,7 @@ func (s *ApmSyntheticsMonitorResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
 
-	response, err := s.Client.UpdateMonitor(context.Background(), request)
+	response, err := s.Client.UpdateMonitor(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -799,7 +800,7 @@ func (s *ApmSyntheticsMonitorResourceCrud) Update() error {
 	return nil
 }
 
-func (s *ApmSyntheticsMonitorResourceCrud) Delete() error {
+func (s *ApmSyntheticsMonitorResourceCrud) Delete(ctx context.Context) error {
 	request := oci_apm_synthetics.DeleteMonitorRequest{}
 
 	tmp := s.D.Id()
@@ -816,7 +817,7 @@ func (s *ApmSyntheticsMonitorResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
 
-	_, err := s.Client.DeleteMonitor(context.Background(), request)
+	_, err := s.Client.DeleteMonitor(ctx, request)
 	return err
 }
 
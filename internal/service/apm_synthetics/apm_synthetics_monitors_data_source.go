@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apm_synthetics "github.com/oracle/oci-go-sdk/v65/apmsynthetics"
 
@@ -16,7 +17,7 @@ import (
 
 func ApmSyntheticsMonitorsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readApmSyntheticsMonitors,
+		ReadContext: readApmSyntheticsMonitors,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"apm_domain_id": {
@@ -61,12 +62,12 @@ func ApmSyntheticsMonitorsDataSource() *schema.Resource {
 	}
 }
 
-func readApmSyntheticsMonitors(d *schema.ResourceData, m interface{}) error {
+func readApmSyntheticsMonitors(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsMonitorsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ApmSyntheticsMonitorsDataSourceCrud struct {
@@ -79,7 +80,7 @@ func (s *ApmSyntheticsMonitorsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ApmSyntheticsMonitorsDataSourceCrud) Get() error {
+func (s *ApmSyntheticsMonitorsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_apm_synthetics.ListMonitorsRequest{}
 
 	if apmDomainId, ok := s.D.GetOkExists("apm_domain_id"); ok {
@@ -119,7 +120,7 @@ func (s *ApmSyntheticsMonitorsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm_synthetics")
 
-	response, err := s.Client.ListMonitors(context.Background(), request)
+	response, err := s.Client.ListMonitors(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -128,7 +129,7 @@ func (s *ApmSyntheticsMonitorsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListMonitors(context.Background(), request)
+		listResponse, err := s.Client.ListMonitors(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package apm_synthetics
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apm_synthetics "github.com/oracle/oci-go-sdk/v65/apmsynthetics"
 
@@ -15,7 +16,7 @@ import (
 
 func ApmSyntheticsPublicVantagePointDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularApmSyntheticsPublicVantagePoint,
+		ReadContext: readSingularApmSyntheticsPublicVantagePoint,
 		Schema: map[string]*schema.Schema{
 			"apm_domain_id": {
 				Type:     schema.TypeString,
@@ -92,12 +93,12 @@ func ApmSyntheticsPublicVantagePointDataSource() *schema.Resource {
 	}
 }
 
-func readSingularApmSyntheticsPublicVantagePoint(d *schema.ResourceData, m interface{}) error {
+func readSingularApmSyntheticsPublicVantagePoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsPublicVantagePointDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ApmSyntheticsPublicVantagePointDataSourceCrud struct {
@@ -110,7 +111,7 @@ func (s *ApmSyntheticsPublicVantagePointDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ApmSyntheticsPublicVantagePointDataSourceCrud) Get() error {
+func (s *ApmSyntheticsPublicVantagePointDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_apm_synthetics.ListPublicVantagePointsRequest{}
 
 	if apmDomainId, ok := s.D.GetOkExists("apm_domain_id"); ok {
@@ -130,7 +131,7 @@ func (s *ApmSyntheticsPublicVantagePointDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm_synthetics")
 
-	response, err := s.Client.ListPublicVantagePoints(context.Background(), request)
+	response, err := s.Client.ListPublicVantagePoints(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package apm_synthetics
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apm_synthetics "github.com/oracle/oci-go-sdk/v65/apmsynthetics"
 
@@ -15,7 +16,7 @@ import (
 
 func ApmSyntheticsResultDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularApmSyntheticsResult,
+		ReadContext: readSingularApmSyntheticsResult,
 		Schema: map[string]*schema.Schema{
 			"apm_domain_id": {
 				Type:     schema.TypeString,
@@ -75,12 +76,12 @@ func ApmSyntheticsResultDataSource() *schema.Resource {
 	}
 }
 
-func readSingularApmSyntheticsResult(d *schema.ResourceData, m interface{}) error {
+func readSingularApmSyntheticsResult(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsResultDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ApmSyntheticsResultDataSourceCrud struct {
@@ -93,7 +94,7 @@ func (s *ApmSyntheticsResultDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ApmSyntheticsResultDataSourceCrud) Get() error {
+func (s *ApmSyntheticsResultDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_apm_synthetics.GetMonitorResultRequest{}
 
 	if apmDomainId, ok := s.D.GetOkExists("apm_domain_id"); ok {
@@ -128,7 +129,7 @@ func (s *ApmSyntheticsResultDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm_synthetics")
 
-	response, err := s.Client.GetMonitorResult(context.Background(), request)
+	response, err := s.Client.GetMonitorResult(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apm_synthetics "github.com/oracle/oci-go-sdk/v65/apmsynthetics"
 
@@ -27,12 +28,12 @@ func ApmSyntheticsScriptDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ApmSyntheticsScriptResource(), fieldMap, readSingularApmSyntheticsScript)
 }
 
-func readSingularApmSyntheticsScript(d *schema.ResourceData, m interface{}) error {
+func readSingularApmSyntheticsScript(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsScriptDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ApmSyntheticsScriptDataSourceCrud struct {
@@ -45,7 +46,7 @@ func (s *ApmSyntheticsScriptDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ApmSyntheticsScriptDataSourceCrud) Get() error {
+func (s *ApmSyntheticsScriptDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_apm_synthetics.GetScriptRequest{}
 
 	if apmDomainId, ok := s.D.GetOkExists("apm_domain_id"); ok {
@@ -66,7 +67,7 @@ func (s *ApmSyntheticsScriptDataSourceCrud) Get() error {
 	}
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm_synthetics")
 
-	response, err := s.Client.GetScript(context.Background(), request)
+	response, err := s.Client.GetScript(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -24,11 +25,11 @@ func ApmSyntheticsScriptResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createApmSyntheticsScript,
-		Read:     readApmSyntheticsScript,
-		Update:   updateApmSyntheticsScript,
-		Delete:   deleteApmSyntheticsScript,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createApmSyntheticsScript,
+		ReadContext:   readApmSyntheticsScript,
+		UpdateContext: updateApmSyntheticsScript,
+		DeleteContext: deleteApmSyntheticsScript,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"apm_domain_id": {
@@ -175,37 +176,37 @@ func ApmSyntheticsScriptResource() *schema.Resource {
 	}
 }
 
-func createApmSyntheticsScript(d *schema.ResourceData, m interface{}) error {
+func createApmSyntheticsScript(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsScriptResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readApmSyntheticsScript(d *schema.ResourceData, m interface{}) error {
+func readApmSyntheticsScript(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsScriptResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateApmSyntheticsScript(d *schema.ResourceData, m interface{}) error {
+func updateApmSyntheticsScript(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsScriptResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteApmSyntheticsScript(d *schema.ResourceData, m interface{}) error {
+func deleteApmSyntheticsScript(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsScriptResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type ApmSyntheticsScriptResourceCrud struct {
@@ -219,7 +220,7 @@ func (s *ApmSyntheticsScriptResourceCrud) ID() string {
 	return GetScriptCompositeId(*s.Res.Id, s.D.Get("apm_domain_id").(string))
 }
 
-func (s *ApmSyntheticsScriptResourceCrud) Create() error {
+func (s *ApmSyntheticsScriptResourceCrud) Create(ctx context.Context) error {
 	request := oci_apm_synthetics.CreateScriptRequest{}
 
 	if apmDomainId, ok := s.D.GetOkExists("apm_domain_id"); ok {
@@ -277,7 +278,7 @@ func (s *ApmSyntheticsScriptResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
 
-	response, err := s.Client.CreateScript(context.Background(), request)
+	response, err := s.Client.CreateScript(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -286,7 +287,7 @@ func (s *ApmSyntheticsScriptResourceCrud) Create() error {
 	return nil
 }
 
-func (s *ApmSyntheticsScriptResourceCrud) Get() error {
+func (s *ApmSyntheticsScriptResourceCrud) Get(ctx context.Context) error {
 	request := oci_apm_synthetics.GetScriptRequest{}
 
 	scriptId, apmDomainId, err := parseScriptCompositeId(s.D.Id())
@@ -299,7 +300,7 @@ func (s *ApmSyntheticsScriptResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
 
-	response, err := s.Client.GetScript(context.Background(), request)
+	response, err := s.Client.GetScript(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -308,7 +309,7 @@ func (s *ApmSyntheticsScriptResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ApmSyntheticsScriptResourceCrud) Update() error {
+func (s *ApmSyntheticsScriptResourceCrud) Update(ctx context.Context) error {
 	request := oci_apm_synthetics.UpdateScriptRequest{}
 
 	if apmDomainId, ok := s.D.GetOkExists("apm_domain_id"); ok {
@@ -376,7 +377,7 @@ func (s *ApmSyntheticsScriptResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
 
-	response, err := s.Client.UpdateScript(context.Background(), request)
+	response, err := s.Client.UpdateScript(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -385,7 +386,7 @@ func (s *ApmSyntheticsScriptResourceCrud) Update() error {
 	return nil
 }
 
-func (s *ApmSyntheticsScriptResourceCrud) Delete() error {
+func (s *ApmSyntheticsScriptResourceCrud) Delete(ctx context.Context) error {
 	request := oci_apm_synthetics.DeleteScriptRequest{}
 
 	if apmDomainId, ok := s.D.GetOkExists("apm_domain_id"); ok {
@@ -407,7 +408,7 @@ func (s *ApmSyntheticsScriptResourceCrud) Delete() error {
 	}
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "apm_synthetics")
 
-	_, err := s.Client.DeleteScript(context.Background(), request)
+	_, err := s.Client.DeleteScript(ctx, request)
 	return err
 }
 
@@ -6,6 +6,7 @@ package apm_synthetics
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apm_synthetics "github.com/oracle/oci-go-sdk/v65/apmsynthetics"
 
@@ -15,7 +16,7 @@ import (
 
 func ApmSyntheticsScriptsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readApmSyntheticsScripts,
+		ReadContext: readApmSyntheticsScripts,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"apm_domain_id": {
@@ -48,12 +49,12 @@ func ApmSyntheticsScriptsDataSource() *schema.Resource {
 	}
 }
 
-func readApmSyntheticsScripts(d *schema.ResourceData, m interface{}) error {
+func readApmSyntheticsScripts(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmSyntheticsScriptsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ApmSyntheticClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ApmSyntheticsScriptsDataSourceCrud struct {
@@ -66,7 +67,7 @@ func (s *ApmSyntheticsScriptsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ApmSyntheticsScriptsDataSourceCrud) Get() error {
+func (s *ApmSyntheticsScriptsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_apm_synthetics.ListScriptsRequest{}
 
 	if apmDomainId, ok := s.D.GetOkExists("apm_domain_id"); ok {
@@ -86,7 +87,7 @@ func (s *ApmSyntheticsScriptsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm_synthetics")
 
-	response, err := s.Client.ListScripts(context.Background(), request)
+	response, err := s.Client.ListScripts(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +96,7 @@ func (s *ApmSyntheticsScriptsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListScripts(context.Background(), request)
+		listResponse, err := s.Client.ListScripts(ctx, request)
 		if err != nil {
 			return err
 		}
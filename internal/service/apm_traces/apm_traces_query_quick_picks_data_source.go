@@ -6,6 +6,7 @@ package apm_traces
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apm_traces "github.com/oracle/oci-go-sdk/v65/apmtraces"
 
@@ -15,7 +16,7 @@ import (
 
 func ApmTracesQueryQuickPicksDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readApmTracesQueryQuickPicks,
+		ReadContext: readApmTracesQueryQuickPicks,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"apm_domain_id": {
@@ -47,12 +48,12 @@ func ApmTracesQueryQuickPicksDataSource() *schema.Resource {
 	}
 }
 
-func readApmTracesQueryQuickPicks(d *schema.ResourceData, m interface{}) error {
+func readApmTracesQueryQuickPicks(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmTracesQueryQuickPicksDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).QueryClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ApmTracesQueryQuickPicksDataSourceCrud struct {
@@ -65,7 +66,7 @@ func (s *ApmTracesQueryQuickPicksDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ApmTracesQueryQuickPicksDataSourceCrud) Get() error {
+func (s *ApmTracesQueryQuickPicksDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_apm_traces.ListQuickPicksRequest{}
 
 	if apmDomainId, ok := s.D.GetOkExists("apm_domain_id"); ok {
@@ -75,7 +76,7 @@ func (s *ApmTracesQueryQuickPicksDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm_traces")
 
-	response, err := s.Client.ListQuickPicks(context.Background(), request)
+	response, err := s.Client.ListQuickPicks(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -84,7 +85,7 @@ func (s *ApmTracesQueryQuickPicksDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListQuickPicks(context.Background(), request)
+		listResponse, err := s.Client.ListQuickPicks(ctx, request)
 		if err != nil {
 			return err
 		}
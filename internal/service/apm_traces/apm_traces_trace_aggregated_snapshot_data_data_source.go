@@ -6,6 +6,7 @@ package apm_traces
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apm_traces "github.com/oracle/oci-go-sdk/v65/apmtraces"
 
@@ -15,7 +16,7 @@ import (
 
 func ApmTracesTraceAggregatedSnapshotDataDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularApmTracesTraceAggregatedSnapshotData,
+		ReadContext: readSingularApmTracesTraceAggregatedSnapshotData,
 		Schema: map[string]*schema.Schema{
 			"apm_domain_id": {
 				Type:     schema.TypeString,
@@ -51,12 +52,12 @@ func ApmTracesTraceAggregatedSnapshotDataDataSource() *schema.Resource {
 	}
 }
 
-func readSingularApmTracesTraceAggregatedSnapshotData(d *schema.ResourceData, m interface{}) error {
+func readSingularApmTracesTraceAggregatedSnapshotData(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmTracesTraceAggregatedSnapshotDataDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).TraceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ApmTracesTraceAggregatedSnapshotDataDataSourceCrud struct {
@@ -69,7 +70,7 @@ func (s *ApmTracesTraceAggregatedSnapshotDataDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ApmTracesTraceAggregatedSnapshotDataDataSourceCrud) Get() error {
+func (s *ApmTracesTraceAggregatedSnapshotDataDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_apm_traces.GetAggregatedSnapshotRequest{}
 
 	if apmDomainId, ok := s.D.GetOkExists("apm_domain_id"); ok {
@@ -84,7 +85,7 @@ func (s *ApmTracesTraceAggregatedSnapshotDataDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm_traces")
 
-	response, err := s.Client.GetAggregatedSnapshot(context.Background(), request)
+	response, err := s.Client.GetAggregatedSnapshot(ctx, request)
 	if err != nil {
 		return err
 	}
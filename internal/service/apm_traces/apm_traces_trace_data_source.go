@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apm_traces "github.com/oracle/oci-go-sdk/v65/apmtraces"
 
@@ -16,7 +17,7 @@ import (
 
 func ApmTracesTraceDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularApmTracesTrace,
+		ReadContext: readSingularApmTracesTrace,
 		Schema: map[string]*schema.Schema{
 			"apm_domain_id": {
 				Type:     schema.TypeString,
@@ -326,12 +327,12 @@ func ApmTracesTraceDataSource() *schema.Resource {
 	}
 }
 
-func readSingularApmTracesTrace(d *schema.ResourceData, m interface{}) error {
+func readSingularApmTracesTrace(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmTracesTraceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).TraceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ApmTracesTraceDataSourceCrud struct {
@@ -344,7 +345,7 @@ func (s *ApmTracesTraceDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ApmTracesTraceDataSourceCrud) Get() error {
+func (s *ApmTracesTraceDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_apm_traces.GetTraceRequest{}
 
 	if apmDomainId, ok := s.D.GetOkExists("apm_domain_id"); ok {
@@ -359,7 +360,7 @@ func (s *ApmTracesTraceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm_traces")
 
-	response, err := s.Client.GetTrace(context.Background(), request)
+	response, err := s.Client.GetTrace(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_apm_traces "github.com/oracle/oci-go-sdk/v65/apmtraces"
 
@@ -16,7 +17,7 @@ import (
 
 func ApmTracesTraceSnapshotDataDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularApmTracesTraceSnapshotData,
+		ReadContext: readSingularApmTracesTraceSnapshotData,
 		Schema: map[string]*schema.Schema{
 			"apm_domain_id": {
 				Type:     schema.TypeString,
@@ -76,12 +77,12 @@ func ApmTracesTraceSnapshotDataDataSource() *schema.Resource {
 	}
 }
 
-func readSingularApmTracesTraceSnapshotData(d *schema.ResourceData, m interface{}) error {
+func readSingularApmTracesTraceSnapshotData(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ApmTracesTraceSnapshotDataDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).TraceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ApmTracesTraceSnapshotDataDataSourceCrud struct {
@@ -94,7 +95,7 @@ func (s *ApmTracesTraceSnapshotDataDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ApmTracesTraceSnapshotDataDataSourceCrud) Get() error {
+func (s *ApmTracesTraceSnapshotDataDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_apm_traces.GetTraceSnapshotRequest{}
 
 	if apmDomainId, ok := s.D.GetOkExists("apm_domain_id"); ok {
@@ -124,7 +125,7 @@ func (s *ApmTracesTraceSnapshotDataDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "apm_traces")
 
-	response, err := s.Client.GetTraceSnapshot(context.Background(), request)
+	response, err := s.Client.GetTraceSnapshot(ctx, request)
 	if err != nil {
 		return err
 	}
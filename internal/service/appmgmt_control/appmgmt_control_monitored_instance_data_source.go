@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_appmgmt_control "github.com/oracle/oci-go-sdk/v65/appmgmtcontrol"
 )
 
 func AppmgmtControlMonitoredInstanceDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularAppmgmtControlMonitoredInstance,
+		ReadContext: readSingularAppmgmtControlMonitoredInstance,
 		Schema: map[string]*schema.Schema{
 			"monitored_instance_id": {
 				Type:     schema.TypeString,
@@ -62,12 +63,12 @@ func AppmgmtControlMonitoredInstanceDataSource() *schema.Resource {
 	}
 }
 
-func readSingularAppmgmtControlMonitoredInstance(d *schema.ResourceData, m interface{}) error {
+func readSingularAppmgmtControlMonitoredInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AppmgmtControlMonitoredInstanceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AppmgmtControlClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type AppmgmtControlMonitoredInstanceDataSourceCrud struct {
@@ -80,7 +81,7 @@ func (s *AppmgmtControlMonitoredInstanceDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *AppmgmtControlMonitoredInstanceDataSourceCrud) Get() error {
+func (s *AppmgmtControlMonitoredInstanceDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_appmgmt_control.GetMonitoredInstanceRequest{}
 
 	if monitoredInstanceId, ok := s.D.GetOkExists("monitored_instance_id"); ok {
@@ -90,7 +91,7 @@ func (s *AppmgmtControlMonitoredInstanceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "appmgmt_control")
 
-	response, err := s.Client.GetMonitoredInstance(context.Background(), request)
+	response, err := s.Client.GetMonitoredInstance(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_appmgmt_control "github.com/oracle/oci-go-sdk/v65/appmgmtcontrol"
 )
 
 func AppmgmtControlMonitoredInstancesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readAppmgmtControlMonitoredInstances,
+		ReadContext: readAppmgmtControlMonitoredInstances,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -87,12 +88,12 @@ func AppmgmtControlMonitoredInstancesDataSource() *schema.Resource {
 	}
 }
 
-func readAppmgmtControlMonitoredInstances(d *schema.ResourceData, m interface{}) error {
+func readAppmgmtControlMonitoredInstances(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AppmgmtControlMonitoredInstancesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AppmgmtControlClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type AppmgmtControlMonitoredInstancesDataSourceCrud struct {
@@ -105,7 +106,7 @@ func (s *AppmgmtControlMonitoredInstancesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *AppmgmtControlMonitoredInstancesDataSourceCrud) Get() error {
+func (s *AppmgmtControlMonitoredInstancesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_appmgmt_control.ListMonitoredInstancesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -120,7 +121,7 @@ func (s *AppmgmtControlMonitoredInstancesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "appmgmt_control")
 
-	response, err := s.Client.ListMonitoredInstances(context.Background(), request)
+	response, err := s.Client.ListMonitoredInstances(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -129,7 +130,7 @@ func (s *AppmgmtControlMonitoredInstancesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListMonitoredInstances(context.Background(), request)
+		listResponse, err := s.Client.ListMonitoredInstances(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package artifacts
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_artifacts "github.com/oracle/oci-go-sdk/v65/artifacts"
 
@@ -22,12 +23,12 @@ func ArtifactsContainerConfigurationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ArtifactsContainerConfigurationResource(), fieldMap, readSingularArtifactsContainerConfiguration)
 }
 
-func readSingularArtifactsContainerConfiguration(d *schema.ResourceData, m interface{}) error {
+func readSingularArtifactsContainerConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerConfigurationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ArtifactsContainerConfigurationDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *ArtifactsContainerConfigurationDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ArtifactsContainerConfigurationDataSourceCrud) Get() error {
+func (s *ArtifactsContainerConfigurationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_artifacts.GetContainerConfigurationRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -50,7 +51,7 @@ func (s *ArtifactsContainerConfigurationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "artifacts")
 
-	response, err := s.Client.GetContainerConfiguration(context.Background(), request)
+	response, err := s.Client.GetContainerConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package artifacts
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_artifacts "github.com/oracle/oci-go-sdk/v65/artifacts"
@@ -16,11 +17,11 @@ import (
 
 func ArtifactsContainerConfigurationResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createArtifactsContainerConfiguration,
-		Read:     readArtifactsContainerConfiguration,
-		Update:   updateArtifactsContainerConfiguration,
-		Delete:   deleteArtifactsContainerConfiguration,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createArtifactsContainerConfiguration,
+		ReadContext:   readArtifactsContainerConfiguration,
+		UpdateContext: updateArtifactsContainerConfiguration,
+		DeleteContext: deleteArtifactsContainerConfiguration,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -43,31 +44,31 @@ func ArtifactsContainerConfigurationResource() *schema.Resource {
 	}
 }
 
-func createArtifactsContainerConfiguration(d *schema.ResourceData, m interface{}) error {
+func createArtifactsContainerConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readArtifactsContainerConfiguration(d *schema.ResourceData, m interface{}) error {
+func readArtifactsContainerConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateArtifactsContainerConfiguration(d *schema.ResourceData, m interface{}) error {
+func updateArtifactsContainerConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteArtifactsContainerConfiguration(d *schema.ResourceData, m interface{}) error {
+func deleteArtifactsContainerConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -82,7 +83,7 @@ func (s *ArtifactsContainerConfigurationResourceCrud) ID() string {
 	return s.D.Get("compartment_id").(string)
 }
 
-func (s *ArtifactsContainerConfigurationResourceCrud) Create() error {
+func (s *ArtifactsContainerConfigurationResourceCrud) Create(ctx context.Context) error {
 	request := oci_artifacts.UpdateContainerConfigurationRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -97,7 +98,7 @@ func (s *ArtifactsContainerConfigurationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.UpdateContainerConfiguration(context.Background(), request)
+	response, err := s.Client.UpdateContainerConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -106,7 +107,7 @@ func (s *ArtifactsContainerConfigurationResourceCrud) Create() error {
 	return nil
 }
 
-func (s *ArtifactsContainerConfigurationResourceCrud) Get() error {
+func (s *ArtifactsContainerConfigurationResourceCrud) Get(ctx context.Context) error {
 	request := oci_artifacts.GetContainerConfigurationRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -116,7 +117,7 @@ func (s *ArtifactsContainerConfigurationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.GetContainerConfiguration(context.Background(), request)
+	response, err := s.Client.GetContainerConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -125,7 +126,7 @@ func (s *ArtifactsContainerConfigurationResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ArtifactsContainerConfigurationResourceCrud) Update() error {
+func (s *ArtifactsContainerConfigurationResourceCrud) Update(ctx context.Context) error {
 	request := oci_artifacts.UpdateContainerConfigurationRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -140,7 +141,7 @@ func (s *ArtifactsContainerConfigurationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.UpdateContainerConfiguration(context.Background(), request)
+	response, err := s.Client.UpdateContainerConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_artifacts "github.com/oracle/oci-go-sdk/v65/artifacts"
 
@@ -16,7 +17,7 @@ import (
 
 func ArtifactsContainerImageDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularArtifactsContainerImage,
+		ReadContext: readSingularArtifactsContainerImage,
 		Schema: map[string]*schema.Schema{
 			"image_id": {
 				Type:     schema.TypeString,
@@ -129,12 +130,12 @@ func ArtifactsContainerImageDataSource() *schema.Resource {
 	}
 }
 
-func readSingularArtifactsContainerImage(d *schema.ResourceData, m interface{}) error {
+func readSingularArtifactsContainerImage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerImageDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ArtifactsContainerImageDataSourceCrud struct {
@@ -147,7 +148,7 @@ func (s *ArtifactsContainerImageDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ArtifactsContainerImageDataSourceCrud) Get() error {
+func (s *ArtifactsContainerImageDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_artifacts.GetContainerImageRequest{}
 
 	if imageId, ok := s.D.GetOkExists("image_id"); ok {
@@ -157,7 +158,7 @@ func (s *ArtifactsContainerImageDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "artifacts")
 
-	response, err := s.Client.GetContainerImage(context.Background(), request)
+	response, err := s.Client.GetContainerImage(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package artifacts
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_artifacts "github.com/oracle/oci-go-sdk/v65/artifacts"
 
@@ -22,12 +23,12 @@ func ArtifactsContainerImageSignatureDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ArtifactsContainerImageSignatureResource(), fieldMap, readSingularArtifactsContainerImageSignature)
 }
 
-func readSingularArtifactsContainerImageSignature(d *schema.ResourceData, m interface{}) error {
+func readSingularArtifactsContainerImageSignature(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerImageSignatureDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ArtifactsContainerImageSignatureDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *ArtifactsContainerImageSignatureDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ArtifactsContainerImageSignatureDataSourceCrud) Get() error {
+func (s *ArtifactsContainerImageSignatureDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_artifacts.GetContainerImageSignatureRequest{}
 
 	if imageSignatureId, ok := s.D.GetOkExists("image_signature_id"); ok {
@@ -50,7 +51,7 @@ func (s *ArtifactsContainerImageSignatureDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "artifacts")
 
-	response, err := s.Client.GetContainerImageSignature(context.Background(), request)
+	response, err := s.Client.GetContainerImageSignature(ctx, request)
 	if err != nil {
 		return err
 	}
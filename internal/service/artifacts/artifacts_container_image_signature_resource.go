@@ -6,6 +6,7 @@ package artifacts
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_artifacts "github.com/oracle/oci-go-sdk/v65/artifacts"
@@ -19,10 +20,10 @@ func ArtifactsContainerImageSignatureResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createArtifactsContainerImageSignature,
-		Read:     readArtifactsContainerImageSignature,
-		Delete:   deleteArtifactsContainerImageSignature,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createArtifactsContainerImageSignature,
+		ReadContext:   readArtifactsContainerImageSignature,
+		DeleteContext: deleteArtifactsContainerImageSignature,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -80,29 +81,29 @@ func ArtifactsContainerImageSignatureResource() *schema.Resource {
 	}
 }
 
-func createArtifactsContainerImageSignature(d *schema.ResourceData, m interface{}) error {
+func createArtifactsContainerImageSignature(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerImageSignatureResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readArtifactsContainerImageSignature(d *schema.ResourceData, m interface{}) error {
+func readArtifactsContainerImageSignature(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerImageSignatureResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteArtifactsContainerImageSignature(d *schema.ResourceData, m interface{}) error {
+func deleteArtifactsContainerImageSignature(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerImageSignatureResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type ArtifactsContainerImageSignatureResourceCrud struct {
@@ -116,7 +117,7 @@ func (s *ArtifactsContainerImageSignatureResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *ArtifactsContainerImageSignatureResourceCrud) Create() error {
+func (s *ArtifactsContainerImageSignatureResourceCrud) Create(ctx context.Context) error {
 	request := oci_artifacts.CreateContainerImageSignatureRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -155,7 +156,7 @@ func (s *ArtifactsContainerImageSignatureResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.CreateContainerImageSignature(context.Background(), request)
+	response, err := s.Client.CreateContainerImageSignature(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -164,7 +165,7 @@ func (s *ArtifactsContainerImageSignatureResourceCrud) Create() error {
 	return nil
 }
 
-func (s *ArtifactsContainerImageSignatureResourceCrud) Get() error {
+func (s *ArtifactsContainerImageSignatureResourceCrud) Get(ctx context.Context) error {
 	request := oci_artifacts.GetContainerImageSignatureRequest{}
 
 	imageSignatureId := s.D.Id()
@@ -172,7 +173,7 @@ func (s *ArtifactsContainerImageSignatureResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.GetContainerImageSignature(context.Background(), request)
+	response, err := s.Client.GetContainerImageSignature(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -181,7 +182,7 @@ func (s *ArtifactsContainerImageSignatureResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ArtifactsContainerImageSignatureResourceCrud) Delete() error {
+func (s *ArtifactsContainerImageSignatureResourceCrud) Delete(ctx context.Context) error {
 	request := oci_artifacts.DeleteContainerImageSignatureRequest{}
 
 	tmp := s.D.Id()
@@ -189,7 +190,7 @@ func (s *ArtifactsContainerImageSignatureResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	_, err := s.Client.DeleteContainerImageSignature(context.Background(), request)
+	_, err := s.Client.DeleteContainerImageSignature(ctx, request)
 	return err
 }
 
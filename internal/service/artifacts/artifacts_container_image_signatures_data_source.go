@@ -6,6 +6,7 @@ package artifacts
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_artifacts "github.com/oracle/oci-go-sdk/v65/artifacts"
 
@@ -15,7 +16,7 @@ import (
 
 func ArtifactsContainerImageSignaturesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readArtifactsContainerImageSignatures,
+		ReadContext: readArtifactsContainerImageSignatures,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -81,12 +82,12 @@ func ArtifactsContainerImageSignaturesDataSource() *schema.Resource {
 	}
 }
 
-func readArtifactsContainerImageSignatures(d *schema.ResourceData, m interface{}) error {
+func readArtifactsContainerImageSignatures(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerImageSignaturesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ArtifactsContainerImageSignaturesDataSourceCrud struct {
@@ -99,7 +100,7 @@ func (s *ArtifactsContainerImageSignaturesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ArtifactsContainerImageSignaturesDataSourceCrud) Get() error {
+func (s *ArtifactsContainerImageSignaturesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_artifacts.ListContainerImageSignaturesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -153,7 +154,7 @@ func (s *ArtifactsContainerImageSignaturesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "artifacts")
 
-	response, err := s.Client.ListContainerImageSignatures(context.Background(), request)
+	response, err := s.Client.ListContainerImageSignatures(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -162,7 +163,7 @@ func (s *ArtifactsContainerImageSignaturesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListContainerImageSignatures(context.Background(), request)
+		listResponse, err := s.Client.ListContainerImageSignatures(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_artifacts "github.com/oracle/oci-go-sdk/v65/artifacts"
 
@@ -16,7 +17,7 @@ import (
 
 func ArtifactsContainerImagesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readArtifactsContainerImages,
+		ReadContext: readArtifactsContainerImages,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -191,12 +192,12 @@ func ArtifactsContainerImagesDataSource() *schema.Resource {
 	}
 }
 
-func readArtifactsContainerImages(d *schema.ResourceData, m interface{}) error {
+func readArtifactsContainerImages(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerImagesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ArtifactsContainerImagesDataSourceCrud struct {
@@ -209,7 +210,7 @@ func (s *ArtifactsContainerImagesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ArtifactsContainerImagesDataSourceCrud) Get() error {
+func (s *ArtifactsContainerImagesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_artifacts.ListContainerImagesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -259,7 +260,7 @@ func (s *ArtifactsContainerImagesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "artifacts")
 
-	response, err := s.Client.ListContainerImages(context.Background(), request)
+	response, err := s.Client.ListContainerImages(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -268,7 +269,7 @@ func (s *ArtifactsContainerImagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListContainerImages(context.Background(), request)
+		listResponse, err := s.Client.ListContainerImages(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_artifacts "github.com/oracle/oci-go-sdk/v65/artifacts"
 
@@ -16,7 +17,7 @@ import (
 
 func ArtifactsContainerRepositoriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readArtifactsContainerRepositories,
+		ReadContext: readArtifactsContainerRepositories,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -86,12 +87,12 @@ func ArtifactsContainerRepositoriesDataSource() *schema.Resource {
 	}
 }
 
-func readArtifactsContainerRepositories(d *schema.ResourceData, m interface{}) error {
+func readArtifactsContainerRepositories(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerRepositoriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ArtifactsContainerRepositoriesDataSourceCrud struct {
@@ -104,7 +105,7 @@ func (s *ArtifactsContainerRepositoriesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ArtifactsContainerRepositoriesDataSourceCrud) Get() error {
+func (s *ArtifactsContainerRepositoriesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_artifacts.ListContainerRepositoriesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -139,7 +140,7 @@ func (s *ArtifactsContainerRepositoriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "artifacts")
 
-	response, err := s.Client.ListContainerRepositories(context.Background(), request)
+	response, err := s.Client.ListContainerRepositories(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -148,7 +149,7 @@ func (s *ArtifactsContainerRepositoriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListContainerRepositories(context.Background(), request)
+		listResponse, err := s.Client.ListContainerRepositories(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_artifacts "github.com/oracle/oci-go-sdk/v65/artifacts"
 
@@ -23,12 +24,12 @@ func ArtifactsContainerRepositoryDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ArtifactsContainerRepositoryResource(), fieldMap, readSingularArtifactsContainerRepository)
 }
 
-func readSingularArtifactsContainerRepository(d *schema.ResourceData, m interface{}) error {
+func readSingularArtifactsContainerRepository(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerRepositoryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ArtifactsContainerRepositoryDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *ArtifactsContainerRepositoryDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ArtifactsContainerRepositoryDataSourceCrud) Get() error {
+func (s *ArtifactsContainerRepositoryDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_artifacts.GetContainerRepositoryRequest{}
 
 	if repositoryId, ok := s.D.GetOkExists("repository_id"); ok {
@@ -51,7 +52,7 @@ func (s *ArtifactsContainerRepositoryDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "artifacts")
 
-	response, err := s.Client.GetContainerRepository(context.Background(), request)
+	response, err := s.Client.GetContainerRepository(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_artifacts "github.com/oracle/oci-go-sdk/v65/artifacts"
@@ -21,11 +22,11 @@ func ArtifactsContainerRepositoryResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createArtifactsContainerRepository,
-		Read:     readArtifactsContainerRepository,
-		Update:   updateArtifactsContainerRepository,
-		Delete:   deleteArtifactsContainerRepository,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createArtifactsContainerRepository,
+		ReadContext:   readArtifactsContainerRepository,
+		UpdateContext: updateArtifactsContainerRepository,
+		DeleteContext: deleteArtifactsContainerRepository,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -111,37 +112,37 @@ func ArtifactsContainerRepositoryResource() *schema.Resource {
 	}
 }
 
-func createArtifactsContainerRepository(d *schema.ResourceData, m interface{}) error {
+func createArtifactsContainerRepository(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerRepositoryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readArtifactsContainerRepository(d *schema.ResourceData, m interface{}) error {
+func readArtifactsContainerRepository(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerRepositoryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateArtifactsContainerRepository(d *schema.ResourceData, m interface{}) error {
+func updateArtifactsContainerRepository(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerRepositoryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteArtifactsContainerRepository(d *schema.ResourceData, m interface{}) error {
+func deleteArtifactsContainerRepository(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsContainerRepositoryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type ArtifactsContainerRepositoryResourceCrud struct {
@@ -177,7 +178,7 @@ func (s *ArtifactsContainerRepositoryResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *ArtifactsContainerRepositoryResourceCrud) Create() error {
+func (s *ArtifactsContainerRepositoryResourceCrud) Create(ctx context.Context) error {
 	request := oci_artifacts.CreateContainerRepositoryRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -213,7 +214,7 @@ func (s *ArtifactsContainerRepositoryResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.CreateContainerRepository(context.Background(), request)
+	response, err := s.Client.CreateContainerRepository(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -222,7 +223,7 @@ func (s *ArtifactsContainerRepositoryResourceCrud) Create() error {
 	return nil
 }
 
-func (s *ArtifactsContainerRepositoryResourceCrud) Get() error {
+func (s *ArtifactsContainerRepositoryResourceCrud) Get(ctx context.Context) error {
 	request := oci_artifacts.GetContainerRepositoryRequest{}
 
 	tmp := s.D.Id()
@@ -230,7 +231,7 @@ func (s *ArtifactsContainerRepositoryResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.GetContainerRepository(context.Background(), request)
+	response, err := s.Client.GetContainerRepository(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -239,11 +240,11 @@ func (s *ArtifactsContainerRepositoryResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ArtifactsContainerRepositoryResourceCrud) Update() error {
+func (s *ArtifactsContainerRepositoryResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -277,7 +278,7 @@ func (s *ArtifactsContainerRepositoryResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.UpdateContainerRepository(context.Background(), request)
+	response, err := s.Client.UpdateContainerRepository(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -286,7 +287,7 @@ func (s *ArtifactsContainerRepositoryResourceCrud) Update() error {
 	return nil
 }
 
-func (s *ArtifactsContainerRepositoryResourceCrud) Delete() error {
+func (s *ArtifactsContainerRepositoryResourceCrud) Delete(ctx context.Context) error {
 	request := oci_artifacts.DeleteContainerRepositoryRequest{}
 
 	tmp := s.D.Id()
@@ -294,7 +295,7 @@ func (s *ArtifactsContainerRepositoryResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	_, err := s.Client.DeleteContainerRepository(context.Background(), request)
+	_, err := s.Client.DeleteContainerRepository(ctx, request)
 	return err
 }
 
@@ -426,7 +427,7 @@ func ContainerRepositorySummaryToMap(obj oci_artifacts.ContainerRepositorySummar
 	return result
 }
 
-func (s *ArtifactsContainerRepositoryResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *ArtifactsContainerRepositoryResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_artifacts.ChangeContainerRepositoryCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -437,12 +438,12 @@ func (s *ArtifactsContainerRepositoryResourceCrud) updateCompartment(compartment
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	_, err := s.Client.ChangeContainerRepositoryCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeContainerRepositoryCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_artifacts "github.com/oracle/oci-go-sdk/v65/artifacts"
 
@@ -23,12 +24,12 @@ func ArtifactsGenericArtifactDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ArtifactsGenericArtifactResource(), fieldMap, readSingularArtifactsGenericArtifact)
 }
 
-func readSingularArtifactsGenericArtifact(d *schema.ResourceData, m interface{}) error {
+func readSingularArtifactsGenericArtifact(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsGenericArtifactDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ArtifactsGenericArtifactDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *ArtifactsGenericArtifactDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ArtifactsGenericArtifactDataSourceCrud) Get() error {
+func (s *ArtifactsGenericArtifactDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_artifacts.GetGenericArtifactRequest{}
 
 	if artifactId, ok := s.D.GetOkExists("artifact_id"); ok {
@@ -51,7 +52,7 @@ func (s *ArtifactsGenericArtifactDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "artifacts")
 
-	response, err := s.Client.GetGenericArtifact(context.Background(), request)
+	response, err := s.Client.GetGenericArtifact(ctx, request)
 	if err != nil {
 		return err
 	}
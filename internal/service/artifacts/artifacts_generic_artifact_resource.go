@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_artifacts "github.com/oracle/oci-go-sdk/v65/artifacts"
@@ -17,11 +18,11 @@ import (
 
 func ArtifactsGenericArtifactResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createArtifactsGenericArtifact,
-		Read:     readArtifactsGenericArtifact,
-		Update:   updateArtifactsGenericArtifact,
-		Delete:   deleteArtifactsGenericArtifact,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createArtifactsGenericArtifact,
+		ReadContext:   readArtifactsGenericArtifact,
+		UpdateContext: updateArtifactsGenericArtifact,
+		DeleteContext: deleteArtifactsGenericArtifact,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"artifact_id": {
@@ -86,37 +87,37 @@ func ArtifactsGenericArtifactResource() *schema.Resource {
 	}
 }
 
-func createArtifactsGenericArtifact(d *schema.ResourceData, m interface{}) error {
+func createArtifactsGenericArtifact(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsGenericArtifactResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readArtifactsGenericArtifact(d *schema.ResourceData, m interface{}) error {
+func readArtifactsGenericArtifact(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsGenericArtifactResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateArtifactsGenericArtifact(d *schema.ResourceData, m interface{}) error {
+func updateArtifactsGenericArtifact(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsGenericArtifactResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteArtifactsGenericArtifact(d *schema.ResourceData, m interface{}) error {
+func deleteArtifactsGenericArtifact(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsGenericArtifactResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type ArtifactsGenericArtifactResourceCrud struct {
@@ -152,7 +153,7 @@ func (s *ArtifactsGenericArtifactResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *ArtifactsGenericArtifactResourceCrud) Create() error {
+func (s *ArtifactsGenericArtifactResourceCrud) Create(ctx context.Context) error {
 	request := oci_artifacts.UpdateGenericArtifactRequest{}
 
 	if artifactId, ok := s.D.GetOkExists("artifact_id"); ok {
@@ -174,7 +175,7 @@ func (s *ArtifactsGenericArtifactResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.UpdateGenericArtifact(context.Background(), request)
+	response, err := s.Client.UpdateGenericArtifact(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -183,7 +184,7 @@ func (s *ArtifactsGenericArtifactResourceCrud) Create() error {
 	return nil
 }
 
-func (s *ArtifactsGenericArtifactResourceCrud) Get() error {
+func (s *ArtifactsGenericArtifactResourceCrud) Get(ctx context.Context) error {
 	request := oci_artifacts.GetGenericArtifactRequest{}
 
 	if artifactId, ok := s.D.GetOkExists("artifact_id"); ok {
@@ -193,7 +194,7 @@ func (s *ArtifactsGenericArtifactResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.GetGenericArtifact(context.Background(), request)
+	response, err := s.Client.GetGenericArtifact(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -202,7 +203,7 @@ func (s *ArtifactsGenericArtifactResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ArtifactsGenericArtifactResourceCrud) Update() error {
+func (s *ArtifactsGenericArtifactResourceCrud) Update(ctx context.Context) error {
 	request := oci_artifacts.UpdateGenericArtifactRequest{}
 
 	if artifactId, ok := s.D.GetOkExists("artifact_id"); ok {
@@ -224,7 +225,7 @@ func (s *ArtifactsGenericArtifactResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.UpdateGenericArtifact(context.Background(), request)
+	response, err := s.Client.UpdateGenericArtifact(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -233,7 +234,7 @@ func (s *ArtifactsGenericArtifactResourceCrud) Update() error {
 	return nil
 }
 
-func (s *ArtifactsGenericArtifactResourceCrud) Delete() error {
+func (s *ArtifactsGenericArtifactResourceCrud) Delete(ctx context.Context) error {
 	request := oci_artifacts.DeleteGenericArtifactRequest{}
 
 	if artifactId, ok := s.D.GetOkExists("artifact_id"); ok {
@@ -243,7 +244,7 @@ func (s *ArtifactsGenericArtifactResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	_, err := s.Client.DeleteGenericArtifact(context.Background(), request)
+	_, err := s.Client.DeleteGenericArtifact(ctx, request)
 	return err
 }
 
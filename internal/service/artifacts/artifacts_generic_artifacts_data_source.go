@@ -6,6 +6,7 @@ package artifacts
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_artifacts "github.com/oracle/oci-go-sdk/v65/artifacts"
 
@@ -15,7 +16,7 @@ import (
 
 func ArtifactsGenericArtifactsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readArtifactsGenericArtifacts,
+		ReadContext: readArtifactsGenericArtifacts,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"artifact_path": {
@@ -68,12 +69,12 @@ func ArtifactsGenericArtifactsDataSource() *schema.Resource {
 	}
 }
 
-func readArtifactsGenericArtifacts(d *schema.ResourceData, m interface{}) error {
+func readArtifactsGenericArtifacts(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsGenericArtifactsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ArtifactsGenericArtifactsDataSourceCrud struct {
@@ -86,7 +87,7 @@ func (s *ArtifactsGenericArtifactsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ArtifactsGenericArtifactsDataSourceCrud) Get() error {
+func (s *ArtifactsGenericArtifactsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_artifacts.ListGenericArtifactsRequest{}
 
 	if artifactPath, ok := s.D.GetOkExists("artifact_path"); ok {
@@ -131,7 +132,7 @@ func (s *ArtifactsGenericArtifactsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "artifacts")
 
-	response, err := s.Client.ListGenericArtifacts(context.Background(), request)
+	response, err := s.Client.ListGenericArtifacts(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -140,7 +141,7 @@ func (s *ArtifactsGenericArtifactsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListGenericArtifacts(context.Background(), request)
+		listResponse, err := s.Client.ListGenericArtifacts(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package artifacts
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_artifacts "github.com/oracle/oci-go-sdk/v65/artifacts"
 
@@ -15,7 +16,7 @@ import (
 
 func ArtifactsRepositoriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readArtifactsRepositories,
+		ReadContext: readArtifactsRepositories,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -56,12 +57,12 @@ func ArtifactsRepositoriesDataSource() *schema.Resource {
 	}
 }
 
-func readArtifactsRepositories(d *schema.ResourceData, m interface{}) error {
+func readArtifactsRepositories(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsRepositoriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ArtifactsRepositoriesDataSourceCrud struct {
@@ -74,7 +75,7 @@ func (s *ArtifactsRepositoriesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ArtifactsRepositoriesDataSourceCrud) Get() error {
+func (s *ArtifactsRepositoriesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_artifacts.ListRepositoriesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -104,7 +105,7 @@ func (s *ArtifactsRepositoriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "artifacts")
 
-	response, err := s.Client.ListRepositories(context.Background(), request)
+	response, err := s.Client.ListRepositories(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -113,7 +114,7 @@ func (s *ArtifactsRepositoriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRepositories(context.Background(), request)
+		listResponse, err := s.Client.ListRepositories(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_artifacts "github.com/oracle/oci-go-sdk/v65/artifacts"
 
@@ -23,12 +24,12 @@ func ArtifactsRepositoryDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ArtifactsRepositoryResource(), fieldMap, readSingularArtifactsRepository)
 }
 
-func readSingularArtifactsRepository(d *schema.ResourceData, m interface{}) error {
+func readSingularArtifactsRepository(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsRepositoryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ArtifactsRepositoryDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *ArtifactsRepositoryDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ArtifactsRepositoryDataSourceCrud) Get() error {
+func (s *ArtifactsRepositoryDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_artifacts.GetRepositoryRequest{}
 
 	if repositoryId, ok := s.D.GetOkExists("repository_id"); ok {
@@ -51,7 +52,7 @@ func (s *ArtifactsRepositoryDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "artifacts")
 
-	response, err := s.Client.GetRepository(context.Background(), request)
+	response, err := s.Client.GetRepository(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_artifacts "github.com/oracle/oci-go-sdk/v65/artifacts"
@@ -22,11 +23,11 @@ func ArtifactsRepositoryResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createArtifactsRepository,
-		Read:     readArtifactsRepository,
-		Update:   updateArtifactsRepository,
-		Delete:   deleteArtifactsRepository,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createArtifactsRepository,
+		ReadContext:   readArtifactsRepository,
+		UpdateContext: updateArtifactsRepository,
+		DeleteContext: deleteArtifactsRepository,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -81,37 +82,37 @@ func ArtifactsRepositoryResource() *schema.Resource {
 	}
 }
 
-func createArtifactsRepository(d *schema.ResourceData, m interface{}) error {
+func createArtifactsRepository(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsRepositoryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readArtifactsRepository(d *schema.ResourceData, m interface{}) error {
+func readArtifactsRepository(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsRepositoryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateArtifactsRepository(d *schema.ResourceData, m interface{}) error {
+func updateArtifactsRepository(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsRepositoryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteArtifactsRepository(d *schema.ResourceData, m interface{}) error {
+func deleteArtifactsRepository(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ArtifactsRepositoryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ArtifactsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type ArtifactsRepositoryResourceCrud struct {
@@ -148,7 +149,7 @@ func (s *ArtifactsRepositoryResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *ArtifactsRepositoryResourceCrud) Create() error {
+func (s *ArtifactsRepositoryResourceCrud) Create(ctx context.Context) error {
 	request := oci_artifacts.CreateRepositoryRequest{}
 	err := s.populateTopLevelPolymorphicCreateRepositoryRequest(&request)
 	if err != nil {
@@ -157,7 +158,7 @@ func (s *ArtifactsRepositoryResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.CreateRepository(context.Background(), request)
+	response, err := s.Client.CreateRepository(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -166,7 +167,7 @@ func (s *ArtifactsRepositoryResourceCrud) Create() error {
 	return nil
 }
 
-func (s *ArtifactsRepositoryResourceCrud) Get() error {
+func (s *ArtifactsRepositoryResourceCrud) Get(ctx context.Context) error {
 	request := oci_artifacts.GetRepositoryRequest{}
 
 	tmp := s.D.Id()
@@ -174,7 +175,7 @@ func (s *ArtifactsRepositoryResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.GetRepository(context.Background(), request)
+	response, err := s.Client.GetRepository(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -183,11 +184,11 @@ func (s *ArtifactsRepositoryResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ArtifactsRepositoryResourceCrud) Update() error {
+func (s *ArtifactsRepositoryResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -201,7 +202,7 @@ func (s *ArtifactsRepositoryResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	response, err := s.Client.UpdateRepository(context.Background(), request)
+	response, err := s.Client.UpdateRepository(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -210,7 +211,7 @@ func (s *ArtifactsRepositoryResourceCrud) Update() error {
 	return nil
 }
 
-func (s *ArtifactsRepositoryResourceCrud) Delete() error {
+func (s *ArtifactsRepositoryResourceCrud) Delete(ctx context.Context) error {
 	request := oci_artifacts.DeleteRepositoryRequest{}
 
 	tmp := s.D.Id()
@@ -218,7 +219,7 @@ func (s *ArtifactsRepositoryResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	_, err := s.Client.DeleteRepository(context.Background(), request)
+	_, err := s.Client.DeleteRepository(ctx, request)
 	return err
 }
 
@@ -389,7 +390,7 @@ func (s *ArtifactsRepositoryResourceCrud) populateTopLevelPolymorphicUpdateRepos
 	return nil
 }
 
-func (s *ArtifactsRepositoryResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *ArtifactsRepositoryResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_artifacts.ChangeRepositoryCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -400,12 +401,12 @@ func (s *ArtifactsRepositoryResourceCrud) updateCompartment(compartment interfac
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
 
-	_, err := s.Client.ChangeRepositoryCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeRepositoryCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -10,6 +10,7 @@ import (
 
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_audit "github.com/oracle/oci-go-sdk/v65/audit"
 )
@@ -23,12 +24,12 @@ func AuditConfigurationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(AuditConfigurationResource(), fieldMap, readSingularAuditConfiguration)
 }
 
-func readSingularAuditConfiguration(d *schema.ResourceData, m interface{}) error {
+func readSingularAuditConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AuditConfigurationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AuditClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type AuditConfigurationDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *AuditConfigurationDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *AuditConfigurationDataSourceCrud) Get() error {
+func (s *AuditConfigurationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_audit.GetConfigurationRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -51,7 +52,7 @@ func (s *AuditConfigurationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "audit")
 
-	response, err := s.Client.GetConfiguration(context.Background(), request)
+	response, err := s.Client.GetConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
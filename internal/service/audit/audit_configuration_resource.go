@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_audit "github.com/oracle/oci-go-sdk/v65/audit"
@@ -17,11 +18,11 @@ import (
 
 func AuditConfigurationResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createAuditConfiguration,
-		Read:     readAuditConfiguration,
-		Update:   updateAuditConfiguration,
-		Delete:   deleteAuditConfiguration,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createAuditConfiguration,
+		ReadContext:   readAuditConfiguration,
+		UpdateContext: updateAuditConfiguration,
+		DeleteContext: deleteAuditConfiguration,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -41,31 +42,31 @@ func AuditConfigurationResource() *schema.Resource {
 	}
 }
 
-func createAuditConfiguration(d *schema.ResourceData, m interface{}) error {
+func createAuditConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AuditConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AuditClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readAuditConfiguration(d *schema.ResourceData, m interface{}) error {
+func readAuditConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AuditConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AuditClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateAuditConfiguration(d *schema.ResourceData, m interface{}) error {
+func updateAuditConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AuditConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AuditClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteAuditConfiguration(d *schema.ResourceData, m interface{}) error {
+func deleteAuditConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -80,12 +81,12 @@ func (s *AuditConfigurationResourceCrud) ID() string {
 	return s.D.Get("compartment_id").(string)
 }
 
-func (s *AuditConfigurationResourceCrud) Create() error {
+func (s *AuditConfigurationResourceCrud) Create(ctx context.Context) error {
 	// This resource can't actually be created. So treat it as an Update instead.
-	return s.Update()
+	return s.Update(ctx)
 }
 
-func (s *AuditConfigurationResourceCrud) Get() error {
+func (s *AuditConfigurationResourceCrud) Get(ctx context.Context) error {
 	request := oci_audit.GetConfigurationRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -95,7 +96,7 @@ func (s *AuditConfigurationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "audit")
 
-	response, err := s.Client.GetConfiguration(context.Background(), request)
+	response, err := s.Client.GetConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -104,7 +105,7 @@ func (s *AuditConfigurationResourceCrud) Get() error {
 	return nil
 }
 
-func (s *AuditConfigurationResourceCrud) Update() error {
+func (s *AuditConfigurationResourceCrud) Update(ctx context.Context) error {
 	request := oci_audit.UpdateConfigurationRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -119,7 +120,7 @@ func (s *AuditConfigurationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "audit")
 
-	_, err := s.Client.UpdateConfiguration(context.Background(), request)
+	_, err := s.Client.UpdateConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -131,7 +132,7 @@ func (s *AuditConfigurationResourceCrud) Update() error {
 	// Requests to Update the retention policy may succeed instantly but may not see the actual Update take effect
 	// until minutes later. Add polling here to return only when the change has taken effect.
 	retentionPolicyFunc := func() bool { return *s.Res.RetentionPeriodDays == *request.RetentionPeriodDays }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *AuditConfigurationResourceCrud) SetData() error {
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_audit "github.com/oracle/oci-go-sdk/v65/audit"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
@@ -18,7 +19,7 @@ import (
 
 func AuditAuditEventsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readAuditAuditEvents,
+		ReadContext: readAuditAuditEvents,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -280,12 +281,12 @@ func AuditAuditEventsDataSource() *schema.Resource {
 	}
 }
 
-func readAuditAuditEvents(d *schema.ResourceData, m interface{}) error {
+func readAuditAuditEvents(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AuditAuditEventsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AuditClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type AuditAuditEventsDataSourceCrud struct {
@@ -298,7 +299,7 @@ func (s *AuditAuditEventsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *AuditAuditEventsDataSourceCrud) Get() error {
+func (s *AuditAuditEventsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_audit.ListEventsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -324,7 +325,7 @@ func (s *AuditAuditEventsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "audit")
 
-	response, err := s.Client.ListEvents(context.Background(), request)
+	response, err := s.Client.ListEvents(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -333,7 +334,7 @@ func (s *AuditAuditEventsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListEvents(context.Background(), request)
+		listResponse, err := s.Client.ListEvents(ctx, request)
 		if err != nil {
 			return err
 		}
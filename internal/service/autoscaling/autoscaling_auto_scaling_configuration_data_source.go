@@ -6,6 +6,7 @@ package autoscaling
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_auto_scaling "github.com/oracle/oci-go-sdk/v65/autoscaling"
 
@@ -22,12 +23,12 @@ func AutoScalingAutoScalingConfigurationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(AutoScalingAutoScalingConfigurationResource(), fieldMap, readSingularAutoScalingAutoScalingConfiguration)
 }
 
-func readSingularAutoScalingAutoScalingConfiguration(d *schema.ResourceData, m interface{}) error {
+func readSingularAutoScalingAutoScalingConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AutoScalingAutoScalingConfigurationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AutoScalingClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type AutoScalingAutoScalingConfigurationDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *AutoScalingAutoScalingConfigurationDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *AutoScalingAutoScalingConfigurationDataSourceCrud) Get() error {
+func (s *AutoScalingAutoScalingConfigurationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_auto_scaling.GetAutoScalingConfigurationRequest{}
 
 	if autoScalingConfigurationId, ok := s.D.GetOkExists("auto_scaling_configuration_id"); ok {
@@ -50,7 +51,7 @@ func (s *AutoScalingAutoScalingConfigurationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "auto_scaling")
 
-	response, err := s.Client.GetAutoScalingConfiguration(context.Background(), request)
+	response, err := s.Client.GetAutoScalingConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -26,11 +27,11 @@ func AutoScalingAutoScalingConfigurationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createAutoScalingAutoScalingConfiguration,
-		Read:     readAutoScalingAutoScalingConfiguration,
-		Update:   updateAutoScalingAutoScalingConfiguration,
-		Delete:   deleteAutoScalingAutoScalingConfiguration,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createAutoScalingAutoScalingConfiguration,
+		ReadContext:   readAutoScalingAutoScalingConfiguration,
+		UpdateContext: updateAutoScalingAutoScalingConfiguration,
+		DeleteContext: deleteAutoScalingAutoScalingConfiguration,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"auto_scaling_resources": {
@@ -363,37 +364,37 @@ func AutoScalingAutoScalingConfigurationResource() *schema.Resource {
 	}
 }
 
-func createAutoScalingAutoScalingConfiguration(d *schema.ResourceData, m interface{}) error {
+func createAutoScalingAutoScalingConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AutoScalingAutoScalingConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AutoScalingClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readAutoScalingAutoScalingConfiguration(d *schema.ResourceData, m interface{}) error {
+func readAutoScalingAutoScalingConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AutoScalingAutoScalingConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AutoScalingClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateAutoScalingAutoScalingConfiguration(d *schema.ResourceData, m interface{}) error {
+func updateAutoScalingAutoScalingConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AutoScalingAutoScalingConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AutoScalingClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteAutoScalingAutoScalingConfiguration(d *schema.ResourceData, m interface{}) error {
+func deleteAutoScalingAutoScalingConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AutoScalingAutoScalingConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AutoScalingClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type AutoScalingAutoScalingConfigurationResourceCrud struct {
@@ -407,7 +408,7 @@ func (s *AutoScalingAutoScalingConfigurationResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *AutoScalingAutoScalingConfigurationResourceCrud) Create() error {
+func (s *AutoScalingAutoScalingConfigurationResourceCrud) Create(ctx context.Context) error {
 	request := oci_auto_scaling.CreateAutoScalingConfigurationRequest{}
 
 	if autoScalingResources, ok := s.D.GetOkExists("auto_scaling_resources"); ok {
@@ -472,7 +473,7 @@ func (s *AutoScalingAutoScalingConfigurationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "auto_scaling")
 
-	response, err := s.Client.CreateAutoScalingConfiguration(context.Background(), request)
+	response, err := s.Client.CreateAutoScalingConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -481,7 +482,7 @@ func (s *AutoScalingAutoScalingConfigurationResourceCrud) Create() error {
 	return nil
 }
 
-func (s *AutoScalingAutoScalingConfigurationResourceCrud) Get() error {
+func (s *AutoScalingAutoScalingConfigurationResourceCrud) Get(ctx context.Context) error {
 	request := oci_auto_scaling.GetAutoScalingConfigurationRequest{}
 
 	tmp := s.D.Id()
@@ -489,7 +490,7 @@ func (s *AutoScalingAutoScalingConfigurationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "auto_scaling")
 
-	response, err := s.Client.GetAutoScalingConfiguration(context.Background(), request)
+	response, err := s.Client.GetAutoScalingConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -498,11 +499,11 @@ func (s *AutoScalingAutoScalingConfigurationResourceCrud) Get() error {
 	return nil
 }
 
-func (s *AutoScalingAutoScalingConfigurationResourceCrud) Update() error {
+func (s *AutoScalingAutoScalingConfigurationResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -546,12 +547,12 @@ func (s *AutoScalingAutoScalingConfigurationResourceCrud) Update() error {
 	}
 
 	if !updateFlag {
-		return s.Get()
+		return s.Get(ctx)
 	}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "auto_scaling")
 
-	response, err := s.Client.UpdateAutoScalingConfiguration(context.Background(), request)
+	response, err := s.Client.UpdateAutoScalingConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -560,7 +561,7 @@ func (s *AutoScalingAutoScalingConfigurationResourceCrud) Update() error {
 	return nil
 }
 
-func (s *AutoScalingAutoScalingConfigurationResourceCrud) Delete() error {
+func (s *AutoScalingAutoScalingConfigurationResourceCrud) Delete(ctx context.Context) error {
 	request := oci_auto_scaling.DeleteAutoScalingConfigurationRequest{}
 
 	tmp := s.D.Id()
@@ -568,7 +569,7 @@ func (s *AutoScalingAutoScalingConfigurationResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "auto_scaling")
 
-	_, err := s.Client.DeleteAutoScalingConfiguration(context.Background(), request)
+	_, err := s.Client.DeleteAutoScalingConfiguration(ctx, request)
 	return err
 }
 
@@ -1150,7 +1151,7 @@ func autoScalingConfigurationPolicyRulesHashCodeForSets(v interface{}) int {
 	}
 	return utils.GetStringHashcode(buf.String())
 }
-func (s *AutoScalingAutoScalingConfigurationResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *AutoScalingAutoScalingConfigurationResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_auto_scaling.ChangeAutoScalingConfigurationCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -1162,12 +1163,12 @@ func (s *AutoScalingAutoScalingConfigurationResourceCrud) updateCompartment(comp
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "auto_scaling")
 
-	_, err := s.Client.ChangeAutoScalingConfigurationCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeAutoScalingConfigurationCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
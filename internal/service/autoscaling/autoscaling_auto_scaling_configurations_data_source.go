@@ -6,6 +6,7 @@ package autoscaling
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_auto_scaling "github.com/oracle/oci-go-sdk/v65/autoscaling"
 
@@ -15,7 +16,7 @@ import (
 
 func AutoScalingAutoScalingConfigurationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readAutoScalingAutoScalingConfigurations,
+		ReadContext: readAutoScalingAutoScalingConfigurations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -35,12 +36,12 @@ func AutoScalingAutoScalingConfigurationsDataSource() *schema.Resource {
 	}
 }
 
-func readAutoScalingAutoScalingConfigurations(d *schema.ResourceData, m interface{}) error {
+func readAutoScalingAutoScalingConfigurations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AutoScalingAutoScalingConfigurationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AutoScalingClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type AutoScalingAutoScalingConfigurationsDataSourceCrud struct {
@@ -53,7 +54,7 @@ func (s *AutoScalingAutoScalingConfigurationsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *AutoScalingAutoScalingConfigurationsDataSourceCrud) Get() error {
+func (s *AutoScalingAutoScalingConfigurationsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_auto_scaling.ListAutoScalingConfigurationsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -68,7 +69,7 @@ func (s *AutoScalingAutoScalingConfigurationsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "auto_scaling")
 
-	response, err := s.Client.ListAutoScalingConfigurations(context.Background(), request)
+	response, err := s.Client.ListAutoScalingConfigurations(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -77,7 +78,7 @@ func (s *AutoScalingAutoScalingConfigurationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAutoScalingConfigurations(context.Background(), request)
+		listResponse, err := s.Client.ListAutoScalingConfigurations(ctx, request)
 		if err != nil {
 			return err
 		}
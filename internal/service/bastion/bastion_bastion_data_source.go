@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_bastion "github.com/oracle/oci-go-sdk/v65/bastion"
 )
@@ -22,12 +23,12 @@ func BastionBastionDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(BastionBastionResource(), fieldMap, readSingularBastionBastion)
 }
 
-func readSingularBastionBastion(d *schema.ResourceData, m interface{}) error {
+func readSingularBastionBastion(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BastionBastionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BastionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type BastionBastionDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *BastionBastionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *BastionBastionDataSourceCrud) Get() error {
+func (s *BastionBastionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_bastion.GetBastionRequest{}
 
 	if bastionId, ok := s.D.GetOkExists("bastion_id"); ok {
@@ -50,7 +51,7 @@ func (s *BastionBastionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "bastion")
 
-	response, err := s.Client.GetBastion(context.Background(), request)
+	response, err := s.Client.GetBastion(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_bastion "github.com/oracle/oci-go-sdk/v65/bastion"
 )
@@ -22,12 +23,12 @@ func BastionSessionDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(BastionSessionResource(), fieldMap, readSingularBastionSession)
 }
 
-func readSingularBastionSession(d *schema.ResourceData, m interface{}) error {
+func readSingularBastionSession(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BastionSessionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BastionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type BastionSessionDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *BastionSessionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *BastionSessionDataSourceCrud) Get() error {
+func (s *BastionSessionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_bastion.GetSessionRequest{}
 
 	if sessionId, ok := s.D.GetOkExists("session_id"); ok {
@@ -50,7 +51,7 @@ func (s *BastionSessionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "bastion")
 
-	response, err := s.Client.GetSession(context.Background(), request)
+	response, err := s.Client.GetSession(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func BastionSessionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createBastionSession,
-		Read:     readBastionSession,
-		Update:   updateBastionSession,
-		Delete:   deleteBastionSession,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createBastionSession,
+		ReadContext:   readBastionSession,
+		UpdateContext: updateBastionSession,
+		DeleteContext: deleteBastionSession,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"bastion_id": {
@@ -170,37 +171,37 @@ func BastionSessionResource() *schema.Resource {
 	}
 }
 
-func createBastionSession(d *schema.ResourceData, m interface{}) error {
+func createBastionSession(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BastionSessionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BastionClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readBastionSession(d *schema.ResourceData, m interface{}) error {
+func readBastionSession(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BastionSessionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BastionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateBastionSession(d *schema.ResourceData, m interface{}) error {
+func updateBastionSession(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BastionSessionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BastionClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteBastionSession(d *schema.ResourceData, m interface{}) error {
+func deleteBastionSession(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BastionSessionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BastionClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type BastionSessionResourceCrud struct {
@@ -238,7 +239,7 @@ func (s *BastionSessionResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *BastionSessionResourceCrud) Create() error {
+func (s *BastionSessionResourceCrud) Create(ctx context.Context) error {
 	request := oci_bastion.CreateSessionRequest{}
 
 	if bastionId, ok := s.D.GetOkExists("bastion_id"); ok {
@@ -284,20 +285,20 @@ func (s *BastionSessionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion")
 
-	response, err := s.Client.CreateSession(context.Background(), request)
+	response, err := s.Client.CreateSession(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getSessionFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion"), oci_bastion.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getSessionFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion"), oci_bastion.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *BastionSessionResourceCrud) getSessionFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *BastionSessionResourceCrud) getSessionFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_bastion.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	sessionId, err := sessionWaitForWorkRequest(workId, "session",
+	sessionId, err := sessionWaitForWorkRequest(ctx, workId, "session",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -305,7 +306,7 @@ func (s *BastionSessionResourceCrud) getSessionFromWorkRequest(workId *string, r
 	}
 	s.D.SetId(*sessionId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func sessionWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -331,7 +332,7 @@ func sessionWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_
 	}
 }
 
-func sessionWaitForWorkRequest(wId *string, entityType string, action oci_bastion.ActionTypeEnum,
+func sessionWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_bastion.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_bastion.BastionClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "bastion")
 	retryPolicy.ShouldRetryOperation = sessionWorkRequestShouldRetryFunc(timeout)
@@ -350,7 +351,7 @@ func sessionWaitForWorkRequest(wId *string, entityType string, action oci_bastio
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_bastion.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -380,14 +381,14 @@ func sessionWaitForWorkRequest(wId *string, entityType string, action oci_bastio
 	log.Printf("[DEBUG] identifier is %v status is %v \n", identifier, response.Status)
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_bastion.OperationStatusFailed || response.Status == oci_bastion.OperationStatusCanceled {
-		return nil, getErrorFromBastionSessionWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromBastionSessionWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromBastionSessionWorkRequest(client *oci_bastion.BastionClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_bastion.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromBastionSessionWorkRequest(ctx context.Context, client *oci_bastion.BastionClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_bastion.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_bastion.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -409,7 +410,7 @@ func getErrorFromBastionSessionWorkRequest(client *oci_bastion.BastionClient, wo
 	return workRequestErr
 }
 
-func (s *BastionSessionResourceCrud) Get() error {
+func (s *BastionSessionResourceCrud) Get(ctx context.Context) error {
 	request := oci_bastion.GetSessionRequest{}
 
 	tmp := s.D.Id()
@@ -417,7 +418,7 @@ func (s *BastionSessionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion")
 
-	response, err := s.Client.GetSession(context.Background(), request)
+	response, err := s.Client.GetSession(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -426,7 +427,7 @@ func (s *BastionSessionResourceCrud) Get() error {
 	return nil
 }
 
-func (s *BastionSessionResourceCrud) Update() error {
+func (s *BastionSessionResourceCrud) Update(ctx context.Context) error {
 	request := oci_bastion.UpdateSessionRequest{}
 
 	if displayName, ok := s.D.GetOkExists("display_name"); ok {
@@ -439,7 +440,7 @@ func (s *BastionSessionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion")
 
-	response, err := s.Client.UpdateSession(context.Background(), request)
+	response, err := s.Client.UpdateSession(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -448,7 +449,7 @@ func (s *BastionSessionResourceCrud) Update() error {
 	return nil
 }
 
-func (s *BastionSessionResourceCrud) Delete() error {
+func (s *BastionSessionResourceCrud) Delete(ctx context.Context) error {
 	request := oci_bastion.DeleteSessionRequest{}
 
 	tmp := s.D.Id()
@@ -456,14 +457,14 @@ func (s *BastionSessionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bastion")
 
-	response, err := s.Client.DeleteSession(context.Background(), request)
+	response, err := s.Client.DeleteSession(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := sessionWaitForWorkRequest(workId, "session",
+	_, delWorkRequestErr := sessionWaitForWorkRequest(ctx, workId, "session",
 		oci_bastion.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_bds "github.com/oracle/oci-go-sdk/v65/bds"
 )
@@ -26,12 +27,12 @@ func BdsAutoScalingConfigurationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(BdsAutoScalingConfigurationResource(), fieldMap, readSingularBdsAutoScalingConfiguration)
 }
 
-func readSingularBdsAutoScalingConfiguration(d *schema.ResourceData, m interface{}) error {
+func readSingularBdsAutoScalingConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsAutoScalingConfigurationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type BdsAutoScalingConfigurationDataSourceCrud struct {
@@ -44,7 +45,7 @@ func (s *BdsAutoScalingConfigurationDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *BdsAutoScalingConfigurationDataSourceCrud) Get() error {
+func (s *BdsAutoScalingConfigurationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_bds.GetAutoScalingConfigurationRequest{}
 
 	if autoScalingConfigurationId, ok := s.D.GetOkExists("auto_scaling_configuration_id"); ok {
@@ -59,7 +60,7 @@ func (s *BdsAutoScalingConfigurationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "bds")
 
-	response, err := s.Client.GetAutoScalingConfiguration(context.Background(), request)
+	response, err := s.Client.GetAutoScalingConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
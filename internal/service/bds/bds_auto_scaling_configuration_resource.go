@@ -15,6 +15,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -28,11 +29,11 @@ func BdsAutoScalingConfigurationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createBdsAutoScalingConfiguration,
-		Read:     readBdsAutoScalingConfiguration,
-		Update:   updateBdsAutoScalingConfiguration,
-		Delete:   deleteBdsAutoScalingConfiguration,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createBdsAutoScalingConfiguration,
+		ReadContext:   readBdsAutoScalingConfiguration,
+		UpdateContext: updateBdsAutoScalingConfiguration,
+		DeleteContext: deleteBdsAutoScalingConfiguration,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"bds_instance_id": {
@@ -616,36 +617,36 @@ func BdsAutoScalingConfigurationResource() *schema.Resource {
 	}
 }
 
-func createBdsAutoScalingConfiguration(d *schema.ResourceData, m interface{}) error {
+func createBdsAutoScalingConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsAutoScalingConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readBdsAutoScalingConfiguration(d *schema.ResourceData, m interface{}) error {
+func readBdsAutoScalingConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsAutoScalingConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateBdsAutoScalingConfiguration(d *schema.ResourceData, m interface{}) error {
+func updateBdsAutoScalingConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsAutoScalingConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteBdsAutoScalingConfiguration(d *schema.ResourceData, m interface{}) error {
+func deleteBdsAutoScalingConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsAutoScalingConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type BdsAutoScalingConfigurationResourceCrud struct {
@@ -683,7 +684,7 @@ func (s *BdsAutoScalingConfigurationResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *BdsAutoScalingConfigurationResourceCrud) Create() error {
+func (s *BdsAutoScalingConfigurationResourceCrud) Create(ctx context.Context) error {
 	request := oci_bds.AddAutoScalingConfigurationRequest{}
 
 	if bdsInstanceId, ok := s.D.GetOkExists("bds_instance_id"); ok {
@@ -734,20 +735,20 @@ func (s *BdsAutoScalingConfigurationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.AddAutoScalingConfiguration(context.Background(), request)
+	response, err := s.Client.AddAutoScalingConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getAutoScalingConfigurationFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getAutoScalingConfigurationFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *BdsAutoScalingConfigurationResourceCrud) getAutoScalingConfigurationFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *BdsAutoScalingConfigurationResourceCrud) getAutoScalingConfigurationFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_bds.ActionTypesEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	compartmentId, err := autoScalingConfigurationWaitForWorkRequest(workId, "bds",
+	compartmentId, err := autoScalingConfigurationWaitForWorkRequest(ctx, workId, "bds",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -755,7 +756,7 @@ func (s *BdsAutoScalingConfigurationResourceCrud) getAutoScalingConfigurationFro
 	}
 
 	// Temporary manual change required since autoscaling configuration ID is not present in the work request
-	autoScalingConfigurationId, err := s.List(compartmentId)
+	autoScalingConfigurationId, err := s.List(ctx, compartmentId)
 
 	if err != nil {
 		return err
@@ -771,7 +772,7 @@ func (s *BdsAutoScalingConfigurationResourceCrud) getAutoScalingConfigurationFro
 
 	s.D.SetId(compositeId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func autoScalingConfigurationWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -797,7 +798,7 @@ func autoScalingConfigurationWorkRequestShouldRetryFunc(timeout time.Duration) f
 	}
 }
 
-func autoScalingConfigurationWaitForWorkRequest(wId *string, entityType string, action oci_bds.ActionTypesEnum,
+func autoScalingConfigurationWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_bds.ActionTypesEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_bds.BdsClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "bds")
 	retryPolicy.ShouldRetryOperation = autoScalingConfigurationWorkRequestShouldRetryFunc(timeout)
@@ -816,7 +817,7 @@ func autoScalingConfigurationWaitForWorkRequest(wId *string, entityType string,
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_bds.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -839,14 +840,14 @@ func autoScalingConfigurationWaitForWorkRequest(wId *string, entityType string,
 
 	// The workrequest didn't do all its intended tasks, if the errors is set; so we should check for it
 	if compartmentId == nil {
-		return nil, getErrorFromBdsAutoScalingConfigurationWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromBdsAutoScalingConfigurationWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return compartmentId, nil
 }
 
-func getErrorFromBdsAutoScalingConfigurationWorkRequest(client *oci_bds.BdsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_bds.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromBdsAutoScalingConfigurationWorkRequest(ctx context.Context, client *oci_bds.BdsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_bds.ActionTypesEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_bds.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -868,7 +869,7 @@ func getErrorFromBdsAutoScalingConfigurationWorkRequest(client *oci_bds.BdsClien
 	return workRequestErr
 }
 
-func (s *BdsAutoScalingConfigurationResourceCrud) List(compartmentId *string) (*string, error) {
+func (s *BdsAutoScalingConfigurationResourceCrud) List(ctx context.Context, compartmentId *string) (*string, error) {
 	request := oci_bds.ListAutoScalingConfigurationsRequest{}
 
 	request.CompartmentId = compartmentId
@@ -887,7 +888,7 @@ func (s *BdsAutoScalingConfigurationResourceCrud) List(compartmentId *string) (*
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "bds")
 
-	response, err := s.Client.ListAutoScalingConfigurations(context.Background(), request)
+	response, err := s.Client.ListAutoScalingConfigurations(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -897,7 +898,7 @@ func (s *BdsAutoScalingConfigurationResourceCrud) List(compartmentId *string) (*
 	return identifier, nil
 }
 
-func (s *BdsAutoScalingConfigurationResourceCrud) Get() error {
+func (s *BdsAutoScalingConfigurationResourceCrud) Get(ctx context.Context) error {
 	request := oci_bds.GetAutoScalingConfigurationRequest{}
 
 	tmp := s.D.Id()
@@ -918,7 +919,7 @@ func (s *BdsAutoScalingConfigurationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.GetAutoScalingConfiguration(context.Background(), request)
+	response, err := s.Client.GetAutoScalingConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -927,7 +928,7 @@ func (s *BdsAutoScalingConfigurationResourceCrud) Get() error {
 	return nil
 }
 
-func (s *BdsAutoScalingConfigurationResourceCrud) Update() error {
+func (s *BdsAutoScalingConfigurationResourceCrud) Update(ctx context.Context) error {
 	request := oci_bds.UpdateAutoScalingConfigurationRequest{}
 
 	tmp := s.D.Id()
@@ -977,16 +978,16 @@ func (s *BdsAutoScalingConfigurationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.UpdateAutoScalingConfiguration(context.Background(), request)
+	response, err := s.Client.UpdateAutoScalingConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getAutoScalingConfigurationFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getAutoScalingConfigurationFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *BdsAutoScalingConfigurationResourceCrud) Delete() error {
+func (s *BdsAutoScalingConfigurationResourceCrud) Delete(ctx context.Context) error {
 	request := oci_bds.RemoveAutoScalingConfigurationRequest{}
 
 	tmp := s.D.Id()
@@ -1004,7 +1005,7 @@ func (s *BdsAutoScalingConfigurationResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	_, err := s.Client.RemoveAutoScalingConfiguration(context.Background(), request)
+	_, err := s.Client.RemoveAutoScalingConfiguration(ctx, request)
 	return err
 }
 
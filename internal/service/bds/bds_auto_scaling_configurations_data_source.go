@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_bds "github.com/oracle/oci-go-sdk/v65/bds"
 )
 
 func BdsAutoScalingConfigurationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readBdsAutoScalingConfigurations,
+		ReadContext: readBdsAutoScalingConfigurations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"bds_instance_id": {
@@ -43,12 +44,12 @@ func BdsAutoScalingConfigurationsDataSource() *schema.Resource {
 	}
 }
 
-func readBdsAutoScalingConfigurations(d *schema.ResourceData, m interface{}) error {
+func readBdsAutoScalingConfigurations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsAutoScalingConfigurationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type BdsAutoScalingConfigurationsDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *BdsAutoScalingConfigurationsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *BdsAutoScalingConfigurationsDataSourceCrud) Get() error {
+func (s *BdsAutoScalingConfigurationsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_bds.ListAutoScalingConfigurationsRequest{}
 
 	if bdsInstanceId, ok := s.D.GetOkExists("bds_instance_id"); ok {
@@ -81,7 +82,7 @@ func (s *BdsAutoScalingConfigurationsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "bds")
 
-	response, err := s.Client.ListAutoScalingConfigurations(context.Background(), request)
+	response, err := s.Client.ListAutoScalingConfigurations(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -90,7 +91,7 @@ func (s *BdsAutoScalingConfigurationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAutoScalingConfigurations(context.Background(), request)
+		listResponse, err := s.Client.ListAutoScalingConfigurations(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -15,6 +15,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -27,10 +28,10 @@ func BdsBdsInstanceApiKeyResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createBdsBdsInstanceApiKey,
-		Read:     readBdsBdsInstanceApiKey,
-		Delete:   deleteBdsBdsInstanceApiKey,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createBdsBdsInstanceApiKey,
+		ReadContext:   readBdsBdsInstanceApiKey,
+		DeleteContext: deleteBdsBdsInstanceApiKey,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"bds_instance_id": {
@@ -88,29 +89,29 @@ func BdsBdsInstanceApiKeyResource() *schema.Resource {
 	}
 }
 
-func createBdsBdsInstanceApiKey(d *schema.ResourceData, m interface{}) error {
+func createBdsBdsInstanceApiKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsBdsInstanceApiKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readBdsBdsInstanceApiKey(d *schema.ResourceData, m interface{}) error {
+func readBdsBdsInstanceApiKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsBdsInstanceApiKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteBdsBdsInstanceApiKey(d *schema.ResourceData, m interface{}) error {
+func deleteBdsBdsInstanceApiKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsBdsInstanceApiKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type BdsBdsInstanceApiKeyResourceCrud struct {
@@ -148,7 +149,7 @@ func (s *BdsBdsInstanceApiKeyResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *BdsBdsInstanceApiKeyResourceCrud) Create() error {
+func (s *BdsBdsInstanceApiKeyResourceCrud) Create(ctx context.Context) error {
 	request := oci_bds.CreateBdsApiKeyRequest{}
 
 	if bdsInstanceId, ok := s.D.GetOkExists("bds_instance_id"); ok {
@@ -178,20 +179,20 @@ func (s *BdsBdsInstanceApiKeyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.CreateBdsApiKey(context.Background(), request)
+	response, err := s.Client.CreateBdsApiKey(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getBdsInstanceApiKeyFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getBdsInstanceApiKeyFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *BdsBdsInstanceApiKeyResourceCrud) getBdsInstanceApiKeyFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *BdsBdsInstanceApiKeyResourceCrud) getBdsInstanceApiKeyFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_bds.ActionTypesEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	bdsInstanceApiKeyId, err := bdsInstanceApiKeyWaitForWorkRequest(workId, "bds",
+	bdsInstanceApiKeyId, err := bdsInstanceApiKeyWaitForWorkRequest(ctx, workId, "bds",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -199,7 +200,7 @@ func (s *BdsBdsInstanceApiKeyResourceCrud) getBdsInstanceApiKeyFromWorkRequest(w
 	}
 	s.D.SetId(*bdsInstanceApiKeyId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func bdsInstanceApiKeyWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -225,7 +226,7 @@ func bdsInstanceApiKeyWorkRequestShouldRetryFunc(timeout time.Duration) func(res
 	}
 }
 
-func bdsInstanceApiKeyWaitForWorkRequest(wId *string, entityType string, action oci_bds.ActionTypesEnum,
+func bdsInstanceApiKeyWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_bds.ActionTypesEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_bds.BdsClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "bds")
 	retryPolicy.ShouldRetryOperation = bdsInstanceApiKeyWorkRequestShouldRetryFunc(timeout)
@@ -244,7 +245,7 @@ func bdsInstanceApiKeyWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_bds.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -277,14 +278,14 @@ func bdsInstanceApiKeyWaitForWorkRequest(wId *string, entityType string, action
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_bds.OperationStatusFailed || response.Status == oci_bds.OperationStatusCanceled {
-		return nil, getErrorFromBdsBdsInstanceApiKeyWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromBdsBdsInstanceApiKeyWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromBdsBdsInstanceApiKeyWorkRequest(client *oci_bds.BdsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_bds.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromBdsBdsInstanceApiKeyWorkRequest(ctx context.Context, client *oci_bds.BdsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_bds.ActionTypesEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_bds.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -306,7 +307,7 @@ func getErrorFromBdsBdsInstanceApiKeyWorkRequest(client *oci_bds.BdsClient, work
 	return workRequestErr
 }
 
-func (s *BdsBdsInstanceApiKeyResourceCrud) Get() error {
+func (s *BdsBdsInstanceApiKeyResourceCrud) Get(ctx context.Context) error {
 	request := oci_bds.GetBdsApiKeyRequest{}
 
 	tmp := s.D.Id()
@@ -327,7 +328,7 @@ func (s *BdsBdsInstanceApiKeyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.GetBdsApiKey(context.Background(), request)
+	response, err := s.Client.GetBdsApiKey(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -336,7 +337,7 @@ func (s *BdsBdsInstanceApiKeyResourceCrud) Get() error {
 	return nil
 }
 
-func (s *BdsBdsInstanceApiKeyResourceCrud) Delete() error {
+func (s *BdsBdsInstanceApiKeyResourceCrud) Delete(ctx context.Context) error {
 	request := oci_bds.DeleteBdsApiKeyRequest{}
 
 	tmp := s.D.Id()
@@ -349,14 +350,14 @@ func (s *BdsBdsInstanceApiKeyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.DeleteBdsApiKey(context.Background(), request)
+	response, err := s.Client.DeleteBdsApiKey(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := bdsInstanceApiKeyWaitForWorkRequest(workId, "bds",
+	_, delWorkRequestErr := bdsInstanceApiKeyWaitForWorkRequest(ctx, workId, "bds",
 		oci_bds.ActionTypesDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_bds "github.com/oracle/oci-go-sdk/v65/bds"
 )
@@ -22,12 +23,12 @@ func BdsBdsInstanceDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(BdsBdsInstanceResource(), fieldMap, readSingularBdsBdsInstance)
 }
 
-func readSingularBdsBdsInstance(d *schema.ResourceData, m interface{}) error {
+func readSingularBdsBdsInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsBdsInstanceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type BdsBdsInstanceDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *BdsBdsInstanceDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *BdsBdsInstanceDataSourceCrud) Get() error {
+func (s *BdsBdsInstanceDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_bds.GetBdsInstanceRequest{}
 
 	if bdsInstanceId, ok := s.D.GetOkExists("bds_instance_id"); ok {
@@ -50,7 +51,7 @@ func (s *BdsBdsInstanceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "bds")
 
-	response, err := s.Client.GetBdsInstance(context.Background(), request)
+	response, err := s.Client.GetBdsInstance(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -27,11 +28,11 @@ func BdsBdsInstanceMetastoreConfigResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createBdsBdsInstanceMetastoreConfig,
-		Read:     readBdsBdsInstanceMetastoreConfig,
-		Update:   updateBdsBdsInstanceMetastoreConfig,
-		Delete:   deleteBdsBdsInstanceMetastoreConfig,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createBdsBdsInstanceMetastoreConfig,
+		ReadContext:   readBdsBdsInstanceMetastoreConfig,
+		UpdateContext: updateBdsBdsInstanceMetastoreConfig,
+		DeleteContext: deleteBdsBdsInstanceMetastoreConfig,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"bds_api_key_id": {
@@ -93,37 +94,37 @@ func BdsBdsInstanceMetastoreConfigResource() *schema.Resource {
 	}
 }
 
-func createBdsBdsInstanceMetastoreConfig(d *schema.ResourceData, m interface{}) error {
+func createBdsBdsInstanceMetastoreConfig(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsBdsInstanceMetastoreConfigResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readBdsBdsInstanceMetastoreConfig(d *schema.ResourceData, m interface{}) error {
+func readBdsBdsInstanceMetastoreConfig(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsBdsInstanceMetastoreConfigResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateBdsBdsInstanceMetastoreConfig(d *schema.ResourceData, m interface{}) error {
+func updateBdsBdsInstanceMetastoreConfig(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsBdsInstanceMetastoreConfigResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteBdsBdsInstanceMetastoreConfig(d *schema.ResourceData, m interface{}) error {
+func deleteBdsBdsInstanceMetastoreConfig(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsBdsInstanceMetastoreConfigResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type BdsBdsInstanceMetastoreConfigResourceCrud struct {
@@ -162,7 +163,7 @@ func (s *BdsBdsInstanceMetastoreConfigResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *BdsBdsInstanceMetastoreConfigResourceCrud) Create() error {
+func (s *BdsBdsInstanceMetastoreConfigResourceCrud) Create(ctx context.Context) error {
 	request := oci_bds.CreateBdsMetastoreConfigurationRequest{}
 
 	if bdsApiKeyId, ok := s.D.GetOkExists("bds_api_key_id"); ok {
@@ -197,20 +198,20 @@ func (s *BdsBdsInstanceMetastoreConfigResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.CreateBdsMetastoreConfiguration(context.Background(), request)
+	response, err := s.Client.CreateBdsMetastoreConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getBdsInstanceMetastoreConfigFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getBdsInstanceMetastoreConfigFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *BdsBdsInstanceMetastoreConfigResourceCrud) getBdsInstanceMetastoreConfigFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *BdsBdsInstanceMetastoreConfigResourceCrud) getBdsInstanceMetastoreConfigFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_bds.ActionTypesEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	bdsInstanceMetastoreConfigId, err := bdsInstanceMetastoreConfigWaitForWorkRequest(workId, "bds",
+	bdsInstanceMetastoreConfigId, err := bdsInstanceMetastoreConfigWaitForWorkRequest(ctx, workId, "bds",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -218,7 +219,7 @@ func (s *BdsBdsInstanceMetastoreConfigResourceCrud) getBdsInstanceMetastoreConfi
 	}
 	s.D.SetId(*bdsInstanceMetastoreConfigId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func bdsInstanceMetastoreConfigWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -244,7 +245,7 @@ func bdsInstanceMetastoreConfigWorkRequestShouldRetryFunc(timeout time.Duration)
 	}
 }
 
-func bdsInstanceMetastoreConfigWaitForWorkRequest(wId *string, entityType string, action oci_bds.ActionTypesEnum,
+func bdsInstanceMetastoreConfigWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_bds.ActionTypesEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_bds.BdsClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "bds")
 	retryPolicy.ShouldRetryOperation = bdsInstanceMetastoreConfigWorkRequestShouldRetryFunc(timeout)
@@ -263,7 +264,7 @@ func bdsInstanceMetastoreConfigWaitForWorkRequest(wId *string, entityType string
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_bds.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -296,14 +297,14 @@ func bdsInstanceMetastoreConfigWaitForWorkRequest(wId *string, entityType string
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_bds.OperationStatusFailed || response.Status == oci_bds.OperationStatusCanceled {
-		return nil, getErrorFromBdsBdsInstanceMetastoreConfigWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromBdsBdsInstanceMetastoreConfigWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromBdsBdsInstanceMetastoreConfigWorkRequest(client *oci_bds.BdsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_bds.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromBdsBdsInstanceMetastoreConfigWorkRequest(ctx context.Context, client *oci_bds.BdsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_bds.ActionTypesEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_bds.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -325,7 +326,7 @@ func getErrorFromBdsBdsInstanceMetastoreConfigWorkRequest(client *oci_bds.BdsCli
 	return workRequestErr
 }
 
-func (s *BdsBdsInstanceMetastoreConfigResourceCrud) Get() error {
+func (s *BdsBdsInstanceMetastoreConfigResourceCrud) Get(ctx context.Context) error {
 	request := oci_bds.GetBdsMetastoreConfigurationRequest{}
 
 	tmp := s.D.Id()
@@ -346,7 +347,7 @@ func (s *BdsBdsInstanceMetastoreConfigResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.GetBdsMetastoreConfiguration(context.Background(), request)
+	response, err := s.Client.GetBdsMetastoreConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -355,10 +356,10 @@ func (s *BdsBdsInstanceMetastoreConfigResourceCrud) Get() error {
 	return nil
 }
 
-func (s *BdsBdsInstanceMetastoreConfigResourceCrud) Update() error {
+func (s *BdsBdsInstanceMetastoreConfigResourceCrud) Update(ctx context.Context) error {
 
 	if _, ok := s.D.GetOkExists("activate_trigger"); ok && s.D.HasChange("activate_trigger") {
-		err := s.activateBdsInstanceMetastoreConfig()
+		err := s.activateBdsInstanceMetastoreConfig(ctx)
 		if err != nil {
 			return err
 		}
@@ -396,16 +397,16 @@ func (s *BdsBdsInstanceMetastoreConfigResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.UpdateBdsMetastoreConfiguration(context.Background(), request)
+	response, err := s.Client.UpdateBdsMetastoreConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getBdsInstanceMetastoreConfigFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getBdsInstanceMetastoreConfigFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *BdsBdsInstanceMetastoreConfigResourceCrud) Delete() error {
+func (s *BdsBdsInstanceMetastoreConfigResourceCrud) Delete(ctx context.Context) error {
 	request := oci_bds.DeleteBdsMetastoreConfigurationRequest{}
 
 	tmp := s.D.Id()
@@ -418,14 +419,14 @@ func (s *BdsBdsInstanceMetastoreConfigResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.DeleteBdsMetastoreConfiguration(context.Background(), request)
+	response, err := s.Client.DeleteBdsMetastoreConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := bdsInstanceMetastoreConfigWaitForWorkRequest(workId, "bds",
+	_, delWorkRequestErr := bdsInstanceMetastoreConfigWaitForWorkRequest(ctx, workId, "bds",
 		oci_bds.ActionTypesDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -487,7 +488,7 @@ func parseBdsInstanceMetastoreConfigCompositeId(compositeId string) (bdsInstance
 	return
 }
 
-func (s *BdsBdsInstanceMetastoreConfigResourceCrud) activateBdsInstanceMetastoreConfig() error {
+func (s *BdsBdsInstanceMetastoreConfigResourceCrud) activateBdsInstanceMetastoreConfig(ctx context.Context) error {
 
 	request := oci_bds.ActivateBdsMetastoreConfigurationRequest{}
 
@@ -514,13 +515,13 @@ func (s *BdsBdsInstanceMetastoreConfigResourceCrud) activateBdsInstanceMetastore
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.ActivateBdsMetastoreConfiguration(context.Background(), request)
+	response, err := s.Client.ActivateBdsMetastoreConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	_, updateWorkRequestErr := bdsInstanceMetastoreConfigWaitForWorkRequest(workId, "bds",
+	_, updateWorkRequestErr := bdsInstanceMetastoreConfigWaitForWorkRequest(ctx, workId, "bds",
 		oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 	if updateWorkRequestErr != nil {
 		return updateWorkRequestErr
@@ -6,6 +6,7 @@ package bds
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_bds "github.com/oracle/oci-go-sdk/v65/bds"
 
@@ -15,7 +16,7 @@ import (
 
 func BdsBdsInstancePatchHistoriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readBdsBdsInstancePatchHistories,
+		ReadContext: readBdsBdsInstancePatchHistories,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"bds_instance_id": {
@@ -55,12 +56,12 @@ func BdsBdsInstancePatchHistoriesDataSource() *schema.Resource {
 	}
 }
 
-func readBdsBdsInstancePatchHistories(d *schema.ResourceData, m interface{}) error {
+func readBdsBdsInstancePatchHistories(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsBdsInstancePatchHistoriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type BdsBdsInstancePatchHistoriesDataSourceCrud struct {
@@ -73,7 +74,7 @@ func (s *BdsBdsInstancePatchHistoriesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *BdsBdsInstancePatchHistoriesDataSourceCrud) Get() error {
+func (s *BdsBdsInstancePatchHistoriesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_bds.ListPatchHistoriesRequest{}
 
 	if bdsInstanceId, ok := s.D.GetOkExists("bds_instance_id"); ok {
@@ -92,7 +93,7 @@ func (s *BdsBdsInstancePatchHistoriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "bds")
 
-	response, err := s.Client.ListPatchHistories(context.Background(), request)
+	response, err := s.Client.ListPatchHistories(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -101,7 +102,7 @@ func (s *BdsBdsInstancePatchHistoriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPatchHistories(context.Background(), request)
+		listResponse, err := s.Client.ListPatchHistories(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package bds
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_bds "github.com/oracle/oci-go-sdk/v65/bds"
 
@@ -15,7 +16,7 @@ import (
 
 func BdsBdsInstancePatchesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readBdsBdsInstancePatches,
+		ReadContext: readBdsBdsInstancePatches,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"bds_instance_id": {
@@ -43,12 +44,12 @@ func BdsBdsInstancePatchesDataSource() *schema.Resource {
 	}
 }
 
-func readBdsBdsInstancePatches(d *schema.ResourceData, m interface{}) error {
+func readBdsBdsInstancePatches(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsBdsInstancePatchesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type BdsBdsInstancePatchesDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *BdsBdsInstancePatchesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *BdsBdsInstancePatchesDataSourceCrud) Get() error {
+func (s *BdsBdsInstancePatchesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_bds.ListPatchesRequest{}
 
 	if bdsInstanceId, ok := s.D.GetOkExists("bds_instance_id"); ok {
@@ -71,7 +72,7 @@ func (s *BdsBdsInstancePatchesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "bds")
 
-	response, err := s.Client.ListPatches(context.Background(), request)
+	response, err := s.Client.ListPatches(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -80,7 +81,7 @@ func (s *BdsBdsInstancePatchesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPatches(context.Background(), request)
+		listResponse, err := s.Client.ListPatches(ctx, request)
 		if err != nil {
 			return err
 		}
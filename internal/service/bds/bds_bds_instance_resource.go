@@ -14,6 +14,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -32,10 +33,10 @@ func BdsBdsInstanceResource() *schema.Resource {
 			Update: &tfresource.TwelveHours,
 			Delete: &tfresource.TwelveHours,
 		},
-		Create: createBdsBdsInstance,
-		Read:   readBdsBdsInstance,
-		Update: updateBdsBdsInstance,
-		Delete: deleteBdsBdsInstance,
+		CreateContext: createBdsBdsInstance,
+		ReadContext:   readBdsBdsInstance,
+		UpdateContext: updateBdsBdsInstance,
+		DeleteContext: deleteBdsBdsInstance,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"cluster_admin_password": {
@@ -640,7 +641,7 @@ func BdsBdsInstanceResource() *schema.Resource {
 	}
 }
 
-func createBdsBdsInstance(d *schema.ResourceData, m interface{}) error {
+func createBdsBdsInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsBdsInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
@@ -656,24 +657,24 @@ func createBdsBdsInstance(d *schema.ResourceData, m interface{}) error {
 				tmp := blockVolumeSizeInGBs.(string)
 				tmpInt64, err := strconv.ParseInt(tmp, 10, 64)
 				if err != nil {
-					return fmt.Errorf("unable to convert blockVolumeSizeInGBs string: %s to an int64 and encountered error: %v", tmp, err)
+					return tfresource.HandleDiagError(fmt.Errorf("unable to convert blockVolumeSizeInGBs string: %s to an int64 and encountered error: %v", tmp, err))
 				}
 				cloudSqlRequest.BlockVolumeSizeInGBs = &tmpInt64
 			} else {
-				return fmt.Errorf("block_volume_size_in_gbs is required in cloud_sql_details")
+				return tfresource.HandleDiagError(fmt.Errorf("block_volume_size_in_gbs is required in cloud_sql_details"))
 			}
 
 			if shape, ok := sync.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "shape")); ok {
 				tmp := shape.(string)
 				cloudSqlRequest.Shape = &tmp
 			} else {
-				return fmt.Errorf("shape is required in cloud_sql_details")
+				return tfresource.HandleDiagError(fmt.Errorf("shape is required in cloud_sql_details"))
 			}
 		}
 	}
 
-	if err := tfresource.CreateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.CreateResource(ctx, d, sync); err != nil {
+		return tfresource.HandleDiagError(err)
 	}
 
 	if cloudSql {
@@ -683,37 +684,37 @@ func createBdsBdsInstance(d *schema.ResourceData, m interface{}) error {
 			tmp := clusterAdminPassword.(string)
 			cloudSqlRequest.ClusterAdminPassword = &tmp
 		}
-		if err := sync.addCloudSql(cloudSqlRequest); err != nil {
-			return err
+		if err := sync.addCloudSql(ctx, cloudSqlRequest); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
-		return tfresource.ReadResource(sync)
+		return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 	}
 	return nil
 }
 
-func readBdsBdsInstance(d *schema.ResourceData, m interface{}) error {
+func readBdsBdsInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsBdsInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateBdsBdsInstance(d *schema.ResourceData, m interface{}) error {
+func updateBdsBdsInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsBdsInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteBdsBdsInstance(d *schema.ResourceData, m interface{}) error {
+func deleteBdsBdsInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsBdsInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type BdsBdsInstanceResourceCrud struct {
@@ -764,7 +765,7 @@ func (s *BdsBdsInstanceResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *BdsBdsInstanceResourceCrud) Create() error {
+func (s *BdsBdsInstanceResourceCrud) Create(ctx context.Context) error {
 	request := oci_bds.CreateBdsInstanceRequest{}
 
 	if bootstrapScriptUrl, ok := s.D.GetOkExists("bootstrap_script_url"); ok {
@@ -927,25 +928,25 @@ func (s *BdsBdsInstanceResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.CreateBdsInstance(context.Background(), request)
+	response, err := s.Client.CreateBdsInstance(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	createResultError := s.getBdsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
+	createResultError := s.getBdsInstanceFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
 	if createResultError != nil {
 		return createResultError
 	}
-	_, computeWorkerAdditionError := s.updateComputeWorkersIfRequired()
+	_, computeWorkerAdditionError := s.updateComputeWorkersIfRequired(ctx)
 	return computeWorkerAdditionError
 }
 
-func (s *BdsBdsInstanceResourceCrud) getBdsInstanceFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *BdsBdsInstanceResourceCrud) getBdsInstanceFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_bds.ActionTypesEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	bdsInstanceId, err := bdsInstanceWaitForWorkRequest(workId, "bds",
+	bdsInstanceId, err := bdsInstanceWaitForWorkRequest(ctx, workId, "bds",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -953,7 +954,7 @@ func (s *BdsBdsInstanceResourceCrud) getBdsInstanceFromWorkRequest(workId *strin
 	}
 	s.D.SetId(*bdsInstanceId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func bdsInstanceWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -979,7 +980,7 @@ func bdsInstanceWorkRequestShouldRetryFunc(timeout time.Duration) func(response
 	}
 }
 
-func bdsInstanceWaitForWorkRequest(wId *string, entityType string, action oci_bds.ActionTypesEnum,
+func bdsInstanceWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_bds.ActionTypesEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_bds.BdsClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "bds")
 	retryPolicy.ShouldRetryOperation = bdsInstanceWorkRequestShouldRetryFunc(timeout)
@@ -998,7 +999,7 @@ func bdsInstanceWaitForWorkRequest(wId *string, entityType string, action oci_bd
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_bds.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -1027,14 +1028,14 @@ func bdsInstanceWaitForWorkRequest(wId *string, entityType string, action oci_bd
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_bds.OperationStatusFailed || response.Status == oci_bds.OperationStatusCanceled {
-		return nil, getErrorFromBdsBdsInstanceWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromBdsBdsInstanceWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromBdsBdsInstanceWorkRequest(client *oci_bds.BdsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_bds.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromBdsBdsInstanceWorkRequest(ctx context.Context, client *oci_bds.BdsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_bds.ActionTypesEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_bds.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -1056,7 +1057,7 @@ func getErrorFromBdsBdsInstanceWorkRequest(client *oci_bds.BdsClient, workId *st
 	return workRequestErr
 }
 
-func (s *BdsBdsInstanceResourceCrud) Get() error {
+func (s *BdsBdsInstanceResourceCrud) Get(ctx context.Context) error {
 	request := oci_bds.GetBdsInstanceRequest{}
 
 	tmp := s.D.Id()
@@ -1064,7 +1065,7 @@ func (s *BdsBdsInstanceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.GetBdsInstance(context.Background(), request)
+	response, err := s.Client.GetBdsInstance(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -1073,7 +1074,7 @@ func (s *BdsBdsInstanceResourceCrud) Get() error {
 	return nil
 }
 
-func (s *BdsBdsInstanceResourceCrud) Update() error {
+func (s *BdsBdsInstanceResourceCrud) Update(ctx context.Context) error {
 	if cloudSqlConfigured, ok := s.D.GetOkExists("is_cloud_sql_configured"); ok && s.D.HasChange("is_cloud_sql_configured") {
 		oldRaw, newRaw := s.D.GetChange("is_cloud_sql_configured")
 		if newRaw != "" && oldRaw != "" {
@@ -1103,7 +1104,7 @@ func (s *BdsBdsInstanceResourceCrud) Update() error {
 					return fmt.Errorf("block_volume_size_in_gbs is required in cloud_sql_details")
 				}
 
-				err := s.addCloudSql(request)
+				err := s.addCloudSql(ctx, request)
 				if err != nil {
 					return err
 				}
@@ -1116,7 +1117,7 @@ func (s *BdsBdsInstanceResourceCrud) Update() error {
 					request.ClusterAdminPassword = &tmp
 
 				}
-				err := s.deleteCloudSql(request)
+				err := s.deleteCloudSql(ctx, request)
 				if err != nil {
 					return err
 				}
@@ -1128,7 +1129,7 @@ func (s *BdsBdsInstanceResourceCrud) Update() error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -1161,7 +1162,7 @@ func (s *BdsBdsInstanceResourceCrud) Update() error {
 		if tmpInt64New > tmpInt64Old {
 			if clusterAdminPassword, ok := s.D.GetOkExists("cluster_admin_password"); ok {
 				dif := tmpInt64New - tmpInt64Old
-				err := s.updateWorkerBlockStorage(s.D.Id(), clusterAdminPassword, dif, oci_bds.AddBlockStorageDetailsNodeTypeWorker)
+				err := s.updateWorkerBlockStorage(ctx, s.D.Id(), clusterAdminPassword, dif, oci_bds.AddBlockStorageDetailsNodeTypeWorker)
 				if err != nil {
 					return err
 				}
@@ -1178,7 +1179,7 @@ func (s *BdsBdsInstanceResourceCrud) Update() error {
 		tmpNew := newRaw.(int)
 		if tmpNew > tmpOld {
 			if clusterAdminPassword, ok := s.D.GetOkExists("cluster_admin_password"); ok {
-				err := s.updateWorkerNode(s.D.Id(), clusterAdminPassword, tmpNew-tmpOld, oci_bds.AddWorkerNodesDetailsNodeTypeWorker, nil, nil, nil)
+				err := s.updateWorkerNode(ctx, s.D.Id(), clusterAdminPassword, tmpNew-tmpOld, oci_bds.AddWorkerNodesDetailsNodeTypeWorker, nil, nil, nil)
 				if err != nil {
 					return err
 				}
@@ -1188,7 +1189,7 @@ func (s *BdsBdsInstanceResourceCrud) Update() error {
 		}
 	}
 
-	isComputeWorkerAdded, computeWorkerErr := s.updateComputeWorkersIfRequired()
+	isComputeWorkerAdded, computeWorkerErr := s.updateComputeWorkersIfRequired(ctx)
 	if computeWorkerErr != nil {
 		return computeWorkerErr
 	}
@@ -1255,13 +1256,13 @@ func (s *BdsBdsInstanceResourceCrud) Update() error {
 			tmp := s.D.Id()
 			changeShapeRequest.BdsInstanceId = &tmp
 
-			response, err := s.Client.ChangeShape(context.Background(), changeShapeRequest)
+			response, err := s.Client.ChangeShape(ctx, changeShapeRequest)
 			if err != nil {
 				return err
 			}
 
 			workId := response.OpcWorkRequestId
-			err = s.getBdsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+			err = s.getBdsInstanceFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 			if err != nil {
 				return err
 			}
@@ -1302,16 +1303,16 @@ func (s *BdsBdsInstanceResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.UpdateBdsInstance(context.Background(), request)
+	response, err := s.Client.UpdateBdsInstance(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getBdsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getBdsInstanceFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *BdsBdsInstanceResourceCrud) updateComputeWorkersIfRequired() (bool, error) {
+func (s *BdsBdsInstanceResourceCrud) updateComputeWorkersIfRequired(ctx context.Context) (bool, error) {
 	areWorkersAdded := false
 	computeOnlyWorkerNodeFieldKeyFormat := "compute_only_worker_node.0.%s"
 	var computeWorkerBlockVolumeSizeGBInt int64
@@ -1335,7 +1336,7 @@ func (s *BdsBdsInstanceResourceCrud) updateComputeWorkersIfRequired() (bool, err
 		tmpNew := newRaw.(int)
 		if tmpNew > tmpOld {
 			if clusterAdminPassword, ok := s.D.GetOkExists("cluster_admin_password"); ok {
-				err := s.updateWorkerNode(s.D.Id(), clusterAdminPassword, tmpNew-tmpOld, oci_bds.AddWorkerNodesDetailsNodeTypeComputeOnlyWorker, &computeWorkerBlockVolumeSizeGBInt, &compute_worker_shape_string, &compute_worker_shape_config)
+				err := s.updateWorkerNode(ctx, s.D.Id(), clusterAdminPassword, tmpNew-tmpOld, oci_bds.AddWorkerNodesDetailsNodeTypeComputeOnlyWorker, &computeWorkerBlockVolumeSizeGBInt, &compute_worker_shape_string, &compute_worker_shape_config)
 				if err != nil {
 					return false, err
 				}
@@ -1348,7 +1349,7 @@ func (s *BdsBdsInstanceResourceCrud) updateComputeWorkersIfRequired() (bool, err
 	return areWorkersAdded, nil
 }
 
-func (s *BdsBdsInstanceResourceCrud) Delete() error {
+func (s *BdsBdsInstanceResourceCrud) Delete(ctx context.Context) error {
 	request := oci_bds.DeleteBdsInstanceRequest{}
 
 	tmp := s.D.Id()
@@ -1356,14 +1357,14 @@ func (s *BdsBdsInstanceResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.DeleteBdsInstance(context.Background(), request)
+	response, err := s.Client.DeleteBdsInstance(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := bdsInstanceWaitForWorkRequest(workId, "bds",
+	_, delWorkRequestErr := bdsInstanceWaitForWorkRequest(ctx, workId, "bds",
 		oci_bds.ActionTypesDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -1770,7 +1771,7 @@ func VolumeAttachmentDetailToMap(obj oci_bds.VolumeAttachmentDetail) map[string]
 	return result
 }
 
-func (s *BdsBdsInstanceResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *BdsBdsInstanceResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_bds.ChangeBdsInstanceCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -1781,15 +1782,15 @@ func (s *BdsBdsInstanceResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.ChangeBdsInstanceCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeBdsInstanceCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 	workId := response.OpcWorkRequestId
-	return s.getBdsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getBdsInstanceFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *BdsBdsInstanceResourceCrud) updateWorkerBlockStorage(id string, clusterAdminPassword interface{}, blockVolumeSizeInGBs int64, nodeType oci_bds.AddBlockStorageDetailsNodeTypeEnum) error {
+func (s *BdsBdsInstanceResourceCrud) updateWorkerBlockStorage(ctx context.Context, id string, clusterAdminPassword interface{}, blockVolumeSizeInGBs int64, nodeType oci_bds.AddBlockStorageDetailsNodeTypeEnum) error {
 	addBlockStorageRequest := oci_bds.AddBlockStorageRequest{}
 
 	addBlockStorageRequest.BdsInstanceId = &id
@@ -1803,15 +1804,15 @@ func (s *BdsBdsInstanceResourceCrud) updateWorkerBlockStorage(id string, cluster
 
 	addBlockStorageRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.AddBlockStorage(context.Background(), addBlockStorageRequest)
+	response, err := s.Client.AddBlockStorage(ctx, addBlockStorageRequest)
 	if err != nil {
 		return err
 	}
 	workId := response.OpcWorkRequestId
-	return s.getBdsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getBdsInstanceFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *BdsBdsInstanceResourceCrud) updateWorkerNode(id string, clusterAdminPassword interface{}, numberOfWorker int, nodeType oci_bds.AddWorkerNodesDetailsNodeTypeEnum, blockVolumeSizeInGBs *int64, shape *string, shapeConfig *oci_bds.ShapeConfigDetails) error {
+func (s *BdsBdsInstanceResourceCrud) updateWorkerNode(ctx context.Context, id string, clusterAdminPassword interface{}, numberOfWorker int, nodeType oci_bds.AddWorkerNodesDetailsNodeTypeEnum, blockVolumeSizeInGBs *int64, shape *string, shapeConfig *oci_bds.ShapeConfigDetails) error {
 	addWorkerNodesRequest := oci_bds.AddWorkerNodesRequest{}
 	addWorkerNodesRequest.BdsInstanceId = &id
 
@@ -1836,30 +1837,30 @@ func (s *BdsBdsInstanceResourceCrud) updateWorkerNode(id string, clusterAdminPas
 
 	addWorkerNodesRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds")
 
-	response, err := s.Client.AddWorkerNodes(context.Background(), addWorkerNodesRequest)
+	response, err := s.Client.AddWorkerNodes(ctx, addWorkerNodesRequest)
 	if err != nil {
 		return err
 	}
 	workId := response.OpcWorkRequestId
-	return s.getBdsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getBdsInstanceFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *BdsBdsInstanceResourceCrud) addCloudSql(request oci_bds.AddCloudSqlRequest) error {
-	response, err := s.Client.AddCloudSql(context.Background(), request)
+func (s *BdsBdsInstanceResourceCrud) addCloudSql(ctx context.Context, request oci_bds.AddCloudSqlRequest) error {
+	response, err := s.Client.AddCloudSql(ctx, request)
 	if err != nil {
 		return err
 	}
 	workId := response.OpcWorkRequestId
-	return s.getBdsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getBdsInstanceFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *BdsBdsInstanceResourceCrud) deleteCloudSql(request oci_bds.RemoveCloudSqlRequest) error {
-	response, err := s.Client.RemoveCloudSql(context.Background(), request)
+func (s *BdsBdsInstanceResourceCrud) deleteCloudSql(ctx context.Context, request oci_bds.RemoveCloudSqlRequest) error {
+	response, err := s.Client.RemoveCloudSql(ctx, request)
 	if err != nil {
 		return err
 	}
 	workId := response.OpcWorkRequestId
-	return s.getBdsInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getBdsInstanceFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "bds"), oci_bds.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func PopulateNodeTemplate(obj oci_bds.Node, nodeMap map[string]map[string]interface{}) {
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_bds "github.com/oracle/oci-go-sdk/v65/bds"
 )
 
 func BdsBdsInstancesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readBdsBdsInstances,
+		ReadContext: readBdsBdsInstances,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,12 +40,12 @@ func BdsBdsInstancesDataSource() *schema.Resource {
 	}
 }
 
-func readBdsBdsInstances(d *schema.ResourceData, m interface{}) error {
+func readBdsBdsInstances(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BdsBdsInstancesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BdsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type BdsBdsInstancesDataSourceCrud struct {
@@ -57,7 +58,7 @@ func (s *BdsBdsInstancesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *BdsBdsInstancesDataSourceCrud) Get() error {
+func (s *BdsBdsInstancesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_bds.ListBdsInstancesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -77,7 +78,7 @@ func (s *BdsBdsInstancesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "bds")
 
-	response, err := s.Client.ListBdsInstances(context.Background(), request)
+	response, err := s.Client.ListBdsInstances(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -96,7 +97,7 @@ func (s *BdsBdsInstancesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBdsInstances(context.Background(), request)
+		listResponse, err := s.Client.ListBdsInstances(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_blockchain "github.com/oracle/oci-go-sdk/v65/blockchain"
 )
@@ -22,12 +23,12 @@ func BlockchainBlockchainPlatformDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(BlockchainBlockchainPlatformResource(), fieldMap, readSingularBlockchainBlockchainPlatform)
 }
 
-func readSingularBlockchainBlockchainPlatform(d *schema.ResourceData, m interface{}) error {
+func readSingularBlockchainBlockchainPlatform(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BlockchainBlockchainPlatformDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockchainPlatformClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type BlockchainBlockchainPlatformDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *BlockchainBlockchainPlatformDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *BlockchainBlockchainPlatformDataSourceCrud) Get() error {
+func (s *BlockchainBlockchainPlatformDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_blockchain.GetBlockchainPlatformRequest{}
 
 	if blockchainPlatformId, ok := s.D.GetOkExists("blockchain_platform_id"); ok {
@@ -50,7 +51,7 @@ func (s *BlockchainBlockchainPlatformDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "blockchain")
 
-	response, err := s.Client.GetBlockchainPlatform(context.Background(), request)
+	response, err := s.Client.GetBlockchainPlatform(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_blockchain "github.com/oracle/oci-go-sdk/v65/blockchain"
 )
 
 func BlockchainBlockchainPlatformPatchesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readBlockchainBlockchainPlatformPatches,
+		ReadContext: readBlockchainBlockchainPlatformPatches,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"blockchain_platform_id": {
@@ -76,12 +77,12 @@ func BlockchainBlockchainPlatformPatchesDataSource() *schema.Resource {
 	}
 }
 
-func readBlockchainBlockchainPlatformPatches(d *schema.ResourceData, m interface{}) error {
+func readBlockchainBlockchainPlatformPatches(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BlockchainBlockchainPlatformPatchesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockchainPlatformClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type BlockchainBlockchainPlatformPatchesDataSourceCrud struct {
@@ -94,7 +95,7 @@ func (s *BlockchainBlockchainPlatformPatchesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *BlockchainBlockchainPlatformPatchesDataSourceCrud) Get() error {
+func (s *BlockchainBlockchainPlatformPatchesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_blockchain.ListBlockchainPlatformPatchesRequest{}
 
 	if blockchainPlatformId, ok := s.D.GetOkExists("blockchain_platform_id"); ok {
@@ -104,7 +105,7 @@ func (s *BlockchainBlockchainPlatformPatchesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "blockchain")
 
-	response, err := s.Client.ListBlockchainPlatformPatches(context.Background(), request)
+	response, err := s.Client.ListBlockchainPlatformPatches(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -113,7 +114,7 @@ func (s *BlockchainBlockchainPlatformPatchesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBlockchainPlatformPatches(context.Background(), request)
+		listResponse, err := s.Client.ListBlockchainPlatformPatches(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -30,10 +31,10 @@ func BlockchainBlockchainPlatformResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("30m"),
 			Delete: tfresource.GetTimeoutDuration("30m"),
 		},
-		Create: createBlockchainBlockchainPlatform,
-		Read:   readBlockchainBlockchainPlatform,
-		Update: updateBlockchainBlockchainPlatform,
-		Delete: deleteBlockchainBlockchainPlatform,
+		CreateContext: createBlockchainBlockchainPlatform,
+		ReadContext:   readBlockchainBlockchainPlatform,
+		UpdateContext: updateBlockchainBlockchainPlatform,
+		DeleteContext: deleteBlockchainBlockchainPlatform,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -327,37 +328,37 @@ func BlockchainBlockchainPlatformResource() *schema.Resource {
 	}
 }
 
-func createBlockchainBlockchainPlatform(d *schema.ResourceData, m interface{}) error {
+func createBlockchainBlockchainPlatform(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BlockchainBlockchainPlatformResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockchainPlatformClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readBlockchainBlockchainPlatform(d *schema.ResourceData, m interface{}) error {
+func readBlockchainBlockchainPlatform(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BlockchainBlockchainPlatformResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockchainPlatformClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateBlockchainBlockchainPlatform(d *schema.ResourceData, m interface{}) error {
+func updateBlockchainBlockchainPlatform(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BlockchainBlockchainPlatformResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockchainPlatformClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteBlockchainBlockchainPlatform(d *schema.ResourceData, m interface{}) error {
+func deleteBlockchainBlockchainPlatform(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BlockchainBlockchainPlatformResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockchainPlatformClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type BlockchainBlockchainPlatformResourceCrud struct {
@@ -396,7 +397,7 @@ func (s *BlockchainBlockchainPlatformResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *BlockchainBlockchainPlatformResourceCrud) Create() error {
+func (s *BlockchainBlockchainPlatformResourceCrud) Create(ctx context.Context) error {
 	request := oci_blockchain.CreateBlockchainPlatformRequest{}
 
 	if caCertArchiveText, ok := s.D.GetOkExists("ca_cert_archive_text"); ok {
@@ -461,13 +462,13 @@ func (s *BlockchainBlockchainPlatformResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
 
-	response, err := s.Client.CreateBlockchainPlatform(context.Background(), request)
+	response, err := s.Client.CreateBlockchainPlatform(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	err = s.getBlockchainPlatformFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain"), oci_blockchain.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	err = s.getBlockchainPlatformFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain"), oci_blockchain.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -478,23 +479,23 @@ func (s *BlockchainBlockchainPlatformResourceCrud) Create() error {
 	_, loadBalancerShapeExists := s.D.GetOkExists("load_balancer_shape")
 
 	if replicasExists || storageSizeInTbsExists || totalOpcuCapacityExists || loadBalancerShapeExists {
-		return s.Update()
+		return s.Update(ctx)
 	}
 
 	return nil
 }
 
-func (s *BlockchainBlockchainPlatformResourceCrud) getBlockchainPlatformFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *BlockchainBlockchainPlatformResourceCrud) getBlockchainPlatformFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_blockchain.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	blockchainPlatformId, err := blockchainPlatformWaitForWorkRequest(workId, "instance",
+	blockchainPlatformId, err := blockchainPlatformWaitForWorkRequest(ctx, workId, "instance",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, blockchainPlatformId)
-		_, cancelErr := s.Client.DeleteWorkRequest(context.Background(),
+		_, cancelErr := s.Client.DeleteWorkRequest(ctx,
 			oci_blockchain.DeleteWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -508,7 +509,7 @@ func (s *BlockchainBlockchainPlatformResourceCrud) getBlockchainPlatformFromWork
 	}
 	s.D.SetId(*blockchainPlatformId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func blockchainPlatformWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -534,7 +535,7 @@ func blockchainPlatformWorkRequestShouldRetryFunc(timeout time.Duration) func(re
 	}
 }
 
-func blockchainPlatformWaitForWorkRequest(wId *string, entityType string, action oci_blockchain.WorkRequestResourceActionTypeEnum,
+func blockchainPlatformWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_blockchain.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_blockchain.BlockchainPlatformClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "blockchain")
 	retryPolicy.ShouldRetryOperation = blockchainPlatformWorkRequestShouldRetryFunc(timeout)
@@ -553,7 +554,7 @@ func blockchainPlatformWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_blockchain.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -583,15 +584,15 @@ func blockchainPlatformWaitForWorkRequest(wId *string, entityType string, action
 	// The workrequest didn't do all its intended tasks, if the errors is set; so we should check for it
 	var workRequestErr error
 	if response.Status == oci_blockchain.WorkRequestStatusFailed {
-		errorMessage := getErrorFromBlockchainPlatformWorkRequest(client, wId, retryPolicy, entityType, action)
+		errorMessage := getErrorFromBlockchainPlatformWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 		workRequestErr = fmt.Errorf("work request did not succeed, workId: %s, entity: %s, action: %s. Message: %s", *wId, entityType, action, errorMessage)
 	}
 
 	return identifier, workRequestErr
 }
 
-func getErrorFromBlockchainPlatformWorkRequest(client *oci_blockchain.BlockchainPlatformClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_blockchain.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromBlockchainPlatformWorkRequest(ctx context.Context, client *oci_blockchain.BlockchainPlatformClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_blockchain.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_blockchain.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -613,7 +614,7 @@ func getErrorFromBlockchainPlatformWorkRequest(client *oci_blockchain.Blockchain
 	return workRequestErr
 }
 
-func (s *BlockchainBlockchainPlatformResourceCrud) Get() error {
+func (s *BlockchainBlockchainPlatformResourceCrud) Get(ctx context.Context) error {
 	request := oci_blockchain.GetBlockchainPlatformRequest{}
 
 	tmp := s.D.Id()
@@ -621,7 +622,7 @@ func (s *BlockchainBlockchainPlatformResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
 
-	response, err := s.Client.GetBlockchainPlatform(context.Background(), request)
+	response, err := s.Client.GetBlockchainPlatform(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -630,11 +631,11 @@ func (s *BlockchainBlockchainPlatformResourceCrud) Get() error {
 	return nil
 }
 
-func (s *BlockchainBlockchainPlatformResourceCrud) Update() error {
+func (s *BlockchainBlockchainPlatformResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -642,7 +643,7 @@ func (s *BlockchainBlockchainPlatformResourceCrud) Update() error {
 	}
 	defer func() {
 		// get latest state of the instance
-		err := s.Get()
+		err := s.Get(ctx)
 		if err != nil {
 			log.Printf("[ERROR] unable to invoke GET() after UPDATE '%v'", err)
 		}
@@ -666,7 +667,7 @@ func (s *BlockchainBlockchainPlatformResourceCrud) Update() error {
 			}
 			request.Replicas = &tmp
 		}
-		if err := sendUpdateBlockchainPlatformRequest(s, request); err != nil {
+		if err := sendUpdateBlockchainPlatformRequest(ctx, s, request); err != nil {
 			return err
 		}
 		request.Replicas = nil
@@ -675,7 +676,7 @@ func (s *BlockchainBlockchainPlatformResourceCrud) Update() error {
 	if storageSizeInTBs, ok := s.D.GetOkExists("storage_size_in_tbs"); ok && s.D.HasChange("storage_size_in_tbs") {
 		tmp := storageSizeInTBs.(float64)
 		request.StorageSizeInTBs = &tmp
-		if err := sendUpdateBlockchainPlatformRequest(s, request); err != nil {
+		if err := sendUpdateBlockchainPlatformRequest(ctx, s, request); err != nil {
 			return err
 		}
 		request.StorageSizeInTBs = nil
@@ -684,7 +685,7 @@ func (s *BlockchainBlockchainPlatformResourceCrud) Update() error {
 	if totalOcpuCapacity, ok := s.D.GetOkExists("total_ocpu_capacity"); ok && s.D.HasChange("total_ocpu_capacity") {
 		tmp := totalOcpuCapacity.(int)
 		request.TotalOcpuCapacity = &tmp
-		if err := sendUpdateBlockchainPlatformRequest(s, request); err != nil {
+		if err := sendUpdateBlockchainPlatformRequest(ctx, s, request); err != nil {
 			return err
 		}
 		request.TotalOcpuCapacity = nil
@@ -692,7 +693,7 @@ func (s *BlockchainBlockchainPlatformResourceCrud) Update() error {
 
 	if loadBalancerShape, ok := s.D.GetOkExists("load_balancer_shape"); ok && s.D.HasChange("load_balancer_shape") {
 		request.LoadBalancerShape = oci_blockchain.BlockchainPlatformLoadBalancerShapeEnum(loadBalancerShape.(string))
-		if err := sendUpdateBlockchainPlatformRequest(s, request); err != nil {
+		if err := sendUpdateBlockchainPlatformRequest(ctx, s, request); err != nil {
 			return err
 		}
 	}
@@ -716,16 +717,16 @@ func (s *BlockchainBlockchainPlatformResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
 
-	response, err := s.Client.UpdateBlockchainPlatform(context.Background(), request)
+	response, err := s.Client.UpdateBlockchainPlatform(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getBlockchainPlatformFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain"), oci_blockchain.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getBlockchainPlatformFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain"), oci_blockchain.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *BlockchainBlockchainPlatformResourceCrud) Delete() error {
+func (s *BlockchainBlockchainPlatformResourceCrud) Delete(ctx context.Context) error {
 	request := oci_blockchain.DeleteBlockchainPlatformRequest{}
 
 	tmp := s.D.Id()
@@ -733,14 +734,14 @@ func (s *BlockchainBlockchainPlatformResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
 
-	response, err := s.Client.DeleteBlockchainPlatform(context.Background(), request)
+	response, err := s.Client.DeleteBlockchainPlatform(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := blockchainPlatformWaitForWorkRequest(workId, "instance",
+	_, delWorkRequestErr := blockchainPlatformWaitForWorkRequest(ctx, workId, "instance",
 		oci_blockchain.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -1020,7 +1021,7 @@ func ReplicaDetailsToMap(obj *oci_blockchain.ReplicaDetails) map[string]interfac
 	return result
 }
 
-func (s *BlockchainBlockchainPlatformResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *BlockchainBlockchainPlatformResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_blockchain.ChangeBlockchainPlatformCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -1031,11 +1032,11 @@ func (s *BlockchainBlockchainPlatformResourceCrud) updateCompartment(compartment
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
 
-	response, err := s.Client.ChangeBlockchainPlatformCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeBlockchainPlatformCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getBlockchainPlatformFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain"), oci_blockchain.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getBlockchainPlatformFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain"), oci_blockchain.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
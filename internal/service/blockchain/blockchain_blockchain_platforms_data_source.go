@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_blockchain "github.com/oracle/oci-go-sdk/v65/blockchain"
 )
 
 func BlockchainBlockchainPlatformsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readBlockchainBlockchainPlatforms,
+		ReadContext: readBlockchainBlockchainPlatforms,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,12 +49,12 @@ func BlockchainBlockchainPlatformsDataSource() *schema.Resource {
 	}
 }
 
-func readBlockchainBlockchainPlatforms(d *schema.ResourceData, m interface{}) error {
+func readBlockchainBlockchainPlatforms(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BlockchainBlockchainPlatformsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockchainPlatformClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type BlockchainBlockchainPlatformsDataSourceCrud struct {
@@ -66,7 +67,7 @@ func (s *BlockchainBlockchainPlatformsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *BlockchainBlockchainPlatformsDataSourceCrud) Get() error {
+func (s *BlockchainBlockchainPlatformsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_blockchain.ListBlockchainPlatformsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -85,7 +86,7 @@ func (s *BlockchainBlockchainPlatformsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "blockchain")
 
-	response, err := s.Client.ListBlockchainPlatforms(context.Background(), request)
+	response, err := s.Client.ListBlockchainPlatforms(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *BlockchainBlockchainPlatformsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBlockchainPlatforms(context.Background(), request)
+		listResponse, err := s.Client.ListBlockchainPlatforms(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_blockchain "github.com/oracle/oci-go-sdk/v65/blockchain"
 )
@@ -26,12 +27,12 @@ func BlockchainOsnDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(BlockchainOsnResource(), fieldMap, readSingularBlockchainOsn)
 }
 
-func readSingularBlockchainOsn(d *schema.ResourceData, m interface{}) error {
+func readSingularBlockchainOsn(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BlockchainOsnDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockchainPlatformClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type BlockchainOsnDataSourceCrud struct {
@@ -44,7 +45,7 @@ func (s *BlockchainOsnDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *BlockchainOsnDataSourceCrud) Get() error {
+func (s *BlockchainOsnDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_blockchain.GetOsnRequest{}
 
 	if blockchainPlatformId, ok := s.D.GetOkExists("blockchain_platform_id"); ok {
@@ -59,7 +60,7 @@ func (s *BlockchainOsnDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "blockchain")
 
-	response, err := s.Client.GetOsn(context.Background(), request)
+	response, err := s.Client.GetOsn(ctx, request)
 	if err != nil {
 		return err
 	}
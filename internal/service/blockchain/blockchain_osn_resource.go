@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -32,9 +33,9 @@ func BlockchainOsnResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("30m"),
 			Delete: tfresource.GetTimeoutDuration("30m"),
 		},
-		Create: createBlockchainOsn,
-		Read:   readBlockchainOsn,
-		Delete: deleteBlockchainOsn,
+		CreateContext: createBlockchainOsn,
+		ReadContext:   readBlockchainOsn,
+		DeleteContext: deleteBlockchainOsn,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"ad": {
@@ -84,29 +85,29 @@ func BlockchainOsnResource() *schema.Resource {
 	}
 }
 
-func createBlockchainOsn(d *schema.ResourceData, m interface{}) error {
+func createBlockchainOsn(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BlockchainOsnResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockchainPlatformClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readBlockchainOsn(d *schema.ResourceData, m interface{}) error {
+func readBlockchainOsn(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BlockchainOsnResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockchainPlatformClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteBlockchainOsn(d *schema.ResourceData, m interface{}) error {
+func deleteBlockchainOsn(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BlockchainOsnResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockchainPlatformClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type BlockchainOsnResourceCrud struct {
@@ -126,7 +127,7 @@ func (s *BlockchainOsnResourceCrud) CreatedTarget() []string {
 	}
 }
 
-func (s *BlockchainOsnResourceCrud) Create() error {
+func (s *BlockchainOsnResourceCrud) Create(ctx context.Context) error {
 	request := oci_blockchain.CreateOsnRequest{}
 
 	if ad, ok := s.D.GetOkExists("ad"); ok {
@@ -151,26 +152,26 @@ func (s *BlockchainOsnResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
 
-	response, err := s.Client.CreateOsn(context.Background(), request)
+	response, err := s.Client.CreateOsn(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getOsnFromWorkRequest(request.BlockchainPlatformId, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain"), oci_blockchain.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getOsnFromWorkRequest(ctx, request.BlockchainPlatformId, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain"), oci_blockchain.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *BlockchainOsnResourceCrud) getOsnFromWorkRequest(blockchainPlatformId *string, workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *BlockchainOsnResourceCrud) getOsnFromWorkRequest(ctx context.Context, blockchainPlatformId *string, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_blockchain.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	osnId, err := osnWaitForWorkRequest(workId, "instance",
+	osnId, err := osnWaitForWorkRequest(ctx, workId, "instance",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, blockchainPlatformId)
-		_, cancelErr := s.Client.DeleteWorkRequest(context.Background(),
+		_, cancelErr := s.Client.DeleteWorkRequest(ctx,
 			oci_blockchain.DeleteWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -186,7 +187,7 @@ func (s *BlockchainOsnResourceCrud) getOsnFromWorkRequest(blockchainPlatformId *
 	log.Printf("[DEBUG] new osn keyId Create: %v\n", *osnId)
 	s.D.SetId(*osnId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func osnWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -212,7 +213,7 @@ func osnWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_comm
 	}
 }
 
-func osnWaitForWorkRequest(wId *string, entityType string, action oci_blockchain.WorkRequestResourceActionTypeEnum,
+func osnWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_blockchain.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_blockchain.BlockchainPlatformClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "blockchain")
 	retryPolicy.ShouldRetryOperation = osnWorkRequestShouldRetryFunc(timeout)
@@ -231,7 +232,7 @@ func osnWaitForWorkRequest(wId *string, entityType string, action oci_blockchain
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_blockchain.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -264,15 +265,15 @@ func osnWaitForWorkRequest(wId *string, entityType string, action oci_blockchain
 	// The workrequest didn't do all its intended tasks, if the errors is set; so we should check for it
 	var workRequestErr error
 	if response.Status == oci_blockchain.WorkRequestStatusFailed {
-		errorMessage := getErrorFromBlockchainOsnWorkRequest(client, wId, retryPolicy, entityType, action)
+		errorMessage := getErrorFromBlockchainOsnWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 		workRequestErr = fmt.Errorf("work request did not succeed, workId: %s, entity: %s, action: %s. Message: %s", *wId, entityType, action, errorMessage)
 	}
 
 	return subTypeKey, workRequestErr
 }
 
-func getErrorFromBlockchainOsnWorkRequest(client *oci_blockchain.BlockchainPlatformClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_blockchain.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromBlockchainOsnWorkRequest(ctx context.Context, client *oci_blockchain.BlockchainPlatformClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_blockchain.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_blockchain.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -294,7 +295,7 @@ func getErrorFromBlockchainOsnWorkRequest(client *oci_blockchain.BlockchainPlatf
 	return workRequestErr
 }
 
-func (s *BlockchainOsnResourceCrud) Get() error {
+func (s *BlockchainOsnResourceCrud) Get(ctx context.Context) error {
 	request := oci_blockchain.GetOsnRequest{}
 
 	if blockchainPlatformId, ok := s.D.GetOkExists("blockchain_platform_id"); ok {
@@ -315,7 +316,7 @@ func (s *BlockchainOsnResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
 
-	response, err := s.Client.GetOsn(context.Background(), request)
+	response, err := s.Client.GetOsn(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -324,7 +325,7 @@ func (s *BlockchainOsnResourceCrud) Get() error {
 	return nil
 }
 
-func (s *BlockchainOsnResourceCrud) Delete() error {
+func (s *BlockchainOsnResourceCrud) Delete(ctx context.Context) error {
 	// service not support delete yet
 	return nil
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_blockchain "github.com/oracle/oci-go-sdk/v65/blockchain"
 )
@@ -26,12 +27,12 @@ func BlockchainPeerDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(BlockchainPeerResource(), fieldMap, readSingularBlockchainPeer)
 }
 
-func readSingularBlockchainPeer(d *schema.ResourceData, m interface{}) error {
+func readSingularBlockchainPeer(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BlockchainPeerDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockchainPlatformClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type BlockchainPeerDataSourceCrud struct {
@@ -44,7 +45,7 @@ func (s *BlockchainPeerDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *BlockchainPeerDataSourceCrud) Get() error {
+func (s *BlockchainPeerDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_blockchain.GetPeerRequest{}
 
 	if blockchainPlatformId, ok := s.D.GetOkExists("blockchain_platform_id"); ok {
@@ -59,7 +60,7 @@ func (s *BlockchainPeerDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "blockchain")
 
-	response, err := s.Client.GetPeer(context.Background(), request)
+	response, err := s.Client.GetPeer(ctx, request)
 	if err != nil {
 		return err
 	}
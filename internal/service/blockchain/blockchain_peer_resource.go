@@ -15,6 +15,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -32,10 +33,10 @@ func BlockchainPeerResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("30m"),
 			Delete: tfresource.GetTimeoutDuration("30m"),
 		},
-		Create: createBlockchainPeer,
-		Read:   readBlockchainPeer,
-		Update: updateBlockchainPeer,
-		Delete: deleteBlockchainPeer,
+		CreateContext: createBlockchainPeer,
+		ReadContext:   readBlockchainPeer,
+		UpdateContext: updateBlockchainPeer,
+		DeleteContext: deleteBlockchainPeer,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"ad": {
@@ -99,37 +100,37 @@ func BlockchainPeerResource() *schema.Resource {
 	}
 }
 
-func createBlockchainPeer(d *schema.ResourceData, m interface{}) error {
+func createBlockchainPeer(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BlockchainPeerResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockchainPlatformClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readBlockchainPeer(d *schema.ResourceData, m interface{}) error {
+func readBlockchainPeer(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BlockchainPeerResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockchainPlatformClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateBlockchainPeer(d *schema.ResourceData, m interface{}) error {
+func updateBlockchainPeer(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BlockchainPeerResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockchainPlatformClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteBlockchainPeer(d *schema.ResourceData, m interface{}) error {
+func deleteBlockchainPeer(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BlockchainPeerResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockchainPlatformClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type BlockchainPeerResourceCrud struct {
@@ -149,7 +150,7 @@ func (s *BlockchainPeerResourceCrud) CreatedTarget() []string {
 	}
 }
 
-func (s *BlockchainPeerResourceCrud) Create() error {
+func (s *BlockchainPeerResourceCrud) Create(ctx context.Context) error {
 	request := oci_blockchain.CreatePeerRequest{}
 
 	if ad, ok := s.D.GetOkExists("ad"); ok {
@@ -183,26 +184,26 @@ func (s *BlockchainPeerResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
 
-	response, err := s.Client.CreatePeer(context.Background(), request)
+	response, err := s.Client.CreatePeer(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getPeerFromWorkRequest(request.BlockchainPlatformId, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain"), oci_blockchain.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getPeerFromWorkRequest(ctx, request.BlockchainPlatformId, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain"), oci_blockchain.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *BlockchainPeerResourceCrud) getPeerFromWorkRequest(blockchainPlatformId *string, workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *BlockchainPeerResourceCrud) getPeerFromWorkRequest(ctx context.Context, blockchainPlatformId *string, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_blockchain.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	peerId, err := peerWaitForWorkRequest(workId, "instance",
+	peerId, err := peerWaitForWorkRequest(ctx, workId, "instance",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, blockchainPlatformId)
-		_, cancelErr := s.Client.DeleteWorkRequest(context.Background(),
+		_, cancelErr := s.Client.DeleteWorkRequest(ctx,
 			oci_blockchain.DeleteWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -220,7 +221,7 @@ func (s *BlockchainPeerResourceCrud) getPeerFromWorkRequest(blockchainPlatformId
 	}
 	s.D.SetId(*peerId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func peerWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -246,7 +247,7 @@ func peerWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_com
 	}
 }
 
-func peerWaitForWorkRequest(wId *string, entityType string, action oci_blockchain.WorkRequestResourceActionTypeEnum,
+func peerWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_blockchain.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_blockchain.BlockchainPlatformClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "blockchain")
 	retryPolicy.ShouldRetryOperation = peerWorkRequestShouldRetryFunc(timeout)
@@ -265,7 +266,7 @@ func peerWaitForWorkRequest(wId *string, entityType string, action oci_blockchai
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_blockchain.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -297,15 +298,15 @@ func peerWaitForWorkRequest(wId *string, entityType string, action oci_blockchai
 	// The workrequest didn't do all its intended tasks, if the errors is set; so we should check for it
 	var workRequestErr error
 	if response.Status == oci_blockchain.WorkRequestStatusFailed {
-		errorMessage := getErrorFromBlockchainPeerWorkRequest(client, wId, retryPolicy, entityType, action)
+		errorMessage := getErrorFromBlockchainPeerWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 		workRequestErr = fmt.Errorf("work request did not succeed, workId: %s, entity: %s, action: %s. Message: %s", *wId, entityType, action, errorMessage)
 	}
 
 	return subTypeKey, workRequestErr
 }
 
-func getErrorFromBlockchainPeerWorkRequest(client *oci_blockchain.BlockchainPlatformClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_blockchain.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromBlockchainPeerWorkRequest(ctx context.Context, client *oci_blockchain.BlockchainPlatformClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_blockchain.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_blockchain.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -327,7 +328,7 @@ func getErrorFromBlockchainPeerWorkRequest(client *oci_blockchain.BlockchainPlat
 	return workRequestErr
 }
 
-func (s *BlockchainPeerResourceCrud) Get() error {
+func (s *BlockchainPeerResourceCrud) Get(ctx context.Context) error {
 	request := oci_blockchain.GetPeerRequest{}
 
 	if blockchainPlatformId, ok := s.D.GetOkExists("blockchain_platform_id"); ok {
@@ -348,7 +349,7 @@ func (s *BlockchainPeerResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
 
-	response, err := s.Client.GetPeer(context.Background(), request)
+	response, err := s.Client.GetPeer(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -357,7 +358,7 @@ func (s *BlockchainPeerResourceCrud) Get() error {
 	return nil
 }
 
-func (s *BlockchainPeerResourceCrud) Update() error {
+func (s *BlockchainPeerResourceCrud) Update(ctx context.Context) error {
 	request := oci_blockchain.UpdatePeerRequest{}
 
 	if blockchainPlatformId, ok := s.D.GetOkExists("blockchain_platform_id"); ok {
@@ -381,23 +382,23 @@ func (s *BlockchainPeerResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
 
-	response, err := s.Client.UpdatePeer(context.Background(), request)
+	response, err := s.Client.UpdatePeer(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, err = peerWaitForWorkRequest(workId, "instance",
+	_, err = peerWaitForWorkRequest(ctx, workId, "instance",
 		oci_blockchain.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 	if err != nil {
 		return err
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *BlockchainPeerResourceCrud) Delete() error {
+func (s *BlockchainPeerResourceCrud) Delete(ctx context.Context) error {
 	request := oci_blockchain.DeletePeerRequest{}
 
 	if blockchainPlatformId, ok := s.D.GetOkExists("blockchain_platform_id"); ok {
@@ -410,14 +411,14 @@ func (s *BlockchainPeerResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "blockchain")
 
-	response, err := s.Client.DeletePeer(context.Background(), request)
+	response, err := s.Client.DeletePeer(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := peerWaitForWorkRequest(workId, "instance",
+	_, delWorkRequestErr := peerWaitForWorkRequest(ctx, workId, "instance",
 		oci_blockchain.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
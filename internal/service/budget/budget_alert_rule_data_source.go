@@ -6,6 +6,7 @@ package budget
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_budget "github.com/oracle/oci-go-sdk/v65/budget"
 
@@ -26,12 +27,12 @@ func BudgetAlertRuleDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(BudgetAlertRuleResource(), fieldMap, readSingularBudgetAlertRule)
 }
 
-func readSingularBudgetAlertRule(d *schema.ResourceData, m interface{}) error {
+func readSingularBudgetAlertRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BudgetAlertRuleDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BudgetClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type BudgetAlertRuleDataSourceCrud struct {
@@ -44,7 +45,7 @@ func (s *BudgetAlertRuleDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *BudgetAlertRuleDataSourceCrud) Get() error {
+func (s *BudgetAlertRuleDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_budget.GetAlertRuleRequest{}
 
 	if alertRuleId, ok := s.D.GetOkExists("alert_rule_id"); ok {
@@ -59,7 +60,7 @@ func (s *BudgetAlertRuleDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "budget")
 
-	response, err := s.Client.GetAlertRule(context.Background(), request)
+	response, err := s.Client.GetAlertRule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -24,11 +25,11 @@ func BudgetAlertRuleResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createBudgetAlertRule,
-		Read:     readBudgetAlertRule,
-		Update:   updateBudgetAlertRule,
-		Delete:   deleteBudgetAlertRule,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createBudgetAlertRule,
+		ReadContext:   readBudgetAlertRule,
+		UpdateContext: updateBudgetAlertRule,
+		DeleteContext: deleteBudgetAlertRule,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"budget_id": {
@@ -105,37 +106,37 @@ func BudgetAlertRuleResource() *schema.Resource {
 	}
 }
 
-func createBudgetAlertRule(d *schema.ResourceData, m interface{}) error {
+func createBudgetAlertRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BudgetAlertRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BudgetClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readBudgetAlertRule(d *schema.ResourceData, m interface{}) error {
+func readBudgetAlertRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BudgetAlertRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BudgetClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateBudgetAlertRule(d *schema.ResourceData, m interface{}) error {
+func updateBudgetAlertRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BudgetAlertRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BudgetClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteBudgetAlertRule(d *schema.ResourceData, m interface{}) error {
+func deleteBudgetAlertRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BudgetAlertRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BudgetClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type BudgetAlertRuleResourceCrud struct {
@@ -167,7 +168,7 @@ func (s *BudgetAlertRuleResourceCrud) DeletedTarget() []string {
 	return []string{}
 }
 
-func (s *BudgetAlertRuleResourceCrud) Create() error {
+func (s *BudgetAlertRuleResourceCrud) Create(ctx context.Context) error {
 	request := oci_budget.CreateAlertRuleRequest{}
 
 	if budgetId, ok := s.D.GetOkExists("budget_id"); ok {
@@ -222,7 +223,7 @@ func (s *BudgetAlertRuleResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "budget")
 
-	response, err := s.Client.CreateAlertRule(context.Background(), request)
+	response, err := s.Client.CreateAlertRule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -231,7 +232,7 @@ func (s *BudgetAlertRuleResourceCrud) Create() error {
 	return nil
 }
 
-func (s *BudgetAlertRuleResourceCrud) Get() error {
+func (s *BudgetAlertRuleResourceCrud) Get(ctx context.Context) error {
 	request := oci_budget.GetAlertRuleRequest{}
 
 	tmp := s.D.Id()
@@ -252,7 +253,7 @@ func (s *BudgetAlertRuleResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "budget")
 
-	response, err := s.Client.GetAlertRule(context.Background(), request)
+	response, err := s.Client.GetAlertRule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -261,7 +262,7 @@ func (s *BudgetAlertRuleResourceCrud) Get() error {
 	return nil
 }
 
-func (s *BudgetAlertRuleResourceCrud) Update() error {
+func (s *BudgetAlertRuleResourceCrud) Update(ctx context.Context) error {
 	request := oci_budget.UpdateAlertRuleRequest{}
 
 	tmp := s.D.Id()
@@ -319,7 +320,7 @@ func (s *BudgetAlertRuleResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "budget")
 
-	response, err := s.Client.UpdateAlertRule(context.Background(), request)
+	response, err := s.Client.UpdateAlertRule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -328,7 +329,7 @@ func (s *BudgetAlertRuleResourceCrud) Update() error {
 	return nil
 }
 
-func (s *BudgetAlertRuleResourceCrud) Delete() error {
+func (s *BudgetAlertRuleResourceCrud) Delete(ctx context.Context) error {
 	request := oci_budget.DeleteAlertRuleRequest{}
 
 	tmp := s.D.Id()
@@ -341,7 +342,7 @@ func (s *BudgetAlertRuleResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "budget")
 
-	_, err := s.Client.DeleteAlertRule(context.Background(), request)
+	_, err := s.Client.DeleteAlertRule(ctx, request)
 	return err
 }
 
@@ -6,6 +6,7 @@ package budget
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_budget "github.com/oracle/oci-go-sdk/v65/budget"
 
@@ -15,7 +16,7 @@ import (
 
 func BudgetAlertRulesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readBudgetAlertRules,
+		ReadContext: readBudgetAlertRules,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"budget_id": {
@@ -39,12 +40,12 @@ func BudgetAlertRulesDataSource() *schema.Resource {
 	}
 }
 
-func readBudgetAlertRules(d *schema.ResourceData, m interface{}) error {
+func readBudgetAlertRules(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BudgetAlertRulesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BudgetClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type BudgetAlertRulesDataSourceCrud struct {
@@ -57,7 +58,7 @@ func (s *BudgetAlertRulesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *BudgetAlertRulesDataSourceCrud) Get() error {
+func (s *BudgetAlertRulesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_budget.ListAlertRulesRequest{}
 
 	if budgetId, ok := s.D.GetOkExists("budget_id"); ok {
@@ -76,7 +77,7 @@ func (s *BudgetAlertRulesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "budget")
 
-	response, err := s.Client.ListAlertRules(context.Background(), request)
+	response, err := s.Client.ListAlertRules(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *BudgetAlertRulesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAlertRules(context.Background(), request)
+		listResponse, err := s.Client.ListAlertRules(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package budget
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_budget "github.com/oracle/oci-go-sdk/v65/budget"
 
@@ -22,12 +23,12 @@ func BudgetBudgetDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(BudgetBudgetResource(), fieldMap, readSingularBudgetBudget)
 }
 
-func readSingularBudgetBudget(d *schema.ResourceData, m interface{}) error {
+func readSingularBudgetBudget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BudgetBudgetDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BudgetClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type BudgetBudgetDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *BudgetBudgetDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *BudgetBudgetDataSourceCrud) Get() error {
+func (s *BudgetBudgetDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_budget.GetBudgetRequest{}
 
 	if budgetId, ok := s.D.GetOkExists("budget_id"); ok {
@@ -50,7 +51,7 @@ func (s *BudgetBudgetDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "budget")
 
-	response, err := s.Client.GetBudget(context.Background(), request)
+	response, err := s.Client.GetBudget(ctx, request)
 	if err != nil {
 		return err
 	}
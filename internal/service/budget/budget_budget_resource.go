@@ -6,6 +6,7 @@ package budget
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_budget "github.com/oracle/oci-go-sdk/v65/budget"
 
@@ -18,11 +19,11 @@ func BudgetBudgetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createBudgetBudget,
-		Read:     readBudgetBudget,
-		Update:   updateBudgetBudget,
-		Delete:   deleteBudgetBudget,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createBudgetBudget,
+		ReadContext:   readBudgetBudget,
+		UpdateContext: updateBudgetBudget,
+		DeleteContext: deleteBudgetBudget,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"amount": {
@@ -135,37 +136,37 @@ func BudgetBudgetResource() *schema.Resource {
 	}
 }
 
-func createBudgetBudget(d *schema.ResourceData, m interface{}) error {
+func createBudgetBudget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BudgetBudgetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BudgetClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readBudgetBudget(d *schema.ResourceData, m interface{}) error {
+func readBudgetBudget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BudgetBudgetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BudgetClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateBudgetBudget(d *schema.ResourceData, m interface{}) error {
+func updateBudgetBudget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BudgetBudgetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BudgetClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteBudgetBudget(d *schema.ResourceData, m interface{}) error {
+func deleteBudgetBudget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BudgetBudgetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BudgetClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type BudgetBudgetResourceCrud struct {
@@ -197,7 +198,7 @@ func (s *BudgetBudgetResourceCrud) DeletedTarget() []string {
 	return []string{}
 }
 
-func (s *BudgetBudgetResourceCrud) Create() error {
+func (s *BudgetBudgetResourceCrud) Create(ctx context.Context) error {
 	request := oci_budget.CreateBudgetRequest{}
 
 	if amount, ok := s.D.GetOkExists("amount"); ok {
@@ -269,7 +270,7 @@ func (s *BudgetBudgetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "budget")
 
-	response, err := s.Client.CreateBudget(context.Background(), request)
+	response, err := s.Client.CreateBudget(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -278,7 +279,7 @@ func (s *BudgetBudgetResourceCrud) Create() error {
 	return nil
 }
 
-func (s *BudgetBudgetResourceCrud) Get() error {
+func (s *BudgetBudgetResourceCrud) Get(ctx context.Context) error {
 	request := oci_budget.GetBudgetRequest{}
 
 	tmp := s.D.Id()
@@ -286,7 +287,7 @@ func (s *BudgetBudgetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "budget")
 
-	response, err := s.Client.GetBudget(context.Background(), request)
+	response, err := s.Client.GetBudget(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -295,7 +296,7 @@ func (s *BudgetBudgetResourceCrud) Get() error {
 	return nil
 }
 
-func (s *BudgetBudgetResourceCrud) Update() error {
+func (s *BudgetBudgetResourceCrud) Update(ctx context.Context) error {
 	request := oci_budget.UpdateBudgetRequest{}
 
 	if amount, ok := s.D.GetOkExists("amount"); ok {
@@ -343,7 +344,7 @@ func (s *BudgetBudgetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "budget")
 
-	response, err := s.Client.UpdateBudget(context.Background(), request)
+	response, err := s.Client.UpdateBudget(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -352,7 +353,7 @@ func (s *BudgetBudgetResourceCrud) Update() error {
 	return nil
 }
 
-func (s *BudgetBudgetResourceCrud) Delete() error {
+func (s *BudgetBudgetResourceCrud) Delete(ctx context.Context) error {
 	request := oci_budget.DeleteBudgetRequest{}
 
 	tmp := s.D.Id()
@@ -360,7 +361,7 @@ func (s *BudgetBudgetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "budget")
 
-	_, err := s.Client.DeleteBudget(context.Background(), request)
+	_, err := s.Client.DeleteBudget(ctx, request)
 	return err
 }
 
@@ -6,6 +6,7 @@ package budget
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_budget "github.com/oracle/oci-go-sdk/v65/budget"
 
@@ -15,7 +16,7 @@ import (
 
 func BudgetBudgetsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readBudgetBudgets,
+		ReadContext: readBudgetBudgets,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,12 +44,12 @@ func BudgetBudgetsDataSource() *schema.Resource {
 	}
 }
 
-func readBudgetBudgets(d *schema.ResourceData, m interface{}) error {
+func readBudgetBudgets(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &BudgetBudgetsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BudgetClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type BudgetBudgetsDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *BudgetBudgetsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *BudgetBudgetsDataSourceCrud) Get() error {
+func (s *BudgetBudgetsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_budget.ListBudgetsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -84,7 +85,7 @@ func (s *BudgetBudgetsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "budget")
 
-	response, err := s.Client.ListBudgets(context.Background(), request)
+	response, err := s.Client.ListBudgets(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -93,7 +94,7 @@ func (s *BudgetBudgetsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBudgets(context.Background(), request)
+		listResponse, err := s.Client.ListBudgets(ctx, request)
 		if err != nil {
 			return err
 		}
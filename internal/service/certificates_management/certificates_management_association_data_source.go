@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_certificates_management "github.com/oracle/oci-go-sdk/v65/certificatesmanagement"
 )
 
 func CertificatesManagementAssociationDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCertificatesManagementAssociation,
+		ReadContext: readSingularCertificatesManagementAssociation,
 		Schema: map[string]*schema.Schema{
 			"association_id": {
 				Type:     schema.TypeString,
@@ -54,12 +55,12 @@ func CertificatesManagementAssociationDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCertificatesManagementAssociation(d *schema.ResourceData, m interface{}) error {
+func readSingularCertificatesManagementAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementAssociationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CertificatesManagementAssociationDataSourceCrud struct {
@@ -72,7 +73,7 @@ func (s *CertificatesManagementAssociationDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CertificatesManagementAssociationDataSourceCrud) Get() error {
+func (s *CertificatesManagementAssociationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_certificates_management.GetAssociationRequest{}
 
 	if associationId, ok := s.D.GetOkExists("association_id"); ok {
@@ -82,7 +83,7 @@ func (s *CertificatesManagementAssociationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "certificates_management")
 
-	response, err := s.Client.GetAssociation(context.Background(), request)
+	response, err := s.Client.GetAssociation(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_certificates_management "github.com/oracle/oci-go-sdk/v65/certificatesmanagement"
 )
 
 func CertificatesManagementAssociationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCertificatesManagementAssociations,
+		ReadContext: readCertificatesManagementAssociations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"associated_resource_id": {
@@ -107,12 +108,12 @@ func CertificatesManagementAssociationsDataSource() *schema.Resource {
 	}
 }
 
-func readCertificatesManagementAssociations(d *schema.ResourceData, m interface{}) error {
+func readCertificatesManagementAssociations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementAssociationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CertificatesManagementAssociationsDataSourceCrud struct {
@@ -125,7 +126,7 @@ func (s *CertificatesManagementAssociationsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CertificatesManagementAssociationsDataSourceCrud) Get() error {
+func (s *CertificatesManagementAssociationsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_certificates_management.ListAssociationsRequest{}
 
 	if associatedResourceId, ok := s.D.GetOkExists("associated_resource_id"); ok {
@@ -159,7 +160,7 @@ func (s *CertificatesManagementAssociationsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "certificates_management")
 
-	response, err := s.Client.ListAssociations(context.Background(), request)
+	response, err := s.Client.ListAssociations(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -168,7 +169,7 @@ func (s *CertificatesManagementAssociationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAssociations(context.Background(), request)
+		listResponse, err := s.Client.ListAssociations(ctx, request)
 		if err != nil {
 			return err
 		}
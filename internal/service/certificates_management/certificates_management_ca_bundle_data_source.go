@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_certificates_management "github.com/oracle/oci-go-sdk/v65/certificatesmanagement"
 )
@@ -22,12 +23,12 @@ func CertificatesManagementCaBundleDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CertificatesManagementCaBundleResource(), fieldMap, readSingularCertificatesManagementCaBundle)
 }
 
-func readSingularCertificatesManagementCaBundle(d *schema.ResourceData, m interface{}) error {
+func readSingularCertificatesManagementCaBundle(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCaBundleDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CertificatesManagementCaBundleDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *CertificatesManagementCaBundleDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CertificatesManagementCaBundleDataSourceCrud) Get() error {
+func (s *CertificatesManagementCaBundleDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_certificates_management.GetCaBundleRequest{}
 
 	if caBundleId, ok := s.D.GetOkExists("ca_bundle_id"); ok {
@@ -50,7 +51,7 @@ func (s *CertificatesManagementCaBundleDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "certificates_management")
 
-	response, err := s.Client.GetCaBundle(context.Background(), request)
+	response, err := s.Client.GetCaBundle(ctx, request)
 	if err != nil {
 		return err
 	}
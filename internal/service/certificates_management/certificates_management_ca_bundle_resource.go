@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_certificates_management "github.com/oracle/oci-go-sdk/v65/certificatesmanagement"
@@ -19,11 +20,11 @@ func CertificatesManagementCaBundleResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCertificatesManagementCaBundle,
-		Read:     readCertificatesManagementCaBundle,
-		Update:   updateCertificatesManagementCaBundle,
-		Delete:   deleteCertificatesManagementCaBundle,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCertificatesManagementCaBundle,
+		ReadContext:   readCertificatesManagementCaBundle,
+		UpdateContext: updateCertificatesManagementCaBundle,
+		DeleteContext: deleteCertificatesManagementCaBundle,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"ca_bundle_pem": {
@@ -77,37 +78,37 @@ func CertificatesManagementCaBundleResource() *schema.Resource {
 	}
 }
 
-func createCertificatesManagementCaBundle(d *schema.ResourceData, m interface{}) error {
+func createCertificatesManagementCaBundle(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCaBundleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCertificatesManagementCaBundle(d *schema.ResourceData, m interface{}) error {
+func readCertificatesManagementCaBundle(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCaBundleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCertificatesManagementCaBundle(d *schema.ResourceData, m interface{}) error {
+func updateCertificatesManagementCaBundle(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCaBundleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCertificatesManagementCaBundle(d *schema.ResourceData, m interface{}) error {
+func deleteCertificatesManagementCaBundle(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCaBundleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CertificatesManagementCaBundleResourceCrud struct {
@@ -157,7 +158,7 @@ func (s *CertificatesManagementCaBundleResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CertificatesManagementCaBundleResourceCrud) Create() error {
+func (s *CertificatesManagementCaBundleResourceCrud) Create(ctx context.Context) error {
 	request := oci_certificates_management.CreateCaBundleRequest{}
 
 	if caBundlePem, ok := s.D.GetOkExists("ca_bundle_pem"); ok {
@@ -194,7 +195,7 @@ func (s *CertificatesManagementCaBundleResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
 
-	response, err := s.Client.CreateCaBundle(context.Background(), request)
+	response, err := s.Client.CreateCaBundle(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -203,7 +204,7 @@ func (s *CertificatesManagementCaBundleResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CertificatesManagementCaBundleResourceCrud) Get() error {
+func (s *CertificatesManagementCaBundleResourceCrud) Get(ctx context.Context) error {
 	request := oci_certificates_management.GetCaBundleRequest{}
 
 	tmp := s.D.Id()
@@ -211,7 +212,7 @@ func (s *CertificatesManagementCaBundleResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
 
-	response, err := s.Client.GetCaBundle(context.Background(), request)
+	response, err := s.Client.GetCaBundle(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -220,11 +221,11 @@ func (s *CertificatesManagementCaBundleResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CertificatesManagementCaBundleResourceCrud) Update() error {
+func (s *CertificatesManagementCaBundleResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -260,7 +261,7 @@ func (s *CertificatesManagementCaBundleResourceCrud) Update() error {
 	if request.CaBundlePem != nil || request.DefinedTags != nil || request.Description != nil || request.FreeformTags != nil {
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
 
-		response, err := s.Client.UpdateCaBundle(context.Background(), request)
+		response, err := s.Client.UpdateCaBundle(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -271,7 +272,7 @@ func (s *CertificatesManagementCaBundleResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CertificatesManagementCaBundleResourceCrud) Delete() error {
+func (s *CertificatesManagementCaBundleResourceCrud) Delete(ctx context.Context) error {
 	request := oci_certificates_management.DeleteCaBundleRequest{}
 
 	tmp := s.D.Id()
@@ -279,7 +280,7 @@ func (s *CertificatesManagementCaBundleResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
 
-	_, err := s.Client.DeleteCaBundle(context.Background(), request)
+	_, err := s.Client.DeleteCaBundle(ctx, request)
 	return err
 }
 
@@ -357,7 +358,7 @@ func CaBundleSummaryToMap(obj oci_certificates_management.CaBundleSummary) map[s
 	return result
 }
 
-func (s *CertificatesManagementCaBundleResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CertificatesManagementCaBundleResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_certificates_management.ChangeCaBundleCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -368,7 +369,7 @@ func (s *CertificatesManagementCaBundleResourceCrud) updateCompartment(compartme
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
 
-	_, err := s.Client.ChangeCaBundleCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeCaBundleCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
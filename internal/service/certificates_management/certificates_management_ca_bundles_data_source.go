@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_certificates_management "github.com/oracle/oci-go-sdk/v65/certificatesmanagement"
 )
 
 func CertificatesManagementCaBundlesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCertificatesManagementCaBundles,
+		ReadContext: readCertificatesManagementCaBundles,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"ca_bundle_id": {
@@ -56,12 +57,12 @@ func CertificatesManagementCaBundlesDataSource() *schema.Resource {
 	}
 }
 
-func readCertificatesManagementCaBundles(d *schema.ResourceData, m interface{}) error {
+func readCertificatesManagementCaBundles(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCaBundlesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CertificatesManagementCaBundlesDataSourceCrud struct {
@@ -74,7 +75,7 @@ func (s *CertificatesManagementCaBundlesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CertificatesManagementCaBundlesDataSourceCrud) Get() error {
+func (s *CertificatesManagementCaBundlesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_certificates_management.ListCaBundlesRequest{}
 
 	if caBundleId, ok := s.D.GetOkExists("ca_bundle_id"); ok {
@@ -98,7 +99,7 @@ func (s *CertificatesManagementCaBundlesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "certificates_management")
 
-	response, err := s.Client.ListCaBundles(context.Background(), request)
+	response, err := s.Client.ListCaBundles(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -107,7 +108,7 @@ func (s *CertificatesManagementCaBundlesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCaBundles(context.Background(), request)
+		listResponse, err := s.Client.ListCaBundles(ctx, request)
 		if err != nil {
 			return err
 		}
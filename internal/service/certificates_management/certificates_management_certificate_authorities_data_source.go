@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_certificates_management "github.com/oracle/oci-go-sdk/v65/certificatesmanagement"
 )
 
 func CertificatesManagementCertificateAuthoritiesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCertificatesManagementCertificateAuthorities,
+		ReadContext: readCertificatesManagementCertificateAuthorities,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"certificate_authority_id": {
@@ -62,12 +63,12 @@ func CertificatesManagementCertificateAuthoritiesDataSource() *schema.Resource {
 	}
 }
 
-func readCertificatesManagementCertificateAuthorities(d *schema.ResourceData, m interface{}) error {
+func readCertificatesManagementCertificateAuthorities(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCertificateAuthoritiesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CertificatesManagementCertificateAuthoritiesDataSourceCrud struct {
@@ -80,7 +81,7 @@ func (s *CertificatesManagementCertificateAuthoritiesDataSourceCrud) VoidState()
 	s.D.SetId("")
 }
 
-func (s *CertificatesManagementCertificateAuthoritiesDataSourceCrud) Get() error {
+func (s *CertificatesManagementCertificateAuthoritiesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_certificates_management.ListCertificateAuthoritiesRequest{}
 
 	if certificateAuthorityId, ok := s.D.GetOkExists("certificate_authority_id"); ok {
@@ -109,7 +110,7 @@ func (s *CertificatesManagementCertificateAuthoritiesDataSourceCrud) Get() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "certificates_management")
 
-	response, err := s.Client.ListCertificateAuthorities(context.Background(), request)
+	response, err := s.Client.ListCertificateAuthorities(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -118,7 +119,7 @@ func (s *CertificatesManagementCertificateAuthoritiesDataSourceCrud) Get() error
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCertificateAuthorities(context.Background(), request)
+		listResponse, err := s.Client.ListCertificateAuthorities(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_certificates_management "github.com/oracle/oci-go-sdk/v65/certificatesmanagement"
 )
@@ -22,12 +23,12 @@ func CertificatesManagementCertificateAuthorityDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CertificatesManagementCertificateAuthorityResource(), fieldMap, readSingularCertificatesManagementCertificateAuthority)
 }
 
-func readSingularCertificatesManagementCertificateAuthority(d *schema.ResourceData, m interface{}) error {
+func readSingularCertificatesManagementCertificateAuthority(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCertificateAuthorityDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CertificatesManagementCertificateAuthorityDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *CertificatesManagementCertificateAuthorityDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CertificatesManagementCertificateAuthorityDataSourceCrud) Get() error {
+func (s *CertificatesManagementCertificateAuthorityDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_certificates_management.GetCertificateAuthorityRequest{}
 
 	if certificateAuthorityId, ok := s.D.GetOkExists("certificate_authority_id"); ok {
@@ -50,7 +51,7 @@ func (s *CertificatesManagementCertificateAuthorityDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "certificates_management")
 
-	response, err := s.Client.GetCertificateAuthority(context.Background(), request)
+	response, err := s.Client.GetCertificateAuthority(ctx, request)
 	if err != nil {
 		return err
 	}
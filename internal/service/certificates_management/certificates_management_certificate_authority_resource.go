@@ -15,6 +15,7 @@ import (
 
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -26,11 +27,11 @@ func CertificatesManagementCertificateAuthorityResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCertificatesManagementCertificateAuthority,
-		Read:     readCertificatesManagementCertificateAuthority,
-		Update:   updateCertificatesManagementCertificateAuthority,
-		Delete:   deleteCertificatesManagementCertificateAuthority,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCertificatesManagementCertificateAuthority,
+		ReadContext:   readCertificatesManagementCertificateAuthority,
+		UpdateContext: updateCertificatesManagementCertificateAuthority,
+		DeleteContext: deleteCertificatesManagementCertificateAuthority,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"certificate_authority_config": {
@@ -543,36 +544,36 @@ func CertificatesManagementCertificateAuthorityResource() *schema.Resource {
 	}
 }
 
-func createCertificatesManagementCertificateAuthority(d *schema.ResourceData, m interface{}) error {
+func createCertificatesManagementCertificateAuthority(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCertificateAuthorityResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCertificatesManagementCertificateAuthority(d *schema.ResourceData, m interface{}) error {
+func readCertificatesManagementCertificateAuthority(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCertificateAuthorityResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCertificatesManagementCertificateAuthority(d *schema.ResourceData, m interface{}) error {
+func updateCertificatesManagementCertificateAuthority(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCertificateAuthorityResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCertificatesManagementCertificateAuthority(d *schema.ResourceData, m interface{}) error {
+func deleteCertificatesManagementCertificateAuthority(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCertificateAuthorityResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CertificatesManagementCertificateAuthorityResourceCrud struct {
@@ -624,7 +625,7 @@ func (s *CertificatesManagementCertificateAuthorityResourceCrud) DeletedTarget()
 	}
 }
 
-func (s *CertificatesManagementCertificateAuthorityResourceCrud) Create() error {
+func (s *CertificatesManagementCertificateAuthorityResourceCrud) Create(ctx context.Context) error {
 	request := oci_certificates_management.CreateCertificateAuthorityRequest{}
 
 	if certificateAuthorityConfig, ok := s.D.GetOkExists("certificate_authority_config"); ok {
@@ -700,7 +701,7 @@ func (s *CertificatesManagementCertificateAuthorityResourceCrud) Create() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
 
-	response, err := s.Client.CreateCertificateAuthority(context.Background(), request)
+	response, err := s.Client.CreateCertificateAuthority(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -709,7 +710,7 @@ func (s *CertificatesManagementCertificateAuthorityResourceCrud) Create() error
 	return nil
 }
 
-func (s *CertificatesManagementCertificateAuthorityResourceCrud) Get() error {
+func (s *CertificatesManagementCertificateAuthorityResourceCrud) Get(ctx context.Context) error {
 	request := oci_certificates_management.GetCertificateAuthorityRequest{}
 
 	tmp := s.D.Id()
@@ -717,7 +718,7 @@ func (s *CertificatesManagementCertificateAuthorityResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
 
-	response, err := s.Client.GetCertificateAuthority(context.Background(), request)
+	response, err := s.Client.GetCertificateAuthority(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -726,11 +727,11 @@ func (s *CertificatesManagementCertificateAuthorityResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CertificatesManagementCertificateAuthorityResourceCrud) Update() error {
+func (s *CertificatesManagementCertificateAuthorityResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -787,7 +788,7 @@ func (s *CertificatesManagementCertificateAuthorityResourceCrud) Update() error
 		tmp := s.D.Id()
 		request.CertificateAuthorityId = &tmp
 
-		response, err := s.Client.UpdateCertificateAuthority(context.Background(), request)
+		response, err := s.Client.UpdateCertificateAuthority(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -797,16 +798,16 @@ func (s *CertificatesManagementCertificateAuthorityResourceCrud) Update() error
 
 	// update rules
 	if _, ok := s.D.GetOkExists("certificate_authority_rules"); ok && s.D.HasChange("certificate_authority_rules") {
-		err := s.UpdateRules()
+		err := s.UpdateRules(ctx)
 		if err != nil {
 			return err
 		}
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *CertificatesManagementCertificateAuthorityResourceCrud) UpdateRules() error {
+func (s *CertificatesManagementCertificateAuthorityResourceCrud) UpdateRules(ctx context.Context) error {
 	request := oci_certificates_management.UpdateCertificateAuthorityRequest{}
 
 	tmp := s.D.Id()
@@ -832,16 +833,16 @@ func (s *CertificatesManagementCertificateAuthorityResourceCrud) UpdateRules() e
 	}
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
 
-	response, err := s.Client.UpdateCertificateAuthority(context.Background(), request)
+	response, err := s.Client.UpdateCertificateAuthority(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.CertificateAuthority
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *CertificatesManagementCertificateAuthorityResourceCrud) Delete() error {
+func (s *CertificatesManagementCertificateAuthorityResourceCrud) Delete(ctx context.Context) error {
 	request := oci_certificates_management.ScheduleCertificateAuthorityDeletionRequest{}
 
 	if timeOfDeletion, ok := s.D.GetOkExists("time_of_deletion"); ok {
@@ -856,7 +857,7 @@ func (s *CertificatesManagementCertificateAuthorityResourceCrud) Delete() error
 	tmp := s.D.Id()
 	request.CertificateAuthorityId = &tmp
 
-	_, err := s.Client.ScheduleCertificateAuthorityDeletion(context.Background(), request)
+	_, err := s.Client.ScheduleCertificateAuthorityDeletion(ctx, request)
 	return err
 }
 
@@ -1405,7 +1406,7 @@ func VersionStageToMap(obj oci_certificates_management.VersionStageEnum) map[str
 	return result
 }
 
-func (s *CertificatesManagementCertificateAuthorityResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CertificatesManagementCertificateAuthorityResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_certificates_management.ChangeCertificateAuthorityCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -1416,7 +1417,7 @@ func (s *CertificatesManagementCertificateAuthorityResourceCrud) updateCompartme
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
 
-	_, err := s.Client.ChangeCertificateAuthorityCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeCertificateAuthorityCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -11,13 +11,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_certificates_management "github.com/oracle/oci-go-sdk/v65/certificatesmanagement"
 )
 
 func CertificatesManagementCertificateAuthorityVersionDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCertificatesManagementCertificateAuthorityVersion,
+		ReadContext: readSingularCertificatesManagementCertificateAuthorityVersion,
 		Schema: map[string]*schema.Schema{
 			"certificate_authority_id": {
 				Type:     schema.TypeString,
@@ -126,12 +127,12 @@ func CertificatesManagementCertificateAuthorityVersionDataSource() *schema.Resou
 	}
 }
 
-func readSingularCertificatesManagementCertificateAuthorityVersion(d *schema.ResourceData, m interface{}) error {
+func readSingularCertificatesManagementCertificateAuthorityVersion(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCertificateAuthorityVersionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CertificatesManagementCertificateAuthorityVersionDataSourceCrud struct {
@@ -144,7 +145,7 @@ func (s *CertificatesManagementCertificateAuthorityVersionDataSourceCrud) VoidSt
 	s.D.SetId("")
 }
 
-func (s *CertificatesManagementCertificateAuthorityVersionDataSourceCrud) Get() error {
+func (s *CertificatesManagementCertificateAuthorityVersionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_certificates_management.GetCertificateAuthorityVersionRequest{}
 
 	if certificateAuthorityId, ok := s.D.GetOkExists("certificate_authority_id"); ok {
@@ -163,7 +164,7 @@ func (s *CertificatesManagementCertificateAuthorityVersionDataSourceCrud) Get()
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "certificates_management")
 
-	response, err := s.Client.GetCertificateAuthorityVersion(context.Background(), request)
+	response, err := s.Client.GetCertificateAuthorityVersion(ctx, request)
 	if err != nil {
 		return err
 	}
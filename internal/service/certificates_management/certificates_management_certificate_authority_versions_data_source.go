@@ -11,13 +11,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_certificates_management "github.com/oracle/oci-go-sdk/v65/certificatesmanagement"
 )
 
 func CertificatesManagementCertificateAuthorityVersionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCertificatesManagementCertificateAuthorityVersions,
+		ReadContext: readCertificatesManagementCertificateAuthorityVersions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"certificate_authority_id": {
@@ -128,12 +129,12 @@ func CertificatesManagementCertificateAuthorityVersionsDataSource() *schema.Reso
 	}
 }
 
-func readCertificatesManagementCertificateAuthorityVersions(d *schema.ResourceData, m interface{}) error {
+func readCertificatesManagementCertificateAuthorityVersions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCertificateAuthorityVersionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CertificatesManagementCertificateAuthorityVersionsDataSourceCrud struct {
@@ -146,7 +147,7 @@ func (s *CertificatesManagementCertificateAuthorityVersionsDataSourceCrud) VoidS
 	s.D.SetId("")
 }
 
-func (s *CertificatesManagementCertificateAuthorityVersionsDataSourceCrud) Get() error {
+func (s *CertificatesManagementCertificateAuthorityVersionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_certificates_management.ListCertificateAuthorityVersionsRequest{}
 
 	if certificateAuthorityId, ok := s.D.GetOkExists("certificate_authority_id"); ok {
@@ -165,7 +166,7 @@ func (s *CertificatesManagementCertificateAuthorityVersionsDataSourceCrud) Get()
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "certificates_management")
 
-	response, err := s.Client.ListCertificateAuthorityVersions(context.Background(), request)
+	response, err := s.Client.ListCertificateAuthorityVersions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -174,7 +175,7 @@ func (s *CertificatesManagementCertificateAuthorityVersionsDataSourceCrud) Get()
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCertificateAuthorityVersions(context.Background(), request)
+		listResponse, err := s.Client.ListCertificateAuthorityVersions(ctx, request)
 		if err != nil {
 			return err
 		}
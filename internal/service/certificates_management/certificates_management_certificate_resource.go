@@ -16,6 +16,7 @@ import (
 
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -27,11 +28,11 @@ func CertificatesManagementCertificateResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCertificatesManagementCertificate,
-		Read:     readCertificatesManagementCertificate,
-		Update:   updateCertificatesManagementCertificate,
-		Delete:   deleteCertificatesManagementCertificate,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCertificatesManagementCertificate,
+		ReadContext:   readCertificatesManagementCertificate,
+		UpdateContext: updateCertificatesManagementCertificate,
+		DeleteContext: deleteCertificatesManagementCertificate,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"certificate_config": {
@@ -602,36 +603,36 @@ func CertificatesManagementCertificateResource() *schema.Resource {
 	}
 }
 
-func createCertificatesManagementCertificate(d *schema.ResourceData, m interface{}) error {
+func createCertificatesManagementCertificate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCertificateResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCertificatesManagementCertificate(d *schema.ResourceData, m interface{}) error {
+func readCertificatesManagementCertificate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCertificateResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCertificatesManagementCertificate(d *schema.ResourceData, m interface{}) error {
+func updateCertificatesManagementCertificate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCertificateResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCertificatesManagementCertificate(d *schema.ResourceData, m interface{}) error {
+func deleteCertificatesManagementCertificate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCertificateResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CertificatesManagementCertificateResourceCrud struct {
@@ -683,7 +684,7 @@ func (s *CertificatesManagementCertificateResourceCrud) DeletedTarget() []string
 	}
 }
 
-func (s *CertificatesManagementCertificateResourceCrud) Create() error {
+func (s *CertificatesManagementCertificateResourceCrud) Create(ctx context.Context) error {
 	request := oci_certificates_management.CreateCertificateRequest{}
 
 	if certificateConfig, ok := s.D.GetOkExists("certificate_config"); ok {
@@ -743,7 +744,7 @@ func (s *CertificatesManagementCertificateResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
 
-	response, err := s.Client.CreateCertificate(context.Background(), request)
+	response, err := s.Client.CreateCertificate(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -752,7 +753,7 @@ func (s *CertificatesManagementCertificateResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CertificatesManagementCertificateResourceCrud) Get() error {
+func (s *CertificatesManagementCertificateResourceCrud) Get(ctx context.Context) error {
 	request := oci_certificates_management.GetCertificateRequest{}
 
 	tmp := s.D.Id()
@@ -760,7 +761,7 @@ func (s *CertificatesManagementCertificateResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
 
-	response, err := s.Client.GetCertificate(context.Background(), request)
+	response, err := s.Client.GetCertificate(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -769,11 +770,11 @@ func (s *CertificatesManagementCertificateResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CertificatesManagementCertificateResourceCrud) Update() error {
+func (s *CertificatesManagementCertificateResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -825,7 +826,7 @@ func (s *CertificatesManagementCertificateResourceCrud) Update() error {
 		tmp := s.D.Id()
 		request.CertificateId = &tmp
 
-		response, err := s.Client.UpdateCertificate(context.Background(), request)
+		response, err := s.Client.UpdateCertificate(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -835,7 +836,7 @@ func (s *CertificatesManagementCertificateResourceCrud) Update() error {
 
 	// update rules
 	if _, ok := s.D.GetOkExists("certificate_rules"); ok && s.D.HasChange("certificate_rules") {
-		err := s.UpdateRules()
+		err := s.UpdateRules(ctx)
 		if err != nil {
 			return err
 		}
@@ -844,7 +845,7 @@ func (s *CertificatesManagementCertificateResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CertificatesManagementCertificateResourceCrud) UpdateRules() error {
+func (s *CertificatesManagementCertificateResourceCrud) UpdateRules(ctx context.Context) error {
 	request := oci_certificates_management.UpdateCertificateRequest{}
 
 	tmp := s.D.Id()
@@ -871,16 +872,16 @@ func (s *CertificatesManagementCertificateResourceCrud) UpdateRules() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
 
-	response, err := s.Client.UpdateCertificate(context.Background(), request)
+	response, err := s.Client.UpdateCertificate(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.Certificate
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *CertificatesManagementCertificateResourceCrud) Delete() error {
+func (s *CertificatesManagementCertificateResourceCrud) Delete(ctx context.Context) error {
 	request := oci_certificates_management.ScheduleCertificateDeletionRequest{}
 
 	if timeOfDeletion, ok := s.D.GetOkExists("time_of_deletion"); ok {
@@ -895,7 +896,7 @@ func (s *CertificatesManagementCertificateResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.CertificateId = &tmp
 
-	_, err := s.Client.ScheduleCertificateDeletion(context.Background(), request)
+	_, err := s.Client.ScheduleCertificateDeletion(ctx, request)
 	return err
 }
 
@@ -1380,7 +1381,7 @@ func CreateCertificateConfigDetailsToMap(obj *oci_certificates_management.Create
 	return result
 }
 
-func (s *CertificatesManagementCertificateResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CertificatesManagementCertificateResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_certificates_management.ChangeCertificateCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -1391,7 +1392,7 @@ func (s *CertificatesManagementCertificateResourceCrud) updateCompartment(compar
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "certificates_management")
 
-	_, err := s.Client.ChangeCertificateCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeCertificateCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
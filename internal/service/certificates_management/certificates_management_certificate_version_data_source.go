@@ -11,13 +11,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_certificates_management "github.com/oracle/oci-go-sdk/v65/certificatesmanagement"
 )
 
 func CertificatesManagementCertificateVersionDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCertificatesManagementCertificateVersion,
+		ReadContext: readSingularCertificatesManagementCertificateVersion,
 		Schema: map[string]*schema.Schema{
 			"certificate_id": {
 				Type:     schema.TypeString,
@@ -126,12 +127,12 @@ func CertificatesManagementCertificateVersionDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCertificatesManagementCertificateVersion(d *schema.ResourceData, m interface{}) error {
+func readSingularCertificatesManagementCertificateVersion(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCertificateVersionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CertificatesManagementCertificateVersionDataSourceCrud struct {
@@ -144,7 +145,7 @@ func (s *CertificatesManagementCertificateVersionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CertificatesManagementCertificateVersionDataSourceCrud) Get() error {
+func (s *CertificatesManagementCertificateVersionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_certificates_management.GetCertificateVersionRequest{}
 
 	if certificateId, ok := s.D.GetOkExists("certificate_id"); ok {
@@ -163,7 +164,7 @@ func (s *CertificatesManagementCertificateVersionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "certificates_management")
 
-	response, err := s.Client.GetCertificateVersion(context.Background(), request)
+	response, err := s.Client.GetCertificateVersion(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -11,13 +11,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_certificates_management "github.com/oracle/oci-go-sdk/v65/certificatesmanagement"
 )
 
 func CertificatesManagementCertificateVersionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCertificatesManagementCertificateVersions,
+		ReadContext: readCertificatesManagementCertificateVersions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"certificate_id": {
@@ -149,12 +150,12 @@ func CertificatesManagementCertificateVersionsDataSource() *schema.Resource {
 	}
 }
 
-func readCertificatesManagementCertificateVersions(d *schema.ResourceData, m interface{}) error {
+func readCertificatesManagementCertificateVersions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCertificateVersionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CertificatesManagementCertificateVersionsDataSourceCrud struct {
@@ -167,7 +168,7 @@ func (s *CertificatesManagementCertificateVersionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CertificatesManagementCertificateVersionsDataSourceCrud) Get() error {
+func (s *CertificatesManagementCertificateVersionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_certificates_management.ListCertificateVersionsRequest{}
 
 	if certificateId, ok := s.D.GetOkExists("certificate_id"); ok {
@@ -186,7 +187,7 @@ func (s *CertificatesManagementCertificateVersionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "certificates_management")
 
-	response, err := s.Client.ListCertificateVersions(context.Background(), request)
+	response, err := s.Client.ListCertificateVersions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -195,7 +196,7 @@ func (s *CertificatesManagementCertificateVersionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCertificateVersions(context.Background(), request)
+		listResponse, err := s.Client.ListCertificateVersions(ctx, request)
 		if err != nil {
 			return err
 		}
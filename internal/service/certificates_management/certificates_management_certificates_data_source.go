@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_certificates_management "github.com/oracle/oci-go-sdk/v65/certificatesmanagement"
 )
 
 func CertificatesManagementCertificatesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCertificatesManagementCertificates,
+		ReadContext: readCertificatesManagementCertificates,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"certificate_id": {
@@ -62,12 +63,12 @@ func CertificatesManagementCertificatesDataSource() *schema.Resource {
 	}
 }
 
-func readCertificatesManagementCertificates(d *schema.ResourceData, m interface{}) error {
+func readCertificatesManagementCertificates(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CertificatesManagementCertificatesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CertificatesManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CertificatesManagementCertificatesDataSourceCrud struct {
@@ -80,7 +81,7 @@ func (s *CertificatesManagementCertificatesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CertificatesManagementCertificatesDataSourceCrud) Get() error {
+func (s *CertificatesManagementCertificatesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_certificates_management.ListCertificatesRequest{}
 
 	if certificateId, ok := s.D.GetOkExists("certificate_id"); ok {
@@ -109,7 +110,7 @@ func (s *CertificatesManagementCertificatesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "certificates_management")
 
-	response, err := s.Client.ListCertificates(context.Background(), request)
+	response, err := s.Client.ListCertificates(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -118,7 +119,7 @@ func (s *CertificatesManagementCertificatesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCertificates(context.Background(), request)
+		listResponse, err := s.Client.ListCertificates(ctx, request)
 		if err != nil {
 			return err
 		}
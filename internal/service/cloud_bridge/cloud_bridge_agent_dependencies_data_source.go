@@ -6,6 +6,7 @@ package cloud_bridge
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_bridge "github.com/oracle/oci-go-sdk/v65/cloudbridge"
 
@@ -15,7 +16,7 @@ import (
 
 func CloudBridgeAgentDependenciesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCloudBridgeAgentDependencies,
+		ReadContext: readCloudBridgeAgentDependencies,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"agent_id": {
@@ -56,12 +57,12 @@ func CloudBridgeAgentDependenciesDataSource() *schema.Resource {
 	}
 }
 
-func readCloudBridgeAgentDependencies(d *schema.ResourceData, m interface{}) error {
+func readCloudBridgeAgentDependencies(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudBridgeAgentDependenciesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OcbAgentSvcClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudBridgeAgentDependenciesDataSourceCrud struct {
@@ -74,7 +75,7 @@ func (s *CloudBridgeAgentDependenciesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudBridgeAgentDependenciesDataSourceCrud) Get() error {
+func (s *CloudBridgeAgentDependenciesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_bridge.ListAgentDependenciesRequest{}
 
 	if agentId, ok := s.D.GetOkExists("agent_id"); ok {
@@ -103,7 +104,7 @@ func (s *CloudBridgeAgentDependenciesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_bridge")
 
-	response, err := s.Client.ListAgentDependencies(context.Background(), request)
+	response, err := s.Client.ListAgentDependencies(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +113,7 @@ func (s *CloudBridgeAgentDependenciesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAgentDependencies(context.Background(), request)
+		listResponse, err := s.Client.ListAgentDependencies(ctx, request)
 		if err != nil {
 			return err
 		}
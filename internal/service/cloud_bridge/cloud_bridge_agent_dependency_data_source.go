@@ -6,6 +6,7 @@ package cloud_bridge
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_bridge "github.com/oracle/oci-go-sdk/v65/cloudbridge"
 
@@ -22,12 +23,12 @@ func CloudBridgeAgentDependencyDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CloudBridgeAgentDependencyResource(), fieldMap, readSingularCloudBridgeAgentDependency)
 }
 
-func readSingularCloudBridgeAgentDependency(d *schema.ResourceData, m interface{}) error {
+func readSingularCloudBridgeAgentDependency(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudBridgeAgentDependencyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OcbAgentSvcClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudBridgeAgentDependencyDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *CloudBridgeAgentDependencyDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudBridgeAgentDependencyDataSourceCrud) Get() error {
+func (s *CloudBridgeAgentDependencyDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_bridge.GetAgentDependencyRequest{}
 
 	if agentDependencyId, ok := s.D.GetOkExists("agent_dependency_id"); ok {
@@ -50,7 +51,7 @@ func (s *CloudBridgeAgentDependencyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_bridge")
 
-	response, err := s.Client.GetAgentDependency(context.Background(), request)
+	response, err := s.Client.GetAgentDependency(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -25,11 +26,11 @@ func CloudBridgeAgentDependencyResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCloudBridgeAgentDependency,
-		Read:     readCloudBridgeAgentDependency,
-		Update:   updateCloudBridgeAgentDependency,
-		Delete:   deleteCloudBridgeAgentDependency,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCloudBridgeAgentDependency,
+		ReadContext:   readCloudBridgeAgentDependency,
+		UpdateContext: updateCloudBridgeAgentDependency,
+		DeleteContext: deleteCloudBridgeAgentDependency,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"bucket": {
@@ -113,41 +114,41 @@ func CloudBridgeAgentDependencyResource() *schema.Resource {
 	}
 }
 
-func createCloudBridgeAgentDependency(d *schema.ResourceData, m interface{}) error {
+func createCloudBridgeAgentDependency(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudBridgeAgentDependencyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OcbAgentSvcClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).CommonClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCloudBridgeAgentDependency(d *schema.ResourceData, m interface{}) error {
+func readCloudBridgeAgentDependency(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudBridgeAgentDependencyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OcbAgentSvcClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).CommonClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCloudBridgeAgentDependency(d *schema.ResourceData, m interface{}) error {
+func updateCloudBridgeAgentDependency(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudBridgeAgentDependencyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OcbAgentSvcClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).CommonClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCloudBridgeAgentDependency(d *schema.ResourceData, m interface{}) error {
+func deleteCloudBridgeAgentDependency(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudBridgeAgentDependencyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OcbAgentSvcClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).CommonClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CloudBridgeAgentDependencyResourceCrud struct {
@@ -186,7 +187,7 @@ func (s *CloudBridgeAgentDependencyResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CloudBridgeAgentDependencyResourceCrud) Create() error {
+func (s *CloudBridgeAgentDependencyResourceCrud) Create(ctx context.Context) error {
 	request := oci_cloud_bridge.CreateAgentDependencyRequest{}
 
 	if bucket, ok := s.D.GetOkExists("bucket"); ok {
@@ -251,26 +252,26 @@ func (s *CloudBridgeAgentDependencyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
-	response, err := s.Client.CreateAgentDependency(context.Background(), request)
+	response, err := s.Client.CreateAgentDependency(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getAgentDependencyFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge"), oci_cloud_bridge.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getAgentDependencyFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge"), oci_cloud_bridge.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *CloudBridgeAgentDependencyResourceCrud) getAgentDependencyFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *CloudBridgeAgentDependencyResourceCrud) getAgentDependencyFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_cloud_bridge.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	agentDependencyId, err := agentDependencyWaitForWorkRequest(workId, "agentdependency",
+	agentDependencyId, err := agentDependencyWaitForWorkRequest(ctx, workId, "agentdependency",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.WorkRequestClient)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, agentDependencyId)
-		_, cancelErr := s.WorkRequestClient.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.WorkRequestClient.CancelWorkRequest(ctx,
 			oci_cloud_bridge.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -284,7 +285,7 @@ func (s *CloudBridgeAgentDependencyResourceCrud) getAgentDependencyFromWorkReque
 	}
 	s.D.SetId(*agentDependencyId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func agentDependencyWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -310,7 +311,7 @@ func agentDependencyWorkRequestShouldRetryFunc(timeout time.Duration) func(respo
 	}
 }
 
-func agentDependencyWaitForWorkRequest(wId *string, entityType string, action oci_cloud_bridge.ActionTypeEnum,
+func agentDependencyWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_cloud_bridge.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_cloud_bridge.CommonClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "cloud_bridge")
 	retryPolicy.ShouldRetryOperation = agentDependencyWorkRequestShouldRetryFunc(timeout)
@@ -329,7 +330,7 @@ func agentDependencyWaitForWorkRequest(wId *string, entityType string, action oc
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_cloud_bridge.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -358,14 +359,14 @@ func agentDependencyWaitForWorkRequest(wId *string, entityType string, action oc
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_cloud_bridge.OperationStatusFailed || response.Status == oci_cloud_bridge.OperationStatusCanceled {
-		return nil, getErrorFromCloudBridgeAgentDependencyWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromCloudBridgeAgentDependencyWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromCloudBridgeAgentDependencyWorkRequest(client *oci_cloud_bridge.CommonClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_cloud_bridge.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromCloudBridgeAgentDependencyWorkRequest(ctx context.Context, client *oci_cloud_bridge.CommonClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_cloud_bridge.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_cloud_bridge.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -387,7 +388,7 @@ func getErrorFromCloudBridgeAgentDependencyWorkRequest(client *oci_cloud_bridge.
 	return workRequestErr
 }
 
-func (s *CloudBridgeAgentDependencyResourceCrud) Get() error {
+func (s *CloudBridgeAgentDependencyResourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_bridge.GetAgentDependencyRequest{}
 
 	tmp := s.D.Id()
@@ -395,7 +396,7 @@ func (s *CloudBridgeAgentDependencyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
-	response, err := s.Client.GetAgentDependency(context.Background(), request)
+	response, err := s.Client.GetAgentDependency(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -404,11 +405,11 @@ func (s *CloudBridgeAgentDependencyResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CloudBridgeAgentDependencyResourceCrud) Update() error {
+func (s *CloudBridgeAgentDependencyResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -476,16 +477,16 @@ func (s *CloudBridgeAgentDependencyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
-	response, err := s.Client.UpdateAgentDependency(context.Background(), request)
+	response, err := s.Client.UpdateAgentDependency(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getAgentDependencyFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge"), oci_cloud_bridge.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getAgentDependencyFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge"), oci_cloud_bridge.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *CloudBridgeAgentDependencyResourceCrud) Delete() error {
+func (s *CloudBridgeAgentDependencyResourceCrud) Delete(ctx context.Context) error {
 	request := oci_cloud_bridge.DeleteAgentDependencyRequest{}
 
 	tmp := s.D.Id()
@@ -493,7 +494,7 @@ func (s *CloudBridgeAgentDependencyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
-	_, err := s.Client.DeleteAgentDependency(context.Background(), request)
+	_, err := s.Client.DeleteAgentDependency(ctx, request)
 	return err
 }
 
@@ -633,7 +634,7 @@ func AgentDependencySummaryToMap(obj oci_cloud_bridge.AgentDependencySummary) ma
 	return result
 }
 
-func (s *CloudBridgeAgentDependencyResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CloudBridgeAgentDependencyResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_cloud_bridge.ChangeAgentDependencyCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -644,12 +645,12 @@ func (s *CloudBridgeAgentDependencyResourceCrud) updateCompartment(compartment i
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
-	_, err := s.Client.ChangeAgentDependencyCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeAgentDependencyCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
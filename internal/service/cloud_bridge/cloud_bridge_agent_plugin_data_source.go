@@ -6,6 +6,7 @@ package cloud_bridge
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_bridge "github.com/oracle/oci-go-sdk/v65/cloudbridge"
 
@@ -26,12 +27,12 @@ func CloudBridgeAgentPluginDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CloudBridgeAgentPluginResource(), fieldMap, readSingularCloudBridgeAgentPlugin)
 }
 
-func readSingularCloudBridgeAgentPlugin(d *schema.ResourceData, m interface{}) error {
+func readSingularCloudBridgeAgentPlugin(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudBridgeAgentPluginDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OcbAgentSvcClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudBridgeAgentPluginDataSourceCrud struct {
@@ -44,7 +45,7 @@ func (s *CloudBridgeAgentPluginDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudBridgeAgentPluginDataSourceCrud) Get() error {
+func (s *CloudBridgeAgentPluginDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_bridge.GetPluginRequest{}
 
 	if agentId, ok := s.D.GetOkExists("agent_id"); ok {
@@ -59,7 +60,7 @@ func (s *CloudBridgeAgentPluginDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_bridge")
 
-	response, err := s.Client.GetPlugin(context.Background(), request)
+	response, err := s.Client.GetPlugin(ctx, request)
 	if err != nil {
 		return err
 	}
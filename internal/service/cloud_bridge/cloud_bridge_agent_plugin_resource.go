@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_cloud_bridge "github.com/oracle/oci-go-sdk/v65/cloudbridge"
@@ -24,11 +25,11 @@ func CloudBridgeAgentPluginResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCloudBridgeAgentPlugin,
-		Read:     readCloudBridgeAgentPlugin,
-		Update:   updateCloudBridgeAgentPlugin,
-		Delete:   deleteCloudBridgeAgentPlugin,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCloudBridgeAgentPlugin,
+		ReadContext:   readCloudBridgeAgentPlugin,
+		UpdateContext: updateCloudBridgeAgentPlugin,
+		DeleteContext: deleteCloudBridgeAgentPlugin,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"agent_id": {
@@ -93,31 +94,31 @@ func CloudBridgeAgentPluginResource() *schema.Resource {
 	}
 }
 
-func createCloudBridgeAgentPlugin(d *schema.ResourceData, m interface{}) error {
+func createCloudBridgeAgentPlugin(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudBridgeAgentPluginResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OcbAgentSvcClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCloudBridgeAgentPlugin(d *schema.ResourceData, m interface{}) error {
+func readCloudBridgeAgentPlugin(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudBridgeAgentPluginResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OcbAgentSvcClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCloudBridgeAgentPlugin(d *schema.ResourceData, m interface{}) error {
+func updateCloudBridgeAgentPlugin(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudBridgeAgentPluginResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OcbAgentSvcClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCloudBridgeAgentPlugin(d *schema.ResourceData, m interface{}) error {
+func deleteCloudBridgeAgentPlugin(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -153,7 +154,7 @@ func (s *CloudBridgeAgentPluginResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CloudBridgeAgentPluginResourceCrud) Create() error {
+func (s *CloudBridgeAgentPluginResourceCrud) Create(ctx context.Context) error {
 	request := oci_cloud_bridge.UpdatePluginRequest{}
 
 	if agentId, ok := s.D.GetOkExists("agent_id"); ok {
@@ -172,7 +173,7 @@ func (s *CloudBridgeAgentPluginResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
-	response, err := s.Client.UpdatePlugin(context.Background(), request)
+	response, err := s.Client.UpdatePlugin(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -181,7 +182,7 @@ func (s *CloudBridgeAgentPluginResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CloudBridgeAgentPluginResourceCrud) Get() error {
+func (s *CloudBridgeAgentPluginResourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_bridge.GetPluginRequest{}
 
 	if agentId, ok := s.D.GetOkExists("agent_id"); ok {
@@ -204,7 +205,7 @@ func (s *CloudBridgeAgentPluginResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
-	response, err := s.Client.GetPlugin(context.Background(), request)
+	response, err := s.Client.GetPlugin(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -213,7 +214,7 @@ func (s *CloudBridgeAgentPluginResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CloudBridgeAgentPluginResourceCrud) Update() error {
+func (s *CloudBridgeAgentPluginResourceCrud) Update(ctx context.Context) error {
 	request := oci_cloud_bridge.UpdatePluginRequest{}
 
 	if agentId, ok := s.D.GetOkExists("agent_id"); ok {
@@ -232,7 +233,7 @@ func (s *CloudBridgeAgentPluginResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
-	response, err := s.Client.UpdatePlugin(context.Background(), request)
+	response, err := s.Client.UpdatePlugin(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package cloud_bridge
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_bridge "github.com/oracle/oci-go-sdk/v65/cloudbridge"
 
@@ -15,7 +16,7 @@ import (
 
 func CloudBridgeAgentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCloudBridgeAgents,
+		ReadContext: readCloudBridgeAgents,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"agent_id": {
@@ -56,12 +57,12 @@ func CloudBridgeAgentsDataSource() *schema.Resource {
 	}
 }
 
-func readCloudBridgeAgents(d *schema.ResourceData, m interface{}) error {
+func readCloudBridgeAgents(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudBridgeAgentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OcbAgentSvcClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudBridgeAgentsDataSourceCrud struct {
@@ -74,7 +75,7 @@ func (s *CloudBridgeAgentsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudBridgeAgentsDataSourceCrud) Get() error {
+func (s *CloudBridgeAgentsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_bridge.ListAgentsRequest{}
 
 	if agentId, ok := s.D.GetOkExists("id"); ok {
@@ -103,7 +104,7 @@ func (s *CloudBridgeAgentsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_bridge")
 
-	response, err := s.Client.ListAgents(context.Background(), request)
+	response, err := s.Client.ListAgents(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +113,7 @@ func (s *CloudBridgeAgentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAgents(context.Background(), request)
+		listResponse, err := s.Client.ListAgents(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package cloud_bridge
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_bridge "github.com/oracle/oci-go-sdk/v65/cloudbridge"
 
@@ -15,7 +16,7 @@ import (
 
 func CloudBridgeApplianceImageDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCloudBridgeApplianceImage,
+		ReadContext: readSingularCloudBridgeApplianceImage,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -97,12 +98,12 @@ func CloudBridgeApplianceImageDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCloudBridgeApplianceImage(d *schema.ResourceData, m interface{}) error {
+func readSingularCloudBridgeApplianceImage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudBridgeApplianceImageDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OcbAgentSvcClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudBridgeApplianceImageDataSourceCrud struct {
@@ -115,7 +116,7 @@ func (s *CloudBridgeApplianceImageDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudBridgeApplianceImageDataSourceCrud) Get() error {
+func (s *CloudBridgeApplianceImageDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_bridge.ListApplianceImagesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -130,7 +131,7 @@ func (s *CloudBridgeApplianceImageDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_bridge")
 
-	response, err := s.Client.ListApplianceImages(context.Background(), request)
+	response, err := s.Client.ListApplianceImages(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package cloud_bridge
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_bridge "github.com/oracle/oci-go-sdk/v65/cloudbridge"
 
@@ -15,7 +16,7 @@ import (
 
 func CloudBridgeApplianceImagesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCloudBridgeApplianceImages,
+		ReadContext: readCloudBridgeApplianceImages,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -105,12 +106,12 @@ func CloudBridgeApplianceImagesDataSource() *schema.Resource {
 	}
 }
 
-func readCloudBridgeApplianceImages(d *schema.ResourceData, m interface{}) error {
+func readCloudBridgeApplianceImages(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudBridgeApplianceImagesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OcbAgentSvcClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudBridgeApplianceImagesDataSourceCrud struct {
@@ -123,7 +124,7 @@ func (s *CloudBridgeApplianceImagesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudBridgeApplianceImagesDataSourceCrud) Get() error {
+func (s *CloudBridgeApplianceImagesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_bridge.ListApplianceImagesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -138,7 +139,7 @@ func (s *CloudBridgeApplianceImagesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_bridge")
 
-	response, err := s.Client.ListApplianceImages(context.Background(), request)
+	response, err := s.Client.ListApplianceImages(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -147,7 +148,7 @@ func (s *CloudBridgeApplianceImagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListApplianceImages(context.Background(), request)
+		listResponse, err := s.Client.ListApplianceImages(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -24,11 +25,11 @@ func CloudBridgeAssetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCloudBridgeAsset,
-		Read:     readCloudBridgeAsset,
-		Update:   updateCloudBridgeAsset,
-		Delete:   deleteCloudBridgeAsset,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCloudBridgeAsset,
+		ReadContext:   readCloudBridgeAsset,
+		UpdateContext: updateCloudBridgeAsset,
+		DeleteContext: deleteCloudBridgeAsset,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"asset_type": {
@@ -627,37 +628,37 @@ func CloudBridgeAssetResource() *schema.Resource {
 	}
 }
 
-func createCloudBridgeAsset(d *schema.ResourceData, m interface{}) error {
+func createCloudBridgeAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudBridgeAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).InventoryClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCloudBridgeAsset(d *schema.ResourceData, m interface{}) error {
+func readCloudBridgeAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudBridgeAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).InventoryClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCloudBridgeAsset(d *schema.ResourceData, m interface{}) error {
+func updateCloudBridgeAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudBridgeAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).InventoryClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCloudBridgeAsset(d *schema.ResourceData, m interface{}) error {
+func deleteCloudBridgeAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudBridgeAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).InventoryClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CloudBridgeAssetResourceCrud struct {
@@ -692,7 +693,7 @@ func (s *CloudBridgeAssetResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CloudBridgeAssetResourceCrud) Create() error {
+func (s *CloudBridgeAssetResourceCrud) Create(ctx context.Context) error {
 	request := oci_cloud_bridge.CreateAssetRequest{}
 	err := s.populateTopLevelPolymorphicCreateAssetRequest(&request)
 	if err != nil {
@@ -701,7 +702,7 @@ func (s *CloudBridgeAssetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
-	response, err := s.Client.CreateAsset(context.Background(), request)
+	response, err := s.Client.CreateAsset(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -710,7 +711,7 @@ func (s *CloudBridgeAssetResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CloudBridgeAssetResourceCrud) Get() error {
+func (s *CloudBridgeAssetResourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_bridge.GetAssetRequest{}
 
 	tmp := s.D.Id()
@@ -718,7 +719,7 @@ func (s *CloudBridgeAssetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
-	response, err := s.Client.GetAsset(context.Background(), request)
+	response, err := s.Client.GetAsset(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -727,11 +728,11 @@ func (s *CloudBridgeAssetResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CloudBridgeAssetResourceCrud) Update() error {
+func (s *CloudBridgeAssetResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -745,7 +746,7 @@ func (s *CloudBridgeAssetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
-	_, err = s.Client.UpdateAsset(context.Background(), request)
+	_, err = s.Client.UpdateAsset(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -764,7 +765,7 @@ func (s *CloudBridgeAssetResourceCrud) Update() error {
 	if freeformTags, ok := s.D.GetOkExists("freeform_tags"); ok {
 		changeAssetTagsRequest.ChangeAssetTagsDetails.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
 	}
-	changeAssetTagsResponse, err := s.Client.ChangeAssetTags(context.Background(), changeAssetTagsRequest)
+	changeAssetTagsResponse, err := s.Client.ChangeAssetTags(ctx, changeAssetTagsRequest)
 	if err != nil {
 		return err
 	}
@@ -773,7 +774,7 @@ func (s *CloudBridgeAssetResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CloudBridgeAssetResourceCrud) Delete() error {
+func (s *CloudBridgeAssetResourceCrud) Delete(ctx context.Context) error {
 	request := oci_cloud_bridge.DeleteAssetRequest{}
 
 	tmp := s.D.Id()
@@ -781,7 +782,7 @@ func (s *CloudBridgeAssetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
-	_, err := s.Client.DeleteAsset(context.Background(), request)
+	_, err := s.Client.DeleteAsset(ctx, request)
 	return err
 }
 
@@ -2100,7 +2101,7 @@ func (s *CloudBridgeAssetResourceCrud) populateTopLevelPolymorphicUpdateAssetReq
 	return nil
 }
 
-func (s *CloudBridgeAssetResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CloudBridgeAssetResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_cloud_bridge.ChangeAssetCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -2111,12 +2112,12 @@ func (s *CloudBridgeAssetResourceCrud) updateCompartment(compartment interface{}
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
-	_, err := s.Client.ChangeAssetCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeAssetCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
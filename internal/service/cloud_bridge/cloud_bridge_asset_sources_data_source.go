@@ -6,6 +6,7 @@ package cloud_bridge
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_bridge "github.com/oracle/oci-go-sdk/v65/cloudbridge"
 
@@ -15,7 +16,7 @@ import (
 
 func CloudBridgeAssetSourcesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCloudBridgeAssetSources,
+		ReadContext: readCloudBridgeAssetSources,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"asset_source_id": {
@@ -52,12 +53,12 @@ func CloudBridgeAssetSourcesDataSource() *schema.Resource {
 	}
 }
 
-func readCloudBridgeAssetSources(d *schema.ResourceData, m interface{}) error {
+func readCloudBridgeAssetSources(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudBridgeAssetSourcesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DiscoveryClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudBridgeAssetSourcesDataSourceCrud struct {
@@ -70,7 +71,7 @@ func (s *CloudBridgeAssetSourcesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudBridgeAssetSourcesDataSourceCrud) Get() error {
+func (s *CloudBridgeAssetSourcesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_bridge.ListAssetSourcesRequest{}
 
 	if assetSourceId, ok := s.D.GetOkExists("id"); ok {
@@ -94,7 +95,7 @@ func (s *CloudBridgeAssetSourcesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_bridge")
 
-	response, err := s.Client.ListAssetSources(context.Background(), request)
+	response, err := s.Client.ListAssetSources(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *CloudBridgeAssetSourcesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAssetSources(context.Background(), request)
+		listResponse, err := s.Client.ListAssetSources(ctx, request)
 		if err != nil {
 			return err
 		}
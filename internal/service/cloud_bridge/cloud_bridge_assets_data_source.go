@@ -6,6 +6,7 @@ package cloud_bridge
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_bridge "github.com/oracle/oci-go-sdk/v65/cloudbridge"
 
@@ -15,7 +16,7 @@ import (
 
 func CloudBridgeAssetsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCloudBridgeAssets,
+		ReadContext: readCloudBridgeAssets,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"asset_id": {
@@ -68,12 +69,12 @@ func CloudBridgeAssetsDataSource() *schema.Resource {
 	}
 }
 
-func readCloudBridgeAssets(d *schema.ResourceData, m interface{}) error {
+func readCloudBridgeAssets(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudBridgeAssetsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).InventoryClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudBridgeAssetsDataSourceCrud struct {
@@ -86,7 +87,7 @@ func (s *CloudBridgeAssetsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudBridgeAssetsDataSourceCrud) Get() error {
+func (s *CloudBridgeAssetsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_bridge.ListAssetsRequest{}
 
 	if assetId, ok := s.D.GetOkExists("id"); ok {
@@ -129,7 +130,7 @@ func (s *CloudBridgeAssetsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_bridge")
 
-	response, err := s.Client.ListAssets(context.Background(), request)
+	response, err := s.Client.ListAssets(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -138,7 +139,7 @@ func (s *CloudBridgeAssetsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAssets(context.Background(), request)
+		listResponse, err := s.Client.ListAssets(ctx, request)
 		if err != nil {
 			return err
 		}
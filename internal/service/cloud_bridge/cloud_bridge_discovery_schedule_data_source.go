@@ -6,6 +6,7 @@ package cloud_bridge
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_bridge "github.com/oracle/oci-go-sdk/v65/cloudbridge"
 
@@ -22,12 +23,12 @@ func CloudBridgeDiscoveryScheduleDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CloudBridgeDiscoveryScheduleResource(), fieldMap, readSingularCloudBridgeDiscoverySchedule)
 }
 
-func readSingularCloudBridgeDiscoverySchedule(d *schema.ResourceData, m interface{}) error {
+func readSingularCloudBridgeDiscoverySchedule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudBridgeDiscoveryScheduleDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DiscoveryClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudBridgeDiscoveryScheduleDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *CloudBridgeDiscoveryScheduleDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudBridgeDiscoveryScheduleDataSourceCrud) Get() error {
+func (s *CloudBridgeDiscoveryScheduleDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_bridge.GetDiscoveryScheduleRequest{}
 
 	if discoveryScheduleId, ok := s.D.GetOkExists("discovery_schedule_id"); ok {
@@ -50,7 +51,7 @@ func (s *CloudBridgeDiscoveryScheduleDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_bridge")
 
-	response, err := s.Client.GetDiscoverySchedule(context.Background(), request)
+	response, err := s.Client.GetDiscoverySchedule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package cloud_bridge
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_cloud_bridge "github.com/oracle/oci-go-sdk/v65/cloudbridge"
@@ -19,11 +20,11 @@ func CloudBridgeDiscoveryScheduleResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCloudBridgeDiscoverySchedule,
-		Read:     readCloudBridgeDiscoverySchedule,
-		Update:   updateCloudBridgeDiscoverySchedule,
-		Delete:   deleteCloudBridgeDiscoverySchedule,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCloudBridgeDiscoverySchedule,
+		ReadContext:   readCloudBridgeDiscoverySchedule,
+		UpdateContext: updateCloudBridgeDiscoverySchedule,
+		DeleteContext: deleteCloudBridgeDiscoverySchedule,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -81,37 +82,37 @@ func CloudBridgeDiscoveryScheduleResource() *schema.Resource {
 	}
 }
 
-func createCloudBridgeDiscoverySchedule(d *schema.ResourceData, m interface{}) error {
+func createCloudBridgeDiscoverySchedule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudBridgeDiscoveryScheduleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DiscoveryClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCloudBridgeDiscoverySchedule(d *schema.ResourceData, m interface{}) error {
+func readCloudBridgeDiscoverySchedule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudBridgeDiscoveryScheduleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DiscoveryClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCloudBridgeDiscoverySchedule(d *schema.ResourceData, m interface{}) error {
+func updateCloudBridgeDiscoverySchedule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudBridgeDiscoveryScheduleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DiscoveryClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCloudBridgeDiscoverySchedule(d *schema.ResourceData, m interface{}) error {
+func deleteCloudBridgeDiscoverySchedule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudBridgeDiscoveryScheduleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DiscoveryClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CloudBridgeDiscoveryScheduleResourceCrud struct {
@@ -145,7 +146,7 @@ func (s *CloudBridgeDiscoveryScheduleResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CloudBridgeDiscoveryScheduleResourceCrud) Create() error {
+func (s *CloudBridgeDiscoveryScheduleResourceCrud) Create(ctx context.Context) error {
 	request := oci_cloud_bridge.CreateDiscoveryScheduleRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -177,7 +178,7 @@ func (s *CloudBridgeDiscoveryScheduleResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
-	response, err := s.Client.CreateDiscoverySchedule(context.Background(), request)
+	response, err := s.Client.CreateDiscoverySchedule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -186,7 +187,7 @@ func (s *CloudBridgeDiscoveryScheduleResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CloudBridgeDiscoveryScheduleResourceCrud) Get() error {
+func (s *CloudBridgeDiscoveryScheduleResourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_bridge.GetDiscoveryScheduleRequest{}
 
 	tmp := s.D.Id()
@@ -194,7 +195,7 @@ func (s *CloudBridgeDiscoveryScheduleResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
-	response, err := s.Client.GetDiscoverySchedule(context.Background(), request)
+	response, err := s.Client.GetDiscoverySchedule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -203,11 +204,11 @@ func (s *CloudBridgeDiscoveryScheduleResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CloudBridgeDiscoveryScheduleResourceCrud) Update() error {
+func (s *CloudBridgeDiscoveryScheduleResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -242,7 +243,7 @@ func (s *CloudBridgeDiscoveryScheduleResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
-	response, err := s.Client.UpdateDiscoverySchedule(context.Background(), request)
+	response, err := s.Client.UpdateDiscoverySchedule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -251,7 +252,7 @@ func (s *CloudBridgeDiscoveryScheduleResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CloudBridgeDiscoveryScheduleResourceCrud) Delete() error {
+func (s *CloudBridgeDiscoveryScheduleResourceCrud) Delete(ctx context.Context) error {
 	request := oci_cloud_bridge.DeleteDiscoveryScheduleRequest{}
 
 	tmp := s.D.Id()
@@ -259,7 +260,7 @@ func (s *CloudBridgeDiscoveryScheduleResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
-	_, err := s.Client.DeleteDiscoverySchedule(context.Background(), request)
+	_, err := s.Client.DeleteDiscoverySchedule(ctx, request)
 	return err
 }
 
@@ -347,7 +348,7 @@ func DiscoveryScheduleSummaryToMap(obj oci_cloud_bridge.DiscoveryScheduleSummary
 	return result
 }
 
-func (s *CloudBridgeDiscoveryScheduleResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CloudBridgeDiscoveryScheduleResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_cloud_bridge.ChangeDiscoveryScheduleCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -358,12 +359,12 @@ func (s *CloudBridgeDiscoveryScheduleResourceCrud) updateCompartment(compartment
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
-	_, err := s.Client.ChangeDiscoveryScheduleCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeDiscoveryScheduleCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
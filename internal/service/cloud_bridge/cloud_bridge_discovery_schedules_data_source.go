@@ -6,6 +6,7 @@ package cloud_bridge
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_bridge "github.com/oracle/oci-go-sdk/v65/cloudbridge"
 
@@ -15,7 +16,7 @@ import (
 
 func CloudBridgeDiscoverySchedulesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCloudBridgeDiscoverySchedules,
+		ReadContext: readCloudBridgeDiscoverySchedules,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -52,12 +53,12 @@ func CloudBridgeDiscoverySchedulesDataSource() *schema.Resource {
 	}
 }
 
-func readCloudBridgeDiscoverySchedules(d *schema.ResourceData, m interface{}) error {
+func readCloudBridgeDiscoverySchedules(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudBridgeDiscoverySchedulesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DiscoveryClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudBridgeDiscoverySchedulesDataSourceCrud struct {
@@ -70,7 +71,7 @@ func (s *CloudBridgeDiscoverySchedulesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudBridgeDiscoverySchedulesDataSourceCrud) Get() error {
+func (s *CloudBridgeDiscoverySchedulesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_bridge.ListDiscoverySchedulesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -94,7 +95,7 @@ func (s *CloudBridgeDiscoverySchedulesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_bridge")
 
-	response, err := s.Client.ListDiscoverySchedules(context.Background(), request)
+	response, err := s.Client.ListDiscoverySchedules(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *CloudBridgeDiscoverySchedulesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDiscoverySchedules(context.Background(), request)
+		listResponse, err := s.Client.ListDiscoverySchedules(ctx, request)
 		if err != nil {
 			return err
 		}
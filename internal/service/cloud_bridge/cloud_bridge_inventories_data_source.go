@@ -6,6 +6,7 @@ package cloud_bridge
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_bridge "github.com/oracle/oci-go-sdk/v65/cloudbridge"
 
@@ -15,7 +16,7 @@ import (
 
 func CloudBridgeInventoriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCloudBridgeInventories,
+		ReadContext: readCloudBridgeInventories,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -44,12 +45,12 @@ func CloudBridgeInventoriesDataSource() *schema.Resource {
 	}
 }
 
-func readCloudBridgeInventories(d *schema.ResourceData, m interface{}) error {
+func readCloudBridgeInventories(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudBridgeInventoriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).InventoryClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudBridgeInventoriesDataSourceCrud struct {
@@ -62,7 +63,7 @@ func (s *CloudBridgeInventoriesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudBridgeInventoriesDataSourceCrud) Get() error {
+func (s *CloudBridgeInventoriesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_bridge.ListInventoriesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -76,7 +77,7 @@ func (s *CloudBridgeInventoriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_bridge")
 
-	response, err := s.Client.ListInventories(context.Background(), request)
+	response, err := s.Client.ListInventories(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *CloudBridgeInventoriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListInventories(context.Background(), request)
+		listResponse, err := s.Client.ListInventories(ctx, request)
 		if err != nil {
 			return err
 		}
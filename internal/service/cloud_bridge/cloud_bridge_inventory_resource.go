@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -25,11 +26,11 @@ func CloudBridgeInventoryResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCloudBridgeInventory,
-		Read:     readCloudBridgeInventory,
-		Update:   updateCloudBridgeInventory,
-		Delete:   deleteCloudBridgeInventory,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCloudBridgeInventory,
+		ReadContext:   readCloudBridgeInventory,
+		UpdateContext: updateCloudBridgeInventory,
+		DeleteContext: deleteCloudBridgeInventory,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -83,41 +84,41 @@ func CloudBridgeInventoryResource() *schema.Resource {
 	}
 }
 
-func createCloudBridgeInventory(d *schema.ResourceData, m interface{}) error {
+func createCloudBridgeInventory(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudBridgeInventoryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).InventoryClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).CommonClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCloudBridgeInventory(d *schema.ResourceData, m interface{}) error {
+func readCloudBridgeInventory(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudBridgeInventoryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).InventoryClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).CommonClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCloudBridgeInventory(d *schema.ResourceData, m interface{}) error {
+func updateCloudBridgeInventory(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudBridgeInventoryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).InventoryClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).CommonClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCloudBridgeInventory(d *schema.ResourceData, m interface{}) error {
+func deleteCloudBridgeInventory(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudBridgeInventoryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).InventoryClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).CommonClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CloudBridgeInventoryResourceCrud struct {
@@ -156,7 +157,7 @@ func (s *CloudBridgeInventoryResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CloudBridgeInventoryResourceCrud) Create() error {
+func (s *CloudBridgeInventoryResourceCrud) Create(ctx context.Context) error {
 	request := oci_cloud_bridge.CreateInventoryRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -183,26 +184,26 @@ func (s *CloudBridgeInventoryResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
-	response, err := s.Client.CreateInventory(context.Background(), request)
+	response, err := s.Client.CreateInventory(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getInventoryFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge"), oci_cloud_bridge.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getInventoryFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge"), oci_cloud_bridge.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *CloudBridgeInventoryResourceCrud) getInventoryFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *CloudBridgeInventoryResourceCrud) getInventoryFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_cloud_bridge.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	inventoryId, err := inventoryWaitForWorkRequest(workId, "inventory",
+	inventoryId, err := inventoryWaitForWorkRequest(ctx, workId, "inventory",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.WorkRequestClient)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, inventoryId)
-		_, cancelErr := s.WorkRequestClient.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.WorkRequestClient.CancelWorkRequest(ctx,
 			oci_cloud_bridge.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -216,7 +217,7 @@ func (s *CloudBridgeInventoryResourceCrud) getInventoryFromWorkRequest(workId *s
 	}
 	s.D.SetId(*inventoryId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func inventoryWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -242,7 +243,7 @@ func inventoryWorkRequestShouldRetryFunc(timeout time.Duration) func(response oc
 	}
 }
 
-func inventoryWaitForWorkRequest(wId *string, entityType string, action oci_cloud_bridge.ActionTypeEnum,
+func inventoryWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_cloud_bridge.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_cloud_bridge.CommonClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "cloud_bridge")
 	retryPolicy.ShouldRetryOperation = inventoryWorkRequestShouldRetryFunc(timeout)
@@ -261,7 +262,7 @@ func inventoryWaitForWorkRequest(wId *string, entityType string, action oci_clou
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_cloud_bridge.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -290,14 +291,14 @@ func inventoryWaitForWorkRequest(wId *string, entityType string, action oci_clou
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_cloud_bridge.OperationStatusFailed || response.Status == oci_cloud_bridge.OperationStatusCanceled {
-		return nil, getErrorFromCloudBridgeInventoryWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromCloudBridgeInventoryWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromCloudBridgeInventoryWorkRequest(client *oci_cloud_bridge.CommonClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_cloud_bridge.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromCloudBridgeInventoryWorkRequest(ctx context.Context, client *oci_cloud_bridge.CommonClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_cloud_bridge.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_cloud_bridge.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -319,7 +320,7 @@ func getErrorFromCloudBridgeInventoryWorkRequest(client *oci_cloud_bridge.Common
 	return workRequestErr
 }
 
-func (s *CloudBridgeInventoryResourceCrud) Get() error {
+func (s *CloudBridgeInventoryResourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_bridge.GetInventoryRequest{}
 
 	tmp := s.D.Id()
@@ -327,7 +328,7 @@ func (s *CloudBridgeInventoryResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
-	response, err := s.Client.GetInventory(context.Background(), request)
+	response, err := s.Client.GetInventory(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -336,7 +337,7 @@ func (s *CloudBridgeInventoryResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CloudBridgeInventoryResourceCrud) Update() error {
+func (s *CloudBridgeInventoryResourceCrud) Update(ctx context.Context) error {
 	request := oci_cloud_bridge.UpdateInventoryRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -361,7 +362,7 @@ func (s *CloudBridgeInventoryResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
-	response, err := s.Client.UpdateInventory(context.Background(), request)
+	response, err := s.Client.UpdateInventory(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -370,7 +371,7 @@ func (s *CloudBridgeInventoryResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CloudBridgeInventoryResourceCrud) Delete() error {
+func (s *CloudBridgeInventoryResourceCrud) Delete(ctx context.Context) error {
 	request := oci_cloud_bridge.DeleteInventoryRequest{}
 
 	tmp := s.D.Id()
@@ -378,14 +379,14 @@ func (s *CloudBridgeInventoryResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_bridge")
 
-	response, err := s.Client.DeleteInventory(context.Background(), request)
+	response, err := s.Client.DeleteInventory(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := inventoryWaitForWorkRequest(workId, "inventory",
+	_, delWorkRequestErr := inventoryWaitForWorkRequest(ctx, workId, "inventory",
 		oci_cloud_bridge.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.WorkRequestClient)
 	return delWorkRequestErr
 }
@@ -12,6 +12,7 @@ import (
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v65/cloudguard"
@@ -21,11 +22,11 @@ import (
 
 func CloudGuardCloudGuardConfigurationResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCloudGuardCloudGuardConfiguration,
-		Read:     readCloudGuardCloudGuardConfiguration,
-		Update:   updateCloudGuardCloudGuardConfiguration,
-		Delete:   deleteCloudGuardCloudGuardConfiguration,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCloudGuardCloudGuardConfiguration,
+		ReadContext:   readCloudGuardCloudGuardConfiguration,
+		UpdateContext: updateCloudGuardCloudGuardConfiguration,
+		DeleteContext: deleteCloudGuardCloudGuardConfiguration,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -53,31 +54,31 @@ func CloudGuardCloudGuardConfigurationResource() *schema.Resource {
 	}
 }
 
-func createCloudGuardCloudGuardConfiguration(d *schema.ResourceData, m interface{}) error {
+func createCloudGuardCloudGuardConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardCloudGuardConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCloudGuardCloudGuardConfiguration(d *schema.ResourceData, m interface{}) error {
+func readCloudGuardCloudGuardConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardCloudGuardConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCloudGuardCloudGuardConfiguration(d *schema.ResourceData, m interface{}) error {
+func updateCloudGuardCloudGuardConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardCloudGuardConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCloudGuardCloudGuardConfiguration(d *schema.ResourceData, m interface{}) error {
+func deleteCloudGuardCloudGuardConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -92,7 +93,7 @@ func (s *CloudGuardCloudGuardConfigurationResourceCrud) ID() string {
 	return getCloudGuardConfigurationCompositeId()
 }
 
-func (s *CloudGuardCloudGuardConfigurationResourceCrud) Create() error {
+func (s *CloudGuardCloudGuardConfigurationResourceCrud) Create(ctx context.Context) error {
 	request := oci_cloud_guard.UpdateConfigurationRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -116,7 +117,7 @@ func (s *CloudGuardCloudGuardConfigurationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.UpdateConfiguration(context.Background(), request)
+	response, err := s.Client.UpdateConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -125,7 +126,7 @@ func (s *CloudGuardCloudGuardConfigurationResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CloudGuardCloudGuardConfigurationResourceCrud) Get() error {
+func (s *CloudGuardCloudGuardConfigurationResourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_guard.GetConfigurationRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -141,7 +142,7 @@ func (s *CloudGuardCloudGuardConfigurationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.GetConfiguration(context.Background(), request)
+	response, err := s.Client.GetConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -150,7 +151,7 @@ func (s *CloudGuardCloudGuardConfigurationResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CloudGuardCloudGuardConfigurationResourceCrud) Update() error {
+func (s *CloudGuardCloudGuardConfigurationResourceCrud) Update(ctx context.Context) error {
 	request := oci_cloud_guard.UpdateConfigurationRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -174,7 +175,7 @@ func (s *CloudGuardCloudGuardConfigurationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.UpdateConfiguration(context.Background(), request)
+	response, err := s.Client.UpdateConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
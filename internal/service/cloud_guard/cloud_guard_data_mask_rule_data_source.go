@@ -6,6 +6,7 @@ package cloud_guard
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v65/cloudguard"
 
@@ -22,12 +23,12 @@ func CloudGuardDataMaskRuleDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CloudGuardDataMaskRuleResource(), fieldMap, readSingularCloudGuardDataMaskRule)
 }
 
-func readSingularCloudGuardDataMaskRule(d *schema.ResourceData, m interface{}) error {
+func readSingularCloudGuardDataMaskRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardDataMaskRuleDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudGuardDataMaskRuleDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *CloudGuardDataMaskRuleDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudGuardDataMaskRuleDataSourceCrud) Get() error {
+func (s *CloudGuardDataMaskRuleDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_guard.GetDataMaskRuleRequest{}
 
 	if dataMaskRuleId, ok := s.D.GetOkExists("data_mask_rule_id"); ok {
@@ -50,7 +51,7 @@ func (s *CloudGuardDataMaskRuleDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_guard")
 
-	response, err := s.Client.GetDataMaskRule(context.Background(), request)
+	response, err := s.Client.GetDataMaskRule(ctx, request)
 	if err != nil {
 		return err
 	}
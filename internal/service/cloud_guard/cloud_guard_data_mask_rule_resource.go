@@ -9,6 +9,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -23,11 +24,11 @@ func CloudGuardDataMaskRuleResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCloudGuardDataMaskRule,
-		Read:     readCloudGuardDataMaskRule,
-		Update:   updateCloudGuardDataMaskRule,
-		Delete:   deleteCloudGuardDataMaskRule,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCloudGuardDataMaskRule,
+		ReadContext:   readCloudGuardDataMaskRule,
+		UpdateContext: updateCloudGuardDataMaskRule,
+		DeleteContext: deleteCloudGuardDataMaskRule,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -137,37 +138,37 @@ func CloudGuardDataMaskRuleResource() *schema.Resource {
 	}
 }
 
-func createCloudGuardDataMaskRule(d *schema.ResourceData, m interface{}) error {
+func createCloudGuardDataMaskRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardDataMaskRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCloudGuardDataMaskRule(d *schema.ResourceData, m interface{}) error {
+func readCloudGuardDataMaskRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardDataMaskRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCloudGuardDataMaskRule(d *schema.ResourceData, m interface{}) error {
+func updateCloudGuardDataMaskRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardDataMaskRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCloudGuardDataMaskRule(d *schema.ResourceData, m interface{}) error {
+func deleteCloudGuardDataMaskRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardDataMaskRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CloudGuardDataMaskRuleResourceCrud struct {
@@ -205,7 +206,7 @@ func (s *CloudGuardDataMaskRuleResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CloudGuardDataMaskRuleResourceCrud) Create() error {
+func (s *CloudGuardDataMaskRuleResourceCrud) Create(ctx context.Context) error {
 	request := oci_cloud_guard.CreateDataMaskRuleRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -274,7 +275,7 @@ func (s *CloudGuardDataMaskRuleResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.CreateDataMaskRule(context.Background(), request)
+	response, err := s.Client.CreateDataMaskRule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -283,7 +284,7 @@ func (s *CloudGuardDataMaskRuleResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CloudGuardDataMaskRuleResourceCrud) Get() error {
+func (s *CloudGuardDataMaskRuleResourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_guard.GetDataMaskRuleRequest{}
 
 	tmp := s.D.Id()
@@ -291,7 +292,7 @@ func (s *CloudGuardDataMaskRuleResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.GetDataMaskRule(context.Background(), request)
+	response, err := s.Client.GetDataMaskRule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -300,7 +301,7 @@ func (s *CloudGuardDataMaskRuleResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CloudGuardDataMaskRuleResourceCrud) Update() error {
+func (s *CloudGuardDataMaskRuleResourceCrud) Update(ctx context.Context) error {
 	request := oci_cloud_guard.UpdateDataMaskRuleRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -363,7 +364,7 @@ func (s *CloudGuardDataMaskRuleResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.UpdateDataMaskRule(context.Background(), request)
+	response, err := s.Client.UpdateDataMaskRule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -372,7 +373,7 @@ func (s *CloudGuardDataMaskRuleResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CloudGuardDataMaskRuleResourceCrud) Delete() error {
+func (s *CloudGuardDataMaskRuleResourceCrud) Delete(ctx context.Context) error {
 	request := oci_cloud_guard.DeleteDataMaskRuleRequest{}
 
 	tmp := s.D.Id()
@@ -380,7 +381,7 @@ func (s *CloudGuardDataMaskRuleResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	_, err := s.Client.DeleteDataMaskRule(context.Background(), request)
+	_, err := s.Client.DeleteDataMaskRule(ctx, request)
 	return err
 }
 
@@ -6,6 +6,7 @@ package cloud_guard
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v65/cloudguard"
 
@@ -15,7 +16,7 @@ import (
 
 func CloudGuardDataMaskRulesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCloudGuardDataMaskRules,
+		ReadContext: readCloudGuardDataMaskRules,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -68,12 +69,12 @@ func CloudGuardDataMaskRulesDataSource() *schema.Resource {
 	}
 }
 
-func readCloudGuardDataMaskRules(d *schema.ResourceData, m interface{}) error {
+func readCloudGuardDataMaskRules(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardDataMaskRulesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudGuardDataMaskRulesDataSourceCrud struct {
@@ -86,7 +87,7 @@ func (s *CloudGuardDataMaskRulesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudGuardDataMaskRulesDataSourceCrud) Get() error {
+func (s *CloudGuardDataMaskRulesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_guard.ListDataMaskRulesRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -128,7 +129,7 @@ func (s *CloudGuardDataMaskRulesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_guard")
 
-	response, err := s.Client.ListDataMaskRules(context.Background(), request)
+	response, err := s.Client.ListDataMaskRules(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -137,7 +138,7 @@ func (s *CloudGuardDataMaskRulesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDataMaskRules(context.Background(), request)
+		listResponse, err := s.Client.ListDataMaskRules(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package cloud_guard
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v65/cloudguard"
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -21,12 +22,12 @@ func CloudGuardDataSourceDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CloudGuardDataSourceResource(), fieldMap, readSingularCloudGuardDataSource)
 }
 
-func readSingularCloudGuardDataSource(d *schema.ResourceData, m interface{}) error {
+func readSingularCloudGuardDataSource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardDataSourceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudGuardDataSourceDataSourceCrud struct {
@@ -39,7 +40,7 @@ func (s *CloudGuardDataSourceDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudGuardDataSourceDataSourceCrud) Get() error {
+func (s *CloudGuardDataSourceDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_guard.GetDataSourceRequest{}
 
 	if dataSourceId, ok := s.D.GetOkExists("data_source_id"); ok {
@@ -49,7 +50,7 @@ func (s *CloudGuardDataSourceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_guard")
 
-	response, err := s.Client.GetDataSource(context.Background(), request)
+	response, err := s.Client.GetDataSource(ctx, request)
 	if err != nil {
 		return err
 	}
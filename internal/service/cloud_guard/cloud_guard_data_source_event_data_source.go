@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v65/cloudguard"
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -15,7 +16,7 @@ import (
 
 func CloudGuardDataSourceEventDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCloudGuardDataSourceEvent,
+		ReadContext: readSingularCloudGuardDataSourceEvent,
 		Schema: map[string]*schema.Schema{
 			"data_source_id": {
 				Type:     schema.TypeString,
@@ -100,12 +101,12 @@ func CloudGuardDataSourceEventDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCloudGuardDataSourceEvent(d *schema.ResourceData, m interface{}) error {
+func readSingularCloudGuardDataSourceEvent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardDataSourceEventDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudGuardDataSourceEventDataSourceCrud struct {
@@ -118,7 +119,7 @@ func (s *CloudGuardDataSourceEventDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudGuardDataSourceEventDataSourceCrud) Get() error {
+func (s *CloudGuardDataSourceEventDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_guard.ListDataSourceEventsRequest{}
 
 	if dataSourceId, ok := s.D.GetOkExists("data_source_id"); ok {
@@ -133,7 +134,7 @@ func (s *CloudGuardDataSourceEventDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_guard")
 
-	response, err := s.Client.ListDataSourceEvents(context.Background(), request)
+	response, err := s.Client.ListDataSourceEvents(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v65/cloudguard"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
@@ -15,7 +16,7 @@ import (
 
 func CloudGuardDataSourceEventsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCloudGuardDataSourceEvents,
+		ReadContext: readCloudGuardDataSourceEvents,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"data_source_id": {
@@ -108,12 +109,12 @@ func CloudGuardDataSourceEventsDataSource() *schema.Resource {
 	}
 }
 
-func readCloudGuardDataSourceEvents(d *schema.ResourceData, m interface{}) error {
+func readCloudGuardDataSourceEvents(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardDataSourceEventsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudGuardDataSourceEventsDataSourceCrud struct {
@@ -126,7 +127,7 @@ func (s *CloudGuardDataSourceEventsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudGuardDataSourceEventsDataSourceCrud) Get() error {
+func (s *CloudGuardDataSourceEventsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_guard.ListDataSourceEventsRequest{}
 
 	if dataSourceId, ok := s.D.GetOkExists("data_source_id"); ok {
@@ -141,7 +142,7 @@ func (s *CloudGuardDataSourceEventsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_guard")
 
-	response, err := s.Client.ListDataSourceEvents(context.Background(), request)
+	response, err := s.Client.ListDataSourceEvents(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -150,7 +151,7 @@ func (s *CloudGuardDataSourceEventsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDataSourceEvents(context.Background(), request)
+		listResponse, err := s.Client.ListDataSourceEvents(ctx, request)
 		if err != nil {
 			return err
 		}
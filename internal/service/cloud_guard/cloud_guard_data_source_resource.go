@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func CloudGuardDataSourceResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCloudGuardDataSource,
-		Read:     readCloudGuardDataSource,
-		Update:   updateCloudGuardDataSource,
-		Delete:   deleteCloudGuardDataSource,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCloudGuardDataSource,
+		ReadContext:   readCloudGuardDataSource,
+		UpdateContext: updateCloudGuardDataSource,
+		DeleteContext: deleteCloudGuardDataSource,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -252,37 +253,37 @@ func CloudGuardDataSourceResource() *schema.Resource {
 	}
 }
 
-func createCloudGuardDataSource(d *schema.ResourceData, m interface{}) error {
+func createCloudGuardDataSource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardDataSourceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCloudGuardDataSource(d *schema.ResourceData, m interface{}) error {
+func readCloudGuardDataSource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardDataSourceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCloudGuardDataSource(d *schema.ResourceData, m interface{}) error {
+func updateCloudGuardDataSource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardDataSourceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCloudGuardDataSource(d *schema.ResourceData, m interface{}) error {
+func deleteCloudGuardDataSource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardDataSourceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CloudGuardDataSourceResourceCrud struct {
@@ -320,7 +321,7 @@ func (s *CloudGuardDataSourceResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CloudGuardDataSourceResourceCrud) Create() error {
+func (s *CloudGuardDataSourceResourceCrud) Create(ctx context.Context) error {
 	request := oci_cloud_guard.CreateDataSourceRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -362,26 +363,26 @@ func (s *CloudGuardDataSourceResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.CreateDataSource(context.Background(), request)
+	response, err := s.Client.CreateDataSource(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDataSourceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard"), oci_cloud_guard.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getDataSourceFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard"), oci_cloud_guard.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *CloudGuardDataSourceResourceCrud) getDataSourceFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *CloudGuardDataSourceResourceCrud) getDataSourceFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_cloud_guard.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	dataSourceId, err := dataSourceWaitForWorkRequest(workId, "cloudGuardDataSource",
+	dataSourceId, err := dataSourceWaitForWorkRequest(ctx, workId, "cloudGuardDataSource",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, dataSourceId)
-		_, cancelErr := s.Client.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.Client.CancelWorkRequest(ctx,
 			oci_cloud_guard.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -395,7 +396,7 @@ func (s *CloudGuardDataSourceResourceCrud) getDataSourceFromWorkRequest(workId *
 	}
 	s.D.SetId(*dataSourceId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func dataSourceWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -421,7 +422,7 @@ func dataSourceWorkRequestShouldRetryFunc(timeout time.Duration) func(response o
 	}
 }
 
-func dataSourceWaitForWorkRequest(wId *string, entityType string, action oci_cloud_guard.ActionTypeEnum,
+func dataSourceWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_cloud_guard.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_cloud_guard.CloudGuardClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "cloud_guard")
 	retryPolicy.ShouldRetryOperation = dataSourceWorkRequestShouldRetryFunc(timeout)
@@ -440,7 +441,7 @@ func dataSourceWaitForWorkRequest(wId *string, entityType string, action oci_clo
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_cloud_guard.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -465,14 +466,14 @@ func dataSourceWaitForWorkRequest(wId *string, entityType string, action oci_clo
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_cloud_guard.OperationStatusFailed || response.Status == oci_cloud_guard.OperationStatusCanceled {
-		return nil, getErrorFromCloudGuardDataSourceWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromCloudGuardDataSourceWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromCloudGuardDataSourceWorkRequest(client *oci_cloud_guard.CloudGuardClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_cloud_guard.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromCloudGuardDataSourceWorkRequest(ctx context.Context, client *oci_cloud_guard.CloudGuardClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_cloud_guard.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_cloud_guard.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -494,7 +495,7 @@ func getErrorFromCloudGuardDataSourceWorkRequest(client *oci_cloud_guard.CloudGu
 	return workRequestErr
 }
 
-func (s *CloudGuardDataSourceResourceCrud) Get() error {
+func (s *CloudGuardDataSourceResourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_guard.GetDataSourceRequest{}
 
 	tmp := s.D.Id()
@@ -502,7 +503,7 @@ func (s *CloudGuardDataSourceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.GetDataSource(context.Background(), request)
+	response, err := s.Client.GetDataSource(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -511,11 +512,11 @@ func (s *CloudGuardDataSourceResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CloudGuardDataSourceResourceCrud) Update() error {
+func (s *CloudGuardDataSourceResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -560,16 +561,16 @@ func (s *CloudGuardDataSourceResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.UpdateDataSource(context.Background(), request)
+	response, err := s.Client.UpdateDataSource(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDataSourceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard"), oci_cloud_guard.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getDataSourceFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard"), oci_cloud_guard.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *CloudGuardDataSourceResourceCrud) Delete() error {
+func (s *CloudGuardDataSourceResourceCrud) Delete(ctx context.Context) error {
 	request := oci_cloud_guard.DeleteDataSourceRequest{}
 
 	tmp := s.D.Id()
@@ -577,14 +578,14 @@ func (s *CloudGuardDataSourceResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.DeleteDataSource(context.Background(), request)
+	response, err := s.Client.DeleteDataSource(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := dataSourceWaitForWorkRequest(workId, "cloud_guard",
+	_, delWorkRequestErr := dataSourceWaitForWorkRequest(ctx, workId, "cloud_guard",
 		oci_cloud_guard.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -923,7 +924,7 @@ func RegionStatusDetailToMap(obj oci_cloud_guard.RegionStatusDetail) map[string]
 	return result
 }
 
-func (s *CloudGuardDataSourceResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CloudGuardDataSourceResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_cloud_guard.ChangeDataSourceCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -934,13 +935,13 @@ func (s *CloudGuardDataSourceResourceCrud) updateCompartment(compartment interfa
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.ChangeDataSourceCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeDataSourceCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDataSourceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard"), oci_cloud_guard.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getDataSourceFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard"), oci_cloud_guard.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *CloudGuardDataSourceResourceCrud) mapToLoggingQueryDetails(fieldKeyFormat string) (oci_cloud_guard.LoggingQueryDetails, error) {
@@ -6,6 +6,7 @@ package cloud_guard
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v65/cloudguard"
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -14,7 +15,7 @@ import (
 
 func CloudGuardDataSourcesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCloudGuardDataSources,
+		ReadContext: readCloudGuardDataSources,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -63,12 +64,12 @@ func CloudGuardDataSourcesDataSource() *schema.Resource {
 	}
 }
 
-func readCloudGuardDataSources(d *schema.ResourceData, m interface{}) error {
+func readCloudGuardDataSources(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardDataSourcesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudGuardDataSourcesDataSourceCrud struct {
@@ -81,7 +82,7 @@ func (s *CloudGuardDataSourcesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudGuardDataSourcesDataSourceCrud) Get() error {
+func (s *CloudGuardDataSourcesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_guard.ListDataSourcesRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -112,7 +113,7 @@ func (s *CloudGuardDataSourcesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_guard")
 
-	response, err := s.Client.ListDataSources(context.Background(), request)
+	response, err := s.Client.ListDataSources(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -121,7 +122,7 @@ func (s *CloudGuardDataSourcesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDataSources(context.Background(), request)
+		listResponse, err := s.Client.ListDataSources(ctx, request)
 		if err != nil {
 			return err
 		}
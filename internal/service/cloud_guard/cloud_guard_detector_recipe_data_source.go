@@ -6,6 +6,7 @@ package cloud_guard
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v65/cloudguard"
 
@@ -22,12 +23,12 @@ func CloudGuardDetectorRecipeDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CloudGuardDetectorRecipeResource(), fieldMap, readSingularCloudGuardDetectorRecipe)
 }
 
-func readSingularCloudGuardDetectorRecipe(d *schema.ResourceData, m interface{}) error {
+func readSingularCloudGuardDetectorRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardDetectorRecipeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudGuardDetectorRecipeDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *CloudGuardDetectorRecipeDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudGuardDetectorRecipeDataSourceCrud) Get() error {
+func (s *CloudGuardDetectorRecipeDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_guard.GetDetectorRecipeRequest{}
 
 	if detectorRecipeId, ok := s.D.GetOkExists("detector_recipe_id"); ok {
@@ -50,7 +51,7 @@ func (s *CloudGuardDetectorRecipeDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_guard")
 
-	response, err := s.Client.GetDetectorRecipe(context.Background(), request)
+	response, err := s.Client.GetDetectorRecipe(ctx, request)
 	if err != nil {
 		return err
 	}
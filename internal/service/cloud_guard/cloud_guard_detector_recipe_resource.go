@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v65/cloudguard"
@@ -21,11 +22,11 @@ func CloudGuardDetectorRecipeResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCloudGuardDetectorRecipe,
-		Read:     readCloudGuardDetectorRecipe,
-		Update:   updateCloudGuardDetectorRecipe,
-		Delete:   deleteCloudGuardDetectorRecipe,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCloudGuardDetectorRecipe,
+		ReadContext:   readCloudGuardDetectorRecipe,
+		UpdateContext: updateCloudGuardDetectorRecipe,
+		DeleteContext: deleteCloudGuardDetectorRecipe,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -571,37 +572,37 @@ func CloudGuardDetectorRecipeResource() *schema.Resource {
 	}
 }
 
-func createCloudGuardDetectorRecipe(d *schema.ResourceData, m interface{}) error {
+func createCloudGuardDetectorRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardDetectorRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCloudGuardDetectorRecipe(d *schema.ResourceData, m interface{}) error {
+func readCloudGuardDetectorRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardDetectorRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCloudGuardDetectorRecipe(d *schema.ResourceData, m interface{}) error {
+func updateCloudGuardDetectorRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardDetectorRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCloudGuardDetectorRecipe(d *schema.ResourceData, m interface{}) error {
+func deleteCloudGuardDetectorRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardDetectorRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CloudGuardDetectorRecipeResourceCrud struct {
@@ -639,7 +640,7 @@ func (s *CloudGuardDetectorRecipeResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CloudGuardDetectorRecipeResourceCrud) Create() error {
+func (s *CloudGuardDetectorRecipeResourceCrud) Create(ctx context.Context) error {
 	request := oci_cloud_guard.CreateDetectorRecipeRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -697,7 +698,7 @@ func (s *CloudGuardDetectorRecipeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.CreateDetectorRecipe(context.Background(), request)
+	response, err := s.Client.CreateDetectorRecipe(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -706,7 +707,7 @@ func (s *CloudGuardDetectorRecipeResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CloudGuardDetectorRecipeResourceCrud) Get() error {
+func (s *CloudGuardDetectorRecipeResourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_guard.GetDetectorRecipeRequest{}
 
 	tmp := s.D.Id()
@@ -714,7 +715,7 @@ func (s *CloudGuardDetectorRecipeResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.GetDetectorRecipe(context.Background(), request)
+	response, err := s.Client.GetDetectorRecipe(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -723,11 +724,11 @@ func (s *CloudGuardDetectorRecipeResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CloudGuardDetectorRecipeResourceCrud) Update() error {
+func (s *CloudGuardDetectorRecipeResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -779,7 +780,7 @@ func (s *CloudGuardDetectorRecipeResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.UpdateDetectorRecipe(context.Background(), request)
+	response, err := s.Client.UpdateDetectorRecipe(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -788,7 +789,7 @@ func (s *CloudGuardDetectorRecipeResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CloudGuardDetectorRecipeResourceCrud) Delete() error {
+func (s *CloudGuardDetectorRecipeResourceCrud) Delete(ctx context.Context) error {
 	request := oci_cloud_guard.DeleteDetectorRecipeRequest{}
 
 	tmp := s.D.Id()
@@ -796,7 +797,7 @@ func (s *CloudGuardDetectorRecipeResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	_, err := s.Client.DeleteDetectorRecipe(context.Background(), request)
+	_, err := s.Client.DeleteDetectorRecipe(ctx, request)
 	return err
 }
 
@@ -1200,7 +1201,7 @@ func (s *CloudGuardDetectorRecipeResourceCrud) mapToUpdateDetectorRuleDetails(fi
 	return result, nil
 }
 
-func (s *CloudGuardDetectorRecipeResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CloudGuardDetectorRecipeResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_cloud_guard.ChangeDetectorRecipeCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -1211,12 +1212,12 @@ func (s *CloudGuardDetectorRecipeResourceCrud) updateCompartment(compartment int
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	_, err := s.Client.ChangeDetectorRecipeCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeDetectorRecipeCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package cloud_guard
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v65/cloudguard"
 
@@ -22,12 +23,12 @@ func CloudGuardManagedListDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CloudGuardManagedListResource(), fieldMap, readSingularCloudGuardManagedList)
 }
 
-func readSingularCloudGuardManagedList(d *schema.ResourceData, m interface{}) error {
+func readSingularCloudGuardManagedList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardManagedListDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudGuardManagedListDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *CloudGuardManagedListDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudGuardManagedListDataSourceCrud) Get() error {
+func (s *CloudGuardManagedListDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_guard.GetManagedListRequest{}
 
 	if managedListId, ok := s.D.GetOkExists("managed_list_id"); ok {
@@ -50,7 +51,7 @@ func (s *CloudGuardManagedListDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_guard")
 
-	response, err := s.Client.GetManagedList(context.Background(), request)
+	response, err := s.Client.GetManagedList(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package cloud_guard
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v65/cloudguard"
@@ -19,11 +20,11 @@ func CloudGuardManagedListResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCloudGuardManagedList,
-		Read:     readCloudGuardManagedList,
-		Update:   updateCloudGuardManagedList,
-		Delete:   deleteCloudGuardManagedList,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCloudGuardManagedList,
+		ReadContext:   readCloudGuardManagedList,
+		UpdateContext: updateCloudGuardManagedList,
+		DeleteContext: deleteCloudGuardManagedList,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -109,37 +110,37 @@ func CloudGuardManagedListResource() *schema.Resource {
 	}
 }
 
-func createCloudGuardManagedList(d *schema.ResourceData, m interface{}) error {
+func createCloudGuardManagedList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardManagedListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCloudGuardManagedList(d *schema.ResourceData, m interface{}) error {
+func readCloudGuardManagedList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardManagedListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCloudGuardManagedList(d *schema.ResourceData, m interface{}) error {
+func updateCloudGuardManagedList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardManagedListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCloudGuardManagedList(d *schema.ResourceData, m interface{}) error {
+func deleteCloudGuardManagedList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardManagedListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CloudGuardManagedListResourceCrud struct {
@@ -177,7 +178,7 @@ func (s *CloudGuardManagedListResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CloudGuardManagedListResourceCrud) Create() error {
+func (s *CloudGuardManagedListResourceCrud) Create(ctx context.Context) error {
 	request := oci_cloud_guard.CreateManagedListRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -231,7 +232,7 @@ func (s *CloudGuardManagedListResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.CreateManagedList(context.Background(), request)
+	response, err := s.Client.CreateManagedList(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -240,7 +241,7 @@ func (s *CloudGuardManagedListResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CloudGuardManagedListResourceCrud) Get() error {
+func (s *CloudGuardManagedListResourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_guard.GetManagedListRequest{}
 
 	tmp := s.D.Id()
@@ -248,7 +249,7 @@ func (s *CloudGuardManagedListResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.GetManagedList(context.Background(), request)
+	response, err := s.Client.GetManagedList(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -257,11 +258,11 @@ func (s *CloudGuardManagedListResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CloudGuardManagedListResourceCrud) Update() error {
+func (s *CloudGuardManagedListResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -309,7 +310,7 @@ func (s *CloudGuardManagedListResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.UpdateManagedList(context.Background(), request)
+	response, err := s.Client.UpdateManagedList(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -318,7 +319,7 @@ func (s *CloudGuardManagedListResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CloudGuardManagedListResourceCrud) Delete() error {
+func (s *CloudGuardManagedListResourceCrud) Delete(ctx context.Context) error {
 	request := oci_cloud_guard.DeleteManagedListRequest{}
 
 	tmp := s.D.Id()
@@ -326,7 +327,7 @@ func (s *CloudGuardManagedListResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	_, err := s.Client.DeleteManagedList(context.Background(), request)
+	_, err := s.Client.DeleteManagedList(ctx, request)
 	return err
 }
 
@@ -444,7 +445,7 @@ func ManagedListSummaryToMap(obj oci_cloud_guard.ManagedListSummary) map[string]
 	return result
 }
 
-func (s *CloudGuardManagedListResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CloudGuardManagedListResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_cloud_guard.ChangeManagedListCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -455,12 +456,12 @@ func (s *CloudGuardManagedListResourceCrud) updateCompartment(compartment interf
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	_, err := s.Client.ChangeManagedListCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeManagedListCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
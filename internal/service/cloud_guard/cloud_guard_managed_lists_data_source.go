@@ -6,6 +6,7 @@ package cloud_guard
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v65/cloudguard"
 
@@ -15,7 +16,7 @@ import (
 
 func CloudGuardManagedListsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCloudGuardManagedLists,
+		ReadContext: readCloudGuardManagedLists,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -64,12 +65,12 @@ func CloudGuardManagedListsDataSource() *schema.Resource {
 	}
 }
 
-func readCloudGuardManagedLists(d *schema.ResourceData, m interface{}) error {
+func readCloudGuardManagedLists(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardManagedListsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudGuardManagedListsDataSourceCrud struct {
@@ -82,7 +83,7 @@ func (s *CloudGuardManagedListsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudGuardManagedListsDataSourceCrud) Get() error {
+func (s *CloudGuardManagedListsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_guard.ListManagedListsRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -119,7 +120,7 @@ func (s *CloudGuardManagedListsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_guard")
 
-	response, err := s.Client.ListManagedLists(context.Background(), request)
+	response, err := s.Client.ListManagedLists(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -128,7 +129,7 @@ func (s *CloudGuardManagedListsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListManagedLists(context.Background(), request)
+		listResponse, err := s.Client.ListManagedLists(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package cloud_guard
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v65/cloudguard"
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -14,7 +15,7 @@ import (
 
 func CloudGuardProblemEntitiesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCloudGuardProblemEntities,
+		ReadContext: readCloudGuardProblemEntities,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"problem_id": {
@@ -99,12 +100,12 @@ func CloudGuardProblemEntitiesDataSource() *schema.Resource {
 	}
 }
 
-func readCloudGuardProblemEntities(d *schema.ResourceData, m interface{}) error {
+func readCloudGuardProblemEntities(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardProblemEntitiesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudGuardProblemEntitiesDataSourceCrud struct {
@@ -117,7 +118,7 @@ func (s *CloudGuardProblemEntitiesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudGuardProblemEntitiesDataSourceCrud) Get() error {
+func (s *CloudGuardProblemEntitiesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_guard.ListProblemEntitiesRequest{}
 
 	if problemId, ok := s.D.GetOkExists("problem_id"); ok {
@@ -127,7 +128,7 @@ func (s *CloudGuardProblemEntitiesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_guard")
 
-	response, err := s.Client.ListProblemEntities(context.Background(), request)
+	response, err := s.Client.ListProblemEntities(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -136,7 +137,7 @@ func (s *CloudGuardProblemEntitiesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListProblemEntities(context.Background(), request)
+		listResponse, err := s.Client.ListProblemEntities(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package cloud_guard
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v65/cloudguard"
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -14,7 +15,7 @@ import (
 
 func CloudGuardProblemEntityDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCloudGuardProblemEntity,
+		ReadContext: readSingularCloudGuardProblemEntity,
 		Schema: map[string]*schema.Schema{
 			"problem_id": {
 				Type:     schema.TypeString,
@@ -86,12 +87,12 @@ func CloudGuardProblemEntityDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCloudGuardProblemEntity(d *schema.ResourceData, m interface{}) error {
+func readSingularCloudGuardProblemEntity(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardProblemEntityDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudGuardProblemEntityDataSourceCrud struct {
@@ -104,7 +105,7 @@ func (s *CloudGuardProblemEntityDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudGuardProblemEntityDataSourceCrud) Get() error {
+func (s *CloudGuardProblemEntityDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_guard.ListProblemEntitiesRequest{}
 
 	if problemId, ok := s.D.GetOkExists("problem_id"); ok {
@@ -114,7 +115,7 @@ func (s *CloudGuardProblemEntityDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_guard")
 
-	response, err := s.Client.ListProblemEntities(context.Background(), request)
+	response, err := s.Client.ListProblemEntities(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v65/cloudguard"
@@ -20,11 +21,11 @@ func CloudGuardResponderRecipeResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCloudGuardResponderRecipe,
-		Read:     readCloudGuardResponderRecipe,
-		Update:   updateCloudGuardResponderRecipe,
-		Delete:   deleteCloudGuardResponderRecipe,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCloudGuardResponderRecipe,
+		ReadContext:   readCloudGuardResponderRecipe,
+		UpdateContext: updateCloudGuardResponderRecipe,
+		DeleteContext: deleteCloudGuardResponderRecipe,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -322,37 +323,37 @@ func CloudGuardResponderRecipeResource() *schema.Resource {
 	}
 }
 
-func createCloudGuardResponderRecipe(d *schema.ResourceData, m interface{}) error {
+func createCloudGuardResponderRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardResponderRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCloudGuardResponderRecipe(d *schema.ResourceData, m interface{}) error {
+func readCloudGuardResponderRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardResponderRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCloudGuardResponderRecipe(d *schema.ResourceData, m interface{}) error {
+func updateCloudGuardResponderRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardResponderRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCloudGuardResponderRecipe(d *schema.ResourceData, m interface{}) error {
+func deleteCloudGuardResponderRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardResponderRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CloudGuardResponderRecipeResourceCrud struct {
@@ -390,7 +391,7 @@ func (s *CloudGuardResponderRecipeResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CloudGuardResponderRecipeResourceCrud) Create() error {
+func (s *CloudGuardResponderRecipeResourceCrud) Create(ctx context.Context) error {
 	request := oci_cloud_guard.CreateResponderRecipeRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -444,7 +445,7 @@ func (s *CloudGuardResponderRecipeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.CreateResponderRecipe(context.Background(), request)
+	response, err := s.Client.CreateResponderRecipe(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -453,7 +454,7 @@ func (s *CloudGuardResponderRecipeResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CloudGuardResponderRecipeResourceCrud) Get() error {
+func (s *CloudGuardResponderRecipeResourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_guard.GetResponderRecipeRequest{}
 
 	tmp := s.D.Id()
@@ -461,7 +462,7 @@ func (s *CloudGuardResponderRecipeResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.GetResponderRecipe(context.Background(), request)
+	response, err := s.Client.GetResponderRecipe(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -470,11 +471,11 @@ func (s *CloudGuardResponderRecipeResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CloudGuardResponderRecipeResourceCrud) Update() error {
+func (s *CloudGuardResponderRecipeResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -526,7 +527,7 @@ func (s *CloudGuardResponderRecipeResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.UpdateResponderRecipe(context.Background(), request)
+	response, err := s.Client.UpdateResponderRecipe(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -535,7 +536,7 @@ func (s *CloudGuardResponderRecipeResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CloudGuardResponderRecipeResourceCrud) Delete() error {
+func (s *CloudGuardResponderRecipeResourceCrud) Delete(ctx context.Context) error {
 	request := oci_cloud_guard.DeleteResponderRecipeRequest{}
 
 	tmp := s.D.Id()
@@ -543,7 +544,7 @@ func (s *CloudGuardResponderRecipeResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	_, err := s.Client.DeleteResponderRecipe(context.Background(), request)
+	_, err := s.Client.DeleteResponderRecipe(ctx, request)
 	return err
 }
 
@@ -785,7 +786,7 @@ func (s *CloudGuardResponderRecipeResourceCrud) mapToUpdateResponderRuleDetails(
 	return result, nil
 }
 
-func (s *CloudGuardResponderRecipeResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CloudGuardResponderRecipeResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_cloud_guard.ChangeResponderRecipeCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -796,12 +797,12 @@ func (s *CloudGuardResponderRecipeResourceCrud) updateCompartment(compartment in
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	_, err := s.Client.ChangeResponderRecipeCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeResponderRecipeCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
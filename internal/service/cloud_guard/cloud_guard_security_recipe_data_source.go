@@ -6,6 +6,7 @@ package cloud_guard
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v65/cloudguard"
 
@@ -22,12 +23,12 @@ func CloudGuardSecurityRecipeDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CloudGuardSecurityRecipeResource(), fieldMap, readSingularCloudGuardSecurityRecipe)
 }
 
-func readSingularCloudGuardSecurityRecipe(d *schema.ResourceData, m interface{}) error {
+func readSingularCloudGuardSecurityRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardSecurityRecipeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudGuardSecurityRecipeDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *CloudGuardSecurityRecipeDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudGuardSecurityRecipeDataSourceCrud) Get() error {
+func (s *CloudGuardSecurityRecipeDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_guard.GetSecurityRecipeRequest{}
 
 	if securityRecipeId, ok := s.D.GetOkExists("security_recipe_id"); ok {
@@ -50,7 +51,7 @@ func (s *CloudGuardSecurityRecipeDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_guard")
 
-	response, err := s.Client.GetSecurityRecipe(context.Background(), request)
+	response, err := s.Client.GetSecurityRecipe(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package cloud_guard
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v65/cloudguard"
@@ -19,11 +20,11 @@ func CloudGuardSecurityRecipeResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCloudGuardSecurityRecipe,
-		Read:     readCloudGuardSecurityRecipe,
-		Update:   updateCloudGuardSecurityRecipe,
-		Delete:   deleteCloudGuardSecurityRecipe,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCloudGuardSecurityRecipe,
+		ReadContext:   readCloudGuardSecurityRecipe,
+		UpdateContext: updateCloudGuardSecurityRecipe,
+		DeleteContext: deleteCloudGuardSecurityRecipe,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -92,37 +93,37 @@ func CloudGuardSecurityRecipeResource() *schema.Resource {
 	}
 }
 
-func createCloudGuardSecurityRecipe(d *schema.ResourceData, m interface{}) error {
+func createCloudGuardSecurityRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardSecurityRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCloudGuardSecurityRecipe(d *schema.ResourceData, m interface{}) error {
+func readCloudGuardSecurityRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardSecurityRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCloudGuardSecurityRecipe(d *schema.ResourceData, m interface{}) error {
+func updateCloudGuardSecurityRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardSecurityRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCloudGuardSecurityRecipe(d *schema.ResourceData, m interface{}) error {
+func deleteCloudGuardSecurityRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardSecurityRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CloudGuardSecurityRecipeResourceCrud struct {
@@ -160,7 +161,7 @@ func (s *CloudGuardSecurityRecipeResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CloudGuardSecurityRecipeResourceCrud) Create() error {
+func (s *CloudGuardSecurityRecipeResourceCrud) Create(ctx context.Context) error {
 	request := oci_cloud_guard.CreateSecurityRecipeRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -205,7 +206,7 @@ func (s *CloudGuardSecurityRecipeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.CreateSecurityRecipe(context.Background(), request)
+	response, err := s.Client.CreateSecurityRecipe(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -214,7 +215,7 @@ func (s *CloudGuardSecurityRecipeResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CloudGuardSecurityRecipeResourceCrud) Get() error {
+func (s *CloudGuardSecurityRecipeResourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_guard.GetSecurityRecipeRequest{}
 
 	tmp := s.D.Id()
@@ -222,7 +223,7 @@ func (s *CloudGuardSecurityRecipeResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.GetSecurityRecipe(context.Background(), request)
+	response, err := s.Client.GetSecurityRecipe(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -231,11 +232,11 @@ func (s *CloudGuardSecurityRecipeResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CloudGuardSecurityRecipeResourceCrud) Update() error {
+func (s *CloudGuardSecurityRecipeResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -283,7 +284,7 @@ func (s *CloudGuardSecurityRecipeResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.UpdateSecurityRecipe(context.Background(), request)
+	response, err := s.Client.UpdateSecurityRecipe(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -292,7 +293,7 @@ func (s *CloudGuardSecurityRecipeResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CloudGuardSecurityRecipeResourceCrud) Delete() error {
+func (s *CloudGuardSecurityRecipeResourceCrud) Delete(ctx context.Context) error {
 	request := oci_cloud_guard.DeleteSecurityRecipeRequest{}
 
 	tmp := s.D.Id()
@@ -300,7 +301,7 @@ func (s *CloudGuardSecurityRecipeResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	_, err := s.Client.DeleteSecurityRecipe(context.Background(), request)
+	_, err := s.Client.DeleteSecurityRecipe(ctx, request)
 	return err
 }
 
@@ -398,7 +399,7 @@ func SecurityRecipeSummaryToMap(obj oci_cloud_guard.SecurityRecipeSummary) map[s
 	return result
 }
 
-func (s *CloudGuardSecurityRecipeResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CloudGuardSecurityRecipeResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_cloud_guard.ChangeSecurityRecipeCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -409,12 +410,12 @@ func (s *CloudGuardSecurityRecipeResourceCrud) updateCompartment(compartment int
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	_, err := s.Client.ChangeSecurityRecipeCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeSecurityRecipeCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package cloud_guard
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v65/cloudguard"
 
@@ -22,12 +23,12 @@ func CloudGuardSecurityZoneDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CloudGuardSecurityZoneResource(), fieldMap, readSingularCloudGuardSecurityZone)
 }
 
-func readSingularCloudGuardSecurityZone(d *schema.ResourceData, m interface{}) error {
+func readSingularCloudGuardSecurityZone(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardSecurityZoneDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudGuardSecurityZoneDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *CloudGuardSecurityZoneDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudGuardSecurityZoneDataSourceCrud) Get() error {
+func (s *CloudGuardSecurityZoneDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_guard.GetSecurityZoneRequest{}
 
 	if securityZoneId, ok := s.D.GetOkExists("security_zone_id"); ok {
@@ -50,7 +51,7 @@ func (s *CloudGuardSecurityZoneDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_guard")
 
-	response, err := s.Client.GetSecurityZone(context.Background(), request)
+	response, err := s.Client.GetSecurityZone(ctx, request)
 	if err != nil {
 		return err
 	}
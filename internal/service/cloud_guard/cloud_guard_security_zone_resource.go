@@ -6,6 +6,7 @@ package cloud_guard
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v65/cloudguard"
@@ -19,11 +20,11 @@ func CloudGuardSecurityZoneResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCloudGuardSecurityZone,
-		Read:     readCloudGuardSecurityZone,
-		Update:   updateCloudGuardSecurityZone,
-		Delete:   deleteCloudGuardSecurityZone,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCloudGuardSecurityZone,
+		ReadContext:   readCloudGuardSecurityZone,
+		UpdateContext: updateCloudGuardSecurityZone,
+		DeleteContext: deleteCloudGuardSecurityZone,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -96,37 +97,37 @@ func CloudGuardSecurityZoneResource() *schema.Resource {
 	}
 }
 
-func createCloudGuardSecurityZone(d *schema.ResourceData, m interface{}) error {
+func createCloudGuardSecurityZone(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardSecurityZoneResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCloudGuardSecurityZone(d *schema.ResourceData, m interface{}) error {
+func readCloudGuardSecurityZone(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardSecurityZoneResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCloudGuardSecurityZone(d *schema.ResourceData, m interface{}) error {
+func updateCloudGuardSecurityZone(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardSecurityZoneResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCloudGuardSecurityZone(d *schema.ResourceData, m interface{}) error {
+func deleteCloudGuardSecurityZone(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardSecurityZoneResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CloudGuardSecurityZoneResourceCrud struct {
@@ -164,7 +165,7 @@ func (s *CloudGuardSecurityZoneResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CloudGuardSecurityZoneResourceCrud) Create() error {
+func (s *CloudGuardSecurityZoneResourceCrud) Create(ctx context.Context) error {
 	request := oci_cloud_guard.CreateSecurityZoneRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -201,7 +202,7 @@ func (s *CloudGuardSecurityZoneResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.CreateSecurityZone(context.Background(), request)
+	response, err := s.Client.CreateSecurityZone(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -210,7 +211,7 @@ func (s *CloudGuardSecurityZoneResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CloudGuardSecurityZoneResourceCrud) Get() error {
+func (s *CloudGuardSecurityZoneResourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_guard.GetSecurityZoneRequest{}
 
 	tmp := s.D.Id()
@@ -218,7 +219,7 @@ func (s *CloudGuardSecurityZoneResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.GetSecurityZone(context.Background(), request)
+	response, err := s.Client.GetSecurityZone(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -227,11 +228,11 @@ func (s *CloudGuardSecurityZoneResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CloudGuardSecurityZoneResourceCrud) Update() error {
+func (s *CloudGuardSecurityZoneResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -271,7 +272,7 @@ func (s *CloudGuardSecurityZoneResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.UpdateSecurityZone(context.Background(), request)
+	response, err := s.Client.UpdateSecurityZone(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -280,7 +281,7 @@ func (s *CloudGuardSecurityZoneResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CloudGuardSecurityZoneResourceCrud) Delete() error {
+func (s *CloudGuardSecurityZoneResourceCrud) Delete(ctx context.Context) error {
 	request := oci_cloud_guard.DeleteSecurityZoneRequest{}
 
 	tmp := s.D.Id()
@@ -288,7 +289,7 @@ func (s *CloudGuardSecurityZoneResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	_, err := s.Client.DeleteSecurityZone(context.Background(), request)
+	_, err := s.Client.DeleteSecurityZone(ctx, request)
 	return err
 }
 
@@ -392,7 +393,7 @@ func SecurityZoneSummaryToMap(obj oci_cloud_guard.SecurityZoneSummary) map[strin
 	return result
 }
 
-func (s *CloudGuardSecurityZoneResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CloudGuardSecurityZoneResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_cloud_guard.ChangeSecurityZoneCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -403,12 +404,12 @@ func (s *CloudGuardSecurityZoneResourceCrud) updateCompartment(compartment inter
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	_, err := s.Client.ChangeSecurityZoneCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeSecurityZoneCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
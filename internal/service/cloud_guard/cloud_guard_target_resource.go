@@ -10,6 +10,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v65/cloudguard"
@@ -24,11 +25,11 @@ func CloudGuardTargetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCloudGuardTarget,
-		Read:     readCloudGuardTarget,
-		Update:   updateCloudGuardTarget,
-		Delete:   deleteCloudGuardTarget,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCloudGuardTarget,
+		ReadContext:   readCloudGuardTarget,
+		UpdateContext: updateCloudGuardTarget,
+		DeleteContext: deleteCloudGuardTarget,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -946,37 +947,37 @@ func CloudGuardTargetResource() *schema.Resource {
 	}
 }
 
-func createCloudGuardTarget(d *schema.ResourceData, m interface{}) error {
+func createCloudGuardTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCloudGuardTarget(d *schema.ResourceData, m interface{}) error {
+func readCloudGuardTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCloudGuardTarget(d *schema.ResourceData, m interface{}) error {
+func updateCloudGuardTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCloudGuardTarget(d *schema.ResourceData, m interface{}) error {
+func deleteCloudGuardTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CloudGuardTargetResourceCrud struct {
@@ -1015,7 +1016,7 @@ func (s *CloudGuardTargetResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CloudGuardTargetResourceCrud) Create() error {
+func (s *CloudGuardTargetResourceCrud) Create(ctx context.Context) error {
 	request := oci_cloud_guard.CreateTargetRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -1094,7 +1095,7 @@ func (s *CloudGuardTargetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.CreateTarget(context.Background(), request)
+	response, err := s.Client.CreateTarget(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -1103,7 +1104,7 @@ func (s *CloudGuardTargetResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CloudGuardTargetResourceCrud) Get() error {
+func (s *CloudGuardTargetResourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_guard.GetTargetRequest{}
 
 	tmp := s.D.Id()
@@ -1111,7 +1112,7 @@ func (s *CloudGuardTargetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.GetTarget(context.Background(), request)
+	response, err := s.Client.GetTarget(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -1120,7 +1121,7 @@ func (s *CloudGuardTargetResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CloudGuardTargetResourceCrud) Update() error {
+func (s *CloudGuardTargetResourceCrud) Update(ctx context.Context) error {
 	request := oci_cloud_guard.UpdateTargetRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -1183,7 +1184,7 @@ func (s *CloudGuardTargetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	response, err := s.Client.UpdateTarget(context.Background(), request)
+	response, err := s.Client.UpdateTarget(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -1192,7 +1193,7 @@ func (s *CloudGuardTargetResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CloudGuardTargetResourceCrud) Delete() error {
+func (s *CloudGuardTargetResourceCrud) Delete(ctx context.Context) error {
 	request := oci_cloud_guard.DeleteTargetRequest{}
 
 	tmp := s.D.Id()
@@ -1200,7 +1201,7 @@ func (s *CloudGuardTargetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_guard")
 
-	_, err := s.Client.DeleteTarget(context.Background(), request)
+	_, err := s.Client.DeleteTarget(ctx, request)
 	return err
 }
 
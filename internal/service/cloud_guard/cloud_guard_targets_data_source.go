@@ -6,6 +6,7 @@ package cloud_guard
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_guard "github.com/oracle/oci-go-sdk/v65/cloudguard"
 
@@ -15,7 +16,7 @@ import (
 
 func CloudGuardTargetsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCloudGuardTargets,
+		ReadContext: readCloudGuardTargets,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -60,12 +61,12 @@ func CloudGuardTargetsDataSource() *schema.Resource {
 	}
 }
 
-func readCloudGuardTargets(d *schema.ResourceData, m interface{}) error {
+func readCloudGuardTargets(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudGuardTargetsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CloudGuardClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudGuardTargetsDataSourceCrud struct {
@@ -78,7 +79,7 @@ func (s *CloudGuardTargetsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudGuardTargetsDataSourceCrud) Get() error {
+func (s *CloudGuardTargetsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_guard.ListTargetsRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -111,7 +112,7 @@ func (s *CloudGuardTargetsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_guard")
 
-	response, err := s.Client.ListTargets(context.Background(), request)
+	response, err := s.Client.ListTargets(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -120,7 +121,7 @@ func (s *CloudGuardTargetsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListTargets(context.Background(), request)
+		listResponse, err := s.Client.ListTargets(ctx, request)
 		if err != nil {
 			return err
 		}
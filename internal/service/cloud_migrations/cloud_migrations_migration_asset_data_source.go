@@ -6,6 +6,7 @@ package cloud_migrations
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_migrations "github.com/oracle/oci-go-sdk/v65/cloudmigrations"
 
@@ -22,12 +23,12 @@ func CloudMigrationsMigrationAssetDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CloudMigrationsMigrationAssetResource(), fieldMap, readSingularCloudMigrationsMigrationAsset)
 }
 
-func readSingularCloudMigrationsMigrationAsset(d *schema.ResourceData, m interface{}) error {
+func readSingularCloudMigrationsMigrationAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudMigrationsMigrationAssetDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudMigrationsMigrationAssetDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *CloudMigrationsMigrationAssetDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudMigrationsMigrationAssetDataSourceCrud) Get() error {
+func (s *CloudMigrationsMigrationAssetDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_migrations.GetMigrationAssetRequest{}
 
 	if migrationAssetId, ok := s.D.GetOkExists("migration_asset_id"); ok {
@@ -50,7 +51,7 @@ func (s *CloudMigrationsMigrationAssetDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_migrations")
 
-	response, err := s.Client.GetMigrationAsset(context.Background(), request)
+	response, err := s.Client.GetMigrationAsset(ctx, request)
 	if err != nil {
 		return err
 	}
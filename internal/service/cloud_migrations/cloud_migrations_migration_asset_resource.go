@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -25,11 +26,11 @@ func CloudMigrationsMigrationAssetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCloudMigrationsMigrationAsset,
-		Read:     readCloudMigrationsMigrationAsset,
-		Update:   updateCloudMigrationsMigrationAsset,
-		Delete:   deleteCloudMigrationsMigrationAsset,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCloudMigrationsMigrationAsset,
+		ReadContext:   readCloudMigrationsMigrationAsset,
+		UpdateContext: updateCloudMigrationsMigrationAsset,
+		DeleteContext: deleteCloudMigrationsMigrationAsset,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"availability_domain": {
@@ -139,37 +140,37 @@ func CloudMigrationsMigrationAssetResource() *schema.Resource {
 	}
 }
 
-func createCloudMigrationsMigrationAsset(d *schema.ResourceData, m interface{}) error {
+func createCloudMigrationsMigrationAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudMigrationsMigrationAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MigrationClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCloudMigrationsMigrationAsset(d *schema.ResourceData, m interface{}) error {
+func readCloudMigrationsMigrationAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudMigrationsMigrationAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCloudMigrationsMigrationAsset(d *schema.ResourceData, m interface{}) error {
+func updateCloudMigrationsMigrationAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudMigrationsMigrationAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MigrationClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCloudMigrationsMigrationAsset(d *schema.ResourceData, m interface{}) error {
+func deleteCloudMigrationsMigrationAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudMigrationsMigrationAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MigrationClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CloudMigrationsMigrationAssetResourceCrud struct {
@@ -208,7 +209,7 @@ func (s *CloudMigrationsMigrationAssetResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CloudMigrationsMigrationAssetResourceCrud) Create() error {
+func (s *CloudMigrationsMigrationAssetResourceCrud) Create(ctx context.Context) error {
 	request := oci_cloud_migrations.CreateMigrationAssetRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -261,26 +262,26 @@ func (s *CloudMigrationsMigrationAssetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
-	response, err := s.Client.CreateMigrationAsset(context.Background(), request)
+	response, err := s.Client.CreateMigrationAsset(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getMigrationAssetFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations"), oci_cloud_migrations.ActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getMigrationAssetFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations"), oci_cloud_migrations.ActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *CloudMigrationsMigrationAssetResourceCrud) getMigrationAssetFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *CloudMigrationsMigrationAssetResourceCrud) getMigrationAssetFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_cloud_migrations.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	migrationAssetId, err := migrationAssetWaitForWorkRequest(workId, "migrationAsset",
+	migrationAssetId, err := migrationAssetWaitForWorkRequest(ctx, workId, "migrationAsset",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, migrationAssetId)
-		_, cancelErr := s.Client.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.Client.CancelWorkRequest(ctx,
 			oci_cloud_migrations.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -294,7 +295,7 @@ func (s *CloudMigrationsMigrationAssetResourceCrud) getMigrationAssetFromWorkReq
 	}
 	s.D.SetId(*migrationAssetId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func migrationAssetWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -320,7 +321,7 @@ func migrationAssetWorkRequestShouldRetryFunc(timeout time.Duration) func(respon
 	}
 }
 
-func migrationAssetWaitForWorkRequest(wId *string, entityType string, action oci_cloud_migrations.ActionTypeEnum,
+func migrationAssetWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_cloud_migrations.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_cloud_migrations.MigrationClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "cloud_migrations")
 	retryPolicy.ShouldRetryOperation = migrationAssetWorkRequestShouldRetryFunc(timeout)
@@ -339,7 +340,7 @@ func migrationAssetWaitForWorkRequest(wId *string, entityType string, action oci
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_cloud_migrations.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -368,14 +369,14 @@ func migrationAssetWaitForWorkRequest(wId *string, entityType string, action oci
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_cloud_migrations.OperationStatusFailed || response.Status == oci_cloud_migrations.OperationStatusCanceled {
-		return nil, getErrorFromCloudMigrationsMigrationAssetWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromCloudMigrationsMigrationAssetWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromCloudMigrationsMigrationAssetWorkRequest(client *oci_cloud_migrations.MigrationClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_cloud_migrations.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromCloudMigrationsMigrationAssetWorkRequest(ctx context.Context, client *oci_cloud_migrations.MigrationClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_cloud_migrations.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_cloud_migrations.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -397,7 +398,7 @@ func getErrorFromCloudMigrationsMigrationAssetWorkRequest(client *oci_cloud_migr
 	return workRequestErr
 }
 
-func (s *CloudMigrationsMigrationAssetResourceCrud) Get() error {
+func (s *CloudMigrationsMigrationAssetResourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_migrations.GetMigrationAssetRequest{}
 
 	tmp := s.D.Id()
@@ -405,7 +406,7 @@ func (s *CloudMigrationsMigrationAssetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
-	response, err := s.Client.GetMigrationAsset(context.Background(), request)
+	response, err := s.Client.GetMigrationAsset(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -414,7 +415,7 @@ func (s *CloudMigrationsMigrationAssetResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CloudMigrationsMigrationAssetResourceCrud) Update() error {
+func (s *CloudMigrationsMigrationAssetResourceCrud) Update(ctx context.Context) error {
 	request := oci_cloud_migrations.UpdateMigrationAssetRequest{}
 
 	if dependsOn, ok := s.D.GetOkExists("migration_asset_depends_on"); ok {
@@ -445,7 +446,7 @@ func (s *CloudMigrationsMigrationAssetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
-	response, err := s.Client.UpdateMigrationAsset(context.Background(), request)
+	response, err := s.Client.UpdateMigrationAsset(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -454,7 +455,7 @@ func (s *CloudMigrationsMigrationAssetResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CloudMigrationsMigrationAssetResourceCrud) Delete() error {
+func (s *CloudMigrationsMigrationAssetResourceCrud) Delete(ctx context.Context) error {
 	request := oci_cloud_migrations.DeleteMigrationAssetRequest{}
 
 	tmp := s.D.Id()
@@ -462,14 +463,14 @@ func (s *CloudMigrationsMigrationAssetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
-	response, err := s.Client.DeleteMigrationAsset(context.Background(), request)
+	response, err := s.Client.DeleteMigrationAsset(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := migrationAssetWaitForWorkRequest(workId, "migrationAsset",
+	_, delWorkRequestErr := migrationAssetWaitForWorkRequest(ctx, workId, "migrationAsset",
 		oci_cloud_migrations.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -6,6 +6,7 @@ package cloud_migrations
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_migrations "github.com/oracle/oci-go-sdk/v65/cloudmigrations"
 
@@ -22,12 +23,12 @@ func CloudMigrationsMigrationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CloudMigrationsMigrationResource(), fieldMap, readSingularCloudMigrationsMigration)
 }
 
-func readSingularCloudMigrationsMigration(d *schema.ResourceData, m interface{}) error {
+func readSingularCloudMigrationsMigration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudMigrationsMigrationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudMigrationsMigrationDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *CloudMigrationsMigrationDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudMigrationsMigrationDataSourceCrud) Get() error {
+func (s *CloudMigrationsMigrationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_migrations.GetMigrationRequest{}
 
 	if migrationId, ok := s.D.GetOkExists("migration_id"); ok {
@@ -50,7 +51,7 @@ func (s *CloudMigrationsMigrationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_migrations")
 
-	response, err := s.Client.GetMigration(context.Background(), request)
+	response, err := s.Client.GetMigration(ctx, request)
 	if err != nil {
 		return err
 	}
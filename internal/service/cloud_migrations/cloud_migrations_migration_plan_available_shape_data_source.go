@@ -6,6 +6,7 @@ package cloud_migrations
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_migrations "github.com/oracle/oci-go-sdk/v65/cloudmigrations"
 
@@ -15,7 +16,7 @@ import (
 
 func CloudMigrationsMigrationPlanAvailableShapeDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCloudMigrationsMigrationPlanAvailableShape,
+		ReadContext: readSingularCloudMigrationsMigrationPlanAvailableShape,
 		Schema: map[string]*schema.Schema{
 			"availability_domain": {
 				Type:             schema.TypeString,
@@ -127,12 +128,12 @@ func CloudMigrationsMigrationPlanAvailableShapeDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCloudMigrationsMigrationPlanAvailableShape(d *schema.ResourceData, m interface{}) error {
+func readSingularCloudMigrationsMigrationPlanAvailableShape(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudMigrationsMigrationPlanAvailableShapeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudMigrationsMigrationPlanAvailableShapeDataSourceCrud struct {
@@ -145,7 +146,7 @@ func (s *CloudMigrationsMigrationPlanAvailableShapeDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudMigrationsMigrationPlanAvailableShapeDataSourceCrud) Get() error {
+func (s *CloudMigrationsMigrationPlanAvailableShapeDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_migrations.ListAvailableShapesRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -175,7 +176,7 @@ func (s *CloudMigrationsMigrationPlanAvailableShapeDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_migrations")
 
-	response, err := s.Client.ListAvailableShapes(context.Background(), request)
+	response, err := s.Client.ListAvailableShapes(ctx, request)
 	if err != nil {
 		return err
 	}
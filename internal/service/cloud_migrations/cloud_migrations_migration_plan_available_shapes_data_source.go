@@ -6,6 +6,7 @@ package cloud_migrations
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_migrations "github.com/oracle/oci-go-sdk/v65/cloudmigrations"
 
@@ -15,7 +16,7 @@ import (
 
 func CloudMigrationsMigrationPlanAvailableShapesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCloudMigrationsMigrationPlanAvailableShapes,
+		ReadContext: readCloudMigrationsMigrationPlanAvailableShapes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -129,12 +130,12 @@ func CloudMigrationsMigrationPlanAvailableShapesDataSource() *schema.Resource {
 	}
 }
 
-func readCloudMigrationsMigrationPlanAvailableShapes(d *schema.ResourceData, m interface{}) error {
+func readCloudMigrationsMigrationPlanAvailableShapes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudMigrationsMigrationPlanAvailableShapesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudMigrationsMigrationPlanAvailableShapesDataSourceCrud struct {
@@ -147,7 +148,7 @@ func (s *CloudMigrationsMigrationPlanAvailableShapesDataSourceCrud) VoidState()
 	s.D.SetId("")
 }
 
-func (s *CloudMigrationsMigrationPlanAvailableShapesDataSourceCrud) Get() error {
+func (s *CloudMigrationsMigrationPlanAvailableShapesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_migrations.ListAvailableShapesRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -177,7 +178,7 @@ func (s *CloudMigrationsMigrationPlanAvailableShapesDataSourceCrud) Get() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_migrations")
 
-	response, err := s.Client.ListAvailableShapes(context.Background(), request)
+	response, err := s.Client.ListAvailableShapes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -186,7 +187,7 @@ func (s *CloudMigrationsMigrationPlanAvailableShapesDataSourceCrud) Get() error
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAvailableShapes(context.Background(), request)
+		listResponse, err := s.Client.ListAvailableShapes(ctx, request)
 		if err != nil {
 			return err
 		}
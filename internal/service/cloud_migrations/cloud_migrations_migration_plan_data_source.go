@@ -6,6 +6,7 @@ package cloud_migrations
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_migrations "github.com/oracle/oci-go-sdk/v65/cloudmigrations"
 
@@ -22,12 +23,12 @@ func CloudMigrationsMigrationPlanDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CloudMigrationsMigrationPlanResource(), fieldMap, readSingularCloudMigrationsMigrationPlan)
 }
 
-func readSingularCloudMigrationsMigrationPlan(d *schema.ResourceData, m interface{}) error {
+func readSingularCloudMigrationsMigrationPlan(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudMigrationsMigrationPlanDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudMigrationsMigrationPlanDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *CloudMigrationsMigrationPlanDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudMigrationsMigrationPlanDataSourceCrud) Get() error {
+func (s *CloudMigrationsMigrationPlanDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_migrations.GetMigrationPlanRequest{}
 
 	if migrationPlanId, ok := s.D.GetOkExists("migration_plan_id"); ok {
@@ -50,7 +51,7 @@ func (s *CloudMigrationsMigrationPlanDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_migrations")
 
-	response, err := s.Client.GetMigrationPlan(context.Background(), request)
+	response, err := s.Client.GetMigrationPlan(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func CloudMigrationsMigrationPlanResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCloudMigrationsMigrationPlan,
-		Read:     readCloudMigrationsMigrationPlan,
-		Update:   updateCloudMigrationsMigrationPlan,
-		Delete:   deleteCloudMigrationsMigrationPlan,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCloudMigrationsMigrationPlan,
+		ReadContext:   readCloudMigrationsMigrationPlan,
+		UpdateContext: updateCloudMigrationsMigrationPlan,
+		DeleteContext: deleteCloudMigrationsMigrationPlan,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -391,37 +392,37 @@ func CloudMigrationsMigrationPlanResource() *schema.Resource {
 	}
 }
 
-func createCloudMigrationsMigrationPlan(d *schema.ResourceData, m interface{}) error {
+func createCloudMigrationsMigrationPlan(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudMigrationsMigrationPlanResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MigrationClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCloudMigrationsMigrationPlan(d *schema.ResourceData, m interface{}) error {
+func readCloudMigrationsMigrationPlan(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudMigrationsMigrationPlanResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCloudMigrationsMigrationPlan(d *schema.ResourceData, m interface{}) error {
+func updateCloudMigrationsMigrationPlan(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudMigrationsMigrationPlanResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MigrationClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCloudMigrationsMigrationPlan(d *schema.ResourceData, m interface{}) error {
+func deleteCloudMigrationsMigrationPlan(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudMigrationsMigrationPlanResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MigrationClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CloudMigrationsMigrationPlanResourceCrud struct {
@@ -460,7 +461,7 @@ func (s *CloudMigrationsMigrationPlanResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CloudMigrationsMigrationPlanResourceCrud) Create() error {
+func (s *CloudMigrationsMigrationPlanResourceCrud) Create(ctx context.Context) error {
 	request := oci_cloud_migrations.CreateMigrationPlanRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -531,26 +532,26 @@ func (s *CloudMigrationsMigrationPlanResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
-	response, err := s.Client.CreateMigrationPlan(context.Background(), request)
+	response, err := s.Client.CreateMigrationPlan(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getMigrationPlanFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations"), oci_cloud_migrations.ActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getMigrationPlanFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations"), oci_cloud_migrations.ActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *CloudMigrationsMigrationPlanResourceCrud) getMigrationPlanFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *CloudMigrationsMigrationPlanResourceCrud) getMigrationPlanFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_cloud_migrations.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	migrationPlanId, err := migrationPlanWaitForWorkRequest(workId, "migrationPlan",
+	migrationPlanId, err := migrationPlanWaitForWorkRequest(ctx, workId, "migrationPlan",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, migrationPlanId)
-		_, cancelErr := s.Client.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.Client.CancelWorkRequest(ctx,
 			oci_cloud_migrations.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -564,7 +565,7 @@ func (s *CloudMigrationsMigrationPlanResourceCrud) getMigrationPlanFromWorkReque
 	}
 	s.D.SetId(*migrationPlanId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func migrationPlanWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -590,7 +591,7 @@ func migrationPlanWorkRequestShouldRetryFunc(timeout time.Duration) func(respons
 	}
 }
 
-func migrationPlanWaitForWorkRequest(wId *string, entityType string, action oci_cloud_migrations.ActionTypeEnum,
+func migrationPlanWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_cloud_migrations.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_cloud_migrations.MigrationClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "cloud_migrations")
 	retryPolicy.ShouldRetryOperation = migrationPlanWorkRequestShouldRetryFunc(timeout)
@@ -609,7 +610,7 @@ func migrationPlanWaitForWorkRequest(wId *string, entityType string, action oci_
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_cloud_migrations.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -638,14 +639,14 @@ func migrationPlanWaitForWorkRequest(wId *string, entityType string, action oci_
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_cloud_migrations.OperationStatusFailed || response.Status == oci_cloud_migrations.OperationStatusCanceled {
-		return nil, getErrorFromCloudMigrationsMigrationPlanWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromCloudMigrationsMigrationPlanWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromCloudMigrationsMigrationPlanWorkRequest(client *oci_cloud_migrations.MigrationClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_cloud_migrations.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromCloudMigrationsMigrationPlanWorkRequest(ctx context.Context, client *oci_cloud_migrations.MigrationClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_cloud_migrations.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_cloud_migrations.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -667,7 +668,7 @@ func getErrorFromCloudMigrationsMigrationPlanWorkRequest(client *oci_cloud_migra
 	return workRequestErr
 }
 
-func (s *CloudMigrationsMigrationPlanResourceCrud) Get() error {
+func (s *CloudMigrationsMigrationPlanResourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_migrations.GetMigrationPlanRequest{}
 
 	tmp := s.D.Id()
@@ -675,7 +676,7 @@ func (s *CloudMigrationsMigrationPlanResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
-	response, err := s.Client.GetMigrationPlan(context.Background(), request)
+	response, err := s.Client.GetMigrationPlan(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -684,11 +685,11 @@ func (s *CloudMigrationsMigrationPlanResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CloudMigrationsMigrationPlanResourceCrud) Update() error {
+func (s *CloudMigrationsMigrationPlanResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -752,16 +753,16 @@ func (s *CloudMigrationsMigrationPlanResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
-	response, err := s.Client.UpdateMigrationPlan(context.Background(), request)
+	response, err := s.Client.UpdateMigrationPlan(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getMigrationPlanFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations"), oci_cloud_migrations.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getMigrationPlanFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations"), oci_cloud_migrations.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *CloudMigrationsMigrationPlanResourceCrud) Delete() error {
+func (s *CloudMigrationsMigrationPlanResourceCrud) Delete(ctx context.Context) error {
 	request := oci_cloud_migrations.DeleteMigrationPlanRequest{}
 
 	tmp := s.D.Id()
@@ -769,14 +770,14 @@ func (s *CloudMigrationsMigrationPlanResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
-	response, err := s.Client.DeleteMigrationPlan(context.Background(), request)
+	response, err := s.Client.DeleteMigrationPlan(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := migrationPlanWaitForWorkRequest(workId, "migrationPlan",
+	_, delWorkRequestErr := migrationPlanWaitForWorkRequest(ctx, workId, "migrationPlan",
 		oci_cloud_migrations.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -1324,7 +1325,7 @@ func VolumeCostEstimationToMap(obj oci_cloud_migrations.VolumeCostEstimation) ma
 	return result
 }
 
-func (s *CloudMigrationsMigrationPlanResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CloudMigrationsMigrationPlanResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_cloud_migrations.ChangeMigrationPlanCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -1335,11 +1336,11 @@ func (s *CloudMigrationsMigrationPlanResourceCrud) updateCompartment(compartment
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
-	response, err := s.Client.ChangeMigrationPlanCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeMigrationPlanCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getMigrationPlanFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations"), oci_cloud_migrations.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getMigrationPlanFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations"), oci_cloud_migrations.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -25,11 +26,11 @@ func CloudMigrationsMigrationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCloudMigrationsMigration,
-		Read:     readCloudMigrationsMigration,
-		Update:   updateCloudMigrationsMigration,
-		Delete:   deleteCloudMigrationsMigration,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCloudMigrationsMigration,
+		ReadContext:   readCloudMigrationsMigration,
+		UpdateContext: updateCloudMigrationsMigration,
+		DeleteContext: deleteCloudMigrationsMigration,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -92,37 +93,37 @@ func CloudMigrationsMigrationResource() *schema.Resource {
 	}
 }
 
-func createCloudMigrationsMigration(d *schema.ResourceData, m interface{}) error {
+func createCloudMigrationsMigration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudMigrationsMigrationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MigrationClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCloudMigrationsMigration(d *schema.ResourceData, m interface{}) error {
+func readCloudMigrationsMigration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudMigrationsMigrationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCloudMigrationsMigration(d *schema.ResourceData, m interface{}) error {
+func updateCloudMigrationsMigration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudMigrationsMigrationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MigrationClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCloudMigrationsMigration(d *schema.ResourceData, m interface{}) error {
+func deleteCloudMigrationsMigration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudMigrationsMigrationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MigrationClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CloudMigrationsMigrationResourceCrud struct {
@@ -161,7 +162,7 @@ func (s *CloudMigrationsMigrationResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CloudMigrationsMigrationResourceCrud) Create() error {
+func (s *CloudMigrationsMigrationResourceCrud) Create(ctx context.Context) error {
 	request := oci_cloud_migrations.CreateMigrationRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -198,7 +199,7 @@ func (s *CloudMigrationsMigrationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
-	response, err := s.Client.CreateMigration(context.Background(), request)
+	response, err := s.Client.CreateMigration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -207,17 +208,17 @@ func (s *CloudMigrationsMigrationResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CloudMigrationsMigrationResourceCrud) getMigrationFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *CloudMigrationsMigrationResourceCrud) getMigrationFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_cloud_migrations.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	migrationId, err := migrationWaitForWorkRequest(workId, "migration",
+	migrationId, err := migrationWaitForWorkRequest(ctx, workId, "migration",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, migrationId)
-		_, cancelErr := s.Client.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.Client.CancelWorkRequest(ctx,
 			oci_cloud_migrations.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -231,7 +232,7 @@ func (s *CloudMigrationsMigrationResourceCrud) getMigrationFromWorkRequest(workI
 	}
 	s.D.SetId(*migrationId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func migrationWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -257,7 +258,7 @@ func migrationWorkRequestShouldRetryFunc(timeout time.Duration) func(response oc
 	}
 }
 
-func migrationWaitForWorkRequest(wId *string, entityType string, action oci_cloud_migrations.ActionTypeEnum,
+func migrationWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_cloud_migrations.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_cloud_migrations.MigrationClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "cloud_migrations")
 	retryPolicy.ShouldRetryOperation = migrationWorkRequestShouldRetryFunc(timeout)
@@ -276,7 +277,7 @@ func migrationWaitForWorkRequest(wId *string, entityType string, action oci_clou
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_cloud_migrations.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -305,14 +306,14 @@ func migrationWaitForWorkRequest(wId *string, entityType string, action oci_clou
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_cloud_migrations.OperationStatusFailed || response.Status == oci_cloud_migrations.OperationStatusCanceled {
-		return nil, getErrorFromCloudMigrationsMigrationWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromCloudMigrationsMigrationWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromCloudMigrationsMigrationWorkRequest(client *oci_cloud_migrations.MigrationClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_cloud_migrations.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromCloudMigrationsMigrationWorkRequest(ctx context.Context, client *oci_cloud_migrations.MigrationClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_cloud_migrations.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_cloud_migrations.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -334,7 +335,7 @@ func getErrorFromCloudMigrationsMigrationWorkRequest(client *oci_cloud_migration
 	return workRequestErr
 }
 
-func (s *CloudMigrationsMigrationResourceCrud) Get() error {
+func (s *CloudMigrationsMigrationResourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_migrations.GetMigrationRequest{}
 
 	tmp := s.D.Id()
@@ -342,7 +343,7 @@ func (s *CloudMigrationsMigrationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
-	response, err := s.Client.GetMigration(context.Background(), request)
+	response, err := s.Client.GetMigration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -351,11 +352,11 @@ func (s *CloudMigrationsMigrationResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CloudMigrationsMigrationResourceCrud) Update() error {
+func (s *CloudMigrationsMigrationResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -395,7 +396,7 @@ func (s *CloudMigrationsMigrationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
-	response, err := s.Client.UpdateMigration(context.Background(), request)
+	response, err := s.Client.UpdateMigration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -404,7 +405,7 @@ func (s *CloudMigrationsMigrationResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CloudMigrationsMigrationResourceCrud) Delete() error {
+func (s *CloudMigrationsMigrationResourceCrud) Delete(ctx context.Context) error {
 	request := oci_cloud_migrations.DeleteMigrationRequest{}
 
 	tmp := s.D.Id()
@@ -412,14 +413,14 @@ func (s *CloudMigrationsMigrationResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
-	response, err := s.Client.DeleteMigration(context.Background(), request)
+	response, err := s.Client.DeleteMigration(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := migrationWaitForWorkRequest(workId, "migration",
+	_, delWorkRequestErr := migrationWaitForWorkRequest(ctx, workId, "migration",
 		oci_cloud_migrations.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 
 	return delWorkRequestErr
@@ -521,7 +522,7 @@ func MigrationSummaryToMap(obj oci_cloud_migrations.MigrationSummary) map[string
 	return result
 }
 
-func (s *CloudMigrationsMigrationResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CloudMigrationsMigrationResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_cloud_migrations.ChangeMigrationCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -532,11 +533,11 @@ func (s *CloudMigrationsMigrationResourceCrud) updateCompartment(compartment int
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
-	response, err := s.Client.ChangeMigrationCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeMigrationCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getMigrationFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations"), oci_cloud_migrations.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getMigrationFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations"), oci_cloud_migrations.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
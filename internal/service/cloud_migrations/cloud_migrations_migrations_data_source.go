@@ -6,6 +6,7 @@ package cloud_migrations
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_migrations "github.com/oracle/oci-go-sdk/v65/cloudmigrations"
 
@@ -15,7 +16,7 @@ import (
 
 func CloudMigrationsMigrationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCloudMigrationsMigrations,
+		ReadContext: readCloudMigrationsMigrations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -52,12 +53,12 @@ func CloudMigrationsMigrationsDataSource() *schema.Resource {
 	}
 }
 
-func readCloudMigrationsMigrations(d *schema.ResourceData, m interface{}) error {
+func readCloudMigrationsMigrations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudMigrationsMigrationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudMigrationsMigrationsDataSourceCrud struct {
@@ -70,7 +71,7 @@ func (s *CloudMigrationsMigrationsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudMigrationsMigrationsDataSourceCrud) Get() error {
+func (s *CloudMigrationsMigrationsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_migrations.ListMigrationsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -94,7 +95,7 @@ func (s *CloudMigrationsMigrationsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_migrations")
 
-	response, err := s.Client.ListMigrations(context.Background(), request)
+	response, err := s.Client.ListMigrations(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *CloudMigrationsMigrationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListMigrations(context.Background(), request)
+		listResponse, err := s.Client.ListMigrations(ctx, request)
 		if err != nil {
 			return err
 		}
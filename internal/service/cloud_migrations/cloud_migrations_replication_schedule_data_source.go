@@ -6,6 +6,7 @@ package cloud_migrations
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_migrations "github.com/oracle/oci-go-sdk/v65/cloudmigrations"
 
@@ -22,12 +23,12 @@ func CloudMigrationsReplicationScheduleDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CloudMigrationsReplicationScheduleResource(), fieldMap, readSingularCloudMigrationsReplicationSchedule)
 }
 
-func readSingularCloudMigrationsReplicationSchedule(d *schema.ResourceData, m interface{}) error {
+func readSingularCloudMigrationsReplicationSchedule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudMigrationsReplicationScheduleDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudMigrationsReplicationScheduleDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *CloudMigrationsReplicationScheduleDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudMigrationsReplicationScheduleDataSourceCrud) Get() error {
+func (s *CloudMigrationsReplicationScheduleDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_migrations.GetReplicationScheduleRequest{}
 
 	if replicationScheduleId, ok := s.D.GetOkExists("replication_schedule_id"); ok {
@@ -50,7 +51,7 @@ func (s *CloudMigrationsReplicationScheduleDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_migrations")
 
-	response, err := s.Client.GetReplicationSchedule(context.Background(), request)
+	response, err := s.Client.GetReplicationSchedule(ctx, request)
 	if err != nil {
 		return err
 	}
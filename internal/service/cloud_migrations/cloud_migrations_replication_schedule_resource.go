@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -25,11 +26,11 @@ func CloudMigrationsReplicationScheduleResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCloudMigrationsReplicationSchedule,
-		Read:     readCloudMigrationsReplicationSchedule,
-		Update:   updateCloudMigrationsReplicationSchedule,
-		Delete:   deleteCloudMigrationsReplicationSchedule,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCloudMigrationsReplicationSchedule,
+		ReadContext:   readCloudMigrationsReplicationSchedule,
+		UpdateContext: updateCloudMigrationsReplicationSchedule,
+		DeleteContext: deleteCloudMigrationsReplicationSchedule,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -86,37 +87,37 @@ func CloudMigrationsReplicationScheduleResource() *schema.Resource {
 	}
 }
 
-func createCloudMigrationsReplicationSchedule(d *schema.ResourceData, m interface{}) error {
+func createCloudMigrationsReplicationSchedule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudMigrationsReplicationScheduleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MigrationClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCloudMigrationsReplicationSchedule(d *schema.ResourceData, m interface{}) error {
+func readCloudMigrationsReplicationSchedule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudMigrationsReplicationScheduleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCloudMigrationsReplicationSchedule(d *schema.ResourceData, m interface{}) error {
+func updateCloudMigrationsReplicationSchedule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudMigrationsReplicationScheduleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MigrationClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCloudMigrationsReplicationSchedule(d *schema.ResourceData, m interface{}) error {
+func deleteCloudMigrationsReplicationSchedule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudMigrationsReplicationScheduleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MigrationClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CloudMigrationsReplicationScheduleResourceCrud struct {
@@ -155,7 +156,7 @@ func (s *CloudMigrationsReplicationScheduleResourceCrud) DeletedTarget() []strin
 	}
 }
 
-func (s *CloudMigrationsReplicationScheduleResourceCrud) Create() error {
+func (s *CloudMigrationsReplicationScheduleResourceCrud) Create(ctx context.Context) error {
 	request := oci_cloud_migrations.CreateReplicationScheduleRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -187,26 +188,26 @@ func (s *CloudMigrationsReplicationScheduleResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
-	response, err := s.Client.CreateReplicationSchedule(context.Background(), request)
+	response, err := s.Client.CreateReplicationSchedule(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getReplicationScheduleFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations"), oci_cloud_migrations.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getReplicationScheduleFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations"), oci_cloud_migrations.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *CloudMigrationsReplicationScheduleResourceCrud) getReplicationScheduleFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *CloudMigrationsReplicationScheduleResourceCrud) getReplicationScheduleFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_cloud_migrations.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	replicationScheduleId, err := replicationScheduleWaitForWorkRequest(workId, "replicationschedule",
+	replicationScheduleId, err := replicationScheduleWaitForWorkRequest(ctx, workId, "replicationschedule",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, replicationScheduleId)
-		_, cancelErr := s.Client.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.Client.CancelWorkRequest(ctx,
 			oci_cloud_migrations.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -220,7 +221,7 @@ func (s *CloudMigrationsReplicationScheduleResourceCrud) getReplicationScheduleF
 	}
 	s.D.SetId(*replicationScheduleId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func replicationScheduleWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -246,7 +247,7 @@ func replicationScheduleWorkRequestShouldRetryFunc(timeout time.Duration) func(r
 	}
 }
 
-func replicationScheduleWaitForWorkRequest(wId *string, entityType string, action oci_cloud_migrations.ActionTypeEnum,
+func replicationScheduleWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_cloud_migrations.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_cloud_migrations.MigrationClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "cloud_migrations")
 	retryPolicy.ShouldRetryOperation = replicationScheduleWorkRequestShouldRetryFunc(timeout)
@@ -265,7 +266,7 @@ func replicationScheduleWaitForWorkRequest(wId *string, entityType string, actio
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_cloud_migrations.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -294,14 +295,14 @@ func replicationScheduleWaitForWorkRequest(wId *string, entityType string, actio
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_cloud_migrations.OperationStatusFailed || response.Status == oci_cloud_migrations.OperationStatusCanceled {
-		return nil, getErrorFromCloudMigrationsReplicationScheduleWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromCloudMigrationsReplicationScheduleWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromCloudMigrationsReplicationScheduleWorkRequest(client *oci_cloud_migrations.MigrationClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_cloud_migrations.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromCloudMigrationsReplicationScheduleWorkRequest(ctx context.Context, client *oci_cloud_migrations.MigrationClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_cloud_migrations.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_cloud_migrations.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -323,7 +324,7 @@ func getErrorFromCloudMigrationsReplicationScheduleWorkRequest(client *oci_cloud
 	return workRequestErr
 }
 
-func (s *CloudMigrationsReplicationScheduleResourceCrud) Get() error {
+func (s *CloudMigrationsReplicationScheduleResourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_migrations.GetReplicationScheduleRequest{}
 
 	tmp := s.D.Id()
@@ -331,7 +332,7 @@ func (s *CloudMigrationsReplicationScheduleResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
-	response, err := s.Client.GetReplicationSchedule(context.Background(), request)
+	response, err := s.Client.GetReplicationSchedule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -340,11 +341,11 @@ func (s *CloudMigrationsReplicationScheduleResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CloudMigrationsReplicationScheduleResourceCrud) Update() error {
+func (s *CloudMigrationsReplicationScheduleResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -379,16 +380,16 @@ func (s *CloudMigrationsReplicationScheduleResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
-	response, err := s.Client.UpdateReplicationSchedule(context.Background(), request)
+	response, err := s.Client.UpdateReplicationSchedule(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getReplicationScheduleFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations"), oci_cloud_migrations.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getReplicationScheduleFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations"), oci_cloud_migrations.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *CloudMigrationsReplicationScheduleResourceCrud) Delete() error {
+func (s *CloudMigrationsReplicationScheduleResourceCrud) Delete(ctx context.Context) error {
 	request := oci_cloud_migrations.DeleteReplicationScheduleRequest{}
 
 	tmp := s.D.Id()
@@ -396,14 +397,14 @@ func (s *CloudMigrationsReplicationScheduleResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
-	response, err := s.Client.DeleteReplicationSchedule(context.Background(), request)
+	response, err := s.Client.DeleteReplicationSchedule(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := replicationScheduleWaitForWorkRequest(workId, "replicationschedule",
+	_, delWorkRequestErr := replicationScheduleWaitForWorkRequest(ctx, workId, "replicationschedule",
 		oci_cloud_migrations.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -496,7 +497,7 @@ func ReplicationScheduleSummaryToMap(obj oci_cloud_migrations.ReplicationSchedul
 	return result
 }
 
-func (s *CloudMigrationsReplicationScheduleResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CloudMigrationsReplicationScheduleResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_cloud_migrations.ChangeReplicationScheduleCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -507,11 +508,11 @@ func (s *CloudMigrationsReplicationScheduleResourceCrud) updateCompartment(compa
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
-	response, err := s.Client.ChangeReplicationScheduleCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeReplicationScheduleCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getReplicationScheduleFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations"), oci_cloud_migrations.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getReplicationScheduleFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations"), oci_cloud_migrations.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -6,6 +6,7 @@ package cloud_migrations
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_cloud_migrations "github.com/oracle/oci-go-sdk/v65/cloudmigrations"
 
@@ -15,7 +16,7 @@ import (
 
 func CloudMigrationsReplicationSchedulesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCloudMigrationsReplicationSchedules,
+		ReadContext: readCloudMigrationsReplicationSchedules,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -52,12 +53,12 @@ func CloudMigrationsReplicationSchedulesDataSource() *schema.Resource {
 	}
 }
 
-func readCloudMigrationsReplicationSchedules(d *schema.ResourceData, m interface{}) error {
+func readCloudMigrationsReplicationSchedules(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudMigrationsReplicationSchedulesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CloudMigrationsReplicationSchedulesDataSourceCrud struct {
@@ -70,7 +71,7 @@ func (s *CloudMigrationsReplicationSchedulesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CloudMigrationsReplicationSchedulesDataSourceCrud) Get() error {
+func (s *CloudMigrationsReplicationSchedulesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_migrations.ListReplicationSchedulesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -94,7 +95,7 @@ func (s *CloudMigrationsReplicationSchedulesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "cloud_migrations")
 
-	response, err := s.Client.ListReplicationSchedules(context.Background(), request)
+	response, err := s.Client.ListReplicationSchedules(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *CloudMigrationsReplicationSchedulesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListReplicationSchedules(context.Background(), request)
+		listResponse, err := s.Client.ListReplicationSchedules(ctx, request)
 		if err != nil {
 			return err
 		}
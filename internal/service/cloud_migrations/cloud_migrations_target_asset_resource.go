@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -27,11 +28,11 @@ func CloudMigrationsTargetAssetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCloudMigrationsTargetAsset,
-		Read:     readCloudMigrationsTargetAsset,
-		Update:   updateCloudMigrationsTargetAsset,
-		Delete:   deleteCloudMigrationsTargetAsset,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCloudMigrationsTargetAsset,
+		ReadContext:   readCloudMigrationsTargetAsset,
+		UpdateContext: updateCloudMigrationsTargetAsset,
+		DeleteContext: deleteCloudMigrationsTargetAsset,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"is_excluded_from_execution": {
@@ -1325,37 +1326,37 @@ func CloudMigrationsTargetAssetResource() *schema.Resource {
 	}
 }
 
-func createCloudMigrationsTargetAsset(d *schema.ResourceData, m interface{}) error {
+func createCloudMigrationsTargetAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudMigrationsTargetAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MigrationClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCloudMigrationsTargetAsset(d *schema.ResourceData, m interface{}) error {
+func readCloudMigrationsTargetAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudMigrationsTargetAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCloudMigrationsTargetAsset(d *schema.ResourceData, m interface{}) error {
+func updateCloudMigrationsTargetAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudMigrationsTargetAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MigrationClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCloudMigrationsTargetAsset(d *schema.ResourceData, m interface{}) error {
+func deleteCloudMigrationsTargetAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CloudMigrationsTargetAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MigrationClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CloudMigrationsTargetAssetResourceCrud struct {
@@ -1395,7 +1396,7 @@ func (s *CloudMigrationsTargetAssetResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CloudMigrationsTargetAssetResourceCrud) Create() error {
+func (s *CloudMigrationsTargetAssetResourceCrud) Create(ctx context.Context) error {
 	request := oci_cloud_migrations.CreateTargetAssetRequest{}
 	err := s.populateTopLevelPolymorphicCreateTargetAssetRequest(&request)
 	if err != nil {
@@ -1404,26 +1405,26 @@ func (s *CloudMigrationsTargetAssetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
-	response, err := s.Client.CreateTargetAsset(context.Background(), request)
+	response, err := s.Client.CreateTargetAsset(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getTargetAssetFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations"), oci_cloud_migrations.ActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getTargetAssetFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations"), oci_cloud_migrations.ActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *CloudMigrationsTargetAssetResourceCrud) getTargetAssetFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *CloudMigrationsTargetAssetResourceCrud) getTargetAssetFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_cloud_migrations.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	targetAssetId, err := targetAssetWaitForWorkRequest(workId, "targetasset",
+	targetAssetId, err := targetAssetWaitForWorkRequest(ctx, workId, "targetasset",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, targetAssetId)
-		_, cancelErr := s.Client.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.Client.CancelWorkRequest(ctx,
 			oci_cloud_migrations.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -1437,7 +1438,7 @@ func (s *CloudMigrationsTargetAssetResourceCrud) getTargetAssetFromWorkRequest(w
 	}
 	s.D.SetId(*targetAssetId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func targetAssetWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -1463,7 +1464,7 @@ func targetAssetWorkRequestShouldRetryFunc(timeout time.Duration) func(response
 	}
 }
 
-func targetAssetWaitForWorkRequest(wId *string, entityType string, action oci_cloud_migrations.ActionTypeEnum,
+func targetAssetWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_cloud_migrations.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_cloud_migrations.MigrationClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "cloud_migrations")
 	retryPolicy.ShouldRetryOperation = targetAssetWorkRequestShouldRetryFunc(timeout)
@@ -1482,7 +1483,7 @@ func targetAssetWaitForWorkRequest(wId *string, entityType string, action oci_cl
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_cloud_migrations.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -1511,14 +1512,14 @@ func targetAssetWaitForWorkRequest(wId *string, entityType string, action oci_cl
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_cloud_migrations.OperationStatusFailed || response.Status == oci_cloud_migrations.OperationStatusCanceled {
-		return nil, getErrorFromCloudMigrationsTargetAssetWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromCloudMigrationsTargetAssetWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromCloudMigrationsTargetAssetWorkRequest(client *oci_cloud_migrations.MigrationClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_cloud_migrations.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromCloudMigrationsTargetAssetWorkRequest(ctx context.Context, client *oci_cloud_migrations.MigrationClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_cloud_migrations.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_cloud_migrations.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -1540,7 +1541,7 @@ func getErrorFromCloudMigrationsTargetAssetWorkRequest(client *oci_cloud_migrati
 	return workRequestErr
 }
 
-func (s *CloudMigrationsTargetAssetResourceCrud) Get() error {
+func (s *CloudMigrationsTargetAssetResourceCrud) Get(ctx context.Context) error {
 	request := oci_cloud_migrations.GetTargetAssetRequest{}
 
 	tmp := s.D.Id()
@@ -1548,7 +1549,7 @@ func (s *CloudMigrationsTargetAssetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
-	response, err := s.Client.GetTargetAsset(context.Background(), request)
+	response, err := s.Client.GetTargetAsset(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -1557,7 +1558,7 @@ func (s *CloudMigrationsTargetAssetResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CloudMigrationsTargetAssetResourceCrud) Update() error {
+func (s *CloudMigrationsTargetAssetResourceCrud) Update(ctx context.Context) error {
 	request := oci_cloud_migrations.UpdateTargetAssetRequest{}
 	err := s.populateTopLevelPolymorphicUpdateTargetAssetRequest(&request)
 	if err != nil {
@@ -1566,16 +1567,16 @@ func (s *CloudMigrationsTargetAssetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
-	response, err := s.Client.UpdateTargetAsset(context.Background(), request)
+	response, err := s.Client.UpdateTargetAsset(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getTargetAssetFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations"), oci_cloud_migrations.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getTargetAssetFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations"), oci_cloud_migrations.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *CloudMigrationsTargetAssetResourceCrud) Delete() error {
+func (s *CloudMigrationsTargetAssetResourceCrud) Delete(ctx context.Context) error {
 	request := oci_cloud_migrations.DeleteTargetAssetRequest{}
 
 	tmp := s.D.Id()
@@ -1583,14 +1584,14 @@ func (s *CloudMigrationsTargetAssetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "cloud_migrations")
 
-	response, err := s.Client.DeleteTargetAsset(context.Background(), request)
+	response, err := s.Client.DeleteTargetAsset(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := targetAssetWaitForWorkRequest(workId, "targetasset",
+	_, delWorkRequestErr := targetAssetWaitForWorkRequest(ctx, workId, "targetasset",
 		oci_cloud_migrations.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
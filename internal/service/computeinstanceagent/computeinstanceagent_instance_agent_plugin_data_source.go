@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_computeinstanceagent "github.com/oracle/oci-go-sdk/v65/computeinstanceagent"
 )
 
 func ComputeinstanceagentInstanceAgentPluginDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularComputeinstanceagentInstanceAgentPlugin,
+		ReadContext: readSingularComputeinstanceagentInstanceAgentPlugin,
 		Schema: map[string]*schema.Schema{
 			"instanceagent_id": {
 				Type:     schema.TypeString,
@@ -50,12 +51,12 @@ func ComputeinstanceagentInstanceAgentPluginDataSource() *schema.Resource {
 	}
 }
 
-func readSingularComputeinstanceagentInstanceAgentPlugin(d *schema.ResourceData, m interface{}) error {
+func readSingularComputeinstanceagentInstanceAgentPlugin(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ComputeinstanceagentInstanceAgentPluginDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).PluginClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ComputeinstanceagentInstanceAgentPluginDataSourceCrud struct {
@@ -68,7 +69,7 @@ func (s *ComputeinstanceagentInstanceAgentPluginDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ComputeinstanceagentInstanceAgentPluginDataSourceCrud) Get() error {
+func (s *ComputeinstanceagentInstanceAgentPluginDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_computeinstanceagent.GetInstanceAgentPluginRequest{}
 
 	if instanceagentId, ok := s.D.GetOkExists("instanceagent_id"); ok {
@@ -88,7 +89,7 @@ func (s *ComputeinstanceagentInstanceAgentPluginDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "computeinstanceagent")
 
-	response, err := s.Client.GetInstanceAgentPlugin(context.Background(), request)
+	response, err := s.Client.GetInstanceAgentPlugin(ctx, request)
 	if err != nil {
 		return err
 	}
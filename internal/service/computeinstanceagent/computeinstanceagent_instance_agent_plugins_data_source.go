@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_computeinstanceagent "github.com/oracle/oci-go-sdk/v65/computeinstanceagent"
 )
 
 func ComputeinstanceagentInstanceAgentPluginsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readComputeinstanceagentInstanceAgentPlugins,
+		ReadContext: readComputeinstanceagentInstanceAgentPlugins,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"instanceagent_id": {
@@ -67,12 +68,12 @@ func ComputeinstanceagentInstanceAgentPluginsDataSource() *schema.Resource {
 	}
 }
 
-func readComputeinstanceagentInstanceAgentPlugins(d *schema.ResourceData, m interface{}) error {
+func readComputeinstanceagentInstanceAgentPlugins(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ComputeinstanceagentInstanceAgentPluginsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).PluginClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ComputeinstanceagentInstanceAgentPluginsDataSourceCrud struct {
@@ -85,7 +86,7 @@ func (s *ComputeinstanceagentInstanceAgentPluginsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ComputeinstanceagentInstanceAgentPluginsDataSourceCrud) Get() error {
+func (s *ComputeinstanceagentInstanceAgentPluginsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_computeinstanceagent.ListInstanceAgentPluginsRequest{}
 
 	if instanceagentId, ok := s.D.GetOkExists("instanceagent_id"); ok {
@@ -109,7 +110,7 @@ func (s *ComputeinstanceagentInstanceAgentPluginsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "computeinstanceagent")
 
-	response, err := s.Client.ListInstanceAgentPlugins(context.Background(), request)
+	response, err := s.Client.ListInstanceAgentPlugins(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_computeinstanceagent "github.com/oracle/oci-go-sdk/v65/computeinstanceagent"
 )
 
 func ComputeinstanceagentInstanceAvailablePluginsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readComputeinstanceagentInstanceAvailablePlugins,
+		ReadContext: readComputeinstanceagentInstanceAvailablePlugins,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"name": {
@@ -67,12 +68,12 @@ func ComputeinstanceagentInstanceAvailablePluginsDataSource() *schema.Resource {
 	}
 }
 
-func readComputeinstanceagentInstanceAvailablePlugins(d *schema.ResourceData, m interface{}) error {
+func readComputeinstanceagentInstanceAvailablePlugins(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ComputeinstanceagentInstanceAvailablePluginsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).PluginconfigClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ComputeinstanceagentInstanceAvailablePluginsDataSourceCrud struct {
@@ -85,7 +86,7 @@ func (s *ComputeinstanceagentInstanceAvailablePluginsDataSourceCrud) VoidState()
 	s.D.SetId("")
 }
 
-func (s *ComputeinstanceagentInstanceAvailablePluginsDataSourceCrud) Get() error {
+func (s *ComputeinstanceagentInstanceAvailablePluginsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_computeinstanceagent.ListInstanceagentAvailablePluginsRequest{}
 
 	if name, ok := s.D.GetOkExists("name"); ok {
@@ -110,7 +111,7 @@ func (s *ComputeinstanceagentInstanceAvailablePluginsDataSourceCrud) Get() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "computeinstanceagent")
 
-	response, err := s.Client.ListInstanceagentAvailablePlugins(context.Background(), request)
+	response, err := s.Client.ListInstanceagentAvailablePlugins(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_containerengine "github.com/oracle/oci-go-sdk/v65/containerengine"
 
@@ -17,7 +18,7 @@ import (
 
 func ContainerengineClusterKubeConfigDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularContainerengineClusterKubeConfig,
+		ReadContext: readSingularContainerengineClusterKubeConfig,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:     schema.TypeString,
@@ -44,12 +45,12 @@ func ContainerengineClusterKubeConfigDataSource() *schema.Resource {
 	}
 }
 
-func readSingularContainerengineClusterKubeConfig(d *schema.ResourceData, m interface{}) error {
+func readSingularContainerengineClusterKubeConfig(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ContainerengineClusterKubeConfigDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ContainerengineClusterKubeConfigDataSourceCrud struct {
@@ -62,7 +63,7 @@ func (s *ContainerengineClusterKubeConfigDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ContainerengineClusterKubeConfigDataSourceCrud) Get() error {
+func (s *ContainerengineClusterKubeConfigDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_containerengine.CreateKubeconfigRequest{}
 
 	if clusterId, ok := s.D.GetOkExists("cluster_id"); ok {
@@ -86,7 +87,7 @@ func (s *ContainerengineClusterKubeConfigDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "containerengine")
 
-	response, err := s.Client.CreateKubeconfig(context.Background(), request)
+	response, err := s.Client.CreateKubeconfig(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_containerengine "github.com/oracle/oci-go-sdk/v65/containerengine"
 )
 
 func ContainerengineClusterOptionDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularContainerengineClusterOption,
+		ReadContext: readSingularContainerengineClusterOption,
 		Schema: map[string]*schema.Schema{
 			"cluster_option_id": {
 				Type:     schema.TypeString,
@@ -54,12 +55,12 @@ func ContainerengineClusterOptionDataSource() *schema.Resource {
 	}
 }
 
-func readSingularContainerengineClusterOption(d *schema.ResourceData, m interface{}) error {
+func readSingularContainerengineClusterOption(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ContainerengineClusterOptionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ContainerengineClusterOptionDataSourceCrud struct {
@@ -72,7 +73,7 @@ func (s *ContainerengineClusterOptionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ContainerengineClusterOptionDataSourceCrud) Get() error {
+func (s *ContainerengineClusterOptionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_containerengine.GetClusterOptionsRequest{}
 
 	if clusterOptionId, ok := s.D.GetOkExists("cluster_option_id"); ok {
@@ -87,7 +88,7 @@ func (s *ContainerengineClusterOptionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "containerengine")
 
-	response, err := s.Client.GetClusterOptions(context.Background(), request)
+	response, err := s.Client.GetClusterOptions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_containerengine "github.com/oracle/oci-go-sdk/v65/containerengine"
 )
 
 func ContainerengineClustersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readContainerengineClusters,
+		ReadContext: readContainerengineClusters,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -42,12 +43,12 @@ func ContainerengineClustersDataSource() *schema.Resource {
 	}
 }
 
-func readContainerengineClusters(d *schema.ResourceData, m interface{}) error {
+func readContainerengineClusters(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ContainerengineClustersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ContainerengineClustersDataSourceCrud struct {
@@ -60,7 +61,7 @@ func (s *ContainerengineClustersDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ContainerengineClustersDataSourceCrud) Get() error {
+func (s *ContainerengineClustersDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_containerengine.ListClustersRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -83,7 +84,7 @@ func (s *ContainerengineClustersDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "containerengine")
 
-	response, err := s.Client.ListClusters(context.Background(), request)
+	response, err := s.Client.ListClusters(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -92,7 +93,7 @@ func (s *ContainerengineClustersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListClusters(context.Background(), request)
+		listResponse, err := s.Client.ListClusters(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_containerengine "github.com/oracle/oci-go-sdk/v65/containerengine"
 )
 
 func ContainerengineMigrateToNativeVcnStatusDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularContainerengineMigrateToNativeVcnStatus,
+		ReadContext: readSingularContainerengineMigrateToNativeVcnStatus,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:     schema.TypeString,
@@ -34,12 +35,12 @@ func ContainerengineMigrateToNativeVcnStatusDataSource() *schema.Resource {
 	}
 }
 
-func readSingularContainerengineMigrateToNativeVcnStatus(d *schema.ResourceData, m interface{}) error {
+func readSingularContainerengineMigrateToNativeVcnStatus(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ContainerengineMigrateToNativeVcnStatusDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ContainerengineMigrateToNativeVcnStatusDataSourceCrud struct {
@@ -52,7 +53,7 @@ func (s *ContainerengineMigrateToNativeVcnStatusDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ContainerengineMigrateToNativeVcnStatusDataSourceCrud) Get() error {
+func (s *ContainerengineMigrateToNativeVcnStatusDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_containerengine.GetClusterMigrateToNativeVcnStatusRequest{}
 
 	if clusterId, ok := s.D.GetOkExists("cluster_id"); ok {
@@ -62,7 +63,7 @@ func (s *ContainerengineMigrateToNativeVcnStatusDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "containerengine")
 
-	response, err := s.Client.GetClusterMigrateToNativeVcnStatus(context.Background(), request)
+	response, err := s.Client.GetClusterMigrateToNativeVcnStatus(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_containerengine "github.com/oracle/oci-go-sdk/v65/containerengine"
 )
 
 func ContainerengineNodePoolOptionDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularContainerengineNodePoolOption,
+		ReadContext: readSingularContainerengineNodePoolOption,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -76,12 +77,12 @@ func ContainerengineNodePoolOptionDataSource() *schema.Resource {
 	}
 }
 
-func readSingularContainerengineNodePoolOption(d *schema.ResourceData, m interface{}) error {
+func readSingularContainerengineNodePoolOption(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ContainerengineNodePoolOptionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ContainerengineNodePoolOptionDataSourceCrud struct {
@@ -94,7 +95,7 @@ func (s *ContainerengineNodePoolOptionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ContainerengineNodePoolOptionDataSourceCrud) Get() error {
+func (s *ContainerengineNodePoolOptionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_containerengine.GetNodePoolOptionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -109,7 +110,7 @@ func (s *ContainerengineNodePoolOptionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "containerengine")
 
-	response, err := s.Client.GetNodePoolOptions(context.Background(), request)
+	response, err := s.Client.GetNodePoolOptions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_containerengine "github.com/oracle/oci-go-sdk/v65/containerengine"
 )
 
 func ContainerengineWorkRequestErrorsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readContainerengineWorkRequestErrors,
+		ReadContext: readContainerengineWorkRequestErrors,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -55,12 +56,12 @@ func ContainerengineWorkRequestErrorsDataSource() *schema.Resource {
 	}
 }
 
-func readContainerengineWorkRequestErrors(d *schema.ResourceData, m interface{}) error {
+func readContainerengineWorkRequestErrors(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ContainerengineWorkRequestErrorsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ContainerengineWorkRequestErrorsDataSourceCrud struct {
@@ -73,7 +74,7 @@ func (s *ContainerengineWorkRequestErrorsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ContainerengineWorkRequestErrorsDataSourceCrud) Get() error {
+func (s *ContainerengineWorkRequestErrorsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_containerengine.ListWorkRequestErrorsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -88,7 +89,7 @@ func (s *ContainerengineWorkRequestErrorsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "containerengine")
 
-	response, err := s.Client.ListWorkRequestErrors(context.Background(), request)
+	response, err := s.Client.ListWorkRequestErrors(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_containerengine "github.com/oracle/oci-go-sdk/v65/containerengine"
 )
 
 func ContainerengineWorkRequestLogEntriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readContainerengineWorkRequestLogEntries,
+		ReadContext: readContainerengineWorkRequestLogEntries,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -51,12 +52,12 @@ func ContainerengineWorkRequestLogEntriesDataSource() *schema.Resource {
 	}
 }
 
-func readContainerengineWorkRequestLogEntries(d *schema.ResourceData, m interface{}) error {
+func readContainerengineWorkRequestLogEntries(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ContainerengineWorkRequestLogEntriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ContainerengineWorkRequestLogEntriesDataSourceCrud struct {
@@ -69,7 +70,7 @@ func (s *ContainerengineWorkRequestLogEntriesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ContainerengineWorkRequestLogEntriesDataSourceCrud) Get() error {
+func (s *ContainerengineWorkRequestLogEntriesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_containerengine.ListWorkRequestLogsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -84,7 +85,7 @@ func (s *ContainerengineWorkRequestLogEntriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "containerengine")
 
-	response, err := s.Client.ListWorkRequestLogs(context.Background(), request)
+	response, err := s.Client.ListWorkRequestLogs(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_containerengine "github.com/oracle/oci-go-sdk/v65/containerengine"
 )
 
 func ContainerengineWorkRequestsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readContainerengineWorkRequests,
+		ReadContext: readContainerengineWorkRequests,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"cluster_id": {
@@ -115,12 +116,12 @@ func ContainerengineWorkRequestsDataSource() *schema.Resource {
 	}
 }
 
-func readContainerengineWorkRequests(d *schema.ResourceData, m interface{}) error {
+func readContainerengineWorkRequests(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ContainerengineWorkRequestsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ContainerengineWorkRequestsDataSourceCrud struct {
@@ -133,7 +134,7 @@ func (s *ContainerengineWorkRequestsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ContainerengineWorkRequestsDataSourceCrud) Get() error {
+func (s *ContainerengineWorkRequestsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_containerengine.ListWorkRequestsRequest{}
 
 	if clusterId, ok := s.D.GetOkExists("cluster_id"); ok {
@@ -166,7 +167,7 @@ func (s *ContainerengineWorkRequestsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "containerengine")
 
-	response, err := s.Client.ListWorkRequests(context.Background(), request)
+	response, err := s.Client.ListWorkRequests(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -175,7 +176,7 @@ func (s *ContainerengineWorkRequestsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListWorkRequests(context.Background(), request)
+		listResponse, err := s.Client.ListWorkRequests(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 )
 
 func CoreAppCatalogListingDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreAppCatalogListing,
+		ReadContext: readSingularCoreAppCatalogListing,
 		Schema: map[string]*schema.Schema{
 			"listing_id": {
 				Type:     schema.TypeString,
@@ -54,12 +55,12 @@ func CoreAppCatalogListingDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreAppCatalogListing(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreAppCatalogListing(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreAppCatalogListingDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreAppCatalogListingDataSourceCrud struct {
@@ -72,7 +73,7 @@ func (s *CoreAppCatalogListingDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreAppCatalogListingDataSourceCrud) Get() error {
+func (s *CoreAppCatalogListingDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetAppCatalogListingRequest{}
 
 	if listingId, ok := s.D.GetOkExists("listing_id"); ok {
@@ -82,7 +83,7 @@ func (s *CoreAppCatalogListingDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetAppCatalogListing(context.Background(), request)
+	response, err := s.Client.GetAppCatalogListing(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -10,16 +10,17 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 )
 
 func AppCatalogListingResourceVersionAgreementResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createAppCatalogListingResourceVersionAgreement,
-		Read:     readAppCatalogListingResourceVersionAgreement,
-		Delete:   deleteAppCatalogListingResourceVersionAgreement,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createAppCatalogListingResourceVersionAgreement,
+		ReadContext:   readAppCatalogListingResourceVersionAgreement,
+		DeleteContext: deleteAppCatalogListingResourceVersionAgreement,
 		Schema: map[string]*schema.Schema{
 			"listing_id": {
 				Type:     schema.TypeString,
@@ -52,29 +53,29 @@ func AppCatalogListingResourceVersionAgreementResource() *schema.Resource {
 	}
 }
 
-func createAppCatalogListingResourceVersionAgreement(d *schema.ResourceData, m interface{}) error {
+func createAppCatalogListingResourceVersionAgreement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AppCatalogListingResourceVersionAgreementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readAppCatalogListingResourceVersionAgreement(d *schema.ResourceData, m interface{}) error {
+func readAppCatalogListingResourceVersionAgreement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AppCatalogListingResourceVersionAgreementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteAppCatalogListingResourceVersionAgreement(d *schema.ResourceData, m interface{}) error {
+func deleteAppCatalogListingResourceVersionAgreement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AppCatalogListingResourceVersionAgreementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type AppCatalogListingResourceVersionAgreementResourceCrud struct {
@@ -88,7 +89,7 @@ func (s *AppCatalogListingResourceVersionAgreementResourceCrud) ID() string {
 	return s.Res.TimeRetrieved.Format(time.RFC3339Nano)
 }
 
-func (s *AppCatalogListingResourceVersionAgreementResourceCrud) Create() error {
+func (s *AppCatalogListingResourceVersionAgreementResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.GetAppCatalogListingAgreementsRequest{}
 
 	if listingId, ok := s.D.GetOkExists("listing_id"); ok {
@@ -103,7 +104,7 @@ func (s *AppCatalogListingResourceVersionAgreementResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetAppCatalogListingAgreements(context.Background(), request)
+	response, err := s.Client.GetAppCatalogListingAgreements(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -112,11 +113,11 @@ func (s *AppCatalogListingResourceVersionAgreementResourceCrud) Create() error {
 	return nil
 }
 
-func (s *AppCatalogListingResourceVersionAgreementResourceCrud) Get() error {
+func (s *AppCatalogListingResourceVersionAgreementResourceCrud) Get(ctx context.Context) error {
 	return nil
 }
 
-func (s *AppCatalogListingResourceVersionAgreementResourceCrud) Delete() error {
+func (s *AppCatalogListingResourceVersionAgreementResourceCrud) Delete(ctx context.Context) error {
 	return nil
 }
 
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 )
 
 func CoreAppCatalogListingResourceVersionDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreAppCatalogListingResourceVersion,
+		ReadContext: readSingularCoreAppCatalogListingResourceVersion,
 		Schema: map[string]*schema.Schema{
 			"listing_id": {
 				Type:     schema.TypeString,
@@ -70,12 +71,12 @@ func CoreAppCatalogListingResourceVersionDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreAppCatalogListingResourceVersion(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreAppCatalogListingResourceVersion(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreAppCatalogListingResourceVersionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreAppCatalogListingResourceVersionDataSourceCrud struct {
@@ -88,7 +89,7 @@ func (s *CoreAppCatalogListingResourceVersionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreAppCatalogListingResourceVersionDataSourceCrud) Get() error {
+func (s *CoreAppCatalogListingResourceVersionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetAppCatalogListingResourceVersionRequest{}
 
 	if listingId, ok := s.D.GetOkExists("listing_id"); ok {
@@ -103,7 +104,7 @@ func (s *CoreAppCatalogListingResourceVersionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetAppCatalogListingResourceVersion(context.Background(), request)
+	response, err := s.Client.GetAppCatalogListingResourceVersion(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 )
 
 func CoreAppCatalogListingResourceVersionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreAppCatalogListingResourceVersions,
+		ReadContext: readCoreAppCatalogListingResourceVersions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"listing_id": {
@@ -83,12 +84,12 @@ func CoreAppCatalogListingResourceVersionsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreAppCatalogListingResourceVersions(d *schema.ResourceData, m interface{}) error {
+func readCoreAppCatalogListingResourceVersions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreAppCatalogListingResourceVersionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreAppCatalogListingResourceVersionsDataSourceCrud struct {
@@ -101,7 +102,7 @@ func (s *CoreAppCatalogListingResourceVersionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreAppCatalogListingResourceVersionsDataSourceCrud) Get() error {
+func (s *CoreAppCatalogListingResourceVersionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListAppCatalogListingResourceVersionsRequest{}
 
 	if listingId, ok := s.D.GetOkExists("listing_id"); ok {
@@ -111,7 +112,7 @@ func (s *CoreAppCatalogListingResourceVersionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListAppCatalogListingResourceVersions(context.Background(), request)
+	response, err := s.Client.ListAppCatalogListingResourceVersions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -120,7 +121,7 @@ func (s *CoreAppCatalogListingResourceVersionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAppCatalogListingResourceVersions(context.Background(), request)
+		listResponse, err := s.Client.ListAppCatalogListingResourceVersions(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 )
 
 func CoreAppCatalogListingsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreAppCatalogListings,
+		ReadContext: readCoreAppCatalogListings,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"display_name": {
@@ -79,12 +80,12 @@ func CoreAppCatalogListingsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreAppCatalogListings(d *schema.ResourceData, m interface{}) error {
+func readCoreAppCatalogListings(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreAppCatalogListingsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreAppCatalogListingsDataSourceCrud struct {
@@ -97,7 +98,7 @@ func (s *CoreAppCatalogListingsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreAppCatalogListingsDataSourceCrud) Get() error {
+func (s *CoreAppCatalogListingsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListAppCatalogListingsRequest{}
 
 	if displayName, ok := s.D.GetOkExists("display_name"); ok {
@@ -117,7 +118,7 @@ func (s *CoreAppCatalogListingsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListAppCatalogListings(context.Background(), request)
+	response, err := s.Client.ListAppCatalogListings(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -126,7 +127,7 @@ func (s *CoreAppCatalogListingsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAppCatalogListings(context.Background(), request)
+		listResponse, err := s.Client.ListAppCatalogListings(ctx, request)
 		if err != nil {
 			return err
 		}
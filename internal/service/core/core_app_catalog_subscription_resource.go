@@ -15,6 +15,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
@@ -26,10 +27,10 @@ func CoreAppCatalogSubscriptionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreAppCatalogSubscription,
-		Read:     readCoreAppCatalogSubscription,
-		Delete:   deleteCoreAppCatalogSubscription,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreAppCatalogSubscription,
+		ReadContext:   readCoreAppCatalogSubscription,
+		DeleteContext: deleteCoreAppCatalogSubscription,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -96,29 +97,29 @@ func CoreAppCatalogSubscriptionResource() *schema.Resource {
 	}
 }
 
-func createCoreAppCatalogSubscription(d *schema.ResourceData, m interface{}) error {
+func createCoreAppCatalogSubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreAppCatalogSubscriptionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreAppCatalogSubscription(d *schema.ResourceData, m interface{}) error {
+func readCoreAppCatalogSubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreAppCatalogSubscriptionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteCoreAppCatalogSubscription(d *schema.ResourceData, m interface{}) error {
+func deleteCoreAppCatalogSubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreAppCatalogSubscriptionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreAppCatalogSubscriptionResourceCrud struct {
@@ -132,7 +133,7 @@ func (s *CoreAppCatalogSubscriptionResourceCrud) ID() string {
 	return getSubscriptionCompositeId(*s.Res.CompartmentId, *s.Res.ListingId, *s.Res.ListingResourceVersion)
 }
 
-func (s *CoreAppCatalogSubscriptionResourceCrud) Create() error {
+func (s *CoreAppCatalogSubscriptionResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateAppCatalogSubscriptionRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -175,17 +176,17 @@ func (s *CoreAppCatalogSubscriptionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.CreateAppCatalogSubscription(context.Background(), request)
+	_, err := s.Client.CreateAppCatalogSubscription(ctx, request)
 	if err != nil {
 		return err
 	}
 	retentionPolicyFunc := func() bool { return s.Res != nil && s.Res.TimeCreated != nil }
 	compositeId := getSubscriptionCompositeId(*request.CompartmentId, *request.ListingId, *request.ListingResourceVersion)
 	s.D.SetId(compositeId)
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutCreate))
+	return tfresource.WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *CoreAppCatalogSubscriptionResourceCrud) Get() error {
+func (s *CoreAppCatalogSubscriptionResourceCrud) Get(ctx context.Context) error {
 	compartmentId, listingId, listingResourceVersion, err := parseSubscriptionCompositeId(s.D.Id())
 	if err != nil {
 		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
@@ -198,7 +199,7 @@ func (s *CoreAppCatalogSubscriptionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.ListAppCatalogSubscriptions(context.Background(), request)
+	response, err := s.Client.ListAppCatalogSubscriptions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -223,7 +224,7 @@ func (s *CoreAppCatalogSubscriptionResourceCrud) Get() error {
 
 	for !isFound && response.OpcNextPage != nil {
 		request.Page = response.OpcNextPage
-		response, err := s.Client.ListAppCatalogSubscriptions(context.Background(), request)
+		response, err := s.Client.ListAppCatalogSubscriptions(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -249,7 +250,7 @@ func (s *CoreAppCatalogSubscriptionResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreAppCatalogSubscriptionResourceCrud) Delete() error {
+func (s *CoreAppCatalogSubscriptionResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteAppCatalogSubscriptionRequest{}
 	compartmentId, listingId, listingResourceVersion, err := parseSubscriptionCompositeId(s.D.Id())
 	if err != nil {
@@ -261,12 +262,12 @@ func (s *CoreAppCatalogSubscriptionResourceCrud) Delete() error {
 	request.ResourceVersion = &listingResourceVersion
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err = s.Client.DeleteAppCatalogSubscription(context.Background(), request)
+	_, err = s.Client.DeleteAppCatalogSubscription(ctx, request)
 	if err != nil {
 		return err
 	}
 	retentionPolicyFunc := func() bool { return s.Res == nil }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutDelete))
+	return tfresource.WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutDelete))
 }
 
 func (s *CoreAppCatalogSubscriptionResourceCrud) SetData() error {
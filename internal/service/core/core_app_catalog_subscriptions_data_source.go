@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 )
 
 func CoreAppCatalogSubscriptionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreAppCatalogSubscriptions,
+		ReadContext: readCoreAppCatalogSubscriptions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -35,12 +36,12 @@ func CoreAppCatalogSubscriptionsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreAppCatalogSubscriptions(d *schema.ResourceData, m interface{}) error {
+func readCoreAppCatalogSubscriptions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreAppCatalogSubscriptionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreAppCatalogSubscriptionsDataSourceCrud struct {
@@ -53,7 +54,7 @@ func (s *CoreAppCatalogSubscriptionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreAppCatalogSubscriptionsDataSourceCrud) Get() error {
+func (s *CoreAppCatalogSubscriptionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListAppCatalogSubscriptionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -68,7 +69,7 @@ func (s *CoreAppCatalogSubscriptionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListAppCatalogSubscriptions(context.Background(), request)
+	response, err := s.Client.ListAppCatalogSubscriptions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -77,7 +78,7 @@ func (s *CoreAppCatalogSubscriptionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAppCatalogSubscriptions(context.Background(), request)
+		listResponse, err := s.Client.ListAppCatalogSubscriptions(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -10,13 +10,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 )
 
 func CoreBlockVolumeReplicaDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreBlockVolumeReplica,
+		ReadContext: readSingularCoreBlockVolumeReplica,
 		Schema: map[string]*schema.Schema{
 			"block_volume_replica_id": {
 				Type:     schema.TypeString,
@@ -73,12 +74,12 @@ func CoreBlockVolumeReplicaDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreBlockVolumeReplica(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreBlockVolumeReplica(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBlockVolumeReplicaDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreBlockVolumeReplicaDataSourceCrud struct {
@@ -91,7 +92,7 @@ func (s *CoreBlockVolumeReplicaDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreBlockVolumeReplicaDataSourceCrud) Get() error {
+func (s *CoreBlockVolumeReplicaDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetBlockVolumeReplicaRequest{}
 
 	if blockVolumeReplicaId, ok := s.D.GetOkExists("block_volume_replica_id"); ok {
@@ -101,7 +102,7 @@ func (s *CoreBlockVolumeReplicaDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetBlockVolumeReplica(context.Background(), request)
+	response, err := s.Client.GetBlockVolumeReplica(ctx, request)
 	if err != nil {
 		return err
 	}
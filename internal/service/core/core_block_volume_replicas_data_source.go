@@ -10,13 +10,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 )
 
 func CoreBlockVolumeReplicasDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreBlockVolumeReplicas,
+		ReadContext: readCoreBlockVolumeReplicas,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -106,12 +107,12 @@ func CoreBlockVolumeReplicasDataSource() *schema.Resource {
 	}
 }
 
-func readCoreBlockVolumeReplicas(d *schema.ResourceData, m interface{}) error {
+func readCoreBlockVolumeReplicas(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBlockVolumeReplicasDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreBlockVolumeReplicasDataSourceCrud struct {
@@ -124,7 +125,7 @@ func (s *CoreBlockVolumeReplicasDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreBlockVolumeReplicasDataSourceCrud) Get() error {
+func (s *CoreBlockVolumeReplicasDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListBlockVolumeReplicasRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -153,7 +154,7 @@ func (s *CoreBlockVolumeReplicasDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListBlockVolumeReplicas(context.Background(), request)
+	response, err := s.Client.ListBlockVolumeReplicas(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -162,7 +163,7 @@ func (s *CoreBlockVolumeReplicasDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBlockVolumeReplicas(context.Background(), request)
+		listResponse, err := s.Client.ListBlockVolumeReplicas(ctx, request)
 		if err != nil {
 			return err
 		}
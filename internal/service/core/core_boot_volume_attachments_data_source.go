@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 )
 
 func CoreBootVolumeAttachmentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreBootVolumeAttachments,
+		ReadContext: readCoreBootVolumeAttachments,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -93,12 +94,12 @@ func CoreBootVolumeAttachmentsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreBootVolumeAttachments(d *schema.ResourceData, m interface{}) error {
+func readCoreBootVolumeAttachments(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBootVolumeAttachmentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreBootVolumeAttachmentsDataSourceCrud struct {
@@ -111,7 +112,7 @@ func (s *CoreBootVolumeAttachmentsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreBootVolumeAttachmentsDataSourceCrud) Get() error {
+func (s *CoreBootVolumeAttachmentsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListBootVolumeAttachmentsRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -136,7 +137,7 @@ func (s *CoreBootVolumeAttachmentsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListBootVolumeAttachments(context.Background(), request)
+	response, err := s.Client.ListBootVolumeAttachments(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -145,7 +146,7 @@ func (s *CoreBootVolumeAttachmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBootVolumeAttachments(context.Background(), request)
+		listResponse, err := s.Client.ListBootVolumeAttachments(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
@@ -23,11 +24,11 @@ func CoreBootVolumeBackupResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreBootVolumeBackup,
-		Read:     readCoreBootVolumeBackup,
-		Update:   updateCoreBootVolumeBackup,
-		Delete:   deleteCoreBootVolumeBackup,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreBootVolumeBackup,
+		ReadContext:   readCoreBootVolumeBackup,
+		UpdateContext: updateCoreBootVolumeBackup,
+		DeleteContext: deleteCoreBootVolumeBackup,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"boot_volume_id": {
@@ -149,7 +150,7 @@ func CoreBootVolumeBackupResource() *schema.Resource {
 	}
 }
 
-func createCoreBootVolumeBackup(d *schema.ResourceData, m interface{}) error {
+func createCoreBootVolumeBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBootVolumeBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
@@ -157,19 +158,19 @@ func createCoreBootVolumeBackup(d *schema.ResourceData, m interface{}) error {
 
 	compartment, ok := sync.D.GetOkExists("compartment_id")
 
-	err := tfresource.CreateResource(d, sync)
+	err := tfresource.CreateResource(ctx, d, sync)
 	if err != nil {
-		return err
+		return tfresource.HandleDiagError(err)
 	}
 
 	if ok && compartment != *sync.Res.CompartmentId {
-		err = sync.updateCompartment(compartment)
+		err = sync.updateCompartment(ctx, compartment)
 		if err != nil {
-			return err
+			return tfresource.HandleDiagError(err)
 		}
 		tmp := compartment.(string)
 		sync.Res.CompartmentId = &tmp
-		err := sync.Get()
+		err := sync.Get(ctx)
 		if err != nil {
 			log.Printf("error doing a Get() after compartment Update: %v", err)
 		}
@@ -181,32 +182,32 @@ func createCoreBootVolumeBackup(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func readCoreBootVolumeBackup(d *schema.ResourceData, m interface{}) error {
+func readCoreBootVolumeBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBootVolumeBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 	sync.workRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreBootVolumeBackup(d *schema.ResourceData, m interface{}) error {
+func updateCoreBootVolumeBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBootVolumeBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 	sync.workRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreBootVolumeBackup(d *schema.ResourceData, m interface{}) error {
+func deleteCoreBootVolumeBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBootVolumeBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 	sync.workRequestClient = m.(*client.OracleClients).WorkRequestClient
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreBootVolumeBackupResourceCrud struct {
@@ -247,22 +248,22 @@ func (s *CoreBootVolumeBackupResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreBootVolumeBackupResourceCrud) Create() error {
+func (s *CoreBootVolumeBackupResourceCrud) Create(ctx context.Context) error {
 	if s.isCopyCreate() {
-		err := s.createBootVolumeBackupCopy()
+		err := s.createBootVolumeBackupCopy(ctx)
 		if err != nil {
 			return err
 		}
 		s.D.SetId(*s.Res.Id)
-		err = tfresource.WaitForResourceCondition(s, func() bool { return s.Res.LifecycleState == oci_core.BootVolumeBackupLifecycleStateAvailable }, s.D.Timeout(schema.TimeoutCreate))
+		err = tfresource.WaitForResourceCondition(ctx, s, func() bool { return s.Res.LifecycleState == oci_core.BootVolumeBackupLifecycleStateAvailable }, s.D.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
 		// Update for some fields that can't be created by copy
-		return s.Update()
+		return s.Update(ctx)
 	}
 
-	return s.createBootVolumeBackup()
+	return s.createBootVolumeBackup(ctx)
 }
 
 func (s *CoreBootVolumeBackupResourceCrud) isCopyCreate() bool {
@@ -274,7 +275,7 @@ func (s *CoreBootVolumeBackupResourceCrud) isCopyCreate() bool {
 	return false
 }
 
-func (s *CoreBootVolumeBackupResourceCrud) createBootVolumeBackup() error {
+func (s *CoreBootVolumeBackupResourceCrud) createBootVolumeBackup(ctx context.Context) error {
 	request := oci_core.CreateBootVolumeBackupRequest{}
 
 	if bootVolumeId, ok := s.D.GetOkExists("boot_volume_id"); ok {
@@ -305,7 +306,7 @@ func (s *CoreBootVolumeBackupResourceCrud) createBootVolumeBackup() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateBootVolumeBackup(context.Background(), request)
+	response, err := s.Client.CreateBootVolumeBackup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -314,7 +315,7 @@ func (s *CoreBootVolumeBackupResourceCrud) createBootVolumeBackup() error {
 	return nil
 }
 
-func (s *CoreBootVolumeBackupResourceCrud) createBootVolumeBackupCopy() error {
+func (s *CoreBootVolumeBackupResourceCrud) createBootVolumeBackupCopy(ctx context.Context) error {
 	copyBootVolumeBackupRequest := oci_core.CopyBootVolumeBackupRequest{}
 
 	configProvider := *s.Client.ConfigurationProvider()
@@ -355,7 +356,7 @@ func (s *CoreBootVolumeBackupResourceCrud) createBootVolumeBackupCopy() error {
 		copyBootVolumeBackupRequest.DisplayName = &tmp
 	}
 
-	response, err := s.SourceRegionClient.CopyBootVolumeBackup(context.Background(), copyBootVolumeBackupRequest)
+	response, err := s.SourceRegionClient.CopyBootVolumeBackup(ctx, copyBootVolumeBackupRequest)
 	if err != nil {
 		return err
 	}
@@ -365,7 +366,7 @@ func (s *CoreBootVolumeBackupResourceCrud) createBootVolumeBackupCopy() error {
 	s.Res = &response.BootVolumeBackup
 
 	if workRequestId != nil {
-		_, err := tfresource.WaitForWorkRequestWithErrorHandling(s.workRequestClient, workRequestId, "bootVolumeBackup", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		_, err := tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.workRequestClient, workRequestId, "bootVolumeBackup", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -374,7 +375,7 @@ func (s *CoreBootVolumeBackupResourceCrud) createBootVolumeBackupCopy() error {
 	return nil
 }
 
-func (s *CoreBootVolumeBackupResourceCrud) Get() error {
+func (s *CoreBootVolumeBackupResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetBootVolumeBackupRequest{}
 
 	tmp := s.D.Id()
@@ -382,7 +383,7 @@ func (s *CoreBootVolumeBackupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetBootVolumeBackup(context.Background(), request)
+	response, err := s.Client.GetBootVolumeBackup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -391,11 +392,11 @@ func (s *CoreBootVolumeBackupResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreBootVolumeBackupResourceCrud) Update() error {
+func (s *CoreBootVolumeBackupResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -437,7 +438,7 @@ func (s *CoreBootVolumeBackupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateBootVolumeBackup(context.Background(), request)
+	response, err := s.Client.UpdateBootVolumeBackup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -446,7 +447,7 @@ func (s *CoreBootVolumeBackupResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreBootVolumeBackupResourceCrud) Delete() error {
+func (s *CoreBootVolumeBackupResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteBootVolumeBackupRequest{}
 
 	tmp := s.D.Id()
@@ -454,7 +455,7 @@ func (s *CoreBootVolumeBackupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteBootVolumeBackup(context.Background(), request)
+	_, err := s.Client.DeleteBootVolumeBackup(ctx, request)
 	return err
 }
 
@@ -522,7 +523,7 @@ func (s *CoreBootVolumeBackupResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CoreBootVolumeBackupResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreBootVolumeBackupResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeBootVolumeBackupCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -533,12 +534,12 @@ func (s *CoreBootVolumeBackupResourceCrud) updateCompartment(compartment interfa
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeBootVolumeBackupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeBootVolumeBackupCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
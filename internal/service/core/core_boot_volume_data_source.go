@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 )
@@ -23,12 +24,12 @@ func CoreBootVolumeDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreBootVolumeResource(), fieldMap, readSingularCoreBootVolume)
 }
 
-func readSingularCoreBootVolume(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreBootVolume(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBootVolumeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreBootVolumeDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *CoreBootVolumeDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreBootVolumeDataSourceCrud) Get() error {
+func (s *CoreBootVolumeDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetBootVolumeRequest{}
 
 	if bootVolumeId, ok := s.D.GetOkExists("boot_volume_id"); ok {
@@ -51,7 +52,7 @@ func (s *CoreBootVolumeDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetBootVolume(context.Background(), request)
+	response, err := s.Client.GetBootVolume(ctx, request)
 	if err != nil {
 		return err
 	}
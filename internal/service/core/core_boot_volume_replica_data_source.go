@@ -10,13 +10,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 )
 
 func CoreBootVolumeReplicaDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreBootVolumeReplica,
+		ReadContext: readSingularCoreBootVolumeReplica,
 		Schema: map[string]*schema.Schema{
 			"boot_volume_replica_id": {
 				Type:     schema.TypeString,
@@ -77,12 +78,12 @@ func CoreBootVolumeReplicaDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreBootVolumeReplica(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreBootVolumeReplica(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBootVolumeReplicaDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreBootVolumeReplicaDataSourceCrud struct {
@@ -95,7 +96,7 @@ func (s *CoreBootVolumeReplicaDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreBootVolumeReplicaDataSourceCrud) Get() error {
+func (s *CoreBootVolumeReplicaDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetBootVolumeReplicaRequest{}
 
 	if bootVolumeReplicaId, ok := s.D.GetOkExists("boot_volume_replica_id"); ok {
@@ -105,7 +106,7 @@ func (s *CoreBootVolumeReplicaDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetBootVolumeReplica(context.Background(), request)
+	response, err := s.Client.GetBootVolumeReplica(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -10,13 +10,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 )
 
 func CoreBootVolumeReplicasDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreBootVolumeReplicas,
+		ReadContext: readCoreBootVolumeReplicas,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -110,12 +111,12 @@ func CoreBootVolumeReplicasDataSource() *schema.Resource {
 	}
 }
 
-func readCoreBootVolumeReplicas(d *schema.ResourceData, m interface{}) error {
+func readCoreBootVolumeReplicas(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBootVolumeReplicasDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreBootVolumeReplicasDataSourceCrud struct {
@@ -128,7 +129,7 @@ func (s *CoreBootVolumeReplicasDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreBootVolumeReplicasDataSourceCrud) Get() error {
+func (s *CoreBootVolumeReplicasDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListBootVolumeReplicasRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -157,7 +158,7 @@ func (s *CoreBootVolumeReplicasDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListBootVolumeReplicas(context.Background(), request)
+	response, err := s.Client.ListBootVolumeReplicas(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -166,7 +167,7 @@ func (s *CoreBootVolumeReplicasDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBootVolumeReplicas(context.Background(), request)
+		listResponse, err := s.Client.ListBootVolumeReplicas(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -24,11 +25,11 @@ func CoreBootVolumeResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreBootVolume,
-		Read:     readCoreBootVolume,
-		Update:   updateCoreBootVolume,
-		Delete:   deleteCoreBootVolume,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreBootVolume,
+		ReadContext:   readCoreBootVolume,
+		UpdateContext: updateCoreBootVolume,
+		DeleteContext: deleteCoreBootVolume,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"availability_domain": {
@@ -225,37 +226,37 @@ func CoreBootVolumeResource() *schema.Resource {
 	}
 }
 
-func createCoreBootVolume(d *schema.ResourceData, m interface{}) error {
+func createCoreBootVolume(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBootVolumeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreBootVolume(d *schema.ResourceData, m interface{}) error {
+func readCoreBootVolume(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBootVolumeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreBootVolume(d *schema.ResourceData, m interface{}) error {
+func updateCoreBootVolume(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBootVolumeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreBootVolume(d *schema.ResourceData, m interface{}) error {
+func deleteCoreBootVolume(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBootVolumeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreBootVolumeResourceCrud struct {
@@ -306,7 +307,7 @@ func (s *CoreBootVolumeResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *CoreBootVolumeResourceCrud) Create() error {
+func (s *CoreBootVolumeResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateBootVolumeRequest{}
 
 	if autotunePolicies, ok := s.D.GetOkExists("autotune_policies"); ok {
@@ -416,7 +417,7 @@ func (s *CoreBootVolumeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateBootVolume(context.Background(), request)
+	response, err := s.Client.CreateBootVolume(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -425,7 +426,7 @@ func (s *CoreBootVolumeResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreBootVolumeResourceCrud) Get() error {
+func (s *CoreBootVolumeResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetBootVolumeRequest{}
 
 	tmp := s.D.Id()
@@ -433,7 +434,7 @@ func (s *CoreBootVolumeResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetBootVolume(context.Background(), request)
+	response, err := s.Client.GetBootVolume(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -442,11 +443,11 @@ func (s *CoreBootVolumeResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreBootVolumeResourceCrud) Update() error {
+func (s *CoreBootVolumeResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -531,7 +532,7 @@ func (s *CoreBootVolumeResourceCrud) Update() error {
 
 		keyUpdateRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-		_, err := s.Client.UpdateBootVolumeKmsKey(context.Background(), keyUpdateRequest)
+		_, err := s.Client.UpdateBootVolumeKmsKey(ctx, keyUpdateRequest)
 		if err != nil {
 			return err
 		}
@@ -557,7 +558,7 @@ func (s *CoreBootVolumeResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateBootVolume(context.Background(), request)
+	response, err := s.Client.UpdateBootVolume(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -566,7 +567,7 @@ func (s *CoreBootVolumeResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreBootVolumeResourceCrud) Delete() error {
+func (s *CoreBootVolumeResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteBootVolumeRequest{}
 
 	tmp := s.D.Id()
@@ -574,7 +575,7 @@ func (s *CoreBootVolumeResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteBootVolume(context.Background(), request)
+	_, err := s.Client.DeleteBootVolume(ctx, request)
 	return err
 }
 
@@ -666,7 +667,7 @@ func (s *CoreBootVolumeResourceCrud) SetData() error {
 	}
 
 	// Add backup policy id from the other API
-	backupPolicyId, err := getBackupPolicyId(s.Res.Id, s.Client)
+	backupPolicyId, err := getBackupPolicyId(context.Background(), s.Res.Id, s.Client)
 	if err != nil {
 		log.Printf("[ERROR] Received an error when fetching backup policy id %v", err)
 	} else if backupPolicyId != nil {
@@ -826,7 +827,7 @@ func BootVolumeSourceDetailsToMap(obj *oci_core.BootVolumeSourceDetails) map[str
 	return result
 }
 
-func (s *CoreBootVolumeResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreBootVolumeResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeBootVolumeCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -837,12 +838,12 @@ func (s *CoreBootVolumeResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeBootVolumeCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeBootVolumeCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
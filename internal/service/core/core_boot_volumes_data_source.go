@@ -10,13 +10,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 )
 
 func CoreBootVolumesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreBootVolumes,
+		ReadContext: readCoreBootVolumes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -40,12 +41,12 @@ func CoreBootVolumesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreBootVolumes(d *schema.ResourceData, m interface{}) error {
+func readCoreBootVolumes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreBootVolumesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreBootVolumesDataSourceCrud struct {
@@ -58,7 +59,7 @@ func (s *CoreBootVolumesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreBootVolumesDataSourceCrud) Get() error {
+func (s *CoreBootVolumesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListBootVolumesRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -78,7 +79,7 @@ func (s *CoreBootVolumesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListBootVolumes(context.Background(), request)
+	response, err := s.Client.ListBootVolumes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -87,7 +88,7 @@ func (s *CoreBootVolumesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBootVolumes(context.Background(), request)
+		listResponse, err := s.Client.ListBootVolumes(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 )
 
 func CoreByoipAllocatedRangesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreByoipAllocatedRanges,
+		ReadContext: readCoreByoipAllocatedRanges,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"byoip_range_id": {
@@ -60,12 +61,12 @@ func CoreByoipAllocatedRangesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreByoipAllocatedRanges(d *schema.ResourceData, m interface{}) error {
+func readCoreByoipAllocatedRanges(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreByoipAllocatedRangesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreByoipAllocatedRangesDataSourceCrud struct {
@@ -78,7 +79,7 @@ func (s *CoreByoipAllocatedRangesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreByoipAllocatedRangesDataSourceCrud) Get() error {
+func (s *CoreByoipAllocatedRangesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListByoipAllocatedRangesRequest{}
 
 	if byoipRangeId, ok := s.D.GetOkExists("byoip_range_id"); ok {
@@ -88,7 +89,7 @@ func (s *CoreByoipAllocatedRangesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListByoipAllocatedRanges(context.Background(), request)
+	response, err := s.Client.ListByoipAllocatedRanges(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -97,7 +98,7 @@ func (s *CoreByoipAllocatedRangesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListByoipAllocatedRanges(context.Background(), request)
+		listResponse, err := s.Client.ListByoipAllocatedRanges(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 )
 
 func CoreByoipRangeDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreByoipRange,
+		ReadContext: readSingularCoreByoipRange,
 		Schema: map[string]*schema.Schema{
 			"byoip_range_id": {
 				Type:     schema.TypeString,
@@ -110,12 +111,12 @@ func CoreByoipRangeDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreByoipRange(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreByoipRange(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreByoipRangeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreByoipRangeDataSourceCrud struct {
@@ -128,7 +129,7 @@ func (s *CoreByoipRangeDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreByoipRangeDataSourceCrud) Get() error {
+func (s *CoreByoipRangeDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetByoipRangeRequest{}
 
 	if byoipRangeId, ok := s.D.GetOkExists("byoip_range_id"); ok {
@@ -138,7 +139,7 @@ func (s *CoreByoipRangeDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetByoipRange(context.Background(), request)
+	response, err := s.Client.GetByoipRange(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 )
 
 func CoreByoipRangesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreByoipRanges,
+		ReadContext: readCoreByoipRanges,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -146,12 +147,12 @@ func CoreByoipRangesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreByoipRanges(d *schema.ResourceData, m interface{}) error {
+func readCoreByoipRanges(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreByoipRangesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreByoipRangesDataSourceCrud struct {
@@ -164,7 +165,7 @@ func (s *CoreByoipRangesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreByoipRangesDataSourceCrud) Get() error {
+func (s *CoreByoipRangesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListByoipRangesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -184,7 +185,7 @@ func (s *CoreByoipRangesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListByoipRanges(context.Background(), request)
+	response, err := s.Client.ListByoipRanges(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -193,7 +194,7 @@ func (s *CoreByoipRangesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListByoipRanges(context.Background(), request)
+		listResponse, err := s.Client.ListByoipRanges(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
@@ -21,11 +22,11 @@ func CoreCaptureFilterResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreCaptureFilter,
-		Read:     readCoreCaptureFilter,
-		Update:   updateCoreCaptureFilter,
-		Delete:   deleteCoreCaptureFilter,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreCaptureFilter,
+		ReadContext:   readCoreCaptureFilter,
+		UpdateContext: updateCoreCaptureFilter,
+		DeleteContext: deleteCoreCaptureFilter,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -262,40 +263,40 @@ func CoreCaptureFilterResource() *schema.Resource {
 	}
 }
 
-func createCoreCaptureFilter(d *schema.ResourceData, m interface{}) error {
+func createCoreCaptureFilter(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCaptureFilterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreCaptureFilter(d *schema.ResourceData, m interface{}) error {
+func readCoreCaptureFilter(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCaptureFilterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreCaptureFilter(d *schema.ResourceData, m interface{}) error {
+func updateCoreCaptureFilter(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCaptureFilterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreCaptureFilter(d *schema.ResourceData, m interface{}) error {
+func deleteCoreCaptureFilter(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCaptureFilterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreCaptureFilterResourceCrud struct {
@@ -334,7 +335,7 @@ func (s *CoreCaptureFilterResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreCaptureFilterResourceCrud) Create() error {
+func (s *CoreCaptureFilterResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateCaptureFilterRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -382,7 +383,7 @@ func (s *CoreCaptureFilterResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateCaptureFilter(context.Background(), request)
+	response, err := s.Client.CreateCaptureFilter(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -391,7 +392,7 @@ func (s *CoreCaptureFilterResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreCaptureFilterResourceCrud) Get() error {
+func (s *CoreCaptureFilterResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetCaptureFilterRequest{}
 
 	tmp := s.D.Id()
@@ -399,7 +400,7 @@ func (s *CoreCaptureFilterResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetCaptureFilter(context.Background(), request)
+	response, err := s.Client.GetCaptureFilter(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -408,11 +409,11 @@ func (s *CoreCaptureFilterResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreCaptureFilterResourceCrud) Update() error {
+func (s *CoreCaptureFilterResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -459,7 +460,7 @@ func (s *CoreCaptureFilterResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateCaptureFilter(context.Background(), request)
+	response, err := s.Client.UpdateCaptureFilter(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -468,7 +469,7 @@ func (s *CoreCaptureFilterResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreCaptureFilterResourceCrud) Delete() error {
+func (s *CoreCaptureFilterResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteCaptureFilterRequest{}
 
 	tmp := s.D.Id()
@@ -476,7 +477,7 @@ func (s *CoreCaptureFilterResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteCaptureFilter(context.Background(), request)
+	_, err := s.Client.DeleteCaptureFilter(ctx, request)
 	return err
 }
 
@@ -752,7 +753,7 @@ func VtapCaptureFilterRuleDetailsToMap(obj oci_core.VtapCaptureFilterRuleDetails
 	return result
 }
 
-func (s *CoreCaptureFilterResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreCaptureFilterResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeCaptureFilterCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -763,14 +764,14 @@ func (s *CoreCaptureFilterResourceCrud) updateCompartment(compartment interface{
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.ChangeCaptureFilterCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeCaptureFilterCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "captureFilter", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "captureFilter", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
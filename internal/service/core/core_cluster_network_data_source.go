@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 )
@@ -22,12 +23,12 @@ func CoreClusterNetworkDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreClusterNetworkResource(), fieldMap, readSingularCoreClusterNetwork)
 }
 
-func readSingularCoreClusterNetwork(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreClusterNetwork(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreClusterNetworkDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreClusterNetworkDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *CoreClusterNetworkDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreClusterNetworkDataSourceCrud) Get() error {
+func (s *CoreClusterNetworkDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetClusterNetworkRequest{}
 
 	if clusterNetworkId, ok := s.D.GetOkExists("cluster_network_id"); ok {
@@ -50,7 +51,7 @@ func (s *CoreClusterNetworkDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetClusterNetwork(context.Background(), request)
+	response, err := s.Client.GetClusterNetwork(ctx, request)
 	if err != nil {
 		return err
 	}
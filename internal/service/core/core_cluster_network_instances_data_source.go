@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 )
 
 func CoreClusterNetworkInstancesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreClusterNetworkInstances,
+		ReadContext: readCoreClusterNetworkInstances,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"cluster_network_id": {
@@ -121,12 +122,12 @@ func CoreClusterNetworkInstancesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreClusterNetworkInstances(d *schema.ResourceData, m interface{}) error {
+func readCoreClusterNetworkInstances(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreClusterNetworkInstancesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreClusterNetworkInstancesDataSourceCrud struct {
@@ -139,7 +140,7 @@ func (s *CoreClusterNetworkInstancesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreClusterNetworkInstancesDataSourceCrud) Get() error {
+func (s *CoreClusterNetworkInstancesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListClusterNetworkInstancesRequest{}
 
 	if clusterNetworkId, ok := s.D.GetOkExists("cluster_network_id"); ok {
@@ -159,7 +160,7 @@ func (s *CoreClusterNetworkInstancesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListClusterNetworkInstances(context.Background(), request)
+	response, err := s.Client.ListClusterNetworkInstances(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -168,7 +169,7 @@ func (s *CoreClusterNetworkInstancesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListClusterNetworkInstances(context.Background(), request)
+		listResponse, err := s.Client.ListClusterNetworkInstances(ctx, request)
 		if err != nil {
 			return err
 		}
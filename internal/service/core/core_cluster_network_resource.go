@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
@@ -22,11 +23,11 @@ func CoreClusterNetworkResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreClusterNetwork,
-		Read:     readCoreClusterNetwork,
-		Update:   updateCoreClusterNetwork,
-		Delete:   deleteCoreClusterNetwork,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreClusterNetwork,
+		ReadContext:   readCoreClusterNetwork,
+		UpdateContext: updateCoreClusterNetwork,
+		DeleteContext: deleteCoreClusterNetwork,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -270,37 +271,37 @@ func CoreClusterNetworkResource() *schema.Resource {
 	}
 }
 
-func createCoreClusterNetwork(d *schema.ResourceData, m interface{}) error {
+func createCoreClusterNetwork(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreClusterNetworkResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreClusterNetwork(d *schema.ResourceData, m interface{}) error {
+func readCoreClusterNetwork(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreClusterNetworkResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreClusterNetwork(d *schema.ResourceData, m interface{}) error {
+func updateCoreClusterNetwork(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreClusterNetworkResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreClusterNetwork(d *schema.ResourceData, m interface{}) error {
+func deleteCoreClusterNetwork(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreClusterNetworkResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreClusterNetworkResourceCrud struct {
@@ -340,7 +341,7 @@ func (s *CoreClusterNetworkResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreClusterNetworkResourceCrud) Create() error {
+func (s *CoreClusterNetworkResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateClusterNetworkRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -395,7 +396,7 @@ func (s *CoreClusterNetworkResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateClusterNetwork(context.Background(), request)
+	response, err := s.Client.CreateClusterNetwork(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -404,7 +405,7 @@ func (s *CoreClusterNetworkResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreClusterNetworkResourceCrud) Get() error {
+func (s *CoreClusterNetworkResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetClusterNetworkRequest{}
 
 	tmp := s.D.Id()
@@ -412,7 +413,7 @@ func (s *CoreClusterNetworkResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetClusterNetwork(context.Background(), request)
+	response, err := s.Client.GetClusterNetwork(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -421,11 +422,11 @@ func (s *CoreClusterNetworkResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreClusterNetworkResourceCrud) Update() error {
+func (s *CoreClusterNetworkResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -472,7 +473,7 @@ func (s *CoreClusterNetworkResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateClusterNetwork(context.Background(), request)
+	response, err := s.Client.UpdateClusterNetwork(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -481,7 +482,7 @@ func (s *CoreClusterNetworkResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreClusterNetworkResourceCrud) Delete() error {
+func (s *CoreClusterNetworkResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.TerminateClusterNetworkRequest{}
 
 	tmp := s.D.Id()
@@ -489,7 +490,7 @@ func (s *CoreClusterNetworkResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.TerminateClusterNetwork(context.Background(), request)
+	_, err := s.Client.TerminateClusterNetwork(ctx, request)
 	return err
 }
 
@@ -741,7 +742,7 @@ func secondaryVnicSubnetsHashCodeForSets(v interface{}) int {
 	return utils.GetStringHashcode(buf.String())
 }
 
-func (s *CoreClusterNetworkResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreClusterNetworkResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeClusterNetworkCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -752,12 +753,12 @@ func (s *CoreClusterNetworkResourceCrud) updateCompartment(compartment interface
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeClusterNetworkCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeClusterNetworkCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 )
 
 func CoreClusterNetworksDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreClusterNetworks,
+		ReadContext: readCoreClusterNetworks,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,12 +40,12 @@ func CoreClusterNetworksDataSource() *schema.Resource {
 	}
 }
 
-func readCoreClusterNetworks(d *schema.ResourceData, m interface{}) error {
+func readCoreClusterNetworks(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreClusterNetworksDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreClusterNetworksDataSourceCrud struct {
@@ -57,7 +58,7 @@ func (s *CoreClusterNetworksDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreClusterNetworksDataSourceCrud) Get() error {
+func (s *CoreClusterNetworksDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListClusterNetworksRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -76,7 +77,7 @@ func (s *CoreClusterNetworksDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListClusterNetworks(context.Background(), request)
+	response, err := s.Client.ListClusterNetworks(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *CoreClusterNetworksDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListClusterNetworks(context.Background(), request)
+		listResponse, err := s.Client.ListClusterNetworks(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 )
@@ -23,12 +24,12 @@ func CoreComputeCapacityReservationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreComputeCapacityReservationResource(), fieldMap, readSingularCoreComputeCapacityReservation)
 }
 
-func readSingularCoreComputeCapacityReservation(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreComputeCapacityReservation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeCapacityReservationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreComputeCapacityReservationDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *CoreComputeCapacityReservationDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreComputeCapacityReservationDataSourceCrud) Get() error {
+func (s *CoreComputeCapacityReservationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetComputeCapacityReservationRequest{}
 
 	if capacityReservationId, ok := s.D.GetOkExists("capacity_reservation_id"); ok {
@@ -51,7 +52,7 @@ func (s *CoreComputeCapacityReservationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetComputeCapacityReservation(context.Background(), request)
+	response, err := s.Client.GetComputeCapacityReservation(ctx, request)
 	if err != nil {
 		return err
 	}
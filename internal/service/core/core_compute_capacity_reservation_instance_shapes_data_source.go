@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 )
 
 func CoreComputeCapacityReservationInstanceShapesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreComputeCapacityReservationInstanceShapes,
+		ReadContext: readCoreComputeCapacityReservationInstanceShapes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -55,12 +56,12 @@ func CoreComputeCapacityReservationInstanceShapesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreComputeCapacityReservationInstanceShapes(d *schema.ResourceData, m interface{}) error {
+func readCoreComputeCapacityReservationInstanceShapes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeCapacityReservationInstanceShapesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreComputeCapacityReservationInstanceShapesDataSourceCrud struct {
@@ -73,7 +74,7 @@ func (s *CoreComputeCapacityReservationInstanceShapesDataSourceCrud) VoidState()
 	s.D.SetId("")
 }
 
-func (s *CoreComputeCapacityReservationInstanceShapesDataSourceCrud) Get() error {
+func (s *CoreComputeCapacityReservationInstanceShapesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListComputeCapacityReservationInstanceShapesRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -93,7 +94,7 @@ func (s *CoreComputeCapacityReservationInstanceShapesDataSourceCrud) Get() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListComputeCapacityReservationInstanceShapes(context.Background(), request)
+	response, err := s.Client.ListComputeCapacityReservationInstanceShapes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -102,7 +103,7 @@ func (s *CoreComputeCapacityReservationInstanceShapesDataSourceCrud) Get() error
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListComputeCapacityReservationInstanceShapes(context.Background(), request)
+		listResponse, err := s.Client.ListComputeCapacityReservationInstanceShapes(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 )
 
 func CoreComputeCapacityReservationInstancesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreComputeCapacityReservationInstances,
+		ReadContext: readCoreComputeCapacityReservationInstances,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -88,12 +89,12 @@ func CoreComputeCapacityReservationInstancesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreComputeCapacityReservationInstances(d *schema.ResourceData, m interface{}) error {
+func readCoreComputeCapacityReservationInstances(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeCapacityReservationInstancesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreComputeCapacityReservationInstancesDataSourceCrud struct {
@@ -106,7 +107,7 @@ func (s *CoreComputeCapacityReservationInstancesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreComputeCapacityReservationInstancesDataSourceCrud) Get() error {
+func (s *CoreComputeCapacityReservationInstancesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListComputeCapacityReservationInstancesRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -126,7 +127,7 @@ func (s *CoreComputeCapacityReservationInstancesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListComputeCapacityReservationInstances(context.Background(), request)
+	response, err := s.Client.ListComputeCapacityReservationInstances(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -135,7 +136,7 @@ func (s *CoreComputeCapacityReservationInstancesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListComputeCapacityReservationInstances(context.Background(), request)
+		listResponse, err := s.Client.ListComputeCapacityReservationInstances(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -11,6 +11,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
@@ -22,11 +23,11 @@ func CoreComputeCapacityReservationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreComputeCapacityReservation,
-		Read:     readCoreComputeCapacityReservation,
-		Update:   updateCoreComputeCapacityReservation,
-		Delete:   deleteCoreComputeCapacityReservation,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreComputeCapacityReservation,
+		ReadContext:   readCoreComputeCapacityReservation,
+		UpdateContext: updateCoreComputeCapacityReservation,
+		DeleteContext: deleteCoreComputeCapacityReservation,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"availability_domain": {
@@ -148,40 +149,40 @@ func CoreComputeCapacityReservationResource() *schema.Resource {
 	}
 }
 
-func createCoreComputeCapacityReservation(d *schema.ResourceData, m interface{}) error {
+func createCoreComputeCapacityReservation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeCapacityReservationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreComputeCapacityReservation(d *schema.ResourceData, m interface{}) error {
+func readCoreComputeCapacityReservation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeCapacityReservationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreComputeCapacityReservation(d *schema.ResourceData, m interface{}) error {
+func updateCoreComputeCapacityReservation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeCapacityReservationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreComputeCapacityReservation(d *schema.ResourceData, m interface{}) error {
+func deleteCoreComputeCapacityReservation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeCapacityReservationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.DisableNotFoundRetries = true
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreComputeCapacityReservationResourceCrud struct {
@@ -221,7 +222,7 @@ func (s *CoreComputeCapacityReservationResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreComputeCapacityReservationResourceCrud) Create() error {
+func (s *CoreComputeCapacityReservationResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateComputeCapacityReservationRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -275,7 +276,7 @@ func (s *CoreComputeCapacityReservationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateComputeCapacityReservation(context.Background(), request)
+	response, err := s.Client.CreateComputeCapacityReservation(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -284,7 +285,7 @@ func (s *CoreComputeCapacityReservationResourceCrud) Create() error {
 	if workId == nil {
 		return fmt.Errorf("CreateComputeCapacityReservation response.OpcWorkRequestId was nil")
 	}
-	identifier, err := tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "capacityreservation", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+	identifier, err := tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "capacityreservation", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 	if identifier != nil {
 		s.D.SetId(*identifier)
 	}
@@ -292,10 +293,10 @@ func (s *CoreComputeCapacityReservationResourceCrud) Create() error {
 		return err
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *CoreComputeCapacityReservationResourceCrud) Get() error {
+func (s *CoreComputeCapacityReservationResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetComputeCapacityReservationRequest{}
 
 	tmp := s.D.Id()
@@ -303,7 +304,7 @@ func (s *CoreComputeCapacityReservationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetComputeCapacityReservation(context.Background(), request)
+	response, err := s.Client.GetComputeCapacityReservation(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -312,11 +313,11 @@ func (s *CoreComputeCapacityReservationResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreComputeCapacityReservationResourceCrud) Update() error {
+func (s *CoreComputeCapacityReservationResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -368,23 +369,23 @@ func (s *CoreComputeCapacityReservationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateComputeCapacityReservation(context.Background(), request)
+	response, err := s.Client.UpdateComputeCapacityReservation(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "capacityreservation", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "capacityreservation", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *CoreComputeCapacityReservationResourceCrud) Delete() error {
+func (s *CoreComputeCapacityReservationResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteComputeCapacityReservationRequest{}
 
 	tmp := s.D.Id()
@@ -392,20 +393,20 @@ func (s *CoreComputeCapacityReservationResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.DeleteComputeCapacityReservation(context.Background(), request)
+	response, err := s.Client.DeleteComputeCapacityReservation(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "capacityreservation", oci_work_requests.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "capacityreservation", oci_work_requests.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func (s *CoreComputeCapacityReservationResourceCrud) SetData() error {
@@ -552,7 +553,7 @@ func InstanceReservationShapeConfigDetailsToMap(obj *oci_core.InstanceReservatio
 	return result
 }
 
-func (s *CoreComputeCapacityReservationResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreComputeCapacityReservationResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeComputeCapacityReservationCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -563,14 +564,14 @@ func (s *CoreComputeCapacityReservationResourceCrud) updateCompartment(compartme
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.ChangeComputeCapacityReservationCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeComputeCapacityReservationCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "capacityreservation", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "capacityreservation", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
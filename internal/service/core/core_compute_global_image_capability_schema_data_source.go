@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 )
 
 func CoreComputeGlobalImageCapabilitySchemaDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreComputeGlobalImageCapabilitySchema,
+		ReadContext: readSingularCoreComputeGlobalImageCapabilitySchema,
 		Schema: map[string]*schema.Schema{
 			"compute_global_image_capability_schema_id": {
 				Type:     schema.TypeString,
@@ -52,12 +53,12 @@ func CoreComputeGlobalImageCapabilitySchemaDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreComputeGlobalImageCapabilitySchema(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreComputeGlobalImageCapabilitySchema(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeGlobalImageCapabilitySchemaDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreComputeGlobalImageCapabilitySchemaDataSourceCrud struct {
@@ -70,7 +71,7 @@ func (s *CoreComputeGlobalImageCapabilitySchemaDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreComputeGlobalImageCapabilitySchemaDataSourceCrud) Get() error {
+func (s *CoreComputeGlobalImageCapabilitySchemaDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetComputeGlobalImageCapabilitySchemaRequest{}
 
 	if computeGlobalImageCapabilitySchemaId, ok := s.D.GetOkExists("compute_global_image_capability_schema_id"); ok {
@@ -80,7 +81,7 @@ func (s *CoreComputeGlobalImageCapabilitySchemaDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetComputeGlobalImageCapabilitySchema(context.Background(), request)
+	response, err := s.Client.GetComputeGlobalImageCapabilitySchema(ctx, request)
 	if err != nil {
 		return err
 	}
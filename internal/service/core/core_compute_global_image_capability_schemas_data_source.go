@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 )
 
 func CoreComputeGlobalImageCapabilitySchemasDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreComputeGlobalImageCapabilitySchemas,
+		ReadContext: readCoreComputeGlobalImageCapabilitySchemas,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -73,12 +74,12 @@ func CoreComputeGlobalImageCapabilitySchemasDataSource() *schema.Resource {
 	}
 }
 
-func readCoreComputeGlobalImageCapabilitySchemas(d *schema.ResourceData, m interface{}) error {
+func readCoreComputeGlobalImageCapabilitySchemas(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeGlobalImageCapabilitySchemasDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreComputeGlobalImageCapabilitySchemasDataSourceCrud struct {
@@ -91,7 +92,7 @@ func (s *CoreComputeGlobalImageCapabilitySchemasDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreComputeGlobalImageCapabilitySchemasDataSourceCrud) Get() error {
+func (s *CoreComputeGlobalImageCapabilitySchemasDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListComputeGlobalImageCapabilitySchemasRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -106,7 +107,7 @@ func (s *CoreComputeGlobalImageCapabilitySchemasDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListComputeGlobalImageCapabilitySchemas(context.Background(), request)
+	response, err := s.Client.ListComputeGlobalImageCapabilitySchemas(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -115,7 +116,7 @@ func (s *CoreComputeGlobalImageCapabilitySchemasDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListComputeGlobalImageCapabilitySchemas(context.Background(), request)
+		listResponse, err := s.Client.ListComputeGlobalImageCapabilitySchemas(ctx, request)
 		if err != nil {
 			return err
 		}
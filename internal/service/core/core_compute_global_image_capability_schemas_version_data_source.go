@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreComputeGlobalImageCapabilitySchemasVersionDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreComputeGlobalImageCapabilitySchemasVersion,
+		ReadContext: readSingularCoreComputeGlobalImageCapabilitySchemasVersion,
 		Schema: map[string]*schema.Schema{
 			"compute_global_image_capability_schema_id": {
 				Type:     schema.TypeString,
@@ -47,12 +48,12 @@ func CoreComputeGlobalImageCapabilitySchemasVersionDataSource() *schema.Resource
 	}
 }
 
-func readSingularCoreComputeGlobalImageCapabilitySchemasVersion(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreComputeGlobalImageCapabilitySchemasVersion(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeGlobalImageCapabilitySchemasVersionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreComputeGlobalImageCapabilitySchemasVersionDataSourceCrud struct {
@@ -65,7 +66,7 @@ func (s *CoreComputeGlobalImageCapabilitySchemasVersionDataSourceCrud) VoidState
 	s.D.SetId("")
 }
 
-func (s *CoreComputeGlobalImageCapabilitySchemasVersionDataSourceCrud) Get() error {
+func (s *CoreComputeGlobalImageCapabilitySchemasVersionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetComputeGlobalImageCapabilitySchemaVersionRequest{}
 
 	if computeGlobalImageCapabilitySchemaId, ok := s.D.GetOkExists("compute_global_image_capability_schema_id"); ok {
@@ -80,7 +81,7 @@ func (s *CoreComputeGlobalImageCapabilitySchemasVersionDataSourceCrud) Get() err
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetComputeGlobalImageCapabilitySchemaVersion(context.Background(), request)
+	response, err := s.Client.GetComputeGlobalImageCapabilitySchemaVersion(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreComputeGlobalImageCapabilitySchemasVersionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreComputeGlobalImageCapabilitySchemasVersions,
+		ReadContext: readCoreComputeGlobalImageCapabilitySchemasVersions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compute_global_image_capability_schema_id": {
@@ -64,12 +65,12 @@ func CoreComputeGlobalImageCapabilitySchemasVersionsDataSource() *schema.Resourc
 	}
 }
 
-func readCoreComputeGlobalImageCapabilitySchemasVersions(d *schema.ResourceData, m interface{}) error {
+func readCoreComputeGlobalImageCapabilitySchemasVersions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeGlobalImageCapabilitySchemasVersionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreComputeGlobalImageCapabilitySchemasVersionsDataSourceCrud struct {
@@ -82,7 +83,7 @@ func (s *CoreComputeGlobalImageCapabilitySchemasVersionsDataSourceCrud) VoidStat
 	s.D.SetId("")
 }
 
-func (s *CoreComputeGlobalImageCapabilitySchemasVersionsDataSourceCrud) Get() error {
+func (s *CoreComputeGlobalImageCapabilitySchemasVersionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListComputeGlobalImageCapabilitySchemaVersionsRequest{}
 
 	if computeGlobalImageCapabilitySchemaId, ok := s.D.GetOkExists("compute_global_image_capability_schema_id"); ok {
@@ -97,7 +98,7 @@ func (s *CoreComputeGlobalImageCapabilitySchemasVersionsDataSourceCrud) Get() er
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListComputeGlobalImageCapabilitySchemaVersions(context.Background(), request)
+	response, err := s.Client.ListComputeGlobalImageCapabilitySchemaVersions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -106,7 +107,7 @@ func (s *CoreComputeGlobalImageCapabilitySchemasVersionsDataSourceCrud) Get() er
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListComputeGlobalImageCapabilitySchemaVersions(context.Background(), request)
+		listResponse, err := s.Client.ListComputeGlobalImageCapabilitySchemaVersions(ctx, request)
 		if err != nil {
 			return err
 		}
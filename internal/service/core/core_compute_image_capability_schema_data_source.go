@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -27,12 +28,12 @@ func CoreComputeImageCapabilitySchemaDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreComputeImageCapabilitySchemaResource(), fieldMap, readSingularCoreComputeImageCapabilitySchema)
 }
 
-func readSingularCoreComputeImageCapabilitySchema(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreComputeImageCapabilitySchema(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeImageCapabilitySchemaDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreComputeImageCapabilitySchemaDataSourceCrud struct {
@@ -45,7 +46,7 @@ func (s *CoreComputeImageCapabilitySchemaDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreComputeImageCapabilitySchemaDataSourceCrud) Get() error {
+func (s *CoreComputeImageCapabilitySchemaDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetComputeImageCapabilitySchemaRequest{}
 
 	if computeImageCapabilitySchemaId, ok := s.D.GetOkExists("compute_image_capability_schema_id"); ok {
@@ -63,7 +64,7 @@ func (s *CoreComputeImageCapabilitySchemaDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetComputeImageCapabilitySchema(context.Background(), request)
+	response, err := s.Client.GetComputeImageCapabilitySchema(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -21,11 +22,11 @@ func CoreComputeImageCapabilitySchemaResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreComputeImageCapabilitySchema,
-		Read:     readCoreComputeImageCapabilitySchema,
-		Update:   updateCoreComputeImageCapabilitySchema,
-		Delete:   deleteCoreComputeImageCapabilitySchema,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreComputeImageCapabilitySchema,
+		ReadContext:   readCoreComputeImageCapabilitySchema,
+		UpdateContext: updateCoreComputeImageCapabilitySchema,
+		DeleteContext: deleteCoreComputeImageCapabilitySchema,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -82,37 +83,37 @@ func CoreComputeImageCapabilitySchemaResource() *schema.Resource {
 	}
 }
 
-func createCoreComputeImageCapabilitySchema(d *schema.ResourceData, m interface{}) error {
+func createCoreComputeImageCapabilitySchema(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeImageCapabilitySchemaResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreComputeImageCapabilitySchema(d *schema.ResourceData, m interface{}) error {
+func readCoreComputeImageCapabilitySchema(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeImageCapabilitySchemaResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreComputeImageCapabilitySchema(d *schema.ResourceData, m interface{}) error {
+func updateCoreComputeImageCapabilitySchema(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeImageCapabilitySchemaResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreComputeImageCapabilitySchema(d *schema.ResourceData, m interface{}) error {
+func deleteCoreComputeImageCapabilitySchema(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeImageCapabilitySchemaResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreComputeImageCapabilitySchemaResourceCrud struct {
@@ -126,7 +127,7 @@ func (s *CoreComputeImageCapabilitySchemaResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *CoreComputeImageCapabilitySchemaResourceCrud) Create() error {
+func (s *CoreComputeImageCapabilitySchemaResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateComputeImageCapabilitySchemaRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -171,7 +172,7 @@ func (s *CoreComputeImageCapabilitySchemaResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateComputeImageCapabilitySchema(context.Background(), request)
+	response, err := s.Client.CreateComputeImageCapabilitySchema(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -180,7 +181,7 @@ func (s *CoreComputeImageCapabilitySchemaResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreComputeImageCapabilitySchemaResourceCrud) Get() error {
+func (s *CoreComputeImageCapabilitySchemaResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetComputeImageCapabilitySchemaRequest{}
 
 	tmp := s.D.Id()
@@ -193,7 +194,7 @@ func (s *CoreComputeImageCapabilitySchemaResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetComputeImageCapabilitySchema(context.Background(), request)
+	response, err := s.Client.GetComputeImageCapabilitySchema(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -202,11 +203,11 @@ func (s *CoreComputeImageCapabilitySchemaResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreComputeImageCapabilitySchemaResourceCrud) Update() error {
+func (s *CoreComputeImageCapabilitySchemaResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -244,7 +245,7 @@ func (s *CoreComputeImageCapabilitySchemaResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateComputeImageCapabilitySchema(context.Background(), request)
+	response, err := s.Client.UpdateComputeImageCapabilitySchema(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -253,7 +254,7 @@ func (s *CoreComputeImageCapabilitySchemaResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreComputeImageCapabilitySchemaResourceCrud) Delete() error {
+func (s *CoreComputeImageCapabilitySchemaResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteComputeImageCapabilitySchemaRequest{}
 
 	tmp := s.D.Id()
@@ -261,7 +262,7 @@ func (s *CoreComputeImageCapabilitySchemaResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteComputeImageCapabilitySchema(context.Background(), request)
+	_, err := s.Client.DeleteComputeImageCapabilitySchema(ctx, request)
 	return err
 }
 
@@ -303,7 +304,7 @@ func (s *CoreComputeImageCapabilitySchemaResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CoreComputeImageCapabilitySchemaResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreComputeImageCapabilitySchemaResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeComputeImageCapabilitySchemaCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -314,12 +315,12 @@ func (s *CoreComputeImageCapabilitySchemaResourceCrud) updateCompartment(compart
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeComputeImageCapabilitySchemaCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeComputeImageCapabilitySchemaCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
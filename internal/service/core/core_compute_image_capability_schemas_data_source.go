@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreComputeImageCapabilitySchemasDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreComputeImageCapabilitySchemas,
+		ReadContext: readCoreComputeImageCapabilitySchemas,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,12 +40,12 @@ func CoreComputeImageCapabilitySchemasDataSource() *schema.Resource {
 	}
 }
 
-func readCoreComputeImageCapabilitySchemas(d *schema.ResourceData, m interface{}) error {
+func readCoreComputeImageCapabilitySchemas(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreComputeImageCapabilitySchemasDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreComputeImageCapabilitySchemasDataSourceCrud struct {
@@ -57,7 +58,7 @@ func (s *CoreComputeImageCapabilitySchemasDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreComputeImageCapabilitySchemasDataSourceCrud) Get() error {
+func (s *CoreComputeImageCapabilitySchemasDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListComputeImageCapabilitySchemasRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -77,7 +78,7 @@ func (s *CoreComputeImageCapabilitySchemasDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListComputeImageCapabilitySchemas(context.Background(), request)
+	response, err := s.Client.ListComputeImageCapabilitySchemas(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *CoreComputeImageCapabilitySchemasDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListComputeImageCapabilitySchemas(context.Background(), request)
+		listResponse, err := s.Client.ListComputeImageCapabilitySchemas(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreConsoleHistoriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreConsoleHistories,
+		ReadContext: readCoreConsoleHistories,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -43,12 +44,12 @@ func CoreConsoleHistoriesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreConsoleHistories(d *schema.ResourceData, m interface{}) error {
+func readCoreConsoleHistories(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreConsoleHistoriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreConsoleHistoriesDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *CoreConsoleHistoriesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreConsoleHistoriesDataSourceCrud) Get() error {
+func (s *CoreConsoleHistoriesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListConsoleHistoriesRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -85,7 +86,7 @@ func (s *CoreConsoleHistoriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListConsoleHistories(context.Background(), request)
+	response, err := s.Client.ListConsoleHistories(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *CoreConsoleHistoriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListConsoleHistories(context.Background(), request)
+		listResponse, err := s.Client.ListConsoleHistories(ctx, request)
 		if err != nil {
 			return err
 		}
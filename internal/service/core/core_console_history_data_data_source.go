@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreConsoleHistoryContentDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreConsoleHistoryContent,
+		ReadContext: readSingularCoreConsoleHistoryContent,
 		Schema: map[string]*schema.Schema{
 			"console_history_id": {
 				Type:     schema.TypeString,
@@ -38,12 +39,12 @@ func CoreConsoleHistoryContentDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreConsoleHistoryContent(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreConsoleHistoryContent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreConsoleHistoryContentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreConsoleHistoryContentDataSourceCrud struct {
@@ -56,7 +57,7 @@ func (s *CoreConsoleHistoryContentDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreConsoleHistoryContentDataSourceCrud) Get() error {
+func (s *CoreConsoleHistoryContentDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetConsoleHistoryContentRequest{}
 
 	if consoleHistoryId, ok := s.D.GetOkExists("console_history_id"); ok {
@@ -76,7 +77,7 @@ func (s *CoreConsoleHistoryContentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetConsoleHistoryContent(context.Background(), request)
+	response, err := s.Client.GetConsoleHistoryContent(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -19,11 +20,11 @@ func CoreConsoleHistoryResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreConsoleHistory,
-		Read:     readCoreConsoleHistory,
-		Update:   updateCoreConsoleHistory,
-		Delete:   deleteCoreConsoleHistory,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreConsoleHistory,
+		ReadContext:   readCoreConsoleHistory,
+		UpdateContext: updateCoreConsoleHistory,
+		DeleteContext: deleteCoreConsoleHistory,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"instance_id": {
@@ -73,37 +74,37 @@ func CoreConsoleHistoryResource() *schema.Resource {
 	}
 }
 
-func createCoreConsoleHistory(d *schema.ResourceData, m interface{}) error {
+func createCoreConsoleHistory(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreConsoleHistoryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreConsoleHistory(d *schema.ResourceData, m interface{}) error {
+func readCoreConsoleHistory(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreConsoleHistoryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreConsoleHistory(d *schema.ResourceData, m interface{}) error {
+func updateCoreConsoleHistory(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreConsoleHistoryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreConsoleHistory(d *schema.ResourceData, m interface{}) error {
+func deleteCoreConsoleHistory(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreConsoleHistoryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreConsoleHistoryResourceCrud struct {
@@ -130,7 +131,7 @@ func (s *CoreConsoleHistoryResourceCrud) CreatedTarget() []string {
 	}
 }
 
-func (s *CoreConsoleHistoryResourceCrud) Create() error {
+func (s *CoreConsoleHistoryResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CaptureConsoleHistoryRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -157,7 +158,7 @@ func (s *CoreConsoleHistoryResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CaptureConsoleHistory(context.Background(), request)
+	response, err := s.Client.CaptureConsoleHistory(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -166,7 +167,7 @@ func (s *CoreConsoleHistoryResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreConsoleHistoryResourceCrud) Get() error {
+func (s *CoreConsoleHistoryResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetConsoleHistoryRequest{}
 
 	tmp := s.D.Id()
@@ -174,7 +175,7 @@ func (s *CoreConsoleHistoryResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetConsoleHistory(context.Background(), request)
+	response, err := s.Client.GetConsoleHistory(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -183,7 +184,7 @@ func (s *CoreConsoleHistoryResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreConsoleHistoryResourceCrud) Update() error {
+func (s *CoreConsoleHistoryResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateConsoleHistoryRequest{}
 
 	tmp := s.D.Id()
@@ -208,7 +209,7 @@ func (s *CoreConsoleHistoryResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateConsoleHistory(context.Background(), request)
+	response, err := s.Client.UpdateConsoleHistory(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -217,7 +218,7 @@ func (s *CoreConsoleHistoryResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreConsoleHistoryResourceCrud) Delete() error {
+func (s *CoreConsoleHistoryResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteConsoleHistoryRequest{}
 
 	tmp := s.D.Id()
@@ -225,7 +226,7 @@ func (s *CoreConsoleHistoryResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteConsoleHistory(context.Background(), request)
+	_, err := s.Client.DeleteConsoleHistory(ctx, request)
 	return err
 }
 
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreCpeDeviceShapeDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreCpeDeviceShape,
+		ReadContext: readSingularCoreCpeDeviceShape,
 		Schema: map[string]*schema.Schema{
 			"cpe_device_shape_id": {
 				Type:     schema.TypeString,
@@ -76,12 +77,12 @@ func CoreCpeDeviceShapeDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreCpeDeviceShape(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreCpeDeviceShape(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCpeDeviceShapeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreCpeDeviceShapeDataSourceCrud struct {
@@ -94,7 +95,7 @@ func (s *CoreCpeDeviceShapeDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreCpeDeviceShapeDataSourceCrud) Get() error {
+func (s *CoreCpeDeviceShapeDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetCpeDeviceShapeRequest{}
 
 	if cpeDeviceShapeId, ok := s.D.GetOkExists("cpe_device_shape_id"); ok {
@@ -104,7 +105,7 @@ func (s *CoreCpeDeviceShapeDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetCpeDeviceShape(context.Background(), request)
+	response, err := s.Client.GetCpeDeviceShape(ctx, request)
 	if err != nil {
 		return err
 	}
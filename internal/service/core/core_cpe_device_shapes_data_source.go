@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreCpeDeviceShapesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreCpeDeviceShapes,
+		ReadContext: readCoreCpeDeviceShapes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"cpe_device_shapes": {
@@ -64,12 +65,12 @@ func CoreCpeDeviceShapesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreCpeDeviceShapes(d *schema.ResourceData, m interface{}) error {
+func readCoreCpeDeviceShapes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCpeDeviceShapesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreCpeDeviceShapesDataSourceCrud struct {
@@ -82,12 +83,12 @@ func (s *CoreCpeDeviceShapesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreCpeDeviceShapesDataSourceCrud) Get() error {
+func (s *CoreCpeDeviceShapesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListCpeDeviceShapesRequest{}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListCpeDeviceShapes(context.Background(), request)
+	response, err := s.Client.ListCpeDeviceShapes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -96,7 +97,7 @@ func (s *CoreCpeDeviceShapesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCpeDeviceShapes(context.Background(), request)
+		listResponse, err := s.Client.ListCpeDeviceShapes(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -19,11 +20,11 @@ func CoreCpeResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreCpe,
-		Read:     readCoreCpe,
-		Update:   updateCoreCpe,
-		Delete:   deleteCoreCpe,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreCpe,
+		ReadContext:   readCoreCpe,
+		UpdateContext: updateCoreCpe,
+		DeleteContext: deleteCoreCpe,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -70,37 +71,37 @@ func CoreCpeResource() *schema.Resource {
 	}
 }
 
-func createCoreCpe(d *schema.ResourceData, m interface{}) error {
+func createCoreCpe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCpeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreCpe(d *schema.ResourceData, m interface{}) error {
+func readCoreCpe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCpeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreCpe(d *schema.ResourceData, m interface{}) error {
+func updateCoreCpe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCpeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreCpe(d *schema.ResourceData, m interface{}) error {
+func deleteCoreCpe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCpeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreCpeResourceCrud struct {
@@ -114,7 +115,7 @@ func (s *CoreCpeResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *CoreCpeResourceCrud) Create() error {
+func (s *CoreCpeResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateCpeRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -151,7 +152,7 @@ func (s *CoreCpeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateCpe(context.Background(), request)
+	response, err := s.Client.CreateCpe(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -160,7 +161,7 @@ func (s *CoreCpeResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreCpeResourceCrud) Get() error {
+func (s *CoreCpeResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetCpeRequest{}
 
 	tmp := s.D.Id()
@@ -168,7 +169,7 @@ func (s *CoreCpeResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetCpe(context.Background(), request)
+	response, err := s.Client.GetCpe(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -177,11 +178,11 @@ func (s *CoreCpeResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreCpeResourceCrud) Update() error {
+func (s *CoreCpeResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -216,7 +217,7 @@ func (s *CoreCpeResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateCpe(context.Background(), request)
+	response, err := s.Client.UpdateCpe(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -225,7 +226,7 @@ func (s *CoreCpeResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreCpeResourceCrud) Delete() error {
+func (s *CoreCpeResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteCpeRequest{}
 
 	tmp := s.D.Id()
@@ -233,7 +234,7 @@ func (s *CoreCpeResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteCpe(context.Background(), request)
+	_, err := s.Client.DeleteCpe(ctx, request)
 	return err
 }
 
@@ -267,7 +268,7 @@ func (s *CoreCpeResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CoreCpeResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreCpeResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeCpeCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -278,12 +279,12 @@ func (s *CoreCpeResourceCrud) updateCompartment(compartment interface{}) error {
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeCpeCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeCpeCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
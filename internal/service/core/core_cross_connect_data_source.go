@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -22,12 +23,12 @@ func CoreCrossConnectDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreCrossConnectResource(), fieldMap, readSingularCoreCrossConnect)
 }
 
-func readSingularCoreCrossConnect(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreCrossConnect(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCrossConnectDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreCrossConnectDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *CoreCrossConnectDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreCrossConnectDataSourceCrud) Get() error {
+func (s *CoreCrossConnectDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetCrossConnectRequest{}
 
 	if crossConnectId, ok := s.D.GetOkExists("cross_connect_id"); ok {
@@ -50,7 +51,7 @@ func (s *CoreCrossConnectDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetCrossConnect(context.Background(), request)
+	response, err := s.Client.GetCrossConnect(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -22,12 +23,12 @@ func CoreCrossConnectGroupDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreCrossConnectGroupResource(), fieldMap, readSingularCoreCrossConnectGroup)
 }
 
-func readSingularCoreCrossConnectGroup(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreCrossConnectGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCrossConnectGroupDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreCrossConnectGroupDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *CoreCrossConnectGroupDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreCrossConnectGroupDataSourceCrud) Get() error {
+func (s *CoreCrossConnectGroupDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetCrossConnectGroupRequest{}
 
 	if crossConnectGroupId, ok := s.D.GetOkExists("cross_connect_group_id"); ok {
@@ -50,7 +51,7 @@ func (s *CoreCrossConnectGroupDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetCrossConnectGroup(context.Background(), request)
+	response, err := s.Client.GetCrossConnectGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -21,11 +22,11 @@ func CoreCrossConnectGroupResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreCrossConnectGroup,
-		Read:     readCoreCrossConnectGroup,
-		Update:   updateCoreCrossConnectGroup,
-		Delete:   deleteCoreCrossConnectGroup,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreCrossConnectGroup,
+		ReadContext:   readCoreCrossConnectGroup,
+		UpdateContext: updateCoreCrossConnectGroup,
+		DeleteContext: deleteCoreCrossConnectGroup,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -134,37 +135,37 @@ func CoreCrossConnectGroupResource() *schema.Resource {
 	}
 }
 
-func createCoreCrossConnectGroup(d *schema.ResourceData, m interface{}) error {
+func createCoreCrossConnectGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCrossConnectGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreCrossConnectGroup(d *schema.ResourceData, m interface{}) error {
+func readCoreCrossConnectGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCrossConnectGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreCrossConnectGroup(d *schema.ResourceData, m interface{}) error {
+func updateCoreCrossConnectGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCrossConnectGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreCrossConnectGroup(d *schema.ResourceData, m interface{}) error {
+func deleteCoreCrossConnectGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCrossConnectGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreCrossConnectGroupResourceCrud struct {
@@ -216,7 +217,7 @@ func (s *CoreCrossConnectGroupResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *CoreCrossConnectGroupResourceCrud) Create() error {
+func (s *CoreCrossConnectGroupResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateCrossConnectGroupRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -259,7 +260,7 @@ func (s *CoreCrossConnectGroupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateCrossConnectGroup(context.Background(), request)
+	response, err := s.Client.CreateCrossConnectGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -268,7 +269,7 @@ func (s *CoreCrossConnectGroupResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreCrossConnectGroupResourceCrud) Get() error {
+func (s *CoreCrossConnectGroupResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetCrossConnectGroupRequest{}
 
 	tmp := s.D.Id()
@@ -276,7 +277,7 @@ func (s *CoreCrossConnectGroupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetCrossConnectGroup(context.Background(), request)
+	response, err := s.Client.GetCrossConnectGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -285,11 +286,11 @@ func (s *CoreCrossConnectGroupResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreCrossConnectGroupResourceCrud) Update() error {
+func (s *CoreCrossConnectGroupResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -335,7 +336,7 @@ func (s *CoreCrossConnectGroupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateCrossConnectGroup(context.Background(), request)
+	response, err := s.Client.UpdateCrossConnectGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -344,7 +345,7 @@ func (s *CoreCrossConnectGroupResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreCrossConnectGroupResourceCrud) Delete() error {
+func (s *CoreCrossConnectGroupResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteCrossConnectGroupRequest{}
 
 	tmp := s.D.Id()
@@ -352,7 +353,7 @@ func (s *CoreCrossConnectGroupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteCrossConnectGroup(context.Background(), request)
+	_, err := s.Client.DeleteCrossConnectGroup(ctx, request)
 	return err
 }
 
@@ -498,7 +499,7 @@ func (s *CoreCrossConnectGroupResourceCrud) mapToUpdateMacsecProperties(fieldKey
 	return result, nil
 }
 
-func (s *CoreCrossConnectGroupResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreCrossConnectGroupResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeCrossConnectGroupCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -509,12 +510,12 @@ func (s *CoreCrossConnectGroupResourceCrud) updateCompartment(compartment interf
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeCrossConnectGroupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeCrossConnectGroupCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
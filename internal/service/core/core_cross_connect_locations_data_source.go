@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreCrossConnectLocationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreCrossConnectLocations,
+		ReadContext: readCoreCrossConnectLocations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -47,12 +48,12 @@ func CoreCrossConnectLocationsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreCrossConnectLocations(d *schema.ResourceData, m interface{}) error {
+func readCoreCrossConnectLocations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCrossConnectLocationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreCrossConnectLocationsDataSourceCrud struct {
@@ -65,7 +66,7 @@ func (s *CoreCrossConnectLocationsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreCrossConnectLocationsDataSourceCrud) Get() error {
+func (s *CoreCrossConnectLocationsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListCrossConnectLocationsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -75,7 +76,7 @@ func (s *CoreCrossConnectLocationsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListCrossConnectLocations(context.Background(), request)
+	response, err := s.Client.ListCrossConnectLocations(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -84,7 +85,7 @@ func (s *CoreCrossConnectLocationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCrossConnectLocations(context.Background(), request)
+		listResponse, err := s.Client.ListCrossConnectLocations(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreCrossConnectPortSpeedShapesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreCrossConnectPortSpeedShapes,
+		ReadContext: readCoreCrossConnectPortSpeedShapes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -47,12 +48,12 @@ func CoreCrossConnectPortSpeedShapesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreCrossConnectPortSpeedShapes(d *schema.ResourceData, m interface{}) error {
+func readCoreCrossConnectPortSpeedShapes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCrossConnectPortSpeedShapesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreCrossConnectPortSpeedShapesDataSourceCrud struct {
@@ -65,7 +66,7 @@ func (s *CoreCrossConnectPortSpeedShapesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreCrossConnectPortSpeedShapesDataSourceCrud) Get() error {
+func (s *CoreCrossConnectPortSpeedShapesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListCrossconnectPortSpeedShapesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -75,7 +76,7 @@ func (s *CoreCrossConnectPortSpeedShapesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListCrossconnectPortSpeedShapes(context.Background(), request)
+	response, err := s.Client.ListCrossconnectPortSpeedShapes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -84,7 +85,7 @@ func (s *CoreCrossConnectPortSpeedShapesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCrossconnectPortSpeedShapes(context.Background(), request)
+		listResponse, err := s.Client.ListCrossconnectPortSpeedShapes(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -22,11 +23,11 @@ func CoreCrossConnectResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreCrossConnect,
-		Read:     readCoreCrossConnect,
-		Update:   updateCoreCrossConnect,
-		Delete:   deleteCoreCrossConnect,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreCrossConnect,
+		ReadContext:   readCoreCrossConnect,
+		UpdateContext: updateCoreCrossConnect,
+		DeleteContext: deleteCoreCrossConnect,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -174,48 +175,48 @@ func CoreCrossConnectResource() *schema.Resource {
 	}
 }
 
-func createCoreCrossConnect(d *schema.ResourceData, m interface{}) error {
+func createCoreCrossConnect(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCrossConnectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	err := tfresource.CreateResource(d, sync)
+	err := tfresource.CreateResource(ctx, d, sync)
 	if err != nil {
-		return err
+		return tfresource.HandleDiagError(err)
 	}
 
 	// Issue an Update if 'is_active' is set to true
 	if _, ok := sync.D.GetOkExists("is_active"); ok {
 		log.Printf("[DEBUG] CrossConnect resource is set to be active, calling 'Update' for the resource")
-		return tfresource.UpdateResource(d, sync)
+		return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 	}
 
 	return nil
 }
 
-func readCoreCrossConnect(d *schema.ResourceData, m interface{}) error {
+func readCoreCrossConnect(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCrossConnectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreCrossConnect(d *schema.ResourceData, m interface{}) error {
+func updateCoreCrossConnect(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCrossConnectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreCrossConnect(d *schema.ResourceData, m interface{}) error {
+func deleteCoreCrossConnect(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCrossConnectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreCrossConnectResourceCrud struct {
@@ -274,7 +275,7 @@ func (s *CoreCrossConnectResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *CoreCrossConnectResourceCrud) Create() error {
+func (s *CoreCrossConnectResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateCrossConnectRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -342,7 +343,7 @@ func (s *CoreCrossConnectResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateCrossConnect(context.Background(), request)
+	response, err := s.Client.CreateCrossConnect(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -351,7 +352,7 @@ func (s *CoreCrossConnectResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreCrossConnectResourceCrud) Get() error {
+func (s *CoreCrossConnectResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetCrossConnectRequest{}
 
 	tmp := s.D.Id()
@@ -359,7 +360,7 @@ func (s *CoreCrossConnectResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetCrossConnect(context.Background(), request)
+	response, err := s.Client.GetCrossConnect(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -368,11 +369,11 @@ func (s *CoreCrossConnectResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreCrossConnectResourceCrud) Update() error {
+func (s *CoreCrossConnectResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -427,7 +428,7 @@ func (s *CoreCrossConnectResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateCrossConnect(context.Background(), request)
+	response, err := s.Client.UpdateCrossConnect(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -436,7 +437,7 @@ func (s *CoreCrossConnectResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreCrossConnectResourceCrud) Delete() error {
+func (s *CoreCrossConnectResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteCrossConnectRequest{}
 
 	tmp := s.D.Id()
@@ -444,7 +445,7 @@ func (s *CoreCrossConnectResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteCrossConnect(context.Background(), request)
+	_, err := s.Client.DeleteCrossConnect(ctx, request)
 	return err
 }
 
@@ -642,7 +643,7 @@ func MacsecPropertiesToMap(obj *oci_core.MacsecProperties) map[string]interface{
 	return result
 }
 
-func (s *CoreCrossConnectResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreCrossConnectResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeCrossConnectCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -653,12 +654,12 @@ func (s *CoreCrossConnectResourceCrud) updateCompartment(compartment interface{}
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeCrossConnectCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeCrossConnectCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
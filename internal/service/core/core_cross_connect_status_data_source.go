@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreCrossConnectStatusDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreCrossConnectStatus,
+		ReadContext: readSingularCoreCrossConnectStatus,
 		Schema: map[string]*schema.Schema{
 			"cross_connect_id": {
 				Type:     schema.TypeString,
@@ -42,12 +43,12 @@ func CoreCrossConnectStatusDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreCrossConnectStatus(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreCrossConnectStatus(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreCrossConnectStatusDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreCrossConnectStatusDataSourceCrud struct {
@@ -60,7 +61,7 @@ func (s *CoreCrossConnectStatusDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreCrossConnectStatusDataSourceCrud) Get() error {
+func (s *CoreCrossConnectStatusDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetCrossConnectStatusRequest{}
 
 	if crossConnectId, ok := s.D.GetOkExists("cross_connect_id"); ok {
@@ -70,7 +71,7 @@ func (s *CoreCrossConnectStatusDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetCrossConnectStatus(context.Background(), request)
+	response, err := s.Client.GetCrossConnectStatus(ctx, request)
 	if err != nil {
 		return err
 	}
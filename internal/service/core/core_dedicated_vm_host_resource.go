@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -20,11 +21,11 @@ func CoreDedicatedVmHostResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreDedicatedVmHost,
-		Read:     readCoreDedicatedVmHost,
-		Update:   updateCoreDedicatedVmHost,
-		Delete:   deleteCoreDedicatedVmHost,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreDedicatedVmHost,
+		ReadContext:   readCoreDedicatedVmHost,
+		UpdateContext: updateCoreDedicatedVmHost,
+		DeleteContext: deleteCoreDedicatedVmHost,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"availability_domain": {
@@ -98,40 +99,40 @@ func CoreDedicatedVmHostResource() *schema.Resource {
 	}
 }
 
-func createCoreDedicatedVmHost(d *schema.ResourceData, m interface{}) error {
+func createCoreDedicatedVmHost(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDedicatedVmHostResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreDedicatedVmHost(d *schema.ResourceData, m interface{}) error {
+func readCoreDedicatedVmHost(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDedicatedVmHostResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreDedicatedVmHost(d *schema.ResourceData, m interface{}) error {
+func updateCoreDedicatedVmHost(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDedicatedVmHostResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreDedicatedVmHost(d *schema.ResourceData, m interface{}) error {
+func deleteCoreDedicatedVmHost(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDedicatedVmHostResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.DisableNotFoundRetries = true
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreDedicatedVmHostResourceCrud struct {
@@ -171,7 +172,7 @@ func (s *CoreDedicatedVmHostResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreDedicatedVmHostResourceCrud) Create() error {
+func (s *CoreDedicatedVmHostResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateDedicatedVmHostRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -213,7 +214,7 @@ func (s *CoreDedicatedVmHostResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateDedicatedVmHost(context.Background(), request)
+	response, err := s.Client.CreateDedicatedVmHost(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -222,7 +223,7 @@ func (s *CoreDedicatedVmHostResourceCrud) Create() error {
 	s.Res = &response.DedicatedVmHost
 
 	if workId != nil {
-		identifier, err := tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "dedicatedvmhost", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		identifier, err := tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "dedicatedvmhost", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if identifier != nil {
 			s.D.SetId(*identifier)
 		}
@@ -231,10 +232,10 @@ func (s *CoreDedicatedVmHostResourceCrud) Create() error {
 		}
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *CoreDedicatedVmHostResourceCrud) Get() error {
+func (s *CoreDedicatedVmHostResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetDedicatedVmHostRequest{}
 
 	tmp := s.D.Id()
@@ -242,7 +243,7 @@ func (s *CoreDedicatedVmHostResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetDedicatedVmHost(context.Background(), request)
+	response, err := s.Client.GetDedicatedVmHost(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -251,11 +252,11 @@ func (s *CoreDedicatedVmHostResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreDedicatedVmHostResourceCrud) Update() error {
+func (s *CoreDedicatedVmHostResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -285,7 +286,7 @@ func (s *CoreDedicatedVmHostResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateDedicatedVmHost(context.Background(), request)
+	response, err := s.Client.UpdateDedicatedVmHost(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -294,7 +295,7 @@ func (s *CoreDedicatedVmHostResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreDedicatedVmHostResourceCrud) Delete() error {
+func (s *CoreDedicatedVmHostResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteDedicatedVmHostRequest{}
 
 	tmp := s.D.Id()
@@ -302,20 +303,20 @@ func (s *CoreDedicatedVmHostResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.DeleteDedicatedVmHost(context.Background(), request)
+	response, err := s.Client.DeleteDedicatedVmHost(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "dedicatedvmhost", oci_work_requests.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "dedicatedvmhost", oci_work_requests.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func (s *CoreDedicatedVmHostResourceCrud) SetData() error {
@@ -370,7 +371,7 @@ func (s *CoreDedicatedVmHostResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CoreDedicatedVmHostResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreDedicatedVmHostResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeDedicatedVmHostCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -381,14 +382,14 @@ func (s *CoreDedicatedVmHostResourceCrud) updateCompartment(compartment interfac
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.ChangeDedicatedVmHostCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeDedicatedVmHostCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "dedicatedvmhost", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "dedicatedvmhost", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
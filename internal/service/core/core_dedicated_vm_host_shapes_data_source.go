@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreDedicatedVmHostShapesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreDedicatedVmHostShapes,
+		ReadContext: readCoreDedicatedVmHostShapes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -55,12 +56,12 @@ func CoreDedicatedVmHostShapesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreDedicatedVmHostShapes(d *schema.ResourceData, m interface{}) error {
+func readCoreDedicatedVmHostShapes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDedicatedVmHostShapesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreDedicatedVmHostShapesDataSourceCrud struct {
@@ -73,7 +74,7 @@ func (s *CoreDedicatedVmHostShapesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreDedicatedVmHostShapesDataSourceCrud) Get() error {
+func (s *CoreDedicatedVmHostShapesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListDedicatedVmHostShapesRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -93,7 +94,7 @@ func (s *CoreDedicatedVmHostShapesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListDedicatedVmHostShapes(context.Background(), request)
+	response, err := s.Client.ListDedicatedVmHostShapes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -102,7 +103,7 @@ func (s *CoreDedicatedVmHostShapesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDedicatedVmHostShapes(context.Background(), request)
+		listResponse, err := s.Client.ListDedicatedVmHostShapes(ctx, request)
 		if err != nil {
 			return err
 		}
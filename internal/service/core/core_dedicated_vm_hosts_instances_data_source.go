@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreDedicatedVmHostsInstancesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreDedicatedVmHostsInstances,
+		ReadContext: readCoreDedicatedVmHostsInstances,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -67,12 +68,12 @@ func CoreDedicatedVmHostsInstancesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreDedicatedVmHostsInstances(d *schema.ResourceData, m interface{}) error {
+func readCoreDedicatedVmHostsInstances(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDedicatedVmHostsInstancesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreDedicatedVmHostsInstancesDataSourceCrud struct {
@@ -85,7 +86,7 @@ func (s *CoreDedicatedVmHostsInstancesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreDedicatedVmHostsInstancesDataSourceCrud) Get() error {
+func (s *CoreDedicatedVmHostsInstancesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListDedicatedVmHostInstancesRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -105,7 +106,7 @@ func (s *CoreDedicatedVmHostsInstancesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListDedicatedVmHostInstances(context.Background(), request)
+	response, err := s.Client.ListDedicatedVmHostInstances(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -114,7 +115,7 @@ func (s *CoreDedicatedVmHostsInstancesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDedicatedVmHostInstances(context.Background(), request)
+		listResponse, err := s.Client.ListDedicatedVmHostInstances(ctx, request)
 		if err != nil {
 			return err
 		}
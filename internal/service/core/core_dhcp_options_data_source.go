@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreDhcpOptionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreDhcpOptionsList,
+		ReadContext: readCoreDhcpOptionsList,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,12 +44,12 @@ func CoreDhcpOptionsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreDhcpOptionsList(d *schema.ResourceData, m interface{}) error {
+func readCoreDhcpOptionsList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDhcpOptionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreDhcpOptionsDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *CoreDhcpOptionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreDhcpOptionsDataSourceCrud) Get() error {
+func (s *CoreDhcpOptionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListDhcpOptionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -85,7 +86,7 @@ func (s *CoreDhcpOptionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListDhcpOptions(context.Background(), request)
+	response, err := s.Client.ListDhcpOptions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *CoreDhcpOptionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDhcpOptions(context.Background(), request)
+		listResponse, err := s.Client.ListDhcpOptions(ctx, request)
 		if err != nil {
 			return err
 		}
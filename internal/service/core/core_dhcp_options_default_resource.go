@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -19,8 +20,8 @@ import (
 func DefaultCoreDhcpOptionsResource() *schema.Resource {
 	defaultResourceSchema := ConvertToDefaultVcnResourceSchema(CoreDhcpOptionsResource())
 
-	defaultResourceSchema.Create = createDefaultDhcpOptions
-	defaultResourceSchema.Delete = deleteDefaultDhcpOptions
+	defaultResourceSchema.CreateContext = createDefaultDhcpOptions
+	defaultResourceSchema.DeleteContext = deleteDefaultDhcpOptions
 
 	return defaultResourceSchema
 }
@@ -29,28 +30,28 @@ type DefaultDhcpOptionsResourceCrud struct {
 	CoreDhcpOptionsResourceCrud
 }
 
-func createDefaultDhcpOptions(d *schema.ResourceData, m interface{}) error {
+func createDefaultDhcpOptions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DefaultDhcpOptionsResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func deleteDefaultDhcpOptions(d *schema.ResourceData, m interface{}) error {
+func deleteDefaultDhcpOptions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DefaultDhcpOptionsResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
-func (s *DefaultDhcpOptionsResourceCrud) Create() error {
+func (s *DefaultDhcpOptionsResourceCrud) Create(ctx context.Context) error {
 	// If we are creating a default resource, then don't have to
 	// actually Create it. Just set the ID and Update it.
 	if defaultId, ok := s.D.GetOkExists("manage_default_resource_id"); ok {
 		s.D.SetId(defaultId.(string))
-		return s.Update()
+		return s.Update(ctx)
 	}
 
 	return fmt.Errorf("Default resource does not have a manage_default_resource_id set")
@@ -58,7 +59,7 @@ func (s *DefaultDhcpOptionsResourceCrud) Create() error {
 
 // This creates a DHCP option with no dns servers
 // This is used to clear out default DHCP options resources that can't otherwise be deleted
-func (s *DefaultDhcpOptionsResourceCrud) reset() error {
+func (s *DefaultDhcpOptionsResourceCrud) reset(ctx context.Context) error {
 	request := oci_core.UpdateDhcpOptionsRequest{}
 
 	tmp := s.D.Id()
@@ -73,7 +74,7 @@ func (s *DefaultDhcpOptionsResourceCrud) reset() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateDhcpOptions(context.Background(), request)
+	response, err := s.Client.UpdateDhcpOptions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -82,11 +83,11 @@ func (s *DefaultDhcpOptionsResourceCrud) reset() error {
 	return nil
 }
 
-func (s *DefaultDhcpOptionsResourceCrud) Delete() error {
+func (s *DefaultDhcpOptionsResourceCrud) Delete(ctx context.Context) error {
 	if _, ok := s.D.GetOkExists("manage_default_resource_id"); ok {
 		// We can't actually delete a default resource.
 		// Clear out its settings and mark it as deleted.
-		err := s.reset()
+		err := s.reset(ctx)
 		s.D.Set("state", s.DeletedTarget()[0])
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -25,11 +26,11 @@ func CoreDhcpOptionsResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreDhcpOptions,
-		Read:     readCoreDhcpOptions,
-		Update:   updateCoreDhcpOptions,
-		Delete:   deleteCoreDhcpOptions,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreDhcpOptions,
+		ReadContext:   readCoreDhcpOptions,
+		UpdateContext: updateCoreDhcpOptions,
+		DeleteContext: deleteCoreDhcpOptions,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -131,37 +132,37 @@ func CoreDhcpOptionsResource() *schema.Resource {
 	}
 }
 
-func createCoreDhcpOptions(d *schema.ResourceData, m interface{}) error {
+func createCoreDhcpOptions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDhcpOptionsResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreDhcpOptions(d *schema.ResourceData, m interface{}) error {
+func readCoreDhcpOptions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDhcpOptionsResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreDhcpOptions(d *schema.ResourceData, m interface{}) error {
+func updateCoreDhcpOptions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDhcpOptionsResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreDhcpOptions(d *schema.ResourceData, m interface{}) error {
+func deleteCoreDhcpOptions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDhcpOptionsResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreDhcpOptionsResourceCrud struct {
@@ -199,7 +200,7 @@ func (s *CoreDhcpOptionsResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreDhcpOptionsResourceCrud) Create() error {
+func (s *CoreDhcpOptionsResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateDhcpOptionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -253,7 +254,7 @@ func (s *CoreDhcpOptionsResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateDhcpOptions(context.Background(), request)
+	response, err := s.Client.CreateDhcpOptions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -265,7 +266,7 @@ func (s *CoreDhcpOptionsResourceCrud) Create() error {
 	updateRequest.DhcpId = s.Res.Id
 	updateRequest.Options = request.Options
 	updateRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
-	updateResponse, err := s.Client.UpdateDhcpOptions(context.Background(), updateRequest)
+	updateResponse, err := s.Client.UpdateDhcpOptions(ctx, updateRequest)
 	if err != nil {
 		log.Printf("[ERROR] Could not perform an Update right after the Create of the dhcpOptions: %v", err)
 	}
@@ -274,7 +275,7 @@ func (s *CoreDhcpOptionsResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreDhcpOptionsResourceCrud) Get() error {
+func (s *CoreDhcpOptionsResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetDhcpOptionsRequest{}
 
 	tmp := s.D.Id()
@@ -282,7 +283,7 @@ func (s *CoreDhcpOptionsResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetDhcpOptions(context.Background(), request)
+	response, err := s.Client.GetDhcpOptions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -291,11 +292,11 @@ func (s *CoreDhcpOptionsResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreDhcpOptionsResourceCrud) Update() error {
+func (s *CoreDhcpOptionsResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -347,7 +348,7 @@ func (s *CoreDhcpOptionsResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateDhcpOptions(context.Background(), request)
+	response, err := s.Client.UpdateDhcpOptions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -356,7 +357,7 @@ func (s *CoreDhcpOptionsResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreDhcpOptionsResourceCrud) Delete() error {
+func (s *CoreDhcpOptionsResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteDhcpOptionsRequest{}
 
 	tmp := s.D.Id()
@@ -364,7 +365,7 @@ func (s *CoreDhcpOptionsResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteDhcpOptions(context.Background(), request)
+	_, err := s.Client.DeleteDhcpOptions(ctx, request)
 	return err
 }
 
@@ -503,7 +504,7 @@ func optionsHashCodeForSets(v interface{}) int {
 	}
 	return utils.GetStringHashcode(buf.String())
 }
-func (s *CoreDhcpOptionsResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreDhcpOptionsResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeDhcpOptionsCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -514,12 +515,12 @@ func (s *CoreDhcpOptionsResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeDhcpOptionsCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeDhcpOptionsCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
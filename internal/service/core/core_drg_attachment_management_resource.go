@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -22,11 +23,11 @@ func CoreDrgAttachmentManagementResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreDrgAttachmentManagement,
-		Read:     readCoreDrgAttachmentManagement,
-		Update:   updateCoreDrgAttachmentManagement,
-		Delete:   deleteCoreDrgAttachmentManagement,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreDrgAttachmentManagement,
+		ReadContext:   readCoreDrgAttachmentManagement,
+		UpdateContext: updateCoreDrgAttachmentManagement,
+		DeleteContext: deleteCoreDrgAttachmentManagement,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"attachment_type": {
@@ -152,50 +153,50 @@ func CoreDrgAttachmentManagementResource() *schema.Resource {
 	}
 }
 
-func createCoreDrgAttachmentManagement(d *schema.ResourceData, m interface{}) error {
+func createCoreDrgAttachmentManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgAttachmentManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	err := sync.Get()
+	err := sync.Get(ctx)
 	if err != nil {
-		return err
+		return tfresource.HandleDiagError(err)
 	}
 
-	return updateCoreDrgAttachmentManagement(d, m)
+	return updateCoreDrgAttachmentManagement(ctx, d, m)
 }
 
-func readCoreDrgAttachmentManagement(d *schema.ResourceData, m interface{}) error {
+func readCoreDrgAttachmentManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgAttachmentManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreDrgAttachmentManagement(d *schema.ResourceData, m interface{}) error {
+func updateCoreDrgAttachmentManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgAttachmentManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
 	if _, ok := sync.D.GetOkExists("remove_export_drg_route_distribution_trigger"); ok &&
 		sync.D.HasChange("remove_export_drg_route_distribution_trigger") {
-		err := sync.removeExportDrgRouteDistribution()
+		err := sync.removeExportDrgRouteDistribution(ctx)
 		if err != nil {
-			return err
+			return tfresource.HandleDiagError(err)
 		}
 	}
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreDrgAttachmentManagement(d *schema.ResourceData, m interface{}) error {
+func deleteCoreDrgAttachmentManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgAttachmentManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreDrgAttachmentManagementResourceCrud struct {
@@ -221,7 +222,7 @@ func (s *CoreDrgAttachmentManagementResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *CoreDrgAttachmentManagementResourceCrud) Get() error {
+func (s *CoreDrgAttachmentManagementResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListDrgAttachmentsRequest{}
 
 	if attachmentType, ok := s.D.GetOkExists("attachment_type"); ok {
@@ -240,7 +241,7 @@ func (s *CoreDrgAttachmentManagementResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListDrgAttachments(context.Background(), request)
+	response, err := s.Client.ListDrgAttachments(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -257,7 +258,7 @@ func (s *CoreDrgAttachmentManagementResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreDrgAttachmentManagementResourceCrud) Update() error {
+func (s *CoreDrgAttachmentManagementResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateDrgAttachmentRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok && s.D.HasChange("defined_tags") {
@@ -310,7 +311,7 @@ func (s *CoreDrgAttachmentManagementResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateDrgAttachment(context.Background(), request)
+	response, err := s.Client.UpdateDrgAttachment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -319,7 +320,7 @@ func (s *CoreDrgAttachmentManagementResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreDrgAttachmentManagementResourceCrud) Delete() error {
+func (s *CoreDrgAttachmentManagementResourceCrud) Delete(ctx context.Context) error {
 	return nil
 }
 
@@ -443,7 +444,7 @@ func (s *CoreDrgAttachmentManagementResourceCrud) mapToDrgAttachmentNetworkUpdat
 	return baseObject, nil
 }
 
-func (s *CoreDrgAttachmentManagementResourceCrud) removeExportDrgRouteDistribution() error {
+func (s *CoreDrgAttachmentManagementResourceCrud) removeExportDrgRouteDistribution(ctx context.Context) error {
 	request := oci_core.RemoveExportDrgRouteDistributionRequest{}
 
 	tmp := s.D.Id()
@@ -451,7 +452,7 @@ func (s *CoreDrgAttachmentManagementResourceCrud) removeExportDrgRouteDistributi
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.RemoveExportDrgRouteDistribution(context.Background(), request)
+	response, err := s.Client.RemoveExportDrgRouteDistribution(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -23,11 +24,11 @@ func CoreDrgAttachmentResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreDrgAttachment,
-		Read:     readCoreDrgAttachment,
-		Update:   updateCoreDrgAttachment,
-		Delete:   deleteCoreDrgAttachment,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreDrgAttachment,
+		ReadContext:   readCoreDrgAttachment,
+		UpdateContext: updateCoreDrgAttachment,
+		DeleteContext: deleteCoreDrgAttachment,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"drg_id": {
@@ -143,60 +144,60 @@ func CoreDrgAttachmentResource() *schema.Resource {
 	}
 }
 
-func createCoreDrgAttachment(d *schema.ResourceData, m interface{}) error {
+func createCoreDrgAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	if e := tfresource.CreateResource(d, sync); e != nil {
-		return e
+	if e := tfresource.CreateResource(ctx, d, sync); e != nil {
+		return tfresource.HandleDiagError(e)
 	}
 
 	if _, ok := sync.D.GetOkExists("remove_export_drg_route_distribution_trigger"); ok {
-		err := sync.removeExportDrgRouteDistribution()
+		err := sync.removeExportDrgRouteDistribution(ctx)
 		if err != nil {
-			return err
+			return tfresource.HandleDiagError(err)
 		}
 	}
 
 	if _, ok := sync.D.GetOkExists("export_drg_route_distribution_id"); ok && sync.D.HasChange("export_drg_route_distribution_id") {
-		return sync.Update()
+		return tfresource.HandleDiagError(sync.Update(ctx))
 	}
 
 	return nil
 }
 
-func readCoreDrgAttachment(d *schema.ResourceData, m interface{}) error {
+func readCoreDrgAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreDrgAttachment(d *schema.ResourceData, m interface{}) error {
+func updateCoreDrgAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
 	if _, ok := sync.D.GetOkExists("remove_export_drg_route_distribution_trigger"); ok &&
 		sync.D.HasChange("remove_export_drg_route_distribution_trigger") {
-		err := sync.removeExportDrgRouteDistribution()
+		err := sync.removeExportDrgRouteDistribution(ctx)
 		if err != nil {
-			return err
+			return tfresource.HandleDiagError(err)
 		}
 	}
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreDrgAttachment(d *schema.ResourceData, m interface{}) error {
+func deleteCoreDrgAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreDrgAttachmentResourceCrud struct {
@@ -234,7 +235,7 @@ func (s *CoreDrgAttachmentResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreDrgAttachmentResourceCrud) Create() error {
+func (s *CoreDrgAttachmentResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateDrgAttachmentRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -287,7 +288,7 @@ func (s *CoreDrgAttachmentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateDrgAttachment(context.Background(), request)
+	response, err := s.Client.CreateDrgAttachment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -297,7 +298,7 @@ func (s *CoreDrgAttachmentResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreDrgAttachmentResourceCrud) Get() error {
+func (s *CoreDrgAttachmentResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetDrgAttachmentRequest{}
 
 	tmp := s.D.Id()
@@ -305,7 +306,7 @@ func (s *CoreDrgAttachmentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetDrgAttachment(context.Background(), request)
+	response, err := s.Client.GetDrgAttachment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -314,7 +315,7 @@ func (s *CoreDrgAttachmentResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreDrgAttachmentResourceCrud) Update() error {
+func (s *CoreDrgAttachmentResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateDrgAttachmentRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok && s.D.HasChange("defined_tags") {
@@ -367,7 +368,7 @@ func (s *CoreDrgAttachmentResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateDrgAttachment(context.Background(), request)
+	response, err := s.Client.UpdateDrgAttachment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -376,7 +377,7 @@ func (s *CoreDrgAttachmentResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreDrgAttachmentResourceCrud) Delete() error {
+func (s *CoreDrgAttachmentResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteDrgAttachmentRequest{}
 
 	tmp := s.D.Id()
@@ -384,7 +385,7 @@ func (s *CoreDrgAttachmentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteDrgAttachment(context.Background(), request)
+	_, err := s.Client.DeleteDrgAttachment(ctx, request)
 	return err
 }
 
@@ -531,7 +532,7 @@ func DrgAttachmentNetworkDetailsToMap(obj *oci_core.DrgAttachmentNetworkDetails)
 	return result
 }
 
-func (s *CoreDrgAttachmentResourceCrud) removeExportDrgRouteDistribution() error {
+func (s *CoreDrgAttachmentResourceCrud) removeExportDrgRouteDistribution(ctx context.Context) error {
 	request := oci_core.RemoveExportDrgRouteDistributionRequest{}
 
 	tmp := s.D.Id()
@@ -539,7 +540,7 @@ func (s *CoreDrgAttachmentResourceCrud) removeExportDrgRouteDistribution() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.RemoveExportDrgRouteDistribution(context.Background(), request)
+	response, err := s.Client.RemoveExportDrgRouteDistribution(ctx, request)
 	if err != nil {
 		return err
 	}
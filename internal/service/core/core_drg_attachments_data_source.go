@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreDrgAttachmentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreDrgAttachments,
+		ReadContext: readCoreDrgAttachments,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"attachment_type": {
@@ -59,12 +60,12 @@ func CoreDrgAttachmentsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreDrgAttachments(d *schema.ResourceData, m interface{}) error {
+func readCoreDrgAttachments(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgAttachmentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreDrgAttachmentsDataSourceCrud struct {
@@ -77,7 +78,7 @@ func (s *CoreDrgAttachmentsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreDrgAttachmentsDataSourceCrud) Get() error {
+func (s *CoreDrgAttachmentsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListDrgAttachmentsRequest{}
 
 	if attachmentType, ok := s.D.GetOkExists("attachment_type"); ok {
@@ -120,7 +121,7 @@ func (s *CoreDrgAttachmentsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListDrgAttachments(context.Background(), request)
+	response, err := s.Client.ListDrgAttachments(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -129,7 +130,7 @@ func (s *CoreDrgAttachmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDrgAttachments(context.Background(), request)
+		listResponse, err := s.Client.ListDrgAttachments(ctx, request)
 		if err != nil {
 			return err
 		}
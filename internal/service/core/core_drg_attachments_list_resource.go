@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -16,10 +17,10 @@ import (
 
 func CoreDrgAttachmentsListResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreDrgAttachmentsList,
-		Read:     readCoreDrgAttachmentsList,
-		Delete:   deleteCoreDrgAttachmentsList,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreDrgAttachmentsList,
+		ReadContext:   readCoreDrgAttachmentsList,
+		DeleteContext: deleteCoreDrgAttachmentsList,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"drg_id": {
@@ -57,19 +58,19 @@ func CoreDrgAttachmentsListResource() *schema.Resource {
 	}
 }
 
-func createCoreDrgAttachmentsList(d *schema.ResourceData, m interface{}) error {
+func createCoreDrgAttachmentsList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgAttachmentsListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreDrgAttachmentsList(d *schema.ResourceData, m interface{}) error {
+func readCoreDrgAttachmentsList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteCoreDrgAttachmentsList(d *schema.ResourceData, m interface{}) error {
+func deleteCoreDrgAttachmentsList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -84,7 +85,7 @@ func (s *CoreDrgAttachmentsListResourceCrud) ID() string {
 	return tfresource.GenerateDataSourceHashID("CoreDrgAttachmentsListResource-", CoreDrgAttachmentsListResource(), s.D)
 }
 
-func (s *CoreDrgAttachmentsListResourceCrud) Create() error {
+func (s *CoreDrgAttachmentsListResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.GetAllDrgAttachmentsRequest{}
 
 	if attachmentType, ok := s.D.GetOkExists("attachment_type"); ok {
@@ -103,7 +104,7 @@ func (s *CoreDrgAttachmentsListResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetAllDrgAttachments(context.Background(), request)
+	response, err := s.Client.GetAllDrgAttachments(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -113,7 +114,7 @@ func (s *CoreDrgAttachmentsListResourceCrud) Create() error {
 	request.Page = response.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.GetAllDrgAttachments(context.Background(), request)
+		listResponse, err := s.Client.GetAllDrgAttachments(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -22,12 +23,12 @@ func CoreDrgRouteDistributionDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreDrgRouteDistributionResource(), fieldMap, readSingularCoreDrgRouteDistribution)
 }
 
-func readSingularCoreDrgRouteDistribution(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreDrgRouteDistribution(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteDistributionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreDrgRouteDistributionDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *CoreDrgRouteDistributionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreDrgRouteDistributionDataSourceCrud) Get() error {
+func (s *CoreDrgRouteDistributionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetDrgRouteDistributionRequest{}
 
 	if drgRouteDistributionId, ok := s.D.GetOkExists("drg_route_distribution_id"); ok {
@@ -50,7 +51,7 @@ func (s *CoreDrgRouteDistributionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetDrgRouteDistribution(context.Background(), request)
+	response, err := s.Client.GetDrgRouteDistribution(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -19,11 +20,11 @@ func CoreDrgRouteDistributionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreDrgRouteDistribution,
-		Read:     readCoreDrgRouteDistribution,
-		Update:   updateCoreDrgRouteDistribution,
-		Delete:   deleteCoreDrgRouteDistribution,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreDrgRouteDistribution,
+		ReadContext:   readCoreDrgRouteDistribution,
+		UpdateContext: updateCoreDrgRouteDistribution,
+		DeleteContext: deleteCoreDrgRouteDistribution,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"distribution_type": {
@@ -74,37 +75,37 @@ func CoreDrgRouteDistributionResource() *schema.Resource {
 	}
 }
 
-func createCoreDrgRouteDistribution(d *schema.ResourceData, m interface{}) error {
+func createCoreDrgRouteDistribution(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteDistributionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreDrgRouteDistribution(d *schema.ResourceData, m interface{}) error {
+func readCoreDrgRouteDistribution(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteDistributionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreDrgRouteDistribution(d *schema.ResourceData, m interface{}) error {
+func updateCoreDrgRouteDistribution(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteDistributionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreDrgRouteDistribution(d *schema.ResourceData, m interface{}) error {
+func deleteCoreDrgRouteDistribution(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteDistributionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreDrgRouteDistributionResourceCrud struct {
@@ -142,7 +143,7 @@ func (s *CoreDrgRouteDistributionResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreDrgRouteDistributionResourceCrud) Create() error {
+func (s *CoreDrgRouteDistributionResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateDrgRouteDistributionRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -173,7 +174,7 @@ func (s *CoreDrgRouteDistributionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateDrgRouteDistribution(context.Background(), request)
+	response, err := s.Client.CreateDrgRouteDistribution(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -182,7 +183,7 @@ func (s *CoreDrgRouteDistributionResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreDrgRouteDistributionResourceCrud) Get() error {
+func (s *CoreDrgRouteDistributionResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetDrgRouteDistributionRequest{}
 
 	tmp := s.D.Id()
@@ -190,7 +191,7 @@ func (s *CoreDrgRouteDistributionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetDrgRouteDistribution(context.Background(), request)
+	response, err := s.Client.GetDrgRouteDistribution(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -199,7 +200,7 @@ func (s *CoreDrgRouteDistributionResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreDrgRouteDistributionResourceCrud) Update() error {
+func (s *CoreDrgRouteDistributionResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateDrgRouteDistributionRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok && s.D.HasChange("defined_tags") {
@@ -224,7 +225,7 @@ func (s *CoreDrgRouteDistributionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateDrgRouteDistribution(context.Background(), request)
+	response, err := s.Client.UpdateDrgRouteDistribution(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -233,7 +234,7 @@ func (s *CoreDrgRouteDistributionResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreDrgRouteDistributionResourceCrud) Delete() error {
+func (s *CoreDrgRouteDistributionResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteDrgRouteDistributionRequest{}
 
 	tmp := s.D.Id()
@@ -241,7 +242,7 @@ func (s *CoreDrgRouteDistributionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteDrgRouteDistribution(context.Background(), request)
+	_, err := s.Client.DeleteDrgRouteDistribution(ctx, request)
 	return err
 }
 
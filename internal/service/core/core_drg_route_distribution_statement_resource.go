@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -25,11 +26,11 @@ func CoreDrgRouteDistributionStatementResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreDrgRouteDistributionStatement,
-		Read:     readCoreDrgRouteDistributionStatement,
-		Update:   updateCoreDrgRouteDistributionStatement,
-		Delete:   deleteCoreDrgRouteDistributionStatement,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreDrgRouteDistributionStatement,
+		ReadContext:   readCoreDrgRouteDistributionStatement,
+		UpdateContext: updateCoreDrgRouteDistributionStatement,
+		DeleteContext: deleteCoreDrgRouteDistributionStatement,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"drg_route_distribution_id": {
@@ -83,33 +84,33 @@ func CoreDrgRouteDistributionStatementResource() *schema.Resource {
 	}
 }
 
-func createCoreDrgRouteDistributionStatement(d *schema.ResourceData, m interface{}) error {
+func createCoreDrgRouteDistributionStatement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteDistributionStatementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreDrgRouteDistributionStatement(d *schema.ResourceData, m interface{}) error {
+func readCoreDrgRouteDistributionStatement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteDistributionStatementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreDrgRouteDistributionStatement(d *schema.ResourceData, m interface{}) error {
+func updateCoreDrgRouteDistributionStatement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteDistributionStatementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreDrgRouteDistributionStatement(d *schema.ResourceData, m interface{}) error {
+func deleteCoreDrgRouteDistributionStatement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteDistributionStatementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreDrgRouteDistributionStatementResourceCrud struct {
@@ -123,7 +124,7 @@ func (s *CoreDrgRouteDistributionStatementResourceCrud) ID() string {
 	return getDrgRouteDistributionStatementCompositeId(s.D.Get("drg_route_distribution_id").(string), *s.Res.Id)
 }
 
-func (s *CoreDrgRouteDistributionStatementResourceCrud) Create() error {
+func (s *CoreDrgRouteDistributionStatementResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.AddDrgRouteDistributionStatementsRequest{}
 
 	if drgRouteDistributionId, ok := s.D.GetOkExists("drg_route_distribution_id"); ok {
@@ -162,7 +163,7 @@ func (s *CoreDrgRouteDistributionStatementResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.AddDrgRouteDistributionStatements(context.Background(), request)
+	response, err := s.Client.AddDrgRouteDistributionStatements(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -189,7 +190,7 @@ func (s *CoreDrgRouteDistributionStatementResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreDrgRouteDistributionStatementResourceCrud) Get() error {
+func (s *CoreDrgRouteDistributionStatementResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListDrgRouteDistributionStatementsRequest{}
 
 	drgRouteDistributionId, statementId, err := parseDrgRouteDistributionStatementCompositeId(s.D.Id())
@@ -201,7 +202,7 @@ func (s *CoreDrgRouteDistributionStatementResourceCrud) Get() error {
 	}
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListDrgRouteDistributionStatements(context.Background(), request)
+	response, err := s.Client.ListDrgRouteDistributionStatements(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -211,7 +212,7 @@ func (s *CoreDrgRouteDistributionStatementResourceCrud) Get() error {
 	request.Page = response.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDrgRouteDistributionStatements(context.Background(), request)
+		listResponse, err := s.Client.ListDrgRouteDistributionStatements(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -234,7 +235,7 @@ func (s *CoreDrgRouteDistributionStatementResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreDrgRouteDistributionStatementResourceCrud) Update() error {
+func (s *CoreDrgRouteDistributionStatementResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateDrgRouteDistributionStatementsRequest{}
 
 	drgRouteDistributionId, statementId, err := parseDrgRouteDistributionStatementCompositeId(s.D.Id())
@@ -270,7 +271,7 @@ func (s *CoreDrgRouteDistributionStatementResourceCrud) Update() error {
 	request.Statements = tmp
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
-	response, err := s.Client.UpdateDrgRouteDistributionStatements(context.Background(), request)
+	response, err := s.Client.UpdateDrgRouteDistributionStatements(ctx, request)
 	if err != nil {
 		return fmt.Errorf("failed to Update distribution statements, error: %v", err)
 	}
@@ -289,7 +290,7 @@ func (s *CoreDrgRouteDistributionStatementResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreDrgRouteDistributionStatementResourceCrud) Delete() error {
+func (s *CoreDrgRouteDistributionStatementResourceCrud) Delete(ctx context.Context) error {
 
 	request := oci_core.RemoveDrgRouteDistributionStatementsRequest{}
 
@@ -303,7 +304,7 @@ func (s *CoreDrgRouteDistributionStatementResourceCrud) Delete() error {
 	request.StatementIds = tmp
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
-	_, err = s.Client.RemoveDrgRouteDistributionStatements(context.Background(), request)
+	_, err = s.Client.RemoveDrgRouteDistributionStatements(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreDrgRouteDistributionStatementsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreDrgRouteDistributionStatements,
+		ReadContext: readCoreDrgRouteDistributionStatements,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"drg_route_distribution_id": {
@@ -76,12 +77,12 @@ func CoreDrgRouteDistributionStatementsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreDrgRouteDistributionStatements(d *schema.ResourceData, m interface{}) error {
+func readCoreDrgRouteDistributionStatements(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteDistributionStatementsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreDrgRouteDistributionStatementsDataSourceCrud struct {
@@ -94,7 +95,7 @@ func (s *CoreDrgRouteDistributionStatementsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreDrgRouteDistributionStatementsDataSourceCrud) Get() error {
+func (s *CoreDrgRouteDistributionStatementsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListDrgRouteDistributionStatementsRequest{}
 
 	if drgRouteDistributionId, ok := s.D.GetOkExists("drg_route_distribution_id"); ok {
@@ -104,7 +105,7 @@ func (s *CoreDrgRouteDistributionStatementsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListDrgRouteDistributionStatements(context.Background(), request)
+	response, err := s.Client.ListDrgRouteDistributionStatements(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -113,7 +114,7 @@ func (s *CoreDrgRouteDistributionStatementsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDrgRouteDistributionStatements(context.Background(), request)
+		listResponse, err := s.Client.ListDrgRouteDistributionStatements(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreDrgRouteDistributionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreDrgRouteDistributions,
+		ReadContext: readCoreDrgRouteDistributions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"display_name": {
@@ -39,12 +40,12 @@ func CoreDrgRouteDistributionsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreDrgRouteDistributions(d *schema.ResourceData, m interface{}) error {
+func readCoreDrgRouteDistributions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteDistributionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreDrgRouteDistributionsDataSourceCrud struct {
@@ -57,7 +58,7 @@ func (s *CoreDrgRouteDistributionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreDrgRouteDistributionsDataSourceCrud) Get() error {
+func (s *CoreDrgRouteDistributionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListDrgRouteDistributionsRequest{}
 
 	if displayName, ok := s.D.GetOkExists("display_name"); ok {
@@ -76,7 +77,7 @@ func (s *CoreDrgRouteDistributionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListDrgRouteDistributions(context.Background(), request)
+	response, err := s.Client.ListDrgRouteDistributions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *CoreDrgRouteDistributionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDrgRouteDistributions(context.Background(), request)
+		listResponse, err := s.Client.ListDrgRouteDistributions(ctx, request)
 		if err != nil {
 			return err
 		}
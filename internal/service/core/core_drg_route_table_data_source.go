@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -22,12 +23,12 @@ func CoreDrgRouteTableDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreDrgRouteTableResource(), fieldMap, readSingularCoreDrgRouteTable)
 }
 
-func readSingularCoreDrgRouteTable(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreDrgRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteTableDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreDrgRouteTableDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *CoreDrgRouteTableDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreDrgRouteTableDataSourceCrud) Get() error {
+func (s *CoreDrgRouteTableDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetDrgRouteTableRequest{}
 
 	if drgRouteTableId, ok := s.D.GetOkExists("drg_route_table_id"); ok {
@@ -50,7 +51,7 @@ func (s *CoreDrgRouteTableDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetDrgRouteTable(context.Background(), request)
+	response, err := s.Client.GetDrgRouteTable(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -19,11 +20,11 @@ func CoreDrgRouteTableResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreDrgRouteTable,
-		Read:     readCoreDrgRouteTable,
-		Update:   updateCoreDrgRouteTable,
-		Delete:   deleteCoreDrgRouteTable,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreDrgRouteTable,
+		ReadContext:   readCoreDrgRouteTable,
+		UpdateContext: updateCoreDrgRouteTable,
+		DeleteContext: deleteCoreDrgRouteTable,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"drg_id": {
@@ -82,56 +83,56 @@ func CoreDrgRouteTableResource() *schema.Resource {
 	}
 }
 
-func createCoreDrgRouteTable(d *schema.ResourceData, m interface{}) error {
+func createCoreDrgRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	if e := tfresource.CreateResource(d, sync); e != nil {
-		return e
+	if e := tfresource.CreateResource(ctx, d, sync); e != nil {
+		return tfresource.HandleDiagError(e)
 	}
 
 	if _, ok := sync.D.GetOkExists("remove_import_trigger"); ok {
-		err := sync.RemoveImportRouteDistribution()
+		err := sync.RemoveImportRouteDistribution(ctx)
 		if err != nil {
-			return err
+			return tfresource.HandleDiagError(err)
 		}
 	}
 
 	return nil
 }
 
-func readCoreDrgRouteTable(d *schema.ResourceData, m interface{}) error {
+func readCoreDrgRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreDrgRouteTable(d *schema.ResourceData, m interface{}) error {
+func updateCoreDrgRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
 	if _, ok := sync.D.GetOkExists("remove_import_trigger"); ok &&
 		sync.D.HasChange("remove_import_trigger") {
-		err := sync.RemoveImportRouteDistribution()
+		err := sync.RemoveImportRouteDistribution(ctx)
 		if err != nil {
-			return err
+			return tfresource.HandleDiagError(err)
 		}
 	}
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreDrgRouteTable(d *schema.ResourceData, m interface{}) error {
+func deleteCoreDrgRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreDrgRouteTableResourceCrud struct {
@@ -169,7 +170,7 @@ func (s *CoreDrgRouteTableResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreDrgRouteTableResourceCrud) Create() error {
+func (s *CoreDrgRouteTableResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateDrgRouteTableRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -206,7 +207,7 @@ func (s *CoreDrgRouteTableResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateDrgRouteTable(context.Background(), request)
+	response, err := s.Client.CreateDrgRouteTable(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -215,7 +216,7 @@ func (s *CoreDrgRouteTableResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreDrgRouteTableResourceCrud) Get() error {
+func (s *CoreDrgRouteTableResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetDrgRouteTableRequest{}
 
 	tmp := s.D.Id()
@@ -223,7 +224,7 @@ func (s *CoreDrgRouteTableResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetDrgRouteTable(context.Background(), request)
+	response, err := s.Client.GetDrgRouteTable(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -232,7 +233,7 @@ func (s *CoreDrgRouteTableResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreDrgRouteTableResourceCrud) Update() error {
+func (s *CoreDrgRouteTableResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateDrgRouteTableRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok && s.D.HasChange("defined_tags") {
@@ -269,7 +270,7 @@ func (s *CoreDrgRouteTableResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateDrgRouteTable(context.Background(), request)
+	response, err := s.Client.UpdateDrgRouteTable(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -278,7 +279,7 @@ func (s *CoreDrgRouteTableResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreDrgRouteTableResourceCrud) RemoveImportRouteDistribution() error {
+func (s *CoreDrgRouteTableResourceCrud) RemoveImportRouteDistribution(ctx context.Context) error {
 	request := oci_core.RemoveImportDrgRouteDistributionRequest{}
 
 	tmp := s.D.Id()
@@ -286,7 +287,7 @@ func (s *CoreDrgRouteTableResourceCrud) RemoveImportRouteDistribution() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.RemoveImportDrgRouteDistribution(context.Background(), request)
+	response, err := s.Client.RemoveImportDrgRouteDistribution(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -301,7 +302,7 @@ func (s *CoreDrgRouteTableResourceCrud) RemoveImportRouteDistribution() error {
 	return nil
 
 }
-func (s *CoreDrgRouteTableResourceCrud) Delete() error {
+func (s *CoreDrgRouteTableResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteDrgRouteTableRequest{}
 
 	tmp := s.D.Id()
@@ -309,13 +310,13 @@ func (s *CoreDrgRouteTableResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.DeleteDrgRouteTable(context.Background(), request)
+	response, err := s.Client.DeleteDrgRouteTable(ctx, request)
 	if response.HTTPResponse().StatusCode == 400 {
 		retryDeleteFunc := func() bool {
-			response, err := s.Client.DeleteDrgRouteTable(context.Background(), request)
+			response, err := s.Client.DeleteDrgRouteTable(ctx, request)
 			return err == nil && response.HTTPResponse().StatusCode == 200
 		}
-		return tfresource.WaitForResourceCondition(s, retryDeleteFunc, s.D.Timeout(schema.TimeoutDelete))
+		return tfresource.WaitForResourceCondition(ctx, s, retryDeleteFunc, s.D.Timeout(schema.TimeoutDelete))
 	}
 	return err
 }
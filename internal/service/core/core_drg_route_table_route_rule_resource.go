@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -24,11 +25,11 @@ func CoreDrgRouteTableRouteRuleResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreDrgRouteTableRouteRule,
-		Read:     readCoreDrgRouteTableRouteRule,
-		Update:   updateCoreDrgRouteTableRouteRuleResource,
-		Delete:   deleteCoreDrgRouteTableRouteRule,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreDrgRouteTableRouteRule,
+		ReadContext:   readCoreDrgRouteTableRouteRule,
+		UpdateContext: updateCoreDrgRouteTableRouteRuleResource,
+		DeleteContext: deleteCoreDrgRouteTableRouteRule,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"drg_route_table_id": {
@@ -75,33 +76,33 @@ func CoreDrgRouteTableRouteRuleResource() *schema.Resource {
 	}
 }
 
-func createCoreDrgRouteTableRouteRule(d *schema.ResourceData, m interface{}) error {
+func createCoreDrgRouteTableRouteRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteTableRouteRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreDrgRouteTableRouteRule(d *schema.ResourceData, m interface{}) error {
+func readCoreDrgRouteTableRouteRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteTableRouteRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteCoreDrgRouteTableRouteRule(d *schema.ResourceData, m interface{}) error {
+func deleteCoreDrgRouteTableRouteRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteTableRouteRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
-func updateCoreDrgRouteTableRouteRuleResource(d *schema.ResourceData, m interface{}) error {
+func updateCoreDrgRouteTableRouteRuleResource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteTableRouteRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
 type CoreDrgRouteTableRouteRuleResourceCrud struct {
@@ -115,7 +116,7 @@ func (s *CoreDrgRouteTableRouteRuleResourceCrud) ID() string {
 	return GetDrgRouteTableRouteRuleCompositeId(s.D.Get("drg_route_table_id").(string), *s.Res.Id)
 }
 
-func (s *CoreDrgRouteTableRouteRuleResourceCrud) Create() error {
+func (s *CoreDrgRouteTableRouteRuleResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.AddDrgRouteRulesRequest{}
 
 	if drgRouteTableId, ok := s.D.GetOkExists("drg_route_table_id"); ok {
@@ -144,7 +145,7 @@ func (s *CoreDrgRouteTableRouteRuleResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.AddDrgRouteRules(context.Background(), request)
+	response, err := s.Client.AddDrgRouteRules(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -216,7 +217,7 @@ func (s *CoreDrgRouteTableRouteRuleResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CoreDrgRouteTableRouteRuleResourceCrud) Get() error {
+func (s *CoreDrgRouteTableRouteRuleResourceCrud) Get(ctx context.Context) error {
 
 	request := oci_core.ListDrgRouteRulesRequest{}
 	drgRouteTableId, drgRouteRuleId, err := parseDrgRouteTableRouteRuleCompositeId(s.D.Id())
@@ -229,7 +230,7 @@ func (s *CoreDrgRouteTableRouteRuleResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.ListDrgRouteRules(context.Background(), request)
+	response, err := s.Client.ListDrgRouteRules(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -238,7 +239,7 @@ func (s *CoreDrgRouteTableRouteRuleResourceCrud) Get() error {
 	request.Page = response.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDrgRouteRules(context.Background(), request)
+		listResponse, err := s.Client.ListDrgRouteRules(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -261,7 +262,7 @@ func (s *CoreDrgRouteTableRouteRuleResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreDrgRouteTableRouteRuleResourceCrud) Delete() error {
+func (s *CoreDrgRouteTableRouteRuleResourceCrud) Delete(ctx context.Context) error {
 
 	request := oci_core.RemoveDrgRouteRulesRequest{}
 	drgRouteTableId, drgRouteRuleId, err := parseDrgRouteTableRouteRuleCompositeId(s.D.Id())
@@ -274,14 +275,14 @@ func (s *CoreDrgRouteTableRouteRuleResourceCrud) Delete() error {
 	request.RouteRuleIds = tmp
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
-	_, err = s.Client.RemoveDrgRouteRules(context.Background(), request)
+	_, err = s.Client.RemoveDrgRouteRules(ctx, request)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (s *CoreDrgRouteTableRouteRuleResourceCrud) Update() error {
+func (s *CoreDrgRouteTableRouteRuleResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateDrgRouteRulesRequest{}
 
 	drgRouteTableId, drgRouteRuleId, err := parseDrgRouteTableRouteRuleCompositeId(s.D.Id())
@@ -313,7 +314,7 @@ func (s *CoreDrgRouteTableRouteRuleResourceCrud) Update() error {
 	request.RouteRules = tmp
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
-	response, err := s.Client.UpdateDrgRouteRules(context.Background(), request)
+	response, err := s.Client.UpdateDrgRouteRules(ctx, request)
 	if err != nil {
 		return fmt.Errorf("failed to Update route rules, error: %v", err)
 	}
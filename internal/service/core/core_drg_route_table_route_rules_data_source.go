@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreDrgRouteTableRouteRulesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreDrgRouteTableRouteRules,
+		ReadContext: readCoreDrgRouteTableRouteRules,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"drg_route_table_id": {
@@ -80,12 +81,12 @@ func CoreDrgRouteTableRouteRulesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreDrgRouteTableRouteRules(d *schema.ResourceData, m interface{}) error {
+func readCoreDrgRouteTableRouteRules(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteTableRouteRulesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreDrgRouteTableRouteRulesDataSourceCrud struct {
@@ -98,7 +99,7 @@ func (s *CoreDrgRouteTableRouteRulesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreDrgRouteTableRouteRulesDataSourceCrud) Get() error {
+func (s *CoreDrgRouteTableRouteRulesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListDrgRouteRulesRequest{}
 
 	if drgRouteTableId, ok := s.D.GetOkExists("drg_route_table_id"); ok {
@@ -112,7 +113,7 @@ func (s *CoreDrgRouteTableRouteRulesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListDrgRouteRules(context.Background(), request)
+	response, err := s.Client.ListDrgRouteRules(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -121,7 +122,7 @@ func (s *CoreDrgRouteTableRouteRulesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDrgRouteRules(context.Background(), request)
+		listResponse, err := s.Client.ListDrgRouteRules(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreDrgRouteTablesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreDrgRouteTables,
+		ReadContext: readCoreDrgRouteTables,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"display_name": {
@@ -43,12 +44,12 @@ func CoreDrgRouteTablesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreDrgRouteTables(d *schema.ResourceData, m interface{}) error {
+func readCoreDrgRouteTables(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreDrgRouteTablesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreDrgRouteTablesDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *CoreDrgRouteTablesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreDrgRouteTablesDataSourceCrud) Get() error {
+func (s *CoreDrgRouteTablesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListDrgRouteTablesRequest{}
 
 	if displayName, ok := s.D.GetOkExists("display_name"); ok {
@@ -85,7 +86,7 @@ func (s *CoreDrgRouteTablesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListDrgRouteTables(context.Background(), request)
+	response, err := s.Client.ListDrgRouteTables(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *CoreDrgRouteTablesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDrgRouteTables(context.Background(), request)
+		listResponse, err := s.Client.ListDrgRouteTables(ctx, request)
 		if err != nil {
 			return err
 		}
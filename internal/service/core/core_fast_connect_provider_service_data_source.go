@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreFastConnectProviderServiceDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreFastConnectProviderService,
+		ReadContext: readSingularCoreFastConnectProviderService,
 		Schema: map[string]*schema.Schema{
 			"provider_service_id": {
 				Type:     schema.TypeString,
@@ -73,12 +74,12 @@ func CoreFastConnectProviderServiceDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreFastConnectProviderService(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreFastConnectProviderService(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreFastConnectProviderServiceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreFastConnectProviderServiceDataSourceCrud struct {
@@ -91,7 +92,7 @@ func (s *CoreFastConnectProviderServiceDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreFastConnectProviderServiceDataSourceCrud) Get() error {
+func (s *CoreFastConnectProviderServiceDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetFastConnectProviderServiceRequest{}
 
 	if providerServiceId, ok := s.D.GetOkExists("provider_service_id"); ok {
@@ -101,7 +102,7 @@ func (s *CoreFastConnectProviderServiceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetFastConnectProviderService(context.Background(), request)
+	response, err := s.Client.GetFastConnectProviderService(ctx, request)
 	if err != nil {
 		return err
 	}
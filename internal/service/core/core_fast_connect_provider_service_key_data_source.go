@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreFastConnectProviderServiceKeyDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreFastConnectProviderServiceKey,
+		ReadContext: readSingularCoreFastConnectProviderServiceKey,
 		Schema: map[string]*schema.Schema{
 			"provider_service_id": {
 				Type:     schema.TypeString,
@@ -42,12 +43,12 @@ func CoreFastConnectProviderServiceKeyDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreFastConnectProviderServiceKey(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreFastConnectProviderServiceKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreFastConnectProviderServiceKeyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreFastConnectProviderServiceKeyDataSourceCrud struct {
@@ -60,7 +61,7 @@ func (s *CoreFastConnectProviderServiceKeyDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreFastConnectProviderServiceKeyDataSourceCrud) Get() error {
+func (s *CoreFastConnectProviderServiceKeyDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetFastConnectProviderServiceKeyRequest{}
 
 	if providerServiceId, ok := s.D.GetOkExists("provider_service_id"); ok {
@@ -75,7 +76,7 @@ func (s *CoreFastConnectProviderServiceKeyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetFastConnectProviderServiceKey(context.Background(), request)
+	response, err := s.Client.GetFastConnectProviderServiceKey(ctx, request)
 	if err != nil {
 		return err
 	}
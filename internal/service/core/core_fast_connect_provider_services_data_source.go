@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreFastConnectProviderServicesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreFastConnectProviderServices,
+		ReadContext: readCoreFastConnectProviderServices,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -90,12 +91,12 @@ func CoreFastConnectProviderServicesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreFastConnectProviderServices(d *schema.ResourceData, m interface{}) error {
+func readCoreFastConnectProviderServices(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreFastConnectProviderServicesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreFastConnectProviderServicesDataSourceCrud struct {
@@ -108,7 +109,7 @@ func (s *CoreFastConnectProviderServicesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreFastConnectProviderServicesDataSourceCrud) Get() error {
+func (s *CoreFastConnectProviderServicesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListFastConnectProviderServicesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -118,7 +119,7 @@ func (s *CoreFastConnectProviderServicesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListFastConnectProviderServices(context.Background(), request)
+	response, err := s.Client.ListFastConnectProviderServices(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -127,7 +128,7 @@ func (s *CoreFastConnectProviderServicesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListFastConnectProviderServices(context.Background(), request)
+		listResponse, err := s.Client.ListFastConnectProviderServices(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -23,12 +24,12 @@ func CoreImageDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreImageResource(), fieldMap, readSingularCoreImage)
 }
 
-func readSingularCoreImage(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreImage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreImageDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreImageDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *CoreImageDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreImageDataSourceCrud) Get() error {
+func (s *CoreImageDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetImageRequest{}
 
 	if imageId, ok := s.D.GetOkExists("image_id"); ok {
@@ -51,7 +52,7 @@ func (s *CoreImageDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetImage(context.Background(), request)
+	response, err := s.Client.GetImage(ctx, request)
 	if err != nil {
 		return err
 	}
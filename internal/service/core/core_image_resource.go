@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -29,10 +30,10 @@ func CoreImageResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("2h"),
 			Delete: tfresource.GetTimeoutDuration("2h"),
 		},
-		Create: createCoreImage,
-		Read:   readCoreImage,
-		Update: updateCoreImage,
-		Delete: deleteCoreImage,
+		CreateContext: createCoreImage,
+		ReadContext:   readCoreImage,
+		UpdateContext: updateCoreImage,
+		DeleteContext: deleteCoreImage,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -231,40 +232,40 @@ func CoreImageResource() *schema.Resource {
 	}
 }
 
-func createCoreImage(d *schema.ResourceData, m interface{}) error {
+func createCoreImage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreImageResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreImage(d *schema.ResourceData, m interface{}) error {
+func readCoreImage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreImageResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreImage(d *schema.ResourceData, m interface{}) error {
+func updateCoreImage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreImageResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreImage(d *schema.ResourceData, m interface{}) error {
+func deleteCoreImage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreImageResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.DisableNotFoundRetries = true
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreImageResourceCrud struct {
@@ -304,7 +305,7 @@ func (s *CoreImageResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreImageResourceCrud) Create() error {
+func (s *CoreImageResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateImageRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -351,7 +352,7 @@ func (s *CoreImageResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateImage(context.Background(), request)
+	response, err := s.Client.CreateImage(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -360,7 +361,7 @@ func (s *CoreImageResourceCrud) Create() error {
 	s.Res = &response.Image
 
 	if workId != nil {
-		identifier, err := tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "image", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		identifier, err := tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "image", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if identifier != nil {
 			s.D.SetId(*identifier)
 		}
@@ -369,10 +370,10 @@ func (s *CoreImageResourceCrud) Create() error {
 		}
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *CoreImageResourceCrud) Get() error {
+func (s *CoreImageResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetImageRequest{}
 
 	tmp := s.D.Id()
@@ -380,7 +381,7 @@ func (s *CoreImageResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetImage(context.Background(), request)
+	response, err := s.Client.GetImage(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -389,11 +390,11 @@ func (s *CoreImageResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreImageResourceCrud) Update() error {
+func (s *CoreImageResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -423,7 +424,7 @@ func (s *CoreImageResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateImage(context.Background(), request)
+	response, err := s.Client.UpdateImage(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -432,7 +433,7 @@ func (s *CoreImageResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreImageResourceCrud) Delete() error {
+func (s *CoreImageResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteImageRequest{}
 
 	tmp := s.D.Id()
@@ -440,7 +441,7 @@ func (s *CoreImageResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteImage(context.Background(), request)
+	_, err := s.Client.DeleteImage(ctx, request)
 	return err
 }
 
@@ -583,7 +584,7 @@ func InstanceAgentFeaturesToMap(obj *oci_core.InstanceAgentFeatures) map[string]
 	return result
 }
 
-func (s *CoreImageResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreImageResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeImageCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -594,12 +595,12 @@ func (s *CoreImageResourceCrud) updateCompartment(compartment interface{}) error
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeImageCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeImageCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreImageShapeDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreImageShape,
+		ReadContext: readSingularCoreImageShape,
 		Schema: map[string]*schema.Schema{
 			"image_id": {
 				Type:     schema.TypeString,
@@ -76,12 +77,12 @@ func CoreImageShapeDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreImageShape(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreImageShape(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreImageShapeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreImageShapeDataSourceCrud struct {
@@ -94,7 +95,7 @@ func (s *CoreImageShapeDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreImageShapeDataSourceCrud) Get() error {
+func (s *CoreImageShapeDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetImageShapeCompatibilityEntryRequest{}
 
 	if imageId, ok := s.D.GetOkExists("image_id"); ok {
@@ -109,7 +110,7 @@ func (s *CoreImageShapeDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetImageShapeCompatibilityEntry(context.Background(), request)
+	response, err := s.Client.GetImageShapeCompatibilityEntry(ctx, request)
 	if err != nil {
 		return err
 	}
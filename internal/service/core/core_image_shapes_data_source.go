@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreImageShapesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreImageShapes,
+		ReadContext: readCoreImageShapes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"image_id": {
@@ -89,12 +90,12 @@ func CoreImageShapesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreImageShapes(d *schema.ResourceData, m interface{}) error {
+func readCoreImageShapes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreImageShapesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreImageShapesDataSourceCrud struct {
@@ -107,7 +108,7 @@ func (s *CoreImageShapesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreImageShapesDataSourceCrud) Get() error {
+func (s *CoreImageShapesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListImageShapeCompatibilityEntriesRequest{}
 
 	if imageId, ok := s.D.GetOkExists("image_id"); ok {
@@ -117,7 +118,7 @@ func (s *CoreImageShapesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListImageShapeCompatibilityEntries(context.Background(), request)
+	response, err := s.Client.ListImageShapeCompatibilityEntries(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -126,7 +127,7 @@ func (s *CoreImageShapesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListImageShapeCompatibilityEntries(context.Background(), request)
+		listResponse, err := s.Client.ListImageShapeCompatibilityEntries(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -22,12 +23,12 @@ func CoreInstanceConfigurationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreInstanceConfigurationResource(), fieldMap, readSingularCoreInstanceConfiguration)
 }
 
-func readSingularCoreInstanceConfiguration(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreInstanceConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceConfigurationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreInstanceConfigurationDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *CoreInstanceConfigurationDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreInstanceConfigurationDataSourceCrud) Get() error {
+func (s *CoreInstanceConfigurationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetInstanceConfigurationRequest{}
 
 	if instanceConfigurationId, ok := s.D.GetOkExists("instance_configuration_id"); ok {
@@ -50,7 +51,7 @@ func (s *CoreInstanceConfigurationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetInstanceConfiguration(context.Background(), request)
+	response, err := s.Client.GetInstanceConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -24,11 +25,11 @@ func CoreInstanceConfigurationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreInstanceConfiguration,
-		Read:     readCoreInstanceConfiguration,
-		Update:   updateCoreInstanceConfiguration,
-		Delete:   deleteCoreInstanceConfiguration,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreInstanceConfiguration,
+		ReadContext:   readCoreInstanceConfiguration,
+		UpdateContext: updateCoreInstanceConfiguration,
+		DeleteContext: deleteCoreInstanceConfiguration,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -1040,37 +1041,37 @@ func CoreInstanceConfigurationResource() *schema.Resource {
 	}
 }
 
-func createCoreInstanceConfiguration(d *schema.ResourceData, m interface{}) error {
+func createCoreInstanceConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreInstanceConfiguration(d *schema.ResourceData, m interface{}) error {
+func readCoreInstanceConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreInstanceConfiguration(d *schema.ResourceData, m interface{}) error {
+func updateCoreInstanceConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreInstanceConfiguration(d *schema.ResourceData, m interface{}) error {
+func deleteCoreInstanceConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreInstanceConfigurationResourceCrud struct {
@@ -1084,7 +1085,7 @@ func (s *CoreInstanceConfigurationResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *CoreInstanceConfigurationResourceCrud) Create() error {
+func (s *CoreInstanceConfigurationResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateInstanceConfigurationRequest{}
 	err := s.populateTopLevelPolymorphicCreateInstanceConfigurationRequest(&request)
 	if err != nil {
@@ -1093,7 +1094,7 @@ func (s *CoreInstanceConfigurationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateInstanceConfiguration(context.Background(), request)
+	response, err := s.Client.CreateInstanceConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -1102,7 +1103,7 @@ func (s *CoreInstanceConfigurationResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreInstanceConfigurationResourceCrud) Get() error {
+func (s *CoreInstanceConfigurationResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetInstanceConfigurationRequest{}
 
 	tmp := s.D.Id()
@@ -1110,7 +1111,7 @@ func (s *CoreInstanceConfigurationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetInstanceConfiguration(context.Background(), request)
+	response, err := s.Client.GetInstanceConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -1119,11 +1120,11 @@ func (s *CoreInstanceConfigurationResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreInstanceConfigurationResourceCrud) Update() error {
+func (s *CoreInstanceConfigurationResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -1153,7 +1154,7 @@ func (s *CoreInstanceConfigurationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateInstanceConfiguration(context.Background(), request)
+	response, err := s.Client.UpdateInstanceConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -1162,7 +1163,7 @@ func (s *CoreInstanceConfigurationResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreInstanceConfigurationResourceCrud) Delete() error {
+func (s *CoreInstanceConfigurationResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteInstanceConfigurationRequest{}
 
 	tmp := s.D.Id()
@@ -1170,7 +1171,7 @@ func (s *CoreInstanceConfigurationResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteInstanceConfiguration(context.Background(), request)
+	_, err := s.Client.DeleteInstanceConfiguration(ctx, request)
 	return err
 }
 
@@ -2895,7 +2896,7 @@ func (s *CoreInstanceConfigurationResourceCrud) populateTopLevelPolymorphicCreat
 	return nil
 }
 
-func (s *CoreInstanceConfigurationResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreInstanceConfigurationResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeInstanceConfigurationCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -2906,12 +2907,12 @@ func (s *CoreInstanceConfigurationResourceCrud) updateCompartment(compartment in
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeInstanceConfigurationCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeInstanceConfigurationCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
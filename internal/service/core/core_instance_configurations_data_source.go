@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreInstanceConfigurationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreInstanceConfigurations,
+		ReadContext: readCoreInstanceConfigurations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -31,12 +32,12 @@ func CoreInstanceConfigurationsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreInstanceConfigurations(d *schema.ResourceData, m interface{}) error {
+func readCoreInstanceConfigurations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceConfigurationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreInstanceConfigurationsDataSourceCrud struct {
@@ -49,7 +50,7 @@ func (s *CoreInstanceConfigurationsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreInstanceConfigurationsDataSourceCrud) Get() error {
+func (s *CoreInstanceConfigurationsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListInstanceConfigurationsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -59,7 +60,7 @@ func (s *CoreInstanceConfigurationsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListInstanceConfigurations(context.Background(), request)
+	response, err := s.Client.ListInstanceConfigurations(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -68,7 +69,7 @@ func (s *CoreInstanceConfigurationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListInstanceConfigurations(context.Background(), request)
+		listResponse, err := s.Client.ListInstanceConfigurations(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -19,11 +20,11 @@ func CoreInstanceConsoleConnectionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreInstanceConsoleConnection,
-		Read:     readCoreInstanceConsoleConnection,
-		Update:   updateCoreInstanceConsoleConnection,
-		Delete:   deleteCoreInstanceConsoleConnection,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreInstanceConsoleConnection,
+		ReadContext:   readCoreInstanceConsoleConnection,
+		UpdateContext: updateCoreInstanceConsoleConnection,
+		DeleteContext: deleteCoreInstanceConsoleConnection,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"instance_id": {
@@ -81,37 +82,37 @@ func CoreInstanceConsoleConnectionResource() *schema.Resource {
 	}
 }
 
-func createCoreInstanceConsoleConnection(d *schema.ResourceData, m interface{}) error {
+func createCoreInstanceConsoleConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceConsoleConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreInstanceConsoleConnection(d *schema.ResourceData, m interface{}) error {
+func readCoreInstanceConsoleConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceConsoleConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreInstanceConsoleConnection(d *schema.ResourceData, m interface{}) error {
+func updateCoreInstanceConsoleConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceConsoleConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreInstanceConsoleConnection(d *schema.ResourceData, m interface{}) error {
+func deleteCoreInstanceConsoleConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceConsoleConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreInstanceConsoleConnectionResourceCrud struct {
@@ -149,7 +150,7 @@ func (s *CoreInstanceConsoleConnectionResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreInstanceConsoleConnectionResourceCrud) Create() error {
+func (s *CoreInstanceConsoleConnectionResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateInstanceConsoleConnectionRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -176,7 +177,7 @@ func (s *CoreInstanceConsoleConnectionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateInstanceConsoleConnection(context.Background(), request)
+	response, err := s.Client.CreateInstanceConsoleConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -185,7 +186,7 @@ func (s *CoreInstanceConsoleConnectionResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreInstanceConsoleConnectionResourceCrud) Get() error {
+func (s *CoreInstanceConsoleConnectionResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetInstanceConsoleConnectionRequest{}
 
 	tmp := s.D.Id()
@@ -193,7 +194,7 @@ func (s *CoreInstanceConsoleConnectionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetInstanceConsoleConnection(context.Background(), request)
+	response, err := s.Client.GetInstanceConsoleConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -202,7 +203,7 @@ func (s *CoreInstanceConsoleConnectionResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreInstanceConsoleConnectionResourceCrud) Update() error {
+func (s *CoreInstanceConsoleConnectionResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateInstanceConsoleConnectionRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -222,7 +223,7 @@ func (s *CoreInstanceConsoleConnectionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateInstanceConsoleConnection(context.Background(), request)
+	response, err := s.Client.UpdateInstanceConsoleConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -231,7 +232,7 @@ func (s *CoreInstanceConsoleConnectionResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreInstanceConsoleConnectionResourceCrud) Delete() error {
+func (s *CoreInstanceConsoleConnectionResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteInstanceConsoleConnectionRequest{}
 
 	tmp := s.D.Id()
@@ -239,7 +240,7 @@ func (s *CoreInstanceConsoleConnectionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteInstanceConsoleConnection(context.Background(), request)
+	_, err := s.Client.DeleteInstanceConsoleConnection(ctx, request)
 	return err
 }
 
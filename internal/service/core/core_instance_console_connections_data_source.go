@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreInstanceConsoleConnectionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreInstanceConsoleConnections,
+		ReadContext: readCoreInstanceConsoleConnections,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -35,12 +36,12 @@ func CoreInstanceConsoleConnectionsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreInstanceConsoleConnections(d *schema.ResourceData, m interface{}) error {
+func readCoreInstanceConsoleConnections(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceConsoleConnectionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreInstanceConsoleConnectionsDataSourceCrud struct {
@@ -53,7 +54,7 @@ func (s *CoreInstanceConsoleConnectionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreInstanceConsoleConnectionsDataSourceCrud) Get() error {
+func (s *CoreInstanceConsoleConnectionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListInstanceConsoleConnectionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -68,7 +69,7 @@ func (s *CoreInstanceConsoleConnectionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListInstanceConsoleConnections(context.Background(), request)
+	response, err := s.Client.ListInstanceConsoleConnections(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -77,7 +78,7 @@ func (s *CoreInstanceConsoleConnectionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListInstanceConsoleConnections(context.Background(), request)
+		listResponse, err := s.Client.ListInstanceConsoleConnections(ctx, request)
 		if err != nil {
 			return err
 		}
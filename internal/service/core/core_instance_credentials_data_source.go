@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreInstanceCredentialDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreInstanceCredential,
+		ReadContext: readSingularCoreInstanceCredential,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -34,12 +35,12 @@ func CoreInstanceCredentialDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreInstanceCredential(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreInstanceCredential(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceCredentialDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreInstanceCredentialDataSourceCrud struct {
@@ -52,7 +53,7 @@ func (s *CoreInstanceCredentialDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreInstanceCredentialDataSourceCrud) Get() error {
+func (s *CoreInstanceCredentialDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetWindowsInstanceInitialCredentialsRequest{}
 
 	if instanceId, ok := s.D.GetOkExists("instance_id"); ok {
@@ -62,7 +63,7 @@ func (s *CoreInstanceCredentialDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetWindowsInstanceInitialCredentials(context.Background(), request)
+	response, err := s.Client.GetWindowsInstanceInitialCredentials(ctx, request)
 	if err != nil {
 		return err
 	}
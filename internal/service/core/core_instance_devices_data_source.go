@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreInstanceDevicesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreInstanceDevices,
+		ReadContext: readCoreInstanceDevices,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"instance_id": {
@@ -55,12 +56,12 @@ func CoreInstanceDevicesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreInstanceDevices(d *schema.ResourceData, m interface{}) error {
+func readCoreInstanceDevices(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceDevicesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreInstanceDevicesDataSourceCrud struct {
@@ -73,7 +74,7 @@ func (s *CoreInstanceDevicesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreInstanceDevicesDataSourceCrud) Get() error {
+func (s *CoreInstanceDevicesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListInstanceDevicesRequest{}
 
 	if instanceId, ok := s.D.GetOkExists("instance_id"); ok {
@@ -93,7 +94,7 @@ func (s *CoreInstanceDevicesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListInstanceDevices(context.Background(), request)
+	response, err := s.Client.ListInstanceDevices(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -102,7 +103,7 @@ func (s *CoreInstanceDevicesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListInstanceDevices(context.Background(), request)
+		listResponse, err := s.Client.ListInstanceDevices(ctx, request)
 		if err != nil {
 			return err
 		}
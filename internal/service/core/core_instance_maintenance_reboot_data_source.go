@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreInstanceMaintenanceRebootDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreInstanceMaintenanceReboot,
+		ReadContext: readSingularCoreInstanceMaintenanceReboot,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -30,12 +31,12 @@ func CoreInstanceMaintenanceRebootDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreInstanceMaintenanceReboot(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreInstanceMaintenanceReboot(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceMaintenanceRebootDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreInstanceMaintenanceRebootDataSourceCrud struct {
@@ -48,7 +49,7 @@ func (s *CoreInstanceMaintenanceRebootDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreInstanceMaintenanceRebootDataSourceCrud) Get() error {
+func (s *CoreInstanceMaintenanceRebootDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetInstanceMaintenanceRebootRequest{}
 
 	if instanceId, ok := s.D.GetOkExists("instance_id"); ok {
@@ -58,7 +59,7 @@ func (s *CoreInstanceMaintenanceRebootDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetInstanceMaintenanceReboot(context.Background(), request)
+	response, err := s.Client.GetInstanceMaintenanceReboot(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreInstanceMeasuredBootReportDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreInstanceMeasuredBootReport,
+		ReadContext: readSingularCoreInstanceMeasuredBootReport,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -93,12 +94,12 @@ func CoreInstanceMeasuredBootReportDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreInstanceMeasuredBootReport(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreInstanceMeasuredBootReport(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstanceMeasuredBootReportDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreInstanceMeasuredBootReportDataSourceCrud struct {
@@ -111,7 +112,7 @@ func (s *CoreInstanceMeasuredBootReportDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreInstanceMeasuredBootReportDataSourceCrud) Get() error {
+func (s *CoreInstanceMeasuredBootReportDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetMeasuredBootReportRequest{}
 
 	if instanceId, ok := s.D.GetOkExists("instance_id"); ok {
@@ -121,7 +122,7 @@ func (s *CoreInstanceMeasuredBootReportDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetMeasuredBootReport(context.Background(), request)
+	response, err := s.Client.GetMeasuredBootReport(ctx, request)
 	if err != nil {
 		return err
 	}
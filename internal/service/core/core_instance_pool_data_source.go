@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -22,12 +23,12 @@ func CoreInstancePoolDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreInstancePoolResource(), fieldMap, readSingularCoreInstancePool)
 }
 
-func readSingularCoreInstancePool(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreInstancePool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstancePoolDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreInstancePoolDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *CoreInstancePoolDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreInstancePoolDataSourceCrud) Get() error {
+func (s *CoreInstancePoolDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetInstancePoolRequest{}
 
 	if instancePoolId, ok := s.D.GetOkExists("instance_pool_id"); ok {
@@ -50,7 +51,7 @@ func (s *CoreInstancePoolDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetInstancePool(context.Background(), request)
+	response, err := s.Client.GetInstancePool(ctx, request)
 	if err != nil {
 		return err
 	}
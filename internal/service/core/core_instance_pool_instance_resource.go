@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -25,10 +26,10 @@ func CoreInstancePoolInstanceResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreInstancePoolInstance,
-		Read:     readCoreInstancePoolInstance,
-		Delete:   deleteCoreInstancePoolInstance,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreInstancePoolInstance,
+		ReadContext:   readCoreInstancePoolInstance,
+		DeleteContext: deleteCoreInstancePoolInstance,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"instance_id": {
@@ -131,30 +132,30 @@ func CoreInstancePoolInstanceResource() *schema.Resource {
 	}
 }
 
-func createCoreInstancePoolInstance(d *schema.ResourceData, m interface{}) error {
+func createCoreInstancePoolInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstancePoolInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreInstancePoolInstance(d *schema.ResourceData, m interface{}) error {
+func readCoreInstancePoolInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstancePoolInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteCoreInstancePoolInstance(d *schema.ResourceData, m interface{}) error {
+func deleteCoreInstancePoolInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstancePoolInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreInstancePoolInstanceResourceCrud struct {
@@ -187,7 +188,7 @@ func (s *CoreInstancePoolInstanceResourceCrud) DeletedPending() []string {
 	}
 }
 
-func (s *CoreInstancePoolInstanceResourceCrud) Create() error {
+func (s *CoreInstancePoolInstanceResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.AttachInstancePoolInstanceRequest{}
 
 	if instanceId, ok := s.D.GetOkExists("instance_id"); ok {
@@ -202,7 +203,7 @@ func (s *CoreInstancePoolInstanceResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.AttachInstancePoolInstance(context.Background(), request)
+	response, err := s.Client.AttachInstancePoolInstance(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -211,7 +212,7 @@ func (s *CoreInstancePoolInstanceResourceCrud) Create() error {
 	s.Res = &response.InstancePoolInstance
 
 	if workId != nil {
-		identifier, err := tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "instancepool", oci_work_requests.WorkRequestResourceActionTypeRelated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		identifier, err := tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "instancepool", oci_work_requests.WorkRequestResourceActionTypeRelated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if identifier != nil {
 			s.D.SetId(*identifier)
 		}
@@ -223,7 +224,7 @@ func (s *CoreInstancePoolInstanceResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreInstancePoolInstanceResourceCrud) Get() error {
+func (s *CoreInstancePoolInstanceResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetInstancePoolInstanceRequest{}
 	instancePoolId, instanceId, err := parseInstancePoolInstanceCompositeId(s.D.Id())
 	if err == nil {
@@ -235,7 +236,7 @@ func (s *CoreInstancePoolInstanceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetInstancePoolInstance(context.Background(), request)
+	response, err := s.Client.GetInstancePoolInstance(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -244,7 +245,7 @@ func (s *CoreInstancePoolInstanceResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreInstancePoolInstanceResourceCrud) Delete() error {
+func (s *CoreInstancePoolInstanceResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DetachInstancePoolInstanceRequest{}
 	instancePoolId, instanceId, err := parseInstancePoolInstanceCompositeId(s.D.Id())
 	if err == nil {
@@ -266,14 +267,14 @@ func (s *CoreInstancePoolInstanceResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.DetachInstancePoolInstance(context.Background(), request)
+	response, err := s.Client.DetachInstancePoolInstance(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		identifier, err := tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "instancepool", oci_work_requests.WorkRequestResourceActionTypeRelated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		identifier, err := tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "instancepool", oci_work_requests.WorkRequestResourceActionTypeRelated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if identifier != nil {
 			s.D.SetId(*identifier)
 		}
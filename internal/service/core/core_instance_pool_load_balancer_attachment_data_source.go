@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreInstancePoolLoadBalancerAttachmentDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreInstancePoolLoadBalancerAttachment,
+		ReadContext: readSingularCoreInstancePoolLoadBalancerAttachment,
 		Schema: map[string]*schema.Schema{
 			"instance_pool_id": {
 				Type:     schema.TypeString,
@@ -50,12 +51,12 @@ func CoreInstancePoolLoadBalancerAttachmentDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreInstancePoolLoadBalancerAttachment(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreInstancePoolLoadBalancerAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstancePoolLoadBalancerAttachmentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreInstancePoolLoadBalancerAttachmentDataSourceCrud struct {
@@ -68,7 +69,7 @@ func (s *CoreInstancePoolLoadBalancerAttachmentDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreInstancePoolLoadBalancerAttachmentDataSourceCrud) Get() error {
+func (s *CoreInstancePoolLoadBalancerAttachmentDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetInstancePoolLoadBalancerAttachmentRequest{}
 
 	if instancePoolId, ok := s.D.GetOkExists("instance_pool_id"); ok {
@@ -83,7 +84,7 @@ func (s *CoreInstancePoolLoadBalancerAttachmentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetInstancePoolLoadBalancerAttachment(context.Background(), request)
+	response, err := s.Client.GetInstancePoolLoadBalancerAttachment(ctx, request)
 	if err != nil {
 		return err
 	}
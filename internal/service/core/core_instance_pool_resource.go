@@ -9,6 +9,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -35,10 +36,10 @@ func CoreInstancePoolResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("1h"),
 			Delete: tfresource.GetTimeoutDuration("1h"),
 		},
-		Create: createCoreInstancePool,
-		Read:   readCoreInstancePool,
-		Update: updateCoreInstancePool,
-		Delete: deleteCoreInstancePool,
+		CreateContext: createCoreInstancePool,
+		ReadContext:   readCoreInstancePool,
+		UpdateContext: updateCoreInstancePool,
+		DeleteContext: deleteCoreInstancePool,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -194,37 +195,37 @@ func CoreInstancePoolResource() *schema.Resource {
 	}
 }
 
-func createCoreInstancePool(d *schema.ResourceData, m interface{}) error {
+func createCoreInstancePool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstancePoolResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreInstancePool(d *schema.ResourceData, m interface{}) error {
+func readCoreInstancePool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstancePoolResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreInstancePool(d *schema.ResourceData, m interface{}) error {
+func updateCoreInstancePool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstancePoolResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreInstancePool(d *schema.ResourceData, m interface{}) error {
+func deleteCoreInstancePool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstancePoolResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreInstancePoolResourceCrud struct {
@@ -282,7 +283,7 @@ func (s *CoreInstancePoolResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *CoreInstancePoolResourceCrud) Create() error {
+func (s *CoreInstancePoolResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateInstancePoolRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -353,28 +354,28 @@ func (s *CoreInstancePoolResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateInstancePool(context.Background(), request)
+	response, err := s.Client.CreateInstancePool(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.InstancePool
 
-	if waitErr := tfresource.WaitForCreatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForCreatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
 	return nil
 }
 
-func (s *CoreInstancePoolResourceCrud) setInstancePoolDesiredState(instancePoolId *string, desiredState string) (*oci_core.InstancePool, error) {
+func (s *CoreInstancePoolResourceCrud) setInstancePoolDesiredState(ctx context.Context, instancePoolId *string, desiredState string) (*oci_core.InstancePool, error) {
 	switch strings.ToLower(desiredState) {
 	case instancePoolRunningState:
 		startRequest := oci_core.StartInstancePoolRequest{}
 		startRequest.InstancePoolId = instancePoolId
 		startRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-		startResponse, err := s.Client.StartInstancePool(context.Background(), startRequest)
+		startResponse, err := s.Client.StartInstancePool(ctx, startRequest)
 
 		return &startResponse.InstancePool, err
 	case instancePoolStoppedState:
@@ -382,7 +383,7 @@ func (s *CoreInstancePoolResourceCrud) setInstancePoolDesiredState(instancePoolI
 		stopRequest.InstancePoolId = instancePoolId
 		stopRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-		stopResponse, err := s.Client.StopInstancePool(context.Background(), stopRequest)
+		stopResponse, err := s.Client.StopInstancePool(ctx, stopRequest)
 
 		return &stopResponse.InstancePool, err
 	default:
@@ -391,7 +392,7 @@ func (s *CoreInstancePoolResourceCrud) setInstancePoolDesiredState(instancePoolI
 
 }
 
-func (s *CoreInstancePoolResourceCrud) Get() error {
+func (s *CoreInstancePoolResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetInstancePoolRequest{}
 
 	tmp := s.D.Id()
@@ -399,7 +400,7 @@ func (s *CoreInstancePoolResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetInstancePool(context.Background(), request)
+	response, err := s.Client.GetInstancePool(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -408,11 +409,11 @@ func (s *CoreInstancePoolResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreInstancePoolResourceCrud) Update() error {
+func (s *CoreInstancePoolResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -435,7 +436,7 @@ func (s *CoreInstancePoolResourceCrud) Update() error {
 
 	if _, ok := s.D.GetOkExists("load_balancers"); ok && s.D.HasChange("load_balancers") {
 		oldRaw, newRaw := s.D.GetChange("load_balancers")
-		err := s.updateLoadBalancers(oldRaw, newRaw)
+		err := s.updateLoadBalancers(ctx, oldRaw, newRaw)
 		if err != nil {
 			return err
 		}
@@ -478,7 +479,7 @@ func (s *CoreInstancePoolResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateInstancePool(context.Background(), request)
+	response, err := s.Client.UpdateInstancePool(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -486,7 +487,7 @@ func (s *CoreInstancePoolResourceCrud) Update() error {
 	s.Res = &response.InstancePool
 
 	// This update does not support work-request
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -497,7 +498,7 @@ func (s *CoreInstancePoolResourceCrud) Update() error {
 
 		if oldState == instancePoolRunningState && newState == instancePoolStoppedState ||
 			oldState == instancePoolStoppedState && newState == instancePoolRunningState {
-			instancePool, err := s.setInstancePoolDesiredState(response.InstancePool.Id, newState)
+			instancePool, err := s.setInstancePoolDesiredState(ctx, response.InstancePool.Id, newState)
 			if err != nil {
 				return err
 			}
@@ -509,7 +510,7 @@ func (s *CoreInstancePoolResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreInstancePoolResourceCrud) Delete() error {
+func (s *CoreInstancePoolResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.TerminateInstancePoolRequest{}
 
 	tmp := s.D.Id()
@@ -517,7 +518,7 @@ func (s *CoreInstancePoolResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.TerminateInstancePool(context.Background(), request)
+	_, err := s.Client.TerminateInstancePool(ctx, request)
 	return err
 }
 
@@ -783,7 +784,7 @@ func InstancePoolPlacementSecondaryVnicSubnetToMap(obj oci_core.InstancePoolPlac
 	return result
 }
 
-func (s *CoreInstancePoolResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreInstancePoolResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeInstancePoolCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -794,23 +795,25 @@ func (s *CoreInstancePoolResourceCrud) updateCompartment(compartment interface{}
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeInstancePoolCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeInstancePoolCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
 	return nil
 }
 
-/**
-   	This method decides if load_balancers update/attach/detach should go through.
-	Only one operation can happen in single request
-	1. update/attach should happen from the end of the lb list
-	2. detach can happen from anywhere in the list.
+/*
+*
+
+	   	This method decides if load_balancers update/attach/detach should go through.
+		Only one operation can happen in single request
+		1. update/attach should happen from the end of the lb list
+		2. detach can happen from anywhere in the list.
 */
 func (s *CoreInstancePoolResourceCrud) oneEditAway(oldLoadBalancers []oci_core.AttachLoadBalancerDetails, newLoadBalancers []oci_core.AttachLoadBalancerDetails) (bool, string, oci_core.AttachLoadBalancerDetails, oci_core.AttachLoadBalancerDetails, string) {
 	newLbsLength := len(newLoadBalancers)
@@ -855,7 +858,7 @@ func (s *CoreInstancePoolResourceCrud) oneEditAway(oldLoadBalancers []oci_core.A
 	return true, "detach", oldLoadBalancers[oldLbsLength-1], oci_core.AttachLoadBalancerDetails{}, ""
 }
 
-func (s *CoreInstancePoolResourceCrud) updateLoadBalancers(oldRaw interface{}, newRaw interface{}) error {
+func (s *CoreInstancePoolResourceCrud) updateLoadBalancers(ctx context.Context, oldRaw interface{}, newRaw interface{}) error {
 	interfaces := oldRaw.([]interface{})
 	oldBalancers := make([]oci_core.AttachLoadBalancerDetails, len(interfaces))
 	for i, item := range interfaces {
@@ -881,13 +884,13 @@ func (s *CoreInstancePoolResourceCrud) updateLoadBalancers(oldRaw interface{}, n
 		detachLoadBalancerRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 		detachLoadBalancerRequest.InstancePoolId = &id
 		detachLoadBalancerRequest.BackendSetName = oldLoadbalancer.BackendSetName
-		_, err := s.Client.DetachLoadBalancer(context.Background(), detachLoadBalancerRequest)
+		_, err := s.Client.DetachLoadBalancer(ctx, detachLoadBalancerRequest)
 
 		if err != nil {
 			return err
 		}
 
-		_, err = s.pollForLbOperationCompletion(&id, &oldLoadbalancer)
+		_, err = s.pollForLbOperationCompletion(ctx, &id, &oldLoadbalancer)
 
 		if err != nil {
 			return err
@@ -899,13 +902,13 @@ func (s *CoreInstancePoolResourceCrud) updateLoadBalancers(oldRaw interface{}, n
 		attachLoadBalancerRequest.InstancePoolId = &id
 		attachLoadBalancerRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 		attachLoadBalancerRequest.AttachLoadBalancerDetails = newLoadBalancer
-		_, err := s.Client.AttachLoadBalancer(context.Background(), attachLoadBalancerRequest)
+		_, err := s.Client.AttachLoadBalancer(ctx, attachLoadBalancerRequest)
 
 		if err != nil {
 			return err
 		}
 
-		_, err = s.pollForLbOperationCompletion(&id, &attachLoadBalancerRequest.AttachLoadBalancerDetails)
+		_, err = s.pollForLbOperationCompletion(ctx, &id, &attachLoadBalancerRequest.AttachLoadBalancerDetails)
 
 		if err != nil {
 			return err
@@ -930,7 +933,7 @@ func mapToAttachLoadBalancerDetails(item map[string]interface{}) oci_core.Attach
 	return result
 }
 
-func (s *CoreInstancePoolResourceCrud) pollForLbOperationCompletion(poolId *string, lbToTrack *oci_core.AttachLoadBalancerDetails) (*oci_core.InstancePool, error) {
+func (s *CoreInstancePoolResourceCrud) pollForLbOperationCompletion(ctx context.Context, poolId *string, lbToTrack *oci_core.AttachLoadBalancerDetails) (*oci_core.InstancePool, error) {
 	response := oci_core.GetInstancePoolResponse{}
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
@@ -944,7 +947,7 @@ func (s *CoreInstancePoolResourceCrud) pollForLbOperationCompletion(poolId *stri
 		Refresh: func() (interface{}, string, error) {
 			var err error
 
-			response, err = s.Client.GetInstancePool(context.Background(),
+			response, err = s.Client.GetInstancePool(ctx,
 				oci_core.GetInstancePoolRequest{
 					InstancePoolId: poolId,
 				})
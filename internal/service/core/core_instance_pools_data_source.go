@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreInstancePoolsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreInstancePools,
+		ReadContext: readCoreInstancePools,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,12 +40,12 @@ func CoreInstancePoolsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreInstancePools(d *schema.ResourceData, m interface{}) error {
+func readCoreInstancePools(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInstancePoolsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreInstancePoolsDataSourceCrud struct {
@@ -57,7 +58,7 @@ func (s *CoreInstancePoolsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreInstancePoolsDataSourceCrud) Get() error {
+func (s *CoreInstancePoolsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListInstancePoolsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -76,7 +77,7 @@ func (s *CoreInstancePoolsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListInstancePools(context.Background(), request)
+	response, err := s.Client.ListInstancePools(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *CoreInstancePoolsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListInstancePools(context.Background(), request)
+		listResponse, err := s.Client.ListInstancePools(ctx, request)
 		if err != nil {
 			return err
 		}
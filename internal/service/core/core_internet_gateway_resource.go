@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -19,11 +20,11 @@ func CoreInternetGatewayResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreInternetGateway,
-		Read:     readCoreInternetGateway,
-		Update:   updateCoreInternetGateway,
-		Delete:   deleteCoreInternetGateway,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreInternetGateway,
+		ReadContext:   readCoreInternetGateway,
+		UpdateContext: updateCoreInternetGateway,
+		DeleteContext: deleteCoreInternetGateway,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -79,37 +80,37 @@ func CoreInternetGatewayResource() *schema.Resource {
 	}
 }
 
-func createCoreInternetGateway(d *schema.ResourceData, m interface{}) error {
+func createCoreInternetGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInternetGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreInternetGateway(d *schema.ResourceData, m interface{}) error {
+func readCoreInternetGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInternetGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreInternetGateway(d *schema.ResourceData, m interface{}) error {
+func updateCoreInternetGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInternetGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreInternetGateway(d *schema.ResourceData, m interface{}) error {
+func deleteCoreInternetGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInternetGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreInternetGatewayResourceCrud struct {
@@ -147,7 +148,7 @@ func (s *CoreInternetGatewayResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreInternetGatewayResourceCrud) Create() error {
+func (s *CoreInternetGatewayResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateInternetGatewayRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -189,7 +190,7 @@ func (s *CoreInternetGatewayResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateInternetGateway(context.Background(), request)
+	response, err := s.Client.CreateInternetGateway(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -198,7 +199,7 @@ func (s *CoreInternetGatewayResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreInternetGatewayResourceCrud) Get() error {
+func (s *CoreInternetGatewayResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetInternetGatewayRequest{}
 
 	tmp := s.D.Id()
@@ -206,7 +207,7 @@ func (s *CoreInternetGatewayResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetInternetGateway(context.Background(), request)
+	response, err := s.Client.GetInternetGateway(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -215,11 +216,11 @@ func (s *CoreInternetGatewayResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreInternetGatewayResourceCrud) Update() error {
+func (s *CoreInternetGatewayResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -259,7 +260,7 @@ func (s *CoreInternetGatewayResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateInternetGateway(context.Background(), request)
+	response, err := s.Client.UpdateInternetGateway(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -268,7 +269,7 @@ func (s *CoreInternetGatewayResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreInternetGatewayResourceCrud) Delete() error {
+func (s *CoreInternetGatewayResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteInternetGatewayRequest{}
 
 	tmp := s.D.Id()
@@ -276,7 +277,7 @@ func (s *CoreInternetGatewayResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteInternetGateway(context.Background(), request)
+	_, err := s.Client.DeleteInternetGateway(ctx, request)
 	return err
 }
 
@@ -316,7 +317,7 @@ func (s *CoreInternetGatewayResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CoreInternetGatewayResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreInternetGatewayResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeInternetGatewayCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -327,12 +328,12 @@ func (s *CoreInternetGatewayResourceCrud) updateCompartment(compartment interfac
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeInternetGatewayCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeInternetGatewayCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreInternetGatewaysDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreInternetGateways,
+		ReadContext: readCoreInternetGateways,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,12 +44,12 @@ func CoreInternetGatewaysDataSource() *schema.Resource {
 	}
 }
 
-func readCoreInternetGateways(d *schema.ResourceData, m interface{}) error {
+func readCoreInternetGateways(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreInternetGatewaysDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreInternetGatewaysDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *CoreInternetGatewaysDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreInternetGatewaysDataSourceCrud) Get() error {
+func (s *CoreInternetGatewaysDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListInternetGatewaysRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -85,7 +86,7 @@ func (s *CoreInternetGatewaysDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListInternetGateways(context.Background(), request)
+	response, err := s.Client.ListInternetGateways(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *CoreInternetGatewaysDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListInternetGateways(context.Background(), request)
+		listResponse, err := s.Client.ListInternetGateways(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreIpsecAlgorithmDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreIpsecAlgorithm,
+		ReadContext: readSingularCoreIpsecAlgorithm,
 		Schema: map[string]*schema.Schema{
 			// Computed
 			"allowed_phase_one_parameters": {
@@ -155,12 +156,12 @@ func CoreIpsecAlgorithmDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreIpsecAlgorithm(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreIpsecAlgorithm(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpsecAlgorithmDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreIpsecAlgorithmDataSourceCrud struct {
@@ -173,12 +174,12 @@ func (s *CoreIpsecAlgorithmDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreIpsecAlgorithmDataSourceCrud) Get() error {
+func (s *CoreIpsecAlgorithmDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetAllowedIkeIPSecParametersRequest{}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetAllowedIkeIPSecParameters(context.Background(), request)
+	response, err := s.Client.GetAllowedIkeIPSecParameters(ctx, request)
 	if err != nil {
 		return err
 	}
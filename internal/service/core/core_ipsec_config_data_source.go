@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreIpSecConnectionDeviceConfigDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreIpSecConnectionDeviceConfig,
+		ReadContext: readSingularCoreIpSecConnectionDeviceConfig,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"ipsec_id": {
@@ -60,12 +61,12 @@ func CoreIpSecConnectionDeviceConfigDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreIpSecConnectionDeviceConfig(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreIpSecConnectionDeviceConfig(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpSecConnectionDeviceConfigDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreIpSecConnectionDeviceConfigDataSourceCrud struct {
@@ -78,7 +79,7 @@ func (s *CoreIpSecConnectionDeviceConfigDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreIpSecConnectionDeviceConfigDataSourceCrud) Get() error {
+func (s *CoreIpSecConnectionDeviceConfigDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetIPSecConnectionDeviceConfigRequest{}
 
 	if ipsecId, ok := s.D.GetOkExists("ipsec_id"); ok {
@@ -88,7 +89,7 @@ func (s *CoreIpSecConnectionDeviceConfigDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetIPSecConnectionDeviceConfig(context.Background(), request)
+	response, err := s.Client.GetIPSecConnectionDeviceConfig(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreIpSecConnectionTunnelDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreIpSecConnectionTunnel,
+		ReadContext: readSingularCoreIpSecConnectionTunnel,
 		Schema: map[string]*schema.Schema{
 			"ipsec_id": {
 				Type:     schema.TypeString,
@@ -134,11 +135,11 @@ func CoreIpSecConnectionTunnelDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreIpSecConnectionTunnel(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreIpSecConnectionTunnel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpSecConnectionTunnelDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreIpSecConnectionTunnelDataSourceCrud struct {
@@ -151,7 +152,7 @@ func (s *CoreIpSecConnectionTunnelDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreIpSecConnectionTunnelDataSourceCrud) Get() error {
+func (s *CoreIpSecConnectionTunnelDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetIPSecConnectionTunnelRequest{}
 
 	if ipsecId, ok := s.D.GetOkExists("ipsec_id"); ok {
@@ -166,7 +167,7 @@ func (s *CoreIpSecConnectionTunnelDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetIPSecConnectionTunnel(context.Background(), request)
+	response, err := s.Client.GetIPSecConnectionTunnel(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreIpsecConnectionTunnelErrorDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreIpsecConnectionTunnelError,
+		ReadContext: readSingularCoreIpsecConnectionTunnelError,
 		Schema: map[string]*schema.Schema{
 			"ipsec_id": {
 				Type:     schema.TypeString,
@@ -50,12 +51,12 @@ func CoreIpsecConnectionTunnelErrorDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreIpsecConnectionTunnelError(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreIpsecConnectionTunnelError(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpsecConnectionTunnelErrorDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreIpsecConnectionTunnelErrorDataSourceCrud struct {
@@ -68,7 +69,7 @@ func (s *CoreIpsecConnectionTunnelErrorDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreIpsecConnectionTunnelErrorDataSourceCrud) Get() error {
+func (s *CoreIpsecConnectionTunnelErrorDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetIPSecConnectionTunnelErrorRequest{}
 
 	if ipsecId, ok := s.D.GetOkExists("ipsec_id"); ok {
@@ -83,7 +84,7 @@ func (s *CoreIpsecConnectionTunnelErrorDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetIPSecConnectionTunnelError(context.Background(), request)
+	response, err := s.Client.GetIPSecConnectionTunnelError(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -18,11 +19,11 @@ import (
 
 func CoreIpSecConnectionTunnelManagementResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreIpSecConnectionTunnelManagement,
-		Read:     readCoreIpSecConnectionTunnelManagement,
-		Update:   updateCoreIpSecConnectionTunnelManagement,
-		Delete:   deleteCoreIpSecConnectionTunnelManagement,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreIpSecConnectionTunnelManagement,
+		ReadContext:   readCoreIpSecConnectionTunnelManagement,
+		UpdateContext: updateCoreIpSecConnectionTunnelManagement,
+		DeleteContext: deleteCoreIpSecConnectionTunnelManagement,
 		Schema: map[string]*schema.Schema{
 			"ipsec_id": {
 				Type:     schema.TypeString,
@@ -317,34 +318,34 @@ func CoreIpSecConnectionTunnelManagementResource() *schema.Resource {
 	}
 }
 
-func createCoreIpSecConnectionTunnelManagement(d *schema.ResourceData, m interface{}) error {
+func createCoreIpSecConnectionTunnelManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpSecConnectionTunnelManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreIpSecConnectionTunnelManagement(d *schema.ResourceData, m interface{}) error {
+func readCoreIpSecConnectionTunnelManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpSecConnectionTunnelManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreIpSecConnectionTunnelManagement(d *schema.ResourceData, m interface{}) error {
+func updateCoreIpSecConnectionTunnelManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpSecConnectionTunnelManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreIpSecConnectionTunnelManagement(d *schema.ResourceData, m interface{}) error {
+func deleteCoreIpSecConnectionTunnelManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpSecConnectionTunnelManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreIpSecConnectionTunnelManagementResourceCrud struct {
@@ -383,11 +384,11 @@ func (s *CoreIpSecConnectionTunnelManagementResourceCrud) UpdatedTarget() []stri
 	}
 }
 
-func (s *CoreIpSecConnectionTunnelManagementResourceCrud) Create() error {
-	return s.Update()
+func (s *CoreIpSecConnectionTunnelManagementResourceCrud) Create(ctx context.Context) error {
+	return s.Update(ctx)
 }
 
-func (s *CoreIpSecConnectionTunnelManagementResourceCrud) Get() error {
+func (s *CoreIpSecConnectionTunnelManagementResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetIPSecConnectionTunnelRequest{}
 
 	if ipsecId, ok := s.D.GetOkExists("ipsec_id"); ok {
@@ -402,7 +403,7 @@ func (s *CoreIpSecConnectionTunnelManagementResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetIPSecConnectionTunnel(context.Background(), request)
+	response, err := s.Client.GetIPSecConnectionTunnel(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -415,7 +416,7 @@ func (s *CoreIpSecConnectionTunnelManagementResourceCrud) Get() error {
 
 	secretRequest.TunnelId = request.TunnelId
 
-	secretResponse, err := s.Client.GetIPSecConnectionTunnelSharedSecret(context.Background(), secretRequest)
+	secretResponse, err := s.Client.GetIPSecConnectionTunnelSharedSecret(ctx, secretRequest)
 	if err != nil {
 		return err
 	}
@@ -425,7 +426,7 @@ func (s *CoreIpSecConnectionTunnelManagementResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreIpSecConnectionTunnelManagementResourceCrud) Update() error {
+func (s *CoreIpSecConnectionTunnelManagementResourceCrud) Update(ctx context.Context) error {
 	if s.D.HasChange("shared_secret") {
 		if sharedSecret, ok := s.D.GetOkExists("shared_secret"); ok {
 			secretUpdateRequest := oci_core.UpdateIPSecConnectionTunnelSharedSecretRequest{}
@@ -441,13 +442,13 @@ func (s *CoreIpSecConnectionTunnelManagementResourceCrud) Update() error {
 				tmp := tunnelId.(string)
 				secretUpdateRequest.TunnelId = &tmp
 			}
-			_, err := s.Client.UpdateIPSecConnectionTunnelSharedSecret(context.Background(), secretUpdateRequest)
+			_, err := s.Client.UpdateIPSecConnectionTunnelSharedSecret(ctx, secretUpdateRequest)
 			if err != nil {
 				return err
 			}
 
 			retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_core.IpSecConnectionTunnelLifecycleStateAvailable }
-			if conditionErr := tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate)); conditionErr != nil {
+			if conditionErr := tfresource.WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate)); conditionErr != nil {
 				return conditionErr
 			}
 		}
@@ -618,7 +619,7 @@ func (s *CoreIpSecConnectionTunnelManagementResourceCrud) Update() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
-	response, err := s.Client.UpdateIPSecConnectionTunnel(context.Background(), request)
+	response, err := s.Client.UpdateIPSecConnectionTunnel(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -626,7 +627,7 @@ func (s *CoreIpSecConnectionTunnelManagementResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreIpSecConnectionTunnelManagementResourceCrud) Delete() error {
+func (s *CoreIpSecConnectionTunnelManagementResourceCrud) Delete(ctx context.Context) error {
 	return nil
 }
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -16,7 +17,7 @@ import (
 
 func CoreIpsecConnectionTunnelRoutesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreIpsecConnectionTunnelRoutes,
+		ReadContext: readCoreIpsecConnectionTunnelRoutes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"advertiser": {
@@ -71,12 +72,12 @@ func CoreIpsecConnectionTunnelRoutesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreIpsecConnectionTunnelRoutes(d *schema.ResourceData, m interface{}) error {
+func readCoreIpsecConnectionTunnelRoutes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpsecConnectionTunnelRoutesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreIpsecConnectionTunnelRoutesDataSourceCrud struct {
@@ -89,7 +90,7 @@ func (s *CoreIpsecConnectionTunnelRoutesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreIpsecConnectionTunnelRoutesDataSourceCrud) Get() error {
+func (s *CoreIpsecConnectionTunnelRoutesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListIPSecConnectionTunnelRoutesRequest{}
 
 	if advertiser, ok := s.D.GetOkExists("advertiser"); ok {
@@ -108,7 +109,7 @@ func (s *CoreIpsecConnectionTunnelRoutesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListIPSecConnectionTunnelRoutes(context.Background(), request)
+	response, err := s.Client.ListIPSecConnectionTunnelRoutes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -117,7 +118,7 @@ func (s *CoreIpsecConnectionTunnelRoutesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIPSecConnectionTunnelRoutes(context.Background(), request)
+		listResponse, err := s.Client.ListIPSecConnectionTunnelRoutes(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -16,7 +17,7 @@ import (
 
 func CoreIpSecConnectionTunnelsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreIpSecConnectionTunnels,
+		ReadContext: readCoreIpSecConnectionTunnels,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"ipsec_id": {
@@ -290,12 +291,12 @@ func CoreIpSecConnectionTunnelsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreIpSecConnectionTunnels(d *schema.ResourceData, m interface{}) error {
+func readCoreIpSecConnectionTunnels(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpSecConnectionTunnelsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreIpSecConnectionTunnelsDataSourceCrud struct {
@@ -308,7 +309,7 @@ func (s *CoreIpSecConnectionTunnelsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreIpSecConnectionTunnelsDataSourceCrud) Get() error {
+func (s *CoreIpSecConnectionTunnelsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListIPSecConnectionTunnelsRequest{}
 
 	if ipsecId, ok := s.D.GetOkExists("ipsec_id"); ok {
@@ -318,7 +319,7 @@ func (s *CoreIpSecConnectionTunnelsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListIPSecConnectionTunnels(context.Background(), request)
+	response, err := s.Client.ListIPSecConnectionTunnels(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -327,7 +328,7 @@ func (s *CoreIpSecConnectionTunnelsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIPSecConnectionTunnels(context.Background(), request)
+		listResponse, err := s.Client.ListIPSecConnectionTunnels(ctx, request)
 		if err != nil {
 			return err
 		}
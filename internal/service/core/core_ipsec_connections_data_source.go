@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreIpSecConnectionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreIpSecConnections,
+		ReadContext: readCoreIpSecConnections,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,12 +40,12 @@ func CoreIpSecConnectionsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreIpSecConnections(d *schema.ResourceData, m interface{}) error {
+func readCoreIpSecConnections(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpSecConnectionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreIpSecConnectionsDataSourceCrud struct {
@@ -57,7 +58,7 @@ func (s *CoreIpSecConnectionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreIpSecConnectionsDataSourceCrud) Get() error {
+func (s *CoreIpSecConnectionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListIPSecConnectionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -77,7 +78,7 @@ func (s *CoreIpSecConnectionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListIPSecConnections(context.Background(), request)
+	response, err := s.Client.ListIPSecConnections(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *CoreIpSecConnectionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIPSecConnections(context.Background(), request)
+		listResponse, err := s.Client.ListIPSecConnections(ctx, request)
 		if err != nil {
 			return err
 		}
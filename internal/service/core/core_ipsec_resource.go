@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -19,11 +20,11 @@ func CoreIpSecConnectionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreIpSecConnection,
-		Read:     readCoreIpSecConnection,
-		Update:   updateCoreIpSecConnection,
-		Delete:   deleteCoreIpSecConnection,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreIpSecConnection,
+		ReadContext:   readCoreIpSecConnection,
+		UpdateContext: updateCoreIpSecConnection,
+		DeleteContext: deleteCoreIpSecConnection,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -91,37 +92,37 @@ func CoreIpSecConnectionResource() *schema.Resource {
 	}
 }
 
-func createCoreIpSecConnection(d *schema.ResourceData, m interface{}) error {
+func createCoreIpSecConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpSecConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreIpSecConnection(d *schema.ResourceData, m interface{}) error {
+func readCoreIpSecConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpSecConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreIpSecConnection(d *schema.ResourceData, m interface{}) error {
+func updateCoreIpSecConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpSecConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreIpSecConnection(d *schema.ResourceData, m interface{}) error {
+func deleteCoreIpSecConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpSecConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreIpSecConnectionResourceCrud struct {
@@ -171,7 +172,7 @@ func (s *CoreIpSecConnectionResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *CoreIpSecConnectionResourceCrud) Create() error {
+func (s *CoreIpSecConnectionResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateIPSecConnectionRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -229,7 +230,7 @@ func (s *CoreIpSecConnectionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateIPSecConnection(context.Background(), request)
+	response, err := s.Client.CreateIPSecConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -238,7 +239,7 @@ func (s *CoreIpSecConnectionResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreIpSecConnectionResourceCrud) Get() error {
+func (s *CoreIpSecConnectionResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetIPSecConnectionRequest{}
 
 	tmp := s.D.Id()
@@ -246,7 +247,7 @@ func (s *CoreIpSecConnectionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetIPSecConnection(context.Background(), request)
+	response, err := s.Client.GetIPSecConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -255,11 +256,11 @@ func (s *CoreIpSecConnectionResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreIpSecConnectionResourceCrud) Update() error {
+func (s *CoreIpSecConnectionResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -310,7 +311,7 @@ func (s *CoreIpSecConnectionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateIPSecConnection(context.Background(), request)
+	response, err := s.Client.UpdateIPSecConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -319,7 +320,7 @@ func (s *CoreIpSecConnectionResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreIpSecConnectionResourceCrud) Delete() error {
+func (s *CoreIpSecConnectionResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteIPSecConnectionRequest{}
 
 	tmp := s.D.Id()
@@ -327,7 +328,7 @@ func (s *CoreIpSecConnectionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteIPSecConnection(context.Background(), request)
+	_, err := s.Client.DeleteIPSecConnection(ctx, request)
 	return err
 }
 
@@ -371,7 +372,7 @@ func (s *CoreIpSecConnectionResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CoreIpSecConnectionResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreIpSecConnectionResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeIPSecConnectionCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -382,12 +383,12 @@ func (s *CoreIpSecConnectionResourceCrud) updateCompartment(compartment interfac
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeIPSecConnectionCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeIPSecConnectionCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
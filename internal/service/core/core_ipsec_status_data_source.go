@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreIpSecConnectionDeviceStatusDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreIpSecConnectionDeviceStatus,
+		ReadContext: readSingularCoreIpSecConnectionDeviceStatus,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"ipsec_id": {
@@ -64,12 +65,12 @@ func CoreIpSecConnectionDeviceStatusDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreIpSecConnectionDeviceStatus(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreIpSecConnectionDeviceStatus(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpSecConnectionDeviceStatusDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreIpSecConnectionDeviceStatusDataSourceCrud struct {
@@ -82,7 +83,7 @@ func (s *CoreIpSecConnectionDeviceStatusDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreIpSecConnectionDeviceStatusDataSourceCrud) Get() error {
+func (s *CoreIpSecConnectionDeviceStatusDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetIPSecConnectionDeviceStatusRequest{}
 
 	if ipsecId, ok := s.D.GetOkExists("ipsec_id"); ok {
@@ -92,7 +93,7 @@ func (s *CoreIpSecConnectionDeviceStatusDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetIPSecConnectionDeviceStatus(context.Background(), request)
+	response, err := s.Client.GetIPSecConnectionDeviceStatus(ctx, request)
 	if err != nil {
 		return err
 	}
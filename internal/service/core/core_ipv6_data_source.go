@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -22,12 +23,12 @@ func CoreIpv6DataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreIpv6Resource(), fieldMap, readSingularCoreIpv6)
 }
 
-func readSingularCoreIpv6(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreIpv6(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpv6DataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreIpv6DataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *CoreIpv6DataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreIpv6DataSourceCrud) Get() error {
+func (s *CoreIpv6DataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetIpv6Request{}
 
 	if ipv6Id, ok := s.D.GetOkExists("ipv6id"); ok {
@@ -50,7 +51,7 @@ func (s *CoreIpv6DataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetIpv6(context.Background(), request)
+	response, err := s.Client.GetIpv6(ctx, request)
 	if err != nil {
 		return err
 	}
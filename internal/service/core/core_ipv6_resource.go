@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -19,11 +20,11 @@ func CoreIpv6Resource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreIpv6,
-		Read:     readCoreIpv6,
-		Update:   updateCoreIpv6,
-		Delete:   deleteCoreIpv6,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreIpv6,
+		ReadContext:   readCoreIpv6,
+		UpdateContext: updateCoreIpv6,
+		DeleteContext: deleteCoreIpv6,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"vnic_id": {
@@ -84,37 +85,37 @@ func CoreIpv6Resource() *schema.Resource {
 	}
 }
 
-func createCoreIpv6(d *schema.ResourceData, m interface{}) error {
+func createCoreIpv6(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpv6ResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreIpv6(d *schema.ResourceData, m interface{}) error {
+func readCoreIpv6(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpv6ResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreIpv6(d *schema.ResourceData, m interface{}) error {
+func updateCoreIpv6(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpv6ResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreIpv6(d *schema.ResourceData, m interface{}) error {
+func deleteCoreIpv6(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpv6ResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreIpv6ResourceCrud struct {
@@ -152,7 +153,7 @@ func (s *CoreIpv6ResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreIpv6ResourceCrud) Create() error {
+func (s *CoreIpv6ResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateIpv6Request{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -189,7 +190,7 @@ func (s *CoreIpv6ResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateIpv6(context.Background(), request)
+	response, err := s.Client.CreateIpv6(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -198,7 +199,7 @@ func (s *CoreIpv6ResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreIpv6ResourceCrud) Get() error {
+func (s *CoreIpv6ResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetIpv6Request{}
 
 	tmp := s.D.Id()
@@ -206,7 +207,7 @@ func (s *CoreIpv6ResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetIpv6(context.Background(), request)
+	response, err := s.Client.GetIpv6(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -215,7 +216,7 @@ func (s *CoreIpv6ResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreIpv6ResourceCrud) Update() error {
+func (s *CoreIpv6ResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateIpv6Request{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -245,7 +246,7 @@ func (s *CoreIpv6ResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateIpv6(context.Background(), request)
+	response, err := s.Client.UpdateIpv6(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -254,7 +255,7 @@ func (s *CoreIpv6ResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreIpv6ResourceCrud) Delete() error {
+func (s *CoreIpv6ResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteIpv6Request{}
 
 	tmp := s.D.Id()
@@ -262,7 +263,7 @@ func (s *CoreIpv6ResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteIpv6(context.Background(), request)
+	_, err := s.Client.DeleteIpv6(ctx, request)
 	return err
 }
 
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreIpv6sDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreIpv6s,
+		ReadContext: readCoreIpv6s,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"ip_address": {
@@ -39,12 +40,12 @@ func CoreIpv6sDataSource() *schema.Resource {
 	}
 }
 
-func readCoreIpv6s(d *schema.ResourceData, m interface{}) error {
+func readCoreIpv6s(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreIpv6sDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreIpv6sDataSourceCrud struct {
@@ -57,7 +58,7 @@ func (s *CoreIpv6sDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreIpv6sDataSourceCrud) Get() error {
+func (s *CoreIpv6sDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListIpv6sRequest{}
 
 	if ipAddress, ok := s.D.GetOkExists("ip_address"); ok {
@@ -77,7 +78,7 @@ func (s *CoreIpv6sDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListIpv6s(context.Background(), request)
+	response, err := s.Client.ListIpv6s(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *CoreIpv6sDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIpv6s(context.Background(), request)
+		listResponse, err := s.Client.ListIpv6s(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreLetterOfAuthorityDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreLetterOfAuthority,
+		ReadContext: readSingularCoreLetterOfAuthority,
 		Schema: map[string]*schema.Schema{
 			"cross_connect_id": {
 				Type:     schema.TypeString,
@@ -50,12 +51,12 @@ func CoreLetterOfAuthorityDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreLetterOfAuthority(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreLetterOfAuthority(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreLetterOfAuthorityDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreLetterOfAuthorityDataSourceCrud struct {
@@ -68,7 +69,7 @@ func (s *CoreLetterOfAuthorityDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreLetterOfAuthorityDataSourceCrud) Get() error {
+func (s *CoreLetterOfAuthorityDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetCrossConnectLetterOfAuthorityRequest{}
 
 	if crossConnectId, ok := s.D.GetOkExists("cross_connect_id"); ok {
@@ -78,7 +79,7 @@ func (s *CoreLetterOfAuthorityDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetCrossConnectLetterOfAuthority(context.Background(), request)
+	response, err := s.Client.GetCrossConnectLetterOfAuthority(ctx, request)
 	if err != nil {
 		return err
 	}
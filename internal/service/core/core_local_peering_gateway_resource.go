@@ -9,6 +9,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -23,11 +24,11 @@ func CoreLocalPeeringGatewayResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreLocalPeeringGateway,
-		Read:     readCoreLocalPeeringGateway,
-		Update:   updateCoreLocalPeeringGateway,
-		Delete:   deleteCoreLocalPeeringGateway,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreLocalPeeringGateway,
+		ReadContext:   readCoreLocalPeeringGateway,
+		UpdateContext: updateCoreLocalPeeringGateway,
+		DeleteContext: deleteCoreLocalPeeringGateway,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -109,42 +110,42 @@ func CoreLocalPeeringGatewayResource() *schema.Resource {
 	}
 }
 
-func createCoreLocalPeeringGateway(d *schema.ResourceData, m interface{}) error {
+func createCoreLocalPeeringGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreLocalPeeringGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	err := tfresource.CreateResource(d, sync)
+	err := tfresource.CreateResource(ctx, d, sync)
 	if err != nil {
-		return err
+		return tfresource.HandleDiagError(err)
 	}
 	//This needs to be here rather than in the Create() because we want the resource to finish provisioning and set to the statefile before we connect
-	return sync.ConnectLocalPeeringGateway()
+	return tfresource.HandleDiagError(sync.ConnectLocalPeeringGateway(ctx))
 }
 
-func readCoreLocalPeeringGateway(d *schema.ResourceData, m interface{}) error {
+func readCoreLocalPeeringGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreLocalPeeringGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreLocalPeeringGateway(d *schema.ResourceData, m interface{}) error {
+func updateCoreLocalPeeringGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreLocalPeeringGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreLocalPeeringGateway(d *schema.ResourceData, m interface{}) error {
+func deleteCoreLocalPeeringGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreLocalPeeringGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreLocalPeeringGatewayResourceCrud struct {
@@ -182,7 +183,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreLocalPeeringGatewayResourceCrud) ConnectLocalPeeringGateway() error {
+func (s *CoreLocalPeeringGatewayResourceCrud) ConnectLocalPeeringGateway(ctx context.Context) error {
 	if s.Res == nil || s.Res.Id == nil {
 		return fmt.Errorf("CreateLocalPeeringGateway returned a nil LocalPeeringGateway or a LocalPeeringGateway without an ID")
 	}
@@ -197,7 +198,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) ConnectLocalPeeringGateway() error
 
 		connectRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-		_, err := s.Client.ConnectLocalPeeringGateways(context.Background(), connectRequest)
+		_, err := s.Client.ConnectLocalPeeringGateways(ctx, connectRequest)
 		if err != nil {
 			// we set peer_id to "" so that terraform detects a forceNew change on the next apply and the user can try the connection again
 			s.D.Set("peer_id", "")
@@ -211,7 +212,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) ConnectLocalPeeringGateway() error
 
 		request.RequestMetadata.RetryPolicy = getLocalPeeringGatewayRetryPolicy(s.D.Timeout(schema.TimeoutCreate))
 
-		response, getError := s.Client.GetLocalPeeringGateway(context.Background(), request)
+		response, getError := s.Client.GetLocalPeeringGateway(ctx, request)
 		if getError != nil {
 			log.Printf("[DEBUG] Get error while waiting for peering connection to finish: %+v", getError)
 			return getError
@@ -229,7 +230,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) ConnectLocalPeeringGateway() error
 	return nil
 }
 
-func (s *CoreLocalPeeringGatewayResourceCrud) Create() error {
+func (s *CoreLocalPeeringGatewayResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateLocalPeeringGatewayRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -266,7 +267,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateLocalPeeringGateway(context.Background(), request)
+	response, err := s.Client.CreateLocalPeeringGateway(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -275,7 +276,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreLocalPeeringGatewayResourceCrud) Get() error {
+func (s *CoreLocalPeeringGatewayResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetLocalPeeringGatewayRequest{}
 
 	tmp := s.D.Id()
@@ -283,7 +284,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetLocalPeeringGateway(context.Background(), request)
+	response, err := s.Client.GetLocalPeeringGateway(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -292,11 +293,11 @@ func (s *CoreLocalPeeringGatewayResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreLocalPeeringGatewayResourceCrud) Update() error {
+func (s *CoreLocalPeeringGatewayResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -331,7 +332,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateLocalPeeringGateway(context.Background(), request)
+	response, err := s.Client.UpdateLocalPeeringGateway(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -340,7 +341,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreLocalPeeringGatewayResourceCrud) Delete() error {
+func (s *CoreLocalPeeringGatewayResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteLocalPeeringGatewayRequest{}
 
 	tmp := s.D.Id()
@@ -348,7 +349,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteLocalPeeringGateway(context.Background(), request)
+	_, err := s.Client.DeleteLocalPeeringGateway(ctx, request)
 	return err
 }
 
@@ -404,7 +405,7 @@ func (s *CoreLocalPeeringGatewayResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CoreLocalPeeringGatewayResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreLocalPeeringGatewayResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeLocalPeeringGatewayCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -415,12 +416,12 @@ func (s *CoreLocalPeeringGatewayResourceCrud) updateCompartment(compartment inte
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeLocalPeeringGatewayCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeLocalPeeringGatewayCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreLocalPeeringGatewaysDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreLocalPeeringGateways,
+		ReadContext: readCoreLocalPeeringGateways,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -35,12 +36,12 @@ func CoreLocalPeeringGatewaysDataSource() *schema.Resource {
 	}
 }
 
-func readCoreLocalPeeringGateways(d *schema.ResourceData, m interface{}) error {
+func readCoreLocalPeeringGateways(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreLocalPeeringGatewaysDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreLocalPeeringGatewaysDataSourceCrud struct {
@@ -53,7 +54,7 @@ func (s *CoreLocalPeeringGatewaysDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreLocalPeeringGatewaysDataSourceCrud) Get() error {
+func (s *CoreLocalPeeringGatewaysDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListLocalPeeringGatewaysRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -68,7 +69,7 @@ func (s *CoreLocalPeeringGatewaysDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListLocalPeeringGateways(context.Background(), request)
+	response, err := s.Client.ListLocalPeeringGateways(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -77,7 +78,7 @@ func (s *CoreLocalPeeringGatewaysDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListLocalPeeringGateways(context.Background(), request)
+		listResponse, err := s.Client.ListLocalPeeringGateways(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -22,12 +23,12 @@ func CoreNatGatewayDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreNatGatewayResource(), fieldMap, readSingularCoreNatGateway)
 }
 
-func readSingularCoreNatGateway(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreNatGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreNatGatewayDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreNatGatewayDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *CoreNatGatewayDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreNatGatewayDataSourceCrud) Get() error {
+func (s *CoreNatGatewayDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetNatGatewayRequest{}
 
 	if natGatewayId, ok := s.D.GetOkExists("nat_gateway_id"); ok {
@@ -50,7 +51,7 @@ func (s *CoreNatGatewayDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetNatGateway(context.Background(), request)
+	response, err := s.Client.GetNatGateway(ctx, request)
 	if err != nil {
 		return err
 	}
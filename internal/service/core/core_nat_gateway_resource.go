@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -19,11 +20,11 @@ func CoreNatGatewayResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreNatGateway,
-		Read:     readCoreNatGateway,
-		Update:   updateCoreNatGateway,
-		Delete:   deleteCoreNatGateway,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreNatGateway,
+		ReadContext:   readCoreNatGateway,
+		UpdateContext: updateCoreNatGateway,
+		DeleteContext: deleteCoreNatGateway,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -89,37 +90,37 @@ func CoreNatGatewayResource() *schema.Resource {
 	}
 }
 
-func createCoreNatGateway(d *schema.ResourceData, m interface{}) error {
+func createCoreNatGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreNatGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreNatGateway(d *schema.ResourceData, m interface{}) error {
+func readCoreNatGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreNatGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreNatGateway(d *schema.ResourceData, m interface{}) error {
+func updateCoreNatGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreNatGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreNatGateway(d *schema.ResourceData, m interface{}) error {
+func deleteCoreNatGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreNatGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreNatGatewayResourceCrud struct {
@@ -157,7 +158,7 @@ func (s *CoreNatGatewayResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreNatGatewayResourceCrud) Create() error {
+func (s *CoreNatGatewayResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateNatGatewayRequest{}
 
 	if blockTraffic, ok := s.D.GetOkExists("block_traffic"); ok {
@@ -204,7 +205,7 @@ func (s *CoreNatGatewayResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateNatGateway(context.Background(), request)
+	response, err := s.Client.CreateNatGateway(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -213,7 +214,7 @@ func (s *CoreNatGatewayResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreNatGatewayResourceCrud) Get() error {
+func (s *CoreNatGatewayResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetNatGatewayRequest{}
 
 	tmp := s.D.Id()
@@ -221,7 +222,7 @@ func (s *CoreNatGatewayResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetNatGateway(context.Background(), request)
+	response, err := s.Client.GetNatGateway(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -230,11 +231,11 @@ func (s *CoreNatGatewayResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreNatGatewayResourceCrud) Update() error {
+func (s *CoreNatGatewayResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -274,7 +275,7 @@ func (s *CoreNatGatewayResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateNatGateway(context.Background(), request)
+	response, err := s.Client.UpdateNatGateway(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -283,7 +284,7 @@ func (s *CoreNatGatewayResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreNatGatewayResourceCrud) Delete() error {
+func (s *CoreNatGatewayResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteNatGatewayRequest{}
 
 	tmp := s.D.Id()
@@ -291,7 +292,7 @@ func (s *CoreNatGatewayResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteNatGateway(context.Background(), request)
+	_, err := s.Client.DeleteNatGateway(ctx, request)
 	return err
 }
 
@@ -339,7 +340,7 @@ func (s *CoreNatGatewayResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CoreNatGatewayResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreNatGatewayResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeNatGatewayCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -350,12 +351,12 @@ func (s *CoreNatGatewayResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeNatGatewayCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeNatGatewayCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -22,12 +23,12 @@ func CoreNetworkSecurityGroupDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreNetworkSecurityGroupResource(), fieldMap, readSingularCoreNetworkSecurityGroup)
 }
 
-func readSingularCoreNetworkSecurityGroup(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreNetworkSecurityGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreNetworkSecurityGroupDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreNetworkSecurityGroupDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *CoreNetworkSecurityGroupDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreNetworkSecurityGroupDataSourceCrud) Get() error {
+func (s *CoreNetworkSecurityGroupDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetNetworkSecurityGroupRequest{}
 
 	if networkSecurityGroupId, ok := s.D.GetOkExists("network_security_group_id"); ok {
@@ -50,7 +51,7 @@ func (s *CoreNetworkSecurityGroupDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetNetworkSecurityGroup(context.Background(), request)
+	response, err := s.Client.GetNetworkSecurityGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -19,11 +20,11 @@ func CoreNetworkSecurityGroupResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreNetworkSecurityGroup,
-		Read:     readCoreNetworkSecurityGroup,
-		Update:   updateCoreNetworkSecurityGroup,
-		Delete:   deleteCoreNetworkSecurityGroup,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreNetworkSecurityGroup,
+		ReadContext:   readCoreNetworkSecurityGroup,
+		UpdateContext: updateCoreNetworkSecurityGroup,
+		DeleteContext: deleteCoreNetworkSecurityGroup,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -69,37 +70,37 @@ func CoreNetworkSecurityGroupResource() *schema.Resource {
 	}
 }
 
-func createCoreNetworkSecurityGroup(d *schema.ResourceData, m interface{}) error {
+func createCoreNetworkSecurityGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreNetworkSecurityGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreNetworkSecurityGroup(d *schema.ResourceData, m interface{}) error {
+func readCoreNetworkSecurityGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreNetworkSecurityGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreNetworkSecurityGroup(d *schema.ResourceData, m interface{}) error {
+func updateCoreNetworkSecurityGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreNetworkSecurityGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreNetworkSecurityGroup(d *schema.ResourceData, m interface{}) error {
+func deleteCoreNetworkSecurityGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreNetworkSecurityGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreNetworkSecurityGroupResourceCrud struct {
@@ -137,7 +138,7 @@ func (s *CoreNetworkSecurityGroupResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreNetworkSecurityGroupResourceCrud) Create() error {
+func (s *CoreNetworkSecurityGroupResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateNetworkSecurityGroupRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -169,7 +170,7 @@ func (s *CoreNetworkSecurityGroupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateNetworkSecurityGroup(context.Background(), request)
+	response, err := s.Client.CreateNetworkSecurityGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -178,7 +179,7 @@ func (s *CoreNetworkSecurityGroupResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreNetworkSecurityGroupResourceCrud) Get() error {
+func (s *CoreNetworkSecurityGroupResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetNetworkSecurityGroupRequest{}
 
 	tmp := s.D.Id()
@@ -186,7 +187,7 @@ func (s *CoreNetworkSecurityGroupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetNetworkSecurityGroup(context.Background(), request)
+	response, err := s.Client.GetNetworkSecurityGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -195,11 +196,11 @@ func (s *CoreNetworkSecurityGroupResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreNetworkSecurityGroupResourceCrud) Update() error {
+func (s *CoreNetworkSecurityGroupResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -229,7 +230,7 @@ func (s *CoreNetworkSecurityGroupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateNetworkSecurityGroup(context.Background(), request)
+	response, err := s.Client.UpdateNetworkSecurityGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -238,7 +239,7 @@ func (s *CoreNetworkSecurityGroupResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreNetworkSecurityGroupResourceCrud) Delete() error {
+func (s *CoreNetworkSecurityGroupResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteNetworkSecurityGroupRequest{}
 
 	tmp := s.D.Id()
@@ -246,7 +247,7 @@ func (s *CoreNetworkSecurityGroupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteNetworkSecurityGroup(context.Background(), request)
+	_, err := s.Client.DeleteNetworkSecurityGroup(ctx, request)
 	return err
 }
 
@@ -278,7 +279,7 @@ func (s *CoreNetworkSecurityGroupResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CoreNetworkSecurityGroupResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreNetworkSecurityGroupResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeNetworkSecurityGroupCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -289,12 +290,12 @@ func (s *CoreNetworkSecurityGroupResourceCrud) updateCompartment(compartment int
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeNetworkSecurityGroupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeNetworkSecurityGroupCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -24,11 +25,11 @@ func CoreNetworkSecurityGroupSecurityRuleResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreNetworkSecurityGroupSecurityRule,
-		Read:     readCoreNetworkSecurityGroupSecurityRule,
-		Update:   updateCoreNetworkSecurityGroupSecurityRule,
-		Delete:   deleteCoreNetworkSecurityGroupSecurityRule,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreNetworkSecurityGroupSecurityRule,
+		ReadContext:   readCoreNetworkSecurityGroupSecurityRule,
+		UpdateContext: updateCoreNetworkSecurityGroupSecurityRule,
+		DeleteContext: deleteCoreNetworkSecurityGroupSecurityRule,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"network_security_group_id": {
@@ -239,32 +240,32 @@ func CoreNetworkSecurityGroupSecurityRuleResource() *schema.Resource {
 	}
 }
 
-func createCoreNetworkSecurityGroupSecurityRule(d *schema.ResourceData, m interface{}) error {
+func createCoreNetworkSecurityGroupSecurityRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreSecurityRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreNetworkSecurityGroupSecurityRule(d *schema.ResourceData, m interface{}) error {
+func readCoreNetworkSecurityGroupSecurityRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreSecurityRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreNetworkSecurityGroupSecurityRule(d *schema.ResourceData, m interface{}) error {
+func updateCoreNetworkSecurityGroupSecurityRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreSecurityRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreNetworkSecurityGroupSecurityRule(d *schema.ResourceData, m interface{}) error {
+func deleteCoreNetworkSecurityGroupSecurityRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreSecurityRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreSecurityRuleResourceCrud struct {
@@ -278,7 +279,7 @@ func (s *CoreSecurityRuleResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *CoreSecurityRuleResourceCrud) Create() error {
+func (s *CoreSecurityRuleResourceCrud) Create(ctx context.Context) error {
 
 	request := oci_core.AddNetworkSecurityGroupSecurityRulesRequest{}
 
@@ -364,7 +365,7 @@ func (s *CoreSecurityRuleResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.AddNetworkSecurityGroupSecurityRules(context.Background(), request)
+	response, err := s.Client.AddNetworkSecurityGroupSecurityRules(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -378,7 +379,7 @@ func (s *CoreSecurityRuleResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreSecurityRuleResourceCrud) Get() error {
+func (s *CoreSecurityRuleResourceCrud) Get(ctx context.Context) error {
 
 	request := oci_core.ListNetworkSecurityGroupSecurityRulesRequest{}
 
@@ -398,7 +399,7 @@ func (s *CoreSecurityRuleResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListNetworkSecurityGroupSecurityRules(context.Background(), request)
+	response, err := s.Client.ListNetworkSecurityGroupSecurityRules(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -407,7 +408,7 @@ func (s *CoreSecurityRuleResourceCrud) Get() error {
 	request.Page = response.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListNetworkSecurityGroupSecurityRules(context.Background(), request)
+		listResponse, err := s.Client.ListNetworkSecurityGroupSecurityRules(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -430,7 +431,7 @@ func (s *CoreSecurityRuleResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreSecurityRuleResourceCrud) Update() error {
+func (s *CoreSecurityRuleResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateNetworkSecurityGroupSecurityRulesRequest{}
 
 	if networkSecurityGroupId, ok := s.D.GetOkExists("network_security_group_id"); ok {
@@ -515,7 +516,7 @@ func (s *CoreSecurityRuleResourceCrud) Update() error {
 	request.SecurityRules = tmp
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
-	response, err := s.Client.UpdateNetworkSecurityGroupSecurityRules(context.Background(), request)
+	response, err := s.Client.UpdateNetworkSecurityGroupSecurityRules(ctx, request)
 	if err != nil {
 		return fmt.Errorf("failed to update security rules, error: %v", err)
 	}
@@ -526,7 +527,7 @@ func (s *CoreSecurityRuleResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreSecurityRuleResourceCrud) Delete() error {
+func (s *CoreSecurityRuleResourceCrud) Delete(ctx context.Context) error {
 
 	request := oci_core.RemoveNetworkSecurityGroupSecurityRulesRequest{}
 	if networkSecurityGroupId, ok := s.D.GetOkExists("network_security_group_id"); ok {
@@ -538,7 +539,7 @@ func (s *CoreSecurityRuleResourceCrud) Delete() error {
 	request.SecurityRuleIds = tmp
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
-	_, err := s.Client.RemoveNetworkSecurityGroupSecurityRules(context.Background(), request)
+	_, err := s.Client.RemoveNetworkSecurityGroupSecurityRules(ctx, request)
 	if err != nil {
 		return err
 	}
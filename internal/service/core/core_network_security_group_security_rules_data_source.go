@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreNetworkSecurityGroupSecurityRulesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreNetworkSecurityGroupSecurityRules,
+		ReadContext: readCoreNetworkSecurityGroupSecurityRules,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"direction": {
@@ -218,12 +219,12 @@ func CoreNetworkSecurityGroupSecurityRulesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreNetworkSecurityGroupSecurityRules(d *schema.ResourceData, m interface{}) error {
+func readCoreNetworkSecurityGroupSecurityRules(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreNetworkSecurityGroupSecurityRulesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreNetworkSecurityGroupSecurityRulesDataSourceCrud struct {
@@ -236,7 +237,7 @@ func (s *CoreNetworkSecurityGroupSecurityRulesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreNetworkSecurityGroupSecurityRulesDataSourceCrud) Get() error {
+func (s *CoreNetworkSecurityGroupSecurityRulesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListNetworkSecurityGroupSecurityRulesRequest{}
 
 	if direction, ok := s.D.GetOkExists("direction"); ok {
@@ -250,7 +251,7 @@ func (s *CoreNetworkSecurityGroupSecurityRulesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListNetworkSecurityGroupSecurityRules(context.Background(), request)
+	response, err := s.Client.ListNetworkSecurityGroupSecurityRules(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -259,7 +260,7 @@ func (s *CoreNetworkSecurityGroupSecurityRulesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListNetworkSecurityGroupSecurityRules(context.Background(), request)
+		listResponse, err := s.Client.ListNetworkSecurityGroupSecurityRules(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreNetworkSecurityGroupVnicsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreNetworkSecurityGroupVnics,
+		ReadContext: readCoreNetworkSecurityGroupVnics,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"network_security_group_id": {
@@ -51,12 +52,12 @@ func CoreNetworkSecurityGroupVnicsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreNetworkSecurityGroupVnics(d *schema.ResourceData, m interface{}) error {
+func readCoreNetworkSecurityGroupVnics(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreNetworkSecurityGroupVnicsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreNetworkSecurityGroupVnicsDataSourceCrud struct {
@@ -69,7 +70,7 @@ func (s *CoreNetworkSecurityGroupVnicsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreNetworkSecurityGroupVnicsDataSourceCrud) Get() error {
+func (s *CoreNetworkSecurityGroupVnicsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListNetworkSecurityGroupVnicsRequest{}
 
 	if networkSecurityGroupId, ok := s.D.GetOkExists("network_security_group_id"); ok {
@@ -79,7 +80,7 @@ func (s *CoreNetworkSecurityGroupVnicsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListNetworkSecurityGroupVnics(context.Background(), request)
+	response, err := s.Client.ListNetworkSecurityGroupVnics(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -88,7 +89,7 @@ func (s *CoreNetworkSecurityGroupVnicsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListNetworkSecurityGroupVnics(context.Background(), request)
+		listResponse, err := s.Client.ListNetworkSecurityGroupVnics(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreNetworkSecurityGroupsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreNetworkSecurityGroups,
+		ReadContext: readCoreNetworkSecurityGroups,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -47,12 +48,12 @@ func CoreNetworkSecurityGroupsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreNetworkSecurityGroups(d *schema.ResourceData, m interface{}) error {
+func readCoreNetworkSecurityGroups(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreNetworkSecurityGroupsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreNetworkSecurityGroupsDataSourceCrud struct {
@@ -65,7 +66,7 @@ func (s *CoreNetworkSecurityGroupsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreNetworkSecurityGroupsDataSourceCrud) Get() error {
+func (s *CoreNetworkSecurityGroupsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListNetworkSecurityGroupsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -94,7 +95,7 @@ func (s *CoreNetworkSecurityGroupsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListNetworkSecurityGroups(context.Background(), request)
+	response, err := s.Client.ListNetworkSecurityGroups(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *CoreNetworkSecurityGroupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListNetworkSecurityGroups(context.Background(), request)
+		listResponse, err := s.Client.ListNetworkSecurityGroups(ctx, request)
 		if err != nil {
 			return err
 		}
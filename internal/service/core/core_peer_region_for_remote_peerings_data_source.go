@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CorePeerRegionForRemotePeeringsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCorePeerRegionForRemotePeerings,
+		ReadContext: readCorePeerRegionForRemotePeerings,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"peer_region_for_remote_peerings": {
@@ -39,12 +40,12 @@ func CorePeerRegionForRemotePeeringsDataSource() *schema.Resource {
 	}
 }
 
-func readCorePeerRegionForRemotePeerings(d *schema.ResourceData, m interface{}) error {
+func readCorePeerRegionForRemotePeerings(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePeerRegionForRemotePeeringsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CorePeerRegionForRemotePeeringsDataSourceCrud struct {
@@ -57,12 +58,12 @@ func (s *CorePeerRegionForRemotePeeringsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CorePeerRegionForRemotePeeringsDataSourceCrud) Get() error {
+func (s *CorePeerRegionForRemotePeeringsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListAllowedPeerRegionsForRemotePeeringRequest{}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListAllowedPeerRegionsForRemotePeering(context.Background(), request)
+	response, err := s.Client.ListAllowedPeerRegionsForRemotePeering(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -22,12 +23,12 @@ func CorePrivateIpDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CorePrivateIpResource(), fieldMap, readSingularCorePrivateIp)
 }
 
-func readSingularCorePrivateIp(d *schema.ResourceData, m interface{}) error {
+func readSingularCorePrivateIp(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePrivateIpDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CorePrivateIpDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *CorePrivateIpDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CorePrivateIpDataSourceCrud) Get() error {
+func (s *CorePrivateIpDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetPrivateIpRequest{}
 
 	if privateIpId, ok := s.D.GetOkExists("private_ip_id"); ok {
@@ -50,7 +51,7 @@ func (s *CorePrivateIpDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetPrivateIp(context.Background(), request)
+	response, err := s.Client.GetPrivateIp(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -19,11 +20,11 @@ func CorePrivateIpResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCorePrivateIp,
-		Read:     readCorePrivateIp,
-		Update:   updateCorePrivateIp,
-		Delete:   deleteCorePrivateIp,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCorePrivateIp,
+		ReadContext:   readCorePrivateIp,
+		UpdateContext: updateCorePrivateIp,
+		DeleteContext: deleteCorePrivateIp,
 		Schema: map[string]*schema.Schema{
 			// Required
 
@@ -99,37 +100,37 @@ func CorePrivateIpResource() *schema.Resource {
 	}
 }
 
-func createCorePrivateIp(d *schema.ResourceData, m interface{}) error {
+func createCorePrivateIp(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePrivateIpResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCorePrivateIp(d *schema.ResourceData, m interface{}) error {
+func readCorePrivateIp(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePrivateIpResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCorePrivateIp(d *schema.ResourceData, m interface{}) error {
+func updateCorePrivateIp(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePrivateIpResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCorePrivateIp(d *schema.ResourceData, m interface{}) error {
+func deleteCorePrivateIp(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePrivateIpResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CorePrivateIpResourceCrud struct {
@@ -143,7 +144,7 @@ func (s *CorePrivateIpResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *CorePrivateIpResourceCrud) Create() error {
+func (s *CorePrivateIpResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreatePrivateIpRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -185,7 +186,7 @@ func (s *CorePrivateIpResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreatePrivateIp(context.Background(), request)
+	response, err := s.Client.CreatePrivateIp(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -194,7 +195,7 @@ func (s *CorePrivateIpResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CorePrivateIpResourceCrud) Get() error {
+func (s *CorePrivateIpResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetPrivateIpRequest{}
 
 	tmp := s.D.Id()
@@ -202,7 +203,7 @@ func (s *CorePrivateIpResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetPrivateIp(context.Background(), request)
+	response, err := s.Client.GetPrivateIp(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -211,7 +212,7 @@ func (s *CorePrivateIpResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CorePrivateIpResourceCrud) Update() error {
+func (s *CorePrivateIpResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdatePrivateIpRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -246,7 +247,7 @@ func (s *CorePrivateIpResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdatePrivateIp(context.Background(), request)
+	response, err := s.Client.UpdatePrivateIp(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -255,7 +256,7 @@ func (s *CorePrivateIpResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CorePrivateIpResourceCrud) Delete() error {
+func (s *CorePrivateIpResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeletePrivateIpRequest{}
 
 	tmp := s.D.Id()
@@ -263,7 +264,7 @@ func (s *CorePrivateIpResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeletePrivateIp(context.Background(), request)
+	_, err := s.Client.DeletePrivateIp(ctx, request)
 	return err
 }
 
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CorePrivateIpsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCorePrivateIps,
+		ReadContext: readCorePrivateIps,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"ip_address": {
@@ -43,12 +44,12 @@ func CorePrivateIpsDataSource() *schema.Resource {
 	}
 }
 
-func readCorePrivateIps(d *schema.ResourceData, m interface{}) error {
+func readCorePrivateIps(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePrivateIpsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CorePrivateIpsDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *CorePrivateIpsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CorePrivateIpsDataSourceCrud) Get() error {
+func (s *CorePrivateIpsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListPrivateIpsRequest{}
 
 	if ipAddress, ok := s.D.GetOkExists("ip_address"); ok {
@@ -86,7 +87,7 @@ func (s *CorePrivateIpsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListPrivateIps(context.Background(), request)
+	response, err := s.Client.ListPrivateIps(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +96,7 @@ func (s *CorePrivateIpsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPrivateIps(context.Background(), request)
+		listResponse, err := s.Client.ListPrivateIps(ctx, request)
 		if err != nil {
 			return err
 		}
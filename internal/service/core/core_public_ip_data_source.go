@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -16,8 +17,8 @@ import (
 
 func CorePublicIpDataSource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Read:     readSingularCorePublicIp,
+		Timeouts:    tfresource.DefaultTimeout,
+		ReadContext: readSingularCorePublicIp,
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -92,12 +93,12 @@ func CorePublicIpDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCorePublicIp(d *schema.ResourceData, m interface{}) error {
+func readSingularCorePublicIp(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePublicIpDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CorePublicIpDataSourceCrud struct {
@@ -110,30 +111,30 @@ func (s *CorePublicIpDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CorePublicIpDataSourceCrud) Get() error {
+func (s *CorePublicIpDataSourceCrud) Get(ctx context.Context) error {
 	// public ip resource fetching strategies ordered by specificity
 	if id, ok := s.D.GetOkExists("id"); ok {
-		return s.getById(id.(string))
+		return s.getById(ctx, id.(string))
 	}
 
 	if privateIpId, ok := s.D.GetOkExists("private_ip_id"); ok {
-		return s.getByPrivateIpId(privateIpId.(string))
+		return s.getByPrivateIpId(ctx, privateIpId.(string))
 	}
 
 	if ipAddress, ok := s.D.GetOkExists("ip_address"); ok {
-		return s.getByPublicIp(ipAddress.(string))
+		return s.getByPublicIp(ctx, ipAddress.(string))
 	}
 
 	return errors.New("require at least an id, private_ip_id, or ip_address to get a public ip data source")
 }
 
-func (s *CorePublicIpDataSourceCrud) getById(id string) error {
+func (s *CorePublicIpDataSourceCrud) getById(ctx context.Context, id string) error {
 	request := oci_core.GetPublicIpRequest{}
 	request.PublicIpId = &id
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetPublicIp(context.Background(), request)
+	response, err := s.Client.GetPublicIp(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -142,14 +143,14 @@ func (s *CorePublicIpDataSourceCrud) getById(id string) error {
 	return nil
 }
 
-func (s *CorePublicIpDataSourceCrud) getByPrivateIpId(privateIpId string) error {
+func (s *CorePublicIpDataSourceCrud) getByPrivateIpId(ctx context.Context, privateIpId string) error {
 	request := oci_core.GetPublicIpByPrivateIpIdRequest{}
 	if privateIpId, ok := s.D.GetOkExists("private_ip_id"); ok {
 		tmp := privateIpId.(string)
 		request.PrivateIpId = &tmp
 	}
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
-	response, err := s.Client.GetPublicIpByPrivateIpId(context.Background(), request)
+	response, err := s.Client.GetPublicIpByPrivateIpId(ctx, request)
 
 	if err != nil {
 		return err
@@ -159,7 +160,7 @@ func (s *CorePublicIpDataSourceCrud) getByPrivateIpId(privateIpId string) error
 	return nil
 }
 
-func (s *CorePublicIpDataSourceCrud) getByPublicIp(ipAddress string) error {
+func (s *CorePublicIpDataSourceCrud) getByPublicIp(ctx context.Context, ipAddress string) error {
 	request := oci_core.GetPublicIpByIpAddressRequest{}
 	if ipAddress, ok := s.D.GetOkExists("ip_address"); ok {
 		tmp := ipAddress.(string)
@@ -167,7 +168,7 @@ func (s *CorePublicIpDataSourceCrud) getByPublicIp(ipAddress string) error {
 	}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
-	response, err := s.Client.GetPublicIpByIpAddress(context.Background(), request)
+	response, err := s.Client.GetPublicIpByIpAddress(ctx, request)
 
 	if err != nil {
 		return err
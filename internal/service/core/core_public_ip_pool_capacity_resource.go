@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -24,10 +25,10 @@ func PublicIpPoolCapacityResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createPublicIpPoolCapacity,
-		Read:     readPublicIpPoolCapacity,
-		Delete:   deletePublicIpPoolCapacity,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createPublicIpPoolCapacity,
+		ReadContext:   readPublicIpPoolCapacity,
+		DeleteContext: deletePublicIpPoolCapacity,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"public_ip_pool_id": {
@@ -49,29 +50,29 @@ func PublicIpPoolCapacityResource() *schema.Resource {
 	}
 }
 
-func createPublicIpPoolCapacity(d *schema.ResourceData, m interface{}) error {
+func createPublicIpPoolCapacity(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePublicIpPoolCapacityResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readPublicIpPoolCapacity(d *schema.ResourceData, m interface{}) error {
+func readPublicIpPoolCapacity(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePublicIpPoolCapacityResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deletePublicIpPoolCapacity(d *schema.ResourceData, m interface{}) error {
+func deletePublicIpPoolCapacity(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePublicIpPoolCapacityResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CorePublicIpPoolCapacityResourceCrud struct {
@@ -91,7 +92,7 @@ func (s *CorePublicIpPoolCapacityResourceCrud) ID() string {
 	return id
 }
 
-func (s *CorePublicIpPoolCapacityResourceCrud) Create() error {
+func (s *CorePublicIpPoolCapacityResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.AddPublicIpPoolCapacityRequest{}
 
 	if publicIpPoolId, ok := s.D.GetOkExists("public_ip_pool_id"); ok {
@@ -111,7 +112,7 @@ func (s *CorePublicIpPoolCapacityResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.AddPublicIpPoolCapacity(context.Background(), request)
+	response, err := s.Client.AddPublicIpPoolCapacity(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -120,7 +121,7 @@ func (s *CorePublicIpPoolCapacityResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CorePublicIpPoolCapacityResourceCrud) Get() error {
+func (s *CorePublicIpPoolCapacityResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetPublicIpPoolRequest{}
 
 	publicIpPoolId, _, cidrBlock, err := parseCapacityCompositeId(s.D.Id())
@@ -132,7 +133,7 @@ func (s *CorePublicIpPoolCapacityResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetPublicIpPool(context.Background(), request)
+	response, err := s.Client.GetPublicIpPool(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -170,7 +171,7 @@ func (s *CorePublicIpPoolCapacityResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CorePublicIpPoolCapacityResourceCrud) Delete() error {
+func (s *CorePublicIpPoolCapacityResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.RemovePublicIpPoolCapacityRequest{}
 
 	if publicIpPoolId, ok := s.D.GetOkExists("public_ip_pool_id"); ok {
@@ -185,7 +186,7 @@ func (s *CorePublicIpPoolCapacityResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.RemovePublicIpPoolCapacity(context.Background(), request)
+	_, err := s.Client.RemovePublicIpPoolCapacity(ctx, request)
 	return err
 }
 
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -22,12 +23,12 @@ func CorePublicIpPoolDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CorePublicIpPoolResource(), fieldMap, readSingularCorePublicIpPool)
 }
 
-func readSingularCorePublicIpPool(d *schema.ResourceData, m interface{}) error {
+func readSingularCorePublicIpPool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePublicIpPoolDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CorePublicIpPoolDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *CorePublicIpPoolDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CorePublicIpPoolDataSourceCrud) Get() error {
+func (s *CorePublicIpPoolDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetPublicIpPoolRequest{}
 
 	if publicIpPoolId, ok := s.D.GetOkExists("public_ip_pool_id"); ok {
@@ -50,7 +51,7 @@ func (s *CorePublicIpPoolDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetPublicIpPool(context.Background(), request)
+	response, err := s.Client.GetPublicIpPool(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -19,11 +20,11 @@ func CorePublicIpPoolResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCorePublicIpPool,
-		Read:     readCorePublicIpPool,
-		Update:   updateCorePublicIpPool,
-		Delete:   deleteCorePublicIpPool,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCorePublicIpPool,
+		ReadContext:   readCorePublicIpPool,
+		UpdateContext: updateCorePublicIpPool,
+		DeleteContext: deleteCorePublicIpPool,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -71,37 +72,37 @@ func CorePublicIpPoolResource() *schema.Resource {
 	}
 }
 
-func createCorePublicIpPool(d *schema.ResourceData, m interface{}) error {
+func createCorePublicIpPool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePublicIpPoolResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCorePublicIpPool(d *schema.ResourceData, m interface{}) error {
+func readCorePublicIpPool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePublicIpPoolResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCorePublicIpPool(d *schema.ResourceData, m interface{}) error {
+func updateCorePublicIpPool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePublicIpPoolResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCorePublicIpPool(d *schema.ResourceData, m interface{}) error {
+func deleteCorePublicIpPool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePublicIpPoolResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CorePublicIpPoolResourceCrud struct {
@@ -137,7 +138,7 @@ func (s *CorePublicIpPoolResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CorePublicIpPoolResourceCrud) Create() error {
+func (s *CorePublicIpPoolResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreatePublicIpPoolRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -164,7 +165,7 @@ func (s *CorePublicIpPoolResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreatePublicIpPool(context.Background(), request)
+	response, err := s.Client.CreatePublicIpPool(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -173,7 +174,7 @@ func (s *CorePublicIpPoolResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CorePublicIpPoolResourceCrud) Get() error {
+func (s *CorePublicIpPoolResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetPublicIpPoolRequest{}
 
 	tmp := s.D.Id()
@@ -181,7 +182,7 @@ func (s *CorePublicIpPoolResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetPublicIpPool(context.Background(), request)
+	response, err := s.Client.GetPublicIpPool(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -190,11 +191,11 @@ func (s *CorePublicIpPoolResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CorePublicIpPoolResourceCrud) Update() error {
+func (s *CorePublicIpPoolResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -224,7 +225,7 @@ func (s *CorePublicIpPoolResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdatePublicIpPool(context.Background(), request)
+	response, err := s.Client.UpdatePublicIpPool(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -233,7 +234,7 @@ func (s *CorePublicIpPoolResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CorePublicIpPoolResourceCrud) Delete() error {
+func (s *CorePublicIpPoolResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeletePublicIpPoolRequest{}
 
 	tmp := s.D.Id()
@@ -241,7 +242,7 @@ func (s *CorePublicIpPoolResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeletePublicIpPool(context.Background(), request)
+	_, err := s.Client.DeletePublicIpPool(ctx, request)
 	return err
 }
 
@@ -301,7 +302,7 @@ func PublicIpPoolSummaryToMap(obj oci_core.PublicIpPoolSummary) map[string]inter
 	return result
 }
 
-func (s *CorePublicIpPoolResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CorePublicIpPoolResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangePublicIpPoolCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -312,12 +313,12 @@ func (s *CorePublicIpPoolResourceCrud) updateCompartment(compartment interface{}
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangePublicIpPoolCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangePublicIpPoolCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
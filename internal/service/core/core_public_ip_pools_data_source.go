@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CorePublicIpPoolsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCorePublicIpPools,
+		ReadContext: readCorePublicIpPools,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"byoip_range_id": {
@@ -48,12 +49,12 @@ func CorePublicIpPoolsDataSource() *schema.Resource {
 	}
 }
 
-func readCorePublicIpPools(d *schema.ResourceData, m interface{}) error {
+func readCorePublicIpPools(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePublicIpPoolsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CorePublicIpPoolsDataSourceCrud struct {
@@ -66,7 +67,7 @@ func (s *CorePublicIpPoolsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CorePublicIpPoolsDataSourceCrud) Get() error {
+func (s *CorePublicIpPoolsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListPublicIpPoolsRequest{}
 
 	if byoipRangeId, ok := s.D.GetOkExists("byoip_range_id"); ok {
@@ -86,7 +87,7 @@ func (s *CorePublicIpPoolsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListPublicIpPools(context.Background(), request)
+	response, err := s.Client.ListPublicIpPools(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +96,7 @@ func (s *CorePublicIpPoolsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPublicIpPools(context.Background(), request)
+		listResponse, err := s.Client.ListPublicIpPools(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -19,11 +20,11 @@ func CorePublicIpResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCorePublicIp,
-		Read:     readCorePublicIp,
-		Update:   updateCorePublicIp,
-		Delete:   deleteCorePublicIp,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCorePublicIp,
+		ReadContext:   readCorePublicIp,
+		UpdateContext: updateCorePublicIp,
+		DeleteContext: deleteCorePublicIp,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -99,37 +100,37 @@ func CorePublicIpResource() *schema.Resource {
 	}
 }
 
-func createCorePublicIp(d *schema.ResourceData, m interface{}) error {
+func createCorePublicIp(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePublicIpResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCorePublicIp(d *schema.ResourceData, m interface{}) error {
+func readCorePublicIp(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePublicIpResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCorePublicIp(d *schema.ResourceData, m interface{}) error {
+func updateCorePublicIp(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePublicIpResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCorePublicIp(d *schema.ResourceData, m interface{}) error {
+func deleteCorePublicIp(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePublicIpResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CorePublicIpResourceCrud struct {
@@ -186,7 +187,7 @@ func (s *CorePublicIpResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *CorePublicIpResourceCrud) Create() error {
+func (s *CorePublicIpResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreatePublicIpRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -227,7 +228,7 @@ func (s *CorePublicIpResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreatePublicIp(context.Background(), request)
+	response, err := s.Client.CreatePublicIp(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -236,7 +237,7 @@ func (s *CorePublicIpResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CorePublicIpResourceCrud) Get() error {
+func (s *CorePublicIpResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetPublicIpRequest{}
 
 	tmp := s.D.Id()
@@ -244,7 +245,7 @@ func (s *CorePublicIpResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetPublicIp(context.Background(), request)
+	response, err := s.Client.GetPublicIp(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -253,11 +254,11 @@ func (s *CorePublicIpResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CorePublicIpResourceCrud) Update() error {
+func (s *CorePublicIpResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -297,7 +298,7 @@ func (s *CorePublicIpResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdatePublicIp(context.Background(), request)
+	response, err := s.Client.UpdatePublicIp(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -306,7 +307,7 @@ func (s *CorePublicIpResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CorePublicIpResourceCrud) Delete() error {
+func (s *CorePublicIpResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeletePublicIpRequest{}
 
 	tmp := s.D.Id()
@@ -314,7 +315,7 @@ func (s *CorePublicIpResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeletePublicIp(context.Background(), request)
+	_, err := s.Client.DeletePublicIp(ctx, request)
 	return err
 }
 
@@ -368,7 +369,7 @@ func (s *CorePublicIpResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CorePublicIpResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CorePublicIpResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangePublicIpCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -379,12 +380,12 @@ func (s *CorePublicIpResourceCrud) updateCompartment(compartment interface{}) er
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangePublicIpCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangePublicIpCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CorePublicIpsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCorePublicIps,
+		ReadContext: readCorePublicIps,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -47,12 +48,12 @@ func CorePublicIpsDataSource() *schema.Resource {
 	}
 }
 
-func readCorePublicIps(d *schema.ResourceData, m interface{}) error {
+func readCorePublicIps(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CorePublicIpsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CorePublicIpsDataSourceCrud struct {
@@ -65,7 +66,7 @@ func (s *CorePublicIpsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CorePublicIpsDataSourceCrud) Get() error {
+func (s *CorePublicIpsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListPublicIpsRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -93,7 +94,7 @@ func (s *CorePublicIpsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListPublicIps(context.Background(), request)
+	response, err := s.Client.ListPublicIps(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -102,7 +103,7 @@ func (s *CorePublicIpsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPublicIps(context.Background(), request)
+		listResponse, err := s.Client.ListPublicIps(ctx, request)
 		if err != nil {
 			return err
 		}
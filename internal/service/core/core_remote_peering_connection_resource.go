@@ -9,6 +9,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -23,11 +24,11 @@ func CoreRemotePeeringConnectionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreRemotePeeringConnection,
-		Read:     readCoreRemotePeeringConnection,
-		Update:   updateCoreRemotePeeringConnection,
-		Delete:   deleteCoreRemotePeeringConnection,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreRemotePeeringConnection,
+		ReadContext:   readCoreRemotePeeringConnection,
+		UpdateContext: updateCoreRemotePeeringConnection,
+		DeleteContext: deleteCoreRemotePeeringConnection,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -100,43 +101,43 @@ func CoreRemotePeeringConnectionResource() *schema.Resource {
 	}
 }
 
-func createCoreRemotePeeringConnection(d *schema.ResourceData, m interface{}) error {
+func createCoreRemotePeeringConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreRemotePeeringConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	err := tfresource.CreateResource(d, sync)
+	err := tfresource.CreateResource(ctx, d, sync)
 	if err != nil {
-		return err
+		return tfresource.HandleDiagError(err)
 	}
 
 	//This needs to be here rather than in the Create() because we want the resource to finish provisioning and set to the statefile before we connect
-	return sync.ConnectRemotePeeringConnection()
+	return tfresource.HandleDiagError(sync.ConnectRemotePeeringConnection(ctx))
 }
 
-func readCoreRemotePeeringConnection(d *schema.ResourceData, m interface{}) error {
+func readCoreRemotePeeringConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreRemotePeeringConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreRemotePeeringConnection(d *schema.ResourceData, m interface{}) error {
+func updateCoreRemotePeeringConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreRemotePeeringConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreRemotePeeringConnection(d *schema.ResourceData, m interface{}) error {
+func deleteCoreRemotePeeringConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreRemotePeeringConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreRemotePeeringConnectionResourceCrud struct {
@@ -174,7 +175,7 @@ func (s *CoreRemotePeeringConnectionResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreRemotePeeringConnectionResourceCrud) ConnectRemotePeeringConnection() error {
+func (s *CoreRemotePeeringConnectionResourceCrud) ConnectRemotePeeringConnection(ctx context.Context) error {
 	if s.Res == nil || s.Res.Id == nil {
 		return fmt.Errorf("CreateRemotePeeringConnection returned a nil RemotePeeringConnection or a RemotePeeringConnection without an ID")
 	}
@@ -198,7 +199,7 @@ func (s *CoreRemotePeeringConnectionResourceCrud) ConnectRemotePeeringConnection
 
 	connectRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ConnectRemotePeeringConnections(context.Background(), connectRequest)
+	_, err := s.Client.ConnectRemotePeeringConnections(ctx, connectRequest)
 	if err != nil {
 		// we set peer_id to "" so that terraform detects a forceNew change on the next apply and the user can try the connection again
 		s.D.Set("peer_id", "")
@@ -212,7 +213,7 @@ func (s *CoreRemotePeeringConnectionResourceCrud) ConnectRemotePeeringConnection
 
 	request.RequestMetadata.RetryPolicy = getRemotePeeringConnectionRetryPolicy(s.D.Timeout(schema.TimeoutCreate))
 
-	response, getError := s.Client.GetRemotePeeringConnection(context.Background(), request)
+	response, getError := s.Client.GetRemotePeeringConnection(ctx, request)
 	if getError != nil {
 		log.Printf("[DEBUG] Get error while waiting for peering connection to finish: %+v", getError)
 		return getError
@@ -230,7 +231,7 @@ func (s *CoreRemotePeeringConnectionResourceCrud) ConnectRemotePeeringConnection
 	return nil
 }
 
-func (s *CoreRemotePeeringConnectionResourceCrud) Create() error {
+func (s *CoreRemotePeeringConnectionResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateRemotePeeringConnectionRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -262,7 +263,7 @@ func (s *CoreRemotePeeringConnectionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateRemotePeeringConnection(context.Background(), request)
+	response, err := s.Client.CreateRemotePeeringConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -271,7 +272,7 @@ func (s *CoreRemotePeeringConnectionResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreRemotePeeringConnectionResourceCrud) Get() error {
+func (s *CoreRemotePeeringConnectionResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetRemotePeeringConnectionRequest{}
 
 	tmp := s.D.Id()
@@ -279,7 +280,7 @@ func (s *CoreRemotePeeringConnectionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetRemotePeeringConnection(context.Background(), request)
+	response, err := s.Client.GetRemotePeeringConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -288,11 +289,11 @@ func (s *CoreRemotePeeringConnectionResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreRemotePeeringConnectionResourceCrud) Update() error {
+func (s *CoreRemotePeeringConnectionResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -322,7 +323,7 @@ func (s *CoreRemotePeeringConnectionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateRemotePeeringConnection(context.Background(), request)
+	response, err := s.Client.UpdateRemotePeeringConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -331,7 +332,7 @@ func (s *CoreRemotePeeringConnectionResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreRemotePeeringConnectionResourceCrud) Delete() error {
+func (s *CoreRemotePeeringConnectionResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteRemotePeeringConnectionRequest{}
 
 	tmp := s.D.Id()
@@ -339,7 +340,7 @@ func (s *CoreRemotePeeringConnectionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteRemotePeeringConnection(context.Background(), request)
+	_, err := s.Client.DeleteRemotePeeringConnection(ctx, request)
 	return err
 }
 
@@ -412,7 +413,7 @@ func getRemotePeeringConnectionRetryPolicy(timeout time.Duration) *oci_common.Re
 	}
 }
 
-func (s *CoreRemotePeeringConnectionResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreRemotePeeringConnectionResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeRemotePeeringConnectionCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -423,12 +424,12 @@ func (s *CoreRemotePeeringConnectionResourceCrud) updateCompartment(compartment
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeRemotePeeringConnectionCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeRemotePeeringConnectionCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -21,10 +22,10 @@ func CoreRouteTableAttachmentResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createRouteTableAttachment,
-		Delete:   deleteRouteTableAttachment,
-		Read:     readRouteTableAttachment,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createRouteTableAttachment,
+		DeleteContext: deleteRouteTableAttachment,
+		ReadContext:   readRouteTableAttachment,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"subnet_id": {
@@ -41,29 +42,29 @@ func CoreRouteTableAttachmentResource() *schema.Resource {
 	}
 }
 
-func createRouteTableAttachment(d *schema.ResourceData, m interface{}) error {
+func createRouteTableAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &RouteTableAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func deleteRouteTableAttachment(d *schema.ResourceData, m interface{}) error {
+func deleteRouteTableAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &RouteTableAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
-func readRouteTableAttachment(d *schema.ResourceData, m interface{}) error {
+func readRouteTableAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &RouteTableAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type RouteTableAttachmentResourceCrud struct {
@@ -91,7 +92,7 @@ func (s *RouteTableAttachmentResourceCrud) ID() string {
 	return getRouteTableAttachmentId(*s.Res.Id, *s.Res.RouteTableId)
 }
 
-func (s *RouteTableAttachmentResourceCrud) Create() error {
+func (s *RouteTableAttachmentResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.UpdateSubnetRequest{}
 
 	if routeTableId, ok := s.D.GetOkExists("route_table_id"); ok {
@@ -105,7 +106,7 @@ func (s *RouteTableAttachmentResourceCrud) Create() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
-	response, err := s.Client.UpdateSubnet(context.Background(), request)
+	response, err := s.Client.UpdateSubnet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -114,7 +115,7 @@ func (s *RouteTableAttachmentResourceCrud) Create() error {
 	return nil
 }
 
-func (s *RouteTableAttachmentResourceCrud) Get() error {
+func (s *RouteTableAttachmentResourceCrud) Get(ctx context.Context) error {
 
 	subnetId, _, err := parseRouteTableAttachmentId(s.D.Id())
 	if err != nil {
@@ -123,7 +124,7 @@ func (s *RouteTableAttachmentResourceCrud) Get() error {
 	request := oci_core.GetSubnetRequest{}
 	request.SubnetId = &subnetId
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
-	response, err := s.Client.GetSubnet(context.Background(), request)
+	response, err := s.Client.GetSubnet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -132,7 +133,7 @@ func (s *RouteTableAttachmentResourceCrud) Get() error {
 	return nil
 }
 
-func (s *RouteTableAttachmentResourceCrud) Delete() error {
+func (s *RouteTableAttachmentResourceCrud) Delete(ctx context.Context) error {
 
 	var subnetIdStr = s.D.Get("subnet_id").(string)
 	var retryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
@@ -140,7 +141,7 @@ func (s *RouteTableAttachmentResourceCrud) Delete() error {
 	subnetRequest := oci_core.GetSubnetRequest{}
 	subnetRequest.SubnetId = &subnetIdStr
 	subnetRequest.RequestMetadata.RetryPolicy = retryPolicy
-	subnetResponse, err := s.Client.GetSubnet(context.Background(), subnetRequest)
+	subnetResponse, err := s.Client.GetSubnet(ctx, subnetRequest)
 	if err != nil {
 		return err
 	}
@@ -148,7 +149,7 @@ func (s *RouteTableAttachmentResourceCrud) Delete() error {
 	vcnRequest := oci_core.GetVcnRequest{}
 	vcnRequest.VcnId = subnetResponse.VcnId
 	vcnRequest.RequestMetadata.RetryPolicy = retryPolicy
-	vcnResponse, err := s.Client.GetVcn(context.Background(), vcnRequest)
+	vcnResponse, err := s.Client.GetVcn(ctx, vcnRequest)
 	if err != nil {
 		return err
 	}
@@ -157,7 +158,7 @@ func (s *RouteTableAttachmentResourceCrud) Delete() error {
 	updateSubnetRequest.RouteTableId = vcnResponse.DefaultRouteTableId
 	updateSubnetRequest.SubnetId = &subnetIdStr
 	updateSubnetRequest.RequestMetadata.RetryPolicy = retryPolicy
-	updateSubnetResponse, err := s.Client.UpdateSubnet(context.Background(), updateSubnetRequest)
+	updateSubnetResponse, err := s.Client.UpdateSubnet(ctx, updateSubnetRequest)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -19,8 +20,8 @@ import (
 func DefaultCoreRouteTableResource() *schema.Resource {
 	defaultResourceSchema := ConvertToDefaultVcnResourceSchema(CoreRouteTableResource())
 
-	defaultResourceSchema.Create = createDefaultRouteTable
-	defaultResourceSchema.Delete = deleteDefaultRouteTable
+	defaultResourceSchema.CreateContext = createDefaultRouteTable
+	defaultResourceSchema.DeleteContext = deleteDefaultRouteTable
 
 	return defaultResourceSchema
 }
@@ -29,28 +30,28 @@ type DefaultRouteTableResourceCrud struct {
 	CoreRouteTableResourceCrud
 }
 
-func createDefaultRouteTable(d *schema.ResourceData, m interface{}) error {
+func createDefaultRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DefaultRouteTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func deleteDefaultRouteTable(d *schema.ResourceData, m interface{}) error {
+func deleteDefaultRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DefaultRouteTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
-func (s *DefaultRouteTableResourceCrud) Create() error {
+func (s *DefaultRouteTableResourceCrud) Create(ctx context.Context) error {
 	// If we are creating a default resource, then don't have to
 	// actually Create it. Just set the ID and Update it.
 	if defaultId, ok := s.D.GetOkExists("manage_default_resource_id"); ok {
 		s.D.SetId(defaultId.(string))
-		return s.Update()
+		return s.Update(ctx)
 	}
 
 	return fmt.Errorf("Default resource does not have a manage_default_resource_id set")
@@ -58,7 +59,7 @@ func (s *DefaultRouteTableResourceCrud) Create() error {
 
 // This clears out all of the route table rules and sets it to empty
 // This is used to clear out default Route Table resources that can't otherwise be deleted
-func (s *DefaultRouteTableResourceCrud) reset() error {
+func (s *DefaultRouteTableResourceCrud) reset(ctx context.Context) error {
 	request := oci_core.UpdateRouteTableRequest{}
 
 	tmp := s.D.Id()
@@ -68,7 +69,7 @@ func (s *DefaultRouteTableResourceCrud) reset() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateRouteTable(context.Background(), request)
+	response, err := s.Client.UpdateRouteTable(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -77,11 +78,11 @@ func (s *DefaultRouteTableResourceCrud) reset() error {
 	return nil
 }
 
-func (s *DefaultRouteTableResourceCrud) Delete() error {
+func (s *DefaultRouteTableResourceCrud) Delete(ctx context.Context) error {
 	if _, ok := s.D.GetOkExists("manage_default_resource_id"); ok {
 		// We can't actually delete a default resource.
 		// Clear out its settings and mark it as deleted.
-		err := s.reset()
+		err := s.reset(ctx)
 		s.D.Set("state", s.DeletedTarget()[0])
 		return err
 	}
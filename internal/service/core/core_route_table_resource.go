@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -22,11 +23,11 @@ func CoreRouteTableResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreRouteTable,
-		Read:     readCoreRouteTable,
-		Update:   updateCoreRouteTable,
-		Delete:   deleteCoreRouteTable,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreRouteTable,
+		ReadContext:   readCoreRouteTable,
+		UpdateContext: updateCoreRouteTable,
+		DeleteContext: deleteCoreRouteTable,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -119,37 +120,37 @@ func CoreRouteTableResource() *schema.Resource {
 	}
 }
 
-func createCoreRouteTable(d *schema.ResourceData, m interface{}) error {
+func createCoreRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreRouteTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreRouteTable(d *schema.ResourceData, m interface{}) error {
+func readCoreRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreRouteTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreRouteTable(d *schema.ResourceData, m interface{}) error {
+func updateCoreRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreRouteTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreRouteTable(d *schema.ResourceData, m interface{}) error {
+func deleteCoreRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreRouteTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreRouteTableResourceCrud struct {
@@ -187,7 +188,7 @@ func (s *CoreRouteTableResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreRouteTableResourceCrud) Create() error {
+func (s *CoreRouteTableResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateRouteTableRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -236,7 +237,7 @@ func (s *CoreRouteTableResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateRouteTable(context.Background(), request)
+	response, err := s.Client.CreateRouteTable(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -245,7 +246,7 @@ func (s *CoreRouteTableResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreRouteTableResourceCrud) Get() error {
+func (s *CoreRouteTableResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetRouteTableRequest{}
 
 	tmp := s.D.Id()
@@ -253,7 +254,7 @@ func (s *CoreRouteTableResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetRouteTable(context.Background(), request)
+	response, err := s.Client.GetRouteTable(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -262,11 +263,11 @@ func (s *CoreRouteTableResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreRouteTableResourceCrud) Update() error {
+func (s *CoreRouteTableResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -313,7 +314,7 @@ func (s *CoreRouteTableResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateRouteTable(context.Background(), request)
+	response, err := s.Client.UpdateRouteTable(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -322,7 +323,7 @@ func (s *CoreRouteTableResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreRouteTableResourceCrud) Delete() error {
+func (s *CoreRouteTableResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteRouteTableRequest{}
 
 	tmp := s.D.Id()
@@ -330,7 +331,7 @@ func (s *CoreRouteTableResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteRouteTable(context.Background(), request)
+	_, err := s.Client.DeleteRouteTable(ctx, request)
 	return err
 }
 
@@ -487,7 +488,7 @@ func routeRulesHashCodeForSets(v interface{}) int {
 	return utils.GetStringHashcode(buf.String())
 
 }
-func (s *CoreRouteTableResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreRouteTableResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeRouteTableCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -498,12 +499,12 @@ func (s *CoreRouteTableResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeRouteTableCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeRouteTableCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -19,8 +20,8 @@ import (
 func CoreDefaultSecurityListResource() *schema.Resource {
 	defaultResourceSchema := ConvertToDefaultVcnResourceSchema(CoreSecurityListResource())
 
-	defaultResourceSchema.Create = createDefaultSecurityList
-	defaultResourceSchema.Delete = deleteDefaultSecurityList
+	defaultResourceSchema.CreateContext = createDefaultSecurityList
+	defaultResourceSchema.DeleteContext = deleteDefaultSecurityList
 
 	return defaultResourceSchema
 }
@@ -29,28 +30,28 @@ type DefaultSecurityListResourceCrud struct {
 	CoreSecurityListResourceCrud
 }
 
-func createDefaultSecurityList(d *schema.ResourceData, m interface{}) error {
+func createDefaultSecurityList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DefaultSecurityListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func deleteDefaultSecurityList(d *schema.ResourceData, m interface{}) error {
+func deleteDefaultSecurityList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DefaultSecurityListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
-func (s *DefaultSecurityListResourceCrud) Create() error {
+func (s *DefaultSecurityListResourceCrud) Create(ctx context.Context) error {
 	// If we are creating a default resource, then don't have to
 	// actually Create it. Just set the ID and Update it.
 	if defaultId, ok := s.D.GetOkExists("manage_default_resource_id"); ok {
 		s.D.SetId(defaultId.(string))
-		return s.Update()
+		return s.Update(ctx)
 	}
 
 	return fmt.Errorf("Default resource does not have a manage_default_resource_id set")
@@ -58,7 +59,7 @@ func (s *DefaultSecurityListResourceCrud) Create() error {
 
 // This clears out all of the security rules from the list
 // This is used to clear out default security list resources that can't otherwise be deleted
-func (s *DefaultSecurityListResourceCrud) reset() error {
+func (s *DefaultSecurityListResourceCrud) reset(ctx context.Context) error {
 	request := oci_core.UpdateSecurityListRequest{}
 
 	tmp := s.D.Id()
@@ -70,7 +71,7 @@ func (s *DefaultSecurityListResourceCrud) reset() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateSecurityList(context.Background(), request)
+	response, err := s.Client.UpdateSecurityList(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -79,11 +80,11 @@ func (s *DefaultSecurityListResourceCrud) reset() error {
 	return nil
 }
 
-func (s *DefaultSecurityListResourceCrud) Delete() error {
+func (s *DefaultSecurityListResourceCrud) Delete(ctx context.Context) error {
 	if _, ok := s.D.GetOkExists("manage_default_resource_id"); ok {
 		// We can't actually delete a default resource.
 		// Clear out its settings and mark it as deleted.
-		err := s.reset()
+		err := s.reset(ctx)
 		s.D.Set("state", s.DeletedTarget()[0])
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -22,11 +23,11 @@ func CoreSecurityListResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreSecurityList,
-		Read:     readCoreSecurityList,
-		Update:   updateCoreSecurityList,
-		Delete:   deleteCoreSecurityList,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreSecurityList,
+		ReadContext:   readCoreSecurityList,
+		UpdateContext: updateCoreSecurityList,
+		DeleteContext: deleteCoreSecurityList,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -397,37 +398,37 @@ func CoreSecurityListResource() *schema.Resource {
 	}
 }
 
-func createCoreSecurityList(d *schema.ResourceData, m interface{}) error {
+func createCoreSecurityList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreSecurityListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreSecurityList(d *schema.ResourceData, m interface{}) error {
+func readCoreSecurityList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreSecurityListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreSecurityList(d *schema.ResourceData, m interface{}) error {
+func updateCoreSecurityList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreSecurityListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreSecurityList(d *schema.ResourceData, m interface{}) error {
+func deleteCoreSecurityList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreSecurityListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreSecurityListResourceCrud struct {
@@ -465,7 +466,7 @@ func (s *CoreSecurityListResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreSecurityListResourceCrud) Create() error {
+func (s *CoreSecurityListResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateSecurityListRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -531,7 +532,7 @@ func (s *CoreSecurityListResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateSecurityList(context.Background(), request)
+	response, err := s.Client.CreateSecurityList(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -540,7 +541,7 @@ func (s *CoreSecurityListResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreSecurityListResourceCrud) Get() error {
+func (s *CoreSecurityListResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetSecurityListRequest{}
 
 	tmp := s.D.Id()
@@ -548,7 +549,7 @@ func (s *CoreSecurityListResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetSecurityList(context.Background(), request)
+	response, err := s.Client.GetSecurityList(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -557,11 +558,11 @@ func (s *CoreSecurityListResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreSecurityListResourceCrud) Update() error {
+func (s *CoreSecurityListResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -625,7 +626,7 @@ func (s *CoreSecurityListResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateSecurityList(context.Background(), request)
+	response, err := s.Client.UpdateSecurityList(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -634,7 +635,7 @@ func (s *CoreSecurityListResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreSecurityListResourceCrud) Delete() error {
+func (s *CoreSecurityListResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteSecurityListRequest{}
 
 	tmp := s.D.Id()
@@ -642,7 +643,7 @@ func (s *CoreSecurityListResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteSecurityList(context.Background(), request)
+	_, err := s.Client.DeleteSecurityList(ctx, request)
 	return err
 }
 
@@ -1216,7 +1217,7 @@ func ingressSecurityRulesHashCodeForSets(v interface{}) int {
 	}
 	return utils.GetStringHashcode(buf.String())
 }
-func (s *CoreSecurityListResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreSecurityListResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeSecurityListCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -1227,12 +1228,12 @@ func (s *CoreSecurityListResourceCrud) updateCompartment(compartment interface{}
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeSecurityListCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeSecurityListCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreSecurityListsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreSecurityLists,
+		ReadContext: readCoreSecurityLists,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,12 +44,12 @@ func CoreSecurityListsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreSecurityLists(d *schema.ResourceData, m interface{}) error {
+func readCoreSecurityLists(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreSecurityListsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreSecurityListsDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *CoreSecurityListsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreSecurityListsDataSourceCrud) Get() error {
+func (s *CoreSecurityListsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListSecurityListsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -85,7 +86,7 @@ func (s *CoreSecurityListsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListSecurityLists(context.Background(), request)
+	response, err := s.Client.ListSecurityLists(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *CoreSecurityListsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSecurityLists(context.Background(), request)
+		listResponse, err := s.Client.ListSecurityLists(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -22,11 +23,11 @@ func CoreServiceGatewayResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreServiceGateway,
-		Read:     readCoreServiceGateway,
-		Update:   updateCoreServiceGateway,
-		Delete:   deleteCoreServiceGateway,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreServiceGateway,
+		ReadContext:   readCoreServiceGateway,
+		UpdateContext: updateCoreServiceGateway,
+		DeleteContext: deleteCoreServiceGateway,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -103,37 +104,37 @@ func CoreServiceGatewayResource() *schema.Resource {
 	}
 }
 
-func createCoreServiceGateway(d *schema.ResourceData, m interface{}) error {
+func createCoreServiceGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreServiceGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreServiceGateway(d *schema.ResourceData, m interface{}) error {
+func readCoreServiceGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreServiceGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreServiceGateway(d *schema.ResourceData, m interface{}) error {
+func updateCoreServiceGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreServiceGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreServiceGateway(d *schema.ResourceData, m interface{}) error {
+func deleteCoreServiceGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreServiceGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreServiceGatewayResourceCrud struct {
@@ -171,7 +172,7 @@ func (s *CoreServiceGatewayResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreServiceGatewayResourceCrud) Create() error {
+func (s *CoreServiceGatewayResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateServiceGatewayRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -226,7 +227,7 @@ func (s *CoreServiceGatewayResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateServiceGateway(context.Background(), request)
+	response, err := s.Client.CreateServiceGateway(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -235,7 +236,7 @@ func (s *CoreServiceGatewayResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreServiceGatewayResourceCrud) Get() error {
+func (s *CoreServiceGatewayResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetServiceGatewayRequest{}
 
 	tmp := s.D.Id()
@@ -243,7 +244,7 @@ func (s *CoreServiceGatewayResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetServiceGateway(context.Background(), request)
+	response, err := s.Client.GetServiceGateway(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -252,11 +253,11 @@ func (s *CoreServiceGatewayResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreServiceGatewayResourceCrud) Update() error {
+func (s *CoreServiceGatewayResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -314,7 +315,7 @@ func (s *CoreServiceGatewayResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateServiceGateway(context.Background(), request)
+	response, err := s.Client.UpdateServiceGateway(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -323,7 +324,7 @@ func (s *CoreServiceGatewayResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreServiceGatewayResourceCrud) Delete() error {
+func (s *CoreServiceGatewayResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteServiceGatewayRequest{}
 
 	tmp := s.D.Id()
@@ -331,7 +332,7 @@ func (s *CoreServiceGatewayResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteServiceGateway(context.Background(), request)
+	_, err := s.Client.DeleteServiceGateway(ctx, request)
 	return err
 }
 
@@ -410,7 +411,7 @@ func servicesHashCodeForSets(v interface{}) int {
 	}
 	return utils.GetStringHashcode(buf.String())
 }
-func (s *CoreServiceGatewayResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreServiceGatewayResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeServiceGatewayCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -421,12 +422,12 @@ func (s *CoreServiceGatewayResourceCrud) updateCompartment(compartment interface
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeServiceGatewayCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeServiceGatewayCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
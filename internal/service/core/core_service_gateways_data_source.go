@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreServiceGatewaysDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreServiceGateways,
+		ReadContext: readCoreServiceGateways,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,12 +40,12 @@ func CoreServiceGatewaysDataSource() *schema.Resource {
 	}
 }
 
-func readCoreServiceGateways(d *schema.ResourceData, m interface{}) error {
+func readCoreServiceGateways(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreServiceGatewaysDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreServiceGatewaysDataSourceCrud struct {
@@ -57,7 +58,7 @@ func (s *CoreServiceGatewaysDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreServiceGatewaysDataSourceCrud) Get() error {
+func (s *CoreServiceGatewaysDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListServiceGatewaysRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -76,7 +77,7 @@ func (s *CoreServiceGatewaysDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListServiceGateways(context.Background(), request)
+	response, err := s.Client.ListServiceGateways(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *CoreServiceGatewaysDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListServiceGateways(context.Background(), request)
+		listResponse, err := s.Client.ListServiceGateways(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreServicesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreServices,
+		ReadContext: readCoreServices,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"services": {
@@ -51,12 +52,12 @@ func CoreServicesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreServices(d *schema.ResourceData, m interface{}) error {
+func readCoreServices(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreServicesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreServicesDataSourceCrud struct {
@@ -69,12 +70,12 @@ func (s *CoreServicesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreServicesDataSourceCrud) Get() error {
+func (s *CoreServicesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListServicesRequest{}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListServices(context.Background(), request)
+	response, err := s.Client.ListServices(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -83,7 +84,7 @@ func (s *CoreServicesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListServices(context.Background(), request)
+		listResponse, err := s.Client.ListServices(ctx, request)
 		if err != nil {
 			return err
 		}
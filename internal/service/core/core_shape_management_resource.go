@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -20,10 +21,10 @@ import (
 
 func CoreShapeResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreShape,
-		Read:     readCoreShape,
-		Delete:   deleteCoreShape,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreShape,
+		ReadContext:   readCoreShape,
+		DeleteContext: deleteCoreShape,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -45,29 +46,29 @@ func CoreShapeResource() *schema.Resource {
 	}
 }
 
-func createCoreShape(d *schema.ResourceData, m interface{}) error {
+func createCoreShape(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreShapeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreShape(d *schema.ResourceData, m interface{}) error {
+func readCoreShape(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreShapeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteCoreShape(d *schema.ResourceData, m interface{}) error {
+func deleteCoreShape(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreShapeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreShapeResourceCrud struct {
@@ -81,7 +82,7 @@ func (s *CoreShapeResourceCrud) ID() string {
 	return getShapeCompositeId(s.D.Get("compartment_id").(string), s.D.Get("image_id").(string), s.D.Get("shape_name").(string))
 }
 
-func (s *CoreShapeResourceCrud) Create() error {
+func (s *CoreShapeResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.AddImageShapeCompatibilityEntryRequest{}
 
 	if imageId, ok := s.D.GetOkExists("image_id"); ok {
@@ -96,7 +97,7 @@ func (s *CoreShapeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.AddImageShapeCompatibilityEntry(context.Background(), request)
+	response, err := s.Client.AddImageShapeCompatibilityEntry(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -105,7 +106,7 @@ func (s *CoreShapeResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreShapeResourceCrud) Get() error {
+func (s *CoreShapeResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListShapesRequest{}
 
 	compartmentId, imageId, shape, err := parseShapeCompositeId(s.D.Id())
@@ -119,7 +120,7 @@ func (s *CoreShapeResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.ListShapes(context.Background(), request)
+	response, err := s.Client.ListShapes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -135,7 +136,7 @@ func (s *CoreShapeResourceCrud) Get() error {
 	return fmt.Errorf("requested compatible shape %s does not exist", shape)
 }
 
-func (s *CoreShapeResourceCrud) Delete() error {
+func (s *CoreShapeResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.RemoveImageShapeCompatibilityEntryRequest{}
 
 	_, imageId, shape, err := parseShapeCompositeId(s.D.Id())
@@ -149,7 +150,7 @@ func (s *CoreShapeResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err = s.Client.RemoveImageShapeCompatibilityEntry(context.Background(), request)
+	_, err = s.Client.RemoveImageShapeCompatibilityEntry(ctx, request)
 	return err
 }
 
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreShapesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreShapes,
+		ReadContext: readCoreShapes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -503,12 +504,12 @@ func CoreShapesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreShapes(d *schema.ResourceData, m interface{}) error {
+func readCoreShapes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreShapesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreShapesDataSourceCrud struct {
@@ -521,7 +522,7 @@ func (s *CoreShapesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreShapesDataSourceCrud) Get() error {
+func (s *CoreShapesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListShapesRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -541,7 +542,7 @@ func (s *CoreShapesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListShapes(context.Background(), request)
+	response, err := s.Client.ListShapes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -550,7 +551,7 @@ func (s *CoreShapesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListShapes(context.Background(), request)
+		listResponse, err := s.Client.ListShapes(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/oracle/terraform-provider-oci/internal/globalvar"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -23,11 +24,11 @@ func CoreSubnetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreSubnet,
-		Read:     readCoreSubnet,
-		Update:   updateCoreSubnet,
-		Delete:   deleteCoreSubnet,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreSubnet,
+		ReadContext:   readCoreSubnet,
+		UpdateContext: updateCoreSubnet,
+		DeleteContext: deleteCoreSubnet,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"cidr_block": {
@@ -155,37 +156,37 @@ func CoreSubnetResource() *schema.Resource {
 	}
 }
 
-func createCoreSubnet(d *schema.ResourceData, m interface{}) error {
+func createCoreSubnet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreSubnetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreSubnet(d *schema.ResourceData, m interface{}) error {
+func readCoreSubnet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreSubnetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreSubnet(d *schema.ResourceData, m interface{}) error {
+func updateCoreSubnet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreSubnetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreSubnet(d *schema.ResourceData, m interface{}) error {
+func deleteCoreSubnet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreSubnetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreSubnetResourceCrud struct {
@@ -223,7 +224,7 @@ func (s *CoreSubnetResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreSubnetResourceCrud) Create() error {
+func (s *CoreSubnetResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateSubnetRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -327,7 +328,7 @@ func (s *CoreSubnetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateSubnet(context.Background(), request)
+	response, err := s.Client.CreateSubnet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -336,7 +337,7 @@ func (s *CoreSubnetResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreSubnetResourceCrud) Get() error {
+func (s *CoreSubnetResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetSubnetRequest{}
 
 	tmp := s.D.Id()
@@ -344,7 +345,7 @@ func (s *CoreSubnetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetSubnet(context.Background(), request)
+	response, err := s.Client.GetSubnet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -353,11 +354,11 @@ func (s *CoreSubnetResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreSubnetResourceCrud) Update() error {
+func (s *CoreSubnetResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -368,7 +369,7 @@ func (s *CoreSubnetResourceCrud) Update() error {
 	if _, ok := s.D.GetOkExists("ipv6cidr_blocks"); ok && s.D.HasChange("ipv6cidr_blocks") {
 		oldRaw, newRaw := s.D.GetChange("ipv6cidr_blocks")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateIpv6CidrBlocks(oldRaw, newRaw)
+			err := s.updateIpv6CidrBlocks(ctx, oldRaw, newRaw)
 			if err != nil {
 				return err
 			}
@@ -434,7 +435,7 @@ func (s *CoreSubnetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateSubnet(context.Background(), request)
+	response, err := s.Client.UpdateSubnet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -443,7 +444,7 @@ func (s *CoreSubnetResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreSubnetResourceCrud) Delete() error {
+func (s *CoreSubnetResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteSubnetRequest{}
 
 	tmp := s.D.Id()
@@ -451,7 +452,7 @@ func (s *CoreSubnetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, globalvar.CoreService, globalvar.SubnetService, globalvar.DeleteResource)
 
-	_, err := s.Client.DeleteSubnet(context.Background(), request)
+	_, err := s.Client.DeleteSubnet(ctx, request)
 	return err
 }
 
@@ -539,7 +540,7 @@ func (s *CoreSubnetResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CoreSubnetResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreSubnetResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeSubnetCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -550,19 +551,19 @@ func (s *CoreSubnetResourceCrud) updateCompartment(compartment interface{}) erro
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeSubnetCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeSubnetCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
 	return nil
 }
 
-func (s *CoreSubnetResourceCrud) updateIpv6CidrBlocks(oldRaw interface{}, newRaw interface{}) error {
+func (s *CoreSubnetResourceCrud) updateIpv6CidrBlocks(ctx context.Context, oldRaw interface{}, newRaw interface{}) error {
 	interfaces := oldRaw.([]interface{})
 	oldBlocks := make([]string, len(interfaces))
 	for i := range interfaces {
@@ -592,7 +593,7 @@ func (s *CoreSubnetResourceCrud) updateIpv6CidrBlocks(oldRaw interface{}, newRaw
 		addIpv6SubnetCidrRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 		addSubnetIpv6CidrDetails.Ipv6CidrBlock = &changeCidr
 		addIpv6SubnetCidrRequest.AddSubnetIpv6CidrDetails = addSubnetIpv6CidrDetails
-		_, err := s.Client.AddIpv6SubnetCidr(context.Background(), addIpv6SubnetCidrRequest)
+		_, err := s.Client.AddIpv6SubnetCidr(ctx, addIpv6SubnetCidrRequest)
 		if err != nil {
 			return err
 		}
@@ -606,7 +607,7 @@ func (s *CoreSubnetResourceCrud) updateIpv6CidrBlocks(oldRaw interface{}, newRaw
 		removeIpv6SubnetCidrRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 		removeSubnetIpv6CidrDetails.Ipv6CidrBlock = &changeCidr
 		removeIpv6SubnetCidrRequest.RemoveSubnetIpv6CidrDetails = removeSubnetIpv6CidrDetails
-		_, err := s.Client.RemoveIpv6SubnetCidr(context.Background(), removeIpv6SubnetCidrRequest)
+		_, err := s.Client.RemoveIpv6SubnetCidr(ctx, removeIpv6SubnetCidrRequest)
 		if err != nil {
 			return err
 		}
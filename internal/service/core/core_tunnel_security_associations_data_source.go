@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreTunnelSecurityAssociationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreTunnelSecurityAssociations,
+		ReadContext: readCoreTunnelSecurityAssociations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"ipsec_id": {
@@ -63,12 +64,12 @@ func CoreTunnelSecurityAssociationsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreTunnelSecurityAssociations(d *schema.ResourceData, m interface{}) error {
+func readCoreTunnelSecurityAssociations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreTunnelSecurityAssociationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreTunnelSecurityAssociationsDataSourceCrud struct {
@@ -81,7 +82,7 @@ func (s *CoreTunnelSecurityAssociationsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreTunnelSecurityAssociationsDataSourceCrud) Get() error {
+func (s *CoreTunnelSecurityAssociationsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListIPSecConnectionTunnelSecurityAssociationsRequest{}
 
 	if ipsecId, ok := s.D.GetOkExists("ipsec_id"); ok {
@@ -96,7 +97,7 @@ func (s *CoreTunnelSecurityAssociationsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListIPSecConnectionTunnelSecurityAssociations(context.Background(), request)
+	response, err := s.Client.ListIPSecConnectionTunnelSecurityAssociations(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -105,7 +106,7 @@ func (s *CoreTunnelSecurityAssociationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIPSecConnectionTunnelSecurityAssociations(context.Background(), request)
+		listResponse, err := s.Client.ListIPSecConnectionTunnelSecurityAssociations(ctx, request)
 		if err != nil {
 			return err
 		}
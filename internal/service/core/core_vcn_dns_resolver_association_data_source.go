@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreVcnDnsResolverAssociationDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreVcnDnsResolverAssociation,
+		ReadContext: readSingularCoreVcnDnsResolverAssociation,
 		Schema: map[string]*schema.Schema{
 			"vcn_id": {
 				Type:     schema.TypeString,
@@ -34,12 +35,12 @@ func CoreVcnDnsResolverAssociationDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreVcnDnsResolverAssociation(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreVcnDnsResolverAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVcnDnsResolverAssociationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreVcnDnsResolverAssociationDataSourceCrud struct {
@@ -52,7 +53,7 @@ func (s *CoreVcnDnsResolverAssociationDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVcnDnsResolverAssociationDataSourceCrud) Get() error {
+func (s *CoreVcnDnsResolverAssociationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVcnDnsResolverAssociationRequest{}
 
 	if vcnId, ok := s.D.GetOkExists("vcn_id"); ok {
@@ -62,7 +63,7 @@ func (s *CoreVcnDnsResolverAssociationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetVcnDnsResolverAssociation(context.Background(), request)
+	response, err := s.Client.GetVcnDnsResolverAssociation(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -20,11 +21,11 @@ func CoreVcnResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreVcn,
-		Read:     readCoreVcn,
-		Update:   updateCoreVcn,
-		Delete:   deleteCoreVcn,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreVcn,
+		ReadContext:   readCoreVcn,
+		UpdateContext: updateCoreVcn,
+		DeleteContext: deleteCoreVcn,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -161,37 +162,37 @@ func CoreVcnResource() *schema.Resource {
 	}
 }
 
-func createCoreVcn(d *schema.ResourceData, m interface{}) error {
+func createCoreVcn(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVcnResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreVcn(d *schema.ResourceData, m interface{}) error {
+func readCoreVcn(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVcnResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreVcn(d *schema.ResourceData, m interface{}) error {
+func updateCoreVcn(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVcnResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreVcn(d *schema.ResourceData, m interface{}) error {
+func deleteCoreVcn(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVcnResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreVcnResourceCrud struct {
@@ -229,7 +230,7 @@ func (s *CoreVcnResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreVcnResourceCrud) Create() error {
+func (s *CoreVcnResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateVcnRequest{}
 
 	if byoipv6CidrDetails, ok := s.D.GetOkExists("byoipv6cidr_details"); ok {
@@ -319,7 +320,7 @@ func (s *CoreVcnResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVcn(context.Background(), request)
+	response, err := s.Client.CreateVcn(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -328,7 +329,7 @@ func (s *CoreVcnResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreVcnResourceCrud) Get() error {
+func (s *CoreVcnResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVcnRequest{}
 
 	tmp := s.D.Id()
@@ -336,7 +337,7 @@ func (s *CoreVcnResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVcn(context.Background(), request)
+	response, err := s.Client.GetVcn(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -345,11 +346,11 @@ func (s *CoreVcnResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreVcnResourceCrud) Update() error {
+func (s *CoreVcnResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -362,14 +363,14 @@ func (s *CoreVcnResourceCrud) Update() error {
 		enableIPv6Request.VcnId = &tmp
 		enableIPv6Request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-		_, err := s.Client.AddIpv6VcnCidr(context.Background(), enableIPv6Request)
+		_, err := s.Client.AddIpv6VcnCidr(ctx, enableIPv6Request)
 		if err != nil {
 			return err
 		}
 	}
 
 	if byoipv6CidrDetails, ok := s.D.GetOkExists("byoipv6Cidr_details"); ok && s.D.HasChange("byoipv6Cidr_details") {
-		err := s.addByoIpv6CidrBlocks(byoipv6CidrDetails)
+		err := s.addByoIpv6CidrBlocks(ctx, byoipv6CidrDetails)
 		if err != nil {
 			return err
 		}
@@ -378,7 +379,7 @@ func (s *CoreVcnResourceCrud) Update() error {
 	if _, ok := s.D.GetOkExists("ipv6private_cidr_blocks"); ok && s.D.HasChange("ipv6private_cidr_blocks") {
 		oldRaw, newRaw := s.D.GetChange("ipv6private_cidr_blocks")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateIpv6CidrBlocks(oldRaw, newRaw)
+			err := s.updateIpv6CidrBlocks(ctx, oldRaw, newRaw)
 			if err != nil {
 				return err
 			}
@@ -388,7 +389,7 @@ func (s *CoreVcnResourceCrud) Update() error {
 	if _, ok := s.D.GetOkExists("byoipv6cidr_blocks"); ok && s.D.HasChange("byoipv6cidr_blocks") {
 		oldRaw, newRaw := s.D.GetChange("byoipv6cidr_blocks")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateIpv6CidrBlocks(oldRaw, newRaw)
+			err := s.updateIpv6CidrBlocks(ctx, oldRaw, newRaw)
 			if err != nil {
 				return err
 			}
@@ -404,7 +405,7 @@ func (s *CoreVcnResourceCrud) Update() error {
 		isOracleGuaAllocationEnabled := enableOracleGuaAllocation.(bool)
 		addVcnIpv6CidrDetails.IsOracleGuaAllocationEnabled = &isOracleGuaAllocationEnabled
 		enableIPv6Request.AddVcnIpv6CidrDetails = addVcnIpv6CidrDetails
-		_, err := s.Client.AddIpv6VcnCidr(context.Background(), enableIPv6Request)
+		_, err := s.Client.AddIpv6VcnCidr(ctx, enableIPv6Request)
 		if err != nil {
 			return err
 		}
@@ -415,7 +416,7 @@ func (s *CoreVcnResourceCrud) Update() error {
 	if _, ok := s.D.GetOkExists("cidr_blocks"); ok && s.D.HasChange("cidr_blocks") {
 		oldRaw, newRaw := s.D.GetChange("cidr_blocks")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCidrBlocks(oldRaw, newRaw)
+			err := s.updateCidrBlocks(ctx, oldRaw, newRaw)
 			if err != nil {
 				return err
 			}
@@ -444,7 +445,7 @@ func (s *CoreVcnResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateVcn(context.Background(), request)
+	response, err := s.Client.UpdateVcn(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -453,7 +454,7 @@ func (s *CoreVcnResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreVcnResourceCrud) addByoIpv6CidrBlocks(byoipv6CidrDetails interface{}) error {
+func (s *CoreVcnResourceCrud) addByoIpv6CidrBlocks(ctx context.Context, byoipv6CidrDetails interface{}) error {
 	request := oci_core.AddIpv6VcnCidrRequest{}
 	addVcnIpv6CidrDetails := oci_core.AddVcnIpv6CidrDetails{}
 	fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", "byoipv6cidr_details", byoipv6CidrDetails)
@@ -467,14 +468,14 @@ func (s *CoreVcnResourceCrud) addByoIpv6CidrBlocks(byoipv6CidrDetails interface{
 	request.VcnId = &idTmp
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 	request.AddVcnIpv6CidrDetails = addVcnIpv6CidrDetails
-	_, err = s.Client.AddIpv6VcnCidr(context.Background(), request)
+	_, err = s.Client.AddIpv6VcnCidr(ctx, request)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (s *CoreVcnResourceCrud) updateIpv6CidrBlocks(oldRaw interface{}, newRaw interface{}) error {
+func (s *CoreVcnResourceCrud) updateIpv6CidrBlocks(ctx context.Context, oldRaw interface{}, newRaw interface{}) error {
 	interfaces := oldRaw.([]interface{})
 	oldBlocks := make([]string, len(interfaces))
 	for i := range interfaces {
@@ -503,7 +504,7 @@ func (s *CoreVcnResourceCrud) updateIpv6CidrBlocks(oldRaw interface{}, newRaw in
 		addIpv6VcnCidrRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 		addVcnIpv6CidrDetails.Ipv6PrivateCidrBlock = &newCidr
 		addIpv6VcnCidrRequest.AddVcnIpv6CidrDetails = addVcnIpv6CidrDetails
-		_, err := s.Client.AddIpv6VcnCidr(context.Background(), addIpv6VcnCidrRequest)
+		_, err := s.Client.AddIpv6VcnCidr(ctx, addIpv6VcnCidrRequest)
 		if err != nil {
 			return err
 		}
@@ -516,7 +517,7 @@ func (s *CoreVcnResourceCrud) updateIpv6CidrBlocks(oldRaw interface{}, newRaw in
 		removeIpv6VcnCidrRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 		removeVcnIpv6CidrDetails.Ipv6CidrBlock = &oldCidr
 		removeIpv6VcnCidrRequest.RemoveVcnIpv6CidrDetails = removeVcnIpv6CidrDetails
-		_, err := s.Client.RemoveIpv6VcnCidr(context.Background(), removeIpv6VcnCidrRequest)
+		_, err := s.Client.RemoveIpv6VcnCidr(ctx, removeIpv6VcnCidrRequest)
 		if err != nil {
 			return err
 		}
@@ -524,7 +525,7 @@ func (s *CoreVcnResourceCrud) updateIpv6CidrBlocks(oldRaw interface{}, newRaw in
 	return nil
 }
 
-func (s *CoreVcnResourceCrud) Delete() error {
+func (s *CoreVcnResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteVcnRequest{}
 
 	tmp := s.D.Id()
@@ -532,7 +533,7 @@ func (s *CoreVcnResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVcn(context.Background(), request)
+	_, err := s.Client.DeleteVcn(ctx, request)
 	return err
 }
 
@@ -628,7 +629,7 @@ func Byoipv6CidrDetailsToMap(obj oci_core.Byoipv6CidrDetails) map[string]interfa
 	return result
 }
 
-func (s *CoreVcnResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreVcnResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeVcnCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -639,19 +640,19 @@ func (s *CoreVcnResourceCrud) updateCompartment(compartment interface{}) error {
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeVcnCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeVcnCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
 	return nil
 }
 
-func (s *CoreVcnResourceCrud) updateCidrBlocks(oldRaw interface{}, newRaw interface{}) error {
+func (s *CoreVcnResourceCrud) updateCidrBlocks(ctx context.Context, oldRaw interface{}, newRaw interface{}) error {
 	interfaces := oldRaw.([]interface{})
 	oldBlocks := make([]string, len(interfaces))
 	for i := range interfaces {
@@ -676,7 +677,7 @@ func (s *CoreVcnResourceCrud) updateCidrBlocks(oldRaw interface{}, newRaw interf
 		addVcnCidrRequest.VcnId = &idTmp
 		addVcnCidrRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 		addVcnCidrRequest.CidrBlock = &newCidr
-		_, err := s.Client.AddVcnCidr(context.Background(), addVcnCidrRequest)
+		_, err := s.Client.AddVcnCidr(ctx, addVcnCidrRequest)
 		if err != nil {
 			return err
 		}
@@ -687,7 +688,7 @@ func (s *CoreVcnResourceCrud) updateCidrBlocks(oldRaw interface{}, newRaw interf
 		removeVcnCidrRequest.VcnId = &idTmp
 		removeVcnCidrRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 		removeVcnCidrRequest.CidrBlock = &oldCidr
-		_, err := s.Client.RemoveVcnCidr(context.Background(), removeVcnCidrRequest)
+		_, err := s.Client.RemoveVcnCidr(ctx, removeVcnCidrRequest)
 		if err != nil {
 			return err
 		}
@@ -699,7 +700,7 @@ func (s *CoreVcnResourceCrud) updateCidrBlocks(oldRaw interface{}, newRaw interf
 		modifyVcnCidrRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 		modifyVcnCidrRequest.OriginalCidrBlock = &oldCidr
 		modifyVcnCidrRequest.NewCidrBlock = &newCidr
-		_, err := s.Client.ModifyVcnCidr(context.Background(), modifyVcnCidrRequest)
+		_, err := s.Client.ModifyVcnCidr(ctx, modifyVcnCidrRequest)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreVirtualCircuitBandwidthShapesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreVirtualCircuitBandwidthShapes,
+		ReadContext: readCoreVirtualCircuitBandwidthShapes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"provider_service_id": {
@@ -47,12 +48,12 @@ func CoreVirtualCircuitBandwidthShapesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreVirtualCircuitBandwidthShapes(d *schema.ResourceData, m interface{}) error {
+func readCoreVirtualCircuitBandwidthShapes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVirtualCircuitBandwidthShapesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreVirtualCircuitBandwidthShapesDataSourceCrud struct {
@@ -65,7 +66,7 @@ func (s *CoreVirtualCircuitBandwidthShapesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVirtualCircuitBandwidthShapesDataSourceCrud) Get() error {
+func (s *CoreVirtualCircuitBandwidthShapesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListFastConnectProviderVirtualCircuitBandwidthShapesRequest{}
 
 	if providerServiceId, ok := s.D.GetOkExists("provider_service_id"); ok {
@@ -75,7 +76,7 @@ func (s *CoreVirtualCircuitBandwidthShapesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListFastConnectProviderVirtualCircuitBandwidthShapes(context.Background(), request)
+	response, err := s.Client.ListFastConnectProviderVirtualCircuitBandwidthShapes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -84,7 +85,7 @@ func (s *CoreVirtualCircuitBandwidthShapesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListFastConnectProviderVirtualCircuitBandwidthShapes(context.Background(), request)
+		listResponse, err := s.Client.ListFastConnectProviderVirtualCircuitBandwidthShapes(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -23,12 +24,12 @@ func CoreVirtualCircuitDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreVirtualCircuitResource(), fieldMap, readSingularCoreVirtualCircuit)
 }
 
-func readSingularCoreVirtualCircuit(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreVirtualCircuit(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVirtualCircuitDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreVirtualCircuitDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *CoreVirtualCircuitDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVirtualCircuitDataSourceCrud) Get() error {
+func (s *CoreVirtualCircuitDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVirtualCircuitRequest{}
 
 	if virtualCircuitId, ok := s.D.GetOkExists("virtual_circuit_id"); ok {
@@ -51,7 +52,7 @@ func (s *CoreVirtualCircuitDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetVirtualCircuit(context.Background(), request)
+	response, err := s.Client.GetVirtualCircuit(ctx, request)
 	if err != nil {
 		return err
 	}
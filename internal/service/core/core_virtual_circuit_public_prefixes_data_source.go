@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreVirtualCircuitPublicPrefixesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreVirtualCircuitPublicPrefixes,
+		ReadContext: readCoreVirtualCircuitPublicPrefixes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"verification_state": {
@@ -51,12 +52,12 @@ func CoreVirtualCircuitPublicPrefixesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreVirtualCircuitPublicPrefixes(d *schema.ResourceData, m interface{}) error {
+func readCoreVirtualCircuitPublicPrefixes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVirtualCircuitPublicPrefixesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreVirtualCircuitPublicPrefixesDataSourceCrud struct {
@@ -69,7 +70,7 @@ func (s *CoreVirtualCircuitPublicPrefixesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVirtualCircuitPublicPrefixesDataSourceCrud) Get() error {
+func (s *CoreVirtualCircuitPublicPrefixesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListVirtualCircuitPublicPrefixesRequest{}
 
 	if verificationState, ok := s.D.GetOkExists("verification_state"); ok {
@@ -83,7 +84,7 @@ func (s *CoreVirtualCircuitPublicPrefixesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListVirtualCircuitPublicPrefixes(context.Background(), request)
+	response, err := s.Client.ListVirtualCircuitPublicPrefixes(ctx, request)
 	if err != nil {
 		return err
 	}
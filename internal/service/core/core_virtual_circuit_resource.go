@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -24,11 +25,11 @@ func CoreVirtualCircuitResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreVirtualCircuit,
-		Read:     readCoreVirtualCircuit,
-		Update:   updateCoreVirtualCircuit,
-		Delete:   deleteCoreVirtualCircuit,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreVirtualCircuit,
+		ReadContext:   readCoreVirtualCircuit,
+		UpdateContext: updateCoreVirtualCircuit,
+		DeleteContext: deleteCoreVirtualCircuit,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -237,37 +238,37 @@ func CoreVirtualCircuitResource() *schema.Resource {
 	}
 }
 
-func createCoreVirtualCircuit(d *schema.ResourceData, m interface{}) error {
+func createCoreVirtualCircuit(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVirtualCircuitResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreVirtualCircuit(d *schema.ResourceData, m interface{}) error {
+func readCoreVirtualCircuit(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVirtualCircuitResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreVirtualCircuit(d *schema.ResourceData, m interface{}) error {
+func updateCoreVirtualCircuit(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVirtualCircuitResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreVirtualCircuit(d *schema.ResourceData, m interface{}) error {
+func deleteCoreVirtualCircuit(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVirtualCircuitResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreVirtualCircuitResourceCrud struct {
@@ -320,7 +321,7 @@ func (s *CoreVirtualCircuitResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *CoreVirtualCircuitResourceCrud) Create() error {
+func (s *CoreVirtualCircuitResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateVirtualCircuitRequest{}
 
 	if bandwidthShapeName, ok := s.D.GetOkExists("bandwidth_shape_name"); ok {
@@ -456,7 +457,7 @@ func (s *CoreVirtualCircuitResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVirtualCircuit(context.Background(), request)
+	response, err := s.Client.CreateVirtualCircuit(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -465,7 +466,7 @@ func (s *CoreVirtualCircuitResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreVirtualCircuitResourceCrud) Get() error {
+func (s *CoreVirtualCircuitResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVirtualCircuitRequest{}
 
 	tmp := s.D.Id()
@@ -473,7 +474,7 @@ func (s *CoreVirtualCircuitResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVirtualCircuit(context.Background(), request)
+	response, err := s.Client.GetVirtualCircuit(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -484,7 +485,7 @@ func (s *CoreVirtualCircuitResourceCrud) Get() error {
 	ppRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 	ppRequest.VirtualCircuitId = request.VirtualCircuitId
 
-	ppResponse, ppErr := s.Client.ListVirtualCircuitPublicPrefixes(context.Background(), ppRequest)
+	ppResponse, ppErr := s.Client.ListVirtualCircuitPublicPrefixes(ctx, ppRequest)
 	if ppErr != nil {
 		return ppErr
 	}
@@ -499,11 +500,11 @@ func (s *CoreVirtualCircuitResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreVirtualCircuitResourceCrud) Update() error {
+func (s *CoreVirtualCircuitResourceCrud) Update(ctx context.Context) error {
 	// Update public prefixes, if changed
 	// Cannot Update PublicPrefix when the VirtualCircuit is in state PROVISIONING so public prefixes should be updated first
 	if s.D.HasChange("public_prefixes") {
-		err := s.updatePublicPrefixes()
+		err := s.updatePublicPrefixes(ctx)
 		if err != nil {
 			return fmt.Errorf("unable to Update 'public_prefixes', error: %v", err)
 		}
@@ -511,12 +512,12 @@ func (s *CoreVirtualCircuitResourceCrud) Update() error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
 
-			if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+			if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 				return waitErr
 			}
 		}
@@ -625,7 +626,7 @@ func (s *CoreVirtualCircuitResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateVirtualCircuit(context.Background(), request)
+	response, err := s.Client.UpdateVirtualCircuit(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -635,7 +636,7 @@ func (s *CoreVirtualCircuitResourceCrud) Update() error {
 }
 
 // Update public prefixes using BulkAdd and BulkDelete APIs by computing the diff
-func (s *CoreVirtualCircuitResourceCrud) updatePublicPrefixes() error {
+func (s *CoreVirtualCircuitResourceCrud) updatePublicPrefixes(ctx context.Context) error {
 	virtualCircuitId := s.D.Id()
 
 	o, n := s.D.GetChange("public_prefixes")
@@ -672,12 +673,12 @@ func (s *CoreVirtualCircuitResourceCrud) updatePublicPrefixes() error {
 		addRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 		addRequest.PublicPrefixes = publicPrefixesToAdd
 		addRequest.VirtualCircuitId = &virtualCircuitId
-		_, addErr := s.Client.BulkAddVirtualCircuitPublicPrefixes(context.Background(), addRequest)
+		_, addErr := s.Client.BulkAddVirtualCircuitPublicPrefixes(ctx, addRequest)
 		if addErr != nil {
 			return fmt.Errorf("failed to add public prefixes, error: %v", addErr)
 		}
 
-		if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+		if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 			return waitErr
 		}
 	}
@@ -689,12 +690,12 @@ func (s *CoreVirtualCircuitResourceCrud) updatePublicPrefixes() error {
 		deleteRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 		deleteRequest.PublicPrefixes = publicPrefixesToDelete
 		deleteRequest.VirtualCircuitId = &virtualCircuitId
-		_, deleteErr := s.Client.BulkDeleteVirtualCircuitPublicPrefixes(context.Background(), deleteRequest)
+		_, deleteErr := s.Client.BulkDeleteVirtualCircuitPublicPrefixes(ctx, deleteRequest)
 		if deleteErr != nil {
 			return fmt.Errorf("failed to delete public prefixes, error: %v", deleteErr)
 		}
 
-		if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+		if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 			return waitErr
 		}
 	}
@@ -702,7 +703,7 @@ func (s *CoreVirtualCircuitResourceCrud) updatePublicPrefixes() error {
 	return nil
 }
 
-func (s *CoreVirtualCircuitResourceCrud) Delete() error {
+func (s *CoreVirtualCircuitResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteVirtualCircuitRequest{}
 
 	tmp := s.D.Id()
@@ -710,7 +711,7 @@ func (s *CoreVirtualCircuitResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVirtualCircuit(context.Background(), request)
+	_, err := s.Client.DeleteVirtualCircuit(ctx, request)
 	return err
 }
 
@@ -944,7 +945,7 @@ func publicPrefixesHashCodeForSets(v interface{}) int {
 	}
 	return utils.GetStringHashcode(buf.String())
 }
-func (s *CoreVirtualCircuitResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreVirtualCircuitResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeVirtualCircuitCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -955,12 +956,12 @@ func (s *CoreVirtualCircuitResourceCrud) updateCompartment(compartment interface
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeVirtualCircuitCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeVirtualCircuitCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -16,7 +17,7 @@ import (
 
 func CoreVirtualCircuitsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreVirtualCircuits,
+		ReadContext: readCoreVirtualCircuits,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -40,12 +41,12 @@ func CoreVirtualCircuitsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreVirtualCircuits(d *schema.ResourceData, m interface{}) error {
+func readCoreVirtualCircuits(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVirtualCircuitsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreVirtualCircuitsDataSourceCrud struct {
@@ -58,7 +59,7 @@ func (s *CoreVirtualCircuitsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVirtualCircuitsDataSourceCrud) Get() error {
+func (s *CoreVirtualCircuitsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListVirtualCircuitsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -77,7 +78,7 @@ func (s *CoreVirtualCircuitsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListVirtualCircuits(context.Background(), request)
+	response, err := s.Client.ListVirtualCircuits(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *CoreVirtualCircuitsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVirtualCircuits(context.Background(), request)
+		listResponse, err := s.Client.ListVirtualCircuits(ctx, request)
 		if err != nil {
 			return err
 		}
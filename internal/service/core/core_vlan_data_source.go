@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -22,12 +23,12 @@ func CoreVlanDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreVlanResource(), fieldMap, readSingularCoreVlan)
 }
 
-func readSingularCoreVlan(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreVlan(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVlanDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreVlanDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *CoreVlanDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVlanDataSourceCrud) Get() error {
+func (s *CoreVlanDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVlanRequest{}
 
 	if vlanId, ok := s.D.GetOkExists("vlan_id"); ok {
@@ -50,7 +51,7 @@ func (s *CoreVlanDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetVlan(context.Background(), request)
+	response, err := s.Client.GetVlan(ctx, request)
 	if err != nil {
 		return err
 	}
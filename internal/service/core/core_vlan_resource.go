@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -19,11 +20,11 @@ func CoreVlanResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreVlan,
-		Read:     readCoreVlan,
-		Update:   updateCoreVlan,
-		Delete:   deleteCoreVlan,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreVlan,
+		ReadContext:   readCoreVlan,
+		UpdateContext: updateCoreVlan,
+		DeleteContext: deleteCoreVlan,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"cidr_block": {
@@ -100,37 +101,37 @@ func CoreVlanResource() *schema.Resource {
 	}
 }
 
-func createCoreVlan(d *schema.ResourceData, m interface{}) error {
+func createCoreVlan(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVlanResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreVlan(d *schema.ResourceData, m interface{}) error {
+func readCoreVlan(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVlanResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreVlan(d *schema.ResourceData, m interface{}) error {
+func updateCoreVlan(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVlanResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreVlan(d *schema.ResourceData, m interface{}) error {
+func deleteCoreVlan(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVlanResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreVlanResourceCrud struct {
@@ -168,7 +169,7 @@ func (s *CoreVlanResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreVlanResourceCrud) Create() error {
+func (s *CoreVlanResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateVlanRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -234,7 +235,7 @@ func (s *CoreVlanResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVlan(context.Background(), request)
+	response, err := s.Client.CreateVlan(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -243,7 +244,7 @@ func (s *CoreVlanResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreVlanResourceCrud) Get() error {
+func (s *CoreVlanResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVlanRequest{}
 
 	tmp := s.D.Id()
@@ -251,7 +252,7 @@ func (s *CoreVlanResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVlan(context.Background(), request)
+	response, err := s.Client.GetVlan(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -260,11 +261,11 @@ func (s *CoreVlanResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreVlanResourceCrud) Update() error {
+func (s *CoreVlanResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -318,7 +319,7 @@ func (s *CoreVlanResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateVlan(context.Background(), request)
+	response, err := s.Client.UpdateVlan(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -327,7 +328,7 @@ func (s *CoreVlanResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreVlanResourceCrud) Delete() error {
+func (s *CoreVlanResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteVlanRequest{}
 
 	tmp := s.D.Id()
@@ -335,7 +336,7 @@ func (s *CoreVlanResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVlan(context.Background(), request)
+	_, err := s.Client.DeleteVlan(ctx, request)
 	return err
 }
 
@@ -389,7 +390,7 @@ func (s *CoreVlanResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CoreVlanResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreVlanResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeVlanCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -400,12 +401,12 @@ func (s *CoreVlanResourceCrud) updateCompartment(compartment interface{}) error
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeVlanCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeVlanCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,6 +9,7 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -22,11 +23,11 @@ func CoreVnicAttachmentResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreVnicAttachment,
-		Read:     readCoreVnicAttachment,
-		Update:   updateCoreVnicAttachment,
-		Delete:   deleteCoreVnicAttachment,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreVnicAttachment,
+		ReadContext:   readCoreVnicAttachment,
+		UpdateContext: updateCoreVnicAttachment,
+		DeleteContext: deleteCoreVnicAttachment,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"create_vnic_details": {
@@ -181,41 +182,41 @@ func CoreVnicAttachmentResource() *schema.Resource {
 	}
 }
 
-func createCoreVnicAttachment(d *schema.ResourceData, m interface{}) error {
+func createCoreVnicAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVnicAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.VirtualNetworkClient = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreVnicAttachment(d *schema.ResourceData, m interface{}) error {
+func readCoreVnicAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVnicAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.VirtualNetworkClient = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreVnicAttachment(d *schema.ResourceData, m interface{}) error {
+func updateCoreVnicAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVnicAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.VirtualNetworkClient = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreVnicAttachment(d *schema.ResourceData, m interface{}) error {
+func deleteCoreVnicAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVnicAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.VirtualNetworkClient = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreVnicAttachmentResourceCrud struct {
@@ -254,7 +255,7 @@ func (s *CoreVnicAttachmentResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreVnicAttachmentResourceCrud) Create() error {
+func (s *CoreVnicAttachmentResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.AttachVnicRequest{}
 
 	if createVnicDetails, ok := s.D.GetOkExists("create_vnic_details"); ok {
@@ -285,7 +286,7 @@ func (s *CoreVnicAttachmentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.AttachVnic(context.Background(), request)
+	response, err := s.Client.AttachVnic(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -297,10 +298,10 @@ func (s *CoreVnicAttachmentResourceCrud) Create() error {
 // @CODEGEN 1/2018: Generator doesn't give us an Update method for VnicAttachment.
 // However, the existing behavior allows vnic to be updated through the create_vnic_details.
 // So keep this Update functionality in the provider.
-func (s *CoreVnicAttachmentResourceCrud) Update() error {
+func (s *CoreVnicAttachmentResourceCrud) Update(ctx context.Context) error {
 	// We should fetch the VnicAttachment in order to Update
 	// the state data after the Update call.
-	err := s.Get()
+	err := s.Get(ctx)
 	if err != nil {
 		return err
 	}
@@ -328,11 +329,11 @@ func (s *CoreVnicAttachmentResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err = s.VirtualNetworkClient.UpdateVnic(context.Background(), request)
+	_, err = s.VirtualNetworkClient.UpdateVnic(ctx, request)
 	return err
 }
 
-func (s *CoreVnicAttachmentResourceCrud) Get() error {
+func (s *CoreVnicAttachmentResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVnicAttachmentRequest{}
 
 	tmp := s.D.Id()
@@ -340,7 +341,7 @@ func (s *CoreVnicAttachmentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVnicAttachment(context.Background(), request)
+	response, err := s.Client.GetVnicAttachment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -349,7 +350,7 @@ func (s *CoreVnicAttachmentResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreVnicAttachmentResourceCrud) Delete() error {
+func (s *CoreVnicAttachmentResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DetachVnicRequest{}
 
 	tmp := s.D.Id()
@@ -357,7 +358,7 @@ func (s *CoreVnicAttachmentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DetachVnic(context.Background(), request)
+	_, err := s.Client.DetachVnic(ctx, request)
 	return err
 }
 
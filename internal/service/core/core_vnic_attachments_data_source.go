@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreVnicAttachmentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreVnicAttachments,
+		ReadContext: readCoreVnicAttachments,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -43,12 +44,12 @@ func CoreVnicAttachmentsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreVnicAttachments(d *schema.ResourceData, m interface{}) error {
+func readCoreVnicAttachments(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVnicAttachmentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreVnicAttachmentsDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *CoreVnicAttachmentsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVnicAttachmentsDataSourceCrud) Get() error {
+func (s *CoreVnicAttachmentsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListVnicAttachmentsRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -86,7 +87,7 @@ func (s *CoreVnicAttachmentsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListVnicAttachments(context.Background(), request)
+	response, err := s.Client.ListVnicAttachments(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +96,7 @@ func (s *CoreVnicAttachmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVnicAttachments(context.Background(), request)
+		listResponse, err := s.Client.ListVnicAttachments(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreVnicDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreVnic,
+		ReadContext: readSingularCoreVnic,
 		Schema: map[string]*schema.Schema{
 			"vnic_id": {
 				Type:     schema.TypeString,
@@ -95,12 +96,12 @@ func CoreVnicDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreVnic(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreVnic(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVnicDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreVnicDataSourceCrud struct {
@@ -113,7 +114,7 @@ func (s *CoreVnicDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVnicDataSourceCrud) Get() error {
+func (s *CoreVnicDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVnicRequest{}
 
 	if vnicId, ok := s.D.GetOkExists("vnic_id"); ok {
@@ -123,7 +124,7 @@ func (s *CoreVnicDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetVnic(context.Background(), request)
+	response, err := s.Client.GetVnic(ctx, request)
 	if err != nil {
 		return err
 	}
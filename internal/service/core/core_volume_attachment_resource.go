@@ -9,6 +9,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -23,10 +24,10 @@ func CoreVolumeAttachmentResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreVolumeAttachment,
-		Read:     readCoreVolumeAttachment,
-		Delete:   deleteCoreVolumeAttachment,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreVolumeAttachment,
+		ReadContext:   readCoreVolumeAttachment,
+		DeleteContext: deleteCoreVolumeAttachment,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"attachment_type": {
@@ -178,29 +179,29 @@ func CoreVolumeAttachmentResource() *schema.Resource {
 	}
 }
 
-func createCoreVolumeAttachment(d *schema.ResourceData, m interface{}) error {
+func createCoreVolumeAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreVolumeAttachment(d *schema.ResourceData, m interface{}) error {
+func readCoreVolumeAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteCoreVolumeAttachment(d *schema.ResourceData, m interface{}) error {
+func deleteCoreVolumeAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreVolumeAttachmentResourceCrud struct {
@@ -239,7 +240,7 @@ func (s *CoreVolumeAttachmentResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreVolumeAttachmentResourceCrud) Create() error {
+func (s *CoreVolumeAttachmentResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.AttachVolumeRequest{}
 	err := s.populateTopLevelPolymorphicAttachVolumeRequest(&request)
 	if err != nil {
@@ -248,7 +249,7 @@ func (s *CoreVolumeAttachmentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.AttachVolume(context.Background(), request)
+	response, err := s.Client.AttachVolume(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -257,7 +258,7 @@ func (s *CoreVolumeAttachmentResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreVolumeAttachmentResourceCrud) Get() error {
+func (s *CoreVolumeAttachmentResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVolumeAttachmentRequest{}
 
 	tmp := s.D.Id()
@@ -265,7 +266,7 @@ func (s *CoreVolumeAttachmentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVolumeAttachment(context.Background(), request)
+	response, err := s.Client.GetVolumeAttachment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -274,7 +275,7 @@ func (s *CoreVolumeAttachmentResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreVolumeAttachmentResourceCrud) Delete() error {
+func (s *CoreVolumeAttachmentResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DetachVolumeRequest{}
 
 	tmp := s.D.Id()
@@ -282,7 +283,7 @@ func (s *CoreVolumeAttachmentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DetachVolume(context.Background(), request)
+	_, err := s.Client.DetachVolume(ctx, request)
 	return err
 }
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -16,7 +17,7 @@ import (
 
 func CoreVolumeAttachmentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreVolumeAttachments,
+		ReadContext: readCoreVolumeAttachments,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -44,12 +45,12 @@ func CoreVolumeAttachmentsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreVolumeAttachments(d *schema.ResourceData, m interface{}) error {
+func readCoreVolumeAttachments(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeAttachmentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreVolumeAttachmentsDataSourceCrud struct {
@@ -62,7 +63,7 @@ func (s *CoreVolumeAttachmentsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVolumeAttachmentsDataSourceCrud) Get() error {
+func (s *CoreVolumeAttachmentsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListVolumeAttachmentsRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -87,7 +88,7 @@ func (s *CoreVolumeAttachmentsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListVolumeAttachments(context.Background(), request)
+	response, err := s.Client.ListVolumeAttachments(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -96,7 +97,7 @@ func (s *CoreVolumeAttachmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVolumeAttachments(context.Background(), request)
+		listResponse, err := s.Client.ListVolumeAttachments(ctx, request)
 		if err != nil {
 			return err
 		}
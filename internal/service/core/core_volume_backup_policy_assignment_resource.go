@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -19,10 +20,10 @@ func CoreVolumeBackupPolicyAssignmentResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreVolumeBackupPolicyAssignment,
-		Read:     readCoreVolumeBackupPolicyAssignment,
-		Delete:   deleteCoreVolumeBackupPolicyAssignment,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreVolumeBackupPolicyAssignment,
+		ReadContext:   readCoreVolumeBackupPolicyAssignment,
+		DeleteContext: deleteCoreVolumeBackupPolicyAssignment,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"asset_id": {
@@ -47,29 +48,29 @@ func CoreVolumeBackupPolicyAssignmentResource() *schema.Resource {
 	}
 }
 
-func createCoreVolumeBackupPolicyAssignment(d *schema.ResourceData, m interface{}) error {
+func createCoreVolumeBackupPolicyAssignment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeBackupPolicyAssignmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreVolumeBackupPolicyAssignment(d *schema.ResourceData, m interface{}) error {
+func readCoreVolumeBackupPolicyAssignment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeBackupPolicyAssignmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteCoreVolumeBackupPolicyAssignment(d *schema.ResourceData, m interface{}) error {
+func deleteCoreVolumeBackupPolicyAssignment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeBackupPolicyAssignmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreVolumeBackupPolicyAssignmentResourceCrud struct {
@@ -83,7 +84,7 @@ func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) Create() error {
+func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateVolumeBackupPolicyAssignmentRequest{}
 
 	if assetId, ok := s.D.GetOkExists("asset_id"); ok {
@@ -98,7 +99,7 @@ func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVolumeBackupPolicyAssignment(context.Background(), request)
+	response, err := s.Client.CreateVolumeBackupPolicyAssignment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -107,7 +108,7 @@ func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) Get() error {
+func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVolumeBackupPolicyAssignmentRequest{}
 
 	tmp := s.D.Id()
@@ -115,7 +116,7 @@ func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVolumeBackupPolicyAssignment(context.Background(), request)
+	response, err := s.Client.GetVolumeBackupPolicyAssignment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -124,7 +125,7 @@ func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) Delete() error {
+func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteVolumeBackupPolicyAssignmentRequest{}
 
 	tmp := s.D.Id()
@@ -132,7 +133,7 @@ func (s *CoreVolumeBackupPolicyAssignmentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVolumeBackupPolicyAssignment(context.Background(), request)
+	_, err := s.Client.DeleteVolumeBackupPolicyAssignment(ctx, request)
 	return err
 }
 
@@ -6,6 +6,7 @@ package core
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -15,7 +16,7 @@ import (
 
 func CoreVolumeBackupPolicyAssignmentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreVolumeBackupPolicyAssignments,
+		ReadContext: readCoreVolumeBackupPolicyAssignments,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"asset_id": {
@@ -31,12 +32,12 @@ func CoreVolumeBackupPolicyAssignmentsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreVolumeBackupPolicyAssignments(d *schema.ResourceData, m interface{}) error {
+func readCoreVolumeBackupPolicyAssignments(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeBackupPolicyAssignmentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreVolumeBackupPolicyAssignmentsDataSourceCrud struct {
@@ -49,7 +50,7 @@ func (s *CoreVolumeBackupPolicyAssignmentsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVolumeBackupPolicyAssignmentsDataSourceCrud) Get() error {
+func (s *CoreVolumeBackupPolicyAssignmentsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVolumeBackupPolicyAssetAssignmentRequest{}
 
 	if assetId, ok := s.D.GetOkExists("asset_id"); ok {
@@ -59,7 +60,7 @@ func (s *CoreVolumeBackupPolicyAssignmentsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetVolumeBackupPolicyAssetAssignment(context.Background(), request)
+	response, err := s.Client.GetVolumeBackupPolicyAssetAssignment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -68,7 +69,7 @@ func (s *CoreVolumeBackupPolicyAssignmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.GetVolumeBackupPolicyAssetAssignment(context.Background(), request)
+		listResponse, err := s.Client.GetVolumeBackupPolicyAssetAssignment(ctx, request)
 		if err != nil {
 			return err
 		}
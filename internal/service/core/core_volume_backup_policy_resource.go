@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -22,11 +23,11 @@ func CoreVolumeBackupPolicyResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreVolumeBackupPolicy,
-		Read:     readCoreVolumeBackupPolicy,
-		Update:   updateCoreVolumeBackupPolicy,
-		Delete:   deleteCoreVolumeBackupPolicy,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreVolumeBackupPolicy,
+		ReadContext:   readCoreVolumeBackupPolicy,
+		UpdateContext: updateCoreVolumeBackupPolicy,
+		DeleteContext: deleteCoreVolumeBackupPolicy,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -126,37 +127,37 @@ func CoreVolumeBackupPolicyResource() *schema.Resource {
 	}
 }
 
-func createCoreVolumeBackupPolicy(d *schema.ResourceData, m interface{}) error {
+func createCoreVolumeBackupPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeBackupPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreVolumeBackupPolicy(d *schema.ResourceData, m interface{}) error {
+func readCoreVolumeBackupPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeBackupPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreVolumeBackupPolicy(d *schema.ResourceData, m interface{}) error {
+func updateCoreVolumeBackupPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeBackupPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreVolumeBackupPolicy(d *schema.ResourceData, m interface{}) error {
+func deleteCoreVolumeBackupPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeBackupPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreVolumeBackupPolicyResourceCrud struct {
@@ -170,7 +171,7 @@ func (s *CoreVolumeBackupPolicyResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *CoreVolumeBackupPolicyResourceCrud) Create() error {
+func (s *CoreVolumeBackupPolicyResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateVolumeBackupPolicyRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -220,7 +221,7 @@ func (s *CoreVolumeBackupPolicyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVolumeBackupPolicy(context.Background(), request)
+	response, err := s.Client.CreateVolumeBackupPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -229,7 +230,7 @@ func (s *CoreVolumeBackupPolicyResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreVolumeBackupPolicyResourceCrud) Get() error {
+func (s *CoreVolumeBackupPolicyResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVolumeBackupPolicyRequest{}
 
 	tmp := s.D.Id()
@@ -237,7 +238,7 @@ func (s *CoreVolumeBackupPolicyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVolumeBackupPolicy(context.Background(), request)
+	response, err := s.Client.GetVolumeBackupPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -246,7 +247,7 @@ func (s *CoreVolumeBackupPolicyResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreVolumeBackupPolicyResourceCrud) Update() error {
+func (s *CoreVolumeBackupPolicyResourceCrud) Update(ctx context.Context) error {
 	request := oci_core.UpdateVolumeBackupPolicyRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -294,7 +295,7 @@ func (s *CoreVolumeBackupPolicyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateVolumeBackupPolicy(context.Background(), request)
+	response, err := s.Client.UpdateVolumeBackupPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -303,7 +304,7 @@ func (s *CoreVolumeBackupPolicyResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreVolumeBackupPolicyResourceCrud) Delete() error {
+func (s *CoreVolumeBackupPolicyResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteVolumeBackupPolicyRequest{}
 
 	tmp := s.D.Id()
@@ -311,7 +312,7 @@ func (s *CoreVolumeBackupPolicyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVolumeBackupPolicy(context.Background(), request)
+	_, err := s.Client.DeleteVolumeBackupPolicy(ctx, request)
 	return err
 }
 
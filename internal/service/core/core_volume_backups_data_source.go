@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -16,7 +17,7 @@ import (
 
 func CoreVolumeBackupsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreVolumeBackups,
+		ReadContext: readCoreVolumeBackups,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,12 +49,12 @@ func CoreVolumeBackupsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreVolumeBackups(d *schema.ResourceData, m interface{}) error {
+func readCoreVolumeBackups(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeBackupsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreVolumeBackupsDataSourceCrud struct {
@@ -66,7 +67,7 @@ func (s *CoreVolumeBackupsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVolumeBackupsDataSourceCrud) Get() error {
+func (s *CoreVolumeBackupsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListVolumeBackupsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -95,7 +96,7 @@ func (s *CoreVolumeBackupsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListVolumeBackups(context.Background(), request)
+	response, err := s.Client.ListVolumeBackups(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -104,7 +105,7 @@ func (s *CoreVolumeBackupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVolumeBackups(context.Background(), request)
+		listResponse, err := s.Client.ListVolumeBackups(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -23,12 +24,12 @@ func CoreVolumeDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(CoreVolumeResource(), fieldMap, readSingularCoreVolume)
 }
 
-func readSingularCoreVolume(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreVolume(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreVolumeDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *CoreVolumeDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVolumeDataSourceCrud) Get() error {
+func (s *CoreVolumeDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVolumeRequest{}
 
 	if volumeId, ok := s.D.GetOkExists("volume_id"); ok {
@@ -51,7 +52,7 @@ func (s *CoreVolumeDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetVolume(context.Background(), request)
+	response, err := s.Client.GetVolume(ctx, request)
 	if err != nil {
 		return err
 	}
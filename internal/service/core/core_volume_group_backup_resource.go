@@ -9,6 +9,7 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -22,11 +23,11 @@ func CoreVolumeGroupBackupResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreVolumeGroupBackup,
-		Read:     readCoreVolumeGroupBackup,
-		Update:   updateCoreVolumeGroupBackup,
-		Delete:   deleteCoreVolumeGroupBackup,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreVolumeGroupBackup,
+		ReadContext:   readCoreVolumeGroupBackup,
+		UpdateContext: updateCoreVolumeGroupBackup,
+		DeleteContext: deleteCoreVolumeGroupBackup,
 		Schema: map[string]*schema.Schema{
 			"volume_group_id": {
 				Type:          schema.TypeString,
@@ -149,7 +150,7 @@ func CoreVolumeGroupBackupResource() *schema.Resource {
 	}
 }
 
-func createCoreVolumeGroupBackup(d *schema.ResourceData, m interface{}) error {
+func createCoreVolumeGroupBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeGroupBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
@@ -158,18 +159,18 @@ func createCoreVolumeGroupBackup(d *schema.ResourceData, m interface{}) error {
 	// Remove custom code after issue is fixed.
 	compartment, ok := sync.D.GetOkExists("compartment_id")
 
-	err := tfresource.CreateResource(d, sync)
+	err := tfresource.CreateResource(ctx, d, sync)
 	if err != nil {
-		return err
+		return tfresource.HandleDiagError(err)
 	}
 	if ok && compartment != *sync.Res.CompartmentId {
-		err = sync.updateCompartment(compartment)
+		err = sync.updateCompartment(ctx, compartment)
 		if err != nil {
-			return err
+			return tfresource.HandleDiagError(err)
 		}
 		tmp := compartment.(string)
 		sync.Res.CompartmentId = &tmp
-		err := sync.Get()
+		err := sync.Get(ctx)
 		if err != nil {
 			log.Printf("error doing a Get() after compartment Update: %v", err)
 		}
@@ -181,29 +182,29 @@ func createCoreVolumeGroupBackup(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func readCoreVolumeGroupBackup(d *schema.ResourceData, m interface{}) error {
+func readCoreVolumeGroupBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeGroupBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreVolumeGroupBackup(d *schema.ResourceData, m interface{}) error {
+func updateCoreVolumeGroupBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeGroupBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreVolumeGroupBackup(d *schema.ResourceData, m interface{}) error {
+func deleteCoreVolumeGroupBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeGroupBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreVolumeGroupBackupResourceCrud struct {
@@ -244,22 +245,22 @@ func (s *CoreVolumeGroupBackupResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreVolumeGroupBackupResourceCrud) Create() error {
+func (s *CoreVolumeGroupBackupResourceCrud) Create(ctx context.Context) error {
 	if s.isCopyCreate() {
-		err := s.createVolumeGroupBackupCopy()
+		err := s.createVolumeGroupBackupCopy(ctx)
 		if err != nil {
 			return err
 		}
 		s.D.SetId(*s.Res.Id)
-		err = tfresource.WaitForResourceCondition(s, func() bool { return s.Res.LifecycleState == oci_core.VolumeGroupBackupLifecycleStateAvailable }, s.D.Timeout(schema.TimeoutCreate))
+		err = tfresource.WaitForResourceCondition(ctx, s, func() bool { return s.Res.LifecycleState == oci_core.VolumeGroupBackupLifecycleStateAvailable }, s.D.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
 		// Update for some fields that can't be created by copy
-		return s.Update()
+		return s.Update(ctx)
 	}
 
-	return s.CreateVolumeGroupBackup()
+	return s.CreateVolumeGroupBackup(ctx)
 }
 
 func (s *CoreVolumeGroupBackupResourceCrud) isCopyCreate() bool {
@@ -271,7 +272,7 @@ func (s *CoreVolumeGroupBackupResourceCrud) isCopyCreate() bool {
 	return false
 }
 
-func (s *CoreVolumeGroupBackupResourceCrud) CreateVolumeGroupBackup() error {
+func (s *CoreVolumeGroupBackupResourceCrud) CreateVolumeGroupBackup(ctx context.Context) error {
 	request := oci_core.CreateVolumeGroupBackupRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -307,7 +308,7 @@ func (s *CoreVolumeGroupBackupResourceCrud) CreateVolumeGroupBackup() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVolumeGroupBackup(context.Background(), request)
+	response, err := s.Client.CreateVolumeGroupBackup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -316,7 +317,7 @@ func (s *CoreVolumeGroupBackupResourceCrud) CreateVolumeGroupBackup() error {
 	return nil
 }
 
-func (s *CoreVolumeGroupBackupResourceCrud) createVolumeGroupBackupCopy() error {
+func (s *CoreVolumeGroupBackupResourceCrud) createVolumeGroupBackupCopy(ctx context.Context) error {
 	copyVolumeGroupBackupRequest := oci_core.CopyVolumeGroupBackupRequest{}
 
 	configProvider := *s.Client.ConfigurationProvider()
@@ -357,7 +358,7 @@ func (s *CoreVolumeGroupBackupResourceCrud) createVolumeGroupBackupCopy() error
 		copyVolumeGroupBackupRequest.DisplayName = &tmp
 	}
 
-	response, err := s.SourceRegionClient.CopyVolumeGroupBackup(context.Background(), copyVolumeGroupBackupRequest)
+	response, err := s.SourceRegionClient.CopyVolumeGroupBackup(ctx, copyVolumeGroupBackupRequest)
 	if err != nil {
 		return err
 	}
@@ -366,7 +367,7 @@ func (s *CoreVolumeGroupBackupResourceCrud) createVolumeGroupBackupCopy() error
 	return nil
 }
 
-func (s *CoreVolumeGroupBackupResourceCrud) Get() error {
+func (s *CoreVolumeGroupBackupResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVolumeGroupBackupRequest{}
 
 	tmp := s.D.Id()
@@ -374,7 +375,7 @@ func (s *CoreVolumeGroupBackupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVolumeGroupBackup(context.Background(), request)
+	response, err := s.Client.GetVolumeGroupBackup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -383,11 +384,11 @@ func (s *CoreVolumeGroupBackupResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreVolumeGroupBackupResourceCrud) Update() error {
+func (s *CoreVolumeGroupBackupResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -427,7 +428,7 @@ func (s *CoreVolumeGroupBackupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateVolumeGroupBackup(context.Background(), request)
+	response, err := s.Client.UpdateVolumeGroupBackup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -436,7 +437,7 @@ func (s *CoreVolumeGroupBackupResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreVolumeGroupBackupResourceCrud) Delete() error {
+func (s *CoreVolumeGroupBackupResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteVolumeGroupBackupRequest{}
 
 	tmp := s.D.Id()
@@ -444,7 +445,7 @@ func (s *CoreVolumeGroupBackupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVolumeGroupBackup(context.Background(), request)
+	_, err := s.Client.DeleteVolumeGroupBackup(ctx, request)
 	return err
 }
 
@@ -510,7 +511,7 @@ func (s *CoreVolumeGroupBackupResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CoreVolumeGroupBackupResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreVolumeGroupBackupResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeVolumeGroupBackupCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -521,12 +522,12 @@ func (s *CoreVolumeGroupBackupResourceCrud) updateCompartment(compartment interf
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeVolumeGroupBackupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeVolumeGroupBackupCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -16,7 +17,7 @@ import (
 
 func CoreVolumeGroupBackupsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreVolumeGroupBackups,
+		ReadContext: readCoreVolumeGroupBackups,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -40,12 +41,12 @@ func CoreVolumeGroupBackupsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreVolumeGroupBackups(d *schema.ResourceData, m interface{}) error {
+func readCoreVolumeGroupBackups(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeGroupBackupsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreVolumeGroupBackupsDataSourceCrud struct {
@@ -58,7 +59,7 @@ func (s *CoreVolumeGroupBackupsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVolumeGroupBackupsDataSourceCrud) Get() error {
+func (s *CoreVolumeGroupBackupsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListVolumeGroupBackupsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -78,7 +79,7 @@ func (s *CoreVolumeGroupBackupsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListVolumeGroupBackups(context.Background(), request)
+	response, err := s.Client.ListVolumeGroupBackups(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -87,7 +88,7 @@ func (s *CoreVolumeGroupBackupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVolumeGroupBackups(context.Background(), request)
+		listResponse, err := s.Client.ListVolumeGroupBackups(ctx, request)
 		if err != nil {
 			return err
 		}
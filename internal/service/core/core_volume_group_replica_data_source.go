@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -16,7 +17,7 @@ import (
 
 func CoreVolumeGroupReplicaDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularCoreVolumeGroupReplica,
+		ReadContext: readSingularCoreVolumeGroupReplica,
 		Schema: map[string]*schema.Schema{
 			"volume_group_replica_id": {
 				Type:     schema.TypeString,
@@ -90,12 +91,12 @@ func CoreVolumeGroupReplicaDataSource() *schema.Resource {
 	}
 }
 
-func readSingularCoreVolumeGroupReplica(d *schema.ResourceData, m interface{}) error {
+func readSingularCoreVolumeGroupReplica(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeGroupReplicaDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreVolumeGroupReplicaDataSourceCrud struct {
@@ -108,7 +109,7 @@ func (s *CoreVolumeGroupReplicaDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVolumeGroupReplicaDataSourceCrud) Get() error {
+func (s *CoreVolumeGroupReplicaDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVolumeGroupReplicaRequest{}
 
 	if volumeGroupReplicaId, ok := s.D.GetOkExists("volume_group_replica_id"); ok {
@@ -118,7 +119,7 @@ func (s *CoreVolumeGroupReplicaDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.GetVolumeGroupReplica(context.Background(), request)
+	response, err := s.Client.GetVolumeGroupReplica(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -16,7 +17,7 @@ import (
 
 func CoreVolumeGroupReplicasDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreVolumeGroupReplicas,
+		ReadContext: readCoreVolumeGroupReplicas,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -115,12 +116,12 @@ func CoreVolumeGroupReplicasDataSource() *schema.Resource {
 	}
 }
 
-func readCoreVolumeGroupReplicas(d *schema.ResourceData, m interface{}) error {
+func readCoreVolumeGroupReplicas(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeGroupReplicasDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreVolumeGroupReplicasDataSourceCrud struct {
@@ -133,7 +134,7 @@ func (s *CoreVolumeGroupReplicasDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVolumeGroupReplicasDataSourceCrud) Get() error {
+func (s *CoreVolumeGroupReplicasDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListVolumeGroupReplicasRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -157,7 +158,7 @@ func (s *CoreVolumeGroupReplicasDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListVolumeGroupReplicas(context.Background(), request)
+	response, err := s.Client.ListVolumeGroupReplicas(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -166,7 +167,7 @@ func (s *CoreVolumeGroupReplicasDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVolumeGroupReplicas(context.Background(), request)
+		listResponse, err := s.Client.ListVolumeGroupReplicas(ctx, request)
 		if err != nil {
 			return err
 		}
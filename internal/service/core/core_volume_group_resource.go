@@ -13,6 +13,7 @@ import (
 	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -24,11 +25,11 @@ func CoreVolumeGroupResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreVolumeGroup,
-		Read:     readCoreVolumeGroup,
-		Update:   updateCoreVolumeGroup,
-		Delete:   deleteCoreVolumeGroup,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreVolumeGroup,
+		ReadContext:   readCoreVolumeGroup,
+		UpdateContext: updateCoreVolumeGroup,
+		DeleteContext: deleteCoreVolumeGroup,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"availability_domain": {
@@ -189,37 +190,37 @@ func CoreVolumeGroupResource() *schema.Resource {
 	}
 }
 
-func createCoreVolumeGroup(d *schema.ResourceData, m interface{}) error {
+func createCoreVolumeGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*tf_client.OracleClients).BlockstorageClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreVolumeGroup(d *schema.ResourceData, m interface{}) error {
+func readCoreVolumeGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*tf_client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreVolumeGroup(d *schema.ResourceData, m interface{}) error {
+func updateCoreVolumeGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*tf_client.OracleClients).BlockstorageClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreVolumeGroup(d *schema.ResourceData, m interface{}) error {
+func deleteCoreVolumeGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*tf_client.OracleClients).BlockstorageClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreVolumeGroupResourceCrud struct {
@@ -257,7 +258,7 @@ func (s *CoreVolumeGroupResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreVolumeGroupResourceCrud) Create() error {
+func (s *CoreVolumeGroupResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateVolumeGroupRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -322,7 +323,7 @@ func (s *CoreVolumeGroupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVolumeGroup(context.Background(), request)
+	response, err := s.Client.CreateVolumeGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -332,7 +333,7 @@ func (s *CoreVolumeGroupResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreVolumeGroupResourceCrud) Get() error {
+func (s *CoreVolumeGroupResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVolumeGroupRequest{}
 
 	tmp := s.D.Id()
@@ -340,7 +341,7 @@ func (s *CoreVolumeGroupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVolumeGroup(context.Background(), request)
+	response, err := s.Client.GetVolumeGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -349,11 +350,11 @@ func (s *CoreVolumeGroupResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreVolumeGroupResourceCrud) Update() error {
+func (s *CoreVolumeGroupResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -428,7 +429,7 @@ func (s *CoreVolumeGroupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateVolumeGroup(context.Background(), request)
+	response, err := s.Client.UpdateVolumeGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -437,7 +438,7 @@ func (s *CoreVolumeGroupResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreVolumeGroupResourceCrud) Delete() error {
+func (s *CoreVolumeGroupResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteVolumeGroupRequest{}
 
 	tmp := s.D.Id()
@@ -445,7 +446,7 @@ func (s *CoreVolumeGroupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVolumeGroup(context.Background(), request)
+	_, err := s.Client.DeleteVolumeGroup(ctx, request)
 	return err
 }
 
@@ -636,7 +637,7 @@ func VolumeGroupSourceDetailsToMap(obj *oci_core.VolumeGroupSourceDetails, datas
 	return result
 }
 
-func (s *CoreVolumeGroupResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreVolumeGroupResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeVolumeGroupCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -647,12 +648,12 @@ func (s *CoreVolumeGroupResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeVolumeGroupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeVolumeGroupCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
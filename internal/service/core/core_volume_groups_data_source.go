@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -16,7 +17,7 @@ import (
 
 func CoreVolumeGroupsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreVolumeGroups,
+		ReadContext: readCoreVolumeGroups,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -44,12 +45,12 @@ func CoreVolumeGroupsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreVolumeGroups(d *schema.ResourceData, m interface{}) error {
+func readCoreVolumeGroups(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeGroupsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreVolumeGroupsDataSourceCrud struct {
@@ -62,7 +63,7 @@ func (s *CoreVolumeGroupsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVolumeGroupsDataSourceCrud) Get() error {
+func (s *CoreVolumeGroupsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListVolumeGroupsRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -86,7 +87,7 @@ func (s *CoreVolumeGroupsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListVolumeGroups(context.Background(), request)
+	response, err := s.Client.ListVolumeGroups(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +96,7 @@ func (s *CoreVolumeGroupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVolumeGroups(context.Background(), request)
+		listResponse, err := s.Client.ListVolumeGroups(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -24,11 +25,11 @@ func CoreVolumeResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreVolume,
-		Read:     readCoreVolume,
-		Update:   updateCoreVolume,
-		Delete:   deleteCoreVolume,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreVolume,
+		ReadContext:   readCoreVolume,
+		UpdateContext: updateCoreVolume,
+		DeleteContext: deleteCoreVolume,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"availability_domain": {
@@ -233,37 +234,37 @@ func CoreVolumeResource() *schema.Resource {
 	}
 }
 
-func createCoreVolume(d *schema.ResourceData, m interface{}) error {
+func createCoreVolume(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreVolume(d *schema.ResourceData, m interface{}) error {
+func readCoreVolume(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreVolume(d *schema.ResourceData, m interface{}) error {
+func updateCoreVolume(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreVolume(d *schema.ResourceData, m interface{}) error {
+func deleteCoreVolume(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreVolumeResourceCrud struct {
@@ -314,7 +315,7 @@ func (s *CoreVolumeResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *CoreVolumeResourceCrud) Create() error {
+func (s *CoreVolumeResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateVolumeRequest{}
 
 	if autotunePolicies, ok := s.D.GetOkExists("autotune_policies"); ok {
@@ -443,7 +444,7 @@ func (s *CoreVolumeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVolume(context.Background(), request)
+	response, err := s.Client.CreateVolume(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -452,7 +453,7 @@ func (s *CoreVolumeResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreVolumeResourceCrud) Get() error {
+func (s *CoreVolumeResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVolumeRequest{}
 
 	tmp := s.D.Id()
@@ -460,7 +461,7 @@ func (s *CoreVolumeResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVolume(context.Background(), request)
+	response, err := s.Client.GetVolume(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -469,11 +470,11 @@ func (s *CoreVolumeResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreVolumeResourceCrud) Update() error {
+func (s *CoreVolumeResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -555,7 +556,7 @@ func (s *CoreVolumeResourceCrud) Update() error {
 
 		keyUpdateRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-		_, err := s.Client.UpdateVolumeKmsKey(context.Background(), keyUpdateRequest)
+		_, err := s.Client.UpdateVolumeKmsKey(ctx, keyUpdateRequest)
 		if err != nil {
 			return err
 		}
@@ -584,7 +585,7 @@ func (s *CoreVolumeResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.UpdateVolume(context.Background(), request)
+	response, err := s.Client.UpdateVolume(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -593,7 +594,7 @@ func (s *CoreVolumeResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreVolumeResourceCrud) Delete() error {
+func (s *CoreVolumeResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteVolumeRequest{}
 
 	tmp := s.D.Id()
@@ -601,7 +602,7 @@ func (s *CoreVolumeResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVolume(context.Background(), request)
+	_, err := s.Client.DeleteVolume(ctx, request)
 	return err
 }
 
@@ -689,7 +690,7 @@ func (s *CoreVolumeResourceCrud) SetData() error {
 	}
 
 	// Add backup policy id from the other API
-	backupPolicyId, err := getBackupPolicyId(s.Res.Id, s.Client)
+	backupPolicyId, err := getBackupPolicyId(context.Background(), s.Res.Id, s.Client)
 	if err != nil {
 		log.Printf("[ERROR] Received an error when fetching backup policy id %v", err)
 	} else if backupPolicyId != nil {
@@ -849,7 +850,7 @@ func VolumeSourceDetailsToMap(obj *oci_core.VolumeSourceDetails) map[string]inte
 	return result
 }
 
-func (s *CoreVolumeResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreVolumeResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeVolumeCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -860,12 +861,12 @@ func (s *CoreVolumeResourceCrud) updateCompartment(compartment interface{}) erro
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.ChangeVolumeCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeVolumeCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
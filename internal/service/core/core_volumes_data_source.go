@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -16,7 +17,7 @@ import (
 
 func CoreVolumesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreVolumes,
+		ReadContext: readCoreVolumes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -48,12 +49,12 @@ func CoreVolumesDataSource() *schema.Resource {
 	}
 }
 
-func readCoreVolumes(d *schema.ResourceData, m interface{}) error {
+func readCoreVolumes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVolumesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BlockstorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreVolumesDataSourceCrud struct {
@@ -66,7 +67,7 @@ func (s *CoreVolumesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVolumesDataSourceCrud) Get() error {
+func (s *CoreVolumesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListVolumesRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -95,7 +96,7 @@ func (s *CoreVolumesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListVolumes(context.Background(), request)
+	response, err := s.Client.ListVolumes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -104,7 +105,7 @@ func (s *CoreVolumesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVolumes(context.Background(), request)
+		listResponse, err := s.Client.ListVolumes(ctx, request)
 		if err != nil {
 			return err
 		}
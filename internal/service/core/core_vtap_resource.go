@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
@@ -22,11 +23,11 @@ func CoreVtapResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreVtap,
-		Read:     readCoreVtap,
-		Update:   updateCoreVtap,
-		Delete:   deleteCoreVtap,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createCoreVtap,
+		ReadContext:   readCoreVtap,
+		UpdateContext: updateCoreVtap,
+		DeleteContext: deleteCoreVtap,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"capture_filter_id": {
@@ -141,40 +142,40 @@ func CoreVtapResource() *schema.Resource {
 	}
 }
 
-func createCoreVtap(d *schema.ResourceData, m interface{}) error {
+func createCoreVtap(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVtapResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readCoreVtap(d *schema.ResourceData, m interface{}) error {
+func readCoreVtap(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVtapResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateCoreVtap(d *schema.ResourceData, m interface{}) error {
+func updateCoreVtap(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVtapResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteCoreVtap(d *schema.ResourceData, m interface{}) error {
+func deleteCoreVtap(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVtapResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type CoreVtapResourceCrud struct {
@@ -213,7 +214,7 @@ func (s *CoreVtapResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *CoreVtapResourceCrud) Create() error {
+func (s *CoreVtapResourceCrud) Create(ctx context.Context) error {
 	request := oci_core.CreateVtapRequest{}
 
 	if captureFilterId, ok := s.D.GetOkExists("capture_filter_id"); ok {
@@ -310,7 +311,7 @@ func (s *CoreVtapResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.CreateVtap(context.Background(), request)
+	response, err := s.Client.CreateVtap(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -319,7 +320,7 @@ func (s *CoreVtapResourceCrud) Create() error {
 	return nil
 }
 
-func (s *CoreVtapResourceCrud) Get() error {
+func (s *CoreVtapResourceCrud) Get(ctx context.Context) error {
 	request := oci_core.GetVtapRequest{}
 
 	tmp := s.D.Id()
@@ -327,7 +328,7 @@ func (s *CoreVtapResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.GetVtap(context.Background(), request)
+	response, err := s.Client.GetVtap(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -336,11 +337,11 @@ func (s *CoreVtapResourceCrud) Get() error {
 	return nil
 }
 
-func (s *CoreVtapResourceCrud) Update() error {
+func (s *CoreVtapResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -435,15 +436,15 @@ func (s *CoreVtapResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.UpdateVtap(context.Background(), request)
+	_, err := s.Client.UpdateVtap(ctx, request)
 	if err != nil {
 		return err
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *CoreVtapResourceCrud) Delete() error {
+func (s *CoreVtapResourceCrud) Delete(ctx context.Context) error {
 	request := oci_core.DeleteVtapRequest{}
 
 	tmp := s.D.Id()
@@ -451,7 +452,7 @@ func (s *CoreVtapResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	_, err := s.Client.DeleteVtap(context.Background(), request)
+	_, err := s.Client.DeleteVtap(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -533,7 +534,7 @@ func (s *CoreVtapResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *CoreVtapResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *CoreVtapResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeVtapCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -544,14 +545,14 @@ func (s *CoreVtapResourceCrud) updateCompartment(compartment interface{}) error
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 
-	response, err := s.Client.ChangeVtapCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeVtapCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "vtap", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "vtap", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
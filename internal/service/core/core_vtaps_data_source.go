@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 
@@ -16,7 +17,7 @@ import (
 
 func CoreVtapsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readCoreVtaps,
+		ReadContext: readCoreVtaps,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -60,12 +61,12 @@ func CoreVtapsDataSource() *schema.Resource {
 	}
 }
 
-func readCoreVtaps(d *schema.ResourceData, m interface{}) error {
+func readCoreVtaps(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &CoreVtapsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type CoreVtapsDataSourceCrud struct {
@@ -78,7 +79,7 @@ func (s *CoreVtapsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *CoreVtapsDataSourceCrud) Get() error {
+func (s *CoreVtapsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_core.ListVtapsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -122,7 +123,7 @@ func (s *CoreVtapsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := s.Client.ListVtaps(context.Background(), request)
+	response, err := s.Client.ListVtaps(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -131,7 +132,7 @@ func (s *CoreVtapsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVtaps(context.Background(), request)
+		listResponse, err := s.Client.ListVtaps(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -71,12 +71,12 @@ func LaunchOptionsToMap(obj *oci_core.LaunchOptions) map[string]interface{} {
 	return result
 }
 
-func getBackupPolicyId(assetId *string, client *oci_core.BlockstorageClient) (*string, error) {
+func getBackupPolicyId(ctx context.Context, assetId *string, client *oci_core.BlockstorageClient) (*string, error) {
 	request := oci_core.GetVolumeBackupPolicyAssetAssignmentRequest{}
 	request.AssetId = assetId
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
 
-	response, err := client.GetVolumeBackupPolicyAssetAssignment(context.Background(), request)
+	response, err := client.GetVolumeBackupPolicyAssetAssignment(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -6,6 +6,7 @@ package data_connectivity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_connectivity "github.com/oracle/oci-go-sdk/v65/dataconnectivity"
 
@@ -15,7 +16,7 @@ import (
 
 func DataConnectivityRegistriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataConnectivityRegistries,
+		ReadContext: readDataConnectivityRegistries,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -52,12 +53,12 @@ func DataConnectivityRegistriesDataSource() *schema.Resource {
 	}
 }
 
-func readDataConnectivityRegistries(d *schema.ResourceData, m interface{}) error {
+func readDataConnectivityRegistries(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataConnectivityRegistriesDataSourceCrud struct {
@@ -70,7 +71,7 @@ func (s *DataConnectivityRegistriesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataConnectivityRegistriesDataSourceCrud) Get() error {
+func (s *DataConnectivityRegistriesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_connectivity.ListRegistriesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -94,7 +95,7 @@ func (s *DataConnectivityRegistriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_connectivity")
 
-	response, err := s.Client.ListRegistries(context.Background(), request)
+	response, err := s.Client.ListRegistries(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *DataConnectivityRegistriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRegistries(context.Background(), request)
+		listResponse, err := s.Client.ListRegistries(ctx, request)
 		if err != nil {
 			return err
 		}
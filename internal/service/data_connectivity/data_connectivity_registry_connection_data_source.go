@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_connectivity "github.com/oracle/oci-go-sdk/v65/dataconnectivity"
 )
@@ -26,12 +27,12 @@ func DataConnectivityRegistryConnectionDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DataConnectivityRegistryConnectionResource(), fieldMap, readSingularDataConnectivityRegistryConnection)
 }
 
-func readSingularDataConnectivityRegistryConnection(d *schema.ResourceData, m interface{}) error {
+func readSingularDataConnectivityRegistryConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryConnectionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataConnectivityRegistryConnectionDataSourceCrud struct {
@@ -44,7 +45,7 @@ func (s *DataConnectivityRegistryConnectionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataConnectivityRegistryConnectionDataSourceCrud) Get() error {
+func (s *DataConnectivityRegistryConnectionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_connectivity.GetConnectionRequest{}
 
 	if connectionKey, ok := s.D.GetOkExists("connection_key"); ok {
@@ -59,7 +60,7 @@ func (s *DataConnectivityRegistryConnectionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_connectivity")
 
-	response, err := s.Client.GetConnection(context.Background(), request)
+	response, err := s.Client.GetConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
@@ -26,11 +27,11 @@ func DataConnectivityRegistryConnectionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataConnectivityRegistryConnection,
-		Read:     readDataConnectivityRegistryConnection,
-		Update:   updateDataConnectivityRegistryConnection,
-		Delete:   deleteDataConnectivityRegistryConnection,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataConnectivityRegistryConnection,
+		ReadContext:   readDataConnectivityRegistryConnection,
+		UpdateContext: updateDataConnectivityRegistryConnection,
+		DeleteContext: deleteDataConnectivityRegistryConnection,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"identifier": {
@@ -537,37 +538,37 @@ func DataConnectivityRegistryConnectionResource() *schema.Resource {
 	}
 }
 
-func createDataConnectivityRegistryConnection(d *schema.ResourceData, m interface{}) error {
+func createDataConnectivityRegistryConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDataConnectivityRegistryConnection(d *schema.ResourceData, m interface{}) error {
+func readDataConnectivityRegistryConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDataConnectivityRegistryConnection(d *schema.ResourceData, m interface{}) error {
+func updateDataConnectivityRegistryConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDataConnectivityRegistryConnection(d *schema.ResourceData, m interface{}) error {
+func deleteDataConnectivityRegistryConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DataConnectivityRegistryConnectionResourceCrud struct {
@@ -581,7 +582,7 @@ func (s *DataConnectivityRegistryConnectionResourceCrud) ID() string {
 	return GetRegistryConnectionCompositeId(*(*s.Res).Key, s.D.Get("registry_id").(string))
 }
 
-func (s *DataConnectivityRegistryConnectionResourceCrud) Create() error {
+func (s *DataConnectivityRegistryConnectionResourceCrud) Create(ctx context.Context) error {
 	request := oci_data_connectivity.CreateConnectionRequest{}
 
 	if connectionProperties, ok := s.D.GetOkExists("connection_properties"); ok {
@@ -699,7 +700,7 @@ func (s *DataConnectivityRegistryConnectionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
 
-	response, err := s.Client.CreateConnection(context.Background(), request)
+	response, err := s.Client.CreateConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -708,7 +709,7 @@ func (s *DataConnectivityRegistryConnectionResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DataConnectivityRegistryConnectionResourceCrud) Get() error {
+func (s *DataConnectivityRegistryConnectionResourceCrud) Get(ctx context.Context) error {
 	request := oci_data_connectivity.GetConnectionRequest{}
 
 	if connectionKey, ok := s.D.GetOkExists("key"); ok {
@@ -731,7 +732,7 @@ func (s *DataConnectivityRegistryConnectionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
 
-	response, err := s.Client.GetConnection(context.Background(), request)
+	response, err := s.Client.GetConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -740,7 +741,7 @@ func (s *DataConnectivityRegistryConnectionResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DataConnectivityRegistryConnectionResourceCrud) Update() error {
+func (s *DataConnectivityRegistryConnectionResourceCrud) Update(ctx context.Context) error {
 	request := oci_data_connectivity.UpdateConnectionRequest{}
 
 	if connectionKey, ok := s.D.GetOkExists("key"); ok {
@@ -858,7 +859,7 @@ func (s *DataConnectivityRegistryConnectionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
 
-	response, err := s.Client.UpdateConnection(context.Background(), request)
+	response, err := s.Client.UpdateConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -867,7 +868,7 @@ func (s *DataConnectivityRegistryConnectionResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DataConnectivityRegistryConnectionResourceCrud) Delete() error {
+func (s *DataConnectivityRegistryConnectionResourceCrud) Delete(ctx context.Context) error {
 	request := oci_data_connectivity.DeleteConnectionRequest{}
 
 	if connectionKey, ok := s.D.GetOkExists("key"); ok {
@@ -882,7 +883,7 @@ func (s *DataConnectivityRegistryConnectionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
 
-	_, err := s.Client.DeleteConnection(context.Background(), request)
+	_, err := s.Client.DeleteConnection(ctx, request)
 	return err
 }
 
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_connectivity "github.com/oracle/oci-go-sdk/v65/dataconnectivity"
 )
 
 func DataConnectivityRegistryConnectionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataConnectivityRegistryConnections,
+		ReadContext: readDataConnectivityRegistryConnections,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"data_asset_key": {
@@ -63,12 +64,12 @@ func DataConnectivityRegistryConnectionsDataSource() *schema.Resource {
 	}
 }
 
-func readDataConnectivityRegistryConnections(d *schema.ResourceData, m interface{}) error {
+func readDataConnectivityRegistryConnections(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryConnectionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataConnectivityRegistryConnectionsDataSourceCrud struct {
@@ -81,7 +82,7 @@ func (s *DataConnectivityRegistryConnectionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataConnectivityRegistryConnectionsDataSourceCrud) Get() error {
+func (s *DataConnectivityRegistryConnectionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_connectivity.ListConnectionsRequest{}
 
 	if dataAssetKey, ok := s.D.GetOkExists("data_asset_key"); ok {
@@ -123,7 +124,7 @@ func (s *DataConnectivityRegistryConnectionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_connectivity")
 
-	response, err := s.Client.ListConnections(context.Background(), request)
+	response, err := s.Client.ListConnections(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -132,7 +133,7 @@ func (s *DataConnectivityRegistryConnectionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListConnections(context.Background(), request)
+		listResponse, err := s.Client.ListConnections(ctx, request)
 		if err != nil {
 			return err
 		}
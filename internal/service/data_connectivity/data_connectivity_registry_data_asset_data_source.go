@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_connectivity "github.com/oracle/oci-go-sdk/v65/dataconnectivity"
 )
@@ -27,12 +28,12 @@ func DataConnectivityRegistryDataAssetDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DataConnectivityRegistryDataAssetResource(), fieldMap, readSingularDataConnectivityRegistryDataAsset)
 }
 
-func readSingularDataConnectivityRegistryDataAsset(d *schema.ResourceData, m interface{}) error {
+func readSingularDataConnectivityRegistryDataAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryDataAssetDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataConnectivityRegistryDataAssetDataSourceCrud struct {
@@ -45,7 +46,7 @@ func (s *DataConnectivityRegistryDataAssetDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataConnectivityRegistryDataAssetDataSourceCrud) Get() error {
+func (s *DataConnectivityRegistryDataAssetDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_connectivity.GetDataAssetRequest{}
 
 	if dataAssetKey, ok := s.D.GetOkExists("data_asset_key"); ok {
@@ -60,7 +61,7 @@ func (s *DataConnectivityRegistryDataAssetDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_connectivity")
 
-	response, err := s.Client.GetDataAsset(context.Background(), request)
+	response, err := s.Client.GetDataAsset(ctx, request)
 	if err != nil {
 		return err
 	}
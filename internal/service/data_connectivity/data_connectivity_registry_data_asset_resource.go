@@ -16,6 +16,7 @@ import (
 
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
@@ -30,11 +31,11 @@ func DataConnectivityRegistryDataAssetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataConnectivityRegistryDataAsset,
-		Read:     readDataConnectivityRegistryDataAsset,
-		Update:   updateDataConnectivityRegistryDataAsset,
-		Delete:   deleteDataConnectivityRegistryDataAsset,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataConnectivityRegistryDataAsset,
+		ReadContext:   readDataConnectivityRegistryDataAsset,
+		UpdateContext: updateDataConnectivityRegistryDataAsset,
+		DeleteContext: deleteDataConnectivityRegistryDataAsset,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"identifier": {
@@ -1058,37 +1059,37 @@ func DataConnectivityRegistryDataAssetResource() *schema.Resource {
 	}
 }
 
-func createDataConnectivityRegistryDataAsset(d *schema.ResourceData, m interface{}) error {
+func createDataConnectivityRegistryDataAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryDataAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDataConnectivityRegistryDataAsset(d *schema.ResourceData, m interface{}) error {
+func readDataConnectivityRegistryDataAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryDataAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDataConnectivityRegistryDataAsset(d *schema.ResourceData, m interface{}) error {
+func updateDataConnectivityRegistryDataAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryDataAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDataConnectivityRegistryDataAsset(d *schema.ResourceData, m interface{}) error {
+func deleteDataConnectivityRegistryDataAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryDataAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DataConnectivityRegistryDataAssetResourceCrud struct {
@@ -1102,7 +1103,7 @@ func (s *DataConnectivityRegistryDataAssetResourceCrud) ID() string {
 	return GetRegistryDataAssetCompositeId(*(*s.Res).Key, s.D.Get("registry_id").(string))
 }
 
-func (s *DataConnectivityRegistryDataAssetResourceCrud) Create() error {
+func (s *DataConnectivityRegistryDataAssetResourceCrud) Create(ctx context.Context) error {
 	request := oci_data_connectivity.CreateDataAssetRequest{}
 
 	if assetProperties, ok := s.D.GetOkExists("asset_properties"); ok {
@@ -1228,7 +1229,7 @@ func (s *DataConnectivityRegistryDataAssetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
 
-	response, err := s.Client.CreateDataAsset(context.Background(), request)
+	response, err := s.Client.CreateDataAsset(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -1237,7 +1238,7 @@ func (s *DataConnectivityRegistryDataAssetResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DataConnectivityRegistryDataAssetResourceCrud) Get() error {
+func (s *DataConnectivityRegistryDataAssetResourceCrud) Get(ctx context.Context) error {
 	request := oci_data_connectivity.GetDataAssetRequest{}
 
 	if dataAssetKey, ok := s.D.GetOkExists("key"); ok {
@@ -1260,7 +1261,7 @@ func (s *DataConnectivityRegistryDataAssetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
 
-	response, err := s.Client.GetDataAsset(context.Background(), request)
+	response, err := s.Client.GetDataAsset(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -1269,7 +1270,7 @@ func (s *DataConnectivityRegistryDataAssetResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DataConnectivityRegistryDataAssetResourceCrud) Update() error {
+func (s *DataConnectivityRegistryDataAssetResourceCrud) Update(ctx context.Context) error {
 	request := oci_data_connectivity.UpdateDataAssetRequest{}
 	if assetProperties, ok := s.D.GetOkExists("asset_properties"); ok {
 		request.AssetProperties = tfresource.ObjectMapToStringMap(assetProperties.(map[string]interface{}))
@@ -1394,7 +1395,7 @@ func (s *DataConnectivityRegistryDataAssetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
 
-	response, err := s.Client.UpdateDataAsset(context.Background(), request)
+	response, err := s.Client.UpdateDataAsset(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -1403,7 +1404,7 @@ func (s *DataConnectivityRegistryDataAssetResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DataConnectivityRegistryDataAssetResourceCrud) Delete() error {
+func (s *DataConnectivityRegistryDataAssetResourceCrud) Delete(ctx context.Context) error {
 	request := oci_data_connectivity.DeleteDataAssetRequest{}
 
 	if dataAssetKey, ok := s.D.GetOkExists("key"); ok {
@@ -1418,7 +1419,7 @@ func (s *DataConnectivityRegistryDataAssetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
 
-	_, err := s.Client.DeleteDataAsset(context.Background(), request)
+	_, err := s.Client.DeleteDataAsset(ctx, request)
 	return err
 }
 
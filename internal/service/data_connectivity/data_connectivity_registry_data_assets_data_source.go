@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_connectivity "github.com/oracle/oci-go-sdk/v65/dataconnectivity"
 )
 
 func DataConnectivityRegistryDataAssetsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataConnectivityRegistryDataAssets,
+		ReadContext: readDataConnectivityRegistryDataAssets,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"endpoint_ids": {
@@ -91,12 +92,12 @@ func DataConnectivityRegistryDataAssetsDataSource() *schema.Resource {
 	}
 }
 
-func readDataConnectivityRegistryDataAssets(d *schema.ResourceData, m interface{}) error {
+func readDataConnectivityRegistryDataAssets(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryDataAssetsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataConnectivityRegistryDataAssetsDataSourceCrud struct {
@@ -109,7 +110,7 @@ func (s *DataConnectivityRegistryDataAssetsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataConnectivityRegistryDataAssetsDataSourceCrud) Get() error {
+func (s *DataConnectivityRegistryDataAssetsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_connectivity.ListDataAssetsRequest{}
 
 	if endpointIds, ok := s.D.GetOkExists("endpoint_ids"); ok {
@@ -198,7 +199,7 @@ func (s *DataConnectivityRegistryDataAssetsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_connectivity")
 
-	response, err := s.Client.ListDataAssets(context.Background(), request)
+	response, err := s.Client.ListDataAssets(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -207,7 +208,7 @@ func (s *DataConnectivityRegistryDataAssetsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDataAssets(context.Background(), request)
+		listResponse, err := s.Client.ListDataAssets(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_connectivity "github.com/oracle/oci-go-sdk/v65/dataconnectivity"
 )
@@ -22,12 +23,12 @@ func DataConnectivityRegistryDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DataConnectivityRegistryResource(), fieldMap, readSingularDataConnectivityRegistry)
 }
 
-func readSingularDataConnectivityRegistry(d *schema.ResourceData, m interface{}) error {
+func readSingularDataConnectivityRegistry(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataConnectivityRegistryDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DataConnectivityRegistryDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataConnectivityRegistryDataSourceCrud) Get() error {
+func (s *DataConnectivityRegistryDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_connectivity.GetRegistryRequest{}
 
 	if registryId, ok := s.D.GetOkExists("registry_id"); ok {
@@ -50,7 +51,7 @@ func (s *DataConnectivityRegistryDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_connectivity")
 
-	response, err := s.Client.GetRegistry(context.Background(), request)
+	response, err := s.Client.GetRegistry(ctx, request)
 	if err != nil {
 		return err
 	}
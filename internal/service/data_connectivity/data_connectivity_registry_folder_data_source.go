@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_connectivity "github.com/oracle/oci-go-sdk/v65/dataconnectivity"
 )
@@ -26,12 +27,12 @@ func DataConnectivityRegistryFolderDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DataConnectivityRegistryFolderResource(), fieldMap, readSingularDataConnectivityRegistryFolder)
 }
 
-func readSingularDataConnectivityRegistryFolder(d *schema.ResourceData, m interface{}) error {
+func readSingularDataConnectivityRegistryFolder(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryFolderDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataConnectivityRegistryFolderDataSourceCrud struct {
@@ -44,7 +45,7 @@ func (s *DataConnectivityRegistryFolderDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataConnectivityRegistryFolderDataSourceCrud) Get() error {
+func (s *DataConnectivityRegistryFolderDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_connectivity.GetFolderRequest{}
 
 	if folderKey, ok := s.D.GetOkExists("folder_key"); ok {
@@ -59,7 +60,7 @@ func (s *DataConnectivityRegistryFolderDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_connectivity")
 
-	response, err := s.Client.GetFolder(context.Background(), request)
+	response, err := s.Client.GetFolder(ctx, request)
 	if err != nil {
 		return err
 	}
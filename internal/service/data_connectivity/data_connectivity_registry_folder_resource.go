@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -24,11 +25,11 @@ func DataConnectivityRegistryFolderResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataConnectivityRegistryFolder,
-		Read:     readDataConnectivityRegistryFolder,
-		Update:   updateDataConnectivityRegistryFolder,
-		Delete:   deleteDataConnectivityRegistryFolder,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataConnectivityRegistryFolder,
+		ReadContext:   readDataConnectivityRegistryFolder,
+		UpdateContext: updateDataConnectivityRegistryFolder,
+		DeleteContext: deleteDataConnectivityRegistryFolder,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"identifier": {
@@ -1119,37 +1120,37 @@ func DataConnectivityRegistryFolderResource() *schema.Resource {
 	}
 }
 
-func createDataConnectivityRegistryFolder(d *schema.ResourceData, m interface{}) error {
+func createDataConnectivityRegistryFolder(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryFolderResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDataConnectivityRegistryFolder(d *schema.ResourceData, m interface{}) error {
+func readDataConnectivityRegistryFolder(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryFolderResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDataConnectivityRegistryFolder(d *schema.ResourceData, m interface{}) error {
+func updateDataConnectivityRegistryFolder(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryFolderResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDataConnectivityRegistryFolder(d *schema.ResourceData, m interface{}) error {
+func deleteDataConnectivityRegistryFolder(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryFolderResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DataConnectivityRegistryFolderResourceCrud struct {
@@ -1163,7 +1164,7 @@ func (s *DataConnectivityRegistryFolderResourceCrud) ID() string {
 	return GetRegistryFolderCompositeId(*(*s.Res).Key, s.D.Get("registry_id").(string))
 }
 
-func (s *DataConnectivityRegistryFolderResourceCrud) Create() error {
+func (s *DataConnectivityRegistryFolderResourceCrud) Create(ctx context.Context) error {
 	request := oci_data_connectivity.CreateFolderRequest{}
 
 	if description, ok := s.D.GetOkExists("description"); ok {
@@ -1224,7 +1225,7 @@ func (s *DataConnectivityRegistryFolderResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
 
-	response, err := s.Client.CreateFolder(context.Background(), request)
+	response, err := s.Client.CreateFolder(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -1233,7 +1234,7 @@ func (s *DataConnectivityRegistryFolderResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DataConnectivityRegistryFolderResourceCrud) Get() error {
+func (s *DataConnectivityRegistryFolderResourceCrud) Get(ctx context.Context) error {
 	request := oci_data_connectivity.GetFolderRequest{}
 
 	if folderKey, ok := s.D.GetOkExists("key"); ok {
@@ -1256,7 +1257,7 @@ func (s *DataConnectivityRegistryFolderResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
 
-	response, err := s.Client.GetFolder(context.Background(), request)
+	response, err := s.Client.GetFolder(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -1265,7 +1266,7 @@ func (s *DataConnectivityRegistryFolderResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DataConnectivityRegistryFolderResourceCrud) Update() error {
+func (s *DataConnectivityRegistryFolderResourceCrud) Update(ctx context.Context) error {
 	request := oci_data_connectivity.UpdateFolderRequest{}
 
 	if description, ok := s.D.GetOkExists("description"); ok {
@@ -1331,7 +1332,7 @@ func (s *DataConnectivityRegistryFolderResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
 
-	response, err := s.Client.UpdateFolder(context.Background(), request)
+	response, err := s.Client.UpdateFolder(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -1340,7 +1341,7 @@ func (s *DataConnectivityRegistryFolderResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DataConnectivityRegistryFolderResourceCrud) Delete() error {
+func (s *DataConnectivityRegistryFolderResourceCrud) Delete(ctx context.Context) error {
 	request := oci_data_connectivity.DeleteFolderRequest{}
 
 	if folderKey, ok := s.D.GetOkExists("key"); ok {
@@ -1355,7 +1356,7 @@ func (s *DataConnectivityRegistryFolderResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
 
-	_, err := s.Client.DeleteFolder(context.Background(), request)
+	_, err := s.Client.DeleteFolder(ctx, request)
 	return err
 }
 
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_connectivity "github.com/oracle/oci-go-sdk/v65/dataconnectivity"
 )
 
 func DataConnectivityRegistryFoldersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataConnectivityRegistryFolders,
+		ReadContext: readDataConnectivityRegistryFolders,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"favorites_query_param": {
@@ -59,12 +60,12 @@ func DataConnectivityRegistryFoldersDataSource() *schema.Resource {
 	}
 }
 
-func readDataConnectivityRegistryFolders(d *schema.ResourceData, m interface{}) error {
+func readDataConnectivityRegistryFolders(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryFoldersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataConnectivityRegistryFoldersDataSourceCrud struct {
@@ -77,7 +78,7 @@ func (s *DataConnectivityRegistryFoldersDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataConnectivityRegistryFoldersDataSourceCrud) Get() error {
+func (s *DataConnectivityRegistryFoldersDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_connectivity.ListFoldersRequest{}
 
 	if favoritesQueryParam, ok := s.D.GetOkExists("favorites_query_param"); ok {
@@ -114,7 +115,7 @@ func (s *DataConnectivityRegistryFoldersDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_connectivity")
 
-	response, err := s.Client.ListFolders(context.Background(), request)
+	response, err := s.Client.ListFolders(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -123,7 +124,7 @@ func (s *DataConnectivityRegistryFoldersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListFolders(context.Background(), request)
+		listResponse, err := s.Client.ListFolders(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,11 +25,11 @@ func DataConnectivityRegistryResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataConnectivityRegistry,
-		Read:     readDataConnectivityRegistry,
-		Update:   updateDataConnectivityRegistry,
-		Delete:   deleteDataConnectivityRegistry,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataConnectivityRegistry,
+		ReadContext:   readDataConnectivityRegistry,
+		UpdateContext: updateDataConnectivityRegistry,
+		DeleteContext: deleteDataConnectivityRegistry,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"display_name": {
@@ -86,37 +87,37 @@ func DataConnectivityRegistryResource() *schema.Resource {
 	}
 }
 
-func createDataConnectivityRegistry(d *schema.ResourceData, m interface{}) error {
+func createDataConnectivityRegistry(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDataConnectivityRegistry(d *schema.ResourceData, m interface{}) error {
+func readDataConnectivityRegistry(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDataConnectivityRegistry(d *schema.ResourceData, m interface{}) error {
+func updateDataConnectivityRegistry(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDataConnectivityRegistry(d *schema.ResourceData, m interface{}) error {
+func deleteDataConnectivityRegistry(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DataConnectivityRegistryResourceCrud struct {
@@ -154,7 +155,7 @@ func (s *DataConnectivityRegistryResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DataConnectivityRegistryResourceCrud) Create() error {
+func (s *DataConnectivityRegistryResourceCrud) Create(ctx context.Context) error {
 	request := oci_data_connectivity.CreateRegistryRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -186,20 +187,20 @@ func (s *DataConnectivityRegistryResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
 
-	response, err := s.Client.CreateRegistry(context.Background(), request)
+	response, err := s.Client.CreateRegistry(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getRegistryFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity"), oci_data_connectivity.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getRegistryFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity"), oci_data_connectivity.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *DataConnectivityRegistryResourceCrud) getRegistryFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *DataConnectivityRegistryResourceCrud) getRegistryFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_data_connectivity.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	registryId, err := registryWaitForWorkRequest(workId, "dcmsregistry",
+	registryId, err := registryWaitForWorkRequest(ctx, workId, "dcmsregistry",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -207,7 +208,7 @@ func (s *DataConnectivityRegistryResourceCrud) getRegistryFromWorkRequest(workId
 	}
 	s.D.SetId(*registryId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func registryWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -233,7 +234,7 @@ func registryWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci
 	}
 }
 
-func registryWaitForWorkRequest(wId *string, entityType string, action oci_data_connectivity.WorkRequestResourceActionTypeEnum,
+func registryWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_data_connectivity.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_data_connectivity.DataConnectivityManagementClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "data_connectivity")
 	retryPolicy.ShouldRetryOperation = registryWorkRequestShouldRetryFunc(timeout)
@@ -252,7 +253,7 @@ func registryWaitForWorkRequest(wId *string, entityType string, action oci_data_
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_data_connectivity.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -281,14 +282,14 @@ func registryWaitForWorkRequest(wId *string, entityType string, action oci_data_
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_data_connectivity.WorkRequestStatusFailed || response.Status == oci_data_connectivity.WorkRequestStatusCanceled {
-		return nil, getErrorFromDataConnectivityRegistryWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDataConnectivityRegistryWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDataConnectivityRegistryWorkRequest(client *oci_data_connectivity.DataConnectivityManagementClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_connectivity.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDataConnectivityRegistryWorkRequest(ctx context.Context, client *oci_data_connectivity.DataConnectivityManagementClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_connectivity.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_data_connectivity.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -310,7 +311,7 @@ func getErrorFromDataConnectivityRegistryWorkRequest(client *oci_data_connectivi
 	return workRequestErr
 }
 
-func (s *DataConnectivityRegistryResourceCrud) Get() error {
+func (s *DataConnectivityRegistryResourceCrud) Get(ctx context.Context) error {
 	request := oci_data_connectivity.GetRegistryRequest{}
 
 	tmp := s.D.Id()
@@ -318,7 +319,7 @@ func (s *DataConnectivityRegistryResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
 
-	response, err := s.Client.GetRegistry(context.Background(), request)
+	response, err := s.Client.GetRegistry(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -327,11 +328,11 @@ func (s *DataConnectivityRegistryResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DataConnectivityRegistryResourceCrud) Update() error {
+func (s *DataConnectivityRegistryResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -366,16 +367,16 @@ func (s *DataConnectivityRegistryResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
 
-	response, err := s.Client.UpdateRegistry(context.Background(), request)
+	response, err := s.Client.UpdateRegistry(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getRegistryFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity"), oci_data_connectivity.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getRegistryFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity"), oci_data_connectivity.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *DataConnectivityRegistryResourceCrud) Delete() error {
+func (s *DataConnectivityRegistryResourceCrud) Delete(ctx context.Context) error {
 	request := oci_data_connectivity.DeleteRegistryRequest{}
 
 	if isForceOperation, ok := s.D.GetOkExists("is_force_operation"); ok {
@@ -388,14 +389,14 @@ func (s *DataConnectivityRegistryResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
 
-	response, err := s.Client.DeleteRegistry(context.Background(), request)
+	response, err := s.Client.DeleteRegistry(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := registryWaitForWorkRequest(workId, "dcmsregistry",
+	_, delWorkRequestErr := registryWaitForWorkRequest(ctx, workId, "dcmsregistry",
 		oci_data_connectivity.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -486,7 +487,7 @@ func RegistrySummaryToMap(obj oci_data_connectivity.RegistrySummary) map[string]
 	return result
 }
 
-func (s *DataConnectivityRegistryResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DataConnectivityRegistryResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_data_connectivity.ChangeRegistryCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -497,11 +498,11 @@ func (s *DataConnectivityRegistryResourceCrud) updateCompartment(compartment int
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity")
 
-	response, err := s.Client.ChangeRegistryCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeRegistryCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getRegistryFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity"), oci_data_connectivity.WorkRequestResourceActionTypeMoved, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getRegistryFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_connectivity"), oci_data_connectivity.WorkRequestResourceActionTypeMoved, s.D.Timeout(schema.TimeoutUpdate))
 }
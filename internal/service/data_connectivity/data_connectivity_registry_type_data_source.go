@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_connectivity "github.com/oracle/oci-go-sdk/v65/dataconnectivity"
 )
 
 func DataConnectivityRegistryTypeDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDataConnectivityRegistryType,
+		ReadContext: readSingularDataConnectivityRegistryType,
 		Schema: map[string]*schema.Schema{
 			"fields": {
 				Type:     schema.TypeList,
@@ -86,12 +87,12 @@ func DataConnectivityRegistryTypeDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDataConnectivityRegistryType(d *schema.ResourceData, m interface{}) error {
+func readSingularDataConnectivityRegistryType(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryTypeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataConnectivityRegistryTypeDataSourceCrud struct {
@@ -104,7 +105,7 @@ func (s *DataConnectivityRegistryTypeDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataConnectivityRegistryTypeDataSourceCrud) Get() error {
+func (s *DataConnectivityRegistryTypeDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_connectivity.GetTypeRequest{}
 
 	if fields, ok := s.D.GetOkExists("fields"); ok {
@@ -132,7 +133,7 @@ func (s *DataConnectivityRegistryTypeDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_connectivity")
 
-	response, err := s.Client.GetType(context.Background(), request)
+	response, err := s.Client.GetType(ctx, request)
 	if err != nil {
 		return err
 	}
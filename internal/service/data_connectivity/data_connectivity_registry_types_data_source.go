@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_connectivity "github.com/oracle/oci-go-sdk/v65/dataconnectivity"
 )
 
 func DataConnectivityRegistryTypesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataConnectivityRegistryTypes,
+		ReadContext: readDataConnectivityRegistryTypes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"name": {
@@ -113,12 +114,12 @@ func DataConnectivityRegistryTypesDataSource() *schema.Resource {
 	}
 }
 
-func readDataConnectivityRegistryTypes(d *schema.ResourceData, m interface{}) error {
+func readDataConnectivityRegistryTypes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataConnectivityRegistryTypesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataConnectivityManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataConnectivityRegistryTypesDataSourceCrud struct {
@@ -131,7 +132,7 @@ func (s *DataConnectivityRegistryTypesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataConnectivityRegistryTypesDataSourceCrud) Get() error {
+func (s *DataConnectivityRegistryTypesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_connectivity.ListTypesRequest{}
 
 	if name, ok := s.D.GetOkExists("name"); ok {
@@ -151,7 +152,7 @@ func (s *DataConnectivityRegistryTypesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_connectivity")
 
-	response, err := s.Client.ListTypes(context.Background(), request)
+	response, err := s.Client.ListTypes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -160,7 +161,7 @@ func (s *DataConnectivityRegistryTypesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListTypes(context.Background(), request)
+		listResponse, err := s.Client.ListTypes(ctx, request)
 		if err != nil {
 			return err
 		}
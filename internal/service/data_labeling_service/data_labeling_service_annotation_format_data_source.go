@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_labeling_service "github.com/oracle/oci-go-sdk/v65/datalabelingservice"
 )
 
 func DataLabelingServiceAnnotationFormatDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDataLabelingServiceAnnotationFormat,
+		ReadContext: readSingularDataLabelingServiceAnnotationFormat,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -43,12 +44,12 @@ func DataLabelingServiceAnnotationFormatDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDataLabelingServiceAnnotationFormat(d *schema.ResourceData, m interface{}) error {
+func readSingularDataLabelingServiceAnnotationFormat(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataLabelingServiceAnnotationFormatDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataLabelingManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataLabelingServiceAnnotationFormatDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *DataLabelingServiceAnnotationFormatDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataLabelingServiceAnnotationFormatDataSourceCrud) Get() error {
+func (s *DataLabelingServiceAnnotationFormatDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_labeling_service.ListAnnotationFormatsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -71,7 +72,7 @@ func (s *DataLabelingServiceAnnotationFormatDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_labeling_service")
 
-	response, err := s.Client.ListAnnotationFormats(context.Background(), request)
+	response, err := s.Client.ListAnnotationFormats(ctx, request)
 	if err != nil {
 		return err
 	}
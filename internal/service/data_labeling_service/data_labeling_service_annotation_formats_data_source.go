@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_labeling_service "github.com/oracle/oci-go-sdk/v65/datalabelingservice"
 )
 
 func DataLabelingServiceAnnotationFormatsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataLabelingServiceAnnotationFormats,
+		ReadContext: readDataLabelingServiceAnnotationFormats,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -51,12 +52,12 @@ func DataLabelingServiceAnnotationFormatsDataSource() *schema.Resource {
 	}
 }
 
-func readDataLabelingServiceAnnotationFormats(d *schema.ResourceData, m interface{}) error {
+func readDataLabelingServiceAnnotationFormats(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataLabelingServiceAnnotationFormatsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataLabelingManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataLabelingServiceAnnotationFormatsDataSourceCrud struct {
@@ -69,7 +70,7 @@ func (s *DataLabelingServiceAnnotationFormatsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataLabelingServiceAnnotationFormatsDataSourceCrud) Get() error {
+func (s *DataLabelingServiceAnnotationFormatsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_labeling_service.ListAnnotationFormatsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -79,7 +80,7 @@ func (s *DataLabelingServiceAnnotationFormatsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_labeling_service")
 
-	response, err := s.Client.ListAnnotationFormats(context.Background(), request)
+	response, err := s.Client.ListAnnotationFormats(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -88,7 +89,7 @@ func (s *DataLabelingServiceAnnotationFormatsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAnnotationFormats(context.Background(), request)
+		listResponse, err := s.Client.ListAnnotationFormats(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_labeling_service "github.com/oracle/oci-go-sdk/v65/datalabelingservice"
 )
@@ -22,12 +23,12 @@ func DataLabelingServiceDatasetDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DataLabelingServiceDatasetResource(), fieldMap, readSingularDataLabelingServiceDataset)
 }
 
-func readSingularDataLabelingServiceDataset(d *schema.ResourceData, m interface{}) error {
+func readSingularDataLabelingServiceDataset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataLabelingServiceDatasetDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataLabelingManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataLabelingServiceDatasetDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DataLabelingServiceDatasetDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataLabelingServiceDatasetDataSourceCrud) Get() error {
+func (s *DataLabelingServiceDatasetDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_labeling_service.GetDatasetRequest{}
 
 	if datasetId, ok := s.D.GetOkExists("dataset_id"); ok {
@@ -50,7 +51,7 @@ func (s *DataLabelingServiceDatasetDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_labeling_service")
 
-	response, err := s.Client.GetDataset(context.Background(), request)
+	response, err := s.Client.GetDataset(ctx, request)
 	if err != nil {
 		return err
 	}
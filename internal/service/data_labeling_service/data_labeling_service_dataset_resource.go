@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func DataLabelingServiceDatasetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataLabelingServiceDataset,
-		Read:     readDataLabelingServiceDataset,
-		Update:   updateDataLabelingServiceDataset,
-		Delete:   deleteDataLabelingServiceDataset,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataLabelingServiceDataset,
+		ReadContext:   readDataLabelingServiceDataset,
+		UpdateContext: updateDataLabelingServiceDataset,
+		DeleteContext: deleteDataLabelingServiceDataset,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"annotation_format": {
@@ -266,37 +267,37 @@ func DataLabelingServiceDatasetResource() *schema.Resource {
 	}
 }
 
-func createDataLabelingServiceDataset(d *schema.ResourceData, m interface{}) error {
+func createDataLabelingServiceDataset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataLabelingServiceDatasetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataLabelingManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDataLabelingServiceDataset(d *schema.ResourceData, m interface{}) error {
+func readDataLabelingServiceDataset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataLabelingServiceDatasetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataLabelingManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDataLabelingServiceDataset(d *schema.ResourceData, m interface{}) error {
+func updateDataLabelingServiceDataset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataLabelingServiceDatasetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataLabelingManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDataLabelingServiceDataset(d *schema.ResourceData, m interface{}) error {
+func deleteDataLabelingServiceDataset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataLabelingServiceDatasetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataLabelingManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DataLabelingServiceDatasetResourceCrud struct {
@@ -335,7 +336,7 @@ func (s *DataLabelingServiceDatasetResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DataLabelingServiceDatasetResourceCrud) Create() error {
+func (s *DataLabelingServiceDatasetResourceCrud) Create(ctx context.Context) error {
 	request := oci_data_labeling_service.CreateDatasetRequest{}
 
 	if annotationFormat, ok := s.D.GetOkExists("annotation_format"); ok {
@@ -421,20 +422,20 @@ func (s *DataLabelingServiceDatasetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service")
 
-	response, err := s.Client.CreateDataset(context.Background(), request)
+	response, err := s.Client.CreateDataset(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDatasetFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service"), oci_data_labeling_service.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getDatasetFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service"), oci_data_labeling_service.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *DataLabelingServiceDatasetResourceCrud) getDatasetFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *DataLabelingServiceDatasetResourceCrud) getDatasetFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_data_labeling_service.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	datasetId, err := datasetWaitForWorkRequest(workId, "dataset",
+	datasetId, err := datasetWaitForWorkRequest(ctx, workId, "dataset",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -442,7 +443,7 @@ func (s *DataLabelingServiceDatasetResourceCrud) getDatasetFromWorkRequest(workI
 	}
 	s.D.SetId(*datasetId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func datasetWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -468,7 +469,7 @@ func datasetWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_
 	}
 }
 
-func datasetWaitForWorkRequest(wId *string, entityType string, action oci_data_labeling_service.ActionTypeEnum,
+func datasetWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_data_labeling_service.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_data_labeling_service.DataLabelingManagementClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "data_labeling_service")
 	retryPolicy.ShouldRetryOperation = datasetWorkRequestShouldRetryFunc(timeout)
@@ -487,7 +488,7 @@ func datasetWaitForWorkRequest(wId *string, entityType string, action oci_data_l
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_data_labeling_service.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -516,14 +517,14 @@ func datasetWaitForWorkRequest(wId *string, entityType string, action oci_data_l
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_data_labeling_service.OperationStatusFailed || response.Status == oci_data_labeling_service.OperationStatusCanceled {
-		return nil, getErrorFromDataLabelingServiceDatasetWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDataLabelingServiceDatasetWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDataLabelingServiceDatasetWorkRequest(client *oci_data_labeling_service.DataLabelingManagementClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_labeling_service.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDataLabelingServiceDatasetWorkRequest(ctx context.Context, client *oci_data_labeling_service.DataLabelingManagementClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_labeling_service.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_data_labeling_service.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -545,7 +546,7 @@ func getErrorFromDataLabelingServiceDatasetWorkRequest(client *oci_data_labeling
 	return workRequestErr
 }
 
-func (s *DataLabelingServiceDatasetResourceCrud) Get() error {
+func (s *DataLabelingServiceDatasetResourceCrud) Get(ctx context.Context) error {
 	request := oci_data_labeling_service.GetDatasetRequest{}
 
 	tmp := s.D.Id()
@@ -553,7 +554,7 @@ func (s *DataLabelingServiceDatasetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service")
 
-	response, err := s.Client.GetDataset(context.Background(), request)
+	response, err := s.Client.GetDataset(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -562,11 +563,11 @@ func (s *DataLabelingServiceDatasetResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DataLabelingServiceDatasetResourceCrud) Update() error {
+func (s *DataLabelingServiceDatasetResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -584,7 +585,7 @@ func (s *DataLabelingServiceDatasetResourceCrud) Update() error {
 		if err != nil {
 			return err
 		}
-		err = s.updateLabelSet(oldLabelSet, newLabelSet)
+		err = s.updateLabelSet(ctx, oldLabelSet, newLabelSet)
 		if err != nil {
 			return err
 		}
@@ -624,7 +625,7 @@ func (s *DataLabelingServiceDatasetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service")
 
-	response, err := s.Client.UpdateDataset(context.Background(), request)
+	response, err := s.Client.UpdateDataset(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -633,7 +634,7 @@ func (s *DataLabelingServiceDatasetResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DataLabelingServiceDatasetResourceCrud) Delete() error {
+func (s *DataLabelingServiceDatasetResourceCrud) Delete(ctx context.Context) error {
 	request := oci_data_labeling_service.DeleteDatasetRequest{}
 
 	tmp := s.D.Id()
@@ -641,14 +642,14 @@ func (s *DataLabelingServiceDatasetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service")
 
-	response, err := s.Client.DeleteDataset(context.Background(), request)
+	response, err := s.Client.DeleteDataset(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := datasetWaitForWorkRequest(workId, "dataset",
+	_, delWorkRequestErr := datasetWaitForWorkRequest(ctx, workId, "dataset",
 		oci_data_labeling_service.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -1053,7 +1054,7 @@ func TextFileTypeMetadataToMap(obj *oci_data_labeling_service.TextFileTypeMetada
 	return result
 }
 
-func (s *DataLabelingServiceDatasetResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DataLabelingServiceDatasetResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_data_labeling_service.ChangeDatasetCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -1064,16 +1065,16 @@ func (s *DataLabelingServiceDatasetResourceCrud) updateCompartment(compartment i
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service")
 
-	response, err := s.Client.ChangeDatasetCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeDatasetCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDatasetFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service"), oci_data_labeling_service.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getDatasetFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service"), oci_data_labeling_service.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *DataLabelingServiceDatasetResourceCrud) updateLabelSet(oldLabelSet, newLabelSet oci_data_labeling_service.LabelSet) error {
+func (s *DataLabelingServiceDatasetResourceCrud) updateLabelSet(ctx context.Context, oldLabelSet, newLabelSet oci_data_labeling_service.LabelSet) error {
 	addDatasetLabelsRequest := oci_data_labeling_service.AddDatasetLabelsRequest{}
 	labelSetDiffAdd := make([]oci_data_labeling_service.Label, 0)
 
@@ -1097,13 +1098,13 @@ func (s *DataLabelingServiceDatasetResourceCrud) updateLabelSet(oldLabelSet, new
 		addDatasetLabelsRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service")
 		addDatasetLabelsRequest.LabelSet = &oci_data_labeling_service.LabelSet{Items: labelSetDiffAdd}
 
-		response, err := s.Client.AddDatasetLabels(context.Background(), addDatasetLabelsRequest)
+		response, err := s.Client.AddDatasetLabels(ctx, addDatasetLabelsRequest)
 		if err != nil {
 			return err
 		}
 
 		workId := response.OpcWorkRequestId
-		err = s.getDatasetFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service"), oci_data_labeling_service.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+		err = s.getDatasetFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service"), oci_data_labeling_service.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return err
 		}
@@ -1131,13 +1132,13 @@ func (s *DataLabelingServiceDatasetResourceCrud) updateLabelSet(oldLabelSet, new
 		removeDatasetLabelsRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service")
 		removeDatasetLabelsRequest.LabelSet = &oci_data_labeling_service.LabelSet{Items: labelSetDiffRemove}
 
-		response, err := s.Client.RemoveDatasetLabels(context.Background(), removeDatasetLabelsRequest)
+		response, err := s.Client.RemoveDatasetLabels(ctx, removeDatasetLabelsRequest)
 		if err != nil {
 			return err
 		}
 
 		workId := response.OpcWorkRequestId
-		err = s.getDatasetFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service"), oci_data_labeling_service.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+		err = s.getDatasetFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_labeling_service"), oci_data_labeling_service.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return err
 		}
@@ -11,6 +11,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
@@ -18,7 +19,7 @@ import (
 
 func DataSafeAlertAnalyticDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDataSafeAlertAnalytic,
+		ReadContext: readSingularDataSafeAlertAnalytic,
 		Schema: map[string]*schema.Schema{
 			"access_level": {
 				Type:     schema.TypeString,
@@ -114,12 +115,12 @@ func DataSafeAlertAnalyticDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDataSafeAlertAnalytic(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeAlertAnalytic(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAlertAnalyticDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeAlertAnalyticDataSourceCrud struct {
@@ -132,7 +133,7 @@ func (s *DataSafeAlertAnalyticDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeAlertAnalyticDataSourceCrud) Get() error {
+func (s *DataSafeAlertAnalyticDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListAlertAnalyticsRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -203,7 +204,7 @@ func (s *DataSafeAlertAnalyticDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListAlertAnalytics(context.Background(), request)
+	response, err := s.Client.ListAlertAnalytics(ctx, request)
 	if err != nil {
 		return err
 	}
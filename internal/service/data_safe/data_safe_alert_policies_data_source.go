@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
@@ -17,7 +18,7 @@ import (
 
 func DataSafeAlertPoliciesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeAlertPolicies,
+		ReadContext: readDataSafeAlertPolicies,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -140,12 +141,12 @@ func DataSafeAlertPoliciesDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeAlertPolicies(d *schema.ResourceData, m interface{}) error {
+func readDataSafeAlertPolicies(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAlertPoliciesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeAlertPoliciesDataSourceCrud struct {
@@ -158,7 +159,7 @@ func (s *DataSafeAlertPoliciesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeAlertPoliciesDataSourceCrud) Get() error {
+func (s *DataSafeAlertPoliciesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListAlertPoliciesRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -216,7 +217,7 @@ func (s *DataSafeAlertPoliciesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListAlertPolicies(context.Background(), request)
+	response, err := s.Client.ListAlertPolicies(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -225,7 +226,7 @@ func (s *DataSafeAlertPoliciesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAlertPolicies(context.Background(), request)
+		listResponse, err := s.Client.ListAlertPolicies(ctx, request)
 		if err != nil {
 			return err
 		}
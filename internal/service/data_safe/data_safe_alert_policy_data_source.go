@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 )
 
 func DataSafeAlertPolicyDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDataSafeAlertPolicy,
+		ReadContext: readSingularDataSafeAlertPolicy,
 		Schema: map[string]*schema.Schema{
 			"alert_policy_id": {
 				Type:     schema.TypeString,
@@ -77,12 +78,12 @@ func DataSafeAlertPolicyDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDataSafeAlertPolicy(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeAlertPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAlertPolicyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeAlertPolicyDataSourceCrud struct {
@@ -95,7 +96,7 @@ func (s *DataSafeAlertPolicyDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeAlertPolicyDataSourceCrud) Get() error {
+func (s *DataSafeAlertPolicyDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetAlertPolicyRequest{}
 
 	if alertPolicyId, ok := s.D.GetOkExists("alert_policy_id"); ok {
@@ -105,7 +106,7 @@ func (s *DataSafeAlertPolicyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.GetAlertPolicy(context.Background(), request)
+	response, err := s.Client.GetAlertPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package data_safe
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 
@@ -15,7 +16,7 @@ import (
 
 func DataSafeAlertPolicyRuleDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDataSafeAlertPolicyRule,
+		ReadContext: readSingularDataSafeAlertPolicyRule,
 		Schema: map[string]*schema.Schema{
 			"alert_policy_id": {
 				Type:     schema.TypeString,
@@ -51,12 +52,12 @@ func DataSafeAlertPolicyRuleDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDataSafeAlertPolicyRule(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeAlertPolicyRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAlertPolicyRuleDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeAlertPolicyRuleDataSourceCrud struct {
@@ -69,7 +70,7 @@ func (s *DataSafeAlertPolicyRuleDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeAlertPolicyRuleDataSourceCrud) Get() error {
+func (s *DataSafeAlertPolicyRuleDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListAlertPolicyRulesRequest{}
 
 	if alertPolicyId, ok := s.D.GetOkExists("alert_policy_id"); ok {
@@ -79,7 +80,7 @@ func (s *DataSafeAlertPolicyRuleDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListAlertPolicyRules(context.Background(), request)
+	response, err := s.Client.ListAlertPolicyRules(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
@@ -22,11 +23,11 @@ func DataSafeAlertResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataSafeAlert,
-		Read:     readDataSafeAlert,
-		Update:   updateDataSafeAlert,
-		Delete:   deleteDataSafeAlert,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataSafeAlert,
+		ReadContext:   readDataSafeAlert,
+		UpdateContext: updateDataSafeAlert,
+		DeleteContext: deleteDataSafeAlert,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"alert_id": {
@@ -142,25 +143,25 @@ func DataSafeAlertResource() *schema.Resource {
 	}
 }
 
-func createDataSafeAlert(d *schema.ResourceData, m interface{}) error {
+func createDataSafeAlert(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAlertResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 	compartment, ok := sync.D.GetOkExists("compartment_id")
 
-	err := tfresource.CreateResource(d, sync)
+	err := tfresource.CreateResource(ctx, d, sync)
 	if err != nil {
-		return err
+		return tfresource.HandleDiagError(err)
 	}
 
 	if ok && compartment != *sync.Res.CompartmentId {
-		err = sync.updateCompartment(compartment)
+		err = sync.updateCompartment(ctx, compartment)
 		if err != nil {
-			return err
+			return tfresource.HandleDiagError(err)
 		}
 		tmp := compartment.(string)
 		sync.Res.CompartmentId = &tmp
-		err := sync.Get()
+		err := sync.Get(ctx)
 		if err != nil {
 			log.Printf("error doing a Get() after compartment update: %v", err)
 		}
@@ -172,23 +173,23 @@ func createDataSafeAlert(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func readDataSafeAlert(d *schema.ResourceData, m interface{}) error {
+func readDataSafeAlert(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAlertResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDataSafeAlert(d *schema.ResourceData, m interface{}) error {
+func updateDataSafeAlert(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAlertResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDataSafeAlert(d *schema.ResourceData, m interface{}) error {
+func deleteDataSafeAlert(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -221,7 +222,7 @@ func (s *DataSafeAlertResourceCrud) DeletedTarget() []string {
 	return []string{}
 }
 
-func (s *DataSafeAlertResourceCrud) Create() error {
+func (s *DataSafeAlertResourceCrud) Create(ctx context.Context) error {
 	request := oci_data_safe.UpdateAlertRequest{}
 
 	if alertId, ok := s.D.GetOkExists("alert_id"); ok {
@@ -252,7 +253,7 @@ func (s *DataSafeAlertResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.UpdateAlert(context.Background(), request)
+	response, err := s.Client.UpdateAlert(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -261,7 +262,7 @@ func (s *DataSafeAlertResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DataSafeAlertResourceCrud) Get() error {
+func (s *DataSafeAlertResourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetAlertRequest{}
 
 	tmp := s.D.Id()
@@ -269,7 +270,7 @@ func (s *DataSafeAlertResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.GetAlert(context.Background(), request)
+	response, err := s.Client.GetAlert(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -278,11 +279,11 @@ func (s *DataSafeAlertResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DataSafeAlertResourceCrud) Update() error {
+func (s *DataSafeAlertResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -316,7 +317,7 @@ func (s *DataSafeAlertResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.UpdateAlert(context.Background(), request)
+	response, err := s.Client.UpdateAlert(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -467,7 +468,7 @@ func AlertSummaryToMap(obj oci_data_safe.AlertSummary) map[string]interface{} {
 	return result
 }
 
-func (s *DataSafeAlertResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DataSafeAlertResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_data_safe.ChangeAlertCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -478,12 +479,12 @@ func (s *DataSafeAlertResourceCrud) updateCompartment(compartment interface{}) e
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	_, err := s.Client.ChangeAlertCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeAlertCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
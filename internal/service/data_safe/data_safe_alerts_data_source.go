@@ -6,6 +6,7 @@ package data_safe
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 
@@ -15,7 +16,7 @@ import (
 
 func DataSafeAlertsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeAlerts,
+		ReadContext: readDataSafeAlerts,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -63,12 +64,12 @@ func DataSafeAlertsDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeAlerts(d *schema.ResourceData, m interface{}) error {
+func readDataSafeAlerts(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAlertsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeAlertsDataSourceCrud struct {
@@ -81,7 +82,7 @@ func (s *DataSafeAlertsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeAlertsDataSourceCrud) Get() error {
+func (s *DataSafeAlertsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListAlertsRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -123,7 +124,7 @@ func (s *DataSafeAlertsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListAlerts(context.Background(), request)
+	response, err := s.Client.ListAlerts(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -132,7 +133,7 @@ func (s *DataSafeAlertsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAlerts(context.Background(), request)
+		listResponse, err := s.Client.ListAlerts(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 
@@ -24,12 +25,12 @@ func DataSafeAuditArchiveRetrievalDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DataSafeAuditArchiveRetrievalResource(), fieldMap, readSingularDataSafeAuditArchiveRetrieval)
 }
 
-func readSingularDataSafeAuditArchiveRetrieval(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeAuditArchiveRetrieval(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAuditArchiveRetrievalDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeAuditArchiveRetrievalDataSourceCrud struct {
@@ -42,7 +43,7 @@ func (s *DataSafeAuditArchiveRetrievalDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeAuditArchiveRetrievalDataSourceCrud) Get() error {
+func (s *DataSafeAuditArchiveRetrievalDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetAuditArchiveRetrievalRequest{}
 
 	if auditArchiveRetrievalId, ok := s.D.GetOkExists("audit_archive_retrieval_id"); ok {
@@ -52,7 +53,7 @@ func (s *DataSafeAuditArchiveRetrievalDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.GetAuditArchiveRetrieval(context.Background(), request)
+	response, err := s.Client.GetAuditArchiveRetrieval(ctx, request)
 	if err != nil {
 		return err
 	}
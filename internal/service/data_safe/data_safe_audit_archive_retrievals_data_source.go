@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
@@ -17,7 +18,7 @@ import (
 
 func DataSafeAuditArchiveRetrievalsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeAuditArchiveRetrievals,
+		ReadContext: readDataSafeAuditArchiveRetrievals,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -70,12 +71,12 @@ func DataSafeAuditArchiveRetrievalsDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeAuditArchiveRetrievals(d *schema.ResourceData, m interface{}) error {
+func readDataSafeAuditArchiveRetrievals(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAuditArchiveRetrievalsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeAuditArchiveRetrievalsDataSourceCrud struct {
@@ -88,7 +89,7 @@ func (s *DataSafeAuditArchiveRetrievalsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeAuditArchiveRetrievalsDataSourceCrud) Get() error {
+func (s *DataSafeAuditArchiveRetrievalsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListAuditArchiveRetrievalsRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -134,7 +135,7 @@ func (s *DataSafeAuditArchiveRetrievalsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListAuditArchiveRetrievals(context.Background(), request)
+	response, err := s.Client.ListAuditArchiveRetrievals(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -143,7 +144,7 @@ func (s *DataSafeAuditArchiveRetrievalsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAuditArchiveRetrievals(context.Background(), request)
+		listResponse, err := s.Client.ListAuditArchiveRetrievals(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
@@ -18,7 +19,7 @@ import (
 
 func DataSafeAuditEventAnalyticDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDataSafeAuditEventAnalytic,
+		ReadContext: readSingularDataSafeAuditEventAnalytic,
 		Schema: map[string]*schema.Schema{
 			"access_level": {
 				Type:     schema.TypeString,
@@ -190,12 +191,12 @@ func DataSafeAuditEventAnalyticDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDataSafeAuditEventAnalytic(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeAuditEventAnalytic(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAuditEventAnalyticDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeAuditEventAnalyticDataSourceCrud struct {
@@ -208,7 +209,7 @@ func (s *DataSafeAuditEventAnalyticDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeAuditEventAnalyticDataSourceCrud) Get() error {
+func (s *DataSafeAuditEventAnalyticDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListAuditEventAnalyticsRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -279,7 +280,7 @@ func (s *DataSafeAuditEventAnalyticDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListAuditEventAnalytics(context.Background(), request)
+	response, err := s.Client.ListAuditEventAnalytics(ctx, request)
 	if err != nil {
 		return err
 	}
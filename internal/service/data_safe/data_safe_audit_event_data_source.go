@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 )
 
 func DataSafeAuditEventDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDataSafeAuditEvent,
+		ReadContext: readSingularDataSafeAuditEvent,
 		Schema: map[string]*schema.Schema{
 			"access_level": {
 				Type:     schema.TypeString,
@@ -189,12 +190,12 @@ func DataSafeAuditEventDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDataSafeAuditEvent(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeAuditEvent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAuditEventDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeAuditEventDataSourceCrud struct {
@@ -207,7 +208,7 @@ func (s *DataSafeAuditEventDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeAuditEventDataSourceCrud) Get() error {
+func (s *DataSafeAuditEventDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListAuditEventsRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -231,7 +232,7 @@ func (s *DataSafeAuditEventDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListAuditEvents(context.Background(), request)
+	response, err := s.Client.ListAuditEvents(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 )
 
 func DataSafeAuditEventsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeAuditEvents,
+		ReadContext: readDataSafeAuditEvents,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -197,12 +198,12 @@ func DataSafeAuditEventsDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeAuditEvents(d *schema.ResourceData, m interface{}) error {
+func readDataSafeAuditEvents(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAuditEventsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeAuditEventsDataSourceCrud struct {
@@ -215,7 +216,7 @@ func (s *DataSafeAuditEventsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeAuditEventsDataSourceCrud) Get() error {
+func (s *DataSafeAuditEventsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListAuditEventsRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -239,7 +240,7 @@ func (s *DataSafeAuditEventsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListAuditEvents(context.Background(), request)
+	response, err := s.Client.ListAuditEvents(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package data_safe
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 
@@ -15,7 +16,7 @@ import (
 
 func DataSafeAuditPoliciesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeAuditPolicies,
+		ReadContext: readDataSafeAuditPolicies,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -64,12 +65,12 @@ func DataSafeAuditPoliciesDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeAuditPolicies(d *schema.ResourceData, m interface{}) error {
+func readDataSafeAuditPolicies(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAuditPoliciesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeAuditPoliciesDataSourceCrud struct {
@@ -82,7 +83,7 @@ func (s *DataSafeAuditPoliciesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeAuditPoliciesDataSourceCrud) Get() error {
+func (s *DataSafeAuditPoliciesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListAuditPoliciesRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -120,7 +121,7 @@ func (s *DataSafeAuditPoliciesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListAuditPolicies(context.Background(), request)
+	response, err := s.Client.ListAuditPolicies(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -129,7 +130,7 @@ func (s *DataSafeAuditPoliciesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAuditPolicies(context.Background(), request)
+		listResponse, err := s.Client.ListAuditPolicies(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package data_safe
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 
@@ -22,12 +23,12 @@ func DataSafeAuditPolicyDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DataSafeAuditPolicyResource(), fieldMap, readSingularDataSafeAuditPolicy)
 }
 
-func readSingularDataSafeAuditPolicy(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeAuditPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAuditPolicyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeAuditPolicyDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DataSafeAuditPolicyDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeAuditPolicyDataSourceCrud) Get() error {
+func (s *DataSafeAuditPolicyDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetAuditPolicyRequest{}
 
 	if auditPolicyId, ok := s.D.GetOkExists("audit_policy_id"); ok {
@@ -50,7 +51,7 @@ func (s *DataSafeAuditPolicyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.GetAuditPolicy(context.Background(), request)
+	response, err := s.Client.GetAuditPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
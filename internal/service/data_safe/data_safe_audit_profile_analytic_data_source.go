@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 
@@ -16,7 +17,7 @@ import (
 
 func DataSafeAuditProfileAnalyticDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDataSafeAuditProfileAnalytic,
+		ReadContext: readSingularDataSafeAuditProfileAnalytic,
 		Schema: map[string]*schema.Schema{
 			"access_level": {
 				Type:     schema.TypeString,
@@ -76,12 +77,12 @@ func DataSafeAuditProfileAnalyticDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDataSafeAuditProfileAnalytic(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeAuditProfileAnalytic(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAuditProfileAnalyticDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeAuditProfileAnalyticDataSourceCrud struct {
@@ -94,7 +95,7 @@ func (s *DataSafeAuditProfileAnalyticDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeAuditProfileAnalyticDataSourceCrud) Get() error {
+func (s *DataSafeAuditProfileAnalyticDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListAuditProfileAnalyticsRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -126,7 +127,7 @@ func (s *DataSafeAuditProfileAnalyticDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListAuditProfileAnalytics(context.Background(), request)
+	response, err := s.Client.ListAuditProfileAnalytics(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
@@ -17,7 +18,7 @@ import (
 
 func DataSafeAuditProfileAvailableAuditVolumeDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDataSafeAuditProfileAvailableAuditVolume,
+		ReadContext: readSingularDataSafeAuditProfileAvailableAuditVolume,
 		Schema: map[string]*schema.Schema{
 			"audit_profile_id": {
 				Type:     schema.TypeString,
@@ -73,12 +74,12 @@ func DataSafeAuditProfileAvailableAuditVolumeDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDataSafeAuditProfileAvailableAuditVolume(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeAuditProfileAvailableAuditVolume(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAuditProfileAvailableAuditVolumeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeAuditProfileAvailableAuditVolumeDataSourceCrud struct {
@@ -91,7 +92,7 @@ func (s *DataSafeAuditProfileAvailableAuditVolumeDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeAuditProfileAvailableAuditVolumeDataSourceCrud) Get() error {
+func (s *DataSafeAuditProfileAvailableAuditVolumeDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListAvailableAuditVolumesRequest{}
 
 	if auditProfileId, ok := s.D.GetOkExists("audit_profile_id"); ok {
@@ -127,7 +128,7 @@ func (s *DataSafeAuditProfileAvailableAuditVolumeDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListAvailableAuditVolumes(context.Background(), request)
+	response, err := s.Client.ListAvailableAuditVolumes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
@@ -18,7 +19,7 @@ import (
 
 func DataSafeAuditProfileAvailableAuditVolumesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeAuditProfileAvailableAuditVolumes,
+		ReadContext: readDataSafeAuditProfileAvailableAuditVolumes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"audit_profile_id": {
@@ -95,12 +96,12 @@ func DataSafeAuditProfileAvailableAuditVolumesDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeAuditProfileAvailableAuditVolumes(d *schema.ResourceData, m interface{}) error {
+func readDataSafeAuditProfileAvailableAuditVolumes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAuditProfileAvailableAuditVolumesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeAuditProfileAvailableAuditVolumesDataSourceCrud struct {
@@ -113,7 +114,7 @@ func (s *DataSafeAuditProfileAvailableAuditVolumesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeAuditProfileAvailableAuditVolumesDataSourceCrud) Get() error {
+func (s *DataSafeAuditProfileAvailableAuditVolumesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListAvailableAuditVolumesRequest{}
 
 	if auditProfileId, ok := s.D.GetOkExists("audit_profile_id"); ok {
@@ -149,7 +150,7 @@ func (s *DataSafeAuditProfileAvailableAuditVolumesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListAvailableAuditVolumes(context.Background(), request)
+	response, err := s.Client.ListAvailableAuditVolumes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -158,7 +159,7 @@ func (s *DataSafeAuditProfileAvailableAuditVolumesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAvailableAuditVolumes(context.Background(), request)
+		listResponse, err := s.Client.ListAvailableAuditVolumes(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
@@ -17,7 +18,7 @@ import (
 
 func DataSafeAuditProfileCollectedAuditVolumeDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDataSafeAuditProfileCollectedAuditVolume,
+		ReadContext: readSingularDataSafeAuditProfileCollectedAuditVolume,
 		Schema: map[string]*schema.Schema{
 			"audit_profile_id": {
 				Type:     schema.TypeString,
@@ -69,12 +70,12 @@ func DataSafeAuditProfileCollectedAuditVolumeDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDataSafeAuditProfileCollectedAuditVolume(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeAuditProfileCollectedAuditVolume(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAuditProfileCollectedAuditVolumeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeAuditProfileCollectedAuditVolumeDataSourceCrud struct {
@@ -87,7 +88,7 @@ func (s *DataSafeAuditProfileCollectedAuditVolumeDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeAuditProfileCollectedAuditVolumeDataSourceCrud) Get() error {
+func (s *DataSafeAuditProfileCollectedAuditVolumeDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListCollectedAuditVolumesRequest{}
 
 	if auditProfileId, ok := s.D.GetOkExists("audit_profile_id"); ok {
@@ -118,7 +119,7 @@ func (s *DataSafeAuditProfileCollectedAuditVolumeDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListCollectedAuditVolumes(context.Background(), request)
+	response, err := s.Client.ListCollectedAuditVolumes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
@@ -18,7 +19,7 @@ import (
 
 func DataSafeAuditProfileCollectedAuditVolumesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeAuditProfileCollectedAuditVolumes,
+		ReadContext: readDataSafeAuditProfileCollectedAuditVolumes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"audit_profile_id": {
@@ -91,12 +92,12 @@ func DataSafeAuditProfileCollectedAuditVolumesDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeAuditProfileCollectedAuditVolumes(d *schema.ResourceData, m interface{}) error {
+func readDataSafeAuditProfileCollectedAuditVolumes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAuditProfileCollectedAuditVolumesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeAuditProfileCollectedAuditVolumesDataSourceCrud struct {
@@ -109,7 +110,7 @@ func (s *DataSafeAuditProfileCollectedAuditVolumesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeAuditProfileCollectedAuditVolumesDataSourceCrud) Get() error {
+func (s *DataSafeAuditProfileCollectedAuditVolumesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListCollectedAuditVolumesRequest{}
 
 	if auditProfileId, ok := s.D.GetOkExists("audit_profile_id"); ok {
@@ -140,7 +141,7 @@ func (s *DataSafeAuditProfileCollectedAuditVolumesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListCollectedAuditVolumes(context.Background(), request)
+	response, err := s.Client.ListCollectedAuditVolumes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -149,7 +150,7 @@ func (s *DataSafeAuditProfileCollectedAuditVolumesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCollectedAuditVolumes(context.Background(), request)
+		listResponse, err := s.Client.ListCollectedAuditVolumes(ctx, request)
 		if err != nil {
 			return err
 		}
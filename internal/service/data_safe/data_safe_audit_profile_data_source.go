@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 
@@ -23,12 +24,12 @@ func DataSafeAuditProfileDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DataSafeAuditProfileResource(), fieldMap, readSingularDataSafeAuditProfile)
 }
 
-func readSingularDataSafeAuditProfile(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeAuditProfile(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAuditProfileDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeAuditProfileDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *DataSafeAuditProfileDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeAuditProfileDataSourceCrud) Get() error {
+func (s *DataSafeAuditProfileDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetAuditProfileRequest{}
 
 	if auditProfileId, ok := s.D.GetOkExists("audit_profile_id"); ok {
@@ -51,7 +52,7 @@ func (s *DataSafeAuditProfileDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.GetAuditProfile(context.Background(), request)
+	response, err := s.Client.GetAuditProfile(ctx, request)
 	if err != nil {
 		return err
 	}
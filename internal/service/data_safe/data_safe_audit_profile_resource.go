@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -26,11 +27,11 @@ func DataSafeAuditProfileResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataSafeAuditProfile,
-		Read:     readDataSafeAuditProfile,
-		Update:   updateDataSafeAuditProfile,
-		Delete:   deleteDataSafeAuditProfile,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataSafeAuditProfile,
+		ReadContext:   readDataSafeAuditProfile,
+		UpdateContext: updateDataSafeAuditProfile,
+		DeleteContext: deleteDataSafeAuditProfile,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"audit_profile_id": {
@@ -213,32 +214,32 @@ func DataSafeAuditProfileResource() *schema.Resource {
 	}
 }
 
-func createDataSafeAuditProfile(d *schema.ResourceData, m interface{}) error {
+func createDataSafeAuditProfile(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAuditProfileResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 	compartment, ok := sync.D.GetOkExists("compartment_id")
 
-	err := tfresource.CreateResource(d, sync)
+	err := tfresource.CreateResource(ctx, d, sync)
 	if err != nil {
-		return err
+		return tfresource.HandleDiagError(err)
 	}
 
 	if _, ok := sync.D.GetOkExists("change_retention_trigger"); ok {
-		err := sync.ChangeRetention()
+		err := sync.ChangeRetention(ctx)
 		if err != nil {
-			return err
+			return tfresource.HandleDiagError(err)
 		}
 	}
 
 	if ok && compartment != *sync.Res.CompartmentId {
-		err = sync.updateCompartment(compartment)
+		err = sync.updateCompartment(ctx, compartment)
 		if err != nil {
-			return err
+			return tfresource.HandleDiagError(err)
 		}
 		tmp := compartment.(string)
 		sync.Res.CompartmentId = &tmp
-		err := sync.Get()
+		err := sync.Get(ctx)
 		if err != nil {
 			log.Printf("error doing a Get() after compartment update: %v", err)
 		}
@@ -250,15 +251,15 @@ func createDataSafeAuditProfile(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func readDataSafeAuditProfile(d *schema.ResourceData, m interface{}) error {
+func readDataSafeAuditProfile(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAuditProfileResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDataSafeAuditProfile(d *schema.ResourceData, m interface{}) error {
+func updateDataSafeAuditProfile(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAuditProfileResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
@@ -268,25 +269,25 @@ func updateDataSafeAuditProfile(d *schema.ResourceData, m interface{}) error {
 		oldValue := oldRaw.(int)
 		newValue := newRaw.(int)
 		if oldValue < newValue {
-			err := sync.ChangeRetention()
+			err := sync.ChangeRetention(ctx)
 
 			if err != nil {
-				return err
+				return tfresource.HandleDiagError(err)
 			}
 		} else {
 			sync.D.Set("change_retention_trigger", oldRaw)
-			return fmt.Errorf("new value of trigger should be greater than the old value")
+			return tfresource.HandleDiagError(fmt.Errorf("new value of trigger should be greater than the old value"))
 		}
 	}
 
-	if err := tfresource.UpdateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.UpdateResource(ctx, d, sync); err != nil {
+		return tfresource.HandleDiagError(err)
 	}
 
 	return nil
 }
 
-func deleteDataSafeAuditProfile(d *schema.ResourceData, m interface{}) error {
+func deleteDataSafeAuditProfile(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -326,7 +327,7 @@ func (s *DataSafeAuditProfileResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DataSafeAuditProfileResourceCrud) Create() error {
+func (s *DataSafeAuditProfileResourceCrud) Create(ctx context.Context) error {
 	request := oci_data_safe.UpdateAuditProfileRequest{}
 
 	if auditProfileId, ok := s.D.GetOkExists("audit_profile_id"); ok {
@@ -363,20 +364,20 @@ func (s *DataSafeAuditProfileResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.UpdateAuditProfile(context.Background(), request)
+	response, err := s.Client.UpdateAuditProfile(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getAuditProfileFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getAuditProfileFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *DataSafeAuditProfileResourceCrud) getAuditProfileFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *DataSafeAuditProfileResourceCrud) getAuditProfileFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_data_safe.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	auditProfileId, err := auditProfileWaitForWorkRequest(workId, "auditprofile",
+	auditProfileId, err := auditProfileWaitForWorkRequest(ctx, workId, "auditprofile",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -384,7 +385,7 @@ func (s *DataSafeAuditProfileResourceCrud) getAuditProfileFromWorkRequest(workId
 	}
 	s.D.SetId(*auditProfileId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func auditProfileWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -410,7 +411,7 @@ func auditProfileWorkRequestShouldRetryFunc(timeout time.Duration) func(response
 	}
 }
 
-func auditProfileWaitForWorkRequest(wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
+func auditProfileWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_data_safe.DataSafeClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "data_safe")
 	retryPolicy.ShouldRetryOperation = auditProfileWorkRequestShouldRetryFunc(timeout)
@@ -429,7 +430,7 @@ func auditProfileWaitForWorkRequest(wId *string, entityType string, action oci_d
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -458,14 +459,14 @@ func auditProfileWaitForWorkRequest(wId *string, entityType string, action oci_d
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_data_safe.WorkRequestStatusFailed || response.Status == oci_data_safe.WorkRequestStatusCanceled {
-		return nil, getErrorFromDataSafeAuditProfileWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDataSafeAuditProfileWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDataSafeAuditProfileWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDataSafeAuditProfileWorkRequest(ctx context.Context, client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -487,7 +488,7 @@ func getErrorFromDataSafeAuditProfileWorkRequest(client *oci_data_safe.DataSafeC
 	return workRequestErr
 }
 
-func (s *DataSafeAuditProfileResourceCrud) Get() error {
+func (s *DataSafeAuditProfileResourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetAuditProfileRequest{}
 
 	tmp := s.D.Id()
@@ -495,7 +496,7 @@ func (s *DataSafeAuditProfileResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.GetAuditProfile(context.Background(), request)
+	response, err := s.Client.GetAuditProfile(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -504,11 +505,11 @@ func (s *DataSafeAuditProfileResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DataSafeAuditProfileResourceCrud) Update() error {
+func (s *DataSafeAuditProfileResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -548,13 +549,13 @@ func (s *DataSafeAuditProfileResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.UpdateAuditProfile(context.Background(), request)
+	response, err := s.Client.UpdateAuditProfile(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getAuditProfileFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getAuditProfileFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *DataSafeAuditProfileResourceCrud) SetData() error {
@@ -627,7 +628,7 @@ func (s *DataSafeAuditProfileResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *DataSafeAuditProfileResourceCrud) ChangeRetention() error {
+func (s *DataSafeAuditProfileResourceCrud) ChangeRetention(ctx context.Context) error {
 	request := oci_data_safe.ChangeRetentionRequest{}
 
 	idTmp := s.D.Id()
@@ -650,12 +651,12 @@ func (s *DataSafeAuditProfileResourceCrud) ChangeRetention() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.ChangeRetention(context.Background(), request)
+	response, err := s.Client.ChangeRetention(ctx, request)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -663,7 +664,7 @@ func (s *DataSafeAuditProfileResourceCrud) ChangeRetention() error {
 	s.D.Set("change_retention_trigger", val)
 
 	workId := response.OpcWorkRequestId
-	return s.getAuditProfileFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getAuditProfileFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 
 }
 
@@ -805,7 +806,7 @@ func AuditTrailToMap(obj oci_data_safe.AuditTrail) map[string]interface{} {
 	return result
 }
 
-func (s *DataSafeAuditProfileResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DataSafeAuditProfileResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_data_safe.ChangeAuditProfileCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -816,11 +817,11 @@ func (s *DataSafeAuditProfileResourceCrud) updateCompartment(compartment interfa
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.ChangeAuditProfileCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeAuditProfileCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getAuditProfileFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getAuditProfileFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -10,6 +10,7 @@ import (
 
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -18,7 +19,7 @@ import (
 
 func DataSafeAuditProfilesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeAuditProfiles,
+		ReadContext: readDataSafeAuditProfiles,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -79,12 +80,12 @@ func DataSafeAuditProfilesDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeAuditProfiles(d *schema.ResourceData, m interface{}) error {
+func readDataSafeAuditProfiles(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAuditProfilesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeAuditProfilesDataSourceCrud struct {
@@ -97,7 +98,7 @@ func (s *DataSafeAuditProfilesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeAuditProfilesDataSourceCrud) Get() error {
+func (s *DataSafeAuditProfilesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListAuditProfilesRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -154,7 +155,7 @@ func (s *DataSafeAuditProfilesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListAuditProfiles(context.Background(), request)
+	response, err := s.Client.ListAuditProfiles(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -163,7 +164,7 @@ func (s *DataSafeAuditProfilesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAuditProfiles(context.Background(), request)
+		listResponse, err := s.Client.ListAuditProfiles(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -17,7 +18,7 @@ import (
 
 func DataSafeAuditTrailAnalyticDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDataSafeAuditTrailAnalytic,
+		ReadContext: readSingularDataSafeAuditTrailAnalytic,
 		Schema: map[string]*schema.Schema{
 			"access_level": {
 				Type:     schema.TypeString,
@@ -93,12 +94,12 @@ func DataSafeAuditTrailAnalyticDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDataSafeAuditTrailAnalytic(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeAuditTrailAnalytic(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAuditTrailAnalyticDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeAuditTrailAnalyticDataSourceCrud struct {
@@ -111,7 +112,7 @@ func (s *DataSafeAuditTrailAnalyticDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeAuditTrailAnalyticDataSourceCrud) Get() error {
+func (s *DataSafeAuditTrailAnalyticDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListAuditTrailAnalyticsRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -148,7 +149,7 @@ func (s *DataSafeAuditTrailAnalyticDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListAuditTrailAnalytics(context.Background(), request)
+	response, err := s.Client.ListAuditTrailAnalytics(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -25,11 +26,11 @@ func DataSafeAuditTrailResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataSafeAuditTrail,
-		Read:     readDataSafeAuditTrail,
-		Update:   updateDataSafeAuditTrail,
-		Delete:   deleteDataSafeAuditTrail,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataSafeAuditTrail,
+		ReadContext:   readDataSafeAuditTrail,
+		UpdateContext: updateDataSafeAuditTrail,
+		DeleteContext: deleteDataSafeAuditTrail,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"audit_trail_id": {
@@ -132,7 +133,7 @@ func DataSafeAuditTrailResource() *schema.Resource {
 	}
 }
 
-func createDataSafeAuditTrail(d *schema.ResourceData, m interface{}) error {
+func createDataSafeAuditTrail(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAuditTrailResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
@@ -148,14 +149,14 @@ func createDataSafeAuditTrail(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	if e := tfresource.CreateResource(d, sync); e != nil {
-		return e
+	if e := tfresource.CreateResource(ctx, d, sync); e != nil {
+		return tfresource.HandleDiagError(e)
 	}
 
 	if _, ok := sync.D.GetOkExists("resume_trigger"); ok {
-		err := sync.ResumeAuditTrail()
+		err := sync.ResumeAuditTrail(ctx)
 		if err != nil {
-			return err
+			return tfresource.HandleDiagError(err)
 		}
 	}
 
@@ -163,15 +164,15 @@ func createDataSafeAuditTrail(d *schema.ResourceData, m interface{}) error {
 	oldValue := oldRaw.(int)
 	newValue := newRaw.(int)
 	if powerOn && !(oldValue < newValue) {
-		if err := sync.StartAuditTrail(); err != nil {
-			return err
+		if err := sync.StartAuditTrail(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		sync.D.Set("state", oci_data_safe.AuditTrailLifecycleStateActive)
 	}
 
 	if powerOff {
-		if err := sync.StopAuditTrail(); err != nil {
-			return err
+		if err := sync.StopAuditTrail(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		sync.D.Set("state", oci_data_safe.AuditTrailLifecycleStateInactive)
 	}
@@ -179,15 +180,15 @@ func createDataSafeAuditTrail(d *schema.ResourceData, m interface{}) error {
 
 }
 
-func readDataSafeAuditTrail(d *schema.ResourceData, m interface{}) error {
+func readDataSafeAuditTrail(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAuditTrailResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDataSafeAuditTrail(d *schema.ResourceData, m interface{}) error {
+func updateDataSafeAuditTrail(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAuditTrailResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
@@ -204,32 +205,32 @@ func updateDataSafeAuditTrail(d *schema.ResourceData, m interface{}) error {
 	oldValue := oldRaw.(int)
 	newValue := newRaw.(int)
 	if powerOn && !(oldValue < newValue) {
-		if err := sync.StartAuditTrail(); err != nil {
-			return err
+		if err := sync.StartAuditTrail(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		sync.D.Set("state", oci_data_safe.AuditTrailLifecycleStateActive)
 	}
 
 	if _, ok := sync.D.GetOkExists("resume_trigger"); ok && sync.D.HasChange("resume_trigger") {
 		if oldValue < newValue {
-			err := sync.ResumeAuditTrail()
+			err := sync.ResumeAuditTrail(ctx)
 
 			if err != nil {
-				return err
+				return tfresource.HandleDiagError(err)
 			}
 		} else {
 			sync.D.Set("resume_trigger", oldRaw)
-			return fmt.Errorf("new value of trigger should be greater than the old value")
+			return tfresource.HandleDiagError(fmt.Errorf("new value of trigger should be greater than the old value"))
 		}
 	}
 
-	if err := tfresource.UpdateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.UpdateResource(ctx, d, sync); err != nil {
+		return tfresource.HandleDiagError(err)
 	}
 
 	if powerOff {
-		if err := sync.StopAuditTrail(); err != nil {
-			return err
+		if err := sync.StopAuditTrail(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		sync.D.Set("state", oci_data_safe.AuditTrailLifecycleStateInactive)
 	}
@@ -237,13 +238,13 @@ func updateDataSafeAuditTrail(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func deleteDataSafeAuditTrail(d *schema.ResourceData, m interface{}) error {
+func deleteDataSafeAuditTrail(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAuditTrailResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DataSafeAuditTrailResourceCrud struct {
@@ -279,7 +280,7 @@ func (s *DataSafeAuditTrailResourceCrud) DeletedTarget() []string {
 	return []string{}
 }
 
-func (s *DataSafeAuditTrailResourceCrud) Create() error {
+func (s *DataSafeAuditTrailResourceCrud) Create(ctx context.Context) error {
 	request := oci_data_safe.UpdateAuditTrailRequest{}
 
 	if auditTrailId, ok := s.D.GetOkExists("audit_trail_id"); ok {
@@ -316,20 +317,20 @@ func (s *DataSafeAuditTrailResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.UpdateAuditTrail(context.Background(), request)
+	response, err := s.Client.UpdateAuditTrail(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getAuditTrailFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getAuditTrailFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *DataSafeAuditTrailResourceCrud) getAuditTrailFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *DataSafeAuditTrailResourceCrud) getAuditTrailFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_data_safe.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	auditTrailId, err := auditTrailWaitForWorkRequest(workId, "audittrail",
+	auditTrailId, err := auditTrailWaitForWorkRequest(ctx, workId, "audittrail",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -337,7 +338,7 @@ func (s *DataSafeAuditTrailResourceCrud) getAuditTrailFromWorkRequest(workId *st
 	}
 	s.D.SetId(*auditTrailId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func auditTrailWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -363,7 +364,7 @@ func auditTrailWorkRequestShouldRetryFunc(timeout time.Duration) func(response o
 	}
 }
 
-func auditTrailWaitForWorkRequest(wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
+func auditTrailWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_data_safe.DataSafeClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "data_safe")
 	retryPolicy.ShouldRetryOperation = auditTrailWorkRequestShouldRetryFunc(timeout)
@@ -382,7 +383,7 @@ func auditTrailWaitForWorkRequest(wId *string, entityType string, action oci_dat
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -411,14 +412,14 @@ func auditTrailWaitForWorkRequest(wId *string, entityType string, action oci_dat
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_data_safe.WorkRequestStatusFailed || response.Status == oci_data_safe.WorkRequestStatusCanceled {
-		return nil, getErrorFromDataSafeAuditTrailWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDataSafeAuditTrailWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDataSafeAuditTrailWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDataSafeAuditTrailWorkRequest(ctx context.Context, client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -440,7 +441,7 @@ func getErrorFromDataSafeAuditTrailWorkRequest(client *oci_data_safe.DataSafeCli
 	return workRequestErr
 }
 
-func (s *DataSafeAuditTrailResourceCrud) Get() error {
+func (s *DataSafeAuditTrailResourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetAuditTrailRequest{}
 
 	tmp := s.D.Id()
@@ -448,7 +449,7 @@ func (s *DataSafeAuditTrailResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.GetAuditTrail(context.Background(), request)
+	response, err := s.Client.GetAuditTrail(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -457,7 +458,7 @@ func (s *DataSafeAuditTrailResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DataSafeAuditTrailResourceCrud) Update() error {
+func (s *DataSafeAuditTrailResourceCrud) Update(ctx context.Context) error {
 	request := oci_data_safe.UpdateAuditTrailRequest{}
 
 	tmp := s.D.Id()
@@ -492,16 +493,16 @@ func (s *DataSafeAuditTrailResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.UpdateAuditTrail(context.Background(), request)
+	response, err := s.Client.UpdateAuditTrail(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getAuditTrailFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getAuditTrailFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *DataSafeAuditTrailResourceCrud) Delete() error {
+func (s *DataSafeAuditTrailResourceCrud) Delete(ctx context.Context) error {
 	request := oci_data_safe.DeleteAuditTrailRequest{}
 
 	tmp := s.D.Id()
@@ -509,14 +510,14 @@ func (s *DataSafeAuditTrailResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.DeleteAuditTrail(context.Background(), request)
+	response, err := s.Client.DeleteAuditTrail(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := auditTrailWaitForWorkRequest(workId, "audittrail",
+	_, delWorkRequestErr := auditTrailWaitForWorkRequest(ctx, workId, "audittrail",
 		oci_data_safe.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -587,7 +588,7 @@ func (s *DataSafeAuditTrailResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *DataSafeAuditTrailResourceCrud) StartAuditTrail() error {
+func (s *DataSafeAuditTrailResourceCrud) StartAuditTrail(ctx context.Context) error {
 	request := oci_data_safe.StartAuditTrailRequest{}
 
 	tmp, err := time.Parse(time.RFC3339, "2021-10-01T00:00:00.000Z")
@@ -606,16 +607,16 @@ func (s *DataSafeAuditTrailResourceCrud) StartAuditTrail() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	_, err = s.Client.StartAuditTrail(context.Background(), request)
+	_, err = s.Client.StartAuditTrail(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_data_safe.AuditTrailLifecycleStateActive }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *DataSafeAuditTrailResourceCrud) StopAuditTrail() error {
+func (s *DataSafeAuditTrailResourceCrud) StopAuditTrail(ctx context.Context) error {
 	request := oci_data_safe.StopAuditTrailRequest{}
 
 	idTmp := s.D.Id()
@@ -623,16 +624,16 @@ func (s *DataSafeAuditTrailResourceCrud) StopAuditTrail() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	_, err := s.Client.StopAuditTrail(context.Background(), request)
+	_, err := s.Client.StopAuditTrail(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_data_safe.AuditTrailLifecycleStateInactive }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *DataSafeAuditTrailResourceCrud) ResumeAuditTrail() error {
+func (s *DataSafeAuditTrailResourceCrud) ResumeAuditTrail(ctx context.Context) error {
 	request := oci_data_safe.ResumeAuditTrailRequest{}
 
 	idTmp := s.D.Id()
@@ -640,12 +641,12 @@ func (s *DataSafeAuditTrailResourceCrud) ResumeAuditTrail() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	_, err := s.Client.ResumeAuditTrail(context.Background(), request)
+	_, err := s.Client.ResumeAuditTrail(ctx, request)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -653,7 +654,7 @@ func (s *DataSafeAuditTrailResourceCrud) ResumeAuditTrail() error {
 	s.D.Set("resume_trigger", val)
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_data_safe.AuditTrailLifecycleStateActive }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func AuditTrailSummaryToMap(obj oci_data_safe.AuditTrailSummary) map[string]interface{} {
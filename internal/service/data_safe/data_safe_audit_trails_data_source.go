@@ -6,6 +6,7 @@ package data_safe
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 
@@ -15,7 +16,7 @@ import (
 
 func DataSafeAuditTrailsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeAuditTrails,
+		ReadContext: readDataSafeAuditTrails,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -68,12 +69,12 @@ func DataSafeAuditTrailsDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeAuditTrails(d *schema.ResourceData, m interface{}) error {
+func readDataSafeAuditTrails(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeAuditTrailsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeAuditTrailsDataSourceCrud struct {
@@ -86,7 +87,7 @@ func (s *DataSafeAuditTrailsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeAuditTrailsDataSourceCrud) Get() error {
+func (s *DataSafeAuditTrailsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListAuditTrailsRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -128,7 +129,7 @@ func (s *DataSafeAuditTrailsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListAuditTrails(context.Background(), request)
+	response, err := s.Client.ListAuditTrails(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -137,7 +138,7 @@ func (s *DataSafeAuditTrailsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAuditTrails(context.Background(), request)
+		listResponse, err := s.Client.ListAuditTrails(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,10 +25,10 @@ func DataSafeCompareSecurityAssessmentResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataSafeCompareSecurityAssessment,
-		Read:     readDataSafeCompareSecurityAssessment,
-		Delete:   deleteDataSafeCompareSecurityAssessment,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataSafeCompareSecurityAssessment,
+		ReadContext:   readDataSafeCompareSecurityAssessment,
+		DeleteContext: deleteDataSafeCompareSecurityAssessment,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"comparison_security_assessment_id": {
@@ -48,19 +49,19 @@ func DataSafeCompareSecurityAssessmentResource() *schema.Resource {
 	}
 }
 
-func createDataSafeCompareSecurityAssessment(d *schema.ResourceData, m interface{}) error {
+func createDataSafeCompareSecurityAssessment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeCompareSecurityAssessmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDataSafeCompareSecurityAssessment(d *schema.ResourceData, m interface{}) error {
+func readDataSafeCompareSecurityAssessment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteDataSafeCompareSecurityAssessment(d *schema.ResourceData, m interface{}) error {
+func deleteDataSafeCompareSecurityAssessment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -75,7 +76,7 @@ func (s *DataSafeCompareSecurityAssessmentResourceCrud) ID() string {
 	return *s.Res.OpcRequestId
 }
 
-func (s *DataSafeCompareSecurityAssessmentResourceCrud) Get() error {
+func (s *DataSafeCompareSecurityAssessmentResourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetSecurityAssessmentComparisonRequest{}
 
 	if comparisonSecurityAssessmentId, ok := s.D.GetOkExists("comparison_security_assessment_id"); ok {
@@ -90,7 +91,7 @@ func (s *DataSafeCompareSecurityAssessmentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.GetSecurityAssessmentComparison(context.Background(), request)
+	response, err := s.Client.GetSecurityAssessmentComparison(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -99,7 +100,7 @@ func (s *DataSafeCompareSecurityAssessmentResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DataSafeCompareSecurityAssessmentResourceCrud) Create() error {
+func (s *DataSafeCompareSecurityAssessmentResourceCrud) Create(ctx context.Context) error {
 	request := oci_data_safe.CompareSecurityAssessmentRequest{}
 
 	if comparisonSecurityAssessmentId, ok := s.D.GetOkExists("comparison_security_assessment_id"); ok {
@@ -114,20 +115,20 @@ func (s *DataSafeCompareSecurityAssessmentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.CompareSecurityAssessment(context.Background(), request)
+	response, err := s.Client.CompareSecurityAssessment(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getCompareSecurityAssessmentFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getCompareSecurityAssessmentFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *DataSafeCompareSecurityAssessmentResourceCrud) getCompareSecurityAssessmentFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *DataSafeCompareSecurityAssessmentResourceCrud) getCompareSecurityAssessmentFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_data_safe.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	compareSecurityAssessmentId, err := compareSecurityAssessmentWaitForWorkRequest(workId, "securityassessment",
+	compareSecurityAssessmentId, err := compareSecurityAssessmentWaitForWorkRequest(ctx, workId, "securityassessment",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -135,7 +136,7 @@ func (s *DataSafeCompareSecurityAssessmentResourceCrud) getCompareSecurityAssess
 	}
 	s.D.SetId(*compareSecurityAssessmentId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func compareSecurityAssessmentWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -161,7 +162,7 @@ func compareSecurityAssessmentWorkRequestShouldRetryFunc(timeout time.Duration)
 	}
 }
 
-func compareSecurityAssessmentWaitForWorkRequest(wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
+func compareSecurityAssessmentWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_data_safe.DataSafeClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "data_safe")
 	retryPolicy.ShouldRetryOperation = compareSecurityAssessmentWorkRequestShouldRetryFunc(timeout)
@@ -178,7 +179,7 @@ func compareSecurityAssessmentWaitForWorkRequest(wId *string, entityType string,
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -207,14 +208,14 @@ func compareSecurityAssessmentWaitForWorkRequest(wId *string, entityType string,
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_data_safe.WorkRequestStatusFailed {
-		return nil, getErrorFromDataSafeCompareSecurityAssessmentWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDataSafeCompareSecurityAssessmentWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDataSafeCompareSecurityAssessmentWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDataSafeCompareSecurityAssessmentWorkRequest(ctx context.Context, client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -6,6 +6,7 @@ package data_safe
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 
@@ -15,7 +16,7 @@ import (
 
 func DataSafeCompatibleFormatsForDataTypeDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDataSafeCompatibleFormatsForDataType,
+		ReadContext: readSingularDataSafeCompatibleFormatsForDataType,
 		Schema: map[string]*schema.Schema{
 			// Computed
 			"formats_for_data_type": {
@@ -64,12 +65,12 @@ func DataSafeCompatibleFormatsForDataTypeDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDataSafeCompatibleFormatsForDataType(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeCompatibleFormatsForDataType(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeCompatibleFormatsForDataTypeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeCompatibleFormatsForDataTypeDataSourceCrud struct {
@@ -82,12 +83,12 @@ func (s *DataSafeCompatibleFormatsForDataTypeDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeCompatibleFormatsForDataTypeDataSourceCrud) Get() error {
+func (s *DataSafeCompatibleFormatsForDataTypeDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetCompatibleFormatsForDataTypesRequest{}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.GetCompatibleFormatsForDataTypes(context.Background(), request)
+	response, err := s.Client.GetCompatibleFormatsForDataTypes(ctx, request)
 	if err != nil {
 		return err
 	}
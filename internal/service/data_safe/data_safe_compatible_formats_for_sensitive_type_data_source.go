@@ -6,6 +6,7 @@ package data_safe
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 
@@ -15,7 +16,7 @@ import (
 
 func DataSafeCompatibleFormatsForSensitiveTypeDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDataSafeCompatibleFormatsForSensitiveType,
+		ReadContext: readSingularDataSafeCompatibleFormatsForSensitiveType,
 		Schema: map[string]*schema.Schema{
 			"access_level": {
 				Type:     schema.TypeString,
@@ -76,12 +77,12 @@ func DataSafeCompatibleFormatsForSensitiveTypeDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDataSafeCompatibleFormatsForSensitiveType(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeCompatibleFormatsForSensitiveType(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeCompatibleFormatsForSensitiveTypeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeCompatibleFormatsForSensitiveTypeDataSourceCrud struct {
@@ -94,7 +95,7 @@ func (s *DataSafeCompatibleFormatsForSensitiveTypeDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeCompatibleFormatsForSensitiveTypeDataSourceCrud) Get() error {
+func (s *DataSafeCompatibleFormatsForSensitiveTypeDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetCompatibleFormatsForSensitiveTypesRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -113,7 +114,7 @@ func (s *DataSafeCompatibleFormatsForSensitiveTypeDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.GetCompatibleFormatsForSensitiveTypes(context.Background(), request)
+	response, err := s.Client.GetCompatibleFormatsForSensitiveTypes(ctx, request)
 	if err != nil {
 		return err
 	}
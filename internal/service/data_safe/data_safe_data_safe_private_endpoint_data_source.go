@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 )
@@ -22,12 +23,12 @@ func DataSafeDataSafePrivateEndpointDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DataSafeDataSafePrivateEndpointResource(), fieldMap, readSingularDataSafeDataSafePrivateEndpoint)
 }
 
-func readSingularDataSafeDataSafePrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeDataSafePrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeDataSafePrivateEndpointDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeDataSafePrivateEndpointDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DataSafeDataSafePrivateEndpointDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeDataSafePrivateEndpointDataSourceCrud) Get() error {
+func (s *DataSafeDataSafePrivateEndpointDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetDataSafePrivateEndpointRequest{}
 
 	if dataSafePrivateEndpointId, ok := s.D.GetOkExists("data_safe_private_endpoint_id"); ok {
@@ -50,7 +51,7 @@ func (s *DataSafeDataSafePrivateEndpointDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.GetDataSafePrivateEndpoint(context.Background(), request)
+	response, err := s.Client.GetDataSafePrivateEndpoint(ctx, request)
 	if err != nil {
 		return err
 	}
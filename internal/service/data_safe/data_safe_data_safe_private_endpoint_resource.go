@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -25,11 +26,11 @@ func DataSafeDataSafePrivateEndpointResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataSafeDataSafePrivateEndpoint,
-		Read:     readDataSafeDataSafePrivateEndpoint,
-		Update:   updateDataSafeDataSafePrivateEndpoint,
-		Delete:   deleteDataSafeDataSafePrivateEndpoint,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataSafeDataSafePrivateEndpoint,
+		ReadContext:   readDataSafeDataSafePrivateEndpoint,
+		UpdateContext: updateDataSafeDataSafePrivateEndpoint,
+		DeleteContext: deleteDataSafeDataSafePrivateEndpoint,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -112,37 +113,37 @@ func DataSafeDataSafePrivateEndpointResource() *schema.Resource {
 	}
 }
 
-func createDataSafeDataSafePrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func createDataSafeDataSafePrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeDataSafePrivateEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDataSafeDataSafePrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func readDataSafeDataSafePrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeDataSafePrivateEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDataSafeDataSafePrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func updateDataSafeDataSafePrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeDataSafePrivateEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDataSafeDataSafePrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func deleteDataSafeDataSafePrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeDataSafePrivateEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DataSafeDataSafePrivateEndpointResourceCrud struct {
@@ -180,7 +181,7 @@ func (s *DataSafeDataSafePrivateEndpointResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DataSafeDataSafePrivateEndpointResourceCrud) Create() error {
+func (s *DataSafeDataSafePrivateEndpointResourceCrud) Create(ctx context.Context) error {
 	request := oci_data_safe.CreateDataSafePrivateEndpointRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -241,20 +242,20 @@ func (s *DataSafeDataSafePrivateEndpointResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.CreateDataSafePrivateEndpoint(context.Background(), request)
+	response, err := s.Client.CreateDataSafePrivateEndpoint(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDataSafePrivateEndpointFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getDataSafePrivateEndpointFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *DataSafeDataSafePrivateEndpointResourceCrud) getDataSafePrivateEndpointFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *DataSafeDataSafePrivateEndpointResourceCrud) getDataSafePrivateEndpointFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_data_safe.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	dataSafePrivateEndpointId, err := dataSafePrivateEndpointWaitForWorkRequest(workId, "datasafeprivateendpoints",
+	dataSafePrivateEndpointId, err := dataSafePrivateEndpointWaitForWorkRequest(ctx, workId, "datasafeprivateendpoints",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -263,7 +264,7 @@ func (s *DataSafeDataSafePrivateEndpointResourceCrud) getDataSafePrivateEndpoint
 	}
 	s.D.SetId(*dataSafePrivateEndpointId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func dataSafePrivateEndpointWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -289,7 +290,7 @@ func dataSafePrivateEndpointWorkRequestShouldRetryFunc(timeout time.Duration) fu
 	}
 }
 
-func dataSafePrivateEndpointWaitForWorkRequest(wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
+func dataSafePrivateEndpointWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_data_safe.DataSafeClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "data_safe")
 	retryPolicy.ShouldRetryOperation = dataSafePrivateEndpointWorkRequestShouldRetryFunc(timeout)
@@ -306,7 +307,7 @@ func dataSafePrivateEndpointWaitForWorkRequest(wId *string, entityType string, a
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -335,14 +336,14 @@ func dataSafePrivateEndpointWaitForWorkRequest(wId *string, entityType string, a
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_data_safe.WorkRequestStatusFailed {
-		return nil, getErrorFromDataSafeDataSafePrivateEndpointWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDataSafeDataSafePrivateEndpointWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDataSafeDataSafePrivateEndpointWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDataSafeDataSafePrivateEndpointWorkRequest(ctx context.Context, client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -364,7 +365,7 @@ func getErrorFromDataSafeDataSafePrivateEndpointWorkRequest(client *oci_data_saf
 	return workRequestErr
 }
 
-func (s *DataSafeDataSafePrivateEndpointResourceCrud) Get() error {
+func (s *DataSafeDataSafePrivateEndpointResourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetDataSafePrivateEndpointRequest{}
 
 	tmp := s.D.Id()
@@ -372,7 +373,7 @@ func (s *DataSafeDataSafePrivateEndpointResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.GetDataSafePrivateEndpoint(context.Background(), request)
+	response, err := s.Client.GetDataSafePrivateEndpoint(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -381,11 +382,11 @@ func (s *DataSafeDataSafePrivateEndpointResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DataSafeDataSafePrivateEndpointResourceCrud) Update() error {
+func (s *DataSafeDataSafePrivateEndpointResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -434,16 +435,16 @@ func (s *DataSafeDataSafePrivateEndpointResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.UpdateDataSafePrivateEndpoint(context.Background(), request)
+	response, err := s.Client.UpdateDataSafePrivateEndpoint(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDataSafePrivateEndpointFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getDataSafePrivateEndpointFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *DataSafeDataSafePrivateEndpointResourceCrud) Delete() error {
+func (s *DataSafeDataSafePrivateEndpointResourceCrud) Delete(ctx context.Context) error {
 	request := oci_data_safe.DeleteDataSafePrivateEndpointRequest{}
 
 	tmp := s.D.Id()
@@ -451,14 +452,14 @@ func (s *DataSafeDataSafePrivateEndpointResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.DeleteDataSafePrivateEndpoint(context.Background(), request)
+	response, err := s.Client.DeleteDataSafePrivateEndpoint(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := dataSafePrivateEndpointWaitForWorkRequest(workId, "datasafeprivateendpoints",
+	_, delWorkRequestErr := dataSafePrivateEndpointWaitForWorkRequest(ctx, workId, "datasafeprivateendpoints",
 		oci_data_safe.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -521,7 +522,7 @@ func (s *DataSafeDataSafePrivateEndpointResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *DataSafeDataSafePrivateEndpointResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DataSafeDataSafePrivateEndpointResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_data_safe.ChangeDataSafePrivateEndpointCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -532,11 +533,11 @@ func (s *DataSafeDataSafePrivateEndpointResourceCrud) updateCompartment(compartm
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.ChangeDataSafePrivateEndpointCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeDataSafePrivateEndpointCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDataSafePrivateEndpointFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datasafeprivateendpoints"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getDataSafePrivateEndpointFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datasafeprivateendpoints"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
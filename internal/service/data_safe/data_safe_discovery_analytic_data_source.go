@@ -6,6 +6,7 @@ package data_safe
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 
@@ -15,7 +16,7 @@ import (
 
 func DataSafeDiscoveryAnalyticDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDataSafeDiscoveryAnalytic,
+		ReadContext: readSingularDataSafeDiscoveryAnalytic,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -84,12 +85,12 @@ func DataSafeDiscoveryAnalyticDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDataSafeDiscoveryAnalytic(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeDiscoveryAnalytic(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeDiscoveryAnalyticDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeDiscoveryAnalyticDataSourceCrud struct {
@@ -102,7 +103,7 @@ func (s *DataSafeDiscoveryAnalyticDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeDiscoveryAnalyticDataSourceCrud) Get() error {
+func (s *DataSafeDiscoveryAnalyticDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListDiscoveryAnalyticsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -131,7 +132,7 @@ func (s *DataSafeDiscoveryAnalyticDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListDiscoveryAnalytics(context.Background(), request)
+	response, err := s.Client.ListDiscoveryAnalytics(ctx, request)
 	if err != nil {
 		return err
 	}
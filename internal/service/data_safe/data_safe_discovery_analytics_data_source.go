@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 
@@ -16,7 +17,7 @@ import (
 
 func DataSafeDiscoveryAnalyticsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeDiscoveryAnalytics,
+		ReadContext: readDataSafeDiscoveryAnalytics,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -98,12 +99,12 @@ func DataSafeDiscoveryAnalyticsDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeDiscoveryAnalytics(d *schema.ResourceData, m interface{}) error {
+func readDataSafeDiscoveryAnalytics(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeDiscoveryAnalyticsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeDiscoveryAnalyticsDataSourceCrud struct {
@@ -116,7 +117,7 @@ func (s *DataSafeDiscoveryAnalyticsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeDiscoveryAnalyticsDataSourceCrud) Get() error {
+func (s *DataSafeDiscoveryAnalyticsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListDiscoveryAnalyticsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -145,7 +146,7 @@ func (s *DataSafeDiscoveryAnalyticsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListDiscoveryAnalytics(context.Background(), request)
+	response, err := s.Client.ListDiscoveryAnalytics(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -154,7 +155,7 @@ func (s *DataSafeDiscoveryAnalyticsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDiscoveryAnalytics(context.Background(), request)
+		listResponse, err := s.Client.ListDiscoveryAnalytics(ctx, request)
 		if err != nil {
 			return err
 		}
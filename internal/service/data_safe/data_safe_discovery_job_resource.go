@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -26,11 +27,11 @@ func DataSafeDiscoveryJobResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataSafeDiscoveryJob,
-		Read:     readDataSafeDiscoveryJob,
-		Update:   updateDataSafeDiscoveryJob,
-		Delete:   deleteDataSafeDiscoveryJob,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataSafeDiscoveryJob,
+		ReadContext:   readDataSafeDiscoveryJob,
+		UpdateContext: updateDataSafeDiscoveryJob,
+		DeleteContext: deleteDataSafeDiscoveryJob,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -164,37 +165,37 @@ func DataSafeDiscoveryJobResource() *schema.Resource {
 	}
 }
 
-func createDataSafeDiscoveryJob(d *schema.ResourceData, m interface{}) error {
+func createDataSafeDiscoveryJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeDiscoveryJobResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDataSafeDiscoveryJob(d *schema.ResourceData, m interface{}) error {
+func readDataSafeDiscoveryJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeDiscoveryJobResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDataSafeDiscoveryJob(d *schema.ResourceData, m interface{}) error {
+func updateDataSafeDiscoveryJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeDiscoveryJobResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDataSafeDiscoveryJob(d *schema.ResourceData, m interface{}) error {
+func deleteDataSafeDiscoveryJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeDiscoveryJobResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DataSafeDiscoveryJobResourceCrud struct {
@@ -232,7 +233,7 @@ func (s *DataSafeDiscoveryJobResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DataSafeDiscoveryJobResourceCrud) Create() error {
+func (s *DataSafeDiscoveryJobResourceCrud) Create(ctx context.Context) error {
 	request := oci_data_safe.CreateDiscoveryJobRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -314,26 +315,26 @@ func (s *DataSafeDiscoveryJobResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.CreateDiscoveryJob(context.Background(), request)
+	response, err := s.Client.CreateDiscoveryJob(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDiscoveryJobFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getDiscoveryJobFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *DataSafeDiscoveryJobResourceCrud) getDiscoveryJobFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *DataSafeDiscoveryJobResourceCrud) getDiscoveryJobFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_data_safe.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	discoveryJobId, err := discoveryJobWaitForWorkRequest(workId, "discoveryjob",
+	discoveryJobId, err := discoveryJobWaitForWorkRequest(ctx, workId, "discoveryjob",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, discoveryJobId)
-		_, cancelErr := s.Client.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.Client.CancelWorkRequest(ctx,
 			oci_data_safe.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -347,7 +348,7 @@ func (s *DataSafeDiscoveryJobResourceCrud) getDiscoveryJobFromWorkRequest(workId
 	}
 	s.D.SetId(*discoveryJobId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func discoveryJobWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -373,7 +374,7 @@ func discoveryJobWorkRequestShouldRetryFunc(timeout time.Duration) func(response
 	}
 }
 
-func discoveryJobWaitForWorkRequest(wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
+func discoveryJobWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_data_safe.DataSafeClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "data_safe")
 	retryPolicy.ShouldRetryOperation = discoveryJobWorkRequestShouldRetryFunc(timeout)
@@ -392,7 +393,7 @@ func discoveryJobWaitForWorkRequest(wId *string, entityType string, action oci_d
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -421,14 +422,14 @@ func discoveryJobWaitForWorkRequest(wId *string, entityType string, action oci_d
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_data_safe.WorkRequestStatusFailed || response.Status == oci_data_safe.WorkRequestStatusCanceled {
-		return nil, getErrorFromDataSafeDiscoveryJobWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDataSafeDiscoveryJobWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDataSafeDiscoveryJobWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDataSafeDiscoveryJobWorkRequest(ctx context.Context, client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -450,7 +451,7 @@ func getErrorFromDataSafeDiscoveryJobWorkRequest(client *oci_data_safe.DataSafeC
 	return workRequestErr
 }
 
-func (s *DataSafeDiscoveryJobResourceCrud) Get() error {
+func (s *DataSafeDiscoveryJobResourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetDiscoveryJobRequest{}
 
 	tmp := s.D.Id()
@@ -458,7 +459,7 @@ func (s *DataSafeDiscoveryJobResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.GetDiscoveryJob(context.Background(), request)
+	response, err := s.Client.GetDiscoveryJob(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -467,21 +468,21 @@ func (s *DataSafeDiscoveryJobResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DataSafeDiscoveryJobResourceCrud) Update() error {
+func (s *DataSafeDiscoveryJobResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
 		}
 	}
 	//s.D.SetId(*discoveryJobId)
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *DataSafeDiscoveryJobResourceCrud) Delete() error {
+func (s *DataSafeDiscoveryJobResourceCrud) Delete(ctx context.Context) error {
 	request := oci_data_safe.DeleteDiscoveryJobRequest{}
 
 	tmp := s.D.Id()
@@ -489,14 +490,14 @@ func (s *DataSafeDiscoveryJobResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.DeleteDiscoveryJob(context.Background(), request)
+	response, err := s.Client.DeleteDiscoveryJob(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := discoveryJobWaitForWorkRequest(workId, "discoveryjob",
+	_, delWorkRequestErr := discoveryJobWaitForWorkRequest(ctx, workId, "discoveryjob",
 		oci_data_safe.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -631,7 +632,7 @@ func DiscoveryJobSummaryToMap(obj oci_data_safe.DiscoveryJobSummary) map[string]
 	return result
 }
 
-func (s *DataSafeDiscoveryJobResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DataSafeDiscoveryJobResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_data_safe.ChangeDiscoveryJobCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -642,7 +643,7 @@ func (s *DataSafeDiscoveryJobResourceCrud) updateCompartment(compartment interfa
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	_, err := s.Client.ChangeDiscoveryJobCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeDiscoveryJobCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
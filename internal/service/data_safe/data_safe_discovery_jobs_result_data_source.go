@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 
@@ -27,12 +28,12 @@ func DataSafeDiscoveryJobsResultDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DataSafeDiscoveryJobsResultResource(), fieldMap, readSingularDataSafeDiscoveryJobsResult)
 }
 
-func readSingularDataSafeDiscoveryJobsResult(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeDiscoveryJobsResult(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeDiscoveryJobsResultDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeDiscoveryJobsResultDataSourceCrud struct {
@@ -45,7 +46,7 @@ func (s *DataSafeDiscoveryJobsResultDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeDiscoveryJobsResultDataSourceCrud) Get() error {
+func (s *DataSafeDiscoveryJobsResultDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetDiscoveryJobResultRequest{}
 
 	if discoveryJobId, ok := s.D.GetOkExists("discovery_job_id"); ok {
@@ -60,7 +61,7 @@ func (s *DataSafeDiscoveryJobsResultDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.GetDiscoveryJobResult(context.Background(), request)
+	response, err := s.Client.GetDiscoveryJobResult(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -25,10 +26,10 @@ func DataSafeDiscoveryJobsResultResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataSafeDiscoveryJobsResult,
-		Read:     readDataSafeDiscoveryJobsResult,
-		Delete:   deleteDataSafeDiscoveryJobsResult,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataSafeDiscoveryJobsResult,
+		ReadContext:   readDataSafeDiscoveryJobsResult,
+		DeleteContext: deleteDataSafeDiscoveryJobsResult,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"discovery_job_id": {
@@ -154,29 +155,29 @@ func DataSafeDiscoveryJobsResultResource() *schema.Resource {
 	}
 }
 
-func createDataSafeDiscoveryJobsResult(d *schema.ResourceData, m interface{}) error {
+func createDataSafeDiscoveryJobsResult(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeDiscoveryJobsResultResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDataSafeDiscoveryJobsResult(d *schema.ResourceData, m interface{}) error {
+func readDataSafeDiscoveryJobsResult(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeDiscoveryJobsResultResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteDataSafeDiscoveryJobsResult(d *schema.ResourceData, m interface{}) error {
+func deleteDataSafeDiscoveryJobsResult(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeDiscoveryJobsResultResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DataSafeDiscoveryJobsResultResourceCrud struct {
@@ -190,7 +191,7 @@ func (s *DataSafeDiscoveryJobsResultResourceCrud) ID() string {
 	return s.D.Id()
 }
 
-func (s *DataSafeDiscoveryJobsResultResourceCrud) Create() error {
+func (s *DataSafeDiscoveryJobsResultResourceCrud) Create(ctx context.Context) error {
 	request := oci_data_safe.ListDiscoveryJobResultsRequest{}
 
 	if discoveryJobId, ok := s.D.GetOkExists("discovery_job_id"); ok {
@@ -199,7 +200,7 @@ func (s *DataSafeDiscoveryJobsResultResourceCrud) Create() error {
 	}
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.ListDiscoveryJobResults(context.Background(), request)
+	response, err := s.Client.ListDiscoveryJobResults(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -211,10 +212,10 @@ func (s *DataSafeDiscoveryJobsResultResourceCrud) Create() error {
 	url := "discoveryJobs/" + *request.DiscoveryJobId + "/results/" + *resultKey
 	s.D.SetId(url)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *DataSafeDiscoveryJobsResultResourceCrud) Get() error {
+func (s *DataSafeDiscoveryJobsResultResourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetDiscoveryJobResultRequest{}
 
 	if discoveryJobId, ok := s.D.GetOkExists("discovery_job_id"); ok {
@@ -237,7 +238,7 @@ func (s *DataSafeDiscoveryJobsResultResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.GetDiscoveryJobResult(context.Background(), request)
+	response, err := s.Client.GetDiscoveryJobResult(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -246,7 +247,7 @@ func (s *DataSafeDiscoveryJobsResultResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DataSafeDiscoveryJobsResultResourceCrud) Delete() error {
+func (s *DataSafeDiscoveryJobsResultResourceCrud) Delete(ctx context.Context) error {
 	request := oci_data_safe.DeleteDiscoveryJobResultRequest{}
 
 	if discoveryJobId, ok := s.D.GetOkExists("discovery_job_id"); ok {
@@ -261,7 +262,7 @@ func (s *DataSafeDiscoveryJobsResultResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	_, err := s.Client.DeleteDiscoveryJobResult(context.Background(), request)
+	_, err := s.Client.DeleteDiscoveryJobResult(ctx, request)
 	return err
 }
 
@@ -6,6 +6,7 @@ package data_safe
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 
@@ -15,7 +16,7 @@ import (
 
 func DataSafeDiscoveryJobsResultsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeDiscoveryJobsResults,
+		ReadContext: readDataSafeDiscoveryJobsResults,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"column_name": {
@@ -73,12 +74,12 @@ func DataSafeDiscoveryJobsResultsDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeDiscoveryJobsResults(d *schema.ResourceData, m interface{}) error {
+func readDataSafeDiscoveryJobsResults(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeDiscoveryJobsResultsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeDiscoveryJobsResultsDataSourceCrud struct {
@@ -91,7 +92,7 @@ func (s *DataSafeDiscoveryJobsResultsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeDiscoveryJobsResultsDataSourceCrud) Get() error {
+func (s *DataSafeDiscoveryJobsResultsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListDiscoveryJobResultsRequest{}
 
 	if columnName, ok := s.D.GetOkExists("column_name"); ok {
@@ -153,7 +154,7 @@ func (s *DataSafeDiscoveryJobsResultsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListDiscoveryJobResults(context.Background(), request)
+	response, err := s.Client.ListDiscoveryJobResults(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -162,7 +163,7 @@ func (s *DataSafeDiscoveryJobsResultsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDiscoveryJobResults(context.Background(), request)
+		listResponse, err := s.Client.ListDiscoveryJobResults(ctx, request)
 		if err != nil {
 			return err
 		}
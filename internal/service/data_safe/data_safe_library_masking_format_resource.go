@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -27,11 +28,11 @@ func DataSafeLibraryMaskingFormatResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataSafeLibraryMaskingFormat,
-		Read:     readDataSafeLibraryMaskingFormat,
-		Update:   updateDataSafeLibraryMaskingFormat,
-		Delete:   deleteDataSafeLibraryMaskingFormat,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataSafeLibraryMaskingFormat,
+		ReadContext:   readDataSafeLibraryMaskingFormat,
+		UpdateContext: updateDataSafeLibraryMaskingFormat,
+		DeleteContext: deleteDataSafeLibraryMaskingFormat,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -254,37 +255,37 @@ func DataSafeLibraryMaskingFormatResource() *schema.Resource {
 	}
 }
 
-func createDataSafeLibraryMaskingFormat(d *schema.ResourceData, m interface{}) error {
+func createDataSafeLibraryMaskingFormat(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeLibraryMaskingFormatResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDataSafeLibraryMaskingFormat(d *schema.ResourceData, m interface{}) error {
+func readDataSafeLibraryMaskingFormat(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeLibraryMaskingFormatResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDataSafeLibraryMaskingFormat(d *schema.ResourceData, m interface{}) error {
+func updateDataSafeLibraryMaskingFormat(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeLibraryMaskingFormatResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDataSafeLibraryMaskingFormat(d *schema.ResourceData, m interface{}) error {
+func deleteDataSafeLibraryMaskingFormat(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeLibraryMaskingFormatResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DataSafeLibraryMaskingFormatResourceCrud struct {
@@ -323,7 +324,7 @@ func (s *DataSafeLibraryMaskingFormatResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DataSafeLibraryMaskingFormatResourceCrud) Create() error {
+func (s *DataSafeLibraryMaskingFormatResourceCrud) Create(ctx context.Context) error {
 	request := oci_data_safe.CreateLibraryMaskingFormatRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -385,26 +386,26 @@ func (s *DataSafeLibraryMaskingFormatResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.CreateLibraryMaskingFormat(context.Background(), request)
+	response, err := s.Client.CreateLibraryMaskingFormat(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getLibraryMaskingFormatFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeCreated, "lmfcreate", s.D.Timeout(schema.TimeoutCreate))
+	return s.getLibraryMaskingFormatFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeCreated, "lmfcreate", s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *DataSafeLibraryMaskingFormatResourceCrud) getLibraryMaskingFormatFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *DataSafeLibraryMaskingFormatResourceCrud) getLibraryMaskingFormatFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_data_safe.WorkRequestResourceActionTypeEnum, entityType string, timeout time.Duration) error {
 
 	// Wait until it finishes
-	libraryMaskingFormatId, err := libraryMaskingFormatWaitForWorkRequest(workId, entityType,
+	libraryMaskingFormatId, err := libraryMaskingFormatWaitForWorkRequest(ctx, workId, entityType,
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, libraryMaskingFormatId)
-		_, cancelErr := s.Client.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.Client.CancelWorkRequest(ctx,
 			oci_data_safe.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -418,7 +419,7 @@ func (s *DataSafeLibraryMaskingFormatResourceCrud) getLibraryMaskingFormatFromWo
 	}
 	s.D.SetId(*libraryMaskingFormatId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func libraryMaskingFormatWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -444,7 +445,7 @@ func libraryMaskingFormatWorkRequestShouldRetryFunc(timeout time.Duration) func(
 	}
 }
 
-func libraryMaskingFormatWaitForWorkRequest(wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
+func libraryMaskingFormatWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_data_safe.DataSafeClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "data_safe")
 	retryPolicy.ShouldRetryOperation = libraryMaskingFormatWorkRequestShouldRetryFunc(timeout)
@@ -463,7 +464,7 @@ func libraryMaskingFormatWaitForWorkRequest(wId *string, entityType string, acti
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -492,14 +493,14 @@ func libraryMaskingFormatWaitForWorkRequest(wId *string, entityType string, acti
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_data_safe.WorkRequestStatusFailed || response.Status == oci_data_safe.WorkRequestStatusCanceled {
-		return nil, getErrorFromDataSafeLibraryMaskingFormatWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDataSafeLibraryMaskingFormatWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDataSafeLibraryMaskingFormatWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDataSafeLibraryMaskingFormatWorkRequest(ctx context.Context, client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -521,7 +522,7 @@ func getErrorFromDataSafeLibraryMaskingFormatWorkRequest(client *oci_data_safe.D
 	return workRequestErr
 }
 
-func (s *DataSafeLibraryMaskingFormatResourceCrud) Get() error {
+func (s *DataSafeLibraryMaskingFormatResourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetLibraryMaskingFormatRequest{}
 
 	tmp := s.D.Id()
@@ -529,7 +530,7 @@ func (s *DataSafeLibraryMaskingFormatResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.GetLibraryMaskingFormat(context.Background(), request)
+	response, err := s.Client.GetLibraryMaskingFormat(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -538,11 +539,11 @@ func (s *DataSafeLibraryMaskingFormatResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DataSafeLibraryMaskingFormatResourceCrud) Update() error {
+func (s *DataSafeLibraryMaskingFormatResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -607,16 +608,16 @@ func (s *DataSafeLibraryMaskingFormatResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.UpdateLibraryMaskingFormat(context.Background(), request)
+	response, err := s.Client.UpdateLibraryMaskingFormat(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getLibraryMaskingFormatFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, "updatelmfwf", s.D.Timeout(schema.TimeoutUpdate))
+	return s.getLibraryMaskingFormatFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, "updatelmfwf", s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *DataSafeLibraryMaskingFormatResourceCrud) Delete() error {
+func (s *DataSafeLibraryMaskingFormatResourceCrud) Delete(ctx context.Context) error {
 	request := oci_data_safe.DeleteLibraryMaskingFormatRequest{}
 
 	tmp := s.D.Id()
@@ -624,7 +625,7 @@ func (s *DataSafeLibraryMaskingFormatResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	_, err := s.Client.DeleteLibraryMaskingFormat(context.Background(), request)
+	_, err := s.Client.DeleteLibraryMaskingFormat(ctx, request)
 	return err
 }
 
@@ -1319,7 +1320,7 @@ func LibraryMaskingFormatSummaryToMap(obj oci_data_safe.LibraryMaskingFormatSumm
 	return result
 }
 
-func (s *DataSafeLibraryMaskingFormatResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DataSafeLibraryMaskingFormatResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_data_safe.ChangeLibraryMaskingFormatCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -1330,12 +1331,12 @@ func (s *DataSafeLibraryMaskingFormatResourceCrud) updateCompartment(compartment
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	_, err := s.Client.ChangeLibraryMaskingFormatCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeLibraryMaskingFormatCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
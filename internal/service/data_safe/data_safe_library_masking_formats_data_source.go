@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
@@ -17,7 +18,7 @@ import (
 
 func DataSafeLibraryMaskingFormatsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeLibraryMaskingFormats,
+		ReadContext: readDataSafeLibraryMaskingFormats,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -74,12 +75,12 @@ func DataSafeLibraryMaskingFormatsDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeLibraryMaskingFormats(d *schema.ResourceData, m interface{}) error {
+func readDataSafeLibraryMaskingFormats(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeLibraryMaskingFormatsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeLibraryMaskingFormatsDataSourceCrud struct {
@@ -92,7 +93,7 @@ func (s *DataSafeLibraryMaskingFormatsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeLibraryMaskingFormatsDataSourceCrud) Get() error {
+func (s *DataSafeLibraryMaskingFormatsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListLibraryMaskingFormatsRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -145,7 +146,7 @@ func (s *DataSafeLibraryMaskingFormatsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListLibraryMaskingFormats(context.Background(), request)
+	response, err := s.Client.ListLibraryMaskingFormats(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -154,7 +155,7 @@ func (s *DataSafeLibraryMaskingFormatsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListLibraryMaskingFormats(context.Background(), request)
+		listResponse, err := s.Client.ListLibraryMaskingFormats(ctx, request)
 		if err != nil {
 			return err
 		}
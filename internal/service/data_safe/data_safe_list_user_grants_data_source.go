@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 )
 
 func DataSafeListUserGrantsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeListUserGrants,
+		ReadContext: readDataSafeListUserGrants,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"depth_level": {
@@ -91,12 +92,12 @@ func DataSafeListUserGrantsDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeListUserGrants(d *schema.ResourceData, m interface{}) error {
+func readDataSafeListUserGrants(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeListUserGrantsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeListUserGrantsDataSourceCrud struct {
@@ -109,7 +110,7 @@ func (s *DataSafeListUserGrantsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeListUserGrantsDataSourceCrud) Get() error {
+func (s *DataSafeListUserGrantsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListGrantsRequest{}
 
 	if depthLevel, ok := s.D.GetOkExists("depth_level"); ok {
@@ -159,7 +160,7 @@ func (s *DataSafeListUserGrantsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListGrants(context.Background(), request)
+	response, err := s.Client.ListGrants(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -168,7 +169,7 @@ func (s *DataSafeListUserGrantsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListGrants(context.Background(), request)
+		listResponse, err := s.Client.ListGrants(ctx, request)
 		if err != nil {
 			return err
 		}
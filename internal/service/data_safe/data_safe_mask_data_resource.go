@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,10 +25,10 @@ func DataSafeMaskDataResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataSafeMaskData,
-		Read:     readDataSafeMaskData,
-		Delete:   deleteDataSafeMaskData,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataSafeMaskData,
+		ReadContext:   readDataSafeMaskData,
+		DeleteContext: deleteDataSafeMaskData,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"masking_policy_id": {
@@ -48,19 +49,19 @@ func DataSafeMaskDataResource() *schema.Resource {
 	}
 }
 
-func createDataSafeMaskData(d *schema.ResourceData, m interface{}) error {
+func createDataSafeMaskData(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeMaskDataResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDataSafeMaskData(d *schema.ResourceData, m interface{}) error {
+func readDataSafeMaskData(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteDataSafeMaskData(d *schema.ResourceData, m interface{}) error {
+func deleteDataSafeMaskData(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -75,7 +76,7 @@ func (s *DataSafeMaskDataResourceCrud) ID() string {
 	return *s.Res.OpcRequestId
 }
 
-func (s *DataSafeMaskDataResourceCrud) Get() error {
+func (s *DataSafeMaskDataResourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetMaskingPolicyRequest{}
 
 	if maskingPolicyId, ok := s.D.GetOkExists("masking_policy_id"); ok {
@@ -85,7 +86,7 @@ func (s *DataSafeMaskDataResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.GetMaskingPolicy(context.Background(), request)
+	response, err := s.Client.GetMaskingPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *DataSafeMaskDataResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DataSafeMaskDataResourceCrud) Create() error {
+func (s *DataSafeMaskDataResourceCrud) Create(ctx context.Context) error {
 	request := oci_data_safe.MaskDataRequest{}
 
 	if maskingPolicyId, ok := s.D.GetOkExists("masking_policy_id"); ok {
@@ -109,20 +110,20 @@ func (s *DataSafeMaskDataResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.MaskData(context.Background(), request)
+	response, err := s.Client.MaskData(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getMaskDataFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getMaskDataFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *DataSafeMaskDataResourceCrud) getMaskDataFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *DataSafeMaskDataResourceCrud) getMaskDataFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_data_safe.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	maskingPolicyId, err := maskDataWaitForWorkRequest(workId, "maskingpolicy",
+	maskingPolicyId, err := maskDataWaitForWorkRequest(ctx, workId, "maskingpolicy",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -130,7 +131,7 @@ func (s *DataSafeMaskDataResourceCrud) getMaskDataFromWorkRequest(workId *string
 	}
 	s.D.SetId(*maskingPolicyId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func maskDataWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -156,7 +157,7 @@ func maskDataWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci
 	}
 }
 
-func maskDataWaitForWorkRequest(wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
+func maskDataWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_data_safe.DataSafeClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "data_safe")
 	retryPolicy.ShouldRetryOperation = maskDataWorkRequestShouldRetryFunc(timeout)
@@ -173,7 +174,7 @@ func maskDataWaitForWorkRequest(wId *string, entityType string, action oci_data_
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -202,14 +203,14 @@ func maskDataWaitForWorkRequest(wId *string, entityType string, action oci_data_
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_data_safe.WorkRequestStatusFailed {
-		return nil, getErrorFromDataSafeMaskDataWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDataSafeMaskDataWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDataSafeMaskDataWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDataSafeMaskDataWorkRequest(ctx context.Context, client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 
@@ -16,7 +17,7 @@ import (
 
 func DataSafeMaskingAnalyticDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDataSafeMaskingAnalytic,
+		ReadContext: readSingularDataSafeMaskingAnalytic,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -85,12 +86,12 @@ func DataSafeMaskingAnalyticDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDataSafeMaskingAnalytic(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeMaskingAnalytic(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeMaskingAnalyticDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeMaskingAnalyticDataSourceCrud struct {
@@ -103,7 +104,7 @@ func (s *DataSafeMaskingAnalyticDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeMaskingAnalyticDataSourceCrud) Get() error {
+func (s *DataSafeMaskingAnalyticDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListMaskingAnalyticsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -132,7 +133,7 @@ func (s *DataSafeMaskingAnalyticDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListMaskingAnalytics(context.Background(), request)
+	response, err := s.Client.ListMaskingAnalytics(ctx, request)
 	if err != nil {
 		return err
 	}
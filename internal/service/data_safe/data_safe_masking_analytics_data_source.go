@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 
@@ -16,7 +17,7 @@ import (
 
 func DataSafeMaskingAnalyticsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeMaskingAnalytics,
+		ReadContext: readDataSafeMaskingAnalytics,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -93,12 +94,12 @@ func DataSafeMaskingAnalyticsDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeMaskingAnalytics(d *schema.ResourceData, m interface{}) error {
+func readDataSafeMaskingAnalytics(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeMaskingAnalyticsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeMaskingAnalyticsDataSourceCrud struct {
@@ -111,7 +112,7 @@ func (s *DataSafeMaskingAnalyticsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeMaskingAnalyticsDataSourceCrud) Get() error {
+func (s *DataSafeMaskingAnalyticsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListMaskingAnalyticsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -140,7 +141,7 @@ func (s *DataSafeMaskingAnalyticsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListMaskingAnalytics(context.Background(), request)
+	response, err := s.Client.ListMaskingAnalytics(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -149,7 +150,7 @@ func (s *DataSafeMaskingAnalyticsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListMaskingAnalytics(context.Background(), request)
+		listResponse, err := s.Client.ListMaskingAnalytics(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
@@ -17,7 +18,7 @@ import (
 
 func DataSafeMaskingPoliciesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeMaskingPolicies,
+		ReadContext: readDataSafeMaskingPolicies,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -78,12 +79,12 @@ func DataSafeMaskingPoliciesDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeMaskingPolicies(d *schema.ResourceData, m interface{}) error {
+func readDataSafeMaskingPolicies(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeMaskingPoliciesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeMaskingPoliciesDataSourceCrud struct {
@@ -96,7 +97,7 @@ func (s *DataSafeMaskingPoliciesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeMaskingPoliciesDataSourceCrud) Get() error {
+func (s *DataSafeMaskingPoliciesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListMaskingPoliciesRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -155,7 +156,7 @@ func (s *DataSafeMaskingPoliciesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListMaskingPolicies(context.Background(), request)
+	response, err := s.Client.ListMaskingPolicies(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -164,7 +165,7 @@ func (s *DataSafeMaskingPoliciesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListMaskingPolicies(context.Background(), request)
+		listResponse, err := s.Client.ListMaskingPolicies(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -13,6 +13,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -29,11 +30,11 @@ func DataSafeMaskingPoliciesMaskingColumnResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataSafeMaskingPoliciesMaskingColumn,
-		Read:     readDataSafeMaskingPoliciesMaskingColumn,
-		Update:   updateDataSafeMaskingPoliciesMaskingColumn,
-		Delete:   deleteDataSafeMaskingPoliciesMaskingColumn,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataSafeMaskingPoliciesMaskingColumn,
+		ReadContext:   readDataSafeMaskingPoliciesMaskingColumn,
+		UpdateContext: updateDataSafeMaskingPoliciesMaskingColumn,
+		DeleteContext: deleteDataSafeMaskingPoliciesMaskingColumn,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"column_name": {
@@ -300,37 +301,37 @@ func DataSafeMaskingPoliciesMaskingColumnResource() *schema.Resource {
 	}
 }
 
-func createDataSafeMaskingPoliciesMaskingColumn(d *schema.ResourceData, m interface{}) error {
+func createDataSafeMaskingPoliciesMaskingColumn(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeMaskingPoliciesMaskingColumnResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDataSafeMaskingPoliciesMaskingColumn(d *schema.ResourceData, m interface{}) error {
+func readDataSafeMaskingPoliciesMaskingColumn(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeMaskingPoliciesMaskingColumnResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDataSafeMaskingPoliciesMaskingColumn(d *schema.ResourceData, m interface{}) error {
+func updateDataSafeMaskingPoliciesMaskingColumn(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeMaskingPoliciesMaskingColumnResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDataSafeMaskingPoliciesMaskingColumn(d *schema.ResourceData, m interface{}) error {
+func deleteDataSafeMaskingPoliciesMaskingColumn(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeMaskingPoliciesMaskingColumnResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DataSafeMaskingPoliciesMaskingColumnResourceCrud struct {
@@ -368,7 +369,7 @@ func (s *DataSafeMaskingPoliciesMaskingColumnResourceCrud) DeletedTarget() []str
 	return []string{}
 }
 
-func (s *DataSafeMaskingPoliciesMaskingColumnResourceCrud) Create() error {
+func (s *DataSafeMaskingPoliciesMaskingColumnResourceCrud) Create(ctx context.Context) error {
 	request := oci_data_safe.CreateMaskingColumnRequest{}
 
 	if columnName, ok := s.D.GetOkExists("column_name"); ok {
@@ -429,26 +430,26 @@ func (s *DataSafeMaskingPoliciesMaskingColumnResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.CreateMaskingColumn(context.Background(), request)
+	response, err := s.Client.CreateMaskingColumn(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getMaskingPoliciesMaskingColumnFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getMaskingPoliciesMaskingColumnFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *DataSafeMaskingPoliciesMaskingColumnResourceCrud) getMaskingPoliciesMaskingColumnFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *DataSafeMaskingPoliciesMaskingColumnResourceCrud) getMaskingPoliciesMaskingColumnFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_data_safe.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	maskingPoliciesMaskingColumnId, err := maskingPoliciesMaskingColumnWaitForWorkRequest(workId, "maskingcolumn",
+	maskingPoliciesMaskingColumnId, err := maskingPoliciesMaskingColumnWaitForWorkRequest(ctx, workId, "maskingcolumn",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, maskingPoliciesMaskingColumnId)
-		_, cancelErr := s.Client.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.Client.CancelWorkRequest(ctx,
 			oci_data_safe.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -463,7 +464,7 @@ func (s *DataSafeMaskingPoliciesMaskingColumnResourceCrud) getMaskingPoliciesMas
 
 	s.D.SetId(*maskingPoliciesMaskingColumnId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func maskingPoliciesMaskingColumnWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -489,7 +490,7 @@ func maskingPoliciesMaskingColumnWorkRequestShouldRetryFunc(timeout time.Duratio
 	}
 }
 
-func maskingPoliciesMaskingColumnWaitForWorkRequest(wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
+func maskingPoliciesMaskingColumnWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_data_safe.DataSafeClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "data_safe")
 	retryPolicy.ShouldRetryOperation = maskingPoliciesMaskingColumnWorkRequestShouldRetryFunc(timeout)
@@ -509,7 +510,7 @@ func maskingPoliciesMaskingColumnWaitForWorkRequest(wId *string, entityType stri
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -538,14 +539,14 @@ func maskingPoliciesMaskingColumnWaitForWorkRequest(wId *string, entityType stri
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_data_safe.WorkRequestStatusFailed || response.Status == oci_data_safe.WorkRequestStatusCanceled {
-		return nil, getErrorFromDataSafeMaskingPoliciesMaskingColumnWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDataSafeMaskingPoliciesMaskingColumnWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDataSafeMaskingPoliciesMaskingColumnWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDataSafeMaskingPoliciesMaskingColumnWorkRequest(ctx context.Context, client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -567,7 +568,7 @@ func getErrorFromDataSafeMaskingPoliciesMaskingColumnWorkRequest(client *oci_dat
 	return workRequestErr
 }
 
-func (s *DataSafeMaskingPoliciesMaskingColumnResourceCrud) Get() error {
+func (s *DataSafeMaskingPoliciesMaskingColumnResourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetMaskingColumnRequest{}
 
 	if maskingColumnKey, ok := s.D.GetOkExists("key"); ok {
@@ -590,7 +591,7 @@ func (s *DataSafeMaskingPoliciesMaskingColumnResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.GetMaskingColumn(context.Background(), request)
+	response, err := s.Client.GetMaskingColumn(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -599,7 +600,7 @@ func (s *DataSafeMaskingPoliciesMaskingColumnResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DataSafeMaskingPoliciesMaskingColumnResourceCrud) Update() error {
+func (s *DataSafeMaskingPoliciesMaskingColumnResourceCrud) Update(ctx context.Context) error {
 	request := oci_data_safe.UpdateMaskingColumnRequest{}
 
 	if isMaskingEnabled, ok := s.D.GetOkExists("is_masking_enabled"); ok {
@@ -650,16 +651,16 @@ func (s *DataSafeMaskingPoliciesMaskingColumnResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.UpdateMaskingColumn(context.Background(), request)
+	response, err := s.Client.UpdateMaskingColumn(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getMaskingPoliciesMaskingColumnFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getMaskingPoliciesMaskingColumnFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *DataSafeMaskingPoliciesMaskingColumnResourceCrud) Delete() error {
+func (s *DataSafeMaskingPoliciesMaskingColumnResourceCrud) Delete(ctx context.Context) error {
 	request := oci_data_safe.DeleteMaskingColumnRequest{}
 
 	if maskingColumnKey, ok := s.D.GetOkExists("key"); ok {
@@ -674,7 +675,7 @@ func (s *DataSafeMaskingPoliciesMaskingColumnResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	_, err := s.Client.DeleteMaskingColumn(context.Background(), request)
+	_, err := s.Client.DeleteMaskingColumn(ctx, request)
 	return err
 }
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 
@@ -16,7 +17,7 @@ import (
 
 func DataSafeMaskingReportDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDataSafeMaskingReport,
+		ReadContext: readSingularDataSafeMaskingReport,
 		Schema: map[string]*schema.Schema{
 			"masking_report_id": {
 				Type:     schema.TypeString,
@@ -71,12 +72,12 @@ func DataSafeMaskingReportDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDataSafeMaskingReport(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeMaskingReport(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeMaskingReportDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeMaskingReportDataSourceCrud struct {
@@ -89,7 +90,7 @@ func (s *DataSafeMaskingReportDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeMaskingReportDataSourceCrud) Get() error {
+func (s *DataSafeMaskingReportDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetMaskingReportRequest{}
 
 	if maskingReportId, ok := s.D.GetOkExists("masking_report_id"); ok {
@@ -99,7 +100,7 @@ func (s *DataSafeMaskingReportDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.GetMaskingReport(context.Background(), request)
+	response, err := s.Client.GetMaskingReport(ctx, request)
 	if err != nil {
 		return err
 	}
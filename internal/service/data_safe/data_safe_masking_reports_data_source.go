@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 
@@ -16,7 +17,7 @@ import (
 
 func DataSafeMaskingReportsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeMaskingReports,
+		ReadContext: readDataSafeMaskingReports,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -112,12 +113,12 @@ func DataSafeMaskingReportsDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeMaskingReports(d *schema.ResourceData, m interface{}) error {
+func readDataSafeMaskingReports(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeMaskingReportsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeMaskingReportsDataSourceCrud struct {
@@ -130,7 +131,7 @@ func (s *DataSafeMaskingReportsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeMaskingReportsDataSourceCrud) Get() error {
+func (s *DataSafeMaskingReportsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListMaskingReportsRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -159,7 +160,7 @@ func (s *DataSafeMaskingReportsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListMaskingReports(context.Background(), request)
+	response, err := s.Client.ListMaskingReports(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -168,7 +169,7 @@ func (s *DataSafeMaskingReportsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListMaskingReports(context.Background(), request)
+		listResponse, err := s.Client.ListMaskingReports(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/oracle/oci-go-sdk/v65/datasafe"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
@@ -17,7 +18,7 @@ import (
 
 func DataSafeMaskingReportsMaskedColumnDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDataSafeMaskingReportsMaskedColumn,
+		ReadContext: readSingularDataSafeMaskingReportsMaskedColumn,
 		Schema: map[string]*schema.Schema{
 			"column_name": {
 				Type:     schema.TypeList,
@@ -120,12 +121,12 @@ func DataSafeMaskingReportsMaskedColumnDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDataSafeMaskingReportsMaskedColumn(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeMaskingReportsMaskedColumn(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeMaskingReportsMaskedColumnDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeMaskingReportsMaskedColumnDataSourceCrud struct {
@@ -138,7 +139,7 @@ func (s *DataSafeMaskingReportsMaskedColumnDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeMaskingReportsMaskedColumnDataSourceCrud) Get() error {
+func (s *DataSafeMaskingReportsMaskedColumnDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListMaskedColumnsRequest{}
 
 	if columnName, ok := s.D.GetOkExists("column_name"); ok {
@@ -218,7 +219,7 @@ func (s *DataSafeMaskingReportsMaskedColumnDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListMaskedColumns(context.Background(), request)
+	response, err := s.Client.ListMaskedColumns(ctx, request)
 	if err != nil {
 		return err
 	}
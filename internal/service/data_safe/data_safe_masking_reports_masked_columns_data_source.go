@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/oracle/oci-go-sdk/v65/datasafe"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
@@ -17,7 +18,7 @@ import (
 
 func DataSafeMaskingReportsMaskedColumnsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeMaskingReportsMaskedColumns,
+		ReadContext: readDataSafeMaskingReportsMaskedColumns,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"column_name": {
@@ -133,12 +134,12 @@ func DataSafeMaskingReportsMaskedColumnsDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeMaskingReportsMaskedColumns(d *schema.ResourceData, m interface{}) error {
+func readDataSafeMaskingReportsMaskedColumns(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeMaskingReportsMaskedColumnsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeMaskingReportsMaskedColumnsDataSourceCrud struct {
@@ -151,7 +152,7 @@ func (s *DataSafeMaskingReportsMaskedColumnsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeMaskingReportsMaskedColumnsDataSourceCrud) Get() error {
+func (s *DataSafeMaskingReportsMaskedColumnsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListMaskedColumnsRequest{}
 
 	if columnName, ok := s.D.GetOkExists("column_name"); ok {
@@ -231,7 +232,7 @@ func (s *DataSafeMaskingReportsMaskedColumnsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListMaskedColumns(context.Background(), request)
+	response, err := s.Client.ListMaskedColumns(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -240,7 +241,7 @@ func (s *DataSafeMaskingReportsMaskedColumnsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListMaskedColumns(context.Background(), request)
+		listResponse, err := s.Client.ListMaskedColumns(ctx, request)
 		if err != nil {
 			return err
 		}
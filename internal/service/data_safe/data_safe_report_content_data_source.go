@@ -6,6 +6,7 @@ package data_safe
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 
@@ -15,7 +16,7 @@ import (
 
 func DataSafeReportContentDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDataSafeReportContent,
+		ReadContext: readSingularDataSafeReportContent,
 		Schema: map[string]*schema.Schema{
 			"report_id": {
 				Type:     schema.TypeString,
@@ -26,12 +27,12 @@ func DataSafeReportContentDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDataSafeReportContent(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeReportContent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeReportContentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeReportContentDataSourceCrud struct {
@@ -44,7 +45,7 @@ func (s *DataSafeReportContentDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeReportContentDataSourceCrud) Get() error {
+func (s *DataSafeReportContentDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetReportContentRequest{}
 
 	if reportId, ok := s.D.GetOkExists("report_id"); ok {
@@ -54,7 +55,7 @@ func (s *DataSafeReportContentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.GetReportContent(context.Background(), request)
+	response, err := s.Client.GetReportContent(ctx, request)
 	if err != nil {
 		return err
 	}
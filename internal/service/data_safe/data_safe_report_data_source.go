@@ -6,6 +6,7 @@ package data_safe
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 
@@ -15,7 +16,7 @@ import (
 
 func DataSafeReportDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDataSafeReport,
+		ReadContext: readSingularDataSafeReport,
 		Schema: map[string]*schema.Schema{
 			"report_id": {
 				Type:     schema.TypeString,
@@ -69,12 +70,12 @@ func DataSafeReportDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDataSafeReport(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeReport(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeReportDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeReportDataSourceCrud struct {
@@ -87,7 +88,7 @@ func (s *DataSafeReportDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeReportDataSourceCrud) Get() error {
+func (s *DataSafeReportDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetReportRequest{}
 
 	if reportId, ok := s.D.GetOkExists("report_id"); ok {
@@ -97,7 +98,7 @@ func (s *DataSafeReportDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.GetReport(context.Background(), request)
+	response, err := s.Client.GetReport(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package data_safe
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 
@@ -15,7 +16,7 @@ import (
 
 func DataSafeReportsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeReports,
+		ReadContext: readDataSafeReports,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -115,12 +116,12 @@ func DataSafeReportsDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeReports(d *schema.ResourceData, m interface{}) error {
+func readDataSafeReports(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeReportsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeReportsDataSourceCrud struct {
@@ -133,7 +134,7 @@ func (s *DataSafeReportsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeReportsDataSourceCrud) Get() error {
+func (s *DataSafeReportsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListReportsRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -166,7 +167,7 @@ func (s *DataSafeReportsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListReports(context.Background(), request)
+	response, err := s.Client.ListReports(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -175,7 +176,7 @@ func (s *DataSafeReportsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListReports(context.Background(), request)
+		listResponse, err := s.Client.ListReports(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 )
 
 func DataSafeSecurityAssessmentComparisonDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDataSafeSecurityAssessmentComparison,
+		ReadContext: readSingularDataSafeSecurityAssessmentComparison,
 		Schema: map[string]*schema.Schema{
 			"comparison_security_assessment_id": {
 				Type:     schema.TypeString,
@@ -1239,12 +1240,12 @@ func DataSafeSecurityAssessmentComparisonDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDataSafeSecurityAssessmentComparison(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeSecurityAssessmentComparison(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeSecurityAssessmentComparisonDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeSecurityAssessmentComparisonDataSourceCrud struct {
@@ -1257,7 +1258,7 @@ func (s *DataSafeSecurityAssessmentComparisonDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeSecurityAssessmentComparisonDataSourceCrud) Get() error {
+func (s *DataSafeSecurityAssessmentComparisonDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetSecurityAssessmentComparisonRequest{}
 
 	if comparisonSecurityAssessmentId, ok := s.D.GetOkExists("comparison_security_assessment_id"); ok {
@@ -1272,7 +1273,7 @@ func (s *DataSafeSecurityAssessmentComparisonDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.GetSecurityAssessmentComparison(context.Background(), request)
+	response, err := s.Client.GetSecurityAssessmentComparison(ctx, request)
 	if err != nil {
 		return err
 	}
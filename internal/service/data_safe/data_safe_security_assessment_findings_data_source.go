@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 )
 
 func DataSafeSecurityAssessmentFindingsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeSecurityAssessmentFindings,
+		ReadContext: readDataSafeSecurityAssessmentFindings,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -115,12 +116,12 @@ func DataSafeSecurityAssessmentFindingsDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeSecurityAssessmentFindings(d *schema.ResourceData, m interface{}) error {
+func readDataSafeSecurityAssessmentFindings(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeSecurityAssessmentFindingsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeSecurityAssessmentFindingsDataSourceCrud struct {
@@ -133,7 +134,7 @@ func (s *DataSafeSecurityAssessmentFindingsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeSecurityAssessmentFindingsDataSourceCrud) Get() error {
+func (s *DataSafeSecurityAssessmentFindingsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListFindingsRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -161,7 +162,7 @@ func (s *DataSafeSecurityAssessmentFindingsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListFindings(context.Background(), request)
+	response, err := s.Client.ListFindings(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -170,7 +171,7 @@ func (s *DataSafeSecurityAssessmentFindingsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListFindings(context.Background(), request)
+		listResponse, err := s.Client.ListFindings(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -29,10 +30,10 @@ func DataSafeSecurityAssessmentResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("45m"),
 			Delete: tfresource.GetTimeoutDuration("45m"),
 		},
-		Create: createDataSafeSecurityAssessment,
-		Read:   readDataSafeSecurityAssessment,
-		Update: updateDataSafeSecurityAssessment,
-		Delete: deleteDataSafeSecurityAssessment,
+		CreateContext: createDataSafeSecurityAssessment,
+		ReadContext:   readDataSafeSecurityAssessment,
+		UpdateContext: updateDataSafeSecurityAssessment,
+		DeleteContext: deleteDataSafeSecurityAssessment,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -441,37 +442,37 @@ func DataSafeSecurityAssessmentResource() *schema.Resource {
 	}
 }
 
-func createDataSafeSecurityAssessment(d *schema.ResourceData, m interface{}) error {
+func createDataSafeSecurityAssessment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeSecurityAssessmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDataSafeSecurityAssessment(d *schema.ResourceData, m interface{}) error {
+func readDataSafeSecurityAssessment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeSecurityAssessmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDataSafeSecurityAssessment(d *schema.ResourceData, m interface{}) error {
+func updateDataSafeSecurityAssessment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeSecurityAssessmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDataSafeSecurityAssessment(d *schema.ResourceData, m interface{}) error {
+func deleteDataSafeSecurityAssessment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeSecurityAssessmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DataSafeSecurityAssessmentResourceCrud struct {
@@ -509,7 +510,7 @@ func (s *DataSafeSecurityAssessmentResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DataSafeSecurityAssessmentResourceCrud) Create() error {
+func (s *DataSafeSecurityAssessmentResourceCrud) Create(ctx context.Context) error {
 	request := oci_data_safe.CreateSecurityAssessmentRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -551,20 +552,20 @@ func (s *DataSafeSecurityAssessmentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.CreateSecurityAssessment(context.Background(), request)
+	response, err := s.Client.CreateSecurityAssessment(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getSecurityAssessmentFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getSecurityAssessmentFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *DataSafeSecurityAssessmentResourceCrud) getSecurityAssessmentFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *DataSafeSecurityAssessmentResourceCrud) getSecurityAssessmentFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_data_safe.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	securityAssessmentId, err := securityAssessmentWaitForWorkRequest(workId, "securityassessment",
+	securityAssessmentId, err := securityAssessmentWaitForWorkRequest(ctx, workId, "securityassessment",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -572,7 +573,7 @@ func (s *DataSafeSecurityAssessmentResourceCrud) getSecurityAssessmentFromWorkRe
 	}
 	s.D.SetId(*securityAssessmentId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func securityAssessmentWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -598,7 +599,7 @@ func securityAssessmentWorkRequestShouldRetryFunc(timeout time.Duration) func(re
 	}
 }
 
-func securityAssessmentWaitForWorkRequest(wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
+func securityAssessmentWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_data_safe.DataSafeClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "data_safe")
 	retryPolicy.ShouldRetryOperation = securityAssessmentWorkRequestShouldRetryFunc(timeout)
@@ -615,7 +616,7 @@ func securityAssessmentWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -644,14 +645,14 @@ func securityAssessmentWaitForWorkRequest(wId *string, entityType string, action
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_data_safe.WorkRequestStatusFailed {
-		return nil, getErrorFromDataSafeSecurityAssessmentWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDataSafeSecurityAssessmentWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDataSafeSecurityAssessmentWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDataSafeSecurityAssessmentWorkRequest(ctx context.Context, client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -673,7 +674,7 @@ func getErrorFromDataSafeSecurityAssessmentWorkRequest(client *oci_data_safe.Dat
 	return workRequestErr
 }
 
-func (s *DataSafeSecurityAssessmentResourceCrud) Get() error {
+func (s *DataSafeSecurityAssessmentResourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetSecurityAssessmentRequest{}
 
 	tmp := s.D.Id()
@@ -681,7 +682,7 @@ func (s *DataSafeSecurityAssessmentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.GetSecurityAssessment(context.Background(), request)
+	response, err := s.Client.GetSecurityAssessment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -690,11 +691,11 @@ func (s *DataSafeSecurityAssessmentResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DataSafeSecurityAssessmentResourceCrud) Update() error {
+func (s *DataSafeSecurityAssessmentResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -734,16 +735,16 @@ func (s *DataSafeSecurityAssessmentResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.UpdateSecurityAssessment(context.Background(), request)
+	response, err := s.Client.UpdateSecurityAssessment(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getSecurityAssessmentFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getSecurityAssessmentFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *DataSafeSecurityAssessmentResourceCrud) Delete() error {
+func (s *DataSafeSecurityAssessmentResourceCrud) Delete(ctx context.Context) error {
 	request := oci_data_safe.DeleteSecurityAssessmentRequest{}
 
 	tmp := s.D.Id()
@@ -751,14 +752,14 @@ func (s *DataSafeSecurityAssessmentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.DeleteSecurityAssessment(context.Background(), request)
+	response, err := s.Client.DeleteSecurityAssessment(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := securityAssessmentWaitForWorkRequest(workId, "securityassessment",
+	_, delWorkRequestErr := securityAssessmentWaitForWorkRequest(ctx, workId, "securityassessment",
 		oci_data_safe.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -927,7 +928,7 @@ func SecurityAssessmentStatisticsToMap(obj *oci_data_safe.SecurityAssessmentStat
 	return result
 }
 
-func (s *DataSafeSecurityAssessmentResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DataSafeSecurityAssessmentResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_data_safe.ChangeSecurityAssessmentCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -938,7 +939,7 @@ func (s *DataSafeSecurityAssessmentResourceCrud) updateCompartment(compartment i
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	_, err := s.Client.ChangeSecurityAssessmentCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeSecurityAssessmentCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
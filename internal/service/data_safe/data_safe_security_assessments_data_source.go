@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
@@ -17,7 +18,7 @@ import (
 
 func DataSafeSecurityAssessmentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeSecurityAssessments,
+		ReadContext: readDataSafeSecurityAssessments,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -81,12 +82,12 @@ func DataSafeSecurityAssessmentsDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeSecurityAssessments(d *schema.ResourceData, m interface{}) error {
+func readDataSafeSecurityAssessments(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeSecurityAssessmentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeSecurityAssessmentsDataSourceCrud struct {
@@ -99,7 +100,7 @@ func (s *DataSafeSecurityAssessmentsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeSecurityAssessmentsDataSourceCrud) Get() error {
+func (s *DataSafeSecurityAssessmentsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListSecurityAssessmentsRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -171,7 +172,7 @@ func (s *DataSafeSecurityAssessmentsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListSecurityAssessments(context.Background(), request)
+	response, err := s.Client.ListSecurityAssessments(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -180,7 +181,7 @@ func (s *DataSafeSecurityAssessmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSecurityAssessments(context.Background(), request)
+		listResponse, err := s.Client.ListSecurityAssessments(ctx, request)
 		if err != nil {
 			return err
 		}
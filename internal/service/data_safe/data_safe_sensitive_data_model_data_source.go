@@ -6,6 +6,7 @@ package data_safe
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 
@@ -22,12 +23,12 @@ func DataSafeSensitiveDataModelDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DataSafeSensitiveDataModelResource(), fieldMap, readSingularDataSafeSensitiveDataModel)
 }
 
-func readSingularDataSafeSensitiveDataModel(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeSensitiveDataModel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeSensitiveDataModelDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeSensitiveDataModelDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DataSafeSensitiveDataModelDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeSensitiveDataModelDataSourceCrud) Get() error {
+func (s *DataSafeSensitiveDataModelDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetSensitiveDataModelRequest{}
 
 	if sensitiveDataModelId, ok := s.D.GetOkExists("sensitive_data_model_id"); ok {
@@ -50,7 +51,7 @@ func (s *DataSafeSensitiveDataModelDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.GetSensitiveDataModel(context.Background(), request)
+	response, err := s.Client.GetSensitiveDataModel(ctx, request)
 	if err != nil {
 		return err
 	}
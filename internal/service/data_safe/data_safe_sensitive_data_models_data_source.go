@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
@@ -17,7 +18,7 @@ import (
 
 func DataSafeSensitiveDataModelsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeSensitiveDataModels,
+		ReadContext: readDataSafeSensitiveDataModels,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -74,12 +75,12 @@ func DataSafeSensitiveDataModelsDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeSensitiveDataModels(d *schema.ResourceData, m interface{}) error {
+func readDataSafeSensitiveDataModels(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeSensitiveDataModelsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeSensitiveDataModelsDataSourceCrud struct {
@@ -92,7 +93,7 @@ func (s *DataSafeSensitiveDataModelsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeSensitiveDataModelsDataSourceCrud) Get() error {
+func (s *DataSafeSensitiveDataModelsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListSensitiveDataModelsRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -146,7 +147,7 @@ func (s *DataSafeSensitiveDataModelsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListSensitiveDataModels(context.Background(), request)
+	response, err := s.Client.ListSensitiveDataModels(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -155,7 +156,7 @@ func (s *DataSafeSensitiveDataModelsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSensitiveDataModels(context.Background(), request)
+		listResponse, err := s.Client.ListSensitiveDataModels(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -13,6 +13,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -28,11 +29,11 @@ func DataSafeSensitiveDataModelsSensitiveColumnResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataSafeSensitiveDataModelsSensitiveColumn,
-		Read:     readDataSafeSensitiveDataModelsSensitiveColumn,
-		Update:   updateDataSafeSensitiveDataModelsSensitiveColumn,
-		Delete:   deleteDataSafeSensitiveDataModelsSensitiveColumn,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataSafeSensitiveDataModelsSensitiveColumn,
+		ReadContext:   readDataSafeSensitiveDataModelsSensitiveColumn,
+		UpdateContext: updateDataSafeSensitiveDataModelsSensitiveColumn,
+		DeleteContext: deleteDataSafeSensitiveDataModelsSensitiveColumn,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"column_name": {
@@ -161,37 +162,37 @@ func DataSafeSensitiveDataModelsSensitiveColumnResource() *schema.Resource {
 	}
 }
 
-func createDataSafeSensitiveDataModelsSensitiveColumn(d *schema.ResourceData, m interface{}) error {
+func createDataSafeSensitiveDataModelsSensitiveColumn(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeSensitiveDataModelsSensitiveColumnResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDataSafeSensitiveDataModelsSensitiveColumn(d *schema.ResourceData, m interface{}) error {
+func readDataSafeSensitiveDataModelsSensitiveColumn(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeSensitiveDataModelsSensitiveColumnResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDataSafeSensitiveDataModelsSensitiveColumn(d *schema.ResourceData, m interface{}) error {
+func updateDataSafeSensitiveDataModelsSensitiveColumn(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeSensitiveDataModelsSensitiveColumnResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDataSafeSensitiveDataModelsSensitiveColumn(d *schema.ResourceData, m interface{}) error {
+func deleteDataSafeSensitiveDataModelsSensitiveColumn(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeSensitiveDataModelsSensitiveColumnResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DataSafeSensitiveDataModelsSensitiveColumnResourceCrud struct {
@@ -228,7 +229,7 @@ func (s *DataSafeSensitiveDataModelsSensitiveColumnResourceCrud) DeletedTarget()
 	return []string{}
 }
 
-func (s *DataSafeSensitiveDataModelsSensitiveColumnResourceCrud) Create() error {
+func (s *DataSafeSensitiveDataModelsSensitiveColumnResourceCrud) Create(ctx context.Context) error {
 	request := oci_data_safe.CreateSensitiveColumnRequest{}
 	if appDefinedChildColumnKeys, ok := s.D.GetOkExists("app_defined_child_column_keys"); ok {
 		interfaces := appDefinedChildColumnKeys.([]interface{})
@@ -318,26 +319,26 @@ func (s *DataSafeSensitiveDataModelsSensitiveColumnResourceCrud) Create() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.CreateSensitiveColumn(context.Background(), request)
+	response, err := s.Client.CreateSensitiveColumn(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getSensitiveDataModelsSensitiveColumnFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getSensitiveDataModelsSensitiveColumnFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *DataSafeSensitiveDataModelsSensitiveColumnResourceCrud) getSensitiveDataModelsSensitiveColumnFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *DataSafeSensitiveDataModelsSensitiveColumnResourceCrud) getSensitiveDataModelsSensitiveColumnFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_data_safe.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	sensitiveDataModelsSensitiveColumnId, err := sensitiveDataModelsSensitiveColumnWaitForWorkRequest(workId, "sensitivecolumn",
+	sensitiveDataModelsSensitiveColumnId, err := sensitiveDataModelsSensitiveColumnWaitForWorkRequest(ctx, workId, "sensitivecolumn",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, sensitiveDataModelsSensitiveColumnId)
-		_, cancelErr := s.Client.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.Client.CancelWorkRequest(ctx,
 			oci_data_safe.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -352,7 +353,7 @@ func (s *DataSafeSensitiveDataModelsSensitiveColumnResourceCrud) getSensitiveDat
 
 	s.D.SetId(*sensitiveDataModelsSensitiveColumnId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func sensitiveDataModelsSensitiveColumnWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -378,7 +379,7 @@ func sensitiveDataModelsSensitiveColumnWorkRequestShouldRetryFunc(timeout time.D
 	}
 }
 
-func sensitiveDataModelsSensitiveColumnWaitForWorkRequest(wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
+func sensitiveDataModelsSensitiveColumnWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_data_safe.DataSafeClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "data_safe")
 	retryPolicy.ShouldRetryOperation = sensitiveDataModelsSensitiveColumnWorkRequestShouldRetryFunc(timeout)
@@ -397,7 +398,7 @@ func sensitiveDataModelsSensitiveColumnWaitForWorkRequest(wId *string, entityTyp
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -427,13 +428,13 @@ func sensitiveDataModelsSensitiveColumnWaitForWorkRequest(wId *string, entityTyp
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_data_safe.WorkRequestStatusFailed || response.Status == oci_data_safe.WorkRequestStatusCanceled {
-		return nil, getErrorFromDataSafeSensitiveDataModelsSensitiveColumnWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDataSafeSensitiveDataModelsSensitiveColumnWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 	return identifier, nil
 }
 
-func getErrorFromDataSafeSensitiveDataModelsSensitiveColumnWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDataSafeSensitiveDataModelsSensitiveColumnWorkRequest(ctx context.Context, client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -455,7 +456,7 @@ func getErrorFromDataSafeSensitiveDataModelsSensitiveColumnWorkRequest(client *o
 	return workRequestErr
 }
 
-func (s *DataSafeSensitiveDataModelsSensitiveColumnResourceCrud) Get() error {
+func (s *DataSafeSensitiveDataModelsSensitiveColumnResourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetSensitiveColumnRequest{}
 
 	sensitiveColumnKey, sensitiveDataModelId, err := parseSensitiveDataModelsSensitiveColumnCompositeId(s.D.Id())
@@ -468,7 +469,7 @@ func (s *DataSafeSensitiveDataModelsSensitiveColumnResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.GetSensitiveColumn(context.Background(), request)
+	response, err := s.Client.GetSensitiveColumn(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -477,7 +478,7 @@ func (s *DataSafeSensitiveDataModelsSensitiveColumnResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DataSafeSensitiveDataModelsSensitiveColumnResourceCrud) Update() error {
+func (s *DataSafeSensitiveDataModelsSensitiveColumnResourceCrud) Update(ctx context.Context) error {
 	request := oci_data_safe.UpdateSensitiveColumnRequest{}
 
 	if appDefinedChildColumnKeys, ok := s.D.GetOkExists("app_defined_child_column_keys"); ok {
@@ -549,16 +550,16 @@ func (s *DataSafeSensitiveDataModelsSensitiveColumnResourceCrud) Update() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.UpdateSensitiveColumn(context.Background(), request)
+	response, err := s.Client.UpdateSensitiveColumn(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getSensitiveDataModelsSensitiveColumnFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getSensitiveDataModelsSensitiveColumnFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *DataSafeSensitiveDataModelsSensitiveColumnResourceCrud) Delete() error {
+func (s *DataSafeSensitiveDataModelsSensitiveColumnResourceCrud) Delete(ctx context.Context) error {
 	request := oci_data_safe.DeleteSensitiveColumnRequest{}
 
 	if sensitiveColumnKey, ok := s.D.GetOkExists("key"); ok {
@@ -573,7 +574,7 @@ func (s *DataSafeSensitiveDataModelsSensitiveColumnResourceCrud) Delete() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	_, err := s.Client.DeleteSensitiveColumn(context.Background(), request)
+	_, err := s.Client.DeleteSensitiveColumn(ctx, request)
 	return err
 }
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
@@ -17,7 +18,7 @@ import (
 
 func DataSafeSensitiveDataModelsSensitiveColumnsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeSensitiveDataModelsSensitiveColumns,
+		ReadContext: readDataSafeSensitiveDataModelsSensitiveColumns,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"column_group": {
@@ -129,12 +130,12 @@ func DataSafeSensitiveDataModelsSensitiveColumnsDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeSensitiveDataModelsSensitiveColumns(d *schema.ResourceData, m interface{}) error {
+func readDataSafeSensitiveDataModelsSensitiveColumns(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeSensitiveDataModelsSensitiveColumnsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeSensitiveDataModelsSensitiveColumnsDataSourceCrud struct {
@@ -147,7 +148,7 @@ func (s *DataSafeSensitiveDataModelsSensitiveColumnsDataSourceCrud) VoidState()
 	s.D.SetId("")
 }
 
-func (s *DataSafeSensitiveDataModelsSensitiveColumnsDataSourceCrud) Get() error {
+func (s *DataSafeSensitiveDataModelsSensitiveColumnsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListSensitiveColumnsRequest{}
 
 	if columnGroup, ok := s.D.GetOkExists("column_group"); ok {
@@ -298,7 +299,7 @@ func (s *DataSafeSensitiveDataModelsSensitiveColumnsDataSourceCrud) Get() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListSensitiveColumns(context.Background(), request)
+	response, err := s.Client.ListSensitiveColumns(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -307,7 +308,7 @@ func (s *DataSafeSensitiveDataModelsSensitiveColumnsDataSourceCrud) Get() error
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSensitiveColumns(context.Background(), request)
+		listResponse, err := s.Client.ListSensitiveColumns(ctx, request)
 		if err != nil {
 			return err
 		}
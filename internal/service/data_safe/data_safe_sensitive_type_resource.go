@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func DataSafeSensitiveTypeResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataSafeSensitiveType,
-		Read:     readDataSafeSensitiveType,
-		Update:   updateDataSafeSensitiveType,
-		Delete:   deleteDataSafeSensitiveType,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataSafeSensitiveType,
+		ReadContext:   readDataSafeSensitiveType,
+		UpdateContext: updateDataSafeSensitiveType,
+		DeleteContext: deleteDataSafeSensitiveType,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -133,37 +134,37 @@ func DataSafeSensitiveTypeResource() *schema.Resource {
 	}
 }
 
-func createDataSafeSensitiveType(d *schema.ResourceData, m interface{}) error {
+func createDataSafeSensitiveType(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeSensitiveTypeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDataSafeSensitiveType(d *schema.ResourceData, m interface{}) error {
+func readDataSafeSensitiveType(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeSensitiveTypeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDataSafeSensitiveType(d *schema.ResourceData, m interface{}) error {
+func updateDataSafeSensitiveType(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeSensitiveTypeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDataSafeSensitiveType(d *schema.ResourceData, m interface{}) error {
+func deleteDataSafeSensitiveType(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeSensitiveTypeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DataSafeSensitiveTypeResourceCrud struct {
@@ -202,7 +203,7 @@ func (s *DataSafeSensitiveTypeResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DataSafeSensitiveTypeResourceCrud) Create() error {
+func (s *DataSafeSensitiveTypeResourceCrud) Create(ctx context.Context) error {
 	request := oci_data_safe.CreateSensitiveTypeRequest{}
 	err := s.populateTopLevelPolymorphicCreateSensitiveTypeRequest(&request)
 	if err != nil {
@@ -211,26 +212,26 @@ func (s *DataSafeSensitiveTypeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.CreateSensitiveType(context.Background(), request)
+	response, err := s.Client.CreateSensitiveType(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getSensitiveTypeFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getSensitiveTypeFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *DataSafeSensitiveTypeResourceCrud) getSensitiveTypeFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *DataSafeSensitiveTypeResourceCrud) getSensitiveTypeFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_data_safe.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	sensitiveTypeId, err := sensitiveTypeWaitForWorkRequest(workId, "sensitivetype",
+	sensitiveTypeId, err := sensitiveTypeWaitForWorkRequest(ctx, workId, "sensitivetype",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v and enum: %v\n", workId, sensitiveTypeId, actionTypeEnum)
-		_, cancelErr := s.Client.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.Client.CancelWorkRequest(ctx,
 			oci_data_safe.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -244,7 +245,7 @@ func (s *DataSafeSensitiveTypeResourceCrud) getSensitiveTypeFromWorkRequest(work
 	}
 	s.D.SetId(*sensitiveTypeId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func sensitiveTypeWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -270,7 +271,7 @@ func sensitiveTypeWorkRequestShouldRetryFunc(timeout time.Duration) func(respons
 	}
 }
 
-func sensitiveTypeWaitForWorkRequest(wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
+func sensitiveTypeWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_data_safe.DataSafeClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "data_safe")
 	retryPolicy.ShouldRetryOperation = sensitiveTypeWorkRequestShouldRetryFunc(timeout)
@@ -289,7 +290,7 @@ func sensitiveTypeWaitForWorkRequest(wId *string, entityType string, action oci_
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -318,14 +319,14 @@ func sensitiveTypeWaitForWorkRequest(wId *string, entityType string, action oci_
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_data_safe.WorkRequestStatusFailed || response.Status == oci_data_safe.WorkRequestStatusCanceled {
-		return nil, getErrorFromDataSafeSensitiveTypeWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDataSafeSensitiveTypeWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDataSafeSensitiveTypeWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDataSafeSensitiveTypeWorkRequest(ctx context.Context, client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -347,7 +348,7 @@ func getErrorFromDataSafeSensitiveTypeWorkRequest(client *oci_data_safe.DataSafe
 	return workRequestErr
 }
 
-func (s *DataSafeSensitiveTypeResourceCrud) Get() error {
+func (s *DataSafeSensitiveTypeResourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetSensitiveTypeRequest{}
 
 	tmp := s.D.Id()
@@ -355,7 +356,7 @@ func (s *DataSafeSensitiveTypeResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.GetSensitiveType(context.Background(), request)
+	response, err := s.Client.GetSensitiveType(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -364,11 +365,11 @@ func (s *DataSafeSensitiveTypeResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DataSafeSensitiveTypeResourceCrud) Update() error {
+func (s *DataSafeSensitiveTypeResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -382,16 +383,16 @@ func (s *DataSafeSensitiveTypeResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.UpdateSensitiveType(context.Background(), request)
+	response, err := s.Client.UpdateSensitiveType(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getSensitiveTypeFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getSensitiveTypeFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *DataSafeSensitiveTypeResourceCrud) Delete() error {
+func (s *DataSafeSensitiveTypeResourceCrud) Delete(ctx context.Context) error {
 	request := oci_data_safe.DeleteSensitiveTypeRequest{}
 
 	tmp := s.D.Id()
@@ -399,7 +400,7 @@ func (s *DataSafeSensitiveTypeResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	_, err := s.Client.DeleteSensitiveType(context.Background(), request)
+	_, err := s.Client.DeleteSensitiveType(ctx, request)
 	return err
 }
 
@@ -792,7 +793,7 @@ func (s *DataSafeSensitiveTypeResourceCrud) populateTopLevelPolymorphicUpdateSen
 	return nil
 }
 
-func (s *DataSafeSensitiveTypeResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DataSafeSensitiveTypeResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_data_safe.ChangeSensitiveTypeCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -803,12 +804,12 @@ func (s *DataSafeSensitiveTypeResourceCrud) updateCompartment(compartment interf
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	_, err := s.Client.ChangeSensitiveTypeCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeSensitiveTypeCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,10 +25,10 @@ func DataSafeSetSecurityAssessmentBaselineResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataSafeSetSecurityAssessmentBaseline,
-		Read:     readDataSafeSetSecurityAssessmentBaseline,
-		Delete:   deleteDataSafeSetSecurityAssessmentBaseline,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataSafeSetSecurityAssessmentBaseline,
+		ReadContext:   readDataSafeSetSecurityAssessmentBaseline,
+		DeleteContext: deleteDataSafeSetSecurityAssessmentBaseline,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"security_assessment_id": {
@@ -52,19 +53,19 @@ func DataSafeSetSecurityAssessmentBaselineResource() *schema.Resource {
 	}
 }
 
-func createDataSafeSetSecurityAssessmentBaseline(d *schema.ResourceData, m interface{}) error {
+func createDataSafeSetSecurityAssessmentBaseline(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeSetSecurityAssessmentBaselineResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDataSafeSetSecurityAssessmentBaseline(d *schema.ResourceData, m interface{}) error {
+func readDataSafeSetSecurityAssessmentBaseline(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteDataSafeSetSecurityAssessmentBaseline(d *schema.ResourceData, m interface{}) error {
+func deleteDataSafeSetSecurityAssessmentBaseline(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -79,7 +80,7 @@ func (s *DataSafeSetSecurityAssessmentBaselineResourceCrud) ID() string {
 	return *s.Res.OpcRequestId
 }
 
-func (s *DataSafeSetSecurityAssessmentBaselineResourceCrud) Create() error {
+func (s *DataSafeSetSecurityAssessmentBaselineResourceCrud) Create(ctx context.Context) error {
 	request := oci_data_safe.SetSecurityAssessmentBaselineRequest{}
 	baseLineStruct := oci_data_safe.SecurityAssessmentBaseLineDetails{}
 	if assessmentIds, ok := s.D.GetOkExists("assessment_ids"); ok {
@@ -104,16 +105,16 @@ func (s *DataSafeSetSecurityAssessmentBaselineResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.SetSecurityAssessmentBaseline(context.Background(), request)
+	response, err := s.Client.SetSecurityAssessmentBaseline(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getSetSecurityAssessmentBaselineFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getSetSecurityAssessmentBaselineFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *DataSafeSetSecurityAssessmentBaselineResourceCrud) Get() error {
+func (s *DataSafeSetSecurityAssessmentBaselineResourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.SetSecurityAssessmentBaselineRequest{}
 	baseLineStruct := oci_data_safe.SecurityAssessmentBaseLineDetails{}
 	if assessmentIds, ok := s.D.GetOkExists("assessment_ids"); ok {
@@ -137,7 +138,7 @@ func (s *DataSafeSetSecurityAssessmentBaselineResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.SetSecurityAssessmentBaseline(context.Background(), request)
+	response, err := s.Client.SetSecurityAssessmentBaseline(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -146,11 +147,11 @@ func (s *DataSafeSetSecurityAssessmentBaselineResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DataSafeSetSecurityAssessmentBaselineResourceCrud) getSetSecurityAssessmentBaselineFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *DataSafeSetSecurityAssessmentBaselineResourceCrud) getSetSecurityAssessmentBaselineFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_data_safe.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	setSecurityAssessmentBaselineId, err := setSecurityAssessmentBaselineWaitForWorkRequest(workId, "securityassessment",
+	setSecurityAssessmentBaselineId, err := setSecurityAssessmentBaselineWaitForWorkRequest(ctx, workId, "securityassessment",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -158,7 +159,7 @@ func (s *DataSafeSetSecurityAssessmentBaselineResourceCrud) getSetSecurityAssess
 	}
 	s.D.SetId(*setSecurityAssessmentBaselineId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func setSecurityAssessmentBaselineWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -184,7 +185,7 @@ func setSecurityAssessmentBaselineWorkRequestShouldRetryFunc(timeout time.Durati
 	}
 }
 
-func setSecurityAssessmentBaselineWaitForWorkRequest(wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
+func setSecurityAssessmentBaselineWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_data_safe.DataSafeClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "data_safe")
 	retryPolicy.ShouldRetryOperation = setSecurityAssessmentBaselineWorkRequestShouldRetryFunc(timeout)
@@ -201,7 +202,7 @@ func setSecurityAssessmentBaselineWaitForWorkRequest(wId *string, entityType str
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -230,14 +231,14 @@ func setSecurityAssessmentBaselineWaitForWorkRequest(wId *string, entityType str
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_data_safe.WorkRequestStatusFailed {
-		return nil, getErrorFromDataSafeSetSecurityAssessmentBaselineWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDataSafeSetSecurityAssessmentBaselineWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDataSafeSetSecurityAssessmentBaselineWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDataSafeSetSecurityAssessmentBaselineWorkRequest(ctx context.Context, client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func DataSafeTargetDatabaseResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataSafeTargetDatabase,
-		Read:     readDataSafeTargetDatabase,
-		Update:   updateDataSafeTargetDatabase,
-		Delete:   deleteDataSafeTargetDatabase,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataSafeTargetDatabase,
+		ReadContext:   readDataSafeTargetDatabase,
+		UpdateContext: updateDataSafeTargetDatabase,
+		DeleteContext: deleteDataSafeTargetDatabase,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -264,37 +265,37 @@ func DataSafeTargetDatabaseResource() *schema.Resource {
 	}
 }
 
-func createDataSafeTargetDatabase(d *schema.ResourceData, m interface{}) error {
+func createDataSafeTargetDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeTargetDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDataSafeTargetDatabase(d *schema.ResourceData, m interface{}) error {
+func readDataSafeTargetDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeTargetDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDataSafeTargetDatabase(d *schema.ResourceData, m interface{}) error {
+func updateDataSafeTargetDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeTargetDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDataSafeTargetDatabase(d *schema.ResourceData, m interface{}) error {
+func deleteDataSafeTargetDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeTargetDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DataSafeTargetDatabaseResourceCrud struct {
@@ -332,7 +333,7 @@ func (s *DataSafeTargetDatabaseResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DataSafeTargetDatabaseResourceCrud) Create() error {
+func (s *DataSafeTargetDatabaseResourceCrud) Create(ctx context.Context) error {
 	request := oci_data_safe.CreateTargetDatabaseRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -408,20 +409,20 @@ func (s *DataSafeTargetDatabaseResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.CreateTargetDatabase(context.Background(), request)
+	response, err := s.Client.CreateTargetDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getTargetDatabaseFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getTargetDatabaseFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *DataSafeTargetDatabaseResourceCrud) getTargetDatabaseFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *DataSafeTargetDatabaseResourceCrud) getTargetDatabaseFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_data_safe.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	targetDatabaseId, err := targetDatabaseWaitForWorkRequest(workId, "target-database",
+	targetDatabaseId, err := targetDatabaseWaitForWorkRequest(ctx, workId, "target-database",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -429,7 +430,7 @@ func (s *DataSafeTargetDatabaseResourceCrud) getTargetDatabaseFromWorkRequest(wo
 	}
 	s.D.SetId(*targetDatabaseId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func targetDatabaseWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -455,7 +456,7 @@ func targetDatabaseWorkRequestShouldRetryFunc(timeout time.Duration) func(respon
 	}
 }
 
-func targetDatabaseWaitForWorkRequest(wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
+func targetDatabaseWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_data_safe.DataSafeClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "data_safe")
 	retryPolicy.ShouldRetryOperation = targetDatabaseWorkRequestShouldRetryFunc(timeout)
@@ -472,7 +473,7 @@ func targetDatabaseWaitForWorkRequest(wId *string, entityType string, action oci
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -501,14 +502,14 @@ func targetDatabaseWaitForWorkRequest(wId *string, entityType string, action oci
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_data_safe.WorkRequestStatusFailed {
-		return nil, getErrorFromDataSafeTargetDatabaseWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDataSafeTargetDatabaseWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDataSafeTargetDatabaseWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDataSafeTargetDatabaseWorkRequest(ctx context.Context, client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -530,7 +531,7 @@ func getErrorFromDataSafeTargetDatabaseWorkRequest(client *oci_data_safe.DataSaf
 	return workRequestErr
 }
 
-func (s *DataSafeTargetDatabaseResourceCrud) Get() error {
+func (s *DataSafeTargetDatabaseResourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetTargetDatabaseRequest{}
 
 	tmp := s.D.Id()
@@ -538,7 +539,7 @@ func (s *DataSafeTargetDatabaseResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.GetTargetDatabase(context.Background(), request)
+	response, err := s.Client.GetTargetDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -547,11 +548,11 @@ func (s *DataSafeTargetDatabaseResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DataSafeTargetDatabaseResourceCrud) Update() error {
+func (s *DataSafeTargetDatabaseResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -630,16 +631,16 @@ func (s *DataSafeTargetDatabaseResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.UpdateTargetDatabase(context.Background(), request)
+	response, err := s.Client.UpdateTargetDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getTargetDatabaseFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getTargetDatabaseFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *DataSafeTargetDatabaseResourceCrud) Delete() error {
+func (s *DataSafeTargetDatabaseResourceCrud) Delete(ctx context.Context) error {
 	request := oci_data_safe.DeleteTargetDatabaseRequest{}
 
 	tmp := s.D.Id()
@@ -647,14 +648,14 @@ func (s *DataSafeTargetDatabaseResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.DeleteTargetDatabase(context.Background(), request)
+	response, err := s.Client.DeleteTargetDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := targetDatabaseWaitForWorkRequest(workId, "target-database",
+	_, delWorkRequestErr := targetDatabaseWaitForWorkRequest(ctx, workId, "target-database",
 		oci_data_safe.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -976,7 +977,7 @@ func TlsConfigToMap(obj *oci_data_safe.TlsConfig) map[string]interface{} {
 	return result
 }
 
-func (s *DataSafeTargetDatabaseResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DataSafeTargetDatabaseResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_data_safe.ChangeTargetDatabaseCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -987,12 +988,12 @@ func (s *DataSafeTargetDatabaseResourceCrud) updateCompartment(compartment inter
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	_, err := s.Client.ChangeTargetDatabaseCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeTargetDatabaseCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package data_safe
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 
@@ -15,7 +16,7 @@ import (
 
 func DataSafeTargetDatabaseRolesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeTargetDatabaseRoles,
+		ReadContext: readDataSafeTargetDatabaseRoles,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"authentication_type": {
@@ -86,12 +87,12 @@ func DataSafeTargetDatabaseRolesDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeTargetDatabaseRoles(d *schema.ResourceData, m interface{}) error {
+func readDataSafeTargetDatabaseRoles(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeTargetDatabaseRolesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeTargetDatabaseRolesDataSourceCrud struct {
@@ -104,7 +105,7 @@ func (s *DataSafeTargetDatabaseRolesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeTargetDatabaseRolesDataSourceCrud) Get() error {
+func (s *DataSafeTargetDatabaseRolesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListRolesRequest{}
 
 	if authenticationType, ok := s.D.GetOkExists("authentication_type"); ok {
@@ -133,7 +134,7 @@ func (s *DataSafeTargetDatabaseRolesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListRoles(context.Background(), request)
+	response, err := s.Client.ListRoles(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -142,7 +143,7 @@ func (s *DataSafeTargetDatabaseRolesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRoles(context.Background(), request)
+		listResponse, err := s.Client.ListRoles(ctx, request)
 		if err != nil {
 			return err
 		}
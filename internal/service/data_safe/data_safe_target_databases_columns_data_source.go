@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 
@@ -16,7 +17,7 @@ import (
 
 func DataSafeTargetDatabasesColumnsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeTargetDatabasesColumns,
+		ReadContext: readDataSafeTargetDatabasesColumns,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"column_name": {
@@ -112,12 +113,12 @@ func DataSafeTargetDatabasesColumnsDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeTargetDatabasesColumns(d *schema.ResourceData, m interface{}) error {
+func readDataSafeTargetDatabasesColumns(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeTargetDatabasesColumnsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeTargetDatabasesColumnsDataSourceCrud struct {
@@ -130,7 +131,7 @@ func (s *DataSafeTargetDatabasesColumnsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeTargetDatabasesColumnsDataSourceCrud) Get() error {
+func (s *DataSafeTargetDatabasesColumnsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListColumnsRequest{}
 
 	if columnName, ok := s.D.GetOkExists("column_name"); ok {
@@ -207,7 +208,7 @@ func (s *DataSafeTargetDatabasesColumnsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListColumns(context.Background(), request)
+	response, err := s.Client.ListColumns(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -216,7 +217,7 @@ func (s *DataSafeTargetDatabasesColumnsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListColumns(context.Background(), request)
+		listResponse, err := s.Client.ListColumns(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 )
 
 func DataSafeTargetDatabasesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeTargetDatabases,
+		ReadContext: readDataSafeTargetDatabases,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -63,12 +64,12 @@ func DataSafeTargetDatabasesDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeTargetDatabases(d *schema.ResourceData, m interface{}) error {
+func readDataSafeTargetDatabases(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeTargetDatabasesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeTargetDatabasesDataSourceCrud struct {
@@ -81,7 +82,7 @@ func (s *DataSafeTargetDatabasesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeTargetDatabasesDataSourceCrud) Get() error {
+func (s *DataSafeTargetDatabasesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListTargetDatabasesRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -127,7 +128,7 @@ func (s *DataSafeTargetDatabasesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListTargetDatabases(context.Background(), request)
+	response, err := s.Client.ListTargetDatabases(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -136,7 +137,7 @@ func (s *DataSafeTargetDatabasesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListTargetDatabases(context.Background(), request)
+		listResponse, err := s.Client.ListTargetDatabases(ctx, request)
 		if err != nil {
 			return err
 		}
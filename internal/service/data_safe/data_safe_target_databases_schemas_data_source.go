@@ -6,6 +6,7 @@ package data_safe
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 
@@ -15,7 +16,7 @@ import (
 
 func DataSafeTargetDatabasesSchemasDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeTargetDatabasesSchemas,
+		ReadContext: readDataSafeTargetDatabasesSchemas,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"is_oracle_maintained": {
@@ -62,12 +63,12 @@ func DataSafeTargetDatabasesSchemasDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeTargetDatabasesSchemas(d *schema.ResourceData, m interface{}) error {
+func readDataSafeTargetDatabasesSchemas(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeTargetDatabasesSchemasDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeTargetDatabasesSchemasDataSourceCrud struct {
@@ -80,7 +81,7 @@ func (s *DataSafeTargetDatabasesSchemasDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeTargetDatabasesSchemasDataSourceCrud) Get() error {
+func (s *DataSafeTargetDatabasesSchemasDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListSchemasRequest{}
 
 	if isOracleMaintained, ok := s.D.GetOkExists("is_oracle_maintained"); ok {
@@ -113,7 +114,7 @@ func (s *DataSafeTargetDatabasesSchemasDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListSchemas(context.Background(), request)
+	response, err := s.Client.ListSchemas(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -122,7 +123,7 @@ func (s *DataSafeTargetDatabasesSchemasDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSchemas(context.Background(), request)
+		listResponse, err := s.Client.ListSchemas(ctx, request)
 		if err != nil {
 			return err
 		}
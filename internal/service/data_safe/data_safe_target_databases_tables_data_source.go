@@ -6,6 +6,7 @@ package data_safe
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 
@@ -15,7 +16,7 @@ import (
 
 func DataSafeTargetDatabasesTablesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeTargetDatabasesTables,
+		ReadContext: readDataSafeTargetDatabasesTables,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"schema_name": {
@@ -69,12 +70,12 @@ func DataSafeTargetDatabasesTablesDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeTargetDatabasesTables(d *schema.ResourceData, m interface{}) error {
+func readDataSafeTargetDatabasesTables(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeTargetDatabasesTablesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeTargetDatabasesTablesDataSourceCrud struct {
@@ -87,7 +88,7 @@ func (s *DataSafeTargetDatabasesTablesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeTargetDatabasesTablesDataSourceCrud) Get() error {
+func (s *DataSafeTargetDatabasesTablesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListTablesRequest{}
 
 	if schemaName, ok := s.D.GetOkExists("schema_name"); ok {
@@ -133,7 +134,7 @@ func (s *DataSafeTargetDatabasesTablesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListTables(context.Background(), request)
+	response, err := s.Client.ListTables(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -142,7 +143,7 @@ func (s *DataSafeTargetDatabasesTablesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListTables(context.Background(), request)
+		listResponse, err := s.Client.ListTables(ctx, request)
 		if err != nil {
 			return err
 		}
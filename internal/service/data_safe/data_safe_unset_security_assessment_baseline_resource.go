@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,10 +25,10 @@ func DataSafeUnsetSecurityAssessmentBaselineResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataSafeUnsetSecurityAssessmentBaseline,
-		Read:     readDataSafeUnsetSecurityAssessmentBaseline,
-		Delete:   deleteDataSafeUnsetSecurityAssessmentBaseline,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataSafeUnsetSecurityAssessmentBaseline,
+		ReadContext:   readDataSafeUnsetSecurityAssessmentBaseline,
+		DeleteContext: deleteDataSafeUnsetSecurityAssessmentBaseline,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"security_assessment_id": {
@@ -43,19 +44,19 @@ func DataSafeUnsetSecurityAssessmentBaselineResource() *schema.Resource {
 	}
 }
 
-func createDataSafeUnsetSecurityAssessmentBaseline(d *schema.ResourceData, m interface{}) error {
+func createDataSafeUnsetSecurityAssessmentBaseline(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeUnsetSecurityAssessmentBaselineResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDataSafeUnsetSecurityAssessmentBaseline(d *schema.ResourceData, m interface{}) error {
+func readDataSafeUnsetSecurityAssessmentBaseline(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteDataSafeUnsetSecurityAssessmentBaseline(d *schema.ResourceData, m interface{}) error {
+func deleteDataSafeUnsetSecurityAssessmentBaseline(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -70,7 +71,7 @@ func (s *DataSafeUnsetSecurityAssessmentBaselineResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *DataSafeUnsetSecurityAssessmentBaselineResourceCrud) Get() error {
+func (s *DataSafeUnsetSecurityAssessmentBaselineResourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetSecurityAssessmentRequest{}
 
 	if securityAssessmentId, ok := s.D.GetOkExists("security_assessment_id"); ok {
@@ -80,7 +81,7 @@ func (s *DataSafeUnsetSecurityAssessmentBaselineResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.GetSecurityAssessment(context.Background(), request)
+	response, err := s.Client.GetSecurityAssessment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -90,7 +91,7 @@ func (s *DataSafeUnsetSecurityAssessmentBaselineResourceCrud) Get() error {
 
 }
 
-func (s *DataSafeUnsetSecurityAssessmentBaselineResourceCrud) Create() error {
+func (s *DataSafeUnsetSecurityAssessmentBaselineResourceCrud) Create(ctx context.Context) error {
 	request := oci_data_safe.UnsetSecurityAssessmentBaselineRequest{}
 
 	if securityAssessmentId, ok := s.D.GetOkExists("security_assessment_id"); ok {
@@ -100,20 +101,20 @@ func (s *DataSafeUnsetSecurityAssessmentBaselineResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.UnsetSecurityAssessmentBaseline(context.Background(), request)
+	response, err := s.Client.UnsetSecurityAssessmentBaseline(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getUnsetSecurityAssessmentBaselineFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getUnsetSecurityAssessmentBaselineFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *DataSafeUnsetSecurityAssessmentBaselineResourceCrud) getUnsetSecurityAssessmentBaselineFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *DataSafeUnsetSecurityAssessmentBaselineResourceCrud) getUnsetSecurityAssessmentBaselineFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_data_safe.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	unsetSecurityAssessmentBaselineId, err := unsetSecurityAssessmentBaselineWaitForWorkRequest(workId, "securityassessment",
+	unsetSecurityAssessmentBaselineId, err := unsetSecurityAssessmentBaselineWaitForWorkRequest(ctx, workId, "securityassessment",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -121,7 +122,7 @@ func (s *DataSafeUnsetSecurityAssessmentBaselineResourceCrud) getUnsetSecurityAs
 	}
 	s.D.SetId(*unsetSecurityAssessmentBaselineId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func unsetSecurityAssessmentBaselineWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -147,7 +148,7 @@ func unsetSecurityAssessmentBaselineWorkRequestShouldRetryFunc(timeout time.Dura
 	}
 }
 
-func unsetSecurityAssessmentBaselineWaitForWorkRequest(wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
+func unsetSecurityAssessmentBaselineWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_data_safe.DataSafeClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "data_safe")
 	retryPolicy.ShouldRetryOperation = unsetSecurityAssessmentBaselineWorkRequestShouldRetryFunc(timeout)
@@ -164,7 +165,7 @@ func unsetSecurityAssessmentBaselineWaitForWorkRequest(wId *string, entityType s
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -193,14 +194,14 @@ func unsetSecurityAssessmentBaselineWaitForWorkRequest(wId *string, entityType s
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_data_safe.WorkRequestStatusFailed {
-		return nil, getErrorFromDataSafeUnsetSecurityAssessmentBaselineWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDataSafeUnsetSecurityAssessmentBaselineWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDataSafeUnsetSecurityAssessmentBaselineWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDataSafeUnsetSecurityAssessmentBaselineWorkRequest(ctx context.Context, client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
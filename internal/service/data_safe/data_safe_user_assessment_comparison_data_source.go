@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 )
 
 func DataSafeUserAssessmentComparisonDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDataSafeUserAssessmentComparison,
+		ReadContext: readSingularDataSafeUserAssessmentComparison,
 		Schema: map[string]*schema.Schema{
 			"comparison_user_assessment_id": {
 				Type:     schema.TypeString,
@@ -82,12 +83,12 @@ func DataSafeUserAssessmentComparisonDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDataSafeUserAssessmentComparison(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeUserAssessmentComparison(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeUserAssessmentComparisonDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeUserAssessmentComparisonDataSourceCrud struct {
@@ -102,7 +103,7 @@ func (s *DataSafeUserAssessmentComparisonDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeUserAssessmentComparisonDataSourceCrud) Get() error {
+func (s *DataSafeUserAssessmentComparisonDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetUserAssessmentComparisonRequest{}
 
 	if comparisonUserAssessmentId, ok := s.D.GetOkExists("comparison_user_assessment_id"); ok {
@@ -117,7 +118,7 @@ func (s *DataSafeUserAssessmentComparisonDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.GetUserAssessmentComparison(context.Background(), request)
+	response, err := s.Client.GetUserAssessmentComparison(ctx, request)
 	if err != nil {
 		return err
 	}
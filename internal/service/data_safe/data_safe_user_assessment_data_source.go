@@ -11,6 +11,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
 )
@@ -24,12 +25,12 @@ func DataSafeUserAssessmentDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DataSafeUserAssessmentResource(), fieldMap, readSingularDataSafeUserAssessment)
 }
 
-func readSingularDataSafeUserAssessment(d *schema.ResourceData, m interface{}) error {
+func readSingularDataSafeUserAssessment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeUserAssessmentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeUserAssessmentDataSourceCrud struct {
@@ -42,7 +43,7 @@ func (s *DataSafeUserAssessmentDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeUserAssessmentDataSourceCrud) Get() error {
+func (s *DataSafeUserAssessmentDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetUserAssessmentRequest{}
 
 	if userAssessmentId, ok := s.D.GetOkExists("user_assessment_id"); ok {
@@ -52,7 +53,7 @@ func (s *DataSafeUserAssessmentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.GetUserAssessment(context.Background(), request)
+	response, err := s.Client.GetUserAssessment(ctx, request)
 	if err != nil {
 		return err
 	}
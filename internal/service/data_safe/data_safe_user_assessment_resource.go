@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -30,10 +31,10 @@ func DataSafeUserAssessmentResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("45m"),
 			Delete: tfresource.GetTimeoutDuration("45m"),
 		},
-		Create: createDataSafeUserAssessment,
-		Read:   readDataSafeUserAssessment,
-		Update: updateDataSafeUserAssessment,
-		Delete: deleteDataSafeUserAssessment,
+		CreateContext: createDataSafeUserAssessment,
+		ReadContext:   readDataSafeUserAssessment,
+		UpdateContext: updateDataSafeUserAssessment,
+		DeleteContext: deleteDataSafeUserAssessment,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -164,37 +165,37 @@ func DataSafeUserAssessmentResource() *schema.Resource {
 	}
 }
 
-func createDataSafeUserAssessment(d *schema.ResourceData, m interface{}) error {
+func createDataSafeUserAssessment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeUserAssessmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDataSafeUserAssessment(d *schema.ResourceData, m interface{}) error {
+func readDataSafeUserAssessment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeUserAssessmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDataSafeUserAssessment(d *schema.ResourceData, m interface{}) error {
+func updateDataSafeUserAssessment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeUserAssessmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDataSafeUserAssessment(d *schema.ResourceData, m interface{}) error {
+func deleteDataSafeUserAssessment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeUserAssessmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DataSafeUserAssessmentResourceCrud struct {
@@ -232,7 +233,7 @@ func (s *DataSafeUserAssessmentResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DataSafeUserAssessmentResourceCrud) Create() error {
+func (s *DataSafeUserAssessmentResourceCrud) Create(ctx context.Context) error {
 	request := oci_data_safe.CreateUserAssessmentRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -274,20 +275,20 @@ func (s *DataSafeUserAssessmentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.CreateUserAssessment(context.Background(), request)
+	response, err := s.Client.CreateUserAssessment(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getUserAssessmentFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getUserAssessmentFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *DataSafeUserAssessmentResourceCrud) getUserAssessmentFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *DataSafeUserAssessmentResourceCrud) getUserAssessmentFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_data_safe.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	userAssessmentId, err := userAssessmentWaitForWorkRequest(workId, "userassessment",
+	userAssessmentId, err := userAssessmentWaitForWorkRequest(ctx, workId, "userassessment",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -295,7 +296,7 @@ func (s *DataSafeUserAssessmentResourceCrud) getUserAssessmentFromWorkRequest(wo
 	}
 	s.D.SetId(*userAssessmentId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func userAssessmentWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -321,7 +322,7 @@ func userAssessmentWorkRequestShouldRetryFunc(timeout time.Duration) func(respon
 	}
 }
 
-func userAssessmentWaitForWorkRequest(wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
+func userAssessmentWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_data_safe.DataSafeClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "data_safe")
 	retryPolicy.ShouldRetryOperation = userAssessmentWorkRequestShouldRetryFunc(timeout)
@@ -338,7 +339,7 @@ func userAssessmentWaitForWorkRequest(wId *string, entityType string, action oci
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_data_safe.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -367,14 +368,14 @@ func userAssessmentWaitForWorkRequest(wId *string, entityType string, action oci
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_data_safe.WorkRequestStatusFailed {
-		return nil, getErrorFromDataSafeUserAssessmentWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDataSafeUserAssessmentWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDataSafeUserAssessmentWorkRequest(client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDataSafeUserAssessmentWorkRequest(ctx context.Context, client *oci_data_safe.DataSafeClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_data_safe.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_data_safe.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -396,7 +397,7 @@ func getErrorFromDataSafeUserAssessmentWorkRequest(client *oci_data_safe.DataSaf
 	return workRequestErr
 }
 
-func (s *DataSafeUserAssessmentResourceCrud) Get() error {
+func (s *DataSafeUserAssessmentResourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.GetUserAssessmentRequest{}
 
 	tmp := s.D.Id()
@@ -404,7 +405,7 @@ func (s *DataSafeUserAssessmentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.GetUserAssessment(context.Background(), request)
+	response, err := s.Client.GetUserAssessment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -413,11 +414,11 @@ func (s *DataSafeUserAssessmentResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DataSafeUserAssessmentResourceCrud) Update() error {
+func (s *DataSafeUserAssessmentResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -457,16 +458,16 @@ func (s *DataSafeUserAssessmentResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.UpdateUserAssessment(context.Background(), request)
+	response, err := s.Client.UpdateUserAssessment(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getUserAssessmentFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getUserAssessmentFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe"), oci_data_safe.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *DataSafeUserAssessmentResourceCrud) Delete() error {
+func (s *DataSafeUserAssessmentResourceCrud) Delete(ctx context.Context) error {
 	request := oci_data_safe.DeleteUserAssessmentRequest{}
 
 	tmp := s.D.Id()
@@ -474,14 +475,14 @@ func (s *DataSafeUserAssessmentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	response, err := s.Client.DeleteUserAssessment(context.Background(), request)
+	response, err := s.Client.DeleteUserAssessment(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := userAssessmentWaitForWorkRequest(workId, "userassessment",
+	_, delWorkRequestErr := userAssessmentWaitForWorkRequest(ctx, workId, "userassessment",
 		oci_data_safe.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -572,7 +573,7 @@ func (s *DataSafeUserAssessmentResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *DataSafeUserAssessmentResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DataSafeUserAssessmentResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_data_safe.ChangeUserAssessmentCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -583,7 +584,7 @@ func (s *DataSafeUserAssessmentResourceCrud) updateCompartment(compartment inter
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "data_safe")
 
-	_, err := s.Client.ChangeUserAssessmentCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeUserAssessmentCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
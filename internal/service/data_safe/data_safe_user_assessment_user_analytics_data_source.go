@@ -11,6 +11,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
@@ -18,7 +19,7 @@ import (
 
 func DataSafeUserAssessmentUserAnalyticsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeUserAssessmentUserAnalytics,
+		ReadContext: readDataSafeUserAssessmentUserAnalytics,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -105,12 +106,12 @@ func DataSafeUserAssessmentUserAnalyticsDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeUserAssessmentUserAnalytics(d *schema.ResourceData, m interface{}) error {
+func readDataSafeUserAssessmentUserAnalytics(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeUserAssessmentUserAnalyticsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeUserAssessmentUserAnalyticsDataSourceCrud struct {
@@ -123,7 +124,7 @@ func (s *DataSafeUserAssessmentUserAnalyticsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeUserAssessmentUserAnalyticsDataSourceCrud) Get() error {
+func (s *DataSafeUserAssessmentUserAnalyticsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListUserAnalyticsRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -220,7 +221,7 @@ func (s *DataSafeUserAssessmentUserAnalyticsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListUserAnalytics(context.Background(), request)
+	response, err := s.Client.ListUserAnalytics(ctx, request)
 	fmt.Println("response in get ****************", response)
 
 	if err != nil {
@@ -232,7 +233,7 @@ func (s *DataSafeUserAssessmentUserAnalyticsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListUserAnalytics(context.Background(), request)
+		listResponse, err := s.Client.ListUserAnalytics(ctx, request)
 		if err != nil {
 			return err
 		}
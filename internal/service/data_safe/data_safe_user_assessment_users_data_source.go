@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
@@ -17,7 +18,7 @@ import (
 
 func DataSafeUserAssessmentUsersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeUserAssessmentUsers,
+		ReadContext: readDataSafeUserAssessmentUsers,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -151,12 +152,12 @@ func DataSafeUserAssessmentUsersDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeUserAssessmentUsers(d *schema.ResourceData, m interface{}) error {
+func readDataSafeUserAssessmentUsers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeUserAssessmentUsersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeUserAssessmentUsersDataSourceCrud struct {
@@ -169,7 +170,7 @@ func (s *DataSafeUserAssessmentUsersDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeUserAssessmentUsersDataSourceCrud) Get() error {
+func (s *DataSafeUserAssessmentUsersDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListUsersRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -266,7 +267,7 @@ func (s *DataSafeUserAssessmentUsersDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListUsers(context.Background(), request)
+	response, err := s.Client.ListUsers(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -275,7 +276,7 @@ func (s *DataSafeUserAssessmentUsersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListUsers(context.Background(), request)
+		listResponse, err := s.Client.ListUsers(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_data_safe "github.com/oracle/oci-go-sdk/v65/datasafe"
@@ -19,7 +20,7 @@ import (
 
 func DataSafeUserAssessmentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataSafeUserAssessments,
+		ReadContext: readDataSafeUserAssessments,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -103,12 +104,12 @@ func DataSafeUserAssessmentsDataSource() *schema.Resource {
 	}
 }
 
-func readDataSafeUserAssessments(d *schema.ResourceData, m interface{}) error {
+func readDataSafeUserAssessments(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataSafeUserAssessmentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataSafeClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataSafeUserAssessmentsDataSourceCrud struct {
@@ -121,7 +122,7 @@ func (s *DataSafeUserAssessmentsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataSafeUserAssessmentsDataSourceCrud) Get() error {
+func (s *DataSafeUserAssessmentsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_data_safe.ListUserAssessmentsRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -193,7 +194,7 @@ func (s *DataSafeUserAssessmentsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "data_safe")
 
-	response, err := s.Client.ListUserAssessments(context.Background(), request)
+	response, err := s.Client.ListUserAssessments(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -202,7 +203,7 @@ func (s *DataSafeUserAssessmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListUserAssessments(context.Background(), request)
+		listResponse, err := s.Client.ListUserAssessments(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
@@ -22,12 +23,12 @@ func DatabaseAutonomousContainerDatabaseDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseAutonomousContainerDatabaseResource(), fieldMap, readSingularDatabaseAutonomousContainerDatabase)
 }
 
-func readSingularDatabaseAutonomousContainerDatabase(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseAutonomousContainerDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousContainerDatabaseDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseAutonomousContainerDatabaseDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DatabaseAutonomousContainerDatabaseDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousContainerDatabaseDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousContainerDatabaseDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetAutonomousContainerDatabaseRequest{}
 
 	if autonomousContainerDatabaseId, ok := s.D.GetOkExists("autonomous_container_database_id"); ok {
@@ -50,7 +51,7 @@ func (s *DatabaseAutonomousContainerDatabaseDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetAutonomousContainerDatabase(context.Background(), request)
+	response, err := s.Client.GetAutonomousContainerDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
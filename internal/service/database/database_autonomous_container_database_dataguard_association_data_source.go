@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
@@ -26,12 +27,12 @@ func DatabaseAutonomousContainerDatabaseDataguardAssociationDataSource() *schema
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseAutonomousContainerDatabaseDataguardAssociationResource(), fieldMap, readSingularDatabaseAutonomousContainerDatabaseDataguardAssociation)
 }
 
-func readSingularDatabaseAutonomousContainerDatabaseDataguardAssociation(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseAutonomousContainerDatabaseDataguardAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousContainerDatabaseDataguardAssociationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseAutonomousContainerDatabaseDataguardAssociationDataSourceCrud struct {
@@ -44,7 +45,7 @@ func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationDataSourceCrud)
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetAutonomousContainerDatabaseDataguardAssociationRequest{}
 
 	if autonomousContainerDatabaseDataguardAssociationId, ok := s.D.GetOkExists("autonomous_container_database_dataguard_association_id"); ok {
@@ -59,7 +60,7 @@ func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationDataSourceCrud)
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetAutonomousContainerDatabaseDataguardAssociation(context.Background(), request)
+	response, err := s.Client.GetAutonomousContainerDatabaseDataguardAssociation(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
@@ -25,11 +26,11 @@ func DatabaseAutonomousContainerDatabaseDataguardAssociationResource() *schema.R
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseAutonomousContainerDatabaseDataguardAssociation,
-		Read:     readDatabaseAutonomousContainerDatabaseDataguardAssociation,
-		Update:   updateDatabaseAutonomousContainerDatabaseDataguardAssociation,
-		Delete:   deleteDatabaseAutonomousContainerDatabaseDataguardAssociation,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseAutonomousContainerDatabaseDataguardAssociation,
+		ReadContext:   readDatabaseAutonomousContainerDatabaseDataguardAssociation,
+		UpdateContext: updateDatabaseAutonomousContainerDatabaseDataguardAssociation,
+		DeleteContext: deleteDatabaseAutonomousContainerDatabaseDataguardAssociation,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"autonomous_container_database_dataguard_association_id": {
@@ -111,33 +112,33 @@ func DatabaseAutonomousContainerDatabaseDataguardAssociationResource() *schema.R
 	}
 }
 
-func createDatabaseAutonomousContainerDatabaseDataguardAssociation(d *schema.ResourceData, m interface{}) error {
+func createDatabaseAutonomousContainerDatabaseDataguardAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousContainerDatabaseDataguardAssociationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseAutonomousContainerDatabaseDataguardAssociation(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousContainerDatabaseDataguardAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousContainerDatabaseDataguardAssociationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatabaseAutonomousContainerDatabaseDataguardAssociation(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseAutonomousContainerDatabaseDataguardAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousContainerDatabaseDataguardAssociationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatabaseAutonomousContainerDatabaseDataguardAssociation(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseAutonomousContainerDatabaseDataguardAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -178,7 +179,7 @@ func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationResourceCrud) De
 	}
 }
 
-func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationResourceCrud) Create() error {
+func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.UpdateAutonomousContainerDatabaseDataguardAssociationRequest{}
 
 	if autonomousContainerDatabaseDataguardAssociationId, ok := s.D.GetOkExists("autonomous_container_database_dataguard_association_id"); ok {
@@ -198,7 +199,7 @@ func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationResourceCrud) Cr
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateAutonomousContainerDatabaseDataguardAssociation(context.Background(), request)
+	response, err := s.Client.UpdateAutonomousContainerDatabaseDataguardAssociation(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -207,7 +208,7 @@ func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationResourceCrud) Cr
 	s.Res = &response.AutonomousContainerDatabaseDataguardAssociation
 
 	if workId != nil {
-		identifier, err := tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "autonomousContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		identifier, err := tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "autonomousContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if identifier != nil {
 			s.D.SetId(*identifier)
 		}
@@ -215,10 +216,10 @@ func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationResourceCrud) Cr
 		}
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationResourceCrud) Get() error {
+func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetAutonomousContainerDatabaseDataguardAssociationRequest{}
 
 	if autonomousContainerDatabaseDataguardAssociationId, ok := s.D.GetOkExists("autonomous_container_database_dataguard_association_id"); ok {
@@ -241,7 +242,7 @@ func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationResourceCrud) Ge
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetAutonomousContainerDatabaseDataguardAssociation(context.Background(), request)
+	response, err := s.Client.GetAutonomousContainerDatabaseDataguardAssociation(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -250,7 +251,7 @@ func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationResourceCrud) Ge
 	return nil
 }
 
-func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationResourceCrud) Update() error {
+func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationResourceCrud) Update(ctx context.Context) error {
 	request := oci_database.UpdateAutonomousContainerDatabaseDataguardAssociationRequest{}
 
 	if autonomousContainerDatabaseDataguardAssociationId, ok := s.D.GetOkExists("autonomous_container_database_dataguard_association_id"); ok {
@@ -270,20 +271,20 @@ func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationResourceCrud) Up
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateAutonomousContainerDatabaseDataguardAssociation(context.Background(), request)
+	response, err := s.Client.UpdateAutonomousContainerDatabaseDataguardAssociation(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "autonomousContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "autonomousContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationResourceCrud) SetData() error {
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseAutonomousContainerDatabaseDataguardAssociationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseAutonomousContainerDatabaseDataguardAssociations,
+		ReadContext: readDatabaseAutonomousContainerDatabaseDataguardAssociations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"autonomous_container_database_id": {
@@ -31,12 +32,12 @@ func DatabaseAutonomousContainerDatabaseDataguardAssociationsDataSource() *schem
 	}
 }
 
-func readDatabaseAutonomousContainerDatabaseDataguardAssociations(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousContainerDatabaseDataguardAssociations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousContainerDatabaseDataguardAssociationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseAutonomousContainerDatabaseDataguardAssociationsDataSourceCrud struct {
@@ -49,7 +50,7 @@ func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationsDataSourceCrud)
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationsDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListAutonomousContainerDatabaseDataguardAssociationsRequest{}
 
 	if autonomousContainerDatabaseId, ok := s.D.GetOkExists("autonomous_container_database_id"); ok {
@@ -59,7 +60,7 @@ func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationsDataSourceCrud)
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListAutonomousContainerDatabaseDataguardAssociations(context.Background(), request)
+	response, err := s.Client.ListAutonomousContainerDatabaseDataguardAssociations(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -68,7 +69,7 @@ func (s *DatabaseAutonomousContainerDatabaseDataguardAssociationsDataSourceCrud)
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAutonomousContainerDatabaseDataguardAssociations(context.Background(), request)
+		listResponse, err := s.Client.ListAutonomousContainerDatabaseDataguardAssociations(ctx, request)
 		if err != nil {
 			return err
 		}
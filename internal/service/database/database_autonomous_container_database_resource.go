@@ -12,6 +12,7 @@ import (
 
 	oci_work_requests "github.com/oracle/oci-go-sdk/v65/workrequests"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
@@ -27,10 +28,10 @@ func DatabaseAutonomousContainerDatabaseResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("12h"),
 			Delete: tfresource.GetTimeoutDuration("12h"),
 		},
-		Create: createDatabaseAutonomousContainerDatabase,
-		Read:   readDatabaseAutonomousContainerDatabase,
-		Update: updateDatabaseAutonomousContainerDatabase,
-		Delete: deleteDatabaseAutonomousContainerDatabase,
+		CreateContext: createDatabaseAutonomousContainerDatabase,
+		ReadContext:   readDatabaseAutonomousContainerDatabase,
+		UpdateContext: updateDatabaseAutonomousContainerDatabase,
+		DeleteContext: deleteDatabaseAutonomousContainerDatabase,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"display_name": {
@@ -582,59 +583,59 @@ func DatabaseAutonomousContainerDatabaseResource() *schema.Resource {
 	}
 }
 
-func createDatabaseAutonomousContainerDatabase(d *schema.ResourceData, m interface{}) error {
+func createDatabaseAutonomousContainerDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousContainerDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	if e := tfresource.CreateResource(d, sync); e != nil {
-		return e
+	if e := tfresource.CreateResource(ctx, d, sync); e != nil {
+		return tfresource.HandleDiagError(e)
 	}
 
 	if _, ok := sync.D.GetOkExists("rotate_key_trigger"); ok {
-		err := sync.RotateContainerDatabaseEncryptionKey()
+		err := sync.RotateContainerDatabaseEncryptionKey(ctx)
 		if err != nil {
-			return err
+			return tfresource.HandleDiagError(err)
 		}
 	}
 
 	return nil
 }
 
-func readDatabaseAutonomousContainerDatabase(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousContainerDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousContainerDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatabaseAutonomousContainerDatabase(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseAutonomousContainerDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousContainerDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
 	if _, ok := sync.D.GetOkExists("rotate_key_trigger"); ok && sync.D.HasChange("rotate_key_trigger") {
-		err := sync.RotateContainerDatabaseEncryptionKey()
+		err := sync.RotateContainerDatabaseEncryptionKey(ctx)
 		if err != nil {
-			return err
+			return tfresource.HandleDiagError(err)
 		}
 	}
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatabaseAutonomousContainerDatabase(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseAutonomousContainerDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousContainerDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabaseAutonomousContainerDatabaseResourceCrud struct {
@@ -692,7 +693,7 @@ func (s *DatabaseAutonomousContainerDatabaseResourceCrud) UpdatedTarget() []stri
 	}
 }
 
-func (s *DatabaseAutonomousContainerDatabaseResourceCrud) Create() error {
+func (s *DatabaseAutonomousContainerDatabaseResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.CreateAutonomousContainerDatabaseRequest{}
 
 	if autonomousExadataInfrastructureId, ok := s.D.GetOkExists("autonomous_exadata_infrastructure_id"); ok {
@@ -839,13 +840,13 @@ func (s *DatabaseAutonomousContainerDatabaseResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateAutonomousContainerDatabase(context.Background(), request)
+	response, err := s.Client.CreateAutonomousContainerDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -855,7 +856,7 @@ func (s *DatabaseAutonomousContainerDatabaseResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabaseAutonomousContainerDatabaseResourceCrud) Get() error {
+func (s *DatabaseAutonomousContainerDatabaseResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetAutonomousContainerDatabaseRequest{}
 
 	tmp := s.D.Id()
@@ -863,7 +864,7 @@ func (s *DatabaseAutonomousContainerDatabaseResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetAutonomousContainerDatabase(context.Background(), request)
+	response, err := s.Client.GetAutonomousContainerDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -872,11 +873,11 @@ func (s *DatabaseAutonomousContainerDatabaseResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseAutonomousContainerDatabaseResourceCrud) Update() error {
+func (s *DatabaseAutonomousContainerDatabaseResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -937,14 +938,14 @@ func (s *DatabaseAutonomousContainerDatabaseResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateAutonomousContainerDatabase(context.Background(), request)
+	response, err := s.Client.UpdateAutonomousContainerDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -954,7 +955,7 @@ func (s *DatabaseAutonomousContainerDatabaseResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DatabaseAutonomousContainerDatabaseResourceCrud) Delete() error {
+func (s *DatabaseAutonomousContainerDatabaseResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database.TerminateAutonomousContainerDatabaseRequest{}
 
 	tmp := s.D.Id()
@@ -962,7 +963,7 @@ func (s *DatabaseAutonomousContainerDatabaseResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.TerminateAutonomousContainerDatabase(context.Background(), request)
+	_, err := s.Client.TerminateAutonomousContainerDatabase(ctx, request)
 	return err
 }
 
@@ -1371,7 +1372,7 @@ func PeerAutonomousContainerDatabaseBackupConfigToMap(obj *oci_database.PeerAuto
 	return result
 }
 
-func (s *DatabaseAutonomousContainerDatabaseResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DatabaseAutonomousContainerDatabaseResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_database.ChangeAutonomousContainerDatabaseCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -1382,19 +1383,19 @@ func (s *DatabaseAutonomousContainerDatabaseResourceCrud) updateCompartment(comp
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeAutonomousContainerDatabaseCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeAutonomousContainerDatabaseCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
 	return nil
 }
 
-func (s *DatabaseAutonomousContainerDatabaseResourceCrud) RotateContainerDatabaseEncryptionKey() error {
+func (s *DatabaseAutonomousContainerDatabaseResourceCrud) RotateContainerDatabaseEncryptionKey(ctx context.Context) error {
 	request := oci_database.RotateAutonomousContainerDatabaseEncryptionKeyRequest{}
 
 	if _, isDedicated := s.D.GetOkExists("cloud_autonomous_vm_cluster_id"); !isDedicated {
@@ -1406,13 +1407,13 @@ func (s *DatabaseAutonomousContainerDatabaseResourceCrud) RotateContainerDatabas
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.RotateAutonomousContainerDatabaseEncryptionKey(context.Background(), request)
+	response, err := s.Client.RotateAutonomousContainerDatabaseEncryptionKey(ctx, request)
 	if err != nil {
 		return err
 	}
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseAutonomousContainerPatchesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseAutonomousContainerPatches,
+		ReadContext: readDatabaseAutonomousContainerPatches,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"autonomous_container_database_id": {
@@ -83,12 +84,12 @@ func DatabaseAutonomousContainerPatchesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseAutonomousContainerPatches(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousContainerPatches(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousContainerPatchesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseAutonomousContainerPatchesDataSourceCrud struct {
@@ -101,7 +102,7 @@ func (s *DatabaseAutonomousContainerPatchesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousContainerPatchesDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousContainerPatchesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListContainerDatabasePatchesRequest{}
 
 	if autonomousContainerDatabaseId, ok := s.D.GetOkExists("autonomous_container_database_id"); ok {
@@ -116,7 +117,7 @@ func (s *DatabaseAutonomousContainerPatchesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListContainerDatabasePatches(context.Background(), request)
+	response, err := s.Client.ListContainerDatabasePatches(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -125,7 +126,7 @@ func (s *DatabaseAutonomousContainerPatchesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListContainerDatabasePatches(context.Background(), request)
+		listResponse, err := s.Client.ListContainerDatabasePatches(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
@@ -22,12 +23,12 @@ func DatabaseAutonomousDatabaseBackupDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseAutonomousDatabaseBackupResource(), fieldMap, readSingularDatabaseAutonomousDatabaseBackup)
 }
 
-func readSingularDatabaseAutonomousDatabaseBackup(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseAutonomousDatabaseBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseBackupDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseAutonomousDatabaseBackupDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DatabaseAutonomousDatabaseBackupDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousDatabaseBackupDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousDatabaseBackupDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetAutonomousDatabaseBackupRequest{}
 
 	if autonomousDatabaseBackupId, ok := s.D.GetOkExists("autonomous_database_backup_id"); ok {
@@ -50,7 +51,7 @@ func (s *DatabaseAutonomousDatabaseBackupDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetAutonomousDatabaseBackup(context.Background(), request)
+	response, err := s.Client.GetAutonomousDatabaseBackup(ctx, request)
 	if err != nil {
 		return err
 	}
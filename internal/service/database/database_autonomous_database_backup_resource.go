@@ -12,6 +12,7 @@ import (
 
 	oci_work_requests "github.com/oracle/oci-go-sdk/v65/workrequests"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
@@ -22,10 +23,10 @@ func DatabaseAutonomousDatabaseBackupResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseAutonomousDatabaseBackup,
-		Read:     readDatabaseAutonomousDatabaseBackup,
-		Delete:   deleteDatabaseAutonomousDatabaseBackup,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseAutonomousDatabaseBackup,
+		ReadContext:   readDatabaseAutonomousDatabaseBackup,
+		DeleteContext: deleteDatabaseAutonomousDatabaseBackup,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"autonomous_database_id": {
@@ -102,25 +103,25 @@ func DatabaseAutonomousDatabaseBackupResource() *schema.Resource {
 	}
 }
 
-func createDatabaseAutonomousDatabaseBackup(d *schema.ResourceData, m interface{}) error {
+func createDatabaseAutonomousDatabaseBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseAutonomousDatabaseBackup(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousDatabaseBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteDatabaseAutonomousDatabaseBackup(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseAutonomousDatabaseBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -160,7 +161,7 @@ func (s *DatabaseAutonomousDatabaseBackupResourceCrud) DeletedTarget() []string
 	}
 }
 
-func (s *DatabaseAutonomousDatabaseBackupResourceCrud) Create() error {
+func (s *DatabaseAutonomousDatabaseBackupResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.CreateAutonomousDatabaseBackupRequest{}
 
 	if autonomousDatabaseId, ok := s.D.GetOkExists("autonomous_database_id"); ok {
@@ -175,14 +176,14 @@ func (s *DatabaseAutonomousDatabaseBackupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateAutonomousDatabaseBackup(context.Background(), request)
+	response, err := s.Client.CreateAutonomousDatabaseBackup(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -192,7 +193,7 @@ func (s *DatabaseAutonomousDatabaseBackupResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabaseAutonomousDatabaseBackupResourceCrud) Get() error {
+func (s *DatabaseAutonomousDatabaseBackupResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetAutonomousDatabaseBackupRequest{}
 
 	tmp := s.D.Id()
@@ -200,7 +201,7 @@ func (s *DatabaseAutonomousDatabaseBackupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetAutonomousDatabaseBackup(context.Background(), request)
+	response, err := s.Client.GetAutonomousDatabaseBackup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseAutonomousDatabaseBackupsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseAutonomousDatabaseBackups,
+		ReadContext: readDatabaseAutonomousDatabaseBackups,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"autonomous_database_id": {
@@ -43,12 +44,12 @@ func DatabaseAutonomousDatabaseBackupsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseAutonomousDatabaseBackups(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousDatabaseBackups(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseBackupsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseAutonomousDatabaseBackupsDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *DatabaseAutonomousDatabaseBackupsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousDatabaseBackupsDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousDatabaseBackupsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListAutonomousDatabaseBackupsRequest{}
 
 	if autonomousDatabaseId, ok := s.D.GetOkExists("autonomous_database_id"); ok {
@@ -85,7 +86,7 @@ func (s *DatabaseAutonomousDatabaseBackupsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListAutonomousDatabaseBackups(context.Background(), request)
+	response, err := s.Client.ListAutonomousDatabaseBackups(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *DatabaseAutonomousDatabaseBackupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAutonomousDatabaseBackups(context.Background(), request)
+		listResponse, err := s.Client.ListAutonomousDatabaseBackups(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package database
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 
@@ -15,7 +16,7 @@ import (
 
 func DatabaseAutonomousDatabaseCharacterSetsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseAutonomousDatabaseCharacterSets,
+		ReadContext: readDatabaseAutonomousDatabaseCharacterSets,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"character_set_type": {
@@ -47,12 +48,12 @@ func DatabaseAutonomousDatabaseCharacterSetsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseAutonomousDatabaseCharacterSets(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousDatabaseCharacterSets(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseCharacterSetsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseAutonomousDatabaseCharacterSetsDataSourceCrud struct {
@@ -65,7 +66,7 @@ func (s *DatabaseAutonomousDatabaseCharacterSetsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousDatabaseCharacterSetsDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousDatabaseCharacterSetsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListAutonomousDatabaseCharacterSetsRequest{}
 
 	if characterSetType, ok := s.D.GetOkExists("character_set_type"); ok {
@@ -79,7 +80,7 @@ func (s *DatabaseAutonomousDatabaseCharacterSetsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListAutonomousDatabaseCharacterSets(context.Background(), request)
+	response, err := s.Client.ListAutonomousDatabaseCharacterSets(ctx, request)
 	if err != nil {
 		return err
 	}
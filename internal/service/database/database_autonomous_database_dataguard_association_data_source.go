@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseAutonomousDatabaseDataguardAssociationDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseAutonomousDatabaseDataguardAssociation,
+		ReadContext: readSingularDatabaseAutonomousDatabaseDataguardAssociation,
 		Schema: map[string]*schema.Schema{
 			"autonomous_database_dataguard_association_id": {
 				Type:     schema.TypeString,
@@ -74,12 +75,12 @@ func DatabaseAutonomousDatabaseDataguardAssociationDataSource() *schema.Resource
 	}
 }
 
-func readSingularDatabaseAutonomousDatabaseDataguardAssociation(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseAutonomousDatabaseDataguardAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseDataguardAssociationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseAutonomousDatabaseDataguardAssociationDataSourceCrud struct {
@@ -92,7 +93,7 @@ func (s *DatabaseAutonomousDatabaseDataguardAssociationDataSourceCrud) VoidState
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousDatabaseDataguardAssociationDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousDatabaseDataguardAssociationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetAutonomousDatabaseDataguardAssociationRequest{}
 
 	if autonomousDatabaseDataguardAssociationId, ok := s.D.GetOkExists("autonomous_database_dataguard_association_id"); ok {
@@ -107,7 +108,7 @@ func (s *DatabaseAutonomousDatabaseDataguardAssociationDataSourceCrud) Get() err
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetAutonomousDatabaseDataguardAssociation(context.Background(), request)
+	response, err := s.Client.GetAutonomousDatabaseDataguardAssociation(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseAutonomousDatabaseDataguardAssociationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseAutonomousDatabaseDataguardAssociations,
+		ReadContext: readDatabaseAutonomousDatabaseDataguardAssociations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"autonomous_database_id": {
@@ -103,12 +104,12 @@ func DatabaseAutonomousDatabaseDataguardAssociationsDataSource() *schema.Resourc
 	}
 }
 
-func readDatabaseAutonomousDatabaseDataguardAssociations(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousDatabaseDataguardAssociations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseDataguardAssociationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseAutonomousDatabaseDataguardAssociationsDataSourceCrud struct {
@@ -121,7 +122,7 @@ func (s *DatabaseAutonomousDatabaseDataguardAssociationsDataSourceCrud) VoidStat
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousDatabaseDataguardAssociationsDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousDatabaseDataguardAssociationsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListAutonomousDatabaseDataguardAssociationsRequest{}
 
 	if autonomousDatabaseId, ok := s.D.GetOkExists("autonomous_database_id"); ok {
@@ -131,7 +132,7 @@ func (s *DatabaseAutonomousDatabaseDataguardAssociationsDataSourceCrud) Get() er
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListAutonomousDatabaseDataguardAssociations(context.Background(), request)
+	response, err := s.Client.ListAutonomousDatabaseDataguardAssociations(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -140,7 +141,7 @@ func (s *DatabaseAutonomousDatabaseDataguardAssociationsDataSourceCrud) Get() er
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAutonomousDatabaseDataguardAssociations(context.Background(), request)
+		listResponse, err := s.Client.ListAutonomousDatabaseDataguardAssociations(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
@@ -22,12 +23,12 @@ func DatabaseAutonomousDatabaseInstanceWalletManagementDataSource() *schema.Reso
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseAutonomousDatabaseInstanceWalletManagementResource(), fieldMap, readSingularDatabaseAutonomousDatabaseInstanceWalletManagement)
 }
 
-func readSingularDatabaseAutonomousDatabaseInstanceWalletManagement(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseAutonomousDatabaseInstanceWalletManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseInstanceWalletManagementDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseAutonomousDatabaseInstanceWalletManagementDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DatabaseAutonomousDatabaseInstanceWalletManagementDataSourceCrud) VoidS
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousDatabaseInstanceWalletManagementDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousDatabaseInstanceWalletManagementDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetAutonomousDatabaseWalletRequest{}
 
 	if autonomousDatabaseId, ok := s.D.GetOkExists("autonomous_database_id"); ok {
@@ -50,7 +51,7 @@ func (s *DatabaseAutonomousDatabaseInstanceWalletManagementDataSourceCrud) Get()
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetAutonomousDatabaseWallet(context.Background(), request)
+	response, err := s.Client.GetAutonomousDatabaseWallet(ctx, request)
 	if err != nil {
 		return err
 	}
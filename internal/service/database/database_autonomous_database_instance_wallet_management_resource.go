@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
@@ -21,10 +22,10 @@ func DatabaseAutonomousDatabaseInstanceWalletManagementResource() *schema.Resour
 			Update: tfresource.GetTimeoutDuration("20m"),
 			Delete: tfresource.GetTimeoutDuration("20m"),
 		},
-		Create: createDatabaseAutonomousDatabaseInstanceWalletManagement,
-		Read:   readDatabaseAutonomousDatabaseInstanceWalletManagement,
-		Update: updateDatabaseAutonomousDatabaseInstanceWalletManagement,
-		Delete: deleteDatabaseAutonomousDatabaseInstanceWalletManagement,
+		CreateContext: createDatabaseAutonomousDatabaseInstanceWalletManagement,
+		ReadContext:   readDatabaseAutonomousDatabaseInstanceWalletManagement,
+		UpdateContext: updateDatabaseAutonomousDatabaseInstanceWalletManagement,
+		DeleteContext: deleteDatabaseAutonomousDatabaseInstanceWalletManagement,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"autonomous_database_id": {
@@ -57,31 +58,31 @@ func DatabaseAutonomousDatabaseInstanceWalletManagementResource() *schema.Resour
 	}
 }
 
-func createDatabaseAutonomousDatabaseInstanceWalletManagement(d *schema.ResourceData, m interface{}) error {
+func createDatabaseAutonomousDatabaseInstanceWalletManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseInstanceWalletManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseAutonomousDatabaseInstanceWalletManagement(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousDatabaseInstanceWalletManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseInstanceWalletManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatabaseAutonomousDatabaseInstanceWalletManagement(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseAutonomousDatabaseInstanceWalletManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseInstanceWalletManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatabaseAutonomousDatabaseInstanceWalletManagement(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseAutonomousDatabaseInstanceWalletManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -128,16 +129,16 @@ func (s *DatabaseAutonomousDatabaseInstanceWalletManagementResourceCrud) Updated
 	}
 }
 
-func (s *DatabaseAutonomousDatabaseInstanceWalletManagementResourceCrud) Create() error {
+func (s *DatabaseAutonomousDatabaseInstanceWalletManagementResourceCrud) Create(ctx context.Context) error {
 	if shouldRotate, ok := s.D.GetOkExists("should_rotate"); ok {
 		if tmp := shouldRotate.(bool); tmp {
-			return s.Update()
+			return s.Update(ctx)
 		}
 	}
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *DatabaseAutonomousDatabaseInstanceWalletManagementResourceCrud) Get() error {
+func (s *DatabaseAutonomousDatabaseInstanceWalletManagementResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetAutonomousDatabaseWalletRequest{}
 
 	if autonomousDatabaseId, ok := s.D.GetOkExists("autonomous_database_id"); ok {
@@ -147,7 +148,7 @@ func (s *DatabaseAutonomousDatabaseInstanceWalletManagementResourceCrud) Get() e
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetAutonomousDatabaseWallet(context.Background(), request)
+	response, err := s.Client.GetAutonomousDatabaseWallet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -156,7 +157,7 @@ func (s *DatabaseAutonomousDatabaseInstanceWalletManagementResourceCrud) Get() e
 	return nil
 }
 
-func (s *DatabaseAutonomousDatabaseInstanceWalletManagementResourceCrud) Update() error {
+func (s *DatabaseAutonomousDatabaseInstanceWalletManagementResourceCrud) Update(ctx context.Context) error {
 	request := oci_database.UpdateAutonomousDatabaseWalletRequest{}
 
 	if autonomousDatabaseId, ok := s.D.GetOkExists("autonomous_database_id"); ok {
@@ -176,15 +177,15 @@ func (s *DatabaseAutonomousDatabaseInstanceWalletManagementResourceCrud) Update(
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.UpdateAutonomousDatabaseWallet(context.Background(), request)
+	_, err := s.Client.UpdateAutonomousDatabaseWallet(ctx, request)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func (s *DatabaseAutonomousDatabaseInstanceWalletManagementResourceCrud) SetData() error {
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
@@ -18,12 +19,12 @@ func DatabaseAutonomousDatabaseRegionalWalletManagementDataSource() *schema.Reso
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseAutonomousDatabaseRegionalWalletManagementResource(), fieldMap, readSingularDatabaseAutonomousDatabaseRegionalWalletManagement)
 }
 
-func readSingularDatabaseAutonomousDatabaseRegionalWalletManagement(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseAutonomousDatabaseRegionalWalletManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseRegionalWalletManagementDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseAutonomousDatabaseRegionalWalletManagementDataSourceCrud struct {
@@ -36,12 +37,12 @@ func (s *DatabaseAutonomousDatabaseRegionalWalletManagementDataSourceCrud) VoidS
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousDatabaseRegionalWalletManagementDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousDatabaseRegionalWalletManagementDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetAutonomousDatabaseRegionalWalletRequest{}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetAutonomousDatabaseRegionalWallet(context.Background(), request)
+	response, err := s.Client.GetAutonomousDatabaseRegionalWallet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
@@ -21,10 +22,10 @@ func DatabaseAutonomousDatabaseRegionalWalletManagementResource() *schema.Resour
 			Update: tfresource.GetTimeoutDuration("2h"),
 			Delete: tfresource.GetTimeoutDuration("2h"),
 		},
-		Create: createDatabaseAutonomousDatabaseRegionalWalletManagement,
-		Read:   readDatabaseAutonomousDatabaseRegionalWalletManagement,
-		Update: updateDatabaseAutonomousDatabaseRegionalWalletManagement,
-		Delete: deleteDatabaseAutonomousDatabaseRegionalWalletManagement,
+		CreateContext: createDatabaseAutonomousDatabaseRegionalWalletManagement,
+		ReadContext:   readDatabaseAutonomousDatabaseRegionalWalletManagement,
+		UpdateContext: updateDatabaseAutonomousDatabaseRegionalWalletManagement,
+		DeleteContext: deleteDatabaseAutonomousDatabaseRegionalWalletManagement,
 		Schema: map[string]*schema.Schema{
 			// Required
 
@@ -53,34 +54,34 @@ func DatabaseAutonomousDatabaseRegionalWalletManagementResource() *schema.Resour
 	}
 }
 
-func createDatabaseAutonomousDatabaseRegionalWalletManagement(d *schema.ResourceData, m interface{}) error {
+func createDatabaseAutonomousDatabaseRegionalWalletManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseRegionalWalletManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.Configuration = m.(*client.OracleClients).Configuration
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseAutonomousDatabaseRegionalWalletManagement(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousDatabaseRegionalWalletManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseRegionalWalletManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.Configuration = m.(*client.OracleClients).Configuration
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatabaseAutonomousDatabaseRegionalWalletManagement(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseAutonomousDatabaseRegionalWalletManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseRegionalWalletManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.Configuration = m.(*client.OracleClients).Configuration
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatabaseAutonomousDatabaseRegionalWalletManagement(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseAutonomousDatabaseRegionalWalletManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -126,21 +127,21 @@ func (s *DatabaseAutonomousDatabaseRegionalWalletManagementResourceCrud) Updated
 	}
 }
 
-func (s *DatabaseAutonomousDatabaseRegionalWalletManagementResourceCrud) Create() error {
+func (s *DatabaseAutonomousDatabaseRegionalWalletManagementResourceCrud) Create(ctx context.Context) error {
 	if shouldRotate, ok := s.D.GetOkExists("should_rotate"); ok {
 		if tmp := shouldRotate.(bool); tmp {
-			return s.Update()
+			return s.Update(ctx)
 		}
 	}
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *DatabaseAutonomousDatabaseRegionalWalletManagementResourceCrud) Get() error {
+func (s *DatabaseAutonomousDatabaseRegionalWalletManagementResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetAutonomousDatabaseRegionalWalletRequest{}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetAutonomousDatabaseRegionalWallet(context.Background(), request)
+	response, err := s.Client.GetAutonomousDatabaseRegionalWallet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -149,7 +150,7 @@ func (s *DatabaseAutonomousDatabaseRegionalWalletManagementResourceCrud) Get() e
 	return nil
 }
 
-func (s *DatabaseAutonomousDatabaseRegionalWalletManagementResourceCrud) Update() error {
+func (s *DatabaseAutonomousDatabaseRegionalWalletManagementResourceCrud) Update(ctx context.Context) error {
 	request := oci_database.UpdateAutonomousDatabaseRegionalWalletRequest{}
 
 	if gracePeriod, ok := s.D.GetOkExists("grace_period"); ok {
@@ -164,15 +165,15 @@ func (s *DatabaseAutonomousDatabaseRegionalWalletManagementResourceCrud) Update(
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.UpdateAutonomousDatabaseRegionalWallet(context.Background(), request)
+	_, err := s.Client.UpdateAutonomousDatabaseRegionalWallet(ctx, request)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func (s *DatabaseAutonomousDatabaseRegionalWalletManagementResourceCrud) SetData() error {
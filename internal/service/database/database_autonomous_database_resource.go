@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -30,10 +31,10 @@ func DatabaseAutonomousDatabaseResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("12h"),
 			Delete: tfresource.GetTimeoutDuration("12h"),
 		},
-		Create: createDatabaseAutonomousDatabase,
-		Read:   readDatabaseAutonomousDatabase,
-		Update: updateDatabaseAutonomousDatabase,
-		Delete: deleteDatabaseAutonomousDatabase,
+		CreateContext: createDatabaseAutonomousDatabase,
+		ReadContext:   readDatabaseAutonomousDatabase,
+		UpdateContext: updateDatabaseAutonomousDatabase,
+		DeleteContext: deleteDatabaseAutonomousDatabase,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -835,7 +836,7 @@ func DatabaseAutonomousDatabaseResource() *schema.Resource {
 	}
 }
 
-func createDatabaseAutonomousDatabase(d *schema.ResourceData, m interface{}) error {
+func createDatabaseAutonomousDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
@@ -874,37 +875,37 @@ func createDatabaseAutonomousDatabase(d *schema.ResourceData, m interface{}) err
 		configDatabaseManagementStatus = oci_database.AutonomousDatabaseDatabaseManagementStatusEnum(databaseManagementStatus.(string))
 	}
 
-	if e := tfresource.CreateResource(d, sync); e != nil {
-		return e
+	if e := tfresource.CreateResource(ctx, d, sync); e != nil {
+		return tfresource.HandleDiagError(e)
 	}
 
 	if _, ok := sync.D.GetOkExists("rotate_key_trigger"); ok {
-		err := sync.RotateAutonomousDatabaseEncryptionKey()
+		err := sync.RotateAutonomousDatabaseEncryptionKey(ctx)
 		if err != nil {
-			return err
+			return tfresource.HandleDiagError(err)
 		}
 	}
 
 	if _, ok := sync.D.GetOkExists("is_shrink_only"); ok {
 		raw := sync.D.Get("is_shrink_only")
 		if raw.(bool) {
-			err := sync.ShrinkAutonomousDatabase(oci_database.AutonomousDatabaseLifecycleStateAvailable)
+			err := sync.ShrinkAutonomousDatabase(ctx, oci_database.AutonomousDatabaseLifecycleStateAvailable)
 			if err != nil {
-				return err
+				return tfresource.HandleDiagError(err)
 			}
 		}
 	}
 
 	if isInactiveRequest {
-		return inactiveAutonomousDatabaseIfNeeded(d, sync)
+		return tfresource.HandleDiagError(inactiveAutonomousDatabaseIfNeeded(ctx, d, sync))
 	}
 
 	if configDataSafeStatus == oci_database.AutonomousDatabaseDataSafeStatusRegistered {
-		err := sync.updateDataSafeStatus(sync.D.Id(), oci_database.AutonomousDatabaseDataSafeStatusRegistered)
+		err := sync.updateDataSafeStatus(ctx, sync.D.Id(), oci_database.AutonomousDatabaseDataSafeStatusRegistered)
 		if err != nil {
-			return err
+			return tfresource.HandleDiagError(err)
 		}
-		return tfresource.ReadResource(sync)
+		return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 	}
 
 	if configOpenMode == oci_database.UpdateAutonomousDatabaseDetailsOpenModeOnly || configPermissionLevel == oci_database.UpdateAutonomousDatabaseDetailsPermissionLevelRestricted {
@@ -914,57 +915,57 @@ func createDatabaseAutonomousDatabase(d *schema.ResourceData, m interface{}) err
 		if configPermissionLevel == oci_database.UpdateAutonomousDatabaseDetailsPermissionLevelRestricted {
 			sync.D.Set("permission_level", configPermissionLevel)
 		}
-		err := sync.updateOpenModeAndPermission(sync.D.Id(), configOpenMode, configPermissionLevel)
+		err := sync.updateOpenModeAndPermission(ctx, sync.D.Id(), configOpenMode, configPermissionLevel)
 		if err != nil {
-			return err
+			return tfresource.HandleDiagError(err)
 		}
-		return tfresource.ReadResource(sync)
+		return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 	}
 
 	if configDatabaseManagementStatus == oci_database.AutonomousDatabaseDatabaseManagementStatusEnabled {
-		err := sync.updateAutonomousDatabaseManagementStatus(sync.D.Id(), oci_database.AutonomousDatabaseDatabaseManagementStatusEnabled)
+		err := sync.updateAutonomousDatabaseManagementStatus(ctx, sync.D.Id(), oci_database.AutonomousDatabaseDatabaseManagementStatusEnabled)
 		if err != nil {
-			return err
+			return tfresource.HandleDiagError(err)
 		}
-		return tfresource.ReadResource(sync)
+		return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 	}
 
 	if configOperationsInsightsStatus == oci_database.AutonomousDatabaseOperationsInsightsStatusEnabled {
-		err := sync.updateOperationsInsightsStatus(sync.D.Id(), oci_database.AutonomousDatabaseOperationsInsightsStatusEnabled)
+		err := sync.updateOperationsInsightsStatus(ctx, sync.D.Id(), oci_database.AutonomousDatabaseOperationsInsightsStatusEnabled)
 		if err != nil {
-			return err
+			return tfresource.HandleDiagError(err)
 		}
-		return tfresource.ReadResource(sync)
+		return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 	}
 
 	return nil
 }
 
-func readDatabaseAutonomousDatabase(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatabaseAutonomousDatabase(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseAutonomousDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	err := sync.validateSwitchoverDatabase()
+	err := sync.validateSwitchoverDatabase(ctx)
 	if err != nil {
-		return err
+		return tfresource.HandleDiagError(err)
 	}
 
 	if _, ok := sync.D.GetOkExists("is_shrink_only"); ok && sync.D.HasChange("is_shrink_only") {
 		oldRaw, newRaw := sync.D.GetChange("is_shrink_only")
 		if !oldRaw.(bool) && newRaw.(bool) {
-			err = sync.ShrinkAutonomousDatabase(oci_database.AutonomousDatabaseLifecycleStateAvailable)
+			err = sync.ShrinkAutonomousDatabase(ctx, oci_database.AutonomousDatabaseLifecycleStateAvailable)
 			if err != nil {
-				return err
+				return tfresource.HandleDiagError(err)
 			}
 		}
 	}
@@ -983,45 +984,45 @@ func updateDatabaseAutonomousDatabase(d *schema.ResourceData, m interface{}) err
 	}
 
 	if stateActive {
-		if err := sync.StartAutonomousDatabase(oci_database.AutonomousDatabaseLifecycleStateAvailable); err != nil {
-			return err
+		if err := sync.StartAutonomousDatabase(ctx, oci_database.AutonomousDatabaseLifecycleStateAvailable); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		if err := sync.D.Set("state", oci_database.AutonomousDatabaseLifecycleStateAvailable); err != nil {
-			return err
+			return tfresource.HandleDiagError(err)
 		}
 	}
 
 	if _, ok := sync.D.GetOkExists("rotate_key_trigger"); ok && sync.D.HasChange("rotate_key_trigger") {
-		err := sync.RotateAutonomousDatabaseEncryptionKey()
+		err := sync.RotateAutonomousDatabaseEncryptionKey(ctx)
 		if err != nil {
-			return err
+			return tfresource.HandleDiagError(err)
 		}
 	}
 
-	if err := tfresource.UpdateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.UpdateResource(ctx, d, sync); err != nil {
+		return tfresource.HandleDiagError(err)
 	}
 
 	if stateInactive {
-		if err := sync.StopAutonomousDatabase(oci_database.AutonomousDatabaseLifecycleStateStopped); err != nil {
-			return err
+		if err := sync.StopAutonomousDatabase(ctx, oci_database.AutonomousDatabaseLifecycleStateStopped); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		if err := sync.D.Set("state", oci_database.AutonomousDatabaseLifecycleStateStopped); err != nil {
-			return err
+			return tfresource.HandleDiagError(err)
 		}
 	}
 
 	return nil
 }
 
-func deleteDatabaseAutonomousDatabase(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseAutonomousDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.DisableNotFoundRetries = true
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabaseAutonomousDatabaseResourceCrud struct {
@@ -1081,7 +1082,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *DatabaseAutonomousDatabaseResourceCrud) Create() error {
+func (s *DatabaseAutonomousDatabaseResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.CreateAutonomousDatabaseRequest{}
 	err := s.populateTopLevelPolymorphicCreateAutonomousDatabaseRequest(&request)
 	if err != nil {
@@ -1090,14 +1091,14 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateAutonomousDatabase(context.Background(), request)
+	response, err := s.Client.CreateAutonomousDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -1107,7 +1108,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabaseAutonomousDatabaseResourceCrud) Get() error {
+func (s *DatabaseAutonomousDatabaseResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetAutonomousDatabaseRequest{}
 
 	tmp := s.D.Id()
@@ -1115,7 +1116,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetAutonomousDatabase(context.Background(), request)
+	response, err := s.Client.GetAutonomousDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -1124,11 +1125,11 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseAutonomousDatabaseResourceCrud) Update() error {
+func (s *DatabaseAutonomousDatabaseResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -1139,7 +1140,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Update() error {
 		oldRaw, newRaw := s.D.GetChange("data_safe_status")
 		if newRaw != "" && oldRaw != "" {
 			configDataSafeStatus := oci_database.AutonomousDatabaseDataSafeStatusEnum(strings.ToUpper(dataSafeStatus.(string)))
-			err := s.updateDataSafeStatus(s.D.Id(), configDataSafeStatus)
+			err := s.updateDataSafeStatus(ctx, s.D.Id(), configDataSafeStatus)
 			if err != nil {
 				return err
 			}
@@ -1158,7 +1159,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Update() error {
 		permissionLevelConfig = oci_database.UpdateAutonomousDatabaseDetailsPermissionLevelEnum(permissionLevel.(string))
 	}
 	if updateFlag == true {
-		err := s.updateOpenModeAndPermission(s.D.Id(), openModeConfig, permissionLevelConfig)
+		err := s.updateOpenModeAndPermission(ctx, s.D.Id(), openModeConfig, permissionLevelConfig)
 		if err != nil {
 			return err
 		}
@@ -1168,7 +1169,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Update() error {
 		oldRaw, newRaw := s.D.GetChange("operations_insights_status")
 		if newRaw != "" && oldRaw != "" {
 			configOperationsInsightsStatus := oci_database.AutonomousDatabaseOperationsInsightsStatusEnum(operationsInsightsStatus.(string))
-			err := s.updateOperationsInsightsStatus(s.D.Id(), configOperationsInsightsStatus)
+			err := s.updateOperationsInsightsStatus(ctx, s.D.Id(), configOperationsInsightsStatus)
 			if err != nil {
 				return err
 			}
@@ -1179,7 +1180,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Update() error {
 		_, newRaw := s.D.GetChange("database_management_status")
 		if newRaw != "" {
 			configDatabaseManagementStatus := oci_database.AutonomousDatabaseDatabaseManagementStatusEnum(databaseManagementStatus.(string))
-			err := s.updateAutonomousDatabaseManagementStatus(s.D.Id(), configDatabaseManagementStatus)
+			err := s.updateAutonomousDatabaseManagementStatus(ctx, s.D.Id(), configDatabaseManagementStatus)
 			if err != nil {
 				return err
 			}
@@ -1191,7 +1192,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Update() error {
 			oldRaw1, newRaw1 := s.D.GetChange("kms_key_id")
 			oldRaw2, newRaw2 := s.D.GetChange("vault_id")
 			if newRaw1 != "" && oldRaw1 != "" && newRaw2 != "" && oldRaw2 != "" && (s.D.HasChange("kms_key_id") || s.D.HasChange("vault_id")) {
-				err := s.ConfigureAutonomousDatabaseVaultKey(s.D.Id(), kmsKeyId.(string), vaultId.(string))
+				err := s.ConfigureAutonomousDatabaseVaultKey(ctx, s.D.Id(), kmsKeyId.(string), vaultId.(string))
 				if err != nil {
 					return err
 				}
@@ -1251,7 +1252,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Update() error {
 	}
 
 	if dbVersion, ok := s.D.GetOkExists("db_version"); ok && s.D.HasChange("db_version") {
-		err := s.updateDbVersion(dbVersion.(string))
+		err := s.updateDbVersion(ctx, dbVersion.(string))
 		if err != nil {
 			return err
 		}
@@ -1350,7 +1351,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Update() error {
 			}
 		}
 		if len(tmp) != 0 || s.D.HasChange("nsg_ids") || updateNewtworkAccessFlag {
-			err := s.updateNsgIds(tmp)
+			err := s.updateNsgIds(ctx, tmp)
 			if err != nil {
 				return err
 			}
@@ -1419,14 +1420,14 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateAutonomousDatabase(context.Background(), request)
+	response, err := s.Client.UpdateAutonomousDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -1436,7 +1437,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DatabaseAutonomousDatabaseResourceCrud) Delete() error {
+func (s *DatabaseAutonomousDatabaseResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database.DeleteAutonomousDatabaseRequest{}
 
 	tmp := s.D.Id()
@@ -1444,7 +1445,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteAutonomousDatabase(context.Background(), request)
+	_, err := s.Client.DeleteAutonomousDatabase(ctx, request)
 	return err
 }
 
@@ -3270,7 +3271,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) populateTopLevelPolymorphicCrea
 	return nil
 }
 
-func (s *DatabaseAutonomousDatabaseResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DatabaseAutonomousDatabaseResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_database.ChangeAutonomousDatabaseCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -3281,13 +3282,13 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateCompartment(compartment i
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.ChangeAutonomousDatabaseCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeAutonomousDatabaseCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+	_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 	if err != nil {
 		return err
 	}
@@ -3295,7 +3296,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateCompartment(compartment i
 	return nil
 }
 
-func (s *DatabaseAutonomousDatabaseResourceCrud) updateDataSafeStatus(autonomousDatabaseId string, dataSafeStatus oci_database.AutonomousDatabaseDataSafeStatusEnum) error {
+func (s *DatabaseAutonomousDatabaseResourceCrud) updateDataSafeStatus(ctx context.Context, autonomousDatabaseId string, dataSafeStatus oci_database.AutonomousDatabaseDataSafeStatusEnum) error {
 	switch dataSafeStatus {
 	case oci_database.AutonomousDatabaseDataSafeStatusRegistered:
 		request := oci_database.RegisterAutonomousDatabaseDataSafeRequest{}
@@ -3306,13 +3307,13 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateDataSafeStatus(autonomous
 		request.AutonomousDatabaseId = &autonomousDatabaseId
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-		response, err := s.Client.RegisterAutonomousDatabaseDataSafe(context.Background(), request)
+		response, err := s.Client.RegisterAutonomousDatabaseDataSafe(ctx, request)
 
 		if err != nil {
 			return err
 		}
 		workId := response.OpcWorkRequestId
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -3327,13 +3328,13 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateDataSafeStatus(autonomous
 		request.AutonomousDatabaseId = &autonomousDatabaseId
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-		response, err := s.Client.DeregisterAutonomousDatabaseDataSafe(context.Background(), request)
+		response, err := s.Client.DeregisterAutonomousDatabaseDataSafe(ctx, request)
 
 		if err != nil {
 			return err
 		}
 		workId := response.OpcWorkRequestId
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -3345,7 +3346,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateDataSafeStatus(autonomous
 
 }
 
-func (s *DatabaseAutonomousDatabaseResourceCrud) updateDbVersion(dbVersion string) error {
+func (s *DatabaseAutonomousDatabaseResourceCrud) updateDbVersion(ctx context.Context, dbVersion string) error {
 	changeDbVersionRequest := oci_database.UpdateAutonomousDatabaseRequest{}
 	changeDbVersionRequest.DbVersion = &dbVersion
 
@@ -3354,13 +3355,13 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateDbVersion(dbVersion strin
 
 	changeDbVersionRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateAutonomousDatabase(context.Background(), changeDbVersionRequest)
+	response, err := s.Client.UpdateAutonomousDatabase(ctx, changeDbVersionRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+	_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 	if err != nil {
 		return err
 	}
@@ -3368,7 +3369,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateDbVersion(dbVersion strin
 	return nil
 }
 
-func (s *DatabaseAutonomousDatabaseResourceCrud) updateNsgIds(nsgIds []string) error {
+func (s *DatabaseAutonomousDatabaseResourceCrud) updateNsgIds(ctx context.Context, nsgIds []string) error {
 	changeNsgIdsRequest := oci_database.UpdateAutonomousDatabaseRequest{}
 	changeNsgIdsRequest.NsgIds = nsgIds
 
@@ -3394,13 +3395,13 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateNsgIds(nsgIds []string) e
 
 	changeNsgIdsRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateAutonomousDatabase(context.Background(), changeNsgIdsRequest)
+	response, err := s.Client.UpdateAutonomousDatabase(ctx, changeNsgIdsRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+	_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 	if err != nil {
 		return err
 	}
@@ -3408,8 +3409,8 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateNsgIds(nsgIds []string) e
 	return nil
 }
 
-func (s *DatabaseAutonomousDatabaseResourceCrud) validateSwitchoverDatabase() error {
-	err := s.Get()
+func (s *DatabaseAutonomousDatabaseResourceCrud) validateSwitchoverDatabase(ctx context.Context) error {
+	err := s.Get(ctx)
 	if err != nil {
 		return err
 	}
@@ -3430,7 +3431,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) validateSwitchoverDatabase() er
 							if standbyState, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "state")); ok {
 								wantedStandByState := oci_database.AutonomousDatabaseStandbySummaryLifecycleStateEnum(strings.ToUpper(standbyState.(string)))
 								if (wantedStandByState == oci_database.AutonomousDatabaseStandbySummaryLifecycleStateAvailable) || (wantedStandByState == oci_database.AutonomousDatabaseStandbySummaryLifecycleStateStandby) {
-									if err := s.switchoverDatabase(""); err != nil {
+									if err := s.switchoverDatabase(ctx, ""); err != nil {
 										s.D.Set("switchover_to", oldRaw.(string))
 										return err
 									}
@@ -3476,7 +3477,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) validateSwitchoverDatabase() er
 				return fmt.Errorf("Autonomous Data Guard not found in enabled state")
 			}
 
-			if err := s.switchoverDatabase(newId); err != nil {
+			if err := s.switchoverDatabase(ctx, newId); err != nil {
 				s.D.Set("switchover_to_remote_peer_id", oldId)
 				return err
 			}
@@ -3487,7 +3488,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) validateSwitchoverDatabase() er
 	return nil
 }
 
-func (s *DatabaseAutonomousDatabaseResourceCrud) switchoverDatabase(peerDbId string) error {
+func (s *DatabaseAutonomousDatabaseResourceCrud) switchoverDatabase(ctx context.Context, peerDbId string) error {
 	request := oci_database.SwitchoverAutonomousDatabaseRequest{}
 
 	tmp := s.D.Id()
@@ -3498,14 +3499,14 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) switchoverDatabase(peerDbId str
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.SwitchoverAutonomousDatabase(context.Background(), request)
+	response, err := s.Client.SwitchoverAutonomousDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -3514,19 +3515,19 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) switchoverDatabase(peerDbId str
 	return nil
 }
 
-func (s *DatabaseAutonomousDatabaseResourceCrud) updateOperationsInsightsStatus(autonomousDatabaseId string, operationsInsightsStatus oci_database.AutonomousDatabaseOperationsInsightsStatusEnum) error {
+func (s *DatabaseAutonomousDatabaseResourceCrud) updateOperationsInsightsStatus(ctx context.Context, autonomousDatabaseId string, operationsInsightsStatus oci_database.AutonomousDatabaseOperationsInsightsStatusEnum) error {
 	switch operationsInsightsStatus {
 	case oci_database.AutonomousDatabaseOperationsInsightsStatusEnabled:
 		request := oci_database.EnableAutonomousDatabaseOperationsInsightsRequest{}
 
 		request.AutonomousDatabaseId = &autonomousDatabaseId
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
-		response, err := s.Client.EnableAutonomousDatabaseOperationsInsights(context.Background(), request)
+		response, err := s.Client.EnableAutonomousDatabaseOperationsInsights(ctx, request)
 		if err != nil {
 			return err
 		}
 		workId := response.OpcWorkRequestId
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -3538,12 +3539,12 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateOperationsInsightsStatus(
 
 		request.AutonomousDatabaseId = &autonomousDatabaseId
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
-		response, err := s.Client.DisableAutonomousDatabaseOperationsInsights(context.Background(), request)
+		response, err := s.Client.DisableAutonomousDatabaseOperationsInsights(ctx, request)
 		if err != nil {
 			return err
 		}
 		workId := response.OpcWorkRequestId
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -3554,19 +3555,19 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateOperationsInsightsStatus(
 	}
 }
 
-func (s *DatabaseAutonomousDatabaseResourceCrud) updateAutonomousDatabaseManagementStatus(autonomousDatabaseId string, autonomousDatabaseManagement oci_database.AutonomousDatabaseDatabaseManagementStatusEnum) error {
+func (s *DatabaseAutonomousDatabaseResourceCrud) updateAutonomousDatabaseManagementStatus(ctx context.Context, autonomousDatabaseId string, autonomousDatabaseManagement oci_database.AutonomousDatabaseDatabaseManagementStatusEnum) error {
 	switch autonomousDatabaseManagement {
 	case oci_database.AutonomousDatabaseDatabaseManagementStatusEnabled:
 		request := oci_database.EnableAutonomousDatabaseManagementRequest{}
 
 		request.AutonomousDatabaseId = &autonomousDatabaseId
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
-		response, err := s.Client.EnableAutonomousDatabaseManagement(context.Background(), request)
+		response, err := s.Client.EnableAutonomousDatabaseManagement(ctx, request)
 		if err != nil {
 			return err
 		}
 		workId := response.OpcWorkRequestId
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -3577,12 +3578,12 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateAutonomousDatabaseManagem
 		request := oci_database.DisableAutonomousDatabaseManagementRequest{}
 		request.AutonomousDatabaseId = &autonomousDatabaseId
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
-		response, err := s.Client.DisableAutonomousDatabaseManagement(context.Background(), request)
+		response, err := s.Client.DisableAutonomousDatabaseManagement(ctx, request)
 		if err != nil {
 			return err
 		}
 		workId := response.OpcWorkRequestId
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -3593,14 +3594,14 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateAutonomousDatabaseManagem
 	}
 }
 
-func inactiveAutonomousDatabaseIfNeeded(d *schema.ResourceData, sync *DatabaseAutonomousDatabaseResourceCrud) error {
-	if err := sync.StopAutonomousDatabase(oci_database.AutonomousDatabaseLifecycleStateStopped); err != nil {
+func inactiveAutonomousDatabaseIfNeeded(ctx context.Context, d *schema.ResourceData, sync *DatabaseAutonomousDatabaseResourceCrud) error {
+	if err := sync.StopAutonomousDatabase(ctx, oci_database.AutonomousDatabaseLifecycleStateStopped); err != nil {
 		return err
 	}
-	return tfresource.ReadResource(sync)
+	return tfresource.ReadResource(ctx, sync)
 }
 
-func (s *DatabaseAutonomousDatabaseResourceCrud) StartAutonomousDatabase(state oci_database.AutonomousDatabaseLifecycleStateEnum) error {
+func (s *DatabaseAutonomousDatabaseResourceCrud) StartAutonomousDatabase(ctx context.Context, state oci_database.AutonomousDatabaseLifecycleStateEnum) error {
 	request := oci_database.StartAutonomousDatabaseRequest{}
 
 	tmp := s.D.Id()
@@ -3608,15 +3609,15 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) StartAutonomousDatabase(state o
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	if _, err := s.Client.StartAutonomousDatabase(context.Background(), request); err != nil {
+	if _, err := s.Client.StartAutonomousDatabase(ctx, request); err != nil {
 		return err
 	}
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == state }
 
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *DatabaseAutonomousDatabaseResourceCrud) StopAutonomousDatabase(state oci_database.AutonomousDatabaseLifecycleStateEnum) error {
+func (s *DatabaseAutonomousDatabaseResourceCrud) StopAutonomousDatabase(ctx context.Context, state oci_database.AutonomousDatabaseLifecycleStateEnum) error {
 	request := oci_database.StopAutonomousDatabaseRequest{}
 
 	tmp := s.D.Id()
@@ -3624,15 +3625,15 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) StopAutonomousDatabase(state oc
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	if _, err := s.Client.StopAutonomousDatabase(context.Background(), request); err != nil {
+	if _, err := s.Client.StopAutonomousDatabase(ctx, request); err != nil {
 		return err
 	}
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == state }
 
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *DatabaseAutonomousDatabaseResourceCrud) ShrinkAutonomousDatabase(state oci_database.AutonomousDatabaseLifecycleStateEnum) error {
+func (s *DatabaseAutonomousDatabaseResourceCrud) ShrinkAutonomousDatabase(ctx context.Context, state oci_database.AutonomousDatabaseLifecycleStateEnum) error {
 	request := oci_database.ShrinkAutonomousDatabaseRequest{}
 
 	tmp := s.D.Id()
@@ -3640,15 +3641,15 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) ShrinkAutonomousDatabase(state
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	if _, err := s.Client.ShrinkAutonomousDatabase(context.Background(), request); err != nil {
+	if _, err := s.Client.ShrinkAutonomousDatabase(ctx, request); err != nil {
 		return err
 	}
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == state }
 
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *DatabaseAutonomousDatabaseResourceCrud) updateOpenModeAndPermission(autonomousDatabaseId string, openMode oci_database.UpdateAutonomousDatabaseDetailsOpenModeEnum, permissionLevel oci_database.UpdateAutonomousDatabaseDetailsPermissionLevelEnum) error {
+func (s *DatabaseAutonomousDatabaseResourceCrud) updateOpenModeAndPermission(ctx context.Context, autonomousDatabaseId string, openMode oci_database.UpdateAutonomousDatabaseDetailsOpenModeEnum, permissionLevel oci_database.UpdateAutonomousDatabaseDetailsPermissionLevelEnum) error {
 	updateRequest := oci_database.UpdateAutonomousDatabaseRequest{}
 	updateRequest.AutonomousDatabaseId = &autonomousDatabaseId
 
@@ -3676,14 +3677,14 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateOpenModeAndPermission(aut
 	}
 	updateRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	updateResponse, err := s.Client.UpdateAutonomousDatabase(context.Background(), updateRequest)
+	updateResponse, err := s.Client.UpdateAutonomousDatabase(ctx, updateRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := updateResponse.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -3691,7 +3692,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) updateOpenModeAndPermission(aut
 	return nil
 }
 
-func (s *DatabaseAutonomousDatabaseResourceCrud) RotateAutonomousDatabaseEncryptionKey() error {
+func (s *DatabaseAutonomousDatabaseResourceCrud) RotateAutonomousDatabaseEncryptionKey(ctx context.Context) error {
 	request := oci_database.RotateAutonomousDatabaseEncryptionKeyRequest{}
 
 	if isDedicated, ok := s.D.GetOkExists("is_dedicated"); !ok || isDedicated.(bool) == false {
@@ -3703,13 +3704,13 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) RotateAutonomousDatabaseEncrypt
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.RotateAutonomousDatabaseEncryptionKey(context.Background(), request)
+	response, err := s.Client.RotateAutonomousDatabaseEncryptionKey(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+	_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 	if err != nil {
 		return err
 	}
@@ -3721,7 +3722,7 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) RotateAutonomousDatabaseEncrypt
 	return nil
 }
 
-func (s *DatabaseAutonomousDatabaseResourceCrud) ConfigureAutonomousDatabaseVaultKey(autonomousDatabaseId string, kmsKeyId string, vautlId string) error {
+func (s *DatabaseAutonomousDatabaseResourceCrud) ConfigureAutonomousDatabaseVaultKey(ctx context.Context, autonomousDatabaseId string, kmsKeyId string, vautlId string) error {
 	request := oci_database.ConfigureAutonomousDatabaseVaultKeyRequest{}
 
 	request.AutonomousDatabaseId = &autonomousDatabaseId
@@ -3732,14 +3733,14 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) ConfigureAutonomousDatabaseVaul
 
 	request.VaultId = &vautlId
 
-	response, err := s.Client.ConfigureAutonomousDatabaseVaultKey(context.Background(), request)
+	response, err := s.Client.ConfigureAutonomousDatabaseVaultKey(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -11,6 +11,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
@@ -18,7 +19,7 @@ import (
 
 func DatabaseAutonomousDatabaseWalletDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseAutonomousDatabaseWallet,
+		ReadContext: readSingularDatabaseAutonomousDatabaseWallet,
 		Schema: map[string]*schema.Schema{
 			"autonomous_database_id": {
 				Type:       schema.TypeString,
@@ -50,12 +51,12 @@ func DatabaseAutonomousDatabaseWalletDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseAutonomousDatabaseWallet(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseAutonomousDatabaseWallet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseWalletDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseAutonomousDatabaseWalletDataSourceCrud struct {
@@ -68,7 +69,7 @@ func (s *DatabaseAutonomousDatabaseWalletDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousDatabaseWalletDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousDatabaseWalletDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GenerateAutonomousDatabaseWalletRequest{}
 
 	if autonomousDatabaseId, ok := s.D.GetOkExists("autonomous_database_id"); ok {
@@ -87,7 +88,7 @@ func (s *DatabaseAutonomousDatabaseWalletDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GenerateAutonomousDatabaseWallet(context.Background(), request)
+	response, err := s.Client.GenerateAutonomousDatabaseWallet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
@@ -18,10 +19,10 @@ import (
 
 func DatabaseAutonomousDatabaseWalletResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseAutonomousDatabaseWallet,
-		Read:     readDatabaseAutonomousDatabaseWallet,
-		Delete:   deleteDatabaseAutonomousDatabaseWallet,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseAutonomousDatabaseWallet,
+		ReadContext:   readDatabaseAutonomousDatabaseWallet,
+		DeleteContext: deleteDatabaseAutonomousDatabaseWallet,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"autonomous_database_id": {
@@ -60,19 +61,19 @@ func DatabaseAutonomousDatabaseWalletResource() *schema.Resource {
 	}
 }
 
-func createDatabaseAutonomousDatabaseWallet(d *schema.ResourceData, m interface{}) error {
+func createDatabaseAutonomousDatabaseWallet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabaseWalletResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseAutonomousDatabaseWallet(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousDatabaseWallet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteDatabaseAutonomousDatabaseWallet(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseAutonomousDatabaseWallet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -87,7 +88,7 @@ func (s *DatabaseAutonomousDatabaseWalletResourceCrud) ID() string {
 	return tfresource.GenerateDataSourceHashID("DatabaseAutonomousDatabaseWalletResource-", DatabaseAutonomousDatabaseWalletResource(), s.D)
 }
 
-func (s *DatabaseAutonomousDatabaseWalletResourceCrud) Create() error {
+func (s *DatabaseAutonomousDatabaseWalletResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.GenerateAutonomousDatabaseWalletRequest{}
 
 	if autonomousDatabaseId, ok := s.D.GetOkExists("autonomous_database_id"); ok {
@@ -106,7 +107,7 @@ func (s *DatabaseAutonomousDatabaseWalletResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GenerateAutonomousDatabaseWallet(context.Background(), request)
+	response, err := s.Client.GenerateAutonomousDatabaseWallet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseAutonomousDatabasesClonesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseAutonomousDatabasesClones,
+		ReadContext: readDatabaseAutonomousDatabasesClones,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"autonomous_database_id": {
@@ -691,12 +692,12 @@ func DatabaseAutonomousDatabasesClonesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseAutonomousDatabasesClones(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousDatabasesClones(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabasesClonesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseAutonomousDatabasesClonesDataSourceCrud struct {
@@ -709,7 +710,7 @@ func (s *DatabaseAutonomousDatabasesClonesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousDatabasesClonesDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousDatabasesClonesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListAutonomousDatabaseClonesRequest{}
 
 	if autonomousDatabaseId, ok := s.D.GetOkExists("autonomous_database_id"); ok {
@@ -737,7 +738,7 @@ func (s *DatabaseAutonomousDatabasesClonesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListAutonomousDatabaseClones(context.Background(), request)
+	response, err := s.Client.ListAutonomousDatabaseClones(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -746,7 +747,7 @@ func (s *DatabaseAutonomousDatabasesClonesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAutonomousDatabaseClones(context.Background(), request)
+		listResponse, err := s.Client.ListAutonomousDatabaseClones(ctx, request)
 		if err != nil {
 			return err
 		}
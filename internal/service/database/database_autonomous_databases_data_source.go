@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseAutonomousDatabasesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseAutonomousDatabases,
+		ReadContext: readDatabaseAutonomousDatabases,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"autonomous_container_database_id": {
@@ -67,12 +68,12 @@ func DatabaseAutonomousDatabasesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseAutonomousDatabases(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousDatabases(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDatabasesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseAutonomousDatabasesDataSourceCrud struct {
@@ -85,7 +86,7 @@ func (s *DatabaseAutonomousDatabasesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousDatabasesDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousDatabasesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListAutonomousDatabasesRequest{}
 
 	if autonomousContainerDatabaseId, ok := s.D.GetOkExists("autonomous_container_database_id"); ok {
@@ -137,7 +138,7 @@ func (s *DatabaseAutonomousDatabasesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListAutonomousDatabases(context.Background(), request)
+	response, err := s.Client.ListAutonomousDatabases(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -146,7 +147,7 @@ func (s *DatabaseAutonomousDatabasesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAutonomousDatabases(context.Background(), request)
+		listResponse, err := s.Client.ListAutonomousDatabases(ctx, request)
 		if err != nil {
 			return err
 		}
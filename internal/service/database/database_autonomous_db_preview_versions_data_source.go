@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseAutonomousDbPreviewVersionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseAutonomousDbPreviewVersions,
+		ReadContext: readDatabaseAutonomousDbPreviewVersions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -59,12 +60,12 @@ func DatabaseAutonomousDbPreviewVersionsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseAutonomousDbPreviewVersions(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousDbPreviewVersions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDbPreviewVersionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseAutonomousDbPreviewVersionsDataSourceCrud struct {
@@ -77,7 +78,7 @@ func (s *DatabaseAutonomousDbPreviewVersionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousDbPreviewVersionsDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousDbPreviewVersionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListAutonomousDbPreviewVersionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -87,7 +88,7 @@ func (s *DatabaseAutonomousDbPreviewVersionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListAutonomousDbPreviewVersions(context.Background(), request)
+	response, err := s.Client.ListAutonomousDbPreviewVersions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -96,7 +97,7 @@ func (s *DatabaseAutonomousDbPreviewVersionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAutonomousDbPreviewVersions(context.Background(), request)
+		listResponse, err := s.Client.ListAutonomousDbPreviewVersions(ctx, request)
 		if err != nil {
 			return err
 		}
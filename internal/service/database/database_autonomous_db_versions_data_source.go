@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseAutonomousDbVersionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseAutonomousDbVersions,
+		ReadContext: readDatabaseAutonomousDbVersions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -75,12 +76,12 @@ func DatabaseAutonomousDbVersionsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseAutonomousDbVersions(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousDbVersions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousDbVersionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseAutonomousDbVersionsDataSourceCrud struct {
@@ -93,7 +94,7 @@ func (s *DatabaseAutonomousDbVersionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousDbVersionsDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousDbVersionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListAutonomousDbVersionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -107,7 +108,7 @@ func (s *DatabaseAutonomousDbVersionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListAutonomousDbVersions(context.Background(), request)
+	response, err := s.Client.ListAutonomousDbVersions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -116,7 +117,7 @@ func (s *DatabaseAutonomousDbVersionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAutonomousDbVersions(context.Background(), request)
+		listResponse, err := s.Client.ListAutonomousDbVersions(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
@@ -22,12 +23,12 @@ func DatabaseAutonomousExadataInfrastructureDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseAutonomousExadataInfrastructureResource(), fieldMap, readSingularDatabaseAutonomousExadataInfrastructure)
 }
 
-func readSingularDatabaseAutonomousExadataInfrastructure(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseAutonomousExadataInfrastructure(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousExadataInfrastructureDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseAutonomousExadataInfrastructureDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DatabaseAutonomousExadataInfrastructureDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousExadataInfrastructureDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousExadataInfrastructureDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetAutonomousExadataInfrastructureRequest{}
 
 	if autonomousExadataInfrastructureId, ok := s.D.GetOkExists("autonomous_exadata_infrastructure_id"); ok {
@@ -50,7 +51,7 @@ func (s *DatabaseAutonomousExadataInfrastructureDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetAutonomousExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.GetAutonomousExadataInfrastructure(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseAutonomousExadataInfrastructureOcpuDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseAutonomousExadataInfrastructureOcpu,
+		ReadContext: readSingularDatabaseAutonomousExadataInfrastructureOcpu,
 		Schema: map[string]*schema.Schema{
 			"autonomous_exadata_infrastructure_id": {
 				Type:     schema.TypeString,
@@ -55,12 +56,12 @@ func DatabaseAutonomousExadataInfrastructureOcpuDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseAutonomousExadataInfrastructureOcpu(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseAutonomousExadataInfrastructureOcpu(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousExadataInfrastructureOcpuDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseAutonomousExadataInfrastructureOcpuDataSourceCrud struct {
@@ -73,7 +74,7 @@ func (s *DatabaseAutonomousExadataInfrastructureOcpuDataSourceCrud) VoidState()
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousExadataInfrastructureOcpuDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousExadataInfrastructureOcpuDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetExadataInfrastructureOcpusRequest{}
 
 	if autonomousExadataInfrastructureId, ok := s.D.GetOkExists("autonomous_exadata_infrastructure_id"); ok {
@@ -83,7 +84,7 @@ func (s *DatabaseAutonomousExadataInfrastructureOcpuDataSourceCrud) Get() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetExadataInfrastructureOcpus(context.Background(), request)
+	response, err := s.Client.GetExadataInfrastructureOcpus(ctx, request)
 	if err != nil {
 		return err
 	}
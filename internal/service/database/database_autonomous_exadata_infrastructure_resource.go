@@ -12,6 +12,7 @@ import (
 
 	oci_work_requests "github.com/oracle/oci-go-sdk/v65/workrequests"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
@@ -27,10 +28,10 @@ func DatabaseAutonomousExadataInfrastructureResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("12h"),
 			Delete: tfresource.GetTimeoutDuration("12h"),
 		},
-		Create: createDatabaseAutonomousExadataInfrastructure,
-		Read:   readDatabaseAutonomousExadataInfrastructure,
-		Update: updateDatabaseAutonomousExadataInfrastructure,
-		Delete: deleteDatabaseAutonomousExadataInfrastructure,
+		CreateContext: createDatabaseAutonomousExadataInfrastructure,
+		ReadContext:   readDatabaseAutonomousExadataInfrastructure,
+		UpdateContext: updateDatabaseAutonomousExadataInfrastructure,
+		DeleteContext: deleteDatabaseAutonomousExadataInfrastructure,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"availability_domain": {
@@ -322,41 +323,41 @@ func DatabaseAutonomousExadataInfrastructureResource() *schema.Resource {
 	}
 }
 
-func createDatabaseAutonomousExadataInfrastructure(d *schema.ResourceData, m interface{}) error {
+func createDatabaseAutonomousExadataInfrastructure(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousExadataInfrastructureResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseAutonomousExadataInfrastructure(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousExadataInfrastructure(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousExadataInfrastructureResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatabaseAutonomousExadataInfrastructure(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseAutonomousExadataInfrastructure(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousExadataInfrastructureResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatabaseAutonomousExadataInfrastructure(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseAutonomousExadataInfrastructure(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousExadataInfrastructureResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabaseAutonomousExadataInfrastructureResourceCrud struct {
@@ -420,7 +421,7 @@ func (s *DatabaseAutonomousExadataInfrastructureResourceCrud) UpdatedTarget() []
 	}
 }
 
-func (s *DatabaseAutonomousExadataInfrastructureResourceCrud) Create() error {
+func (s *DatabaseAutonomousExadataInfrastructureResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.LaunchAutonomousExadataInfrastructureRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -496,14 +497,14 @@ func (s *DatabaseAutonomousExadataInfrastructureResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.LaunchAutonomousExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.LaunchAutonomousExadataInfrastructure(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "autonomousExadataInfrastructure", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "autonomousExadataInfrastructure", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -512,7 +513,7 @@ func (s *DatabaseAutonomousExadataInfrastructureResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabaseAutonomousExadataInfrastructureResourceCrud) Get() error {
+func (s *DatabaseAutonomousExadataInfrastructureResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetAutonomousExadataInfrastructureRequest{}
 
 	tmp := s.D.Id()
@@ -520,7 +521,7 @@ func (s *DatabaseAutonomousExadataInfrastructureResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetAutonomousExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.GetAutonomousExadataInfrastructure(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -529,11 +530,11 @@ func (s *DatabaseAutonomousExadataInfrastructureResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseAutonomousExadataInfrastructureResourceCrud) Update() error {
+func (s *DatabaseAutonomousExadataInfrastructureResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -588,14 +589,14 @@ func (s *DatabaseAutonomousExadataInfrastructureResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateAutonomousExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.UpdateAutonomousExadataInfrastructure(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "autonomousExadataInfrastructure", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "autonomousExadataInfrastructure", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -605,7 +606,7 @@ func (s *DatabaseAutonomousExadataInfrastructureResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DatabaseAutonomousExadataInfrastructureResourceCrud) Delete() error {
+func (s *DatabaseAutonomousExadataInfrastructureResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database.TerminateAutonomousExadataInfrastructureRequest{}
 
 	tmp := s.D.Id()
@@ -613,7 +614,7 @@ func (s *DatabaseAutonomousExadataInfrastructureResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.TerminateAutonomousExadataInfrastructure(context.Background(), request)
+	_, err := s.Client.TerminateAutonomousExadataInfrastructure(ctx, request)
 	return err
 }
 
@@ -879,7 +880,7 @@ func MonthToMap(obj oci_database.Month) map[string]interface{} {
 	return result
 }
 
-func (s *DatabaseAutonomousExadataInfrastructureResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DatabaseAutonomousExadataInfrastructureResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_database.ChangeAutonomousExadataInfrastructureCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -890,12 +891,12 @@ func (s *DatabaseAutonomousExadataInfrastructureResourceCrud) updateCompartment(
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeAutonomousExadataInfrastructureCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeAutonomousExadataInfrastructureCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseAutonomousExadataInfrastructureShapesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseAutonomousExadataInfrastructureShapes,
+		ReadContext: readDatabaseAutonomousExadataInfrastructureShapes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -67,12 +68,12 @@ func DatabaseAutonomousExadataInfrastructureShapesDataSource() *schema.Resource
 	}
 }
 
-func readDatabaseAutonomousExadataInfrastructureShapes(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousExadataInfrastructureShapes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousExadataInfrastructureShapesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseAutonomousExadataInfrastructureShapesDataSourceCrud struct {
@@ -85,7 +86,7 @@ func (s *DatabaseAutonomousExadataInfrastructureShapesDataSourceCrud) VoidState(
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousExadataInfrastructureShapesDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousExadataInfrastructureShapesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListAutonomousExadataInfrastructureShapesRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -100,7 +101,7 @@ func (s *DatabaseAutonomousExadataInfrastructureShapesDataSourceCrud) Get() erro
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListAutonomousExadataInfrastructureShapes(context.Background(), request)
+	response, err := s.Client.ListAutonomousExadataInfrastructureShapes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -109,7 +110,7 @@ func (s *DatabaseAutonomousExadataInfrastructureShapesDataSourceCrud) Get() erro
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAutonomousExadataInfrastructureShapes(context.Background(), request)
+		listResponse, err := s.Client.ListAutonomousExadataInfrastructureShapes(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseAutonomousPatchDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseAutonomousPatch,
+		ReadContext: readSingularDatabaseAutonomousPatch,
 		Schema: map[string]*schema.Schema{
 			"autonomous_patch_id": {
 				Type:     schema.TypeString,
@@ -62,12 +63,12 @@ func DatabaseAutonomousPatchDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseAutonomousPatch(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseAutonomousPatch(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousPatchDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseAutonomousPatchDataSourceCrud struct {
@@ -80,7 +81,7 @@ func (s *DatabaseAutonomousPatchDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousPatchDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousPatchDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetAutonomousPatchRequest{}
 
 	if autonomousPatchId, ok := s.D.GetOkExists("autonomous_patch_id"); ok {
@@ -90,7 +91,7 @@ func (s *DatabaseAutonomousPatchDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetAutonomousPatch(context.Background(), request)
+	response, err := s.Client.GetAutonomousPatch(ctx, request)
 	if err != nil {
 		return err
 	}
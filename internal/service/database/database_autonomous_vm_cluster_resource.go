@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
@@ -20,11 +21,11 @@ func DatabaseAutonomousVmClusterResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseAutonomousVmCluster,
-		Read:     readDatabaseAutonomousVmCluster,
-		Update:   updateDatabaseAutonomousVmCluster,
-		Delete:   deleteDatabaseAutonomousVmCluster,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseAutonomousVmCluster,
+		ReadContext:   readDatabaseAutonomousVmCluster,
+		UpdateContext: updateDatabaseAutonomousVmCluster,
+		DeleteContext: deleteDatabaseAutonomousVmCluster,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -354,37 +355,37 @@ func DatabaseAutonomousVmClusterResource() *schema.Resource {
 	}
 }
 
-func createDatabaseAutonomousVmCluster(d *schema.ResourceData, m interface{}) error {
+func createDatabaseAutonomousVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousVmClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseAutonomousVmCluster(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousVmClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatabaseAutonomousVmCluster(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseAutonomousVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousVmClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatabaseAutonomousVmCluster(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseAutonomousVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousVmClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabaseAutonomousVmClusterResourceCrud struct {
@@ -436,7 +437,7 @@ func (s *DatabaseAutonomousVmClusterResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *DatabaseAutonomousVmClusterResourceCrud) Create() error {
+func (s *DatabaseAutonomousVmClusterResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.CreateAutonomousVmClusterRequest{}
 
 	if autonomousDataStorageSizeInTBs, ok := s.D.GetOkExists("autonomous_data_storage_size_in_tbs"); ok {
@@ -518,7 +519,7 @@ func (s *DatabaseAutonomousVmClusterResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateAutonomousVmCluster(context.Background(), request)
+	response, err := s.Client.CreateAutonomousVmCluster(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -527,7 +528,7 @@ func (s *DatabaseAutonomousVmClusterResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabaseAutonomousVmClusterResourceCrud) Get() error {
+func (s *DatabaseAutonomousVmClusterResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetAutonomousVmClusterRequest{}
 
 	tmp := s.D.Id()
@@ -535,7 +536,7 @@ func (s *DatabaseAutonomousVmClusterResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetAutonomousVmCluster(context.Background(), request)
+	response, err := s.Client.GetAutonomousVmCluster(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -544,11 +545,11 @@ func (s *DatabaseAutonomousVmClusterResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseAutonomousVmClusterResourceCrud) Update() error {
+func (s *DatabaseAutonomousVmClusterResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -588,7 +589,7 @@ func (s *DatabaseAutonomousVmClusterResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateAutonomousVmCluster(context.Background(), request)
+	response, err := s.Client.UpdateAutonomousVmCluster(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -597,7 +598,7 @@ func (s *DatabaseAutonomousVmClusterResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DatabaseAutonomousVmClusterResourceCrud) Delete() error {
+func (s *DatabaseAutonomousVmClusterResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database.DeleteAutonomousVmClusterRequest{}
 
 	tmp := s.D.Id()
@@ -605,7 +606,7 @@ func (s *DatabaseAutonomousVmClusterResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteAutonomousVmCluster(context.Background(), request)
+	_, err := s.Client.DeleteAutonomousVmCluster(ctx, request)
 	return err
 }
 
@@ -887,7 +888,7 @@ func AvmMonthToMap(obj oci_database.Month) map[string]interface{} {
 	return result
 }
 
-func (s *DatabaseAutonomousVmClusterResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DatabaseAutonomousVmClusterResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_database.ChangeAutonomousVmClusterCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -898,12 +899,12 @@ func (s *DatabaseAutonomousVmClusterResourceCrud) updateCompartment(compartment
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeAutonomousVmClusterCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeAutonomousVmClusterCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseAutonomousVmClustersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseAutonomousVmClusters,
+		ReadContext: readDatabaseAutonomousVmClusters,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,12 +44,12 @@ func DatabaseAutonomousVmClustersDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseAutonomousVmClusters(d *schema.ResourceData, m interface{}) error {
+func readDatabaseAutonomousVmClusters(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseAutonomousVmClustersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseAutonomousVmClustersDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *DatabaseAutonomousVmClustersDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseAutonomousVmClustersDataSourceCrud) Get() error {
+func (s *DatabaseAutonomousVmClustersDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListAutonomousVmClustersRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -85,7 +86,7 @@ func (s *DatabaseAutonomousVmClustersDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListAutonomousVmClusters(context.Background(), request)
+	response, err := s.Client.ListAutonomousVmClusters(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *DatabaseAutonomousVmClustersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAutonomousVmClusters(context.Background(), request)
+		listResponse, err := s.Client.ListAutonomousVmClusters(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
@@ -22,12 +23,12 @@ func DatabaseBackupDestinationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseBackupDestinationResource(), fieldMap, readSingularDatabaseBackupDestination)
 }
 
-func readSingularDatabaseBackupDestination(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseBackupDestination(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseBackupDestinationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseBackupDestinationDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DatabaseBackupDestinationDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseBackupDestinationDataSourceCrud) Get() error {
+func (s *DatabaseBackupDestinationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetBackupDestinationRequest{}
 
 	if backupDestinationId, ok := s.D.GetOkExists("backup_destination_id"); ok {
@@ -50,7 +51,7 @@ func (s *DatabaseBackupDestinationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetBackupDestination(context.Background(), request)
+	response, err := s.Client.GetBackupDestination(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -24,11 +25,11 @@ func DatabaseBackupDestinationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseBackupDestination,
-		Read:     readDatabaseBackupDestination,
-		Update:   updateDatabaseBackupDestination,
-		Delete:   deleteDatabaseBackupDestination,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseBackupDestination,
+		ReadContext:   readDatabaseBackupDestination,
+		UpdateContext: updateDatabaseBackupDestination,
+		DeleteContext: deleteDatabaseBackupDestination,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -181,40 +182,40 @@ func DatabaseBackupDestinationResource() *schema.Resource {
 	}
 }
 
-func createDatabaseBackupDestination(d *schema.ResourceData, m interface{}) error {
+func createDatabaseBackupDestination(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseBackupDestinationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseBackupDestination(d *schema.ResourceData, m interface{}) error {
+func readDatabaseBackupDestination(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseBackupDestinationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatabaseBackupDestination(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseBackupDestination(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseBackupDestinationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatabaseBackupDestination(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseBackupDestination(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseBackupDestinationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.DisableNotFoundRetries = true
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabaseBackupDestinationResourceCrud struct {
@@ -249,7 +250,7 @@ func (s *DatabaseBackupDestinationResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DatabaseBackupDestinationResourceCrud) Create() error {
+func (s *DatabaseBackupDestinationResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.CreateBackupDestinationRequest{}
 	err := s.populateTopLevelPolymorphicCreateBackupDestinationRequest(&request)
 	if err != nil {
@@ -258,7 +259,7 @@ func (s *DatabaseBackupDestinationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateBackupDestination(context.Background(), request)
+	response, err := s.Client.CreateBackupDestination(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -267,7 +268,7 @@ func (s *DatabaseBackupDestinationResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabaseBackupDestinationResourceCrud) Get() error {
+func (s *DatabaseBackupDestinationResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetBackupDestinationRequest{}
 
 	tmp := s.D.Id()
@@ -275,7 +276,7 @@ func (s *DatabaseBackupDestinationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetBackupDestination(context.Background(), request)
+	response, err := s.Client.GetBackupDestination(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -284,11 +285,11 @@ func (s *DatabaseBackupDestinationResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseBackupDestinationResourceCrud) Update() error {
+func (s *DatabaseBackupDestinationResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -341,7 +342,7 @@ func (s *DatabaseBackupDestinationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateBackupDestination(context.Background(), request)
+	response, err := s.Client.UpdateBackupDestination(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -350,7 +351,7 @@ func (s *DatabaseBackupDestinationResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DatabaseBackupDestinationResourceCrud) Delete() error {
+func (s *DatabaseBackupDestinationResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database.DeleteBackupDestinationRequest{}
 
 	tmp := s.D.Id()
@@ -358,7 +359,7 @@ func (s *DatabaseBackupDestinationResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteBackupDestination(context.Background(), request)
+	_, err := s.Client.DeleteBackupDestination(ctx, request)
 	return err
 }
 
@@ -637,7 +638,7 @@ func (s *DatabaseBackupDestinationResourceCrud) populateTopLevelPolymorphicCreat
 	return nil
 }
 
-func (s *DatabaseBackupDestinationResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DatabaseBackupDestinationResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_database.ChangeBackupDestinationCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -648,14 +649,14 @@ func (s *DatabaseBackupDestinationResourceCrud) updateCompartment(compartment in
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.ChangeBackupDestinationCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeBackupDestinationCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "backupDestination", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "backupDestination", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
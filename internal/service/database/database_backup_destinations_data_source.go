@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseBackupDestinationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseBackupDestinations,
+		ReadContext: readDatabaseBackupDestinations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -35,12 +36,12 @@ func DatabaseBackupDestinationsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseBackupDestinations(d *schema.ResourceData, m interface{}) error {
+func readDatabaseBackupDestinations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseBackupDestinationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseBackupDestinationsDataSourceCrud struct {
@@ -53,7 +54,7 @@ func (s *DatabaseBackupDestinationsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseBackupDestinationsDataSourceCrud) Get() error {
+func (s *DatabaseBackupDestinationsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListBackupDestinationRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -68,7 +69,7 @@ func (s *DatabaseBackupDestinationsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListBackupDestination(context.Background(), request)
+	response, err := s.Client.ListBackupDestination(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -77,7 +78,7 @@ func (s *DatabaseBackupDestinationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBackupDestination(context.Background(), request)
+		listResponse, err := s.Client.ListBackupDestination(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
@@ -25,9 +26,9 @@ func DatabaseBackupResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("1h"),
 			Delete: tfresource.GetTimeoutDuration("1h"),
 		},
-		Create: createDatabaseBackup,
-		Read:   readDatabaseBackup,
-		Delete: deleteDatabaseBackup,
+		CreateContext: createDatabaseBackup,
+		ReadContext:   readDatabaseBackup,
+		DeleteContext: deleteDatabaseBackup,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"database_id": {
@@ -104,29 +105,29 @@ func DatabaseBackupResource() *schema.Resource {
 	}
 }
 
-func createDatabaseBackup(d *schema.ResourceData, m interface{}) error {
+func createDatabaseBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseBackup(d *schema.ResourceData, m interface{}) error {
+func readDatabaseBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteDatabaseBackup(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabaseBackupResourceCrud struct {
@@ -165,7 +166,7 @@ func (s *DatabaseBackupResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DatabaseBackupResourceCrud) Create() error {
+func (s *DatabaseBackupResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.CreateBackupRequest{}
 
 	if databaseId, ok := s.D.GetOkExists("database_id"); ok {
@@ -180,7 +181,7 @@ func (s *DatabaseBackupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateBackup(context.Background(), request)
+	response, err := s.Client.CreateBackup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -189,7 +190,7 @@ func (s *DatabaseBackupResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabaseBackupResourceCrud) Get() error {
+func (s *DatabaseBackupResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetBackupRequest{}
 
 	tmp := s.D.Id()
@@ -197,7 +198,7 @@ func (s *DatabaseBackupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetBackup(context.Background(), request)
+	response, err := s.Client.GetBackup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -206,7 +207,7 @@ func (s *DatabaseBackupResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseBackupResourceCrud) Delete() error {
+func (s *DatabaseBackupResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database.DeleteBackupRequest{}
 
 	tmp := s.D.Id()
@@ -214,7 +215,7 @@ func (s *DatabaseBackupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteBackup(context.Background(), request)
+	_, err := s.Client.DeleteBackup(ctx, request)
 	return err
 }
 
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
@@ -22,12 +23,12 @@ func DatabaseCloudAutonomousVmClusterDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseCloudAutonomousVmClusterResource(), fieldMap, readSingularDatabaseCloudAutonomousVmCluster)
 }
 
-func readSingularDatabaseCloudAutonomousVmCluster(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseCloudAutonomousVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudAutonomousVmClusterDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseCloudAutonomousVmClusterDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DatabaseCloudAutonomousVmClusterDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseCloudAutonomousVmClusterDataSourceCrud) Get() error {
+func (s *DatabaseCloudAutonomousVmClusterDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetCloudAutonomousVmClusterRequest{}
 
 	if cloudAutonomousVmClusterId, ok := s.D.GetOkExists("cloud_autonomous_vm_cluster_id"); ok {
@@ -50,7 +51,7 @@ func (s *DatabaseCloudAutonomousVmClusterDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetCloudAutonomousVmCluster(context.Background(), request)
+	response, err := s.Client.GetCloudAutonomousVmCluster(ctx, request)
 	if err != nil {
 		return err
 	}
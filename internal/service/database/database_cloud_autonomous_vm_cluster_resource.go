@@ -6,6 +6,7 @@ package database
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -20,11 +21,11 @@ func DatabaseCloudAutonomousVmClusterResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseCloudAutonomousVmCluster,
-		Read:     readDatabaseCloudAutonomousVmCluster,
-		Update:   updateDatabaseCloudAutonomousVmCluster,
-		Delete:   deleteDatabaseCloudAutonomousVmCluster,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseCloudAutonomousVmCluster,
+		ReadContext:   readDatabaseCloudAutonomousVmCluster,
+		UpdateContext: updateDatabaseCloudAutonomousVmCluster,
+		DeleteContext: deleteDatabaseCloudAutonomousVmCluster,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"cloud_exadata_infrastructure_id": {
@@ -199,22 +200,22 @@ func DatabaseCloudAutonomousVmClusterResource() *schema.Resource {
 	}
 }
 
-func createDatabaseCloudAutonomousVmCluster(d *schema.ResourceData, m interface{}) error {
+func createDatabaseCloudAutonomousVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudAutonomousVmClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	if e := tfresource.CreateResource(d, sync); e != nil {
-		return e
+	if e := tfresource.CreateResource(ctx, d, sync); e != nil {
+		return tfresource.HandleDiagError(e)
 	}
 
 	if rotateOrdsCertsTrigger, ok := sync.D.GetOkExists("rotate_ords_certs_trigger"); ok {
 		tmp := rotateOrdsCertsTrigger.(bool)
 		if tmp {
-			err := sync.RotateAutonomousCloudVmClusterOrdsCerts()
+			err := sync.RotateAutonomousCloudVmClusterOrdsCerts(ctx)
 			if err != nil {
-				return err
+				return tfresource.HandleDiagError(err)
 			}
 		}
 	}
@@ -222,9 +223,9 @@ func createDatabaseCloudAutonomousVmCluster(d *schema.ResourceData, m interface{
 	if rotateSslCertsTrigger, ok := sync.D.GetOkExists("rotate_ssl_certs_trigger"); ok {
 		tmp := rotateSslCertsTrigger.(bool)
 		if tmp {
-			err := sync.RotateAutonomousCloudVmClusterSslCerts()
+			err := sync.RotateAutonomousCloudVmClusterSslCerts(ctx)
 			if err != nil {
-				return err
+				return tfresource.HandleDiagError(err)
 			}
 		}
 	}
@@ -232,15 +233,15 @@ func createDatabaseCloudAutonomousVmCluster(d *schema.ResourceData, m interface{
 	return nil
 }
 
-func readDatabaseCloudAutonomousVmCluster(d *schema.ResourceData, m interface{}) error {
+func readDatabaseCloudAutonomousVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudAutonomousVmClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatabaseCloudAutonomousVmCluster(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseCloudAutonomousVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudAutonomousVmClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
@@ -249,9 +250,9 @@ func updateDatabaseCloudAutonomousVmCluster(d *schema.ResourceData, m interface{
 	if rotateOrdsCertsTrigger, ok := sync.D.GetOkExists("rotate_ords_certs_trigger"); ok && sync.D.HasChange("rotate_ords_certs_trigger") {
 		tmp := rotateOrdsCertsTrigger.(bool)
 		if tmp {
-			err := sync.RotateAutonomousCloudVmClusterOrdsCerts()
+			err := sync.RotateAutonomousCloudVmClusterOrdsCerts(ctx)
 			if err != nil {
-				return err
+				return tfresource.HandleDiagError(err)
 			}
 		}
 	}
@@ -259,24 +260,24 @@ func updateDatabaseCloudAutonomousVmCluster(d *schema.ResourceData, m interface{
 	if rotateSslCertsTrigger, ok := sync.D.GetOkExists("rotate_ssl_certs_trigger"); ok && sync.D.HasChange("rotate_ssl_certs_trigger") {
 		tmp := rotateSslCertsTrigger.(bool)
 		if tmp {
-			err := sync.RotateAutonomousCloudVmClusterSslCerts()
+			err := sync.RotateAutonomousCloudVmClusterSslCerts(ctx)
 			if err != nil {
-				return err
+				return tfresource.HandleDiagError(err)
 			}
 		}
 	}
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatabaseCloudAutonomousVmCluster(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseCloudAutonomousVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudAutonomousVmClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.DisableNotFoundRetries = true
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabaseCloudAutonomousVmClusterResourceCrud struct {
@@ -315,7 +316,7 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) DeletedTarget() []string
 	}
 }
 
-func (s *DatabaseCloudAutonomousVmClusterResourceCrud) Create() error {
+func (s *DatabaseCloudAutonomousVmClusterResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.CreateCloudAutonomousVmClusterRequest{}
 
 	if cloudExadataInfrastructureId, ok := s.D.GetOkExists("cloud_exadata_infrastructure_id"); ok {
@@ -380,7 +381,7 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = nil //tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateCloudAutonomousVmCluster(context.Background(), request)
+	response, err := s.Client.CreateCloudAutonomousVmCluster(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -389,7 +390,7 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) Create() error {
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		identifier, err := tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "cloudAutonomousVmCluster", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		identifier, err := tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "cloudAutonomousVmCluster", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if identifier != nil {
 			s.D.SetId(*identifier)
 		}
@@ -398,10 +399,10 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) Create() error {
 		}
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *DatabaseCloudAutonomousVmClusterResourceCrud) Get() error {
+func (s *DatabaseCloudAutonomousVmClusterResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetCloudAutonomousVmClusterRequest{}
 
 	tmp := s.D.Id()
@@ -409,7 +410,7 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetCloudAutonomousVmCluster(context.Background(), request)
+	response, err := s.Client.GetCloudAutonomousVmCluster(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -418,11 +419,11 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseCloudAutonomousVmClusterResourceCrud) Update() error {
+func (s *DatabaseCloudAutonomousVmClusterResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -475,7 +476,7 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateCloudAutonomousVmCluster(context.Background(), request)
+	response, err := s.Client.UpdateCloudAutonomousVmCluster(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -483,16 +484,16 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) Update() error {
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "cloudAutonomousVmCluster", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "cloudAutonomousVmCluster", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *DatabaseCloudAutonomousVmClusterResourceCrud) Delete() error {
+func (s *DatabaseCloudAutonomousVmClusterResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database.DeleteCloudAutonomousVmClusterRequest{}
 
 	tmp := s.D.Id()
@@ -500,14 +501,14 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DeleteCloudAutonomousVmCluster(context.Background(), request)
+	response, err := s.Client.DeleteCloudAutonomousVmCluster(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "cloudAutonomousVmCluster", oci_work_requests.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "cloudAutonomousVmCluster", oci_work_requests.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -656,7 +657,7 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *DatabaseCloudAutonomousVmClusterResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DatabaseCloudAutonomousVmClusterResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_database.ChangeCloudAutonomousVmClusterCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -667,14 +668,14 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) updateCompartment(compart
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.ChangeCloudAutonomousVmClusterCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeCloudAutonomousVmClusterCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "cloudAutonomousVmCluster", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "cloudAutonomousVmCluster", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -682,7 +683,7 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) updateCompartment(compart
 	return nil
 }
 
-func (s *DatabaseCloudAutonomousVmClusterResourceCrud) RotateAutonomousCloudVmClusterOrdsCerts() error {
+func (s *DatabaseCloudAutonomousVmClusterResourceCrud) RotateAutonomousCloudVmClusterOrdsCerts(ctx context.Context) error {
 	request := oci_database.RotateCloudAutonomousVmClusterOrdsCertsRequest{}
 
 	tmp := s.D.Id()
@@ -690,13 +691,13 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) RotateAutonomousCloudVmCl
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.RotateCloudAutonomousVmClusterOrdsCerts(context.Background(), request)
+	response, err := s.Client.RotateCloudAutonomousVmClusterOrdsCerts(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+	_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 	if err != nil {
 		return err
 	}
@@ -704,7 +705,7 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) RotateAutonomousCloudVmCl
 	return nil
 }
 
-func (s *DatabaseCloudAutonomousVmClusterResourceCrud) RotateAutonomousCloudVmClusterSslCerts() error {
+func (s *DatabaseCloudAutonomousVmClusterResourceCrud) RotateAutonomousCloudVmClusterSslCerts(ctx context.Context) error {
 	request := oci_database.RotateCloudAutonomousVmClusterSslCertsRequest{}
 
 	tmp := s.D.Id()
@@ -712,13 +713,13 @@ func (s *DatabaseCloudAutonomousVmClusterResourceCrud) RotateAutonomousCloudVmCl
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.RotateCloudAutonomousVmClusterSslCerts(context.Background(), request)
+	response, err := s.Client.RotateCloudAutonomousVmClusterSslCerts(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+	_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseCloudAutonomousVmClustersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseCloudAutonomousVmClusters,
+		ReadContext: readDatabaseCloudAutonomousVmClusters,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -47,12 +48,12 @@ func DatabaseCloudAutonomousVmClustersDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseCloudAutonomousVmClusters(d *schema.ResourceData, m interface{}) error {
+func readDatabaseCloudAutonomousVmClusters(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudAutonomousVmClustersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseCloudAutonomousVmClustersDataSourceCrud struct {
@@ -65,7 +66,7 @@ func (s *DatabaseCloudAutonomousVmClustersDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseCloudAutonomousVmClustersDataSourceCrud) Get() error {
+func (s *DatabaseCloudAutonomousVmClustersDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListCloudAutonomousVmClustersRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -94,7 +95,7 @@ func (s *DatabaseCloudAutonomousVmClustersDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListCloudAutonomousVmClusters(context.Background(), request)
+	response, err := s.Client.ListCloudAutonomousVmClusters(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *DatabaseCloudAutonomousVmClustersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCloudAutonomousVmClusters(context.Background(), request)
+		listResponse, err := s.Client.ListCloudAutonomousVmClusters(ctx, request)
 		if err != nil {
 			return err
 		}
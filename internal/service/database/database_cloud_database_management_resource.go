@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 	oci_work_requests "github.com/oracle/oci-go-sdk/v65/workrequests"
@@ -16,11 +17,11 @@ import (
 
 func DatabaseCloudDatabaseManagementResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseCloudDatabaseManagement,
-		Update:   updateDatabaseCloudDatabaseManagement,
-		Read:     readDatabaseCloudDatabaseManagement,
-		Delete:   deleteDatabaseCloudDatabaseManagement,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseCloudDatabaseManagement,
+		UpdateContext: updateDatabaseCloudDatabaseManagement,
+		ReadContext:   readDatabaseCloudDatabaseManagement,
+		DeleteContext: deleteDatabaseCloudDatabaseManagement,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"database_id": {
@@ -83,36 +84,36 @@ func DatabaseCloudDatabaseManagementResource() *schema.Resource {
 	}
 }
 
-func createDatabaseCloudDatabaseManagement(d *schema.ResourceData, m interface{}) error {
+func createDatabaseCloudDatabaseManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudDatabaseManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func updateDatabaseCloudDatabaseManagement(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseCloudDatabaseManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudDatabaseManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func readDatabaseCloudDatabaseManagement(d *schema.ResourceData, m interface{}) error {
+func readDatabaseCloudDatabaseManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteDatabaseCloudDatabaseManagement(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseCloudDatabaseManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudDatabaseManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabaseCloudDatabaseManagementResourceCrud struct {
@@ -127,7 +128,7 @@ func (s *DatabaseCloudDatabaseManagementResourceCrud) ID() string {
 	return tfresource.GenerateDataSourceHashID("DatabaseCloudDatabaseManagementResource-", DatabaseCloudDatabaseManagementResource(), s.D)
 }
 
-func (s *DatabaseCloudDatabaseManagementResourceCrud) Create() error {
+func (s *DatabaseCloudDatabaseManagementResourceCrud) Create(ctx context.Context) error {
 
 	var operation bool
 	if enableManagement, ok := s.D.GetOkExists("enable_management"); ok {
@@ -188,20 +189,20 @@ func (s *DatabaseCloudDatabaseManagementResourceCrud) Create() error {
 
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-		response, err := s.Client.EnableDatabaseManagement(context.Background(), request)
+		response, err := s.Client.EnableDatabaseManagement(ctx, request)
 		if err != nil {
 			return err
 		}
 
 		workId := response.OpcWorkRequestId
 		if workId != nil {
-			_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+			_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 			if err != nil {
 				return err
 			}
 		}
 		s.Res = &response.Database
-		return s.getDatabaseFromWorkRequest(workId, oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
+		return s.getDatabaseFromWorkRequest(ctx, workId, oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
 	}
 	// disable
 	request := oci_database.DisableDatabaseManagementRequest{}
@@ -213,23 +214,23 @@ func (s *DatabaseCloudDatabaseManagementResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DisableDatabaseManagement(context.Background(), request)
+	response, err := s.Client.DisableDatabaseManagement(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
 	}
 	s.Res = &response.Database
-	return s.getDatabaseFromWorkRequest(workId, oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getDatabaseFromWorkRequest(ctx, workId, oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *DatabaseCloudDatabaseManagementResourceCrud) Update() error {
+func (s *DatabaseCloudDatabaseManagementResourceCrud) Update(ctx context.Context) error {
 	var operation bool
 	if enableManagement, ok := s.D.GetOkExists("enable_management"); ok {
 		operation = enableManagement.(bool)
@@ -289,20 +290,20 @@ func (s *DatabaseCloudDatabaseManagementResourceCrud) Update() error {
 
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-		response, err := s.Client.ModifyDatabaseManagement(context.Background(), request)
+		response, err := s.Client.ModifyDatabaseManagement(ctx, request)
 		if err != nil {
 			return err
 		}
 
 		workId := response.OpcWorkRequestId
 		if workId != nil {
-			_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+			_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 			if err != nil {
 				return err
 			}
 		}
 		s.Res = &response.Database
-		return s.getDatabaseFromWorkRequest(workId, oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
+		return s.getDatabaseFromWorkRequest(ctx, workId, oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
 	}
 	// disable
 	request := oci_database.DisableDatabaseManagementRequest{}
@@ -314,23 +315,23 @@ func (s *DatabaseCloudDatabaseManagementResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DisableDatabaseManagement(context.Background(), request)
+	response, err := s.Client.DisableDatabaseManagement(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
 	}
 	s.Res = &response.Database
-	return s.getDatabaseFromWorkRequest(workId, oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getDatabaseFromWorkRequest(ctx, workId, oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *DatabaseCloudDatabaseManagementResourceCrud) Delete() error {
+func (s *DatabaseCloudDatabaseManagementResourceCrud) Delete(ctx context.Context) error {
 	var operation bool
 	if enableManagement, ok := s.D.GetOkExists("enable_management"); ok {
 		operation = enableManagement.(bool)
@@ -350,21 +351,21 @@ func (s *DatabaseCloudDatabaseManagementResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DisableDatabaseManagement(context.Background(), request)
+	response, err := s.Client.DisableDatabaseManagement(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err := tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		_, err := tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
 	}
 
 	s.Res = &response.Database
-	return s.getDatabaseFromWorkRequest(workId, oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getDatabaseFromWorkRequest(ctx, workId, oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *DatabaseCloudDatabaseManagementResourceCrud) SetData() error {
@@ -387,8 +388,8 @@ func (s *DatabaseCloudDatabaseManagementResourceCrud) mapToDatabaseManagementCre
 	return result, nil
 }
 
-func (s *DatabaseCloudDatabaseManagementResourceCrud) getDatabaseFromWorkRequest(workId *string, actionTypeEnum oci_work_requests.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
-	databaseId, err := tfresource.WaitForWorkRequest(s.WorkRequestClient, workId, "database", actionTypeEnum, timeout, s.DisableNotFoundRetries, true)
+func (s *DatabaseCloudDatabaseManagementResourceCrud) getDatabaseFromWorkRequest(ctx context.Context, workId *string, actionTypeEnum oci_work_requests.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
+	databaseId, err := tfresource.WaitForWorkRequest(ctx, s.WorkRequestClient, workId, "database", actionTypeEnum, timeout, s.DisableNotFoundRetries, true)
 	log.Printf("[DEBUG] WaitForWorkRequest finished. databaseId: %v err: %v for workId: %v, actionTypeEnum: %v\n", *databaseId, err, *workId, actionTypeEnum)
 	if err != nil {
 		log.Printf("[ERROR] Database management operation failed, attempting to cancel the workrequest: %v for identifier: %v\n", *workId, databaseId)
@@ -397,10 +398,10 @@ func (s *DatabaseCloudDatabaseManagementResourceCrud) getDatabaseFromWorkRequest
 
 	s.D.SetId(*databaseId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *DatabaseCloudDatabaseManagementResourceCrud) Get() error {
+func (s *DatabaseCloudDatabaseManagementResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetDatabaseRequest{}
 
 	tmp := s.D.Id()
@@ -408,7 +409,7 @@ func (s *DatabaseCloudDatabaseManagementResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetDatabase(context.Background(), request)
+	response, err := s.Client.GetDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
@@ -25,10 +26,10 @@ func DatabaseCloudExadataInfrastructureResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("12h"),
 			Delete: tfresource.GetTimeoutDuration("12h"),
 		},
-		Create: createDatabaseCloudExadataInfrastructure,
-		Read:   readDatabaseCloudExadataInfrastructure,
-		Update: updateDatabaseCloudExadataInfrastructure,
-		Delete: deleteDatabaseCloudExadataInfrastructure,
+		CreateContext: createDatabaseCloudExadataInfrastructure,
+		ReadContext:   readDatabaseCloudExadataInfrastructure,
+		UpdateContext: updateDatabaseCloudExadataInfrastructure,
+		DeleteContext: deleteDatabaseCloudExadataInfrastructure,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"availability_domain": {
@@ -229,37 +230,37 @@ func DatabaseCloudExadataInfrastructureResource() *schema.Resource {
 	}
 }
 
-func createDatabaseCloudExadataInfrastructure(d *schema.ResourceData, m interface{}) error {
+func createDatabaseCloudExadataInfrastructure(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudExadataInfrastructureResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseCloudExadataInfrastructure(d *schema.ResourceData, m interface{}) error {
+func readDatabaseCloudExadataInfrastructure(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudExadataInfrastructureResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatabaseCloudExadataInfrastructure(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseCloudExadataInfrastructure(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudExadataInfrastructureResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatabaseCloudExadataInfrastructure(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseCloudExadataInfrastructure(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudExadataInfrastructureResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabaseCloudExadataInfrastructureResourceCrud struct {
@@ -310,7 +311,7 @@ func (s *DatabaseCloudExadataInfrastructureResourceCrud) UpdatedTarget() []strin
 	}
 }
 
-func (s *DatabaseCloudExadataInfrastructureResourceCrud) Create() error {
+func (s *DatabaseCloudExadataInfrastructureResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.CreateCloudExadataInfrastructureRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -385,7 +386,7 @@ func (s *DatabaseCloudExadataInfrastructureResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateCloudExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.CreateCloudExadataInfrastructure(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -394,7 +395,7 @@ func (s *DatabaseCloudExadataInfrastructureResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabaseCloudExadataInfrastructureResourceCrud) Get() error {
+func (s *DatabaseCloudExadataInfrastructureResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetCloudExadataInfrastructureRequest{}
 
 	tmp := s.D.Id()
@@ -402,7 +403,7 @@ func (s *DatabaseCloudExadataInfrastructureResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetCloudExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.GetCloudExadataInfrastructure(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -411,11 +412,11 @@ func (s *DatabaseCloudExadataInfrastructureResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseCloudExadataInfrastructureResourceCrud) Update() error {
+func (s *DatabaseCloudExadataInfrastructureResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -483,7 +484,7 @@ func (s *DatabaseCloudExadataInfrastructureResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateCloudExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.UpdateCloudExadataInfrastructure(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -492,7 +493,7 @@ func (s *DatabaseCloudExadataInfrastructureResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DatabaseCloudExadataInfrastructureResourceCrud) Delete() error {
+func (s *DatabaseCloudExadataInfrastructureResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database.DeleteCloudExadataInfrastructureRequest{}
 
 	tmp := s.D.Id()
@@ -505,7 +506,7 @@ func (s *DatabaseCloudExadataInfrastructureResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteCloudExadataInfrastructure(context.Background(), request)
+	_, err := s.Client.DeleteCloudExadataInfrastructure(ctx, request)
 	return err
 }
 
@@ -714,7 +715,7 @@ func (s *DatabaseCloudExadataInfrastructureResourceCrud) mapToMonth(fieldKeyForm
 	return result, nil
 }
 
-func (s *DatabaseCloudExadataInfrastructureResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DatabaseCloudExadataInfrastructureResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_database.ChangeCloudExadataInfrastructureCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -725,12 +726,12 @@ func (s *DatabaseCloudExadataInfrastructureResourceCrud) updateCompartment(compa
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeCloudExadataInfrastructureCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeCloudExadataInfrastructureCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
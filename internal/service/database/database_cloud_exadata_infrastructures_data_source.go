@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseCloudExadataInfrastructuresDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseCloudExadataInfrastructures,
+		ReadContext: readDatabaseCloudExadataInfrastructures,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,12 +40,12 @@ func DatabaseCloudExadataInfrastructuresDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseCloudExadataInfrastructures(d *schema.ResourceData, m interface{}) error {
+func readDatabaseCloudExadataInfrastructures(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudExadataInfrastructuresDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseCloudExadataInfrastructuresDataSourceCrud struct {
@@ -57,7 +58,7 @@ func (s *DatabaseCloudExadataInfrastructuresDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseCloudExadataInfrastructuresDataSourceCrud) Get() error {
+func (s *DatabaseCloudExadataInfrastructuresDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListCloudExadataInfrastructuresRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -76,7 +77,7 @@ func (s *DatabaseCloudExadataInfrastructuresDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListCloudExadataInfrastructures(context.Background(), request)
+	response, err := s.Client.ListCloudExadataInfrastructures(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *DatabaseCloudExadataInfrastructuresDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCloudExadataInfrastructures(context.Background(), request)
+		listResponse, err := s.Client.ListCloudExadataInfrastructures(ctx, request)
 		if err != nil {
 			return err
 		}
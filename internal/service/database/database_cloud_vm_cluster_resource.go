@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
@@ -29,10 +30,10 @@ func DatabaseCloudVmClusterResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("12h"),
 			Delete: tfresource.GetTimeoutDuration("12h"),
 		},
-		Create: createDatabaseCloudVmCluster,
-		Read:   readDatabaseCloudVmCluster,
-		Update: updateDatabaseCloudVmCluster,
-		Delete: deleteDatabaseCloudVmCluster,
+		CreateContext: createDatabaseCloudVmCluster,
+		ReadContext:   readDatabaseCloudVmCluster,
+		UpdateContext: updateDatabaseCloudVmCluster,
+		DeleteContext: deleteDatabaseCloudVmCluster,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"backup_subnet_id": {
@@ -334,41 +335,41 @@ func DatabaseCloudVmClusterResource() *schema.Resource {
 	}
 }
 
-func createDatabaseCloudVmCluster(d *schema.ResourceData, m interface{}) error {
+func createDatabaseCloudVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudVmClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseCloudVmCluster(d *schema.ResourceData, m interface{}) error {
+func readDatabaseCloudVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudVmClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatabaseCloudVmCluster(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseCloudVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudVmClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatabaseCloudVmCluster(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseCloudVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudVmClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabaseCloudVmClusterResourceCrud struct {
@@ -430,7 +431,7 @@ func (s *DatabaseCloudVmClusterResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *DatabaseCloudVmClusterResourceCrud) Create() error {
+func (s *DatabaseCloudVmClusterResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.CreateCloudVmClusterRequest{}
 
 	if backupNetworkNsgIds, ok := s.D.GetOkExists("backup_network_nsg_ids"); ok {
@@ -588,14 +589,14 @@ func (s *DatabaseCloudVmClusterResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateCloudVmCluster(context.Background(), request)
+	response, err := s.Client.CreateCloudVmCluster(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "cloudVmCluster", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "cloudVmCluster", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -605,7 +606,7 @@ func (s *DatabaseCloudVmClusterResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabaseCloudVmClusterResourceCrud) Get() error {
+func (s *DatabaseCloudVmClusterResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetCloudVmClusterRequest{}
 
 	tmp := s.D.Id()
@@ -613,7 +614,7 @@ func (s *DatabaseCloudVmClusterResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetCloudVmCluster(context.Background(), request)
+	response, err := s.Client.GetCloudVmCluster(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -622,11 +623,11 @@ func (s *DatabaseCloudVmClusterResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseCloudVmClusterResourceCrud) Update() error {
+func (s *DatabaseCloudVmClusterResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -653,7 +654,7 @@ func (s *DatabaseCloudVmClusterResourceCrud) Update() error {
 
 	if cloudExadataInfrastructureId, ok := s.D.GetOkExists("cloud_exadata_infrastructure_id"); ok {
 		if s.Infra == nil || s.Infra.Id == nil {
-			err := s.getInfraInfo(cloudExadataInfrastructureId.(string))
+			err := s.getInfraInfo(ctx, cloudExadataInfrastructureId.(string))
 			if err != nil {
 				log.Printf("[ERROR] Could not get Cloud Exadata Infrastructure info for the : %v", err)
 			}
@@ -669,7 +670,7 @@ func (s *DatabaseCloudVmClusterResourceCrud) Update() error {
 				flexShape := shape.(string) + ".StorageServer"
 
 				if compartmentId, compOk := s.D.GetOkExists("compartment_id"); compOk {
-					flex, err := s.flexAvailableDbStorageInGBs(compartmentId.(string), flexShape)
+					flex, err := s.flexAvailableDbStorageInGBs(ctx, compartmentId.(string), flexShape)
 
 					if err == nil {
 						if storageSizeInGBs, ok := s.D.GetOkExists("storage_size_in_gbs"); ok {
@@ -753,14 +754,14 @@ func (s *DatabaseCloudVmClusterResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateCloudVmCluster(context.Background(), request)
+	response, err := s.Client.UpdateCloudVmCluster(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "cloudVmCluster", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "cloudVmCluster", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -770,7 +771,7 @@ func (s *DatabaseCloudVmClusterResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DatabaseCloudVmClusterResourceCrud) Delete() error {
+func (s *DatabaseCloudVmClusterResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database.DeleteCloudVmClusterRequest{}
 
 	tmp := s.D.Id()
@@ -778,7 +779,7 @@ func (s *DatabaseCloudVmClusterResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteCloudVmCluster(context.Background(), request)
+	_, err := s.Client.DeleteCloudVmCluster(ctx, request)
 	return err
 }
 
@@ -964,7 +965,7 @@ func (s *DatabaseCloudVmClusterResourceCrud) mapToDataCollectionOptions(fieldKey
 	return result, nil
 }
 
-func (s *DatabaseCloudVmClusterResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DatabaseCloudVmClusterResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_database.ChangeCloudVmClusterCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -975,25 +976,25 @@ func (s *DatabaseCloudVmClusterResourceCrud) updateCompartment(compartment inter
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeCloudVmClusterCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeCloudVmClusterCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
 	return nil
 }
 
-func (s *DatabaseCloudVmClusterResourceCrud) flexAvailableDbStorageInGBs(compartmentId string, shapeName string) (int, error) {
+func (s *DatabaseCloudVmClusterResourceCrud) flexAvailableDbStorageInGBs(ctx context.Context, compartmentId string, shapeName string) (int, error) {
 	request := oci_database.ListFlexComponentsRequest{}
 	request.CompartmentId = &compartmentId
 	request.Name = &shapeName
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListFlexComponents(context.Background(), request)
+	response, err := s.Client.ListFlexComponents(ctx, request)
 	if err != nil {
 		return 0, err
 	}
@@ -1004,12 +1005,12 @@ func (s *DatabaseCloudVmClusterResourceCrud) flexAvailableDbStorageInGBs(compart
 	return 0, fmt.Errorf("No flex component found for compartment")
 }
 
-func (s *DatabaseCloudVmClusterResourceCrud) getInfraInfo(ceiId string) error {
+func (s *DatabaseCloudVmClusterResourceCrud) getInfraInfo(ctx context.Context, ceiId string) error {
 	request := oci_database.GetCloudExadataInfrastructureRequest{}
 
 	request.CloudExadataInfrastructureId = &ceiId
 
-	response, err := s.Client.GetCloudExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.GetCloudExadataInfrastructure(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -10,13 +10,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseCloudVmClustersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseCloudVmClusters,
+		ReadContext: readDatabaseCloudVmClusters,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"cloud_exadata_infrastructure_id": {
@@ -44,12 +45,12 @@ func DatabaseCloudVmClustersDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseCloudVmClusters(d *schema.ResourceData, m interface{}) error {
+func readDatabaseCloudVmClusters(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseCloudVmClustersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseCloudVmClustersDataSourceCrud struct {
@@ -62,7 +63,7 @@ func (s *DatabaseCloudVmClustersDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseCloudVmClustersDataSourceCrud) Get() error {
+func (s *DatabaseCloudVmClustersDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListCloudVmClustersRequest{}
 
 	if cloudExadataInfrastructureId, ok := s.D.GetOkExists("cloud_exadata_infrastructure_id"); ok {
@@ -86,7 +87,7 @@ func (s *DatabaseCloudVmClustersDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListCloudVmClusters(context.Background(), request)
+	response, err := s.Client.ListCloudVmClusters(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +96,7 @@ func (s *DatabaseCloudVmClustersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCloudVmClusters(context.Background(), request)
+		listResponse, err := s.Client.ListCloudVmClusters(ctx, request)
 		if err != nil {
 			return err
 		}
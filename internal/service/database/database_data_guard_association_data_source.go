@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
@@ -26,12 +27,12 @@ func DatabaseDataGuardAssociationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseDataGuardAssociationResource(), fieldMap, readSingularDatabaseDataGuardAssociation)
 }
 
-func readSingularDatabaseDataGuardAssociation(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseDataGuardAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDataGuardAssociationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseDataGuardAssociationDataSourceCrud struct {
@@ -44,7 +45,7 @@ func (s *DatabaseDataGuardAssociationDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDataGuardAssociationDataSourceCrud) Get() error {
+func (s *DatabaseDataGuardAssociationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetDataGuardAssociationRequest{}
 
 	if dataGuardAssociationId, ok := s.D.GetOkExists("data_guard_association_id"); ok {
@@ -59,7 +60,7 @@ func (s *DatabaseDataGuardAssociationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetDataGuardAssociation(context.Background(), request)
+	response, err := s.Client.GetDataGuardAssociation(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -28,10 +29,10 @@ func DatabaseDataGuardAssociationResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("2h"),
 			Delete: tfresource.GetTimeoutDuration("2h"),
 		},
-		Create: createDatabaseDataGuardAssociation,
-		Read:   readDatabaseDataGuardAssociation,
-		Update: updateDatabaseDataGuardAssociation,
-		Delete: deleteDatabaseDataGuardAssociation,
+		CreateContext: createDatabaseDataGuardAssociation,
+		ReadContext:   readDatabaseDataGuardAssociation,
+		UpdateContext: updateDatabaseDataGuardAssociation,
+		DeleteContext: deleteDatabaseDataGuardAssociation,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"creation_type": {
@@ -301,36 +302,36 @@ func DatabaseDataGuardAssociationResource() *schema.Resource {
 	}
 }
 
-func createDatabaseDataGuardAssociation(d *schema.ResourceData, m interface{}) error {
+func createDatabaseDataGuardAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDataGuardAssociationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseDataGuardAssociation(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDataGuardAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDataGuardAssociationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatabaseDataGuardAssociation(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseDataGuardAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDataGuardAssociationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatabaseDataGuardAssociation(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseDataGuardAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDataGuardAssociationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabaseDataGuardAssociationResourceCrud struct {
@@ -377,7 +378,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DatabaseDataGuardAssociationResourceCrud) Create() error {
+func (s *DatabaseDataGuardAssociationResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.CreateDataGuardAssociationRequest{}
 	err := s.populateTopLevelPolymorphicCreateDataGuardAssociationRequest(&request)
 	if err != nil {
@@ -386,7 +387,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateDataGuardAssociation(context.Background(), request)
+	response, err := s.Client.CreateDataGuardAssociation(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -395,7 +396,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabaseDataGuardAssociationResourceCrud) Get() error {
+func (s *DatabaseDataGuardAssociationResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetDataGuardAssociationRequest{}
 
 	tmp := s.D.Id()
@@ -408,7 +409,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetDataGuardAssociation(context.Background(), request)
+	response, err := s.Client.GetDataGuardAssociation(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -417,7 +418,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseDataGuardAssociationResourceCrud) Update() error {
+func (s *DatabaseDataGuardAssociationResourceCrud) Update(ctx context.Context) error {
 	request := oci_database.UpdateDataGuardAssociationRequest{}
 
 	tmp := s.D.Id()
@@ -448,7 +449,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateDataGuardAssociation(context.Background(), request)
+	response, err := s.Client.UpdateDataGuardAssociation(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -790,7 +791,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) populateTopLevelPolymorphicCr
 	return nil
 }
 
-func (s *DatabaseDataGuardAssociationResourceCrud) Delete() error {
+func (s *DatabaseDataGuardAssociationResourceCrud) Delete(ctx context.Context) error {
 	if deleteStandbyDbHomeOnDelete, ok := s.D.GetOkExists("delete_standby_db_home_on_delete"); ok {
 		tmp := deleteStandbyDbHomeOnDelete.(string)
 		if tmp != "true" {
@@ -798,7 +799,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Delete() error {
 		}
 	}
 
-	err := s.Get()
+	err := s.Get(ctx)
 	if err != nil {
 		return err
 	}
@@ -813,7 +814,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Delete() error {
 		if s.Res.PeerRole == oci_database.DataGuardAssociationPeerRoleStandby {
 			standbyDbHomeId = s.Res.PeerDbHomeId
 		} else if s.Res.Role == oci_database.DataGuardAssociationRoleStandby {
-			standbyDbHomeId, _ = s.GetDbHomeIdFromDatabaseId(s.Res.DatabaseId)
+			standbyDbHomeId, _ = s.GetDbHomeIdFromDatabaseId(ctx, s.Res.DatabaseId)
 		} else {
 			return fmt.Errorf("could not delete the dataguard association as it is not possible to determine the standby db home")
 		}
@@ -825,14 +826,14 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Delete() error {
 		deleteDbHomeRequest.DbHomeId = standbyDbHomeId
 		deleteDbHomeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-		if _, err = s.Client.DeleteDbHome(context.Background(), deleteDbHomeRequest); err != nil {
+		if _, err = s.Client.DeleteDbHome(ctx, deleteDbHomeRequest); err != nil {
 			return fmt.Errorf("failed to delete the standby db home")
 		}
 
 		getDbHomeRequest := oci_database.GetDbHomeRequest{}
 		getDbHomeRequest.DbHomeId = standbyDbHomeId
 		getDbHomeRequest.RequestMetadata.RetryPolicy = waitForDbHomeToTerminateRetryPolicy(2 * time.Hour)
-		getDbHomeResponse, err := s.Client.GetDbHome(context.Background(), getDbHomeRequest)
+		getDbHomeResponse, err := s.Client.GetDbHome(ctx, getDbHomeRequest)
 
 		if getDbHomeResponse.LifecycleState == oci_database.DbHomeLifecycleStateAvailable {
 			return fmt.Errorf("could not delete the dataguard association as the standby db home could not be deleted")
@@ -844,7 +845,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Delete() error {
 		if s.Res.PeerRole == oci_database.DataGuardAssociationPeerRoleStandby {
 			standbyDbSystemId = s.Res.PeerDbSystemId
 		} else if s.Res.Role == oci_database.DataGuardAssociationRoleStandby {
-			standbyDbSystemId, err = s.GetDbSystemIdFromDatabaseId(s.Res.DatabaseId)
+			standbyDbSystemId, err = s.GetDbSystemIdFromDatabaseId(ctx, s.Res.DatabaseId)
 			if err != nil {
 				return fmt.Errorf("could not delete the dataguard association as the standby DB System Id could not be obtained: %v", err)
 			}
@@ -859,7 +860,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Delete() error {
 		request := oci_database.TerminateDbSystemRequest{}
 		request.DbSystemId = standbyDbSystemId
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
-		_, err := s.Client.TerminateDbSystem(context.Background(), request)
+		_, err := s.Client.TerminateDbSystem(ctx, request)
 		if err != nil {
 			return fmt.Errorf("could not delete standby DB System to delete the data guard association: %v", err)
 		}
@@ -867,7 +868,7 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Delete() error {
 		getDbSystemRequest := oci_database.GetDbSystemRequest{}
 		getDbSystemRequest.DbSystemId = standbyDbSystemId
 		getDbSystemRequest.RequestMetadata.RetryPolicy = waitForDbSystemToTerminateRetryPolicy(2 * time.Hour)
-		getDbSystemResponse, err := s.Client.GetDbSystem(context.Background(), getDbSystemRequest)
+		getDbSystemResponse, err := s.Client.GetDbSystem(ctx, getDbSystemRequest)
 
 		if getDbSystemResponse.LifecycleState == oci_database.DbSystemLifecycleStateAvailable {
 			return fmt.Errorf("could not delete the dataguard association as the dbSystem could not be deleted")
@@ -893,14 +894,14 @@ func (s *DatabaseDataGuardAssociationResourceCrud) Delete() error {
 		deleteDBrequest.DatabaseId = standbyDatabaseId
 		deleteDBrequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-		if _, err = s.Client.DeleteDatabase(context.Background(), deleteDBrequest); err != nil {
+		if _, err = s.Client.DeleteDatabase(ctx, deleteDBrequest); err != nil {
 			return fmt.Errorf("failed to delete the standby database")
 		}
 
 		getDatabaseRequest := oci_database.GetDatabaseRequest{}
 		getDatabaseRequest.DatabaseId = standbyDatabaseId
 		getDatabaseRequest.RequestMetadata.RetryPolicy = waitForDatabaseToTerminateRetryPolicy(2 * time.Hour)
-		getDatabaseResponse, err := s.Client.GetDatabase(context.Background(), getDatabaseRequest)
+		getDatabaseResponse, err := s.Client.GetDatabase(ctx, getDatabaseRequest)
 
 		if getDatabaseResponse.LifecycleState == oci_database.DatabaseLifecycleStateAvailable {
 			return fmt.Errorf("could not delete the dataguard association as the standby database could not be deleted")
@@ -919,36 +920,36 @@ func (s *DatabaseDataGuardAssociationResourceCrud) ExtraWaitPostCreateDelete() t
 	return time.Second * 30
 }
 
-func (s *DatabaseDataGuardAssociationResourceCrud) GetDbHomeIdFromDatabaseId(databaseId *string) (*string, error) {
+func (s *DatabaseDataGuardAssociationResourceCrud) GetDbHomeIdFromDatabaseId(ctx context.Context, databaseId *string) (*string, error) {
 	request := oci_database.GetDatabaseRequest{}
 
 	request.DatabaseId = databaseId
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
 
-	response, err := s.Client.GetDatabase(context.Background(), request)
+	response, err := s.Client.GetDatabase(ctx, request)
 	if err != nil {
 		return nil, err
 	}
 	return response.DbHomeId, nil
 }
 
-func (s *DatabaseDataGuardAssociationResourceCrud) GetDbSystemIdFromDatabaseId(databaseId *string) (*string, error) {
-	dbHomeId, err := s.GetDbHomeIdFromDatabaseId(databaseId)
+func (s *DatabaseDataGuardAssociationResourceCrud) GetDbSystemIdFromDatabaseId(ctx context.Context, databaseId *string) (*string, error) {
+	dbHomeId, err := s.GetDbHomeIdFromDatabaseId(ctx, databaseId)
 	if err != nil {
 		return dbHomeId, err
 	}
-	return s.GetDbSystemIdFromDbHomeId(dbHomeId)
+	return s.GetDbSystemIdFromDbHomeId(ctx, dbHomeId)
 }
 
-func (s *DatabaseDataGuardAssociationResourceCrud) GetDbSystemIdFromDbHomeId(dbHomeId *string) (*string, error) {
+func (s *DatabaseDataGuardAssociationResourceCrud) GetDbSystemIdFromDbHomeId(ctx context.Context, dbHomeId *string) (*string, error) {
 	request := oci_database.GetDbHomeRequest{}
 
 	request.DbHomeId = dbHomeId
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
 
-	response, err := s.Client.GetDbHome(context.Background(), request)
+	response, err := s.Client.GetDbHome(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseDataGuardAssociationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseDataGuardAssociations,
+		ReadContext: readDatabaseDataGuardAssociations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"database_id": {
@@ -31,12 +32,12 @@ func DatabaseDataGuardAssociationsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseDataGuardAssociations(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDataGuardAssociations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDataGuardAssociationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseDataGuardAssociationsDataSourceCrud struct {
@@ -49,7 +50,7 @@ func (s *DatabaseDataGuardAssociationsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDataGuardAssociationsDataSourceCrud) Get() error {
+func (s *DatabaseDataGuardAssociationsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListDataGuardAssociationsRequest{}
 
 	if databaseId, ok := s.D.GetOkExists("database_id"); ok {
@@ -59,7 +60,7 @@ func (s *DatabaseDataGuardAssociationsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListDataGuardAssociations(context.Background(), request)
+	response, err := s.Client.ListDataGuardAssociations(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -68,7 +69,7 @@ func (s *DatabaseDataGuardAssociationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDataGuardAssociations(context.Background(), request)
+		listResponse, err := s.Client.ListDataGuardAssociations(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
@@ -22,12 +23,12 @@ func DatabaseDatabaseDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseDatabaseResource(), fieldMap, readSingularDatabaseDatabase)
 }
 
-func readSingularDatabaseDatabase(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDatabaseDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseDatabaseDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DatabaseDatabaseDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDatabaseDataSourceCrud) Get() error {
+func (s *DatabaseDatabaseDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetDatabaseRequest{}
 
 	if databaseId, ok := s.D.GetOkExists("database_id"); ok {
@@ -50,7 +51,7 @@ func (s *DatabaseDatabaseDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetDatabase(context.Background(), request)
+	response, err := s.Client.GetDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
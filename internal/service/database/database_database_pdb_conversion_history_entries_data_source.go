@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseDatabasePdbConversionHistoryEntriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseDatabasePdbConversionHistoryEntries,
+		ReadContext: readDatabaseDatabasePdbConversionHistoryEntries,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"database_id": {
@@ -91,12 +92,12 @@ func DatabaseDatabasePdbConversionHistoryEntriesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseDatabasePdbConversionHistoryEntries(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDatabasePdbConversionHistoryEntries(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDatabasePdbConversionHistoryEntriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseDatabasePdbConversionHistoryEntriesDataSourceCrud struct {
@@ -109,7 +110,7 @@ func (s *DatabaseDatabasePdbConversionHistoryEntriesDataSourceCrud) VoidState()
 	s.D.SetId("")
 }
 
-func (s *DatabaseDatabasePdbConversionHistoryEntriesDataSourceCrud) Get() error {
+func (s *DatabaseDatabasePdbConversionHistoryEntriesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListPdbConversionHistoryEntriesRequest{}
 
 	if databaseId, ok := s.D.GetOkExists("database_id"); ok {
@@ -127,7 +128,7 @@ func (s *DatabaseDatabasePdbConversionHistoryEntriesDataSourceCrud) Get() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListPdbConversionHistoryEntries(context.Background(), request)
+	response, err := s.Client.ListPdbConversionHistoryEntries(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -136,7 +137,7 @@ func (s *DatabaseDatabasePdbConversionHistoryEntriesDataSourceCrud) Get() error
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPdbConversionHistoryEntries(context.Background(), request)
+		listResponse, err := s.Client.ListPdbConversionHistoryEntries(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseDatabasePdbConversionHistoryEntryDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseDatabasePdbConversionHistoryEntry,
+		ReadContext: readSingularDatabaseDatabasePdbConversionHistoryEntry,
 		Schema: map[string]*schema.Schema{
 			"database_id": {
 				Type:     schema.TypeString,
@@ -70,12 +71,12 @@ func DatabaseDatabasePdbConversionHistoryEntryDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseDatabasePdbConversionHistoryEntry(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseDatabasePdbConversionHistoryEntry(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDatabasePdbConversionHistoryEntryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseDatabasePdbConversionHistoryEntryDataSourceCrud struct {
@@ -88,7 +89,7 @@ func (s *DatabaseDatabasePdbConversionHistoryEntryDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDatabasePdbConversionHistoryEntryDataSourceCrud) Get() error {
+func (s *DatabaseDatabasePdbConversionHistoryEntryDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetPdbConversionHistoryEntryRequest{}
 
 	if databaseId, ok := s.D.GetOkExists("database_id"); ok {
@@ -103,7 +104,7 @@ func (s *DatabaseDatabasePdbConversionHistoryEntryDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetPdbConversionHistoryEntry(context.Background(), request)
+	response, err := s.Client.GetPdbConversionHistoryEntry(ctx, request)
 	if err != nil {
 		return err
 	}
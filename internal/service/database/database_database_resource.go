@@ -21,6 +21,7 @@ import (
 
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -39,10 +40,10 @@ func DatabaseDatabaseResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("2h"),
 			Delete: tfresource.GetTimeoutDuration("2h"),
 		},
-		Create: createDatabaseDatabase,
-		Read:   readDatabaseDatabase,
-		Update: updateDatabaseDatabase,
-		Delete: deleteDatabaseDatabase,
+		CreateContext: createDatabaseDatabase,
+		ReadContext:   readDatabaseDatabase,
+		UpdateContext: updateDatabaseDatabase,
+		DeleteContext: deleteDatabaseDatabase,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"database": {
@@ -448,32 +449,32 @@ func DatabaseDatabaseResource() *schema.Resource {
 	}
 }
 
-func createDatabaseDatabase(d *schema.ResourceData, m interface{}) error {
+func createDatabaseDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseDatabase(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteDatabaseDatabase(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabaseDatabaseResourceCrud struct {
@@ -526,7 +527,7 @@ func (s *DatabaseDatabaseResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *DatabaseDatabaseResourceCrud) Create() error {
+func (s *DatabaseDatabaseResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.CreateDatabaseRequest{}
 	err := s.populateTopLevelPolymorphicCreateDatabaseRequest(&request)
 	if err != nil {
@@ -536,13 +537,13 @@ func (s *DatabaseDatabaseResourceCrud) Create() error {
 	createDatabaseRetryDurationFn := getdatabaseRetryDurationFunction(s.D.Timeout(schema.TimeoutCreate))
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database", createDatabaseRetryDurationFn)
 
-	response, err := s.Client.CreateDatabase(context.Background(), request)
+	response, err := s.Client.CreateDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -552,7 +553,7 @@ func (s *DatabaseDatabaseResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabaseDatabaseResourceCrud) Get() error {
+func (s *DatabaseDatabaseResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetDatabaseRequest{}
 
 	tmp := s.D.Id()
@@ -560,7 +561,7 @@ func (s *DatabaseDatabaseResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetDatabase(context.Background(), request)
+	response, err := s.Client.GetDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -569,7 +570,7 @@ func (s *DatabaseDatabaseResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseDatabaseResourceCrud) Delete() error {
+func (s *DatabaseDatabaseResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database.DeleteDatabaseRequest{}
 
 	tmp := s.D.Id()
@@ -583,7 +584,7 @@ func (s *DatabaseDatabaseResourceCrud) Delete() error {
 	deleteDatabaseRetryDurationFn := getdatabaseRetryDurationFunction(s.D.Timeout(schema.TimeoutDelete))
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database", deleteDatabaseRetryDurationFn)
 
-	_, err := s.Client.DeleteDatabase(context.Background(), request)
+	_, err := s.Client.DeleteDatabase(ctx, request)
 	return err
 }
 
@@ -1011,27 +1012,27 @@ func (s *DatabaseDatabaseResourceCrud) populateTopLevelPolymorphicCreateDatabase
 	return nil
 }
 
-func updateDatabaseDatabase(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func (s *DatabaseDatabaseResourceCrud) Update() error {
+func (s *DatabaseDatabaseResourceCrud) Update(ctx context.Context) error {
 	request := oci_database.UpdateDatabaseRequest{}
 
 	tmp := s.D.Id()
 	request.DatabaseId = &tmp
 
-	error := s.kmsRotation(tmp)
+	error := s.kmsRotation(ctx, tmp)
 	if error != nil {
 		return error
 	}
 
-	err := s.kmsMigration(tmp)
+	err := s.kmsMigration(ctx, tmp)
 	if err != nil {
 		return err
 	}
@@ -1050,14 +1051,14 @@ func (s *DatabaseDatabaseResourceCrud) Update() error {
 	updateDatabaseRetryDurationFn := getdatabaseRetryDurationFunction(s.D.Timeout(schema.TimeoutUpdate))
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database", updateDatabaseRetryDurationFn)
 
-	response, err := s.Client.UpdateDatabase(context.Background(), request)
+	response, err := s.Client.UpdateDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -1193,18 +1194,18 @@ func (s *DatabaseDatabaseResourceCrud) DatabaseToMap(obj *oci_database.Database)
 	return result
 }
 
-func (s *DatabaseDatabaseResourceCrud) kmsRotation(databaseId string) error {
+func (s *DatabaseDatabaseResourceCrud) kmsRotation(ctx context.Context, databaseId string) error {
 	if _, ok := s.D.GetOkExists("kms_key_rotation"); ok && s.D.HasChange("kms_key_rotation") {
 		rotateKeyRequest := oci_database.RotateVaultKeyRequest{}
 		rotateKeyRequest.DatabaseId = &databaseId
 		rotateKeyRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
-		response, err := s.Client.RotateVaultKey(context.Background(), rotateKeyRequest)
+		response, err := s.Client.RotateVaultKey(ctx, rotateKeyRequest)
 		if err != nil {
 			return err
 		}
 		workId := response.OpcWorkRequestId
 		if workId != nil {
-			_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+			_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 			if err != nil {
 				return err
 			}
@@ -1214,7 +1215,7 @@ func (s *DatabaseDatabaseResourceCrud) kmsRotation(databaseId string) error {
 	return nil
 }
 
-func (s *DatabaseDatabaseResourceCrud) kmsMigration(databaseId string) error {
+func (s *DatabaseDatabaseResourceCrud) kmsMigration(ctx context.Context, databaseId string) error {
 	migrateOperation := false
 	if _, ok := s.D.GetOkExists("kms_key_migration"); ok && s.D.HasChange("kms_key_migration") && s.D.Get("kms_key_migration").(bool) {
 		migrationKeyRequest := oci_database.MigrateVaultKeyRequest{}
@@ -1235,13 +1236,13 @@ func (s *DatabaseDatabaseResourceCrud) kmsMigration(databaseId string) error {
 				migrationKeyRequest.KmsKeyVersionId = &temp
 			}
 		}
-		response, err := s.Client.MigrateVaultKey(context.Background(), migrationKeyRequest)
+		response, err := s.Client.MigrateVaultKey(ctx, migrationKeyRequest)
 		if err != nil {
 			return err
 		}
 		workId := response.OpcWorkRequestId
 		if workId != nil {
-			_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+			_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 			if err != nil {
 			}
 		}
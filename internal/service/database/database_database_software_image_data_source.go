@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
@@ -22,12 +23,12 @@ func DatabaseDatabaseSoftwareImageDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseDatabaseSoftwareImageResource(), fieldMap, readSingularDatabaseDatabaseSoftwareImage)
 }
 
-func readSingularDatabaseDatabaseSoftwareImage(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseDatabaseSoftwareImage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDatabaseSoftwareImageDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseDatabaseSoftwareImageDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DatabaseDatabaseSoftwareImageDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDatabaseSoftwareImageDataSourceCrud) Get() error {
+func (s *DatabaseDatabaseSoftwareImageDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetDatabaseSoftwareImageRequest{}
 
 	if databaseSoftwareImageId, ok := s.D.GetOkExists("database_software_image_id"); ok {
@@ -50,7 +51,7 @@ func (s *DatabaseDatabaseSoftwareImageDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetDatabaseSoftwareImage(context.Background(), request)
+	response, err := s.Client.GetDatabaseSoftwareImage(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
@@ -24,10 +25,10 @@ func DatabaseDatabaseSoftwareImageResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("30m"),
 			Delete: tfresource.GetTimeoutDuration("30m"),
 		},
-		Create: createDatabaseDatabaseSoftwareImage,
-		Read:   readDatabaseDatabaseSoftwareImage,
-		Update: updateDatabaseDatabaseSoftwareImage,
-		Delete: deleteDatabaseDatabaseSoftwareImage,
+		CreateContext: createDatabaseDatabaseSoftwareImage,
+		ReadContext:   readDatabaseDatabaseSoftwareImage,
+		UpdateContext: updateDatabaseDatabaseSoftwareImage,
+		DeleteContext: deleteDatabaseDatabaseSoftwareImage,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -131,37 +132,37 @@ func DatabaseDatabaseSoftwareImageResource() *schema.Resource {
 	}
 }
 
-func createDatabaseDatabaseSoftwareImage(d *schema.ResourceData, m interface{}) error {
+func createDatabaseDatabaseSoftwareImage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDatabaseSoftwareImageResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseDatabaseSoftwareImage(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDatabaseSoftwareImage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDatabaseSoftwareImageResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatabaseDatabaseSoftwareImage(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseDatabaseSoftwareImage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDatabaseSoftwareImageResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatabaseDatabaseSoftwareImage(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseDatabaseSoftwareImage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDatabaseSoftwareImageResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabaseDatabaseSoftwareImageResourceCrud struct {
@@ -201,7 +202,7 @@ func (s *DatabaseDatabaseSoftwareImageResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DatabaseDatabaseSoftwareImageResourceCrud) Create() error {
+func (s *DatabaseDatabaseSoftwareImageResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.CreateDatabaseSoftwareImageRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -269,21 +270,21 @@ func (s *DatabaseDatabaseSoftwareImageResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateDatabaseSoftwareImage(context.Background(), request)
+	response, err := s.Client.CreateDatabaseSoftwareImage(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.DatabaseSoftwareImage
 
-	if waitErr := tfresource.WaitForCreatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForCreatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
 	return nil
 }
 
-func (s *DatabaseDatabaseSoftwareImageResourceCrud) Get() error {
+func (s *DatabaseDatabaseSoftwareImageResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetDatabaseSoftwareImageRequest{}
 
 	tmp := s.D.Id()
@@ -291,7 +292,7 @@ func (s *DatabaseDatabaseSoftwareImageResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetDatabaseSoftwareImage(context.Background(), request)
+	response, err := s.Client.GetDatabaseSoftwareImage(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -300,11 +301,11 @@ func (s *DatabaseDatabaseSoftwareImageResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseDatabaseSoftwareImageResourceCrud) Update() error {
+func (s *DatabaseDatabaseSoftwareImageResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -334,13 +335,13 @@ func (s *DatabaseDatabaseSoftwareImageResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateDatabaseSoftwareImage(context.Background(), request)
+	response, err := s.Client.UpdateDatabaseSoftwareImage(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	// This Update does not support work-request
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -348,7 +349,7 @@ func (s *DatabaseDatabaseSoftwareImageResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DatabaseDatabaseSoftwareImageResourceCrud) Delete() error {
+func (s *DatabaseDatabaseSoftwareImageResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database.DeleteDatabaseSoftwareImageRequest{}
 
 	tmp := s.D.Id()
@@ -356,7 +357,7 @@ func (s *DatabaseDatabaseSoftwareImageResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteDatabaseSoftwareImage(context.Background(), request)
+	_, err := s.Client.DeleteDatabaseSoftwareImage(ctx, request)
 	return err
 }
 
@@ -416,7 +417,7 @@ func (s *DatabaseDatabaseSoftwareImageResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *DatabaseDatabaseSoftwareImageResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DatabaseDatabaseSoftwareImageResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_database.ChangeDatabaseSoftwareImageCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -427,12 +428,12 @@ func (s *DatabaseDatabaseSoftwareImageResourceCrud) updateCompartment(compartmen
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeDatabaseSoftwareImageCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeDatabaseSoftwareImageCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseDatabaseSoftwareImagesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseDatabaseSoftwareImages,
+		ReadContext: readDatabaseDatabaseSoftwareImages,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -51,12 +52,12 @@ func DatabaseDatabaseSoftwareImagesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseDatabaseSoftwareImages(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDatabaseSoftwareImages(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDatabaseSoftwareImagesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseDatabaseSoftwareImagesDataSourceCrud struct {
@@ -69,7 +70,7 @@ func (s *DatabaseDatabaseSoftwareImagesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDatabaseSoftwareImagesDataSourceCrud) Get() error {
+func (s *DatabaseDatabaseSoftwareImagesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListDatabaseSoftwareImagesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -101,7 +102,7 @@ func (s *DatabaseDatabaseSoftwareImagesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListDatabaseSoftwareImages(context.Background(), request)
+	response, err := s.Client.ListDatabaseSoftwareImages(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -110,7 +111,7 @@ func (s *DatabaseDatabaseSoftwareImagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDatabaseSoftwareImages(context.Background(), request)
+		listResponse, err := s.Client.ListDatabaseSoftwareImages(ctx, request)
 		if err != nil {
 			return err
 		}
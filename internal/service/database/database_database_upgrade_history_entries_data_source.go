@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseDatabaseUpgradeHistoryEntriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseDatabaseUpgradeHistoryEntries,
+		ReadContext: readDatabaseDatabaseUpgradeHistoryEntries,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"database_id": {
@@ -95,12 +96,12 @@ func DatabaseDatabaseUpgradeHistoryEntriesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseDatabaseUpgradeHistoryEntries(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDatabaseUpgradeHistoryEntries(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDatabaseUpgradeHistoryEntriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseDatabaseUpgradeHistoryEntriesDataSourceCrud struct {
@@ -113,7 +114,7 @@ func (s *DatabaseDatabaseUpgradeHistoryEntriesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDatabaseUpgradeHistoryEntriesDataSourceCrud) Get() error {
+func (s *DatabaseDatabaseUpgradeHistoryEntriesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListDatabaseUpgradeHistoryEntriesRequest{}
 
 	if databaseId, ok := s.D.GetOkExists("database_id"); ok {
@@ -131,7 +132,7 @@ func (s *DatabaseDatabaseUpgradeHistoryEntriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListDatabaseUpgradeHistoryEntries(context.Background(), request)
+	response, err := s.Client.ListDatabaseUpgradeHistoryEntries(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -140,7 +141,7 @@ func (s *DatabaseDatabaseUpgradeHistoryEntriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDatabaseUpgradeHistoryEntries(context.Background(), request)
+		listResponse, err := s.Client.ListDatabaseUpgradeHistoryEntries(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseDatabaseUpgradeHistoryEntryDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseDatabaseUpgradeHistoryEntry,
+		ReadContext: readSingularDatabaseDatabaseUpgradeHistoryEntry,
 		Schema: map[string]*schema.Schema{
 			"database_id": {
 				Type:     schema.TypeString,
@@ -74,12 +75,12 @@ func DatabaseDatabaseUpgradeHistoryEntryDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseDatabaseUpgradeHistoryEntry(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseDatabaseUpgradeHistoryEntry(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDatabaseUpgradeHistoryEntryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseDatabaseUpgradeHistoryEntryDataSourceCrud struct {
@@ -92,7 +93,7 @@ func (s *DatabaseDatabaseUpgradeHistoryEntryDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDatabaseUpgradeHistoryEntryDataSourceCrud) Get() error {
+func (s *DatabaseDatabaseUpgradeHistoryEntryDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetDatabaseUpgradeHistoryEntryRequest{}
 
 	if databaseId, ok := s.D.GetOkExists("database_id"); ok {
@@ -107,7 +108,7 @@ func (s *DatabaseDatabaseUpgradeHistoryEntryDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetDatabaseUpgradeHistoryEntry(context.Background(), request)
+	response, err := s.Client.GetDatabaseUpgradeHistoryEntry(ctx, request)
 	if err != nil {
 		return err
 	}
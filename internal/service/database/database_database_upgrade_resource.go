@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -22,10 +23,10 @@ import (
 
 func DatabaseDatabaseUpgradeResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseDatabaseUpgrade,
-		Read:     readDatabaseDatabaseUpgrade,
-		Delete:   deleteDatabaseDatabaseUpgrade,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseDatabaseUpgrade,
+		ReadContext:   readDatabaseDatabaseUpgrade,
+		DeleteContext: deleteDatabaseDatabaseUpgrade,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"action": {
@@ -270,24 +271,24 @@ func DatabaseDatabaseUpgradeResource() *schema.Resource {
 	}
 }
 
-func createDatabaseDatabaseUpgrade(d *schema.ResourceData, m interface{}) error {
+func createDatabaseDatabaseUpgrade(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDatabaseUpgradeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseDatabaseUpgrade(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDatabaseUpgrade(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDatabaseUpgradeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteDatabaseDatabaseUpgrade(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseDatabaseUpgrade(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -315,7 +316,7 @@ func (s *DatabaseDatabaseUpgradeResourceCrud) CreatedTarget() []string {
 	}
 }
 
-func (s *DatabaseDatabaseUpgradeResourceCrud) Create() error {
+func (s *DatabaseDatabaseUpgradeResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.UpgradeDatabaseRequest{}
 
 	if action, ok := s.D.GetOkExists("action"); ok {
@@ -340,7 +341,7 @@ func (s *DatabaseDatabaseUpgradeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpgradeDatabase(context.Background(), request)
+	response, err := s.Client.UpgradeDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -349,11 +350,11 @@ func (s *DatabaseDatabaseUpgradeResourceCrud) Create() error {
 
 	workId := response.OpcWorkRequestId
 
-	return s.getDatabaseUpgradeFromWorkRequest(workId, oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getDatabaseUpgradeFromWorkRequest(ctx, workId, oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *DatabaseDatabaseUpgradeResourceCrud) getDatabaseUpgradeFromWorkRequest(workId *string, actionTypeEnum oci_work_requests.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
-	databaseUpgradeId, err := tfresource.WaitForWorkRequest(s.WorkRequestClient, workId, "database", actionTypeEnum, timeout, s.DisableNotFoundRetries, true)
+func (s *DatabaseDatabaseUpgradeResourceCrud) getDatabaseUpgradeFromWorkRequest(ctx context.Context, workId *string, actionTypeEnum oci_work_requests.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
+	databaseUpgradeId, err := tfresource.WaitForWorkRequest(ctx, s.WorkRequestClient, workId, "database", actionTypeEnum, timeout, s.DisableNotFoundRetries, true)
 	log.Printf("[DEBUG] WaitForWorkRequest finished. databaseUpgradeId: %v err: %v for workId: %v, actionTypeEnum: %v\n", *databaseUpgradeId, err, *workId, actionTypeEnum)
 	if err != nil {
 		log.Printf("[ERROR] Database upgrade operation failed, attempting to cancel the workrequest: %v for identifier: %v\n", *workId, databaseUpgradeId)
@@ -362,7 +363,7 @@ func (s *DatabaseDatabaseUpgradeResourceCrud) getDatabaseUpgradeFromWorkRequest(
 
 	s.D.SetId(*databaseUpgradeId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func (s *DatabaseDatabaseUpgradeResourceCrud) SetData() error {
@@ -469,7 +470,7 @@ func (s *DatabaseDatabaseUpgradeResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *DatabaseDatabaseUpgradeResourceCrud) Get() error {
+func (s *DatabaseDatabaseUpgradeResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetDatabaseRequest{}
 
 	tmp := s.D.Id()
@@ -477,7 +478,7 @@ func (s *DatabaseDatabaseUpgradeResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetDatabase(context.Background(), request)
+	response, err := s.Client.GetDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
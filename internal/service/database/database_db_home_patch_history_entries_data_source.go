@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseDbHomePatchHistoryEntriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseDbHomePatchHistoryEntries,
+		ReadContext: readDatabaseDbHomePatchHistoryEntries,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"db_home_id": {
@@ -67,12 +68,12 @@ func DatabaseDbHomePatchHistoryEntriesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseDbHomePatchHistoryEntries(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDbHomePatchHistoryEntries(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbHomePatchHistoryEntriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseDbHomePatchHistoryEntriesDataSourceCrud struct {
@@ -85,7 +86,7 @@ func (s *DatabaseDbHomePatchHistoryEntriesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDbHomePatchHistoryEntriesDataSourceCrud) Get() error {
+func (s *DatabaseDbHomePatchHistoryEntriesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListDbHomePatchHistoryEntriesRequest{}
 
 	if dbHomeId, ok := s.D.GetOkExists("db_home_id"); ok {
@@ -95,7 +96,7 @@ func (s *DatabaseDbHomePatchHistoryEntriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListDbHomePatchHistoryEntries(context.Background(), request)
+	response, err := s.Client.ListDbHomePatchHistoryEntries(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -104,7 +105,7 @@ func (s *DatabaseDbHomePatchHistoryEntriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbHomePatchHistoryEntries(context.Background(), request)
+		listResponse, err := s.Client.ListDbHomePatchHistoryEntries(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseDbHomePatchesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseDbHomePatches,
+		ReadContext: readDatabaseDbHomePatches,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"db_home_id": {
@@ -74,12 +75,12 @@ func DatabaseDbHomePatchesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseDbHomePatches(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDbHomePatches(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbHomePatchesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseDbHomePatchesDataSourceCrud struct {
@@ -92,7 +93,7 @@ func (s *DatabaseDbHomePatchesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDbHomePatchesDataSourceCrud) Get() error {
+func (s *DatabaseDbHomePatchesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListDbHomePatchesRequest{}
 
 	if dbHomeId, ok := s.D.GetOkExists("db_home_id"); ok {
@@ -102,7 +103,7 @@ func (s *DatabaseDbHomePatchesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListDbHomePatches(context.Background(), request)
+	response, err := s.Client.ListDbHomePatches(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -111,7 +112,7 @@ func (s *DatabaseDbHomePatchesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbHomePatches(context.Background(), request)
+		listResponse, err := s.Client.ListDbHomePatches(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -15,6 +15,7 @@ import (
 
 	oci_work_requests "github.com/oracle/oci-go-sdk/v65/workrequests"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -33,10 +34,10 @@ func DatabaseDbHomeResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("2h"),
 			Delete: tfresource.GetTimeoutDuration("2h"),
 		},
-		Create: createDatabaseDbHome,
-		Read:   readDatabaseDbHome,
-		Update: updateDatabaseDbHome,
-		Delete: deleteDatabaseDbHome,
+		CreateContext: createDatabaseDbHome,
+		ReadContext:   readDatabaseDbHome,
+		UpdateContext: updateDatabaseDbHome,
+		DeleteContext: deleteDatabaseDbHome,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"database": {
@@ -381,32 +382,32 @@ func DatabaseDbHomeResource() *schema.Resource {
 	}
 }
 
-func createDatabaseDbHome(d *schema.ResourceData, m interface{}) error {
+func createDatabaseDbHome(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbHomeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseDbHome(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDbHome(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbHomeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteDatabaseDbHome(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseDbHome(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbHomeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabaseDbHomeResourceCrud struct {
@@ -458,7 +459,7 @@ func (s *DatabaseDbHomeResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *DatabaseDbHomeResourceCrud) Create() error {
+func (s *DatabaseDbHomeResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.CreateDbHomeRequest{}
 	err := s.populateTopLevelPolymorphicCreateDbHomeRequest(&request)
 	if err != nil {
@@ -471,7 +472,7 @@ func (s *DatabaseDbHomeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database", createDbHomeRetryDurationFn)
 
-	response, err := s.Client.CreateDbHome(context.Background(), request)
+	response, err := s.Client.CreateDbHome(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -481,7 +482,7 @@ func (s *DatabaseDbHomeResourceCrud) Create() error {
 	if database, ok := s.D.GetOkExists("database"); ok {
 		if tmpList := database.([]interface{}); len(tmpList) > 0 {
 			if workId != nil {
-				_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+				_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 				if err != nil {
 					return err
 				}
@@ -489,7 +490,7 @@ func (s *DatabaseDbHomeResourceCrud) Create() error {
 
 			s.Res = &response.DbHome
 
-			err = s.getDatabaseInfo()
+			err = s.getDatabaseInfo(ctx)
 			if err != nil {
 				log.Printf("[ERROR] Could not get Database info for the dbHome: %v", err)
 			}
@@ -497,7 +498,7 @@ func (s *DatabaseDbHomeResourceCrud) Create() error {
 	} else {
 
 		if workId != nil {
-			_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "dbHome", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+			_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "dbHome", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 			if err != nil {
 				return err
 			}
@@ -509,7 +510,7 @@ func (s *DatabaseDbHomeResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabaseDbHomeResourceCrud) Get() error {
+func (s *DatabaseDbHomeResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetDbHomeRequest{}
 
 	tmp := s.D.Id()
@@ -517,7 +518,7 @@ func (s *DatabaseDbHomeResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetDbHome(context.Background(), request)
+	response, err := s.Client.GetDbHome(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -527,7 +528,7 @@ func (s *DatabaseDbHomeResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseDbHomeResourceCrud) Update() error {
+func (s *DatabaseDbHomeResourceCrud) Update(ctx context.Context) error {
 	updateDbHomeRequest := oci_database.UpdateDbHomeRequest{}
 
 	tmp := s.D.Id()
@@ -555,26 +556,26 @@ func (s *DatabaseDbHomeResourceCrud) Update() error {
 			}
 		}
 	}
-	response, err := s.Client.UpdateDbHome(context.Background(), updateDbHomeRequest)
+	response, err := s.Client.UpdateDbHome(ctx, updateDbHomeRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
 	}
 
 	s.Res = &response.DbHome
-	err = s.Get()
+	err = s.Get(ctx)
 	if err != nil {
 		log.Printf("[ERROR] error refreshing the dbHome information before an upate: %v", err)
 	}
 	if s.Database == nil || s.Database.Id == nil {
-		err := s.getDatabaseInfo()
+		err := s.getDatabaseInfo(ctx)
 		if err != nil {
 			return fmt.Errorf("could not perform an Update as we could not get the databaseId in the dbHome: %v", err)
 		}
@@ -596,14 +597,14 @@ func (s *DatabaseDbHomeResourceCrud) Update() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
-	updateDatabaseResponse, err := s.Client.UpdateDatabase(context.Background(), request)
+	updateDatabaseResponse, err := s.Client.UpdateDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId = response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -614,7 +615,7 @@ func (s *DatabaseDbHomeResourceCrud) Update() error {
 	getDatabaseRequest.DatabaseId = s.Database.Id
 
 	getDatabaseRequest.RequestMetadata.RetryPolicy = waitForDatabaseUpdateRetryPolicy(s.D.Timeout(schema.TimeoutUpdate))
-	getDatabaseResponse, err := s.Client.GetDatabase(context.Background(), getDatabaseRequest)
+	getDatabaseResponse, err := s.Client.GetDatabase(ctx, getDatabaseRequest)
 	if err != nil {
 		s.Database = &updateDatabaseResponse.Database
 		err = s.SetData()
@@ -629,7 +630,7 @@ func (s *DatabaseDbHomeResourceCrud) Update() error {
 	return err
 }
 
-func (s *DatabaseDbHomeResourceCrud) Delete() error {
+func (s *DatabaseDbHomeResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database.DeleteDbHomeRequest{}
 
 	tmp := s.D.Id()
@@ -645,12 +646,12 @@ func (s *DatabaseDbHomeResourceCrud) Delete() error {
 	deleteDbHomeRetryDurationFn := tfresource.GetDbHomeRetryDurationFunction(s.D.Timeout(schema.TimeoutDelete))
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database", deleteDbHomeRetryDurationFn)
 
-	dbErr := s.deleteNestedDB()
+	dbErr := s.deleteNestedDB(ctx)
 	if dbErr != nil {
 		log.Printf("[WARN] Could not delete nested database in DbHome. Will proceed to delete dbHome: %v", dbErr)
 	}
 
-	_, err := s.Client.DeleteDbHome(context.Background(), request)
+	_, err := s.Client.DeleteDbHome(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -1176,16 +1177,16 @@ func (s *DatabaseDbHomeResourceCrud) populateTopLevelPolymorphicCreateDbHomeRequ
 	return nil
 }
 
-func updateDatabaseDbHome(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseDbHome(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbHomeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func (s *DatabaseDbHomeResourceCrud) deleteNestedDB() error {
+func (s *DatabaseDbHomeResourceCrud) deleteNestedDB(ctx context.Context) error {
 
 	request := oci_database.DeleteDatabaseRequest{}
 
@@ -1203,7 +1204,7 @@ func (s *DatabaseDbHomeResourceCrud) deleteNestedDB() error {
 	listDBRequest.SortBy = oci_database.ListDatabasesSortByTimecreated
 	listDBRequest.SortOrder = oci_database.ListDatabasesSortOrderAsc
 	listDBRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
-	listDatabasesResponse, err := s.Client.ListDatabases(context.Background(), listDBRequest)
+	listDatabasesResponse, err := s.Client.ListDatabases(ctx, listDBRequest)
 	if err != nil {
 		return err
 	}
@@ -1232,11 +1233,11 @@ func (s *DatabaseDbHomeResourceCrud) deleteNestedDB() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DeleteDatabase(context.Background(), request)
+	response, err := s.Client.DeleteDatabase(ctx, request)
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -1245,7 +1246,7 @@ func (s *DatabaseDbHomeResourceCrud) deleteNestedDB() error {
 	return nil
 }
 
-func (s *DatabaseDbHomeResourceCrud) getDatabaseInfo() error {
+func (s *DatabaseDbHomeResourceCrud) getDatabaseInfo(ctx context.Context) error {
 	listDatabasesRequest := oci_database.ListDatabasesRequest{}
 
 	listDatabasesRequest.CompartmentId = s.Res.CompartmentId
@@ -1253,7 +1254,7 @@ func (s *DatabaseDbHomeResourceCrud) getDatabaseInfo() error {
 	listDatabasesRequest.SortBy = oci_database.ListDatabasesSortByTimecreated
 	listDatabasesRequest.SortOrder = oci_database.ListDatabasesSortOrderAsc
 	listDatabasesRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
-	listDatabasesResponse, err := s.Client.ListDatabases(context.Background(), listDatabasesRequest)
+	listDatabasesResponse, err := s.Client.ListDatabases(ctx, listDatabasesRequest)
 	if err != nil {
 		return err
 	}
@@ -1266,7 +1267,7 @@ func (s *DatabaseDbHomeResourceCrud) getDatabaseInfo() error {
 	getDatabaseRequest := oci_database.GetDatabaseRequest{}
 	getDatabaseRequest.DatabaseId = databaseId
 	getDatabaseRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
-	getDatabaseResponse, err := s.Client.GetDatabase(context.Background(), getDatabaseRequest)
+	getDatabaseResponse, err := s.Client.GetDatabase(ctx, getDatabaseRequest)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
@@ -27,12 +28,12 @@ func DatabaseDbNodeConsoleConnectionDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseDbNodeConsoleConnectionResource(), fieldMap, readSingularDatabaseDbNodeConsoleConnection)
 }
 
-func readSingularDatabaseDbNodeConsoleConnection(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseDbNodeConsoleConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbNodeConsoleConnectionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseDbNodeConsoleConnectionDataSourceCrud struct {
@@ -45,7 +46,7 @@ func (s *DatabaseDbNodeConsoleConnectionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDbNodeConsoleConnectionDataSourceCrud) Get() error {
+func (s *DatabaseDbNodeConsoleConnectionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetConsoleConnectionRequest{}
 
 	if dbNodeId, ok := s.D.GetOkExists("db_node_id"); ok {
@@ -68,7 +69,7 @@ func (s *DatabaseDbNodeConsoleConnectionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetConsoleConnection(context.Background(), request)
+	response, err := s.Client.GetConsoleConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
@@ -24,10 +25,10 @@ func DatabaseDbNodeConsoleConnectionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseDbNodeConsoleConnection,
-		Read:     readDatabaseDbNodeConsoleConnection,
-		Delete:   deleteDatabaseDbNodeConsoleConnection,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseDbNodeConsoleConnection,
+		ReadContext:   readDatabaseDbNodeConsoleConnection,
+		DeleteContext: deleteDatabaseDbNodeConsoleConnection,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"db_node_id": {
@@ -64,29 +65,29 @@ func DatabaseDbNodeConsoleConnectionResource() *schema.Resource {
 	}
 }
 
-func createDatabaseDbNodeConsoleConnection(d *schema.ResourceData, m interface{}) error {
+func createDatabaseDbNodeConsoleConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbNodeConsoleConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseDbNodeConsoleConnection(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDbNodeConsoleConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbNodeConsoleConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteDatabaseDbNodeConsoleConnection(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseDbNodeConsoleConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbNodeConsoleConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabaseDbNodeConsoleConnectionResourceCrud struct {
@@ -124,7 +125,7 @@ func (s *DatabaseDbNodeConsoleConnectionResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DatabaseDbNodeConsoleConnectionResourceCrud) Create() error {
+func (s *DatabaseDbNodeConsoleConnectionResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.CreateConsoleConnectionRequest{}
 
 	if dbNodeId, ok := s.D.GetOkExists("db_node_id"); ok {
@@ -139,21 +140,21 @@ func (s *DatabaseDbNodeConsoleConnectionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateConsoleConnection(context.Background(), request)
+	response, err := s.Client.CreateConsoleConnection(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.ConsoleConnection
 
-	if waitErr := tfresource.WaitForCreatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForCreatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
 	return nil
 }
 
-func (s *DatabaseDbNodeConsoleConnectionResourceCrud) Get() error {
+func (s *DatabaseDbNodeConsoleConnectionResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetConsoleConnectionRequest{}
 
 	if dbNodeId, ok := s.D.GetOkExists("db_node_id"); ok {
@@ -174,7 +175,7 @@ func (s *DatabaseDbNodeConsoleConnectionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetConsoleConnection(context.Background(), request)
+	response, err := s.Client.GetConsoleConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -183,7 +184,7 @@ func (s *DatabaseDbNodeConsoleConnectionResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseDbNodeConsoleConnectionResourceCrud) Delete() error {
+func (s *DatabaseDbNodeConsoleConnectionResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database.DeleteConsoleConnectionRequest{}
 
 	if dbNodeId, ok := s.D.GetOkExists("db_node_id"); ok {
@@ -201,7 +202,7 @@ func (s *DatabaseDbNodeConsoleConnectionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteConsoleConnection(context.Background(), request)
+	_, err := s.Client.DeleteConsoleConnection(ctx, request)
 	return err
 }
 
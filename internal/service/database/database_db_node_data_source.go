@@ -6,6 +6,7 @@ package database
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 
@@ -15,7 +16,7 @@ import (
 
 func DatabaseDbNodeDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseDbNode,
+		ReadContext: readSingularDatabaseDbNode,
 		Schema: map[string]*schema.Schema{
 			"db_node_id": {
 				Type:     schema.TypeString,
@@ -106,12 +107,12 @@ func DatabaseDbNodeDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseDbNode(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseDbNode(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbNodeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseDbNodeDataSourceCrud struct {
@@ -124,7 +125,7 @@ func (s *DatabaseDbNodeDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDbNodeDataSourceCrud) Get() error {
+func (s *DatabaseDbNodeDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetDbNodeRequest{}
 
 	if dbNodeId, ok := s.D.GetOkExists("db_node_id"); ok {
@@ -134,7 +135,7 @@ func (s *DatabaseDbNodeDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetDbNode(context.Background(), request)
+	response, err := s.Client.GetDbNode(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseDbNodesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseDbNodes,
+		ReadContext: readDatabaseDbNodes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -143,12 +144,12 @@ func DatabaseDbNodesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseDbNodes(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDbNodes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbNodesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseDbNodesDataSourceCrud struct {
@@ -161,7 +162,7 @@ func (s *DatabaseDbNodesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDbNodesDataSourceCrud) Get() error {
+func (s *DatabaseDbNodesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListDbNodesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -190,7 +191,7 @@ func (s *DatabaseDbNodesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListDbNodes(context.Background(), request)
+	response, err := s.Client.ListDbNodes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -200,7 +201,7 @@ func (s *DatabaseDbNodesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbNodes(context.Background(), request)
+		listResponse, err := s.Client.ListDbNodes(ctx, request)
 		if err != nil {
 			return err
 		}
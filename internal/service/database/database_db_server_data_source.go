@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseDbServerDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseDbServer,
+		ReadContext: readSingularDatabaseDbServer,
 		Schema: map[string]*schema.Schema{
 			"db_server_id": {
 				Type:     schema.TypeString,
@@ -127,12 +128,12 @@ func DatabaseDbServerDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseDbServer(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseDbServer(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbServerDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseDbServerDataSourceCrud struct {
@@ -145,7 +146,7 @@ func (s *DatabaseDbServerDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDbServerDataSourceCrud) Get() error {
+func (s *DatabaseDbServerDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetDbServerRequest{}
 
 	if dbServerId, ok := s.D.GetOkExists("db_server_id"); ok {
@@ -160,7 +161,7 @@ func (s *DatabaseDbServerDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetDbServer(context.Background(), request)
+	response, err := s.Client.GetDbServer(ctx, request)
 	if err != nil {
 		return err
 	}
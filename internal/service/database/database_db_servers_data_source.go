@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseDbServersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseDbServers,
+		ReadContext: readDatabaseDbServers,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -156,12 +157,12 @@ func DatabaseDbServersDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseDbServers(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDbServers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbServersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseDbServersDataSourceCrud struct {
@@ -174,7 +175,7 @@ func (s *DatabaseDbServersDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDbServersDataSourceCrud) Get() error {
+func (s *DatabaseDbServersDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListDbServersRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -198,7 +199,7 @@ func (s *DatabaseDbServersDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListDbServers(context.Background(), request)
+	response, err := s.Client.ListDbServers(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -207,7 +208,7 @@ func (s *DatabaseDbServersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbServers(context.Background(), request)
+		listResponse, err := s.Client.ListDbServers(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseDbSystemComputePerformancesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseDbSystemComputePerformances,
+		ReadContext: readDatabaseDbSystemComputePerformances,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"db_system_shape": {
@@ -76,12 +77,12 @@ func DatabaseDbSystemComputePerformancesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseDbSystemComputePerformances(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDbSystemComputePerformances(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbSystemComputePerformancesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseDbSystemComputePerformancesDataSourceCrud struct {
@@ -94,7 +95,7 @@ func (s *DatabaseDbSystemComputePerformancesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDbSystemComputePerformancesDataSourceCrud) Get() error {
+func (s *DatabaseDbSystemComputePerformancesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListDbSystemComputePerformancesRequest{}
 
 	if dbSystemShape, ok := s.D.GetOkExists("db_system_shape"); ok {
@@ -104,7 +105,7 @@ func (s *DatabaseDbSystemComputePerformancesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListDbSystemComputePerformances(context.Background(), request)
+	response, err := s.Client.ListDbSystemComputePerformances(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseDbSystemPatchHistoryEntriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseDbSystemPatchHistoryEntries,
+		ReadContext: readDatabaseDbSystemPatchHistoryEntries,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"db_system_id": {
@@ -67,12 +68,12 @@ func DatabaseDbSystemPatchHistoryEntriesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseDbSystemPatchHistoryEntries(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDbSystemPatchHistoryEntries(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbSystemPatchHistoryEntriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseDbSystemPatchHistoryEntriesDataSourceCrud struct {
@@ -85,7 +86,7 @@ func (s *DatabaseDbSystemPatchHistoryEntriesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDbSystemPatchHistoryEntriesDataSourceCrud) Get() error {
+func (s *DatabaseDbSystemPatchHistoryEntriesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListDbSystemPatchHistoryEntriesRequest{}
 
 	if dbSystemId, ok := s.D.GetOkExists("db_system_id"); ok {
@@ -95,7 +96,7 @@ func (s *DatabaseDbSystemPatchHistoryEntriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListDbSystemPatchHistoryEntries(context.Background(), request)
+	response, err := s.Client.ListDbSystemPatchHistoryEntries(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -104,7 +105,7 @@ func (s *DatabaseDbSystemPatchHistoryEntriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbSystemPatchHistoryEntries(context.Background(), request)
+		listResponse, err := s.Client.ListDbSystemPatchHistoryEntries(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseDbSystemPatchesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseDbSystemPatches,
+		ReadContext: readDatabaseDbSystemPatches,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"db_system_id": {
@@ -74,12 +75,12 @@ func DatabaseDbSystemPatchesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseDbSystemPatches(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDbSystemPatches(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbSystemPatchesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseDbSystemPatchesDataSourceCrud struct {
@@ -92,7 +93,7 @@ func (s *DatabaseDbSystemPatchesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDbSystemPatchesDataSourceCrud) Get() error {
+func (s *DatabaseDbSystemPatchesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListDbSystemPatchesRequest{}
 
 	if dbSystemId, ok := s.D.GetOkExists("db_system_id"); ok {
@@ -102,7 +103,7 @@ func (s *DatabaseDbSystemPatchesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListDbSystemPatches(context.Background(), request)
+	response, err := s.Client.ListDbSystemPatches(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -111,7 +112,7 @@ func (s *DatabaseDbSystemPatchesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbSystemPatches(context.Background(), request)
+		listResponse, err := s.Client.ListDbSystemPatches(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -15,6 +15,7 @@ import (
 
 	oci_work_requests "github.com/oracle/oci-go-sdk/v65/workrequests"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -32,10 +33,10 @@ func DatabaseDbSystemResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("2h"),
 			Delete: tfresource.GetTimeoutDuration("2h"),
 		},
-		Create: createDatabaseDbSystem,
-		Read:   readDatabaseDbSystem,
-		Update: updateDatabaseDbSystem,
-		Delete: deleteDatabaseDbSystem,
+		CreateContext: createDatabaseDbSystem,
+		ReadContext:   readDatabaseDbSystem,
+		UpdateContext: updateDatabaseDbSystem,
+		DeleteContext: deleteDatabaseDbSystem,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"availability_domain": {
@@ -912,41 +913,41 @@ func DatabaseDbSystemResource() *schema.Resource {
 	}
 }
 
-func createDatabaseDbSystem(d *schema.ResourceData, m interface{}) error {
+func createDatabaseDbSystem(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbSystemResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return createDBSystemResource(d, sync)
+	return tfresource.HandleDiagError(createDBSystemResource(ctx, d, sync))
 }
 
-func readDatabaseDbSystem(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDbSystem(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbSystemResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatabaseDbSystem(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseDbSystem(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbSystemResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatabaseDbSystem(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseDbSystem(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbSystemResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabaseDbSystemResourceCrud struct {
@@ -1010,7 +1011,7 @@ func (s *DatabaseDbSystemResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *DatabaseDbSystemResourceCrud) Create() error {
+func (s *DatabaseDbSystemResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.LaunchDbSystemRequest{}
 	err := s.populateTopLevelPolymorphicLaunchDbSystemRequest(&request)
 	if err != nil {
@@ -1019,7 +1020,7 @@ func (s *DatabaseDbSystemResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.LaunchDbSystem(context.Background(), request)
+	response, err := s.Client.LaunchDbSystem(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -1028,13 +1029,13 @@ func (s *DatabaseDbSystemResourceCrud) Create() error {
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
 	}
 
-	err = s.getDbHomeInfo()
+	err = s.getDbHomeInfo(ctx)
 	if err != nil {
 		log.Printf("[WARN] Could not get info about the first DbHome in the dbSystem: %v", err)
 	}
@@ -1042,7 +1043,7 @@ func (s *DatabaseDbSystemResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabaseDbSystemResourceCrud) Get() error {
+func (s *DatabaseDbSystemResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetDbSystemRequest{}
 
 	tmp := s.D.Id()
@@ -1050,14 +1051,14 @@ func (s *DatabaseDbSystemResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetDbSystem(context.Background(), request)
+	response, err := s.Client.GetDbSystem(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.DbSystem
 
-	err = s.getDbHomeInfo()
+	err = s.getDbHomeInfo(ctx)
 	if err != nil {
 		log.Printf("[WARN] Could not get info about the first DbHome in the dbSystem: %v", err)
 	}
@@ -1065,11 +1066,11 @@ func (s *DatabaseDbSystemResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseDbSystemResourceCrud) Update() error {
+func (s *DatabaseDbSystemResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -1128,7 +1129,7 @@ func (s *DatabaseDbSystemResourceCrud) Update() error {
 	}
 
 	if licenseModel, ok := s.D.GetOkExists("license_model"); ok && s.D.HasChange("license_model") {
-		if err := s.sendUpdateForLicenseModel(s.D.Id(), licenseModel); err != nil {
+		if err := s.sendUpdateForLicenseModel(ctx, s.D.Id(), licenseModel); err != nil {
 			return err
 		}
 	}
@@ -1184,14 +1185,14 @@ func (s *DatabaseDbSystemResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateDbSystem(context.Background(), request)
+	response, err := s.Client.UpdateDbSystem(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -1200,7 +1201,7 @@ func (s *DatabaseDbSystemResourceCrud) Update() error {
 	s.Res = &response.DbSystem
 
 	// Check lifecycle state of db system
-	getDbSystemResponse, err := waitForDbSystemIfItIsUpdating(s.Res.Id, s.Client, s.D.Timeout(schema.TimeoutUpdate))
+	getDbSystemResponse, err := waitForDbSystemIfItIsUpdating(ctx, s.Res.Id, s.Client, s.D.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		err = s.SetData()
 		if err != nil {
@@ -1216,10 +1217,10 @@ func (s *DatabaseDbSystemResourceCrud) Update() error {
 		return fmt.Errorf("[ERROR] error setting data after dbsystem update but before database Update: %v", err)
 	}
 
-	return s.UpdateDatabaseOperation()
+	return s.UpdateDatabaseOperation(ctx)
 }
 
-func (s *DatabaseDbSystemResourceCrud) Delete() error {
+func (s *DatabaseDbSystemResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database.TerminateDbSystemRequest{}
 
 	tmp := s.D.Id()
@@ -1227,7 +1228,7 @@ func (s *DatabaseDbSystemResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.TerminateDbSystem(context.Background(), request)
+	_, err := s.Client.TerminateDbSystem(ctx, request)
 	return err
 }
 
@@ -2983,7 +2984,7 @@ func (s *DatabaseDbSystemResourceCrud) populateTopLevelPolymorphicLaunchDbSystem
 	return nil
 }
 
-func (s *DatabaseDbSystemResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DatabaseDbSystemResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_database.ChangeDbSystemCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -2994,12 +2995,12 @@ func (s *DatabaseDbSystemResourceCrud) updateCompartment(compartment interface{}
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeDbSystemCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeDbSystemCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -3050,7 +3051,7 @@ func (s *DatabaseDbSystemResourceCrud) mapToUpdateDbBackupConfig(fieldKeyFormat
 	return result, nil
 }
 
-func (s *DatabaseDbSystemResourceCrud) getDbHomeInfo() error {
+func (s *DatabaseDbSystemResourceCrud) getDbHomeInfo(ctx context.Context) error {
 	if s.DbHome == nil {
 		s.DbHome = &oci_database.DbHome{}
 	}
@@ -3073,7 +3074,7 @@ func (s *DatabaseDbSystemResourceCrud) getDbHomeInfo() error {
 			listDbHomeRequest.SortBy = oci_database.ListDbHomesSortByTimecreated
 			listDbHomeRequest.SortOrder = oci_database.ListDbHomesSortOrderAsc
 			listDbHomeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
-			listDbHomeResponse, err := s.Client.ListDbHomes(context.Background(), listDbHomeRequest)
+			listDbHomeResponse, err := s.Client.ListDbHomes(ctx, listDbHomeRequest)
 			if err != nil {
 				return err
 			}
@@ -3093,7 +3094,7 @@ func (s *DatabaseDbSystemResourceCrud) getDbHomeInfo() error {
 	getDbHomeRequest := oci_database.GetDbHomeRequest{}
 	getDbHomeRequest.DbHomeId = dbHomeId
 	getDbHomeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
-	getDbHomeResponse, err := s.Client.GetDbHome(context.Background(), getDbHomeRequest)
+	getDbHomeResponse, err := s.Client.GetDbHome(ctx, getDbHomeRequest)
 	if err != nil {
 		return err
 	}
@@ -3115,7 +3116,7 @@ func (s *DatabaseDbSystemResourceCrud) getDbHomeInfo() error {
 			listDatabasesRequest.SortBy = oci_database.ListDatabasesSortByTimecreated
 			listDatabasesRequest.SortOrder = oci_database.ListDatabasesSortOrderAsc
 			listDatabasesRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
-			listDatabasesResponse, err := s.Client.ListDatabases(context.Background(), listDatabasesRequest)
+			listDatabasesResponse, err := s.Client.ListDatabases(ctx, listDatabasesRequest)
 			if err != nil {
 				return err
 			}
@@ -3133,7 +3134,7 @@ func (s *DatabaseDbSystemResourceCrud) getDbHomeInfo() error {
 	getDatabaseRequest := oci_database.GetDatabaseRequest{}
 	getDatabaseRequest.DatabaseId = databaseId
 	getDatabaseRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
-	getDatabaseResponse, err := s.Client.GetDatabase(context.Background(), getDatabaseRequest)
+	getDatabaseResponse, err := s.Client.GetDatabase(ctx, getDatabaseRequest)
 	if err != nil {
 		return err
 	}
@@ -3152,7 +3153,7 @@ func (s *DatabaseDbSystemResourceCrud) getDbHomeInfo() error {
 	return nil
 }
 
-func waitForDbSystemIfItIsUpdating(dbSystemID *string, client *oci_database.DatabaseClient, timeout time.Duration) (*oci_database.GetDbSystemResponse, error) {
+func waitForDbSystemIfItIsUpdating(ctx context.Context, dbSystemID *string, client *oci_database.DatabaseClient, timeout time.Duration) (*oci_database.GetDbSystemResponse, error) {
 	getDbSystemRequest := oci_database.GetDbSystemRequest{}
 
 	getDbSystemRequest.DbSystemId = dbSystemID
@@ -3167,12 +3168,12 @@ func waitForDbSystemIfItIsUpdating(dbSystemID *string, client *oci_database.Data
 	}
 
 	getDbSystemRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicyWithAdditionalRetryCondition(timeout, dbSystemUpdating, "database")
-	getDbSystemResponse, err := client.GetDbSystem(context.Background(), getDbSystemRequest)
+	getDbSystemResponse, err := client.GetDbSystem(ctx, getDbSystemRequest)
 	return &getDbSystemResponse, err
 }
 
-func (s *DatabaseDbSystemResourceCrud) UpdateDatabaseOperation() error {
-	err := s.getDbHomeInfo()
+func (s *DatabaseDbSystemResourceCrud) UpdateDatabaseOperation(ctx context.Context) error {
+	err := s.getDbHomeInfo(ctx)
 	if err != nil {
 		return err
 	}
@@ -3188,14 +3189,14 @@ func (s *DatabaseDbSystemResourceCrud) UpdateDatabaseOperation() error {
 	}
 
 	updateDatabaseRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
-	updateDatabaseResponse, err := s.Client.UpdateDatabase(context.Background(), updateDatabaseRequest)
+	updateDatabaseResponse, err := s.Client.UpdateDatabase(ctx, updateDatabaseRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := updateDatabaseResponse.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -3206,7 +3207,7 @@ func (s *DatabaseDbSystemResourceCrud) UpdateDatabaseOperation() error {
 	getDatabaseRequest.DatabaseId = s.Database.Id
 
 	getDatabaseRequest.RequestMetadata.RetryPolicy = waitForDatabaseUpdateRetryPolicy(s.D.Timeout(schema.TimeoutUpdate))
-	getDatabaseResponse, err := s.Client.GetDatabase(context.Background(), getDatabaseRequest)
+	getDatabaseResponse, err := s.Client.GetDatabase(ctx, getDatabaseRequest)
 	if err != nil {
 		s.Database = &updateDatabaseResponse.Database
 		err = s.SetData()
@@ -3221,21 +3222,21 @@ func (s *DatabaseDbSystemResourceCrud) UpdateDatabaseOperation() error {
 	return nil
 }
 
-func (s *DatabaseDbSystemResourceCrud) sendUpdateForLicenseModel(dbSystemId string, licenseModel interface{}) error {
+func (s *DatabaseDbSystemResourceCrud) sendUpdateForLicenseModel(ctx context.Context, dbSystemId string, licenseModel interface{}) error {
 	request := oci_database.UpdateDbSystemRequest{}
 	request.LicenseModel = oci_database.UpdateDbSystemDetailsLicenseModelEnum(licenseModel.(string))
 
 	request.DbSystemId = &dbSystemId
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateDbSystem(context.Background(), request)
+	response, err := s.Client.UpdateDbSystem(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -3243,7 +3244,7 @@ func (s *DatabaseDbSystemResourceCrud) sendUpdateForLicenseModel(dbSystemId stri
 
 	s.Res = &response.DbSystem
 
-	getDbSystemResponse, err := waitForDbSystemIfItIsUpdating(s.Res.Id, s.Client, s.D.Timeout(schema.TimeoutUpdate))
+	getDbSystemResponse, err := waitForDbSystemIfItIsUpdating(ctx, s.Res.Id, s.Client, s.D.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		err = s.SetData()
 		if err != nil {
@@ -3447,8 +3448,8 @@ func (s *DatabaseDbSystemResourceCrud) DatabaseToMap(obj *oci_database.Database)
 	return result
 }
 
-func createDBSystemResource(d *schema.ResourceData, sync tfresource.ResourceCreator) error {
-	if e := sync.Create(); e != nil {
+func createDBSystemResource(ctx context.Context, d *schema.ResourceData, sync tfresource.ResourceCreator) error {
+	if e := sync.Create(ctx); e != nil {
 		return tfresource.HandleError(sync, e)
 	}
 
@@ -3466,7 +3467,7 @@ func createDBSystemResource(d *schema.ResourceData, sync tfresource.ResourceCrea
 		}
 	}
 	if stateful, ok := sync.(tfresource.StatefullyCreatedResource); ok {
-		if e := tfresource.WaitForStateRefresh(stateful, timeout, "creation", stateful.CreatedPending(), stateful.CreatedTarget()); e != nil {
+		if e := tfresource.WaitForStateRefresh(ctx, stateful, timeout, "creation", stateful.CreatedPending(), stateful.CreatedTarget()); e != nil {
 			//We need to SetData() here because if there is an error or timeout in the wait for state after the Create() was successful we want to store the resource in the statefile to avoid dangling resources
 			if setDataErr := sync.SetData(); setDataErr != nil {
 				log.Printf("[ERROR] error setting data after waitForStateRefresh() error: %v", setDataErr)
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseDbSystemShapesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseDbSystemShapes,
+		ReadContext: readDatabaseDbSystemShapes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -132,12 +133,12 @@ func DatabaseDbSystemShapesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseDbSystemShapes(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDbSystemShapes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbSystemShapesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseDbSystemShapesDataSourceCrud struct {
@@ -150,7 +151,7 @@ func (s *DatabaseDbSystemShapesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDbSystemShapesDataSourceCrud) Get() error {
+func (s *DatabaseDbSystemShapesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListDbSystemShapesRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -165,7 +166,7 @@ func (s *DatabaseDbSystemShapesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListDbSystemShapes(context.Background(), request)
+	response, err := s.Client.ListDbSystemShapes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -174,7 +175,7 @@ func (s *DatabaseDbSystemShapesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbSystemShapes(context.Background(), request)
+		listResponse, err := s.Client.ListDbSystemShapes(ctx, request)
 		if err != nil {
 			return err
 		}
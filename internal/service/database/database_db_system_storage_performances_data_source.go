@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseDbSystemStoragePerformancesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseDbSystemStoragePerformances,
+		ReadContext: readDatabaseDbSystemStoragePerformances,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"shape_type": {
@@ -165,12 +166,12 @@ func DatabaseDbSystemStoragePerformancesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseDbSystemStoragePerformances(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDbSystemStoragePerformances(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbSystemStoragePerformancesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseDbSystemStoragePerformancesDataSourceCrud struct {
@@ -183,7 +184,7 @@ func (s *DatabaseDbSystemStoragePerformancesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDbSystemStoragePerformancesDataSourceCrud) Get() error {
+func (s *DatabaseDbSystemStoragePerformancesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListDbSystemStoragePerformancesRequest{}
 
 	if shapeType, ok := s.D.GetOkExists("shape_type"); ok {
@@ -197,7 +198,7 @@ func (s *DatabaseDbSystemStoragePerformancesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListDbSystemStoragePerformances(context.Background(), request)
+	response, err := s.Client.ListDbSystemStoragePerformances(ctx, request)
 	if err != nil {
 		return err
 	}
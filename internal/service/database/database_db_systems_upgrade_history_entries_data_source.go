@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseDbSystemsUpgradeHistoryEntriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseDbSystemsUpgradeHistoryEntries,
+		ReadContext: readDatabaseDbSystemsUpgradeHistoryEntries,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"db_system_id": {
@@ -83,12 +84,12 @@ func DatabaseDbSystemsUpgradeHistoryEntriesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseDbSystemsUpgradeHistoryEntries(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDbSystemsUpgradeHistoryEntries(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbSystemsUpgradeHistoryEntriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseDbSystemsUpgradeHistoryEntriesDataSourceCrud struct {
@@ -101,7 +102,7 @@ func (s *DatabaseDbSystemsUpgradeHistoryEntriesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDbSystemsUpgradeHistoryEntriesDataSourceCrud) Get() error {
+func (s *DatabaseDbSystemsUpgradeHistoryEntriesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListDbSystemUpgradeHistoryEntriesRequest{}
 
 	if dbSystemId, ok := s.D.GetOkExists("db_system_id"); ok {
@@ -119,7 +120,7 @@ func (s *DatabaseDbSystemsUpgradeHistoryEntriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListDbSystemUpgradeHistoryEntries(context.Background(), request)
+	response, err := s.Client.ListDbSystemUpgradeHistoryEntries(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -128,7 +129,7 @@ func (s *DatabaseDbSystemsUpgradeHistoryEntriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbSystemUpgradeHistoryEntries(context.Background(), request)
+		listResponse, err := s.Client.ListDbSystemUpgradeHistoryEntries(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseDbSystemsUpgradeHistoryEntryDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseDbSystemsUpgradeHistoryEntry,
+		ReadContext: readSingularDatabaseDbSystemsUpgradeHistoryEntry,
 		Schema: map[string]*schema.Schema{
 			"db_system_id": {
 				Type:     schema.TypeString,
@@ -62,12 +63,12 @@ func DatabaseDbSystemsUpgradeHistoryEntryDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseDbSystemsUpgradeHistoryEntry(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseDbSystemsUpgradeHistoryEntry(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbSystemsUpgradeHistoryEntryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseDbSystemsUpgradeHistoryEntryDataSourceCrud struct {
@@ -80,7 +81,7 @@ func (s *DatabaseDbSystemsUpgradeHistoryEntryDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDbSystemsUpgradeHistoryEntryDataSourceCrud) Get() error {
+func (s *DatabaseDbSystemsUpgradeHistoryEntryDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetDbSystemUpgradeHistoryEntryRequest{}
 
 	if dbSystemId, ok := s.D.GetOkExists("db_system_id"); ok {
@@ -95,7 +96,7 @@ func (s *DatabaseDbSystemsUpgradeHistoryEntryDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetDbSystemUpgradeHistoryEntry(context.Background(), request)
+	response, err := s.Client.GetDbSystemUpgradeHistoryEntry(ctx, request)
 	if err != nil {
 		return err
 	}
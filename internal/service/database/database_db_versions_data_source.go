@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseDbVersionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseDbVersions,
+		ReadContext: readDatabaseDbVersions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -79,12 +80,12 @@ func DatabaseDbVersionsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseDbVersions(d *schema.ResourceData, m interface{}) error {
+func readDatabaseDbVersions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseDbVersionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseDbVersionsDataSourceCrud struct {
@@ -97,7 +98,7 @@ func (s *DatabaseDbVersionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseDbVersionsDataSourceCrud) Get() error {
+func (s *DatabaseDbVersionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListDbVersionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -131,7 +132,7 @@ func (s *DatabaseDbVersionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListDbVersions(context.Background(), request)
+	response, err := s.Client.ListDbVersions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -140,7 +141,7 @@ func (s *DatabaseDbVersionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbVersions(context.Background(), request)
+		listResponse, err := s.Client.ListDbVersions(ctx, request)
 		if err != nil {
 			return err
 		}
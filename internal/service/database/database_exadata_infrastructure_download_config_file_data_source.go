@@ -12,13 +12,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseExadataInfrastructureDownloadConfigFileDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseExadataInfrastructureDownloadConfigFile,
+		ReadContext: readSingularDatabaseExadataInfrastructureDownloadConfigFile,
 		Schema: map[string]*schema.Schema{
 			"base64_encode_content": {
 				Type:     schema.TypeBool,
@@ -38,12 +39,12 @@ func DatabaseExadataInfrastructureDownloadConfigFileDataSource() *schema.Resourc
 	}
 }
 
-func readSingularDatabaseExadataInfrastructureDownloadConfigFile(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseExadataInfrastructureDownloadConfigFile(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExadataInfrastructureDownloadConfigFileDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseExadataInfrastructureDownloadConfigFileDataSourceCrud struct {
@@ -56,7 +57,7 @@ func (s *DatabaseExadataInfrastructureDownloadConfigFileDataSourceCrud) VoidStat
 	s.D.SetId("")
 }
 
-func (s *DatabaseExadataInfrastructureDownloadConfigFileDataSourceCrud) Get() error {
+func (s *DatabaseExadataInfrastructureDownloadConfigFileDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.DownloadExadataInfrastructureConfigFileRequest{}
 
 	if exadataInfrastructureId, ok := s.D.GetOkExists("exadata_infrastructure_id"); ok {
@@ -66,7 +67,7 @@ func (s *DatabaseExadataInfrastructureDownloadConfigFileDataSourceCrud) Get() er
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.DownloadExadataInfrastructureConfigFile(context.Background(), request)
+	response, err := s.Client.DownloadExadataInfrastructureConfigFile(ctx, request)
 	if err != nil {
 		return err
 	}
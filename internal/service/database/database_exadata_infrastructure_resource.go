@@ -14,6 +14,7 @@ import (
 
 	oci_work_requests "github.com/oracle/oci-go-sdk/v65/workrequests"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
@@ -24,11 +25,11 @@ func DatabaseExadataInfrastructureResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseExadataInfrastructure,
-		Read:     readDatabaseExadataInfrastructure,
-		Update:   updateDatabaseExadataInfrastructure,
-		Delete:   deleteDatabaseExadataInfrastructure,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseExadataInfrastructure,
+		ReadContext:   readDatabaseExadataInfrastructure,
+		UpdateContext: updateDatabaseExadataInfrastructure,
+		DeleteContext: deleteDatabaseExadataInfrastructure,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"admin_network_cidr": {
@@ -346,41 +347,41 @@ func DatabaseExadataInfrastructureResource() *schema.Resource {
 	}
 }
 
-func createDatabaseExadataInfrastructure(d *schema.ResourceData, m interface{}) error {
+func createDatabaseExadataInfrastructure(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExadataInfrastructureResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseExadataInfrastructure(d *schema.ResourceData, m interface{}) error {
+func readDatabaseExadataInfrastructure(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExadataInfrastructureResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatabaseExadataInfrastructure(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseExadataInfrastructure(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExadataInfrastructureResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatabaseExadataInfrastructure(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseExadataInfrastructure(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExadataInfrastructureResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabaseExadataInfrastructureResourceCrud struct {
@@ -451,7 +452,7 @@ func (s *DatabaseExadataInfrastructureResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *DatabaseExadataInfrastructureResourceCrud) Create() error {
+func (s *DatabaseExadataInfrastructureResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.CreateExadataInfrastructureRequest{}
 
 	if adminNetworkCIDR, ok := s.D.GetOkExists("admin_network_cidr"); ok {
@@ -594,19 +595,19 @@ func (s *DatabaseExadataInfrastructureResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.CreateExadataInfrastructure(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.ExadataInfrastructure
 
-	if waitErr := tfresource.WaitForCreatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForCreatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
 	if activationFile, ok := s.D.GetOkExists("activation_file"); ok {
-		response, err := s.activateExadataInfrastructure(activationFile.(string), s.D.Id())
+		response, err := s.activateExadataInfrastructure(ctx, activationFile.(string), s.D.Id())
 		if err != nil {
 			s.D.Set("activation_file", "")
 			return err
@@ -617,7 +618,7 @@ func (s *DatabaseExadataInfrastructureResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabaseExadataInfrastructureResourceCrud) Get() error {
+func (s *DatabaseExadataInfrastructureResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetExadataInfrastructureRequest{}
 
 	tmp := s.D.Id()
@@ -625,7 +626,7 @@ func (s *DatabaseExadataInfrastructureResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.GetExadataInfrastructure(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -634,11 +635,11 @@ func (s *DatabaseExadataInfrastructureResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseExadataInfrastructureResourceCrud) Update() error {
+func (s *DatabaseExadataInfrastructureResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -774,7 +775,7 @@ func (s *DatabaseExadataInfrastructureResourceCrud) Update() error {
 		s.D.Get("additional_storage_count").(int) > 0 {
 		if activationFile, ok := s.D.GetOkExists("activation_file"); ok &&
 			s.D.Get("activation_file").(string) != "" {
-			response, err := s.activateExadataInfrastructure(activationFile.(string), s.D.Id())
+			response, err := s.activateExadataInfrastructure(ctx, activationFile.(string), s.D.Id())
 			if err != nil {
 				s.D.Set("activation_file", "")
 				return err
@@ -784,21 +785,21 @@ func (s *DatabaseExadataInfrastructureResourceCrud) Update() error {
 		}
 	}
 
-	response, err := s.Client.UpdateExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.UpdateExadataInfrastructure(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.ExadataInfrastructure
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
 	return nil
 }
 
-func (s *DatabaseExadataInfrastructureResourceCrud) Delete() error {
+func (s *DatabaseExadataInfrastructureResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database.DeleteExadataInfrastructureRequest{}
 
 	tmp := s.D.Id()
@@ -806,7 +807,7 @@ func (s *DatabaseExadataInfrastructureResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteExadataInfrastructure(context.Background(), request)
+	_, err := s.Client.DeleteExadataInfrastructure(ctx, request)
 	return err
 }
 
@@ -1195,7 +1196,7 @@ func ExadataInfrastructureMonthToMap(obj oci_database.Month) map[string]interfac
 	return result
 }
 
-func (s *DatabaseExadataInfrastructureResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DatabaseExadataInfrastructureResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_database.ChangeExadataInfrastructureCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -1206,19 +1207,19 @@ func (s *DatabaseExadataInfrastructureResourceCrud) updateCompartment(compartmen
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeExadataInfrastructureCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeExadataInfrastructureCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
 	return nil
 }
 
-func (s *DatabaseExadataInfrastructureResourceCrud) activateExadataInfrastructure(activationFile string, exadataInfrastructureId string) (*oci_database.ActivateExadataInfrastructureResponse, error) {
+func (s *DatabaseExadataInfrastructureResourceCrud) activateExadataInfrastructure(ctx context.Context, activationFile string, exadataInfrastructureId string) (*oci_database.ActivateExadataInfrastructureResponse, error) {
 	request := oci_database.ActivateExadataInfrastructureRequest{}
 
 	activationKeyFile, err := ioutil.ReadFile(activationFile)
@@ -1233,14 +1234,14 @@ func (s *DatabaseExadataInfrastructureResourceCrud) activateExadataInfrastructur
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.ActivateExadataInfrastructure(context.Background(), request)
+	response, err := s.Client.ActivateExadataInfrastructure(ctx, request)
 	if err != nil {
 		return nil, err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "exadataInfrastructure", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "exadataInfrastructure", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return nil, err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
@@ -22,12 +23,12 @@ func DatabaseExternalContainerDatabaseDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseExternalContainerDatabaseResource(), fieldMap, readSingularDatabaseExternalContainerDatabase)
 }
 
-func readSingularDatabaseExternalContainerDatabase(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseExternalContainerDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExternalContainerDatabaseDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseExternalContainerDatabaseDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DatabaseExternalContainerDatabaseDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseExternalContainerDatabaseDataSourceCrud) Get() error {
+func (s *DatabaseExternalContainerDatabaseDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetExternalContainerDatabaseRequest{}
 
 	if externalContainerDatabaseId, ok := s.D.GetOkExists("external_container_database_id"); ok {
@@ -50,7 +51,7 @@ func (s *DatabaseExternalContainerDatabaseDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetExternalContainerDatabase(context.Background(), request)
+	response, err := s.Client.GetExternalContainerDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
@@ -20,11 +21,11 @@ func DatabaseExternalContainerDatabaseResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseExternalContainerDatabase,
-		Read:     readDatabaseExternalContainerDatabase,
-		Update:   updateDatabaseExternalContainerDatabase,
-		Delete:   deleteDatabaseExternalContainerDatabase,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseExternalContainerDatabase,
+		ReadContext:   readDatabaseExternalContainerDatabase,
+		UpdateContext: updateDatabaseExternalContainerDatabase,
+		DeleteContext: deleteDatabaseExternalContainerDatabase,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -150,40 +151,40 @@ func DatabaseExternalContainerDatabaseResource() *schema.Resource {
 	}
 }
 
-func createDatabaseExternalContainerDatabase(d *schema.ResourceData, m interface{}) error {
+func createDatabaseExternalContainerDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExternalContainerDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseExternalContainerDatabase(d *schema.ResourceData, m interface{}) error {
+func readDatabaseExternalContainerDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExternalContainerDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatabaseExternalContainerDatabase(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseExternalContainerDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExternalContainerDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatabaseExternalContainerDatabase(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseExternalContainerDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExternalContainerDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.DisableNotFoundRetries = true
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabaseExternalContainerDatabaseResourceCrud struct {
@@ -223,7 +224,7 @@ func (s *DatabaseExternalContainerDatabaseResourceCrud) DeletedTarget() []string
 	}
 }
 
-func (s *DatabaseExternalContainerDatabaseResourceCrud) Create() error {
+func (s *DatabaseExternalContainerDatabaseResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.CreateExternalContainerDatabaseRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -250,7 +251,7 @@ func (s *DatabaseExternalContainerDatabaseResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateExternalContainerDatabase(context.Background(), request)
+	response, err := s.Client.CreateExternalContainerDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -259,7 +260,7 @@ func (s *DatabaseExternalContainerDatabaseResourceCrud) Create() error {
 	s.Res = &response.ExternalContainerDatabase
 
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "externalContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "externalContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -268,7 +269,7 @@ func (s *DatabaseExternalContainerDatabaseResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabaseExternalContainerDatabaseResourceCrud) Get() error {
+func (s *DatabaseExternalContainerDatabaseResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetExternalContainerDatabaseRequest{}
 
 	tmp := s.D.Id()
@@ -276,7 +277,7 @@ func (s *DatabaseExternalContainerDatabaseResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetExternalContainerDatabase(context.Background(), request)
+	response, err := s.Client.GetExternalContainerDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -285,11 +286,11 @@ func (s *DatabaseExternalContainerDatabaseResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseExternalContainerDatabaseResourceCrud) Update() error {
+func (s *DatabaseExternalContainerDatabaseResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -319,23 +320,23 @@ func (s *DatabaseExternalContainerDatabaseResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateExternalContainerDatabase(context.Background(), request)
+	response, err := s.Client.UpdateExternalContainerDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "externalContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "externalContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *DatabaseExternalContainerDatabaseResourceCrud) Delete() error {
+func (s *DatabaseExternalContainerDatabaseResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database.DeleteExternalContainerDatabaseRequest{}
 
 	tmp := s.D.Id()
@@ -343,14 +344,14 @@ func (s *DatabaseExternalContainerDatabaseResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DeleteExternalContainerDatabase(context.Background(), request)
+	response, err := s.Client.DeleteExternalContainerDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "externalContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "externalContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -457,7 +458,7 @@ func StackMonitoringContainerConfigToMap(obj *oci_database.StackMonitoringConfig
 	return result
 }
 
-func (s *DatabaseExternalContainerDatabaseResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DatabaseExternalContainerDatabaseResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_database.ChangeExternalContainerDatabaseCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -468,7 +469,7 @@ func (s *DatabaseExternalContainerDatabaseResourceCrud) updateCompartment(compar
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeExternalContainerDatabaseCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeExternalContainerDatabaseCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
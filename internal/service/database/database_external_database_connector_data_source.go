@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
@@ -23,12 +24,12 @@ func DatabaseExternalDatabaseConnectorDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseExternalDatabaseConnectorResource(), fieldMap, readSingularDatabaseExternalDatabaseConnector)
 }
 
-func readSingularDatabaseExternalDatabaseConnector(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseExternalDatabaseConnector(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExternalDatabaseConnectorDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseExternalDatabaseConnectorDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *DatabaseExternalDatabaseConnectorDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseExternalDatabaseConnectorDataSourceCrud) Get() error {
+func (s *DatabaseExternalDatabaseConnectorDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetExternalDatabaseConnectorRequest{}
 
 	if externalDatabaseConnectorId, ok := s.D.GetOkExists("external_database_connector_id"); ok {
@@ -51,7 +52,7 @@ func (s *DatabaseExternalDatabaseConnectorDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetExternalDatabaseConnector(context.Background(), request)
+	response, err := s.Client.GetExternalDatabaseConnector(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -24,11 +25,11 @@ func DatabaseExternalDatabaseConnectorResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseExternalDatabaseConnector,
-		Read:     readDatabaseExternalDatabaseConnector,
-		Update:   updateDatabaseExternalDatabaseConnector,
-		Delete:   deleteDatabaseExternalDatabaseConnector,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseExternalDatabaseConnector,
+		ReadContext:   readDatabaseExternalDatabaseConnector,
+		UpdateContext: updateDatabaseExternalDatabaseConnector,
+		DeleteContext: deleteDatabaseExternalDatabaseConnector,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"connection_credentials": {
@@ -181,41 +182,41 @@ func DatabaseExternalDatabaseConnectorResource() *schema.Resource {
 	}
 }
 
-func createDatabaseExternalDatabaseConnector(d *schema.ResourceData, m interface{}) error {
+func createDatabaseExternalDatabaseConnector(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExternalDatabaseConnectorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseExternalDatabaseConnector(d *schema.ResourceData, m interface{}) error {
+func readDatabaseExternalDatabaseConnector(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExternalDatabaseConnectorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatabaseExternalDatabaseConnector(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseExternalDatabaseConnector(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExternalDatabaseConnectorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatabaseExternalDatabaseConnector(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseExternalDatabaseConnector(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExternalDatabaseConnectorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.DisableNotFoundRetries = true
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabaseExternalDatabaseConnectorResourceCrud struct {
@@ -255,7 +256,7 @@ func (s *DatabaseExternalDatabaseConnectorResourceCrud) DeletedTarget() []string
 	}
 }
 
-func (s *DatabaseExternalDatabaseConnectorResourceCrud) Create() error {
+func (s *DatabaseExternalDatabaseConnectorResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.CreateExternalDatabaseConnectorRequest{}
 	err := s.populateTopLevelPolymorphicCreateExternalDatabaseConnectorRequest(&request)
 	if err != nil {
@@ -264,7 +265,7 @@ func (s *DatabaseExternalDatabaseConnectorResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateExternalDatabaseConnector(context.Background(), request)
+	response, err := s.Client.CreateExternalDatabaseConnector(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -273,7 +274,7 @@ func (s *DatabaseExternalDatabaseConnectorResourceCrud) Create() error {
 	s.Res = &response.ExternalDatabaseConnector
 
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "externalDatabaseConnector", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "externalDatabaseConnector", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -282,7 +283,7 @@ func (s *DatabaseExternalDatabaseConnectorResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabaseExternalDatabaseConnectorResourceCrud) Get() error {
+func (s *DatabaseExternalDatabaseConnectorResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetExternalDatabaseConnectorRequest{}
 
 	tmp := s.D.Id()
@@ -290,7 +291,7 @@ func (s *DatabaseExternalDatabaseConnectorResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetExternalDatabaseConnector(context.Background(), request)
+	response, err := s.Client.GetExternalDatabaseConnector(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -299,7 +300,7 @@ func (s *DatabaseExternalDatabaseConnectorResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseExternalDatabaseConnectorResourceCrud) Update() error {
+func (s *DatabaseExternalDatabaseConnectorResourceCrud) Update(ctx context.Context) error {
 	request := oci_database.UpdateExternalDatabaseConnectorRequest{}
 	err := s.populateTopLevelPolymorphicUpdateExternalDatabaseConnectorRequest(&request)
 	if err != nil {
@@ -308,23 +309,23 @@ func (s *DatabaseExternalDatabaseConnectorResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateExternalDatabaseConnector(context.Background(), request)
+	response, err := s.Client.UpdateExternalDatabaseConnector(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "externalDatabaseConnector", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "externalDatabaseConnector", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *DatabaseExternalDatabaseConnectorResourceCrud) Delete() error {
+func (s *DatabaseExternalDatabaseConnectorResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database.DeleteExternalDatabaseConnectorRequest{}
 
 	tmp := s.D.Id()
@@ -332,14 +333,14 @@ func (s *DatabaseExternalDatabaseConnectorResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DeleteExternalDatabaseConnector(context.Background(), request)
+	response, err := s.Client.DeleteExternalDatabaseConnector(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "externalDatabaseConnector", oci_work_requests.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "externalDatabaseConnector", oci_work_requests.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
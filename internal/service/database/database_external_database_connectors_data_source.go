@@ -10,13 +10,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseExternalDatabaseConnectorsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseExternalDatabaseConnectors,
+		ReadContext: readDatabaseExternalDatabaseConnectors,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -44,12 +45,12 @@ func DatabaseExternalDatabaseConnectorsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseExternalDatabaseConnectors(d *schema.ResourceData, m interface{}) error {
+func readDatabaseExternalDatabaseConnectors(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExternalDatabaseConnectorsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseExternalDatabaseConnectorsDataSourceCrud struct {
@@ -62,7 +63,7 @@ func (s *DatabaseExternalDatabaseConnectorsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseExternalDatabaseConnectorsDataSourceCrud) Get() error {
+func (s *DatabaseExternalDatabaseConnectorsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListExternalDatabaseConnectorsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -86,7 +87,7 @@ func (s *DatabaseExternalDatabaseConnectorsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListExternalDatabaseConnectors(context.Background(), request)
+	response, err := s.Client.ListExternalDatabaseConnectors(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +96,7 @@ func (s *DatabaseExternalDatabaseConnectorsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListExternalDatabaseConnectors(context.Background(), request)
+		listResponse, err := s.Client.ListExternalDatabaseConnectors(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
@@ -22,12 +23,12 @@ func DatabaseExternalNonContainerDatabaseDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseExternalNonContainerDatabaseResource(), fieldMap, readSingularDatabaseExternalNonContainerDatabase)
 }
 
-func readSingularDatabaseExternalNonContainerDatabase(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseExternalNonContainerDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExternalNonContainerDatabaseDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseExternalNonContainerDatabaseDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DatabaseExternalNonContainerDatabaseDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseExternalNonContainerDatabaseDataSourceCrud) Get() error {
+func (s *DatabaseExternalNonContainerDatabaseDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetExternalNonContainerDatabaseRequest{}
 
 	if externalNonContainerDatabaseId, ok := s.D.GetOkExists("external_non_container_database_id"); ok {
@@ -50,7 +51,7 @@ func (s *DatabaseExternalNonContainerDatabaseDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetExternalNonContainerDatabase(context.Background(), request)
+	response, err := s.Client.GetExternalNonContainerDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
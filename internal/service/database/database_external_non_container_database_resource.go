@@ -6,6 +6,7 @@ package database
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -20,11 +21,11 @@ func DatabaseExternalNonContainerDatabaseResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseExternalNonContainerDatabase,
-		Read:     readDatabaseExternalNonContainerDatabase,
-		Update:   updateDatabaseExternalNonContainerDatabase,
-		Delete:   deleteDatabaseExternalNonContainerDatabase,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseExternalNonContainerDatabase,
+		ReadContext:   readDatabaseExternalNonContainerDatabase,
+		UpdateContext: updateDatabaseExternalNonContainerDatabase,
+		DeleteContext: deleteDatabaseExternalNonContainerDatabase,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -171,41 +172,41 @@ func DatabaseExternalNonContainerDatabaseResource() *schema.Resource {
 	}
 }
 
-func createDatabaseExternalNonContainerDatabase(d *schema.ResourceData, m interface{}) error {
+func createDatabaseExternalNonContainerDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExternalNonContainerDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseExternalNonContainerDatabase(d *schema.ResourceData, m interface{}) error {
+func readDatabaseExternalNonContainerDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExternalNonContainerDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatabaseExternalNonContainerDatabase(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseExternalNonContainerDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExternalNonContainerDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatabaseExternalNonContainerDatabase(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseExternalNonContainerDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExternalNonContainerDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.DisableNotFoundRetries = true
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabaseExternalNonContainerDatabaseResourceCrud struct {
@@ -245,7 +246,7 @@ func (s *DatabaseExternalNonContainerDatabaseResourceCrud) DeletedTarget() []str
 	}
 }
 
-func (s *DatabaseExternalNonContainerDatabaseResourceCrud) Create() error {
+func (s *DatabaseExternalNonContainerDatabaseResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.CreateExternalNonContainerDatabaseRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -272,7 +273,7 @@ func (s *DatabaseExternalNonContainerDatabaseResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateExternalNonContainerDatabase(context.Background(), request)
+	response, err := s.Client.CreateExternalNonContainerDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -281,7 +282,7 @@ func (s *DatabaseExternalNonContainerDatabaseResourceCrud) Create() error {
 	s.Res = &response.ExternalNonContainerDatabase
 
 	if workId != nil {
-		_, err := tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "externalNonContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		_, err := tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "externalNonContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -291,7 +292,7 @@ func (s *DatabaseExternalNonContainerDatabaseResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabaseExternalNonContainerDatabaseResourceCrud) Get() error {
+func (s *DatabaseExternalNonContainerDatabaseResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetExternalNonContainerDatabaseRequest{}
 
 	tmp := s.D.Id()
@@ -299,7 +300,7 @@ func (s *DatabaseExternalNonContainerDatabaseResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetExternalNonContainerDatabase(context.Background(), request)
+	response, err := s.Client.GetExternalNonContainerDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -308,11 +309,11 @@ func (s *DatabaseExternalNonContainerDatabaseResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseExternalNonContainerDatabaseResourceCrud) Update() error {
+func (s *DatabaseExternalNonContainerDatabaseResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -342,23 +343,23 @@ func (s *DatabaseExternalNonContainerDatabaseResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateExternalNonContainerDatabase(context.Background(), request)
+	response, err := s.Client.UpdateExternalNonContainerDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "externalNonContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "externalNonContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *DatabaseExternalNonContainerDatabaseResourceCrud) Delete() error {
+func (s *DatabaseExternalNonContainerDatabaseResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database.DeleteExternalNonContainerDatabaseRequest{}
 
 	tmp := s.D.Id()
@@ -366,14 +367,14 @@ func (s *DatabaseExternalNonContainerDatabaseResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DeleteExternalNonContainerDatabase(context.Background(), request)
+	response, err := s.Client.DeleteExternalNonContainerDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "externalNonContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "externalNonContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -498,7 +499,7 @@ func StackMonitoringNonContainerConfigToMap(obj *oci_database.StackMonitoringCon
 	return result
 }
 
-func (s *DatabaseExternalNonContainerDatabaseResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DatabaseExternalNonContainerDatabaseResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_database.ChangeExternalNonContainerDatabaseCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -509,7 +510,7 @@ func (s *DatabaseExternalNonContainerDatabaseResourceCrud) updateCompartment(com
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeExternalNonContainerDatabaseCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeExternalNonContainerDatabaseCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
@@ -22,12 +23,12 @@ func DatabaseExternalPluggableDatabaseDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseExternalPluggableDatabaseResource(), fieldMap, readSingularDatabaseExternalPluggableDatabase)
 }
 
-func readSingularDatabaseExternalPluggableDatabase(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseExternalPluggableDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExternalPluggableDatabaseDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseExternalPluggableDatabaseDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DatabaseExternalPluggableDatabaseDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseExternalPluggableDatabaseDataSourceCrud) Get() error {
+func (s *DatabaseExternalPluggableDatabaseDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetExternalPluggableDatabaseRequest{}
 
 	if externalPluggableDatabaseId, ok := s.D.GetOkExists("external_pluggable_database_id"); ok {
@@ -50,7 +51,7 @@ func (s *DatabaseExternalPluggableDatabaseDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetExternalPluggableDatabase(context.Background(), request)
+	response, err := s.Client.GetExternalPluggableDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
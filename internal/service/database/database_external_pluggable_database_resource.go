@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
@@ -20,11 +21,11 @@ func DatabaseExternalPluggableDatabaseResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseExternalPluggableDatabase,
-		Read:     readDatabaseExternalPluggableDatabase,
-		Update:   updateDatabaseExternalPluggableDatabase,
-		Delete:   deleteDatabaseExternalPluggableDatabase,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseExternalPluggableDatabase,
+		ReadContext:   readDatabaseExternalPluggableDatabase,
+		UpdateContext: updateDatabaseExternalPluggableDatabase,
+		DeleteContext: deleteDatabaseExternalPluggableDatabase,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -182,40 +183,40 @@ func DatabaseExternalPluggableDatabaseResource() *schema.Resource {
 	}
 }
 
-func createDatabaseExternalPluggableDatabase(d *schema.ResourceData, m interface{}) error {
+func createDatabaseExternalPluggableDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExternalPluggableDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseExternalPluggableDatabase(d *schema.ResourceData, m interface{}) error {
+func readDatabaseExternalPluggableDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExternalPluggableDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatabaseExternalPluggableDatabase(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseExternalPluggableDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExternalPluggableDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatabaseExternalPluggableDatabase(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseExternalPluggableDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExternalPluggableDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.DisableNotFoundRetries = true
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabaseExternalPluggableDatabaseResourceCrud struct {
@@ -255,7 +256,7 @@ func (s *DatabaseExternalPluggableDatabaseResourceCrud) DeletedTarget() []string
 	}
 }
 
-func (s *DatabaseExternalPluggableDatabaseResourceCrud) Create() error {
+func (s *DatabaseExternalPluggableDatabaseResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.CreateExternalPluggableDatabaseRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -292,7 +293,7 @@ func (s *DatabaseExternalPluggableDatabaseResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateExternalPluggableDatabase(context.Background(), request)
+	response, err := s.Client.CreateExternalPluggableDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -301,7 +302,7 @@ func (s *DatabaseExternalPluggableDatabaseResourceCrud) Create() error {
 	s.Res = &response.ExternalPluggableDatabase
 
 	if workId != nil {
-		_, err := tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "externalPluggableDatabase", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		_, err := tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "externalPluggableDatabase", oci_work_requests.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -310,7 +311,7 @@ func (s *DatabaseExternalPluggableDatabaseResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabaseExternalPluggableDatabaseResourceCrud) Get() error {
+func (s *DatabaseExternalPluggableDatabaseResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetExternalPluggableDatabaseRequest{}
 
 	tmp := s.D.Id()
@@ -318,7 +319,7 @@ func (s *DatabaseExternalPluggableDatabaseResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetExternalPluggableDatabase(context.Background(), request)
+	response, err := s.Client.GetExternalPluggableDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -327,11 +328,11 @@ func (s *DatabaseExternalPluggableDatabaseResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseExternalPluggableDatabaseResourceCrud) Update() error {
+func (s *DatabaseExternalPluggableDatabaseResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -361,23 +362,23 @@ func (s *DatabaseExternalPluggableDatabaseResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateExternalPluggableDatabase(context.Background(), request)
+	response, err := s.Client.UpdateExternalPluggableDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "externalPluggableDatabase", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "externalPluggableDatabase", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *DatabaseExternalPluggableDatabaseResourceCrud) Delete() error {
+func (s *DatabaseExternalPluggableDatabaseResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database.DeleteExternalPluggableDatabaseRequest{}
 
 	tmp := s.D.Id()
@@ -385,14 +386,14 @@ func (s *DatabaseExternalPluggableDatabaseResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DeleteExternalPluggableDatabase(context.Background(), request)
+	response, err := s.Client.DeleteExternalPluggableDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "externalPluggableDatabase", oci_work_requests.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "externalPluggableDatabase", oci_work_requests.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -525,7 +526,7 @@ func StackMonitoringConfigToMap(obj *oci_database.StackMonitoringConfig) map[str
 	return result
 }
 
-func (s *DatabaseExternalPluggableDatabaseResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DatabaseExternalPluggableDatabaseResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_database.ChangeExternalPluggableDatabaseCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -536,7 +537,7 @@ func (s *DatabaseExternalPluggableDatabaseResourceCrud) updateCompartment(compar
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeExternalPluggableDatabaseCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeExternalPluggableDatabaseCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
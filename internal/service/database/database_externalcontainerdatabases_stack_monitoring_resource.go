@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
@@ -17,11 +18,11 @@ import (
 
 func DatabaseExternalcontainerdatabasesStackMonitoringResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseExternalcontainerdatabasesStackMonitoring,
-		Update:   updateDatabaseExternalcontainerdatabasesStackMonitoring,
-		Read:     readDatabaseExternalcontainerdatabasesStackMonitoring,
-		Delete:   deleteDatabaseExternalcontainerdatabasesStackMonitoring,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseExternalcontainerdatabasesStackMonitoring,
+		UpdateContext: updateDatabaseExternalcontainerdatabasesStackMonitoring,
+		ReadContext:   readDatabaseExternalcontainerdatabasesStackMonitoring,
+		DeleteContext: deleteDatabaseExternalcontainerdatabasesStackMonitoring,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"external_container_database_id": {
@@ -46,31 +47,31 @@ func DatabaseExternalcontainerdatabasesStackMonitoringResource() *schema.Resourc
 	}
 }
 
-func createDatabaseExternalcontainerdatabasesStackMonitoring(d *schema.ResourceData, m interface{}) error {
+func createDatabaseExternalcontainerdatabasesStackMonitoring(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExternalcontainerdatabasesStackMonitoringResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 	sync.Res = &DatabaseExternalcontainerdatabaseStackMonitoringResponse{}
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func updateDatabaseExternalcontainerdatabasesStackMonitoring(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseExternalcontainerdatabasesStackMonitoring(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func readDatabaseExternalcontainerdatabasesStackMonitoring(d *schema.ResourceData, m interface{}) error {
+func readDatabaseExternalcontainerdatabasesStackMonitoring(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteDatabaseExternalcontainerdatabasesStackMonitoring(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseExternalcontainerdatabasesStackMonitoring(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseExternalcontainerdatabasesStackMonitoringResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 	sync.Res = &DatabaseExternalcontainerdatabaseStackMonitoringResponse{}
 	sync.DisableNotFoundRetries = true
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabaseExternalcontainerdatabaseStackMonitoringResponse struct {
@@ -90,7 +91,7 @@ func (s *DatabaseExternalcontainerdatabasesStackMonitoringResourceCrud) ID() str
 	return tfresource.GenerateDataSourceHashID("DatabaseExternalcontainerdatabasesStackMonitoringResource-", DatabaseExternalcontainerdatabasesStackMonitoringResource(), s.D)
 }
 
-func (s *DatabaseExternalcontainerdatabasesStackMonitoringResourceCrud) Create() error {
+func (s *DatabaseExternalcontainerdatabasesStackMonitoringResourceCrud) Create(ctx context.Context) error {
 	var operation_stack_monitoring bool
 	if enableStackMonitoring, ok := s.D.GetOkExists("enable_stack_monitoring"); ok {
 		operation_stack_monitoring = enableStackMonitoring.(bool)
@@ -112,13 +113,13 @@ func (s *DatabaseExternalcontainerdatabasesStackMonitoringResourceCrud) Create()
 
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-		response, err := s.Client.EnableExternalContainerDatabaseStackMonitoring(context.Background(), request)
+		response, err := s.Client.EnableExternalContainerDatabaseStackMonitoring(ctx, request)
 		if err != nil {
 			return err
 		}
 		workId := response.OpcWorkRequestId
 		if workId != nil {
-			identifier, err := tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "externalContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+			identifier, err := tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "externalContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 			if identifier != nil {
 				s.D.SetId(*identifier)
 			}
@@ -139,14 +140,14 @@ func (s *DatabaseExternalcontainerdatabasesStackMonitoringResourceCrud) Create()
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DisableExternalContainerDatabaseStackMonitoring(context.Background(), request)
+	response, err := s.Client.DisableExternalContainerDatabaseStackMonitoring(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "externalContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "externalContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
@@ -155,7 +156,7 @@ func (s *DatabaseExternalcontainerdatabasesStackMonitoringResourceCrud) Create()
 	return nil
 }
 
-func (s *DatabaseExternalcontainerdatabasesStackMonitoringResourceCrud) Delete() error {
+func (s *DatabaseExternalcontainerdatabasesStackMonitoringResourceCrud) Delete(ctx context.Context) error {
 	var operation_stack_monitoring bool
 	if enableOpsi, ok := s.D.GetOkExists("enable_stack_monitoring"); ok {
 		operation_stack_monitoring = enableOpsi.(bool)
@@ -174,7 +175,7 @@ func (s *DatabaseExternalcontainerdatabasesStackMonitoringResourceCrud) Delete()
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.DisableExternalContainerDatabaseStackMonitoring(context.Background(), request)
+	response, err := s.Client.DisableExternalContainerDatabaseStackMonitoring(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -182,7 +183,7 @@ func (s *DatabaseExternalcontainerdatabasesStackMonitoringResourceCrud) Delete()
 	workId := response.OpcWorkRequestId
 	if workId != nil {
 		// verification required for entity type name "externalPluggableDatabase"
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "externalContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "externalContainerDatabase", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
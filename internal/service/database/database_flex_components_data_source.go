@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseFlexComponentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseFlexComponents,
+		ReadContext: readDatabaseFlexComponents,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -67,12 +68,12 @@ func DatabaseFlexComponentsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseFlexComponents(d *schema.ResourceData, m interface{}) error {
+func readDatabaseFlexComponents(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseFlexComponentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseFlexComponentsDataSourceCrud struct {
@@ -85,7 +86,7 @@ func (s *DatabaseFlexComponentsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseFlexComponentsDataSourceCrud) Get() error {
+func (s *DatabaseFlexComponentsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListFlexComponentsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -100,7 +101,7 @@ func (s *DatabaseFlexComponentsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListFlexComponents(context.Background(), request)
+	response, err := s.Client.ListFlexComponents(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -109,7 +110,7 @@ func (s *DatabaseFlexComponentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListFlexComponents(context.Background(), request)
+		listResponse, err := s.Client.ListFlexComponents(ctx, request)
 		if err != nil {
 			return err
 		}
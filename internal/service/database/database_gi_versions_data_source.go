@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseGiVersionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseGiVersions,
+		ReadContext: readDatabaseGiVersions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -47,12 +48,12 @@ func DatabaseGiVersionsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseGiVersions(d *schema.ResourceData, m interface{}) error {
+func readDatabaseGiVersions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseGiVersionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseGiVersionsDataSourceCrud struct {
@@ -65,7 +66,7 @@ func (s *DatabaseGiVersionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseGiVersionsDataSourceCrud) Get() error {
+func (s *DatabaseGiVersionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListGiVersionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -80,7 +81,7 @@ func (s *DatabaseGiVersionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListGiVersions(context.Background(), request)
+	response, err := s.Client.ListGiVersions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -89,7 +90,7 @@ func (s *DatabaseGiVersionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListGiVersions(context.Background(), request)
+		listResponse, err := s.Client.ListGiVersions(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package database
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -14,7 +15,7 @@ import (
 
 func DatabaseInfrastructureTargetVersionDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseInfrastructureTargetVersion,
+		ReadContext: readSingularDatabaseInfrastructureTargetVersion,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -47,12 +48,12 @@ func DatabaseInfrastructureTargetVersionDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseInfrastructureTargetVersion(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseInfrastructureTargetVersion(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseInfrastructureTargetVersionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseInfrastructureTargetVersionDataSourceCrud struct {
@@ -65,7 +66,7 @@ func (s *DatabaseInfrastructureTargetVersionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseInfrastructureTargetVersionDataSourceCrud) Get() error {
+func (s *DatabaseInfrastructureTargetVersionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetInfrastructureTargetVersionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -84,7 +85,7 @@ func (s *DatabaseInfrastructureTargetVersionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetInfrastructureTargetVersions(context.Background(), request)
+	response, err := s.Client.GetInfrastructureTargetVersions(ctx, request)
 	if err != nil {
 		return err
 	}
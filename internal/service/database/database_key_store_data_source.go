@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
@@ -22,12 +23,12 @@ func DatabaseKeyStoreDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseKeyStoreResource(), fieldMap, readSingularDatabaseKeyStore)
 }
 
-func readSingularDatabaseKeyStore(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseKeyStore(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseKeyStoreDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseKeyStoreDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DatabaseKeyStoreDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseKeyStoreDataSourceCrud) Get() error {
+func (s *DatabaseKeyStoreDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetKeyStoreRequest{}
 
 	if keyStoreId, ok := s.D.GetOkExists("key_store_id"); ok {
@@ -50,7 +51,7 @@ func (s *DatabaseKeyStoreDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetKeyStore(context.Background(), request)
+	response, err := s.Client.GetKeyStore(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -23,11 +24,11 @@ func DatabaseKeyStoreResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseKeyStore,
-		Read:     readDatabaseKeyStore,
-		Update:   updateDatabaseKeyStore,
-		Delete:   deleteDatabaseKeyStore,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseKeyStore,
+		ReadContext:   readDatabaseKeyStore,
+		UpdateContext: updateDatabaseKeyStore,
+		DeleteContext: deleteDatabaseKeyStore,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -136,37 +137,37 @@ func DatabaseKeyStoreResource() *schema.Resource {
 	}
 }
 
-func createDatabaseKeyStore(d *schema.ResourceData, m interface{}) error {
+func createDatabaseKeyStore(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseKeyStoreResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseKeyStore(d *schema.ResourceData, m interface{}) error {
+func readDatabaseKeyStore(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseKeyStoreResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatabaseKeyStore(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseKeyStore(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseKeyStoreResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatabaseKeyStore(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseKeyStore(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseKeyStoreResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabaseKeyStoreResourceCrud struct {
@@ -200,7 +201,7 @@ func (s *DatabaseKeyStoreResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DatabaseKeyStoreResourceCrud) Create() error {
+func (s *DatabaseKeyStoreResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.CreateKeyStoreRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -238,7 +239,7 @@ func (s *DatabaseKeyStoreResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateKeyStore(context.Background(), request)
+	response, err := s.Client.CreateKeyStore(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -247,7 +248,7 @@ func (s *DatabaseKeyStoreResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabaseKeyStoreResourceCrud) Get() error {
+func (s *DatabaseKeyStoreResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetKeyStoreRequest{}
 
 	tmp := s.D.Id()
@@ -255,7 +256,7 @@ func (s *DatabaseKeyStoreResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetKeyStore(context.Background(), request)
+	response, err := s.Client.GetKeyStore(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -264,11 +265,11 @@ func (s *DatabaseKeyStoreResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseKeyStoreResourceCrud) Update() error {
+func (s *DatabaseKeyStoreResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -304,7 +305,7 @@ func (s *DatabaseKeyStoreResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateKeyStore(context.Background(), request)
+	response, err := s.Client.UpdateKeyStore(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -313,7 +314,7 @@ func (s *DatabaseKeyStoreResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DatabaseKeyStoreResourceCrud) Delete() error {
+func (s *DatabaseKeyStoreResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database.DeleteKeyStoreRequest{}
 
 	tmp := s.D.Id()
@@ -321,7 +322,7 @@ func (s *DatabaseKeyStoreResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteKeyStore(context.Background(), request)
+	_, err := s.Client.DeleteKeyStore(ctx, request)
 	return err
 }
 
@@ -455,7 +456,7 @@ func KeyStoreTypeDetailsToMap(obj *oci_database.KeyStoreTypeDetails, datasource
 	return result
 }
 
-func (s *DatabaseKeyStoreResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DatabaseKeyStoreResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_database.ChangeKeyStoreCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -466,12 +467,12 @@ func (s *DatabaseKeyStoreResourceCrud) updateCompartment(compartment interface{}
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.ChangeKeyStoreCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeKeyStoreCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseKeyStoresDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseKeyStores,
+		ReadContext: readDatabaseKeyStores,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -31,12 +32,12 @@ func DatabaseKeyStoresDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseKeyStores(d *schema.ResourceData, m interface{}) error {
+func readDatabaseKeyStores(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseKeyStoresDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseKeyStoresDataSourceCrud struct {
@@ -49,7 +50,7 @@ func (s *DatabaseKeyStoresDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseKeyStoresDataSourceCrud) Get() error {
+func (s *DatabaseKeyStoresDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListKeyStoresRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -59,7 +60,7 @@ func (s *DatabaseKeyStoresDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListKeyStores(context.Background(), request)
+	response, err := s.Client.ListKeyStores(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -68,7 +69,7 @@ func (s *DatabaseKeyStoresDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListKeyStores(context.Background(), request)
+		listResponse, err := s.Client.ListKeyStores(ctx, request)
 		if err != nil {
 			return err
 		}
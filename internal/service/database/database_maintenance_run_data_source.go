@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
@@ -23,12 +24,12 @@ func DatabaseMaintenanceRunDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseMaintenanceRunResource(), fieldMap, readSingularDatabaseMaintenanceRun)
 }
 
-func readSingularDatabaseMaintenanceRun(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseMaintenanceRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMaintenanceRunDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseMaintenanceRunDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *DatabaseMaintenanceRunDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseMaintenanceRunDataSourceCrud) Get() error {
+func (s *DatabaseMaintenanceRunDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetMaintenanceRunRequest{}
 
 	if maintenanceRunId, ok := s.D.GetOkExists("maintenance_run_id"); ok {
@@ -51,7 +52,7 @@ func (s *DatabaseMaintenanceRunDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetMaintenanceRun(context.Background(), request)
+	response, err := s.Client.GetMaintenanceRun(ctx, request)
 	if err != nil {
 		return err
 	}
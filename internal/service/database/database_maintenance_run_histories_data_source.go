@@ -6,6 +6,7 @@ package database
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 
@@ -15,7 +16,7 @@ import (
 
 func DatabaseMaintenanceRunHistoriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseMaintenanceRunHistories,
+		ReadContext: readDatabaseMaintenanceRunHistories,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -263,12 +264,12 @@ func DatabaseMaintenanceRunHistoriesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseMaintenanceRunHistories(d *schema.ResourceData, m interface{}) error {
+func readDatabaseMaintenanceRunHistories(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMaintenanceRunHistoriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseMaintenanceRunHistoriesDataSourceCrud struct {
@@ -281,7 +282,7 @@ func (s *DatabaseMaintenanceRunHistoriesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseMaintenanceRunHistoriesDataSourceCrud) Get() error {
+func (s *DatabaseMaintenanceRunHistoriesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListMaintenanceRunHistoryRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -313,7 +314,7 @@ func (s *DatabaseMaintenanceRunHistoriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListMaintenanceRunHistory(context.Background(), request)
+	response, err := s.Client.ListMaintenanceRunHistory(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -322,7 +323,7 @@ func (s *DatabaseMaintenanceRunHistoriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListMaintenanceRunHistory(context.Background(), request)
+		listResponse, err := s.Client.ListMaintenanceRunHistory(ctx, request)
 		if err != nil {
 			return err
 		}
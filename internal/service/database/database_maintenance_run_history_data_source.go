@@ -6,6 +6,7 @@ package database
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 
@@ -15,7 +16,7 @@ import (
 
 func DatabaseMaintenanceRunHistoryDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseMaintenanceRunHistory,
+		ReadContext: readSingularDatabaseMaintenanceRunHistory,
 		Schema: map[string]*schema.Schema{
 			"maintenance_run_history_id": {
 				Type:     schema.TypeString,
@@ -226,12 +227,12 @@ func DatabaseMaintenanceRunHistoryDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseMaintenanceRunHistory(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseMaintenanceRunHistory(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMaintenanceRunHistoryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseMaintenanceRunHistoryDataSourceCrud struct {
@@ -244,7 +245,7 @@ func (s *DatabaseMaintenanceRunHistoryDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseMaintenanceRunHistoryDataSourceCrud) Get() error {
+func (s *DatabaseMaintenanceRunHistoryDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetMaintenanceRunHistoryRequest{}
 
 	if maintenanceRunHistoryId, ok := s.D.GetOkExists("maintenance_run_history_id"); ok {
@@ -254,7 +255,7 @@ func (s *DatabaseMaintenanceRunHistoryDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetMaintenanceRunHistory(context.Background(), request)
+	response, err := s.Client.GetMaintenanceRunHistory(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
@@ -21,11 +22,11 @@ func DatabaseMaintenanceRunResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseMaintenanceRun,
-		Read:     readDatabaseMaintenanceRun,
-		Update:   updateDatabaseMaintenanceRun,
-		Delete:   deleteDatabaseMaintenanceRun,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseMaintenanceRun,
+		ReadContext:   readDatabaseMaintenanceRun,
+		UpdateContext: updateDatabaseMaintenanceRun,
+		DeleteContext: deleteDatabaseMaintenanceRun,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"maintenance_run_id": {
@@ -198,31 +199,31 @@ func DatabaseMaintenanceRunResource() *schema.Resource {
 	}
 }
 
-func createDatabaseMaintenanceRun(d *schema.ResourceData, m interface{}) error {
+func createDatabaseMaintenanceRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMaintenanceRunResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseMaintenanceRun(d *schema.ResourceData, m interface{}) error {
+func readDatabaseMaintenanceRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMaintenanceRunResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatabaseMaintenanceRun(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseMaintenanceRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMaintenanceRunResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatabaseMaintenanceRun(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseMaintenanceRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -269,7 +270,7 @@ func (s *DatabaseMaintenanceRunResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DatabaseMaintenanceRunResourceCrud) Create() error {
+func (s *DatabaseMaintenanceRunResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.UpdateMaintenanceRunRequest{}
 
 	if currentCustomActionTimeoutInMins, ok := s.D.GetOkExists("current_custom_action_timeout_in_mins"); ok {
@@ -336,7 +337,7 @@ func (s *DatabaseMaintenanceRunResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateMaintenanceRun(context.Background(), request)
+	response, err := s.Client.UpdateMaintenanceRun(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -345,7 +346,7 @@ func (s *DatabaseMaintenanceRunResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabaseMaintenanceRunResourceCrud) Get() error {
+func (s *DatabaseMaintenanceRunResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetMaintenanceRunRequest{}
 
 	tmp := s.D.Id()
@@ -358,7 +359,7 @@ func (s *DatabaseMaintenanceRunResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetMaintenanceRun(context.Background(), request)
+	response, err := s.Client.GetMaintenanceRun(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -367,7 +368,7 @@ func (s *DatabaseMaintenanceRunResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseMaintenanceRunResourceCrud) Update() error {
+func (s *DatabaseMaintenanceRunResourceCrud) Update(ctx context.Context) error {
 
 	request := oci_database.UpdateMaintenanceRunRequest{}
 
@@ -433,7 +434,7 @@ func (s *DatabaseMaintenanceRunResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.UpdateMaintenanceRun(context.Background(), request)
+	_, err := s.Client.UpdateMaintenanceRun(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -444,7 +445,7 @@ func (s *DatabaseMaintenanceRunResourceCrud) Update() error {
 	// Requests to Update may succeed instantly but may not see the actual Update take effect
 	// until minutes later. Add polling here to return only when the change has taken effect.
 	maintenanceRunUpdatePatchingModeFunc := func() bool { return s.Res.LifecycleState != oci_database.MaintenanceRunLifecycleStateUpdating }
-	return tfresource.WaitForResourceCondition(s, maintenanceRunUpdatePatchingModeFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceCondition(ctx, s, maintenanceRunUpdatePatchingModeFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *DatabaseMaintenanceRunResourceCrud) SetData() error {
@@ -10,13 +10,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseMaintenanceRunsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseMaintenanceRuns,
+		ReadContext: readDatabaseMaintenanceRuns,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -56,12 +57,12 @@ func DatabaseMaintenanceRunsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseMaintenanceRuns(d *schema.ResourceData, m interface{}) error {
+func readDatabaseMaintenanceRuns(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMaintenanceRunsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseMaintenanceRunsDataSourceCrud struct {
@@ -74,7 +75,7 @@ func (s *DatabaseMaintenanceRunsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseMaintenanceRunsDataSourceCrud) Get() error {
+func (s *DatabaseMaintenanceRunsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListMaintenanceRunsRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -110,7 +111,7 @@ func (s *DatabaseMaintenanceRunsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListMaintenanceRuns(context.Background(), request)
+	response, err := s.Client.ListMaintenanceRuns(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -119,7 +120,7 @@ func (s *DatabaseMaintenanceRunsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListMaintenanceRuns(context.Background(), request)
+		listResponse, err := s.Client.ListMaintenanceRuns(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
@@ -22,12 +23,12 @@ func DatabasePluggableDatabaseDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabasePluggableDatabaseResource(), fieldMap, readSingularDatabasePluggableDatabase)
 }
 
-func readSingularDatabasePluggableDatabase(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabasePluggableDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabasePluggableDatabaseDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabasePluggableDatabaseDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DatabasePluggableDatabaseDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabasePluggableDatabaseDataSourceCrud) Get() error {
+func (s *DatabasePluggableDatabaseDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetPluggableDatabaseRequest{}
 
 	if pluggableDatabaseId, ok := s.D.GetOkExists("pluggable_database_id"); ok {
@@ -50,7 +51,7 @@ func (s *DatabasePluggableDatabaseDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetPluggableDatabase(context.Background(), request)
+	response, err := s.Client.GetPluggableDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
@@ -19,11 +20,11 @@ func DatabasePluggableDatabaseResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabasePluggableDatabase,
-		Read:     readDatabasePluggableDatabase,
-		Update:   updateDatabasePluggableDatabase,
-		Delete:   deleteDatabasePluggableDatabase,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabasePluggableDatabase,
+		ReadContext:   readDatabasePluggableDatabase,
+		UpdateContext: updateDatabasePluggableDatabase,
+		DeleteContext: deleteDatabasePluggableDatabase,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"container_database_id": {
@@ -127,37 +128,37 @@ func DatabasePluggableDatabaseResource() *schema.Resource {
 	}
 }
 
-func createDatabasePluggableDatabase(d *schema.ResourceData, m interface{}) error {
+func createDatabasePluggableDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabasePluggableDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabasePluggableDatabase(d *schema.ResourceData, m interface{}) error {
+func readDatabasePluggableDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabasePluggableDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatabasePluggableDatabase(d *schema.ResourceData, m interface{}) error {
+func updateDatabasePluggableDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabasePluggableDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatabasePluggableDatabase(d *schema.ResourceData, m interface{}) error {
+func deleteDatabasePluggableDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabasePluggableDatabaseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabasePluggableDatabaseResourceCrud struct {
@@ -195,7 +196,7 @@ func (s *DatabasePluggableDatabaseResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DatabasePluggableDatabaseResourceCrud) Create() error {
+func (s *DatabasePluggableDatabaseResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.CreatePluggableDatabaseRequest{}
 
 	if containerDatabaseId, ok := s.D.GetOkExists("container_database_id"); ok {
@@ -237,7 +238,7 @@ func (s *DatabasePluggableDatabaseResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreatePluggableDatabase(context.Background(), request)
+	response, err := s.Client.CreatePluggableDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -246,7 +247,7 @@ func (s *DatabasePluggableDatabaseResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabasePluggableDatabaseResourceCrud) Get() error {
+func (s *DatabasePluggableDatabaseResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetPluggableDatabaseRequest{}
 
 	tmp := s.D.Id()
@@ -254,7 +255,7 @@ func (s *DatabasePluggableDatabaseResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetPluggableDatabase(context.Background(), request)
+	response, err := s.Client.GetPluggableDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -263,7 +264,7 @@ func (s *DatabasePluggableDatabaseResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabasePluggableDatabaseResourceCrud) Update() error {
+func (s *DatabasePluggableDatabaseResourceCrud) Update(ctx context.Context) error {
 	request := oci_database.UpdatePluggableDatabaseRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -283,7 +284,7 @@ func (s *DatabasePluggableDatabaseResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdatePluggableDatabase(context.Background(), request)
+	response, err := s.Client.UpdatePluggableDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -292,7 +293,7 @@ func (s *DatabasePluggableDatabaseResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DatabasePluggableDatabaseResourceCrud) Delete() error {
+func (s *DatabasePluggableDatabaseResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database.DeletePluggableDatabaseRequest{}
 
 	tmp := s.D.Id()
@@ -300,7 +301,7 @@ func (s *DatabasePluggableDatabaseResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeletePluggableDatabase(context.Background(), request)
+	_, err := s.Client.DeletePluggableDatabase(ctx, request)
 	return err
 }
 
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabasePluggableDatabasesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabasePluggableDatabases,
+		ReadContext: readDatabasePluggableDatabases,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,12 +44,12 @@ func DatabasePluggableDatabasesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabasePluggableDatabases(d *schema.ResourceData, m interface{}) error {
+func readDatabasePluggableDatabases(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabasePluggableDatabasesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabasePluggableDatabasesDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *DatabasePluggableDatabasesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabasePluggableDatabasesDataSourceCrud) Get() error {
+func (s *DatabasePluggableDatabasesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListPluggableDatabasesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -85,7 +86,7 @@ func (s *DatabasePluggableDatabasesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListPluggableDatabases(context.Background(), request)
+	response, err := s.Client.ListPluggableDatabases(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *DatabasePluggableDatabasesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPluggableDatabases(context.Background(), request)
+		listResponse, err := s.Client.ListPluggableDatabases(ctx, request)
 		if err != nil {
 			return err
 		}
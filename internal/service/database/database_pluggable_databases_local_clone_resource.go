@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
@@ -16,10 +17,10 @@ import (
 
 func DatabasePluggableDatabasesLocalCloneResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabasePluggableDatabasesLocalClone,
-		Read:     readDatabasePluggableDatabasesLocalClone,
-		Delete:   deleteDatabasePluggableDatabasesLocalClone,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabasePluggableDatabasesLocalClone,
+		ReadContext:   readDatabasePluggableDatabasesLocalClone,
+		DeleteContext: deleteDatabasePluggableDatabasesLocalClone,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"cloned_pdb_name": {
@@ -128,19 +129,19 @@ func DatabasePluggableDatabasesLocalCloneResource() *schema.Resource {
 	}
 }
 
-func createDatabasePluggableDatabasesLocalClone(d *schema.ResourceData, m interface{}) error {
+func createDatabasePluggableDatabasesLocalClone(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabasePluggableDatabasesLocalCloneResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabasePluggableDatabasesLocalClone(d *schema.ResourceData, m interface{}) error {
+func readDatabasePluggableDatabasesLocalClone(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteDatabasePluggableDatabasesLocalClone(d *schema.ResourceData, m interface{}) error {
+func deleteDatabasePluggableDatabasesLocalClone(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -179,7 +180,7 @@ func (s *DatabasePluggableDatabasesLocalCloneResourceCrud) DeletedTarget() []str
 	}
 }
 
-func (s *DatabasePluggableDatabasesLocalCloneResourceCrud) Create() error {
+func (s *DatabasePluggableDatabasesLocalCloneResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.LocalClonePluggableDatabaseRequest{}
 
 	if clonedPdbName, ok := s.D.GetOkExists("cloned_pdb_name"); ok {
@@ -209,7 +210,7 @@ func (s *DatabasePluggableDatabasesLocalCloneResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.LocalClonePluggableDatabase(context.Background(), request)
+	response, err := s.Client.LocalClonePluggableDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
@@ -16,10 +17,10 @@ import (
 
 func DatabasePluggableDatabasesRemoteCloneResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabasePluggableDatabasesRemoteClone,
-		Read:     readDatabasePluggableDatabasesRemoteClone,
-		Delete:   deleteDatabasePluggableDatabasesRemoteClone,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabasePluggableDatabasesRemoteClone,
+		ReadContext:   readDatabasePluggableDatabasesRemoteClone,
+		DeleteContext: deleteDatabasePluggableDatabasesRemoteClone,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"cloned_pdb_name": {
@@ -139,19 +140,19 @@ func DatabasePluggableDatabasesRemoteCloneResource() *schema.Resource {
 	}
 }
 
-func createDatabasePluggableDatabasesRemoteClone(d *schema.ResourceData, m interface{}) error {
+func createDatabasePluggableDatabasesRemoteClone(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabasePluggableDatabasesRemoteCloneResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabasePluggableDatabasesRemoteClone(d *schema.ResourceData, m interface{}) error {
+func readDatabasePluggableDatabasesRemoteClone(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteDatabasePluggableDatabasesRemoteClone(d *schema.ResourceData, m interface{}) error {
+func deleteDatabasePluggableDatabasesRemoteClone(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -190,7 +191,7 @@ func (s *DatabasePluggableDatabasesRemoteCloneResourceCrud) DeletedTarget() []st
 	}
 }
 
-func (s *DatabasePluggableDatabasesRemoteCloneResourceCrud) Create() error {
+func (s *DatabasePluggableDatabasesRemoteCloneResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.RemoteClonePluggableDatabaseRequest{}
 
 	if clonedPdbName, ok := s.D.GetOkExists("cloned_pdb_name"); ok {
@@ -230,7 +231,7 @@ func (s *DatabasePluggableDatabasesRemoteCloneResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.RemoteClonePluggableDatabase(context.Background(), request)
+	response, err := s.Client.RemoteClonePluggableDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
@@ -22,12 +23,12 @@ func DatabaseVmClusterDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseVmClusterResource(), fieldMap, readSingularDatabaseVmCluster)
 }
 
-func readSingularDatabaseVmCluster(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseVmClusterDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DatabaseVmClusterDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseVmClusterDataSourceCrud) Get() error {
+func (s *DatabaseVmClusterDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetVmClusterRequest{}
 
 	if vmClusterId, ok := s.D.GetOkExists("vm_cluster_id"); ok {
@@ -50,7 +51,7 @@ func (s *DatabaseVmClusterDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetVmCluster(context.Background(), request)
+	response, err := s.Client.GetVmCluster(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
@@ -26,12 +27,12 @@ func DatabaseVmClusterNetworkDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseVmClusterNetworkResource(), fieldMap, readSingularDatabaseVmClusterNetwork)
 }
 
-func readSingularDatabaseVmClusterNetwork(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseVmClusterNetwork(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterNetworkDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseVmClusterNetworkDataSourceCrud struct {
@@ -44,7 +45,7 @@ func (s *DatabaseVmClusterNetworkDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseVmClusterNetworkDataSourceCrud) Get() error {
+func (s *DatabaseVmClusterNetworkDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetVmClusterNetworkRequest{}
 
 	if exadataInfrastructureId, ok := s.D.GetOkExists("exadata_infrastructure_id"); ok {
@@ -59,7 +60,7 @@ func (s *DatabaseVmClusterNetworkDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetVmClusterNetwork(context.Background(), request)
+	response, err := s.Client.GetVmClusterNetwork(ctx, request)
 	if err != nil {
 		return err
 	}
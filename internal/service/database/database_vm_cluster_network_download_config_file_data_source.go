@@ -12,13 +12,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseVmClusterNetworkDownloadConfigFileDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseVmClusterNetworkDownloadConfigFile,
+		ReadContext: readSingularDatabaseVmClusterNetworkDownloadConfigFile,
 		Schema: map[string]*schema.Schema{
 			"base64_encode_content": {
 				Type:     schema.TypeBool,
@@ -42,12 +43,12 @@ func DatabaseVmClusterNetworkDownloadConfigFileDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseVmClusterNetworkDownloadConfigFile(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseVmClusterNetworkDownloadConfigFile(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterNetworkDownloadConfigFileDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseVmClusterNetworkDownloadConfigFileDataSourceCrud struct {
@@ -60,7 +61,7 @@ func (s *DatabaseVmClusterNetworkDownloadConfigFileDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseVmClusterNetworkDownloadConfigFileDataSourceCrud) Get() error {
+func (s *DatabaseVmClusterNetworkDownloadConfigFileDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.DownloadVmClusterNetworkConfigFileRequest{}
 
 	if exadataInfrastructureId, ok := s.D.GetOkExists("exadata_infrastructure_id"); ok {
@@ -75,7 +76,7 @@ func (s *DatabaseVmClusterNetworkDownloadConfigFileDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.DownloadVmClusterNetworkConfigFile(context.Background(), request)
+	response, err := s.Client.DownloadVmClusterNetworkConfigFile(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
@@ -26,11 +27,11 @@ func DatabaseVmClusterNetworkResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseVmClusterNetwork,
-		Read:     readDatabaseVmClusterNetwork,
-		Update:   updateDatabaseVmClusterNetwork,
-		Delete:   deleteDatabaseVmClusterNetwork,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseVmClusterNetwork,
+		ReadContext:   readDatabaseVmClusterNetwork,
+		UpdateContext: updateDatabaseVmClusterNetwork,
+		DeleteContext: deleteDatabaseVmClusterNetwork,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -211,37 +212,37 @@ func DatabaseVmClusterNetworkResource() *schema.Resource {
 	}
 }
 
-func createDatabaseVmClusterNetwork(d *schema.ResourceData, m interface{}) error {
+func createDatabaseVmClusterNetwork(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterNetworkResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseVmClusterNetwork(d *schema.ResourceData, m interface{}) error {
+func readDatabaseVmClusterNetwork(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterNetworkResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatabaseVmClusterNetwork(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseVmClusterNetwork(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterNetworkResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatabaseVmClusterNetwork(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseVmClusterNetwork(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterNetworkResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabaseVmClusterNetworkResourceCrud struct {
@@ -297,7 +298,7 @@ func (s *DatabaseVmClusterNetworkResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *DatabaseVmClusterNetworkResourceCrud) Create() error {
+func (s *DatabaseVmClusterNetworkResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.CreateVmClusterNetworkRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -396,20 +397,20 @@ func (s *DatabaseVmClusterNetworkResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateVmClusterNetwork(context.Background(), request)
+	response, err := s.Client.CreateVmClusterNetwork(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.VmClusterNetwork
 
-	if waitErr := tfresource.WaitForCreatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForCreatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
 	if validateVmClusterNetwork, ok := s.D.GetOkExists("validate_vm_cluster_network"); ok &&
 		validateVmClusterNetwork.(bool) == true {
-		response, err := s.validateVmClusterNetwork(*s.Res.Id, *s.Res.ExadataInfrastructureId)
+		response, err := s.validateVmClusterNetwork(ctx, *s.Res.Id, *s.Res.ExadataInfrastructureId)
 		if err != nil {
 			s.D.Set("validate_vm_cluster_network", false)
 			return err
@@ -420,7 +421,7 @@ func (s *DatabaseVmClusterNetworkResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabaseVmClusterNetworkResourceCrud) Get() error {
+func (s *DatabaseVmClusterNetworkResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetVmClusterNetworkRequest{}
 
 	if exadataInfrastructureId, ok := s.D.GetOkExists("exadata_infrastructure_id"); ok {
@@ -441,7 +442,7 @@ func (s *DatabaseVmClusterNetworkResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetVmClusterNetwork(context.Background(), request)
+	response, err := s.Client.GetVmClusterNetwork(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -450,7 +451,7 @@ func (s *DatabaseVmClusterNetworkResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseVmClusterNetworkResourceCrud) Update() error {
+func (s *DatabaseVmClusterNetworkResourceCrud) Update(ctx context.Context) error {
 
 	if s.D.Get("state").(string) == string(oci_database.VmClusterNetworkLifecycleStateValidated) ||
 		s.D.Get("state").(string) == string(oci_database.VmClusterNetworkLifecycleStateAllocated) {
@@ -547,20 +548,20 @@ func (s *DatabaseVmClusterNetworkResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateVmClusterNetwork(context.Background(), request)
+	response, err := s.Client.UpdateVmClusterNetwork(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.VmClusterNetwork
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
 	if validateVmClusterNetwork, ok := s.D.GetOkExists("validate_vm_cluster_network"); ok &&
 		validateVmClusterNetwork.(bool) == true {
-		response, err := s.validateVmClusterNetwork(*s.Res.Id, *s.Res.ExadataInfrastructureId)
+		response, err := s.validateVmClusterNetwork(ctx, *s.Res.Id, *s.Res.ExadataInfrastructureId)
 		if err != nil {
 			s.D.Set("validate_vm_cluster_network", false)
 			return err
@@ -571,7 +572,7 @@ func (s *DatabaseVmClusterNetworkResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DatabaseVmClusterNetworkResourceCrud) Delete() error {
+func (s *DatabaseVmClusterNetworkResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database.DeleteVmClusterNetworkRequest{}
 
 	if exadataInfrastructureId, ok := s.D.GetOkExists("exadata_infrastructure_id"); ok {
@@ -584,7 +585,7 @@ func (s *DatabaseVmClusterNetworkResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteVmClusterNetwork(context.Background(), request)
+	_, err := s.Client.DeleteVmClusterNetwork(ctx, request)
 	return err
 }
 
@@ -934,7 +935,7 @@ func vmNetworksHashCodeForSets(v interface{}) int {
 	return utils.GetStringHashcode(buf.String())
 }
 
-func (s *DatabaseVmClusterNetworkResourceCrud) validateVmClusterNetwork(vmClusterNetworkId string, exadataInfrastructureId string) (*oci_database.ValidateVmClusterNetworkResponse, error) {
+func (s *DatabaseVmClusterNetworkResourceCrud) validateVmClusterNetwork(ctx context.Context, vmClusterNetworkId string, exadataInfrastructureId string) (*oci_database.ValidateVmClusterNetworkResponse, error) {
 	request := oci_database.ValidateVmClusterNetworkRequest{}
 
 	request.ExadataInfrastructureId = &exadataInfrastructureId
@@ -943,7 +944,7 @@ func (s *DatabaseVmClusterNetworkResourceCrud) validateVmClusterNetwork(vmCluste
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.ValidateVmClusterNetwork(context.Background(), request)
+	response, err := s.Client.ValidateVmClusterNetwork(ctx, request)
 	if err != nil {
 		return nil, err
 	}
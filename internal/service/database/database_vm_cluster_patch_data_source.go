@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseVmClusterPatchDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseVmClusterPatch,
+		ReadContext: readSingularDatabaseVmClusterPatch,
 		Schema: map[string]*schema.Schema{
 			"patch_id": {
 				Type:     schema.TypeString,
@@ -61,12 +62,12 @@ func DatabaseVmClusterPatchDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseVmClusterPatch(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseVmClusterPatch(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterPatchDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseVmClusterPatchDataSourceCrud struct {
@@ -79,7 +80,7 @@ func (s *DatabaseVmClusterPatchDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseVmClusterPatchDataSourceCrud) Get() error {
+func (s *DatabaseVmClusterPatchDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetVmClusterPatchRequest{}
 
 	if patchId, ok := s.D.GetOkExists("patch_id"); ok {
@@ -94,7 +95,7 @@ func (s *DatabaseVmClusterPatchDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetVmClusterPatch(context.Background(), request)
+	response, err := s.Client.GetVmClusterPatch(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseVmClusterPatchHistoryEntriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseVmClusterPatchHistoryEntries,
+		ReadContext: readDatabaseVmClusterPatchHistoryEntries,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"vm_cluster_id": {
@@ -67,12 +68,12 @@ func DatabaseVmClusterPatchHistoryEntriesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseVmClusterPatchHistoryEntries(d *schema.ResourceData, m interface{}) error {
+func readDatabaseVmClusterPatchHistoryEntries(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterPatchHistoryEntriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseVmClusterPatchHistoryEntriesDataSourceCrud struct {
@@ -85,7 +86,7 @@ func (s *DatabaseVmClusterPatchHistoryEntriesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseVmClusterPatchHistoryEntriesDataSourceCrud) Get() error {
+func (s *DatabaseVmClusterPatchHistoryEntriesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListVmClusterPatchHistoryEntriesRequest{}
 
 	if vmClusterId, ok := s.D.GetOkExists("vm_cluster_id"); ok {
@@ -95,7 +96,7 @@ func (s *DatabaseVmClusterPatchHistoryEntriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListVmClusterPatchHistoryEntries(context.Background(), request)
+	response, err := s.Client.ListVmClusterPatchHistoryEntries(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -104,7 +105,7 @@ func (s *DatabaseVmClusterPatchHistoryEntriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVmClusterPatchHistoryEntries(context.Background(), request)
+		listResponse, err := s.Client.ListVmClusterPatchHistoryEntries(ctx, request)
 		if err != nil {
 			return err
 		}
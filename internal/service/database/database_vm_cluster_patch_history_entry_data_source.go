@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseVmClusterPatchHistoryEntryDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseVmClusterPatchHistoryEntry,
+		ReadContext: readSingularDatabaseVmClusterPatchHistoryEntry,
 		Schema: map[string]*schema.Schema{
 			"patch_history_entry_id": {
 				Type:     schema.TypeString,
@@ -54,12 +55,12 @@ func DatabaseVmClusterPatchHistoryEntryDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseVmClusterPatchHistoryEntry(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseVmClusterPatchHistoryEntry(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterPatchHistoryEntryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseVmClusterPatchHistoryEntryDataSourceCrud struct {
@@ -72,7 +73,7 @@ func (s *DatabaseVmClusterPatchHistoryEntryDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseVmClusterPatchHistoryEntryDataSourceCrud) Get() error {
+func (s *DatabaseVmClusterPatchHistoryEntryDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetVmClusterPatchHistoryEntryRequest{}
 
 	if patchHistoryEntryId, ok := s.D.GetOkExists("patch_history_entry_id"); ok {
@@ -87,7 +88,7 @@ func (s *DatabaseVmClusterPatchHistoryEntryDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetVmClusterPatchHistoryEntry(context.Background(), request)
+	response, err := s.Client.GetVmClusterPatchHistoryEntry(ctx, request)
 	if err != nil {
 		return err
 	}
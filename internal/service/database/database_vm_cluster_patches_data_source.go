@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseVmClusterPatchesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseVmClusterPatches,
+		ReadContext: readDatabaseVmClusterPatches,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"vm_cluster_id": {
@@ -74,12 +75,12 @@ func DatabaseVmClusterPatchesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseVmClusterPatches(d *schema.ResourceData, m interface{}) error {
+func readDatabaseVmClusterPatches(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterPatchesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseVmClusterPatchesDataSourceCrud struct {
@@ -92,7 +93,7 @@ func (s *DatabaseVmClusterPatchesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseVmClusterPatchesDataSourceCrud) Get() error {
+func (s *DatabaseVmClusterPatchesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListVmClusterPatchesRequest{}
 
 	if vmClusterId, ok := s.D.GetOkExists("vm_cluster_id"); ok {
@@ -102,7 +103,7 @@ func (s *DatabaseVmClusterPatchesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListVmClusterPatches(context.Background(), request)
+	response, err := s.Client.ListVmClusterPatches(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -111,7 +112,7 @@ func (s *DatabaseVmClusterPatchesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVmClusterPatches(context.Background(), request)
+		listResponse, err := s.Client.ListVmClusterPatches(ctx, request)
 		if err != nil {
 			return err
 		}
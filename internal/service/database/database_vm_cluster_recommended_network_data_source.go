@@ -10,13 +10,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
 
 func DatabaseVmClusterRecommendedNetworkDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseVmClusterRecommendedNetwork,
+		ReadContext: readSingularDatabaseVmClusterRecommendedNetwork,
 		Schema: map[string]*schema.Schema{
 
 			// Required
@@ -215,12 +216,12 @@ func DatabaseVmClusterRecommendedNetworkDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseVmClusterRecommendedNetwork(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseVmClusterRecommendedNetwork(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterRecommendedNetworkDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseVmClusterRecommendedNetworkDataSourceCrud struct {
@@ -233,7 +234,7 @@ func (s *DatabaseVmClusterRecommendedNetworkDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseVmClusterRecommendedNetworkDataSourceCrud) Get() error {
+func (s *DatabaseVmClusterRecommendedNetworkDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GenerateRecommendedVmClusterNetworkRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -318,7 +319,7 @@ func (s *DatabaseVmClusterRecommendedNetworkDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GenerateRecommendedVmClusterNetwork(context.Background(), request)
+	response, err := s.Client.GenerateRecommendedVmClusterNetwork(ctx, request)
 	if err != nil {
 		return err
 	}
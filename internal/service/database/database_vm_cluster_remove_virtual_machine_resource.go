@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
@@ -20,10 +21,10 @@ func DatabaseVmClusterRemoveVirtualMachineResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseVmClusterRemoveVirtualMachine,
-		Read:     readDatabaseVmClusterRemoveVirtualMachine,
-		Delete:   deleteDatabaseVmClusterRemoveVirtualMachine,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseVmClusterRemoveVirtualMachine,
+		ReadContext:   readDatabaseVmClusterRemoveVirtualMachine,
+		DeleteContext: deleteDatabaseVmClusterRemoveVirtualMachine,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"db_servers": {
@@ -176,19 +177,19 @@ func DatabaseVmClusterRemoveVirtualMachineResource() *schema.Resource {
 	}
 }
 
-func createDatabaseVmClusterRemoveVirtualMachine(d *schema.ResourceData, m interface{}) error {
+func createDatabaseVmClusterRemoveVirtualMachine(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterRemoveVirtualMachineResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseVmClusterRemoveVirtualMachine(d *schema.ResourceData, m interface{}) error {
+func readDatabaseVmClusterRemoveVirtualMachine(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteDatabaseVmClusterRemoveVirtualMachine(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseVmClusterRemoveVirtualMachine(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -227,7 +228,7 @@ func (s *DatabaseVmClusterRemoveVirtualMachineResourceCrud) DeletedTarget() []st
 	}
 }
 
-func (s *DatabaseVmClusterRemoveVirtualMachineResourceCrud) Create() error {
+func (s *DatabaseVmClusterRemoveVirtualMachineResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.RemoveVirtualMachineFromVmClusterRequest{}
 
 	if dbServers, ok := s.D.GetOkExists("db_servers"); ok {
@@ -254,7 +255,7 @@ func (s *DatabaseVmClusterRemoveVirtualMachineResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.RemoveVirtualMachineFromVmCluster(context.Background(), request)
+	response, err := s.Client.RemoveVirtualMachineFromVmCluster(ctx, request)
 	if err != nil {
 		return err
 	}
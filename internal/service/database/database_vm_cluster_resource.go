@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
@@ -21,11 +22,11 @@ func DatabaseVmClusterResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseVmCluster,
-		Read:     readDatabaseVmCluster,
-		Update:   updateDatabaseVmCluster,
-		Delete:   deleteDatabaseVmCluster,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseVmCluster,
+		ReadContext:   readDatabaseVmCluster,
+		UpdateContext: updateDatabaseVmCluster,
+		DeleteContext: deleteDatabaseVmCluster,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -206,40 +207,40 @@ func DatabaseVmClusterResource() *schema.Resource {
 	}
 }
 
-func createDatabaseVmCluster(d *schema.ResourceData, m interface{}) error {
+func createDatabaseVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseVmCluster(d *schema.ResourceData, m interface{}) error {
+func readDatabaseVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatabaseVmCluster(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatabaseVmCluster(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseVmCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.DisableNotFoundRetries = true
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabaseVmClusterResourceCrud struct {
@@ -291,7 +292,7 @@ func (s *DatabaseVmClusterResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *DatabaseVmClusterResourceCrud) Create() error {
+func (s *DatabaseVmClusterResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.CreateVmClusterRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -421,7 +422,7 @@ func (s *DatabaseVmClusterResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.CreateVmCluster(context.Background(), request)
+	response, err := s.Client.CreateVmCluster(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -430,7 +431,7 @@ func (s *DatabaseVmClusterResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabaseVmClusterResourceCrud) Get() error {
+func (s *DatabaseVmClusterResourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetVmClusterRequest{}
 
 	tmp := s.D.Id()
@@ -438,7 +439,7 @@ func (s *DatabaseVmClusterResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.GetVmCluster(context.Background(), request)
+	response, err := s.Client.GetVmCluster(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -447,11 +448,11 @@ func (s *DatabaseVmClusterResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseVmClusterResourceCrud) Update() error {
+func (s *DatabaseVmClusterResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -534,7 +535,7 @@ func (s *DatabaseVmClusterResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.UpdateVmCluster(context.Background(), request)
+	response, err := s.Client.UpdateVmCluster(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -543,7 +544,7 @@ func (s *DatabaseVmClusterResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DatabaseVmClusterResourceCrud) Delete() error {
+func (s *DatabaseVmClusterResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database.DeleteVmClusterRequest{}
 
 	tmp := s.D.Id()
@@ -551,7 +552,7 @@ func (s *DatabaseVmClusterResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	_, err := s.Client.DeleteVmCluster(context.Background(), request)
+	_, err := s.Client.DeleteVmCluster(ctx, request)
 	return err
 }
 
@@ -699,7 +700,7 @@ func DataCollectionOptionsToMap(obj *oci_database.DataCollectionOptions) map[str
 	return result
 }
 
-func (s *DatabaseVmClusterResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DatabaseVmClusterResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_database.ChangeVmClusterCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -710,14 +711,14 @@ func (s *DatabaseVmClusterResourceCrud) updateCompartment(compartment interface{
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.ChangeVmClusterCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeVmClusterCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	if workId != nil {
-		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "vmCluster", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(ctx, s.WorkRequestClient, workId, "vmCluster", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
 		if err != nil {
 			return err
 		}
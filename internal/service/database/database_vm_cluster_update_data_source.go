@@ -6,6 +6,7 @@ package database
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 
@@ -15,7 +16,7 @@ import (
 
 func DatabaseVmClusterUpdateDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseVmClusterUpdate,
+		ReadContext: readSingularDatabaseVmClusterUpdate,
 		Schema: map[string]*schema.Schema{
 			"update_id": {
 				Type:     schema.TypeString,
@@ -65,12 +66,12 @@ func DatabaseVmClusterUpdateDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseVmClusterUpdate(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseVmClusterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterUpdateDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseVmClusterUpdateDataSourceCrud struct {
@@ -83,7 +84,7 @@ func (s *DatabaseVmClusterUpdateDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseVmClusterUpdateDataSourceCrud) Get() error {
+func (s *DatabaseVmClusterUpdateDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetVmClusterUpdateRequest{}
 
 	if updateId, ok := s.D.GetOkExists("update_id"); ok {
@@ -98,7 +99,7 @@ func (s *DatabaseVmClusterUpdateDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetVmClusterUpdate(context.Background(), request)
+	response, err := s.Client.GetVmClusterUpdate(ctx, request)
 	if err != nil {
 		return err
 	}
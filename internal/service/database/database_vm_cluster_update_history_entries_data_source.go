@@ -6,6 +6,7 @@ package database
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 
@@ -15,7 +16,7 @@ import (
 
 func DatabaseVmClusterUpdateHistoryEntriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseVmClusterUpdateHistoryEntries,
+		ReadContext: readDatabaseVmClusterUpdateHistoryEntries,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"state": {
@@ -79,12 +80,12 @@ func DatabaseVmClusterUpdateHistoryEntriesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseVmClusterUpdateHistoryEntries(d *schema.ResourceData, m interface{}) error {
+func readDatabaseVmClusterUpdateHistoryEntries(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterUpdateHistoryEntriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseVmClusterUpdateHistoryEntriesDataSourceCrud struct {
@@ -97,7 +98,7 @@ func (s *DatabaseVmClusterUpdateHistoryEntriesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseVmClusterUpdateHistoryEntriesDataSourceCrud) Get() error {
+func (s *DatabaseVmClusterUpdateHistoryEntriesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListVmClusterUpdateHistoryEntriesRequest{}
 
 	if state, ok := s.D.GetOkExists("state"); ok {
@@ -115,7 +116,7 @@ func (s *DatabaseVmClusterUpdateHistoryEntriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListVmClusterUpdateHistoryEntries(context.Background(), request)
+	response, err := s.Client.ListVmClusterUpdateHistoryEntries(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -124,7 +125,7 @@ func (s *DatabaseVmClusterUpdateHistoryEntriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVmClusterUpdateHistoryEntries(context.Background(), request)
+		listResponse, err := s.Client.ListVmClusterUpdateHistoryEntries(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package database
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 
@@ -15,7 +16,7 @@ import (
 
 func DatabaseVmClusterUpdateHistoryEntryDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseVmClusterUpdateHistoryEntry,
+		ReadContext: readSingularDatabaseVmClusterUpdateHistoryEntry,
 		Schema: map[string]*schema.Schema{
 			"update_history_entry_id": {
 				Type:     schema.TypeString,
@@ -58,12 +59,12 @@ func DatabaseVmClusterUpdateHistoryEntryDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseVmClusterUpdateHistoryEntry(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseVmClusterUpdateHistoryEntry(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterUpdateHistoryEntryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseVmClusterUpdateHistoryEntryDataSourceCrud struct {
@@ -76,7 +77,7 @@ func (s *DatabaseVmClusterUpdateHistoryEntryDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseVmClusterUpdateHistoryEntryDataSourceCrud) Get() error {
+func (s *DatabaseVmClusterUpdateHistoryEntryDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.GetVmClusterUpdateHistoryEntryRequest{}
 
 	if updateHistoryEntryId, ok := s.D.GetOkExists("update_history_entry_id"); ok {
@@ -91,7 +92,7 @@ func (s *DatabaseVmClusterUpdateHistoryEntryDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.GetVmClusterUpdateHistoryEntry(context.Background(), request)
+	response, err := s.Client.GetVmClusterUpdateHistoryEntry(ctx, request)
 	if err != nil {
 		return err
 	}
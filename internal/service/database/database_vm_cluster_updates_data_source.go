@@ -6,6 +6,7 @@ package database
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 
@@ -15,7 +16,7 @@ import (
 
 func DatabaseVmClusterUpdatesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseVmClusterUpdates,
+		ReadContext: readDatabaseVmClusterUpdates,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"state": {
@@ -86,12 +87,12 @@ func DatabaseVmClusterUpdatesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseVmClusterUpdates(d *schema.ResourceData, m interface{}) error {
+func readDatabaseVmClusterUpdates(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClusterUpdatesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseVmClusterUpdatesDataSourceCrud struct {
@@ -104,7 +105,7 @@ func (s *DatabaseVmClusterUpdatesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseVmClusterUpdatesDataSourceCrud) Get() error {
+func (s *DatabaseVmClusterUpdatesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListVmClusterUpdatesRequest{}
 
 	if state, ok := s.D.GetOkExists("state"); ok {
@@ -122,7 +123,7 @@ func (s *DatabaseVmClusterUpdatesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListVmClusterUpdates(context.Background(), request)
+	response, err := s.Client.ListVmClusterUpdates(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -131,7 +132,7 @@ func (s *DatabaseVmClusterUpdatesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVmClusterUpdates(context.Background(), request)
+		listResponse, err := s.Client.ListVmClusterUpdates(ctx, request)
 		if err != nil {
 			return err
 		}
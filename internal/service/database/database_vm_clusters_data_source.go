@@ -6,6 +6,7 @@ package database
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 
@@ -15,7 +16,7 @@ import (
 
 func DatabaseVmClustersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseVmClusters,
+		ReadContext: readDatabaseVmClusters,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,12 +44,12 @@ func DatabaseVmClustersDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseVmClusters(d *schema.ResourceData, m interface{}) error {
+func readDatabaseVmClusters(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseVmClustersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseVmClustersDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *DatabaseVmClustersDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseVmClustersDataSourceCrud) Get() error {
+func (s *DatabaseVmClustersDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database.ListVmClustersRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -85,7 +86,7 @@ func (s *DatabaseVmClustersDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
 
-	response, err := s.Client.ListVmClusters(context.Background(), request)
+	response, err := s.Client.ListVmClusters(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *DatabaseVmClustersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVmClusters(context.Background(), request)
+		listResponse, err := s.Client.ListVmClusters(ctx, request)
 		if err != nil {
 			return err
 		}
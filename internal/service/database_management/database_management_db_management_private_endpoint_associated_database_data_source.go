@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementDbManagementPrivateEndpointAssociatedDatabaseDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementDbManagementPrivateEndpointAssociatedDatabase,
+		ReadContext: readSingularDatabaseManagementDbManagementPrivateEndpointAssociatedDatabase,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -59,12 +60,12 @@ func DatabaseManagementDbManagementPrivateEndpointAssociatedDatabaseDataSource()
 	}
 }
 
-func readSingularDatabaseManagementDbManagementPrivateEndpointAssociatedDatabase(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementDbManagementPrivateEndpointAssociatedDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementDbManagementPrivateEndpointAssociatedDatabaseDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementDbManagementPrivateEndpointAssociatedDatabaseDataSourceCrud struct {
@@ -77,7 +78,7 @@ func (s *DatabaseManagementDbManagementPrivateEndpointAssociatedDatabaseDataSour
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementDbManagementPrivateEndpointAssociatedDatabaseDataSourceCrud) Get() error {
+func (s *DatabaseManagementDbManagementPrivateEndpointAssociatedDatabaseDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListAssociatedDatabasesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -92,7 +93,7 @@ func (s *DatabaseManagementDbManagementPrivateEndpointAssociatedDatabaseDataSour
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListAssociatedDatabases(context.Background(), request)
+	response, err := s.Client.ListAssociatedDatabases(ctx, request)
 	if err != nil {
 		return err
 	}
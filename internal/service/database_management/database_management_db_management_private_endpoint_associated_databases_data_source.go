@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementDbManagementPrivateEndpointAssociatedDatabasesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementDbManagementPrivateEndpointAssociatedDatabases,
+		ReadContext: readDatabaseManagementDbManagementPrivateEndpointAssociatedDatabases,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -72,12 +73,12 @@ func DatabaseManagementDbManagementPrivateEndpointAssociatedDatabasesDataSource(
 	}
 }
 
-func readDatabaseManagementDbManagementPrivateEndpointAssociatedDatabases(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementDbManagementPrivateEndpointAssociatedDatabases(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementDbManagementPrivateEndpointAssociatedDatabasesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementDbManagementPrivateEndpointAssociatedDatabasesDataSourceCrud struct {
@@ -90,7 +91,7 @@ func (s *DatabaseManagementDbManagementPrivateEndpointAssociatedDatabasesDataSou
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementDbManagementPrivateEndpointAssociatedDatabasesDataSourceCrud) Get() error {
+func (s *DatabaseManagementDbManagementPrivateEndpointAssociatedDatabasesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListAssociatedDatabasesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -105,7 +106,7 @@ func (s *DatabaseManagementDbManagementPrivateEndpointAssociatedDatabasesDataSou
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListAssociatedDatabases(context.Background(), request)
+	response, err := s.Client.ListAssociatedDatabases(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -114,7 +115,7 @@ func (s *DatabaseManagementDbManagementPrivateEndpointAssociatedDatabasesDataSou
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAssociatedDatabases(context.Background(), request)
+		listResponse, err := s.Client.ListAssociatedDatabases(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
@@ -22,12 +23,12 @@ func DatabaseManagementDbManagementPrivateEndpointDataSource() *schema.Resource
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseManagementDbManagementPrivateEndpointResource(), fieldMap, readSingularDatabaseManagementDbManagementPrivateEndpoint)
 }
 
-func readSingularDatabaseManagementDbManagementPrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementDbManagementPrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementDbManagementPrivateEndpointDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementDbManagementPrivateEndpointDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DatabaseManagementDbManagementPrivateEndpointDataSourceCrud) VoidState(
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementDbManagementPrivateEndpointDataSourceCrud) Get() error {
+func (s *DatabaseManagementDbManagementPrivateEndpointDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.GetDbManagementPrivateEndpointRequest{}
 
 	if dbManagementPrivateEndpointId, ok := s.D.GetOkExists("db_management_private_endpoint_id"); ok {
@@ -50,7 +51,7 @@ func (s *DatabaseManagementDbManagementPrivateEndpointDataSourceCrud) Get() erro
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.GetDbManagementPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.GetDbManagementPrivateEndpoint(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementDbManagementPrivateEndpointsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementDbManagementPrivateEndpoints,
+		ReadContext: readDatabaseManagementDbManagementPrivateEndpoints,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -56,12 +57,12 @@ func DatabaseManagementDbManagementPrivateEndpointsDataSource() *schema.Resource
 	}
 }
 
-func readDatabaseManagementDbManagementPrivateEndpoints(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementDbManagementPrivateEndpoints(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementDbManagementPrivateEndpointsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementDbManagementPrivateEndpointsDataSourceCrud struct {
@@ -74,7 +75,7 @@ func (s *DatabaseManagementDbManagementPrivateEndpointsDataSourceCrud) VoidState
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementDbManagementPrivateEndpointsDataSourceCrud) Get() error {
+func (s *DatabaseManagementDbManagementPrivateEndpointsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListDbManagementPrivateEndpointsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -103,7 +104,7 @@ func (s *DatabaseManagementDbManagementPrivateEndpointsDataSourceCrud) Get() err
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListDbManagementPrivateEndpoints(context.Background(), request)
+	response, err := s.Client.ListDbManagementPrivateEndpoints(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +113,7 @@ func (s *DatabaseManagementDbManagementPrivateEndpointsDataSourceCrud) Get() err
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbManagementPrivateEndpoints(context.Background(), request)
+		listResponse, err := s.Client.ListDbManagementPrivateEndpoints(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementJobExecutionsStatusDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementJobExecutionsStatus,
+		ReadContext: readSingularDatabaseManagementJobExecutionsStatus,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -71,12 +72,12 @@ func DatabaseManagementJobExecutionsStatusDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseManagementJobExecutionsStatus(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementJobExecutionsStatus(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementJobExecutionsStatusDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementJobExecutionsStatusDataSourceCrud struct {
@@ -89,7 +90,7 @@ func (s *DatabaseManagementJobExecutionsStatusDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementJobExecutionsStatusDataSourceCrud) Get() error {
+func (s *DatabaseManagementJobExecutionsStatusDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.SummarizeJobExecutionsStatusesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -129,7 +130,7 @@ func (s *DatabaseManagementJobExecutionsStatusDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.SummarizeJobExecutionsStatuses(context.Background(), request)
+	response, err := s.Client.SummarizeJobExecutionsStatuses(ctx, request)
 	if err != nil {
 		return err
 	}
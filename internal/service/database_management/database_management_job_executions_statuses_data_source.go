@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementJobExecutionsStatusesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementJobExecutionsStatuses,
+		ReadContext: readDatabaseManagementJobExecutionsStatuses,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -79,12 +80,12 @@ func DatabaseManagementJobExecutionsStatusesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseManagementJobExecutionsStatuses(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementJobExecutionsStatuses(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementJobExecutionsStatusesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementJobExecutionsStatusesDataSourceCrud struct {
@@ -97,7 +98,7 @@ func (s *DatabaseManagementJobExecutionsStatusesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementJobExecutionsStatusesDataSourceCrud) Get() error {
+func (s *DatabaseManagementJobExecutionsStatusesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.SummarizeJobExecutionsStatusesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -137,7 +138,7 @@ func (s *DatabaseManagementJobExecutionsStatusesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.SummarizeJobExecutionsStatuses(context.Background(), request)
+	response, err := s.Client.SummarizeJobExecutionsStatuses(ctx, request)
 	if err != nil {
 		return err
 	}
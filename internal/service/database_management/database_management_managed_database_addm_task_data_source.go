@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
@@ -17,7 +18,7 @@ import (
 
 func DatabaseManagementManagedDatabaseAddmTaskDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabaseAddmTask,
+		ReadContext: readSingularDatabaseManagementManagedDatabaseAddmTask,
 		Schema: map[string]*schema.Schema{
 			"managed_database_id": {
 				Type:     schema.TypeString,
@@ -97,12 +98,12 @@ func DatabaseManagementManagedDatabaseAddmTaskDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabaseAddmTask(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseAddmTask(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseAddmTaskDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseAddmTaskDataSourceCrud struct {
@@ -115,7 +116,7 @@ func (s *DatabaseManagementManagedDatabaseAddmTaskDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseAddmTaskDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseAddmTaskDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.AddmTasksRequest{}
 
 	if managedDatabaseId, ok := s.D.GetOkExists("managed_database_id"); ok {
@@ -141,7 +142,7 @@ func (s *DatabaseManagementManagedDatabaseAddmTaskDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.AddmTasks(context.Background(), request)
+	response, err := s.Client.AddmTasks(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
@@ -18,7 +19,7 @@ import (
 
 func DatabaseManagementManagedDatabaseAddmTasksDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabaseAddmTasks,
+		ReadContext: readDatabaseManagementManagedDatabaseAddmTasks,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"managed_database_id": {
@@ -111,12 +112,12 @@ func DatabaseManagementManagedDatabaseAddmTasksDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseManagementManagedDatabaseAddmTasks(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabaseAddmTasks(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseAddmTasksDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseAddmTasksDataSourceCrud struct {
@@ -129,7 +130,7 @@ func (s *DatabaseManagementManagedDatabaseAddmTasksDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseAddmTasksDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseAddmTasksDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.AddmTasksRequest{}
 
 	if managedDatabaseId, ok := s.D.GetOkExists("managed_database_id"); ok {
@@ -155,7 +156,7 @@ func (s *DatabaseManagementManagedDatabaseAddmTasksDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.AddmTasks(context.Background(), request)
+	response, err := s.Client.AddmTasks(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -164,7 +165,7 @@ func (s *DatabaseManagementManagedDatabaseAddmTasksDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.AddmTasks(context.Background(), request)
+		listResponse, err := s.Client.AddmTasks(ctx, request)
 		if err != nil {
 			return err
 		}
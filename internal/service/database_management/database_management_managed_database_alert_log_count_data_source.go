@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
@@ -17,7 +18,7 @@ import (
 
 func DatabaseManagementManagedDatabaseAlertLogCountDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabaseAlertLogCount,
+		ReadContext: readSingularDatabaseManagementManagedDatabaseAlertLogCount,
 		Schema: map[string]*schema.Schema{
 			"group_by": {
 				Type:     schema.TypeString,
@@ -77,12 +78,12 @@ func DatabaseManagementManagedDatabaseAlertLogCountDataSource() *schema.Resource
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabaseAlertLogCount(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseAlertLogCount(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseAlertLogCountDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DiagnosabilityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseAlertLogCountDataSourceCrud struct {
@@ -95,7 +96,7 @@ func (s *DatabaseManagementManagedDatabaseAlertLogCountDataSourceCrud) VoidState
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseAlertLogCountDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseAlertLogCountDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.SummarizeAlertLogCountsRequest{}
 
 	if groupBy, ok := s.D.GetOkExists("group_by"); ok {
@@ -143,7 +144,7 @@ func (s *DatabaseManagementManagedDatabaseAlertLogCountDataSourceCrud) Get() err
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.SummarizeAlertLogCounts(context.Background(), request)
+	response, err := s.Client.SummarizeAlertLogCounts(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
@@ -17,7 +18,7 @@ import (
 
 func DatabaseManagementManagedDatabaseAttentionLogCountDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabaseAttentionLogCount,
+		ReadContext: readSingularDatabaseManagementManagedDatabaseAttentionLogCount,
 		Schema: map[string]*schema.Schema{
 			"group_by": {
 				Type:     schema.TypeString,
@@ -77,12 +78,12 @@ func DatabaseManagementManagedDatabaseAttentionLogCountDataSource() *schema.Reso
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabaseAttentionLogCount(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseAttentionLogCount(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseAttentionLogCountDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DiagnosabilityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseAttentionLogCountDataSourceCrud struct {
@@ -95,7 +96,7 @@ func (s *DatabaseManagementManagedDatabaseAttentionLogCountDataSourceCrud) VoidS
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseAttentionLogCountDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseAttentionLogCountDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.SummarizeAttentionLogCountsRequest{}
 
 	if groupBy, ok := s.D.GetOkExists("group_by"); ok {
@@ -143,7 +144,7 @@ func (s *DatabaseManagementManagedDatabaseAttentionLogCountDataSourceCrud) Get()
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.SummarizeAttentionLogCounts(context.Background(), request)
+	response, err := s.Client.SummarizeAttentionLogCounts(ctx, request)
 	if err != nil {
 		return err
 	}
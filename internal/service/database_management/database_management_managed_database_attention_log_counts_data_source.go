@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
@@ -17,7 +18,7 @@ import (
 
 func DatabaseManagementManagedDatabaseAttentionLogCountsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabaseAttentionLogCounts,
+		ReadContext: readDatabaseManagementManagedDatabaseAttentionLogCounts,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"group_by": {
@@ -90,12 +91,12 @@ func DatabaseManagementManagedDatabaseAttentionLogCountsDataSource() *schema.Res
 	}
 }
 
-func readDatabaseManagementManagedDatabaseAttentionLogCounts(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabaseAttentionLogCounts(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseAttentionLogCountsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DiagnosabilityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseAttentionLogCountsDataSourceCrud struct {
@@ -108,7 +109,7 @@ func (s *DatabaseManagementManagedDatabaseAttentionLogCountsDataSourceCrud) Void
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseAttentionLogCountsDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseAttentionLogCountsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.SummarizeAttentionLogCountsRequest{}
 
 	if groupBy, ok := s.D.GetOkExists("group_by"); ok {
@@ -156,7 +157,7 @@ func (s *DatabaseManagementManagedDatabaseAttentionLogCountsDataSourceCrud) Get(
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.SummarizeAttentionLogCounts(context.Background(), request)
+	response, err := s.Client.SummarizeAttentionLogCounts(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -165,7 +166,7 @@ func (s *DatabaseManagementManagedDatabaseAttentionLogCountsDataSourceCrud) Get(
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.SummarizeAttentionLogCounts(context.Background(), request)
+		listResponse, err := s.Client.SummarizeAttentionLogCounts(ctx, request)
 		if err != nil {
 			return err
 		}
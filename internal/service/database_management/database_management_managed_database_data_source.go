@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabase,
+		ReadContext: readSingularDatabaseManagementManagedDatabase,
 		Schema: map[string]*schema.Schema{
 			"managed_database_id": {
 				Type:     schema.TypeString,
@@ -100,12 +101,12 @@ func DatabaseManagementManagedDatabaseDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabase(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabase(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseDataSourceCrud struct {
@@ -118,7 +119,7 @@ func (s *DatabaseManagementManagedDatabaseDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.GetManagedDatabaseRequest{}
 
 	if managedDatabaseId, ok := s.D.GetOkExists("managed_database_id"); ok {
@@ -128,7 +129,7 @@ func (s *DatabaseManagementManagedDatabaseDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.GetManagedDatabase(context.Background(), request)
+	response, err := s.Client.GetManagedDatabase(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
@@ -22,12 +23,12 @@ func DatabaseManagementManagedDatabaseGroupDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseManagementManagedDatabaseGroupResource(), fieldMap, readSingularDatabaseManagementManagedDatabaseGroup)
 }
 
-func readSingularDatabaseManagementManagedDatabaseGroup(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseGroupDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseGroupDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DatabaseManagementManagedDatabaseGroupDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseGroupDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseGroupDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.GetManagedDatabaseGroupRequest{}
 
 	if managedDatabaseGroupId, ok := s.D.GetOkExists("managed_database_group_id"); ok {
@@ -50,7 +51,7 @@ func (s *DatabaseManagementManagedDatabaseGroupDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.GetManagedDatabaseGroup(context.Background(), request)
+	response, err := s.Client.GetManagedDatabaseGroup(ctx, request)
 	if err != nil {
 		return err
 	}
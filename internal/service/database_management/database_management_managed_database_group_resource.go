@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
@@ -23,11 +24,11 @@ func DatabaseManagementManagedDatabaseGroupResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseManagementManagedDatabaseGroup,
-		Read:     readDatabaseManagementManagedDatabaseGroup,
-		Update:   updateDatabaseManagementManagedDatabaseGroup,
-		Delete:   deleteDatabaseManagementManagedDatabaseGroup,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseManagementManagedDatabaseGroup,
+		ReadContext:   readDatabaseManagementManagedDatabaseGroup,
+		UpdateContext: updateDatabaseManagementManagedDatabaseGroup,
+		DeleteContext: deleteDatabaseManagementManagedDatabaseGroup,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -121,37 +122,37 @@ func managedDatabaseHashCodeForSets(v interface{}) int {
 	return utils.GetStringHashcode(buf.String())
 }
 
-func createDatabaseManagementManagedDatabaseGroup(d *schema.ResourceData, m interface{}) error {
+func createDatabaseManagementManagedDatabaseGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseManagementManagedDatabaseGroup(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabaseGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatabaseManagementManagedDatabaseGroup(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseManagementManagedDatabaseGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatabaseManagementManagedDatabaseGroup(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseManagementManagedDatabaseGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabaseManagementManagedDatabaseGroupResourceCrud struct {
@@ -189,13 +190,13 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) DeletedTarget() []s
 	}
 }
 
-func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) Create() error {
+func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) Create(ctx context.Context) error {
 	defer func() {
 		if s.Res != nil {
 			managedDatabaseGroupId := *s.Res.Id
 			log.Printf("Invoking GET() for '%v'", managedDatabaseGroupId)
 			// get latest state of the instance
-			err := s.GetManagedDatabaseGroupForManagedDatabaseGroupId(managedDatabaseGroupId)
+			err := s.GetManagedDatabaseGroupForManagedDatabaseGroupId(ctx, managedDatabaseGroupId)
 			if err != nil {
 				log.Printf("[ERROR] unable to invoke GET() after CREATE '%v'", err)
 			}
@@ -225,7 +226,7 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
-	response, err := s.Client.CreateManagedDatabaseGroup(context.Background(), request)
+	response, err := s.Client.CreateManagedDatabaseGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -233,7 +234,7 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) Create() error {
 
 	managedDatabaseGroupId := *response.ManagedDatabaseGroup.Id
 
-	err = s.updateManagedDatabases(&managedDatabaseGroupId)
+	err = s.updateManagedDatabases(ctx, &managedDatabaseGroupId)
 	if err != nil {
 		return err
 	}
@@ -262,22 +263,22 @@ func mapToRemoveManagedDatabaseFromManagedDatabaseGroupDetails(managedDatabase m
 	return result
 }
 
-func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) addManagedDatabaseToManagedDatabaseGroup(managedDatabaseGroupId string, managedDatabaseDetails oci_database_management.AddManagedDatabaseToManagedDatabaseGroupDetails) error {
+func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) addManagedDatabaseToManagedDatabaseGroup(ctx context.Context, managedDatabaseGroupId string, managedDatabaseDetails oci_database_management.AddManagedDatabaseToManagedDatabaseGroupDetails) error {
 	request := oci_database_management.AddManagedDatabaseToManagedDatabaseGroupRequest{}
 	request.ManagedDatabaseGroupId = &managedDatabaseGroupId
 	request.AddManagedDatabaseToManagedDatabaseGroupDetails = managedDatabaseDetails
-	_, err := s.Client.AddManagedDatabaseToManagedDatabaseGroup(context.Background(), request)
+	_, err := s.Client.AddManagedDatabaseToManagedDatabaseGroup(ctx, request)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) removeManagedDatabaseFromManagedDatabaseGroup(managedDatabaseGroupId string, managedDatabaseDetails oci_database_management.RemoveManagedDatabaseFromManagedDatabaseGroupDetails) error {
+func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) removeManagedDatabaseFromManagedDatabaseGroup(ctx context.Context, managedDatabaseGroupId string, managedDatabaseDetails oci_database_management.RemoveManagedDatabaseFromManagedDatabaseGroupDetails) error {
 	request := oci_database_management.RemoveManagedDatabaseFromManagedDatabaseGroupRequest{}
 	request.ManagedDatabaseGroupId = &managedDatabaseGroupId
 	request.RemoveManagedDatabaseFromManagedDatabaseGroupDetails = managedDatabaseDetails
-	_, err := s.Client.RemoveManagedDatabaseFromManagedDatabaseGroup(context.Background(), request)
+	_, err := s.Client.RemoveManagedDatabaseFromManagedDatabaseGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -285,13 +286,13 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) removeManagedDataba
 }
 
 // Retrieves the Managed Database Group given the Managed Database Group ID
-func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) GetManagedDatabaseGroupForManagedDatabaseGroupId(managedDatabaseGroupId string) error {
+func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) GetManagedDatabaseGroupForManagedDatabaseGroupId(ctx context.Context, managedDatabaseGroupId string) error {
 	request := oci_database_management.GetManagedDatabaseGroupRequest{}
 	request.ManagedDatabaseGroupId = &managedDatabaseGroupId
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
-	response, err := s.Client.GetManagedDatabaseGroup(context.Background(), request)
+	response, err := s.Client.GetManagedDatabaseGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -300,7 +301,7 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) GetManagedDatabaseG
 	return nil
 }
 
-func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.GetManagedDatabaseGroupRequest{}
 
 	tmp := s.D.Id()
@@ -308,7 +309,7 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
-	response, err := s.Client.GetManagedDatabaseGroup(context.Background(), request)
+	response, err := s.Client.GetManagedDatabaseGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -317,10 +318,10 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) Update() error {
+func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) Update(ctx context.Context) error {
 	defer func() {
 		// get latest state of the instance
-		err := s.Get()
+		err := s.Get(ctx)
 		if err != nil {
 			log.Printf("[ERROR] unable to invoke GET() after CREATE '%v'", err)
 		}
@@ -333,7 +334,7 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) Update() error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -351,7 +352,7 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
-	response, err := s.Client.UpdateManagedDatabaseGroup(context.Background(), request)
+	response, err := s.Client.UpdateManagedDatabaseGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -359,7 +360,7 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) Update() error {
 	s.Res = &response.ManagedDatabaseGroup
 	managedDatabaseGroupId := *response.ManagedDatabaseGroup.Id
 
-	err = s.updateManagedDatabases(&managedDatabaseGroupId)
+	err = s.updateManagedDatabases(ctx, &managedDatabaseGroupId)
 	if err != nil {
 		return err
 	}
@@ -367,7 +368,7 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) updateManagedDatabases(managedDatabaseGroupId *string) error {
+func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) updateManagedDatabases(ctx context.Context, managedDatabaseGroupId *string) error {
 	if _, ok := s.D.GetOkExists("managed_databases"); ok && s.D.HasChange("managed_databases") {
 		o, n := s.D.GetChange("managed_databases")
 		if o == nil {
@@ -384,7 +385,7 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) updateManagedDataba
 
 		for _, managedDatabase := range managedDatabasesToRemove {
 			remove := mapToRemoveManagedDatabaseFromManagedDatabaseGroupDetails(managedDatabase.(map[string]interface{}))
-			err := s.removeManagedDatabaseFromManagedDatabaseGroup(*managedDatabaseGroupId, remove)
+			err := s.removeManagedDatabaseFromManagedDatabaseGroup(ctx, *managedDatabaseGroupId, remove)
 			if err != nil {
 				return fmt.Errorf("failed to remove Managed Database, error: %v", err)
 			}
@@ -392,7 +393,7 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) updateManagedDataba
 
 		for _, managedDatabase := range managedDatabasesToAdd {
 			add := mapToAddManagedDatabaseToManagedDatabaseGroupDetails(managedDatabase.(map[string]interface{}))
-			err := s.addManagedDatabaseToManagedDatabaseGroup(*managedDatabaseGroupId, add)
+			err := s.addManagedDatabaseToManagedDatabaseGroup(ctx, *managedDatabaseGroupId, add)
 			if err != nil {
 				return fmt.Errorf("failed to add Managed Database, error: %v", err)
 			}
@@ -401,13 +402,13 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) updateManagedDataba
 	return nil
 }
 
-func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) removeAllManagedDatabases(managedDatabaseGroupId *string) error {
+func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) removeAllManagedDatabases(ctx context.Context, managedDatabaseGroupId *string) error {
 	if managedDatabases, ok := s.D.GetOkExists("managed_databases"); ok {
 		managedDatabasesToRemove := managedDatabases.(*schema.Set).List()
 
 		for _, managedDatabase := range managedDatabasesToRemove {
 			remove := mapToRemoveManagedDatabaseFromManagedDatabaseGroupDetails(managedDatabase.(map[string]interface{}))
-			err := s.removeManagedDatabaseFromManagedDatabaseGroup(*managedDatabaseGroupId, remove)
+			err := s.removeManagedDatabaseFromManagedDatabaseGroup(ctx, *managedDatabaseGroupId, remove)
 			if err != nil {
 				return fmt.Errorf("failed to remove Managed Database, error: %v", err)
 			}
@@ -416,7 +417,7 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) removeAllManagedDat
 	return nil
 }
 
-func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) Delete() error {
+func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database_management.DeleteManagedDatabaseGroupRequest{}
 
 	tmp := s.D.Id()
@@ -424,12 +425,12 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
-	mderr := s.removeAllManagedDatabases(request.ManagedDatabaseGroupId)
+	mderr := s.removeAllManagedDatabases(ctx, request.ManagedDatabaseGroupId)
 	if mderr != nil {
 		return mderr
 	}
 
-	_, err := s.Client.DeleteManagedDatabaseGroup(context.Background(), request)
+	_, err := s.Client.DeleteManagedDatabaseGroup(ctx, request)
 	return err
 }
 
@@ -529,7 +530,7 @@ func ManagedDatabaseGroupSummaryToMap(obj oci_database_management.ManagedDatabas
 	return result
 }
 
-func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_database_management.ChangeManagedDatabaseGroupCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -540,12 +541,12 @@ func (s *DatabaseManagementManagedDatabaseGroupResourceCrud) updateCompartment(c
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
-	_, err := s.Client.ChangeManagedDatabaseGroupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeManagedDatabaseGroupCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
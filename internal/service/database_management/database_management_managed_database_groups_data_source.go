@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseGroupsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabaseGroups,
+		ReadContext: readDatabaseManagementManagedDatabaseGroups,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -52,12 +53,12 @@ func DatabaseManagementManagedDatabaseGroupsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseManagementManagedDatabaseGroups(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabaseGroups(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseGroupsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseGroupsDataSourceCrud struct {
@@ -70,7 +71,7 @@ func (s *DatabaseManagementManagedDatabaseGroupsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseGroupsDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseGroupsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListManagedDatabaseGroupsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -94,7 +95,7 @@ func (s *DatabaseManagementManagedDatabaseGroupsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListManagedDatabaseGroups(context.Background(), request)
+	response, err := s.Client.ListManagedDatabaseGroups(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *DatabaseManagementManagedDatabaseGroupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListManagedDatabaseGroups(context.Background(), request)
+		listResponse, err := s.Client.ListManagedDatabaseGroups(ctx, request)
 		if err != nil {
 			return err
 		}
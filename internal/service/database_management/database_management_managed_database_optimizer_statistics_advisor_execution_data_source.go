@@ -6,6 +6,7 @@ package database_management
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 
@@ -15,7 +16,7 @@ import (
 
 func DatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutionDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecution,
+		ReadContext: readSingularDatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecution,
 		Schema: map[string]*schema.Schema{
 			"execution_name": {
 				Type:     schema.TypeString,
@@ -266,12 +267,12 @@ func DatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutionDataSou
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecution(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecution(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutionDataSourceCrud struct {
@@ -284,7 +285,7 @@ func (s *DatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutionDat
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutionDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.GetOptimizerStatisticsAdvisorExecutionRequest{}
 
 	if executionName, ok := s.D.GetOkExists("execution_name"); ok {
@@ -304,7 +305,7 @@ func (s *DatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutionDat
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.GetOptimizerStatisticsAdvisorExecution(context.Background(), request)
+	response, err := s.Client.GetOptimizerStatisticsAdvisorExecution(ctx, request)
 	if err != nil {
 		return err
 	}
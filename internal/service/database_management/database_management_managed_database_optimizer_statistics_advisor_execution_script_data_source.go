@@ -6,6 +6,7 @@ package database_management
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 
@@ -15,7 +16,7 @@ import (
 
 func DatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutionScriptDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutionScript,
+		ReadContext: readSingularDatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutionScript,
 		Schema: map[string]*schema.Schema{
 			"execution_name": {
 				Type:     schema.TypeString,
@@ -38,12 +39,12 @@ func DatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutionScriptD
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutionScript(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutionScript(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutionScriptDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutionScriptDataSourceCrud struct {
@@ -56,7 +57,7 @@ func (s *DatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutionScr
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutionScriptDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutionScriptDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.GetOptimizerStatisticsAdvisorExecutionScriptRequest{}
 
 	if executionName, ok := s.D.GetOkExists("execution_name"); ok {
@@ -76,7 +77,7 @@ func (s *DatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutionScr
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.GetOptimizerStatisticsAdvisorExecutionScript(context.Background(), request)
+	response, err := s.Client.GetOptimizerStatisticsAdvisorExecutionScript(ctx, request)
 	if err != nil {
 		return err
 	}
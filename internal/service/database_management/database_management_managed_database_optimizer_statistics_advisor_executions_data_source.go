@@ -6,6 +6,7 @@ package database_management
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 
@@ -15,7 +16,7 @@ import (
 
 func DatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutions,
+		ReadContext: readDatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"end_time_less_than_or_equal_to": {
@@ -295,12 +296,12 @@ func DatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutionsDataSo
 	}
 }
 
-func readDatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutions(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutionsDataSourceCrud struct {
@@ -313,7 +314,7 @@ func (s *DatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutionsDa
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutionsDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListOptimizerStatisticsAdvisorExecutionsRequest{}
 
 	if endTimeLessThanOrEqualTo, ok := s.D.GetOkExists("end_time_less_than_or_equal_to"); ok {
@@ -333,7 +334,7 @@ func (s *DatabaseManagementManagedDatabaseOptimizerStatisticsAdvisorExecutionsDa
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListOptimizerStatisticsAdvisorExecutions(context.Background(), request)
+	response, err := s.Client.ListOptimizerStatisticsAdvisorExecutions(ctx, request)
 	if err != nil {
 		return err
 	}
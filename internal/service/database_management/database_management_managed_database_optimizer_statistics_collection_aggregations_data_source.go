@@ -6,6 +6,7 @@ package database_management
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 
@@ -15,7 +16,7 @@ import (
 
 func DatabaseManagementManagedDatabaseOptimizerStatisticsCollectionAggregationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabaseOptimizerStatisticsCollectionAggregations,
+		ReadContext: readDatabaseManagementManagedDatabaseOptimizerStatisticsCollectionAggregations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"end_time_less_than_or_equal_to": {
@@ -116,12 +117,12 @@ func DatabaseManagementManagedDatabaseOptimizerStatisticsCollectionAggregationsD
 	}
 }
 
-func readDatabaseManagementManagedDatabaseOptimizerStatisticsCollectionAggregations(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabaseOptimizerStatisticsCollectionAggregations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseOptimizerStatisticsCollectionAggregationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseOptimizerStatisticsCollectionAggregationsDataSourceCrud struct {
@@ -134,7 +135,7 @@ func (s *DatabaseManagementManagedDatabaseOptimizerStatisticsCollectionAggregati
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseOptimizerStatisticsCollectionAggregationsDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseOptimizerStatisticsCollectionAggregationsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListOptimizerStatisticsCollectionAggregationsRequest{}
 
 	if endTimeLessThanOrEqualTo, ok := s.D.GetOkExists("end_time_less_than_or_equal_to"); ok {
@@ -167,7 +168,7 @@ func (s *DatabaseManagementManagedDatabaseOptimizerStatisticsCollectionAggregati
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListOptimizerStatisticsCollectionAggregations(context.Background(), request)
+	response, err := s.Client.ListOptimizerStatisticsCollectionAggregations(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -176,7 +177,7 @@ func (s *DatabaseManagementManagedDatabaseOptimizerStatisticsCollectionAggregati
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListOptimizerStatisticsCollectionAggregations(context.Background(), request)
+		listResponse, err := s.Client.ListOptimizerStatisticsCollectionAggregations(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 
@@ -16,7 +17,7 @@ import (
 
 func DatabaseManagementManagedDatabaseOptimizerStatisticsCollectionOperationDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabaseOptimizerStatisticsCollectionOperation,
+		ReadContext: readSingularDatabaseManagementManagedDatabaseOptimizerStatisticsCollectionOperation,
 		Schema: map[string]*schema.Schema{
 			"managed_database_id": {
 				Type:     schema.TypeString,
@@ -152,12 +153,12 @@ func DatabaseManagementManagedDatabaseOptimizerStatisticsCollectionOperationData
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabaseOptimizerStatisticsCollectionOperation(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseOptimizerStatisticsCollectionOperation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseOptimizerStatisticsCollectionOperationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseOptimizerStatisticsCollectionOperationDataSourceCrud struct {
@@ -170,7 +171,7 @@ func (s *DatabaseManagementManagedDatabaseOptimizerStatisticsCollectionOperation
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseOptimizerStatisticsCollectionOperationDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseOptimizerStatisticsCollectionOperationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.GetOptimizerStatisticsCollectionOperationRequest{}
 
 	if managedDatabaseId, ok := s.D.GetOkExists("managed_database_id"); ok {
@@ -185,7 +186,7 @@ func (s *DatabaseManagementManagedDatabaseOptimizerStatisticsCollectionOperation
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.GetOptimizerStatisticsCollectionOperation(context.Background(), request)
+	response, err := s.Client.GetOptimizerStatisticsCollectionOperation(ctx, request)
 	if err != nil {
 		return err
 	}
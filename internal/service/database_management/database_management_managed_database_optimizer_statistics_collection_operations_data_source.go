@@ -6,6 +6,7 @@ package database_management
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 
@@ -15,7 +16,7 @@ import (
 
 func DatabaseManagementManagedDatabaseOptimizerStatisticsCollectionOperationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabaseOptimizerStatisticsCollectionOperations,
+		ReadContext: readDatabaseManagementManagedDatabaseOptimizerStatisticsCollectionOperations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"end_time_less_than_or_equal_to": {
@@ -193,12 +194,12 @@ func DatabaseManagementManagedDatabaseOptimizerStatisticsCollectionOperationsDat
 	}
 }
 
-func readDatabaseManagementManagedDatabaseOptimizerStatisticsCollectionOperations(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabaseOptimizerStatisticsCollectionOperations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseOptimizerStatisticsCollectionOperationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseOptimizerStatisticsCollectionOperationsDataSourceCrud struct {
@@ -211,7 +212,7 @@ func (s *DatabaseManagementManagedDatabaseOptimizerStatisticsCollectionOperation
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseOptimizerStatisticsCollectionOperationsDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseOptimizerStatisticsCollectionOperationsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListOptimizerStatisticsCollectionOperationsRequest{}
 
 	if endTimeLessThanOrEqualTo, ok := s.D.GetOkExists("end_time_less_than_or_equal_to"); ok {
@@ -245,7 +246,7 @@ func (s *DatabaseManagementManagedDatabaseOptimizerStatisticsCollectionOperation
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListOptimizerStatisticsCollectionOperations(context.Background(), request)
+	response, err := s.Client.ListOptimizerStatisticsCollectionOperations(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -254,7 +255,7 @@ func (s *DatabaseManagementManagedDatabaseOptimizerStatisticsCollectionOperation
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListOptimizerStatisticsCollectionOperations(context.Background(), request)
+		listResponse, err := s.Client.ListOptimizerStatisticsCollectionOperations(ctx, request)
 		if err != nil {
 			return err
 		}
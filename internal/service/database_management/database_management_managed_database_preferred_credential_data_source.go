@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 
@@ -16,7 +17,7 @@ import (
 
 func DatabaseManagementManagedDatabasePreferredCredentialDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabasePreferredCredential,
+		ReadContext: readSingularDatabaseManagementManagedDatabasePreferredCredential,
 		Schema: map[string]*schema.Schema{
 			"credential_name": {
 				Type:     schema.TypeString,
@@ -55,12 +56,12 @@ func DatabaseManagementManagedDatabasePreferredCredentialDataSource() *schema.Re
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabasePreferredCredential(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabasePreferredCredential(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabasePreferredCredentialDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabasePreferredCredentialDataSourceCrud struct {
@@ -73,7 +74,7 @@ func (s *DatabaseManagementManagedDatabasePreferredCredentialDataSourceCrud) Voi
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabasePreferredCredentialDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabasePreferredCredentialDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.GetPreferredCredentialRequest{}
 
 	if credentialName, ok := s.D.GetOkExists("credential_name"); ok {
@@ -88,7 +89,7 @@ func (s *DatabaseManagementManagedDatabasePreferredCredentialDataSourceCrud) Get
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.GetPreferredCredential(context.Background(), request)
+	response, err := s.Client.GetPreferredCredential(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package database_management
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 
@@ -15,7 +16,7 @@ import (
 
 func DatabaseManagementManagedDatabasePreferredCredentialsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabasePreferredCredentials,
+		ReadContext: readDatabaseManagementManagedDatabasePreferredCredentials,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"managed_database_id": {
@@ -75,12 +76,12 @@ func DatabaseManagementManagedDatabasePreferredCredentialsDataSource() *schema.R
 	}
 }
 
-func readDatabaseManagementManagedDatabasePreferredCredentials(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabasePreferredCredentials(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabasePreferredCredentialsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabasePreferredCredentialsDataSourceCrud struct {
@@ -93,7 +94,7 @@ func (s *DatabaseManagementManagedDatabasePreferredCredentialsDataSourceCrud) Vo
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabasePreferredCredentialsDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabasePreferredCredentialsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListPreferredCredentialsRequest{}
 
 	if managedDatabaseId, ok := s.D.GetOkExists("managed_database_id"); ok {
@@ -103,7 +104,7 @@ func (s *DatabaseManagementManagedDatabasePreferredCredentialsDataSourceCrud) Ge
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListPreferredCredentials(context.Background(), request)
+	response, err := s.Client.ListPreferredCredentials(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
@@ -17,7 +18,7 @@ import (
 
 func DatabaseManagementManagedDatabaseSqlTuningAdvisorTaskDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTask,
+		ReadContext: readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTask,
 		Schema: map[string]*schema.Schema{
 			"managed_database_id": {
 				Type:     schema.TypeString,
@@ -105,12 +106,12 @@ func DatabaseManagementManagedDatabaseSqlTuningAdvisorTaskDataSource() *schema.R
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTask(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTask(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseSqlTuningAdvisorTaskDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SqlTuningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseSqlTuningAdvisorTaskDataSourceCrud struct {
@@ -123,7 +124,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTaskDataSourceCrud) Vo
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTaskDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTaskDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListSqlTuningAdvisorTasksRequest{}
 
 	if managedDatabaseId, ok := s.D.GetOkExists("managed_database_id"); ok {
@@ -158,7 +159,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTaskDataSourceCrud) Ge
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListSqlTuningAdvisorTasks(context.Background(), request)
+	response, err := s.Client.ListSqlTuningAdvisorTasks(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
@@ -19,7 +20,7 @@ import (
 
 func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabaseSqlTuningAdvisorTasks,
+		ReadContext: readDatabaseManagementManagedDatabaseSqlTuningAdvisorTasks,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"managed_database_id": {
@@ -115,12 +116,12 @@ func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksDataSource() *schema.
 	}
 }
 
-func readDatabaseManagementManagedDatabaseSqlTuningAdvisorTasks(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabaseSqlTuningAdvisorTasks(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SqlTuningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksDataSourceCrud struct {
@@ -133,7 +134,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksDataSourceCrud) V
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListSqlTuningAdvisorTasksRequest{}
 
 	if managedDatabaseId, ok := s.D.GetOkExists("managed_database_id"); ok {
@@ -168,7 +169,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksDataSourceCrud) G
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListSqlTuningAdvisorTasks(context.Background(), request)
+	response, err := s.Client.ListSqlTuningAdvisorTasks(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -177,7 +178,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksDataSourceCrud) G
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSqlTuningAdvisorTasks(context.Background(), request)
+		listResponse, err := s.Client.ListSqlTuningAdvisorTasks(ctx, request)
 		if err != nil {
 			return err
 		}
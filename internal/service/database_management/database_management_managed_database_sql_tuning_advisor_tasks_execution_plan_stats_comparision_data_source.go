@@ -11,13 +11,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksExecutionPlanStatsComparisionDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksExecutionPlanStatsComparision,
+		ReadContext: readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksExecutionPlanStatsComparision,
 		Schema: map[string]*schema.Schema{
 			"execution_id": {
 				Type:     schema.TypeString,
@@ -92,12 +93,12 @@ func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksExecutionPlanStatsCom
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksExecutionPlanStatsComparision(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksExecutionPlanStatsComparision(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksExecutionPlanStatsComparisionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SqlTuningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksExecutionPlanStatsComparisionDataSourceCrud struct {
@@ -110,7 +111,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksExecutionPlanStat
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksExecutionPlanStatsComparisionDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksExecutionPlanStatsComparisionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.GetExecutionPlanStatsComparisionRequest{}
 
 	if executionId, ok := s.D.GetOkExists("execution_id"); ok {
@@ -147,7 +148,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksExecutionPlanStat
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.GetExecutionPlanStatsComparision(context.Background(), request)
+	response, err := s.Client.GetExecutionPlanStatsComparision(ctx, request)
 	if err != nil {
 		return err
 	}
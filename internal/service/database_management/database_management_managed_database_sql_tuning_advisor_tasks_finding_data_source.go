@@ -11,13 +11,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFinding,
+		ReadContext: readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFinding,
 		Schema: map[string]*schema.Schema{
 			"begin_exec_id": {
 				Type:     schema.TypeString,
@@ -137,12 +138,12 @@ func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingDataSource() *
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFinding(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFinding(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SqlTuningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingDataSourceCrud struct {
@@ -155,7 +156,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingDataSource
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListSqlTuningAdvisorTaskFindingsRequest{}
 
 	if beginExecId, ok := s.D.GetOkExists("begin_exec_id"); ok {
@@ -210,7 +211,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingDataSource
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListSqlTuningAdvisorTaskFindings(context.Background(), request)
+	response, err := s.Client.ListSqlTuningAdvisorTaskFindings(ctx, request)
 	if err != nil {
 		return err
 	}
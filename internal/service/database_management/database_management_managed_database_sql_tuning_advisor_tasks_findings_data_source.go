@@ -11,13 +11,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindings,
+		ReadContext: readDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindings,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"begin_exec_id": {
@@ -145,12 +146,12 @@ func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingsDataSource()
 	}
 }
 
-func readDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindings(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindings(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SqlTuningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingsDataSourceCrud struct {
@@ -163,7 +164,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingsDataSourc
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingsDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListSqlTuningAdvisorTaskFindingsRequest{}
 
 	if beginExecId, ok := s.D.GetOkExists("begin_exec_id"); ok {
@@ -218,7 +219,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingsDataSourc
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListSqlTuningAdvisorTaskFindings(context.Background(), request)
+	response, err := s.Client.ListSqlTuningAdvisorTaskFindings(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -227,7 +228,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksFindingsDataSourc
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSqlTuningAdvisorTaskFindings(context.Background(), request)
+		listResponse, err := s.Client.ListSqlTuningAdvisorTaskFindings(ctx, request)
 		if err != nil {
 			return err
 		}
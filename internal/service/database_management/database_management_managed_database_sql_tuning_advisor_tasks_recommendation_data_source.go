@@ -11,13 +11,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendation,
+		ReadContext: readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendation,
 		Schema: map[string]*schema.Schema{
 			"execution_id": {
 				Type:     schema.TypeString,
@@ -93,12 +94,12 @@ func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationDataSou
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendation(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SqlTuningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationDataSourceCrud struct {
@@ -111,7 +112,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationDat
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListSqlTuningAdvisorTaskRecommendationsRequest{}
 
 	if executionId, ok := s.D.GetOkExists("execution_id"); ok {
@@ -148,7 +149,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationDat
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListSqlTuningAdvisorTaskRecommendations(context.Background(), request)
+	response, err := s.Client.ListSqlTuningAdvisorTaskRecommendations(ctx, request)
 	if err != nil {
 		return err
 	}
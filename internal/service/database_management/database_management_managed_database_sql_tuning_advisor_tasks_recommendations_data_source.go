@@ -11,13 +11,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendations,
+		ReadContext: readDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"execution_id": {
@@ -101,12 +102,12 @@ func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationsDataSo
 	}
 }
 
-func readDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendations(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SqlTuningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationsDataSourceCrud struct {
@@ -119,7 +120,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationsDa
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationsDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListSqlTuningAdvisorTaskRecommendationsRequest{}
 
 	if executionId, ok := s.D.GetOkExists("execution_id"); ok {
@@ -156,7 +157,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationsDa
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListSqlTuningAdvisorTaskRecommendations(context.Background(), request)
+	response, err := s.Client.ListSqlTuningAdvisorTaskRecommendations(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -165,7 +166,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksRecommendationsDa
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSqlTuningAdvisorTaskRecommendations(context.Background(), request)
+		listResponse, err := s.Client.ListSqlTuningAdvisorTaskRecommendations(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -11,13 +11,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSqlExecutionPlanDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSqlExecutionPlan,
+		ReadContext: readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSqlExecutionPlan,
 		Schema: map[string]*schema.Schema{
 			"attribute": {
 				Type:     schema.TypeString,
@@ -169,12 +170,12 @@ func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSqlExecutionPlanDataS
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSqlExecutionPlan(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSqlExecutionPlan(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSqlExecutionPlanDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SqlTuningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSqlExecutionPlanDataSourceCrud struct {
@@ -187,7 +188,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSqlExecutionPlanD
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSqlExecutionPlanDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSqlExecutionPlanDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.GetSqlExecutionPlanRequest{}
 
 	if attribute, ok := s.D.GetOkExists("attribute"); ok {
@@ -219,7 +220,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSqlExecutionPlanD
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.GetSqlExecutionPlan(context.Background(), request)
+	response, err := s.Client.GetSqlExecutionPlan(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
@@ -19,7 +20,7 @@ import (
 
 func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSummaryReportDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSummaryReport,
+		ReadContext: readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSummaryReport,
 		Schema: map[string]*schema.Schema{
 			"begin_exec_id_greater_than_or_equal_to": {
 				Type:     schema.TypeString,
@@ -284,12 +285,12 @@ func DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSummaryReportDataSour
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSummaryReport(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSummaryReport(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSummaryReportDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SqlTuningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSummaryReportDataSourceCrud struct {
@@ -302,7 +303,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSummaryReportData
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSummaryReportDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSummaryReportDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.GetSqlTuningAdvisorTaskSummaryReportRequest{}
 
 	if beginExecIdGreaterThanOrEqualTo, ok := s.D.GetOkExists("begin_exec_id_greater_than_or_equal_to"); ok {
@@ -359,7 +360,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningAdvisorTasksSummaryReportData
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.GetSqlTuningAdvisorTaskSummaryReport(context.Background(), request)
+	response, err := s.Client.GetSqlTuningAdvisorTaskSummaryReport(ctx, request)
 	if err != nil {
 		return err
 	}
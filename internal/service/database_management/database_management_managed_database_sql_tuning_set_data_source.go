@@ -6,6 +6,7 @@ package database_management
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 
@@ -15,7 +16,7 @@ import (
 
 func DatabaseManagementManagedDatabaseSqlTuningSetDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabaseSqlTuningSet,
+		ReadContext: readSingularDatabaseManagementManagedDatabaseSqlTuningSet,
 		Schema: map[string]*schema.Schema{
 			"managed_database_id": {
 				Type:     schema.TypeString,
@@ -63,12 +64,12 @@ func DatabaseManagementManagedDatabaseSqlTuningSetDataSource() *schema.Resource
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabaseSqlTuningSet(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseSqlTuningSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseSqlTuningSetDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SqlTuningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseSqlTuningSetDataSourceCrud struct {
@@ -81,7 +82,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningSetDataSourceCrud) VoidState(
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseSqlTuningSetDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseSqlTuningSetDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListSqlTuningSetsRequest{}
 
 	if managedDatabaseId, ok := s.D.GetOkExists("managed_database_id"); ok {
@@ -101,7 +102,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningSetDataSourceCrud) Get() erro
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListSqlTuningSets(context.Background(), request)
+	response, err := s.Client.ListSqlTuningSets(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package database_management
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 
@@ -15,7 +16,7 @@ import (
 
 func DatabaseManagementManagedDatabaseSqlTuningSetsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabaseSqlTuningSets,
+		ReadContext: readDatabaseManagementManagedDatabaseSqlTuningSets,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"managed_database_id": {
@@ -76,12 +77,12 @@ func DatabaseManagementManagedDatabaseSqlTuningSetsDataSource() *schema.Resource
 	}
 }
 
-func readDatabaseManagementManagedDatabaseSqlTuningSets(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabaseSqlTuningSets(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseSqlTuningSetsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SqlTuningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseSqlTuningSetsDataSourceCrud struct {
@@ -94,7 +95,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningSetsDataSourceCrud) VoidState
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseSqlTuningSetsDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseSqlTuningSetsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListSqlTuningSetsRequest{}
 
 	if managedDatabaseId, ok := s.D.GetOkExists("managed_database_id"); ok {
@@ -114,7 +115,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningSetsDataSourceCrud) Get() err
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListSqlTuningSets(context.Background(), request)
+	response, err := s.Client.ListSqlTuningSets(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -123,7 +124,7 @@ func (s *DatabaseManagementManagedDatabaseSqlTuningSetsDataSourceCrud) Get() err
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSqlTuningSets(context.Background(), request)
+		listResponse, err := s.Client.ListSqlTuningSets(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package database_management
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 
@@ -15,7 +16,7 @@ import (
 
 func DatabaseManagementManagedDatabaseTableStatisticsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabaseTableStatistics,
+		ReadContext: readDatabaseManagementManagedDatabaseTableStatistics,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"managed_database_id": {
@@ -64,12 +65,12 @@ func DatabaseManagementManagedDatabaseTableStatisticsDataSource() *schema.Resour
 	}
 }
 
-func readDatabaseManagementManagedDatabaseTableStatistics(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabaseTableStatistics(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseTableStatisticsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseTableStatisticsDataSourceCrud struct {
@@ -82,7 +83,7 @@ func (s *DatabaseManagementManagedDatabaseTableStatisticsDataSourceCrud) VoidSta
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseTableStatisticsDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseTableStatisticsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListTableStatisticsRequest{}
 
 	if managedDatabaseId, ok := s.D.GetOkExists("managed_database_id"); ok {
@@ -92,7 +93,7 @@ func (s *DatabaseManagementManagedDatabaseTableStatisticsDataSourceCrud) Get() e
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListTableStatistics(context.Background(), request)
+	response, err := s.Client.ListTableStatistics(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseUserConsumerGroupPrivilegeDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabaseUserConsumerGroupPrivilege,
+		ReadContext: readSingularDatabaseManagementManagedDatabaseUserConsumerGroupPrivilege,
 		Schema: map[string]*schema.Schema{
 			"managed_database_id": {
 				Type:     schema.TypeString,
@@ -59,12 +60,12 @@ func DatabaseManagementManagedDatabaseUserConsumerGroupPrivilegeDataSource() *sc
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabaseUserConsumerGroupPrivilege(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseUserConsumerGroupPrivilege(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseUserConsumerGroupPrivilegeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseUserConsumerGroupPrivilegeDataSourceCrud struct {
@@ -77,7 +78,7 @@ func (s *DatabaseManagementManagedDatabaseUserConsumerGroupPrivilegeDataSourceCr
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseUserConsumerGroupPrivilegeDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseUserConsumerGroupPrivilegeDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListConsumerGroupPrivilegesRequest{}
 
 	if managedDatabaseId, ok := s.D.GetOkExists("managed_database_id"); ok {
@@ -97,7 +98,7 @@ func (s *DatabaseManagementManagedDatabaseUserConsumerGroupPrivilegeDataSourceCr
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListConsumerGroupPrivileges(context.Background(), request)
+	response, err := s.Client.ListConsumerGroupPrivileges(ctx, request)
 	if err != nil {
 		return err
 	}
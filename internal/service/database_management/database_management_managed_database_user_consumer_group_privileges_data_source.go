@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseUserConsumerGroupPrivilegesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabaseUserConsumerGroupPrivileges,
+		ReadContext: readDatabaseManagementManagedDatabaseUserConsumerGroupPrivileges,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"managed_database_id": {
@@ -67,12 +68,12 @@ func DatabaseManagementManagedDatabaseUserConsumerGroupPrivilegesDataSource() *s
 	}
 }
 
-func readDatabaseManagementManagedDatabaseUserConsumerGroupPrivileges(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabaseUserConsumerGroupPrivileges(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseUserConsumerGroupPrivilegesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseUserConsumerGroupPrivilegesDataSourceCrud struct {
@@ -85,7 +86,7 @@ func (s *DatabaseManagementManagedDatabaseUserConsumerGroupPrivilegesDataSourceC
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseUserConsumerGroupPrivilegesDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseUserConsumerGroupPrivilegesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListConsumerGroupPrivilegesRequest{}
 
 	if managedDatabaseId, ok := s.D.GetOkExists("managed_database_id"); ok {
@@ -105,7 +106,7 @@ func (s *DatabaseManagementManagedDatabaseUserConsumerGroupPrivilegesDataSourceC
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListConsumerGroupPrivileges(context.Background(), request)
+	response, err := s.Client.ListConsumerGroupPrivileges(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -114,7 +115,7 @@ func (s *DatabaseManagementManagedDatabaseUserConsumerGroupPrivilegesDataSourceC
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListConsumerGroupPrivileges(context.Background(), request)
+		listResponse, err := s.Client.ListConsumerGroupPrivileges(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseUserDataAccessContainerDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabaseUserDataAccessContainer,
+		ReadContext: readSingularDatabaseManagementManagedDatabaseUserDataAccessContainer,
 		Schema: map[string]*schema.Schema{
 			"managed_database_id": {
 				Type:     schema.TypeString,
@@ -51,12 +52,12 @@ func DatabaseManagementManagedDatabaseUserDataAccessContainerDataSource() *schem
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabaseUserDataAccessContainer(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseUserDataAccessContainer(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseUserDataAccessContainerDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseUserDataAccessContainerDataSourceCrud struct {
@@ -69,7 +70,7 @@ func (s *DatabaseManagementManagedDatabaseUserDataAccessContainerDataSourceCrud)
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseUserDataAccessContainerDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseUserDataAccessContainerDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListDataAccessContainersRequest{}
 
 	if managedDatabaseId, ok := s.D.GetOkExists("managed_database_id"); ok {
@@ -89,7 +90,7 @@ func (s *DatabaseManagementManagedDatabaseUserDataAccessContainerDataSourceCrud)
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListDataAccessContainers(context.Background(), request)
+	response, err := s.Client.ListDataAccessContainers(ctx, request)
 	if err != nil {
 		return err
 	}
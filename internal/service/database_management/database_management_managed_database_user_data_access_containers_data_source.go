@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseUserDataAccessContainersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabaseUserDataAccessContainers,
+		ReadContext: readDatabaseManagementManagedDatabaseUserDataAccessContainers,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"managed_database_id": {
@@ -59,12 +60,12 @@ func DatabaseManagementManagedDatabaseUserDataAccessContainersDataSource() *sche
 	}
 }
 
-func readDatabaseManagementManagedDatabaseUserDataAccessContainers(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabaseUserDataAccessContainers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseUserDataAccessContainersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseUserDataAccessContainersDataSourceCrud struct {
@@ -77,7 +78,7 @@ func (s *DatabaseManagementManagedDatabaseUserDataAccessContainersDataSourceCrud
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseUserDataAccessContainersDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseUserDataAccessContainersDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListDataAccessContainersRequest{}
 
 	if managedDatabaseId, ok := s.D.GetOkExists("managed_database_id"); ok {
@@ -97,7 +98,7 @@ func (s *DatabaseManagementManagedDatabaseUserDataAccessContainersDataSourceCrud
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListDataAccessContainers(context.Background(), request)
+	response, err := s.Client.ListDataAccessContainers(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -106,7 +107,7 @@ func (s *DatabaseManagementManagedDatabaseUserDataAccessContainersDataSourceCrud
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDataAccessContainers(context.Background(), request)
+		listResponse, err := s.Client.ListDataAccessContainers(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseUserDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabaseUser,
+		ReadContext: readSingularDatabaseManagementManagedDatabaseUser,
 		Schema: map[string]*schema.Schema{
 			"managed_database_id": {
 				Type:     schema.TypeString,
@@ -126,12 +127,12 @@ func DatabaseManagementManagedDatabaseUserDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabaseUser(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseUserDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseUserDataSourceCrud struct {
@@ -144,7 +145,7 @@ func (s *DatabaseManagementManagedDatabaseUserDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseUserDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseUserDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.GetUserRequest{}
 
 	if managedDatabaseId, ok := s.D.GetOkExists("managed_database_id"); ok {
@@ -159,7 +160,7 @@ func (s *DatabaseManagementManagedDatabaseUserDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.GetUser(context.Background(), request)
+	response, err := s.Client.GetUser(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseUserObjectPrivilegeDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabaseUserObjectPrivilege,
+		ReadContext: readSingularDatabaseManagementManagedDatabaseUserObjectPrivilege,
 		Schema: map[string]*schema.Schema{
 			"managed_database_id": {
 				Type:     schema.TypeString,
@@ -83,12 +84,12 @@ func DatabaseManagementManagedDatabaseUserObjectPrivilegeDataSource() *schema.Re
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabaseUserObjectPrivilege(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseUserObjectPrivilege(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseUserObjectPrivilegeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseUserObjectPrivilegeDataSourceCrud struct {
@@ -101,7 +102,7 @@ func (s *DatabaseManagementManagedDatabaseUserObjectPrivilegeDataSourceCrud) Voi
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseUserObjectPrivilegeDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseUserObjectPrivilegeDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListObjectPrivilegesRequest{}
 
 	if managedDatabaseId, ok := s.D.GetOkExists("managed_database_id"); ok {
@@ -121,7 +122,7 @@ func (s *DatabaseManagementManagedDatabaseUserObjectPrivilegeDataSourceCrud) Get
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListObjectPrivileges(context.Background(), request)
+	response, err := s.Client.ListObjectPrivileges(ctx, request)
 	if err != nil {
 		return err
 	}
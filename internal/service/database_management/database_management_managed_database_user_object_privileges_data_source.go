@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseUserObjectPrivilegesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabaseUserObjectPrivileges,
+		ReadContext: readDatabaseManagementManagedDatabaseUserObjectPrivileges,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"managed_database_id": {
@@ -91,12 +92,12 @@ func DatabaseManagementManagedDatabaseUserObjectPrivilegesDataSource() *schema.R
 	}
 }
 
-func readDatabaseManagementManagedDatabaseUserObjectPrivileges(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabaseUserObjectPrivileges(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseUserObjectPrivilegesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseUserObjectPrivilegesDataSourceCrud struct {
@@ -109,7 +110,7 @@ func (s *DatabaseManagementManagedDatabaseUserObjectPrivilegesDataSourceCrud) Vo
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseUserObjectPrivilegesDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseUserObjectPrivilegesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListObjectPrivilegesRequest{}
 
 	if managedDatabaseId, ok := s.D.GetOkExists("managed_database_id"); ok {
@@ -129,7 +130,7 @@ func (s *DatabaseManagementManagedDatabaseUserObjectPrivilegesDataSourceCrud) Ge
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListObjectPrivileges(context.Background(), request)
+	response, err := s.Client.ListObjectPrivileges(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -138,7 +139,7 @@ func (s *DatabaseManagementManagedDatabaseUserObjectPrivilegesDataSourceCrud) Ge
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListObjectPrivileges(context.Background(), request)
+		listResponse, err := s.Client.ListObjectPrivileges(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseUserProxiedForUserDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabaseUserProxiedForUser,
+		ReadContext: readSingularDatabaseManagementManagedDatabaseUserProxiedForUser,
 		Schema: map[string]*schema.Schema{
 			"managed_database_id": {
 				Type:     schema.TypeString,
@@ -59,12 +60,12 @@ func DatabaseManagementManagedDatabaseUserProxiedForUserDataSource() *schema.Res
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabaseUserProxiedForUser(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseUserProxiedForUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseUserProxiedForUserDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseUserProxiedForUserDataSourceCrud struct {
@@ -77,7 +78,7 @@ func (s *DatabaseManagementManagedDatabaseUserProxiedForUserDataSourceCrud) Void
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseUserProxiedForUserDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseUserProxiedForUserDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListProxiedForUsersRequest{}
 
 	if managedDatabaseId, ok := s.D.GetOkExists("managed_database_id"); ok {
@@ -97,7 +98,7 @@ func (s *DatabaseManagementManagedDatabaseUserProxiedForUserDataSourceCrud) Get(
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListProxiedForUsers(context.Background(), request)
+	response, err := s.Client.ListProxiedForUsers(ctx, request)
 	if err != nil {
 		return err
 	}
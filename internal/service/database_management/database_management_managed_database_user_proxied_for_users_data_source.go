@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseUserProxiedForUsersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabaseUserProxiedForUsers,
+		ReadContext: readDatabaseManagementManagedDatabaseUserProxiedForUsers,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"managed_database_id": {
@@ -67,12 +68,12 @@ func DatabaseManagementManagedDatabaseUserProxiedForUsersDataSource() *schema.Re
 	}
 }
 
-func readDatabaseManagementManagedDatabaseUserProxiedForUsers(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabaseUserProxiedForUsers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseUserProxiedForUsersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseUserProxiedForUsersDataSourceCrud struct {
@@ -85,7 +86,7 @@ func (s *DatabaseManagementManagedDatabaseUserProxiedForUsersDataSourceCrud) Voi
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseUserProxiedForUsersDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseUserProxiedForUsersDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListProxiedForUsersRequest{}
 
 	if managedDatabaseId, ok := s.D.GetOkExists("managed_database_id"); ok {
@@ -105,7 +106,7 @@ func (s *DatabaseManagementManagedDatabaseUserProxiedForUsersDataSourceCrud) Get
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListProxiedForUsers(context.Background(), request)
+	response, err := s.Client.ListProxiedForUsers(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -114,7 +115,7 @@ func (s *DatabaseManagementManagedDatabaseUserProxiedForUsersDataSourceCrud) Get
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListProxiedForUsers(context.Background(), request)
+		listResponse, err := s.Client.ListProxiedForUsers(ctx, request)
 		if err != nil {
 			return err
 		}
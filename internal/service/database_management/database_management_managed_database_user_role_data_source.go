@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseUserRoleDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabaseUserRole,
+		ReadContext: readSingularDatabaseManagementManagedDatabaseUserRole,
 		Schema: map[string]*schema.Schema{
 			"managed_database_id": {
 				Type:     schema.TypeString,
@@ -71,12 +72,12 @@ func DatabaseManagementManagedDatabaseUserRoleDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabaseUserRole(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabaseUserRole(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseUserRoleDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseUserRoleDataSourceCrud struct {
@@ -89,7 +90,7 @@ func (s *DatabaseManagementManagedDatabaseUserRoleDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseUserRoleDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseUserRoleDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListRolesRequest{}
 
 	if managedDatabaseId, ok := s.D.GetOkExists("managed_database_id"); ok {
@@ -109,7 +110,7 @@ func (s *DatabaseManagementManagedDatabaseUserRoleDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListRoles(context.Background(), request)
+	response, err := s.Client.ListRoles(ctx, request)
 	if err != nil {
 		return err
 	}
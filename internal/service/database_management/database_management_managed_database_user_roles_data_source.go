@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseUserRolesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabaseUserRoles,
+		ReadContext: readDatabaseManagementManagedDatabaseUserRoles,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"managed_database_id": {
@@ -79,12 +80,12 @@ func DatabaseManagementManagedDatabaseUserRolesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseManagementManagedDatabaseUserRoles(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabaseUserRoles(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseUserRolesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseUserRolesDataSourceCrud struct {
@@ -97,7 +98,7 @@ func (s *DatabaseManagementManagedDatabaseUserRolesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseUserRolesDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseUserRolesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListRolesRequest{}
 
 	if managedDatabaseId, ok := s.D.GetOkExists("managed_database_id"); ok {
@@ -117,7 +118,7 @@ func (s *DatabaseManagementManagedDatabaseUserRolesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListRoles(context.Background(), request)
+	response, err := s.Client.ListRoles(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -126,7 +127,7 @@ func (s *DatabaseManagementManagedDatabaseUserRolesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRoles(context.Background(), request)
+		listResponse, err := s.Client.ListRoles(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabaseUsersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabaseUsers,
+		ReadContext: readDatabaseManagementManagedDatabaseUsers,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"managed_database_id": {
@@ -147,12 +148,12 @@ func DatabaseManagementManagedDatabaseUsersDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseManagementManagedDatabaseUsers(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabaseUsers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabaseUsersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabaseUsersDataSourceCrud struct {
@@ -165,7 +166,7 @@ func (s *DatabaseManagementManagedDatabaseUsersDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabaseUsersDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabaseUsersDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListUsersRequest{}
 
 	if managedDatabaseId, ok := s.D.GetOkExists("managed_database_id"); ok {
@@ -180,7 +181,7 @@ func (s *DatabaseManagementManagedDatabaseUsersDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListUsers(context.Background(), request)
+	response, err := s.Client.ListUsers(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -189,7 +190,7 @@ func (s *DatabaseManagementManagedDatabaseUsersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListUsers(context.Background(), request)
+		listResponse, err := s.Client.ListUsers(ctx, request)
 		if err != nil {
 			return err
 		}
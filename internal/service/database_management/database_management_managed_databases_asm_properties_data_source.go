@@ -6,6 +6,7 @@ package database_management
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 
@@ -15,7 +16,7 @@ import (
 
 func DatabaseManagementManagedDatabasesAsmPropertiesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabasesAsmProperties,
+		ReadContext: readDatabaseManagementManagedDatabasesAsmProperties,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"managed_database_id": {
@@ -60,12 +61,12 @@ func DatabaseManagementManagedDatabasesAsmPropertiesDataSource() *schema.Resourc
 	}
 }
 
-func readDatabaseManagementManagedDatabasesAsmProperties(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabasesAsmProperties(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabasesAsmPropertiesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabasesAsmPropertiesDataSourceCrud struct {
@@ -78,7 +79,7 @@ func (s *DatabaseManagementManagedDatabasesAsmPropertiesDataSourceCrud) VoidStat
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabasesAsmPropertiesDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabasesAsmPropertiesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListAsmPropertiesRequest{}
 
 	if managedDatabaseId, ok := s.D.GetOkExists("managed_database_id"); ok {
@@ -93,7 +94,7 @@ func (s *DatabaseManagementManagedDatabasesAsmPropertiesDataSourceCrud) Get() er
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListAsmProperties(context.Background(), request)
+	response, err := s.Client.ListAsmProperties(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -102,7 +103,7 @@ func (s *DatabaseManagementManagedDatabasesAsmPropertiesDataSourceCrud) Get() er
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAsmProperties(context.Background(), request)
+		listResponse, err := s.Client.ListAsmProperties(ctx, request)
 		if err != nil {
 			return err
 		}
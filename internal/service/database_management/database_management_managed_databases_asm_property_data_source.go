@@ -6,6 +6,7 @@ package database_management
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 
@@ -15,7 +16,7 @@ import (
 
 func DatabaseManagementManagedDatabasesAsmPropertyDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabasesAsmProperty,
+		ReadContext: readSingularDatabaseManagementManagedDatabasesAsmProperty,
 		Schema: map[string]*schema.Schema{
 			"managed_database_id": {
 				Type:     schema.TypeString,
@@ -47,12 +48,12 @@ func DatabaseManagementManagedDatabasesAsmPropertyDataSource() *schema.Resource
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabasesAsmProperty(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabasesAsmProperty(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabasesAsmPropertyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabasesAsmPropertyDataSourceCrud struct {
@@ -65,7 +66,7 @@ func (s *DatabaseManagementManagedDatabasesAsmPropertyDataSourceCrud) VoidState(
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabasesAsmPropertyDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabasesAsmPropertyDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListAsmPropertiesRequest{}
 
 	if managedDatabaseId, ok := s.D.GetOkExists("managed_database_id"); ok {
@@ -80,7 +81,7 @@ func (s *DatabaseManagementManagedDatabasesAsmPropertyDataSourceCrud) Get() erro
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListAsmProperties(context.Background(), request)
+	response, err := s.Client.ListAsmProperties(ctx, request)
 	if err != nil {
 		return err
 	}
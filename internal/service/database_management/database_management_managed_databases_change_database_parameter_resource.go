@@ -10,16 +10,17 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabasesChangeDatabaseParameterResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseManagementManagedDatabasesChangeDatabaseParameter,
-		Read:     readDatabaseManagementManagedDatabasesChangeDatabaseParameter,
-		Delete:   deleteDatabaseManagementManagedDatabasesChangeDatabaseParameter,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseManagementManagedDatabasesChangeDatabaseParameter,
+		ReadContext:   readDatabaseManagementManagedDatabasesChangeDatabaseParameter,
+		DeleteContext: deleteDatabaseManagementManagedDatabasesChangeDatabaseParameter,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"credentials": {
@@ -107,19 +108,19 @@ func DatabaseManagementManagedDatabasesChangeDatabaseParameterResource() *schema
 	}
 }
 
-func createDatabaseManagementManagedDatabasesChangeDatabaseParameter(d *schema.ResourceData, m interface{}) error {
+func createDatabaseManagementManagedDatabasesChangeDatabaseParameter(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabasesChangeDatabaseParameterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseManagementManagedDatabasesChangeDatabaseParameter(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabasesChangeDatabaseParameter(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteDatabaseManagementManagedDatabasesChangeDatabaseParameter(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseManagementManagedDatabasesChangeDatabaseParameter(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -139,7 +140,7 @@ func (s *DatabaseManagementManagedDatabasesChangeDatabaseParameterResourceCrud)
 	return *s.Req.ManagedDatabaseId
 }
 
-func (s *DatabaseManagementManagedDatabasesChangeDatabaseParameterResourceCrud) Create() error {
+func (s *DatabaseManagementManagedDatabasesChangeDatabaseParameterResourceCrud) Create(ctx context.Context) error {
 	request := oci_database_management.ChangeDatabaseParametersRequest{}
 
 	if credentials, ok := s.D.GetOkExists("credentials"); ok {
@@ -181,7 +182,7 @@ func (s *DatabaseManagementManagedDatabasesChangeDatabaseParameterResourceCrud)
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_management")
 
-	response, err := s.Client.ChangeDatabaseParameters(context.Background(), request)
+	response, err := s.Client.ChangeDatabaseParameters(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabasesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabases,
+		ReadContext: readDatabaseManagementManagedDatabases,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -141,12 +142,12 @@ func DatabaseManagementManagedDatabasesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseManagementManagedDatabases(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabases(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabasesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabasesDataSourceCrud struct {
@@ -159,7 +160,7 @@ func (s *DatabaseManagementManagedDatabasesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabasesDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabasesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListManagedDatabasesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -187,7 +188,7 @@ func (s *DatabaseManagementManagedDatabasesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListManagedDatabases(context.Background(), request)
+	response, err := s.Client.ListManagedDatabases(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -196,7 +197,7 @@ func (s *DatabaseManagementManagedDatabasesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListManagedDatabases(context.Background(), request)
+		listResponse, err := s.Client.ListManagedDatabases(ctx, request)
 		if err != nil {
 			return err
 		}
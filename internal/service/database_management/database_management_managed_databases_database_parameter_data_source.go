@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabasesDatabaseParameterDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabasesDatabaseParameter,
+		ReadContext: readSingularDatabaseManagementManagedDatabasesDatabaseParameter,
 		Schema: map[string]*schema.Schema{
 			"is_allowed_values_included": {
 				Type:     schema.TypeBool,
@@ -180,12 +181,12 @@ func DatabaseManagementManagedDatabasesDatabaseParameterDataSource() *schema.Res
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabasesDatabaseParameter(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabasesDatabaseParameter(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabasesDatabaseParameterDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabasesDatabaseParameterDataSourceCrud struct {
@@ -198,7 +199,7 @@ func (s *DatabaseManagementManagedDatabasesDatabaseParameterDataSourceCrud) Void
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabasesDatabaseParameterDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabasesDatabaseParameterDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListDatabaseParametersRequest{}
 
 	if isAllowedValuesIncluded, ok := s.D.GetOkExists("is_allowed_values_included"); ok {
@@ -222,7 +223,7 @@ func (s *DatabaseManagementManagedDatabasesDatabaseParameterDataSourceCrud) Get(
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListDatabaseParameters(context.Background(), request)
+	response, err := s.Client.ListDatabaseParameters(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabasesDatabaseParametersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabasesDatabaseParameters,
+		ReadContext: readDatabaseManagementManagedDatabasesDatabaseParameters,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"is_allowed_values_included": {
@@ -193,12 +194,12 @@ func DatabaseManagementManagedDatabasesDatabaseParametersDataSource() *schema.Re
 	}
 }
 
-func readDatabaseManagementManagedDatabasesDatabaseParameters(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabasesDatabaseParameters(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabasesDatabaseParametersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabasesDatabaseParametersDataSourceCrud struct {
@@ -211,7 +212,7 @@ func (s *DatabaseManagementManagedDatabasesDatabaseParametersDataSourceCrud) Voi
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabasesDatabaseParametersDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabasesDatabaseParametersDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListDatabaseParametersRequest{}
 
 	if isAllowedValuesIncluded, ok := s.D.GetOkExists("is_allowed_values_included"); ok {
@@ -235,7 +236,7 @@ func (s *DatabaseManagementManagedDatabasesDatabaseParametersDataSourceCrud) Get
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListDatabaseParameters(context.Background(), request)
+	response, err := s.Client.ListDatabaseParameters(ctx, request)
 	if err != nil {
 		return err
 	}
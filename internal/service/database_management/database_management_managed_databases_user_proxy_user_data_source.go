@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabasesUserProxyUserDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabasesUserProxyUser,
+		ReadContext: readSingularDatabaseManagementManagedDatabasesUserProxyUser,
 		Schema: map[string]*schema.Schema{
 			"managed_database_id": {
 				Type:     schema.TypeString,
@@ -59,12 +60,12 @@ func DatabaseManagementManagedDatabasesUserProxyUserDataSource() *schema.Resourc
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabasesUserProxyUser(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabasesUserProxyUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabasesUserProxyUserDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabasesUserProxyUserDataSourceCrud struct {
@@ -77,7 +78,7 @@ func (s *DatabaseManagementManagedDatabasesUserProxyUserDataSourceCrud) VoidStat
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabasesUserProxyUserDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabasesUserProxyUserDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListProxyUsersRequest{}
 
 	if managedDatabaseId, ok := s.D.GetOkExists("managed_database_id"); ok {
@@ -97,7 +98,7 @@ func (s *DatabaseManagementManagedDatabasesUserProxyUserDataSourceCrud) Get() er
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListProxyUsers(context.Background(), request)
+	response, err := s.Client.ListProxyUsers(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabasesUserProxyUsersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabasesUserProxyUsers,
+		ReadContext: readDatabaseManagementManagedDatabasesUserProxyUsers,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"managed_database_id": {
@@ -67,12 +68,12 @@ func DatabaseManagementManagedDatabasesUserProxyUsersDataSource() *schema.Resour
 	}
 }
 
-func readDatabaseManagementManagedDatabasesUserProxyUsers(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabasesUserProxyUsers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabasesUserProxyUsersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabasesUserProxyUsersDataSourceCrud struct {
@@ -85,7 +86,7 @@ func (s *DatabaseManagementManagedDatabasesUserProxyUsersDataSourceCrud) VoidSta
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabasesUserProxyUsersDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabasesUserProxyUsersDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListProxyUsersRequest{}
 
 	if managedDatabaseId, ok := s.D.GetOkExists("managed_database_id"); ok {
@@ -105,7 +106,7 @@ func (s *DatabaseManagementManagedDatabasesUserProxyUsersDataSourceCrud) Get() e
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListProxyUsers(context.Background(), request)
+	response, err := s.Client.ListProxyUsers(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -114,7 +115,7 @@ func (s *DatabaseManagementManagedDatabasesUserProxyUsersDataSourceCrud) Get() e
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListProxyUsers(context.Background(), request)
+		listResponse, err := s.Client.ListProxyUsers(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabasesUserSystemPrivilegeDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseManagementManagedDatabasesUserSystemPrivilege,
+		ReadContext: readSingularDatabaseManagementManagedDatabasesUserSystemPrivilege,
 		Schema: map[string]*schema.Schema{
 			"managed_database_id": {
 				Type:     schema.TypeString,
@@ -63,12 +64,12 @@ func DatabaseManagementManagedDatabasesUserSystemPrivilegeDataSource() *schema.R
 	}
 }
 
-func readSingularDatabaseManagementManagedDatabasesUserSystemPrivilege(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseManagementManagedDatabasesUserSystemPrivilege(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabasesUserSystemPrivilegeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabasesUserSystemPrivilegeDataSourceCrud struct {
@@ -81,7 +82,7 @@ func (s *DatabaseManagementManagedDatabasesUserSystemPrivilegeDataSourceCrud) Vo
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabasesUserSystemPrivilegeDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabasesUserSystemPrivilegeDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListSystemPrivilegesRequest{}
 
 	if managedDatabaseId, ok := s.D.GetOkExists("managed_database_id"); ok {
@@ -101,7 +102,7 @@ func (s *DatabaseManagementManagedDatabasesUserSystemPrivilegeDataSourceCrud) Ge
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListSystemPrivileges(context.Background(), request)
+	response, err := s.Client.ListSystemPrivileges(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_management "github.com/oracle/oci-go-sdk/v65/databasemanagement"
 )
 
 func DatabaseManagementManagedDatabasesUserSystemPrivilegesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseManagementManagedDatabasesUserSystemPrivileges,
+		ReadContext: readDatabaseManagementManagedDatabasesUserSystemPrivileges,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"managed_database_id": {
@@ -71,12 +72,12 @@ func DatabaseManagementManagedDatabasesUserSystemPrivilegesDataSource() *schema.
 	}
 }
 
-func readDatabaseManagementManagedDatabasesUserSystemPrivileges(d *schema.ResourceData, m interface{}) error {
+func readDatabaseManagementManagedDatabasesUserSystemPrivileges(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseManagementManagedDatabasesUserSystemPrivilegesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseManagementManagedDatabasesUserSystemPrivilegesDataSourceCrud struct {
@@ -89,7 +90,7 @@ func (s *DatabaseManagementManagedDatabasesUserSystemPrivilegesDataSourceCrud) V
 	s.D.SetId("")
 }
 
-func (s *DatabaseManagementManagedDatabasesUserSystemPrivilegesDataSourceCrud) Get() error {
+func (s *DatabaseManagementManagedDatabasesUserSystemPrivilegesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_management.ListSystemPrivilegesRequest{}
 
 	if managedDatabaseId, ok := s.D.GetOkExists("managed_database_id"); ok {
@@ -109,7 +110,7 @@ func (s *DatabaseManagementManagedDatabasesUserSystemPrivilegesDataSourceCrud) G
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_management")
 
-	response, err := s.Client.ListSystemPrivileges(context.Background(), request)
+	response, err := s.Client.ListSystemPrivileges(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -118,7 +119,7 @@ func (s *DatabaseManagementManagedDatabasesUserSystemPrivilegesDataSourceCrud) G
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSystemPrivileges(context.Background(), request)
+		listResponse, err := s.Client.ListSystemPrivileges(ctx, request)
 		if err != nil {
 			return err
 		}
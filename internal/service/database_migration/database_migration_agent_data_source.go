@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_migration "github.com/oracle/oci-go-sdk/v65/databasemigration"
 )
@@ -22,12 +23,12 @@ func DatabaseMigrationAgentDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseMigrationAgentResource(), fieldMap, readSingularDatabaseMigrationAgent)
 }
 
-func readSingularDatabaseMigrationAgent(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseMigrationAgent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationAgentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseMigrationAgentDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DatabaseMigrationAgentDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseMigrationAgentDataSourceCrud) Get() error {
+func (s *DatabaseMigrationAgentDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_migration.GetAgentRequest{}
 
 	if agentId, ok := s.D.GetOkExists("agent_id"); ok {
@@ -50,7 +51,7 @@ func (s *DatabaseMigrationAgentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_migration")
 
-	response, err := s.Client.GetAgent(context.Background(), request)
+	response, err := s.Client.GetAgent(ctx, request)
 	if err != nil {
 		return err
 	}
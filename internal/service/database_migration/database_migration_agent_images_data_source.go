@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_migration "github.com/oracle/oci-go-sdk/v65/databasemigration"
 )
 
 func DatabaseMigrationAgentImagesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseMigrationAgentImages,
+		ReadContext: readDatabaseMigrationAgentImages,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"agent_image_collection": {
@@ -56,12 +57,12 @@ func DatabaseMigrationAgentImagesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseMigrationAgentImages(d *schema.ResourceData, m interface{}) error {
+func readDatabaseMigrationAgentImages(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationAgentImagesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseMigrationAgentImagesDataSourceCrud struct {
@@ -74,12 +75,12 @@ func (s *DatabaseMigrationAgentImagesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseMigrationAgentImagesDataSourceCrud) Get() error {
+func (s *DatabaseMigrationAgentImagesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_migration.ListAgentImagesRequest{}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_migration")
 
-	response, err := s.Client.ListAgentImages(context.Background(), request)
+	response, err := s.Client.ListAgentImages(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -88,7 +89,7 @@ func (s *DatabaseMigrationAgentImagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAgentImages(context.Background(), request)
+		listResponse, err := s.Client.ListAgentImages(ctx, request)
 		if err != nil {
 			return err
 		}
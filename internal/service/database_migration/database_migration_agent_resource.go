@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database_migration "github.com/oracle/oci-go-sdk/v65/databasemigration"
@@ -19,11 +20,11 @@ func DatabaseMigrationAgentResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseMigrationAgent,
-		Read:     readDatabaseMigrationAgent,
-		Update:   updateDatabaseMigrationAgent,
-		Delete:   deleteDatabaseMigrationAgent,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseMigrationAgent,
+		ReadContext:   readDatabaseMigrationAgent,
+		UpdateContext: updateDatabaseMigrationAgent,
+		DeleteContext: deleteDatabaseMigrationAgent,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"agent_id": {
@@ -98,37 +99,37 @@ func DatabaseMigrationAgentResource() *schema.Resource {
 	}
 }
 
-func createDatabaseMigrationAgent(d *schema.ResourceData, m interface{}) error {
+func createDatabaseMigrationAgent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationAgentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseMigrationAgent(d *schema.ResourceData, m interface{}) error {
+func readDatabaseMigrationAgent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationAgentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatabaseMigrationAgent(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseMigrationAgent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationAgentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatabaseMigrationAgent(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseMigrationAgent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationAgentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabaseMigrationAgentResourceCrud struct {
@@ -166,7 +167,7 @@ func (s *DatabaseMigrationAgentResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DatabaseMigrationAgentResourceCrud) Create() error {
+func (s *DatabaseMigrationAgentResourceCrud) Create(ctx context.Context) error {
 	request := oci_database_migration.UpdateAgentRequest{}
 
 	if agentId, ok := s.D.GetOkExists("agent_id"); ok {
@@ -213,7 +214,7 @@ func (s *DatabaseMigrationAgentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	response, err := s.Client.UpdateAgent(context.Background(), request)
+	response, err := s.Client.UpdateAgent(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -222,7 +223,7 @@ func (s *DatabaseMigrationAgentResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabaseMigrationAgentResourceCrud) Get() error {
+func (s *DatabaseMigrationAgentResourceCrud) Get(ctx context.Context) error {
 	request := oci_database_migration.GetAgentRequest{}
 
 	tmp := s.D.Id()
@@ -230,7 +231,7 @@ func (s *DatabaseMigrationAgentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	response, err := s.Client.GetAgent(context.Background(), request)
+	response, err := s.Client.GetAgent(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -239,11 +240,11 @@ func (s *DatabaseMigrationAgentResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseMigrationAgentResourceCrud) Update() error {
+func (s *DatabaseMigrationAgentResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -293,7 +294,7 @@ func (s *DatabaseMigrationAgentResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	response, err := s.Client.UpdateAgent(context.Background(), request)
+	response, err := s.Client.UpdateAgent(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -302,7 +303,7 @@ func (s *DatabaseMigrationAgentResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DatabaseMigrationAgentResourceCrud) Delete() error {
+func (s *DatabaseMigrationAgentResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database_migration.DeleteAgentRequest{}
 
 	tmp := s.D.Id()
@@ -310,7 +311,7 @@ func (s *DatabaseMigrationAgentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	_, err := s.Client.DeleteAgent(context.Background(), request)
+	_, err := s.Client.DeleteAgent(ctx, request)
 	return err
 }
 
@@ -412,7 +413,7 @@ func AgentSummaryToMap(obj oci_database_migration.AgentSummary) map[string]inter
 	return result
 }
 
-func (s *DatabaseMigrationAgentResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DatabaseMigrationAgentResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_database_migration.ChangeAgentCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -423,12 +424,12 @@ func (s *DatabaseMigrationAgentResourceCrud) updateCompartment(compartment inter
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	_, err := s.Client.ChangeAgentCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeAgentCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_migration "github.com/oracle/oci-go-sdk/v65/databasemigration"
 )
 
 func DatabaseMigrationAgentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseMigrationAgents,
+		ReadContext: readDatabaseMigrationAgents,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,12 +49,12 @@ func DatabaseMigrationAgentsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseMigrationAgents(d *schema.ResourceData, m interface{}) error {
+func readDatabaseMigrationAgents(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationAgentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseMigrationAgentsDataSourceCrud struct {
@@ -66,7 +67,7 @@ func (s *DatabaseMigrationAgentsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseMigrationAgentsDataSourceCrud) Get() error {
+func (s *DatabaseMigrationAgentsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_migration.ListAgentsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -85,7 +86,7 @@ func (s *DatabaseMigrationAgentsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_migration")
 
-	response, err := s.Client.ListAgents(context.Background(), request)
+	response, err := s.Client.ListAgents(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *DatabaseMigrationAgentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAgents(context.Background(), request)
+		listResponse, err := s.Client.ListAgents(ctx, request)
 		if err != nil {
 			return err
 		}
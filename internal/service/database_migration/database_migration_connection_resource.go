@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -26,10 +27,10 @@ func DatabaseMigrationConnectionResource() *schema.Resource {
 		},
 		Timeouts: tfresource.DefaultTimeout,
 
-		Create: createDatabaseMigrationConnection,
-		Read:   readDatabaseMigrationConnection,
-		Update: updateDatabaseMigrationConnection,
-		Delete: deleteDatabaseMigrationConnection,
+		CreateContext: createDatabaseMigrationConnection,
+		ReadContext:   readDatabaseMigrationConnection,
+		UpdateContext: updateDatabaseMigrationConnection,
+		DeleteContext: deleteDatabaseMigrationConnection,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"admin_credentials": {
@@ -268,37 +269,37 @@ func DatabaseMigrationConnectionResource() *schema.Resource {
 	}
 }
 
-func createDatabaseMigrationConnection(d *schema.ResourceData, m interface{}) error {
+func createDatabaseMigrationConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseMigrationConnection(d *schema.ResourceData, m interface{}) error {
+func readDatabaseMigrationConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatabaseMigrationConnection(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseMigrationConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatabaseMigrationConnection(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseMigrationConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabaseMigrationConnectionResourceCrud struct {
@@ -336,7 +337,7 @@ func (s *DatabaseMigrationConnectionResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DatabaseMigrationConnectionResourceCrud) Create() error {
+func (s *DatabaseMigrationConnectionResourceCrud) Create(ctx context.Context) error {
 	request := oci_database_migration.CreateConnectionRequest{}
 
 	if adminCredentials, ok := s.D.GetOkExists("admin_credentials"); ok {
@@ -442,20 +443,20 @@ func (s *DatabaseMigrationConnectionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	response, err := s.Client.CreateConnection(context.Background(), request)
+	response, err := s.Client.CreateConnection(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getConnectionFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration"), oci_database_migration.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getConnectionFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration"), oci_database_migration.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *DatabaseMigrationConnectionResourceCrud) getConnectionFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *DatabaseMigrationConnectionResourceCrud) getConnectionFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_database_migration.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	connectionId, err := connectionWaitForWorkRequest(workId, "connection",
+	connectionId, err := connectionWaitForWorkRequest(ctx, workId, "connection",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -463,7 +464,7 @@ func (s *DatabaseMigrationConnectionResourceCrud) getConnectionFromWorkRequest(w
 	}
 	s.D.SetId(*connectionId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func connectionWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -491,7 +492,7 @@ func connectionWorkRequestShouldRetryFunc(timeout time.Duration) func(response o
 	}
 }
 
-func connectionWaitForWorkRequest(wId *string, entityType string, action oci_database_migration.WorkRequestResourceActionTypeEnum,
+func connectionWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_database_migration.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_database_migration.DatabaseMigrationClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "database_migration")
 	retryPolicy.ShouldRetryOperation = connectionWorkRequestShouldRetryFunc(timeout)
@@ -511,7 +512,7 @@ func connectionWaitForWorkRequest(wId *string, entityType string, action oci_dat
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_database_migration.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -539,13 +540,13 @@ func connectionWaitForWorkRequest(wId *string, entityType string, action oci_dat
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_database_migration.OperationStatusFailed || response.Status == oci_database_migration.OperationStatusCanceled {
-		return nil, getErrorFromDatabaseMigrationConnectionWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDatabaseMigrationConnectionWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 	return identifier, nil
 }
 
-func getErrorFromDatabaseMigrationConnectionWorkRequest(client *oci_database_migration.DatabaseMigrationClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_database_migration.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDatabaseMigrationConnectionWorkRequest(ctx context.Context, client *oci_database_migration.DatabaseMigrationClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_database_migration.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_database_migration.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -567,7 +568,7 @@ func getErrorFromDatabaseMigrationConnectionWorkRequest(client *oci_database_mig
 	return workRequestErr
 }
 
-func (s *DatabaseMigrationConnectionResourceCrud) Get() error {
+func (s *DatabaseMigrationConnectionResourceCrud) Get(ctx context.Context) error {
 	request := oci_database_migration.GetConnectionRequest{}
 
 	tmp := s.D.Id()
@@ -575,7 +576,7 @@ func (s *DatabaseMigrationConnectionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	response, err := s.Client.GetConnection(context.Background(), request)
+	response, err := s.Client.GetConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -584,11 +585,11 @@ func (s *DatabaseMigrationConnectionResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseMigrationConnectionResourceCrud) Update() error {
+func (s *DatabaseMigrationConnectionResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -694,30 +695,30 @@ func (s *DatabaseMigrationConnectionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	response, err := s.Client.UpdateConnection(context.Background(), request)
+	response, err := s.Client.UpdateConnection(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getConnectionFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration"), oci_database_migration.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getConnectionFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration"), oci_database_migration.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *DatabaseMigrationConnectionResourceCrud) Delete() error {
+func (s *DatabaseMigrationConnectionResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database_migration.DeleteConnectionRequest{}
 
 	tmp := s.D.Id()
 	request.ConnectionId = &tmp
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	response, err := s.Client.DeleteConnection(context.Background(), request)
+	response, err := s.Client.DeleteConnection(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := connectionWaitForWorkRequest(workId, "connection",
+	_, delWorkRequestErr := connectionWaitForWorkRequest(ctx, workId, "connection",
 		oci_database_migration.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -1260,7 +1261,7 @@ func VaultDetailsToMap(obj *oci_database_migration.VaultDetails) map[string]inte
 	return result
 }
 
-func (s *DatabaseMigrationConnectionResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DatabaseMigrationConnectionResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_database_migration.ChangeConnectionCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -1271,12 +1272,12 @@ func (s *DatabaseMigrationConnectionResourceCrud) updateCompartment(compartment
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	_, err := s.Client.ChangeConnectionCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeConnectionCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_migration "github.com/oracle/oci-go-sdk/v65/databasemigration"
 )
 
 func DatabaseMigrationJobAdvisorReportDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseMigrationJobAdvisorReport,
+		ReadContext: readSingularDatabaseMigrationJobAdvisorReport,
 		Schema: map[string]*schema.Schema{
 			"job_id": {
 				Type:     schema.TypeString,
@@ -88,12 +89,12 @@ func DatabaseMigrationJobAdvisorReportDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseMigrationJobAdvisorReport(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseMigrationJobAdvisorReport(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationJobAdvisorReportDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseMigrationJobAdvisorReportDataSourceCrud struct {
@@ -106,7 +107,7 @@ func (s *DatabaseMigrationJobAdvisorReportDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseMigrationJobAdvisorReportDataSourceCrud) Get() error {
+func (s *DatabaseMigrationJobAdvisorReportDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_migration.GetAdvisorReportRequest{}
 
 	if jobId, ok := s.D.GetOkExists("job_id"); ok {
@@ -116,7 +117,7 @@ func (s *DatabaseMigrationJobAdvisorReportDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_migration")
 
-	response, err := s.Client.GetAdvisorReport(context.Background(), request)
+	response, err := s.Client.GetAdvisorReport(ctx, request)
 	if err != nil {
 		return err
 	}
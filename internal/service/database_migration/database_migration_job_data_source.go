@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_migration "github.com/oracle/oci-go-sdk/v65/databasemigration"
 )
@@ -22,12 +23,12 @@ func DatabaseMigrationJobDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseMigrationJobResource(), fieldMap, readSingularDatabaseMigrationJob)
 }
 
-func readSingularDatabaseMigrationJob(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseMigrationJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationJobDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseMigrationJobDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DatabaseMigrationJobDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseMigrationJobDataSourceCrud) Get() error {
+func (s *DatabaseMigrationJobDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_migration.GetJobRequest{}
 
 	if jobId, ok := s.D.GetOkExists("job_id"); ok {
@@ -50,7 +51,7 @@ func (s *DatabaseMigrationJobDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_migration")
 
-	response, err := s.Client.GetJob(context.Background(), request)
+	response, err := s.Client.GetJob(ctx, request)
 	if err != nil {
 		return err
 	}
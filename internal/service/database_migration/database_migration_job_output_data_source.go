@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_migration "github.com/oracle/oci-go-sdk/v65/databasemigration"
 )
 
 func DatabaseMigrationJobOutputDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseMigrationJobOutput,
+		ReadContext: readSingularDatabaseMigrationJobOutput,
 		Schema: map[string]*schema.Schema{
 			"job_id": {
 				Type:     schema.TypeString,
@@ -43,12 +44,12 @@ func DatabaseMigrationJobOutputDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseMigrationJobOutput(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseMigrationJobOutput(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationJobOutputDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseMigrationJobOutputDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *DatabaseMigrationJobOutputDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseMigrationJobOutputDataSourceCrud) Get() error {
+func (s *DatabaseMigrationJobOutputDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_migration.ListJobOutputsRequest{}
 
 	if jobId, ok := s.D.GetOkExists("job_id"); ok {
@@ -71,7 +72,7 @@ func (s *DatabaseMigrationJobOutputDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_migration")
 
-	response, err := s.Client.ListJobOutputs(context.Background(), request)
+	response, err := s.Client.ListJobOutputs(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database_migration "github.com/oracle/oci-go-sdk/v65/databasemigration"
@@ -19,11 +20,11 @@ func DatabaseMigrationJobResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseMigrationJob,
-		Read:     readDatabaseMigrationJob,
-		Update:   updateDatabaseMigrationJob,
-		Delete:   deleteDatabaseMigrationJob,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseMigrationJob,
+		ReadContext:   readDatabaseMigrationJob,
+		UpdateContext: updateDatabaseMigrationJob,
+		DeleteContext: deleteDatabaseMigrationJob,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"job_id": {
@@ -211,37 +212,37 @@ func DatabaseMigrationJobResource() *schema.Resource {
 	}
 }
 
-func createDatabaseMigrationJob(d *schema.ResourceData, m interface{}) error {
+func createDatabaseMigrationJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationJobResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseMigrationJob(d *schema.ResourceData, m interface{}) error {
+func readDatabaseMigrationJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationJobResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatabaseMigrationJob(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseMigrationJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationJobResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatabaseMigrationJob(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseMigrationJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationJobResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabaseMigrationJobResourceCrud struct {
@@ -277,7 +278,7 @@ func (s *DatabaseMigrationJobResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DatabaseMigrationJobResourceCrud) Create() error {
+func (s *DatabaseMigrationJobResourceCrud) Create(ctx context.Context) error {
 	request := oci_database_migration.UpdateJobRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -302,7 +303,7 @@ func (s *DatabaseMigrationJobResourceCrud) Create() error {
 		request.JobId = &tmp
 	}
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
-	response, err := s.Client.UpdateJob(context.Background(), request)
+	response, err := s.Client.UpdateJob(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -311,7 +312,7 @@ func (s *DatabaseMigrationJobResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatabaseMigrationJobResourceCrud) Get() error {
+func (s *DatabaseMigrationJobResourceCrud) Get(ctx context.Context) error {
 	request := oci_database_migration.GetJobRequest{}
 
 	tmp := s.D.Id()
@@ -319,7 +320,7 @@ func (s *DatabaseMigrationJobResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	response, err := s.Client.GetJob(context.Background(), request)
+	response, err := s.Client.GetJob(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -328,7 +329,7 @@ func (s *DatabaseMigrationJobResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseMigrationJobResourceCrud) Update() error {
+func (s *DatabaseMigrationJobResourceCrud) Update(ctx context.Context) error {
 	request := oci_database_migration.UpdateJobRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -353,7 +354,7 @@ func (s *DatabaseMigrationJobResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	response, err := s.Client.UpdateJob(context.Background(), request)
+	response, err := s.Client.UpdateJob(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -362,7 +363,7 @@ func (s *DatabaseMigrationJobResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DatabaseMigrationJobResourceCrud) Delete() error {
+func (s *DatabaseMigrationJobResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database_migration.DeleteJobRequest{}
 
 	tmp := s.D.Id()
@@ -370,7 +371,7 @@ func (s *DatabaseMigrationJobResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	_, err := s.Client.DeleteJob(context.Background(), request)
+	_, err := s.Client.DeleteJob(ctx, request)
 	return err
 }
 
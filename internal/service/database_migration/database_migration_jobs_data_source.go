@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_migration "github.com/oracle/oci-go-sdk/v65/databasemigration"
 )
 
 func DatabaseMigrationJobsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseMigrationJobs,
+		ReadContext: readDatabaseMigrationJobs,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"display_name": {
@@ -48,12 +49,12 @@ func DatabaseMigrationJobsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseMigrationJobs(d *schema.ResourceData, m interface{}) error {
+func readDatabaseMigrationJobs(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationJobsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseMigrationJobsDataSourceCrud struct {
@@ -66,7 +67,7 @@ func (s *DatabaseMigrationJobsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseMigrationJobsDataSourceCrud) Get() error {
+func (s *DatabaseMigrationJobsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_migration.ListJobsRequest{}
 
 	if displayName, ok := s.D.GetOkExists("display_name"); ok {
@@ -85,7 +86,7 @@ func (s *DatabaseMigrationJobsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_migration")
 
-	response, err := s.Client.ListJobs(context.Background(), request)
+	response, err := s.Client.ListJobs(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *DatabaseMigrationJobsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListJobs(context.Background(), request)
+		listResponse, err := s.Client.ListJobs(ctx, request)
 		if err != nil {
 			return err
 		}
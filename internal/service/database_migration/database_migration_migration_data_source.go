@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_migration "github.com/oracle/oci-go-sdk/v65/databasemigration"
 )
@@ -22,12 +23,12 @@ func DatabaseMigrationMigrationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseMigrationMigrationResource(), fieldMap, readSingularDatabaseMigrationMigration)
 }
 
-func readSingularDatabaseMigrationMigration(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseMigrationMigration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationMigrationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseMigrationMigrationDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DatabaseMigrationMigrationDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseMigrationMigrationDataSourceCrud) Get() error {
+func (s *DatabaseMigrationMigrationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_migration.GetMigrationRequest{}
 
 	if migrationId, ok := s.D.GetOkExists("migration_id"); ok {
@@ -50,7 +51,7 @@ func (s *DatabaseMigrationMigrationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_migration")
 
-	response, err := s.Client.GetMigration(context.Background(), request)
+	response, err := s.Client.GetMigration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_migration "github.com/oracle/oci-go-sdk/v65/databasemigration"
 )
 
 func DatabaseMigrationMigrationObjectTypesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseMigrationMigrationObjectTypes,
+		ReadContext: readDatabaseMigrationMigrationObjectTypes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"migration_object_type_summary_collection": {
@@ -52,12 +53,12 @@ func DatabaseMigrationMigrationObjectTypesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseMigrationMigrationObjectTypes(d *schema.ResourceData, m interface{}) error {
+func readDatabaseMigrationMigrationObjectTypes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationMigrationObjectTypesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseMigrationMigrationObjectTypesDataSourceCrud struct {
@@ -70,12 +71,12 @@ func (s *DatabaseMigrationMigrationObjectTypesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseMigrationMigrationObjectTypesDataSourceCrud) Get() error {
+func (s *DatabaseMigrationMigrationObjectTypesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_migration.ListMigrationObjectTypesRequest{}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_migration")
 
-	response, err := s.Client.ListMigrationObjectTypes(context.Background(), request)
+	response, err := s.Client.ListMigrationObjectTypes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -84,7 +85,7 @@ func (s *DatabaseMigrationMigrationObjectTypesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListMigrationObjectTypes(context.Background(), request)
+		listResponse, err := s.Client.ListMigrationObjectTypes(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -15,6 +15,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -28,11 +29,11 @@ func DatabaseMigrationMigrationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseMigrationMigration,
-		Read:     readDatabaseMigrationMigration,
-		Update:   updateDatabaseMigrationMigration,
-		Delete:   deleteDatabaseMigrationMigration,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseMigrationMigration,
+		ReadContext:   readDatabaseMigrationMigration,
+		UpdateContext: updateDatabaseMigrationMigration,
+		DeleteContext: deleteDatabaseMigrationMigration,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -775,37 +776,37 @@ func excludeObjectsHashCodeForSets(v interface{}) int {
 	return utils.GetStringHashcode(buf.String())
 }
 
-func createDatabaseMigrationMigration(d *schema.ResourceData, m interface{}) error {
+func createDatabaseMigrationMigration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationMigrationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseMigrationMigration(d *schema.ResourceData, m interface{}) error {
+func readDatabaseMigrationMigration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationMigrationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatabaseMigrationMigration(d *schema.ResourceData, m interface{}) error {
+func updateDatabaseMigrationMigration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationMigrationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatabaseMigrationMigration(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseMigrationMigration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationMigrationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatabaseMigrationMigrationResourceCrud struct {
@@ -847,7 +848,7 @@ func (s *DatabaseMigrationMigrationResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DatabaseMigrationMigrationResourceCrud) Create() error {
+func (s *DatabaseMigrationMigrationResourceCrud) Create(ctx context.Context) error {
 	request := oci_database_migration.CreateMigrationRequest{}
 
 	if advisorSettings, ok := s.D.GetOkExists("advisor_settings"); ok {
@@ -999,20 +1000,20 @@ func (s *DatabaseMigrationMigrationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	response, err := s.Client.CreateMigration(context.Background(), request)
+	response, err := s.Client.CreateMigration(ctx, request)
 
 	if err != nil {
 		return err
 	}
 	workId := response.OpcWorkRequestId
-	return s.getMigrationFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration"), oci_database_migration.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getMigrationFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration"), oci_database_migration.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *DatabaseMigrationMigrationResourceCrud) getMigrationFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *DatabaseMigrationMigrationResourceCrud) getMigrationFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_database_migration.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	migrationId, err := migrationWaitForWorkRequest(workId, "migration",
+	migrationId, err := migrationWaitForWorkRequest(ctx, workId, "migration",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -1020,7 +1021,7 @@ func (s *DatabaseMigrationMigrationResourceCrud) getMigrationFromWorkRequest(wor
 	}
 	s.D.SetId(*migrationId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func migrationWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -1046,7 +1047,7 @@ func migrationWorkRequestShouldRetryFunc(timeout time.Duration) func(response oc
 	}
 }
 
-func migrationWaitForWorkRequest(wId *string, entityType string, action oci_database_migration.WorkRequestResourceActionTypeEnum,
+func migrationWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_database_migration.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_database_migration.DatabaseMigrationClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "database_migration")
 	retryPolicy.ShouldRetryOperation = migrationWorkRequestShouldRetryFunc(timeout)
@@ -1065,7 +1066,7 @@ func migrationWaitForWorkRequest(wId *string, entityType string, action oci_data
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_database_migration.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -1094,14 +1095,14 @@ func migrationWaitForWorkRequest(wId *string, entityType string, action oci_data
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_database_migration.OperationStatusFailed || response.Status == oci_database_migration.OperationStatusCanceled {
-		return nil, getErrorFromDatabaseMigrationMigrationWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDatabaseMigrationMigrationWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDatabaseMigrationMigrationWorkRequest(client *oci_database_migration.DatabaseMigrationClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_database_migration.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDatabaseMigrationMigrationWorkRequest(ctx context.Context, client *oci_database_migration.DatabaseMigrationClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_database_migration.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_database_migration.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -1123,7 +1124,7 @@ func getErrorFromDatabaseMigrationMigrationWorkRequest(client *oci_database_migr
 	return workRequestErr
 }
 
-func (s *DatabaseMigrationMigrationResourceCrud) Get() error {
+func (s *DatabaseMigrationMigrationResourceCrud) Get(ctx context.Context) error {
 	request := oci_database_migration.GetMigrationRequest{}
 
 	tmp := s.D.Id()
@@ -1131,7 +1132,7 @@ func (s *DatabaseMigrationMigrationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "migration")
 
-	response, err := s.Client.GetMigration(context.Background(), request)
+	response, err := s.Client.GetMigration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -1140,11 +1141,11 @@ func (s *DatabaseMigrationMigrationResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatabaseMigrationMigrationResourceCrud) Update() error {
+func (s *DatabaseMigrationMigrationResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -1299,29 +1300,29 @@ func (s *DatabaseMigrationMigrationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	response, err := s.Client.UpdateMigration(context.Background(), request)
+	response, err := s.Client.UpdateMigration(ctx, request)
 	if err != nil {
 		return err
 	}
 	workId := response.OpcWorkRequestId
-	return s.getMigrationFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration"), oci_database_migration.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getMigrationFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration"), oci_database_migration.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *DatabaseMigrationMigrationResourceCrud) Delete() error {
+func (s *DatabaseMigrationMigrationResourceCrud) Delete(ctx context.Context) error {
 	request := oci_database_migration.DeleteMigrationRequest{}
 
 	tmp := s.D.Id()
 	request.MigrationId = &tmp
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	response, err := s.Client.DeleteMigration(context.Background(), request)
+	response, err := s.Client.DeleteMigration(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := migrationWaitForWorkRequest(workId, "migration",
+	_, delWorkRequestErr := migrationWaitForWorkRequest(ctx, workId, "migration",
 		oci_database_migration.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 
 	return delWorkRequestErr
@@ -2907,7 +2908,7 @@ func metadataRemapsHashCodeForSets(v interface{}) int {
 	return utils.GetStringHashcode(buf.String())
 }
 
-func (s *DatabaseMigrationMigrationResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DatabaseMigrationMigrationResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_database_migration.ChangeMigrationCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -2918,12 +2919,12 @@ func (s *DatabaseMigrationMigrationResourceCrud) updateCompartment(compartment i
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_migration")
 
-	_, err := s.Client.ChangeMigrationCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeMigrationCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
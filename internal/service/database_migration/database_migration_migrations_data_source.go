@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_migration "github.com/oracle/oci-go-sdk/v65/databasemigration"
 )
 
 func DatabaseMigrationMigrationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseMigrationMigrations,
+		ReadContext: readDatabaseMigrationMigrations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -52,12 +53,12 @@ func DatabaseMigrationMigrationsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseMigrationMigrations(d *schema.ResourceData, m interface{}) error {
+func readDatabaseMigrationMigrations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationMigrationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseMigrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseMigrationMigrationsDataSourceCrud struct {
@@ -70,7 +71,7 @@ func (s *DatabaseMigrationMigrationsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseMigrationMigrationsDataSourceCrud) Get() error {
+func (s *DatabaseMigrationMigrationsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_migration.ListMigrationsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -93,7 +94,7 @@ func (s *DatabaseMigrationMigrationsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_migration")
 
-	response, err := s.Client.ListMigrations(context.Background(), request)
+	response, err := s.Client.ListMigrations(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -102,7 +103,7 @@ func (s *DatabaseMigrationMigrationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListMigrations(context.Background(), request)
+		listResponse, err := s.Client.ListMigrations(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
@@ -17,10 +18,10 @@ import (
 
 func DatabaseMigrationResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatabaseMigration,
-		Read:     readDatabaseMigration,
-		Delete:   deleteDatabaseMigration,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatabaseMigration,
+		ReadContext:   readDatabaseMigration,
+		DeleteContext: deleteDatabaseMigration,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"db_system_id": {
@@ -69,20 +70,20 @@ func DatabaseMigrationResource() *schema.Resource {
 	}
 }
 
-func createDatabaseMigration(d *schema.ResourceData, m interface{}) error {
+func createDatabaseMigration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseMigrationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatabaseMigration(d *schema.ResourceData, m interface{}) error {
+func readDatabaseMigration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteDatabaseMigration(d *schema.ResourceData, m interface{}) error {
+func deleteDatabaseMigration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -98,7 +99,7 @@ func (s *DatabaseMigrationResourceCrud) ID() string {
 	return *s.Res.DbSystemId
 }
 
-func (s *DatabaseMigrationResourceCrud) Create() error {
+func (s *DatabaseMigrationResourceCrud) Create(ctx context.Context) error {
 	request := oci_database.MigrateExadataDbSystemResourceModelRequest{}
 
 	if dbSystemId, ok := s.D.GetOkExists("db_system_id"); ok {
@@ -108,7 +109,7 @@ func (s *DatabaseMigrationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
-	response, err := s.Client.MigrateExadataDbSystemResourceModel(context.Background(), request)
+	response, err := s.Client.MigrateExadataDbSystemResourceModel(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_tools "github.com/oracle/oci-go-sdk/v65/databasetools"
 )
@@ -23,12 +24,12 @@ func DatabaseToolsDatabaseToolsConnectionDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatabaseToolsDatabaseToolsConnectionResource(), fieldMap, readSingularDatabaseToolsDatabaseToolsConnection)
 }
 
-func readSingularDatabaseToolsDatabaseToolsConnection(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseToolsDatabaseToolsConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseToolsDatabaseToolsConnectionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseToolsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseToolsDatabaseToolsConnectionDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *DatabaseToolsDatabaseToolsConnectionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseToolsDatabaseToolsConnectionDataSourceCrud) Get() error {
+func (s *DatabaseToolsDatabaseToolsConnectionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_tools.GetDatabaseToolsConnectionRequest{}
 
 	if databaseToolsConnectionId, ok := s.D.GetOkExists("database_tools_connection_id"); ok {
@@ -51,7 +52,7 @@ func (s *DatabaseToolsDatabaseToolsConnectionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_tools")
 
-	response, err := s.Client.GetDatabaseToolsConnection(context.Background(), request)
+	response, err := s.Client.GetDatabaseToolsConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_tools "github.com/oracle/oci-go-sdk/v65/databasetools"
 )
 
 func DatabaseToolsDatabaseToolsEndpointServiceDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatabaseToolsDatabaseToolsEndpointService,
+		ReadContext: readSingularDatabaseToolsDatabaseToolsEndpointService,
 		Schema: map[string]*schema.Schema{
 			"database_tools_endpoint_service_id": {
 				Type:     schema.TypeString,
@@ -73,12 +74,12 @@ func DatabaseToolsDatabaseToolsEndpointServiceDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatabaseToolsDatabaseToolsEndpointService(d *schema.ResourceData, m interface{}) error {
+func readSingularDatabaseToolsDatabaseToolsEndpointService(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseToolsDatabaseToolsEndpointServiceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseToolsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseToolsDatabaseToolsEndpointServiceDataSourceCrud struct {
@@ -91,7 +92,7 @@ func (s *DatabaseToolsDatabaseToolsEndpointServiceDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseToolsDatabaseToolsEndpointServiceDataSourceCrud) Get() error {
+func (s *DatabaseToolsDatabaseToolsEndpointServiceDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_tools.GetDatabaseToolsEndpointServiceRequest{}
 
 	if databaseToolsEndpointServiceId, ok := s.D.GetOkExists("database_tools_endpoint_service_id"); ok {
@@ -101,7 +102,7 @@ func (s *DatabaseToolsDatabaseToolsEndpointServiceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_tools")
 
-	response, err := s.Client.GetDatabaseToolsEndpointService(context.Background(), request)
+	response, err := s.Client.GetDatabaseToolsEndpointService(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_tools "github.com/oracle/oci-go-sdk/v65/databasetools"
 )
 
 func DatabaseToolsDatabaseToolsEndpointServicesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseToolsDatabaseToolsEndpointServices,
+		ReadContext: readDatabaseToolsDatabaseToolsEndpointServices,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -110,12 +111,12 @@ func DatabaseToolsDatabaseToolsEndpointServicesDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseToolsDatabaseToolsEndpointServices(d *schema.ResourceData, m interface{}) error {
+func readDatabaseToolsDatabaseToolsEndpointServices(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseToolsDatabaseToolsEndpointServicesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseToolsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseToolsDatabaseToolsEndpointServicesDataSourceCrud struct {
@@ -128,7 +129,7 @@ func (s *DatabaseToolsDatabaseToolsEndpointServicesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseToolsDatabaseToolsEndpointServicesDataSourceCrud) Get() error {
+func (s *DatabaseToolsDatabaseToolsEndpointServicesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_tools.ListDatabaseToolsEndpointServicesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -152,7 +153,7 @@ func (s *DatabaseToolsDatabaseToolsEndpointServicesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_tools")
 
-	response, err := s.Client.ListDatabaseToolsEndpointServices(context.Background(), request)
+	response, err := s.Client.ListDatabaseToolsEndpointServices(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -161,7 +162,7 @@ func (s *DatabaseToolsDatabaseToolsEndpointServicesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDatabaseToolsEndpointServices(context.Background(), request)
+		listResponse, err := s.Client.ListDatabaseToolsEndpointServices(ctx, request)
 		if err != nil {
 			return err
 		}
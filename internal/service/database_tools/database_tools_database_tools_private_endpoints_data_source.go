@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_database_tools "github.com/oracle/oci-go-sdk/v65/databasetools"
 )
 
 func DatabaseToolsDatabaseToolsPrivateEndpointsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatabaseToolsDatabaseToolsPrivateEndpoints,
+		ReadContext: readDatabaseToolsDatabaseToolsPrivateEndpoints,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -56,12 +57,12 @@ func DatabaseToolsDatabaseToolsPrivateEndpointsDataSource() *schema.Resource {
 	}
 }
 
-func readDatabaseToolsDatabaseToolsPrivateEndpoints(d *schema.ResourceData, m interface{}) error {
+func readDatabaseToolsDatabaseToolsPrivateEndpoints(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatabaseToolsDatabaseToolsPrivateEndpointsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DatabaseToolsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatabaseToolsDatabaseToolsPrivateEndpointsDataSourceCrud struct {
@@ -74,7 +75,7 @@ func (s *DatabaseToolsDatabaseToolsPrivateEndpointsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatabaseToolsDatabaseToolsPrivateEndpointsDataSourceCrud) Get() error {
+func (s *DatabaseToolsDatabaseToolsPrivateEndpointsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_database_tools.ListDatabaseToolsPrivateEndpointsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -103,7 +104,7 @@ func (s *DatabaseToolsDatabaseToolsPrivateEndpointsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database_tools")
 
-	response, err := s.Client.ListDatabaseToolsPrivateEndpoints(context.Background(), request)
+	response, err := s.Client.ListDatabaseToolsPrivateEndpoints(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +113,7 @@ func (s *DatabaseToolsDatabaseToolsPrivateEndpointsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDatabaseToolsPrivateEndpoints(context.Background(), request)
+		listResponse, err := s.Client.ListDatabaseToolsPrivateEndpoints(ctx, request)
 		if err != nil {
 			return err
 		}
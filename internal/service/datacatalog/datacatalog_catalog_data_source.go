@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_datacatalog "github.com/oracle/oci-go-sdk/v65/datacatalog"
 )
@@ -22,12 +23,12 @@ func DatacatalogCatalogDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatacatalogCatalogResource(), fieldMap, readSingularDatacatalogCatalog)
 }
 
-func readSingularDatacatalogCatalog(d *schema.ResourceData, m interface{}) error {
+func readSingularDatacatalogCatalog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogCatalogDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatacatalogCatalogDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DatacatalogCatalogDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatacatalogCatalogDataSourceCrud) Get() error {
+func (s *DatacatalogCatalogDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_datacatalog.GetCatalogRequest{}
 
 	if catalogId, ok := s.D.GetOkExists("catalog_id"); ok {
@@ -50,7 +51,7 @@ func (s *DatacatalogCatalogDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datacatalog")
 
-	response, err := s.Client.GetCatalog(context.Background(), request)
+	response, err := s.Client.GetCatalog(ctx, request)
 	if err != nil {
 		return err
 	}
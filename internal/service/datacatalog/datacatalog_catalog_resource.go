@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,11 +25,11 @@ func DatacatalogCatalogResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatacatalogCatalog,
-		Read:     readDatacatalogCatalog,
-		Update:   updateDatacatalogCatalog,
-		Delete:   deleteDatacatalogCatalog,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatacatalogCatalog,
+		ReadContext:   readDatacatalogCatalog,
+		UpdateContext: updateDatacatalogCatalog,
+		DeleteContext: deleteDatacatalogCatalog,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -98,37 +99,37 @@ func DatacatalogCatalogResource() *schema.Resource {
 	}
 }
 
-func createDatacatalogCatalog(d *schema.ResourceData, m interface{}) error {
+func createDatacatalogCatalog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogCatalogResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatacatalogCatalog(d *schema.ResourceData, m interface{}) error {
+func readDatacatalogCatalog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogCatalogResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatacatalogCatalog(d *schema.ResourceData, m interface{}) error {
+func updateDatacatalogCatalog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogCatalogResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatacatalogCatalog(d *schema.ResourceData, m interface{}) error {
+func deleteDatacatalogCatalog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogCatalogResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatacatalogCatalogResourceCrud struct {
@@ -166,7 +167,7 @@ func (s *DatacatalogCatalogResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DatacatalogCatalogResourceCrud) Create() error {
+func (s *DatacatalogCatalogResourceCrud) Create(ctx context.Context) error {
 	request := oci_datacatalog.CreateCatalogRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -193,13 +194,13 @@ func (s *DatacatalogCatalogResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.CreateCatalog(context.Background(), request)
+	response, err := s.Client.CreateCatalog(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	err = s.getCatalogFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog"), oci_datacatalog.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	err = s.getCatalogFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog"), oci_datacatalog.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -214,7 +215,7 @@ func (s *DatacatalogCatalogResourceCrud) Create() error {
 			}
 		}
 		if len(tmp) != 0 || s.D.HasChange("attached_catalog_private_endpoints") {
-			err := s.attachCatalogPrivateEndpoints(tmp)
+			err := s.attachCatalogPrivateEndpoints(ctx, tmp)
 			if err != nil {
 				// store the state when datacatalog is created but attach fail
 				if setDataErr := s.SetData(); setDataErr != nil {
@@ -228,11 +229,11 @@ func (s *DatacatalogCatalogResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatacatalogCatalogResourceCrud) getCatalogFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *DatacatalogCatalogResourceCrud) getCatalogFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_datacatalog.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	catalogId, err := catalogWaitForWorkRequest(workId, "catalog",
+	catalogId, err := catalogWaitForWorkRequest(ctx, workId, "catalog",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -240,7 +241,7 @@ func (s *DatacatalogCatalogResourceCrud) getCatalogFromWorkRequest(workId *strin
 	}
 	s.D.SetId(*catalogId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func catalogWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -266,7 +267,7 @@ func catalogWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_
 	}
 }
 
-func catalogWaitForWorkRequest(wId *string, entityType string, action oci_datacatalog.WorkRequestResourceActionTypeEnum,
+func catalogWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_datacatalog.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_datacatalog.DataCatalogClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "datacatalog")
 	retryPolicy.ShouldRetryOperation = catalogWorkRequestShouldRetryFunc(timeout)
@@ -285,7 +286,7 @@ func catalogWaitForWorkRequest(wId *string, entityType string, action oci_dataca
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_datacatalog.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -314,14 +315,14 @@ func catalogWaitForWorkRequest(wId *string, entityType string, action oci_dataca
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_datacatalog.WorkRequestStatusFailed || response.Status == oci_datacatalog.WorkRequestStatusCanceled {
-		return nil, getErrorFromDatacatalogCatalogWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDatacatalogCatalogWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDatacatalogCatalogWorkRequest(client *oci_datacatalog.DataCatalogClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_datacatalog.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDatacatalogCatalogWorkRequest(ctx context.Context, client *oci_datacatalog.DataCatalogClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_datacatalog.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_datacatalog.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -343,7 +344,7 @@ func getErrorFromDatacatalogCatalogWorkRequest(client *oci_datacatalog.DataCatal
 	return workRequestErr
 }
 
-func (s *DatacatalogCatalogResourceCrud) Get() error {
+func (s *DatacatalogCatalogResourceCrud) Get(ctx context.Context) error {
 	request := oci_datacatalog.GetCatalogRequest{}
 
 	tmp := s.D.Id()
@@ -351,7 +352,7 @@ func (s *DatacatalogCatalogResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.GetCatalog(context.Background(), request)
+	response, err := s.Client.GetCatalog(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -360,11 +361,11 @@ func (s *DatacatalogCatalogResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatacatalogCatalogResourceCrud) Update() error {
+func (s *DatacatalogCatalogResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -392,7 +393,7 @@ func (s *DatacatalogCatalogResourceCrud) Update() error {
 					tmp[i] = newPrivateEndpointsToAttach[i].(string)
 				}
 			}
-			err := s.attachCatalogPrivateEndpoints(tmp)
+			err := s.attachCatalogPrivateEndpoints(ctx, tmp)
 			if err != nil {
 				return err
 			}
@@ -405,7 +406,7 @@ func (s *DatacatalogCatalogResourceCrud) Update() error {
 					tmp[i] = oldPrivateEndpointsToDetach[i].(string)
 				}
 			}
-			err := s.detachCatalogPrivateEndpoints(tmp)
+			err := s.detachCatalogPrivateEndpoints(ctx, tmp)
 			if err != nil {
 				return err
 			}
@@ -436,7 +437,7 @@ func (s *DatacatalogCatalogResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.UpdateCatalog(context.Background(), request)
+	response, err := s.Client.UpdateCatalog(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -445,7 +446,7 @@ func (s *DatacatalogCatalogResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DatacatalogCatalogResourceCrud) Delete() error {
+func (s *DatacatalogCatalogResourceCrud) Delete(ctx context.Context) error {
 	request := oci_datacatalog.DeleteCatalogRequest{}
 
 	tmp := s.D.Id()
@@ -464,21 +465,21 @@ func (s *DatacatalogCatalogResourceCrud) Delete() error {
 			}
 		}
 		if len(tmp) != 0 {
-			err := s.detachCatalogPrivateEndpoints(tmp)
+			err := s.detachCatalogPrivateEndpoints(ctx, tmp)
 			if err != nil {
 				return err
 			}
 		}
 	}
 
-	response, err := s.Client.DeleteCatalog(context.Background(), request)
+	response, err := s.Client.DeleteCatalog(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := catalogWaitForWorkRequest(workId, "catalog",
+	_, delWorkRequestErr := catalogWaitForWorkRequest(ctx, workId, "catalog",
 		oci_datacatalog.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -533,7 +534,7 @@ func (s *DatacatalogCatalogResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *DatacatalogCatalogResourceCrud) attachCatalogPrivateEndpoints(attachCatalogPrivateEndpoints []string) error {
+func (s *DatacatalogCatalogResourceCrud) attachCatalogPrivateEndpoints(ctx context.Context, attachCatalogPrivateEndpoints []string) error {
 	for _, attachCatalogPrivateEndpoint := range attachCatalogPrivateEndpoints {
 		catalogId := s.D.Id()
 		request := oci_datacatalog.AttachCatalogPrivateEndpointRequest{}
@@ -541,20 +542,20 @@ func (s *DatacatalogCatalogResourceCrud) attachCatalogPrivateEndpoints(attachCat
 		request.CatalogId = &catalogId
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-		response, err := s.Client.AttachCatalogPrivateEndpoint(context.Background(), request)
+		response, err := s.Client.AttachCatalogPrivateEndpoint(ctx, request)
 		if err != nil {
 			return err
 		}
 		workId := response.OpcWorkRequestId
 
 		// Wait until Update finishes
-		_, err = catalogWaitForWorkRequest(workId, "catalog",
+		_, err = catalogWaitForWorkRequest(ctx, workId, "catalog",
 			oci_datacatalog.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 	}
 	return nil
 }
 
-func (s *DatacatalogCatalogResourceCrud) detachCatalogPrivateEndpoints(detachCatalogPrivateEndpoints []string) error {
+func (s *DatacatalogCatalogResourceCrud) detachCatalogPrivateEndpoints(ctx context.Context, detachCatalogPrivateEndpoints []string) error {
 	for _, detachCatalogPrivateEndpoint := range detachCatalogPrivateEndpoints {
 		catalogId := s.D.Id()
 		request := oci_datacatalog.DetachCatalogPrivateEndpointRequest{}
@@ -562,20 +563,20 @@ func (s *DatacatalogCatalogResourceCrud) detachCatalogPrivateEndpoints(detachCat
 		request.CatalogId = &catalogId
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-		response, err := s.Client.DetachCatalogPrivateEndpoint(context.Background(), request)
+		response, err := s.Client.DetachCatalogPrivateEndpoint(ctx, request)
 		if err != nil {
 			return err
 		}
 		workId := response.OpcWorkRequestId
 
 		// Wait until it finishes
-		_, err = catalogWaitForWorkRequest(workId, "catalog",
+		_, err = catalogWaitForWorkRequest(ctx, workId, "catalog",
 			oci_datacatalog.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 	}
 	return nil
 }
 
-func (s *DatacatalogCatalogResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DatacatalogCatalogResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_datacatalog.ChangeCatalogCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -586,11 +587,11 @@ func (s *DatacatalogCatalogResourceCrud) updateCompartment(compartment interface
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.ChangeCatalogCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeCatalogCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getCatalogFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "catalog"), oci_datacatalog.WorkRequestResourceActionTypeMoved, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getCatalogFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "catalog"), oci_datacatalog.WorkRequestResourceActionTypeMoved, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -10,13 +10,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_datacatalog "github.com/oracle/oci-go-sdk/v65/datacatalog"
 )
 
 func DatacatalogCatalogTypeDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDatacatalogCatalogType,
+		ReadContext: readSingularDatacatalogCatalogType,
 		Schema: map[string]*schema.Schema{
 			"catalog_id": {
 				Type:     schema.TypeString,
@@ -84,12 +85,12 @@ func DatacatalogCatalogTypeDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDatacatalogCatalogType(d *schema.ResourceData, m interface{}) error {
+func readSingularDatacatalogCatalogType(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogCatalogTypeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatacatalogCatalogTypeDataSourceCrud struct {
@@ -102,7 +103,7 @@ func (s *DatacatalogCatalogTypeDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatacatalogCatalogTypeDataSourceCrud) Get() error {
+func (s *DatacatalogCatalogTypeDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_datacatalog.GetTypeRequest{}
 
 	if catalogId, ok := s.D.GetOkExists("catalog_id"); ok {
@@ -131,7 +132,7 @@ func (s *DatacatalogCatalogTypeDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datacatalog")
 
-	response, err := s.Client.GetType(context.Background(), request)
+	response, err := s.Client.GetType(ctx, request)
 	if err != nil {
 		return err
 	}
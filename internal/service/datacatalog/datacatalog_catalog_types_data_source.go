@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_datacatalog "github.com/oracle/oci-go-sdk/v65/datacatalog"
 )
 
 func DatacatalogCatalogTypesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatacatalogCatalogTypes,
+		ReadContext: readDatacatalogCatalogTypes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"catalog_id": {
@@ -110,12 +111,12 @@ func DatacatalogCatalogTypesDataSource() *schema.Resource {
 	}
 }
 
-func readDatacatalogCatalogTypes(d *schema.ResourceData, m interface{}) error {
+func readDatacatalogCatalogTypes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogCatalogTypesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatacatalogCatalogTypesDataSourceCrud struct {
@@ -128,7 +129,7 @@ func (s *DatacatalogCatalogTypesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatacatalogCatalogTypesDataSourceCrud) Get() error {
+func (s *DatacatalogCatalogTypesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_datacatalog.ListTypesRequest{}
 
 	if catalogId, ok := s.D.GetOkExists("catalog_id"); ok {
@@ -186,7 +187,7 @@ func (s *DatacatalogCatalogTypesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datacatalog")
 
-	response, err := s.Client.ListTypes(context.Background(), request)
+	response, err := s.Client.ListTypes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -195,7 +196,7 @@ func (s *DatacatalogCatalogTypesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListTypes(context.Background(), request)
+		listResponse, err := s.Client.ListTypes(ctx, request)
 		if err != nil {
 			return err
 		}
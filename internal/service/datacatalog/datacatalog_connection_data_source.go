@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_datacatalog "github.com/oracle/oci-go-sdk/v65/datacatalog"
 )
@@ -38,12 +39,12 @@ func DatacatalogConnectionDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatacatalogConnectionResource(), fieldMap, readSingularDatacatalogConnection)
 }
 
-func readSingularDatacatalogConnection(d *schema.ResourceData, m interface{}) error {
+func readSingularDatacatalogConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogConnectionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatacatalogConnectionDataSourceCrud struct {
@@ -56,7 +57,7 @@ func (s *DatacatalogConnectionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatacatalogConnectionDataSourceCrud) Get() error {
+func (s *DatacatalogConnectionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_datacatalog.GetConnectionRequest{}
 
 	if catalogId, ok := s.D.GetOkExists("catalog_id"); ok {
@@ -90,7 +91,7 @@ func (s *DatacatalogConnectionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datacatalog")
 
-	response, err := s.Client.GetConnection(context.Background(), request)
+	response, err := s.Client.GetConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_datacatalog "github.com/oracle/oci-go-sdk/v65/datacatalog"
@@ -24,11 +25,11 @@ func DatacatalogConnectionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatacatalogConnection,
-		Read:     readDatacatalogConnection,
-		Update:   updateDatacatalogConnection,
-		Delete:   deleteDatacatalogConnection,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatacatalogConnection,
+		ReadContext:   readDatacatalogConnection,
+		UpdateContext: updateDatacatalogConnection,
+		DeleteContext: deleteDatacatalogConnection,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"catalog_id": {
@@ -117,37 +118,37 @@ func DatacatalogConnectionResource() *schema.Resource {
 	}
 }
 
-func createDatacatalogConnection(d *schema.ResourceData, m interface{}) error {
+func createDatacatalogConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatacatalogConnection(d *schema.ResourceData, m interface{}) error {
+func readDatacatalogConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatacatalogConnection(d *schema.ResourceData, m interface{}) error {
+func updateDatacatalogConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatacatalogConnection(d *schema.ResourceData, m interface{}) error {
+func deleteDatacatalogConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatacatalogConnectionResourceCrud struct {
@@ -185,7 +186,7 @@ func (s *DatacatalogConnectionResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DatacatalogConnectionResourceCrud) Create() error {
+func (s *DatacatalogConnectionResourceCrud) Create(ctx context.Context) error {
 	request := oci_datacatalog.CreateConnectionRequest{}
 
 	if catalogId, ok := s.D.GetOkExists("catalog_id"); ok {
@@ -236,7 +237,7 @@ func (s *DatacatalogConnectionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.CreateConnection(context.Background(), request)
+	response, err := s.Client.CreateConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -245,7 +246,7 @@ func (s *DatacatalogConnectionResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatacatalogConnectionResourceCrud) Get() error {
+func (s *DatacatalogConnectionResourceCrud) Get(ctx context.Context) error {
 	request := oci_datacatalog.GetConnectionRequest{}
 
 	tmp := s.D.Id()
@@ -285,7 +286,7 @@ func (s *DatacatalogConnectionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.GetConnection(context.Background(), request)
+	response, err := s.Client.GetConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -294,7 +295,7 @@ func (s *DatacatalogConnectionResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatacatalogConnectionResourceCrud) Update() error {
+func (s *DatacatalogConnectionResourceCrud) Update(ctx context.Context) error {
 	request := oci_datacatalog.UpdateConnectionRequest{}
 
 	tmp := s.D.Id()
@@ -343,7 +344,7 @@ func (s *DatacatalogConnectionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.UpdateConnection(context.Background(), request)
+	response, err := s.Client.UpdateConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -352,7 +353,7 @@ func (s *DatacatalogConnectionResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DatacatalogConnectionResourceCrud) Delete() error {
+func (s *DatacatalogConnectionResourceCrud) Delete(ctx context.Context) error {
 	request := oci_datacatalog.DeleteConnectionRequest{}
 
 	tmp := s.D.Id()
@@ -375,7 +376,7 @@ func (s *DatacatalogConnectionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	_, err := s.Client.DeleteConnection(context.Background(), request)
+	_, err := s.Client.DeleteConnection(ctx, request)
 	return err
 }
 
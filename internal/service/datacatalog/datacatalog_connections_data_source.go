@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_datacatalog "github.com/oracle/oci-go-sdk/v65/datacatalog"
@@ -17,7 +18,7 @@ import (
 
 func DatacatalogConnectionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatacatalogConnections,
+		ReadContext: readDatacatalogConnections,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"catalog_id": {
@@ -99,12 +100,12 @@ func DatacatalogConnectionsDataSource() *schema.Resource {
 	}
 }
 
-func readDatacatalogConnections(d *schema.ResourceData, m interface{}) error {
+func readDatacatalogConnections(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogConnectionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatacatalogConnectionsDataSourceCrud struct {
@@ -117,7 +118,7 @@ func (s *DatacatalogConnectionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatacatalogConnectionsDataSourceCrud) Get() error {
+func (s *DatacatalogConnectionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_datacatalog.ListConnectionsRequest{}
 
 	if catalogId, ok := s.D.GetOkExists("catalog_id"); ok {
@@ -204,7 +205,7 @@ func (s *DatacatalogConnectionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datacatalog")
 
-	response, err := s.Client.ListConnections(context.Background(), request)
+	response, err := s.Client.ListConnections(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -213,7 +214,7 @@ func (s *DatacatalogConnectionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListConnections(context.Background(), request)
+		listResponse, err := s.Client.ListConnections(ctx, request)
 		if err != nil {
 			return err
 		}
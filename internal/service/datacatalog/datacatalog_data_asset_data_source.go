@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_datacatalog "github.com/oracle/oci-go-sdk/v65/datacatalog"
 )
@@ -34,12 +35,12 @@ func DatacatalogDataAssetDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatacatalogDataAssetResource(), fieldMap, readSingularDatacatalogDataAsset)
 }
 
-func readSingularDatacatalogDataAsset(d *schema.ResourceData, m interface{}) error {
+func readSingularDatacatalogDataAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogDataAssetDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatacatalogDataAssetDataSourceCrud struct {
@@ -52,7 +53,7 @@ func (s *DatacatalogDataAssetDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatacatalogDataAssetDataSourceCrud) Get() error {
+func (s *DatacatalogDataAssetDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_datacatalog.GetDataAssetRequest{}
 
 	if catalogId, ok := s.D.GetOkExists("catalog_id"); ok {
@@ -81,7 +82,7 @@ func (s *DatacatalogDataAssetDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datacatalog")
 
-	response, err := s.Client.GetDataAsset(context.Background(), request)
+	response, err := s.Client.GetDataAsset(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_datacatalog "github.com/oracle/oci-go-sdk/v65/datacatalog"
@@ -24,11 +25,11 @@ func DatacatalogDataAssetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatacatalogDataAsset,
-		Read:     readDatacatalogDataAsset,
-		Update:   updateDatacatalogDataAsset,
-		Delete:   deleteDatacatalogDataAsset,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatacatalogDataAsset,
+		ReadContext:   readDatacatalogDataAsset,
+		UpdateContext: updateDatacatalogDataAsset,
+		DeleteContext: deleteDatacatalogDataAsset,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"catalog_id": {
@@ -102,37 +103,37 @@ func DatacatalogDataAssetResource() *schema.Resource {
 	}
 }
 
-func createDatacatalogDataAsset(d *schema.ResourceData, m interface{}) error {
+func createDatacatalogDataAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogDataAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatacatalogDataAsset(d *schema.ResourceData, m interface{}) error {
+func readDatacatalogDataAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogDataAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatacatalogDataAsset(d *schema.ResourceData, m interface{}) error {
+func updateDatacatalogDataAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogDataAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatacatalogDataAsset(d *schema.ResourceData, m interface{}) error {
+func deleteDatacatalogDataAsset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogDataAssetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatacatalogDataAssetResourceCrud struct {
@@ -170,7 +171,7 @@ func (s *DatacatalogDataAssetResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DatacatalogDataAssetResourceCrud) Create() error {
+func (s *DatacatalogDataAssetResourceCrud) Create(ctx context.Context) error {
 	request := oci_datacatalog.CreateDataAssetRequest{}
 
 	if catalogId, ok := s.D.GetOkExists("catalog_id"); ok {
@@ -203,7 +204,7 @@ func (s *DatacatalogDataAssetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.CreateDataAsset(context.Background(), request)
+	response, err := s.Client.CreateDataAsset(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -212,7 +213,7 @@ func (s *DatacatalogDataAssetResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatacatalogDataAssetResourceCrud) Get() error {
+func (s *DatacatalogDataAssetResourceCrud) Get(ctx context.Context) error {
 	request := oci_datacatalog.GetDataAssetRequest{}
 
 	tmp := s.D.Id()
@@ -246,7 +247,7 @@ func (s *DatacatalogDataAssetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.GetDataAsset(context.Background(), request)
+	response, err := s.Client.GetDataAsset(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -255,7 +256,7 @@ func (s *DatacatalogDataAssetResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatacatalogDataAssetResourceCrud) Update() error {
+func (s *DatacatalogDataAssetResourceCrud) Update(ctx context.Context) error {
 	request := oci_datacatalog.UpdateDataAssetRequest{}
 
 	if catalogId, ok := s.D.GetOkExists("catalog_id"); ok {
@@ -286,7 +287,7 @@ func (s *DatacatalogDataAssetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.UpdateDataAsset(context.Background(), request)
+	response, err := s.Client.UpdateDataAsset(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -295,7 +296,7 @@ func (s *DatacatalogDataAssetResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DatacatalogDataAssetResourceCrud) Delete() error {
+func (s *DatacatalogDataAssetResourceCrud) Delete(ctx context.Context) error {
 	request := oci_datacatalog.DeleteDataAssetRequest{}
 
 	if catalogId, ok := s.D.GetOkExists("catalog_id"); ok {
@@ -310,7 +311,7 @@ func (s *DatacatalogDataAssetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	_, err := s.Client.DeleteDataAsset(context.Background(), request)
+	_, err := s.Client.DeleteDataAsset(ctx, request)
 	return err
 }
 
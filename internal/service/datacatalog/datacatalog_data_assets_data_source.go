@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_datacatalog "github.com/oracle/oci-go-sdk/v65/datacatalog"
@@ -17,7 +18,7 @@ import (
 
 func DatacatalogDataAssetsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatacatalogDataAssets,
+		ReadContext: readDatacatalogDataAssets,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"catalog_id": {
@@ -79,12 +80,12 @@ func DatacatalogDataAssetsDataSource() *schema.Resource {
 	}
 }
 
-func readDatacatalogDataAssets(d *schema.ResourceData, m interface{}) error {
+func readDatacatalogDataAssets(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogDataAssetsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatacatalogDataAssetsDataSourceCrud struct {
@@ -97,7 +98,7 @@ func (s *DatacatalogDataAssetsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatacatalogDataAssetsDataSourceCrud) Get() error {
+func (s *DatacatalogDataAssetsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_datacatalog.ListDataAssetsRequest{}
 
 	if catalogId, ok := s.D.GetOkExists("catalog_id"); ok {
@@ -171,7 +172,7 @@ func (s *DatacatalogDataAssetsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datacatalog")
 
-	response, err := s.Client.ListDataAssets(context.Background(), request)
+	response, err := s.Client.ListDataAssets(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -180,7 +181,7 @@ func (s *DatacatalogDataAssetsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDataAssets(context.Background(), request)
+		listResponse, err := s.Client.ListDataAssets(ctx, request)
 		if err != nil {
 			return err
 		}
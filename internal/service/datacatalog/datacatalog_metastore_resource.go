@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,11 +25,11 @@ func DatacatalogMetastoreResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatacatalogMetastore,
-		Read:     readDatacatalogMetastore,
-		Update:   updateDatacatalogMetastore,
-		Delete:   deleteDatacatalogMetastore,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatacatalogMetastore,
+		ReadContext:   readDatacatalogMetastore,
+		UpdateContext: updateDatacatalogMetastore,
+		DeleteContext: deleteDatacatalogMetastore,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -87,37 +88,37 @@ func DatacatalogMetastoreResource() *schema.Resource {
 	}
 }
 
-func createDatacatalogMetastore(d *schema.ResourceData, m interface{}) error {
+func createDatacatalogMetastore(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogMetastoreResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatacatalogMetastore(d *schema.ResourceData, m interface{}) error {
+func readDatacatalogMetastore(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogMetastoreResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatacatalogMetastore(d *schema.ResourceData, m interface{}) error {
+func updateDatacatalogMetastore(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogMetastoreResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatacatalogMetastore(d *schema.ResourceData, m interface{}) error {
+func deleteDatacatalogMetastore(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatacatalogMetastoreResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataCatalogClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatacatalogMetastoreResourceCrud struct {
@@ -155,7 +156,7 @@ func (s *DatacatalogMetastoreResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DatacatalogMetastoreResourceCrud) Create() error {
+func (s *DatacatalogMetastoreResourceCrud) Create(ctx context.Context) error {
 	request := oci_datacatalog.CreateMetastoreRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -192,24 +193,24 @@ func (s *DatacatalogMetastoreResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.CreateMetastore(context.Background(), request)
+	response, err := s.Client.CreateMetastore(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	err = s.getMetastoreFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog"), oci_datacatalog.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	err = s.getMetastoreFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog"), oci_datacatalog.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (s *DatacatalogMetastoreResourceCrud) getMetastoreFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *DatacatalogMetastoreResourceCrud) getMetastoreFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_datacatalog.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	catalogId, err := catalogMetastoreWaitForWorkRequest(workId, "metastore",
+	catalogId, err := catalogMetastoreWaitForWorkRequest(ctx, workId, "metastore",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -217,10 +218,10 @@ func (s *DatacatalogMetastoreResourceCrud) getMetastoreFromWorkRequest(workId *s
 	}
 	s.D.SetId(*catalogId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func catalogMetastoreWaitForWorkRequest(wId *string, entityType string, action oci_datacatalog.WorkRequestResourceActionTypeEnum,
+func catalogMetastoreWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_datacatalog.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_datacatalog.DataCatalogClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "datacatalog")
 	retryPolicy.ShouldRetryOperation = catalogMetastoreWorkRequestShouldRetryFunc(timeout)
@@ -239,7 +240,7 @@ func catalogMetastoreWaitForWorkRequest(wId *string, entityType string, action o
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_datacatalog.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -268,7 +269,7 @@ func catalogMetastoreWaitForWorkRequest(wId *string, entityType string, action o
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_datacatalog.WorkRequestStatusFailed || response.Status == oci_datacatalog.WorkRequestStatusCanceled {
-		return nil, getErrorFromDatacatalogCatalogMetastoreWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDatacatalogCatalogMetastoreWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
@@ -297,8 +298,8 @@ func catalogMetastoreWorkRequestShouldRetryFunc(timeout time.Duration) func(resp
 	}
 }
 
-func getErrorFromDatacatalogCatalogMetastoreWorkRequest(client *oci_datacatalog.DataCatalogClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_datacatalog.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDatacatalogCatalogMetastoreWorkRequest(ctx context.Context, client *oci_datacatalog.DataCatalogClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_datacatalog.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_datacatalog.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -320,7 +321,7 @@ func getErrorFromDatacatalogCatalogMetastoreWorkRequest(client *oci_datacatalog.
 	return workRequestErr
 }
 
-func (s *DatacatalogMetastoreResourceCrud) Get() error {
+func (s *DatacatalogMetastoreResourceCrud) Get(ctx context.Context) error {
 	request := oci_datacatalog.GetMetastoreRequest{}
 
 	tmp := s.D.Id()
@@ -328,7 +329,7 @@ func (s *DatacatalogMetastoreResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.GetMetastore(context.Background(), request)
+	response, err := s.Client.GetMetastore(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -337,11 +338,11 @@ func (s *DatacatalogMetastoreResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatacatalogMetastoreResourceCrud) Update() error {
+func (s *DatacatalogMetastoreResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -371,7 +372,7 @@ func (s *DatacatalogMetastoreResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	response, err := s.Client.UpdateMetastore(context.Background(), request)
+	response, err := s.Client.UpdateMetastore(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -380,7 +381,7 @@ func (s *DatacatalogMetastoreResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DatacatalogMetastoreResourceCrud) Delete() error {
+func (s *DatacatalogMetastoreResourceCrud) Delete(ctx context.Context) error {
 	request := oci_datacatalog.DeleteMetastoreRequest{}
 
 	tmp := s.D.Id()
@@ -388,7 +389,7 @@ func (s *DatacatalogMetastoreResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	_, err := s.Client.DeleteMetastore(context.Background(), request)
+	_, err := s.Client.DeleteMetastore(ctx, request)
 	return err
 }
 
@@ -432,7 +433,7 @@ func (s *DatacatalogMetastoreResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *DatacatalogMetastoreResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DatacatalogMetastoreResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_datacatalog.ChangeMetastoreCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -443,7 +444,7 @@ func (s *DatacatalogMetastoreResourceCrud) updateCompartment(compartment interfa
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datacatalog")
 
-	_, err := s.Client.ChangeMetastoreCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeMetastoreCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
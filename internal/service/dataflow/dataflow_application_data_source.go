@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dataflow "github.com/oracle/oci-go-sdk/v65/dataflow"
 )
@@ -22,12 +23,12 @@ func DataflowApplicationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DataflowApplicationResource(), fieldMap, readSingularDataflowApplication)
 }
 
-func readSingularDataflowApplication(d *schema.ResourceData, m interface{}) error {
+func readSingularDataflowApplication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataflowApplicationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataFlowClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataflowApplicationDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DataflowApplicationDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataflowApplicationDataSourceCrud) Get() error {
+func (s *DataflowApplicationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_dataflow.GetApplicationRequest{}
 
 	if applicationId, ok := s.D.GetOkExists("application_id"); ok {
@@ -50,7 +51,7 @@ func (s *DataflowApplicationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dataflow")
 
-	response, err := s.Client.GetApplication(context.Background(), request)
+	response, err := s.Client.GetApplication(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_dataflow "github.com/oracle/oci-go-sdk/v65/dataflow"
@@ -20,11 +21,11 @@ func DataflowApplicationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataflowApplication,
-		Read:     readDataflowApplication,
-		Update:   updateDataflowApplication,
-		Delete:   deleteDataflowApplication,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataflowApplication,
+		ReadContext:   readDataflowApplication,
+		UpdateContext: updateDataflowApplication,
+		DeleteContext: deleteDataflowApplication,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -256,37 +257,37 @@ func DataflowApplicationResource() *schema.Resource {
 	}
 }
 
-func createDataflowApplication(d *schema.ResourceData, m interface{}) error {
+func createDataflowApplication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataflowApplicationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataFlowClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDataflowApplication(d *schema.ResourceData, m interface{}) error {
+func readDataflowApplication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataflowApplicationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataFlowClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDataflowApplication(d *schema.ResourceData, m interface{}) error {
+func updateDataflowApplication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataflowApplicationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataFlowClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDataflowApplication(d *schema.ResourceData, m interface{}) error {
+func deleteDataflowApplication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataflowApplicationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataFlowClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DataflowApplicationResourceCrud struct {
@@ -320,7 +321,7 @@ func (s *DataflowApplicationResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DataflowApplicationResourceCrud) Create() error {
+func (s *DataflowApplicationResourceCrud) Create(ctx context.Context) error {
 	request := oci_dataflow.CreateApplicationRequest{}
 
 	if applicationLogConfig, ok := s.D.GetOkExists("application_log_config"); ok {
@@ -487,7 +488,7 @@ func (s *DataflowApplicationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
-	response, err := s.Client.CreateApplication(context.Background(), request)
+	response, err := s.Client.CreateApplication(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -496,7 +497,7 @@ func (s *DataflowApplicationResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DataflowApplicationResourceCrud) Get() error {
+func (s *DataflowApplicationResourceCrud) Get(ctx context.Context) error {
 	request := oci_dataflow.GetApplicationRequest{}
 
 	tmp := s.D.Id()
@@ -504,7 +505,7 @@ func (s *DataflowApplicationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
-	response, err := s.Client.GetApplication(context.Background(), request)
+	response, err := s.Client.GetApplication(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -513,11 +514,11 @@ func (s *DataflowApplicationResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DataflowApplicationResourceCrud) Update() error {
+func (s *DataflowApplicationResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -683,7 +684,7 @@ func (s *DataflowApplicationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
-	response, err := s.Client.UpdateApplication(context.Background(), request)
+	response, err := s.Client.UpdateApplication(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -692,7 +693,7 @@ func (s *DataflowApplicationResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DataflowApplicationResourceCrud) Delete() error {
+func (s *DataflowApplicationResourceCrud) Delete(ctx context.Context) error {
 	request := oci_dataflow.DeleteApplicationRequest{}
 
 	tmp := s.D.Id()
@@ -700,7 +701,7 @@ func (s *DataflowApplicationResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
-	_, err := s.Client.DeleteApplication(context.Background(), request)
+	_, err := s.Client.DeleteApplication(ctx, request)
 	return err
 }
 
@@ -914,7 +915,7 @@ func ShapeConfigToMap(obj *oci_dataflow.ShapeConfig) map[string]interface{} {
 	return result
 }
 
-func (s *DataflowApplicationResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DataflowApplicationResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_dataflow.ChangeApplicationCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -925,12 +926,12 @@ func (s *DataflowApplicationResourceCrud) updateCompartment(compartment interfac
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
-	_, err := s.Client.ChangeApplicationCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeApplicationCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dataflow "github.com/oracle/oci-go-sdk/v65/dataflow"
 )
@@ -23,12 +24,12 @@ func DataflowInvokeRunDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DataflowInvokeRunResource(), fieldMap, readSingularDataflowInvokeRun)
 }
 
-func readSingularDataflowInvokeRun(d *schema.ResourceData, m interface{}) error {
+func readSingularDataflowInvokeRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataflowInvokeRunDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataFlowClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataflowInvokeRunDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *DataflowInvokeRunDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataflowInvokeRunDataSourceCrud) Get() error {
+func (s *DataflowInvokeRunDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_dataflow.GetRunRequest{}
 
 	if runId, ok := s.D.GetOkExists("run_id"); ok {
@@ -51,7 +52,7 @@ func (s *DataflowInvokeRunDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dataflow")
 
-	response, err := s.Client.GetRun(context.Background(), request)
+	response, err := s.Client.GetRun(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_dataflow "github.com/oracle/oci-go-sdk/v65/dataflow"
@@ -24,11 +25,11 @@ func DataflowInvokeRunResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDataflowInvokeRun,
-		Read:     readDataflowInvokeRun,
-		Update:   updateDataflowInvokeRun,
-		Delete:   deleteDataflowInvokeRun,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDataflowInvokeRun,
+		ReadContext:   readDataflowInvokeRun,
+		UpdateContext: updateDataflowInvokeRun,
+		DeleteContext: deleteDataflowInvokeRun,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -342,37 +343,37 @@ func DataflowInvokeRunResource() *schema.Resource {
 	}
 }
 
-func createDataflowInvokeRun(d *schema.ResourceData, m interface{}) error {
+func createDataflowInvokeRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataflowInvokeRunResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataFlowClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDataflowInvokeRun(d *schema.ResourceData, m interface{}) error {
+func readDataflowInvokeRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataflowInvokeRunResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataFlowClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDataflowInvokeRun(d *schema.ResourceData, m interface{}) error {
+func updateDataflowInvokeRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataflowInvokeRunResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataFlowClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDataflowInvokeRun(d *schema.ResourceData, m interface{}) error {
+func deleteDataflowInvokeRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataflowInvokeRunResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataFlowClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DataflowInvokeRunResourceCrud struct {
@@ -435,7 +436,7 @@ func (s *DataflowInvokeRunResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DataflowInvokeRunResourceCrud) Create() error {
+func (s *DataflowInvokeRunResourceCrud) Create(ctx context.Context) error {
 	request := oci_dataflow.CreateRunRequest{}
 
 	if applicationId, ok := s.D.GetOkExists("application_id"); ok {
@@ -583,7 +584,7 @@ func (s *DataflowInvokeRunResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
-	response, err := s.Client.CreateRun(context.Background(), request)
+	response, err := s.Client.CreateRun(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -592,7 +593,7 @@ func (s *DataflowInvokeRunResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DataflowInvokeRunResourceCrud) Get() error {
+func (s *DataflowInvokeRunResourceCrud) Get(ctx context.Context) error {
 	request := oci_dataflow.GetRunRequest{}
 
 	tmp := s.D.Id()
@@ -600,7 +601,7 @@ func (s *DataflowInvokeRunResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
-	response, err := s.Client.GetRun(context.Background(), request)
+	response, err := s.Client.GetRun(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -609,11 +610,11 @@ func (s *DataflowInvokeRunResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DataflowInvokeRunResourceCrud) Update() error {
+func (s *DataflowInvokeRunResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -638,7 +639,7 @@ func (s *DataflowInvokeRunResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
-	response, err := s.Client.UpdateRun(context.Background(), request)
+	response, err := s.Client.UpdateRun(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -647,7 +648,7 @@ func (s *DataflowInvokeRunResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DataflowInvokeRunResourceCrud) Delete() error {
+func (s *DataflowInvokeRunResourceCrud) Delete(ctx context.Context) error {
 	getRequest := oci_dataflow.GetRunRequest{}
 
 	tmp := s.D.Id()
@@ -655,7 +656,7 @@ func (s *DataflowInvokeRunResourceCrud) Delete() error {
 
 	getRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
-	getResponse, err := s.Client.GetRun(context.Background(), getRequest)
+	getResponse, err := s.Client.GetRun(ctx, getRequest)
 	if err != nil {
 		return err
 	}
@@ -670,7 +671,7 @@ func (s *DataflowInvokeRunResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
-	_, err = s.Client.DeleteRun(context.Background(), request)
+	_, err = s.Client.DeleteRun(ctx, request)
 	return err
 }
 
@@ -910,7 +911,7 @@ func (s *DataflowInvokeRunResourceCrud) mapToShapeConfig(fieldKeyFormat string)
 	return result, nil
 }
 
-func (s *DataflowInvokeRunResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DataflowInvokeRunResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_dataflow.ChangeRunCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -922,12 +923,12 @@ func (s *DataflowInvokeRunResourceCrud) updateCompartment(compartment interface{
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dataflow")
 
-	_, err := s.Client.ChangeRunCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeRunCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
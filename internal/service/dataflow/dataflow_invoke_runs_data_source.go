@@ -11,6 +11,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_dataflow "github.com/oracle/oci-go-sdk/v65/dataflow"
@@ -18,7 +19,7 @@ import (
 
 func DataflowInvokeRunsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataflowInvokeRuns,
+		ReadContext: readDataflowInvokeRuns,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"application_id": {
@@ -58,12 +59,12 @@ func DataflowInvokeRunsDataSource() *schema.Resource {
 	}
 }
 
-func readDataflowInvokeRuns(d *schema.ResourceData, m interface{}) error {
+func readDataflowInvokeRuns(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataflowInvokeRunsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataFlowClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataflowInvokeRunsDataSourceCrud struct {
@@ -76,7 +77,7 @@ func (s *DataflowInvokeRunsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataflowInvokeRunsDataSourceCrud) Get() error {
+func (s *DataflowInvokeRunsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_dataflow.ListRunsRequest{}
 
 	if applicationId, ok := s.D.GetOkExists("application_id"); ok {
@@ -118,7 +119,7 @@ func (s *DataflowInvokeRunsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dataflow")
 
-	response, err := s.Client.ListRuns(context.Background(), request)
+	response, err := s.Client.ListRuns(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -127,7 +128,7 @@ func (s *DataflowInvokeRunsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRuns(context.Background(), request)
+		listResponse, err := s.Client.ListRuns(ctx, request)
 		if err != nil {
 			return err
 		}
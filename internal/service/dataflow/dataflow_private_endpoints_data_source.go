@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dataflow "github.com/oracle/oci-go-sdk/v65/dataflow"
 )
 
 func DataflowPrivateEndpointsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataflowPrivateEndpoints,
+		ReadContext: readDataflowPrivateEndpoints,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -56,12 +57,12 @@ func DataflowPrivateEndpointsDataSource() *schema.Resource {
 	}
 }
 
-func readDataflowPrivateEndpoints(d *schema.ResourceData, m interface{}) error {
+func readDataflowPrivateEndpoints(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataflowPrivateEndpointsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataFlowClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataflowPrivateEndpointsDataSourceCrud struct {
@@ -74,7 +75,7 @@ func (s *DataflowPrivateEndpointsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataflowPrivateEndpointsDataSourceCrud) Get() error {
+func (s *DataflowPrivateEndpointsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_dataflow.ListPrivateEndpointsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -103,7 +104,7 @@ func (s *DataflowPrivateEndpointsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dataflow")
 
-	response, err := s.Client.ListPrivateEndpoints(context.Background(), request)
+	response, err := s.Client.ListPrivateEndpoints(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +113,7 @@ func (s *DataflowPrivateEndpointsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPrivateEndpoints(context.Background(), request)
+		listResponse, err := s.Client.ListPrivateEndpoints(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -12,13 +12,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dataflow "github.com/oracle/oci-go-sdk/v65/dataflow"
 )
 
 func DataflowRunLogDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDataflowRunLog,
+		ReadContext: readSingularDataflowRunLog,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -46,12 +47,12 @@ func DataflowRunLogDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDataflowRunLog(d *schema.ResourceData, m interface{}) error {
+func readSingularDataflowRunLog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataflowRunLogDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataFlowClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataflowRunLogDataSourceCrud struct {
@@ -64,7 +65,7 @@ func (s *DataflowRunLogDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataflowRunLogDataSourceCrud) Get() error {
+func (s *DataflowRunLogDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_dataflow.GetRunLogRequest{}
 
 	if name, ok := s.D.GetOkExists("name"); ok {
@@ -79,7 +80,7 @@ func (s *DataflowRunLogDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dataflow")
 
-	response, err := s.Client.GetRunLog(context.Background(), request)
+	response, err := s.Client.GetRunLog(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -10,13 +10,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dataflow "github.com/oracle/oci-go-sdk/v65/dataflow"
 )
 
 func DataflowRunLogsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataflowRunLogs,
+		ReadContext: readDataflowRunLogs,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"run_id": {
@@ -64,12 +65,12 @@ func DataflowRunLogsDataSource() *schema.Resource {
 	}
 }
 
-func readDataflowRunLogs(d *schema.ResourceData, m interface{}) error {
+func readDataflowRunLogs(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataflowRunLogsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataFlowClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataflowRunLogsDataSourceCrud struct {
@@ -82,7 +83,7 @@ func (s *DataflowRunLogsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataflowRunLogsDataSourceCrud) Get() error {
+func (s *DataflowRunLogsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_dataflow.ListRunLogsRequest{}
 
 	if runId, ok := s.D.GetOkExists("run_id"); ok {
@@ -92,7 +93,7 @@ func (s *DataflowRunLogsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dataflow")
 
-	response, err := s.Client.ListRunLogs(context.Background(), request)
+	response, err := s.Client.ListRunLogs(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -101,7 +102,7 @@ func (s *DataflowRunLogsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRunLogs(context.Background(), request)
+		listResponse, err := s.Client.ListRunLogs(ctx, request)
 		if err != nil {
 			return err
 		}
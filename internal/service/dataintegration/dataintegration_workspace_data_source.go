@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dataintegration "github.com/oracle/oci-go-sdk/v65/dataintegration"
 )
@@ -22,12 +23,12 @@ func DataintegrationWorkspaceDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DataintegrationWorkspaceResource(), fieldMap, readSingularDataintegrationWorkspace)
 }
 
-func readSingularDataintegrationWorkspace(d *schema.ResourceData, m interface{}) error {
+func readSingularDataintegrationWorkspace(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataintegrationWorkspaceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataIntegrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataintegrationWorkspaceDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DataintegrationWorkspaceDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataintegrationWorkspaceDataSourceCrud) Get() error {
+func (s *DataintegrationWorkspaceDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_dataintegration.GetWorkspaceRequest{}
 
 	if workspaceId, ok := s.D.GetOkExists("workspace_id"); ok {
@@ -50,7 +51,7 @@ func (s *DataintegrationWorkspaceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dataintegration")
 
-	response, err := s.Client.GetWorkspace(context.Background(), request)
+	response, err := s.Client.GetWorkspace(ctx, request)
 	if err != nil {
 		return err
 	}
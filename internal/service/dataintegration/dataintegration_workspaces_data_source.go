@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dataintegration "github.com/oracle/oci-go-sdk/v65/dataintegration"
 )
 
 func DataintegrationWorkspacesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDataintegrationWorkspaces,
+		ReadContext: readDataintegrationWorkspaces,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,12 +40,12 @@ func DataintegrationWorkspacesDataSource() *schema.Resource {
 	}
 }
 
-func readDataintegrationWorkspaces(d *schema.ResourceData, m interface{}) error {
+func readDataintegrationWorkspaces(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DataintegrationWorkspacesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataIntegrationClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DataintegrationWorkspacesDataSourceCrud struct {
@@ -57,7 +58,7 @@ func (s *DataintegrationWorkspacesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DataintegrationWorkspacesDataSourceCrud) Get() error {
+func (s *DataintegrationWorkspacesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_dataintegration.ListWorkspacesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -76,7 +77,7 @@ func (s *DataintegrationWorkspacesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dataintegration")
 
-	response, err := s.Client.ListWorkspaces(context.Background(), request)
+	response, err := s.Client.ListWorkspaces(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *DataintegrationWorkspacesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListWorkspaces(context.Background(), request)
+		listResponse, err := s.Client.ListWorkspaces(ctx, request)
 		if err != nil {
 			return err
 		}
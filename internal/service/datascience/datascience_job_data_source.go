@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_datascience "github.com/oracle/oci-go-sdk/v65/datascience"
 )
@@ -22,12 +23,12 @@ func DatascienceJobDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatascienceJobResource(), fieldMap, readSingularDatascienceJob)
 }
 
-func readSingularDatascienceJob(d *schema.ResourceData, m interface{}) error {
+func readSingularDatascienceJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceJobDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatascienceJobDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DatascienceJobDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatascienceJobDataSourceCrud) Get() error {
+func (s *DatascienceJobDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_datascience.GetJobRequest{}
 
 	if jobId, ok := s.D.GetOkExists("job_id"); ok {
@@ -50,7 +51,7 @@ func (s *DatascienceJobDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datascience")
 
-	response, err := s.Client.GetJob(context.Background(), request)
+	response, err := s.Client.GetJob(ctx, request)
 	if err != nil {
 		return err
 	}
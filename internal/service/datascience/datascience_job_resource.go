@@ -16,6 +16,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -28,11 +29,11 @@ func DatascienceJobResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatascienceJob,
-		Read:     readDatascienceJob,
-		Update:   updateDatascienceJob,
-		Delete:   deleteDatascienceJob,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatascienceJob,
+		ReadContext:   readDatascienceJob,
+		UpdateContext: updateDatascienceJob,
+		DeleteContext: deleteDatascienceJob,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -275,47 +276,47 @@ func DatascienceJobResource() *schema.Resource {
 	}
 }
 
-func createDatascienceJob(d *schema.ResourceData, m interface{}) error {
+func createDatascienceJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceJobResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	if e := tfresource.CreateResource(d, sync); e != nil {
-		return e
+	if e := tfresource.CreateResource(ctx, d, sync); e != nil {
+		return tfresource.HandleDiagError(e)
 	}
 
 	if _, ok := d.GetOkExists("job_artifact"); ok {
-		if e := sync.CreateArtifact(); e != nil {
-			return e
+		if e := sync.CreateArtifact(ctx); e != nil {
+			return tfresource.HandleDiagError(e)
 		}
 	}
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func readDatascienceJob(d *schema.ResourceData, m interface{}) error {
+func readDatascienceJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceJobResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatascienceJob(d *schema.ResourceData, m interface{}) error {
+func updateDatascienceJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceJobResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatascienceJob(d *schema.ResourceData, m interface{}) error {
+func deleteDatascienceJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceJobResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type HeadJobArtifact struct {
@@ -360,7 +361,7 @@ func (s *DatascienceJobResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DatascienceJobResourceCrud) Create() error {
+func (s *DatascienceJobResourceCrud) Create(ctx context.Context) error {
 	request := oci_datascience.CreateJobRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -430,7 +431,7 @@ func (s *DatascienceJobResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.CreateJob(context.Background(), request)
+	response, err := s.Client.CreateJob(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -439,7 +440,7 @@ func (s *DatascienceJobResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatascienceJobResourceCrud) Get() error {
+func (s *DatascienceJobResourceCrud) Get(ctx context.Context) error {
 	request := oci_datascience.GetJobRequest{}
 
 	tmp := s.D.Id()
@@ -447,7 +448,7 @@ func (s *DatascienceJobResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.GetJob(context.Background(), request)
+	response, err := s.Client.GetJob(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -456,7 +457,7 @@ func (s *DatascienceJobResourceCrud) Get() error {
 	if emptyArtifact, ok := s.D.GetOkExists("empty_artifact"); ok {
 		tmp := emptyArtifact.(bool)
 		if !tmp {
-			err := s.GetArtifactHead()
+			err := s.GetArtifactHead(ctx)
 			if err != nil {
 				return err
 			}
@@ -465,11 +466,11 @@ func (s *DatascienceJobResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatascienceJobResourceCrud) Update() error {
+func (s *DatascienceJobResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -515,7 +516,7 @@ func (s *DatascienceJobResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.UpdateJob(context.Background(), request)
+	response, err := s.Client.UpdateJob(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -524,7 +525,7 @@ func (s *DatascienceJobResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DatascienceJobResourceCrud) Delete() error {
+func (s *DatascienceJobResourceCrud) Delete(ctx context.Context) error {
 	request := oci_datascience.DeleteJobRequest{}
 
 	if deleteRelatedJobRuns, ok := s.D.GetOkExists("delete_related_job_runs"); ok {
@@ -537,7 +538,7 @@ func (s *DatascienceJobResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.DeleteJob(context.Background(), request)
+	_, err := s.Client.DeleteJob(ctx, request)
 	return err
 }
 
@@ -849,7 +850,7 @@ func JobShapeConfigDetailsToMap(obj *oci_datascience.JobShapeConfigDetails) map[
 	return result
 }
 
-func (s *DatascienceJobResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DatascienceJobResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_datascience.ChangeJobCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -860,14 +861,14 @@ func (s *DatascienceJobResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.ChangeJobCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeJobCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (s *DatascienceJobResourceCrud) CreateArtifact() error {
+func (s *DatascienceJobResourceCrud) CreateArtifact(ctx context.Context) error {
 	request := oci_datascience.CreateJobArtifactRequest{}
 
 	if contentDisposition, ok := s.D.GetOkExists("artifact_content_disposition"); ok {
@@ -898,14 +899,14 @@ func (s *DatascienceJobResourceCrud) CreateArtifact() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.CreateJobArtifact(context.Background(), request)
+	_, err := s.Client.CreateJobArtifact(ctx, request)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (s *DatascienceJobResourceCrud) GetArtifactHead() error {
+func (s *DatascienceJobResourceCrud) GetArtifactHead(ctx context.Context) error {
 	request := oci_datascience.HeadJobArtifactRequest{}
 
 	tmp := s.D.Id()
@@ -913,7 +914,7 @@ func (s *DatascienceJobResourceCrud) GetArtifactHead() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "datascience")
 
-	response, err := s.Client.HeadJobArtifact(context.Background(), request)
+	response, err := s.Client.HeadJobArtifact(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_datascience "github.com/oracle/oci-go-sdk/v65/datascience"
 )
@@ -22,12 +23,12 @@ func DatascienceJobRunDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatascienceJobRunResource(), fieldMap, readSingularDatascienceJobRun)
 }
 
-func readSingularDatascienceJobRun(d *schema.ResourceData, m interface{}) error {
+func readSingularDatascienceJobRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceJobRunDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatascienceJobRunDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DatascienceJobRunDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatascienceJobRunDataSourceCrud) Get() error {
+func (s *DatascienceJobRunDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_datascience.GetJobRunRequest{}
 
 	if jobRunId, ok := s.D.GetOkExists("job_run_id"); ok {
@@ -50,7 +51,7 @@ func (s *DatascienceJobRunDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datascience")
 
-	response, err := s.Client.GetJobRun(context.Background(), request)
+	response, err := s.Client.GetJobRun(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -23,11 +24,11 @@ func DatascienceJobRunResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatascienceJobRun,
-		Read:     readDatascienceJobRun,
-		Update:   updateDatascienceJobRun,
-		Delete:   deleteDatascienceJobRun,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatascienceJobRun,
+		ReadContext:   readDatascienceJobRun,
+		UpdateContext: updateDatascienceJobRun,
+		DeleteContext: deleteDatascienceJobRun,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -259,37 +260,37 @@ func DatascienceJobRunResource() *schema.Resource {
 	}
 }
 
-func createDatascienceJobRun(d *schema.ResourceData, m interface{}) error {
+func createDatascienceJobRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceJobRunResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatascienceJobRun(d *schema.ResourceData, m interface{}) error {
+func readDatascienceJobRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceJobRunResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatascienceJobRun(d *schema.ResourceData, m interface{}) error {
+func updateDatascienceJobRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceJobRunResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatascienceJobRun(d *schema.ResourceData, m interface{}) error {
+func deleteDatascienceJobRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceJobRunResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatascienceJobRunResourceCrud struct {
@@ -349,7 +350,7 @@ func (s *DatascienceJobRunResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DatascienceJobRunResourceCrud) Create() error {
+func (s *DatascienceJobRunResourceCrud) Create(ctx context.Context) error {
 	request := oci_datascience.CreateJobRunRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -408,7 +409,7 @@ func (s *DatascienceJobRunResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.CreateJobRun(context.Background(), request)
+	response, err := s.Client.CreateJobRun(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -417,7 +418,7 @@ func (s *DatascienceJobRunResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatascienceJobRunResourceCrud) Get() error {
+func (s *DatascienceJobRunResourceCrud) Get(ctx context.Context) error {
 	request := oci_datascience.GetJobRunRequest{}
 
 	tmp := s.D.Id()
@@ -425,7 +426,7 @@ func (s *DatascienceJobRunResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.GetJobRun(context.Background(), request)
+	response, err := s.Client.GetJobRun(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -434,11 +435,11 @@ func (s *DatascienceJobRunResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatascienceJobRunResourceCrud) Update() error {
+func (s *DatascienceJobRunResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -468,7 +469,7 @@ func (s *DatascienceJobRunResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.UpdateJobRun(context.Background(), request)
+	response, err := s.Client.UpdateJobRun(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -477,7 +478,7 @@ func (s *DatascienceJobRunResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DatascienceJobRunResourceCrud) Delete() error {
+func (s *DatascienceJobRunResourceCrud) Delete(ctx context.Context) error {
 	request := oci_datascience.DeleteJobRunRequest{}
 
 	tmp := s.D.Id()
@@ -485,7 +486,7 @@ func (s *DatascienceJobRunResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.DeleteJobRun(context.Background(), request)
+	_, err := s.Client.DeleteJobRun(ctx, request)
 	return err
 }
 
@@ -660,7 +661,7 @@ func (s *DatascienceJobRunResourceCrud) mapToJobShapeConfigDetails(fieldKeyForma
 	return result, nil
 }
 
-func (s *DatascienceJobRunResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DatascienceJobRunResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_datascience.ChangeJobRunCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -671,7 +672,7 @@ func (s *DatascienceJobRunResourceCrud) updateCompartment(compartment interface{
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.ChangeJobRunCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeJobRunCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
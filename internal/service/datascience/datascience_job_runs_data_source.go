@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_datascience "github.com/oracle/oci-go-sdk/v65/datascience"
 )
 
 func DatascienceJobRunsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatascienceJobRuns,
+		ReadContext: readDatascienceJobRuns,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -51,12 +52,12 @@ func DatascienceJobRunsDataSource() *schema.Resource {
 	}
 }
 
-func readDatascienceJobRuns(d *schema.ResourceData, m interface{}) error {
+func readDatascienceJobRuns(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceJobRunsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatascienceJobRunsDataSourceCrud struct {
@@ -69,7 +70,7 @@ func (s *DatascienceJobRunsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatascienceJobRunsDataSourceCrud) Get() error {
+func (s *DatascienceJobRunsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_datascience.ListJobRunsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -103,7 +104,7 @@ func (s *DatascienceJobRunsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datascience")
 
-	response, err := s.Client.ListJobRuns(context.Background(), request)
+	response, err := s.Client.ListJobRuns(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +113,7 @@ func (s *DatascienceJobRunsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListJobRuns(context.Background(), request)
+		listResponse, err := s.Client.ListJobRuns(ctx, request)
 		if err != nil {
 			return err
 		}
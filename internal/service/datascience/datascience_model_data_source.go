@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_datascience "github.com/oracle/oci-go-sdk/v65/datascience"
 )
@@ -22,12 +23,12 @@ func DatascienceModelDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatascienceModelResource(), fieldMap, readSingularDatascienceModel)
 }
 
-func readSingularDatascienceModel(d *schema.ResourceData, m interface{}) error {
+func readSingularDatascienceModel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceModelDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatascienceModelDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *DatascienceModelDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatascienceModelDataSourceCrud) Get() error {
+func (s *DatascienceModelDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_datascience.GetModelRequest{}
 
 	if modelId, ok := s.D.GetOkExists("model_id"); ok {
@@ -51,7 +52,7 @@ func (s *DatascienceModelDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datascience")
 
-	response, err := s.Client.GetModel(context.Background(), request)
+	response, err := s.Client.GetModel(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -66,7 +67,7 @@ func (s *DatascienceModelDataSourceCrud) Get() error {
 
 	headModelArtifactRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datascience")
 
-	headModelArtifactResponse, err := s.Client.HeadModelArtifact(context.Background(), headModelArtifactRequest)
+	headModelArtifactResponse, err := s.Client.HeadModelArtifact(ctx, headModelArtifactRequest)
 	if err != nil {
 		return err
 	}
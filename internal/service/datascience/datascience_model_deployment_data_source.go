@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_datascience "github.com/oracle/oci-go-sdk/v65/datascience"
 )
@@ -22,12 +23,12 @@ func DatascienceModelDeploymentDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatascienceModelDeploymentResource(), fieldMap, readSingularDatascienceModelDeployment)
 }
 
-func readSingularDatascienceModelDeployment(d *schema.ResourceData, m interface{}) error {
+func readSingularDatascienceModelDeployment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceModelDeploymentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatascienceModelDeploymentDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DatascienceModelDeploymentDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatascienceModelDeploymentDataSourceCrud) Get() error {
+func (s *DatascienceModelDeploymentDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_datascience.GetModelDeploymentRequest{}
 
 	if modelDeploymentId, ok := s.D.GetOkExists("model_deployment_id"); ok {
@@ -50,7 +51,7 @@ func (s *DatascienceModelDeploymentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datascience")
 
-	response, err := s.Client.GetModelDeployment(context.Background(), request)
+	response, err := s.Client.GetModelDeployment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -31,10 +32,10 @@ func DatascienceModelDeploymentResource() *schema.Resource {
 			Update: &tfresource.ThirtyMinutes,
 			Delete: &tfresource.TwentyMinutes,
 		},
-		Create: createDatascienceModelDeployment,
-		Read:   readDatascienceModelDeployment,
-		Update: updateDatascienceModelDeployment,
-		Delete: deleteDatascienceModelDeployment,
+		CreateContext: createDatascienceModelDeployment,
+		ReadContext:   readDatascienceModelDeployment,
+		UpdateContext: updateDatascienceModelDeployment,
+		DeleteContext: deleteDatascienceModelDeployment,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -287,7 +288,7 @@ func DatascienceModelDeploymentResource() *schema.Resource {
 	}
 }
 
-func createDatascienceModelDeployment(d *schema.ResourceData, m interface{}) error {
+func createDatascienceModelDeployment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceModelDeploymentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
@@ -299,13 +300,13 @@ func createDatascienceModelDeployment(d *schema.ResourceData, m interface{}) err
 		}
 	}
 
-	if e := tfresource.CreateResource(d, sync); e != nil {
-		return e
+	if e := tfresource.CreateResource(ctx, d, sync); e != nil {
+		return tfresource.HandleDiagError(e)
 	}
 
 	if powerOff {
-		if err := sync.StopModelDeployment(); err != nil {
-			return err
+		if err := sync.StopModelDeployment(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		sync.D.Set("state", oci_datascience.ModelDeploymentLifecycleStateInactive)
 	}
@@ -313,15 +314,15 @@ func createDatascienceModelDeployment(d *schema.ResourceData, m interface{}) err
 
 }
 
-func readDatascienceModelDeployment(d *schema.ResourceData, m interface{}) error {
+func readDatascienceModelDeployment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceModelDeploymentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatascienceModelDeployment(d *schema.ResourceData, m interface{}) error {
+func updateDatascienceModelDeployment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceModelDeploymentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
@@ -338,19 +339,19 @@ func updateDatascienceModelDeployment(d *schema.ResourceData, m interface{}) err
 	}
 
 	if powerOn {
-		if err := sync.StartModelDeployment(); err != nil {
-			return err
+		if err := sync.StartModelDeployment(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		sync.D.Set("state", oci_datascience.ModelDeploymentLifecycleStateActive)
 	}
 
-	if err := tfresource.UpdateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.UpdateResource(ctx, d, sync); err != nil {
+		return tfresource.HandleDiagError(err)
 	}
 
 	if powerOff {
-		if err := sync.StopModelDeployment(); err != nil {
-			return err
+		if err := sync.StopModelDeployment(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		sync.D.Set("state", oci_datascience.ModelDeploymentLifecycleStateInactive)
 	}
@@ -358,13 +359,13 @@ func updateDatascienceModelDeployment(d *schema.ResourceData, m interface{}) err
 	return nil
 }
 
-func deleteDatascienceModelDeployment(d *schema.ResourceData, m interface{}) error {
+func deleteDatascienceModelDeployment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceModelDeploymentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatascienceModelDeploymentResourceCrud struct {
@@ -419,7 +420,7 @@ func (s *DatascienceModelDeploymentResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *DatascienceModelDeploymentResourceCrud) Create() error {
+func (s *DatascienceModelDeploymentResourceCrud) Create(ctx context.Context) error {
 	request := oci_datascience.CreateModelDeploymentRequest{}
 
 	if categoryLogDetails, ok := s.D.GetOkExists("category_log_details"); ok {
@@ -478,26 +479,26 @@ func (s *DatascienceModelDeploymentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.CreateModelDeployment(context.Background(), request)
+	response, err := s.Client.CreateModelDeployment(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getModelDeploymentFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience"), oci_datascience.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getModelDeploymentFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience"), oci_datascience.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *DatascienceModelDeploymentResourceCrud) getModelDeploymentFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *DatascienceModelDeploymentResourceCrud) getModelDeploymentFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_datascience.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	modelDeploymentId, err := modelDeploymentWaitForWorkRequest(workId, "model-deployment",
+	modelDeploymentId, err := modelDeploymentWaitForWorkRequest(ctx, workId, "model-deployment",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, modelDeploymentId)
-		_, cancelErr := s.Client.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.Client.CancelWorkRequest(ctx,
 			oci_datascience.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -511,7 +512,7 @@ func (s *DatascienceModelDeploymentResourceCrud) getModelDeploymentFromWorkReque
 	}
 	s.D.SetId(*modelDeploymentId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func modelDeploymentWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -537,7 +538,7 @@ func modelDeploymentWorkRequestShouldRetryFunc(timeout time.Duration) func(respo
 	}
 }
 
-func modelDeploymentWaitForWorkRequest(wId *string, entityType string, action oci_datascience.WorkRequestResourceActionTypeEnum,
+func modelDeploymentWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_datascience.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_datascience.DataScienceClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "datascience")
 	retryPolicy.ShouldRetryOperation = modelDeploymentWorkRequestShouldRetryFunc(timeout)
@@ -556,7 +557,7 @@ func modelDeploymentWaitForWorkRequest(wId *string, entityType string, action oc
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_datascience.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -585,14 +586,14 @@ func modelDeploymentWaitForWorkRequest(wId *string, entityType string, action oc
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_datascience.WorkRequestStatusFailed || response.Status == oci_datascience.WorkRequestStatusCanceled {
-		return nil, getErrorFromDatascienceModelDeploymentWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDatascienceModelDeploymentWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDatascienceModelDeploymentWorkRequest(client *oci_datascience.DataScienceClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_datascience.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDatascienceModelDeploymentWorkRequest(ctx context.Context, client *oci_datascience.DataScienceClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_datascience.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_datascience.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -614,7 +615,7 @@ func getErrorFromDatascienceModelDeploymentWorkRequest(client *oci_datascience.D
 	return workRequestErr
 }
 
-func (s *DatascienceModelDeploymentResourceCrud) Get() error {
+func (s *DatascienceModelDeploymentResourceCrud) Get(ctx context.Context) error {
 	request := oci_datascience.GetModelDeploymentRequest{}
 
 	tmp := s.D.Id()
@@ -622,7 +623,7 @@ func (s *DatascienceModelDeploymentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.GetModelDeployment(context.Background(), request)
+	response, err := s.Client.GetModelDeployment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -631,11 +632,11 @@ func (s *DatascienceModelDeploymentResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatascienceModelDeploymentResourceCrud) Update() error {
+func (s *DatascienceModelDeploymentResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -692,16 +693,16 @@ func (s *DatascienceModelDeploymentResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.UpdateModelDeployment(context.Background(), request)
+	response, err := s.Client.UpdateModelDeployment(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getModelDeploymentFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience"), oci_datascience.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getModelDeploymentFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience"), oci_datascience.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *DatascienceModelDeploymentResourceCrud) Delete() error {
+func (s *DatascienceModelDeploymentResourceCrud) Delete(ctx context.Context) error {
 	request := oci_datascience.DeleteModelDeploymentRequest{}
 
 	tmp := s.D.Id()
@@ -709,14 +710,14 @@ func (s *DatascienceModelDeploymentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.DeleteModelDeployment(context.Background(), request)
+	response, err := s.Client.DeleteModelDeployment(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := modelDeploymentWaitForWorkRequest(workId, "model-deployment",
+	_, delWorkRequestErr := modelDeploymentWaitForWorkRequest(ctx, workId, "model-deployment",
 		oci_datascience.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -781,7 +782,7 @@ func (s *DatascienceModelDeploymentResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *DatascienceModelDeploymentResourceCrud) StartModelDeployment() error {
+func (s *DatascienceModelDeploymentResourceCrud) StartModelDeployment(ctx context.Context) error {
 	request := oci_datascience.ActivateModelDeploymentRequest{}
 
 	idTmp := s.D.Id()
@@ -789,16 +790,16 @@ func (s *DatascienceModelDeploymentResourceCrud) StartModelDeployment() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.ActivateModelDeployment(context.Background(), request)
+	_, err := s.Client.ActivateModelDeployment(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_datascience.ModelDeploymentLifecycleStateActive }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *DatascienceModelDeploymentResourceCrud) StopModelDeployment() error {
+func (s *DatascienceModelDeploymentResourceCrud) StopModelDeployment(ctx context.Context) error {
 	request := oci_datascience.DeactivateModelDeploymentRequest{}
 
 	idTmp := s.D.Id()
@@ -806,13 +807,13 @@ func (s *DatascienceModelDeploymentResourceCrud) StopModelDeployment() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.DeactivateModelDeployment(context.Background(), request)
+	_, err := s.Client.DeactivateModelDeployment(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_datascience.ModelDeploymentLifecycleStateInactive }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *DatascienceModelDeploymentResourceCrud) mapToCategoryLogDetails(fieldKeyFormat string) (oci_datascience.CategoryLogDetails, error) {
@@ -1203,7 +1204,7 @@ func UpdateModelConfigurationDetailsToMap(obj *oci_datascience.ModelConfiguratio
 	return result
 }
 
-func (s *DatascienceModelDeploymentResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DatascienceModelDeploymentResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_datascience.ChangeModelDeploymentCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -1214,12 +1215,12 @@ func (s *DatascienceModelDeploymentResourceCrud) updateCompartment(compartment i
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.ChangeModelDeploymentCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeModelDeploymentCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_datascience "github.com/oracle/oci-go-sdk/v65/datascience"
 )
 
 func DatascienceModelDeploymentShapesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatascienceModelDeploymentShapes,
+		ReadContext: readDatascienceModelDeploymentShapes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -55,12 +56,12 @@ func DatascienceModelDeploymentShapesDataSource() *schema.Resource {
 	}
 }
 
-func readDatascienceModelDeploymentShapes(d *schema.ResourceData, m interface{}) error {
+func readDatascienceModelDeploymentShapes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceModelDeploymentShapesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatascienceModelDeploymentShapesDataSourceCrud struct {
@@ -73,7 +74,7 @@ func (s *DatascienceModelDeploymentShapesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatascienceModelDeploymentShapesDataSourceCrud) Get() error {
+func (s *DatascienceModelDeploymentShapesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_datascience.ListModelDeploymentShapesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -83,7 +84,7 @@ func (s *DatascienceModelDeploymentShapesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datascience")
 
-	response, err := s.Client.ListModelDeploymentShapes(context.Background(), request)
+	response, err := s.Client.ListModelDeploymentShapes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -92,7 +93,7 @@ func (s *DatascienceModelDeploymentShapesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListModelDeploymentShapes(context.Background(), request)
+		listResponse, err := s.Client.ListModelDeploymentShapes(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -14,6 +14,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_datascience "github.com/oracle/oci-go-sdk/v65/datascience"
@@ -24,11 +25,11 @@ func DatascienceModelProvenanceResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatascienceModelProvenance,
-		Read:     readDatascienceModelProvenance,
-		Update:   updateDatascienceModelProvenance,
-		Delete:   deleteDatascienceModelProvenance,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatascienceModelProvenance,
+		ReadContext:   readDatascienceModelProvenance,
+		UpdateContext: updateDatascienceModelProvenance,
+		DeleteContext: deleteDatascienceModelProvenance,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"model_id": {
@@ -74,31 +75,31 @@ func DatascienceModelProvenanceResource() *schema.Resource {
 	}
 }
 
-func createDatascienceModelProvenance(d *schema.ResourceData, m interface{}) error {
+func createDatascienceModelProvenance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceModelProvenanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatascienceModelProvenance(d *schema.ResourceData, m interface{}) error {
+func readDatascienceModelProvenance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceModelProvenanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatascienceModelProvenance(d *schema.ResourceData, m interface{}) error {
+func updateDatascienceModelProvenance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceModelProvenanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatascienceModelProvenance(d *schema.ResourceData, m interface{}) error {
+func deleteDatascienceModelProvenance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -113,7 +114,7 @@ func (s *DatascienceModelProvenanceResourceCrud) ID() string {
 	return GetModelProvenanceCompositeId(s.D.Get("model_id").(string))
 }
 
-func (s *DatascienceModelProvenanceResourceCrud) Create() error {
+func (s *DatascienceModelProvenanceResourceCrud) Create(ctx context.Context) error {
 	request := oci_datascience.CreateModelProvenanceRequest{}
 
 	if gitBranch, ok := s.D.GetOkExists("git_branch"); ok {
@@ -153,7 +154,7 @@ func (s *DatascienceModelProvenanceResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.CreateModelProvenance(context.Background(), request)
+	response, err := s.Client.CreateModelProvenance(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -162,7 +163,7 @@ func (s *DatascienceModelProvenanceResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatascienceModelProvenanceResourceCrud) Get() error {
+func (s *DatascienceModelProvenanceResourceCrud) Get(ctx context.Context) error {
 	request := oci_datascience.GetModelProvenanceRequest{}
 
 	if modelId, ok := s.D.GetOkExists("model_id"); ok {
@@ -179,7 +180,7 @@ func (s *DatascienceModelProvenanceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.GetModelProvenance(context.Background(), request)
+	response, err := s.Client.GetModelProvenance(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -188,7 +189,7 @@ func (s *DatascienceModelProvenanceResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatascienceModelProvenanceResourceCrud) Update() error {
+func (s *DatascienceModelProvenanceResourceCrud) Update(ctx context.Context) error {
 	request := oci_datascience.UpdateModelProvenanceRequest{}
 
 	if gitBranch, ok := s.D.GetOkExists("git_branch"); ok {
@@ -228,7 +229,7 @@ func (s *DatascienceModelProvenanceResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.UpdateModelProvenance(context.Background(), request)
+	response, err := s.Client.UpdateModelProvenance(ctx, request)
 	if err != nil {
 		return err
 	}
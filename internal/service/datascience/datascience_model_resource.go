@@ -17,6 +17,7 @@ import (
 
 	"github.com/oracle/oci-go-sdk/v65/common"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -28,11 +29,11 @@ func DatascienceModelResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatascienceModel,
-		Read:     readDatascienceModel,
-		Update:   updateDatascienceModel,
-		Delete:   deleteDatascienceModel,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatascienceModel,
+		ReadContext:   readDatascienceModel,
+		UpdateContext: updateDatascienceModel,
+		DeleteContext: deleteDatascienceModel,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"artifact_content_length": {
@@ -203,7 +204,7 @@ func DatascienceModelResource() *schema.Resource {
 	}
 }
 
-func createDatascienceModel(d *schema.ResourceData, m interface{}) error {
+func createDatascienceModel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceModelResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
@@ -216,31 +217,31 @@ func createDatascienceModel(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	if e := tfresource.CreateResource(d, sync); e != nil {
-		return e
+	if e := tfresource.CreateResource(ctx, d, sync); e != nil {
+		return tfresource.HandleDiagError(e)
 	}
 	if deactivateModel {
-		if e := sync.DeactivateModel(); e != nil {
-			return e
+		if e := sync.DeactivateModel(ctx); e != nil {
+			return tfresource.HandleDiagError(e)
 		}
 		sync.D.Set("state", oci_datascience.ModelLifecycleStateInactive)
 	}
-	if e := sync.CreateArtifact(); e != nil {
-		return e
+	if e := sync.CreateArtifact(ctx); e != nil {
+		return tfresource.HandleDiagError(e)
 	}
 	sync.D.Set("empty_model", false)
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func readDatascienceModel(d *schema.ResourceData, m interface{}) error {
+func readDatascienceModel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceModelResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatascienceModel(d *schema.ResourceData, m interface{}) error {
+func updateDatascienceModel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceModelResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
@@ -264,31 +265,31 @@ func updateDatascienceModel(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if deactivate {
-		if err := sync.DeactivateModel(); err != nil {
-			return err
+		if err := sync.DeactivateModel(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		sync.D.Set("state", oci_datascience.ModelLifecycleStateInactive)
 	}
-	if err := tfresource.UpdateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.UpdateResource(ctx, d, sync); err != nil {
+		return tfresource.HandleDiagError(err)
 	}
 
 	if activate {
-		if err := sync.ActivateModel(); err != nil {
-			return err
+		if err := sync.ActivateModel(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		sync.D.Set("state", oci_datascience.ModelLifecycleStateActive)
 	}
 	return nil
 }
 
-func deleteDatascienceModel(d *schema.ResourceData, m interface{}) error {
+func deleteDatascienceModel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceModelResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type HeadModelArtifact struct {
@@ -330,7 +331,7 @@ func (s *DatascienceModelResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DatascienceModelResourceCrud) Create() error {
+func (s *DatascienceModelResourceCrud) Create(ctx context.Context) error {
 	request := oci_datascience.CreateModelRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -411,7 +412,7 @@ func (s *DatascienceModelResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.CreateModel(context.Background(), request)
+	response, err := s.Client.CreateModel(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -420,7 +421,7 @@ func (s *DatascienceModelResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatascienceModelResourceCrud) Get() error {
+func (s *DatascienceModelResourceCrud) Get(ctx context.Context) error {
 	request := oci_datascience.GetModelRequest{}
 
 	tmp := s.D.Id()
@@ -428,7 +429,7 @@ func (s *DatascienceModelResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.GetModel(context.Background(), request)
+	response, err := s.Client.GetModel(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -437,7 +438,7 @@ func (s *DatascienceModelResourceCrud) Get() error {
 	if emptyModel, ok := s.D.GetOkExists("empty_model"); ok {
 		tmp := emptyModel.(bool)
 		if !tmp {
-			err := s.GetArtifactHead()
+			err := s.GetArtifactHead(ctx)
 			if err != nil {
 				return err
 			}
@@ -447,11 +448,11 @@ func (s *DatascienceModelResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatascienceModelResourceCrud) Update() error {
+func (s *DatascienceModelResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -520,7 +521,7 @@ func (s *DatascienceModelResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.UpdateModel(context.Background(), request)
+	response, err := s.Client.UpdateModel(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -529,7 +530,7 @@ func (s *DatascienceModelResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DatascienceModelResourceCrud) Delete() error {
+func (s *DatascienceModelResourceCrud) Delete(ctx context.Context) error {
 	request := oci_datascience.DeleteModelRequest{}
 
 	tmp := s.D.Id()
@@ -537,7 +538,7 @@ func (s *DatascienceModelResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.DeleteModel(context.Background(), request)
+	_, err := s.Client.DeleteModel(ctx, request)
 	return err
 }
 
@@ -624,7 +625,7 @@ func (s *DatascienceModelResourceCrud) mapToMetadata(fieldKeyFormat string) (oci
 }
 
 /*
-  The New Mapping method is created for DefinedMetadataList to pass null values explicitly for 'category' and 'description'.
+The New Mapping method is created for DefinedMetadataList to pass null values explicitly for 'category' and 'description'.
 */
 func (s *DatascienceModelResourceCrud) mapToMetadataDefined(fieldKeyFormat string) (oci_datascience.Metadata, error) {
 	result := oci_datascience.Metadata{}
@@ -664,7 +665,7 @@ func MetadataToMap(obj oci_datascience.Metadata) map[string]interface{} {
 	return result
 }
 
-func (s *DatascienceModelResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DatascienceModelResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_datascience.ChangeModelCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -675,19 +676,19 @@ func (s *DatascienceModelResourceCrud) updateCompartment(compartment interface{}
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.ChangeModelCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeModelCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
 	return nil
 }
 
-func (s *DatascienceModelResourceCrud) ActivateModel() error {
+func (s *DatascienceModelResourceCrud) ActivateModel(ctx context.Context) error {
 	request := oci_datascience.ActivateModelRequest{}
 
 	tmp := s.D.Id()
@@ -695,16 +696,16 @@ func (s *DatascienceModelResourceCrud) ActivateModel() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.ActivateModel(context.Background(), request)
+	_, err := s.Client.ActivateModel(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_datascience.ModelLifecycleStateActive }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *DatascienceModelResourceCrud) DeactivateModel() error {
+func (s *DatascienceModelResourceCrud) DeactivateModel(ctx context.Context) error {
 	request := oci_datascience.DeactivateModelRequest{}
 
 	tmp := s.D.Id()
@@ -712,16 +713,16 @@ func (s *DatascienceModelResourceCrud) DeactivateModel() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.DeactivateModel(context.Background(), request)
+	_, err := s.Client.DeactivateModel(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_datascience.ModelLifecycleStateInactive }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *DatascienceModelResourceCrud) CreateArtifact() error {
+func (s *DatascienceModelResourceCrud) CreateArtifact(ctx context.Context) error {
 	request := oci_datascience.CreateModelArtifactRequest{}
 
 	if contentDisposition, ok := s.D.GetOkExists("artifact_content_disposition"); ok {
@@ -752,14 +753,14 @@ func (s *DatascienceModelResourceCrud) CreateArtifact() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.CreateModelArtifact(context.Background(), request)
+	_, err := s.Client.CreateModelArtifact(ctx, request)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (s *DatascienceModelResourceCrud) GetArtifactHead() error {
+func (s *DatascienceModelResourceCrud) GetArtifactHead(ctx context.Context) error {
 	request := oci_datascience.HeadModelArtifactRequest{}
 
 	tmp := s.D.Id()
@@ -767,7 +768,7 @@ func (s *DatascienceModelResourceCrud) GetArtifactHead() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "datascience")
 
-	response, err := s.Client.HeadModelArtifact(context.Background(), request)
+	response, err := s.Client.HeadModelArtifact(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_datascience "github.com/oracle/oci-go-sdk/v65/datascience"
 )
@@ -22,12 +23,12 @@ func DatascienceNotebookSessionDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatascienceNotebookSessionResource(), fieldMap, readSingularDatascienceNotebookSession)
 }
 
-func readSingularDatascienceNotebookSession(d *schema.ResourceData, m interface{}) error {
+func readSingularDatascienceNotebookSession(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceNotebookSessionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatascienceNotebookSessionDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DatascienceNotebookSessionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatascienceNotebookSessionDataSourceCrud) Get() error {
+func (s *DatascienceNotebookSessionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_datascience.GetNotebookSessionRequest{}
 
 	if notebookSessionId, ok := s.D.GetOkExists("notebook_session_id"); ok {
@@ -50,7 +51,7 @@ func (s *DatascienceNotebookSessionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datascience")
 
-	response, err := s.Client.GetNotebookSession(context.Background(), request)
+	response, err := s.Client.GetNotebookSession(ctx, request)
 	if err != nil {
 		return err
 	}
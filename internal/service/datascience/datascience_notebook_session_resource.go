@@ -11,6 +11,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -22,11 +23,11 @@ func DatascienceNotebookSessionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatascienceNotebookSession,
-		Read:     readDatascienceNotebookSession,
-		Update:   updateDatascienceNotebookSession,
-		Delete:   deleteDatascienceNotebookSession,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatascienceNotebookSession,
+		ReadContext:   readDatascienceNotebookSession,
+		UpdateContext: updateDatascienceNotebookSession,
+		DeleteContext: deleteDatascienceNotebookSession,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -264,7 +265,7 @@ func DatascienceNotebookSessionResource() *schema.Resource {
 	}
 }
 
-func createDatascienceNotebookSession(d *schema.ResourceData, m interface{}) error {
+func createDatascienceNotebookSession(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceNotebookSessionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
@@ -277,27 +278,27 @@ func createDatascienceNotebookSession(d *schema.ResourceData, m interface{}) err
 		}
 	}
 
-	if e := tfresource.CreateResource(d, sync); e != nil {
-		return e
+	if e := tfresource.CreateResource(ctx, d, sync); e != nil {
+		return tfresource.HandleDiagError(e)
 	}
 	if deactivateNotebookSession {
-		if e := sync.DeactivateNotebookSession(); e != nil {
-			return e
+		if e := sync.DeactivateNotebookSession(ctx); e != nil {
+			return tfresource.HandleDiagError(e)
 		}
 		sync.D.Set("state", oci_datascience.NotebookSessionLifecycleStateInactive)
 	}
 	return nil
 }
 
-func readDatascienceNotebookSession(d *schema.ResourceData, m interface{}) error {
+func readDatascienceNotebookSession(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceNotebookSessionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatascienceNotebookSession(d *schema.ResourceData, m interface{}) error {
+func updateDatascienceNotebookSession(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceNotebookSessionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
@@ -321,31 +322,31 @@ func updateDatascienceNotebookSession(d *schema.ResourceData, m interface{}) err
 	}
 
 	if deactivate {
-		if err := sync.DeactivateNotebookSession(); err != nil {
-			return err
+		if err := sync.DeactivateNotebookSession(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		sync.D.Set("state", oci_datascience.NotebookSessionLifecycleStateInactive)
 	}
-	if err := tfresource.UpdateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.UpdateResource(ctx, d, sync); err != nil {
+		return tfresource.HandleDiagError(err)
 	}
 
 	if activate {
-		if err := sync.ActivateNotebookSession(); err != nil {
-			return err
+		if err := sync.ActivateNotebookSession(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		sync.D.Set("state", oci_datascience.NotebookSessionLifecycleStateActive)
 	}
 	return nil
 }
 
-func deleteDatascienceNotebookSession(d *schema.ResourceData, m interface{}) error {
+func deleteDatascienceNotebookSession(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceNotebookSessionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatascienceNotebookSessionResourceCrud struct {
@@ -383,7 +384,7 @@ func (s *DatascienceNotebookSessionResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DatascienceNotebookSessionResourceCrud) Create() error {
+func (s *DatascienceNotebookSessionResourceCrud) Create(ctx context.Context) error {
 	request := oci_datascience.CreateNotebookSessionRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -448,7 +449,7 @@ func (s *DatascienceNotebookSessionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.CreateNotebookSession(context.Background(), request)
+	response, err := s.Client.CreateNotebookSession(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -457,7 +458,7 @@ func (s *DatascienceNotebookSessionResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatascienceNotebookSessionResourceCrud) Get() error {
+func (s *DatascienceNotebookSessionResourceCrud) Get(ctx context.Context) error {
 	request := oci_datascience.GetNotebookSessionRequest{}
 
 	tmp := s.D.Id()
@@ -465,7 +466,7 @@ func (s *DatascienceNotebookSessionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.GetNotebookSession(context.Background(), request)
+	response, err := s.Client.GetNotebookSession(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -474,11 +475,11 @@ func (s *DatascienceNotebookSessionResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatascienceNotebookSessionResourceCrud) Update() error {
+func (s *DatascienceNotebookSessionResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -530,7 +531,7 @@ func (s *DatascienceNotebookSessionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.UpdateNotebookSession(context.Background(), request)
+	response, err := s.Client.UpdateNotebookSession(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -539,7 +540,7 @@ func (s *DatascienceNotebookSessionResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DatascienceNotebookSessionResourceCrud) Delete() error {
+func (s *DatascienceNotebookSessionResourceCrud) Delete(ctx context.Context) error {
 	request := oci_datascience.DeleteNotebookSessionRequest{}
 
 	tmp := s.D.Id()
@@ -547,7 +548,7 @@ func (s *DatascienceNotebookSessionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.DeleteNotebookSession(context.Background(), request)
+	_, err := s.Client.DeleteNotebookSession(ctx, request)
 	return err
 }
 
@@ -836,7 +837,7 @@ func NotebookSessionShapeConfigDetailsToMap(obj *oci_datascience.NotebookSession
 	return result
 }
 
-func (s *DatascienceNotebookSessionResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DatascienceNotebookSessionResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_datascience.ChangeNotebookSessionCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -847,19 +848,19 @@ func (s *DatascienceNotebookSessionResourceCrud) updateCompartment(compartment i
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.ChangeNotebookSessionCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeNotebookSessionCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
 	return nil
 }
 
-func (s *DatascienceNotebookSessionResourceCrud) ActivateNotebookSession() error {
+func (s *DatascienceNotebookSessionResourceCrud) ActivateNotebookSession(ctx context.Context) error {
 	request := oci_datascience.ActivateNotebookSessionRequest{}
 
 	tmp := s.D.Id()
@@ -867,16 +868,16 @@ func (s *DatascienceNotebookSessionResourceCrud) ActivateNotebookSession() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.ActivateNotebookSession(context.Background(), request)
+	_, err := s.Client.ActivateNotebookSession(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_datascience.NotebookSessionLifecycleStateActive }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *DatascienceNotebookSessionResourceCrud) DeactivateNotebookSession() error {
+func (s *DatascienceNotebookSessionResourceCrud) DeactivateNotebookSession(ctx context.Context) error {
 	request := oci_datascience.DeactivateNotebookSessionRequest{}
 
 	tmp := s.D.Id()
@@ -884,11 +885,11 @@ func (s *DatascienceNotebookSessionResourceCrud) DeactivateNotebookSession() err
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.DeactivateNotebookSession(context.Background(), request)
+	_, err := s.Client.DeactivateNotebookSession(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_datascience.NotebookSessionLifecycleStateInactive }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_datascience "github.com/oracle/oci-go-sdk/v65/datascience"
 )
 
 func DatascienceNotebookSessionShapesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDatascienceNotebookSessionShapes,
+		ReadContext: readDatascienceNotebookSessionShapes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -55,12 +56,12 @@ func DatascienceNotebookSessionShapesDataSource() *schema.Resource {
 	}
 }
 
-func readDatascienceNotebookSessionShapes(d *schema.ResourceData, m interface{}) error {
+func readDatascienceNotebookSessionShapes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceNotebookSessionShapesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatascienceNotebookSessionShapesDataSourceCrud struct {
@@ -73,7 +74,7 @@ func (s *DatascienceNotebookSessionShapesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatascienceNotebookSessionShapesDataSourceCrud) Get() error {
+func (s *DatascienceNotebookSessionShapesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_datascience.ListNotebookSessionShapesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -83,7 +84,7 @@ func (s *DatascienceNotebookSessionShapesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datascience")
 
-	response, err := s.Client.ListNotebookSessionShapes(context.Background(), request)
+	response, err := s.Client.ListNotebookSessionShapes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -92,7 +93,7 @@ func (s *DatascienceNotebookSessionShapesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListNotebookSessionShapes(context.Background(), request)
+		listResponse, err := s.Client.ListNotebookSessionShapes(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_datascience "github.com/oracle/oci-go-sdk/v65/datascience"
 )
@@ -22,12 +23,12 @@ func DatascienceProjectDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DatascienceProjectResource(), fieldMap, readSingularDatascienceProject)
 }
 
-func readSingularDatascienceProject(d *schema.ResourceData, m interface{}) error {
+func readSingularDatascienceProject(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceProjectDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DatascienceProjectDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DatascienceProjectDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DatascienceProjectDataSourceCrud) Get() error {
+func (s *DatascienceProjectDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_datascience.GetProjectRequest{}
 
 	if projectId, ok := s.D.GetOkExists("project_id"); ok {
@@ -50,7 +51,7 @@ func (s *DatascienceProjectDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "datascience")
 
-	response, err := s.Client.GetProject(context.Background(), request)
+	response, err := s.Client.GetProject(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_datascience "github.com/oracle/oci-go-sdk/v65/datascience"
@@ -19,11 +20,11 @@ func DatascienceProjectResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDatascienceProject,
-		Read:     readDatascienceProject,
-		Update:   updateDatascienceProject,
-		Delete:   deleteDatascienceProject,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDatascienceProject,
+		ReadContext:   readDatascienceProject,
+		UpdateContext: updateDatascienceProject,
+		DeleteContext: deleteDatascienceProject,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -73,37 +74,37 @@ func DatascienceProjectResource() *schema.Resource {
 	}
 }
 
-func createDatascienceProject(d *schema.ResourceData, m interface{}) error {
+func createDatascienceProject(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceProjectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDatascienceProject(d *schema.ResourceData, m interface{}) error {
+func readDatascienceProject(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceProjectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDatascienceProject(d *schema.ResourceData, m interface{}) error {
+func updateDatascienceProject(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceProjectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDatascienceProject(d *schema.ResourceData, m interface{}) error {
+func deleteDatascienceProject(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DatascienceProjectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataScienceClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DatascienceProjectResourceCrud struct {
@@ -139,7 +140,7 @@ func (s *DatascienceProjectResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DatascienceProjectResourceCrud) Create() error {
+func (s *DatascienceProjectResourceCrud) Create(ctx context.Context) error {
 	request := oci_datascience.CreateProjectRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -171,7 +172,7 @@ func (s *DatascienceProjectResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.CreateProject(context.Background(), request)
+	response, err := s.Client.CreateProject(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -180,7 +181,7 @@ func (s *DatascienceProjectResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DatascienceProjectResourceCrud) Get() error {
+func (s *DatascienceProjectResourceCrud) Get(ctx context.Context) error {
 	request := oci_datascience.GetProjectRequest{}
 
 	tmp := s.D.Id()
@@ -188,7 +189,7 @@ func (s *DatascienceProjectResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.GetProject(context.Background(), request)
+	response, err := s.Client.GetProject(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -197,11 +198,11 @@ func (s *DatascienceProjectResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DatascienceProjectResourceCrud) Update() error {
+func (s *DatascienceProjectResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -236,7 +237,7 @@ func (s *DatascienceProjectResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	response, err := s.Client.UpdateProject(context.Background(), request)
+	response, err := s.Client.UpdateProject(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -245,7 +246,7 @@ func (s *DatascienceProjectResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DatascienceProjectResourceCrud) Delete() error {
+func (s *DatascienceProjectResourceCrud) Delete(ctx context.Context) error {
 	request := oci_datascience.DeleteProjectRequest{}
 
 	tmp := s.D.Id()
@@ -253,7 +254,7 @@ func (s *DatascienceProjectResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.DeleteProject(context.Background(), request)
+	_, err := s.Client.DeleteProject(ctx, request)
 	return err
 }
 
@@ -289,7 +290,7 @@ func (s *DatascienceProjectResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *DatascienceProjectResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DatascienceProjectResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_datascience.ChangeProjectCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -300,12 +301,12 @@ func (s *DatascienceProjectResourceCrud) updateCompartment(compartment interface
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "datascience")
 
-	_, err := s.Client.ChangeProjectCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeProjectCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func DevopsBuildPipelineStageResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDevopsBuildPipelineStage,
-		Read:     readDevopsBuildPipelineStage,
-		Update:   updateDevopsBuildPipelineStage,
-		Delete:   deleteDevopsBuildPipelineStage,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDevopsBuildPipelineStage,
+		ReadContext:   readDevopsBuildPipelineStage,
+		UpdateContext: updateDevopsBuildPipelineStage,
+		DeleteContext: deleteDevopsBuildPipelineStage,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"build_pipeline_id": {
@@ -346,37 +347,37 @@ func DevopsBuildPipelineStageResource() *schema.Resource {
 	}
 }
 
-func createDevopsBuildPipelineStage(d *schema.ResourceData, m interface{}) error {
+func createDevopsBuildPipelineStage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsBuildPipelineStageResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDevopsBuildPipelineStage(d *schema.ResourceData, m interface{}) error {
+func readDevopsBuildPipelineStage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsBuildPipelineStageResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDevopsBuildPipelineStage(d *schema.ResourceData, m interface{}) error {
+func updateDevopsBuildPipelineStage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsBuildPipelineStageResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDevopsBuildPipelineStage(d *schema.ResourceData, m interface{}) error {
+func deleteDevopsBuildPipelineStage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsBuildPipelineStageResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DevopsBuildPipelineStageResourceCrud struct {
@@ -415,7 +416,7 @@ func (s *DevopsBuildPipelineStageResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DevopsBuildPipelineStageResourceCrud) Create() error {
+func (s *DevopsBuildPipelineStageResourceCrud) Create(ctx context.Context) error {
 	request := oci_devops.CreateBuildPipelineStageRequest{}
 	err := s.populateTopLevelPolymorphicCreateBuildPipelineStageRequest(&request)
 	if err != nil {
@@ -424,20 +425,20 @@ func (s *DevopsBuildPipelineStageResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.CreateBuildPipelineStage(context.Background(), request)
+	response, err := s.Client.CreateBuildPipelineStage(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getBuildPipelineStageFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops"), oci_devops.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getBuildPipelineStageFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops"), oci_devops.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *DevopsBuildPipelineStageResourceCrud) getBuildPipelineStageFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *DevopsBuildPipelineStageResourceCrud) getBuildPipelineStageFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_devops.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	buildPipelineStageId, err := buildPipelineStageWaitForWorkRequest(workId, "buildPipelineStage",
+	buildPipelineStageId, err := buildPipelineStageWaitForWorkRequest(ctx, workId, "buildPipelineStage",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -445,7 +446,7 @@ func (s *DevopsBuildPipelineStageResourceCrud) getBuildPipelineStageFromWorkRequ
 	}
 	s.D.SetId(*buildPipelineStageId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func buildPipelineStageWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -471,7 +472,7 @@ func buildPipelineStageWorkRequestShouldRetryFunc(timeout time.Duration) func(re
 	}
 }
 
-func buildPipelineStageWaitForWorkRequest(wId *string, entityType string, action oci_devops.ActionTypeEnum,
+func buildPipelineStageWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_devops.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_devops.DevopsClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "devops")
 	retryPolicy.ShouldRetryOperation = buildPipelineStageWorkRequestShouldRetryFunc(timeout)
@@ -490,7 +491,7 @@ func buildPipelineStageWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_devops.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -519,14 +520,14 @@ func buildPipelineStageWaitForWorkRequest(wId *string, entityType string, action
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_devops.OperationStatusFailed || response.Status == oci_devops.OperationStatusCanceled {
-		return nil, getErrorFromDevopsBuildPipelineStageWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDevopsBuildPipelineStageWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDevopsBuildPipelineStageWorkRequest(client *oci_devops.DevopsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_devops.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDevopsBuildPipelineStageWorkRequest(ctx context.Context, client *oci_devops.DevopsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_devops.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_devops.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -548,7 +549,7 @@ func getErrorFromDevopsBuildPipelineStageWorkRequest(client *oci_devops.DevopsCl
 	return workRequestErr
 }
 
-func (s *DevopsBuildPipelineStageResourceCrud) Get() error {
+func (s *DevopsBuildPipelineStageResourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.GetBuildPipelineStageRequest{}
 
 	tmp := s.D.Id()
@@ -556,7 +557,7 @@ func (s *DevopsBuildPipelineStageResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.GetBuildPipelineStage(context.Background(), request)
+	response, err := s.Client.GetBuildPipelineStage(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -565,7 +566,7 @@ func (s *DevopsBuildPipelineStageResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DevopsBuildPipelineStageResourceCrud) Update() error {
+func (s *DevopsBuildPipelineStageResourceCrud) Update(ctx context.Context) error {
 	request := oci_devops.UpdateBuildPipelineStageRequest{}
 	err := s.populateTopLevelPolymorphicUpdateBuildPipelineStageRequest(&request)
 	if err != nil {
@@ -574,16 +575,16 @@ func (s *DevopsBuildPipelineStageResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.UpdateBuildPipelineStage(context.Background(), request)
+	response, err := s.Client.UpdateBuildPipelineStage(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getBuildPipelineStageFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops"), oci_devops.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getBuildPipelineStageFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops"), oci_devops.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *DevopsBuildPipelineStageResourceCrud) Delete() error {
+func (s *DevopsBuildPipelineStageResourceCrud) Delete(ctx context.Context) error {
 	request := oci_devops.DeleteBuildPipelineStageRequest{}
 
 	tmp := s.D.Id()
@@ -591,14 +592,14 @@ func (s *DevopsBuildPipelineStageResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.DeleteBuildPipelineStage(context.Background(), request)
+	response, err := s.Client.DeleteBuildPipelineStage(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := buildPipelineStageWaitForWorkRequest(workId, "buildPipelineStage",
+	_, delWorkRequestErr := buildPipelineStageWaitForWorkRequest(ctx, workId, "buildPipelineStage",
 		oci_devops.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -6,6 +6,7 @@ package devops
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -17,7 +18,7 @@ import (
 
 func DevopsBuildPipelineStagesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDevopsBuildPipelineStages,
+		ReadContext: readDevopsBuildPipelineStages,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"build_pipeline_id": {
@@ -309,12 +310,12 @@ func DevopsBuildPipelineStagesDataSource() *schema.Resource {
 	}
 }
 
-func readDevopsBuildPipelineStages(d *schema.ResourceData, m interface{}) error {
+func readDevopsBuildPipelineStages(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsBuildPipelineStagesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DevopsBuildPipelineStagesDataSourceCrud struct {
@@ -327,7 +328,7 @@ func (s *DevopsBuildPipelineStagesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DevopsBuildPipelineStagesDataSourceCrud) Get() error {
+func (s *DevopsBuildPipelineStagesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.ListBuildPipelineStagesRequest{}
 
 	if buildPipelineId, ok := s.D.GetOkExists("build_pipeline_id"); ok {
@@ -356,7 +357,7 @@ func (s *DevopsBuildPipelineStagesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.ListBuildPipelineStages(context.Background(), request)
+	response, err := s.Client.ListBuildPipelineStages(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -365,7 +366,7 @@ func (s *DevopsBuildPipelineStagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBuildPipelineStages(context.Background(), request)
+		listResponse, err := s.Client.ListBuildPipelineStages(ctx, request)
 		if err != nil {
 			return err
 		}
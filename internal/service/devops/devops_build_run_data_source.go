@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v65/devops"
 )
@@ -22,12 +23,12 @@ func DevopsBuildRunDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DevopsBuildRunResource(), fieldMap, readSingularDevopsBuildRun)
 }
 
-func readSingularDevopsBuildRun(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsBuildRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsBuildRunDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DevopsBuildRunDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DevopsBuildRunDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DevopsBuildRunDataSourceCrud) Get() error {
+func (s *DevopsBuildRunDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.GetBuildRunRequest{}
 
 	if buildRunId, ok := s.D.GetOkExists("build_run_id"); ok {
@@ -50,7 +51,7 @@ func (s *DevopsBuildRunDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.GetBuildRun(context.Background(), request)
+	response, err := s.Client.GetBuildRun(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
@@ -24,11 +25,11 @@ func DevopsBuildRunResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDevopsBuildRun,
-		Read:     readDevopsBuildRun,
-		Update:   updateDevopsBuildRun,
-		Delete:   deleteDevopsBuildRun,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDevopsBuildRun,
+		ReadContext:   readDevopsBuildRun,
+		UpdateContext: updateDevopsBuildRun,
+		DeleteContext: deleteDevopsBuildRun,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"build_pipeline_id": {
@@ -540,31 +541,31 @@ func DevopsBuildRunResource() *schema.Resource {
 	}
 }
 
-func createDevopsBuildRun(d *schema.ResourceData, m interface{}) error {
+func createDevopsBuildRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsBuildRunResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDevopsBuildRun(d *schema.ResourceData, m interface{}) error {
+func readDevopsBuildRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsBuildRunResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDevopsBuildRun(d *schema.ResourceData, m interface{}) error {
+func updateDevopsBuildRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsBuildRunResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDevopsBuildRun(d *schema.ResourceData, m interface{}) error {
+func deleteDevopsBuildRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -600,7 +601,7 @@ func (s *DevopsBuildRunResourceCrud) DeletedTarget() []string {
 	return []string{}
 }
 
-func (s *DevopsBuildRunResourceCrud) Create() error {
+func (s *DevopsBuildRunResourceCrud) Create(ctx context.Context) error {
 	request := oci_devops.CreateBuildRunRequest{}
 
 	if buildPipelineId, ok := s.D.GetOkExists("build_pipeline_id"); ok {
@@ -649,7 +650,7 @@ func (s *DevopsBuildRunResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.CreateBuildRun(context.Background(), request)
+	response, err := s.Client.CreateBuildRun(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -658,7 +659,7 @@ func (s *DevopsBuildRunResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DevopsBuildRunResourceCrud) Get() error {
+func (s *DevopsBuildRunResourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.GetBuildRunRequest{}
 
 	tmp := s.D.Id()
@@ -666,7 +667,7 @@ func (s *DevopsBuildRunResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.GetBuildRun(context.Background(), request)
+	response, err := s.Client.GetBuildRun(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -675,7 +676,7 @@ func (s *DevopsBuildRunResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DevopsBuildRunResourceCrud) Update() error {
+func (s *DevopsBuildRunResourceCrud) Update(ctx context.Context) error {
 	request := oci_devops.UpdateBuildRunRequest{}
 
 	tmp := s.D.Id()
@@ -700,7 +701,7 @@ func (s *DevopsBuildRunResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.UpdateBuildRun(context.Background(), request)
+	response, err := s.Client.UpdateBuildRun(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v65/devops"
 )
 
 func DevopsBuildRunsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDevopsBuildRuns,
+		ReadContext: readDevopsBuildRuns,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"build_pipeline_id": {
@@ -326,12 +327,12 @@ func DevopsBuildRunsDataSource() *schema.Resource {
 	}
 }
 
-func readDevopsBuildRuns(d *schema.ResourceData, m interface{}) error {
+func readDevopsBuildRuns(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsBuildRunsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DevopsBuildRunsDataSourceCrud struct {
@@ -344,7 +345,7 @@ func (s *DevopsBuildRunsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DevopsBuildRunsDataSourceCrud) Get() error {
+func (s *DevopsBuildRunsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.ListBuildRunsRequest{}
 
 	if buildPipelineId, ok := s.D.GetOkExists("build_pipeline_id"); ok {
@@ -378,7 +379,7 @@ func (s *DevopsBuildRunsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.ListBuildRuns(context.Background(), request)
+	response, err := s.Client.ListBuildRuns(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -387,7 +388,7 @@ func (s *DevopsBuildRunsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBuildRuns(context.Background(), request)
+		listResponse, err := s.Client.ListBuildRuns(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v65/devops"
 )
@@ -23,12 +24,12 @@ func DevopsConnectionDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DevopsConnectionResource(), fieldMap, readSingularDevopsConnection)
 }
 
-func readSingularDevopsConnection(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsConnectionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DevopsConnectionDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *DevopsConnectionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DevopsConnectionDataSourceCrud) Get() error {
+func (s *DevopsConnectionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.GetConnectionRequest{}
 
 	if connectionId, ok := s.D.GetOkExists("connection_id"); ok {
@@ -51,7 +52,7 @@ func (s *DevopsConnectionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.GetConnection(context.Background(), request)
+	response, err := s.Client.GetConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func DevopsConnectionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDevopsConnection,
-		Read:     readDevopsConnection,
-		Update:   updateDevopsConnection,
-		Delete:   deleteDevopsConnection,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDevopsConnection,
+		ReadContext:   readDevopsConnection,
+		UpdateContext: updateDevopsConnection,
+		DeleteContext: deleteDevopsConnection,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"connection_type": {
@@ -152,37 +153,37 @@ func DevopsConnectionResource() *schema.Resource {
 	}
 }
 
-func createDevopsConnection(d *schema.ResourceData, m interface{}) error {
+func createDevopsConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDevopsConnection(d *schema.ResourceData, m interface{}) error {
+func readDevopsConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDevopsConnection(d *schema.ResourceData, m interface{}) error {
+func updateDevopsConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDevopsConnection(d *schema.ResourceData, m interface{}) error {
+func deleteDevopsConnection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsConnectionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DevopsConnectionResourceCrud struct {
@@ -215,7 +216,7 @@ func (s *DevopsConnectionResourceCrud) DeletedTarget() []string {
 	return []string{}
 }
 
-func (s *DevopsConnectionResourceCrud) Create() error {
+func (s *DevopsConnectionResourceCrud) Create(ctx context.Context) error {
 	request := oci_devops.CreateConnectionRequest{}
 	err := s.populateTopLevelPolymorphicCreateConnectionRequest(&request)
 	if err != nil {
@@ -224,20 +225,20 @@ func (s *DevopsConnectionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.CreateConnection(context.Background(), request)
+	response, err := s.Client.CreateConnection(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getConnectionFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops"), oci_devops.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getConnectionFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops"), oci_devops.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *DevopsConnectionResourceCrud) getConnectionFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *DevopsConnectionResourceCrud) getConnectionFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_devops.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	connectionId, err := devopsConnectionWaitForWorkRequest(workId, "connection",
+	connectionId, err := devopsConnectionWaitForWorkRequest(ctx, workId, "connection",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -245,7 +246,7 @@ func (s *DevopsConnectionResourceCrud) getConnectionFromWorkRequest(workId *stri
 	}
 	s.D.SetId(*connectionId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func devopsConnectionWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -271,7 +272,7 @@ func devopsConnectionWorkRequestShouldRetryFunc(timeout time.Duration) func(resp
 	}
 }
 
-func devopsConnectionWaitForWorkRequest(wId *string, entityType string, action oci_devops.ActionTypeEnum,
+func devopsConnectionWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_devops.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_devops.DevopsClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "devops")
 	retryPolicy.ShouldRetryOperation = devopsConnectionWorkRequestShouldRetryFunc(timeout)
@@ -290,7 +291,7 @@ func devopsConnectionWaitForWorkRequest(wId *string, entityType string, action o
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_devops.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -319,14 +320,14 @@ func devopsConnectionWaitForWorkRequest(wId *string, entityType string, action o
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_devops.OperationStatusFailed || response.Status == oci_devops.OperationStatusCanceled {
-		return nil, getErrorFromDevopsConnectionWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDevopsConnectionWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDevopsConnectionWorkRequest(client *oci_devops.DevopsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_devops.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDevopsConnectionWorkRequest(ctx context.Context, client *oci_devops.DevopsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_devops.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_devops.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -348,7 +349,7 @@ func getErrorFromDevopsConnectionWorkRequest(client *oci_devops.DevopsClient, wo
 	return workRequestErr
 }
 
-func (s *DevopsConnectionResourceCrud) Get() error {
+func (s *DevopsConnectionResourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.GetConnectionRequest{}
 
 	tmp := s.D.Id()
@@ -356,7 +357,7 @@ func (s *DevopsConnectionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.GetConnection(context.Background(), request)
+	response, err := s.Client.GetConnection(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -365,7 +366,7 @@ func (s *DevopsConnectionResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DevopsConnectionResourceCrud) Update() error {
+func (s *DevopsConnectionResourceCrud) Update(ctx context.Context) error {
 	request := oci_devops.UpdateConnectionRequest{}
 	err := s.populateTopLevelPolymorphicUpdateConnectionRequest(&request)
 	if err != nil {
@@ -374,16 +375,16 @@ func (s *DevopsConnectionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.UpdateConnection(context.Background(), request)
+	response, err := s.Client.UpdateConnection(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getConnectionFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops"), oci_devops.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getConnectionFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops"), oci_devops.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *DevopsConnectionResourceCrud) Delete() error {
+func (s *DevopsConnectionResourceCrud) Delete(ctx context.Context) error {
 	request := oci_devops.DeleteConnectionRequest{}
 
 	tmp := s.D.Id()
@@ -391,14 +392,14 @@ func (s *DevopsConnectionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.DeleteConnection(context.Background(), request)
+	response, err := s.Client.DeleteConnection(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := devopsConnectionWaitForWorkRequest(workId, "connection",
+	_, delWorkRequestErr := devopsConnectionWaitForWorkRequest(ctx, workId, "connection",
 		oci_devops.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func DevopsDeployEnvironmentResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDevopsDeployEnvironment,
-		Read:     readDevopsDeployEnvironment,
-		Update:   updateDevopsDeployEnvironment,
-		Delete:   deleteDevopsDeployEnvironment,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDevopsDeployEnvironment,
+		ReadContext:   readDevopsDeployEnvironment,
+		UpdateContext: updateDevopsDeployEnvironment,
+		DeleteContext: deleteDevopsDeployEnvironment,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"deploy_environment_type": {
@@ -208,37 +209,37 @@ func DevopsDeployEnvironmentResource() *schema.Resource {
 	}
 }
 
-func createDevopsDeployEnvironment(d *schema.ResourceData, m interface{}) error {
+func createDevopsDeployEnvironment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsDeployEnvironmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDevopsDeployEnvironment(d *schema.ResourceData, m interface{}) error {
+func readDevopsDeployEnvironment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsDeployEnvironmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDevopsDeployEnvironment(d *schema.ResourceData, m interface{}) error {
+func updateDevopsDeployEnvironment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsDeployEnvironmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDevopsDeployEnvironment(d *schema.ResourceData, m interface{}) error {
+func deleteDevopsDeployEnvironment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsDeployEnvironmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DevopsDeployEnvironmentResourceCrud struct {
@@ -278,7 +279,7 @@ func (s *DevopsDeployEnvironmentResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DevopsDeployEnvironmentResourceCrud) Create() error {
+func (s *DevopsDeployEnvironmentResourceCrud) Create(ctx context.Context) error {
 	request := oci_devops.CreateDeployEnvironmentRequest{}
 	err := s.populateTopLevelPolymorphicCreateDeployEnvironmentRequest(&request)
 	if err != nil {
@@ -287,20 +288,20 @@ func (s *DevopsDeployEnvironmentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.CreateDeployEnvironment(context.Background(), request)
+	response, err := s.Client.CreateDeployEnvironment(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDeployEnvironmentFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops"), oci_devops.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getDeployEnvironmentFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops"), oci_devops.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *DevopsDeployEnvironmentResourceCrud) getDeployEnvironmentFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *DevopsDeployEnvironmentResourceCrud) getDeployEnvironmentFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_devops.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	deployEnvironmentId, err := deployEnvironmentWaitForWorkRequest(workId, "environment",
+	deployEnvironmentId, err := deployEnvironmentWaitForWorkRequest(ctx, workId, "environment",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -308,7 +309,7 @@ func (s *DevopsDeployEnvironmentResourceCrud) getDeployEnvironmentFromWorkReques
 	}
 	s.D.SetId(*deployEnvironmentId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func deployEnvironmentWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -334,7 +335,7 @@ func deployEnvironmentWorkRequestShouldRetryFunc(timeout time.Duration) func(res
 	}
 }
 
-func deployEnvironmentWaitForWorkRequest(wId *string, entityType string, action oci_devops.ActionTypeEnum,
+func deployEnvironmentWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_devops.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_devops.DevopsClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "devops")
 	retryPolicy.ShouldRetryOperation = deployEnvironmentWorkRequestShouldRetryFunc(timeout)
@@ -353,7 +354,7 @@ func deployEnvironmentWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_devops.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -382,14 +383,14 @@ func deployEnvironmentWaitForWorkRequest(wId *string, entityType string, action
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_devops.OperationStatusFailed {
-		return nil, getErrorFromDevopsDeployEnvironmentWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDevopsDeployEnvironmentWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDevopsDeployEnvironmentWorkRequest(client *oci_devops.DevopsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_devops.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDevopsDeployEnvironmentWorkRequest(ctx context.Context, client *oci_devops.DevopsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_devops.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_devops.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -411,7 +412,7 @@ func getErrorFromDevopsDeployEnvironmentWorkRequest(client *oci_devops.DevopsCli
 	return workRequestErr
 }
 
-func (s *DevopsDeployEnvironmentResourceCrud) Get() error {
+func (s *DevopsDeployEnvironmentResourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.GetDeployEnvironmentRequest{}
 
 	tmp := s.D.Id()
@@ -419,7 +420,7 @@ func (s *DevopsDeployEnvironmentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.GetDeployEnvironment(context.Background(), request)
+	response, err := s.Client.GetDeployEnvironment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -428,7 +429,7 @@ func (s *DevopsDeployEnvironmentResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DevopsDeployEnvironmentResourceCrud) Update() error {
+func (s *DevopsDeployEnvironmentResourceCrud) Update(ctx context.Context) error {
 	request := oci_devops.UpdateDeployEnvironmentRequest{}
 	err := s.populateTopLevelPolymorphicUpdateDeployEnvironmentRequest(&request)
 	if err != nil {
@@ -437,16 +438,16 @@ func (s *DevopsDeployEnvironmentResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.UpdateDeployEnvironment(context.Background(), request)
+	response, err := s.Client.UpdateDeployEnvironment(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDeployEnvironmentFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops"), oci_devops.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getDeployEnvironmentFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops"), oci_devops.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *DevopsDeployEnvironmentResourceCrud) Delete() error {
+func (s *DevopsDeployEnvironmentResourceCrud) Delete(ctx context.Context) error {
 	request := oci_devops.DeleteDeployEnvironmentRequest{}
 
 	tmp := s.D.Id()
@@ -454,14 +455,14 @@ func (s *DevopsDeployEnvironmentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.DeleteDeployEnvironment(context.Background(), request)
+	response, err := s.Client.DeleteDeployEnvironment(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := deployEnvironmentWaitForWorkRequest(workId, "environment",
+	_, delWorkRequestErr := deployEnvironmentWaitForWorkRequest(ctx, workId, "environment",
 		oci_devops.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,11 +25,11 @@ func DevopsDeployPipelineResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDevopsDeployPipeline,
-		Read:     readDevopsDeployPipeline,
-		Update:   updateDevopsDeployPipeline,
-		Delete:   deleteDevopsDeployPipeline,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDevopsDeployPipeline,
+		ReadContext:   readDevopsDeployPipeline,
+		UpdateContext: updateDevopsDeployPipeline,
+		DeleteContext: deleteDevopsDeployPipeline,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"project_id": {
@@ -271,37 +272,37 @@ func DevopsDeployPipelineResource() *schema.Resource {
 	}
 }
 
-func createDevopsDeployPipeline(d *schema.ResourceData, m interface{}) error {
+func createDevopsDeployPipeline(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsDeployPipelineResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDevopsDeployPipeline(d *schema.ResourceData, m interface{}) error {
+func readDevopsDeployPipeline(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsDeployPipelineResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDevopsDeployPipeline(d *schema.ResourceData, m interface{}) error {
+func updateDevopsDeployPipeline(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsDeployPipelineResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDevopsDeployPipeline(d *schema.ResourceData, m interface{}) error {
+func deleteDevopsDeployPipeline(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsDeployPipelineResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DevopsDeployPipelineResourceCrud struct {
@@ -339,7 +340,7 @@ func (s *DevopsDeployPipelineResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DevopsDeployPipelineResourceCrud) Create() error {
+func (s *DevopsDeployPipelineResourceCrud) Create(ctx context.Context) error {
 	request := oci_devops.CreateDeployPipelineRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -382,20 +383,20 @@ func (s *DevopsDeployPipelineResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.CreateDeployPipeline(context.Background(), request)
+	response, err := s.Client.CreateDeployPipeline(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDeployPipelineFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops"), oci_devops.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getDeployPipelineFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops"), oci_devops.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *DevopsDeployPipelineResourceCrud) getDeployPipelineFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *DevopsDeployPipelineResourceCrud) getDeployPipelineFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_devops.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	deployPipelineId, err := deployPipelineWaitForWorkRequest(workId, "deployPipeline",
+	deployPipelineId, err := deployPipelineWaitForWorkRequest(ctx, workId, "deployPipeline",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -403,7 +404,7 @@ func (s *DevopsDeployPipelineResourceCrud) getDeployPipelineFromWorkRequest(work
 	}
 	s.D.SetId(*deployPipelineId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func deployPipelineWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -429,7 +430,7 @@ func deployPipelineWorkRequestShouldRetryFunc(timeout time.Duration) func(respon
 	}
 }
 
-func deployPipelineWaitForWorkRequest(wId *string, entityType string, action oci_devops.ActionTypeEnum,
+func deployPipelineWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_devops.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_devops.DevopsClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "devops")
 	retryPolicy.ShouldRetryOperation = deployPipelineWorkRequestShouldRetryFunc(timeout)
@@ -448,7 +449,7 @@ func deployPipelineWaitForWorkRequest(wId *string, entityType string, action oci
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_devops.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -477,14 +478,14 @@ func deployPipelineWaitForWorkRequest(wId *string, entityType string, action oci
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_devops.OperationStatusFailed {
-		return nil, getErrorFromDevopsDeployPipelineWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDevopsDeployPipelineWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDevopsDeployPipelineWorkRequest(client *oci_devops.DevopsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_devops.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDevopsDeployPipelineWorkRequest(ctx context.Context, client *oci_devops.DevopsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_devops.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_devops.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -506,7 +507,7 @@ func getErrorFromDevopsDeployPipelineWorkRequest(client *oci_devops.DevopsClient
 	return workRequestErr
 }
 
-func (s *DevopsDeployPipelineResourceCrud) Get() error {
+func (s *DevopsDeployPipelineResourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.GetDeployPipelineRequest{}
 
 	tmp := s.D.Id()
@@ -514,7 +515,7 @@ func (s *DevopsDeployPipelineResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.GetDeployPipeline(context.Background(), request)
+	response, err := s.Client.GetDeployPipeline(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -523,7 +524,7 @@ func (s *DevopsDeployPipelineResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DevopsDeployPipelineResourceCrud) Update() error {
+func (s *DevopsDeployPipelineResourceCrud) Update(ctx context.Context) error {
 	request := oci_devops.UpdateDeployPipelineRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -564,16 +565,16 @@ func (s *DevopsDeployPipelineResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.UpdateDeployPipeline(context.Background(), request)
+	response, err := s.Client.UpdateDeployPipeline(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDeployPipelineFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops"), oci_devops.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getDeployPipelineFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops"), oci_devops.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *DevopsDeployPipelineResourceCrud) Delete() error {
+func (s *DevopsDeployPipelineResourceCrud) Delete(ctx context.Context) error {
 	request := oci_devops.DeleteDeployPipelineRequest{}
 
 	tmp := s.D.Id()
@@ -581,14 +582,14 @@ func (s *DevopsDeployPipelineResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.DeleteDeployPipeline(context.Background(), request)
+	response, err := s.Client.DeleteDeployPipeline(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := deployPipelineWaitForWorkRequest(workId, "deployPipeline",
+	_, delWorkRequestErr := deployPipelineWaitForWorkRequest(ctx, workId, "deployPipeline",
 		oci_devops.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v65/devops"
 )
@@ -24,12 +25,12 @@ func DevopsDeployStageDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DevopsDeployStageResource(), fieldMap, readSingularDevopsDeployStage)
 }
 
-func readSingularDevopsDeployStage(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsDeployStage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsDeployStageDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DevopsDeployStageDataSourceCrud struct {
@@ -42,7 +43,7 @@ func (s *DevopsDeployStageDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DevopsDeployStageDataSourceCrud) Get() error {
+func (s *DevopsDeployStageDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.GetDeployStageRequest{}
 
 	if deployStageId, ok := s.D.GetOkExists("deploy_stage_id"); ok {
@@ -52,7 +53,7 @@ func (s *DevopsDeployStageDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.GetDeployStage(context.Background(), request)
+	response, err := s.Client.GetDeployStage(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v65/devops"
 )
@@ -23,12 +24,12 @@ func DevopsDeploymentDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DevopsDeploymentResource(), fieldMap, readSingularDevopsDeployment)
 }
 
-func readSingularDevopsDeployment(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsDeployment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsDeploymentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DevopsDeploymentDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *DevopsDeploymentDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DevopsDeploymentDataSourceCrud) Get() error {
+func (s *DevopsDeploymentDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.GetDeploymentRequest{}
 
 	if deploymentId, ok := s.D.GetOkExists("deployment_id"); ok {
@@ -51,7 +52,7 @@ func (s *DevopsDeploymentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.GetDeployment(context.Background(), request)
+	response, err := s.Client.GetDeployment(ctx, request)
 	if err != nil {
 		return err
 	}
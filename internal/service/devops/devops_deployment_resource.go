@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -24,11 +25,11 @@ func DevopsDeploymentResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDevopsDeployment,
-		Read:     readDevopsDeployment,
-		Update:   updateDevopsDeployment,
-		Delete:   deleteDevopsDeployment,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDevopsDeployment,
+		ReadContext:   readDevopsDeployment,
+		UpdateContext: updateDevopsDeployment,
+		DeleteContext: deleteDevopsDeployment,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"deploy_pipeline_id": {
@@ -370,31 +371,31 @@ func DevopsDeploymentResource() *schema.Resource {
 	}
 }
 
-func createDevopsDeployment(d *schema.ResourceData, m interface{}) error {
+func createDevopsDeployment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsDeploymentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDevopsDeployment(d *schema.ResourceData, m interface{}) error {
+func readDevopsDeployment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsDeploymentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDevopsDeployment(d *schema.ResourceData, m interface{}) error {
+func updateDevopsDeployment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsDeploymentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDevopsDeployment(d *schema.ResourceData, m interface{}) error {
+func deleteDevopsDeployment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -431,7 +432,7 @@ func (s *DevopsDeploymentResourceCrud) DeletedTarget() []string {
 	return []string{}
 }
 
-func (s *DevopsDeploymentResourceCrud) Create() error {
+func (s *DevopsDeploymentResourceCrud) Create(ctx context.Context) error {
 	request := oci_devops.CreateDeploymentRequest{}
 	err := s.populateTopLevelPolymorphicCreateDeploymentRequest(&request)
 	if err != nil {
@@ -440,7 +441,7 @@ func (s *DevopsDeploymentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.CreateDeployment(context.Background(), request)
+	response, err := s.Client.CreateDeployment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -449,7 +450,7 @@ func (s *DevopsDeploymentResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DevopsDeploymentResourceCrud) Get() error {
+func (s *DevopsDeploymentResourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.GetDeploymentRequest{}
 
 	tmp := s.D.Id()
@@ -457,7 +458,7 @@ func (s *DevopsDeploymentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.GetDeployment(context.Background(), request)
+	response, err := s.Client.GetDeployment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -466,7 +467,7 @@ func (s *DevopsDeploymentResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DevopsDeploymentResourceCrud) Update() error {
+func (s *DevopsDeploymentResourceCrud) Update(ctx context.Context) error {
 	request := oci_devops.UpdateDeploymentRequest{}
 	err := s.populateTopLevelPolymorphicUpdateDeploymentRequest(&request)
 	if err != nil {
@@ -475,7 +476,7 @@ func (s *DevopsDeploymentResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.UpdateDeployment(context.Background(), request)
+	response, err := s.Client.UpdateDeployment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_devops "github.com/oracle/oci-go-sdk/v65/devops"
@@ -17,7 +18,7 @@ import (
 
 func DevopsDeploymentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDevopsDeployments,
+		ReadContext: readDevopsDeployments,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -70,12 +71,12 @@ func DevopsDeploymentsDataSource() *schema.Resource {
 	}
 }
 
-func readDevopsDeployments(d *schema.ResourceData, m interface{}) error {
+func readDevopsDeployments(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsDeploymentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DevopsDeploymentsDataSourceCrud struct {
@@ -88,7 +89,7 @@ func (s *DevopsDeploymentsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DevopsDeploymentsDataSourceCrud) Get() error {
+func (s *DevopsDeploymentsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.ListDeploymentsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -138,7 +139,7 @@ func (s *DevopsDeploymentsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.ListDeployments(context.Background(), request)
+	response, err := s.Client.ListDeployments(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -147,7 +148,7 @@ func (s *DevopsDeploymentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDeployments(context.Background(), request)
+		listResponse, err := s.Client.ListDeployments(ctx, request)
 		if err != nil {
 			return err
 		}
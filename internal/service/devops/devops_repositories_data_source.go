@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v65/devops"
 )
 
 func DevopsRepositoriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDevopsRepositories,
+		ReadContext: readDevopsRepositories,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -56,12 +57,12 @@ func DevopsRepositoriesDataSource() *schema.Resource {
 	}
 }
 
-func readDevopsRepositories(d *schema.ResourceData, m interface{}) error {
+func readDevopsRepositories(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DevopsRepositoriesDataSourceCrud struct {
@@ -74,7 +75,7 @@ func (s *DevopsRepositoriesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DevopsRepositoriesDataSourceCrud) Get() error {
+func (s *DevopsRepositoriesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.ListRepositoriesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -121,7 +122,7 @@ func (s *DevopsRepositoriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.ListRepositories(context.Background(), request)
+	response, err := s.Client.ListRepositories(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -130,7 +131,7 @@ func (s *DevopsRepositoriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRepositories(context.Background(), request)
+		listResponse, err := s.Client.ListRepositories(ctx, request)
 		if err != nil {
 			return err
 		}
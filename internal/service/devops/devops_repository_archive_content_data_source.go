@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v65/devops"
 )
 
 func DevopsRepositoryArchiveContentDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDevopsRepositoryArchiveContent,
+		ReadContext: readSingularDevopsRepositoryArchiveContent,
 		Schema: map[string]*schema.Schema{
 			"format": {
 				Type:     schema.TypeString,
@@ -34,12 +35,12 @@ func DevopsRepositoryArchiveContentDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDevopsRepositoryArchiveContent(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsRepositoryArchiveContent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryArchiveContentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DevopsRepositoryArchiveContentDataSourceCrud struct {
@@ -52,7 +53,7 @@ func (s *DevopsRepositoryArchiveContentDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DevopsRepositoryArchiveContentDataSourceCrud) Get() error {
+func (s *DevopsRepositoryArchiveContentDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.GetRepositoryArchiveContentRequest{}
 
 	if format, ok := s.D.GetOkExists("format"); ok {
@@ -72,7 +73,7 @@ func (s *DevopsRepositoryArchiveContentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.GetRepositoryArchiveContent(context.Background(), request)
+	response, err := s.Client.GetRepositoryArchiveContent(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v65/devops"
 )
 
 func DevopsRepositoryAuthorDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDevopsRepositoryAuthor,
+		ReadContext: readSingularDevopsRepositoryAuthor,
 		Schema: map[string]*schema.Schema{
 			"ref_name": {
 				Type:     schema.TypeString,
@@ -57,12 +58,12 @@ func DevopsRepositoryAuthorDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDevopsRepositoryAuthor(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsRepositoryAuthor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryAuthorDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DevopsRepositoryAuthorDataSourceCrud struct {
@@ -75,7 +76,7 @@ func (s *DevopsRepositoryAuthorDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DevopsRepositoryAuthorDataSourceCrud) Get() error {
+func (s *DevopsRepositoryAuthorDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.ListAuthorsRequest{}
 
 	if refName, ok := s.D.GetOkExists("ref_name"); ok {
@@ -90,7 +91,7 @@ func (s *DevopsRepositoryAuthorDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.ListAuthors(context.Background(), request)
+	response, err := s.Client.ListAuthors(ctx, request)
 	if err != nil {
 		return err
 	}
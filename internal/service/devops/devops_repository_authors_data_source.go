@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v65/devops"
 )
 
 func DevopsRepositoryAuthorsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDevopsRepositoryAuthors,
+		ReadContext: readDevopsRepositoryAuthors,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"ref_name": {
@@ -66,12 +67,12 @@ func DevopsRepositoryAuthorsDataSource() *schema.Resource {
 	}
 }
 
-func readDevopsRepositoryAuthors(d *schema.ResourceData, m interface{}) error {
+func readDevopsRepositoryAuthors(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryAuthorsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DevopsRepositoryAuthorsDataSourceCrud struct {
@@ -84,7 +85,7 @@ func (s *DevopsRepositoryAuthorsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DevopsRepositoryAuthorsDataSourceCrud) Get() error {
+func (s *DevopsRepositoryAuthorsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.ListAuthorsRequest{}
 
 	if refName, ok := s.D.GetOkExists("ref_name"); ok {
@@ -99,7 +100,7 @@ func (s *DevopsRepositoryAuthorsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.ListAuthors(context.Background(), request)
+	response, err := s.Client.ListAuthors(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -108,7 +109,7 @@ func (s *DevopsRepositoryAuthorsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAuthors(context.Background(), request)
+		listResponse, err := s.Client.ListAuthors(ctx, request)
 		if err != nil {
 			return err
 		}
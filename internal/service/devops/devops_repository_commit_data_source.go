@@ -6,6 +6,7 @@ package devops
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v65/devops"
 
@@ -15,7 +16,7 @@ import (
 
 func DevopsRepositoryCommitDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDevopsRepositoryCommit,
+		ReadContext: readSingularDevopsRepositoryCommit,
 		Schema: map[string]*schema.Schema{
 			"commit_id": {
 				Type:     schema.TypeString,
@@ -65,12 +66,12 @@ func DevopsRepositoryCommitDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDevopsRepositoryCommit(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsRepositoryCommit(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryCommitDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DevopsRepositoryCommitDataSourceCrud struct {
@@ -83,7 +84,7 @@ func (s *DevopsRepositoryCommitDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DevopsRepositoryCommitDataSourceCrud) Get() error {
+func (s *DevopsRepositoryCommitDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.GetCommitRequest{}
 
 	if commitId, ok := s.D.GetOkExists("commit_id"); ok {
@@ -98,7 +99,7 @@ func (s *DevopsRepositoryCommitDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.GetCommit(context.Background(), request)
+	response, err := s.Client.GetCommit(ctx, request)
 	if err != nil {
 		return err
 	}
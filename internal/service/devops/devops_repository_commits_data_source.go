@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_devops "github.com/oracle/oci-go-sdk/v65/devops"
@@ -17,7 +18,7 @@ import (
 
 func DevopsRepositoryCommitsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDevopsRepositoryCommits,
+		ReadContext: readDevopsRepositoryCommits,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"author_name": {
@@ -126,12 +127,12 @@ func DevopsRepositoryCommitsDataSource() *schema.Resource {
 	}
 }
 
-func readDevopsRepositoryCommits(d *schema.ResourceData, m interface{}) error {
+func readDevopsRepositoryCommits(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryCommitsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DevopsRepositoryCommitsDataSourceCrud struct {
@@ -144,7 +145,7 @@ func (s *DevopsRepositoryCommitsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DevopsRepositoryCommitsDataSourceCrud) Get() error {
+func (s *DevopsRepositoryCommitsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.ListCommitsRequest{}
 
 	if authorName, ok := s.D.GetOkExists("author_name"); ok {
@@ -195,7 +196,7 @@ func (s *DevopsRepositoryCommitsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.ListCommits(context.Background(), request)
+	response, err := s.Client.ListCommits(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -204,7 +205,7 @@ func (s *DevopsRepositoryCommitsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCommits(context.Background(), request)
+		listResponse, err := s.Client.ListCommits(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v65/devops"
 )
 
 func DevopsRepositoryDiffDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDevopsRepositoryDiff,
+		ReadContext: readSingularDevopsRepositoryDiff,
 		Schema: map[string]*schema.Schema{
 			"base_version": {
 				Type:     schema.TypeString,
@@ -145,12 +146,12 @@ func DevopsRepositoryDiffDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDevopsRepositoryDiff(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsRepositoryDiff(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryDiffDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DevopsRepositoryDiffDataSourceCrud struct {
@@ -163,7 +164,7 @@ func (s *DevopsRepositoryDiffDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DevopsRepositoryDiffDataSourceCrud) Get() error {
+func (s *DevopsRepositoryDiffDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.GetFileDiffRequest{}
 
 	if baseVersion, ok := s.D.GetOkExists("base_version"); ok {
@@ -193,7 +194,7 @@ func (s *DevopsRepositoryDiffDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.GetFileDiff(context.Background(), request)
+	response, err := s.Client.GetFileDiff(ctx, request)
 	if err != nil {
 		return err
 	}
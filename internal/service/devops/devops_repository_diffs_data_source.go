@@ -6,6 +6,7 @@ package devops
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v65/devops"
 
@@ -15,7 +16,7 @@ import (
 
 func DevopsRepositoryDiffsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDevopsRepositoryDiffs,
+		ReadContext: readDevopsRepositoryDiffs,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"base_version": {
@@ -167,12 +168,12 @@ func DevopsRepositoryDiffsDataSource() *schema.Resource {
 	}
 }
 
-func readDevopsRepositoryDiffs(d *schema.ResourceData, m interface{}) error {
+func readDevopsRepositoryDiffs(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryDiffsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DevopsRepositoryDiffsDataSourceCrud struct {
@@ -185,7 +186,7 @@ func (s *DevopsRepositoryDiffsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DevopsRepositoryDiffsDataSourceCrud) Get() error {
+func (s *DevopsRepositoryDiffsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.ListCommitDiffsRequest{}
 
 	if baseVersion, ok := s.D.GetOkExists("base_version"); ok {
@@ -210,7 +211,7 @@ func (s *DevopsRepositoryDiffsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.ListCommitDiffs(context.Background(), request)
+	response, err := s.Client.ListCommitDiffs(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -219,7 +220,7 @@ func (s *DevopsRepositoryDiffsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCommitDiffs(context.Background(), request)
+		listResponse, err := s.Client.ListCommitDiffs(ctx, request)
 		if err != nil {
 			return err
 		}
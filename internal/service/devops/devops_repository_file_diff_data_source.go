@@ -6,6 +6,7 @@ package devops
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v65/devops"
 
@@ -15,7 +16,7 @@ import (
 
 func DevopsRepositoryFileDiffDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDevopsRepositoryFileDiff,
+		ReadContext: readSingularDevopsRepositoryFileDiff,
 		Schema: map[string]*schema.Schema{
 			"base_version": {
 				Type:     schema.TypeString,
@@ -145,12 +146,12 @@ func DevopsRepositoryFileDiffDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDevopsRepositoryFileDiff(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsRepositoryFileDiff(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryFileDiffDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DevopsRepositoryFileDiffDataSourceCrud struct {
@@ -163,7 +164,7 @@ func (s *DevopsRepositoryFileDiffDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DevopsRepositoryFileDiffDataSourceCrud) Get() error {
+func (s *DevopsRepositoryFileDiffDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.GetRepoFileDiffRequest{}
 
 	if baseVersion, ok := s.D.GetOkExists("base_version"); ok {
@@ -193,7 +194,7 @@ func (s *DevopsRepositoryFileDiffDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.GetRepoFileDiff(context.Background(), request)
+	response, err := s.Client.GetRepoFileDiff(ctx, request)
 	if err != nil {
 		return err
 	}
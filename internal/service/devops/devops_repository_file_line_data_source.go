@@ -6,6 +6,7 @@ package devops
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v65/devops"
 
@@ -15,7 +16,7 @@ import (
 
 func DevopsRepositoryFileLineDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDevopsRepositoryFileLine,
+		ReadContext: readSingularDevopsRepositoryFileLine,
 		Schema: map[string]*schema.Schema{
 			"file_path": {
 				Type:     schema.TypeString,
@@ -59,12 +60,12 @@ func DevopsRepositoryFileLineDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDevopsRepositoryFileLine(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsRepositoryFileLine(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryFileLineDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DevopsRepositoryFileLineDataSourceCrud struct {
@@ -77,7 +78,7 @@ func (s *DevopsRepositoryFileLineDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DevopsRepositoryFileLineDataSourceCrud) Get() error {
+func (s *DevopsRepositoryFileLineDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.GetRepositoryFileLinesRequest{}
 
 	if filePath, ok := s.D.GetOkExists("file_path"); ok {
@@ -102,7 +103,7 @@ func (s *DevopsRepositoryFileLineDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.GetRepositoryFileLines(context.Background(), request)
+	response, err := s.Client.GetRepositoryFileLines(ctx, request)
 	if err != nil {
 		return err
 	}
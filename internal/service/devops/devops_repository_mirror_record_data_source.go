@@ -6,6 +6,7 @@ package devops
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v65/devops"
 
@@ -15,7 +16,7 @@ import (
 
 func DevopsRepositoryMirrorRecordDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDevopsRepositoryMirrorRecord,
+		ReadContext: readSingularDevopsRepositoryMirrorRecord,
 		Schema: map[string]*schema.Schema{
 			"mirror_record_type": {
 				Type:     schema.TypeString,
@@ -50,12 +51,12 @@ func DevopsRepositoryMirrorRecordDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDevopsRepositoryMirrorRecord(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsRepositoryMirrorRecord(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryMirrorRecordDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DevopsRepositoryMirrorRecordDataSourceCrud struct {
@@ -68,7 +69,7 @@ func (s *DevopsRepositoryMirrorRecordDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DevopsRepositoryMirrorRecordDataSourceCrud) Get() error {
+func (s *DevopsRepositoryMirrorRecordDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.GetMirrorRecordRequest{}
 
 	if mirrorRecordType, ok := s.D.GetOkExists("mirror_record_type"); ok {
@@ -82,7 +83,7 @@ func (s *DevopsRepositoryMirrorRecordDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.GetMirrorRecord(context.Background(), request)
+	response, err := s.Client.GetMirrorRecord(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package devops
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v65/devops"
 
@@ -15,7 +16,7 @@ import (
 
 func DevopsRepositoryMirrorRecordsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDevopsRepositoryMirrorRecords,
+		ReadContext: readDevopsRepositoryMirrorRecords,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"repository_id": {
@@ -72,12 +73,12 @@ func DevopsRepositoryMirrorRecordsDataSource() *schema.Resource {
 	}
 }
 
-func readDevopsRepositoryMirrorRecords(d *schema.ResourceData, m interface{}) error {
+func readDevopsRepositoryMirrorRecords(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryMirrorRecordsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DevopsRepositoryMirrorRecordsDataSourceCrud struct {
@@ -90,7 +91,7 @@ func (s *DevopsRepositoryMirrorRecordsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DevopsRepositoryMirrorRecordsDataSourceCrud) Get() error {
+func (s *DevopsRepositoryMirrorRecordsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.ListMirrorRecordsRequest{}
 
 	if repositoryId, ok := s.D.GetOkExists("repository_id"); ok {
@@ -100,7 +101,7 @@ func (s *DevopsRepositoryMirrorRecordsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.ListMirrorRecords(context.Background(), request)
+	response, err := s.Client.ListMirrorRecords(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -109,7 +110,7 @@ func (s *DevopsRepositoryMirrorRecordsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListMirrorRecords(context.Background(), request)
+		listResponse, err := s.Client.ListMirrorRecords(ctx, request)
 		if err != nil {
 			return err
 		}
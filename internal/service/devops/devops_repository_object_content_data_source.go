@@ -6,6 +6,7 @@ package devops
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v65/devops"
 
@@ -15,7 +16,7 @@ import (
 
 func DevopsRepositoryObjectContentDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDevopsRepositoryObjectContent,
+		ReadContext: readSingularDevopsRepositoryObjectContent,
 		Schema: map[string]*schema.Schema{
 			"file_path": {
 				Type:     schema.TypeString,
@@ -34,12 +35,12 @@ func DevopsRepositoryObjectContentDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDevopsRepositoryObjectContent(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsRepositoryObjectContent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryObjectContentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DevopsRepositoryObjectContentDataSourceCrud struct {
@@ -52,7 +53,7 @@ func (s *DevopsRepositoryObjectContentDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DevopsRepositoryObjectContentDataSourceCrud) Get() error {
+func (s *DevopsRepositoryObjectContentDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.GetObjectContentRequest{}
 
 	if filePath, ok := s.D.GetOkExists("file_path"); ok {
@@ -72,7 +73,7 @@ func (s *DevopsRepositoryObjectContentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.GetObjectContent(context.Background(), request)
+	response, err := s.Client.GetObjectContent(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v65/devops"
 
@@ -16,7 +17,7 @@ import (
 
 func DevopsRepositoryObjectDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDevopsRepositoryObject,
+		ReadContext: readSingularDevopsRepositoryObject,
 		Schema: map[string]*schema.Schema{
 			"file_path": {
 				Type:     schema.TypeString,
@@ -51,12 +52,12 @@ func DevopsRepositoryObjectDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDevopsRepositoryObject(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsRepositoryObject(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryObjectDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DevopsRepositoryObjectDataSourceCrud struct {
@@ -69,7 +70,7 @@ func (s *DevopsRepositoryObjectDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DevopsRepositoryObjectDataSourceCrud) Get() error {
+func (s *DevopsRepositoryObjectDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.GetObjectRequest{}
 
 	if filePath, ok := s.D.GetOkExists("file_path"); ok {
@@ -89,7 +90,7 @@ func (s *DevopsRepositoryObjectDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.GetObject(context.Background(), request)
+	response, err := s.Client.GetObject(ctx, request)
 	if err != nil {
 		return err
 	}
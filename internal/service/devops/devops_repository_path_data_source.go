@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v65/devops"
 
@@ -16,7 +17,7 @@ import (
 
 func DevopsRepositoryPathDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDevopsRepositoryPath,
+		ReadContext: readSingularDevopsRepositoryPath,
 		Schema: map[string]*schema.Schema{
 			"display_name": {
 				Type:     schema.TypeString,
@@ -90,12 +91,12 @@ func DevopsRepositoryPathDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDevopsRepositoryPath(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsRepositoryPath(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryPathDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DevopsRepositoryPathDataSourceCrud struct {
@@ -108,7 +109,7 @@ func (s *DevopsRepositoryPathDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DevopsRepositoryPathDataSourceCrud) Get() error {
+func (s *DevopsRepositoryPathDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.ListPathsRequest{}
 
 	if displayName, ok := s.D.GetOkExists("display_name"); ok {
@@ -138,7 +139,7 @@ func (s *DevopsRepositoryPathDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.ListPaths(context.Background(), request)
+	response, err := s.Client.ListPaths(ctx, request)
 	if err != nil {
 		return err
 	}
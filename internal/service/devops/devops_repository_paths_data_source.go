@@ -6,6 +6,7 @@ package devops
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v65/devops"
 
@@ -15,7 +16,7 @@ import (
 
 func DevopsRepositoryPathsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDevopsRepositoryPaths,
+		ReadContext: readDevopsRepositoryPaths,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"display_name": {
@@ -92,12 +93,12 @@ func DevopsRepositoryPathsDataSource() *schema.Resource {
 	}
 }
 
-func readDevopsRepositoryPaths(d *schema.ResourceData, m interface{}) error {
+func readDevopsRepositoryPaths(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryPathsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DevopsRepositoryPathsDataSourceCrud struct {
@@ -110,7 +111,7 @@ func (s *DevopsRepositoryPathsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DevopsRepositoryPathsDataSourceCrud) Get() error {
+func (s *DevopsRepositoryPathsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.ListPathsRequest{}
 
 	if displayName, ok := s.D.GetOkExists("display_name"); ok {
@@ -140,7 +141,7 @@ func (s *DevopsRepositoryPathsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.ListPaths(context.Background(), request)
+	response, err := s.Client.ListPaths(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -149,7 +150,7 @@ func (s *DevopsRepositoryPathsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPaths(context.Background(), request)
+		listResponse, err := s.Client.ListPaths(ctx, request)
 		if err != nil {
 			return err
 		}
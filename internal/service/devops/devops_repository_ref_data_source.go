@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v65/devops"
 
@@ -27,12 +28,12 @@ func DevopsRepositoryRefDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DevopsRepositoryRefResource(), fieldMap, readSingularDevopsRepositoryRef)
 }
 
-func readSingularDevopsRepositoryRef(d *schema.ResourceData, m interface{}) error {
+func readSingularDevopsRepositoryRef(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryRefDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DevopsRepositoryRefDataSourceCrud struct {
@@ -45,7 +46,7 @@ func (s *DevopsRepositoryRefDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DevopsRepositoryRefDataSourceCrud) Get() error {
+func (s *DevopsRepositoryRefDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.GetRefRequest{}
 
 	if refName, ok := s.D.GetOkExists("ref_name"); ok {
@@ -60,7 +61,7 @@ func (s *DevopsRepositoryRefDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.GetRef(context.Background(), request)
+	response, err := s.Client.GetRef(ctx, request)
 	if err != nil {
 		return err
 	}
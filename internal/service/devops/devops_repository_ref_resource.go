@@ -14,6 +14,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -25,11 +26,11 @@ func DevopsRepositoryRefResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDevopsRepositoryRef,
-		Read:     readDevopsRepositoryRef,
-		Update:   updateDevopsRepositoryRef,
-		Delete:   deleteDevopsRepositoryRef,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDevopsRepositoryRef,
+		ReadContext:   readDevopsRepositoryRef,
+		UpdateContext: updateDevopsRepositoryRef,
+		DeleteContext: deleteDevopsRepositoryRef,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"ref_name": {
@@ -83,37 +84,37 @@ func DevopsRepositoryRefResource() *schema.Resource {
 	}
 }
 
-func createDevopsRepositoryRef(d *schema.ResourceData, m interface{}) error {
+func createDevopsRepositoryRef(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryRefResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDevopsRepositoryRef(d *schema.ResourceData, m interface{}) error {
+func readDevopsRepositoryRef(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryRefResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDevopsRepositoryRef(d *schema.ResourceData, m interface{}) error {
+func updateDevopsRepositoryRef(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryRefResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDevopsRepositoryRef(d *schema.ResourceData, m interface{}) error {
+func deleteDevopsRepositoryRef(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryRefResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DevopsRepositoryRefResourceCrud struct {
@@ -128,7 +129,7 @@ func (s *DevopsRepositoryRefResourceCrud) ID() string {
 	return GetRepositoryRefCompositeId(*repositoryRef.GetRefName(), *repositoryRef.GetRepositoryId()) //TODO: is this unique
 }
 
-func (s *DevopsRepositoryRefResourceCrud) Create() error {
+func (s *DevopsRepositoryRefResourceCrud) Create(ctx context.Context) error {
 	request := oci_devops.PutRepositoryRefRequest{}
 	err := s.populateTopLevelPolymorphicPutRepositoryRefRequest(&request)
 	if err != nil {
@@ -147,7 +148,7 @@ func (s *DevopsRepositoryRefResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.PutRepositoryRef(context.Background(), request)
+	response, err := s.Client.PutRepositoryRef(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -156,7 +157,7 @@ func (s *DevopsRepositoryRefResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DevopsRepositoryRefResourceCrud) Get() error {
+func (s *DevopsRepositoryRefResourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.GetRefRequest{}
 
 	if refName, ok := s.D.GetOkExists("ref_name"); ok {
@@ -179,7 +180,7 @@ func (s *DevopsRepositoryRefResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.GetRef(context.Background(), request)
+	response, err := s.Client.GetRef(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -188,7 +189,7 @@ func (s *DevopsRepositoryRefResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DevopsRepositoryRefResourceCrud) Update() error {
+func (s *DevopsRepositoryRefResourceCrud) Update(ctx context.Context) error {
 	request := oci_devops.PutRepositoryRefRequest{}
 	err := s.populateTopLevelPolymorphicPutRepositoryRefRequest(&request)
 	if err != nil {
@@ -197,7 +198,7 @@ func (s *DevopsRepositoryRefResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.PutRepositoryRef(context.Background(), request)
+	response, err := s.Client.PutRepositoryRef(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -206,7 +207,7 @@ func (s *DevopsRepositoryRefResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DevopsRepositoryRefResourceCrud) Delete() error {
+func (s *DevopsRepositoryRefResourceCrud) Delete(ctx context.Context) error {
 	request := oci_devops.DeleteRefRequest{}
 
 	if refName, ok := s.D.GetOkExists("ref_name"); ok {
@@ -221,7 +222,7 @@ func (s *DevopsRepositoryRefResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	_, err := s.Client.DeleteRef(context.Background(), request)
+	_, err := s.Client.DeleteRef(ctx, request)
 	return err
 }
 
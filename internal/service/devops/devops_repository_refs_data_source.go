@@ -6,6 +6,7 @@ package devops
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_devops "github.com/oracle/oci-go-sdk/v65/devops"
 
@@ -15,7 +16,7 @@ import (
 
 func DevopsRepositoryRefsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDevopsRepositoryRefs,
+		ReadContext: readDevopsRepositoryRefs,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"commit_id": {
@@ -52,12 +53,12 @@ func DevopsRepositoryRefsDataSource() *schema.Resource {
 	}
 }
 
-func readDevopsRepositoryRefs(d *schema.ResourceData, m interface{}) error {
+func readDevopsRepositoryRefs(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsRepositoryRefsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DevopsRepositoryRefsDataSourceCrud struct {
@@ -70,7 +71,7 @@ func (s *DevopsRepositoryRefsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DevopsRepositoryRefsDataSourceCrud) Get() error {
+func (s *DevopsRepositoryRefsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.ListRefsRequest{}
 
 	if commitId, ok := s.D.GetOkExists("commit_id"); ok {
@@ -94,7 +95,7 @@ func (s *DevopsRepositoryRefsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "devops")
 
-	response, err := s.Client.ListRefs(context.Background(), request)
+	response, err := s.Client.ListRefs(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *DevopsRepositoryRefsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRefs(context.Background(), request)
+		listResponse, err := s.Client.ListRefs(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func DevopsTriggerResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDevopsTrigger,
-		Read:     readDevopsTrigger,
-		Update:   updateDevopsTrigger,
-		Delete:   deleteDevopsTrigger,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDevopsTrigger,
+		ReadContext:   readDevopsTrigger,
+		UpdateContext: updateDevopsTrigger,
+		DeleteContext: deleteDevopsTrigger,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"actions": {
@@ -280,37 +281,37 @@ func DevopsTriggerResource() *schema.Resource {
 	}
 }
 
-func createDevopsTrigger(d *schema.ResourceData, m interface{}) error {
+func createDevopsTrigger(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsTriggerResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDevopsTrigger(d *schema.ResourceData, m interface{}) error {
+func readDevopsTrigger(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsTriggerResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDevopsTrigger(d *schema.ResourceData, m interface{}) error {
+func updateDevopsTrigger(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsTriggerResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDevopsTrigger(d *schema.ResourceData, m interface{}) error {
+func deleteDevopsTrigger(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DevopsTriggerResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DevopsTriggerResourceCrud struct {
@@ -343,7 +344,7 @@ func (s *DevopsTriggerResourceCrud) DeletedTarget() []string {
 	return []string{}
 }
 
-func (s *DevopsTriggerResourceCrud) Create() error {
+func (s *DevopsTriggerResourceCrud) Create(ctx context.Context) error {
 	request := oci_devops.CreateTriggerRequest{}
 	err := s.populateTopLevelPolymorphicCreateTriggerRequest(&request)
 	if err != nil {
@@ -352,20 +353,20 @@ func (s *DevopsTriggerResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.CreateTrigger(context.Background(), request)
+	response, err := s.Client.CreateTrigger(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getTriggerFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops"), oci_devops.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getTriggerFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops"), oci_devops.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *DevopsTriggerResourceCrud) getTriggerFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *DevopsTriggerResourceCrud) getTriggerFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_devops.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	triggerId, err := triggerWaitForWorkRequest(workId, "trigger",
+	triggerId, err := triggerWaitForWorkRequest(ctx, workId, "trigger",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -373,7 +374,7 @@ func (s *DevopsTriggerResourceCrud) getTriggerFromWorkRequest(workId *string, re
 	}
 	s.D.SetId(*triggerId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func triggerWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -399,7 +400,7 @@ func triggerWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_
 	}
 }
 
-func triggerWaitForWorkRequest(wId *string, entityType string, action oci_devops.ActionTypeEnum,
+func triggerWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_devops.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_devops.DevopsClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "devops")
 	retryPolicy.ShouldRetryOperation = triggerWorkRequestShouldRetryFunc(timeout)
@@ -418,7 +419,7 @@ func triggerWaitForWorkRequest(wId *string, entityType string, action oci_devops
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_devops.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -447,14 +448,14 @@ func triggerWaitForWorkRequest(wId *string, entityType string, action oci_devops
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_devops.OperationStatusFailed || response.Status == oci_devops.OperationStatusCanceled {
-		return nil, getErrorFromDevopsTriggerWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromDevopsTriggerWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromDevopsTriggerWorkRequest(client *oci_devops.DevopsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_devops.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromDevopsTriggerWorkRequest(ctx context.Context, client *oci_devops.DevopsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_devops.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_devops.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -476,7 +477,7 @@ func getErrorFromDevopsTriggerWorkRequest(client *oci_devops.DevopsClient, workI
 	return workRequestErr
 }
 
-func (s *DevopsTriggerResourceCrud) Get() error {
+func (s *DevopsTriggerResourceCrud) Get(ctx context.Context) error {
 	request := oci_devops.GetTriggerRequest{}
 
 	tmp := s.D.Id()
@@ -484,7 +485,7 @@ func (s *DevopsTriggerResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.GetTrigger(context.Background(), request)
+	response, err := s.Client.GetTrigger(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -493,7 +494,7 @@ func (s *DevopsTriggerResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DevopsTriggerResourceCrud) Update() error {
+func (s *DevopsTriggerResourceCrud) Update(ctx context.Context) error {
 	request := oci_devops.UpdateTriggerRequest{}
 	err := s.populateTopLevelPolymorphicUpdateTriggerRequest(&request)
 	if err != nil {
@@ -502,16 +503,16 @@ func (s *DevopsTriggerResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.UpdateTrigger(context.Background(), request)
+	response, err := s.Client.UpdateTrigger(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getTriggerFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops"), oci_devops.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getTriggerFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops"), oci_devops.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *DevopsTriggerResourceCrud) Delete() error {
+func (s *DevopsTriggerResourceCrud) Delete(ctx context.Context) error {
 	request := oci_devops.DeleteTriggerRequest{}
 
 	tmp := s.D.Id()
@@ -519,14 +520,14 @@ func (s *DevopsTriggerResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
 
-	response, err := s.Client.DeleteTrigger(context.Background(), request)
+	response, err := s.Client.DeleteTrigger(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := triggerWaitForWorkRequest(workId, "trigger",
+	_, delWorkRequestErr := triggerWaitForWorkRequest(ctx, workId, "trigger",
 		oci_devops.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
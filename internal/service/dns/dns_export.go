@@ -10,6 +10,7 @@ import (
 	oci_dns "github.com/oracle/oci-go-sdk/v65/dns"
 
 	tf_export "github.com/oracle/terraform-provider-oci/internal/commonexport"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 )
 
 func init() {
@@ -94,7 +95,7 @@ func findDnsRrset(ctx *tf_export.ResourceDiscoveryContext, tfMeta *tf_export.Ter
 			d := recordResource.TestResourceData()
 			zoneId := parent.Id
 			d.SetId(getRrsetCompositeId(domain, rtype, zoneId))
-			if err := recordResource.Read(d, ctx.Clients); err != nil {
+			if err := tfresource.ReadSchemaResource(recordResource, d, ctx.Clients); err != nil {
 				rdError := &tf_export.ResourceDiscoveryError{ResourceType: tfMeta.ResourceClass, ParentResource: parent.TerraformName, Error: err, ResourceGraph: resourceGraph}
 				ctx.AddErrorToList(rdError)
 				continue
@@ -18,6 +18,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"net"
@@ -28,11 +29,11 @@ import (
 
 func DnsRecordResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDnsRecord,
-		Read:     readDnsRecord,
-		Update:   updateDnsRecord,
-		Delete:   deleteDnsRecord,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDnsRecord,
+		ReadContext:   readDnsRecord,
+		UpdateContext: updateDnsRecord,
+		DeleteContext: deleteDnsRecord,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"zone_name_or_id": {
@@ -92,37 +93,37 @@ func DnsRecordResource() *schema.Resource {
 	}
 }
 
-func createDnsRecord(d *schema.ResourceData, m interface{}) error {
+func createDnsRecord(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsRecordResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDnsRecord(d *schema.ResourceData, m interface{}) error {
+func readDnsRecord(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsRecordResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDnsRecord(d *schema.ResourceData, m interface{}) error {
+func updateDnsRecord(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsRecordResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDnsRecord(d *schema.ResourceData, m interface{}) error {
+func deleteDnsRecord(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsRecordResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DnsRecordResourceCrud struct {
@@ -136,7 +137,7 @@ func (s *DnsRecordResourceCrud) ID() string {
 	return s.D.Get("record_hash").(string)
 }
 
-func (s *DnsRecordResourceCrud) Create() error {
+func (s *DnsRecordResourceCrud) Create(ctx context.Context) error {
 	request := oci_dns.PatchRRSetRequest{}
 	ro := oci_dns.RecordOperation{Operation: oci_dns.RecordOperationOperationAdd}
 
@@ -169,7 +170,7 @@ func (s *DnsRecordResourceCrud) Create() error {
 	request.Items = []oci_dns.RecordOperation{ro}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
-	response, err := s.Client.PatchRRSet(context.Background(), request)
+	response, err := s.Client.PatchRRSet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -180,7 +181,7 @@ func (s *DnsRecordResourceCrud) Create() error {
 
 	if err != nil {
 		//PatchZoneRecords only returns 50 records we need to do a Get with pagination to make sure we can't find the item
-		err = s.Get()
+		err = s.Get(ctx)
 		if err == nil {
 			return nil
 		}
@@ -198,14 +199,14 @@ func (s *DnsRecordResourceCrud) Create() error {
 
 	s.Res = item
 
-	if waitErr := tfresource.WaitForCreatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForCreatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
 	return nil
 }
 
-func (s *DnsRecordResourceCrud) Get() error {
+func (s *DnsRecordResourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.GetRRSetRequest{}
 
 	zoneNameOrId := s.D.Get("zone_name_or_id").(string)
@@ -226,7 +227,7 @@ func (s *DnsRecordResourceCrud) Get() error {
 
 	var err error
 	for true {
-		response, err := s.Client.GetRRSet(context.Background(), request)
+		response, err := s.Client.GetRRSet(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -243,7 +244,7 @@ func (s *DnsRecordResourceCrud) Get() error {
 	return err
 }
 
-func (s *DnsRecordResourceCrud) Update() error {
+func (s *DnsRecordResourceCrud) Update(ctx context.Context) error {
 	zoneNameOrId := s.D.Get("zone_name_or_id").(string)
 	domain := s.D.Get("domain").(string)
 	rtype := s.D.Get("rtype").(string)
@@ -275,7 +276,7 @@ func (s *DnsRecordResourceCrud) Update() error {
 	request.Items = []oci_dns.RecordOperation{removeOp, addOp}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
-	response, err := s.Client.PatchRRSet(context.Background(), request)
+	response, err := s.Client.PatchRRSet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -283,7 +284,7 @@ func (s *DnsRecordResourceCrud) Update() error {
 
 	if err != nil {
 		//PatchZoneRecords only returns 50 records we need to do a Get with pagination to make sure we can't find the item
-		err = s.Get()
+		err = s.Get(ctx)
 		if err == nil {
 			return nil
 		}
@@ -293,14 +294,14 @@ func (s *DnsRecordResourceCrud) Update() error {
 	s.Res = item
 
 	// This update does not support work-request
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
 	return nil
 }
 
-func (s *DnsRecordResourceCrud) Delete() error {
+func (s *DnsRecordResourceCrud) Delete(ctx context.Context) error {
 	request := oci_dns.PatchRRSetRequest{}
 	ro := oci_dns.RecordOperation{Operation: oci_dns.RecordOperationOperationRemove}
 
@@ -326,7 +327,7 @@ func (s *DnsRecordResourceCrud) Delete() error {
 	request.Items = []oci_dns.RecordOperation{ro}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
-	_, err := s.Client.PatchRRSet(context.Background(), request)
+	_, err := s.Client.PatchRRSet(ctx, request)
 	return err
 }
 
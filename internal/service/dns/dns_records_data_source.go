@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dns "github.com/oracle/oci-go-sdk/v65/dns"
 )
 
 func DnsRecordsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDnsRecords,
+		ReadContext: readDnsRecords,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 
@@ -66,12 +67,12 @@ func DnsRecordsDataSource() *schema.Resource {
 	}
 }
 
-func readDnsRecords(d *schema.ResourceData, m interface{}) error {
+func readDnsRecords(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsRecordsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DnsRecordsDataSourceCrud struct {
@@ -84,7 +85,7 @@ func (s *DnsRecordsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DnsRecordsDataSourceCrud) Get() error {
+func (s *DnsRecordsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.GetZoneRecordsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -129,7 +130,7 @@ func (s *DnsRecordsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
 
-	response, err := s.Client.GetZoneRecords(context.Background(), request)
+	response, err := s.Client.GetZoneRecords(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -138,7 +139,7 @@ func (s *DnsRecordsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.GetZoneRecords(context.Background(), request)
+		listResponse, err := s.Client.GetZoneRecords(ctx, request)
 		if err != nil {
 			return err
 		}
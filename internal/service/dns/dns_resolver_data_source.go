@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dns "github.com/oracle/oci-go-sdk/v65/dns"
 )
@@ -26,12 +27,12 @@ func DnsResolverDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DnsResolverResource(), fieldMap, readSingularDnsResolver)
 }
 
-func readSingularDnsResolver(d *schema.ResourceData, m interface{}) error {
+func readSingularDnsResolver(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsResolverDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DnsResolverDataSourceCrud struct {
@@ -44,7 +45,7 @@ func (s *DnsResolverDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DnsResolverDataSourceCrud) Get() error {
+func (s *DnsResolverDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.GetResolverRequest{}
 
 	if resolverId, ok := s.D.GetOkExists("resolver_id"); ok {
@@ -58,7 +59,7 @@ func (s *DnsResolverDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
 
-	response, err := s.Client.GetResolver(context.Background(), request)
+	response, err := s.Client.GetResolver(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dns "github.com/oracle/oci-go-sdk/v65/dns"
 )
@@ -31,12 +32,12 @@ func DnsResolverEndpointDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DnsResolverEndpointResource(), fieldMap, readSingularDnsResolverEndpoint)
 }
 
-func readSingularDnsResolverEndpoint(d *schema.ResourceData, m interface{}) error {
+func readSingularDnsResolverEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsResolverEndpointDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DnsResolverEndpointDataSourceCrud struct {
@@ -49,7 +50,7 @@ func (s *DnsResolverEndpointDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DnsResolverEndpointDataSourceCrud) Get() error {
+func (s *DnsResolverEndpointDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.GetResolverEndpointRequest{}
 
 	if resolverEndpointName, ok := s.D.GetOkExists("resolver_endpoint_name"); ok {
@@ -68,7 +69,7 @@ func (s *DnsResolverEndpointDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
 
-	response, err := s.Client.GetResolverEndpoint(context.Background(), request)
+	response, err := s.Client.GetResolverEndpoint(ctx, request)
 	if err != nil {
 		return err
 	}
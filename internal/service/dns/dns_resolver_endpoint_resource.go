@@ -14,6 +14,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -25,11 +26,11 @@ func DnsResolverEndpointResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDnsResolverEndpoint,
-		Read:     readDnsResolverEndpoint,
-		Update:   updateDnsResolverEndpoint,
-		Delete:   deleteDnsResolverEndpoint,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDnsResolverEndpoint,
+		ReadContext:   readDnsResolverEndpoint,
+		UpdateContext: updateDnsResolverEndpoint,
+		DeleteContext: deleteDnsResolverEndpoint,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"is_forwarding": {
@@ -120,37 +121,37 @@ func DnsResolverEndpointResource() *schema.Resource {
 	}
 }
 
-func createDnsResolverEndpoint(d *schema.ResourceData, m interface{}) error {
+func createDnsResolverEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsResolverEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDnsResolverEndpoint(d *schema.ResourceData, m interface{}) error {
+func readDnsResolverEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsResolverEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDnsResolverEndpoint(d *schema.ResourceData, m interface{}) error {
+func updateDnsResolverEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsResolverEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDnsResolverEndpoint(d *schema.ResourceData, m interface{}) error {
+func deleteDnsResolverEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsResolverEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DnsResolverEndpointResourceCrud struct {
@@ -200,7 +201,7 @@ func (s *DnsResolverEndpointResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DnsResolverEndpointResourceCrud) Create() error {
+func (s *DnsResolverEndpointResourceCrud) Create(ctx context.Context) error {
 	request := oci_dns.CreateResolverEndpointRequest{}
 	err := s.populateTopLevelPolymorphicCreateResolverEndpointRequest(&request)
 	if err != nil {
@@ -209,7 +210,7 @@ func (s *DnsResolverEndpointResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.CreateResolverEndpoint(context.Background(), request)
+	response, err := s.Client.CreateResolverEndpoint(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -218,7 +219,7 @@ func (s *DnsResolverEndpointResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DnsResolverEndpointResourceCrud) Get() error {
+func (s *DnsResolverEndpointResourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.GetResolverEndpointRequest{}
 
 	if resolverEndpointName, ok := s.D.GetOkExists("name"); ok {
@@ -248,7 +249,7 @@ func (s *DnsResolverEndpointResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.GetResolverEndpoint(context.Background(), request)
+	response, err := s.Client.GetResolverEndpoint(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -257,7 +258,7 @@ func (s *DnsResolverEndpointResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DnsResolverEndpointResourceCrud) Update() error {
+func (s *DnsResolverEndpointResourceCrud) Update(ctx context.Context) error {
 	request := oci_dns.UpdateResolverEndpointRequest{}
 	err := s.populateTopLevelPolymorphicUpdateResolverEndpointRequest(&request)
 	if err != nil {
@@ -266,7 +267,7 @@ func (s *DnsResolverEndpointResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateResolverEndpoint(context.Background(), request)
+	response, err := s.Client.UpdateResolverEndpoint(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -275,7 +276,7 @@ func (s *DnsResolverEndpointResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DnsResolverEndpointResourceCrud) Delete() error {
+func (s *DnsResolverEndpointResourceCrud) Delete(ctx context.Context) error {
 	request := oci_dns.DeleteResolverEndpointRequest{}
 
 	if resolverEndpointName, ok := s.D.GetOkExists("name"); ok {
@@ -294,7 +295,7 @@ func (s *DnsResolverEndpointResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.DeleteResolverEndpoint(context.Background(), request)
+	_, err := s.Client.DeleteResolverEndpoint(ctx, request)
 	return err
 }
 
@@ -10,13 +10,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dns "github.com/oracle/oci-go-sdk/v65/dns"
 )
 
 func DnsResolverEndpointsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDnsResolverEndpoints,
+		ReadContext: readDnsResolverEndpoints,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"name": {
@@ -44,12 +45,12 @@ func DnsResolverEndpointsDataSource() *schema.Resource {
 	}
 }
 
-func readDnsResolverEndpoints(d *schema.ResourceData, m interface{}) error {
+func readDnsResolverEndpoints(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsResolverEndpointsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DnsResolverEndpointsDataSourceCrud struct {
@@ -62,7 +63,7 @@ func (s *DnsResolverEndpointsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DnsResolverEndpointsDataSourceCrud) Get() error {
+func (s *DnsResolverEndpointsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.ListResolverEndpointsRequest{}
 
 	if name, ok := s.D.GetOkExists("name"); ok {
@@ -85,7 +86,7 @@ func (s *DnsResolverEndpointsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
 
-	response, err := s.Client.ListResolverEndpoints(context.Background(), request)
+	response, err := s.Client.ListResolverEndpoints(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *DnsResolverEndpointsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListResolverEndpoints(context.Background(), request)
+		listResponse, err := s.Client.ListResolverEndpoints(ctx, request)
 		if err != nil {
 			return err
 		}
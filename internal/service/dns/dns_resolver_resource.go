@@ -14,6 +14,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -25,11 +26,11 @@ func DnsResolverResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDnsResolver,
-		Read:     readDnsResolver,
-		Update:   updateDnsResolver,
-		Delete:   deleteDnsResolver,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDnsResolver,
+		ReadContext:   readDnsResolver,
+		UpdateContext: updateDnsResolver,
+		DeleteContext: deleteDnsResolver,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"resolver_id": {
@@ -228,25 +229,25 @@ func DnsResolverResource() *schema.Resource {
 	}
 }
 
-func createDnsResolver(d *schema.ResourceData, m interface{}) error {
+func createDnsResolver(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsResolverResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 	compartment, ok := sync.D.GetOkExists("compartment_id")
 
-	err := tfresource.CreateResource(d, sync)
+	err := tfresource.CreateResource(ctx, d, sync)
 	if err != nil {
-		return err
+		return tfresource.HandleDiagError(err)
 	}
 
 	if ok && compartment != *sync.Res.CompartmentId {
-		err = sync.updateCompartment(compartment)
+		err = sync.updateCompartment(ctx, compartment)
 		if err != nil {
-			return err
+			return tfresource.HandleDiagError(err)
 		}
 		tmp := compartment.(string)
 		sync.Res.CompartmentId = &tmp
-		err := sync.Get()
+		err := sync.Get(ctx)
 		if err != nil {
 			log.Printf("error doing a Get() after compartment Update: %v", err)
 		}
@@ -258,29 +259,29 @@ func createDnsResolver(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func readDnsResolver(d *schema.ResourceData, m interface{}) error {
+func readDnsResolver(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsResolverResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDnsResolver(d *schema.ResourceData, m interface{}) error {
+func updateDnsResolver(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsResolverResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDnsResolver(d *schema.ResourceData, m interface{}) error {
+func deleteDnsResolver(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsResolverResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 	// This will call the Delete function which internally calls the updateResolver dns API operation in order to clear properties of the resolver.
 	// DeleteResolver is not a public facing dns operation. Resolvers are deleted when their corresponding VCN is deleted.
-	return sync.Delete()
+	return tfresource.HandleDiagError(sync.Delete(ctx))
 }
 
 type DnsResolverResourceCrud struct {
@@ -330,7 +331,7 @@ func (s *DnsResolverResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DnsResolverResourceCrud) Create() error {
+func (s *DnsResolverResourceCrud) Create(ctx context.Context) error {
 	request := oci_dns.UpdateResolverRequest{}
 
 	if attachedViews, ok := s.D.GetOkExists("attached_views"); ok {
@@ -395,7 +396,7 @@ func (s *DnsResolverResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateResolver(context.Background(), request)
+	response, err := s.Client.UpdateResolver(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -403,14 +404,14 @@ func (s *DnsResolverResourceCrud) Create() error {
 	s.Res = &response.Resolver
 	s.D.SetId(s.ID())
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
 	return nil
 }
 
-func (s *DnsResolverResourceCrud) Get() error {
+func (s *DnsResolverResourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.GetResolverRequest{}
 
 	tmp := s.D.Id()
@@ -433,7 +434,7 @@ func (s *DnsResolverResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.GetResolver(context.Background(), request)
+	response, err := s.Client.GetResolver(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -442,11 +443,11 @@ func (s *DnsResolverResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DnsResolverResourceCrud) Update() error {
+func (s *DnsResolverResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -514,7 +515,7 @@ func (s *DnsResolverResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateResolver(context.Background(), request)
+	response, err := s.Client.UpdateResolver(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -526,7 +527,7 @@ func (s *DnsResolverResourceCrud) Update() error {
 
 // The Delete function below checks if existing resolver has attachedViews, rules, defined tags and/or freeform tags. If any of these property is present then
 // the function calls dns updateResolver API and drops the AttachedViews and Rules and clears defined tags and freeform tags.
-func (s *DnsResolverResourceCrud) Delete() error {
+func (s *DnsResolverResourceCrud) Delete(ctx context.Context) error {
 	var hasAttachedViews, hasRules, hasDefinedtags, hasFreeformTags bool
 
 	// ...check if the existing resolver has attachedViews
@@ -584,7 +585,7 @@ func (s *DnsResolverResourceCrud) Delete() error {
 
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-		response, err := s.Client.UpdateResolver(context.Background(), request)
+		response, err := s.Client.UpdateResolver(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -820,7 +821,7 @@ func ResolverRuleToMap(obj oci_dns.ResolverRule) map[string]interface{} {
 	return result
 }
 
-func (s *DnsResolverResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DnsResolverResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_dns.ChangeResolverCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -835,12 +836,12 @@ func (s *DnsResolverResourceCrud) updateCompartment(compartment interface{}) err
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.ChangeResolverCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeResolverCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
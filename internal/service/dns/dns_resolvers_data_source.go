@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dns "github.com/oracle/oci-go-sdk/v65/dns"
 )
 
 func DnsResolversDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDnsResolvers,
+		ReadContext: readDnsResolvers,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -47,12 +48,12 @@ func DnsResolversDataSource() *schema.Resource {
 	}
 }
 
-func readDnsResolvers(d *schema.ResourceData, m interface{}) error {
+func readDnsResolvers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsResolversDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DnsResolversDataSourceCrud struct {
@@ -65,7 +66,7 @@ func (s *DnsResolversDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DnsResolversDataSourceCrud) Get() error {
+func (s *DnsResolversDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.ListResolversRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -93,7 +94,7 @@ func (s *DnsResolversDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
 
-	response, err := s.Client.ListResolvers(context.Background(), request)
+	response, err := s.Client.ListResolvers(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -102,7 +103,7 @@ func (s *DnsResolversDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListResolvers(context.Background(), request)
+		listResponse, err := s.Client.ListResolvers(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dns "github.com/oracle/oci-go-sdk/v65/dns"
 )
@@ -46,12 +47,12 @@ func DnsRrsetDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DnsRrsetResource(), fieldMap, readSingularDnsRrset)
 }
 
-func readSingularDnsRrset(d *schema.ResourceData, m interface{}) error {
+func readSingularDnsRrset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsRrsetDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DnsRrsetDataSourceCrud struct {
@@ -64,7 +65,7 @@ func (s *DnsRrsetDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DnsRrsetDataSourceCrud) Get() error {
+func (s *DnsRrsetDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.GetRRSetRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -103,7 +104,7 @@ func (s *DnsRrsetDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
 
-	response, err := s.Client.GetRRSet(context.Background(), request)
+	response, err := s.Client.GetRRSet(ctx, request)
 	if err != nil {
 		return err
 	}
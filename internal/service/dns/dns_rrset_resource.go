@@ -16,6 +16,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_dns "github.com/oracle/oci-go-sdk/v65/dns"
@@ -26,11 +27,11 @@ func DnsRrsetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDnsRrset,
-		Read:     readDnsRrset,
-		Update:   updateDnsRrset,
-		Delete:   deleteDnsRrset,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDnsRrset,
+		ReadContext:   readDnsRrset,
+		UpdateContext: updateDnsRrset,
+		DeleteContext: deleteDnsRrset,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"domain": {
@@ -120,37 +121,37 @@ func DnsRrsetResource() *schema.Resource {
 	}
 }
 
-func createDnsRrset(d *schema.ResourceData, m interface{}) error {
+func createDnsRrset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsRrsetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDnsRrset(d *schema.ResourceData, m interface{}) error {
+func readDnsRrset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsRrsetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDnsRrset(d *schema.ResourceData, m interface{}) error {
+func updateDnsRrset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsRrsetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDnsRrset(d *schema.ResourceData, m interface{}) error {
+func deleteDnsRrset(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsRrsetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DnsRrsetResourceCrud struct {
@@ -164,7 +165,7 @@ func (s *DnsRrsetResourceCrud) ID() string {
 	return getRrsetCompositeId(s.D.Get("domain").(string), s.D.Get("rtype").(string), s.D.Get("zone_name_or_id").(string))
 }
 
-func (s *DnsRrsetResourceCrud) Create() error {
+func (s *DnsRrsetResourceCrud) Create(ctx context.Context) error {
 	request := oci_dns.UpdateRRSetRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -217,7 +218,7 @@ func (s *DnsRrsetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateRRSet(context.Background(), request)
+	response, err := s.Client.UpdateRRSet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -229,7 +230,7 @@ func (s *DnsRrsetResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DnsRrsetResourceCrud) Get() error {
+func (s *DnsRrsetResourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.GetRRSetRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -278,7 +279,7 @@ func (s *DnsRrsetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.GetRRSet(context.Background(), request)
+	response, err := s.Client.GetRRSet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -287,7 +288,7 @@ func (s *DnsRrsetResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DnsRrsetResourceCrud) Update() error {
+func (s *DnsRrsetResourceCrud) Update(ctx context.Context) error {
 	request := oci_dns.UpdateRRSetRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -340,7 +341,7 @@ func (s *DnsRrsetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateRRSet(context.Background(), request)
+	response, err := s.Client.UpdateRRSet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -352,7 +353,7 @@ func (s *DnsRrsetResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DnsRrsetResourceCrud) Delete() error {
+func (s *DnsRrsetResourceCrud) Delete(ctx context.Context) error {
 	// To ensure terraform does not attempt to delete protected records, which
 	// will fail, determine whether all records in the rrset are protected,
 	// whether some are protected and some are not, or whether none are
@@ -420,7 +421,7 @@ func (s *DnsRrsetResourceCrud) Delete() error {
 
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-		_, err := s.Client.DeleteRRSet(context.Background(), request)
+		_, err := s.Client.DeleteRRSet(ctx, request)
 		return err
 	}
 
@@ -487,7 +488,7 @@ func (s *DnsRrsetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateRRSet(context.Background(), request)
+	response, err := s.Client.UpdateRRSet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_dns "github.com/oracle/oci-go-sdk/v65/dns"
@@ -17,7 +18,7 @@ import (
 
 func DnsSteeringPoliciesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDnsSteeringPolicies,
+		ReadContext: readDnsSteeringPolicies,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -65,12 +66,12 @@ func DnsSteeringPoliciesDataSource() *schema.Resource {
 	}
 }
 
-func readDnsSteeringPolicies(d *schema.ResourceData, m interface{}) error {
+func readDnsSteeringPolicies(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsSteeringPoliciesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DnsSteeringPoliciesDataSourceCrud struct {
@@ -83,7 +84,7 @@ func (s *DnsSteeringPoliciesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DnsSteeringPoliciesDataSourceCrud) Get() error {
+func (s *DnsSteeringPoliciesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.ListSteeringPoliciesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -138,7 +139,7 @@ func (s *DnsSteeringPoliciesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
 
-	response, err := s.Client.ListSteeringPolicies(context.Background(), request)
+	response, err := s.Client.ListSteeringPolicies(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -147,7 +148,7 @@ func (s *DnsSteeringPoliciesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSteeringPolicies(context.Background(), request)
+		listResponse, err := s.Client.ListSteeringPolicies(ctx, request)
 		if err != nil {
 			return err
 		}
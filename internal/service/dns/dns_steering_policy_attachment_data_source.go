@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dns "github.com/oracle/oci-go-sdk/v65/dns"
 )
@@ -22,12 +23,12 @@ func DnsSteeringPolicyAttachmentDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DnsSteeringPolicyAttachmentResource(), fieldMap, readSingularDnsSteeringPolicyAttachment)
 }
 
-func readSingularDnsSteeringPolicyAttachment(d *schema.ResourceData, m interface{}) error {
+func readSingularDnsSteeringPolicyAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsSteeringPolicyAttachmentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DnsSteeringPolicyAttachmentDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DnsSteeringPolicyAttachmentDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DnsSteeringPolicyAttachmentDataSourceCrud) Get() error {
+func (s *DnsSteeringPolicyAttachmentDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.GetSteeringPolicyAttachmentRequest{}
 
 	if steeringPolicyAttachmentId, ok := s.D.GetOkExists("steering_policy_attachment_id"); ok {
@@ -50,7 +51,7 @@ func (s *DnsSteeringPolicyAttachmentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
 
-	response, err := s.Client.GetSteeringPolicyAttachment(context.Background(), request)
+	response, err := s.Client.GetSteeringPolicyAttachment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_dns "github.com/oracle/oci-go-sdk/v65/dns"
@@ -19,11 +20,11 @@ func DnsSteeringPolicyAttachmentResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDnsSteeringPolicyAttachment,
-		Read:     readDnsSteeringPolicyAttachment,
-		Update:   updateDnsSteeringPolicyAttachment,
-		Delete:   deleteDnsSteeringPolicyAttachment,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDnsSteeringPolicyAttachment,
+		ReadContext:   readDnsSteeringPolicyAttachment,
+		UpdateContext: updateDnsSteeringPolicyAttachment,
+		DeleteContext: deleteDnsSteeringPolicyAttachment,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"domain_name": {
@@ -78,37 +79,37 @@ func DnsSteeringPolicyAttachmentResource() *schema.Resource {
 	}
 }
 
-func createDnsSteeringPolicyAttachment(d *schema.ResourceData, m interface{}) error {
+func createDnsSteeringPolicyAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsSteeringPolicyAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDnsSteeringPolicyAttachment(d *schema.ResourceData, m interface{}) error {
+func readDnsSteeringPolicyAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsSteeringPolicyAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDnsSteeringPolicyAttachment(d *schema.ResourceData, m interface{}) error {
+func updateDnsSteeringPolicyAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsSteeringPolicyAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDnsSteeringPolicyAttachment(d *schema.ResourceData, m interface{}) error {
+func deleteDnsSteeringPolicyAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsSteeringPolicyAttachmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DnsSteeringPolicyAttachmentResourceCrud struct {
@@ -144,7 +145,7 @@ func (s *DnsSteeringPolicyAttachmentResourceCrud) DeletedTarget() []string {
 	return []string{}
 }
 
-func (s *DnsSteeringPolicyAttachmentResourceCrud) Create() error {
+func (s *DnsSteeringPolicyAttachmentResourceCrud) Create(ctx context.Context) error {
 	request := oci_dns.CreateSteeringPolicyAttachmentRequest{}
 
 	if displayName, ok := s.D.GetOkExists("display_name"); ok {
@@ -169,7 +170,7 @@ func (s *DnsSteeringPolicyAttachmentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.CreateSteeringPolicyAttachment(context.Background(), request)
+	response, err := s.Client.CreateSteeringPolicyAttachment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -178,7 +179,7 @@ func (s *DnsSteeringPolicyAttachmentResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DnsSteeringPolicyAttachmentResourceCrud) Get() error {
+func (s *DnsSteeringPolicyAttachmentResourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.GetSteeringPolicyAttachmentRequest{}
 
 	tmp := s.D.Id()
@@ -186,7 +187,7 @@ func (s *DnsSteeringPolicyAttachmentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.GetSteeringPolicyAttachment(context.Background(), request)
+	response, err := s.Client.GetSteeringPolicyAttachment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -195,7 +196,7 @@ func (s *DnsSteeringPolicyAttachmentResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DnsSteeringPolicyAttachmentResourceCrud) Update() error {
+func (s *DnsSteeringPolicyAttachmentResourceCrud) Update(ctx context.Context) error {
 	request := oci_dns.UpdateSteeringPolicyAttachmentRequest{}
 
 	if displayName, ok := s.D.GetOkExists("display_name"); ok {
@@ -208,7 +209,7 @@ func (s *DnsSteeringPolicyAttachmentResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateSteeringPolicyAttachment(context.Background(), request)
+	response, err := s.Client.UpdateSteeringPolicyAttachment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -217,7 +218,7 @@ func (s *DnsSteeringPolicyAttachmentResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DnsSteeringPolicyAttachmentResourceCrud) Delete() error {
+func (s *DnsSteeringPolicyAttachmentResourceCrud) Delete(ctx context.Context) error {
 	request := oci_dns.DeleteSteeringPolicyAttachmentRequest{}
 
 	tmp := s.D.Id()
@@ -225,7 +226,7 @@ func (s *DnsSteeringPolicyAttachmentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.DeleteSteeringPolicyAttachment(context.Background(), request)
+	_, err := s.Client.DeleteSteeringPolicyAttachment(ctx, request)
 	return err
 }
 
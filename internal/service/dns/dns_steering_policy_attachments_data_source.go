@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_dns "github.com/oracle/oci-go-sdk/v65/dns"
@@ -17,7 +18,7 @@ import (
 
 func DnsSteeringPolicyAttachmentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDnsSteeringPolicyAttachments,
+		ReadContext: readDnsSteeringPolicyAttachments,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -69,12 +70,12 @@ func DnsSteeringPolicyAttachmentsDataSource() *schema.Resource {
 	}
 }
 
-func readDnsSteeringPolicyAttachments(d *schema.ResourceData, m interface{}) error {
+func readDnsSteeringPolicyAttachments(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsSteeringPolicyAttachmentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DnsSteeringPolicyAttachmentsDataSourceCrud struct {
@@ -87,7 +88,7 @@ func (s *DnsSteeringPolicyAttachmentsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DnsSteeringPolicyAttachmentsDataSourceCrud) Get() error {
+func (s *DnsSteeringPolicyAttachmentsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.ListSteeringPolicyAttachmentsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -147,7 +148,7 @@ func (s *DnsSteeringPolicyAttachmentsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
 
-	response, err := s.Client.ListSteeringPolicyAttachments(context.Background(), request)
+	response, err := s.Client.ListSteeringPolicyAttachments(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -156,7 +157,7 @@ func (s *DnsSteeringPolicyAttachmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSteeringPolicyAttachments(context.Background(), request)
+		listResponse, err := s.Client.ListSteeringPolicyAttachments(ctx, request)
 		if err != nil {
 			return err
 		}
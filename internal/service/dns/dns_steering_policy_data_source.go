@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dns "github.com/oracle/oci-go-sdk/v65/dns"
 )
@@ -22,12 +23,12 @@ func DnsSteeringPolicyDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DnsSteeringPolicyResource(), fieldMap, readSingularDnsSteeringPolicy)
 }
 
-func readSingularDnsSteeringPolicy(d *schema.ResourceData, m interface{}) error {
+func readSingularDnsSteeringPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsSteeringPolicyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DnsSteeringPolicyDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DnsSteeringPolicyDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DnsSteeringPolicyDataSourceCrud) Get() error {
+func (s *DnsSteeringPolicyDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.GetSteeringPolicyRequest{}
 
 	if steeringPolicyId, ok := s.D.GetOkExists("steering_policy_id"); ok {
@@ -50,7 +51,7 @@ func (s *DnsSteeringPolicyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
 
-	response, err := s.Client.GetSteeringPolicy(context.Background(), request)
+	response, err := s.Client.GetSteeringPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
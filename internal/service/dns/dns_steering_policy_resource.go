@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -23,11 +24,11 @@ func DnsSteeringPolicyResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDnsSteeringPolicy,
-		Read:     readDnsSteeringPolicy,
-		Update:   updateDnsSteeringPolicy,
-		Delete:   deleteDnsSteeringPolicy,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDnsSteeringPolicy,
+		ReadContext:   readDnsSteeringPolicy,
+		UpdateContext: updateDnsSteeringPolicy,
+		DeleteContext: deleteDnsSteeringPolicy,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -260,37 +261,37 @@ func DnsSteeringPolicyResource() *schema.Resource {
 	}
 }
 
-func createDnsSteeringPolicy(d *schema.ResourceData, m interface{}) error {
+func createDnsSteeringPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsSteeringPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDnsSteeringPolicy(d *schema.ResourceData, m interface{}) error {
+func readDnsSteeringPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsSteeringPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDnsSteeringPolicy(d *schema.ResourceData, m interface{}) error {
+func updateDnsSteeringPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsSteeringPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDnsSteeringPolicy(d *schema.ResourceData, m interface{}) error {
+func deleteDnsSteeringPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsSteeringPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DnsSteeringPolicyResourceCrud struct {
@@ -328,7 +329,7 @@ func (s *DnsSteeringPolicyResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DnsSteeringPolicyResourceCrud) Create() error {
+func (s *DnsSteeringPolicyResourceCrud) Create(ctx context.Context) error {
 	request := oci_dns.CreateSteeringPolicyRequest{}
 
 	if answers, ok := s.D.GetOkExists("answers"); ok {
@@ -403,21 +404,21 @@ func (s *DnsSteeringPolicyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.CreateSteeringPolicy(context.Background(), request)
+	response, err := s.Client.CreateSteeringPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.SteeringPolicy
 
-	if waitErr := tfresource.WaitForCreatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForCreatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
 	return nil
 }
 
-func (s *DnsSteeringPolicyResourceCrud) Get() error {
+func (s *DnsSteeringPolicyResourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.GetSteeringPolicyRequest{}
 
 	tmp := s.D.Id()
@@ -425,7 +426,7 @@ func (s *DnsSteeringPolicyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.GetSteeringPolicy(context.Background(), request)
+	response, err := s.Client.GetSteeringPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -434,11 +435,11 @@ func (s *DnsSteeringPolicyResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DnsSteeringPolicyResourceCrud) Update() error {
+func (s *DnsSteeringPolicyResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -482,7 +483,7 @@ func (s *DnsSteeringPolicyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateSteeringPolicy(context.Background(), request)
+	response, err := s.Client.UpdateSteeringPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -490,14 +491,14 @@ func (s *DnsSteeringPolicyResourceCrud) Update() error {
 	s.Res = &response.SteeringPolicy
 
 	// This update does not support work-request
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
 	return nil
 }
 
-func (s *DnsSteeringPolicyResourceCrud) Delete() error {
+func (s *DnsSteeringPolicyResourceCrud) Delete(ctx context.Context) error {
 	request := oci_dns.DeleteSteeringPolicyRequest{}
 
 	tmp := s.D.Id()
@@ -505,7 +506,7 @@ func (s *DnsSteeringPolicyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.DeleteSteeringPolicy(context.Background(), request)
+	_, err := s.Client.DeleteSteeringPolicy(ctx, request)
 	return err
 }
 
@@ -1166,7 +1167,7 @@ func SteeringPolicyWeightedRuleCaseToMap(obj oci_dns.SteeringPolicyWeightedRuleC
 	return result
 }
 
-func (s *DnsSteeringPolicyResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DnsSteeringPolicyResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_dns.ChangeSteeringPolicyCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -1177,12 +1178,12 @@ func (s *DnsSteeringPolicyResourceCrud) updateCompartment(compartment interface{
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.ChangeSteeringPolicyCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeSteeringPolicyCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
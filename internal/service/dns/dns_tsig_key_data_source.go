@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dns "github.com/oracle/oci-go-sdk/v65/dns"
 )
@@ -22,12 +23,12 @@ func DnsTsigKeyDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DnsTsigKeyResource(), fieldMap, readSingularDnsTsigKey)
 }
 
-func readSingularDnsTsigKey(d *schema.ResourceData, m interface{}) error {
+func readSingularDnsTsigKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsTsigKeyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DnsTsigKeyDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *DnsTsigKeyDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DnsTsigKeyDataSourceCrud) Get() error {
+func (s *DnsTsigKeyDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.GetTsigKeyRequest{}
 
 	if tsigKeyId, ok := s.D.GetOkExists("tsig_key_id"); ok {
@@ -50,7 +51,7 @@ func (s *DnsTsigKeyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
 
-	response, err := s.Client.GetTsigKey(context.Background(), request)
+	response, err := s.Client.GetTsigKey(ctx, request)
 	if err != nil {
 		return err
 	}
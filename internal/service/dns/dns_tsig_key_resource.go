@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_dns "github.com/oracle/oci-go-sdk/v65/dns"
@@ -19,11 +20,11 @@ func DnsTsigKeyResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDnsTsigKey,
-		Read:     readDnsTsigKey,
-		Update:   updateDnsTsigKey,
-		Delete:   deleteDnsTsigKey,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDnsTsigKey,
+		ReadContext:   readDnsTsigKey,
+		UpdateContext: updateDnsTsigKey,
+		DeleteContext: deleteDnsTsigKey,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"algorithm": {
@@ -83,37 +84,37 @@ func DnsTsigKeyResource() *schema.Resource {
 	}
 }
 
-func createDnsTsigKey(d *schema.ResourceData, m interface{}) error {
+func createDnsTsigKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsTsigKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDnsTsigKey(d *schema.ResourceData, m interface{}) error {
+func readDnsTsigKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsTsigKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDnsTsigKey(d *schema.ResourceData, m interface{}) error {
+func updateDnsTsigKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsTsigKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDnsTsigKey(d *schema.ResourceData, m interface{}) error {
+func deleteDnsTsigKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsTsigKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DnsTsigKeyResourceCrud struct {
@@ -163,7 +164,7 @@ func (s *DnsTsigKeyResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DnsTsigKeyResourceCrud) Create() error {
+func (s *DnsTsigKeyResourceCrud) Create(ctx context.Context) error {
 	request := oci_dns.CreateTsigKeyRequest{}
 
 	if algorithm, ok := s.D.GetOkExists("algorithm"); ok {
@@ -200,7 +201,7 @@ func (s *DnsTsigKeyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.CreateTsigKey(context.Background(), request)
+	response, err := s.Client.CreateTsigKey(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -209,7 +210,7 @@ func (s *DnsTsigKeyResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DnsTsigKeyResourceCrud) Get() error {
+func (s *DnsTsigKeyResourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.GetTsigKeyRequest{}
 
 	tmp := s.D.Id()
@@ -217,7 +218,7 @@ func (s *DnsTsigKeyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.GetTsigKey(context.Background(), request)
+	response, err := s.Client.GetTsigKey(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -226,11 +227,11 @@ func (s *DnsTsigKeyResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DnsTsigKeyResourceCrud) Update() error {
+func (s *DnsTsigKeyResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -255,7 +256,7 @@ func (s *DnsTsigKeyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateTsigKey(context.Background(), request)
+	response, err := s.Client.UpdateTsigKey(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -264,7 +265,7 @@ func (s *DnsTsigKeyResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DnsTsigKeyResourceCrud) Delete() error {
+func (s *DnsTsigKeyResourceCrud) Delete(ctx context.Context) error {
 	request := oci_dns.DeleteTsigKeyRequest{}
 
 	tmp := s.D.Id()
@@ -272,7 +273,7 @@ func (s *DnsTsigKeyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.DeleteTsigKey(context.Background(), request)
+	_, err := s.Client.DeleteTsigKey(ctx, request)
 	return err
 }
 
@@ -316,7 +317,7 @@ func (s *DnsTsigKeyResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *DnsTsigKeyResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DnsTsigKeyResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_dns.ChangeTsigKeyCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -327,12 +328,12 @@ func (s *DnsTsigKeyResourceCrud) updateCompartment(compartment interface{}) erro
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.ChangeTsigKeyCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeTsigKeyCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dns "github.com/oracle/oci-go-sdk/v65/dns"
 )
 
 func DnsTsigKeysDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDnsTsigKeys,
+		ReadContext: readDnsTsigKeys,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,12 +44,12 @@ func DnsTsigKeysDataSource() *schema.Resource {
 	}
 }
 
-func readDnsTsigKeys(d *schema.ResourceData, m interface{}) error {
+func readDnsTsigKeys(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsTsigKeysDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DnsTsigKeysDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *DnsTsigKeysDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DnsTsigKeysDataSourceCrud) Get() error {
+func (s *DnsTsigKeysDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.ListTsigKeysRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -85,7 +86,7 @@ func (s *DnsTsigKeysDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
 
-	response, err := s.Client.ListTsigKeys(context.Background(), request)
+	response, err := s.Client.ListTsigKeys(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *DnsTsigKeysDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListTsigKeys(context.Background(), request)
+		listResponse, err := s.Client.ListTsigKeys(ctx, request)
 		if err != nil {
 			return err
 		}
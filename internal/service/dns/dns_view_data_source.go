@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_dns "github.com/oracle/oci-go-sdk/v65/dns"
 )
@@ -26,12 +27,12 @@ func DnsViewDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(DnsViewResource(), fieldMap, readSingularDnsView)
 }
 
-func readSingularDnsView(d *schema.ResourceData, m interface{}) error {
+func readSingularDnsView(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsViewDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DnsViewDataSourceCrud struct {
@@ -44,7 +45,7 @@ func (s *DnsViewDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DnsViewDataSourceCrud) Get() error {
+func (s *DnsViewDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.GetViewRequest{}
 
 	if scope, ok := s.D.GetOkExists("scope"); ok {
@@ -58,7 +59,7 @@ func (s *DnsViewDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
 
-	response, err := s.Client.GetView(context.Background(), request)
+	response, err := s.Client.GetView(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_dns "github.com/oracle/oci-go-sdk/v65/dns"
@@ -24,11 +25,11 @@ func DnsViewResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDnsView,
-		Read:     readDnsView,
-		Update:   updateDnsView,
-		Delete:   deleteDnsView,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDnsView,
+		ReadContext:   readDnsView,
+		UpdateContext: updateDnsView,
+		DeleteContext: deleteDnsView,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -86,31 +87,31 @@ func DnsViewResource() *schema.Resource {
 	}
 }
 
-func createDnsView(d *schema.ResourceData, m interface{}) error {
+func createDnsView(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsViewResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDnsView(d *schema.ResourceData, m interface{}) error {
+func readDnsView(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsViewResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDnsView(d *schema.ResourceData, m interface{}) error {
+func updateDnsView(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsViewResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDnsView(d *schema.ResourceData, m interface{}) error {
+func deleteDnsView(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsViewResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
@@ -125,7 +126,7 @@ func deleteDnsView(d *schema.ResourceData, m interface{}) error {
 			return nil
 		}
 	}
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DnsViewResourceCrud struct {
@@ -173,7 +174,7 @@ func (s *DnsViewResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DnsViewResourceCrud) Create() error {
+func (s *DnsViewResourceCrud) Create(ctx context.Context) error {
 	request := oci_dns.CreateViewRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -204,7 +205,7 @@ func (s *DnsViewResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.CreateView(context.Background(), request)
+	response, err := s.Client.CreateView(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -213,7 +214,7 @@ func (s *DnsViewResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DnsViewResourceCrud) Get() error {
+func (s *DnsViewResourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.GetViewRequest{}
 
 	if scope, ok := s.D.GetOkExists("scope"); ok {
@@ -235,7 +236,7 @@ func (s *DnsViewResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.GetView(context.Background(), request)
+	response, err := s.Client.GetView(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -244,11 +245,11 @@ func (s *DnsViewResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DnsViewResourceCrud) Update() error {
+func (s *DnsViewResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -282,7 +283,7 @@ func (s *DnsViewResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateView(context.Background(), request)
+	response, err := s.Client.UpdateView(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -291,7 +292,7 @@ func (s *DnsViewResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DnsViewResourceCrud) Delete() error {
+func (s *DnsViewResourceCrud) Delete(ctx context.Context) error {
 	request := oci_dns.DeleteViewRequest{}
 
 	if scope, ok := s.D.GetOkExists("scope"); ok {
@@ -303,7 +304,7 @@ func (s *DnsViewResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.DeleteView(context.Background(), request)
+	_, err := s.Client.DeleteView(ctx, request)
 	return err
 }
 
@@ -343,7 +344,7 @@ func (s *DnsViewResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *DnsViewResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DnsViewResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_dns.ChangeViewCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -358,12 +359,12 @@ func (s *DnsViewResourceCrud) updateCompartment(compartment interface{}) error {
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.ChangeViewCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeViewCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
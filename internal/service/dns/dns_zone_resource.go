@@ -14,6 +14,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -25,11 +26,11 @@ func DnsZoneResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createDnsZone,
-		Read:     readDnsZone,
-		Update:   updateDnsZone,
-		Delete:   deleteDnsZone,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createDnsZone,
+		ReadContext:   readDnsZone,
+		UpdateContext: updateDnsZone,
+		DeleteContext: deleteDnsZone,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -150,31 +151,31 @@ func DnsZoneResource() *schema.Resource {
 	}
 }
 
-func createDnsZone(d *schema.ResourceData, m interface{}) error {
+func createDnsZone(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsZoneResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readDnsZone(d *schema.ResourceData, m interface{}) error {
+func readDnsZone(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsZoneResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateDnsZone(d *schema.ResourceData, m interface{}) error {
+func updateDnsZone(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsZoneResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteDnsZone(d *schema.ResourceData, m interface{}) error {
+func deleteDnsZone(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsZoneResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
@@ -190,7 +191,7 @@ func deleteDnsZone(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type DnsZoneResourceCrud struct {
@@ -228,7 +229,7 @@ func (s *DnsZoneResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *DnsZoneResourceCrud) Create() error {
+func (s *DnsZoneResourceCrud) Create(ctx context.Context) error {
 	request := oci_dns.CreateZoneRequest{}
 	createZoneDetailsRequest := oci_dns.CreateZoneDetails{}
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -287,7 +288,7 @@ func (s *DnsZoneResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.CreateZone(context.Background(), request)
+	response, err := s.Client.CreateZone(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -296,7 +297,7 @@ func (s *DnsZoneResourceCrud) Create() error {
 	return nil
 }
 
-func (s *DnsZoneResourceCrud) Get() error {
+func (s *DnsZoneResourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.GetZoneRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -332,7 +333,7 @@ func (s *DnsZoneResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.GetZone(context.Background(), request)
+	response, err := s.Client.GetZone(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -341,11 +342,11 @@ func (s *DnsZoneResourceCrud) Get() error {
 	return nil
 }
 
-func (s *DnsZoneResourceCrud) Update() error {
+func (s *DnsZoneResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -401,7 +402,7 @@ func (s *DnsZoneResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	response, err := s.Client.UpdateZone(context.Background(), request)
+	response, err := s.Client.UpdateZone(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -410,7 +411,7 @@ func (s *DnsZoneResourceCrud) Update() error {
 	return nil
 }
 
-func (s *DnsZoneResourceCrud) Delete() error {
+func (s *DnsZoneResourceCrud) Delete(ctx context.Context) error {
 	request := oci_dns.DeleteZoneRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -432,7 +433,7 @@ func (s *DnsZoneResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.DeleteZone(context.Background(), request)
+	_, err := s.Client.DeleteZone(ctx, request)
 	return err
 }
 
@@ -551,7 +552,7 @@ func NameserverToMap(obj oci_dns.Nameserver) map[string]interface{} {
 	return result
 }
 
-func (s *DnsZoneResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *DnsZoneResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_dns.ChangeZoneCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -566,12 +567,12 @@ func (s *DnsZoneResourceCrud) updateCompartment(compartment interface{}) error {
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "dns")
 
-	_, err := s.Client.ChangeZoneCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeZoneCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_dns "github.com/oracle/oci-go-sdk/v65/dns"
@@ -17,7 +18,7 @@ import (
 
 func DnsZonesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readDnsZones,
+		ReadContext: readDnsZones,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -77,12 +78,12 @@ func DnsZonesDataSource() *schema.Resource {
 	}
 }
 
-func readDnsZones(d *schema.ResourceData, m interface{}) error {
+func readDnsZones(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DnsZonesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DnsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DnsZonesDataSourceCrud struct {
@@ -95,7 +96,7 @@ func (s *DnsZonesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DnsZonesDataSourceCrud) Get() error {
+func (s *DnsZonesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_dns.ListZonesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -170,7 +171,7 @@ func (s *DnsZonesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "dns")
 
-	response, err := s.Client.ListZones(context.Background(), request)
+	response, err := s.Client.ListZones(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -179,7 +180,7 @@ func (s *DnsZonesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListZones(context.Background(), request)
+		listResponse, err := s.Client.ListZones(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -191,7 +192,7 @@ func (s *DnsZonesDataSourceCrud) Get() error {
 	// Now search for private zones if scope is set to the special value 'ALL'
 	if allScope {
 		request.Scope = oci_dns.ListZonesScopePrivate
-		listResponse, err := s.Client.ListZones(context.Background(), request)
+		listResponse, err := s.Client.ListZones(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -200,7 +201,7 @@ func (s *DnsZonesDataSourceCrud) Get() error {
 		request.Page = s.Res.OpcNextPage
 
 		for request.Page != nil {
-			listResponse, err = s.Client.ListZones(context.Background(), request)
+			listResponse, err = s.Client.ListZones(ctx, request)
 			if err != nil {
 				return err
 			}
@@ -6,6 +6,7 @@ package em_warehouse
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_em_warehouse "github.com/oracle/oci-go-sdk/v65/emwarehouse"
 
@@ -22,12 +23,12 @@ func EmWarehouseEmWarehouseDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(EmWarehouseEmWarehouseResource(), fieldMap, readSingularEmWarehouseEmWarehouse)
 }
 
-func readSingularEmWarehouseEmWarehouse(d *schema.ResourceData, m interface{}) error {
+func readSingularEmWarehouseEmWarehouse(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmWarehouseEmWarehouseDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmWarehouseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type EmWarehouseEmWarehouseDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *EmWarehouseEmWarehouseDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *EmWarehouseEmWarehouseDataSourceCrud) Get() error {
+func (s *EmWarehouseEmWarehouseDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_em_warehouse.GetEmWarehouseRequest{}
 
 	if emWarehouseId, ok := s.D.GetOkExists("em_warehouse_id"); ok {
@@ -50,7 +51,7 @@ func (s *EmWarehouseEmWarehouseDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "emwarehouse")
 
-	response, err := s.Client.GetEmWarehouse(context.Background(), request)
+	response, err := s.Client.GetEmWarehouse(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_em_warehouse "github.com/oracle/oci-go-sdk/v65/emwarehouse"
 
@@ -16,7 +17,7 @@ import (
 
 func EmWarehouseEmWarehouseEtlRunDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularEmWarehouseEmWarehouseEtlRun,
+		ReadContext: readSingularEmWarehouseEmWarehouseEtlRun,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -94,12 +95,12 @@ func EmWarehouseEmWarehouseEtlRunDataSource() *schema.Resource {
 	}
 }
 
-func readSingularEmWarehouseEmWarehouseEtlRun(d *schema.ResourceData, m interface{}) error {
+func readSingularEmWarehouseEmWarehouseEtlRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmWarehouseEmWarehouseEtlRunDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmWarehouseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type EmWarehouseEmWarehouseEtlRunDataSourceCrud struct {
@@ -112,7 +113,7 @@ func (s *EmWarehouseEmWarehouseEtlRunDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *EmWarehouseEmWarehouseEtlRunDataSourceCrud) Get() error {
+func (s *EmWarehouseEmWarehouseEtlRunDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_em_warehouse.ListEtlRunsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -132,7 +133,7 @@ func (s *EmWarehouseEmWarehouseEtlRunDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "em_warehouse")
 
-	response, err := s.Client.ListEtlRuns(context.Background(), request)
+	response, err := s.Client.ListEtlRuns(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_em_warehouse "github.com/oracle/oci-go-sdk/v65/emwarehouse"
 
@@ -16,7 +17,7 @@ import (
 
 func EmWarehouseEmWarehouseEtlRunsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readEmWarehouseEmWarehouseEtlRuns,
+		ReadContext: readEmWarehouseEmWarehouseEtlRuns,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -115,12 +116,12 @@ func EmWarehouseEmWarehouseEtlRunsDataSource() *schema.Resource {
 	}
 }
 
-func readEmWarehouseEmWarehouseEtlRuns(d *schema.ResourceData, m interface{}) error {
+func readEmWarehouseEmWarehouseEtlRuns(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmWarehouseEmWarehouseEtlRunsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmWarehouseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type EmWarehouseEmWarehouseEtlRunsDataSourceCrud struct {
@@ -133,7 +134,7 @@ func (s *EmWarehouseEmWarehouseEtlRunsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *EmWarehouseEmWarehouseEtlRunsDataSourceCrud) Get() error {
+func (s *EmWarehouseEmWarehouseEtlRunsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_em_warehouse.ListEtlRunsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -153,7 +154,7 @@ func (s *EmWarehouseEmWarehouseEtlRunsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "em_warehouse")
 
-	response, err := s.Client.ListEtlRuns(context.Background(), request)
+	response, err := s.Client.ListEtlRuns(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -162,7 +163,7 @@ func (s *EmWarehouseEmWarehouseEtlRunsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListEtlRuns(context.Background(), request)
+		listResponse, err := s.Client.ListEtlRuns(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -25,11 +26,11 @@ func EmWarehouseEmWarehouseResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createEmWarehouseEmWarehouse,
-		Read:     readEmWarehouseEmWarehouse,
-		Update:   updateEmWarehouseEmWarehouse,
-		Delete:   deleteEmWarehouseEmWarehouse,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createEmWarehouseEmWarehouse,
+		ReadContext:   readEmWarehouseEmWarehouse,
+		UpdateContext: updateEmWarehouseEmWarehouse,
+		DeleteContext: deleteEmWarehouseEmWarehouse,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -109,37 +110,37 @@ func EmWarehouseEmWarehouseResource() *schema.Resource {
 	}
 }
 
-func createEmWarehouseEmWarehouse(d *schema.ResourceData, m interface{}) error {
+func createEmWarehouseEmWarehouse(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmWarehouseEmWarehouseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmWarehouseClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readEmWarehouseEmWarehouse(d *schema.ResourceData, m interface{}) error {
+func readEmWarehouseEmWarehouse(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmWarehouseEmWarehouseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmWarehouseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateEmWarehouseEmWarehouse(d *schema.ResourceData, m interface{}) error {
+func updateEmWarehouseEmWarehouse(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmWarehouseEmWarehouseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmWarehouseClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteEmWarehouseEmWarehouse(d *schema.ResourceData, m interface{}) error {
+func deleteEmWarehouseEmWarehouse(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmWarehouseEmWarehouseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmWarehouseClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type EmWarehouseEmWarehouseResourceCrud struct {
@@ -177,7 +178,7 @@ func (s *EmWarehouseEmWarehouseResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *EmWarehouseEmWarehouseResourceCrud) Create() error {
+func (s *EmWarehouseEmWarehouseResourceCrud) Create(ctx context.Context) error {
 	request := oci_em_warehouse.CreateEmWarehouseRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -214,26 +215,26 @@ func (s *EmWarehouseEmWarehouseResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "emwarehouse")
 
-	response, err := s.Client.CreateEmWarehouse(context.Background(), request)
+	response, err := s.Client.CreateEmWarehouse(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getEmWarehouseFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "emwarehouse"), oci_em_warehouse.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getEmWarehouseFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "emwarehouse"), oci_em_warehouse.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *EmWarehouseEmWarehouseResourceCrud) getEmWarehouseFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *EmWarehouseEmWarehouseResourceCrud) getEmWarehouseFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_em_warehouse.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	emWarehouseId, err := emWarehouseWaitForWorkRequest(workId, "emwarehouse",
+	emWarehouseId, err := emWarehouseWaitForWorkRequest(ctx, workId, "emwarehouse",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, emWarehouseId)
-		_, cancelErr := s.Client.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.Client.CancelWorkRequest(ctx,
 			oci_em_warehouse.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -247,7 +248,7 @@ func (s *EmWarehouseEmWarehouseResourceCrud) getEmWarehouseFromWorkRequest(workI
 	}
 	s.D.SetId(*emWarehouseId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func emWarehouseWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -273,7 +274,7 @@ func emWarehouseWorkRequestShouldRetryFunc(timeout time.Duration) func(response
 	}
 }
 
-func emWarehouseWaitForWorkRequest(wId *string, entityType string, action oci_em_warehouse.ActionTypeEnum,
+func emWarehouseWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_em_warehouse.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_em_warehouse.EmWarehouseClient) (*string, error) {
 
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "emwarehouse")
@@ -293,7 +294,7 @@ func emWarehouseWaitForWorkRequest(wId *string, entityType string, action oci_em
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_em_warehouse.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -322,14 +323,14 @@ func emWarehouseWaitForWorkRequest(wId *string, entityType string, action oci_em
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_em_warehouse.OperationStatusFailed || response.Status == oci_em_warehouse.OperationStatusCanceled {
-		return nil, getErrorFromEmWarehouseEmWarehouseWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromEmWarehouseEmWarehouseWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromEmWarehouseEmWarehouseWorkRequest(client *oci_em_warehouse.EmWarehouseClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_em_warehouse.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromEmWarehouseEmWarehouseWorkRequest(ctx context.Context, client *oci_em_warehouse.EmWarehouseClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_em_warehouse.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_em_warehouse.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -351,7 +352,7 @@ func getErrorFromEmWarehouseEmWarehouseWorkRequest(client *oci_em_warehouse.EmWa
 	return workRequestErr
 }
 
-func (s *EmWarehouseEmWarehouseResourceCrud) Get() error {
+func (s *EmWarehouseEmWarehouseResourceCrud) Get(ctx context.Context) error {
 	request := oci_em_warehouse.GetEmWarehouseRequest{}
 
 	tmp := s.D.Id()
@@ -359,7 +360,7 @@ func (s *EmWarehouseEmWarehouseResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "emwarehouse")
 
-	response, err := s.Client.GetEmWarehouse(context.Background(), request)
+	response, err := s.Client.GetEmWarehouse(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -368,11 +369,11 @@ func (s *EmWarehouseEmWarehouseResourceCrud) Get() error {
 	return nil
 }
 
-func (s *EmWarehouseEmWarehouseResourceCrud) Update() error {
+func (s *EmWarehouseEmWarehouseResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -407,16 +408,16 @@ func (s *EmWarehouseEmWarehouseResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "emwarehouse")
 
-	response, err := s.Client.UpdateEmWarehouse(context.Background(), request)
+	response, err := s.Client.UpdateEmWarehouse(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getEmWarehouseFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "emwarehouse"), oci_em_warehouse.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getEmWarehouseFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "emwarehouse"), oci_em_warehouse.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *EmWarehouseEmWarehouseResourceCrud) Delete() error {
+func (s *EmWarehouseEmWarehouseResourceCrud) Delete(ctx context.Context) error {
 	request := oci_em_warehouse.DeleteEmWarehouseRequest{}
 
 	tmp := s.D.Id()
@@ -424,14 +425,14 @@ func (s *EmWarehouseEmWarehouseResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "emwarehouse")
 
-	response, err := s.Client.DeleteEmWarehouse(context.Background(), request)
+	response, err := s.Client.DeleteEmWarehouse(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := emWarehouseWaitForWorkRequest(workId, "emwarehouse",
+	_, delWorkRequestErr := emWarehouseWaitForWorkRequest(ctx, workId, "emwarehouse",
 		oci_em_warehouse.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -562,7 +563,7 @@ func EmWarehouseSummaryToMap(obj oci_em_warehouse.EmWarehouseSummary) map[string
 	return result
 }
 
-func (s *EmWarehouseEmWarehouseResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *EmWarehouseEmWarehouseResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_em_warehouse.ChangeEmWarehouseCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -573,11 +574,11 @@ func (s *EmWarehouseEmWarehouseResourceCrud) updateCompartment(compartment inter
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "emwarehouse")
 
-	response, err := s.Client.ChangeEmWarehouseCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeEmWarehouseCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getEmWarehouseFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "emwarehouse"), oci_em_warehouse.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getEmWarehouseFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "emwarehouse"), oci_em_warehouse.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -6,6 +6,7 @@ package em_warehouse
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_em_warehouse "github.com/oracle/oci-go-sdk/v65/emwarehouse"
 
@@ -15,7 +16,7 @@ import (
 
 func EmWarehouseEmWarehouseResourceUsageDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularEmWarehouseEmWarehouseResourceUsage,
+		ReadContext: readSingularEmWarehouseEmWarehouseResourceUsage,
 		Schema: map[string]*schema.Schema{
 			"em_warehouse_id": {
 				Type:     schema.TypeString,
@@ -71,12 +72,12 @@ func EmWarehouseEmWarehouseResourceUsageDataSource() *schema.Resource {
 	}
 }
 
-func readSingularEmWarehouseEmWarehouseResourceUsage(d *schema.ResourceData, m interface{}) error {
+func readSingularEmWarehouseEmWarehouseResourceUsage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmWarehouseEmWarehouseResourceUsageDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmWarehouseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type EmWarehouseEmWarehouseResourceUsageDataSourceCrud struct {
@@ -89,7 +90,7 @@ func (s *EmWarehouseEmWarehouseResourceUsageDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *EmWarehouseEmWarehouseResourceUsageDataSourceCrud) Get() error {
+func (s *EmWarehouseEmWarehouseResourceUsageDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_em_warehouse.GetEmWarehouseResourceUsageRequest{}
 
 	if emWarehouseId, ok := s.D.GetOkExists("em_warehouse_id"); ok {
@@ -99,7 +100,7 @@ func (s *EmWarehouseEmWarehouseResourceUsageDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "emwarehouse")
 
-	response, err := s.Client.GetEmWarehouseResourceUsage(context.Background(), request)
+	response, err := s.Client.GetEmWarehouseResourceUsage(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package em_warehouse
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_em_warehouse "github.com/oracle/oci-go-sdk/v65/emwarehouse"
 
@@ -15,7 +16,7 @@ import (
 
 func EmWarehouseEmWarehousesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readEmWarehouseEmWarehouses,
+		ReadContext: readEmWarehouseEmWarehouses,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -56,12 +57,12 @@ func EmWarehouseEmWarehousesDataSource() *schema.Resource {
 	}
 }
 
-func readEmWarehouseEmWarehouses(d *schema.ResourceData, m interface{}) error {
+func readEmWarehouseEmWarehouses(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmWarehouseEmWarehousesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmWarehouseClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type EmWarehouseEmWarehousesDataSourceCrud struct {
@@ -74,7 +75,7 @@ func (s *EmWarehouseEmWarehousesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *EmWarehouseEmWarehousesDataSourceCrud) Get() error {
+func (s *EmWarehouseEmWarehousesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_em_warehouse.ListEmWarehousesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -103,7 +104,7 @@ func (s *EmWarehouseEmWarehousesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "emwarehouse")
 
-	response, err := s.Client.ListEmWarehouses(context.Background(), request)
+	response, err := s.Client.ListEmWarehouses(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +113,7 @@ func (s *EmWarehouseEmWarehousesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListEmWarehouses(context.Background(), request)
+		listResponse, err := s.Client.ListEmWarehouses(ctx, request)
 		if err != nil {
 			return err
 		}
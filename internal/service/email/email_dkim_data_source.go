@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_email "github.com/oracle/oci-go-sdk/v65/email"
 )
@@ -22,12 +23,12 @@ func EmailDkimDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(EmailDkimResource(), fieldMap, readSingularEmailDkim)
 }
 
-func readSingularEmailDkim(d *schema.ResourceData, m interface{}) error {
+func readSingularEmailDkim(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailDkimDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type EmailDkimDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *EmailDkimDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *EmailDkimDataSourceCrud) Get() error {
+func (s *EmailDkimDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_email.GetDkimRequest{}
 
 	if dkimId, ok := s.D.GetOkExists("dkim_id"); ok {
@@ -50,7 +51,7 @@ func (s *EmailDkimDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "email")
 
-	response, err := s.Client.GetDkim(context.Background(), request)
+	response, err := s.Client.GetDkim(ctx, request)
 	if err != nil {
 		return err
 	}
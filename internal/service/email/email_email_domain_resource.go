@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -25,11 +26,11 @@ func EmailEmailDomainResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createEmailEmailDomain,
-		Read:     readEmailEmailDomain,
-		Update:   updateEmailEmailDomain,
-		Delete:   deleteEmailEmailDomain,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createEmailEmailDomain,
+		ReadContext:   readEmailEmailDomain,
+		UpdateContext: updateEmailEmailDomain,
+		DeleteContext: deleteEmailEmailDomain,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -88,37 +89,37 @@ func EmailEmailDomainResource() *schema.Resource {
 	}
 }
 
-func createEmailEmailDomain(d *schema.ResourceData, m interface{}) error {
+func createEmailEmailDomain(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailEmailDomainResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readEmailEmailDomain(d *schema.ResourceData, m interface{}) error {
+func readEmailEmailDomain(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailEmailDomainResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateEmailEmailDomain(d *schema.ResourceData, m interface{}) error {
+func updateEmailEmailDomain(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailEmailDomainResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteEmailEmailDomain(d *schema.ResourceData, m interface{}) error {
+func deleteEmailEmailDomain(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailEmailDomainResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type EmailEmailDomainResourceCrud struct {
@@ -156,7 +157,7 @@ func (s *EmailEmailDomainResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *EmailEmailDomainResourceCrud) Create() error {
+func (s *EmailEmailDomainResourceCrud) Create(ctx context.Context) error {
 	request := oci_email.CreateEmailDomainRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -188,20 +189,20 @@ func (s *EmailEmailDomainResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.CreateEmailDomain(context.Background(), request)
+	response, err := s.Client.CreateEmailDomain(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getEmailDomainFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email"), oci_email.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getEmailDomainFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email"), oci_email.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *EmailEmailDomainResourceCrud) getEmailDomainFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *EmailEmailDomainResourceCrud) getEmailDomainFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_email.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	emailDomainId, err := emailDomainWaitForWorkRequest(workId, "email",
+	emailDomainId, err := emailDomainWaitForWorkRequest(ctx, workId, "email",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -211,7 +212,7 @@ func (s *EmailEmailDomainResourceCrud) getEmailDomainFromWorkRequest(workId *str
 	}
 	s.D.SetId(*emailDomainId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func emailDomainWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -237,7 +238,7 @@ func emailDomainWorkRequestShouldRetryFunc(timeout time.Duration) func(response
 	}
 }
 
-func emailDomainWaitForWorkRequest(wId *string, entityType string, action oci_email.ActionTypeEnum,
+func emailDomainWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_email.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_email.EmailClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "email")
 	retryPolicy.ShouldRetryOperation = emailDomainWorkRequestShouldRetryFunc(timeout)
@@ -256,7 +257,7 @@ func emailDomainWaitForWorkRequest(wId *string, entityType string, action oci_em
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_email.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -285,14 +286,14 @@ func emailDomainWaitForWorkRequest(wId *string, entityType string, action oci_em
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_email.OperationStatusFailed || response.Status == oci_email.OperationStatusCanceled {
-		return nil, getErrorFromEmailEmailDomainWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromEmailEmailDomainWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromEmailEmailDomainWorkRequest(client *oci_email.EmailClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_email.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromEmailEmailDomainWorkRequest(ctx context.Context, client *oci_email.EmailClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_email.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_email.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -314,7 +315,7 @@ func getErrorFromEmailEmailDomainWorkRequest(client *oci_email.EmailClient, work
 	return workRequestErr
 }
 
-func (s *EmailEmailDomainResourceCrud) Get() error {
+func (s *EmailEmailDomainResourceCrud) Get(ctx context.Context) error {
 	request := oci_email.GetEmailDomainRequest{}
 
 	tmp := s.D.Id()
@@ -322,7 +323,7 @@ func (s *EmailEmailDomainResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.GetEmailDomain(context.Background(), request)
+	response, err := s.Client.GetEmailDomain(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -331,11 +332,11 @@ func (s *EmailEmailDomainResourceCrud) Get() error {
 	return nil
 }
 
-func (s *EmailEmailDomainResourceCrud) Update() error {
+func (s *EmailEmailDomainResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -365,16 +366,16 @@ func (s *EmailEmailDomainResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.UpdateEmailDomain(context.Background(), request)
+	response, err := s.Client.UpdateEmailDomain(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getEmailDomainFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email"), oci_email.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getEmailDomainFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email"), oci_email.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *EmailEmailDomainResourceCrud) Delete() error {
+func (s *EmailEmailDomainResourceCrud) Delete(ctx context.Context) error {
 	request := oci_email.DeleteEmailDomainRequest{}
 
 	tmp := s.D.Id()
@@ -382,14 +383,14 @@ func (s *EmailEmailDomainResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.DeleteEmailDomain(context.Background(), request)
+	response, err := s.Client.DeleteEmailDomain(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := emailDomainWaitForWorkRequest(workId, "email",
+	_, delWorkRequestErr := emailDomainWaitForWorkRequest(ctx, workId, "email",
 		oci_email.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -476,7 +477,7 @@ func EmailDomainSummaryToMap(obj oci_email.EmailDomainSummary) map[string]interf
 	return result
 }
 
-func (s *EmailEmailDomainResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *EmailEmailDomainResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_email.ChangeEmailDomainCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -487,11 +488,11 @@ func (s *EmailEmailDomainResourceCrud) updateCompartment(compartment interface{}
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.ChangeEmailDomainCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeEmailDomainCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getEmailDomainFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email"), oci_email.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getEmailDomainFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email"), oci_email.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_email "github.com/oracle/oci-go-sdk/v65/email"
 )
@@ -22,12 +23,12 @@ func EmailSenderDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(EmailSenderResource(), fieldMap, readSingularEmailSender)
 }
 
-func readSingularEmailSender(d *schema.ResourceData, m interface{}) error {
+func readSingularEmailSender(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailSenderDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type EmailSenderDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *EmailSenderDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *EmailSenderDataSourceCrud) Get() error {
+func (s *EmailSenderDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_email.GetSenderRequest{}
 
 	if senderId, ok := s.D.GetOkExists("sender_id"); ok {
@@ -50,7 +51,7 @@ func (s *EmailSenderDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "email")
 
-	response, err := s.Client.GetSender(context.Background(), request)
+	response, err := s.Client.GetSender(ctx, request)
 	if err != nil {
 		return err
 	}
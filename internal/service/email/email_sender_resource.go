@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_email "github.com/oracle/oci-go-sdk/v65/email"
@@ -19,11 +20,11 @@ func EmailSenderResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createEmailSender,
-		Read:     readEmailSender,
-		Update:   updateEmailSender,
-		Delete:   deleteEmailSender,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createEmailSender,
+		ReadContext:   readEmailSender,
+		UpdateContext: updateEmailSender,
+		DeleteContext: deleteEmailSender,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -72,37 +73,37 @@ func EmailSenderResource() *schema.Resource {
 	}
 }
 
-func createEmailSender(d *schema.ResourceData, m interface{}) error {
+func createEmailSender(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailSenderResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readEmailSender(d *schema.ResourceData, m interface{}) error {
+func readEmailSender(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailSenderResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateEmailSender(d *schema.ResourceData, m interface{}) error {
+func updateEmailSender(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailSenderResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteEmailSender(d *schema.ResourceData, m interface{}) error {
+func deleteEmailSender(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailSenderResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type EmailSenderResourceCrud struct {
@@ -140,7 +141,7 @@ func (s *EmailSenderResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *EmailSenderResourceCrud) Create() error {
+func (s *EmailSenderResourceCrud) Create(ctx context.Context) error {
 	request := oci_email.CreateSenderRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -167,7 +168,7 @@ func (s *EmailSenderResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.CreateSender(context.Background(), request)
+	response, err := s.Client.CreateSender(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -176,7 +177,7 @@ func (s *EmailSenderResourceCrud) Create() error {
 	return nil
 }
 
-func (s *EmailSenderResourceCrud) Get() error {
+func (s *EmailSenderResourceCrud) Get(ctx context.Context) error {
 	request := oci_email.GetSenderRequest{}
 
 	tmp := s.D.Id()
@@ -184,7 +185,7 @@ func (s *EmailSenderResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.GetSender(context.Background(), request)
+	response, err := s.Client.GetSender(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -193,11 +194,11 @@ func (s *EmailSenderResourceCrud) Get() error {
 	return nil
 }
 
-func (s *EmailSenderResourceCrud) Update() error {
+func (s *EmailSenderResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -222,7 +223,7 @@ func (s *EmailSenderResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.UpdateSender(context.Background(), request)
+	response, err := s.Client.UpdateSender(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -231,7 +232,7 @@ func (s *EmailSenderResourceCrud) Update() error {
 	return nil
 }
 
-func (s *EmailSenderResourceCrud) Delete() error {
+func (s *EmailSenderResourceCrud) Delete(ctx context.Context) error {
 	request := oci_email.DeleteSenderRequest{}
 
 	tmp := s.D.Id()
@@ -239,7 +240,7 @@ func (s *EmailSenderResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	_, err := s.Client.DeleteSender(context.Background(), request)
+	_, err := s.Client.DeleteSender(ctx, request)
 	return err
 }
 
@@ -275,7 +276,7 @@ func (s *EmailSenderResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *EmailSenderResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *EmailSenderResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_email.ChangeSenderCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -286,12 +287,12 @@ func (s *EmailSenderResourceCrud) updateCompartment(compartment interface{}) err
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	_, err := s.Client.ChangeSenderCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeSenderCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_email "github.com/oracle/oci-go-sdk/v65/email"
 )
 
 func EmailSendersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readEmailSenders,
+		ReadContext: readEmailSenders,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,12 +44,12 @@ func EmailSendersDataSource() *schema.Resource {
 	}
 }
 
-func readEmailSenders(d *schema.ResourceData, m interface{}) error {
+func readEmailSenders(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailSendersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type EmailSendersDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *EmailSendersDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *EmailSendersDataSourceCrud) Get() error {
+func (s *EmailSendersDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_email.ListSendersRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -85,7 +86,7 @@ func (s *EmailSendersDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "email")
 
-	response, err := s.Client.ListSenders(context.Background(), request)
+	response, err := s.Client.ListSenders(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *EmailSendersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSenders(context.Background(), request)
+		listResponse, err := s.Client.ListSenders(ctx, request)
 		if err != nil {
 			return err
 		}
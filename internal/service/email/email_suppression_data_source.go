@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_email "github.com/oracle/oci-go-sdk/v65/email"
 )
@@ -22,12 +23,12 @@ func EmailSuppressionDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(EmailSuppressionResource(), fieldMap, readSingularEmailSuppression)
 }
 
-func readSingularEmailSuppression(d *schema.ResourceData, m interface{}) error {
+func readSingularEmailSuppression(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailSuppressionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type EmailSuppressionDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *EmailSuppressionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *EmailSuppressionDataSourceCrud) Get() error {
+func (s *EmailSuppressionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_email.GetSuppressionRequest{}
 
 	if suppressionId, ok := s.D.GetOkExists("suppression_id"); ok {
@@ -50,7 +51,7 @@ func (s *EmailSuppressionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "email")
 
-	response, err := s.Client.GetSuppression(context.Background(), request)
+	response, err := s.Client.GetSuppression(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_email "github.com/oracle/oci-go-sdk/v65/email"
@@ -19,10 +20,10 @@ func EmailSuppressionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createEmailSuppression,
-		Read:     readEmailSuppression,
-		Delete:   deleteEmailSuppression,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createEmailSuppression,
+		ReadContext:   readEmailSuppression,
+		DeleteContext: deleteEmailSuppression,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -68,29 +69,29 @@ func EmailSuppressionResource() *schema.Resource {
 	}
 }
 
-func createEmailSuppression(d *schema.ResourceData, m interface{}) error {
+func createEmailSuppression(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailSuppressionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readEmailSuppression(d *schema.ResourceData, m interface{}) error {
+func readEmailSuppression(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailSuppressionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteEmailSuppression(d *schema.ResourceData, m interface{}) error {
+func deleteEmailSuppression(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailSuppressionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type EmailSuppressionResourceCrud struct {
@@ -104,7 +105,7 @@ func (s *EmailSuppressionResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *EmailSuppressionResourceCrud) Create() error {
+func (s *EmailSuppressionResourceCrud) Create(ctx context.Context) error {
 	request := oci_email.CreateSuppressionRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -119,7 +120,7 @@ func (s *EmailSuppressionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.CreateSuppression(context.Background(), request)
+	response, err := s.Client.CreateSuppression(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -128,7 +129,7 @@ func (s *EmailSuppressionResourceCrud) Create() error {
 	return nil
 }
 
-func (s *EmailSuppressionResourceCrud) Get() error {
+func (s *EmailSuppressionResourceCrud) Get(ctx context.Context) error {
 	request := oci_email.GetSuppressionRequest{}
 
 	tmp := s.D.Id()
@@ -136,7 +137,7 @@ func (s *EmailSuppressionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	response, err := s.Client.GetSuppression(context.Background(), request)
+	response, err := s.Client.GetSuppression(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -145,7 +146,7 @@ func (s *EmailSuppressionResourceCrud) Get() error {
 	return nil
 }
 
-func (s *EmailSuppressionResourceCrud) Delete() error {
+func (s *EmailSuppressionResourceCrud) Delete(ctx context.Context) error {
 	request := oci_email.DeleteSuppressionRequest{}
 
 	tmp := s.D.Id()
@@ -153,7 +154,7 @@ func (s *EmailSuppressionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "email")
 
-	_, err := s.Client.DeleteSuppression(context.Background(), request)
+	_, err := s.Client.DeleteSuppression(ctx, request)
 	return err
 }
 
@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_email "github.com/oracle/oci-go-sdk/v65/email"
@@ -17,7 +18,7 @@ import (
 
 func EmailSuppressionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readEmailSuppressions,
+		ReadContext: readEmailSuppressions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -45,12 +46,12 @@ func EmailSuppressionsDataSource() *schema.Resource {
 	}
 }
 
-func readEmailSuppressions(d *schema.ResourceData, m interface{}) error {
+func readEmailSuppressions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EmailSuppressionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EmailClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type EmailSuppressionsDataSourceCrud struct {
@@ -63,7 +64,7 @@ func (s *EmailSuppressionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *EmailSuppressionsDataSourceCrud) Get() error {
+func (s *EmailSuppressionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_email.ListSuppressionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -94,7 +95,7 @@ func (s *EmailSuppressionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "email")
 
-	response, err := s.Client.ListSuppressions(context.Background(), request)
+	response, err := s.Client.ListSuppressions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *EmailSuppressionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSuppressions(context.Background(), request)
+		listResponse, err := s.Client.ListSuppressions(ctx, request)
 		if err != nil {
 			return err
 		}
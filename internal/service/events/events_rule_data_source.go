@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_events "github.com/oracle/oci-go-sdk/v65/events"
 )
@@ -22,12 +23,12 @@ func EventsRuleDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(EventsRuleResource(), fieldMap, readSingularEventsRule)
 }
 
-func readSingularEventsRule(d *schema.ResourceData, m interface{}) error {
+func readSingularEventsRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EventsRuleDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EventsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type EventsRuleDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *EventsRuleDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *EventsRuleDataSourceCrud) Get() error {
+func (s *EventsRuleDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_events.GetRuleRequest{}
 
 	if ruleId, ok := s.D.GetOkExists("rule_id"); ok {
@@ -50,7 +51,7 @@ func (s *EventsRuleDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "events")
 
-	response, err := s.Client.GetRule(context.Background(), request)
+	response, err := s.Client.GetRule(ctx, request)
 	if err != nil {
 		return err
 	}
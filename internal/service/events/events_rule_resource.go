@@ -14,6 +14,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -25,11 +26,11 @@ func EventsRuleResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createEventsRule,
-		Read:     readEventsRule,
-		Update:   updateEventsRule,
-		Delete:   deleteEventsRule,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createEventsRule,
+		ReadContext:   readEventsRule,
+		UpdateContext: updateEventsRule,
+		DeleteContext: deleteEventsRule,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"actions": {
@@ -161,37 +162,37 @@ func EventsRuleResource() *schema.Resource {
 	}
 }
 
-func createEventsRule(d *schema.ResourceData, m interface{}) error {
+func createEventsRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EventsRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EventsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readEventsRule(d *schema.ResourceData, m interface{}) error {
+func readEventsRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EventsRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EventsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateEventsRule(d *schema.ResourceData, m interface{}) error {
+func updateEventsRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EventsRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EventsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteEventsRule(d *schema.ResourceData, m interface{}) error {
+func deleteEventsRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EventsRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EventsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type EventsRuleResourceCrud struct {
@@ -230,7 +231,7 @@ func (s *EventsRuleResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *EventsRuleResourceCrud) Create() error {
+func (s *EventsRuleResourceCrud) Create(ctx context.Context) error {
 	request := oci_events.CreateRuleRequest{}
 
 	if actions, ok := s.D.GetOkExists("actions"); ok {
@@ -283,7 +284,7 @@ func (s *EventsRuleResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "events")
 
-	response, err := s.Client.CreateRule(context.Background(), request)
+	response, err := s.Client.CreateRule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -292,7 +293,7 @@ func (s *EventsRuleResourceCrud) Create() error {
 	return nil
 }
 
-func (s *EventsRuleResourceCrud) Get() error {
+func (s *EventsRuleResourceCrud) Get(ctx context.Context) error {
 	request := oci_events.GetRuleRequest{}
 
 	tmp := s.D.Id()
@@ -300,7 +301,7 @@ func (s *EventsRuleResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "events")
 
-	response, err := s.Client.GetRule(context.Background(), request)
+	response, err := s.Client.GetRule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -309,11 +310,11 @@ func (s *EventsRuleResourceCrud) Get() error {
 	return nil
 }
 
-func (s *EventsRuleResourceCrud) Update() error {
+func (s *EventsRuleResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -369,7 +370,7 @@ func (s *EventsRuleResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "events")
 
-	response, err := s.Client.UpdateRule(context.Background(), request)
+	response, err := s.Client.UpdateRule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -378,7 +379,7 @@ func (s *EventsRuleResourceCrud) Update() error {
 	return nil
 }
 
-func (s *EventsRuleResourceCrud) Delete() error {
+func (s *EventsRuleResourceCrud) Delete(ctx context.Context) error {
 	request := oci_events.DeleteRuleRequest{}
 
 	tmp := s.D.Id()
@@ -386,7 +387,7 @@ func (s *EventsRuleResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "events")
 
-	_, err := s.Client.DeleteRule(context.Background(), request)
+	_, err := s.Client.DeleteRule(ctx, request)
 	return err
 }
 
@@ -646,7 +647,7 @@ func actionsHashCodeForSets(v interface{}) int {
 	}
 	return utils.GetStringHashcode(buf.String())
 }
-func (s *EventsRuleResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *EventsRuleResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_events.ChangeRuleCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -657,12 +658,12 @@ func (s *EventsRuleResourceCrud) updateCompartment(compartment interface{}) erro
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "events")
 
-	_, err := s.Client.ChangeRuleCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeRuleCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
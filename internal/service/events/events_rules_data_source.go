@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_events "github.com/oracle/oci-go-sdk/v65/events"
 )
 
 func EventsRulesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readEventsRules,
+		ReadContext: readEventsRules,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,12 +40,12 @@ func EventsRulesDataSource() *schema.Resource {
 	}
 }
 
-func readEventsRules(d *schema.ResourceData, m interface{}) error {
+func readEventsRules(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EventsRulesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EventsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type EventsRulesDataSourceCrud struct {
@@ -57,7 +58,7 @@ func (s *EventsRulesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *EventsRulesDataSourceCrud) Get() error {
+func (s *EventsRulesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_events.ListRulesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -76,7 +77,7 @@ func (s *EventsRulesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "events")
 
-	response, err := s.Client.ListRules(context.Background(), request)
+	response, err := s.Client.ListRules(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *EventsRulesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRules(context.Background(), request)
+		listResponse, err := s.Client.ListRules(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -11,6 +11,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_file_storage "github.com/oracle/oci-go-sdk/v65/filestorage"
@@ -21,11 +22,11 @@ func FileStorageExportResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createFileStorageExport,
-		Read:     readFileStorageExport,
-		Update:   updateFileStorageExport,
-		Delete:   deleteFileStorageExport,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createFileStorageExport,
+		ReadContext:   readFileStorageExport,
+		UpdateContext: updateFileStorageExport,
+		DeleteContext: deleteFileStorageExport,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"export_set_id": {
@@ -106,37 +107,37 @@ func FileStorageExportResource() *schema.Resource {
 	}
 }
 
-func createFileStorageExport(d *schema.ResourceData, m interface{}) error {
+func createFileStorageExport(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageExportResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readFileStorageExport(d *schema.ResourceData, m interface{}) error {
+func readFileStorageExport(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageExportResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateFileStorageExport(d *schema.ResourceData, m interface{}) error {
+func updateFileStorageExport(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageExportResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteFileStorageExport(d *schema.ResourceData, m interface{}) error {
+func deleteFileStorageExport(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageExportResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type FileStorageExportResourceCrud struct {
@@ -174,7 +175,7 @@ func (s *FileStorageExportResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *FileStorageExportResourceCrud) Create() error {
+func (s *FileStorageExportResourceCrud) Create(ctx context.Context) error {
 	request := oci_file_storage.CreateExportRequest{}
 
 	if exportOptions, ok := s.D.GetOkExists("export_options"); ok {
@@ -211,20 +212,20 @@ func (s *FileStorageExportResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.CreateExport(context.Background(), request)
+	response, err := s.Client.CreateExport(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.Export
-	if waitErr := tfresource.WaitForCreatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForCreatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
 	return nil
 }
 
-func (s *FileStorageExportResourceCrud) Get() error {
+func (s *FileStorageExportResourceCrud) Get(ctx context.Context) error {
 	request := oci_file_storage.GetExportRequest{}
 
 	tmp := s.D.Id()
@@ -232,7 +233,7 @@ func (s *FileStorageExportResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.GetExport(context.Background(), request)
+	response, err := s.Client.GetExport(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -241,7 +242,7 @@ func (s *FileStorageExportResourceCrud) Get() error {
 	return nil
 }
 
-func (s *FileStorageExportResourceCrud) Update() error {
+func (s *FileStorageExportResourceCrud) Update(ctx context.Context) error {
 	request := oci_file_storage.UpdateExportRequest{}
 
 	tmp := s.D.Id()
@@ -266,7 +267,7 @@ func (s *FileStorageExportResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.UpdateExport(context.Background(), request)
+	response, err := s.Client.UpdateExport(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -275,7 +276,7 @@ func (s *FileStorageExportResourceCrud) Update() error {
 	return nil
 }
 
-func (s *FileStorageExportResourceCrud) Delete() error {
+func (s *FileStorageExportResourceCrud) Delete(ctx context.Context) error {
 	request := oci_file_storage.DeleteExportRequest{}
 
 	tmp := s.D.Id()
@@ -283,7 +284,7 @@ func (s *FileStorageExportResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	_, err := s.Client.DeleteExport(context.Background(), request)
+	_, err := s.Client.DeleteExport(ctx, request)
 	return err
 }
 
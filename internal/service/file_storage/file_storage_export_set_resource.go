@@ -11,6 +11,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_file_storage "github.com/oracle/oci-go-sdk/v65/filestorage"
@@ -21,11 +22,11 @@ func FileStorageExportSetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createFileStorageExportSet,
-		Read:     readFileStorageExportSet,
-		Update:   updateFileStorageExportSet,
-		Delete:   deleteFileStorageExportSet,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createFileStorageExportSet,
+		ReadContext:   readFileStorageExportSet,
+		UpdateContext: updateFileStorageExportSet,
+		DeleteContext: deleteFileStorageExportSet,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"mount_target_id": {
@@ -80,31 +81,31 @@ func FileStorageExportSetResource() *schema.Resource {
 	}
 }
 
-func createFileStorageExportSet(d *schema.ResourceData, m interface{}) error {
+func createFileStorageExportSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageExportSetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readFileStorageExportSet(d *schema.ResourceData, m interface{}) error {
+func readFileStorageExportSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageExportSetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateFileStorageExportSet(d *schema.ResourceData, m interface{}) error {
+func updateFileStorageExportSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageExportSetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteFileStorageExportSet(d *schema.ResourceData, m interface{}) error {
+func deleteFileStorageExportSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Export set is deleted when a mount target is deleted.
 	return nil
 }
@@ -120,7 +121,7 @@ func (s *FileStorageExportSetResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *FileStorageExportSetResourceCrud) Create() error {
+func (s *FileStorageExportSetResourceCrud) Create(ctx context.Context) error {
 	// We can't really Create an ExportSet. We need to get the exportSetId from the MountTarget it is attached to.
 	if mountTargetId, ok := s.D.GetOkExists("mount_target_id"); ok {
 		tmp := mountTargetId.(string)
@@ -130,7 +131,7 @@ func (s *FileStorageExportSetResourceCrud) Create() error {
 
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-		response, err := s.Client.GetMountTarget(context.Background(), request)
+		response, err := s.Client.GetMountTarget(ctx, request)
 		if err != nil {
 			return fmt.Errorf("getting mount target details failed with error: %s", err.Error())
 		}
@@ -142,13 +143,13 @@ func (s *FileStorageExportSetResourceCrud) Create() error {
 		}
 
 		s.D.SetId(*exportSetId)
-		return s.Update()
+		return s.Update(ctx)
 	}
 
 	return fmt.Errorf("no mount_target_id value could be found")
 }
 
-func (s *FileStorageExportSetResourceCrud) Get() error {
+func (s *FileStorageExportSetResourceCrud) Get(ctx context.Context) error {
 	request := oci_file_storage.GetExportSetRequest{}
 
 	tmp := s.D.Id()
@@ -156,7 +157,7 @@ func (s *FileStorageExportSetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.GetExportSet(context.Background(), request)
+	response, err := s.Client.GetExportSet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -165,7 +166,7 @@ func (s *FileStorageExportSetResourceCrud) Get() error {
 	return nil
 }
 
-func (s *FileStorageExportSetResourceCrud) Update() error {
+func (s *FileStorageExportSetResourceCrud) Update(ctx context.Context) error {
 	request := oci_file_storage.UpdateExportSetRequest{}
 
 	if displayName, ok := s.D.GetOkExists("display_name"); ok {
@@ -196,7 +197,7 @@ func (s *FileStorageExportSetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.UpdateExportSet(context.Background(), request)
+	response, err := s.Client.UpdateExportSet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -205,7 +206,7 @@ func (s *FileStorageExportSetResourceCrud) Update() error {
 	return nil
 }
 
-func (s *FileStorageExportSetResourceCrud) Delete() error {
+func (s *FileStorageExportSetResourceCrud) Delete(ctx context.Context) error {
 	// Export set is deleted when a mount target is deleted.
 	return nil
 }
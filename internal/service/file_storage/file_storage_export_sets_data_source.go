@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_file_storage "github.com/oracle/oci-go-sdk/v65/filestorage"
 )
 
 func FileStorageExportSetsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readFileStorageExportSets,
+		ReadContext: readFileStorageExportSets,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -47,12 +48,12 @@ func FileStorageExportSetsDataSource() *schema.Resource {
 	}
 }
 
-func readFileStorageExportSets(d *schema.ResourceData, m interface{}) error {
+func readFileStorageExportSets(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageExportSetsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type FileStorageExportSetsDataSourceCrud struct {
@@ -65,7 +66,7 @@ func (s *FileStorageExportSetsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *FileStorageExportSetsDataSourceCrud) Get() error {
+func (s *FileStorageExportSetsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_file_storage.ListExportSetsRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -94,7 +95,7 @@ func (s *FileStorageExportSetsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "file_storage")
 
-	response, err := s.Client.ListExportSets(context.Background(), request)
+	response, err := s.Client.ListExportSets(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *FileStorageExportSetsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListExportSets(context.Background(), request)
+		listResponse, err := s.Client.ListExportSets(ctx, request)
 		if err != nil {
 			return err
 		}
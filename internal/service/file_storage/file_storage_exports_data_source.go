@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_file_storage "github.com/oracle/oci-go-sdk/v65/filestorage"
 )
 
 func FileStorageExportsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readFileStorageExports,
+		ReadContext: readFileStorageExports,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -47,12 +48,12 @@ func FileStorageExportsDataSource() *schema.Resource {
 	}
 }
 
-func readFileStorageExports(d *schema.ResourceData, m interface{}) error {
+func readFileStorageExports(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageExportsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type FileStorageExportsDataSourceCrud struct {
@@ -65,7 +66,7 @@ func (s *FileStorageExportsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *FileStorageExportsDataSourceCrud) Get() error {
+func (s *FileStorageExportsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_file_storage.ListExportsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -94,7 +95,7 @@ func (s *FileStorageExportsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "file_storage")
 
-	response, err := s.Client.ListExports(context.Background(), request)
+	response, err := s.Client.ListExports(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *FileStorageExportsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListExports(context.Background(), request)
+		listResponse, err := s.Client.ListExports(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_file_storage "github.com/oracle/oci-go-sdk/v65/filestorage"
@@ -20,11 +21,11 @@ func FileStorageFileSystemResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createFileStorageFileSystem,
-		Read:     readFileStorageFileSystem,
-		Update:   updateFileStorageFileSystem,
-		Delete:   deleteFileStorageFileSystem,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createFileStorageFileSystem,
+		ReadContext:   readFileStorageFileSystem,
+		UpdateContext: updateFileStorageFileSystem,
+		DeleteContext: deleteFileStorageFileSystem,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"availability_domain": {
@@ -118,37 +119,37 @@ func FileStorageFileSystemResource() *schema.Resource {
 	}
 }
 
-func createFileStorageFileSystem(d *schema.ResourceData, m interface{}) error {
+func createFileStorageFileSystem(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageFileSystemResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readFileStorageFileSystem(d *schema.ResourceData, m interface{}) error {
+func readFileStorageFileSystem(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageFileSystemResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateFileStorageFileSystem(d *schema.ResourceData, m interface{}) error {
+func updateFileStorageFileSystem(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageFileSystemResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteFileStorageFileSystem(d *schema.ResourceData, m interface{}) error {
+func deleteFileStorageFileSystem(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageFileSystemResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type FileStorageFileSystemResourceCrud struct {
@@ -186,7 +187,7 @@ func (s *FileStorageFileSystemResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *FileStorageFileSystemResourceCrud) Create() error {
+func (s *FileStorageFileSystemResourceCrud) Create(ctx context.Context) error {
 	request := oci_file_storage.CreateFileSystemRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -228,20 +229,20 @@ func (s *FileStorageFileSystemResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.CreateFileSystem(context.Background(), request)
+	response, err := s.Client.CreateFileSystem(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.FileSystem
-	if waitErr := tfresource.WaitForCreatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForCreatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
 	return nil
 }
 
-func (s *FileStorageFileSystemResourceCrud) Get() error {
+func (s *FileStorageFileSystemResourceCrud) Get(ctx context.Context) error {
 	request := oci_file_storage.GetFileSystemRequest{}
 
 	tmp := s.D.Id()
@@ -249,7 +250,7 @@ func (s *FileStorageFileSystemResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.GetFileSystem(context.Background(), request)
+	response, err := s.Client.GetFileSystem(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -258,11 +259,11 @@ func (s *FileStorageFileSystemResourceCrud) Get() error {
 	return nil
 }
 
-func (s *FileStorageFileSystemResourceCrud) Update() error {
+func (s *FileStorageFileSystemResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -297,7 +298,7 @@ func (s *FileStorageFileSystemResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.UpdateFileSystem(context.Background(), request)
+	response, err := s.Client.UpdateFileSystem(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -306,7 +307,7 @@ func (s *FileStorageFileSystemResourceCrud) Update() error {
 	return nil
 }
 
-func (s *FileStorageFileSystemResourceCrud) Delete() error {
+func (s *FileStorageFileSystemResourceCrud) Delete(ctx context.Context) error {
 	request := oci_file_storage.DeleteFileSystemRequest{}
 
 	tmp := s.D.Id()
@@ -314,7 +315,7 @@ func (s *FileStorageFileSystemResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	_, err := s.Client.DeleteFileSystem(context.Background(), request)
+	_, err := s.Client.DeleteFileSystem(ctx, request)
 	return err
 }
 
@@ -386,7 +387,7 @@ func FileSystemSourceDetailsToMap(obj *oci_file_storage.SourceDetails) map[strin
 	return result
 }
 
-func (s *FileStorageFileSystemResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *FileStorageFileSystemResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_file_storage.ChangeFileSystemCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -397,12 +398,12 @@ func (s *FileStorageFileSystemResourceCrud) updateCompartment(compartment interf
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	_, err := s.Client.ChangeFileSystemCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeFileSystemCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
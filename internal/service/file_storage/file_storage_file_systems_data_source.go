@@ -10,13 +10,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_file_storage "github.com/oracle/oci-go-sdk/v65/filestorage"
 )
 
 func FileStorageFileSystemsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readFileStorageFileSystems,
+		ReadContext: readFileStorageFileSystems,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -56,12 +57,12 @@ func FileStorageFileSystemsDataSource() *schema.Resource {
 	}
 }
 
-func readFileStorageFileSystems(d *schema.ResourceData, m interface{}) error {
+func readFileStorageFileSystems(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageFileSystemsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type FileStorageFileSystemsDataSourceCrud struct {
@@ -74,7 +75,7 @@ func (s *FileStorageFileSystemsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *FileStorageFileSystemsDataSourceCrud) Get() error {
+func (s *FileStorageFileSystemsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_file_storage.ListFileSystemsRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -113,7 +114,7 @@ func (s *FileStorageFileSystemsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "file_storage")
 
-	response, err := s.Client.ListFileSystems(context.Background(), request)
+	response, err := s.Client.ListFileSystems(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -122,7 +123,7 @@ func (s *FileStorageFileSystemsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListFileSystems(context.Background(), request)
+		listResponse, err := s.Client.ListFileSystems(ctx, request)
 		if err != nil {
 			return err
 		}
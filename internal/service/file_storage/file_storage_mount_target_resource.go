@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
@@ -20,11 +21,11 @@ func FileStorageMountTargetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createFileStorageMountTarget,
-		Read:     readFileStorageMountTarget,
-		Update:   updateFileStorageMountTarget,
-		Delete:   deleteFileStorageMountTarget,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createFileStorageMountTarget,
+		ReadContext:   readFileStorageMountTarget,
+		UpdateContext: updateFileStorageMountTarget,
+		DeleteContext: deleteFileStorageMountTarget,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"availability_domain": {
@@ -113,41 +114,41 @@ func FileStorageMountTargetResource() *schema.Resource {
 	}
 }
 
-func createFileStorageMountTarget(d *schema.ResourceData, m interface{}) error {
+func createFileStorageMountTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageMountTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 	sync.VirtualNetworkClient = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readFileStorageMountTarget(d *schema.ResourceData, m interface{}) error {
+func readFileStorageMountTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageMountTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 	sync.VirtualNetworkClient = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateFileStorageMountTarget(d *schema.ResourceData, m interface{}) error {
+func updateFileStorageMountTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageMountTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 	sync.VirtualNetworkClient = m.(*client.OracleClients).VirtualNetworkClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteFileStorageMountTarget(d *schema.ResourceData, m interface{}) error {
+func deleteFileStorageMountTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageMountTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 	sync.VirtualNetworkClient = m.(*client.OracleClients).VirtualNetworkClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type FileStorageMountTargetResourceCrud struct {
@@ -188,7 +189,7 @@ func (s *FileStorageMountTargetResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *FileStorageMountTargetResourceCrud) Create() error {
+func (s *FileStorageMountTargetResourceCrud) Create(ctx context.Context) error {
 	request := oci_file_storage.CreateMountTargetRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -249,20 +250,20 @@ func (s *FileStorageMountTargetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.CreateMountTarget(context.Background(), request)
+	response, err := s.Client.CreateMountTarget(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.MountTarget
-	if waitErr := tfresource.WaitForCreatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForCreatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
 	return nil
 }
 
-func (s *FileStorageMountTargetResourceCrud) Get() error {
+func (s *FileStorageMountTargetResourceCrud) Get(ctx context.Context) error {
 	request := oci_file_storage.GetMountTargetRequest{}
 
 	tmp := s.D.Id()
@@ -270,7 +271,7 @@ func (s *FileStorageMountTargetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.GetMountTarget(context.Background(), request)
+	response, err := s.Client.GetMountTarget(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -279,11 +280,11 @@ func (s *FileStorageMountTargetResourceCrud) Get() error {
 	return nil
 }
 
-func (s *FileStorageMountTargetResourceCrud) Update() error {
+func (s *FileStorageMountTargetResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -327,7 +328,7 @@ func (s *FileStorageMountTargetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.UpdateMountTarget(context.Background(), request)
+	response, err := s.Client.UpdateMountTarget(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -336,7 +337,7 @@ func (s *FileStorageMountTargetResourceCrud) Update() error {
 	return nil
 }
 
-func (s *FileStorageMountTargetResourceCrud) Delete() error {
+func (s *FileStorageMountTargetResourceCrud) Delete(ctx context.Context) error {
 	request := oci_file_storage.DeleteMountTargetRequest{}
 
 	tmp := s.D.Id()
@@ -344,7 +345,7 @@ func (s *FileStorageMountTargetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	_, err := s.Client.DeleteMountTarget(context.Background(), request)
+	_, err := s.Client.DeleteMountTarget(ctx, request)
 	return err
 }
 
@@ -385,7 +386,7 @@ func (s *FileStorageMountTargetResourceCrud) SetData() error {
 
 	// Service returns only 1 item in this field
 	if len(s.Res.PrivateIpIds) > 0 {
-		err := s.setPrivateIpDetails(s.Res.PrivateIpIds[0])
+		err := s.setPrivateIpDetails(context.Background(), s.Res.PrivateIpIds[0])
 		if err != nil {
 			return err
 		}
@@ -404,7 +405,7 @@ func (s *FileStorageMountTargetResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *FileStorageMountTargetResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *FileStorageMountTargetResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_file_storage.ChangeMountTargetCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -415,26 +416,26 @@ func (s *FileStorageMountTargetResourceCrud) updateCompartment(compartment inter
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	_, err := s.Client.ChangeMountTargetCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeMountTargetCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
 	return nil
 }
 
-func (s *FileStorageMountTargetResourceCrud) setPrivateIpDetails(privateIpOcid string) error {
+func (s *FileStorageMountTargetResourceCrud) setPrivateIpDetails(ctx context.Context, privateIpOcid string) error {
 	request := oci_core.GetPrivateIpRequest{}
 
 	request.PrivateIpId = &privateIpOcid
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
 
-	response, err := s.VirtualNetworkClient.GetPrivateIp(context.Background(), request)
+	response, err := s.VirtualNetworkClient.GetPrivateIp(ctx, request)
 	if err != nil {
 		return err
 	}
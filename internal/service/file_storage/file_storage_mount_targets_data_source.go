@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_file_storage "github.com/oracle/oci-go-sdk/v65/filestorage"
 )
 
 func FileStorageMountTargetsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readFileStorageMountTargets,
+		ReadContext: readFileStorageMountTargets,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -51,12 +52,12 @@ func FileStorageMountTargetsDataSource() *schema.Resource {
 	}
 }
 
-func readFileStorageMountTargets(d *schema.ResourceData, m interface{}) error {
+func readFileStorageMountTargets(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageMountTargetsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type FileStorageMountTargetsDataSourceCrud struct {
@@ -69,7 +70,7 @@ func (s *FileStorageMountTargetsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *FileStorageMountTargetsDataSourceCrud) Get() error {
+func (s *FileStorageMountTargetsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_file_storage.ListMountTargetsRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -103,7 +104,7 @@ func (s *FileStorageMountTargetsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "file_storage")
 
-	response, err := s.Client.ListMountTargets(context.Background(), request)
+	response, err := s.Client.ListMountTargets(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +113,7 @@ func (s *FileStorageMountTargetsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListMountTargets(context.Background(), request)
+		listResponse, err := s.Client.ListMountTargets(ctx, request)
 		if err != nil {
 			return err
 		}
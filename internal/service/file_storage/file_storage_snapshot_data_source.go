@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_file_storage "github.com/oracle/oci-go-sdk/v65/filestorage"
 )
@@ -22,12 +23,12 @@ func FileStorageSnapshotDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(FileStorageSnapshotResource(), fieldMap, readSingularFileStorageSnapshot)
 }
 
-func readSingularFileStorageSnapshot(d *schema.ResourceData, m interface{}) error {
+func readSingularFileStorageSnapshot(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageSnapshotDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type FileStorageSnapshotDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *FileStorageSnapshotDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *FileStorageSnapshotDataSourceCrud) Get() error {
+func (s *FileStorageSnapshotDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_file_storage.GetSnapshotRequest{}
 
 	if snapshotId, ok := s.D.GetOkExists("snapshot_id"); ok {
@@ -50,7 +51,7 @@ func (s *FileStorageSnapshotDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "file_storage")
 
-	response, err := s.Client.GetSnapshot(context.Background(), request)
+	response, err := s.Client.GetSnapshot(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_file_storage "github.com/oracle/oci-go-sdk/v65/filestorage"
@@ -19,11 +20,11 @@ func FileStorageSnapshotResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createFileStorageSnapshot,
-		Read:     readFileStorageSnapshot,
-		Update:   updateFileStorageSnapshot,
-		Delete:   deleteFileStorageSnapshot,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createFileStorageSnapshot,
+		ReadContext:   readFileStorageSnapshot,
+		UpdateContext: updateFileStorageSnapshot,
+		DeleteContext: deleteFileStorageSnapshot,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"file_system_id": {
@@ -77,37 +78,37 @@ func FileStorageSnapshotResource() *schema.Resource {
 	}
 }
 
-func createFileStorageSnapshot(d *schema.ResourceData, m interface{}) error {
+func createFileStorageSnapshot(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageSnapshotResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readFileStorageSnapshot(d *schema.ResourceData, m interface{}) error {
+func readFileStorageSnapshot(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageSnapshotResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateFileStorageSnapshot(d *schema.ResourceData, m interface{}) error {
+func updateFileStorageSnapshot(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageSnapshotResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteFileStorageSnapshot(d *schema.ResourceData, m interface{}) error {
+func deleteFileStorageSnapshot(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageSnapshotResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type FileStorageSnapshotResourceCrud struct {
@@ -145,7 +146,7 @@ func (s *FileStorageSnapshotResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *FileStorageSnapshotResourceCrud) Create() error {
+func (s *FileStorageSnapshotResourceCrud) Create(ctx context.Context) error {
 	request := oci_file_storage.CreateSnapshotRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -172,20 +173,20 @@ func (s *FileStorageSnapshotResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.CreateSnapshot(context.Background(), request)
+	response, err := s.Client.CreateSnapshot(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.Snapshot
-	if waitErr := tfresource.WaitForCreatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForCreatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
 	return nil
 }
 
-func (s *FileStorageSnapshotResourceCrud) Get() error {
+func (s *FileStorageSnapshotResourceCrud) Get(ctx context.Context) error {
 	request := oci_file_storage.GetSnapshotRequest{}
 
 	tmp := s.D.Id()
@@ -193,7 +194,7 @@ func (s *FileStorageSnapshotResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.GetSnapshot(context.Background(), request)
+	response, err := s.Client.GetSnapshot(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -202,7 +203,7 @@ func (s *FileStorageSnapshotResourceCrud) Get() error {
 	return nil
 }
 
-func (s *FileStorageSnapshotResourceCrud) Update() error {
+func (s *FileStorageSnapshotResourceCrud) Update(ctx context.Context) error {
 	request := oci_file_storage.UpdateSnapshotRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -222,7 +223,7 @@ func (s *FileStorageSnapshotResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	response, err := s.Client.UpdateSnapshot(context.Background(), request)
+	response, err := s.Client.UpdateSnapshot(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -231,7 +232,7 @@ func (s *FileStorageSnapshotResourceCrud) Update() error {
 	return nil
 }
 
-func (s *FileStorageSnapshotResourceCrud) Delete() error {
+func (s *FileStorageSnapshotResourceCrud) Delete(ctx context.Context) error {
 	request := oci_file_storage.DeleteSnapshotRequest{}
 
 	tmp := s.D.Id()
@@ -239,7 +240,7 @@ func (s *FileStorageSnapshotResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "file_storage")
 
-	_, err := s.Client.DeleteSnapshot(context.Background(), request)
+	_, err := s.Client.DeleteSnapshot(ctx, request)
 	return err
 }
 
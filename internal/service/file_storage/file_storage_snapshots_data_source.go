@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_file_storage "github.com/oracle/oci-go-sdk/v65/filestorage"
 )
 
 func FileStorageSnapshotsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readFileStorageSnapshots,
+		ReadContext: readFileStorageSnapshots,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"file_system_id": {
@@ -39,12 +40,12 @@ func FileStorageSnapshotsDataSource() *schema.Resource {
 	}
 }
 
-func readFileStorageSnapshots(d *schema.ResourceData, m interface{}) error {
+func readFileStorageSnapshots(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FileStorageSnapshotsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FileStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type FileStorageSnapshotsDataSourceCrud struct {
@@ -57,7 +58,7 @@ func (s *FileStorageSnapshotsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *FileStorageSnapshotsDataSourceCrud) Get() error {
+func (s *FileStorageSnapshotsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_file_storage.ListSnapshotsRequest{}
 
 	if fileSystemId, ok := s.D.GetOkExists("file_system_id"); ok {
@@ -76,7 +77,7 @@ func (s *FileStorageSnapshotsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "file_storage")
 
-	response, err := s.Client.ListSnapshots(context.Background(), request)
+	response, err := s.Client.ListSnapshots(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *FileStorageSnapshotsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSnapshots(context.Background(), request)
+		listResponse, err := s.Client.ListSnapshots(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_functions "github.com/oracle/oci-go-sdk/v65/functions"
 )
@@ -22,12 +23,12 @@ func FunctionsApplicationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(FunctionsApplicationResource(), fieldMap, readSingularFunctionsApplication)
 }
 
-func readSingularFunctionsApplication(d *schema.ResourceData, m interface{}) error {
+func readSingularFunctionsApplication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FunctionsApplicationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FunctionsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type FunctionsApplicationDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *FunctionsApplicationDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *FunctionsApplicationDataSourceCrud) Get() error {
+func (s *FunctionsApplicationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_functions.GetApplicationRequest{}
 
 	if applicationId, ok := s.D.GetOkExists("application_id"); ok {
@@ -50,7 +51,7 @@ func (s *FunctionsApplicationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "functions")
 
-	response, err := s.Client.GetApplication(context.Background(), request)
+	response, err := s.Client.GetApplication(ctx, request)
 	if err != nil {
 		return err
 	}
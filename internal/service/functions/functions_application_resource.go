@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/httpreplay"
@@ -24,11 +25,11 @@ func FunctionsApplicationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createFunctionsApplication,
-		Read:     readFunctionsApplication,
-		Update:   updateFunctionsApplication,
-		Delete:   deleteFunctionsApplication,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createFunctionsApplication,
+		ReadContext:   readFunctionsApplication,
+		UpdateContext: updateFunctionsApplication,
+		DeleteContext: deleteFunctionsApplication,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -165,37 +166,37 @@ func FunctionsApplicationResource() *schema.Resource {
 	}
 }
 
-func createFunctionsApplication(d *schema.ResourceData, m interface{}) error {
+func createFunctionsApplication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FunctionsApplicationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FunctionsManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readFunctionsApplication(d *schema.ResourceData, m interface{}) error {
+func readFunctionsApplication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FunctionsApplicationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FunctionsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateFunctionsApplication(d *schema.ResourceData, m interface{}) error {
+func updateFunctionsApplication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FunctionsApplicationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FunctionsManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteFunctionsApplication(d *schema.ResourceData, m interface{}) error {
+func deleteFunctionsApplication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FunctionsApplicationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FunctionsManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type FunctionsApplicationResourceCrud struct {
@@ -233,7 +234,7 @@ func (s *FunctionsApplicationResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *FunctionsApplicationResourceCrud) Create() error {
+func (s *FunctionsApplicationResourceCrud) Create(ctx context.Context) error {
 	request := oci_functions.CreateApplicationRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -318,7 +319,7 @@ func (s *FunctionsApplicationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
 
-	response, err := s.Client.CreateApplication(context.Background(), request)
+	response, err := s.Client.CreateApplication(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -327,7 +328,7 @@ func (s *FunctionsApplicationResourceCrud) Create() error {
 	return nil
 }
 
-func (s *FunctionsApplicationResourceCrud) Get() error {
+func (s *FunctionsApplicationResourceCrud) Get(ctx context.Context) error {
 	request := oci_functions.GetApplicationRequest{}
 
 	tmp := s.D.Id()
@@ -335,7 +336,7 @@ func (s *FunctionsApplicationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
 
-	response, err := s.Client.GetApplication(context.Background(), request)
+	response, err := s.Client.GetApplication(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -344,11 +345,11 @@ func (s *FunctionsApplicationResourceCrud) Get() error {
 	return nil
 }
 
-func (s *FunctionsApplicationResourceCrud) Update() error {
+func (s *FunctionsApplicationResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -418,7 +419,7 @@ func (s *FunctionsApplicationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
 
-	response, err := s.Client.UpdateApplication(context.Background(), request)
+	response, err := s.Client.UpdateApplication(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -427,7 +428,7 @@ func (s *FunctionsApplicationResourceCrud) Update() error {
 	return nil
 }
 
-func (s *FunctionsApplicationResourceCrud) Delete() error {
+func (s *FunctionsApplicationResourceCrud) Delete(ctx context.Context) error {
 	request := oci_functions.DeleteApplicationRequest{}
 
 	tmp := s.D.Id()
@@ -435,7 +436,7 @@ func (s *FunctionsApplicationResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
 
-	_, err := s.Client.DeleteApplication(context.Background(), request)
+	_, err := s.Client.DeleteApplication(ctx, request)
 	return err
 }
 
@@ -588,7 +589,7 @@ func KeyDetailsToMapFunctions(obj oci_functions.KeyDetails) map[string]interface
 	return result
 }
 
-func (s *FunctionsApplicationResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *FunctionsApplicationResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_functions.ChangeApplicationCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -599,12 +600,12 @@ func (s *FunctionsApplicationResourceCrud) updateCompartment(compartment interfa
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
 
-	_, err := s.Client.ChangeApplicationCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeApplicationCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_functions "github.com/oracle/oci-go-sdk/v65/functions"
 )
 
 func FunctionsApplicationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readFunctionsApplications,
+		ReadContext: readFunctionsApplications,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,12 +44,12 @@ func FunctionsApplicationsDataSource() *schema.Resource {
 	}
 }
 
-func readFunctionsApplications(d *schema.ResourceData, m interface{}) error {
+func readFunctionsApplications(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FunctionsApplicationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FunctionsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type FunctionsApplicationsDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *FunctionsApplicationsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *FunctionsApplicationsDataSourceCrud) Get() error {
+func (s *FunctionsApplicationsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_functions.ListApplicationsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -85,7 +86,7 @@ func (s *FunctionsApplicationsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "functions")
 
-	response, err := s.Client.ListApplications(context.Background(), request)
+	response, err := s.Client.ListApplications(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *FunctionsApplicationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListApplications(context.Background(), request)
+		listResponse, err := s.Client.ListApplications(ctx, request)
 		if err != nil {
 			return err
 		}
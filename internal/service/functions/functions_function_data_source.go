@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_functions "github.com/oracle/oci-go-sdk/v65/functions"
 )
@@ -23,12 +24,12 @@ func FunctionsFunctionDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(FunctionsFunctionResource(), fieldMap, readSingularFunctionsFunction)
 }
 
-func readSingularFunctionsFunction(d *schema.ResourceData, m interface{}) error {
+func readSingularFunctionsFunction(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FunctionsFunctionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FunctionsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type FunctionsFunctionDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *FunctionsFunctionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *FunctionsFunctionDataSourceCrud) Get() error {
+func (s *FunctionsFunctionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_functions.GetFunctionRequest{}
 
 	if functionId, ok := s.D.GetOkExists("function_id"); ok {
@@ -51,7 +52,7 @@ func (s *FunctionsFunctionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "functions")
 
-	response, err := s.Client.GetFunction(context.Background(), request)
+	response, err := s.Client.GetFunction(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -44,11 +45,11 @@ func FunctionsFunctionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createFunctionsFunction,
-		Read:     readFunctionsFunction,
-		Update:   updateFunctionsFunction,
-		Delete:   deleteFunctionsFunction,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createFunctionsFunction,
+		ReadContext:   readFunctionsFunction,
+		UpdateContext: updateFunctionsFunction,
+		DeleteContext: deleteFunctionsFunction,
 		CustomizeDiff: customdiff.All(
 			customdiff.IfValueChange("image",
 				func(ctx context.Context, old, new, meta interface{}) bool {
@@ -210,37 +211,37 @@ func FunctionsFunctionResource() *schema.Resource {
 	}
 }
 
-func createFunctionsFunction(d *schema.ResourceData, m interface{}) error {
+func createFunctionsFunction(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FunctionsFunctionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FunctionsManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readFunctionsFunction(d *schema.ResourceData, m interface{}) error {
+func readFunctionsFunction(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FunctionsFunctionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FunctionsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateFunctionsFunction(d *schema.ResourceData, m interface{}) error {
+func updateFunctionsFunction(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FunctionsFunctionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FunctionsManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteFunctionsFunction(d *schema.ResourceData, m interface{}) error {
+func deleteFunctionsFunction(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FunctionsFunctionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FunctionsManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type FunctionsFunctionResourceCrud struct {
@@ -278,7 +279,7 @@ func (s *FunctionsFunctionResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *FunctionsFunctionResourceCrud) Create() error {
+func (s *FunctionsFunctionResourceCrud) Create(ctx context.Context) error {
 	request := oci_functions.CreateFunctionRequest{}
 
 	if applicationId, ok := s.D.GetOkExists("application_id"); ok {
@@ -359,7 +360,7 @@ func (s *FunctionsFunctionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
 
-	response, err := s.Client.CreateFunction(context.Background(), request)
+	response, err := s.Client.CreateFunction(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -368,7 +369,7 @@ func (s *FunctionsFunctionResourceCrud) Create() error {
 	return nil
 }
 
-func (s *FunctionsFunctionResourceCrud) Get() error {
+func (s *FunctionsFunctionResourceCrud) Get(ctx context.Context) error {
 	request := oci_functions.GetFunctionRequest{}
 
 	tmp := s.D.Id()
@@ -376,7 +377,7 @@ func (s *FunctionsFunctionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
 
-	response, err := s.Client.GetFunction(context.Background(), request)
+	response, err := s.Client.GetFunction(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -385,7 +386,7 @@ func (s *FunctionsFunctionResourceCrud) Get() error {
 	return nil
 }
 
-func (s *FunctionsFunctionResourceCrud) Update() error {
+func (s *FunctionsFunctionResourceCrud) Update(ctx context.Context) error {
 	request := oci_functions.UpdateFunctionRequest{}
 
 	if config, ok := s.D.GetOkExists("config"); ok {
@@ -458,7 +459,7 @@ func (s *FunctionsFunctionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
 
-	response, err := s.Client.UpdateFunction(context.Background(), request)
+	response, err := s.Client.UpdateFunction(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -467,7 +468,7 @@ func (s *FunctionsFunctionResourceCrud) Update() error {
 	return nil
 }
 
-func (s *FunctionsFunctionResourceCrud) Delete() error {
+func (s *FunctionsFunctionResourceCrud) Delete(ctx context.Context) error {
 	request := oci_functions.DeleteFunctionRequest{}
 
 	tmp := s.D.Id()
@@ -475,7 +476,7 @@ func (s *FunctionsFunctionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "functions")
 
-	_, err := s.Client.DeleteFunction(context.Background(), request)
+	_, err := s.Client.DeleteFunction(ctx, request)
 	return err
 }
 
@@ -10,13 +10,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_functions "github.com/oracle/oci-go-sdk/v65/functions"
 )
 
 func FunctionsFunctionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readFunctionsFunctions,
+		ReadContext: readFunctionsFunctions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"application_id": {
@@ -44,12 +45,12 @@ func FunctionsFunctionsDataSource() *schema.Resource {
 	}
 }
 
-func readFunctionsFunctions(d *schema.ResourceData, m interface{}) error {
+func readFunctionsFunctions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FunctionsFunctionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FunctionsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type FunctionsFunctionsDataSourceCrud struct {
@@ -62,7 +63,7 @@ func (s *FunctionsFunctionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *FunctionsFunctionsDataSourceCrud) Get() error {
+func (s *FunctionsFunctionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_functions.ListFunctionsRequest{}
 
 	if applicationId, ok := s.D.GetOkExists("application_id"); ok {
@@ -86,7 +87,7 @@ func (s *FunctionsFunctionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "functions")
 
-	response, err := s.Client.ListFunctions(context.Background(), request)
+	response, err := s.Client.ListFunctions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +96,7 @@ func (s *FunctionsFunctionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListFunctions(context.Background(), request)
+		listResponse, err := s.Client.ListFunctions(ctx, request)
 		if err != nil {
 			return err
 		}
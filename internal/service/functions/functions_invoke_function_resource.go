@@ -15,6 +15,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_functions "github.com/oracle/oci-go-sdk/v65/functions"
@@ -22,10 +23,10 @@ import (
 
 func FunctionsInvokeFunctionResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createFunctionsInvokeFunction,
-		Read:     readFunctionsInvokeFunction,
-		Delete:   deleteFunctionsInvokeFunction,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createFunctionsInvokeFunction,
+		ReadContext:   readFunctionsInvokeFunction,
+		DeleteContext: deleteFunctionsInvokeFunction,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"function_id": {
@@ -89,26 +90,26 @@ func FunctionsInvokeFunctionResource() *schema.Resource {
 	}
 }
 
-func createFunctionsInvokeFunction(d *schema.ResourceData, m interface{}) error {
+func createFunctionsInvokeFunction(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FunctionsInvokeFunctionResourceCrud{}
 	sync.D = d
 
 	endPoint, ok := d.GetOkExists("invoke_endpoint")
 	if !ok {
 		var err error
-		endPoint, err = sync.getInvokeEndPoint(m)
+		endPoint, err = sync.getInvokeEndPoint(ctx, m)
 		if err != nil {
-			return err
+			return tfresource.HandleDiagError(err)
 		}
 		// cache endpoint as it won't change for a function
 		d.Set("invoke_endpoint", endPoint)
 	}
 
 	sync.Client, _ = m.(*client.OracleClients).FunctionsInvokeClientWithEndpoint(endPoint.(string))
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func (s *FunctionsInvokeFunctionResourceCrud) getInvokeEndPoint(m interface{}) (string, error) {
+func (s *FunctionsInvokeFunctionResourceCrud) getInvokeEndPoint(ctx context.Context, m interface{}) (string, error) {
 	functionsManagementClient := m.(*client.OracleClients).FunctionsManagementClient()
 	functionGetRequest := oci_functions.GetFunctionRequest{}
 
@@ -119,7 +120,7 @@ func (s *FunctionsInvokeFunctionResourceCrud) getInvokeEndPoint(m interface{}) (
 
 	functionGetRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "functions")
 
-	functionGetResponse, err := functionsManagementClient.GetFunction(context.Background(), functionGetRequest)
+	functionGetResponse, err := functionsManagementClient.GetFunction(ctx, functionGetRequest)
 	if err != nil {
 		return "", err
 	}
@@ -132,11 +133,11 @@ func (s *FunctionsInvokeFunctionResourceCrud) getInvokeEndPoint(m interface{}) (
 	return endPoint, nil
 }
 
-func readFunctionsInvokeFunction(d *schema.ResourceData, m interface{}) error {
+func readFunctionsInvokeFunction(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteFunctionsInvokeFunction(d *schema.ResourceData, m interface{}) error {
+func deleteFunctionsInvokeFunction(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -151,7 +152,7 @@ func (s *FunctionsInvokeFunctionResourceCrud) ID() string {
 	return tfresource.Timestamp()
 }
 
-func (s *FunctionsInvokeFunctionResourceCrud) Create() error {
+func (s *FunctionsInvokeFunctionResourceCrud) Create(ctx context.Context) error {
 	request := oci_functions.InvokeFunctionRequest{}
 
 	if invokeFunctionBody, ok := s.D.GetOkExists("invoke_function_body"); ok {
@@ -195,7 +196,7 @@ func (s *FunctionsInvokeFunctionResourceCrud) Create() error {
 		s.Client.Host = endPoint.(string)
 	}
 
-	response, err := s.Client.InvokeFunction(context.Background(), request)
+	response, err := s.Client.InvokeFunction(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package fusion_apps
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_fusion_apps "github.com/oracle/oci-go-sdk/v65/fusionapps"
 
@@ -22,12 +23,12 @@ func FusionAppsFusionEnvironmentAdminUserDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(FusionAppsFusionEnvironmentAdminUserResource(), fieldMap, readSingularFusionAppsFusionEnvironmentAdminUser)
 }
 
-func readSingularFusionAppsFusionEnvironmentAdminUser(d *schema.ResourceData, m interface{}) error {
+func readSingularFusionAppsFusionEnvironmentAdminUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FusionAppsFusionEnvironmentAdminUserDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FusionApplicationsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type FusionAppsFusionEnvironmentAdminUserDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *FusionAppsFusionEnvironmentAdminUserDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *FusionAppsFusionEnvironmentAdminUserDataSourceCrud) Get() error {
+func (s *FusionAppsFusionEnvironmentAdminUserDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_fusion_apps.ListAdminUsersRequest{}
 
 	if fusionEnvironmentId, ok := s.D.GetOkExists("fusion_environment_id"); ok {
@@ -50,7 +51,7 @@ func (s *FusionAppsFusionEnvironmentAdminUserDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "fusion_apps")
 
-	response, err := s.Client.ListAdminUsers(context.Background(), request)
+	response, err := s.Client.ListAdminUsers(ctx, request)
 	if err != nil {
 		return err
 	}
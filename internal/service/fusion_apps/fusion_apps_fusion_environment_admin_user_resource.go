@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -27,10 +28,10 @@ func FusionAppsFusionEnvironmentAdminUserResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createFusionAppsFusionEnvironmentAdminUser,
-		Read:     readFusionAppsFusionEnvironmentAdminUser,
-		Delete:   deleteFusionAppsFusionEnvironmentAdminUser,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createFusionAppsFusionEnvironmentAdminUser,
+		ReadContext:   readFusionAppsFusionEnvironmentAdminUser,
+		DeleteContext: deleteFusionAppsFusionEnvironmentAdminUser,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"email_address": {
@@ -101,29 +102,29 @@ func FusionAppsFusionEnvironmentAdminUserResource() *schema.Resource {
 	}
 }
 
-func createFusionAppsFusionEnvironmentAdminUser(d *schema.ResourceData, m interface{}) error {
+func createFusionAppsFusionEnvironmentAdminUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FusionAppsFusionEnvironmentAdminUserResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FusionApplicationsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readFusionAppsFusionEnvironmentAdminUser(d *schema.ResourceData, m interface{}) error {
+func readFusionAppsFusionEnvironmentAdminUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FusionAppsFusionEnvironmentAdminUserResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FusionApplicationsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteFusionAppsFusionEnvironmentAdminUser(d *schema.ResourceData, m interface{}) error {
+func deleteFusionAppsFusionEnvironmentAdminUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FusionAppsFusionEnvironmentAdminUserResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FusionApplicationsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type FusionAppsFusionEnvironmentAdminUserResourceCrud struct {
@@ -137,7 +138,7 @@ func (s *FusionAppsFusionEnvironmentAdminUserResourceCrud) ID() string {
 	return GetFusionEnvironmentAdminUserCompositeId(s.D.Get("username").(string), s.D.Get("fusion_environment_id").(string))
 }
 
-func (s *FusionAppsFusionEnvironmentAdminUserResourceCrud) Create() error {
+func (s *FusionAppsFusionEnvironmentAdminUserResourceCrud) Create(ctx context.Context) error {
 	request := oci_fusion_apps.CreateFusionEnvironmentAdminUserRequest{}
 
 	if emailAddress, ok := s.D.GetOkExists("email_address"); ok {
@@ -172,20 +173,20 @@ func (s *FusionAppsFusionEnvironmentAdminUserResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps")
 
-	response, err := s.Client.CreateFusionEnvironmentAdminUser(context.Background(), request)
+	response, err := s.Client.CreateFusionEnvironmentAdminUser(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getFusionEnvironmentAdminUserFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps"), oci_fusion_apps.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getFusionEnvironmentAdminUserFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps"), oci_fusion_apps.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *FusionAppsFusionEnvironmentAdminUserResourceCrud) getFusionEnvironmentAdminUserFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *FusionAppsFusionEnvironmentAdminUserResourceCrud) getFusionEnvironmentAdminUserFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_fusion_apps.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	fusionEnvironmentAdminUserId, err := fusionEnvironmentAdminUserWaitForWorkRequest(workId, "fusionenvironment",
+	fusionEnvironmentAdminUserId, err := fusionEnvironmentAdminUserWaitForWorkRequest(ctx, workId, "fusionenvironment",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -193,7 +194,7 @@ func (s *FusionAppsFusionEnvironmentAdminUserResourceCrud) getFusionEnvironmentA
 	}
 	s.D.SetId(*fusionEnvironmentAdminUserId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func fusionEnvironmentAdminUserWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -219,7 +220,7 @@ func fusionEnvironmentAdminUserWorkRequestShouldRetryFunc(timeout time.Duration)
 	}
 }
 
-func fusionEnvironmentAdminUserWaitForWorkRequest(wId *string, entityType string, action oci_fusion_apps.WorkRequestResourceActionTypeEnum,
+func fusionEnvironmentAdminUserWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_fusion_apps.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_fusion_apps.FusionApplicationsClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "fusion_apps")
 	retryPolicy.ShouldRetryOperation = fusionEnvironmentAdminUserWorkRequestShouldRetryFunc(timeout)
@@ -238,7 +239,7 @@ func fusionEnvironmentAdminUserWaitForWorkRequest(wId *string, entityType string
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_fusion_apps.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -267,14 +268,14 @@ func fusionEnvironmentAdminUserWaitForWorkRequest(wId *string, entityType string
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_fusion_apps.WorkRequestStatusFailed || response.Status == oci_fusion_apps.WorkRequestStatusCanceled {
-		return nil, getErrorFromFusionAppsFusionEnvironmentAdminUserWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromFusionAppsFusionEnvironmentAdminUserWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromFusionAppsFusionEnvironmentAdminUserWorkRequest(client *oci_fusion_apps.FusionApplicationsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_fusion_apps.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromFusionAppsFusionEnvironmentAdminUserWorkRequest(ctx context.Context, client *oci_fusion_apps.FusionApplicationsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_fusion_apps.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_fusion_apps.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -296,7 +297,7 @@ func getErrorFromFusionAppsFusionEnvironmentAdminUserWorkRequest(client *oci_fus
 	return workRequestErr
 }
 
-func (s *FusionAppsFusionEnvironmentAdminUserResourceCrud) Get() error {
+func (s *FusionAppsFusionEnvironmentAdminUserResourceCrud) Get(ctx context.Context) error {
 	request := oci_fusion_apps.ListAdminUsersRequest{}
 
 	if fusionEnvironmentId, ok := s.D.GetOkExists("fusion_environment_id"); ok {
@@ -313,7 +314,7 @@ func (s *FusionAppsFusionEnvironmentAdminUserResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps")
 
-	response, err := s.Client.ListAdminUsers(context.Background(), request)
+	response, err := s.Client.ListAdminUsers(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -322,7 +323,7 @@ func (s *FusionAppsFusionEnvironmentAdminUserResourceCrud) Get() error {
 	return nil
 }
 
-func (s *FusionAppsFusionEnvironmentAdminUserResourceCrud) Delete() error {
+func (s *FusionAppsFusionEnvironmentAdminUserResourceCrud) Delete(ctx context.Context) error {
 	request := oci_fusion_apps.DeleteFusionEnvironmentAdminUserRequest{}
 
 	if adminUsername, ok := s.D.GetOkExists("username"); ok {
@@ -337,14 +338,14 @@ func (s *FusionAppsFusionEnvironmentAdminUserResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps")
 
-	response, err := s.Client.DeleteFusionEnvironmentAdminUser(context.Background(), request)
+	response, err := s.Client.DeleteFusionEnvironmentAdminUser(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := fusionEnvironmentAdminUserWaitForWorkRequest(workId, "fusionenvironment",
+	_, delWorkRequestErr := fusionEnvironmentAdminUserWaitForWorkRequest(ctx, workId, "fusionenvironment",
 		oci_fusion_apps.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
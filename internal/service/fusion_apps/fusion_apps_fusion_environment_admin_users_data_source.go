@@ -6,6 +6,7 @@ package fusion_apps
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_fusion_apps "github.com/oracle/oci-go-sdk/v65/fusionapps"
 
@@ -15,7 +16,7 @@ import (
 
 func FusionAppsFusionEnvironmentAdminUsersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readFusionAppsFusionEnvironmentAdminUsers,
+		ReadContext: readFusionAppsFusionEnvironmentAdminUsers,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"fusion_environment_id": {
@@ -40,12 +41,12 @@ func FusionAppsFusionEnvironmentAdminUsersDataSource() *schema.Resource {
 	}
 }
 
-func readFusionAppsFusionEnvironmentAdminUsers(d *schema.ResourceData, m interface{}) error {
+func readFusionAppsFusionEnvironmentAdminUsers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FusionAppsFusionEnvironmentAdminUsersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FusionApplicationsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type FusionAppsFusionEnvironmentAdminUsersDataSourceCrud struct {
@@ -58,7 +59,7 @@ func (s *FusionAppsFusionEnvironmentAdminUsersDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *FusionAppsFusionEnvironmentAdminUsersDataSourceCrud) Get() error {
+func (s *FusionAppsFusionEnvironmentAdminUsersDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_fusion_apps.ListAdminUsersRequest{}
 
 	if fusionEnvironmentId, ok := s.D.GetOkExists("fusion_environment_id"); ok {
@@ -68,7 +69,7 @@ func (s *FusionAppsFusionEnvironmentAdminUsersDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "fusion_apps")
 
-	response, err := s.Client.ListAdminUsers(context.Background(), request)
+	response, err := s.Client.ListAdminUsers(ctx, request)
 	if err != nil {
 		return err
 	}
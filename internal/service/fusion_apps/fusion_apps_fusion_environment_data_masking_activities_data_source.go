@@ -6,6 +6,7 @@ package fusion_apps
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_fusion_apps "github.com/oracle/oci-go-sdk/v65/fusionapps"
 
@@ -15,7 +16,7 @@ import (
 
 func FusionAppsFusionEnvironmentDataMaskingActivitiesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readFusionAppsFusionEnvironmentDataMaskingActivities,
+		ReadContext: readFusionAppsFusionEnvironmentDataMaskingActivities,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"fusion_environment_id": {
@@ -44,12 +45,12 @@ func FusionAppsFusionEnvironmentDataMaskingActivitiesDataSource() *schema.Resour
 	}
 }
 
-func readFusionAppsFusionEnvironmentDataMaskingActivities(d *schema.ResourceData, m interface{}) error {
+func readFusionAppsFusionEnvironmentDataMaskingActivities(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FusionAppsFusionEnvironmentDataMaskingActivitiesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FusionApplicationsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type FusionAppsFusionEnvironmentDataMaskingActivitiesDataSourceCrud struct {
@@ -62,7 +63,7 @@ func (s *FusionAppsFusionEnvironmentDataMaskingActivitiesDataSourceCrud) VoidSta
 	s.D.SetId("")
 }
 
-func (s *FusionAppsFusionEnvironmentDataMaskingActivitiesDataSourceCrud) Get() error {
+func (s *FusionAppsFusionEnvironmentDataMaskingActivitiesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_fusion_apps.ListDataMaskingActivitiesRequest{}
 
 	if fusionEnvironmentId, ok := s.D.GetOkExists("fusion_environment_id"); ok {
@@ -76,7 +77,7 @@ func (s *FusionAppsFusionEnvironmentDataMaskingActivitiesDataSourceCrud) Get() e
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "fusion_apps")
 
-	response, err := s.Client.ListDataMaskingActivities(context.Background(), request)
+	response, err := s.Client.ListDataMaskingActivities(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *FusionAppsFusionEnvironmentDataMaskingActivitiesDataSourceCrud) Get() e
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDataMaskingActivities(context.Background(), request)
+		listResponse, err := s.Client.ListDataMaskingActivities(ctx, request)
 		if err != nil {
 			return err
 		}
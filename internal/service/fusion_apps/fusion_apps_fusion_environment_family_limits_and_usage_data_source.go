@@ -6,6 +6,7 @@ package fusion_apps
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_fusion_apps "github.com/oracle/oci-go-sdk/v65/fusionapps"
 
@@ -15,7 +16,7 @@ import (
 
 func FusionAppsFusionEnvironmentFamilyLimitsAndUsageDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularFusionAppsFusionEnvironmentFamilyLimitsAndUsage,
+		ReadContext: readSingularFusionAppsFusionEnvironmentFamilyLimitsAndUsage,
 		Schema: map[string]*schema.Schema{
 			"fusion_environment_family_id": {
 				Type:     schema.TypeString,
@@ -89,12 +90,12 @@ func FusionAppsFusionEnvironmentFamilyLimitsAndUsageDataSource() *schema.Resourc
 	}
 }
 
-func readSingularFusionAppsFusionEnvironmentFamilyLimitsAndUsage(d *schema.ResourceData, m interface{}) error {
+func readSingularFusionAppsFusionEnvironmentFamilyLimitsAndUsage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FusionAppsFusionEnvironmentFamilyLimitsAndUsageDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FusionApplicationsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type FusionAppsFusionEnvironmentFamilyLimitsAndUsageDataSourceCrud struct {
@@ -107,7 +108,7 @@ func (s *FusionAppsFusionEnvironmentFamilyLimitsAndUsageDataSourceCrud) VoidStat
 	s.D.SetId("")
 }
 
-func (s *FusionAppsFusionEnvironmentFamilyLimitsAndUsageDataSourceCrud) Get() error {
+func (s *FusionAppsFusionEnvironmentFamilyLimitsAndUsageDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_fusion_apps.GetFusionEnvironmentFamilyLimitsAndUsageRequest{}
 
 	if fusionEnvironmentFamilyId, ok := s.D.GetOkExists("fusion_environment_family_id"); ok {
@@ -117,7 +118,7 @@ func (s *FusionAppsFusionEnvironmentFamilyLimitsAndUsageDataSourceCrud) Get() er
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "fusion_apps")
 
-	response, err := s.Client.GetFusionEnvironmentFamilyLimitsAndUsage(context.Background(), request)
+	response, err := s.Client.GetFusionEnvironmentFamilyLimitsAndUsage(ctx, request)
 	if err != nil {
 		return err
 	}
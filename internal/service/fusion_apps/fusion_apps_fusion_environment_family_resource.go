@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,11 +25,11 @@ func FusionAppsFusionEnvironmentFamilyResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createFusionAppsFusionEnvironmentFamily,
-		Read:     readFusionAppsFusionEnvironmentFamily,
-		Update:   updateFusionAppsFusionEnvironmentFamily,
-		Delete:   deleteFusionAppsFusionEnvironmentFamily,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createFusionAppsFusionEnvironmentFamily,
+		ReadContext:   readFusionAppsFusionEnvironmentFamily,
+		UpdateContext: updateFusionAppsFusionEnvironmentFamily,
+		DeleteContext: deleteFusionAppsFusionEnvironmentFamily,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -123,37 +124,37 @@ func FusionAppsFusionEnvironmentFamilyResource() *schema.Resource {
 	}
 }
 
-func createFusionAppsFusionEnvironmentFamily(d *schema.ResourceData, m interface{}) error {
+func createFusionAppsFusionEnvironmentFamily(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FusionAppsFusionEnvironmentFamilyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FusionApplicationsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readFusionAppsFusionEnvironmentFamily(d *schema.ResourceData, m interface{}) error {
+func readFusionAppsFusionEnvironmentFamily(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FusionAppsFusionEnvironmentFamilyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FusionApplicationsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateFusionAppsFusionEnvironmentFamily(d *schema.ResourceData, m interface{}) error {
+func updateFusionAppsFusionEnvironmentFamily(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FusionAppsFusionEnvironmentFamilyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FusionApplicationsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteFusionAppsFusionEnvironmentFamily(d *schema.ResourceData, m interface{}) error {
+func deleteFusionAppsFusionEnvironmentFamily(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FusionAppsFusionEnvironmentFamilyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FusionApplicationsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type FusionAppsFusionEnvironmentFamilyResourceCrud struct {
@@ -191,7 +192,7 @@ func (s *FusionAppsFusionEnvironmentFamilyResourceCrud) DeletedTarget() []string
 	}
 }
 
-func (s *FusionAppsFusionEnvironmentFamilyResourceCrud) Create() error {
+func (s *FusionAppsFusionEnvironmentFamilyResourceCrud) Create(ctx context.Context) error {
 	request := oci_fusion_apps.CreateFusionEnvironmentFamilyRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -242,20 +243,20 @@ func (s *FusionAppsFusionEnvironmentFamilyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps")
 
-	response, err := s.Client.CreateFusionEnvironmentFamily(context.Background(), request)
+	response, err := s.Client.CreateFusionEnvironmentFamily(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getFusionEnvironmentFamilyFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps"), oci_fusion_apps.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getFusionEnvironmentFamilyFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps"), oci_fusion_apps.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *FusionAppsFusionEnvironmentFamilyResourceCrud) getFusionEnvironmentFamilyFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *FusionAppsFusionEnvironmentFamilyResourceCrud) getFusionEnvironmentFamilyFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_fusion_apps.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	fusionEnvironmentFamilyId, err := fusionEnvironmentFamilyWaitForWorkRequest(workId, "fusionenvironmentfamily",
+	fusionEnvironmentFamilyId, err := fusionEnvironmentFamilyWaitForWorkRequest(ctx, workId, "fusionenvironmentfamily",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -263,7 +264,7 @@ func (s *FusionAppsFusionEnvironmentFamilyResourceCrud) getFusionEnvironmentFami
 	}
 	s.D.SetId(*fusionEnvironmentFamilyId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func fusionEnvironmentFamilyWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -289,7 +290,7 @@ func fusionEnvironmentFamilyWorkRequestShouldRetryFunc(timeout time.Duration) fu
 	}
 }
 
-func fusionEnvironmentFamilyWaitForWorkRequest(wId *string, entityType string, action oci_fusion_apps.WorkRequestResourceActionTypeEnum,
+func fusionEnvironmentFamilyWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_fusion_apps.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_fusion_apps.FusionApplicationsClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "fusion_apps")
 	retryPolicy.ShouldRetryOperation = fusionEnvironmentFamilyWorkRequestShouldRetryFunc(timeout)
@@ -308,7 +309,7 @@ func fusionEnvironmentFamilyWaitForWorkRequest(wId *string, entityType string, a
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_fusion_apps.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -337,14 +338,14 @@ func fusionEnvironmentFamilyWaitForWorkRequest(wId *string, entityType string, a
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_fusion_apps.WorkRequestStatusFailed || response.Status == oci_fusion_apps.WorkRequestStatusCanceled {
-		return nil, getErrorFromFusionAppsFusionEnvironmentFamilyWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromFusionAppsFusionEnvironmentFamilyWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromFusionAppsFusionEnvironmentFamilyWorkRequest(client *oci_fusion_apps.FusionApplicationsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_fusion_apps.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromFusionAppsFusionEnvironmentFamilyWorkRequest(ctx context.Context, client *oci_fusion_apps.FusionApplicationsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_fusion_apps.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_fusion_apps.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -366,7 +367,7 @@ func getErrorFromFusionAppsFusionEnvironmentFamilyWorkRequest(client *oci_fusion
 	return workRequestErr
 }
 
-func (s *FusionAppsFusionEnvironmentFamilyResourceCrud) Get() error {
+func (s *FusionAppsFusionEnvironmentFamilyResourceCrud) Get(ctx context.Context) error {
 	request := oci_fusion_apps.GetFusionEnvironmentFamilyRequest{}
 
 	tmp := s.D.Id()
@@ -374,7 +375,7 @@ func (s *FusionAppsFusionEnvironmentFamilyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps")
 
-	response, err := s.Client.GetFusionEnvironmentFamily(context.Background(), request)
+	response, err := s.Client.GetFusionEnvironmentFamily(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -383,11 +384,11 @@ func (s *FusionAppsFusionEnvironmentFamilyResourceCrud) Get() error {
 	return nil
 }
 
-func (s *FusionAppsFusionEnvironmentFamilyResourceCrud) Update() error {
+func (s *FusionAppsFusionEnvironmentFamilyResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -441,16 +442,16 @@ func (s *FusionAppsFusionEnvironmentFamilyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps")
 
-	response, err := s.Client.UpdateFusionEnvironmentFamily(context.Background(), request)
+	response, err := s.Client.UpdateFusionEnvironmentFamily(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getFusionEnvironmentFamilyFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps"), oci_fusion_apps.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getFusionEnvironmentFamilyFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps"), oci_fusion_apps.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *FusionAppsFusionEnvironmentFamilyResourceCrud) Delete() error {
+func (s *FusionAppsFusionEnvironmentFamilyResourceCrud) Delete(ctx context.Context) error {
 	request := oci_fusion_apps.DeleteFusionEnvironmentFamilyRequest{}
 
 	tmp := s.D.Id()
@@ -458,14 +459,14 @@ func (s *FusionAppsFusionEnvironmentFamilyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps")
 
-	response, err := s.Client.DeleteFusionEnvironmentFamily(context.Background(), request)
+	response, err := s.Client.DeleteFusionEnvironmentFamily(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := fusionEnvironmentFamilyWaitForWorkRequest(workId, "fusionenvironmentfamily",
+	_, delWorkRequestErr := fusionEnvironmentFamilyWaitForWorkRequest(ctx, workId, "fusionenvironmentfamily",
 		oci_fusion_apps.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -582,7 +583,7 @@ func FusionEnvironmentFamilySummaryToMap(obj oci_fusion_apps.FusionEnvironmentFa
 	return result
 }
 
-func (s *FusionAppsFusionEnvironmentFamilyResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *FusionAppsFusionEnvironmentFamilyResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_fusion_apps.ChangeFusionEnvironmentFamilyCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -593,13 +594,13 @@ func (s *FusionAppsFusionEnvironmentFamilyResourceCrud) updateCompartment(compar
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps")
 
-	response, err := s.Client.ChangeFusionEnvironmentFamilyCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeFusionEnvironmentFamilyCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getFusionEnvironmentFamilyFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps"), oci_fusion_apps.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getFusionEnvironmentFamilyFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps"), oci_fusion_apps.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *FusionAppsFusionEnvironmentFamilyResourceCrud) mapToFamilyMaintenancePolicy(fieldKeyFormat string) (oci_fusion_apps.FamilyMaintenancePolicy, error) {
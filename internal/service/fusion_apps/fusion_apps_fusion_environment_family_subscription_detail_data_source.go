@@ -6,6 +6,7 @@ package fusion_apps
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_fusion_apps "github.com/oracle/oci-go-sdk/v65/fusionapps"
 
@@ -15,7 +16,7 @@ import (
 
 func FusionAppsFusionEnvironmentFamilySubscriptionDetailDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularFusionAppsFusionEnvironmentFamilySubscriptionDetail,
+		ReadContext: readSingularFusionAppsFusionEnvironmentFamilySubscriptionDetail,
 		Schema: map[string]*schema.Schema{
 			"fusion_environment_family_id": {
 				Type:     schema.TypeString,
@@ -84,12 +85,12 @@ func FusionAppsFusionEnvironmentFamilySubscriptionDetailDataSource() *schema.Res
 	}
 }
 
-func readSingularFusionAppsFusionEnvironmentFamilySubscriptionDetail(d *schema.ResourceData, m interface{}) error {
+func readSingularFusionAppsFusionEnvironmentFamilySubscriptionDetail(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FusionAppsFusionEnvironmentFamilySubscriptionDetailDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FusionApplicationsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type FusionAppsFusionEnvironmentFamilySubscriptionDetailDataSourceCrud struct {
@@ -102,7 +103,7 @@ func (s *FusionAppsFusionEnvironmentFamilySubscriptionDetailDataSourceCrud) Void
 	s.D.SetId("")
 }
 
-func (s *FusionAppsFusionEnvironmentFamilySubscriptionDetailDataSourceCrud) Get() error {
+func (s *FusionAppsFusionEnvironmentFamilySubscriptionDetailDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_fusion_apps.GetFusionEnvironmentFamilySubscriptionDetailRequest{}
 
 	if fusionEnvironmentFamilyId, ok := s.D.GetOkExists("fusion_environment_family_id"); ok {
@@ -112,7 +113,7 @@ func (s *FusionAppsFusionEnvironmentFamilySubscriptionDetailDataSourceCrud) Get(
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "fusion_apps")
 
-	response, err := s.Client.GetFusionEnvironmentFamilySubscriptionDetail(context.Background(), request)
+	response, err := s.Client.GetFusionEnvironmentFamilySubscriptionDetail(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -27,10 +28,10 @@ func FusionAppsFusionEnvironmentRefreshActivityResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createFusionAppsFusionEnvironmentRefreshActivity,
-		Read:     readFusionAppsFusionEnvironmentRefreshActivity,
-		Delete:   deleteFusionAppsFusionEnvironmentRefreshActivity,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createFusionAppsFusionEnvironmentRefreshActivity,
+		ReadContext:   readFusionAppsFusionEnvironmentRefreshActivity,
+		DeleteContext: deleteFusionAppsFusionEnvironmentRefreshActivity,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"fusion_environment_id": {
@@ -91,23 +92,23 @@ func FusionAppsFusionEnvironmentRefreshActivityResource() *schema.Resource {
 	}
 }
 
-func createFusionAppsFusionEnvironmentRefreshActivity(d *schema.ResourceData, m interface{}) error {
+func createFusionAppsFusionEnvironmentRefreshActivity(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FusionAppsFusionEnvironmentRefreshActivityResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FusionApplicationsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readFusionAppsFusionEnvironmentRefreshActivity(d *schema.ResourceData, m interface{}) error {
+func readFusionAppsFusionEnvironmentRefreshActivity(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FusionAppsFusionEnvironmentRefreshActivityResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FusionApplicationsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteFusionAppsFusionEnvironmentRefreshActivity(d *schema.ResourceData, m interface{}) error {
+func deleteFusionAppsFusionEnvironmentRefreshActivity(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -142,7 +143,7 @@ func (s *FusionAppsFusionEnvironmentRefreshActivityResourceCrud) DeletedTarget()
 	return []string{}
 }
 
-func (s *FusionAppsFusionEnvironmentRefreshActivityResourceCrud) Create() error {
+func (s *FusionAppsFusionEnvironmentRefreshActivityResourceCrud) Create(ctx context.Context) error {
 	request := oci_fusion_apps.CreateRefreshActivityRequest{}
 
 	if fusionEnvironmentId, ok := s.D.GetOkExists("fusion_environment_id"); ok {
@@ -157,20 +158,20 @@ func (s *FusionAppsFusionEnvironmentRefreshActivityResourceCrud) Create() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps")
 
-	response, err := s.Client.CreateRefreshActivity(context.Background(), request)
+	response, err := s.Client.CreateRefreshActivity(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getFusionEnvironmentRefreshActivityFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps"), oci_fusion_apps.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getFusionEnvironmentRefreshActivityFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps"), oci_fusion_apps.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *FusionAppsFusionEnvironmentRefreshActivityResourceCrud) getFusionEnvironmentRefreshActivityFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *FusionAppsFusionEnvironmentRefreshActivityResourceCrud) getFusionEnvironmentRefreshActivityFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_fusion_apps.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	fusionEnvironmentRefreshActivityId, err := fusionEnvironmentRefreshActivityWaitForWorkRequest(workId, "fusion_apps",
+	fusionEnvironmentRefreshActivityId, err := fusionEnvironmentRefreshActivityWaitForWorkRequest(ctx, workId, "fusion_apps",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -178,7 +179,7 @@ func (s *FusionAppsFusionEnvironmentRefreshActivityResourceCrud) getFusionEnviro
 	}
 	s.D.SetId(*fusionEnvironmentRefreshActivityId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func fusionEnvironmentRefreshActivityWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -204,7 +205,7 @@ func fusionEnvironmentRefreshActivityWorkRequestShouldRetryFunc(timeout time.Dur
 	}
 }
 
-func fusionEnvironmentRefreshActivityWaitForWorkRequest(wId *string, entityType string, action oci_fusion_apps.WorkRequestResourceActionTypeEnum,
+func fusionEnvironmentRefreshActivityWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_fusion_apps.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_fusion_apps.FusionApplicationsClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "fusion_apps")
 	retryPolicy.ShouldRetryOperation = fusionEnvironmentRefreshActivityWorkRequestShouldRetryFunc(timeout)
@@ -223,7 +224,7 @@ func fusionEnvironmentRefreshActivityWaitForWorkRequest(wId *string, entityType
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_fusion_apps.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -252,14 +253,14 @@ func fusionEnvironmentRefreshActivityWaitForWorkRequest(wId *string, entityType
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_fusion_apps.WorkRequestStatusFailed || response.Status == oci_fusion_apps.WorkRequestStatusCanceled {
-		return nil, getErrorFromFusionAppsFusionEnvironmentRefreshActivityWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromFusionAppsFusionEnvironmentRefreshActivityWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromFusionAppsFusionEnvironmentRefreshActivityWorkRequest(client *oci_fusion_apps.FusionApplicationsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_fusion_apps.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromFusionAppsFusionEnvironmentRefreshActivityWorkRequest(ctx context.Context, client *oci_fusion_apps.FusionApplicationsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_fusion_apps.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_fusion_apps.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -281,7 +282,7 @@ func getErrorFromFusionAppsFusionEnvironmentRefreshActivityWorkRequest(client *o
 	return workRequestErr
 }
 
-func (s *FusionAppsFusionEnvironmentRefreshActivityResourceCrud) Get() error {
+func (s *FusionAppsFusionEnvironmentRefreshActivityResourceCrud) Get(ctx context.Context) error {
 	request := oci_fusion_apps.GetRefreshActivityRequest{}
 
 	if fusionEnvironmentId, ok := s.D.GetOkExists("fusion_environment_id"); ok {
@@ -304,7 +305,7 @@ func (s *FusionAppsFusionEnvironmentRefreshActivityResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps")
 
-	response, err := s.Client.GetRefreshActivity(context.Background(), request)
+	response, err := s.Client.GetRefreshActivity(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func FusionAppsFusionEnvironmentResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createFusionAppsFusionEnvironment,
-		Read:     readFusionAppsFusionEnvironment,
-		Update:   updateFusionAppsFusionEnvironment,
-		Delete:   deleteFusionAppsFusionEnvironment,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createFusionAppsFusionEnvironment,
+		ReadContext:   readFusionAppsFusionEnvironment,
+		UpdateContext: updateFusionAppsFusionEnvironment,
+		DeleteContext: deleteFusionAppsFusionEnvironment,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -320,37 +321,37 @@ func FusionAppsFusionEnvironmentResource() *schema.Resource {
 	}
 }
 
-func createFusionAppsFusionEnvironment(d *schema.ResourceData, m interface{}) error {
+func createFusionAppsFusionEnvironment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FusionAppsFusionEnvironmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FusionApplicationsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readFusionAppsFusionEnvironment(d *schema.ResourceData, m interface{}) error {
+func readFusionAppsFusionEnvironment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FusionAppsFusionEnvironmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FusionApplicationsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateFusionAppsFusionEnvironment(d *schema.ResourceData, m interface{}) error {
+func updateFusionAppsFusionEnvironment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FusionAppsFusionEnvironmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FusionApplicationsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteFusionAppsFusionEnvironment(d *schema.ResourceData, m interface{}) error {
+func deleteFusionAppsFusionEnvironment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FusionAppsFusionEnvironmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FusionApplicationsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type FusionAppsFusionEnvironmentResourceCrud struct {
@@ -388,7 +389,7 @@ func (s *FusionAppsFusionEnvironmentResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *FusionAppsFusionEnvironmentResourceCrud) Create() error {
+func (s *FusionAppsFusionEnvironmentResourceCrud) Create(ctx context.Context) error {
 	request := oci_fusion_apps.CreateFusionEnvironmentRequest{}
 
 	if additionalLanguagePacks, ok := s.D.GetOkExists("additional_language_packs"); ok {
@@ -486,20 +487,20 @@ func (s *FusionAppsFusionEnvironmentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps")
 
-	response, err := s.Client.CreateFusionEnvironment(context.Background(), request)
+	response, err := s.Client.CreateFusionEnvironment(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getFusionEnvironmentFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps"), oci_fusion_apps.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getFusionEnvironmentFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps"), oci_fusion_apps.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *FusionAppsFusionEnvironmentResourceCrud) getFusionEnvironmentFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *FusionAppsFusionEnvironmentResourceCrud) getFusionEnvironmentFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_fusion_apps.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	fusionEnvironmentId, err := fusionEnvironmentWaitForWorkRequest(workId, "fusionenvironment",
+	fusionEnvironmentId, err := fusionEnvironmentWaitForWorkRequest(ctx, workId, "fusionenvironment",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -507,7 +508,7 @@ func (s *FusionAppsFusionEnvironmentResourceCrud) getFusionEnvironmentFromWorkRe
 	}
 	s.D.SetId(*fusionEnvironmentId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func fusionEnvironmentWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -533,7 +534,7 @@ func fusionEnvironmentWorkRequestShouldRetryFunc(timeout time.Duration) func(res
 	}
 }
 
-func fusionEnvironmentWaitForWorkRequest(wId *string, entityType string, action oci_fusion_apps.WorkRequestResourceActionTypeEnum,
+func fusionEnvironmentWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_fusion_apps.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_fusion_apps.FusionApplicationsClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "fusion_apps")
 	retryPolicy.ShouldRetryOperation = fusionEnvironmentWorkRequestShouldRetryFunc(timeout)
@@ -552,7 +553,7 @@ func fusionEnvironmentWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_fusion_apps.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -581,14 +582,14 @@ func fusionEnvironmentWaitForWorkRequest(wId *string, entityType string, action
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_fusion_apps.WorkRequestStatusFailed || response.Status == oci_fusion_apps.WorkRequestStatusCanceled {
-		return nil, getErrorFromFusionAppsFusionEnvironmentWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromFusionAppsFusionEnvironmentWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromFusionAppsFusionEnvironmentWorkRequest(client *oci_fusion_apps.FusionApplicationsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_fusion_apps.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromFusionAppsFusionEnvironmentWorkRequest(ctx context.Context, client *oci_fusion_apps.FusionApplicationsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_fusion_apps.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_fusion_apps.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -610,7 +611,7 @@ func getErrorFromFusionAppsFusionEnvironmentWorkRequest(client *oci_fusion_apps.
 	return workRequestErr
 }
 
-func (s *FusionAppsFusionEnvironmentResourceCrud) Get() error {
+func (s *FusionAppsFusionEnvironmentResourceCrud) Get(ctx context.Context) error {
 	request := oci_fusion_apps.GetFusionEnvironmentRequest{}
 
 	tmp := s.D.Id()
@@ -618,7 +619,7 @@ func (s *FusionAppsFusionEnvironmentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps")
 
-	response, err := s.Client.GetFusionEnvironment(context.Background(), request)
+	response, err := s.Client.GetFusionEnvironment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -627,11 +628,11 @@ func (s *FusionAppsFusionEnvironmentResourceCrud) Get() error {
 	return nil
 }
 
-func (s *FusionAppsFusionEnvironmentResourceCrud) Update() error {
+func (s *FusionAppsFusionEnvironmentResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -707,16 +708,16 @@ func (s *FusionAppsFusionEnvironmentResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps")
 
-	response, err := s.Client.UpdateFusionEnvironment(context.Background(), request)
+	response, err := s.Client.UpdateFusionEnvironment(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getFusionEnvironmentFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps"), oci_fusion_apps.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getFusionEnvironmentFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps"), oci_fusion_apps.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *FusionAppsFusionEnvironmentResourceCrud) Delete() error {
+func (s *FusionAppsFusionEnvironmentResourceCrud) Delete(ctx context.Context) error {
 	request := oci_fusion_apps.DeleteFusionEnvironmentRequest{}
 
 	tmp := s.D.Id()
@@ -724,14 +725,14 @@ func (s *FusionAppsFusionEnvironmentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps")
 
-	response, err := s.Client.DeleteFusionEnvironment(context.Background(), request)
+	response, err := s.Client.DeleteFusionEnvironment(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := fusionEnvironmentWaitForWorkRequest(workId, "fusionenvironment",
+	_, delWorkRequestErr := fusionEnvironmentWaitForWorkRequest(ctx, workId, "fusionenvironment",
 		oci_fusion_apps.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -1165,7 +1166,7 @@ func objectToMap(obj interface{}) map[string]interface{} {
 	return result
 }
 
-func (s *FusionAppsFusionEnvironmentResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *FusionAppsFusionEnvironmentResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_fusion_apps.ChangeFusionEnvironmentCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -1176,11 +1177,11 @@ func (s *FusionAppsFusionEnvironmentResourceCrud) updateCompartment(compartment
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps")
 
-	response, err := s.Client.ChangeFusionEnvironmentCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeFusionEnvironmentCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getFusionEnvironmentFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps"), oci_fusion_apps.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getFusionEnvironmentFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "fusion_apps"), oci_fusion_apps.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
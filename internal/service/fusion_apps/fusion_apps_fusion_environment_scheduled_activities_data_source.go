@@ -8,6 +8,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_fusion_apps "github.com/oracle/oci-go-sdk/v65/fusionapps"
@@ -18,7 +19,7 @@ import (
 
 func FusionAppsFusionEnvironmentScheduledActivitiesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readFusionAppsFusionEnvironmentScheduledActivities,
+		ReadContext: readFusionAppsFusionEnvironmentScheduledActivities,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"display_name": {
@@ -176,12 +177,12 @@ func FusionAppsFusionEnvironmentScheduledActivitiesDataSource() *schema.Resource
 	}
 }
 
-func readFusionAppsFusionEnvironmentScheduledActivities(d *schema.ResourceData, m interface{}) error {
+func readFusionAppsFusionEnvironmentScheduledActivities(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FusionAppsFusionEnvironmentScheduledActivitiesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FusionApplicationsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type FusionAppsFusionEnvironmentScheduledActivitiesDataSourceCrud struct {
@@ -194,7 +195,7 @@ func (s *FusionAppsFusionEnvironmentScheduledActivitiesDataSourceCrud) VoidState
 	s.D.SetId("")
 }
 
-func (s *FusionAppsFusionEnvironmentScheduledActivitiesDataSourceCrud) Get() error {
+func (s *FusionAppsFusionEnvironmentScheduledActivitiesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_fusion_apps.ListScheduledActivitiesRequest{}
 
 	if displayName, ok := s.D.GetOkExists("display_name"); ok {
@@ -233,7 +234,7 @@ func (s *FusionAppsFusionEnvironmentScheduledActivitiesDataSourceCrud) Get() err
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "fusion_apps")
 
-	response, err := s.Client.ListScheduledActivities(context.Background(), request)
+	response, err := s.Client.ListScheduledActivities(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -242,7 +243,7 @@ func (s *FusionAppsFusionEnvironmentScheduledActivitiesDataSourceCrud) Get() err
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListScheduledActivities(context.Background(), request)
+		listResponse, err := s.Client.ListScheduledActivities(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package fusion_apps
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_fusion_apps "github.com/oracle/oci-go-sdk/v65/fusionapps"
 
@@ -15,7 +16,7 @@ import (
 
 func FusionAppsFusionEnvironmentScheduledActivityDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularFusionAppsFusionEnvironmentScheduledActivity,
+		ReadContext: readSingularFusionAppsFusionEnvironmentScheduledActivity,
 		Schema: map[string]*schema.Schema{
 			"fusion_environment_id": {
 				Type:     schema.TypeString,
@@ -123,12 +124,12 @@ func FusionAppsFusionEnvironmentScheduledActivityDataSource() *schema.Resource {
 	}
 }
 
-func readSingularFusionAppsFusionEnvironmentScheduledActivity(d *schema.ResourceData, m interface{}) error {
+func readSingularFusionAppsFusionEnvironmentScheduledActivity(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FusionAppsFusionEnvironmentScheduledActivityDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FusionApplicationsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type FusionAppsFusionEnvironmentScheduledActivityDataSourceCrud struct {
@@ -141,7 +142,7 @@ func (s *FusionAppsFusionEnvironmentScheduledActivityDataSourceCrud) VoidState()
 	s.D.SetId("")
 }
 
-func (s *FusionAppsFusionEnvironmentScheduledActivityDataSourceCrud) Get() error {
+func (s *FusionAppsFusionEnvironmentScheduledActivityDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_fusion_apps.GetScheduledActivityRequest{}
 
 	if fusionEnvironmentId, ok := s.D.GetOkExists("fusion_environment_id"); ok {
@@ -156,7 +157,7 @@ func (s *FusionAppsFusionEnvironmentScheduledActivityDataSourceCrud) Get() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "fusion_apps")
 
-	response, err := s.Client.GetScheduledActivity(context.Background(), request)
+	response, err := s.Client.GetScheduledActivity(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package fusion_apps
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_fusion_apps "github.com/oracle/oci-go-sdk/v65/fusionapps"
 
@@ -15,7 +16,7 @@ import (
 
 func FusionAppsFusionEnvironmentServiceAttachmentDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularFusionAppsFusionEnvironmentServiceAttachment,
+		ReadContext: readSingularFusionAppsFusionEnvironmentServiceAttachment,
 		Schema: map[string]*schema.Schema{
 			"fusion_environment_id": {
 				Type:     schema.TypeString,
@@ -80,12 +81,12 @@ func FusionAppsFusionEnvironmentServiceAttachmentDataSource() *schema.Resource {
 	}
 }
 
-func readSingularFusionAppsFusionEnvironmentServiceAttachment(d *schema.ResourceData, m interface{}) error {
+func readSingularFusionAppsFusionEnvironmentServiceAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FusionAppsFusionEnvironmentServiceAttachmentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FusionApplicationsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type FusionAppsFusionEnvironmentServiceAttachmentDataSourceCrud struct {
@@ -98,7 +99,7 @@ func (s *FusionAppsFusionEnvironmentServiceAttachmentDataSourceCrud) VoidState()
 	s.D.SetId("")
 }
 
-func (s *FusionAppsFusionEnvironmentServiceAttachmentDataSourceCrud) Get() error {
+func (s *FusionAppsFusionEnvironmentServiceAttachmentDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_fusion_apps.GetServiceAttachmentRequest{}
 
 	if fusionEnvironmentId, ok := s.D.GetOkExists("fusion_environment_id"); ok {
@@ -113,7 +114,7 @@ func (s *FusionAppsFusionEnvironmentServiceAttachmentDataSourceCrud) Get() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "fusion_apps")
 
-	response, err := s.Client.GetServiceAttachment(context.Background(), request)
+	response, err := s.Client.GetServiceAttachment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package fusion_apps
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_fusion_apps "github.com/oracle/oci-go-sdk/v65/fusionapps"
 
@@ -15,7 +16,7 @@ import (
 
 func FusionAppsFusionEnvironmentStatusDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularFusionAppsFusionEnvironmentStatus,
+		ReadContext: readSingularFusionAppsFusionEnvironmentStatus,
 		Schema: map[string]*schema.Schema{
 			"fusion_environment_id": {
 				Type:     schema.TypeString,
@@ -30,12 +31,12 @@ func FusionAppsFusionEnvironmentStatusDataSource() *schema.Resource {
 	}
 }
 
-func readSingularFusionAppsFusionEnvironmentStatus(d *schema.ResourceData, m interface{}) error {
+func readSingularFusionAppsFusionEnvironmentStatus(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FusionAppsFusionEnvironmentStatusDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FusionApplicationsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type FusionAppsFusionEnvironmentStatusDataSourceCrud struct {
@@ -48,7 +49,7 @@ func (s *FusionAppsFusionEnvironmentStatusDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *FusionAppsFusionEnvironmentStatusDataSourceCrud) Get() error {
+func (s *FusionAppsFusionEnvironmentStatusDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_fusion_apps.GetFusionEnvironmentStatusRequest{}
 
 	if fusionEnvironmentId, ok := s.D.GetOkExists("fusion_environment_id"); ok {
@@ -58,7 +59,7 @@ func (s *FusionAppsFusionEnvironmentStatusDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "fusion_apps")
 
-	response, err := s.Client.GetFusionEnvironmentStatus(context.Background(), request)
+	response, err := s.Client.GetFusionEnvironmentStatus(ctx, request)
 	if err != nil {
 		return err
 	}
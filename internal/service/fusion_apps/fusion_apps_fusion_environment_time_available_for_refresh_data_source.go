@@ -6,6 +6,7 @@ package fusion_apps
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_fusion_apps "github.com/oracle/oci-go-sdk/v65/fusionapps"
 
@@ -15,7 +16,7 @@ import (
 
 func FusionAppsFusionEnvironmentTimeAvailableForRefreshDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularFusionAppsFusionEnvironmentTimeAvailableForRefresh,
+		ReadContext: readSingularFusionAppsFusionEnvironmentTimeAvailableForRefresh,
 		Schema: map[string]*schema.Schema{
 			"fusion_environment_id": {
 				Type:     schema.TypeString,
@@ -43,12 +44,12 @@ func FusionAppsFusionEnvironmentTimeAvailableForRefreshDataSource() *schema.Reso
 	}
 }
 
-func readSingularFusionAppsFusionEnvironmentTimeAvailableForRefresh(d *schema.ResourceData, m interface{}) error {
+func readSingularFusionAppsFusionEnvironmentTimeAvailableForRefresh(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FusionAppsFusionEnvironmentTimeAvailableForRefreshDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FusionApplicationsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type FusionAppsFusionEnvironmentTimeAvailableForRefreshDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *FusionAppsFusionEnvironmentTimeAvailableForRefreshDataSourceCrud) VoidS
 	s.D.SetId("")
 }
 
-func (s *FusionAppsFusionEnvironmentTimeAvailableForRefreshDataSourceCrud) Get() error {
+func (s *FusionAppsFusionEnvironmentTimeAvailableForRefreshDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_fusion_apps.ListTimeAvailableForRefreshesRequest{}
 
 	if fusionEnvironmentId, ok := s.D.GetOkExists("fusion_environment_id"); ok {
@@ -71,7 +72,7 @@ func (s *FusionAppsFusionEnvironmentTimeAvailableForRefreshDataSourceCrud) Get()
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "fusion_apps")
 
-	response, err := s.Client.ListTimeAvailableForRefreshes(context.Background(), request)
+	response, err := s.Client.ListTimeAvailableForRefreshes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package fusion_apps
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_fusion_apps "github.com/oracle/oci-go-sdk/v65/fusionapps"
 
@@ -15,7 +16,7 @@ import (
 
 func FusionAppsFusionEnvironmentTimeAvailableForRefreshsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readFusionAppsFusionEnvironmentTimeAvailableForRefreshs,
+		ReadContext: readFusionAppsFusionEnvironmentTimeAvailableForRefreshs,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"fusion_environment_id": {
@@ -51,12 +52,12 @@ func FusionAppsFusionEnvironmentTimeAvailableForRefreshsDataSource() *schema.Res
 	}
 }
 
-func readFusionAppsFusionEnvironmentTimeAvailableForRefreshs(d *schema.ResourceData, m interface{}) error {
+func readFusionAppsFusionEnvironmentTimeAvailableForRefreshs(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FusionAppsFusionEnvironmentTimeAvailableForRefreshsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FusionApplicationsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type FusionAppsFusionEnvironmentTimeAvailableForRefreshsDataSourceCrud struct {
@@ -69,7 +70,7 @@ func (s *FusionAppsFusionEnvironmentTimeAvailableForRefreshsDataSourceCrud) Void
 	s.D.SetId("")
 }
 
-func (s *FusionAppsFusionEnvironmentTimeAvailableForRefreshsDataSourceCrud) Get() error {
+func (s *FusionAppsFusionEnvironmentTimeAvailableForRefreshsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_fusion_apps.ListTimeAvailableForRefreshesRequest{}
 
 	if fusionEnvironmentId, ok := s.D.GetOkExists("fusion_environment_id"); ok {
@@ -79,7 +80,7 @@ func (s *FusionAppsFusionEnvironmentTimeAvailableForRefreshsDataSourceCrud) Get(
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "fusion_apps")
 
-	response, err := s.Client.ListTimeAvailableForRefreshes(context.Background(), request)
+	response, err := s.Client.ListTimeAvailableForRefreshes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -88,7 +89,7 @@ func (s *FusionAppsFusionEnvironmentTimeAvailableForRefreshsDataSourceCrud) Get(
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListTimeAvailableForRefreshes(context.Background(), request)
+		listResponse, err := s.Client.ListTimeAvailableForRefreshes(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package fusion_apps
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_fusion_apps "github.com/oracle/oci-go-sdk/v65/fusionapps"
 
@@ -15,7 +16,7 @@ import (
 
 func FusionAppsFusionEnvironmentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readFusionAppsFusionEnvironments,
+		ReadContext: readFusionAppsFusionEnvironments,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -52,12 +53,12 @@ func FusionAppsFusionEnvironmentsDataSource() *schema.Resource {
 	}
 }
 
-func readFusionAppsFusionEnvironments(d *schema.ResourceData, m interface{}) error {
+func readFusionAppsFusionEnvironments(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &FusionAppsFusionEnvironmentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).FusionApplicationsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type FusionAppsFusionEnvironmentsDataSourceCrud struct {
@@ -70,7 +71,7 @@ func (s *FusionAppsFusionEnvironmentsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *FusionAppsFusionEnvironmentsDataSourceCrud) Get() error {
+func (s *FusionAppsFusionEnvironmentsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_fusion_apps.ListFusionEnvironmentsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -94,7 +95,7 @@ func (s *FusionAppsFusionEnvironmentsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "fusion_apps")
 
-	response, err := s.Client.ListFusionEnvironments(context.Background(), request)
+	response, err := s.Client.ListFusionEnvironments(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *FusionAppsFusionEnvironmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListFusionEnvironments(context.Background(), request)
+		listResponse, err := s.Client.ListFusionEnvironments(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package generic_artifacts_content
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_generic_artifacts_content "github.com/oracle/oci-go-sdk/v65/genericartifactscontent"
 
@@ -30,12 +31,12 @@ func GenericArtifactsContentArtifactByPathDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(GenericArtifactsContentArtifactByPathResource(), fieldMap, readSingularGenericArtifactsContentArtifactByPath)
 }
 
-func readSingularGenericArtifactsContentArtifactByPath(d *schema.ResourceData, m interface{}) error {
+func readSingularGenericArtifactsContentArtifactByPath(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GenericArtifactsContentArtifactByPathDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GenericArtifactsContentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type GenericArtifactsContentArtifactByPathDataSourceCrud struct {
@@ -48,7 +49,7 @@ func (s *GenericArtifactsContentArtifactByPathDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *GenericArtifactsContentArtifactByPathDataSourceCrud) Get() error {
+func (s *GenericArtifactsContentArtifactByPathDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_generic_artifacts_content.GetGenericArtifactContentByPathRequest{}
 
 	if artifactPath, ok := s.D.GetOkExists("artifact_path"); ok {
@@ -68,7 +69,7 @@ func (s *GenericArtifactsContentArtifactByPathDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "generic_artifacts_content")
 
-	response, err := s.Client.GetGenericArtifactContentByPath(context.Background(), request)
+	response, err := s.Client.GetGenericArtifactContentByPath(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -14,6 +14,7 @@ import (
 	"os"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_generic_artifacts_content "github.com/oracle/oci-go-sdk/v65/genericartifactscontent"
 
@@ -23,10 +24,10 @@ import (
 
 func GenericArtifactsContentArtifactByPathResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createGenericArtifactsContentArtifactByPath,
-		Read:     readGenericArtifactsContentArtifactByPath,
-		Delete:   deleteGenericArtifactsContentArtifactByPath,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createGenericArtifactsContentArtifactByPath,
+		ReadContext:   readGenericArtifactsContentArtifactByPath,
+		DeleteContext: deleteGenericArtifactsContentArtifactByPath,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"repository_id": {
@@ -110,23 +111,23 @@ func GenericArtifactsContentArtifactByPathResource() *schema.Resource {
 	}
 }
 
-func createGenericArtifactsContentArtifactByPath(d *schema.ResourceData, m interface{}) error {
+func createGenericArtifactsContentArtifactByPath(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GenericArtifactsContentArtifactByPathResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GenericArtifactsContentClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readGenericArtifactsContentArtifactByPath(d *schema.ResourceData, m interface{}) error {
+func readGenericArtifactsContentArtifactByPath(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GenericArtifactsContentArtifactByPathResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GenericArtifactsContentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteGenericArtifactsContentArtifactByPath(d *schema.ResourceData, m interface{}) error {
+func deleteGenericArtifactsContentArtifactByPath(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -164,7 +165,7 @@ func (s *GenericArtifactsContentArtifactByPathResourceCrud) DeletedTarget() []st
 	}
 }
 
-func (s *GenericArtifactsContentArtifactByPathResourceCrud) Get() error {
+func (s *GenericArtifactsContentArtifactByPathResourceCrud) Get(ctx context.Context) error {
 	request := oci_generic_artifacts_content.GetGenericArtifactContentByPathRequest{}
 
 	if artifactPath, ok := s.D.GetOkExists("artifact_path"); ok {
@@ -184,7 +185,7 @@ func (s *GenericArtifactsContentArtifactByPathResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "generic_artifacts_content")
 
-	response, err := s.Client.GetGenericArtifactContentByPath(context.Background(), request)
+	response, err := s.Client.GetGenericArtifactContentByPath(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -193,12 +194,12 @@ func (s *GenericArtifactsContentArtifactByPathResourceCrud) Get() error {
 	return nil
 }
 
-func (s *GenericArtifactsContentArtifactByPathResourceCrud) Create() error {
+func (s *GenericArtifactsContentArtifactByPathResourceCrud) Create(ctx context.Context) error {
 	if s.isSourceCreate() {
-		return s.createArtifactBySource()
+		return s.createArtifactBySource(ctx)
 	}
 
-	return s.createArtifactByContent()
+	return s.createArtifactByContent(ctx)
 }
 
 func (s *GenericArtifactsContentArtifactByPathResourceCrud) isSourceCreate() bool {
@@ -206,7 +207,7 @@ func (s *GenericArtifactsContentArtifactByPathResourceCrud) isSourceCreate() boo
 	return source != ""
 }
 
-func (s *GenericArtifactsContentArtifactByPathResourceCrud) createArtifactByContent() error {
+func (s *GenericArtifactsContentArtifactByPathResourceCrud) createArtifactByContent(ctx context.Context) error {
 	request := oci_generic_artifacts_content.PutGenericArtifactContentByPathRequest{}
 
 	if genericArtifactContentBody, ok := s.D.GetOkExists("content"); ok {
@@ -231,7 +232,7 @@ func (s *GenericArtifactsContentArtifactByPathResourceCrud) createArtifactByCont
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "generic_artifacts_content")
 
-	response, err := s.Client.PutGenericArtifactContentByPath(context.Background(), request)
+	response, err := s.Client.PutGenericArtifactContentByPath(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -239,7 +240,7 @@ func (s *GenericArtifactsContentArtifactByPathResourceCrud) createArtifactByCont
 	return nil
 }
 
-func (s *GenericArtifactsContentArtifactByPathResourceCrud) createArtifactBySource() error {
+func (s *GenericArtifactsContentArtifactByPathResourceCrud) createArtifactBySource(ctx context.Context) error {
 	request := oci_generic_artifacts_content.PutGenericArtifactContentByPathRequest{}
 
 	source, ok := s.D.GetOkExists("source")
@@ -273,7 +274,7 @@ func (s *GenericArtifactsContentArtifactByPathResourceCrud) createArtifactBySour
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "generic_artifacts_content")
 
-	response, err := s.Client.PutGenericArtifactContentByPath(context.Background(), request)
+	response, err := s.Client.PutGenericArtifactContentByPath(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package generic_artifacts_content
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_generic_artifacts_content "github.com/oracle/oci-go-sdk/v65/genericartifactscontent"
 
@@ -15,7 +16,7 @@ import (
 
 func GenericArtifactsContentGenericArtifactsContentDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularGenericArtifactsContentGenericArtifactsContent,
+		ReadContext: readSingularGenericArtifactsContentGenericArtifactsContent,
 		Schema: map[string]*schema.Schema{
 			"artifact_id": {
 				Type:     schema.TypeString,
@@ -26,12 +27,12 @@ func GenericArtifactsContentGenericArtifactsContentDataSource() *schema.Resource
 	}
 }
 
-func readSingularGenericArtifactsContentGenericArtifactsContent(d *schema.ResourceData, m interface{}) error {
+func readSingularGenericArtifactsContentGenericArtifactsContent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GenericArtifactsContentGenericArtifactsContentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GenericArtifactsContentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type GenericArtifactsContentGenericArtifactsContentDataSourceCrud struct {
@@ -44,7 +45,7 @@ func (s *GenericArtifactsContentGenericArtifactsContentDataSourceCrud) VoidState
 	s.D.SetId("")
 }
 
-func (s *GenericArtifactsContentGenericArtifactsContentDataSourceCrud) Get() error {
+func (s *GenericArtifactsContentGenericArtifactsContentDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_generic_artifacts_content.GetGenericArtifactContentRequest{}
 
 	if artifactId, ok := s.D.GetOkExists("artifact_id"); ok {
@@ -54,7 +55,7 @@ func (s *GenericArtifactsContentGenericArtifactsContentDataSourceCrud) Get() err
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "generic_artifacts_content")
 
-	response, err := s.Client.GetGenericArtifactContent(context.Background(), request)
+	response, err := s.Client.GetGenericArtifactContent(ctx, request)
 	if err != nil {
 		return err
 	}
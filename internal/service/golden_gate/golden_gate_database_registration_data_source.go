@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_golden_gate "github.com/oracle/oci-go-sdk/v65/goldengate"
 )
@@ -22,12 +23,12 @@ func GoldenGateDatabaseRegistrationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(GoldenGateDatabaseRegistrationResource(), fieldMap, readSingularGoldenGateDatabaseRegistration)
 }
 
-func readSingularGoldenGateDatabaseRegistration(d *schema.ResourceData, m interface{}) error {
+func readSingularGoldenGateDatabaseRegistration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GoldenGateDatabaseRegistrationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GoldenGateClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type GoldenGateDatabaseRegistrationDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *GoldenGateDatabaseRegistrationDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *GoldenGateDatabaseRegistrationDataSourceCrud) Get() error {
+func (s *GoldenGateDatabaseRegistrationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_golden_gate.GetDatabaseRegistrationRequest{}
 
 	if databaseRegistrationId, ok := s.D.GetOkExists("database_registration_id"); ok {
@@ -50,7 +51,7 @@ func (s *GoldenGateDatabaseRegistrationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "golden_gate")
 
-	response, err := s.Client.GetDatabaseRegistration(context.Background(), request)
+	response, err := s.Client.GetDatabaseRegistration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -25,11 +26,11 @@ func GoldenGateDatabaseRegistrationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createGoldenGateDatabaseRegistration,
-		Read:     readGoldenGateDatabaseRegistration,
-		Update:   updateGoldenGateDatabaseRegistration,
-		Delete:   deleteGoldenGateDatabaseRegistration,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createGoldenGateDatabaseRegistration,
+		ReadContext:   readGoldenGateDatabaseRegistration,
+		UpdateContext: updateGoldenGateDatabaseRegistration,
+		DeleteContext: deleteGoldenGateDatabaseRegistration,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"alias_name": {
@@ -163,37 +164,37 @@ func GoldenGateDatabaseRegistrationResource() *schema.Resource {
 	}
 }
 
-func createGoldenGateDatabaseRegistration(d *schema.ResourceData, m interface{}) error {
+func createGoldenGateDatabaseRegistration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GoldenGateDatabaseRegistrationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GoldenGateClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readGoldenGateDatabaseRegistration(d *schema.ResourceData, m interface{}) error {
+func readGoldenGateDatabaseRegistration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GoldenGateDatabaseRegistrationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GoldenGateClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateGoldenGateDatabaseRegistration(d *schema.ResourceData, m interface{}) error {
+func updateGoldenGateDatabaseRegistration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GoldenGateDatabaseRegistrationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GoldenGateClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteGoldenGateDatabaseRegistration(d *schema.ResourceData, m interface{}) error {
+func deleteGoldenGateDatabaseRegistration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GoldenGateDatabaseRegistrationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GoldenGateClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type GoldenGateDatabaseRegistrationResourceCrud struct {
@@ -234,7 +235,7 @@ func (s *GoldenGateDatabaseRegistrationResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *GoldenGateDatabaseRegistrationResourceCrud) Create() error {
+func (s *GoldenGateDatabaseRegistrationResourceCrud) Create(ctx context.Context) error {
 	request := oci_golden_gate.CreateDatabaseRegistrationRequest{}
 
 	if aliasName, ok := s.D.GetOkExists("alias_name"); ok {
@@ -330,20 +331,20 @@ func (s *GoldenGateDatabaseRegistrationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
-	response, err := s.Client.CreateDatabaseRegistration(context.Background(), request)
+	response, err := s.Client.CreateDatabaseRegistration(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDatabaseRegistrationFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate"), oci_golden_gate.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getDatabaseRegistrationFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate"), oci_golden_gate.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *GoldenGateDatabaseRegistrationResourceCrud) getDatabaseRegistrationFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *GoldenGateDatabaseRegistrationResourceCrud) getDatabaseRegistrationFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_golden_gate.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	databaseRegistrationId, err := databaseRegistrationWaitForWorkRequest(workId, "databaseregistration",
+	databaseRegistrationId, err := databaseRegistrationWaitForWorkRequest(ctx, workId, "databaseregistration",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -353,7 +354,7 @@ func (s *GoldenGateDatabaseRegistrationResourceCrud) getDatabaseRegistrationFrom
 	}
 	s.D.SetId(*databaseRegistrationId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func databaseRegistrationWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -379,7 +380,7 @@ func databaseRegistrationWorkRequestShouldRetryFunc(timeout time.Duration) func(
 	}
 }
 
-func databaseRegistrationWaitForWorkRequest(wId *string, entityType string, action oci_golden_gate.ActionTypeEnum,
+func databaseRegistrationWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_golden_gate.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_golden_gate.GoldenGateClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "golden_gate")
 	retryPolicy.ShouldRetryOperation = databaseRegistrationWorkRequestShouldRetryFunc(timeout)
@@ -397,7 +398,7 @@ func databaseRegistrationWaitForWorkRequest(wId *string, entityType string, acti
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_golden_gate.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -426,14 +427,14 @@ func databaseRegistrationWaitForWorkRequest(wId *string, entityType string, acti
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_golden_gate.OperationStatusFailed || response.Status == oci_golden_gate.OperationStatusCanceled {
-		return nil, getErrorFromGoldenGateDatabaseRegistrationWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromGoldenGateDatabaseRegistrationWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromGoldenGateDatabaseRegistrationWorkRequest(client *oci_golden_gate.GoldenGateClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_golden_gate.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromGoldenGateDatabaseRegistrationWorkRequest(ctx context.Context, client *oci_golden_gate.GoldenGateClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_golden_gate.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_golden_gate.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -455,7 +456,7 @@ func getErrorFromGoldenGateDatabaseRegistrationWorkRequest(client *oci_golden_ga
 	return workRequestErr
 }
 
-func (s *GoldenGateDatabaseRegistrationResourceCrud) Get() error {
+func (s *GoldenGateDatabaseRegistrationResourceCrud) Get(ctx context.Context) error {
 	request := oci_golden_gate.GetDatabaseRegistrationRequest{}
 
 	tmp := s.D.Id()
@@ -463,7 +464,7 @@ func (s *GoldenGateDatabaseRegistrationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
-	response, err := s.Client.GetDatabaseRegistration(context.Background(), request)
+	response, err := s.Client.GetDatabaseRegistration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -472,11 +473,11 @@ func (s *GoldenGateDatabaseRegistrationResourceCrud) Get() error {
 	return nil
 }
 
-func (s *GoldenGateDatabaseRegistrationResourceCrud) Update() error {
+func (s *GoldenGateDatabaseRegistrationResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -545,16 +546,16 @@ func (s *GoldenGateDatabaseRegistrationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
-	response, err := s.Client.UpdateDatabaseRegistration(context.Background(), request)
+	response, err := s.Client.UpdateDatabaseRegistration(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDatabaseRegistrationFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate"), oci_golden_gate.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getDatabaseRegistrationFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate"), oci_golden_gate.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *GoldenGateDatabaseRegistrationResourceCrud) Delete() error {
+func (s *GoldenGateDatabaseRegistrationResourceCrud) Delete(ctx context.Context) error {
 	request := oci_golden_gate.DeleteDatabaseRegistrationRequest{}
 
 	tmp := s.D.Id()
@@ -562,14 +563,14 @@ func (s *GoldenGateDatabaseRegistrationResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
-	response, err := s.Client.DeleteDatabaseRegistration(context.Background(), request)
+	response, err := s.Client.DeleteDatabaseRegistration(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := databaseRegistrationWaitForWorkRequest(workId, "databaseregistration",
+	_, delWorkRequestErr := databaseRegistrationWaitForWorkRequest(ctx, workId, "databaseregistration",
 		oci_golden_gate.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -740,7 +741,7 @@ func DatabaseRegistrationSummaryToMap(obj oci_golden_gate.DatabaseRegistrationSu
 	return result
 }
 
-func (s *GoldenGateDatabaseRegistrationResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *GoldenGateDatabaseRegistrationResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_golden_gate.ChangeDatabaseRegistrationCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -751,14 +752,14 @@ func (s *GoldenGateDatabaseRegistrationResourceCrud) updateCompartment(compartme
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
-	response, err := s.Client.ChangeDatabaseRegistrationCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeDatabaseRegistrationCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, changeWorkRequestErr := databaseRegistrationWaitForWorkRequest(workId, "databaseregistration",
+	_, changeWorkRequestErr := databaseRegistrationWaitForWorkRequest(ctx, workId, "databaseregistration",
 		oci_golden_gate.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 	return changeWorkRequestErr
 }
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_golden_gate "github.com/oracle/oci-go-sdk/v65/goldengate"
 )
 
 func GoldenGateDatabaseRegistrationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readGoldenGateDatabaseRegistrations,
+		ReadContext: readGoldenGateDatabaseRegistrations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,12 +49,12 @@ func GoldenGateDatabaseRegistrationsDataSource() *schema.Resource {
 	}
 }
 
-func readGoldenGateDatabaseRegistrations(d *schema.ResourceData, m interface{}) error {
+func readGoldenGateDatabaseRegistrations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GoldenGateDatabaseRegistrationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GoldenGateClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type GoldenGateDatabaseRegistrationsDataSourceCrud struct {
@@ -66,7 +67,7 @@ func (s *GoldenGateDatabaseRegistrationsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *GoldenGateDatabaseRegistrationsDataSourceCrud) Get() error {
+func (s *GoldenGateDatabaseRegistrationsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_golden_gate.ListDatabaseRegistrationsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -85,7 +86,7 @@ func (s *GoldenGateDatabaseRegistrationsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "golden_gate")
 
-	response, err := s.Client.ListDatabaseRegistrations(context.Background(), request)
+	response, err := s.Client.ListDatabaseRegistrations(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *GoldenGateDatabaseRegistrationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDatabaseRegistrations(context.Background(), request)
+		listResponse, err := s.Client.ListDatabaseRegistrations(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_golden_gate "github.com/oracle/oci-go-sdk/v65/goldengate"
 )
@@ -22,12 +23,12 @@ func GoldenGateDeploymentBackupDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(GoldenGateDeploymentBackupResource(), fieldMap, readSingularGoldenGateDeploymentBackup)
 }
 
-func readSingularGoldenGateDeploymentBackup(d *schema.ResourceData, m interface{}) error {
+func readSingularGoldenGateDeploymentBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GoldenGateDeploymentBackupDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GoldenGateClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type GoldenGateDeploymentBackupDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *GoldenGateDeploymentBackupDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *GoldenGateDeploymentBackupDataSourceCrud) Get() error {
+func (s *GoldenGateDeploymentBackupDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_golden_gate.GetDeploymentBackupRequest{}
 
 	if deploymentBackupId, ok := s.D.GetOkExists("deployment_backup_id"); ok {
@@ -50,7 +51,7 @@ func (s *GoldenGateDeploymentBackupDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "golden_gate")
 
-	response, err := s.Client.GetDeploymentBackup(context.Background(), request)
+	response, err := s.Client.GetDeploymentBackup(ctx, request)
 	if err != nil {
 		return err
 	}
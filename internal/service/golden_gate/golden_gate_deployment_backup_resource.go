@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -25,11 +26,11 @@ func GoldenGateDeploymentBackupResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createGoldenGateDeploymentBackup,
-		Read:     readGoldenGateDeploymentBackup,
-		Update:   updateGoldenGateDeploymentBackup,
-		Delete:   deleteGoldenGateDeploymentBackup,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createGoldenGateDeploymentBackup,
+		ReadContext:   readGoldenGateDeploymentBackup,
+		UpdateContext: updateGoldenGateDeploymentBackup,
+		DeleteContext: deleteGoldenGateDeploymentBackup,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"bucket": {
@@ -127,37 +128,37 @@ func GoldenGateDeploymentBackupResource() *schema.Resource {
 	}
 }
 
-func createGoldenGateDeploymentBackup(d *schema.ResourceData, m interface{}) error {
+func createGoldenGateDeploymentBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GoldenGateDeploymentBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GoldenGateClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readGoldenGateDeploymentBackup(d *schema.ResourceData, m interface{}) error {
+func readGoldenGateDeploymentBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GoldenGateDeploymentBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GoldenGateClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateGoldenGateDeploymentBackup(d *schema.ResourceData, m interface{}) error {
+func updateGoldenGateDeploymentBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GoldenGateDeploymentBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GoldenGateClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteGoldenGateDeploymentBackup(d *schema.ResourceData, m interface{}) error {
+func deleteGoldenGateDeploymentBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GoldenGateDeploymentBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GoldenGateClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type GoldenGateDeploymentBackupResourceCrud struct {
@@ -198,7 +199,7 @@ func (s *GoldenGateDeploymentBackupResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *GoldenGateDeploymentBackupResourceCrud) Create() error {
+func (s *GoldenGateDeploymentBackupResourceCrud) Create(ctx context.Context) error {
 	request := oci_golden_gate.CreateDeploymentBackupRequest{}
 
 	if bucket, ok := s.D.GetOkExists("bucket"); ok {
@@ -245,20 +246,20 @@ func (s *GoldenGateDeploymentBackupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
-	response, err := s.Client.CreateDeploymentBackup(context.Background(), request)
+	response, err := s.Client.CreateDeploymentBackup(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDeploymentBackupFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate"), oci_golden_gate.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getDeploymentBackupFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate"), oci_golden_gate.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *GoldenGateDeploymentBackupResourceCrud) getDeploymentBackupFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *GoldenGateDeploymentBackupResourceCrud) getDeploymentBackupFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_golden_gate.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	deploymentId, err := goldenGateDeploymentBackupWaitForWorkRequest(workId, "deploymentbackup",
+	deploymentId, err := goldenGateDeploymentBackupWaitForWorkRequest(ctx, workId, "deploymentbackup",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -268,7 +269,7 @@ func (s *GoldenGateDeploymentBackupResourceCrud) getDeploymentBackupFromWorkRequ
 	}
 	s.D.SetId(*deploymentId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func deploymentBackupWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -294,7 +295,7 @@ func deploymentBackupWorkRequestShouldRetryFunc(timeout time.Duration) func(resp
 	}
 }
 
-func goldenGateDeploymentBackupWaitForWorkRequest(wId *string, entityType string, action oci_golden_gate.ActionTypeEnum,
+func goldenGateDeploymentBackupWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_golden_gate.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_golden_gate.GoldenGateClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "golden_gate")
 	retryPolicy.ShouldRetryOperation = deploymentBackupWorkRequestShouldRetryFunc(timeout)
@@ -312,7 +313,7 @@ func goldenGateDeploymentBackupWaitForWorkRequest(wId *string, entityType string
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_golden_gate.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -341,14 +342,14 @@ func goldenGateDeploymentBackupWaitForWorkRequest(wId *string, entityType string
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_golden_gate.OperationStatusFailed || response.Status == oci_golden_gate.OperationStatusCanceled {
-		return nil, getErrorFromGoldenGateDeploymentBackupWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromGoldenGateDeploymentBackupWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromGoldenGateDeploymentBackupWorkRequest(client *oci_golden_gate.GoldenGateClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_golden_gate.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromGoldenGateDeploymentBackupWorkRequest(ctx context.Context, client *oci_golden_gate.GoldenGateClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_golden_gate.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_golden_gate.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -370,7 +371,7 @@ func getErrorFromGoldenGateDeploymentBackupWorkRequest(client *oci_golden_gate.G
 	return workRequestErr
 }
 
-func (s *GoldenGateDeploymentBackupResourceCrud) Get() error {
+func (s *GoldenGateDeploymentBackupResourceCrud) Get(ctx context.Context) error {
 	request := oci_golden_gate.GetDeploymentBackupRequest{}
 
 	tmp := s.D.Id()
@@ -378,7 +379,7 @@ func (s *GoldenGateDeploymentBackupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
-	response, err := s.Client.GetDeploymentBackup(context.Background(), request)
+	response, err := s.Client.GetDeploymentBackup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -387,11 +388,11 @@ func (s *GoldenGateDeploymentBackupResourceCrud) Get() error {
 	return nil
 }
 
-func (s *GoldenGateDeploymentBackupResourceCrud) Update() error {
+func (s *GoldenGateDeploymentBackupResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -416,7 +417,7 @@ func (s *GoldenGateDeploymentBackupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
-	response, err := s.Client.UpdateDeploymentBackup(context.Background(), request)
+	response, err := s.Client.UpdateDeploymentBackup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -425,7 +426,7 @@ func (s *GoldenGateDeploymentBackupResourceCrud) Update() error {
 	return nil
 }
 
-func (s *GoldenGateDeploymentBackupResourceCrud) Delete() error {
+func (s *GoldenGateDeploymentBackupResourceCrud) Delete(ctx context.Context) error {
 	request := oci_golden_gate.DeleteDeploymentBackupRequest{}
 
 	tmp := s.D.Id()
@@ -433,14 +434,14 @@ func (s *GoldenGateDeploymentBackupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
-	response, err := s.Client.DeleteDeploymentBackup(context.Background(), request)
+	response, err := s.Client.DeleteDeploymentBackup(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := goldenGateDeploymentBackupWaitForWorkRequest(workId, "deploymentbackup",
+	_, delWorkRequestErr := goldenGateDeploymentBackupWaitForWorkRequest(ctx, workId, "deploymentbackup",
 		oci_golden_gate.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -599,7 +600,7 @@ func DeploymentBackupSummaryToMap(obj oci_golden_gate.DeploymentBackupSummary) m
 	return result
 }
 
-func (s *GoldenGateDeploymentBackupResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *GoldenGateDeploymentBackupResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_golden_gate.ChangeDeploymentBackupCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -610,12 +611,12 @@ func (s *GoldenGateDeploymentBackupResourceCrud) updateCompartment(compartment i
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "golden_gate")
 
-	_, err := s.Client.ChangeDeploymentBackupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeDeploymentBackupCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
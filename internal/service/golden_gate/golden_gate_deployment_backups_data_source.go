@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_golden_gate "github.com/oracle/oci-go-sdk/v65/goldengate"
 )
 
 func GoldenGateDeploymentBackupsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readGoldenGateDeploymentBackups,
+		ReadContext: readGoldenGateDeploymentBackups,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -52,12 +53,12 @@ func GoldenGateDeploymentBackupsDataSource() *schema.Resource {
 	}
 }
 
-func readGoldenGateDeploymentBackups(d *schema.ResourceData, m interface{}) error {
+func readGoldenGateDeploymentBackups(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GoldenGateDeploymentBackupsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GoldenGateClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type GoldenGateDeploymentBackupsDataSourceCrud struct {
@@ -70,7 +71,7 @@ func (s *GoldenGateDeploymentBackupsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *GoldenGateDeploymentBackupsDataSourceCrud) Get() error {
+func (s *GoldenGateDeploymentBackupsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_golden_gate.ListDeploymentBackupsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -94,7 +95,7 @@ func (s *GoldenGateDeploymentBackupsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "golden_gate")
 
-	response, err := s.Client.ListDeploymentBackups(context.Background(), request)
+	response, err := s.Client.ListDeploymentBackups(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *GoldenGateDeploymentBackupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDeploymentBackups(context.Background(), request)
+		listResponse, err := s.Client.ListDeploymentBackups(ctx, request)
 		if err != nil {
 			return err
 		}
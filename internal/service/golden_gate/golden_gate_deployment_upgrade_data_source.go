@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_golden_gate "github.com/oracle/oci-go-sdk/v65/goldengate"
 )
 
 func GoldenGateDeploymentUpgradeDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularGoldenGateDeploymentUpgrade,
+		ReadContext: readSingularGoldenGateDeploymentUpgrade,
 		Schema: map[string]*schema.Schema{
 			"deployment_upgrade_id": {
 				Type:     schema.TypeString,
@@ -93,12 +94,12 @@ func GoldenGateDeploymentUpgradeDataSource() *schema.Resource {
 	}
 }
 
-func readSingularGoldenGateDeploymentUpgrade(d *schema.ResourceData, m interface{}) error {
+func readSingularGoldenGateDeploymentUpgrade(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GoldenGateDeploymentUpgradeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GoldenGateClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type GoldenGateDeploymentUpgradeDataSourceCrud struct {
@@ -111,7 +112,7 @@ func (s *GoldenGateDeploymentUpgradeDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *GoldenGateDeploymentUpgradeDataSourceCrud) Get() error {
+func (s *GoldenGateDeploymentUpgradeDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_golden_gate.GetDeploymentUpgradeRequest{}
 
 	if deploymentUpgradeId, ok := s.D.GetOkExists("deployment_upgrade_id"); ok {
@@ -121,7 +122,7 @@ func (s *GoldenGateDeploymentUpgradeDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "golden_gate")
 
-	response, err := s.Client.GetDeploymentUpgrade(context.Background(), request)
+	response, err := s.Client.GetDeploymentUpgrade(ctx, request)
 	if err != nil {
 		return err
 	}
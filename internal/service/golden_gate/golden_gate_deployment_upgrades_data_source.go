@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_golden_gate "github.com/oracle/oci-go-sdk/v65/goldengate"
 )
 
 func GoldenGateDeploymentUpgradesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readGoldenGateDeploymentUpgrades,
+		ReadContext: readGoldenGateDeploymentUpgrades,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -130,12 +131,12 @@ func GoldenGateDeploymentUpgradesDataSource() *schema.Resource {
 	}
 }
 
-func readGoldenGateDeploymentUpgrades(d *schema.ResourceData, m interface{}) error {
+func readGoldenGateDeploymentUpgrades(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GoldenGateDeploymentUpgradesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GoldenGateClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type GoldenGateDeploymentUpgradesDataSourceCrud struct {
@@ -148,7 +149,7 @@ func (s *GoldenGateDeploymentUpgradesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *GoldenGateDeploymentUpgradesDataSourceCrud) Get() error {
+func (s *GoldenGateDeploymentUpgradesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_golden_gate.ListDeploymentUpgradesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -172,7 +173,7 @@ func (s *GoldenGateDeploymentUpgradesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "golden_gate")
 
-	response, err := s.Client.ListDeploymentUpgrades(context.Background(), request)
+	response, err := s.Client.ListDeploymentUpgrades(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -181,7 +182,7 @@ func (s *GoldenGateDeploymentUpgradesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDeploymentUpgrades(context.Background(), request)
+		listResponse, err := s.Client.ListDeploymentUpgrades(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package golden_gate
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_golden_gate "github.com/oracle/oci-go-sdk/v65/goldengate"
 
@@ -15,7 +16,7 @@ import (
 
 func GoldenGateMessageDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularGoldenGateMessage,
+		ReadContext: readSingularGoldenGateMessage,
 		Schema: map[string]*schema.Schema{
 			"deployment_id": {
 				Type:     schema.TypeString,
@@ -51,12 +52,12 @@ func GoldenGateMessageDataSource() *schema.Resource {
 	}
 }
 
-func readSingularGoldenGateMessage(d *schema.ResourceData, m interface{}) error {
+func readSingularGoldenGateMessage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GoldenGateMessageDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GoldenGateClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type GoldenGateMessageDataSourceCrud struct {
@@ -69,7 +70,7 @@ func (s *GoldenGateMessageDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *GoldenGateMessageDataSourceCrud) Get() error {
+func (s *GoldenGateMessageDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_golden_gate.ListMessagesRequest{}
 
 	if deploymentId, ok := s.D.GetOkExists("deployment_id"); ok {
@@ -79,7 +80,7 @@ func (s *GoldenGateMessageDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "golden_gate")
 
-	response, err := s.Client.ListMessages(context.Background(), request)
+	response, err := s.Client.ListMessages(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package golden_gate
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_golden_gate "github.com/oracle/oci-go-sdk/v65/goldengate"
 
@@ -15,7 +16,7 @@ import (
 
 func GoldenGateTrailFileDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularGoldenGateTrailFile,
+		ReadContext: readSingularGoldenGateTrailFile,
 		Schema: map[string]*schema.Schema{
 			"deployment_id": {
 				Type:     schema.TypeString,
@@ -90,12 +91,12 @@ func GoldenGateTrailFileDataSource() *schema.Resource {
 	}
 }
 
-func readSingularGoldenGateTrailFile(d *schema.ResourceData, m interface{}) error {
+func readSingularGoldenGateTrailFile(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GoldenGateTrailFileDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GoldenGateClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type GoldenGateTrailFileDataSourceCrud struct {
@@ -108,7 +109,7 @@ func (s *GoldenGateTrailFileDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *GoldenGateTrailFileDataSourceCrud) Get() error {
+func (s *GoldenGateTrailFileDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_golden_gate.ListTrailFilesRequest{}
 
 	if deploymentId, ok := s.D.GetOkExists("deployment_id"); ok {
@@ -128,7 +129,7 @@ func (s *GoldenGateTrailFileDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "golden_gate")
 
-	response, err := s.Client.ListTrailFiles(context.Background(), request)
+	response, err := s.Client.ListTrailFiles(ctx, request)
 	if err != nil {
 		return err
 	}
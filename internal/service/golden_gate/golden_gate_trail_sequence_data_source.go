@@ -6,6 +6,7 @@ package golden_gate
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_golden_gate "github.com/oracle/oci-go-sdk/v65/goldengate"
 
@@ -15,7 +16,7 @@ import (
 
 func GoldenGateTrailSequenceDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularGoldenGateTrailSequence,
+		ReadContext: readSingularGoldenGateTrailSequence,
 		Schema: map[string]*schema.Schema{
 			"deployment_id": {
 				Type:     schema.TypeString,
@@ -71,12 +72,12 @@ func GoldenGateTrailSequenceDataSource() *schema.Resource {
 	}
 }
 
-func readSingularGoldenGateTrailSequence(d *schema.ResourceData, m interface{}) error {
+func readSingularGoldenGateTrailSequence(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GoldenGateTrailSequenceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GoldenGateClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type GoldenGateTrailSequenceDataSourceCrud struct {
@@ -89,7 +90,7 @@ func (s *GoldenGateTrailSequenceDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *GoldenGateTrailSequenceDataSourceCrud) Get() error {
+func (s *GoldenGateTrailSequenceDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_golden_gate.ListTrailSequencesRequest{}
 
 	if deploymentId, ok := s.D.GetOkExists("deployment_id"); ok {
@@ -114,7 +115,7 @@ func (s *GoldenGateTrailSequenceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "golden_gate")
 
-	response, err := s.Client.ListTrailSequences(context.Background(), request)
+	response, err := s.Client.ListTrailSequences(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package golden_gate
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_golden_gate "github.com/oracle/oci-go-sdk/v65/goldengate"
 
@@ -15,7 +16,7 @@ import (
 
 func GoldenGateTrailSequencesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readGoldenGateTrailSequences,
+		ReadContext: readGoldenGateTrailSequences,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"deployment_id": {
@@ -79,12 +80,12 @@ func GoldenGateTrailSequencesDataSource() *schema.Resource {
 	}
 }
 
-func readGoldenGateTrailSequences(d *schema.ResourceData, m interface{}) error {
+func readGoldenGateTrailSequences(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &GoldenGateTrailSequencesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GoldenGateClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type GoldenGateTrailSequencesDataSourceCrud struct {
@@ -97,7 +98,7 @@ func (s *GoldenGateTrailSequencesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *GoldenGateTrailSequencesDataSourceCrud) Get() error {
+func (s *GoldenGateTrailSequencesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_golden_gate.ListTrailSequencesRequest{}
 
 	if deploymentId, ok := s.D.GetOkExists("deployment_id"); ok {
@@ -122,7 +123,7 @@ func (s *GoldenGateTrailSequencesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "golden_gate")
 
-	response, err := s.Client.ListTrailSequences(context.Background(), request)
+	response, err := s.Client.ListTrailSequences(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -131,7 +132,7 @@ func (s *GoldenGateTrailSequencesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListTrailSequences(context.Background(), request)
+		listResponse, err := s.Client.ListTrailSequences(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package health_checks
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_health_checks "github.com/oracle/oci-go-sdk/v65/healthchecks"
 
@@ -22,12 +23,12 @@ func HealthChecksHttpMonitorDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(HealthChecksHttpMonitorResource(), fieldMap, readSingularHealthChecksHttpMonitor)
 }
 
-func readSingularHealthChecksHttpMonitor(d *schema.ResourceData, m interface{}) error {
+func readSingularHealthChecksHttpMonitor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &HealthChecksHttpMonitorDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).HealthChecksClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type HealthChecksHttpMonitorDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *HealthChecksHttpMonitorDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *HealthChecksHttpMonitorDataSourceCrud) Get() error {
+func (s *HealthChecksHttpMonitorDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_health_checks.GetHttpMonitorRequest{}
 
 	if monitorId, ok := s.D.GetOkExists("monitor_id"); ok {
@@ -50,7 +51,7 @@ func (s *HealthChecksHttpMonitorDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "health_checks")
 
-	response, err := s.Client.GetHttpMonitor(context.Background(), request)
+	response, err := s.Client.GetHttpMonitor(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package health_checks
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_health_checks "github.com/oracle/oci-go-sdk/v65/healthchecks"
@@ -19,11 +20,11 @@ func HealthChecksHttpMonitorResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createHealthChecksHttpMonitor,
-		Read:     readHealthChecksHttpMonitor,
-		Update:   updateHealthChecksHttpMonitor,
-		Delete:   deleteHealthChecksHttpMonitor,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createHealthChecksHttpMonitor,
+		ReadContext:   readHealthChecksHttpMonitor,
+		UpdateContext: updateHealthChecksHttpMonitor,
+		DeleteContext: deleteHealthChecksHttpMonitor,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -121,37 +122,37 @@ func HealthChecksHttpMonitorResource() *schema.Resource {
 	}
 }
 
-func createHealthChecksHttpMonitor(d *schema.ResourceData, m interface{}) error {
+func createHealthChecksHttpMonitor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &HealthChecksHttpMonitorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).HealthChecksClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readHealthChecksHttpMonitor(d *schema.ResourceData, m interface{}) error {
+func readHealthChecksHttpMonitor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &HealthChecksHttpMonitorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).HealthChecksClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateHealthChecksHttpMonitor(d *schema.ResourceData, m interface{}) error {
+func updateHealthChecksHttpMonitor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &HealthChecksHttpMonitorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).HealthChecksClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteHealthChecksHttpMonitor(d *schema.ResourceData, m interface{}) error {
+func deleteHealthChecksHttpMonitor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &HealthChecksHttpMonitorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).HealthChecksClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type HealthChecksHttpMonitorResourceCrud struct {
@@ -165,7 +166,7 @@ func (s *HealthChecksHttpMonitorResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *HealthChecksHttpMonitorResourceCrud) Create() error {
+func (s *HealthChecksHttpMonitorResourceCrud) Create(ctx context.Context) error {
 	request := oci_health_checks.CreateHttpMonitorRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -255,7 +256,7 @@ func (s *HealthChecksHttpMonitorResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.CreateHttpMonitor(context.Background(), request)
+	response, err := s.Client.CreateHttpMonitor(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -264,7 +265,7 @@ func (s *HealthChecksHttpMonitorResourceCrud) Create() error {
 	return nil
 }
 
-func (s *HealthChecksHttpMonitorResourceCrud) Get() error {
+func (s *HealthChecksHttpMonitorResourceCrud) Get(ctx context.Context) error {
 	request := oci_health_checks.GetHttpMonitorRequest{}
 
 	tmp := s.D.Id()
@@ -272,7 +273,7 @@ func (s *HealthChecksHttpMonitorResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.GetHttpMonitor(context.Background(), request)
+	response, err := s.Client.GetHttpMonitor(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -281,11 +282,11 @@ func (s *HealthChecksHttpMonitorResourceCrud) Get() error {
 	return nil
 }
 
-func (s *HealthChecksHttpMonitorResourceCrud) Update() error {
+func (s *HealthChecksHttpMonitorResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -378,7 +379,7 @@ func (s *HealthChecksHttpMonitorResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.UpdateHttpMonitor(context.Background(), request)
+	response, err := s.Client.UpdateHttpMonitor(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -387,7 +388,7 @@ func (s *HealthChecksHttpMonitorResourceCrud) Update() error {
 	return nil
 }
 
-func (s *HealthChecksHttpMonitorResourceCrud) Delete() error {
+func (s *HealthChecksHttpMonitorResourceCrud) Delete(ctx context.Context) error {
 	request := oci_health_checks.DeleteHttpMonitorRequest{}
 
 	tmp := s.D.Id()
@@ -395,7 +396,7 @@ func (s *HealthChecksHttpMonitorResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	_, err := s.Client.DeleteHttpMonitor(context.Background(), request)
+	_, err := s.Client.DeleteHttpMonitor(ctx, request)
 	return err
 }
 
@@ -459,7 +460,7 @@ func (s *HealthChecksHttpMonitorResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *HealthChecksHttpMonitorResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *HealthChecksHttpMonitorResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_health_checks.ChangeHttpMonitorCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -470,12 +471,12 @@ func (s *HealthChecksHttpMonitorResourceCrud) updateCompartment(compartment inte
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	_, err := s.Client.ChangeHttpMonitorCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeHttpMonitorCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package health_checks
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_health_checks "github.com/oracle/oci-go-sdk/v65/healthchecks"
 
@@ -15,7 +16,7 @@ import (
 
 func HealthChecksHttpMonitorsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readHealthChecksHttpMonitors,
+		ReadContext: readHealthChecksHttpMonitors,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,12 +40,12 @@ func HealthChecksHttpMonitorsDataSource() *schema.Resource {
 	}
 }
 
-func readHealthChecksHttpMonitors(d *schema.ResourceData, m interface{}) error {
+func readHealthChecksHttpMonitors(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &HealthChecksHttpMonitorsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).HealthChecksClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type HealthChecksHttpMonitorsDataSourceCrud struct {
@@ -57,7 +58,7 @@ func (s *HealthChecksHttpMonitorsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *HealthChecksHttpMonitorsDataSourceCrud) Get() error {
+func (s *HealthChecksHttpMonitorsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_health_checks.ListHttpMonitorsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -77,7 +78,7 @@ func (s *HealthChecksHttpMonitorsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "health_checks")
 
-	response, err := s.Client.ListHttpMonitors(context.Background(), request)
+	response, err := s.Client.ListHttpMonitors(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *HealthChecksHttpMonitorsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListHttpMonitors(context.Background(), request)
+		listResponse, err := s.Client.ListHttpMonitors(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package health_checks
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_health_checks "github.com/oracle/oci-go-sdk/v65/healthchecks"
 
@@ -15,7 +16,7 @@ import (
 
 func HealthChecksHttpProbeResultsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readHealthChecksHttpProbeResults,
+		ReadContext: readHealthChecksHttpProbeResults,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"probe_configuration_id": {
@@ -192,12 +193,12 @@ func HealthChecksHttpProbeResultsDataSource() *schema.Resource {
 	}
 }
 
-func readHealthChecksHttpProbeResults(d *schema.ResourceData, m interface{}) error {
+func readHealthChecksHttpProbeResults(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &HealthChecksHttpProbeResultsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).HealthChecksClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type HealthChecksHttpProbeResultsDataSourceCrud struct {
@@ -210,7 +211,7 @@ func (s *HealthChecksHttpProbeResultsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *HealthChecksHttpProbeResultsDataSourceCrud) Get() error {
+func (s *HealthChecksHttpProbeResultsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_health_checks.ListHttpProbeResultsRequest{}
 
 	if probeConfigurationId, ok := s.D.GetOkExists("probe_configuration_id"); ok {
@@ -235,7 +236,7 @@ func (s *HealthChecksHttpProbeResultsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "health_checks")
 
-	response, err := s.Client.ListHttpProbeResults(context.Background(), request)
+	response, err := s.Client.ListHttpProbeResults(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -244,7 +245,7 @@ func (s *HealthChecksHttpProbeResultsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListHttpProbeResults(context.Background(), request)
+		listResponse, err := s.Client.ListHttpProbeResults(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package health_checks
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_health_checks "github.com/oracle/oci-go-sdk/v65/healthchecks"
@@ -19,11 +20,11 @@ func HealthChecksPingMonitorResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createHealthChecksPingMonitor,
-		Read:     readHealthChecksPingMonitor,
-		Update:   updateHealthChecksPingMonitor,
-		Delete:   deleteHealthChecksPingMonitor,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createHealthChecksPingMonitor,
+		ReadContext:   readHealthChecksPingMonitor,
+		UpdateContext: updateHealthChecksPingMonitor,
+		DeleteContext: deleteHealthChecksPingMonitor,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -105,37 +106,37 @@ func HealthChecksPingMonitorResource() *schema.Resource {
 	}
 }
 
-func createHealthChecksPingMonitor(d *schema.ResourceData, m interface{}) error {
+func createHealthChecksPingMonitor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &HealthChecksPingMonitorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).HealthChecksClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readHealthChecksPingMonitor(d *schema.ResourceData, m interface{}) error {
+func readHealthChecksPingMonitor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &HealthChecksPingMonitorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).HealthChecksClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateHealthChecksPingMonitor(d *schema.ResourceData, m interface{}) error {
+func updateHealthChecksPingMonitor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &HealthChecksPingMonitorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).HealthChecksClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteHealthChecksPingMonitor(d *schema.ResourceData, m interface{}) error {
+func deleteHealthChecksPingMonitor(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &HealthChecksPingMonitorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).HealthChecksClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type HealthChecksPingMonitorResourceCrud struct {
@@ -149,7 +150,7 @@ func (s *HealthChecksPingMonitorResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *HealthChecksPingMonitorResourceCrud) Create() error {
+func (s *HealthChecksPingMonitorResourceCrud) Create(ctx context.Context) error {
 	request := oci_health_checks.CreatePingMonitorRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -226,7 +227,7 @@ func (s *HealthChecksPingMonitorResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.CreatePingMonitor(context.Background(), request)
+	response, err := s.Client.CreatePingMonitor(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -235,7 +236,7 @@ func (s *HealthChecksPingMonitorResourceCrud) Create() error {
 	return nil
 }
 
-func (s *HealthChecksPingMonitorResourceCrud) Get() error {
+func (s *HealthChecksPingMonitorResourceCrud) Get(ctx context.Context) error {
 	request := oci_health_checks.GetPingMonitorRequest{}
 
 	tmp := s.D.Id()
@@ -243,7 +244,7 @@ func (s *HealthChecksPingMonitorResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.GetPingMonitor(context.Background(), request)
+	response, err := s.Client.GetPingMonitor(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -252,11 +253,11 @@ func (s *HealthChecksPingMonitorResourceCrud) Get() error {
 	return nil
 }
 
-func (s *HealthChecksPingMonitorResourceCrud) Update() error {
+func (s *HealthChecksPingMonitorResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -336,7 +337,7 @@ func (s *HealthChecksPingMonitorResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.UpdatePingMonitor(context.Background(), request)
+	response, err := s.Client.UpdatePingMonitor(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -345,7 +346,7 @@ func (s *HealthChecksPingMonitorResourceCrud) Update() error {
 	return nil
 }
 
-func (s *HealthChecksPingMonitorResourceCrud) Delete() error {
+func (s *HealthChecksPingMonitorResourceCrud) Delete(ctx context.Context) error {
 	request := oci_health_checks.DeletePingMonitorRequest{}
 
 	tmp := s.D.Id()
@@ -353,7 +354,7 @@ func (s *HealthChecksPingMonitorResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	_, err := s.Client.DeletePingMonitor(context.Background(), request)
+	_, err := s.Client.DeletePingMonitor(ctx, request)
 	return err
 }
 
@@ -409,7 +410,7 @@ func (s *HealthChecksPingMonitorResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *HealthChecksPingMonitorResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *HealthChecksPingMonitorResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_health_checks.ChangePingMonitorCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -420,12 +421,12 @@ func (s *HealthChecksPingMonitorResourceCrud) updateCompartment(compartment inte
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	_, err := s.Client.ChangePingMonitorCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangePingMonitorCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package health_checks
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_health_checks "github.com/oracle/oci-go-sdk/v65/healthchecks"
 
@@ -15,7 +16,7 @@ import (
 
 func HealthChecksPingMonitorsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readHealthChecksPingMonitors,
+		ReadContext: readHealthChecksPingMonitors,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,12 +40,12 @@ func HealthChecksPingMonitorsDataSource() *schema.Resource {
 	}
 }
 
-func readHealthChecksPingMonitors(d *schema.ResourceData, m interface{}) error {
+func readHealthChecksPingMonitors(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &HealthChecksPingMonitorsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).HealthChecksClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type HealthChecksPingMonitorsDataSourceCrud struct {
@@ -57,7 +58,7 @@ func (s *HealthChecksPingMonitorsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *HealthChecksPingMonitorsDataSourceCrud) Get() error {
+func (s *HealthChecksPingMonitorsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_health_checks.ListPingMonitorsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -77,7 +78,7 @@ func (s *HealthChecksPingMonitorsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "health_checks")
 
-	response, err := s.Client.ListPingMonitors(context.Background(), request)
+	response, err := s.Client.ListPingMonitors(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *HealthChecksPingMonitorsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPingMonitors(context.Background(), request)
+		listResponse, err := s.Client.ListPingMonitors(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package health_checks
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_health_checks "github.com/oracle/oci-go-sdk/v65/healthchecks"
@@ -19,10 +20,10 @@ func HealthChecksPingProbeResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createHealthChecksPingProbe,
-		Read:     readHealthChecksPingProbe,
-		Delete:   deleteHealthChecksPingProbe,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createHealthChecksPingProbe,
+		ReadContext:   readHealthChecksPingProbe,
+		DeleteContext: deleteHealthChecksPingProbe,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -84,19 +85,19 @@ func HealthChecksPingProbeResource() *schema.Resource {
 	}
 }
 
-func createHealthChecksPingProbe(d *schema.ResourceData, m interface{}) error {
+func createHealthChecksPingProbe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &HealthChecksPingProbeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).HealthChecksClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readHealthChecksPingProbe(d *schema.ResourceData, m interface{}) error {
+func readHealthChecksPingProbe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteHealthChecksPingProbe(d *schema.ResourceData, m interface{}) error {
+func deleteHealthChecksPingProbe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -111,7 +112,7 @@ func (s *HealthChecksPingProbeResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *HealthChecksPingProbeResourceCrud) Create() error {
+func (s *HealthChecksPingProbeResourceCrud) Create(ctx context.Context) error {
 	request := oci_health_checks.CreateOnDemandPingProbeRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -161,7 +162,7 @@ func (s *HealthChecksPingProbeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "health_checks")
 
-	response, err := s.Client.CreateOnDemandPingProbe(context.Background(), request)
+	response, err := s.Client.CreateOnDemandPingProbe(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package health_checks
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_health_checks "github.com/oracle/oci-go-sdk/v65/healthchecks"
 
@@ -15,7 +16,7 @@ import (
 
 func HealthChecksPingProbeResultsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readHealthChecksPingProbeResults,
+		ReadContext: readHealthChecksPingProbeResults,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"probe_configuration_id": {
@@ -152,12 +153,12 @@ func HealthChecksPingProbeResultsDataSource() *schema.Resource {
 	}
 }
 
-func readHealthChecksPingProbeResults(d *schema.ResourceData, m interface{}) error {
+func readHealthChecksPingProbeResults(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &HealthChecksPingProbeResultsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).HealthChecksClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type HealthChecksPingProbeResultsDataSourceCrud struct {
@@ -170,7 +171,7 @@ func (s *HealthChecksPingProbeResultsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *HealthChecksPingProbeResultsDataSourceCrud) Get() error {
+func (s *HealthChecksPingProbeResultsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_health_checks.ListPingProbeResultsRequest{}
 
 	if probeConfigurationId, ok := s.D.GetOkExists("probe_configuration_id"); ok {
@@ -195,7 +196,7 @@ func (s *HealthChecksPingProbeResultsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "health_checks")
 
-	response, err := s.Client.ListPingProbeResults(context.Background(), request)
+	response, err := s.Client.ListPingProbeResults(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -204,7 +205,7 @@ func (s *HealthChecksPingProbeResultsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPingProbeResults(context.Background(), request)
+		listResponse, err := s.Client.ListPingProbeResults(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package health_checks
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_health_checks "github.com/oracle/oci-go-sdk/v65/healthchecks"
 
@@ -15,7 +16,7 @@ import (
 
 func HealthChecksVantagePointsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readHealthChecksVantagePoints,
+		ReadContext: readHealthChecksVantagePoints,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"display_name": {
@@ -125,12 +126,12 @@ func HealthChecksVantagePointsDataSource() *schema.Resource {
 	}
 }
 
-func readHealthChecksVantagePoints(d *schema.ResourceData, m interface{}) error {
+func readHealthChecksVantagePoints(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &HealthChecksVantagePointsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).HealthChecksClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type HealthChecksVantagePointsDataSourceCrud struct {
@@ -143,7 +144,7 @@ func (s *HealthChecksVantagePointsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *HealthChecksVantagePointsDataSourceCrud) Get() error {
+func (s *HealthChecksVantagePointsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_health_checks.ListHealthChecksVantagePointsRequest{}
 
 	if displayName, ok := s.D.GetOkExists("display_name"); ok {
@@ -158,7 +159,7 @@ func (s *HealthChecksVantagePointsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "health_checks")
 
-	response, err := s.Client.ListHealthChecksVantagePoints(context.Background(), request)
+	response, err := s.Client.ListHealthChecksVantagePoints(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -167,7 +168,7 @@ func (s *HealthChecksVantagePointsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListHealthChecksVantagePoints(context.Background(), request)
+		listResponse, err := s.Client.ListHealthChecksVantagePoints(ctx, request)
 		if err != nil {
 			return err
 		}
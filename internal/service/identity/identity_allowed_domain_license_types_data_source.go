@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 )
 
 func IdentityAllowedDomainLicenseTypesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityAllowedDomainLicenseTypes,
+		ReadContext: readIdentityAllowedDomainLicenseTypes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"current_license_type_name": {
@@ -51,12 +52,12 @@ func IdentityAllowedDomainLicenseTypesDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityAllowedDomainLicenseTypes(d *schema.ResourceData, m interface{}) error {
+func readIdentityAllowedDomainLicenseTypes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityAllowedDomainLicenseTypesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityAllowedDomainLicenseTypesDataSourceCrud struct {
@@ -69,7 +70,7 @@ func (s *IdentityAllowedDomainLicenseTypesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityAllowedDomainLicenseTypesDataSourceCrud) Get() error {
+func (s *IdentityAllowedDomainLicenseTypesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListAllowedDomainLicenseTypesRequest{}
 
 	if currentLicenseTypeName, ok := s.D.GetOkExists("current_license_type_name"); ok {
@@ -79,7 +80,7 @@ func (s *IdentityAllowedDomainLicenseTypesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListAllowedDomainLicenseTypes(context.Background(), request)
+	response, err := s.Client.ListAllowedDomainLicenseTypes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
@@ -26,10 +27,10 @@ func IdentityApiKeyResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityApiKey,
-		Read:     readIdentityApiKey,
-		Delete:   deleteIdentityApiKey,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityApiKey,
+		ReadContext:   readIdentityApiKey,
+		DeleteContext: deleteIdentityApiKey,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"key_value": {
@@ -72,29 +73,29 @@ func IdentityApiKeyResource() *schema.Resource {
 	}
 }
 
-func createIdentityApiKey(d *schema.ResourceData, m interface{}) error {
+func createIdentityApiKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityApiKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readIdentityApiKey(d *schema.ResourceData, m interface{}) error {
+func readIdentityApiKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityApiKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteIdentityApiKey(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityApiKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityApiKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type IdentityApiKeyResourceCrud struct {
@@ -136,7 +137,7 @@ func (s *IdentityApiKeyResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentityApiKeyResourceCrud) Create() error {
+func (s *IdentityApiKeyResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.UploadApiKeyRequest{}
 
 	if keyValue, ok := s.D.GetOkExists("key_value"); ok {
@@ -151,7 +152,7 @@ func (s *IdentityApiKeyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UploadApiKey(context.Background(), request)
+	response, err := s.Client.UploadApiKey(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -160,7 +161,7 @@ func (s *IdentityApiKeyResourceCrud) Create() error {
 	return nil
 }
 
-func (s *IdentityApiKeyResourceCrud) Get() error {
+func (s *IdentityApiKeyResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListApiKeysRequest{}
 
 	if userId, ok := s.D.GetOkExists("user_id"); ok {
@@ -178,7 +179,7 @@ func (s *IdentityApiKeyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ListApiKeys(context.Background(), request)
+	response, err := s.Client.ListApiKeys(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -195,7 +196,7 @@ func (s *IdentityApiKeyResourceCrud) Get() error {
 
 }
 
-func (s *IdentityApiKeyResourceCrud) Delete() error {
+func (s *IdentityApiKeyResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.DeleteApiKeyRequest{}
 
 	if fingerprint, ok := s.D.GetOkExists("fingerprint"); ok {
@@ -210,7 +211,7 @@ func (s *IdentityApiKeyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteApiKey(context.Background(), request)
+	_, err := s.Client.DeleteApiKey(ctx, request)
 	return err
 }
 
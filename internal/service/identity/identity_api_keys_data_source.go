@@ -10,13 +10,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 )
 
 func IdentityApiKeysDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityApiKeys,
+		ReadContext: readIdentityApiKeys,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"user_id": {
@@ -32,12 +33,12 @@ func IdentityApiKeysDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityApiKeys(d *schema.ResourceData, m interface{}) error {
+func readIdentityApiKeys(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityApiKeysDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityApiKeysDataSourceCrud struct {
@@ -50,7 +51,7 @@ func (s *IdentityApiKeysDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityApiKeysDataSourceCrud) Get() error {
+func (s *IdentityApiKeysDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListApiKeysRequest{}
 
 	if userId, ok := s.D.GetOkExists("user_id"); ok {
@@ -60,7 +61,7 @@ func (s *IdentityApiKeysDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListApiKeys(context.Background(), request)
+	response, err := s.Client.ListApiKeys(ctx, request)
 	if err != nil {
 		return err
 	}
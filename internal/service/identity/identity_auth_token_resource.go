@@ -16,6 +16,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
@@ -26,11 +27,11 @@ func IdentityAuthTokenResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityAuthToken,
-		Read:     readIdentityAuthToken,
-		Update:   updateIdentityAuthToken,
-		Delete:   deleteIdentityAuthToken,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityAuthToken,
+		ReadContext:   readIdentityAuthToken,
+		UpdateContext: updateIdentityAuthToken,
+		DeleteContext: deleteIdentityAuthToken,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"description": {
@@ -70,37 +71,37 @@ func IdentityAuthTokenResource() *schema.Resource {
 	}
 }
 
-func createIdentityAuthToken(d *schema.ResourceData, m interface{}) error {
+func createIdentityAuthToken(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityAuthTokenResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readIdentityAuthToken(d *schema.ResourceData, m interface{}) error {
+func readIdentityAuthToken(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityAuthTokenResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateIdentityAuthToken(d *schema.ResourceData, m interface{}) error {
+func updateIdentityAuthToken(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityAuthTokenResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteIdentityAuthToken(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityAuthToken(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityAuthTokenResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type IdentityAuthTokenResourceCrud struct {
@@ -142,7 +143,7 @@ func (s *IdentityAuthTokenResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentityAuthTokenResourceCrud) Create() error {
+func (s *IdentityAuthTokenResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreateAuthTokenRequest{}
 
 	if description, ok := s.D.GetOkExists("description"); ok {
@@ -157,7 +158,7 @@ func (s *IdentityAuthTokenResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateAuthToken(context.Background(), request)
+	response, err := s.Client.CreateAuthToken(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -166,7 +167,7 @@ func (s *IdentityAuthTokenResourceCrud) Create() error {
 	return nil
 }
 
-func (s *IdentityAuthTokenResourceCrud) Get() error {
+func (s *IdentityAuthTokenResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListAuthTokensRequest{}
 
 	if userId, ok := s.D.GetOkExists("user_id"); ok {
@@ -184,7 +185,7 @@ func (s *IdentityAuthTokenResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ListAuthTokens(context.Background(), request)
+	response, err := s.Client.ListAuthTokens(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -200,7 +201,7 @@ func (s *IdentityAuthTokenResourceCrud) Get() error {
 
 }
 
-func (s *IdentityAuthTokenResourceCrud) Update() error {
+func (s *IdentityAuthTokenResourceCrud) Update(ctx context.Context) error {
 	request := oci_identity.UpdateAuthTokenRequest{}
 
 	tmp := s.D.Id()
@@ -218,7 +219,7 @@ func (s *IdentityAuthTokenResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateAuthToken(context.Background(), request)
+	response, err := s.Client.UpdateAuthToken(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -227,7 +228,7 @@ func (s *IdentityAuthTokenResourceCrud) Update() error {
 	return nil
 }
 
-func (s *IdentityAuthTokenResourceCrud) Delete() error {
+func (s *IdentityAuthTokenResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.DeleteAuthTokenRequest{}
 
 	tmp := s.D.Id()
@@ -240,7 +241,7 @@ func (s *IdentityAuthTokenResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteAuthToken(context.Background(), request)
+	_, err := s.Client.DeleteAuthToken(ctx, request)
 	return err
 }
 
@@ -10,13 +10,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 )
 
 func IdentityAuthTokensDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityAuthTokens,
+		ReadContext: readIdentityAuthTokens,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"user_id": {
@@ -32,12 +33,12 @@ func IdentityAuthTokensDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityAuthTokens(d *schema.ResourceData, m interface{}) error {
+func readIdentityAuthTokens(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityAuthTokensDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityAuthTokensDataSourceCrud struct {
@@ -50,7 +51,7 @@ func (s *IdentityAuthTokensDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityAuthTokensDataSourceCrud) Get() error {
+func (s *IdentityAuthTokensDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListAuthTokensRequest{}
 
 	if userId, ok := s.D.GetOkExists("user_id"); ok {
@@ -60,7 +61,7 @@ func (s *IdentityAuthTokensDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListAuthTokens(context.Background(), request)
+	response, err := s.Client.ListAuthTokens(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -22,12 +23,12 @@ func IdentityAuthenticationPolicyDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(IdentityAuthenticationPolicyResource(), fieldMap, readSingularIdentityAuthenticationPolicy)
 }
 
-func readSingularIdentityAuthenticationPolicy(d *schema.ResourceData, m interface{}) error {
+func readSingularIdentityAuthenticationPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityAuthenticationPolicyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityAuthenticationPolicyDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *IdentityAuthenticationPolicyDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityAuthenticationPolicyDataSourceCrud) Get() error {
+func (s *IdentityAuthenticationPolicyDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetAuthenticationPolicyRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -50,7 +51,7 @@ func (s *IdentityAuthenticationPolicyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.GetAuthenticationPolicy(context.Background(), request)
+	response, err := s.Client.GetAuthenticationPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
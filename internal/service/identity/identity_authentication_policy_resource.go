@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -24,11 +25,11 @@ func IdentityAuthenticationPolicyResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityAuthenticationPolicy,
-		Read:     readIdentityAuthenticationPolicy,
-		Update:   updateIdentityAuthenticationPolicy,
-		Delete:   deleteIdentityAuthenticationPolicy,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityAuthenticationPolicy,
+		ReadContext:   readIdentityAuthenticationPolicy,
+		UpdateContext: updateIdentityAuthenticationPolicy,
+		DeleteContext: deleteIdentityAuthenticationPolicy,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -114,31 +115,31 @@ func IdentityAuthenticationPolicyResource() *schema.Resource {
 	}
 }
 
-func createIdentityAuthenticationPolicy(d *schema.ResourceData, m interface{}) error {
+func createIdentityAuthenticationPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityAuthenticationPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readIdentityAuthenticationPolicy(d *schema.ResourceData, m interface{}) error {
+func readIdentityAuthenticationPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityAuthenticationPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateIdentityAuthenticationPolicy(d *schema.ResourceData, m interface{}) error {
+func updateIdentityAuthenticationPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityAuthenticationPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteIdentityAuthenticationPolicy(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityAuthenticationPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -153,7 +154,7 @@ func (s *IdentityAuthenticationPolicyResourceCrud) ID() string {
 	return GetAuthenticationPolicyCompositeId(s.D.Get("compartment_id").(string))
 }
 
-func (s *IdentityAuthenticationPolicyResourceCrud) Create() error {
+func (s *IdentityAuthenticationPolicyResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.UpdateAuthenticationPolicyRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -185,7 +186,7 @@ func (s *IdentityAuthenticationPolicyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateAuthenticationPolicy(context.Background(), request)
+	response, err := s.Client.UpdateAuthenticationPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -194,7 +195,7 @@ func (s *IdentityAuthenticationPolicyResourceCrud) Create() error {
 	return nil
 }
 
-func (s *IdentityAuthenticationPolicyResourceCrud) Get() error {
+func (s *IdentityAuthenticationPolicyResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetAuthenticationPolicyRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -211,7 +212,7 @@ func (s *IdentityAuthenticationPolicyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetAuthenticationPolicy(context.Background(), request)
+	response, err := s.Client.GetAuthenticationPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -220,7 +221,7 @@ func (s *IdentityAuthenticationPolicyResourceCrud) Get() error {
 	return nil
 }
 
-func (s *IdentityAuthenticationPolicyResourceCrud) Update() error {
+func (s *IdentityAuthenticationPolicyResourceCrud) Update(ctx context.Context) error {
 	request := oci_identity.UpdateAuthenticationPolicyRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -252,7 +253,7 @@ func (s *IdentityAuthenticationPolicyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateAuthenticationPolicy(context.Background(), request)
+	response, err := s.Client.UpdateAuthenticationPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"regexp"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -21,7 +22,7 @@ import (
 
 func IdentityAvailabilityDomainDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readAvailabilityDomain,
+		ReadContext: readAvailabilityDomain,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -46,12 +47,12 @@ func IdentityAvailabilityDomainDataSource() *schema.Resource {
 	}
 }
 
-func readAvailabilityDomain(d *schema.ResourceData, m interface{}) error {
+func readAvailabilityDomain(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &AvailabilityDomainDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type AvailabilityDomainDataSourceCrud struct {
@@ -64,7 +65,7 @@ func (s *AvailabilityDomainDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *AvailabilityDomainDataSourceCrud) Get() error {
+func (s *AvailabilityDomainDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListAvailabilityDomainsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -74,7 +75,7 @@ func (s *AvailabilityDomainDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListAvailabilityDomains(context.Background(), request)
+	response, err := s.Client.ListAvailabilityDomains(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 
 	"sort"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -17,7 +18,7 @@ import (
 
 func IdentityAvailabilityDomainsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityAvailabilityDomains,
+		ReadContext: readIdentityAvailabilityDomains,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -53,12 +54,12 @@ func IdentityAvailabilityDomainsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityAvailabilityDomains(d *schema.ResourceData, m interface{}) error {
+func readIdentityAvailabilityDomains(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityAvailabilityDomainsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityAvailabilityDomainsDataSourceCrud struct {
@@ -71,7 +72,7 @@ func (s *IdentityAvailabilityDomainsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityAvailabilityDomainsDataSourceCrud) Get() error {
+func (s *IdentityAvailabilityDomainsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListAvailabilityDomainsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -81,7 +82,7 @@ func (s *IdentityAvailabilityDomainsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListAvailabilityDomains(context.Background(), request)
+	response, err := s.Client.ListAvailabilityDomains(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -16,7 +17,7 @@ import (
 
 func IdentityCompartmentDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularIdentityCompartment,
+		ReadContext: readSingularIdentityCompartment,
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -65,12 +66,12 @@ func IdentityCompartmentDataSource() *schema.Resource {
 	}
 }
 
-func readSingularIdentityCompartment(d *schema.ResourceData, m interface{}) error {
+func readSingularIdentityCompartment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityCompartmentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityCompartmentDataSourceCrud struct {
@@ -83,7 +84,7 @@ func (s *IdentityCompartmentDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityCompartmentDataSourceCrud) Get() error {
+func (s *IdentityCompartmentDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetCompartmentRequest{}
 
 	// API ambiguity: the GET identifier for a single compartment is "compartmentId", but on the returned object
@@ -95,7 +96,7 @@ func (s *IdentityCompartmentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.GetCompartment(context.Background(), request)
+	response, err := s.Client.GetCompartment(ctx, request)
 	if err != nil {
 		return err
 	}
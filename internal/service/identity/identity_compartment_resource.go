@@ -12,6 +12,7 @@ import (
 
 	"github.com/oracle/terraform-provider-oci/internal/globalvar"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -27,10 +28,10 @@ func IdentityCompartmentResource() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Delete: tfresource.GetTimeoutDuration("90m"), // service team states: p50: 30 min, p90: 60 min, max: 180 min
 		},
-		Create: createIdentityCompartment,
-		Read:   readIdentityCompartment,
-		Update: updateIdentityCompartment,
-		Delete: deleteIdentityCompartment,
+		CreateContext: createIdentityCompartment,
+		ReadContext:   readIdentityCompartment,
+		UpdateContext: updateIdentityCompartment,
+		DeleteContext: deleteIdentityCompartment,
 		Schema: map[string]*schema.Schema{
 			// Required
 			// @next-break: remove customizations
@@ -91,32 +92,32 @@ func IdentityCompartmentResource() *schema.Resource {
 	}
 }
 
-func createIdentityCompartment(d *schema.ResourceData, m interface{}) error {
+func createIdentityCompartment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityCompartmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.Configuration = m.(*client.OracleClients).Configuration
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readIdentityCompartment(d *schema.ResourceData, m interface{}) error {
+func readIdentityCompartment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityCompartmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateIdentityCompartment(d *schema.ResourceData, m interface{}) error {
+func updateIdentityCompartment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityCompartmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteIdentityCompartment(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityCompartment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	if enableDelete, ok := d.GetOkExists("enable_delete"); !ok || !enableDelete.(bool) {
 		return nil
 	}
@@ -126,7 +127,7 @@ func deleteIdentityCompartment(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type IdentityCompartmentResourceCrud struct {
@@ -165,7 +166,7 @@ func (s *IdentityCompartmentResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentityCompartmentResourceCrud) Create() error {
+func (s *IdentityCompartmentResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreateCompartmentRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -212,7 +213,7 @@ func (s *IdentityCompartmentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateCompartment(context.Background(), request)
+	response, err := s.Client.CreateCompartment(ctx, request)
 	if err != nil {
 		if response.RawResponse != nil && response.RawResponse.StatusCode == 409 {
 
@@ -236,7 +237,7 @@ If you intended to manage an existing compartment, use terraform import instead.
 				log.Println(fmt.Sprintf("[DEBUG] The specified compartment with name '%s' may already exist, listing compartments to lookup with name instead.",
 					*request.Name))
 				dsCrud := &IdentityCompartmentsDataSourceCrud{s.D, s.Client, nil}
-				if err := dsCrud.Get(); err != nil {
+				if err := dsCrud.Get(ctx); err != nil {
 					return err
 				}
 
@@ -245,7 +246,7 @@ If you intended to manage an existing compartment, use terraform import instead.
 						s.Res = &compartment
 						//Update with correct description
 						s.D.SetId(s.ID())
-						return s.Update()
+						return s.Update(ctx)
 					}
 				}
 				// Return an error if the lookup failed, to provide user with information on which compartment id and name were used for lookup
@@ -263,7 +264,7 @@ Refer to the 'oci_identity_compartment' documentation for more information.`, er
 	return nil
 }
 
-func (s *IdentityCompartmentResourceCrud) Get() error {
+func (s *IdentityCompartmentResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetCompartmentRequest{}
 
 	tmp := s.D.Id()
@@ -271,7 +272,7 @@ func (s *IdentityCompartmentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetCompartment(context.Background(), request)
+	response, err := s.Client.GetCompartment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -280,11 +281,11 @@ func (s *IdentityCompartmentResourceCrud) Get() error {
 	return nil
 }
 
-func (s *IdentityCompartmentResourceCrud) Update() error {
+func (s *IdentityCompartmentResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -319,7 +320,7 @@ func (s *IdentityCompartmentResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateCompartment(context.Background(), request)
+	response, err := s.Client.UpdateCompartment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -328,7 +329,7 @@ func (s *IdentityCompartmentResourceCrud) Update() error {
 	return nil
 }
 
-func (s *IdentityCompartmentResourceCrud) Delete() error {
+func (s *IdentityCompartmentResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.DeleteCompartmentRequest{}
 
 	tmp := s.D.Id()
@@ -336,7 +337,7 @@ func (s *IdentityCompartmentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteCompartment(context.Background(), request)
+	_, err := s.Client.DeleteCompartment(ctx, request)
 	return err
 }
 
@@ -376,7 +377,7 @@ func (s *IdentityCompartmentResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *IdentityCompartmentResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *IdentityCompartmentResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_identity.MoveCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -389,12 +390,12 @@ func (s *IdentityCompartmentResourceCrud) updateCompartment(compartment interfac
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.MoveCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.MoveCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -16,7 +17,7 @@ import (
 
 func IdentityCompartmentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityCompartments,
+		ReadContext: readIdentityCompartments,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -48,12 +49,12 @@ func IdentityCompartmentsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityCompartments(d *schema.ResourceData, m interface{}) error {
+func readIdentityCompartments(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityCompartmentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityCompartmentsDataSourceCrud struct {
@@ -66,7 +67,7 @@ func (s *IdentityCompartmentsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityCompartmentsDataSourceCrud) Get() error {
+func (s *IdentityCompartmentsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListCompartmentsRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -94,7 +95,7 @@ func (s *IdentityCompartmentsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListCompartments(context.Background(), request)
+	response, err := s.Client.ListCompartments(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *IdentityCompartmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCompartments(context.Background(), request)
+		listResponse, err := s.Client.ListCompartments(ctx, request)
 		if err != nil {
 			return err
 		}
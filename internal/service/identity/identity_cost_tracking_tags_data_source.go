@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -15,7 +16,7 @@ import (
 
 func IdentityCostTrackingTagsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityCostTrackingTags,
+		ReadContext: readIdentityCostTrackingTags,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -113,12 +114,12 @@ func IdentityCostTrackingTagsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityCostTrackingTags(d *schema.ResourceData, m interface{}) error {
+func readIdentityCostTrackingTags(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityCostTrackingTagsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityCostTrackingTagsDataSourceCrud struct {
@@ -131,7 +132,7 @@ func (s *IdentityCostTrackingTagsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityCostTrackingTagsDataSourceCrud) Get() error {
+func (s *IdentityCostTrackingTagsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListCostTrackingTagsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -141,7 +142,7 @@ func (s *IdentityCostTrackingTagsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListCostTrackingTags(context.Background(), request)
+	response, err := s.Client.ListCostTrackingTags(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -150,7 +151,7 @@ func (s *IdentityCostTrackingTagsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCostTrackingTags(context.Background(), request)
+		listResponse, err := s.Client.ListCostTrackingTags(ctx, request)
 		if err != nil {
 			return err
 		}
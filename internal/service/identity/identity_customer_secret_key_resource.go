@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -26,11 +27,11 @@ func IdentityCustomerSecretKeyResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityCustomerSecretKey,
-		Read:     readIdentityCustomerSecretKey,
-		Update:   updateIdentityCustomerSecretKey,
-		Delete:   deleteIdentityCustomerSecretKey,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityCustomerSecretKey,
+		ReadContext:   readIdentityCustomerSecretKey,
+		UpdateContext: updateIdentityCustomerSecretKey,
+		DeleteContext: deleteIdentityCustomerSecretKey,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"display_name": {
@@ -70,37 +71,37 @@ func IdentityCustomerSecretKeyResource() *schema.Resource {
 	}
 }
 
-func createIdentityCustomerSecretKey(d *schema.ResourceData, m interface{}) error {
+func createIdentityCustomerSecretKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityCustomerSecretKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readIdentityCustomerSecretKey(d *schema.ResourceData, m interface{}) error {
+func readIdentityCustomerSecretKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityCustomerSecretKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateIdentityCustomerSecretKey(d *schema.ResourceData, m interface{}) error {
+func updateIdentityCustomerSecretKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityCustomerSecretKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteIdentityCustomerSecretKey(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityCustomerSecretKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityCustomerSecretKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type IdentityCustomerSecretKeyResourceCrud struct {
@@ -142,7 +143,7 @@ func (s *IdentityCustomerSecretKeyResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentityCustomerSecretKeyResourceCrud) Create() error {
+func (s *IdentityCustomerSecretKeyResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreateCustomerSecretKeyRequest{}
 
 	if displayName, ok := s.D.GetOkExists("display_name"); ok {
@@ -157,7 +158,7 @@ func (s *IdentityCustomerSecretKeyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateCustomerSecretKey(context.Background(), request)
+	response, err := s.Client.CreateCustomerSecretKey(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -166,7 +167,7 @@ func (s *IdentityCustomerSecretKeyResourceCrud) Create() error {
 	return nil
 }
 
-func (s *IdentityCustomerSecretKeyResourceCrud) Get() error {
+func (s *IdentityCustomerSecretKeyResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListCustomerSecretKeysRequest{}
 
 	if userId, ok := s.D.GetOkExists("user_id"); ok {
@@ -184,7 +185,7 @@ func (s *IdentityCustomerSecretKeyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ListCustomerSecretKeys(context.Background(), request)
+	response, err := s.Client.ListCustomerSecretKeys(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -200,7 +201,7 @@ func (s *IdentityCustomerSecretKeyResourceCrud) Get() error {
 
 }
 
-func (s *IdentityCustomerSecretKeyResourceCrud) Update() error {
+func (s *IdentityCustomerSecretKeyResourceCrud) Update(ctx context.Context) error {
 	request := oci_identity.UpdateCustomerSecretKeyRequest{}
 
 	tmp := s.D.Id()
@@ -218,7 +219,7 @@ func (s *IdentityCustomerSecretKeyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateCustomerSecretKey(context.Background(), request)
+	response, err := s.Client.UpdateCustomerSecretKey(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -227,7 +228,7 @@ func (s *IdentityCustomerSecretKeyResourceCrud) Update() error {
 	return nil
 }
 
-func (s *IdentityCustomerSecretKeyResourceCrud) Delete() error {
+func (s *IdentityCustomerSecretKeyResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.DeleteCustomerSecretKeyRequest{}
 
 	tmp := s.D.Id()
@@ -240,7 +241,7 @@ func (s *IdentityCustomerSecretKeyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteCustomerSecretKey(context.Background(), request)
+	_, err := s.Client.DeleteCustomerSecretKey(ctx, request)
 	return err
 }
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -16,7 +17,7 @@ import (
 
 func IdentityCustomerSecretKeysDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityCustomerSecretKeys,
+		ReadContext: readIdentityCustomerSecretKeys,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"user_id": {
@@ -32,12 +33,12 @@ func IdentityCustomerSecretKeysDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityCustomerSecretKeys(d *schema.ResourceData, m interface{}) error {
+func readIdentityCustomerSecretKeys(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityCustomerSecretKeysDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityCustomerSecretKeysDataSourceCrud struct {
@@ -50,7 +51,7 @@ func (s *IdentityCustomerSecretKeysDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityCustomerSecretKeysDataSourceCrud) Get() error {
+func (s *IdentityCustomerSecretKeysDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListCustomerSecretKeysRequest{}
 
 	if userId, ok := s.D.GetOkExists("user_id"); ok {
@@ -60,7 +61,7 @@ func (s *IdentityCustomerSecretKeysDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListCustomerSecretKeys(context.Background(), request)
+	response, err := s.Client.ListCustomerSecretKeys(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -23,10 +24,10 @@ import (
 
 func IdentityDbCredentialResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityDbCredential,
-		Read:     readIdentityDbCredential,
-		Delete:   deleteIdentityDbCredential,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityDbCredential,
+		ReadContext:   readIdentityDbCredential,
+		DeleteContext: deleteIdentityDbCredential,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"description": {
@@ -69,29 +70,29 @@ func IdentityDbCredentialResource() *schema.Resource {
 	}
 }
 
-func createIdentityDbCredential(d *schema.ResourceData, m interface{}) error {
+func createIdentityDbCredential(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDbCredentialResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readIdentityDbCredential(d *schema.ResourceData, m interface{}) error {
+func readIdentityDbCredential(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDbCredentialResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteIdentityDbCredential(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityDbCredential(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDbCredentialResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type IdentityDbCredentialResourceCrud struct {
@@ -129,7 +130,7 @@ func (s *IdentityDbCredentialResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentityDbCredentialResourceCrud) Create() error {
+func (s *IdentityDbCredentialResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreateDbCredentialRequest{}
 
 	if description, ok := s.D.GetOkExists("description"); ok {
@@ -149,7 +150,7 @@ func (s *IdentityDbCredentialResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateDbCredential(context.Background(), request)
+	response, err := s.Client.CreateDbCredential(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -158,7 +159,7 @@ func (s *IdentityDbCredentialResourceCrud) Create() error {
 	return nil
 }
 
-func (s *IdentityDbCredentialResourceCrud) Get() error {
+func (s *IdentityDbCredentialResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListDbCredentialsRequest{}
 
 	if userId, ok := s.D.GetOkExists("user_id"); ok {
@@ -176,7 +177,7 @@ func (s *IdentityDbCredentialResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ListDbCredentials(context.Background(), request)
+	response, err := s.Client.ListDbCredentials(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -200,7 +201,7 @@ func fromDbCredentialSummary(c oci_identity.DbCredentialSummary) *oci_identity.D
 		LifecycleState: oci_identity.DbCredentialLifecycleStateEnum(c.LifecycleState)}
 }
 
-func (s *IdentityDbCredentialResourceCrud) Delete() error {
+func (s *IdentityDbCredentialResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.DeleteDbCredentialRequest{}
 
 	tmp := s.D.Id()
@@ -213,7 +214,7 @@ func (s *IdentityDbCredentialResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteDbCredential(context.Background(), request)
+	_, err := s.Client.DeleteDbCredential(ctx, request)
 	return err
 }
 
@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -15,7 +16,7 @@ import (
 
 func IdentityDbCredentialsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityDbCredentials,
+		ReadContext: readIdentityDbCredentials,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"name": {
@@ -39,12 +40,12 @@ func IdentityDbCredentialsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityDbCredentials(d *schema.ResourceData, m interface{}) error {
+func readIdentityDbCredentials(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDbCredentialsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityDbCredentialsDataSourceCrud struct {
@@ -57,7 +58,7 @@ func (s *IdentityDbCredentialsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityDbCredentialsDataSourceCrud) Get() error {
+func (s *IdentityDbCredentialsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListDbCredentialsRequest{}
 
 	if name, ok := s.D.GetOkExists("name"); ok {
@@ -76,7 +77,7 @@ func (s *IdentityDbCredentialsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListDbCredentials(context.Background(), request)
+	response, err := s.Client.ListDbCredentials(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *IdentityDbCredentialsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbCredentials(context.Background(), request)
+		listResponse, err := s.Client.ListDbCredentials(ctx, request)
 		if err != nil {
 			return err
 		}
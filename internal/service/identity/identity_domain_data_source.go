@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -22,12 +23,12 @@ func IdentityDomainDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(IdentityDomainResource(), fieldMap, readSingularIdentityDomain)
 }
 
-func readSingularIdentityDomain(d *schema.ResourceData, m interface{}) error {
+func readSingularIdentityDomain(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDomainDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityDomainDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *IdentityDomainDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityDomainDataSourceCrud) Get() error {
+func (s *IdentityDomainDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetDomainRequest{}
 
 	if domainId, ok := s.D.GetOkExists("domain_id"); ok {
@@ -50,7 +51,7 @@ func (s *IdentityDomainDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.GetDomain(context.Background(), request)
+	response, err := s.Client.GetDomain(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -24,11 +25,11 @@ func IdentityDomainResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityDomain,
-		Read:     readIdentityDomain,
-		Update:   updateIdentityDomain,
-		Delete:   deleteIdentityDomain,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityDomain,
+		ReadContext:   readIdentityDomain,
+		UpdateContext: updateIdentityDomain,
+		DeleteContext: deleteIdentityDomain,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -169,7 +170,7 @@ func IdentityDomainResource() *schema.Resource {
 	}
 }
 
-func createIdentityDomain(d *schema.ResourceData, m interface{}) error {
+func createIdentityDomain(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDomainResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
@@ -181,28 +182,28 @@ func createIdentityDomain(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	if err := tfresource.CreateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.CreateResource(ctx, d, sync); err != nil {
+		return tfresource.HandleDiagError(err)
 	}
 
 	if powerOff {
-		if err := sync.deActivate(); err != nil {
-			return err
+		if err := sync.deActivate(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		sync.D.Set("state", oci_identity.DomainLifecycleStateInactive)
 	}
 	return nil
 }
 
-func readIdentityDomain(d *schema.ResourceData, m interface{}) error {
+func readIdentityDomain(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDomainResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateIdentityDomain(d *schema.ResourceData, m interface{}) error {
+func updateIdentityDomain(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDomainResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
@@ -219,19 +220,19 @@ func updateIdentityDomain(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if powerOn {
-		if err := sync.activate(); err != nil {
-			return err
+		if err := sync.activate(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		sync.D.Set("state", oci_identity.DomainLifecycleStateActive)
 	}
 
-	if err := tfresource.UpdateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.UpdateResource(ctx, d, sync); err != nil {
+		return tfresource.HandleDiagError(err)
 	}
 
 	if powerOff {
-		if err := sync.deActivate(); err != nil {
-			return err
+		if err := sync.deActivate(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		sync.D.Set("state", oci_identity.DomainLifecycleStateInactive)
 	}
@@ -239,13 +240,13 @@ func updateIdentityDomain(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func deleteIdentityDomain(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityDomain(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDomainResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type IdentityDomainResourceCrud struct {
@@ -281,7 +282,7 @@ func (s *IdentityDomainResourceCrud) DeletedTarget() []string {
 	return []string{}
 }
 
-func (s *IdentityDomainResourceCrud) Create() error {
+func (s *IdentityDomainResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreateDomainRequest{}
 
 	if adminEmail, ok := s.D.GetOkExists("admin_email"); ok {
@@ -358,20 +359,20 @@ func (s *IdentityDomainResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateDomain(context.Background(), request)
+	response, err := s.Client.CreateDomain(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDomainFromWorkRequest(workId, oci_identity.IamWorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getDomainFromWorkRequest(ctx, workId, oci_identity.IamWorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *IdentityDomainResourceCrud) getDomainFromWorkRequest(workId *string,
+func (s *IdentityDomainResourceCrud) getDomainFromWorkRequest(ctx context.Context, workId *string,
 	actionTypeEnum oci_identity.IamWorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	domainId, err := domainWaitForWorkRequest(workId, "domain",
+	domainId, err := domainWaitForWorkRequest(ctx, workId, "domain",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -379,7 +380,7 @@ func (s *IdentityDomainResourceCrud) getDomainFromWorkRequest(workId *string,
 	}
 	s.D.SetId(*domainId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func domainWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -405,7 +406,7 @@ func domainWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_c
 	}
 }
 
-func domainWaitForWorkRequest(wId *string, entityType string, action oci_identity.IamWorkRequestResourceActionTypeEnum,
+func domainWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_identity.IamWorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_identity.IdentityClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "identity")
 	retryPolicy.ShouldRetryOperation = domainWorkRequestShouldRetryFunc(timeout)
@@ -424,7 +425,7 @@ func domainWaitForWorkRequest(wId *string, entityType string, action oci_identit
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetIamWorkRequest(context.Background(),
+			response, err = client.GetIamWorkRequest(ctx,
 				oci_identity.GetIamWorkRequestRequest{
 					IamWorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -452,15 +453,15 @@ func domainWaitForWorkRequest(wId *string, entityType string, action oci_identit
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_identity.IamWorkRequestStatusFailed || response.Status == oci_identity.IamWorkRequestStatusCanceled {
-		return nil, getErrorFromIdentityDomainWorkRequest(client, wId, retryPolicy, response.OperationType)
+		return nil, getErrorFromIdentityDomainWorkRequest(ctx, client, wId, retryPolicy, response.OperationType)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromIdentityDomainWorkRequest(client *oci_identity.IdentityClient, workId *string, retryPolicy *oci_common.RetryPolicy, operationType oci_identity.IamWorkRequestOperationTypeEnum) error {
+func getErrorFromIdentityDomainWorkRequest(ctx context.Context, client *oci_identity.IdentityClient, workId *string, retryPolicy *oci_common.RetryPolicy, operationType oci_identity.IamWorkRequestOperationTypeEnum) error {
 
-	errorMessage, err := getErrorMessageFromIdentityDomainWorkRequest(client, workId, retryPolicy)
+	errorMessage, err := getErrorMessageFromIdentityDomainWorkRequest(ctx, client, workId, retryPolicy)
 	if err != nil {
 		return err
 	}
@@ -468,9 +469,9 @@ func getErrorFromIdentityDomainWorkRequest(client *oci_identity.IdentityClient,
 	return workRequestErr
 }
 
-func getErrorMessageFromIdentityDomainWorkRequest(client *oci_identity.IdentityClient, workId *string, retryPolicy *oci_common.RetryPolicy) (string, error) {
+func getErrorMessageFromIdentityDomainWorkRequest(ctx context.Context, client *oci_identity.IdentityClient, workId *string, retryPolicy *oci_common.RetryPolicy) (string, error) {
 	errorMessage := ""
-	response, err := client.ListIamWorkRequestErrors(context.Background(),
+	response, err := client.ListIamWorkRequestErrors(ctx,
 		oci_identity.ListIamWorkRequestErrorsRequest{
 			IamWorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -491,7 +492,7 @@ func getErrorMessageFromIdentityDomainWorkRequest(client *oci_identity.IdentityC
 	return errorMessage, nil
 }
 
-func (s *IdentityDomainResourceCrud) Get() error {
+func (s *IdentityDomainResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetDomainRequest{}
 
 	tmp := s.D.Id()
@@ -499,7 +500,7 @@ func (s *IdentityDomainResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetDomain(context.Background(), request)
+	response, err := s.Client.GetDomain(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -508,11 +509,11 @@ func (s *IdentityDomainResourceCrud) Get() error {
 	return nil
 }
 
-func (s *IdentityDomainResourceCrud) Update() error {
+func (s *IdentityDomainResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -522,7 +523,7 @@ func (s *IdentityDomainResourceCrud) Update() error {
 	if licenseType, ok := s.D.GetOkExists("license_type"); ok && s.D.HasChange("license_type") {
 		oldRaw, newRaw := s.D.GetChange("license_type")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateLicenseType(licenseType)
+			err := s.updateLicenseType(ctx, licenseType)
 			if err != nil {
 				return err
 			}
@@ -563,16 +564,16 @@ func (s *IdentityDomainResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateDomain(context.Background(), request)
+	response, err := s.Client.UpdateDomain(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDomainFromWorkRequest(workId, oci_identity.IamWorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getDomainFromWorkRequest(ctx, workId, oci_identity.IamWorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *IdentityDomainResourceCrud) Delete() error {
+func (s *IdentityDomainResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.DeleteDomainRequest{}
 
 	tmp := s.D.Id()
@@ -580,14 +581,14 @@ func (s *IdentityDomainResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.DeleteDomain(context.Background(), request)
+	response, err := s.Client.DeleteDomain(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := domainWaitForWorkRequest(workId, "domain",
+	_, delWorkRequestErr := domainWaitForWorkRequest(ctx, workId, "domain",
 		oci_identity.IamWorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -666,7 +667,7 @@ func ReplicatedRegionDetailsToMap(obj oci_identity.ReplicatedRegionDetails) map[
 	return result
 }
 
-func (s *IdentityDomainResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *IdentityDomainResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_identity.ChangeDomainCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -677,16 +678,16 @@ func (s *IdentityDomainResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ChangeDomainCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeDomainCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDomainFromWorkRequest(workId, oci_identity.IamWorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getDomainFromWorkRequest(ctx, workId, oci_identity.IamWorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *IdentityDomainResourceCrud) updateLicenseType(licenseType interface{}) error {
+func (s *IdentityDomainResourceCrud) updateLicenseType(ctx context.Context, licenseType interface{}) error {
 	changeDomainLicenseTypeRequest := oci_identity.ChangeDomainLicenseTypeRequest{}
 
 	licenseTypeTmp := licenseType.(string)
@@ -697,16 +698,16 @@ func (s *IdentityDomainResourceCrud) updateLicenseType(licenseType interface{})
 
 	changeDomainLicenseTypeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ChangeDomainLicenseType(context.Background(), changeDomainLicenseTypeRequest)
+	response, err := s.Client.ChangeDomainLicenseType(ctx, changeDomainLicenseTypeRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDomainFromWorkRequest(workId, oci_identity.IamWorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getDomainFromWorkRequest(ctx, workId, oci_identity.IamWorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *IdentityDomainResourceCrud) activate() error {
+func (s *IdentityDomainResourceCrud) activate(ctx context.Context) error {
 	activateDomainRequest := oci_identity.ActivateDomainRequest{}
 
 	idTmp := s.D.Id()
@@ -714,16 +715,16 @@ func (s *IdentityDomainResourceCrud) activate() error {
 
 	activateDomainRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ActivateDomain(context.Background(), activateDomainRequest)
+	response, err := s.Client.ActivateDomain(ctx, activateDomainRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDomainFromWorkRequest(workId, oci_identity.IamWorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getDomainFromWorkRequest(ctx, workId, oci_identity.IamWorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *IdentityDomainResourceCrud) deActivate() error {
+func (s *IdentityDomainResourceCrud) deActivate(ctx context.Context) error {
 	deactivateDomainRequest := oci_identity.DeactivateDomainRequest{}
 
 	idTmp := s.D.Id()
@@ -731,11 +732,11 @@ func (s *IdentityDomainResourceCrud) deActivate() error {
 
 	deactivateDomainRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.DeactivateDomain(context.Background(), deactivateDomainRequest)
+	response, err := s.Client.DeactivateDomain(ctx, deactivateDomainRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDomainFromWorkRequest(workId, oci_identity.IamWorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getDomainFromWorkRequest(ctx, workId, oci_identity.IamWorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
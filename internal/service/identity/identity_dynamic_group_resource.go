@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -20,11 +21,11 @@ func IdentityDynamicGroupResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityDynamicGroup,
-		Read:     readIdentityDynamicGroup,
-		Update:   updateIdentityDynamicGroup,
-		Delete:   deleteIdentityDynamicGroup,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityDynamicGroup,
+		ReadContext:   readIdentityDynamicGroup,
+		UpdateContext: updateIdentityDynamicGroup,
+		DeleteContext: deleteIdentityDynamicGroup,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -78,37 +79,37 @@ func IdentityDynamicGroupResource() *schema.Resource {
 	}
 }
 
-func createIdentityDynamicGroup(d *schema.ResourceData, m interface{}) error {
+func createIdentityDynamicGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDynamicGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readIdentityDynamicGroup(d *schema.ResourceData, m interface{}) error {
+func readIdentityDynamicGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDynamicGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateIdentityDynamicGroup(d *schema.ResourceData, m interface{}) error {
+func updateIdentityDynamicGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDynamicGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteIdentityDynamicGroup(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityDynamicGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDynamicGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type IdentityDynamicGroupResourceCrud struct {
@@ -146,7 +147,7 @@ func (s *IdentityDynamicGroupResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentityDynamicGroupResourceCrud) Create() error {
+func (s *IdentityDynamicGroupResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreateDynamicGroupRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -183,7 +184,7 @@ func (s *IdentityDynamicGroupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateDynamicGroup(context.Background(), request)
+	response, err := s.Client.CreateDynamicGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -192,7 +193,7 @@ func (s *IdentityDynamicGroupResourceCrud) Create() error {
 	return nil
 }
 
-func (s *IdentityDynamicGroupResourceCrud) Get() error {
+func (s *IdentityDynamicGroupResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetDynamicGroupRequest{}
 
 	tmp := s.D.Id()
@@ -200,7 +201,7 @@ func (s *IdentityDynamicGroupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetDynamicGroup(context.Background(), request)
+	response, err := s.Client.GetDynamicGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -209,7 +210,7 @@ func (s *IdentityDynamicGroupResourceCrud) Get() error {
 	return nil
 }
 
-func (s *IdentityDynamicGroupResourceCrud) Update() error {
+func (s *IdentityDynamicGroupResourceCrud) Update(ctx context.Context) error {
 	request := oci_identity.UpdateDynamicGroupRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -239,7 +240,7 @@ func (s *IdentityDynamicGroupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateDynamicGroup(context.Background(), request)
+	response, err := s.Client.UpdateDynamicGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -248,7 +249,7 @@ func (s *IdentityDynamicGroupResourceCrud) Update() error {
 	return nil
 }
 
-func (s *IdentityDynamicGroupResourceCrud) Delete() error {
+func (s *IdentityDynamicGroupResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.DeleteDynamicGroupRequest{}
 
 	tmp := s.D.Id()
@@ -256,7 +257,7 @@ func (s *IdentityDynamicGroupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteDynamicGroup(context.Background(), request)
+	_, err := s.Client.DeleteDynamicGroup(ctx, request)
 	return err
 }
 
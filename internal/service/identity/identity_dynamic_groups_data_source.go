@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -16,7 +17,7 @@ import (
 
 func IdentityDynamicGroupsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityDynamicGroups,
+		ReadContext: readIdentityDynamicGroups,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -40,12 +41,12 @@ func IdentityDynamicGroupsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityDynamicGroups(d *schema.ResourceData, m interface{}) error {
+func readIdentityDynamicGroups(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDynamicGroupsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityDynamicGroupsDataSourceCrud struct {
@@ -58,7 +59,7 @@ func (s *IdentityDynamicGroupsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityDynamicGroupsDataSourceCrud) Get() error {
+func (s *IdentityDynamicGroupsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListDynamicGroupsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -77,7 +78,7 @@ func (s *IdentityDynamicGroupsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListDynamicGroups(context.Background(), request)
+	response, err := s.Client.ListDynamicGroups(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *IdentityDynamicGroupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDynamicGroups(context.Background(), request)
+		listResponse, err := s.Client.ListDynamicGroups(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -93,7 +93,7 @@ func findIdentityTags(ctx *tf_export.ResourceDiscoveryContext, tfMeta *tf_export
 		d := tagResource.TestResourceData()
 		d.SetId(GetIdentityTagCompositeId(*tag.Name, parent.Id))
 
-		if err := tagResource.Read(d, ctx.Clients); err != nil {
+		if err := tfresource.ReadSchemaResource(tagResource, d, ctx.Clients); err != nil {
 			rdError := &tf_export.ResourceDiscoveryError{ResourceType: tfMeta.ResourceClass, ParentResource: parent.TerraformName, Error: err, ResourceGraph: resourceGraph}
 			ctx.AddErrorToList(rdError)
 			continue
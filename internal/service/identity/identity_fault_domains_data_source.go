@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -15,7 +16,7 @@ import (
 
 func IdentityFaultDomainsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityFaultDomains,
+		ReadContext: readIdentityFaultDomains,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -59,12 +60,12 @@ func IdentityFaultDomainsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityFaultDomains(d *schema.ResourceData, m interface{}) error {
+func readIdentityFaultDomains(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityFaultDomainsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityFaultDomainsDataSourceCrud struct {
@@ -77,7 +78,7 @@ func (s *IdentityFaultDomainsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityFaultDomainsDataSourceCrud) Get() error {
+func (s *IdentityFaultDomainsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListFaultDomainsRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -92,7 +93,7 @@ func (s *IdentityFaultDomainsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListFaultDomains(context.Background(), request)
+	response, err := s.Client.ListFaultDomains(ctx, request)
 	if err != nil {
 		return err
 	}
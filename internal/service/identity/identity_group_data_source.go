@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -23,12 +24,12 @@ func IdentityGroupDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(IdentityGroupResource(), fieldMap, readSingularIdentityGroup)
 }
 
-func readSingularIdentityGroup(d *schema.ResourceData, m interface{}) error {
+func readSingularIdentityGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityGroupDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityGroupDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *IdentityGroupDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityGroupDataSourceCrud) Get() error {
+func (s *IdentityGroupDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetGroupRequest{}
 
 	if groupId, ok := s.D.GetOkExists("group_id"); ok {
@@ -51,7 +52,7 @@ func (s *IdentityGroupDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.GetGroup(context.Background(), request)
+	response, err := s.Client.GetGroup(ctx, request)
 	if err != nil {
 		return err
 	}
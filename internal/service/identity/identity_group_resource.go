@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -24,11 +25,11 @@ func IdentityGroupResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityGroup,
-		Read:     readIdentityGroup,
-		Update:   updateIdentityGroup,
-		Delete:   deleteIdentityGroup,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityGroup,
+		ReadContext:   readIdentityGroup,
+		UpdateContext: updateIdentityGroup,
+		DeleteContext: deleteIdentityGroup,
 		Schema: map[string]*schema.Schema{
 			// The legacy provider exposed this as read-only/computed. The API requires this param. For legacy users who are
 			// not supplying a value, make it optional, behind the scenes it will use the tenancy ocid if not supplied.
@@ -82,38 +83,38 @@ func IdentityGroupResource() *schema.Resource {
 	}
 }
 
-func createIdentityGroup(d *schema.ResourceData, m interface{}) error {
+func createIdentityGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.Configuration = m.(*client.OracleClients).Configuration
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readIdentityGroup(d *schema.ResourceData, m interface{}) error {
+func readIdentityGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateIdentityGroup(d *schema.ResourceData, m interface{}) error {
+func updateIdentityGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteIdentityGroup(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type IdentityGroupResourceCrud struct {
@@ -152,7 +153,7 @@ func (s *IdentityGroupResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentityGroupResourceCrud) Create() error {
+func (s *IdentityGroupResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreateGroupRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -199,7 +200,7 @@ func (s *IdentityGroupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateGroup(context.Background(), request)
+	response, err := s.Client.CreateGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -208,7 +209,7 @@ func (s *IdentityGroupResourceCrud) Create() error {
 	return nil
 }
 
-func (s *IdentityGroupResourceCrud) Get() error {
+func (s *IdentityGroupResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetGroupRequest{}
 
 	tmp := s.D.Id()
@@ -216,7 +217,7 @@ func (s *IdentityGroupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetGroup(context.Background(), request)
+	response, err := s.Client.GetGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -225,7 +226,7 @@ func (s *IdentityGroupResourceCrud) Get() error {
 	return nil
 }
 
-func (s *IdentityGroupResourceCrud) Update() error {
+func (s *IdentityGroupResourceCrud) Update(ctx context.Context) error {
 	request := oci_identity.UpdateGroupRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -250,7 +251,7 @@ func (s *IdentityGroupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateGroup(context.Background(), request)
+	response, err := s.Client.UpdateGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -259,7 +260,7 @@ func (s *IdentityGroupResourceCrud) Update() error {
 	return nil
 }
 
-func (s *IdentityGroupResourceCrud) Delete() error {
+func (s *IdentityGroupResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.DeleteGroupRequest{}
 
 	tmp := s.D.Id()
@@ -267,7 +268,7 @@ func (s *IdentityGroupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteGroup(context.Background(), request)
+	_, err := s.Client.DeleteGroup(ctx, request)
 	return err
 }
 
@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -15,7 +16,7 @@ import (
 
 func IdentityIamWorkRequestDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularIdentityIamWorkRequest,
+		ReadContext: readSingularIdentityIamWorkRequest,
 		Schema: map[string]*schema.Schema{
 			"iam_work_request_id": {
 				Type:     schema.TypeString,
@@ -83,12 +84,12 @@ func IdentityIamWorkRequestDataSource() *schema.Resource {
 	}
 }
 
-func readSingularIdentityIamWorkRequest(d *schema.ResourceData, m interface{}) error {
+func readSingularIdentityIamWorkRequest(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityIamWorkRequestDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityIamWorkRequestDataSourceCrud struct {
@@ -101,7 +102,7 @@ func (s *IdentityIamWorkRequestDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityIamWorkRequestDataSourceCrud) Get() error {
+func (s *IdentityIamWorkRequestDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetIamWorkRequestRequest{}
 
 	if iamWorkRequestId, ok := s.D.GetOkExists("iam_work_request_id"); ok {
@@ -111,7 +112,7 @@ func (s *IdentityIamWorkRequestDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.GetIamWorkRequest(context.Background(), request)
+	response, err := s.Client.GetIamWorkRequest(ctx, request)
 	if err != nil {
 		return err
 	}
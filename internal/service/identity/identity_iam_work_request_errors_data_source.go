@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -15,7 +16,7 @@ import (
 
 func IdentityIamWorkRequestErrorsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityIamWorkRequestErrors,
+		ReadContext: readIdentityIamWorkRequestErrors,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"iam_work_request_id": {
@@ -51,12 +52,12 @@ func IdentityIamWorkRequestErrorsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityIamWorkRequestErrors(d *schema.ResourceData, m interface{}) error {
+func readIdentityIamWorkRequestErrors(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityIamWorkRequestErrorsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityIamWorkRequestErrorsDataSourceCrud struct {
@@ -69,7 +70,7 @@ func (s *IdentityIamWorkRequestErrorsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityIamWorkRequestErrorsDataSourceCrud) Get() error {
+func (s *IdentityIamWorkRequestErrorsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListIamWorkRequestErrorsRequest{}
 
 	if iamWorkRequestId, ok := s.D.GetOkExists("iam_work_request_id"); ok {
@@ -79,7 +80,7 @@ func (s *IdentityIamWorkRequestErrorsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListIamWorkRequestErrors(context.Background(), request)
+	response, err := s.Client.ListIamWorkRequestErrors(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -88,7 +89,7 @@ func (s *IdentityIamWorkRequestErrorsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIamWorkRequestErrors(context.Background(), request)
+		listResponse, err := s.Client.ListIamWorkRequestErrors(ctx, request)
 		if err != nil {
 			return err
 		}
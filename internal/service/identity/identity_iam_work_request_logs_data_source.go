@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -15,7 +16,7 @@ import (
 
 func IdentityIamWorkRequestLogsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityIamWorkRequestLogs,
+		ReadContext: readIdentityIamWorkRequestLogs,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"iam_work_request_id": {
@@ -47,12 +48,12 @@ func IdentityIamWorkRequestLogsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityIamWorkRequestLogs(d *schema.ResourceData, m interface{}) error {
+func readIdentityIamWorkRequestLogs(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityIamWorkRequestLogsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityIamWorkRequestLogsDataSourceCrud struct {
@@ -65,7 +66,7 @@ func (s *IdentityIamWorkRequestLogsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityIamWorkRequestLogsDataSourceCrud) Get() error {
+func (s *IdentityIamWorkRequestLogsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListIamWorkRequestLogsRequest{}
 
 	if iamWorkRequestId, ok := s.D.GetOkExists("iam_work_request_id"); ok {
@@ -75,7 +76,7 @@ func (s *IdentityIamWorkRequestLogsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListIamWorkRequestLogs(context.Background(), request)
+	response, err := s.Client.ListIamWorkRequestLogs(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -84,7 +85,7 @@ func (s *IdentityIamWorkRequestLogsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIamWorkRequestLogs(context.Background(), request)
+		listResponse, err := s.Client.ListIamWorkRequestLogs(ctx, request)
 		if err != nil {
 			return err
 		}
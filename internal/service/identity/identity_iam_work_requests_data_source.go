@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -15,7 +16,7 @@ import (
 
 func IdentityIamWorkRequestsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityIamWorkRequests,
+		ReadContext: readIdentityIamWorkRequests,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -104,12 +105,12 @@ func IdentityIamWorkRequestsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityIamWorkRequests(d *schema.ResourceData, m interface{}) error {
+func readIdentityIamWorkRequests(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityIamWorkRequestsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityIamWorkRequestsDataSourceCrud struct {
@@ -122,7 +123,7 @@ func (s *IdentityIamWorkRequestsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityIamWorkRequestsDataSourceCrud) Get() error {
+func (s *IdentityIamWorkRequestsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListIamWorkRequestsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -137,7 +138,7 @@ func (s *IdentityIamWorkRequestsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListIamWorkRequests(context.Background(), request)
+	response, err := s.Client.ListIamWorkRequests(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -146,7 +147,7 @@ func (s *IdentityIamWorkRequestsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIamWorkRequests(context.Background(), request)
+		listResponse, err := s.Client.ListIamWorkRequests(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -15,7 +16,7 @@ import (
 
 func IdentityIdentityProviderGroupsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityIdentityProviderGroups,
+		ReadContext: readIdentityIdentityProviderGroups,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"identity_provider_id": {
@@ -75,12 +76,12 @@ func IdentityIdentityProviderGroupsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityIdentityProviderGroups(d *schema.ResourceData, m interface{}) error {
+func readIdentityIdentityProviderGroups(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityIdentityProviderGroupsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityIdentityProviderGroupsDataSourceCrud struct {
@@ -93,7 +94,7 @@ func (s *IdentityIdentityProviderGroupsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityIdentityProviderGroupsDataSourceCrud) Get() error {
+func (s *IdentityIdentityProviderGroupsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListIdentityProviderGroupsRequest{}
 
 	if identityProviderId, ok := s.D.GetOkExists("identity_provider_id"); ok {
@@ -112,7 +113,7 @@ func (s *IdentityIdentityProviderGroupsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListIdentityProviderGroups(context.Background(), request)
+	response, err := s.Client.ListIdentityProviderGroups(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -121,7 +122,7 @@ func (s *IdentityIdentityProviderGroupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIdentityProviderGroups(context.Background(), request)
+		listResponse, err := s.Client.ListIdentityProviderGroups(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -24,11 +25,11 @@ func IdentityIdentityProviderResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityIdentityProvider,
-		Read:     readIdentityIdentityProvider,
-		Update:   updateIdentityIdentityProvider,
-		Delete:   deleteIdentityIdentityProvider,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityIdentityProvider,
+		ReadContext:   readIdentityIdentityProvider,
+		UpdateContext: updateIdentityIdentityProvider,
+		DeleteContext: deleteIdentityIdentityProvider,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -113,37 +114,37 @@ func IdentityIdentityProviderResource() *schema.Resource {
 	}
 }
 
-func createIdentityIdentityProvider(d *schema.ResourceData, m interface{}) error {
+func createIdentityIdentityProvider(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityIdentityProviderResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readIdentityIdentityProvider(d *schema.ResourceData, m interface{}) error {
+func readIdentityIdentityProvider(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityIdentityProviderResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateIdentityIdentityProvider(d *schema.ResourceData, m interface{}) error {
+func updateIdentityIdentityProvider(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityIdentityProviderResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteIdentityIdentityProvider(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityIdentityProvider(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityIdentityProviderResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type IdentityIdentityProviderResourceCrud struct {
@@ -182,7 +183,7 @@ func (s *IdentityIdentityProviderResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentityIdentityProviderResourceCrud) Create() error {
+func (s *IdentityIdentityProviderResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreateIdentityProviderRequest{}
 	err := s.populateTopLevelPolymorphicCreateIdentityProviderRequest(&request)
 	if err != nil {
@@ -191,7 +192,7 @@ func (s *IdentityIdentityProviderResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateIdentityProvider(context.Background(), request)
+	response, err := s.Client.CreateIdentityProvider(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -200,7 +201,7 @@ func (s *IdentityIdentityProviderResourceCrud) Create() error {
 	return nil
 }
 
-func (s *IdentityIdentityProviderResourceCrud) Get() error {
+func (s *IdentityIdentityProviderResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetIdentityProviderRequest{}
 
 	tmp := s.D.Id()
@@ -208,7 +209,7 @@ func (s *IdentityIdentityProviderResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetIdentityProvider(context.Background(), request)
+	response, err := s.Client.GetIdentityProvider(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -217,7 +218,7 @@ func (s *IdentityIdentityProviderResourceCrud) Get() error {
 	return nil
 }
 
-func (s *IdentityIdentityProviderResourceCrud) Update() error {
+func (s *IdentityIdentityProviderResourceCrud) Update(ctx context.Context) error {
 	request := oci_identity.UpdateIdentityProviderRequest{}
 	err := s.populateTopLevelPolymorphicUpdateIdentityProviderRequest(&request)
 	if err != nil {
@@ -226,7 +227,7 @@ func (s *IdentityIdentityProviderResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateIdentityProvider(context.Background(), request)
+	response, err := s.Client.UpdateIdentityProvider(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -235,7 +236,7 @@ func (s *IdentityIdentityProviderResourceCrud) Update() error {
 	return nil
 }
 
-func (s *IdentityIdentityProviderResourceCrud) Delete() error {
+func (s *IdentityIdentityProviderResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.DeleteIdentityProviderRequest{}
 
 	tmp := s.D.Id()
@@ -243,7 +244,7 @@ func (s *IdentityIdentityProviderResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteIdentityProvider(context.Background(), request)
+	_, err := s.Client.DeleteIdentityProvider(ctx, request)
 	return err
 }
 
@@ -8,6 +8,7 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -17,7 +18,7 @@ import (
 
 func IdentityIdentityProvidersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityIdentityProviders,
+		ReadContext: readIdentityIdentityProviders,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -45,12 +46,12 @@ func IdentityIdentityProvidersDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityIdentityProviders(d *schema.ResourceData, m interface{}) error {
+func readIdentityIdentityProviders(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityIdentityProvidersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityIdentityProvidersDataSourceCrud struct {
@@ -63,7 +64,7 @@ func (s *IdentityIdentityProvidersDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityIdentityProvidersDataSourceCrud) Get() error {
+func (s *IdentityIdentityProvidersDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListIdentityProvidersRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -86,7 +87,7 @@ func (s *IdentityIdentityProvidersDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListIdentityProviders(context.Background(), request)
+	response, err := s.Client.ListIdentityProviders(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +96,7 @@ func (s *IdentityIdentityProvidersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIdentityProviders(context.Background(), request)
+		listResponse, err := s.Client.ListIdentityProviders(ctx, request)
 		if err != nil {
 			return err
 		}
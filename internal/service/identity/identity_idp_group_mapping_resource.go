@@ -12,6 +12,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -25,11 +26,11 @@ func IdentityIdpGroupMappingResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityIdpGroupMapping,
-		Read:     readIdentityIdpGroupMapping,
-		Update:   updateIdentityIdpGroupMapping,
-		Delete:   deleteIdentityIdpGroupMapping,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityIdpGroupMapping,
+		ReadContext:   readIdentityIdpGroupMapping,
+		UpdateContext: updateIdentityIdpGroupMapping,
+		DeleteContext: deleteIdentityIdpGroupMapping,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"group_id": {
@@ -69,37 +70,37 @@ func IdentityIdpGroupMappingResource() *schema.Resource {
 	}
 }
 
-func createIdentityIdpGroupMapping(d *schema.ResourceData, m interface{}) error {
+func createIdentityIdpGroupMapping(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityIdpGroupMappingResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readIdentityIdpGroupMapping(d *schema.ResourceData, m interface{}) error {
+func readIdentityIdpGroupMapping(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityIdpGroupMappingResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateIdentityIdpGroupMapping(d *schema.ResourceData, m interface{}) error {
+func updateIdentityIdpGroupMapping(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityIdpGroupMappingResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteIdentityIdpGroupMapping(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityIdpGroupMapping(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityIdpGroupMappingResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type IdentityIdpGroupMappingResourceCrud struct {
@@ -137,7 +138,7 @@ func (s *IdentityIdpGroupMappingResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentityIdpGroupMappingResourceCrud) Create() error {
+func (s *IdentityIdpGroupMappingResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreateIdpGroupMappingRequest{}
 
 	if groupId, ok := s.D.GetOkExists("group_id"); ok {
@@ -157,7 +158,7 @@ func (s *IdentityIdpGroupMappingResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateIdpGroupMapping(context.Background(), request)
+	response, err := s.Client.CreateIdpGroupMapping(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -166,7 +167,7 @@ func (s *IdentityIdpGroupMappingResourceCrud) Create() error {
 	return nil
 }
 
-func (s *IdentityIdpGroupMappingResourceCrud) Get() error {
+func (s *IdentityIdpGroupMappingResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetIdpGroupMappingRequest{}
 
 	if identityProviderId, ok := s.D.GetOkExists("identity_provider_id"); ok {
@@ -187,7 +188,7 @@ func (s *IdentityIdpGroupMappingResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetIdpGroupMapping(context.Background(), request)
+	response, err := s.Client.GetIdpGroupMapping(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -196,7 +197,7 @@ func (s *IdentityIdpGroupMappingResourceCrud) Get() error {
 	return nil
 }
 
-func (s *IdentityIdpGroupMappingResourceCrud) Update() error {
+func (s *IdentityIdpGroupMappingResourceCrud) Update(ctx context.Context) error {
 	request := oci_identity.UpdateIdpGroupMappingRequest{}
 
 	if groupId, ok := s.D.GetOkExists("group_id"); ok {
@@ -219,7 +220,7 @@ func (s *IdentityIdpGroupMappingResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateIdpGroupMapping(context.Background(), request)
+	response, err := s.Client.UpdateIdpGroupMapping(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -228,7 +229,7 @@ func (s *IdentityIdpGroupMappingResourceCrud) Update() error {
 	return nil
 }
 
-func (s *IdentityIdpGroupMappingResourceCrud) Delete() error {
+func (s *IdentityIdpGroupMappingResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.DeleteIdpGroupMappingRequest{}
 
 	if identityProviderId, ok := s.D.GetOkExists("identity_provider_id"); ok {
@@ -241,7 +242,7 @@ func (s *IdentityIdpGroupMappingResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteIdpGroupMapping(context.Background(), request)
+	_, err := s.Client.DeleteIdpGroupMapping(ctx, request)
 	return err
 }
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -16,7 +17,7 @@ import (
 
 func IdentityIdpGroupMappingsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityIdpGroupMappings,
+		ReadContext: readIdentityIdpGroupMappings,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"identity_provider_id": {
@@ -32,12 +33,12 @@ func IdentityIdpGroupMappingsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityIdpGroupMappings(d *schema.ResourceData, m interface{}) error {
+func readIdentityIdpGroupMappings(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityIdpGroupMappingsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityIdpGroupMappingsDataSourceCrud struct {
@@ -50,7 +51,7 @@ func (s *IdentityIdpGroupMappingsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityIdpGroupMappingsDataSourceCrud) Get() error {
+func (s *IdentityIdpGroupMappingsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListIdpGroupMappingsRequest{}
 
 	if identityProviderId, ok := s.D.GetOkExists("identity_provider_id"); ok {
@@ -60,7 +61,7 @@ func (s *IdentityIdpGroupMappingsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListIdpGroupMappings(context.Background(), request)
+	response, err := s.Client.ListIdpGroupMappings(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -69,7 +70,7 @@ func (s *IdentityIdpGroupMappingsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIdpGroupMappings(context.Background(), request)
+		listResponse, err := s.Client.ListIdpGroupMappings(ctx, request)
 		if err != nil {
 			return err
 		}
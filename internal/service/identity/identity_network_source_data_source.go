@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -23,12 +24,12 @@ func IdentityNetworkSourceDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(IdentityNetworkSourceResource(), fieldMap, readSingularIdentityNetworkSource)
 }
 
-func readSingularIdentityNetworkSource(d *schema.ResourceData, m interface{}) error {
+func readSingularIdentityNetworkSource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityNetworkSourceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityNetworkSourceDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *IdentityNetworkSourceDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityNetworkSourceDataSourceCrud) Get() error {
+func (s *IdentityNetworkSourceDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetNetworkSourceRequest{}
 
 	if networkSourceId, ok := s.D.GetOkExists("network_source_id"); ok {
@@ -51,7 +52,7 @@ func (s *IdentityNetworkSourceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.GetNetworkSource(context.Background(), request)
+	response, err := s.Client.GetNetworkSource(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -21,11 +22,11 @@ func IdentityNetworkSourceResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityNetworkSource,
-		Read:     readIdentityNetworkSource,
-		Update:   updateIdentityNetworkSource,
-		Delete:   deleteIdentityNetworkSource,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityNetworkSource,
+		ReadContext:   readIdentityNetworkSource,
+		UpdateContext: updateIdentityNetworkSource,
+		DeleteContext: deleteIdentityNetworkSource,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -113,37 +114,37 @@ func IdentityNetworkSourceResource() *schema.Resource {
 	}
 }
 
-func createIdentityNetworkSource(d *schema.ResourceData, m interface{}) error {
+func createIdentityNetworkSource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityNetworkSourceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readIdentityNetworkSource(d *schema.ResourceData, m interface{}) error {
+func readIdentityNetworkSource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityNetworkSourceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateIdentityNetworkSource(d *schema.ResourceData, m interface{}) error {
+func updateIdentityNetworkSource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityNetworkSourceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteIdentityNetworkSource(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityNetworkSource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityNetworkSourceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type IdentityNetworkSourceResourceCrud struct {
@@ -181,7 +182,7 @@ func (s *IdentityNetworkSourceResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentityNetworkSourceResourceCrud) Create() error {
+func (s *IdentityNetworkSourceResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreateNetworkSourceRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -256,7 +257,7 @@ func (s *IdentityNetworkSourceResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateNetworkSource(context.Background(), request)
+	response, err := s.Client.CreateNetworkSource(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -265,7 +266,7 @@ func (s *IdentityNetworkSourceResourceCrud) Create() error {
 	return nil
 }
 
-func (s *IdentityNetworkSourceResourceCrud) Get() error {
+func (s *IdentityNetworkSourceResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetNetworkSourceRequest{}
 
 	tmp := s.D.Id()
@@ -273,7 +274,7 @@ func (s *IdentityNetworkSourceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetNetworkSource(context.Background(), request)
+	response, err := s.Client.GetNetworkSource(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -282,7 +283,7 @@ func (s *IdentityNetworkSourceResourceCrud) Get() error {
 	return nil
 }
 
-func (s *IdentityNetworkSourceResourceCrud) Update() error {
+func (s *IdentityNetworkSourceResourceCrud) Update(ctx context.Context) error {
 	request := oci_identity.UpdateNetworkSourceRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -350,7 +351,7 @@ func (s *IdentityNetworkSourceResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateNetworkSource(context.Background(), request)
+	response, err := s.Client.UpdateNetworkSource(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -359,7 +360,7 @@ func (s *IdentityNetworkSourceResourceCrud) Update() error {
 	return nil
 }
 
-func (s *IdentityNetworkSourceResourceCrud) Delete() error {
+func (s *IdentityNetworkSourceResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.DeleteNetworkSourceRequest{}
 
 	tmp := s.D.Id()
@@ -367,7 +368,7 @@ func (s *IdentityNetworkSourceResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteNetworkSource(context.Background(), request)
+	_, err := s.Client.DeleteNetworkSource(ctx, request)
 	return err
 }
 
@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -24,11 +25,11 @@ func IdentityPolicyResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityPolicy,
-		Read:     readIdentityPolicy,
-		Update:   updateIdentityPolicy,
-		Delete:   deleteIdentityPolicy,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityPolicy,
+		ReadContext:   readIdentityPolicy,
+		UpdateContext: updateIdentityPolicy,
+		DeleteContext: deleteIdentityPolicy,
 		Schema: map[string]*schema.Schema{
 			// Required
 			// The legacy provider required this and the API requires. Do not make it optional or swap tenancy OCID in behind the scenes
@@ -114,37 +115,37 @@ func IdentityPolicyResource() *schema.Resource {
 	}
 }
 
-func createIdentityPolicy(d *schema.ResourceData, m interface{}) error {
+func createIdentityPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readIdentityPolicy(d *schema.ResourceData, m interface{}) error {
+func readIdentityPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateIdentityPolicy(d *schema.ResourceData, m interface{}) error {
+func updateIdentityPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteIdentityPolicy(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type IdentityPolicyResourceCrud struct {
@@ -184,7 +185,7 @@ func (s *IdentityPolicyResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentityPolicyResourceCrud) Create() error {
+func (s *IdentityPolicyResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreatePolicyRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -237,7 +238,7 @@ func (s *IdentityPolicyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreatePolicy(context.Background(), request)
+	response, err := s.Client.CreatePolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -253,7 +254,7 @@ func (s *IdentityPolicyResourceCrud) Create() error {
 	return nil
 }
 
-func (s *IdentityPolicyResourceCrud) Get() error {
+func (s *IdentityPolicyResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetPolicyRequest{}
 
 	tmp := s.D.Id()
@@ -261,7 +262,7 @@ func (s *IdentityPolicyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetPolicy(context.Background(), request)
+	response, err := s.Client.GetPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -275,7 +276,7 @@ func (s *IdentityPolicyResourceCrud) Get() error {
 	return nil
 }
 
-func (s *IdentityPolicyResourceCrud) Update() error {
+func (s *IdentityPolicyResourceCrud) Update(ctx context.Context) error {
 	request := oci_identity.UpdatePolicyRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -321,7 +322,7 @@ func (s *IdentityPolicyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdatePolicy(context.Background(), request)
+	response, err := s.Client.UpdatePolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -337,7 +338,7 @@ func (s *IdentityPolicyResourceCrud) Update() error {
 	return nil
 }
 
-func (s *IdentityPolicyResourceCrud) Delete() error {
+func (s *IdentityPolicyResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.DeletePolicyRequest{}
 
 	tmp := s.D.Id()
@@ -345,7 +346,7 @@ func (s *IdentityPolicyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeletePolicy(context.Background(), request)
+	_, err := s.Client.DeletePolicy(ctx, request)
 	return err
 }
 
@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -15,7 +16,7 @@ import (
 
 func IdentityRegionSubscriptionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityRegionSubscriptions,
+		ReadContext: readIdentityRegionSubscriptions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"tenancy_id": {
@@ -59,12 +60,12 @@ func IdentityRegionSubscriptionsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityRegionSubscriptions(d *schema.ResourceData, m interface{}) error {
+func readIdentityRegionSubscriptions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityRegionSubscriptionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityRegionSubscriptionsDataSourceCrud struct {
@@ -77,7 +78,7 @@ func (s *IdentityRegionSubscriptionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityRegionSubscriptionsDataSourceCrud) Get() error {
+func (s *IdentityRegionSubscriptionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListRegionSubscriptionsRequest{}
 
 	if tenancyId, ok := s.D.GetOkExists("tenancy_id"); ok {
@@ -87,7 +88,7 @@ func (s *IdentityRegionSubscriptionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListRegionSubscriptions(context.Background(), request)
+	response, err := s.Client.ListRegionSubscriptions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -15,7 +16,7 @@ import (
 
 func IdentityRegionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityRegions,
+		ReadContext: readIdentityRegions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"regions": {
@@ -43,12 +44,12 @@ func IdentityRegionsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityRegions(d *schema.ResourceData, m interface{}) error {
+func readIdentityRegions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityRegionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityRegionsDataSourceCrud struct {
@@ -61,12 +62,12 @@ func (s *IdentityRegionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityRegionsDataSourceCrud) Get() error {
+func (s *IdentityRegionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListRegionsRequest{}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListRegions(context.Background())
+	response, err := s.Client.ListRegions(ctx)
 	if err != nil {
 		return err
 	}
@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -26,11 +27,11 @@ func IdentitySmtpCredentialResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentitySmtpCredential,
-		Read:     readIdentitySmtpCredential,
-		Update:   updateIdentitySmtpCredential,
-		Delete:   deleteIdentitySmtpCredential,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentitySmtpCredential,
+		ReadContext:   readIdentitySmtpCredential,
+		UpdateContext: updateIdentitySmtpCredential,
+		DeleteContext: deleteIdentitySmtpCredential,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"description": {
@@ -74,37 +75,37 @@ func IdentitySmtpCredentialResource() *schema.Resource {
 	}
 }
 
-func createIdentitySmtpCredential(d *schema.ResourceData, m interface{}) error {
+func createIdentitySmtpCredential(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentitySmtpCredentialResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readIdentitySmtpCredential(d *schema.ResourceData, m interface{}) error {
+func readIdentitySmtpCredential(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentitySmtpCredentialResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateIdentitySmtpCredential(d *schema.ResourceData, m interface{}) error {
+func updateIdentitySmtpCredential(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentitySmtpCredentialResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteIdentitySmtpCredential(d *schema.ResourceData, m interface{}) error {
+func deleteIdentitySmtpCredential(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentitySmtpCredentialResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type IdentitySmtpCredentialResourceCrud struct {
@@ -146,7 +147,7 @@ func (s *IdentitySmtpCredentialResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentitySmtpCredentialResourceCrud) Create() error {
+func (s *IdentitySmtpCredentialResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreateSmtpCredentialRequest{}
 
 	if description, ok := s.D.GetOkExists("description"); ok {
@@ -161,7 +162,7 @@ func (s *IdentitySmtpCredentialResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateSmtpCredential(context.Background(), request)
+	response, err := s.Client.CreateSmtpCredential(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -181,7 +182,7 @@ func fromSmtpCredentialSummary(c oci_identity.SmtpCredentialSummary) *oci_identi
 		InactiveStatus: c.InactiveStatus}
 }
 
-func (s *IdentitySmtpCredentialResourceCrud) Get() error {
+func (s *IdentitySmtpCredentialResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListSmtpCredentialsRequest{}
 
 	if userId, ok := s.D.GetOkExists("user_id"); ok {
@@ -199,7 +200,7 @@ func (s *IdentitySmtpCredentialResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ListSmtpCredentials(context.Background(), request)
+	response, err := s.Client.ListSmtpCredentials(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -215,7 +216,7 @@ func (s *IdentitySmtpCredentialResourceCrud) Get() error {
 
 }
 
-func (s *IdentitySmtpCredentialResourceCrud) Update() error {
+func (s *IdentitySmtpCredentialResourceCrud) Update(ctx context.Context) error {
 	request := oci_identity.UpdateSmtpCredentialRequest{}
 
 	if description, ok := s.D.GetOkExists("description"); ok {
@@ -233,7 +234,7 @@ func (s *IdentitySmtpCredentialResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateSmtpCredential(context.Background(), request)
+	response, err := s.Client.UpdateSmtpCredential(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -242,7 +243,7 @@ func (s *IdentitySmtpCredentialResourceCrud) Update() error {
 	return nil
 }
 
-func (s *IdentitySmtpCredentialResourceCrud) Delete() error {
+func (s *IdentitySmtpCredentialResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.DeleteSmtpCredentialRequest{}
 
 	tmp := s.D.Id()
@@ -255,7 +256,7 @@ func (s *IdentitySmtpCredentialResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteSmtpCredential(context.Background(), request)
+	_, err := s.Client.DeleteSmtpCredential(ctx, request)
 	return err
 }
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -16,7 +17,7 @@ import (
 
 func IdentitySmtpCredentialsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentitySmtpCredentials,
+		ReadContext: readIdentitySmtpCredentials,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"user_id": {
@@ -32,12 +33,12 @@ func IdentitySmtpCredentialsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentitySmtpCredentials(d *schema.ResourceData, m interface{}) error {
+func readIdentitySmtpCredentials(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentitySmtpCredentialsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentitySmtpCredentialsDataSourceCrud struct {
@@ -50,7 +51,7 @@ func (s *IdentitySmtpCredentialsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentitySmtpCredentialsDataSourceCrud) Get() error {
+func (s *IdentitySmtpCredentialsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListSmtpCredentialsRequest{}
 
 	if userId, ok := s.D.GetOkExists("user_id"); ok {
@@ -60,7 +61,7 @@ func (s *IdentitySmtpCredentialsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListSmtpCredentials(context.Background(), request)
+	response, err := s.Client.ListSmtpCredentials(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -26,11 +27,11 @@ func IdentitySwiftPasswordResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentitySwiftPassword,
-		Read:     readIdentitySwiftPassword,
-		Update:   updateIdentitySwiftPassword,
-		Delete:   deleteIdentitySwiftPassword,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentitySwiftPassword,
+		ReadContext:   readIdentitySwiftPassword,
+		UpdateContext: updateIdentitySwiftPassword,
+		DeleteContext: deleteIdentitySwiftPassword,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"description": {
@@ -70,37 +71,37 @@ func IdentitySwiftPasswordResource() *schema.Resource {
 	}
 }
 
-func createIdentitySwiftPassword(d *schema.ResourceData, m interface{}) error {
+func createIdentitySwiftPassword(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentitySwiftPasswordResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readIdentitySwiftPassword(d *schema.ResourceData, m interface{}) error {
+func readIdentitySwiftPassword(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentitySwiftPasswordResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateIdentitySwiftPassword(d *schema.ResourceData, m interface{}) error {
+func updateIdentitySwiftPassword(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentitySwiftPasswordResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteIdentitySwiftPassword(d *schema.ResourceData, m interface{}) error {
+func deleteIdentitySwiftPassword(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentitySwiftPasswordResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type IdentitySwiftPasswordResourceCrud struct {
@@ -142,7 +143,7 @@ func (s *IdentitySwiftPasswordResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentitySwiftPasswordResourceCrud) Create() error {
+func (s *IdentitySwiftPasswordResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreateSwiftPasswordRequest{}
 
 	if description, ok := s.D.GetOkExists("description"); ok {
@@ -157,7 +158,7 @@ func (s *IdentitySwiftPasswordResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateSwiftPassword(context.Background(), request)
+	response, err := s.Client.CreateSwiftPassword(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -166,7 +167,7 @@ func (s *IdentitySwiftPasswordResourceCrud) Create() error {
 	return nil
 }
 
-func (s *IdentitySwiftPasswordResourceCrud) Get() error {
+func (s *IdentitySwiftPasswordResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListSwiftPasswordsRequest{}
 
 	if userId, ok := s.D.GetOkExists("user_id"); ok {
@@ -184,7 +185,7 @@ func (s *IdentitySwiftPasswordResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.ListSwiftPasswords(context.Background(), request)
+	response, err := s.Client.ListSwiftPasswords(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -200,7 +201,7 @@ func (s *IdentitySwiftPasswordResourceCrud) Get() error {
 
 }
 
-func (s *IdentitySwiftPasswordResourceCrud) Update() error {
+func (s *IdentitySwiftPasswordResourceCrud) Update(ctx context.Context) error {
 	request := oci_identity.UpdateSwiftPasswordRequest{}
 
 	if description, ok := s.D.GetOkExists("description"); ok {
@@ -218,7 +219,7 @@ func (s *IdentitySwiftPasswordResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateSwiftPassword(context.Background(), request)
+	response, err := s.Client.UpdateSwiftPassword(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -227,7 +228,7 @@ func (s *IdentitySwiftPasswordResourceCrud) Update() error {
 	return nil
 }
 
-func (s *IdentitySwiftPasswordResourceCrud) Delete() error {
+func (s *IdentitySwiftPasswordResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.DeleteSwiftPasswordRequest{}
 
 	tmp := s.D.Id()
@@ -240,7 +241,7 @@ func (s *IdentitySwiftPasswordResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteSwiftPassword(context.Background(), request)
+	_, err := s.Client.DeleteSwiftPassword(ctx, request)
 	return err
 }
 
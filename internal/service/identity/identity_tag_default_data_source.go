@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -22,12 +23,12 @@ func IdentityTagDefaultDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(IdentityTagDefaultResource(), fieldMap, readSingularIdentityTagDefault)
 }
 
-func readSingularIdentityTagDefault(d *schema.ResourceData, m interface{}) error {
+func readSingularIdentityTagDefault(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityTagDefaultDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityTagDefaultDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *IdentityTagDefaultDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityTagDefaultDataSourceCrud) Get() error {
+func (s *IdentityTagDefaultDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetTagDefaultRequest{}
 
 	if tagDefaultId, ok := s.D.GetOkExists("tag_default_id"); ok {
@@ -50,7 +51,7 @@ func (s *IdentityTagDefaultDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.GetTagDefault(context.Background(), request)
+	response, err := s.Client.GetTagDefault(ctx, request)
 	if err != nil {
 		return err
 	}
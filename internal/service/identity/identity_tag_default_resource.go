@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -20,11 +21,11 @@ func IdentityTagDefaultResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityTagDefault,
-		Read:     readIdentityTagDefault,
-		Update:   updateIdentityTagDefault,
-		Delete:   deleteIdentityTagDefault,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityTagDefault,
+		ReadContext:   readIdentityTagDefault,
+		UpdateContext: updateIdentityTagDefault,
+		DeleteContext: deleteIdentityTagDefault,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -70,37 +71,37 @@ func IdentityTagDefaultResource() *schema.Resource {
 	}
 }
 
-func createIdentityTagDefault(d *schema.ResourceData, m interface{}) error {
+func createIdentityTagDefault(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityTagDefaultResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readIdentityTagDefault(d *schema.ResourceData, m interface{}) error {
+func readIdentityTagDefault(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityTagDefaultResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateIdentityTagDefault(d *schema.ResourceData, m interface{}) error {
+func updateIdentityTagDefault(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityTagDefaultResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteIdentityTagDefault(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityTagDefault(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityTagDefaultResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type IdentityTagDefaultResourceCrud struct {
@@ -132,7 +133,7 @@ func (s *IdentityTagDefaultResourceCrud) DeletedTarget() []string {
 	return []string{}
 }
 
-func (s *IdentityTagDefaultResourceCrud) Create() error {
+func (s *IdentityTagDefaultResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreateTagDefaultRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -157,7 +158,7 @@ func (s *IdentityTagDefaultResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateTagDefault(context.Background(), request)
+	response, err := s.Client.CreateTagDefault(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -176,7 +177,7 @@ func (s *IdentityTagDefaultResourceCrud) Create() error {
 	return nil
 }
 
-func (s *IdentityTagDefaultResourceCrud) Get() error {
+func (s *IdentityTagDefaultResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetTagDefaultRequest{}
 
 	tmp := s.D.Id()
@@ -184,7 +185,7 @@ func (s *IdentityTagDefaultResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetTagDefault(context.Background(), request)
+	response, err := s.Client.GetTagDefault(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -193,7 +194,7 @@ func (s *IdentityTagDefaultResourceCrud) Get() error {
 	return nil
 }
 
-func (s *IdentityTagDefaultResourceCrud) Update() error {
+func (s *IdentityTagDefaultResourceCrud) Update(ctx context.Context) error {
 	request := oci_identity.UpdateTagDefaultRequest{}
 
 	if isRequired, ok := s.D.GetOkExists("is_required"); ok {
@@ -211,7 +212,7 @@ func (s *IdentityTagDefaultResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateTagDefault(context.Background(), request)
+	response, err := s.Client.UpdateTagDefault(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -228,7 +229,7 @@ func (s *IdentityTagDefaultResourceCrud) Update() error {
 	return nil
 }
 
-func (s *IdentityTagDefaultResourceCrud) Delete() error {
+func (s *IdentityTagDefaultResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.DeleteTagDefaultRequest{}
 
 	tmp := s.D.Id()
@@ -236,7 +237,7 @@ func (s *IdentityTagDefaultResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteTagDefault(context.Background(), request)
+	_, err := s.Client.DeleteTagDefault(ctx, request)
 	return err
 }
 
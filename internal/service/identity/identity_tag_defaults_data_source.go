@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -15,7 +16,7 @@ import (
 
 func IdentityTagDefaultsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityTagDefaults,
+		ReadContext: readIdentityTagDefaults,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,12 +44,12 @@ func IdentityTagDefaultsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityTagDefaults(d *schema.ResourceData, m interface{}) error {
+func readIdentityTagDefaults(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityTagDefaultsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityTagDefaultsDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *IdentityTagDefaultsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityTagDefaultsDataSourceCrud) Get() error {
+func (s *IdentityTagDefaultsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListTagDefaultsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -85,7 +86,7 @@ func (s *IdentityTagDefaultsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListTagDefaults(context.Background(), request)
+	response, err := s.Client.ListTagDefaults(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *IdentityTagDefaultsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListTagDefaults(context.Background(), request)
+		listResponse, err := s.Client.ListTagDefaults(ctx, request)
 		if err != nil {
 			return err
 		}
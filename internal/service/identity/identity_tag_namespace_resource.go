@@ -8,6 +8,7 @@ import (
 
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -25,11 +26,11 @@ func IdentityTagNamespaceResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityTagNamespace,
-		Read:     readIdentityTagNamespace,
-		Update:   updateIdentityTagNamespace,
-		Delete:   deleteIdentityTagNamespace,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityTagNamespace,
+		ReadContext:   readIdentityTagNamespace,
+		UpdateContext: updateIdentityTagNamespace,
+		DeleteContext: deleteIdentityTagNamespace,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -80,31 +81,31 @@ func IdentityTagNamespaceResource() *schema.Resource {
 	}
 }
 
-func createIdentityTagNamespace(d *schema.ResourceData, m interface{}) error {
+func createIdentityTagNamespace(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityTagNamespaceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readIdentityTagNamespace(d *schema.ResourceData, m interface{}) error {
+func readIdentityTagNamespace(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityTagNamespaceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateIdentityTagNamespace(d *schema.ResourceData, m interface{}) error {
+func updateIdentityTagNamespace(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityTagNamespaceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteIdentityTagNamespace(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityTagNamespace(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Only empty tag namespaces can be deleted, to execute our tests we don't want to delete namespaces as we Create
 	// namespaces with tags and deleting a tag is a sequential and time consuming operation allowed one per tenancy
 	importIfExists, _ := strconv.ParseBool(utils.GetEnvSettingWithDefault("tags_import_if_exists", "false"))
@@ -117,7 +118,7 @@ func deleteIdentityTagNamespace(d *schema.ResourceData, m interface{}) error {
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type IdentityTagNamespaceResourceCrud struct {
@@ -153,7 +154,7 @@ func (s *IdentityTagNamespaceResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentityTagNamespaceResourceCrud) Create() error {
+func (s *IdentityTagNamespaceResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreateTagNamespaceRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -185,13 +186,13 @@ func (s *IdentityTagNamespaceResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	contextToUse := context.Background()
+	contextToUse := ctx
 	response, err := s.Client.CreateTagNamespace(contextToUse, request)
 	if err == nil {
 		s.Res = &response.TagNamespace
 		s.D.SetId(*s.Res.Id)
 		//is_retired field is currently not supported in Create so Update to make server state same as config
-		if updateError := s.Update(); updateError != nil {
+		if updateError := s.Update(ctx); updateError != nil {
 			return updateError
 		}
 		return nil
@@ -206,7 +207,7 @@ func (s *IdentityTagNamespaceResourceCrud) Create() error {
 		s.D.Set("compartment_id", request.CompartmentId)
 		s.D.Set("name", request.Name)
 		dsCrud := &IdentityTagNamespacesDataSourceCrud{s.D, s.Client, nil}
-		if dsErr := dsCrud.Get(); dsErr != nil {
+		if dsErr := dsCrud.Get(ctx); dsErr != nil {
 			//return original error when datasource call fails
 			return err
 		}
@@ -214,8 +215,8 @@ func (s *IdentityTagNamespaceResourceCrud) Create() error {
 		for _, namespace := range dsCrud.Res.Items {
 			if strings.EqualFold(*namespace.Name, *request.Name) {
 				s.D.SetId(*namespace.Id)
-				if updateError := s.Update(); updateError != nil {
-					if getError := s.Get(); getError != nil {
+				if updateError := s.Update(ctx); updateError != nil {
+					if getError := s.Get(ctx); getError != nil {
 						//Update to tags can only be done from home region, so do get in that case
 						return getError
 					}
@@ -228,7 +229,7 @@ func (s *IdentityTagNamespaceResourceCrud) Create() error {
 	return err
 }
 
-func (s *IdentityTagNamespaceResourceCrud) Get() error {
+func (s *IdentityTagNamespaceResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetTagNamespaceRequest{}
 
 	tmp := s.D.Id()
@@ -236,7 +237,7 @@ func (s *IdentityTagNamespaceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetTagNamespace(context.Background(), request)
+	response, err := s.Client.GetTagNamespace(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -245,11 +246,11 @@ func (s *IdentityTagNamespaceResourceCrud) Get() error {
 	return nil
 }
 
-func (s *IdentityTagNamespaceResourceCrud) Update() error {
+func (s *IdentityTagNamespaceResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -284,7 +285,7 @@ func (s *IdentityTagNamespaceResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateTagNamespace(context.Background(), request)
+	response, err := s.Client.UpdateTagNamespace(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -293,7 +294,7 @@ func (s *IdentityTagNamespaceResourceCrud) Update() error {
 	return nil
 }
 
-func (s *IdentityTagNamespaceResourceCrud) Delete() error {
+func (s *IdentityTagNamespaceResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.DeleteTagNamespaceRequest{}
 
 	tmp := s.D.Id()
@@ -301,7 +302,7 @@ func (s *IdentityTagNamespaceResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteTagNamespace(context.Background(), request)
+	_, err := s.Client.DeleteTagNamespace(ctx, request)
 	return err
 }
 
@@ -337,7 +338,7 @@ func (s *IdentityTagNamespaceResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *IdentityTagNamespaceResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *IdentityTagNamespaceResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_identity.ChangeTagNamespaceCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -348,12 +349,12 @@ func (s *IdentityTagNamespaceResourceCrud) updateCompartment(compartment interfa
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.ChangeTagNamespaceCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeTagNamespaceCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
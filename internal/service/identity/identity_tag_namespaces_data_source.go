@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -15,7 +16,7 @@ import (
 
 func IdentityTagNamespacesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityTagNamespaces,
+		ReadContext: readIdentityTagNamespaces,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,12 +40,12 @@ func IdentityTagNamespacesDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityTagNamespaces(d *schema.ResourceData, m interface{}) error {
+func readIdentityTagNamespaces(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityTagNamespacesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityTagNamespacesDataSourceCrud struct {
@@ -57,7 +58,7 @@ func (s *IdentityTagNamespacesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityTagNamespacesDataSourceCrud) Get() error {
+func (s *IdentityTagNamespacesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListTagNamespacesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -76,7 +77,7 @@ func (s *IdentityTagNamespacesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListTagNamespaces(context.Background(), request)
+	response, err := s.Client.ListTagNamespaces(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *IdentityTagNamespacesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListTagNamespaces(context.Background(), request)
+		listResponse, err := s.Client.ListTagNamespaces(ctx, request)
 		if err != nil {
 			return err
 		}
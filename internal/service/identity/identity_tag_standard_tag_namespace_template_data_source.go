@@ -9,13 +9,14 @@ import (
 	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 )
 
 func IdentityTagStandardTagNamespaceTemplateDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularIdentityTagStandardTagNamespaceTemplate,
+		ReadContext: readSingularIdentityTagStandardTagNamespaceTemplate,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -78,12 +79,12 @@ func IdentityTagStandardTagNamespaceTemplateDataSource() *schema.Resource {
 	}
 }
 
-func readSingularIdentityTagStandardTagNamespaceTemplate(d *schema.ResourceData, m interface{}) error {
+func readSingularIdentityTagStandardTagNamespaceTemplate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityTagStandardTagNamespaceTemplateDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*tf_client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityTagStandardTagNamespaceTemplateDataSourceCrud struct {
@@ -96,7 +97,7 @@ func (s *IdentityTagStandardTagNamespaceTemplateDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityTagStandardTagNamespaceTemplateDataSourceCrud) Get() error {
+func (s *IdentityTagStandardTagNamespaceTemplateDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetStandardTagTemplateRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -111,7 +112,7 @@ func (s *IdentityTagStandardTagNamespaceTemplateDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.GetStandardTagTemplate(context.Background(), request)
+	response, err := s.Client.GetStandardTagTemplate(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 )
 
 func IdentityTagStandardTagNamespaceTemplatesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityTagStandardTagNamespaceTemplates,
+		ReadContext: readIdentityTagStandardTagNamespaceTemplates,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -51,12 +52,12 @@ func IdentityTagStandardTagNamespaceTemplatesDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityTagStandardTagNamespaceTemplates(d *schema.ResourceData, m interface{}) error {
+func readIdentityTagStandardTagNamespaceTemplates(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityTagStandardTagNamespaceTemplatesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*tf_client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityTagStandardTagNamespaceTemplatesDataSourceCrud struct {
@@ -69,7 +70,7 @@ func (s *IdentityTagStandardTagNamespaceTemplatesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityTagStandardTagNamespaceTemplatesDataSourceCrud) Get() error {
+func (s *IdentityTagStandardTagNamespaceTemplatesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListStandardTagNamespacesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -79,7 +80,7 @@ func (s *IdentityTagStandardTagNamespaceTemplatesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListStandardTagNamespaces(context.Background(), request)
+	response, err := s.Client.ListStandardTagNamespaces(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -88,7 +89,7 @@ func (s *IdentityTagStandardTagNamespaceTemplatesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListStandardTagNamespaces(context.Background(), request)
+		listResponse, err := s.Client.ListStandardTagNamespaces(ctx, request)
 		if err != nil {
 			return err
 		}
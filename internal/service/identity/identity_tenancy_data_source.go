@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -15,7 +16,7 @@ import (
 
 func IdentityTenancyDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularIdentityTenancy,
+		ReadContext: readSingularIdentityTenancy,
 		Schema: map[string]*schema.Schema{
 			"tenancy_id": {
 				Type:     schema.TypeString,
@@ -52,12 +53,12 @@ func IdentityTenancyDataSource() *schema.Resource {
 	}
 }
 
-func readSingularIdentityTenancy(d *schema.ResourceData, m interface{}) error {
+func readSingularIdentityTenancy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityTenancyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityTenancyDataSourceCrud struct {
@@ -70,7 +71,7 @@ func (s *IdentityTenancyDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityTenancyDataSourceCrud) Get() error {
+func (s *IdentityTenancyDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetTenancyRequest{}
 
 	if tenancyId, ok := s.D.GetOkExists("tenancy_id"); ok {
@@ -80,7 +81,7 @@ func (s *IdentityTenancyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.GetTenancy(context.Background(), request)
+	response, err := s.Client.GetTenancy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package identity
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -22,12 +23,12 @@ func IdentityUiPasswordDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(IdentityUiPasswordResource(), fieldMap, readSingularIdentityUiPassword)
 }
 
-func readSingularIdentityUiPassword(d *schema.ResourceData, m interface{}) error {
+func readSingularIdentityUiPassword(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityUiPasswordDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityUiPasswordDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *IdentityUiPasswordDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityUiPasswordDataSourceCrud) Get() error {
+func (s *IdentityUiPasswordDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetUserUIPasswordInformationRequest{}
 
 	if userId, ok := s.D.GetOkExists("user_id"); ok {
@@ -50,7 +51,7 @@ func (s *IdentityUiPasswordDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.GetUserUIPasswordInformation(context.Background(), request)
+	response, err := s.Client.GetUserUIPasswordInformation(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -17,10 +18,10 @@ import (
 
 func IdentityUiPasswordResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityUiPassword,
-		Read:     readIdentityUiPassword,
-		Delete:   deleteIdentityUiPassword,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityUiPassword,
+		ReadContext:   readIdentityUiPassword,
+		DeleteContext: deleteIdentityUiPassword,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"user_id": {
@@ -52,19 +53,19 @@ func IdentityUiPasswordResource() *schema.Resource {
 	}
 }
 
-func createIdentityUiPassword(d *schema.ResourceData, m interface{}) error {
+func createIdentityUiPassword(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityUiPasswordResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readIdentityUiPassword(d *schema.ResourceData, m interface{}) error {
+func readIdentityUiPassword(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteIdentityUiPassword(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityUiPassword(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -103,7 +104,7 @@ func (s *IdentityUiPasswordResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentityUiPasswordResourceCrud) Create() error {
+func (s *IdentityUiPasswordResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreateOrResetUIPasswordRequest{}
 
 	if userId, ok := s.D.GetOkExists("user_id"); ok {
@@ -113,7 +114,7 @@ func (s *IdentityUiPasswordResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateOrResetUIPassword(context.Background(), request)
+	response, err := s.Client.CreateOrResetUIPassword(ctx, request)
 	if err != nil {
 		return err
 	}
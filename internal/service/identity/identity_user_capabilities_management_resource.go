@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -24,11 +25,11 @@ func IdentityUserCapabilitiesManagementResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createUserCapabilitiesManagement,
-		Read:     readUserCapabilitiesManagement,
-		Update:   updateUserCapabilitiesManagement,
-		Delete:   deleteUserCapabilitiesManagement,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createUserCapabilitiesManagement,
+		ReadContext:   readUserCapabilitiesManagement,
+		UpdateContext: updateUserCapabilitiesManagement,
+		DeleteContext: deleteUserCapabilitiesManagement,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"user_id": {
@@ -68,36 +69,36 @@ func IdentityUserCapabilitiesManagementResource() *schema.Resource {
 	}
 }
 
-func createUserCapabilitiesManagement(d *schema.ResourceData, m interface{}) error {
+func createUserCapabilitiesManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &UserCapabilitiesManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.Configuration = m.(*client.OracleClients).Configuration
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readUserCapabilitiesManagement(d *schema.ResourceData, m interface{}) error {
+func readUserCapabilitiesManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &UserCapabilitiesManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateUserCapabilitiesManagement(d *schema.ResourceData, m interface{}) error {
+func updateUserCapabilitiesManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &UserCapabilitiesManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteUserCapabilitiesManagement(d *schema.ResourceData, m interface{}) error {
+func deleteUserCapabilitiesManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &UserCapabilitiesManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type UserCapabilitiesManagementResourceCrud struct {
@@ -128,7 +129,7 @@ func (s *UserCapabilitiesManagementResourceCrud) ID() string {
 	return getUserCapabilitiesCompositeId(s.D.Get("user_id").(string))
 }
 
-func (s *UserCapabilitiesManagementResourceCrud) Create() error {
+func (s *UserCapabilitiesManagementResourceCrud) Create(ctx context.Context) error {
 	userCapabilityRequest := oci_identity.UpdateUserCapabilitiesRequest{}
 
 	if userId, ok := s.D.GetOkExists("user_id"); ok {
@@ -161,7 +162,7 @@ func (s *UserCapabilitiesManagementResourceCrud) Create() error {
 		userCapabilityRequest.CanUseSmtpCredentials = &canUseSmtpCredentials
 	}
 
-	userCapabilityResponse, err := s.Client.UpdateUserCapabilities(context.Background(), userCapabilityRequest)
+	userCapabilityResponse, err := s.Client.UpdateUserCapabilities(ctx, userCapabilityRequest)
 	if err != nil {
 		return err
 	}
@@ -170,7 +171,7 @@ func (s *UserCapabilitiesManagementResourceCrud) Create() error {
 	return nil
 }
 
-func (s *UserCapabilitiesManagementResourceCrud) Get() error {
+func (s *UserCapabilitiesManagementResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetUserRequest{}
 
 	userId, err := parseUserCapabilitiesCompositeId(s.D.Id())
@@ -182,7 +183,7 @@ func (s *UserCapabilitiesManagementResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetUser(context.Background(), request)
+	response, err := s.Client.GetUser(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -191,11 +192,11 @@ func (s *UserCapabilitiesManagementResourceCrud) Get() error {
 	return nil
 }
 
-func (s *UserCapabilitiesManagementResourceCrud) Update() error {
-	return s.Create()
+func (s *UserCapabilitiesManagementResourceCrud) Update(ctx context.Context) error {
+	return s.Create(ctx)
 }
 
-func (s *UserCapabilitiesManagementResourceCrud) Delete() error {
+func (s *UserCapabilitiesManagementResourceCrud) Delete(ctx context.Context) error {
 	// We dont know the original default values, so we pretend that the existing state is to be retained
 	return nil
 }
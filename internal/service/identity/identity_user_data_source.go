@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -23,12 +24,12 @@ func IdentityUserDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(IdentityUserResource(), fieldMap, readSingularIdentityUser)
 }
 
-func readSingularIdentityUser(d *schema.ResourceData, m interface{}) error {
+func readSingularIdentityUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityUserDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityUserDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *IdentityUserDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityUserDataSourceCrud) Get() error {
+func (s *IdentityUserDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetUserRequest{}
 
 	if userId, ok := s.D.GetOkExists("user_id"); ok {
@@ -51,7 +52,7 @@ func (s *IdentityUserDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.GetUser(context.Background(), request)
+	response, err := s.Client.GetUser(ctx, request)
 	if err != nil {
 		return err
 	}
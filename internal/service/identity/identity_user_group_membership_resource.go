@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -20,10 +21,10 @@ func IdentityUserGroupMembershipResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityUserGroupMembership,
-		Read:     readIdentityUserGroupMembership,
-		Delete:   deleteIdentityUserGroupMembership,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityUserGroupMembership,
+		ReadContext:   readIdentityUserGroupMembership,
+		DeleteContext: deleteIdentityUserGroupMembership,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"group_id": {
@@ -63,29 +64,29 @@ func IdentityUserGroupMembershipResource() *schema.Resource {
 	}
 }
 
-func createIdentityUserGroupMembership(d *schema.ResourceData, m interface{}) error {
+func createIdentityUserGroupMembership(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityUserGroupMembershipResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readIdentityUserGroupMembership(d *schema.ResourceData, m interface{}) error {
+func readIdentityUserGroupMembership(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityUserGroupMembershipResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteIdentityUserGroupMembership(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityUserGroupMembership(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityUserGroupMembershipResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type IdentityUserGroupMembershipResourceCrud struct {
@@ -123,7 +124,7 @@ func (s *IdentityUserGroupMembershipResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentityUserGroupMembershipResourceCrud) Create() error {
+func (s *IdentityUserGroupMembershipResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.AddUserToGroupRequest{}
 
 	if groupId, ok := s.D.GetOkExists("group_id"); ok {
@@ -138,7 +139,7 @@ func (s *IdentityUserGroupMembershipResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.AddUserToGroup(context.Background(), request)
+	response, err := s.Client.AddUserToGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -147,7 +148,7 @@ func (s *IdentityUserGroupMembershipResourceCrud) Create() error {
 	return nil
 }
 
-func (s *IdentityUserGroupMembershipResourceCrud) Get() error {
+func (s *IdentityUserGroupMembershipResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetUserGroupMembershipRequest{}
 
 	tmp := s.D.Id()
@@ -155,7 +156,7 @@ func (s *IdentityUserGroupMembershipResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetUserGroupMembership(context.Background(), request)
+	response, err := s.Client.GetUserGroupMembership(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -164,7 +165,7 @@ func (s *IdentityUserGroupMembershipResourceCrud) Get() error {
 	return nil
 }
 
-func (s *IdentityUserGroupMembershipResourceCrud) Delete() error {
+func (s *IdentityUserGroupMembershipResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.RemoveUserFromGroupRequest{}
 
 	tmp := s.D.Id()
@@ -172,7 +173,7 @@ func (s *IdentityUserGroupMembershipResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.RemoveUserFromGroup(context.Background(), request)
+	_, err := s.Client.RemoveUserFromGroup(ctx, request)
 	return err
 }
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -16,7 +17,7 @@ import (
 
 func IdentityUserGroupMembershipsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityUserGroupMemberships,
+		ReadContext: readIdentityUserGroupMemberships,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -40,12 +41,12 @@ func IdentityUserGroupMembershipsDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityUserGroupMemberships(d *schema.ResourceData, m interface{}) error {
+func readIdentityUserGroupMemberships(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityUserGroupMembershipsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityUserGroupMembershipsDataSourceCrud struct {
@@ -58,7 +59,7 @@ func (s *IdentityUserGroupMembershipsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityUserGroupMembershipsDataSourceCrud) Get() error {
+func (s *IdentityUserGroupMembershipsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListUserGroupMembershipsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -78,7 +79,7 @@ func (s *IdentityUserGroupMembershipsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListUserGroupMemberships(context.Background(), request)
+	response, err := s.Client.ListUserGroupMemberships(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -87,7 +88,7 @@ func (s *IdentityUserGroupMembershipsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListUserGroupMemberships(context.Background(), request)
+		listResponse, err := s.Client.ListUserGroupMemberships(ctx, request)
 		if err != nil {
 			return err
 		}
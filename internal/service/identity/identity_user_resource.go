@@ -11,6 +11,7 @@ import (
 
 	"github.com/oracle/terraform-provider-oci/internal/globalvar"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -24,11 +25,11 @@ func IdentityUserResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityUser,
-		Read:     readIdentityUser,
-		Update:   updateIdentityUser,
-		Delete:   deleteIdentityUser,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityUser,
+		ReadContext:   readIdentityUser,
+		UpdateContext: updateIdentityUser,
+		DeleteContext: deleteIdentityUser,
 		Schema: map[string]*schema.Schema{
 			// The legacy provider exposed this as read-only/computed. The API requires this param. For legacy users who are
 			// not supplying a value, make it optional, behind the scenes it will use the tenancy ocid if not supplied.
@@ -152,38 +153,38 @@ func IdentityUserResource() *schema.Resource {
 	}
 }
 
-func createIdentityUser(d *schema.ResourceData, m interface{}) error {
+func createIdentityUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityUserResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.Configuration = m.(*client.OracleClients).Configuration
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readIdentityUser(d *schema.ResourceData, m interface{}) error {
+func readIdentityUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityUserResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateIdentityUser(d *schema.ResourceData, m interface{}) error {
+func updateIdentityUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityUserResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteIdentityUser(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityUserResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type IdentityUserResourceCrud struct {
@@ -222,7 +223,7 @@ func (s *IdentityUserResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IdentityUserResourceCrud) Create() error {
+func (s *IdentityUserResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity.CreateUserRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -276,7 +277,7 @@ func (s *IdentityUserResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.CreateUser(context.Background(), request)
+	response, err := s.Client.CreateUser(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -285,7 +286,7 @@ func (s *IdentityUserResourceCrud) Create() error {
 	return nil
 }
 
-func (s *IdentityUserResourceCrud) Get() error {
+func (s *IdentityUserResourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.GetUserRequest{}
 
 	tmp := s.D.Id()
@@ -293,7 +294,7 @@ func (s *IdentityUserResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.GetUser(context.Background(), request)
+	response, err := s.Client.GetUser(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -302,7 +303,7 @@ func (s *IdentityUserResourceCrud) Get() error {
 	return nil
 }
 
-func (s *IdentityUserResourceCrud) Update() error {
+func (s *IdentityUserResourceCrud) Update(ctx context.Context) error {
 	request := oci_identity.UpdateUserRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -332,7 +333,7 @@ func (s *IdentityUserResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	response, err := s.Client.UpdateUser(context.Background(), request)
+	response, err := s.Client.UpdateUser(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -341,7 +342,7 @@ func (s *IdentityUserResourceCrud) Update() error {
 	return nil
 }
 
-func (s *IdentityUserResourceCrud) Delete() error {
+func (s *IdentityUserResourceCrud) Delete(ctx context.Context) error {
 	request := oci_identity.DeleteUserRequest{}
 
 	tmp := s.D.Id()
@@ -349,7 +350,7 @@ func (s *IdentityUserResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity")
 
-	_, err := s.Client.DeleteUser(context.Background(), request)
+	_, err := s.Client.DeleteUser(ctx, request)
 	return err
 }
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
@@ -16,7 +17,7 @@ import (
 
 func IdentityUsersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIdentityUsers,
+		ReadContext: readIdentityUsers,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,12 +49,12 @@ func IdentityUsersDataSource() *schema.Resource {
 	}
 }
 
-func readIdentityUsers(d *schema.ResourceData, m interface{}) error {
+func readIdentityUsers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityUsersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IdentityClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IdentityUsersDataSourceCrud struct {
@@ -66,7 +67,7 @@ func (s *IdentityUsersDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IdentityUsersDataSourceCrud) Get() error {
+func (s *IdentityUsersDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_identity.ListUsersRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -95,7 +96,7 @@ func (s *IdentityUsersDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
 
-	response, err := s.Client.ListUsers(context.Background(), request)
+	response, err := s.Client.ListUsers(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -104,7 +105,7 @@ func (s *IdentityUsersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListUsers(context.Background(), request)
+		listResponse, err := s.Client.ListUsers(ctx, request)
 		if err != nil {
 			return err
 		}
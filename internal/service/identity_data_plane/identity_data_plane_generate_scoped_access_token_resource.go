@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_identity_data_plane "github.com/oracle/oci-go-sdk/v65/identitydataplane"
@@ -19,10 +20,10 @@ func IdentityDataPlaneGenerateScopedAccessTokenResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createIdentityDataPlaneGenerateScopedAccessToken,
-		Read:     readIdentityDataPlaneGenerateScopedAccessToken,
-		Delete:   deleteIdentityDataPlaneGenerateScopedAccessToken,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createIdentityDataPlaneGenerateScopedAccessToken,
+		ReadContext:   readIdentityDataPlaneGenerateScopedAccessToken,
+		DeleteContext: deleteIdentityDataPlaneGenerateScopedAccessToken,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"public_key": {
@@ -47,19 +48,19 @@ func IdentityDataPlaneGenerateScopedAccessTokenResource() *schema.Resource {
 	}
 }
 
-func createIdentityDataPlaneGenerateScopedAccessToken(d *schema.ResourceData, m interface{}) error {
+func createIdentityDataPlaneGenerateScopedAccessToken(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IdentityDataPlaneGenerateScopedAccessTokenResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DataplaneClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readIdentityDataPlaneGenerateScopedAccessToken(d *schema.ResourceData, m interface{}) error {
+func readIdentityDataPlaneGenerateScopedAccessToken(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteIdentityDataPlaneGenerateScopedAccessToken(d *schema.ResourceData, m interface{}) error {
+func deleteIdentityDataPlaneGenerateScopedAccessToken(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -74,7 +75,7 @@ func (s *IdentityDataPlaneGenerateScopedAccessTokenResourceCrud) ID() string {
 	return *s.Res.Token
 }
 
-func (s *IdentityDataPlaneGenerateScopedAccessTokenResourceCrud) Create() error {
+func (s *IdentityDataPlaneGenerateScopedAccessTokenResourceCrud) Create(ctx context.Context) error {
 	request := oci_identity_data_plane.GenerateScopedAccessTokenRequest{}
 
 	if publicKey, ok := s.D.GetOkExists("public_key"); ok {
@@ -89,7 +90,7 @@ func (s *IdentityDataPlaneGenerateScopedAccessTokenResourceCrud) Create() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "identity_data_plane")
 
-	response, err := s.Client.GenerateScopedAccessToken(context.Background(), request)
+	response, err := s.Client.GenerateScopedAccessToken(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_integration "github.com/oracle/oci-go-sdk/v65/integration"
 )
@@ -22,12 +23,12 @@ func IntegrationIntegrationInstanceDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(IntegrationIntegrationInstanceResource(), fieldMap, readSingularIntegrationIntegrationInstance)
 }
 
-func readSingularIntegrationIntegrationInstance(d *schema.ResourceData, m interface{}) error {
+func readSingularIntegrationIntegrationInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IntegrationIntegrationInstanceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IntegrationInstanceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IntegrationIntegrationInstanceDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *IntegrationIntegrationInstanceDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IntegrationIntegrationInstanceDataSourceCrud) Get() error {
+func (s *IntegrationIntegrationInstanceDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_integration.GetIntegrationInstanceRequest{}
 
 	if integrationInstanceId, ok := s.D.GetOkExists("integration_instance_id"); ok {
@@ -50,7 +51,7 @@ func (s *IntegrationIntegrationInstanceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "integration")
 
-	response, err := s.Client.GetIntegrationInstance(context.Background(), request)
+	response, err := s.Client.GetIntegrationInstance(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -34,10 +35,10 @@ func IntegrationIntegrationInstanceResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("1h"),
 			Delete: tfresource.GetTimeoutDuration("1h"),
 		},
-		Create: createIntegrationIntegrationInstance,
-		Read:   readIntegrationIntegrationInstance,
-		Update: updateIntegrationIntegrationInstance,
-		Delete: deleteIntegrationIntegrationInstance,
+		CreateContext: createIntegrationIntegrationInstance,
+		ReadContext:   readIntegrationIntegrationInstance,
+		UpdateContext: updateIntegrationIntegrationInstance,
+		DeleteContext: deleteIntegrationIntegrationInstance,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -333,7 +334,7 @@ func IntegrationIntegrationInstanceResource() *schema.Resource {
 	}
 }
 
-func createIntegrationIntegrationInstance(d *schema.ResourceData, m interface{}) error {
+func createIntegrationIntegrationInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IntegrationIntegrationInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IntegrationInstanceClient()
@@ -346,33 +347,33 @@ func createIntegrationIntegrationInstance(d *schema.ResourceData, m interface{})
 		}
 	}
 
-	if error := tfresource.CreateResource(d, sync); error != nil {
-		return error
+	if error := tfresource.CreateResource(ctx, d, sync); error != nil {
+		return tfresource.HandleDiagError(error)
 	}
 
 	if powerOff {
-		return powerOffIntegrationInstance(d, sync)
+		return tfresource.HandleDiagError(powerOffIntegrationInstance(ctx, d, sync))
 	}
 
 	return nil
 }
 
-func powerOffIntegrationInstance(d *schema.ResourceData, sync *IntegrationIntegrationInstanceResourceCrud) error {
-	if err := sync.StopIntegerationInstance(); err != nil {
+func powerOffIntegrationInstance(ctx context.Context, d *schema.ResourceData, sync *IntegrationIntegrationInstanceResourceCrud) error {
+	if err := sync.StopIntegerationInstance(ctx); err != nil {
 		return err
 	}
-	return tfresource.ReadResource(sync)
+	return tfresource.ReadResource(ctx, sync)
 }
 
-func readIntegrationIntegrationInstance(d *schema.ResourceData, m interface{}) error {
+func readIntegrationIntegrationInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IntegrationIntegrationInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IntegrationInstanceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateIntegrationIntegrationInstance(d *schema.ResourceData, m interface{}) error {
+func updateIntegrationIntegrationInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IntegrationIntegrationInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IntegrationInstanceClient()
@@ -387,42 +388,42 @@ func updateIntegrationIntegrationInstance(d *schema.ResourceData, m interface{})
 		} else if oci_integration.IntegrationInstanceLifecycleStateInactive == oci_integration.IntegrationInstanceLifecycleStateEnum(wantedState) {
 			powerOff = true
 		} else {
-			return fmt.Errorf("[ERROR] Invalid state input for Update %v", wantedState)
+			return tfresource.HandleDiagError(fmt.Errorf("[ERROR] Invalid state input for Update %v", wantedState))
 		}
 	}
 
 	if powerOn {
-		if err := sync.StartIntegerationInstance(); err != nil {
-			return err
+		if err := sync.StartIntegerationInstance(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		if err := sync.D.Set("state", oci_integration.IntegrationInstanceLifecycleStateActive); err != nil {
-			return err
+			return tfresource.HandleDiagError(err)
 		}
 	}
 
-	if err := tfresource.UpdateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.UpdateResource(ctx, d, sync); err != nil {
+		return tfresource.HandleDiagError(err)
 	}
 
 	if powerOff {
-		if err := sync.StopIntegerationInstance(); err != nil {
-			return err
+		if err := sync.StopIntegerationInstance(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		if err := sync.D.Set("state", oci_integration.IntegrationInstanceLifecycleStateInactive); err != nil {
-			return err
+			return tfresource.HandleDiagError(err)
 		}
 	}
 
 	return nil
 }
 
-func deleteIntegrationIntegrationInstance(d *schema.ResourceData, m interface{}) error {
+func deleteIntegrationIntegrationInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IntegrationIntegrationInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IntegrationInstanceClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type IntegrationIntegrationInstanceResourceCrud struct {
@@ -460,7 +461,7 @@ func (s *IntegrationIntegrationInstanceResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *IntegrationIntegrationInstanceResourceCrud) Create() error {
+func (s *IntegrationIntegrationInstanceResourceCrud) Create(ctx context.Context) error {
 	request := oci_integration.CreateIntegrationInstanceRequest{}
 
 	if alternateCustomEndpoints, ok := s.D.GetOkExists("alternate_custom_endpoints"); ok {
@@ -560,20 +561,20 @@ func (s *IntegrationIntegrationInstanceResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration")
 
-	response, err := s.Client.CreateIntegrationInstance(context.Background(), request)
+	response, err := s.Client.CreateIntegrationInstance(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getIntegrationInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration"), oci_integration.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getIntegrationInstanceFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration"), oci_integration.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *IntegrationIntegrationInstanceResourceCrud) getIntegrationInstanceFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *IntegrationIntegrationInstanceResourceCrud) getIntegrationInstanceFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_integration.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	integrationInstanceId, err := integrationInstanceWaitForWorkRequest(workId, "integration",
+	integrationInstanceId, err := integrationInstanceWaitForWorkRequest(ctx, workId, "integration",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -581,7 +582,7 @@ func (s *IntegrationIntegrationInstanceResourceCrud) getIntegrationInstanceFromW
 	}
 	s.D.SetId(*integrationInstanceId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func integrationInstanceWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -607,7 +608,7 @@ func integrationInstanceWorkRequestShouldRetryFunc(timeout time.Duration) func(r
 	}
 }
 
-func integrationInstanceWaitForWorkRequest(wId *string, entityType string, action oci_integration.WorkRequestResourceActionTypeEnum,
+func integrationInstanceWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_integration.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_integration.IntegrationInstanceClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "integration")
 	retryPolicy.ShouldRetryOperation = integrationInstanceWorkRequestShouldRetryFunc(timeout)
@@ -626,7 +627,7 @@ func integrationInstanceWaitForWorkRequest(wId *string, entityType string, actio
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_integration.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -655,14 +656,14 @@ func integrationInstanceWaitForWorkRequest(wId *string, entityType string, actio
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_integration.WorkRequestStatusFailed || response.Status == oci_integration.WorkRequestStatusCanceled {
-		return nil, getErrorFromIntegrationIntegrationInstanceWorkRequest(client, wId, response.CompartmentId, retryPolicy, entityType, action)
+		return nil, getErrorFromIntegrationIntegrationInstanceWorkRequest(ctx, client, wId, response.CompartmentId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromIntegrationIntegrationInstanceWorkRequest(client *oci_integration.IntegrationInstanceClient, compartmentId *string, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_integration.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromIntegrationIntegrationInstanceWorkRequest(ctx context.Context, client *oci_integration.IntegrationInstanceClient, compartmentId *string, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_integration.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_integration.ListWorkRequestErrorsRequest{
 			CompartmentId: compartmentId,
 			WorkRequestId: workId,
@@ -685,7 +686,7 @@ func getErrorFromIntegrationIntegrationInstanceWorkRequest(client *oci_integrati
 	return workRequestErr
 }
 
-func (s *IntegrationIntegrationInstanceResourceCrud) Get() error {
+func (s *IntegrationIntegrationInstanceResourceCrud) Get(ctx context.Context) error {
 	request := oci_integration.GetIntegrationInstanceRequest{}
 
 	tmp := s.D.Id()
@@ -693,7 +694,7 @@ func (s *IntegrationIntegrationInstanceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration")
 
-	response, err := s.Client.GetIntegrationInstance(context.Background(), request)
+	response, err := s.Client.GetIntegrationInstance(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -702,11 +703,11 @@ func (s *IntegrationIntegrationInstanceResourceCrud) Get() error {
 	return nil
 }
 
-func (s *IntegrationIntegrationInstanceResourceCrud) Update() error {
+func (s *IntegrationIntegrationInstanceResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -789,16 +790,16 @@ func (s *IntegrationIntegrationInstanceResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration")
 
-	response, err := s.Client.UpdateIntegrationInstance(context.Background(), request)
+	response, err := s.Client.UpdateIntegrationInstance(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getIntegrationInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration"), oci_integration.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getIntegrationInstanceFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration"), oci_integration.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *IntegrationIntegrationInstanceResourceCrud) Delete() error {
+func (s *IntegrationIntegrationInstanceResourceCrud) Delete(ctx context.Context) error {
 	request := oci_integration.DeleteIntegrationInstanceRequest{}
 
 	tmp := s.D.Id()
@@ -806,7 +807,7 @@ func (s *IntegrationIntegrationInstanceResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration")
 
-	response, err := s.Client.DeleteIntegrationInstance(context.Background(), request)
+	response, err := s.Client.DeleteIntegrationInstance(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -817,7 +818,7 @@ func (s *IntegrationIntegrationInstanceResourceCrud) Delete() error {
 	// 	oci_integration.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	// return delWorkRequestErr
 
-	if _, err := integrationInstanceWaitForWorkRequest(workId, "integration", oci_integration.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client); err != nil {
+	if _, err := integrationInstanceWaitForWorkRequest(ctx, workId, "integration", oci_integration.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client); err != nil {
 		return err
 	}
 	return nil
@@ -1178,7 +1179,7 @@ func alternateCustomEndpointsHashCodeForSets(v interface{}) int {
 	return utils.GetStringHashcode(buf.String())
 }
 
-func (s *IntegrationIntegrationInstanceResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *IntegrationIntegrationInstanceResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_integration.ChangeIntegrationInstanceCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -1189,18 +1190,18 @@ func (s *IntegrationIntegrationInstanceResourceCrud) updateCompartment(compartme
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration")
 
-	response, err := s.Client.ChangeIntegrationInstanceCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeIntegrationInstanceCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getIntegrationInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration"), oci_integration.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getIntegrationInstanceFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration"), oci_integration.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *IntegrationIntegrationInstanceResourceCrud) StartIntegerationInstance() error {
+func (s *IntegrationIntegrationInstanceResourceCrud) StartIntegerationInstance(ctx context.Context) error {
 	state := oci_integration.IntegrationInstanceLifecycleStateActive
-	if err := s.Get(); err != nil {
+	if err := s.Get(ctx); err != nil {
 		return err
 	}
 	if s.Res.LifecycleState == state {
@@ -1213,17 +1214,17 @@ func (s *IntegrationIntegrationInstanceResourceCrud) StartIntegerationInstance()
 	request.IntegrationInstanceId = &tmp
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration")
 
-	if _, err := s.Client.StartIntegrationInstance(context.Background(), request); err != nil {
+	if _, err := s.Client.StartIntegrationInstance(ctx, request); err != nil {
 		return err
 	}
 	resourceChangedFunc := func() bool { return s.Res.LifecycleState == state }
 
-	return tfresource.WaitForResourceCondition(s, resourceChangedFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceCondition(ctx, s, resourceChangedFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *IntegrationIntegrationInstanceResourceCrud) StopIntegerationInstance() error {
+func (s *IntegrationIntegrationInstanceResourceCrud) StopIntegerationInstance(ctx context.Context) error {
 	state := oci_integration.IntegrationInstanceLifecycleStateInactive
-	if err := s.Get(); err != nil {
+	if err := s.Get(ctx); err != nil {
 		return err
 	}
 	if s.Res.LifecycleState == state {
@@ -1236,10 +1237,10 @@ func (s *IntegrationIntegrationInstanceResourceCrud) StopIntegerationInstance()
 	request.IntegrationInstanceId = &tmp
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "integration")
 
-	if _, err := s.Client.StopIntegrationInstance(context.Background(), request); err != nil {
+	if _, err := s.Client.StopIntegrationInstance(ctx, request); err != nil {
 		return err
 	}
 	resourceChangedFunc := func() bool { return s.Res.LifecycleState == state }
 
-	return tfresource.WaitForResourceCondition(s, resourceChangedFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceCondition(ctx, s, resourceChangedFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
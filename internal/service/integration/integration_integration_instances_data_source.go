@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_integration "github.com/oracle/oci-go-sdk/v65/integration"
 )
 
 func IntegrationIntegrationInstancesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readIntegrationIntegrationInstances,
+		ReadContext: readIntegrationIntegrationInstances,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,12 +40,12 @@ func IntegrationIntegrationInstancesDataSource() *schema.Resource {
 	}
 }
 
-func readIntegrationIntegrationInstances(d *schema.ResourceData, m interface{}) error {
+func readIntegrationIntegrationInstances(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &IntegrationIntegrationInstancesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).IntegrationInstanceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type IntegrationIntegrationInstancesDataSourceCrud struct {
@@ -57,7 +58,7 @@ func (s *IntegrationIntegrationInstancesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *IntegrationIntegrationInstancesDataSourceCrud) Get() error {
+func (s *IntegrationIntegrationInstancesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_integration.ListIntegrationInstancesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -76,7 +77,7 @@ func (s *IntegrationIntegrationInstancesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "integration")
 
-	response, err := s.Client.ListIntegrationInstances(context.Background(), request)
+	response, err := s.Client.ListIntegrationInstances(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *IntegrationIntegrationInstancesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIntegrationInstances(context.Background(), request)
+		listResponse, err := s.Client.ListIntegrationInstances(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package jms
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_jms "github.com/oracle/oci-go-sdk/v65/jms"
 
@@ -15,7 +16,7 @@ import (
 
 func JmsFleetBlocklistsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readJmsFleetBlocklists,
+		ReadContext: readJmsFleetBlocklists,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"fleet_id": {
@@ -85,12 +86,12 @@ func JmsFleetBlocklistsDataSource() *schema.Resource {
 	}
 }
 
-func readJmsFleetBlocklists(d *schema.ResourceData, m interface{}) error {
+func readJmsFleetBlocklists(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &JmsFleetBlocklistsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).JavaManagementServiceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type JmsFleetBlocklistsDataSourceCrud struct {
@@ -103,7 +104,7 @@ func (s *JmsFleetBlocklistsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *JmsFleetBlocklistsDataSourceCrud) Get() error {
+func (s *JmsFleetBlocklistsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_jms.ListBlocklistsRequest{}
 
 	if fleetId, ok := s.D.GetOkExists("fleet_id"); ok {
@@ -122,7 +123,7 @@ func (s *JmsFleetBlocklistsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "jms")
 
-	response, err := s.Client.ListBlocklists(context.Background(), request)
+	response, err := s.Client.ListBlocklists(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_jms "github.com/oracle/oci-go-sdk/v65/jms"
@@ -17,7 +18,7 @@ import (
 
 func JmsFleetInstallationSiteDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularJmsFleetInstallationSite,
+		ReadContext: readSingularJmsFleetInstallationSite,
 		Schema: map[string]*schema.Schema{
 			"application_id": {
 				Type:     schema.TypeString,
@@ -199,12 +200,12 @@ func JmsFleetInstallationSiteDataSource() *schema.Resource {
 	}
 }
 
-func readSingularJmsFleetInstallationSite(d *schema.ResourceData, m interface{}) error {
+func readSingularJmsFleetInstallationSite(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &JmsFleetInstallationSiteDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).JavaManagementServiceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type JmsFleetInstallationSiteDataSourceCrud struct {
@@ -217,7 +218,7 @@ func (s *JmsFleetInstallationSiteDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *JmsFleetInstallationSiteDataSourceCrud) Get() error {
+func (s *JmsFleetInstallationSiteDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_jms.ListInstallationSitesRequest{}
 
 	if applicationId, ok := s.D.GetOkExists("application_id"); ok {
@@ -295,7 +296,7 @@ func (s *JmsFleetInstallationSiteDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "jms")
 
-	response, err := s.Client.ListInstallationSites(context.Background(), request)
+	response, err := s.Client.ListInstallationSites(ctx, request)
 	if err != nil {
 		return err
 	}
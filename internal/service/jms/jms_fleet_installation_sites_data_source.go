@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_jms "github.com/oracle/oci-go-sdk/v65/jms"
@@ -17,7 +18,7 @@ import (
 
 func JmsFleetInstallationSitesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readJmsFleetInstallationSites,
+		ReadContext: readJmsFleetInstallationSites,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"application_id": {
@@ -220,12 +221,12 @@ func JmsFleetInstallationSitesDataSource() *schema.Resource {
 	}
 }
 
-func readJmsFleetInstallationSites(d *schema.ResourceData, m interface{}) error {
+func readJmsFleetInstallationSites(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &JmsFleetInstallationSitesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).JavaManagementServiceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type JmsFleetInstallationSitesDataSourceCrud struct {
@@ -238,7 +239,7 @@ func (s *JmsFleetInstallationSitesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *JmsFleetInstallationSitesDataSourceCrud) Get() error {
+func (s *JmsFleetInstallationSitesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_jms.ListInstallationSitesRequest{}
 
 	if applicationId, ok := s.D.GetOkExists("application_id"); ok {
@@ -316,7 +317,7 @@ func (s *JmsFleetInstallationSitesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "jms")
 
-	response, err := s.Client.ListInstallationSites(context.Background(), request)
+	response, err := s.Client.ListInstallationSites(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -325,7 +326,7 @@ func (s *JmsFleetInstallationSitesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListInstallationSites(context.Background(), request)
+		listResponse, err := s.Client.ListInstallationSites(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_jms "github.com/oracle/oci-go-sdk/v65/jms"
 )
 
 func JmsFleetsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readJmsFleets,
+		ReadContext: readJmsFleets,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -56,12 +57,12 @@ func JmsFleetsDataSource() *schema.Resource {
 	}
 }
 
-func readJmsFleets(d *schema.ResourceData, m interface{}) error {
+func readJmsFleets(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &JmsFleetsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).JavaManagementServiceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type JmsFleetsDataSourceCrud struct {
@@ -74,7 +75,7 @@ func (s *JmsFleetsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *JmsFleetsDataSourceCrud) Get() error {
+func (s *JmsFleetsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_jms.ListFleetsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -103,7 +104,7 @@ func (s *JmsFleetsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "jms")
 
-	response, err := s.Client.ListFleets(context.Background(), request)
+	response, err := s.Client.ListFleets(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +113,7 @@ func (s *JmsFleetsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListFleets(context.Background(), request)
+		listResponse, err := s.Client.ListFleets(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package jms
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_jms "github.com/oracle/oci-go-sdk/v65/jms"
 
@@ -15,7 +16,7 @@ import (
 
 func JmsJavaFamiliesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readJmsJavaFamilies,
+		ReadContext: readJmsJavaFamilies,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"display_name": {
@@ -71,12 +72,12 @@ func JmsJavaFamiliesDataSource() *schema.Resource {
 	}
 }
 
-func readJmsJavaFamilies(d *schema.ResourceData, m interface{}) error {
+func readJmsJavaFamilies(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &JmsJavaFamiliesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).JavaManagementServiceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type JmsJavaFamiliesDataSourceCrud struct {
@@ -89,7 +90,7 @@ func (s *JmsJavaFamiliesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *JmsJavaFamiliesDataSourceCrud) Get() error {
+func (s *JmsJavaFamiliesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_jms.ListJavaFamiliesRequest{}
 
 	if displayName, ok := s.D.GetOkExists("display_name"); ok {
@@ -104,7 +105,7 @@ func (s *JmsJavaFamiliesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "jms")
 
-	response, err := s.Client.ListJavaFamilies(context.Background(), request)
+	response, err := s.Client.ListJavaFamilies(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -113,7 +114,7 @@ func (s *JmsJavaFamiliesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListJavaFamilies(context.Background(), request)
+		listResponse, err := s.Client.ListJavaFamilies(ctx, request)
 		if err != nil {
 			return err
 		}
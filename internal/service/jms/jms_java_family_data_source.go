@@ -6,6 +6,7 @@ package jms
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_jms "github.com/oracle/oci-go-sdk/v65/jms"
 
@@ -15,7 +16,7 @@ import (
 
 func JmsJavaFamilyDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularJmsJavaFamily,
+		ReadContext: readSingularJmsJavaFamily,
 		Schema: map[string]*schema.Schema{
 			"family_version": {
 				Type:     schema.TypeString,
@@ -42,12 +43,12 @@ func JmsJavaFamilyDataSource() *schema.Resource {
 	}
 }
 
-func readSingularJmsJavaFamily(d *schema.ResourceData, m interface{}) error {
+func readSingularJmsJavaFamily(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &JmsJavaFamilyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).JavaManagementServiceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type JmsJavaFamilyDataSourceCrud struct {
@@ -60,7 +61,7 @@ func (s *JmsJavaFamilyDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *JmsJavaFamilyDataSourceCrud) Get() error {
+func (s *JmsJavaFamilyDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_jms.GetJavaFamilyRequest{}
 
 	if familyVersion, ok := s.D.GetOkExists("family_version"); ok {
@@ -70,7 +71,7 @@ func (s *JmsJavaFamilyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "jms")
 
-	response, err := s.Client.GetJavaFamily(context.Background(), request)
+	response, err := s.Client.GetJavaFamily(ctx, request)
 	if err != nil {
 		return err
 	}
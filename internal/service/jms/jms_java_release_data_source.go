@@ -6,6 +6,7 @@ package jms
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_jms "github.com/oracle/oci-go-sdk/v65/jms"
 
@@ -15,7 +16,7 @@ import (
 
 func JmsJavaReleaseDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularJmsJavaRelease,
+		ReadContext: readSingularJmsJavaRelease,
 		Schema: map[string]*schema.Schema{
 			"release_version": {
 				Type:     schema.TypeString,
@@ -145,12 +146,12 @@ func JmsJavaReleaseDataSource() *schema.Resource {
 	}
 }
 
-func readSingularJmsJavaRelease(d *schema.ResourceData, m interface{}) error {
+func readSingularJmsJavaRelease(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &JmsJavaReleaseDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).JavaManagementServiceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type JmsJavaReleaseDataSourceCrud struct {
@@ -163,7 +164,7 @@ func (s *JmsJavaReleaseDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *JmsJavaReleaseDataSourceCrud) Get() error {
+func (s *JmsJavaReleaseDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_jms.GetJavaReleaseRequest{}
 
 	if releaseVersion, ok := s.D.GetOkExists("release_version"); ok {
@@ -173,7 +174,7 @@ func (s *JmsJavaReleaseDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "jms")
 
-	response, err := s.Client.GetJavaRelease(context.Background(), request)
+	response, err := s.Client.GetJavaRelease(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_jms "github.com/oracle/oci-go-sdk/v65/jms"
 
@@ -16,7 +17,7 @@ import (
 
 func JmsJavaReleasesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readJmsJavaReleases,
+		ReadContext: readJmsJavaReleases,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"family_version": {
@@ -187,12 +188,12 @@ func JmsJavaReleasesDataSource() *schema.Resource {
 	}
 }
 
-func readJmsJavaReleases(d *schema.ResourceData, m interface{}) error {
+func readJmsJavaReleases(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &JmsJavaReleasesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).JavaManagementServiceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type JmsJavaReleasesDataSourceCrud struct {
@@ -205,7 +206,7 @@ func (s *JmsJavaReleasesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *JmsJavaReleasesDataSourceCrud) Get() error {
+func (s *JmsJavaReleasesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_jms.ListJavaReleasesRequest{}
 
 	if familyVersion, ok := s.D.GetOkExists("family_version"); ok {
@@ -232,7 +233,7 @@ func (s *JmsJavaReleasesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "jms")
 
-	response, err := s.Client.ListJavaReleases(context.Background(), request)
+	response, err := s.Client.ListJavaReleases(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -241,7 +242,7 @@ func (s *JmsJavaReleasesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListJavaReleases(context.Background(), request)
+		listResponse, err := s.Client.ListJavaReleases(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_jms "github.com/oracle/oci-go-sdk/v65/jms"
@@ -17,7 +18,7 @@ import (
 
 func JmsListJreUsageDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularJmsListJreUsage,
+		ReadContext: readSingularJmsListJreUsage,
 		Schema: map[string]*schema.Schema{
 			"application_id": {
 				Type:     schema.TypeString,
@@ -166,12 +167,12 @@ func JmsListJreUsageDataSource() *schema.Resource {
 	}
 }
 
-func readSingularJmsListJreUsage(d *schema.ResourceData, m interface{}) error {
+func readSingularJmsListJreUsage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &JmsListJreUsageDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).JavaManagementServiceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type JmsListJreUsageDataSourceCrud struct {
@@ -184,7 +185,7 @@ func (s *JmsListJreUsageDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *JmsListJreUsageDataSourceCrud) Get() error {
+func (s *JmsListJreUsageDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_jms.ListJreUsageRequest{}
 
 	if applicationId, ok := s.D.GetOkExists("application_id"); ok {
@@ -225,7 +226,7 @@ func (s *JmsListJreUsageDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "jms")
 
-	response, err := s.Client.ListJreUsage(context.Background(), request)
+	response, err := s.Client.ListJreUsage(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_jms "github.com/oracle/oci-go-sdk/v65/jms"
@@ -17,7 +18,7 @@ import (
 
 func JmsSummarizeResourceInventoryDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularJmsSummarizeResourceInventory,
+		ReadContext: readSingularJmsSummarizeResourceInventory,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -56,12 +57,12 @@ func JmsSummarizeResourceInventoryDataSource() *schema.Resource {
 	}
 }
 
-func readSingularJmsSummarizeResourceInventory(d *schema.ResourceData, m interface{}) error {
+func readSingularJmsSummarizeResourceInventory(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &JmsSummarizeResourceInventoryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).JavaManagementServiceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type JmsSummarizeResourceInventoryDataSourceCrud struct {
@@ -74,7 +75,7 @@ func (s *JmsSummarizeResourceInventoryDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *JmsSummarizeResourceInventoryDataSourceCrud) Get() error {
+func (s *JmsSummarizeResourceInventoryDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_jms.SummarizeResourceInventoryRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -100,7 +101,7 @@ func (s *JmsSummarizeResourceInventoryDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "jms")
 
-	response, err := s.Client.SummarizeResourceInventory(context.Background(), request)
+	response, err := s.Client.SummarizeResourceInventory(ctx, request)
 	if err != nil {
 		return err
 	}
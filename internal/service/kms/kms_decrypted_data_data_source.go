@@ -11,13 +11,14 @@ import (
 
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_kms "github.com/oracle/oci-go-sdk/v65/keymanagement"
 )
 
 func KmsDecryptedDataDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularDecryptedData,
+		ReadContext: readSingularDecryptedData,
 		Schema: map[string]*schema.Schema{
 			"associated_data": {
 				Type:     schema.TypeMap,
@@ -49,20 +50,20 @@ func KmsDecryptedDataDataSource() *schema.Resource {
 	}
 }
 
-func readSingularDecryptedData(d *schema.ResourceData, m interface{}) error {
+func readSingularDecryptedData(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &DecryptedDataDataSourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("crypto_endpoint")
 	if !ok {
-		return fmt.Errorf("crypto_endpoint missing")
+		return tfresource.HandleDiagError(fmt.Errorf("crypto_endpoint missing"))
 	}
 	client, err := m.(*client.OracleClients).KmsCryptoClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.HandleDiagError(err)
 	}
 	sync.Client = client
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type DecryptedDataDataSourceCrud struct {
@@ -75,7 +76,7 @@ func (s *DecryptedDataDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *DecryptedDataDataSourceCrud) Get() error {
+func (s *DecryptedDataDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_kms.DecryptRequest{}
 
 	if associatedData, ok := s.D.GetOkExists("associated_data"); ok {
@@ -94,7 +95,7 @@ func (s *DecryptedDataDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
 
-	response, err := s.Client.Decrypt(context.Background(), request)
+	response, err := s.Client.Decrypt(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -11,13 +11,14 @@ import (
 
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_kms "github.com/oracle/oci-go-sdk/v65/keymanagement"
 )
 
 func KmsEncryptedDataDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularEncryptedData,
+		ReadContext: readSingularEncryptedData,
 		Schema: map[string]*schema.Schema{
 			"associated_data": {
 				Type:     schema.TypeMap,
@@ -45,20 +46,20 @@ func KmsEncryptedDataDataSource() *schema.Resource {
 	}
 }
 
-func readSingularEncryptedData(d *schema.ResourceData, m interface{}) error {
+func readSingularEncryptedData(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &EncryptedDataDataSourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("crypto_endpoint")
 	if !ok {
-		return fmt.Errorf("crypto_endpoint missing")
+		return tfresource.HandleDiagError(fmt.Errorf("crypto_endpoint missing"))
 	}
 	client, err := m.(*client.OracleClients).KmsCryptoClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.HandleDiagError(err)
 	}
 	sync.Client = client
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type EncryptedDataDataSourceCrud struct {
@@ -71,7 +72,7 @@ func (s *EncryptedDataDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *EncryptedDataDataSourceCrud) Get() error {
+func (s *EncryptedDataDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_kms.EncryptRequest{}
 
 	if associatedData, ok := s.D.GetOkExists("associated_data"); ok {
@@ -90,7 +91,7 @@ func (s *EncryptedDataDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
 
-	response, err := s.Client.Encrypt(context.Background(), request)
+	response, err := s.Client.Encrypt(ctx, request)
 	if err != nil {
 		return err
 	}
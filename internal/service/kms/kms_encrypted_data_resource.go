@@ -6,6 +6,7 @@ package kms
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -19,10 +20,10 @@ import (
 
 func KmsEncryptedDataResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createKmsEncryptedData,
-		Read:     readKmsEncryptedData,
-		Delete:   deleteKmsEncryptedData,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createKmsEncryptedData,
+		ReadContext:   readKmsEncryptedData,
+		DeleteContext: deleteKmsEncryptedData,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"crypto_endpoint": {
@@ -76,39 +77,39 @@ func KmsEncryptedDataResource() *schema.Resource {
 	}
 }
 
-func createKmsEncryptedData(d *schema.ResourceData, m interface{}) error {
+func createKmsEncryptedData(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsEncryptedDataResourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("crypto_endpoint")
 	if !ok {
-		return fmt.Errorf("crypto_endpoint missing")
+		return tfresource.HandleDiagError(fmt.Errorf("crypto_endpoint missing"))
 	}
 	client, err := m.(*client.OracleClients).KmsCryptoClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.HandleDiagError(err)
 	}
 	sync.Client = client
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readKmsEncryptedData(d *schema.ResourceData, m interface{}) error {
+func readKmsEncryptedData(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsEncryptedDataResourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("crypto_endpoint")
 	if !ok {
-		return fmt.Errorf("crypto_endpoint missing")
+		return tfresource.HandleDiagError(fmt.Errorf("crypto_endpoint missing"))
 	}
 	client, err := m.(*client.OracleClients).KmsCryptoClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.HandleDiagError(err)
 	}
 	sync.Client = client
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteKmsEncryptedData(d *schema.ResourceData, m interface{}) error {
+func deleteKmsEncryptedData(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -123,7 +124,7 @@ func (s *KmsEncryptedDataResourceCrud) ID() string {
 	return fmt.Sprint(utils.GetStringHashcode(*s.Res.Ciphertext))
 }
 
-func (s *KmsEncryptedDataResourceCrud) Create() error {
+func (s *KmsEncryptedDataResourceCrud) Create(ctx context.Context) error {
 	request := oci_kms.EncryptRequest{}
 
 	if associatedData, ok := s.D.GetOkExists("associated_data"); ok {
@@ -155,7 +156,7 @@ func (s *KmsEncryptedDataResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.Encrypt(context.Background(), request)
+	response, err := s.Client.Encrypt(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -164,14 +165,14 @@ func (s *KmsEncryptedDataResourceCrud) Create() error {
 	return nil
 }
 
-func (s *KmsEncryptedDataResourceCrud) Get() error {
+func (s *KmsEncryptedDataResourceCrud) Get(ctx context.Context) error {
 
 	if cipherText, ok := s.D.GetOkExists("ciphertext"); ok {
 		tmp := cipherText.(string)
 		encryptedData := oci_kms.EncryptedData{Ciphertext: &tmp}
 		s.Res = &encryptedData
 	} else {
-		return s.Create()
+		return s.Create(ctx)
 	}
 
 	return nil
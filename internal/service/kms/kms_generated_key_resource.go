@@ -11,6 +11,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_kms "github.com/oracle/oci-go-sdk/v65/keymanagement"
@@ -18,10 +19,10 @@ import (
 
 func KmsGeneratedKeyResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createKmsGeneratedKey,
-		Read:     readKmsGeneratedKey,
-		Delete:   deleteKmsGeneratedKey,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createKmsGeneratedKey,
+		ReadContext:   readKmsGeneratedKey,
+		DeleteContext: deleteKmsGeneratedKey,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"crypto_endpoint": {
@@ -103,27 +104,27 @@ func KmsGeneratedKeyResource() *schema.Resource {
 	}
 }
 
-func createKmsGeneratedKey(d *schema.ResourceData, m interface{}) error {
+func createKmsGeneratedKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsGeneratedKeyResourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("crypto_endpoint")
 	if !ok {
-		return fmt.Errorf("crypto_endpoint missing")
+		return tfresource.HandleDiagError(fmt.Errorf("crypto_endpoint missing"))
 	}
 	client, err := m.(*client.OracleClients).KmsCryptoClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.HandleDiagError(err)
 	}
 	sync.Client = client
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readKmsGeneratedKey(d *schema.ResourceData, m interface{}) error {
+func readKmsGeneratedKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteKmsGeneratedKey(d *schema.ResourceData, m interface{}) error {
+func deleteKmsGeneratedKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -138,7 +139,7 @@ func (s *KmsGeneratedKeyResourceCrud) ID() string {
 	return fmt.Sprint(utils.GetStringHashcode(*s.Res.Ciphertext))
 }
 
-func (s *KmsGeneratedKeyResourceCrud) Create() error {
+func (s *KmsGeneratedKeyResourceCrud) Create(ctx context.Context) error {
 	request := oci_kms.GenerateDataEncryptionKeyRequest{}
 
 	if associatedData, ok := s.D.GetOkExists("associated_data"); ok {
@@ -172,7 +173,7 @@ func (s *KmsGeneratedKeyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.GenerateDataEncryptionKey(context.Background(), request)
+	response, err := s.Client.GenerateDataEncryptionKey(ctx, request)
 	if err != nil {
 		return err
 	}
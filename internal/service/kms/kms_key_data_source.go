@@ -11,6 +11,7 @@ import (
 
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_kms "github.com/oracle/oci-go-sdk/v65/keymanagement"
 )
@@ -28,21 +29,21 @@ func KmsKeyDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(KmsKeyResource(), fieldMap, readSingularKmsKey)
 }
 
-func readSingularKmsKey(d *schema.ResourceData, m interface{}) error {
+func readSingularKmsKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsKeyDataSourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("management_endpoint")
 	if !ok {
-		return fmt.Errorf("management endpoint missing")
+		return tfresource.HandleDiagError(fmt.Errorf("management endpoint missing"))
 	}
 
 	client, err := m.(*client.OracleClients).KmsManagementClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.HandleDiagError(err)
 	}
 	sync.Client = client
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type KmsKeyDataSourceCrud struct {
@@ -55,7 +56,7 @@ func (s *KmsKeyDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *KmsKeyDataSourceCrud) Get() error {
+func (s *KmsKeyDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_kms.GetKeyRequest{}
 
 	if keyId, ok := s.D.GetOkExists("key_id"); ok {
@@ -65,7 +66,7 @@ func (s *KmsKeyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
 
-	response, err := s.Client.GetKey(context.Background(), request)
+	response, err := s.Client.GetKey(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"regexp"
@@ -32,11 +33,11 @@ func KmsKeyResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createKmsKey,
-		Read:     readKmsKey,
-		Update:   updateKmsKey,
-		Delete:   deleteKmsKey,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createKmsKey,
+		ReadContext:   readKmsKey,
+		UpdateContext: updateKmsKey,
+		DeleteContext: deleteKmsKey,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -241,23 +242,23 @@ func KmsKeyResource() *schema.Resource {
 	}
 }
 
-func createKmsKey(d *schema.ResourceData, m interface{}) error {
+func createKmsKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsKeyResourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("management_endpoint")
 	if !ok {
-		return fmt.Errorf("management endpoint missing")
+		return tfresource.HandleDiagError(fmt.Errorf("management endpoint missing"))
 	}
 	client, err := m.(*client.OracleClients).KmsManagementClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.HandleDiagError(err)
 	}
 	sync.Client = client
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readKmsKey(d *schema.ResourceData, m interface{}) error {
+func readKmsKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsKeyResourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("management_endpoint")
@@ -271,49 +272,49 @@ func readKmsKey(d *schema.ResourceData, m interface{}) error {
 			d.Set("management_endpoint", endpoint)
 			d.SetId(tokens[2])
 		} else {
-			return fmt.Errorf("id %s should be format: managementEndpoint/{managementEndpoint}/keys/{keyId}", id)
+			return tfresource.HandleDiagError(fmt.Errorf("id %s should be format: managementEndpoint/{managementEndpoint}/keys/{keyId}", id))
 		}
 	}
 
 	client, err := m.(*client.OracleClients).KmsManagementClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.HandleDiagError(err)
 	}
 	sync.Client = client
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateKmsKey(d *schema.ResourceData, m interface{}) error {
+func updateKmsKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsKeyResourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("management_endpoint")
 	if !ok {
-		return fmt.Errorf("management endpoint missing")
+		return tfresource.HandleDiagError(fmt.Errorf("management endpoint missing"))
 	}
 	client, err := m.(*client.OracleClients).KmsManagementClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.HandleDiagError(err)
 	}
 	sync.Client = client
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteKmsKey(d *schema.ResourceData, m interface{}) error {
+func deleteKmsKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsKeyResourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("management_endpoint")
 	if !ok {
-		return fmt.Errorf("management endpoint missing")
+		return tfresource.HandleDiagError(fmt.Errorf("management endpoint missing"))
 	}
 	client, err := m.(*client.OracleClients).KmsManagementClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.HandleDiagError(err)
 	}
 	sync.Client = client
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 // existing Id is key OCID and it is not the format that readKmsKey expects managementEndpoint/{managementEndpoint}/keys/{keyId}
@@ -381,23 +382,23 @@ func (s *KmsKeyResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *KmsKeyResourceCrud) Create() error {
+func (s *KmsKeyResourceCrud) Create(ctx context.Context) error {
 	if _, ok := s.D.GetOk("restore_from_file"); ok {
-		err := s.RestoreKeyFromFile()
+		err := s.RestoreKeyFromFile(ctx)
 		if err != nil {
 			return err
 		}
 		s.D.SetId(s.ID())
-		return s.UpdateKeyDetails()
+		return s.UpdateKeyDetails(ctx)
 	}
 	if _, ok := s.D.GetOk("restore_from_object_store"); ok {
-		err := s.RestoreKeyFromObjectStore()
+		err := s.RestoreKeyFromObjectStore(ctx)
 		if err != nil {
 			return err
 		}
 		s.D.SetId(s.ID())
 
-		return s.UpdateKeyDetails()
+		return s.UpdateKeyDetails(ctx)
 	}
 
 	if desiredState, ok := s.D.GetOkExists("desired_state"); ok && !strings.EqualFold(desiredState.(string), "ENABLED") {
@@ -445,7 +446,7 @@ func (s *KmsKeyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.CreateKey(context.Background(), request)
+	response, err := s.Client.CreateKey(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -454,7 +455,7 @@ func (s *KmsKeyResourceCrud) Create() error {
 	return nil
 }
 
-func (s *KmsKeyResourceCrud) Get() error {
+func (s *KmsKeyResourceCrud) Get(ctx context.Context) error {
 	request := oci_kms.GetKeyRequest{}
 
 	tmp := s.D.Id()
@@ -462,7 +463,7 @@ func (s *KmsKeyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.GetKey(context.Background(), request)
+	response, err := s.Client.GetKey(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -471,29 +472,29 @@ func (s *KmsKeyResourceCrud) Get() error {
 	return nil
 }
 
-func (s *KmsKeyResourceCrud) Update() error {
+func (s *KmsKeyResourceCrud) Update(ctx context.Context) error {
 	if _, ok := s.D.GetOk("restore_from_file"); ok && s.D.HasChange("restore_trigger") {
-		err := s.RestoreKeyFromFile()
+		err := s.RestoreKeyFromFile(ctx)
 		if err != nil {
 			return err
 		}
 		s.D.SetId(s.ID())
 	}
 	if _, ok := s.D.GetOk("restore_from_object_store"); ok && s.D.HasChange("restore_trigger") {
-		err := s.RestoreKeyFromObjectStore()
+		err := s.RestoreKeyFromObjectStore(ctx)
 		if err != nil {
 			return err
 		}
 		s.D.SetId(s.ID())
 	}
-	return s.UpdateKeyDetails()
+	return s.UpdateKeyDetails(ctx)
 }
 
-func (s *KmsKeyResourceCrud) UpdateKeyDetails() error {
+func (s *KmsKeyResourceCrud) UpdateKeyDetails(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -520,7 +521,7 @@ func (s *KmsKeyResourceCrud) UpdateKeyDetails() error {
 	request.KeyId = &tmp
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.UpdateKey(context.Background(), request)
+	response, err := s.Client.UpdateKey(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -537,7 +538,7 @@ func (s *KmsKeyResourceCrud) UpdateKeyDetails() error {
 			activationRequest.KeyId = &tmpId
 
 			activationRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
-			activationResponse, err := s.Client.EnableKey(context.Background(), activationRequest)
+			activationResponse, err := s.Client.EnableKey(ctx, activationRequest)
 			if err != nil {
 				return err
 			}
@@ -548,7 +549,7 @@ func (s *KmsKeyResourceCrud) UpdateKeyDetails() error {
 			deactivationRequest.KeyId = &tmpId
 
 			deactivationRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
-			deactivationResponse, err := s.Client.DisableKey(context.Background(), deactivationRequest)
+			deactivationResponse, err := s.Client.DisableKey(ctx, deactivationRequest)
 			if err != nil {
 				return err
 			}
@@ -559,7 +560,7 @@ func (s *KmsKeyResourceCrud) UpdateKeyDetails() error {
 	return nil
 }
 
-func (s *KmsKeyResourceCrud) Delete() error {
+func (s *KmsKeyResourceCrud) Delete(ctx context.Context) error {
 	request := oci_kms.ScheduleKeyDeletionRequest{}
 
 	if timeOfDeletion, ok := s.D.GetOkExists("time_of_deletion"); ok {
@@ -574,7 +575,7 @@ func (s *KmsKeyResourceCrud) Delete() error {
 	tmp := s.D.Id()
 	request.KeyId = &tmp
 
-	_, err := s.Client.ScheduleKeyDeletion(context.Background(), request)
+	_, err := s.Client.ScheduleKeyDeletion(ctx, request)
 	return err
 }
 
@@ -681,7 +682,7 @@ func KeyShapeToMap(obj *oci_kms.KeyShape) map[string]interface{} {
 	return result
 }
 
-func (s *KmsKeyResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *KmsKeyResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_kms.ChangeKeyCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -692,19 +693,19 @@ func (s *KmsKeyResourceCrud) updateCompartment(compartment interface{}) error {
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	_, err := s.Client.ChangeKeyCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeKeyCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
 	return nil
 }
 
-func (s *KmsKeyResourceCrud) RestoreKeyFromObjectStore() error {
+func (s *KmsKeyResourceCrud) RestoreKeyFromObjectStore(ctx context.Context) error {
 	request := oci_kms.RestoreKeyFromObjectStoreRequest{}
 
 	if backupLocation, ok := s.D.GetOkExists("restore_from_object_store"); ok {
@@ -720,7 +721,7 @@ func (s *KmsKeyResourceCrud) RestoreKeyFromObjectStore() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.RestoreKeyFromObjectStore(context.Background(), request)
+	response, err := s.Client.RestoreKeyFromObjectStore(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -729,7 +730,7 @@ func (s *KmsKeyResourceCrud) RestoreKeyFromObjectStore() error {
 	return nil
 }
 
-func (s *KmsKeyResourceCrud) RestoreKeyFromFile() error {
+func (s *KmsKeyResourceCrud) RestoreKeyFromFile(ctx context.Context) error {
 	request := oci_kms.RestoreKeyFromFileRequest{}
 	if restoreKeyFromFileDetails, ok := s.D.GetOk("restore_from_file.0.restore_key_from_file_details"); ok {
 		decodedFileContent, _ := base64.StdEncoding.DecodeString(restoreKeyFromFileDetails.(string))
@@ -754,7 +755,7 @@ func (s *KmsKeyResourceCrud) RestoreKeyFromFile() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.RestoreKeyFromFile(context.Background(), request)
+	response, err := s.Client.RestoreKeyFromFile(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_kms "github.com/oracle/oci-go-sdk/v65/keymanagement"
 
@@ -32,20 +33,20 @@ func KmsKeyVersionDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(KmsKeyVersionResource(), fieldMap, readSingularKmsKeyVersion)
 }
 
-func readSingularKmsKeyVersion(d *schema.ResourceData, m interface{}) error {
+func readSingularKmsKeyVersion(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsKeyVersionDataSourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("management_endpoint")
 	if !ok {
-		return fmt.Errorf("management endpoint missing")
+		return tfresource.HandleDiagError(fmt.Errorf("management endpoint missing"))
 	}
 	client, err := m.(*client.OracleClients).KmsManagementClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.HandleDiagError(err)
 	}
 	sync.Client = client
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type KmsKeyVersionDataSourceCrud struct {
@@ -58,7 +59,7 @@ func (s *KmsKeyVersionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *KmsKeyVersionDataSourceCrud) Get() error {
+func (s *KmsKeyVersionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_kms.GetKeyVersionRequest{}
 
 	if keyId, ok := s.D.GetOkExists("key_id"); ok {
@@ -73,7 +74,7 @@ func (s *KmsKeyVersionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
 
-	response, err := s.Client.GetKeyVersion(context.Background(), request)
+	response, err := s.Client.GetKeyVersion(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -30,10 +31,10 @@ func KmsKeyVersionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createKmsKeyVersion,
-		Read:     readKmsKeyVersion,
-		Delete:   deleteKmsKeyVersion,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createKmsKeyVersion,
+		ReadContext:   readKmsKeyVersion,
+		DeleteContext: deleteKmsKeyVersion,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"key_id": {
@@ -112,23 +113,23 @@ func KmsKeyVersionResource() *schema.Resource {
 	}
 }
 
-func createKmsKeyVersion(d *schema.ResourceData, m interface{}) error {
+func createKmsKeyVersion(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsKeyVersionResourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("management_endpoint")
 	if !ok {
-		return fmt.Errorf("management endpoint missing")
+		return tfresource.HandleDiagError(fmt.Errorf("management endpoint missing"))
 	}
 	client, err := m.(*client.OracleClients).KmsManagementClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.HandleDiagError(err)
 	}
 	sync.Client = client
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readKmsKeyVersion(d *schema.ResourceData, m interface{}) error {
+func readKmsKeyVersion(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsKeyVersionResourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("management_endpoint")
@@ -144,19 +145,19 @@ func readKmsKeyVersion(d *schema.ResourceData, m interface{}) error {
 			d.Set("key_version_id", tokens[3])
 			d.SetId(getKeyVersionCompositeId(tokens[2], tokens[3]))
 		} else {
-			return fmt.Errorf("id %s should be of format: managementEndpoint/{managementEndpoint}/keys/{keyId}/keyVersions/{keyVersionId}", id)
+			return tfresource.HandleDiagError(fmt.Errorf("id %s should be of format: managementEndpoint/{managementEndpoint}/keys/{keyId}/keyVersions/{keyVersionId}", id))
 		}
 	}
 	client, err := m.(*client.OracleClients).KmsManagementClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.HandleDiagError(err)
 	}
 	sync.Client = client
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteKmsKeyVersion(d *schema.ResourceData, m interface{}) error {
+func deleteKmsKeyVersion(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// prevent kms version deletion as part of testing as version deletion is only applicable when the version is not the current version of the key
 	disableKmsVersionDeletion, _ := strconv.ParseBool(utils.GetEnvSettingWithDefault("disable_kms_version_delete", "false"))
 	if disableKmsVersionDeletion {
@@ -167,15 +168,15 @@ func deleteKmsKeyVersion(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	endpoint, ok := d.GetOkExists("management_endpoint")
 	if !ok {
-		return fmt.Errorf("management endpoint missing")
+		return tfresource.HandleDiagError(fmt.Errorf("management endpoint missing"))
 	}
 	client, err := m.(*client.OracleClients).KmsManagementClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.HandleDiagError(err)
 	}
 	sync.Client = client
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 // existing ID() returned by getKeyVersionCompositeId method is of format keys/(.*)/keyVersions/(.*) and
@@ -226,7 +227,7 @@ func (s *KmsKeyVersionResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *KmsKeyVersionResourceCrud) Create() error {
+func (s *KmsKeyVersionResourceCrud) Create(ctx context.Context) error {
 	request := oci_kms.CreateKeyVersionRequest{}
 
 	if keyId, ok := s.D.GetOkExists("key_id"); ok {
@@ -236,7 +237,7 @@ func (s *KmsKeyVersionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.CreateKeyVersion(context.Background(), request)
+	response, err := s.Client.CreateKeyVersion(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -246,7 +247,7 @@ func (s *KmsKeyVersionResourceCrud) Create() error {
 	return nil
 }
 
-func (s *KmsKeyVersionResourceCrud) Get() error {
+func (s *KmsKeyVersionResourceCrud) Get(ctx context.Context) error {
 	request := oci_kms.GetKeyVersionRequest{}
 
 	keyId, keyVersionId, err := parseKeyVersionCompositeId(s.D.Id())
@@ -260,7 +261,7 @@ func (s *KmsKeyVersionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.GetKeyVersion(context.Background(), request)
+	response, err := s.Client.GetKeyVersion(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -269,7 +270,7 @@ func (s *KmsKeyVersionResourceCrud) Get() error {
 	return nil
 }
 
-func (s *KmsKeyVersionResourceCrud) Delete() error {
+func (s *KmsKeyVersionResourceCrud) Delete(ctx context.Context) error {
 	request := oci_kms.ScheduleKeyVersionDeletionRequest{}
 
 	keyId, keyVersionId, err := parseKeyVersionCompositeId(s.D.Id())
@@ -291,7 +292,7 @@ func (s *KmsKeyVersionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	_, error := s.Client.ScheduleKeyVersionDeletion(context.Background(), request)
+	_, error := s.Client.ScheduleKeyVersionDeletion(ctx, request)
 	return error
 }
 
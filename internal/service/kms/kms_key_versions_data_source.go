@@ -9,6 +9,7 @@ import (
 
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_kms "github.com/oracle/oci-go-sdk/v65/keymanagement"
 
@@ -18,7 +19,7 @@ import (
 
 func KmsKeyVersionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readKmsKeyVersions,
+		ReadContext: readKmsKeyVersions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"key_id": {
@@ -38,20 +39,20 @@ func KmsKeyVersionsDataSource() *schema.Resource {
 	}
 }
 
-func readKmsKeyVersions(d *schema.ResourceData, m interface{}) error {
+func readKmsKeyVersions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsKeyVersionsDataSourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("management_endpoint")
 	if !ok {
-		return fmt.Errorf("management endpoint missing")
+		return tfresource.HandleDiagError(fmt.Errorf("management endpoint missing"))
 	}
 	client, err := m.(*client.OracleClients).KmsManagementClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.HandleDiagError(err)
 	}
 	sync.Client = client
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type KmsKeyVersionsDataSourceCrud struct {
@@ -64,14 +65,14 @@ func (s *KmsKeyVersionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *KmsKeyVersionsDataSourceCrud) Get() error {
+func (s *KmsKeyVersionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_kms.ListKeyVersionsRequest{}
 
 	request.KeyId = getKeyID(s)
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
 
-	response, err := s.Client.ListKeyVersions(context.Background(), request)
+	response, err := s.Client.ListKeyVersions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -80,7 +81,7 @@ func (s *KmsKeyVersionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListKeyVersions(context.Background(), request)
+		listResponse, err := s.Client.ListKeyVersions(ctx, request)
 		if err != nil {
 			return err
 		}
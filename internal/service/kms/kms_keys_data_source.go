@@ -8,6 +8,7 @@ import (
 
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_kms "github.com/oracle/oci-go-sdk/v65/keymanagement"
 
@@ -17,7 +18,7 @@ import (
 
 func KmsKeysDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readKmsKeys,
+		ReadContext: readKmsKeys,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"algorithm": {
@@ -53,20 +54,20 @@ func KmsKeysDataSource() *schema.Resource {
 	}
 }
 
-func readKmsKeys(d *schema.ResourceData, m interface{}) error {
+func readKmsKeys(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsKeysDataSourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("management_endpoint")
 	if !ok {
-		return fmt.Errorf("management endpoint missing")
+		return tfresource.HandleDiagError(fmt.Errorf("management endpoint missing"))
 	}
 	client, err := m.(*client.OracleClients).KmsManagementClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.HandleDiagError(err)
 	}
 	sync.Client = client
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type KmsKeysDataSourceCrud struct {
@@ -79,7 +80,7 @@ func (s *KmsKeysDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *KmsKeysDataSourceCrud) Get() error {
+func (s *KmsKeysDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_kms.ListKeysRequest{}
 
 	if algorithm, ok := s.D.GetOkExists("algorithm"); ok {
@@ -106,7 +107,7 @@ func (s *KmsKeysDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
 
-	response, err := s.Client.ListKeys(context.Background(), request)
+	response, err := s.Client.ListKeys(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -115,7 +116,7 @@ func (s *KmsKeysDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListKeys(context.Background(), request)
+		listResponse, err := s.Client.ListKeys(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_kms "github.com/oracle/oci-go-sdk/v65/keymanagement"
 
@@ -16,7 +17,7 @@ import (
 
 func KmsReplicationStatusDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularKmsReplicationStatus,
+		ReadContext: readSingularKmsReplicationStatus,
 		Schema: map[string]*schema.Schema{
 			"replication_id": {
 				Type:     schema.TypeString,
@@ -53,21 +54,21 @@ func KmsReplicationStatusDataSource() *schema.Resource {
 	}
 }
 
-func readSingularKmsReplicationStatus(d *schema.ResourceData, m interface{}) error {
+func readSingularKmsReplicationStatus(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsReplicationStatusDataSourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("management_endpoint")
 	if !ok {
-		return fmt.Errorf("management endpoint missing")
+		return tfresource.HandleDiagError(fmt.Errorf("management endpoint missing"))
 	}
 
 	client, err := m.(*client.OracleClients).KmsManagementClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.HandleDiagError(err)
 	}
 	sync.Client = client
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type KmsReplicationStatusDataSourceCrud struct {
@@ -80,7 +81,7 @@ func (s *KmsReplicationStatusDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *KmsReplicationStatusDataSourceCrud) Get() error {
+func (s *KmsReplicationStatusDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_kms.GetReplicationStatusRequest{}
 
 	if replicationId, ok := s.D.GetOkExists("replication_id"); ok {
@@ -90,7 +91,7 @@ func (s *KmsReplicationStatusDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
 
-	response, err := s.Client.GetReplicationStatus(context.Background(), request)
+	response, err := s.Client.GetReplicationStatus(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -20,10 +21,10 @@ func KmsSignResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createKmsSign,
-		Read:     readKmsSign,
-		Delete:   deleteKmsSign,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createKmsSign,
+		ReadContext:   readKmsSign,
+		DeleteContext: deleteKmsSign,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"crypto_endpoint": {
@@ -70,27 +71,27 @@ func KmsSignResource() *schema.Resource {
 	}
 }
 
-func createKmsSign(d *schema.ResourceData, m interface{}) error {
+func createKmsSign(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsSignResourceCrud{}
 	sync.D = d
 	endpoint, ok := d.GetOkExists("crypto_endpoint")
 	if !ok {
-		return fmt.Errorf("crypto_endpoint missing")
+		return tfresource.HandleDiagError(fmt.Errorf("crypto_endpoint missing"))
 	}
 	client, err := m.(*client.OracleClients).KmsCryptoClientWithEndpoint(endpoint.(string))
 	if err != nil {
-		return err
+		return tfresource.HandleDiagError(err)
 	}
 	sync.Client = client
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readKmsSign(d *schema.ResourceData, m interface{}) error {
+func readKmsSign(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteKmsSign(d *schema.ResourceData, m interface{}) error {
+func deleteKmsSign(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -105,7 +106,7 @@ func (s *KmsSignResourceCrud) ID() string {
 	return *s.Res.KeyId
 }
 
-func (s *KmsSignResourceCrud) Create() error {
+func (s *KmsSignResourceCrud) Create(ctx context.Context) error {
 	request := oci_kms.SignRequest{}
 
 	if keyId, ok := s.D.GetOkExists("key_id"); ok {
@@ -133,7 +134,7 @@ func (s *KmsSignResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.Sign(context.Background(), request)
+	response, err := s.Client.Sign(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package kms
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_kms "github.com/oracle/oci-go-sdk/v65/keymanagement"
 
@@ -22,12 +23,12 @@ func KmsVaultDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(KmsVaultResource(), fieldMap, readSingularKmsVault)
 }
 
-func readSingularKmsVault(d *schema.ResourceData, m interface{}) error {
+func readSingularKmsVault(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsVaultDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).KmsVaultClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type KmsVaultDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *KmsVaultDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *KmsVaultDataSourceCrud) Get() error {
+func (s *KmsVaultDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_kms.GetVaultRequest{}
 
 	if vaultId, ok := s.D.GetOkExists("vault_id"); ok {
@@ -50,7 +51,7 @@ func (s *KmsVaultDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
 
-	response, err := s.Client.GetVault(context.Background(), request)
+	response, err := s.Client.GetVault(ctx, request)
 	if err != nil {
 		return err
 	}
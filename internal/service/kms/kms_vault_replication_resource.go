@@ -7,6 +7,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_kms "github.com/oracle/oci-go-sdk/v65/keymanagement"
 )
@@ -16,11 +17,11 @@ func KmsVaultReplicationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createKmsVaultReplica,
-		Read:     readKmsVaultReplica,
-		Update:   updateKmsVaultReplica,
-		Delete:   deleteKmsVaultReplica,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createKmsVaultReplica,
+		ReadContext:   readKmsVaultReplica,
+		UpdateContext: updateKmsVaultReplica,
+		DeleteContext: deleteKmsVaultReplica,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"vault_id": {
@@ -40,36 +41,36 @@ func KmsVaultReplicationResource() *schema.Resource {
 	}
 }
 
-func createKmsVaultReplica(d *schema.ResourceData, m interface{}) error {
+func createKmsVaultReplica(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsVaultReplicaResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).KmsVaultClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readKmsVaultReplica(d *schema.ResourceData, m interface{}) error {
+func readKmsVaultReplica(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsVaultReplicaResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).KmsVaultClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateKmsVaultReplica(d *schema.ResourceData, m interface{}) error {
+func updateKmsVaultReplica(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsVaultReplicaResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).KmsVaultClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteKmsVaultReplica(d *schema.ResourceData, m interface{}) error {
+func deleteKmsVaultReplica(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsVaultReplicaResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).KmsVaultClient()
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type KmsVaultReplicaResourceCrud struct {
@@ -83,7 +84,7 @@ func (s *KmsVaultReplicaResourceCrud) ID() string {
 	return *s.Res.OpcRequestId
 }
 
-func (s *KmsVaultReplicaResourceCrud) Create() error {
+func (s *KmsVaultReplicaResourceCrud) Create(ctx context.Context) error {
 	replicaRegionStr := ""
 	if replicaRegion, ok := s.D.GetOkExists("replica_region"); ok {
 		tmp := replicaRegion.(string)
@@ -96,10 +97,10 @@ func (s *KmsVaultReplicaResourceCrud) Create() error {
 		vaultIdStr = tmp
 	}
 
-	return s.createVaultReplicaHelper(vaultIdStr, replicaRegionStr)
+	return s.createVaultReplicaHelper(ctx, vaultIdStr, replicaRegionStr)
 }
 
-func (s *KmsVaultReplicaResourceCrud) Get() error {
+func (s *KmsVaultReplicaResourceCrud) Get(ctx context.Context) error {
 	request := oci_kms.ListVaultReplicasRequest{}
 
 	if vaultId, ok := s.D.GetOkExists("vault_id"); ok {
@@ -109,7 +110,7 @@ func (s *KmsVaultReplicaResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.ListVaultReplicas(context.Background(), request)
+	response, err := s.Client.ListVaultReplicas(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -118,7 +119,7 @@ func (s *KmsVaultReplicaResourceCrud) Get() error {
 	return nil
 }
 
-func (s *KmsVaultReplicaResourceCrud) Update() error {
+func (s *KmsVaultReplicaResourceCrud) Update(ctx context.Context) error {
 
 	// Update is only supported for the change in replica region. All others are a forceNew
 	if s.D.HasChange("replica_region") {
@@ -134,18 +135,18 @@ func (s *KmsVaultReplicaResourceCrud) Update() error {
 		}
 
 		// delete replica in the old region for the primary vault
-		err := s.deleteVaultReplicaHelper(vaultIdStr, oldReplicaRegionName)
+		err := s.deleteVaultReplicaHelper(ctx, vaultIdStr, oldReplicaRegionName)
 		if err != nil {
 			return err
 		}
 
 		// Create replica in the new region for the primary vault after deletion is completed
-		return s.createVaultReplicaHelper(vaultIdStr, newReplicaRegionName)
+		return s.createVaultReplicaHelper(ctx, vaultIdStr, newReplicaRegionName)
 	}
 	return nil
 }
 
-func (s *KmsVaultReplicaResourceCrud) Delete() error {
+func (s *KmsVaultReplicaResourceCrud) Delete(ctx context.Context) error {
 	replicaRegionStr := ""
 	if replicaRegion, ok := s.D.GetOkExists("replica_region"); ok {
 		tmp := replicaRegion.(string)
@@ -158,10 +159,10 @@ func (s *KmsVaultReplicaResourceCrud) Delete() error {
 		vaultIdStr = tmp
 	}
 
-	return s.deleteVaultReplicaHelper(vaultIdStr, replicaRegionStr)
+	return s.deleteVaultReplicaHelper(ctx, vaultIdStr, replicaRegionStr)
 }
 
-func (s *KmsVaultReplicaResourceCrud) createVaultReplicaHelper(vaultId string, replicaRegion string) error {
+func (s *KmsVaultReplicaResourceCrud) createVaultReplicaHelper(ctx context.Context, vaultId string, replicaRegion string) error {
 	request := oci_kms.CreateVaultReplicaRequest{}
 
 	if len(strings.TrimSpace(vaultId)) != 0 {
@@ -174,16 +175,16 @@ func (s *KmsVaultReplicaResourceCrud) createVaultReplicaHelper(vaultId string, r
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	_, err := s.Client.CreateVaultReplica(context.Background(), request)
+	_, err := s.Client.CreateVaultReplica(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.Items[0].Status == oci_kms.VaultReplicaSummaryStatusCreated }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutCreate))
+	return tfresource.WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *KmsVaultReplicaResourceCrud) deleteVaultReplicaHelper(vaultId string, replicaRegion string) error {
+func (s *KmsVaultReplicaResourceCrud) deleteVaultReplicaHelper(ctx context.Context, vaultId string, replicaRegion string) error {
 	request := oci_kms.DeleteVaultReplicaRequest{}
 
 	if len(strings.TrimSpace(vaultId)) != 0 {
@@ -196,7 +197,7 @@ func (s *KmsVaultReplicaResourceCrud) deleteVaultReplicaHelper(vaultId string, r
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	_, err := s.Client.DeleteVaultReplica(context.Background(), request)
+	_, err := s.Client.DeleteVaultReplica(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -204,7 +205,7 @@ func (s *KmsVaultReplicaResourceCrud) deleteVaultReplicaHelper(vaultId string, r
 	retentionPolicyFunc := func() bool {
 		return (len(s.Res.Items) == 0 || s.Res.Items[0].Status == oci_kms.VaultReplicaSummaryStatusDeleted)
 	}
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutDelete))
+	return tfresource.WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutDelete))
 }
 
 func (s *KmsVaultReplicaResourceCrud) SetData() error {
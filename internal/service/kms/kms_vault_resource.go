@@ -17,6 +17,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -29,11 +30,11 @@ func KmsVaultResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createKmsVault,
-		Read:     readKmsVault,
-		Update:   updateKmsVault,
-		Delete:   deleteKmsVault,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createKmsVault,
+		ReadContext:   readKmsVault,
+		UpdateContext: updateKmsVault,
+		DeleteContext: deleteKmsVault,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -191,36 +192,36 @@ func KmsVaultResource() *schema.Resource {
 	}
 }
 
-func createKmsVault(d *schema.ResourceData, m interface{}) error {
+func createKmsVault(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsVaultResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).KmsVaultClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readKmsVault(d *schema.ResourceData, m interface{}) error {
+func readKmsVault(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsVaultResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).KmsVaultClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateKmsVault(d *schema.ResourceData, m interface{}) error {
+func updateKmsVault(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsVaultResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).KmsVaultClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteKmsVault(d *schema.ResourceData, m interface{}) error {
+func deleteKmsVault(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsVaultResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).KmsVaultClient()
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type KmsVaultResourceCrud struct {
@@ -261,22 +262,22 @@ func (s *KmsVaultResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *KmsVaultResourceCrud) Create() error {
+func (s *KmsVaultResourceCrud) Create(ctx context.Context) error {
 	if _, ok := s.D.GetOkExists("restore_from_file"); ok {
-		err := s.RestoreVaultFromFile()
+		err := s.RestoreVaultFromFile(ctx)
 		if err != nil {
 			return err
 		}
 		s.D.SetId(s.ID())
-		return s.UpdateVaultDetails()
+		return s.UpdateVaultDetails(ctx)
 	}
 	if _, ok := s.D.GetOkExists("restore_from_object_store"); ok {
-		err := s.RestoreVaultFromObjectStore()
+		err := s.RestoreVaultFromObjectStore(ctx)
 		if err != nil {
 			return err
 		}
 		s.D.SetId(s.ID())
-		return s.UpdateVaultDetails()
+		return s.UpdateVaultDetails(ctx)
 	}
 
 	request := oci_kms.CreateVaultRequest{}
@@ -309,7 +310,7 @@ func (s *KmsVaultResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.CreateVault(context.Background(), request)
+	response, err := s.Client.CreateVault(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -318,7 +319,7 @@ func (s *KmsVaultResourceCrud) Create() error {
 	return nil
 }
 
-func (s *KmsVaultResourceCrud) Get() error {
+func (s *KmsVaultResourceCrud) Get(ctx context.Context) error {
 	request := oci_kms.GetVaultRequest{}
 
 	tmp := s.D.Id()
@@ -326,7 +327,7 @@ func (s *KmsVaultResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.GetVault(context.Background(), request)
+	response, err := s.Client.GetVault(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -335,29 +336,29 @@ func (s *KmsVaultResourceCrud) Get() error {
 	return nil
 }
 
-func (s *KmsVaultResourceCrud) Update() error {
+func (s *KmsVaultResourceCrud) Update(ctx context.Context) error {
 	if _, ok := s.D.GetOk("restore_from_file"); ok && s.D.HasChange("restore_trigger") {
-		err := s.RestoreVaultFromFile()
+		err := s.RestoreVaultFromFile(ctx)
 		if err != nil {
 			return err
 		}
 		s.D.SetId(s.ID())
 	}
 	if _, ok := s.D.GetOk("restore_from_object_store"); ok && s.D.HasChange("restore_trigger") {
-		err := s.RestoreVaultFromObjectStore()
+		err := s.RestoreVaultFromObjectStore(ctx)
 		if err != nil {
 			return err
 		}
 		s.D.SetId(s.ID())
 	}
-	return s.UpdateVaultDetails()
+	return s.UpdateVaultDetails(ctx)
 }
 
-func (s *KmsVaultResourceCrud) UpdateVaultDetails() error {
+func (s *KmsVaultResourceCrud) UpdateVaultDetails(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -382,7 +383,7 @@ func (s *KmsVaultResourceCrud) UpdateVaultDetails() error {
 	request.VaultId = &tmp
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.UpdateVault(context.Background(), request)
+	response, err := s.Client.UpdateVault(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -391,7 +392,7 @@ func (s *KmsVaultResourceCrud) UpdateVaultDetails() error {
 	return nil
 }
 
-func (s *KmsVaultResourceCrud) Delete() error {
+func (s *KmsVaultResourceCrud) Delete(ctx context.Context) error {
 	request := oci_kms.ScheduleVaultDeletionRequest{}
 
 	tmp := s.D.Id()
@@ -407,7 +408,7 @@ func (s *KmsVaultResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	_, err := s.Client.ScheduleVaultDeletion(context.Background(), request)
+	_, err := s.Client.ScheduleVaultDeletion(ctx, request)
 	return err
 }
 
@@ -473,7 +474,7 @@ func VaultReplicaDetailsToMap(obj *oci_kms.VaultReplicaDetails) map[string]inter
 	return result
 }
 
-func (s *KmsVaultResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *KmsVaultResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_kms.ChangeVaultCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -484,19 +485,19 @@ func (s *KmsVaultResourceCrud) updateCompartment(compartment interface{}) error
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	_, err := s.Client.ChangeVaultCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeVaultCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
 	return nil
 }
 
-func (s *KmsVaultResourceCrud) RestoreVaultFromObjectStore() error {
+func (s *KmsVaultResourceCrud) RestoreVaultFromObjectStore(ctx context.Context) error {
 	request := oci_kms.RestoreVaultFromObjectStoreRequest{}
 
 	if backupLocation, ok := s.D.GetOkExists("restore_from_object_store"); ok {
@@ -517,7 +518,7 @@ func (s *KmsVaultResourceCrud) RestoreVaultFromObjectStore() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.RestoreVaultFromObjectStore(context.Background(), request)
+	response, err := s.Client.RestoreVaultFromObjectStore(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -526,7 +527,7 @@ func (s *KmsVaultResourceCrud) RestoreVaultFromObjectStore() error {
 	return nil
 }
 
-func (s *KmsVaultResourceCrud) RestoreVaultFromFile() error {
+func (s *KmsVaultResourceCrud) RestoreVaultFromFile(ctx context.Context) error {
 	request := oci_kms.RestoreVaultFromFileRequest{}
 
 	if compartmentId, ok := s.D.GetOk("compartment_id"); ok {
@@ -557,7 +558,7 @@ func (s *KmsVaultResourceCrud) RestoreVaultFromFile() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "kms")
 
-	response, err := s.Client.RestoreVaultFromFile(context.Background(), request)
+	response, err := s.Client.RestoreVaultFromFile(ctx, request)
 	if err != nil {
 		return err
 	}
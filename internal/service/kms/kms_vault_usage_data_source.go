@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_kms "github.com/oracle/oci-go-sdk/v65/keymanagement"
 )
 
 func KmsVaultUsageDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularKmsVaultUsage,
+		ReadContext: readSingularKmsVaultUsage,
 		Schema: map[string]*schema.Schema{
 			"vault_id": {
 				Type:     schema.TypeString,
@@ -42,12 +43,12 @@ func KmsVaultUsageDataSource() *schema.Resource {
 	}
 }
 
-func readSingularKmsVaultUsage(d *schema.ResourceData, m interface{}) error {
+func readSingularKmsVaultUsage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsVaultUsageDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).KmsVaultClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type KmsVaultUsageDataSourceCrud struct {
@@ -60,7 +61,7 @@ func (s *KmsVaultUsageDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *KmsVaultUsageDataSourceCrud) Get() error {
+func (s *KmsVaultUsageDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_kms.GetVaultUsageRequest{}
 
 	if vaultId, ok := s.D.GetOkExists("vault_id"); ok {
@@ -70,7 +71,7 @@ func (s *KmsVaultUsageDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
 
-	response, err := s.Client.GetVaultUsage(context.Background(), request)
+	response, err := s.Client.GetVaultUsage(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package kms
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_kms "github.com/oracle/oci-go-sdk/v65/keymanagement"
 
@@ -15,7 +16,7 @@ import (
 
 func KmsVaultsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readKmsVaults,
+		ReadContext: readKmsVaults,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -31,12 +32,12 @@ func KmsVaultsDataSource() *schema.Resource {
 	}
 }
 
-func readKmsVaults(d *schema.ResourceData, m interface{}) error {
+func readKmsVaults(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &KmsVaultsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).KmsVaultClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type KmsVaultsDataSourceCrud struct {
@@ -49,7 +50,7 @@ func (s *KmsVaultsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *KmsVaultsDataSourceCrud) Get() error {
+func (s *KmsVaultsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_kms.ListVaultsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -59,7 +60,7 @@ func (s *KmsVaultsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "kms")
 
-	response, err := s.Client.ListVaults(context.Background(), request)
+	response, err := s.Client.ListVaults(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -68,7 +69,7 @@ func (s *KmsVaultsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVaults(context.Background(), request)
+		listResponse, err := s.Client.ListVaults(ctx, request)
 		if err != nil {
 			return err
 		}
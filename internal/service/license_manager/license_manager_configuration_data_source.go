@@ -6,6 +6,7 @@ package license_manager
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_license_manager "github.com/oracle/oci-go-sdk/v65/licensemanager"
 
@@ -22,12 +23,12 @@ func LicenseManagerConfigurationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(LicenseManagerConfigurationResource(), fieldMap, readSingularLicenseManagerConfiguration)
 }
 
-func readSingularLicenseManagerConfiguration(d *schema.ResourceData, m interface{}) error {
+func readSingularLicenseManagerConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LicenseManagerConfigurationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LicenseManagerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LicenseManagerConfigurationDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *LicenseManagerConfigurationDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LicenseManagerConfigurationDataSourceCrud) Get() error {
+func (s *LicenseManagerConfigurationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_license_manager.GetConfigurationRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -50,7 +51,7 @@ func (s *LicenseManagerConfigurationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "license_manager")
 
-	response, err := s.Client.GetConfiguration(context.Background(), request)
+	response, err := s.Client.GetConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
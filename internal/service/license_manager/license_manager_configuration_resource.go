@@ -7,6 +7,7 @@ import (
 	"context"
 	"net/url"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_license_manager "github.com/oracle/oci-go-sdk/v65/licensemanager"
@@ -20,11 +21,11 @@ func LicenseManagerConfigurationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLicenseManagerConfiguration,
-		Read:     readLicenseManagerConfiguration,
-		Update:   updateLicenseManagerConfiguration,
-		Delete:   deleteLicenseManagerConfiguration,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLicenseManagerConfiguration,
+		ReadContext:   readLicenseManagerConfiguration,
+		UpdateContext: updateLicenseManagerConfiguration,
+		DeleteContext: deleteLicenseManagerConfiguration,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -54,31 +55,31 @@ func LicenseManagerConfigurationResource() *schema.Resource {
 	}
 }
 
-func createLicenseManagerConfiguration(d *schema.ResourceData, m interface{}) error {
+func createLicenseManagerConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LicenseManagerConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LicenseManagerClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readLicenseManagerConfiguration(d *schema.ResourceData, m interface{}) error {
+func readLicenseManagerConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LicenseManagerConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LicenseManagerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateLicenseManagerConfiguration(d *schema.ResourceData, m interface{}) error {
+func updateLicenseManagerConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LicenseManagerConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LicenseManagerClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteLicenseManagerConfiguration(d *schema.ResourceData, m interface{}) error {
+func deleteLicenseManagerConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -93,7 +94,7 @@ func (s *LicenseManagerConfigurationResourceCrud) ID() string {
 	return GetConfigurationId(*s.Res.CompartmentId)
 }
 
-func (s *LicenseManagerConfigurationResourceCrud) Create() error {
+func (s *LicenseManagerConfigurationResourceCrud) Create(ctx context.Context) error {
 	request := oci_license_manager.UpdateConfigurationRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -116,7 +117,7 @@ func (s *LicenseManagerConfigurationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "license_manager")
 
-	response, err := s.Client.UpdateConfiguration(context.Background(), request)
+	response, err := s.Client.UpdateConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -125,7 +126,7 @@ func (s *LicenseManagerConfigurationResourceCrud) Create() error {
 	return nil
 }
 
-func (s *LicenseManagerConfigurationResourceCrud) Get() error {
+func (s *LicenseManagerConfigurationResourceCrud) Get(ctx context.Context) error {
 	request := oci_license_manager.GetConfigurationRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -138,7 +139,7 @@ func (s *LicenseManagerConfigurationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "license_manager")
 
-	response, err := s.Client.GetConfiguration(context.Background(), request)
+	response, err := s.Client.GetConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -147,7 +148,7 @@ func (s *LicenseManagerConfigurationResourceCrud) Get() error {
 	return nil
 }
 
-func (s *LicenseManagerConfigurationResourceCrud) Update() error {
+func (s *LicenseManagerConfigurationResourceCrud) Update(ctx context.Context) error {
 	request := oci_license_manager.UpdateConfigurationRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -170,7 +171,7 @@ func (s *LicenseManagerConfigurationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "license_manager")
 
-	response, err := s.Client.UpdateConfiguration(context.Background(), request)
+	response, err := s.Client.UpdateConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
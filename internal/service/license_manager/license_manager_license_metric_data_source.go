@@ -6,6 +6,7 @@ package license_manager
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_license_manager "github.com/oracle/oci-go-sdk/v65/licensemanager"
 
@@ -15,7 +16,7 @@ import (
 
 func LicenseManagerLicenseMetricDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularLicenseManagerLicenseMetric,
+		ReadContext: readSingularLicenseManagerLicenseMetric,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -46,12 +47,12 @@ func LicenseManagerLicenseMetricDataSource() *schema.Resource {
 	}
 }
 
-func readSingularLicenseManagerLicenseMetric(d *schema.ResourceData, m interface{}) error {
+func readSingularLicenseManagerLicenseMetric(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LicenseManagerLicenseMetricDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LicenseManagerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LicenseManagerLicenseMetricDataSourceCrud struct {
@@ -64,7 +65,7 @@ func (s *LicenseManagerLicenseMetricDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LicenseManagerLicenseMetricDataSourceCrud) Get() error {
+func (s *LicenseManagerLicenseMetricDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_license_manager.GetLicenseMetricRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -79,7 +80,7 @@ func (s *LicenseManagerLicenseMetricDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "license_manager")
 
-	response, err := s.Client.GetLicenseMetric(context.Background(), request)
+	response, err := s.Client.GetLicenseMetric(ctx, request)
 	if err != nil {
 		return err
 	}
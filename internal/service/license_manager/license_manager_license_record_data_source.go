@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_license_manager "github.com/oracle/oci-go-sdk/v65/licensemanager"
 
@@ -23,12 +24,12 @@ func LicenseManagerLicenseRecordDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(LicenseManagerLicenseRecordResource(), fieldMap, readSingularLicenseManagerLicenseRecord)
 }
 
-func readSingularLicenseManagerLicenseRecord(d *schema.ResourceData, m interface{}) error {
+func readSingularLicenseManagerLicenseRecord(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LicenseManagerLicenseRecordDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LicenseManagerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LicenseManagerLicenseRecordDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *LicenseManagerLicenseRecordDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LicenseManagerLicenseRecordDataSourceCrud) Get() error {
+func (s *LicenseManagerLicenseRecordDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_license_manager.GetLicenseRecordRequest{}
 
 	if licenseRecordId, ok := s.D.GetOkExists("license_record_id"); ok {
@@ -51,7 +52,7 @@ func (s *LicenseManagerLicenseRecordDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "license_manager")
 
-	response, err := s.Client.GetLicenseRecord(context.Background(), request)
+	response, err := s.Client.GetLicenseRecord(ctx, request)
 	if err != nil {
 		return err
 	}
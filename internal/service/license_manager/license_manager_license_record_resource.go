@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
@@ -21,11 +22,11 @@ func LicenseManagerLicenseRecordResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLicenseManagerLicenseRecord,
-		Read:     readLicenseManagerLicenseRecord,
-		Update:   updateLicenseManagerLicenseRecord,
-		Delete:   deleteLicenseManagerLicenseRecord,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLicenseManagerLicenseRecord,
+		ReadContext:   readLicenseManagerLicenseRecord,
+		UpdateContext: updateLicenseManagerLicenseRecord,
+		DeleteContext: deleteLicenseManagerLicenseRecord,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"display_name": {
@@ -117,37 +118,37 @@ func LicenseManagerLicenseRecordResource() *schema.Resource {
 	}
 }
 
-func createLicenseManagerLicenseRecord(d *schema.ResourceData, m interface{}) error {
+func createLicenseManagerLicenseRecord(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LicenseManagerLicenseRecordResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LicenseManagerClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readLicenseManagerLicenseRecord(d *schema.ResourceData, m interface{}) error {
+func readLicenseManagerLicenseRecord(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LicenseManagerLicenseRecordResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LicenseManagerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateLicenseManagerLicenseRecord(d *schema.ResourceData, m interface{}) error {
+func updateLicenseManagerLicenseRecord(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LicenseManagerLicenseRecordResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LicenseManagerClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteLicenseManagerLicenseRecord(d *schema.ResourceData, m interface{}) error {
+func deleteLicenseManagerLicenseRecord(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LicenseManagerLicenseRecordResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LicenseManagerClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type LicenseManagerLicenseRecordResourceCrud struct {
@@ -181,7 +182,7 @@ func (s *LicenseManagerLicenseRecordResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *LicenseManagerLicenseRecordResourceCrud) Create() error {
+func (s *LicenseManagerLicenseRecordResourceCrud) Create(ctx context.Context) error {
 	request := oci_license_manager.CreateLicenseRecordRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -244,7 +245,7 @@ func (s *LicenseManagerLicenseRecordResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "license_manager")
 
-	response, err := s.Client.CreateLicenseRecord(context.Background(), request)
+	response, err := s.Client.CreateLicenseRecord(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -253,7 +254,7 @@ func (s *LicenseManagerLicenseRecordResourceCrud) Create() error {
 	return nil
 }
 
-func (s *LicenseManagerLicenseRecordResourceCrud) Get() error {
+func (s *LicenseManagerLicenseRecordResourceCrud) Get(ctx context.Context) error {
 	request := oci_license_manager.GetLicenseRecordRequest{}
 
 	tmp := s.D.Id()
@@ -261,7 +262,7 @@ func (s *LicenseManagerLicenseRecordResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "license_manager")
 
-	response, err := s.Client.GetLicenseRecord(context.Background(), request)
+	response, err := s.Client.GetLicenseRecord(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -270,7 +271,7 @@ func (s *LicenseManagerLicenseRecordResourceCrud) Get() error {
 	return nil
 }
 
-func (s *LicenseManagerLicenseRecordResourceCrud) Update() error {
+func (s *LicenseManagerLicenseRecordResourceCrud) Update(ctx context.Context) error {
 	request := oci_license_manager.UpdateLicenseRecordRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -331,7 +332,7 @@ func (s *LicenseManagerLicenseRecordResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "license_manager")
 
-	response, err := s.Client.UpdateLicenseRecord(context.Background(), request)
+	response, err := s.Client.UpdateLicenseRecord(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -340,7 +341,7 @@ func (s *LicenseManagerLicenseRecordResourceCrud) Update() error {
 	return nil
 }
 
-func (s *LicenseManagerLicenseRecordResourceCrud) Delete() error {
+func (s *LicenseManagerLicenseRecordResourceCrud) Delete(ctx context.Context) error {
 	request := oci_license_manager.DeleteLicenseRecordRequest{}
 
 	tmp := s.D.Id()
@@ -348,7 +349,7 @@ func (s *LicenseManagerLicenseRecordResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "license_manager")
 
-	_, err := s.Client.DeleteLicenseRecord(context.Background(), request)
+	_, err := s.Client.DeleteLicenseRecord(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package license_manager
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_license_manager "github.com/oracle/oci-go-sdk/v65/licensemanager"
 
@@ -15,7 +16,7 @@ import (
 
 func LicenseManagerLicenseRecordsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLicenseManagerLicenseRecords,
+		ReadContext: readLicenseManagerLicenseRecords,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"product_license_id": {
@@ -40,12 +41,12 @@ func LicenseManagerLicenseRecordsDataSource() *schema.Resource {
 	}
 }
 
-func readLicenseManagerLicenseRecords(d *schema.ResourceData, m interface{}) error {
+func readLicenseManagerLicenseRecords(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LicenseManagerLicenseRecordsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LicenseManagerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LicenseManagerLicenseRecordsDataSourceCrud struct {
@@ -58,7 +59,7 @@ func (s *LicenseManagerLicenseRecordsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LicenseManagerLicenseRecordsDataSourceCrud) Get() error {
+func (s *LicenseManagerLicenseRecordsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_license_manager.ListLicenseRecordsRequest{}
 
 	if productLicenseId, ok := s.D.GetOkExists("product_license_id"); ok {
@@ -68,7 +69,7 @@ func (s *LicenseManagerLicenseRecordsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "license_manager")
 
-	response, err := s.Client.ListLicenseRecords(context.Background(), request)
+	response, err := s.Client.ListLicenseRecords(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -77,7 +78,7 @@ func (s *LicenseManagerLicenseRecordsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListLicenseRecords(context.Background(), request)
+		listResponse, err := s.Client.ListLicenseRecords(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package license_manager
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_license_manager "github.com/oracle/oci-go-sdk/v65/licensemanager"
 
@@ -15,7 +16,7 @@ import (
 
 func LicenseManagerProductLicenseConsumersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLicenseManagerProductLicenseConsumers,
+		ReadContext: readLicenseManagerProductLicenseConsumers,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -116,12 +117,12 @@ func LicenseManagerProductLicenseConsumersDataSource() *schema.Resource {
 	}
 }
 
-func readLicenseManagerProductLicenseConsumers(d *schema.ResourceData, m interface{}) error {
+func readLicenseManagerProductLicenseConsumers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LicenseManagerProductLicenseConsumersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LicenseManagerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LicenseManagerProductLicenseConsumersDataSourceCrud struct {
@@ -134,7 +135,7 @@ func (s *LicenseManagerProductLicenseConsumersDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LicenseManagerProductLicenseConsumersDataSourceCrud) Get() error {
+func (s *LicenseManagerProductLicenseConsumersDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_license_manager.ListProductLicenseConsumersRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -154,7 +155,7 @@ func (s *LicenseManagerProductLicenseConsumersDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "license_manager")
 
-	response, err := s.Client.ListProductLicenseConsumers(context.Background(), request)
+	response, err := s.Client.ListProductLicenseConsumers(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package license_manager
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_license_manager "github.com/oracle/oci-go-sdk/v65/licensemanager"
 
@@ -22,12 +23,12 @@ func LicenseManagerProductLicenseDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(LicenseManagerProductLicenseResource(), fieldMap, readSingularLicenseManagerProductLicense)
 }
 
-func readSingularLicenseManagerProductLicense(d *schema.ResourceData, m interface{}) error {
+func readSingularLicenseManagerProductLicense(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LicenseManagerProductLicenseDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LicenseManagerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LicenseManagerProductLicenseDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *LicenseManagerProductLicenseDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LicenseManagerProductLicenseDataSourceCrud) Get() error {
+func (s *LicenseManagerProductLicenseDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_license_manager.GetProductLicenseRequest{}
 
 	if productLicenseId, ok := s.D.GetOkExists("product_license_id"); ok {
@@ -50,7 +51,7 @@ func (s *LicenseManagerProductLicenseDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "license_manager")
 
-	response, err := s.Client.GetProductLicense(context.Background(), request)
+	response, err := s.Client.GetProductLicense(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_license_manager "github.com/oracle/oci-go-sdk/v65/licensemanager"
@@ -20,11 +21,11 @@ func LicenseManagerProductLicenseResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLicenseManagerProductLicense,
-		Read:     readLicenseManagerProductLicense,
-		Update:   updateLicenseManagerProductLicense,
-		Delete:   deleteLicenseManagerProductLicense,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLicenseManagerProductLicense,
+		ReadContext:   readLicenseManagerProductLicense,
+		UpdateContext: updateLicenseManagerProductLicense,
+		DeleteContext: deleteLicenseManagerProductLicense,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -157,37 +158,37 @@ func LicenseManagerProductLicenseResource() *schema.Resource {
 	}
 }
 
-func createLicenseManagerProductLicense(d *schema.ResourceData, m interface{}) error {
+func createLicenseManagerProductLicense(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LicenseManagerProductLicenseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LicenseManagerClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readLicenseManagerProductLicense(d *schema.ResourceData, m interface{}) error {
+func readLicenseManagerProductLicense(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LicenseManagerProductLicenseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LicenseManagerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateLicenseManagerProductLicense(d *schema.ResourceData, m interface{}) error {
+func updateLicenseManagerProductLicense(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LicenseManagerProductLicenseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LicenseManagerClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteLicenseManagerProductLicense(d *schema.ResourceData, m interface{}) error {
+func deleteLicenseManagerProductLicense(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LicenseManagerProductLicenseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LicenseManagerClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type LicenseManagerProductLicenseResourceCrud struct {
@@ -221,7 +222,7 @@ func (s *LicenseManagerProductLicenseResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *LicenseManagerProductLicenseResourceCrud) Create() error {
+func (s *LicenseManagerProductLicenseResourceCrud) Create(ctx context.Context) error {
 	request := oci_license_manager.CreateProductLicenseRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -279,7 +280,7 @@ func (s *LicenseManagerProductLicenseResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "license_manager")
 
-	response, err := s.Client.CreateProductLicense(context.Background(), request)
+	response, err := s.Client.CreateProductLicense(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -288,7 +289,7 @@ func (s *LicenseManagerProductLicenseResourceCrud) Create() error {
 	return nil
 }
 
-func (s *LicenseManagerProductLicenseResourceCrud) Get() error {
+func (s *LicenseManagerProductLicenseResourceCrud) Get(ctx context.Context) error {
 	request := oci_license_manager.GetProductLicenseRequest{}
 
 	tmp := s.D.Id()
@@ -296,7 +297,7 @@ func (s *LicenseManagerProductLicenseResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "license_manager")
 
-	response, err := s.Client.GetProductLicense(context.Background(), request)
+	response, err := s.Client.GetProductLicense(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -305,7 +306,7 @@ func (s *LicenseManagerProductLicenseResourceCrud) Get() error {
 	return nil
 }
 
-func (s *LicenseManagerProductLicenseResourceCrud) Update() error {
+func (s *LicenseManagerProductLicenseResourceCrud) Update(ctx context.Context) error {
 	request := oci_license_manager.UpdateProductLicenseRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -342,7 +343,7 @@ func (s *LicenseManagerProductLicenseResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "license_manager")
 
-	response, err := s.Client.UpdateProductLicense(context.Background(), request)
+	response, err := s.Client.UpdateProductLicense(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -351,7 +352,7 @@ func (s *LicenseManagerProductLicenseResourceCrud) Update() error {
 	return nil
 }
 
-func (s *LicenseManagerProductLicenseResourceCrud) Delete() error {
+func (s *LicenseManagerProductLicenseResourceCrud) Delete(ctx context.Context) error {
 	request := oci_license_manager.DeleteProductLicenseRequest{}
 
 	tmp := s.D.Id()
@@ -359,7 +360,7 @@ func (s *LicenseManagerProductLicenseResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "license_manager")
 
-	_, err := s.Client.DeleteProductLicense(context.Background(), request)
+	_, err := s.Client.DeleteProductLicense(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package license_manager
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_license_manager "github.com/oracle/oci-go-sdk/v65/licensemanager"
 
@@ -15,7 +16,7 @@ import (
 
 func LicenseManagerProductLicensesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLicenseManagerProductLicenses,
+		ReadContext: readLicenseManagerProductLicenses,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -44,12 +45,12 @@ func LicenseManagerProductLicensesDataSource() *schema.Resource {
 	}
 }
 
-func readLicenseManagerProductLicenses(d *schema.ResourceData, m interface{}) error {
+func readLicenseManagerProductLicenses(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LicenseManagerProductLicensesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LicenseManagerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LicenseManagerProductLicensesDataSourceCrud struct {
@@ -62,7 +63,7 @@ func (s *LicenseManagerProductLicensesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LicenseManagerProductLicensesDataSourceCrud) Get() error {
+func (s *LicenseManagerProductLicensesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_license_manager.ListProductLicensesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -77,7 +78,7 @@ func (s *LicenseManagerProductLicensesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "license_manager")
 
-	response, err := s.Client.ListProductLicenses(context.Background(), request)
+	response, err := s.Client.ListProductLicenses(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *LicenseManagerProductLicensesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListProductLicenses(context.Background(), request)
+		listResponse, err := s.Client.ListProductLicenses(ctx, request)
 		if err != nil {
 			return err
 		}
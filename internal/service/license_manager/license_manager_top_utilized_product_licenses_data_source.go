@@ -6,6 +6,7 @@ package license_manager
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_license_manager "github.com/oracle/oci-go-sdk/v65/licensemanager"
 
@@ -15,7 +16,7 @@ import (
 
 func LicenseManagerTopUtilizedProductLicensesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLicenseManagerTopUtilizedProductLicenses,
+		ReadContext: readLicenseManagerTopUtilizedProductLicenses,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -71,12 +72,12 @@ func LicenseManagerTopUtilizedProductLicensesDataSource() *schema.Resource {
 	}
 }
 
-func readLicenseManagerTopUtilizedProductLicenses(d *schema.ResourceData, m interface{}) error {
+func readLicenseManagerTopUtilizedProductLicenses(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LicenseManagerTopUtilizedProductLicensesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LicenseManagerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LicenseManagerTopUtilizedProductLicensesDataSourceCrud struct {
@@ -89,7 +90,7 @@ func (s *LicenseManagerTopUtilizedProductLicensesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LicenseManagerTopUtilizedProductLicensesDataSourceCrud) Get() error {
+func (s *LicenseManagerTopUtilizedProductLicensesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_license_manager.ListTopUtilizedProductLicensesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -104,7 +105,7 @@ func (s *LicenseManagerTopUtilizedProductLicensesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "license_manager")
 
-	response, err := s.Client.ListTopUtilizedProductLicenses(context.Background(), request)
+	response, err := s.Client.ListTopUtilizedProductLicenses(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package license_manager
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_license_manager "github.com/oracle/oci-go-sdk/v65/licensemanager"
 
@@ -15,7 +16,7 @@ import (
 
 func LicenseManagerTopUtilizedResourcesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLicenseManagerTopUtilizedResources,
+		ReadContext: readLicenseManagerTopUtilizedResources,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -71,12 +72,12 @@ func LicenseManagerTopUtilizedResourcesDataSource() *schema.Resource {
 	}
 }
 
-func readLicenseManagerTopUtilizedResources(d *schema.ResourceData, m interface{}) error {
+func readLicenseManagerTopUtilizedResources(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LicenseManagerTopUtilizedResourcesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LicenseManagerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LicenseManagerTopUtilizedResourcesDataSourceCrud struct {
@@ -89,7 +90,7 @@ func (s *LicenseManagerTopUtilizedResourcesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LicenseManagerTopUtilizedResourcesDataSourceCrud) Get() error {
+func (s *LicenseManagerTopUtilizedResourcesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_license_manager.ListTopUtilizedResourcesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -108,7 +109,7 @@ func (s *LicenseManagerTopUtilizedResourcesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "license_manager")
 
-	response, err := s.Client.ListTopUtilizedResources(context.Background(), request)
+	response, err := s.Client.ListTopUtilizedResources(ctx, request)
 	if err != nil {
 		return err
 	}
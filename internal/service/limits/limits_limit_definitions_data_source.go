@@ -8,6 +8,7 @@ import (
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_limits "github.com/oracle/oci-go-sdk/v65/limits"
 
@@ -16,7 +17,7 @@ import (
 
 func LimitsLimitDefinitionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLimitsLimitDefinitions,
+		ReadContext: readLimitsLimitDefinitions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -84,12 +85,12 @@ func LimitsLimitDefinitionsDataSource() *schema.Resource {
 	}
 }
 
-func readLimitsLimitDefinitions(d *schema.ResourceData, m interface{}) error {
+func readLimitsLimitDefinitions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LimitsLimitDefinitionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LimitsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LimitsLimitDefinitionsDataSourceCrud struct {
@@ -102,7 +103,7 @@ func (s *LimitsLimitDefinitionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LimitsLimitDefinitionsDataSourceCrud) Get() error {
+func (s *LimitsLimitDefinitionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_limits.ListLimitDefinitionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -122,7 +123,7 @@ func (s *LimitsLimitDefinitionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "limits")
 
-	response, err := s.Client.ListLimitDefinitions(context.Background(), request)
+	response, err := s.Client.ListLimitDefinitions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -131,7 +132,7 @@ func (s *LimitsLimitDefinitionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListLimitDefinitions(context.Background(), request)
+		listResponse, err := s.Client.ListLimitDefinitions(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_limits "github.com/oracle/oci-go-sdk/v65/limits"
 
@@ -17,7 +18,7 @@ import (
 
 func LimitsLimitValuesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLimitsLimitValues,
+		ReadContext: readLimitsLimitValues,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -73,12 +74,12 @@ func LimitsLimitValuesDataSource() *schema.Resource {
 	}
 }
 
-func readLimitsLimitValues(d *schema.ResourceData, m interface{}) error {
+func readLimitsLimitValues(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LimitsLimitValuesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LimitsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LimitsLimitValuesDataSourceCrud struct {
@@ -91,7 +92,7 @@ func (s *LimitsLimitValuesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LimitsLimitValuesDataSourceCrud) Get() error {
+func (s *LimitsLimitValuesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_limits.ListLimitValuesRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -120,7 +121,7 @@ func (s *LimitsLimitValuesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "limits")
 
-	response, err := s.Client.ListLimitValues(context.Background(), request)
+	response, err := s.Client.ListLimitValues(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -129,7 +130,7 @@ func (s *LimitsLimitValuesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListLimitValues(context.Background(), request)
+		listResponse, err := s.Client.ListLimitValues(ctx, request)
 		if err != nil {
 			return err
 		}
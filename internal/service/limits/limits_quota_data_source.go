@@ -8,6 +8,7 @@ import (
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_limits "github.com/oracle/oci-go-sdk/v65/limits"
 
@@ -23,12 +24,12 @@ func LimitsQuotaDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(LimitsQuotaResource(), fieldMap, readSingularLimitsQuota)
 }
 
-func readSingularLimitsQuota(d *schema.ResourceData, m interface{}) error {
+func readSingularLimitsQuota(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LimitsQuotaDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).QuotasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LimitsQuotaDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *LimitsQuotaDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LimitsQuotaDataSourceCrud) Get() error {
+func (s *LimitsQuotaDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_limits.GetQuotaRequest{}
 
 	if quotaId, ok := s.D.GetOkExists("quota_id"); ok {
@@ -51,7 +52,7 @@ func (s *LimitsQuotaDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "limits")
 
-	response, err := s.Client.GetQuota(context.Background(), request)
+	response, err := s.Client.GetQuota(ctx, request)
 	if err != nil {
 		return err
 	}
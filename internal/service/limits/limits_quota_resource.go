@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_limits "github.com/oracle/oci-go-sdk/v65/limits"
@@ -20,11 +21,11 @@ func LimitsQuotaResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLimitsQuota,
-		Read:     readLimitsQuota,
-		Update:   updateLimitsQuota,
-		Delete:   deleteLimitsQuota,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLimitsQuota,
+		ReadContext:   readLimitsQuota,
+		UpdateContext: updateLimitsQuota,
+		DeleteContext: deleteLimitsQuota,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -118,37 +119,37 @@ func LimitsQuotaResource() *schema.Resource {
 	}
 }
 
-func createLimitsQuota(d *schema.ResourceData, m interface{}) error {
+func createLimitsQuota(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LimitsQuotaResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).QuotasClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readLimitsQuota(d *schema.ResourceData, m interface{}) error {
+func readLimitsQuota(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LimitsQuotaResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).QuotasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateLimitsQuota(d *schema.ResourceData, m interface{}) error {
+func updateLimitsQuota(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LimitsQuotaResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).QuotasClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteLimitsQuota(d *schema.ResourceData, m interface{}) error {
+func deleteLimitsQuota(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LimitsQuotaResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).QuotasClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type LimitsQuotaResourceCrud struct {
@@ -180,7 +181,7 @@ func (s *LimitsQuotaResourceCrud) DeletedTarget() []string {
 	return []string{}
 }
 
-func (s *LimitsQuotaResourceCrud) Create() error {
+func (s *LimitsQuotaResourceCrud) Create(ctx context.Context) error {
 	request := oci_limits.CreateQuotaRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -242,7 +243,7 @@ func (s *LimitsQuotaResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "limits")
 
-	response, err := s.Client.CreateQuota(context.Background(), request)
+	response, err := s.Client.CreateQuota(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -251,7 +252,7 @@ func (s *LimitsQuotaResourceCrud) Create() error {
 	return nil
 }
 
-func (s *LimitsQuotaResourceCrud) Get() error {
+func (s *LimitsQuotaResourceCrud) Get(ctx context.Context) error {
 	request := oci_limits.GetQuotaRequest{}
 
 	tmp := s.D.Id()
@@ -259,7 +260,7 @@ func (s *LimitsQuotaResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "limits")
 
-	response, err := s.Client.GetQuota(context.Background(), request)
+	response, err := s.Client.GetQuota(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -268,7 +269,7 @@ func (s *LimitsQuotaResourceCrud) Get() error {
 	return nil
 }
 
-func (s *LimitsQuotaResourceCrud) Update() error {
+func (s *LimitsQuotaResourceCrud) Update(ctx context.Context) error {
 	request := oci_limits.UpdateQuotaRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -314,7 +315,7 @@ func (s *LimitsQuotaResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "limits")
 
-	response, err := s.Client.UpdateQuota(context.Background(), request)
+	response, err := s.Client.UpdateQuota(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -323,7 +324,7 @@ func (s *LimitsQuotaResourceCrud) Update() error {
 	return nil
 }
 
-func (s *LimitsQuotaResourceCrud) Delete() error {
+func (s *LimitsQuotaResourceCrud) Delete(ctx context.Context) error {
 	request := oci_limits.DeleteQuotaRequest{}
 
 	if locks, ok := s.D.GetOkExists("locks"); ok {
@@ -339,7 +340,7 @@ func (s *LimitsQuotaResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "limits")
 
-	_, err := s.Client.DeleteQuota(context.Background(), request)
+	_, err := s.Client.DeleteQuota(ctx, request)
 	return err
 }
 
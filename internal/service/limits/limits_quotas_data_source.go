@@ -8,6 +8,7 @@ import (
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_limits "github.com/oracle/oci-go-sdk/v65/limits"
 
@@ -16,7 +17,7 @@ import (
 
 func LimitsQuotasDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLimitsQuotas,
+		ReadContext: readLimitsQuotas,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -40,12 +41,12 @@ func LimitsQuotasDataSource() *schema.Resource {
 	}
 }
 
-func readLimitsQuotas(d *schema.ResourceData, m interface{}) error {
+func readLimitsQuotas(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LimitsQuotasDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).QuotasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LimitsQuotasDataSourceCrud struct {
@@ -58,7 +59,7 @@ func (s *LimitsQuotasDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LimitsQuotasDataSourceCrud) Get() error {
+func (s *LimitsQuotasDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_limits.ListQuotasRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -77,7 +78,7 @@ func (s *LimitsQuotasDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "limits")
 
-	response, err := s.Client.ListQuotas(context.Background(), request)
+	response, err := s.Client.ListQuotas(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *LimitsQuotasDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListQuotas(context.Background(), request)
+		listResponse, err := s.Client.ListQuotas(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_limits "github.com/oracle/oci-go-sdk/v65/limits"
 
@@ -17,7 +18,7 @@ import (
 
 func LimitsResourceAvailabilityDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularLimitsResourceAvailability,
+		ReadContext: readSingularLimitsResourceAvailability,
 		Schema: map[string]*schema.Schema{
 			"availability_domain": {
 				Type:             schema.TypeString,
@@ -61,12 +62,12 @@ func LimitsResourceAvailabilityDataSource() *schema.Resource {
 	}
 }
 
-func readSingularLimitsResourceAvailability(d *schema.ResourceData, m interface{}) error {
+func readSingularLimitsResourceAvailability(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LimitsResourceAvailabilityDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LimitsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LimitsResourceAvailabilityDataSourceCrud struct {
@@ -79,7 +80,7 @@ func (s *LimitsResourceAvailabilityDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LimitsResourceAvailabilityDataSourceCrud) Get() error {
+func (s *LimitsResourceAvailabilityDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_limits.GetResourceAvailabilityRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -104,7 +105,7 @@ func (s *LimitsResourceAvailabilityDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "limits")
 
-	response, err := s.Client.GetResourceAvailability(context.Background(), request)
+	response, err := s.Client.GetResourceAvailability(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_limits "github.com/oracle/oci-go-sdk/v65/limits"
 
@@ -16,7 +17,7 @@ import (
 
 func LimitsServicesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLimitsServices,
+		ReadContext: readLimitsServices,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,12 +49,12 @@ func LimitsServicesDataSource() *schema.Resource {
 	}
 }
 
-func readLimitsServices(d *schema.ResourceData, m interface{}) error {
+func readLimitsServices(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LimitsServicesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LimitsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LimitsServicesDataSourceCrud struct {
@@ -66,7 +67,7 @@ func (s *LimitsServicesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LimitsServicesDataSourceCrud) Get() error {
+func (s *LimitsServicesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_limits.ListServicesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -76,7 +77,7 @@ func (s *LimitsServicesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "limits")
 
-	response, err := s.Client.ListServices(context.Background(), request)
+	response, err := s.Client.ListServices(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *LimitsServicesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListServices(context.Background(), request)
+		listResponse, err := s.Client.ListServices(ctx, request)
 		if err != nil {
 			return err
 		}
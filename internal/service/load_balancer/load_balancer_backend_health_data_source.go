@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_load_balancer "github.com/oracle/oci-go-sdk/v65/loadbalancer"
 )
 
 func LoadBalancerBackendHealthDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularLoadBalancerBackendHealth,
+		ReadContext: readSingularLoadBalancerBackendHealth,
 		Schema: map[string]*schema.Schema{
 			"backend_name": {
 				Type:     schema.TypeString,
@@ -67,12 +68,12 @@ func LoadBalancerBackendHealthDataSource() *schema.Resource {
 	}
 }
 
-func readSingularLoadBalancerBackendHealth(d *schema.ResourceData, m interface{}) error {
+func readSingularLoadBalancerBackendHealth(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerBackendHealthDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LoadBalancerBackendHealthDataSourceCrud struct {
@@ -85,7 +86,7 @@ func (s *LoadBalancerBackendHealthDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LoadBalancerBackendHealthDataSourceCrud) Get() error {
+func (s *LoadBalancerBackendHealthDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_load_balancer.GetBackendHealthRequest{}
 
 	if backendName, ok := s.D.GetOkExists("backend_name"); ok {
@@ -105,7 +106,7 @@ func (s *LoadBalancerBackendHealthDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "load_balancer")
 
-	response, err := s.Client.GetBackendHealth(context.Background(), request)
+	response, err := s.Client.GetBackendHealth(ctx, request)
 	if err != nil {
 		return err
 	}
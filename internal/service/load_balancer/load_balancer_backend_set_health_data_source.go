@@ -6,6 +6,7 @@ package load_balancer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_load_balancer "github.com/oracle/oci-go-sdk/v65/loadbalancer"
 
@@ -15,7 +16,7 @@ import (
 
 func LoadBalancerBackendSetHealthDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularLoadBalancerBackendSetHealth,
+		ReadContext: readSingularLoadBalancerBackendSetHealth,
 		Schema: map[string]*schema.Schema{
 			"backend_set_name": {
 				Type:     schema.TypeString,
@@ -59,12 +60,12 @@ func LoadBalancerBackendSetHealthDataSource() *schema.Resource {
 	}
 }
 
-func readSingularLoadBalancerBackendSetHealth(d *schema.ResourceData, m interface{}) error {
+func readSingularLoadBalancerBackendSetHealth(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerBackendSetHealthDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LoadBalancerBackendSetHealthDataSourceCrud struct {
@@ -77,7 +78,7 @@ func (s *LoadBalancerBackendSetHealthDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LoadBalancerBackendSetHealthDataSourceCrud) Get() error {
+func (s *LoadBalancerBackendSetHealthDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_load_balancer.GetBackendSetHealthRequest{}
 
 	if backendSetName, ok := s.D.GetOkExists("backend_set_name"); ok {
@@ -92,7 +93,7 @@ func (s *LoadBalancerBackendSetHealthDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "load_balancer")
 
-	response, err := s.Client.GetBackendSetHealth(context.Background(), request)
+	response, err := s.Client.GetBackendSetHealth(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -90,7 +90,7 @@ func findLoadBalancerListeners(ctx *tf_export.ResourceDiscoveryContext, tfMeta *
 		// This calls into the listener resource's Read fn which has the unfortunate implementation of
 		// calling GetLoadBalancer and looping through the listeners to find the expected one. So this entire method
 		// may require O(n^^2) time. However, the benefits of having Read populate the ResourceData struct is better than duplicating it here.
-		if err := listenerResource.Read(d, ctx.Clients); err != nil {
+		if err := tfresource.ReadSchemaResource(listenerResource, d, ctx.Clients); err != nil {
 			// add error to the errorList and continue discovering rest of the resources
 			rdError := &tf_export.ResourceDiscoveryError{ResourceType: tfMeta.ResourceClass, ParentResource: parent.TerraformName, Error: err, ResourceGraph: resourceGraph}
 			ctx.AddErrorToList(rdError)
@@ -6,6 +6,7 @@ package load_balancer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_load_balancer "github.com/oracle/oci-go-sdk/v65/loadbalancer"
 
@@ -15,7 +16,7 @@ import (
 
 func LoadBalancerLoadBalancerHealthDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularLoadBalancerLoadBalancerHealth,
+		ReadContext: readSingularLoadBalancerLoadBalancerHealth,
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
 				Type:     schema.TypeString,
@@ -55,12 +56,12 @@ func LoadBalancerLoadBalancerHealthDataSource() *schema.Resource {
 	}
 }
 
-func readSingularLoadBalancerLoadBalancerHealth(d *schema.ResourceData, m interface{}) error {
+func readSingularLoadBalancerLoadBalancerHealth(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerLoadBalancerHealthDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LoadBalancerLoadBalancerHealthDataSourceCrud struct {
@@ -73,7 +74,7 @@ func (s *LoadBalancerLoadBalancerHealthDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LoadBalancerLoadBalancerHealthDataSourceCrud) Get() error {
+func (s *LoadBalancerLoadBalancerHealthDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_load_balancer.GetLoadBalancerHealthRequest{}
 
 	if loadBalancerId, ok := s.D.GetOkExists("load_balancer_id"); ok {
@@ -83,7 +84,7 @@ func (s *LoadBalancerLoadBalancerHealthDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "load_balancer")
 
-	response, err := s.Client.GetLoadBalancerHealth(context.Background(), request)
+	response, err := s.Client.GetLoadBalancerHealth(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -24,11 +25,11 @@ func LoadBalancerHostnameResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLoadBalancerHostname,
-		Read:     readLoadBalancerHostname,
-		Update:   updateLoadBalancerHostname,
-		Delete:   deleteLoadBalancerHostname,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLoadBalancerHostname,
+		ReadContext:   readLoadBalancerHostname,
+		UpdateContext: updateLoadBalancerHostname,
+		DeleteContext: deleteLoadBalancerHostname,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"hostname": {
@@ -58,37 +59,37 @@ func LoadBalancerHostnameResource() *schema.Resource {
 	}
 }
 
-func createLoadBalancerHostname(d *schema.ResourceData, m interface{}) error {
+func createLoadBalancerHostname(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerHostnameResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readLoadBalancerHostname(d *schema.ResourceData, m interface{}) error {
+func readLoadBalancerHostname(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerHostnameResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateLoadBalancerHostname(d *schema.ResourceData, m interface{}) error {
+func updateLoadBalancerHostname(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerHostnameResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteLoadBalancerHostname(d *schema.ResourceData, m interface{}) error {
+func deleteLoadBalancerHostname(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerHostnameResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type LoadBalancerHostnameResourceCrud struct {
@@ -138,7 +139,7 @@ func (s *LoadBalancerHostnameResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *LoadBalancerHostnameResourceCrud) Create() error {
+func (s *LoadBalancerHostnameResourceCrud) Create(ctx context.Context) error {
 	request := oci_load_balancer.CreateHostnameRequest{}
 
 	if hostname, ok := s.D.GetOkExists("hostname"); ok {
@@ -158,7 +159,7 @@ func (s *LoadBalancerHostnameResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.CreateHostname(context.Background(), request)
+	response, err := s.Client.CreateHostname(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -167,20 +168,20 @@ func (s *LoadBalancerHostnameResourceCrud) Create() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = loadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	err = loadBalancerWaitForWorkRequest(ctx, s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (s *LoadBalancerHostnameResourceCrud) Get() error {
-	_, stillWorking, err := loadBalancerResourceGet(s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+func (s *LoadBalancerHostnameResourceCrud) Get(ctx context.Context) error {
+	_, stillWorking, err := loadBalancerResourceGet(ctx, s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -211,7 +212,7 @@ func (s *LoadBalancerHostnameResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.GetHostname(context.Background(), request)
+	response, err := s.Client.GetHostname(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -220,7 +221,7 @@ func (s *LoadBalancerHostnameResourceCrud) Get() error {
 	return nil
 }
 
-func (s *LoadBalancerHostnameResourceCrud) Update() error {
+func (s *LoadBalancerHostnameResourceCrud) Update(ctx context.Context) error {
 	request := oci_load_balancer.UpdateHostnameRequest{}
 
 	if hostname, ok := s.D.GetOkExists("hostname"); ok {
@@ -240,7 +241,7 @@ func (s *LoadBalancerHostnameResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.UpdateHostname(context.Background(), request)
+	response, err := s.Client.UpdateHostname(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -249,20 +250,20 @@ func (s *LoadBalancerHostnameResourceCrud) Update() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = loadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	err = loadBalancerWaitForWorkRequest(ctx, s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *LoadBalancerHostnameResourceCrud) Delete() error {
+func (s *LoadBalancerHostnameResourceCrud) Delete(ctx context.Context) error {
 	request := oci_load_balancer.DeleteHostnameRequest{}
 
 	if loadBalancerId, ok := s.D.GetOkExists("load_balancer_id"); ok {
@@ -277,7 +278,7 @@ func (s *LoadBalancerHostnameResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.DeleteHostname(context.Background(), request)
+	response, err := s.Client.DeleteHostname(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -286,12 +287,12 @@ func (s *LoadBalancerHostnameResourceCrud) Delete() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = loadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	err = loadBalancerWaitForWorkRequest(ctx, s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
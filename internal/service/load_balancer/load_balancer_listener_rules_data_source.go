@@ -6,6 +6,7 @@ package load_balancer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_load_balancer "github.com/oracle/oci-go-sdk/v65/loadbalancer"
 
@@ -15,7 +16,7 @@ import (
 
 func LoadBalancerListenerRulesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLoadBalancerListenerRules,
+		ReadContext: readLoadBalancerListenerRules,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"listener_name": {
@@ -170,12 +171,12 @@ func LoadBalancerListenerRulesDataSource() *schema.Resource {
 	}
 }
 
-func readLoadBalancerListenerRules(d *schema.ResourceData, m interface{}) error {
+func readLoadBalancerListenerRules(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerListenerRulesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LoadBalancerListenerRulesDataSourceCrud struct {
@@ -188,7 +189,7 @@ func (s *LoadBalancerListenerRulesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LoadBalancerListenerRulesDataSourceCrud) Get() error {
+func (s *LoadBalancerListenerRulesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_load_balancer.ListListenerRulesRequest{}
 
 	if listenerName, ok := s.D.GetOkExists("listener_name"); ok {
@@ -203,7 +204,7 @@ func (s *LoadBalancerListenerRulesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "load_balancer")
 
-	response, err := s.Client.ListListenerRules(context.Background(), request)
+	response, err := s.Client.ListListenerRules(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -21,11 +22,11 @@ func LoadBalancerLoadBalancerResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLoadBalancerLoadBalancer,
-		Read:     readLoadBalancerLoadBalancer,
-		Update:   updateLoadBalancerLoadBalancer,
-		Delete:   deleteLoadBalancerLoadBalancer,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLoadBalancerLoadBalancer,
+		ReadContext:   readLoadBalancerLoadBalancer,
+		UpdateContext: updateLoadBalancerLoadBalancer,
+		DeleteContext: deleteLoadBalancerLoadBalancer,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -192,37 +193,37 @@ func LoadBalancerLoadBalancerResource() *schema.Resource {
 	}
 }
 
-func createLoadBalancerLoadBalancer(d *schema.ResourceData, m interface{}) error {
+func createLoadBalancerLoadBalancer(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerLoadBalancerResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readLoadBalancerLoadBalancer(d *schema.ResourceData, m interface{}) error {
+func readLoadBalancerLoadBalancer(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerLoadBalancerResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateLoadBalancerLoadBalancer(d *schema.ResourceData, m interface{}) error {
+func updateLoadBalancerLoadBalancer(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerLoadBalancerResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteLoadBalancerLoadBalancer(d *schema.ResourceData, m interface{}) error {
+func deleteLoadBalancerLoadBalancer(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerLoadBalancerResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type LoadBalancerLoadBalancerResourceCrud struct {
@@ -275,7 +276,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *LoadBalancerLoadBalancerResourceCrud) Create() error {
+func (s *LoadBalancerLoadBalancerResourceCrud) Create(ctx context.Context) error {
 	request := oci_load_balancer.CreateLoadBalancerRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -371,7 +372,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.CreateLoadBalancer(context.Background(), request)
+	response, err := s.Client.CreateLoadBalancer(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -380,20 +381,20 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Create() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = loadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	err = loadBalancerWaitForWorkRequest(ctx, s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (s *LoadBalancerLoadBalancerResourceCrud) Get() error {
-	id, stillWorking, err := loadBalancerResourceGet(s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+func (s *LoadBalancerLoadBalancerResourceCrud) Get(ctx context.Context) error {
+	id, stillWorking, err := loadBalancerResourceGet(ctx, s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -412,7 +413,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.GetLoadBalancer(context.Background(), request)
+	response, err := s.Client.GetLoadBalancer(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -421,11 +422,11 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Get() error {
 	return nil
 }
 
-func (s *LoadBalancerLoadBalancerResourceCrud) Update() error {
+func (s *LoadBalancerLoadBalancerResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -435,7 +436,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Update() error {
 	if shape, ok := s.D.GetOkExists("shape"); ok && s.D.HasChange("shape") {
 		oldRaw, newRaw := s.D.GetChange("shape")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateShape(shape)
+			err := s.updateShape(ctx, shape)
 			if err != nil {
 				return err
 			}
@@ -443,7 +444,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Update() error {
 	} else if _, ok := s.D.GetOkExists("shape_details"); ok && s.D.HasChange("shape_details") {
 		if shape, ok := s.D.GetOkExists("shape"); ok {
 			if shape == "flexible" {
-				err := s.updateShape(shape)
+				err := s.updateShape(ctx, shape)
 				if err != nil {
 					return err
 				}
@@ -452,7 +453,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Update() error {
 	}
 
 	if s.D.HasChange("network_security_group_ids") {
-		err := s.updateNetworkSecurityGroups()
+		err := s.updateNetworkSecurityGroups(ctx)
 		if err != nil {
 			return fmt.Errorf("unable to update 'network_security_group_ids', error: %v", err)
 		}
@@ -481,7 +482,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.UpdateLoadBalancer(context.Background(), request)
+	response, err := s.Client.UpdateLoadBalancer(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -490,20 +491,20 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Update() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = loadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	err = loadBalancerWaitForWorkRequest(ctx, s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *LoadBalancerLoadBalancerResourceCrud) Delete() error {
+func (s *LoadBalancerLoadBalancerResourceCrud) Delete(ctx context.Context) error {
 	request := oci_load_balancer.DeleteLoadBalancerRequest{}
 
 	tmp := s.D.Id()
@@ -511,7 +512,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.DeleteLoadBalancer(context.Background(), request)
+	response, err := s.Client.DeleteLoadBalancer(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -520,12 +521,12 @@ func (s *LoadBalancerLoadBalancerResourceCrud) Delete() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = loadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	err = loadBalancerWaitForWorkRequest(ctx, s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -680,7 +681,7 @@ func isIPV4(ipAddress string) bool {
 	return strings.Contains(ipAddress, ".")
 }
 
-func (s *LoadBalancerLoadBalancerResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *LoadBalancerLoadBalancerResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_load_balancer.ChangeLoadBalancerCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -691,19 +692,19 @@ func (s *LoadBalancerLoadBalancerResourceCrud) updateCompartment(compartment int
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	_, err := s.Client.ChangeLoadBalancerCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeLoadBalancerCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
 	return nil
 }
 
-func (s *LoadBalancerLoadBalancerResourceCrud) updateNetworkSecurityGroups() error {
+func (s *LoadBalancerLoadBalancerResourceCrud) updateNetworkSecurityGroups(ctx context.Context) error {
 	updateNsgIdsRequest := oci_load_balancer.UpdateNetworkSecurityGroupsRequest{}
 
 	//@Codegen: Unless explicitly specified by the user, network_security_group_ids will not be supplied as the feature may or may not be supported
@@ -724,7 +725,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) updateNetworkSecurityGroups() err
 
 	updateNsgIdsRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.UpdateNetworkSecurityGroups(context.Background(), updateNsgIdsRequest)
+	response, err := s.Client.UpdateNetworkSecurityGroups(ctx, updateNsgIdsRequest)
 	if err != nil {
 		return err
 	}
@@ -733,19 +734,19 @@ func (s *LoadBalancerLoadBalancerResourceCrud) updateNetworkSecurityGroups() err
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = loadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	err = loadBalancerWaitForWorkRequest(ctx, s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (s *LoadBalancerLoadBalancerResourceCrud) updateShape(shape interface{}) error {
+func (s *LoadBalancerLoadBalancerResourceCrud) updateShape(ctx context.Context, shape interface{}) error {
 	changeShapeRequest := oci_load_balancer.UpdateLoadBalancerShapeRequest{}
 
 	shapeTmp := shape.(string)
@@ -767,7 +768,7 @@ func (s *LoadBalancerLoadBalancerResourceCrud) updateShape(shape interface{}) er
 		}
 	}
 
-	response, err := s.Client.UpdateLoadBalancerShape(context.Background(), changeShapeRequest)
+	response, err := s.Client.UpdateLoadBalancerShape(ctx, changeShapeRequest)
 	if err != nil {
 		return err
 	}
@@ -775,12 +776,12 @@ func (s *LoadBalancerLoadBalancerResourceCrud) updateShape(shape interface{}) er
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = loadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	err = loadBalancerWaitForWorkRequest(ctx, s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
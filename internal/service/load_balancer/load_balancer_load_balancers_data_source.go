@@ -6,6 +6,7 @@ package load_balancer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_load_balancer "github.com/oracle/oci-go-sdk/v65/loadbalancer"
 
@@ -15,7 +16,7 @@ import (
 
 func LoadBalancerLoadBalancersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLoadBalancerLoadBalancers,
+		ReadContext: readLoadBalancerLoadBalancers,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,12 +44,12 @@ func LoadBalancerLoadBalancersDataSource() *schema.Resource {
 	}
 }
 
-func readLoadBalancerLoadBalancers(d *schema.ResourceData, m interface{}) error {
+func readLoadBalancerLoadBalancers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerLoadBalancersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LoadBalancerLoadBalancersDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *LoadBalancerLoadBalancersDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LoadBalancerLoadBalancersDataSourceCrud) Get() error {
+func (s *LoadBalancerLoadBalancersDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_load_balancer.ListLoadBalancersRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -85,7 +86,7 @@ func (s *LoadBalancerLoadBalancersDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "load_balancer")
 
-	response, err := s.Client.ListLoadBalancers(context.Background(), request)
+	response, err := s.Client.ListLoadBalancers(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *LoadBalancerLoadBalancersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListLoadBalancers(context.Background(), request)
+		listResponse, err := s.Client.ListLoadBalancers(ctx, request)
 		if err != nil {
 			return err
 		}
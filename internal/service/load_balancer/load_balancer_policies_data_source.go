@@ -6,6 +6,7 @@ package load_balancer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_load_balancer "github.com/oracle/oci-go-sdk/v65/loadbalancer"
 
@@ -15,7 +16,7 @@ import (
 
 func LoadBalancerLoadBalancerPoliciesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLoadBalancerLoadBalancerPolicies,
+		ReadContext: readLoadBalancerLoadBalancerPolicies,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,12 +44,12 @@ func LoadBalancerLoadBalancerPoliciesDataSource() *schema.Resource {
 	}
 }
 
-func readLoadBalancerLoadBalancerPolicies(d *schema.ResourceData, m interface{}) error {
+func readLoadBalancerLoadBalancerPolicies(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerLoadBalancerPoliciesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LoadBalancerLoadBalancerPoliciesDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *LoadBalancerLoadBalancerPoliciesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LoadBalancerLoadBalancerPoliciesDataSourceCrud) Get() error {
+func (s *LoadBalancerLoadBalancerPoliciesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_load_balancer.ListPoliciesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -71,7 +72,7 @@ func (s *LoadBalancerLoadBalancerPoliciesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "load_balancer")
 
-	response, err := s.Client.ListPolicies(context.Background(), request)
+	response, err := s.Client.ListPolicies(ctx, request)
 	if err != nil {
 		return err
 	}
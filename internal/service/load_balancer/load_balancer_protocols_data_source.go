@@ -6,6 +6,7 @@ package load_balancer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_load_balancer "github.com/oracle/oci-go-sdk/v65/loadbalancer"
 
@@ -15,7 +16,7 @@ import (
 
 func LoadBalancerLoadBalancerProtocolsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLoadBalancerLoadBalancerProtocols,
+		ReadContext: readLoadBalancerLoadBalancerProtocols,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,12 +44,12 @@ func LoadBalancerLoadBalancerProtocolsDataSource() *schema.Resource {
 	}
 }
 
-func readLoadBalancerLoadBalancerProtocols(d *schema.ResourceData, m interface{}) error {
+func readLoadBalancerLoadBalancerProtocols(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerLoadBalancerProtocolsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LoadBalancerLoadBalancerProtocolsDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *LoadBalancerLoadBalancerProtocolsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LoadBalancerLoadBalancerProtocolsDataSourceCrud) Get() error {
+func (s *LoadBalancerLoadBalancerProtocolsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_load_balancer.ListProtocolsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -71,7 +72,7 @@ func (s *LoadBalancerLoadBalancerProtocolsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "load_balancer")
 
-	response, err := s.Client.ListProtocols(context.Background(), request)
+	response, err := s.Client.ListProtocols(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -28,11 +29,11 @@ func LoadBalancerRuleSetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLoadBalancerRuleSet,
-		Read:     readLoadBalancerRuleSet,
-		Update:   updateLoadBalancerRuleSet,
-		Delete:   deleteLoadBalancerRuleSet,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLoadBalancerRuleSet,
+		ReadContext:   readLoadBalancerRuleSet,
+		UpdateContext: updateLoadBalancerRuleSet,
+		DeleteContext: deleteLoadBalancerRuleSet,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"items": {
@@ -218,37 +219,37 @@ func LoadBalancerRuleSetResource() *schema.Resource {
 	}
 }
 
-func createLoadBalancerRuleSet(d *schema.ResourceData, m interface{}) error {
+func createLoadBalancerRuleSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerRuleSetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readLoadBalancerRuleSet(d *schema.ResourceData, m interface{}) error {
+func readLoadBalancerRuleSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerRuleSetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateLoadBalancerRuleSet(d *schema.ResourceData, m interface{}) error {
+func updateLoadBalancerRuleSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerRuleSetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteLoadBalancerRuleSet(d *schema.ResourceData, m interface{}) error {
+func deleteLoadBalancerRuleSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerRuleSetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type LoadBalancerRuleSetResourceCrud struct {
@@ -298,7 +299,7 @@ func (s *LoadBalancerRuleSetResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *LoadBalancerRuleSetResourceCrud) Create() error {
+func (s *LoadBalancerRuleSetResourceCrud) Create(ctx context.Context) error {
 	request := oci_load_balancer.CreateRuleSetRequest{}
 
 	if items, ok := s.D.GetOkExists("items"); ok {
@@ -331,7 +332,7 @@ func (s *LoadBalancerRuleSetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.CreateRuleSet(context.Background(), request)
+	response, err := s.Client.CreateRuleSet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -340,20 +341,20 @@ func (s *LoadBalancerRuleSetResourceCrud) Create() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = loadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	err = loadBalancerWaitForWorkRequest(ctx, s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (s *LoadBalancerRuleSetResourceCrud) Get() error {
-	_, stillWorking, err := loadBalancerResourceGet(s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+func (s *LoadBalancerRuleSetResourceCrud) Get(ctx context.Context) error {
+	_, stillWorking, err := loadBalancerResourceGet(ctx, s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -384,7 +385,7 @@ func (s *LoadBalancerRuleSetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.GetRuleSet(context.Background(), request)
+	response, err := s.Client.GetRuleSet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -393,7 +394,7 @@ func (s *LoadBalancerRuleSetResourceCrud) Get() error {
 	return nil
 }
 
-func (s *LoadBalancerRuleSetResourceCrud) Update() error {
+func (s *LoadBalancerRuleSetResourceCrud) Update(ctx context.Context) error {
 	request := oci_load_balancer.UpdateRuleSetRequest{}
 
 	if items, ok := s.D.GetOkExists("items"); ok {
@@ -426,7 +427,7 @@ func (s *LoadBalancerRuleSetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.UpdateRuleSet(context.Background(), request)
+	response, err := s.Client.UpdateRuleSet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -435,20 +436,20 @@ func (s *LoadBalancerRuleSetResourceCrud) Update() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = loadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	err = loadBalancerWaitForWorkRequest(ctx, s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *LoadBalancerRuleSetResourceCrud) Delete() error {
+func (s *LoadBalancerRuleSetResourceCrud) Delete(ctx context.Context) error {
 	request := oci_load_balancer.DeleteRuleSetRequest{}
 
 	if loadBalancerId, ok := s.D.GetOkExists("load_balancer_id"); ok {
@@ -463,7 +464,7 @@ func (s *LoadBalancerRuleSetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.DeleteRuleSet(context.Background(), request)
+	response, err := s.Client.DeleteRuleSet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -472,12 +473,12 @@ func (s *LoadBalancerRuleSetResourceCrud) Delete() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = loadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	err = loadBalancerWaitForWorkRequest(ctx, s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
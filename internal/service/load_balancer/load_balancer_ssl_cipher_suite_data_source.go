@@ -6,6 +6,7 @@ package load_balancer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_load_balancer "github.com/oracle/oci-go-sdk/v65/loadbalancer"
 
@@ -26,12 +27,12 @@ func LoadBalancerSslCipherSuiteDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(LoadBalancerSslCipherSuiteResource(), fieldMap, readSingularLoadBalancerSslCipherSuite)
 }
 
-func readSingularLoadBalancerSslCipherSuite(d *schema.ResourceData, m interface{}) error {
+func readSingularLoadBalancerSslCipherSuite(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerSslCipherSuiteDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LoadBalancerSslCipherSuiteDataSourceCrud struct {
@@ -44,7 +45,7 @@ func (s *LoadBalancerSslCipherSuiteDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LoadBalancerSslCipherSuiteDataSourceCrud) Get() error {
+func (s *LoadBalancerSslCipherSuiteDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_load_balancer.GetSSLCipherSuiteRequest{}
 
 	if loadBalancerId, ok := s.D.GetOkExists("load_balancer_id"); ok {
@@ -59,7 +60,7 @@ func (s *LoadBalancerSslCipherSuiteDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "load_balancer")
 
-	response, err := s.Client.GetSSLCipherSuite(context.Background(), request)
+	response, err := s.Client.GetSSLCipherSuite(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -24,10 +25,10 @@ func LoadBalancerSslCipherSuiteResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLoadBalancerSslCipherSuite,
-		Read:     readLoadBalancerSslCipherSuite,
-		Delete:   deleteLoadBalancerSslCipherSuite,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLoadBalancerSslCipherSuite,
+		ReadContext:   readLoadBalancerSslCipherSuite,
+		DeleteContext: deleteLoadBalancerSslCipherSuite,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"ciphers": {
@@ -62,29 +63,29 @@ func LoadBalancerSslCipherSuiteResource() *schema.Resource {
 	}
 }
 
-func createLoadBalancerSslCipherSuite(d *schema.ResourceData, m interface{}) error {
+func createLoadBalancerSslCipherSuite(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerSslCipherSuiteResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readLoadBalancerSslCipherSuite(d *schema.ResourceData, m interface{}) error {
+func readLoadBalancerSslCipherSuite(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerSslCipherSuiteResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteLoadBalancerSslCipherSuite(d *schema.ResourceData, m interface{}) error {
+func deleteLoadBalancerSslCipherSuite(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoadBalancerSslCipherSuiteResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type LoadBalancerSslCipherSuiteResourceCrud struct {
@@ -134,7 +135,7 @@ func (s *LoadBalancerSslCipherSuiteResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *LoadBalancerSslCipherSuiteResourceCrud) Create() error {
+func (s *LoadBalancerSslCipherSuiteResourceCrud) Create(ctx context.Context) error {
 	request := oci_load_balancer.CreateSSLCipherSuiteRequest{}
 
 	if ciphers, ok := s.D.GetOkExists("ciphers"); ok {
@@ -162,7 +163,7 @@ func (s *LoadBalancerSslCipherSuiteResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.CreateSSLCipherSuite(context.Background(), request)
+	response, err := s.Client.CreateSSLCipherSuite(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -171,20 +172,20 @@ func (s *LoadBalancerSslCipherSuiteResourceCrud) Create() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = loadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	err = loadBalancerWaitForWorkRequest(ctx, s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (s *LoadBalancerSslCipherSuiteResourceCrud) Get() error {
-	_, stillWorking, err := loadBalancerResourceGet(s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+func (s *LoadBalancerSslCipherSuiteResourceCrud) Get(ctx context.Context) error {
+	_, stillWorking, err := loadBalancerResourceGet(ctx, s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -215,7 +216,7 @@ func (s *LoadBalancerSslCipherSuiteResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.GetSSLCipherSuite(context.Background(), request)
+	response, err := s.Client.GetSSLCipherSuite(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -224,7 +225,7 @@ func (s *LoadBalancerSslCipherSuiteResourceCrud) Get() error {
 	return nil
 }
 
-func (s *LoadBalancerSslCipherSuiteResourceCrud) Delete() error {
+func (s *LoadBalancerSslCipherSuiteResourceCrud) Delete(ctx context.Context) error {
 	request := oci_load_balancer.DeleteSSLCipherSuiteRequest{}
 
 	if loadBalancerId, ok := s.D.GetOkExists("load_balancer_id"); ok {
@@ -239,7 +240,7 @@ func (s *LoadBalancerSslCipherSuiteResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
 
-	response, err := s.Client.DeleteSSLCipherSuite(context.Background(), request)
+	response, err := s.Client.DeleteSSLCipherSuite(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -248,12 +249,12 @@ func (s *LoadBalancerSslCipherSuiteResourceCrud) Delete() error {
 	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
 	getWorkRequestRequest.WorkRequestId = workReqID
 	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
-	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	workRequestResponse, err := s.Client.GetWorkRequest(ctx, getWorkRequestRequest)
 	if err != nil {
 		return err
 	}
 	s.WorkRequest = &workRequestResponse.WorkRequest
-	err = loadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	err = loadBalancerWaitForWorkRequest(ctx, s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v65/loganalytics"
 )
 
 func LogAnalyticsLogAnalyticsCategoriesListDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularLogAnalyticsLogAnalyticsCategoriesList,
+		ReadContext: readSingularLogAnalyticsLogAnalyticsCategoriesList,
 		Schema: map[string]*schema.Schema{
 			"category_display_text": {
 				Type:     schema.TypeString,
@@ -71,12 +72,12 @@ func LogAnalyticsLogAnalyticsCategoriesListDataSource() *schema.Resource {
 	}
 }
 
-func readSingularLogAnalyticsLogAnalyticsCategoriesList(d *schema.ResourceData, m interface{}) error {
+func readSingularLogAnalyticsLogAnalyticsCategoriesList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsCategoriesListDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LogAnalyticsLogAnalyticsCategoriesListDataSourceCrud struct {
@@ -89,7 +90,7 @@ func (s *LogAnalyticsLogAnalyticsCategoriesListDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LogAnalyticsLogAnalyticsCategoriesListDataSourceCrud) Get() error {
+func (s *LogAnalyticsLogAnalyticsCategoriesListDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_log_analytics.ListCategoriesRequest{}
 
 	if categoryDisplayText, ok := s.D.GetOkExists("category_display_text"); ok {
@@ -114,7 +115,7 @@ func (s *LogAnalyticsLogAnalyticsCategoriesListDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.ListCategories(context.Background(), request)
+	response, err := s.Client.ListCategories(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v65/loganalytics"
 )
 
 func LogAnalyticsLogAnalyticsCategoryDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularLogAnalyticsLogAnalyticsCategory,
+		ReadContext: readSingularLogAnalyticsLogAnalyticsCategory,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -46,12 +47,12 @@ func LogAnalyticsLogAnalyticsCategoryDataSource() *schema.Resource {
 	}
 }
 
-func readSingularLogAnalyticsLogAnalyticsCategory(d *schema.ResourceData, m interface{}) error {
+func readSingularLogAnalyticsLogAnalyticsCategory(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsCategoryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LogAnalyticsLogAnalyticsCategoryDataSourceCrud struct {
@@ -64,7 +65,7 @@ func (s *LogAnalyticsLogAnalyticsCategoryDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LogAnalyticsLogAnalyticsCategoryDataSourceCrud) Get() error {
+func (s *LogAnalyticsLogAnalyticsCategoryDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_log_analytics.GetCategoryRequest{}
 
 	if categoryName, ok := s.D.GetOkExists("name"); ok {
@@ -79,7 +80,7 @@ func (s *LogAnalyticsLogAnalyticsCategoryDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.GetCategory(context.Background(), request)
+	response, err := s.Client.GetCategory(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v65/loganalytics"
 )
 
 func LogAnalyticsLogAnalyticsEntitiesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLogAnalyticsLogAnalyticsEntities,
+		ReadContext: readLogAnalyticsLogAnalyticsEntities,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"cloud_resource_id": {
@@ -87,12 +88,12 @@ func LogAnalyticsLogAnalyticsEntitiesDataSource() *schema.Resource {
 	}
 }
 
-func readLogAnalyticsLogAnalyticsEntities(d *schema.ResourceData, m interface{}) error {
+func readLogAnalyticsLogAnalyticsEntities(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsEntitiesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LogAnalyticsLogAnalyticsEntitiesDataSourceCrud struct {
@@ -105,7 +106,7 @@ func (s *LogAnalyticsLogAnalyticsEntitiesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LogAnalyticsLogAnalyticsEntitiesDataSourceCrud) Get() error {
+func (s *LogAnalyticsLogAnalyticsEntitiesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_log_analytics.ListLogAnalyticsEntitiesRequest{}
 
 	if cloudResourceId, ok := s.D.GetOkExists("cloud_resource_id"); ok {
@@ -176,7 +177,7 @@ func (s *LogAnalyticsLogAnalyticsEntitiesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.ListLogAnalyticsEntities(context.Background(), request)
+	response, err := s.Client.ListLogAnalyticsEntities(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -185,7 +186,7 @@ func (s *LogAnalyticsLogAnalyticsEntitiesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListLogAnalyticsEntities(context.Background(), request)
+		listResponse, err := s.Client.ListLogAnalyticsEntities(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v65/loganalytics"
 )
 
 func LogAnalyticsLogAnalyticsEntitiesSummaryDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularLogAnalyticsLogAnalyticsEntitiesSummary,
+		ReadContext: readSingularLogAnalyticsLogAnalyticsEntitiesSummary,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -42,12 +43,12 @@ func LogAnalyticsLogAnalyticsEntitiesSummaryDataSource() *schema.Resource {
 	}
 }
 
-func readSingularLogAnalyticsLogAnalyticsEntitiesSummary(d *schema.ResourceData, m interface{}) error {
+func readSingularLogAnalyticsLogAnalyticsEntitiesSummary(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsEntitiesSummaryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LogAnalyticsLogAnalyticsEntitiesSummaryDataSourceCrud struct {
@@ -60,7 +61,7 @@ func (s *LogAnalyticsLogAnalyticsEntitiesSummaryDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LogAnalyticsLogAnalyticsEntitiesSummaryDataSourceCrud) Get() error {
+func (s *LogAnalyticsLogAnalyticsEntitiesSummaryDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_log_analytics.GetLogAnalyticsEntitiesSummaryRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -75,7 +76,7 @@ func (s *LogAnalyticsLogAnalyticsEntitiesSummaryDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.GetLogAnalyticsEntitiesSummary(context.Background(), request)
+	response, err := s.Client.GetLogAnalyticsEntitiesSummary(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v65/loganalytics"
 )
@@ -26,12 +27,12 @@ func LogAnalyticsLogAnalyticsEntityDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(LogAnalyticsLogAnalyticsEntityResource(), fieldMap, readSingularLogAnalyticsLogAnalyticsEntity)
 }
 
-func readSingularLogAnalyticsLogAnalyticsEntity(d *schema.ResourceData, m interface{}) error {
+func readSingularLogAnalyticsLogAnalyticsEntity(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsEntityDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LogAnalyticsLogAnalyticsEntityDataSourceCrud struct {
@@ -44,7 +45,7 @@ func (s *LogAnalyticsLogAnalyticsEntityDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LogAnalyticsLogAnalyticsEntityDataSourceCrud) Get() error {
+func (s *LogAnalyticsLogAnalyticsEntityDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_log_analytics.GetLogAnalyticsEntityRequest{}
 
 	if logAnalyticsEntityId, ok := s.D.GetOkExists("log_analytics_entity_id"); ok {
@@ -59,7 +60,7 @@ func (s *LogAnalyticsLogAnalyticsEntityDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.GetLogAnalyticsEntity(context.Background(), request)
+	response, err := s.Client.GetLogAnalyticsEntity(ctx, request)
 	if err != nil {
 		return err
 	}
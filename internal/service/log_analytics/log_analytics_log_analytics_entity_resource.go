@@ -14,6 +14,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v65/loganalytics"
@@ -24,11 +25,11 @@ func LogAnalyticsLogAnalyticsEntityResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLogAnalyticsLogAnalyticsEntity,
-		Read:     readLogAnalyticsLogAnalyticsEntity,
-		Update:   updateLogAnalyticsLogAnalyticsEntity,
-		Delete:   deleteLogAnalyticsLogAnalyticsEntity,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLogAnalyticsLogAnalyticsEntity,
+		ReadContext:   readLogAnalyticsLogAnalyticsEntity,
+		UpdateContext: updateLogAnalyticsLogAnalyticsEntity,
+		DeleteContext: deleteLogAnalyticsLogAnalyticsEntity,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -135,37 +136,37 @@ func LogAnalyticsLogAnalyticsEntityResource() *schema.Resource {
 	}
 }
 
-func createLogAnalyticsLogAnalyticsEntity(d *schema.ResourceData, m interface{}) error {
+func createLogAnalyticsLogAnalyticsEntity(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsEntityResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readLogAnalyticsLogAnalyticsEntity(d *schema.ResourceData, m interface{}) error {
+func readLogAnalyticsLogAnalyticsEntity(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsEntityResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateLogAnalyticsLogAnalyticsEntity(d *schema.ResourceData, m interface{}) error {
+func updateLogAnalyticsLogAnalyticsEntity(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsEntityResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteLogAnalyticsLogAnalyticsEntity(d *schema.ResourceData, m interface{}) error {
+func deleteLogAnalyticsLogAnalyticsEntity(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsEntityResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type LogAnalyticsLogAnalyticsEntityResourceCrud struct {
@@ -199,7 +200,7 @@ func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) Create() error {
+func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) Create(ctx context.Context) error {
 	request := oci_log_analytics.CreateLogAnalyticsEntityRequest{}
 
 	if cloudResourceId, ok := s.D.GetOkExists("cloud_resource_id"); ok {
@@ -265,7 +266,7 @@ func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.CreateLogAnalyticsEntity(context.Background(), request)
+	response, err := s.Client.CreateLogAnalyticsEntity(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -274,7 +275,7 @@ func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) Create() error {
 	return nil
 }
 
-func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) Get() error {
+func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) Get(ctx context.Context) error {
 	request := oci_log_analytics.GetLogAnalyticsEntityRequest{}
 
 	tmp := s.D.Id()
@@ -295,7 +296,7 @@ func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.GetLogAnalyticsEntity(context.Background(), request)
+	response, err := s.Client.GetLogAnalyticsEntity(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -304,11 +305,11 @@ func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) Get() error {
 	return nil
 }
 
-func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) Update() error {
+func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -362,7 +363,7 @@ func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.UpdateLogAnalyticsEntity(context.Background(), request)
+	response, err := s.Client.UpdateLogAnalyticsEntity(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -371,7 +372,7 @@ func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) Update() error {
 	return nil
 }
 
-func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) Delete() error {
+func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) Delete(ctx context.Context) error {
 	request := oci_log_analytics.DeleteLogAnalyticsEntityRequest{}
 
 	tmp := s.D.Id()
@@ -384,7 +385,7 @@ func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	_, err := s.Client.DeleteLogAnalyticsEntity(context.Background(), request)
+	_, err := s.Client.DeleteLogAnalyticsEntity(ctx, request)
 	return err
 }
 
@@ -557,7 +558,7 @@ func LogAnalyticsEntitySummaryToMap(obj oci_log_analytics.LogAnalyticsEntitySumm
 	return result
 }
 
-func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_log_analytics.ChangeLogAnalyticsEntityCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -573,12 +574,12 @@ func (s *LogAnalyticsLogAnalyticsEntityResourceCrud) updateCompartment(compartme
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	_, err := s.Client.ChangeLogAnalyticsEntityCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeLogAnalyticsEntityCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v65/loganalytics"
 )
 
 func LogAnalyticsLogAnalyticsEntityTopologyDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularLogAnalyticsLogAnalyticsEntityTopology,
+		ReadContext: readSingularLogAnalyticsLogAnalyticsEntityTopology,
 		Schema: map[string]*schema.Schema{
 			"log_analytics_entity_id": {
 				Type:     schema.TypeString,
@@ -173,12 +174,12 @@ func LogAnalyticsLogAnalyticsEntityTopologyDataSource() *schema.Resource {
 	}
 }
 
-func readSingularLogAnalyticsLogAnalyticsEntityTopology(d *schema.ResourceData, m interface{}) error {
+func readSingularLogAnalyticsLogAnalyticsEntityTopology(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsEntityTopologyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LogAnalyticsLogAnalyticsEntityTopologyDataSourceCrud struct {
@@ -191,7 +192,7 @@ func (s *LogAnalyticsLogAnalyticsEntityTopologyDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LogAnalyticsLogAnalyticsEntityTopologyDataSourceCrud) Get() error {
+func (s *LogAnalyticsLogAnalyticsEntityTopologyDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_log_analytics.ListLogAnalyticsEntityTopologyRequest{}
 
 	if logAnalyticsEntityId, ok := s.D.GetOkExists("log_analytics_entity_id"); ok {
@@ -210,7 +211,7 @@ func (s *LogAnalyticsLogAnalyticsEntityTopologyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.ListLogAnalyticsEntityTopology(context.Background(), request)
+	response, err := s.Client.ListLogAnalyticsEntityTopology(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v65/loganalytics"
 )
@@ -21,10 +22,10 @@ func LogAnalyticsLogAnalyticsImportCustomContentResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLogAnalyticsLogAnalyticsImportCustomContent,
-		Read:     readLogAnalyticsLogAnalyticsImportCustomContent,
-		Delete:   deleteLogAnalyticsLogAnalyticsImportCustomContent,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLogAnalyticsLogAnalyticsImportCustomContent,
+		ReadContext:   readLogAnalyticsLogAnalyticsImportCustomContent,
+		DeleteContext: deleteLogAnalyticsLogAnalyticsImportCustomContent,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"import_custom_content_file": {
@@ -158,19 +159,19 @@ func LogAnalyticsLogAnalyticsImportCustomContentResource() *schema.Resource {
 	}
 }
 
-func createLogAnalyticsLogAnalyticsImportCustomContent(d *schema.ResourceData, m interface{}) error {
+func createLogAnalyticsLogAnalyticsImportCustomContent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsImportCustomContentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readLogAnalyticsLogAnalyticsImportCustomContent(d *schema.ResourceData, m interface{}) error {
+func readLogAnalyticsLogAnalyticsImportCustomContent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteLogAnalyticsLogAnalyticsImportCustomContent(d *schema.ResourceData, m interface{}) error {
+func deleteLogAnalyticsLogAnalyticsImportCustomContent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -185,7 +186,7 @@ func (s *LogAnalyticsLogAnalyticsImportCustomContentResourceCrud) ID() string {
 	return tfresource.GenerateDataSourceHashID("LogAnalyticsLogAnalyticsImportCustomContentResource-", LogAnalyticsLogAnalyticsImportCustomContentResource(), s.D)
 }
 
-func (s *LogAnalyticsLogAnalyticsImportCustomContentResourceCrud) Create() error {
+func (s *LogAnalyticsLogAnalyticsImportCustomContentResourceCrud) Create(ctx context.Context) error {
 	request := oci_log_analytics.ImportCustomContentRequest{}
 
 	if importFile, ok := s.D.GetOkExists("import_custom_content_file"); ok {
@@ -214,7 +215,7 @@ func (s *LogAnalyticsLogAnalyticsImportCustomContentResourceCrud) Create() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.ImportCustomContent(context.Background(), request)
+	response, err := s.Client.ImportCustomContent(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v65/loganalytics"
 )
@@ -26,12 +27,12 @@ func LogAnalyticsLogAnalyticsLogGroupDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(LogAnalyticsLogAnalyticsLogGroupResource(), fieldMap, readSingularLogAnalyticsLogAnalyticsLogGroup)
 }
 
-func readSingularLogAnalyticsLogAnalyticsLogGroup(d *schema.ResourceData, m interface{}) error {
+func readSingularLogAnalyticsLogAnalyticsLogGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsLogGroupDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LogAnalyticsLogAnalyticsLogGroupDataSourceCrud struct {
@@ -44,7 +45,7 @@ func (s *LogAnalyticsLogAnalyticsLogGroupDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LogAnalyticsLogAnalyticsLogGroupDataSourceCrud) Get() error {
+func (s *LogAnalyticsLogAnalyticsLogGroupDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_log_analytics.GetLogAnalyticsLogGroupRequest{}
 
 	if logAnalyticsLogGroupId, ok := s.D.GetOkExists("log_analytics_log_group_id"); ok {
@@ -59,7 +60,7 @@ func (s *LogAnalyticsLogAnalyticsLogGroupDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.GetLogAnalyticsLogGroup(context.Background(), request)
+	response, err := s.Client.GetLogAnalyticsLogGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v65/loganalytics"
@@ -24,11 +25,11 @@ func LogAnalyticsLogAnalyticsLogGroupResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLogAnalyticsLogAnalyticsLogGroup,
-		Read:     readLogAnalyticsLogAnalyticsLogGroup,
-		Update:   updateLogAnalyticsLogAnalyticsLogGroup,
-		Delete:   deleteLogAnalyticsLogAnalyticsLogGroup,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLogAnalyticsLogAnalyticsLogGroup,
+		ReadContext:   readLogAnalyticsLogAnalyticsLogGroup,
+		UpdateContext: updateLogAnalyticsLogAnalyticsLogGroup,
+		DeleteContext: deleteLogAnalyticsLogAnalyticsLogGroup,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -78,37 +79,37 @@ func LogAnalyticsLogAnalyticsLogGroupResource() *schema.Resource {
 	}
 }
 
-func createLogAnalyticsLogAnalyticsLogGroup(d *schema.ResourceData, m interface{}) error {
+func createLogAnalyticsLogAnalyticsLogGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsLogGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readLogAnalyticsLogAnalyticsLogGroup(d *schema.ResourceData, m interface{}) error {
+func readLogAnalyticsLogAnalyticsLogGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsLogGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateLogAnalyticsLogAnalyticsLogGroup(d *schema.ResourceData, m interface{}) error {
+func updateLogAnalyticsLogAnalyticsLogGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsLogGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteLogAnalyticsLogAnalyticsLogGroup(d *schema.ResourceData, m interface{}) error {
+func deleteLogAnalyticsLogAnalyticsLogGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsLogGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type LogAnalyticsLogAnalyticsLogGroupResourceCrud struct {
@@ -122,7 +123,7 @@ func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) ID() string {
 	return getLogAnalyticsLogGroupCompositeId(*s.Res.Id, s.D.Get("namespace").(string))
 }
 
-func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) Create() error {
+func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) Create(ctx context.Context) error {
 	request := oci_log_analytics.CreateLogAnalyticsLogGroupRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -159,7 +160,7 @@ func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.CreateLogAnalyticsLogGroup(context.Background(), request)
+	response, err := s.Client.CreateLogAnalyticsLogGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -168,7 +169,7 @@ func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) Create() error {
 	return nil
 }
 
-func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) Get() error {
+func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) Get(ctx context.Context) error {
 	request := oci_log_analytics.GetLogAnalyticsLogGroupRequest{}
 
 	tmp := s.D.Id()
@@ -189,7 +190,7 @@ func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.GetLogAnalyticsLogGroup(context.Background(), request)
+	response, err := s.Client.GetLogAnalyticsLogGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -198,11 +199,11 @@ func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) Get() error {
 	return nil
 }
 
-func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) Update() error {
+func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -242,7 +243,7 @@ func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.UpdateLogAnalyticsLogGroup(context.Background(), request)
+	response, err := s.Client.UpdateLogAnalyticsLogGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -251,7 +252,7 @@ func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) Update() error {
 	return nil
 }
 
-func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) Delete() error {
+func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) Delete(ctx context.Context) error {
 	request := oci_log_analytics.DeleteLogAnalyticsLogGroupRequest{}
 
 	tmp := s.D.Id()
@@ -264,7 +265,7 @@ func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	_, err := s.Client.DeleteLogAnalyticsLogGroup(context.Background(), request)
+	_, err := s.Client.DeleteLogAnalyticsLogGroup(ctx, request)
 	return err
 }
 
@@ -363,7 +364,7 @@ func LogAnalyticsLogGroupSummaryToMap(obj oci_log_analytics.LogAnalyticsLogGroup
 	return result
 }
 
-func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_log_analytics.ChangeLogAnalyticsLogGroupCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -379,12 +380,12 @@ func (s *LogAnalyticsLogAnalyticsLogGroupResourceCrud) updateCompartment(compart
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	_, err := s.Client.ChangeLogAnalyticsLogGroupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeLogAnalyticsLogGroupCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
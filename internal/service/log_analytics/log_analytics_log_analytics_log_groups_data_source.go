@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v65/loganalytics"
 )
 
 func LogAnalyticsLogAnalyticsLogGroupsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLogAnalyticsLogAnalyticsLogGroups,
+		ReadContext: readLogAnalyticsLogAnalyticsLogGroups,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,12 +49,12 @@ func LogAnalyticsLogAnalyticsLogGroupsDataSource() *schema.Resource {
 	}
 }
 
-func readLogAnalyticsLogAnalyticsLogGroups(d *schema.ResourceData, m interface{}) error {
+func readLogAnalyticsLogAnalyticsLogGroups(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsLogGroupsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LogAnalyticsLogAnalyticsLogGroupsDataSourceCrud struct {
@@ -66,7 +67,7 @@ func (s *LogAnalyticsLogAnalyticsLogGroupsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LogAnalyticsLogAnalyticsLogGroupsDataSourceCrud) Get() error {
+func (s *LogAnalyticsLogAnalyticsLogGroupsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_log_analytics.ListLogAnalyticsLogGroupsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -86,7 +87,7 @@ func (s *LogAnalyticsLogAnalyticsLogGroupsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.ListLogAnalyticsLogGroups(context.Background(), request)
+	response, err := s.Client.ListLogAnalyticsLogGroups(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +96,7 @@ func (s *LogAnalyticsLogAnalyticsLogGroupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListLogAnalyticsLogGroups(context.Background(), request)
+		listResponse, err := s.Client.ListLogAnalyticsLogGroups(ctx, request)
 		if err != nil {
 			return err
 		}
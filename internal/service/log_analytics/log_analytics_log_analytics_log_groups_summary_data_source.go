@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v65/loganalytics"
 )
 
 func LogAnalyticsLogAnalyticsLogGroupsSummaryDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularLogAnalyticsLogAnalyticsLogGroupsSummary,
+		ReadContext: readSingularLogAnalyticsLogAnalyticsLogGroupsSummary,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -34,12 +35,12 @@ func LogAnalyticsLogAnalyticsLogGroupsSummaryDataSource() *schema.Resource {
 	}
 }
 
-func readSingularLogAnalyticsLogAnalyticsLogGroupsSummary(d *schema.ResourceData, m interface{}) error {
+func readSingularLogAnalyticsLogAnalyticsLogGroupsSummary(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsLogGroupsSummaryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LogAnalyticsLogAnalyticsLogGroupsSummaryDataSourceCrud struct {
@@ -52,7 +53,7 @@ func (s *LogAnalyticsLogAnalyticsLogGroupsSummaryDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LogAnalyticsLogAnalyticsLogGroupsSummaryDataSourceCrud) Get() error {
+func (s *LogAnalyticsLogAnalyticsLogGroupsSummaryDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_log_analytics.GetLogAnalyticsLogGroupsSummaryRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -67,7 +68,7 @@ func (s *LogAnalyticsLogAnalyticsLogGroupsSummaryDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.GetLogAnalyticsLogGroupsSummary(context.Background(), request)
+	response, err := s.Client.GetLogAnalyticsLogGroupsSummary(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v65/loganalytics"
 )
@@ -26,12 +27,12 @@ func LogAnalyticsLogAnalyticsObjectCollectionRuleDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(LogAnalyticsLogAnalyticsObjectCollectionRuleResource(), fieldMap, readSingularLogAnalyticsLogAnalyticsObjectCollectionRule)
 }
 
-func readSingularLogAnalyticsLogAnalyticsObjectCollectionRule(d *schema.ResourceData, m interface{}) error {
+func readSingularLogAnalyticsLogAnalyticsObjectCollectionRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsObjectCollectionRuleDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LogAnalyticsLogAnalyticsObjectCollectionRuleDataSourceCrud struct {
@@ -44,7 +45,7 @@ func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleDataSourceCrud) VoidState()
 	s.D.SetId("")
 }
 
-func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleDataSourceCrud) Get() error {
+func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_log_analytics.GetLogAnalyticsObjectCollectionRuleRequest{}
 
 	if logAnalyticsObjectCollectionRuleId, ok := s.D.GetOkExists("log_analytics_object_collection_rule_id"); ok {
@@ -59,7 +60,7 @@ func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleDataSourceCrud) Get() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.GetLogAnalyticsObjectCollectionRule(context.Background(), request)
+	response, err := s.Client.GetLogAnalyticsObjectCollectionRule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v65/loganalytics"
@@ -24,11 +25,11 @@ func LogAnalyticsLogAnalyticsObjectCollectionRuleResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLogAnalyticsLogAnalyticsObjectCollectionRule,
-		Read:     readLogAnalyticsLogAnalyticsObjectCollectionRule,
-		Update:   updateLogAnalyticsLogAnalyticsObjectCollectionRule,
-		Delete:   deleteLogAnalyticsLogAnalyticsObjectCollectionRule,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLogAnalyticsLogAnalyticsObjectCollectionRule,
+		ReadContext:   readLogAnalyticsLogAnalyticsObjectCollectionRule,
+		UpdateContext: updateLogAnalyticsLogAnalyticsObjectCollectionRule,
+		DeleteContext: deleteLogAnalyticsLogAnalyticsObjectCollectionRule,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -195,37 +196,37 @@ func LogAnalyticsLogAnalyticsObjectCollectionRuleResource() *schema.Resource {
 	}
 }
 
-func createLogAnalyticsLogAnalyticsObjectCollectionRule(d *schema.ResourceData, m interface{}) error {
+func createLogAnalyticsLogAnalyticsObjectCollectionRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readLogAnalyticsLogAnalyticsObjectCollectionRule(d *schema.ResourceData, m interface{}) error {
+func readLogAnalyticsLogAnalyticsObjectCollectionRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateLogAnalyticsLogAnalyticsObjectCollectionRule(d *schema.ResourceData, m interface{}) error {
+func updateLogAnalyticsLogAnalyticsObjectCollectionRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteLogAnalyticsLogAnalyticsObjectCollectionRule(d *schema.ResourceData, m interface{}) error {
+func deleteLogAnalyticsLogAnalyticsObjectCollectionRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud struct {
@@ -259,7 +260,7 @@ func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud) DeletedTarget
 	}
 }
 
-func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud) Create() error {
+func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud) Create(ctx context.Context) error {
 	request := oci_log_analytics.CreateLogAnalyticsObjectCollectionRuleRequest{}
 
 	if charEncoding, ok := s.D.GetOkExists("char_encoding"); ok {
@@ -389,7 +390,7 @@ func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud) Create() erro
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.CreateLogAnalyticsObjectCollectionRule(context.Background(), request)
+	response, err := s.Client.CreateLogAnalyticsObjectCollectionRule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -398,7 +399,7 @@ func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud) Create() erro
 	return nil
 }
 
-func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud) Get() error {
+func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud) Get(ctx context.Context) error {
 	request := oci_log_analytics.GetLogAnalyticsObjectCollectionRuleRequest{}
 
 	tmp := s.D.Id()
@@ -424,7 +425,7 @@ func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.GetLogAnalyticsObjectCollectionRule(context.Background(), request)
+	response, err := s.Client.GetLogAnalyticsObjectCollectionRule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -433,11 +434,11 @@ func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud) Get() error {
 	return nil
 }
 
-func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud) Update() error {
+func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -541,7 +542,7 @@ func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud) Update() erro
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.UpdateLogAnalyticsObjectCollectionRule(context.Background(), request)
+	response, err := s.Client.UpdateLogAnalyticsObjectCollectionRule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -550,7 +551,7 @@ func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud) Update() erro
 	return nil
 }
 
-func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud) Delete() error {
+func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud) Delete(ctx context.Context) error {
 	request := oci_log_analytics.DeleteLogAnalyticsObjectCollectionRuleRequest{}
 
 	tmp := s.D.Id()
@@ -563,7 +564,7 @@ func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud) Delete() erro
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	_, err := s.Client.DeleteLogAnalyticsObjectCollectionRule(context.Background(), request)
+	_, err := s.Client.DeleteLogAnalyticsObjectCollectionRule(ctx, request)
 	return err
 }
 
@@ -742,7 +743,7 @@ func LogAnalyticsObjectCollectionRuleSummaryToMap(obj oci_log_analytics.LogAnaly
 	return result
 }
 
-func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_log_analytics.ChangeLogAnalyticsObjectCollectionRuleCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -758,12 +759,12 @@ func (s *LogAnalyticsLogAnalyticsObjectCollectionRuleResourceCrud) updateCompart
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	_, err := s.Client.ChangeLogAnalyticsObjectCollectionRuleCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeLogAnalyticsObjectCollectionRuleCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
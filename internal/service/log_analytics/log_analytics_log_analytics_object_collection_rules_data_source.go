@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v65/loganalytics"
 )
 
 func LogAnalyticsLogAnalyticsObjectCollectionRulesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLogAnalyticsLogAnalyticsObjectCollectionRules,
+		ReadContext: readLogAnalyticsLogAnalyticsObjectCollectionRules,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -52,12 +53,12 @@ func LogAnalyticsLogAnalyticsObjectCollectionRulesDataSource() *schema.Resource
 	}
 }
 
-func readLogAnalyticsLogAnalyticsObjectCollectionRules(d *schema.ResourceData, m interface{}) error {
+func readLogAnalyticsLogAnalyticsObjectCollectionRules(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsObjectCollectionRulesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LogAnalyticsLogAnalyticsObjectCollectionRulesDataSourceCrud struct {
@@ -70,7 +71,7 @@ func (s *LogAnalyticsLogAnalyticsObjectCollectionRulesDataSourceCrud) VoidState(
 	s.D.SetId("")
 }
 
-func (s *LogAnalyticsLogAnalyticsObjectCollectionRulesDataSourceCrud) Get() error {
+func (s *LogAnalyticsLogAnalyticsObjectCollectionRulesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_log_analytics.ListLogAnalyticsObjectCollectionRulesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -94,7 +95,7 @@ func (s *LogAnalyticsLogAnalyticsObjectCollectionRulesDataSourceCrud) Get() erro
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.ListLogAnalyticsObjectCollectionRules(context.Background(), request)
+	response, err := s.Client.ListLogAnalyticsObjectCollectionRules(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *LogAnalyticsLogAnalyticsObjectCollectionRulesDataSourceCrud) Get() erro
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListLogAnalyticsObjectCollectionRules(context.Background(), request)
+		listResponse, err := s.Client.ListLogAnalyticsObjectCollectionRules(ctx, request)
 		if err != nil {
 			return err
 		}
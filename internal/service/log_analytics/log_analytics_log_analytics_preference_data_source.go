@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v65/loganalytics"
 )
 
 func LogAnalyticsLogAnalyticsPreferenceDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularLogAnalyticsLogAnalyticsPreference,
+		ReadContext: readSingularLogAnalyticsLogAnalyticsPreference,
 		Schema: map[string]*schema.Schema{
 			"namespace": {
 				Type:     schema.TypeString,
@@ -47,12 +48,12 @@ func LogAnalyticsLogAnalyticsPreferenceDataSource() *schema.Resource {
 	}
 }
 
-func readSingularLogAnalyticsLogAnalyticsPreference(d *schema.ResourceData, m interface{}) error {
+func readSingularLogAnalyticsLogAnalyticsPreference(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsPreferenceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LogAnalyticsLogAnalyticsPreferenceDataSourceCrud struct {
@@ -65,7 +66,7 @@ func (s *LogAnalyticsLogAnalyticsPreferenceDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LogAnalyticsLogAnalyticsPreferenceDataSourceCrud) Get() error {
+func (s *LogAnalyticsLogAnalyticsPreferenceDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_log_analytics.GetPreferencesRequest{}
 
 	if namespace, ok := s.D.GetOkExists("namespace"); ok {
@@ -75,7 +76,7 @@ func (s *LogAnalyticsLogAnalyticsPreferenceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.GetPreferences(context.Background(), request)
+	response, err := s.Client.GetPreferences(ctx, request)
 	if err != nil {
 		return err
 	}
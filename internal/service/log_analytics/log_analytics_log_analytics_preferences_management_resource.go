@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v65/loganalytics"
@@ -23,11 +24,11 @@ func LogAnalyticsLogAnalyticsPreferencesManagementResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLogAnalyticsLogAnalyticsPreferencesManagement,
-		Update:   updateLogAnalyticsLogAnalyticsPreferencesManagement,
-		Read:     readLogAnalyticsLogAnalyticsPreferencesManagement,
-		Delete:   deleteLogAnalyticsLogAnalyticsPreferencesManagement,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLogAnalyticsLogAnalyticsPreferencesManagement,
+		UpdateContext: updateLogAnalyticsLogAnalyticsPreferencesManagement,
+		ReadContext:   readLogAnalyticsLogAnalyticsPreferencesManagement,
+		DeleteContext: deleteLogAnalyticsLogAnalyticsPreferencesManagement,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"namespace": {
@@ -65,33 +66,33 @@ func LogAnalyticsLogAnalyticsPreferencesManagementResource() *schema.Resource {
 	}
 }
 
-func createLogAnalyticsLogAnalyticsPreferencesManagement(d *schema.ResourceData, m interface{}) error {
+func createLogAnalyticsLogAnalyticsPreferencesManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func updateLogAnalyticsLogAnalyticsPreferencesManagement(d *schema.ResourceData, m interface{}) error {
+func updateLogAnalyticsLogAnalyticsPreferencesManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func readLogAnalyticsLogAnalyticsPreferencesManagement(d *schema.ResourceData, m interface{}) error {
+func readLogAnalyticsLogAnalyticsPreferencesManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteLogAnalyticsLogAnalyticsPreferencesManagement(d *schema.ResourceData, m interface{}) error {
+func deleteLogAnalyticsLogAnalyticsPreferencesManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud struct {
@@ -104,7 +105,7 @@ func (s *LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud) ID() string
 	return getLogAnalyticsPreferencesManagementId(s.D.Get("namespace").(string))
 }
 
-func (s *LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud) Create() error {
+func (s *LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud) Create(ctx context.Context) error {
 	if items, ok := s.D.GetOkExists("items"); ok {
 		interfaces := items.(*schema.Set).List()
 		updateItems := make([]oci_log_analytics.LogAnalyticsPreference, len(interfaces))
@@ -125,7 +126,7 @@ func (s *LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud) Create() err
 			ns = namespace.(string)
 		}
 
-		err := s.updatePreferences(ns, updateItems)
+		err := s.updatePreferences(ctx, ns, updateItems)
 		if err != nil {
 			return fmt.Errorf("failed to update preferences, error: %v", err)
 		}
@@ -134,7 +135,7 @@ func (s *LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud) Create() err
 	return nil
 }
 
-func (s *LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud) Update() error {
+func (s *LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud) Update(ctx context.Context) error {
 	if _, ok := s.D.GetOkExists("items"); ok && s.D.HasChange("items") {
 		o, n := s.D.GetChange("items")
 
@@ -161,7 +162,7 @@ func (s *LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud) Update() err
 			for i := range preferencesToRemove {
 				removeItems[i] = mapToLogAnalyticsPreferenceDetailsPreference(preferencesToRemove[i].(map[string]interface{}))
 			}
-			err := s.removePreferences(ns, removeItems)
+			err := s.removePreferences(ctx, ns, removeItems)
 			if err != nil {
 				return fmt.Errorf("failed to remove preferences, error: %v", err)
 			}
@@ -172,7 +173,7 @@ func (s *LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud) Update() err
 			for i := range preferencesToUpdate {
 				updateItems[i] = mapToLogAnalyticsPreferenceDetailsPreference(preferencesToUpdate[i].(map[string]interface{}))
 			}
-			err := s.updatePreferences(ns, updateItems)
+			err := s.updatePreferences(ctx, ns, updateItems)
 			if err != nil {
 				return fmt.Errorf("failed to update preferences, error: %v", err)
 			}
@@ -182,7 +183,7 @@ func (s *LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud) Update() err
 	return nil
 }
 
-func (s *LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud) Delete() error {
+func (s *LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud) Delete(ctx context.Context) error {
 	if items, ok := s.D.GetOkExists("items"); ok {
 		interfaces := items.(*schema.Set).List()
 		removeItems := make([]oci_log_analytics.LogAnalyticsPreference, len(interfaces))
@@ -203,7 +204,7 @@ func (s *LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud) Delete() err
 			ns = namespace.(string)
 		}
 
-		err := s.removePreferences(ns, removeItems)
+		err := s.removePreferences(ctx, ns, removeItems)
 		if err != nil {
 			return fmt.Errorf("failed to remove preferences, error: %v", err)
 		}
@@ -212,12 +213,12 @@ func (s *LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud) Delete() err
 	return nil
 }
 
-func (s *LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud) updatePreferences(namespace string, items []oci_log_analytics.LogAnalyticsPreference) error {
+func (s *LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud) updatePreferences(ctx context.Context, namespace string, items []oci_log_analytics.LogAnalyticsPreference) error {
 	request := oci_log_analytics.UpdatePreferencesRequest{}
 	request.NamespaceName = &namespace
 	request.UpdatePreferencesDetails.Items = items
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
-	_, err := s.Client.UpdatePreferences(context.Background(), request)
+	_, err := s.Client.UpdatePreferences(ctx, request)
 
 	if err != nil {
 		return err
@@ -225,12 +226,12 @@ func (s *LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud) updatePrefer
 	return nil
 }
 
-func (s *LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud) removePreferences(namespace string, items []oci_log_analytics.LogAnalyticsPreference) error {
+func (s *LogAnalyticsLogAnalyticsPreferencesManagementResourceCrud) removePreferences(ctx context.Context, namespace string, items []oci_log_analytics.LogAnalyticsPreference) error {
 	request := oci_log_analytics.RemovePreferencesRequest{}
 	request.NamespaceName = &namespace
 	request.RemovePreferencesDetails.Items = items
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
-	_, err := s.Client.RemovePreferences(context.Background(), request)
+	_, err := s.Client.RemovePreferences(ctx, request)
 
 	if err != nil {
 		return err
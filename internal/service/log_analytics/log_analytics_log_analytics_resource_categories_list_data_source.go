@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v65/loganalytics"
 )
 
 func LogAnalyticsLogAnalyticsResourceCategoriesListDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularLogAnalyticsLogAnalyticsResourceCategoriesList,
+		ReadContext: readSingularLogAnalyticsLogAnalyticsResourceCategoriesList,
 		Schema: map[string]*schema.Schema{
 			"namespace": {
 				Type:     schema.TypeString,
@@ -100,12 +101,12 @@ func LogAnalyticsLogAnalyticsResourceCategoriesListDataSource() *schema.Resource
 	}
 }
 
-func readSingularLogAnalyticsLogAnalyticsResourceCategoriesList(d *schema.ResourceData, m interface{}) error {
+func readSingularLogAnalyticsLogAnalyticsResourceCategoriesList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsResourceCategoriesListDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LogAnalyticsLogAnalyticsResourceCategoriesListDataSourceCrud struct {
@@ -118,7 +119,7 @@ func (s *LogAnalyticsLogAnalyticsResourceCategoriesListDataSourceCrud) VoidState
 	s.D.SetId("")
 }
 
-func (s *LogAnalyticsLogAnalyticsResourceCategoriesListDataSourceCrud) Get() error {
+func (s *LogAnalyticsLogAnalyticsResourceCategoriesListDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_log_analytics.ListResourceCategoriesRequest{}
 
 	if namespace, ok := s.D.GetOkExists("namespace"); ok {
@@ -143,7 +144,7 @@ func (s *LogAnalyticsLogAnalyticsResourceCategoriesListDataSourceCrud) Get() err
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.ListResourceCategories(context.Background(), request)
+	response, err := s.Client.ListResourceCategories(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v65/loganalytics"
@@ -21,11 +22,11 @@ func LogAnalyticsLogAnalyticsResourceCategoriesManagementResource() *schema.Reso
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLogAnalyticsLogAnalyticsResourceCategoriesManagement,
-		Update:   updateLogAnalyticsLogAnalyticsResourceCategoriesManagement,
-		Read:     readLogAnalyticsLogAnalyticsResourceCategoriesManagement,
-		Delete:   deleteLogAnalyticsLogAnalyticsResourceCategoriesManagement,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLogAnalyticsLogAnalyticsResourceCategoriesManagement,
+		UpdateContext: updateLogAnalyticsLogAnalyticsResourceCategoriesManagement,
+		ReadContext:   readLogAnalyticsLogAnalyticsResourceCategoriesManagement,
+		DeleteContext: deleteLogAnalyticsLogAnalyticsResourceCategoriesManagement,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"namespace": {
@@ -62,33 +63,33 @@ func LogAnalyticsLogAnalyticsResourceCategoriesManagementResource() *schema.Reso
 	}
 }
 
-func createLogAnalyticsLogAnalyticsResourceCategoriesManagement(d *schema.ResourceData, m interface{}) error {
+func createLogAnalyticsLogAnalyticsResourceCategoriesManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func updateLogAnalyticsLogAnalyticsResourceCategoriesManagement(d *schema.ResourceData, m interface{}) error {
+func updateLogAnalyticsLogAnalyticsResourceCategoriesManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func readLogAnalyticsLogAnalyticsResourceCategoriesManagement(d *schema.ResourceData, m interface{}) error {
+func readLogAnalyticsLogAnalyticsResourceCategoriesManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteLogAnalyticsLogAnalyticsResourceCategoriesManagement(d *schema.ResourceData, m interface{}) error {
+func deleteLogAnalyticsLogAnalyticsResourceCategoriesManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud struct {
@@ -102,7 +103,7 @@ func (s *LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud) ID()
 		s.D.Get("namespace").(string), s.D.Get("resource_id").(string), s.D.Get("resource_type").(string))
 }
 
-func (s *LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud) Create() error {
+func (s *LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud) Create(ctx context.Context) error {
 	if resourceCategories, ok := s.D.GetOkExists("resource_categories"); ok {
 		interfaces := resourceCategories.(*schema.Set).List()
 		updateItems := make([]oci_log_analytics.LogAnalyticsResourceCategory, len(interfaces))
@@ -126,7 +127,7 @@ func (s *LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud) Creat
 			updateItems[i] = toLogAnalyticsResourceCategory(resourceId, resourceType, interfaces[i].(string))
 		}
 
-		err := s.updateResourceCategories(ns, updateItems)
+		err := s.updateResourceCategories(ctx, ns, updateItems)
 		if err != nil {
 			return fmt.Errorf("failed to update resource categories, error: %v", err)
 		}
@@ -135,7 +136,7 @@ func (s *LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud) Creat
 	return nil
 }
 
-func (s *LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud) Update() error {
+func (s *LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud) Update(ctx context.Context) error {
 	if _, ok := s.D.GetOkExists("resource_categories"); ok && s.D.HasChange("resource_categories") {
 		o, n := s.D.GetChange("resource_categories")
 
@@ -172,7 +173,7 @@ func (s *LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud) Updat
 			for i := range resourceCategoriesToRemove {
 				removeItems[i] = toLogAnalyticsResourceCategory(resourceId, resourceType, resourceCategoriesToRemove[i].(string))
 			}
-			err := s.removeResourceCategories(ns, removeItems)
+			err := s.removeResourceCategories(ctx, ns, removeItems)
 			if err != nil {
 				return fmt.Errorf("failed to remove resource categories, error: %v", err)
 			}
@@ -183,7 +184,7 @@ func (s *LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud) Updat
 			for i := range resourceCategoriesToUpdate {
 				updateItems[i] = toLogAnalyticsResourceCategory(resourceId, resourceType, resourceCategoriesToUpdate[i].(string))
 			}
-			err := s.updateResourceCategories(ns, updateItems)
+			err := s.updateResourceCategories(ctx, ns, updateItems)
 			if err != nil {
 				return fmt.Errorf("failed to update resource categories, error: %v", err)
 			}
@@ -193,7 +194,7 @@ func (s *LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud) Updat
 	return nil
 }
 
-func (s *LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud) Delete() error {
+func (s *LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud) Delete(ctx context.Context) error {
 	if resourceCategories, ok := s.D.GetOkExists("resource_categories"); ok {
 		interfaces := resourceCategories.(*schema.Set).List()
 		removeItems := make([]oci_log_analytics.LogAnalyticsResourceCategory, len(interfaces))
@@ -217,7 +218,7 @@ func (s *LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud) Delet
 			removeItems[i] = toLogAnalyticsResourceCategory(resourceId, resourceType, interfaces[i].(string))
 		}
 
-		err := s.removeResourceCategories(ns, removeItems)
+		err := s.removeResourceCategories(ctx, ns, removeItems)
 		if err != nil {
 			return fmt.Errorf("failed to remove resource categories, error: %v", err)
 		}
@@ -226,12 +227,12 @@ func (s *LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud) Delet
 	return nil
 }
 
-func (s *LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud) updateResourceCategories(namespace string, items []oci_log_analytics.LogAnalyticsResourceCategory) error {
+func (s *LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud) updateResourceCategories(ctx context.Context, namespace string, items []oci_log_analytics.LogAnalyticsResourceCategory) error {
 	request := oci_log_analytics.UpdateResourceCategoriesRequest{}
 	request.NamespaceName = &namespace
 	request.UpdateResourceCategoriesDetails.Items = items
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
-	_, err := s.Client.UpdateResourceCategories(context.Background(), request)
+	_, err := s.Client.UpdateResourceCategories(ctx, request)
 
 	if err != nil {
 		return err
@@ -239,12 +240,12 @@ func (s *LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud) updat
 	return nil
 }
 
-func (s *LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud) removeResourceCategories(namespace string, items []oci_log_analytics.LogAnalyticsResourceCategory) error {
+func (s *LogAnalyticsLogAnalyticsResourceCategoriesManagementResourceCrud) removeResourceCategories(ctx context.Context, namespace string, items []oci_log_analytics.LogAnalyticsResourceCategory) error {
 	request := oci_log_analytics.RemoveResourceCategoriesRequest{}
 	request.NamespaceName = &namespace
 	request.RemoveResourceCategoriesDetails.Items = items
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
-	_, err := s.Client.RemoveResourceCategories(context.Background(), request)
+	_, err := s.Client.RemoveResourceCategories(ctx, request)
 
 	if err != nil {
 		return err
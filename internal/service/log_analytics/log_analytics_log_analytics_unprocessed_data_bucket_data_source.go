@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v65/loganalytics"
 )
 
 func LogAnalyticsLogAnalyticsUnprocessedDataBucketDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularLogAnalyticsLogAnalyticsUnprocessedDataBucket,
+		ReadContext: readSingularLogAnalyticsLogAnalyticsUnprocessedDataBucket,
 		Schema: map[string]*schema.Schema{
 			"namespace": {
 				Type:     schema.TypeString,
@@ -42,12 +43,12 @@ func LogAnalyticsLogAnalyticsUnprocessedDataBucketDataSource() *schema.Resource
 	}
 }
 
-func readSingularLogAnalyticsLogAnalyticsUnprocessedDataBucket(d *schema.ResourceData, m interface{}) error {
+func readSingularLogAnalyticsLogAnalyticsUnprocessedDataBucket(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsUnprocessedDataBucketDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LogAnalyticsLogAnalyticsUnprocessedDataBucketDataSourceCrud struct {
@@ -60,7 +61,7 @@ func (s *LogAnalyticsLogAnalyticsUnprocessedDataBucketDataSourceCrud) VoidState(
 	s.D.SetId("")
 }
 
-func (s *LogAnalyticsLogAnalyticsUnprocessedDataBucketDataSourceCrud) Get() error {
+func (s *LogAnalyticsLogAnalyticsUnprocessedDataBucketDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_log_analytics.GetUnprocessedDataBucketRequest{}
 
 	if namespace, ok := s.D.GetOkExists("namespace"); ok {
@@ -70,7 +71,7 @@ func (s *LogAnalyticsLogAnalyticsUnprocessedDataBucketDataSourceCrud) Get() erro
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.GetUnprocessedDataBucket(context.Background(), request)
+	response, err := s.Client.GetUnprocessedDataBucket(ctx, request)
 	if err != nil {
 		return err
 	}
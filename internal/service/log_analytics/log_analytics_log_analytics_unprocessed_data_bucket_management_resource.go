@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v65/loganalytics"
@@ -19,11 +20,11 @@ func LogAnalyticsLogAnalyticsUnprocessedDataBucketManagementResource() *schema.R
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement,
-		Update:   updateLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement,
-		Read:     readLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement,
-		Delete:   deleteLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement,
+		UpdateContext: updateLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement,
+		ReadContext:   readLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement,
+		DeleteContext: deleteLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"bucket": {
@@ -56,33 +57,33 @@ func LogAnalyticsLogAnalyticsUnprocessedDataBucketManagementResource() *schema.R
 	}
 }
 
-func createLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement(d *schema.ResourceData, m interface{}) error {
+func createLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsUnprocessedDataBucketManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func updateLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement(d *schema.ResourceData, m interface{}) error {
+func updateLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsUnprocessedDataBucketManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func readLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement(d *schema.ResourceData, m interface{}) error {
+func readLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement(d *schema.ResourceData, m interface{}) error {
+func deleteLogAnalyticsLogAnalyticsUnprocessedDataBucketManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogAnalyticsUnprocessedDataBucketManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type LogAnalyticsLogAnalyticsUnprocessedDataBucketManagementResourceCrud struct {
@@ -96,7 +97,7 @@ func (s *LogAnalyticsLogAnalyticsUnprocessedDataBucketManagementResourceCrud) ID
 	return s.D.Get("namespace").(string)
 }
 
-func (s *LogAnalyticsLogAnalyticsUnprocessedDataBucketManagementResourceCrud) Create() error {
+func (s *LogAnalyticsLogAnalyticsUnprocessedDataBucketManagementResourceCrud) Create(ctx context.Context) error {
 	request := oci_log_analytics.SetUnprocessedDataBucketRequest{}
 
 	if bucket, ok := s.D.GetOkExists("bucket"); ok {
@@ -116,7 +117,7 @@ func (s *LogAnalyticsLogAnalyticsUnprocessedDataBucketManagementResourceCrud) Cr
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.SetUnprocessedDataBucket(context.Background(), request)
+	response, err := s.Client.SetUnprocessedDataBucket(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -125,7 +126,7 @@ func (s *LogAnalyticsLogAnalyticsUnprocessedDataBucketManagementResourceCrud) Cr
 	return nil
 }
 
-func (s *LogAnalyticsLogAnalyticsUnprocessedDataBucketManagementResourceCrud) Update() error {
+func (s *LogAnalyticsLogAnalyticsUnprocessedDataBucketManagementResourceCrud) Update(ctx context.Context) error {
 	request := oci_log_analytics.SetUnprocessedDataBucketRequest{}
 
 	if bucket, ok := s.D.GetOkExists("bucket"); ok {
@@ -145,7 +146,7 @@ func (s *LogAnalyticsLogAnalyticsUnprocessedDataBucketManagementResourceCrud) Up
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.SetUnprocessedDataBucket(context.Background(), request)
+	response, err := s.Client.SetUnprocessedDataBucket(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -154,7 +155,7 @@ func (s *LogAnalyticsLogAnalyticsUnprocessedDataBucketManagementResourceCrud) Up
 	return nil
 }
 
-func (s *LogAnalyticsLogAnalyticsUnprocessedDataBucketManagementResourceCrud) Delete() error {
+func (s *LogAnalyticsLogAnalyticsUnprocessedDataBucketManagementResourceCrud) Delete(ctx context.Context) error {
 	request := oci_log_analytics.SetUnprocessedDataBucketRequest{}
 
 	if bucket, ok := s.D.GetOkExists("bucket"); ok {
@@ -172,7 +173,7 @@ func (s *LogAnalyticsLogAnalyticsUnprocessedDataBucketManagementResourceCrud) De
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.SetUnprocessedDataBucket(context.Background(), request)
+	response, err := s.Client.SetUnprocessedDataBucket(ctx, request)
 	if err != nil {
 		return err
 	}
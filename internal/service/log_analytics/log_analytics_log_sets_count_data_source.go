@@ -10,13 +10,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v65/loganalytics"
 )
 
 func LogAnalyticsLogSetsCountDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularLogAnalyticsLogSetsCount,
+		ReadContext: readSingularLogAnalyticsLogSetsCount,
 		Schema: map[string]*schema.Schema{
 			"namespace": {
 				Type:     schema.TypeString,
@@ -31,12 +32,12 @@ func LogAnalyticsLogSetsCountDataSource() *schema.Resource {
 	}
 }
 
-func readSingularLogAnalyticsLogSetsCount(d *schema.ResourceData, m interface{}) error {
+func readSingularLogAnalyticsLogSetsCount(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsLogSetsCountDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LogAnalyticsLogSetsCountDataSourceCrud struct {
@@ -49,7 +50,7 @@ func (s *LogAnalyticsLogSetsCountDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LogAnalyticsLogSetsCountDataSourceCrud) Get() error {
+func (s *LogAnalyticsLogSetsCountDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_log_analytics.GetLogSetsCountRequest{}
 
 	if namespace, ok := s.D.GetOkExists("namespace"); ok {
@@ -59,7 +60,7 @@ func (s *LogAnalyticsLogSetsCountDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.GetLogSetsCount(context.Background(), request)
+	response, err := s.Client.GetLogSetsCount(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v65/loganalytics"
 )
 
 func LogAnalyticsNamespaceDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularLogAnalyticsNamespace,
+		ReadContext: readSingularLogAnalyticsNamespace,
 		Schema: map[string]*schema.Schema{
 			"namespace": {
 				Type:     schema.TypeString,
@@ -34,12 +35,12 @@ func LogAnalyticsNamespaceDataSource() *schema.Resource {
 	}
 }
 
-func readSingularLogAnalyticsNamespace(d *schema.ResourceData, m interface{}) error {
+func readSingularLogAnalyticsNamespace(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsNamespaceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LogAnalyticsNamespaceDataSourceCrud struct {
@@ -52,7 +53,7 @@ func (s *LogAnalyticsNamespaceDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LogAnalyticsNamespaceDataSourceCrud) Get() error {
+func (s *LogAnalyticsNamespaceDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_log_analytics.GetNamespaceRequest{}
 
 	if namespace, ok := s.D.GetOkExists("namespace"); ok {
@@ -62,7 +63,7 @@ func (s *LogAnalyticsNamespaceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.GetNamespace(context.Background(), request)
+	response, err := s.Client.GetNamespace(ctx, request)
 	if err != nil {
 		return err
 	}
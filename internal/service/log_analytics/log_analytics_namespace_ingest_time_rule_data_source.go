@@ -6,6 +6,7 @@ package log_analytics
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v65/loganalytics"
 
@@ -26,12 +27,12 @@ func LogAnalyticsNamespaceIngestTimeRuleDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(LogAnalyticsNamespaceIngestTimeRuleResource(), fieldMap, readSingularLogAnalyticsNamespaceIngestTimeRule)
 }
 
-func readSingularLogAnalyticsNamespaceIngestTimeRule(d *schema.ResourceData, m interface{}) error {
+func readSingularLogAnalyticsNamespaceIngestTimeRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsNamespaceIngestTimeRuleDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LogAnalyticsNamespaceIngestTimeRuleDataSourceCrud struct {
@@ -44,7 +45,7 @@ func (s *LogAnalyticsNamespaceIngestTimeRuleDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LogAnalyticsNamespaceIngestTimeRuleDataSourceCrud) Get() error {
+func (s *LogAnalyticsNamespaceIngestTimeRuleDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_log_analytics.GetIngestTimeRuleRequest{}
 
 	if ingestTimeRuleId, ok := s.D.GetOkExists("ingest_time_rule_id"); ok {
@@ -59,7 +60,7 @@ func (s *LogAnalyticsNamespaceIngestTimeRuleDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.GetIngestTimeRule(context.Background(), request)
+	response, err := s.Client.GetIngestTimeRule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -28,11 +29,11 @@ func LogAnalyticsNamespaceIngestTimeRuleResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLogAnalyticsNamespaceIngestTimeRule,
-		Read:     readLogAnalyticsNamespaceIngestTimeRule,
-		Update:   updateLogAnalyticsNamespaceIngestTimeRule,
-		Delete:   deleteLogAnalyticsNamespaceIngestTimeRule,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLogAnalyticsNamespaceIngestTimeRule,
+		ReadContext:   readLogAnalyticsNamespaceIngestTimeRule,
+		UpdateContext: updateLogAnalyticsNamespaceIngestTimeRule,
+		DeleteContext: deleteLogAnalyticsNamespaceIngestTimeRule,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"actions": {
@@ -204,37 +205,37 @@ func LogAnalyticsNamespaceIngestTimeRuleResource() *schema.Resource {
 	}
 }
 
-func createLogAnalyticsNamespaceIngestTimeRule(d *schema.ResourceData, m interface{}) error {
+func createLogAnalyticsNamespaceIngestTimeRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsNamespaceIngestTimeRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readLogAnalyticsNamespaceIngestTimeRule(d *schema.ResourceData, m interface{}) error {
+func readLogAnalyticsNamespaceIngestTimeRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsNamespaceIngestTimeRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateLogAnalyticsNamespaceIngestTimeRule(d *schema.ResourceData, m interface{}) error {
+func updateLogAnalyticsNamespaceIngestTimeRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsNamespaceIngestTimeRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteLogAnalyticsNamespaceIngestTimeRule(d *schema.ResourceData, m interface{}) error {
+func deleteLogAnalyticsNamespaceIngestTimeRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsNamespaceIngestTimeRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type LogAnalyticsNamespaceIngestTimeRuleResourceCrud struct {
@@ -268,7 +269,7 @@ func (s *LogAnalyticsNamespaceIngestTimeRuleResourceCrud) DeletedTarget() []stri
 	}
 }
 
-func (s *LogAnalyticsNamespaceIngestTimeRuleResourceCrud) Create() error {
+func (s *LogAnalyticsNamespaceIngestTimeRuleResourceCrud) Create(ctx context.Context) error {
 	request := oci_log_analytics.CreateIngestTimeRuleRequest{}
 
 	if actions, ok := s.D.GetOkExists("actions"); ok {
@@ -333,7 +334,7 @@ func (s *LogAnalyticsNamespaceIngestTimeRuleResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.CreateIngestTimeRule(context.Background(), request)
+	response, err := s.Client.CreateIngestTimeRule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -342,7 +343,7 @@ func (s *LogAnalyticsNamespaceIngestTimeRuleResourceCrud) Create() error {
 	return nil
 }
 
-func (s *LogAnalyticsNamespaceIngestTimeRuleResourceCrud) Get() error {
+func (s *LogAnalyticsNamespaceIngestTimeRuleResourceCrud) Get(ctx context.Context) error {
 	request := oci_log_analytics.GetIngestTimeRuleRequest{}
 
 	if ingestTimeRuleId, ok := s.D.GetOkExists("ingest_time_rule_id"); ok {
@@ -365,7 +366,7 @@ func (s *LogAnalyticsNamespaceIngestTimeRuleResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.GetIngestTimeRule(context.Background(), request)
+	response, err := s.Client.GetIngestTimeRule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -374,11 +375,11 @@ func (s *LogAnalyticsNamespaceIngestTimeRuleResourceCrud) Get() error {
 	return nil
 }
 
-func (s *LogAnalyticsNamespaceIngestTimeRuleResourceCrud) Update() error {
+func (s *LogAnalyticsNamespaceIngestTimeRuleResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -465,7 +466,7 @@ func (s *LogAnalyticsNamespaceIngestTimeRuleResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.UpdateIngestTimeRule(context.Background(), request)
+	response, err := s.Client.UpdateIngestTimeRule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -474,7 +475,7 @@ func (s *LogAnalyticsNamespaceIngestTimeRuleResourceCrud) Update() error {
 	return nil
 }
 
-func (s *LogAnalyticsNamespaceIngestTimeRuleResourceCrud) Delete() error {
+func (s *LogAnalyticsNamespaceIngestTimeRuleResourceCrud) Delete(ctx context.Context) error {
 	request := oci_log_analytics.DeleteIngestTimeRuleRequest{}
 
 	if ingestTimeRuleId, ok := s.D.GetOkExists("ingest_time_rule_id"); ok {
@@ -489,7 +490,7 @@ func (s *LogAnalyticsNamespaceIngestTimeRuleResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	_, err := s.Client.DeleteIngestTimeRule(context.Background(), request)
+	_, err := s.Client.DeleteIngestTimeRule(ctx, request)
 	return err
 }
 
@@ -880,7 +881,7 @@ func IngestTimeRuleSummaryToMap(obj oci_log_analytics.IngestTimeRuleSummary) map
 	return result
 }
 
-func (s *LogAnalyticsNamespaceIngestTimeRuleResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *LogAnalyticsNamespaceIngestTimeRuleResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_log_analytics.ChangeIngestTimeRuleCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -898,12 +899,12 @@ func (s *LogAnalyticsNamespaceIngestTimeRuleResourceCrud) updateCompartment(comp
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	_, err := s.Client.ChangeIngestTimeRuleCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeIngestTimeRuleCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package log_analytics
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v65/loganalytics"
 
@@ -15,7 +16,7 @@ import (
 
 func LogAnalyticsNamespaceIngestTimeRulesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLogAnalyticsNamespaceIngestTimeRules,
+		ReadContext: readLogAnalyticsNamespaceIngestTimeRules,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -125,12 +126,12 @@ func LogAnalyticsNamespaceIngestTimeRulesDataSource() *schema.Resource {
 	}
 }
 
-func readLogAnalyticsNamespaceIngestTimeRules(d *schema.ResourceData, m interface{}) error {
+func readLogAnalyticsNamespaceIngestTimeRules(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsNamespaceIngestTimeRulesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LogAnalyticsNamespaceIngestTimeRulesDataSourceCrud struct {
@@ -143,7 +144,7 @@ func (s *LogAnalyticsNamespaceIngestTimeRulesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LogAnalyticsNamespaceIngestTimeRulesDataSourceCrud) Get() error {
+func (s *LogAnalyticsNamespaceIngestTimeRulesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_log_analytics.ListIngestTimeRulesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -181,7 +182,7 @@ func (s *LogAnalyticsNamespaceIngestTimeRulesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.ListIngestTimeRules(context.Background(), request)
+	response, err := s.Client.ListIngestTimeRules(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -190,7 +191,7 @@ func (s *LogAnalyticsNamespaceIngestTimeRulesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIngestTimeRules(context.Background(), request)
+		listResponse, err := s.Client.ListIngestTimeRules(ctx, request)
 		if err != nil {
 			return err
 		}
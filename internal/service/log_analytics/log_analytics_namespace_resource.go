@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 
@@ -25,10 +26,10 @@ func LogAnalyticsNamespaceResource() *schema.Resource {
 			Create: tfresource.GetTimeoutDuration("2m"),
 			Update: tfresource.GetTimeoutDuration("2m"),
 		},
-		Create: createLogAnalyticsNamespace,
-		Read:   readLogAnalyticsNamespace,
-		Update: updateLogAnalyticsNamespace,
-		Delete: deleteLogAnalyticsNamespace,
+		CreateContext: createLogAnalyticsNamespace,
+		ReadContext:   readLogAnalyticsNamespace,
+		UpdateContext: updateLogAnalyticsNamespace,
+		DeleteContext: deleteLogAnalyticsNamespace,
 		Schema: map[string]*schema.Schema{
 			"namespace": {
 				Type:     schema.TypeString,
@@ -46,31 +47,31 @@ func LogAnalyticsNamespaceResource() *schema.Resource {
 	}
 }
 
-func createLogAnalyticsNamespace(d *schema.ResourceData, m interface{}) error {
+func createLogAnalyticsNamespace(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsNamespaceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readLogAnalyticsNamespace(d *schema.ResourceData, m interface{}) error {
+func readLogAnalyticsNamespace(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsNamespaceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateLogAnalyticsNamespace(d *schema.ResourceData, m interface{}) error {
+func updateLogAnalyticsNamespace(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsNamespaceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteLogAnalyticsNamespace(d *schema.ResourceData, m interface{}) error {
+func deleteLogAnalyticsNamespace(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -85,28 +86,28 @@ func (s *LogAnalyticsNamespaceResourceCrud) ID() string {
 	return s.D.Get("namespace").(string)
 }
 
-func (s *LogAnalyticsNamespaceResourceCrud) Create() error {
+func (s *LogAnalyticsNamespaceResourceCrud) Create(ctx context.Context) error {
 	// This resource can't actually be created. So treat it as an Update instead.
-	if err := s.Get(); err != nil {
+	if err := s.Get(ctx); err != nil {
 		return err
 	}
-	return s.Update()
+	return s.Update(ctx)
 }
 
-func (s *LogAnalyticsNamespaceResourceCrud) Update() error {
+func (s *LogAnalyticsNamespaceResourceCrud) Update(ctx context.Context) error {
 	var desiredState bool
 	if isOnboarded, ok := s.D.GetOkExists("is_onboarded"); ok && s.D.HasChange("is_onboarded") {
 		desiredState = isOnboarded.(bool)
 		if desiredState == true {
-			return s.OnboardNamespace()
+			return s.OnboardNamespace(ctx)
 		} else {
-			return s.OffboardNamespace()
+			return s.OffboardNamespace(ctx)
 		}
 	}
 	return nil
 }
 
-func (s *LogAnalyticsNamespaceResourceCrud) OnboardNamespace() error {
+func (s *LogAnalyticsNamespaceResourceCrud) OnboardNamespace(ctx context.Context) error {
 	request := oci_log_analytics.OnboardNamespaceRequest{}
 	var namespace *string
 	if ns, ok := s.D.GetOkExists("namespace"); ok {
@@ -116,16 +117,16 @@ func (s *LogAnalyticsNamespaceResourceCrud) OnboardNamespace() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
-	response, err := s.Client.OnboardNamespace(context.Background(), request)
+	response, err := s.Client.OnboardNamespace(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getNamespaceFromWorkRequest(workId, namespace, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics"), oci_log_analytics.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getNamespaceFromWorkRequest(ctx, workId, namespace, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics"), oci_log_analytics.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *LogAnalyticsNamespaceResourceCrud) OffboardNamespace() error {
+func (s *LogAnalyticsNamespaceResourceCrud) OffboardNamespace(ctx context.Context) error {
 	request := oci_log_analytics.OffboardNamespaceRequest{}
 	var namespace *string
 	if ns, ok := s.D.GetOkExists("namespace"); ok {
@@ -135,32 +136,32 @@ func (s *LogAnalyticsNamespaceResourceCrud) OffboardNamespace() error {
 	}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
-	response, err := s.Client.OffboardNamespace(context.Background(), request)
+	response, err := s.Client.OffboardNamespace(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getNamespaceFromWorkRequest(workId, namespace, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics"), oci_log_analytics.ActionTypesDeleted, s.D.Timeout(schema.TimeoutCreate))
+	return s.getNamespaceFromWorkRequest(ctx, workId, namespace, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics"), oci_log_analytics.ActionTypesDeleted, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *LogAnalyticsNamespaceResourceCrud) getNamespaceFromWorkRequest(workId *string, ns *string, retryPolicy *oci_common.RetryPolicy, actionTypeEnum oci_log_analytics.ActionTypesEnum, timeout time.Duration) error {
+func (s *LogAnalyticsNamespaceResourceCrud) getNamespaceFromWorkRequest(ctx context.Context, workId *string, ns *string, retryPolicy *oci_common.RetryPolicy, actionTypeEnum oci_log_analytics.ActionTypesEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	namespaceName, err := logAnalyticsWaitForWorkRequest(workId, ns, "loganalytics", actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
+	namespaceName, err := logAnalyticsWaitForWorkRequest(ctx, workId, ns, "loganalytics", actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		return err
 	}
 	s.D.SetId(*namespaceName)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 // GET namespace returns 404 Not Found if tenancy not on-boarded.
 // LIST namespace returns response irrespective of whether tenancy is on-boarded or off-boarded
 // if tenancy is off-boarded during Update, the GET would throw 404 but LIST would work, hence using LIST instead of GET
-func (s *LogAnalyticsNamespaceResourceCrud) Get() error {
+func (s *LogAnalyticsNamespaceResourceCrud) Get(ctx context.Context) error {
 	request := oci_log_analytics.ListNamespacesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -170,7 +171,7 @@ func (s *LogAnalyticsNamespaceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.ListNamespaces(context.Background(), request)
+	response, err := s.Client.ListNamespaces(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -210,7 +211,7 @@ func (s *LogAnalyticsNamespaceResourceCrud) SetData() error {
 	return nil
 }
 
-func logAnalyticsWaitForWorkRequest(wId *string, ns *string, entityType string, action oci_log_analytics.ActionTypesEnum, timeout time.Duration, disableFoundRetries bool, client *oci_log_analytics.LogAnalyticsClient) (*string, error) {
+func logAnalyticsWaitForWorkRequest(ctx context.Context, wId *string, ns *string, entityType string, action oci_log_analytics.ActionTypesEnum, timeout time.Duration, disableFoundRetries bool, client *oci_log_analytics.LogAnalyticsClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "log_analytics")
 	retryPolicy.ShouldRetryOperation = logAnalyticsWorkRequestShouldRetryFunc(timeout)
 
@@ -228,7 +229,7 @@ func logAnalyticsWaitForWorkRequest(wId *string, ns *string, entityType string,
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_log_analytics.GetWorkRequestRequest{
 					NamespaceName: ns,
 					WorkRequestId: wId,
@@ -258,7 +259,7 @@ func logAnalyticsWaitForWorkRequest(wId *string, ns *string, entityType string,
 
 	// The Log Analytics workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_log_analytics.OperationStatusFailed || response.Status == oci_log_analytics.OperationStatusCanceled {
-		return nil, getErrorFromLogAnalyticsWorkRequest(client, wId, ns, retryPolicy, entityType, action)
+		return nil, getErrorFromLogAnalyticsWorkRequest(ctx, client, wId, ns, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
@@ -287,8 +288,8 @@ func logAnalyticsWorkRequestShouldRetryFunc(timeout time.Duration) func(response
 	}
 }
 
-func getErrorFromLogAnalyticsWorkRequest(client *oci_log_analytics.LogAnalyticsClient, wId *string, ns *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_log_analytics.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromLogAnalyticsWorkRequest(ctx context.Context, client *oci_log_analytics.LogAnalyticsClient, wId *string, ns *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_log_analytics.ActionTypesEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_log_analytics.ListWorkRequestErrorsRequest{
 			WorkRequestId: wId,
 			NamespaceName: ns,
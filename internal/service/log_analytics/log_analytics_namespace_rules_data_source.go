@@ -6,6 +6,7 @@ package log_analytics
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v65/loganalytics"
 
@@ -15,7 +16,7 @@ import (
 
 func LogAnalyticsNamespaceRulesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLogAnalyticsNamespaceRules,
+		ReadContext: readLogAnalyticsNamespaceRules,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -122,12 +123,12 @@ func LogAnalyticsNamespaceRulesDataSource() *schema.Resource {
 	}
 }
 
-func readLogAnalyticsNamespaceRules(d *schema.ResourceData, m interface{}) error {
+func readLogAnalyticsNamespaceRules(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsNamespaceRulesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LogAnalyticsNamespaceRulesDataSourceCrud struct {
@@ -140,7 +141,7 @@ func (s *LogAnalyticsNamespaceRulesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LogAnalyticsNamespaceRulesDataSourceCrud) Get() error {
+func (s *LogAnalyticsNamespaceRulesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_log_analytics.ListRulesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -168,7 +169,7 @@ func (s *LogAnalyticsNamespaceRulesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.ListRules(context.Background(), request)
+	response, err := s.Client.ListRules(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -177,7 +178,7 @@ func (s *LogAnalyticsNamespaceRulesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRules(context.Background(), request)
+		listResponse, err := s.Client.ListRules(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -17,6 +17,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -28,11 +29,11 @@ func LogAnalyticsNamespaceScheduledTaskResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLogAnalyticsNamespaceScheduledTask,
-		Read:     readLogAnalyticsNamespaceScheduledTask,
-		Update:   updateLogAnalyticsNamespaceScheduledTask,
-		Delete:   deleteLogAnalyticsNamespaceScheduledTask,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLogAnalyticsNamespaceScheduledTask,
+		ReadContext:   readLogAnalyticsNamespaceScheduledTask,
+		UpdateContext: updateLogAnalyticsNamespaceScheduledTask,
+		DeleteContext: deleteLogAnalyticsNamespaceScheduledTask,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -237,37 +238,37 @@ func LogAnalyticsNamespaceScheduledTaskResource() *schema.Resource {
 	}
 }
 
-func createLogAnalyticsNamespaceScheduledTask(d *schema.ResourceData, m interface{}) error {
+func createLogAnalyticsNamespaceScheduledTask(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsNamespaceScheduledTaskResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readLogAnalyticsNamespaceScheduledTask(d *schema.ResourceData, m interface{}) error {
+func readLogAnalyticsNamespaceScheduledTask(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsNamespaceScheduledTaskResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateLogAnalyticsNamespaceScheduledTask(d *schema.ResourceData, m interface{}) error {
+func updateLogAnalyticsNamespaceScheduledTask(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsNamespaceScheduledTaskResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteLogAnalyticsNamespaceScheduledTask(d *schema.ResourceData, m interface{}) error {
+func deleteLogAnalyticsNamespaceScheduledTask(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsNamespaceScheduledTaskResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type LogAnalyticsNamespaceScheduledTaskResourceCrud struct {
@@ -314,7 +315,7 @@ func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) DeletedTarget() []strin
 	}
 }
 
-func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) Create() error {
+func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) Create(ctx context.Context) error {
 	request := oci_log_analytics.CreateScheduledTaskRequest{}
 	err := s.populateTopLevelPolymorphicCreateScheduledTaskRequest(&request)
 	if err != nil {
@@ -323,7 +324,7 @@ func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.CreateScheduledTask(context.Background(), request)
+	response, err := s.Client.CreateScheduledTask(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -332,7 +333,7 @@ func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) Create() error {
 	return nil
 }
 
-func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) Get() error {
+func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) Get(ctx context.Context) error {
 	request := oci_log_analytics.GetScheduledTaskRequest{}
 
 	namespace, scheduledTaskId, err := parseNamespaceScheduledTaskCompositeId(s.D.Id())
@@ -345,7 +346,7 @@ func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.GetScheduledTask(context.Background(), request)
+	response, err := s.Client.GetScheduledTask(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -354,11 +355,11 @@ func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) Get() error {
 	return nil
 }
 
-func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) Update() error {
+func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -423,7 +424,7 @@ func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) Update() error {
 	request.UpdateScheduledTaskDetails = details
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	response, err := s.Client.UpdateScheduledTask(context.Background(), request)
+	response, err := s.Client.UpdateScheduledTask(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -432,7 +433,7 @@ func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) Update() error {
 	return nil
 }
 
-func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) Delete() error {
+func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) Delete(ctx context.Context) error {
 	request := oci_log_analytics.DeleteScheduledTaskRequest{}
 
 	namespace, scheduledTaskId, err := parseNamespaceScheduledTaskCompositeId(s.D.Id())
@@ -445,7 +446,7 @@ func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	_, err = s.Client.DeleteScheduledTask(context.Background(), request)
+	_, err = s.Client.DeleteScheduledTask(ctx, request)
 	return err
 }
 
@@ -839,7 +840,7 @@ func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) populateTopLevelPolymor
 	return nil
 }
 
-func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_log_analytics.ChangeScheduledTaskCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -861,7 +862,7 @@ func (s *LogAnalyticsNamespaceScheduledTaskResourceCrud) updateCompartment(compa
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "log_analytics")
 
-	_, err := s.Client.ChangeScheduledTaskCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeScheduledTaskCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_log_analytics "github.com/oracle/oci-go-sdk/v65/loganalytics"
 )
 
 func LogAnalyticsNamespacesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLogAnalyticsNamespaces,
+		ReadContext: readLogAnalyticsNamespaces,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -59,12 +60,12 @@ func LogAnalyticsNamespacesDataSource() *schema.Resource {
 	}
 }
 
-func readLogAnalyticsNamespaces(d *schema.ResourceData, m interface{}) error {
+func readLogAnalyticsNamespaces(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LogAnalyticsNamespacesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LogAnalyticsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LogAnalyticsNamespacesDataSourceCrud struct {
@@ -77,7 +78,7 @@ func (s *LogAnalyticsNamespacesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LogAnalyticsNamespacesDataSourceCrud) Get() error {
+func (s *LogAnalyticsNamespacesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_log_analytics.ListNamespacesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -87,7 +88,7 @@ func (s *LogAnalyticsNamespacesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "log_analytics")
 
-	response, err := s.Client.ListNamespaces(context.Background(), request)
+	response, err := s.Client.ListNamespaces(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_logging "github.com/oracle/oci-go-sdk/v65/logging"
 )
@@ -22,12 +23,12 @@ func LoggingLogGroupDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(LoggingLogGroupResource(), fieldMap, readSingularLoggingLogGroup)
 }
 
-func readSingularLoggingLogGroup(d *schema.ResourceData, m interface{}) error {
+func readSingularLoggingLogGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingLogGroupDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LoggingLogGroupDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *LoggingLogGroupDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LoggingLogGroupDataSourceCrud) Get() error {
+func (s *LoggingLogGroupDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_logging.GetLogGroupRequest{}
 
 	if logGroupId, ok := s.D.GetOkExists("log_group_id"); ok {
@@ -50,7 +51,7 @@ func (s *LoggingLogGroupDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "logging")
 
-	response, err := s.Client.GetLogGroup(context.Background(), request)
+	response, err := s.Client.GetLogGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -28,11 +29,11 @@ func LoggingLogResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLoggingLog,
-		Read:     readLoggingLog,
-		Update:   updateLoggingLog,
-		Delete:   deleteLoggingLog,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLoggingLog,
+		ReadContext:   readLoggingLog,
+		UpdateContext: updateLoggingLog,
+		DeleteContext: deleteLoggingLog,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"display_name": {
@@ -162,37 +163,37 @@ func LoggingLogResource() *schema.Resource {
 	}
 }
 
-func createLoggingLog(d *schema.ResourceData, m interface{}) error {
+func createLoggingLog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingLogResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readLoggingLog(d *schema.ResourceData, m interface{}) error {
+func readLoggingLog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingLogResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateLoggingLog(d *schema.ResourceData, m interface{}) error {
+func updateLoggingLog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingLogResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteLoggingLog(d *schema.ResourceData, m interface{}) error {
+func deleteLoggingLog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingLogResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type LoggingLogResourceCrud struct {
@@ -228,7 +229,7 @@ func (s *LoggingLogResourceCrud) DeletedTarget() []string {
 	return []string{}
 }
 
-func (s *LoggingLogResourceCrud) Create() error {
+func (s *LoggingLogResourceCrud) Create(ctx context.Context) error {
 	request := oci_logging.CreateLogRequest{}
 
 	if configuration, ok := s.D.GetOkExists("configuration"); ok {
@@ -280,27 +281,27 @@ func (s *LoggingLogResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.CreateLog(context.Background(), request)
+	response, err := s.Client.CreateLog(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getLogFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging"), oci_logging.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getLogFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging"), oci_logging.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
 
 }
 
-func (s *LoggingLogResourceCrud) getLogFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *LoggingLogResourceCrud) getLogFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_logging.ActionTypesEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	logId, err := logWaitForWorkRequest(workId, "log",
+	logId, err := logWaitForWorkRequest(ctx, workId, "log",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, logId)
-		_, cancelErr := s.Client.DeleteWorkRequest(context.Background(),
+		_, cancelErr := s.Client.DeleteWorkRequest(ctx,
 			oci_logging.DeleteWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -314,7 +315,7 @@ func (s *LoggingLogResourceCrud) getLogFromWorkRequest(workId *string, retryPoli
 	}
 	s.D.SetId(*logId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func logWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -340,7 +341,7 @@ func logWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_comm
 	}
 }
 
-func logWaitForWorkRequest(wId *string, entityType string, action oci_logging.ActionTypesEnum,
+func logWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_logging.ActionTypesEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_logging.LoggingManagementClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "logging")
 	retryPolicy.ShouldRetryOperation = logWorkRequestShouldRetryFunc(timeout)
@@ -359,7 +360,7 @@ func logWaitForWorkRequest(wId *string, entityType string, action oci_logging.Ac
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_logging.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -388,14 +389,14 @@ func logWaitForWorkRequest(wId *string, entityType string, action oci_logging.Ac
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_logging.OperationStatusFailed || response.Status == oci_logging.OperationStatusCanceled {
-		return nil, getErrorFromLoggingLogWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromLoggingLogWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromLoggingLogWorkRequest(client *oci_logging.LoggingManagementClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_logging.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromLoggingLogWorkRequest(ctx context.Context, client *oci_logging.LoggingManagementClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_logging.ActionTypesEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_logging.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -417,7 +418,7 @@ func getErrorFromLoggingLogWorkRequest(client *oci_logging.LoggingManagementClie
 	return workRequestErr
 }
 
-func (s *LoggingLogResourceCrud) Get() error {
+func (s *LoggingLogResourceCrud) Get(ctx context.Context) error {
 	request := oci_logging.GetLogRequest{}
 
 	if logGroupId, ok := s.D.GetOkExists("log_group_id"); ok {
@@ -438,7 +439,7 @@ func (s *LoggingLogResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.GetLog(context.Background(), request)
+	response, err := s.Client.GetLog(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -447,12 +448,12 @@ func (s *LoggingLogResourceCrud) Get() error {
 	return nil
 }
 
-func (s *LoggingLogResourceCrud) Update() error {
+func (s *LoggingLogResourceCrud) Update(ctx context.Context) error {
 
 	if _, ok := s.D.GetOkExists("log_group_id"); ok && s.D.HasChange("log_group_id") {
 		oldRaw, newRaw := s.D.GetChange("log_group_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateLogGroup(oldRaw, newRaw)
+			err := s.updateLogGroup(ctx, oldRaw, newRaw)
 			if err != nil {
 				return err
 			}
@@ -498,16 +499,16 @@ func (s *LoggingLogResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.UpdateLog(context.Background(), request)
+	response, err := s.Client.UpdateLog(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getLogFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging"), oci_logging.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getLogFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging"), oci_logging.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *LoggingLogResourceCrud) Delete() error {
+func (s *LoggingLogResourceCrud) Delete(ctx context.Context) error {
 	request := oci_logging.DeleteLogRequest{}
 
 	if logGroupId, ok := s.D.GetOkExists("log_group_id"); ok {
@@ -520,7 +521,7 @@ func (s *LoggingLogResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
-	_, err := s.Client.DeleteLog(context.Background(), request)
+	_, err := s.Client.DeleteLog(ctx, request)
 	return err
 }
 
@@ -684,7 +685,7 @@ func SourceToMap(obj *oci_logging.Source) map[string]interface{} {
 	return result
 }
 
-func (s *LoggingLogResourceCrud) updateLogGroup(oldLogGroupId interface{}, newLogGroupId interface{}) error {
+func (s *LoggingLogResourceCrud) updateLogGroup(ctx context.Context, oldLogGroupId interface{}, newLogGroupId interface{}) error {
 	updateLogGroupRequest := oci_logging.ChangeLogLogGroupRequest{}
 
 	oldLogGroupIdtmp := oldLogGroupId.(string)
@@ -699,13 +700,13 @@ func (s *LoggingLogResourceCrud) updateLogGroup(oldLogGroupId interface{}, newLo
 
 	updateLogGroupRequest.ChangeLogLogGroupDetails = updateLogGroupDetails
 
-	response, err := s.Client.ChangeLogLogGroup(context.Background(), updateLogGroupRequest)
+	response, err := s.Client.ChangeLogLogGroup(ctx, updateLogGroupRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	err = s.getLogFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging"), oci_logging.ActionTypesRelated, s.D.Timeout(schema.TimeoutUpdate))
+	err = s.getLogFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging"), oci_logging.ActionTypesRelated, s.D.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_logging "github.com/oracle/oci-go-sdk/v65/logging"
 )
@@ -22,12 +23,12 @@ func LoggingLogSavedSearchDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(LoggingLogSavedSearchResource(), fieldMap, readSingularLoggingLogSavedSearch)
 }
 
-func readSingularLoggingLogSavedSearch(d *schema.ResourceData, m interface{}) error {
+func readSingularLoggingLogSavedSearch(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingLogSavedSearchDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LoggingLogSavedSearchDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *LoggingLogSavedSearchDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LoggingLogSavedSearchDataSourceCrud) Get() error {
+func (s *LoggingLogSavedSearchDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_logging.GetLogSavedSearchRequest{}
 
 	if logSavedSearchId, ok := s.D.GetOkExists("log_saved_search_id"); ok {
@@ -50,7 +51,7 @@ func (s *LoggingLogSavedSearchDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "logging")
 
-	response, err := s.Client.GetLogSavedSearch(context.Background(), request)
+	response, err := s.Client.GetLogSavedSearch(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_logging "github.com/oracle/oci-go-sdk/v65/logging"
@@ -19,11 +20,11 @@ func LoggingLogSavedSearchResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLoggingLogSavedSearch,
-		Read:     readLoggingLogSavedSearch,
-		Update:   updateLoggingLogSavedSearch,
-		Delete:   deleteLoggingLogSavedSearch,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createLoggingLogSavedSearch,
+		ReadContext:   readLoggingLogSavedSearch,
+		UpdateContext: updateLoggingLogSavedSearch,
+		DeleteContext: deleteLoggingLogSavedSearch,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -76,37 +77,37 @@ func LoggingLogSavedSearchResource() *schema.Resource {
 	}
 }
 
-func createLoggingLogSavedSearch(d *schema.ResourceData, m interface{}) error {
+func createLoggingLogSavedSearch(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingLogSavedSearchResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readLoggingLogSavedSearch(d *schema.ResourceData, m interface{}) error {
+func readLoggingLogSavedSearch(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingLogSavedSearchResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateLoggingLogSavedSearch(d *schema.ResourceData, m interface{}) error {
+func updateLoggingLogSavedSearch(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingLogSavedSearchResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteLoggingLogSavedSearch(d *schema.ResourceData, m interface{}) error {
+func deleteLoggingLogSavedSearch(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingLogSavedSearchResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type LoggingLogSavedSearchResourceCrud struct {
@@ -154,7 +155,7 @@ func (s *LoggingLogSavedSearchResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *LoggingLogSavedSearchResourceCrud) Create() error {
+func (s *LoggingLogSavedSearchResourceCrud) Create(ctx context.Context) error {
 	request := oci_logging.CreateLogSavedSearchRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -191,7 +192,7 @@ func (s *LoggingLogSavedSearchResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.CreateLogSavedSearch(context.Background(), request)
+	response, err := s.Client.CreateLogSavedSearch(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -200,7 +201,7 @@ func (s *LoggingLogSavedSearchResourceCrud) Create() error {
 	return nil
 }
 
-func (s *LoggingLogSavedSearchResourceCrud) Get() error {
+func (s *LoggingLogSavedSearchResourceCrud) Get(ctx context.Context) error {
 	request := oci_logging.GetLogSavedSearchRequest{}
 
 	tmp := s.D.Id()
@@ -208,7 +209,7 @@ func (s *LoggingLogSavedSearchResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.GetLogSavedSearch(context.Background(), request)
+	response, err := s.Client.GetLogSavedSearch(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -217,11 +218,11 @@ func (s *LoggingLogSavedSearchResourceCrud) Get() error {
 	return nil
 }
 
-func (s *LoggingLogSavedSearchResourceCrud) Update() error {
+func (s *LoggingLogSavedSearchResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -261,7 +262,7 @@ func (s *LoggingLogSavedSearchResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
-	response, err := s.Client.UpdateLogSavedSearch(context.Background(), request)
+	response, err := s.Client.UpdateLogSavedSearch(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -270,7 +271,7 @@ func (s *LoggingLogSavedSearchResourceCrud) Update() error {
 	return nil
 }
 
-func (s *LoggingLogSavedSearchResourceCrud) Delete() error {
+func (s *LoggingLogSavedSearchResourceCrud) Delete(ctx context.Context) error {
 	request := oci_logging.DeleteLogSavedSearchRequest{}
 
 	tmp := s.D.Id()
@@ -278,7 +279,7 @@ func (s *LoggingLogSavedSearchResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
-	_, err := s.Client.DeleteLogSavedSearch(context.Background(), request)
+	_, err := s.Client.DeleteLogSavedSearch(ctx, request)
 	return err
 }
 
@@ -360,7 +361,7 @@ func LogSavedSearchSummaryToMap(obj oci_logging.LogSavedSearchSummary) map[strin
 	return result
 }
 
-func (s *LoggingLogSavedSearchResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *LoggingLogSavedSearchResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_logging.ChangeLogSavedSearchCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -371,12 +372,12 @@ func (s *LoggingLogSavedSearchResourceCrud) updateCompartment(compartment interf
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
 
-	_, err := s.Client.ChangeLogSavedSearchCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeLogSavedSearchCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
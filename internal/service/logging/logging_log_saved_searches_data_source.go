@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_logging "github.com/oracle/oci-go-sdk/v65/logging"
 )
 
 func LoggingLogSavedSearchesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLoggingLogSavedSearches,
+		ReadContext: readLoggingLogSavedSearches,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,12 +49,12 @@ func LoggingLogSavedSearchesDataSource() *schema.Resource {
 	}
 }
 
-func readLoggingLogSavedSearches(d *schema.ResourceData, m interface{}) error {
+func readLoggingLogSavedSearches(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingLogSavedSearchesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LoggingLogSavedSearchesDataSourceCrud struct {
@@ -66,7 +67,7 @@ func (s *LoggingLogSavedSearchesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LoggingLogSavedSearchesDataSourceCrud) Get() error {
+func (s *LoggingLogSavedSearchesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_logging.ListLogSavedSearchesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -86,7 +87,7 @@ func (s *LoggingLogSavedSearchesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "logging")
 
-	response, err := s.Client.ListLogSavedSearches(context.Background(), request)
+	response, err := s.Client.ListLogSavedSearches(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -95,7 +96,7 @@ func (s *LoggingLogSavedSearchesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListLogSavedSearches(context.Background(), request)
+		listResponse, err := s.Client.ListLogSavedSearches(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	oci_logging "github.com/oracle/oci-go-sdk/v65/logging"
@@ -16,7 +17,7 @@ import (
 
 func LoggingUnifiedAgentConfigurationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readLoggingUnifiedAgentConfigurations,
+		ReadContext: readLoggingUnifiedAgentConfigurations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -122,12 +123,12 @@ func LoggingUnifiedAgentConfigurationsDataSource() *schema.Resource {
 	}
 }
 
-func readLoggingUnifiedAgentConfigurations(d *schema.ResourceData, m interface{}) error {
+func readLoggingUnifiedAgentConfigurations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &LoggingUnifiedAgentConfigurationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type LoggingUnifiedAgentConfigurationsDataSourceCrud struct {
@@ -140,7 +141,7 @@ func (s *LoggingUnifiedAgentConfigurationsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *LoggingUnifiedAgentConfigurationsDataSourceCrud) Get() error {
+func (s *LoggingUnifiedAgentConfigurationsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_logging.ListUnifiedAgentConfigurationsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -174,7 +175,7 @@ func (s *LoggingUnifiedAgentConfigurationsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "logging")
 
-	response, err := s.Client.ListUnifiedAgentConfigurations(context.Background(), request)
+	response, err := s.Client.ListUnifiedAgentConfigurations(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -183,7 +184,7 @@ func (s *LoggingUnifiedAgentConfigurationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListUnifiedAgentConfigurations(context.Background(), request)
+		listResponse, err := s.Client.ListUnifiedAgentConfigurations(ctx, request)
 		if err != nil {
 			return err
 		}
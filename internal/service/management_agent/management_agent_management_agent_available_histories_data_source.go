@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_management_agent "github.com/oracle/oci-go-sdk/v65/managementagent"
@@ -17,7 +18,7 @@ import (
 
 func ManagementAgentManagementAgentAvailableHistoriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readManagementAgentManagementAgentAvailableHistories,
+		ReadContext: readManagementAgentManagementAgentAvailableHistories,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"management_agent_id": {
@@ -65,12 +66,12 @@ func ManagementAgentManagementAgentAvailableHistoriesDataSource() *schema.Resour
 	}
 }
 
-func readManagementAgentManagementAgentAvailableHistories(d *schema.ResourceData, m interface{}) error {
+func readManagementAgentManagementAgentAvailableHistories(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentAvailableHistoriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ManagementAgentManagementAgentAvailableHistoriesDataSourceCrud struct {
@@ -83,7 +84,7 @@ func (s *ManagementAgentManagementAgentAvailableHistoriesDataSourceCrud) VoidSta
 	s.D.SetId("")
 }
 
-func (s *ManagementAgentManagementAgentAvailableHistoriesDataSourceCrud) Get() error {
+func (s *ManagementAgentManagementAgentAvailableHistoriesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_management_agent.ListAvailabilityHistoriesRequest{}
 
 	if managementAgentId, ok := s.D.GetOkExists("management_agent_id"); ok {
@@ -109,7 +110,7 @@ func (s *ManagementAgentManagementAgentAvailableHistoriesDataSourceCrud) Get() e
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_agent")
 
-	response, err := s.Client.ListAvailabilityHistories(context.Background(), request)
+	response, err := s.Client.ListAvailabilityHistories(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -118,7 +119,7 @@ func (s *ManagementAgentManagementAgentAvailableHistoriesDataSourceCrud) Get() e
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAvailabilityHistories(context.Background(), request)
+		listResponse, err := s.Client.ListAvailabilityHistories(ctx, request)
 		if err != nil {
 			return err
 		}
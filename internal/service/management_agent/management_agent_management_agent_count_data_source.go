@@ -6,6 +6,7 @@ package management_agent
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_management_agent "github.com/oracle/oci-go-sdk/v65/managementagent"
 
@@ -15,7 +16,7 @@ import (
 
 func ManagementAgentManagementAgentCountDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularManagementAgentManagementAgentCount,
+		ReadContext: readSingularManagementAgentManagementAgentCount,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -91,12 +92,12 @@ func ManagementAgentManagementAgentCountDataSource() *schema.Resource {
 	}
 }
 
-func readSingularManagementAgentManagementAgentCount(d *schema.ResourceData, m interface{}) error {
+func readSingularManagementAgentManagementAgentCount(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentCountDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ManagementAgentManagementAgentCountDataSourceCrud struct {
@@ -109,7 +110,7 @@ func (s *ManagementAgentManagementAgentCountDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ManagementAgentManagementAgentCountDataSourceCrud) Get() error {
+func (s *ManagementAgentManagementAgentCountDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_management_agent.SummarizeManagementAgentCountsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -141,7 +142,7 @@ func (s *ManagementAgentManagementAgentCountDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_agent")
 
-	response, err := s.Client.SummarizeManagementAgentCounts(context.Background(), request)
+	response, err := s.Client.SummarizeManagementAgentCounts(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package management_agent
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_management_agent "github.com/oracle/oci-go-sdk/v65/managementagent"
 
@@ -22,12 +23,12 @@ func ManagementAgentManagementAgentDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ManagementAgentManagementAgentResource(), fieldMap, readSingularManagementAgentManagementAgent)
 }
 
-func readSingularManagementAgentManagementAgent(d *schema.ResourceData, m interface{}) error {
+func readSingularManagementAgentManagementAgent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ManagementAgentManagementAgentDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *ManagementAgentManagementAgentDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ManagementAgentManagementAgentDataSourceCrud) Get() error {
+func (s *ManagementAgentManagementAgentDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_management_agent.GetManagementAgentRequest{}
 
 	if managementAgentId, ok := s.D.GetOkExists("management_agent_id"); ok {
@@ -50,7 +51,7 @@ func (s *ManagementAgentManagementAgentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_agent")
 
-	response, err := s.Client.GetManagementAgent(context.Background(), request)
+	response, err := s.Client.GetManagementAgent(ctx, request)
 	if err != nil {
 		return err
 	}
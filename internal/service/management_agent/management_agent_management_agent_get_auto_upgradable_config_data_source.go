@@ -6,6 +6,7 @@ package management_agent
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_management_agent "github.com/oracle/oci-go-sdk/v65/managementagent"
 
@@ -15,7 +16,7 @@ import (
 
 func ManagementAgentManagementAgentGetAutoUpgradableConfigDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularManagementAgentManagementAgentGetAutoUpgradableConfig,
+		ReadContext: readSingularManagementAgentManagementAgentGetAutoUpgradableConfig,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -30,12 +31,12 @@ func ManagementAgentManagementAgentGetAutoUpgradableConfigDataSource() *schema.R
 	}
 }
 
-func readSingularManagementAgentManagementAgentGetAutoUpgradableConfig(d *schema.ResourceData, m interface{}) error {
+func readSingularManagementAgentManagementAgentGetAutoUpgradableConfig(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentGetAutoUpgradableConfigDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ManagementAgentManagementAgentGetAutoUpgradableConfigDataSourceCrud struct {
@@ -48,7 +49,7 @@ func (s *ManagementAgentManagementAgentGetAutoUpgradableConfigDataSourceCrud) Vo
 	s.D.SetId("")
 }
 
-func (s *ManagementAgentManagementAgentGetAutoUpgradableConfigDataSourceCrud) Get() error {
+func (s *ManagementAgentManagementAgentGetAutoUpgradableConfigDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_management_agent.GetAutoUpgradableConfigRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -58,7 +59,7 @@ func (s *ManagementAgentManagementAgentGetAutoUpgradableConfigDataSourceCrud) Ge
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_agent")
 
-	response, err := s.Client.GetAutoUpgradableConfig(context.Background(), request)
+	response, err := s.Client.GetAutoUpgradableConfig(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package management_agent
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_management_agent "github.com/oracle/oci-go-sdk/v65/managementagent"
 
@@ -15,7 +16,7 @@ import (
 
 func ManagementAgentManagementAgentImagesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readManagementAgentManagementAgentImages,
+		ReadContext: readManagementAgentManagementAgentImages,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -91,12 +92,12 @@ func ManagementAgentManagementAgentImagesDataSource() *schema.Resource {
 	}
 }
 
-func readManagementAgentManagementAgentImages(d *schema.ResourceData, m interface{}) error {
+func readManagementAgentManagementAgentImages(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentImagesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ManagementAgentManagementAgentImagesDataSourceCrud struct {
@@ -109,7 +110,7 @@ func (s *ManagementAgentManagementAgentImagesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ManagementAgentManagementAgentImagesDataSourceCrud) Get() error {
+func (s *ManagementAgentManagementAgentImagesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_management_agent.ListManagementAgentImagesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -132,7 +133,7 @@ func (s *ManagementAgentManagementAgentImagesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_agent")
 
-	response, err := s.Client.ListManagementAgentImages(context.Background(), request)
+	response, err := s.Client.ListManagementAgentImages(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -141,7 +142,7 @@ func (s *ManagementAgentManagementAgentImagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListManagementAgentImages(context.Background(), request)
+		listResponse, err := s.Client.ListManagementAgentImages(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_management_agent "github.com/oracle/oci-go-sdk/v65/managementagent"
 
@@ -23,12 +24,12 @@ func ManagementAgentManagementAgentInstallKeyDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ManagementAgentManagementAgentInstallKeyResource(), fieldMap, readSingularManagementAgentManagementAgentInstallKey)
 }
 
-func readSingularManagementAgentManagementAgentInstallKey(d *schema.ResourceData, m interface{}) error {
+func readSingularManagementAgentManagementAgentInstallKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentInstallKeyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ManagementAgentManagementAgentInstallKeyDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *ManagementAgentManagementAgentInstallKeyDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ManagementAgentManagementAgentInstallKeyDataSourceCrud) Get() error {
+func (s *ManagementAgentManagementAgentInstallKeyDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_management_agent.GetManagementAgentInstallKeyRequest{}
 
 	if managementAgentInstallKeyId, ok := s.D.GetOkExists("management_agent_install_key_id"); ok {
@@ -51,7 +52,7 @@ func (s *ManagementAgentManagementAgentInstallKeyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_agent")
 
-	response, err := s.Client.GetManagementAgentInstallKey(context.Background(), request)
+	response, err := s.Client.GetManagementAgentInstallKey(ctx, request)
 	if err != nil {
 		return err
 	}
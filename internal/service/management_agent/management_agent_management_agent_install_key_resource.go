@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
@@ -21,11 +22,11 @@ func ManagementAgentManagementAgentInstallKeyResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createManagementAgentManagementAgentInstallKey,
-		Read:     readManagementAgentManagementAgentInstallKey,
-		Update:   updateManagementAgentManagementAgentInstallKey,
-		Delete:   deleteManagementAgentManagementAgentInstallKey,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createManagementAgentManagementAgentInstallKey,
+		ReadContext:   readManagementAgentManagementAgentInstallKey,
+		UpdateContext: updateManagementAgentManagementAgentInstallKey,
+		DeleteContext: deleteManagementAgentManagementAgentInstallKey,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -92,37 +93,37 @@ func ManagementAgentManagementAgentInstallKeyResource() *schema.Resource {
 	}
 }
 
-func createManagementAgentManagementAgentInstallKey(d *schema.ResourceData, m interface{}) error {
+func createManagementAgentManagementAgentInstallKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentInstallKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readManagementAgentManagementAgentInstallKey(d *schema.ResourceData, m interface{}) error {
+func readManagementAgentManagementAgentInstallKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentInstallKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateManagementAgentManagementAgentInstallKey(d *schema.ResourceData, m interface{}) error {
+func updateManagementAgentManagementAgentInstallKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentInstallKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteManagementAgentManagementAgentInstallKey(d *schema.ResourceData, m interface{}) error {
+func deleteManagementAgentManagementAgentInstallKey(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentInstallKeyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type ManagementAgentManagementAgentInstallKeyResourceCrud struct {
@@ -161,7 +162,7 @@ func (s *ManagementAgentManagementAgentInstallKeyResourceCrud) DeletedTarget() [
 	}
 }
 
-func (s *ManagementAgentManagementAgentInstallKeyResourceCrud) Create() error {
+func (s *ManagementAgentManagementAgentInstallKeyResourceCrud) Create(ctx context.Context) error {
 	request := oci_management_agent.CreateManagementAgentInstallKeyRequest{}
 
 	if allowedKeyInstallCount, ok := s.D.GetOkExists("allowed_key_install_count"); ok {
@@ -194,7 +195,7 @@ func (s *ManagementAgentManagementAgentInstallKeyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_agent")
 
-	response, err := s.Client.CreateManagementAgentInstallKey(context.Background(), request)
+	response, err := s.Client.CreateManagementAgentInstallKey(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -203,7 +204,7 @@ func (s *ManagementAgentManagementAgentInstallKeyResourceCrud) Create() error {
 	return nil
 }
 
-func (s *ManagementAgentManagementAgentInstallKeyResourceCrud) Get() error {
+func (s *ManagementAgentManagementAgentInstallKeyResourceCrud) Get(ctx context.Context) error {
 	request := oci_management_agent.GetManagementAgentInstallKeyRequest{}
 
 	tmp := s.D.Id()
@@ -211,7 +212,7 @@ func (s *ManagementAgentManagementAgentInstallKeyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_agent")
 
-	response, err := s.Client.GetManagementAgentInstallKey(context.Background(), request)
+	response, err := s.Client.GetManagementAgentInstallKey(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -220,7 +221,7 @@ func (s *ManagementAgentManagementAgentInstallKeyResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ManagementAgentManagementAgentInstallKeyResourceCrud) Update() error {
+func (s *ManagementAgentManagementAgentInstallKeyResourceCrud) Update(ctx context.Context) error {
 	request := oci_management_agent.UpdateManagementAgentInstallKeyRequest{}
 
 	if displayName, ok := s.D.GetOkExists("display_name"); ok {
@@ -238,7 +239,7 @@ func (s *ManagementAgentManagementAgentInstallKeyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_agent")
 
-	response, err := s.Client.UpdateManagementAgentInstallKey(context.Background(), request)
+	response, err := s.Client.UpdateManagementAgentInstallKey(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -247,7 +248,7 @@ func (s *ManagementAgentManagementAgentInstallKeyResourceCrud) Update() error {
 	return nil
 }
 
-func (s *ManagementAgentManagementAgentInstallKeyResourceCrud) Delete() error {
+func (s *ManagementAgentManagementAgentInstallKeyResourceCrud) Delete(ctx context.Context) error {
 	request := oci_management_agent.DeleteManagementAgentInstallKeyRequest{}
 
 	tmp := s.D.Id()
@@ -255,7 +256,7 @@ func (s *ManagementAgentManagementAgentInstallKeyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_agent")
 
-	_, err := s.Client.DeleteManagementAgentInstallKey(context.Background(), request)
+	_, err := s.Client.DeleteManagementAgentInstallKey(ctx, request)
 	return err
 }
 
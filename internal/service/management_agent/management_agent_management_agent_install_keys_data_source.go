@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_management_agent "github.com/oracle/oci-go-sdk/v65/managementagent"
 
@@ -16,7 +17,7 @@ import (
 
 func ManagementAgentManagementAgentInstallKeysDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readManagementAgentManagementAgentInstallKeys,
+		ReadContext: readManagementAgentManagementAgentInstallKeys,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -48,12 +49,12 @@ func ManagementAgentManagementAgentInstallKeysDataSource() *schema.Resource {
 	}
 }
 
-func readManagementAgentManagementAgentInstallKeys(d *schema.ResourceData, m interface{}) error {
+func readManagementAgentManagementAgentInstallKeys(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentInstallKeysDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ManagementAgentManagementAgentInstallKeysDataSourceCrud struct {
@@ -66,7 +67,7 @@ func (s *ManagementAgentManagementAgentInstallKeysDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ManagementAgentManagementAgentInstallKeysDataSourceCrud) Get() error {
+func (s *ManagementAgentManagementAgentInstallKeysDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_management_agent.ListManagementAgentInstallKeysRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -95,7 +96,7 @@ func (s *ManagementAgentManagementAgentInstallKeysDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_agent")
 
-	response, err := s.Client.ListManagementAgentInstallKeys(context.Background(), request)
+	response, err := s.Client.ListManagementAgentInstallKeys(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -104,7 +105,7 @@ func (s *ManagementAgentManagementAgentInstallKeysDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListManagementAgentInstallKeys(context.Background(), request)
+		listResponse, err := s.Client.ListManagementAgentInstallKeys(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package management_agent
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_management_agent "github.com/oracle/oci-go-sdk/v65/managementagent"
 
@@ -15,7 +16,7 @@ import (
 
 func ManagementAgentManagementAgentPluginCountDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularManagementAgentManagementAgentPluginCount,
+		ReadContext: readSingularManagementAgentManagementAgentPluginCount,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -68,12 +69,12 @@ func ManagementAgentManagementAgentPluginCountDataSource() *schema.Resource {
 	}
 }
 
-func readSingularManagementAgentManagementAgentPluginCount(d *schema.ResourceData, m interface{}) error {
+func readSingularManagementAgentManagementAgentPluginCount(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentPluginCountDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ManagementAgentManagementAgentPluginCountDataSourceCrud struct {
@@ -86,7 +87,7 @@ func (s *ManagementAgentManagementAgentPluginCountDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ManagementAgentManagementAgentPluginCountDataSourceCrud) Get() error {
+func (s *ManagementAgentManagementAgentPluginCountDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_management_agent.SummarizeManagementAgentPluginCountsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -100,7 +101,7 @@ func (s *ManagementAgentManagementAgentPluginCountDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_agent")
 
-	response, err := s.Client.SummarizeManagementAgentPluginCounts(context.Background(), request)
+	response, err := s.Client.SummarizeManagementAgentPluginCounts(ctx, request)
 	if err != nil {
 		return err
 	}
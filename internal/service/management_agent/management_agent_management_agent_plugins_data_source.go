@@ -6,6 +6,7 @@ package management_agent
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_management_agent "github.com/oracle/oci-go-sdk/v65/managementagent"
 
@@ -15,7 +16,7 @@ import (
 
 func ManagementAgentManagementAgentPluginsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readManagementAgentManagementAgentPlugins,
+		ReadContext: readManagementAgentManagementAgentPlugins,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"agent_id": {
@@ -93,12 +94,12 @@ func ManagementAgentManagementAgentPluginsDataSource() *schema.Resource {
 	}
 }
 
-func readManagementAgentManagementAgentPlugins(d *schema.ResourceData, m interface{}) error {
+func readManagementAgentManagementAgentPlugins(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentPluginsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ManagementAgentManagementAgentPluginsDataSourceCrud struct {
@@ -111,7 +112,7 @@ func (s *ManagementAgentManagementAgentPluginsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ManagementAgentManagementAgentPluginsDataSourceCrud) Get() error {
+func (s *ManagementAgentManagementAgentPluginsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_management_agent.ListManagementAgentPluginsRequest{}
 
 	if agentId, ok := s.D.GetOkExists("agent_id"); ok {
@@ -148,7 +149,7 @@ func (s *ManagementAgentManagementAgentPluginsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_agent")
 
-	response, err := s.Client.ListManagementAgentPlugins(context.Background(), request)
+	response, err := s.Client.ListManagementAgentPlugins(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -157,7 +158,7 @@ func (s *ManagementAgentManagementAgentPluginsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListManagementAgentPlugins(context.Background(), request)
+		listResponse, err := s.Client.ListManagementAgentPlugins(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -25,11 +26,11 @@ func ManagementAgentManagementAgentResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createManagementAgentManagementAgent,
-		Read:     readManagementAgentManagementAgent,
-		Update:   updateManagementAgentManagementAgent,
-		Delete:   deleteManagementAgentManagementAgent,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createManagementAgentManagementAgent,
+		ReadContext:   readManagementAgentManagementAgent,
+		UpdateContext: updateManagementAgentManagementAgent,
+		DeleteContext: deleteManagementAgentManagementAgent,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"managed_agent_id": {
@@ -187,37 +188,37 @@ func ManagementAgentManagementAgentResource() *schema.Resource {
 	}
 }
 
-func createManagementAgentManagementAgent(d *schema.ResourceData, m interface{}) error {
+func createManagementAgentManagementAgent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readManagementAgentManagementAgent(d *schema.ResourceData, m interface{}) error {
+func readManagementAgentManagementAgent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateManagementAgentManagementAgent(d *schema.ResourceData, m interface{}) error {
+func updateManagementAgentManagementAgent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteManagementAgentManagementAgent(d *schema.ResourceData, m interface{}) error {
+func deleteManagementAgentManagementAgent(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type ManagementAgentManagementAgentResourceCrud struct {
@@ -256,11 +257,11 @@ func (s *ManagementAgentManagementAgentResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *ManagementAgentManagementAgentResourceCrud) getManagementAgentFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *ManagementAgentManagementAgentResourceCrud) getManagementAgentFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_management_agent.ActionTypesEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	managementAgentId, err := managementAgentWaitForWorkRequest(workId, "managementagent",
+	managementAgentId, err := managementAgentWaitForWorkRequest(ctx, workId, "managementagent",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -280,7 +281,7 @@ func (s *ManagementAgentManagementAgentResourceCrud) getManagementAgentFromWorkR
 	}
 	s.D.SetId(*managementAgentId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func managementAgentWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -306,7 +307,7 @@ func managementAgentWorkRequestShouldRetryFunc(timeout time.Duration) func(respo
 	}
 }
 
-func managementAgentWaitForWorkRequest(wId *string, entityType string, action oci_management_agent.ActionTypesEnum,
+func managementAgentWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_management_agent.ActionTypesEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_management_agent.ManagementAgentClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "management_agent")
 	retryPolicy.ShouldRetryOperation = managementAgentWorkRequestShouldRetryFunc(timeout)
@@ -325,7 +326,7 @@ func managementAgentWaitForWorkRequest(wId *string, entityType string, action oc
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_management_agent.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -354,14 +355,14 @@ func managementAgentWaitForWorkRequest(wId *string, entityType string, action oc
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_management_agent.OperationStatusFailed || response.Status == oci_management_agent.OperationStatusCanceled {
-		return nil, getErrorFromManagementAgentManagementAgentWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromManagementAgentManagementAgentWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromManagementAgentManagementAgentWorkRequest(client *oci_management_agent.ManagementAgentClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_management_agent.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromManagementAgentManagementAgentWorkRequest(ctx context.Context, client *oci_management_agent.ManagementAgentClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_management_agent.ActionTypesEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_management_agent.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -383,7 +384,7 @@ func getErrorFromManagementAgentManagementAgentWorkRequest(client *oci_managemen
 	return workRequestErr
 }
 
-func (s *ManagementAgentManagementAgentResourceCrud) Get() error {
+func (s *ManagementAgentManagementAgentResourceCrud) Get(ctx context.Context) error {
 	request := oci_management_agent.GetManagementAgentRequest{}
 
 	if managedInstanceId, ok := s.D.GetOkExists("managed_agent_id"); ok {
@@ -395,7 +396,7 @@ func (s *ManagementAgentManagementAgentResourceCrud) Get() error {
 	request.ManagementAgentId = &managedInstanceId
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_agent")
 
-	response, err := s.Client.GetManagementAgent(context.Background(), request)
+	response, err := s.Client.GetManagementAgent(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -404,7 +405,7 @@ func (s *ManagementAgentManagementAgentResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ManagementAgentManagementAgentResourceCrud) Update() error {
+func (s *ManagementAgentManagementAgentResourceCrud) Update(ctx context.Context) error {
 	request := oci_management_agent.UpdateManagementAgentRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -434,7 +435,7 @@ func (s *ManagementAgentManagementAgentResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_agent")
 
-	response, err := s.Client.UpdateManagementAgent(context.Background(), request)
+	response, err := s.Client.UpdateManagementAgent(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -451,7 +452,7 @@ func (s *ManagementAgentManagementAgentResourceCrud) Update() error {
 		}
 		if len(tmp) != 0 || s.D.HasChange("deploy_plugins_id") {
 			comparmtentId := response.CompartmentId
-			if err = s.deployPlugin(tmp, agentId, *comparmtentId); err != nil {
+			if err = s.deployPlugin(ctx, tmp, agentId, *comparmtentId); err != nil {
 				return err
 			}
 		}
@@ -459,7 +460,7 @@ func (s *ManagementAgentManagementAgentResourceCrud) Update() error {
 	return nil
 }
 
-func (s *ManagementAgentManagementAgentResourceCrud) Delete() error {
+func (s *ManagementAgentManagementAgentResourceCrud) Delete(ctx context.Context) error {
 	request := oci_management_agent.DeleteManagementAgentRequest{}
 
 	tmp := s.D.Id()
@@ -467,7 +468,7 @@ func (s *ManagementAgentManagementAgentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_agent")
 
-	_, err := s.Client.DeleteManagementAgent(context.Background(), request)
+	_, err := s.Client.DeleteManagementAgent(ctx, request)
 	return err
 }
 
@@ -596,16 +597,16 @@ func ManagementAgentPluginDetailsToMap(obj oci_management_agent.ManagementAgentP
 	return result
 }
 
-func (s *ManagementAgentManagementAgentResourceCrud) Create() error {
-	e := s.Get()
+func (s *ManagementAgentManagementAgentResourceCrud) Create(ctx context.Context) error {
+	e := s.Get(ctx)
 	if e != nil {
 		return e
 	}
 
-	return s.Update()
+	return s.Update(ctx)
 }
 
-func (s *ManagementAgentManagementAgentResourceCrud) deployPlugin(pluginIds []string, agentId string, compartmentId string) error {
+func (s *ManagementAgentManagementAgentResourceCrud) deployPlugin(ctx context.Context, pluginIds []string, agentId string, compartmentId string) error {
 	request := oci_management_agent.DeployPluginsRequest{}
 	request.AgentIds = append(request.AgentIds, agentId)
 	request.AgentCompartmentId = &compartmentId
@@ -613,13 +614,13 @@ func (s *ManagementAgentManagementAgentResourceCrud) deployPlugin(pluginIds []st
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_agent")
 
-	response, err := s.Client.DeployPlugins(context.Background(), request)
+	response, err := s.Client.DeployPlugins(ctx, request)
 	if err != nil {
 		return err
 	}
 	workRequestId := response.OpcWorkRequestId
 
-	_, workRequestErr := managementAgentWaitForWorkRequest(workRequestId, "managementagent",
+	_, workRequestErr := managementAgentWaitForWorkRequest(ctx, workRequestId, "managementagent",
 		oci_management_agent.ActionTypesUpdated, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return workRequestErr
 }
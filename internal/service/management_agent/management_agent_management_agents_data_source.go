@@ -6,6 +6,7 @@ package management_agent
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_management_agent "github.com/oracle/oci-go-sdk/v65/managementagent"
 
@@ -15,7 +16,7 @@ import (
 
 func ManagementAgentManagementAgentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readManagementAgentManagementAgents,
+		ReadContext: readManagementAgentManagementAgents,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"access_level": {
@@ -84,12 +85,12 @@ func ManagementAgentManagementAgentsDataSource() *schema.Resource {
 	}
 }
 
-func readManagementAgentManagementAgents(d *schema.ResourceData, m interface{}) error {
+func readManagementAgentManagementAgents(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementAgentManagementAgentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ManagementAgentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ManagementAgentManagementAgentsDataSourceCrud struct {
@@ -102,7 +103,7 @@ func (s *ManagementAgentManagementAgentsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ManagementAgentManagementAgentsDataSourceCrud) Get() error {
+func (s *ManagementAgentManagementAgentsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_management_agent.ListManagementAgentsRequest{}
 
 	if accessLevel, ok := s.D.GetOkExists("access_level"); ok {
@@ -188,7 +189,7 @@ func (s *ManagementAgentManagementAgentsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_agent")
 
-	response, err := s.Client.ListManagementAgents(context.Background(), request)
+	response, err := s.Client.ListManagementAgents(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -197,7 +198,7 @@ func (s *ManagementAgentManagementAgentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListManagementAgents(context.Background(), request)
+		listResponse, err := s.Client.ListManagementAgents(ctx, request)
 		if err != nil {
 			return err
 		}
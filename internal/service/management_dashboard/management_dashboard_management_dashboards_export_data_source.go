@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_management_dashboard "github.com/oracle/oci-go-sdk/v65/managementdashboard"
 
@@ -17,7 +18,7 @@ import (
 
 func ManagementDashboardManagementDashboardsExportDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularManagementDashboardManagementDashboardsExport,
+		ReadContext: readSingularManagementDashboardManagementDashboardsExport,
 		Schema: map[string]*schema.Schema{
 			"export_dashboard_id": {
 				Type:     schema.TypeString,
@@ -32,12 +33,12 @@ func ManagementDashboardManagementDashboardsExportDataSource() *schema.Resource
 	}
 }
 
-func readSingularManagementDashboardManagementDashboardsExport(d *schema.ResourceData, m interface{}) error {
+func readSingularManagementDashboardManagementDashboardsExport(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementDashboardManagementDashboardsExportDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DashxApisClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ManagementDashboardManagementDashboardsExportDataSourceCrud struct {
@@ -50,7 +51,7 @@ func (s *ManagementDashboardManagementDashboardsExportDataSourceCrud) VoidState(
 	s.D.SetId("")
 }
 
-func (s *ManagementDashboardManagementDashboardsExportDataSourceCrud) Get() error {
+func (s *ManagementDashboardManagementDashboardsExportDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_management_dashboard.ExportDashboardRequest{}
 
 	if exportDashboardId, ok := s.D.GetOkExists("export_dashboard_id"); ok {
@@ -60,7 +61,7 @@ func (s *ManagementDashboardManagementDashboardsExportDataSourceCrud) Get() erro
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "management_dashboard")
 
-	response, err := s.Client.ExportDashboard(context.Background(), request)
+	response, err := s.Client.ExportDashboard(ctx, request)
 	if err != nil {
 		return err
 	}
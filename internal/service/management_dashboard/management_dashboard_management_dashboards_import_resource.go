@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io/ioutil"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_management_dashboard "github.com/oracle/oci-go-sdk/v65/managementdashboard"
@@ -22,10 +23,10 @@ func ManagementDashboardManagementDashboardsImportResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createManagementDashboardManagementDashboardsImport,
-		Read:     readManagementDashboardManagementDashboardsImport,
-		Delete:   deleteManagementDashboardManagementDashboardsImport,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createManagementDashboardManagementDashboardsImport,
+		ReadContext:   readManagementDashboardManagementDashboardsImport,
+		DeleteContext: deleteManagementDashboardManagementDashboardsImport,
 		Schema: map[string]*schema.Schema{
 			// Optional
 			"import_details_file": {
@@ -44,19 +45,19 @@ func ManagementDashboardManagementDashboardsImportResource() *schema.Resource {
 	}
 }
 
-func createManagementDashboardManagementDashboardsImport(d *schema.ResourceData, m interface{}) error {
+func createManagementDashboardManagementDashboardsImport(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ManagementDashboardManagementDashboardsImportResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DashxApisClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readManagementDashboardManagementDashboardsImport(d *schema.ResourceData, m interface{}) error {
+func readManagementDashboardManagementDashboardsImport(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteManagementDashboardManagementDashboardsImport(d *schema.ResourceData, m interface{}) error {
+func deleteManagementDashboardManagementDashboardsImport(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -70,7 +71,7 @@ func (s *ManagementDashboardManagementDashboardsImportResourceCrud) ID() string
 	return tfresource.GenerateDataSourceHashID("ManagementDashboardManagementDashboardsImportResource-", ManagementDashboardManagementDashboardsImportResource(), s.D)
 }
 
-func (s *ManagementDashboardManagementDashboardsImportResourceCrud) Create() error {
+func (s *ManagementDashboardManagementDashboardsImportResourceCrud) Create(ctx context.Context) error {
 	request := oci_management_dashboard.ImportDashboardRequest{}
 
 	var flag = false
@@ -105,7 +106,7 @@ func (s *ManagementDashboardManagementDashboardsImportResourceCrud) Create() err
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "management_dashboard")
 
-	_, err := s.Client.ImportDashboard(context.Background(), request)
+	_, err := s.Client.ImportDashboard(ctx, request)
 	if err != nil {
 		return err
 	}
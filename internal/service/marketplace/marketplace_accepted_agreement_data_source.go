@@ -6,6 +6,7 @@ package marketplace
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v65/marketplace"
 
@@ -22,12 +23,12 @@ func MarketplaceAcceptedAgreementDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(MarketplaceAcceptedAgreementResource(), fieldMap, readSingularMarketplaceAcceptedAgreement)
 }
 
-func readSingularMarketplaceAcceptedAgreement(d *schema.ResourceData, m interface{}) error {
+func readSingularMarketplaceAcceptedAgreement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceAcceptedAgreementDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MarketplaceAcceptedAgreementDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *MarketplaceAcceptedAgreementDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MarketplaceAcceptedAgreementDataSourceCrud) Get() error {
+func (s *MarketplaceAcceptedAgreementDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_marketplace.GetAcceptedAgreementRequest{}
 
 	if acceptedAgreementId, ok := s.D.GetOkExists("accepted_agreement_id"); ok {
@@ -50,7 +51,7 @@ func (s *MarketplaceAcceptedAgreementDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
 
-	response, err := s.Client.GetAcceptedAgreement(context.Background(), request)
+	response, err := s.Client.GetAcceptedAgreement(ctx, request)
 	if err != nil {
 		return err
 	}
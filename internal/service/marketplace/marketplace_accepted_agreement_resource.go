@@ -6,6 +6,7 @@ package marketplace
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_marketplace "github.com/oracle/oci-go-sdk/v65/marketplace"
@@ -19,11 +20,11 @@ func MarketplaceAcceptedAgreementResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createMarketplaceAcceptedAgreement,
-		Read:     readMarketplaceAcceptedAgreement,
-		Update:   updateMarketplaceAcceptedAgreement,
-		Delete:   deleteMarketplaceAcceptedAgreement,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createMarketplaceAcceptedAgreement,
+		ReadContext:   readMarketplaceAcceptedAgreement,
+		UpdateContext: updateMarketplaceAcceptedAgreement,
+		DeleteContext: deleteMarketplaceAcceptedAgreement,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"agreement_id": {
@@ -81,37 +82,37 @@ func MarketplaceAcceptedAgreementResource() *schema.Resource {
 	}
 }
 
-func createMarketplaceAcceptedAgreement(d *schema.ResourceData, m interface{}) error {
+func createMarketplaceAcceptedAgreement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceAcceptedAgreementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readMarketplaceAcceptedAgreement(d *schema.ResourceData, m interface{}) error {
+func readMarketplaceAcceptedAgreement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceAcceptedAgreementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateMarketplaceAcceptedAgreement(d *schema.ResourceData, m interface{}) error {
+func updateMarketplaceAcceptedAgreement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceAcceptedAgreementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteMarketplaceAcceptedAgreement(d *schema.ResourceData, m interface{}) error {
+func deleteMarketplaceAcceptedAgreement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceAcceptedAgreementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type MarketplaceAcceptedAgreementResourceCrud struct {
@@ -125,7 +126,7 @@ func (s *MarketplaceAcceptedAgreementResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *MarketplaceAcceptedAgreementResourceCrud) Create() error {
+func (s *MarketplaceAcceptedAgreementResourceCrud) Create(ctx context.Context) error {
 	request := oci_marketplace.CreateAcceptedAgreementRequest{}
 
 	if agreementId, ok := s.D.GetOkExists("agreement_id"); ok {
@@ -172,7 +173,7 @@ func (s *MarketplaceAcceptedAgreementResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
 
-	response, err := s.Client.CreateAcceptedAgreement(context.Background(), request)
+	response, err := s.Client.CreateAcceptedAgreement(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -181,7 +182,7 @@ func (s *MarketplaceAcceptedAgreementResourceCrud) Create() error {
 	return nil
 }
 
-func (s *MarketplaceAcceptedAgreementResourceCrud) Get() error {
+func (s *MarketplaceAcceptedAgreementResourceCrud) Get(ctx context.Context) error {
 	request := oci_marketplace.GetAcceptedAgreementRequest{}
 
 	tmp := s.D.Id()
@@ -189,7 +190,7 @@ func (s *MarketplaceAcceptedAgreementResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
 
-	response, err := s.Client.GetAcceptedAgreement(context.Background(), request)
+	response, err := s.Client.GetAcceptedAgreement(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -198,7 +199,7 @@ func (s *MarketplaceAcceptedAgreementResourceCrud) Get() error {
 	return nil
 }
 
-func (s *MarketplaceAcceptedAgreementResourceCrud) Update() error {
+func (s *MarketplaceAcceptedAgreementResourceCrud) Update(ctx context.Context) error {
 	request := oci_marketplace.UpdateAcceptedAgreementRequest{}
 
 	tmp := s.D.Id()
@@ -223,7 +224,7 @@ func (s *MarketplaceAcceptedAgreementResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
 
-	response, err := s.Client.UpdateAcceptedAgreement(context.Background(), request)
+	response, err := s.Client.UpdateAcceptedAgreement(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -232,7 +233,7 @@ func (s *MarketplaceAcceptedAgreementResourceCrud) Update() error {
 	return nil
 }
 
-func (s *MarketplaceAcceptedAgreementResourceCrud) Delete() error {
+func (s *MarketplaceAcceptedAgreementResourceCrud) Delete(ctx context.Context) error {
 	request := oci_marketplace.DeleteAcceptedAgreementRequest{}
 
 	tmp := s.D.Id()
@@ -245,7 +246,7 @@ func (s *MarketplaceAcceptedAgreementResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
 
-	_, err := s.Client.DeleteAcceptedAgreement(context.Background(), request)
+	_, err := s.Client.DeleteAcceptedAgreement(ctx, request)
 	return err
 }
 
@@ -6,6 +6,7 @@ package marketplace
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v65/marketplace"
 
@@ -15,7 +16,7 @@ import (
 
 func MarketplaceAcceptedAgreementsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMarketplaceAcceptedAgreements,
+		ReadContext: readMarketplaceAcceptedAgreements,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"accepted_agreement_id": {
@@ -47,12 +48,12 @@ func MarketplaceAcceptedAgreementsDataSource() *schema.Resource {
 	}
 }
 
-func readMarketplaceAcceptedAgreements(d *schema.ResourceData, m interface{}) error {
+func readMarketplaceAcceptedAgreements(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceAcceptedAgreementsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MarketplaceAcceptedAgreementsDataSourceCrud struct {
@@ -65,7 +66,7 @@ func (s *MarketplaceAcceptedAgreementsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MarketplaceAcceptedAgreementsDataSourceCrud) Get() error {
+func (s *MarketplaceAcceptedAgreementsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_marketplace.ListAcceptedAgreementsRequest{}
 
 	if acceptedAgreementId, ok := s.D.GetOkExists("id"); ok {
@@ -95,7 +96,7 @@ func (s *MarketplaceAcceptedAgreementsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
 
-	response, err := s.Client.ListAcceptedAgreements(context.Background(), request)
+	response, err := s.Client.ListAcceptedAgreements(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -104,7 +105,7 @@ func (s *MarketplaceAcceptedAgreementsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAcceptedAgreements(context.Background(), request)
+		listResponse, err := s.Client.ListAcceptedAgreements(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package marketplace
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v65/marketplace"
 
@@ -15,7 +16,7 @@ import (
 
 func MarketplaceCategoriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMarketplaceCategories,
+		ReadContext: readMarketplaceCategories,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,12 +44,12 @@ func MarketplaceCategoriesDataSource() *schema.Resource {
 	}
 }
 
-func readMarketplaceCategories(d *schema.ResourceData, m interface{}) error {
+func readMarketplaceCategories(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceCategoriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MarketplaceCategoriesDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *MarketplaceCategoriesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MarketplaceCategoriesDataSourceCrud) Get() error {
+func (s *MarketplaceCategoriesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_marketplace.ListCategoriesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -71,7 +72,7 @@ func (s *MarketplaceCategoriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
 
-	response, err := s.Client.ListCategories(context.Background(), request)
+	response, err := s.Client.ListCategories(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -80,7 +81,7 @@ func (s *MarketplaceCategoriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCategories(context.Background(), request)
+		listResponse, err := s.Client.ListCategories(ctx, request)
 		if err != nil {
 			return err
 		}
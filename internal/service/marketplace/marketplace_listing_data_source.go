@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v65/marketplace"
 
@@ -16,7 +17,7 @@ import (
 
 func MarketplaceListingDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularMarketplaceListing,
+		ReadContext: readSingularMarketplaceListing,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -488,12 +489,12 @@ func MarketplaceListingDataSource() *schema.Resource {
 	}
 }
 
-func readSingularMarketplaceListing(d *schema.ResourceData, m interface{}) error {
+func readSingularMarketplaceListing(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceListingDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MarketplaceListingDataSourceCrud struct {
@@ -506,7 +507,7 @@ func (s *MarketplaceListingDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MarketplaceListingDataSourceCrud) Get() error {
+func (s *MarketplaceListingDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_marketplace.GetListingRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -521,7 +522,7 @@ func (s *MarketplaceListingDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
 
-	response, err := s.Client.GetListing(context.Background(), request)
+	response, err := s.Client.GetListing(ctx, request)
 	if err != nil {
 		return err
 	}
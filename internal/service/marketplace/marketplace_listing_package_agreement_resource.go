@@ -6,6 +6,7 @@ package marketplace
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v65/marketplace"
 
@@ -15,9 +16,9 @@ import (
 
 func MarketplaceListingPackageAgreementResource() *schema.Resource {
 	return &schema.Resource{
-		Create: createMarketplaceListingPackageAgreement,
-		Read:   readMarketplaceListingPackageAgreement,
-		Delete: deleteMarketplaceListingPackageAgreement,
+		CreateContext: createMarketplaceListingPackageAgreement,
+		ReadContext:   readMarketplaceListingPackageAgreement,
+		DeleteContext: deleteMarketplaceListingPackageAgreement,
 		Schema: map[string]*schema.Schema{
 			"agreement_id": {
 				Type:     schema.TypeString,
@@ -62,25 +63,25 @@ func MarketplaceListingPackageAgreementResource() *schema.Resource {
 	}
 }
 
-func createMarketplaceListingPackageAgreement(d *schema.ResourceData, m interface{}) error {
+func createMarketplaceListingPackageAgreement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceListingPackageAgreementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readMarketplaceListingPackageAgreement(d *schema.ResourceData, m interface{}) error {
+func readMarketplaceListingPackageAgreement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceListingPackageAgreementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteMarketplaceListingPackageAgreement(d *schema.ResourceData, m interface{}) error {
+func deleteMarketplaceListingPackageAgreement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceListingPackageAgreementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type MarketplaceListingPackageAgreementResourceCrud struct {
@@ -94,7 +95,7 @@ func (s *MarketplaceListingPackageAgreementResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *MarketplaceListingPackageAgreementResourceCrud) Create() error {
+func (s *MarketplaceListingPackageAgreementResourceCrud) Create(ctx context.Context) error {
 	request := oci_marketplace.GetAgreementRequest{}
 
 	if agreementId, ok := s.D.GetOkExists("agreement_id"); ok {
@@ -119,7 +120,7 @@ func (s *MarketplaceListingPackageAgreementResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
 
-	response, err := s.Client.GetAgreement(context.Background(), request)
+	response, err := s.Client.GetAgreement(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -128,11 +129,11 @@ func (s *MarketplaceListingPackageAgreementResourceCrud) Create() error {
 	return nil
 }
 
-func (s *MarketplaceListingPackageAgreementResourceCrud) Get() error {
+func (s *MarketplaceListingPackageAgreementResourceCrud) Get(ctx context.Context) error {
 	return nil
 }
 
-func (s *MarketplaceListingPackageAgreementResourceCrud) Delete() error {
+func (s *MarketplaceListingPackageAgreementResourceCrud) Delete(ctx context.Context) error {
 	return nil
 }
 
@@ -6,6 +6,7 @@ package marketplace
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v65/marketplace"
 
@@ -15,7 +16,7 @@ import (
 
 func MarketplaceListingPackageAgreementsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMarketplaceListingPackageAgreements,
+		ReadContext: readMarketplaceListingPackageAgreements,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -63,12 +64,12 @@ func MarketplaceListingPackageAgreementsDataSource() *schema.Resource {
 	}
 }
 
-func readMarketplaceListingPackageAgreements(d *schema.ResourceData, m interface{}) error {
+func readMarketplaceListingPackageAgreements(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceListingPackageAgreementsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MarketplaceListingPackageAgreementsDataSourceCrud struct {
@@ -81,7 +82,7 @@ func (s *MarketplaceListingPackageAgreementsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MarketplaceListingPackageAgreementsDataSourceCrud) Get() error {
+func (s *MarketplaceListingPackageAgreementsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_marketplace.ListAgreementsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -101,7 +102,7 @@ func (s *MarketplaceListingPackageAgreementsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
 
-	response, err := s.Client.ListAgreements(context.Background(), request)
+	response, err := s.Client.ListAgreements(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -110,7 +111,7 @@ func (s *MarketplaceListingPackageAgreementsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAgreements(context.Background(), request)
+		listResponse, err := s.Client.ListAgreements(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v65/marketplace"
 
@@ -16,7 +17,7 @@ import (
 
 func MarketplaceListingPackageDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularMarketplaceListingPackage,
+		ReadContext: readSingularMarketplaceListingPackage,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -221,12 +222,12 @@ func MarketplaceListingPackageDataSource() *schema.Resource {
 	}
 }
 
-func readSingularMarketplaceListingPackage(d *schema.ResourceData, m interface{}) error {
+func readSingularMarketplaceListingPackage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceListingPackageDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MarketplaceListingPackageDataSourceCrud struct {
@@ -239,7 +240,7 @@ func (s *MarketplaceListingPackageDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MarketplaceListingPackageDataSourceCrud) Get() error {
+func (s *MarketplaceListingPackageDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_marketplace.GetPackageRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -259,7 +260,7 @@ func (s *MarketplaceListingPackageDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
 
-	response, err := s.Client.GetPackage(context.Background(), request)
+	response, err := s.Client.GetPackage(ctx, request)
 	if err != nil {
 		return err
 	}
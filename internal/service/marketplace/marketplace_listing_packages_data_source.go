@@ -6,6 +6,7 @@ package marketplace
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v65/marketplace"
@@ -16,7 +17,7 @@ import (
 
 func MarketplaceListingPackagesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMarketplaceListingPackages,
+		ReadContext: readMarketplaceListingPackages,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -190,12 +191,12 @@ func MarketplaceListingPackagesDataSource() *schema.Resource {
 	}
 }
 
-func readMarketplaceListingPackages(d *schema.ResourceData, m interface{}) error {
+func readMarketplaceListingPackages(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceListingPackagesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MarketplaceListingPackagesDataSourceCrud struct {
@@ -208,7 +209,7 @@ func (s *MarketplaceListingPackagesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MarketplaceListingPackagesDataSourceCrud) Get() error {
+func (s *MarketplaceListingPackagesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_marketplace.ListPackagesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -233,7 +234,7 @@ func (s *MarketplaceListingPackagesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
 
-	response, err := s.Client.ListPackages(context.Background(), request)
+	response, err := s.Client.ListPackages(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -242,7 +243,7 @@ func (s *MarketplaceListingPackagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPackages(context.Background(), request)
+		listResponse, err := s.Client.ListPackages(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package marketplace
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v65/marketplace"
 
@@ -15,7 +16,7 @@ import (
 
 func MarketplaceListingTaxesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMarketplaceListingTaxes,
+		ReadContext: readMarketplaceListingTaxes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -59,12 +60,12 @@ func MarketplaceListingTaxesDataSource() *schema.Resource {
 	}
 }
 
-func readMarketplaceListingTaxes(d *schema.ResourceData, m interface{}) error {
+func readMarketplaceListingTaxes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceListingTaxesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MarketplaceListingTaxesDataSourceCrud struct {
@@ -77,7 +78,7 @@ func (s *MarketplaceListingTaxesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MarketplaceListingTaxesDataSourceCrud) Get() error {
+func (s *MarketplaceListingTaxesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_marketplace.ListTaxesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -92,7 +93,7 @@ func (s *MarketplaceListingTaxesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
 
-	response, err := s.Client.ListTaxes(context.Background(), request)
+	response, err := s.Client.ListTaxes(ctx, request)
 	if err != nil {
 		return err
 	}
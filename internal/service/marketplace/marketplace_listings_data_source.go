@@ -6,6 +6,7 @@ package marketplace
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v65/marketplace"
 
@@ -15,7 +16,7 @@ import (
 
 func MarketplaceListingsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMarketplaceListings,
+		ReadContext: readMarketplaceListings,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"category": {
@@ -310,12 +311,12 @@ func MarketplaceListingsDataSource() *schema.Resource {
 	}
 }
 
-func readMarketplaceListings(d *schema.ResourceData, m interface{}) error {
+func readMarketplaceListings(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplaceListingsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MarketplaceListingsDataSourceCrud struct {
@@ -328,7 +329,7 @@ func (s *MarketplaceListingsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MarketplaceListingsDataSourceCrud) Get() error {
+func (s *MarketplaceListingsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_marketplace.ListListingsRequest{}
 
 	if category, ok := s.D.GetOkExists("category"); ok {
@@ -428,7 +429,7 @@ func (s *MarketplaceListingsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
 
-	response, err := s.Client.ListListings(context.Background(), request)
+	response, err := s.Client.ListListings(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -437,7 +438,7 @@ func (s *MarketplaceListingsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListListings(context.Background(), request)
+		listResponse, err := s.Client.ListListings(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package marketplace
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v65/marketplace"
 
@@ -22,12 +23,12 @@ func MarketplacePublicationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(MarketplacePublicationResource(), fieldMap, readSingularMarketplacePublication)
 }
 
-func readSingularMarketplacePublication(d *schema.ResourceData, m interface{}) error {
+func readSingularMarketplacePublication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplacePublicationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MarketplacePublicationDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *MarketplacePublicationDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MarketplacePublicationDataSourceCrud) Get() error {
+func (s *MarketplacePublicationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_marketplace.GetPublicationRequest{}
 
 	if publicationId, ok := s.D.GetOkExists("publication_id"); ok {
@@ -50,7 +51,7 @@ func (s *MarketplacePublicationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
 
-	response, err := s.Client.GetPublication(context.Background(), request)
+	response, err := s.Client.GetPublication(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v65/marketplace"
 
@@ -16,7 +17,7 @@ import (
 
 func MarketplacePublicationPackageDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularMarketplacePublicationPackage,
+		ReadContext: readSingularMarketplacePublicationPackage,
 		Schema: map[string]*schema.Schema{
 			"package_version": {
 				Type:     schema.TypeString,
@@ -125,12 +126,12 @@ func MarketplacePublicationPackageDataSource() *schema.Resource {
 	}
 }
 
-func readSingularMarketplacePublicationPackage(d *schema.ResourceData, m interface{}) error {
+func readSingularMarketplacePublicationPackage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplacePublicationPackageDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MarketplacePublicationPackageDataSourceCrud struct {
@@ -143,7 +144,7 @@ func (s *MarketplacePublicationPackageDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MarketplacePublicationPackageDataSourceCrud) Get() error {
+func (s *MarketplacePublicationPackageDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_marketplace.GetPublicationPackageRequest{}
 
 	if packageVersion, ok := s.D.GetOkExists("package_version"); ok {
@@ -158,7 +159,7 @@ func (s *MarketplacePublicationPackageDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
 
-	response, err := s.Client.GetPublicationPackage(context.Background(), request)
+	response, err := s.Client.GetPublicationPackage(ctx, request)
 	if err != nil {
 		return err
 	}
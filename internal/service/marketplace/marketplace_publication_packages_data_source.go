@@ -6,6 +6,7 @@ package marketplace
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v65/marketplace"
 
@@ -15,7 +16,7 @@ import (
 
 func MarketplacePublicationPackagesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMarketplacePublicationPackages,
+		ReadContext: readMarketplacePublicationPackages,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"package_type": {
@@ -67,12 +68,12 @@ func MarketplacePublicationPackagesDataSource() *schema.Resource {
 	}
 }
 
-func readMarketplacePublicationPackages(d *schema.ResourceData, m interface{}) error {
+func readMarketplacePublicationPackages(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplacePublicationPackagesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MarketplacePublicationPackagesDataSourceCrud struct {
@@ -85,7 +86,7 @@ func (s *MarketplacePublicationPackagesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MarketplacePublicationPackagesDataSourceCrud) Get() error {
+func (s *MarketplacePublicationPackagesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_marketplace.ListPublicationPackagesRequest{}
 
 	if packageType, ok := s.D.GetOkExists("package_type"); ok {
@@ -105,7 +106,7 @@ func (s *MarketplacePublicationPackagesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
 
-	response, err := s.Client.ListPublicationPackages(context.Background(), request)
+	response, err := s.Client.ListPublicationPackages(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -114,7 +115,7 @@ func (s *MarketplacePublicationPackagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPublicationPackages(context.Background(), request)
+		listResponse, err := s.Client.ListPublicationPackages(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -30,10 +31,10 @@ func MarketplacePublicationResource() *schema.Resource {
 			Update: &tfresource.TwentyMinutes,
 			Delete: &tfresource.TwentyMinutes,
 		},
-		Create: createMarketplacePublication,
-		Read:   readMarketplacePublication,
-		Update: updateMarketplacePublication,
-		Delete: deleteMarketplacePublication,
+		CreateContext: createMarketplacePublication,
+		ReadContext:   readMarketplacePublication,
+		UpdateContext: updateMarketplacePublication,
+		DeleteContext: deleteMarketplacePublication,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -264,37 +265,37 @@ func MarketplacePublicationResource() *schema.Resource {
 	}
 }
 
-func createMarketplacePublication(d *schema.ResourceData, m interface{}) error {
+func createMarketplacePublication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplacePublicationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readMarketplacePublication(d *schema.ResourceData, m interface{}) error {
+func readMarketplacePublication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplacePublicationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateMarketplacePublication(d *schema.ResourceData, m interface{}) error {
+func updateMarketplacePublication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplacePublicationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteMarketplacePublication(d *schema.ResourceData, m interface{}) error {
+func deleteMarketplacePublication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplacePublicationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type MarketplacePublicationResourceCrud struct {
@@ -332,7 +333,7 @@ func (s *MarketplacePublicationResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *MarketplacePublicationResourceCrud) Create() error {
+func (s *MarketplacePublicationResourceCrud) Create(ctx context.Context) error {
 	request := oci_marketplace.CreatePublicationRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -407,7 +408,7 @@ func (s *MarketplacePublicationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
 
-	response, err := s.Client.CreatePublication(context.Background(), request)
+	response, err := s.Client.CreatePublication(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -416,7 +417,7 @@ func (s *MarketplacePublicationResourceCrud) Create() error {
 	return nil
 }
 
-func (s *MarketplacePublicationResourceCrud) Get() error {
+func (s *MarketplacePublicationResourceCrud) Get(ctx context.Context) error {
 	request := oci_marketplace.GetPublicationRequest{}
 
 	tmp := s.D.Id()
@@ -424,7 +425,7 @@ func (s *MarketplacePublicationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
 
-	response, err := s.Client.GetPublication(context.Background(), request)
+	response, err := s.Client.GetPublication(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -433,11 +434,11 @@ func (s *MarketplacePublicationResourceCrud) Get() error {
 	return nil
 }
 
-func (s *MarketplacePublicationResourceCrud) Update() error {
+func (s *MarketplacePublicationResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -495,7 +496,7 @@ func (s *MarketplacePublicationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
 
-	response, err := s.Client.UpdatePublication(context.Background(), request)
+	response, err := s.Client.UpdatePublication(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -504,7 +505,7 @@ func (s *MarketplacePublicationResourceCrud) Update() error {
 	return nil
 }
 
-func (s *MarketplacePublicationResourceCrud) Delete() error {
+func (s *MarketplacePublicationResourceCrud) Delete(ctx context.Context) error {
 	request := oci_marketplace.DeletePublicationRequest{}
 
 	tmp := s.D.Id()
@@ -512,7 +513,7 @@ func (s *MarketplacePublicationResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
 
-	_, err := s.Client.DeletePublication(context.Background(), request)
+	_, err := s.Client.DeletePublication(ctx, request)
 	return err
 }
 
@@ -792,7 +793,7 @@ func supportContactsHashCodeForSets(v interface{}) int {
 	}
 	return utils.GetStringHashcode(buf.String())
 }
-func (s *MarketplacePublicationResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *MarketplacePublicationResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_marketplace.ChangePublicationCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -803,12 +804,12 @@ func (s *MarketplacePublicationResourceCrud) updateCompartment(compartment inter
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "marketplace")
 
-	_, err := s.Client.ChangePublicationCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangePublicationCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
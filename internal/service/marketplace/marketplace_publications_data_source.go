@@ -6,6 +6,7 @@ package marketplace
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v65/marketplace"
 
@@ -15,7 +16,7 @@ import (
 
 func MarketplacePublicationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMarketplacePublications,
+		ReadContext: readMarketplacePublications,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -53,12 +54,12 @@ func MarketplacePublicationsDataSource() *schema.Resource {
 	}
 }
 
-func readMarketplacePublications(d *schema.ResourceData, m interface{}) error {
+func readMarketplacePublications(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplacePublicationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MarketplacePublicationsDataSourceCrud struct {
@@ -71,7 +72,7 @@ func (s *MarketplacePublicationsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MarketplacePublicationsDataSourceCrud) Get() error {
+func (s *MarketplacePublicationsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_marketplace.ListPublicationsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -116,7 +117,7 @@ func (s *MarketplacePublicationsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
 
-	response, err := s.Client.ListPublications(context.Background(), request)
+	response, err := s.Client.ListPublications(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -125,7 +126,7 @@ func (s *MarketplacePublicationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPublications(context.Background(), request)
+		listResponse, err := s.Client.ListPublications(ctx, request)
 		if err != nil {
 			return err
 		}
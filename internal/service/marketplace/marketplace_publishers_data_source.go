@@ -6,6 +6,7 @@ package marketplace
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_marketplace "github.com/oracle/oci-go-sdk/v65/marketplace"
 
@@ -15,7 +16,7 @@ import (
 
 func MarketplacePublishersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMarketplacePublishers,
+		ReadContext: readMarketplacePublishers,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -55,12 +56,12 @@ func MarketplacePublishersDataSource() *schema.Resource {
 	}
 }
 
-func readMarketplacePublishers(d *schema.ResourceData, m interface{}) error {
+func readMarketplacePublishers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MarketplacePublishersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MarketplaceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MarketplacePublishersDataSourceCrud struct {
@@ -73,7 +74,7 @@ func (s *MarketplacePublishersDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MarketplacePublishersDataSourceCrud) Get() error {
+func (s *MarketplacePublishersDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_marketplace.ListPublishersRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -88,7 +89,7 @@ func (s *MarketplacePublishersDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "marketplace")
 
-	response, err := s.Client.ListPublishers(context.Background(), request)
+	response, err := s.Client.ListPublishers(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -97,7 +98,7 @@ func (s *MarketplacePublishersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPublishers(context.Background(), request)
+		listResponse, err := s.Client.ListPublishers(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package metering_computation
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_metering_computation "github.com/oracle/oci-go-sdk/v65/usageapi"
 
@@ -15,7 +16,7 @@ import (
 
 func MeteringComputationConfigurationDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularMeteringComputationConfiguration,
+		ReadContext: readSingularMeteringComputationConfiguration,
 		Schema: map[string]*schema.Schema{
 			"tenant_id": {
 				Type:     schema.TypeString,
@@ -50,12 +51,12 @@ func MeteringComputationConfigurationDataSource() *schema.Resource {
 	}
 }
 
-func readSingularMeteringComputationConfiguration(d *schema.ResourceData, m interface{}) error {
+func readSingularMeteringComputationConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationConfigurationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MeteringComputationConfigurationDataSourceCrud struct {
@@ -68,7 +69,7 @@ func (s *MeteringComputationConfigurationDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MeteringComputationConfigurationDataSourceCrud) Get() error {
+func (s *MeteringComputationConfigurationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_metering_computation.RequestSummarizedConfigurationsRequest{}
 
 	if tenantId, ok := s.D.GetOkExists("tenant_id"); ok {
@@ -78,7 +79,7 @@ func (s *MeteringComputationConfigurationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "metering_computation")
 
-	response, err := s.Client.RequestSummarizedConfigurations(context.Background(), request)
+	response, err := s.Client.RequestSummarizedConfigurations(ctx, request)
 	if err != nil {
 		return err
 	}
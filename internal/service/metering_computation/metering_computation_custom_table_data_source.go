@@ -6,6 +6,7 @@ package metering_computation
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_metering_computation "github.com/oracle/oci-go-sdk/v65/usageapi"
 
@@ -22,12 +23,12 @@ func MeteringComputationCustomTableDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(MeteringComputationCustomTableResource(), fieldMap, readSingularMeteringComputationCustomTable)
 }
 
-func readSingularMeteringComputationCustomTable(d *schema.ResourceData, m interface{}) error {
+func readSingularMeteringComputationCustomTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationCustomTableDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MeteringComputationCustomTableDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *MeteringComputationCustomTableDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MeteringComputationCustomTableDataSourceCrud) Get() error {
+func (s *MeteringComputationCustomTableDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_metering_computation.GetCustomTableRequest{}
 
 	if customTableId, ok := s.D.GetOkExists("custom_table_id"); ok {
@@ -50,7 +51,7 @@ func (s *MeteringComputationCustomTableDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "metering_computation")
 
-	response, err := s.Client.GetCustomTable(context.Background(), request)
+	response, err := s.Client.GetCustomTable(ctx, request)
 	if err != nil {
 		return err
 	}
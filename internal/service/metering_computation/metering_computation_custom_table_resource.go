@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_metering_computation "github.com/oracle/oci-go-sdk/v65/usageapi"
@@ -20,11 +21,11 @@ func MeteringComputationCustomTableResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createMeteringComputationCustomTable,
-		Read:     readMeteringComputationCustomTable,
-		Update:   updateMeteringComputationCustomTable,
-		Delete:   deleteMeteringComputationCustomTable,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createMeteringComputationCustomTable,
+		ReadContext:   readMeteringComputationCustomTable,
+		UpdateContext: updateMeteringComputationCustomTable,
+		DeleteContext: deleteMeteringComputationCustomTable,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -119,37 +120,37 @@ func MeteringComputationCustomTableResource() *schema.Resource {
 	}
 }
 
-func createMeteringComputationCustomTable(d *schema.ResourceData, m interface{}) error {
+func createMeteringComputationCustomTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationCustomTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readMeteringComputationCustomTable(d *schema.ResourceData, m interface{}) error {
+func readMeteringComputationCustomTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationCustomTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateMeteringComputationCustomTable(d *schema.ResourceData, m interface{}) error {
+func updateMeteringComputationCustomTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationCustomTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteMeteringComputationCustomTable(d *schema.ResourceData, m interface{}) error {
+func deleteMeteringComputationCustomTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationCustomTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type MeteringComputationCustomTableResourceCrud struct {
@@ -163,7 +164,7 @@ func (s *MeteringComputationCustomTableResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *MeteringComputationCustomTableResourceCrud) Create() error {
+func (s *MeteringComputationCustomTableResourceCrud) Create(ctx context.Context) error {
 	request := oci_metering_computation.CreateCustomTableRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -189,7 +190,7 @@ func (s *MeteringComputationCustomTableResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
-	response, err := s.Client.CreateCustomTable(context.Background(), request)
+	response, err := s.Client.CreateCustomTable(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -198,7 +199,7 @@ func (s *MeteringComputationCustomTableResourceCrud) Create() error {
 	return nil
 }
 
-func (s *MeteringComputationCustomTableResourceCrud) Get() error {
+func (s *MeteringComputationCustomTableResourceCrud) Get(ctx context.Context) error {
 	request := oci_metering_computation.GetCustomTableRequest{}
 
 	tmp := s.D.Id()
@@ -206,7 +207,7 @@ func (s *MeteringComputationCustomTableResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
-	response, err := s.Client.GetCustomTable(context.Background(), request)
+	response, err := s.Client.GetCustomTable(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -215,7 +216,7 @@ func (s *MeteringComputationCustomTableResourceCrud) Get() error {
 	return nil
 }
 
-func (s *MeteringComputationCustomTableResourceCrud) Update() error {
+func (s *MeteringComputationCustomTableResourceCrud) Update(ctx context.Context) error {
 	request := oci_metering_computation.UpdateCustomTableRequest{}
 
 	tmp := s.D.Id()
@@ -234,7 +235,7 @@ func (s *MeteringComputationCustomTableResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
-	response, err := s.Client.UpdateCustomTable(context.Background(), request)
+	response, err := s.Client.UpdateCustomTable(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -243,7 +244,7 @@ func (s *MeteringComputationCustomTableResourceCrud) Update() error {
 	return nil
 }
 
-func (s *MeteringComputationCustomTableResourceCrud) Delete() error {
+func (s *MeteringComputationCustomTableResourceCrud) Delete(ctx context.Context) error {
 	request := oci_metering_computation.DeleteCustomTableRequest{}
 
 	tmp := s.D.Id()
@@ -251,7 +252,7 @@ func (s *MeteringComputationCustomTableResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
-	_, err := s.Client.DeleteCustomTable(context.Background(), request)
+	_, err := s.Client.DeleteCustomTable(ctx, request)
 	return err
 }
 
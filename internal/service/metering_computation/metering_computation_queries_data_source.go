@@ -6,6 +6,7 @@ package metering_computation
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_metering_computation "github.com/oracle/oci-go-sdk/v65/usageapi"
 
@@ -15,7 +16,7 @@ import (
 
 func MeteringComputationQueriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMeteringComputationQueries,
+		ReadContext: readMeteringComputationQueries,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -40,12 +41,12 @@ func MeteringComputationQueriesDataSource() *schema.Resource {
 	}
 }
 
-func readMeteringComputationQueries(d *schema.ResourceData, m interface{}) error {
+func readMeteringComputationQueries(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationQueriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MeteringComputationQueriesDataSourceCrud struct {
@@ -58,7 +59,7 @@ func (s *MeteringComputationQueriesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MeteringComputationQueriesDataSourceCrud) Get() error {
+func (s *MeteringComputationQueriesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_metering_computation.ListQueriesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -68,7 +69,7 @@ func (s *MeteringComputationQueriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "metering_computation")
 
-	response, err := s.Client.ListQueries(context.Background(), request)
+	response, err := s.Client.ListQueries(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -77,7 +78,7 @@ func (s *MeteringComputationQueriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListQueries(context.Background(), request)
+		listResponse, err := s.Client.ListQueries(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package metering_computation
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_metering_computation "github.com/oracle/oci-go-sdk/v65/usageapi"
 
@@ -22,12 +23,12 @@ func MeteringComputationQueryDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(MeteringComputationQueryResource(), fieldMap, readSingularMeteringComputationQuery)
 }
 
-func readSingularMeteringComputationQuery(d *schema.ResourceData, m interface{}) error {
+func readSingularMeteringComputationQuery(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationQueryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MeteringComputationQueryDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *MeteringComputationQueryDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MeteringComputationQueryDataSourceCrud) Get() error {
+func (s *MeteringComputationQueryDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_metering_computation.GetQueryRequest{}
 
 	if queryId, ok := s.D.GetOkExists("query_id"); ok {
@@ -50,7 +51,7 @@ func (s *MeteringComputationQueryDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "metering_computation")
 
-	response, err := s.Client.GetQuery(context.Background(), request)
+	response, err := s.Client.GetQuery(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
@@ -23,11 +24,11 @@ func MeteringComputationQueryResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createMeteringComputationQuery,
-		Read:     readMeteringComputationQuery,
-		Update:   updateMeteringComputationQuery,
-		Delete:   deleteMeteringComputationQuery,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createMeteringComputationQuery,
+		ReadContext:   readMeteringComputationQuery,
+		UpdateContext: updateMeteringComputationQuery,
+		DeleteContext: deleteMeteringComputationQuery,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -220,37 +221,37 @@ func MeteringComputationQueryResource() *schema.Resource {
 	}
 }
 
-func createMeteringComputationQuery(d *schema.ResourceData, m interface{}) error {
+func createMeteringComputationQuery(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationQueryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readMeteringComputationQuery(d *schema.ResourceData, m interface{}) error {
+func readMeteringComputationQuery(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationQueryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateMeteringComputationQuery(d *schema.ResourceData, m interface{}) error {
+func updateMeteringComputationQuery(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationQueryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteMeteringComputationQuery(d *schema.ResourceData, m interface{}) error {
+func deleteMeteringComputationQuery(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationQueryResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type MeteringComputationQueryResourceCrud struct {
@@ -264,7 +265,7 @@ func (s *MeteringComputationQueryResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *MeteringComputationQueryResourceCrud) Create() error {
+func (s *MeteringComputationQueryResourceCrud) Create(ctx context.Context) error {
 	request := oci_metering_computation.CreateQueryRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -285,7 +286,7 @@ func (s *MeteringComputationQueryResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
-	response, err := s.Client.CreateQuery(context.Background(), request)
+	response, err := s.Client.CreateQuery(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -294,7 +295,7 @@ func (s *MeteringComputationQueryResourceCrud) Create() error {
 	return nil
 }
 
-func (s *MeteringComputationQueryResourceCrud) Get() error {
+func (s *MeteringComputationQueryResourceCrud) Get(ctx context.Context) error {
 	request := oci_metering_computation.GetQueryRequest{}
 
 	tmp := s.D.Id()
@@ -302,7 +303,7 @@ func (s *MeteringComputationQueryResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
-	response, err := s.Client.GetQuery(context.Background(), request)
+	response, err := s.Client.GetQuery(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -311,7 +312,7 @@ func (s *MeteringComputationQueryResourceCrud) Get() error {
 	return nil
 }
 
-func (s *MeteringComputationQueryResourceCrud) Update() error {
+func (s *MeteringComputationQueryResourceCrud) Update(ctx context.Context) error {
 	request := oci_metering_computation.UpdateQueryRequest{}
 
 	if queryDefinition, ok := s.D.GetOkExists("query_definition"); ok {
@@ -330,7 +331,7 @@ func (s *MeteringComputationQueryResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
-	response, err := s.Client.UpdateQuery(context.Background(), request)
+	response, err := s.Client.UpdateQuery(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -339,7 +340,7 @@ func (s *MeteringComputationQueryResourceCrud) Update() error {
 	return nil
 }
 
-func (s *MeteringComputationQueryResourceCrud) Delete() error {
+func (s *MeteringComputationQueryResourceCrud) Delete(ctx context.Context) error {
 	request := oci_metering_computation.DeleteQueryRequest{}
 
 	tmp := s.D.Id()
@@ -347,7 +348,7 @@ func (s *MeteringComputationQueryResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
-	_, err := s.Client.DeleteQuery(context.Background(), request)
+	_, err := s.Client.DeleteQuery(ctx, request)
 	return err
 }
 
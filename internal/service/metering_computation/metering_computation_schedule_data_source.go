@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_metering_computation "github.com/oracle/oci-go-sdk/v65/usageapi"
 
@@ -23,12 +24,12 @@ func MeteringComputationScheduleDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(MeteringComputationScheduleResource(), fieldMap, readSingularMeteringComputationSchedule)
 }
 
-func readSingularMeteringComputationSchedule(d *schema.ResourceData, m interface{}) error {
+func readSingularMeteringComputationSchedule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationScheduleDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MeteringComputationScheduleDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *MeteringComputationScheduleDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MeteringComputationScheduleDataSourceCrud) Get() error {
+func (s *MeteringComputationScheduleDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_metering_computation.GetScheduleRequest{}
 
 	if scheduleId, ok := s.D.GetOkExists("schedule_id"); ok {
@@ -51,7 +52,7 @@ func (s *MeteringComputationScheduleDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "metering_computation")
 
-	response, err := s.Client.GetSchedule(context.Background(), request)
+	response, err := s.Client.GetSchedule(ctx, request)
 	if err != nil {
 		return err
 	}
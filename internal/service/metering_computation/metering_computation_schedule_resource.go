@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -26,11 +27,11 @@ func MeteringComputationScheduleResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createMeteringComputationSchedule,
-		Read:     readMeteringComputationSchedule,
-		Update:   updateMeteringComputationSchedule,
-		Delete:   deleteMeteringComputationSchedule,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createMeteringComputationSchedule,
+		ReadContext:   readMeteringComputationSchedule,
+		UpdateContext: updateMeteringComputationSchedule,
+		DeleteContext: deleteMeteringComputationSchedule,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -277,37 +278,37 @@ func MeteringComputationScheduleResource() *schema.Resource {
 	}
 }
 
-func createMeteringComputationSchedule(d *schema.ResourceData, m interface{}) error {
+func createMeteringComputationSchedule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationScheduleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readMeteringComputationSchedule(d *schema.ResourceData, m interface{}) error {
+func readMeteringComputationSchedule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationScheduleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateMeteringComputationSchedule(d *schema.ResourceData, m interface{}) error {
+func updateMeteringComputationSchedule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationScheduleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteMeteringComputationSchedule(d *schema.ResourceData, m interface{}) error {
+func deleteMeteringComputationSchedule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationScheduleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type MeteringComputationScheduleResourceCrud struct {
@@ -339,7 +340,7 @@ func (s *MeteringComputationScheduleResourceCrud) DeletedTarget() []string {
 	return []string{}
 }
 
-func (s *MeteringComputationScheduleResourceCrud) Create() error {
+func (s *MeteringComputationScheduleResourceCrud) Create(ctx context.Context) error {
 	request := oci_metering_computation.CreateScheduleRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -415,7 +416,7 @@ func (s *MeteringComputationScheduleResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
-	response, err := s.Client.CreateSchedule(context.Background(), request)
+	response, err := s.Client.CreateSchedule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -424,7 +425,7 @@ func (s *MeteringComputationScheduleResourceCrud) Create() error {
 	return nil
 }
 
-func (s *MeteringComputationScheduleResourceCrud) Get() error {
+func (s *MeteringComputationScheduleResourceCrud) Get(ctx context.Context) error {
 	request := oci_metering_computation.GetScheduleRequest{}
 
 	tmp := s.D.Id()
@@ -432,7 +433,7 @@ func (s *MeteringComputationScheduleResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
-	response, err := s.Client.GetSchedule(context.Background(), request)
+	response, err := s.Client.GetSchedule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -441,7 +442,7 @@ func (s *MeteringComputationScheduleResourceCrud) Get() error {
 	return nil
 }
 
-func (s *MeteringComputationScheduleResourceCrud) Update() error {
+func (s *MeteringComputationScheduleResourceCrud) Update(ctx context.Context) error {
 	request := oci_metering_computation.UpdateScheduleRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -481,7 +482,7 @@ func (s *MeteringComputationScheduleResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
-	response, err := s.Client.UpdateSchedule(context.Background(), request)
+	response, err := s.Client.UpdateSchedule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -490,7 +491,7 @@ func (s *MeteringComputationScheduleResourceCrud) Update() error {
 	return nil
 }
 
-func (s *MeteringComputationScheduleResourceCrud) Delete() error {
+func (s *MeteringComputationScheduleResourceCrud) Delete(ctx context.Context) error {
 	request := oci_metering_computation.DeleteScheduleRequest{}
 
 	tmp := s.D.Id()
@@ -498,7 +499,7 @@ func (s *MeteringComputationScheduleResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
-	_, err := s.Client.DeleteSchedule(context.Background(), request)
+	_, err := s.Client.DeleteSchedule(ctx, request)
 	return err
 }
 
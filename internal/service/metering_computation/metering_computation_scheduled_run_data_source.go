@@ -6,6 +6,7 @@ package metering_computation
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_metering_computation "github.com/oracle/oci-go-sdk/v65/usageapi"
 
@@ -15,7 +16,7 @@ import (
 
 func MeteringComputationScheduledRunDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularMeteringComputationScheduledRun,
+		ReadContext: readSingularMeteringComputationScheduledRun,
 		Schema: map[string]*schema.Schema{
 			"scheduled_run_id": {
 				Type:     schema.TypeString,
@@ -46,12 +47,12 @@ func MeteringComputationScheduledRunDataSource() *schema.Resource {
 	}
 }
 
-func readSingularMeteringComputationScheduledRun(d *schema.ResourceData, m interface{}) error {
+func readSingularMeteringComputationScheduledRun(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationScheduledRunDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MeteringComputationScheduledRunDataSourceCrud struct {
@@ -64,7 +65,7 @@ func (s *MeteringComputationScheduledRunDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MeteringComputationScheduledRunDataSourceCrud) Get() error {
+func (s *MeteringComputationScheduledRunDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_metering_computation.GetScheduledRunRequest{}
 
 	if scheduledRunId, ok := s.D.GetOkExists("scheduled_run_id"); ok {
@@ -74,7 +75,7 @@ func (s *MeteringComputationScheduledRunDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "metering_computation")
 
-	response, err := s.Client.GetScheduledRun(context.Background(), request)
+	response, err := s.Client.GetScheduledRun(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package metering_computation
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_metering_computation "github.com/oracle/oci-go-sdk/v65/usageapi"
 
@@ -15,7 +16,7 @@ import (
 
 func MeteringComputationScheduledRunsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMeteringComputationScheduledRuns,
+		ReadContext: readMeteringComputationScheduledRuns,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"schedule_id": {
@@ -71,12 +72,12 @@ func MeteringComputationScheduledRunsDataSource() *schema.Resource {
 	}
 }
 
-func readMeteringComputationScheduledRuns(d *schema.ResourceData, m interface{}) error {
+func readMeteringComputationScheduledRuns(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationScheduledRunsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MeteringComputationScheduledRunsDataSourceCrud struct {
@@ -89,7 +90,7 @@ func (s *MeteringComputationScheduledRunsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MeteringComputationScheduledRunsDataSourceCrud) Get() error {
+func (s *MeteringComputationScheduledRunsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_metering_computation.ListScheduledRunsRequest{}
 
 	if scheduleId, ok := s.D.GetOkExists("schedule_id"); ok {
@@ -99,7 +100,7 @@ func (s *MeteringComputationScheduledRunsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "metering_computation")
 
-	response, err := s.Client.ListScheduledRuns(context.Background(), request)
+	response, err := s.Client.ListScheduledRuns(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -108,7 +109,7 @@ func (s *MeteringComputationScheduledRunsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListScheduledRuns(context.Background(), request)
+		listResponse, err := s.Client.ListScheduledRuns(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package metering_computation
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_metering_computation "github.com/oracle/oci-go-sdk/v65/usageapi"
 
@@ -15,7 +16,7 @@ import (
 
 func MeteringComputationSchedulesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMeteringComputationSchedules,
+		ReadContext: readMeteringComputationSchedules,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -44,12 +45,12 @@ func MeteringComputationSchedulesDataSource() *schema.Resource {
 	}
 }
 
-func readMeteringComputationSchedules(d *schema.ResourceData, m interface{}) error {
+func readMeteringComputationSchedules(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationSchedulesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MeteringComputationSchedulesDataSourceCrud struct {
@@ -62,7 +63,7 @@ func (s *MeteringComputationSchedulesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MeteringComputationSchedulesDataSourceCrud) Get() error {
+func (s *MeteringComputationSchedulesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_metering_computation.ListSchedulesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -77,7 +78,7 @@ func (s *MeteringComputationSchedulesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "metering_computation")
 
-	response, err := s.Client.ListSchedules(context.Background(), request)
+	response, err := s.Client.ListSchedules(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *MeteringComputationSchedulesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSchedules(context.Background(), request)
+		listResponse, err := s.Client.ListSchedules(ctx, request)
 		if err != nil {
 			return err
 		}
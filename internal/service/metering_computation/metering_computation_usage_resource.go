@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -21,10 +22,10 @@ import (
 
 func MeteringComputationUsageResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createMeteringComputationUsage,
-		Read:     readMeteringComputationUsage,
-		Delete:   deleteMeteringComputationUsage,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createMeteringComputationUsage,
+		ReadContext:   readMeteringComputationUsage,
+		DeleteContext: deleteMeteringComputationUsage,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"granularity": {
@@ -309,19 +310,19 @@ func MeteringComputationUsageResource() *schema.Resource {
 	}
 }
 
-func createMeteringComputationUsage(d *schema.ResourceData, m interface{}) error {
+func createMeteringComputationUsage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MeteringComputationUsageResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).UsageapiClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readMeteringComputationUsage(d *schema.ResourceData, m interface{}) error {
+func readMeteringComputationUsage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteMeteringComputationUsage(d *schema.ResourceData, m interface{}) error {
+func deleteMeteringComputationUsage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -336,7 +337,7 @@ func (s *MeteringComputationUsageResourceCrud) ID() string {
 	return tfresource.Timestamp()
 }
 
-func (s *MeteringComputationUsageResourceCrud) Create() error {
+func (s *MeteringComputationUsageResourceCrud) Create(ctx context.Context) error {
 	request := oci_metering_computation.RequestSummarizedUsagesRequest{}
 
 	if compartmentDepth, ok := s.D.GetOkExists("compartment_depth"); ok {
@@ -431,7 +432,7 @@ func (s *MeteringComputationUsageResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "metering_computation")
 
-	response, err := s.Client.RequestSummarizedUsages(context.Background(), request)
+	response, err := s.Client.RequestSummarizedUsages(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_monitoring "github.com/oracle/oci-go-sdk/v65/monitoring"
 )
@@ -22,12 +23,12 @@ func MonitoringAlarmDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(MonitoringAlarmResource(), fieldMap, readSingularMonitoringAlarm)
 }
 
-func readSingularMonitoringAlarm(d *schema.ResourceData, m interface{}) error {
+func readSingularMonitoringAlarm(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MonitoringAlarmDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MonitoringClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MonitoringAlarmDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *MonitoringAlarmDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MonitoringAlarmDataSourceCrud) Get() error {
+func (s *MonitoringAlarmDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_monitoring.GetAlarmRequest{}
 
 	if alarmId, ok := s.D.GetOkExists("alarm_id"); ok {
@@ -50,7 +51,7 @@ func (s *MonitoringAlarmDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "monitoring")
 
-	response, err := s.Client.GetAlarm(context.Background(), request)
+	response, err := s.Client.GetAlarm(ctx, request)
 	if err != nil {
 		return err
 	}
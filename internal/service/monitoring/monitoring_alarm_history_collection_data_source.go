@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_monitoring "github.com/oracle/oci-go-sdk/v65/monitoring"
@@ -17,7 +18,7 @@ import (
 
 func MonitoringAlarmHistoryCollectionDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularMonitoringAlarmHistoryCollection,
+		ReadContext: readSingularMonitoringAlarmHistoryCollection,
 		Schema: map[string]*schema.Schema{
 			"alarm_historytype": {
 				Type:     schema.TypeString,
@@ -69,12 +70,12 @@ func MonitoringAlarmHistoryCollectionDataSource() *schema.Resource {
 	}
 }
 
-func readSingularMonitoringAlarmHistoryCollection(d *schema.ResourceData, m interface{}) error {
+func readSingularMonitoringAlarmHistoryCollection(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MonitoringAlarmHistoryCollectionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MonitoringClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MonitoringAlarmHistoryCollectionDataSourceCrud struct {
@@ -87,7 +88,7 @@ func (s *MonitoringAlarmHistoryCollectionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MonitoringAlarmHistoryCollectionDataSourceCrud) Get() error {
+func (s *MonitoringAlarmHistoryCollectionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_monitoring.GetAlarmHistoryRequest{}
 
 	if alarmHistorytype, ok := s.D.GetOkExists("alarm_historytype"); ok {
@@ -117,7 +118,7 @@ func (s *MonitoringAlarmHistoryCollectionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "monitoring")
 
-	response, err := s.Client.GetAlarmHistory(context.Background(), request)
+	response, err := s.Client.GetAlarmHistory(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
@@ -22,11 +23,11 @@ func MonitoringAlarmResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createMonitoringAlarm,
-		Read:     readMonitoringAlarm,
-		Update:   updateMonitoringAlarm,
-		Delete:   deleteMonitoringAlarm,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createMonitoringAlarm,
+		ReadContext:   readMonitoringAlarm,
+		UpdateContext: updateMonitoringAlarm,
+		DeleteContext: deleteMonitoringAlarm,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -168,37 +169,37 @@ func MonitoringAlarmResource() *schema.Resource {
 	}
 }
 
-func createMonitoringAlarm(d *schema.ResourceData, m interface{}) error {
+func createMonitoringAlarm(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MonitoringAlarmResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MonitoringClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readMonitoringAlarm(d *schema.ResourceData, m interface{}) error {
+func readMonitoringAlarm(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MonitoringAlarmResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MonitoringClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateMonitoringAlarm(d *schema.ResourceData, m interface{}) error {
+func updateMonitoringAlarm(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MonitoringAlarmResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MonitoringClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteMonitoringAlarm(d *schema.ResourceData, m interface{}) error {
+func deleteMonitoringAlarm(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MonitoringAlarmResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MonitoringClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type MonitoringAlarmResourceCrud struct {
@@ -234,7 +235,7 @@ func (s *MonitoringAlarmResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *MonitoringAlarmResourceCrud) Create() error {
+func (s *MonitoringAlarmResourceCrud) Create(ctx context.Context) error {
 	request := oci_monitoring.CreateAlarmRequest{}
 
 	if body, ok := s.D.GetOkExists("body"); ok {
@@ -348,7 +349,7 @@ func (s *MonitoringAlarmResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "monitoring")
 
-	response, err := s.Client.CreateAlarm(context.Background(), request)
+	response, err := s.Client.CreateAlarm(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -357,7 +358,7 @@ func (s *MonitoringAlarmResourceCrud) Create() error {
 	return nil
 }
 
-func (s *MonitoringAlarmResourceCrud) Get() error {
+func (s *MonitoringAlarmResourceCrud) Get(ctx context.Context) error {
 	request := oci_monitoring.GetAlarmRequest{}
 
 	tmp := s.D.Id()
@@ -365,7 +366,7 @@ func (s *MonitoringAlarmResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "monitoring")
 
-	response, err := s.Client.GetAlarm(context.Background(), request)
+	response, err := s.Client.GetAlarm(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -374,11 +375,11 @@ func (s *MonitoringAlarmResourceCrud) Get() error {
 	return nil
 }
 
-func (s *MonitoringAlarmResourceCrud) Update() error {
+func (s *MonitoringAlarmResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -500,7 +501,7 @@ func (s *MonitoringAlarmResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "monitoring")
 
-	response, err := s.Client.UpdateAlarm(context.Background(), request)
+	response, err := s.Client.UpdateAlarm(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -509,7 +510,7 @@ func (s *MonitoringAlarmResourceCrud) Update() error {
 	return nil
 }
 
-func (s *MonitoringAlarmResourceCrud) Delete() error {
+func (s *MonitoringAlarmResourceCrud) Delete(ctx context.Context) error {
 	request := oci_monitoring.DeleteAlarmRequest{}
 
 	tmp := s.D.Id()
@@ -517,7 +518,7 @@ func (s *MonitoringAlarmResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "monitoring")
 
-	_, err := s.Client.DeleteAlarm(context.Background(), request)
+	_, err := s.Client.DeleteAlarm(ctx, request)
 	return err
 }
 
@@ -650,7 +651,7 @@ func SuppressionToMap(obj *oci_monitoring.Suppression) map[string]interface{} {
 	return result
 }
 
-func (s *MonitoringAlarmResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *MonitoringAlarmResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_monitoring.ChangeAlarmCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -661,12 +662,12 @@ func (s *MonitoringAlarmResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "monitoring")
 
-	_, err := s.Client.ChangeAlarmCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeAlarmCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
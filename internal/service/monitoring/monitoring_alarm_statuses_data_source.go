@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_monitoring "github.com/oracle/oci-go-sdk/v65/monitoring"
 )
 
 func MonitoringAlarmStatusesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMonitoringAlarmStatuses,
+		ReadContext: readMonitoringAlarmStatuses,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -92,12 +93,12 @@ func MonitoringAlarmStatusesDataSource() *schema.Resource {
 	}
 }
 
-func readMonitoringAlarmStatuses(d *schema.ResourceData, m interface{}) error {
+func readMonitoringAlarmStatuses(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MonitoringAlarmStatusesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MonitoringClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MonitoringAlarmStatusesDataSourceCrud struct {
@@ -110,7 +111,7 @@ func (s *MonitoringAlarmStatusesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MonitoringAlarmStatusesDataSourceCrud) Get() error {
+func (s *MonitoringAlarmStatusesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_monitoring.ListAlarmsStatusRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -130,7 +131,7 @@ func (s *MonitoringAlarmStatusesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "monitoring")
 
-	response, err := s.Client.ListAlarmsStatus(context.Background(), request)
+	response, err := s.Client.ListAlarmsStatus(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -139,7 +140,7 @@ func (s *MonitoringAlarmStatusesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAlarmsStatus(context.Background(), request)
+		listResponse, err := s.Client.ListAlarmsStatus(ctx, request)
 		if err != nil {
 			return err
 		}
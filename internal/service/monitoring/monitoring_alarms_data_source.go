@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_monitoring "github.com/oracle/oci-go-sdk/v65/monitoring"
 )
 
 func MonitoringAlarmsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMonitoringAlarms,
+		ReadContext: readMonitoringAlarms,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,12 +44,12 @@ func MonitoringAlarmsDataSource() *schema.Resource {
 	}
 }
 
-func readMonitoringAlarms(d *schema.ResourceData, m interface{}) error {
+func readMonitoringAlarms(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MonitoringAlarmsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MonitoringClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MonitoringAlarmsDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *MonitoringAlarmsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MonitoringAlarmsDataSourceCrud) Get() error {
+func (s *MonitoringAlarmsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_monitoring.ListAlarmsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -85,7 +86,7 @@ func (s *MonitoringAlarmsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "monitoring")
 
-	response, err := s.Client.ListAlarms(context.Background(), request)
+	response, err := s.Client.ListAlarms(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *MonitoringAlarmsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAlarms(context.Background(), request)
+		listResponse, err := s.Client.ListAlarms(ctx, request)
 		if err != nil {
 			return err
 		}
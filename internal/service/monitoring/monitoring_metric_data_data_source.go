@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_monitoring "github.com/oracle/oci-go-sdk/v65/monitoring"
@@ -17,7 +18,7 @@ import (
 
 func MonitoringMetricDataDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMonitoringMetricData,
+		ReadContext: readMonitoringMetricData,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -143,12 +144,12 @@ func MonitoringMetricDataDataSource() *schema.Resource {
 	}
 }
 
-func readMonitoringMetricData(d *schema.ResourceData, m interface{}) error {
+func readMonitoringMetricData(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MonitoringMetricDataDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MonitoringClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MonitoringMetricDataDataSourceCrud struct {
@@ -161,7 +162,7 @@ func (s *MonitoringMetricDataDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MonitoringMetricDataDataSourceCrud) Get() error {
+func (s *MonitoringMetricDataDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_monitoring.SummarizeMetricsDataRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -212,7 +213,7 @@ func (s *MonitoringMetricDataDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "monitoring")
 
-	response, err := s.Client.SummarizeMetricsData(context.Background(), request)
+	response, err := s.Client.SummarizeMetricsData(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_monitoring "github.com/oracle/oci-go-sdk/v65/monitoring"
 )
 
 func MonitoringMetricsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMonitoringMetrics,
+		ReadContext: readMonitoringMetrics,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -110,12 +111,12 @@ func MonitoringMetricsDataSource() *schema.Resource {
 	}
 }
 
-func readMonitoringMetrics(d *schema.ResourceData, m interface{}) error {
+func readMonitoringMetrics(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MonitoringMetricsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MonitoringClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MonitoringMetricsDataSourceCrud struct {
@@ -128,7 +129,7 @@ func (s *MonitoringMetricsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MonitoringMetricsDataSourceCrud) Get() error {
+func (s *MonitoringMetricsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_monitoring.ListMetricsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -175,7 +176,7 @@ func (s *MonitoringMetricsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "monitoring")
 
-	response, err := s.Client.ListMetrics(context.Background(), request)
+	response, err := s.Client.ListMetrics(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -184,7 +185,7 @@ func (s *MonitoringMetricsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListMetrics(context.Background(), request)
+		listResponse, err := s.Client.ListMetrics(ctx, request)
 		if err != nil {
 			return err
 		}
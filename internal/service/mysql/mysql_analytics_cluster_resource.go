@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -30,10 +31,10 @@ func MysqlAnalyticsClusterResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("1h"),
 			Delete: tfresource.GetTimeoutDuration("1h"),
 		},
-		Create: createMysqlAnalyticsCluster,
-		Read:   readMysqlAnalyticsCluster,
-		Update: updateMysqlAnalyticsCluster,
-		Delete: deleteMysqlAnalyticsCluster,
+		CreateContext: createMysqlAnalyticsCluster,
+		ReadContext:   readMysqlAnalyticsCluster,
+		UpdateContext: updateMysqlAnalyticsCluster,
+		DeleteContext: deleteMysqlAnalyticsCluster,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"db_system_id": {
@@ -108,7 +109,7 @@ func MysqlAnalyticsClusterResource() *schema.Resource {
 	}
 }
 
-func createMysqlAnalyticsCluster(d *schema.ResourceData, m interface{}) error {
+func createMysqlAnalyticsCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlAnalyticsClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbSystemClient()
@@ -120,13 +121,13 @@ func createMysqlAnalyticsCluster(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	if e := tfresource.CreateResource(d, sync); e != nil {
-		return e
+	if e := tfresource.CreateResource(ctx, d, sync); e != nil {
+		return tfresource.HandleDiagError(e)
 	}
 
 	if powerOff {
-		if err := sync.StopAnalyticsCluster(); err != nil {
-			return err
+		if err := sync.StopAnalyticsCluster(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		sync.D.Set("state", oci_mysql.AnalyticsClusterLifecycleStateInactive)
 	}
@@ -134,15 +135,15 @@ func createMysqlAnalyticsCluster(d *schema.ResourceData, m interface{}) error {
 
 }
 
-func readMysqlAnalyticsCluster(d *schema.ResourceData, m interface{}) error {
+func readMysqlAnalyticsCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlAnalyticsClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbSystemClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateMysqlAnalyticsCluster(d *schema.ResourceData, m interface{}) error {
+func updateMysqlAnalyticsCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlAnalyticsClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbSystemClient()
@@ -159,19 +160,19 @@ func updateMysqlAnalyticsCluster(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if powerOn {
-		if err := sync.StartAnalyticsCluster(); err != nil {
-			return err
+		if err := sync.StartAnalyticsCluster(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		sync.D.Set("state", oci_mysql.AnalyticsClusterLifecycleStateActive)
 	}
 
-	if err := tfresource.UpdateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.UpdateResource(ctx, d, sync); err != nil {
+		return tfresource.HandleDiagError(err)
 	}
 
 	if powerOff {
-		if err := sync.StopAnalyticsCluster(); err != nil {
-			return err
+		if err := sync.StopAnalyticsCluster(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		sync.D.Set("state", oci_mysql.AnalyticsClusterLifecycleStateInactive)
 	}
@@ -179,13 +180,13 @@ func updateMysqlAnalyticsCluster(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func deleteMysqlAnalyticsCluster(d *schema.ResourceData, m interface{}) error {
+func deleteMysqlAnalyticsCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlAnalyticsClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbSystemClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type MysqlAnalyticsClusterResourceCrud struct {
@@ -235,7 +236,7 @@ func (s *MysqlAnalyticsClusterResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *MysqlAnalyticsClusterResourceCrud) Create() error {
+func (s *MysqlAnalyticsClusterResourceCrud) Create(ctx context.Context) error {
 	request := oci_mysql.AddAnalyticsClusterRequest{}
 
 	if clusterSize, ok := s.D.GetOkExists("cluster_size"); ok {
@@ -255,7 +256,7 @@ func (s *MysqlAnalyticsClusterResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.AddAnalyticsCluster(context.Background(), request)
+	response, err := s.Client.AddAnalyticsCluster(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -264,7 +265,7 @@ func (s *MysqlAnalyticsClusterResourceCrud) Create() error {
 	return nil
 }
 
-func (s *MysqlAnalyticsClusterResourceCrud) Get() error {
+func (s *MysqlAnalyticsClusterResourceCrud) Get(ctx context.Context) error {
 	request := oci_mysql.GetAnalyticsClusterRequest{}
 
 	if dbSystemId, ok := s.D.GetOkExists("db_system_id"); ok {
@@ -281,7 +282,7 @@ func (s *MysqlAnalyticsClusterResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.GetAnalyticsCluster(context.Background(), request)
+	response, err := s.Client.GetAnalyticsCluster(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -290,7 +291,7 @@ func (s *MysqlAnalyticsClusterResourceCrud) Get() error {
 	return nil
 }
 
-func (s *MysqlAnalyticsClusterResourceCrud) Update() error {
+func (s *MysqlAnalyticsClusterResourceCrud) Update(ctx context.Context) error {
 	request := oci_mysql.UpdateAnalyticsClusterRequest{}
 
 	if clusterSize, ok := s.D.GetOkExists("cluster_size"); ok {
@@ -310,15 +311,15 @@ func (s *MysqlAnalyticsClusterResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.UpdateAnalyticsCluster(context.Background(), request)
+	_, err := s.Client.UpdateAnalyticsCluster(ctx, request)
 	if err != nil {
 		return err
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *MysqlAnalyticsClusterResourceCrud) Delete() error {
+func (s *MysqlAnalyticsClusterResourceCrud) Delete(ctx context.Context) error {
 	request := oci_mysql.DeleteAnalyticsClusterRequest{}
 
 	if dbSystemId, ok := s.D.GetOkExists("db_system_id"); ok {
@@ -328,7 +329,7 @@ func (s *MysqlAnalyticsClusterResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.DeleteAnalyticsCluster(context.Background(), request)
+	_, err := s.Client.DeleteAnalyticsCluster(ctx, request)
 	return err
 }
 
@@ -394,7 +395,7 @@ func parseAnalyticsClusterCompositeId(compositeId string) (dbSystemId string, er
 	return
 }
 
-func (s *MysqlAnalyticsClusterResourceCrud) StartAnalyticsCluster() error {
+func (s *MysqlAnalyticsClusterResourceCrud) StartAnalyticsCluster(ctx context.Context) error {
 	request := oci_mysql.StartAnalyticsClusterRequest{}
 
 	if dbSystemId, ok := s.D.GetOkExists("db_system_id"); ok {
@@ -404,16 +405,16 @@ func (s *MysqlAnalyticsClusterResourceCrud) StartAnalyticsCluster() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.StartAnalyticsCluster(context.Background(), request)
+	_, err := s.Client.StartAnalyticsCluster(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_mysql.AnalyticsClusterLifecycleStateActive }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *MysqlAnalyticsClusterResourceCrud) StopAnalyticsCluster() error {
+func (s *MysqlAnalyticsClusterResourceCrud) StopAnalyticsCluster(ctx context.Context) error {
 	request := oci_mysql.StopAnalyticsClusterRequest{}
 
 	if dbSystemId, ok := s.D.GetOkExists("db_system_id"); ok {
@@ -423,13 +424,13 @@ func (s *MysqlAnalyticsClusterResourceCrud) StopAnalyticsCluster() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.StopAnalyticsCluster(context.Background(), request)
+	_, err := s.Client.StopAnalyticsCluster(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_mysql.AnalyticsClusterLifecycleStateInactive }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func AnalyticsClusterNodeToMap(obj oci_mysql.AnalyticsClusterNode) map[string]interface{} {
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_mysql "github.com/oracle/oci-go-sdk/v65/mysql"
 )
@@ -22,12 +23,12 @@ func MysqlChannelDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(MysqlChannelResource(), fieldMap, readSingularMysqlChannel)
 }
 
-func readSingularMysqlChannel(d *schema.ResourceData, m interface{}) error {
+func readSingularMysqlChannel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlChannelDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ChannelsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MysqlChannelDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *MysqlChannelDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MysqlChannelDataSourceCrud) Get() error {
+func (s *MysqlChannelDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_mysql.GetChannelRequest{}
 
 	if channelId, ok := s.D.GetOkExists("channel_id"); ok {
@@ -50,7 +51,7 @@ func (s *MysqlChannelDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
 
-	response, err := s.Client.GetChannel(context.Background(), request)
+	response, err := s.Client.GetChannel(ctx, request)
 	if err != nil {
 		return err
 	}
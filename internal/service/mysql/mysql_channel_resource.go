@@ -9,6 +9,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -28,10 +29,10 @@ func MysqlChannelResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("30m"),
 			Delete: tfresource.GetTimeoutDuration("30m"),
 		},
-		Create: createMysqlChannel,
-		Read:   readMysqlChannel,
-		Update: updateMysqlChannel,
-		Delete: deleteMysqlChannel,
+		CreateContext: createMysqlChannel,
+		ReadContext:   readMysqlChannel,
+		UpdateContext: updateMysqlChannel,
+		DeleteContext: deleteMysqlChannel,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"source": {
@@ -203,37 +204,37 @@ func MysqlChannelResource() *schema.Resource {
 	}
 }
 
-func createMysqlChannel(d *schema.ResourceData, m interface{}) error {
+func createMysqlChannel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlChannelResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ChannelsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readMysqlChannel(d *schema.ResourceData, m interface{}) error {
+func readMysqlChannel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlChannelResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ChannelsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateMysqlChannel(d *schema.ResourceData, m interface{}) error {
+func updateMysqlChannel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlChannelResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ChannelsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteMysqlChannel(d *schema.ResourceData, m interface{}) error {
+func deleteMysqlChannel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlChannelResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ChannelsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type MysqlChannelResourceCrud struct {
@@ -287,7 +288,7 @@ func (s *MysqlChannelResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *MysqlChannelResourceCrud) Create() error {
+func (s *MysqlChannelResourceCrud) Create(ctx context.Context) error {
 	request := oci_mysql.CreateChannelRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -346,7 +347,7 @@ func (s *MysqlChannelResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.CreateChannel(context.Background(), request)
+	response, err := s.Client.CreateChannel(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -355,7 +356,7 @@ func (s *MysqlChannelResourceCrud) Create() error {
 	return nil
 }
 
-func (s *MysqlChannelResourceCrud) Get() error {
+func (s *MysqlChannelResourceCrud) Get(ctx context.Context) error {
 	request := oci_mysql.GetChannelRequest{}
 
 	tmp := s.D.Id()
@@ -363,7 +364,7 @@ func (s *MysqlChannelResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.GetChannel(context.Background(), request)
+	response, err := s.Client.GetChannel(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -372,7 +373,7 @@ func (s *MysqlChannelResourceCrud) Get() error {
 	return nil
 }
 
-func (s *MysqlChannelResourceCrud) Update() error {
+func (s *MysqlChannelResourceCrud) Update(ctx context.Context) error {
 	request := oci_mysql.UpdateChannelRequest{}
 
 	tmp := s.D.Id()
@@ -429,15 +430,15 @@ func (s *MysqlChannelResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.UpdateChannel(context.Background(), request)
+	_, err := s.Client.UpdateChannel(ctx, request)
 	if err != nil {
 		return err
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *MysqlChannelResourceCrud) Delete() error {
+func (s *MysqlChannelResourceCrud) Delete(ctx context.Context) error {
 	request := oci_mysql.DeleteChannelRequest{}
 
 	tmp := s.D.Id()
@@ -445,7 +446,7 @@ func (s *MysqlChannelResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.DeleteChannel(context.Background(), request)
+	_, err := s.Client.DeleteChannel(ctx, request)
 	return err
 }
 
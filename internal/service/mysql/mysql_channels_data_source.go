@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_mysql "github.com/oracle/oci-go-sdk/v65/mysql"
 )
 
 func MysqlChannelsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMysqlChannels,
+		ReadContext: readMysqlChannels,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"channel_id": {
@@ -51,12 +52,12 @@ func MysqlChannelsDataSource() *schema.Resource {
 	}
 }
 
-func readMysqlChannels(d *schema.ResourceData, m interface{}) error {
+func readMysqlChannels(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlChannelsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ChannelsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MysqlChannelsDataSourceCrud struct {
@@ -69,7 +70,7 @@ func (s *MysqlChannelsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MysqlChannelsDataSourceCrud) Get() error {
+func (s *MysqlChannelsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_mysql.ListChannelsRequest{}
 
 	if channelId, ok := s.D.GetOkExists("id"); ok {
@@ -103,7 +104,7 @@ func (s *MysqlChannelsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
 
-	response, err := s.Client.ListChannels(context.Background(), request)
+	response, err := s.Client.ListChannels(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +113,7 @@ func (s *MysqlChannelsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListChannels(context.Background(), request)
+		listResponse, err := s.Client.ListChannels(ctx, request)
 		if err != nil {
 			return err
 		}
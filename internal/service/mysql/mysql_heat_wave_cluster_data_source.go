@@ -6,6 +6,7 @@ package mysql
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_mysql "github.com/oracle/oci-go-sdk/v65/mysql"
 
@@ -22,12 +23,12 @@ func MysqlHeatWaveClusterDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(MysqlHeatWaveClusterResource(), fieldMap, readSingularMysqlHeatWaveCluster)
 }
 
-func readSingularMysqlHeatWaveCluster(d *schema.ResourceData, m interface{}) error {
+func readSingularMysqlHeatWaveCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlHeatWaveClusterDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbSystemClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MysqlHeatWaveClusterDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *MysqlHeatWaveClusterDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MysqlHeatWaveClusterDataSourceCrud) Get() error {
+func (s *MysqlHeatWaveClusterDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_mysql.GetHeatWaveClusterRequest{}
 
 	if dbSystemId, ok := s.D.GetOkExists("db_system_id"); ok {
@@ -50,7 +51,7 @@ func (s *MysqlHeatWaveClusterDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
 
-	response, err := s.Client.GetHeatWaveCluster(context.Background(), request)
+	response, err := s.Client.GetHeatWaveCluster(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -30,10 +31,10 @@ func MysqlHeatWaveClusterResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("1h"),
 			Delete: tfresource.GetTimeoutDuration("1h"),
 		},
-		Create: createMysqlHeatWaveCluster,
-		Read:   readMysqlHeatWaveCluster,
-		Update: updateMysqlHeatWaveCluster,
-		Delete: deleteMysqlHeatWaveCluster,
+		CreateContext: createMysqlHeatWaveCluster,
+		ReadContext:   readMysqlHeatWaveCluster,
+		UpdateContext: updateMysqlHeatWaveCluster,
+		DeleteContext: deleteMysqlHeatWaveCluster,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"db_system_id": {
@@ -108,7 +109,7 @@ func MysqlHeatWaveClusterResource() *schema.Resource {
 	}
 }
 
-func createMysqlHeatWaveCluster(d *schema.ResourceData, m interface{}) error {
+func createMysqlHeatWaveCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlHeatWaveClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbSystemClient()
@@ -121,14 +122,14 @@ func createMysqlHeatWaveCluster(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	if e := tfresource.CreateResource(d, sync); e != nil {
-		return e
+	if e := tfresource.CreateResource(ctx, d, sync); e != nil {
+		return tfresource.HandleDiagError(e)
 	}
 
 	// switch to power off
 	if powerOff {
-		if err := sync.Stop(); err != nil {
-			return err
+		if err := sync.Stop(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		sync.D.Set("state", oci_mysql.HeatWaveClusterLifecycleStateInactive)
 	}
@@ -136,15 +137,15 @@ func createMysqlHeatWaveCluster(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func readMysqlHeatWaveCluster(d *schema.ResourceData, m interface{}) error {
+func readMysqlHeatWaveCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlHeatWaveClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbSystemClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateMysqlHeatWaveCluster(d *schema.ResourceData, m interface{}) error {
+func updateMysqlHeatWaveCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlHeatWaveClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbSystemClient()
@@ -162,20 +163,20 @@ func updateMysqlHeatWaveCluster(d *schema.ResourceData, m interface{}) error {
 
 	// switch to power on
 	if powerOn {
-		if err := sync.Start(); err != nil {
-			return err
+		if err := sync.Start(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		sync.D.Set("state", oci_mysql.HeatWaveClusterLifecycleStateActive)
 	}
 
-	if err := tfresource.UpdateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.UpdateResource(ctx, d, sync); err != nil {
+		return tfresource.HandleDiagError(err)
 	}
 
 	// switch to power off
 	if powerOff {
-		if err := sync.Stop(); err != nil {
-			return err
+		if err := sync.Stop(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		sync.D.Set("state", oci_mysql.HeatWaveClusterLifecycleStateInactive)
 	}
@@ -183,13 +184,13 @@ func updateMysqlHeatWaveCluster(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func deleteMysqlHeatWaveCluster(d *schema.ResourceData, m interface{}) error {
+func deleteMysqlHeatWaveCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlHeatWaveClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbSystemClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type MysqlHeatWaveClusterResourceCrud struct {
@@ -239,7 +240,7 @@ func (s *MysqlHeatWaveClusterResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *MysqlHeatWaveClusterResourceCrud) Create() error {
+func (s *MysqlHeatWaveClusterResourceCrud) Create(ctx context.Context) error {
 	request := oci_mysql.AddHeatWaveClusterRequest{}
 
 	if clusterSize, ok := s.D.GetOkExists("cluster_size"); ok {
@@ -259,7 +260,7 @@ func (s *MysqlHeatWaveClusterResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.AddHeatWaveCluster(context.Background(), request)
+	response, err := s.Client.AddHeatWaveCluster(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -268,7 +269,7 @@ func (s *MysqlHeatWaveClusterResourceCrud) Create() error {
 	return nil
 }
 
-func (s *MysqlHeatWaveClusterResourceCrud) Get() error {
+func (s *MysqlHeatWaveClusterResourceCrud) Get(ctx context.Context) error {
 	request := oci_mysql.GetHeatWaveClusterRequest{}
 
 	if dbSystemId, ok := s.D.GetOkExists("db_system_id"); ok {
@@ -285,7 +286,7 @@ func (s *MysqlHeatWaveClusterResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.GetHeatWaveCluster(context.Background(), request)
+	response, err := s.Client.GetHeatWaveCluster(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -294,7 +295,7 @@ func (s *MysqlHeatWaveClusterResourceCrud) Get() error {
 	return nil
 }
 
-func (s *MysqlHeatWaveClusterResourceCrud) Update() error {
+func (s *MysqlHeatWaveClusterResourceCrud) Update(ctx context.Context) error {
 	request := oci_mysql.UpdateHeatWaveClusterRequest{}
 
 	if clusterSize, ok := s.D.GetOkExists("cluster_size"); ok {
@@ -314,15 +315,15 @@ func (s *MysqlHeatWaveClusterResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.UpdateHeatWaveCluster(context.Background(), request)
+	_, err := s.Client.UpdateHeatWaveCluster(ctx, request)
 	if err != nil {
 		return err
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *MysqlHeatWaveClusterResourceCrud) Delete() error {
+func (s *MysqlHeatWaveClusterResourceCrud) Delete(ctx context.Context) error {
 	request := oci_mysql.DeleteHeatWaveClusterRequest{}
 
 	if dbSystemId, ok := s.D.GetOkExists("db_system_id"); ok {
@@ -332,7 +333,7 @@ func (s *MysqlHeatWaveClusterResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.DeleteHeatWaveCluster(context.Background(), request)
+	_, err := s.Client.DeleteHeatWaveCluster(ctx, request)
 	return err
 }
 
@@ -418,7 +419,7 @@ func HeatWaveNodeToMap(obj oci_mysql.HeatWaveNode) map[string]interface{} {
 	return result
 }
 
-func (s *MysqlHeatWaveClusterResourceCrud) Stop() error {
+func (s *MysqlHeatWaveClusterResourceCrud) Stop(ctx context.Context) error {
 	request := oci_mysql.StopHeatWaveClusterRequest{}
 
 	if dbSystemId, ok := s.D.GetOkExists("db_system_id"); ok {
@@ -428,16 +429,16 @@ func (s *MysqlHeatWaveClusterResourceCrud) Stop() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.StopHeatWaveCluster(context.Background(), request)
+	_, err := s.Client.StopHeatWaveCluster(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_mysql.HeatWaveClusterLifecycleStateInactive }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *MysqlHeatWaveClusterResourceCrud) Start() error {
+func (s *MysqlHeatWaveClusterResourceCrud) Start(ctx context.Context) error {
 	request := oci_mysql.StartHeatWaveClusterRequest{}
 
 	if dbSystemId, ok := s.D.GetOkExists("db_system_id"); ok {
@@ -447,11 +448,11 @@ func (s *MysqlHeatWaveClusterResourceCrud) Start() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.StartHeatWaveCluster(context.Background(), request)
+	_, err := s.Client.StartHeatWaveCluster(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_mysql.HeatWaveClusterLifecycleStateActive }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
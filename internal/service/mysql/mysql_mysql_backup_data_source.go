@@ -6,6 +6,7 @@ package mysql
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_mysql "github.com/oracle/oci-go-sdk/v65/mysql"
 
@@ -22,12 +23,12 @@ func MysqlMysqlBackupDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(MysqlMysqlBackupResource(), fieldMap, readSingularMysqlMysqlBackup)
 }
 
-func readSingularMysqlMysqlBackup(d *schema.ResourceData, m interface{}) error {
+func readSingularMysqlMysqlBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlBackupDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbBackupsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MysqlMysqlBackupDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *MysqlMysqlBackupDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MysqlMysqlBackupDataSourceCrud) Get() error {
+func (s *MysqlMysqlBackupDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_mysql.GetBackupRequest{}
 
 	if backupId, ok := s.D.GetOkExists("backup_id"); ok {
@@ -50,7 +51,7 @@ func (s *MysqlMysqlBackupDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
 
-	response, err := s.Client.GetBackup(context.Background(), request)
+	response, err := s.Client.GetBackup(ctx, request)
 	if err != nil {
 		return err
 	}
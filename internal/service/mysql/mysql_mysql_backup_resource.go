@@ -6,6 +6,7 @@ package mysql
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -19,11 +20,11 @@ func MysqlMysqlBackupResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createMysqlMysqlBackup,
-		Read:     readMysqlMysqlBackup,
-		Update:   updateMysqlMysqlBackup,
-		Delete:   deleteMysqlMysqlBackup,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createMysqlMysqlBackup,
+		ReadContext:   readMysqlMysqlBackup,
+		UpdateContext: updateMysqlMysqlBackup,
+		DeleteContext: deleteMysqlMysqlBackup,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"db_system_id": {
@@ -348,37 +349,37 @@ func MysqlMysqlBackupResource() *schema.Resource {
 	}
 }
 
-func createMysqlMysqlBackup(d *schema.ResourceData, m interface{}) error {
+func createMysqlMysqlBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbBackupsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readMysqlMysqlBackup(d *schema.ResourceData, m interface{}) error {
+func readMysqlMysqlBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbBackupsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateMysqlMysqlBackup(d *schema.ResourceData, m interface{}) error {
+func updateMysqlMysqlBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbBackupsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteMysqlMysqlBackup(d *schema.ResourceData, m interface{}) error {
+func deleteMysqlMysqlBackup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlBackupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbBackupsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type MysqlMysqlBackupResourceCrud struct {
@@ -428,7 +429,7 @@ func (s *MysqlMysqlBackupResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *MysqlMysqlBackupResourceCrud) Create() error {
+func (s *MysqlMysqlBackupResourceCrud) Create(ctx context.Context) error {
 	request := oci_mysql.CreateBackupRequest{}
 
 	if backupType, ok := s.D.GetOkExists("backup_type"); ok {
@@ -469,7 +470,7 @@ func (s *MysqlMysqlBackupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.CreateBackup(context.Background(), request)
+	response, err := s.Client.CreateBackup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -478,7 +479,7 @@ func (s *MysqlMysqlBackupResourceCrud) Create() error {
 	return nil
 }
 
-func (s *MysqlMysqlBackupResourceCrud) Get() error {
+func (s *MysqlMysqlBackupResourceCrud) Get(ctx context.Context) error {
 	request := oci_mysql.GetBackupRequest{}
 
 	tmp := s.D.Id()
@@ -486,7 +487,7 @@ func (s *MysqlMysqlBackupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.GetBackup(context.Background(), request)
+	response, err := s.Client.GetBackup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -495,11 +496,11 @@ func (s *MysqlMysqlBackupResourceCrud) Get() error {
 	return nil
 }
 
-func (s *MysqlMysqlBackupResourceCrud) Update() error {
+func (s *MysqlMysqlBackupResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -539,7 +540,7 @@ func (s *MysqlMysqlBackupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.UpdateBackup(context.Background(), request)
+	response, err := s.Client.UpdateBackup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -548,7 +549,7 @@ func (s *MysqlMysqlBackupResourceCrud) Update() error {
 	return nil
 }
 
-func (s *MysqlMysqlBackupResourceCrud) Delete() error {
+func (s *MysqlMysqlBackupResourceCrud) Delete(ctx context.Context) error {
 	request := oci_mysql.DeleteBackupRequest{}
 
 	tmp := s.D.Id()
@@ -556,7 +557,7 @@ func (s *MysqlMysqlBackupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.DeleteBackup(context.Background(), request)
+	_, err := s.Client.DeleteBackup(ctx, request)
 	return err
 }
 
@@ -798,7 +799,7 @@ func MaintenanceDetailsToMap(obj *oci_mysql.MaintenanceDetails) map[string]inter
 	return result
 }
 
-func (s *MysqlMysqlBackupResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *MysqlMysqlBackupResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_mysql.ChangeBackupCompartmentRequest{}
 
 	tmp := s.D.Id()
@@ -809,12 +810,12 @@ func (s *MysqlMysqlBackupResourceCrud) updateCompartment(compartment interface{}
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.ChangeBackupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeBackupCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
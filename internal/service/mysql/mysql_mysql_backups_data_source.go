@@ -6,6 +6,7 @@ package mysql
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_mysql "github.com/oracle/oci-go-sdk/v65/mysql"
 
@@ -15,7 +16,7 @@ import (
 
 func MysqlMysqlBackupsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMysqlMysqlBackups,
+		ReadContext: readMysqlMysqlBackups,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"backup_id": {
@@ -51,12 +52,12 @@ func MysqlMysqlBackupsDataSource() *schema.Resource {
 	}
 }
 
-func readMysqlMysqlBackups(d *schema.ResourceData, m interface{}) error {
+func readMysqlMysqlBackups(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlBackupsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbBackupsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MysqlMysqlBackupsDataSourceCrud struct {
@@ -69,7 +70,7 @@ func (s *MysqlMysqlBackupsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MysqlMysqlBackupsDataSourceCrud) Get() error {
+func (s *MysqlMysqlBackupsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_mysql.ListBackupsRequest{}
 
 	if backupId, ok := s.D.GetOkExists("backup_id"); ok {
@@ -102,7 +103,7 @@ func (s *MysqlMysqlBackupsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
 
-	response, err := s.Client.ListBackups(context.Background(), request)
+	response, err := s.Client.ListBackups(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -111,7 +112,7 @@ func (s *MysqlMysqlBackupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBackups(context.Background(), request)
+		listResponse, err := s.Client.ListBackups(ctx, request)
 		if err != nil {
 			return err
 		}
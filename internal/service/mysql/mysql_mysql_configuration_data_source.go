@@ -6,6 +6,7 @@ package mysql
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_mysql "github.com/oracle/oci-go-sdk/v65/mysql"
 
@@ -22,12 +23,12 @@ func MysqlMysqlConfigurationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(MysqlMysqlConfigurationResource(), fieldMap, readSingularMysqlMysqlConfiguration)
 }
 
-func readSingularMysqlMysqlConfiguration(d *schema.ResourceData, m interface{}) error {
+func readSingularMysqlMysqlConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlConfigurationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MysqlaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MysqlMysqlConfigurationDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *MysqlMysqlConfigurationDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MysqlMysqlConfigurationDataSourceCrud) Get() error {
+func (s *MysqlMysqlConfigurationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_mysql.GetConfigurationRequest{}
 
 	if configurationId, ok := s.D.GetOkExists("configuration_id"); ok {
@@ -50,7 +51,7 @@ func (s *MysqlMysqlConfigurationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
 
-	response, err := s.Client.GetConfiguration(context.Background(), request)
+	response, err := s.Client.GetConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -25,11 +26,11 @@ func MysqlMysqlConfigurationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createMysqlMysqlConfiguration,
-		Read:     readMysqlMysqlConfiguration,
-		Update:   updateMysqlMysqlConfiguration,
-		Delete:   deleteMysqlMysqlConfiguration,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createMysqlMysqlConfiguration,
+		ReadContext:   readMysqlMysqlConfiguration,
+		UpdateContext: updateMysqlMysqlConfiguration,
+		DeleteContext: deleteMysqlMysqlConfiguration,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -652,37 +653,37 @@ func MysqlMysqlConfigurationResource() *schema.Resource {
 	}
 }
 
-func createMysqlMysqlConfiguration(d *schema.ResourceData, m interface{}) error {
+func createMysqlMysqlConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MysqlaasClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readMysqlMysqlConfiguration(d *schema.ResourceData, m interface{}) error {
+func readMysqlMysqlConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MysqlaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateMysqlMysqlConfiguration(d *schema.ResourceData, m interface{}) error {
+func updateMysqlMysqlConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MysqlaasClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteMysqlMysqlConfiguration(d *schema.ResourceData, m interface{}) error {
+func deleteMysqlMysqlConfiguration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlConfigurationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MysqlaasClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type MysqlMysqlConfigurationResourceCrud struct {
@@ -726,7 +727,7 @@ func (s *MysqlMysqlConfigurationResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *MysqlMysqlConfigurationResourceCrud) Create() error {
+func (s *MysqlMysqlConfigurationResourceCrud) Create(ctx context.Context) error {
 	request := oci_mysql.CreateConfigurationRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -790,7 +791,7 @@ func (s *MysqlMysqlConfigurationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.CreateConfiguration(context.Background(), request)
+	response, err := s.Client.CreateConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -799,7 +800,7 @@ func (s *MysqlMysqlConfigurationResourceCrud) Create() error {
 	return nil
 }
 
-func (s *MysqlMysqlConfigurationResourceCrud) Get() error {
+func (s *MysqlMysqlConfigurationResourceCrud) Get(ctx context.Context) error {
 	request := oci_mysql.GetConfigurationRequest{}
 
 	tmp := s.D.Id()
@@ -814,7 +815,7 @@ func (s *MysqlMysqlConfigurationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.GetConfiguration(context.Background(), request)
+	response, err := s.Client.GetConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -823,7 +824,7 @@ func (s *MysqlMysqlConfigurationResourceCrud) Get() error {
 	return nil
 }
 
-func (s *MysqlMysqlConfigurationResourceCrud) Update() error {
+func (s *MysqlMysqlConfigurationResourceCrud) Update(ctx context.Context) error {
 	request := oci_mysql.UpdateConfigurationRequest{}
 
 	tmp := s.D.Id()
@@ -853,7 +854,7 @@ func (s *MysqlMysqlConfigurationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.UpdateConfiguration(context.Background(), request)
+	response, err := s.Client.UpdateConfiguration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -862,7 +863,7 @@ func (s *MysqlMysqlConfigurationResourceCrud) Update() error {
 	return nil
 }
 
-func (s *MysqlMysqlConfigurationResourceCrud) Delete() error {
+func (s *MysqlMysqlConfigurationResourceCrud) Delete(ctx context.Context) error {
 	request := oci_mysql.DeleteConfigurationRequest{}
 
 	tmp := s.D.Id()
@@ -870,7 +871,7 @@ func (s *MysqlMysqlConfigurationResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.DeleteConfiguration(context.Background(), request)
+	_, err := s.Client.DeleteConfiguration(ctx, request)
 	return err
 }
 
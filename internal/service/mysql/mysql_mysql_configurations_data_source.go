@@ -6,6 +6,7 @@ package mysql
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_mysql "github.com/oracle/oci-go-sdk/v65/mysql"
 
@@ -15,7 +16,7 @@ import (
 
 func MysqlMysqlConfigurationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMysqlMysqlConfigurations,
+		ReadContext: readMysqlMysqlConfigurations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -54,12 +55,12 @@ func MysqlMysqlConfigurationsDataSource() *schema.Resource {
 	}
 }
 
-func readMysqlMysqlConfigurations(d *schema.ResourceData, m interface{}) error {
+func readMysqlMysqlConfigurations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlConfigurationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MysqlaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MysqlMysqlConfigurationsDataSourceCrud struct {
@@ -72,7 +73,7 @@ func (s *MysqlMysqlConfigurationsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MysqlMysqlConfigurationsDataSourceCrud) Get() error {
+func (s *MysqlMysqlConfigurationsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_mysql.ListConfigurationsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -114,7 +115,7 @@ func (s *MysqlMysqlConfigurationsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
 
-	response, err := s.Client.ListConfigurations(context.Background(), request)
+	response, err := s.Client.ListConfigurations(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -123,7 +124,7 @@ func (s *MysqlMysqlConfigurationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListConfigurations(context.Background(), request)
+		listResponse, err := s.Client.ListConfigurations(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_mysql "github.com/oracle/oci-go-sdk/v65/mysql"
 )
@@ -22,12 +23,12 @@ func MysqlMysqlDbSystemDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(MysqlMysqlDbSystemResource(), fieldMap, readSingularMysqlMysqlDbSystem)
 }
 
-func readSingularMysqlMysqlDbSystem(d *schema.ResourceData, m interface{}) error {
+func readSingularMysqlMysqlDbSystem(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlDbSystemDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbSystemClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MysqlMysqlDbSystemDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *MysqlMysqlDbSystemDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MysqlMysqlDbSystemDataSourceCrud) Get() error {
+func (s *MysqlMysqlDbSystemDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_mysql.GetDbSystemRequest{}
 
 	if dbSystemId, ok := s.D.GetOkExists("db_system_id"); ok {
@@ -50,7 +51,7 @@ func (s *MysqlMysqlDbSystemDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
 
-	response, err := s.Client.GetDbSystem(context.Background(), request)
+	response, err := s.Client.GetDbSystem(ctx, request)
 	if err != nil {
 		return err
 	}
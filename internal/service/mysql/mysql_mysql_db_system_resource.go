@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -30,10 +31,10 @@ func MysqlMysqlDbSystemResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("1h"),
 			Delete: tfresource.GetTimeoutDuration("1h"),
 		},
-		Create: createMysqlMysqlDbSystem,
-		Read:   readMysqlMysqlDbSystem,
-		Update: updateMysqlMysqlDbSystem,
-		Delete: deleteMysqlMysqlDbSystem,
+		CreateContext: createMysqlMysqlDbSystem,
+		ReadContext:   readMysqlMysqlDbSystem,
+		UpdateContext: updateMysqlMysqlDbSystem,
+		DeleteContext: deleteMysqlMysqlDbSystem,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"availability_domain": {
@@ -643,7 +644,7 @@ func MysqlMysqlDbSystemResource() *schema.Resource {
 	}
 }
 
-func createMysqlMysqlDbSystem(d *schema.ResourceData, m interface{}) error {
+func createMysqlMysqlDbSystem(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlDbSystemResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbSystemClient()
@@ -656,13 +657,13 @@ func createMysqlMysqlDbSystem(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	if e := tfresource.CreateResource(d, sync); e != nil {
-		return e
+	if e := tfresource.CreateResource(ctx, d, sync); e != nil {
+		return tfresource.HandleDiagError(e)
 	}
 
 	if powerOff {
-		if err := sync.StopMysqlDbInstance(); err != nil {
-			return err
+		if err := sync.StopMysqlDbInstance(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		sync.D.Set("state", oci_mysql.DbSystemLifecycleStateInactive)
 	}
@@ -670,15 +671,15 @@ func createMysqlMysqlDbSystem(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func readMysqlMysqlDbSystem(d *schema.ResourceData, m interface{}) error {
+func readMysqlMysqlDbSystem(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlDbSystemResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbSystemClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateMysqlMysqlDbSystem(d *schema.ResourceData, m interface{}) error {
+func updateMysqlMysqlDbSystem(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlDbSystemResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbSystemClient()
@@ -696,20 +697,20 @@ func updateMysqlMysqlDbSystem(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if powerOn {
-		if err := sync.StartMysqlDbInstance(); err != nil {
-			return err
+		if err := sync.StartMysqlDbInstance(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		sync.D.Set("state", oci_mysql.DbSystemLifecycleStateActive)
 	}
 
-	if err := tfresource.UpdateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.UpdateResource(ctx, d, sync); err != nil {
+		return tfresource.HandleDiagError(err)
 	}
 
 	// switch to power off
 	if powerOff {
-		if err := sync.StopMysqlDbInstance(); err != nil {
-			return err
+		if err := sync.StopMysqlDbInstance(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		sync.D.Set("state", oci_mysql.DbSystemLifecycleStateInactive)
 	}
@@ -717,13 +718,13 @@ func updateMysqlMysqlDbSystem(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
-func deleteMysqlMysqlDbSystem(d *schema.ResourceData, m interface{}) error {
+func deleteMysqlMysqlDbSystem(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlDbSystemResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbSystemClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type MysqlMysqlDbSystemResourceCrud struct {
@@ -773,7 +774,7 @@ func (s *MysqlMysqlDbSystemResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *MysqlMysqlDbSystemResourceCrud) Create() error {
+func (s *MysqlMysqlDbSystemResourceCrud) Create(ctx context.Context) error {
 	request := oci_mysql.CreateDbSystemRequest{}
 
 	if adminPassword, ok := s.D.GetOkExists("admin_password"); ok {
@@ -923,7 +924,7 @@ func (s *MysqlMysqlDbSystemResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.CreateDbSystem(context.Background(), request)
+	response, err := s.Client.CreateDbSystem(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -932,7 +933,7 @@ func (s *MysqlMysqlDbSystemResourceCrud) Create() error {
 	return nil
 }
 
-func (s *MysqlMysqlDbSystemResourceCrud) Get() error {
+func (s *MysqlMysqlDbSystemResourceCrud) Get(ctx context.Context) error {
 	request := oci_mysql.GetDbSystemRequest{}
 
 	tmp := s.D.Id()
@@ -940,7 +941,7 @@ func (s *MysqlMysqlDbSystemResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	response, err := s.Client.GetDbSystem(context.Background(), request)
+	response, err := s.Client.GetDbSystem(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -949,7 +950,7 @@ func (s *MysqlMysqlDbSystemResourceCrud) Get() error {
 	return nil
 }
 
-func (s *MysqlMysqlDbSystemResourceCrud) Update() error {
+func (s *MysqlMysqlDbSystemResourceCrud) Update(ctx context.Context) error {
 	request := oci_mysql.UpdateDbSystemRequest{}
 
 	if backupPolicy, ok := s.D.GetOkExists("backup_policy"); ok && s.D.HasChange("backup_policy") {
@@ -1036,15 +1037,15 @@ func (s *MysqlMysqlDbSystemResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.UpdateDbSystem(context.Background(), request)
+	_, err := s.Client.UpdateDbSystem(ctx, request)
 	if err != nil {
 		return err
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *MysqlMysqlDbSystemResourceCrud) Delete() error {
+func (s *MysqlMysqlDbSystemResourceCrud) Delete(ctx context.Context) error {
 	request := oci_mysql.DeleteDbSystemRequest{}
 
 	tmp := s.D.Id()
@@ -1052,7 +1053,7 @@ func (s *MysqlMysqlDbSystemResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.DeleteDbSystem(context.Background(), request)
+	_, err := s.Client.DeleteDbSystem(ctx, request)
 	return err
 }
 
@@ -1706,7 +1707,7 @@ func (s *MysqlMysqlDbSystemResourceCrud) mapToUpdateMaintenanceDetails(fieldKeyF
 	return result, nil
 }
 
-func (s *MysqlMysqlDbSystemResourceCrud) StartMysqlDbInstance() error {
+func (s *MysqlMysqlDbSystemResourceCrud) StartMysqlDbInstance(ctx context.Context) error {
 	request := oci_mysql.StartDbSystemRequest{}
 
 	tmp := s.D.Id()
@@ -1714,16 +1715,16 @@ func (s *MysqlMysqlDbSystemResourceCrud) StartMysqlDbInstance() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.StartDbSystem(context.Background(), request)
+	_, err := s.Client.StartDbSystem(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_mysql.DbSystemLifecycleStateActive }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *MysqlMysqlDbSystemResourceCrud) StopMysqlDbInstance() error {
+func (s *MysqlMysqlDbSystemResourceCrud) StopMysqlDbInstance(ctx context.Context) error {
 	request := oci_mysql.StopDbSystemRequest{}
 
 	tmp := s.D.Id()
@@ -1751,11 +1752,11 @@ func (s *MysqlMysqlDbSystemResourceCrud) StopMysqlDbInstance() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
 
-	_, err := s.Client.StopDbSystem(context.Background(), request)
+	_, err := s.Client.StopDbSystem(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_mysql.DbSystemLifecycleStateInactive }
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
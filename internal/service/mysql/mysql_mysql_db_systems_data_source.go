@@ -6,6 +6,7 @@ package mysql
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_mysql "github.com/oracle/oci-go-sdk/v65/mysql"
 
@@ -15,7 +16,7 @@ import (
 
 func MysqlMysqlDbSystemsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMysqlMysqlDbSystems,
+		ReadContext: readMysqlMysqlDbSystems,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -59,12 +60,12 @@ func MysqlMysqlDbSystemsDataSource() *schema.Resource {
 	}
 }
 
-func readMysqlMysqlDbSystems(d *schema.ResourceData, m interface{}) error {
+func readMysqlMysqlDbSystems(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlDbSystemsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DbSystemClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MysqlMysqlDbSystemsDataSourceCrud struct {
@@ -77,7 +78,7 @@ func (s *MysqlMysqlDbSystemsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MysqlMysqlDbSystemsDataSourceCrud) Get() error {
+func (s *MysqlMysqlDbSystemsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_mysql.ListDbSystemsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -121,7 +122,7 @@ func (s *MysqlMysqlDbSystemsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
 
-	response, err := s.Client.ListDbSystems(context.Background(), request)
+	response, err := s.Client.ListDbSystems(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -130,7 +131,7 @@ func (s *MysqlMysqlDbSystemsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDbSystems(context.Background(), request)
+		listResponse, err := s.Client.ListDbSystems(ctx, request)
 		if err != nil {
 			return err
 		}
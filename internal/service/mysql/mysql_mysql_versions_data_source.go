@@ -6,6 +6,7 @@ package mysql
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_mysql "github.com/oracle/oci-go-sdk/v65/mysql"
 
@@ -15,7 +16,7 @@ import (
 
 func MysqlMysqlVersionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMysqlMysqlVersions,
+		ReadContext: readMysqlMysqlVersions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -64,12 +65,12 @@ func MysqlMysqlVersionsDataSource() *schema.Resource {
 	}
 }
 
-func readMysqlMysqlVersions(d *schema.ResourceData, m interface{}) error {
+func readMysqlMysqlVersions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlMysqlVersionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MysqlaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MysqlMysqlVersionsDataSourceCrud struct {
@@ -82,7 +83,7 @@ func (s *MysqlMysqlVersionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MysqlMysqlVersionsDataSourceCrud) Get() error {
+func (s *MysqlMysqlVersionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_mysql.ListVersionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -92,7 +93,7 @@ func (s *MysqlMysqlVersionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
 
-	response, err := s.Client.ListVersions(context.Background(), request)
+	response, err := s.Client.ListVersions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package mysql
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_mysql "github.com/oracle/oci-go-sdk/v65/mysql"
 
@@ -15,7 +16,7 @@ import (
 
 func MysqlShapesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readMysqlShapes,
+		ReadContext: readMysqlShapes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -73,12 +74,12 @@ func MysqlShapesDataSource() *schema.Resource {
 	}
 }
 
-func readMysqlShapes(d *schema.ResourceData, m interface{}) error {
+func readMysqlShapes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &MysqlShapesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).MysqlaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type MysqlShapesDataSourceCrud struct {
@@ -91,7 +92,7 @@ func (s *MysqlShapesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *MysqlShapesDataSourceCrud) Get() error {
+func (s *MysqlShapesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_mysql.ListShapesRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -124,7 +125,7 @@ func (s *MysqlShapesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "mysql")
 
-	response, err := s.Client.ListShapes(context.Background(), request)
+	response, err := s.Client.ListShapes(ctx, request)
 	if err != nil {
 		return err
 	}
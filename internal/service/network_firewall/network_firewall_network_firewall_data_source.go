@@ -6,6 +6,7 @@ package network_firewall
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_network_firewall "github.com/oracle/oci-go-sdk/v65/networkfirewall"
 
@@ -22,12 +23,12 @@ func NetworkFirewallNetworkFirewallDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(NetworkFirewallNetworkFirewallResource(), fieldMap, readSingularNetworkFirewallNetworkFirewall)
 }
 
-func readSingularNetworkFirewallNetworkFirewall(d *schema.ResourceData, m interface{}) error {
+func readSingularNetworkFirewallNetworkFirewall(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkFirewallNetworkFirewallDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkFirewallClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type NetworkFirewallNetworkFirewallDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *NetworkFirewallNetworkFirewallDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *NetworkFirewallNetworkFirewallDataSourceCrud) Get() error {
+func (s *NetworkFirewallNetworkFirewallDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_network_firewall.GetNetworkFirewallRequest{}
 
 	if networkFirewallId, ok := s.D.GetOkExists("network_firewall_id"); ok {
@@ -50,7 +51,7 @@ func (s *NetworkFirewallNetworkFirewallDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_firewall")
 
-	response, err := s.Client.GetNetworkFirewall(context.Background(), request)
+	response, err := s.Client.GetNetworkFirewall(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package network_firewall
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_network_firewall "github.com/oracle/oci-go-sdk/v65/networkfirewall"
 
@@ -15,7 +16,7 @@ import (
 
 func NetworkFirewallNetworkFirewallPoliciesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readNetworkFirewallNetworkFirewallPolicies,
+		ReadContext: readNetworkFirewallNetworkFirewallPolicies,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -52,12 +53,12 @@ func NetworkFirewallNetworkFirewallPoliciesDataSource() *schema.Resource {
 	}
 }
 
-func readNetworkFirewallNetworkFirewallPolicies(d *schema.ResourceData, m interface{}) error {
+func readNetworkFirewallNetworkFirewallPolicies(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkFirewallNetworkFirewallPoliciesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkFirewallClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type NetworkFirewallNetworkFirewallPoliciesDataSourceCrud struct {
@@ -70,7 +71,7 @@ func (s *NetworkFirewallNetworkFirewallPoliciesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *NetworkFirewallNetworkFirewallPoliciesDataSourceCrud) Get() error {
+func (s *NetworkFirewallNetworkFirewallPoliciesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_network_firewall.ListNetworkFirewallPoliciesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -94,7 +95,7 @@ func (s *NetworkFirewallNetworkFirewallPoliciesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_firewall")
 
-	response, err := s.Client.ListNetworkFirewallPolicies(context.Background(), request)
+	response, err := s.Client.ListNetworkFirewallPolicies(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -103,7 +104,7 @@ func (s *NetworkFirewallNetworkFirewallPoliciesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListNetworkFirewallPolicies(context.Background(), request)
+		listResponse, err := s.Client.ListNetworkFirewallPolicies(ctx, request)
 		if err != nil {
 			return err
 		}
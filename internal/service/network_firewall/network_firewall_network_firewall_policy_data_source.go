@@ -6,6 +6,7 @@ package network_firewall
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_network_firewall "github.com/oracle/oci-go-sdk/v65/networkfirewall"
 
@@ -22,12 +23,12 @@ func NetworkFirewallNetworkFirewallPolicyDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(NetworkFirewallNetworkFirewallPolicyResource(), fieldMap, readSingularNetworkFirewallNetworkFirewallPolicy)
 }
 
-func readSingularNetworkFirewallNetworkFirewallPolicy(d *schema.ResourceData, m interface{}) error {
+func readSingularNetworkFirewallNetworkFirewallPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkFirewallNetworkFirewallPolicyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkFirewallClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type NetworkFirewallNetworkFirewallPolicyDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *NetworkFirewallNetworkFirewallPolicyDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *NetworkFirewallNetworkFirewallPolicyDataSourceCrud) Get() error {
+func (s *NetworkFirewallNetworkFirewallPolicyDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_network_firewall.GetNetworkFirewallPolicyRequest{}
 
 	if networkFirewallPolicyId, ok := s.D.GetOkExists("network_firewall_policy_id"); ok {
@@ -50,7 +51,7 @@ func (s *NetworkFirewallNetworkFirewallPolicyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_firewall")
 
-	response, err := s.Client.GetNetworkFirewallPolicy(context.Background(), request)
+	response, err := s.Client.GetNetworkFirewallPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
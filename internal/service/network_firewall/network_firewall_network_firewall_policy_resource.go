@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -30,10 +31,10 @@ func NetworkFirewallNetworkFirewallPolicyResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("10m"),
 			Delete: tfresource.GetTimeoutDuration("5m"),
 		},
-		Create: createNetworkFirewallNetworkFirewallPolicy,
-		Read:   readNetworkFirewallNetworkFirewallPolicy,
-		Update: updateNetworkFirewallNetworkFirewallPolicy,
-		Delete: deleteNetworkFirewallNetworkFirewallPolicy,
+		CreateContext: createNetworkFirewallNetworkFirewallPolicy,
+		ReadContext:   readNetworkFirewallNetworkFirewallPolicy,
+		UpdateContext: updateNetworkFirewallNetworkFirewallPolicy,
+		DeleteContext: deleteNetworkFirewallNetworkFirewallPolicy,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -405,37 +406,37 @@ func NetworkFirewallNetworkFirewallPolicyResource() *schema.Resource {
 	}
 }
 
-func createNetworkFirewallNetworkFirewallPolicy(d *schema.ResourceData, m interface{}) error {
+func createNetworkFirewallNetworkFirewallPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkFirewallNetworkFirewallPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkFirewallClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readNetworkFirewallNetworkFirewallPolicy(d *schema.ResourceData, m interface{}) error {
+func readNetworkFirewallNetworkFirewallPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkFirewallNetworkFirewallPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkFirewallClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateNetworkFirewallNetworkFirewallPolicy(d *schema.ResourceData, m interface{}) error {
+func updateNetworkFirewallNetworkFirewallPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkFirewallNetworkFirewallPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkFirewallClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteNetworkFirewallNetworkFirewallPolicy(d *schema.ResourceData, m interface{}) error {
+func deleteNetworkFirewallNetworkFirewallPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkFirewallNetworkFirewallPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkFirewallClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type NetworkFirewallNetworkFirewallPolicyResourceCrud struct {
@@ -543,7 +544,7 @@ func (s *NetworkFirewallNetworkFirewallPolicyResourceCrud) mapToUrlPattern(field
 	return nil, nil
 }
 
-func (s *NetworkFirewallNetworkFirewallPolicyResourceCrud) Create() error {
+func (s *NetworkFirewallNetworkFirewallPolicyResourceCrud) Create(ctx context.Context) error {
 	request := oci_network_firewall.CreateNetworkFirewallPolicyRequest{}
 
 	if applicationLists, ok := s.D.GetOkExists("application_lists"); ok {
@@ -670,13 +671,13 @@ func (s *NetworkFirewallNetworkFirewallPolicyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
-	response, err := s.Client.CreateNetworkFirewallPolicy(context.Background(), request)
+	response, err := s.Client.CreateNetworkFirewallPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getNetworkFirewallPolicyFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall"), oci_network_firewall.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getNetworkFirewallPolicyFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall"), oci_network_firewall.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
 func (s *NetworkFirewallNetworkFirewallPolicyResourceCrud) objectMapToDecryptionProfileMap(decryptionProfiles interface{}) (map[string]oci_network_firewall.DecryptionProfile, error) {
@@ -833,17 +834,17 @@ func (s *NetworkFirewallNetworkFirewallPolicyResourceCrud) mapToMappedSecret(fie
 	return result, nil
 }
 
-func (s *NetworkFirewallNetworkFirewallPolicyResourceCrud) getNetworkFirewallPolicyFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *NetworkFirewallNetworkFirewallPolicyResourceCrud) getNetworkFirewallPolicyFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_network_firewall.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	networkFirewallPolicyId, err := networkFirewallPolicyWaitForWorkRequest(workId, "networkfirewallpolicy",
+	networkFirewallPolicyId, err := networkFirewallPolicyWaitForWorkRequest(ctx, workId, "networkfirewallpolicy",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, networkFirewallPolicyId)
-		_, cancelErr := s.Client.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.Client.CancelWorkRequest(ctx,
 			oci_network_firewall.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -857,7 +858,7 @@ func (s *NetworkFirewallNetworkFirewallPolicyResourceCrud) getNetworkFirewallPol
 	}
 	s.D.SetId(*networkFirewallPolicyId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func networkFirewallPolicyWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -883,7 +884,7 @@ func networkFirewallPolicyWorkRequestShouldRetryFunc(timeout time.Duration) func
 	}
 }
 
-func networkFirewallPolicyWaitForWorkRequest(wId *string, entityType string, action oci_network_firewall.ActionTypeEnum,
+func networkFirewallPolicyWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_network_firewall.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_network_firewall.NetworkFirewallClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "network_firewall")
 	retryPolicy.ShouldRetryOperation = networkFirewallPolicyWorkRequestShouldRetryFunc(timeout)
@@ -902,7 +903,7 @@ func networkFirewallPolicyWaitForWorkRequest(wId *string, entityType string, act
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_network_firewall.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -931,14 +932,14 @@ func networkFirewallPolicyWaitForWorkRequest(wId *string, entityType string, act
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_network_firewall.OperationStatusFailed || response.Status == oci_network_firewall.OperationStatusCanceled {
-		return nil, getErrorFromNetworkFirewallNetworkFirewallPolicyWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromNetworkFirewallNetworkFirewallPolicyWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromNetworkFirewallNetworkFirewallPolicyWorkRequest(client *oci_network_firewall.NetworkFirewallClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_network_firewall.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromNetworkFirewallNetworkFirewallPolicyWorkRequest(ctx context.Context, client *oci_network_firewall.NetworkFirewallClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_network_firewall.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_network_firewall.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -960,7 +961,7 @@ func getErrorFromNetworkFirewallNetworkFirewallPolicyWorkRequest(client *oci_net
 	return workRequestErr
 }
 
-func (s *NetworkFirewallNetworkFirewallPolicyResourceCrud) Get() error {
+func (s *NetworkFirewallNetworkFirewallPolicyResourceCrud) Get(ctx context.Context) error {
 	request := oci_network_firewall.GetNetworkFirewallPolicyRequest{}
 
 	tmp := s.D.Id()
@@ -968,7 +969,7 @@ func (s *NetworkFirewallNetworkFirewallPolicyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
-	response, err := s.Client.GetNetworkFirewallPolicy(context.Background(), request)
+	response, err := s.Client.GetNetworkFirewallPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -977,11 +978,11 @@ func (s *NetworkFirewallNetworkFirewallPolicyResourceCrud) Get() error {
 	return nil
 }
 
-func (s *NetworkFirewallNetworkFirewallPolicyResourceCrud) Update() error {
+func (s *NetworkFirewallNetworkFirewallPolicyResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -1111,16 +1112,16 @@ func (s *NetworkFirewallNetworkFirewallPolicyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
-	response, err := s.Client.UpdateNetworkFirewallPolicy(context.Background(), request)
+	response, err := s.Client.UpdateNetworkFirewallPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getNetworkFirewallPolicyFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall"), oci_network_firewall.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getNetworkFirewallPolicyFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall"), oci_network_firewall.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *NetworkFirewallNetworkFirewallPolicyResourceCrud) Delete() error {
+func (s *NetworkFirewallNetworkFirewallPolicyResourceCrud) Delete(ctx context.Context) error {
 	request := oci_network_firewall.DeleteNetworkFirewallPolicyRequest{}
 
 	tmp := s.D.Id()
@@ -1128,14 +1129,14 @@ func (s *NetworkFirewallNetworkFirewallPolicyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
-	response, err := s.Client.DeleteNetworkFirewallPolicy(context.Background(), request)
+	response, err := s.Client.DeleteNetworkFirewallPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := networkFirewallPolicyWaitForWorkRequest(workId, "networkfirewallpolicy",
+	_, delWorkRequestErr := networkFirewallPolicyWaitForWorkRequest(ctx, workId, "networkfirewallpolicy",
 		oci_network_firewall.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -1629,7 +1630,7 @@ func SecurityRuleMatchCriteriaToMap(obj *oci_network_firewall.SecurityRuleMatchC
 	return result
 }
 
-func (s *NetworkFirewallNetworkFirewallPolicyResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *NetworkFirewallNetworkFirewallPolicyResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_network_firewall.ChangeNetworkFirewallPolicyCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -1640,12 +1641,12 @@ func (s *NetworkFirewallNetworkFirewallPolicyResourceCrud) updateCompartment(com
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
-	_, err := s.Client.ChangeNetworkFirewallPolicyCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeNetworkFirewallPolicyCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
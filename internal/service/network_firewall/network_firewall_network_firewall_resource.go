@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -30,10 +31,10 @@ func NetworkFirewallNetworkFirewallResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("1h"),
 			Delete: tfresource.GetTimeoutDuration("20m"),
 		},
-		Create: createNetworkFirewallNetworkFirewall,
-		Read:   readNetworkFirewallNetworkFirewall,
-		Update: updateNetworkFirewallNetworkFirewall,
-		Delete: deleteNetworkFirewallNetworkFirewall,
+		CreateContext: createNetworkFirewallNetworkFirewall,
+		ReadContext:   readNetworkFirewallNetworkFirewall,
+		UpdateContext: updateNetworkFirewallNetworkFirewall,
+		DeleteContext: deleteNetworkFirewallNetworkFirewall,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -124,37 +125,37 @@ func NetworkFirewallNetworkFirewallResource() *schema.Resource {
 	}
 }
 
-func createNetworkFirewallNetworkFirewall(d *schema.ResourceData, m interface{}) error {
+func createNetworkFirewallNetworkFirewall(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkFirewallNetworkFirewallResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkFirewallClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readNetworkFirewallNetworkFirewall(d *schema.ResourceData, m interface{}) error {
+func readNetworkFirewallNetworkFirewall(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkFirewallNetworkFirewallResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkFirewallClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateNetworkFirewallNetworkFirewall(d *schema.ResourceData, m interface{}) error {
+func updateNetworkFirewallNetworkFirewall(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkFirewallNetworkFirewallResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkFirewallClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteNetworkFirewallNetworkFirewall(d *schema.ResourceData, m interface{}) error {
+func deleteNetworkFirewallNetworkFirewall(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkFirewallNetworkFirewallResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkFirewallClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type NetworkFirewallNetworkFirewallResourceCrud struct {
@@ -192,7 +193,7 @@ func (s *NetworkFirewallNetworkFirewallResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *NetworkFirewallNetworkFirewallResourceCrud) Create() error {
+func (s *NetworkFirewallNetworkFirewallResourceCrud) Create(ctx context.Context) error {
 	request := oci_network_firewall.CreateNetworkFirewallRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -258,25 +259,25 @@ func (s *NetworkFirewallNetworkFirewallResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
-	response, err := s.Client.CreateNetworkFirewall(context.Background(), request)
+	response, err := s.Client.CreateNetworkFirewall(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getNetworkFirewallFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall"), oci_network_firewall.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getNetworkFirewallFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall"), oci_network_firewall.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *NetworkFirewallNetworkFirewallResourceCrud) getNetworkFirewallFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *NetworkFirewallNetworkFirewallResourceCrud) getNetworkFirewallFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_network_firewall.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	networkFirewallId, err := networkFirewallWaitForWorkRequest(workId, "networkfirewall",
+	networkFirewallId, err := networkFirewallWaitForWorkRequest(ctx, workId, "networkfirewall",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, networkFirewallId)
-		_, cancelErr := s.Client.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.Client.CancelWorkRequest(ctx,
 			oci_network_firewall.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -290,7 +291,7 @@ func (s *NetworkFirewallNetworkFirewallResourceCrud) getNetworkFirewallFromWorkR
 	}
 	s.D.SetId(*networkFirewallId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func networkFirewallWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -312,7 +313,7 @@ func networkFirewallWorkRequestShouldRetryFunc(timeout time.Duration) func(respo
 	}
 }
 
-func networkFirewallWaitForWorkRequest(wId *string, entityType string, action oci_network_firewall.ActionTypeEnum,
+func networkFirewallWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_network_firewall.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_network_firewall.NetworkFirewallClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "network_firewall")
 	retryPolicy.ShouldRetryOperation = networkFirewallWorkRequestShouldRetryFunc(timeout)
@@ -332,7 +333,7 @@ func networkFirewallWaitForWorkRequest(wId *string, entityType string, action oc
 		Refresh: func() (interface{}, string, error) {
 			fmt.Printf("Calling Refresh")
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_network_firewall.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -369,14 +370,14 @@ func networkFirewallWaitForWorkRequest(wId *string, entityType string, action oc
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_network_firewall.OperationStatusFailed || response.Status == oci_network_firewall.OperationStatusCanceled {
-		return nil, getErrorFromNetworkFirewallNetworkFirewallWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromNetworkFirewallNetworkFirewallWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromNetworkFirewallNetworkFirewallWorkRequest(client *oci_network_firewall.NetworkFirewallClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_network_firewall.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromNetworkFirewallNetworkFirewallWorkRequest(ctx context.Context, client *oci_network_firewall.NetworkFirewallClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_network_firewall.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_network_firewall.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -398,7 +399,7 @@ func getErrorFromNetworkFirewallNetworkFirewallWorkRequest(client *oci_network_f
 	return workRequestErr
 }
 
-func (s *NetworkFirewallNetworkFirewallResourceCrud) Get() error {
+func (s *NetworkFirewallNetworkFirewallResourceCrud) Get(ctx context.Context) error {
 	request := oci_network_firewall.GetNetworkFirewallRequest{}
 
 	tmp := s.D.Id()
@@ -406,7 +407,7 @@ func (s *NetworkFirewallNetworkFirewallResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
-	response, err := s.Client.GetNetworkFirewall(context.Background(), request)
+	response, err := s.Client.GetNetworkFirewall(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -415,11 +416,11 @@ func (s *NetworkFirewallNetworkFirewallResourceCrud) Get() error {
 	return nil
 }
 
-func (s *NetworkFirewallNetworkFirewallResourceCrud) Update() error {
+func (s *NetworkFirewallNetworkFirewallResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -468,16 +469,16 @@ func (s *NetworkFirewallNetworkFirewallResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
-	response, err := s.Client.UpdateNetworkFirewall(context.Background(), request)
+	response, err := s.Client.UpdateNetworkFirewall(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getNetworkFirewallFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall"), oci_network_firewall.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getNetworkFirewallFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall"), oci_network_firewall.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *NetworkFirewallNetworkFirewallResourceCrud) Delete() error {
+func (s *NetworkFirewallNetworkFirewallResourceCrud) Delete(ctx context.Context) error {
 	request := oci_network_firewall.DeleteNetworkFirewallRequest{}
 
 	tmp := s.D.Id()
@@ -485,14 +486,14 @@ func (s *NetworkFirewallNetworkFirewallResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
-	response, err := s.Client.DeleteNetworkFirewall(context.Background(), request)
+	response, err := s.Client.DeleteNetworkFirewall(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := networkFirewallWaitForWorkRequest(workId, "networkfirewall",
+	_, delWorkRequestErr := networkFirewallWaitForWorkRequest(ctx, workId, "networkfirewall",
 		oci_network_firewall.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -621,7 +622,7 @@ func NetworkFirewallSummaryToMap(obj oci_network_firewall.NetworkFirewallSummary
 	return result
 }
 
-func (s *NetworkFirewallNetworkFirewallResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *NetworkFirewallNetworkFirewallResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_network_firewall.ChangeNetworkFirewallCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -632,11 +633,11 @@ func (s *NetworkFirewallNetworkFirewallResourceCrud) updateCompartment(compartme
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
 
-	response, err := s.Client.ChangeNetworkFirewallCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeNetworkFirewallCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getNetworkFirewallFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall"), oci_network_firewall.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getNetworkFirewallFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall"), oci_network_firewall.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -6,6 +6,7 @@ package network_firewall
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_network_firewall "github.com/oracle/oci-go-sdk/v65/networkfirewall"
 
@@ -15,7 +16,7 @@ import (
 
 func NetworkFirewallNetworkFirewallsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readNetworkFirewallNetworkFirewalls,
+		ReadContext: readNetworkFirewallNetworkFirewalls,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"availability_domain": {
@@ -60,12 +61,12 @@ func NetworkFirewallNetworkFirewallsDataSource() *schema.Resource {
 	}
 }
 
-func readNetworkFirewallNetworkFirewalls(d *schema.ResourceData, m interface{}) error {
+func readNetworkFirewallNetworkFirewalls(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkFirewallNetworkFirewallsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkFirewallClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type NetworkFirewallNetworkFirewallsDataSourceCrud struct {
@@ -78,7 +79,7 @@ func (s *NetworkFirewallNetworkFirewallsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *NetworkFirewallNetworkFirewallsDataSourceCrud) Get() error {
+func (s *NetworkFirewallNetworkFirewallsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_network_firewall.ListNetworkFirewallsRequest{}
 
 	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
@@ -112,7 +113,7 @@ func (s *NetworkFirewallNetworkFirewallsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_firewall")
 
-	response, err := s.Client.ListNetworkFirewalls(context.Background(), request)
+	response, err := s.Client.ListNetworkFirewalls(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -121,7 +122,7 @@ func (s *NetworkFirewallNetworkFirewallsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListNetworkFirewalls(context.Background(), request)
+		listResponse, err := s.Client.ListNetworkFirewalls(ctx, request)
 		if err != nil {
 			return err
 		}
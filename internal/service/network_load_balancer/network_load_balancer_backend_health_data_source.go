@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_network_load_balancer "github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
 )
 
 func NetworkLoadBalancerBackendHealthDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularNetworkLoadBalancerBackendHealth,
+		ReadContext: readSingularNetworkLoadBalancerBackendHealth,
 		Schema: map[string]*schema.Schema{
 			"backend_name": {
 				Type:     schema.TypeString,
@@ -59,12 +60,12 @@ func NetworkLoadBalancerBackendHealthDataSource() *schema.Resource {
 	}
 }
 
-func readSingularNetworkLoadBalancerBackendHealth(d *schema.ResourceData, m interface{}) error {
+func readSingularNetworkLoadBalancerBackendHealth(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerBackendHealthDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type NetworkLoadBalancerBackendHealthDataSourceCrud struct {
@@ -77,7 +78,7 @@ func (s *NetworkLoadBalancerBackendHealthDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *NetworkLoadBalancerBackendHealthDataSourceCrud) Get() error {
+func (s *NetworkLoadBalancerBackendHealthDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_network_load_balancer.GetBackendHealthRequest{}
 
 	if backendName, ok := s.D.GetOkExists("backend_name"); ok {
@@ -97,7 +98,7 @@ func (s *NetworkLoadBalancerBackendHealthDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_load_balancer")
 
-	response, err := s.Client.GetBackendHealth(context.Background(), request)
+	response, err := s.Client.GetBackendHealth(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_network_load_balancer "github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
 )
@@ -26,12 +27,12 @@ func NetworkLoadBalancerBackendSetDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(NetworkLoadBalancerBackendSetResource(), fieldMap, readSingularNetworkLoadBalancerBackendSet)
 }
 
-func readSingularNetworkLoadBalancerBackendSet(d *schema.ResourceData, m interface{}) error {
+func readSingularNetworkLoadBalancerBackendSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerBackendSetDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type NetworkLoadBalancerBackendSetDataSourceCrud struct {
@@ -44,7 +45,7 @@ func (s *NetworkLoadBalancerBackendSetDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *NetworkLoadBalancerBackendSetDataSourceCrud) Get() error {
+func (s *NetworkLoadBalancerBackendSetDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_network_load_balancer.GetBackendSetRequest{}
 
 	if backendSetName, ok := s.D.GetOkExists("backend_set_name"); ok {
@@ -59,7 +60,7 @@ func (s *NetworkLoadBalancerBackendSetDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_load_balancer")
 
-	response, err := s.Client.GetBackendSet(context.Background(), request)
+	response, err := s.Client.GetBackendSet(ctx, request)
 	if err != nil {
 		return err
 	}
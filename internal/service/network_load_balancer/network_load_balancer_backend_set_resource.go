@@ -19,6 +19,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -31,11 +32,11 @@ func NetworkLoadBalancerBackendSetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createNetworkLoadBalancerBackendSet,
-		Read:     readNetworkLoadBalancerBackendSet,
-		Update:   updateNetworkLoadBalancerBackendSet,
-		Delete:   deleteNetworkLoadBalancerBackendSet,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createNetworkLoadBalancerBackendSet,
+		ReadContext:   readNetworkLoadBalancerBackendSet,
+		UpdateContext: updateNetworkLoadBalancerBackendSet,
+		DeleteContext: deleteNetworkLoadBalancerBackendSet,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"health_checker": {
@@ -186,37 +187,37 @@ func NetworkLoadBalancerBackendSetResource() *schema.Resource {
 	}
 }
 
-func createNetworkLoadBalancerBackendSet(d *schema.ResourceData, m interface{}) error {
+func createNetworkLoadBalancerBackendSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerBackendSetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readNetworkLoadBalancerBackendSet(d *schema.ResourceData, m interface{}) error {
+func readNetworkLoadBalancerBackendSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerBackendSetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateNetworkLoadBalancerBackendSet(d *schema.ResourceData, m interface{}) error {
+func updateNetworkLoadBalancerBackendSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerBackendSetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteNetworkLoadBalancerBackendSet(d *schema.ResourceData, m interface{}) error {
+func deleteNetworkLoadBalancerBackendSet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerBackendSetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type NetworkLoadBalancerBackendSetResourceCrud struct {
@@ -237,7 +238,7 @@ func (s *NetworkLoadBalancerBackendSetResourceCrud) ID() string {
 	return GetNlbBackendSetCompositeId(s.D.Get("name").(string), s.D.Get("network_load_balancer_id").(string))
 }
 
-func (s *NetworkLoadBalancerBackendSetResourceCrud) Create() error {
+func (s *NetworkLoadBalancerBackendSetResourceCrud) Create(ctx context.Context) error {
 	request := oci_network_load_balancer.CreateBackendSetRequest{}
 
 	if healthChecker, ok := s.D.GetOkExists("health_checker"); ok {
@@ -276,20 +277,20 @@ func (s *NetworkLoadBalancerBackendSetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.CreateBackendSet(context.Background(), request)
+	response, err := s.Client.CreateBackendSet(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getBackendSetFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer"), oci_network_load_balancer.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getBackendSetFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer"), oci_network_load_balancer.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *NetworkLoadBalancerBackendSetResourceCrud) getBackendSetFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *NetworkLoadBalancerBackendSetResourceCrud) getBackendSetFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_network_load_balancer.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	nlbId, err := nlbBackendSetWaitForWorkRequest(workId,
+	nlbId, err := nlbBackendSetWaitForWorkRequest(ctx, workId,
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -297,7 +298,7 @@ func (s *NetworkLoadBalancerBackendSetResourceCrud) getBackendSetFromWorkRequest
 	}
 	s.D.Set("network_load_balancer_id", nlbId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func backendSetWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -323,7 +324,7 @@ func backendSetWorkRequestShouldRetryFunc(timeout time.Duration) func(response o
 	}
 }
 
-func nlbBackendSetWaitForWorkRequest(wId *string, action oci_network_load_balancer.ActionTypeEnum,
+func nlbBackendSetWaitForWorkRequest(ctx context.Context, wId *string, action oci_network_load_balancer.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_network_load_balancer.NetworkLoadBalancerClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "network_load_balancer")
 	retryPolicy.ShouldRetryOperation = networkLoadBalancerWorkRequestShouldRetryFunc(timeout)
@@ -342,7 +343,7 @@ func nlbBackendSetWaitForWorkRequest(wId *string, action oci_network_load_balanc
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_network_load_balancer.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -371,17 +372,17 @@ func nlbBackendSetWaitForWorkRequest(wId *string, action oci_network_load_balanc
 
 	var workRequestErr error
 	if response.WorkRequest.Status == oci_network_load_balancer.OperationStatusFailed {
-		errorMessage := getErrorFromNlbBackendSetWorkRequest(response.WorkRequest, client, retryPolicy)
+		errorMessage := getErrorFromNlbBackendSetWorkRequest(ctx, response.WorkRequest, client, retryPolicy)
 		workRequestErr = fmt.Errorf("work request did not succeed, workId: %s, action: %s. Message: %s", *wId, action, errorMessage)
 	}
 
 	return identifier, workRequestErr
 }
 
-func getErrorFromNlbBackendSetWorkRequest(wr oci_network_load_balancer.WorkRequest,
+func getErrorFromNlbBackendSetWorkRequest(ctx context.Context, wr oci_network_load_balancer.WorkRequest,
 	client *oci_network_load_balancer.NetworkLoadBalancerClient, retryPolicy *oci_common.RetryPolicy) string {
 	// Fetch the list of work request errors
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_network_load_balancer.ListWorkRequestErrorsRequest{
 			WorkRequestId: wr.Id,
 			CompartmentId: wr.CompartmentId,
@@ -402,7 +403,7 @@ func getErrorFromNlbBackendSetWorkRequest(wr oci_network_load_balancer.WorkReque
 	return errorMessage
 }
 
-func (s *NetworkLoadBalancerBackendSetResourceCrud) Get() error {
+func (s *NetworkLoadBalancerBackendSetResourceCrud) Get(ctx context.Context) error {
 	request := oci_network_load_balancer.GetBackendSetRequest{}
 
 	if backendSetName, ok := s.D.GetOkExists("name"); ok {
@@ -425,7 +426,7 @@ func (s *NetworkLoadBalancerBackendSetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.GetBackendSet(context.Background(), request)
+	response, err := s.Client.GetBackendSet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -434,13 +435,13 @@ func (s *NetworkLoadBalancerBackendSetResourceCrud) Get() error {
 	return nil
 }
 
-func (s *NetworkLoadBalancerBackendSetResourceCrud) Update() error {
+func (s *NetworkLoadBalancerBackendSetResourceCrud) Update(ctx context.Context) error {
 	request := oci_network_load_balancer.UpdateBackendSetRequest{}
 
 	// @CODEGEN: Backends are marked computed in this resource, so will do a GET and include the results in the UPDATE, although they are not a required parameter.
 	//           This behavior is intentionally set identical to LBaaS
 	// Side-note: There is a potential for a race condition if the backend are added at the same time outside Terraform
-	err := s.Get()
+	err := s.Get(ctx)
 	if err != nil {
 		return err
 	}
@@ -499,16 +500,16 @@ func (s *NetworkLoadBalancerBackendSetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.UpdateBackendSet(context.Background(), request)
+	response, err := s.Client.UpdateBackendSet(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getBackendSetFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer"), oci_network_load_balancer.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getBackendSetFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer"), oci_network_load_balancer.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *NetworkLoadBalancerBackendSetResourceCrud) Delete() error {
+func (s *NetworkLoadBalancerBackendSetResourceCrud) Delete(ctx context.Context) error {
 	request := oci_network_load_balancer.DeleteBackendSetRequest{}
 
 	if backendSetName, ok := s.D.GetOkExists("name"); ok {
@@ -523,14 +524,14 @@ func (s *NetworkLoadBalancerBackendSetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.DeleteBackendSet(context.Background(), request)
+	response, err := s.Client.DeleteBackendSet(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := networkLoadBalancerWaitForWorkRequest(workId,
+	_, delWorkRequestErr := networkLoadBalancerWaitForWorkRequest(ctx, workId,
 		oci_network_load_balancer.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_network_load_balancer "github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
 )
 
 func NetworkLoadBalancerBackendSetsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readNetworkLoadBalancerBackendSets,
+		ReadContext: readNetworkLoadBalancerBackendSets,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"network_load_balancer_id": {
@@ -39,12 +40,12 @@ func NetworkLoadBalancerBackendSetsDataSource() *schema.Resource {
 	}
 }
 
-func readNetworkLoadBalancerBackendSets(d *schema.ResourceData, m interface{}) error {
+func readNetworkLoadBalancerBackendSets(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerBackendSetsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type NetworkLoadBalancerBackendSetsDataSourceCrud struct {
@@ -57,7 +58,7 @@ func (s *NetworkLoadBalancerBackendSetsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *NetworkLoadBalancerBackendSetsDataSourceCrud) Get() error {
+func (s *NetworkLoadBalancerBackendSetsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_network_load_balancer.ListBackendSetsRequest{}
 
 	if networkLoadBalancerId, ok := s.D.GetOkExists("network_load_balancer_id"); ok {
@@ -67,7 +68,7 @@ func (s *NetworkLoadBalancerBackendSetsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_load_balancer")
 
-	response, err := s.Client.ListBackendSets(context.Background(), request)
+	response, err := s.Client.ListBackendSets(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -76,7 +77,7 @@ func (s *NetworkLoadBalancerBackendSetsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBackendSets(context.Background(), request)
+		listResponse, err := s.Client.ListBackendSets(ctx, request)
 		if err != nil {
 			return err
 		}
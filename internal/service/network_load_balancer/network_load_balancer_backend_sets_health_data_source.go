@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_network_load_balancer "github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
 )
 
 func NetworkLoadBalancerBackendSetHealthDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularNetworkLoadBalancerBackendSetHealth,
+		ReadContext: readSingularNetworkLoadBalancerBackendSetHealth,
 		Schema: map[string]*schema.Schema{
 			"backend_set_name": {
 				Type:     schema.TypeString,
@@ -59,12 +60,12 @@ func NetworkLoadBalancerBackendSetHealthDataSource() *schema.Resource {
 	}
 }
 
-func readSingularNetworkLoadBalancerBackendSetHealth(d *schema.ResourceData, m interface{}) error {
+func readSingularNetworkLoadBalancerBackendSetHealth(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerBackendSetHealthDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type NetworkLoadBalancerBackendSetHealthDataSourceCrud struct {
@@ -77,7 +78,7 @@ func (s *NetworkLoadBalancerBackendSetHealthDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *NetworkLoadBalancerBackendSetHealthDataSourceCrud) Get() error {
+func (s *NetworkLoadBalancerBackendSetHealthDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_network_load_balancer.GetBackendSetHealthRequest{}
 
 	if backendSetName, ok := s.D.GetOkExists("backend_set_name"); ok {
@@ -92,7 +93,7 @@ func (s *NetworkLoadBalancerBackendSetHealthDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_load_balancer")
 
-	response, err := s.Client.GetBackendSetHealth(context.Background(), request)
+	response, err := s.Client.GetBackendSetHealth(ctx, request)
 	if err != nil {
 		return err
 	}
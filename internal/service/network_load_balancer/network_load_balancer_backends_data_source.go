@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_network_load_balancer "github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
 )
 
 func NetworkLoadBalancerBackendsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readNetworkLoadBalancerBackends,
+		ReadContext: readNetworkLoadBalancerBackends,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"backend_set_name": {
@@ -44,12 +45,12 @@ func NetworkLoadBalancerBackendsDataSource() *schema.Resource {
 	}
 }
 
-func readNetworkLoadBalancerBackends(d *schema.ResourceData, m interface{}) error {
+func readNetworkLoadBalancerBackends(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerBackendsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type NetworkLoadBalancerBackendsDataSourceCrud struct {
@@ -62,7 +63,7 @@ func (s *NetworkLoadBalancerBackendsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *NetworkLoadBalancerBackendsDataSourceCrud) Get() error {
+func (s *NetworkLoadBalancerBackendsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_network_load_balancer.ListBackendsRequest{}
 
 	if backendSetName, ok := s.D.GetOkExists("backend_set_name"); ok {
@@ -77,7 +78,7 @@ func (s *NetworkLoadBalancerBackendsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_load_balancer")
 
-	response, err := s.Client.ListBackends(context.Background(), request)
+	response, err := s.Client.ListBackends(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *NetworkLoadBalancerBackendsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBackends(context.Background(), request)
+		listResponse, err := s.Client.ListBackends(ctx, request)
 		if err != nil {
 			return err
 		}
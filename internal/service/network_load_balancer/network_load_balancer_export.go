@@ -89,7 +89,7 @@ func findNetworkLoadBalancerListeners(ctx *tf_export.ResourceDiscoveryContext, t
 		// This calls into the listener resource's Read fn which has the unfortunate implementation of
 		// calling GetNetworkLoadBalancer and looping through the listeners to find the expected one. So this entire method
 		// may require O(n^^2) time. However, the benefits of having Read populate the ResourceData struct is better than duplicating it here.
-		if err := listenerResource.Read(d, ctx.Clients); err != nil {
+		if err := tfresource.ReadSchemaResource(listenerResource, d, ctx.Clients); err != nil {
 			// add error to the errorList and continue discovering rest of the resources
 			rdError := &tf_export.ResourceDiscoveryError{ResourceType: tfMeta.ResourceClass, ParentResource: parent.TerraformName, Error: err, ResourceGraph: resourceGraph}
 			ctx.AddErrorToList(rdError)
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_network_load_balancer "github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
 )
@@ -26,12 +27,12 @@ func NetworkLoadBalancerListenerDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(NetworkLoadBalancerListenerResource(), fieldMap, readSingularNetworkLoadBalancerListener)
 }
 
-func readSingularNetworkLoadBalancerListener(d *schema.ResourceData, m interface{}) error {
+func readSingularNetworkLoadBalancerListener(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerListenerDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type NetworkLoadBalancerListenerDataSourceCrud struct {
@@ -44,7 +45,7 @@ func (s *NetworkLoadBalancerListenerDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *NetworkLoadBalancerListenerDataSourceCrud) Get() error {
+func (s *NetworkLoadBalancerListenerDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_network_load_balancer.GetListenerRequest{}
 
 	if listenerName, ok := s.D.GetOkExists("listener_name"); ok {
@@ -59,7 +60,7 @@ func (s *NetworkLoadBalancerListenerDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_load_balancer")
 
-	response, err := s.Client.GetListener(context.Background(), request)
+	response, err := s.Client.GetListener(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -27,11 +28,11 @@ func NetworkLoadBalancerListenerResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createNetworkLoadBalancerListener,
-		Read:     readNetworkLoadBalancerListener,
-		Update:   updateNetworkLoadBalancerListener,
-		Delete:   deleteNetworkLoadBalancerListener,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createNetworkLoadBalancerListener,
+		ReadContext:   readNetworkLoadBalancerListener,
+		UpdateContext: updateNetworkLoadBalancerListener,
+		DeleteContext: deleteNetworkLoadBalancerListener,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"default_backend_set_name": {
@@ -68,37 +69,37 @@ func NetworkLoadBalancerListenerResource() *schema.Resource {
 	}
 }
 
-func createNetworkLoadBalancerListener(d *schema.ResourceData, m interface{}) error {
+func createNetworkLoadBalancerListener(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerListenerResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readNetworkLoadBalancerListener(d *schema.ResourceData, m interface{}) error {
+func readNetworkLoadBalancerListener(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerListenerResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateNetworkLoadBalancerListener(d *schema.ResourceData, m interface{}) error {
+func updateNetworkLoadBalancerListener(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerListenerResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteNetworkLoadBalancerListener(d *schema.ResourceData, m interface{}) error {
+func deleteNetworkLoadBalancerListener(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerListenerResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type NetworkLoadBalancerListenerResourceCrud struct {
@@ -112,7 +113,7 @@ func (s *NetworkLoadBalancerListenerResourceCrud) ID() string {
 	return GetNlbListenerCompositeId(s.D.Get("name").(string), s.D.Get("network_load_balancer_id").(string))
 }
 
-func (s *NetworkLoadBalancerListenerResourceCrud) Create() error {
+func (s *NetworkLoadBalancerListenerResourceCrud) Create(ctx context.Context) error {
 	request := oci_network_load_balancer.CreateListenerRequest{}
 
 	if defaultBackendSetName, ok := s.D.GetOkExists("default_backend_set_name"); ok {
@@ -143,20 +144,20 @@ func (s *NetworkLoadBalancerListenerResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.CreateListener(context.Background(), request)
+	response, err := s.Client.CreateListener(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getListenerFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer"), oci_network_load_balancer.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getListenerFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer"), oci_network_load_balancer.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *NetworkLoadBalancerListenerResourceCrud) getListenerFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *NetworkLoadBalancerListenerResourceCrud) getListenerFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_network_load_balancer.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	nlbId, err := nlbListenerWaitForWorkRequest(workId,
+	nlbId, err := nlbListenerWaitForWorkRequest(ctx, workId,
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -164,7 +165,7 @@ func (s *NetworkLoadBalancerListenerResourceCrud) getListenerFromWorkRequest(wor
 	}
 	s.D.Set("network_load_balancer_id", nlbId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func listenerWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -190,7 +191,7 @@ func listenerWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci
 	}
 }
 
-func nlbListenerWaitForWorkRequest(wId *string, action oci_network_load_balancer.ActionTypeEnum,
+func nlbListenerWaitForWorkRequest(ctx context.Context, wId *string, action oci_network_load_balancer.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_network_load_balancer.NetworkLoadBalancerClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "network_load_balancer")
 	retryPolicy.ShouldRetryOperation = networkLoadBalancerWorkRequestShouldRetryFunc(timeout)
@@ -209,7 +210,7 @@ func nlbListenerWaitForWorkRequest(wId *string, action oci_network_load_balancer
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_network_load_balancer.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -238,17 +239,17 @@ func nlbListenerWaitForWorkRequest(wId *string, action oci_network_load_balancer
 
 	var workRequestErr error
 	if response.WorkRequest.Status == oci_network_load_balancer.OperationStatusFailed {
-		errorMessage := getErrorFromNlbListenerWorkRequest(response.WorkRequest, client, retryPolicy)
+		errorMessage := getErrorFromNlbListenerWorkRequest(ctx, response.WorkRequest, client, retryPolicy)
 		workRequestErr = fmt.Errorf("work request did not succeed, workId: %s, action: %s. Message: %s", *wId, action, errorMessage)
 	}
 
 	return identifier, workRequestErr
 }
 
-func getErrorFromNlbListenerWorkRequest(wr oci_network_load_balancer.WorkRequest,
+func getErrorFromNlbListenerWorkRequest(ctx context.Context, wr oci_network_load_balancer.WorkRequest,
 	client *oci_network_load_balancer.NetworkLoadBalancerClient, retryPolicy *oci_common.RetryPolicy) string {
 	// Fetch the list of work request errors
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_network_load_balancer.ListWorkRequestErrorsRequest{
 			WorkRequestId: wr.Id,
 			CompartmentId: wr.CompartmentId,
@@ -269,7 +270,7 @@ func getErrorFromNlbListenerWorkRequest(wr oci_network_load_balancer.WorkRequest
 	return errorMessage
 }
 
-func (s *NetworkLoadBalancerListenerResourceCrud) Get() error {
+func (s *NetworkLoadBalancerListenerResourceCrud) Get(ctx context.Context) error {
 	request := oci_network_load_balancer.GetListenerRequest{}
 
 	if listenerName, ok := s.D.GetOkExists("name"); ok {
@@ -292,7 +293,7 @@ func (s *NetworkLoadBalancerListenerResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.GetListener(context.Background(), request)
+	response, err := s.Client.GetListener(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -301,7 +302,7 @@ func (s *NetworkLoadBalancerListenerResourceCrud) Get() error {
 	return nil
 }
 
-func (s *NetworkLoadBalancerListenerResourceCrud) Update() error {
+func (s *NetworkLoadBalancerListenerResourceCrud) Update(ctx context.Context) error {
 	request := oci_network_load_balancer.UpdateListenerRequest{}
 
 	if defaultBackendSetName, ok := s.D.GetOkExists("default_backend_set_name"); ok {
@@ -332,16 +333,16 @@ func (s *NetworkLoadBalancerListenerResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.UpdateListener(context.Background(), request)
+	response, err := s.Client.UpdateListener(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getListenerFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer"), oci_network_load_balancer.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getListenerFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer"), oci_network_load_balancer.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *NetworkLoadBalancerListenerResourceCrud) Delete() error {
+func (s *NetworkLoadBalancerListenerResourceCrud) Delete(ctx context.Context) error {
 	request := oci_network_load_balancer.DeleteListenerRequest{}
 
 	if listenerName, ok := s.D.GetOkExists("name"); ok {
@@ -356,14 +357,14 @@ func (s *NetworkLoadBalancerListenerResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.DeleteListener(context.Background(), request)
+	response, err := s.Client.DeleteListener(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := networkLoadBalancerWaitForWorkRequest(workId,
+	_, delWorkRequestErr := networkLoadBalancerWaitForWorkRequest(ctx, workId,
 		oci_network_load_balancer.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_network_load_balancer "github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
 )
@@ -22,12 +23,12 @@ func NetworkLoadBalancerNetworkLoadBalancerDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(NetworkLoadBalancerNetworkLoadBalancerResource(), fieldMap, readSingularNetworkLoadBalancerNetworkLoadBalancer)
 }
 
-func readSingularNetworkLoadBalancerNetworkLoadBalancer(d *schema.ResourceData, m interface{}) error {
+func readSingularNetworkLoadBalancerNetworkLoadBalancer(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerNetworkLoadBalancerDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type NetworkLoadBalancerNetworkLoadBalancerDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *NetworkLoadBalancerNetworkLoadBalancerDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *NetworkLoadBalancerNetworkLoadBalancerDataSourceCrud) Get() error {
+func (s *NetworkLoadBalancerNetworkLoadBalancerDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_network_load_balancer.GetNetworkLoadBalancerRequest{}
 
 	if networkLoadBalancerId, ok := s.D.GetOkExists("network_load_balancer_id"); ok {
@@ -50,7 +51,7 @@ func (s *NetworkLoadBalancerNetworkLoadBalancerDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_load_balancer")
 
-	response, err := s.Client.GetNetworkLoadBalancer(context.Background(), request)
+	response, err := s.Client.GetNetworkLoadBalancer(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_network_load_balancer "github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
 )
 
 func NetworkLoadBalancerNetworkLoadBalancerHealthDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularNetworkLoadBalancerNetworkLoadBalancerHealth,
+		ReadContext: readSingularNetworkLoadBalancerNetworkLoadBalancerHealth,
 		Schema: map[string]*schema.Schema{
 			"network_load_balancer_id": {
 				Type:     schema.TypeString,
@@ -55,12 +56,12 @@ func NetworkLoadBalancerNetworkLoadBalancerHealthDataSource() *schema.Resource {
 	}
 }
 
-func readSingularNetworkLoadBalancerNetworkLoadBalancerHealth(d *schema.ResourceData, m interface{}) error {
+func readSingularNetworkLoadBalancerNetworkLoadBalancerHealth(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerNetworkLoadBalancerHealthDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type NetworkLoadBalancerNetworkLoadBalancerHealthDataSourceCrud struct {
@@ -73,7 +74,7 @@ func (s *NetworkLoadBalancerNetworkLoadBalancerHealthDataSourceCrud) VoidState()
 	s.D.SetId("")
 }
 
-func (s *NetworkLoadBalancerNetworkLoadBalancerHealthDataSourceCrud) Get() error {
+func (s *NetworkLoadBalancerNetworkLoadBalancerHealthDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_network_load_balancer.GetNetworkLoadBalancerHealthRequest{}
 
 	if networkLoadBalancerId, ok := s.D.GetOkExists("network_load_balancer_id"); ok {
@@ -83,7 +84,7 @@ func (s *NetworkLoadBalancerNetworkLoadBalancerHealthDataSourceCrud) Get() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_load_balancer")
 
-	response, err := s.Client.GetNetworkLoadBalancerHealth(context.Background(), request)
+	response, err := s.Client.GetNetworkLoadBalancerHealth(ctx, request)
 	if err != nil {
 		return err
 	}
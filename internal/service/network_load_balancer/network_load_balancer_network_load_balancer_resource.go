@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,11 +25,11 @@ func NetworkLoadBalancerNetworkLoadBalancerResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createNetworkLoadBalancerNetworkLoadBalancer,
-		Read:     readNetworkLoadBalancerNetworkLoadBalancer,
-		Update:   updateNetworkLoadBalancerNetworkLoadBalancer,
-		Delete:   deleteNetworkLoadBalancerNetworkLoadBalancer,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createNetworkLoadBalancerNetworkLoadBalancer,
+		ReadContext:   readNetworkLoadBalancerNetworkLoadBalancer,
+		UpdateContext: updateNetworkLoadBalancerNetworkLoadBalancer,
+		DeleteContext: deleteNetworkLoadBalancerNetworkLoadBalancer,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -173,37 +174,37 @@ func NetworkLoadBalancerNetworkLoadBalancerResource() *schema.Resource {
 	}
 }
 
-func createNetworkLoadBalancerNetworkLoadBalancer(d *schema.ResourceData, m interface{}) error {
+func createNetworkLoadBalancerNetworkLoadBalancer(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerNetworkLoadBalancerResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readNetworkLoadBalancerNetworkLoadBalancer(d *schema.ResourceData, m interface{}) error {
+func readNetworkLoadBalancerNetworkLoadBalancer(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerNetworkLoadBalancerResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateNetworkLoadBalancerNetworkLoadBalancer(d *schema.ResourceData, m interface{}) error {
+func updateNetworkLoadBalancerNetworkLoadBalancer(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerNetworkLoadBalancerResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteNetworkLoadBalancerNetworkLoadBalancer(d *schema.ResourceData, m interface{}) error {
+func deleteNetworkLoadBalancerNetworkLoadBalancer(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerNetworkLoadBalancerResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type NetworkLoadBalancerNetworkLoadBalancerResourceCrud struct {
@@ -242,7 +243,7 @@ func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) DeletedTarget() []s
 	}
 }
 
-func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) Create() error {
+func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) Create(ctx context.Context) error {
 	request := oci_network_load_balancer.CreateNetworkLoadBalancerRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -317,20 +318,20 @@ func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.CreateNetworkLoadBalancer(context.Background(), request)
+	response, err := s.Client.CreateNetworkLoadBalancer(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getNetworkLoadBalancerFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer"), oci_network_load_balancer.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getNetworkLoadBalancerFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer"), oci_network_load_balancer.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) getNetworkLoadBalancerFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) getNetworkLoadBalancerFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_network_load_balancer.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	networkLoadBalancerId, err := networkLoadBalancerWaitForWorkRequest(workId,
+	networkLoadBalancerId, err := networkLoadBalancerWaitForWorkRequest(ctx, workId,
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -338,7 +339,7 @@ func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) getNetworkLoadBalan
 	}
 	s.D.SetId(*networkLoadBalancerId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func networkLoadBalancerWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -364,7 +365,7 @@ func networkLoadBalancerWorkRequestShouldRetryFunc(timeout time.Duration) func(r
 	}
 }
 
-func networkLoadBalancerWaitForWorkRequest(wId *string, action oci_network_load_balancer.ActionTypeEnum,
+func networkLoadBalancerWaitForWorkRequest(ctx context.Context, wId *string, action oci_network_load_balancer.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_network_load_balancer.NetworkLoadBalancerClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "network_load_balancer")
 	retryPolicy.ShouldRetryOperation = networkLoadBalancerWorkRequestShouldRetryFunc(timeout)
@@ -383,7 +384,7 @@ func networkLoadBalancerWaitForWorkRequest(wId *string, action oci_network_load_
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_network_load_balancer.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -412,17 +413,17 @@ func networkLoadBalancerWaitForWorkRequest(wId *string, action oci_network_load_
 
 	var workRequestErr error
 	if response.WorkRequest.Status == oci_network_load_balancer.OperationStatusFailed {
-		errorMessage := getErrorFromNetworkLoadBalancerWorkRequest(response.WorkRequest, client, retryPolicy)
+		errorMessage := getErrorFromNetworkLoadBalancerWorkRequest(ctx, response.WorkRequest, client, retryPolicy)
 		workRequestErr = fmt.Errorf("work request did not succeed, workId: %s, action: %s. Message: %s", *wId, action, errorMessage)
 	}
 
 	return identifier, workRequestErr
 }
 
-func getErrorFromNetworkLoadBalancerWorkRequest(wr oci_network_load_balancer.WorkRequest,
+func getErrorFromNetworkLoadBalancerWorkRequest(ctx context.Context, wr oci_network_load_balancer.WorkRequest,
 	client *oci_network_load_balancer.NetworkLoadBalancerClient, retryPolicy *oci_common.RetryPolicy) string {
 	// Fetch the list of work request errors
-	response, err := client.ListWorkRequestErrors(context.Background(),
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_network_load_balancer.ListWorkRequestErrorsRequest{
 			WorkRequestId: wr.Id,
 			CompartmentId: wr.CompartmentId,
@@ -443,7 +444,7 @@ func getErrorFromNetworkLoadBalancerWorkRequest(wr oci_network_load_balancer.Wor
 	return errorMessage
 }
 
-func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) Get() error {
+func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) Get(ctx context.Context) error {
 	request := oci_network_load_balancer.GetNetworkLoadBalancerRequest{}
 
 	tmp := s.D.Id()
@@ -451,7 +452,7 @@ func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.GetNetworkLoadBalancer(context.Background(), request)
+	response, err := s.Client.GetNetworkLoadBalancer(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -460,11 +461,11 @@ func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) Get() error {
 	return nil
 }
 
-func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) Update() error {
+func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -472,7 +473,7 @@ func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) Update() error {
 	}
 
 	if s.D.HasChange("network_security_group_ids") {
-		err := s.updateNetworkSecurityGroups()
+		err := s.updateNetworkSecurityGroups(ctx)
 		if err != nil {
 			return fmt.Errorf("unable to update 'network_security_group_ids', error: %v", err)
 		}
@@ -509,16 +510,16 @@ func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) Update() error {
 	}
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.UpdateNetworkLoadBalancer(context.Background(), request)
+	response, err := s.Client.UpdateNetworkLoadBalancer(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getNetworkLoadBalancerFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer"), oci_network_load_balancer.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getNetworkLoadBalancerFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer"), oci_network_load_balancer.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) Delete() error {
+func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) Delete(ctx context.Context) error {
 	request := oci_network_load_balancer.DeleteNetworkLoadBalancerRequest{}
 
 	tmp := s.D.Id()
@@ -526,14 +527,14 @@ func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.DeleteNetworkLoadBalancer(context.Background(), request)
+	response, err := s.Client.DeleteNetworkLoadBalancer(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := networkLoadBalancerWaitForWorkRequest(workId,
+	_, delWorkRequestErr := networkLoadBalancerWaitForWorkRequest(ctx, workId,
 		oci_network_load_balancer.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -707,7 +708,7 @@ func NetworkLoadBalancerReservedIpToMap(obj oci_network_load_balancer.ReservedIp
 	return result
 }
 
-func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) updateNetworkSecurityGroups() error {
+func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) updateNetworkSecurityGroups(ctx context.Context) error {
 	updateNsgIdsRequest := oci_network_load_balancer.UpdateNetworkSecurityGroupsRequest{}
 
 	//@Codegen: Unless explicitly specified by the user, network_security_group_ids will not be supplied as the feature may or may not be supported
@@ -728,16 +729,16 @@ func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) updateNetworkSecuri
 
 	updateNsgIdsRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.UpdateNetworkSecurityGroups(context.Background(), updateNsgIdsRequest)
+	response, err := s.Client.UpdateNetworkSecurityGroups(ctx, updateNsgIdsRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getNetworkLoadBalancerFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer"), oci_network_load_balancer.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getNetworkLoadBalancerFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer"), oci_network_load_balancer.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_network_load_balancer.ChangeNetworkLoadBalancerCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -748,11 +749,11 @@ func (s *NetworkLoadBalancerNetworkLoadBalancerResourceCrud) updateCompartment(c
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer")
 
-	response, err := s.Client.ChangeNetworkLoadBalancerCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeNetworkLoadBalancerCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getNetworkLoadBalancerFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer"), oci_network_load_balancer.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getNetworkLoadBalancerFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_load_balancer"), oci_network_load_balancer.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
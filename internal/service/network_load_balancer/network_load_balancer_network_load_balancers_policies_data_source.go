@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_network_load_balancer "github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
 )
 
 func NetworkLoadBalancerNetworkLoadBalancersPoliciesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readNetworkLoadBalancerNetworkLoadBalancersPolicies,
+		ReadContext: readNetworkLoadBalancerNetworkLoadBalancersPolicies,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"network_load_balancers_policy_collection": {
@@ -42,12 +43,12 @@ func NetworkLoadBalancerNetworkLoadBalancersPoliciesDataSource() *schema.Resourc
 	}
 }
 
-func readNetworkLoadBalancerNetworkLoadBalancersPolicies(d *schema.ResourceData, m interface{}) error {
+func readNetworkLoadBalancerNetworkLoadBalancersPolicies(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerNetworkLoadBalancersPoliciesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type NetworkLoadBalancerNetworkLoadBalancersPoliciesDataSourceCrud struct {
@@ -60,12 +61,12 @@ func (s *NetworkLoadBalancerNetworkLoadBalancersPoliciesDataSourceCrud) VoidStat
 	s.D.SetId("")
 }
 
-func (s *NetworkLoadBalancerNetworkLoadBalancersPoliciesDataSourceCrud) Get() error {
+func (s *NetworkLoadBalancerNetworkLoadBalancersPoliciesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_network_load_balancer.ListNetworkLoadBalancersPoliciesRequest{}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_load_balancer")
 
-	response, err := s.Client.ListNetworkLoadBalancersPolicies(context.Background(), request)
+	response, err := s.Client.ListNetworkLoadBalancersPolicies(ctx, request)
 	if err != nil {
 		return err
 	}
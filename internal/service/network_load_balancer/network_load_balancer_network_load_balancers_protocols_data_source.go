@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_network_load_balancer "github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
 )
 
 func NetworkLoadBalancerNetworkLoadBalancersProtocolsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readNetworkLoadBalancerNetworkLoadBalancersProtocols,
+		ReadContext: readNetworkLoadBalancerNetworkLoadBalancersProtocols,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"network_load_balancers_protocol_collection": {
@@ -42,12 +43,12 @@ func NetworkLoadBalancerNetworkLoadBalancersProtocolsDataSource() *schema.Resour
 	}
 }
 
-func readNetworkLoadBalancerNetworkLoadBalancersProtocols(d *schema.ResourceData, m interface{}) error {
+func readNetworkLoadBalancerNetworkLoadBalancersProtocols(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NetworkLoadBalancerNetworkLoadBalancersProtocolsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type NetworkLoadBalancerNetworkLoadBalancersProtocolsDataSourceCrud struct {
@@ -60,12 +61,12 @@ func (s *NetworkLoadBalancerNetworkLoadBalancersProtocolsDataSourceCrud) VoidSta
 	s.D.SetId("")
 }
 
-func (s *NetworkLoadBalancerNetworkLoadBalancersProtocolsDataSourceCrud) Get() error {
+func (s *NetworkLoadBalancerNetworkLoadBalancersProtocolsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_network_load_balancer.ListNetworkLoadBalancersProtocolsRequest{}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "network_load_balancer")
 
-	response, err := s.Client.ListNetworkLoadBalancersProtocols(context.Background(), request)
+	response, err := s.Client.ListNetworkLoadBalancersProtocols(ctx, request)
 	if err != nil {
 		return err
 	}
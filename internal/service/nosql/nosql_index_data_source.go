@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_nosql "github.com/oracle/oci-go-sdk/v65/nosql"
 )
@@ -30,12 +31,12 @@ func NosqlIndexDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(NosqlIndexResource(), fieldMap, readSingularNosqlIndex)
 }
 
-func readSingularNosqlIndex(d *schema.ResourceData, m interface{}) error {
+func readSingularNosqlIndex(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NosqlIndexDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NosqlClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type NosqlIndexDataSourceCrud struct {
@@ -48,7 +49,7 @@ func (s *NosqlIndexDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *NosqlIndexDataSourceCrud) Get() error {
+func (s *NosqlIndexDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_nosql.GetIndexRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -68,7 +69,7 @@ func (s *NosqlIndexDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "nosql")
 
-	response, err := s.Client.GetIndex(context.Background(), request)
+	response, err := s.Client.GetIndex(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_nosql "github.com/oracle/oci-go-sdk/v65/nosql"
 )
 
 func NosqlIndexesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readNosqlIndexes,
+		ReadContext: readNosqlIndexes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,12 +44,12 @@ func NosqlIndexesDataSource() *schema.Resource {
 	}
 }
 
-func readNosqlIndexes(d *schema.ResourceData, m interface{}) error {
+func readNosqlIndexes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NosqlIndexesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NosqlClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type NosqlIndexesDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *NosqlIndexesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *NosqlIndexesDataSourceCrud) Get() error {
+func (s *NosqlIndexesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_nosql.ListIndexesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -85,7 +86,7 @@ func (s *NosqlIndexesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "nosql")
 
-	response, err := s.Client.ListIndexes(context.Background(), request)
+	response, err := s.Client.ListIndexes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_nosql "github.com/oracle/oci-go-sdk/v65/nosql"
 )
@@ -26,12 +27,12 @@ func NosqlTableDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(NosqlTableResource(), fieldMap, readSingularNosqlTable)
 }
 
-func readSingularNosqlTable(d *schema.ResourceData, m interface{}) error {
+func readSingularNosqlTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NosqlTableDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NosqlClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type NosqlTableDataSourceCrud struct {
@@ -44,7 +45,7 @@ func (s *NosqlTableDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *NosqlTableDataSourceCrud) Get() error {
+func (s *NosqlTableDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_nosql.GetTableRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -59,7 +60,7 @@ func (s *NosqlTableDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "nosql")
 
-	response, err := s.Client.GetTable(context.Background(), request)
+	response, err := s.Client.GetTable(ctx, request)
 	if err != nil {
 		return err
 	}
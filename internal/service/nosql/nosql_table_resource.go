@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -25,11 +26,11 @@ func NosqlTableResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createNosqlTable,
-		Read:     readNosqlTable,
-		Update:   updateNosqlTable,
-		Delete:   deleteNosqlTable,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createNosqlTable,
+		ReadContext:   readNosqlTable,
+		UpdateContext: updateNosqlTable,
+		DeleteContext: deleteNosqlTable,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -224,37 +225,37 @@ func NosqlTableResource() *schema.Resource {
 	}
 }
 
-func createNosqlTable(d *schema.ResourceData, m interface{}) error {
+func createNosqlTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NosqlTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NosqlClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readNosqlTable(d *schema.ResourceData, m interface{}) error {
+func readNosqlTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NosqlTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NosqlClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateNosqlTable(d *schema.ResourceData, m interface{}) error {
+func updateNosqlTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NosqlTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NosqlClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteNosqlTable(d *schema.ResourceData, m interface{}) error {
+func deleteNosqlTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NosqlTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NosqlClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type NosqlTableResourceCrud struct {
@@ -292,7 +293,7 @@ func (s *NosqlTableResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *NosqlTableResourceCrud) Create() error {
+func (s *NosqlTableResourceCrud) Create(ctx context.Context) error {
 	request := oci_nosql.CreateTableRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -345,26 +346,26 @@ func (s *NosqlTableResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "nosql")
 
-	response, err := s.Client.CreateTable(context.Background(), request)
+	response, err := s.Client.CreateTable(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getTableFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "nosql"), oci_nosql.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getTableFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "nosql"), oci_nosql.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *NosqlTableResourceCrud) getTableFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *NosqlTableResourceCrud) getTableFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_nosql.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	tableId, err := tableWaitForWorkRequest(workId, "TABLE",
+	tableId, err := tableWaitForWorkRequest(ctx, workId, "TABLE",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, tableId)
-		_, cancelErr := s.Client.DeleteWorkRequest(context.Background(),
+		_, cancelErr := s.Client.DeleteWorkRequest(ctx,
 			oci_nosql.DeleteWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -383,7 +384,7 @@ func (s *NosqlTableResourceCrud) getTableFromWorkRequest(workId *string, retryPo
 	}
 	s.D.SetId(*tableId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func tableWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -409,7 +410,7 @@ func tableWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_co
 	}
 }
 
-func tableWaitForWorkRequest(wId *string, entityType string, action oci_nosql.WorkRequestResourceActionTypeEnum,
+func tableWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_nosql.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_nosql.NosqlClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "nosql")
 	retryPolicy.ShouldRetryOperation = tableWorkRequestShouldRetryFunc(timeout)
@@ -428,7 +429,7 @@ func tableWaitForWorkRequest(wId *string, entityType string, action oci_nosql.Wo
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_nosql.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -456,14 +457,14 @@ func tableWaitForWorkRequest(wId *string, entityType string, action oci_nosql.Wo
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.WorkRequest.Status == oci_nosql.WorkRequestStatusFailed || response.WorkRequest.Status == oci_nosql.WorkRequestStatusCanceled {
-		return nil, getErrorFromNosqlTableWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromNosqlTableWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromNosqlTableWorkRequest(client *oci_nosql.NosqlClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_nosql.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromNosqlTableWorkRequest(ctx context.Context, client *oci_nosql.NosqlClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_nosql.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_nosql.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -485,7 +486,7 @@ func getErrorFromNosqlTableWorkRequest(client *oci_nosql.NosqlClient, workId *st
 	return workRequestErr
 }
 
-func (s *NosqlTableResourceCrud) Get() error {
+func (s *NosqlTableResourceCrud) Get(ctx context.Context) error {
 	request := oci_nosql.GetTableRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -503,7 +504,7 @@ func (s *NosqlTableResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "nosql")
 
-	response, err := s.Client.GetTable(context.Background(), request)
+	response, err := s.Client.GetTable(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -512,13 +513,13 @@ func (s *NosqlTableResourceCrud) Get() error {
 	return nil
 }
 
-func (s *NosqlTableResourceCrud) Update() error {
+func (s *NosqlTableResourceCrud) Update(ctx context.Context) error {
 	if _, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
 			fromCompartmentId := oldRaw.(string)
 			toCompartmentId := newRaw.(string)
-			err := s.updateCompartment(fromCompartmentId, toCompartmentId)
+			err := s.updateCompartment(ctx, fromCompartmentId, toCompartmentId)
 			if err != nil {
 				return err
 			}
@@ -527,7 +528,7 @@ func (s *NosqlTableResourceCrud) Update() error {
 
 	defer func() {
 		// get latest state of the instance
-		err := s.Get()
+		err := s.Get(ctx)
 		if err != nil {
 			log.Printf("[ERROR] unable to invoke GET() after UPDATE '%v'", err)
 		}
@@ -552,7 +553,7 @@ func (s *NosqlTableResourceCrud) Update() error {
 	if ddlStatement, ok := s.D.GetOkExists("ddl_statement"); ok && s.D.HasChange("ddl_statement") {
 		tmp := ddlStatement.(string)
 		request.DdlStatement = &tmp
-		err := sendUpdateRequest(s, request)
+		err := sendUpdateRequest(ctx, s, request)
 		if err != nil {
 			return err
 		}
@@ -565,7 +566,7 @@ func (s *NosqlTableResourceCrud) Update() error {
 			return err
 		}
 		request.DefinedTags = convertedDefinedTags
-		err = sendUpdateRequest(s, request)
+		err = sendUpdateRequest(ctx, s, request)
 		if err != nil {
 			return err
 		}
@@ -574,7 +575,7 @@ func (s *NosqlTableResourceCrud) Update() error {
 
 	if freeformTags, ok := s.D.GetOkExists("freeform_tags"); ok && s.D.HasChange("freeform_tags") {
 		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
-		err := sendUpdateRequest(s, request)
+		err := sendUpdateRequest(ctx, s, request)
 		if err != nil {
 			return err
 		}
@@ -590,7 +591,7 @@ func (s *NosqlTableResourceCrud) Update() error {
 			}
 			request.TableLimits = &tmp
 		}
-		err := sendUpdateRequest(s, request)
+		err := sendUpdateRequest(ctx, s, request)
 		if err != nil {
 			return err
 		}
@@ -600,20 +601,20 @@ func (s *NosqlTableResourceCrud) Update() error {
 	return nil
 }
 
-func sendUpdateRequest(s *NosqlTableResourceCrud, request oci_nosql.UpdateTableRequest) error {
-	response, err := s.Client.UpdateTable(context.Background(), request)
+func sendUpdateRequest(ctx context.Context, s *NosqlTableResourceCrud, request oci_nosql.UpdateTableRequest) error {
+	response, err := s.Client.UpdateTable(ctx, request)
 	if err != nil {
 		return err
 	}
 	workId := response.OpcWorkRequestId
-	err = s.getTableFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "nosql"), oci_nosql.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	err = s.getTableFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "nosql"), oci_nosql.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (s *NosqlTableResourceCrud) Delete() error {
+func (s *NosqlTableResourceCrud) Delete(ctx context.Context) error {
 	request := oci_nosql.DeleteTableRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -636,14 +637,14 @@ func (s *NosqlTableResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "nosql")
 
-	response, err := s.Client.DeleteTable(context.Background(), request)
+	response, err := s.Client.DeleteTable(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := tableWaitForWorkRequest(workId, "TABLE",
+	_, delWorkRequestErr := tableWaitForWorkRequest(ctx, workId, "TABLE",
 		oci_nosql.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -883,7 +884,7 @@ func TableSummaryToMap(obj oci_nosql.TableSummary) map[string]interface{} {
 	return result
 }
 
-func (s *NosqlTableResourceCrud) updateCompartment(fromCompartmentId, toCompartmentId string) error {
+func (s *NosqlTableResourceCrud) updateCompartment(ctx context.Context, fromCompartmentId, toCompartmentId string) error {
 	changeCompartmentRequest := oci_nosql.ChangeTableCompartmentRequest{}
 
 	changeCompartmentRequest.FromCompartmentId = &fromCompartmentId
@@ -900,11 +901,11 @@ func (s *NosqlTableResourceCrud) updateCompartment(fromCompartmentId, toCompartm
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "nosql")
 
-	response, err := s.Client.ChangeTableCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeTableCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getTableFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "nosql"), oci_nosql.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getTableFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "nosql"), oci_nosql.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -113,7 +113,7 @@ func validateLowerCaseKeysInMetadata(raw interface{}, fieldName string) ([]strin
 	return nil, errors
 }
 
-func singlePartUpload(multipartUploadData MultipartUploadData) (string, error) {
+func singlePartUpload(ctx context.Context, multipartUploadData MultipartUploadData) (string, error) {
 
 	sourcePath := *multipartUploadData.SourcePath
 	sourceInfo := *multipartUploadData.SourceInfo
@@ -143,7 +143,7 @@ func singlePartUpload(multipartUploadData MultipartUploadData) (string, error) {
 	}
 	putObjectRequest.RequestMetadata.RetryPolicy = multipartUploadData.RequestMetadata.RetryPolicy
 
-	_, err = multipartUploadData.ObjectStorageClient.PutObject(context.Background(), *putObjectRequest)
+	_, err = multipartUploadData.ObjectStorageClient.PutObject(ctx, *putObjectRequest)
 	if err != nil {
 		return "", err
 	}
@@ -179,18 +179,18 @@ func PutObjectStorageTierEnumFromString(s string) oci_object_storage.PutObjectSt
 	}
 }
 
-func MultiPartUpload(multipartUploadData MultipartUploadData) (string, error) {
+func MultiPartUpload(ctx context.Context, multipartUploadData MultipartUploadData) (string, error) {
 
 	sourceInfo := *multipartUploadData.SourceInfo
 
 	if sourceInfo.Size() > DefaultFilePartSize {
-		return multiPartUploadImpl(multipartUploadData)
+		return multiPartUploadImpl(ctx, multipartUploadData)
 	}
 
-	return singlePartUpload(multipartUploadData)
+	return singlePartUpload(ctx, multipartUploadData)
 }
 
-func multiPartUploadImpl(multipartUploadData MultipartUploadData) (string, error) {
+func multiPartUploadImpl(ctx context.Context, multipartUploadData MultipartUploadData) (string, error) {
 
 	multipartUploadRequest := &oci_object_storage.CreateMultipartUploadRequest{
 		NamespaceName:   multipartUploadData.NamespaceName,
@@ -221,7 +221,7 @@ func multiPartUploadImpl(multipartUploadData MultipartUploadData) (string, error
 		return "", fmt.Errorf("error splitting source file for upload \"%v\": %s", source, err)
 	}
 
-	multipartUploadResponse, err := client.CreateMultipartUpload(context.Background(), *multipartUploadRequest)
+	multipartUploadResponse, err := client.CreateMultipartUpload(ctx, *multipartUploadRequest)
 	if err != nil {
 		return "", fmt.Errorf("error creating object in the Oracle cloud \"%v\": %s", source, err)
 	}
@@ -282,7 +282,7 @@ func multiPartUploadImpl(multipartUploadData MultipartUploadData) (string, error
 			RequestMetadata:    multipartUploadRequest.RequestMetadata,
 		}
 
-		_, err := client.AbortMultipartUpload(context.Background(), abortMultipartUploadRequest)
+		_, err := client.AbortMultipartUpload(ctx, abortMultipartUploadRequest)
 
 		if err != nil {
 			log.Println("[WARN] Aborting the multi part upload failed")
@@ -301,7 +301,7 @@ func multiPartUploadImpl(multipartUploadData MultipartUploadData) (string, error
 	}
 	commitMultipartUploadRequest.PartsToCommit = commitMultipartUploadPartDetails
 
-	_, err = client.CommitMultipartUpload(context.Background(), commitMultipartUploadRequest)
+	_, err = client.CommitMultipartUpload(ctx, commitMultipartUploadRequest)
 	if err != nil {
 		return "", fmt.Errorf("failed to commit multi part upload of \"%v\" to the service: %s", source, err)
 	}
@@ -433,7 +433,7 @@ func (s *ObjectStorageObjectResourceCrud) createSourceRegionClient(region string
 	return nil
 }
 
-func copyObjectWaitForWorkRequest(wId *string, entityType string, timeout time.Duration, disableFoundRetries bool, client *oci_object_storage.ObjectStorageClient) error {
+func copyObjectWaitForWorkRequest(ctx context.Context, wId *string, entityType string, timeout time.Duration, disableFoundRetries bool, client *oci_object_storage.ObjectStorageClient) error {
 
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "object_storage")
 	retryPolicy.ShouldRetryOperation = objectStorageWorkRequestShouldRetryFunc(timeout)
@@ -453,7 +453,7 @@ func copyObjectWaitForWorkRequest(wId *string, entityType string, timeout time.D
 			getWorkRequestRequest := oci_object_storage.GetWorkRequestRequest{}
 			getWorkRequestRequest.WorkRequestId = wId
 			getWorkRequestRequest.RequestMetadata.RetryPolicy = retryPolicy
-			workRequestResponse, err := client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+			workRequestResponse, err := client.GetWorkRequest(ctx, getWorkRequestRequest)
 			wr := &workRequestResponse.WorkRequest
 			return workRequestResponse, string(wr.Status), err
 		},
@@ -472,7 +472,7 @@ func copyObjectWaitForWorkRequest(wId *string, entityType string, timeout time.D
 
 	wr := wrr.(oci_object_storage.GetWorkRequestResponse).WorkRequest
 	if wr.Status == oci_object_storage.WorkRequestStatusFailed {
-		errorMessage, _ := getObjectStorageErrorFromWorkRequest(wId, client, disableFoundRetries)
+		errorMessage, _ := getObjectStorageErrorFromWorkRequest(ctx, wId, client, disableFoundRetries)
 		return fmt.Errorf("work request did not succeed, workId: %s, entity: %s. Message: %s", *wId, entityType, errorMessage)
 	}
 
@@ -503,11 +503,11 @@ func objectStorageWorkRequestShouldRetryFunc(timeout time.Duration) func(respons
 	}
 }
 
-func getObjectStorageErrorFromWorkRequest(workRequestId *string, client *oci_object_storage.ObjectStorageClient, disableFoundAutoRetries bool) (string, error) {
+func getObjectStorageErrorFromWorkRequest(ctx context.Context, workRequestId *string, client *oci_object_storage.ObjectStorageClient, disableFoundAutoRetries bool) (string, error) {
 	req := oci_object_storage.ListWorkRequestErrorsRequest{}
 	req.WorkRequestId = workRequestId
 	req.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(disableFoundAutoRetries, "object_storage")
-	res, err := client.ListWorkRequestErrors(context.Background(), req)
+	res, err := client.ListWorkRequestErrors(ctx, req)
 
 	if err != nil {
 		return "", err
@@ -522,7 +522,7 @@ func getObjectStorageErrorFromWorkRequest(workRequestId *string, client *oci_obj
 	return errorMessage, nil
 }
 
-func DeleteAllObjectVersions(client *oci_object_storage.ObjectStorageClient, bucket string, namespace string, prefix string) error {
+func DeleteAllObjectVersions(ctx context.Context, client *oci_object_storage.ObjectStorageClient, bucket string, namespace string, prefix string) error {
 	request := oci_object_storage.ListObjectVersionsRequest{}
 
 	request.BucketName = &bucket
@@ -532,7 +532,7 @@ func DeleteAllObjectVersions(client *oci_object_storage.ObjectStorageClient, buc
 		request.Prefix = &prefix
 	}
 
-	response, err := client.ListObjectVersions(context.Background(), request)
+	response, err := client.ListObjectVersions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -542,7 +542,7 @@ func DeleteAllObjectVersions(client *oci_object_storage.ObjectStorageClient, buc
 	for request.Page != nil {
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "object_storage")
 
-		listResponse, err := client.ListObjectVersions(context.Background(), request)
+		listResponse, err := client.ListObjectVersions(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -561,7 +561,7 @@ func DeleteAllObjectVersions(client *oci_object_storage.ObjectStorageClient, buc
 
 		deleteObjectVersionRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "object_storage")
 
-		_, err := client.DeleteObject(context.Background(), deleteObjectVersionRequest)
+		_, err := client.DeleteObject(ctx, deleteObjectVersionRequest)
 		if err != nil {
 			errors = append(errors, err.Error())
 		}
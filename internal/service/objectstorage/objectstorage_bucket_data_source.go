@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v65/objectstorage"
 )
@@ -27,12 +28,12 @@ func ObjectStorageBucketDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ObjectStorageBucketResource(), fieldMap, readSingularObjectStorageBucket)
 }
 
-func readSingularObjectStorageBucket(d *schema.ResourceData, m interface{}) error {
+func readSingularObjectStorageBucket(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageBucketDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ObjectStorageBucketDataSourceCrud struct {
@@ -47,7 +48,7 @@ func (s *ObjectStorageBucketDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ObjectStorageBucketDataSourceCrud) Get() error {
+func (s *ObjectStorageBucketDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_object_storage.GetBucketRequest{}
 	listRetentionRulesRequest := oci_object_storage.ListRetentionRulesRequest{}
 
@@ -66,7 +67,7 @@ func (s *ObjectStorageBucketDataSourceCrud) Get() error {
 	request.Fields = oci_object_storage.GetGetBucketFieldsEnumValues()
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "object_storage")
 
-	response, err := s.Client.GetBucket(context.Background(), request)
+	response, err := s.Client.GetBucket(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -75,7 +76,7 @@ func (s *ObjectStorageBucketDataSourceCrud) Get() error {
 
 	// using list call as summary and get response is same for a retention rule
 	listRetentionRulesRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
-	listRetentionRulesResponse, e := s.Client.ListRetentionRules(context.Background(), listRetentionRulesRequest)
+	listRetentionRulesResponse, e := s.Client.ListRetentionRules(ctx, listRetentionRulesRequest)
 	if e != nil {
 		return e
 	}
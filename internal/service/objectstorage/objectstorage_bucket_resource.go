@@ -19,6 +19,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v65/objectstorage"
@@ -29,11 +30,11 @@ func ObjectStorageBucketResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createObjectStorageBucket,
-		Read:     readObjectStorageBucket,
-		Update:   updateObjectStorageBucket,
-		Delete:   deleteObjectStorageBucket,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createObjectStorageBucket,
+		ReadContext:   readObjectStorageBucket,
+		UpdateContext: updateObjectStorageBucket,
+		DeleteContext: deleteObjectStorageBucket,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -200,37 +201,37 @@ func ObjectStorageBucketResource() *schema.Resource {
 	}
 }
 
-func createObjectStorageBucket(d *schema.ResourceData, m interface{}) error {
+func createObjectStorageBucket(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageBucketResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readObjectStorageBucket(d *schema.ResourceData, m interface{}) error {
+func readObjectStorageBucket(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageBucketResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateObjectStorageBucket(d *schema.ResourceData, m interface{}) error {
+func updateObjectStorageBucket(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageBucketResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteObjectStorageBucket(d *schema.ResourceData, m interface{}) error {
+func deleteObjectStorageBucket(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageBucketResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type ObjectStorageBucketResourceCrud struct {
@@ -264,7 +265,7 @@ func (s *ObjectStorageBucketResourceCrud) mapToDuration(fieldKeyFormat string) (
 	return result, nil
 }
 
-func (s *ObjectStorageBucketResourceCrud) Create() error {
+func (s *ObjectStorageBucketResourceCrud) Create(ctx context.Context) error {
 	request := oci_object_storage.CreateBucketRequest{}
 
 	if accessType, ok := s.D.GetOkExists("access_type"); ok {
@@ -326,14 +327,14 @@ func (s *ObjectStorageBucketResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.CreateBucket(context.Background(), request)
+	response, err := s.Client.CreateBucket(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.Bucket
 
-	if err := s.handleRetentionRules(); err != nil {
+	if err := s.handleRetentionRules(ctx); err != nil {
 		log.Printf("[ERROR] Error in retention rule Create: '%v'", err)
 		return err
 	}
@@ -341,7 +342,7 @@ func (s *ObjectStorageBucketResourceCrud) Create() error {
 	return nil
 }
 
-func (s *ObjectStorageBucketResourceCrud) Get() error {
+func (s *ObjectStorageBucketResourceCrud) Get(ctx context.Context) error {
 	request := oci_object_storage.GetBucketRequest{}
 	listRetentionRulesRequest := oci_object_storage.ListRetentionRulesRequest{}
 
@@ -370,7 +371,7 @@ func (s *ObjectStorageBucketResourceCrud) Get() error {
 	request.Fields = oci_object_storage.GetGetBucketFieldsEnumValues()
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.GetBucket(context.Background(), request)
+	response, err := s.Client.GetBucket(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -379,7 +380,7 @@ func (s *ObjectStorageBucketResourceCrud) Get() error {
 
 	// using list call as summary and get response is same for a retention rule
 	listRetentionRulesRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
-	listRetentionRulesResponse, e := s.Client.ListRetentionRules(context.Background(), listRetentionRulesRequest)
+	listRetentionRulesResponse, e := s.Client.ListRetentionRules(ctx, listRetentionRulesRequest)
 
 	if e != nil {
 		return e
@@ -407,7 +408,7 @@ func listResponseToRetentionRuleRes(listRetentionRulesResponse oci_object_storag
 	return RetentionRuleRes
 }
 
-func (s *ObjectStorageBucketResourceCrud) Update() error {
+func (s *ObjectStorageBucketResourceCrud) Update(ctx context.Context) error {
 	request := oci_object_storage.UpdateBucketRequest{}
 
 	if accessType, ok := s.D.GetOkExists("access_type"); ok {
@@ -473,14 +474,14 @@ func (s *ObjectStorageBucketResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.UpdateBucket(context.Background(), request)
+	response, err := s.Client.UpdateBucket(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	s.Res = &response.Bucket
 
-	if err := s.handleRetentionRules(); err != nil {
+	if err := s.handleRetentionRules(ctx); err != nil {
 		log.Printf("[ERROR] Error in retention rule handling: '%v'", err)
 		return err
 	}
@@ -488,7 +489,7 @@ func (s *ObjectStorageBucketResourceCrud) Update() error {
 	return nil
 }
 
-func (s *ObjectStorageBucketResourceCrud) Delete() error {
+func (s *ObjectStorageBucketResourceCrud) Delete(ctx context.Context) error {
 	request := oci_object_storage.DeleteBucketRequest{}
 
 	if name, ok := s.D.GetOkExists("name"); ok {
@@ -503,7 +504,7 @@ func (s *ObjectStorageBucketResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	_, err := s.Client.DeleteBucket(context.Background(), request)
+	_, err := s.Client.DeleteBucket(ctx, request)
 	return err
 }
 
@@ -609,7 +610,7 @@ func retentionRulesResToSet(retentionRuleRes []*oci_object_storage.RetentionRule
 	}
 }
 
-func (s *ObjectStorageBucketResourceCrud) createRetentionRulesHelper(newRetentionRulesToCreate []interface{}) error {
+func (s *ObjectStorageBucketResourceCrud) createRetentionRulesHelper(ctx context.Context, newRetentionRulesToCreate []interface{}) error {
 	if len(newRetentionRulesToCreate) == 0 {
 		return nil
 	}
@@ -642,7 +643,7 @@ func (s *ObjectStorageBucketResourceCrud) createRetentionRulesHelper(newRetentio
 		createRequest.TimeRuleLocked = item.TimeRuleLocked
 		createRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-		createResponse, err := s.Client.CreateRetentionRule(context.Background(), createRequest)
+		createResponse, err := s.Client.CreateRetentionRule(ctx, createRequest)
 		if err != nil {
 			log.Printf("[ERROR] Failed to Create the retention rule '%s' : %v", *createRequest.DisplayName, err)
 			s.RetentionRuleRes = responseList
@@ -654,10 +655,10 @@ func (s *ObjectStorageBucketResourceCrud) createRetentionRulesHelper(newRetentio
 	return nil
 }
 
-func (s *ObjectStorageBucketResourceCrud) handleRetentionRules() error {
+func (s *ObjectStorageBucketResourceCrud) handleRetentionRules(ctx context.Context) error {
 
 	defer func() {
-		if err := s.Get(); err != nil {
+		if err := s.Get(ctx); err != nil {
 			log.Printf("[ERROR] unable to invoke Get() '%v'", err)
 		}
 		if err := s.SetData(); err != nil {
@@ -672,15 +673,15 @@ func (s *ObjectStorageBucketResourceCrud) handleRetentionRules() error {
 		return err
 	}
 
-	if err := s.createRetentionRulesHelper(newRetentionRulesToCreate); err != nil {
+	if err := s.createRetentionRulesHelper(ctx, newRetentionRulesToCreate); err != nil {
 		return err
 	}
 
-	if err := s.updateRetentionRulesHelper(retentionRulesToUpdate); err != nil {
+	if err := s.updateRetentionRulesHelper(ctx, retentionRulesToUpdate); err != nil {
 		return err
 	}
 
-	if err := s.deleteRetentionRulesHelper(oldRetentionRulesToDelete); err != nil {
+	if err := s.deleteRetentionRulesHelper(ctx, oldRetentionRulesToDelete); err != nil {
 		return err
 	}
 
@@ -752,7 +753,7 @@ func (s *ObjectStorageBucketResourceCrud) categorizeRetentionRules() ([]interfac
 	return newRetentionRulesToCreate, retentionRulesToUpdate, oldRetentionRulesToDelete, nil
 }
 
-func (s *ObjectStorageBucketResourceCrud) updateRetentionRulesHelper(retentionRulesToUpdate []interface{}) error {
+func (s *ObjectStorageBucketResourceCrud) updateRetentionRulesHelper(ctx context.Context, retentionRulesToUpdate []interface{}) error {
 	if len(retentionRulesToUpdate) == 0 {
 		return nil
 	}
@@ -786,7 +787,7 @@ func (s *ObjectStorageBucketResourceCrud) updateRetentionRulesHelper(retentionRu
 		updateRequest.TimeRuleLocked = item.TimeRuleLocked
 		updateRequest.RetentionRuleId = item.Id
 
-		updateResponse, err := s.Client.UpdateRetentionRule(context.Background(), updateRequest)
+		updateResponse, err := s.Client.UpdateRetentionRule(ctx, updateRequest)
 		if err != nil {
 			log.Printf("[ERROR] Failed to Update the retention rule '%s' : %v", *updateRequest.DisplayName, err)
 			s.RetentionRuleRes = responseList
@@ -798,7 +799,7 @@ func (s *ObjectStorageBucketResourceCrud) updateRetentionRulesHelper(retentionRu
 	return nil
 }
 
-func (s *ObjectStorageBucketResourceCrud) deleteRetentionRulesHelper(oldRetentionRulesToDelete []interface{}) error {
+func (s *ObjectStorageBucketResourceCrud) deleteRetentionRulesHelper(ctx context.Context, oldRetentionRulesToDelete []interface{}) error {
 	if len(oldRetentionRulesToDelete) == 0 {
 		return nil
 	}
@@ -826,10 +827,10 @@ func (s *ObjectStorageBucketResourceCrud) deleteRetentionRulesHelper(oldRetentio
 	for _, retentionRuleId := range deleteIds {
 		deleteRequest.RetentionRuleId = &retentionRuleId
 
-		_, err := s.Client.DeleteRetentionRule(context.Background(), deleteRequest)
+		_, err := s.Client.DeleteRetentionRule(ctx, deleteRequest)
 		if err != nil {
 			log.Printf("Failed to delete the retention rule '%s' : %v", *deleteRequest.RetentionRuleId, err)
-			e := tfresource.ReadResource(s)
+			e := tfresource.ReadResource(ctx, s)
 			if e != nil {
 				log.Printf("Failed to store the retention rules in the state file: %v", e)
 			}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v65/objectstorage"
 )
 
 func ObjectStorageBucketsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readObjectStorageBuckets,
+		ReadContext: readObjectStorageBuckets,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -35,12 +36,12 @@ func ObjectStorageBucketsDataSource() *schema.Resource {
 	}
 }
 
-func readObjectStorageBuckets(d *schema.ResourceData, m interface{}) error {
+func readObjectStorageBuckets(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageBucketsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ObjectStorageBucketsDataSourceCrud struct {
@@ -53,7 +54,7 @@ func (s *ObjectStorageBucketsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ObjectStorageBucketsDataSourceCrud) Get() error {
+func (s *ObjectStorageBucketsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_object_storage.ListBucketsRequest{
 		// @CODEGEN 6/2018: Need to specify all the fields we want from the BucketSummaries
 		Fields: oci_object_storage.GetListBucketsFieldsEnumValues(),
@@ -71,7 +72,7 @@ func (s *ObjectStorageBucketsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
 
-	response, err := s.Client.ListBuckets(context.Background(), request)
+	response, err := s.Client.ListBuckets(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -80,7 +81,7 @@ func (s *ObjectStorageBucketsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBuckets(context.Background(), request)
+		listResponse, err := s.Client.ListBuckets(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v65/objectstorage"
 )
 
 func ObjectStorageNamespaceDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularObjectStorageNamespace,
+		ReadContext: readSingularObjectStorageNamespace,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -33,12 +34,12 @@ func ObjectStorageNamespaceDataSource() *schema.Resource {
 	}
 }
 
-func readSingularObjectStorageNamespace(d *schema.ResourceData, m interface{}) error {
+func readSingularObjectStorageNamespace(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageNamespaceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ObjectStorageNamespaceDataSourceCrud struct {
@@ -51,7 +52,7 @@ func (s *ObjectStorageNamespaceDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ObjectStorageNamespaceDataSourceCrud) Get() error {
+func (s *ObjectStorageNamespaceDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_object_storage.GetNamespaceRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -61,7 +62,7 @@ func (s *ObjectStorageNamespaceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
 
-	response, err := s.Client.GetNamespace(context.Background(), request)
+	response, err := s.Client.GetNamespace(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v65/objectstorage"
 )
 
 func ObjectStorageNamespaceMetadataDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readNamespaceMetadata2,
+		ReadContext: readNamespaceMetadata2,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"namespace": {
@@ -36,11 +37,11 @@ func ObjectStorageNamespaceMetadataDataSource() *schema.Resource {
 	}
 }
 
-func readNamespaceMetadata2(d *schema.ResourceData, m interface{}) error {
+func readNamespaceMetadata2(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NamespaceMetadataDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type NamespaceMetadataDataSourceCrud struct {
@@ -53,7 +54,7 @@ func (s *NamespaceMetadataDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *NamespaceMetadataDataSourceCrud) Get() error {
+func (s *NamespaceMetadataDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_object_storage.GetNamespaceMetadataRequest{}
 
 	tmp := s.D.Get("namespace").(string)
@@ -61,7 +62,7 @@ func (s *NamespaceMetadataDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
 
-	response, err := s.Client.GetNamespaceMetadata(context.Background(), request)
+	response, err := s.Client.GetNamespaceMetadata(ctx, request)
 	if err != nil {
 		return err
 	}
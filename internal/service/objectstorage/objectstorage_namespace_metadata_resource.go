@@ -9,17 +9,18 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v65/objectstorage"
 )
 
 func ObjectStorageNamespaceMetadataResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   readNamespaceMetadata,
-		Read:     readNamespaceMetadata,
-		Update:   updateNamespaceMetadata,
-		Delete:   deleteNamespaceMetadata,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: readNamespaceMetadata,
+		ReadContext:   readNamespaceMetadata,
+		UpdateContext: updateNamespaceMetadata,
+		DeleteContext: deleteNamespaceMetadata,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"namespace": {
@@ -43,21 +44,21 @@ func ObjectStorageNamespaceMetadataResource() *schema.Resource {
 	}
 }
 
-func readNamespaceMetadata(d *schema.ResourceData, m interface{}) error {
+func readNamespaceMetadata(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NamespaceMetadataResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateNamespaceMetadata(d *schema.ResourceData, m interface{}) error {
+func updateNamespaceMetadata(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &NamespaceMetadataResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteNamespaceMetadata(d *schema.ResourceData, m interface{}) error {
+func deleteNamespaceMetadata(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -72,7 +73,7 @@ func (s *NamespaceMetadataResourceCrud) ID() string {
 	return *s.Res.Namespace
 }
 
-func (s *NamespaceMetadataResourceCrud) Get() error {
+func (s *NamespaceMetadataResourceCrud) Get(ctx context.Context) error {
 	request := oci_object_storage.GetNamespaceMetadataRequest{}
 
 	if namespace, ok := s.D.GetOkExists("namespace"); ok {
@@ -82,7 +83,7 @@ func (s *NamespaceMetadataResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.GetNamespaceMetadata(context.Background(), request)
+	response, err := s.Client.GetNamespaceMetadata(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -92,7 +93,7 @@ func (s *NamespaceMetadataResourceCrud) Get() error {
 	return nil
 }
 
-func (s *NamespaceMetadataResourceCrud) Update() error {
+func (s *NamespaceMetadataResourceCrud) Update(ctx context.Context) error {
 	request := oci_object_storage.UpdateNamespaceMetadataRequest{}
 
 	if defaultS3CompartmentId, ok := s.D.GetOkExists("default_s3compartment_id"); ok {
@@ -112,7 +113,7 @@ func (s *NamespaceMetadataResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.UpdateNamespaceMetadata(context.Background(), request)
+	response, err := s.Client.UpdateNamespaceMetadata(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v65/objectstorage"
 
@@ -17,7 +18,7 @@ import (
 
 func ObjectStorageObjectHeadDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readObjectHead,
+		ReadContext: readObjectHead,
 		Schema: map[string]*schema.Schema{
 			"namespace": {
 				Type:     schema.TypeString,
@@ -59,11 +60,11 @@ func ObjectStorageObjectHeadDataSource() *schema.Resource {
 	}
 }
 
-func readObjectHead(d *schema.ResourceData, m interface{}) error {
+func readObjectHead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectHeadDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ObjectHeadDataSourceCrud struct {
@@ -76,7 +77,7 @@ func (s *ObjectHeadDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ObjectHeadDataSourceCrud) Get() error {
+func (s *ObjectHeadDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_object_storage.HeadObjectRequest{}
 
 	if namespace, ok := s.D.GetOkExists("namespace"); ok {
@@ -96,7 +97,7 @@ func (s *ObjectHeadDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
 
-	response, err := s.Client.HeadObject(context.Background(), request)
+	response, err := s.Client.HeadObject(ctx, request)
 	if err != nil {
 		return err
 	}
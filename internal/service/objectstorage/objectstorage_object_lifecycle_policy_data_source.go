@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v65/objectstorage"
 )
@@ -26,12 +27,12 @@ func ObjectStorageObjectLifecyclePolicyDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ObjectStorageObjectLifecyclePolicyResource(), fieldMap, readSingularObjectStorageObjectLifecyclePolicy)
 }
 
-func readSingularObjectStorageObjectLifecyclePolicy(d *schema.ResourceData, m interface{}) error {
+func readSingularObjectStorageObjectLifecyclePolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageObjectLifecyclePolicyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ObjectStorageObjectLifecyclePolicyDataSourceCrud struct {
@@ -44,7 +45,7 @@ func (s *ObjectStorageObjectLifecyclePolicyDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ObjectStorageObjectLifecyclePolicyDataSourceCrud) Get() error {
+func (s *ObjectStorageObjectLifecyclePolicyDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_object_storage.GetObjectLifecyclePolicyRequest{}
 
 	if bucket, ok := s.D.GetOkExists("bucket"); ok {
@@ -59,7 +60,7 @@ func (s *ObjectStorageObjectLifecyclePolicyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
 
-	response, err := s.Client.GetObjectLifecyclePolicy(context.Background(), request)
+	response, err := s.Client.GetObjectLifecyclePolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -17,6 +17,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -28,11 +29,11 @@ func ObjectStorageObjectLifecyclePolicyResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createObjectStorageObjectLifecyclePolicy,
-		Read:     readObjectStorageObjectLifecyclePolicy,
-		Update:   updateObjectStorageObjectLifecyclePolicy,
-		Delete:   deleteObjectStorageObjectLifecyclePolicy,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createObjectStorageObjectLifecyclePolicy,
+		ReadContext:   readObjectStorageObjectLifecyclePolicy,
+		UpdateContext: updateObjectStorageObjectLifecyclePolicy,
+		DeleteContext: deleteObjectStorageObjectLifecyclePolicy,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"bucket": {
@@ -146,37 +147,37 @@ func ObjectStorageObjectLifecyclePolicyResource() *schema.Resource {
 	}
 }
 
-func createObjectStorageObjectLifecyclePolicy(d *schema.ResourceData, m interface{}) error {
+func createObjectStorageObjectLifecyclePolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageObjectLifecyclePolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readObjectStorageObjectLifecyclePolicy(d *schema.ResourceData, m interface{}) error {
+func readObjectStorageObjectLifecyclePolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageObjectLifecyclePolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateObjectStorageObjectLifecyclePolicy(d *schema.ResourceData, m interface{}) error {
+func updateObjectStorageObjectLifecyclePolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageObjectLifecyclePolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteObjectStorageObjectLifecyclePolicy(d *schema.ResourceData, m interface{}) error {
+func deleteObjectStorageObjectLifecyclePolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageObjectLifecyclePolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type ObjectStorageObjectLifecyclePolicyResourceCrud struct {
@@ -190,7 +191,7 @@ func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) ID() string {
 	return GetObjectLifecyclePolicyCompositeId(s.D.Get("bucket").(string), s.D.Get("namespace").(string))
 }
 
-func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Create() error {
+func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Create(ctx context.Context) error {
 	request := oci_object_storage.PutObjectLifecyclePolicyRequest{}
 
 	if bucket, ok := s.D.GetOkExists("bucket"); ok {
@@ -223,7 +224,7 @@ func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.PutObjectLifecyclePolicy(context.Background(), request)
+	response, err := s.Client.PutObjectLifecyclePolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -232,7 +233,7 @@ func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Create() error {
 	return nil
 }
 
-func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Get() error {
+func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Get(ctx context.Context) error {
 	request := oci_object_storage.GetObjectLifecyclePolicyRequest{}
 
 	if bucket, ok := s.D.GetOkExists("bucket"); ok {
@@ -255,7 +256,7 @@ func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.GetObjectLifecyclePolicy(context.Background(), request)
+	response, err := s.Client.GetObjectLifecyclePolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -264,7 +265,7 @@ func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Update() error {
+func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Update(ctx context.Context) error {
 	request := oci_object_storage.PutObjectLifecyclePolicyRequest{}
 
 	if bucket, ok := s.D.GetOkExists("bucket"); ok {
@@ -296,7 +297,7 @@ func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.PutObjectLifecyclePolicy(context.Background(), request)
+	response, err := s.Client.PutObjectLifecyclePolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -305,7 +306,7 @@ func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Update() error {
 	return nil
 }
 
-func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Delete() error {
+func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Delete(ctx context.Context) error {
 	request := oci_object_storage.DeleteObjectLifecyclePolicyRequest{}
 
 	if bucket, ok := s.D.GetOkExists("bucket"); ok {
@@ -320,7 +321,7 @@ func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	_, err := s.Client.DeleteObjectLifecyclePolicy(context.Background(), request)
+	_, err := s.Client.DeleteObjectLifecyclePolicy(ctx, request)
 	return err
 }
 
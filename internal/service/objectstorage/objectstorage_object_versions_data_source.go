@@ -11,13 +11,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v65/objectstorage"
 )
 
 func ObjectStorageObjectVersionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readObjectStorageObjectVersions,
+		ReadContext: readObjectStorageObjectVersions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"bucket": {
@@ -117,12 +118,12 @@ func ObjectStorageObjectVersionsDataSource() *schema.Resource {
 	}
 }
 
-func readObjectStorageObjectVersions(d *schema.ResourceData, m interface{}) error {
+func readObjectStorageObjectVersions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageObjectVersionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ObjectStorageObjectVersionsDataSourceCrud struct {
@@ -135,7 +136,7 @@ func (s *ObjectStorageObjectVersionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ObjectStorageObjectVersionsDataSourceCrud) Get() error {
+func (s *ObjectStorageObjectVersionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_object_storage.ListObjectVersionsRequest{
 		Fields: oci_object_storage.ListObjectVersionsFieldsEnum(listObjectsFields),
 	}
@@ -181,7 +182,7 @@ func (s *ObjectStorageObjectVersionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
 
-	response, err := s.Client.ListObjectVersions(context.Background(), request)
+	response, err := s.Client.ListObjectVersions(ctx, request)
 	if err != nil {
 		return err
 	}
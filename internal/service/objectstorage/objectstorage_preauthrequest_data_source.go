@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v65/objectstorage"
 )
@@ -30,12 +31,12 @@ func ObjectStoragePreauthenticatedRequestDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ObjectStoragePreauthenticatedRequestResource(), fieldMap, readSingularObjectStoragePreauthenticatedRequest)
 }
 
-func readSingularObjectStoragePreauthenticatedRequest(d *schema.ResourceData, m interface{}) error {
+func readSingularObjectStoragePreauthenticatedRequest(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStoragePreauthenticatedRequestDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ObjectStoragePreauthenticatedRequestDataSourceCrud struct {
@@ -48,7 +49,7 @@ func (s *ObjectStoragePreauthenticatedRequestDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ObjectStoragePreauthenticatedRequestDataSourceCrud) Get() error {
+func (s *ObjectStoragePreauthenticatedRequestDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_object_storage.GetPreauthenticatedRequestRequest{}
 
 	if bucket, ok := s.D.GetOkExists("bucket"); ok {
@@ -68,7 +69,7 @@ func (s *ObjectStoragePreauthenticatedRequestDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
 
-	response, err := s.Client.GetPreauthenticatedRequest(context.Background(), request)
+	response, err := s.Client.GetPreauthenticatedRequest(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -26,10 +27,10 @@ func ObjectStoragePreauthenticatedRequestResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createObjectStoragePreauthenticatedRequest,
-		Read:     readObjectStoragePreauthenticatedRequest,
-		Delete:   deleteObjectStoragePreauthenticatedRequest,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createObjectStoragePreauthenticatedRequest,
+		ReadContext:   readObjectStoragePreauthenticatedRequest,
+		DeleteContext: deleteObjectStoragePreauthenticatedRequest,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"access_type": {
@@ -107,15 +108,15 @@ func ObjectStoragePreauthenticatedRequestResource() *schema.Resource {
 	}
 }
 
-func createObjectStoragePreauthenticatedRequest(d *schema.ResourceData, m interface{}) error {
+func createObjectStoragePreauthenticatedRequest(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStoragePreauthenticatedRequestResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readObjectStoragePreauthenticatedRequest(d *schema.ResourceData, m interface{}) error {
+func readObjectStoragePreauthenticatedRequest(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStoragePreauthenticatedRequestResourceCrud{}
 	// For backward compatibility with CompositeId change
 
@@ -134,16 +135,16 @@ func readObjectStoragePreauthenticatedRequest(d *schema.ResourceData, m interfac
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteObjectStoragePreauthenticatedRequest(d *schema.ResourceData, m interface{}) error {
+func deleteObjectStoragePreauthenticatedRequest(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStoragePreauthenticatedRequestResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type ObjectStoragePreauthenticatedRequestResourceCrud struct {
@@ -157,7 +158,7 @@ func (s *ObjectStoragePreauthenticatedRequestResourceCrud) ID() string {
 	return GetPreauthenticatedRequestCompositeId(s.D.Get("bucket").(string), s.D.Get("namespace").(string), *s.Res.Id)
 }
 
-func (s *ObjectStoragePreauthenticatedRequestResourceCrud) Create() error {
+func (s *ObjectStoragePreauthenticatedRequestResourceCrud) Create(ctx context.Context) error {
 	request := oci_object_storage.CreatePreauthenticatedRequestRequest{}
 
 	if accessType, ok := s.D.GetOkExists("access_type"); ok {
@@ -203,7 +204,7 @@ func (s *ObjectStoragePreauthenticatedRequestResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.CreatePreauthenticatedRequest(context.Background(), request)
+	response, err := s.Client.CreatePreauthenticatedRequest(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -212,7 +213,7 @@ func (s *ObjectStoragePreauthenticatedRequestResourceCrud) Create() error {
 	return nil
 }
 
-func (s *ObjectStoragePreauthenticatedRequestResourceCrud) Get() error {
+func (s *ObjectStoragePreauthenticatedRequestResourceCrud) Get(ctx context.Context) error {
 	request := oci_object_storage.GetPreauthenticatedRequestRequest{}
 
 	bucket, namespace, parId, err := ParsePreauthenticatedRequestCompositeId(s.D.Id())
@@ -226,7 +227,7 @@ func (s *ObjectStoragePreauthenticatedRequestResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.GetPreauthenticatedRequest(context.Background(), request)
+	response, err := s.Client.GetPreauthenticatedRequest(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -246,7 +247,7 @@ func (s *ObjectStoragePreauthenticatedRequestResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ObjectStoragePreauthenticatedRequestResourceCrud) Delete() error {
+func (s *ObjectStoragePreauthenticatedRequestResourceCrud) Delete(ctx context.Context) error {
 	request := oci_object_storage.DeletePreauthenticatedRequestRequest{}
 
 	if bucket, ok := s.D.GetOkExists("bucket"); ok {
@@ -265,7 +266,7 @@ func (s *ObjectStoragePreauthenticatedRequestResourceCrud) Delete() error {
 	}
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	_, err := s.Client.DeletePreauthenticatedRequest(context.Background(), request)
+	_, err := s.Client.DeletePreauthenticatedRequest(ctx, request)
 	return err
 }
 
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v65/objectstorage"
 )
 
 func ObjectStoragePreauthenticatedRequestsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readObjectStoragePreauthenticatedRequests,
+		ReadContext: readObjectStoragePreauthenticatedRequests,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"bucket": {
@@ -39,12 +40,12 @@ func ObjectStoragePreauthenticatedRequestsDataSource() *schema.Resource {
 	}
 }
 
-func readObjectStoragePreauthenticatedRequests(d *schema.ResourceData, m interface{}) error {
+func readObjectStoragePreauthenticatedRequests(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStoragePreauthenticatedRequestsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ObjectStoragePreauthenticatedRequestsDataSourceCrud struct {
@@ -57,7 +58,7 @@ func (s *ObjectStoragePreauthenticatedRequestsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ObjectStoragePreauthenticatedRequestsDataSourceCrud) Get() error {
+func (s *ObjectStoragePreauthenticatedRequestsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_object_storage.ListPreauthenticatedRequestsRequest{}
 
 	if bucket, ok := s.D.GetOkExists("bucket"); ok {
@@ -77,7 +78,7 @@ func (s *ObjectStoragePreauthenticatedRequestsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
 
-	response, err := s.Client.ListPreauthenticatedRequests(context.Background(), request)
+	response, err := s.Client.ListPreauthenticatedRequests(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *ObjectStoragePreauthenticatedRequestsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPreauthenticatedRequests(context.Background(), request)
+		listResponse, err := s.Client.ListPreauthenticatedRequests(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v65/objectstorage"
 )
 
 func ObjectStorageReplicationPoliciesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readObjectStorageReplicationPolicies,
+		ReadContext: readObjectStorageReplicationPolicies,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"bucket": {
@@ -35,12 +36,12 @@ func ObjectStorageReplicationPoliciesDataSource() *schema.Resource {
 	}
 }
 
-func readObjectStorageReplicationPolicies(d *schema.ResourceData, m interface{}) error {
+func readObjectStorageReplicationPolicies(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageReplicationPoliciesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ObjectStorageReplicationPoliciesDataSourceCrud struct {
@@ -53,7 +54,7 @@ func (s *ObjectStorageReplicationPoliciesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ObjectStorageReplicationPoliciesDataSourceCrud) Get() error {
+func (s *ObjectStorageReplicationPoliciesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_object_storage.ListReplicationPoliciesRequest{}
 
 	if bucket, ok := s.D.GetOkExists("bucket"); ok {
@@ -68,7 +69,7 @@ func (s *ObjectStorageReplicationPoliciesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
 
-	response, err := s.Client.ListReplicationPolicies(context.Background(), request)
+	response, err := s.Client.ListReplicationPolicies(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -77,7 +78,7 @@ func (s *ObjectStorageReplicationPoliciesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListReplicationPolicies(context.Background(), request)
+		listResponse, err := s.Client.ListReplicationPolicies(ctx, request)
 		if err != nil {
 			return err
 		}
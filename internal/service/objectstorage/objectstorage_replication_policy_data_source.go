@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v65/objectstorage"
 )
@@ -30,12 +31,12 @@ func ObjectStorageReplicationPolicyDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ObjectStorageReplicationPolicyResource(), fieldMap, readSingularObjectStorageReplicationPolicy)
 }
 
-func readSingularObjectStorageReplicationPolicy(d *schema.ResourceData, m interface{}) error {
+func readSingularObjectStorageReplicationPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageReplicationPolicyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ObjectStorageReplicationPolicyDataSourceCrud struct {
@@ -48,7 +49,7 @@ func (s *ObjectStorageReplicationPolicyDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ObjectStorageReplicationPolicyDataSourceCrud) Get() error {
+func (s *ObjectStorageReplicationPolicyDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_object_storage.GetReplicationPolicyRequest{}
 
 	if bucket, ok := s.D.GetOkExists("bucket"); ok {
@@ -68,7 +69,7 @@ func (s *ObjectStorageReplicationPolicyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
 
-	response, err := s.Client.GetReplicationPolicy(context.Background(), request)
+	response, err := s.Client.GetReplicationPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
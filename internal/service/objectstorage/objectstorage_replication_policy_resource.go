@@ -14,6 +14,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -25,10 +26,10 @@ func ObjectStorageReplicationPolicyResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createObjectStorageReplicationPolicy,
-		Read:     readObjectStorageReplicationPolicy,
-		Delete:   deleteObjectStorageReplicationPolicy,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createObjectStorageReplicationPolicy,
+		ReadContext:   readObjectStorageReplicationPolicy,
+		DeleteContext: deleteObjectStorageReplicationPolicy,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"bucket": {
@@ -89,29 +90,29 @@ func ObjectStorageReplicationPolicyResource() *schema.Resource {
 	}
 }
 
-func createObjectStorageReplicationPolicy(d *schema.ResourceData, m interface{}) error {
+func createObjectStorageReplicationPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageReplicationPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readObjectStorageReplicationPolicy(d *schema.ResourceData, m interface{}) error {
+func readObjectStorageReplicationPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageReplicationPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteObjectStorageReplicationPolicy(d *schema.ResourceData, m interface{}) error {
+func deleteObjectStorageReplicationPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageReplicationPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type ObjectStorageReplicationPolicyResourceCrud struct {
@@ -125,7 +126,7 @@ func (s *ObjectStorageReplicationPolicyResourceCrud) ID() string {
 	return GetReplicationPolicyCompositeId(s.D.Get("bucket").(string), s.D.Get("namespace").(string), *s.Res.Id)
 }
 
-func (s *ObjectStorageReplicationPolicyResourceCrud) Create() error {
+func (s *ObjectStorageReplicationPolicyResourceCrud) Create(ctx context.Context) error {
 	request := oci_object_storage.CreateReplicationPolicyRequest{}
 
 	if bucket, ok := s.D.GetOkExists("bucket"); ok {
@@ -155,7 +156,7 @@ func (s *ObjectStorageReplicationPolicyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.CreateReplicationPolicy(context.Background(), request)
+	response, err := s.Client.CreateReplicationPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -164,7 +165,7 @@ func (s *ObjectStorageReplicationPolicyResourceCrud) Create() error {
 	return nil
 }
 
-func (s *ObjectStorageReplicationPolicyResourceCrud) Get() error {
+func (s *ObjectStorageReplicationPolicyResourceCrud) Get(ctx context.Context) error {
 	request := oci_object_storage.GetReplicationPolicyRequest{}
 
 	bucket, namespace, replicationId, err := ParseReplicationPolicyCompositeId(s.D.Id())
@@ -178,7 +179,7 @@ func (s *ObjectStorageReplicationPolicyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	response, err := s.Client.GetReplicationPolicy(context.Background(), request)
+	response, err := s.Client.GetReplicationPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -187,7 +188,7 @@ func (s *ObjectStorageReplicationPolicyResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ObjectStorageReplicationPolicyResourceCrud) Delete() error {
+func (s *ObjectStorageReplicationPolicyResourceCrud) Delete(ctx context.Context) error {
 	request := oci_object_storage.DeleteReplicationPolicyRequest{}
 
 	bucket, namespace, replicationId, err := ParseReplicationPolicyCompositeId(s.D.Id())
@@ -201,7 +202,7 @@ func (s *ObjectStorageReplicationPolicyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
-	_, err = s.Client.DeleteReplicationPolicy(context.Background(), request)
+	_, err = s.Client.DeleteReplicationPolicy(ctx, request)
 	return err
 }
 
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v65/objectstorage"
 )
 
 func ObjectStorageReplicationSourcesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readObjectStorageReplicationSources,
+		ReadContext: readObjectStorageReplicationSources,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"bucket": {
@@ -55,12 +56,12 @@ func ObjectStorageReplicationSourcesDataSource() *schema.Resource {
 	}
 }
 
-func readObjectStorageReplicationSources(d *schema.ResourceData, m interface{}) error {
+func readObjectStorageReplicationSources(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ObjectStorageReplicationSourcesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ObjectStorageReplicationSourcesDataSourceCrud struct {
@@ -73,7 +74,7 @@ func (s *ObjectStorageReplicationSourcesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ObjectStorageReplicationSourcesDataSourceCrud) Get() error {
+func (s *ObjectStorageReplicationSourcesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_object_storage.ListReplicationSourcesRequest{}
 
 	if bucket, ok := s.D.GetOkExists("bucket"); ok {
@@ -88,7 +89,7 @@ func (s *ObjectStorageReplicationSourcesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "object_storage")
 
-	response, err := s.Client.ListReplicationSources(context.Background(), request)
+	response, err := s.Client.ListReplicationSources(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -97,7 +98,7 @@ func (s *ObjectStorageReplicationSourcesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListReplicationSources(context.Background(), request)
+		listResponse, err := s.Client.ListReplicationSources(ctx, request)
 		if err != nil {
 			return err
 		}
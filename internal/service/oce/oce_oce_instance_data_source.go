@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_oce "github.com/oracle/oci-go-sdk/v65/oce"
 )
@@ -22,12 +23,12 @@ func OceOceInstanceDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OceOceInstanceResource(), fieldMap, readSingularOceOceInstance)
 }
 
-func readSingularOceOceInstance(d *schema.ResourceData, m interface{}) error {
+func readSingularOceOceInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OceOceInstanceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OceInstanceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OceOceInstanceDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *OceOceInstanceDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OceOceInstanceDataSourceCrud) Get() error {
+func (s *OceOceInstanceDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_oce.GetOceInstanceRequest{}
 
 	if oceInstanceId, ok := s.D.GetOkExists("oce_instance_id"); ok {
@@ -50,7 +51,7 @@ func (s *OceOceInstanceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "oce")
 
-	response, err := s.Client.GetOceInstance(context.Background(), request)
+	response, err := s.Client.GetOceInstance(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -30,10 +31,10 @@ func OceOceInstanceResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("20m"),
 			Delete: tfresource.GetTimeoutDuration("20m"),
 		},
-		Create: createOceOceInstance,
-		Read:   readOceOceInstance,
-		Update: updateOceOceInstance,
-		Delete: deleteOceOceInstance,
+		CreateContext: createOceOceInstance,
+		ReadContext:   readOceOceInstance,
+		UpdateContext: updateOceOceInstance,
+		DeleteContext: deleteOceOceInstance,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"admin_email": {
@@ -170,37 +171,37 @@ func OceOceInstanceResource() *schema.Resource {
 	}
 }
 
-func createOceOceInstance(d *schema.ResourceData, m interface{}) error {
+func createOceOceInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OceOceInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OceInstanceClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readOceOceInstance(d *schema.ResourceData, m interface{}) error {
+func readOceOceInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OceOceInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OceInstanceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateOceOceInstance(d *schema.ResourceData, m interface{}) error {
+func updateOceOceInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OceOceInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OceInstanceClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteOceOceInstance(d *schema.ResourceData, m interface{}) error {
+func deleteOceOceInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OceOceInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OceInstanceClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type OceOceInstanceResourceCrud struct {
@@ -238,7 +239,7 @@ func (s *OceOceInstanceResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *OceOceInstanceResourceCrud) Create() error {
+func (s *OceOceInstanceResourceCrud) Create(ctx context.Context) error {
 	request := oci_oce.CreateOceInstanceRequest{}
 
 	if addOnFeatures, ok := s.D.GetOkExists("add_on_features"); ok {
@@ -329,19 +330,19 @@ func (s *OceOceInstanceResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oce")
 
-	response, err := s.Client.CreateOceInstance(context.Background(), request)
+	response, err := s.Client.CreateOceInstance(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getOceInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oce"), oci_oce.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getOceInstanceFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oce"), oci_oce.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *OceOceInstanceResourceCrud) getOceInstanceFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *OceOceInstanceResourceCrud) getOceInstanceFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_oce.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
-	oceInstanceId, err := oceInstanceWaitForWorkRequest(workId, "oce",
+	oceInstanceId, err := oceInstanceWaitForWorkRequest(ctx, workId, "oce",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -355,7 +356,7 @@ func (s *OceOceInstanceResourceCrud) getOceInstanceFromWorkRequest(workId *strin
 
 	s.D.SetId(*oceInstanceId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func oceInstanceWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -381,7 +382,7 @@ func oceInstanceWorkRequestShouldRetryFunc(timeout time.Duration) func(response
 	}
 }
 
-func oceInstanceWaitForWorkRequest(wId *string, entityType string, action oci_oce.WorkRequestResourceActionTypeEnum,
+func oceInstanceWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_oce.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_oce.OceInstanceClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "oce")
 	retryPolicy.ShouldRetryOperation = oceInstanceWorkRequestShouldRetryFunc(timeout)
@@ -400,7 +401,7 @@ func oceInstanceWaitForWorkRequest(wId *string, entityType string, action oci_oc
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_oce.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -429,14 +430,14 @@ func oceInstanceWaitForWorkRequest(wId *string, entityType string, action oci_oc
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_oce.WorkRequestStatusFailed || response.Status == oci_oce.WorkRequestStatusCanceled {
-		return nil, getErrorFromOceOceInstanceWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromOceOceInstanceWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromOceOceInstanceWorkRequest(client *oci_oce.OceInstanceClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_oce.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromOceOceInstanceWorkRequest(ctx context.Context, client *oci_oce.OceInstanceClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_oce.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_oce.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -458,7 +459,7 @@ func getErrorFromOceOceInstanceWorkRequest(client *oci_oce.OceInstanceClient, wo
 	return workRequestErr
 }
 
-func (s *OceOceInstanceResourceCrud) Get() error {
+func (s *OceOceInstanceResourceCrud) Get(ctx context.Context) error {
 	request := oci_oce.GetOceInstanceRequest{}
 
 	tmp := s.D.Id()
@@ -466,7 +467,7 @@ func (s *OceOceInstanceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oce")
 
-	response, err := s.Client.GetOceInstance(context.Background(), request)
+	response, err := s.Client.GetOceInstance(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -475,11 +476,11 @@ func (s *OceOceInstanceResourceCrud) Get() error {
 	return nil
 }
 
-func (s *OceOceInstanceResourceCrud) Update() error {
+func (s *OceOceInstanceResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -535,16 +536,16 @@ func (s *OceOceInstanceResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oce")
 
-	response, err := s.Client.UpdateOceInstance(context.Background(), request)
+	response, err := s.Client.UpdateOceInstance(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getOceInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oce"), oci_oce.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getOceInstanceFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oce"), oci_oce.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *OceOceInstanceResourceCrud) Delete() error {
+func (s *OceOceInstanceResourceCrud) Delete(ctx context.Context) error {
 	request := oci_oce.DeleteOceInstanceRequest{}
 
 	tmp := s.D.Id()
@@ -552,14 +553,14 @@ func (s *OceOceInstanceResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oce")
 
-	response, err := s.Client.DeleteOceInstance(context.Background(), request)
+	response, err := s.Client.DeleteOceInstance(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := oceInstanceWaitForWorkRequest(workId, "oce",
+	_, delWorkRequestErr := oceInstanceWaitForWorkRequest(ctx, workId, "oce",
 		oci_oce.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -646,7 +647,7 @@ func (s *OceOceInstanceResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *OceOceInstanceResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *OceOceInstanceResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_oce.ChangeOceInstanceCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -657,11 +658,11 @@ func (s *OceOceInstanceResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oce")
 
-	response, err := s.Client.ChangeOceInstanceCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeOceInstanceCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getOceInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oce"), oci_oce.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getOceInstanceFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oce"), oci_oce.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
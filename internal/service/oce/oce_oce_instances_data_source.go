@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_oce "github.com/oracle/oci-go-sdk/v65/oce"
 )
 
 func OceOceInstancesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOceOceInstances,
+		ReadContext: readOceOceInstances,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,12 +44,12 @@ func OceOceInstancesDataSource() *schema.Resource {
 	}
 }
 
-func readOceOceInstances(d *schema.ResourceData, m interface{}) error {
+func readOceOceInstances(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OceOceInstancesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OceInstanceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OceOceInstancesDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *OceOceInstancesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OceOceInstancesDataSourceCrud) Get() error {
+func (s *OceOceInstancesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_oce.ListOceInstancesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -85,7 +86,7 @@ func (s *OceOceInstancesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "oce")
 
-	response, err := s.Client.ListOceInstances(context.Background(), request)
+	response, err := s.Client.ListOceInstances(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *OceOceInstancesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListOceInstances(context.Background(), request)
+		listResponse, err := s.Client.ListOceInstances(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package ocvp
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ocvp "github.com/oracle/oci-go-sdk/v65/ocvp"
 
@@ -22,12 +23,12 @@ func OcvpEsxiHostDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OcvpEsxiHostResource(), fieldMap, readSingularOcvpEsxiHost)
 }
 
-func readSingularOcvpEsxiHost(d *schema.ResourceData, m interface{}) error {
+func readSingularOcvpEsxiHost(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OcvpEsxiHostDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EsxiHostClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OcvpEsxiHostDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *OcvpEsxiHostDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OcvpEsxiHostDataSourceCrud) Get() error {
+func (s *OcvpEsxiHostDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_ocvp.GetEsxiHostRequest{}
 
 	if esxiHostId, ok := s.D.GetOkExists("esxi_host_id"); ok {
@@ -50,7 +51,7 @@ func (s *OcvpEsxiHostDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ocvp")
 
-	response, err := s.Client.GetEsxiHost(context.Background(), request)
+	response, err := s.Client.GetEsxiHost(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -27,10 +28,10 @@ func OcvpEsxiHostResource() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Create: tfresource.GetTimeoutDuration("1h"),
 		},
-		Create: createOcvpEsxiHost,
-		Read:   readOcvpEsxiHost,
-		Update: updateOcvpEsxiHost,
-		Delete: deleteOcvpEsxiHost,
+		CreateContext: createOcvpEsxiHost,
+		ReadContext:   readOcvpEsxiHost,
+		UpdateContext: updateOcvpEsxiHost,
+		DeleteContext: deleteOcvpEsxiHost,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"sddc_id": {
@@ -151,40 +152,40 @@ func OcvpEsxiHostResource() *schema.Resource {
 	}
 }
 
-func createOcvpEsxiHost(d *schema.ResourceData, m interface{}) error {
+func createOcvpEsxiHost(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OcvpEsxiHostResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EsxiHostClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).OcvpWorkRequestClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readOcvpEsxiHost(d *schema.ResourceData, m interface{}) error {
+func readOcvpEsxiHost(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OcvpEsxiHostResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EsxiHostClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateOcvpEsxiHost(d *schema.ResourceData, m interface{}) error {
+func updateOcvpEsxiHost(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OcvpEsxiHostResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EsxiHostClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).OcvpWorkRequestClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteOcvpEsxiHost(d *schema.ResourceData, m interface{}) error {
+func deleteOcvpEsxiHost(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OcvpEsxiHostResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EsxiHostClient()
 	sync.DisableNotFoundRetries = true
 	sync.WorkRequestClient = m.(*client.OracleClients).OcvpWorkRequestClient()
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type OcvpEsxiHostResourceCrud struct {
@@ -223,7 +224,7 @@ func (s *OcvpEsxiHostResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *OcvpEsxiHostResourceCrud) Create() error {
+func (s *OcvpEsxiHostResourceCrud) Create(ctx context.Context) error {
 	request := oci_ocvp.CreateEsxiHostRequest{}
 
 	if capacityReservationId, ok := s.D.GetOkExists("capacity_reservation_id"); ok {
@@ -288,20 +289,20 @@ func (s *OcvpEsxiHostResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
 
-	response, err := s.Client.CreateEsxiHost(context.Background(), request)
+	response, err := s.Client.CreateEsxiHost(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getEsxiHostFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp"), oci_ocvp.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getEsxiHostFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp"), oci_ocvp.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *OcvpEsxiHostResourceCrud) getEsxiHostFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *OcvpEsxiHostResourceCrud) getEsxiHostFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_ocvp.ActionTypesEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	esxiHostId, err := esxiHostWaitForWorkRequest(workId, "esxihost",
+	esxiHostId, err := esxiHostWaitForWorkRequest(ctx, workId, "esxihost",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.WorkRequestClient)
 
 	if err != nil {
@@ -309,7 +310,7 @@ func (s *OcvpEsxiHostResourceCrud) getEsxiHostFromWorkRequest(workId *string, re
 	}
 	s.D.SetId(*esxiHostId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func esxiHostWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -335,7 +336,7 @@ func esxiHostWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci
 	}
 }
 
-func esxiHostWaitForWorkRequest(wId *string, entityType string, action oci_ocvp.ActionTypesEnum,
+func esxiHostWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_ocvp.ActionTypesEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_ocvp.WorkRequestClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "ocvp")
 	retryPolicy.ShouldRetryOperation = esxiHostWorkRequestShouldRetryFunc(timeout)
@@ -354,7 +355,7 @@ func esxiHostWaitForWorkRequest(wId *string, entityType string, action oci_ocvp.
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_ocvp.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -383,14 +384,14 @@ func esxiHostWaitForWorkRequest(wId *string, entityType string, action oci_ocvp.
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_ocvp.OperationStatusFailed || response.Status == oci_ocvp.OperationStatusCanceled {
-		return nil, getErrorFromOcvpEsxiHostWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromOcvpEsxiHostWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromOcvpEsxiHostWorkRequest(client *oci_ocvp.WorkRequestClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_ocvp.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromOcvpEsxiHostWorkRequest(ctx context.Context, client *oci_ocvp.WorkRequestClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_ocvp.ActionTypesEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_ocvp.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -412,7 +413,7 @@ func getErrorFromOcvpEsxiHostWorkRequest(client *oci_ocvp.WorkRequestClient, wor
 	return workRequestErr
 }
 
-func (s *OcvpEsxiHostResourceCrud) Get() error {
+func (s *OcvpEsxiHostResourceCrud) Get(ctx context.Context) error {
 	request := oci_ocvp.GetEsxiHostRequest{}
 
 	tmp := s.D.Id()
@@ -420,7 +421,7 @@ func (s *OcvpEsxiHostResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
 
-	response, err := s.Client.GetEsxiHost(context.Background(), request)
+	response, err := s.Client.GetEsxiHost(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -429,7 +430,7 @@ func (s *OcvpEsxiHostResourceCrud) Get() error {
 	return nil
 }
 
-func (s *OcvpEsxiHostResourceCrud) Update() error {
+func (s *OcvpEsxiHostResourceCrud) Update(ctx context.Context) error {
 	request := oci_ocvp.UpdateEsxiHostRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -458,7 +459,7 @@ func (s *OcvpEsxiHostResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
 
-	response, err := s.Client.UpdateEsxiHost(context.Background(), request)
+	response, err := s.Client.UpdateEsxiHost(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -467,7 +468,7 @@ func (s *OcvpEsxiHostResourceCrud) Update() error {
 	return nil
 }
 
-func (s *OcvpEsxiHostResourceCrud) Delete() error {
+func (s *OcvpEsxiHostResourceCrud) Delete(ctx context.Context) error {
 	request := oci_ocvp.DeleteEsxiHostRequest{}
 
 	tmp := s.D.Id()
@@ -475,14 +476,14 @@ func (s *OcvpEsxiHostResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
 
-	response, err := s.Client.DeleteEsxiHost(context.Background(), request)
+	response, err := s.Client.DeleteEsxiHost(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := esxiHostWaitForWorkRequest(workId, "esxihost",
+	_, delWorkRequestErr := esxiHostWaitForWorkRequest(ctx, workId, "esxihost",
 		oci_ocvp.ActionTypesDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.WorkRequestClient)
 	return delWorkRequestErr
 }
@@ -6,6 +6,7 @@ package ocvp
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ocvp "github.com/oracle/oci-go-sdk/v65/ocvp"
 
@@ -22,12 +23,12 @@ func OcvpSddcDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OcvpSddcResource(), fieldMap, readSingularOcvpSddc)
 }
 
-func readSingularOcvpSddc(d *schema.ResourceData, m interface{}) error {
+func readSingularOcvpSddc(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OcvpSddcDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SddcClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OcvpSddcDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *OcvpSddcDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OcvpSddcDataSourceCrud) Get() error {
+func (s *OcvpSddcDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_ocvp.GetSddcRequest{}
 
 	if sddcId, ok := s.D.GetOkExists("sddc_id"); ok {
@@ -50,7 +51,7 @@ func (s *OcvpSddcDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ocvp")
 
-	response, err := s.Client.GetSddc(context.Background(), request)
+	response, err := s.Client.GetSddc(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -30,10 +31,10 @@ func OcvpSddcResource() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Create: tfresource.GetTimeoutDuration("6h"),
 		},
-		Create: createOcvpSddc,
-		Read:   readOcvpSddc,
-		Update: updateOcvpSddc,
-		Delete: deleteOcvpSddc,
+		CreateContext: createOcvpSddc,
+		ReadContext:   readOcvpSddc,
+		UpdateContext: updateOcvpSddc,
+		DeleteContext: deleteOcvpSddc,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -372,40 +373,40 @@ const (
 	CancelDowngradeHcxAction string = "CANCEL_DOWNGRADE"
 )
 
-func createOcvpSddc(d *schema.ResourceData, m interface{}) error {
+func createOcvpSddc(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OcvpSddcResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SddcClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).OcvpWorkRequestClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readOcvpSddc(d *schema.ResourceData, m interface{}) error {
+func readOcvpSddc(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OcvpSddcResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SddcClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateOcvpSddc(d *schema.ResourceData, m interface{}) error {
+func updateOcvpSddc(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OcvpSddcResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SddcClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).OcvpWorkRequestClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteOcvpSddc(d *schema.ResourceData, m interface{}) error {
+func deleteOcvpSddc(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OcvpSddcResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SddcClient()
 	sync.DisableNotFoundRetries = true
 	sync.WorkRequestClient = m.(*client.OracleClients).OcvpWorkRequestClient()
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type OcvpSddcResourceCrud struct {
@@ -444,7 +445,7 @@ func (s *OcvpSddcResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *OcvpSddcResourceCrud) Create() error {
+func (s *OcvpSddcResourceCrud) Create(ctx context.Context) error {
 	request := oci_ocvp.CreateSddcRequest{}
 
 	if capacityReservationId, ok := s.D.GetOkExists("capacity_reservation_id"); ok {
@@ -604,13 +605,13 @@ func (s *OcvpSddcResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
 
-	response, err := s.Client.CreateSddc(context.Background(), request)
+	response, err := s.Client.CreateSddc(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	creationError := s.getSddcFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp"), oci_ocvp.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
+	creationError := s.getSddcFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp"), oci_ocvp.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate))
 
 	if creationError != nil {
 		return creationError
@@ -620,17 +621,17 @@ func (s *OcvpSddcResourceCrud) Create() error {
 
 	if refresh, ok := s.D.GetOk("refresh_hcx_license_status"); ok {
 		tmp := s.D.Id()
-		return s.refreshHcxLicenseStatus(&tmp, refresh)
+		return s.refreshHcxLicenseStatus(ctx, &tmp, refresh)
 	}
 
 	return nil
 }
 
-func (s *OcvpSddcResourceCrud) getSddcFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *OcvpSddcResourceCrud) getSddcFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_ocvp.ActionTypesEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	sddcId, err := sddcWaitForWorkRequest(workId, "sddc",
+	sddcId, err := sddcWaitForWorkRequest(ctx, workId, "sddc",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.WorkRequestClient)
 
 	if err != nil {
@@ -638,7 +639,7 @@ func (s *OcvpSddcResourceCrud) getSddcFromWorkRequest(workId *string, retryPolic
 	}
 	s.D.SetId(*sddcId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func sddcWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -664,7 +665,7 @@ func sddcWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_com
 	}
 }
 
-func sddcWaitForWorkRequest(wId *string, entityType string, action oci_ocvp.ActionTypesEnum,
+func sddcWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_ocvp.ActionTypesEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_ocvp.WorkRequestClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "ocvp")
 	retryPolicy.ShouldRetryOperation = sddcWorkRequestShouldRetryFunc(timeout)
@@ -683,7 +684,7 @@ func sddcWaitForWorkRequest(wId *string, entityType string, action oci_ocvp.Acti
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_ocvp.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -712,14 +713,14 @@ func sddcWaitForWorkRequest(wId *string, entityType string, action oci_ocvp.Acti
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_ocvp.OperationStatusFailed || response.Status == oci_ocvp.OperationStatusCanceled {
-		return nil, getErrorFromOcvpSddcWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromOcvpSddcWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromOcvpSddcWorkRequest(client *oci_ocvp.WorkRequestClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_ocvp.ActionTypesEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromOcvpSddcWorkRequest(ctx context.Context, client *oci_ocvp.WorkRequestClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_ocvp.ActionTypesEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_ocvp.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -741,7 +742,7 @@ func getErrorFromOcvpSddcWorkRequest(client *oci_ocvp.WorkRequestClient, workId
 	return workRequestErr
 }
 
-func (s *OcvpSddcResourceCrud) Get() error {
+func (s *OcvpSddcResourceCrud) Get(ctx context.Context) error {
 	request := oci_ocvp.GetSddcRequest{}
 
 	tmp := s.D.Id()
@@ -749,7 +750,7 @@ func (s *OcvpSddcResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
 
-	response, err := s.Client.GetSddc(context.Background(), request)
+	response, err := s.Client.GetSddc(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -758,11 +759,11 @@ func (s *OcvpSddcResourceCrud) Get() error {
 	return nil
 }
 
-func (s *OcvpSddcResourceCrud) Update() error {
+func (s *OcvpSddcResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -852,7 +853,7 @@ func (s *OcvpSddcResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
 
-	response, err := s.Client.UpdateSddc(context.Background(), request)
+	response, err := s.Client.UpdateSddc(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -881,12 +882,12 @@ func (s *OcvpSddcResourceCrud) Update() error {
 		if action == UpgradeHcxAction {
 			hcxRequest := oci_ocvp.UpgradeHcxRequest{}
 			hcxRequest.SddcId = &sddcId
-			hcxRes, hcxErr := s.Client.UpgradeHcx(context.Background(), hcxRequest)
+			hcxRes, hcxErr := s.Client.UpgradeHcx(ctx, hcxRequest)
 			if hcxErr != nil {
 				return hcxErr
 			}
 			workId := hcxRes.OpcWorkRequestId
-			updateHcxError = s.getSddcFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp"), oci_ocvp.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+			updateHcxError = s.getSddcFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp"), oci_ocvp.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 			if updateHcxError == nil {
 				s.D.Set("hcx_action", hcxAction)
 			}
@@ -900,24 +901,24 @@ func (s *OcvpSddcResourceCrud) Update() error {
 				}
 				hcxRequest.ReservingHcxOnPremiseLicenseKeys = keys
 			}
-			hcxRes, hcxErr := s.Client.DowngradeHcx(context.Background(), hcxRequest)
+			hcxRes, hcxErr := s.Client.DowngradeHcx(ctx, hcxRequest)
 			if hcxErr != nil {
 				return hcxErr
 			}
 			workId := hcxRes.OpcWorkRequestId
-			updateHcxError = s.getSddcFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp"), oci_ocvp.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+			updateHcxError = s.getSddcFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp"), oci_ocvp.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 			if updateHcxError == nil {
 				s.D.Set("hcx_action", hcxAction)
 			}
 		} else if action == CancelDowngradeHcxAction {
 			hcxRequest := oci_ocvp.CancelDowngradeHcxRequest{}
 			hcxRequest.SddcId = &sddcId
-			hcxRes, hcxErr := s.Client.CancelDowngradeHcx(context.Background(), hcxRequest)
+			hcxRes, hcxErr := s.Client.CancelDowngradeHcx(ctx, hcxRequest)
 			if hcxErr != nil {
 				return hcxErr
 			}
 			workId := hcxRes.OpcWorkRequestId
-			updateHcxError = s.getSddcFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp"), oci_ocvp.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+			updateHcxError = s.getSddcFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp"), oci_ocvp.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 			if updateHcxError == nil {
 				s.D.Set("hcx_action", hcxAction)
 			}
@@ -932,28 +933,28 @@ func (s *OcvpSddcResourceCrud) Update() error {
 
 	if refresh, ok := s.D.GetOk("refresh_hcx_license_status"); ok && s.D.HasChange("refresh_hcx_license_status") {
 		tmp := s.D.Id()
-		return s.refreshHcxLicenseStatus(&tmp, refresh)
+		return s.refreshHcxLicenseStatus(ctx, &tmp, refresh)
 	}
 
 	return nil
 }
 
-func (s *OcvpSddcResourceCrud) refreshHcxLicenseStatus(sddcId *string, refresh interface{}) error {
+func (s *OcvpSddcResourceCrud) refreshHcxLicenseStatus(ctx context.Context, sddcId *string, refresh interface{}) error {
 	hcxRequest := oci_ocvp.RefreshHcxLicenseStatusRequest{}
 	hcxRequest.SddcId = sddcId
-	hcxRes, hcxErr := s.Client.RefreshHcxLicenseStatus(context.Background(), hcxRequest)
+	hcxRes, hcxErr := s.Client.RefreshHcxLicenseStatus(ctx, hcxRequest)
 	if hcxErr != nil {
 		return hcxErr
 	}
 	workId := hcxRes.OpcWorkRequestId
-	err := s.getSddcFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp"), oci_ocvp.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	err := s.getSddcFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp"), oci_ocvp.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate))
 	if err == nil {
 		s.D.Set("refresh_hcx_license_status", refresh)
 	}
 	return err
 }
 
-func (s *OcvpSddcResourceCrud) Delete() error {
+func (s *OcvpSddcResourceCrud) Delete(ctx context.Context) error {
 	request := oci_ocvp.DeleteSddcRequest{}
 
 	tmp := s.D.Id()
@@ -961,14 +962,14 @@ func (s *OcvpSddcResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
 
-	response, err := s.Client.DeleteSddc(context.Background(), request)
+	response, err := s.Client.DeleteSddc(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := sddcWaitForWorkRequest(workId, "sddc",
+	_, delWorkRequestErr := sddcWaitForWorkRequest(ctx, workId, "sddc",
 		oci_ocvp.ActionTypesDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.WorkRequestClient)
 	return delWorkRequestErr
 }
@@ -1325,7 +1326,7 @@ func VsphereUpgradeObjectToMap(obj oci_ocvp.VsphereUpgradeObject) map[string]int
 	return result
 }
 
-func (s *OcvpSddcResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *OcvpSddcResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_ocvp.ChangeSddcCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -1336,12 +1337,12 @@ func (s *OcvpSddcResourceCrud) updateCompartment(compartment interface{}) error
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ocvp")
 
-	_, err := s.Client.ChangeSddcCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeSddcCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package ocvp
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ocvp "github.com/oracle/oci-go-sdk/v65/ocvp"
 
@@ -15,7 +16,7 @@ import (
 
 func OcvpSupportedHostShapesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOcvpSupportedHostShapes,
+		ReadContext: readOcvpSupportedHostShapes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -96,12 +97,12 @@ func OcvpSupportedHostShapesDataSource() *schema.Resource {
 	}
 }
 
-func readOcvpSupportedHostShapes(d *schema.ResourceData, m interface{}) error {
+func readOcvpSupportedHostShapes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OcvpSupportedHostShapesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SddcClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OcvpSupportedHostShapesDataSourceCrud struct {
@@ -114,7 +115,7 @@ func (s *OcvpSupportedHostShapesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OcvpSupportedHostShapesDataSourceCrud) Get() error {
+func (s *OcvpSupportedHostShapesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_ocvp.ListSupportedHostShapesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -133,7 +134,7 @@ func (s *OcvpSupportedHostShapesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ocvp")
 
-	response, err := s.Client.ListSupportedHostShapes(context.Background(), request)
+	response, err := s.Client.ListSupportedHostShapes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -142,7 +143,7 @@ func (s *OcvpSupportedHostShapesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSupportedHostShapes(context.Background(), request)
+		listResponse, err := s.Client.ListSupportedHostShapes(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package ocvp
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ocvp "github.com/oracle/oci-go-sdk/v65/ocvp"
 
@@ -15,7 +16,7 @@ import (
 
 func OcvpSupportedSkusDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOcvpSupportedSkus,
+		ReadContext: readOcvpSupportedSkus,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,12 +44,12 @@ func OcvpSupportedSkusDataSource() *schema.Resource {
 	}
 }
 
-func readOcvpSupportedSkus(d *schema.ResourceData, m interface{}) error {
+func readOcvpSupportedSkus(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OcvpSupportedSkusDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SddcClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OcvpSupportedSkusDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *OcvpSupportedSkusDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OcvpSupportedSkusDataSourceCrud) Get() error {
+func (s *OcvpSupportedSkusDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_ocvp.ListSupportedSkusRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -71,7 +72,7 @@ func (s *OcvpSupportedSkusDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ocvp")
 
-	response, err := s.Client.ListSupportedSkus(context.Background(), request)
+	response, err := s.Client.ListSupportedSkus(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -80,7 +81,7 @@ func (s *OcvpSupportedSkusDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSupportedSkus(context.Background(), request)
+		listResponse, err := s.Client.ListSupportedSkus(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package ocvp
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ocvp "github.com/oracle/oci-go-sdk/v65/ocvp"
 
@@ -15,7 +16,7 @@ import (
 
 func OcvpSupportedVmwareSoftwareVersionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOcvpSupportedVmwareSoftwareVersions,
+		ReadContext: readOcvpSupportedVmwareSoftwareVersions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,12 +49,12 @@ func OcvpSupportedVmwareSoftwareVersionsDataSource() *schema.Resource {
 	}
 }
 
-func readOcvpSupportedVmwareSoftwareVersions(d *schema.ResourceData, m interface{}) error {
+func readOcvpSupportedVmwareSoftwareVersions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OcvpSupportedVmwareSoftwareVersionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SddcClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OcvpSupportedVmwareSoftwareVersionsDataSourceCrud struct {
@@ -66,7 +67,7 @@ func (s *OcvpSupportedVmwareSoftwareVersionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OcvpSupportedVmwareSoftwareVersionsDataSourceCrud) Get() error {
+func (s *OcvpSupportedVmwareSoftwareVersionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_ocvp.ListSupportedVmwareSoftwareVersionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -76,7 +77,7 @@ func (s *OcvpSupportedVmwareSoftwareVersionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ocvp")
 
-	response, err := s.Client.ListSupportedVmwareSoftwareVersions(context.Background(), request)
+	response, err := s.Client.ListSupportedVmwareSoftwareVersions(ctx, request)
 	if err != nil {
 		return err
 	}
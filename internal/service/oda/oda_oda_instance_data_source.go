@@ -6,6 +6,7 @@ package oda
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_oda "github.com/oracle/oci-go-sdk/v65/oda"
 
@@ -22,12 +23,12 @@ func OdaOdaInstanceDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OdaOdaInstanceResource(), fieldMap, readSingularOdaOdaInstance)
 }
 
-func readSingularOdaOdaInstance(d *schema.ResourceData, m interface{}) error {
+func readSingularOdaOdaInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OdaOdaInstanceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OdaClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OdaOdaInstanceDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *OdaOdaInstanceDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OdaOdaInstanceDataSourceCrud) Get() error {
+func (s *OdaOdaInstanceDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_oda.GetOdaInstanceRequest{}
 
 	if odaInstanceId, ok := s.D.GetOkExists("oda_instance_id"); ok {
@@ -50,7 +51,7 @@ func (s *OdaOdaInstanceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "oda")
 
-	response, err := s.Client.GetOdaInstance(context.Background(), request)
+	response, err := s.Client.GetOdaInstance(ctx, request)
 	if err != nil {
 		return err
 	}
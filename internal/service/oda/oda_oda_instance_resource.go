@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -31,10 +32,10 @@ func OdaOdaInstanceResource() *schema.Resource {
 			Update: schema.DefaultTimeout(40 * time.Minute),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
-		Create: createOdaOdaInstance,
-		Read:   readOdaOdaInstance,
-		Update: updateOdaOdaInstance,
-		Delete: deleteOdaOdaInstance,
+		CreateContext: createOdaOdaInstance,
+		ReadContext:   readOdaOdaInstance,
+		UpdateContext: updateOdaOdaInstance,
+		DeleteContext: deleteOdaOdaInstance,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -179,7 +180,7 @@ func OdaOdaInstanceResource() *schema.Resource {
 	}
 }
 
-func createOdaOdaInstance(d *schema.ResourceData, m interface{}) error {
+func createOdaOdaInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OdaOdaInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OdaClient()
@@ -192,33 +193,33 @@ func createOdaOdaInstance(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	if error := tfresource.CreateResource(d, sync); error != nil {
-		return error
+	if error := tfresource.CreateResource(ctx, d, sync); error != nil {
+		return tfresource.HandleDiagError(error)
 	}
 
 	if isInactiveRequest {
-		return inactiveOdaIfNeeded(d, sync)
+		return tfresource.HandleDiagError(inactiveOdaIfNeeded(ctx, d, sync))
 	}
 
 	return nil
 }
 
-func inactiveOdaIfNeeded(d *schema.ResourceData, sync *OdaOdaInstanceResourceCrud) error {
-	if err := sync.StopOdaInstance(); err != nil {
+func inactiveOdaIfNeeded(ctx context.Context, d *schema.ResourceData, sync *OdaOdaInstanceResourceCrud) error {
+	if err := sync.StopOdaInstance(ctx); err != nil {
 		return err
 	}
-	return tfresource.ReadResource(sync)
+	return tfresource.ReadResource(ctx, sync)
 }
 
-func readOdaOdaInstance(d *schema.ResourceData, m interface{}) error {
+func readOdaOdaInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OdaOdaInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OdaClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateOdaOdaInstance(d *schema.ResourceData, m interface{}) error {
+func updateOdaOdaInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OdaOdaInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OdaClient()
@@ -235,43 +236,43 @@ func updateOdaOdaInstance(d *schema.ResourceData, m interface{}) error {
 			stateInactive = true
 			stateActive = false
 		} else {
-			return fmt.Errorf("[ERROR] Invalid state input for Update %v", wantedState)
+			return tfresource.HandleDiagError(fmt.Errorf("[ERROR] Invalid state input for Update %v", wantedState))
 		}
 	}
 
 	if stateActive {
-		if err := sync.StartOdaInstance(); err != nil {
-			return err
+		if err := sync.StartOdaInstance(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		if err := sync.D.Set("state", oci_oda.OdaInstanceLifecycleStateActive); err != nil {
-			return err
+			return tfresource.HandleDiagError(err)
 		}
 	}
 
 	// when state is inactive, it is invalid to Update resource
-	if err := tfresource.UpdateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.UpdateResource(ctx, d, sync); err != nil {
+		return tfresource.HandleDiagError(err)
 	}
 
 	if stateInactive {
-		if err := sync.StopOdaInstance(); err != nil {
-			return err
+		if err := sync.StopOdaInstance(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		if err := sync.D.Set("state", oci_oda.OdaInstanceLifecycleStateInactive); err != nil {
-			return err
+			return tfresource.HandleDiagError(err)
 		}
 	}
 
 	return nil
 }
 
-func deleteOdaOdaInstance(d *schema.ResourceData, m interface{}) error {
+func deleteOdaOdaInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OdaOdaInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OdaClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type OdaOdaInstanceResourceCrud struct {
@@ -321,7 +322,7 @@ func (s *OdaOdaInstanceResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *OdaOdaInstanceResourceCrud) Create() error {
+func (s *OdaOdaInstanceResourceCrud) Create(ctx context.Context) error {
 	request := oci_oda.CreateOdaInstanceRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -367,20 +368,20 @@ func (s *OdaOdaInstanceResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oda")
 
-	response, err := s.Client.CreateOdaInstance(context.Background(), request)
+	response, err := s.Client.CreateOdaInstance(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getOdaInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oda"), oci_oda.WorkRequestResourceResourceActionCreate, s.D.Timeout(schema.TimeoutCreate))
+	return s.getOdaInstanceFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oda"), oci_oda.WorkRequestResourceResourceActionCreate, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *OdaOdaInstanceResourceCrud) getOdaInstanceFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *OdaOdaInstanceResourceCrud) getOdaInstanceFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_oda.WorkRequestResourceResourceActionEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	odaInstanceId, err := odaInstanceWaitForWorkRequest(workId, "oda",
+	odaInstanceId, err := odaInstanceWaitForWorkRequest(ctx, workId, "oda",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -388,7 +389,7 @@ func (s *OdaOdaInstanceResourceCrud) getOdaInstanceFromWorkRequest(workId *strin
 	}
 	s.D.SetId(*odaInstanceId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func odaInstanceWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -414,7 +415,7 @@ func odaInstanceWorkRequestShouldRetryFunc(timeout time.Duration) func(response
 	}
 }
 
-func odaInstanceWaitForWorkRequest(wId *string, entityType string, action oci_oda.WorkRequestResourceResourceActionEnum,
+func odaInstanceWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_oda.WorkRequestResourceResourceActionEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_oda.OdaClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "oda")
 	retryPolicy.ShouldRetryOperation = odaInstanceWorkRequestShouldRetryFunc(timeout)
@@ -433,7 +434,7 @@ func odaInstanceWaitForWorkRequest(wId *string, entityType string, action oci_od
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_oda.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -462,14 +463,14 @@ func odaInstanceWaitForWorkRequest(wId *string, entityType string, action oci_od
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_oda.WorkRequestStatusFailed || response.Status == oci_oda.WorkRequestStatusCanceled {
-		return nil, getErrorFromOdaOdaInstanceWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromOdaOdaInstanceWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromOdaOdaInstanceWorkRequest(client *oci_oda.OdaClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_oda.WorkRequestResourceResourceActionEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromOdaOdaInstanceWorkRequest(ctx context.Context, client *oci_oda.OdaClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_oda.WorkRequestResourceResourceActionEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_oda.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -491,7 +492,7 @@ func getErrorFromOdaOdaInstanceWorkRequest(client *oci_oda.OdaClient, workId *st
 	return workRequestErr
 }
 
-func (s *OdaOdaInstanceResourceCrud) Get() error {
+func (s *OdaOdaInstanceResourceCrud) Get(ctx context.Context) error {
 	request := oci_oda.GetOdaInstanceRequest{}
 
 	tmp := s.D.Id()
@@ -499,7 +500,7 @@ func (s *OdaOdaInstanceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oda")
 
-	response, err := s.Client.GetOdaInstance(context.Background(), request)
+	response, err := s.Client.GetOdaInstance(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -508,11 +509,11 @@ func (s *OdaOdaInstanceResourceCrud) Get() error {
 	return nil
 }
 
-func (s *OdaOdaInstanceResourceCrud) Update() error {
+func (s *OdaOdaInstanceResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -547,7 +548,7 @@ func (s *OdaOdaInstanceResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oda")
 
-	response, err := s.Client.UpdateOdaInstance(context.Background(), request)
+	response, err := s.Client.UpdateOdaInstance(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -556,7 +557,7 @@ func (s *OdaOdaInstanceResourceCrud) Update() error {
 	return nil
 }
 
-func (s *OdaOdaInstanceResourceCrud) Delete() error {
+func (s *OdaOdaInstanceResourceCrud) Delete(ctx context.Context) error {
 	request := oci_oda.DeleteOdaInstanceRequest{}
 
 	tmp := s.D.Id()
@@ -564,14 +565,14 @@ func (s *OdaOdaInstanceResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oda")
 
-	response, err := s.Client.DeleteOdaInstance(context.Background(), request)
+	response, err := s.Client.DeleteOdaInstance(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := odaInstanceWaitForWorkRequest(workId, "oda",
+	_, delWorkRequestErr := odaInstanceWaitForWorkRequest(ctx, workId, "oda",
 		oci_oda.WorkRequestResourceResourceActionDelete, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -668,7 +669,7 @@ func RestrictedOperationToMap(obj oci_oda.RestrictedOperation) map[string]interf
 	return result
 }
 
-func (s *OdaOdaInstanceResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *OdaOdaInstanceResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_oda.ChangeOdaInstanceCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -679,18 +680,18 @@ func (s *OdaOdaInstanceResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oda")
 
-	response, err := s.Client.ChangeOdaInstanceCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeOdaInstanceCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getOdaInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oda"), oci_oda.WorkRequestResourceResourceActionChangeCompartment, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getOdaInstanceFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "oda"), oci_oda.WorkRequestResourceResourceActionChangeCompartment, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *OdaOdaInstanceResourceCrud) StartOdaInstance() error {
+func (s *OdaOdaInstanceResourceCrud) StartOdaInstance(ctx context.Context) error {
 	state := oci_oda.OdaInstanceLifecycleStateActive
-	if err := s.Get(); err != nil {
+	if err := s.Get(ctx); err != nil {
 		return err
 	}
 	if s.Res.LifecycleState == state {
@@ -702,17 +703,17 @@ func (s *OdaOdaInstanceResourceCrud) StartOdaInstance() error {
 	tmp := s.D.Id()
 	request.OdaInstanceId = &tmp
 
-	if _, err := s.Client.StartOdaInstance(context.Background(), request); err != nil {
+	if _, err := s.Client.StartOdaInstance(ctx, request); err != nil {
 		return err
 	}
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == state }
 
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *OdaOdaInstanceResourceCrud) StopOdaInstance() error {
+func (s *OdaOdaInstanceResourceCrud) StopOdaInstance(ctx context.Context) error {
 	state := oci_oda.OdaInstanceLifecycleStateInactive
-	if err := s.Get(); err != nil {
+	if err := s.Get(ctx); err != nil {
 		return err
 	}
 	if s.Res.LifecycleState == state {
@@ -724,10 +725,10 @@ func (s *OdaOdaInstanceResourceCrud) StopOdaInstance() error {
 	tmp := s.D.Id()
 	request.OdaInstanceId = &tmp
 
-	if _, err := s.Client.StopOdaInstance(context.Background(), request); err != nil {
+	if _, err := s.Client.StopOdaInstance(ctx, request); err != nil {
 		return err
 	}
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == state }
 
-	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+	return tfresource.WaitForResourceCondition(ctx, s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
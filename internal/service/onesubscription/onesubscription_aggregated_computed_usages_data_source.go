@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_onesubscription "github.com/oracle/oci-go-sdk/v65/onesubscription"
@@ -17,7 +18,7 @@ import (
 
 func OnesubscriptionAggregatedComputedUsagesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOnesubscriptionAggregatedComputedUsages,
+		ReadContext: readOnesubscriptionAggregatedComputedUsages,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -216,12 +217,12 @@ func OnesubscriptionAggregatedComputedUsagesDataSource() *schema.Resource {
 	}
 }
 
-func readOnesubscriptionAggregatedComputedUsages(d *schema.ResourceData, m interface{}) error {
+func readOnesubscriptionAggregatedComputedUsages(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnesubscriptionAggregatedComputedUsagesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputedUsageRegionalClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OnesubscriptionAggregatedComputedUsagesDataSourceCrud struct {
@@ -234,7 +235,7 @@ func (s *OnesubscriptionAggregatedComputedUsagesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OnesubscriptionAggregatedComputedUsagesDataSourceCrud) Get() error {
+func (s *OnesubscriptionAggregatedComputedUsagesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_onesubscription.ListAggregatedComputedUsagesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -274,7 +275,7 @@ func (s *OnesubscriptionAggregatedComputedUsagesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "onesubscription")
 
-	response, err := s.Client.ListAggregatedComputedUsages(context.Background(), request)
+	response, err := s.Client.ListAggregatedComputedUsages(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -283,7 +284,7 @@ func (s *OnesubscriptionAggregatedComputedUsagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAggregatedComputedUsages(context.Background(), request)
+		listResponse, err := s.Client.ListAggregatedComputedUsages(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package onesubscription
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_onesubscription "github.com/oracle/oci-go-sdk/v65/onesubscription"
 
@@ -15,7 +16,7 @@ import (
 
 func OnesubscriptionBillingSchedulesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOnesubscriptionBillingSchedules,
+		ReadContext: readOnesubscriptionBillingSchedules,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -116,12 +117,12 @@ func OnesubscriptionBillingSchedulesDataSource() *schema.Resource {
 	}
 }
 
-func readOnesubscriptionBillingSchedules(d *schema.ResourceData, m interface{}) error {
+func readOnesubscriptionBillingSchedules(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnesubscriptionBillingSchedulesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BillingScheduleRegionalClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OnesubscriptionBillingSchedulesDataSourceCrud struct {
@@ -134,7 +135,7 @@ func (s *OnesubscriptionBillingSchedulesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OnesubscriptionBillingSchedulesDataSourceCrud) Get() error {
+func (s *OnesubscriptionBillingSchedulesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_onesubscription.ListBillingSchedulesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -154,7 +155,7 @@ func (s *OnesubscriptionBillingSchedulesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "onesubscription")
 
-	response, err := s.Client.ListBillingSchedules(context.Background(), request)
+	response, err := s.Client.ListBillingSchedules(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -163,7 +164,7 @@ func (s *OnesubscriptionBillingSchedulesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBillingSchedules(context.Background(), request)
+		listResponse, err := s.Client.ListBillingSchedules(ctx, request)
 		if err != nil {
 			return err
 		}
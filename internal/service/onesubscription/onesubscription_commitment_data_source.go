@@ -6,6 +6,7 @@ package onesubscription
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_onesubscription "github.com/oracle/oci-go-sdk/v65/onesubscription"
 
@@ -15,7 +16,7 @@ import (
 
 func OnesubscriptionCommitmentDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOnesubscriptionCommitment,
+		ReadContext: readSingularOnesubscriptionCommitment,
 		Schema: map[string]*schema.Schema{
 			"commitment_id": {
 				Type:     schema.TypeString,
@@ -54,12 +55,12 @@ func OnesubscriptionCommitmentDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOnesubscriptionCommitment(d *schema.ResourceData, m interface{}) error {
+func readSingularOnesubscriptionCommitment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnesubscriptionCommitmentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CommitmentRegionalClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OnesubscriptionCommitmentDataSourceCrud struct {
@@ -72,7 +73,7 @@ func (s *OnesubscriptionCommitmentDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OnesubscriptionCommitmentDataSourceCrud) Get() error {
+func (s *OnesubscriptionCommitmentDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_onesubscription.GetCommitmentRequest{}
 
 	if commitmentId, ok := s.D.GetOkExists("commitment_id"); ok {
@@ -82,7 +83,7 @@ func (s *OnesubscriptionCommitmentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "onesubscription")
 
-	response, err := s.Client.GetCommitment(context.Background(), request)
+	response, err := s.Client.GetCommitment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package onesubscription
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_onesubscription "github.com/oracle/oci-go-sdk/v65/onesubscription"
 
@@ -15,7 +16,7 @@ import (
 
 func OnesubscriptionCommitmentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOnesubscriptionCommitments,
+		ReadContext: readOnesubscriptionCommitments,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -75,12 +76,12 @@ func OnesubscriptionCommitmentsDataSource() *schema.Resource {
 	}
 }
 
-func readOnesubscriptionCommitments(d *schema.ResourceData, m interface{}) error {
+func readOnesubscriptionCommitments(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnesubscriptionCommitmentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CommitmentRegionalClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OnesubscriptionCommitmentsDataSourceCrud struct {
@@ -93,7 +94,7 @@ func (s *OnesubscriptionCommitmentsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OnesubscriptionCommitmentsDataSourceCrud) Get() error {
+func (s *OnesubscriptionCommitmentsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_onesubscription.ListCommitmentsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -108,7 +109,7 @@ func (s *OnesubscriptionCommitmentsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "onesubscription")
 
-	response, err := s.Client.ListCommitments(context.Background(), request)
+	response, err := s.Client.ListCommitments(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -117,7 +118,7 @@ func (s *OnesubscriptionCommitmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCommitments(context.Background(), request)
+		listResponse, err := s.Client.ListCommitments(ctx, request)
 		if err != nil {
 			return err
 		}
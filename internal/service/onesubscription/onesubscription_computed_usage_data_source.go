@@ -6,6 +6,7 @@ package onesubscription
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_onesubscription "github.com/oracle/oci-go-sdk/v65/onesubscription"
 
@@ -15,7 +16,7 @@ import (
 
 func OnesubscriptionComputedUsageDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOnesubscriptionComputedUsage,
+		ReadContext: readSingularOnesubscriptionComputedUsage,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -207,12 +208,12 @@ func OnesubscriptionComputedUsageDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOnesubscriptionComputedUsage(d *schema.ResourceData, m interface{}) error {
+func readSingularOnesubscriptionComputedUsage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnesubscriptionComputedUsageDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputedUsageRegionalClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OnesubscriptionComputedUsageDataSourceCrud struct {
@@ -225,7 +226,7 @@ func (s *OnesubscriptionComputedUsageDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OnesubscriptionComputedUsageDataSourceCrud) Get() error {
+func (s *OnesubscriptionComputedUsageDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_onesubscription.GetComputedUsageRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -253,7 +254,7 @@ func (s *OnesubscriptionComputedUsageDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "onesubscription")
 
-	response, err := s.Client.GetComputedUsage(context.Background(), request)
+	response, err := s.Client.GetComputedUsage(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_onesubscription "github.com/oracle/oci-go-sdk/v65/onesubscription"
@@ -17,7 +18,7 @@ import (
 
 func OnesubscriptionComputedUsagesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOnesubscriptionComputedUsages,
+		ReadContext: readOnesubscriptionComputedUsages,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -239,12 +240,12 @@ func OnesubscriptionComputedUsagesDataSource() *schema.Resource {
 	}
 }
 
-func readOnesubscriptionComputedUsages(d *schema.ResourceData, m interface{}) error {
+func readOnesubscriptionComputedUsages(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnesubscriptionComputedUsagesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputedUsageRegionalClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OnesubscriptionComputedUsagesDataSourceCrud struct {
@@ -257,7 +258,7 @@ func (s *OnesubscriptionComputedUsagesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OnesubscriptionComputedUsagesDataSourceCrud) Get() error {
+func (s *OnesubscriptionComputedUsagesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_onesubscription.ListComputedUsagesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -298,7 +299,7 @@ func (s *OnesubscriptionComputedUsagesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "onesubscription")
 
-	response, err := s.Client.ListComputedUsages(context.Background(), request)
+	response, err := s.Client.ListComputedUsages(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -307,7 +308,7 @@ func (s *OnesubscriptionComputedUsagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListComputedUsages(context.Background(), request)
+		listResponse, err := s.Client.ListComputedUsages(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package onesubscription
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_onesubscription "github.com/oracle/oci-go-sdk/v65/onesubscription"
 
@@ -15,7 +16,7 @@ import (
 
 func OnesubscriptionInvoiceLineComputedUsagesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOnesubscriptionInvoiceLineComputedUsages,
+		ReadContext: readOnesubscriptionInvoiceLineComputedUsages,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -148,12 +149,12 @@ func OnesubscriptionInvoiceLineComputedUsagesDataSource() *schema.Resource {
 	}
 }
 
-func readOnesubscriptionInvoiceLineComputedUsages(d *schema.ResourceData, m interface{}) error {
+func readOnesubscriptionInvoiceLineComputedUsages(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnesubscriptionInvoiceLineComputedUsagesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).InvoiceSummaryRegionalClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OnesubscriptionInvoiceLineComputedUsagesDataSourceCrud struct {
@@ -166,7 +167,7 @@ func (s *OnesubscriptionInvoiceLineComputedUsagesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OnesubscriptionInvoiceLineComputedUsagesDataSourceCrud) Get() error {
+func (s *OnesubscriptionInvoiceLineComputedUsagesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_onesubscription.ListInvoicelineComputedUsagesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -194,7 +195,7 @@ func (s *OnesubscriptionInvoiceLineComputedUsagesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "onesubscription")
 
-	response, err := s.Client.ListInvoicelineComputedUsages(context.Background(), request)
+	response, err := s.Client.ListInvoicelineComputedUsages(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -203,7 +204,7 @@ func (s *OnesubscriptionInvoiceLineComputedUsagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListInvoicelineComputedUsages(context.Background(), request)
+		listResponse, err := s.Client.ListInvoicelineComputedUsages(ctx, request)
 		if err != nil {
 			return err
 		}
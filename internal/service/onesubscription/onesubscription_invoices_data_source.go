@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_onesubscription "github.com/oracle/oci-go-sdk/v65/onesubscription"
@@ -18,7 +19,7 @@ import (
 
 func OnesubscriptionInvoicesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOnesubscriptionInvoices,
+		ReadContext: readOnesubscriptionInvoices,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"ar_customer_transaction_id": {
@@ -450,12 +451,12 @@ func OnesubscriptionInvoicesDataSource() *schema.Resource {
 	}
 }
 
-func readOnesubscriptionInvoices(d *schema.ResourceData, m interface{}) error {
+func readOnesubscriptionInvoices(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnesubscriptionInvoicesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).InvoiceSummaryRegionalClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OnesubscriptionInvoicesDataSourceCrud struct {
@@ -468,7 +469,7 @@ func (s *OnesubscriptionInvoicesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OnesubscriptionInvoicesDataSourceCrud) Get() error {
+func (s *OnesubscriptionInvoicesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_onesubscription.ListInvoicesRequest{}
 
 	if arCustomerTransactionId, ok := s.D.GetOkExists("ar_customer_transaction_id"); ok {
@@ -512,7 +513,7 @@ func (s *OnesubscriptionInvoicesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "onesubscription")
 
-	response, err := s.Client.ListInvoices(context.Background(), request)
+	response, err := s.Client.ListInvoices(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -521,7 +522,7 @@ func (s *OnesubscriptionInvoicesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListInvoices(context.Background(), request)
+		listResponse, err := s.Client.ListInvoices(ctx, request)
 		if err != nil {
 			return err
 		}
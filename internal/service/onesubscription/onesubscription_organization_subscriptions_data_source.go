@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_onesubscription "github.com/oracle/oci-go-sdk/v65/onesubscription"
 
@@ -16,7 +17,7 @@ import (
 
 func OnesubscriptionOrganizationSubscriptionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOnesubscriptionOrganizationSubscriptions,
+		ReadContext: readOnesubscriptionOrganizationSubscriptions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -93,12 +94,12 @@ func OnesubscriptionOrganizationSubscriptionsDataSource() *schema.Resource {
 	}
 }
 
-func readOnesubscriptionOrganizationSubscriptions(d *schema.ResourceData, m interface{}) error {
+func readOnesubscriptionOrganizationSubscriptions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnesubscriptionOrganizationSubscriptionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OrganizationSubscriptionRegionalClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OnesubscriptionOrganizationSubscriptionsDataSourceCrud struct {
@@ -111,7 +112,7 @@ func (s *OnesubscriptionOrganizationSubscriptionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OnesubscriptionOrganizationSubscriptionsDataSourceCrud) Get() error {
+func (s *OnesubscriptionOrganizationSubscriptionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_onesubscription.ListOrganizationSubscriptionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -121,7 +122,7 @@ func (s *OnesubscriptionOrganizationSubscriptionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "onesubscription")
 
-	response, err := s.Client.ListOrganizationSubscriptions(context.Background(), request)
+	response, err := s.Client.ListOrganizationSubscriptions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -130,7 +131,7 @@ func (s *OnesubscriptionOrganizationSubscriptionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListOrganizationSubscriptions(context.Background(), request)
+		listResponse, err := s.Client.ListOrganizationSubscriptions(ctx, request)
 		if err != nil {
 			return err
 		}
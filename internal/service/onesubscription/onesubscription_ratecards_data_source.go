@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_onesubscription "github.com/oracle/oci-go-sdk/v65/onesubscription"
@@ -17,7 +18,7 @@ import (
 
 func OnesubscriptionRatecardsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOnesubscriptionRatecards,
+		ReadContext: readOnesubscriptionRatecards,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -172,12 +173,12 @@ func OnesubscriptionRatecardsDataSource() *schema.Resource {
 	}
 }
 
-func readOnesubscriptionRatecards(d *schema.ResourceData, m interface{}) error {
+func readOnesubscriptionRatecards(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnesubscriptionRatecardsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RatecardRegionalClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OnesubscriptionRatecardsDataSourceCrud struct {
@@ -190,7 +191,7 @@ func (s *OnesubscriptionRatecardsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OnesubscriptionRatecardsDataSourceCrud) Get() error {
+func (s *OnesubscriptionRatecardsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_onesubscription.ListRateCardsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -226,7 +227,7 @@ func (s *OnesubscriptionRatecardsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "onesubscription")
 
-	response, err := s.Client.ListRateCards(context.Background(), request)
+	response, err := s.Client.ListRateCards(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -235,7 +236,7 @@ func (s *OnesubscriptionRatecardsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRateCards(context.Background(), request)
+		listResponse, err := s.Client.ListRateCards(ctx, request)
 		if err != nil {
 			return err
 		}
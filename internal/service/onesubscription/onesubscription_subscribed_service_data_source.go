@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_onesubscription "github.com/oracle/oci-go-sdk/v65/onesubscription"
 
@@ -16,7 +17,7 @@ import (
 
 func OnesubscriptionSubscribedServiceDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOnesubscriptionSubscribedService,
+		ReadContext: readSingularOnesubscriptionSubscribedService,
 		Schema: map[string]*schema.Schema{
 			"fields": {
 				Type:     schema.TypeList,
@@ -1463,12 +1464,12 @@ func OnesubscriptionSubscribedServiceDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOnesubscriptionSubscribedService(d *schema.ResourceData, m interface{}) error {
+func readSingularOnesubscriptionSubscribedService(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnesubscriptionSubscribedServiceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SubscribedServiceRegionalClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OnesubscriptionSubscribedServiceDataSourceCrud struct {
@@ -1481,7 +1482,7 @@ func (s *OnesubscriptionSubscribedServiceDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OnesubscriptionSubscribedServiceDataSourceCrud) Get() error {
+func (s *OnesubscriptionSubscribedServiceDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_onesubscription.GetSubscribedServiceRequest{}
 
 	if fields, ok := s.D.GetOkExists("fields"); ok {
@@ -1504,7 +1505,7 @@ func (s *OnesubscriptionSubscribedServiceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "onesubscription")
 
-	response, err := s.Client.GetSubscribedService(context.Background(), request)
+	response, err := s.Client.GetSubscribedService(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_onesubscription "github.com/oracle/oci-go-sdk/v65/onesubscription"
 
@@ -17,7 +18,7 @@ import (
 
 func OnesubscriptionSubscribedServicesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOnesubscriptionSubscribedServices,
+		ReadContext: readOnesubscriptionSubscribedServices,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -1486,12 +1487,12 @@ func OnesubscriptionSubscribedServicesDataSource() *schema.Resource {
 	}
 }
 
-func readOnesubscriptionSubscribedServices(d *schema.ResourceData, m interface{}) error {
+func readOnesubscriptionSubscribedServices(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnesubscriptionSubscribedServicesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SubscribedServiceRegionalClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OnesubscriptionSubscribedServicesDataSourceCrud struct {
@@ -1504,7 +1505,7 @@ func (s *OnesubscriptionSubscribedServicesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OnesubscriptionSubscribedServicesDataSourceCrud) Get() error {
+func (s *OnesubscriptionSubscribedServicesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_onesubscription.ListSubscribedServicesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -1533,7 +1534,7 @@ func (s *OnesubscriptionSubscribedServicesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "onesubscription")
 
-	response, err := s.Client.ListSubscribedServices(context.Background(), request)
+	response, err := s.Client.ListSubscribedServices(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -1542,7 +1543,7 @@ func (s *OnesubscriptionSubscribedServicesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSubscribedServices(context.Background(), request)
+		listResponse, err := s.Client.ListSubscribedServices(ctx, request)
 		if err != nil {
 			return err
 		}
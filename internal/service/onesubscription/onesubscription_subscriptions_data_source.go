@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_onesubscription "github.com/oracle/oci-go-sdk/v65/onesubscription"
 
@@ -16,7 +17,7 @@ import (
 
 func OnesubscriptionSubscriptionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOnesubscriptionSubscriptions,
+		ReadContext: readOnesubscriptionSubscriptions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"buyer_email": {
@@ -276,12 +277,12 @@ func OnesubscriptionSubscriptionsDataSource() *schema.Resource {
 	}
 }
 
-func readOnesubscriptionSubscriptions(d *schema.ResourceData, m interface{}) error {
+func readOnesubscriptionSubscriptions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnesubscriptionSubscriptionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SubscriptionRegionalClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OnesubscriptionSubscriptionsDataSourceCrud struct {
@@ -294,7 +295,7 @@ func (s *OnesubscriptionSubscriptionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OnesubscriptionSubscriptionsDataSourceCrud) Get() error {
+func (s *OnesubscriptionSubscriptionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_onesubscription.ListSubscriptionsRequest{}
 
 	if buyerEmail, ok := s.D.GetOkExists("buyer_email"); ok {
@@ -324,7 +325,7 @@ func (s *OnesubscriptionSubscriptionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "onesubscription")
 
-	response, err := s.Client.ListSubscriptions(context.Background(), request)
+	response, err := s.Client.ListSubscriptions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -333,7 +334,7 @@ func (s *OnesubscriptionSubscriptionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSubscriptions(context.Background(), request)
+		listResponse, err := s.Client.ListSubscriptions(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ons "github.com/oracle/oci-go-sdk/v65/ons"
 )
@@ -22,12 +23,12 @@ func OnsNotificationTopicDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OnsNotificationTopicResource(), fieldMap, readSingularOnsNotificationTopic)
 }
 
-func readSingularOnsNotificationTopic(d *schema.ResourceData, m interface{}) error {
+func readSingularOnsNotificationTopic(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnsNotificationTopicDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NotificationControlPlaneClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OnsNotificationTopicDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *OnsNotificationTopicDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OnsNotificationTopicDataSourceCrud) Get() error {
+func (s *OnsNotificationTopicDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_ons.GetTopicRequest{}
 
 	if topicId, ok := s.D.GetOkExists("topic_id"); ok {
@@ -50,7 +51,7 @@ func (s *OnsNotificationTopicDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ons")
 
-	response, err := s.Client.GetTopic(context.Background(), request)
+	response, err := s.Client.GetTopic(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_ons "github.com/oracle/oci-go-sdk/v65/ons"
@@ -27,10 +28,10 @@ func OnsNotificationTopicResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("15m"),
 			Delete: tfresource.GetTimeoutDuration("150m"),
 		},
-		Create: createOnsNotificationTopic,
-		Read:   readOnsNotificationTopic,
-		Update: updateOnsNotificationTopic,
-		Delete: deleteOnsNotificationTopic,
+		CreateContext: createOnsNotificationTopic,
+		ReadContext:   readOnsNotificationTopic,
+		UpdateContext: updateOnsNotificationTopic,
+		DeleteContext: deleteOnsNotificationTopic,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -92,37 +93,37 @@ func OnsNotificationTopicResource() *schema.Resource {
 	}
 }
 
-func createOnsNotificationTopic(d *schema.ResourceData, m interface{}) error {
+func createOnsNotificationTopic(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnsNotificationTopicResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NotificationControlPlaneClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readOnsNotificationTopic(d *schema.ResourceData, m interface{}) error {
+func readOnsNotificationTopic(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnsNotificationTopicResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NotificationControlPlaneClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateOnsNotificationTopic(d *schema.ResourceData, m interface{}) error {
+func updateOnsNotificationTopic(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnsNotificationTopicResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NotificationControlPlaneClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteOnsNotificationTopic(d *schema.ResourceData, m interface{}) error {
+func deleteOnsNotificationTopic(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnsNotificationTopicResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NotificationControlPlaneClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type OnsNotificationTopicResourceCrud struct {
@@ -164,7 +165,7 @@ func (s *OnsNotificationTopicResourceCrud) DeletedTarget() []string {
 	return []string{}
 }
 
-func (s *OnsNotificationTopicResourceCrud) Create() error {
+func (s *OnsNotificationTopicResourceCrud) Create(ctx context.Context) error {
 	request := oci_ons.CreateTopicRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -196,7 +197,7 @@ func (s *OnsNotificationTopicResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
 
-	response, err := s.Client.CreateTopic(context.Background(), request)
+	response, err := s.Client.CreateTopic(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -205,7 +206,7 @@ func (s *OnsNotificationTopicResourceCrud) Create() error {
 	return nil
 }
 
-func (s *OnsNotificationTopicResourceCrud) Get() error {
+func (s *OnsNotificationTopicResourceCrud) Get(ctx context.Context) error {
 	request := oci_ons.GetTopicRequest{}
 
 	if topicId, ok := s.D.GetOkExists("topic_id"); ok {
@@ -218,7 +219,7 @@ func (s *OnsNotificationTopicResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
 
-	response, err := s.Client.GetTopic(context.Background(), request)
+	response, err := s.Client.GetTopic(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -227,11 +228,11 @@ func (s *OnsNotificationTopicResourceCrud) Get() error {
 	return nil
 }
 
-func (s *OnsNotificationTopicResourceCrud) Update() error {
+func (s *OnsNotificationTopicResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -263,7 +264,7 @@ func (s *OnsNotificationTopicResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
 
-	response, err := s.Client.UpdateTopic(context.Background(), request)
+	response, err := s.Client.UpdateTopic(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -272,7 +273,7 @@ func (s *OnsNotificationTopicResourceCrud) Update() error {
 	return nil
 }
 
-func (s *OnsNotificationTopicResourceCrud) Delete() error {
+func (s *OnsNotificationTopicResourceCrud) Delete(ctx context.Context) error {
 	request := oci_ons.DeleteTopicRequest{}
 
 	if topicId, ok := s.D.GetOkExists("topic_id"); ok {
@@ -282,7 +283,7 @@ func (s *OnsNotificationTopicResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
 
-	_, err := s.Client.DeleteTopic(context.Background(), request)
+	_, err := s.Client.DeleteTopic(ctx, request)
 	return err
 }
 
@@ -333,7 +334,7 @@ func (s *OnsNotificationTopicResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *OnsNotificationTopicResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *OnsNotificationTopicResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_ons.ChangeTopicCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -346,12 +347,12 @@ func (s *OnsNotificationTopicResourceCrud) updateCompartment(compartment interfa
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
 
-	_, err := s.Client.ChangeTopicCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeTopicCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
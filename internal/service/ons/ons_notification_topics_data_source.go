@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ons "github.com/oracle/oci-go-sdk/v65/ons"
 )
 
 func OnsNotificationTopicsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOnsNotificationTopics,
+		ReadContext: readOnsNotificationTopics,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,12 +44,12 @@ func OnsNotificationTopicsDataSource() *schema.Resource {
 	}
 }
 
-func readOnsNotificationTopics(d *schema.ResourceData, m interface{}) error {
+func readOnsNotificationTopics(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnsNotificationTopicsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NotificationControlPlaneClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OnsNotificationTopicsDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *OnsNotificationTopicsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OnsNotificationTopicsDataSourceCrud) Get() error {
+func (s *OnsNotificationTopicsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_ons.ListTopicsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -85,7 +86,7 @@ func (s *OnsNotificationTopicsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ons")
 
-	response, err := s.Client.ListTopics(context.Background(), request)
+	response, err := s.Client.ListTopics(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *OnsNotificationTopicsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListTopics(context.Background(), request)
+		listResponse, err := s.Client.ListTopics(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ons "github.com/oracle/oci-go-sdk/v65/ons"
 )
@@ -23,12 +24,12 @@ func OnsSubscriptionDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OnsSubscriptionResource(), fieldMap, readSingularOnsSubscription)
 }
 
-func readSingularOnsSubscription(d *schema.ResourceData, m interface{}) error {
+func readSingularOnsSubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnsSubscriptionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NotificationDataPlaneClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OnsSubscriptionDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *OnsSubscriptionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OnsSubscriptionDataSourceCrud) Get() error {
+func (s *OnsSubscriptionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_ons.GetSubscriptionRequest{}
 
 	if subscriptionId, ok := s.D.GetOkExists("subscription_id"); ok {
@@ -51,7 +52,7 @@ func (s *OnsSubscriptionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ons")
 
-	response, err := s.Client.GetSubscription(context.Background(), request)
+	response, err := s.Client.GetSubscription(ctx, request)
 	if err != nil {
 		return err
 	}
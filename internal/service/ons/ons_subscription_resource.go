@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_ons "github.com/oracle/oci-go-sdk/v65/ons"
@@ -22,11 +23,11 @@ func OnsSubscriptionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOnsSubscription,
-		Read:     readOnsSubscription,
-		Update:   updateOnsSubscription,
-		Delete:   deleteOnsSubscription,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOnsSubscription,
+		ReadContext:   readOnsSubscription,
+		UpdateContext: updateOnsSubscription,
+		DeleteContext: deleteOnsSubscription,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -87,37 +88,37 @@ func OnsSubscriptionResource() *schema.Resource {
 	}
 }
 
-func createOnsSubscription(d *schema.ResourceData, m interface{}) error {
+func createOnsSubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnsSubscriptionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NotificationDataPlaneClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readOnsSubscription(d *schema.ResourceData, m interface{}) error {
+func readOnsSubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnsSubscriptionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NotificationDataPlaneClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateOnsSubscription(d *schema.ResourceData, m interface{}) error {
+func updateOnsSubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnsSubscriptionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NotificationDataPlaneClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteOnsSubscription(d *schema.ResourceData, m interface{}) error {
+func deleteOnsSubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnsSubscriptionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NotificationDataPlaneClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type OnsSubscriptionResourceCrud struct {
@@ -152,7 +153,7 @@ func (s *OnsSubscriptionResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *OnsSubscriptionResourceCrud) Create() error {
+func (s *OnsSubscriptionResourceCrud) Create(ctx context.Context) error {
 	request := oci_ons.CreateSubscriptionRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -189,7 +190,7 @@ func (s *OnsSubscriptionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
 
-	response, err := s.Client.CreateSubscription(context.Background(), request)
+	response, err := s.Client.CreateSubscription(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -198,7 +199,7 @@ func (s *OnsSubscriptionResourceCrud) Create() error {
 	return nil
 }
 
-func (s *OnsSubscriptionResourceCrud) Get() error {
+func (s *OnsSubscriptionResourceCrud) Get(ctx context.Context) error {
 	request := oci_ons.GetSubscriptionRequest{}
 
 	tmp := s.D.Id()
@@ -206,7 +207,7 @@ func (s *OnsSubscriptionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
 
-	response, err := s.Client.GetSubscription(context.Background(), request)
+	response, err := s.Client.GetSubscription(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -215,11 +216,11 @@ func (s *OnsSubscriptionResourceCrud) Get() error {
 	return nil
 }
 
-func (s *OnsSubscriptionResourceCrud) Update() error {
+func (s *OnsSubscriptionResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -253,15 +254,15 @@ func (s *OnsSubscriptionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
 
-	_, err := s.Client.UpdateSubscription(context.Background(), request)
+	_, err := s.Client.UpdateSubscription(ctx, request)
 	if err == nil {
-		return s.Get()
+		return s.Get(ctx)
 	}
 
 	return err
 }
 
-func (s *OnsSubscriptionResourceCrud) Delete() error {
+func (s *OnsSubscriptionResourceCrud) Delete(ctx context.Context) error {
 	request := oci_ons.DeleteSubscriptionRequest{}
 
 	tmp := s.D.Id()
@@ -269,7 +270,7 @@ func (s *OnsSubscriptionResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
 
-	_, err := s.Client.DeleteSubscription(context.Background(), request)
+	_, err := s.Client.DeleteSubscription(ctx, request)
 	return err
 }
 
@@ -357,7 +358,7 @@ func jsonStringDiffSuppresionFunction(key string, old string, new string, d *sch
 	}
 }
 
-func (s *OnsSubscriptionResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *OnsSubscriptionResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_ons.ChangeSubscriptionCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -368,12 +369,12 @@ func (s *OnsSubscriptionResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "ons")
 
-	_, err := s.Client.ChangeSubscriptionCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeSubscriptionCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
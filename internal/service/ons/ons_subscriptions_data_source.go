@@ -11,13 +11,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_ons "github.com/oracle/oci-go-sdk/v65/ons"
 )
 
 func OnsSubscriptionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOnsSubscriptions,
+		ReadContext: readOnsSubscriptions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -37,12 +38,12 @@ func OnsSubscriptionsDataSource() *schema.Resource {
 	}
 }
 
-func readOnsSubscriptions(d *schema.ResourceData, m interface{}) error {
+func readOnsSubscriptions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OnsSubscriptionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).NotificationDataPlaneClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OnsSubscriptionsDataSourceCrud struct {
@@ -55,7 +56,7 @@ func (s *OnsSubscriptionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OnsSubscriptionsDataSourceCrud) Get() error {
+func (s *OnsSubscriptionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_ons.ListSubscriptionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -70,7 +71,7 @@ func (s *OnsSubscriptionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "ons")
 
-	response, err := s.Client.ListSubscriptions(context.Background(), request)
+	response, err := s.Client.ListSubscriptions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -79,7 +80,7 @@ func (s *OnsSubscriptionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSubscriptions(context.Background(), request)
+		listResponse, err := s.Client.ListSubscriptions(ctx, request)
 		if err != nil {
 			return err
 		}
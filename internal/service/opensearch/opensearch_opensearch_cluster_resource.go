@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -25,11 +26,11 @@ func OpensearchOpensearchClusterResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOpensearchOpensearchCluster,
-		Read:     readOpensearchOpensearchCluster,
-		Update:   updateOpensearchOpensearchCluster,
-		Delete:   deleteOpensearchOpensearchCluster,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOpensearchOpensearchCluster,
+		ReadContext:   readOpensearchOpensearchCluster,
+		UpdateContext: updateOpensearchOpensearchCluster,
+		DeleteContext: deleteOpensearchOpensearchCluster,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -216,45 +217,45 @@ func OpensearchOpensearchClusterResource() *schema.Resource {
 	}
 }
 
-func createOpensearchOpensearchCluster(d *schema.ResourceData, m interface{}) error {
+func createOpensearchOpensearchCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpensearchOpensearchClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OpensearchClusterClient()
 
-	if e := tfresource.CreateResource(d, sync); e != nil {
-		return e
+	if e := tfresource.CreateResource(ctx, d, sync); e != nil {
+		return tfresource.HandleDiagError(e)
 	}
 	return nil
 
 }
 
-func readOpensearchOpensearchCluster(d *schema.ResourceData, m interface{}) error {
+func readOpensearchOpensearchCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpensearchOpensearchClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OpensearchClusterClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateOpensearchOpensearchCluster(d *schema.ResourceData, m interface{}) error {
+func updateOpensearchOpensearchCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpensearchOpensearchClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OpensearchClusterClient()
 
-	if err := tfresource.UpdateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.UpdateResource(ctx, d, sync); err != nil {
+		return tfresource.HandleDiagError(err)
 	}
 
 	return nil
 }
 
-func deleteOpensearchOpensearchCluster(d *schema.ResourceData, m interface{}) error {
+func deleteOpensearchOpensearchCluster(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpensearchOpensearchClusterResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OpensearchClusterClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type OpensearchOpensearchClusterResourceCrud struct {
@@ -292,7 +293,7 @@ func (s *OpensearchOpensearchClusterResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *OpensearchOpensearchClusterResourceCrud) Create() error {
+func (s *OpensearchOpensearchClusterResourceCrud) Create(ctx context.Context) error {
 	request := oci_opensearch.CreateOpensearchClusterRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -420,20 +421,20 @@ func (s *OpensearchOpensearchClusterResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opensearch")
 
-	response, err := s.Client.CreateOpensearchCluster(context.Background(), request)
+	response, err := s.Client.CreateOpensearchCluster(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getOpensearchClusterFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opensearch"), oci_opensearch.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getOpensearchClusterFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opensearch"), oci_opensearch.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *OpensearchOpensearchClusterResourceCrud) getOpensearchClusterFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *OpensearchOpensearchClusterResourceCrud) getOpensearchClusterFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_opensearch.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	opensearchClusterId, err := opensearchClusterWaitForWorkRequest(workId, "opensearch",
+	opensearchClusterId, err := opensearchClusterWaitForWorkRequest(ctx, workId, "opensearch",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -441,7 +442,7 @@ func (s *OpensearchOpensearchClusterResourceCrud) getOpensearchClusterFromWorkRe
 	}
 	s.D.SetId(*opensearchClusterId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func opensearchClusterWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -467,7 +468,7 @@ func opensearchClusterWorkRequestShouldRetryFunc(timeout time.Duration) func(res
 	}
 }
 
-func opensearchClusterWaitForWorkRequest(wId *string, entityType string, action oci_opensearch.ActionTypeEnum,
+func opensearchClusterWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_opensearch.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_opensearch.OpensearchClusterClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "opensearch")
 	retryPolicy.ShouldRetryOperation = opensearchClusterWorkRequestShouldRetryFunc(timeout)
@@ -486,7 +487,7 @@ func opensearchClusterWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_opensearch.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -515,14 +516,14 @@ func opensearchClusterWaitForWorkRequest(wId *string, entityType string, action
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_opensearch.OperationStatusFailed || response.Status == oci_opensearch.OperationStatusCanceled {
-		return nil, getErrorFromOpensearchOpensearchClusterWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromOpensearchOpensearchClusterWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromOpensearchOpensearchClusterWorkRequest(client *oci_opensearch.OpensearchClusterClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opensearch.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromOpensearchOpensearchClusterWorkRequest(ctx context.Context, client *oci_opensearch.OpensearchClusterClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opensearch.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_opensearch.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -544,7 +545,7 @@ func getErrorFromOpensearchOpensearchClusterWorkRequest(client *oci_opensearch.O
 	return workRequestErr
 }
 
-func (s *OpensearchOpensearchClusterResourceCrud) Get() error {
+func (s *OpensearchOpensearchClusterResourceCrud) Get(ctx context.Context) error {
 	request := oci_opensearch.GetOpensearchClusterRequest{}
 
 	tmp := s.D.Id()
@@ -552,7 +553,7 @@ func (s *OpensearchOpensearchClusterResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opensearch")
 
-	response, err := s.Client.GetOpensearchCluster(context.Background(), request)
+	response, err := s.Client.GetOpensearchCluster(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -561,7 +562,7 @@ func (s *OpensearchOpensearchClusterResourceCrud) Get() error {
 	return nil
 }
 
-func (s *OpensearchOpensearchClusterResourceCrud) Update() error {
+func (s *OpensearchOpensearchClusterResourceCrud) Update(ctx context.Context) error {
 	request := oci_opensearch.UpdateOpensearchClusterRequest{}
 
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
@@ -591,16 +592,16 @@ func (s *OpensearchOpensearchClusterResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opensearch")
 
-	response, err := s.Client.UpdateOpensearchCluster(context.Background(), request)
+	response, err := s.Client.UpdateOpensearchCluster(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getOpensearchClusterFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opensearch"), oci_opensearch.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getOpensearchClusterFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opensearch"), oci_opensearch.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *OpensearchOpensearchClusterResourceCrud) Delete() error {
+func (s *OpensearchOpensearchClusterResourceCrud) Delete(ctx context.Context) error {
 	request := oci_opensearch.DeleteOpensearchClusterRequest{}
 
 	tmp := s.D.Id()
@@ -608,14 +609,14 @@ func (s *OpensearchOpensearchClusterResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opensearch")
 
-	response, err := s.Client.DeleteOpensearchCluster(context.Background(), request)
+	response, err := s.Client.DeleteOpensearchCluster(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := opensearchClusterWaitForWorkRequest(workId, "opensearch",
+	_, delWorkRequestErr := opensearchClusterWaitForWorkRequest(ctx, workId, "opensearch",
 		oci_opensearch.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -6,6 +6,7 @@ package opensearch
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opensearch "github.com/oracle/oci-go-sdk/v65/opensearch"
 
@@ -16,7 +17,7 @@ import (
 
 func OpensearchOpensearchVersionDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOpensearchOpensearchVersion,
+		ReadContext: readSingularOpensearchOpensearchVersion,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -44,12 +45,12 @@ func OpensearchOpensearchVersionDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOpensearchOpensearchVersion(d *schema.ResourceData, m interface{}) error {
+func readSingularOpensearchOpensearchVersion(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpensearchOpensearchVersionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OpensearchClusterClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OpensearchOpensearchVersionDataSourceCrud struct {
@@ -62,7 +63,7 @@ func (s *OpensearchOpensearchVersionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OpensearchOpensearchVersionDataSourceCrud) Get() error {
+func (s *OpensearchOpensearchVersionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_opensearch.ListOpensearchVersionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -72,7 +73,7 @@ func (s *OpensearchOpensearchVersionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opensearch")
 
-	response, err := s.Client.ListOpensearchVersions(context.Background(), request)
+	response, err := s.Client.ListOpensearchVersions(ctx, request)
 	if err != nil {
 		return err
 	}
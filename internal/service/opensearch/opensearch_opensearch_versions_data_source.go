@@ -6,6 +6,7 @@ package opensearch
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opensearch "github.com/oracle/oci-go-sdk/v65/opensearch"
 
@@ -16,7 +17,7 @@ import (
 
 func OpensearchOpensearchVersionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOpensearchOpensearchVersions,
+		ReadContext: readOpensearchOpensearchVersions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -52,12 +53,12 @@ func OpensearchOpensearchVersionsDataSource() *schema.Resource {
 	}
 }
 
-func readOpensearchOpensearchVersions(d *schema.ResourceData, m interface{}) error {
+func readOpensearchOpensearchVersions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpensearchOpensearchVersionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OpensearchClusterClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OpensearchOpensearchVersionsDataSourceCrud struct {
@@ -70,7 +71,7 @@ func (s *OpensearchOpensearchVersionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OpensearchOpensearchVersionsDataSourceCrud) Get() error {
+func (s *OpensearchOpensearchVersionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_opensearch.ListOpensearchVersionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -80,7 +81,7 @@ func (s *OpensearchOpensearchVersionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opensearch")
 
-	response, err := s.Client.ListOpensearchVersions(context.Background(), request)
+	response, err := s.Client.ListOpensearchVersions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -89,7 +90,7 @@ func (s *OpensearchOpensearchVersionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListOpensearchVersions(context.Background(), request)
+		listResponse, err := s.Client.ListOpensearchVersions(ctx, request)
 		if err != nil {
 			return err
 		}
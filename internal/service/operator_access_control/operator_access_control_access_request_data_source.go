@@ -6,6 +6,7 @@ package operator_access_control
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_operator_access_control "github.com/oracle/oci-go-sdk/v65/operatoraccesscontrol"
 
@@ -15,7 +16,7 @@ import (
 
 func OperatorAccessControlAccessRequestDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOperatorAccessControlAccessRequest,
+		ReadContext: readSingularOperatorAccessControlAccessRequest,
 		Schema: map[string]*schema.Schema{
 			"access_request_id": {
 				Type:     schema.TypeString,
@@ -153,12 +154,12 @@ func OperatorAccessControlAccessRequestDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOperatorAccessControlAccessRequest(d *schema.ResourceData, m interface{}) error {
+func readSingularOperatorAccessControlAccessRequest(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlAccessRequestDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AccessRequestsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OperatorAccessControlAccessRequestDataSourceCrud struct {
@@ -171,7 +172,7 @@ func (s *OperatorAccessControlAccessRequestDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OperatorAccessControlAccessRequestDataSourceCrud) Get() error {
+func (s *OperatorAccessControlAccessRequestDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_operator_access_control.GetAccessRequestRequest{}
 
 	if accessRequestId, ok := s.D.GetOkExists("access_request_id"); ok {
@@ -181,7 +182,7 @@ func (s *OperatorAccessControlAccessRequestDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "operator_access_control")
 
-	response, err := s.Client.GetAccessRequest(context.Background(), request)
+	response, err := s.Client.GetAccessRequest(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package operator_access_control
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_operator_access_control "github.com/oracle/oci-go-sdk/v65/operatoraccesscontrol"
 
@@ -15,7 +16,7 @@ import (
 
 func OperatorAccessControlAccessRequestHistoryDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOperatorAccessControlAccessRequestHistory,
+		ReadContext: readSingularOperatorAccessControlAccessRequestHistory,
 		Schema: map[string]*schema.Schema{
 			"access_request_id": {
 				Type:     schema.TypeString,
@@ -70,12 +71,12 @@ func OperatorAccessControlAccessRequestHistoryDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOperatorAccessControlAccessRequestHistory(d *schema.ResourceData, m interface{}) error {
+func readSingularOperatorAccessControlAccessRequestHistory(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlAccessRequestHistoryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AccessRequestsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OperatorAccessControlAccessRequestHistoryDataSourceCrud struct {
@@ -88,7 +89,7 @@ func (s *OperatorAccessControlAccessRequestHistoryDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OperatorAccessControlAccessRequestHistoryDataSourceCrud) Get() error {
+func (s *OperatorAccessControlAccessRequestHistoryDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_operator_access_control.ListAccessRequestHistoriesRequest{}
 
 	if accessRequestId, ok := s.D.GetOkExists("access_request_id"); ok {
@@ -98,7 +99,7 @@ func (s *OperatorAccessControlAccessRequestHistoryDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "operator_access_control")
 
-	response, err := s.Client.ListAccessRequestHistories(context.Background(), request)
+	response, err := s.Client.ListAccessRequestHistories(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_operator_access_control "github.com/oracle/oci-go-sdk/v65/operatoraccesscontrol"
@@ -17,7 +18,7 @@ import (
 
 func OperatorAccessControlAccessRequestsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOperatorAccessControlAccessRequests,
+		ReadContext: readOperatorAccessControlAccessRequests,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -195,12 +196,12 @@ func OperatorAccessControlAccessRequestsDataSource() *schema.Resource {
 	}
 }
 
-func readOperatorAccessControlAccessRequests(d *schema.ResourceData, m interface{}) error {
+func readOperatorAccessControlAccessRequests(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlAccessRequestsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).AccessRequestsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OperatorAccessControlAccessRequestsDataSourceCrud struct {
@@ -213,7 +214,7 @@ func (s *OperatorAccessControlAccessRequestsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OperatorAccessControlAccessRequestsDataSourceCrud) Get() error {
+func (s *OperatorAccessControlAccessRequestsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_operator_access_control.ListAccessRequestsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -253,7 +254,7 @@ func (s *OperatorAccessControlAccessRequestsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "operator_access_control")
 
-	response, err := s.Client.ListAccessRequests(context.Background(), request)
+	response, err := s.Client.ListAccessRequests(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package operator_access_control
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_operator_access_control "github.com/oracle/oci-go-sdk/v65/operatoraccesscontrol"
 
@@ -15,7 +16,7 @@ import (
 
 func OperatorAccessControlOperatorActionDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOperatorAccessControlOperatorAction,
+		ReadContext: readSingularOperatorAccessControlOperatorAction,
 		Schema: map[string]*schema.Schema{
 			"operator_action_id": {
 				Type:     schema.TypeString,
@@ -67,12 +68,12 @@ func OperatorAccessControlOperatorActionDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOperatorAccessControlOperatorAction(d *schema.ResourceData, m interface{}) error {
+func readSingularOperatorAccessControlOperatorAction(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlOperatorActionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperatorActionsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OperatorAccessControlOperatorActionDataSourceCrud struct {
@@ -85,7 +86,7 @@ func (s *OperatorAccessControlOperatorActionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OperatorAccessControlOperatorActionDataSourceCrud) Get() error {
+func (s *OperatorAccessControlOperatorActionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_operator_access_control.GetOperatorActionRequest{}
 
 	if operatorActionId, ok := s.D.GetOkExists("operator_action_id"); ok {
@@ -95,7 +96,7 @@ func (s *OperatorAccessControlOperatorActionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "operator_access_control")
 
-	response, err := s.Client.GetOperatorAction(context.Background(), request)
+	response, err := s.Client.GetOperatorAction(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package operator_access_control
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_operator_access_control "github.com/oracle/oci-go-sdk/v65/operatoraccesscontrol"
 
@@ -15,7 +16,7 @@ import (
 
 func OperatorAccessControlOperatorActionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOperatorAccessControlOperatorActions,
+		ReadContext: readOperatorAccessControlOperatorActions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -107,12 +108,12 @@ func OperatorAccessControlOperatorActionsDataSource() *schema.Resource {
 	}
 }
 
-func readOperatorAccessControlOperatorActions(d *schema.ResourceData, m interface{}) error {
+func readOperatorAccessControlOperatorActions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlOperatorActionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperatorActionsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OperatorAccessControlOperatorActionsDataSourceCrud struct {
@@ -125,7 +126,7 @@ func (s *OperatorAccessControlOperatorActionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OperatorAccessControlOperatorActionsDataSourceCrud) Get() error {
+func (s *OperatorAccessControlOperatorActionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_operator_access_control.ListOperatorActionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -149,7 +150,7 @@ func (s *OperatorAccessControlOperatorActionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "operator_access_control")
 
-	response, err := s.Client.ListOperatorActions(context.Background(), request)
+	response, err := s.Client.ListOperatorActions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_operator_access_control "github.com/oracle/oci-go-sdk/v65/operatoraccesscontrol"
 
@@ -23,12 +24,12 @@ func OperatorAccessControlOperatorControlAssignmentDataSource() *schema.Resource
 	return tfresource.GetSingularDataSourceItemSchema(OperatorAccessControlOperatorControlAssignmentResource(), fieldMap, readSingularOperatorAccessControlOperatorControlAssignment)
 }
 
-func readSingularOperatorAccessControlOperatorControlAssignment(d *schema.ResourceData, m interface{}) error {
+func readSingularOperatorAccessControlOperatorControlAssignment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlOperatorControlAssignmentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperatorControlAssignmentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OperatorAccessControlOperatorControlAssignmentDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *OperatorAccessControlOperatorControlAssignmentDataSourceCrud) VoidState
 	s.D.SetId("")
 }
 
-func (s *OperatorAccessControlOperatorControlAssignmentDataSourceCrud) Get() error {
+func (s *OperatorAccessControlOperatorControlAssignmentDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_operator_access_control.GetOperatorControlAssignmentRequest{}
 
 	if operatorControlAssignmentId, ok := s.D.GetOkExists("operator_control_assignment_id"); ok {
@@ -51,7 +52,7 @@ func (s *OperatorAccessControlOperatorControlAssignmentDataSourceCrud) Get() err
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "operator_access_control")
 
-	response, err := s.Client.GetOperatorControlAssignment(context.Background(), request)
+	response, err := s.Client.GetOperatorControlAssignment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
@@ -21,11 +22,11 @@ func OperatorAccessControlOperatorControlAssignmentResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOperatorAccessControlOperatorControlAssignment,
-		Read:     readOperatorAccessControlOperatorControlAssignment,
-		Update:   updateOperatorAccessControlOperatorControlAssignment,
-		Delete:   deleteOperatorAccessControlOperatorControlAssignment,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOperatorAccessControlOperatorControlAssignment,
+		ReadContext:   readOperatorAccessControlOperatorControlAssignment,
+		UpdateContext: updateOperatorAccessControlOperatorControlAssignment,
+		DeleteContext: deleteOperatorAccessControlOperatorControlAssignment,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -160,37 +161,37 @@ func OperatorAccessControlOperatorControlAssignmentResource() *schema.Resource {
 	}
 }
 
-func createOperatorAccessControlOperatorControlAssignment(d *schema.ResourceData, m interface{}) error {
+func createOperatorAccessControlOperatorControlAssignment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlOperatorControlAssignmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperatorControlAssignmentClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readOperatorAccessControlOperatorControlAssignment(d *schema.ResourceData, m interface{}) error {
+func readOperatorAccessControlOperatorControlAssignment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlOperatorControlAssignmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperatorControlAssignmentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateOperatorAccessControlOperatorControlAssignment(d *schema.ResourceData, m interface{}) error {
+func updateOperatorAccessControlOperatorControlAssignment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlOperatorControlAssignmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperatorControlAssignmentClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteOperatorAccessControlOperatorControlAssignment(d *schema.ResourceData, m interface{}) error {
+func deleteOperatorAccessControlOperatorControlAssignment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlOperatorControlAssignmentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperatorControlAssignmentClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type OperatorAccessControlOperatorControlAssignmentResourceCrud struct {
@@ -226,7 +227,7 @@ func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) DeletedTarg
 	}
 }
 
-func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) Create() error {
+func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) Create(ctx context.Context) error {
 	request := oci_operator_access_control.CreateOperatorControlAssignmentRequest{}
 
 	if comment, ok := s.D.GetOkExists("comment"); ok {
@@ -323,7 +324,7 @@ func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) Create() er
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
 
-	response, err := s.Client.CreateOperatorControlAssignment(context.Background(), request)
+	response, err := s.Client.CreateOperatorControlAssignment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -332,7 +333,7 @@ func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) Create() er
 	return nil
 }
 
-func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) Get() error {
+func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) Get(ctx context.Context) error {
 	request := oci_operator_access_control.GetOperatorControlAssignmentRequest{}
 
 	tmp := s.D.Id()
@@ -340,7 +341,7 @@ func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) Get() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
 
-	response, err := s.Client.GetOperatorControlAssignment(context.Background(), request)
+	response, err := s.Client.GetOperatorControlAssignment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -349,11 +350,11 @@ func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) Get() error
 	return nil
 }
 
-func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) Update() error {
+func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -429,7 +430,7 @@ func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) Update() er
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
 
-	response, err := s.Client.UpdateOperatorControlAssignment(context.Background(), request)
+	response, err := s.Client.UpdateOperatorControlAssignment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -438,7 +439,7 @@ func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) Update() er
 	return nil
 }
 
-func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) Delete() error {
+func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) Delete(ctx context.Context) error {
 	request := oci_operator_access_control.DeleteOperatorControlAssignmentRequest{}
 
 	if description, ok := s.D.GetOkExists("description"); ok {
@@ -451,7 +452,7 @@ func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) Delete() er
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
 
-	_, err := s.Client.DeleteOperatorControlAssignment(context.Background(), request)
+	_, err := s.Client.DeleteOperatorControlAssignment(ctx, request)
 	return err
 }
 
@@ -629,7 +630,7 @@ func OperatorControlAssignmentSummaryToMap(obj oci_operator_access_control.Opera
 	return result
 }
 
-func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_operator_access_control.ChangeOperatorControlAssignmentCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -640,12 +641,12 @@ func (s *OperatorAccessControlOperatorControlAssignmentResourceCrud) updateCompa
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
 
-	_, err := s.Client.ChangeOperatorControlAssignmentCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeOperatorControlAssignmentCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
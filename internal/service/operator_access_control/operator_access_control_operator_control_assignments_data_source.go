@@ -6,6 +6,7 @@ package operator_access_control
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_operator_access_control "github.com/oracle/oci-go-sdk/v65/operatoraccesscontrol"
 
@@ -15,7 +16,7 @@ import (
 
 func OperatorAccessControlOperatorControlAssignmentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOperatorAccessControlOperatorControlAssignments,
+		ReadContext: readOperatorAccessControlOperatorControlAssignments,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -56,12 +57,12 @@ func OperatorAccessControlOperatorControlAssignmentsDataSource() *schema.Resourc
 	}
 }
 
-func readOperatorAccessControlOperatorControlAssignments(d *schema.ResourceData, m interface{}) error {
+func readOperatorAccessControlOperatorControlAssignments(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlOperatorControlAssignmentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperatorControlAssignmentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OperatorAccessControlOperatorControlAssignmentsDataSourceCrud struct {
@@ -74,7 +75,7 @@ func (s *OperatorAccessControlOperatorControlAssignmentsDataSourceCrud) VoidStat
 	s.D.SetId("")
 }
 
-func (s *OperatorAccessControlOperatorControlAssignmentsDataSourceCrud) Get() error {
+func (s *OperatorAccessControlOperatorControlAssignmentsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_operator_access_control.ListOperatorControlAssignmentsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -103,7 +104,7 @@ func (s *OperatorAccessControlOperatorControlAssignmentsDataSourceCrud) Get() er
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "operator_access_control")
 
-	response, err := s.Client.ListOperatorControlAssignments(context.Background(), request)
+	response, err := s.Client.ListOperatorControlAssignments(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -112,7 +113,7 @@ func (s *OperatorAccessControlOperatorControlAssignmentsDataSourceCrud) Get() er
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListOperatorControlAssignments(context.Background(), request)
+		listResponse, err := s.Client.ListOperatorControlAssignments(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package operator_access_control
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_operator_access_control "github.com/oracle/oci-go-sdk/v65/operatoraccesscontrol"
 
@@ -22,12 +23,12 @@ func OperatorAccessControlOperatorControlDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OperatorAccessControlOperatorControlResource(), fieldMap, readSingularOperatorAccessControlOperatorControl)
 }
 
-func readSingularOperatorAccessControlOperatorControl(d *schema.ResourceData, m interface{}) error {
+func readSingularOperatorAccessControlOperatorControl(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlOperatorControlDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperatorControlClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OperatorAccessControlOperatorControlDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *OperatorAccessControlOperatorControlDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OperatorAccessControlOperatorControlDataSourceCrud) Get() error {
+func (s *OperatorAccessControlOperatorControlDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_operator_access_control.GetOperatorControlRequest{}
 
 	if operatorControlId, ok := s.D.GetOkExists("operator_control_id"); ok {
@@ -50,7 +51,7 @@ func (s *OperatorAccessControlOperatorControlDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "operator_access_control")
 
-	response, err := s.Client.GetOperatorControl(context.Background(), request)
+	response, err := s.Client.GetOperatorControl(ctx, request)
 	if err != nil {
 		return err
 	}
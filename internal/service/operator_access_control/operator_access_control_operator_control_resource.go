@@ -6,6 +6,7 @@ package operator_access_control
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_operator_access_control "github.com/oracle/oci-go-sdk/v65/operatoraccesscontrol"
@@ -19,11 +20,11 @@ func OperatorAccessControlOperatorControlResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOperatorAccessControlOperatorControl,
-		Read:     readOperatorAccessControlOperatorControl,
-		Update:   updateOperatorAccessControlOperatorControl,
-		Delete:   deleteOperatorAccessControlOperatorControl,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOperatorAccessControlOperatorControl,
+		ReadContext:   readOperatorAccessControlOperatorControl,
+		UpdateContext: updateOperatorAccessControlOperatorControl,
+		DeleteContext: deleteOperatorAccessControlOperatorControl,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"approver_groups_list": {
@@ -132,37 +133,37 @@ func OperatorAccessControlOperatorControlResource() *schema.Resource {
 	}
 }
 
-func createOperatorAccessControlOperatorControl(d *schema.ResourceData, m interface{}) error {
+func createOperatorAccessControlOperatorControl(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlOperatorControlResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperatorControlClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readOperatorAccessControlOperatorControl(d *schema.ResourceData, m interface{}) error {
+func readOperatorAccessControlOperatorControl(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlOperatorControlResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperatorControlClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateOperatorAccessControlOperatorControl(d *schema.ResourceData, m interface{}) error {
+func updateOperatorAccessControlOperatorControl(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlOperatorControlResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperatorControlClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteOperatorAccessControlOperatorControl(d *schema.ResourceData, m interface{}) error {
+func deleteOperatorAccessControlOperatorControl(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlOperatorControlResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperatorControlClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type OperatorAccessControlOperatorControlResourceCrud struct {
@@ -198,7 +199,7 @@ func (s *OperatorAccessControlOperatorControlResourceCrud) DeletedTarget() []str
 	}
 }
 
-func (s *OperatorAccessControlOperatorControlResourceCrud) Create() error {
+func (s *OperatorAccessControlOperatorControlResourceCrud) Create(ctx context.Context) error {
 	request := oci_operator_access_control.CreateOperatorControlRequest{}
 
 	if approverGroupsList, ok := s.D.GetOkExists("approver_groups_list"); ok {
@@ -296,7 +297,7 @@ func (s *OperatorAccessControlOperatorControlResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
 
-	response, err := s.Client.CreateOperatorControl(context.Background(), request)
+	response, err := s.Client.CreateOperatorControl(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -305,7 +306,7 @@ func (s *OperatorAccessControlOperatorControlResourceCrud) Create() error {
 	return nil
 }
 
-func (s *OperatorAccessControlOperatorControlResourceCrud) Get() error {
+func (s *OperatorAccessControlOperatorControlResourceCrud) Get(ctx context.Context) error {
 	request := oci_operator_access_control.GetOperatorControlRequest{}
 
 	tmp := s.D.Id()
@@ -313,7 +314,7 @@ func (s *OperatorAccessControlOperatorControlResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
 
-	response, err := s.Client.GetOperatorControl(context.Background(), request)
+	response, err := s.Client.GetOperatorControl(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -322,11 +323,11 @@ func (s *OperatorAccessControlOperatorControlResourceCrud) Get() error {
 	return nil
 }
 
-func (s *OperatorAccessControlOperatorControlResourceCrud) Update() error {
+func (s *OperatorAccessControlOperatorControlResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -423,7 +424,7 @@ func (s *OperatorAccessControlOperatorControlResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
 
-	response, err := s.Client.UpdateOperatorControl(context.Background(), request)
+	response, err := s.Client.UpdateOperatorControl(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -432,7 +433,7 @@ func (s *OperatorAccessControlOperatorControlResourceCrud) Update() error {
 	return nil
 }
 
-func (s *OperatorAccessControlOperatorControlResourceCrud) Delete() error {
+func (s *OperatorAccessControlOperatorControlResourceCrud) Delete(ctx context.Context) error {
 	request := oci_operator_access_control.DeleteOperatorControlRequest{}
 
 	if description, ok := s.D.GetOkExists("description"); ok {
@@ -445,7 +446,7 @@ func (s *OperatorAccessControlOperatorControlResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
 
-	_, err := s.Client.DeleteOperatorControl(context.Background(), request)
+	_, err := s.Client.DeleteOperatorControl(ctx, request)
 	return err
 }
 
@@ -553,7 +554,7 @@ func OperatorControlSummaryToMap(obj oci_operator_access_control.OperatorControl
 	return result
 }
 
-func (s *OperatorAccessControlOperatorControlResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *OperatorAccessControlOperatorControlResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_operator_access_control.ChangeOperatorControlCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -564,12 +565,12 @@ func (s *OperatorAccessControlOperatorControlResourceCrud) updateCompartment(com
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "operator_access_control")
 
-	_, err := s.Client.ChangeOperatorControlCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeOperatorControlCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package operator_access_control
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_operator_access_control "github.com/oracle/oci-go-sdk/v65/operatoraccesscontrol"
 
@@ -15,7 +16,7 @@ import (
 
 func OperatorAccessControlOperatorControlsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOperatorAccessControlOperatorControls,
+		ReadContext: readOperatorAccessControlOperatorControls,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -52,12 +53,12 @@ func OperatorAccessControlOperatorControlsDataSource() *schema.Resource {
 	}
 }
 
-func readOperatorAccessControlOperatorControls(d *schema.ResourceData, m interface{}) error {
+func readOperatorAccessControlOperatorControls(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OperatorAccessControlOperatorControlsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperatorControlClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OperatorAccessControlOperatorControlsDataSourceCrud struct {
@@ -70,7 +71,7 @@ func (s *OperatorAccessControlOperatorControlsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OperatorAccessControlOperatorControlsDataSourceCrud) Get() error {
+func (s *OperatorAccessControlOperatorControlsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_operator_access_control.ListOperatorControlsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -94,7 +95,7 @@ func (s *OperatorAccessControlOperatorControlsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "operator_access_control")
 
-	response, err := s.Client.ListOperatorControls(context.Background(), request)
+	response, err := s.Client.ListOperatorControls(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_opsi "github.com/oracle/oci-go-sdk/v65/opsi"
@@ -17,7 +18,7 @@ import (
 
 func OpsiAwrHubAwrSnapshotDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOpsiAwrHubAwrSnapshot,
+		ReadContext: readSingularOpsiAwrHubAwrSnapshot,
 		Schema: map[string]*schema.Schema{
 			"awr_hub_id": {
 				Type:     schema.TypeString,
@@ -81,12 +82,12 @@ func OpsiAwrHubAwrSnapshotDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOpsiAwrHubAwrSnapshot(d *schema.ResourceData, m interface{}) error {
+func readSingularOpsiAwrHubAwrSnapshot(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiAwrHubAwrSnapshotDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OpsiAwrHubAwrSnapshotDataSourceCrud struct {
@@ -99,7 +100,7 @@ func (s *OpsiAwrHubAwrSnapshotDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OpsiAwrHubAwrSnapshotDataSourceCrud) Get() error {
+func (s *OpsiAwrHubAwrSnapshotDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_opsi.ListAwrSnapshotsRequest{}
 
 	if awrHubId, ok := s.D.GetOkExists("awr_hub_id"); ok {
@@ -130,7 +131,7 @@ func (s *OpsiAwrHubAwrSnapshotDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.ListAwrSnapshots(context.Background(), request)
+	response, err := s.Client.ListAwrSnapshots(ctx, request)
 	if err != nil {
 		return err
 	}
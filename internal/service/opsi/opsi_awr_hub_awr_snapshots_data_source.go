@@ -11,6 +11,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_opsi "github.com/oracle/oci-go-sdk/v65/opsi"
@@ -18,7 +19,7 @@ import (
 
 func OpsiAwrHubAwrSnapshotsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOpsiAwrHubAwrSnapshots,
+		ReadContext: readOpsiAwrHubAwrSnapshots,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"awr_hub_id": {
@@ -103,12 +104,12 @@ func OpsiAwrHubAwrSnapshotsDataSource() *schema.Resource {
 	}
 }
 
-func readOpsiAwrHubAwrSnapshots(d *schema.ResourceData, m interface{}) error {
+func readOpsiAwrHubAwrSnapshots(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiAwrHubAwrSnapshotsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OpsiAwrHubAwrSnapshotsDataSourceCrud struct {
@@ -121,7 +122,7 @@ func (s *OpsiAwrHubAwrSnapshotsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OpsiAwrHubAwrSnapshotsDataSourceCrud) Get() error {
+func (s *OpsiAwrHubAwrSnapshotsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_opsi.ListAwrSnapshotsRequest{}
 
 	if awrHubId, ok := s.D.GetOkExists("awr_hub_id"); ok {
@@ -152,7 +153,7 @@ func (s *OpsiAwrHubAwrSnapshotsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.ListAwrSnapshots(context.Background(), request)
+	response, err := s.Client.ListAwrSnapshots(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -161,7 +162,7 @@ func (s *OpsiAwrHubAwrSnapshotsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAwrSnapshots(context.Background(), request)
+		listResponse, err := s.Client.ListAwrSnapshots(ctx, request)
 		if err != nil {
 			return err
 		}
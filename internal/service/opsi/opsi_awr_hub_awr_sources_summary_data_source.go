@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v65/opsi"
 )
 
 func OpsiAwrHubAwrSourcesSummaryDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOpsiAwrHubAwrSourcesSummary,
+		ReadContext: readSingularOpsiAwrHubAwrSourcesSummary,
 		Schema: map[string]*schema.Schema{
 			"awr_hub_id": {
 				Type:     schema.TypeString,
@@ -83,12 +84,12 @@ func OpsiAwrHubAwrSourcesSummaryDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOpsiAwrHubAwrSourcesSummary(d *schema.ResourceData, m interface{}) error {
+func readSingularOpsiAwrHubAwrSourcesSummary(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiAwrHubAwrSourcesSummaryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OpsiAwrHubAwrSourcesSummaryDataSourceCrud struct {
@@ -101,7 +102,7 @@ func (s *OpsiAwrHubAwrSourcesSummaryDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OpsiAwrHubAwrSourcesSummaryDataSourceCrud) Get() error {
+func (s *OpsiAwrHubAwrSourcesSummaryDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_opsi.SummarizeAwrSourcesSummariesRequest{}
 
 	if awrHubId, ok := s.D.GetOkExists("awr_hub_id"); ok {
@@ -121,7 +122,7 @@ func (s *OpsiAwrHubAwrSourcesSummaryDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.SummarizeAwrSourcesSummaries(context.Background(), request)
+	response, err := s.Client.SummarizeAwrSourcesSummaries(ctx, request)
 	if err != nil {
 		return err
 	}
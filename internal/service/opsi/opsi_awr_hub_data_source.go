@@ -6,6 +6,7 @@ package opsi
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v65/opsi"
 
@@ -22,12 +23,12 @@ func OpsiAwrHubDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OpsiAwrHubResource(), fieldMap, readSingularOpsiAwrHub)
 }
 
-func readSingularOpsiAwrHub(d *schema.ResourceData, m interface{}) error {
+func readSingularOpsiAwrHub(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiAwrHubDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OpsiAwrHubDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *OpsiAwrHubDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OpsiAwrHubDataSourceCrud) Get() error {
+func (s *OpsiAwrHubDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_opsi.GetAwrHubRequest{}
 
 	if awrHubId, ok := s.D.GetOkExists("awr_hub_id"); ok {
@@ -50,7 +51,7 @@ func (s *OpsiAwrHubDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.GetAwrHub(context.Background(), request)
+	response, err := s.Client.GetAwrHub(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v65/opsi"
 )
 
 func OpsiAwrHubsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOpsiAwrHubs,
+		ReadContext: readOpsiAwrHubs,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -59,12 +60,12 @@ func OpsiAwrHubsDataSource() *schema.Resource {
 	}
 }
 
-func readOpsiAwrHubs(d *schema.ResourceData, m interface{}) error {
+func readOpsiAwrHubs(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiAwrHubsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OpsiAwrHubsDataSourceCrud struct {
@@ -77,7 +78,7 @@ func (s *OpsiAwrHubsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OpsiAwrHubsDataSourceCrud) Get() error {
+func (s *OpsiAwrHubsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_opsi.ListAwrHubsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -116,7 +117,7 @@ func (s *OpsiAwrHubsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.ListAwrHubs(context.Background(), request)
+	response, err := s.Client.ListAwrHubs(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -125,7 +126,7 @@ func (s *OpsiAwrHubsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListAwrHubs(context.Background(), request)
+		listResponse, err := s.Client.ListAwrHubs(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v65/opsi"
 )
@@ -23,12 +24,12 @@ func OpsiDatabaseInsightDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OpsiDatabaseInsightResource(), fieldMap, readSingularOpsiDatabaseInsight)
 }
 
-func readSingularOpsiDatabaseInsight(d *schema.ResourceData, m interface{}) error {
+func readSingularOpsiDatabaseInsight(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiDatabaseInsightDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OpsiDatabaseInsightDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *OpsiDatabaseInsightDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OpsiDatabaseInsightDataSourceCrud) Get() error {
+func (s *OpsiDatabaseInsightDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_opsi.GetDatabaseInsightRequest{}
 
 	if databaseInsightId, ok := s.D.GetOkExists("database_insight_id"); ok {
@@ -51,7 +52,7 @@ func (s *OpsiDatabaseInsightDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.GetDatabaseInsight(context.Background(), request)
+	response, err := s.Client.GetDatabaseInsight(ctx, request)
 	if err != nil {
 		return err
 	}
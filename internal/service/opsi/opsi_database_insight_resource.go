@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func OpsiDatabaseInsightResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOpsiDatabaseInsight,
-		Read:     readOpsiDatabaseInsight,
-		Update:   updateOpsiDatabaseInsight,
-		Delete:   deleteOpsiDatabaseInsight,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOpsiDatabaseInsight,
+		ReadContext:   readOpsiDatabaseInsight,
+		UpdateContext: updateOpsiDatabaseInsight,
+		DeleteContext: deleteOpsiDatabaseInsight,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -319,37 +320,37 @@ func OpsiDatabaseInsightResource() *schema.Resource {
 	}
 }
 
-func createOpsiDatabaseInsight(d *schema.ResourceData, m interface{}) error {
+func createOpsiDatabaseInsight(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiDatabaseInsightResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readOpsiDatabaseInsight(d *schema.ResourceData, m interface{}) error {
+func readOpsiDatabaseInsight(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiDatabaseInsightResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateOpsiDatabaseInsight(d *schema.ResourceData, m interface{}) error {
+func updateOpsiDatabaseInsight(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiDatabaseInsightResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteOpsiDatabaseInsight(d *schema.ResourceData, m interface{}) error {
+func deleteOpsiDatabaseInsight(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiDatabaseInsightResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type OpsiDatabaseInsightResourceCrud struct {
@@ -388,7 +389,7 @@ func (s *OpsiDatabaseInsightResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *OpsiDatabaseInsightResourceCrud) Create() error {
+func (s *OpsiDatabaseInsightResourceCrud) Create(ctx context.Context) error {
 	request := oci_opsi.CreateDatabaseInsightRequest{}
 	err := s.populateTopLevelPolymorphicCreateDatabaseInsightRequest(&request)
 	if err != nil {
@@ -397,7 +398,7 @@ func (s *OpsiDatabaseInsightResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.CreateDatabaseInsight(context.Background(), request)
+	response, err := s.Client.CreateDatabaseInsight(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -405,7 +406,7 @@ func (s *OpsiDatabaseInsightResourceCrud) Create() error {
 	workId := response.OpcWorkRequestId
 
 	// Wait until it finishes
-	databaseInsightId, err := databaseInsightWaitForWorkRequest(workId, "opsi",
+	databaseInsightId, err := databaseInsightWaitForWorkRequest(ctx, workId, "opsi",
 		oci_opsi.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -420,7 +421,7 @@ func (s *OpsiDatabaseInsightResourceCrud) Create() error {
 			request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 			tmp := s.D.Id()
 			request.DatabaseInsightId = &tmp
-			response, err := s.Client.DisableDatabaseInsight(context.Background(), request)
+			response, err := s.Client.DisableDatabaseInsight(ctx, request)
 			if err != nil {
 				return err
 			}
@@ -428,7 +429,7 @@ func (s *OpsiDatabaseInsightResourceCrud) Create() error {
 			workId := response.OpcWorkRequestId
 
 			// Wait until it finishes
-			databaseInsightId, err := databaseInsightWaitForWorkRequest(workId, "opsi",
+			databaseInsightId, err := databaseInsightWaitForWorkRequest(ctx, workId, "opsi",
 				oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 			if err != nil {
 				return err
@@ -437,14 +438,14 @@ func (s *OpsiDatabaseInsightResourceCrud) Create() error {
 		}
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *OpsiDatabaseInsightResourceCrud) getDatabaseInsightFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *OpsiDatabaseInsightResourceCrud) getDatabaseInsightFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_opsi.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	databaseInsightId, err := databaseInsightWaitForWorkRequest(workId, "opsi",
+	databaseInsightId, err := databaseInsightWaitForWorkRequest(ctx, workId, "opsi",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -452,7 +453,7 @@ func (s *OpsiDatabaseInsightResourceCrud) getDatabaseInsightFromWorkRequest(work
 	}
 	s.D.SetId(*databaseInsightId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func databaseInsightWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -478,7 +479,7 @@ func databaseInsightWorkRequestShouldRetryFunc(timeout time.Duration) func(respo
 	}
 }
 
-func databaseInsightWaitForWorkRequest(wId *string, entityType string, action oci_opsi.ActionTypeEnum,
+func databaseInsightWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_opsi.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_opsi.OperationsInsightsClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "opsi")
 	retryPolicy.ShouldRetryOperation = databaseInsightWorkRequestShouldRetryFunc(timeout)
@@ -497,7 +498,7 @@ func databaseInsightWaitForWorkRequest(wId *string, entityType string, action oc
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_opsi.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -526,14 +527,14 @@ func databaseInsightWaitForWorkRequest(wId *string, entityType string, action oc
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_opsi.OperationStatusFailed || response.Status == oci_opsi.OperationStatusCanceled {
-		return nil, getErrorFromOpsiDatabaseInsightWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromOpsiDatabaseInsightWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromOpsiDatabaseInsightWorkRequest(client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromOpsiDatabaseInsightWorkRequest(ctx context.Context, client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_opsi.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -555,7 +556,7 @@ func getErrorFromOpsiDatabaseInsightWorkRequest(client *oci_opsi.OperationsInsig
 	return workRequestErr
 }
 
-func (s *OpsiDatabaseInsightResourceCrud) Get() error {
+func (s *OpsiDatabaseInsightResourceCrud) Get(ctx context.Context) error {
 	request := oci_opsi.GetDatabaseInsightRequest{}
 
 	tmp := s.D.Id()
@@ -563,7 +564,7 @@ func (s *OpsiDatabaseInsightResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.GetDatabaseInsight(context.Background(), request)
+	response, err := s.Client.GetDatabaseInsight(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -572,11 +573,11 @@ func (s *OpsiDatabaseInsightResourceCrud) Get() error {
 	return nil
 }
 
-func (s *OpsiDatabaseInsightResourceCrud) Update() error {
+func (s *OpsiDatabaseInsightResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -586,7 +587,7 @@ func (s *OpsiDatabaseInsightResourceCrud) Update() error {
 	updateRequest := oci_opsi.ChangePeComanagedDatabaseInsightRequest{}
 	hasChanged := s.populateChangePeComanagedDatabaseInsightRequest(&updateRequest)
 	if hasChanged {
-		err := s.updatePecomanagedDetails(&updateRequest)
+		err := s.updatePecomanagedDetails(ctx, &updateRequest)
 		if err != nil {
 			return err
 		}
@@ -600,14 +601,14 @@ func (s *OpsiDatabaseInsightResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.UpdateDatabaseInsight(context.Background(), request)
+	response, err := s.Client.UpdateDatabaseInsight(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	databaseInsightId, err := databaseInsightWaitForWorkRequest(workId, "opsi",
+	databaseInsightId, err := databaseInsightWaitForWorkRequest(ctx, workId, "opsi",
 		oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -631,7 +632,7 @@ func (s *OpsiDatabaseInsightResourceCrud) Update() error {
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 		tmp := s.D.Id()
 		request.DatabaseInsightId = &tmp
-		response, err := s.Client.DisableDatabaseInsight(context.Background(), request)
+		response, err := s.Client.DisableDatabaseInsight(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -639,7 +640,7 @@ func (s *OpsiDatabaseInsightResourceCrud) Update() error {
 		workId := response.OpcWorkRequestId
 
 		// Wait until it finishes
-		databaseInsightId, err := databaseInsightWaitForWorkRequest(workId, "opsi",
+		databaseInsightId, err := databaseInsightWaitForWorkRequest(ctx, workId, "opsi",
 			oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 		if err != nil {
 			return err
@@ -657,7 +658,7 @@ func (s *OpsiDatabaseInsightResourceCrud) Update() error {
 			return err
 		}
 
-		response, err := s.Client.EnableDatabaseInsight(context.Background(), request)
+		response, err := s.Client.EnableDatabaseInsight(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -665,7 +666,7 @@ func (s *OpsiDatabaseInsightResourceCrud) Update() error {
 		workId := response.OpcWorkRequestId
 
 		// Wait until it finishes
-		databaseInsightId, err := databaseInsightWaitForWorkRequest(workId, "opsi",
+		databaseInsightId, err := databaseInsightWaitForWorkRequest(ctx, workId, "opsi",
 			oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 		if err != nil {
 			return err
@@ -673,17 +674,17 @@ func (s *OpsiDatabaseInsightResourceCrud) Update() error {
 		s.D.SetId(*databaseInsightId)
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *OpsiDatabaseInsightResourceCrud) Delete() error {
+func (s *OpsiDatabaseInsightResourceCrud) Delete(ctx context.Context) error {
 	status, ok := s.D.GetOkExists("status")
 	if ok && oci_opsi.ResourceStatusEnabled == oci_opsi.ResourceStatusEnum(strings.ToUpper(status.(string))) {
 		request := oci_opsi.DisableDatabaseInsightRequest{}
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 		tmp := s.D.Id()
 		request.DatabaseInsightId = &tmp
-		response, err := s.Client.DisableDatabaseInsight(context.Background(), request)
+		response, err := s.Client.DisableDatabaseInsight(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -691,7 +692,7 @@ func (s *OpsiDatabaseInsightResourceCrud) Delete() error {
 		workId := response.OpcWorkRequestId
 
 		// Wait until it finishes
-		_, disableWorkRequestErr := databaseInsightWaitForWorkRequest(workId, "opsi",
+		_, disableWorkRequestErr := databaseInsightWaitForWorkRequest(ctx, workId, "opsi",
 			oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 		if disableWorkRequestErr != nil {
 			return disableWorkRequestErr
@@ -704,14 +705,14 @@ func (s *OpsiDatabaseInsightResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.DeleteDatabaseInsight(context.Background(), request)
+	response, err := s.Client.DeleteDatabaseInsight(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := databaseInsightWaitForWorkRequest(workId, "opsi",
+	_, delWorkRequestErr := databaseInsightWaitForWorkRequest(ctx, workId, "opsi",
 		oci_opsi.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -1469,7 +1470,7 @@ func (s *OpsiDatabaseInsightResourceCrud) populateTopLevelPolymorphicEnableDatab
 	return nil
 }
 
-func (s *OpsiDatabaseInsightResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *OpsiDatabaseInsightResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_opsi.ChangeDatabaseInsightCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -1480,26 +1481,26 @@ func (s *OpsiDatabaseInsightResourceCrud) updateCompartment(compartment interfac
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.ChangeDatabaseInsightCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeDatabaseInsightCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDatabaseInsightFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getDatabaseInsightFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *OpsiDatabaseInsightResourceCrud) updatePecomanagedDetails(updateRequest *oci_opsi.ChangePeComanagedDatabaseInsightRequest) error {
+func (s *OpsiDatabaseInsightResourceCrud) updatePecomanagedDetails(ctx context.Context, updateRequest *oci_opsi.ChangePeComanagedDatabaseInsightRequest) error {
 	idTmp := s.D.Id()
 	updateRequest.DatabaseInsightId = &idTmp
 
 	updateRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.ChangePeComanagedDatabaseInsight(context.Background(), *updateRequest)
+	response, err := s.Client.ChangePeComanagedDatabaseInsight(ctx, *updateRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getDatabaseInsightFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getDatabaseInsightFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v65/opsi"
 )
 
 func OpsiDatabaseInsightsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOpsiDatabaseInsights,
+		ReadContext: readOpsiDatabaseInsights,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -95,12 +96,12 @@ func OpsiDatabaseInsightsDataSource() *schema.Resource {
 	}
 }
 
-func readOpsiDatabaseInsights(d *schema.ResourceData, m interface{}) error {
+func readOpsiDatabaseInsights(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiDatabaseInsightsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OpsiDatabaseInsightsDataSourceCrud struct {
@@ -113,7 +114,7 @@ func (s *OpsiDatabaseInsightsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OpsiDatabaseInsightsDataSourceCrud) Get() error {
+func (s *OpsiDatabaseInsightsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_opsi.ListDatabaseInsightsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -212,7 +213,7 @@ func (s *OpsiDatabaseInsightsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.ListDatabaseInsights(context.Background(), request)
+	response, err := s.Client.ListDatabaseInsights(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -221,7 +222,7 @@ func (s *OpsiDatabaseInsightsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDatabaseInsights(context.Background(), request)
+		listResponse, err := s.Client.ListDatabaseInsights(ctx, request)
 		if err != nil {
 			return err
 		}
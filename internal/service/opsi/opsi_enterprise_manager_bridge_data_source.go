@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v65/opsi"
 )
@@ -22,12 +23,12 @@ func OpsiEnterpriseManagerBridgeDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OpsiEnterpriseManagerBridgeResource(), fieldMap, readSingularOpsiEnterpriseManagerBridge)
 }
 
-func readSingularOpsiEnterpriseManagerBridge(d *schema.ResourceData, m interface{}) error {
+func readSingularOpsiEnterpriseManagerBridge(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiEnterpriseManagerBridgeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OpsiEnterpriseManagerBridgeDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *OpsiEnterpriseManagerBridgeDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OpsiEnterpriseManagerBridgeDataSourceCrud) Get() error {
+func (s *OpsiEnterpriseManagerBridgeDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_opsi.GetEnterpriseManagerBridgeRequest{}
 
 	if enterpriseManagerBridgeId, ok := s.D.GetOkExists("enterprise_manager_bridge_id"); ok {
@@ -50,7 +51,7 @@ func (s *OpsiEnterpriseManagerBridgeDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.GetEnterpriseManagerBridge(context.Background(), request)
+	response, err := s.Client.GetEnterpriseManagerBridge(ctx, request)
 	if err != nil {
 		return err
 	}
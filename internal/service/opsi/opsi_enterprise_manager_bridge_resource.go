@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,11 +25,11 @@ func OpsiEnterpriseManagerBridgeResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOpsiEnterpriseManagerBridge,
-		Read:     readOpsiEnterpriseManagerBridge,
-		Update:   updateOpsiEnterpriseManagerBridge,
-		Delete:   deleteOpsiEnterpriseManagerBridge,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOpsiEnterpriseManagerBridge,
+		ReadContext:   readOpsiEnterpriseManagerBridge,
+		UpdateContext: updateOpsiEnterpriseManagerBridge,
+		DeleteContext: deleteOpsiEnterpriseManagerBridge,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -99,37 +100,37 @@ func OpsiEnterpriseManagerBridgeResource() *schema.Resource {
 	}
 }
 
-func createOpsiEnterpriseManagerBridge(d *schema.ResourceData, m interface{}) error {
+func createOpsiEnterpriseManagerBridge(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiEnterpriseManagerBridgeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readOpsiEnterpriseManagerBridge(d *schema.ResourceData, m interface{}) error {
+func readOpsiEnterpriseManagerBridge(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiEnterpriseManagerBridgeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateOpsiEnterpriseManagerBridge(d *schema.ResourceData, m interface{}) error {
+func updateOpsiEnterpriseManagerBridge(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiEnterpriseManagerBridgeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteOpsiEnterpriseManagerBridge(d *schema.ResourceData, m interface{}) error {
+func deleteOpsiEnterpriseManagerBridge(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiEnterpriseManagerBridgeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type OpsiEnterpriseManagerBridgeResourceCrud struct {
@@ -168,7 +169,7 @@ func (s *OpsiEnterpriseManagerBridgeResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *OpsiEnterpriseManagerBridgeResourceCrud) Create() error {
+func (s *OpsiEnterpriseManagerBridgeResourceCrud) Create(ctx context.Context) error {
 	request := oci_opsi.CreateEnterpriseManagerBridgeRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -205,20 +206,20 @@ func (s *OpsiEnterpriseManagerBridgeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.CreateEnterpriseManagerBridge(context.Background(), request)
+	response, err := s.Client.CreateEnterpriseManagerBridge(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getEnterpriseManagerBridgeFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getEnterpriseManagerBridgeFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *OpsiEnterpriseManagerBridgeResourceCrud) getEnterpriseManagerBridgeFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *OpsiEnterpriseManagerBridgeResourceCrud) getEnterpriseManagerBridgeFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_opsi.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	enterpriseManagerBridgeId, err := enterpriseManagerBridgeWaitForWorkRequest(workId, "opsi",
+	enterpriseManagerBridgeId, err := enterpriseManagerBridgeWaitForWorkRequest(ctx, workId, "opsi",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -226,7 +227,7 @@ func (s *OpsiEnterpriseManagerBridgeResourceCrud) getEnterpriseManagerBridgeFrom
 	}
 	s.D.SetId(*enterpriseManagerBridgeId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func enterpriseManagerBridgeWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -252,7 +253,7 @@ func enterpriseManagerBridgeWorkRequestShouldRetryFunc(timeout time.Duration) fu
 	}
 }
 
-func enterpriseManagerBridgeWaitForWorkRequest(wId *string, entityType string, action oci_opsi.ActionTypeEnum,
+func enterpriseManagerBridgeWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_opsi.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_opsi.OperationsInsightsClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "opsi")
 	retryPolicy.ShouldRetryOperation = enterpriseManagerBridgeWorkRequestShouldRetryFunc(timeout)
@@ -271,7 +272,7 @@ func enterpriseManagerBridgeWaitForWorkRequest(wId *string, entityType string, a
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_opsi.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -300,14 +301,14 @@ func enterpriseManagerBridgeWaitForWorkRequest(wId *string, entityType string, a
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_opsi.OperationStatusFailed || response.Status == oci_opsi.OperationStatusCanceled {
-		return nil, getErrorFromOpsiEnterpriseManagerBridgeWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromOpsiEnterpriseManagerBridgeWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromOpsiEnterpriseManagerBridgeWorkRequest(client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromOpsiEnterpriseManagerBridgeWorkRequest(ctx context.Context, client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_opsi.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -329,7 +330,7 @@ func getErrorFromOpsiEnterpriseManagerBridgeWorkRequest(client *oci_opsi.Operati
 	return workRequestErr
 }
 
-func (s *OpsiEnterpriseManagerBridgeResourceCrud) Get() error {
+func (s *OpsiEnterpriseManagerBridgeResourceCrud) Get(ctx context.Context) error {
 	request := oci_opsi.GetEnterpriseManagerBridgeRequest{}
 
 	tmp := s.D.Id()
@@ -337,7 +338,7 @@ func (s *OpsiEnterpriseManagerBridgeResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.GetEnterpriseManagerBridge(context.Background(), request)
+	response, err := s.Client.GetEnterpriseManagerBridge(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -346,11 +347,11 @@ func (s *OpsiEnterpriseManagerBridgeResourceCrud) Get() error {
 	return nil
 }
 
-func (s *OpsiEnterpriseManagerBridgeResourceCrud) Update() error {
+func (s *OpsiEnterpriseManagerBridgeResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -385,16 +386,16 @@ func (s *OpsiEnterpriseManagerBridgeResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.UpdateEnterpriseManagerBridge(context.Background(), request)
+	response, err := s.Client.UpdateEnterpriseManagerBridge(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getEnterpriseManagerBridgeFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getEnterpriseManagerBridgeFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *OpsiEnterpriseManagerBridgeResourceCrud) Delete() error {
+func (s *OpsiEnterpriseManagerBridgeResourceCrud) Delete(ctx context.Context) error {
 	request := oci_opsi.DeleteEnterpriseManagerBridgeRequest{}
 
 	tmp := s.D.Id()
@@ -402,14 +403,14 @@ func (s *OpsiEnterpriseManagerBridgeResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.DeleteEnterpriseManagerBridge(context.Background(), request)
+	response, err := s.Client.DeleteEnterpriseManagerBridge(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := enterpriseManagerBridgeWaitForWorkRequest(workId, "opsi",
+	_, delWorkRequestErr := enterpriseManagerBridgeWaitForWorkRequest(ctx, workId, "opsi",
 		oci_opsi.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -520,7 +521,7 @@ func EnterpriseManagerBridgeSummaryToMap(obj oci_opsi.EnterpriseManagerBridgeSum
 	return result
 }
 
-func (s *OpsiEnterpriseManagerBridgeResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *OpsiEnterpriseManagerBridgeResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_opsi.ChangeEnterpriseManagerBridgeCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -531,11 +532,11 @@ func (s *OpsiEnterpriseManagerBridgeResourceCrud) updateCompartment(compartment
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.ChangeEnterpriseManagerBridgeCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeEnterpriseManagerBridgeCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getEnterpriseManagerBridgeFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getEnterpriseManagerBridgeFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
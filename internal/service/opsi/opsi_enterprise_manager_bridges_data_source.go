@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v65/opsi"
 )
 
 func OpsiEnterpriseManagerBridgesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOpsiEnterpriseManagerBridges,
+		ReadContext: readOpsiEnterpriseManagerBridges,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -59,12 +60,12 @@ func OpsiEnterpriseManagerBridgesDataSource() *schema.Resource {
 	}
 }
 
-func readOpsiEnterpriseManagerBridges(d *schema.ResourceData, m interface{}) error {
+func readOpsiEnterpriseManagerBridges(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiEnterpriseManagerBridgesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OpsiEnterpriseManagerBridgesDataSourceCrud struct {
@@ -77,7 +78,7 @@ func (s *OpsiEnterpriseManagerBridgesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OpsiEnterpriseManagerBridgesDataSourceCrud) Get() error {
+func (s *OpsiEnterpriseManagerBridgesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_opsi.ListEnterpriseManagerBridgesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -115,7 +116,7 @@ func (s *OpsiEnterpriseManagerBridgesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.ListEnterpriseManagerBridges(context.Background(), request)
+	response, err := s.Client.ListEnterpriseManagerBridges(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -124,7 +125,7 @@ func (s *OpsiEnterpriseManagerBridgesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListEnterpriseManagerBridges(context.Background(), request)
+		listResponse, err := s.Client.ListEnterpriseManagerBridges(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v65/opsi"
 )
@@ -23,12 +24,12 @@ func OpsiExadataInsightDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OpsiExadataInsightResource(), fieldMap, readSingularOpsiExadataInsight)
 }
 
-func readSingularOpsiExadataInsight(d *schema.ResourceData, m interface{}) error {
+func readSingularOpsiExadataInsight(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiExadataInsightDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OpsiExadataInsightDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *OpsiExadataInsightDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OpsiExadataInsightDataSourceCrud) Get() error {
+func (s *OpsiExadataInsightDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_opsi.GetExadataInsightRequest{}
 
 	if exadataInsightId, ok := s.D.GetOkExists("exadata_insight_id"); ok {
@@ -51,7 +52,7 @@ func (s *OpsiExadataInsightDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.GetExadataInsight(context.Background(), request)
+	response, err := s.Client.GetExadataInsight(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func OpsiExadataInsightResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOpsiExadataInsight,
-		Read:     readOpsiExadataInsight,
-		Update:   updateOpsiExadataInsight,
-		Delete:   deleteOpsiExadataInsight,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOpsiExadataInsight,
+		ReadContext:   readOpsiExadataInsight,
+		UpdateContext: updateOpsiExadataInsight,
+		DeleteContext: deleteOpsiExadataInsight,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -144,37 +145,37 @@ func OpsiExadataInsightResource() *schema.Resource {
 	}
 }
 
-func createOpsiExadataInsight(d *schema.ResourceData, m interface{}) error {
+func createOpsiExadataInsight(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiExadataInsightResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readOpsiExadataInsight(d *schema.ResourceData, m interface{}) error {
+func readOpsiExadataInsight(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiExadataInsightResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateOpsiExadataInsight(d *schema.ResourceData, m interface{}) error {
+func updateOpsiExadataInsight(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiExadataInsightResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteOpsiExadataInsight(d *schema.ResourceData, m interface{}) error {
+func deleteOpsiExadataInsight(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiExadataInsightResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type OpsiExadataInsightResourceCrud struct {
@@ -213,7 +214,7 @@ func (s *OpsiExadataInsightResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *OpsiExadataInsightResourceCrud) Create() error {
+func (s *OpsiExadataInsightResourceCrud) Create(ctx context.Context) error {
 	request := oci_opsi.CreateExadataInsightRequest{}
 	err := s.populateTopLevelPolymorphicCreateExadataInsightRequest(&request)
 	if err != nil {
@@ -222,7 +223,7 @@ func (s *OpsiExadataInsightResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.CreateExadataInsight(context.Background(), request)
+	response, err := s.Client.CreateExadataInsight(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -230,7 +231,7 @@ func (s *OpsiExadataInsightResourceCrud) Create() error {
 	workId := response.OpcWorkRequestId
 
 	// Wait until it finishes
-	exadataInsightId, err := exadataInsightWaitForWorkRequest(workId, "opsi",
+	exadataInsightId, err := exadataInsightWaitForWorkRequest(ctx, workId, "opsi",
 		oci_opsi.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -245,7 +246,7 @@ func (s *OpsiExadataInsightResourceCrud) Create() error {
 			request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 			tmp := s.D.Id()
 			request.ExadataInsightId = &tmp
-			response, err := s.Client.DisableExadataInsight(context.Background(), request)
+			response, err := s.Client.DisableExadataInsight(ctx, request)
 			if err != nil {
 				return err
 			}
@@ -253,7 +254,7 @@ func (s *OpsiExadataInsightResourceCrud) Create() error {
 			workId := response.OpcWorkRequestId
 
 			// Wait until it finishes
-			exadataInsightId, err := exadataInsightWaitForWorkRequest(workId, "opsi",
+			exadataInsightId, err := exadataInsightWaitForWorkRequest(ctx, workId, "opsi",
 				oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 			if err != nil {
 				return err
@@ -262,14 +263,14 @@ func (s *OpsiExadataInsightResourceCrud) Create() error {
 		}
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *OpsiExadataInsightResourceCrud) getExadataInsightFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *OpsiExadataInsightResourceCrud) getExadataInsightFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_opsi.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	exadataInsightId, err := exadataInsightWaitForWorkRequest(workId, "opsi",
+	exadataInsightId, err := exadataInsightWaitForWorkRequest(ctx, workId, "opsi",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -277,7 +278,7 @@ func (s *OpsiExadataInsightResourceCrud) getExadataInsightFromWorkRequest(workId
 	}
 	s.D.SetId(*exadataInsightId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func exadataInsightWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -303,7 +304,7 @@ func exadataInsightWorkRequestShouldRetryFunc(timeout time.Duration) func(respon
 	}
 }
 
-func exadataInsightWaitForWorkRequest(wId *string, entityType string, action oci_opsi.ActionTypeEnum,
+func exadataInsightWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_opsi.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_opsi.OperationsInsightsClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "opsi")
 	retryPolicy.ShouldRetryOperation = exadataInsightWorkRequestShouldRetryFunc(timeout)
@@ -322,7 +323,7 @@ func exadataInsightWaitForWorkRequest(wId *string, entityType string, action oci
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_opsi.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -351,14 +352,14 @@ func exadataInsightWaitForWorkRequest(wId *string, entityType string, action oci
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_opsi.OperationStatusFailed || response.Status == oci_opsi.OperationStatusCanceled {
-		return nil, getErrorFromOpsiExadataInsightWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromOpsiExadataInsightWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromOpsiExadataInsightWorkRequest(client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromOpsiExadataInsightWorkRequest(ctx context.Context, client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_opsi.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -380,7 +381,7 @@ func getErrorFromOpsiExadataInsightWorkRequest(client *oci_opsi.OperationsInsigh
 	return workRequestErr
 }
 
-func (s *OpsiExadataInsightResourceCrud) Get() error {
+func (s *OpsiExadataInsightResourceCrud) Get(ctx context.Context) error {
 	request := oci_opsi.GetExadataInsightRequest{}
 
 	tmp := s.D.Id()
@@ -388,7 +389,7 @@ func (s *OpsiExadataInsightResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.GetExadataInsight(context.Background(), request)
+	response, err := s.Client.GetExadataInsight(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -397,11 +398,11 @@ func (s *OpsiExadataInsightResourceCrud) Get() error {
 	return nil
 }
 
-func (s *OpsiExadataInsightResourceCrud) Update() error {
+func (s *OpsiExadataInsightResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -415,14 +416,14 @@ func (s *OpsiExadataInsightResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.UpdateExadataInsight(context.Background(), request)
+	response, err := s.Client.UpdateExadataInsight(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	exadataInsightId, err := exadataInsightWaitForWorkRequest(workId, "opsi",
+	exadataInsightId, err := exadataInsightWaitForWorkRequest(ctx, workId, "opsi",
 		oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -446,7 +447,7 @@ func (s *OpsiExadataInsightResourceCrud) Update() error {
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 		tmp := s.D.Id()
 		request.ExadataInsightId = &tmp
-		response, err := s.Client.DisableExadataInsight(context.Background(), request)
+		response, err := s.Client.DisableExadataInsight(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -454,7 +455,7 @@ func (s *OpsiExadataInsightResourceCrud) Update() error {
 		workId := response.OpcWorkRequestId
 
 		// Wait until it finishes
-		exadataInsightId, err := exadataInsightWaitForWorkRequest(workId, "opsi",
+		exadataInsightId, err := exadataInsightWaitForWorkRequest(ctx, workId, "opsi",
 			oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 		if err != nil {
 			return err
@@ -472,7 +473,7 @@ func (s *OpsiExadataInsightResourceCrud) Update() error {
 			return err
 		}
 
-		response, err := s.Client.EnableExadataInsight(context.Background(), request)
+		response, err := s.Client.EnableExadataInsight(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -480,7 +481,7 @@ func (s *OpsiExadataInsightResourceCrud) Update() error {
 		workId := response.OpcWorkRequestId
 
 		// Wait until it finishes
-		exadataInsightId, err := exadataInsightWaitForWorkRequest(workId, "opsi",
+		exadataInsightId, err := exadataInsightWaitForWorkRequest(ctx, workId, "opsi",
 			oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 		if err != nil {
 			return err
@@ -488,17 +489,17 @@ func (s *OpsiExadataInsightResourceCrud) Update() error {
 		s.D.SetId(*exadataInsightId)
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *OpsiExadataInsightResourceCrud) Delete() error {
+func (s *OpsiExadataInsightResourceCrud) Delete(ctx context.Context) error {
 	status, ok := s.D.GetOkExists("status")
 	if ok && oci_opsi.ResourceStatusEnabled == oci_opsi.ResourceStatusEnum(strings.ToUpper(status.(string))) {
 		request := oci_opsi.DisableExadataInsightRequest{}
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 		tmp := s.D.Id()
 		request.ExadataInsightId = &tmp
-		response, err := s.Client.DisableExadataInsight(context.Background(), request)
+		response, err := s.Client.DisableExadataInsight(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -506,7 +507,7 @@ func (s *OpsiExadataInsightResourceCrud) Delete() error {
 		workId := response.OpcWorkRequestId
 
 		// Wait until it finishes
-		_, disableWorkRequestErr := exadataInsightWaitForWorkRequest(workId, "opsi",
+		_, disableWorkRequestErr := exadataInsightWaitForWorkRequest(ctx, workId, "opsi",
 			oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 		if disableWorkRequestErr != nil {
 			return disableWorkRequestErr
@@ -520,14 +521,14 @@ func (s *OpsiExadataInsightResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.DeleteExadataInsight(context.Background(), request)
+	response, err := s.Client.DeleteExadataInsight(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := exadataInsightWaitForWorkRequest(workId, "opsi",
+	_, delWorkRequestErr := exadataInsightWaitForWorkRequest(ctx, workId, "opsi",
 		oci_opsi.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -857,7 +858,7 @@ func (s *OpsiExadataInsightResourceCrud) populateTopLevelPolymorphicEnableExadat
 	return nil
 }
 
-func (s *OpsiExadataInsightResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *OpsiExadataInsightResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_opsi.ChangeExadataInsightCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -868,11 +869,11 @@ func (s *OpsiExadataInsightResourceCrud) updateCompartment(compartment interface
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.ChangeExadataInsightCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeExadataInsightCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getExadataInsightFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getExadataInsightFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
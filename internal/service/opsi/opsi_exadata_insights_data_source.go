@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v65/opsi"
 )
 
 func OpsiExadataInsightsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOpsiExadataInsights,
+		ReadContext: readOpsiExadataInsights,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -73,12 +74,12 @@ func OpsiExadataInsightsDataSource() *schema.Resource {
 	}
 }
 
-func readOpsiExadataInsights(d *schema.ResourceData, m interface{}) error {
+func readOpsiExadataInsights(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiExadataInsightsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OpsiExadataInsightsDataSourceCrud struct {
@@ -91,7 +92,7 @@ func (s *OpsiExadataInsightsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OpsiExadataInsightsDataSourceCrud) Get() error {
+func (s *OpsiExadataInsightsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_opsi.ListExadataInsightsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -154,7 +155,7 @@ func (s *OpsiExadataInsightsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.ListExadataInsights(context.Background(), request)
+	response, err := s.Client.ListExadataInsights(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -163,7 +164,7 @@ func (s *OpsiExadataInsightsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListExadataInsights(context.Background(), request)
+		listResponse, err := s.Client.ListExadataInsights(ctx, request)
 		if err != nil {
 			return err
 		}
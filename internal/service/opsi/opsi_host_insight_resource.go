@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func OpsiHostInsightResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOpsiHostInsight,
-		Read:     readOpsiHostInsight,
-		Update:   updateOpsiHostInsight,
-		Delete:   deleteOpsiHostInsight,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOpsiHostInsight,
+		ReadContext:   readOpsiHostInsight,
+		UpdateContext: updateOpsiHostInsight,
+		DeleteContext: deleteOpsiHostInsight,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -170,37 +171,37 @@ func OpsiHostInsightResource() *schema.Resource {
 	}
 }
 
-func createOpsiHostInsight(d *schema.ResourceData, m interface{}) error {
+func createOpsiHostInsight(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiHostInsightResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readOpsiHostInsight(d *schema.ResourceData, m interface{}) error {
+func readOpsiHostInsight(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiHostInsightResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateOpsiHostInsight(d *schema.ResourceData, m interface{}) error {
+func updateOpsiHostInsight(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiHostInsightResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteOpsiHostInsight(d *schema.ResourceData, m interface{}) error {
+func deleteOpsiHostInsight(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiHostInsightResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type OpsiHostInsightResourceCrud struct {
@@ -239,7 +240,7 @@ func (s *OpsiHostInsightResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *OpsiHostInsightResourceCrud) Create() error {
+func (s *OpsiHostInsightResourceCrud) Create(ctx context.Context) error {
 	request := oci_opsi.CreateHostInsightRequest{}
 	err := s.populateTopLevelPolymorphicCreateHostInsightRequest(&request)
 	if err != nil {
@@ -248,7 +249,7 @@ func (s *OpsiHostInsightResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.CreateHostInsight(context.Background(), request)
+	response, err := s.Client.CreateHostInsight(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -256,7 +257,7 @@ func (s *OpsiHostInsightResourceCrud) Create() error {
 	workId := response.OpcWorkRequestId
 
 	// Wait until it finishes
-	hostInsightId, err := hostInsightWaitForWorkRequest(workId, "opsi",
+	hostInsightId, err := hostInsightWaitForWorkRequest(ctx, workId, "opsi",
 		oci_opsi.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -271,7 +272,7 @@ func (s *OpsiHostInsightResourceCrud) Create() error {
 			request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 			tmp := s.D.Id()
 			request.HostInsightId = &tmp
-			response, err := s.Client.DisableHostInsight(context.Background(), request)
+			response, err := s.Client.DisableHostInsight(ctx, request)
 			if err != nil {
 				return err
 			}
@@ -279,7 +280,7 @@ func (s *OpsiHostInsightResourceCrud) Create() error {
 			workId := response.OpcWorkRequestId
 
 			// Wait until it finishes
-			hostInsightId, err := hostInsightWaitForWorkRequest(workId, "opsi",
+			hostInsightId, err := hostInsightWaitForWorkRequest(ctx, workId, "opsi",
 				oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 			if err != nil {
 				return err
@@ -288,14 +289,14 @@ func (s *OpsiHostInsightResourceCrud) Create() error {
 		}
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *OpsiHostInsightResourceCrud) getHostInsightFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *OpsiHostInsightResourceCrud) getHostInsightFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_opsi.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	hostInsightId, err := hostInsightWaitForWorkRequest(workId, "opsi",
+	hostInsightId, err := hostInsightWaitForWorkRequest(ctx, workId, "opsi",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -303,7 +304,7 @@ func (s *OpsiHostInsightResourceCrud) getHostInsightFromWorkRequest(workId *stri
 	}
 	s.D.SetId(*hostInsightId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func hostInsightWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -329,7 +330,7 @@ func hostInsightWorkRequestShouldRetryFunc(timeout time.Duration) func(response
 	}
 }
 
-func hostInsightWaitForWorkRequest(wId *string, entityType string, action oci_opsi.ActionTypeEnum,
+func hostInsightWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_opsi.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_opsi.OperationsInsightsClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "opsi")
 	retryPolicy.ShouldRetryOperation = hostInsightWorkRequestShouldRetryFunc(timeout)
@@ -348,7 +349,7 @@ func hostInsightWaitForWorkRequest(wId *string, entityType string, action oci_op
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_opsi.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -377,14 +378,14 @@ func hostInsightWaitForWorkRequest(wId *string, entityType string, action oci_op
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_opsi.OperationStatusFailed || response.Status == oci_opsi.OperationStatusCanceled {
-		return nil, getErrorFromOpsiHostInsightWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromOpsiHostInsightWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromOpsiHostInsightWorkRequest(client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromOpsiHostInsightWorkRequest(ctx context.Context, client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_opsi.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -406,7 +407,7 @@ func getErrorFromOpsiHostInsightWorkRequest(client *oci_opsi.OperationsInsightsC
 	return workRequestErr
 }
 
-func (s *OpsiHostInsightResourceCrud) Get() error {
+func (s *OpsiHostInsightResourceCrud) Get(ctx context.Context) error {
 	request := oci_opsi.GetHostInsightRequest{}
 
 	tmp := s.D.Id()
@@ -414,7 +415,7 @@ func (s *OpsiHostInsightResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.GetHostInsight(context.Background(), request)
+	response, err := s.Client.GetHostInsight(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -423,11 +424,11 @@ func (s *OpsiHostInsightResourceCrud) Get() error {
 	return nil
 }
 
-func (s *OpsiHostInsightResourceCrud) Update() error {
+func (s *OpsiHostInsightResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -441,7 +442,7 @@ func (s *OpsiHostInsightResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.UpdateHostInsight(context.Background(), request)
+	response, err := s.Client.UpdateHostInsight(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -449,7 +450,7 @@ func (s *OpsiHostInsightResourceCrud) Update() error {
 	workId := response.OpcWorkRequestId
 
 	// Wait until it finishes
-	hostInsightId, err := hostInsightWaitForWorkRequest(workId, "opsi",
+	hostInsightId, err := hostInsightWaitForWorkRequest(ctx, workId, "opsi",
 		oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -473,7 +474,7 @@ func (s *OpsiHostInsightResourceCrud) Update() error {
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 		tmp := s.D.Id()
 		request.HostInsightId = &tmp
-		response, err := s.Client.DisableHostInsight(context.Background(), request)
+		response, err := s.Client.DisableHostInsight(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -481,7 +482,7 @@ func (s *OpsiHostInsightResourceCrud) Update() error {
 		workId := response.OpcWorkRequestId
 
 		// Wait until it finishes
-		hostInsightId, err := hostInsightWaitForWorkRequest(workId, "opsi",
+		hostInsightId, err := hostInsightWaitForWorkRequest(ctx, workId, "opsi",
 			oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 		if err != nil {
 			return err
@@ -499,7 +500,7 @@ func (s *OpsiHostInsightResourceCrud) Update() error {
 			return err
 		}
 
-		response, err := s.Client.EnableHostInsight(context.Background(), request)
+		response, err := s.Client.EnableHostInsight(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -507,7 +508,7 @@ func (s *OpsiHostInsightResourceCrud) Update() error {
 		workId := response.OpcWorkRequestId
 
 		// Wait until it finishes
-		hostInsightId, err := hostInsightWaitForWorkRequest(workId, "opsi",
+		hostInsightId, err := hostInsightWaitForWorkRequest(ctx, workId, "opsi",
 			oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 		if err != nil {
 			return err
@@ -515,10 +516,10 @@ func (s *OpsiHostInsightResourceCrud) Update() error {
 		s.D.SetId(*hostInsightId)
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *OpsiHostInsightResourceCrud) Delete() error {
+func (s *OpsiHostInsightResourceCrud) Delete(ctx context.Context) error {
 
 	status, ok := s.D.GetOkExists("status")
 	if ok && oci_opsi.ResourceStatusEnabled == oci_opsi.ResourceStatusEnum(strings.ToUpper(status.(string))) {
@@ -526,7 +527,7 @@ func (s *OpsiHostInsightResourceCrud) Delete() error {
 		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 		tmp := s.D.Id()
 		request.HostInsightId = &tmp
-		response, err := s.Client.DisableHostInsight(context.Background(), request)
+		response, err := s.Client.DisableHostInsight(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -534,7 +535,7 @@ func (s *OpsiHostInsightResourceCrud) Delete() error {
 		workId := response.OpcWorkRequestId
 
 		// Wait until it finishes
-		_, disableWorkRequestErr := hostInsightWaitForWorkRequest(workId, "opsi",
+		_, disableWorkRequestErr := hostInsightWaitForWorkRequest(ctx, workId, "opsi",
 			oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
 		if disableWorkRequestErr != nil {
 			return disableWorkRequestErr
@@ -549,14 +550,14 @@ func (s *OpsiHostInsightResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.DeleteHostInsight(context.Background(), request)
+	response, err := s.Client.DeleteHostInsight(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := hostInsightWaitForWorkRequest(workId, "opsi",
+	_, delWorkRequestErr := hostInsightWaitForWorkRequest(ctx, workId, "opsi",
 		oci_opsi.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -1176,7 +1177,7 @@ func (s *OpsiHostInsightResourceCrud) populateTopLevelPolymorphicEnableHostInsig
 	return nil
 }
 
-func (s *OpsiHostInsightResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *OpsiHostInsightResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_opsi.ChangeHostInsightCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -1187,11 +1188,11 @@ func (s *OpsiHostInsightResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.ChangeHostInsightCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeHostInsightCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getHostInsightFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getHostInsightFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
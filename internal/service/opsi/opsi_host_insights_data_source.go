@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v65/opsi"
 )
 
 func OpsiHostInsightsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOpsiHostInsights,
+		ReadContext: readOpsiHostInsights,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -77,12 +78,12 @@ func OpsiHostInsightsDataSource() *schema.Resource {
 	}
 }
 
-func readOpsiHostInsights(d *schema.ResourceData, m interface{}) error {
+func readOpsiHostInsights(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiHostInsightsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OpsiHostInsightsDataSourceCrud struct {
@@ -95,7 +96,7 @@ func (s *OpsiHostInsightsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OpsiHostInsightsDataSourceCrud) Get() error {
+func (s *OpsiHostInsightsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_opsi.ListHostInsightsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -163,7 +164,7 @@ func (s *OpsiHostInsightsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.ListHostInsights(context.Background(), request)
+	response, err := s.Client.ListHostInsights(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -172,7 +173,7 @@ func (s *OpsiHostInsightsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListHostInsights(context.Background(), request)
+		listResponse, err := s.Client.ListHostInsights(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -16,7 +16,7 @@ import (
 
 func OpsiImportableAgentEntitiesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOpsiImportableAgentEntity,
+		ReadContext: readSingularOpsiImportableAgentEntity,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -60,12 +60,12 @@ func OpsiImportableAgentEntitiesDataSource() *schema.Resource {
 	}
 }
 
-func readOpsiImportableAgentEntities(d *schema.ResourceData, m interface{}) error {
+func readOpsiImportableAgentEntities(ctx context.Context, d *schema.ResourceData, m interface{}) error {
 	sync := &OpsiImportableAgentEntitiesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ReadResource(ctx, sync)
 }
 
 type OpsiImportableAgentEntitiesDataSourceCrud struct {
@@ -78,7 +78,7 @@ func (s *OpsiImportableAgentEntitiesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OpsiImportableAgentEntitiesDataSourceCrud) Get() error {
+func (s *OpsiImportableAgentEntitiesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_opsi.ListImportableAgentEntitiesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -88,7 +88,7 @@ func (s *OpsiImportableAgentEntitiesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.ListImportableAgentEntities(context.Background(), request)
+	response, err := s.Client.ListImportableAgentEntities(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -97,7 +97,7 @@ func (s *OpsiImportableAgentEntitiesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListImportableAgentEntities(context.Background(), request)
+		listResponse, err := s.Client.ListImportableAgentEntities(ctx, request)
 		if err != nil {
 			return err
 		}
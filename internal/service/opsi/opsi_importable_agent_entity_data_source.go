@@ -6,6 +6,7 @@ package opsi
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v65/opsi"
 
@@ -15,7 +16,7 @@ import (
 
 func OpsiImportableAgentEntityDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOpsiImportableAgentEntity,
+		ReadContext: readSingularOpsiImportableAgentEntity,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -59,12 +60,12 @@ func OpsiImportableAgentEntityDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOpsiImportableAgentEntity(d *schema.ResourceData, m interface{}) error {
+func readSingularOpsiImportableAgentEntity(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiImportableAgentEntityDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OpsiImportableAgentEntityDataSourceCrud struct {
@@ -77,7 +78,7 @@ func (s *OpsiImportableAgentEntityDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OpsiImportableAgentEntityDataSourceCrud) Get() error {
+func (s *OpsiImportableAgentEntityDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_opsi.ListImportableAgentEntitiesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -87,7 +88,7 @@ func (s *OpsiImportableAgentEntityDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.ListImportableAgentEntities(context.Background(), request)
+	response, err := s.Client.ListImportableAgentEntities(ctx, request)
 	if err != nil {
 		return err
 	}
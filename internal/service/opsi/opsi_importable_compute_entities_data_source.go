@@ -16,7 +16,7 @@ import (
 
 func OpsiImportableComputeEntitiesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOpsiImportableComputeEntity,
+		ReadContext: readSingularOpsiImportableComputeEntity,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -64,12 +64,12 @@ func OpsiImportableComputeEntitiesDataSource() *schema.Resource {
 	}
 }
 
-func readOpsiImportableComputeEntities(d *schema.ResourceData, m interface{}) error {
+func readOpsiImportableComputeEntities(ctx context.Context, d *schema.ResourceData, m interface{}) error {
 	sync := &OpsiImportableComputeEntitiesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.ReadResource(ctx, sync)
 }
 
 type OpsiImportableComputeEntitiesDataSourceCrud struct {
@@ -82,7 +82,7 @@ func (s *OpsiImportableComputeEntitiesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OpsiImportableComputeEntitiesDataSourceCrud) Get() error {
+func (s *OpsiImportableComputeEntitiesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_opsi.ListImportableComputeEntitiesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -92,7 +92,7 @@ func (s *OpsiImportableComputeEntitiesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.ListImportableComputeEntities(context.Background(), request)
+	response, err := s.Client.ListImportableComputeEntities(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -101,7 +101,7 @@ func (s *OpsiImportableComputeEntitiesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListImportableComputeEntities(context.Background(), request)
+		listResponse, err := s.Client.ListImportableComputeEntities(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v65/opsi"
 
@@ -16,7 +17,7 @@ import (
 
 func OpsiImportableComputeEntityDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOpsiImportableComputeEntity,
+		ReadContext: readSingularOpsiImportableComputeEntity,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -64,12 +65,12 @@ func OpsiImportableComputeEntityDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOpsiImportableComputeEntity(d *schema.ResourceData, m interface{}) error {
+func readSingularOpsiImportableComputeEntity(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiImportableComputeEntityDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OpsiImportableComputeEntityDataSourceCrud struct {
@@ -82,7 +83,7 @@ func (s *OpsiImportableComputeEntityDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OpsiImportableComputeEntityDataSourceCrud) Get() error {
+func (s *OpsiImportableComputeEntityDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_opsi.ListImportableComputeEntitiesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -92,7 +93,7 @@ func (s *OpsiImportableComputeEntityDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.ListImportableComputeEntities(context.Background(), request)
+	response, err := s.Client.ListImportableComputeEntities(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package opsi
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v65/opsi"
 
@@ -22,12 +23,12 @@ func OpsiOperationsInsightsPrivateEndpointDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OpsiOperationsInsightsPrivateEndpointResource(), fieldMap, readSingularOpsiOperationsInsightsPrivateEndpoint)
 }
 
-func readSingularOpsiOperationsInsightsPrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func readSingularOpsiOperationsInsightsPrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiOperationsInsightsPrivateEndpointDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OpsiOperationsInsightsPrivateEndpointDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *OpsiOperationsInsightsPrivateEndpointDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OpsiOperationsInsightsPrivateEndpointDataSourceCrud) Get() error {
+func (s *OpsiOperationsInsightsPrivateEndpointDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_opsi.GetOperationsInsightsPrivateEndpointRequest{}
 
 	if operationsInsightsPrivateEndpointId, ok := s.D.GetOkExists("operations_insights_private_endpoint_id"); ok {
@@ -50,7 +51,7 @@ func (s *OpsiOperationsInsightsPrivateEndpointDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.GetOperationsInsightsPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.GetOperationsInsightsPrivateEndpoint(ctx, request)
 	if err != nil {
 		return err
 	}
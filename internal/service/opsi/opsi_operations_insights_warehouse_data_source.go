@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v65/opsi"
 )
@@ -22,12 +23,12 @@ func OpsiOperationsInsightsWarehouseDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OpsiOperationsInsightsWarehouseResource(), fieldMap, readSingularOpsiOperationsInsightsWarehouse)
 }
 
-func readSingularOpsiOperationsInsightsWarehouse(d *schema.ResourceData, m interface{}) error {
+func readSingularOpsiOperationsInsightsWarehouse(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiOperationsInsightsWarehouseDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OpsiOperationsInsightsWarehouseDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *OpsiOperationsInsightsWarehouseDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OpsiOperationsInsightsWarehouseDataSourceCrud) Get() error {
+func (s *OpsiOperationsInsightsWarehouseDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_opsi.GetOperationsInsightsWarehouseRequest{}
 
 	if operationsInsightsWarehouseId, ok := s.D.GetOkExists("operations_insights_warehouse_id"); ok {
@@ -50,7 +51,7 @@ func (s *OpsiOperationsInsightsWarehouseDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.GetOperationsInsightsWarehouse(context.Background(), request)
+	response, err := s.Client.GetOperationsInsightsWarehouse(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_opsi "github.com/oracle/oci-go-sdk/v65/opsi"
@@ -20,10 +21,10 @@ func OpsiOperationsInsightsWarehouseDownloadWarehouseWalletResource() *schema.Re
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOpsiOperationsInsightsWarehouseDownloadWarehouseWallet,
-		Read:     readOpsiOperationsInsightsWarehouseDownloadWarehouseWallet,
-		Delete:   deleteOpsiOperationsInsightsWarehouseDownloadWarehouseWallet,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOpsiOperationsInsightsWarehouseDownloadWarehouseWallet,
+		ReadContext:   readOpsiOperationsInsightsWarehouseDownloadWarehouseWallet,
+		DeleteContext: deleteOpsiOperationsInsightsWarehouseDownloadWarehouseWallet,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"operations_insights_warehouse_id": {
@@ -45,19 +46,19 @@ func OpsiOperationsInsightsWarehouseDownloadWarehouseWalletResource() *schema.Re
 	}
 }
 
-func createOpsiOperationsInsightsWarehouseDownloadWarehouseWallet(d *schema.ResourceData, m interface{}) error {
+func createOpsiOperationsInsightsWarehouseDownloadWarehouseWallet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiOperationsInsightsWarehouseDownloadWarehouseWalletResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readOpsiOperationsInsightsWarehouseDownloadWarehouseWallet(d *schema.ResourceData, m interface{}) error {
+func readOpsiOperationsInsightsWarehouseDownloadWarehouseWallet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteOpsiOperationsInsightsWarehouseDownloadWarehouseWallet(d *schema.ResourceData, m interface{}) error {
+func deleteOpsiOperationsInsightsWarehouseDownloadWarehouseWallet(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -72,7 +73,7 @@ func (s *OpsiOperationsInsightsWarehouseDownloadWarehouseWalletResourceCrud) ID(
 	return tfresource.GenerateDataSourceHashID("OpsiOperationsInsightsWarehouseDownloadWarehouseWalletResource-", OpsiOperationsInsightsWarehouseDownloadWarehouseWalletResource(), s.D)
 }
 
-func (s *OpsiOperationsInsightsWarehouseDownloadWarehouseWalletResourceCrud) Create() error {
+func (s *OpsiOperationsInsightsWarehouseDownloadWarehouseWalletResourceCrud) Create(ctx context.Context) error {
 	request := oci_opsi.DownloadOperationsInsightsWarehouseWalletRequest{}
 
 	if operationsInsightsWarehouseId, ok := s.D.GetOkExists("operations_insights_warehouse_id"); ok {
@@ -87,7 +88,7 @@ func (s *OpsiOperationsInsightsWarehouseDownloadWarehouseWalletResourceCrud) Cre
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.DownloadOperationsInsightsWarehouseWallet(context.Background(), request)
+	response, err := s.Client.DownloadOperationsInsightsWarehouseWallet(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,11 +25,11 @@ func OpsiOperationsInsightsWarehouseResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOpsiOperationsInsightsWarehouse,
-		Read:     readOpsiOperationsInsightsWarehouse,
-		Update:   updateOpsiOperationsInsightsWarehouse,
-		Delete:   deleteOpsiOperationsInsightsWarehouse,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOpsiOperationsInsightsWarehouse,
+		ReadContext:   readOpsiOperationsInsightsWarehouse,
+		UpdateContext: updateOpsiOperationsInsightsWarehouse,
+		DeleteContext: deleteOpsiOperationsInsightsWarehouse,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -111,37 +112,37 @@ func OpsiOperationsInsightsWarehouseResource() *schema.Resource {
 	}
 }
 
-func createOpsiOperationsInsightsWarehouse(d *schema.ResourceData, m interface{}) error {
+func createOpsiOperationsInsightsWarehouse(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiOperationsInsightsWarehouseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readOpsiOperationsInsightsWarehouse(d *schema.ResourceData, m interface{}) error {
+func readOpsiOperationsInsightsWarehouse(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiOperationsInsightsWarehouseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateOpsiOperationsInsightsWarehouse(d *schema.ResourceData, m interface{}) error {
+func updateOpsiOperationsInsightsWarehouse(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiOperationsInsightsWarehouseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteOpsiOperationsInsightsWarehouse(d *schema.ResourceData, m interface{}) error {
+func deleteOpsiOperationsInsightsWarehouse(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiOperationsInsightsWarehouseResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type OpsiOperationsInsightsWarehouseResourceCrud struct {
@@ -179,7 +180,7 @@ func (s *OpsiOperationsInsightsWarehouseResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *OpsiOperationsInsightsWarehouseResourceCrud) Create() error {
+func (s *OpsiOperationsInsightsWarehouseResourceCrud) Create(ctx context.Context) error {
 	request := oci_opsi.CreateOperationsInsightsWarehouseRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -216,20 +217,20 @@ func (s *OpsiOperationsInsightsWarehouseResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.CreateOperationsInsightsWarehouse(context.Background(), request)
+	response, err := s.Client.CreateOperationsInsightsWarehouse(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getOperationsInsightsWarehouseFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getOperationsInsightsWarehouseFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *OpsiOperationsInsightsWarehouseResourceCrud) getOperationsInsightsWarehouseFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *OpsiOperationsInsightsWarehouseResourceCrud) getOperationsInsightsWarehouseFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_opsi.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	operationsInsightsWarehouseId, err := operationsInsightsWarehouseWaitForWorkRequest(workId, "opsi",
+	operationsInsightsWarehouseId, err := operationsInsightsWarehouseWaitForWorkRequest(ctx, workId, "opsi",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -237,7 +238,7 @@ func (s *OpsiOperationsInsightsWarehouseResourceCrud) getOperationsInsightsWareh
 	}
 	s.D.SetId(*operationsInsightsWarehouseId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func operationsInsightsWarehouseWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -263,7 +264,7 @@ func operationsInsightsWarehouseWorkRequestShouldRetryFunc(timeout time.Duration
 	}
 }
 
-func operationsInsightsWarehouseWaitForWorkRequest(wId *string, entityType string, action oci_opsi.ActionTypeEnum,
+func operationsInsightsWarehouseWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_opsi.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_opsi.OperationsInsightsClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "opsi")
 	retryPolicy.ShouldRetryOperation = operationsInsightsWarehouseWorkRequestShouldRetryFunc(timeout)
@@ -282,7 +283,7 @@ func operationsInsightsWarehouseWaitForWorkRequest(wId *string, entityType strin
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_opsi.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -311,14 +312,14 @@ func operationsInsightsWarehouseWaitForWorkRequest(wId *string, entityType strin
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_opsi.OperationStatusFailed || response.Status == oci_opsi.OperationStatusCanceled {
-		return nil, getErrorFromOpsiOperationsInsightsWarehouseWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromOpsiOperationsInsightsWarehouseWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromOpsiOperationsInsightsWarehouseWorkRequest(client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromOpsiOperationsInsightsWarehouseWorkRequest(ctx context.Context, client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_opsi.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -340,7 +341,7 @@ func getErrorFromOpsiOperationsInsightsWarehouseWorkRequest(client *oci_opsi.Ope
 	return workRequestErr
 }
 
-func (s *OpsiOperationsInsightsWarehouseResourceCrud) Get() error {
+func (s *OpsiOperationsInsightsWarehouseResourceCrud) Get(ctx context.Context) error {
 	request := oci_opsi.GetOperationsInsightsWarehouseRequest{}
 
 	tmp := s.D.Id()
@@ -348,7 +349,7 @@ func (s *OpsiOperationsInsightsWarehouseResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.GetOperationsInsightsWarehouse(context.Background(), request)
+	response, err := s.Client.GetOperationsInsightsWarehouse(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -357,7 +358,7 @@ func (s *OpsiOperationsInsightsWarehouseResourceCrud) Get() error {
 	return nil
 }
 
-func (s *OpsiOperationsInsightsWarehouseResourceCrud) Update() error {
+func (s *OpsiOperationsInsightsWarehouseResourceCrud) Update(ctx context.Context) error {
 	request := oci_opsi.UpdateOperationsInsightsWarehouseRequest{}
 
 	if cpuAllocated, ok := s.D.GetOkExists("cpu_allocated"); ok {
@@ -392,16 +393,16 @@ func (s *OpsiOperationsInsightsWarehouseResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.UpdateOperationsInsightsWarehouse(context.Background(), request)
+	response, err := s.Client.UpdateOperationsInsightsWarehouse(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getOperationsInsightsWarehouseFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeRelated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getOperationsInsightsWarehouseFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeRelated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *OpsiOperationsInsightsWarehouseResourceCrud) Delete() error {
+func (s *OpsiOperationsInsightsWarehouseResourceCrud) Delete(ctx context.Context) error {
 	request := oci_opsi.DeleteOperationsInsightsWarehouseRequest{}
 
 	tmp := s.D.Id()
@@ -409,14 +410,14 @@ func (s *OpsiOperationsInsightsWarehouseResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.DeleteOperationsInsightsWarehouse(context.Background(), request)
+	response, err := s.Client.DeleteOperationsInsightsWarehouse(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := operationsInsightsWarehouseWaitForWorkRequest(workId, "opsi",
+	_, delWorkRequestErr := operationsInsightsWarehouseWaitForWorkRequest(ctx, workId, "opsi",
 		oci_opsi.ActionTypeRelated, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
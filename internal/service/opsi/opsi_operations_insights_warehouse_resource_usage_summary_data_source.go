@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v65/opsi"
 )
 
 func OpsiOperationsInsightsWarehouseResourceUsageSummaryDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOpsiOperationsInsightsWarehouseResourceUsageSummary,
+		ReadContext: readSingularOpsiOperationsInsightsWarehouseResourceUsageSummary,
 		Schema: map[string]*schema.Schema{
 			"operations_insights_warehouse_id": {
 				Type:     schema.TypeString,
@@ -38,12 +39,12 @@ func OpsiOperationsInsightsWarehouseResourceUsageSummaryDataSource() *schema.Res
 	}
 }
 
-func readSingularOpsiOperationsInsightsWarehouseResourceUsageSummary(d *schema.ResourceData, m interface{}) error {
+func readSingularOpsiOperationsInsightsWarehouseResourceUsageSummary(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiOperationsInsightsWarehouseResourceUsageSummaryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OpsiOperationsInsightsWarehouseResourceUsageSummaryDataSourceCrud struct {
@@ -56,7 +57,7 @@ func (s *OpsiOperationsInsightsWarehouseResourceUsageSummaryDataSourceCrud) Void
 	s.D.SetId("")
 }
 
-func (s *OpsiOperationsInsightsWarehouseResourceUsageSummaryDataSourceCrud) Get() error {
+func (s *OpsiOperationsInsightsWarehouseResourceUsageSummaryDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_opsi.SummarizeOperationsInsightsWarehouseResourceUsageRequest{}
 
 	if operationsInsightsWarehouseId, ok := s.D.GetOkExists("operations_insights_warehouse_id"); ok {
@@ -66,7 +67,7 @@ func (s *OpsiOperationsInsightsWarehouseResourceUsageSummaryDataSourceCrud) Get(
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.SummarizeOperationsInsightsWarehouseResourceUsage(context.Background(), request)
+	response, err := s.Client.SummarizeOperationsInsightsWarehouseResourceUsage(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_opsi "github.com/oracle/oci-go-sdk/v65/opsi"
 )
@@ -22,12 +23,12 @@ func OpsiOperationsInsightsWarehouseUserDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OpsiOperationsInsightsWarehouseUserResource(), fieldMap, readSingularOpsiOperationsInsightsWarehouseUser)
 }
 
-func readSingularOpsiOperationsInsightsWarehouseUser(d *schema.ResourceData, m interface{}) error {
+func readSingularOpsiOperationsInsightsWarehouseUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiOperationsInsightsWarehouseUserDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OpsiOperationsInsightsWarehouseUserDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *OpsiOperationsInsightsWarehouseUserDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OpsiOperationsInsightsWarehouseUserDataSourceCrud) Get() error {
+func (s *OpsiOperationsInsightsWarehouseUserDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_opsi.GetOperationsInsightsWarehouseUserRequest{}
 
 	if operationsInsightsWarehouseUserId, ok := s.D.GetOkExists("operations_insights_warehouse_user_id"); ok {
@@ -50,7 +51,7 @@ func (s *OpsiOperationsInsightsWarehouseUserDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "opsi")
 
-	response, err := s.Client.GetOperationsInsightsWarehouseUser(context.Background(), request)
+	response, err := s.Client.GetOperationsInsightsWarehouseUser(ctx, request)
 	if err != nil {
 		return err
 	}
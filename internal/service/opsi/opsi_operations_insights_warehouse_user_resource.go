@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,11 +25,11 @@ func OpsiOperationsInsightsWarehouseUserResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOpsiOperationsInsightsWarehouseUser,
-		Read:     readOpsiOperationsInsightsWarehouseUser,
-		Update:   updateOpsiOperationsInsightsWarehouseUser,
-		Delete:   deleteOpsiOperationsInsightsWarehouseUser,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOpsiOperationsInsightsWarehouseUser,
+		ReadContext:   readOpsiOperationsInsightsWarehouseUser,
+		UpdateContext: updateOpsiOperationsInsightsWarehouseUser,
+		DeleteContext: deleteOpsiOperationsInsightsWarehouseUser,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -107,37 +108,37 @@ func OpsiOperationsInsightsWarehouseUserResource() *schema.Resource {
 	}
 }
 
-func createOpsiOperationsInsightsWarehouseUser(d *schema.ResourceData, m interface{}) error {
+func createOpsiOperationsInsightsWarehouseUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiOperationsInsightsWarehouseUserResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readOpsiOperationsInsightsWarehouseUser(d *schema.ResourceData, m interface{}) error {
+func readOpsiOperationsInsightsWarehouseUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiOperationsInsightsWarehouseUserResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateOpsiOperationsInsightsWarehouseUser(d *schema.ResourceData, m interface{}) error {
+func updateOpsiOperationsInsightsWarehouseUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiOperationsInsightsWarehouseUserResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteOpsiOperationsInsightsWarehouseUser(d *schema.ResourceData, m interface{}) error {
+func deleteOpsiOperationsInsightsWarehouseUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OpsiOperationsInsightsWarehouseUserResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OperationsInsightsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type OpsiOperationsInsightsWarehouseUserResourceCrud struct {
@@ -175,7 +176,7 @@ func (s *OpsiOperationsInsightsWarehouseUserResourceCrud) DeletedTarget() []stri
 	}
 }
 
-func (s *OpsiOperationsInsightsWarehouseUserResourceCrud) Create() error {
+func (s *OpsiOperationsInsightsWarehouseUserResourceCrud) Create(ctx context.Context) error {
 	request := oci_opsi.CreateOperationsInsightsWarehouseUserRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -227,20 +228,20 @@ func (s *OpsiOperationsInsightsWarehouseUserResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.CreateOperationsInsightsWarehouseUser(context.Background(), request)
+	response, err := s.Client.CreateOperationsInsightsWarehouseUser(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getOperationsInsightsWarehouseUserFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getOperationsInsightsWarehouseUserFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *OpsiOperationsInsightsWarehouseUserResourceCrud) getOperationsInsightsWarehouseUserFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *OpsiOperationsInsightsWarehouseUserResourceCrud) getOperationsInsightsWarehouseUserFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_opsi.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	operationsInsightsWarehouseUserId, err := operationsInsightsWarehouseUserWaitForWorkRequest(workId, "opsi",
+	operationsInsightsWarehouseUserId, err := operationsInsightsWarehouseUserWaitForWorkRequest(ctx, workId, "opsi",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -248,7 +249,7 @@ func (s *OpsiOperationsInsightsWarehouseUserResourceCrud) getOperationsInsightsW
 	}
 	s.D.SetId(*operationsInsightsWarehouseUserId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func operationsInsightsWarehouseUserWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -274,7 +275,7 @@ func operationsInsightsWarehouseUserWorkRequestShouldRetryFunc(timeout time.Dura
 	}
 }
 
-func operationsInsightsWarehouseUserWaitForWorkRequest(wId *string, entityType string, action oci_opsi.ActionTypeEnum,
+func operationsInsightsWarehouseUserWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_opsi.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_opsi.OperationsInsightsClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "opsi")
 	retryPolicy.ShouldRetryOperation = operationsInsightsWarehouseUserWorkRequestShouldRetryFunc(timeout)
@@ -293,7 +294,7 @@ func operationsInsightsWarehouseUserWaitForWorkRequest(wId *string, entityType s
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_opsi.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -322,14 +323,14 @@ func operationsInsightsWarehouseUserWaitForWorkRequest(wId *string, entityType s
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_opsi.OperationStatusFailed || response.Status == oci_opsi.OperationStatusCanceled {
-		return nil, getErrorFromOpsiOperationsInsightsWarehouseUserWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromOpsiOperationsInsightsWarehouseUserWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromOpsiOperationsInsightsWarehouseUserWorkRequest(client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromOpsiOperationsInsightsWarehouseUserWorkRequest(ctx context.Context, client *oci_opsi.OperationsInsightsClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_opsi.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_opsi.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -351,7 +352,7 @@ func getErrorFromOpsiOperationsInsightsWarehouseUserWorkRequest(client *oci_opsi
 	return workRequestErr
 }
 
-func (s *OpsiOperationsInsightsWarehouseUserResourceCrud) Get() error {
+func (s *OpsiOperationsInsightsWarehouseUserResourceCrud) Get(ctx context.Context) error {
 	request := oci_opsi.GetOperationsInsightsWarehouseUserRequest{}
 
 	tmp := s.D.Id()
@@ -359,7 +360,7 @@ func (s *OpsiOperationsInsightsWarehouseUserResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.GetOperationsInsightsWarehouseUser(context.Background(), request)
+	response, err := s.Client.GetOperationsInsightsWarehouseUser(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -368,7 +369,7 @@ func (s *OpsiOperationsInsightsWarehouseUserResourceCrud) Get() error {
 	return nil
 }
 
-func (s *OpsiOperationsInsightsWarehouseUserResourceCrud) Update() error {
+func (s *OpsiOperationsInsightsWarehouseUserResourceCrud) Update(ctx context.Context) error {
 	request := oci_opsi.UpdateOperationsInsightsWarehouseUserRequest{}
 
 	if connectionPassword, ok := s.D.GetOkExists("connection_password"); ok {
@@ -408,16 +409,16 @@ func (s *OpsiOperationsInsightsWarehouseUserResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.UpdateOperationsInsightsWarehouseUser(context.Background(), request)
+	response, err := s.Client.UpdateOperationsInsightsWarehouseUser(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getOperationsInsightsWarehouseUserFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getOperationsInsightsWarehouseUserFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi"), oci_opsi.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *OpsiOperationsInsightsWarehouseUserResourceCrud) Delete() error {
+func (s *OpsiOperationsInsightsWarehouseUserResourceCrud) Delete(ctx context.Context) error {
 	request := oci_opsi.DeleteOperationsInsightsWarehouseUserRequest{}
 
 	tmp := s.D.Id()
@@ -425,14 +426,14 @@ func (s *OpsiOperationsInsightsWarehouseUserResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "opsi")
 
-	response, err := s.Client.DeleteOperationsInsightsWarehouseUser(context.Background(), request)
+	response, err := s.Client.DeleteOperationsInsightsWarehouseUser(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := operationsInsightsWarehouseUserWaitForWorkRequest(workId, "opsi",
+	_, delWorkRequestErr := operationsInsightsWarehouseUserWaitForWorkRequest(ctx, workId, "opsi",
 		oci_opsi.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -6,6 +6,7 @@ package optimizer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v65/optimizer"
 
@@ -15,7 +16,7 @@ import (
 
 func OptimizerCategoriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOptimizerCategories,
+		ReadContext: readOptimizerCategories,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"child_tenancy_ids": {
@@ -153,12 +154,12 @@ func OptimizerCategoriesDataSource() *schema.Resource {
 	}
 }
 
-func readOptimizerCategories(d *schema.ResourceData, m interface{}) error {
+func readOptimizerCategories(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerCategoriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OptimizerCategoriesDataSourceCrud struct {
@@ -171,7 +172,7 @@ func (s *OptimizerCategoriesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OptimizerCategoriesDataSourceCrud) Get() error {
+func (s *OptimizerCategoriesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_optimizer.ListCategoriesRequest{}
 
 	if childTenancyIds, ok := s.D.GetOkExists("child_tenancy_ids"); ok {
@@ -213,7 +214,7 @@ func (s *OptimizerCategoriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
 
-	response, err := s.Client.ListCategories(context.Background(), request)
+	response, err := s.Client.ListCategories(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -222,7 +223,7 @@ func (s *OptimizerCategoriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCategories(context.Background(), request)
+		listResponse, err := s.Client.ListCategories(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package optimizer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v65/optimizer"
 
@@ -15,7 +16,7 @@ import (
 
 func OptimizerCategoryDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOptimizerCategory,
+		ReadContext: readSingularOptimizerCategory,
 		Schema: map[string]*schema.Schema{
 			"category_id": {
 				Type:     schema.TypeString,
@@ -105,12 +106,12 @@ func OptimizerCategoryDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOptimizerCategory(d *schema.ResourceData, m interface{}) error {
+func readSingularOptimizerCategory(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerCategoryDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OptimizerCategoryDataSourceCrud struct {
@@ -123,7 +124,7 @@ func (s *OptimizerCategoryDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OptimizerCategoryDataSourceCrud) Get() error {
+func (s *OptimizerCategoryDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_optimizer.GetCategoryRequest{}
 
 	if categoryId, ok := s.D.GetOkExists("category_id"); ok {
@@ -133,7 +134,7 @@ func (s *OptimizerCategoryDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
 
-	response, err := s.Client.GetCategory(context.Background(), request)
+	response, err := s.Client.GetCategory(ctx, request)
 	if err != nil {
 		return err
 	}
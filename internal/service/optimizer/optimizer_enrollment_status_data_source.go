@@ -6,6 +6,7 @@ package optimizer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v65/optimizer"
 
@@ -22,12 +23,12 @@ func OptimizerEnrollmentStatusDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OptimizerEnrollmentStatusResource(), fieldMap, readSingularOptimizerEnrollmentStatus)
 }
 
-func readSingularOptimizerEnrollmentStatus(d *schema.ResourceData, m interface{}) error {
+func readSingularOptimizerEnrollmentStatus(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerEnrollmentStatusDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OptimizerEnrollmentStatusDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *OptimizerEnrollmentStatusDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OptimizerEnrollmentStatusDataSourceCrud) Get() error {
+func (s *OptimizerEnrollmentStatusDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_optimizer.GetEnrollmentStatusRequest{}
 
 	if enrollmentStatusId, ok := s.D.GetOkExists("enrollment_status_id"); ok {
@@ -50,7 +51,7 @@ func (s *OptimizerEnrollmentStatusDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
 
-	response, err := s.Client.GetEnrollmentStatus(context.Background(), request)
+	response, err := s.Client.GetEnrollmentStatus(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package optimizer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_optimizer "github.com/oracle/oci-go-sdk/v65/optimizer"
@@ -19,11 +20,11 @@ func OptimizerEnrollmentStatusResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOptimizerEnrollmentStatus,
-		Read:     readOptimizerEnrollmentStatus,
-		Update:   updateOptimizerEnrollmentStatus,
-		Delete:   deleteOptimizerEnrollmentStatus,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOptimizerEnrollmentStatus,
+		ReadContext:   readOptimizerEnrollmentStatus,
+		UpdateContext: updateOptimizerEnrollmentStatus,
+		DeleteContext: deleteOptimizerEnrollmentStatus,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"enrollment_status_id": {
@@ -63,31 +64,31 @@ func OptimizerEnrollmentStatusResource() *schema.Resource {
 	}
 }
 
-func createOptimizerEnrollmentStatus(d *schema.ResourceData, m interface{}) error {
+func createOptimizerEnrollmentStatus(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerEnrollmentStatusResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readOptimizerEnrollmentStatus(d *schema.ResourceData, m interface{}) error {
+func readOptimizerEnrollmentStatus(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerEnrollmentStatusResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateOptimizerEnrollmentStatus(d *schema.ResourceData, m interface{}) error {
+func updateOptimizerEnrollmentStatus(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerEnrollmentStatusResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteOptimizerEnrollmentStatus(d *schema.ResourceData, m interface{}) error {
+func deleteOptimizerEnrollmentStatus(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -128,7 +129,7 @@ func (s *OptimizerEnrollmentStatusResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *OptimizerEnrollmentStatusResourceCrud) Create() error {
+func (s *OptimizerEnrollmentStatusResourceCrud) Create(ctx context.Context) error {
 	request := oci_optimizer.UpdateEnrollmentStatusRequest{}
 
 	if enrollmentStatusId, ok := s.D.GetOkExists("enrollment_status_id"); ok {
@@ -142,7 +143,7 @@ func (s *OptimizerEnrollmentStatusResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.UpdateEnrollmentStatus(context.Background(), request)
+	response, err := s.Client.UpdateEnrollmentStatus(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -151,7 +152,7 @@ func (s *OptimizerEnrollmentStatusResourceCrud) Create() error {
 	return nil
 }
 
-func (s *OptimizerEnrollmentStatusResourceCrud) Get() error {
+func (s *OptimizerEnrollmentStatusResourceCrud) Get(ctx context.Context) error {
 	request := oci_optimizer.GetEnrollmentStatusRequest{}
 
 	tmp := s.D.Id()
@@ -159,7 +160,7 @@ func (s *OptimizerEnrollmentStatusResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.GetEnrollmentStatus(context.Background(), request)
+	response, err := s.Client.GetEnrollmentStatus(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -168,7 +169,7 @@ func (s *OptimizerEnrollmentStatusResourceCrud) Get() error {
 	return nil
 }
 
-func (s *OptimizerEnrollmentStatusResourceCrud) Update() error {
+func (s *OptimizerEnrollmentStatusResourceCrud) Update(ctx context.Context) error {
 	request := oci_optimizer.UpdateEnrollmentStatusRequest{}
 
 	tmp := s.D.Id()
@@ -180,7 +181,7 @@ func (s *OptimizerEnrollmentStatusResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.UpdateEnrollmentStatus(context.Background(), request)
+	response, err := s.Client.UpdateEnrollmentStatus(ctx, request)
 	if err != nil {
 		return err
 	}
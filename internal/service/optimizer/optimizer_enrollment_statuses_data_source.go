@@ -6,6 +6,7 @@ package optimizer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v65/optimizer"
 
@@ -15,7 +16,7 @@ import (
 
 func OptimizerEnrollmentStatusesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOptimizerEnrollmentStatuses,
+		ReadContext: readOptimizerEnrollmentStatuses,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,12 +49,12 @@ func OptimizerEnrollmentStatusesDataSource() *schema.Resource {
 	}
 }
 
-func readOptimizerEnrollmentStatuses(d *schema.ResourceData, m interface{}) error {
+func readOptimizerEnrollmentStatuses(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerEnrollmentStatusesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OptimizerEnrollmentStatusesDataSourceCrud struct {
@@ -66,7 +67,7 @@ func (s *OptimizerEnrollmentStatusesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OptimizerEnrollmentStatusesDataSourceCrud) Get() error {
+func (s *OptimizerEnrollmentStatusesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_optimizer.ListEnrollmentStatusesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -84,7 +85,7 @@ func (s *OptimizerEnrollmentStatusesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
 
-	response, err := s.Client.ListEnrollmentStatuses(context.Background(), request)
+	response, err := s.Client.ListEnrollmentStatuses(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -93,7 +94,7 @@ func (s *OptimizerEnrollmentStatusesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListEnrollmentStatuses(context.Background(), request)
+		listResponse, err := s.Client.ListEnrollmentStatuses(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package optimizer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v65/optimizer"
 
@@ -15,7 +16,7 @@ import (
 
 func OptimizerHistoriesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOptimizerHistories,
+		ReadContext: readOptimizerHistories,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -166,12 +167,12 @@ func OptimizerHistoriesDataSource() *schema.Resource {
 	}
 }
 
-func readOptimizerHistories(d *schema.ResourceData, m interface{}) error {
+func readOptimizerHistories(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerHistoriesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OptimizerHistoriesDataSourceCrud struct {
@@ -184,7 +185,7 @@ func (s *OptimizerHistoriesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OptimizerHistoriesDataSourceCrud) Get() error {
+func (s *OptimizerHistoriesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_optimizer.ListHistoriesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -227,7 +228,7 @@ func (s *OptimizerHistoriesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
 
-	response, err := s.Client.ListHistories(context.Background(), request)
+	response, err := s.Client.ListHistories(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -236,7 +237,7 @@ func (s *OptimizerHistoriesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListHistories(context.Background(), request)
+		listResponse, err := s.Client.ListHistories(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package optimizer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v65/optimizer"
 
@@ -22,12 +23,12 @@ func OptimizerProfileDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OptimizerProfileResource(), fieldMap, readSingularOptimizerProfile)
 }
 
-func readSingularOptimizerProfile(d *schema.ResourceData, m interface{}) error {
+func readSingularOptimizerProfile(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerProfileDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OptimizerProfileDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *OptimizerProfileDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OptimizerProfileDataSourceCrud) Get() error {
+func (s *OptimizerProfileDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_optimizer.GetProfileRequest{}
 
 	if profileId, ok := s.D.GetOkExists("profile_id"); ok {
@@ -50,7 +51,7 @@ func (s *OptimizerProfileDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
 
-	response, err := s.Client.GetProfile(context.Background(), request)
+	response, err := s.Client.GetProfile(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package optimizer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v65/optimizer"
 
@@ -15,7 +16,7 @@ import (
 
 func OptimizerProfileLevelDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOptimizerProfileLevel,
+		ReadContext: readSingularOptimizerProfileLevel,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -107,12 +108,12 @@ func OptimizerProfileLevelDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOptimizerProfileLevel(d *schema.ResourceData, m interface{}) error {
+func readSingularOptimizerProfileLevel(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerProfileLevelDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OptimizerProfileLevelDataSourceCrud struct {
@@ -125,7 +126,7 @@ func (s *OptimizerProfileLevelDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OptimizerProfileLevelDataSourceCrud) Get() error {
+func (s *OptimizerProfileLevelDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_optimizer.ListProfileLevelsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -150,7 +151,7 @@ func (s *OptimizerProfileLevelDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
 
-	response, err := s.Client.ListProfileLevels(context.Background(), request)
+	response, err := s.Client.ListProfileLevels(ctx, request)
 	if err != nil {
 		return err
 	}
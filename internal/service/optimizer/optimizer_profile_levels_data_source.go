@@ -6,6 +6,7 @@ package optimizer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v65/optimizer"
 
@@ -15,7 +16,7 @@ import (
 
 func OptimizerProfileLevelsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOptimizerProfileLevels,
+		ReadContext: readOptimizerProfileLevels,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -113,12 +114,12 @@ func OptimizerProfileLevelsDataSource() *schema.Resource {
 	}
 }
 
-func readOptimizerProfileLevels(d *schema.ResourceData, m interface{}) error {
+func readOptimizerProfileLevels(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerProfileLevelsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OptimizerProfileLevelsDataSourceCrud struct {
@@ -131,7 +132,7 @@ func (s *OptimizerProfileLevelsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OptimizerProfileLevelsDataSourceCrud) Get() error {
+func (s *OptimizerProfileLevelsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_optimizer.ListProfileLevelsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -156,7 +157,7 @@ func (s *OptimizerProfileLevelsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
 
-	response, err := s.Client.ListProfileLevels(context.Background(), request)
+	response, err := s.Client.ListProfileLevels(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -165,7 +166,7 @@ func (s *OptimizerProfileLevelsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListProfileLevels(context.Background(), request)
+		listResponse, err := s.Client.ListProfileLevels(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_optimizer "github.com/oracle/oci-go-sdk/v65/optimizer"
@@ -20,11 +21,11 @@ func OptimizerProfileResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOptimizerProfile,
-		Read:     readOptimizerProfile,
-		Update:   updateOptimizerProfile,
-		Delete:   deleteOptimizerProfile,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOptimizerProfile,
+		ReadContext:   readOptimizerProfile,
+		UpdateContext: updateOptimizerProfile,
+		DeleteContext: deleteOptimizerProfile,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -189,37 +190,37 @@ func OptimizerProfileResource() *schema.Resource {
 	}
 }
 
-func createOptimizerProfile(d *schema.ResourceData, m interface{}) error {
+func createOptimizerProfile(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerProfileResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readOptimizerProfile(d *schema.ResourceData, m interface{}) error {
+func readOptimizerProfile(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerProfileResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateOptimizerProfile(d *schema.ResourceData, m interface{}) error {
+func updateOptimizerProfile(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerProfileResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteOptimizerProfile(d *schema.ResourceData, m interface{}) error {
+func deleteOptimizerProfile(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerProfileResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type OptimizerProfileResourceCrud struct {
@@ -259,7 +260,7 @@ func (s *OptimizerProfileResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *OptimizerProfileResourceCrud) Create() error {
+func (s *OptimizerProfileResourceCrud) Create(ctx context.Context) error {
 	request := oci_optimizer.CreateProfileRequest{}
 
 	if aggregationIntervalInDays, ok := s.D.GetOkExists("aggregation_interval_in_days"); ok {
@@ -329,7 +330,7 @@ func (s *OptimizerProfileResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.CreateProfile(context.Background(), request)
+	response, err := s.Client.CreateProfile(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -338,7 +339,7 @@ func (s *OptimizerProfileResourceCrud) Create() error {
 	return nil
 }
 
-func (s *OptimizerProfileResourceCrud) Get() error {
+func (s *OptimizerProfileResourceCrud) Get(ctx context.Context) error {
 	request := oci_optimizer.GetProfileRequest{}
 
 	tmp := s.D.Id()
@@ -346,7 +347,7 @@ func (s *OptimizerProfileResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.GetProfile(context.Background(), request)
+	response, err := s.Client.GetProfile(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -355,7 +356,7 @@ func (s *OptimizerProfileResourceCrud) Get() error {
 	return nil
 }
 
-func (s *OptimizerProfileResourceCrud) Update() error {
+func (s *OptimizerProfileResourceCrud) Update(ctx context.Context) error {
 	request := oci_optimizer.UpdateProfileRequest{}
 
 	if aggregationIntervalInDays, ok := s.D.GetOkExists("aggregation_interval_in_days"); ok {
@@ -423,7 +424,7 @@ func (s *OptimizerProfileResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.UpdateProfile(context.Background(), request)
+	response, err := s.Client.UpdateProfile(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -432,7 +433,7 @@ func (s *OptimizerProfileResourceCrud) Update() error {
 	return nil
 }
 
-func (s *OptimizerProfileResourceCrud) Delete() error {
+func (s *OptimizerProfileResourceCrud) Delete(ctx context.Context) error {
 	request := oci_optimizer.DeleteProfileRequest{}
 
 	tmp := s.D.Id()
@@ -440,7 +441,7 @@ func (s *OptimizerProfileResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
-	_, err := s.Client.DeleteProfile(context.Background(), request)
+	_, err := s.Client.DeleteProfile(ctx, request)
 	return err
 }
 
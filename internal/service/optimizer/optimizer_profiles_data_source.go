@@ -6,6 +6,7 @@ package optimizer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v65/optimizer"
 
@@ -15,7 +16,7 @@ import (
 
 func OptimizerProfilesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOptimizerProfiles,
+		ReadContext: readOptimizerProfiles,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,12 +49,12 @@ func OptimizerProfilesDataSource() *schema.Resource {
 	}
 }
 
-func readOptimizerProfiles(d *schema.ResourceData, m interface{}) error {
+func readOptimizerProfiles(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerProfilesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OptimizerProfilesDataSourceCrud struct {
@@ -66,7 +67,7 @@ func (s *OptimizerProfilesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OptimizerProfilesDataSourceCrud) Get() error {
+func (s *OptimizerProfilesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_optimizer.ListProfilesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -85,7 +86,7 @@ func (s *OptimizerProfilesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
 
-	response, err := s.Client.ListProfiles(context.Background(), request)
+	response, err := s.Client.ListProfiles(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *OptimizerProfilesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListProfiles(context.Background(), request)
+		listResponse, err := s.Client.ListProfiles(ctx, request)
 		if err != nil {
 			return err
 		}
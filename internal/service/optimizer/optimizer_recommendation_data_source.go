@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v65/optimizer"
 
@@ -23,12 +24,12 @@ func OptimizerRecommendationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OptimizerRecommendationResource(), fieldMap, readSingularOptimizerRecommendation)
 }
 
-func readSingularOptimizerRecommendation(d *schema.ResourceData, m interface{}) error {
+func readSingularOptimizerRecommendation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerRecommendationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OptimizerRecommendationDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *OptimizerRecommendationDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OptimizerRecommendationDataSourceCrud) Get() error {
+func (s *OptimizerRecommendationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_optimizer.GetRecommendationRequest{}
 
 	if recommendationId, ok := s.D.GetOkExists("recommendation_id"); ok {
@@ -51,7 +52,7 @@ func (s *OptimizerRecommendationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
 
-	response, err := s.Client.GetRecommendation(context.Background(), request)
+	response, err := s.Client.GetRecommendation(ctx, request)
 	if err != nil {
 		return err
 	}
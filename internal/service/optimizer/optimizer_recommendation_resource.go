@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
@@ -21,11 +22,11 @@ func OptimizerRecommendationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOptimizerRecommendation,
-		Read:     readOptimizerRecommendation,
-		Update:   updateOptimizerRecommendation,
-		Delete:   deleteOptimizerRecommendation,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOptimizerRecommendation,
+		ReadContext:   readOptimizerRecommendation,
+		UpdateContext: updateOptimizerRecommendation,
+		DeleteContext: deleteOptimizerRecommendation,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"recommendation_id": {
@@ -147,31 +148,31 @@ func OptimizerRecommendationResource() *schema.Resource {
 	}
 }
 
-func createOptimizerRecommendation(d *schema.ResourceData, m interface{}) error {
+func createOptimizerRecommendation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerRecommendationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readOptimizerRecommendation(d *schema.ResourceData, m interface{}) error {
+func readOptimizerRecommendation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerRecommendationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateOptimizerRecommendation(d *schema.ResourceData, m interface{}) error {
+func updateOptimizerRecommendation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerRecommendationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteOptimizerRecommendation(d *schema.ResourceData, m interface{}) error {
+func deleteOptimizerRecommendation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -212,7 +213,7 @@ func (s *OptimizerRecommendationResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *OptimizerRecommendationResourceCrud) Create() error {
+func (s *OptimizerRecommendationResourceCrud) Create(ctx context.Context) error {
 	request := oci_optimizer.UpdateRecommendationRequest{}
 
 	if recommendationId, ok := s.D.GetOkExists("recommendation_id"); ok {
@@ -234,7 +235,7 @@ func (s *OptimizerRecommendationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.UpdateRecommendation(context.Background(), request)
+	response, err := s.Client.UpdateRecommendation(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -243,7 +244,7 @@ func (s *OptimizerRecommendationResourceCrud) Create() error {
 	return nil
 }
 
-func (s *OptimizerRecommendationResourceCrud) Get() error {
+func (s *OptimizerRecommendationResourceCrud) Get(ctx context.Context) error {
 	request := oci_optimizer.GetRecommendationRequest{}
 
 	tmp := s.D.Id()
@@ -251,7 +252,7 @@ func (s *OptimizerRecommendationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.GetRecommendation(context.Background(), request)
+	response, err := s.Client.GetRecommendation(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -260,7 +261,7 @@ func (s *OptimizerRecommendationResourceCrud) Get() error {
 	return nil
 }
 
-func (s *OptimizerRecommendationResourceCrud) Update() error {
+func (s *OptimizerRecommendationResourceCrud) Update(ctx context.Context) error {
 	request := oci_optimizer.UpdateRecommendationRequest{}
 
 	tmp := s.D.Id()
@@ -280,7 +281,7 @@ func (s *OptimizerRecommendationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.UpdateRecommendation(context.Background(), request)
+	response, err := s.Client.UpdateRecommendation(ctx, request)
 	if err != nil {
 		return err
 	}
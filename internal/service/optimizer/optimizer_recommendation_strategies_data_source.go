@@ -6,6 +6,7 @@ package optimizer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v65/optimizer"
 
@@ -15,7 +16,7 @@ import (
 
 func OptimizerRecommendationStrategiesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOptimizerRecommendationStrategies,
+		ReadContext: readOptimizerRecommendationStrategies,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -123,12 +124,12 @@ func OptimizerRecommendationStrategiesDataSource() *schema.Resource {
 	}
 }
 
-func readOptimizerRecommendationStrategies(d *schema.ResourceData, m interface{}) error {
+func readOptimizerRecommendationStrategies(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerRecommendationStrategiesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OptimizerRecommendationStrategiesDataSourceCrud struct {
@@ -141,7 +142,7 @@ func (s *OptimizerRecommendationStrategiesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OptimizerRecommendationStrategiesDataSourceCrud) Get() error {
+func (s *OptimizerRecommendationStrategiesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_optimizer.ListRecommendationStrategiesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -166,7 +167,7 @@ func (s *OptimizerRecommendationStrategiesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
 
-	response, err := s.Client.ListRecommendationStrategies(context.Background(), request)
+	response, err := s.Client.ListRecommendationStrategies(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -175,7 +176,7 @@ func (s *OptimizerRecommendationStrategiesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRecommendationStrategies(context.Background(), request)
+		listResponse, err := s.Client.ListRecommendationStrategies(ctx, request)
 		if err != nil {
 			return err
 		}
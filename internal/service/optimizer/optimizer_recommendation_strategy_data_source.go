@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v65/optimizer"
 
@@ -16,7 +17,7 @@ import (
 
 func OptimizerRecommendationStrategyDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOptimizerRecommendationStrategy,
+		ReadContext: readSingularOptimizerRecommendationStrategy,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -116,12 +117,12 @@ func OptimizerRecommendationStrategyDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOptimizerRecommendationStrategy(d *schema.ResourceData, m interface{}) error {
+func readSingularOptimizerRecommendationStrategy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerRecommendationStrategyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OptimizerRecommendationStrategyDataSourceCrud struct {
@@ -134,7 +135,7 @@ func (s *OptimizerRecommendationStrategyDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OptimizerRecommendationStrategyDataSourceCrud) Get() error {
+func (s *OptimizerRecommendationStrategyDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_optimizer.ListRecommendationStrategiesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -159,7 +160,7 @@ func (s *OptimizerRecommendationStrategyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
 
-	response, err := s.Client.ListRecommendationStrategies(context.Background(), request)
+	response, err := s.Client.ListRecommendationStrategies(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package optimizer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v65/optimizer"
 
@@ -15,7 +16,7 @@ import (
 
 func OptimizerRecommendationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOptimizerRecommendations,
+		ReadContext: readOptimizerRecommendations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"category_id": {
@@ -75,12 +76,12 @@ func OptimizerRecommendationsDataSource() *schema.Resource {
 	}
 }
 
-func readOptimizerRecommendations(d *schema.ResourceData, m interface{}) error {
+func readOptimizerRecommendations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerRecommendationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OptimizerRecommendationsDataSourceCrud struct {
@@ -93,7 +94,7 @@ func (s *OptimizerRecommendationsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OptimizerRecommendationsDataSourceCrud) Get() error {
+func (s *OptimizerRecommendationsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_optimizer.ListRecommendationsRequest{}
 
 	if categoryId, ok := s.D.GetOkExists("category_id"); ok {
@@ -149,7 +150,7 @@ func (s *OptimizerRecommendationsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
 
-	response, err := s.Client.ListRecommendations(context.Background(), request)
+	response, err := s.Client.ListRecommendations(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -158,7 +159,7 @@ func (s *OptimizerRecommendationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRecommendations(context.Background(), request)
+		listResponse, err := s.Client.ListRecommendations(ctx, request)
 		if err != nil {
 			return err
 		}
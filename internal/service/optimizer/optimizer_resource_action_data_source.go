@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v65/optimizer"
 
@@ -23,12 +24,12 @@ func OptimizerResourceActionDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OptimizerResourceActionResource(), fieldMap, readSingularOptimizerResourceAction)
 }
 
-func readSingularOptimizerResourceAction(d *schema.ResourceData, m interface{}) error {
+func readSingularOptimizerResourceAction(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerResourceActionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OptimizerResourceActionDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *OptimizerResourceActionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OptimizerResourceActionDataSourceCrud) Get() error {
+func (s *OptimizerResourceActionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_optimizer.GetResourceActionRequest{}
 
 	if resourceActionId, ok := s.D.GetOkExists("resource_action_id"); ok {
@@ -51,7 +52,7 @@ func (s *OptimizerResourceActionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
 
-	response, err := s.Client.GetResourceAction(context.Background(), request)
+	response, err := s.Client.GetResourceAction(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
@@ -21,11 +22,11 @@ func OptimizerResourceActionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOptimizerResourceAction,
-		Read:     readOptimizerResourceAction,
-		Update:   updateOptimizerResourceAction,
-		Delete:   deleteOptimizerResourceAction,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOptimizerResourceAction,
+		ReadContext:   readOptimizerResourceAction,
+		UpdateContext: updateOptimizerResourceAction,
+		DeleteContext: deleteOptimizerResourceAction,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"resource_action_id": {
@@ -133,31 +134,31 @@ func OptimizerResourceActionResource() *schema.Resource {
 	}
 }
 
-func createOptimizerResourceAction(d *schema.ResourceData, m interface{}) error {
+func createOptimizerResourceAction(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerResourceActionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readOptimizerResourceAction(d *schema.ResourceData, m interface{}) error {
+func readOptimizerResourceAction(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerResourceActionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateOptimizerResourceAction(d *schema.ResourceData, m interface{}) error {
+func updateOptimizerResourceAction(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerResourceActionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteOptimizerResourceAction(d *schema.ResourceData, m interface{}) error {
+func deleteOptimizerResourceAction(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -198,7 +199,7 @@ func (s *OptimizerResourceActionResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *OptimizerResourceActionResourceCrud) Create() error {
+func (s *OptimizerResourceActionResourceCrud) Create(ctx context.Context) error {
 	request := oci_optimizer.UpdateResourceActionRequest{}
 
 	if resourceActionId, ok := s.D.GetOkExists("resource_action_id"); ok {
@@ -220,7 +221,7 @@ func (s *OptimizerResourceActionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.UpdateResourceAction(context.Background(), request)
+	response, err := s.Client.UpdateResourceAction(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -229,7 +230,7 @@ func (s *OptimizerResourceActionResourceCrud) Create() error {
 	return nil
 }
 
-func (s *OptimizerResourceActionResourceCrud) Get() error {
+func (s *OptimizerResourceActionResourceCrud) Get(ctx context.Context) error {
 	request := oci_optimizer.GetResourceActionRequest{}
 
 	tmp := s.D.Id()
@@ -237,7 +238,7 @@ func (s *OptimizerResourceActionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.GetResourceAction(context.Background(), request)
+	response, err := s.Client.GetResourceAction(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -246,7 +247,7 @@ func (s *OptimizerResourceActionResourceCrud) Get() error {
 	return nil
 }
 
-func (s *OptimizerResourceActionResourceCrud) Update() error {
+func (s *OptimizerResourceActionResourceCrud) Update(ctx context.Context) error {
 	request := oci_optimizer.UpdateResourceActionRequest{}
 
 	tmp := s.D.Id()
@@ -266,7 +267,7 @@ func (s *OptimizerResourceActionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "optimizer")
 
-	response, err := s.Client.UpdateResourceAction(context.Background(), request)
+	response, err := s.Client.UpdateResourceAction(ctx, request)
 	if err != nil {
 		return err
 	}
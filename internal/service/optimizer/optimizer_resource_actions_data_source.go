@@ -6,6 +6,7 @@ package optimizer
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_optimizer "github.com/oracle/oci-go-sdk/v65/optimizer"
 
@@ -15,7 +16,7 @@ import (
 
 func OptimizerResourceActionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOptimizerResourceActions,
+		ReadContext: readOptimizerResourceActions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"child_tenancy_ids": {
@@ -79,12 +80,12 @@ func OptimizerResourceActionsDataSource() *schema.Resource {
 	}
 }
 
-func readOptimizerResourceActions(d *schema.ResourceData, m interface{}) error {
+func readOptimizerResourceActions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OptimizerResourceActionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OptimizerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OptimizerResourceActionsDataSourceCrud struct {
@@ -97,7 +98,7 @@ func (s *OptimizerResourceActionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OptimizerResourceActionsDataSourceCrud) Get() error {
+func (s *OptimizerResourceActionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_optimizer.ListResourceActionsRequest{}
 
 	if childTenancyIds, ok := s.D.GetOkExists("child_tenancy_ids"); ok {
@@ -158,7 +159,7 @@ func (s *OptimizerResourceActionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "optimizer")
 
-	response, err := s.Client.ListResourceActions(context.Background(), request)
+	response, err := s.Client.ListResourceActions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -167,7 +168,7 @@ func (s *OptimizerResourceActionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListResourceActions(context.Background(), request)
+		listResponse, err := s.Client.ListResourceActions(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osmanagement "github.com/oracle/oci-go-sdk/v65/osmanagement"
 )
@@ -22,12 +23,12 @@ func OsmanagementManagedInstanceDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OsmanagementManagedInstanceResource(), fieldMap, readSingularOsmanagementManagedInstance)
 }
 
-func readSingularOsmanagementManagedInstance(d *schema.ResourceData, m interface{}) error {
+func readSingularOsmanagementManagedInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstanceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OsmanagementManagedInstanceDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *OsmanagementManagedInstanceDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OsmanagementManagedInstanceDataSourceCrud) Get() error {
+func (s *OsmanagementManagedInstanceDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_osmanagement.GetManagedInstanceRequest{}
 
 	if managedInstanceId, ok := s.D.GetOkExists("managed_instance_id"); ok {
@@ -50,7 +51,7 @@ func (s *OsmanagementManagedInstanceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osmanagement")
 
-	response, err := s.Client.GetManagedInstance(context.Background(), request)
+	response, err := s.Client.GetManagedInstance(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_osmanagement "github.com/oracle/oci-go-sdk/v65/osmanagement"
@@ -17,7 +18,7 @@ import (
 
 func OsmanagementManagedInstanceEventReportDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOsmanagementManagedInstanceEventReport,
+		ReadContext: readSingularOsmanagementManagedInstanceEventReport,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -44,12 +45,12 @@ func OsmanagementManagedInstanceEventReportDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOsmanagementManagedInstanceEventReport(d *schema.ResourceData, m interface{}) error {
+func readSingularOsmanagementManagedInstanceEventReport(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstanceEventReportDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).EventClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OsmanagementManagedInstanceEventReportDataSourceCrud struct {
@@ -62,7 +63,7 @@ func (s *OsmanagementManagedInstanceEventReportDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OsmanagementManagedInstanceEventReportDataSourceCrud) Get() error {
+func (s *OsmanagementManagedInstanceEventReportDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_osmanagement.GetEventReportRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -93,7 +94,7 @@ func (s *OsmanagementManagedInstanceEventReportDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osmanagement")
 
-	response, err := s.Client.GetEventReport(context.Background(), request)
+	response, err := s.Client.GetEventReport(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osmanagement "github.com/oracle/oci-go-sdk/v65/osmanagement"
 )
@@ -22,12 +23,12 @@ func OsmanagementManagedInstanceGroupDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OsmanagementManagedInstanceGroupResource(), fieldMap, readSingularOsmanagementManagedInstanceGroup)
 }
 
-func readSingularOsmanagementManagedInstanceGroup(d *schema.ResourceData, m interface{}) error {
+func readSingularOsmanagementManagedInstanceGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstanceGroupDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OsmanagementManagedInstanceGroupDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *OsmanagementManagedInstanceGroupDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OsmanagementManagedInstanceGroupDataSourceCrud) Get() error {
+func (s *OsmanagementManagedInstanceGroupDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_osmanagement.GetManagedInstanceGroupRequest{}
 
 	if managedInstanceGroupId, ok := s.D.GetOkExists("managed_instance_group_id"); ok {
@@ -50,7 +51,7 @@ func (s *OsmanagementManagedInstanceGroupDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osmanagement")
 
-	response, err := s.Client.GetManagedInstanceGroup(context.Background(), request)
+	response, err := s.Client.GetManagedInstanceGroup(ctx, request)
 	if err != nil {
 		return err
 	}
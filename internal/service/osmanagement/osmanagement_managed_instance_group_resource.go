@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_osmanagement "github.com/oracle/oci-go-sdk/v65/osmanagement"
@@ -19,11 +20,11 @@ func OsmanagementManagedInstanceGroupResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOsmanagementManagedInstanceGroup,
-		Read:     readOsmanagementManagedInstanceGroup,
-		Update:   updateOsmanagementManagedInstanceGroup,
-		Delete:   deleteOsmanagementManagedInstanceGroup,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOsmanagementManagedInstanceGroup,
+		ReadContext:   readOsmanagementManagedInstanceGroup,
+		UpdateContext: updateOsmanagementManagedInstanceGroup,
+		DeleteContext: deleteOsmanagementManagedInstanceGroup,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -95,37 +96,37 @@ func OsmanagementManagedInstanceGroupResource() *schema.Resource {
 	}
 }
 
-func createOsmanagementManagedInstanceGroup(d *schema.ResourceData, m interface{}) error {
+func createOsmanagementManagedInstanceGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstanceGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readOsmanagementManagedInstanceGroup(d *schema.ResourceData, m interface{}) error {
+func readOsmanagementManagedInstanceGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstanceGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateOsmanagementManagedInstanceGroup(d *schema.ResourceData, m interface{}) error {
+func updateOsmanagementManagedInstanceGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstanceGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteOsmanagementManagedInstanceGroup(d *schema.ResourceData, m interface{}) error {
+func deleteOsmanagementManagedInstanceGroup(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstanceGroupResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type OsmanagementManagedInstanceGroupResourceCrud struct {
@@ -163,7 +164,7 @@ func (s *OsmanagementManagedInstanceGroupResourceCrud) DeletedTarget() []string
 	}
 }
 
-func (s *OsmanagementManagedInstanceGroupResourceCrud) Create() error {
+func (s *OsmanagementManagedInstanceGroupResourceCrud) Create(ctx context.Context) error {
 	request := oci_osmanagement.CreateManagedInstanceGroupRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -199,7 +200,7 @@ func (s *OsmanagementManagedInstanceGroupResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
-	response, err := s.Client.CreateManagedInstanceGroup(context.Background(), request)
+	response, err := s.Client.CreateManagedInstanceGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -208,7 +209,7 @@ func (s *OsmanagementManagedInstanceGroupResourceCrud) Create() error {
 	return nil
 }
 
-func (s *OsmanagementManagedInstanceGroupResourceCrud) Get() error {
+func (s *OsmanagementManagedInstanceGroupResourceCrud) Get(ctx context.Context) error {
 	request := oci_osmanagement.GetManagedInstanceGroupRequest{}
 
 	tmp := s.D.Id()
@@ -216,7 +217,7 @@ func (s *OsmanagementManagedInstanceGroupResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
-	response, err := s.Client.GetManagedInstanceGroup(context.Background(), request)
+	response, err := s.Client.GetManagedInstanceGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -225,11 +226,11 @@ func (s *OsmanagementManagedInstanceGroupResourceCrud) Get() error {
 	return nil
 }
 
-func (s *OsmanagementManagedInstanceGroupResourceCrud) Update() error {
+func (s *OsmanagementManagedInstanceGroupResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -264,7 +265,7 @@ func (s *OsmanagementManagedInstanceGroupResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
-	response, err := s.Client.UpdateManagedInstanceGroup(context.Background(), request)
+	response, err := s.Client.UpdateManagedInstanceGroup(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -273,7 +274,7 @@ func (s *OsmanagementManagedInstanceGroupResourceCrud) Update() error {
 	return nil
 }
 
-func (s *OsmanagementManagedInstanceGroupResourceCrud) Delete() error {
+func (s *OsmanagementManagedInstanceGroupResourceCrud) Delete(ctx context.Context) error {
 	request := oci_osmanagement.DeleteManagedInstanceGroupRequest{}
 
 	tmp := s.D.Id()
@@ -281,7 +282,7 @@ func (s *OsmanagementManagedInstanceGroupResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
-	_, err := s.Client.DeleteManagedInstanceGroup(context.Background(), request)
+	_, err := s.Client.DeleteManagedInstanceGroup(ctx, request)
 	return err
 }
 
@@ -331,7 +332,7 @@ func IdToMap(obj oci_osmanagement.Id) map[string]interface{} {
 	return result
 }
 
-func (s *OsmanagementManagedInstanceGroupResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *OsmanagementManagedInstanceGroupResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_osmanagement.ChangeManagedInstanceGroupCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -342,12 +343,12 @@ func (s *OsmanagementManagedInstanceGroupResourceCrud) updateCompartment(compart
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
-	_, err := s.Client.ChangeManagedInstanceGroupCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeManagedInstanceGroupCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
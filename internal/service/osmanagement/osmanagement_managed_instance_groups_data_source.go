@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osmanagement "github.com/oracle/oci-go-sdk/v65/osmanagement"
 )
 
 func OsmanagementManagedInstanceGroupsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOsmanagementManagedInstanceGroups,
+		ReadContext: readOsmanagementManagedInstanceGroups,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,12 +44,12 @@ func OsmanagementManagedInstanceGroupsDataSource() *schema.Resource {
 	}
 }
 
-func readOsmanagementManagedInstanceGroups(d *schema.ResourceData, m interface{}) error {
+func readOsmanagementManagedInstanceGroups(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstanceGroupsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OsmanagementManagedInstanceGroupsDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *OsmanagementManagedInstanceGroupsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OsmanagementManagedInstanceGroupsDataSourceCrud) Get() error {
+func (s *OsmanagementManagedInstanceGroupsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_osmanagement.ListManagedInstanceGroupsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -84,7 +85,7 @@ func (s *OsmanagementManagedInstanceGroupsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osmanagement")
 
-	response, err := s.Client.ListManagedInstanceGroups(context.Background(), request)
+	response, err := s.Client.ListManagedInstanceGroups(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -93,7 +94,7 @@ func (s *OsmanagementManagedInstanceGroupsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListManagedInstanceGroups(context.Background(), request)
+		listResponse, err := s.Client.ListManagedInstanceGroups(ctx, request)
 		if err != nil {
 			return err
 		}
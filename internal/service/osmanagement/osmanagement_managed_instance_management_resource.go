@@ -13,17 +13,18 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osmanagement "github.com/oracle/oci-go-sdk/v65/osmanagement"
 )
 
 func OsmanagementManagedInstanceManagementResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOsmanagementManagedInstanceManagement,
-		Read:     readOsmanagementManagedInstanceManagement,
-		Update:   updateOsmanagementManagedInstanceManagement,
-		Delete:   deleteOsmanagementManagedInstanceManagement,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOsmanagementManagedInstanceManagement,
+		ReadContext:   readOsmanagementManagedInstanceManagement,
+		UpdateContext: updateOsmanagementManagedInstanceManagement,
+		DeleteContext: deleteOsmanagementManagedInstanceManagement,
 		Schema: map[string]*schema.Schema{
 			"managed_instance_id": {
 				Type:     schema.TypeString,
@@ -144,31 +145,31 @@ func OsmanagementManagedInstanceManagementResource() *schema.Resource {
 	}
 }
 
-func createOsmanagementManagedInstanceManagement(d *schema.ResourceData, m interface{}) error {
+func createOsmanagementManagedInstanceManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstanceManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readOsmanagementManagedInstanceManagement(d *schema.ResourceData, m interface{}) error {
+func readOsmanagementManagedInstanceManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstanceManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateOsmanagementManagedInstanceManagement(d *schema.ResourceData, m interface{}) error {
+func updateOsmanagementManagedInstanceManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstanceManagementResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteOsmanagementManagedInstanceManagement(d *schema.ResourceData, m interface{}) error {
+func deleteOsmanagementManagedInstanceManagement(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -183,8 +184,8 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *OsmanagementManagedInstanceManagementResourceCrud) Create() error {
-	e := s.Get()
+func (s *OsmanagementManagedInstanceManagementResourceCrud) Create(ctx context.Context) error {
+	e := s.Get(ctx)
 	if e != nil {
 		return e
 	}
@@ -197,7 +198,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) Create() error {
 
 	defer func() {
 		// get latest state of the instance
-		err := s.Get()
+		err := s.Get(ctx)
 		if err != nil {
 			log.Printf("[ERROR] unable to invoke GET() after CREATE '%v'", err)
 		}
@@ -208,31 +209,31 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) Create() error {
 	}()
 
 	// detach old existing parent software sources and attach new parent software sources present in config
-	e = s.createParentSoftwareSource(managedInstanceId)
+	e = s.createParentSoftwareSource(ctx, managedInstanceId)
 	if e != nil {
 		return e
 	}
 
 	// Update resource response
-	e = s.Get()
+	e = s.Get(ctx)
 	if e != nil {
 		return e
 	}
 
 	// detach old existing child software sources and attach new child software sources present in config
-	e = s.createChildSoftwareSources(managedInstanceId)
+	e = s.createChildSoftwareSources(ctx, managedInstanceId)
 	if e != nil {
 		return e
 	}
 
 	// Update the resource response
-	e = s.Get()
+	e = s.Get(ctx)
 	if e != nil {
 		return e
 	}
 
 	// detach old existing managed instance groups and attach new managed instance groups present in config
-	e = s.createManagedInstanceGroups(managedInstanceId)
+	e = s.createManagedInstanceGroups(ctx, managedInstanceId)
 	if e != nil {
 		return e
 	}
@@ -240,7 +241,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) Create() error {
 	return nil
 }
 
-func (s *OsmanagementManagedInstanceManagementResourceCrud) Update() error {
+func (s *OsmanagementManagedInstanceManagementResourceCrud) Update(ctx context.Context) error {
 
 	var managedInstanceId *string
 	if id, ok := s.D.GetOkExists("managed_instance_id"); ok {
@@ -250,7 +251,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) Update() error {
 
 	defer func() {
 		// get latest state of the instance
-		err := s.Get()
+		err := s.Get(ctx)
 		if err != nil {
 			log.Printf("[ERROR] unable to invoke GET() after UPDATE '%v'", err)
 		}
@@ -260,23 +261,23 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) Update() error {
 		}
 	}()
 
-	err := s.updateParentSoftwareSource(managedInstanceId)
+	err := s.updateParentSoftwareSource(ctx, managedInstanceId)
 	if err != nil {
 		return err
 	}
-	err = s.updateChildSoftwareSources(managedInstanceId)
+	err = s.updateChildSoftwareSources(ctx, managedInstanceId)
 	if err != nil {
 		return err
 	}
-	err = s.updateManagedInstanceGroups(managedInstanceId)
+	err = s.updateManagedInstanceGroups(ctx, managedInstanceId)
 	if err != nil {
 		return err
 	}
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func (s *OsmanagementManagedInstanceManagementResourceCrud) Get() error {
+func (s *OsmanagementManagedInstanceManagementResourceCrud) Get(ctx context.Context) error {
 	request := oci_osmanagement.GetManagedInstanceRequest{}
 
 	if managedInstanceId, ok := s.D.GetOkExists("managed_instance_id"); ok {
@@ -286,7 +287,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osmanagement")
 
-	response, err := s.Client.GetManagedInstance(context.Background(), request)
+	response, err := s.Client.GetManagedInstance(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -449,7 +450,7 @@ func softwareSourceHashCodeForSets(v interface{}) int {
 	return utils.GetStringHashcode(buf.String())
 }
 
-func (s *OsmanagementManagedInstanceManagementResourceCrud) createParentSoftwareSource(managedInstanceId *string) error {
+func (s *OsmanagementManagedInstanceManagementResourceCrud) createParentSoftwareSource(ctx context.Context, managedInstanceId *string) error {
 	// detach old groups
 	if s.Res.ParentSoftwareSource != nil {
 		oldSourceToDetach := []interface{}{SoftwareSourceIdToMap(s.Res.ParentSoftwareSource)}
@@ -459,7 +460,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) createParentSoftware
 			detachParentSourceRequest.ManagedInstanceId = managedInstanceId
 			detachParentSourceRequest.DetachParentSoftwareSourceFromManagedInstanceDetails = opp
 
-			_, detachErr := s.Client.DetachParentSoftwareSourceFromManagedInstance(context.Background(), detachParentSourceRequest)
+			_, detachErr := s.Client.DetachParentSoftwareSourceFromManagedInstance(ctx, detachParentSourceRequest)
 			if detachErr != nil {
 				return fmt.Errorf("failed to detach parent software source, error: %v", detachErr)
 			}
@@ -474,7 +475,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) createParentSoftware
 			attachParentSourceRequest.ManagedInstanceId = managedInstanceId
 			attachParentSourceRequest.AttachParentSoftwareSourceToManagedInstanceDetails = opp
 
-			_, attachErr := s.Client.AttachParentSoftwareSourceToManagedInstance(context.Background(), attachParentSourceRequest)
+			_, attachErr := s.Client.AttachParentSoftwareSourceToManagedInstance(ctx, attachParentSourceRequest)
 			if attachErr != nil {
 				return fmt.Errorf("failed to attach parent software source, error: %v", attachErr)
 			}
@@ -483,7 +484,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) createParentSoftware
 	return nil
 }
 
-func (s *OsmanagementManagedInstanceManagementResourceCrud) updateParentSoftwareSource(managedInstanceId *string) error {
+func (s *OsmanagementManagedInstanceManagementResourceCrud) updateParentSoftwareSource(ctx context.Context, managedInstanceId *string) error {
 	// check if any Update is made to parent software source
 	if _, ok := s.D.GetOkExists("parent_software_source"); ok && s.D.HasChange("parent_software_source") {
 		o, n := s.D.GetChange("parent_software_source")
@@ -506,7 +507,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) updateParentSoftware
 			detachParentSourceRequest.ManagedInstanceId = managedInstanceId
 			detachParentSourceRequest.DetachParentSoftwareSourceFromManagedInstanceDetails = opp
 
-			_, detachErr := s.Client.DetachParentSoftwareSourceFromManagedInstance(context.Background(), detachParentSourceRequest)
+			_, detachErr := s.Client.DetachParentSoftwareSourceFromManagedInstance(ctx, detachParentSourceRequest)
 			if detachErr != nil {
 				return fmt.Errorf("failed to detach parent software source, error: %v", detachErr)
 			}
@@ -518,7 +519,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) updateParentSoftware
 			attachParentSourceRequest.ManagedInstanceId = managedInstanceId
 			attachParentSourceRequest.AttachParentSoftwareSourceToManagedInstanceDetails = opp
 
-			_, attachErr := s.Client.AttachParentSoftwareSourceToManagedInstance(context.Background(), attachParentSourceRequest)
+			_, attachErr := s.Client.AttachParentSoftwareSourceToManagedInstance(ctx, attachParentSourceRequest)
 			if attachErr != nil {
 				return fmt.Errorf("failed to attach parent software source, error: %v", attachErr)
 			}
@@ -527,7 +528,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) updateParentSoftware
 	return nil
 }
 
-func (s *OsmanagementManagedInstanceManagementResourceCrud) createChildSoftwareSources(managedInstanceId *string) error {
+func (s *OsmanagementManagedInstanceManagementResourceCrud) createChildSoftwareSources(ctx context.Context, managedInstanceId *string) error {
 	// detach old child software sources
 	childSoftwareSources := []interface{}{}
 	for _, item := range s.Res.ChildSoftwareSources {
@@ -540,7 +541,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) createChildSoftwareS
 		detachChildSourceRequest.ManagedInstanceId = managedInstanceId
 		detachChildSourceRequest.DetachChildSoftwareSourceFromManagedInstanceDetails = opp
 
-		_, detachErr := s.Client.DetachChildSoftwareSourceFromManagedInstance(context.Background(), detachChildSourceRequest)
+		_, detachErr := s.Client.DetachChildSoftwareSourceFromManagedInstance(ctx, detachChildSourceRequest)
 		if detachErr != nil {
 			return fmt.Errorf("failed to detach child software source, error: %v", detachErr)
 		}
@@ -555,7 +556,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) createChildSoftwareS
 			attachChildSourceRequest.ManagedInstanceId = managedInstanceId
 			attachChildSourceRequest.AttachChildSoftwareSourceToManagedInstanceDetails = opp
 
-			_, attachErr := s.Client.AttachChildSoftwareSourceToManagedInstance(context.Background(), attachChildSourceRequest)
+			_, attachErr := s.Client.AttachChildSoftwareSourceToManagedInstance(ctx, attachChildSourceRequest)
 			if attachErr != nil {
 				return fmt.Errorf("failed to attach child software source, error: %v", attachErr)
 			}
@@ -564,7 +565,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) createChildSoftwareS
 	return nil
 }
 
-func (s *OsmanagementManagedInstanceManagementResourceCrud) updateChildSoftwareSources(managedInstanceId *string) error {
+func (s *OsmanagementManagedInstanceManagementResourceCrud) updateChildSoftwareSources(ctx context.Context, managedInstanceId *string) error {
 	if _, ok := s.D.GetOkExists("child_software_sources"); ok && s.D.HasChange("child_software_sources") {
 		o, n := s.D.GetChange("child_software_sources")
 		if o == nil {
@@ -586,7 +587,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) updateChildSoftwareS
 			detachChildSourceRequest.ManagedInstanceId = managedInstanceId
 			detachChildSourceRequest.DetachChildSoftwareSourceFromManagedInstanceDetails = opp
 
-			_, detachErr := s.Client.DetachChildSoftwareSourceFromManagedInstance(context.Background(), detachChildSourceRequest)
+			_, detachErr := s.Client.DetachChildSoftwareSourceFromManagedInstance(ctx, detachChildSourceRequest)
 			if detachErr != nil {
 				return fmt.Errorf("failed to detach child software source, error: %v", detachErr)
 			}
@@ -598,7 +599,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) updateChildSoftwareS
 			attachChildSourceRequest.ManagedInstanceId = managedInstanceId
 			attachChildSourceRequest.AttachChildSoftwareSourceToManagedInstanceDetails = opp
 
-			_, attachErr := s.Client.AttachChildSoftwareSourceToManagedInstance(context.Background(), attachChildSourceRequest)
+			_, attachErr := s.Client.AttachChildSoftwareSourceToManagedInstance(ctx, attachChildSourceRequest)
 			if attachErr != nil {
 				return fmt.Errorf("failed to attach child software source, error: %v", attachErr)
 			}
@@ -607,7 +608,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) updateChildSoftwareS
 	return nil
 }
 
-func (s *OsmanagementManagedInstanceManagementResourceCrud) createManagedInstanceGroups(managedInstanceId *string) error {
+func (s *OsmanagementManagedInstanceManagementResourceCrud) createManagedInstanceGroups(ctx context.Context, managedInstanceId *string) error {
 	// detach old managed groups
 	oldGroups := []interface{}{}
 	for _, item := range s.Res.ManagedInstanceGroups {
@@ -620,7 +621,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) createManagedInstanc
 		detachManagedInstanceFromGroupRequest.ManagedInstanceId = managedInstanceId
 		detachManagedInstanceFromGroupRequest.ManagedInstanceGroupId = managedInstanceGroupId
 
-		_, detachErr := s.Client.DetachManagedInstanceFromManagedInstanceGroup(context.Background(), detachManagedInstanceFromGroupRequest)
+		_, detachErr := s.Client.DetachManagedInstanceFromManagedInstanceGroup(ctx, detachManagedInstanceFromGroupRequest)
 		if detachErr != nil {
 			return fmt.Errorf("failed to detach managed instance from managed instance Group request, error: %v", detachErr)
 		}
@@ -635,7 +636,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) createManagedInstanc
 			attachManagedInstanceToGroupRequest.ManagedInstanceId = managedInstanceId
 			attachManagedInstanceToGroupRequest.ManagedInstanceGroupId = managedInstanceGroupId
 
-			_, attachErr := s.Client.AttachManagedInstanceToManagedInstanceGroup(context.Background(), attachManagedInstanceToGroupRequest)
+			_, attachErr := s.Client.AttachManagedInstanceToManagedInstanceGroup(ctx, attachManagedInstanceToGroupRequest)
 			if attachErr != nil {
 				return fmt.Errorf("failed to attach managed instance to  managed instance Group, error: %v", attachErr)
 			}
@@ -644,7 +645,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) createManagedInstanc
 	return nil
 }
 
-func (s *OsmanagementManagedInstanceManagementResourceCrud) updateManagedInstanceGroups(managedInstanceId *string) error {
+func (s *OsmanagementManagedInstanceManagementResourceCrud) updateManagedInstanceGroups(ctx context.Context, managedInstanceId *string) error {
 	// check if any Update is made to managed instance groups
 	if _, ok := s.D.GetOkExists("managed_instance_groups"); ok && s.D.HasChange("managed_instance_groups") {
 		o, n := s.D.GetChange("managed_instance_groups")
@@ -667,7 +668,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) updateManagedInstanc
 			detachManagedInstanceFromGroupRequest.ManagedInstanceId = managedInstanceId
 			detachManagedInstanceFromGroupRequest.ManagedInstanceGroupId = managedInstanceGroupId
 
-			_, detachErr := s.Client.DetachManagedInstanceFromManagedInstanceGroup(context.Background(), detachManagedInstanceFromGroupRequest)
+			_, detachErr := s.Client.DetachManagedInstanceFromManagedInstanceGroup(ctx, detachManagedInstanceFromGroupRequest)
 			if detachErr != nil {
 				return fmt.Errorf("failed to detach managed instance from managed instance Group request, error: %v", detachErr)
 			}
@@ -679,7 +680,7 @@ func (s *OsmanagementManagedInstanceManagementResourceCrud) updateManagedInstanc
 			attachManagedInstanceToGroupRequest.ManagedInstanceId = managedInstanceId
 			attachManagedInstanceToGroupRequest.ManagedInstanceGroupId = managedInstanceGroupId
 
-			_, attachErr := s.Client.AttachManagedInstanceToManagedInstanceGroup(context.Background(), attachManagedInstanceToGroupRequest)
+			_, attachErr := s.Client.AttachManagedInstanceToManagedInstanceGroup(ctx, attachManagedInstanceToGroupRequest)
 			if attachErr != nil {
 				return fmt.Errorf("failed to attach parent software source, error: %v", attachErr)
 			}
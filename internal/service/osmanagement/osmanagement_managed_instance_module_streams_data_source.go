@@ -6,6 +6,7 @@ package osmanagement
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osmanagement "github.com/oracle/oci-go-sdk/v65/osmanagement"
 
@@ -15,7 +16,7 @@ import (
 
 func OsmanagementManagedInstanceModuleStreamsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOsmanagementManagedInstanceModuleStreams,
+		ReadContext: readOsmanagementManagedInstanceModuleStreams,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -108,12 +109,12 @@ func OsmanagementManagedInstanceModuleStreamsDataSource() *schema.Resource {
 	}
 }
 
-func readOsmanagementManagedInstanceModuleStreams(d *schema.ResourceData, m interface{}) error {
+func readOsmanagementManagedInstanceModuleStreams(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstanceModuleStreamsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OsmanagementManagedInstanceModuleStreamsDataSourceCrud struct {
@@ -126,7 +127,7 @@ func (s *OsmanagementManagedInstanceModuleStreamsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OsmanagementManagedInstanceModuleStreamsDataSourceCrud) Get() error {
+func (s *OsmanagementManagedInstanceModuleStreamsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_osmanagement.ListModuleStreamsOnManagedInstanceRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -155,7 +156,7 @@ func (s *OsmanagementManagedInstanceModuleStreamsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osmanagement")
 
-	response, err := s.Client.ListModuleStreamsOnManagedInstance(context.Background(), request)
+	response, err := s.Client.ListModuleStreamsOnManagedInstance(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -164,7 +165,7 @@ func (s *OsmanagementManagedInstanceModuleStreamsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListModuleStreamsOnManagedInstance(context.Background(), request)
+		listResponse, err := s.Client.ListModuleStreamsOnManagedInstance(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_osmanagement "github.com/oracle/oci-go-sdk/v65/osmanagement"
@@ -19,11 +20,11 @@ func OsmanagementManagedInstanceResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOsmanagementManagedInstance,
-		Read:     readOsmanagementManagedInstance,
-		Update:   updateOsmanagementManagedInstance,
-		Delete:   deleteOsmanagementManagedInstance,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOsmanagementManagedInstance,
+		ReadContext:   readOsmanagementManagedInstance,
+		UpdateContext: updateOsmanagementManagedInstance,
+		DeleteContext: deleteOsmanagementManagedInstance,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"managed_instance_id": {
@@ -205,31 +206,31 @@ func OsmanagementManagedInstanceResource() *schema.Resource {
 	}
 }
 
-func createOsmanagementManagedInstance(d *schema.ResourceData, m interface{}) error {
+func createOsmanagementManagedInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readOsmanagementManagedInstance(d *schema.ResourceData, m interface{}) error {
+func readOsmanagementManagedInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateOsmanagementManagedInstance(d *schema.ResourceData, m interface{}) error {
+func updateOsmanagementManagedInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteOsmanagementManagedInstance(d *schema.ResourceData, m interface{}) error {
+func deleteOsmanagementManagedInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -244,7 +245,7 @@ func (s *OsmanagementManagedInstanceResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *OsmanagementManagedInstanceResourceCrud) Create() error {
+func (s *OsmanagementManagedInstanceResourceCrud) Create(ctx context.Context) error {
 	request := oci_osmanagement.UpdateManagedInstanceRequest{}
 
 	if isDataCollectionAuthorized, ok := s.D.GetOkExists("is_data_collection_authorized"); ok {
@@ -264,7 +265,7 @@ func (s *OsmanagementManagedInstanceResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
-	response, err := s.Client.UpdateManagedInstance(context.Background(), request)
+	response, err := s.Client.UpdateManagedInstance(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -273,7 +274,7 @@ func (s *OsmanagementManagedInstanceResourceCrud) Create() error {
 	return nil
 }
 
-func (s *OsmanagementManagedInstanceResourceCrud) Get() error {
+func (s *OsmanagementManagedInstanceResourceCrud) Get(ctx context.Context) error {
 	request := oci_osmanagement.GetManagedInstanceRequest{}
 
 	tmp := s.D.Id()
@@ -281,7 +282,7 @@ func (s *OsmanagementManagedInstanceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
-	response, err := s.Client.GetManagedInstance(context.Background(), request)
+	response, err := s.Client.GetManagedInstance(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -290,7 +291,7 @@ func (s *OsmanagementManagedInstanceResourceCrud) Get() error {
 	return nil
 }
 
-func (s *OsmanagementManagedInstanceResourceCrud) Update() error {
+func (s *OsmanagementManagedInstanceResourceCrud) Update(ctx context.Context) error {
 	request := oci_osmanagement.UpdateManagedInstanceRequest{}
 
 	if isDataCollectionAuthorized, ok := s.D.GetOkExists("is_data_collection_authorized"); ok {
@@ -308,7 +309,7 @@ func (s *OsmanagementManagedInstanceResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
-	response, err := s.Client.UpdateManagedInstance(context.Background(), request)
+	response, err := s.Client.UpdateManagedInstance(ctx, request)
 	if err != nil {
 		return err
 	}
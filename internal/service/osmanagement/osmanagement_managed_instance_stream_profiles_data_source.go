@@ -6,6 +6,7 @@ package osmanagement
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osmanagement "github.com/oracle/oci-go-sdk/v65/osmanagement"
 
@@ -15,7 +16,7 @@ import (
 
 func OsmanagementManagedInstanceStreamProfilesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOsmanagementManagedInstanceStreamProfiles,
+		ReadContext: readOsmanagementManagedInstanceStreamProfiles,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -79,12 +80,12 @@ func OsmanagementManagedInstanceStreamProfilesDataSource() *schema.Resource {
 	}
 }
 
-func readOsmanagementManagedInstanceStreamProfiles(d *schema.ResourceData, m interface{}) error {
+func readOsmanagementManagedInstanceStreamProfiles(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstanceStreamProfilesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OsmanagementManagedInstanceStreamProfilesDataSourceCrud struct {
@@ -97,7 +98,7 @@ func (s *OsmanagementManagedInstanceStreamProfilesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OsmanagementManagedInstanceStreamProfilesDataSourceCrud) Get() error {
+func (s *OsmanagementManagedInstanceStreamProfilesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_osmanagement.ListModuleStreamProfilesOnManagedInstanceRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -131,7 +132,7 @@ func (s *OsmanagementManagedInstanceStreamProfilesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osmanagement")
 
-	response, err := s.Client.ListModuleStreamProfilesOnManagedInstance(context.Background(), request)
+	response, err := s.Client.ListModuleStreamProfilesOnManagedInstance(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -140,7 +141,7 @@ func (s *OsmanagementManagedInstanceStreamProfilesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListModuleStreamProfilesOnManagedInstance(context.Background(), request)
+		listResponse, err := s.Client.ListModuleStreamProfilesOnManagedInstance(ctx, request)
 		if err != nil {
 			return err
 		}
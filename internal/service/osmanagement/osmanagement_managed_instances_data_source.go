@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osmanagement "github.com/oracle/oci-go-sdk/v65/osmanagement"
 )
 
 func OsmanagementManagedInstancesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOsmanagementManagedInstances,
+		ReadContext: readOsmanagementManagedInstances,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -39,12 +40,12 @@ func OsmanagementManagedInstancesDataSource() *schema.Resource {
 	}
 }
 
-func readOsmanagementManagedInstances(d *schema.ResourceData, m interface{}) error {
+func readOsmanagementManagedInstances(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementManagedInstancesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OsmanagementManagedInstancesDataSourceCrud struct {
@@ -57,7 +58,7 @@ func (s *OsmanagementManagedInstancesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OsmanagementManagedInstancesDataSourceCrud) Get() error {
+func (s *OsmanagementManagedInstancesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_osmanagement.ListManagedInstancesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -76,7 +77,7 @@ func (s *OsmanagementManagedInstancesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osmanagement")
 
-	response, err := s.Client.ListManagedInstances(context.Background(), request)
+	response, err := s.Client.ListManagedInstances(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -85,7 +86,7 @@ func (s *OsmanagementManagedInstancesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListManagedInstances(context.Background(), request)
+		listResponse, err := s.Client.ListManagedInstances(ctx, request)
 		if err != nil {
 			return err
 		}
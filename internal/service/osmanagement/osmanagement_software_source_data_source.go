@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osmanagement "github.com/oracle/oci-go-sdk/v65/osmanagement"
 )
@@ -22,12 +23,12 @@ func OsmanagementSoftwareSourceDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OsmanagementSoftwareSourceResource(), fieldMap, readSingularOsmanagementSoftwareSource)
 }
 
-func readSingularOsmanagementSoftwareSource(d *schema.ResourceData, m interface{}) error {
+func readSingularOsmanagementSoftwareSource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementSoftwareSourceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OsmanagementSoftwareSourceDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *OsmanagementSoftwareSourceDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OsmanagementSoftwareSourceDataSourceCrud) Get() error {
+func (s *OsmanagementSoftwareSourceDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_osmanagement.GetSoftwareSourceRequest{}
 
 	if softwareSourceId, ok := s.D.GetOkExists("software_source_id"); ok {
@@ -50,7 +51,7 @@ func (s *OsmanagementSoftwareSourceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osmanagement")
 
-	response, err := s.Client.GetSoftwareSource(context.Background(), request)
+	response, err := s.Client.GetSoftwareSource(ctx, request)
 	if err != nil {
 		return err
 	}
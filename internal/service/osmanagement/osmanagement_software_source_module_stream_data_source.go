@@ -6,6 +6,7 @@ package osmanagement
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osmanagement "github.com/oracle/oci-go-sdk/v65/osmanagement"
 
@@ -15,7 +16,7 @@ import (
 
 func OsmanagementSoftwareSourceModuleStreamDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOsmanagementSoftwareSourceModuleStream,
+		ReadContext: readSingularOsmanagementSoftwareSourceModuleStream,
 		Schema: map[string]*schema.Schema{
 			"module_name": {
 				Type:     schema.TypeString,
@@ -60,12 +61,12 @@ func OsmanagementSoftwareSourceModuleStreamDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOsmanagementSoftwareSourceModuleStream(d *schema.ResourceData, m interface{}) error {
+func readSingularOsmanagementSoftwareSourceModuleStream(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementSoftwareSourceModuleStreamDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OsmanagementSoftwareSourceModuleStreamDataSourceCrud struct {
@@ -78,7 +79,7 @@ func (s *OsmanagementSoftwareSourceModuleStreamDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OsmanagementSoftwareSourceModuleStreamDataSourceCrud) Get() error {
+func (s *OsmanagementSoftwareSourceModuleStreamDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_osmanagement.GetModuleStreamRequest{}
 
 	if moduleName, ok := s.D.GetOkExists("module_name"); ok {
@@ -98,7 +99,7 @@ func (s *OsmanagementSoftwareSourceModuleStreamDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osmanagement")
 
-	response, err := s.Client.GetModuleStream(context.Background(), request)
+	response, err := s.Client.GetModuleStream(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package osmanagement
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osmanagement "github.com/oracle/oci-go-sdk/v65/osmanagement"
 
@@ -15,7 +16,7 @@ import (
 
 func OsmanagementSoftwareSourceModuleStreamProfileDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOsmanagementSoftwareSourceModuleStreamProfile,
+		ReadContext: readSingularOsmanagementSoftwareSourceModuleStreamProfile,
 		Schema: map[string]*schema.Schema{
 			"module_name": {
 				Type:     schema.TypeString,
@@ -53,12 +54,12 @@ func OsmanagementSoftwareSourceModuleStreamProfileDataSource() *schema.Resource
 	}
 }
 
-func readSingularOsmanagementSoftwareSourceModuleStreamProfile(d *schema.ResourceData, m interface{}) error {
+func readSingularOsmanagementSoftwareSourceModuleStreamProfile(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementSoftwareSourceModuleStreamProfileDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OsmanagementSoftwareSourceModuleStreamProfileDataSourceCrud struct {
@@ -71,7 +72,7 @@ func (s *OsmanagementSoftwareSourceModuleStreamProfileDataSourceCrud) VoidState(
 	s.D.SetId("")
 }
 
-func (s *OsmanagementSoftwareSourceModuleStreamProfileDataSourceCrud) Get() error {
+func (s *OsmanagementSoftwareSourceModuleStreamProfileDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_osmanagement.GetModuleStreamProfileRequest{}
 
 	if moduleName, ok := s.D.GetOkExists("module_name"); ok {
@@ -96,7 +97,7 @@ func (s *OsmanagementSoftwareSourceModuleStreamProfileDataSourceCrud) Get() erro
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osmanagement")
 
-	response, err := s.Client.GetModuleStreamProfile(context.Background(), request)
+	response, err := s.Client.GetModuleStreamProfile(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_osmanagement "github.com/oracle/oci-go-sdk/v65/osmanagement"
@@ -19,11 +20,11 @@ func OsmanagementSoftwareSourceResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOsmanagementSoftwareSource,
-		Read:     readOsmanagementSoftwareSource,
-		Update:   updateOsmanagementSoftwareSource,
-		Delete:   deleteOsmanagementSoftwareSource,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOsmanagementSoftwareSource,
+		ReadContext:   readOsmanagementSoftwareSource,
+		UpdateContext: updateOsmanagementSoftwareSource,
+		DeleteContext: deleteOsmanagementSoftwareSource,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"arch_type": {
@@ -148,37 +149,37 @@ func OsmanagementSoftwareSourceResource() *schema.Resource {
 	}
 }
 
-func createOsmanagementSoftwareSource(d *schema.ResourceData, m interface{}) error {
+func createOsmanagementSoftwareSource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementSoftwareSourceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readOsmanagementSoftwareSource(d *schema.ResourceData, m interface{}) error {
+func readOsmanagementSoftwareSource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementSoftwareSourceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateOsmanagementSoftwareSource(d *schema.ResourceData, m interface{}) error {
+func updateOsmanagementSoftwareSource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementSoftwareSourceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteOsmanagementSoftwareSource(d *schema.ResourceData, m interface{}) error {
+func deleteOsmanagementSoftwareSource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementSoftwareSourceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type OsmanagementSoftwareSourceResourceCrud struct {
@@ -216,7 +217,7 @@ func (s *OsmanagementSoftwareSourceResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *OsmanagementSoftwareSourceResourceCrud) Create() error {
+func (s *OsmanagementSoftwareSourceResourceCrud) Create(ctx context.Context) error {
 	request := oci_osmanagement.CreateSoftwareSourceRequest{}
 
 	if archType, ok := s.D.GetOkExists("arch_type"); ok {
@@ -276,7 +277,7 @@ func (s *OsmanagementSoftwareSourceResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
-	response, err := s.Client.CreateSoftwareSource(context.Background(), request)
+	response, err := s.Client.CreateSoftwareSource(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -285,7 +286,7 @@ func (s *OsmanagementSoftwareSourceResourceCrud) Create() error {
 	return nil
 }
 
-func (s *OsmanagementSoftwareSourceResourceCrud) Get() error {
+func (s *OsmanagementSoftwareSourceResourceCrud) Get(ctx context.Context) error {
 	request := oci_osmanagement.GetSoftwareSourceRequest{}
 
 	tmp := s.D.Id()
@@ -293,7 +294,7 @@ func (s *OsmanagementSoftwareSourceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
-	response, err := s.Client.GetSoftwareSource(context.Background(), request)
+	response, err := s.Client.GetSoftwareSource(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -302,11 +303,11 @@ func (s *OsmanagementSoftwareSourceResourceCrud) Get() error {
 	return nil
 }
 
-func (s *OsmanagementSoftwareSourceResourceCrud) Update() error {
+func (s *OsmanagementSoftwareSourceResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -360,7 +361,7 @@ func (s *OsmanagementSoftwareSourceResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
-	response, err := s.Client.UpdateSoftwareSource(context.Background(), request)
+	response, err := s.Client.UpdateSoftwareSource(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -369,7 +370,7 @@ func (s *OsmanagementSoftwareSourceResourceCrud) Update() error {
 	return nil
 }
 
-func (s *OsmanagementSoftwareSourceResourceCrud) Delete() error {
+func (s *OsmanagementSoftwareSourceResourceCrud) Delete(ctx context.Context) error {
 	request := oci_osmanagement.DeleteSoftwareSourceRequest{}
 
 	tmp := s.D.Id()
@@ -377,7 +378,7 @@ func (s *OsmanagementSoftwareSourceResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
-	_, err := s.Client.DeleteSoftwareSource(context.Background(), request)
+	_, err := s.Client.DeleteSoftwareSource(ctx, request)
 	return err
 }
 
@@ -461,7 +462,7 @@ func (s *OsmanagementSoftwareSourceResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *OsmanagementSoftwareSourceResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *OsmanagementSoftwareSourceResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_osmanagement.ChangeSoftwareSourceCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -472,12 +473,12 @@ func (s *OsmanagementSoftwareSourceResourceCrud) updateCompartment(compartment i
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osmanagement")
 
-	_, err := s.Client.ChangeSoftwareSourceCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeSoftwareSourceCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
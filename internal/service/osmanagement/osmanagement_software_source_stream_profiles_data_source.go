@@ -6,6 +6,7 @@ package osmanagement
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osmanagement "github.com/oracle/oci-go-sdk/v65/osmanagement"
 
@@ -15,7 +16,7 @@ import (
 
 func OsmanagementSoftwareSourceStreamProfilesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOsmanagementSoftwareSourceStreamProfiles,
+		ReadContext: readOsmanagementSoftwareSourceStreamProfiles,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -67,12 +68,12 @@ func OsmanagementSoftwareSourceStreamProfilesDataSource() *schema.Resource {
 	}
 }
 
-func readOsmanagementSoftwareSourceStreamProfiles(d *schema.ResourceData, m interface{}) error {
+func readOsmanagementSoftwareSourceStreamProfiles(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsmanagementSoftwareSourceStreamProfilesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OsManagementClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OsmanagementSoftwareSourceStreamProfilesDataSourceCrud struct {
@@ -85,7 +86,7 @@ func (s *OsmanagementSoftwareSourceStreamProfilesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OsmanagementSoftwareSourceStreamProfilesDataSourceCrud) Get() error {
+func (s *OsmanagementSoftwareSourceStreamProfilesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_osmanagement.ListModuleStreamProfilesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -115,7 +116,7 @@ func (s *OsmanagementSoftwareSourceStreamProfilesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osmanagement")
 
-	response, err := s.Client.ListModuleStreamProfiles(context.Background(), request)
+	response, err := s.Client.ListModuleStreamProfiles(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -124,7 +125,7 @@ func (s *OsmanagementSoftwareSourceStreamProfilesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListModuleStreamProfiles(context.Background(), request)
+		listResponse, err := s.Client.ListModuleStreamProfiles(ctx, request)
 		if err != nil {
 			return err
 		}
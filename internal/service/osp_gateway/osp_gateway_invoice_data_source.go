@@ -8,6 +8,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osp_gateway "github.com/oracle/oci-go-sdk/v65/ospgateway"
 
@@ -17,7 +18,7 @@ import (
 
 func OspGatewayInvoiceDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOspGatewayInvoice,
+		ReadContext: readSingularOspGatewayInvoice,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -311,12 +312,12 @@ func OspGatewayInvoiceDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOspGatewayInvoice(d *schema.ResourceData, m interface{}) error {
+func readSingularOspGatewayInvoice(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OspGatewayInvoiceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).InvoiceServiceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OspGatewayInvoiceDataSourceCrud struct {
@@ -329,7 +330,7 @@ func (s *OspGatewayInvoiceDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OspGatewayInvoiceDataSourceCrud) Get() error {
+func (s *OspGatewayInvoiceDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_osp_gateway.GetInvoiceRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -349,7 +350,7 @@ func (s *OspGatewayInvoiceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osp_gateway")
 
-	response, err := s.Client.GetInvoice(context.Background(), request)
+	response, err := s.Client.GetInvoice(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/ospgateway"
@@ -18,7 +19,7 @@ import (
 
 func OspGatewayInvoicesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOspGatewayInvoices,
+		ReadContext: readOspGatewayInvoices,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -391,12 +392,12 @@ func OspGatewayInvoicesDataSource() *schema.Resource {
 	}
 }
 
-func readOspGatewayInvoices(d *schema.ResourceData, m interface{}) error {
+func readOspGatewayInvoices(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OspGatewayInvoicesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).InvoiceServiceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OspGatewayInvoicesDataSourceCrud struct {
@@ -409,7 +410,7 @@ func (s *OspGatewayInvoicesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OspGatewayInvoicesDataSourceCrud) Get() error {
+func (s *OspGatewayInvoicesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_osp_gateway.ListInvoicesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -492,7 +493,7 @@ func (s *OspGatewayInvoicesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osp_gateway")
 
-	response, err := s.Client.ListInvoices(context.Background(), request)
+	response, err := s.Client.ListInvoices(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -501,7 +502,7 @@ func (s *OspGatewayInvoicesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListInvoices(context.Background(), request)
+		listResponse, err := s.Client.ListInvoices(ctx, request)
 		if err != nil {
 			return err
 		}
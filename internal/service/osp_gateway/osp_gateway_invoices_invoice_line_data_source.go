@@ -6,6 +6,7 @@ package osp_gateway
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osp_gateway "github.com/oracle/oci-go-sdk/v65/ospgateway"
 
@@ -15,7 +16,7 @@ import (
 
 func OspGatewayInvoicesInvoiceLineDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOspGatewayInvoicesInvoiceLine,
+		ReadContext: readSingularOspGatewayInvoicesInvoiceLine,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -112,12 +113,12 @@ func OspGatewayInvoicesInvoiceLineDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOspGatewayInvoicesInvoiceLine(d *schema.ResourceData, m interface{}) error {
+func readSingularOspGatewayInvoicesInvoiceLine(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OspGatewayInvoicesInvoiceLineDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).InvoiceServiceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OspGatewayInvoicesInvoiceLineDataSourceCrud struct {
@@ -130,7 +131,7 @@ func (s *OspGatewayInvoicesInvoiceLineDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OspGatewayInvoicesInvoiceLineDataSourceCrud) Get() error {
+func (s *OspGatewayInvoicesInvoiceLineDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_osp_gateway.ListInvoiceLinesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -150,7 +151,7 @@ func (s *OspGatewayInvoicesInvoiceLineDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osp_gateway")
 
-	response, err := s.Client.ListInvoiceLines(context.Background(), request)
+	response, err := s.Client.ListInvoiceLines(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package osp_gateway
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osp_gateway "github.com/oracle/oci-go-sdk/v65/ospgateway"
 
@@ -15,7 +16,7 @@ import (
 
 func OspGatewayInvoicesInvoiceLinesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOspGatewayInvoicesInvoiceLines,
+		ReadContext: readOspGatewayInvoicesInvoiceLines,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -120,12 +121,12 @@ func OspGatewayInvoicesInvoiceLinesDataSource() *schema.Resource {
 	}
 }
 
-func readOspGatewayInvoicesInvoiceLines(d *schema.ResourceData, m interface{}) error {
+func readOspGatewayInvoicesInvoiceLines(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OspGatewayInvoicesInvoiceLinesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).InvoiceServiceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OspGatewayInvoicesInvoiceLinesDataSourceCrud struct {
@@ -138,7 +139,7 @@ func (s *OspGatewayInvoicesInvoiceLinesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OspGatewayInvoicesInvoiceLinesDataSourceCrud) Get() error {
+func (s *OspGatewayInvoicesInvoiceLinesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_osp_gateway.ListInvoiceLinesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -158,7 +159,7 @@ func (s *OspGatewayInvoicesInvoiceLinesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osp_gateway")
 
-	response, err := s.Client.ListInvoiceLines(context.Background(), request)
+	response, err := s.Client.ListInvoiceLines(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -167,7 +168,7 @@ func (s *OspGatewayInvoicesInvoiceLinesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListInvoiceLines(context.Background(), request)
+		listResponse, err := s.Client.ListInvoiceLines(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package osp_gateway
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osp_gateway "github.com/oracle/oci-go-sdk/v65/ospgateway"
 
@@ -30,12 +31,12 @@ func OspGatewaySubscriptionDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(OspGatewaySubscriptionResource(), fieldMap, readSingularOspGatewaySubscription)
 }
 
-func readSingularOspGatewaySubscription(d *schema.ResourceData, m interface{}) error {
+func readSingularOspGatewaySubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OspGatewaySubscriptionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SubscriptionServiceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OspGatewaySubscriptionDataSourceCrud struct {
@@ -48,7 +49,7 @@ func (s *OspGatewaySubscriptionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OspGatewaySubscriptionDataSourceCrud) Get() error {
+func (s *OspGatewaySubscriptionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_osp_gateway.GetSubscriptionRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -68,7 +69,7 @@ func (s *OspGatewaySubscriptionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osp_gateway")
 
-	response, err := s.Client.GetSubscription(context.Background(), request)
+	response, err := s.Client.GetSubscription(ctx, request)
 	if err != nil {
 		return err
 	}
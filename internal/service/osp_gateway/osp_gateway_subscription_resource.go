@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -27,11 +28,11 @@ func OspGatewaySubscriptionResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createOspGatewaySubscription,
-		Read:     readOspGatewaySubscription,
-		Update:   updateOspGatewaySubscription,
-		Delete:   deleteOspGatewaySubscription,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createOspGatewaySubscription,
+		ReadContext:   readOspGatewaySubscription,
+		UpdateContext: updateOspGatewaySubscription,
+		DeleteContext: deleteOspGatewaySubscription,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -614,31 +615,31 @@ func OspGatewaySubscriptionResource() *schema.Resource {
 	}
 }
 
-func createOspGatewaySubscription(d *schema.ResourceData, m interface{}) error {
+func createOspGatewaySubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OspGatewaySubscriptionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SubscriptionServiceClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readOspGatewaySubscription(d *schema.ResourceData, m interface{}) error {
+func readOspGatewaySubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OspGatewaySubscriptionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SubscriptionServiceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateOspGatewaySubscription(d *schema.ResourceData, m interface{}) error {
+func updateOspGatewaySubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OspGatewaySubscriptionResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SubscriptionServiceClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteOspGatewaySubscription(d *schema.ResourceData, m interface{}) error {
+func deleteOspGatewaySubscription(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -653,7 +654,7 @@ func (s *OspGatewaySubscriptionResourceCrud) ID() string {
 	return GetSubscriptionCompositeId(s.D.Get("id").(string))
 }
 
-func (s *OspGatewaySubscriptionResourceCrud) Create() error {
+func (s *OspGatewaySubscriptionResourceCrud) Create(ctx context.Context) error {
 	request := oci_osp_gateway.UpdateSubscriptionRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -689,7 +690,7 @@ func (s *OspGatewaySubscriptionResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osp_gateway")
 
-	response, err := s.Client.UpdateSubscription(context.Background(), request)
+	response, err := s.Client.UpdateSubscription(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -698,7 +699,7 @@ func (s *OspGatewaySubscriptionResourceCrud) Create() error {
 	return nil
 }
 
-func (s *OspGatewaySubscriptionResourceCrud) Get() error {
+func (s *OspGatewaySubscriptionResourceCrud) Get(ctx context.Context) error {
 	request := oci_osp_gateway.GetSubscriptionRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -723,7 +724,7 @@ func (s *OspGatewaySubscriptionResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osp_gateway")
 
-	response, err := s.Client.GetSubscription(context.Background(), request)
+	response, err := s.Client.GetSubscription(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -732,7 +733,7 @@ func (s *OspGatewaySubscriptionResourceCrud) Get() error {
 	return nil
 }
 
-func (s *OspGatewaySubscriptionResourceCrud) Update() error {
+func (s *OspGatewaySubscriptionResourceCrud) Update(ctx context.Context) error {
 	request := oci_osp_gateway.UpdateSubscriptionRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -766,7 +767,7 @@ func (s *OspGatewaySubscriptionResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "osp_gateway")
 
-	response, err := s.Client.UpdateSubscription(context.Background(), request)
+	response, err := s.Client.UpdateSubscription(ctx, request)
 	if err != nil {
 		return err
 	}
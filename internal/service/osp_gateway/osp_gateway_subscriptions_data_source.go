@@ -6,6 +6,7 @@ package osp_gateway
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osp_gateway "github.com/oracle/oci-go-sdk/v65/ospgateway"
 
@@ -15,7 +16,7 @@ import (
 
 func OspGatewaySubscriptionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOspGatewaySubscriptions,
+		ReadContext: readOspGatewaySubscriptions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -44,12 +45,12 @@ func OspGatewaySubscriptionsDataSource() *schema.Resource {
 	}
 }
 
-func readOspGatewaySubscriptions(d *schema.ResourceData, m interface{}) error {
+func readOspGatewaySubscriptions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OspGatewaySubscriptionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SubscriptionServiceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OspGatewaySubscriptionsDataSourceCrud struct {
@@ -62,7 +63,7 @@ func (s *OspGatewaySubscriptionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OspGatewaySubscriptionsDataSourceCrud) Get() error {
+func (s *OspGatewaySubscriptionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_osp_gateway.ListSubscriptionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -77,7 +78,7 @@ func (s *OspGatewaySubscriptionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osp_gateway")
 
-	response, err := s.Client.ListSubscriptions(context.Background(), request)
+	response, err := s.Client.ListSubscriptions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *OspGatewaySubscriptionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSubscriptions(context.Background(), request)
+		listResponse, err := s.Client.ListSubscriptions(ctx, request)
 		if err != nil {
 			return err
 		}
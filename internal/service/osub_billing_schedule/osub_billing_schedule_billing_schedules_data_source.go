@@ -6,6 +6,7 @@ package osub_billing_schedule
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osub_billing_schedule "github.com/oracle/oci-go-sdk/v65/osubbillingschedule"
 
@@ -15,7 +16,7 @@ import (
 
 func OsubBillingScheduleBillingSchedulesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOsubBillingScheduleBillingSchedules,
+		ReadContext: readOsubBillingScheduleBillingSchedules,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -117,12 +118,12 @@ func OsubBillingScheduleBillingSchedulesDataSource() *schema.Resource {
 	}
 }
 
-func readOsubBillingScheduleBillingSchedules(d *schema.ResourceData, m interface{}) error {
+func readOsubBillingScheduleBillingSchedules(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsubBillingScheduleBillingSchedulesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).BillingScheduleClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OsubBillingScheduleBillingSchedulesDataSourceCrud struct {
@@ -135,7 +136,7 @@ func (s *OsubBillingScheduleBillingSchedulesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OsubBillingScheduleBillingSchedulesDataSourceCrud) Get() error {
+func (s *OsubBillingScheduleBillingSchedulesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_osub_billing_schedule.ListBillingSchedulesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -160,7 +161,7 @@ func (s *OsubBillingScheduleBillingSchedulesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osub_billing_schedule")
 
-	response, err := s.Client.ListBillingSchedules(context.Background(), request)
+	response, err := s.Client.ListBillingSchedules(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -169,7 +170,7 @@ func (s *OsubBillingScheduleBillingSchedulesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListBillingSchedules(context.Background(), request)
+		listResponse, err := s.Client.ListBillingSchedules(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osub_organization_subscription "github.com/oracle/oci-go-sdk/v65/osuborganizationsubscription"
 
@@ -16,7 +17,7 @@ import (
 
 func OsubOrganizationSubscriptionOrganizationSubscriptionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOsubOrganizationSubscriptionOrganizationSubscriptions,
+		ReadContext: readOsubOrganizationSubscriptionOrganizationSubscriptions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -101,12 +102,12 @@ func OsubOrganizationSubscriptionOrganizationSubscriptionsDataSource() *schema.R
 	}
 }
 
-func readOsubOrganizationSubscriptionOrganizationSubscriptions(d *schema.ResourceData, m interface{}) error {
+func readOsubOrganizationSubscriptionOrganizationSubscriptions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsubOrganizationSubscriptionOrganizationSubscriptionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).OrganizationSubscriptionClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OsubOrganizationSubscriptionOrganizationSubscriptionsDataSourceCrud struct {
@@ -119,7 +120,7 @@ func (s *OsubOrganizationSubscriptionOrganizationSubscriptionsDataSourceCrud) Vo
 	s.D.SetId("")
 }
 
-func (s *OsubOrganizationSubscriptionOrganizationSubscriptionsDataSourceCrud) Get() error {
+func (s *OsubOrganizationSubscriptionOrganizationSubscriptionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_osub_organization_subscription.ListOrganizationSubscriptionsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -139,7 +140,7 @@ func (s *OsubOrganizationSubscriptionOrganizationSubscriptionsDataSourceCrud) Ge
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osub_organization_subscription")
 
-	response, err := s.Client.ListOrganizationSubscriptions(context.Background(), request)
+	response, err := s.Client.ListOrganizationSubscriptions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -148,7 +149,7 @@ func (s *OsubOrganizationSubscriptionOrganizationSubscriptionsDataSourceCrud) Ge
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListOrganizationSubscriptions(context.Background(), request)
+		listResponse, err := s.Client.ListOrganizationSubscriptions(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package osub_subscription
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osub_subscription "github.com/oracle/oci-go-sdk/v65/osubsubscription"
 
@@ -15,7 +16,7 @@ import (
 
 func OsubSubscriptionCommitmentDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOsubSubscriptionCommitment,
+		ReadContext: readSingularOsubSubscriptionCommitment,
 		Schema: map[string]*schema.Schema{
 			"commitment_id": {
 				Type:     schema.TypeString,
@@ -58,12 +59,12 @@ func OsubSubscriptionCommitmentDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOsubSubscriptionCommitment(d *schema.ResourceData, m interface{}) error {
+func readSingularOsubSubscriptionCommitment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsubSubscriptionCommitmentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CommitmentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OsubSubscriptionCommitmentDataSourceCrud struct {
@@ -76,7 +77,7 @@ func (s *OsubSubscriptionCommitmentDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OsubSubscriptionCommitmentDataSourceCrud) Get() error {
+func (s *OsubSubscriptionCommitmentDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_osub_subscription.GetCommitmentRequest{}
 
 	if commitmentId, ok := s.D.GetOkExists("commitment_id"); ok {
@@ -96,7 +97,7 @@ func (s *OsubSubscriptionCommitmentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osub_subscription")
 
-	response, err := s.Client.GetCommitment(context.Background(), request)
+	response, err := s.Client.GetCommitment(ctx, request)
 	if err != nil {
 		return err
 	}
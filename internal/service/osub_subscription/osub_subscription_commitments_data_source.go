@@ -6,6 +6,7 @@ package osub_subscription
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osub_subscription "github.com/oracle/oci-go-sdk/v65/osubsubscription"
 
@@ -15,7 +16,7 @@ import (
 
 func OsubSubscriptionCommitmentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOsubSubscriptionCommitments,
+		ReadContext: readOsubSubscriptionCommitments,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -79,12 +80,12 @@ func OsubSubscriptionCommitmentsDataSource() *schema.Resource {
 	}
 }
 
-func readOsubSubscriptionCommitments(d *schema.ResourceData, m interface{}) error {
+func readOsubSubscriptionCommitments(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsubSubscriptionCommitmentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).CommitmentClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OsubSubscriptionCommitmentsDataSourceCrud struct {
@@ -97,7 +98,7 @@ func (s *OsubSubscriptionCommitmentsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OsubSubscriptionCommitmentsDataSourceCrud) Get() error {
+func (s *OsubSubscriptionCommitmentsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_osub_subscription.ListCommitmentsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -122,7 +123,7 @@ func (s *OsubSubscriptionCommitmentsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osub_subscription")
 
-	response, err := s.Client.ListCommitments(context.Background(), request)
+	response, err := s.Client.ListCommitments(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -131,7 +132,7 @@ func (s *OsubSubscriptionCommitmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCommitments(context.Background(), request)
+		listResponse, err := s.Client.ListCommitments(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_osub_usage "github.com/oracle/oci-go-sdk/v65/osubusage"
@@ -17,7 +18,7 @@ import (
 
 func OsubUsageComputedUsageAggregatedsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOsubUsageComputedUsageAggregateds,
+		ReadContext: readOsubUsageComputedUsageAggregateds,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -220,12 +221,12 @@ func OsubUsageComputedUsageAggregatedsDataSource() *schema.Resource {
 	}
 }
 
-func readOsubUsageComputedUsageAggregateds(d *schema.ResourceData, m interface{}) error {
+func readOsubUsageComputedUsageAggregateds(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsubUsageComputedUsageAggregatedsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputedUsageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OsubUsageComputedUsageAggregatedsDataSourceCrud struct {
@@ -238,7 +239,7 @@ func (s *OsubUsageComputedUsageAggregatedsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OsubUsageComputedUsageAggregatedsDataSourceCrud) Get() error {
+func (s *OsubUsageComputedUsageAggregatedsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_osub_usage.ListComputedUsageAggregatedsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -283,7 +284,7 @@ func (s *OsubUsageComputedUsageAggregatedsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osub_usage")
 
-	response, err := s.Client.ListComputedUsageAggregateds(context.Background(), request)
+	response, err := s.Client.ListComputedUsageAggregateds(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -292,7 +293,7 @@ func (s *OsubUsageComputedUsageAggregatedsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListComputedUsageAggregateds(context.Background(), request)
+		listResponse, err := s.Client.ListComputedUsageAggregateds(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package osub_usage
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_osub_usage "github.com/oracle/oci-go-sdk/v65/osubusage"
 
@@ -15,7 +16,7 @@ import (
 
 func OsubUsageComputedUsageDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularOsubUsageComputedUsage,
+		ReadContext: readSingularOsubUsageComputedUsage,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -211,12 +212,12 @@ func OsubUsageComputedUsageDataSource() *schema.Resource {
 	}
 }
 
-func readSingularOsubUsageComputedUsage(d *schema.ResourceData, m interface{}) error {
+func readSingularOsubUsageComputedUsage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsubUsageComputedUsageDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputedUsageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OsubUsageComputedUsageDataSourceCrud struct {
@@ -229,7 +230,7 @@ func (s *OsubUsageComputedUsageDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OsubUsageComputedUsageDataSourceCrud) Get() error {
+func (s *OsubUsageComputedUsageDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_osub_usage.GetComputedUsageRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -262,7 +263,7 @@ func (s *OsubUsageComputedUsageDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osub_usage")
 
-	response, err := s.Client.GetComputedUsage(context.Background(), request)
+	response, err := s.Client.GetComputedUsage(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_osub_usage "github.com/oracle/oci-go-sdk/v65/osubusage"
@@ -17,7 +18,7 @@ import (
 
 func OsubUsageComputedUsagesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readOsubUsageComputedUsages,
+		ReadContext: readOsubUsageComputedUsages,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -243,12 +244,12 @@ func OsubUsageComputedUsagesDataSource() *schema.Resource {
 	}
 }
 
-func readOsubUsageComputedUsages(d *schema.ResourceData, m interface{}) error {
+func readOsubUsageComputedUsages(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &OsubUsageComputedUsagesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputedUsageClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type OsubUsageComputedUsagesDataSourceCrud struct {
@@ -261,7 +262,7 @@ func (s *OsubUsageComputedUsagesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *OsubUsageComputedUsagesDataSourceCrud) Get() error {
+func (s *OsubUsageComputedUsagesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_osub_usage.ListComputedUsagesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -307,7 +308,7 @@ func (s *OsubUsageComputedUsagesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "osub_usage")
 
-	response, err := s.Client.ListComputedUsages(context.Background(), request)
+	response, err := s.Client.ListComputedUsages(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -316,7 +317,7 @@ func (s *OsubUsageComputedUsagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListComputedUsages(context.Background(), request)
+		listResponse, err := s.Client.ListComputedUsages(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_resourcemanager "github.com/oracle/oci-go-sdk/v65/resourcemanager"
 
@@ -16,7 +17,7 @@ import (
 
 func ResourcemanagerPrivateEndpointReachableIpDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularResourcemanagerPrivateEndpointReachableIp,
+		ReadContext: readSingularResourcemanagerPrivateEndpointReachableIp,
 		Schema: map[string]*schema.Schema{
 			"private_endpoint_id": {
 				Type:     schema.TypeString,
@@ -35,12 +36,12 @@ func ResourcemanagerPrivateEndpointReachableIpDataSource() *schema.Resource {
 	}
 }
 
-func readSingularResourcemanagerPrivateEndpointReachableIp(d *schema.ResourceData, m interface{}) error {
+func readSingularResourcemanagerPrivateEndpointReachableIp(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ResourcemanagerPrivateEndpointReachableIpDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ResourceManagerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ResourcemanagerPrivateEndpointReachableIpDataSourceCrud struct {
@@ -53,7 +54,7 @@ func (s *ResourcemanagerPrivateEndpointReachableIpDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ResourcemanagerPrivateEndpointReachableIpDataSourceCrud) Get() error {
+func (s *ResourcemanagerPrivateEndpointReachableIpDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_resourcemanager.GetReachableIpRequest{}
 
 	if privateEndpointId, ok := s.D.GetOkExists("private_endpoint_id"); ok {
@@ -68,7 +69,7 @@ func (s *ResourcemanagerPrivateEndpointReachableIpDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "resourcemanager")
 
-	response, err := s.Client.GetReachableIp(context.Background(), request)
+	response, err := s.Client.GetReachableIp(ctx, request)
 	if err != nil {
 		return err
 	}
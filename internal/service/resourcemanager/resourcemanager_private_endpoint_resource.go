@@ -6,6 +6,7 @@ package resourcemanager
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_resourcemanager "github.com/oracle/oci-go-sdk/v65/resourcemanager"
@@ -19,11 +20,11 @@ func ResourcemanagerPrivateEndpointResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createResourcemanagerPrivateEndpoint,
-		Read:     readResourcemanagerPrivateEndpoint,
-		Update:   updateResourcemanagerPrivateEndpoint,
-		Delete:   deleteResourcemanagerPrivateEndpoint,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createResourcemanagerPrivateEndpoint,
+		ReadContext:   readResourcemanagerPrivateEndpoint,
+		UpdateContext: updateResourcemanagerPrivateEndpoint,
+		DeleteContext: deleteResourcemanagerPrivateEndpoint,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -104,37 +105,37 @@ func ResourcemanagerPrivateEndpointResource() *schema.Resource {
 	}
 }
 
-func createResourcemanagerPrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func createResourcemanagerPrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ResourcemanagerPrivateEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ResourceManagerClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readResourcemanagerPrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func readResourcemanagerPrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ResourcemanagerPrivateEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ResourceManagerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateResourcemanagerPrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func updateResourcemanagerPrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ResourcemanagerPrivateEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ResourceManagerClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteResourcemanagerPrivateEndpoint(d *schema.ResourceData, m interface{}) error {
+func deleteResourcemanagerPrivateEndpoint(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ResourcemanagerPrivateEndpointResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ResourceManagerClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type ResourcemanagerPrivateEndpointResourceCrud struct {
@@ -172,7 +173,7 @@ func (s *ResourcemanagerPrivateEndpointResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *ResourcemanagerPrivateEndpointResourceCrud) Create() error {
+func (s *ResourcemanagerPrivateEndpointResourceCrud) Create(ctx context.Context) error {
 	request := oci_resourcemanager.CreatePrivateEndpointRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -245,7 +246,7 @@ func (s *ResourcemanagerPrivateEndpointResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "resourcemanager")
 
-	response, err := s.Client.CreatePrivateEndpoint(context.Background(), request)
+	response, err := s.Client.CreatePrivateEndpoint(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -254,7 +255,7 @@ func (s *ResourcemanagerPrivateEndpointResourceCrud) Create() error {
 	return nil
 }
 
-func (s *ResourcemanagerPrivateEndpointResourceCrud) Get() error {
+func (s *ResourcemanagerPrivateEndpointResourceCrud) Get(ctx context.Context) error {
 	request := oci_resourcemanager.GetPrivateEndpointRequest{}
 
 	tmp := s.D.Id()
@@ -262,7 +263,7 @@ func (s *ResourcemanagerPrivateEndpointResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "resourcemanager")
 
-	response, err := s.Client.GetPrivateEndpoint(context.Background(), request)
+	response, err := s.Client.GetPrivateEndpoint(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -271,11 +272,11 @@ func (s *ResourcemanagerPrivateEndpointResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ResourcemanagerPrivateEndpointResourceCrud) Update() error {
+func (s *ResourcemanagerPrivateEndpointResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -351,7 +352,7 @@ func (s *ResourcemanagerPrivateEndpointResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "resourcemanager")
 
-	response, err := s.Client.UpdatePrivateEndpoint(context.Background(), request)
+	response, err := s.Client.UpdatePrivateEndpoint(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -360,7 +361,7 @@ func (s *ResourcemanagerPrivateEndpointResourceCrud) Update() error {
 	return nil
 }
 
-func (s *ResourcemanagerPrivateEndpointResourceCrud) Delete() error {
+func (s *ResourcemanagerPrivateEndpointResourceCrud) Delete(ctx context.Context) error {
 	request := oci_resourcemanager.DeletePrivateEndpointRequest{}
 
 	tmp := s.D.Id()
@@ -368,7 +369,7 @@ func (s *ResourcemanagerPrivateEndpointResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "resourcemanager")
 
-	_, err := s.Client.DeletePrivateEndpoint(context.Background(), request)
+	_, err := s.Client.DeletePrivateEndpoint(ctx, request)
 	return err
 }
 
@@ -462,7 +463,7 @@ func PrivateEndpointSummaryToMap(obj oci_resourcemanager.PrivateEndpointSummary)
 	return result
 }
 
-func (s *ResourcemanagerPrivateEndpointResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *ResourcemanagerPrivateEndpointResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_resourcemanager.ChangePrivateEndpointCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -473,12 +474,12 @@ func (s *ResourcemanagerPrivateEndpointResourceCrud) updateCompartment(compartme
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "resourcemanager")
 
-	_, err := s.Client.ChangePrivateEndpointCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangePrivateEndpointCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
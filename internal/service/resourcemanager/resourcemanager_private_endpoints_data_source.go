@@ -6,6 +6,7 @@ package resourcemanager
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_resourcemanager "github.com/oracle/oci-go-sdk/v65/resourcemanager"
 
@@ -15,7 +16,7 @@ import (
 
 func ResourcemanagerPrivateEndpointsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readResourcemanagerPrivateEndpoints,
+		ReadContext: readResourcemanagerPrivateEndpoints,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -52,12 +53,12 @@ func ResourcemanagerPrivateEndpointsDataSource() *schema.Resource {
 	}
 }
 
-func readResourcemanagerPrivateEndpoints(d *schema.ResourceData, m interface{}) error {
+func readResourcemanagerPrivateEndpoints(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ResourcemanagerPrivateEndpointsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ResourceManagerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ResourcemanagerPrivateEndpointsDataSourceCrud struct {
@@ -70,7 +71,7 @@ func (s *ResourcemanagerPrivateEndpointsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ResourcemanagerPrivateEndpointsDataSourceCrud) Get() error {
+func (s *ResourcemanagerPrivateEndpointsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_resourcemanager.ListPrivateEndpointsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -95,7 +96,7 @@ func (s *ResourcemanagerPrivateEndpointsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "resourcemanager")
 
-	response, err := s.Client.ListPrivateEndpoints(context.Background(), request)
+	response, err := s.Client.ListPrivateEndpoints(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -104,7 +105,7 @@ func (s *ResourcemanagerPrivateEndpointsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPrivateEndpoints(context.Background(), request)
+		listResponse, err := s.Client.ListPrivateEndpoints(ctx, request)
 		if err != nil {
 			return err
 		}
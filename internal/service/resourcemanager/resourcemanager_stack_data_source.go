@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	oci_resourcemanager "github.com/oracle/oci-go-sdk/v65/resourcemanager"
@@ -17,7 +18,7 @@ import (
 
 func ResourcemanagerStackDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularResourcemanagerStack,
+		ReadContext: readSingularResourcemanagerStack,
 		Schema: map[string]*schema.Schema{
 			"stack_id": {
 				Type:     schema.TypeString,
@@ -93,12 +94,12 @@ func ResourcemanagerStackDataSource() *schema.Resource {
 	}
 }
 
-func readSingularResourcemanagerStack(d *schema.ResourceData, m interface{}) error {
+func readSingularResourcemanagerStack(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ResourcemanagerStackDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ResourceManagerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ResourcemanagerStackDataSourceCrud struct {
@@ -111,7 +112,7 @@ func (s *ResourcemanagerStackDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ResourcemanagerStackDataSourceCrud) Get() error {
+func (s *ResourcemanagerStackDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_resourcemanager.GetStackRequest{}
 
 	if stackId, ok := s.D.GetOkExists("stack_id"); ok {
@@ -121,7 +122,7 @@ func (s *ResourcemanagerStackDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "resourcemanager")
 
-	response, err := s.Client.GetStack(context.Background(), request)
+	response, err := s.Client.GetStack(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -16,7 +16,7 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/resourcemanager"
 )
 
-func CreateResourceManagerStack(resourceManagerClient resourcemanager.ResourceManagerClient, stackDisplayName string, compartment string) (string, error) {
+func CreateResourceManagerStack(ctx context.Context, resourceManagerClient resourcemanager.ResourceManagerClient, stackDisplayName string, compartment string) (string, error) {
 
 	buf := new(bytes.Buffer)
 	zipWriter := zip.NewWriter(buf)
@@ -39,7 +39,7 @@ func CreateResourceManagerStack(resourceManagerClient resourcemanager.ResourceMa
 	// stack representation to assert in tests
 	stackDescription := stackDisplayName + " Description"
 
-	createStackResponse, err := resourceManagerClient.CreateStack(context.Background(), resourcemanager.CreateStackRequest{
+	createStackResponse, err := resourceManagerClient.CreateStack(ctx, resourcemanager.CreateStackRequest{
 		CreateStackDetails: resourcemanager.CreateStackDetails{
 			CompartmentId: &compartment,
 			ConfigSource: resourcemanager.CreateZipUploadConfigSourceDetails{
@@ -64,13 +64,13 @@ func CreateResourceManagerStack(resourceManagerClient resourcemanager.ResourceMa
 
 }
 
-func DestroyResourceManagerStack(resourceManagerClient resourcemanager.ResourceManagerClient, resourceManagerStackId string) error {
+func DestroyResourceManagerStack(ctx context.Context, resourceManagerClient resourcemanager.ResourceManagerClient, resourceManagerStackId string) error {
 
 	if resourceManagerStackId == "" {
 		return fmt.Errorf("[DEBUG] ResourceManager StackId is not specified to delete the stack")
 	}
 
-	_, err := resourceManagerClient.DeleteStack(context.Background(), resourcemanager.DeleteStackRequest{
+	_, err := resourceManagerClient.DeleteStack(ctx, resourcemanager.DeleteStackRequest{
 		StackId: &resourceManagerStackId,
 		RequestMetadata: common.RequestMetadata{
 			RetryPolicy: tfresource.GetRetryPolicy(true, "resourcemanager"),
@@ -8,6 +8,7 @@ import (
 	"io/ioutil"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_resourcemanager "github.com/oracle/oci-go-sdk/v65/resourcemanager"
 
@@ -17,7 +18,7 @@ import (
 
 func ResourcemanagerStackTfStateDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularResourcemanagerStackTfState,
+		ReadContext: readSingularResourcemanagerStackTfState,
 		Schema: map[string]*schema.Schema{
 			"stack_id": {
 				Type:     schema.TypeString,
@@ -32,12 +33,12 @@ func ResourcemanagerStackTfStateDataSource() *schema.Resource {
 	}
 }
 
-func readSingularResourcemanagerStackTfState(d *schema.ResourceData, m interface{}) error {
+func readSingularResourcemanagerStackTfState(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ResourcemanagerStackTfStateDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ResourceManagerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ResourcemanagerStackTfStateDataSourceCrud struct {
@@ -50,7 +51,7 @@ func (s *ResourcemanagerStackTfStateDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ResourcemanagerStackTfStateDataSourceCrud) Get() error {
+func (s *ResourcemanagerStackTfStateDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_resourcemanager.GetStackTfStateRequest{}
 
 	if stackId, ok := s.D.GetOkExists("stack_id"); ok {
@@ -60,7 +61,7 @@ func (s *ResourcemanagerStackTfStateDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "resourcemanager")
 
-	response, err := s.Client.GetStackTfState(context.Background(), request)
+	response, err := s.Client.GetStackTfState(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package resourcemanager
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	oci_resourcemanager "github.com/oracle/oci-go-sdk/v65/resourcemanager"
@@ -16,7 +17,7 @@ import (
 
 func ResourcemanagerStacksDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readResourcemanagerStacks,
+		ReadContext: readResourcemanagerStacks,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -129,12 +130,12 @@ func ResourcemanagerStacksDataSource() *schema.Resource {
 	}
 }
 
-func readResourcemanagerStacks(d *schema.ResourceData, m interface{}) error {
+func readResourcemanagerStacks(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ResourcemanagerStacksDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ResourceManagerClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ResourcemanagerStacksDataSourceCrud struct {
@@ -147,7 +148,7 @@ func (s *ResourcemanagerStacksDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ResourcemanagerStacksDataSourceCrud) Get() error {
+func (s *ResourcemanagerStacksDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_resourcemanager.ListStacksRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -171,7 +172,7 @@ func (s *ResourcemanagerStacksDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "resourcemanager")
 
-	response, err := s.Client.ListStacks(context.Background(), request)
+	response, err := s.Client.ListStacks(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -180,7 +181,7 @@ func (s *ResourcemanagerStacksDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListStacks(context.Background(), request)
+		listResponse, err := s.Client.ListStacks(ctx, request)
 		if err != nil {
 			return err
 		}
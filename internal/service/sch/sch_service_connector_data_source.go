@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_sch "github.com/oracle/oci-go-sdk/v65/sch"
 )
@@ -22,12 +23,12 @@ func SchServiceConnectorDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(SchServiceConnectorResource(), fieldMap, readSingularSchServiceConnector)
 }
 
-func readSingularSchServiceConnector(d *schema.ResourceData, m interface{}) error {
+func readSingularSchServiceConnector(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &SchServiceConnectorDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceConnectorClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type SchServiceConnectorDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *SchServiceConnectorDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *SchServiceConnectorDataSourceCrud) Get() error {
+func (s *SchServiceConnectorDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_sch.GetServiceConnectorRequest{}
 
 	if serviceConnectorId, ok := s.D.GetOkExists("service_connector_id"); ok {
@@ -50,7 +51,7 @@ func (s *SchServiceConnectorDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "sch")
 
-	response, err := s.Client.GetServiceConnector(context.Background(), request)
+	response, err := s.Client.GetServiceConnector(ctx, request)
 	if err != nil {
 		return err
 	}
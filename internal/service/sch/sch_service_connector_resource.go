@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func SchServiceConnectorResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createSchServiceConnector,
-		Read:     readSchServiceConnector,
-		Update:   updateSchServiceConnector,
-		Delete:   deleteSchServiceConnector,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createSchServiceConnector,
+		ReadContext:   readSchServiceConnector,
+		UpdateContext: updateSchServiceConnector,
+		DeleteContext: deleteSchServiceConnector,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -448,7 +449,7 @@ func SchServiceConnectorResource() *schema.Resource {
 	}
 }
 
-func createSchServiceConnector(d *schema.ResourceData, m interface{}) error {
+func createSchServiceConnector(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &SchServiceConnectorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceConnectorClient()
@@ -461,28 +462,28 @@ func createSchServiceConnector(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	if e := tfresource.CreateResource(d, sync); e != nil {
-		return e
+	if e := tfresource.CreateResource(ctx, d, sync); e != nil {
+		return tfresource.HandleDiagError(e)
 	}
 
 	if powerOff {
-		if err := sync.StopSchResource(); err != nil {
-			return err
+		if err := sync.StopSchResource(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		sync.D.Set("state", oci_sch.LifecycleStateInactive)
 	}
 	return nil
 }
 
-func readSchServiceConnector(d *schema.ResourceData, m interface{}) error {
+func readSchServiceConnector(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &SchServiceConnectorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceConnectorClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateSchServiceConnector(d *schema.ResourceData, m interface{}) error {
+func updateSchServiceConnector(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &SchServiceConnectorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceConnectorClient()
@@ -500,33 +501,33 @@ func updateSchServiceConnector(d *schema.ResourceData, m interface{}) error {
 	}
 
 	if powerOn {
-		if err := sync.StartSchResource(); err != nil {
-			return err
+		if err := sync.StartSchResource(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		sync.D.Set("state", oci_sch.LifecycleStateActive)
 	}
 
-	if err := tfresource.UpdateResource(d, sync); err != nil {
-		return err
+	if err := tfresource.UpdateResource(ctx, d, sync); err != nil {
+		return tfresource.HandleDiagError(err)
 	}
 
 	// switch to power off
 	if powerOff {
-		if err := sync.StopSchResource(); err != nil {
-			return err
+		if err := sync.StopSchResource(ctx); err != nil {
+			return tfresource.HandleDiagError(err)
 		}
 		sync.D.Set("state", oci_sch.LifecycleStateInactive)
 	}
 	return nil
 }
 
-func deleteSchServiceConnector(d *schema.ResourceData, m interface{}) error {
+func deleteSchServiceConnector(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &SchServiceConnectorResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceConnectorClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type SchServiceConnectorResourceCrud struct {
@@ -564,7 +565,7 @@ func (s *SchServiceConnectorResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *SchServiceConnectorResourceCrud) Create() error {
+func (s *SchServiceConnectorResourceCrud) Create(ctx context.Context) error {
 	request := oci_sch.CreateServiceConnectorRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -635,20 +636,20 @@ func (s *SchServiceConnectorResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch")
 
-	response, err := s.Client.CreateServiceConnector(context.Background(), request)
+	response, err := s.Client.CreateServiceConnector(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getServiceConnectorFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch"), oci_sch.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getServiceConnectorFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch"), oci_sch.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *SchServiceConnectorResourceCrud) getServiceConnectorFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *SchServiceConnectorResourceCrud) getServiceConnectorFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_sch.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	serviceConnectorId, err := serviceConnectorWaitForWorkRequest(workId, "serviceConnector",
+	serviceConnectorId, err := serviceConnectorWaitForWorkRequest(ctx, workId, "serviceConnector",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -658,7 +659,7 @@ func (s *SchServiceConnectorResourceCrud) getServiceConnectorFromWorkRequest(wor
 	}
 	s.D.SetId(*serviceConnectorId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func serviceConnectorWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -684,7 +685,7 @@ func serviceConnectorWorkRequestShouldRetryFunc(timeout time.Duration) func(resp
 	}
 }
 
-func serviceConnectorWaitForWorkRequest(wId *string, entityType string, action oci_sch.ActionTypeEnum,
+func serviceConnectorWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_sch.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_sch.ServiceConnectorClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "sch")
 	retryPolicy.ShouldRetryOperation = serviceConnectorWorkRequestShouldRetryFunc(timeout)
@@ -703,7 +704,7 @@ func serviceConnectorWaitForWorkRequest(wId *string, entityType string, action o
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_sch.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -732,14 +733,14 @@ func serviceConnectorWaitForWorkRequest(wId *string, entityType string, action o
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_sch.OperationStatusFailed || response.Status == oci_sch.OperationStatusCanceled {
-		return nil, getErrorFromSchServiceConnectorWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromSchServiceConnectorWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromSchServiceConnectorWorkRequest(client *oci_sch.ServiceConnectorClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_sch.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromSchServiceConnectorWorkRequest(ctx context.Context, client *oci_sch.ServiceConnectorClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_sch.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_sch.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -761,7 +762,7 @@ func getErrorFromSchServiceConnectorWorkRequest(client *oci_sch.ServiceConnector
 	return workRequestErr
 }
 
-func (s *SchServiceConnectorResourceCrud) Get() error {
+func (s *SchServiceConnectorResourceCrud) Get(ctx context.Context) error {
 	request := oci_sch.GetServiceConnectorRequest{}
 
 	tmp := s.D.Id()
@@ -769,7 +770,7 @@ func (s *SchServiceConnectorResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch")
 
-	response, err := s.Client.GetServiceConnector(context.Background(), request)
+	response, err := s.Client.GetServiceConnector(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -778,11 +779,11 @@ func (s *SchServiceConnectorResourceCrud) Get() error {
 	return nil
 }
 
-func (s *SchServiceConnectorResourceCrud) Update() error {
+func (s *SchServiceConnectorResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -856,16 +857,16 @@ func (s *SchServiceConnectorResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch")
 
-	response, err := s.Client.UpdateServiceConnector(context.Background(), request)
+	response, err := s.Client.UpdateServiceConnector(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getServiceConnectorFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch"), oci_sch.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getServiceConnectorFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch"), oci_sch.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *SchServiceConnectorResourceCrud) Delete() error {
+func (s *SchServiceConnectorResourceCrud) Delete(ctx context.Context) error {
 	request := oci_sch.DeleteServiceConnectorRequest{}
 
 	tmp := s.D.Id()
@@ -873,14 +874,14 @@ func (s *SchServiceConnectorResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch")
 
-	response, err := s.Client.DeleteServiceConnector(context.Background(), request)
+	response, err := s.Client.DeleteServiceConnector(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := serviceConnectorWaitForWorkRequest(workId, "serviceConnector",
+	_, delWorkRequestErr := serviceConnectorWaitForWorkRequest(ctx, workId, "serviceConnector",
 		oci_sch.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -1711,7 +1712,7 @@ func TaskDetailsToMap(obj oci_sch.TaskDetails) map[string]interface{} {
 	return result
 }
 
-func (s *SchServiceConnectorResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *SchServiceConnectorResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_sch.ChangeServiceConnectorCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -1722,16 +1723,16 @@ func (s *SchServiceConnectorResourceCrud) updateCompartment(compartment interfac
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch")
 
-	response, err := s.Client.ChangeServiceConnectorCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeServiceConnectorCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getServiceConnectorFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "serviceConnector"), oci_sch.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getServiceConnectorFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "serviceConnector"), oci_sch.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *SchServiceConnectorResourceCrud) StartSchResource() error {
+func (s *SchServiceConnectorResourceCrud) StartSchResource(ctx context.Context) error {
 	request := oci_sch.ActivateServiceConnectorRequest{}
 
 	tmp := s.D.Id()
@@ -1739,16 +1740,16 @@ func (s *SchServiceConnectorResourceCrud) StartSchResource() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch")
 
-	response, err := s.Client.ActivateServiceConnector(context.Background(), request)
+	response, err := s.Client.ActivateServiceConnector(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getServiceConnectorFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch"), oci_sch.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getServiceConnectorFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch"), oci_sch.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *SchServiceConnectorResourceCrud) StopSchResource() error {
+func (s *SchServiceConnectorResourceCrud) StopSchResource(ctx context.Context) error {
 	request := oci_sch.DeactivateServiceConnectorRequest{}
 
 	tmp := s.D.Id()
@@ -1756,11 +1757,11 @@ func (s *SchServiceConnectorResourceCrud) StopSchResource() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch")
 
-	response, err := s.Client.DeactivateServiceConnector(context.Background(), request)
+	response, err := s.Client.DeactivateServiceConnector(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getServiceConnectorFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch"), oci_sch.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getServiceConnectorFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "sch"), oci_sch.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_sch "github.com/oracle/oci-go-sdk/v65/sch"
 )
 
 func SchServiceConnectorsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSchServiceConnectors,
+		ReadContext: readSchServiceConnectors,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,12 +49,12 @@ func SchServiceConnectorsDataSource() *schema.Resource {
 	}
 }
 
-func readSchServiceConnectors(d *schema.ResourceData, m interface{}) error {
+func readSchServiceConnectors(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &SchServiceConnectorsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceConnectorClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type SchServiceConnectorsDataSourceCrud struct {
@@ -66,7 +67,7 @@ func (s *SchServiceConnectorsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *SchServiceConnectorsDataSourceCrud) Get() error {
+func (s *SchServiceConnectorsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_sch.ListServiceConnectorsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -85,7 +86,7 @@ func (s *SchServiceConnectorsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "sch")
 
-	response, err := s.Client.ListServiceConnectors(context.Background(), request)
+	response, err := s.Client.ListServiceConnectors(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *SchServiceConnectorsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListServiceConnectors(context.Background(), request)
+		listResponse, err := s.Client.ListServiceConnectors(ctx, request)
 		if err != nil {
 			return err
 		}
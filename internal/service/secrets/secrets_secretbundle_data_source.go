@@ -12,13 +12,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_secrets "github.com/oracle/oci-go-sdk/v65/secrets"
 )
 
 func SecretsSecretbundleDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularSecretsSecretbundle,
+		ReadContext: readSingularSecretsSecretbundle,
 		Schema: map[string]*schema.Schema{
 			"secret_id": {
 				Type:     schema.TypeString,
@@ -91,12 +92,12 @@ func SecretsSecretbundleDataSource() *schema.Resource {
 	}
 }
 
-func readSingularSecretsSecretbundle(d *schema.ResourceData, m interface{}) error {
+func readSingularSecretsSecretbundle(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &SecretsSecretbundleDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SecretsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type SecretsSecretbundleDataSourceCrud struct {
@@ -109,7 +110,7 @@ func (s *SecretsSecretbundleDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *SecretsSecretbundleDataSourceCrud) Get() error {
+func (s *SecretsSecretbundleDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_secrets.GetSecretBundleRequest{}
 
 	if secretId, ok := s.D.GetOkExists("secret_id"); ok {
@@ -137,7 +138,7 @@ func (s *SecretsSecretbundleDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "secrets")
 
-	response, err := s.Client.GetSecretBundle(context.Background(), request)
+	response, err := s.Client.GetSecretBundle(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -10,13 +10,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_secrets "github.com/oracle/oci-go-sdk/v65/secrets"
 )
 
 func SecretsSecretbundleVersionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSecretsSecretbundleVersions,
+		ReadContext: readSecretsSecretbundleVersions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"secret_id": {
@@ -71,12 +72,12 @@ func SecretsSecretbundleVersionsDataSource() *schema.Resource {
 	}
 }
 
-func readSecretsSecretbundleVersions(d *schema.ResourceData, m interface{}) error {
+func readSecretsSecretbundleVersions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &SecretsSecretbundleVersionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).SecretsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type SecretsSecretbundleVersionsDataSourceCrud struct {
@@ -89,7 +90,7 @@ func (s *SecretsSecretbundleVersionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *SecretsSecretbundleVersionsDataSourceCrud) Get() error {
+func (s *SecretsSecretbundleVersionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_secrets.ListSecretBundleVersionsRequest{}
 
 	if secretId, ok := s.D.GetOkExists("secret_id"); ok {
@@ -99,7 +100,7 @@ func (s *SecretsSecretbundleVersionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "secrets")
 
-	response, err := s.Client.ListSecretBundleVersions(context.Background(), request)
+	response, err := s.Client.ListSecretBundleVersions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -108,7 +109,7 @@ func (s *SecretsSecretbundleVersionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSecretBundleVersions(context.Background(), request)
+		listResponse, err := s.Client.ListSecretBundleVersions(ctx, request)
 		if err != nil {
 			return err
 		}
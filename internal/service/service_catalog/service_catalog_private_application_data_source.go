@@ -6,6 +6,7 @@ package service_catalog
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_service_catalog "github.com/oracle/oci-go-sdk/v65/servicecatalog"
 
@@ -22,12 +23,12 @@ func ServiceCatalogPrivateApplicationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ServiceCatalogPrivateApplicationResource(), fieldMap, readSingularServiceCatalogPrivateApplication)
 }
 
-func readSingularServiceCatalogPrivateApplication(d *schema.ResourceData, m interface{}) error {
+func readSingularServiceCatalogPrivateApplication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceCatalogPrivateApplicationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ServiceCatalogPrivateApplicationDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *ServiceCatalogPrivateApplicationDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ServiceCatalogPrivateApplicationDataSourceCrud) Get() error {
+func (s *ServiceCatalogPrivateApplicationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_service_catalog.GetPrivateApplicationRequest{}
 
 	if privateApplicationId, ok := s.D.GetOkExists("private_application_id"); ok {
@@ -50,7 +51,7 @@ func (s *ServiceCatalogPrivateApplicationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_catalog")
 
-	response, err := s.Client.GetPrivateApplication(context.Background(), request)
+	response, err := s.Client.GetPrivateApplication(ctx, request)
 	if err != nil {
 		return err
 	}
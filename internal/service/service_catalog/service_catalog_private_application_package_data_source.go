@@ -7,6 +7,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_service_catalog "github.com/oracle/oci-go-sdk/v65/servicecatalog"
 
@@ -16,7 +17,7 @@ import (
 
 func ServiceCatalogPrivateApplicationPackageDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularServiceCatalogPrivateApplicationPackage,
+		ReadContext: readSingularServiceCatalogPrivateApplicationPackage,
 		Schema: map[string]*schema.Schema{
 			"private_application_package_id": {
 				Type:     schema.TypeString,
@@ -55,12 +56,12 @@ func ServiceCatalogPrivateApplicationPackageDataSource() *schema.Resource {
 	}
 }
 
-func readSingularServiceCatalogPrivateApplicationPackage(d *schema.ResourceData, m interface{}) error {
+func readSingularServiceCatalogPrivateApplicationPackage(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceCatalogPrivateApplicationPackageDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ServiceCatalogPrivateApplicationPackageDataSourceCrud struct {
@@ -73,7 +74,7 @@ func (s *ServiceCatalogPrivateApplicationPackageDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ServiceCatalogPrivateApplicationPackageDataSourceCrud) Get() error {
+func (s *ServiceCatalogPrivateApplicationPackageDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_service_catalog.GetPrivateApplicationPackageRequest{}
 
 	if privateApplicationPackageId, ok := s.D.GetOkExists("private_application_package_id"); ok {
@@ -83,7 +84,7 @@ func (s *ServiceCatalogPrivateApplicationPackageDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_catalog")
 
-	response, err := s.Client.GetPrivateApplicationPackage(context.Background(), request)
+	response, err := s.Client.GetPrivateApplicationPackage(ctx, request)
 	if err != nil {
 		return err
 	}
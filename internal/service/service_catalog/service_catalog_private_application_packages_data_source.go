@@ -6,6 +6,7 @@ package service_catalog
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_service_catalog "github.com/oracle/oci-go-sdk/v65/servicecatalog"
 
@@ -15,7 +16,7 @@ import (
 
 func ServiceCatalogPrivateApplicationPackagesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readServiceCatalogPrivateApplicationPackages,
+		ReadContext: readServiceCatalogPrivateApplicationPackages,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"display_name": {
@@ -94,12 +95,12 @@ func ServiceCatalogPrivateApplicationPackagesDataSource() *schema.Resource {
 	}
 }
 
-func readServiceCatalogPrivateApplicationPackages(d *schema.ResourceData, m interface{}) error {
+func readServiceCatalogPrivateApplicationPackages(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceCatalogPrivateApplicationPackagesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ServiceCatalogPrivateApplicationPackagesDataSourceCrud struct {
@@ -112,7 +113,7 @@ func (s *ServiceCatalogPrivateApplicationPackagesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ServiceCatalogPrivateApplicationPackagesDataSourceCrud) Get() error {
+func (s *ServiceCatalogPrivateApplicationPackagesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_service_catalog.ListPrivateApplicationPackagesRequest{}
 
 	if displayName, ok := s.D.GetOkExists("display_name"); ok {
@@ -145,7 +146,7 @@ func (s *ServiceCatalogPrivateApplicationPackagesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_catalog")
 
-	response, err := s.Client.ListPrivateApplicationPackages(context.Background(), request)
+	response, err := s.Client.ListPrivateApplicationPackages(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -154,7 +155,7 @@ func (s *ServiceCatalogPrivateApplicationPackagesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPrivateApplicationPackages(context.Background(), request)
+		listResponse, err := s.Client.ListPrivateApplicationPackages(ctx, request)
 		if err != nil {
 			return err
 		}
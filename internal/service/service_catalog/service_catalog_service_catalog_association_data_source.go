@@ -6,6 +6,7 @@ package service_catalog
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_service_catalog "github.com/oracle/oci-go-sdk/v65/servicecatalog"
 
@@ -22,12 +23,12 @@ func ServiceCatalogServiceCatalogAssociationDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ServiceCatalogServiceCatalogAssociationResource(), fieldMap, readSingularServiceCatalogServiceCatalogAssociation)
 }
 
-func readSingularServiceCatalogServiceCatalogAssociation(d *schema.ResourceData, m interface{}) error {
+func readSingularServiceCatalogServiceCatalogAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceCatalogServiceCatalogAssociationDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ServiceCatalogServiceCatalogAssociationDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *ServiceCatalogServiceCatalogAssociationDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ServiceCatalogServiceCatalogAssociationDataSourceCrud) Get() error {
+func (s *ServiceCatalogServiceCatalogAssociationDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_service_catalog.GetServiceCatalogAssociationRequest{}
 
 	if serviceCatalogAssociationId, ok := s.D.GetOkExists("service_catalog_association_id"); ok {
@@ -50,7 +51,7 @@ func (s *ServiceCatalogServiceCatalogAssociationDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_catalog")
 
-	response, err := s.Client.GetServiceCatalogAssociation(context.Background(), request)
+	response, err := s.Client.GetServiceCatalogAssociation(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package service_catalog
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_service_catalog "github.com/oracle/oci-go-sdk/v65/servicecatalog"
@@ -19,10 +20,10 @@ func ServiceCatalogServiceCatalogAssociationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createServiceCatalogServiceCatalogAssociation,
-		Read:     readServiceCatalogServiceCatalogAssociation,
-		Delete:   deleteServiceCatalogServiceCatalogAssociation,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createServiceCatalogServiceCatalogAssociation,
+		ReadContext:   readServiceCatalogServiceCatalogAssociation,
+		DeleteContext: deleteServiceCatalogServiceCatalogAssociation,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"entity_id": {
@@ -53,29 +54,29 @@ func ServiceCatalogServiceCatalogAssociationResource() *schema.Resource {
 	}
 }
 
-func createServiceCatalogServiceCatalogAssociation(d *schema.ResourceData, m interface{}) error {
+func createServiceCatalogServiceCatalogAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceCatalogServiceCatalogAssociationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceCatalogClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readServiceCatalogServiceCatalogAssociation(d *schema.ResourceData, m interface{}) error {
+func readServiceCatalogServiceCatalogAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceCatalogServiceCatalogAssociationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteServiceCatalogServiceCatalogAssociation(d *schema.ResourceData, m interface{}) error {
+func deleteServiceCatalogServiceCatalogAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceCatalogServiceCatalogAssociationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceCatalogClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type ServiceCatalogServiceCatalogAssociationResourceCrud struct {
@@ -89,7 +90,7 @@ func (s *ServiceCatalogServiceCatalogAssociationResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *ServiceCatalogServiceCatalogAssociationResourceCrud) Create() error {
+func (s *ServiceCatalogServiceCatalogAssociationResourceCrud) Create(ctx context.Context) error {
 	request := oci_service_catalog.CreateServiceCatalogAssociationRequest{}
 
 	if entityId, ok := s.D.GetOkExists("entity_id"); ok {
@@ -109,7 +110,7 @@ func (s *ServiceCatalogServiceCatalogAssociationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
 
-	response, err := s.Client.CreateServiceCatalogAssociation(context.Background(), request)
+	response, err := s.Client.CreateServiceCatalogAssociation(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -118,7 +119,7 @@ func (s *ServiceCatalogServiceCatalogAssociationResourceCrud) Create() error {
 	return nil
 }
 
-func (s *ServiceCatalogServiceCatalogAssociationResourceCrud) Get() error {
+func (s *ServiceCatalogServiceCatalogAssociationResourceCrud) Get(ctx context.Context) error {
 	request := oci_service_catalog.GetServiceCatalogAssociationRequest{}
 
 	tmp := s.D.Id()
@@ -126,7 +127,7 @@ func (s *ServiceCatalogServiceCatalogAssociationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
 
-	response, err := s.Client.GetServiceCatalogAssociation(context.Background(), request)
+	response, err := s.Client.GetServiceCatalogAssociation(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -135,7 +136,7 @@ func (s *ServiceCatalogServiceCatalogAssociationResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ServiceCatalogServiceCatalogAssociationResourceCrud) Delete() error {
+func (s *ServiceCatalogServiceCatalogAssociationResourceCrud) Delete(ctx context.Context) error {
 	request := oci_service_catalog.DeleteServiceCatalogAssociationRequest{}
 
 	tmp := s.D.Id()
@@ -143,7 +144,7 @@ func (s *ServiceCatalogServiceCatalogAssociationResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
 
-	_, err := s.Client.DeleteServiceCatalogAssociation(context.Background(), request)
+	_, err := s.Client.DeleteServiceCatalogAssociation(ctx, request)
 	return err
 }
 
@@ -6,6 +6,7 @@ package service_catalog
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_service_catalog "github.com/oracle/oci-go-sdk/v65/servicecatalog"
 
@@ -15,7 +16,7 @@ import (
 
 func ServiceCatalogServiceCatalogAssociationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readServiceCatalogServiceCatalogAssociations,
+		ReadContext: readServiceCatalogServiceCatalogAssociations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"entity_id": {
@@ -52,12 +53,12 @@ func ServiceCatalogServiceCatalogAssociationsDataSource() *schema.Resource {
 	}
 }
 
-func readServiceCatalogServiceCatalogAssociations(d *schema.ResourceData, m interface{}) error {
+func readServiceCatalogServiceCatalogAssociations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceCatalogServiceCatalogAssociationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ServiceCatalogServiceCatalogAssociationsDataSourceCrud struct {
@@ -70,7 +71,7 @@ func (s *ServiceCatalogServiceCatalogAssociationsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ServiceCatalogServiceCatalogAssociationsDataSourceCrud) Get() error {
+func (s *ServiceCatalogServiceCatalogAssociationsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_service_catalog.ListServiceCatalogAssociationsRequest{}
 
 	if entityId, ok := s.D.GetOkExists("entity_id"); ok {
@@ -95,7 +96,7 @@ func (s *ServiceCatalogServiceCatalogAssociationsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_catalog")
 
-	response, err := s.Client.ListServiceCatalogAssociations(context.Background(), request)
+	response, err := s.Client.ListServiceCatalogAssociations(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -104,7 +105,7 @@ func (s *ServiceCatalogServiceCatalogAssociationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListServiceCatalogAssociations(context.Background(), request)
+		listResponse, err := s.Client.ListServiceCatalogAssociations(ctx, request)
 		if err != nil {
 			return err
 		}
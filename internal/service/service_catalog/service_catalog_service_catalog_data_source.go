@@ -6,6 +6,7 @@ package service_catalog
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_service_catalog "github.com/oracle/oci-go-sdk/v65/servicecatalog"
 
@@ -22,12 +23,12 @@ func ServiceCatalogServiceCatalogDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ServiceCatalogServiceCatalogResource(), fieldMap, readSingularServiceCatalogServiceCatalog)
 }
 
-func readSingularServiceCatalogServiceCatalog(d *schema.ResourceData, m interface{}) error {
+func readSingularServiceCatalogServiceCatalog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceCatalogServiceCatalogDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ServiceCatalogServiceCatalogDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *ServiceCatalogServiceCatalogDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ServiceCatalogServiceCatalogDataSourceCrud) Get() error {
+func (s *ServiceCatalogServiceCatalogDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_service_catalog.GetServiceCatalogRequest{}
 
 	if serviceCatalogId, ok := s.D.GetOkExists("service_catalog_id"); ok {
@@ -50,7 +51,7 @@ func (s *ServiceCatalogServiceCatalogDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_catalog")
 
-	response, err := s.Client.GetServiceCatalog(context.Background(), request)
+	response, err := s.Client.GetServiceCatalog(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package service_catalog
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_service_catalog "github.com/oracle/oci-go-sdk/v65/servicecatalog"
@@ -19,11 +20,11 @@ func ServiceCatalogServiceCatalogResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createServiceCatalogServiceCatalog,
-		Read:     readServiceCatalogServiceCatalog,
-		Update:   updateServiceCatalogServiceCatalog,
-		Delete:   deleteServiceCatalogServiceCatalog,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createServiceCatalogServiceCatalog,
+		ReadContext:   readServiceCatalogServiceCatalog,
+		UpdateContext: updateServiceCatalogServiceCatalog,
+		DeleteContext: deleteServiceCatalogServiceCatalog,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -67,37 +68,37 @@ func ServiceCatalogServiceCatalogResource() *schema.Resource {
 	}
 }
 
-func createServiceCatalogServiceCatalog(d *schema.ResourceData, m interface{}) error {
+func createServiceCatalogServiceCatalog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceCatalogServiceCatalogResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceCatalogClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readServiceCatalogServiceCatalog(d *schema.ResourceData, m interface{}) error {
+func readServiceCatalogServiceCatalog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceCatalogServiceCatalogResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceCatalogClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateServiceCatalogServiceCatalog(d *schema.ResourceData, m interface{}) error {
+func updateServiceCatalogServiceCatalog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceCatalogServiceCatalogResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceCatalogClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteServiceCatalogServiceCatalog(d *schema.ResourceData, m interface{}) error {
+func deleteServiceCatalogServiceCatalog(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceCatalogServiceCatalogResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceCatalogClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type ServiceCatalogServiceCatalogResourceCrud struct {
@@ -131,7 +132,7 @@ func (s *ServiceCatalogServiceCatalogResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *ServiceCatalogServiceCatalogResourceCrud) Create() error {
+func (s *ServiceCatalogServiceCatalogResourceCrud) Create(ctx context.Context) error {
 	request := oci_service_catalog.CreateServiceCatalogRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -158,7 +159,7 @@ func (s *ServiceCatalogServiceCatalogResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
 
-	response, err := s.Client.CreateServiceCatalog(context.Background(), request)
+	response, err := s.Client.CreateServiceCatalog(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -167,7 +168,7 @@ func (s *ServiceCatalogServiceCatalogResourceCrud) Create() error {
 	return nil
 }
 
-func (s *ServiceCatalogServiceCatalogResourceCrud) Get() error {
+func (s *ServiceCatalogServiceCatalogResourceCrud) Get(ctx context.Context) error {
 	request := oci_service_catalog.GetServiceCatalogRequest{}
 
 	tmp := s.D.Id()
@@ -175,7 +176,7 @@ func (s *ServiceCatalogServiceCatalogResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
 
-	response, err := s.Client.GetServiceCatalog(context.Background(), request)
+	response, err := s.Client.GetServiceCatalog(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -184,11 +185,11 @@ func (s *ServiceCatalogServiceCatalogResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ServiceCatalogServiceCatalogResourceCrud) Update() error {
+func (s *ServiceCatalogServiceCatalogResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -218,7 +219,7 @@ func (s *ServiceCatalogServiceCatalogResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
 
-	response, err := s.Client.UpdateServiceCatalog(context.Background(), request)
+	response, err := s.Client.UpdateServiceCatalog(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -227,7 +228,7 @@ func (s *ServiceCatalogServiceCatalogResourceCrud) Update() error {
 	return nil
 }
 
-func (s *ServiceCatalogServiceCatalogResourceCrud) Delete() error {
+func (s *ServiceCatalogServiceCatalogResourceCrud) Delete(ctx context.Context) error {
 	request := oci_service_catalog.DeleteServiceCatalogRequest{}
 
 	tmp := s.D.Id()
@@ -235,7 +236,7 @@ func (s *ServiceCatalogServiceCatalogResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
 
-	_, err := s.Client.DeleteServiceCatalog(context.Background(), request)
+	_, err := s.Client.DeleteServiceCatalog(ctx, request)
 	return err
 }
 
@@ -291,7 +292,7 @@ func ServiceCatalogSummaryToMap(obj oci_service_catalog.ServiceCatalogSummary) m
 	return result
 }
 
-func (s *ServiceCatalogServiceCatalogResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *ServiceCatalogServiceCatalogResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_service_catalog.ChangeServiceCatalogCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -302,12 +303,12 @@ func (s *ServiceCatalogServiceCatalogResourceCrud) updateCompartment(compartment
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_catalog")
 
-	_, err := s.Client.ChangeServiceCatalogCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeServiceCatalogCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package service_manager_proxy
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_service_manager_proxy "github.com/oracle/oci-go-sdk/v65/servicemanagerproxy"
 
@@ -15,7 +16,7 @@ import (
 
 func ServiceManagerProxyServiceEnvironmentDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularServiceManagerProxyServiceEnvironment,
+		ReadContext: readSingularServiceManagerProxyServiceEnvironment,
 		Schema: map[string]*schema.Schema{
 			"compartment_id": {
 				Type:     schema.TypeString,
@@ -92,12 +93,12 @@ func ServiceManagerProxyServiceEnvironmentDataSource() *schema.Resource {
 	}
 }
 
-func readSingularServiceManagerProxyServiceEnvironment(d *schema.ResourceData, m interface{}) error {
+func readSingularServiceManagerProxyServiceEnvironment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceManagerProxyServiceEnvironmentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceManagerProxyClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ServiceManagerProxyServiceEnvironmentDataSourceCrud struct {
@@ -110,7 +111,7 @@ func (s *ServiceManagerProxyServiceEnvironmentDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ServiceManagerProxyServiceEnvironmentDataSourceCrud) Get() error {
+func (s *ServiceManagerProxyServiceEnvironmentDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_service_manager_proxy.GetServiceEnvironmentRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -125,7 +126,7 @@ func (s *ServiceManagerProxyServiceEnvironmentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_manager_proxy")
 
-	response, err := s.Client.GetServiceEnvironment(context.Background(), request)
+	response, err := s.Client.GetServiceEnvironment(ctx, request)
 	if err != nil {
 		return err
 	}
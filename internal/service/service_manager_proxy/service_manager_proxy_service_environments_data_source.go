@@ -6,6 +6,7 @@ package service_manager_proxy
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_service_manager_proxy "github.com/oracle/oci-go-sdk/v65/servicemanagerproxy"
 
@@ -15,7 +16,7 @@ import (
 
 func ServiceManagerProxyServiceEnvironmentsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readServiceManagerProxyServiceEnvironments,
+		ReadContext: readServiceManagerProxyServiceEnvironments,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -141,12 +142,12 @@ func ServiceManagerProxyServiceEnvironmentsDataSource() *schema.Resource {
 	}
 }
 
-func readServiceManagerProxyServiceEnvironments(d *schema.ResourceData, m interface{}) error {
+func readServiceManagerProxyServiceEnvironments(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceManagerProxyServiceEnvironmentsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceManagerProxyClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ServiceManagerProxyServiceEnvironmentsDataSourceCrud struct {
@@ -159,7 +160,7 @@ func (s *ServiceManagerProxyServiceEnvironmentsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ServiceManagerProxyServiceEnvironmentsDataSourceCrud) Get() error {
+func (s *ServiceManagerProxyServiceEnvironmentsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_service_manager_proxy.ListServiceEnvironmentsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -184,7 +185,7 @@ func (s *ServiceManagerProxyServiceEnvironmentsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_manager_proxy")
 
-	response, err := s.Client.ListServiceEnvironments(context.Background(), request)
+	response, err := s.Client.ListServiceEnvironments(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -193,7 +194,7 @@ func (s *ServiceManagerProxyServiceEnvironmentsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListServiceEnvironments(context.Background(), request)
+		listResponse, err := s.Client.ListServiceEnvironments(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package service_mesh
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_service_mesh "github.com/oracle/oci-go-sdk/v65/servicemesh"
 
@@ -22,12 +23,12 @@ func ServiceMeshAccessPolicyDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ServiceMeshAccessPolicyResource(), fieldMap, readSingularServiceMeshAccessPolicy)
 }
 
-func readSingularServiceMeshAccessPolicy(d *schema.ResourceData, m interface{}) error {
+func readSingularServiceMeshAccessPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshAccessPolicyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ServiceMeshAccessPolicyDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *ServiceMeshAccessPolicyDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ServiceMeshAccessPolicyDataSourceCrud) Get() error {
+func (s *ServiceMeshAccessPolicyDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_service_mesh.GetAccessPolicyRequest{}
 
 	if accessPolicyId, ok := s.D.GetOkExists("access_policy_id"); ok {
@@ -50,7 +51,7 @@ func (s *ServiceMeshAccessPolicyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_mesh")
 
-	response, err := s.Client.GetAccessPolicy(context.Background(), request)
+	response, err := s.Client.GetAccessPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func ServiceMeshAccessPolicyResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createServiceMeshAccessPolicy,
-		Read:     readServiceMeshAccessPolicy,
-		Update:   updateServiceMeshAccessPolicy,
-		Delete:   deleteServiceMeshAccessPolicy,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createServiceMeshAccessPolicy,
+		ReadContext:   readServiceMeshAccessPolicy,
+		UpdateContext: updateServiceMeshAccessPolicy,
+		DeleteContext: deleteServiceMeshAccessPolicy,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -246,37 +247,37 @@ func ServiceMeshAccessPolicyResource() *schema.Resource {
 	}
 }
 
-func createServiceMeshAccessPolicy(d *schema.ResourceData, m interface{}) error {
+func createServiceMeshAccessPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshAccessPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readServiceMeshAccessPolicy(d *schema.ResourceData, m interface{}) error {
+func readServiceMeshAccessPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshAccessPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateServiceMeshAccessPolicy(d *schema.ResourceData, m interface{}) error {
+func updateServiceMeshAccessPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshAccessPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteServiceMeshAccessPolicy(d *schema.ResourceData, m interface{}) error {
+func deleteServiceMeshAccessPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshAccessPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type ServiceMeshAccessPolicyResourceCrud struct {
@@ -314,7 +315,7 @@ func (s *ServiceMeshAccessPolicyResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *ServiceMeshAccessPolicyResourceCrud) Create() error {
+func (s *ServiceMeshAccessPolicyResourceCrud) Create(ctx context.Context) error {
 	request := oci_service_mesh.CreateAccessPolicyRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -368,20 +369,20 @@ func (s *ServiceMeshAccessPolicyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.CreateAccessPolicy(context.Background(), request)
+	response, err := s.Client.CreateAccessPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getAccessPolicyFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getAccessPolicyFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *ServiceMeshAccessPolicyResourceCrud) getAccessPolicyFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *ServiceMeshAccessPolicyResourceCrud) getAccessPolicyFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_service_mesh.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	accessPolicyId, err := accessPolicyWaitForWorkRequest(workId, "accesspolicy",
+	accessPolicyId, err := accessPolicyWaitForWorkRequest(ctx, workId, "accesspolicy",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -389,7 +390,7 @@ func (s *ServiceMeshAccessPolicyResourceCrud) getAccessPolicyFromWorkRequest(wor
 	}
 	s.D.SetId(*accessPolicyId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func accessPolicyWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -415,7 +416,7 @@ func accessPolicyWorkRequestShouldRetryFunc(timeout time.Duration) func(response
 	}
 }
 
-func accessPolicyWaitForWorkRequest(wId *string, entityType string, action oci_service_mesh.ActionTypeEnum,
+func accessPolicyWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_service_mesh.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_service_mesh.ServiceMeshClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "service_mesh")
 	retryPolicy.ShouldRetryOperation = accessPolicyWorkRequestShouldRetryFunc(timeout)
@@ -434,7 +435,7 @@ func accessPolicyWaitForWorkRequest(wId *string, entityType string, action oci_s
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_service_mesh.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -463,14 +464,14 @@ func accessPolicyWaitForWorkRequest(wId *string, entityType string, action oci_s
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_service_mesh.OperationStatusFailed || response.Status == oci_service_mesh.OperationStatusCanceled {
-		return nil, getErrorFromServiceMeshAccessPolicyWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromServiceMeshAccessPolicyWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromServiceMeshAccessPolicyWorkRequest(client *oci_service_mesh.ServiceMeshClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_service_mesh.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromServiceMeshAccessPolicyWorkRequest(ctx context.Context, client *oci_service_mesh.ServiceMeshClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_service_mesh.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_service_mesh.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -492,7 +493,7 @@ func getErrorFromServiceMeshAccessPolicyWorkRequest(client *oci_service_mesh.Ser
 	return workRequestErr
 }
 
-func (s *ServiceMeshAccessPolicyResourceCrud) Get() error {
+func (s *ServiceMeshAccessPolicyResourceCrud) Get(ctx context.Context) error {
 	request := oci_service_mesh.GetAccessPolicyRequest{}
 
 	tmp := s.D.Id()
@@ -500,7 +501,7 @@ func (s *ServiceMeshAccessPolicyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.GetAccessPolicy(context.Background(), request)
+	response, err := s.Client.GetAccessPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -509,11 +510,11 @@ func (s *ServiceMeshAccessPolicyResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ServiceMeshAccessPolicyResourceCrud) Update() error {
+func (s *ServiceMeshAccessPolicyResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -560,16 +561,16 @@ func (s *ServiceMeshAccessPolicyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.UpdateAccessPolicy(context.Background(), request)
+	response, err := s.Client.UpdateAccessPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getAccessPolicyFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getAccessPolicyFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *ServiceMeshAccessPolicyResourceCrud) Delete() error {
+func (s *ServiceMeshAccessPolicyResourceCrud) Delete(ctx context.Context) error {
 	request := oci_service_mesh.DeleteAccessPolicyRequest{}
 
 	tmp := s.D.Id()
@@ -577,14 +578,14 @@ func (s *ServiceMeshAccessPolicyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.DeleteAccessPolicy(context.Background(), request)
+	response, err := s.Client.DeleteAccessPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := accessPolicyWaitForWorkRequest(workId, "accesspolicy",
+	_, delWorkRequestErr := accessPolicyWaitForWorkRequest(ctx, workId, "accesspolicy",
 		oci_service_mesh.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -856,7 +857,7 @@ func AccessPolicyTargetToMap(obj *oci_service_mesh.AccessPolicyTarget) map[strin
 	return result
 }
 
-func (s *ServiceMeshAccessPolicyResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *ServiceMeshAccessPolicyResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_service_mesh.ChangeAccessPolicyCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -867,11 +868,11 @@ func (s *ServiceMeshAccessPolicyResourceCrud) updateCompartment(compartment inte
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.ChangeAccessPolicyCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeAccessPolicyCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getAccessPolicyFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getAccessPolicyFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
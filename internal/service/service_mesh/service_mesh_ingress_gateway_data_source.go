@@ -6,6 +6,7 @@ package service_mesh
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_service_mesh "github.com/oracle/oci-go-sdk/v65/servicemesh"
 
@@ -22,12 +23,12 @@ func ServiceMeshIngressGatewayDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ServiceMeshIngressGatewayResource(), fieldMap, readSingularServiceMeshIngressGateway)
 }
 
-func readSingularServiceMeshIngressGateway(d *schema.ResourceData, m interface{}) error {
+func readSingularServiceMeshIngressGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshIngressGatewayDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ServiceMeshIngressGatewayDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *ServiceMeshIngressGatewayDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ServiceMeshIngressGatewayDataSourceCrud) Get() error {
+func (s *ServiceMeshIngressGatewayDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_service_mesh.GetIngressGatewayRequest{}
 
 	if ingressGatewayId, ok := s.D.GetOkExists("ingress_gateway_id"); ok {
@@ -50,7 +51,7 @@ func (s *ServiceMeshIngressGatewayDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_mesh")
 
-	response, err := s.Client.GetIngressGateway(context.Background(), request)
+	response, err := s.Client.GetIngressGateway(ctx, request)
 	if err != nil {
 		return err
 	}
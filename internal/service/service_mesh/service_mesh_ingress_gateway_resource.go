@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func ServiceMeshIngressGatewayResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createServiceMeshIngressGateway,
-		Read:     readServiceMeshIngressGateway,
-		Update:   updateServiceMeshIngressGateway,
-		Delete:   deleteServiceMeshIngressGateway,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createServiceMeshIngressGateway,
+		ReadContext:   readServiceMeshIngressGateway,
+		UpdateContext: updateServiceMeshIngressGateway,
+		DeleteContext: deleteServiceMeshIngressGateway,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -300,37 +301,37 @@ func ServiceMeshIngressGatewayResource() *schema.Resource {
 	}
 }
 
-func createServiceMeshIngressGateway(d *schema.ResourceData, m interface{}) error {
+func createServiceMeshIngressGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshIngressGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readServiceMeshIngressGateway(d *schema.ResourceData, m interface{}) error {
+func readServiceMeshIngressGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshIngressGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateServiceMeshIngressGateway(d *schema.ResourceData, m interface{}) error {
+func updateServiceMeshIngressGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshIngressGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteServiceMeshIngressGateway(d *schema.ResourceData, m interface{}) error {
+func deleteServiceMeshIngressGateway(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshIngressGatewayResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type ServiceMeshIngressGatewayResourceCrud struct {
@@ -368,7 +369,7 @@ func (s *ServiceMeshIngressGatewayResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *ServiceMeshIngressGatewayResourceCrud) Create() error {
+func (s *ServiceMeshIngressGatewayResourceCrud) Create(ctx context.Context) error {
 	request := oci_service_mesh.CreateIngressGatewayRequest{}
 
 	if accessLogging, ok := s.D.GetOkExists("access_logging"); ok {
@@ -444,20 +445,20 @@ func (s *ServiceMeshIngressGatewayResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.CreateIngressGateway(context.Background(), request)
+	response, err := s.Client.CreateIngressGateway(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getIngressGatewayFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getIngressGatewayFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *ServiceMeshIngressGatewayResourceCrud) getIngressGatewayFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *ServiceMeshIngressGatewayResourceCrud) getIngressGatewayFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_service_mesh.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	ingressGatewayId, err := ingressGatewayWaitForWorkRequest(workId, "meshingressgateway",
+	ingressGatewayId, err := ingressGatewayWaitForWorkRequest(ctx, workId, "meshingressgateway",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -465,7 +466,7 @@ func (s *ServiceMeshIngressGatewayResourceCrud) getIngressGatewayFromWorkRequest
 	}
 	s.D.SetId(*ingressGatewayId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func ingressGatewayWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -491,7 +492,7 @@ func ingressGatewayWorkRequestShouldRetryFunc(timeout time.Duration) func(respon
 	}
 }
 
-func ingressGatewayWaitForWorkRequest(wId *string, entityType string, action oci_service_mesh.ActionTypeEnum,
+func ingressGatewayWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_service_mesh.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_service_mesh.ServiceMeshClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "service_mesh")
 	retryPolicy.ShouldRetryOperation = ingressGatewayWorkRequestShouldRetryFunc(timeout)
@@ -510,7 +511,7 @@ func ingressGatewayWaitForWorkRequest(wId *string, entityType string, action oci
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_service_mesh.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -539,14 +540,14 @@ func ingressGatewayWaitForWorkRequest(wId *string, entityType string, action oci
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_service_mesh.OperationStatusFailed || response.Status == oci_service_mesh.OperationStatusCanceled {
-		return nil, getErrorFromServiceMeshIngressGatewayWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromServiceMeshIngressGatewayWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromServiceMeshIngressGatewayWorkRequest(client *oci_service_mesh.ServiceMeshClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_service_mesh.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromServiceMeshIngressGatewayWorkRequest(ctx context.Context, client *oci_service_mesh.ServiceMeshClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_service_mesh.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_service_mesh.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -568,7 +569,7 @@ func getErrorFromServiceMeshIngressGatewayWorkRequest(client *oci_service_mesh.S
 	return workRequestErr
 }
 
-func (s *ServiceMeshIngressGatewayResourceCrud) Get() error {
+func (s *ServiceMeshIngressGatewayResourceCrud) Get(ctx context.Context) error {
 	request := oci_service_mesh.GetIngressGatewayRequest{}
 
 	tmp := s.D.Id()
@@ -576,7 +577,7 @@ func (s *ServiceMeshIngressGatewayResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.GetIngressGateway(context.Background(), request)
+	response, err := s.Client.GetIngressGateway(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -585,11 +586,11 @@ func (s *ServiceMeshIngressGatewayResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ServiceMeshIngressGatewayResourceCrud) Update() error {
+func (s *ServiceMeshIngressGatewayResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -658,16 +659,16 @@ func (s *ServiceMeshIngressGatewayResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.UpdateIngressGateway(context.Background(), request)
+	response, err := s.Client.UpdateIngressGateway(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getIngressGatewayFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getIngressGatewayFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *ServiceMeshIngressGatewayResourceCrud) Delete() error {
+func (s *ServiceMeshIngressGatewayResourceCrud) Delete(ctx context.Context) error {
 	request := oci_service_mesh.DeleteIngressGatewayRequest{}
 
 	tmp := s.D.Id()
@@ -675,14 +676,14 @@ func (s *ServiceMeshIngressGatewayResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.DeleteIngressGateway(context.Background(), request)
+	response, err := s.Client.DeleteIngressGateway(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := ingressGatewayWaitForWorkRequest(workId, "meshingressgateway",
+	_, delWorkRequestErr := ingressGatewayWaitForWorkRequest(ctx, workId, "meshingressgateway",
 		oci_service_mesh.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -1142,7 +1143,7 @@ func TlsCertificateToMap(obj *oci_service_mesh.TlsCertificate) map[string]interf
 	return result
 }
 
-func (s *ServiceMeshIngressGatewayResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *ServiceMeshIngressGatewayResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_service_mesh.ChangeIngressGatewayCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -1153,11 +1154,11 @@ func (s *ServiceMeshIngressGatewayResourceCrud) updateCompartment(compartment in
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.ChangeIngressGatewayCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeIngressGatewayCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getIngressGatewayFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getIngressGatewayFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -6,6 +6,7 @@ package service_mesh
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_service_mesh "github.com/oracle/oci-go-sdk/v65/servicemesh"
 
@@ -22,12 +23,12 @@ func ServiceMeshIngressGatewayRouteTableDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ServiceMeshIngressGatewayRouteTableResource(), fieldMap, readSingularServiceMeshIngressGatewayRouteTable)
 }
 
-func readSingularServiceMeshIngressGatewayRouteTable(d *schema.ResourceData, m interface{}) error {
+func readSingularServiceMeshIngressGatewayRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshIngressGatewayRouteTableDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ServiceMeshIngressGatewayRouteTableDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *ServiceMeshIngressGatewayRouteTableDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ServiceMeshIngressGatewayRouteTableDataSourceCrud) Get() error {
+func (s *ServiceMeshIngressGatewayRouteTableDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_service_mesh.GetIngressGatewayRouteTableRequest{}
 
 	if ingressGatewayRouteTableId, ok := s.D.GetOkExists("ingress_gateway_route_table_id"); ok {
@@ -50,7 +51,7 @@ func (s *ServiceMeshIngressGatewayRouteTableDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_mesh")
 
-	response, err := s.Client.GetIngressGatewayRouteTable(context.Background(), request)
+	response, err := s.Client.GetIngressGatewayRouteTable(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func ServiceMeshIngressGatewayRouteTableResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createServiceMeshIngressGatewayRouteTable,
-		Read:     readServiceMeshIngressGatewayRouteTable,
-		Update:   updateServiceMeshIngressGatewayRouteTable,
-		Delete:   deleteServiceMeshIngressGatewayRouteTable,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createServiceMeshIngressGatewayRouteTable,
+		ReadContext:   readServiceMeshIngressGatewayRouteTable,
+		UpdateContext: updateServiceMeshIngressGatewayRouteTable,
+		DeleteContext: deleteServiceMeshIngressGatewayRouteTable,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -200,37 +201,37 @@ func ServiceMeshIngressGatewayRouteTableResource() *schema.Resource {
 	}
 }
 
-func createServiceMeshIngressGatewayRouteTable(d *schema.ResourceData, m interface{}) error {
+func createServiceMeshIngressGatewayRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshIngressGatewayRouteTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readServiceMeshIngressGatewayRouteTable(d *schema.ResourceData, m interface{}) error {
+func readServiceMeshIngressGatewayRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshIngressGatewayRouteTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateServiceMeshIngressGatewayRouteTable(d *schema.ResourceData, m interface{}) error {
+func updateServiceMeshIngressGatewayRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshIngressGatewayRouteTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteServiceMeshIngressGatewayRouteTable(d *schema.ResourceData, m interface{}) error {
+func deleteServiceMeshIngressGatewayRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshIngressGatewayRouteTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type ServiceMeshIngressGatewayRouteTableResourceCrud struct {
@@ -268,7 +269,7 @@ func (s *ServiceMeshIngressGatewayRouteTableResourceCrud) DeletedTarget() []stri
 	}
 }
 
-func (s *ServiceMeshIngressGatewayRouteTableResourceCrud) Create() error {
+func (s *ServiceMeshIngressGatewayRouteTableResourceCrud) Create(ctx context.Context) error {
 	request := oci_service_mesh.CreateIngressGatewayRouteTableRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -328,20 +329,20 @@ func (s *ServiceMeshIngressGatewayRouteTableResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.CreateIngressGatewayRouteTable(context.Background(), request)
+	response, err := s.Client.CreateIngressGatewayRouteTable(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getIngressGatewayRouteTableFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getIngressGatewayRouteTableFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *ServiceMeshIngressGatewayRouteTableResourceCrud) getIngressGatewayRouteTableFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *ServiceMeshIngressGatewayRouteTableResourceCrud) getIngressGatewayRouteTableFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_service_mesh.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	ingressGatewayRouteTableId, err := ingressGatewayRouteTableWaitForWorkRequest(workId, "ingressgatewayroutetable",
+	ingressGatewayRouteTableId, err := ingressGatewayRouteTableWaitForWorkRequest(ctx, workId, "ingressgatewayroutetable",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -349,7 +350,7 @@ func (s *ServiceMeshIngressGatewayRouteTableResourceCrud) getIngressGatewayRoute
 	}
 	s.D.SetId(*ingressGatewayRouteTableId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func ingressGatewayRouteTableWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -375,7 +376,7 @@ func ingressGatewayRouteTableWorkRequestShouldRetryFunc(timeout time.Duration) f
 	}
 }
 
-func ingressGatewayRouteTableWaitForWorkRequest(wId *string, entityType string, action oci_service_mesh.ActionTypeEnum,
+func ingressGatewayRouteTableWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_service_mesh.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_service_mesh.ServiceMeshClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "service_mesh")
 	retryPolicy.ShouldRetryOperation = ingressGatewayRouteTableWorkRequestShouldRetryFunc(timeout)
@@ -394,7 +395,7 @@ func ingressGatewayRouteTableWaitForWorkRequest(wId *string, entityType string,
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_service_mesh.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -423,14 +424,14 @@ func ingressGatewayRouteTableWaitForWorkRequest(wId *string, entityType string,
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_service_mesh.OperationStatusFailed || response.Status == oci_service_mesh.OperationStatusCanceled {
-		return nil, getErrorFromServiceMeshIngressGatewayRouteTableWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromServiceMeshIngressGatewayRouteTableWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromServiceMeshIngressGatewayRouteTableWorkRequest(client *oci_service_mesh.ServiceMeshClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_service_mesh.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromServiceMeshIngressGatewayRouteTableWorkRequest(ctx context.Context, client *oci_service_mesh.ServiceMeshClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_service_mesh.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_service_mesh.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -452,7 +453,7 @@ func getErrorFromServiceMeshIngressGatewayRouteTableWorkRequest(client *oci_serv
 	return workRequestErr
 }
 
-func (s *ServiceMeshIngressGatewayRouteTableResourceCrud) Get() error {
+func (s *ServiceMeshIngressGatewayRouteTableResourceCrud) Get(ctx context.Context) error {
 	request := oci_service_mesh.GetIngressGatewayRouteTableRequest{}
 
 	tmp := s.D.Id()
@@ -460,7 +461,7 @@ func (s *ServiceMeshIngressGatewayRouteTableResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.GetIngressGatewayRouteTable(context.Background(), request)
+	response, err := s.Client.GetIngressGatewayRouteTable(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -469,11 +470,11 @@ func (s *ServiceMeshIngressGatewayRouteTableResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ServiceMeshIngressGatewayRouteTableResourceCrud) Update() error {
+func (s *ServiceMeshIngressGatewayRouteTableResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -526,16 +527,16 @@ func (s *ServiceMeshIngressGatewayRouteTableResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.UpdateIngressGatewayRouteTable(context.Background(), request)
+	response, err := s.Client.UpdateIngressGatewayRouteTable(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getIngressGatewayRouteTableFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getIngressGatewayRouteTableFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *ServiceMeshIngressGatewayRouteTableResourceCrud) Delete() error {
+func (s *ServiceMeshIngressGatewayRouteTableResourceCrud) Delete(ctx context.Context) error {
 	request := oci_service_mesh.DeleteIngressGatewayRouteTableRequest{}
 
 	tmp := s.D.Id()
@@ -543,14 +544,14 @@ func (s *ServiceMeshIngressGatewayRouteTableResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.DeleteIngressGatewayRouteTable(context.Background(), request)
+	response, err := s.Client.DeleteIngressGatewayRouteTable(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := ingressGatewayRouteTableWaitForWorkRequest(workId, "ingressgatewayroutetable",
+	_, delWorkRequestErr := ingressGatewayRouteTableWaitForWorkRequest(ctx, workId, "ingressgatewayroutetable",
 		oci_service_mesh.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -926,7 +927,7 @@ func VirtualServiceTrafficRuleTargetToMap(obj oci_service_mesh.VirtualServiceTra
 	return result
 }
 
-func (s *ServiceMeshIngressGatewayRouteTableResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *ServiceMeshIngressGatewayRouteTableResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_service_mesh.ChangeIngressGatewayRouteTableCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -937,11 +938,11 @@ func (s *ServiceMeshIngressGatewayRouteTableResourceCrud) updateCompartment(comp
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.ChangeIngressGatewayRouteTableCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeIngressGatewayRouteTableCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getIngressGatewayRouteTableFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getIngressGatewayRouteTableFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
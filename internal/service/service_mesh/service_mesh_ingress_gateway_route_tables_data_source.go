@@ -6,6 +6,7 @@ package service_mesh
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_service_mesh "github.com/oracle/oci-go-sdk/v65/servicemesh"
 
@@ -15,7 +16,7 @@ import (
 
 func ServiceMeshIngressGatewayRouteTablesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readServiceMeshIngressGatewayRouteTables,
+		ReadContext: readServiceMeshIngressGatewayRouteTables,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -56,12 +57,12 @@ func ServiceMeshIngressGatewayRouteTablesDataSource() *schema.Resource {
 	}
 }
 
-func readServiceMeshIngressGatewayRouteTables(d *schema.ResourceData, m interface{}) error {
+func readServiceMeshIngressGatewayRouteTables(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshIngressGatewayRouteTablesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ServiceMeshIngressGatewayRouteTablesDataSourceCrud struct {
@@ -74,7 +75,7 @@ func (s *ServiceMeshIngressGatewayRouteTablesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ServiceMeshIngressGatewayRouteTablesDataSourceCrud) Get() error {
+func (s *ServiceMeshIngressGatewayRouteTablesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_service_mesh.ListIngressGatewayRouteTablesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -104,7 +105,7 @@ func (s *ServiceMeshIngressGatewayRouteTablesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_mesh")
 
-	response, err := s.Client.ListIngressGatewayRouteTables(context.Background(), request)
+	response, err := s.Client.ListIngressGatewayRouteTables(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -113,7 +114,7 @@ func (s *ServiceMeshIngressGatewayRouteTablesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListIngressGatewayRouteTables(context.Background(), request)
+		listResponse, err := s.Client.ListIngressGatewayRouteTables(ctx, request)
 		if err != nil {
 			return err
 		}
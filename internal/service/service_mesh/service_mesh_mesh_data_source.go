@@ -6,6 +6,7 @@ package service_mesh
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_service_mesh "github.com/oracle/oci-go-sdk/v65/servicemesh"
 
@@ -22,12 +23,12 @@ func ServiceMeshMeshDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ServiceMeshMeshResource(), fieldMap, readSingularServiceMeshMesh)
 }
 
-func readSingularServiceMeshMesh(d *schema.ResourceData, m interface{}) error {
+func readSingularServiceMeshMesh(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshMeshDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ServiceMeshMeshDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *ServiceMeshMeshDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ServiceMeshMeshDataSourceCrud) Get() error {
+func (s *ServiceMeshMeshDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_service_mesh.GetMeshRequest{}
 
 	if meshId, ok := s.D.GetOkExists("mesh_id"); ok {
@@ -50,7 +51,7 @@ func (s *ServiceMeshMeshDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_mesh")
 
-	response, err := s.Client.GetMesh(context.Background(), request)
+	response, err := s.Client.GetMesh(ctx, request)
 	if err != nil {
 		return err
 	}
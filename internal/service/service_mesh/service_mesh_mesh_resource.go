@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,11 +25,11 @@ func ServiceMeshMeshResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createServiceMeshMesh,
-		Read:     readServiceMeshMesh,
-		Update:   updateServiceMeshMesh,
-		Delete:   deleteServiceMeshMesh,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createServiceMeshMesh,
+		ReadContext:   readServiceMeshMesh,
+		UpdateContext: updateServiceMeshMesh,
+		DeleteContext: deleteServiceMeshMesh,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"certificate_authorities": {
@@ -125,37 +126,37 @@ func ServiceMeshMeshResource() *schema.Resource {
 	}
 }
 
-func createServiceMeshMesh(d *schema.ResourceData, m interface{}) error {
+func createServiceMeshMesh(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshMeshResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readServiceMeshMesh(d *schema.ResourceData, m interface{}) error {
+func readServiceMeshMesh(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshMeshResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateServiceMeshMesh(d *schema.ResourceData, m interface{}) error {
+func updateServiceMeshMesh(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshMeshResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteServiceMeshMesh(d *schema.ResourceData, m interface{}) error {
+func deleteServiceMeshMesh(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshMeshResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type ServiceMeshMeshResourceCrud struct {
@@ -193,7 +194,7 @@ func (s *ServiceMeshMeshResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *ServiceMeshMeshResourceCrud) Create() error {
+func (s *ServiceMeshMeshResourceCrud) Create(ctx context.Context) error {
 	request := oci_service_mesh.CreateMeshRequest{}
 
 	if certificateAuthorities, ok := s.D.GetOkExists("certificate_authorities"); ok {
@@ -253,20 +254,20 @@ func (s *ServiceMeshMeshResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.CreateMesh(context.Background(), request)
+	response, err := s.Client.CreateMesh(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getMeshFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getMeshFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *ServiceMeshMeshResourceCrud) getMeshFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *ServiceMeshMeshResourceCrud) getMeshFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_service_mesh.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	meshId, err := meshWaitForWorkRequest(workId, "mesh",
+	meshId, err := meshWaitForWorkRequest(ctx, workId, "mesh",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -274,7 +275,7 @@ func (s *ServiceMeshMeshResourceCrud) getMeshFromWorkRequest(workId *string, ret
 	}
 	s.D.SetId(*meshId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func meshWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -300,7 +301,7 @@ func meshWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_com
 	}
 }
 
-func meshWaitForWorkRequest(wId *string, entityType string, action oci_service_mesh.ActionTypeEnum,
+func meshWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_service_mesh.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_service_mesh.ServiceMeshClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "service_mesh")
 	retryPolicy.ShouldRetryOperation = meshWorkRequestShouldRetryFunc(timeout)
@@ -319,7 +320,7 @@ func meshWaitForWorkRequest(wId *string, entityType string, action oci_service_m
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_service_mesh.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -348,14 +349,14 @@ func meshWaitForWorkRequest(wId *string, entityType string, action oci_service_m
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_service_mesh.OperationStatusFailed || response.Status == oci_service_mesh.OperationStatusCanceled {
-		return nil, getErrorFromServiceMeshMeshWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromServiceMeshMeshWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromServiceMeshMeshWorkRequest(client *oci_service_mesh.ServiceMeshClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_service_mesh.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromServiceMeshMeshWorkRequest(ctx context.Context, client *oci_service_mesh.ServiceMeshClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_service_mesh.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_service_mesh.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -377,7 +378,7 @@ func getErrorFromServiceMeshMeshWorkRequest(client *oci_service_mesh.ServiceMesh
 	return workRequestErr
 }
 
-func (s *ServiceMeshMeshResourceCrud) Get() error {
+func (s *ServiceMeshMeshResourceCrud) Get(ctx context.Context) error {
 	request := oci_service_mesh.GetMeshRequest{}
 
 	tmp := s.D.Id()
@@ -385,7 +386,7 @@ func (s *ServiceMeshMeshResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.GetMesh(context.Background(), request)
+	response, err := s.Client.GetMesh(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -394,11 +395,11 @@ func (s *ServiceMeshMeshResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ServiceMeshMeshResourceCrud) Update() error {
+func (s *ServiceMeshMeshResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -444,16 +445,16 @@ func (s *ServiceMeshMeshResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.UpdateMesh(context.Background(), request)
+	response, err := s.Client.UpdateMesh(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getMeshFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getMeshFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *ServiceMeshMeshResourceCrud) Delete() error {
+func (s *ServiceMeshMeshResourceCrud) Delete(ctx context.Context) error {
 	request := oci_service_mesh.DeleteMeshRequest{}
 
 	tmp := s.D.Id()
@@ -461,14 +462,14 @@ func (s *ServiceMeshMeshResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.DeleteMesh(context.Background(), request)
+	response, err := s.Client.DeleteMesh(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := meshWaitForWorkRequest(workId, "mesh",
+	_, delWorkRequestErr := meshWaitForWorkRequest(ctx, workId, "mesh",
 		oci_service_mesh.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -614,7 +615,7 @@ func MeshSummaryToMap(obj oci_service_mesh.MeshSummary) map[string]interface{} {
 	return result
 }
 
-func (s *ServiceMeshMeshResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *ServiceMeshMeshResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_service_mesh.ChangeMeshCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -625,11 +626,11 @@ func (s *ServiceMeshMeshResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.ChangeMeshCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeMeshCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getMeshFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getMeshFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -6,6 +6,7 @@ package service_mesh
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_service_mesh "github.com/oracle/oci-go-sdk/v65/servicemesh"
 
@@ -15,7 +16,7 @@ import (
 
 func ServiceMeshMeshesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readServiceMeshMeshes,
+		ReadContext: readServiceMeshMeshes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -52,12 +53,12 @@ func ServiceMeshMeshesDataSource() *schema.Resource {
 	}
 }
 
-func readServiceMeshMeshes(d *schema.ResourceData, m interface{}) error {
+func readServiceMeshMeshes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshMeshesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ServiceMeshMeshesDataSourceCrud struct {
@@ -70,7 +71,7 @@ func (s *ServiceMeshMeshesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ServiceMeshMeshesDataSourceCrud) Get() error {
+func (s *ServiceMeshMeshesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_service_mesh.ListMeshesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -95,7 +96,7 @@ func (s *ServiceMeshMeshesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_mesh")
 
-	response, err := s.Client.ListMeshes(context.Background(), request)
+	response, err := s.Client.ListMeshes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -104,7 +105,7 @@ func (s *ServiceMeshMeshesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListMeshes(context.Background(), request)
+		listResponse, err := s.Client.ListMeshes(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package service_mesh
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_service_mesh "github.com/oracle/oci-go-sdk/v65/servicemesh"
 
@@ -15,7 +16,7 @@ import (
 
 func ServiceMeshProxyDetailDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularServiceMeshProxyDetail,
+		ReadContext: readSingularServiceMeshProxyDetail,
 		Schema: map[string]*schema.Schema{
 			// Computed
 			"proxy_image": {
@@ -26,12 +27,12 @@ func ServiceMeshProxyDetailDataSource() *schema.Resource {
 	}
 }
 
-func readSingularServiceMeshProxyDetail(d *schema.ResourceData, m interface{}) error {
+func readSingularServiceMeshProxyDetail(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshProxyDetailDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ServiceMeshProxyDetailDataSourceCrud struct {
@@ -44,12 +45,12 @@ func (s *ServiceMeshProxyDetailDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ServiceMeshProxyDetailDataSourceCrud) Get() error {
+func (s *ServiceMeshProxyDetailDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_service_mesh.GetProxyDetailsRequest{}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_mesh")
 
-	response, err := s.Client.GetProxyDetails(context.Background(), request)
+	response, err := s.Client.GetProxyDetails(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package service_mesh
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_service_mesh "github.com/oracle/oci-go-sdk/v65/servicemesh"
 
@@ -22,12 +23,12 @@ func ServiceMeshVirtualDeploymentDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ServiceMeshVirtualDeploymentResource(), fieldMap, readSingularServiceMeshVirtualDeployment)
 }
 
-func readSingularServiceMeshVirtualDeployment(d *schema.ResourceData, m interface{}) error {
+func readSingularServiceMeshVirtualDeployment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshVirtualDeploymentDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ServiceMeshVirtualDeploymentDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *ServiceMeshVirtualDeploymentDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ServiceMeshVirtualDeploymentDataSourceCrud) Get() error {
+func (s *ServiceMeshVirtualDeploymentDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_service_mesh.GetVirtualDeploymentRequest{}
 
 	if virtualDeploymentId, ok := s.D.GetOkExists("virtual_deployment_id"); ok {
@@ -50,7 +51,7 @@ func (s *ServiceMeshVirtualDeploymentDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_mesh")
 
-	response, err := s.Client.GetVirtualDeployment(context.Background(), request)
+	response, err := s.Client.GetVirtualDeployment(ctx, request)
 	if err != nil {
 		return err
 	}
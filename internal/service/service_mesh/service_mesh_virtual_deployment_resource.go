@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func ServiceMeshVirtualDeploymentResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createServiceMeshVirtualDeployment,
-		Read:     readServiceMeshVirtualDeployment,
-		Update:   updateServiceMeshVirtualDeployment,
-		Delete:   deleteServiceMeshVirtualDeployment,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createServiceMeshVirtualDeployment,
+		ReadContext:   readServiceMeshVirtualDeployment,
+		UpdateContext: updateServiceMeshVirtualDeployment,
+		DeleteContext: deleteServiceMeshVirtualDeployment,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -163,37 +164,37 @@ func ServiceMeshVirtualDeploymentResource() *schema.Resource {
 	}
 }
 
-func createServiceMeshVirtualDeployment(d *schema.ResourceData, m interface{}) error {
+func createServiceMeshVirtualDeployment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshVirtualDeploymentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readServiceMeshVirtualDeployment(d *schema.ResourceData, m interface{}) error {
+func readServiceMeshVirtualDeployment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshVirtualDeploymentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateServiceMeshVirtualDeployment(d *schema.ResourceData, m interface{}) error {
+func updateServiceMeshVirtualDeployment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshVirtualDeploymentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteServiceMeshVirtualDeployment(d *schema.ResourceData, m interface{}) error {
+func deleteServiceMeshVirtualDeployment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshVirtualDeploymentResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type ServiceMeshVirtualDeploymentResourceCrud struct {
@@ -231,7 +232,7 @@ func (s *ServiceMeshVirtualDeploymentResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *ServiceMeshVirtualDeploymentResourceCrud) Create() error {
+func (s *ServiceMeshVirtualDeploymentResourceCrud) Create(ctx context.Context) error {
 	request := oci_service_mesh.CreateVirtualDeploymentRequest{}
 
 	if accessLogging, ok := s.D.GetOkExists("access_logging"); ok {
@@ -307,20 +308,20 @@ func (s *ServiceMeshVirtualDeploymentResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.CreateVirtualDeployment(context.Background(), request)
+	response, err := s.Client.CreateVirtualDeployment(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getVirtualDeploymentFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getVirtualDeploymentFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *ServiceMeshVirtualDeploymentResourceCrud) getVirtualDeploymentFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *ServiceMeshVirtualDeploymentResourceCrud) getVirtualDeploymentFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_service_mesh.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	virtualDeploymentId, err := virtualDeploymentWaitForWorkRequest(workId, "virtualdeployment",
+	virtualDeploymentId, err := virtualDeploymentWaitForWorkRequest(ctx, workId, "virtualdeployment",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -328,7 +329,7 @@ func (s *ServiceMeshVirtualDeploymentResourceCrud) getVirtualDeploymentFromWorkR
 	}
 	s.D.SetId(*virtualDeploymentId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func virtualDeploymentWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -354,7 +355,7 @@ func virtualDeploymentWorkRequestShouldRetryFunc(timeout time.Duration) func(res
 	}
 }
 
-func virtualDeploymentWaitForWorkRequest(wId *string, entityType string, action oci_service_mesh.ActionTypeEnum,
+func virtualDeploymentWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_service_mesh.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_service_mesh.ServiceMeshClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "service_mesh")
 	retryPolicy.ShouldRetryOperation = virtualDeploymentWorkRequestShouldRetryFunc(timeout)
@@ -373,7 +374,7 @@ func virtualDeploymentWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_service_mesh.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -402,14 +403,14 @@ func virtualDeploymentWaitForWorkRequest(wId *string, entityType string, action
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_service_mesh.OperationStatusFailed || response.Status == oci_service_mesh.OperationStatusCanceled {
-		return nil, getErrorFromServiceMeshVirtualDeploymentWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromServiceMeshVirtualDeploymentWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromServiceMeshVirtualDeploymentWorkRequest(client *oci_service_mesh.ServiceMeshClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_service_mesh.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromServiceMeshVirtualDeploymentWorkRequest(ctx context.Context, client *oci_service_mesh.ServiceMeshClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_service_mesh.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_service_mesh.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -431,7 +432,7 @@ func getErrorFromServiceMeshVirtualDeploymentWorkRequest(client *oci_service_mes
 	return workRequestErr
 }
 
-func (s *ServiceMeshVirtualDeploymentResourceCrud) Get() error {
+func (s *ServiceMeshVirtualDeploymentResourceCrud) Get(ctx context.Context) error {
 	request := oci_service_mesh.GetVirtualDeploymentRequest{}
 
 	tmp := s.D.Id()
@@ -439,7 +440,7 @@ func (s *ServiceMeshVirtualDeploymentResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.GetVirtualDeployment(context.Background(), request)
+	response, err := s.Client.GetVirtualDeployment(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -448,11 +449,11 @@ func (s *ServiceMeshVirtualDeploymentResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ServiceMeshVirtualDeploymentResourceCrud) Update() error {
+func (s *ServiceMeshVirtualDeploymentResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -521,16 +522,16 @@ func (s *ServiceMeshVirtualDeploymentResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.UpdateVirtualDeployment(context.Background(), request)
+	response, err := s.Client.UpdateVirtualDeployment(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getVirtualDeploymentFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getVirtualDeploymentFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *ServiceMeshVirtualDeploymentResourceCrud) Delete() error {
+func (s *ServiceMeshVirtualDeploymentResourceCrud) Delete(ctx context.Context) error {
 	request := oci_service_mesh.DeleteVirtualDeploymentRequest{}
 
 	tmp := s.D.Id()
@@ -538,14 +539,14 @@ func (s *ServiceMeshVirtualDeploymentResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.DeleteVirtualDeployment(context.Background(), request)
+	response, err := s.Client.DeleteVirtualDeployment(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := virtualDeploymentWaitForWorkRequest(workId, "virtualdeployment",
+	_, delWorkRequestErr := virtualDeploymentWaitForWorkRequest(ctx, workId, "virtualdeployment",
 		oci_service_mesh.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -745,7 +746,7 @@ func VirtualDeploymentSummaryToMap(obj oci_service_mesh.VirtualDeploymentSummary
 	return result
 }
 
-func (s *ServiceMeshVirtualDeploymentResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *ServiceMeshVirtualDeploymentResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_service_mesh.ChangeVirtualDeploymentCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -756,11 +757,11 @@ func (s *ServiceMeshVirtualDeploymentResourceCrud) updateCompartment(compartment
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.ChangeVirtualDeploymentCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeVirtualDeploymentCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getVirtualDeploymentFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getVirtualDeploymentFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
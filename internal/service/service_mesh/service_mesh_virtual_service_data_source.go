@@ -6,6 +6,7 @@ package service_mesh
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_service_mesh "github.com/oracle/oci-go-sdk/v65/servicemesh"
 
@@ -22,12 +23,12 @@ func ServiceMeshVirtualServiceDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ServiceMeshVirtualServiceResource(), fieldMap, readSingularServiceMeshVirtualService)
 }
 
-func readSingularServiceMeshVirtualService(d *schema.ResourceData, m interface{}) error {
+func readSingularServiceMeshVirtualService(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshVirtualServiceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ServiceMeshVirtualServiceDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *ServiceMeshVirtualServiceDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ServiceMeshVirtualServiceDataSourceCrud) Get() error {
+func (s *ServiceMeshVirtualServiceDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_service_mesh.GetVirtualServiceRequest{}
 
 	if virtualServiceId, ok := s.D.GetOkExists("virtual_service_id"); ok {
@@ -50,7 +51,7 @@ func (s *ServiceMeshVirtualServiceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_mesh")
 
-	response, err := s.Client.GetVirtualService(context.Background(), request)
+	response, err := s.Client.GetVirtualService(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,11 +25,11 @@ func ServiceMeshVirtualServiceResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createServiceMeshVirtualService,
-		Read:     readServiceMeshVirtualService,
-		Update:   updateServiceMeshVirtualService,
-		Delete:   deleteServiceMeshVirtualService,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createServiceMeshVirtualService,
+		ReadContext:   readServiceMeshVirtualService,
+		UpdateContext: updateServiceMeshVirtualService,
+		DeleteContext: deleteServiceMeshVirtualService,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -149,37 +150,37 @@ func ServiceMeshVirtualServiceResource() *schema.Resource {
 	}
 }
 
-func createServiceMeshVirtualService(d *schema.ResourceData, m interface{}) error {
+func createServiceMeshVirtualService(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshVirtualServiceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readServiceMeshVirtualService(d *schema.ResourceData, m interface{}) error {
+func readServiceMeshVirtualService(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshVirtualServiceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateServiceMeshVirtualService(d *schema.ResourceData, m interface{}) error {
+func updateServiceMeshVirtualService(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshVirtualServiceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteServiceMeshVirtualService(d *schema.ResourceData, m interface{}) error {
+func deleteServiceMeshVirtualService(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshVirtualServiceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type ServiceMeshVirtualServiceResourceCrud struct {
@@ -217,7 +218,7 @@ func (s *ServiceMeshVirtualServiceResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *ServiceMeshVirtualServiceResourceCrud) Create() error {
+func (s *ServiceMeshVirtualServiceResourceCrud) Create(ctx context.Context) error {
 	request := oci_service_mesh.CreateVirtualServiceRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -289,20 +290,20 @@ func (s *ServiceMeshVirtualServiceResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.CreateVirtualService(context.Background(), request)
+	response, err := s.Client.CreateVirtualService(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getVirtualServiceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getVirtualServiceFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *ServiceMeshVirtualServiceResourceCrud) getVirtualServiceFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *ServiceMeshVirtualServiceResourceCrud) getVirtualServiceFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_service_mesh.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	virtualServiceId, err := virtualServiceWaitForWorkRequest(workId, "meshvirtualservice",
+	virtualServiceId, err := virtualServiceWaitForWorkRequest(ctx, workId, "meshvirtualservice",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -310,7 +311,7 @@ func (s *ServiceMeshVirtualServiceResourceCrud) getVirtualServiceFromWorkRequest
 	}
 	s.D.SetId(*virtualServiceId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func virtualServiceWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -336,7 +337,7 @@ func virtualServiceWorkRequestShouldRetryFunc(timeout time.Duration) func(respon
 	}
 }
 
-func virtualServiceWaitForWorkRequest(wId *string, entityType string, action oci_service_mesh.ActionTypeEnum,
+func virtualServiceWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_service_mesh.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_service_mesh.ServiceMeshClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "service_mesh")
 	retryPolicy.ShouldRetryOperation = virtualServiceWorkRequestShouldRetryFunc(timeout)
@@ -355,7 +356,7 @@ func virtualServiceWaitForWorkRequest(wId *string, entityType string, action oci
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_service_mesh.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -384,14 +385,14 @@ func virtualServiceWaitForWorkRequest(wId *string, entityType string, action oci
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_service_mesh.OperationStatusFailed || response.Status == oci_service_mesh.OperationStatusCanceled {
-		return nil, getErrorFromServiceMeshVirtualServiceWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromServiceMeshVirtualServiceWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromServiceMeshVirtualServiceWorkRequest(client *oci_service_mesh.ServiceMeshClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_service_mesh.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromServiceMeshVirtualServiceWorkRequest(ctx context.Context, client *oci_service_mesh.ServiceMeshClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_service_mesh.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_service_mesh.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -413,7 +414,7 @@ func getErrorFromServiceMeshVirtualServiceWorkRequest(client *oci_service_mesh.S
 	return workRequestErr
 }
 
-func (s *ServiceMeshVirtualServiceResourceCrud) Get() error {
+func (s *ServiceMeshVirtualServiceResourceCrud) Get(ctx context.Context) error {
 	request := oci_service_mesh.GetVirtualServiceRequest{}
 
 	tmp := s.D.Id()
@@ -421,7 +422,7 @@ func (s *ServiceMeshVirtualServiceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.GetVirtualService(context.Background(), request)
+	response, err := s.Client.GetVirtualService(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -430,11 +431,11 @@ func (s *ServiceMeshVirtualServiceResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ServiceMeshVirtualServiceResourceCrud) Update() error {
+func (s *ServiceMeshVirtualServiceResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -499,16 +500,16 @@ func (s *ServiceMeshVirtualServiceResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.UpdateVirtualService(context.Background(), request)
+	response, err := s.Client.UpdateVirtualService(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getVirtualServiceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getVirtualServiceFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *ServiceMeshVirtualServiceResourceCrud) Delete() error {
+func (s *ServiceMeshVirtualServiceResourceCrud) Delete(ctx context.Context) error {
 	request := oci_service_mesh.DeleteVirtualServiceRequest{}
 
 	tmp := s.D.Id()
@@ -516,14 +517,14 @@ func (s *ServiceMeshVirtualServiceResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.DeleteVirtualService(context.Background(), request)
+	response, err := s.Client.DeleteVirtualService(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := virtualServiceWaitForWorkRequest(workId, "meshvirtualservice",
+	_, delWorkRequestErr := virtualServiceWaitForWorkRequest(ctx, workId, "meshvirtualservice",
 		oci_service_mesh.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -685,7 +686,7 @@ func VirtualServiceSummaryToMap(obj oci_service_mesh.VirtualServiceSummary) map[
 	return result
 }
 
-func (s *ServiceMeshVirtualServiceResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *ServiceMeshVirtualServiceResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_service_mesh.ChangeVirtualServiceCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -696,11 +697,11 @@ func (s *ServiceMeshVirtualServiceResourceCrud) updateCompartment(compartment in
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.ChangeVirtualServiceCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeVirtualServiceCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getVirtualServiceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getVirtualServiceFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -6,6 +6,7 @@ package service_mesh
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_service_mesh "github.com/oracle/oci-go-sdk/v65/servicemesh"
 
@@ -22,12 +23,12 @@ func ServiceMeshVirtualServiceRouteTableDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(ServiceMeshVirtualServiceRouteTableResource(), fieldMap, readSingularServiceMeshVirtualServiceRouteTable)
 }
 
-func readSingularServiceMeshVirtualServiceRouteTable(d *schema.ResourceData, m interface{}) error {
+func readSingularServiceMeshVirtualServiceRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshVirtualServiceRouteTableDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ServiceMeshVirtualServiceRouteTableDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *ServiceMeshVirtualServiceRouteTableDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ServiceMeshVirtualServiceRouteTableDataSourceCrud) Get() error {
+func (s *ServiceMeshVirtualServiceRouteTableDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_service_mesh.GetVirtualServiceRouteTableRequest{}
 
 	if virtualServiceRouteTableId, ok := s.D.GetOkExists("virtual_service_route_table_id"); ok {
@@ -50,7 +51,7 @@ func (s *ServiceMeshVirtualServiceRouteTableDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_mesh")
 
-	response, err := s.Client.GetVirtualServiceRouteTable(context.Background(), request)
+	response, err := s.Client.GetVirtualServiceRouteTable(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func ServiceMeshVirtualServiceRouteTableResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createServiceMeshVirtualServiceRouteTable,
-		Read:     readServiceMeshVirtualServiceRouteTable,
-		Update:   updateServiceMeshVirtualServiceRouteTable,
-		Delete:   deleteServiceMeshVirtualServiceRouteTable,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createServiceMeshVirtualServiceRouteTable,
+		ReadContext:   readServiceMeshVirtualServiceRouteTable,
+		UpdateContext: updateServiceMeshVirtualServiceRouteTable,
+		DeleteContext: deleteServiceMeshVirtualServiceRouteTable,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -164,37 +165,37 @@ func ServiceMeshVirtualServiceRouteTableResource() *schema.Resource {
 	}
 }
 
-func createServiceMeshVirtualServiceRouteTable(d *schema.ResourceData, m interface{}) error {
+func createServiceMeshVirtualServiceRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshVirtualServiceRouteTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readServiceMeshVirtualServiceRouteTable(d *schema.ResourceData, m interface{}) error {
+func readServiceMeshVirtualServiceRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshVirtualServiceRouteTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateServiceMeshVirtualServiceRouteTable(d *schema.ResourceData, m interface{}) error {
+func updateServiceMeshVirtualServiceRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshVirtualServiceRouteTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteServiceMeshVirtualServiceRouteTable(d *schema.ResourceData, m interface{}) error {
+func deleteServiceMeshVirtualServiceRouteTable(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshVirtualServiceRouteTableResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type ServiceMeshVirtualServiceRouteTableResourceCrud struct {
@@ -232,7 +233,7 @@ func (s *ServiceMeshVirtualServiceRouteTableResourceCrud) DeletedTarget() []stri
 	}
 }
 
-func (s *ServiceMeshVirtualServiceRouteTableResourceCrud) Create() error {
+func (s *ServiceMeshVirtualServiceRouteTableResourceCrud) Create(ctx context.Context) error {
 	request := oci_service_mesh.CreateVirtualServiceRouteTableRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -292,20 +293,20 @@ func (s *ServiceMeshVirtualServiceRouteTableResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.CreateVirtualServiceRouteTable(context.Background(), request)
+	response, err := s.Client.CreateVirtualServiceRouteTable(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getVirtualServiceRouteTableFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getVirtualServiceRouteTableFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *ServiceMeshVirtualServiceRouteTableResourceCrud) getVirtualServiceRouteTableFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *ServiceMeshVirtualServiceRouteTableResourceCrud) getVirtualServiceRouteTableFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_service_mesh.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	virtualServiceRouteTableId, err := virtualServiceRouteTableWaitForWorkRequest(workId, "virtualserviceroutetable",
+	virtualServiceRouteTableId, err := virtualServiceRouteTableWaitForWorkRequest(ctx, workId, "virtualserviceroutetable",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -313,7 +314,7 @@ func (s *ServiceMeshVirtualServiceRouteTableResourceCrud) getVirtualServiceRoute
 	}
 	s.D.SetId(*virtualServiceRouteTableId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func virtualServiceRouteTableWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -339,7 +340,7 @@ func virtualServiceRouteTableWorkRequestShouldRetryFunc(timeout time.Duration) f
 	}
 }
 
-func virtualServiceRouteTableWaitForWorkRequest(wId *string, entityType string, action oci_service_mesh.ActionTypeEnum,
+func virtualServiceRouteTableWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_service_mesh.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_service_mesh.ServiceMeshClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "service_mesh")
 	retryPolicy.ShouldRetryOperation = virtualServiceRouteTableWorkRequestShouldRetryFunc(timeout)
@@ -358,7 +359,7 @@ func virtualServiceRouteTableWaitForWorkRequest(wId *string, entityType string,
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_service_mesh.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -387,14 +388,14 @@ func virtualServiceRouteTableWaitForWorkRequest(wId *string, entityType string,
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_service_mesh.OperationStatusFailed || response.Status == oci_service_mesh.OperationStatusCanceled {
-		return nil, getErrorFromServiceMeshVirtualServiceRouteTableWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromServiceMeshVirtualServiceRouteTableWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromServiceMeshVirtualServiceRouteTableWorkRequest(client *oci_service_mesh.ServiceMeshClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_service_mesh.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromServiceMeshVirtualServiceRouteTableWorkRequest(ctx context.Context, client *oci_service_mesh.ServiceMeshClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_service_mesh.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_service_mesh.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -416,7 +417,7 @@ func getErrorFromServiceMeshVirtualServiceRouteTableWorkRequest(client *oci_serv
 	return workRequestErr
 }
 
-func (s *ServiceMeshVirtualServiceRouteTableResourceCrud) Get() error {
+func (s *ServiceMeshVirtualServiceRouteTableResourceCrud) Get(ctx context.Context) error {
 	request := oci_service_mesh.GetVirtualServiceRouteTableRequest{}
 
 	tmp := s.D.Id()
@@ -424,7 +425,7 @@ func (s *ServiceMeshVirtualServiceRouteTableResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.GetVirtualServiceRouteTable(context.Background(), request)
+	response, err := s.Client.GetVirtualServiceRouteTable(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -433,11 +434,11 @@ func (s *ServiceMeshVirtualServiceRouteTableResourceCrud) Get() error {
 	return nil
 }
 
-func (s *ServiceMeshVirtualServiceRouteTableResourceCrud) Update() error {
+func (s *ServiceMeshVirtualServiceRouteTableResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -490,16 +491,16 @@ func (s *ServiceMeshVirtualServiceRouteTableResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.UpdateVirtualServiceRouteTable(context.Background(), request)
+	response, err := s.Client.UpdateVirtualServiceRouteTable(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getVirtualServiceRouteTableFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getVirtualServiceRouteTableFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *ServiceMeshVirtualServiceRouteTableResourceCrud) Delete() error {
+func (s *ServiceMeshVirtualServiceRouteTableResourceCrud) Delete(ctx context.Context) error {
 	request := oci_service_mesh.DeleteVirtualServiceRouteTableRequest{}
 
 	tmp := s.D.Id()
@@ -507,14 +508,14 @@ func (s *ServiceMeshVirtualServiceRouteTableResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.DeleteVirtualServiceRouteTable(context.Background(), request)
+	response, err := s.Client.DeleteVirtualServiceRouteTable(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := virtualServiceRouteTableWaitForWorkRequest(workId, "virtualserviceroutetable",
+	_, delWorkRequestErr := virtualServiceRouteTableWaitForWorkRequest(ctx, workId, "virtualserviceroutetable",
 		oci_service_mesh.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -796,7 +797,7 @@ func VirtualServiceTrafficRouteRuleToMap(obj oci_service_mesh.VirtualServiceTraf
 	return result
 }
 
-func (s *ServiceMeshVirtualServiceRouteTableResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *ServiceMeshVirtualServiceRouteTableResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_service_mesh.ChangeVirtualServiceRouteTableCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -807,11 +808,11 @@ func (s *ServiceMeshVirtualServiceRouteTableResourceCrud) updateCompartment(comp
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh")
 
-	response, err := s.Client.ChangeVirtualServiceRouteTableCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeVirtualServiceRouteTableCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getVirtualServiceRouteTableFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getVirtualServiceRouteTableFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "service_mesh"), oci_service_mesh.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -6,6 +6,7 @@ package service_mesh
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_service_mesh "github.com/oracle/oci-go-sdk/v65/servicemesh"
 
@@ -15,7 +16,7 @@ import (
 
 func ServiceMeshVirtualServiceRouteTablesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readServiceMeshVirtualServiceRouteTables,
+		ReadContext: readServiceMeshVirtualServiceRouteTables,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -56,12 +57,12 @@ func ServiceMeshVirtualServiceRouteTablesDataSource() *schema.Resource {
 	}
 }
 
-func readServiceMeshVirtualServiceRouteTables(d *schema.ResourceData, m interface{}) error {
+func readServiceMeshVirtualServiceRouteTables(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &ServiceMeshVirtualServiceRouteTablesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ServiceMeshClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type ServiceMeshVirtualServiceRouteTablesDataSourceCrud struct {
@@ -74,7 +75,7 @@ func (s *ServiceMeshVirtualServiceRouteTablesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *ServiceMeshVirtualServiceRouteTablesDataSourceCrud) Get() error {
+func (s *ServiceMeshVirtualServiceRouteTablesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_service_mesh.ListVirtualServiceRouteTablesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -104,7 +105,7 @@ func (s *ServiceMeshVirtualServiceRouteTablesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "service_mesh")
 
-	response, err := s.Client.ListVirtualServiceRouteTables(context.Background(), request)
+	response, err := s.Client.ListVirtualServiceRouteTables(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -113,7 +114,7 @@ func (s *ServiceMeshVirtualServiceRouteTablesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListVirtualServiceRouteTables(context.Background(), request)
+		listResponse, err := s.Client.ListVirtualServiceRouteTables(ctx, request)
 		if err != nil {
 			return err
 		}
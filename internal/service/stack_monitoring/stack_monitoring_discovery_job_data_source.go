@@ -6,6 +6,7 @@ package stack_monitoring
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_stack_monitoring "github.com/oracle/oci-go-sdk/v65/stackmonitoring"
 
@@ -22,12 +23,12 @@ func StackMonitoringDiscoveryJobDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(StackMonitoringDiscoveryJobResource(), fieldMap, readSingularStackMonitoringDiscoveryJob)
 }
 
-func readSingularStackMonitoringDiscoveryJob(d *schema.ResourceData, m interface{}) error {
+func readSingularStackMonitoringDiscoveryJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StackMonitoringDiscoveryJobDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StackMonitoringClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type StackMonitoringDiscoveryJobDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *StackMonitoringDiscoveryJobDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *StackMonitoringDiscoveryJobDataSourceCrud) Get() error {
+func (s *StackMonitoringDiscoveryJobDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_stack_monitoring.GetDiscoveryJobRequest{}
 
 	if discoveryJobId, ok := s.D.GetOkExists("discovery_job_id"); ok {
@@ -50,7 +51,7 @@ func (s *StackMonitoringDiscoveryJobDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "stack_monitoring")
 
-	response, err := s.Client.GetDiscoveryJob(context.Background(), request)
+	response, err := s.Client.GetDiscoveryJob(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package stack_monitoring
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_stack_monitoring "github.com/oracle/oci-go-sdk/v65/stackmonitoring"
 
@@ -15,7 +16,7 @@ import (
 
 func StackMonitoringDiscoveryJobLogsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readStackMonitoringDiscoveryJobLogs,
+		ReadContext: readStackMonitoringDiscoveryJobLogs,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"discovery_job_id": {
@@ -72,12 +73,12 @@ func StackMonitoringDiscoveryJobLogsDataSource() *schema.Resource {
 	}
 }
 
-func readStackMonitoringDiscoveryJobLogs(d *schema.ResourceData, m interface{}) error {
+func readStackMonitoringDiscoveryJobLogs(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StackMonitoringDiscoveryJobLogsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StackMonitoringClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type StackMonitoringDiscoveryJobLogsDataSourceCrud struct {
@@ -90,7 +91,7 @@ func (s *StackMonitoringDiscoveryJobLogsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *StackMonitoringDiscoveryJobLogsDataSourceCrud) Get() error {
+func (s *StackMonitoringDiscoveryJobLogsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_stack_monitoring.ListDiscoveryJobLogsRequest{}
 
 	if discoveryJobId, ok := s.D.GetOkExists("discovery_job_id"); ok {
@@ -104,7 +105,7 @@ func (s *StackMonitoringDiscoveryJobLogsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "stack_monitoring")
 
-	response, err := s.Client.ListDiscoveryJobLogs(context.Background(), request)
+	response, err := s.Client.ListDiscoveryJobLogs(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -113,7 +114,7 @@ func (s *StackMonitoringDiscoveryJobLogsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDiscoveryJobLogs(context.Background(), request)
+		listResponse, err := s.Client.ListDiscoveryJobLogs(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -20,10 +21,10 @@ func StackMonitoringDiscoveryJobResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createStackMonitoringDiscoveryJob,
-		Read:     readStackMonitoringDiscoveryJob,
-		Delete:   deleteStackMonitoringDiscoveryJob,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createStackMonitoringDiscoveryJob,
+		ReadContext:   readStackMonitoringDiscoveryJob,
+		DeleteContext: deleteStackMonitoringDiscoveryJob,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -232,29 +233,29 @@ func StackMonitoringDiscoveryJobResource() *schema.Resource {
 	}
 }
 
-func createStackMonitoringDiscoveryJob(d *schema.ResourceData, m interface{}) error {
+func createStackMonitoringDiscoveryJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StackMonitoringDiscoveryJobResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StackMonitoringClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readStackMonitoringDiscoveryJob(d *schema.ResourceData, m interface{}) error {
+func readStackMonitoringDiscoveryJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StackMonitoringDiscoveryJobResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StackMonitoringClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteStackMonitoringDiscoveryJob(d *schema.ResourceData, m interface{}) error {
+func deleteStackMonitoringDiscoveryJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StackMonitoringDiscoveryJobResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StackMonitoringClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type StackMonitoringDiscoveryJobResourceCrud struct {
@@ -292,7 +293,7 @@ func (s *StackMonitoringDiscoveryJobResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *StackMonitoringDiscoveryJobResourceCrud) Create() error {
+func (s *StackMonitoringDiscoveryJobResourceCrud) Create(ctx context.Context) error {
 	request := oci_stack_monitoring.CreateDiscoveryJobRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -334,7 +335,7 @@ func (s *StackMonitoringDiscoveryJobResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "stack_monitoring")
 
-	response, err := s.Client.CreateDiscoveryJob(context.Background(), request)
+	response, err := s.Client.CreateDiscoveryJob(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -343,7 +344,7 @@ func (s *StackMonitoringDiscoveryJobResourceCrud) Create() error {
 	return nil
 }
 
-func (s *StackMonitoringDiscoveryJobResourceCrud) Get() error {
+func (s *StackMonitoringDiscoveryJobResourceCrud) Get(ctx context.Context) error {
 	request := oci_stack_monitoring.GetDiscoveryJobRequest{}
 
 	tmp := s.D.Id()
@@ -351,7 +352,7 @@ func (s *StackMonitoringDiscoveryJobResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "stack_monitoring")
 
-	response, err := s.Client.GetDiscoveryJob(context.Background(), request)
+	response, err := s.Client.GetDiscoveryJob(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -360,7 +361,7 @@ func (s *StackMonitoringDiscoveryJobResourceCrud) Get() error {
 	return nil
 }
 
-func (s *StackMonitoringDiscoveryJobResourceCrud) Delete() error {
+func (s *StackMonitoringDiscoveryJobResourceCrud) Delete(ctx context.Context) error {
 	request := oci_stack_monitoring.DeleteDiscoveryJobRequest{}
 
 	tmp := s.D.Id()
@@ -368,7 +369,7 @@ func (s *StackMonitoringDiscoveryJobResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "stack_monitoring")
 
-	_, err := s.Client.DeleteDiscoveryJob(context.Background(), request)
+	_, err := s.Client.DeleteDiscoveryJob(ctx, request)
 	return err
 }
 
@@ -6,6 +6,7 @@ package stack_monitoring
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_stack_monitoring "github.com/oracle/oci-go-sdk/v65/stackmonitoring"
 
@@ -15,7 +16,7 @@ import (
 
 func StackMonitoringDiscoveryJobsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readStackMonitoringDiscoveryJobs,
+		ReadContext: readStackMonitoringDiscoveryJobs,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -110,12 +111,12 @@ func StackMonitoringDiscoveryJobsDataSource() *schema.Resource {
 	}
 }
 
-func readStackMonitoringDiscoveryJobs(d *schema.ResourceData, m interface{}) error {
+func readStackMonitoringDiscoveryJobs(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StackMonitoringDiscoveryJobsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StackMonitoringClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type StackMonitoringDiscoveryJobsDataSourceCrud struct {
@@ -128,7 +129,7 @@ func (s *StackMonitoringDiscoveryJobsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *StackMonitoringDiscoveryJobsDataSourceCrud) Get() error {
+func (s *StackMonitoringDiscoveryJobsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_stack_monitoring.ListDiscoveryJobsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -143,7 +144,7 @@ func (s *StackMonitoringDiscoveryJobsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "stack_monitoring")
 
-	response, err := s.Client.ListDiscoveryJobs(context.Background(), request)
+	response, err := s.Client.ListDiscoveryJobs(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -152,7 +153,7 @@ func (s *StackMonitoringDiscoveryJobsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListDiscoveryJobs(context.Background(), request)
+		listResponse, err := s.Client.ListDiscoveryJobs(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package stack_monitoring
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_stack_monitoring "github.com/oracle/oci-go-sdk/v65/stackmonitoring"
 
@@ -22,12 +23,12 @@ func StackMonitoringMonitoredResourceDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(StackMonitoringMonitoredResourceResource(), fieldMap, readSingularStackMonitoringMonitoredResource)
 }
 
-func readSingularStackMonitoringMonitoredResource(d *schema.ResourceData, m interface{}) error {
+func readSingularStackMonitoringMonitoredResource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StackMonitoringMonitoredResourceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StackMonitoringClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type StackMonitoringMonitoredResourceDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *StackMonitoringMonitoredResourceDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *StackMonitoringMonitoredResourceDataSourceCrud) Get() error {
+func (s *StackMonitoringMonitoredResourceDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_stack_monitoring.GetMonitoredResourceRequest{}
 
 	if monitoredResourceId, ok := s.D.GetOkExists("monitored_resource_id"); ok {
@@ -50,7 +51,7 @@ func (s *StackMonitoringMonitoredResourceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "stack_monitoring")
 
-	response, err := s.Client.GetMonitoredResource(context.Background(), request)
+	response, err := s.Client.GetMonitoredResource(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package stack_monitoring
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -19,10 +20,10 @@ func StackMonitoringMonitoredResourcesAssociateMonitoredResourceResource() *sche
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createStackMonitoringMonitoredResourcesAssociateMonitoredResource,
-		Read:     readStackMonitoringMonitoredResourcesAssociateMonitoredResource,
-		Delete:   deleteStackMonitoringMonitoredResourcesAssociateMonitoredResource,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createStackMonitoringMonitoredResourcesAssociateMonitoredResource,
+		ReadContext:   readStackMonitoringMonitoredResourcesAssociateMonitoredResource,
+		DeleteContext: deleteStackMonitoringMonitoredResourcesAssociateMonitoredResource,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"association_type": {
@@ -103,25 +104,25 @@ func StackMonitoringMonitoredResourcesAssociateMonitoredResourceResource() *sche
 	}
 }
 
-func createStackMonitoringMonitoredResourcesAssociateMonitoredResource(d *schema.ResourceData, m interface{}) error {
+func createStackMonitoringMonitoredResourcesAssociateMonitoredResource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StackMonitoringMonitoredResourcesAssociateMonitoredResourceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StackMonitoringClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readStackMonitoringMonitoredResourcesAssociateMonitoredResource(d *schema.ResourceData, m interface{}) error {
+func readStackMonitoringMonitoredResourcesAssociateMonitoredResource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteStackMonitoringMonitoredResourcesAssociateMonitoredResource(d *schema.ResourceData, m interface{}) error {
+func deleteStackMonitoringMonitoredResourcesAssociateMonitoredResource(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StackMonitoringMonitoredResourcesAssociateMonitoredResourceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StackMonitoringClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 
 }
 
@@ -136,7 +137,7 @@ func (s *StackMonitoringMonitoredResourcesAssociateMonitoredResourceResourceCrud
 	return "compartmentId/" + *s.Res.CompartmentId + "associationType/" + *s.Res.AssociationType + "source/" + *s.Res.SourceResourceId + "destination/" + *s.Res.DestinationResourceId
 }
 
-func (s *StackMonitoringMonitoredResourcesAssociateMonitoredResourceResourceCrud) Create() error {
+func (s *StackMonitoringMonitoredResourcesAssociateMonitoredResourceResourceCrud) Create(ctx context.Context) error {
 	request := oci_stack_monitoring.AssociateMonitoredResourcesRequest{}
 
 	if associationType, ok := s.D.GetOkExists("association_type"); ok {
@@ -161,7 +162,7 @@ func (s *StackMonitoringMonitoredResourcesAssociateMonitoredResourceResourceCrud
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "stack_monitoring")
 
-	response, err := s.Client.AssociateMonitoredResources(context.Background(), request)
+	response, err := s.Client.AssociateMonitoredResources(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -170,7 +171,7 @@ func (s *StackMonitoringMonitoredResourcesAssociateMonitoredResourceResourceCrud
 	return nil
 }
 
-func (s *StackMonitoringMonitoredResourcesAssociateMonitoredResourceResourceCrud) Delete() error {
+func (s *StackMonitoringMonitoredResourcesAssociateMonitoredResourceResourceCrud) Delete(ctx context.Context) error {
 	request := oci_stack_monitoring.DisassociateMonitoredResourcesRequest{}
 
 	if associationType, ok := s.D.GetOkExists("association_type"); ok {
@@ -195,7 +196,7 @@ func (s *StackMonitoringMonitoredResourcesAssociateMonitoredResourceResourceCrud
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "stack_monitoring")
 
-	_, err := s.Client.DisassociateMonitoredResources(context.Background(), request)
+	_, err := s.Client.DisassociateMonitoredResources(ctx, request)
 
 	return err
 }
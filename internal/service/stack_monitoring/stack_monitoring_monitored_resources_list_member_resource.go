@@ -6,6 +6,7 @@ package stack_monitoring
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_stack_monitoring "github.com/oracle/oci-go-sdk/v65/stackmonitoring"
@@ -19,10 +20,10 @@ func StackMonitoringMonitoredResourcesListMemberResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createStackMonitoringMonitoredResourcesListMember,
-		Read:     readStackMonitoringMonitoredResourcesListMember,
-		Delete:   deleteStackMonitoringMonitoredResourcesListMember,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createStackMonitoringMonitoredResourcesListMember,
+		ReadContext:   readStackMonitoringMonitoredResourcesListMember,
+		DeleteContext: deleteStackMonitoringMonitoredResourcesListMember,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"monitored_resource_id": {
@@ -108,19 +109,19 @@ func StackMonitoringMonitoredResourcesListMemberResource() *schema.Resource {
 	}
 }
 
-func createStackMonitoringMonitoredResourcesListMember(d *schema.ResourceData, m interface{}) error {
+func createStackMonitoringMonitoredResourcesListMember(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StackMonitoringMonitoredResourcesListMemberResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StackMonitoringClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readStackMonitoringMonitoredResourcesListMember(d *schema.ResourceData, m interface{}) error {
+func readStackMonitoringMonitoredResourcesListMember(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteStackMonitoringMonitoredResourcesListMember(d *schema.ResourceData, m interface{}) error {
+func deleteStackMonitoringMonitoredResourcesListMember(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -146,7 +147,7 @@ func (s *StackMonitoringMonitoredResourcesListMemberResourceCrud) ID() string {
 	return id
 }
 
-func (s *StackMonitoringMonitoredResourcesListMemberResourceCrud) Create() error {
+func (s *StackMonitoringMonitoredResourcesListMemberResourceCrud) Create(ctx context.Context) error {
 	request := oci_stack_monitoring.SearchMonitoredResourceMembersRequest{}
 
 	if destinationResourceId, ok := s.D.GetOkExists("destination_resource_id"); ok {
@@ -166,7 +167,7 @@ func (s *StackMonitoringMonitoredResourcesListMemberResourceCrud) Create() error
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "stack_monitoring")
 
-	response, err := s.Client.SearchMonitoredResourceMembers(context.Background(), request)
+	response, err := s.Client.SearchMonitoredResourceMembers(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package stack_monitoring
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_stack_monitoring "github.com/oracle/oci-go-sdk/v65/stackmonitoring"
@@ -19,10 +20,10 @@ func StackMonitoringMonitoredResourcesSearchAssociationResource() *schema.Resour
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createStackMonitoringMonitoredResourcesSearchAssociation,
-		Read:     readStackMonitoringMonitoredResourcesSearchAssociation,
-		Delete:   deleteStackMonitoringMonitoredResourcesSearchAssociation,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createStackMonitoringMonitoredResourcesSearchAssociation,
+		ReadContext:   readStackMonitoringMonitoredResourcesSearchAssociation,
+		DeleteContext: deleteStackMonitoringMonitoredResourcesSearchAssociation,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -144,19 +145,19 @@ func StackMonitoringMonitoredResourcesSearchAssociationResource() *schema.Resour
 	}
 }
 
-func createStackMonitoringMonitoredResourcesSearchAssociation(d *schema.ResourceData, m interface{}) error {
+func createStackMonitoringMonitoredResourcesSearchAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StackMonitoringMonitoredResourcesSearchAssociationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StackMonitoringClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readStackMonitoringMonitoredResourcesSearchAssociation(d *schema.ResourceData, m interface{}) error {
+func readStackMonitoringMonitoredResourcesSearchAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteStackMonitoringMonitoredResourcesSearchAssociation(d *schema.ResourceData, m interface{}) error {
+func deleteStackMonitoringMonitoredResourcesSearchAssociation(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -198,7 +199,7 @@ func (s *StackMonitoringMonitoredResourcesSearchAssociationResourceCrud) ID() st
 	return id
 }
 
-func (s *StackMonitoringMonitoredResourcesSearchAssociationResourceCrud) Create() error {
+func (s *StackMonitoringMonitoredResourcesSearchAssociationResourceCrud) Create(ctx context.Context) error {
 	request := oci_stack_monitoring.SearchMonitoredResourceAssociationsRequest{}
 
 	if associationType, ok := s.D.GetOkExists("association_type"); ok {
@@ -243,7 +244,7 @@ func (s *StackMonitoringMonitoredResourcesSearchAssociationResourceCrud) Create(
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "stack_monitoring")
 
-	response, err := s.Client.SearchMonitoredResourceAssociations(context.Background(), request)
+	response, err := s.Client.SearchMonitoredResourceAssociations(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
@@ -21,10 +22,10 @@ func StackMonitoringMonitoredResourcesSearchResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createStackMonitoringMonitoredResourcesSearch,
-		Read:     readStackMonitoringMonitoredResourcesSearch,
-		Delete:   deleteStackMonitoringMonitoredResourcesSearch,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createStackMonitoringMonitoredResourcesSearch,
+		ReadContext:   readStackMonitoringMonitoredResourcesSearch,
+		DeleteContext: deleteStackMonitoringMonitoredResourcesSearch,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -220,19 +221,19 @@ func StackMonitoringMonitoredResourcesSearchResource() *schema.Resource {
 	}
 }
 
-func createStackMonitoringMonitoredResourcesSearch(d *schema.ResourceData, m interface{}) error {
+func createStackMonitoringMonitoredResourcesSearch(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StackMonitoringMonitoredResourcesSearchResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StackMonitoringClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readStackMonitoringMonitoredResourcesSearch(d *schema.ResourceData, m interface{}) error {
+func readStackMonitoringMonitoredResourcesSearch(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
-func deleteStackMonitoringMonitoredResourcesSearch(d *schema.ResourceData, m interface{}) error {
+func deleteStackMonitoringMonitoredResourcesSearch(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return nil
 }
 
@@ -271,7 +272,7 @@ func (s *StackMonitoringMonitoredResourcesSearchResourceCrud) ID() string {
 	return id
 }
 
-func (s *StackMonitoringMonitoredResourcesSearchResourceCrud) Create() error {
+func (s *StackMonitoringMonitoredResourcesSearchResourceCrud) Create(ctx context.Context) error {
 	request := oci_stack_monitoring.SearchMonitoredResourcesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -387,7 +388,7 @@ func (s *StackMonitoringMonitoredResourcesSearchResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "stack_monitoring")
 
-	response, err := s.Client.SearchMonitoredResources(context.Background(), request)
+	response, err := s.Client.SearchMonitoredResources(ctx, request)
 	if err != nil {
 		return err
 	}
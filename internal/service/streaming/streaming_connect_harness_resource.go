@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_streaming "github.com/oracle/oci-go-sdk/v65/streaming"
@@ -19,11 +20,11 @@ func StreamingConnectHarnessResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createStreamingConnectHarness,
-		Read:     readStreamingConnectHarness,
-		Update:   updateStreamingConnectHarness,
-		Delete:   deleteStreamingConnectHarness,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createStreamingConnectHarness,
+		ReadContext:   readStreamingConnectHarness,
+		UpdateContext: updateStreamingConnectHarness,
+		DeleteContext: deleteStreamingConnectHarness,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -68,37 +69,37 @@ func StreamingConnectHarnessResource() *schema.Resource {
 	}
 }
 
-func createStreamingConnectHarness(d *schema.ResourceData, m interface{}) error {
+func createStreamingConnectHarness(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StreamingConnectHarnessResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StreamAdminClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readStreamingConnectHarness(d *schema.ResourceData, m interface{}) error {
+func readStreamingConnectHarness(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StreamingConnectHarnessResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StreamAdminClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateStreamingConnectHarness(d *schema.ResourceData, m interface{}) error {
+func updateStreamingConnectHarness(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StreamingConnectHarnessResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StreamAdminClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteStreamingConnectHarness(d *schema.ResourceData, m interface{}) error {
+func deleteStreamingConnectHarness(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StreamingConnectHarnessResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StreamAdminClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type StreamingConnectHarnessResourceCrud struct {
@@ -148,7 +149,7 @@ func (s *StreamingConnectHarnessResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *StreamingConnectHarnessResourceCrud) Create() error {
+func (s *StreamingConnectHarnessResourceCrud) Create(ctx context.Context) error {
 	request := oci_streaming.CreateConnectHarnessRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -175,7 +176,7 @@ func (s *StreamingConnectHarnessResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	response, err := s.Client.CreateConnectHarness(context.Background(), request)
+	response, err := s.Client.CreateConnectHarness(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -184,7 +185,7 @@ func (s *StreamingConnectHarnessResourceCrud) Create() error {
 	return nil
 }
 
-func (s *StreamingConnectHarnessResourceCrud) Get() error {
+func (s *StreamingConnectHarnessResourceCrud) Get(ctx context.Context) error {
 	request := oci_streaming.GetConnectHarnessRequest{}
 
 	tmp := s.D.Id()
@@ -192,7 +193,7 @@ func (s *StreamingConnectHarnessResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	response, err := s.Client.GetConnectHarness(context.Background(), request)
+	response, err := s.Client.GetConnectHarness(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -201,11 +202,11 @@ func (s *StreamingConnectHarnessResourceCrud) Get() error {
 	return nil
 }
 
-func (s *StreamingConnectHarnessResourceCrud) Update() error {
+func (s *StreamingConnectHarnessResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -230,7 +231,7 @@ func (s *StreamingConnectHarnessResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	response, err := s.Client.UpdateConnectHarness(context.Background(), request)
+	response, err := s.Client.UpdateConnectHarness(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -239,7 +240,7 @@ func (s *StreamingConnectHarnessResourceCrud) Update() error {
 	return nil
 }
 
-func (s *StreamingConnectHarnessResourceCrud) Delete() error {
+func (s *StreamingConnectHarnessResourceCrud) Delete(ctx context.Context) error {
 	request := oci_streaming.DeleteConnectHarnessRequest{}
 
 	tmp := s.D.Id()
@@ -247,7 +248,7 @@ func (s *StreamingConnectHarnessResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	_, err := s.Client.DeleteConnectHarness(context.Background(), request)
+	_, err := s.Client.DeleteConnectHarness(ctx, request)
 	return err
 }
 
@@ -279,7 +280,7 @@ func (s *StreamingConnectHarnessResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *StreamingConnectHarnessResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *StreamingConnectHarnessResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_streaming.ChangeConnectHarnessCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -290,12 +291,12 @@ func (s *StreamingConnectHarnessResourceCrud) updateCompartment(compartment inte
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	_, err := s.Client.ChangeConnectHarnessCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeConnectHarnessCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_streaming "github.com/oracle/oci-go-sdk/v65/streaming"
@@ -20,11 +21,11 @@ func StreamingStreamPoolResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createStreamingStreamPool,
-		Read:     readStreamingStreamPool,
-		Update:   updateStreamingStreamPool,
-		Delete:   deleteStreamingStreamPool,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createStreamingStreamPool,
+		ReadContext:   readStreamingStreamPool,
+		UpdateContext: updateStreamingStreamPool,
+		DeleteContext: deleteStreamingStreamPool,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -174,37 +175,37 @@ func StreamingStreamPoolResource() *schema.Resource {
 	}
 }
 
-func createStreamingStreamPool(d *schema.ResourceData, m interface{}) error {
+func createStreamingStreamPool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StreamingStreamPoolResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StreamAdminClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readStreamingStreamPool(d *schema.ResourceData, m interface{}) error {
+func readStreamingStreamPool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StreamingStreamPoolResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StreamAdminClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateStreamingStreamPool(d *schema.ResourceData, m interface{}) error {
+func updateStreamingStreamPool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StreamingStreamPoolResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StreamAdminClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteStreamingStreamPool(d *schema.ResourceData, m interface{}) error {
+func deleteStreamingStreamPool(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StreamingStreamPoolResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StreamAdminClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type StreamingStreamPoolResourceCrud struct {
@@ -254,7 +255,7 @@ func (s *StreamingStreamPoolResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *StreamingStreamPoolResourceCrud) Create() error {
+func (s *StreamingStreamPoolResourceCrud) Create(ctx context.Context) error {
 	request := oci_streaming.CreateStreamPoolRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -314,7 +315,7 @@ func (s *StreamingStreamPoolResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	response, err := s.Client.CreateStreamPool(context.Background(), request)
+	response, err := s.Client.CreateStreamPool(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -323,7 +324,7 @@ func (s *StreamingStreamPoolResourceCrud) Create() error {
 	return nil
 }
 
-func (s *StreamingStreamPoolResourceCrud) Get() error {
+func (s *StreamingStreamPoolResourceCrud) Get(ctx context.Context) error {
 	request := oci_streaming.GetStreamPoolRequest{}
 
 	tmp := s.D.Id()
@@ -331,7 +332,7 @@ func (s *StreamingStreamPoolResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	response, err := s.Client.GetStreamPool(context.Background(), request)
+	response, err := s.Client.GetStreamPool(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -340,11 +341,11 @@ func (s *StreamingStreamPoolResourceCrud) Get() error {
 	return nil
 }
 
-func (s *StreamingStreamPoolResourceCrud) Update() error {
+func (s *StreamingStreamPoolResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -396,7 +397,7 @@ func (s *StreamingStreamPoolResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	response, err := s.Client.UpdateStreamPool(context.Background(), request)
+	response, err := s.Client.UpdateStreamPool(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -405,7 +406,7 @@ func (s *StreamingStreamPoolResourceCrud) Update() error {
 	return nil
 }
 
-func (s *StreamingStreamPoolResourceCrud) Delete() error {
+func (s *StreamingStreamPoolResourceCrud) Delete(ctx context.Context) error {
 	request := oci_streaming.DeleteStreamPoolRequest{}
 
 	tmp := s.D.Id()
@@ -413,7 +414,7 @@ func (s *StreamingStreamPoolResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	_, err := s.Client.DeleteStreamPool(context.Background(), request)
+	_, err := s.Client.DeleteStreamPool(ctx, request)
 	return err
 }
 
@@ -591,7 +592,7 @@ func PrivateEndpointSettingsToMap(obj *oci_streaming.PrivateEndpointSettings, da
 	return result
 }
 
-func (s *StreamingStreamPoolResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *StreamingStreamPoolResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_streaming.ChangeStreamPoolCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -602,12 +603,12 @@ func (s *StreamingStreamPoolResourceCrud) updateCompartment(compartment interfac
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	_, err := s.Client.ChangeStreamPoolCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeStreamPoolCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
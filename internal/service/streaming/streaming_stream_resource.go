@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_streaming "github.com/oracle/oci-go-sdk/v65/streaming"
@@ -19,11 +20,11 @@ func StreamingStreamResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createStreamingStream,
-		Read:     readStreamingStream,
-		Update:   updateStreamingStream,
-		Delete:   deleteStreamingStream,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createStreamingStream,
+		ReadContext:   readStreamingStream,
+		UpdateContext: updateStreamingStream,
+		DeleteContext: deleteStreamingStream,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"name": {
@@ -91,37 +92,37 @@ func StreamingStreamResource() *schema.Resource {
 	}
 }
 
-func createStreamingStream(d *schema.ResourceData, m interface{}) error {
+func createStreamingStream(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StreamingStreamResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StreamAdminClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readStreamingStream(d *schema.ResourceData, m interface{}) error {
+func readStreamingStream(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StreamingStreamResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StreamAdminClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateStreamingStream(d *schema.ResourceData, m interface{}) error {
+func updateStreamingStream(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StreamingStreamResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StreamAdminClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteStreamingStream(d *schema.ResourceData, m interface{}) error {
+func deleteStreamingStream(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &StreamingStreamResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).StreamAdminClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type StreamingStreamResourceCrud struct {
@@ -171,7 +172,7 @@ func (s *StreamingStreamResourceCrud) UpdatedTarget() []string {
 	}
 }
 
-func (s *StreamingStreamResourceCrud) Create() error {
+func (s *StreamingStreamResourceCrud) Create(ctx context.Context) error {
 	request := oci_streaming.CreateStreamRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -213,7 +214,7 @@ func (s *StreamingStreamResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	response, err := s.Client.CreateStream(context.Background(), request)
+	response, err := s.Client.CreateStream(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -222,7 +223,7 @@ func (s *StreamingStreamResourceCrud) Create() error {
 	return nil
 }
 
-func (s *StreamingStreamResourceCrud) Get() error {
+func (s *StreamingStreamResourceCrud) Get(ctx context.Context) error {
 	request := oci_streaming.GetStreamRequest{}
 
 	tmp := s.D.Id()
@@ -230,7 +231,7 @@ func (s *StreamingStreamResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	response, err := s.Client.GetStream(context.Background(), request)
+	response, err := s.Client.GetStream(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -239,11 +240,11 @@ func (s *StreamingStreamResourceCrud) Get() error {
 	return nil
 }
 
-func (s *StreamingStreamResourceCrud) Update() error {
+func (s *StreamingStreamResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -273,7 +274,7 @@ func (s *StreamingStreamResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	response, err := s.Client.UpdateStream(context.Background(), request)
+	response, err := s.Client.UpdateStream(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -282,7 +283,7 @@ func (s *StreamingStreamResourceCrud) Update() error {
 	return nil
 }
 
-func (s *StreamingStreamResourceCrud) Delete() error {
+func (s *StreamingStreamResourceCrud) Delete(ctx context.Context) error {
 	request := oci_streaming.DeleteStreamRequest{}
 
 	tmp := s.D.Id()
@@ -290,7 +291,7 @@ func (s *StreamingStreamResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	_, err := s.Client.DeleteStream(context.Background(), request)
+	_, err := s.Client.DeleteStream(ctx, request)
 	return err
 }
 
@@ -338,7 +339,7 @@ func (s *StreamingStreamResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *StreamingStreamResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *StreamingStreamResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_streaming.ChangeStreamCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -349,12 +350,12 @@ func (s *StreamingStreamResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "streaming")
 
-	_, err := s.Client.ChangeStreamCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeStreamCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package usage_proxy
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_usage_proxy "github.com/oracle/oci-go-sdk/v65/usage"
 
@@ -15,7 +16,7 @@ import (
 
 func UsageProxySubscriptionProductDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularUsageProxySubscriptionProduct,
+		ReadContext: readSingularUsageProxySubscriptionProduct,
 		Schema: map[string]*schema.Schema{
 			"producttype": {
 				Type:     schema.TypeString,
@@ -71,12 +72,12 @@ func UsageProxySubscriptionProductDataSource() *schema.Resource {
 	}
 }
 
-func readSingularUsageProxySubscriptionProduct(d *schema.ResourceData, m interface{}) error {
+func readSingularUsageProxySubscriptionProduct(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &UsageProxySubscriptionProductDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RewardsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type UsageProxySubscriptionProductDataSourceCrud struct {
@@ -89,7 +90,7 @@ func (s *UsageProxySubscriptionProductDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *UsageProxySubscriptionProductDataSourceCrud) Get() error {
+func (s *UsageProxySubscriptionProductDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_usage_proxy.ListProductsRequest{}
 
 	if producttype, ok := s.D.GetOkExists("producttype"); ok {
@@ -113,7 +114,7 @@ func (s *UsageProxySubscriptionProductDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "usage_proxy")
 
-	response, err := s.Client.ListProducts(context.Background(), request)
+	response, err := s.Client.ListProducts(ctx, request)
 	if err != nil {
 		return err
 	}
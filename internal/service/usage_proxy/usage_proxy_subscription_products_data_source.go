@@ -6,6 +6,7 @@ package usage_proxy
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_usage_proxy "github.com/oracle/oci-go-sdk/v65/usage"
 
@@ -15,7 +16,7 @@ import (
 
 func UsageProxySubscriptionProductsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readUsageProxySubscriptionProducts,
+		ReadContext: readUsageProxySubscriptionProducts,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"producttype": {
@@ -92,12 +93,12 @@ func UsageProxySubscriptionProductsDataSource() *schema.Resource {
 	}
 }
 
-func readUsageProxySubscriptionProducts(d *schema.ResourceData, m interface{}) error {
+func readUsageProxySubscriptionProducts(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &UsageProxySubscriptionProductsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RewardsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type UsageProxySubscriptionProductsDataSourceCrud struct {
@@ -110,7 +111,7 @@ func (s *UsageProxySubscriptionProductsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *UsageProxySubscriptionProductsDataSourceCrud) Get() error {
+func (s *UsageProxySubscriptionProductsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_usage_proxy.ListProductsRequest{}
 
 	if producttype, ok := s.D.GetOkExists("producttype"); ok {
@@ -134,7 +135,7 @@ func (s *UsageProxySubscriptionProductsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "usage_proxy")
 
-	response, err := s.Client.ListProducts(context.Background(), request)
+	response, err := s.Client.ListProducts(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -143,7 +144,7 @@ func (s *UsageProxySubscriptionProductsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListProducts(context.Background(), request)
+		listResponse, err := s.Client.ListProducts(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,7 @@ package usage_proxy
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_usage_proxy "github.com/oracle/oci-go-sdk/v65/usage"
 
@@ -26,12 +27,12 @@ func UsageProxySubscriptionRedeemableUserDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(UsageProxySubscriptionRedeemableUserResource(), fieldMap, readSingularUsageProxySubscriptionRedeemableUser)
 }
 
-func readSingularUsageProxySubscriptionRedeemableUser(d *schema.ResourceData, m interface{}) error {
+func readSingularUsageProxySubscriptionRedeemableUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &UsageProxySubscriptionRedeemableUserDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RewardsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type UsageProxySubscriptionRedeemableUserDataSourceCrud struct {
@@ -44,7 +45,7 @@ func (s *UsageProxySubscriptionRedeemableUserDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *UsageProxySubscriptionRedeemableUserDataSourceCrud) Get() error {
+func (s *UsageProxySubscriptionRedeemableUserDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_usage_proxy.ListRedeemableUsersRequest{}
 
 	if subscriptionId, ok := s.D.GetOkExists("subscription_id"); ok {
@@ -59,7 +60,7 @@ func (s *UsageProxySubscriptionRedeemableUserDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "usage_proxy")
 
-	response, err := s.Client.ListRedeemableUsers(context.Background(), request)
+	response, err := s.Client.ListRedeemableUsers(ctx, request)
 	if err != nil {
 		return err
 	}
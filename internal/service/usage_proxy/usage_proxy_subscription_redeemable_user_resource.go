@@ -11,6 +11,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_usage_proxy "github.com/oracle/oci-go-sdk/v65/usage"
@@ -24,10 +25,10 @@ func UsageProxySubscriptionRedeemableUserResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createUsageProxySubscriptionRedeemableUser,
-		Read:     readUsageProxySubscriptionRedeemableUser,
-		Delete:   deleteUsageProxySubscriptionRedeemableUser,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createUsageProxySubscriptionRedeemableUser,
+		ReadContext:   readUsageProxySubscriptionRedeemableUser,
+		DeleteContext: deleteUsageProxySubscriptionRedeemableUser,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"subscription_id": {
@@ -85,29 +86,29 @@ func UsageProxySubscriptionRedeemableUserResource() *schema.Resource {
 	}
 }
 
-func createUsageProxySubscriptionRedeemableUser(d *schema.ResourceData, m interface{}) error {
+func createUsageProxySubscriptionRedeemableUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &UsageProxySubscriptionRedeemableUserResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RewardsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readUsageProxySubscriptionRedeemableUser(d *schema.ResourceData, m interface{}) error {
+func readUsageProxySubscriptionRedeemableUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &UsageProxySubscriptionRedeemableUserResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RewardsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func deleteUsageProxySubscriptionRedeemableUser(d *schema.ResourceData, m interface{}) error {
+func deleteUsageProxySubscriptionRedeemableUser(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &UsageProxySubscriptionRedeemableUserResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RewardsClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type UsageProxySubscriptionRedeemableUserResourceCrud struct {
@@ -121,7 +122,7 @@ func (s *UsageProxySubscriptionRedeemableUserResourceCrud) ID() string {
 	return GetSubscriptionRedeemableUserCompositeId(s.D.Get("subscription_id").(string), s.D.Get("tenancy_id").(string))
 }
 
-func (s *UsageProxySubscriptionRedeemableUserResourceCrud) Create() error {
+func (s *UsageProxySubscriptionRedeemableUserResourceCrud) Create(ctx context.Context) error {
 	request := oci_usage_proxy.CreateRedeemableUserRequest{}
 
 	if items, ok := s.D.GetOkExists("items"); ok {
@@ -158,7 +159,7 @@ func (s *UsageProxySubscriptionRedeemableUserResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "usage_proxy")
 
-	response, err := s.Client.CreateRedeemableUser(context.Background(), request)
+	response, err := s.Client.CreateRedeemableUser(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -167,7 +168,7 @@ func (s *UsageProxySubscriptionRedeemableUserResourceCrud) Create() error {
 	return nil
 }
 
-func (s *UsageProxySubscriptionRedeemableUserResourceCrud) Get() error {
+func (s *UsageProxySubscriptionRedeemableUserResourceCrud) Get(ctx context.Context) error {
 	request := oci_usage_proxy.ListRedeemableUsersRequest{}
 
 	if subscriptionId, ok := s.D.GetOkExists("subscription_id"); ok {
@@ -190,7 +191,7 @@ func (s *UsageProxySubscriptionRedeemableUserResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "usage_proxy")
 
-	response, err := s.Client.ListRedeemableUsers(context.Background(), request)
+	response, err := s.Client.ListRedeemableUsers(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -199,7 +200,7 @@ func (s *UsageProxySubscriptionRedeemableUserResourceCrud) Get() error {
 	return nil
 }
 
-func (s *UsageProxySubscriptionRedeemableUserResourceCrud) Delete() error {
+func (s *UsageProxySubscriptionRedeemableUserResourceCrud) Delete(ctx context.Context) error {
 	request := oci_usage_proxy.DeleteRedeemableUserRequest{}
 	if emailId, ok := s.D.GetOkExists("items.0.email_id"); ok {
 		tmp := emailId.(string)
@@ -218,7 +219,7 @@ func (s *UsageProxySubscriptionRedeemableUserResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "usage_proxy")
 
-	_, err := s.Client.DeleteRedeemableUser(context.Background(), request)
+	_, err := s.Client.DeleteRedeemableUser(ctx, request)
 	return err
 }
 
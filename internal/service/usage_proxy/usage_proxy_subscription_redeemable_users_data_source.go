@@ -6,6 +6,7 @@ package usage_proxy
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_usage_proxy "github.com/oracle/oci-go-sdk/v65/usage"
 
@@ -15,7 +16,7 @@ import (
 
 func UsageProxySubscriptionRedeemableUsersDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readUsageProxySubscriptionRedeemableUsers,
+		ReadContext: readUsageProxySubscriptionRedeemableUsers,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"subscription_id": {
@@ -44,12 +45,12 @@ func UsageProxySubscriptionRedeemableUsersDataSource() *schema.Resource {
 	}
 }
 
-func readUsageProxySubscriptionRedeemableUsers(d *schema.ResourceData, m interface{}) error {
+func readUsageProxySubscriptionRedeemableUsers(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &UsageProxySubscriptionRedeemableUsersDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RewardsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type UsageProxySubscriptionRedeemableUsersDataSourceCrud struct {
@@ -62,7 +63,7 @@ func (s *UsageProxySubscriptionRedeemableUsersDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *UsageProxySubscriptionRedeemableUsersDataSourceCrud) Get() error {
+func (s *UsageProxySubscriptionRedeemableUsersDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_usage_proxy.ListRedeemableUsersRequest{}
 
 	if subscriptionId, ok := s.D.GetOkExists("subscription_id"); ok {
@@ -77,7 +78,7 @@ func (s *UsageProxySubscriptionRedeemableUsersDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "usage_proxy")
 
-	response, err := s.Client.ListRedeemableUsers(context.Background(), request)
+	response, err := s.Client.ListRedeemableUsers(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -86,7 +87,7 @@ func (s *UsageProxySubscriptionRedeemableUsersDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRedeemableUsers(context.Background(), request)
+		listResponse, err := s.Client.ListRedeemableUsers(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_usage_proxy "github.com/oracle/oci-go-sdk/v65/usage"
@@ -17,7 +18,7 @@ import (
 
 func UsageProxySubscriptionRedemptionDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularUsageProxySubscriptionRedemption,
+		ReadContext: readSingularUsageProxySubscriptionRedemption,
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
 				Type:     schema.TypeString,
@@ -93,12 +94,12 @@ func UsageProxySubscriptionRedemptionDataSource() *schema.Resource {
 	}
 }
 
-func readSingularUsageProxySubscriptionRedemption(d *schema.ResourceData, m interface{}) error {
+func readSingularUsageProxySubscriptionRedemption(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &UsageProxySubscriptionRedemptionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RewardsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type UsageProxySubscriptionRedemptionDataSourceCrud struct {
@@ -111,7 +112,7 @@ func (s *UsageProxySubscriptionRedemptionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *UsageProxySubscriptionRedemptionDataSourceCrud) Get() error {
+func (s *UsageProxySubscriptionRedemptionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_usage_proxy.ListRedemptionsRequest{}
 
 	if subscriptionId, ok := s.D.GetOkExists("subscription_id"); ok {
@@ -142,7 +143,7 @@ func (s *UsageProxySubscriptionRedemptionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "usage_proxy")
 
-	response, err := s.Client.ListRedemptions(context.Background(), request)
+	response, err := s.Client.ListRedemptions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_usage_proxy "github.com/oracle/oci-go-sdk/v65/usage"
@@ -17,7 +18,7 @@ import (
 
 func UsageProxySubscriptionRedemptionsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readUsageProxySubscriptionRedemptions,
+		ReadContext: readUsageProxySubscriptionRedemptions,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"subscription_id": {
@@ -114,12 +115,12 @@ func UsageProxySubscriptionRedemptionsDataSource() *schema.Resource {
 	}
 }
 
-func readUsageProxySubscriptionRedemptions(d *schema.ResourceData, m interface{}) error {
+func readUsageProxySubscriptionRedemptions(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &UsageProxySubscriptionRedemptionsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RewardsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type UsageProxySubscriptionRedemptionsDataSourceCrud struct {
@@ -132,7 +133,7 @@ func (s *UsageProxySubscriptionRedemptionsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *UsageProxySubscriptionRedemptionsDataSourceCrud) Get() error {
+func (s *UsageProxySubscriptionRedemptionsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_usage_proxy.ListRedemptionsRequest{}
 
 	if subscriptionId, ok := s.D.GetOkExists("subscription_id"); ok {
@@ -163,7 +164,7 @@ func (s *UsageProxySubscriptionRedemptionsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "usage_proxy")
 
-	response, err := s.Client.ListRedemptions(context.Background(), request)
+	response, err := s.Client.ListRedemptions(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -172,7 +173,7 @@ func (s *UsageProxySubscriptionRedemptionsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListRedemptions(context.Background(), request)
+		listResponse, err := s.Client.ListRedemptions(ctx, request)
 		if err != nil {
 			return err
 		}
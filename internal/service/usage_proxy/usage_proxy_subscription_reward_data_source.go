@@ -6,6 +6,7 @@ package usage_proxy
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_usage_proxy "github.com/oracle/oci-go-sdk/v65/usage"
 
@@ -15,7 +16,7 @@ import (
 
 func UsageProxySubscriptionRewardDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularUsageProxySubscriptionReward,
+		ReadContext: readSingularUsageProxySubscriptionReward,
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
 				Type:     schema.TypeString,
@@ -128,12 +129,12 @@ func UsageProxySubscriptionRewardDataSource() *schema.Resource {
 	}
 }
 
-func readSingularUsageProxySubscriptionReward(d *schema.ResourceData, m interface{}) error {
+func readSingularUsageProxySubscriptionReward(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &UsageProxySubscriptionRewardDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RewardsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type UsageProxySubscriptionRewardDataSourceCrud struct {
@@ -146,7 +147,7 @@ func (s *UsageProxySubscriptionRewardDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *UsageProxySubscriptionRewardDataSourceCrud) Get() error {
+func (s *UsageProxySubscriptionRewardDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_usage_proxy.ListRewardsRequest{}
 
 	if subscriptionId, ok := s.D.GetOkExists("subscription_id"); ok {
@@ -161,7 +162,7 @@ func (s *UsageProxySubscriptionRewardDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "usage_proxy")
 
-	response, err := s.Client.ListRewards(context.Background(), request)
+	response, err := s.Client.ListRewards(ctx, request)
 	if err != nil {
 		return err
 	}
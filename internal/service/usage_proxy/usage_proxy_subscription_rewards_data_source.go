@@ -6,6 +6,7 @@ package usage_proxy
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_usage_proxy "github.com/oracle/oci-go-sdk/v65/usage"
 
@@ -15,7 +16,7 @@ import (
 
 func UsageProxySubscriptionRewardsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readUsageProxySubscriptionRewards,
+		ReadContext: readUsageProxySubscriptionRewards,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"subscription_id": {
@@ -149,12 +150,12 @@ func UsageProxySubscriptionRewardsDataSource() *schema.Resource {
 	}
 }
 
-func readUsageProxySubscriptionRewards(d *schema.ResourceData, m interface{}) error {
+func readUsageProxySubscriptionRewards(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &UsageProxySubscriptionRewardsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RewardsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type UsageProxySubscriptionRewardsDataSourceCrud struct {
@@ -167,7 +168,7 @@ func (s *UsageProxySubscriptionRewardsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *UsageProxySubscriptionRewardsDataSourceCrud) Get() error {
+func (s *UsageProxySubscriptionRewardsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_usage_proxy.ListRewardsRequest{}
 
 	if subscriptionId, ok := s.D.GetOkExists("subscription_id"); ok {
@@ -182,7 +183,7 @@ func (s *UsageProxySubscriptionRewardsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "usage_proxy")
 
-	response, err := s.Client.ListRewards(context.Background(), request)
+	response, err := s.Client.ListRewards(ctx, request)
 	if err != nil {
 		return err
 	}
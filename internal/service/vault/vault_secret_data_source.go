@@ -7,6 +7,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_vault "github.com/oracle/oci-go-sdk/v65/vault"
 
@@ -23,12 +24,12 @@ func VaultSecretDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(VaultSecretResource(), fieldMap, readSingularVaultSecret)
 }
 
-func readSingularVaultSecret(d *schema.ResourceData, m interface{}) error {
+func readSingularVaultSecret(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VaultSecretDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VaultsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type VaultSecretDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *VaultSecretDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *VaultSecretDataSourceCrud) Get() error {
+func (s *VaultSecretDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_vault.GetSecretRequest{}
 
 	if secretId, ok := s.D.GetOkExists("secret_id"); ok {
@@ -51,7 +52,7 @@ func (s *VaultSecretDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "vault")
 
-	response, err := s.Client.GetSecret(context.Background(), request)
+	response, err := s.Client.GetSecret(ctx, request)
 	if err != nil {
 		return err
 	}
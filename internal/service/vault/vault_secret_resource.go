@@ -11,6 +11,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -26,11 +27,11 @@ func VaultSecretResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createVaultSecret,
-		Read:     readVaultSecret,
-		Update:   updateVaultSecret,
-		Delete:   deleteVaultSecret,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createVaultSecret,
+		ReadContext:   readVaultSecret,
+		UpdateContext: updateVaultSecret,
+		DeleteContext: deleteVaultSecret,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -193,36 +194,36 @@ func VaultSecretResource() *schema.Resource {
 	}
 }
 
-func createVaultSecret(d *schema.ResourceData, m interface{}) error {
+func createVaultSecret(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VaultSecretResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VaultsClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readVaultSecret(d *schema.ResourceData, m interface{}) error {
+func readVaultSecret(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VaultSecretResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VaultsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateVaultSecret(d *schema.ResourceData, m interface{}) error {
+func updateVaultSecret(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VaultSecretResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VaultsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteVaultSecret(d *schema.ResourceData, m interface{}) error {
+func deleteVaultSecret(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VaultSecretResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VaultsClient()
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type VaultSecretResourceCrud struct {
@@ -262,7 +263,7 @@ func (s *VaultSecretResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *VaultSecretResourceCrud) Create() error {
+func (s *VaultSecretResourceCrud) Create(ctx context.Context) error {
 	request := oci_vault.CreateSecretRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -336,7 +337,7 @@ func (s *VaultSecretResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vault")
 
-	response, err := s.Client.CreateSecret(context.Background(), request)
+	response, err := s.Client.CreateSecret(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -345,7 +346,7 @@ func (s *VaultSecretResourceCrud) Create() error {
 	return nil
 }
 
-func (s *VaultSecretResourceCrud) Get() error {
+func (s *VaultSecretResourceCrud) Get(ctx context.Context) error {
 	request := oci_vault.GetSecretRequest{}
 
 	tmp := s.D.Id()
@@ -353,7 +354,7 @@ func (s *VaultSecretResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vault")
 
-	response, err := s.Client.GetSecret(context.Background(), request)
+	response, err := s.Client.GetSecret(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -362,11 +363,11 @@ func (s *VaultSecretResourceCrud) Get() error {
 	return nil
 }
 
-func (s *VaultSecretResourceCrud) Update() error {
+func (s *VaultSecretResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -437,7 +438,7 @@ func (s *VaultSecretResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vault")
 
-	response, err := s.Client.UpdateSecret(context.Background(), request)
+	response, err := s.Client.UpdateSecret(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -446,7 +447,7 @@ func (s *VaultSecretResourceCrud) Update() error {
 	return nil
 }
 
-func (s *VaultSecretResourceCrud) Delete() error {
+func (s *VaultSecretResourceCrud) Delete(ctx context.Context) error {
 	request := oci_vault.ScheduleSecretDeletionRequest{}
 
 	tmp := s.D.Id()
@@ -454,7 +455,7 @@ func (s *VaultSecretResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vault")
 
-	_, err := s.Client.ScheduleSecretDeletion(context.Background(), request)
+	_, err := s.Client.ScheduleSecretDeletion(ctx, request)
 	return err
 }
 
@@ -639,7 +640,7 @@ func SecretRuleToMap(obj oci_vault.SecretRule) map[string]interface{} {
 	return result
 }
 
-func (s *VaultSecretResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *VaultSecretResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_vault.ChangeSecretCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -650,12 +651,12 @@ func (s *VaultSecretResourceCrud) updateCompartment(compartment interface{}) err
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vault")
 
-	_, err := s.Client.ChangeSecretCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeSecretCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -11,13 +11,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_vault "github.com/oracle/oci-go-sdk/v65/vault"
 )
 
 func VaultSecretVersionDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularVaultSecretVersion,
+		ReadContext: readSingularVaultSecretVersion,
 		Schema: map[string]*schema.Schema{
 			"secret_id": {
 				Type:     schema.TypeString,
@@ -63,12 +64,12 @@ func VaultSecretVersionDataSource() *schema.Resource {
 	}
 }
 
-func readSingularVaultSecretVersion(d *schema.ResourceData, m interface{}) error {
+func readSingularVaultSecretVersion(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VaultSecretVersionDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VaultsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type VaultSecretVersionDataSourceCrud struct {
@@ -81,7 +82,7 @@ func (s *VaultSecretVersionDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *VaultSecretVersionDataSourceCrud) Get() error {
+func (s *VaultSecretVersionDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_vault.GetSecretVersionRequest{}
 
 	if secretId, ok := s.D.GetOkExists("secret_id"); ok {
@@ -100,7 +101,7 @@ func (s *VaultSecretVersionDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "vault")
 
-	response, err := s.Client.GetSecretVersion(context.Background(), request)
+	response, err := s.Client.GetSecretVersion(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package vault
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_vault "github.com/oracle/oci-go-sdk/v65/vault"
 
@@ -15,7 +16,7 @@ import (
 
 func VaultSecretsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readVaultSecrets,
+		ReadContext: readVaultSecrets,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -43,12 +44,12 @@ func VaultSecretsDataSource() *schema.Resource {
 	}
 }
 
-func readVaultSecrets(d *schema.ResourceData, m interface{}) error {
+func readVaultSecrets(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VaultSecretsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VaultsClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type VaultSecretsDataSourceCrud struct {
@@ -61,7 +62,7 @@ func (s *VaultSecretsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *VaultSecretsDataSourceCrud) Get() error {
+func (s *VaultSecretsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_vault.ListSecretsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -85,7 +86,7 @@ func (s *VaultSecretsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "vault")
 
-	response, err := s.Client.ListSecrets(context.Background(), request)
+	response, err := s.Client.ListSecrets(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *VaultSecretsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListSecrets(context.Background(), request)
+		listResponse, err := s.Client.ListSecrets(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -3,6 +3,7 @@ package visual_builder
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -16,7 +17,7 @@ func VisualBuilderVbInstanceApplicationsDataSource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Read: readVisualBuilderVbInstanceApplication,
+		ReadContext: readVisualBuilderVbInstanceApplication,
 		Schema: map[string]*schema.Schema{
 			"vb_instance_id": {
 				Type:     schema.TypeString,
@@ -65,12 +66,12 @@ func VisualBuilderVbInstanceApplicationsDataSource() *schema.Resource {
 	}
 }
 
-func readVisualBuilderVbInstanceApplication(d *schema.ResourceData, m interface{}) error {
+func readVisualBuilderVbInstanceApplication(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VisualBuilderVbInstanceApplicationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VbInstanceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type VisualBuilderVbInstanceApplicationsDataSourceCrud struct {
@@ -83,7 +84,7 @@ func (s *VisualBuilderVbInstanceApplicationsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *VisualBuilderVbInstanceApplicationsDataSourceCrud) Get() error {
+func (s *VisualBuilderVbInstanceApplicationsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_visual_builder.RequestSummarizedApplicationsRequest{}
 
 	if vbInstanceId, ok := s.D.GetOkExists("vb_instance_id"); ok {
@@ -101,7 +102,7 @@ func (s *VisualBuilderVbInstanceApplicationsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "visual_builder")
 
-	response, err := s.Client.RequestSummarizedApplications(context.Background(), request)
+	response, err := s.Client.RequestSummarizedApplications(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_visual_builder "github.com/oracle/oci-go-sdk/v65/visualbuilder"
 )
@@ -22,12 +23,12 @@ func VisualBuilderVbInstanceDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(VisualBuilderVbInstanceResource(), fieldMap, readSingularVisualBuilderVbInstance)
 }
 
-func readSingularVisualBuilderVbInstance(d *schema.ResourceData, m interface{}) error {
+func readSingularVisualBuilderVbInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VisualBuilderVbInstanceDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VbInstanceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type VisualBuilderVbInstanceDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *VisualBuilderVbInstanceDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *VisualBuilderVbInstanceDataSourceCrud) Get() error {
+func (s *VisualBuilderVbInstanceDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_visual_builder.GetVbInstanceRequest{}
 
 	if vbInstanceId, ok := s.D.GetOkExists("vb_instance_id"); ok {
@@ -50,7 +51,7 @@ func (s *VisualBuilderVbInstanceDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "visual_builder")
 
-	response, err := s.Client.GetVbInstance(context.Background(), request)
+	response, err := s.Client.GetVbInstance(ctx, request)
 	if err != nil {
 		return err
 	}
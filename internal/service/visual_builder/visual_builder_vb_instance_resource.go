@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,11 +25,11 @@ func VisualBuilderVbInstanceResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createVisualBuilderVbInstance,
-		Read:     readVisualBuilderVbInstance,
-		Update:   updateVisualBuilderVbInstance,
-		Delete:   deleteVisualBuilderVbInstance,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createVisualBuilderVbInstance,
+		ReadContext:   readVisualBuilderVbInstance,
+		UpdateContext: updateVisualBuilderVbInstance,
+		DeleteContext: deleteVisualBuilderVbInstance,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -162,37 +163,37 @@ func VisualBuilderVbInstanceResource() *schema.Resource {
 	}
 }
 
-func createVisualBuilderVbInstance(d *schema.ResourceData, m interface{}) error {
+func createVisualBuilderVbInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VisualBuilderVbInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VbInstanceClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readVisualBuilderVbInstance(d *schema.ResourceData, m interface{}) error {
+func readVisualBuilderVbInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VisualBuilderVbInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VbInstanceClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateVisualBuilderVbInstance(d *schema.ResourceData, m interface{}) error {
+func updateVisualBuilderVbInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VisualBuilderVbInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VbInstanceClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteVisualBuilderVbInstance(d *schema.ResourceData, m interface{}) error {
+func deleteVisualBuilderVbInstance(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VisualBuilderVbInstanceResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VbInstanceClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type VisualBuilderVbInstanceResourceCrud struct {
@@ -230,7 +231,7 @@ func (s *VisualBuilderVbInstanceResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *VisualBuilderVbInstanceResourceCrud) Create() error {
+func (s *VisualBuilderVbInstanceResourceCrud) Create(ctx context.Context) error {
 	request := oci_visual_builder.CreateVbInstanceRequest{}
 
 	if alternateCustomEndpoints, ok := s.D.GetOkExists("alternate_custom_endpoints"); ok {
@@ -304,21 +305,21 @@ func (s *VisualBuilderVbInstanceResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "visual_builder")
 
-	response, err := s.Client.CreateVbInstance(context.Background(), request)
+	response, err := s.Client.CreateVbInstance(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getVbInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "visual_builder"), oci_visual_builder.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getVbInstanceFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "visual_builder"), oci_visual_builder.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *VisualBuilderVbInstanceResourceCrud) getVbInstanceFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *VisualBuilderVbInstanceResourceCrud) getVbInstanceFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_visual_builder.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
 	// entityType is visualbuilder and not visual_builder
-	vbInstanceId, err := vbInstanceWaitForWorkRequest(workId, "visualbuilder",
+	vbInstanceId, err := vbInstanceWaitForWorkRequest(ctx, workId, "visualbuilder",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -326,7 +327,7 @@ func (s *VisualBuilderVbInstanceResourceCrud) getVbInstanceFromWorkRequest(workI
 	}
 	s.D.SetId(*vbInstanceId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func vbInstanceWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -352,7 +353,7 @@ func vbInstanceWorkRequestShouldRetryFunc(timeout time.Duration) func(response o
 	}
 }
 
-func vbInstanceWaitForWorkRequest(wId *string, entityType string, action oci_visual_builder.WorkRequestResourceActionTypeEnum,
+func vbInstanceWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_visual_builder.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_visual_builder.VbInstanceClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "visual_builder")
 	retryPolicy.ShouldRetryOperation = vbInstanceWorkRequestShouldRetryFunc(timeout)
@@ -371,7 +372,7 @@ func vbInstanceWaitForWorkRequest(wId *string, entityType string, action oci_vis
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_visual_builder.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -400,14 +401,14 @@ func vbInstanceWaitForWorkRequest(wId *string, entityType string, action oci_vis
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_visual_builder.WorkRequestStatusFailed || response.Status == oci_visual_builder.WorkRequestStatusCanceled {
-		return nil, getErrorFromVisualBuilderVbInstanceWorkRequest(client, response.CompartmentId, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromVisualBuilderVbInstanceWorkRequest(ctx, client, response.CompartmentId, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromVisualBuilderVbInstanceWorkRequest(client *oci_visual_builder.VbInstanceClient, compartmentId *string, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_visual_builder.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromVisualBuilderVbInstanceWorkRequest(ctx context.Context, client *oci_visual_builder.VbInstanceClient, compartmentId *string, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_visual_builder.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_visual_builder.ListWorkRequestErrorsRequest{
 			CompartmentId: compartmentId,
 			WorkRequestId: workId,
@@ -430,7 +431,7 @@ func getErrorFromVisualBuilderVbInstanceWorkRequest(client *oci_visual_builder.V
 	return workRequestErr
 }
 
-func (s *VisualBuilderVbInstanceResourceCrud) Get() error {
+func (s *VisualBuilderVbInstanceResourceCrud) Get(ctx context.Context) error {
 	request := oci_visual_builder.GetVbInstanceRequest{}
 
 	tmp := s.D.Id()
@@ -438,7 +439,7 @@ func (s *VisualBuilderVbInstanceResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "visual_builder")
 
-	response, err := s.Client.GetVbInstance(context.Background(), request)
+	response, err := s.Client.GetVbInstance(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -447,11 +448,11 @@ func (s *VisualBuilderVbInstanceResourceCrud) Get() error {
 	return nil
 }
 
-func (s *VisualBuilderVbInstanceResourceCrud) Update() error {
+func (s *VisualBuilderVbInstanceResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -524,16 +525,16 @@ func (s *VisualBuilderVbInstanceResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "visual_builder")
 
-	response, err := s.Client.UpdateVbInstance(context.Background(), request)
+	response, err := s.Client.UpdateVbInstance(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getVbInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "visual_builder"), oci_visual_builder.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getVbInstanceFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "visual_builder"), oci_visual_builder.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *VisualBuilderVbInstanceResourceCrud) Delete() error {
+func (s *VisualBuilderVbInstanceResourceCrud) Delete(ctx context.Context) error {
 	request := oci_visual_builder.DeleteVbInstanceRequest{}
 
 	tmp := s.D.Id()
@@ -541,7 +542,7 @@ func (s *VisualBuilderVbInstanceResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "visual_builder")
 
-	response, err := s.Client.DeleteVbInstance(context.Background(), request)
+	response, err := s.Client.DeleteVbInstance(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -549,7 +550,7 @@ func (s *VisualBuilderVbInstanceResourceCrud) Delete() error {
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
 	// entityType is visualbuilder and not visual_builder
-	_, delWorkRequestErr := vbInstanceWaitForWorkRequest(workId, "visualbuilder",
+	_, delWorkRequestErr := vbInstanceWaitForWorkRequest(ctx, workId, "visualbuilder",
 		oci_visual_builder.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -732,7 +733,7 @@ func VbInstanceSummaryToMap(obj oci_visual_builder.VbInstanceSummary) map[string
 	return result
 }
 
-func (s *VisualBuilderVbInstanceResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *VisualBuilderVbInstanceResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_visual_builder.ChangeVbInstanceCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -743,11 +744,11 @@ func (s *VisualBuilderVbInstanceResourceCrud) updateCompartment(compartment inte
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "visual_builder")
 
-	response, err := s.Client.ChangeVbInstanceCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeVbInstanceCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getVbInstanceFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "visual_builder"), oci_visual_builder.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getVbInstanceFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "visual_builder"), oci_visual_builder.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -6,6 +6,7 @@ package vn_monitoring
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_vn_monitoring "github.com/oracle/oci-go-sdk/v65/vnmonitoring"
 
@@ -22,12 +23,12 @@ func VnMonitoringPathAnalyzerTestDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(VnMonitoringPathAnalyzerTestResource(), fieldMap, readSingularVnMonitoringPathAnalyzerTest)
 }
 
-func readSingularVnMonitoringPathAnalyzerTest(d *schema.ResourceData, m interface{}) error {
+func readSingularVnMonitoringPathAnalyzerTest(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VnMonitoringPathAnalyzerTestDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VnMonitoringClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type VnMonitoringPathAnalyzerTestDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *VnMonitoringPathAnalyzerTestDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *VnMonitoringPathAnalyzerTestDataSourceCrud) Get() error {
+func (s *VnMonitoringPathAnalyzerTestDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_vn_monitoring.GetPathAnalyzerTestRequest{}
 
 	if pathAnalyzerTestId, ok := s.D.GetOkExists("path_analyzer_test_id"); ok {
@@ -50,7 +51,7 @@ func (s *VnMonitoringPathAnalyzerTestDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "vn_monitoring")
 
-	response, err := s.Client.GetPathAnalyzerTest(context.Background(), request)
+	response, err := s.Client.GetPathAnalyzerTest(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -23,11 +24,11 @@ func VnMonitoringPathAnalyzerTestResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createVnMonitoringPathAnalyzerTest,
-		Read:     readVnMonitoringPathAnalyzerTest,
-		Update:   updateVnMonitoringPathAnalyzerTest,
-		Delete:   deleteVnMonitoringPathAnalyzerTest,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createVnMonitoringPathAnalyzerTest,
+		ReadContext:   readVnMonitoringPathAnalyzerTest,
+		UpdateContext: updateVnMonitoringPathAnalyzerTest,
+		DeleteContext: deleteVnMonitoringPathAnalyzerTest,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -300,37 +301,37 @@ func VnMonitoringPathAnalyzerTestResource() *schema.Resource {
 	}
 }
 
-func createVnMonitoringPathAnalyzerTest(d *schema.ResourceData, m interface{}) error {
+func createVnMonitoringPathAnalyzerTest(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VnMonitoringPathAnalyzerTestResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VnMonitoringClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readVnMonitoringPathAnalyzerTest(d *schema.ResourceData, m interface{}) error {
+func readVnMonitoringPathAnalyzerTest(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VnMonitoringPathAnalyzerTestResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VnMonitoringClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateVnMonitoringPathAnalyzerTest(d *schema.ResourceData, m interface{}) error {
+func updateVnMonitoringPathAnalyzerTest(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VnMonitoringPathAnalyzerTestResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VnMonitoringClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteVnMonitoringPathAnalyzerTest(d *schema.ResourceData, m interface{}) error {
+func deleteVnMonitoringPathAnalyzerTest(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VnMonitoringPathAnalyzerTestResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VnMonitoringClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type VnMonitoringPathAnalyzerTestResourceCrud struct {
@@ -364,7 +365,7 @@ func (s *VnMonitoringPathAnalyzerTestResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *VnMonitoringPathAnalyzerTestResourceCrud) Create() error {
+func (s *VnMonitoringPathAnalyzerTestResourceCrud) Create(ctx context.Context) error {
 	request := oci_vn_monitoring.CreatePathAnalyzerTestRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -440,7 +441,7 @@ func (s *VnMonitoringPathAnalyzerTestResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vn_monitoring")
 
-	response, err := s.Client.CreatePathAnalyzerTest(context.Background(), request)
+	response, err := s.Client.CreatePathAnalyzerTest(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -449,7 +450,7 @@ func (s *VnMonitoringPathAnalyzerTestResourceCrud) Create() error {
 	return nil
 }
 
-func (s *VnMonitoringPathAnalyzerTestResourceCrud) Get() error {
+func (s *VnMonitoringPathAnalyzerTestResourceCrud) Get(ctx context.Context) error {
 	request := oci_vn_monitoring.GetPathAnalyzerTestRequest{}
 
 	tmp := s.D.Id()
@@ -457,7 +458,7 @@ func (s *VnMonitoringPathAnalyzerTestResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vn_monitoring")
 
-	response, err := s.Client.GetPathAnalyzerTest(context.Background(), request)
+	response, err := s.Client.GetPathAnalyzerTest(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -466,11 +467,11 @@ func (s *VnMonitoringPathAnalyzerTestResourceCrud) Get() error {
 	return nil
 }
 
-func (s *VnMonitoringPathAnalyzerTestResourceCrud) Update() error {
+func (s *VnMonitoringPathAnalyzerTestResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -549,7 +550,7 @@ func (s *VnMonitoringPathAnalyzerTestResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vn_monitoring")
 
-	response, err := s.Client.UpdatePathAnalyzerTest(context.Background(), request)
+	response, err := s.Client.UpdatePathAnalyzerTest(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -558,7 +559,7 @@ func (s *VnMonitoringPathAnalyzerTestResourceCrud) Update() error {
 	return nil
 }
 
-func (s *VnMonitoringPathAnalyzerTestResourceCrud) Delete() error {
+func (s *VnMonitoringPathAnalyzerTestResourceCrud) Delete(ctx context.Context) error {
 	request := oci_vn_monitoring.DeletePathAnalyzerTestRequest{}
 
 	tmp := s.D.Id()
@@ -566,7 +567,7 @@ func (s *VnMonitoringPathAnalyzerTestResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vn_monitoring")
 
-	_, err := s.Client.DeletePathAnalyzerTest(context.Background(), request)
+	_, err := s.Client.DeletePathAnalyzerTest(ctx, request)
 	return err
 }
 
@@ -1025,7 +1026,7 @@ func QueryOptionsToMap(obj *oci_vn_monitoring.QueryOptions) map[string]interface
 	return result
 }
 
-func (s *VnMonitoringPathAnalyzerTestResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *VnMonitoringPathAnalyzerTestResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_vn_monitoring.ChangePathAnalyzerTestCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -1036,12 +1037,12 @@ func (s *VnMonitoringPathAnalyzerTestResourceCrud) updateCompartment(compartment
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vn_monitoring")
 
-	_, err := s.Client.ChangePathAnalyzerTestCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangePathAnalyzerTestCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -6,6 +6,7 @@ package vn_monitoring
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_vn_monitoring "github.com/oracle/oci-go-sdk/v65/vnmonitoring"
 
@@ -15,7 +16,7 @@ import (
 
 func VnMonitoringPathAnalyzerTestsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readVnMonitoringPathAnalyzerTests,
+		ReadContext: readVnMonitoringPathAnalyzerTests,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,12 +49,12 @@ func VnMonitoringPathAnalyzerTestsDataSource() *schema.Resource {
 	}
 }
 
-func readVnMonitoringPathAnalyzerTests(d *schema.ResourceData, m interface{}) error {
+func readVnMonitoringPathAnalyzerTests(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VnMonitoringPathAnalyzerTestsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VnMonitoringClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type VnMonitoringPathAnalyzerTestsDataSourceCrud struct {
@@ -66,7 +67,7 @@ func (s *VnMonitoringPathAnalyzerTestsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *VnMonitoringPathAnalyzerTestsDataSourceCrud) Get() error {
+func (s *VnMonitoringPathAnalyzerTestsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_vn_monitoring.ListPathAnalyzerTestsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -85,7 +86,7 @@ func (s *VnMonitoringPathAnalyzerTestsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "vn_monitoring")
 
-	response, err := s.Client.ListPathAnalyzerTests(context.Background(), request)
+	response, err := s.Client.ListPathAnalyzerTests(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *VnMonitoringPathAnalyzerTestsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListPathAnalyzerTests(context.Background(), request)
+		listResponse, err := s.Client.ListPathAnalyzerTests(ctx, request)
 		if err != nil {
 			return err
 		}
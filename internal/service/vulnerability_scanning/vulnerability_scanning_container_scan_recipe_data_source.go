@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_vulnerability_scanning "github.com/oracle/oci-go-sdk/v65/vulnerabilityscanning"
 )
@@ -22,12 +23,12 @@ func VulnerabilityScanningContainerScanRecipeDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(VulnerabilityScanningContainerScanRecipeResource(), fieldMap, readSingularVulnerabilityScanningContainerScanRecipe)
 }
 
-func readSingularVulnerabilityScanningContainerScanRecipe(d *schema.ResourceData, m interface{}) error {
+func readSingularVulnerabilityScanningContainerScanRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningContainerScanRecipeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type VulnerabilityScanningContainerScanRecipeDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *VulnerabilityScanningContainerScanRecipeDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *VulnerabilityScanningContainerScanRecipeDataSourceCrud) Get() error {
+func (s *VulnerabilityScanningContainerScanRecipeDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_vulnerability_scanning.GetContainerScanRecipeRequest{}
 
 	if containerScanRecipeId, ok := s.D.GetOkExists("container_scan_recipe_id"); ok {
@@ -50,7 +51,7 @@ func (s *VulnerabilityScanningContainerScanRecipeDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "vulnerability_scanning")
 
-	response, err := s.Client.GetContainerScanRecipe(context.Background(), request)
+	response, err := s.Client.GetContainerScanRecipe(ctx, request)
 	if err != nil {
 		return err
 	}
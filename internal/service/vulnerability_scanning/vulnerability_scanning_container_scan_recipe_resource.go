@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -24,11 +25,11 @@ func VulnerabilityScanningContainerScanRecipeResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createVulnerabilityScanningContainerScanRecipe,
-		Read:     readVulnerabilityScanningContainerScanRecipe,
-		Update:   updateVulnerabilityScanningContainerScanRecipe,
-		Delete:   deleteVulnerabilityScanningContainerScanRecipe,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createVulnerabilityScanningContainerScanRecipe,
+		ReadContext:   readVulnerabilityScanningContainerScanRecipe,
+		UpdateContext: updateVulnerabilityScanningContainerScanRecipe,
+		DeleteContext: deleteVulnerabilityScanningContainerScanRecipe,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -102,37 +103,37 @@ func VulnerabilityScanningContainerScanRecipeResource() *schema.Resource {
 	}
 }
 
-func createVulnerabilityScanningContainerScanRecipe(d *schema.ResourceData, m interface{}) error {
+func createVulnerabilityScanningContainerScanRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningContainerScanRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readVulnerabilityScanningContainerScanRecipe(d *schema.ResourceData, m interface{}) error {
+func readVulnerabilityScanningContainerScanRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningContainerScanRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateVulnerabilityScanningContainerScanRecipe(d *schema.ResourceData, m interface{}) error {
+func updateVulnerabilityScanningContainerScanRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningContainerScanRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteVulnerabilityScanningContainerScanRecipe(d *schema.ResourceData, m interface{}) error {
+func deleteVulnerabilityScanningContainerScanRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningContainerScanRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type VulnerabilityScanningContainerScanRecipeResourceCrud struct {
@@ -170,7 +171,7 @@ func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) DeletedTarget() [
 	}
 }
 
-func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) Create() error {
+func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) Create(ctx context.Context) error {
 	request := oci_vulnerability_scanning.CreateContainerScanRecipeRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -213,20 +214,20 @@ func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.CreateContainerScanRecipe(context.Background(), request)
+	response, err := s.Client.CreateContainerScanRecipe(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getContainerScanRecipeFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning"), oci_vulnerability_scanning.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getContainerScanRecipeFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning"), oci_vulnerability_scanning.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) getContainerScanRecipeFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) getContainerScanRecipeFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_vulnerability_scanning.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	containerScanRecipeId, err := containerScanRecipeWaitForWorkRequest(workId, "containerScanRecipe",
+	containerScanRecipeId, err := containerScanRecipeWaitForWorkRequest(ctx, workId, "containerScanRecipe",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -234,7 +235,7 @@ func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) getContainerScanR
 	}
 	s.D.SetId(*containerScanRecipeId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func containerScanRecipeWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -260,7 +261,7 @@ func containerScanRecipeWorkRequestShouldRetryFunc(timeout time.Duration) func(r
 	}
 }
 
-func containerScanRecipeWaitForWorkRequest(wId *string, entityType string, action oci_vulnerability_scanning.ActionTypeEnum,
+func containerScanRecipeWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_vulnerability_scanning.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_vulnerability_scanning.VulnerabilityScanningClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "vulnerability_scanning")
 	retryPolicy.ShouldRetryOperation = containerScanRecipeWorkRequestShouldRetryFunc(timeout)
@@ -277,7 +278,7 @@ func containerScanRecipeWaitForWorkRequest(wId *string, entityType string, actio
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_vulnerability_scanning.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -306,14 +307,14 @@ func containerScanRecipeWaitForWorkRequest(wId *string, entityType string, actio
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_vulnerability_scanning.OperationStatusFailed {
-		return nil, getErrorFromVulnerabilityScanningContainerScanRecipeWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromVulnerabilityScanningContainerScanRecipeWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromVulnerabilityScanningContainerScanRecipeWorkRequest(client *oci_vulnerability_scanning.VulnerabilityScanningClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_vulnerability_scanning.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromVulnerabilityScanningContainerScanRecipeWorkRequest(ctx context.Context, client *oci_vulnerability_scanning.VulnerabilityScanningClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_vulnerability_scanning.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_vulnerability_scanning.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -335,7 +336,7 @@ func getErrorFromVulnerabilityScanningContainerScanRecipeWorkRequest(client *oci
 	return workRequestErr
 }
 
-func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) Get() error {
+func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) Get(ctx context.Context) error {
 	request := oci_vulnerability_scanning.GetContainerScanRecipeRequest{}
 
 	tmp := s.D.Id()
@@ -343,7 +344,7 @@ func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.GetContainerScanRecipe(context.Background(), request)
+	response, err := s.Client.GetContainerScanRecipe(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -352,11 +353,11 @@ func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) Get() error {
 	return nil
 }
 
-func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) Update() error {
+func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -402,16 +403,16 @@ func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.UpdateContainerScanRecipe(context.Background(), request)
+	response, err := s.Client.UpdateContainerScanRecipe(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getContainerScanRecipeFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning"), oci_vulnerability_scanning.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getContainerScanRecipeFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning"), oci_vulnerability_scanning.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) Delete() error {
+func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) Delete(ctx context.Context) error {
 	request := oci_vulnerability_scanning.DeleteContainerScanRecipeRequest{}
 
 	tmp := s.D.Id()
@@ -419,14 +420,14 @@ func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.DeleteContainerScanRecipe(context.Background(), request)
+	response, err := s.Client.DeleteContainerScanRecipe(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := containerScanRecipeWaitForWorkRequest(workId, "containerScanRecipe",
+	_, delWorkRequestErr := containerScanRecipeWaitForWorkRequest(ctx, workId, "containerScanRecipe",
 		oci_vulnerability_scanning.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -529,7 +530,7 @@ func ContainerScanSettingsToMap(obj *oci_vulnerability_scanning.ContainerScanSet
 	return result
 }
 
-func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_vulnerability_scanning.ChangeContainerScanRecipeCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -540,12 +541,12 @@ func (s *VulnerabilityScanningContainerScanRecipeResourceCrud) updateCompartment
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	_, err := s.Client.ChangeContainerScanRecipeCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeContainerScanRecipeCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_vulnerability_scanning "github.com/oracle/oci-go-sdk/v65/vulnerabilityscanning"
 )
 
 func VulnerabilityScanningContainerScanRecipesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readVulnerabilityScanningContainerScanRecipes,
+		ReadContext: readVulnerabilityScanningContainerScanRecipes,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,12 +49,12 @@ func VulnerabilityScanningContainerScanRecipesDataSource() *schema.Resource {
 	}
 }
 
-func readVulnerabilityScanningContainerScanRecipes(d *schema.ResourceData, m interface{}) error {
+func readVulnerabilityScanningContainerScanRecipes(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningContainerScanRecipesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type VulnerabilityScanningContainerScanRecipesDataSourceCrud struct {
@@ -66,7 +67,7 @@ func (s *VulnerabilityScanningContainerScanRecipesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *VulnerabilityScanningContainerScanRecipesDataSourceCrud) Get() error {
+func (s *VulnerabilityScanningContainerScanRecipesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_vulnerability_scanning.ListContainerScanRecipesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -85,7 +86,7 @@ func (s *VulnerabilityScanningContainerScanRecipesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "vulnerability_scanning")
 
-	response, err := s.Client.ListContainerScanRecipes(context.Background(), request)
+	response, err := s.Client.ListContainerScanRecipes(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *VulnerabilityScanningContainerScanRecipesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListContainerScanRecipes(context.Background(), request)
+		listResponse, err := s.Client.ListContainerScanRecipes(ctx, request)
 		if err != nil {
 			return err
 		}
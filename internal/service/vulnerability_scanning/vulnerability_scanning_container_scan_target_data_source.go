@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_vulnerability_scanning "github.com/oracle/oci-go-sdk/v65/vulnerabilityscanning"
 )
@@ -22,12 +23,12 @@ func VulnerabilityScanningContainerScanTargetDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(VulnerabilityScanningContainerScanTargetResource(), fieldMap, readSingularVulnerabilityScanningContainerScanTarget)
 }
 
-func readSingularVulnerabilityScanningContainerScanTarget(d *schema.ResourceData, m interface{}) error {
+func readSingularVulnerabilityScanningContainerScanTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningContainerScanTargetDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type VulnerabilityScanningContainerScanTargetDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *VulnerabilityScanningContainerScanTargetDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *VulnerabilityScanningContainerScanTargetDataSourceCrud) Get() error {
+func (s *VulnerabilityScanningContainerScanTargetDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_vulnerability_scanning.GetContainerScanTargetRequest{}
 
 	if containerScanTargetId, ok := s.D.GetOkExists("container_scan_target_id"); ok {
@@ -50,7 +51,7 @@ func (s *VulnerabilityScanningContainerScanTargetDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "vulnerability_scanning")
 
-	response, err := s.Client.GetContainerScanTarget(context.Background(), request)
+	response, err := s.Client.GetContainerScanTarget(ctx, request)
 	if err != nil {
 		return err
 	}
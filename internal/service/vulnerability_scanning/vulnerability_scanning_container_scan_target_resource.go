@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func VulnerabilityScanningContainerScanTargetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createVulnerabilityScanningContainerScanTarget,
-		Read:     readVulnerabilityScanningContainerScanTarget,
-		Update:   updateVulnerabilityScanningContainerScanTarget,
-		Delete:   deleteVulnerabilityScanningContainerScanTarget,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createVulnerabilityScanningContainerScanTarget,
+		ReadContext:   readVulnerabilityScanningContainerScanTarget,
+		UpdateContext: updateVulnerabilityScanningContainerScanTarget,
+		DeleteContext: deleteVulnerabilityScanningContainerScanTarget,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -129,37 +130,37 @@ func VulnerabilityScanningContainerScanTargetResource() *schema.Resource {
 	}
 }
 
-func createVulnerabilityScanningContainerScanTarget(d *schema.ResourceData, m interface{}) error {
+func createVulnerabilityScanningContainerScanTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningContainerScanTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readVulnerabilityScanningContainerScanTarget(d *schema.ResourceData, m interface{}) error {
+func readVulnerabilityScanningContainerScanTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningContainerScanTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateVulnerabilityScanningContainerScanTarget(d *schema.ResourceData, m interface{}) error {
+func updateVulnerabilityScanningContainerScanTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningContainerScanTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteVulnerabilityScanningContainerScanTarget(d *schema.ResourceData, m interface{}) error {
+func deleteVulnerabilityScanningContainerScanTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningContainerScanTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type VulnerabilityScanningContainerScanTargetResourceCrud struct {
@@ -197,7 +198,7 @@ func (s *VulnerabilityScanningContainerScanTargetResourceCrud) DeletedTarget() [
 	}
 }
 
-func (s *VulnerabilityScanningContainerScanTargetResourceCrud) Create() error {
+func (s *VulnerabilityScanningContainerScanTargetResourceCrud) Create(ctx context.Context) error {
 	request := oci_vulnerability_scanning.CreateContainerScanTargetRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -245,20 +246,20 @@ func (s *VulnerabilityScanningContainerScanTargetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.CreateContainerScanTarget(context.Background(), request)
+	response, err := s.Client.CreateContainerScanTarget(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getContainerScanTargetFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning"), oci_vulnerability_scanning.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getContainerScanTargetFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning"), oci_vulnerability_scanning.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *VulnerabilityScanningContainerScanTargetResourceCrud) getContainerScanTargetFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *VulnerabilityScanningContainerScanTargetResourceCrud) getContainerScanTargetFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_vulnerability_scanning.ActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	containerScanTargetId, err := containerScanTargetWaitForWorkRequest(workId, "containerScanTarget",
+	containerScanTargetId, err := containerScanTargetWaitForWorkRequest(ctx, workId, "containerScanTarget",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -266,7 +267,7 @@ func (s *VulnerabilityScanningContainerScanTargetResourceCrud) getContainerScanT
 	}
 	s.D.SetId(*containerScanTargetId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func containerScanTargetWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -292,7 +293,7 @@ func containerScanTargetWorkRequestShouldRetryFunc(timeout time.Duration) func(r
 	}
 }
 
-func containerScanTargetWaitForWorkRequest(wId *string, entityType string, action oci_vulnerability_scanning.ActionTypeEnum,
+func containerScanTargetWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_vulnerability_scanning.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_vulnerability_scanning.VulnerabilityScanningClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "vulnerability_scanning")
 	retryPolicy.ShouldRetryOperation = containerScanTargetWorkRequestShouldRetryFunc(timeout)
@@ -309,7 +310,7 @@ func containerScanTargetWaitForWorkRequest(wId *string, entityType string, actio
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_vulnerability_scanning.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -338,14 +339,14 @@ func containerScanTargetWaitForWorkRequest(wId *string, entityType string, actio
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_vulnerability_scanning.OperationStatusFailed {
-		return nil, getErrorFromVulnerabilityScanningContainerScanTargetWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromVulnerabilityScanningContainerScanTargetWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromVulnerabilityScanningContainerScanTargetWorkRequest(client *oci_vulnerability_scanning.VulnerabilityScanningClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_vulnerability_scanning.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromVulnerabilityScanningContainerScanTargetWorkRequest(ctx context.Context, client *oci_vulnerability_scanning.VulnerabilityScanningClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_vulnerability_scanning.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_vulnerability_scanning.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -367,7 +368,7 @@ func getErrorFromVulnerabilityScanningContainerScanTargetWorkRequest(client *oci
 	return workRequestErr
 }
 
-func (s *VulnerabilityScanningContainerScanTargetResourceCrud) Get() error {
+func (s *VulnerabilityScanningContainerScanTargetResourceCrud) Get(ctx context.Context) error {
 	request := oci_vulnerability_scanning.GetContainerScanTargetRequest{}
 
 	tmp := s.D.Id()
@@ -375,7 +376,7 @@ func (s *VulnerabilityScanningContainerScanTargetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.GetContainerScanTarget(context.Background(), request)
+	response, err := s.Client.GetContainerScanTarget(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -384,11 +385,11 @@ func (s *VulnerabilityScanningContainerScanTargetResourceCrud) Get() error {
 	return nil
 }
 
-func (s *VulnerabilityScanningContainerScanTargetResourceCrud) Update() error {
+func (s *VulnerabilityScanningContainerScanTargetResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -439,16 +440,16 @@ func (s *VulnerabilityScanningContainerScanTargetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.UpdateContainerScanTarget(context.Background(), request)
+	response, err := s.Client.UpdateContainerScanTarget(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getContainerScanTargetFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning"), oci_vulnerability_scanning.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getContainerScanTargetFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning"), oci_vulnerability_scanning.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *VulnerabilityScanningContainerScanTargetResourceCrud) Delete() error {
+func (s *VulnerabilityScanningContainerScanTargetResourceCrud) Delete(ctx context.Context) error {
 	request := oci_vulnerability_scanning.DeleteContainerScanTargetRequest{}
 
 	tmp := s.D.Id()
@@ -456,14 +457,14 @@ func (s *VulnerabilityScanningContainerScanTargetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.DeleteContainerScanTarget(context.Background(), request)
+	response, err := s.Client.DeleteContainerScanTarget(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := containerScanTargetWaitForWorkRequest(workId, "containerScanTarget",
+	_, delWorkRequestErr := containerScanTargetWaitForWorkRequest(ctx, workId, "containerScanTarget",
 		oci_vulnerability_scanning.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -631,7 +632,7 @@ func ContainerScanRegistryToMap(obj *oci_vulnerability_scanning.ContainerScanReg
 	return result
 }
 
-func (s *VulnerabilityScanningContainerScanTargetResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *VulnerabilityScanningContainerScanTargetResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_vulnerability_scanning.ChangeContainerScanTargetCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -642,12 +643,12 @@ func (s *VulnerabilityScanningContainerScanTargetResourceCrud) updateCompartment
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	_, err := s.Client.ChangeContainerScanTargetCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeContainerScanTargetCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_vulnerability_scanning "github.com/oracle/oci-go-sdk/v65/vulnerabilityscanning"
 )
 
 func VulnerabilityScanningContainerScanTargetsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readVulnerabilityScanningContainerScanTargets,
+		ReadContext: readVulnerabilityScanningContainerScanTargets,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,12 +49,12 @@ func VulnerabilityScanningContainerScanTargetsDataSource() *schema.Resource {
 	}
 }
 
-func readVulnerabilityScanningContainerScanTargets(d *schema.ResourceData, m interface{}) error {
+func readVulnerabilityScanningContainerScanTargets(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningContainerScanTargetsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type VulnerabilityScanningContainerScanTargetsDataSourceCrud struct {
@@ -66,7 +67,7 @@ func (s *VulnerabilityScanningContainerScanTargetsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *VulnerabilityScanningContainerScanTargetsDataSourceCrud) Get() error {
+func (s *VulnerabilityScanningContainerScanTargetsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_vulnerability_scanning.ListContainerScanTargetsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -85,7 +86,7 @@ func (s *VulnerabilityScanningContainerScanTargetsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "vulnerability_scanning")
 
-	response, err := s.Client.ListContainerScanTargets(context.Background(), request)
+	response, err := s.Client.ListContainerScanTargets(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *VulnerabilityScanningContainerScanTargetsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListContainerScanTargets(context.Background(), request)
+		listResponse, err := s.Client.ListContainerScanTargets(ctx, request)
 		if err != nil {
 			return err
 		}
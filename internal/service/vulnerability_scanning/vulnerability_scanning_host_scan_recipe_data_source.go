@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_vulnerability_scanning "github.com/oracle/oci-go-sdk/v65/vulnerabilityscanning"
 )
@@ -22,12 +23,12 @@ func VulnerabilityScanningHostScanRecipeDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(VulnerabilityScanningHostScanRecipeResource(), fieldMap, readSingularVulnerabilityScanningHostScanRecipe)
 }
 
-func readSingularVulnerabilityScanningHostScanRecipe(d *schema.ResourceData, m interface{}) error {
+func readSingularVulnerabilityScanningHostScanRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningHostScanRecipeDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type VulnerabilityScanningHostScanRecipeDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *VulnerabilityScanningHostScanRecipeDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *VulnerabilityScanningHostScanRecipeDataSourceCrud) Get() error {
+func (s *VulnerabilityScanningHostScanRecipeDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_vulnerability_scanning.GetHostScanRecipeRequest{}
 
 	if hostScanRecipeId, ok := s.D.GetOkExists("host_scan_recipe_id"); ok {
@@ -50,7 +51,7 @@ func (s *VulnerabilityScanningHostScanRecipeDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "vulnerability_scanning")
 
-	response, err := s.Client.GetHostScanRecipe(context.Background(), request)
+	response, err := s.Client.GetHostScanRecipe(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,11 +29,11 @@ func VulnerabilityScanningHostScanRecipeResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createVulnerabilityScanningHostScanRecipe,
-		Read:     readVulnerabilityScanningHostScanRecipe,
-		Update:   updateVulnerabilityScanningHostScanRecipe,
-		Delete:   deleteVulnerabilityScanningHostScanRecipe,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createVulnerabilityScanningHostScanRecipe,
+		ReadContext:   readVulnerabilityScanningHostScanRecipe,
+		UpdateContext: updateVulnerabilityScanningHostScanRecipe,
+		DeleteContext: deleteVulnerabilityScanningHostScanRecipe,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"agent_settings": {
@@ -255,37 +256,37 @@ func VulnerabilityScanningHostScanRecipeResource() *schema.Resource {
 	}
 }
 
-func createVulnerabilityScanningHostScanRecipe(d *schema.ResourceData, m interface{}) error {
+func createVulnerabilityScanningHostScanRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningHostScanRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readVulnerabilityScanningHostScanRecipe(d *schema.ResourceData, m interface{}) error {
+func readVulnerabilityScanningHostScanRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningHostScanRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateVulnerabilityScanningHostScanRecipe(d *schema.ResourceData, m interface{}) error {
+func updateVulnerabilityScanningHostScanRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningHostScanRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteVulnerabilityScanningHostScanRecipe(d *schema.ResourceData, m interface{}) error {
+func deleteVulnerabilityScanningHostScanRecipe(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningHostScanRecipeResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type VulnerabilityScanningHostScanRecipeResourceCrud struct {
@@ -323,7 +324,7 @@ func (s *VulnerabilityScanningHostScanRecipeResourceCrud) DeletedTarget() []stri
 	}
 }
 
-func (s *VulnerabilityScanningHostScanRecipeResourceCrud) Create() error {
+func (s *VulnerabilityScanningHostScanRecipeResourceCrud) Create(ctx context.Context) error {
 	request := oci_vulnerability_scanning.CreateHostScanRecipeRequest{}
 
 	if agentSettings, ok := s.D.GetOkExists("agent_settings"); ok {
@@ -394,7 +395,7 @@ func (s *VulnerabilityScanningHostScanRecipeResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.CreateHostScanRecipe(context.Background(), request)
+	response, err := s.Client.CreateHostScanRecipe(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -403,7 +404,7 @@ func (s *VulnerabilityScanningHostScanRecipeResourceCrud) Create() error {
 	return nil
 }
 
-func (s *VulnerabilityScanningHostScanRecipeResourceCrud) Get() error {
+func (s *VulnerabilityScanningHostScanRecipeResourceCrud) Get(ctx context.Context) error {
 	request := oci_vulnerability_scanning.GetHostScanRecipeRequest{}
 
 	tmp := s.D.Id()
@@ -411,7 +412,7 @@ func (s *VulnerabilityScanningHostScanRecipeResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.GetHostScanRecipe(context.Background(), request)
+	response, err := s.Client.GetHostScanRecipe(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -420,11 +421,11 @@ func (s *VulnerabilityScanningHostScanRecipeResourceCrud) Get() error {
 	return nil
 }
 
-func (s *VulnerabilityScanningHostScanRecipeResourceCrud) Update() error {
+func (s *VulnerabilityScanningHostScanRecipeResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -498,18 +499,18 @@ func (s *VulnerabilityScanningHostScanRecipeResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.UpdateHostScanRecipe(context.Background(), request)
+	response, err := s.Client.UpdateHostScanRecipe(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getHostScanRecipeFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning"), oci_vulnerability_scanning.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getHostScanRecipeFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning"), oci_vulnerability_scanning.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *VulnerabilityScanningHostScanRecipeResourceCrud) getHostScanRecipeFromWorkRequest(workId *string, policy *common.RetryPolicy, actionTypeEnum oci_vulnerability_scanning.ActionTypeEnum, timeout time.Duration) error {
+func (s *VulnerabilityScanningHostScanRecipeResourceCrud) getHostScanRecipeFromWorkRequest(ctx context.Context, workId *string, policy *common.RetryPolicy, actionTypeEnum oci_vulnerability_scanning.ActionTypeEnum, timeout time.Duration) error {
 	// Wait until it finishes
-	vssHostScanRecipeId, err := hostScanRecipeWaitForWorkRequest(workId, "hostScanRecipe",
+	vssHostScanRecipeId, err := hostScanRecipeWaitForWorkRequest(ctx, workId, "hostScanRecipe",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -524,10 +525,10 @@ func (s *VulnerabilityScanningHostScanRecipeResourceCrud) getHostScanRecipeFromW
 
 	s.D.SetId(*vssHostScanRecipeId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func hostScanRecipeWaitForWorkRequest(wId *string, entityType string, action oci_vulnerability_scanning.ActionTypeEnum,
+func hostScanRecipeWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_vulnerability_scanning.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_vulnerability_scanning.VulnerabilityScanningClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "vulnerability_scanning")
 	retryPolicy.ShouldRetryOperation = hostScanWorkRequestShouldRetryFunc(timeout)
@@ -544,7 +545,7 @@ func hostScanRecipeWaitForWorkRequest(wId *string, entityType string, action oci
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_vulnerability_scanning.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -572,7 +573,7 @@ func hostScanRecipeWaitForWorkRequest(wId *string, entityType string, action oci
 	}
 
 	if identifier == nil || response.Status == oci_vulnerability_scanning.OperationStatusFailed {
-		return nil, getErrorFromHostScanRecipeWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromHostScanRecipeWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
@@ -601,8 +602,8 @@ func hostScanWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci
 	}
 }
 
-func getErrorFromHostScanRecipeWorkRequest(client *oci_vulnerability_scanning.VulnerabilityScanningClient, wId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_vulnerability_scanning.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromHostScanRecipeWorkRequest(ctx context.Context, client *oci_vulnerability_scanning.VulnerabilityScanningClient, wId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_vulnerability_scanning.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_vulnerability_scanning.ListWorkRequestErrorsRequest{
 			WorkRequestId: wId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -624,7 +625,7 @@ func getErrorFromHostScanRecipeWorkRequest(client *oci_vulnerability_scanning.Vu
 	return workRequestErr
 }
 
-func (s *VulnerabilityScanningHostScanRecipeResourceCrud) Delete() error {
+func (s *VulnerabilityScanningHostScanRecipeResourceCrud) Delete(ctx context.Context) error {
 	request := oci_vulnerability_scanning.DeleteHostScanRecipeRequest{}
 
 	tmp := s.D.Id()
@@ -632,12 +633,12 @@ func (s *VulnerabilityScanningHostScanRecipeResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.DeleteHostScanRecipe(context.Background(), request)
+	response, err := s.Client.DeleteHostScanRecipe(ctx, request)
 	if err != nil {
 		return err
 	}
 	workId := response.OpcWorkRequestId
-	_, delWorkRequestErr := hostScanRecipeWaitForWorkRequest(workId, "hostScanRecipe", oci_vulnerability_scanning.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
+	_, delWorkRequestErr := hostScanRecipeWaitForWorkRequest(ctx, workId, "hostScanRecipe", oci_vulnerability_scanning.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
 
@@ -991,7 +992,7 @@ func ScheduleToMap(obj *oci_vulnerability_scanning.Schedule) map[string]interfac
 	return result
 }
 
-func (s *VulnerabilityScanningHostScanRecipeResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *VulnerabilityScanningHostScanRecipeResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_vulnerability_scanning.ChangeHostScanRecipeCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -1002,12 +1003,12 @@ func (s *VulnerabilityScanningHostScanRecipeResourceCrud) updateCompartment(comp
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	_, err := s.Client.ChangeHostScanRecipeCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeHostScanRecipeCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
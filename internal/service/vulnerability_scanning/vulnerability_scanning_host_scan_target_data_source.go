@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_vulnerability_scanning "github.com/oracle/oci-go-sdk/v65/vulnerabilityscanning"
 )
@@ -22,12 +23,12 @@ func VulnerabilityScanningHostScanTargetDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(VulnerabilityScanningHostScanTargetResource(), fieldMap, readSingularVulnerabilityScanningHostScanTarget)
 }
 
-func readSingularVulnerabilityScanningHostScanTarget(d *schema.ResourceData, m interface{}) error {
+func readSingularVulnerabilityScanningHostScanTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningHostScanTargetDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type VulnerabilityScanningHostScanTargetDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *VulnerabilityScanningHostScanTargetDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *VulnerabilityScanningHostScanTargetDataSourceCrud) Get() error {
+func (s *VulnerabilityScanningHostScanTargetDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_vulnerability_scanning.GetHostScanTargetRequest{}
 
 	if hostScanTargetId, ok := s.D.GetOkExists("host_scan_target_id"); ok {
@@ -50,7 +51,7 @@ func (s *VulnerabilityScanningHostScanTargetDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "vulnerability_scanning")
 
-	response, err := s.Client.GetHostScanTarget(context.Background(), request)
+	response, err := s.Client.GetHostScanTarget(ctx, request)
 	if err != nil {
 		return err
 	}
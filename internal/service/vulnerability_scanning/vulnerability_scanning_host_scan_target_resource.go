@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 
@@ -26,11 +27,11 @@ func VulnerabilityScanningHostScanTargetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createVulnerabilityScanningHostScanTarget,
-		Read:     readVulnerabilityScanningHostScanTarget,
-		Update:   updateVulnerabilityScanningHostScanTarget,
-		Delete:   deleteVulnerabilityScanningHostScanTarget,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createVulnerabilityScanningHostScanTarget,
+		ReadContext:   readVulnerabilityScanningHostScanTarget,
+		UpdateContext: updateVulnerabilityScanningHostScanTarget,
+		DeleteContext: deleteVulnerabilityScanningHostScanTarget,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -101,37 +102,37 @@ func VulnerabilityScanningHostScanTargetResource() *schema.Resource {
 	}
 }
 
-func createVulnerabilityScanningHostScanTarget(d *schema.ResourceData, m interface{}) error {
+func createVulnerabilityScanningHostScanTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningHostScanTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readVulnerabilityScanningHostScanTarget(d *schema.ResourceData, m interface{}) error {
+func readVulnerabilityScanningHostScanTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningHostScanTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateVulnerabilityScanningHostScanTarget(d *schema.ResourceData, m interface{}) error {
+func updateVulnerabilityScanningHostScanTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningHostScanTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteVulnerabilityScanningHostScanTarget(d *schema.ResourceData, m interface{}) error {
+func deleteVulnerabilityScanningHostScanTarget(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningHostScanTargetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type VulnerabilityScanningHostScanTargetResourceCrud struct {
@@ -169,7 +170,7 @@ func (s *VulnerabilityScanningHostScanTargetResourceCrud) DeletedTarget() []stri
 	}
 }
 
-func (s *VulnerabilityScanningHostScanTargetResourceCrud) Create() error {
+func (s *VulnerabilityScanningHostScanTargetResourceCrud) Create(ctx context.Context) error {
 	request := oci_vulnerability_scanning.CreateHostScanTargetRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -224,7 +225,7 @@ func (s *VulnerabilityScanningHostScanTargetResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.CreateHostScanTarget(context.Background(), request)
+	response, err := s.Client.CreateHostScanTarget(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -233,7 +234,7 @@ func (s *VulnerabilityScanningHostScanTargetResourceCrud) Create() error {
 	return nil
 }
 
-func (s *VulnerabilityScanningHostScanTargetResourceCrud) Get() error {
+func (s *VulnerabilityScanningHostScanTargetResourceCrud) Get(ctx context.Context) error {
 	request := oci_vulnerability_scanning.GetHostScanTargetRequest{}
 
 	tmp := s.D.Id()
@@ -241,7 +242,7 @@ func (s *VulnerabilityScanningHostScanTargetResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.GetHostScanTarget(context.Background(), request)
+	response, err := s.Client.GetHostScanTarget(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -250,11 +251,11 @@ func (s *VulnerabilityScanningHostScanTargetResourceCrud) Get() error {
 	return nil
 }
 
-func (s *VulnerabilityScanningHostScanTargetResourceCrud) Update() error {
+func (s *VulnerabilityScanningHostScanTargetResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -312,18 +313,18 @@ func (s *VulnerabilityScanningHostScanTargetResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.UpdateHostScanTarget(context.Background(), request)
+	response, err := s.Client.UpdateHostScanTarget(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getHostScanTargetFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning"), oci_vulnerability_scanning.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getHostScanTargetFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning"), oci_vulnerability_scanning.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *VulnerabilityScanningHostScanTargetResourceCrud) getHostScanTargetFromWorkRequest(workId *string, policy *oci_common.RetryPolicy, actionTypeEnum oci_vulnerability_scanning.ActionTypeEnum, timeout time.Duration) error {
+func (s *VulnerabilityScanningHostScanTargetResourceCrud) getHostScanTargetFromWorkRequest(ctx context.Context, workId *string, policy *oci_common.RetryPolicy, actionTypeEnum oci_vulnerability_scanning.ActionTypeEnum, timeout time.Duration) error {
 	// Wait until it finishes
-	vssHostScanTargetId, err := hostScanRecipeWaitForWorkRequest(workId, "hostScanTarget",
+	vssHostScanTargetId, err := hostScanRecipeWaitForWorkRequest(ctx, workId, "hostScanTarget",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -338,10 +339,10 @@ func (s *VulnerabilityScanningHostScanTargetResourceCrud) getHostScanTargetFromW
 
 	s.D.SetId(*vssHostScanTargetId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
-func hostScanTargetWaitForWorkRequest(wId *string, entityType string, action oci_vulnerability_scanning.ActionTypeEnum,
+func hostScanTargetWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_vulnerability_scanning.ActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_vulnerability_scanning.VulnerabilityScanningClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "vulnerability_scanning")
 	retryPolicy.ShouldRetryOperation = hostScanWorkRequestShouldRetryFunc(timeout)
@@ -358,7 +359,7 @@ func hostScanTargetWaitForWorkRequest(wId *string, entityType string, action oci
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_vulnerability_scanning.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -386,14 +387,14 @@ func hostScanTargetWaitForWorkRequest(wId *string, entityType string, action oci
 	}
 
 	if identifier == nil || response.Status == oci_vulnerability_scanning.OperationStatusFailed {
-		return nil, getErrorFromHostScanTargetWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromHostScanTargetWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromHostScanTargetWorkRequest(client *oci_vulnerability_scanning.VulnerabilityScanningClient, wId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_vulnerability_scanning.ActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromHostScanTargetWorkRequest(ctx context.Context, client *oci_vulnerability_scanning.VulnerabilityScanningClient, wId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_vulnerability_scanning.ActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_vulnerability_scanning.ListWorkRequestErrorsRequest{
 			WorkRequestId: wId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -415,7 +416,7 @@ func getErrorFromHostScanTargetWorkRequest(client *oci_vulnerability_scanning.Vu
 	return workRequestErr
 }
 
-func (s *VulnerabilityScanningHostScanTargetResourceCrud) Delete() error {
+func (s *VulnerabilityScanningHostScanTargetResourceCrud) Delete(ctx context.Context) error {
 	request := oci_vulnerability_scanning.DeleteHostScanTargetRequest{}
 
 	tmp := s.D.Id()
@@ -423,13 +424,13 @@ func (s *VulnerabilityScanningHostScanTargetResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	response, err := s.Client.DeleteHostScanTarget(context.Background(), request)
+	response, err := s.Client.DeleteHostScanTarget(ctx, request)
 	if err != nil {
 		return err
 	}
 	workId := response.OpcWorkRequestId
 
-	_, delWorkRequestErr := hostScanTargetWaitForWorkRequest(workId, "hostScanTarget", oci_vulnerability_scanning.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
+	_, delWorkRequestErr := hostScanTargetWaitForWorkRequest(ctx, workId, "hostScanTarget", oci_vulnerability_scanning.ActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
 
@@ -527,7 +528,7 @@ func HostScanTargetSummaryToMap(obj oci_vulnerability_scanning.HostScanTargetSum
 	return result
 }
 
-func (s *VulnerabilityScanningHostScanTargetResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *VulnerabilityScanningHostScanTargetResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_vulnerability_scanning.ChangeHostScanTargetCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -538,12 +539,12 @@ func (s *VulnerabilityScanningHostScanTargetResourceCrud) updateCompartment(comp
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "vulnerability_scanning")
 
-	_, err := s.Client.ChangeHostScanTargetCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeHostScanTargetCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
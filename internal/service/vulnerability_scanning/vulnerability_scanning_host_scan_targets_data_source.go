@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_vulnerability_scanning "github.com/oracle/oci-go-sdk/v65/vulnerabilityscanning"
 )
 
 func VulnerabilityScanningHostScanTargetsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readVulnerabilityScanningHostScanTargets,
+		ReadContext: readVulnerabilityScanningHostScanTargets,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -48,12 +49,12 @@ func VulnerabilityScanningHostScanTargetsDataSource() *schema.Resource {
 	}
 }
 
-func readVulnerabilityScanningHostScanTargets(d *schema.ResourceData, m interface{}) error {
+func readVulnerabilityScanningHostScanTargets(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &VulnerabilityScanningHostScanTargetsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type VulnerabilityScanningHostScanTargetsDataSourceCrud struct {
@@ -66,7 +67,7 @@ func (s *VulnerabilityScanningHostScanTargetsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *VulnerabilityScanningHostScanTargetsDataSourceCrud) Get() error {
+func (s *VulnerabilityScanningHostScanTargetsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_vulnerability_scanning.ListHostScanTargetsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -85,7 +86,7 @@ func (s *VulnerabilityScanningHostScanTargetsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "vulnerability_scanning")
 
-	response, err := s.Client.ListHostScanTargets(context.Background(), request)
+	response, err := s.Client.ListHostScanTargets(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -94,7 +95,7 @@ func (s *VulnerabilityScanningHostScanTargetsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListHostScanTargets(context.Background(), request)
+		listResponse, err := s.Client.ListHostScanTargets(ctx, request)
 		if err != nil {
 			return err
 		}
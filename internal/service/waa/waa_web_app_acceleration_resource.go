@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func WaaWebAppAccelerationResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createWaaWebAppAcceleration,
-		Read:     readWaaWebAppAcceleration,
-		Update:   updateWaaWebAppAcceleration,
-		Delete:   deleteWaaWebAppAcceleration,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createWaaWebAppAcceleration,
+		ReadContext:   readWaaWebAppAcceleration,
+		UpdateContext: updateWaaWebAppAcceleration,
+		DeleteContext: deleteWaaWebAppAcceleration,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"backend_type": {
@@ -103,40 +104,40 @@ func WaaWebAppAccelerationResource() *schema.Resource {
 	}
 }
 
-func createWaaWebAppAcceleration(d *schema.ResourceData, m interface{}) error {
+func createWaaWebAppAcceleration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaaWebAppAccelerationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaaClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WaaWorkRequestClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readWaaWebAppAcceleration(d *schema.ResourceData, m interface{}) error {
+func readWaaWebAppAcceleration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaaWebAppAccelerationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaaClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateWaaWebAppAcceleration(d *schema.ResourceData, m interface{}) error {
+func updateWaaWebAppAcceleration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaaWebAppAccelerationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaaClient()
 	sync.WorkRequestClient = m.(*client.OracleClients).WaaWorkRequestClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteWaaWebAppAcceleration(d *schema.ResourceData, m interface{}) error {
+func deleteWaaWebAppAcceleration(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaaWebAppAccelerationResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaaClient()
 	sync.DisableNotFoundRetries = true
 	sync.WorkRequestClient = m.(*client.OracleClients).WaaWorkRequestClient()
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type WaaWebAppAccelerationResourceCrud struct {
@@ -176,7 +177,7 @@ func (s *WaaWebAppAccelerationResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *WaaWebAppAccelerationResourceCrud) Create() error {
+func (s *WaaWebAppAccelerationResourceCrud) Create(ctx context.Context) error {
 	request := oci_waa.CreateWebAppAccelerationRequest{}
 	err := s.populateTopLevelPolymorphicCreateWebAppAccelerationRequest(&request)
 	if err != nil {
@@ -185,20 +186,20 @@ func (s *WaaWebAppAccelerationResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waa")
 
-	response, err := s.Client.CreateWebAppAcceleration(context.Background(), request)
+	response, err := s.Client.CreateWebAppAcceleration(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getWebAppAccelerationFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waa"), oci_waa.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getWebAppAccelerationFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waa"), oci_waa.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *WaaWebAppAccelerationResourceCrud) getWebAppAccelerationFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *WaaWebAppAccelerationResourceCrud) getWebAppAccelerationFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_waa.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	webAppAccelerationId, err := webAppAccelerationWaitForWorkRequest(workId, "webappacceleration",
+	webAppAccelerationId, err := webAppAccelerationWaitForWorkRequest(ctx, workId, "webappacceleration",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.WorkRequestClient)
 
 	if err != nil {
@@ -206,7 +207,7 @@ func (s *WaaWebAppAccelerationResourceCrud) getWebAppAccelerationFromWorkRequest
 	}
 	s.D.SetId(*webAppAccelerationId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func webAppAccelerationWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -232,7 +233,7 @@ func webAppAccelerationWorkRequestShouldRetryFunc(timeout time.Duration) func(re
 	}
 }
 
-func webAppAccelerationWaitForWorkRequest(wId *string, entityType string, action oci_waa.WorkRequestResourceActionTypeEnum,
+func webAppAccelerationWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_waa.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_waa.WorkRequestClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "waa")
 	retryPolicy.ShouldRetryOperation = webAppAccelerationWorkRequestShouldRetryFunc(timeout)
@@ -251,7 +252,7 @@ func webAppAccelerationWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_waa.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -280,14 +281,14 @@ func webAppAccelerationWaitForWorkRequest(wId *string, entityType string, action
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_waa.WorkRequestStatusFailed || response.Status == oci_waa.WorkRequestStatusCanceled {
-		return nil, getErrorFromWaaWebAppAccelerationWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromWaaWebAppAccelerationWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromWaaWebAppAccelerationWorkRequest(client *oci_waa.WorkRequestClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_waa.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromWaaWebAppAccelerationWorkRequest(ctx context.Context, client *oci_waa.WorkRequestClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_waa.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_waa.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -309,7 +310,7 @@ func getErrorFromWaaWebAppAccelerationWorkRequest(client *oci_waa.WorkRequestCli
 	return workRequestErr
 }
 
-func (s *WaaWebAppAccelerationResourceCrud) Get() error {
+func (s *WaaWebAppAccelerationResourceCrud) Get(ctx context.Context) error {
 	request := oci_waa.GetWebAppAccelerationRequest{}
 
 	tmp := s.D.Id()
@@ -317,7 +318,7 @@ func (s *WaaWebAppAccelerationResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waa")
 
-	response, err := s.Client.GetWebAppAcceleration(context.Background(), request)
+	response, err := s.Client.GetWebAppAcceleration(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -326,11 +327,11 @@ func (s *WaaWebAppAccelerationResourceCrud) Get() error {
 	return nil
 }
 
-func (s *WaaWebAppAccelerationResourceCrud) Update() error {
+func (s *WaaWebAppAccelerationResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -373,16 +374,16 @@ func (s *WaaWebAppAccelerationResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waa")
 
-	response, err := s.Client.UpdateWebAppAcceleration(context.Background(), request)
+	response, err := s.Client.UpdateWebAppAcceleration(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getWebAppAccelerationFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waa"), oci_waa.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getWebAppAccelerationFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waa"), oci_waa.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *WaaWebAppAccelerationResourceCrud) Delete() error {
+func (s *WaaWebAppAccelerationResourceCrud) Delete(ctx context.Context) error {
 	request := oci_waa.DeleteWebAppAccelerationRequest{}
 
 	tmp := s.D.Id()
@@ -390,14 +391,14 @@ func (s *WaaWebAppAccelerationResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waa")
 
-	response, err := s.Client.DeleteWebAppAcceleration(context.Background(), request)
+	response, err := s.Client.DeleteWebAppAcceleration(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := webAppAccelerationWaitForWorkRequest(workId, "webappacceleration",
+	_, delWorkRequestErr := webAppAccelerationWaitForWorkRequest(ctx, workId, "webappacceleration",
 		oci_waa.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.WorkRequestClient)
 	return delWorkRequestErr
 }
@@ -562,7 +563,7 @@ func (s *WaaWebAppAccelerationResourceCrud) populateTopLevelPolymorphicCreateWeb
 	return nil
 }
 
-func (s *WaaWebAppAccelerationResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *WaaWebAppAccelerationResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_waa.ChangeWebAppAccelerationCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -573,11 +574,11 @@ func (s *WaaWebAppAccelerationResourceCrud) updateCompartment(compartment interf
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waa")
 
-	response, err := s.Client.ChangeWebAppAccelerationCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeWebAppAccelerationCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getWebAppAccelerationFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waa"), oci_waa.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getWebAppAccelerationFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waa"), oci_waa.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -6,6 +6,7 @@ package waa
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_waa "github.com/oracle/oci-go-sdk/v65/waa"
 
@@ -15,7 +16,7 @@ import (
 
 func WaaWebAppAccelerationsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readWaaWebAppAccelerations,
+		ReadContext: readWaaWebAppAccelerations,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -59,12 +60,12 @@ func WaaWebAppAccelerationsDataSource() *schema.Resource {
 	}
 }
 
-func readWaaWebAppAccelerations(d *schema.ResourceData, m interface{}) error {
+func readWaaWebAppAccelerations(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaaWebAppAccelerationsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaaClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type WaaWebAppAccelerationsDataSourceCrud struct {
@@ -77,7 +78,7 @@ func (s *WaaWebAppAccelerationsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *WaaWebAppAccelerationsDataSourceCrud) Get() error {
+func (s *WaaWebAppAccelerationsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_waa.ListWebAppAccelerationsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -118,7 +119,7 @@ func (s *WaaWebAppAccelerationsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waa")
 
-	response, err := s.Client.ListWebAppAccelerations(context.Background(), request)
+	response, err := s.Client.ListWebAppAccelerations(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -127,7 +128,7 @@ func (s *WaaWebAppAccelerationsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListWebAppAccelerations(context.Background(), request)
+		listResponse, err := s.Client.ListWebAppAccelerations(ctx, request)
 		if err != nil {
 			return err
 		}
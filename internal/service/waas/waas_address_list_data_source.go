@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_waas "github.com/oracle/oci-go-sdk/v65/waas"
 )
@@ -22,12 +23,12 @@ func WaasAddressListDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(WaasAddressListResource(), fieldMap, readSingularWaasAddressList)
 }
 
-func readSingularWaasAddressList(d *schema.ResourceData, m interface{}) error {
+func readSingularWaasAddressList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasAddressListDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type WaasAddressListDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *WaasAddressListDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *WaasAddressListDataSourceCrud) Get() error {
+func (s *WaasAddressListDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_waas.GetAddressListRequest{}
 
 	if addressListId, ok := s.D.GetOkExists("address_list_id"); ok {
@@ -50,7 +51,7 @@ func (s *WaasAddressListDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waas")
 
-	response, err := s.Client.GetAddressList(context.Background(), request)
+	response, err := s.Client.GetAddressList(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_waas "github.com/oracle/oci-go-sdk/v65/waas"
@@ -19,11 +20,11 @@ func WaasAddressListResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createWaasAddressList,
-		Read:     readWaasAddressList,
-		Update:   updateWaasAddressList,
-		Delete:   deleteWaasAddressList,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createWaasAddressList,
+		ReadContext:   readWaasAddressList,
+		UpdateContext: updateWaasAddressList,
+		DeleteContext: deleteWaasAddressList,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"addresses": {
@@ -74,37 +75,37 @@ func WaasAddressListResource() *schema.Resource {
 	}
 }
 
-func createWaasAddressList(d *schema.ResourceData, m interface{}) error {
+func createWaasAddressList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasAddressListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readWaasAddressList(d *schema.ResourceData, m interface{}) error {
+func readWaasAddressList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasAddressListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateWaasAddressList(d *schema.ResourceData, m interface{}) error {
+func updateWaasAddressList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasAddressListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteWaasAddressList(d *schema.ResourceData, m interface{}) error {
+func deleteWaasAddressList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasAddressListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type WaasAddressListResourceCrud struct {
@@ -142,7 +143,7 @@ func (s *WaasAddressListResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *WaasAddressListResourceCrud) Create() error {
+func (s *WaasAddressListResourceCrud) Create(ctx context.Context) error {
 	request := oci_waas.CreateAddressListRequest{}
 
 	if addresses, ok := s.D.GetOkExists("addresses"); ok {
@@ -182,7 +183,7 @@ func (s *WaasAddressListResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.CreateAddressList(context.Background(), request)
+	response, err := s.Client.CreateAddressList(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -191,7 +192,7 @@ func (s *WaasAddressListResourceCrud) Create() error {
 	return nil
 }
 
-func (s *WaasAddressListResourceCrud) Get() error {
+func (s *WaasAddressListResourceCrud) Get(ctx context.Context) error {
 	request := oci_waas.GetAddressListRequest{}
 
 	tmp := s.D.Id()
@@ -199,7 +200,7 @@ func (s *WaasAddressListResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.GetAddressList(context.Background(), request)
+	response, err := s.Client.GetAddressList(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -208,11 +209,11 @@ func (s *WaasAddressListResourceCrud) Get() error {
 	return nil
 }
 
-func (s *WaasAddressListResourceCrud) Update() error {
+func (s *WaasAddressListResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -255,7 +256,7 @@ func (s *WaasAddressListResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.UpdateAddressList(context.Background(), request)
+	response, err := s.Client.UpdateAddressList(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -264,7 +265,7 @@ func (s *WaasAddressListResourceCrud) Update() error {
 	return nil
 }
 
-func (s *WaasAddressListResourceCrud) Delete() error {
+func (s *WaasAddressListResourceCrud) Delete(ctx context.Context) error {
 	request := oci_waas.DeleteAddressListRequest{}
 
 	tmp := s.D.Id()
@@ -272,7 +273,7 @@ func (s *WaasAddressListResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	_, err := s.Client.DeleteAddressList(context.Background(), request)
+	_, err := s.Client.DeleteAddressList(ctx, request)
 	return err
 }
 
@@ -306,7 +307,7 @@ func (s *WaasAddressListResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *WaasAddressListResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *WaasAddressListResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_waas.ChangeAddressListCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -317,12 +318,12 @@ func (s *WaasAddressListResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	_, err := s.Client.ChangeAddressListCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeAddressListCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_waas "github.com/oracle/oci-go-sdk/v65/waas"
 )
@@ -22,12 +23,12 @@ func WaasCertificateDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(WaasCertificateResource(), fieldMap, readSingularWaasCertificate)
 }
 
-func readSingularWaasCertificate(d *schema.ResourceData, m interface{}) error {
+func readSingularWaasCertificate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasCertificateDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type WaasCertificateDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *WaasCertificateDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *WaasCertificateDataSourceCrud) Get() error {
+func (s *WaasCertificateDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_waas.GetCertificateRequest{}
 
 	if certificateId, ok := s.D.GetOkExists("certificate_id"); ok {
@@ -50,7 +51,7 @@ func (s *WaasCertificateDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waas")
 
-	response, err := s.Client.GetCertificate(context.Background(), request)
+	response, err := s.Client.GetCertificate(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_waas "github.com/oracle/oci-go-sdk/v65/waas"
@@ -22,11 +23,11 @@ const (
 
 func WaasCertificateResource() *schema.Resource {
 	return &schema.Resource{
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createWaasCertificate,
-		Read:     readWaasCertificate,
-		Update:   updateWaasCertificate,
-		Delete:   deleteWaasCertificate,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createWaasCertificate,
+		ReadContext:   readWaasCertificate,
+		UpdateContext: updateWaasCertificate,
+		DeleteContext: deleteWaasCertificate,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"certificate_data": {
@@ -240,37 +241,37 @@ func WaasCertificateResource() *schema.Resource {
 	}
 }
 
-func createWaasCertificate(d *schema.ResourceData, m interface{}) error {
+func createWaasCertificate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasCertificateResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readWaasCertificate(d *schema.ResourceData, m interface{}) error {
+func readWaasCertificate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasCertificateResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateWaasCertificate(d *schema.ResourceData, m interface{}) error {
+func updateWaasCertificate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasCertificateResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteWaasCertificate(d *schema.ResourceData, m interface{}) error {
+func deleteWaasCertificate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasCertificateResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type WaasCertificateResourceCrud struct {
@@ -308,7 +309,7 @@ func (s *WaasCertificateResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *WaasCertificateResourceCrud) Create() error {
+func (s *WaasCertificateResourceCrud) Create(ctx context.Context) error {
 	request := oci_waas.CreateCertificateRequest{}
 
 	if certificateData, ok := s.D.GetOkExists("certificate_data"); ok {
@@ -350,7 +351,7 @@ func (s *WaasCertificateResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.CreateCertificate(context.Background(), request)
+	response, err := s.Client.CreateCertificate(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -359,7 +360,7 @@ func (s *WaasCertificateResourceCrud) Create() error {
 	return nil
 }
 
-func (s *WaasCertificateResourceCrud) Get() error {
+func (s *WaasCertificateResourceCrud) Get(ctx context.Context) error {
 	request := oci_waas.GetCertificateRequest{}
 
 	tmp := s.D.Id()
@@ -367,7 +368,7 @@ func (s *WaasCertificateResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.GetCertificate(context.Background(), request)
+	response, err := s.Client.GetCertificate(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -376,11 +377,11 @@ func (s *WaasCertificateResourceCrud) Get() error {
 	return nil
 }
 
-func (s *WaasCertificateResourceCrud) Update() error {
+func (s *WaasCertificateResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -410,7 +411,7 @@ func (s *WaasCertificateResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.UpdateCertificate(context.Background(), request)
+	response, err := s.Client.UpdateCertificate(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -419,14 +420,14 @@ func (s *WaasCertificateResourceCrud) Update() error {
 	return nil
 }
 
-func (s *WaasCertificateResourceCrud) Delete() error {
+func (s *WaasCertificateResourceCrud) Delete(ctx context.Context) error {
 	request := oci_waas.DeleteCertificateRequest{}
 
 	tmp := s.D.Id()
 	request.CertificateId = &tmp
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, tfresource.WaasService, certificateService, globalvar.DeleteResource)
 
-	_, err := s.Client.DeleteCertificate(context.Background(), request)
+	_, err := s.Client.DeleteCertificate(ctx, request)
 	return err
 }
 
@@ -614,7 +615,7 @@ func CertificateSubjectNameToMap(obj *oci_waas.CertificateSubjectName) map[strin
 	return result
 }
 
-func (s *WaasCertificateResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *WaasCertificateResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_waas.ChangeCertificateCompartmentRequest{}
 
 	idTmp := s.D.Id()
@@ -625,12 +626,12 @@ func (s *WaasCertificateResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	_, err := s.Client.ChangeCertificateCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeCertificateCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
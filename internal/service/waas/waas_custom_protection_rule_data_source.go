@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_waas "github.com/oracle/oci-go-sdk/v65/waas"
 )
@@ -22,12 +23,12 @@ func WaasCustomProtectionRuleDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(WaasCustomProtectionRuleResource(), fieldMap, readSingularWaasCustomProtectionRule)
 }
 
-func readSingularWaasCustomProtectionRule(d *schema.ResourceData, m interface{}) error {
+func readSingularWaasCustomProtectionRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasCustomProtectionRuleDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type WaasCustomProtectionRuleDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *WaasCustomProtectionRuleDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *WaasCustomProtectionRuleDataSourceCrud) Get() error {
+func (s *WaasCustomProtectionRuleDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_waas.GetCustomProtectionRuleRequest{}
 
 	if customProtectionRuleId, ok := s.D.GetOkExists("custom_protection_rule_id"); ok {
@@ -50,7 +51,7 @@ func (s *WaasCustomProtectionRuleDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waas")
 
-	response, err := s.Client.GetCustomProtectionRule(context.Background(), request)
+	response, err := s.Client.GetCustomProtectionRule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_waas "github.com/oracle/oci-go-sdk/v65/waas"
@@ -19,11 +20,11 @@ func WaasCustomProtectionRuleResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createWaasCustomProtectionRule,
-		Read:     readWaasCustomProtectionRule,
-		Update:   updateWaasCustomProtectionRule,
-		Delete:   deleteWaasCustomProtectionRule,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createWaasCustomProtectionRule,
+		ReadContext:   readWaasCustomProtectionRule,
+		UpdateContext: updateWaasCustomProtectionRule,
+		DeleteContext: deleteWaasCustomProtectionRule,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -79,37 +80,37 @@ func WaasCustomProtectionRuleResource() *schema.Resource {
 	}
 }
 
-func createWaasCustomProtectionRule(d *schema.ResourceData, m interface{}) error {
+func createWaasCustomProtectionRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasCustomProtectionRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readWaasCustomProtectionRule(d *schema.ResourceData, m interface{}) error {
+func readWaasCustomProtectionRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasCustomProtectionRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateWaasCustomProtectionRule(d *schema.ResourceData, m interface{}) error {
+func updateWaasCustomProtectionRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasCustomProtectionRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteWaasCustomProtectionRule(d *schema.ResourceData, m interface{}) error {
+func deleteWaasCustomProtectionRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasCustomProtectionRuleResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type WaasCustomProtectionRuleResourceCrud struct {
@@ -147,7 +148,7 @@ func (s *WaasCustomProtectionRuleResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *WaasCustomProtectionRuleResourceCrud) Create() error {
+func (s *WaasCustomProtectionRuleResourceCrud) Create(ctx context.Context) error {
 	request := oci_waas.CreateCustomProtectionRuleRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -184,7 +185,7 @@ func (s *WaasCustomProtectionRuleResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.CreateCustomProtectionRule(context.Background(), request)
+	response, err := s.Client.CreateCustomProtectionRule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -193,7 +194,7 @@ func (s *WaasCustomProtectionRuleResourceCrud) Create() error {
 	return nil
 }
 
-func (s *WaasCustomProtectionRuleResourceCrud) Get() error {
+func (s *WaasCustomProtectionRuleResourceCrud) Get(ctx context.Context) error {
 	request := oci_waas.GetCustomProtectionRuleRequest{}
 
 	tmp := s.D.Id()
@@ -201,7 +202,7 @@ func (s *WaasCustomProtectionRuleResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.GetCustomProtectionRule(context.Background(), request)
+	response, err := s.Client.GetCustomProtectionRule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -210,11 +211,11 @@ func (s *WaasCustomProtectionRuleResourceCrud) Get() error {
 	return nil
 }
 
-func (s *WaasCustomProtectionRuleResourceCrud) Update() error {
+func (s *WaasCustomProtectionRuleResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -254,7 +255,7 @@ func (s *WaasCustomProtectionRuleResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.UpdateCustomProtectionRule(context.Background(), request)
+	response, err := s.Client.UpdateCustomProtectionRule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -263,7 +264,7 @@ func (s *WaasCustomProtectionRuleResourceCrud) Update() error {
 	return nil
 }
 
-func (s *WaasCustomProtectionRuleResourceCrud) Delete() error {
+func (s *WaasCustomProtectionRuleResourceCrud) Delete(ctx context.Context) error {
 	request := oci_waas.DeleteCustomProtectionRuleRequest{}
 
 	tmp := s.D.Id()
@@ -271,7 +272,7 @@ func (s *WaasCustomProtectionRuleResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	_, err := s.Client.DeleteCustomProtectionRule(context.Background(), request)
+	_, err := s.Client.DeleteCustomProtectionRule(ctx, request)
 	return err
 }
 
@@ -309,7 +310,7 @@ func (s *WaasCustomProtectionRuleResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *WaasCustomProtectionRuleResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *WaasCustomProtectionRuleResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_waas.ChangeCustomProtectionRuleCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -320,12 +321,12 @@ func (s *WaasCustomProtectionRuleResourceCrud) updateCompartment(compartment int
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	_, err := s.Client.ChangeCustomProtectionRuleCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeCustomProtectionRuleCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_waas "github.com/oracle/oci-go-sdk/v65/waas"
@@ -17,7 +18,7 @@ import (
 
 func WaasCustomProtectionRulesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readWaasCustomProtectionRules,
+		ReadContext: readWaasCustomProtectionRules,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -62,12 +63,12 @@ func WaasCustomProtectionRulesDataSource() *schema.Resource {
 	}
 }
 
-func readWaasCustomProtectionRules(d *schema.ResourceData, m interface{}) error {
+func readWaasCustomProtectionRules(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasCustomProtectionRulesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type WaasCustomProtectionRulesDataSourceCrud struct {
@@ -80,7 +81,7 @@ func (s *WaasCustomProtectionRulesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *WaasCustomProtectionRulesDataSourceCrud) Get() error {
+func (s *WaasCustomProtectionRulesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_waas.ListCustomProtectionRulesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -145,7 +146,7 @@ func (s *WaasCustomProtectionRulesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waas")
 
-	response, err := s.Client.ListCustomProtectionRules(context.Background(), request)
+	response, err := s.Client.ListCustomProtectionRules(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -154,7 +155,7 @@ func (s *WaasCustomProtectionRulesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListCustomProtectionRules(context.Background(), request)
+		listResponse, err := s.Client.ListCustomProtectionRules(ctx, request)
 		if err != nil {
 			return err
 		}
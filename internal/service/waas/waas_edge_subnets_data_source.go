@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_waas "github.com/oracle/oci-go-sdk/v65/waas"
 )
 
 func WaasEdgeSubnetsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readWaasEdgeSubnets,
+		ReadContext: readWaasEdgeSubnets,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"edge_subnets": {
@@ -47,12 +48,12 @@ func WaasEdgeSubnetsDataSource() *schema.Resource {
 	}
 }
 
-func readWaasEdgeSubnets(d *schema.ResourceData, m interface{}) error {
+func readWaasEdgeSubnets(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasEdgeSubnetsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type WaasEdgeSubnetsDataSourceCrud struct {
@@ -65,12 +66,12 @@ func (s *WaasEdgeSubnetsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *WaasEdgeSubnetsDataSourceCrud) Get() error {
+func (s *WaasEdgeSubnetsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_waas.ListEdgeSubnetsRequest{}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waas")
 
-	response, err := s.Client.ListEdgeSubnets(context.Background(), request)
+	response, err := s.Client.ListEdgeSubnets(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -79,7 +80,7 @@ func (s *WaasEdgeSubnetsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListEdgeSubnets(context.Background(), request)
+		listResponse, err := s.Client.ListEdgeSubnets(ctx, request)
 		if err != nil {
 			return err
 		}
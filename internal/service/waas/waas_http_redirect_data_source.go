@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_waas "github.com/oracle/oci-go-sdk/v65/waas"
 )
@@ -22,12 +23,12 @@ func WaasHttpRedirectDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(WaasHttpRedirectResource(), fieldMap, readSingularWaasHttpRedirect)
 }
 
-func readSingularWaasHttpRedirect(d *schema.ResourceData, m interface{}) error {
+func readSingularWaasHttpRedirect(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasHttpRedirectDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RedirectClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type WaasHttpRedirectDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *WaasHttpRedirectDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *WaasHttpRedirectDataSourceCrud) Get() error {
+func (s *WaasHttpRedirectDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_waas.GetHttpRedirectRequest{}
 
 	if httpRedirectId, ok := s.D.GetOkExists("http_redirect_id"); ok {
@@ -50,7 +51,7 @@ func (s *WaasHttpRedirectDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waas")
 
-	response, err := s.Client.GetHttpRedirect(context.Background(), request)
+	response, err := s.Client.GetHttpRedirect(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -25,11 +26,11 @@ func WaasHttpRedirectResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createWaasHttpRedirect,
-		Read:     readWaasHttpRedirect,
-		Update:   updateWaasHttpRedirect,
-		Delete:   deleteWaasHttpRedirect,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createWaasHttpRedirect,
+		ReadContext:   readWaasHttpRedirect,
+		UpdateContext: updateWaasHttpRedirect,
+		DeleteContext: deleteWaasHttpRedirect,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -116,40 +117,40 @@ func WaasHttpRedirectResource() *schema.Resource {
 	}
 }
 
-func createWaasHttpRedirect(d *schema.ResourceData, m interface{}) error {
+func createWaasHttpRedirect(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasHttpRedirectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RedirectClient()
 	sync.WaasClient = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readWaasHttpRedirect(d *schema.ResourceData, m interface{}) error {
+func readWaasHttpRedirect(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasHttpRedirectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RedirectClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateWaasHttpRedirect(d *schema.ResourceData, m interface{}) error {
+func updateWaasHttpRedirect(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasHttpRedirectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RedirectClient()
 	sync.WaasClient = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteWaasHttpRedirect(d *schema.ResourceData, m interface{}) error {
+func deleteWaasHttpRedirect(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasHttpRedirectResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).RedirectClient()
 	sync.WaasClient = m.(*client.OracleClients).WaasClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type WaasHttpRedirectResourceCrud struct {
@@ -188,7 +189,7 @@ func (s *WaasHttpRedirectResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *WaasHttpRedirectResourceCrud) Create() error {
+func (s *WaasHttpRedirectResourceCrud) Create(ctx context.Context) error {
 	request := oci_waas.CreateHttpRedirectRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -236,26 +237,26 @@ func (s *WaasHttpRedirectResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.CreateHttpRedirect(context.Background(), request)
+	response, err := s.Client.CreateHttpRedirect(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getHttpRedirectFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas"), oci_waas.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getHttpRedirectFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas"), oci_waas.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *WaasHttpRedirectResourceCrud) getHttpRedirectFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *WaasHttpRedirectResourceCrud) getHttpRedirectFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_waas.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	httpRedirectId, err := httpRedirectWaitForWorkRequest(workId, "redirect",
+	httpRedirectId, err := httpRedirectWaitForWorkRequest(ctx, workId, "redirect",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.WaasClient)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, httpRedirectId)
-		_, cancelErr := s.WaasClient.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.WaasClient.CancelWorkRequest(ctx,
 			oci_waas.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -269,7 +270,7 @@ func (s *WaasHttpRedirectResourceCrud) getHttpRedirectFromWorkRequest(workId *st
 	}
 	s.D.SetId(*httpRedirectId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func httpRedirectWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -295,7 +296,7 @@ func httpRedirectWorkRequestShouldRetryFunc(timeout time.Duration) func(response
 	}
 }
 
-func httpRedirectWaitForWorkRequest(wId *string, entityType string, action oci_waas.WorkRequestResourceActionTypeEnum,
+func httpRedirectWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_waas.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_waas.WaasClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "waas")
 	retryPolicy.ShouldRetryOperation = httpRedirectWorkRequestShouldRetryFunc(timeout)
@@ -314,7 +315,7 @@ func httpRedirectWaitForWorkRequest(wId *string, entityType string, action oci_w
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_waas.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -360,7 +361,7 @@ func getErrorFromWaasHttpRedirectWorkRequest(response oci_waas.GetWorkRequestRes
 	return errorMessage
 }
 
-func (s *WaasHttpRedirectResourceCrud) Get() error {
+func (s *WaasHttpRedirectResourceCrud) Get(ctx context.Context) error {
 	request := oci_waas.GetHttpRedirectRequest{}
 
 	tmp := s.D.Id()
@@ -368,7 +369,7 @@ func (s *WaasHttpRedirectResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.GetHttpRedirect(context.Background(), request)
+	response, err := s.Client.GetHttpRedirect(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -377,11 +378,11 @@ func (s *WaasHttpRedirectResourceCrud) Get() error {
 	return nil
 }
 
-func (s *WaasHttpRedirectResourceCrud) Update() error {
+func (s *WaasHttpRedirectResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -427,16 +428,16 @@ func (s *WaasHttpRedirectResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.UpdateHttpRedirect(context.Background(), request)
+	response, err := s.Client.UpdateHttpRedirect(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getHttpRedirectFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas"), oci_waas.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getHttpRedirectFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas"), oci_waas.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *WaasHttpRedirectResourceCrud) Delete() error {
+func (s *WaasHttpRedirectResourceCrud) Delete(ctx context.Context) error {
 	request := oci_waas.DeleteHttpRedirectRequest{}
 
 	tmp := s.D.Id()
@@ -444,14 +445,14 @@ func (s *WaasHttpRedirectResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.DeleteHttpRedirect(context.Background(), request)
+	response, err := s.Client.DeleteHttpRedirect(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := httpRedirectWaitForWorkRequest(workId, "waas",
+	_, delWorkRequestErr := httpRedirectWaitForWorkRequest(ctx, workId, "waas",
 		oci_waas.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.WaasClient)
 	return delWorkRequestErr
 }
@@ -549,7 +550,7 @@ func HttpRedirectTargetToMap(obj *oci_waas.HttpRedirectTarget) map[string]interf
 	return result
 }
 
-func (s *WaasHttpRedirectResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *WaasHttpRedirectResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_waas.ChangeHttpRedirectCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -560,12 +561,12 @@ func (s *WaasHttpRedirectResourceCrud) updateCompartment(compartment interface{}
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	_, err := s.Client.ChangeHttpRedirectCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeHttpRedirectCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
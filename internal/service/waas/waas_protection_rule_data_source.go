@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_waas "github.com/oracle/oci-go-sdk/v65/waas"
 )
 
 func WaasProtectionRuleDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularWaasProtectionRule,
+		ReadContext: readSingularWaasProtectionRule,
 		Schema: map[string]*schema.Schema{
 			"protection_rule_key": {
 				Type:     schema.TypeString,
@@ -84,12 +85,12 @@ func WaasProtectionRuleDataSource() *schema.Resource {
 	}
 }
 
-func readSingularWaasProtectionRule(d *schema.ResourceData, m interface{}) error {
+func readSingularWaasProtectionRule(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasProtectionRuleDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type WaasProtectionRuleDataSourceCrud struct {
@@ -102,7 +103,7 @@ func (s *WaasProtectionRuleDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *WaasProtectionRuleDataSourceCrud) Get() error {
+func (s *WaasProtectionRuleDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_waas.GetProtectionRuleRequest{}
 
 	if protectionRuleKey, ok := s.D.GetOkExists("protection_rule_key"); ok {
@@ -117,7 +118,7 @@ func (s *WaasProtectionRuleDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waas")
 
-	response, err := s.Client.GetProtectionRule(context.Background(), request)
+	response, err := s.Client.GetProtectionRule(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_waas "github.com/oracle/oci-go-sdk/v65/waas"
 )
 
 func WaasProtectionRulesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readWaasProtectionRules,
+		ReadContext: readWaasProtectionRules,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"action": {
@@ -45,12 +46,12 @@ func WaasProtectionRulesDataSource() *schema.Resource {
 	}
 }
 
-func readWaasProtectionRules(d *schema.ResourceData, m interface{}) error {
+func readWaasProtectionRules(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasProtectionRulesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type WaasProtectionRulesDataSourceCrud struct {
@@ -63,7 +64,7 @@ func (s *WaasProtectionRulesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *WaasProtectionRulesDataSourceCrud) Get() error {
+func (s *WaasProtectionRulesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_waas.ListProtectionRulesRequest{}
 
 	if action, ok := s.D.GetOkExists("action"); ok {
@@ -99,7 +100,7 @@ func (s *WaasProtectionRulesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waas")
 
-	response, err := s.Client.ListProtectionRules(context.Background(), request)
+	response, err := s.Client.ListProtectionRules(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -108,7 +109,7 @@ func (s *WaasProtectionRulesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListProtectionRules(context.Background(), request)
+		listResponse, err := s.Client.ListProtectionRules(ctx, request)
 		if err != nil {
 			return err
 		}
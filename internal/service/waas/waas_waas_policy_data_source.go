@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	oci_waas "github.com/oracle/oci-go-sdk/v65/waas"
@@ -16,7 +17,7 @@ import (
 
 func WaasWaasPolicyDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readSingularWaasWaasPolicy,
+		ReadContext: readSingularWaasWaasPolicy,
 		Schema: map[string]*schema.Schema{
 			"waas_policy_id": {
 				Type:     schema.TypeString,
@@ -1235,12 +1236,12 @@ func WaasWaasPolicyDataSource() *schema.Resource {
 	}
 }
 
-func readSingularWaasWaasPolicy(d *schema.ResourceData, m interface{}) error {
+func readSingularWaasWaasPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasWaasPolicyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type WaasWaasPolicyDataSourceCrud struct {
@@ -1253,7 +1254,7 @@ func (s *WaasWaasPolicyDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *WaasWaasPolicyDataSourceCrud) Get() error {
+func (s *WaasWaasPolicyDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_waas.GetWaasPolicyRequest{}
 
 	if waasPolicyId, ok := s.D.GetOkExists("waas_policy_id"); ok {
@@ -1263,7 +1264,7 @@ func (s *WaasWaasPolicyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waas")
 
-	response, err := s.Client.GetWaasPolicy(context.Background(), request)
+	response, err := s.Client.GetWaasPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
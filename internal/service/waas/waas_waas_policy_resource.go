@@ -16,6 +16,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -35,10 +36,10 @@ func WaasWaasPolicyResource() *schema.Resource {
 			Update: tfresource.GetTimeoutDuration("2h"),
 			Delete: tfresource.GetTimeoutDuration("2h"),
 		},
-		Create: createWaasWaasPolicy,
-		Read:   readWaasWaasPolicy,
-		Update: updateWaasWaasPolicy,
-		Delete: deleteWaasWaasPolicy,
+		CreateContext: createWaasWaasPolicy,
+		ReadContext:   readWaasWaasPolicy,
+		UpdateContext: updateWaasWaasPolicy,
+		DeleteContext: deleteWaasWaasPolicy,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -1277,37 +1278,37 @@ func WaasWaasPolicyResource() *schema.Resource {
 	}
 }
 
-func createWaasWaasPolicy(d *schema.ResourceData, m interface{}) error {
+func createWaasWaasPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasWaasPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readWaasWaasPolicy(d *schema.ResourceData, m interface{}) error {
+func readWaasWaasPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasWaasPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateWaasWaasPolicy(d *schema.ResourceData, m interface{}) error {
+func updateWaasWaasPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasWaasPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteWaasWaasPolicy(d *schema.ResourceData, m interface{}) error {
+func deleteWaasWaasPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WaasWaasPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WaasClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type WaasWaasPolicyResourceCrud struct {
@@ -1346,7 +1347,7 @@ func (s *WaasWaasPolicyResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *WaasWaasPolicyResourceCrud) Create() error {
+func (s *WaasWaasPolicyResourceCrud) Create(ctx context.Context) error {
 	request := oci_waas.CreateWaasPolicyRequest{}
 
 	if additionalDomains, ok := s.D.GetOkExists("additional_domains"); ok {
@@ -1434,26 +1435,26 @@ func (s *WaasWaasPolicyResourceCrud) Create() error {
 	retryPolicy := tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 	request.RequestMetadata.RetryPolicy = retryPolicy
 
-	response, err := s.Client.CreateWaasPolicy(context.Background(), request)
+	response, err := s.Client.CreateWaasPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getWaasPolicyFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas"), oci_waas.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getWaasPolicyFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas"), oci_waas.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *WaasWaasPolicyResourceCrud) getWaasPolicyFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *WaasWaasPolicyResourceCrud) getWaasPolicyFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_waas.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	waasPolicyId, err := waasPolicyWaitForWorkRequest(workId, "waas",
+	waasPolicyId, err := waasPolicyWaitForWorkRequest(ctx, workId, "waas",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
 		// Try to cancel the work request
 		log.Printf("[DEBUG] creation failed, attempting to cancel the workrequest: %v for identifier: %v\n", workId, waasPolicyId)
-		_, cancelErr := s.Client.CancelWorkRequest(context.Background(),
+		_, cancelErr := s.Client.CancelWorkRequest(ctx,
 			oci_waas.CancelWorkRequestRequest{
 				WorkRequestId: workId,
 				RequestMetadata: oci_common.RequestMetadata{
@@ -1467,7 +1468,7 @@ func (s *WaasWaasPolicyResourceCrud) getWaasPolicyFromWorkRequest(workId *string
 	}
 	s.D.SetId(*waasPolicyId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func waasPolicyWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -1493,7 +1494,7 @@ func waasPolicyWorkRequestShouldRetryFunc(timeout time.Duration) func(response o
 	}
 }
 
-func waasPolicyWaitForWorkRequest(wId *string, entityType string, action oci_waas.WorkRequestResourceActionTypeEnum,
+func waasPolicyWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_waas.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_waas.WaasClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "waas")
 	retryPolicy.ShouldRetryOperation = waasPolicyWorkRequestShouldRetryFunc(timeout)
@@ -1512,7 +1513,7 @@ func waasPolicyWaitForWorkRequest(wId *string, entityType string, action oci_waa
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_waas.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -1617,7 +1618,7 @@ func (s *WaasWaasPolicyResourceCrud) mapToOriginGroup(fieldKeyFormat string) (oc
 	return result, nil
 }
 
-func (s *WaasWaasPolicyResourceCrud) Get() error {
+func (s *WaasWaasPolicyResourceCrud) Get(ctx context.Context) error {
 	request := oci_waas.GetWaasPolicyRequest{}
 
 	tmp := s.D.Id()
@@ -1625,7 +1626,7 @@ func (s *WaasWaasPolicyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.GetWaasPolicy(context.Background(), request)
+	response, err := s.Client.GetWaasPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -1634,11 +1635,11 @@ func (s *WaasWaasPolicyResourceCrud) Get() error {
 	return nil
 }
 
-func (s *WaasWaasPolicyResourceCrud) Update() error {
+func (s *WaasWaasPolicyResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -1719,13 +1720,13 @@ func (s *WaasWaasPolicyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.UpdateWaasPolicy(context.Background(), request)
+	response, err := s.Client.UpdateWaasPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getWaasPolicyFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas"), oci_waas.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getWaasPolicyFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas"), oci_waas.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
 func (s *WaasWaasPolicyResourceCrud) objectMapToOriginMap(origins interface{}) (map[string]oci_waas.Origin, error) {
@@ -1772,7 +1773,7 @@ func (s *WaasWaasPolicyResourceCrud) objectMapToOriginGroupMap(originGroups inte
 	return resultMap, nil
 }
 
-func (s *WaasWaasPolicyResourceCrud) Delete() error {
+func (s *WaasWaasPolicyResourceCrud) Delete(ctx context.Context) error {
 	request := oci_waas.DeleteWaasPolicyRequest{}
 
 	tmp := s.D.Id()
@@ -1780,14 +1781,14 @@ func (s *WaasWaasPolicyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	response, err := s.Client.DeleteWaasPolicy(context.Background(), request)
+	response, err := s.Client.DeleteWaasPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := waasPolicyWaitForWorkRequest(workId, "waas",
+	_, delWorkRequestErr := waasPolicyWaitForWorkRequest(ctx, workId, "waas",
 		oci_waas.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -3920,7 +3921,7 @@ func WhitelistToMap(obj oci_waas.Whitelist) map[string]interface{} {
 	return result
 }
 
-func (s *WaasWaasPolicyResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *WaasWaasPolicyResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_waas.ChangeWaasPolicyCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -3931,12 +3932,12 @@ func (s *WaasWaasPolicyResourceCrud) updateCompartment(compartment interface{})
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waas")
 
-	_, err := s.Client.ChangeWaasPolicyCompartment(context.Background(), changeCompartmentRequest)
+	_, err := s.Client.ChangeWaasPolicyCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+	if waitErr := tfresource.WaitForUpdatedState(ctx, s.D, s); waitErr != nil {
 		return waitErr
 	}
 
@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_waf "github.com/oracle/oci-go-sdk/v65/waf"
 )
@@ -23,12 +24,12 @@ func WafNetworkAddressListDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(WafNetworkAddressListResource(), fieldMap, readSingularWafNetworkAddressList)
 }
 
-func readSingularWafNetworkAddressList(d *schema.ResourceData, m interface{}) error {
+func readSingularWafNetworkAddressList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WafNetworkAddressListDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WafClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type WafNetworkAddressListDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *WafNetworkAddressListDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *WafNetworkAddressListDataSourceCrud) Get() error {
+func (s *WafNetworkAddressListDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_waf.GetNetworkAddressListRequest{}
 
 	if networkAddressListId, ok := s.D.GetOkExists("network_address_list_id"); ok {
@@ -51,7 +52,7 @@ func (s *WafNetworkAddressListDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waf")
 
-	response, err := s.Client.GetNetworkAddressList(context.Background(), request)
+	response, err := s.Client.GetNetworkAddressList(ctx, request)
 	if err != nil {
 		return err
 	}
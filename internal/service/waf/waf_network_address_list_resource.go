@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func WafNetworkAddressListResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createWafNetworkAddressList,
-		Read:     readWafNetworkAddressList,
-		Update:   updateWafNetworkAddressList,
-		Delete:   deleteWafNetworkAddressList,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createWafNetworkAddressList,
+		ReadContext:   readWafNetworkAddressList,
+		UpdateContext: updateWafNetworkAddressList,
+		DeleteContext: deleteWafNetworkAddressList,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -126,37 +127,37 @@ func WafNetworkAddressListResource() *schema.Resource {
 	}
 }
 
-func createWafNetworkAddressList(d *schema.ResourceData, m interface{}) error {
+func createWafNetworkAddressList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WafNetworkAddressListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WafClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readWafNetworkAddressList(d *schema.ResourceData, m interface{}) error {
+func readWafNetworkAddressList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WafNetworkAddressListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WafClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateWafNetworkAddressList(d *schema.ResourceData, m interface{}) error {
+func updateWafNetworkAddressList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WafNetworkAddressListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WafClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteWafNetworkAddressList(d *schema.ResourceData, m interface{}) error {
+func deleteWafNetworkAddressList(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WafNetworkAddressListResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WafClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type WafNetworkAddressListResourceCrud struct {
@@ -195,7 +196,7 @@ func (s *WafNetworkAddressListResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *WafNetworkAddressListResourceCrud) Create() error {
+func (s *WafNetworkAddressListResourceCrud) Create(ctx context.Context) error {
 	request := oci_waf.CreateNetworkAddressListRequest{}
 	err := s.populateTopLevelPolymorphicCreateNetworkAddressListRequest(&request)
 	if err != nil {
@@ -204,20 +205,20 @@ func (s *WafNetworkAddressListResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.CreateNetworkAddressList(context.Background(), request)
+	response, err := s.Client.CreateNetworkAddressList(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getNetworkAddressListFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf"), oci_waf.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getNetworkAddressListFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf"), oci_waf.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *WafNetworkAddressListResourceCrud) getNetworkAddressListFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *WafNetworkAddressListResourceCrud) getNetworkAddressListFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_waf.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	networkAddressListId, err := networkAddressListWaitForWorkRequest(workId, "networkAddressList",
+	networkAddressListId, err := networkAddressListWaitForWorkRequest(ctx, workId, "networkAddressList",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -225,7 +226,7 @@ func (s *WafNetworkAddressListResourceCrud) getNetworkAddressListFromWorkRequest
 	}
 	s.D.SetId(*networkAddressListId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func networkAddressListWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -251,7 +252,7 @@ func networkAddressListWorkRequestShouldRetryFunc(timeout time.Duration) func(re
 	}
 }
 
-func networkAddressListWaitForWorkRequest(wId *string, entityType string, action oci_waf.WorkRequestResourceActionTypeEnum,
+func networkAddressListWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_waf.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_waf.WafClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "waf")
 	retryPolicy.ShouldRetryOperation = networkAddressListWorkRequestShouldRetryFunc(timeout)
@@ -270,7 +271,7 @@ func networkAddressListWaitForWorkRequest(wId *string, entityType string, action
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_waf.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -299,14 +300,14 @@ func networkAddressListWaitForWorkRequest(wId *string, entityType string, action
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_waf.WorkRequestStatusFailed || response.Status == oci_waf.WorkRequestStatusCanceled {
-		return nil, getErrorFromWafNetworkAddressListWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromWafNetworkAddressListWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromWafNetworkAddressListWorkRequest(client *oci_waf.WafClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_waf.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromWafNetworkAddressListWorkRequest(ctx context.Context, client *oci_waf.WafClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_waf.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_waf.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -328,7 +329,7 @@ func getErrorFromWafNetworkAddressListWorkRequest(client *oci_waf.WafClient, wor
 	return workRequestErr
 }
 
-func (s *WafNetworkAddressListResourceCrud) Get() error {
+func (s *WafNetworkAddressListResourceCrud) Get(ctx context.Context) error {
 	request := oci_waf.GetNetworkAddressListRequest{}
 
 	tmp := s.D.Id()
@@ -336,7 +337,7 @@ func (s *WafNetworkAddressListResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.GetNetworkAddressList(context.Background(), request)
+	response, err := s.Client.GetNetworkAddressList(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -345,11 +346,11 @@ func (s *WafNetworkAddressListResourceCrud) Get() error {
 	return nil
 }
 
-func (s *WafNetworkAddressListResourceCrud) Update() error {
+func (s *WafNetworkAddressListResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -363,16 +364,16 @@ func (s *WafNetworkAddressListResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.UpdateNetworkAddressList(context.Background(), request)
+	response, err := s.Client.UpdateNetworkAddressList(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getNetworkAddressListFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf"), oci_waf.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getNetworkAddressListFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf"), oci_waf.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *WafNetworkAddressListResourceCrud) Delete() error {
+func (s *WafNetworkAddressListResourceCrud) Delete(ctx context.Context) error {
 	request := oci_waf.DeleteNetworkAddressListRequest{}
 
 	tmp := s.D.Id()
@@ -380,14 +381,14 @@ func (s *WafNetworkAddressListResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.DeleteNetworkAddressList(context.Background(), request)
+	response, err := s.Client.DeleteNetworkAddressList(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := networkAddressListWaitForWorkRequest(workId, "networkAddressList",
+	_, delWorkRequestErr := networkAddressListWaitForWorkRequest(ctx, workId, "networkAddressList",
 		oci_waf.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -762,7 +763,7 @@ func (s *WafNetworkAddressListResourceCrud) populateTopLevelPolymorphicUpdateNet
 	return nil
 }
 
-func (s *WafNetworkAddressListResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *WafNetworkAddressListResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_waf.ChangeNetworkAddressListCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -773,11 +774,11 @@ func (s *WafNetworkAddressListResourceCrud) updateCompartment(compartment interf
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.ChangeNetworkAddressListCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeNetworkAddressListCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getNetworkAddressListFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf"), oci_waf.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getNetworkAddressListFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf"), oci_waf.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
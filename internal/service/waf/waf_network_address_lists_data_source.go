@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_waf "github.com/oracle/oci-go-sdk/v65/waf"
 )
 
 func WafNetworkAddressListsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readWafNetworkAddressLists,
+		ReadContext: readWafNetworkAddressLists,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -55,12 +56,12 @@ func WafNetworkAddressListsDataSource() *schema.Resource {
 	}
 }
 
-func readWafNetworkAddressLists(d *schema.ResourceData, m interface{}) error {
+func readWafNetworkAddressLists(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WafNetworkAddressListsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WafClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type WafNetworkAddressListsDataSourceCrud struct {
@@ -73,7 +74,7 @@ func (s *WafNetworkAddressListsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *WafNetworkAddressListsDataSourceCrud) Get() error {
+func (s *WafNetworkAddressListsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_waf.ListNetworkAddressListsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -109,7 +110,7 @@ func (s *WafNetworkAddressListsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waf")
 
-	response, err := s.Client.ListNetworkAddressLists(context.Background(), request)
+	response, err := s.Client.ListNetworkAddressLists(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -118,7 +119,7 @@ func (s *WafNetworkAddressListsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListNetworkAddressLists(context.Background(), request)
+		listResponse, err := s.Client.ListNetworkAddressLists(ctx, request)
 		if err != nil {
 			return err
 		}
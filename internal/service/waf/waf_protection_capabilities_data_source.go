@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_waf "github.com/oracle/oci-go-sdk/v65/waf"
 )
 
 func WafProtectionCapabilitiesDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readWafProtectionCapabilities,
+		ReadContext: readWafProtectionCapabilities,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -138,12 +139,12 @@ func WafProtectionCapabilitiesDataSource() *schema.Resource {
 	}
 }
 
-func readWafProtectionCapabilities(d *schema.ResourceData, m interface{}) error {
+func readWafProtectionCapabilities(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WafProtectionCapabilitiesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WafClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type WafProtectionCapabilitiesDataSourceCrud struct {
@@ -156,7 +157,7 @@ func (s *WafProtectionCapabilitiesDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *WafProtectionCapabilitiesDataSourceCrud) Get() error {
+func (s *WafProtectionCapabilitiesDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_waf.ListProtectionCapabilitiesRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -206,7 +207,7 @@ func (s *WafProtectionCapabilitiesDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waf")
 
-	response, err := s.Client.ListProtectionCapabilities(context.Background(), request)
+	response, err := s.Client.ListProtectionCapabilities(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -215,7 +216,7 @@ func (s *WafProtectionCapabilitiesDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListProtectionCapabilities(context.Background(), request)
+		listResponse, err := s.Client.ListProtectionCapabilities(ctx, request)
 		if err != nil {
 			return err
 		}
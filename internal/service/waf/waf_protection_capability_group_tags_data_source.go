@@ -9,13 +9,14 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_waf "github.com/oracle/oci-go-sdk/v65/waf"
 )
 
 func WafProtectionCapabilityGroupTagsDataSource() *schema.Resource {
 	return &schema.Resource{
-		Read: readWafProtectionCapabilityGroupTags,
+		ReadContext: readWafProtectionCapabilityGroupTags,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
 			"compartment_id": {
@@ -64,12 +65,12 @@ func WafProtectionCapabilityGroupTagsDataSource() *schema.Resource {
 	}
 }
 
-func readWafProtectionCapabilityGroupTags(d *schema.ResourceData, m interface{}) error {
+func readWafProtectionCapabilityGroupTags(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WafProtectionCapabilityGroupTagsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WafClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type WafProtectionCapabilityGroupTagsDataSourceCrud struct {
@@ -82,7 +83,7 @@ func (s *WafProtectionCapabilityGroupTagsDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *WafProtectionCapabilityGroupTagsDataSourceCrud) Get() error {
+func (s *WafProtectionCapabilityGroupTagsDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_waf.ListProtectionCapabilityGroupTagsRequest{}
 
 	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
@@ -101,7 +102,7 @@ func (s *WafProtectionCapabilityGroupTagsDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waf")
 
-	response, err := s.Client.ListProtectionCapabilityGroupTags(context.Background(), request)
+	response, err := s.Client.ListProtectionCapabilityGroupTags(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -110,7 +111,7 @@ func (s *WafProtectionCapabilityGroupTagsDataSourceCrud) Get() error {
 	request.Page = s.Res.OpcNextPage
 
 	for request.Page != nil {
-		listResponse, err := s.Client.ListProtectionCapabilityGroupTags(context.Background(), request)
+		listResponse, err := s.Client.ListProtectionCapabilityGroupTags(ctx, request)
 		if err != nil {
 			return err
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_waf "github.com/oracle/oci-go-sdk/v65/waf"
 )
@@ -23,12 +24,12 @@ func WafWebAppFirewallDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(WafWebAppFirewallResource(), fieldMap, readSingularWafWebAppFirewall)
 }
 
-func readSingularWafWebAppFirewall(d *schema.ResourceData, m interface{}) error {
+func readSingularWafWebAppFirewall(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WafWebAppFirewallDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WafClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type WafWebAppFirewallDataSourceCrud struct {
@@ -41,7 +42,7 @@ func (s *WafWebAppFirewallDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *WafWebAppFirewallDataSourceCrud) Get() error {
+func (s *WafWebAppFirewallDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_waf.GetWebAppFirewallRequest{}
 
 	if webAppFirewallId, ok := s.D.GetOkExists("web_app_firewall_id"); ok {
@@ -51,7 +52,7 @@ func (s *WafWebAppFirewallDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waf")
 
-	response, err := s.Client.GetWebAppFirewall(context.Background(), request)
+	response, err := s.Client.GetWebAppFirewall(ctx, request)
 	if err != nil {
 		return err
 	}
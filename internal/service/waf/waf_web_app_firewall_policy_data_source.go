@@ -9,6 +9,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_waf "github.com/oracle/oci-go-sdk/v65/waf"
 )
@@ -22,12 +23,12 @@ func WafWebAppFirewallPolicyDataSource() *schema.Resource {
 	return tfresource.GetSingularDataSourceItemSchema(WafWebAppFirewallPolicyResource(), fieldMap, readSingularWafWebAppFirewallPolicy)
 }
 
-func readSingularWafWebAppFirewallPolicy(d *schema.ResourceData, m interface{}) error {
+func readSingularWafWebAppFirewallPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WafWebAppFirewallPolicyDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WafClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
 type WafWebAppFirewallPolicyDataSourceCrud struct {
@@ -40,7 +41,7 @@ func (s *WafWebAppFirewallPolicyDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
 
-func (s *WafWebAppFirewallPolicyDataSourceCrud) Get() error {
+func (s *WafWebAppFirewallPolicyDataSourceCrud) Get(ctx context.Context) error {
 	request := oci_waf.GetWebAppFirewallPolicyRequest{}
 
 	if webAppFirewallPolicyId, ok := s.D.GetOkExists("web_app_firewall_policy_id"); ok {
@@ -50,7 +51,7 @@ func (s *WafWebAppFirewallPolicyDataSourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "waf")
 
-	response, err := s.Client.GetWebAppFirewallPolicy(context.Background(), request)
+	response, err := s.Client.GetWebAppFirewallPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
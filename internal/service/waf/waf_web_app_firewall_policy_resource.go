@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func WafWebAppFirewallPolicyResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createWafWebAppFirewallPolicy,
-		Read:     readWafWebAppFirewallPolicy,
-		Update:   updateWafWebAppFirewallPolicy,
-		Delete:   deleteWafWebAppFirewallPolicy,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createWafWebAppFirewallPolicy,
+		ReadContext:   readWafWebAppFirewallPolicy,
+		UpdateContext: updateWafWebAppFirewallPolicy,
+		DeleteContext: deleteWafWebAppFirewallPolicy,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -752,37 +753,37 @@ func WafWebAppFirewallPolicyResource() *schema.Resource {
 	}
 }
 
-func createWafWebAppFirewallPolicy(d *schema.ResourceData, m interface{}) error {
+func createWafWebAppFirewallPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WafWebAppFirewallPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WafClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readWafWebAppFirewallPolicy(d *schema.ResourceData, m interface{}) error {
+func readWafWebAppFirewallPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WafWebAppFirewallPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WafClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateWafWebAppFirewallPolicy(d *schema.ResourceData, m interface{}) error {
+func updateWafWebAppFirewallPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WafWebAppFirewallPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WafClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteWafWebAppFirewallPolicy(d *schema.ResourceData, m interface{}) error {
+func deleteWafWebAppFirewallPolicy(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WafWebAppFirewallPolicyResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WafClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type WafWebAppFirewallPolicyResourceCrud struct {
@@ -820,7 +821,7 @@ func (s *WafWebAppFirewallPolicyResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *WafWebAppFirewallPolicyResourceCrud) Create() error {
+func (s *WafWebAppFirewallPolicyResourceCrud) Create(ctx context.Context) error {
 	request := oci_waf.CreateWebAppFirewallPolicyRequest{}
 
 	if actions, ok := s.D.GetOkExists("actions"); ok {
@@ -927,20 +928,20 @@ func (s *WafWebAppFirewallPolicyResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.CreateWebAppFirewallPolicy(context.Background(), request)
+	response, err := s.Client.CreateWebAppFirewallPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getWebAppFirewallPolicyFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf"), oci_waf.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getWebAppFirewallPolicyFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf"), oci_waf.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *WafWebAppFirewallPolicyResourceCrud) getWebAppFirewallPolicyFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *WafWebAppFirewallPolicyResourceCrud) getWebAppFirewallPolicyFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_waf.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	webAppFirewallPolicyId, err := webAppFirewallPolicyWaitForWorkRequest(workId, "webAppFirewallPolicy",
+	webAppFirewallPolicyId, err := webAppFirewallPolicyWaitForWorkRequest(ctx, workId, "webAppFirewallPolicy",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -948,7 +949,7 @@ func (s *WafWebAppFirewallPolicyResourceCrud) getWebAppFirewallPolicyFromWorkReq
 	}
 	s.D.SetId(*webAppFirewallPolicyId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func webAppFirewallPolicyWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -974,7 +975,7 @@ func webAppFirewallPolicyWorkRequestShouldRetryFunc(timeout time.Duration) func(
 	}
 }
 
-func webAppFirewallPolicyWaitForWorkRequest(wId *string, entityType string, action oci_waf.WorkRequestResourceActionTypeEnum,
+func webAppFirewallPolicyWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_waf.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_waf.WafClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "waf")
 	retryPolicy.ShouldRetryOperation = webAppFirewallPolicyWorkRequestShouldRetryFunc(timeout)
@@ -993,7 +994,7 @@ func webAppFirewallPolicyWaitForWorkRequest(wId *string, entityType string, acti
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_waf.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -1022,14 +1023,14 @@ func webAppFirewallPolicyWaitForWorkRequest(wId *string, entityType string, acti
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_waf.WorkRequestStatusFailed || response.Status == oci_waf.WorkRequestStatusCanceled {
-		return nil, getErrorFromWafWebAppFirewallPolicyWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromWafWebAppFirewallPolicyWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromWafWebAppFirewallPolicyWorkRequest(client *oci_waf.WafClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_waf.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromWafWebAppFirewallPolicyWorkRequest(ctx context.Context, client *oci_waf.WafClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_waf.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_waf.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -1051,7 +1052,7 @@ func getErrorFromWafWebAppFirewallPolicyWorkRequest(client *oci_waf.WafClient, w
 	return workRequestErr
 }
 
-func (s *WafWebAppFirewallPolicyResourceCrud) Get() error {
+func (s *WafWebAppFirewallPolicyResourceCrud) Get(ctx context.Context) error {
 	request := oci_waf.GetWebAppFirewallPolicyRequest{}
 
 	tmp := s.D.Id()
@@ -1059,7 +1060,7 @@ func (s *WafWebAppFirewallPolicyResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.GetWebAppFirewallPolicy(context.Background(), request)
+	response, err := s.Client.GetWebAppFirewallPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -1068,11 +1069,11 @@ func (s *WafWebAppFirewallPolicyResourceCrud) Get() error {
 	return nil
 }
 
-func (s *WafWebAppFirewallPolicyResourceCrud) Update() error {
+func (s *WafWebAppFirewallPolicyResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -1182,16 +1183,16 @@ func (s *WafWebAppFirewallPolicyResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.UpdateWebAppFirewallPolicy(context.Background(), request)
+	response, err := s.Client.UpdateWebAppFirewallPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getWebAppFirewallPolicyFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf"), oci_waf.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getWebAppFirewallPolicyFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf"), oci_waf.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *WafWebAppFirewallPolicyResourceCrud) Delete() error {
+func (s *WafWebAppFirewallPolicyResourceCrud) Delete(ctx context.Context) error {
 	request := oci_waf.DeleteWebAppFirewallPolicyRequest{}
 
 	tmp := s.D.Id()
@@ -1199,14 +1200,14 @@ func (s *WafWebAppFirewallPolicyResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.DeleteWebAppFirewallPolicy(context.Background(), request)
+	response, err := s.Client.DeleteWebAppFirewallPolicy(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := webAppFirewallPolicyWaitForWorkRequest(workId, "webAppFirewallPolicy",
+	_, delWorkRequestErr := webAppFirewallPolicyWaitForWorkRequest(ctx, workId, "webAppFirewallPolicy",
 		oci_waf.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -2193,7 +2194,7 @@ func WebAppFirewallPolicySummaryToMap(obj oci_waf.WebAppFirewallPolicySummary) m
 	return result
 }
 
-func (s *WafWebAppFirewallPolicyResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *WafWebAppFirewallPolicyResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_waf.ChangeWebAppFirewallPolicyCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -2204,11 +2205,11 @@ func (s *WafWebAppFirewallPolicyResourceCrud) updateCompartment(compartment inte
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.ChangeWebAppFirewallPolicyCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeWebAppFirewallPolicyCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getWebAppFirewallPolicyFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf"), oci_waf.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getWebAppFirewallPolicyFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf"), oci_waf.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,11 +27,11 @@ func WafWebAppFirewallResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createWafWebAppFirewall,
-		Read:     readWafWebAppFirewall,
-		Update:   updateWafWebAppFirewall,
-		Delete:   deleteWafWebAppFirewall,
+		Timeouts:      tfresource.DefaultTimeout,
+		CreateContext: createWafWebAppFirewall,
+		ReadContext:   readWafWebAppFirewall,
+		UpdateContext: updateWafWebAppFirewall,
+		DeleteContext: deleteWafWebAppFirewall,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"backend_type": {
@@ -103,37 +104,37 @@ func WafWebAppFirewallResource() *schema.Resource {
 	}
 }
 
-func createWafWebAppFirewall(d *schema.ResourceData, m interface{}) error {
+func createWafWebAppFirewall(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WafWebAppFirewallResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WafClient()
 
-	return tfresource.CreateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.CreateResource(ctx, d, sync))
 }
 
-func readWafWebAppFirewall(d *schema.ResourceData, m interface{}) error {
+func readWafWebAppFirewall(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WafWebAppFirewallResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WafClient()
 
-	return tfresource.ReadResource(sync)
+	return tfresource.HandleDiagError(tfresource.ReadResource(ctx, sync))
 }
 
-func updateWafWebAppFirewall(d *schema.ResourceData, m interface{}) error {
+func updateWafWebAppFirewall(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WafWebAppFirewallResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WafClient()
 
-	return tfresource.UpdateResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.UpdateResource(ctx, d, sync))
 }
 
-func deleteWafWebAppFirewall(d *schema.ResourceData, m interface{}) error {
+func deleteWafWebAppFirewall(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	sync := &WafWebAppFirewallResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).WafClient()
 	sync.DisableNotFoundRetries = true
 
-	return tfresource.DeleteResource(d, sync)
+	return tfresource.HandleDiagError(tfresource.DeleteResource(ctx, d, sync))
 }
 
 type WafWebAppFirewallResourceCrud struct {
@@ -172,7 +173,7 @@ func (s *WafWebAppFirewallResourceCrud) DeletedTarget() []string {
 	}
 }
 
-func (s *WafWebAppFirewallResourceCrud) Create() error {
+func (s *WafWebAppFirewallResourceCrud) Create(ctx context.Context) error {
 	request := oci_waf.CreateWebAppFirewallRequest{}
 	err := s.populateTopLevelPolymorphicCreateWebAppFirewallRequest(&request)
 	if err != nil {
@@ -181,20 +182,20 @@ func (s *WafWebAppFirewallResourceCrud) Create() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.CreateWebAppFirewall(context.Background(), request)
+	response, err := s.Client.CreateWebAppFirewall(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getWebAppFirewallFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf"), oci_waf.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
+	return s.getWebAppFirewallFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf"), oci_waf.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
-func (s *WafWebAppFirewallResourceCrud) getWebAppFirewallFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
+func (s *WafWebAppFirewallResourceCrud) getWebAppFirewallFromWorkRequest(ctx context.Context, workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_waf.WorkRequestResourceActionTypeEnum, timeout time.Duration) error {
 
 	// Wait until it finishes
-	webAppFirewallId, err := webAppFirewallWaitForWorkRequest(workId, "webAppFirewall",
+	webAppFirewallId, err := webAppFirewallWaitForWorkRequest(ctx, workId, "webAppFirewall",
 		actionTypeEnum, timeout, s.DisableNotFoundRetries, s.Client)
 
 	if err != nil {
@@ -202,7 +203,7 @@ func (s *WafWebAppFirewallResourceCrud) getWebAppFirewallFromWorkRequest(workId
 	}
 	s.D.SetId(*webAppFirewallId)
 
-	return s.Get()
+	return s.Get(ctx)
 }
 
 func webAppFirewallWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
@@ -228,7 +229,7 @@ func webAppFirewallWorkRequestShouldRetryFunc(timeout time.Duration) func(respon
 	}
 }
 
-func webAppFirewallWaitForWorkRequest(wId *string, entityType string, action oci_waf.WorkRequestResourceActionTypeEnum,
+func webAppFirewallWaitForWorkRequest(ctx context.Context, wId *string, entityType string, action oci_waf.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, client *oci_waf.WafClient) (*string, error) {
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "waf")
 	retryPolicy.ShouldRetryOperation = webAppFirewallWorkRequestShouldRetryFunc(timeout)
@@ -247,7 +248,7 @@ func webAppFirewallWaitForWorkRequest(wId *string, entityType string, action oci
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = client.GetWorkRequest(context.Background(),
+			response, err = client.GetWorkRequest(ctx,
 				oci_waf.GetWorkRequestRequest{
 					WorkRequestId: wId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -276,14 +277,14 @@ func webAppFirewallWaitForWorkRequest(wId *string, entityType string, action oci
 
 	// The workrequest may have failed, check for errors if identifier is not found or work failed or got cancelled
 	if identifier == nil || response.Status == oci_waf.WorkRequestStatusFailed || response.Status == oci_waf.WorkRequestStatusCanceled {
-		return nil, getErrorFromWafWebAppFirewallWorkRequest(client, wId, retryPolicy, entityType, action)
+		return nil, getErrorFromWafWebAppFirewallWorkRequest(ctx, client, wId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
 }
 
-func getErrorFromWafWebAppFirewallWorkRequest(client *oci_waf.WafClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_waf.WorkRequestResourceActionTypeEnum) error {
-	response, err := client.ListWorkRequestErrors(context.Background(),
+func getErrorFromWafWebAppFirewallWorkRequest(ctx context.Context, client *oci_waf.WafClient, workId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_waf.WorkRequestResourceActionTypeEnum) error {
+	response, err := client.ListWorkRequestErrors(ctx,
 		oci_waf.ListWorkRequestErrorsRequest{
 			WorkRequestId: workId,
 			RequestMetadata: oci_common.RequestMetadata{
@@ -305,7 +306,7 @@ func getErrorFromWafWebAppFirewallWorkRequest(client *oci_waf.WafClient, workId
 	return workRequestErr
 }
 
-func (s *WafWebAppFirewallResourceCrud) Get() error {
+func (s *WafWebAppFirewallResourceCrud) Get(ctx context.Context) error {
 	request := oci_waf.GetWebAppFirewallRequest{}
 
 	tmp := s.D.Id()
@@ -313,7 +314,7 @@ func (s *WafWebAppFirewallResourceCrud) Get() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.GetWebAppFirewall(context.Background(), request)
+	response, err := s.Client.GetWebAppFirewall(ctx, request)
 	if err != nil {
 		return err
 	}
@@ -322,11 +323,11 @@ func (s *WafWebAppFirewallResourceCrud) Get() error {
 	return nil
 }
 
-func (s *WafWebAppFirewallResourceCrud) Update() error {
+func (s *WafWebAppFirewallResourceCrud) Update(ctx context.Context) error {
 	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
 		oldRaw, newRaw := s.D.GetChange("compartment_id")
 		if newRaw != "" && oldRaw != "" {
-			err := s.updateCompartment(compartment)
+			err := s.updateCompartment(ctx, compartment)
 			if err != nil {
 				return err
 			}
@@ -369,16 +370,16 @@ func (s *WafWebAppFirewallResourceCrud) Update() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.UpdateWebAppFirewall(context.Background(), request)
+	response, err := s.Client.UpdateWebAppFirewall(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getWebAppFirewallFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf"), oci_waf.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getWebAppFirewallFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf"), oci_waf.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
-func (s *WafWebAppFirewallResourceCrud) Delete() error {
+func (s *WafWebAppFirewallResourceCrud) Delete(ctx context.Context) error {
 	request := oci_waf.DeleteWebAppFirewallRequest{}
 
 	tmp := s.D.Id()
@@ -386,14 +387,14 @@ func (s *WafWebAppFirewallResourceCrud) Delete() error {
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.DeleteWebAppFirewall(context.Background(), request)
+	response, err := s.Client.DeleteWebAppFirewall(ctx, request)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
 	// Wait until it finishes
-	_, delWorkRequestErr := webAppFirewallWaitForWorkRequest(workId, "webAppFirewall",
+	_, delWorkRequestErr := webAppFirewallWaitForWorkRequest(ctx, workId, "webAppFirewall",
 		oci_waf.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
 	return delWorkRequestErr
 }
@@ -557,7 +558,7 @@ func (s *WafWebAppFirewallResourceCrud) populateTopLevelPolymorphicCreateWebAppF
 	return nil
 }
 
-func (s *WafWebAppFirewallResourceCrud) updateCompartment(compartment interface{}) error {
+func (s *WafWebAppFirewallResourceCrud) updateCompartment(ctx context.Context, compartment interface{}) error {
 	changeCompartmentRequest := oci_waf.ChangeWebAppFirewallCompartmentRequest{}
 
 	compartmentTmp := compartment.(string)
@@ -568,11 +569,11 @@ func (s *WafWebAppFirewallResourceCrud) updateCompartment(compartment interface{
 
 	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
 
-	response, err := s.Client.ChangeWebAppFirewallCompartment(context.Background(), changeCompartmentRequest)
+	response, err := s.Client.ChangeWebAppFirewallCompartment(ctx, changeCompartmentRequest)
 	if err != nil {
 		return err
 	}
 
 	workId := response.OpcWorkRequestId
-	return s.getWebAppFirewallFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf"), oci_waf.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
+	return s.getWebAppFirewallFromWorkRequest(ctx, workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf"), oci_waf.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
@@ -21,10 +21,12 @@ import (
 	"strings"
 	"time"
 
+	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 
 	"sync"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
@@ -138,13 +140,13 @@ type workReqClient interface {
 	ListWorkRequestErrors(context.Context, oci_work_requests.ListWorkRequestErrorsRequest) (oci_work_requests.ListWorkRequestErrorsResponse, error)
 }
 
-func waitForStateRefreshForHybridPolling(workRequestClient workReqClient, workRequestIds *string, entityType string, action oci_work_requests.WorkRequestResourceActionTypeEnum,
+func waitForStateRefreshForHybridPolling(ctx context.Context, workRequestClient workReqClient, workRequestIds *string, entityType string, action oci_work_requests.WorkRequestResourceActionTypeEnum,
 	disableFoundRetries bool, sync StatefulResource, timeout time.Duration, operationName string, pending, target []string) error {
 	// TODO: try to move this onto sync
 	stateConf := &resource.StateChangeConf{
 		Pending: pending,
 		Target:  target,
-		Refresh: stateRefreshFuncVar(sync),
+		Refresh: stateRefreshFuncVar(ctx, sync),
 		Timeout: timeout,
 	}
 
@@ -153,12 +155,15 @@ func waitForStateRefreshForHybridPolling(workRequestClient workReqClient, workRe
 		stateConf.PollInterval = 1
 	}
 
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
 		handleMissingResourceError(sync, &e)
 		if _, ok := e.(*resource.UnexpectedStateError); ok {
 			retryPolicy := GetRetryPolicy(disableFoundRetries, "work_request")
 			retryPolicy.ShouldRetryOperation = workRequestShouldRetryFunc(timeout)
-			e = getWorkRequestErrorsVar(workRequestClient, workRequestIds, retryPolicy, entityType, action)
+			e = getWorkRequestErrorsVar(ctx, workRequestClient, workRequestIds, retryPolicy, entityType, action)
 			return e
 		}
 
@@ -175,14 +180,14 @@ func waitForStateRefreshForHybridPolling(workRequestClient workReqClient, workRe
 	return nil
 }
 
-func ResourceRefreshForHybridPolling(workRequestClient workReqClient, workRequestIds *string, entityType string, action oci_work_requests.WorkRequestResourceActionTypeEnum,
+func ResourceRefreshForHybridPolling(ctx context.Context, workRequestClient workReqClient, workRequestIds *string, entityType string, action oci_work_requests.WorkRequestResourceActionTypeEnum,
 	disableFoundRetries bool, d schemaResourceData, sync ResourceCreator) error {
 
 	// ID is required for state refresh
 	d.SetId(sync.ID())
 
 	if stateful, ok := sync.(StatefullyCreatedResource); ok {
-		if e := waitForStateRefreshForHybridPollingVar(workRequestClient, workRequestIds, entityType, action, disableFoundRetries, stateful, d.Timeout(schema.TimeoutCreate), "creation", stateful.CreatedPending(), stateful.CreatedTarget()); e != nil {
+		if e := waitForStateRefreshForHybridPollingVar(ctx, workRequestClient, workRequestIds, entityType, action, disableFoundRetries, stateful, d.Timeout(schema.TimeoutCreate), "creation", stateful.CreatedPending(), stateful.CreatedTarget()); e != nil {
 			if stateful.State() == FAILED {
 				// Remove resource from state if asynchronous work request has failed so that it is recreated on next apply
 				// TODO: automatic retry on WorkRequestFailed
@@ -204,21 +209,23 @@ func ResourceRefreshForHybridPolling(workRequestClient workReqClient, workReques
 	}
 
 	if ew, waitOK := sync.(ExtraWaitPostCreateDelete); waitOK {
-		time.Sleep(ew.ExtraWaitPostCreateDelete())
+		if e := sleepWithContext(ctx, ew.ExtraWaitPostCreateDelete()); e != nil {
+			return e
+		}
 	}
 
 	return nil
 }
 
-func CreateResourceUsingHybridPolling(sync ResourceCreator) error {
-	if e := sync.Create(); e != nil {
+func CreateResourceUsingHybridPolling(ctx context.Context, sync ResourceCreator) error {
+	if e := sync.Create(ctx); e != nil {
 		return HandleErrorVar(sync, e)
 	}
 
 	return nil
 }
 
-func CreateResource(d schemaResourceData, sync ResourceCreator) error {
+func CreateResource(ctx context.Context, d schemaResourceData, sync ResourceCreator) error {
 	if synchronizedResource, ok := sync.(SynchronizedResource); ok {
 		if mutex := synchronizedResource.GetMutex(); mutex != nil {
 			mutex.Lock()
@@ -226,7 +233,7 @@ func CreateResource(d schemaResourceData, sync ResourceCreator) error {
 		}
 	}
 
-	if e := sync.Create(); e != nil {
+	if e := sync.Create(ctx); e != nil {
 		return HandleError(sync, e)
 	}
 
@@ -234,7 +241,7 @@ func CreateResource(d schemaResourceData, sync ResourceCreator) error {
 	d.SetId(sync.ID())
 
 	if stateful, ok := sync.(StatefullyCreatedResource); ok {
-		if e := waitForStateRefreshVar(stateful, d.Timeout(schema.TimeoutCreate), "creation", stateful.CreatedPending(), stateful.CreatedTarget()); e != nil {
+		if e := waitForStateRefreshVar(ctx, stateful, d.Timeout(schema.TimeoutCreate), "creation", stateful.CreatedPending(), stateful.CreatedTarget()); e != nil {
 			if stateful.State() == FAILED {
 				// Remove resource from state if asynchronous work request has failed so that it is recreated on next apply
 				// TODO: automatic retry on WorkRequestFailed
@@ -256,13 +263,15 @@ func CreateResource(d schemaResourceData, sync ResourceCreator) error {
 	}
 
 	if ew, waitOK := sync.(ExtraWaitPostCreateDelete); waitOK {
-		time.Sleep(ew.ExtraWaitPostCreateDelete())
+		if e := sleepWithContext(ctx, ew.ExtraWaitPostCreateDelete()); e != nil {
+			return e
+		}
 	}
 	return nil
 }
 
-func ReadResource(sync ResourceReader) error {
-	if e := sync.Get(); e != nil {
+func ReadResource(ctx context.Context, sync ResourceReader) error {
+	if e := sync.Get(ctx); e != nil {
 		log.Printf("ERROR IN GET: %v\n", e.Error())
 		handleMissingResourceError(sync, &e)
 		return HandleError(sync, e)
@@ -285,7 +294,7 @@ func ReadResource(sync ResourceReader) error {
 	return nil
 }
 
-func UpdateResource(d schemaResourceData, sync ResourceUpdater) error {
+func UpdateResource(ctx context.Context, d schemaResourceData, sync ResourceUpdater) error {
 	if synchronizedResource, ok := sync.(SynchronizedResource); ok {
 		if mutex := synchronizedResource.GetMutex(); mutex != nil {
 			mutex.Lock()
@@ -294,15 +303,13 @@ func UpdateResource(d schemaResourceData, sync ResourceUpdater) error {
 	}
 
 	d.Partial(true)
-	if e := sync.Update(); e != nil {
-
+	if e := sync.Update(ctx); e != nil {
 		return HandleError(sync, e)
 	}
 	d.Partial(false)
 
 	if stateful, ok := sync.(StatefullyUpdatedResource); ok {
-		if e := waitForStateRefreshVar(stateful, d.Timeout(schema.TimeoutUpdate), "update", stateful.UpdatedPending(), stateful.UpdatedTarget()); e != nil {
-
+		if e := waitForStateRefreshVar(ctx, stateful, d.Timeout(schema.TimeoutUpdate), "update", stateful.UpdatedPending(), stateful.UpdatedTarget()); e != nil {
 			return e
 		}
 	}
@@ -318,7 +325,7 @@ func UpdateResource(d schemaResourceData, sync ResourceUpdater) error {
 // statefully (not immediately), poll State to ensure:
 // () -> Pending -> Deleted.
 // Finally, sets the ResourceData state to empty.
-func DeleteResource(d schemaResourceData, sync ResourceDeleter) error {
+func DeleteResource(ctx context.Context, d schemaResourceData, sync ResourceDeleter) error {
 	if synchronizedResource, ok := sync.(SynchronizedResource); ok {
 		if mutex := synchronizedResource.GetMutex(); mutex != nil {
 			mutex.Lock()
@@ -326,24 +333,28 @@ func DeleteResource(d schemaResourceData, sync ResourceDeleter) error {
 		}
 	}
 
-	if e := sync.Delete(); e != nil {
+	if e := sync.Delete(ctx); e != nil {
 		handleMissingResourceError(sync, &e)
 		return HandleError(sync, e)
 	}
 
 	if stateful, ok := sync.(StatefullyDeletedResource); ok {
-		if e := waitForStateRefreshVar(stateful, d.Timeout(schema.TimeoutDelete), "deletion", stateful.DeletedPending(), stateful.DeletedTarget()); e != nil {
+		if e := waitForStateRefreshVar(ctx, stateful, d.Timeout(schema.TimeoutDelete), "deletion", stateful.DeletedPending(), stateful.DeletedTarget()); e != nil {
 			handleMissingResourceError(sync, &e)
 			return e
 		}
 	}
 
 	if ew, waitOK := sync.(ExtraWaitPostCreateDelete); waitOK {
-		time.Sleep(ew.ExtraWaitPostCreateDelete())
+		if e := sleepWithContext(ctx, ew.ExtraWaitPostCreateDelete()); e != nil {
+			return e
+		}
 	}
 
 	if ew, waitOK := sync.(ExtraWaitPostDelete); waitOK {
-		time.Sleep(ew.ExtraWaitPostDelete())
+		if e := sleepWithContext(ctx, ew.ExtraWaitPostDelete()); e != nil {
+			return e
+		}
 	}
 
 	sync.VoidState()
@@ -351,9 +362,59 @@ func DeleteResource(d schemaResourceData, sync ResourceDeleter) error {
 	return nil
 }
 
-func stateRefreshFunc(sync StatefulResource) resource.StateRefreshFunc {
+// HandleDiagError converts the error returned by the CRUD helpers into the diagnostics
+// expected by the context aware schema.Resource functions
+func HandleDiagError(err error) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+	return diag.FromErr(err)
+}
+
+// ReadSchemaResource invokes the Read of a resource regardless of whether it was registered
+// with Read or ReadContext. Used by callers that read resources outside of a Terraform operation.
+func ReadSchemaResource(r *schema.Resource, d *schema.ResourceData, m interface{}) error {
+	if r.ReadContext != nil {
+		if diags := r.ReadContext(context.Background(), d, m); diags.HasError() {
+			return diagnosticsToError(diags)
+		}
+		return nil
+	}
+	if r.Read == nil {
+		return fmt.Errorf("resource does not implement Read")
+	}
+	return r.Read(d, m)
+}
+
+func diagnosticsToError(diags diag.Diagnostics) error {
+	var errs []string
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+		if d.Detail != "" {
+			errs = append(errs, fmt.Sprintf("%s: %s", d.Summary, d.Detail))
+		} else {
+			errs = append(errs, d.Summary)
+		}
+	}
+	return fmt.Errorf("%s", strings.Join(errs, "\n"))
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func stateRefreshFunc(ctx context.Context, sync StatefulResource) resource.StateRefreshFunc {
 	return func() (res interface{}, s string, e error) {
-		if e = sync.Get(); e != nil {
+		if e = sync.Get(ctx); e != nil {
 			return nil, "", e
 		}
 		// We don't set all the state here, because not found errors are handled elsewhere.
@@ -367,9 +428,9 @@ func stateRefreshFunc(sync StatefulResource) resource.StateRefreshFunc {
 
 // Helper function to wait for Update to reach terminal state before doing another Update
 // Useful in situations where more than one Update is needed and prior Update needs to complete
-func WaitForUpdatedState(d schemaResourceData, sync ResourceUpdater) error {
+func WaitForUpdatedState(ctx context.Context, d schemaResourceData, sync ResourceUpdater) error {
 	if stateful, ok := sync.(StatefullyUpdatedResource); ok {
-		if e := waitForStateRefreshVar(stateful, d.Timeout(schema.TimeoutUpdate), "update", stateful.UpdatedPending(), stateful.UpdatedTarget()); e != nil {
+		if e := waitForStateRefreshVar(ctx, stateful, d.Timeout(schema.TimeoutUpdate), "update", stateful.UpdatedPending(), stateful.UpdatedTarget()); e != nil {
 			return e
 		}
 	}
@@ -379,10 +440,10 @@ func WaitForUpdatedState(d schemaResourceData, sync ResourceUpdater) error {
 
 // Helper function to wait for Create to reach terminal state before doing another operation
 // Useful in situations where another operation is done right after Create
-func WaitForCreatedState(d schemaResourceData, sync ResourceCreator) error {
+func WaitForCreatedState(ctx context.Context, d schemaResourceData, sync ResourceCreator) error {
 	d.SetId(sync.ID())
 	if stateful, ok := sync.(StatefullyCreatedResource); ok {
-		if e := waitForStateRefreshVar(stateful, d.Timeout(schema.TimeoutCreate), "creation", stateful.CreatedPending(), stateful.CreatedTarget()); e != nil {
+		if e := waitForStateRefreshVar(ctx, stateful, d.Timeout(schema.TimeoutCreate), "creation", stateful.CreatedPending(), stateful.CreatedTarget()); e != nil {
 			return e
 		}
 	}
@@ -391,15 +452,16 @@ func WaitForCreatedState(d schemaResourceData, sync ResourceCreator) error {
 }
 
 // WaitForStateRefresh takes a StatefulResource, a timeout duration, a list of states to treat as Pending, and a list of states to treat as Target. It uses those to wrap resource.StateChangeConf.WaitForState(). If the resource returns a missing status, it will not be treated as an error.
+// It stops polling as soon as ctx is cancelled or its deadline is exceeded.
 //
 // sync.D.Id must be set.
 // It does not set state from that refreshed state.
-func WaitForStateRefresh(sync StatefulResource, timeout time.Duration, operationName string, pending, target []string) error {
+func WaitForStateRefresh(ctx context.Context, sync StatefulResource, timeout time.Duration, operationName string, pending, target []string) error {
 	// TODO: try to move this onto sync
 	stateConf := &resource.StateChangeConf{
 		Pending: pending,
 		Target:  target,
-		Refresh: stateRefreshFuncVar(sync),
+		Refresh: stateRefreshFuncVar(ctx, sync),
 		Timeout: timeout,
 	}
 
@@ -408,7 +470,10 @@ func WaitForStateRefresh(sync StatefulResource, timeout time.Duration, operation
 		stateConf.PollInterval = 1
 	}
 
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
 		handleMissingResourceError(sync, &e)
 		if _, ok := e.(*resource.UnexpectedStateError); ok {
 			if len(target) > 0 {
@@ -642,13 +707,13 @@ func NormalizeBoolString(v string) (string, error) {
 // WaitForResourceCondition polls on a resource, waiting for it to reach a specified condition. This is done with exponential
 // backoff. The terminating condition is specified as a boolean function; and this will return a timeout error if the
 // specified condition isn't reached within the specified timeout period.
-func WaitForResourceCondition(s ResourceFetcher, resourceChangedFunc func() bool, timeout time.Duration) error {
+func WaitForResourceCondition(ctx context.Context, s ResourceFetcher, resourceChangedFunc func() bool, timeout time.Duration) error {
 	backoffTime := time.Second
 	startTime := time.Now()
 	endTime := startTime.Add(timeout)
 	lastAttempt := false
 	for {
-		if err := s.Get(); err != nil {
+		if err := s.Get(ctx); err != nil {
 			return err
 		}
 
@@ -673,7 +738,9 @@ func WaitForResourceCondition(s ResourceFetcher, resourceChangedFunc func() bool
 			backoffTime = 10 * time.Millisecond
 		}
 
-		time.Sleep(backoffTime)
+		if err := sleepWithContext(ctx, backoffTime); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -692,12 +759,14 @@ func GetDataSourceItemSchema(resourceSchema *schema.Resource) *schema.Resource {
 	// as though they were resources.
 	resourceSchema.Create = nil
 	resourceSchema.Read = nil
+	resourceSchema.CreateContext = nil
+	resourceSchema.ReadContext = nil
 
 	return convertResFieldsToDSFields(resourceSchema)
 }
 
 // Get the Singular DataSource Schema from Resource Schema with additional fields and Read Function
-func GetSingularDataSourceItemSchema(resourceSchema *schema.Resource, addFieldMap map[string]*schema.Schema, readFunc schema.ReadFunc) *schema.Resource {
+func GetSingularDataSourceItemSchema(resourceSchema *schema.Resource, addFieldMap map[string]*schema.Schema, readFunc schema.ReadContextFunc) *schema.Resource {
 	if _, idExists := resourceSchema.Schema["id"]; !idExists {
 		resourceSchema.Schema["id"] = &schema.Schema{
 			Type:     schema.TypeString,
@@ -708,9 +777,13 @@ func GetSingularDataSourceItemSchema(resourceSchema *schema.Resource, addFieldMa
 	// Ensure Create,Read, Update and Delete are not set for data source schemas. Otherwise, terraform will validate them
 	// as though they were resources.
 	resourceSchema.Create = nil
+	resourceSchema.Read = nil
 	resourceSchema.Update = nil
 	resourceSchema.Delete = nil
-	resourceSchema.Read = readFunc
+	resourceSchema.CreateContext = nil
+	resourceSchema.UpdateContext = nil
+	resourceSchema.DeleteContext = nil
+	resourceSchema.ReadContext = readFunc
 	resourceSchema.Importer = nil
 	resourceSchema.Timeouts = nil
 	resourceSchema.CustomizeDiff = nil
@@ -756,7 +829,7 @@ func convertResourceFieldsToDatasourceFields(resourceSchema *schema.Resource) *s
 
 func GetRetryPolicyWithAdditionalRetryCondition(timeout time.Duration, retryConditionFunction func(oci_common.OCIOperationResponse) bool, service string) *oci_common.RetryPolicy {
 	startTime := time.Now()
-	return &oci_common.RetryPolicy{
+	return tf_client.WithAuditCallEnd(&oci_common.RetryPolicy{
 		ShouldRetryOperation: func(response oci_common.OCIOperationResponse) bool {
 			if ShouldRetryVar(response, false, service, startTime) {
 				return true
@@ -771,14 +844,14 @@ func GetRetryPolicyWithAdditionalRetryCondition(timeout time.Duration, retryCond
 			return GetRetryBackoffDuration(response, false, service, startTime)
 		},
 		MaximumNumberAttempts: 0,
-	}
+	})
 }
 
 func elaspedInMillisecond(start time.Time) int64 {
 	return time.Since(start).Nanoseconds() / int64(time.Millisecond)
 }
 
-func WaitForWorkRequestWithErrorHandling(workRequestClient workReqClient, workRequestIds *string, entityType string, action oci_work_requests.WorkRequestResourceActionTypeEnum,
+func WaitForWorkRequestWithErrorHandling(ctx context.Context, workRequestClient workReqClient, workRequestIds *string, entityType string, action oci_work_requests.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool) (*string, error) {
 	var identifier *string
 	workRequestIdsSet := map[string]bool{}
@@ -790,7 +863,7 @@ func WaitForWorkRequestWithErrorHandling(workRequestClient workReqClient, workRe
 	}
 
 	for wId := range workRequestIdsSet {
-		id, err := WaitForWorkRequestVar(workRequestClient, &wId, entityType, action, timeout, disableFoundRetries, true)
+		id, err := WaitForWorkRequestVar(ctx, workRequestClient, &wId, entityType, action, timeout, disableFoundRetries, true)
 		if err != nil {
 			return id, err
 		}
@@ -800,7 +873,9 @@ func WaitForWorkRequestWithErrorHandling(workRequestClient workReqClient, workRe
 
 }
 
-func WaitForWorkRequest(workRequestClient workReqClient, workRequestId *string, entityType string, action oci_work_requests.WorkRequestResourceActionTypeEnum,
+// WaitForWorkRequest polls the work request until it reaches a terminal state, the timeout elapses or ctx is
+// cancelled, whichever happens first.
+func WaitForWorkRequest(ctx context.Context, workRequestClient workReqClient, workRequestId *string, entityType string, action oci_work_requests.WorkRequestResourceActionTypeEnum,
 	timeout time.Duration, disableFoundRetries bool, expectIdentifier bool) (*string, error) {
 	retryPolicy := GetRetryPolicy(disableFoundRetries, "work_request")
 	retryPolicy.ShouldRetryOperation = workRequestShouldRetryFunc(timeout)
@@ -819,7 +894,7 @@ func WaitForWorkRequest(workRequestClient workReqClient, workRequestId *string,
 		},
 		Refresh: func() (interface{}, string, error) {
 			var err error
-			response, err = workRequestClient.GetWorkRequest(context.Background(),
+			response, err = workRequestClient.GetWorkRequest(ctx,
 				oci_work_requests.GetWorkRequestRequest{
 					WorkRequestId: workRequestId,
 					RequestMetadata: oci_common.RequestMetadata{
@@ -834,7 +909,10 @@ func WaitForWorkRequest(workRequestClient workReqClient, workRequestId *string,
 
 	var identifier *string
 
-	if _, e := stateConf.WaitForState(); e != nil {
+	if _, e := stateConf.WaitForStateContext(ctx); e != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
 		for _, res := range response.Resources {
 			if strings.Contains(strings.ToLower(*res.EntityType), strings.ToLower(entityType)) {
 				if res.Identifier != nil {
@@ -862,7 +940,10 @@ func WaitForWorkRequest(workRequestClient workReqClient, workRequestId *string,
 			return nil, fmt.Errorf("work request succeeded but no identifier was found, workId: %s, entity: %s, action: %s",
 				*workRequestId, entityType, action)
 		}
-		return nil, getWorkRequestErrorsVar(workRequestClient, workRequestId, retryPolicy, entityType, action)
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, getWorkRequestErrorsVar(ctx, workRequestClient, workRequestId, retryPolicy, entityType, action)
 	}
 
 	return identifier, nil
@@ -891,8 +972,8 @@ func workRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.
 	}
 }
 
-func getWorkRequestErrors(workRequestClient workReqClient, workRequestId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_work_requests.WorkRequestResourceActionTypeEnum) error {
-	response, err := workRequestClient.ListWorkRequestErrors(context.Background(), oci_work_requests.ListWorkRequestErrorsRequest{
+func getWorkRequestErrors(ctx context.Context, workRequestClient workReqClient, workRequestId *string, retryPolicy *oci_common.RetryPolicy, entityType string, action oci_work_requests.WorkRequestResourceActionTypeEnum) error {
+	response, err := workRequestClient.ListWorkRequestErrors(ctx, oci_work_requests.ListWorkRequestErrorsRequest{
 		WorkRequestId: workRequestId,
 		RequestMetadata: oci_common.RequestMetadata{
 			RetryPolicy: retryPolicy,
@@ -13,6 +13,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -46,7 +47,7 @@ type ResourceCrud struct {
 	extraWaitPostCreateDelete
 }
 
-func (b ResourceCrud) Create() error {
+func (b ResourceCrud) Create(ctx context.Context) error {
 	if b.id == "4" {
 		return errors.New("")
 	}
@@ -68,7 +69,7 @@ func (b ResourceCrud) State() string {
 	}
 	return "FAILED"
 }
-func (b ResourceCrud) Get() error {
+func (b ResourceCrud) Get(ctx context.Context) error {
 	if b.id == "2" {
 		return errors.New("")
 	}
@@ -108,7 +109,7 @@ type readResourceCrud struct {
 	statefullyDeletedResource
 }
 
-func (b readResourceCrud) Create() error {
+func (b readResourceCrud) Create(ctx context.Context) error {
 	return nil
 }
 func (b readResourceCrud) ID() string {
@@ -121,7 +122,7 @@ func (b readResourceCrud) VoidState() {}
 func (b readResourceCrud) State() string {
 	return "FAILED"
 }
-func (b readResourceCrud) Get() error {
+func (b readResourceCrud) Get(ctx context.Context) error {
 	return nil
 }
 func (b readResourceCrud) DeletedPending() []string {
@@ -148,7 +149,7 @@ type updateResourceCrud struct {
 	statefullyUpdatedResource
 }
 
-func (b updateResourceCrud) Update() error {
+func (b updateResourceCrud) Update(ctx context.Context) error {
 	return nil
 }
 func (b updateResourceCrud) ID() string {
@@ -161,7 +162,7 @@ func (b updateResourceCrud) VoidState() {}
 func (b updateResourceCrud) State() string {
 	return "FAILED"
 }
-func (b updateResourceCrud) Get() error {
+func (b updateResourceCrud) Get(ctx context.Context) error {
 	return nil
 }
 func (b updateResourceCrud) GetMutex() *sync.Mutex {
@@ -187,7 +188,7 @@ type deleteResourceCrud struct {
 	extraWaitPostDelete
 }
 
-func (b deleteResourceCrud) Delete() error {
+func (b deleteResourceCrud) Delete(ctx context.Context) error {
 	return nil
 }
 func (b deleteResourceCrud) ID() string {
@@ -200,7 +201,7 @@ func (b deleteResourceCrud) VoidState() {}
 func (b deleteResourceCrud) State() string {
 	return "FAILED"
 }
-func (b deleteResourceCrud) Get() error {
+func (b deleteResourceCrud) Get(ctx context.Context) error {
 	return nil
 }
 func (b deleteResourceCrud) GetMutex() *sync.Mutex {
@@ -299,7 +300,7 @@ type TestResource struct {
 	ActualGetAttempts int
 }
 
-func (t *TestResource) Get() error {
+func (t *TestResource) Get(ctx context.Context) error {
 	t.ActualGetAttempts++
 	t.GetAttempts--
 	if t.GetAttempts <= 0 {
@@ -445,7 +446,7 @@ func TestUnitGetSingularDataSourceItemSchema(t *testing.T) {
 		},
 		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error { return nil },
 	}
-	reqReadFunc := func(d *schema.ResourceData, m interface{}) error { return nil }
+	reqReadFunc := func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics { return nil }
 	reqAddFieldMap := map[string]*schema.Schema{
 		"foo": {
 			Computed: true,
@@ -458,7 +459,7 @@ func TestUnitGetSingularDataSourceItemSchema(t *testing.T) {
 			},
 		},
 		Create:        nil,
-		Read:          reqReadFunc,
+		ReadContext:   reqReadFunc,
 		Update:        nil,
 		Delete:        nil,
 		Importer:      nil,
@@ -471,7 +472,7 @@ func TestUnitGetSingularDataSourceItemSchema(t *testing.T) {
 	type args struct {
 		resourceSchema *schema.Resource
 		addFieldMap    map[string]*schema.Schema
-		readFunc       schema.ReadFunc
+		readFunc       schema.ReadContextFunc
 	}
 	type testFormat struct {
 		name   string
@@ -979,7 +980,7 @@ func TestUnitCreateResource(t *testing.T) {
 			args:     args{sync: s, d: reqResourceData},
 			gotError: true,
 			mockFunc: func() {
-				waitForStateRefreshVar = func(sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
 					return errors.New("default")
 					//return nil
 				}
@@ -990,7 +991,7 @@ func TestUnitCreateResource(t *testing.T) {
 			args:     args{sync: s, d: reqResourceData},
 			gotError: false,
 			mockFunc: func() {
-				waitForStateRefreshVar = func(sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
 					//return errors.New("default")
 					return nil
 				}
@@ -1000,7 +1001,7 @@ func TestUnitCreateResource(t *testing.T) {
 	for _, test := range tests {
 		t.Logf("Running %s", test.name)
 		test.mockFunc()
-		if res := CreateResource(test.args.d, test.args.sync); (res != nil) != test.gotError {
+		if res := CreateResource(context.Background(), test.args.d, test.args.sync); (res != nil) != test.gotError {
 			t.Errorf("Output error - %q which is not equal to expected error - %t", res, test.gotError)
 		}
 	}
@@ -1028,7 +1029,7 @@ func TestUnitReadResource(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Logf("Running %s", test.name)
-		if res := ReadResource(test.args.sync); (res != nil) != test.gotError {
+		if res := ReadResource(context.Background(), test.args.sync); (res != nil) != test.gotError {
 			t.Errorf("Output error - %q which is not equal to expected error - %t", res, test.gotError)
 		}
 	}
@@ -1055,7 +1056,7 @@ func TestUnitUpdateResource(t *testing.T) {
 			args:     args{sync: s, d: reqResourceData},
 			gotError: true,
 			mockFunc: func() {
-				waitForStateRefreshVar = func(sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
 					return errors.New("default")
 				}
 			},
@@ -1065,7 +1066,7 @@ func TestUnitUpdateResource(t *testing.T) {
 			args:     args{sync: s, d: reqResourceData},
 			gotError: false,
 			mockFunc: func() {
-				waitForStateRefreshVar = func(sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
 					return nil
 				}
 			},
@@ -1074,7 +1075,7 @@ func TestUnitUpdateResource(t *testing.T) {
 	for _, test := range tests {
 		t.Logf("Running %s", test.name)
 		test.mockFunc()
-		if res := UpdateResource(test.args.d, test.args.sync); (res != nil) != test.gotError {
+		if res := UpdateResource(context.Background(), test.args.d, test.args.sync); (res != nil) != test.gotError {
 			t.Errorf("Output error - %q which is not equal to expected error - %t", res, test.gotError)
 		}
 	}
@@ -1101,7 +1102,7 @@ func TestUnitDeleteResource(t *testing.T) {
 			args:     args{sync: s, d: reqResourceData},
 			gotError: true,
 			mockFunc: func() {
-				waitForStateRefreshVar = func(sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
 					return errors.New("default")
 				}
 			},
@@ -1111,7 +1112,7 @@ func TestUnitDeleteResource(t *testing.T) {
 			args:     args{sync: s, d: reqResourceData},
 			gotError: false,
 			mockFunc: func() {
-				waitForStateRefreshVar = func(sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
 					return nil
 				}
 			},
@@ -1120,7 +1121,7 @@ func TestUnitDeleteResource(t *testing.T) {
 	for _, test := range tests {
 		t.Logf("Running %s", test.name)
 		test.mockFunc()
-		if res := DeleteResource(test.args.d, test.args.sync); (res != nil) != test.gotError {
+		if res := DeleteResource(context.Background(), test.args.d, test.args.sync); (res != nil) != test.gotError {
 			t.Errorf("Output error - %q which is not equal to expected error - %t", res, test.gotError)
 		}
 	}
@@ -1147,7 +1148,7 @@ func TestUnitWaitForUpdatedState(t *testing.T) {
 			args:     args{sync: s, d: reqResourceData},
 			gotError: true,
 			mockFunc: func() {
-				waitForStateRefreshVar = func(sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
 					return errors.New("default")
 				}
 			},
@@ -1157,7 +1158,7 @@ func TestUnitWaitForUpdatedState(t *testing.T) {
 			args:     args{sync: s, d: reqResourceData},
 			gotError: false,
 			mockFunc: func() {
-				waitForStateRefreshVar = func(sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
 					return nil
 				}
 			},
@@ -1166,7 +1167,7 @@ func TestUnitWaitForUpdatedState(t *testing.T) {
 	for _, test := range tests {
 		t.Logf("Running %s", test.name)
 		test.mockFunc()
-		if res := WaitForUpdatedState(test.args.d, test.args.sync); (res != nil) != test.gotError {
+		if res := WaitForUpdatedState(context.Background(), test.args.d, test.args.sync); (res != nil) != test.gotError {
 			t.Errorf("Output error - %q which is not equal to expected error - %t", res, test.gotError)
 		}
 	}
@@ -1193,7 +1194,7 @@ func TestUnitWaitForCreatedState(t *testing.T) {
 			args:     args{sync: s, d: reqResourceData},
 			gotError: true,
 			mockFunc: func() {
-				waitForStateRefreshVar = func(sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
 					return errors.New("default")
 				}
 			},
@@ -1203,7 +1204,7 @@ func TestUnitWaitForCreatedState(t *testing.T) {
 			args:     args{sync: s, d: reqResourceData},
 			gotError: false,
 			mockFunc: func() {
-				waitForStateRefreshVar = func(sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
+				waitForStateRefreshVar = func(ctx context.Context, sr StatefulResource, timeout time.Duration, operationName string, pending []string, target []string) error {
 					return nil
 				}
 			},
@@ -1212,7 +1213,7 @@ func TestUnitWaitForCreatedState(t *testing.T) {
 	for _, test := range tests {
 		t.Logf("Running %s", test.name)
 		test.mockFunc()
-		if res := WaitForCreatedState(test.args.d, test.args.sync); (res != nil) != test.gotError {
+		if res := WaitForCreatedState(context.Background(), test.args.d, test.args.sync); (res != nil) != test.gotError {
 			t.Errorf("Output error - %q which is not equal to expected error - %t", res, test.gotError)
 		}
 	}
@@ -1238,7 +1239,7 @@ func TestUnitWaitForStateRefresh(t *testing.T) {
 			args:     args{sync: &ResourceCrud{D: &mockResourceData{}, id: "1"}, timeout: time.Second, operationName: "", pending: []string{}, target: []string{"SUCCEEDED"}},
 			gotError: false,
 			mockFunc: func() {
-				stateRefreshFuncVar = func(sync StatefulResource) resource.StateRefreshFunc {
+				stateRefreshFuncVar = func(ctx context.Context, sync StatefulResource) resource.StateRefreshFunc {
 					return func() (res interface{}, s string, e error) {
 						wr := oci_work_requests.WorkRequest{Status: "SUCCEEDED", Resources: []oci_work_requests.WorkRequestResource{{EntityType: nil, Identifier: nil, ActionType: "CREATED"}}}
 						return wr, string(wr.Status), nil
@@ -1251,7 +1252,7 @@ func TestUnitWaitForStateRefresh(t *testing.T) {
 			args:     args{sync: &ResourceCrud{D: &mockResourceData{}}, timeout: time.Second, operationName: "", pending: []string{}, target: []string{"FAILED"}},
 			gotError: true,
 			mockFunc: func() {
-				stateRefreshFuncVar = func(sync StatefulResource) resource.StateRefreshFunc {
+				stateRefreshFuncVar = func(ctx context.Context, sync StatefulResource) resource.StateRefreshFunc {
 					return func() (res interface{}, s string, e error) {
 						wr := oci_work_requests.WorkRequest{Status: "FAILED", Resources: []oci_work_requests.WorkRequestResource{{EntityType: nil, Identifier: nil, ActionType: "CREATED"}}}
 						return wr, string(wr.Status), nil
@@ -1264,7 +1265,7 @@ func TestUnitWaitForStateRefresh(t *testing.T) {
 			args:     args{sync: &ResourceCrud{D: &mockResourceData{}}, timeout: time.Second, operationName: "", pending: []string{"A"}, target: []string{"A"}},
 			gotError: true,
 			mockFunc: func() {
-				stateRefreshFuncVar = func(sync StatefulResource) resource.StateRefreshFunc {
+				stateRefreshFuncVar = func(ctx context.Context, sync StatefulResource) resource.StateRefreshFunc {
 					return func() (res interface{}, s string, e error) {
 						wr := oci_work_requests.WorkRequest{Status: "ABC", Resources: []oci_work_requests.WorkRequestResource{{EntityType: nil, Identifier: nil, ActionType: "CREATED"}}}
 						return wr, string(wr.Status), nil
@@ -1277,7 +1278,7 @@ func TestUnitWaitForStateRefresh(t *testing.T) {
 			args:     args{sync: &ResourceCrud{D: &mockResourceData{}}, timeout: time.Second, operationName: "", pending: []string{"A"}, target: []string{}},
 			gotError: true,
 			mockFunc: func() {
-				stateRefreshFuncVar = func(sync StatefulResource) resource.StateRefreshFunc {
+				stateRefreshFuncVar = func(ctx context.Context, sync StatefulResource) resource.StateRefreshFunc {
 					return func() (res interface{}, s string, e error) {
 						wr := oci_work_requests.WorkRequest{Status: "ABC", Resources: []oci_work_requests.WorkRequestResource{{EntityType: nil, Identifier: nil, ActionType: "CREATED"}}}
 						return wr, string(wr.Status), nil
@@ -1290,7 +1291,7 @@ func TestUnitWaitForStateRefresh(t *testing.T) {
 			args:     args{sync: &ResourceCrud{D: &mockResourceData{}}, timeout: 0, operationName: "", pending: []string{}, target: []string{}},
 			gotError: true,
 			mockFunc: func() {
-				stateRefreshFuncVar = func(sync StatefulResource) resource.StateRefreshFunc {
+				stateRefreshFuncVar = func(ctx context.Context, sync StatefulResource) resource.StateRefreshFunc {
 					return func() (res interface{}, s string, e error) {
 						wr := oci_work_requests.WorkRequest{Status: "ABC", Resources: []oci_work_requests.WorkRequestResource{{EntityType: nil, Identifier: nil, ActionType: "CREATED"}}}
 						return wr, string(wr.Status), nil
@@ -1302,7 +1303,7 @@ func TestUnitWaitForStateRefresh(t *testing.T) {
 	for _, test := range tests {
 		t.Log("Running ", test.name)
 		test.mockFunc()
-		if res := WaitForStateRefresh(test.args.sync, test.args.timeout, test.args.operationName, test.args.pending, test.args.target); (res != nil) != test.gotError {
+		if res := WaitForStateRefresh(context.Background(), test.args.sync, test.args.timeout, test.args.operationName, test.args.pending, test.args.target); (res != nil) != test.gotError {
 			t.Log(res)
 			t.Errorf("Output error - %q which is not equal to expected error - %t", res, test.gotError)
 		}
@@ -1336,7 +1337,7 @@ func TestUnitWaitForWorkRequestWithErrorHandling(t *testing.T) {
 			args:   args{workRequestClient: nil, workRequestIds: &workReqIds, entityType: "", action: "", timeout: timeoutDuration, disableFoundRetries: false},
 			output: output{identifier: "test", gotError: false},
 			mockFunc: func() {
-				WaitForWorkRequestVar = func(wrc workReqClient, wId *string, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum, tt time.Duration, dfr bool, ei bool) (*string, error) {
+				WaitForWorkRequestVar = func(ctx context.Context, wrc workReqClient, wId *string, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum, tt time.Duration, dfr bool, ei bool) (*string, error) {
 					id := "test"
 					return &id, nil
 				}
@@ -1347,7 +1348,7 @@ func TestUnitWaitForWorkRequestWithErrorHandling(t *testing.T) {
 			args:   args{workRequestClient: nil, workRequestIds: &workReqIds, entityType: "", action: "", timeout: timeoutDuration, disableFoundRetries: false},
 			output: output{identifier: "test", gotError: true},
 			mockFunc: func() {
-				WaitForWorkRequestVar = func(wrc workReqClient, wId *string, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum, tt time.Duration, dfr bool, ei bool) (*string, error) {
+				WaitForWorkRequestVar = func(ctx context.Context, wrc workReqClient, wId *string, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum, tt time.Duration, dfr bool, ei bool) (*string, error) {
 					id := "test"
 					return &id, errors.New("default")
 				}
@@ -1357,7 +1358,7 @@ func TestUnitWaitForWorkRequestWithErrorHandling(t *testing.T) {
 	for _, test := range tests {
 		t.Logf("Running %s", test.name)
 		test.mockFunc()
-		id, err := WaitForWorkRequestWithErrorHandling(test.args.workRequestClient, test.args.workRequestIds, test.args.entityType, test.args.action, test.args.timeout, test.args.disableFoundRetries)
+		id, err := WaitForWorkRequestWithErrorHandling(context.Background(), test.args.workRequestClient, test.args.workRequestIds, test.args.entityType, test.args.action, test.args.timeout, test.args.disableFoundRetries)
 		if (err != nil) != test.output.gotError {
 			t.Errorf("Output error - %q which is not equal to expected error - %t", err, test.output.gotError)
 		}
@@ -1396,7 +1397,7 @@ func TestUnitWaitForWorkRequest(t *testing.T) {
 			args:      args{workRequestClient: nil, entityType: "", action: "CREATED", timeout: timeoutDuration, disableFoundRetries: false, expectIdentifier: true},
 			output:    output{identifier: "oci", gotError: false},
 			mockFunc: func() {
-				getWorkRequestErrorsVar = func(wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
+				getWorkRequestErrorsVar = func(_ context.Context, wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
 					return nil
 				}
 			},
@@ -1407,7 +1408,7 @@ func TestUnitWaitForWorkRequest(t *testing.T) {
 			args:      args{workRequestClient: nil, entityType: "default", action: "CREATED", timeout: timeoutDuration, disableFoundRetries: false, expectIdentifier: true},
 			output:    output{identifier: "", gotError: true},
 			mockFunc: func() {
-				getWorkRequestErrorsVar = func(wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
+				getWorkRequestErrorsVar = func(_ context.Context, wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
 					return errors.New("")
 				}
 			},
@@ -1418,7 +1419,7 @@ func TestUnitWaitForWorkRequest(t *testing.T) {
 			args:      args{workRequestClient: nil, entityType: "default", action: "", timeout: timeoutDuration, disableFoundRetries: false, expectIdentifier: true},
 			output:    output{identifier: "", gotError: true},
 			mockFunc: func() {
-				getWorkRequestErrorsVar = func(wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
+				getWorkRequestErrorsVar = func(_ context.Context, wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
 					return errors.New("")
 				}
 			},
@@ -1429,7 +1430,7 @@ func TestUnitWaitForWorkRequest(t *testing.T) {
 			args:      args{workRequestClient: nil, entityType: "default", action: "", timeout: timeoutDuration, disableFoundRetries: false, expectIdentifier: true},
 			output:    output{identifier: "", gotError: true},
 			mockFunc: func() {
-				getWorkRequestErrorsVar = func(wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
+				getWorkRequestErrorsVar = func(_ context.Context, wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
 					return errors.New("")
 				}
 			},
@@ -1438,7 +1439,7 @@ func TestUnitWaitForWorkRequest(t *testing.T) {
 	for _, test := range tests {
 		t.Log("Running ", test.name)
 		test.mockFunc()
-		id, err := WaitForWorkRequest(test.args.workRequestClient, &test.workReqId, test.args.entityType, test.args.action, test.args.timeout, test.args.disableFoundRetries, test.args.expectIdentifier)
+		id, err := WaitForWorkRequest(context.Background(), test.args.workRequestClient, &test.workReqId, test.args.entityType, test.args.action, test.args.timeout, test.args.disableFoundRetries, test.args.expectIdentifier)
 		if id != nil && *id != test.output.identifier {
 			t.Log(*id)
 			t.Errorf("Output identifier - %s not equal to expected identifier - %s", *id, test.output.identifier)
@@ -1472,7 +1473,7 @@ func TestUnitgetWorkRequestErrors(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Logf("Running %s", test.name)
-		if res := getWorkRequestErrors(test.args.workRequestClient, test.args.workRequestId, test.args.retryPolicy, test.args.entityType, test.args.action); (res != nil) != test.output {
+		if res := getWorkRequestErrors(context.Background(), test.args.workRequestClient, test.args.workRequestId, test.args.retryPolicy, test.args.entityType, test.args.action); (res != nil) != test.output {
 			t.Errorf("Output error - %q which is not equal to expected error - %t", res, test.output)
 		}
 	}
@@ -1504,13 +1505,13 @@ func TestUnitwaitForStateRefreshForHybridPolling(t *testing.T) {
 			args:   args{workRequestClient: nil, workRequestIds: &workReqIds, entityType: "", action: "CREATED", disableFoundRetries: false, sync: &ResourceCrud{id: "1"}, timeout: time.Second, operationName: "default", pending: []string{}, target: []string{"SUCCEEDED"}},
 			output: false,
 			mockFunc: func() {
-				stateRefreshFuncVar = func(sync StatefulResource) resource.StateRefreshFunc {
+				stateRefreshFuncVar = func(ctx context.Context, sync StatefulResource) resource.StateRefreshFunc {
 					return func() (res interface{}, s string, e error) {
 						wr := oci_work_requests.WorkRequest{Status: "SUCCEEDED", Resources: []oci_work_requests.WorkRequestResource{{EntityType: nil, Identifier: nil, ActionType: "CREATED"}}}
 						return wr, string(wr.Status), nil
 					}
 				}
-				getWorkRequestErrorsVar = func(wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
+				getWorkRequestErrorsVar = func(_ context.Context, wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
 					return errors.New("")
 				}
 			},
@@ -1520,13 +1521,13 @@ func TestUnitwaitForStateRefreshForHybridPolling(t *testing.T) {
 			args:   args{workRequestClient: nil, workRequestIds: &workReqIds, entityType: "", action: "CREATED", disableFoundRetries: false, sync: &ResourceCrud{}, timeout: time.Second, operationName: "default", pending: []string{}, target: []string{"FAILED"}},
 			output: true,
 			mockFunc: func() {
-				stateRefreshFuncVar = func(sync StatefulResource) resource.StateRefreshFunc {
+				stateRefreshFuncVar = func(ctx context.Context, sync StatefulResource) resource.StateRefreshFunc {
 					return func() (res interface{}, s string, e error) {
 						wr := oci_work_requests.WorkRequest{Status: "FAILED", Resources: []oci_work_requests.WorkRequestResource{{EntityType: nil, Identifier: nil, ActionType: "CREATED"}}}
 						return wr, string(wr.Status), nil
 					}
 				}
-				getWorkRequestErrorsVar = func(wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
+				getWorkRequestErrorsVar = func(_ context.Context, wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
 					return errors.New("")
 				}
 			},
@@ -1536,13 +1537,13 @@ func TestUnitwaitForStateRefreshForHybridPolling(t *testing.T) {
 			args:   args{workRequestClient: nil, workRequestIds: &workReqIds, entityType: "", action: "CREATED", disableFoundRetries: false, sync: &ResourceCrud{}, timeout: time.Second, operationName: "default", pending: []string{"A"}, target: []string{"A"}},
 			output: true,
 			mockFunc: func() {
-				stateRefreshFuncVar = func(sync StatefulResource) resource.StateRefreshFunc {
+				stateRefreshFuncVar = func(ctx context.Context, sync StatefulResource) resource.StateRefreshFunc {
 					return func() (res interface{}, s string, e error) {
 						wr := oci_work_requests.WorkRequest{Status: "ABC", Resources: []oci_work_requests.WorkRequestResource{{EntityType: nil, Identifier: nil, ActionType: "CREATED"}}}
 						return wr, string(wr.Status), nil
 					}
 				}
-				getWorkRequestErrorsVar = func(wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
+				getWorkRequestErrorsVar = func(_ context.Context, wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
 					return errors.New("")
 				}
 			},
@@ -1552,13 +1553,13 @@ func TestUnitwaitForStateRefreshForHybridPolling(t *testing.T) {
 			args:   args{workRequestClient: nil, workRequestIds: &workReqIds, entityType: "", action: "CREATED", disableFoundRetries: false, sync: &ResourceCrud{}, timeout: 0, operationName: "default", pending: []string{}, target: []string{}},
 			output: true,
 			mockFunc: func() {
-				stateRefreshFuncVar = func(sync StatefulResource) resource.StateRefreshFunc {
+				stateRefreshFuncVar = func(ctx context.Context, sync StatefulResource) resource.StateRefreshFunc {
 					return func() (res interface{}, s string, e error) {
 						wr := oci_work_requests.WorkRequest{Status: "ABC", Resources: []oci_work_requests.WorkRequestResource{{EntityType: nil, Identifier: nil, ActionType: "CREATED"}}}
 						return wr, string(wr.Status), nil
 					}
 				}
-				getWorkRequestErrorsVar = func(wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
+				getWorkRequestErrorsVar = func(_ context.Context, wrc workReqClient, wId *string, rp *oci_common.RetryPolicy, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum) error {
 					return errors.New("")
 				}
 			},
@@ -1567,7 +1568,7 @@ func TestUnitwaitForStateRefreshForHybridPolling(t *testing.T) {
 	for _, test := range tests {
 		t.Log("Running ", test.name)
 		test.mockFunc()
-		if res := waitForStateRefreshForHybridPolling(test.args.workRequestClient, test.args.workRequestIds, test.args.entityType, test.args.action, test.args.disableFoundRetries, test.args.sync, test.args.timeout, test.args.operationName, test.args.pending, test.args.target); (res != nil) != test.output {
+		if res := waitForStateRefreshForHybridPolling(context.Background(), test.args.workRequestClient, test.args.workRequestIds, test.args.entityType, test.args.action, test.args.disableFoundRetries, test.args.sync, test.args.timeout, test.args.operationName, test.args.pending, test.args.target); (res != nil) != test.output {
 			t.Errorf("Output error - %q which is not equal to expected error - %t", res, test.output)
 		}
 	}
@@ -1601,7 +1602,7 @@ func TestUnitstateRefreshFunc(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Log("Running ", test.name)
-		if _, _, err := stateRefreshFunc(test.args.sync)(); (err != nil) != test.output {
+		if _, _, err := stateRefreshFunc(context.Background(), test.args.sync)(); (err != nil) != test.output {
 			t.Errorf("Output error - %q which is not equal to expected error - %t", err, test.output)
 		}
 	}
@@ -1632,7 +1633,7 @@ func TestUnitResourceRefreshForHybridPolling(t *testing.T) {
 			args:   args{workRequestClient: nil, workRequestIds: &workReqIds, entityType: "", action: "CREATED", disableFoundRetries: false, d: reqResourceData, sync: s},
 			output: true,
 			mockFunc: func() {
-				waitForStateRefreshForHybridPollingVar = func(wrc workReqClient, wIds *string, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum, dfr bool, s StatefulResource, tt time.Duration, on string, p []string, t []string) error {
+				waitForStateRefreshForHybridPollingVar = func(ctx context.Context, wrc workReqClient, wIds *string, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum, dfr bool, s StatefulResource, tt time.Duration, on string, p []string, t []string) error {
 					return errors.New("")
 				}
 			},
@@ -1642,7 +1643,7 @@ func TestUnitResourceRefreshForHybridPolling(t *testing.T) {
 			args:   args{workRequestClient: nil, workRequestIds: &workReqIds, entityType: "", action: "CREATED", disableFoundRetries: false, d: reqResourceData, sync: s},
 			output: false,
 			mockFunc: func() {
-				waitForStateRefreshForHybridPollingVar = func(wrc workReqClient, wIds *string, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum, dfr bool, s StatefulResource, tt time.Duration, on string, p []string, t []string) error {
+				waitForStateRefreshForHybridPollingVar = func(ctx context.Context, wrc workReqClient, wIds *string, et string, a oci_work_requests.WorkRequestResourceActionTypeEnum, dfr bool, s StatefulResource, tt time.Duration, on string, p []string, t []string) error {
 					return nil
 				}
 			},
@@ -1651,7 +1652,7 @@ func TestUnitResourceRefreshForHybridPolling(t *testing.T) {
 	for _, test := range tests {
 		t.Logf("Running %s", test.name)
 		test.mockFunc()
-		if res := ResourceRefreshForHybridPolling(test.args.workRequestClient, test.args.workRequestIds, test.args.entityType, test.args.action, test.args.disableFoundRetries, test.args.d, test.args.sync); (res != nil) != test.output {
+		if res := ResourceRefreshForHybridPolling(context.Background(), test.args.workRequestClient, test.args.workRequestIds, test.args.entityType, test.args.action, test.args.disableFoundRetries, test.args.d, test.args.sync); (res != nil) != test.output {
 			t.Errorf("Output error - %q which is not equal to expected error - %t", res, test.output)
 		}
 	}
@@ -1692,7 +1693,7 @@ func TestUnitCreateResourceUsingHybridPolling(t *testing.T) {
 	for _, test := range tests {
 		t.Log("Running ", test.name)
 		test.mockFunc()
-		if res := CreateResourceUsingHybridPolling(test.args.sync); (res != nil) != test.output {
+		if res := CreateResourceUsingHybridPolling(context.Background(), test.args.sync); (res != nil) != test.output {
 			t.Errorf("Output error - %q which is not equal to expected error - %t", res, test.output)
 		}
 	}
@@ -1914,7 +1915,7 @@ func TestUnitWaitForResourceCondition_basic(t *testing.T) {
 	}
 
 	// Test normal case
-	err := WaitForResourceCondition(testResource, finalStateFunc, 0)
+	err := WaitForResourceCondition(context.Background(), testResource, finalStateFunc, 0)
 	if err != nil {
 		t.Errorf("Got unexpected error '%q' from single attempt", err)
 		return
@@ -1922,7 +1923,7 @@ func TestUnitWaitForResourceCondition_basic(t *testing.T) {
 
 	// Test normal case with multiple attempts
 	testResource = &TestResource{GetError: nil, GetAttempts: 3}
-	err = WaitForResourceCondition(testResource, finalStateFunc, time.Minute)
+	err = WaitForResourceCondition(context.Background(), testResource, finalStateFunc, time.Minute)
 	if err != nil {
 		t.Errorf("Got unexpected error '%q' from multiple attempts", err)
 		return
@@ -1930,7 +1931,7 @@ func TestUnitWaitForResourceCondition_basic(t *testing.T) {
 
 	// Test case where Get returns error after 1 attempt
 	testResource = &TestResource{GetError: fmt.Errorf("GetError"), GetAttempts: 1}
-	err = WaitForResourceCondition(testResource, finalStateFunc, 0)
+	err = WaitForResourceCondition(context.Background(), testResource, finalStateFunc, 0)
 	if err == nil || !strings.HasPrefix(err.Error(), "GetError") {
 		t.Errorf("Got unexpected error '%q' after single attempt, expected a GetError", err)
 		return
@@ -1938,7 +1939,7 @@ func TestUnitWaitForResourceCondition_basic(t *testing.T) {
 
 	// Test case where Get returns error after multiple attempts
 	testResource = &TestResource{GetError: fmt.Errorf("GetError"), GetAttempts: 3}
-	err = WaitForResourceCondition(testResource, finalStateFunc, time.Minute)
+	err = WaitForResourceCondition(context.Background(), testResource, finalStateFunc, time.Minute)
 	if err == nil || !strings.HasPrefix(err.Error(), "GetError") {
 		t.Errorf("Got unexpected error '%q' after multiple attempts, expected a GetError", err)
 		return
@@ -1946,7 +1947,7 @@ func TestUnitWaitForResourceCondition_basic(t *testing.T) {
 
 	// Test timing out with zero timeout duration
 	testResource = &TestResource{GetError: nil, GetAttempts: 10}
-	err = WaitForResourceCondition(testResource, finalStateFunc, 0)
+	err = WaitForResourceCondition(context.Background(), testResource, finalStateFunc, 0)
 	if err == nil || !strings.HasPrefix(err.Error(), "Timed out") {
 		t.Errorf("Got unexpected error '%q' after a single attempt, expected a timeout error", err)
 		return
@@ -1954,7 +1955,7 @@ func TestUnitWaitForResourceCondition_basic(t *testing.T) {
 
 	// Test timing out with non-zero timeout duration, also validate that we got expected number of Get attempts due to exponential backoff
 	testResource = &TestResource{GetError: nil, GetAttempts: 10}
-	err = WaitForResourceCondition(testResource, finalStateFunc, 20*time.Second)
+	err = WaitForResourceCondition(context.Background(), testResource, finalStateFunc, 20*time.Second)
 	if err == nil || !strings.HasPrefix(err.Error(), "Timed out") {
 		t.Errorf("Got unexpected error '%q' after a single attempt, expected a timeout error", err)
 		return
@@ -2374,3 +2375,103 @@ func TestUnitValidateNotEmptyString(t *testing.T) {
 		ValidateNotEmptyString()(test.args.i, test.args.k)
 	}
 }
+
+type contextResourceCrud struct {
+	D     *mockResourceData
+	state string
+}
+
+func (b *contextResourceCrud) Create(ctx context.Context) error {
+	return ctx.Err()
+}
+func (b *contextResourceCrud) Get(ctx context.Context) error {
+	return ctx.Err()
+}
+func (b *contextResourceCrud) ID() string {
+	return "1"
+}
+func (b *contextResourceCrud) SetData() error {
+	return nil
+}
+func (b *contextResourceCrud) VoidState() {}
+func (b *contextResourceCrud) State() string {
+	return b.state
+}
+func (b *contextResourceCrud) setState(s StatefulResource) error {
+	return nil
+}
+func (b *contextResourceCrud) CreatedPending() []string {
+	return []string{"PROVISIONING"}
+}
+func (b *contextResourceCrud) CreatedTarget() []string {
+	return []string{"AVAILABLE"}
+}
+
+func TestUnitCreateResourceCancelledContext(t *testing.T) {
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	type testFormat struct {
+		name     string
+		ctx      context.Context
+		state    string
+		gotError bool
+	}
+	tests := []testFormat{
+		{
+			name:     "Test no error is returned",
+			ctx:      context.Background(),
+			state:    "AVAILABLE",
+			gotError: false,
+		},
+		{
+			name:     "Test cancelled context error is returned",
+			ctx:      cancelledCtx,
+			state:    "PROVISIONING",
+			gotError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Logf("Running %s", test.name)
+		s := &contextResourceCrud{D: &mockResourceData{}, state: test.state}
+		if res := CreateResource(test.ctx, s.D, s); (res != nil) != test.gotError {
+			t.Errorf("Output error - %q which is not equal to expected error - %t", res, test.gotError)
+		}
+	}
+}
+
+func TestUnitWaitForStateRefreshContextDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	// Get succeeds until the deadline, the resource never leaves the pending state
+	s := &contextResourceCrud{D: &mockResourceData{}, state: "PROVISIONING"}
+	start := time.Now()
+	err := WaitForStateRefresh(ctx, s, time.Hour, "creation", s.CreatedPending(), s.CreatedTarget())
+	if err == nil {
+		t.Errorf("Expected an error when the context deadline is exceeded")
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("WaitForStateRefresh did not honor the context deadline, took %v", elapsed)
+	}
+}
+
+func TestUnitWaitForWorkRequestCancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	workReqId := "2"
+	_, err := WaitForWorkRequest(ctx, &mockWorkRequestClient{}, &workReqId, "default", "CREATED", time.Hour, false, true)
+	if err != context.Canceled {
+		t.Errorf("Output error - %q which is not equal to expected error - %q", err, context.Canceled)
+	}
+}
+
+func TestUnitHandleDiagError(t *testing.T) {
+	if diags := HandleDiagError(nil); diags != nil {
+		t.Errorf("Expected no diagnostics, got %v", diags)
+	}
+	if diags := HandleDiagError(errors.New("default")); !diags.HasError() || diags[0].Summary != "default" {
+		t.Errorf("Expected an error diagnostic, got %v", diags)
+	}
+}
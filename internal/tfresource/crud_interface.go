@@ -4,6 +4,7 @@
 package tfresource
 
 import (
+	"context"
 	"sync"
 	"time"
 )
@@ -14,7 +15,8 @@ import (
 type ResourceFetcher interface {
 	// Get should Update the s.Resource, and is used by ReadResource() to populate s.D
 	// Get() may expect s.D.Id() to be set, but not s.Resource, or anything else in s.D
-	Get() error
+	// Get() should stop and return an error as soon as ctx is cancelled
+	Get(ctx context.Context) error
 }
 
 // ResourceVoider may set its ResourceData state to empty.
@@ -41,7 +43,7 @@ type ResourceCreator interface {
 	ResourceDataWriter
 	// ID identifies the resource, or a work request to Create the resource.
 	ID() string
-	Create() error
+	Create(ctx context.Context) error
 }
 
 // ResourceReader get BareMetal Resource and updated ResourceData
@@ -53,7 +55,7 @@ type ResourceReader interface {
 // Updates a BareMetal entity to match ResourceData
 type ResourceUpdater interface {
 	ResourceDataWriter
-	Update() error
+	Update(ctx context.Context) error
 }
 
 // Deletes a BareMetal entity
@@ -61,7 +63,7 @@ type ResourceDeleter interface {
 	ResourceVoider
 	// ID identifies the resource, or a work request to Create the resource.
 	ID() string
-	Delete() error
+	Delete(ctx context.Context) error
 }
 
 // Some resources in the oracle API are removed asynchronously, so even
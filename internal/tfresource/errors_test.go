@@ -4,6 +4,7 @@
 package tfresource
 
 import (
+	"context"
 	"testing"
 
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
@@ -61,7 +62,7 @@ func (msr *MockStatefulResource) State() string {
 	return "DummyState"
 }
 
-func (msr *MockStatefulResource) Get() error {
+func (msr *MockStatefulResource) Get(ctx context.Context) error {
 	return nil
 }
 
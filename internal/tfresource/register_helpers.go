@@ -1,7 +1,13 @@
 package tfresource
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+
+	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/globalvar"
 )
 
@@ -9,6 +15,10 @@ func RegisterResource(name string, resourceSchema *schema.Resource) {
 	if globalvar.OciResources == nil {
 		globalvar.OciResources = make(map[string]*schema.Resource)
 	}
+	addAuditResource(name, resourceSchema)
+	addRegionOverride(resourceSchema)
+	AddTagsDiffSuppressFunctions(resourceSchema.Schema)
+	addDefaultTags(resourceSchema)
 	globalvar.OciResources[name] = resourceSchema
 }
 
@@ -16,5 +26,104 @@ func RegisterDatasource(name string, datasourceSchema *schema.Resource) {
 	if globalvar.OciDatasources == nil {
 		globalvar.OciDatasources = make(map[string]*schema.Resource)
 	}
+	addAuditResource(name, datasourceSchema)
 	globalvar.OciDatasources[name] = datasourceSchema
 }
+
+// addAuditResource attributes the requests sent by the context aware CRUD functions of a resource to the resource in the audit log
+func addAuditResource(name string, resourceSchema *schema.Resource) {
+	resourceSchema.CreateContext = withAuditResource(name, "create", resourceSchema.CreateContext)
+	resourceSchema.ReadContext = withAuditResource(name, "read", resourceSchema.ReadContext)
+	resourceSchema.UpdateContext = withAuditResource(name, "update", resourceSchema.UpdateContext)
+	resourceSchema.DeleteContext = withAuditResource(name, "delete", resourceSchema.DeleteContext)
+}
+
+func withAuditResource(name string, operation string, fn func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		return fn(tf_client.WithAuditResource(ctx, name, operation), d, m)
+	}
+}
+
+// addDefaultTags merges the default_tags of the provider into the planned defined_tags and freeform_tags of a resource.
+// Only the tag attributes which are computed can be planned, which is the case for almost every resource.
+func addDefaultTags(resourceSchema *schema.Resource) {
+	var attrNames []string
+	for _, attrName := range []string{"defined_tags", "freeform_tags"} {
+		if attr, ok := resourceSchema.Schema[attrName]; ok && attr.Type == schema.TypeMap && attr.Optional && attr.Computed {
+			attrNames = append(attrNames, attrName)
+		}
+	}
+	if len(attrNames) == 0 {
+		return
+	}
+
+	customizeDiff := resourceSchema.CustomizeDiff
+	resourceSchema.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if err := setDefaultTags(d, attrNames); err != nil {
+			return err
+		}
+		if customizeDiff != nil {
+			return customizeDiff(ctx, d, m)
+		}
+		return nil
+	}
+}
+
+// addRegionOverride adds the optional region_override argument to a resource, which makes its CRUD functions use the
+// clients of that region rather than the clients of the provider's region
+func addRegionOverride(resourceSchema *schema.Resource) {
+	if resourceSchema.Schema == nil {
+		return
+	}
+	if _, exists := resourceSchema.Schema[globalvar.RegionOverrideAttrName]; exists {
+		return
+	}
+	resourceSchema.Schema[globalvar.RegionOverrideAttrName] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		// Setting region_override to the region of the provider does not move the resource
+		DiffSuppressFunc: regionOverrideDiffSuppress,
+		Description:      "The region to manage the resource in, if it is not the region of the provider. It can not be set by terraform import, import the resources of another region with a provider alias for that region.",
+	}
+
+	resourceSchema.CreateContext = withRegionOverride(resourceSchema.CreateContext)
+	resourceSchema.ReadContext = withRegionOverride(resourceSchema.ReadContext)
+	resourceSchema.UpdateContext = withRegionOverride(resourceSchema.UpdateContext)
+	resourceSchema.DeleteContext = withRegionOverride(resourceSchema.DeleteContext)
+}
+
+// ProviderRegion is the region of the provider, which the resources without a region_override are managed in
+var ProviderRegion string
+
+func regionOverrideDiffSuppress(key string, old string, new string, d *schema.ResourceData) bool {
+	resolve := func(region string) string {
+		if region == "" {
+			region = ProviderRegion
+		}
+		return string(oci_common.StringToRegion(region))
+	}
+	return resolve(old) == resolve(new)
+}
+
+// withRegionOverride calls fn with the clients of the region_override of the resource, if any
+func withRegionOverride(fn func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if fn == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		region, _ := d.Get(globalvar.RegionOverrideAttrName).(string)
+		clients, ok := m.(*tf_client.OracleClients)
+		if region == "" || !ok {
+			return fn(ctx, d, m)
+		}
+		regionClients, err := clients.ForRegion(region)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return fn(ctx, d, regionClients)
+	}
+}
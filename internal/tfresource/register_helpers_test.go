@@ -1,10 +1,14 @@
 package tfresource
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/globalvar"
 )
 
@@ -42,3 +46,100 @@ func TestUnitRegisterHelper(t *testing.T) {
 		})
 	}
 }
+
+// issue-routing-tag: terraform/default
+func TestUnitAddRegionOverride(t *testing.T) {
+	var readContextMeta interface{}
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"display_name": {Type: schema.TypeString, Optional: true},
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			readContextMeta = m
+			return nil
+		},
+	}
+	addRegionOverride(resource)
+
+	if _, ok := resource.Schema[globalvar.RegionOverrideAttrName]; !ok {
+		t.Fatalf("Expected the %s argument to be added", globalvar.RegionOverrideAttrName)
+	}
+
+	clients := &tf_client.OracleClients{SdkClientMap: map[string]interface{}{}}
+	d := resource.TestResourceData()
+	if diags := resource.ReadContext(context.Background(), d, clients); diags.HasError() || readContextMeta != clients {
+		t.Errorf("Expected the provider's clients without a region override, got %v", diags)
+	}
+
+	// The clients were not created by CreateSDKClients, so the clients of another region can not be created
+	d.Set(globalvar.RegionOverrideAttrName, "us-ashburn-1")
+	readContextMeta = nil
+	if diags := resource.ReadContext(context.Background(), d, clients); !diags.HasError() || readContextMeta != nil {
+		t.Errorf("Expected an error creating the clients for the region override")
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitRegionOverrideDiffSuppress(t *testing.T) {
+	defer func() { ProviderRegion = "" }()
+	ProviderRegion = "us-phoenix-1"
+
+	resource := &schema.Resource{Schema: map[string]*schema.Schema{}}
+	addRegionOverride(resource)
+	diffSuppress := resource.Schema[globalvar.RegionOverrideAttrName].DiffSuppressFunc
+	for _, test := range []struct {
+		old, new string
+		suppress bool
+	}{
+		{"", "us-phoenix-1", true},
+		{"", "phx", true},
+		{"us-phoenix-1", "", true},
+		{"us-ashburn-1", "iad", true},
+		{"", "us-ashburn-1", false},
+		{"us-ashburn-1", "", false},
+		{"us-ashburn-1", "us-phoenix-1", false},
+	} {
+		if suppress := diffSuppress(globalvar.RegionOverrideAttrName, test.old, test.new, nil); suppress != test.suppress {
+			t.Errorf("Expected the diff from '%s' to '%s' to be suppressed %t, got %t", test.old, test.new, test.suppress, suppress)
+		}
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitAddDefaultTags(t *testing.T) {
+	defer func() { DefaultDefinedTags, DefaultFreeformTags = nil, nil }()
+	DefaultDefinedTags = map[string]interface{}{"Finance.CostCenter": "42"}
+	DefaultFreeformTags = map[string]interface{}{"Owner": "ops", "Env": "dev"}
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"defined_tags":  {Type: schema.TypeMap, Optional: true, Computed: true, Elem: schema.TypeString},
+			"freeform_tags": {Type: schema.TypeMap, Optional: true, Computed: true, Elem: schema.TypeString},
+		},
+	}
+	addDefaultTags(resource)
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"defined_tags":  map[string]interface{}{"finance.costcenter": "7"},
+		"freeform_tags": map[string]interface{}{"Env": "prod"},
+	})
+	diff, err := resource.Diff(context.Background(), nil, config, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error planning the resource: %v", err)
+	}
+
+	planned := map[string]string{}
+	for key, attr := range diff.Attributes {
+		planned[key] = attr.New
+	}
+	want := map[string]string{
+		"defined_tags.%":                  "1",
+		"defined_tags.finance.costcenter": "7",
+		"freeform_tags.%":                 "2",
+		"freeform_tags.Owner":             "ops",
+		"freeform_tags.Env":               "prod",
+	}
+	if !reflect.DeepEqual(planned, want) {
+		t.Errorf("Expected the default tags to be merged with the tags of the resource winning, got %v, want %v", planned, want)
+	}
+}
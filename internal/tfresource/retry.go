@@ -7,10 +7,13 @@ import (
 	"fmt"
 	"log"
 	"math/rand"
+	"net/http"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/globalvar"
 
 	"github.com/oracle/terraform-provider-oci/internal/utils"
@@ -56,6 +59,124 @@ var serviceRetryPolicyFnMap = map[string]getRetryPolicyFunc{
 var ShortRetryTime = 2 * time.Minute
 var LongRetryTime = 10 * time.Minute
 var ConfiguredRetryDuration *time.Duration
+
+// RetryServices are the service names passed to GetRetryPolicy and ShouldRetry, in lower case. A retry profile can
+// be configured for each of them.
+var RetryServices = map[string]bool{
+	"adm":                            true,
+	"ai_anomaly_detection":           true,
+	"ai_vision":                      true,
+	"analytics":                      true,
+	"announcements_service":          true,
+	"apigateway":                     true,
+	"apm":                            true,
+	"apm_config":                     true,
+	"apm_synthetics":                 true,
+	"apm_traces":                     true,
+	"appmgmt_control":                true,
+	"artifacts":                      true,
+	"audit":                          true,
+	"auto_scaling":                   true,
+	"bastion":                        true,
+	"bds":                            true,
+	"blockchain":                     true,
+	"budget":                         true,
+	"catalog":                        true,
+	"catalogprivateendpoint":         true,
+	"certificates_management":        true,
+	"cloud_bridge":                   true,
+	"cloud_guard":                    true,
+	"cloud_migrations":               true,
+	"computeinstanceagent":           true,
+	"containerengine":                true,
+	"core":                           true,
+	"data_connectivity":              true,
+	"data_labeling_service":          true,
+	"data_safe":                      true,
+	"database":                       true,
+	"database_management":            true,
+	"database_migration":             true,
+	"database_tools":                 true,
+	"datacatalog":                    true,
+	"dataflow":                       true,
+	"dataintegration":                true,
+	"datasafeprivateendpoints":       true,
+	"datascience":                    true,
+	"devops":                         true,
+	"disworkspace":                   true,
+	"dns":                            true,
+	"domain":                         true,
+	"em_warehouse":                   true,
+	"email":                          true,
+	"emwarehouse":                    true,
+	"events":                         true,
+	"file_storage":                   true,
+	"functions":                      true,
+	"fusion_apps":                    true,
+	"generic_artifacts_content":      true,
+	"golden_gate":                    true,
+	"health_checks":                  true,
+	"identity":                       true,
+	"identity_data_plane":            true,
+	"integration":                    true,
+	"jms":                            true,
+	"kms":                            true,
+	"license_manager":                true,
+	"limits":                         true,
+	"load_balancer":                  true,
+	"log_analytics":                  true,
+	"logging":                        true,
+	"management_agent":               true,
+	"management_dashboard":           true,
+	"marketplace":                    true,
+	"metering_computation":           true,
+	"migration":                      true,
+	"monitoring":                     true,
+	"mysql":                          true,
+	"network_firewall":               true,
+	"network_load_balancer":          true,
+	"nosql":                          true,
+	"object_storage":                 true,
+	"oce":                            true,
+	"ocvp":                           true,
+	"oda":                            true,
+	"onesubscription":                true,
+	"ons":                            true,
+	"opensearch":                     true,
+	"operator_access_control":        true,
+	"opsi":                           true,
+	"optimizer":                      true,
+	"osmanagement":                   true,
+	"osp_gateway":                    true,
+	"osub_billing_schedule":          true,
+	"osub_organization_subscription": true,
+	"osub_subscription":              true,
+	"osub_usage":                     true,
+	"resourcemanager":                true,
+	"sch":                            true,
+	"secrets":                        true,
+	"service_catalog":                true,
+	"service_manager_proxy":          true,
+	"service_mesh":                   true,
+	"serviceconnector":               true,
+	"stack_monitoring":               true,
+	"streaming":                      true,
+	"targetalertpolicyassociation":   true,
+	"usage_proxy":                    true,
+	"vault":                          true,
+	"visual_builder":                 true,
+	"vn_monitoring":                  true,
+	"vulnerability_scanning":         true,
+	"waa":                            true,
+	"waas":                           true,
+	"waf":                            true,
+	"work_request":                   true,
+}
+
+// RetryProfiles holds the retry profiles configured through the provider's retry_policy blocks, keyed by
+// the service name passed to GetRetryPolicy. The DefaultRetryProfileName profile applies to every service
+// that does not have its own profile.
+var RetryProfiles = map[string]*RetryProfile{}
 var isServiceErrorVar = oci_common.IsServiceError
 var isErrorAffectedByEventualConsistency = oci_common.IsErrorAffectedByEventualConsistency
 
@@ -82,6 +203,17 @@ func getRetryBackoffDurationWithExpectedRetryDurationFn(response oci_common.OCIO
 	// Jitter the backoff time. The actual backoff time might be anywhere within the minimum and quadratic backoff time to avoid clustering.
 	backoffDuration := time.Duration(rand.Int63n(int64(retryBackoffRange+1))) + minRetryBackoff
 
+	// A configured retry profile with a backoff base replaces the quadratic backoff
+	if profile, ok := getRetryProfile(service); ok && profile.BackoffBase > 0 {
+		backoffDuration = profile.backoffDuration(response.AttemptNumber)
+	}
+
+	// A throttled request is retried once the service says it is ready to take requests again, rather than after a guessed backoff
+	if retryAfter, ok := getRetryAfterDuration(response); ok {
+		utils.Logf("Request was throttled, retrying after %v \n", retryAfter)
+		backoffDuration = retryAfter
+	}
+
 	// If we are about to exceed the retry duration; then reduce the backoff so that next attempt happens roughly when
 	// the entire retry duration is supposed to expire. Jitter is necessary again to avoid clustering.
 	expectedRetryDuration := expectedRetryDurationFn(response, disableNotFoundRetries, service, optionals...)
@@ -97,11 +229,40 @@ func getRetryBackoffDurationWithExpectedRetryDurationFn(response oci_common.OCIO
 	return backoffDuration
 }
 
+// getRetryAfterDuration returns the wait asked for by the Retry-After header of a 429 response, if there is one
+func getRetryAfterDuration(response oci_common.OCIOperationResponse) (time.Duration, bool) {
+	if response.Response == nil || response.Response.HTTPResponse() == nil {
+		return 0, false
+	}
+	httpResponse := response.Response.HTTPResponse()
+	if httpResponse.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+	retryAfter, ok := utils.RetryAfterDuration(httpResponse)
+	if !ok {
+		return 0, false
+	}
+	if retryAfter < minRetryBackoff {
+		retryAfter = minRetryBackoff
+	}
+	return retryAfter, true
+}
+
 func GetElapsedRetryDuration(firstAttemptTime time.Time) time.Duration {
 	return time.Now().Sub(firstAttemptTime)
 }
 
 func getExpectedRetryDuration(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, service string, optionals ...interface{}) time.Duration {
+	expectedRetryDuration := getServiceExpectedRetryDuration(response, disableNotFoundRetries, service, optionals...)
+
+	// A configured retry profile may extend or limit the duration computed for the service
+	if profile, ok := getRetryProfile(service); ok {
+		return profile.expectedRetryDuration(response, expectedRetryDuration)
+	}
+	return expectedRetryDuration
+}
+
+func getServiceExpectedRetryDuration(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, service string, optionals ...interface{}) time.Duration {
 	// Get the override retry duration function if it exists. This gives the most granular control over what value to return, and is passed
 	// into GetRetryPolicy function as an optional argument to override retry durations on a per API basis.
 	if len(optionals) > 0 {
@@ -119,6 +280,103 @@ func getExpectedRetryDuration(response oci_common.OCIOperationResponse, disableN
 	return GetDefaultExpectedRetryDuration(response, disableNotFoundRetries)
 }
 
+// RetryProfile overrides the default retry behaviour for a service
+type RetryProfile struct {
+	// MaxDuration is the retry duration for retryable responses, and the upper limit for every other retry
+	MaxDuration *time.Duration
+	// BackoffBase is the wait before the first retry, doubled on each attempt up to BackoffCap
+	BackoffBase time.Duration
+	BackoffCap  time.Duration
+	Jitter      bool
+	// RetryableStatusCodes and RetryableErrorCodes are retried for MaxDuration in addition to the errors
+	// that are already retried for the service
+	RetryableStatusCodes []int
+	RetryableErrorCodes  []string
+}
+
+const DefaultRetryProfileName = "default"
+
+// RetryProfileServices returns the services a retry profile can be configured for, DefaultRetryProfileName included
+func RetryProfileServices() []string {
+	services := []string{DefaultRetryProfileName}
+	for service := range RetryServices {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	return services
+}
+
+func getRetryProfile(service string) (*RetryProfile, bool) {
+	// The retry_policy blocks are keyed by the lower case service name
+	if profile, ok := RetryProfiles[strings.ToLower(service)]; ok && profile != nil {
+		return profile, true
+	}
+	if profile, ok := RetryProfiles[DefaultRetryProfileName]; ok && profile != nil {
+		return profile, true
+	}
+	return nil, false
+}
+
+func (p *RetryProfile) isRetryable(response oci_common.OCIOperationResponse) bool {
+	if response.Response != nil && response.Response.HTTPResponse() != nil {
+		statusCode := response.Response.HTTPResponse().StatusCode
+		for _, retryableStatusCode := range p.RetryableStatusCodes {
+			if statusCode == retryableStatusCode {
+				return true
+			}
+		}
+	}
+	if failure, ok := isServiceErrorVar(response.Error); ok && failure != nil {
+		for _, retryableErrorCode := range p.RetryableErrorCodes {
+			if strings.EqualFold(failure.GetCode(), retryableErrorCode) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (p *RetryProfile) expectedRetryDuration(response oci_common.OCIOperationResponse, serviceRetryDuration time.Duration) time.Duration {
+	if p.isRetryable(response) {
+		if p.MaxDuration != nil {
+			return *p.MaxDuration
+		}
+		return LongRetryTime
+	}
+	if p.MaxDuration != nil && serviceRetryDuration > *p.MaxDuration {
+		return *p.MaxDuration
+	}
+	return serviceRetryDuration
+}
+
+// backoffDuration computes an exponential backoff of BackoffBase * 2^(attempt-1), capped by BackoffCap or, if
+// that is not set, by the cap of the default quadratic backoff.
+// With jitter enabled the actual backoff is anywhere between minRetryBackoff and the computed backoff.
+func (p *RetryProfile) backoffDuration(attempt uint) time.Duration {
+	backoffCap := p.BackoffCap
+	if backoffCap <= 0 {
+		backoffCap = time.Duration(2*quadraticBackoffCap*quadraticBackoffCap) * time.Second
+	}
+	if attempt < 1 {
+		attempt = 1
+	}
+	// Avoid overflowing the shift for long running retries
+	if attempt > 32 {
+		attempt = 32
+	}
+	backoff := p.BackoffBase << (attempt - 1)
+	if backoff <= 0 || backoff > backoffCap {
+		backoff = backoffCap
+	}
+	if backoff < minRetryBackoff {
+		backoff = minRetryBackoff
+	}
+	if p.Jitter {
+		return time.Duration(rand.Int63n(int64(backoff-minRetryBackoff+1))) + minRetryBackoff
+	}
+	return backoff
+}
+
 func GetDefaultExpectedRetryDuration(response oci_common.OCIOperationResponse, disableNotFoundRetries bool) time.Duration {
 	defaultRetryTime := ShortRetryTime
 
@@ -344,9 +602,9 @@ func ShouldRetry(response oci_common.OCIOperationResponse, disableNotFoundRetrie
 // for this function call to be made immediately before the client API call.
 func GetRetryPolicy(disableNotFoundRetries bool, service string, optionals ...interface{}) *oci_common.RetryPolicy {
 	if serviceRetryPolicyFn, ok := serviceRetryPolicyFnMap[service]; ok {
-		return serviceRetryPolicyFn(disableNotFoundRetries, service, optionals...)
+		return tf_client.WithAuditCallEnd(serviceRetryPolicyFn(disableNotFoundRetries, service, optionals...))
 	}
-	return getDefaultRetryPolicy(disableNotFoundRetries, service, optionals...)
+	return tf_client.WithAuditCallEnd(getDefaultRetryPolicy(disableNotFoundRetries, service, optionals...))
 }
 
 func getDefaultRetryPolicy(disableNotFoundRetries bool, service string, optionals ...interface{}) *oci_common.RetryPolicy {
@@ -5,8 +5,15 @@ package tfresource
 
 import (
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"net"
 	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -841,3 +848,180 @@ func TestUnitDefaultExpectedRetryDuration(t *testing.T) {
 
 	}
 }
+
+type testServiceError struct {
+	statusCode int
+	code       string
+}
+
+func (e testServiceError) GetHTTPStatusCode() int  { return e.statusCode }
+func (e testServiceError) GetMessage() string      { return e.code }
+func (e testServiceError) GetCode() string         { return e.code }
+func (e testServiceError) GetOpcRequestID() string { return "" }
+func (e testServiceError) Error() string           { return e.code }
+
+// issue-routing-tag: terraform/default
+func TestUnitRetryProfileExpectedRetryDuration(t *testing.T) {
+	if httpreplay.ModeRecordReplay() {
+		t.Skip("Skip Retry Tests in HttpReplay mode.")
+	}
+	ShortRetryTime = 1 * time.Second
+	LongRetryTime = 2 * time.Second
+	ConfiguredRetryDuration = nil
+	isServiceErrorVar = common.IsServiceError
+	maxDuration := 30 * time.Second
+	shortMaxDuration := 500 * time.Millisecond
+	RetryProfiles = map[string]*RetryProfile{
+		"limits":                {MaxDuration: &maxDuration, RetryableStatusCodes: []int{429}},
+		"database":              {RetryableErrorCodes: []string{"IncorrectState"}},
+		DefaultRetryProfileName: {MaxDuration: &shortMaxDuration},
+	}
+	defer func() {
+		RetryProfiles = map[string]*RetryProfile{}
+	}()
+
+	type args struct {
+		response common.OCIOperationResponse
+		service  string
+	}
+	type testFormat struct {
+		name   string
+		args   args
+		output time.Duration
+	}
+	tests := []testFormat{
+		{
+			name:   "Test retryable status code uses the profile max duration",
+			args:   args{response: common.OCIOperationResponse{Response: TestOCIResponse{statusCode: 429}}, service: "limits"},
+			output: 30 * time.Second,
+		},
+		{
+			name:   "Test non retryable status code keeps the service duration",
+			args:   args{response: common.OCIOperationResponse{Response: TestOCIResponse{statusCode: 404}}, service: "limits"},
+			output: 0,
+		},
+		{
+			name:   "Test retryable error code without max duration uses the long retry time",
+			args:   args{response: common.OCIOperationResponse{Error: testServiceError{statusCode: 409, code: "IncorrectState"}, Response: TestOCIResponse{statusCode: 409}}, service: "database"},
+			output: 2 * time.Second,
+		},
+		{
+			name:   "Test default profile limits the service duration",
+			args:   args{response: common.OCIOperationResponse{Response: TestOCIResponse{statusCode: 500}}, service: "core"},
+			output: 500 * time.Millisecond,
+		},
+	}
+	for _, test := range tests {
+		t.Logf("Running %s", test.name)
+		if res := getExpectedRetryDuration(test.args.response, false, test.args.service); res != test.output {
+			t.Errorf("Output %s not equal to expected %s", res, test.output)
+		}
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitRetryProfileBackoffDuration(t *testing.T) {
+	profile := &RetryProfile{BackoffBase: 2 * time.Second, BackoffCap: 10 * time.Second}
+	expected := []time.Duration{2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second}
+	for i, output := range expected {
+		if res := profile.backoffDuration(uint(i + 1)); res != output {
+			t.Errorf("Output %s not equal to expected %s for attempt %d", res, output, i+1)
+		}
+	}
+
+	profile.Jitter = true
+	for attempt := uint(1); attempt < 100; attempt++ {
+		if res := profile.backoffDuration(attempt); res < minRetryBackoff || res > profile.BackoffCap {
+			t.Errorf("Expected wait time to be between %v and %v for attempt %v, but got %v", minRetryBackoff, profile.BackoffCap, attempt, res)
+		}
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitGetRetryAfterDuration(t *testing.T) {
+	type testFormat struct {
+		name       string
+		statusCode int
+		header     map[string][]string
+		output     time.Duration
+		ok         bool
+	}
+	tests := []testFormat{
+		{name: "Throttled with Retry-After seconds", statusCode: 429, header: map[string][]string{"Retry-After": {"5"}}, output: 5 * time.Second, ok: true},
+		{name: "Throttled with lower case header", statusCode: 429, header: map[string][]string{"retry-after": {"3"}}, output: 3 * time.Second, ok: true},
+		{name: "Throttled with zero wait", statusCode: 429, header: map[string][]string{"Retry-After": {"0"}}, output: minRetryBackoff, ok: true},
+		{name: "Throttled without Retry-After", statusCode: 429, header: map[string][]string{}, output: 0, ok: false},
+		{name: "Retry-After on other status", statusCode: 503, header: map[string][]string{"Retry-After": {"5"}}, output: 0, ok: false},
+	}
+	for _, test := range tests {
+		t.Logf("Running %s", test.name)
+		response := common.NewOCIOperationResponse(TestOCIResponse{statusCode: test.statusCode, header: test.header}, fmt.Errorf("error"), 1)
+		res, ok := getRetryAfterDuration(response)
+		if res != test.output || ok != test.ok {
+			t.Errorf("Output %v, %v not equal to expected %v, %v", res, ok, test.output, test.ok)
+		}
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitGetRetryBackoffDurationRetryAfter(t *testing.T) {
+	expectedRetryDuration := func(response common.OCIOperationResponse, disableNotFoundRetries bool, service string, optionals ...interface{}) time.Duration {
+		return time.Minute
+	}
+	response := common.NewOCIOperationResponse(TestOCIResponse{statusCode: 429, header: map[string][]string{"Retry-After": {"20"}}}, fmt.Errorf("error"), 1)
+
+	if res := getRetryBackoffDurationWithExpectedRetryDurationFn(response, false, "", time.Now(), expectedRetryDuration); res != 20*time.Second {
+		t.Errorf("Expected the wait of the Retry-After header within the retry duration, got %v", res)
+	}
+
+	// The wait is capped by the time left to retry, as for any other backoff
+	startTime := time.Now().Add(-50 * time.Second)
+	maxBackoff := 10*time.Second + time.Duration(float64(time.Minute)*0.05) + minRetryBackoff
+	if res := getRetryBackoffDurationWithExpectedRetryDurationFn(response, false, "", startTime, expectedRetryDuration); res > maxBackoff {
+		t.Errorf("Expected the wait of the Retry-After header to be capped by %v, got %v", maxBackoff, res)
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitRetryServices(t *testing.T) {
+	// Index of the service argument of the functions selecting the retry behavior of a service
+	serviceArgs := map[string]int{"GetRetryPolicy": 1, "ShouldRetry": 2, "GetRetryPolicyWithAdditionalRetryCondition": 2}
+	err := filepath.Walk("../service", func(path string, info os.FileInfo, err error) error {
+		if err != nil || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return err
+		}
+		file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+		if err != nil {
+			return err
+		}
+		ast.Inspect(file, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			selector, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			index, ok := serviceArgs[selector.Sel.Name]
+			if !ok || len(call.Args) <= index {
+				return true
+			}
+			arg := call.Args[index]
+			// e.g. strings.ToLower("targetAlertPolicyAssociation")
+			if inner, ok := arg.(*ast.CallExpr); ok && len(inner.Args) == 1 {
+				arg = inner.Args[0]
+			}
+			if literal, ok := arg.(*ast.BasicLit); ok {
+				service, _ := strconv.Unquote(literal.Value)
+				if !RetryServices[strings.ToLower(service)] {
+					t.Errorf("%s: service '%s' of %s is missing from RetryServices", path, service, selector.Sel.Name)
+				}
+			}
+			return true
+		})
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, RetryProfileServices(), DefaultRetryProfileName)
+}
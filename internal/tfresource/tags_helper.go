@@ -12,6 +12,11 @@ import (
 )
 
 var DefinedTagsToSuppress []string
+var FreeformTagsToSuppress []string
+
+// DefaultDefinedTags and DefaultFreeformTags are the default_tags of the provider, merged into the tags of every resource
+var DefaultDefinedTags map[string]interface{}
+var DefaultFreeformTags map[string]interface{}
 
 func DefinedTagsToMap(definedTags map[string]map[string]interface{}) map[string]interface{} {
 	var tags = make(map[string]interface{})
@@ -44,28 +49,39 @@ func MapToDefinedTags(rawMap map[string]interface{}) (map[string]map[string]inte
 }
 
 func DefinedTagsDiffSuppressFunction(key string, old string, new string, d *schema.ResourceData) bool {
-	keyParts := strings.Split(key, ".")
-	for _, tags := range DefinedTagsToSuppress {
-		if strings.EqualFold(strings.Join(keyParts[1:], "."), tags) {
-			return true
-		}
-	}
-	if old != "" && new != "" {
-		return false
-	}
+	return tagsDiffSuppress("defined_tags", DefinedTagsToSuppress, DefaultDefinedTags, true, key, old, new, d)
+}
 
-	// Find the specific defined_tag key name (mainly if a resource supports tagging at multiple levels)
+// FreeformTagsDiffSuppressFunction suppresses the differences of the freeform tags matching FreeformTagsToSuppress
+func FreeformTagsDiffSuppressFunction(key string, old string, new string, d *schema.ResourceData) bool {
+	return tagsDiffSuppress("freeform_tags", FreeformTagsToSuppress, DefaultFreeformTags, false, key, old, new, d)
+}
+
+func tagsDiffSuppress(attrName string, tagsToSuppress []string, defaultTags map[string]interface{}, ignoreCase bool, key string, old string, new string, d *schema.ResourceData) bool {
+	// Find the specific tag attribute key name (mainly if a resource supports tagging at multiple levels)
 	// For example: "create_vnic_details.0.defined_tags.mynamespace.mykey" => "create_vnic_details.0.defined_tags"
-	definedTagKeyParts := []string{}
+	keyParts := strings.Split(key, ".")
+	tagKeyParts := []string{}
 	for _, keyPart := range keyParts {
-		definedTagKeyParts = append(definedTagKeyParts, keyPart)
-		if strings.EqualFold(keyPart, "defined_tags") {
+		tagKeyParts = append(tagKeyParts, keyPart)
+		if strings.EqualFold(keyPart, attrName) {
 			break
 		}
 	}
+	tagKey := strings.Join(keyParts[len(tagKeyParts):], ".")
+
+	if IsIgnoredTag(tagKey, tagsToSuppress, ignoreCase) {
+		return true
+	}
+	if old != "" && new != "" && tagKey != "%" {
+		return false
+	}
+	if d == nil {
+		return false
+	}
 
 	//Old value comes from refreshed state, while new value comes from config
-	oldRaw, newRaw := d.GetChange(strings.Join(definedTagKeyParts, "."))
+	oldRaw, newRaw := d.GetChange(strings.Join(tagKeyParts, "."))
 	if newRaw == nil || oldRaw == nil {
 		return false
 	}
@@ -76,15 +92,140 @@ func DefinedTagsDiffSuppressFunction(key string, old string, new string, d *sche
 		return false
 	}
 
-	lowerCaseNewValueMap := ToLowerCaseKeyMap(newValue)
-	lowerCaseOldValueMap := ToLowerCaseKeyMap(oldValue)
+	// The default tags of the provider are only merged into the tags of the resource itself, not of its nested blocks
+	if len(tagKeyParts) == 1 {
+		newValue = WithDefaultTags(newValue, defaultTags, ignoreCase)
+	}
+	newValue = withoutIgnoredTags(newValue, tagsToSuppress, ignoreCase)
+	oldValue = withoutIgnoredTags(oldValue, tagsToSuppress, ignoreCase)
+	if ignoreCase {
+		newValue = ToLowerCaseKeyMap(newValue)
+		oldValue = ToLowerCaseKeyMap(oldValue)
+	}
 
-	if reflect.DeepEqual(lowerCaseOldValueMap, lowerCaseNewValueMap) {
+	if reflect.DeepEqual(oldValue, newValue) {
 		return true
 	}
 	return false
 }
 
+// IsIgnoredTag reports if a tag key matches one of the ignore rules. Rules may use '*' as a wildcard, e.g. "Oracle-Tags.*"
+// matches every tag of the Oracle-Tags namespace, and are matched case insensitively if ignoreCase is set, as for the
+// namespace.key of defined tags.
+func IsIgnoredTag(tagKey string, tagsToSuppress []string, ignoreCase bool) bool {
+	if ignoreCase {
+		tagKey = strings.ToLower(tagKey)
+	}
+	for _, pattern := range tagsToSuppress {
+		if ignoreCase {
+			pattern = strings.ToLower(pattern)
+		}
+		if tagPatternMatch(pattern, tagKey) {
+			return true
+		}
+	}
+	return false
+}
+
+func tagPatternMatch(pattern string, tagKey string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == tagKey
+	}
+	if !strings.HasPrefix(tagKey, parts[0]) {
+		return false
+	}
+	tagKey = tagKey[len(parts[0]):]
+	for _, part := range parts[1 : len(parts)-1] {
+		index := strings.Index(tagKey, part)
+		if index < 0 {
+			return false
+		}
+		tagKey = tagKey[index+len(part):]
+	}
+	return strings.HasSuffix(tagKey, parts[len(parts)-1])
+}
+
+// WithDefaultTags merges the tags of a resource into a copy of the default tags, so that the tags of the resource win.
+// Keys are compared case insensitively if ignoreCase is set, as for the namespace.key of defined tags.
+func WithDefaultTags(tags map[string]interface{}, defaultTags map[string]interface{}, ignoreCase bool) map[string]interface{} {
+	if len(defaultTags) == 0 {
+		return tags
+	}
+	result := make(map[string]interface{}, len(tags)+len(defaultTags))
+	for key, value := range defaultTags {
+		result[key] = value
+	}
+	for key, value := range tags {
+		if ignoreCase {
+			for defaultKey := range defaultTags {
+				if strings.EqualFold(key, defaultKey) {
+					delete(result, defaultKey)
+				}
+			}
+		}
+		result[key] = value
+	}
+	return result
+}
+
+// setDefaultTags plans the default tags of the provider as part of the given tag attributes of a resource, so that they
+// are sent with the create and update requests and shown in the plan
+func setDefaultTags(d *schema.ResourceDiff, attrNames []string) error {
+	for _, attrName := range attrNames {
+		defaultTags, ignoreCase := DefaultFreeformTags, false
+		if attrName == "defined_tags" {
+			defaultTags, ignoreCase = DefaultDefinedTags, true
+		}
+		if len(defaultTags) == 0 || !d.NewValueKnown(attrName) {
+			continue
+		}
+		tags, _ := d.Get(attrName).(map[string]interface{})
+		mergedTags := WithDefaultTags(tags, defaultTags, ignoreCase)
+		if reflect.DeepEqual(tags, mergedTags) {
+			continue
+		}
+		if err := d.SetNew(attrName, mergedTags); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func withoutIgnoredTags(tags map[string]interface{}, tagsToSuppress []string, ignoreCase bool) map[string]interface{} {
+	if len(tagsToSuppress) == 0 {
+		return tags
+	}
+	result := make(map[string]interface{}, len(tags))
+	for key, value := range tags {
+		if !IsIgnoredTag(key, tagsToSuppress, ignoreCase) {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+// AddTagsDiffSuppressFunctions applies the tag ignore rules of the provider to the tag attributes of a resource,
+// including the ones of nested blocks, which do not suppress their differences already. The computed only system_tags
+// have no configuration to differ from, so they are left alone.
+func AddTagsDiffSuppressFunctions(resourceSchema map[string]*schema.Schema) {
+	for name, attr := range resourceSchema {
+		if nested, ok := attr.Elem.(*schema.Resource); ok {
+			AddTagsDiffSuppressFunctions(nested.Schema)
+			continue
+		}
+		if attr.Type != schema.TypeMap || attr.DiffSuppressFunc != nil || !(attr.Optional || attr.Required) {
+			continue
+		}
+		switch name {
+		case "defined_tags":
+			attr.DiffSuppressFunc = DefinedTagsDiffSuppressFunction
+		case "freeform_tags":
+			attr.DiffSuppressFunc = FreeformTagsDiffSuppressFunction
+		}
+	}
+}
+
 func ToLowerCaseKeyMap(original map[string]interface{}) map[string]interface{} {
 	lowercaseKeyMap := make(map[string]interface{}, len(original))
 	for key, value := range original {
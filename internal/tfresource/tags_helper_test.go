@@ -268,3 +268,82 @@ func TestUnitDefinedTagsDiffSuppressFunction(t *testing.T) {
 		})
 	}
 }
+
+func TestUnitIsIgnoredTag(t *testing.T) {
+	tagsToSuppress := []string{"CreatedBy", "Oracle-Tags.*", "*.CostCenter", "ops-*-owner"}
+	tests := []struct {
+		tagKey string
+		want   bool
+	}{
+		{"CreatedBy", true},
+		{"createdby", true},
+		{"CreatedByAutomation", false},
+		{"Oracle-Tags.CreatedOn", true},
+		{"oracle-tags.CreatedBy", true},
+		{"Oracle-Tagsx.CreatedBy", false},
+		{"finance.CostCenter", true},
+		{"finance.CostCenters", false},
+		{"ops-team-owner", true},
+		{"ops-owner", false},
+		{"Environment", false},
+	}
+	for _, tt := range tests {
+		if got := IsIgnoredTag(tt.tagKey, tagsToSuppress, true); got != tt.want {
+			t.Errorf("IsIgnoredTag(%q) = %v, want %v", tt.tagKey, got, tt.want)
+		}
+	}
+
+	// Freeform tag keys are case sensitive
+	for _, tagKey := range []string{"createdby", "oracle-tags.CreatedBy", "Finance.costcenter"} {
+		if IsIgnoredTag(tagKey, tagsToSuppress, false) {
+			t.Errorf("IsIgnoredTag(%q) = true for a case sensitive key", tagKey)
+		}
+	}
+}
+
+func TestUnitFreeformTagsDiffSuppressFunction(t *testing.T) {
+	defer func() { FreeformTagsToSuppress = nil }()
+	FreeformTagsToSuppress = []string{"Created*"}
+
+	resourceSchema := map[string]*schema.Schema{
+		"freeform_tags": {Type: schema.TypeMap, Optional: true, Computed: true, Elem: schema.TypeString},
+		"system_tags":   {Type: schema.TypeMap, Computed: true, Elem: schema.TypeString},
+		"create_vnic_details": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"freeform_tags": {Type: schema.TypeMap, Optional: true, Elem: schema.TypeString},
+				},
+			},
+		},
+	}
+	AddTagsDiffSuppressFunctions(resourceSchema)
+	if resourceSchema["freeform_tags"].DiffSuppressFunc == nil || resourceSchema["create_vnic_details"].Elem.(*schema.Resource).Schema["freeform_tags"].DiffSuppressFunc == nil {
+		t.Fatalf("AddTagsDiffSuppressFunctions() did not add the diff suppress function to the freeform_tags attributes")
+	}
+	if resourceSchema["system_tags"].DiffSuppressFunc != nil {
+		t.Errorf("AddTagsDiffSuppressFunctions() added a diff suppress function to the computed only system_tags")
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"freeform_tags": map[string]interface{}{"Department": "Finance"},
+	})
+	tests := []struct {
+		key  string
+		old  string
+		new  string
+		want bool
+	}{
+		{"freeform_tags.CreatedBy", "automation", "", true},
+		{"create_vnic_details.0.freeform_tags.CreatedOn", "2021-01-01", "", true},
+		{"freeform_tags.Department", "Engineering", "Finance", false},
+		{"freeform_tags.Owner", "", "ops", false},
+		{"freeform_tags.createdBy", "automation", "", false},
+	}
+	for _, tt := range tests {
+		if got := FreeformTagsDiffSuppressFunction(tt.key, tt.old, tt.new, d); got != tt.want {
+			t.Errorf("FreeformTagsDiffSuppressFunction(%q) = %v, want %v", tt.key, got, tt.want)
+		}
+	}
+}
@@ -9,12 +9,14 @@ import (
 	"hash/crc32"
 	"io/ioutil"
 	"math/rand"
+	"net/http"
 	"os"
 	"os/user"
 	"path"
 	"path/filepath"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -271,6 +273,31 @@ func CheckProfile(profile string, path string) (err error) {
 	return fmt.Errorf("configuration file did not contain profile: %s", profile)
 }
 
+// GetProfileValue returns the value of key in the profile of the configuration file at path
+func GetProfileValue(profile string, path string, key string) (string, error) {
+	var profileRegex = regexp.MustCompile(`^\[(.*)\]`)
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	inProfile := false
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if match := profileRegex.FindStringSubmatch(line); match != nil && len(match) > 1 {
+			inProfile = match[1] == profile
+			continue
+		}
+		if !inProfile {
+			continue
+		}
+		if keyValue := strings.SplitN(line, "=", 2); len(keyValue) == 2 && strings.TrimSpace(keyValue[0]) == key {
+			return strings.TrimSpace(keyValue[1]), nil
+		}
+	}
+
+	return "", fmt.Errorf("profile %s of configuration file %s does not contain %s", profile, path, key)
+}
+
 func CheckIncompatibleAttrsForApiKeyAuth(d *schema.ResourceData, apiKeyConfigAttributes [5]string) ([]string, bool) {
 	var apiKeyConfigAttributesToUnset []string
 	for _, apiKeyConfigAttribute := range apiKeyConfigAttributes {
@@ -355,3 +382,31 @@ func GetVarNameFromAttributeOfResources(attribute string, resourceType string, r
 	// Following format resourceType--attribute-attribute-...–resourceName
 	return fmt.Sprintf(globalvar.VariableResourceLevelFormat, resourceType, strings.ReplaceAll(attribute, ".", "-"), resourceName)
 }
+
+// RetryAfterDuration returns the wait requested by the Retry-After header of a response, if any.
+// Both the delay-seconds and the HTTP-date forms of the header are supported.
+func RetryAfterDuration(response *http.Response) (time.Duration, bool) {
+	if response == nil || response.Header == nil {
+		return 0, false
+	}
+	value := response.Header.Get("Retry-After")
+	if value == "" {
+		// Some services send the header in lower case, which is not canonicalized when set directly on the map
+		if values := response.Header["retry-after"]; len(values) > 0 {
+			value = values[0]
+		}
+	}
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(date); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
@@ -30,8 +30,17 @@ func main() {
 	var excludeServices = flag.String("exclude_services", "", "[export] [experimental] Comma-separated list of service resources to exclude from export. If a service is present in both 'services' and 'exclude_services' argument, it will be excluded.")
 	var ids = flag.String("ids", "", "[export] Comma-separated list of tuples <resource Type:resource ID> for resources to export. The ID could either be an OCID or a Terraform import ID. By default, all resources are exported.")
 	var generateStateFile = flag.Bool("generate_state", false, "[export][experimental] Set this to import the discovered resources into a state file along with the Terraform configuration")
+	var importMode = flag.String("import_mode", string(tf_export.ImportModeCli), "[export][experimental] How to import the discovered resources. The allowed values are :\n * cli - run terraform import for every resource when generate_state is set\n * native - write the state file directly when generate_state is set, without the Terraform CLI\n * blocks - write Terraform v1.5+ import blocks to imports.tf instead, which does not need the Terraform CLI")
+	var outputLayout = flag.String("output_layout", string(tf_export.OutputLayoutFlat), "[export][experimental] How to structure the generated configuration files. The allowed values are :\n * flat - write a file per service under output_path\n * modules - write a module per compartment with a file per service under output_path/modules, wired together by output_path/main.tf")
+	var baselineState = flag.String("baseline_state", "", "[export][experimental] Path to the state file of a previous export. Set this to write a drift report of the resources which are new, deleted or changed since that export to output_path/drift.json, and to generate the configuration of the new resources only")
+	var filter = flag.String("filter", "", "[export][experimental] Semicolon-separated list of filter expressions, only the resources matching all of them are exported. The supported expressions are :\n * freeform_tag.<key>[=<value>,...] or freeform_tag.<key>!=<value>,...\n * defined_tag.<namespace>.<key>[=<value>,...] or defined_tag.<namespace>.<key>!=<value>,...\n * display_name=<name>,..., display_name!=<name>,... or display_name~<regex>\n * state=<state>,... or state!=<state>,...\n * time_created>=<RFC3339 time>, time_created<=<RFC3339 time>, time_created><RFC3339 time> or time_created<<RFC3339 time>\n * type=<resource type>,... or type!=<resource type>,...")
+	var reportFile = flag.String("report_file", "", "[export][experimental] Path to a JSON file to write a report of the export to, with the discovered and omitted resources, the errors, the missing required attributes and the time taken by each step")
+	var regions = flag.String("regions", "", "[export][experimental] Comma-separated list of regions to export. The first region is the region of the default provider configuration, the resources of the other regions are bound to a provider alias of their region. By default, the region of the provider configuration is exported")
+	var compartmentIds = flag.String("compartment_ids", "", "[export][experimental] Comma-separated list of OCIDs of compartments to export. Use this instead of 'compartment_id' to export several compartments")
+	var compartmentDepth = flag.Int("compartment_depth", 0, "[export][experimental] The levels of sub-compartments of the exported compartments to export as well. By default the value is 0, the sub-compartments are not exported")
+	var resume = flag.Bool("resume", false, "[export][experimental] Set this to resume an export which failed, from the checkpoints it wrote under output_path/.export_checkpoint. The steps which completed their discovery and the resources already imported into the state file are skipped. The other arguments must be the same as for the failed export")
 	var help = flag.Bool("help", false, "Prints usage options")
-	var tfVersion = flag.String("tf_version", "0.12", "The version of terraform syntax to generate for configurations. The state file will be written in v0.12 only. The allowed values are :\n * 0.11\n * 0.12")
+	var tfVersion = flag.String("tf_version", "0.12", "The version of terraform syntax to generate for configurations. The state file will be written in v0.12 only. The allowed values are :\n * 0.11\n * 0.12\n * 1 - Terraform 1.x and OpenTofu")
 	var retryTimeout = flag.String("retry_timeout", "15s", "[export] The time duration for which API calls will wait and retry operation in case of API errors. By default, the retry timeout duration is 15s")
 	var parallelism = flag.Int("parallelism", 1, "The number of threads to use for resource discovery. By default the value is 1")
 	var varsResourceLevel = flag.String("variables_resource_level", "", "[export] List of top-level attributes to be export as variable following format resourceType.attribute, if attribute is present in variables_global_level, it will be excluded for this resourceType")
@@ -61,8 +70,10 @@ func main() {
 				terraformVersion = &tf_export.TfHclVersion11{Value: tf_export.TfVersionEnum(*tfVersion)}
 			} else if *tfVersion == "" || tf_export.TfVersionEnum(*tfVersion) == tf_export.TfVersion12 {
 				terraformVersion = &tf_export.TfHclVersion12{Value: tf_export.TfVersionEnum(*tfVersion)}
+			} else if tf_export.TfVersionEnum(*tfVersion) == tf_export.TfVersion1 {
+				terraformVersion = &tf_export.TfHclVersion1{TfHclVersion12: tf_export.TfHclVersion12{Value: tf_export.TfVersion1}}
 			} else {
-				color.Red("[ERROR]: Invalid tf_version '%s', supported values: 0.11, 0.12\n", *tfVersion)
+				color.Red("[ERROR]: Invalid tf_version '%s', supported values: 0.11, 0.12, 1\n", *tfVersion)
 				os.Exit(1)
 			}
 
@@ -80,6 +91,12 @@ func main() {
 				RetryTimeout:                 retryTimeout,
 				IsExportWithRelatedResources: *includeRelatedResources,
 				Parallelism:                  *parallelism,
+				ImportMode:                   tf_export.ImportModeEnum(*importMode),
+				OutputLayout:                 tf_export.OutputLayoutEnum(*outputLayout),
+				BaselineState:                baselineState,
+				ReportFile:                   reportFile,
+				Resume:                       *resume,
+				CompartmentDepth:             *compartmentDepth,
 			}
 
 			if services != nil && *services != "" {
@@ -101,6 +118,18 @@ func main() {
 			if ids != nil && *ids != "" {
 				args.IDs = strings.Split(*ids, ",")
 			}
+
+			if regions != nil && *regions != "" {
+				args.Regions = strings.Split(*regions, ",")
+			}
+
+			if compartmentIds != nil && *compartmentIds != "" {
+				args.CompartmentIds = strings.Split(*compartmentIds, ",")
+			}
+
+			if filter != nil && *filter != "" {
+				args.Filters = strings.Split(*filter, globalvar.ColonDelimiter)
+			}
 			err, status := resourcediscovery.RunExportCommand(args)
 			if err != nil {
 				color.Red("%v", err)